@@ -19,16 +19,19 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Accounts_FullMethodName             = "/cosmos.auth.v1beta1.Query/Accounts"
-	Query_Account_FullMethodName              = "/cosmos.auth.v1beta1.Query/Account"
-	Query_AccountAddressByID_FullMethodName   = "/cosmos.auth.v1beta1.Query/AccountAddressByID"
-	Query_Params_FullMethodName               = "/cosmos.auth.v1beta1.Query/Params"
-	Query_ModuleAccounts_FullMethodName       = "/cosmos.auth.v1beta1.Query/ModuleAccounts"
-	Query_ModuleAccountByName_FullMethodName  = "/cosmos.auth.v1beta1.Query/ModuleAccountByName"
-	Query_Bech32Prefix_FullMethodName         = "/cosmos.auth.v1beta1.Query/Bech32Prefix"
-	Query_AddressBytesToString_FullMethodName = "/cosmos.auth.v1beta1.Query/AddressBytesToString"
-	Query_AddressStringToBytes_FullMethodName = "/cosmos.auth.v1beta1.Query/AddressStringToBytes"
-	Query_AccountInfo_FullMethodName          = "/cosmos.auth.v1beta1.Query/AccountInfo"
+	Query_Accounts_FullMethodName              = "/cosmos.auth.v1beta1.Query/Accounts"
+	Query_Account_FullMethodName               = "/cosmos.auth.v1beta1.Query/Account"
+	Query_AccountAddressByID_FullMethodName    = "/cosmos.auth.v1beta1.Query/AccountAddressByID"
+	Query_Params_FullMethodName                = "/cosmos.auth.v1beta1.Query/Params"
+	Query_ModuleAccounts_FullMethodName        = "/cosmos.auth.v1beta1.Query/ModuleAccounts"
+	Query_ModuleAccountByName_FullMethodName   = "/cosmos.auth.v1beta1.Query/ModuleAccountByName"
+	Query_Bech32Prefix_FullMethodName          = "/cosmos.auth.v1beta1.Query/Bech32Prefix"
+	Query_AddressBytesToString_FullMethodName  = "/cosmos.auth.v1beta1.Query/AddressBytesToString"
+	Query_AddressStringToBytes_FullMethodName  = "/cosmos.auth.v1beta1.Query/AddressStringToBytes"
+	Query_AccountInfo_FullMethodName           = "/cosmos.auth.v1beta1.Query/AccountInfo"
+	Query_AccountsByNumberRange_FullMethodName = "/cosmos.auth.v1beta1.Query/AccountsByNumberRange"
+	Query_PubKeyHistory_FullMethodName         = "/cosmos.auth.v1beta1.Query/PubKeyHistory"
+	Query_AccountsCount_FullMethodName         = "/cosmos.auth.v1beta1.Query/AccountsCount"
 )
 
 // QueryClient is the client API for Query service.
@@ -72,6 +75,19 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	AccountInfo(ctx context.Context, in *QueryAccountInfoRequest, opts ...grpc.CallOption) (*QueryAccountInfoResponse, error)
+	// AccountsByNumberRange returns the addresses of the accounts whose account
+	// number lies in the requested range, ordered by account number.
+	//
+	// Since: cosmos-sdk 0.51
+	AccountsByNumberRange(ctx context.Context, in *QueryAccountsByNumberRangeRequest, opts ...grpc.CallOption) (*QueryAccountsByNumberRangeResponse, error)
+	// PubKeyHistory queries the public keys an account rotated away from.
+	//
+	// Since: cosmos-sdk 0.51
+	PubKeyHistory(ctx context.Context, in *QueryPubKeyHistoryRequest, opts ...grpc.CallOption) (*QueryPubKeyHistoryResponse, error)
+	// AccountsCount returns the number of accounts stored in state.
+	//
+	// Since: cosmos-sdk 0.51
+	AccountsCount(ctx context.Context, in *QueryAccountsCountRequest, opts ...grpc.CallOption) (*QueryAccountsCountResponse, error)
 }
 
 type queryClient struct {
@@ -172,6 +188,33 @@ func (c *queryClient) AccountInfo(ctx context.Context, in *QueryAccountInfoReque
 	return out, nil
 }
 
+func (c *queryClient) AccountsByNumberRange(ctx context.Context, in *QueryAccountsByNumberRangeRequest, opts ...grpc.CallOption) (*QueryAccountsByNumberRangeResponse, error) {
+	out := new(QueryAccountsByNumberRangeResponse)
+	err := c.cc.Invoke(ctx, Query_AccountsByNumberRange_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PubKeyHistory(ctx context.Context, in *QueryPubKeyHistoryRequest, opts ...grpc.CallOption) (*QueryPubKeyHistoryResponse, error) {
+	out := new(QueryPubKeyHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_PubKeyHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountsCount(ctx context.Context, in *QueryAccountsCountRequest, opts ...grpc.CallOption) (*QueryAccountsCountResponse, error) {
+	out := new(QueryAccountsCountResponse)
+	err := c.cc.Invoke(ctx, Query_AccountsCount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -213,6 +256,19 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	AccountInfo(context.Context, *QueryAccountInfoRequest) (*QueryAccountInfoResponse, error)
+	// AccountsByNumberRange returns the addresses of the accounts whose account
+	// number lies in the requested range, ordered by account number.
+	//
+	// Since: cosmos-sdk 0.51
+	AccountsByNumberRange(context.Context, *QueryAccountsByNumberRangeRequest) (*QueryAccountsByNumberRangeResponse, error)
+	// PubKeyHistory queries the public keys an account rotated away from.
+	//
+	// Since: cosmos-sdk 0.51
+	PubKeyHistory(context.Context, *QueryPubKeyHistoryRequest) (*QueryPubKeyHistoryResponse, error)
+	// AccountsCount returns the number of accounts stored in state.
+	//
+	// Since: cosmos-sdk 0.51
+	AccountsCount(context.Context, *QueryAccountsCountRequest) (*QueryAccountsCountResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -250,6 +306,15 @@ func (UnimplementedQueryServer) AddressStringToBytes(context.Context, *AddressSt
 func (UnimplementedQueryServer) AccountInfo(context.Context, *QueryAccountInfoRequest) (*QueryAccountInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AccountInfo not implemented")
 }
+func (UnimplementedQueryServer) AccountsByNumberRange(context.Context, *QueryAccountsByNumberRangeRequest) (*QueryAccountsByNumberRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountsByNumberRange not implemented")
+}
+func (UnimplementedQueryServer) PubKeyHistory(context.Context, *QueryPubKeyHistoryRequest) (*QueryPubKeyHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PubKeyHistory not implemented")
+}
+func (UnimplementedQueryServer) AccountsCount(context.Context, *QueryAccountsCountRequest) (*QueryAccountsCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountsCount not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -443,6 +508,60 @@ func _Query_AccountInfo_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AccountsByNumberRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountsByNumberRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountsByNumberRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AccountsByNumberRange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountsByNumberRange(ctx, req.(*QueryAccountsByNumberRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PubKeyHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPubKeyHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PubKeyHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PubKeyHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PubKeyHistory(ctx, req.(*QueryPubKeyHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountsCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountsCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountsCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AccountsCount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountsCount(ctx, req.(*QueryAccountsCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -490,6 +609,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AccountInfo",
 			Handler:    _Query_AccountInfo_Handler,
 		},
+		{
+			MethodName: "AccountsByNumberRange",
+			Handler:    _Query_AccountsByNumberRange_Handler,
+		},
+		{
+			MethodName: "PubKeyHistory",
+			Handler:    _Query_PubKeyHistory_Handler,
+		},
+		{
+			MethodName: "AccountsCount",
+			Handler:    _Query_AccountsCount_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/auth/v1beta1/query.proto",
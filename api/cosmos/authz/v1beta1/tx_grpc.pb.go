@@ -25,6 +25,7 @@ const (
 	Msg_Exec_FullMethodName               = "/cosmos.authz.v1beta1.Msg/Exec"
 	Msg_Revoke_FullMethodName             = "/cosmos.authz.v1beta1.Msg/Revoke"
 	Msg_PruneExpiredGrants_FullMethodName = "/cosmos.authz.v1beta1.Msg/PruneExpiredGrants"
+	Msg_RevokeAll_FullMethodName          = "/cosmos.authz.v1beta1.Msg/RevokeAll"
 )
 
 // MsgClient is the client API for Msg service.
@@ -47,6 +48,9 @@ type MsgClient interface {
 	//
 	// Since cosmos-sdk 0.51
 	PruneExpiredGrants(ctx context.Context, in *MsgPruneExpiredGrants, opts ...grpc.CallOption) (*MsgPruneExpiredGrantsResponse, error)
+	// RevokeAll revokes all the authorizations issued by the granter, optionally
+	// only the ones for the provided method name.
+	RevokeAll(ctx context.Context, in *MsgRevokeAll, opts ...grpc.CallOption) (*MsgRevokeAllResponse, error)
 }
 
 type msgClient struct {
@@ -93,6 +97,15 @@ func (c *msgClient) PruneExpiredGrants(ctx context.Context, in *MsgPruneExpiredG
 	return out, nil
 }
 
+func (c *msgClient) RevokeAll(ctx context.Context, in *MsgRevokeAll, opts ...grpc.CallOption) (*MsgRevokeAllResponse, error) {
+	out := new(MsgRevokeAllResponse)
+	err := c.cc.Invoke(ctx, Msg_RevokeAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -113,6 +126,9 @@ type MsgServer interface {
 	//
 	// Since cosmos-sdk 0.51
 	PruneExpiredGrants(context.Context, *MsgPruneExpiredGrants) (*MsgPruneExpiredGrantsResponse, error)
+	// RevokeAll revokes all the authorizations issued by the granter, optionally
+	// only the ones for the provided method name.
+	RevokeAll(context.Context, *MsgRevokeAll) (*MsgRevokeAllResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -132,6 +148,9 @@ func (UnimplementedMsgServer) Revoke(context.Context, *MsgRevoke) (*MsgRevokeRes
 func (UnimplementedMsgServer) PruneExpiredGrants(context.Context, *MsgPruneExpiredGrants) (*MsgPruneExpiredGrantsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PruneExpiredGrants not implemented")
 }
+func (UnimplementedMsgServer) RevokeAll(context.Context, *MsgRevokeAll) (*MsgRevokeAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAll not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -217,6 +236,24 @@ func _Msg_PruneExpiredGrants_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RevokeAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeAll)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RevokeAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeAll(ctx, req.(*MsgRevokeAll))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -240,6 +277,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PruneExpiredGrants",
 			Handler:    _Msg_PruneExpiredGrants_Handler,
 		},
+		{
+			MethodName: "RevokeAll",
+			Handler:    _Msg_RevokeAll_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/authz/v1beta1/tx.proto",
@@ -29,9 +29,12 @@ const (
 	Query_DenomMetadata_FullMethodName              = "/cosmos.bank.v1beta1.Query/DenomMetadata"
 	Query_DenomMetadataByQueryString_FullMethodName = "/cosmos.bank.v1beta1.Query/DenomMetadataByQueryString"
 	Query_DenomsMetadata_FullMethodName             = "/cosmos.bank.v1beta1.Query/DenomsMetadata"
+	Query_DenomsMetadataByQuery_FullMethodName      = "/cosmos.bank.v1beta1.Query/DenomsMetadataByQuery"
 	Query_DenomOwners_FullMethodName                = "/cosmos.bank.v1beta1.Query/DenomOwners"
 	Query_DenomOwnersByQuery_FullMethodName         = "/cosmos.bank.v1beta1.Query/DenomOwnersByQuery"
 	Query_SendEnabled_FullMethodName                = "/cosmos.bank.v1beta1.Query/SendEnabled"
+	Query_Locks_FullMethodName                      = "/cosmos.bank.v1beta1.Query/Locks"
+	Query_AllBalancesStream_FullMethodName          = "/cosmos.bank.v1beta1.Query/AllBalancesStream"
 )
 
 // QueryClient is the client API for Query service.
@@ -80,6 +83,9 @@ type QueryClient interface {
 	// DenomsMetadata queries the client metadata for all registered coin
 	// denominations.
 	DenomsMetadata(ctx context.Context, in *QueryDenomsMetadataRequest, opts ...grpc.CallOption) (*QueryDenomsMetadataResponse, error)
+	// DenomsMetadataByQuery queries the client metadata of the registered coin
+	// denominations matching the given symbol and/or display denomination.
+	DenomsMetadataByQuery(ctx context.Context, in *QueryDenomsMetadataByQueryRequest, opts ...grpc.CallOption) (*QueryDenomsMetadataByQueryResponse, error)
 	// DenomOwners queries for all account addresses that own a particular token
 	// denomination.
 	//
@@ -101,6 +107,15 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	SendEnabled(ctx context.Context, in *QuerySendEnabledRequest, opts ...grpc.CallOption) (*QuerySendEnabledResponse, error)
+	// Locks queries the coins locked in the balance of an account.
+	Locks(ctx context.Context, in *QueryLocksRequest, opts ...grpc.CallOption) (*QueryLocksResponse, error)
+	// AllBalancesStream streams the balances of all accounts, or of a single
+	// account, ordered by address and then denom. Each response carries a cursor
+	// which can be sent back in a new request to resume the stream after it, so
+	// that indexers can snapshot large sets of balances without a query timeout.
+	//
+	// It is only served by the gRPC server and cannot be called from other modules.
+	AllBalancesStream(ctx context.Context, in *QueryAllBalancesStreamRequest, opts ...grpc.CallOption) (Query_AllBalancesStreamClient, error)
 }
 
 type queryClient struct {
@@ -201,6 +216,15 @@ func (c *queryClient) DenomsMetadata(ctx context.Context, in *QueryDenomsMetadat
 	return out, nil
 }
 
+func (c *queryClient) DenomsMetadataByQuery(ctx context.Context, in *QueryDenomsMetadataByQueryRequest, opts ...grpc.CallOption) (*QueryDenomsMetadataByQueryResponse, error) {
+	out := new(QueryDenomsMetadataByQueryResponse)
+	err := c.cc.Invoke(ctx, Query_DenomsMetadataByQuery_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) DenomOwners(ctx context.Context, in *QueryDenomOwnersRequest, opts ...grpc.CallOption) (*QueryDenomOwnersResponse, error) {
 	out := new(QueryDenomOwnersResponse)
 	err := c.cc.Invoke(ctx, Query_DenomOwners_FullMethodName, in, out, opts...)
@@ -228,6 +252,47 @@ func (c *queryClient) SendEnabled(ctx context.Context, in *QuerySendEnabledReque
 	return out, nil
 }
 
+func (c *queryClient) Locks(ctx context.Context, in *QueryLocksRequest, opts ...grpc.CallOption) (*QueryLocksResponse, error) {
+	out := new(QueryLocksResponse)
+	err := c.cc.Invoke(ctx, Query_Locks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllBalancesStream(ctx context.Context, in *QueryAllBalancesStreamRequest, opts ...grpc.CallOption) (Query_AllBalancesStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Query_ServiceDesc.Streams[0], Query_AllBalancesStream_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &queryAllBalancesStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Query_AllBalancesStreamClient interface {
+	Recv() (*QueryAllBalancesStreamResponse, error)
+	grpc.ClientStream
+}
+
+type queryAllBalancesStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *queryAllBalancesStreamClient) Recv() (*QueryAllBalancesStreamResponse, error) {
+	m := new(QueryAllBalancesStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -274,6 +339,9 @@ type QueryServer interface {
 	// DenomsMetadata queries the client metadata for all registered coin
 	// denominations.
 	DenomsMetadata(context.Context, *QueryDenomsMetadataRequest) (*QueryDenomsMetadataResponse, error)
+	// DenomsMetadataByQuery queries the client metadata of the registered coin
+	// denominations matching the given symbol and/or display denomination.
+	DenomsMetadataByQuery(context.Context, *QueryDenomsMetadataByQueryRequest) (*QueryDenomsMetadataByQueryResponse, error)
 	// DenomOwners queries for all account addresses that own a particular token
 	// denomination.
 	//
@@ -295,6 +363,15 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	SendEnabled(context.Context, *QuerySendEnabledRequest) (*QuerySendEnabledResponse, error)
+	// Locks queries the coins locked in the balance of an account.
+	Locks(context.Context, *QueryLocksRequest) (*QueryLocksResponse, error)
+	// AllBalancesStream streams the balances of all accounts, or of a single
+	// account, ordered by address and then denom. Each response carries a cursor
+	// which can be sent back in a new request to resume the stream after it, so
+	// that indexers can snapshot large sets of balances without a query timeout.
+	//
+	// It is only served by the gRPC server and cannot be called from other modules.
+	AllBalancesStream(*QueryAllBalancesStreamRequest, Query_AllBalancesStreamServer) error
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -332,6 +409,9 @@ func (UnimplementedQueryServer) DenomMetadataByQueryString(context.Context, *Que
 func (UnimplementedQueryServer) DenomsMetadata(context.Context, *QueryDenomsMetadataRequest) (*QueryDenomsMetadataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DenomsMetadata not implemented")
 }
+func (UnimplementedQueryServer) DenomsMetadataByQuery(context.Context, *QueryDenomsMetadataByQueryRequest) (*QueryDenomsMetadataByQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomsMetadataByQuery not implemented")
+}
 func (UnimplementedQueryServer) DenomOwners(context.Context, *QueryDenomOwnersRequest) (*QueryDenomOwnersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DenomOwners not implemented")
 }
@@ -341,6 +421,12 @@ func (UnimplementedQueryServer) DenomOwnersByQuery(context.Context, *QueryDenomO
 func (UnimplementedQueryServer) SendEnabled(context.Context, *QuerySendEnabledRequest) (*QuerySendEnabledResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendEnabled not implemented")
 }
+func (UnimplementedQueryServer) Locks(context.Context, *QueryLocksRequest) (*QueryLocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Locks not implemented")
+}
+func (UnimplementedQueryServer) AllBalancesStream(*QueryAllBalancesStreamRequest, Query_AllBalancesStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method AllBalancesStream not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -534,6 +620,24 @@ func _Query_DenomsMetadata_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DenomsMetadataByQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomsMetadataByQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomsMetadataByQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DenomsMetadataByQuery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomsMetadataByQuery(ctx, req.(*QueryDenomsMetadataByQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_DenomOwners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryDenomOwnersRequest)
 	if err := dec(in); err != nil {
@@ -588,6 +692,45 @@ func _Query_SendEnabled_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Locks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Locks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Locks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Locks(ctx, req.(*QueryLocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllBalancesStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(QueryAllBalancesStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueryServer).AllBalancesStream(m, &queryAllBalancesStreamServer{stream})
+}
+
+type Query_AllBalancesStreamServer interface {
+	Send(*QueryAllBalancesStreamResponse) error
+	grpc.ServerStream
+}
+
+type queryAllBalancesStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *queryAllBalancesStreamServer) Send(m *QueryAllBalancesStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -635,6 +778,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DenomsMetadata",
 			Handler:    _Query_DenomsMetadata_Handler,
 		},
+		{
+			MethodName: "DenomsMetadataByQuery",
+			Handler:    _Query_DenomsMetadataByQuery_Handler,
+		},
 		{
 			MethodName: "DenomOwners",
 			Handler:    _Query_DenomOwners_Handler,
@@ -647,7 +794,17 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendEnabled",
 			Handler:    _Query_SendEnabled_Handler,
 		},
+		{
+			MethodName: "Locks",
+			Handler:    _Query_Locks_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "AllBalancesStream",
+			Handler:       _Query_AllBalancesStream_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/bank/v1beta1/query.proto",
 }
@@ -19,11 +19,13 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_Send_FullMethodName           = "/cosmos.bank.v1beta1.Msg/Send"
-	Msg_MultiSend_FullMethodName      = "/cosmos.bank.v1beta1.Msg/MultiSend"
-	Msg_Burn_FullMethodName           = "/cosmos.bank.v1beta1.Msg/Burn"
-	Msg_UpdateParams_FullMethodName   = "/cosmos.bank.v1beta1.Msg/UpdateParams"
-	Msg_SetSendEnabled_FullMethodName = "/cosmos.bank.v1beta1.Msg/SetSendEnabled"
+	Msg_Send_FullMethodName                = "/cosmos.bank.v1beta1.Msg/Send"
+	Msg_MultiSend_FullMethodName           = "/cosmos.bank.v1beta1.Msg/MultiSend"
+	Msg_Burn_FullMethodName                = "/cosmos.bank.v1beta1.Msg/Burn"
+	Msg_UpdateParams_FullMethodName        = "/cosmos.bank.v1beta1.Msg/UpdateParams"
+	Msg_SetSendEnabled_FullMethodName      = "/cosmos.bank.v1beta1.Msg/SetSendEnabled"
+	Msg_SetDenomMetadata_FullMethodName    = "/cosmos.bank.v1beta1.Msg/SetDenomMetadata"
+	Msg_RemoveDenomMetadata_FullMethodName = "/cosmos.bank.v1beta1.Msg/RemoveDenomMetadata"
 )
 
 // MsgClient is the client API for Msg service.
@@ -50,6 +52,13 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	SetSendEnabled(ctx context.Context, in *MsgSetSendEnabled, opts ...grpc.CallOption) (*MsgSetSendEnabledResponse, error)
+	// SetDenomMetadata is a governance operation for setting the client metadata
+	// of a coin denomination, e.g. the display denomination of an IBC voucher.
+	// Existing metadata for the same base denom is replaced.
+	SetDenomMetadata(ctx context.Context, in *MsgSetDenomMetadata, opts ...grpc.CallOption) (*MsgSetDenomMetadataResponse, error)
+	// RemoveDenomMetadata is a governance operation for removing the client
+	// metadata of a coin denomination.
+	RemoveDenomMetadata(ctx context.Context, in *MsgRemoveDenomMetadata, opts ...grpc.CallOption) (*MsgRemoveDenomMetadataResponse, error)
 }
 
 type msgClient struct {
@@ -105,6 +114,24 @@ func (c *msgClient) SetSendEnabled(ctx context.Context, in *MsgSetSendEnabled, o
 	return out, nil
 }
 
+func (c *msgClient) SetDenomMetadata(ctx context.Context, in *MsgSetDenomMetadata, opts ...grpc.CallOption) (*MsgSetDenomMetadataResponse, error) {
+	out := new(MsgSetDenomMetadataResponse)
+	err := c.cc.Invoke(ctx, Msg_SetDenomMetadata_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveDenomMetadata(ctx context.Context, in *MsgRemoveDenomMetadata, opts ...grpc.CallOption) (*MsgRemoveDenomMetadataResponse, error) {
+	out := new(MsgRemoveDenomMetadataResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveDenomMetadata_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -129,6 +156,13 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	SetSendEnabled(context.Context, *MsgSetSendEnabled) (*MsgSetSendEnabledResponse, error)
+	// SetDenomMetadata is a governance operation for setting the client metadata
+	// of a coin denomination, e.g. the display denomination of an IBC voucher.
+	// Existing metadata for the same base denom is replaced.
+	SetDenomMetadata(context.Context, *MsgSetDenomMetadata) (*MsgSetDenomMetadataResponse, error)
+	// RemoveDenomMetadata is a governance operation for removing the client
+	// metadata of a coin denomination.
+	RemoveDenomMetadata(context.Context, *MsgRemoveDenomMetadata) (*MsgRemoveDenomMetadataResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -151,6 +185,12 @@ func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*
 func (UnimplementedMsgServer) SetSendEnabled(context.Context, *MsgSetSendEnabled) (*MsgSetSendEnabledResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetSendEnabled not implemented")
 }
+func (UnimplementedMsgServer) SetDenomMetadata(context.Context, *MsgSetDenomMetadata) (*MsgSetDenomMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDenomMetadata not implemented")
+}
+func (UnimplementedMsgServer) RemoveDenomMetadata(context.Context, *MsgRemoveDenomMetadata) (*MsgRemoveDenomMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveDenomMetadata not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -254,6 +294,42 @@ func _Msg_SetSendEnabled_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetDenomMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDenomMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDenomMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetDenomMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDenomMetadata(ctx, req.(*MsgSetDenomMetadata))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveDenomMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveDenomMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveDenomMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveDenomMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveDenomMetadata(ctx, req.(*MsgRemoveDenomMetadata))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -281,6 +357,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetSendEnabled",
 			Handler:    _Msg_SetSendEnabled_Handler,
 		},
+		{
+			MethodName: "SetDenomMetadata",
+			Handler:    _Msg_SetDenomMetadata_Handler,
+		},
+		{
+			MethodName: "RemoveDenomMetadata",
+			Handler:    _Msg_RemoveDenomMetadata_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/bank/v1beta1/tx.proto",
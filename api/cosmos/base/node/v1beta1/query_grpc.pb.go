@@ -19,8 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Service_Config_FullMethodName = "/cosmos.base.node.v1beta1.Service/Config"
-	Service_Status_FullMethodName = "/cosmos.base.node.v1beta1.Service/Status"
+	Service_Config_FullMethodName          = "/cosmos.base.node.v1beta1.Service/Config"
+	Service_Status_FullMethodName          = "/cosmos.base.node.v1beta1.Service/Status"
+	Service_ErrorCodes_FullMethodName      = "/cosmos.base.node.v1beta1.Service/ErrorCodes"
+	Service_CacheStats_FullMethodName      = "/cosmos.base.node.v1beta1.Service/CacheStats"
+	Service_SubscribeBlocks_FullMethodName = "/cosmos.base.node.v1beta1.Service/SubscribeBlocks"
+	Service_SubscribeTxs_FullMethodName    = "/cosmos.base.node.v1beta1.Service/SubscribeTxs"
 )
 
 // ServiceClient is the client API for Service service.
@@ -31,6 +35,18 @@ type ServiceClient interface {
 	Config(ctx context.Context, in *ConfigRequest, opts ...grpc.CallOption) (*ConfigResponse, error)
 	// Status queries for the node status.
 	Status(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+	// ErrorCodes queries for all the error codes registered by the application,
+	// e.g. for client SDK generation.
+	ErrorCodes(ctx context.Context, in *ErrorCodesRequest, opts ...grpc.CallOption) (*ErrorCodesResponse, error)
+	// CacheStats queries for the statistics of the inter-block caches of the
+	// stores, for debugging.
+	CacheStats(ctx context.Context, in *CacheStatsRequest, opts ...grpc.CallOption) (*CacheStatsResponse, error)
+	// SubscribeBlocks streams the results of the blocks committed by the node,
+	// starting with the next one.
+	SubscribeBlocks(ctx context.Context, in *SubscribeBlocksRequest, opts ...grpc.CallOption) (Service_SubscribeBlocksClient, error)
+	// SubscribeTxs streams the results of the transactions committed by the node,
+	// starting with the next block, whose events match the given query.
+	SubscribeTxs(ctx context.Context, in *SubscribeTxsRequest, opts ...grpc.CallOption) (Service_SubscribeTxsClient, error)
 }
 
 type serviceClient struct {
@@ -59,6 +75,88 @@ func (c *serviceClient) Status(ctx context.Context, in *StatusRequest, opts ...g
 	return out, nil
 }
 
+func (c *serviceClient) ErrorCodes(ctx context.Context, in *ErrorCodesRequest, opts ...grpc.CallOption) (*ErrorCodesResponse, error) {
+	out := new(ErrorCodesResponse)
+	err := c.cc.Invoke(ctx, Service_ErrorCodes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) CacheStats(ctx context.Context, in *CacheStatsRequest, opts ...grpc.CallOption) (*CacheStatsResponse, error) {
+	out := new(CacheStatsResponse)
+	err := c.cc.Invoke(ctx, Service_CacheStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) SubscribeBlocks(ctx context.Context, in *SubscribeBlocksRequest, opts ...grpc.CallOption) (Service_SubscribeBlocksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Service_ServiceDesc.Streams[0], Service_SubscribeBlocks_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &serviceSubscribeBlocksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Service_SubscribeBlocksClient interface {
+	Recv() (*SubscribeBlocksResponse, error)
+	grpc.ClientStream
+}
+
+type serviceSubscribeBlocksClient struct {
+	grpc.ClientStream
+}
+
+func (x *serviceSubscribeBlocksClient) Recv() (*SubscribeBlocksResponse, error) {
+	m := new(SubscribeBlocksResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *serviceClient) SubscribeTxs(ctx context.Context, in *SubscribeTxsRequest, opts ...grpc.CallOption) (Service_SubscribeTxsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Service_ServiceDesc.Streams[1], Service_SubscribeTxs_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &serviceSubscribeTxsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Service_SubscribeTxsClient interface {
+	Recv() (*SubscribeTxsResponse, error)
+	grpc.ClientStream
+}
+
+type serviceSubscribeTxsClient struct {
+	grpc.ClientStream
+}
+
+func (x *serviceSubscribeTxsClient) Recv() (*SubscribeTxsResponse, error) {
+	m := new(SubscribeTxsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // ServiceServer is the server API for Service service.
 // All implementations must embed UnimplementedServiceServer
 // for forward compatibility
@@ -67,6 +165,18 @@ type ServiceServer interface {
 	Config(context.Context, *ConfigRequest) (*ConfigResponse, error)
 	// Status queries for the node status.
 	Status(context.Context, *StatusRequest) (*StatusResponse, error)
+	// ErrorCodes queries for all the error codes registered by the application,
+	// e.g. for client SDK generation.
+	ErrorCodes(context.Context, *ErrorCodesRequest) (*ErrorCodesResponse, error)
+	// CacheStats queries for the statistics of the inter-block caches of the
+	// stores, for debugging.
+	CacheStats(context.Context, *CacheStatsRequest) (*CacheStatsResponse, error)
+	// SubscribeBlocks streams the results of the blocks committed by the node,
+	// starting with the next one.
+	SubscribeBlocks(*SubscribeBlocksRequest, Service_SubscribeBlocksServer) error
+	// SubscribeTxs streams the results of the transactions committed by the node,
+	// starting with the next block, whose events match the given query.
+	SubscribeTxs(*SubscribeTxsRequest, Service_SubscribeTxsServer) error
 	mustEmbedUnimplementedServiceServer()
 }
 
@@ -80,6 +190,18 @@ func (UnimplementedServiceServer) Config(context.Context, *ConfigRequest) (*Conf
 func (UnimplementedServiceServer) Status(context.Context, *StatusRequest) (*StatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Status not implemented")
 }
+func (UnimplementedServiceServer) ErrorCodes(context.Context, *ErrorCodesRequest) (*ErrorCodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ErrorCodes not implemented")
+}
+func (UnimplementedServiceServer) CacheStats(context.Context, *CacheStatsRequest) (*CacheStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CacheStats not implemented")
+}
+func (UnimplementedServiceServer) SubscribeBlocks(*SubscribeBlocksRequest, Service_SubscribeBlocksServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeBlocks not implemented")
+}
+func (UnimplementedServiceServer) SubscribeTxs(*SubscribeTxsRequest, Service_SubscribeTxsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeTxs not implemented")
+}
 func (UnimplementedServiceServer) mustEmbedUnimplementedServiceServer() {}
 
 // UnsafeServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -129,6 +251,84 @@ func _Service_Status_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_ErrorCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ErrorCodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).ErrorCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_ErrorCodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).ErrorCodes(ctx, req.(*ErrorCodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_CacheStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CacheStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).CacheStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_CacheStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).CacheStats(ctx, req.(*CacheStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_SubscribeBlocks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeBlocksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ServiceServer).SubscribeBlocks(m, &serviceSubscribeBlocksServer{stream})
+}
+
+type Service_SubscribeBlocksServer interface {
+	Send(*SubscribeBlocksResponse) error
+	grpc.ServerStream
+}
+
+type serviceSubscribeBlocksServer struct {
+	grpc.ServerStream
+}
+
+func (x *serviceSubscribeBlocksServer) Send(m *SubscribeBlocksResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Service_SubscribeTxs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeTxsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ServiceServer).SubscribeTxs(m, &serviceSubscribeTxsServer{stream})
+}
+
+type Service_SubscribeTxsServer interface {
+	Send(*SubscribeTxsResponse) error
+	grpc.ServerStream
+}
+
+type serviceSubscribeTxsServer struct {
+	grpc.ServerStream
+}
+
+func (x *serviceSubscribeTxsServer) Send(m *SubscribeTxsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // Service_ServiceDesc is the grpc.ServiceDesc for Service service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -144,7 +344,26 @@ var Service_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Status",
 			Handler:    _Service_Status_Handler,
 		},
+		{
+			MethodName: "ErrorCodes",
+			Handler:    _Service_ErrorCodes_Handler,
+		},
+		{
+			MethodName: "CacheStats",
+			Handler:    _Service_CacheStats_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeBlocks",
+			Handler:       _Service_SubscribeBlocks_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeTxs",
+			Handler:       _Service_SubscribeTxs_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/base/node/v1beta1/query.proto",
 }
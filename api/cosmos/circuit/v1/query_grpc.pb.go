@@ -19,9 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Account_FullMethodName      = "/cosmos.circuit.v1.Query/Account"
-	Query_Accounts_FullMethodName     = "/cosmos.circuit.v1.Query/Accounts"
-	Query_DisabledList_FullMethodName = "/cosmos.circuit.v1.Query/DisabledList"
+	Query_Account_FullMethodName       = "/cosmos.circuit.v1.Query/Account"
+	Query_Accounts_FullMethodName      = "/cosmos.circuit.v1.Query/Accounts"
+	Query_DisabledList_FullMethodName  = "/cosmos.circuit.v1.Query/DisabledList"
+	Query_TripCommittee_FullMethodName = "/cosmos.circuit.v1.Query/TripCommittee"
+	Query_TripVotes_FullMethodName     = "/cosmos.circuit.v1.Query/TripVotes"
 )
 
 // QueryClient is the client API for Query service.
@@ -34,6 +36,11 @@ type QueryClient interface {
 	Accounts(ctx context.Context, in *QueryAccountsRequest, opts ...grpc.CallOption) (*AccountsResponse, error)
 	// DisabledList returns a list of disabled message urls
 	DisabledList(ctx context.Context, in *QueryDisabledListRequest, opts ...grpc.CallOption) (*DisabledListResponse, error)
+	// TripCommittee returns the members of the trip committee.
+	TripCommittee(ctx context.Context, in *QueryTripCommitteeRequest, opts ...grpc.CallOption) (*TripCommitteeResponse, error)
+	// TripVotes returns the trip committee members that voted to trip the
+	// circuit breaker for a Msg type URL.
+	TripVotes(ctx context.Context, in *QueryTripVotesRequest, opts ...grpc.CallOption) (*TripVotesResponse, error)
 }
 
 type queryClient struct {
@@ -71,6 +78,24 @@ func (c *queryClient) DisabledList(ctx context.Context, in *QueryDisabledListReq
 	return out, nil
 }
 
+func (c *queryClient) TripCommittee(ctx context.Context, in *QueryTripCommitteeRequest, opts ...grpc.CallOption) (*TripCommitteeResponse, error) {
+	out := new(TripCommitteeResponse)
+	err := c.cc.Invoke(ctx, Query_TripCommittee_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TripVotes(ctx context.Context, in *QueryTripVotesRequest, opts ...grpc.CallOption) (*TripVotesResponse, error) {
+	out := new(TripVotesResponse)
+	err := c.cc.Invoke(ctx, Query_TripVotes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -81,6 +106,11 @@ type QueryServer interface {
 	Accounts(context.Context, *QueryAccountsRequest) (*AccountsResponse, error)
 	// DisabledList returns a list of disabled message urls
 	DisabledList(context.Context, *QueryDisabledListRequest) (*DisabledListResponse, error)
+	// TripCommittee returns the members of the trip committee.
+	TripCommittee(context.Context, *QueryTripCommitteeRequest) (*TripCommitteeResponse, error)
+	// TripVotes returns the trip committee members that voted to trip the
+	// circuit breaker for a Msg type URL.
+	TripVotes(context.Context, *QueryTripVotesRequest) (*TripVotesResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -97,6 +127,12 @@ func (UnimplementedQueryServer) Accounts(context.Context, *QueryAccountsRequest)
 func (UnimplementedQueryServer) DisabledList(context.Context, *QueryDisabledListRequest) (*DisabledListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DisabledList not implemented")
 }
+func (UnimplementedQueryServer) TripCommittee(context.Context, *QueryTripCommitteeRequest) (*TripCommitteeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TripCommittee not implemented")
+}
+func (UnimplementedQueryServer) TripVotes(context.Context, *QueryTripVotesRequest) (*TripVotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TripVotes not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -164,6 +200,42 @@ func _Query_DisabledList_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TripCommittee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTripCommitteeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TripCommittee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TripCommittee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TripCommittee(ctx, req.(*QueryTripCommitteeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TripVotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTripVotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TripVotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TripVotes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TripVotes(ctx, req.(*QueryTripVotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -183,6 +255,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DisabledList",
 			Handler:    _Query_DisabledList_Handler,
 		},
+		{
+			MethodName: "TripCommittee",
+			Handler:    _Query_TripCommittee_Handler,
+		},
+		{
+			MethodName: "TripVotes",
+			Handler:    _Query_TripVotes_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/circuit/v1/query.proto",
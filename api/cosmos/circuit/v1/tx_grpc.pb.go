@@ -22,6 +22,8 @@ const (
 	Msg_AuthorizeCircuitBreaker_FullMethodName = "/cosmos.circuit.v1.Msg/AuthorizeCircuitBreaker"
 	Msg_TripCircuitBreaker_FullMethodName      = "/cosmos.circuit.v1.Msg/TripCircuitBreaker"
 	Msg_ResetCircuitBreaker_FullMethodName     = "/cosmos.circuit.v1.Msg/ResetCircuitBreaker"
+	Msg_SetTripCommittee_FullMethodName        = "/cosmos.circuit.v1.Msg/SetTripCommittee"
+	Msg_VoteTripCircuitBreaker_FullMethodName  = "/cosmos.circuit.v1.Msg/VoteTripCircuitBreaker"
 )
 
 // MsgClient is the client API for Msg service.
@@ -36,6 +38,14 @@ type MsgClient interface {
 	// ResetCircuitBreaker resumes processing of Msg's in the state machine that
 	// have been been paused using TripCircuitBreaker.
 	ResetCircuitBreaker(ctx context.Context, in *MsgResetCircuitBreaker, opts ...grpc.CallOption) (*MsgResetCircuitBreakerResponse, error)
+	// SetTripCommittee allows the module authority or a super-admin to set the
+	// permissioned set of accounts whose supermajority can trip the circuit
+	// breaker.
+	SetTripCommittee(ctx context.Context, in *MsgSetTripCommittee, opts ...grpc.CallOption) (*MsgSetTripCommitteeResponse, error)
+	// VoteTripCircuitBreaker records a trip committee member's vote to pause
+	// processing of Msg's. The circuit breaker is tripped for a Msg type URL once
+	// more than two thirds of the trip committee voted for it.
+	VoteTripCircuitBreaker(ctx context.Context, in *MsgVoteTripCircuitBreaker, opts ...grpc.CallOption) (*MsgVoteTripCircuitBreakerResponse, error)
 }
 
 type msgClient struct {
@@ -73,6 +83,24 @@ func (c *msgClient) ResetCircuitBreaker(ctx context.Context, in *MsgResetCircuit
 	return out, nil
 }
 
+func (c *msgClient) SetTripCommittee(ctx context.Context, in *MsgSetTripCommittee, opts ...grpc.CallOption) (*MsgSetTripCommitteeResponse, error) {
+	out := new(MsgSetTripCommitteeResponse)
+	err := c.cc.Invoke(ctx, Msg_SetTripCommittee_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) VoteTripCircuitBreaker(ctx context.Context, in *MsgVoteTripCircuitBreaker, opts ...grpc.CallOption) (*MsgVoteTripCircuitBreakerResponse, error) {
+	out := new(MsgVoteTripCircuitBreakerResponse)
+	err := c.cc.Invoke(ctx, Msg_VoteTripCircuitBreaker_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -85,6 +113,14 @@ type MsgServer interface {
 	// ResetCircuitBreaker resumes processing of Msg's in the state machine that
 	// have been been paused using TripCircuitBreaker.
 	ResetCircuitBreaker(context.Context, *MsgResetCircuitBreaker) (*MsgResetCircuitBreakerResponse, error)
+	// SetTripCommittee allows the module authority or a super-admin to set the
+	// permissioned set of accounts whose supermajority can trip the circuit
+	// breaker.
+	SetTripCommittee(context.Context, *MsgSetTripCommittee) (*MsgSetTripCommitteeResponse, error)
+	// VoteTripCircuitBreaker records a trip committee member's vote to pause
+	// processing of Msg's. The circuit breaker is tripped for a Msg type URL once
+	// more than two thirds of the trip committee voted for it.
+	VoteTripCircuitBreaker(context.Context, *MsgVoteTripCircuitBreaker) (*MsgVoteTripCircuitBreakerResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -101,6 +137,12 @@ func (UnimplementedMsgServer) TripCircuitBreaker(context.Context, *MsgTripCircui
 func (UnimplementedMsgServer) ResetCircuitBreaker(context.Context, *MsgResetCircuitBreaker) (*MsgResetCircuitBreakerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResetCircuitBreaker not implemented")
 }
+func (UnimplementedMsgServer) SetTripCommittee(context.Context, *MsgSetTripCommittee) (*MsgSetTripCommitteeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTripCommittee not implemented")
+}
+func (UnimplementedMsgServer) VoteTripCircuitBreaker(context.Context, *MsgVoteTripCircuitBreaker) (*MsgVoteTripCircuitBreakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteTripCircuitBreaker not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -168,6 +210,42 @@ func _Msg_ResetCircuitBreaker_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetTripCommittee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetTripCommittee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetTripCommittee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetTripCommittee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetTripCommittee(ctx, req.(*MsgSetTripCommittee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_VoteTripCircuitBreaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVoteTripCircuitBreaker)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VoteTripCircuitBreaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_VoteTripCircuitBreaker_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VoteTripCircuitBreaker(ctx, req.(*MsgVoteTripCircuitBreaker))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -187,6 +265,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ResetCircuitBreaker",
 			Handler:    _Msg_ResetCircuitBreaker_Handler,
 		},
+		{
+			MethodName: "SetTripCommittee",
+			Handler:    _Msg_SetTripCommittee_Handler,
+		},
+		{
+			MethodName: "VoteTripCircuitBreaker",
+			Handler:    _Msg_VoteTripCircuitBreaker_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/circuit/v1/tx.proto",
@@ -19,16 +19,19 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName                      = "/cosmos.distribution.v1beta1.Query/Params"
-	Query_ValidatorDistributionInfo_FullMethodName   = "/cosmos.distribution.v1beta1.Query/ValidatorDistributionInfo"
-	Query_ValidatorOutstandingRewards_FullMethodName = "/cosmos.distribution.v1beta1.Query/ValidatorOutstandingRewards"
-	Query_ValidatorCommission_FullMethodName         = "/cosmos.distribution.v1beta1.Query/ValidatorCommission"
-	Query_ValidatorSlashes_FullMethodName            = "/cosmos.distribution.v1beta1.Query/ValidatorSlashes"
-	Query_DelegationRewards_FullMethodName           = "/cosmos.distribution.v1beta1.Query/DelegationRewards"
-	Query_DelegationTotalRewards_FullMethodName      = "/cosmos.distribution.v1beta1.Query/DelegationTotalRewards"
-	Query_DelegatorValidators_FullMethodName         = "/cosmos.distribution.v1beta1.Query/DelegatorValidators"
-	Query_DelegatorWithdrawAddress_FullMethodName    = "/cosmos.distribution.v1beta1.Query/DelegatorWithdrawAddress"
-	Query_CommunityPool_FullMethodName               = "/cosmos.distribution.v1beta1.Query/CommunityPool"
+	Query_Params_FullMethodName                         = "/cosmos.distribution.v1beta1.Query/Params"
+	Query_ValidatorDistributionInfo_FullMethodName      = "/cosmos.distribution.v1beta1.Query/ValidatorDistributionInfo"
+	Query_ValidatorOutstandingRewards_FullMethodName    = "/cosmos.distribution.v1beta1.Query/ValidatorOutstandingRewards"
+	Query_ValidatorCommission_FullMethodName            = "/cosmos.distribution.v1beta1.Query/ValidatorCommission"
+	Query_ValidatorSlashes_FullMethodName               = "/cosmos.distribution.v1beta1.Query/ValidatorSlashes"
+	Query_DelegationRewards_FullMethodName              = "/cosmos.distribution.v1beta1.Query/DelegationRewards"
+	Query_DelegationTotalRewards_FullMethodName         = "/cosmos.distribution.v1beta1.Query/DelegationTotalRewards"
+	Query_DelegationRewardsByDenom_FullMethodName       = "/cosmos.distribution.v1beta1.Query/DelegationRewardsByDenom"
+	Query_DelegatorValidators_FullMethodName            = "/cosmos.distribution.v1beta1.Query/DelegatorValidators"
+	Query_DelegatorWithdrawAddress_FullMethodName       = "/cosmos.distribution.v1beta1.Query/DelegatorWithdrawAddress"
+	Query_DelegatorAutoCompounds_FullMethodName         = "/cosmos.distribution.v1beta1.Query/DelegatorAutoCompounds"
+	Query_DelegatorWithdrawAddressPolicy_FullMethodName = "/cosmos.distribution.v1beta1.Query/DelegatorWithdrawAddressPolicy"
+	Query_CommunityPool_FullMethodName                  = "/cosmos.distribution.v1beta1.Query/CommunityPool"
 )
 
 // QueryClient is the client API for Query service.
@@ -50,10 +53,25 @@ type QueryClient interface {
 	// DelegationTotalRewards queries the total rewards accrued by each
 	// validator.
 	DelegationTotalRewards(ctx context.Context, in *QueryDelegationTotalRewardsRequest, opts ...grpc.CallOption) (*QueryDelegationTotalRewardsResponse, error)
+	// DelegationRewardsByDenom queries the rewards of a single denom accrued by
+	// each delegation of a delegator.
+	//
+	// Since: cosmos-sdk 0.51
+	DelegationRewardsByDenom(ctx context.Context, in *QueryDelegationRewardsByDenomRequest, opts ...grpc.CallOption) (*QueryDelegationRewardsByDenomResponse, error)
 	// DelegatorValidators queries the validators of a delegator.
 	DelegatorValidators(ctx context.Context, in *QueryDelegatorValidatorsRequest, opts ...grpc.CallOption) (*QueryDelegatorValidatorsResponse, error)
 	// DelegatorWithdrawAddress queries withdraw address of a delegator.
 	DelegatorWithdrawAddress(ctx context.Context, in *QueryDelegatorWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryDelegatorWithdrawAddressResponse, error)
+	// DelegatorAutoCompounds queries the delegations of a delegator whose rewards
+	// are auto-compounded.
+	//
+	// Since: cosmos-sdk 0.51
+	DelegatorAutoCompounds(ctx context.Context, in *QueryDelegatorAutoCompoundsRequest, opts ...grpc.CallOption) (*QueryDelegatorAutoCompoundsResponse, error)
+	// DelegatorWithdrawAddressPolicy queries the withdraw address policy of a
+	// delegator.
+	//
+	// Since: cosmos-sdk 0.51
+	DelegatorWithdrawAddressPolicy(ctx context.Context, in *QueryDelegatorWithdrawAddressPolicyRequest, opts ...grpc.CallOption) (*QueryDelegatorWithdrawAddressPolicyResponse, error)
 	// Deprecated: Do not use.
 	// CommunityPool queries the community pool coins.
 	//
@@ -133,6 +151,15 @@ func (c *queryClient) DelegationTotalRewards(ctx context.Context, in *QueryDeleg
 	return out, nil
 }
 
+func (c *queryClient) DelegationRewardsByDenom(ctx context.Context, in *QueryDelegationRewardsByDenomRequest, opts ...grpc.CallOption) (*QueryDelegationRewardsByDenomResponse, error) {
+	out := new(QueryDelegationRewardsByDenomResponse)
+	err := c.cc.Invoke(ctx, Query_DelegationRewardsByDenom_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) DelegatorValidators(ctx context.Context, in *QueryDelegatorValidatorsRequest, opts ...grpc.CallOption) (*QueryDelegatorValidatorsResponse, error) {
 	out := new(QueryDelegatorValidatorsResponse)
 	err := c.cc.Invoke(ctx, Query_DelegatorValidators_FullMethodName, in, out, opts...)
@@ -151,6 +178,24 @@ func (c *queryClient) DelegatorWithdrawAddress(ctx context.Context, in *QueryDel
 	return out, nil
 }
 
+func (c *queryClient) DelegatorAutoCompounds(ctx context.Context, in *QueryDelegatorAutoCompoundsRequest, opts ...grpc.CallOption) (*QueryDelegatorAutoCompoundsResponse, error) {
+	out := new(QueryDelegatorAutoCompoundsResponse)
+	err := c.cc.Invoke(ctx, Query_DelegatorAutoCompounds_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegatorWithdrawAddressPolicy(ctx context.Context, in *QueryDelegatorWithdrawAddressPolicyRequest, opts ...grpc.CallOption) (*QueryDelegatorWithdrawAddressPolicyResponse, error) {
+	out := new(QueryDelegatorWithdrawAddressPolicyResponse)
+	err := c.cc.Invoke(ctx, Query_DelegatorWithdrawAddressPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Deprecated: Do not use.
 func (c *queryClient) CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error) {
 	out := new(QueryCommunityPoolResponse)
@@ -180,10 +225,25 @@ type QueryServer interface {
 	// DelegationTotalRewards queries the total rewards accrued by each
 	// validator.
 	DelegationTotalRewards(context.Context, *QueryDelegationTotalRewardsRequest) (*QueryDelegationTotalRewardsResponse, error)
+	// DelegationRewardsByDenom queries the rewards of a single denom accrued by
+	// each delegation of a delegator.
+	//
+	// Since: cosmos-sdk 0.51
+	DelegationRewardsByDenom(context.Context, *QueryDelegationRewardsByDenomRequest) (*QueryDelegationRewardsByDenomResponse, error)
 	// DelegatorValidators queries the validators of a delegator.
 	DelegatorValidators(context.Context, *QueryDelegatorValidatorsRequest) (*QueryDelegatorValidatorsResponse, error)
 	// DelegatorWithdrawAddress queries withdraw address of a delegator.
 	DelegatorWithdrawAddress(context.Context, *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error)
+	// DelegatorAutoCompounds queries the delegations of a delegator whose rewards
+	// are auto-compounded.
+	//
+	// Since: cosmos-sdk 0.51
+	DelegatorAutoCompounds(context.Context, *QueryDelegatorAutoCompoundsRequest) (*QueryDelegatorAutoCompoundsResponse, error)
+	// DelegatorWithdrawAddressPolicy queries the withdraw address policy of a
+	// delegator.
+	//
+	// Since: cosmos-sdk 0.51
+	DelegatorWithdrawAddressPolicy(context.Context, *QueryDelegatorWithdrawAddressPolicyRequest) (*QueryDelegatorWithdrawAddressPolicyResponse, error)
 	// Deprecated: Do not use.
 	// CommunityPool queries the community pool coins.
 	//
@@ -218,12 +278,21 @@ func (UnimplementedQueryServer) DelegationRewards(context.Context, *QueryDelegat
 func (UnimplementedQueryServer) DelegationTotalRewards(context.Context, *QueryDelegationTotalRewardsRequest) (*QueryDelegationTotalRewardsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegationTotalRewards not implemented")
 }
+func (UnimplementedQueryServer) DelegationRewardsByDenom(context.Context, *QueryDelegationRewardsByDenomRequest) (*QueryDelegationRewardsByDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegationRewardsByDenom not implemented")
+}
 func (UnimplementedQueryServer) DelegatorValidators(context.Context, *QueryDelegatorValidatorsRequest) (*QueryDelegatorValidatorsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegatorValidators not implemented")
 }
 func (UnimplementedQueryServer) DelegatorWithdrawAddress(context.Context, *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegatorWithdrawAddress not implemented")
 }
+func (UnimplementedQueryServer) DelegatorAutoCompounds(context.Context, *QueryDelegatorAutoCompoundsRequest) (*QueryDelegatorAutoCompoundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorAutoCompounds not implemented")
+}
+func (UnimplementedQueryServer) DelegatorWithdrawAddressPolicy(context.Context, *QueryDelegatorWithdrawAddressPolicyRequest) (*QueryDelegatorWithdrawAddressPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorWithdrawAddressPolicy not implemented")
+}
 func (UnimplementedQueryServer) CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CommunityPool not implemented")
 }
@@ -366,6 +435,24 @@ func _Query_DelegationTotalRewards_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DelegationRewardsByDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegationRewardsByDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegationRewardsByDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DelegationRewardsByDenom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegationRewardsByDenom(ctx, req.(*QueryDelegationRewardsByDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_DelegatorValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryDelegatorValidatorsRequest)
 	if err := dec(in); err != nil {
@@ -402,6 +489,42 @@ func _Query_DelegatorWithdrawAddress_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DelegatorAutoCompounds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorAutoCompoundsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorAutoCompounds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DelegatorAutoCompounds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorAutoCompounds(ctx, req.(*QueryDelegatorAutoCompoundsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegatorWithdrawAddressPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorWithdrawAddressPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorWithdrawAddressPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DelegatorWithdrawAddressPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorWithdrawAddressPolicy(ctx, req.(*QueryDelegatorWithdrawAddressPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_CommunityPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryCommunityPoolRequest)
 	if err := dec(in); err != nil {
@@ -455,6 +578,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DelegationTotalRewards",
 			Handler:    _Query_DelegationTotalRewards_Handler,
 		},
+		{
+			MethodName: "DelegationRewardsByDenom",
+			Handler:    _Query_DelegationRewardsByDenom_Handler,
+		},
 		{
 			MethodName: "DelegatorValidators",
 			Handler:    _Query_DelegatorValidators_Handler,
@@ -463,6 +590,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DelegatorWithdrawAddress",
 			Handler:    _Query_DelegatorWithdrawAddress_Handler,
 		},
+		{
+			MethodName: "DelegatorAutoCompounds",
+			Handler:    _Query_DelegatorAutoCompounds_Handler,
+		},
+		{
+			MethodName: "DelegatorWithdrawAddressPolicy",
+			Handler:    _Query_DelegatorWithdrawAddressPolicy_Handler,
+		},
 		{
 			MethodName: "CommunityPool",
 			Handler:    _Query_CommunityPool_Handler,
@@ -16,12 +16,14 @@ import (
 var (
 	md_EventEpochEnd              protoreflect.MessageDescriptor
 	fd_EventEpochEnd_epoch_number protoreflect.FieldDescriptor
+	fd_EventEpochEnd_identifier   protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_epochs_v1beta1_events_proto_init()
 	md_EventEpochEnd = File_cosmos_epochs_v1beta1_events_proto.Messages().ByName("EventEpochEnd")
 	fd_EventEpochEnd_epoch_number = md_EventEpochEnd.Fields().ByName("epoch_number")
+	fd_EventEpochEnd_identifier = md_EventEpochEnd.Fields().ByName("identifier")
 }
 
 var _ protoreflect.Message = (*fastReflection_EventEpochEnd)(nil)
@@ -95,6 +97,12 @@ func (x *fastReflection_EventEpochEnd) Range(f func(protoreflect.FieldDescriptor
 			return
 		}
 	}
+	if x.Identifier != "" {
+		value := protoreflect.ValueOfString(x.Identifier)
+		if !f(fd_EventEpochEnd_identifier, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -112,6 +120,8 @@ func (x *fastReflection_EventEpochEnd) Has(fd protoreflect.FieldDescriptor) bool
 	switch fd.FullName() {
 	case "cosmos.epochs.v1beta1.EventEpochEnd.epoch_number":
 		return x.EpochNumber != int64(0)
+	case "cosmos.epochs.v1beta1.EventEpochEnd.identifier":
+		return x.Identifier != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.epochs.v1beta1.EventEpochEnd"))
@@ -130,6 +140,8 @@ func (x *fastReflection_EventEpochEnd) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	case "cosmos.epochs.v1beta1.EventEpochEnd.epoch_number":
 		x.EpochNumber = int64(0)
+	case "cosmos.epochs.v1beta1.EventEpochEnd.identifier":
+		x.Identifier = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.epochs.v1beta1.EventEpochEnd"))
@@ -149,6 +161,9 @@ func (x *fastReflection_EventEpochEnd) Get(descriptor protoreflect.FieldDescript
 	case "cosmos.epochs.v1beta1.EventEpochEnd.epoch_number":
 		value := x.EpochNumber
 		return protoreflect.ValueOfInt64(value)
+	case "cosmos.epochs.v1beta1.EventEpochEnd.identifier":
+		value := x.Identifier
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.epochs.v1beta1.EventEpochEnd"))
@@ -171,6 +186,8 @@ func (x *fastReflection_EventEpochEnd) Set(fd protoreflect.FieldDescriptor, valu
 	switch fd.FullName() {
 	case "cosmos.epochs.v1beta1.EventEpochEnd.epoch_number":
 		x.EpochNumber = value.Int()
+	case "cosmos.epochs.v1beta1.EventEpochEnd.identifier":
+		x.Identifier = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.epochs.v1beta1.EventEpochEnd"))
@@ -193,6 +210,8 @@ func (x *fastReflection_EventEpochEnd) Mutable(fd protoreflect.FieldDescriptor)
 	switch fd.FullName() {
 	case "cosmos.epochs.v1beta1.EventEpochEnd.epoch_number":
 		panic(fmt.Errorf("field epoch_number of message cosmos.epochs.v1beta1.EventEpochEnd is not mutable"))
+	case "cosmos.epochs.v1beta1.EventEpochEnd.identifier":
+		panic(fmt.Errorf("field identifier of message cosmos.epochs.v1beta1.EventEpochEnd is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.epochs.v1beta1.EventEpochEnd"))
@@ -208,6 +227,8 @@ func (x *fastReflection_EventEpochEnd) NewField(fd protoreflect.FieldDescriptor)
 	switch fd.FullName() {
 	case "cosmos.epochs.v1beta1.EventEpochEnd.epoch_number":
 		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.epochs.v1beta1.EventEpochEnd.identifier":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.epochs.v1beta1.EventEpochEnd"))
@@ -280,6 +301,10 @@ func (x *fastReflection_EventEpochEnd) ProtoMethods() *protoiface.Methods {
 		if x.EpochNumber != 0 {
 			n += 1 + runtime.Sov(uint64(x.EpochNumber))
 		}
+		l = len(x.Identifier)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -309,6 +334,13 @@ func (x *fastReflection_EventEpochEnd) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Identifier) > 0 {
+			i -= len(x.Identifier)
+			copy(dAtA[i:], x.Identifier)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Identifier)))
+			i--
+			dAtA[i] = 0x12
+		}
 		if x.EpochNumber != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.EpochNumber))
 			i--
@@ -382,6 +414,38 @@ func (x *fastReflection_EventEpochEnd) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Identifier = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -421,6 +485,7 @@ var (
 	md_EventEpochStart                  protoreflect.MessageDescriptor
 	fd_EventEpochStart_epoch_number     protoreflect.FieldDescriptor
 	fd_EventEpochStart_epoch_start_time protoreflect.FieldDescriptor
+	fd_EventEpochStart_identifier       protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -428,6 +493,7 @@ func init() {
 	md_EventEpochStart = File_cosmos_epochs_v1beta1_events_proto.Messages().ByName("EventEpochStart")
 	fd_EventEpochStart_epoch_number = md_EventEpochStart.Fields().ByName("epoch_number")
 	fd_EventEpochStart_epoch_start_time = md_EventEpochStart.Fields().ByName("epoch_start_time")
+	fd_EventEpochStart_identifier = md_EventEpochStart.Fields().ByName("identifier")
 }
 
 var _ protoreflect.Message = (*fastReflection_EventEpochStart)(nil)
@@ -507,6 +573,12 @@ func (x *fastReflection_EventEpochStart) Range(f func(protoreflect.FieldDescript
 			return
 		}
 	}
+	if x.Identifier != "" {
+		value := protoreflect.ValueOfString(x.Identifier)
+		if !f(fd_EventEpochStart_identifier, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -526,6 +598,8 @@ func (x *fastReflection_EventEpochStart) Has(fd protoreflect.FieldDescriptor) bo
 		return x.EpochNumber != int64(0)
 	case "cosmos.epochs.v1beta1.EventEpochStart.epoch_start_time":
 		return x.EpochStartTime != int64(0)
+	case "cosmos.epochs.v1beta1.EventEpochStart.identifier":
+		return x.Identifier != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.epochs.v1beta1.EventEpochStart"))
@@ -546,6 +620,8 @@ func (x *fastReflection_EventEpochStart) Clear(fd protoreflect.FieldDescriptor)
 		x.EpochNumber = int64(0)
 	case "cosmos.epochs.v1beta1.EventEpochStart.epoch_start_time":
 		x.EpochStartTime = int64(0)
+	case "cosmos.epochs.v1beta1.EventEpochStart.identifier":
+		x.Identifier = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.epochs.v1beta1.EventEpochStart"))
@@ -568,6 +644,9 @@ func (x *fastReflection_EventEpochStart) Get(descriptor protoreflect.FieldDescri
 	case "cosmos.epochs.v1beta1.EventEpochStart.epoch_start_time":
 		value := x.EpochStartTime
 		return protoreflect.ValueOfInt64(value)
+	case "cosmos.epochs.v1beta1.EventEpochStart.identifier":
+		value := x.Identifier
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.epochs.v1beta1.EventEpochStart"))
@@ -592,6 +671,8 @@ func (x *fastReflection_EventEpochStart) Set(fd protoreflect.FieldDescriptor, va
 		x.EpochNumber = value.Int()
 	case "cosmos.epochs.v1beta1.EventEpochStart.epoch_start_time":
 		x.EpochStartTime = value.Int()
+	case "cosmos.epochs.v1beta1.EventEpochStart.identifier":
+		x.Identifier = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.epochs.v1beta1.EventEpochStart"))
@@ -616,6 +697,8 @@ func (x *fastReflection_EventEpochStart) Mutable(fd protoreflect.FieldDescriptor
 		panic(fmt.Errorf("field epoch_number of message cosmos.epochs.v1beta1.EventEpochStart is not mutable"))
 	case "cosmos.epochs.v1beta1.EventEpochStart.epoch_start_time":
 		panic(fmt.Errorf("field epoch_start_time of message cosmos.epochs.v1beta1.EventEpochStart is not mutable"))
+	case "cosmos.epochs.v1beta1.EventEpochStart.identifier":
+		panic(fmt.Errorf("field identifier of message cosmos.epochs.v1beta1.EventEpochStart is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.epochs.v1beta1.EventEpochStart"))
@@ -633,6 +716,8 @@ func (x *fastReflection_EventEpochStart) NewField(fd protoreflect.FieldDescripto
 		return protoreflect.ValueOfInt64(int64(0))
 	case "cosmos.epochs.v1beta1.EventEpochStart.epoch_start_time":
 		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.epochs.v1beta1.EventEpochStart.identifier":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.epochs.v1beta1.EventEpochStart"))
@@ -708,6 +793,10 @@ func (x *fastReflection_EventEpochStart) ProtoMethods() *protoiface.Methods {
 		if x.EpochStartTime != 0 {
 			n += 1 + runtime.Sov(uint64(x.EpochStartTime))
 		}
+		l = len(x.Identifier)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -737,6 +826,13 @@ func (x *fastReflection_EventEpochStart) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Identifier) > 0 {
+			i -= len(x.Identifier)
+			copy(dAtA[i:], x.Identifier)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Identifier)))
+			i--
+			dAtA[i] = 0x1a
+		}
 		if x.EpochStartTime != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.EpochStartTime))
 			i--
@@ -834,6 +930,38 @@ func (x *fastReflection_EventEpochStart) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Identifier = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -891,6 +1019,8 @@ type EventEpochEnd struct {
 	unknownFields protoimpl.UnknownFields
 
 	EpochNumber int64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// identifier is the identifier of the epoch that ended.
+	Identifier string `protobuf:"bytes,2,opt,name=identifier,proto3" json:"identifier,omitempty"`
 }
 
 func (x *EventEpochEnd) Reset() {
@@ -920,6 +1050,13 @@ func (x *EventEpochEnd) GetEpochNumber() int64 {
 	return 0
 }
 
+func (x *EventEpochEnd) GetIdentifier() string {
+	if x != nil {
+		return x.Identifier
+	}
+	return ""
+}
+
 // EventEpochStart is an event emitted when an epoch start.
 type EventEpochStart struct {
 	state         protoimpl.MessageState
@@ -928,6 +1065,8 @@ type EventEpochStart struct {
 
 	EpochNumber    int64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
 	EpochStartTime int64 `protobuf:"varint,2,opt,name=epoch_start_time,json=epochStartTime,proto3" json:"epoch_start_time,omitempty"`
+	// identifier is the identifier of the epoch that started.
+	Identifier string `protobuf:"bytes,3,opt,name=identifier,proto3" json:"identifier,omitempty"`
 }
 
 func (x *EventEpochStart) Reset() {
@@ -964,6 +1103,13 @@ func (x *EventEpochStart) GetEpochStartTime() int64 {
 	return 0
 }
 
+func (x *EventEpochStart) GetIdentifier() string {
+	if x != nil {
+		return x.Identifier
+	}
+	return ""
+}
+
 var File_cosmos_epochs_v1beta1_events_proto protoreflect.FileDescriptor
 
 var file_cosmos_epochs_v1beta1_events_proto_rawDesc = []byte{
@@ -974,16 +1120,20 @@ var file_cosmos_epochs_v1beta1_events_proto_rawDesc = []byte{
 	0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x23, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65,
 	0x70, 0x6f, 0x63, 0x68, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x67, 0x65,
-	0x6e, 0x65, 0x73, 0x69, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x32, 0x0a, 0x0d, 0x45,
+	0x6e, 0x65, 0x73, 0x69, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x52, 0x0a, 0x0d, 0x45,
 	0x76, 0x65, 0x6e, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x45, 0x6e, 0x64, 0x12, 0x21, 0x0a, 0x0c,
 	0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22,
-	0x5e, 0x0a, 0x0f, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x53, 0x74, 0x61,
+	0x28, 0x03, 0x52, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x1e, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22,
+	0x7e, 0x0a, 0x0f, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x53, 0x74, 0x61,
 	0x72, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
 	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x4e,
 	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x73,
 	0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0e, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x42,
+	0x0e, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x1e, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x42,
 	0xd4, 0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65,
 	0x70, 0x6f, 0x63, 0x68, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0b, 0x45,
 	0x76, 0x65, 0x6e, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x34, 0x63, 0x6f,
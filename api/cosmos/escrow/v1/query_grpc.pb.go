@@ -0,0 +1,228 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: cosmos/escrow/v1/query.proto
+
+package escrowv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Query_Escrow_FullMethodName             = "/cosmos.escrow.v1.Query/Escrow"
+	Query_Escrows_FullMethodName            = "/cosmos.escrow.v1.Query/Escrows"
+	Query_EscrowsByDepositor_FullMethodName = "/cosmos.escrow.v1.Query/EscrowsByDepositor"
+	Query_EscrowsByRecipient_FullMethodName = "/cosmos.escrow.v1.Query/EscrowsByRecipient"
+)
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QueryClient interface {
+	// Escrow returns a pending escrow by its id.
+	Escrow(ctx context.Context, in *QueryEscrowRequest, opts ...grpc.CallOption) (*QueryEscrowResponse, error)
+	// Escrows returns all the pending escrows.
+	Escrows(ctx context.Context, in *QueryEscrowsRequest, opts ...grpc.CallOption) (*QueryEscrowsResponse, error)
+	// EscrowsByDepositor returns the pending escrows funded by an address.
+	EscrowsByDepositor(ctx context.Context, in *QueryEscrowsByDepositorRequest, opts ...grpc.CallOption) (*QueryEscrowsByDepositorResponse, error)
+	// EscrowsByRecipient returns the pending escrows to be released to an address.
+	EscrowsByRecipient(ctx context.Context, in *QueryEscrowsByRecipientRequest, opts ...grpc.CallOption) (*QueryEscrowsByRecipientResponse, error)
+}
+
+type queryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Escrow(ctx context.Context, in *QueryEscrowRequest, opts ...grpc.CallOption) (*QueryEscrowResponse, error) {
+	out := new(QueryEscrowResponse)
+	err := c.cc.Invoke(ctx, Query_Escrow_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Escrows(ctx context.Context, in *QueryEscrowsRequest, opts ...grpc.CallOption) (*QueryEscrowsResponse, error) {
+	out := new(QueryEscrowsResponse)
+	err := c.cc.Invoke(ctx, Query_Escrows_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EscrowsByDepositor(ctx context.Context, in *QueryEscrowsByDepositorRequest, opts ...grpc.CallOption) (*QueryEscrowsByDepositorResponse, error) {
+	out := new(QueryEscrowsByDepositorResponse)
+	err := c.cc.Invoke(ctx, Query_EscrowsByDepositor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EscrowsByRecipient(ctx context.Context, in *QueryEscrowsByRecipientRequest, opts ...grpc.CallOption) (*QueryEscrowsByRecipientResponse, error) {
+	out := new(QueryEscrowsByRecipientResponse)
+	err := c.cc.Invoke(ctx, Query_EscrowsByRecipient_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+// All implementations must embed UnimplementedQueryServer
+// for forward compatibility
+type QueryServer interface {
+	// Escrow returns a pending escrow by its id.
+	Escrow(context.Context, *QueryEscrowRequest) (*QueryEscrowResponse, error)
+	// Escrows returns all the pending escrows.
+	Escrows(context.Context, *QueryEscrowsRequest) (*QueryEscrowsResponse, error)
+	// EscrowsByDepositor returns the pending escrows funded by an address.
+	EscrowsByDepositor(context.Context, *QueryEscrowsByDepositorRequest) (*QueryEscrowsByDepositorResponse, error)
+	// EscrowsByRecipient returns the pending escrows to be released to an address.
+	EscrowsByRecipient(context.Context, *QueryEscrowsByRecipientRequest) (*QueryEscrowsByRecipientResponse, error)
+	mustEmbedUnimplementedQueryServer()
+}
+
+// UnimplementedQueryServer must be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (UnimplementedQueryServer) Escrow(context.Context, *QueryEscrowRequest) (*QueryEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Escrow not implemented")
+}
+func (UnimplementedQueryServer) Escrows(context.Context, *QueryEscrowsRequest) (*QueryEscrowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Escrows not implemented")
+}
+func (UnimplementedQueryServer) EscrowsByDepositor(context.Context, *QueryEscrowsByDepositorRequest) (*QueryEscrowsByDepositorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EscrowsByDepositor not implemented")
+}
+func (UnimplementedQueryServer) EscrowsByRecipient(context.Context, *QueryEscrowsByRecipientRequest) (*QueryEscrowsByRecipientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EscrowsByRecipient not implemented")
+}
+func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
+
+// UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QueryServer will
+// result in compilation errors.
+type UnsafeQueryServer interface {
+	mustEmbedUnimplementedQueryServer()
+}
+
+func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	s.RegisterService(&Query_ServiceDesc, srv)
+}
+
+func _Query_Escrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEscrowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Escrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Escrow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Escrow(ctx, req.(*QueryEscrowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Escrows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEscrowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Escrows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Escrows_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Escrows(ctx, req.(*QueryEscrowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EscrowsByDepositor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEscrowsByDepositorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EscrowsByDepositor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EscrowsByDepositor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EscrowsByDepositor(ctx, req.(*QueryEscrowsByDepositorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EscrowsByRecipient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEscrowsByRecipientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EscrowsByRecipient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EscrowsByRecipient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EscrowsByRecipient(ctx, req.(*QueryEscrowsByRecipientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Query_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.escrow.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Escrow",
+			Handler:    _Query_Escrow_Handler,
+		},
+		{
+			MethodName: "Escrows",
+			Handler:    _Query_Escrows_Handler,
+		},
+		{
+			MethodName: "EscrowsByDepositor",
+			Handler:    _Query_EscrowsByDepositor_Handler,
+		},
+		{
+			MethodName: "EscrowsByRecipient",
+			Handler:    _Query_EscrowsByRecipient_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/escrow/v1/query.proto",
+}
@@ -21,6 +21,7 @@ const _ = grpc.SupportPackageIsVersion7
 const (
 	Query_Evidence_FullMethodName    = "/cosmos.evidence.v1beta1.Query/Evidence"
 	Query_AllEvidence_FullMethodName = "/cosmos.evidence.v1beta1.Query/AllEvidence"
+	Query_Params_FullMethodName      = "/cosmos.evidence.v1beta1.Query/Params"
 )
 
 // QueryClient is the client API for Query service.
@@ -31,6 +32,10 @@ type QueryClient interface {
 	Evidence(ctx context.Context, in *QueryEvidenceRequest, opts ...grpc.CallOption) (*QueryEvidenceResponse, error)
 	// AllEvidence queries all evidence.
 	AllEvidence(ctx context.Context, in *QueryAllEvidenceRequest, opts ...grpc.CallOption) (*QueryAllEvidenceResponse, error)
+	// Params queries the parameters of the evidence module.
+	//
+	// Since: x/evidence v0.2.0
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
 
 type queryClient struct {
@@ -59,6 +64,15 @@ func (c *queryClient) AllEvidence(ctx context.Context, in *QueryAllEvidenceReque
 	return out, nil
 }
 
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -67,6 +81,10 @@ type QueryServer interface {
 	Evidence(context.Context, *QueryEvidenceRequest) (*QueryEvidenceResponse, error)
 	// AllEvidence queries all evidence.
 	AllEvidence(context.Context, *QueryAllEvidenceRequest) (*QueryAllEvidenceResponse, error)
+	// Params queries the parameters of the evidence module.
+	//
+	// Since: x/evidence v0.2.0
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -80,6 +98,9 @@ func (UnimplementedQueryServer) Evidence(context.Context, *QueryEvidenceRequest)
 func (UnimplementedQueryServer) AllEvidence(context.Context, *QueryAllEvidenceRequest) (*QueryAllEvidenceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllEvidence not implemented")
 }
+func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -129,6 +150,24 @@ func _Query_AllEvidence_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Params_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -144,6 +183,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AllEvidence",
 			Handler:    _Query_AllEvidence_Handler,
 		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evidence/v1beta1/query.proto",
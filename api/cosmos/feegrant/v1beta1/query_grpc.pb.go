@@ -24,6 +24,7 @@ const (
 	Query_Allowance_FullMethodName           = "/cosmos.feegrant.v1beta1.Query/Allowance"
 	Query_Allowances_FullMethodName          = "/cosmos.feegrant.v1beta1.Query/Allowances"
 	Query_AllowancesByGranter_FullMethodName = "/cosmos.feegrant.v1beta1.Query/AllowancesByGranter"
+	Query_ExpiredAllowances_FullMethodName   = "/cosmos.feegrant.v1beta1.Query/ExpiredAllowances"
 )
 
 // QueryClient is the client API for Query service.
@@ -38,6 +39,9 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.46
 	AllowancesByGranter(ctx context.Context, in *QueryAllowancesByGranterRequest, opts ...grpc.CallOption) (*QueryAllowancesByGranterResponse, error)
+	// ExpiredAllowances returns the grants which have expired as of the current
+	// block time but have not been pruned yet.
+	ExpiredAllowances(ctx context.Context, in *QueryExpiredAllowancesRequest, opts ...grpc.CallOption) (*QueryExpiredAllowancesResponse, error)
 }
 
 type queryClient struct {
@@ -75,6 +79,15 @@ func (c *queryClient) AllowancesByGranter(ctx context.Context, in *QueryAllowanc
 	return out, nil
 }
 
+func (c *queryClient) ExpiredAllowances(ctx context.Context, in *QueryExpiredAllowancesRequest, opts ...grpc.CallOption) (*QueryExpiredAllowancesResponse, error) {
+	out := new(QueryExpiredAllowancesResponse)
+	err := c.cc.Invoke(ctx, Query_ExpiredAllowances_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -87,6 +100,9 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.46
 	AllowancesByGranter(context.Context, *QueryAllowancesByGranterRequest) (*QueryAllowancesByGranterResponse, error)
+	// ExpiredAllowances returns the grants which have expired as of the current
+	// block time but have not been pruned yet.
+	ExpiredAllowances(context.Context, *QueryExpiredAllowancesRequest) (*QueryExpiredAllowancesResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -103,6 +119,9 @@ func (UnimplementedQueryServer) Allowances(context.Context, *QueryAllowancesRequ
 func (UnimplementedQueryServer) AllowancesByGranter(context.Context, *QueryAllowancesByGranterRequest) (*QueryAllowancesByGranterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllowancesByGranter not implemented")
 }
+func (UnimplementedQueryServer) ExpiredAllowances(context.Context, *QueryExpiredAllowancesRequest) (*QueryExpiredAllowancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExpiredAllowances not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -170,6 +189,24 @@ func _Query_AllowancesByGranter_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ExpiredAllowances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExpiredAllowancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExpiredAllowances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ExpiredAllowances_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExpiredAllowances(ctx, req.(*QueryExpiredAllowancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -189,6 +226,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AllowancesByGranter",
 			Handler:    _Query_AllowancesByGranter_Handler,
 		},
+		{
+			MethodName: "ExpiredAllowances",
+			Handler:    _Query_ExpiredAllowances_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/feegrant/v1beta1/query.proto",
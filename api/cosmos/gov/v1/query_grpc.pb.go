@@ -21,17 +21,20 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Constitution_FullMethodName        = "/cosmos.gov.v1.Query/Constitution"
-	Query_Proposal_FullMethodName            = "/cosmos.gov.v1.Query/Proposal"
-	Query_Proposals_FullMethodName           = "/cosmos.gov.v1.Query/Proposals"
-	Query_Vote_FullMethodName                = "/cosmos.gov.v1.Query/Vote"
-	Query_Votes_FullMethodName               = "/cosmos.gov.v1.Query/Votes"
-	Query_Params_FullMethodName              = "/cosmos.gov.v1.Query/Params"
-	Query_Deposit_FullMethodName             = "/cosmos.gov.v1.Query/Deposit"
-	Query_Deposits_FullMethodName            = "/cosmos.gov.v1.Query/Deposits"
-	Query_TallyResult_FullMethodName         = "/cosmos.gov.v1.Query/TallyResult"
-	Query_ProposalVoteOptions_FullMethodName = "/cosmos.gov.v1.Query/ProposalVoteOptions"
-	Query_MessageBasedParams_FullMethodName  = "/cosmos.gov.v1.Query/MessageBasedParams"
+	Query_Constitution_FullMethodName            = "/cosmos.gov.v1.Query/Constitution"
+	Query_Proposal_FullMethodName                = "/cosmos.gov.v1.Query/Proposal"
+	Query_Proposals_FullMethodName               = "/cosmos.gov.v1.Query/Proposals"
+	Query_Vote_FullMethodName                    = "/cosmos.gov.v1.Query/Vote"
+	Query_Votes_FullMethodName                   = "/cosmos.gov.v1.Query/Votes"
+	Query_Params_FullMethodName                  = "/cosmos.gov.v1.Query/Params"
+	Query_Deposit_FullMethodName                 = "/cosmos.gov.v1.Query/Deposit"
+	Query_Deposits_FullMethodName                = "/cosmos.gov.v1.Query/Deposits"
+	Query_TallyResult_FullMethodName             = "/cosmos.gov.v1.Query/TallyResult"
+	Query_ProposalVoteOptions_FullMethodName     = "/cosmos.gov.v1.Query/ProposalVoteOptions"
+	Query_MessageBasedParams_FullMethodName      = "/cosmos.gov.v1.Query/MessageBasedParams"
+	Query_VoteDelegation_FullMethodName          = "/cosmos.gov.v1.Query/VoteDelegation"
+	Query_ProposalExecutionResult_FullMethodName = "/cosmos.gov.v1.Query/ProposalExecutionResult"
+	Query_LiveTally_FullMethodName               = "/cosmos.gov.v1.Query/LiveTally"
 )
 
 // QueryClient is the client API for Query service.
@@ -62,6 +65,15 @@ type QueryClient interface {
 	// MessageBasedParams queries the message specific governance params based on a msg url.
 	// Since: cosmos-sdk x/gov v1.0.0
 	MessageBasedParams(ctx context.Context, in *QueryMessageBasedParamsRequest, opts ...grpc.CallOption) (*QueryMessageBasedParamsResponse, error)
+	// VoteDelegation queries the governance vote delegation of a delegator.
+	// Since: cosmos-sdk x/gov v1.0.0
+	VoteDelegation(ctx context.Context, in *QueryVoteDelegationRequest, opts ...grpc.CallOption) (*QueryVoteDelegationResponse, error)
+	// ProposalExecutionResult queries the execution result of the messages of a passed proposal.
+	// Since: cosmos-sdk x/gov v1.0.0
+	ProposalExecutionResult(ctx context.Context, in *QueryProposalExecutionResultRequest, opts ...grpc.CallOption) (*QueryProposalExecutionResultResponse, error)
+	// LiveTally queries the incremental tally of a proposal in voting period.
+	// Since: cosmos-sdk x/gov v1.0.0
+	LiveTally(ctx context.Context, in *QueryLiveTallyRequest, opts ...grpc.CallOption) (*QueryLiveTallyResponse, error)
 }
 
 type queryClient struct {
@@ -171,6 +183,33 @@ func (c *queryClient) MessageBasedParams(ctx context.Context, in *QueryMessageBa
 	return out, nil
 }
 
+func (c *queryClient) VoteDelegation(ctx context.Context, in *QueryVoteDelegationRequest, opts ...grpc.CallOption) (*QueryVoteDelegationResponse, error) {
+	out := new(QueryVoteDelegationResponse)
+	err := c.cc.Invoke(ctx, Query_VoteDelegation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProposalExecutionResult(ctx context.Context, in *QueryProposalExecutionResultRequest, opts ...grpc.CallOption) (*QueryProposalExecutionResultResponse, error) {
+	out := new(QueryProposalExecutionResultResponse)
+	err := c.cc.Invoke(ctx, Query_ProposalExecutionResult_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LiveTally(ctx context.Context, in *QueryLiveTallyRequest, opts ...grpc.CallOption) (*QueryLiveTallyResponse, error) {
+	out := new(QueryLiveTallyResponse)
+	err := c.cc.Invoke(ctx, Query_LiveTally_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -199,6 +238,15 @@ type QueryServer interface {
 	// MessageBasedParams queries the message specific governance params based on a msg url.
 	// Since: cosmos-sdk x/gov v1.0.0
 	MessageBasedParams(context.Context, *QueryMessageBasedParamsRequest) (*QueryMessageBasedParamsResponse, error)
+	// VoteDelegation queries the governance vote delegation of a delegator.
+	// Since: cosmos-sdk x/gov v1.0.0
+	VoteDelegation(context.Context, *QueryVoteDelegationRequest) (*QueryVoteDelegationResponse, error)
+	// ProposalExecutionResult queries the execution result of the messages of a passed proposal.
+	// Since: cosmos-sdk x/gov v1.0.0
+	ProposalExecutionResult(context.Context, *QueryProposalExecutionResultRequest) (*QueryProposalExecutionResultResponse, error)
+	// LiveTally queries the incremental tally of a proposal in voting period.
+	// Since: cosmos-sdk x/gov v1.0.0
+	LiveTally(context.Context, *QueryLiveTallyRequest) (*QueryLiveTallyResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -239,6 +287,15 @@ func (UnimplementedQueryServer) ProposalVoteOptions(context.Context, *QueryPropo
 func (UnimplementedQueryServer) MessageBasedParams(context.Context, *QueryMessageBasedParamsRequest) (*QueryMessageBasedParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MessageBasedParams not implemented")
 }
+func (UnimplementedQueryServer) VoteDelegation(context.Context, *QueryVoteDelegationRequest) (*QueryVoteDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteDelegation not implemented")
+}
+func (UnimplementedQueryServer) ProposalExecutionResult(context.Context, *QueryProposalExecutionResultRequest) (*QueryProposalExecutionResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalExecutionResult not implemented")
+}
+func (UnimplementedQueryServer) LiveTally(context.Context, *QueryLiveTallyRequest) (*QueryLiveTallyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiveTally not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -450,6 +507,60 @@ func _Query_MessageBasedParams_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_VoteDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVoteDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VoteDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_VoteDelegation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VoteDelegation(ctx, req.(*QueryVoteDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProposalExecutionResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalExecutionResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalExecutionResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProposalExecutionResult_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalExecutionResult(ctx, req.(*QueryProposalExecutionResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LiveTally_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLiveTallyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LiveTally(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_LiveTally_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiveTally(ctx, req.(*QueryLiveTallyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -501,6 +612,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MessageBasedParams",
 			Handler:    _Query_MessageBasedParams_Handler,
 		},
+		{
+			MethodName: "VoteDelegation",
+			Handler:    _Query_VoteDelegation_Handler,
+		},
+		{
+			MethodName: "ProposalExecutionResult",
+			Handler:    _Query_ProposalExecutionResult_Handler,
+		},
+		{
+			MethodName: "LiveTally",
+			Handler:    _Query_LiveTally_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/query.proto",
@@ -31,6 +31,7 @@ const (
 	Msg_SubmitMultipleChoiceProposal_FullMethodName = "/cosmos.gov.v1.Msg/SubmitMultipleChoiceProposal"
 	Msg_UpdateMessageParams_FullMethodName          = "/cosmos.gov.v1.Msg/UpdateMessageParams"
 	Msg_SudoExec_FullMethodName                     = "/cosmos.gov.v1.Msg/SudoExec"
+	Msg_DelegateVote_FullMethodName                 = "/cosmos.gov.v1.Msg/DelegateVote"
 )
 
 // MsgClient is the client API for Msg service.
@@ -70,6 +71,11 @@ type MsgClient interface {
 	//
 	// Since: x/gov 1.0.0
 	SudoExec(ctx context.Context, in *MsgSudoExec, opts ...grpc.CallOption) (*MsgSudoExecResponse, error)
+	// DelegateVote defines a method to delegate the governance voting power of an account to another address,
+	// without redelegating its tokens.
+	//
+	// Since: x/gov 1.0.0
+	DelegateVote(ctx context.Context, in *MsgDelegateVote, opts ...grpc.CallOption) (*MsgDelegateVoteResponse, error)
 }
 
 type msgClient struct {
@@ -170,6 +176,15 @@ func (c *msgClient) SudoExec(ctx context.Context, in *MsgSudoExec, opts ...grpc.
 	return out, nil
 }
 
+func (c *msgClient) DelegateVote(ctx context.Context, in *MsgDelegateVote, opts ...grpc.CallOption) (*MsgDelegateVoteResponse, error) {
+	out := new(MsgDelegateVoteResponse)
+	err := c.cc.Invoke(ctx, Msg_DelegateVote_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -207,6 +222,11 @@ type MsgServer interface {
 	//
 	// Since: x/gov 1.0.0
 	SudoExec(context.Context, *MsgSudoExec) (*MsgSudoExecResponse, error)
+	// DelegateVote defines a method to delegate the governance voting power of an account to another address,
+	// without redelegating its tokens.
+	//
+	// Since: x/gov 1.0.0
+	DelegateVote(context.Context, *MsgDelegateVote) (*MsgDelegateVoteResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -244,6 +264,9 @@ func (UnimplementedMsgServer) UpdateMessageParams(context.Context, *MsgUpdateMes
 func (UnimplementedMsgServer) SudoExec(context.Context, *MsgSudoExec) (*MsgSudoExecResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SudoExec not implemented")
 }
+func (UnimplementedMsgServer) DelegateVote(context.Context, *MsgDelegateVote) (*MsgDelegateVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateVote not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -437,6 +460,24 @@ func _Msg_SudoExec_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_DelegateVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateVote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DelegateVote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateVote(ctx, req.(*MsgDelegateVote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -484,6 +525,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SudoExec",
 			Handler:    _Msg_SudoExec_Handler,
 		},
+		{
+			MethodName: "DelegateVote",
+			Handler:    _Msg_DelegateVote_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/tx.proto",
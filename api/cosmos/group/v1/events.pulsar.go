@@ -3857,6 +3857,474 @@ func (x *fastReflection_EventLeaveGroup) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var (
+	md_EventVetoProposal                protoreflect.MessageDescriptor
+	fd_EventVetoProposal_proposal_id    protoreflect.FieldDescriptor
+	fd_EventVetoProposal_veto_authority protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_group_v1_events_proto_init()
+	md_EventVetoProposal = File_cosmos_group_v1_events_proto.Messages().ByName("EventVetoProposal")
+	fd_EventVetoProposal_proposal_id = md_EventVetoProposal.Fields().ByName("proposal_id")
+	fd_EventVetoProposal_veto_authority = md_EventVetoProposal.Fields().ByName("veto_authority")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventVetoProposal)(nil)
+
+type fastReflection_EventVetoProposal EventVetoProposal
+
+func (x *EventVetoProposal) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventVetoProposal)(x)
+}
+
+func (x *EventVetoProposal) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_group_v1_events_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventVetoProposal_messageType fastReflection_EventVetoProposal_messageType
+var _ protoreflect.MessageType = fastReflection_EventVetoProposal_messageType{}
+
+type fastReflection_EventVetoProposal_messageType struct{}
+
+func (x fastReflection_EventVetoProposal_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventVetoProposal)(nil)
+}
+func (x fastReflection_EventVetoProposal_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventVetoProposal)
+}
+func (x fastReflection_EventVetoProposal_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventVetoProposal
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventVetoProposal) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventVetoProposal
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventVetoProposal) Type() protoreflect.MessageType {
+	return _fastReflection_EventVetoProposal_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventVetoProposal) New() protoreflect.Message {
+	return new(fastReflection_EventVetoProposal)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventVetoProposal) Interface() protoreflect.ProtoMessage {
+	return (*EventVetoProposal)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventVetoProposal) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ProposalId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProposalId)
+		if !f(fd_EventVetoProposal_proposal_id, value) {
+			return
+		}
+	}
+	if x.VetoAuthority != "" {
+		value := protoreflect.ValueOfString(x.VetoAuthority)
+		if !f(fd_EventVetoProposal_veto_authority, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventVetoProposal) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.group.v1.EventVetoProposal.proposal_id":
+		return x.ProposalId != uint64(0)
+	case "cosmos.group.v1.EventVetoProposal.veto_authority":
+		return x.VetoAuthority != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.EventVetoProposal"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.EventVetoProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventVetoProposal) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.group.v1.EventVetoProposal.proposal_id":
+		x.ProposalId = uint64(0)
+	case "cosmos.group.v1.EventVetoProposal.veto_authority":
+		x.VetoAuthority = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.EventVetoProposal"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.EventVetoProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventVetoProposal) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.group.v1.EventVetoProposal.proposal_id":
+		value := x.ProposalId
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.group.v1.EventVetoProposal.veto_authority":
+		value := x.VetoAuthority
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.EventVetoProposal"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.EventVetoProposal does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventVetoProposal) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.group.v1.EventVetoProposal.proposal_id":
+		x.ProposalId = value.Uint()
+	case "cosmos.group.v1.EventVetoProposal.veto_authority":
+		x.VetoAuthority = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.EventVetoProposal"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.EventVetoProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventVetoProposal) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.group.v1.EventVetoProposal.proposal_id":
+		panic(fmt.Errorf("field proposal_id of message cosmos.group.v1.EventVetoProposal is not mutable"))
+	case "cosmos.group.v1.EventVetoProposal.veto_authority":
+		panic(fmt.Errorf("field veto_authority of message cosmos.group.v1.EventVetoProposal is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.EventVetoProposal"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.EventVetoProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventVetoProposal) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.group.v1.EventVetoProposal.proposal_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.group.v1.EventVetoProposal.veto_authority":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.EventVetoProposal"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.EventVetoProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventVetoProposal) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.EventVetoProposal", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventVetoProposal) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventVetoProposal) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventVetoProposal) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventVetoProposal) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventVetoProposal)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ProposalId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProposalId))
+		}
+		l = len(x.VetoAuthority)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventVetoProposal)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.VetoAuthority) > 0 {
+			i -= len(x.VetoAuthority)
+			copy(dAtA[i:], x.VetoAuthority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VetoAuthority)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.ProposalId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventVetoProposal)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventVetoProposal: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventVetoProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+				}
+				x.ProposalId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProposalId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VetoAuthority", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VetoAuthority = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
 var (
 	md_EventProposalPruned              protoreflect.MessageDescriptor
 	fd_EventProposalPruned_proposal_id  protoreflect.FieldDescriptor
@@ -3866,22 +4334,557 @@ var (
 
 func init() {
 	file_cosmos_group_v1_events_proto_init()
-	md_EventProposalPruned = File_cosmos_group_v1_events_proto.Messages().ByName("EventProposalPruned")
-	fd_EventProposalPruned_proposal_id = md_EventProposalPruned.Fields().ByName("proposal_id")
-	fd_EventProposalPruned_status = md_EventProposalPruned.Fields().ByName("status")
-	fd_EventProposalPruned_tally_result = md_EventProposalPruned.Fields().ByName("tally_result")
+	md_EventProposalPruned = File_cosmos_group_v1_events_proto.Messages().ByName("EventProposalPruned")
+	fd_EventProposalPruned_proposal_id = md_EventProposalPruned.Fields().ByName("proposal_id")
+	fd_EventProposalPruned_status = md_EventProposalPruned.Fields().ByName("status")
+	fd_EventProposalPruned_tally_result = md_EventProposalPruned.Fields().ByName("tally_result")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventProposalPruned)(nil)
+
+type fastReflection_EventProposalPruned EventProposalPruned
+
+func (x *EventProposalPruned) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventProposalPruned)(x)
+}
+
+func (x *EventProposalPruned) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_group_v1_events_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventProposalPruned_messageType fastReflection_EventProposalPruned_messageType
+var _ protoreflect.MessageType = fastReflection_EventProposalPruned_messageType{}
+
+type fastReflection_EventProposalPruned_messageType struct{}
+
+func (x fastReflection_EventProposalPruned_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventProposalPruned)(nil)
+}
+func (x fastReflection_EventProposalPruned_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventProposalPruned)
+}
+func (x fastReflection_EventProposalPruned_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventProposalPruned
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventProposalPruned) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventProposalPruned
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventProposalPruned) Type() protoreflect.MessageType {
+	return _fastReflection_EventProposalPruned_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventProposalPruned) New() protoreflect.Message {
+	return new(fastReflection_EventProposalPruned)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventProposalPruned) Interface() protoreflect.ProtoMessage {
+	return (*EventProposalPruned)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventProposalPruned) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ProposalId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProposalId)
+		if !f(fd_EventProposalPruned_proposal_id, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_EventProposalPruned_status, value) {
+			return
+		}
+	}
+	if x.TallyResult != nil {
+		value := protoreflect.ValueOfMessage(x.TallyResult.ProtoReflect())
+		if !f(fd_EventProposalPruned_tally_result, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventProposalPruned) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.group.v1.EventProposalPruned.proposal_id":
+		return x.ProposalId != uint64(0)
+	case "cosmos.group.v1.EventProposalPruned.status":
+		return x.Status != 0
+	case "cosmos.group.v1.EventProposalPruned.tally_result":
+		return x.TallyResult != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.EventProposalPruned"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.EventProposalPruned does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventProposalPruned) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.group.v1.EventProposalPruned.proposal_id":
+		x.ProposalId = uint64(0)
+	case "cosmos.group.v1.EventProposalPruned.status":
+		x.Status = 0
+	case "cosmos.group.v1.EventProposalPruned.tally_result":
+		x.TallyResult = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.EventProposalPruned"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.EventProposalPruned does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventProposalPruned) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.group.v1.EventProposalPruned.proposal_id":
+		value := x.ProposalId
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.group.v1.EventProposalPruned.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "cosmos.group.v1.EventProposalPruned.tally_result":
+		value := x.TallyResult
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.EventProposalPruned"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.EventProposalPruned does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventProposalPruned) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.group.v1.EventProposalPruned.proposal_id":
+		x.ProposalId = value.Uint()
+	case "cosmos.group.v1.EventProposalPruned.status":
+		x.Status = (ProposalStatus)(value.Enum())
+	case "cosmos.group.v1.EventProposalPruned.tally_result":
+		x.TallyResult = value.Message().Interface().(*TallyResult)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.EventProposalPruned"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.EventProposalPruned does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventProposalPruned) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.group.v1.EventProposalPruned.tally_result":
+		if x.TallyResult == nil {
+			x.TallyResult = new(TallyResult)
+		}
+		return protoreflect.ValueOfMessage(x.TallyResult.ProtoReflect())
+	case "cosmos.group.v1.EventProposalPruned.proposal_id":
+		panic(fmt.Errorf("field proposal_id of message cosmos.group.v1.EventProposalPruned is not mutable"))
+	case "cosmos.group.v1.EventProposalPruned.status":
+		panic(fmt.Errorf("field status of message cosmos.group.v1.EventProposalPruned is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.EventProposalPruned"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.EventProposalPruned does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventProposalPruned) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.group.v1.EventProposalPruned.proposal_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.group.v1.EventProposalPruned.status":
+		return protoreflect.ValueOfEnum(0)
+	case "cosmos.group.v1.EventProposalPruned.tally_result":
+		m := new(TallyResult)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.EventProposalPruned"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.EventProposalPruned does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventProposalPruned) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.EventProposalPruned", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventProposalPruned) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventProposalPruned) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventProposalPruned) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventProposalPruned) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventProposalPruned)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ProposalId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProposalId))
+		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		if x.TallyResult != nil {
+			l = options.Size(x.TallyResult)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventProposalPruned)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.TallyResult != nil {
+			encoded, err := options.Marshal(x.TallyResult)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.ProposalId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventProposalPruned)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventProposalPruned: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventProposalPruned: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+				}
+				x.ProposalId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProposalId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= ProposalStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TallyResult", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.TallyResult == nil {
+					x.TallyResult = &TallyResult{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TallyResult); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventProposalStatusChanged                 protoreflect.MessageDescriptor
+	fd_EventProposalStatusChanged_proposal_id     protoreflect.FieldDescriptor
+	fd_EventProposalStatusChanged_previous_status protoreflect.FieldDescriptor
+	fd_EventProposalStatusChanged_status          protoreflect.FieldDescriptor
+	fd_EventProposalStatusChanged_executor_result protoreflect.FieldDescriptor
+	fd_EventProposalStatusChanged_tally_result    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_group_v1_events_proto_init()
+	md_EventProposalStatusChanged = File_cosmos_group_v1_events_proto.Messages().ByName("EventProposalStatusChanged")
+	fd_EventProposalStatusChanged_proposal_id = md_EventProposalStatusChanged.Fields().ByName("proposal_id")
+	fd_EventProposalStatusChanged_previous_status = md_EventProposalStatusChanged.Fields().ByName("previous_status")
+	fd_EventProposalStatusChanged_status = md_EventProposalStatusChanged.Fields().ByName("status")
+	fd_EventProposalStatusChanged_executor_result = md_EventProposalStatusChanged.Fields().ByName("executor_result")
+	fd_EventProposalStatusChanged_tally_result = md_EventProposalStatusChanged.Fields().ByName("tally_result")
 }
 
-var _ protoreflect.Message = (*fastReflection_EventProposalPruned)(nil)
+var _ protoreflect.Message = (*fastReflection_EventProposalStatusChanged)(nil)
 
-type fastReflection_EventProposalPruned EventProposalPruned
+type fastReflection_EventProposalStatusChanged EventProposalStatusChanged
 
-func (x *EventProposalPruned) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_EventProposalPruned)(x)
+func (x *EventProposalStatusChanged) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventProposalStatusChanged)(x)
 }
 
-func (x *EventProposalPruned) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_group_v1_events_proto_msgTypes[9]
+func (x *EventProposalStatusChanged) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_group_v1_events_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3892,43 +4895,43 @@ func (x *EventProposalPruned) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_EventProposalPruned_messageType fastReflection_EventProposalPruned_messageType
-var _ protoreflect.MessageType = fastReflection_EventProposalPruned_messageType{}
+var _fastReflection_EventProposalStatusChanged_messageType fastReflection_EventProposalStatusChanged_messageType
+var _ protoreflect.MessageType = fastReflection_EventProposalStatusChanged_messageType{}
 
-type fastReflection_EventProposalPruned_messageType struct{}
+type fastReflection_EventProposalStatusChanged_messageType struct{}
 
-func (x fastReflection_EventProposalPruned_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_EventProposalPruned)(nil)
+func (x fastReflection_EventProposalStatusChanged_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventProposalStatusChanged)(nil)
 }
-func (x fastReflection_EventProposalPruned_messageType) New() protoreflect.Message {
-	return new(fastReflection_EventProposalPruned)
+func (x fastReflection_EventProposalStatusChanged_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventProposalStatusChanged)
 }
-func (x fastReflection_EventProposalPruned_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventProposalPruned
+func (x fastReflection_EventProposalStatusChanged_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventProposalStatusChanged
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_EventProposalPruned) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventProposalPruned
+func (x *fastReflection_EventProposalStatusChanged) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventProposalStatusChanged
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_EventProposalPruned) Type() protoreflect.MessageType {
-	return _fastReflection_EventProposalPruned_messageType
+func (x *fastReflection_EventProposalStatusChanged) Type() protoreflect.MessageType {
+	return _fastReflection_EventProposalStatusChanged_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_EventProposalPruned) New() protoreflect.Message {
-	return new(fastReflection_EventProposalPruned)
+func (x *fastReflection_EventProposalStatusChanged) New() protoreflect.Message {
+	return new(fastReflection_EventProposalStatusChanged)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_EventProposalPruned) Interface() protoreflect.ProtoMessage {
-	return (*EventProposalPruned)(x)
+func (x *fastReflection_EventProposalStatusChanged) Interface() protoreflect.ProtoMessage {
+	return (*EventProposalStatusChanged)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3936,22 +4939,34 @@ func (x *fastReflection_EventProposalPruned) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_EventProposalPruned) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_EventProposalStatusChanged) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.ProposalId != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.ProposalId)
-		if !f(fd_EventProposalPruned_proposal_id, value) {
+		if !f(fd_EventProposalStatusChanged_proposal_id, value) {
+			return
+		}
+	}
+	if x.PreviousStatus != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.PreviousStatus))
+		if !f(fd_EventProposalStatusChanged_previous_status, value) {
 			return
 		}
 	}
 	if x.Status != 0 {
 		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
-		if !f(fd_EventProposalPruned_status, value) {
+		if !f(fd_EventProposalStatusChanged_status, value) {
+			return
+		}
+	}
+	if x.ExecutorResult != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.ExecutorResult))
+		if !f(fd_EventProposalStatusChanged_executor_result, value) {
 			return
 		}
 	}
 	if x.TallyResult != nil {
 		value := protoreflect.ValueOfMessage(x.TallyResult.ProtoReflect())
-		if !f(fd_EventProposalPruned_tally_result, value) {
+		if !f(fd_EventProposalStatusChanged_tally_result, value) {
 			return
 		}
 	}
@@ -3968,19 +4983,23 @@ func (x *fastReflection_EventProposalPruned) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_EventProposalPruned) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_EventProposalStatusChanged) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.group.v1.EventProposalPruned.proposal_id":
+	case "cosmos.group.v1.EventProposalStatusChanged.proposal_id":
 		return x.ProposalId != uint64(0)
-	case "cosmos.group.v1.EventProposalPruned.status":
+	case "cosmos.group.v1.EventProposalStatusChanged.previous_status":
+		return x.PreviousStatus != 0
+	case "cosmos.group.v1.EventProposalStatusChanged.status":
 		return x.Status != 0
-	case "cosmos.group.v1.EventProposalPruned.tally_result":
+	case "cosmos.group.v1.EventProposalStatusChanged.executor_result":
+		return x.ExecutorResult != 0
+	case "cosmos.group.v1.EventProposalStatusChanged.tally_result":
 		return x.TallyResult != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.EventProposalPruned"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.EventProposalStatusChanged"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.EventProposalPruned does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.EventProposalStatusChanged does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3990,19 +5009,23 @@ func (x *fastReflection_EventProposalPruned) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventProposalPruned) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_EventProposalStatusChanged) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.EventProposalPruned.proposal_id":
+	case "cosmos.group.v1.EventProposalStatusChanged.proposal_id":
 		x.ProposalId = uint64(0)
-	case "cosmos.group.v1.EventProposalPruned.status":
+	case "cosmos.group.v1.EventProposalStatusChanged.previous_status":
+		x.PreviousStatus = 0
+	case "cosmos.group.v1.EventProposalStatusChanged.status":
 		x.Status = 0
-	case "cosmos.group.v1.EventProposalPruned.tally_result":
+	case "cosmos.group.v1.EventProposalStatusChanged.executor_result":
+		x.ExecutorResult = 0
+	case "cosmos.group.v1.EventProposalStatusChanged.tally_result":
 		x.TallyResult = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.EventProposalPruned"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.EventProposalStatusChanged"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.EventProposalPruned does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.EventProposalStatusChanged does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4012,22 +5035,28 @@ func (x *fastReflection_EventProposalPruned) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_EventProposalPruned) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventProposalStatusChanged) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.group.v1.EventProposalPruned.proposal_id":
+	case "cosmos.group.v1.EventProposalStatusChanged.proposal_id":
 		value := x.ProposalId
 		return protoreflect.ValueOfUint64(value)
-	case "cosmos.group.v1.EventProposalPruned.status":
+	case "cosmos.group.v1.EventProposalStatusChanged.previous_status":
+		value := x.PreviousStatus
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "cosmos.group.v1.EventProposalStatusChanged.status":
 		value := x.Status
 		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
-	case "cosmos.group.v1.EventProposalPruned.tally_result":
+	case "cosmos.group.v1.EventProposalStatusChanged.executor_result":
+		value := x.ExecutorResult
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "cosmos.group.v1.EventProposalStatusChanged.tally_result":
 		value := x.TallyResult
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.EventProposalPruned"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.EventProposalStatusChanged"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.EventProposalPruned does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.EventProposalStatusChanged does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4041,19 +5070,23 @@ func (x *fastReflection_EventProposalPruned) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventProposalPruned) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_EventProposalStatusChanged) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.EventProposalPruned.proposal_id":
+	case "cosmos.group.v1.EventProposalStatusChanged.proposal_id":
 		x.ProposalId = value.Uint()
-	case "cosmos.group.v1.EventProposalPruned.status":
+	case "cosmos.group.v1.EventProposalStatusChanged.previous_status":
+		x.PreviousStatus = (ProposalStatus)(value.Enum())
+	case "cosmos.group.v1.EventProposalStatusChanged.status":
 		x.Status = (ProposalStatus)(value.Enum())
-	case "cosmos.group.v1.EventProposalPruned.tally_result":
+	case "cosmos.group.v1.EventProposalStatusChanged.executor_result":
+		x.ExecutorResult = (ProposalExecutorResult)(value.Enum())
+	case "cosmos.group.v1.EventProposalStatusChanged.tally_result":
 		x.TallyResult = value.Message().Interface().(*TallyResult)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.EventProposalPruned"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.EventProposalStatusChanged"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.EventProposalPruned does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.EventProposalStatusChanged does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4067,52 +5100,60 @@ func (x *fastReflection_EventProposalPruned) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventProposalPruned) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventProposalStatusChanged) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.EventProposalPruned.tally_result":
+	case "cosmos.group.v1.EventProposalStatusChanged.tally_result":
 		if x.TallyResult == nil {
 			x.TallyResult = new(TallyResult)
 		}
 		return protoreflect.ValueOfMessage(x.TallyResult.ProtoReflect())
-	case "cosmos.group.v1.EventProposalPruned.proposal_id":
-		panic(fmt.Errorf("field proposal_id of message cosmos.group.v1.EventProposalPruned is not mutable"))
-	case "cosmos.group.v1.EventProposalPruned.status":
-		panic(fmt.Errorf("field status of message cosmos.group.v1.EventProposalPruned is not mutable"))
+	case "cosmos.group.v1.EventProposalStatusChanged.proposal_id":
+		panic(fmt.Errorf("field proposal_id of message cosmos.group.v1.EventProposalStatusChanged is not mutable"))
+	case "cosmos.group.v1.EventProposalStatusChanged.previous_status":
+		panic(fmt.Errorf("field previous_status of message cosmos.group.v1.EventProposalStatusChanged is not mutable"))
+	case "cosmos.group.v1.EventProposalStatusChanged.status":
+		panic(fmt.Errorf("field status of message cosmos.group.v1.EventProposalStatusChanged is not mutable"))
+	case "cosmos.group.v1.EventProposalStatusChanged.executor_result":
+		panic(fmt.Errorf("field executor_result of message cosmos.group.v1.EventProposalStatusChanged is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.EventProposalPruned"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.EventProposalStatusChanged"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.EventProposalPruned does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.EventProposalStatusChanged does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_EventProposalPruned) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventProposalStatusChanged) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.EventProposalPruned.proposal_id":
+	case "cosmos.group.v1.EventProposalStatusChanged.proposal_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.group.v1.EventProposalPruned.status":
+	case "cosmos.group.v1.EventProposalStatusChanged.previous_status":
 		return protoreflect.ValueOfEnum(0)
-	case "cosmos.group.v1.EventProposalPruned.tally_result":
+	case "cosmos.group.v1.EventProposalStatusChanged.status":
+		return protoreflect.ValueOfEnum(0)
+	case "cosmos.group.v1.EventProposalStatusChanged.executor_result":
+		return protoreflect.ValueOfEnum(0)
+	case "cosmos.group.v1.EventProposalStatusChanged.tally_result":
 		m := new(TallyResult)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.EventProposalPruned"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.EventProposalStatusChanged"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.EventProposalPruned does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.EventProposalStatusChanged does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_EventProposalPruned) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_EventProposalStatusChanged) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.EventProposalPruned", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.EventProposalStatusChanged", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4120,7 +5161,7 @@ func (x *fastReflection_EventProposalPruned) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_EventProposalPruned) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_EventProposalStatusChanged) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4131,7 +5172,7 @@ func (x *fastReflection_EventProposalPruned) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventProposalPruned) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_EventProposalStatusChanged) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4143,7 +5184,7 @@ func (x *fastReflection_EventProposalPruned) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_EventProposalPruned) IsValid() bool {
+func (x *fastReflection_EventProposalStatusChanged) IsValid() bool {
 	return x != nil
 }
 
@@ -4153,9 +5194,9 @@ func (x *fastReflection_EventProposalPruned) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_EventProposalPruned) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_EventProposalStatusChanged) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*EventProposalPruned)
+		x := input.Message.Interface().(*EventProposalStatusChanged)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4170,9 +5211,15 @@ func (x *fastReflection_EventProposalPruned) ProtoMethods() *protoiface.Methods
 		if x.ProposalId != 0 {
 			n += 1 + runtime.Sov(uint64(x.ProposalId))
 		}
+		if x.PreviousStatus != 0 {
+			n += 1 + runtime.Sov(uint64(x.PreviousStatus))
+		}
 		if x.Status != 0 {
 			n += 1 + runtime.Sov(uint64(x.Status))
 		}
+		if x.ExecutorResult != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExecutorResult))
+		}
 		if x.TallyResult != nil {
 			l = options.Size(x.TallyResult)
 			n += 1 + l + runtime.Sov(uint64(l))
@@ -4187,7 +5234,7 @@ func (x *fastReflection_EventProposalPruned) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*EventProposalPruned)
+		x := input.Message.Interface().(*EventProposalStatusChanged)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4218,11 +5265,21 @@ func (x *fastReflection_EventProposalPruned) ProtoMethods() *protoiface.Methods
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x2a
+		}
+		if x.ExecutorResult != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExecutorResult))
+			i--
+			dAtA[i] = 0x20
 		}
 		if x.Status != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
 			i--
+			dAtA[i] = 0x18
+		}
+		if x.PreviousStatus != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PreviousStatus))
+			i--
 			dAtA[i] = 0x10
 		}
 		if x.ProposalId != 0 {
@@ -4241,7 +5298,7 @@ func (x *fastReflection_EventProposalPruned) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*EventProposalPruned)
+		x := input.Message.Interface().(*EventProposalStatusChanged)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4273,10 +5330,10 @@ func (x *fastReflection_EventProposalPruned) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventProposalPruned: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventProposalStatusChanged: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventProposalPruned: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventProposalStatusChanged: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -4299,6 +5356,25 @@ func (x *fastReflection_EventProposalPruned) ProtoMethods() *protoiface.Methods
 					}
 				}
 			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousStatus", wireType)
+				}
+				x.PreviousStatus = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PreviousStatus |= ProposalStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
 				if wireType != 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 				}
@@ -4317,7 +5393,26 @@ func (x *fastReflection_EventProposalPruned) ProtoMethods() *protoiface.Methods
 						break
 					}
 				}
-			case 3:
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecutorResult", wireType)
+				}
+				x.ExecutorResult = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExecutorResult |= ProposalExecutorResult(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
 				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TallyResult", wireType)
 				}
@@ -4763,6 +5858,54 @@ func (x *EventLeaveGroup) GetAddress() string {
 	return ""
 }
 
+// EventVetoProposal is an event emitted when a proposal is vetoed.
+//
+// Since: x/group v1.0.0
+type EventVetoProposal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// proposal_id is the unique ID of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// veto_authority is the account address of the group policy's veto authority.
+	VetoAuthority string `protobuf:"bytes,2,opt,name=veto_authority,json=vetoAuthority,proto3" json:"veto_authority,omitempty"`
+}
+
+func (x *EventVetoProposal) Reset() {
+	*x = EventVetoProposal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_group_v1_events_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventVetoProposal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventVetoProposal) ProtoMessage() {}
+
+// Deprecated: Use EventVetoProposal.ProtoReflect.Descriptor instead.
+func (*EventVetoProposal) Descriptor() ([]byte, []int) {
+	return file_cosmos_group_v1_events_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *EventVetoProposal) GetProposalId() uint64 {
+	if x != nil {
+		return x.ProposalId
+	}
+	return 0
+}
+
+func (x *EventVetoProposal) GetVetoAuthority() string {
+	if x != nil {
+		return x.VetoAuthority
+	}
+	return ""
+}
+
 // EventProposalPruned is an event emitted when a proposal is pruned.
 type EventProposalPruned struct {
 	state         protoimpl.MessageState
@@ -4771,7 +5914,7 @@ type EventProposalPruned struct {
 
 	// proposal_id is the unique ID of the proposal.
 	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
-	// status is the proposal status (UNSPECIFIED, SUBMITTED, ACCEPTED, REJECTED, ABORTED, WITHDRAWN).
+	// status is the proposal status (UNSPECIFIED, SUBMITTED, ACCEPTED, REJECTED, ABORTED, WITHDRAWN, VETOED).
 	Status ProposalStatus `protobuf:"varint,2,opt,name=status,proto3,enum=cosmos.group.v1.ProposalStatus" json:"status,omitempty"`
 	// tally_result is the proposal tally result (when applicable).
 	TallyResult *TallyResult `protobuf:"bytes,3,opt,name=tally_result,json=tallyResult,proto3" json:"tally_result,omitempty"`
@@ -4780,7 +5923,7 @@ type EventProposalPruned struct {
 func (x *EventProposalPruned) Reset() {
 	*x = EventProposalPruned{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_group_v1_events_proto_msgTypes[9]
+		mi := &file_cosmos_group_v1_events_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4794,7 +5937,7 @@ func (*EventProposalPruned) ProtoMessage() {}
 
 // Deprecated: Use EventProposalPruned.ProtoReflect.Descriptor instead.
 func (*EventProposalPruned) Descriptor() ([]byte, []int) {
-	return file_cosmos_group_v1_events_proto_rawDescGZIP(), []int{9}
+	return file_cosmos_group_v1_events_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *EventProposalPruned) GetProposalId() uint64 {
@@ -4818,6 +5961,85 @@ func (x *EventProposalPruned) GetTallyResult() *TallyResult {
 	return nil
 }
 
+// EventProposalStatusChanged is an event emitted when a proposal is submitted
+// or changes status, with its tally result.
+//
+// Since: x/group v1.0.0
+type EventProposalStatusChanged struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// proposal_id is the unique ID of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// previous_status is the proposal status before the transition, or
+	// UNSPECIFIED when the proposal is submitted.
+	PreviousStatus ProposalStatus `protobuf:"varint,2,opt,name=previous_status,json=previousStatus,proto3,enum=cosmos.group.v1.ProposalStatus" json:"previous_status,omitempty"`
+	// status is the proposal status after the transition.
+	Status ProposalStatus `protobuf:"varint,3,opt,name=status,proto3,enum=cosmos.group.v1.ProposalStatus" json:"status,omitempty"`
+	// executor_result is the proposal executor result after the transition.
+	ExecutorResult ProposalExecutorResult `protobuf:"varint,4,opt,name=executor_result,json=executorResult,proto3,enum=cosmos.group.v1.ProposalExecutorResult" json:"executor_result,omitempty"`
+	// tally_result is the stored tally result of the proposal: its final tally
+	// result once it has been tallied, an empty tally result when it is
+	// withdrawn or aborted during its voting period.
+	TallyResult *TallyResult `protobuf:"bytes,5,opt,name=tally_result,json=tallyResult,proto3" json:"tally_result,omitempty"`
+}
+
+func (x *EventProposalStatusChanged) Reset() {
+	*x = EventProposalStatusChanged{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_group_v1_events_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventProposalStatusChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventProposalStatusChanged) ProtoMessage() {}
+
+// Deprecated: Use EventProposalStatusChanged.ProtoReflect.Descriptor instead.
+func (*EventProposalStatusChanged) Descriptor() ([]byte, []int) {
+	return file_cosmos_group_v1_events_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *EventProposalStatusChanged) GetProposalId() uint64 {
+	if x != nil {
+		return x.ProposalId
+	}
+	return 0
+}
+
+func (x *EventProposalStatusChanged) GetPreviousStatus() ProposalStatus {
+	if x != nil {
+		return x.PreviousStatus
+	}
+	return ProposalStatus_PROPOSAL_STATUS_UNSPECIFIED
+}
+
+func (x *EventProposalStatusChanged) GetStatus() ProposalStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ProposalStatus_PROPOSAL_STATUS_UNSPECIFIED
+}
+
+func (x *EventProposalStatusChanged) GetExecutorResult() ProposalExecutorResult {
+	if x != nil {
+		return x.ExecutorResult
+	}
+	return ProposalExecutorResult_PROPOSAL_EXECUTOR_RESULT_UNSPECIFIED
+}
+
+func (x *EventProposalStatusChanged) GetTallyResult() *TallyResult {
+	if x != nil {
+		return x.TallyResult
+	}
+	return nil
+}
+
 var File_cosmos_group_v1_events_proto protoreflect.FileDescriptor
 
 var file_cosmos_group_v1_events_proto_rawDesc = []byte{
@@ -4867,29 +6089,58 @@ var file_cosmos_group_v1_events_proto_rawDesc = []byte{
 	0x70, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
 	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xb0, 0x01, 0x0a, 0x13, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x12,
-	0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64,
-	0x12, 0x37, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x1f, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e,
-	0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3f, 0x0a, 0x0c, 0x74, 0x61, 0x6c,
-	0x6c, 0x79, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76,
-	0x31, 0x2e, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0b, 0x74,
-	0x61, 0x6c, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0xaa, 0x01, 0x0a, 0x13, 0x63,
-	0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e,
-	0x76, 0x31, 0x42, 0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
-	0x01, 0x5a, 0x28, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x2f, 0x76, 0x31, 0x3b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x47,
-	0x58, 0xaa, 0x02, 0x0f, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x2e, 0x56, 0x31, 0xca, 0x02, 0x0f, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1b, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x75, 0x0a, 0x11, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x56, 0x65, 0x74, 0x6f, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x1f, 0x0a, 0x0b,
+	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x3f, 0x0a,
+	0x0e, 0x76, 0x65, 0x74, 0x6f, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x0d, 0x76, 0x65, 0x74, 0x6f, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0xb0,
+	0x01, 0x0a, 0x13, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
+	0x50, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x3f, 0x0a, 0x0c, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x52, 0x0b, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x22, 0xd3, 0x02, 0x0a, 0x1a, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64,
+	0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49,
+	0x64, 0x12, 0x48, 0x0a, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0e, 0x70, 0x72, 0x65,
+	0x76, 0x69, 0x6f, 0x75, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x37, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x50, 0x0a, 0x0f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72,
+	0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3f, 0x0a, 0x0c, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x5f,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x61, 0x6c, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0b, 0x74, 0x61, 0x6c, 0x6c,
+	0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0xaa, 0x01, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x42,
+	0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x28,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x76, 0x31,
+	0x3b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x47, 0x58, 0xaa, 0x02,
+	0x0f, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x56, 0x31,
+	0xca, 0x02, 0x0f, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x5c,
+	0x56, 0x31, 0xe2, 0x02, 0x1b, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0xea, 0x02, 0x11, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -4904,31 +6155,37 @@ func file_cosmos_group_v1_events_proto_rawDescGZIP() []byte {
 	return file_cosmos_group_v1_events_proto_rawDescData
 }
 
-var file_cosmos_group_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_cosmos_group_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_cosmos_group_v1_events_proto_goTypes = []interface{}{
-	(*EventCreateGroup)(nil),       // 0: cosmos.group.v1.EventCreateGroup
-	(*EventUpdateGroup)(nil),       // 1: cosmos.group.v1.EventUpdateGroup
-	(*EventCreateGroupPolicy)(nil), // 2: cosmos.group.v1.EventCreateGroupPolicy
-	(*EventUpdateGroupPolicy)(nil), // 3: cosmos.group.v1.EventUpdateGroupPolicy
-	(*EventSubmitProposal)(nil),    // 4: cosmos.group.v1.EventSubmitProposal
-	(*EventWithdrawProposal)(nil),  // 5: cosmos.group.v1.EventWithdrawProposal
-	(*EventVote)(nil),              // 6: cosmos.group.v1.EventVote
-	(*EventExec)(nil),              // 7: cosmos.group.v1.EventExec
-	(*EventLeaveGroup)(nil),        // 8: cosmos.group.v1.EventLeaveGroup
-	(*EventProposalPruned)(nil),    // 9: cosmos.group.v1.EventProposalPruned
-	(ProposalExecutorResult)(0),    // 10: cosmos.group.v1.ProposalExecutorResult
-	(ProposalStatus)(0),            // 11: cosmos.group.v1.ProposalStatus
-	(*TallyResult)(nil),            // 12: cosmos.group.v1.TallyResult
+	(*EventCreateGroup)(nil),           // 0: cosmos.group.v1.EventCreateGroup
+	(*EventUpdateGroup)(nil),           // 1: cosmos.group.v1.EventUpdateGroup
+	(*EventCreateGroupPolicy)(nil),     // 2: cosmos.group.v1.EventCreateGroupPolicy
+	(*EventUpdateGroupPolicy)(nil),     // 3: cosmos.group.v1.EventUpdateGroupPolicy
+	(*EventSubmitProposal)(nil),        // 4: cosmos.group.v1.EventSubmitProposal
+	(*EventWithdrawProposal)(nil),      // 5: cosmos.group.v1.EventWithdrawProposal
+	(*EventVote)(nil),                  // 6: cosmos.group.v1.EventVote
+	(*EventExec)(nil),                  // 7: cosmos.group.v1.EventExec
+	(*EventLeaveGroup)(nil),            // 8: cosmos.group.v1.EventLeaveGroup
+	(*EventVetoProposal)(nil),          // 9: cosmos.group.v1.EventVetoProposal
+	(*EventProposalPruned)(nil),        // 10: cosmos.group.v1.EventProposalPruned
+	(*EventProposalStatusChanged)(nil), // 11: cosmos.group.v1.EventProposalStatusChanged
+	(ProposalExecutorResult)(0),        // 12: cosmos.group.v1.ProposalExecutorResult
+	(ProposalStatus)(0),                // 13: cosmos.group.v1.ProposalStatus
+	(*TallyResult)(nil),                // 14: cosmos.group.v1.TallyResult
 }
 var file_cosmos_group_v1_events_proto_depIdxs = []int32{
-	10, // 0: cosmos.group.v1.EventExec.result:type_name -> cosmos.group.v1.ProposalExecutorResult
-	11, // 1: cosmos.group.v1.EventProposalPruned.status:type_name -> cosmos.group.v1.ProposalStatus
-	12, // 2: cosmos.group.v1.EventProposalPruned.tally_result:type_name -> cosmos.group.v1.TallyResult
-	3,  // [3:3] is the sub-list for method output_type
-	3,  // [3:3] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	12, // 0: cosmos.group.v1.EventExec.result:type_name -> cosmos.group.v1.ProposalExecutorResult
+	13, // 1: cosmos.group.v1.EventProposalPruned.status:type_name -> cosmos.group.v1.ProposalStatus
+	14, // 2: cosmos.group.v1.EventProposalPruned.tally_result:type_name -> cosmos.group.v1.TallyResult
+	13, // 3: cosmos.group.v1.EventProposalStatusChanged.previous_status:type_name -> cosmos.group.v1.ProposalStatus
+	13, // 4: cosmos.group.v1.EventProposalStatusChanged.status:type_name -> cosmos.group.v1.ProposalStatus
+	12, // 5: cosmos.group.v1.EventProposalStatusChanged.executor_result:type_name -> cosmos.group.v1.ProposalExecutorResult
+	14, // 6: cosmos.group.v1.EventProposalStatusChanged.tally_result:type_name -> cosmos.group.v1.TallyResult
+	7,  // [7:7] is the sub-list for method output_type
+	7,  // [7:7] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_group_v1_events_proto_init() }
@@ -5047,6 +6304,18 @@ func file_cosmos_group_v1_events_proto_init() {
 			}
 		}
 		file_cosmos_group_v1_events_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventVetoProposal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_group_v1_events_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EventProposalPruned); i {
 			case 0:
 				return &v.state
@@ -5058,6 +6327,18 @@ func file_cosmos_group_v1_events_proto_init() {
 				return nil
 			}
 		}
+		file_cosmos_group_v1_events_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventProposalStatusChanged); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -5065,7 +6346,7 @@ func file_cosmos_group_v1_events_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_group_v1_events_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -35,6 +35,7 @@ const (
 	Query_GroupsByMember_FullMethodName         = "/cosmos.group.v1.Query/GroupsByMember"
 	Query_TallyResult_FullMethodName            = "/cosmos.group.v1.Query/TallyResult"
 	Query_Groups_FullMethodName                 = "/cosmos.group.v1.Query/Groups"
+	Query_ProposalsByStatus_FullMethodName      = "/cosmos.group.v1.Query/ProposalsByStatus"
 )
 
 // QueryClient is the client API for Query service.
@@ -75,6 +76,10 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.47.1
 	Groups(ctx context.Context, in *QueryGroupsRequest, opts ...grpc.CallOption) (*QueryGroupsResponse, error)
+	// ProposalsByStatus queries proposals based on their status.
+	//
+	// Since: x/group v1.0.0
+	ProposalsByStatus(ctx context.Context, in *QueryProposalsByStatusRequest, opts ...grpc.CallOption) (*QueryProposalsByStatusResponse, error)
 }
 
 type queryClient struct {
@@ -211,6 +216,15 @@ func (c *queryClient) Groups(ctx context.Context, in *QueryGroupsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) ProposalsByStatus(ctx context.Context, in *QueryProposalsByStatusRequest, opts ...grpc.CallOption) (*QueryProposalsByStatusResponse, error) {
+	out := new(QueryProposalsByStatusResponse)
+	err := c.cc.Invoke(ctx, Query_ProposalsByStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -249,6 +263,10 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.47.1
 	Groups(context.Context, *QueryGroupsRequest) (*QueryGroupsResponse, error)
+	// ProposalsByStatus queries proposals based on their status.
+	//
+	// Since: x/group v1.0.0
+	ProposalsByStatus(context.Context, *QueryProposalsByStatusRequest) (*QueryProposalsByStatusResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -298,6 +316,9 @@ func (UnimplementedQueryServer) TallyResult(context.Context, *QueryTallyResultRe
 func (UnimplementedQueryServer) Groups(context.Context, *QueryGroupsRequest) (*QueryGroupsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Groups not implemented")
 }
+func (UnimplementedQueryServer) ProposalsByStatus(context.Context, *QueryProposalsByStatusRequest) (*QueryProposalsByStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalsByStatus not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -563,6 +584,24 @@ func _Query_Groups_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ProposalsByStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalsByStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalsByStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProposalsByStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalsByStatus(ctx, req.(*QueryProposalsByStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -626,6 +665,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Groups",
 			Handler:    _Query_Groups_Handler,
 		},
+		{
+			MethodName: "ProposalsByStatus",
+			Handler:    _Query_ProposalsByStatus_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/group/v1/query.proto",
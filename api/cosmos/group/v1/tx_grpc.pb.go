@@ -30,10 +30,14 @@ const (
 	Msg_UpdateGroupPolicyAdmin_FullMethodName          = "/cosmos.group.v1.Msg/UpdateGroupPolicyAdmin"
 	Msg_UpdateGroupPolicyDecisionPolicy_FullMethodName = "/cosmos.group.v1.Msg/UpdateGroupPolicyDecisionPolicy"
 	Msg_UpdateGroupPolicyMetadata_FullMethodName       = "/cosmos.group.v1.Msg/UpdateGroupPolicyMetadata"
+	Msg_UpdateGroupPolicyVetoAuthority_FullMethodName  = "/cosmos.group.v1.Msg/UpdateGroupPolicyVetoAuthority"
+	Msg_UpdateGroupPolicySpendLimit_FullMethodName     = "/cosmos.group.v1.Msg/UpdateGroupPolicySpendLimit"
 	Msg_SubmitProposal_FullMethodName                  = "/cosmos.group.v1.Msg/SubmitProposal"
 	Msg_WithdrawProposal_FullMethodName                = "/cosmos.group.v1.Msg/WithdrawProposal"
 	Msg_Vote_FullMethodName                            = "/cosmos.group.v1.Msg/Vote"
+	Msg_VoteBatch_FullMethodName                       = "/cosmos.group.v1.Msg/VoteBatch"
 	Msg_Exec_FullMethodName                            = "/cosmos.group.v1.Msg/Exec"
+	Msg_VetoProposal_FullMethodName                    = "/cosmos.group.v1.Msg/VetoProposal"
 	Msg_LeaveGroup_FullMethodName                      = "/cosmos.group.v1.Msg/LeaveGroup"
 )
 
@@ -59,14 +63,32 @@ type MsgClient interface {
 	UpdateGroupPolicyDecisionPolicy(ctx context.Context, in *MsgUpdateGroupPolicyDecisionPolicy, opts ...grpc.CallOption) (*MsgUpdateGroupPolicyDecisionPolicyResponse, error)
 	// UpdateGroupPolicyMetadata updates a group policy metadata.
 	UpdateGroupPolicyMetadata(ctx context.Context, in *MsgUpdateGroupPolicyMetadata, opts ...grpc.CallOption) (*MsgUpdateGroupPolicyMetadataResponse, error)
+	// UpdateGroupPolicyVetoAuthority updates a group policy veto authority.
+	//
+	// Since: x/group v1.0.0
+	UpdateGroupPolicyVetoAuthority(ctx context.Context, in *MsgUpdateGroupPolicyVetoAuthority, opts ...grpc.CallOption) (*MsgUpdateGroupPolicyVetoAuthorityResponse, error)
+	// UpdateGroupPolicySpendLimit updates a group policy spend limit.
+	//
+	// Since: x/group v1.0.0
+	UpdateGroupPolicySpendLimit(ctx context.Context, in *MsgUpdateGroupPolicySpendLimit, opts ...grpc.CallOption) (*MsgUpdateGroupPolicySpendLimitResponse, error)
 	// SubmitProposal submits a new proposal.
 	SubmitProposal(ctx context.Context, in *MsgSubmitProposal, opts ...grpc.CallOption) (*MsgSubmitProposalResponse, error)
 	// WithdrawProposal withdraws a proposal.
 	WithdrawProposal(ctx context.Context, in *MsgWithdrawProposal, opts ...grpc.CallOption) (*MsgWithdrawProposalResponse, error)
 	// Vote allows a voter to vote on a proposal.
 	Vote(ctx context.Context, in *MsgVote, opts ...grpc.CallOption) (*MsgVoteResponse, error)
+	// VoteBatch allows a voter to vote on several proposals of the same group
+	// policy at once.
+	//
+	// Since: x/group v1.0.0
+	VoteBatch(ctx context.Context, in *MsgVoteBatch, opts ...grpc.CallOption) (*MsgVoteBatchResponse, error)
 	// Exec executes a proposal.
 	Exec(ctx context.Context, in *MsgExec, opts ...grpc.CallOption) (*MsgExecResponse, error)
+	// VetoProposal allows the veto authority of a group policy to veto an
+	// accepted proposal which is not executed yet.
+	//
+	// Since: x/group v1.0.0
+	VetoProposal(ctx context.Context, in *MsgVetoProposal, opts ...grpc.CallOption) (*MsgVetoProposalResponse, error)
 	// LeaveGroup allows a group member to leave the group.
 	LeaveGroup(ctx context.Context, in *MsgLeaveGroup, opts ...grpc.CallOption) (*MsgLeaveGroupResponse, error)
 }
@@ -160,6 +182,24 @@ func (c *msgClient) UpdateGroupPolicyMetadata(ctx context.Context, in *MsgUpdate
 	return out, nil
 }
 
+func (c *msgClient) UpdateGroupPolicyVetoAuthority(ctx context.Context, in *MsgUpdateGroupPolicyVetoAuthority, opts ...grpc.CallOption) (*MsgUpdateGroupPolicyVetoAuthorityResponse, error) {
+	out := new(MsgUpdateGroupPolicyVetoAuthorityResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateGroupPolicyVetoAuthority_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateGroupPolicySpendLimit(ctx context.Context, in *MsgUpdateGroupPolicySpendLimit, opts ...grpc.CallOption) (*MsgUpdateGroupPolicySpendLimitResponse, error) {
+	out := new(MsgUpdateGroupPolicySpendLimitResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateGroupPolicySpendLimit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) SubmitProposal(ctx context.Context, in *MsgSubmitProposal, opts ...grpc.CallOption) (*MsgSubmitProposalResponse, error) {
 	out := new(MsgSubmitProposalResponse)
 	err := c.cc.Invoke(ctx, Msg_SubmitProposal_FullMethodName, in, out, opts...)
@@ -187,6 +227,15 @@ func (c *msgClient) Vote(ctx context.Context, in *MsgVote, opts ...grpc.CallOpti
 	return out, nil
 }
 
+func (c *msgClient) VoteBatch(ctx context.Context, in *MsgVoteBatch, opts ...grpc.CallOption) (*MsgVoteBatchResponse, error) {
+	out := new(MsgVoteBatchResponse)
+	err := c.cc.Invoke(ctx, Msg_VoteBatch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) Exec(ctx context.Context, in *MsgExec, opts ...grpc.CallOption) (*MsgExecResponse, error) {
 	out := new(MsgExecResponse)
 	err := c.cc.Invoke(ctx, Msg_Exec_FullMethodName, in, out, opts...)
@@ -196,6 +245,15 @@ func (c *msgClient) Exec(ctx context.Context, in *MsgExec, opts ...grpc.CallOpti
 	return out, nil
 }
 
+func (c *msgClient) VetoProposal(ctx context.Context, in *MsgVetoProposal, opts ...grpc.CallOption) (*MsgVetoProposalResponse, error) {
+	out := new(MsgVetoProposalResponse)
+	err := c.cc.Invoke(ctx, Msg_VetoProposal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) LeaveGroup(ctx context.Context, in *MsgLeaveGroup, opts ...grpc.CallOption) (*MsgLeaveGroupResponse, error) {
 	out := new(MsgLeaveGroupResponse)
 	err := c.cc.Invoke(ctx, Msg_LeaveGroup_FullMethodName, in, out, opts...)
@@ -227,14 +285,32 @@ type MsgServer interface {
 	UpdateGroupPolicyDecisionPolicy(context.Context, *MsgUpdateGroupPolicyDecisionPolicy) (*MsgUpdateGroupPolicyDecisionPolicyResponse, error)
 	// UpdateGroupPolicyMetadata updates a group policy metadata.
 	UpdateGroupPolicyMetadata(context.Context, *MsgUpdateGroupPolicyMetadata) (*MsgUpdateGroupPolicyMetadataResponse, error)
+	// UpdateGroupPolicyVetoAuthority updates a group policy veto authority.
+	//
+	// Since: x/group v1.0.0
+	UpdateGroupPolicyVetoAuthority(context.Context, *MsgUpdateGroupPolicyVetoAuthority) (*MsgUpdateGroupPolicyVetoAuthorityResponse, error)
+	// UpdateGroupPolicySpendLimit updates a group policy spend limit.
+	//
+	// Since: x/group v1.0.0
+	UpdateGroupPolicySpendLimit(context.Context, *MsgUpdateGroupPolicySpendLimit) (*MsgUpdateGroupPolicySpendLimitResponse, error)
 	// SubmitProposal submits a new proposal.
 	SubmitProposal(context.Context, *MsgSubmitProposal) (*MsgSubmitProposalResponse, error)
 	// WithdrawProposal withdraws a proposal.
 	WithdrawProposal(context.Context, *MsgWithdrawProposal) (*MsgWithdrawProposalResponse, error)
 	// Vote allows a voter to vote on a proposal.
 	Vote(context.Context, *MsgVote) (*MsgVoteResponse, error)
+	// VoteBatch allows a voter to vote on several proposals of the same group
+	// policy at once.
+	//
+	// Since: x/group v1.0.0
+	VoteBatch(context.Context, *MsgVoteBatch) (*MsgVoteBatchResponse, error)
 	// Exec executes a proposal.
 	Exec(context.Context, *MsgExec) (*MsgExecResponse, error)
+	// VetoProposal allows the veto authority of a group policy to veto an
+	// accepted proposal which is not executed yet.
+	//
+	// Since: x/group v1.0.0
+	VetoProposal(context.Context, *MsgVetoProposal) (*MsgVetoProposalResponse, error)
 	// LeaveGroup allows a group member to leave the group.
 	LeaveGroup(context.Context, *MsgLeaveGroup) (*MsgLeaveGroupResponse, error)
 	mustEmbedUnimplementedMsgServer()
@@ -271,6 +347,12 @@ func (UnimplementedMsgServer) UpdateGroupPolicyDecisionPolicy(context.Context, *
 func (UnimplementedMsgServer) UpdateGroupPolicyMetadata(context.Context, *MsgUpdateGroupPolicyMetadata) (*MsgUpdateGroupPolicyMetadataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroupPolicyMetadata not implemented")
 }
+func (UnimplementedMsgServer) UpdateGroupPolicyVetoAuthority(context.Context, *MsgUpdateGroupPolicyVetoAuthority) (*MsgUpdateGroupPolicyVetoAuthorityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroupPolicyVetoAuthority not implemented")
+}
+func (UnimplementedMsgServer) UpdateGroupPolicySpendLimit(context.Context, *MsgUpdateGroupPolicySpendLimit) (*MsgUpdateGroupPolicySpendLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroupPolicySpendLimit not implemented")
+}
 func (UnimplementedMsgServer) SubmitProposal(context.Context, *MsgSubmitProposal) (*MsgSubmitProposalResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitProposal not implemented")
 }
@@ -280,9 +362,15 @@ func (UnimplementedMsgServer) WithdrawProposal(context.Context, *MsgWithdrawProp
 func (UnimplementedMsgServer) Vote(context.Context, *MsgVote) (*MsgVoteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Vote not implemented")
 }
+func (UnimplementedMsgServer) VoteBatch(context.Context, *MsgVoteBatch) (*MsgVoteBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteBatch not implemented")
+}
 func (UnimplementedMsgServer) Exec(context.Context, *MsgExec) (*MsgExecResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Exec not implemented")
 }
+func (UnimplementedMsgServer) VetoProposal(context.Context, *MsgVetoProposal) (*MsgVetoProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VetoProposal not implemented")
+}
 func (UnimplementedMsgServer) LeaveGroup(context.Context, *MsgLeaveGroup) (*MsgLeaveGroupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LeaveGroup not implemented")
 }
@@ -461,6 +549,42 @@ func _Msg_UpdateGroupPolicyMetadata_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateGroupPolicyVetoAuthority_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateGroupPolicyVetoAuthority)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateGroupPolicyVetoAuthority(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateGroupPolicyVetoAuthority_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateGroupPolicyVetoAuthority(ctx, req.(*MsgUpdateGroupPolicyVetoAuthority))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateGroupPolicySpendLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateGroupPolicySpendLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateGroupPolicySpendLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateGroupPolicySpendLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateGroupPolicySpendLimit(ctx, req.(*MsgUpdateGroupPolicySpendLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_SubmitProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgSubmitProposal)
 	if err := dec(in); err != nil {
@@ -515,6 +639,24 @@ func _Msg_Vote_Handler(srv interface{}, ctx context.Context, dec func(interface{
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_VoteBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVoteBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VoteBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_VoteBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VoteBatch(ctx, req.(*MsgVoteBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_Exec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgExec)
 	if err := dec(in); err != nil {
@@ -533,6 +675,24 @@ func _Msg_Exec_Handler(srv interface{}, ctx context.Context, dec func(interface{
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_VetoProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVetoProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VetoProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_VetoProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VetoProposal(ctx, req.(*MsgVetoProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_LeaveGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgLeaveGroup)
 	if err := dec(in); err != nil {
@@ -594,6 +754,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateGroupPolicyMetadata",
 			Handler:    _Msg_UpdateGroupPolicyMetadata_Handler,
 		},
+		{
+			MethodName: "UpdateGroupPolicyVetoAuthority",
+			Handler:    _Msg_UpdateGroupPolicyVetoAuthority_Handler,
+		},
+		{
+			MethodName: "UpdateGroupPolicySpendLimit",
+			Handler:    _Msg_UpdateGroupPolicySpendLimit_Handler,
+		},
 		{
 			MethodName: "SubmitProposal",
 			Handler:    _Msg_SubmitProposal_Handler,
@@ -606,10 +774,18 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Vote",
 			Handler:    _Msg_Vote_Handler,
 		},
+		{
+			MethodName: "VoteBatch",
+			Handler:    _Msg_VoteBatch_Handler,
+		},
 		{
 			MethodName: "Exec",
 			Handler:    _Msg_Exec_Handler,
 		},
+		{
+			MethodName: "VetoProposal",
+			Handler:    _Msg_VetoProposal_Handler,
+		},
 		{
 			MethodName: "LeaveGroup",
 			Handler:    _Msg_LeaveGroup_Handler,
@@ -19,7 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_Send_FullMethodName = "/cosmos.nft.v1beta1.Msg/Send"
+	Msg_Send_FullMethodName          = "/cosmos.nft.v1beta1.Msg/Send"
+	Msg_UpdateClass_FullMethodName   = "/cosmos.nft.v1beta1.Msg/UpdateClass"
+	Msg_BatchMint_FullMethodName     = "/cosmos.nft.v1beta1.Msg/BatchMint"
+	Msg_BatchTransfer_FullMethodName = "/cosmos.nft.v1beta1.Msg/BatchTransfer"
+	Msg_BatchBurn_FullMethodName     = "/cosmos.nft.v1beta1.Msg/BatchBurn"
+	Msg_UpdateParams_FullMethodName  = "/cosmos.nft.v1beta1.Msg/UpdateParams"
 )
 
 // MsgClient is the client API for Msg service.
@@ -28,6 +33,26 @@ const (
 type MsgClient interface {
 	// Send defines a method to send a nft from one account to another account.
 	Send(ctx context.Context, in *MsgSend, opts ...grpc.CallOption) (*MsgSendResponse, error)
+	// UpdateClass defines a method for the admin of a nft class to update it.
+	//
+	// Since: x/nft v0.2.0
+	UpdateClass(ctx context.Context, in *MsgUpdateClass, opts ...grpc.CallOption) (*MsgUpdateClassResponse, error)
+	// BatchMint defines a method for the admin of nft classes to mint a batch of nfts at once.
+	//
+	// Since: x/nft v0.2.0
+	BatchMint(ctx context.Context, in *MsgBatchMint, opts ...grpc.CallOption) (*MsgBatchMintResponse, error)
+	// BatchTransfer defines a method to send a batch of nfts of a class from one account to another account.
+	//
+	// Since: x/nft v0.2.0
+	BatchTransfer(ctx context.Context, in *MsgBatchTransfer, opts ...grpc.CallOption) (*MsgBatchTransferResponse, error)
+	// BatchBurn defines a method for the owner of a batch of nfts of a class to burn them.
+	//
+	// Since: x/nft v0.2.0
+	BatchBurn(ctx context.Context, in *MsgBatchBurn, opts ...grpc.CallOption) (*MsgBatchBurnResponse, error)
+	// UpdateParams defines a governance operation for updating the nft module parameters.
+	//
+	// Since: x/nft v0.2.0
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -47,12 +72,77 @@ func (c *msgClient) Send(ctx context.Context, in *MsgSend, opts ...grpc.CallOpti
 	return out, nil
 }
 
+func (c *msgClient) UpdateClass(ctx context.Context, in *MsgUpdateClass, opts ...grpc.CallOption) (*MsgUpdateClassResponse, error) {
+	out := new(MsgUpdateClassResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateClass_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BatchMint(ctx context.Context, in *MsgBatchMint, opts ...grpc.CallOption) (*MsgBatchMintResponse, error) {
+	out := new(MsgBatchMintResponse)
+	err := c.cc.Invoke(ctx, Msg_BatchMint_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BatchTransfer(ctx context.Context, in *MsgBatchTransfer, opts ...grpc.CallOption) (*MsgBatchTransferResponse, error) {
+	out := new(MsgBatchTransferResponse)
+	err := c.cc.Invoke(ctx, Msg_BatchTransfer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BatchBurn(ctx context.Context, in *MsgBatchBurn, opts ...grpc.CallOption) (*MsgBatchBurnResponse, error) {
+	out := new(MsgBatchBurnResponse)
+	err := c.cc.Invoke(ctx, Msg_BatchBurn_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
 type MsgServer interface {
 	// Send defines a method to send a nft from one account to another account.
 	Send(context.Context, *MsgSend) (*MsgSendResponse, error)
+	// UpdateClass defines a method for the admin of a nft class to update it.
+	//
+	// Since: x/nft v0.2.0
+	UpdateClass(context.Context, *MsgUpdateClass) (*MsgUpdateClassResponse, error)
+	// BatchMint defines a method for the admin of nft classes to mint a batch of nfts at once.
+	//
+	// Since: x/nft v0.2.0
+	BatchMint(context.Context, *MsgBatchMint) (*MsgBatchMintResponse, error)
+	// BatchTransfer defines a method to send a batch of nfts of a class from one account to another account.
+	//
+	// Since: x/nft v0.2.0
+	BatchTransfer(context.Context, *MsgBatchTransfer) (*MsgBatchTransferResponse, error)
+	// BatchBurn defines a method for the owner of a batch of nfts of a class to burn them.
+	//
+	// Since: x/nft v0.2.0
+	BatchBurn(context.Context, *MsgBatchBurn) (*MsgBatchBurnResponse, error)
+	// UpdateParams defines a governance operation for updating the nft module parameters.
+	//
+	// Since: x/nft v0.2.0
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -63,6 +153,21 @@ type UnimplementedMsgServer struct {
 func (UnimplementedMsgServer) Send(context.Context, *MsgSend) (*MsgSendResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Send not implemented")
 }
+func (UnimplementedMsgServer) UpdateClass(context.Context, *MsgUpdateClass) (*MsgUpdateClassResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateClass not implemented")
+}
+func (UnimplementedMsgServer) BatchMint(context.Context, *MsgBatchMint) (*MsgBatchMintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchMint not implemented")
+}
+func (UnimplementedMsgServer) BatchTransfer(context.Context, *MsgBatchTransfer) (*MsgBatchTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchTransfer not implemented")
+}
+func (UnimplementedMsgServer) BatchBurn(context.Context, *MsgBatchBurn) (*MsgBatchBurnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchBurn not implemented")
+}
+func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -94,6 +199,96 @@ func _Msg_Send_Handler(srv interface{}, ctx context.Context, dec func(interface{
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateClass)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateClass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateClass_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateClass(ctx, req.(*MsgUpdateClass))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BatchMint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchMint)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BatchMint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_BatchMint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchMint(ctx, req.(*MsgBatchMint))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BatchTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BatchTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_BatchTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchTransfer(ctx, req.(*MsgBatchTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BatchBurn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchBurn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BatchBurn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_BatchBurn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchBurn(ctx, req.(*MsgBatchBurn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -105,6 +300,26 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Send",
 			Handler:    _Msg_Send_Handler,
 		},
+		{
+			MethodName: "UpdateClass",
+			Handler:    _Msg_UpdateClass_Handler,
+		},
+		{
+			MethodName: "BatchMint",
+			Handler:    _Msg_BatchMint_Handler,
+		},
+		{
+			MethodName: "BatchTransfer",
+			Handler:    _Msg_BatchTransfer_Handler,
+		},
+		{
+			MethodName: "BatchBurn",
+			Handler:    _Msg_BatchBurn_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/nft/v1beta1/tx.proto",
@@ -21,6 +21,8 @@ const _ = grpc.SupportPackageIsVersion7
 const (
 	Query_CommunityPool_FullMethodName   = "/cosmos.protocolpool.v1.Query/CommunityPool"
 	Query_UnclaimedBudget_FullMethodName = "/cosmos.protocolpool.v1.Query/UnclaimedBudget"
+	Query_FundingStream_FullMethodName   = "/cosmos.protocolpool.v1.Query/FundingStream"
+	Query_FundingStreams_FullMethodName  = "/cosmos.protocolpool.v1.Query/FundingStreams"
 )
 
 // QueryClient is the client API for Query service.
@@ -31,6 +33,10 @@ type QueryClient interface {
 	CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error)
 	// UnclaimedBudget queries the remaining budget left to be claimed and it gives overall budget allocation view.
 	UnclaimedBudget(ctx context.Context, in *QueryUnclaimedBudgetRequest, opts ...grpc.CallOption) (*QueryUnclaimedBudgetResponse, error)
+	// FundingStream queries the funding stream of a recipient.
+	FundingStream(ctx context.Context, in *QueryFundingStreamRequest, opts ...grpc.CallOption) (*QueryFundingStreamResponse, error)
+	// FundingStreams queries all the funding streams.
+	FundingStreams(ctx context.Context, in *QueryFundingStreamsRequest, opts ...grpc.CallOption) (*QueryFundingStreamsResponse, error)
 }
 
 type queryClient struct {
@@ -59,6 +65,24 @@ func (c *queryClient) UnclaimedBudget(ctx context.Context, in *QueryUnclaimedBud
 	return out, nil
 }
 
+func (c *queryClient) FundingStream(ctx context.Context, in *QueryFundingStreamRequest, opts ...grpc.CallOption) (*QueryFundingStreamResponse, error) {
+	out := new(QueryFundingStreamResponse)
+	err := c.cc.Invoke(ctx, Query_FundingStream_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FundingStreams(ctx context.Context, in *QueryFundingStreamsRequest, opts ...grpc.CallOption) (*QueryFundingStreamsResponse, error) {
+	out := new(QueryFundingStreamsResponse)
+	err := c.cc.Invoke(ctx, Query_FundingStreams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -67,6 +91,10 @@ type QueryServer interface {
 	CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error)
 	// UnclaimedBudget queries the remaining budget left to be claimed and it gives overall budget allocation view.
 	UnclaimedBudget(context.Context, *QueryUnclaimedBudgetRequest) (*QueryUnclaimedBudgetResponse, error)
+	// FundingStream queries the funding stream of a recipient.
+	FundingStream(context.Context, *QueryFundingStreamRequest) (*QueryFundingStreamResponse, error)
+	// FundingStreams queries all the funding streams.
+	FundingStreams(context.Context, *QueryFundingStreamsRequest) (*QueryFundingStreamsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -80,6 +108,12 @@ func (UnimplementedQueryServer) CommunityPool(context.Context, *QueryCommunityPo
 func (UnimplementedQueryServer) UnclaimedBudget(context.Context, *QueryUnclaimedBudgetRequest) (*QueryUnclaimedBudgetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnclaimedBudget not implemented")
 }
+func (UnimplementedQueryServer) FundingStream(context.Context, *QueryFundingStreamRequest) (*QueryFundingStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundingStream not implemented")
+}
+func (UnimplementedQueryServer) FundingStreams(context.Context, *QueryFundingStreamsRequest) (*QueryFundingStreamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundingStreams not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -129,6 +163,42 @@ func _Query_UnclaimedBudget_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FundingStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFundingStreamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FundingStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FundingStream_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FundingStream(ctx, req.(*QueryFundingStreamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FundingStreams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFundingStreamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FundingStreams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FundingStreams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FundingStreams(ctx, req.(*QueryFundingStreamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -144,6 +214,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UnclaimedBudget",
 			Handler:    _Query_UnclaimedBudget_Handler,
 		},
+		{
+			MethodName: "FundingStream",
+			Handler:    _Query_FundingStream_Handler,
+		},
+		{
+			MethodName: "FundingStreams",
+			Handler:    _Query_FundingStreams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/protocolpool/v1/query.proto",
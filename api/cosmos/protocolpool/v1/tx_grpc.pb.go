@@ -26,6 +26,8 @@ const (
 	Msg_CreateContinuousFund_FullMethodName   = "/cosmos.protocolpool.v1.Msg/CreateContinuousFund"
 	Msg_WithdrawContinuousFund_FullMethodName = "/cosmos.protocolpool.v1.Msg/WithdrawContinuousFund"
 	Msg_CancelContinuousFund_FullMethodName   = "/cosmos.protocolpool.v1.Msg/CancelContinuousFund"
+	Msg_CreateFundingStream_FullMethodName    = "/cosmos.protocolpool.v1.Msg/CreateFundingStream"
+	Msg_CancelFundingStream_FullMethodName    = "/cosmos.protocolpool.v1.Msg/CancelFundingStream"
 )
 
 // MsgClient is the client API for Msg service.
@@ -50,6 +52,12 @@ type MsgClient interface {
 	WithdrawContinuousFund(ctx context.Context, in *MsgWithdrawContinuousFund, opts ...grpc.CallOption) (*MsgWithdrawContinuousFundResponse, error)
 	// CancelContinuousFund defines a method for cancelling continuous fund.
 	CancelContinuousFund(ctx context.Context, in *MsgCancelContinuousFund, opts ...grpc.CallOption) (*MsgCancelContinuousFundResponse, error)
+	// CreateFundingStream defines a governance operation for registering a
+	// recurring grant paid out of the community pool to a recipient every period.
+	CreateFundingStream(ctx context.Context, in *MsgCreateFundingStream, opts ...grpc.CallOption) (*MsgCreateFundingStreamResponse, error)
+	// CancelFundingStream defines a governance operation for cancelling the
+	// funding stream of a recipient.
+	CancelFundingStream(ctx context.Context, in *MsgCancelFundingStream, opts ...grpc.CallOption) (*MsgCancelFundingStreamResponse, error)
 }
 
 type msgClient struct {
@@ -123,6 +131,24 @@ func (c *msgClient) CancelContinuousFund(ctx context.Context, in *MsgCancelConti
 	return out, nil
 }
 
+func (c *msgClient) CreateFundingStream(ctx context.Context, in *MsgCreateFundingStream, opts ...grpc.CallOption) (*MsgCreateFundingStreamResponse, error) {
+	out := new(MsgCreateFundingStreamResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateFundingStream_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelFundingStream(ctx context.Context, in *MsgCancelFundingStream, opts ...grpc.CallOption) (*MsgCancelFundingStreamResponse, error) {
+	out := new(MsgCancelFundingStreamResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelFundingStream_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -145,6 +171,12 @@ type MsgServer interface {
 	WithdrawContinuousFund(context.Context, *MsgWithdrawContinuousFund) (*MsgWithdrawContinuousFundResponse, error)
 	// CancelContinuousFund defines a method for cancelling continuous fund.
 	CancelContinuousFund(context.Context, *MsgCancelContinuousFund) (*MsgCancelContinuousFundResponse, error)
+	// CreateFundingStream defines a governance operation for registering a
+	// recurring grant paid out of the community pool to a recipient every period.
+	CreateFundingStream(context.Context, *MsgCreateFundingStream) (*MsgCreateFundingStreamResponse, error)
+	// CancelFundingStream defines a governance operation for cancelling the
+	// funding stream of a recipient.
+	CancelFundingStream(context.Context, *MsgCancelFundingStream) (*MsgCancelFundingStreamResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -173,6 +205,12 @@ func (UnimplementedMsgServer) WithdrawContinuousFund(context.Context, *MsgWithdr
 func (UnimplementedMsgServer) CancelContinuousFund(context.Context, *MsgCancelContinuousFund) (*MsgCancelContinuousFundResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelContinuousFund not implemented")
 }
+func (UnimplementedMsgServer) CreateFundingStream(context.Context, *MsgCreateFundingStream) (*MsgCreateFundingStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFundingStream not implemented")
+}
+func (UnimplementedMsgServer) CancelFundingStream(context.Context, *MsgCancelFundingStream) (*MsgCancelFundingStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelFundingStream not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -312,6 +350,42 @@ func _Msg_CancelContinuousFund_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CreateFundingStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateFundingStream)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateFundingStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateFundingStream_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateFundingStream(ctx, req.(*MsgCreateFundingStream))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelFundingStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelFundingStream)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelFundingStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelFundingStream_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelFundingStream(ctx, req.(*MsgCancelFundingStream))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -347,6 +421,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelContinuousFund",
 			Handler:    _Msg_CancelContinuousFund_Handler,
 		},
+		{
+			MethodName: "CreateFundingStream",
+			Handler:    _Msg_CreateFundingStream_Handler,
+		},
+		{
+			MethodName: "CancelFundingStream",
+			Handler:    _Msg_CancelFundingStream_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/protocolpool/v1/tx.proto",
@@ -19,9 +19,10 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName       = "/cosmos.slashing.v1beta1.Query/Params"
-	Query_SigningInfo_FullMethodName  = "/cosmos.slashing.v1beta1.Query/SigningInfo"
-	Query_SigningInfos_FullMethodName = "/cosmos.slashing.v1beta1.Query/SigningInfos"
+	Query_Params_FullMethodName               = "/cosmos.slashing.v1beta1.Query/Params"
+	Query_SigningInfo_FullMethodName          = "/cosmos.slashing.v1beta1.Query/SigningInfo"
+	Query_SigningInfos_FullMethodName         = "/cosmos.slashing.v1beta1.Query/SigningInfos"
+	Query_SigningInfosExtended_FullMethodName = "/cosmos.slashing.v1beta1.Query/SigningInfosExtended"
 )
 
 // QueryClient is the client API for Query service.
@@ -34,6 +35,11 @@ type QueryClient interface {
 	SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(ctx context.Context, in *QuerySigningInfosRequest, opts ...grpc.CallOption) (*QuerySigningInfosResponse, error)
+	// SigningInfosExtended queries the signing info of all validators along with
+	// their uptime and missed block streaks.
+	//
+	// Since: cosmos-sdk 0.51
+	SigningInfosExtended(ctx context.Context, in *QuerySigningInfosExtendedRequest, opts ...grpc.CallOption) (*QuerySigningInfosExtendedResponse, error)
 }
 
 type queryClient struct {
@@ -71,6 +77,15 @@ func (c *queryClient) SigningInfos(ctx context.Context, in *QuerySigningInfosReq
 	return out, nil
 }
 
+func (c *queryClient) SigningInfosExtended(ctx context.Context, in *QuerySigningInfosExtendedRequest, opts ...grpc.CallOption) (*QuerySigningInfosExtendedResponse, error) {
+	out := new(QuerySigningInfosExtendedResponse)
+	err := c.cc.Invoke(ctx, Query_SigningInfosExtended_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -81,6 +96,11 @@ type QueryServer interface {
 	SigningInfo(context.Context, *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error)
+	// SigningInfosExtended queries the signing info of all validators along with
+	// their uptime and missed block streaks.
+	//
+	// Since: cosmos-sdk 0.51
+	SigningInfosExtended(context.Context, *QuerySigningInfosExtendedRequest) (*QuerySigningInfosExtendedResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -97,6 +117,9 @@ func (UnimplementedQueryServer) SigningInfo(context.Context, *QuerySigningInfoRe
 func (UnimplementedQueryServer) SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SigningInfos not implemented")
 }
+func (UnimplementedQueryServer) SigningInfosExtended(context.Context, *QuerySigningInfosExtendedRequest) (*QuerySigningInfosExtendedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SigningInfosExtended not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -164,6 +187,24 @@ func _Query_SigningInfos_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SigningInfosExtended_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySigningInfosExtendedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SigningInfosExtended(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SigningInfosExtended_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SigningInfosExtended(ctx, req.(*QuerySigningInfosExtendedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -183,6 +224,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SigningInfos",
 			Handler:    _Query_SigningInfos_Handler,
 		},
+		{
+			MethodName: "SigningInfosExtended",
+			Handler:    _Query_SigningInfosExtended_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/slashing/v1beta1/query.proto",
@@ -19,20 +19,29 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Validators_FullMethodName                    = "/cosmos.staking.v1beta1.Query/Validators"
-	Query_Validator_FullMethodName                     = "/cosmos.staking.v1beta1.Query/Validator"
-	Query_ValidatorDelegations_FullMethodName          = "/cosmos.staking.v1beta1.Query/ValidatorDelegations"
-	Query_ValidatorUnbondingDelegations_FullMethodName = "/cosmos.staking.v1beta1.Query/ValidatorUnbondingDelegations"
-	Query_Delegation_FullMethodName                    = "/cosmos.staking.v1beta1.Query/Delegation"
-	Query_UnbondingDelegation_FullMethodName           = "/cosmos.staking.v1beta1.Query/UnbondingDelegation"
-	Query_DelegatorDelegations_FullMethodName          = "/cosmos.staking.v1beta1.Query/DelegatorDelegations"
-	Query_DelegatorUnbondingDelegations_FullMethodName = "/cosmos.staking.v1beta1.Query/DelegatorUnbondingDelegations"
-	Query_Redelegations_FullMethodName                 = "/cosmos.staking.v1beta1.Query/Redelegations"
-	Query_DelegatorValidators_FullMethodName           = "/cosmos.staking.v1beta1.Query/DelegatorValidators"
-	Query_DelegatorValidator_FullMethodName            = "/cosmos.staking.v1beta1.Query/DelegatorValidator"
-	Query_HistoricalInfo_FullMethodName                = "/cosmos.staking.v1beta1.Query/HistoricalInfo"
-	Query_Pool_FullMethodName                          = "/cosmos.staking.v1beta1.Query/Pool"
-	Query_Params_FullMethodName                        = "/cosmos.staking.v1beta1.Query/Params"
+	Query_Validators_FullMethodName                           = "/cosmos.staking.v1beta1.Query/Validators"
+	Query_Validator_FullMethodName                            = "/cosmos.staking.v1beta1.Query/Validator"
+	Query_ValidatorDelegations_FullMethodName                 = "/cosmos.staking.v1beta1.Query/ValidatorDelegations"
+	Query_ValidatorUnbondingDelegations_FullMethodName        = "/cosmos.staking.v1beta1.Query/ValidatorUnbondingDelegations"
+	Query_Delegation_FullMethodName                           = "/cosmos.staking.v1beta1.Query/Delegation"
+	Query_UnbondingDelegation_FullMethodName                  = "/cosmos.staking.v1beta1.Query/UnbondingDelegation"
+	Query_DelegatorDelegations_FullMethodName                 = "/cosmos.staking.v1beta1.Query/DelegatorDelegations"
+	Query_DelegatorUnbondingDelegations_FullMethodName        = "/cosmos.staking.v1beta1.Query/DelegatorUnbondingDelegations"
+	Query_Redelegations_FullMethodName                        = "/cosmos.staking.v1beta1.Query/Redelegations"
+	Query_DelegatorValidators_FullMethodName                  = "/cosmos.staking.v1beta1.Query/DelegatorValidators"
+	Query_DelegatorValidator_FullMethodName                   = "/cosmos.staking.v1beta1.Query/DelegatorValidator"
+	Query_HistoricalInfo_FullMethodName                       = "/cosmos.staking.v1beta1.Query/HistoricalInfo"
+	Query_Pool_FullMethodName                                 = "/cosmos.staking.v1beta1.Query/Pool"
+	Query_Params_FullMethodName                               = "/cosmos.staking.v1beta1.Query/Params"
+	Query_TokenizeShareRecordById_FullMethodName              = "/cosmos.staking.v1beta1.Query/TokenizeShareRecordById"
+	Query_TokenizeShareRecordByDenom_FullMethodName           = "/cosmos.staking.v1beta1.Query/TokenizeShareRecordByDenom"
+	Query_TokenizeShareRecordsOwned_FullMethodName            = "/cosmos.staking.v1beta1.Query/TokenizeShareRecordsOwned"
+	Query_AllTokenizeShareRecords_FullMethodName              = "/cosmos.staking.v1beta1.Query/AllTokenizeShareRecords"
+	Query_TotalLiquidStaked_FullMethodName                    = "/cosmos.staking.v1beta1.Query/TotalLiquidStaked"
+	Query_RedelegationsGraph_FullMethodName                   = "/cosmos.staking.v1beta1.Query/RedelegationsGraph"
+	Query_UnbondingDelegationsByCompletionTime_FullMethodName = "/cosmos.staking.v1beta1.Query/UnbondingDelegationsByCompletionTime"
+	Query_TotalUnbondingAmount_FullMethodName                 = "/cosmos.staking.v1beta1.Query/TotalUnbondingAmount"
+	Query_ScheduledCommissionChange_FullMethodName            = "/cosmos.staking.v1beta1.Query/ScheduledCommissionChange"
 )
 
 // QueryClient is the client API for Query service.
@@ -92,6 +101,60 @@ type QueryClient interface {
 	Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// TokenizeShareRecordById queries a tokenize share record by its id.
+	//
+	// Since: cosmos-sdk 0.51
+	TokenizeShareRecordById(ctx context.Context, in *QueryTokenizeShareRecordByIdRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordByIdResponse, error)
+	// TokenizeShareRecordByDenom queries a tokenize share record by the denom of
+	// its share tokens.
+	//
+	// Since: cosmos-sdk 0.51
+	TokenizeShareRecordByDenom(ctx context.Context, in *QueryTokenizeShareRecordByDenomRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordByDenomResponse, error)
+	// TokenizeShareRecordsOwned queries the tokenize share records owned by an
+	// address.
+	//
+	// Since: cosmos-sdk 0.51
+	TokenizeShareRecordsOwned(ctx context.Context, in *QueryTokenizeShareRecordsOwnedRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordsOwnedResponse, error)
+	// AllTokenizeShareRecords queries all the tokenize share records.
+	//
+	// Since: cosmos-sdk 0.51
+	AllTokenizeShareRecords(ctx context.Context, in *QueryAllTokenizeShareRecordsRequest, opts ...grpc.CallOption) (*QueryAllTokenizeShareRecordsResponse, error)
+	// TotalLiquidStaked queries the amount of tokenized bonded tokens, in total
+	// and for a validator if one is given.
+	//
+	// Since: cosmos-sdk 0.51
+	TotalLiquidStaked(ctx context.Context, in *QueryTotalLiquidStakedRequest, opts ...grpc.CallOption) (*QueryTotalLiquidStakedResponse, error)
+	// RedelegationsGraph queries all the active redelegations as edges from
+	// their source validator to their destination validator.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	//
+	// Since: cosmos-sdk 0.51
+	RedelegationsGraph(ctx context.Context, in *QueryRedelegationsGraphRequest, opts ...grpc.CallOption) (*QueryRedelegationsGraphResponse, error)
+	// UnbondingDelegationsByCompletionTime queries the unbonding delegation
+	// entries of the unbonding queue, ordered by completion time.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	//
+	// Since: cosmos-sdk 0.51
+	UnbondingDelegationsByCompletionTime(ctx context.Context, in *QueryUnbondingDelegationsByCompletionTimeRequest, opts ...grpc.CallOption) (*QueryUnbondingDelegationsByCompletionTimeResponse, error)
+	// TotalUnbondingAmount queries the total amount of tokens of the unbonding
+	// delegation entries completing until the given time.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas, as it iterates over the unbonding queue. It fails when more than 10000
+	// entries complete until the given time, in which case an earlier end time
+	// should be used.
+	//
+	// Since: cosmos-sdk 0.51
+	TotalUnbondingAmount(ctx context.Context, in *QueryTotalUnbondingAmountRequest, opts ...grpc.CallOption) (*QueryTotalUnbondingAmountResponse, error)
+	// ScheduledCommissionChange queries the commission change scheduled by a
+	// validator.
+	//
+	// Since: cosmos-sdk 0.51
+	ScheduledCommissionChange(ctx context.Context, in *QueryScheduledCommissionChangeRequest, opts ...grpc.CallOption) (*QueryScheduledCommissionChangeResponse, error)
 }
 
 type queryClient struct {
@@ -228,6 +291,87 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) TokenizeShareRecordById(ctx context.Context, in *QueryTokenizeShareRecordByIdRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordByIdResponse, error) {
+	out := new(QueryTokenizeShareRecordByIdResponse)
+	err := c.cc.Invoke(ctx, Query_TokenizeShareRecordById_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TokenizeShareRecordByDenom(ctx context.Context, in *QueryTokenizeShareRecordByDenomRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordByDenomResponse, error) {
+	out := new(QueryTokenizeShareRecordByDenomResponse)
+	err := c.cc.Invoke(ctx, Query_TokenizeShareRecordByDenom_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TokenizeShareRecordsOwned(ctx context.Context, in *QueryTokenizeShareRecordsOwnedRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordsOwnedResponse, error) {
+	out := new(QueryTokenizeShareRecordsOwnedResponse)
+	err := c.cc.Invoke(ctx, Query_TokenizeShareRecordsOwned_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllTokenizeShareRecords(ctx context.Context, in *QueryAllTokenizeShareRecordsRequest, opts ...grpc.CallOption) (*QueryAllTokenizeShareRecordsResponse, error) {
+	out := new(QueryAllTokenizeShareRecordsResponse)
+	err := c.cc.Invoke(ctx, Query_AllTokenizeShareRecords_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TotalLiquidStaked(ctx context.Context, in *QueryTotalLiquidStakedRequest, opts ...grpc.CallOption) (*QueryTotalLiquidStakedResponse, error) {
+	out := new(QueryTotalLiquidStakedResponse)
+	err := c.cc.Invoke(ctx, Query_TotalLiquidStaked_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RedelegationsGraph(ctx context.Context, in *QueryRedelegationsGraphRequest, opts ...grpc.CallOption) (*QueryRedelegationsGraphResponse, error) {
+	out := new(QueryRedelegationsGraphResponse)
+	err := c.cc.Invoke(ctx, Query_RedelegationsGraph_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UnbondingDelegationsByCompletionTime(ctx context.Context, in *QueryUnbondingDelegationsByCompletionTimeRequest, opts ...grpc.CallOption) (*QueryUnbondingDelegationsByCompletionTimeResponse, error) {
+	out := new(QueryUnbondingDelegationsByCompletionTimeResponse)
+	err := c.cc.Invoke(ctx, Query_UnbondingDelegationsByCompletionTime_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TotalUnbondingAmount(ctx context.Context, in *QueryTotalUnbondingAmountRequest, opts ...grpc.CallOption) (*QueryTotalUnbondingAmountResponse, error) {
+	out := new(QueryTotalUnbondingAmountResponse)
+	err := c.cc.Invoke(ctx, Query_TotalUnbondingAmount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ScheduledCommissionChange(ctx context.Context, in *QueryScheduledCommissionChangeRequest, opts ...grpc.CallOption) (*QueryScheduledCommissionChangeResponse, error) {
+	out := new(QueryScheduledCommissionChangeResponse)
+	err := c.cc.Invoke(ctx, Query_ScheduledCommissionChange_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -285,6 +429,60 @@ type QueryServer interface {
 	Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// TokenizeShareRecordById queries a tokenize share record by its id.
+	//
+	// Since: cosmos-sdk 0.51
+	TokenizeShareRecordById(context.Context, *QueryTokenizeShareRecordByIdRequest) (*QueryTokenizeShareRecordByIdResponse, error)
+	// TokenizeShareRecordByDenom queries a tokenize share record by the denom of
+	// its share tokens.
+	//
+	// Since: cosmos-sdk 0.51
+	TokenizeShareRecordByDenom(context.Context, *QueryTokenizeShareRecordByDenomRequest) (*QueryTokenizeShareRecordByDenomResponse, error)
+	// TokenizeShareRecordsOwned queries the tokenize share records owned by an
+	// address.
+	//
+	// Since: cosmos-sdk 0.51
+	TokenizeShareRecordsOwned(context.Context, *QueryTokenizeShareRecordsOwnedRequest) (*QueryTokenizeShareRecordsOwnedResponse, error)
+	// AllTokenizeShareRecords queries all the tokenize share records.
+	//
+	// Since: cosmos-sdk 0.51
+	AllTokenizeShareRecords(context.Context, *QueryAllTokenizeShareRecordsRequest) (*QueryAllTokenizeShareRecordsResponse, error)
+	// TotalLiquidStaked queries the amount of tokenized bonded tokens, in total
+	// and for a validator if one is given.
+	//
+	// Since: cosmos-sdk 0.51
+	TotalLiquidStaked(context.Context, *QueryTotalLiquidStakedRequest) (*QueryTotalLiquidStakedResponse, error)
+	// RedelegationsGraph queries all the active redelegations as edges from
+	// their source validator to their destination validator.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	//
+	// Since: cosmos-sdk 0.51
+	RedelegationsGraph(context.Context, *QueryRedelegationsGraphRequest) (*QueryRedelegationsGraphResponse, error)
+	// UnbondingDelegationsByCompletionTime queries the unbonding delegation
+	// entries of the unbonding queue, ordered by completion time.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	//
+	// Since: cosmos-sdk 0.51
+	UnbondingDelegationsByCompletionTime(context.Context, *QueryUnbondingDelegationsByCompletionTimeRequest) (*QueryUnbondingDelegationsByCompletionTimeResponse, error)
+	// TotalUnbondingAmount queries the total amount of tokens of the unbonding
+	// delegation entries completing until the given time.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas, as it iterates over the unbonding queue. It fails when more than 10000
+	// entries complete until the given time, in which case an earlier end time
+	// should be used.
+	//
+	// Since: cosmos-sdk 0.51
+	TotalUnbondingAmount(context.Context, *QueryTotalUnbondingAmountRequest) (*QueryTotalUnbondingAmountResponse, error)
+	// ScheduledCommissionChange queries the commission change scheduled by a
+	// validator.
+	//
+	// Since: cosmos-sdk 0.51
+	ScheduledCommissionChange(context.Context, *QueryScheduledCommissionChangeRequest) (*QueryScheduledCommissionChangeResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -334,6 +532,33 @@ func (UnimplementedQueryServer) Pool(context.Context, *QueryPoolRequest) (*Query
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) TokenizeShareRecordById(context.Context, *QueryTokenizeShareRecordByIdRequest) (*QueryTokenizeShareRecordByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenizeShareRecordById not implemented")
+}
+func (UnimplementedQueryServer) TokenizeShareRecordByDenom(context.Context, *QueryTokenizeShareRecordByDenomRequest) (*QueryTokenizeShareRecordByDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenizeShareRecordByDenom not implemented")
+}
+func (UnimplementedQueryServer) TokenizeShareRecordsOwned(context.Context, *QueryTokenizeShareRecordsOwnedRequest) (*QueryTokenizeShareRecordsOwnedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenizeShareRecordsOwned not implemented")
+}
+func (UnimplementedQueryServer) AllTokenizeShareRecords(context.Context, *QueryAllTokenizeShareRecordsRequest) (*QueryAllTokenizeShareRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllTokenizeShareRecords not implemented")
+}
+func (UnimplementedQueryServer) TotalLiquidStaked(context.Context, *QueryTotalLiquidStakedRequest) (*QueryTotalLiquidStakedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalLiquidStaked not implemented")
+}
+func (UnimplementedQueryServer) RedelegationsGraph(context.Context, *QueryRedelegationsGraphRequest) (*QueryRedelegationsGraphResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedelegationsGraph not implemented")
+}
+func (UnimplementedQueryServer) UnbondingDelegationsByCompletionTime(context.Context, *QueryUnbondingDelegationsByCompletionTimeRequest) (*QueryUnbondingDelegationsByCompletionTimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbondingDelegationsByCompletionTime not implemented")
+}
+func (UnimplementedQueryServer) TotalUnbondingAmount(context.Context, *QueryTotalUnbondingAmountRequest) (*QueryTotalUnbondingAmountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalUnbondingAmount not implemented")
+}
+func (UnimplementedQueryServer) ScheduledCommissionChange(context.Context, *QueryScheduledCommissionChangeRequest) (*QueryScheduledCommissionChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledCommissionChange not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -599,6 +824,168 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TokenizeShareRecordById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenizeShareRecordByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenizeShareRecordById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TokenizeShareRecordById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenizeShareRecordById(ctx, req.(*QueryTokenizeShareRecordByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TokenizeShareRecordByDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenizeShareRecordByDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenizeShareRecordByDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TokenizeShareRecordByDenom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenizeShareRecordByDenom(ctx, req.(*QueryTokenizeShareRecordByDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TokenizeShareRecordsOwned_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenizeShareRecordsOwnedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenizeShareRecordsOwned(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TokenizeShareRecordsOwned_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenizeShareRecordsOwned(ctx, req.(*QueryTokenizeShareRecordsOwnedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllTokenizeShareRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllTokenizeShareRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllTokenizeShareRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AllTokenizeShareRecords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllTokenizeShareRecords(ctx, req.(*QueryAllTokenizeShareRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TotalLiquidStaked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalLiquidStakedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalLiquidStaked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TotalLiquidStaked_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalLiquidStaked(ctx, req.(*QueryTotalLiquidStakedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RedelegationsGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRedelegationsGraphRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RedelegationsGraph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RedelegationsGraph_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RedelegationsGraph(ctx, req.(*QueryRedelegationsGraphRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UnbondingDelegationsByCompletionTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnbondingDelegationsByCompletionTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnbondingDelegationsByCompletionTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_UnbondingDelegationsByCompletionTime_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnbondingDelegationsByCompletionTime(ctx, req.(*QueryUnbondingDelegationsByCompletionTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TotalUnbondingAmount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalUnbondingAmountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalUnbondingAmount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TotalUnbondingAmount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalUnbondingAmount(ctx, req.(*QueryTotalUnbondingAmountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScheduledCommissionChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledCommissionChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledCommissionChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ScheduledCommissionChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledCommissionChange(ctx, req.(*QueryScheduledCommissionChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -662,6 +1049,42 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "TokenizeShareRecordById",
+			Handler:    _Query_TokenizeShareRecordById_Handler,
+		},
+		{
+			MethodName: "TokenizeShareRecordByDenom",
+			Handler:    _Query_TokenizeShareRecordByDenom_Handler,
+		},
+		{
+			MethodName: "TokenizeShareRecordsOwned",
+			Handler:    _Query_TokenizeShareRecordsOwned_Handler,
+		},
+		{
+			MethodName: "AllTokenizeShareRecords",
+			Handler:    _Query_AllTokenizeShareRecords_Handler,
+		},
+		{
+			MethodName: "TotalLiquidStaked",
+			Handler:    _Query_TotalLiquidStaked_Handler,
+		},
+		{
+			MethodName: "RedelegationsGraph",
+			Handler:    _Query_RedelegationsGraph_Handler,
+		},
+		{
+			MethodName: "UnbondingDelegationsByCompletionTime",
+			Handler:    _Query_UnbondingDelegationsByCompletionTime_Handler,
+		},
+		{
+			MethodName: "TotalUnbondingAmount",
+			Handler:    _Query_TotalUnbondingAmount_Handler,
+		},
+		{
+			MethodName: "ScheduledCommissionChange",
+			Handler:    _Query_ScheduledCommissionChange_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/staking/v1beta1/query.proto",
@@ -19,14 +19,21 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_CreateValidator_FullMethodName           = "/cosmos.staking.v1beta1.Msg/CreateValidator"
-	Msg_EditValidator_FullMethodName             = "/cosmos.staking.v1beta1.Msg/EditValidator"
-	Msg_Delegate_FullMethodName                  = "/cosmos.staking.v1beta1.Msg/Delegate"
-	Msg_BeginRedelegate_FullMethodName           = "/cosmos.staking.v1beta1.Msg/BeginRedelegate"
-	Msg_Undelegate_FullMethodName                = "/cosmos.staking.v1beta1.Msg/Undelegate"
-	Msg_CancelUnbondingDelegation_FullMethodName = "/cosmos.staking.v1beta1.Msg/CancelUnbondingDelegation"
-	Msg_UpdateParams_FullMethodName              = "/cosmos.staking.v1beta1.Msg/UpdateParams"
-	Msg_RotateConsPubKey_FullMethodName          = "/cosmos.staking.v1beta1.Msg/RotateConsPubKey"
+	Msg_CreateValidator_FullMethodName             = "/cosmos.staking.v1beta1.Msg/CreateValidator"
+	Msg_EditValidator_FullMethodName               = "/cosmos.staking.v1beta1.Msg/EditValidator"
+	Msg_Delegate_FullMethodName                    = "/cosmos.staking.v1beta1.Msg/Delegate"
+	Msg_BeginRedelegate_FullMethodName             = "/cosmos.staking.v1beta1.Msg/BeginRedelegate"
+	Msg_Undelegate_FullMethodName                  = "/cosmos.staking.v1beta1.Msg/Undelegate"
+	Msg_CancelUnbondingDelegation_FullMethodName   = "/cosmos.staking.v1beta1.Msg/CancelUnbondingDelegation"
+	Msg_UpdateParams_FullMethodName                = "/cosmos.staking.v1beta1.Msg/UpdateParams"
+	Msg_RotateConsPubKey_FullMethodName            = "/cosmos.staking.v1beta1.Msg/RotateConsPubKey"
+	Msg_DecreaseMinSelfDelegation_FullMethodName   = "/cosmos.staking.v1beta1.Msg/DecreaseMinSelfDelegation"
+	Msg_TokenizeShares_FullMethodName              = "/cosmos.staking.v1beta1.Msg/TokenizeShares"
+	Msg_RedeemTokensForShares_FullMethodName       = "/cosmos.staking.v1beta1.Msg/RedeemTokensForShares"
+	Msg_TransferTokenizeShareRecord_FullMethodName = "/cosmos.staking.v1beta1.Msg/TransferTokenizeShareRecord"
+	Msg_UndelegateShares_FullMethodName            = "/cosmos.staking.v1beta1.Msg/UndelegateShares"
+	Msg_UndelegateAll_FullMethodName               = "/cosmos.staking.v1beta1.Msg/UndelegateAll"
+	Msg_ScheduleCommissionChange_FullMethodName    = "/cosmos.staking.v1beta1.Msg/ScheduleCommissionChange"
 )
 
 // MsgClient is the client API for Msg service.
@@ -59,6 +66,35 @@ type MsgClient interface {
 	// of a validator.
 	// Since: cosmos-sdk 0.51
 	RotateConsPubKey(ctx context.Context, in *MsgRotateConsPubKey, opts ...grpc.CallOption) (*MsgRotateConsPubKeyResponse, error)
+	// DecreaseMinSelfDelegation defines an operation for decreasing the minimum
+	// self-delegation of a validator, which MsgEditValidator can only increase.
+	// Since: cosmos-sdk 0.51
+	DecreaseMinSelfDelegation(ctx context.Context, in *MsgDecreaseMinSelfDelegation, opts ...grpc.CallOption) (*MsgDecreaseMinSelfDelegationResponse, error)
+	// TokenizeShares defines an operation for converting a delegation into
+	// transferable share tokens.
+	// Since: cosmos-sdk 0.51
+	TokenizeShares(ctx context.Context, in *MsgTokenizeShares, opts ...grpc.CallOption) (*MsgTokenizeSharesResponse, error)
+	// RedeemTokensForShares defines an operation for converting share tokens
+	// back into a delegation.
+	// Since: cosmos-sdk 0.51
+	RedeemTokensForShares(ctx context.Context, in *MsgRedeemTokensForShares, opts ...grpc.CallOption) (*MsgRedeemTokensForSharesResponse, error)
+	// TransferTokenizeShareRecord defines an operation for transferring the
+	// ownership of a tokenize share record, and of the rewards of its delegation.
+	// Since: cosmos-sdk 0.51
+	TransferTokenizeShareRecord(ctx context.Context, in *MsgTransferTokenizeShareRecord, opts ...grpc.CallOption) (*MsgTransferTokenizeShareRecordResponse, error)
+	// UndelegateShares defines an operation for undelegating an amount of
+	// delegation shares, rather than of tokens, from a validator.
+	// Since: cosmos-sdk 0.51
+	UndelegateShares(ctx context.Context, in *MsgUndelegateShares, opts ...grpc.CallOption) (*MsgUndelegateSharesResponse, error)
+	// UndelegateAll defines an operation for undelegating all the shares of a
+	// delegation to a validator.
+	// Since: cosmos-sdk 0.51
+	UndelegateAll(ctx context.Context, in *MsgUndelegateAll, opts ...grpc.CallOption) (*MsgUndelegateAllResponse, error)
+	// ScheduleCommissionChange defines an operation for announcing a commission
+	// rate change of a validator, which takes effect after the commission change
+	// notice period.
+	// Since: cosmos-sdk 0.51
+	ScheduleCommissionChange(ctx context.Context, in *MsgScheduleCommissionChange, opts ...grpc.CallOption) (*MsgScheduleCommissionChangeResponse, error)
 }
 
 type msgClient struct {
@@ -141,6 +177,69 @@ func (c *msgClient) RotateConsPubKey(ctx context.Context, in *MsgRotateConsPubKe
 	return out, nil
 }
 
+func (c *msgClient) DecreaseMinSelfDelegation(ctx context.Context, in *MsgDecreaseMinSelfDelegation, opts ...grpc.CallOption) (*MsgDecreaseMinSelfDelegationResponse, error) {
+	out := new(MsgDecreaseMinSelfDelegationResponse)
+	err := c.cc.Invoke(ctx, Msg_DecreaseMinSelfDelegation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TokenizeShares(ctx context.Context, in *MsgTokenizeShares, opts ...grpc.CallOption) (*MsgTokenizeSharesResponse, error) {
+	out := new(MsgTokenizeSharesResponse)
+	err := c.cc.Invoke(ctx, Msg_TokenizeShares_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RedeemTokensForShares(ctx context.Context, in *MsgRedeemTokensForShares, opts ...grpc.CallOption) (*MsgRedeemTokensForSharesResponse, error) {
+	out := new(MsgRedeemTokensForSharesResponse)
+	err := c.cc.Invoke(ctx, Msg_RedeemTokensForShares_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TransferTokenizeShareRecord(ctx context.Context, in *MsgTransferTokenizeShareRecord, opts ...grpc.CallOption) (*MsgTransferTokenizeShareRecordResponse, error) {
+	out := new(MsgTransferTokenizeShareRecordResponse)
+	err := c.cc.Invoke(ctx, Msg_TransferTokenizeShareRecord_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UndelegateShares(ctx context.Context, in *MsgUndelegateShares, opts ...grpc.CallOption) (*MsgUndelegateSharesResponse, error) {
+	out := new(MsgUndelegateSharesResponse)
+	err := c.cc.Invoke(ctx, Msg_UndelegateShares_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UndelegateAll(ctx context.Context, in *MsgUndelegateAll, opts ...grpc.CallOption) (*MsgUndelegateAllResponse, error) {
+	out := new(MsgUndelegateAllResponse)
+	err := c.cc.Invoke(ctx, Msg_UndelegateAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ScheduleCommissionChange(ctx context.Context, in *MsgScheduleCommissionChange, opts ...grpc.CallOption) (*MsgScheduleCommissionChangeResponse, error) {
+	out := new(MsgScheduleCommissionChangeResponse)
+	err := c.cc.Invoke(ctx, Msg_ScheduleCommissionChange_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -171,6 +270,35 @@ type MsgServer interface {
 	// of a validator.
 	// Since: cosmos-sdk 0.51
 	RotateConsPubKey(context.Context, *MsgRotateConsPubKey) (*MsgRotateConsPubKeyResponse, error)
+	// DecreaseMinSelfDelegation defines an operation for decreasing the minimum
+	// self-delegation of a validator, which MsgEditValidator can only increase.
+	// Since: cosmos-sdk 0.51
+	DecreaseMinSelfDelegation(context.Context, *MsgDecreaseMinSelfDelegation) (*MsgDecreaseMinSelfDelegationResponse, error)
+	// TokenizeShares defines an operation for converting a delegation into
+	// transferable share tokens.
+	// Since: cosmos-sdk 0.51
+	TokenizeShares(context.Context, *MsgTokenizeShares) (*MsgTokenizeSharesResponse, error)
+	// RedeemTokensForShares defines an operation for converting share tokens
+	// back into a delegation.
+	// Since: cosmos-sdk 0.51
+	RedeemTokensForShares(context.Context, *MsgRedeemTokensForShares) (*MsgRedeemTokensForSharesResponse, error)
+	// TransferTokenizeShareRecord defines an operation for transferring the
+	// ownership of a tokenize share record, and of the rewards of its delegation.
+	// Since: cosmos-sdk 0.51
+	TransferTokenizeShareRecord(context.Context, *MsgTransferTokenizeShareRecord) (*MsgTransferTokenizeShareRecordResponse, error)
+	// UndelegateShares defines an operation for undelegating an amount of
+	// delegation shares, rather than of tokens, from a validator.
+	// Since: cosmos-sdk 0.51
+	UndelegateShares(context.Context, *MsgUndelegateShares) (*MsgUndelegateSharesResponse, error)
+	// UndelegateAll defines an operation for undelegating all the shares of a
+	// delegation to a validator.
+	// Since: cosmos-sdk 0.51
+	UndelegateAll(context.Context, *MsgUndelegateAll) (*MsgUndelegateAllResponse, error)
+	// ScheduleCommissionChange defines an operation for announcing a commission
+	// rate change of a validator, which takes effect after the commission change
+	// notice period.
+	// Since: cosmos-sdk 0.51
+	ScheduleCommissionChange(context.Context, *MsgScheduleCommissionChange) (*MsgScheduleCommissionChangeResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -202,6 +330,27 @@ func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*
 func (UnimplementedMsgServer) RotateConsPubKey(context.Context, *MsgRotateConsPubKey) (*MsgRotateConsPubKeyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RotateConsPubKey not implemented")
 }
+func (UnimplementedMsgServer) DecreaseMinSelfDelegation(context.Context, *MsgDecreaseMinSelfDelegation) (*MsgDecreaseMinSelfDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecreaseMinSelfDelegation not implemented")
+}
+func (UnimplementedMsgServer) TokenizeShares(context.Context, *MsgTokenizeShares) (*MsgTokenizeSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenizeShares not implemented")
+}
+func (UnimplementedMsgServer) RedeemTokensForShares(context.Context, *MsgRedeemTokensForShares) (*MsgRedeemTokensForSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeemTokensForShares not implemented")
+}
+func (UnimplementedMsgServer) TransferTokenizeShareRecord(context.Context, *MsgTransferTokenizeShareRecord) (*MsgTransferTokenizeShareRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferTokenizeShareRecord not implemented")
+}
+func (UnimplementedMsgServer) UndelegateShares(context.Context, *MsgUndelegateShares) (*MsgUndelegateSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndelegateShares not implemented")
+}
+func (UnimplementedMsgServer) UndelegateAll(context.Context, *MsgUndelegateAll) (*MsgUndelegateAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndelegateAll not implemented")
+}
+func (UnimplementedMsgServer) ScheduleCommissionChange(context.Context, *MsgScheduleCommissionChange) (*MsgScheduleCommissionChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleCommissionChange not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -359,6 +508,132 @@ func _Msg_RotateConsPubKey_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_DecreaseMinSelfDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDecreaseMinSelfDelegation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DecreaseMinSelfDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DecreaseMinSelfDelegation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DecreaseMinSelfDelegation(ctx, req.(*MsgDecreaseMinSelfDelegation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TokenizeShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTokenizeShares)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TokenizeShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_TokenizeShares_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TokenizeShares(ctx, req.(*MsgTokenizeShares))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RedeemTokensForShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRedeemTokensForShares)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RedeemTokensForShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RedeemTokensForShares_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RedeemTokensForShares(ctx, req.(*MsgRedeemTokensForShares))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferTokenizeShareRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferTokenizeShareRecord)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferTokenizeShareRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_TransferTokenizeShareRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferTokenizeShareRecord(ctx, req.(*MsgTransferTokenizeShareRecord))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UndelegateShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUndelegateShares)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UndelegateShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UndelegateShares_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UndelegateShares(ctx, req.(*MsgUndelegateShares))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UndelegateAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUndelegateAll)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UndelegateAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UndelegateAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UndelegateAll(ctx, req.(*MsgUndelegateAll))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ScheduleCommissionChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleCommissionChange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleCommissionChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ScheduleCommissionChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleCommissionChange(ctx, req.(*MsgScheduleCommissionChange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -398,6 +673,34 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RotateConsPubKey",
 			Handler:    _Msg_RotateConsPubKey_Handler,
 		},
+		{
+			MethodName: "DecreaseMinSelfDelegation",
+			Handler:    _Msg_DecreaseMinSelfDelegation_Handler,
+		},
+		{
+			MethodName: "TokenizeShares",
+			Handler:    _Msg_TokenizeShares_Handler,
+		},
+		{
+			MethodName: "RedeemTokensForShares",
+			Handler:    _Msg_RedeemTokensForShares_Handler,
+		},
+		{
+			MethodName: "TransferTokenizeShareRecord",
+			Handler:    _Msg_TransferTokenizeShareRecord_Handler,
+		},
+		{
+			MethodName: "UndelegateShares",
+			Handler:    _Msg_UndelegateShares_Handler,
+		},
+		{
+			MethodName: "UndelegateAll",
+			Handler:    _Msg_UndelegateAll_Handler,
+		},
+		{
+			MethodName: "ScheduleCommissionChange",
+			Handler:    _Msg_ScheduleCommissionChange_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/staking/v1beta1/tx.proto",
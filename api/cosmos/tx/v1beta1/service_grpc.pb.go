@@ -19,15 +19,16 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Service_Simulate_FullMethodName        = "/cosmos.tx.v1beta1.Service/Simulate"
-	Service_GetTx_FullMethodName           = "/cosmos.tx.v1beta1.Service/GetTx"
-	Service_BroadcastTx_FullMethodName     = "/cosmos.tx.v1beta1.Service/BroadcastTx"
-	Service_GetTxsEvent_FullMethodName     = "/cosmos.tx.v1beta1.Service/GetTxsEvent"
-	Service_GetBlockWithTxs_FullMethodName = "/cosmos.tx.v1beta1.Service/GetBlockWithTxs"
-	Service_TxDecode_FullMethodName        = "/cosmos.tx.v1beta1.Service/TxDecode"
-	Service_TxEncode_FullMethodName        = "/cosmos.tx.v1beta1.Service/TxEncode"
-	Service_TxEncodeAmino_FullMethodName   = "/cosmos.tx.v1beta1.Service/TxEncodeAmino"
-	Service_TxDecodeAmino_FullMethodName   = "/cosmos.tx.v1beta1.Service/TxDecodeAmino"
+	Service_Simulate_FullMethodName              = "/cosmos.tx.v1beta1.Service/Simulate"
+	Service_SimulateWithOverrides_FullMethodName = "/cosmos.tx.v1beta1.Service/SimulateWithOverrides"
+	Service_GetTx_FullMethodName                 = "/cosmos.tx.v1beta1.Service/GetTx"
+	Service_BroadcastTx_FullMethodName           = "/cosmos.tx.v1beta1.Service/BroadcastTx"
+	Service_GetTxsEvent_FullMethodName           = "/cosmos.tx.v1beta1.Service/GetTxsEvent"
+	Service_GetBlockWithTxs_FullMethodName       = "/cosmos.tx.v1beta1.Service/GetBlockWithTxs"
+	Service_TxDecode_FullMethodName              = "/cosmos.tx.v1beta1.Service/TxDecode"
+	Service_TxEncode_FullMethodName              = "/cosmos.tx.v1beta1.Service/TxEncode"
+	Service_TxEncodeAmino_FullMethodName         = "/cosmos.tx.v1beta1.Service/TxEncodeAmino"
+	Service_TxDecodeAmino_FullMethodName         = "/cosmos.tx.v1beta1.Service/TxDecodeAmino"
 )
 
 // ServiceClient is the client API for Service service.
@@ -36,6 +37,11 @@ const (
 type ServiceClient interface {
 	// Simulate simulates executing a transaction for estimating gas usage.
 	Simulate(ctx context.Context, in *SimulateRequest, opts ...grpc.CallOption) (*SimulateResponse, error)
+	// SimulateWithOverrides simulates executing a transaction like Simulate,
+	// against the latest state with the given account and balance overrides,
+	// which are never persisted. It allows previewing the outcome of a
+	// transaction of an account which doesn't exist or isn't funded yet.
+	SimulateWithOverrides(ctx context.Context, in *SimulateWithOverridesRequest, opts ...grpc.CallOption) (*SimulateWithOverridesResponse, error)
 	// GetTx fetches a tx by hash.
 	GetTx(ctx context.Context, in *GetTxRequest, opts ...grpc.CallOption) (*GetTxResponse, error)
 	// BroadcastTx broadcast transaction.
@@ -81,6 +87,15 @@ func (c *serviceClient) Simulate(ctx context.Context, in *SimulateRequest, opts
 	return out, nil
 }
 
+func (c *serviceClient) SimulateWithOverrides(ctx context.Context, in *SimulateWithOverridesRequest, opts ...grpc.CallOption) (*SimulateWithOverridesResponse, error) {
+	out := new(SimulateWithOverridesResponse)
+	err := c.cc.Invoke(ctx, Service_SimulateWithOverrides_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *serviceClient) GetTx(ctx context.Context, in *GetTxRequest, opts ...grpc.CallOption) (*GetTxResponse, error) {
 	out := new(GetTxResponse)
 	err := c.cc.Invoke(ctx, Service_GetTx_FullMethodName, in, out, opts...)
@@ -159,6 +174,11 @@ func (c *serviceClient) TxDecodeAmino(ctx context.Context, in *TxDecodeAminoRequ
 type ServiceServer interface {
 	// Simulate simulates executing a transaction for estimating gas usage.
 	Simulate(context.Context, *SimulateRequest) (*SimulateResponse, error)
+	// SimulateWithOverrides simulates executing a transaction like Simulate,
+	// against the latest state with the given account and balance overrides,
+	// which are never persisted. It allows previewing the outcome of a
+	// transaction of an account which doesn't exist or isn't funded yet.
+	SimulateWithOverrides(context.Context, *SimulateWithOverridesRequest) (*SimulateWithOverridesResponse, error)
 	// GetTx fetches a tx by hash.
 	GetTx(context.Context, *GetTxRequest) (*GetTxResponse, error)
 	// BroadcastTx broadcast transaction.
@@ -195,6 +215,9 @@ type UnimplementedServiceServer struct {
 func (UnimplementedServiceServer) Simulate(context.Context, *SimulateRequest) (*SimulateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Simulate not implemented")
 }
+func (UnimplementedServiceServer) SimulateWithOverrides(context.Context, *SimulateWithOverridesRequest) (*SimulateWithOverridesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateWithOverrides not implemented")
+}
 func (UnimplementedServiceServer) GetTx(context.Context, *GetTxRequest) (*GetTxResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTx not implemented")
 }
@@ -250,6 +273,24 @@ func _Service_Simulate_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_SimulateWithOverrides_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateWithOverridesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).SimulateWithOverrides(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_SimulateWithOverrides_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).SimulateWithOverrides(ctx, req.(*SimulateWithOverridesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Service_GetTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetTxRequest)
 	if err := dec(in); err != nil {
@@ -405,6 +446,10 @@ var Service_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Simulate",
 			Handler:    _Service_Simulate_Handler,
 		},
+		{
+			MethodName: "SimulateWithOverrides",
+			Handler:    _Service_SimulateWithOverrides_Handler,
+		},
 		{
 			MethodName: "GetTx",
 			Handler:    _Service_GetTx_Handler,
@@ -53,6 +53,9 @@ func (app *BaseApp) InitChain(req *abci.RequestInitChain) (*abci.ResponseInitCha
 	if app.initialHeight == 0 { // If initial height is 0, set it to 1
 		app.initialHeight = 1
 	}
+	if err := app.persistInitialHeight(); err != nil {
+		return nil, err
+	}
 
 	// if req.InitialHeight is > 1, then we set the initial version on all stores
 	if req.InitialHeight > 1 {
@@ -581,7 +584,7 @@ func (app *BaseApp) ExtendVote(_ context.Context, req *abci.RequestExtendVote) (
 		ctx, _ = app.finalizeBlockState.Context().CacheContext()
 	} else {
 		ms := app.cms.CacheMultiStore()
-		ctx = sdk.NewContext(ms, false, app.logger).WithStreamingManager(app.streamingManager).WithChainID(app.chainID).WithBlockHeight(req.Height)
+		ctx = sdk.NewContext(ms, false, app.logger).WithStreamingManager(app.getStreamingManager()).WithChainID(app.chainID).WithBlockHeight(req.Height)
 	}
 
 	if app.extendVote == nil {
@@ -657,7 +660,7 @@ func (app *BaseApp) VerifyVoteExtension(req *abci.RequestVerifyVoteExtension) (r
 		ctx, _ = app.finalizeBlockState.Context().CacheContext()
 	} else {
 		ms := app.cms.CacheMultiStore()
-		ctx = sdk.NewContext(ms, false, app.logger).WithStreamingManager(app.streamingManager).WithChainID(app.chainID).WithBlockHeight(req.Height)
+		ctx = sdk.NewContext(ms, false, app.logger).WithStreamingManager(app.getStreamingManager()).WithChainID(app.chainID).WithBlockHeight(req.Height)
 	}
 
 	// If vote extensions are not enabled, as a safety precaution, we return an
@@ -800,37 +803,9 @@ func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.Request
 
 	// Iterate over all raw transactions in the proposal and attempt to execute
 	// them, gathering the execution results.
-	//
-	// NOTE: Not all raw transactions may adhere to the sdk.Tx interface, e.g.
-	// vote extensions, so skip those.
-	txResults := make([]*abci.ExecTxResult, 0, len(req.Txs))
-	for _, rawTx := range req.Txs {
-		var response *abci.ExecTxResult
-
-		if _, err := app.txDecoder(rawTx); err == nil {
-			response = app.deliverTx(rawTx)
-		} else {
-			// In the case where a transaction included in a block proposal is malformed,
-			// we still want to return a default response to comet. This is because comet
-			// expects a response for each transaction included in a block proposal.
-			response = sdkerrors.ResponseExecTxResultWithEvents(
-				sdkerrors.ErrTxDecode,
-				0,
-				0,
-				nil,
-				false,
-			)
-		}
-
-		// check after every tx if we should abort
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		default:
-			// continue
-		}
-
-		txResults = append(txResults, response)
+	txResults, err := app.executeTxs(ctx, req.Txs)
+	if err != nil {
+		return nil, err
 	}
 
 	if app.finalizeBlockState.ms.TracingEnabled() {
@@ -874,7 +849,7 @@ func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.Request
 func (app *BaseApp) FinalizeBlock(req *abci.RequestFinalizeBlock) (res *abci.ResponseFinalizeBlock, err error) {
 	defer func() {
 		// call the streaming service hooks with the FinalizeBlock messages
-		for _, streamingListener := range app.streamingManager.ABCIListeners {
+		for _, streamingListener := range app.getStreamingManager().ABCIListeners {
 			if err := streamingListener.ListenFinalizeBlock(app.finalizeBlockState.Context(), *req, *res); err != nil {
 				app.logger.Error("ListenFinalizeBlock listening hook failed", "height", req.Height, "err", err)
 			}
@@ -949,12 +924,13 @@ func (app *BaseApp) Commit() (*abci.ResponseCommit, error) {
 	}
 
 	app.cms.Commit()
+	app.pruneStores(header.Height)
 
 	resp := &abci.ResponseCommit{
 		RetainHeight: retainHeight,
 	}
 
-	abciListeners := app.streamingManager.ABCIListeners
+	abciListeners := app.getStreamingManager().ABCIListeners
 	if len(abciListeners) > 0 {
 		ctx := app.finalizeBlockState.Context()
 		blockHeight := ctx.BlockHeight()
@@ -1213,23 +1189,17 @@ func (app *BaseApp) CreateQueryContext(height int64, prove bool) (sdk.Context, e
 	}
 
 	lastBlockHeight := qms.LatestVersion()
-	if lastBlockHeight == 0 {
-		return sdk.Context{}, errorsmod.Wrapf(sdkerrors.ErrInvalidHeight, "%s is not ready; please wait for first block", app.Name())
-	}
-
-	if height > lastBlockHeight {
-		return sdk.Context{},
-			errorsmod.Wrap(
-				sdkerrors.ErrInvalidHeight,
-				"cannot query with height in the future; please provide a valid height",
-			)
-	}
 
 	// when a client did not provide a query height, manually inject the latest
 	if height == 0 {
 		height = lastBlockHeight
 	}
 
+	cacheMS, err := app.QueryMultiStore(height)
+	if err != nil {
+		return sdk.Context{}, err
+	}
+
 	if height <= 1 && prove {
 		return sdk.Context{},
 			errorsmod.Wrap(
@@ -1238,15 +1208,6 @@ func (app *BaseApp) CreateQueryContext(height int64, prove bool) (sdk.Context, e
 			)
 	}
 
-	cacheMS, err := qms.CacheMultiStoreWithVersion(height)
-	if err != nil {
-		return sdk.Context{},
-			errorsmod.Wrapf(
-				sdkerrors.ErrInvalidRequest,
-				"failed to load state at height %d; %s (latest height: %d)", height, err, lastBlockHeight,
-			)
-	}
-
 	// branch the commit multi-store for safety
 	ctx := sdk.NewContext(cacheMS, true, app.logger).
 		WithMinGasPrices(app.minGasPrices).
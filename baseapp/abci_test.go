@@ -37,6 +37,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
 	"github.com/cosmos/cosmos-sdk/baseapp/testutil/mock"
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -681,6 +682,158 @@ func TestABCI_FinalizeBlock_DeliverTx(t *testing.T) {
 	}
 }
 
+func newParallelTestTx(t *testing.T, txConfig client.TxConfig, msg sdk.Msg) []byte {
+	t.Helper()
+	builder := txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(msg))
+	setTxSignature(t, builder, 0)
+	txBytes, err := txConfig.TxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+	return txBytes
+}
+
+func TestABCI_FinalizeBlock_ParallelTxExecution(t *testing.T) {
+	deliverKey := []byte("deliver-key")
+	_, _, addr := testdata.KeyTestPubAddr()
+	newTx := newParallelTestTx
+
+	finalizeBlock := func(t *testing.T, opts ...func(*baseapp.BaseApp)) (*abci.ResponseFinalizeBlock, *BaseAppSuite) {
+		t.Helper()
+		suite := NewBaseAppSuite(t, opts...)
+		baseapptestutil.RegisterKeyValueServer(suite.baseApp.MsgServiceRouter(), MsgKeyValueImpl{})
+		baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), NoopCounterServerImpl{})
+		baseapptestutil.RegisterCounter2Server(suite.baseApp.MsgServiceRouter(), Counter2ServerImpl{t, capKey1, deliverKey})
+
+		_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+			ConsensusParams: &cmtproto.ConsensusParams{},
+		})
+		require.NoError(t, err)
+
+		// MsgKeyValue and MsgCounter2 access the key2 and key1 stores respectively,
+		// while MsgCounter doesn't declare the stores it accesses.
+		txs := [][]byte{
+			newTx(t, suite.txConfig, &baseapptestutil.MsgKeyValue{Key: []byte("a"), Value: []byte("1"), Signer: addr.String()}),
+			newTx(t, suite.txConfig, &baseapptestutil.MsgCounter2{Counter: 0, Signer: addr.String()}),
+			newTx(t, suite.txConfig, &baseapptestutil.MsgKeyValue{Key: []byte("a"), Value: []byte("2"), Signer: addr.String()}),
+			newTx(t, suite.txConfig, &baseapptestutil.MsgCounter2{Counter: 1, Signer: addr.String()}),
+			newTx(t, suite.txConfig, &baseapptestutil.MsgCounter{Counter: 0, Signer: addr.String()}),
+			[]byte("invalid tx"),
+			newTx(t, suite.txConfig, &baseapptestutil.MsgCounter2{Counter: 2, Signer: addr.String()}),
+			newTx(t, suite.txConfig, &baseapptestutil.MsgCounter2{Counter: 0, FailOnHandler: true, Signer: addr.String()}),
+			newTx(t, suite.txConfig, &baseapptestutil.MsgKeyValue{Key: []byte("b"), Value: []byte("3"), Signer: addr.String()}),
+		}
+
+		res, err := suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1, Txs: txs})
+		require.NoError(t, err)
+		return res, suite
+	}
+
+	// the gas meter of each tx is expected to be set by the AnteHandler
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			return ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()), nil
+		})
+	}
+
+	serialRes, _ := finalizeBlock(t, anteOpt)
+	parallelRes, suite := finalizeBlock(t, anteOpt, baseapp.SetParallelTxExecution(true))
+
+	// the parallel execution results in the same state as the serial execution
+	require.Equal(t, serialRes.TxResults, parallelRes.TxResults)
+	require.Equal(t, serialRes.AppHash, parallelRes.AppHash)
+
+	for i, txResult := range parallelRes.TxResults {
+		require.Equal(t, i != 5 && i != 7, txResult.IsOK(), "tx %d: %s", i, txResult.Log)
+	}
+
+	ctx := getFinalizeBlockStateCtx(suite.baseApp)
+	require.Equal(t, int64(3), getIntFromStore(t, ctx.KVStore(capKey1), deliverKey))
+	require.Equal(t, []byte("2"), ctx.KVStore(capKey2).Get([]byte("a")))
+	require.Equal(t, []byte("3"), ctx.KVStore(capKey2).Get([]byte("b")))
+}
+
+func TestABCI_FinalizeBlock_ParallelTxExecution_Concurrent(t *testing.T) {
+	_, _, addr := testdata.KeyTestPubAddr()
+
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			return ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()), nil
+		})
+	}
+
+	suite := NewBaseAppSuite(t, anteOpt, baseapp.SetParallelTxExecution(true))
+	// the messages only succeed once both of them are executed concurrently
+	server := newConcurrentServerImpl(2)
+	baseapptestutil.RegisterKeyValueServer(suite.baseApp.MsgServiceRouter(), server)
+	baseapptestutil.RegisterCounter2Server(suite.baseApp.MsgServiceRouter(), server)
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	txs := [][]byte{
+		newParallelTestTx(t, suite.txConfig, &baseapptestutil.MsgKeyValue{Key: []byte("a"), Value: []byte("1"), Signer: addr.String()}),
+		newParallelTestTx(t, suite.txConfig, &baseapptestutil.MsgCounter2{Counter: 0, Signer: addr.String()}),
+	}
+
+	res, err := suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1, Txs: txs})
+	require.NoError(t, err)
+	for i, txResult := range res.TxResults {
+		require.True(t, txResult.IsOK(), "tx %d: %s", i, txResult.Log)
+	}
+}
+
+func TestABCI_FinalizeBlock_ParallelTxExecution_UndeclaredConflicts(t *testing.T) {
+	feeKey, deliverKey := []byte("fee-key"), []byte("deliver-key")
+	_, _, addr := testdata.KeyTestPubAddr()
+
+	// the AnteHandler of every tx increments a counter in the key1 store, like a
+	// fee payment to the fee collector, which MsgKeyValue doesn't declare
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			store := ctx.KVStore(capKey1)
+			setIntOnStore(store, feeKey, getIntFromStore(t, store, feeKey)+1)
+			return ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()), nil
+		})
+	}
+
+	finalizeBlock := func(t *testing.T, opts ...func(*baseapp.BaseApp)) (*abci.ResponseFinalizeBlock, *BaseAppSuite) {
+		t.Helper()
+		suite := NewBaseAppSuite(t, opts...)
+		baseapptestutil.RegisterKeyValueServer(suite.baseApp.MsgServiceRouter(), MsgKeyValueImpl{})
+		baseapptestutil.RegisterCounter2Server(suite.baseApp.MsgServiceRouter(), Counter2ServerImpl{t, capKey1, deliverKey})
+
+		_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+			ConsensusParams: &cmtproto.ConsensusParams{},
+		})
+		require.NoError(t, err)
+
+		// the txs declare disjoint stores, so they are executed in parallel
+		txs := [][]byte{
+			newParallelTestTx(t, suite.txConfig, &baseapptestutil.MsgKeyValue{Key: []byte("a"), Value: []byte("1"), Signer: addr.String()}),
+			newParallelTestTx(t, suite.txConfig, &baseapptestutil.MsgCounter2{Counter: 0, Signer: addr.String()}),
+		}
+
+		res, err := suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1, Txs: txs})
+		require.NoError(t, err)
+		return res, suite
+	}
+
+	serialRes, _ := finalizeBlock(t, anteOpt)
+	parallelRes, suite := finalizeBlock(t, anteOpt, baseapp.SetParallelTxExecution(true))
+
+	// the second tx reads the counter written by the first one, so it is
+	// executed again serially
+	require.Equal(t, serialRes.TxResults, parallelRes.TxResults)
+	require.Equal(t, serialRes.AppHash, parallelRes.AppHash)
+
+	ctx := getFinalizeBlockStateCtx(suite.baseApp)
+	require.Equal(t, int64(2), getIntFromStore(t, ctx.KVStore(capKey1), feeKey))
+	require.Equal(t, int64(1), getIntFromStore(t, ctx.KVStore(capKey1), deliverKey))
+	require.Equal(t, []byte("1"), ctx.KVStore(capKey2).Get([]byte("a")))
+}
+
 func TestABCI_FinalizeBlock_MultiMsg(t *testing.T) {
 	anteKey := []byte("ante-key")
 	anteOpt := func(bapp *baseapp.BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
@@ -703,12 +856,32 @@ func TestABCI_FinalizeBlock_MultiMsg(t *testing.T) {
 	txBytes, err := suite.txConfig.TxEncoder()(tx)
 	require.NoError(t, err)
 
-	_, err = suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{
+	res, err := suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{
 		Height: 1,
 		Txs:    [][]byte{txBytes},
 	})
 	require.NoError(t, err)
 
+	// each message event records the gas consumed by the message
+	var msgsGasUsed uint64
+	var msgEvents int
+	for _, event := range res.TxResults[0].Events {
+		if event.Type != sdk.EventTypeMessage {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key == sdk.AttributeKeyGasUsed {
+				gasUsed, err := strconv.ParseUint(attr.Value, 10, 64)
+				require.NoError(t, err)
+				require.Positive(t, gasUsed)
+				msgsGasUsed += gasUsed
+				msgEvents++
+			}
+		}
+	}
+	require.Equal(t, 3, msgEvents)
+	require.LessOrEqual(t, msgsGasUsed, uint64(res.TxResults[0].GasUsed))
+
 	store := getFinalizeBlockStateCtx(suite.baseApp).KVStore(capKey1)
 
 	// tx counter only incremented once
@@ -820,6 +993,51 @@ func TestABCI_Query_SimulateTx(t *testing.T) {
 	}
 }
 
+func TestABCI_SimulateWithOverrides(t *testing.T) {
+	fundedKey := []byte("funded")
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) {
+			if !ctx.KVStore(capKey1).Has(fundedKey) {
+				return ctx, errors.New("not funded")
+			}
+			return ctx, nil
+		})
+	}
+	suite := NewBaseAppSuite(t, anteOpt)
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImplGasMeterOnly{})
+
+	tx := newTxCounter(t, suite.txConfig, 1, 1)
+	txBytes, err := suite.txConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+
+	_, _, err = suite.baseApp.Simulate(txBytes)
+	require.ErrorContains(t, err, "not funded")
+
+	fund := func(ctx sdk.Context) error {
+		ctx.KVStore(capKey1).Set(fundedKey, []byte{1})
+		return nil
+	}
+	_, result, err := suite.baseApp.SimulateWithOverrides(txBytes, fund)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+
+	// the overrides are never persisted
+	require.False(t, getCheckStateCtx(suite.baseApp).KVStore(capKey1).Has(fundedKey))
+	_, _, err = suite.baseApp.Simulate(txBytes)
+	require.ErrorContains(t, err, "not funded")
+
+	_, _, err = suite.baseApp.SimulateWithOverrides(txBytes, func(ctx sdk.Context) error {
+		return errors.New("invalid override")
+	})
+	require.ErrorContains(t, err, "invalid state overrides: invalid override")
+}
+
 func TestABCI_InvalidTransaction(t *testing.T) {
 	anteOpt := func(bapp *baseapp.BaseApp) {
 		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) {
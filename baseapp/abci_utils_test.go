@@ -705,6 +705,89 @@ func (s *ABCIUtilsTestSuite) TestDefaultProposalHandler_PriorityNonceMempoolTxSe
 	}
 }
 
+func (s *ABCIUtilsTestSuite) TestVoteExtensionInjector() {
+	ext := []byte("vote-extension")
+	cve := cmtproto.CanonicalVoteExtension{
+		Extension: ext,
+		Height:    2,
+		Round:     int64(0),
+		ChainId:   chainID,
+	}
+
+	bz, err := marshalDelimitedFn(&cve)
+	s.Require().NoError(err)
+
+	votes := make([]abci.ExtendedVoteInfo, len(s.vals))
+	for i, val := range s.vals {
+		extSig, err := val.privKey.Sign(bz)
+		s.Require().NoError(err)
+		votes[i] = abci.ExtendedVoteInfo{
+			Validator:          val.toValidator(333),
+			VoteExtension:      ext,
+			ExtensionSignature: extSig,
+			BlockIdFlag:        cmtproto.BlockIDFlagCommit,
+		}
+	}
+	llc, info := extendedCommitToLastCommit(abci.ExtendedCommitInfo{Round: 0, Votes: votes})
+
+	var processedTxs [][]byte
+	injector := baseapp.NewVoteExtensionInjector(
+		s.valStore,
+		baseapp.NoOpPrepareProposal(),
+		func(_ sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+			processedTxs = req.Txs
+			return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil
+		},
+	)
+	tx := []byte("tx")
+
+	// vote extensions are not injected before they are enabled
+	ctx := s.ctx.WithBlockHeight(2).WithHeaderInfo(header.Info{Height: 2, ChainID: chainID})
+	s.Require().False(baseapp.VoteExtensionsEnabled(ctx))
+	prepareResp, err := injector.PrepareProposalHandler()(ctx, &abci.RequestPrepareProposal{Txs: [][]byte{tx}, MaxTxBytes: 1000, Height: 2})
+	s.Require().NoError(err)
+	s.Require().Equal([][]byte{tx}, prepareResp.Txs)
+	_, _, err = baseapp.ExtractInjectedVoteExtensions(ctx, prepareResp.Txs)
+	s.Require().ErrorContains(err, "vote extensions are not enabled")
+
+	ctx = s.ctx.WithBlockHeight(3).WithHeaderInfo(header.Info{Height: 3, ChainID: chainID}).WithCometInfo(info)
+	s.Require().True(baseapp.VoteExtensionsEnabled(ctx))
+
+	// the proposer injects the vote extensions in front of the proposal
+	prepareResp, err = injector.PrepareProposalHandler()(ctx, &abci.RequestPrepareProposal{Txs: [][]byte{tx}, MaxTxBytes: 1000, Height: 3, LocalLastCommit: llc})
+	s.Require().NoError(err)
+	s.Require().Len(prepareResp.Txs, 2)
+	s.Require().Equal(tx, prepareResp.Txs[1])
+
+	extCommit, txs, err := baseapp.ExtractInjectedVoteExtensions(ctx, prepareResp.Txs)
+	s.Require().NoError(err)
+	s.Require().Equal(llc, extCommit)
+	s.Require().Equal([][]byte{tx}, txs)
+
+	// validators accept the proposal, the wrapped handler gets the remaining txs
+	processResp, err := injector.ProcessProposalHandler()(ctx, &abci.RequestProcessProposal{Txs: prepareResp.Txs, Height: 3})
+	s.Require().NoError(err)
+	s.Require().Equal(abci.ResponseProcessProposal_ACCEPT, processResp.Status)
+	s.Require().Equal([][]byte{tx}, processedTxs)
+
+	// proposals without injected vote extensions are rejected
+	processResp, err = injector.ProcessProposalHandler()(ctx, &abci.RequestProcessProposal{Txs: [][]byte{{0xff}}, Height: 3})
+	s.Require().NoError(err)
+	s.Require().Equal(abci.ResponseProcessProposal_REJECT, processResp.Status)
+
+	// proposals with tampered vote extensions are rejected
+	llc.Votes[0].VoteExtension = []byte("tampered")
+	tampered, err := llc.Marshal()
+	s.Require().NoError(err)
+	processResp, err = injector.ProcessProposalHandler()(ctx, &abci.RequestProcessProposal{Txs: [][]byte{tampered, tx}, Height: 3})
+	s.Require().NoError(err)
+	s.Require().Equal(abci.ResponseProcessProposal_REJECT, processResp.Status)
+
+	// the proposer doesn't inject invalid vote extensions
+	_, err = injector.PrepareProposalHandler()(ctx, &abci.RequestPrepareProposal{Txs: [][]byte{tx}, MaxTxBytes: 1000, Height: 3, LocalLastCommit: llc})
+	s.Require().ErrorContains(err, "failed to validate vote extensions")
+}
+
 func marshalDelimitedFn(msg proto.Message) ([]byte, error) {
 	var buf bytes.Buffer
 	if err := protoio.NewDelimitedWriter(&buf).WriteMsg(msg); err != nil {
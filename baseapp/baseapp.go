@@ -3,6 +3,7 @@ package baseapp
 import (
 	"context"
 	"fmt"
+	"io"
 	"math"
 	"sort"
 	"strconv"
@@ -22,9 +23,11 @@ import (
 	"cosmossdk.io/log"
 	"cosmossdk.io/store"
 	storemetrics "cosmossdk.io/store/metrics"
+	pruningtypes "cosmossdk.io/store/pruning/types"
 	"cosmossdk.io/store/snapshots"
 	storetypes "cosmossdk.io/store/types"
 
+	"github.com/cosmos/cosmos-sdk/baseapp/interblockcache"
 	"github.com/cosmos/cosmos-sdk/baseapp/oe"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -76,6 +79,7 @@ type BaseApp struct {
 	txEncoder         sdk.TxEncoder // marshal sdk.Tx into []byte
 
 	mempool     mempool.Mempool // application side mempool
+	mempoolMtx  sync.Mutex      // serializes the removal of txs executed in parallel from the mempool
 	anteHandler sdk.AnteHandler // ante handler for fee and auth
 	postHandler sdk.PostHandler // post handler, optional
 
@@ -126,6 +130,10 @@ type BaseApp struct {
 	// FinalizeBlock call.
 	interBlockCache storetypes.MultiStorePersistentCache
 
+	// wraps the branches of the multistore the execution states start from,
+	// e.g. to instrument the store accesses
+	cacheMultiStoreWrapper func(storetypes.CacheMultiStore) storetypes.CacheMultiStore
+
 	// paramStore is used to query for ABCI consensus parameters from an
 	// application parameter store.
 	paramStore ParamStore
@@ -174,7 +182,9 @@ type BaseApp struct {
 	// which informs CometBFT what to index. If empty, all events will be indexed.
 	indexEvents map[string]struct{}
 
-	// streamingManager for managing instances and configuration of ABCIListener services
+	// streamingManager for managing instances and configuration of ABCIListener services,
+	// guarded by streamingMtx as listeners can be added once the node started
+	streamingMtx     sync.RWMutex
 	streamingManager storetypes.StreamingManager
 
 	chainID string
@@ -185,6 +195,26 @@ type BaseApp struct {
 	// including the goroutine handling.This is experimental and must be enabled
 	// by developers.
 	optimisticExec *oe.OptimisticExecution
+
+	// parallelTxExecution enables the parallel execution of the non-conflicting
+	// txs of a block, see executeTxs. This is experimental and must be enabled
+	// by developers.
+	parallelTxExecution bool
+
+	// historicalQueryDisabled restricts the queries to the latest height.
+	historicalQueryDisabled bool
+
+	// archivedSnapshots serves the queries at a pruned height from the archived
+	// snapshots if enabled, see QueryMultiStore.
+	archivedSnapshots *archivedSnapshots
+
+	// pruning is the pruning options of the stores, except the ones overridden
+	// by store key name in pruningOverrides, see setPruning.
+	pruning          pruningtypes.PruningOptions
+	pruningOverrides map[string]pruningtypes.PruningOptions
+	// storePruning are the prune managers of the stores pruned further than the
+	// multistore, by store key name, see initStorePruning.
+	storePruning map[string]storePruning
 }
 
 // NewBaseApp returns a reference to an initialized BaseApp. It accepts a
@@ -378,6 +408,18 @@ func (app *BaseApp) SnapshotManager() *snapshots.Manager {
 	return app.snapshotManager
 }
 
+// InterBlockCacheStats returns the statistics of the inter-block caches of the
+// stores, nil if the inter-block cache isn't an interblockcache.Manager, e.g.
+// if it is disabled.
+func (app *BaseApp) InterBlockCacheStats() []interblockcache.Stats {
+	cache, ok := app.interBlockCache.(*interblockcache.Manager)
+	if !ok {
+		return nil
+	}
+
+	return cache.Stats()
+}
+
 // LoadVersion loads the BaseApp application version. It will panic if called
 // more than once on a running baseapp.
 func (app *BaseApp) LoadVersion(version int64) error {
@@ -434,6 +476,10 @@ func (app *BaseApp) Init() error {
 	app.setState(execModeCheck, emptyHeader)
 	app.Seal()
 
+	if err := app.initStorePruning(); err != nil {
+		return err
+	}
+
 	return app.cms.GetPruning().Validate()
 }
 
@@ -480,6 +526,9 @@ func (app *BaseApp) IsSealed() bool { return app.sealed }
 // multi-store branch, and provided header.
 func (app *BaseApp) setState(mode execMode, h cmtproto.Header) {
 	ms := app.cms.CacheMultiStore()
+	if app.cacheMultiStoreWrapper != nil {
+		ms = app.cacheMultiStoreWrapper(ms)
+	}
 	headerInfo := header.Info{
 		Height:  h.Height,
 		Time:    h.Time,
@@ -489,7 +538,7 @@ func (app *BaseApp) setState(mode execMode, h cmtproto.Header) {
 	baseState := &state{
 		ms: ms,
 		ctx: sdk.NewContext(ms, false, app.logger).
-			WithStreamingManager(app.streamingManager).
+			WithStreamingManager(app.getStreamingManager()).
 			WithBlockHeader(h).
 			WithHeaderInfo(headerInfo),
 	}
@@ -747,6 +796,11 @@ func (app *BaseApp) beginBlock(req *abci.RequestFinalizeBlock) (sdk.BeginBlock,
 }
 
 func (app *BaseApp) deliverTx(tx []byte) *abci.ExecTxResult {
+	return app.deliverTxWithContext(app.getContextForTx(execModeFinalize, tx), tx)
+}
+
+// deliverTxWithContext executes the tx in the given FinalizeBlock context.
+func (app *BaseApp) deliverTxWithContext(ctx sdk.Context, tx []byte) *abci.ExecTxResult {
 	gInfo := sdk.GasInfo{}
 	resultStr := "successful"
 
@@ -759,7 +813,7 @@ func (app *BaseApp) deliverTx(tx []byte) *abci.ExecTxResult {
 		telemetry.SetGauge(float32(gInfo.GasWanted), "tx", "gas", "wanted")
 	}()
 
-	gInfo, result, anteEvents, err := app.runTx(execModeFinalize, tx)
+	gInfo, result, anteEvents, err := app.runTxWithContext(ctx, execModeFinalize, tx)
 	if err != nil {
 		resultStr = "failed"
 		resp = sdkerrors.ResponseExecTxResultWithEvents(
@@ -817,12 +871,16 @@ func (app *BaseApp) endBlock(ctx context.Context) (sdk.EndBlock, error) {
 // returned if the tx does not run out of gas and if all the messages are valid
 // and execute successfully. An error is returned otherwise.
 func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, err error) {
+	return app.runTxWithContext(app.getContextForTx(mode, txBytes), mode, txBytes)
+}
+
+// runTxWithContext runs the tx like runTx, in the given context.
+func (app *BaseApp) runTxWithContext(ctx sdk.Context, mode execMode, txBytes []byte) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, err error) {
 	// NOTE: GasWanted should be returned by the AnteHandler. GasUsed is
 	// determined by the GasMeter. We need access to the context to get the gas
 	// meter, so we initialize upfront.
 	var gasWanted uint64
 
-	ctx := app.getContextForTx(mode, txBytes)
 	ms := ctx.MultiStore()
 
 	// only run the tx if there is block gas remaining
@@ -923,7 +981,10 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, res
 			return gInfo, nil, anteEvents, err
 		}
 	} else if mode == execModeFinalize {
+		// txs may be executed in parallel, see executeTxs
+		app.mempoolMtx.Lock()
 		err = app.mempool.Remove(tx)
+		app.mempoolMtx.Unlock()
 		if err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
 			return gInfo, nil, anteEvents,
 				fmt.Errorf("failed to remove tx from mempool: %w", err)
@@ -950,7 +1011,9 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, res
 		// The runMsgCtx context currently contains events emitted by the ante handler.
 		// We clear this to correctly order events without duplicates.
 		// Note that the state is still preserved.
-		postCtx := runMsgCtx.WithEventManager(sdk.NewEventManager())
+		// The result of runMsgs is made available to the postHandler, e.g. to refund
+		// unused gas or pay out tips.
+		postCtx := runMsgCtx.WithEventManager(sdk.NewEventManager()).WithTxResult(result)
 
 		newCtx, errPostHandler := app.postHandler(postCtx, tx, mode == execModeSimulate, err == nil)
 		if errPostHandler != nil {
@@ -1002,13 +1065,15 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, msgsV2 []protov2.Me
 		}
 
 		// ADR 031 request type routing
+		gasBefore := ctx.GasMeter().GasConsumed()
 		msgResult, err := handler(ctx, msg)
 		if err != nil {
 			return nil, errorsmod.Wrapf(err, "failed to execute message; message index: %d", i)
 		}
+		msgGasUsed := ctx.GasMeter().GasConsumed() - gasBefore
 
 		// create message events
-		msgEvents, err := createEvents(app.cdc, msgResult.GetEvents(), msg, msgsV2[i])
+		msgEvents, err := createEvents(app.cdc, msgResult.GetEvents(), msg, msgsV2[i], msgGasUsed)
 		if err != nil {
 			return nil, errorsmod.Wrapf(err, "failed to create message events; message index: %d", i)
 		}
@@ -1054,7 +1119,9 @@ func makeABCIData(msgResponses []*codectypes.Any) ([]byte, error) {
 	return proto.Marshal(&sdk.TxMsgData{MsgResponses: msgResponses})
 }
 
-func createEvents(cdc codec.Codec, events sdk.Events, msg sdk.Msg, msgV2 protov2.Message) (sdk.Events, error) {
+// createEvents returns the events of a message, prefixed by a message event
+// recording its action, sender, module and the gas consumed by its execution.
+func createEvents(cdc codec.Codec, events sdk.Events, msg sdk.Msg, msgV2 protov2.Message, gasUsed uint64) (sdk.Events, error) {
 	eventMsgName := sdk.MsgTypeURL(msg)
 	msgEvent := sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyAction, eventMsgName))
 
@@ -1078,6 +1145,8 @@ func createEvents(cdc codec.Codec, events sdk.Events, msg sdk.Msg, msgV2 protov2
 		}
 	}
 
+	msgEvent = msgEvent.AppendAttributes(sdk.NewAttribute(sdk.AttributeKeyGasUsed, strconv.FormatUint(gasUsed, 10)))
+
 	return sdk.Events{msgEvent}.AppendEvents(events), nil
 }
 
@@ -1151,5 +1220,20 @@ func (app *BaseApp) Close() error {
 		}
 	}
 
+	if app.archivedSnapshots != nil {
+		if err := app.archivedSnapshots.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Close the ABCI listeners holding resources, e.g. the streaming sink listener
+	for _, listener := range app.getStreamingManager().ABCIListeners {
+		if closer, ok := listener.(io.Closer); ok {
+			if err := closer.Close(); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
 	return errors.Join(errs...)
 }
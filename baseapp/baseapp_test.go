@@ -16,6 +16,7 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
+	"cosmossdk.io/store/iavl"
 	"cosmossdk.io/store/metrics"
 	pruningtypes "cosmossdk.io/store/pruning/types"
 	"cosmossdk.io/store/rootmulti"
@@ -32,6 +33,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 var (
@@ -291,6 +293,83 @@ func TestLoadVersion(t *testing.T) {
 	testLoadVersionHelper(t, app, int64(2), commitID2)
 }
 
+func TestPruningOverrides(t *testing.T) {
+	testCases := map[string]struct {
+		pruning   pruningtypes.PruningOptions
+		overrides map[string]pruningtypes.PruningOptions
+		// the earliest versions kept by the stores after committing 20 blocks
+		earliestVersion1 int64
+		earliestVersion2 int64
+	}{
+		"override keeping everything": {
+			pruning: pruningtypes.NewCustomPruningOptions(2, 10),
+			overrides: map[string]pruningtypes.PruningOptions{
+				capKey2.Name(): pruningtypes.NewPruningOptions(pruningtypes.PruningNothing),
+			},
+			earliestVersion1: 18,
+			earliestVersion2: 1,
+		},
+		"override pruning a store": {
+			pruning: pruningtypes.NewPruningOptions(pruningtypes.PruningNothing),
+			overrides: map[string]pruningtypes.PruningOptions{
+				capKey2.Name(): pruningtypes.NewCustomPruningOptions(2, 10),
+			},
+			earliestVersion1: 1,
+			earliestVersion2: 18,
+		},
+		"override keeping more versions": {
+			pruning: pruningtypes.NewCustomPruningOptions(2, 10),
+			overrides: map[string]pruningtypes.PruningOptions{
+				capKey2.Name(): pruningtypes.NewCustomPruningOptions(5, 10),
+			},
+			earliestVersion1: 18,
+			earliestVersion2: 15,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			suite := NewBaseAppSuite(t, baseapp.SetPruningOverrides(tc.overrides), baseapp.SetPruning(tc.pruning))
+
+			for height := int64(1); height <= 20; height++ {
+				_, err := suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: height})
+				require.NoError(t, err)
+				_, err = suite.baseApp.Commit()
+				require.NoError(t, err)
+			}
+
+			rms := suite.baseApp.CommitMultiStore().(*rootmulti.Store)
+			for key, earliestVersion := range map[storetypes.StoreKey]int64{capKey1: tc.earliestVersion1, capKey2: tc.earliestVersion2} {
+				store := rms.GetCommitKVStore(key).(*iavl.Store)
+				require.False(t, store.VersionExists(earliestVersion-1), key.Name())
+				require.True(t, store.VersionExists(earliestVersion), key.Name())
+			}
+
+			// the stores pruned further than the others don't fail the queries
+			// of the others
+			version := min(tc.earliestVersion1, tc.earliestVersion2)
+			ms, err := suite.baseApp.QueryMultiStore(version)
+			require.NoError(t, err)
+			for key, earliestVersion := range map[storetypes.StoreKey]int64{capKey1: tc.earliestVersion1, capKey2: tc.earliestVersion2} {
+				if earliestVersion == version {
+					require.NotPanics(t, func() { ms.GetKVStore(key).Get([]byte("key")) }, key.Name())
+					continue
+				}
+				require.PanicsWithError(t, fmt.Sprintf("state of store %s at height %d is no longer available: %s", key.Name(), version, sdkerrors.ErrPrunedHeight), func() {
+					ms.GetKVStore(key).Get([]byte("key"))
+				})
+			}
+		})
+	}
+
+	// the pruning of an unknown store can't be overridden
+	app := baseapp.NewBaseApp(t.Name(), log.NewTestLogger(t), dbm.NewMemDB(), nil, baseapp.SetPruningOverrides(
+		map[string]pruningtypes.PruningOptions{"unknown": pruningtypes.NewPruningOptions(pruningtypes.PruningNothing)},
+	))
+	app.MountStores(capKey1)
+	require.ErrorContains(t, app.LoadLatestVersion(), "unknown store unknown")
+}
+
 func TestSetLoader(t *testing.T) {
 	useDefaultLoader := func(app *baseapp.BaseApp) {
 		app.SetStoreLoader(baseapp.DefaultStoreLoader)
@@ -636,9 +715,11 @@ func TestBaseAppAnteHandler(t *testing.T) {
 
 func TestBaseAppPostHandler(t *testing.T) {
 	postHandlerRun := false
+	var postHandlerTxResult *sdk.Result
 	anteOpt := func(bapp *baseapp.BaseApp) {
 		bapp.SetPostHandler(func(ctx sdk.Context, tx sdk.Tx, simulate, success bool) (newCtx sdk.Context, err error) {
 			postHandlerRun = true
+			postHandlerTxResult = ctx.TxResult()
 			return ctx, nil
 		})
 	}
@@ -664,8 +745,10 @@ func TestBaseAppPostHandler(t *testing.T) {
 	require.Empty(t, res.Events)
 	require.True(t, res.TxResults[0].IsOK(), fmt.Sprintf("%v", res))
 
-	// PostHandler runs on successful message execution
+	// PostHandler runs on successful message execution, with the tx result
 	require.True(t, postHandlerRun)
+	require.NotNil(t, postHandlerTxResult)
+	require.Len(t, postHandlerTxResult.MsgResponses, 1)
 
 	// It should also run on failed message execution
 	postHandlerRun = false
@@ -678,6 +761,7 @@ func TestBaseAppPostHandler(t *testing.T) {
 	require.False(t, res.TxResults[0].IsOK(), fmt.Sprintf("%v", res))
 
 	require.True(t, postHandlerRun)
+	require.Nil(t, postHandlerTxResult)
 
 	// regression test, should not panic when runMsgs fails
 	tx = wonkyMsg(t, suite.txConfig, tx)
@@ -740,6 +824,12 @@ func TestSetMinGasPrices(t *testing.T) {
 	require.Equal(t, minGasPrices, ctx.MinGasPrices())
 }
 
+func TestValidateErrorCodespaces(t *testing.T) {
+	// the collisions themselves are tested by the errors package, whose
+	// registry is global
+	require.NotPanics(t, func() { NewBaseAppSuite(t, baseapp.ValidateErrorCodespaces()) })
+}
+
 type ctxType string
 
 const (
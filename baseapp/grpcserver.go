@@ -25,42 +25,11 @@ func (app *BaseApp) RegisterGRPCServer(server gogogrpc.Server) {
 	// Define an interceptor for all gRPC queries: this interceptor will create
 	// a new sdk.Context, and pass it into the query handler.
 	interceptor := func(grpcCtx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
-		// If there's some metadata in the context, retrieve it.
-		md, ok := metadata.FromIncomingContext(grpcCtx)
-		if !ok {
-			return nil, status.Error(codes.Internal, "unable to retrieve metadata")
-		}
-
-		// Get height header from the request context, if present.
-		var height int64
-		if heightHeaders := md.Get(grpctypes.GRPCBlockHeightHeader); len(heightHeaders) == 1 {
-			height, err = strconv.ParseInt(heightHeaders[0], 10, 64)
-			if err != nil {
-				return nil, errorsmod.Wrapf(
-					sdkerrors.ErrInvalidRequest,
-					"Baseapp.RegisterGRPCServer: invalid height header %q: %v", grpctypes.GRPCBlockHeightHeader, err)
-			}
-			if err := checkNegativeHeight(height); err != nil {
-				return nil, err
-			}
-		}
-
-		// Create the sdk.Context. Passing false as 2nd arg, as we can't
-		// actually support proofs with gRPC right now.
-		sdkCtx, err := app.CreateQueryContext(height, false)
+		grpcCtx, md, err := app.grpcQueryContext(grpcCtx)
 		if err != nil {
 			return nil, err
 		}
 
-		// Add relevant gRPC headers
-		if height == 0 {
-			height = sdkCtx.BlockHeight() // If height was not set in the request, set it to the latest
-		}
-
-		// Attach the sdk.Context into the gRPC's context.Context.
-		grpcCtx = context.WithValue(grpcCtx, sdk.SdkContextKey, sdkCtx)
-
-		md = metadata.Pairs(grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(height, 10))
 		if err = grpc.SetHeader(grpcCtx, md); err != nil {
 			app.logger.Error("failed to set gRPC header", "err", err)
 		}
@@ -70,6 +39,22 @@ func (app *BaseApp) RegisterGRPCServer(server gogogrpc.Server) {
 		return handler(grpcCtx, req)
 	}
 
+	// Server streams get the same sdk.Context through their stream context.
+	streamInterceptor := func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		grpcCtx, md, err := app.grpcQueryContext(stream.Context())
+		if err != nil {
+			return err
+		}
+
+		if err = stream.SetHeader(md); err != nil {
+			app.logger.Error("failed to set gRPC header", "err", err)
+		}
+
+		app.logger.Debug("gRPC query stream received for method: " + info.FullMethod)
+
+		return handler(srv, &queryServerStream{ServerStream: stream, ctx: grpcCtx})
+	}
+
 	// Loop through all services and methods, add the interceptor, and register
 	// the service.
 	for _, data := range app.GRPCQueryRouter().serviceData {
@@ -89,14 +74,88 @@ func (app *BaseApp) RegisterGRPCServer(server gogogrpc.Server) {
 			}
 		}
 
+		newStreams := make([]grpc.StreamDesc, len(desc.Streams))
+		for i, streamDesc := range desc.Streams {
+			streamHandler := streamDesc.Handler
+			info := &grpc.StreamServerInfo{
+				FullMethod:     fmt.Sprintf("/%s/%s", desc.ServiceName, streamDesc.StreamName),
+				IsClientStream: streamDesc.ClientStreams,
+				IsServerStream: streamDesc.ServerStreams,
+			}
+			newStreams[i] = streamDesc
+			newStreams[i].Handler = func(srv interface{}, stream grpc.ServerStream) error {
+				return grpcmiddleware.ChainStreamServer(
+					grpcrecovery.StreamServerInterceptor(),
+					streamInterceptor,
+				)(srv, stream, info, streamHandler)
+			}
+		}
+
 		newDesc := &grpc.ServiceDesc{
 			ServiceName: desc.ServiceName,
 			HandlerType: desc.HandlerType,
 			Methods:     newMethods,
-			Streams:     desc.Streams,
+			Streams:     newStreams,
 			Metadata:    desc.Metadata,
 		}
 
 		server.RegisterService(newDesc, data.handler)
 	}
 }
+
+// grpcQueryContext attaches to grpcCtx the sdk.Context of the height requested
+// in its metadata, or of the latest height, and returns the metadata to send
+// back as header.
+func (app *BaseApp) grpcQueryContext(grpcCtx context.Context) (context.Context, metadata.MD, error) {
+	// If there's some metadata in the context, retrieve it.
+	md, ok := metadata.FromIncomingContext(grpcCtx)
+	if !ok {
+		return nil, nil, status.Error(codes.Internal, "unable to retrieve metadata")
+	}
+
+	// Get height header from the request context, if present.
+	var (
+		height int64
+		err    error
+	)
+	if heightHeaders := md.Get(grpctypes.GRPCBlockHeightHeader); len(heightHeaders) == 1 {
+		height, err = strconv.ParseInt(heightHeaders[0], 10, 64)
+		if err != nil {
+			return nil, nil, errorsmod.Wrapf(
+				sdkerrors.ErrInvalidRequest,
+				"Baseapp.RegisterGRPCServer: invalid height header %q: %v", grpctypes.GRPCBlockHeightHeader, err)
+		}
+		if err := checkNegativeHeight(height); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// Create the sdk.Context. Passing false as 2nd arg, as we can't
+	// actually support proofs with gRPC right now.
+	sdkCtx, err := app.CreateQueryContext(height, false)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Add relevant gRPC headers
+	if height == 0 {
+		height = sdkCtx.BlockHeight() // If height was not set in the request, set it to the latest
+	}
+
+	// Attach the sdk.Context into the gRPC's context.Context.
+	grpcCtx = context.WithValue(grpcCtx, sdk.SdkContextKey, sdkCtx)
+
+	return grpcCtx, metadata.Pairs(grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(height, 10)), nil
+}
+
+// queryServerStream is a grpc.ServerStream whose context carries the sdk.Context
+// of the query.
+type queryServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context implements grpc.ServerStream.
+func (s *queryServerStream) Context() context.Context {
+	return s.ctx
+}
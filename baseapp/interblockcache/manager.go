@@ -0,0 +1,196 @@
+package interblockcache
+
+import (
+	"math"
+	"runtime/debug"
+	"runtime/metrics"
+	"sort"
+	"sync"
+
+	storetypes "cosmossdk.io/store/types"
+)
+
+var _ storetypes.MultiStorePersistentCache = (*Manager)(nil)
+
+const (
+	// DefaultCacheSize is the default maximum number of entries cached per
+	// store.
+	DefaultCacheSize = 1000
+
+	// highMemoryPressure is the fraction of the memory limit above which the
+	// caches shrink.
+	highMemoryPressure = 0.9
+	// lowMemoryPressure is the fraction of the memory limit below which the
+	// caches grow back to their maximum size.
+	lowMemoryPressure = 0.7
+)
+
+// Config defines the configuration of the inter-block cache.
+type Config struct {
+	// Size is the maximum number of entries cached per store.
+	Size uint
+	// MinSize is the number of entries per store below which the caches don't
+	// shrink. It defaults to a sixteenth of Size.
+	MinSize uint
+	// MemoryLimit is the memory used by the process, in bytes, from which the
+	// caches shrink. The soft memory limit of the Go runtime, i.e. GOMEMLIMIT,
+	// is used if 0. The caches keep their maximum size if neither is set.
+	MemoryLimit uint64
+}
+
+// DefaultConfig returns the default configuration of the inter-block cache.
+func DefaultConfig() Config {
+	return Config{Size: DefaultCacheSize}
+}
+
+// Stats are the statistics of the inter-block cache of a store.
+type Stats struct {
+	StoreKey string
+	Len      int // number of cached entries
+	Capacity int // current maximum number of cached entries
+	Hits     uint64
+	Misses   uint64
+}
+
+// Manager maintains the inter-block caches of the stores of a multistore, by
+// store key. The caches are sized adaptively: they shrink, down to their
+// minimum size, while the memory used by the process is close to the memory
+// limit, and grow back, up to their maximum size, once the memory pressure is
+// low.
+//
+// The size of the caches is adjusted when the stores are committed, at most
+// once per version.
+type Manager struct {
+	maxSize     int
+	minSize     int
+	memoryLimit uint64
+	// readMemory returns the memory used by the process, mocked in tests.
+	readMemory func() uint64
+
+	mtx         sync.Mutex
+	size        int   // current maximum number of entries per store
+	lastVersion int64 // last version at which the size was adjusted
+	caches      map[string]*CommitKVStoreCache
+}
+
+// NewManager returns an inter-block cache manager with the given configuration.
+func NewManager(cfg Config) *Manager {
+	maxSize := int(cfg.Size)
+	if maxSize <= 0 {
+		maxSize = DefaultCacheSize
+	}
+	minSize := int(cfg.MinSize)
+	if minSize <= 0 {
+		minSize = max(maxSize/16, 1)
+	}
+	minSize = min(minSize, maxSize)
+
+	memoryLimit := cfg.MemoryLimit
+	if memoryLimit == 0 {
+		// a negative input only returns the current limit
+		if limit := debug.SetMemoryLimit(-1); limit != math.MaxInt64 {
+			memoryLimit = uint64(limit)
+		}
+	}
+
+	return &Manager{
+		maxSize:     maxSize,
+		minSize:     minSize,
+		memoryLimit: memoryLimit,
+		readMemory:  readMemory,
+		size:        maxSize,
+		caches:      make(map[string]*CommitKVStoreCache),
+	}
+}
+
+// GetStoreCache returns the inter-block cache of the store with the given key,
+// wrapping the store if there isn't one yet.
+func (m *Manager) GetStoreCache(key storetypes.StoreKey, store storetypes.CommitKVStore) storetypes.CommitKVStore {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if m.caches[key.Name()] == nil {
+		m.caches[key.Name()] = newCommitKVStoreCache(m, key.Name(), store, m.size)
+	}
+
+	return m.caches[key.Name()]
+}
+
+// Unwrap returns the store wrapped by the inter-block cache of the given store
+// key, nil if there is none.
+func (m *Manager) Unwrap(key storetypes.StoreKey) storetypes.CommitKVStore {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if ckv, ok := m.caches[key.Name()]; ok {
+		return ckv.CommitKVStore
+	}
+
+	return nil
+}
+
+// Reset drops the inter-block caches.
+func (m *Manager) Reset() {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	clear(m.caches)
+}
+
+// Stats returns the statistics of the inter-block caches, sorted by store key.
+func (m *Manager) Stats() []Stats {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	stats := make([]Stats, 0, len(m.caches))
+	for _, ckv := range m.caches {
+		stats = append(stats, ckv.Stats())
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		return stats[i].StoreKey < stats[j].StoreKey
+	})
+
+	return stats
+}
+
+// adjustSize adjusts the size of the caches to the memory pressure once per
+// version, and returns it.
+func (m *Manager) adjustSize(version int64) int {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if version <= m.lastVersion || m.memoryLimit == 0 {
+		return m.size
+	}
+	m.lastVersion = version
+
+	pressure := float64(m.readMemory()) / float64(m.memoryLimit)
+	switch {
+	case pressure >= highMemoryPressure:
+		m.size = max(m.size/2, m.minSize)
+	case pressure < lowMemoryPressure:
+		m.size = min(m.size*2, m.maxSize)
+	}
+
+	return m.size
+}
+
+// readMemory returns the memory mapped by the Go runtime and not released to
+// the OS, as accounted by the soft memory limit.
+func readMemory() uint64 {
+	samples := []metrics.Sample{
+		{Name: "/memory/classes/total:bytes"},
+		{Name: "/memory/classes/heap/released:bytes"},
+	}
+	metrics.Read(samples)
+
+	var total, released uint64
+	if samples[0].Value.Kind() == metrics.KindUint64 {
+		total = samples[0].Value.Uint64()
+	}
+	if samples[1].Value.Kind() == metrics.KindUint64 {
+		released = samples[1].Value.Uint64()
+	}
+
+	return total - released
+}
@@ -0,0 +1,135 @@
+package interblockcache
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	"github.com/hashicorp/go-metrics"
+	lru "github.com/hashicorp/golang-lru"
+
+	"cosmossdk.io/store/cachekv"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+var _ storetypes.CommitKVStore = (*CommitKVStoreCache)(nil)
+
+// CommitKVStoreCache is an inter-block (persistent) cache wrapping a
+// CommitKVStore. Reads first hit the internal LRU cache. During a cache miss,
+// the read is delegated to the underlying CommitKVStore and cached. Deletes and
+// writes always happen to both the cache and the CommitKVStore in a
+// write-through manner.
+//
+// The hits and misses are counted, and emitted as telemetry when the store is
+// committed.
+type CommitKVStoreCache struct {
+	storetypes.CommitKVStore
+
+	manager  *Manager
+	storeKey string
+	cache    *lru.Cache
+	size     atomic.Int64 // current maximum number of entries
+
+	hits   atomic.Uint64
+	misses atomic.Uint64
+	// the hits and misses emitted as telemetry so far
+	emittedHits   uint64
+	emittedMisses uint64
+}
+
+func newCommitKVStoreCache(manager *Manager, storeKey string, store storetypes.CommitKVStore, size int) *CommitKVStoreCache {
+	cache, err := lru.New(size)
+	if err != nil {
+		panic(fmt.Errorf("failed to create KVStore cache: %w", err))
+	}
+
+	ckv := &CommitKVStoreCache{
+		CommitKVStore: store,
+		manager:       manager,
+		storeKey:      storeKey,
+		cache:         cache,
+	}
+	ckv.size.Store(int64(size))
+
+	return ckv
+}
+
+// CacheWrap implements the CacheWrapper interface.
+func (ckv *CommitKVStoreCache) CacheWrap() storetypes.CacheWrap {
+	return cachekv.NewStore(ckv)
+}
+
+// Get retrieves a value by key. It will first look in the write-through cache.
+// If the value doesn't exist in the write-through cache, the query is delegated
+// to the underlying CommitKVStore.
+func (ckv *CommitKVStoreCache) Get(key []byte) []byte {
+	storetypes.AssertValidKey(key)
+
+	keyStr := string(key)
+	if value, ok := ckv.cache.Get(keyStr); ok {
+		ckv.hits.Add(1)
+		return value.([]byte)
+	}
+
+	// cache miss; write to cache
+	ckv.misses.Add(1)
+	value := ckv.CommitKVStore.Get(key)
+	ckv.cache.Add(keyStr, value)
+
+	return value
+}
+
+// Set inserts a key/value pair into both the write-through cache and the
+// underlying CommitKVStore.
+func (ckv *CommitKVStoreCache) Set(key, value []byte) {
+	storetypes.AssertValidKey(key)
+	storetypes.AssertValidValue(value)
+
+	ckv.cache.Add(string(key), value)
+	ckv.CommitKVStore.Set(key, value)
+}
+
+// Delete removes a key/value pair from both the write-through cache and the
+// underlying CommitKVStore.
+func (ckv *CommitKVStoreCache) Delete(key []byte) {
+	ckv.cache.Remove(string(key))
+	ckv.CommitKVStore.Delete(key)
+}
+
+// Commit commits the underlying CommitKVStore, then resizes the cache to the
+// memory pressure and emits its telemetry.
+func (ckv *CommitKVStoreCache) Commit() storetypes.CommitID {
+	commitID := ckv.CommitKVStore.Commit()
+
+	if size := ckv.manager.adjustSize(commitID.Version); int64(size) != ckv.size.Load() {
+		ckv.cache.Resize(size)
+		ckv.size.Store(int64(size))
+	}
+	ckv.emitTelemetry()
+
+	return commitID
+}
+
+// Stats returns the statistics of the cache.
+func (ckv *CommitKVStoreCache) Stats() Stats {
+	return Stats{
+		StoreKey: ckv.storeKey,
+		Len:      ckv.cache.Len(),
+		Capacity: int(ckv.size.Load()),
+		Hits:     ckv.hits.Load(),
+		Misses:   ckv.misses.Load(),
+	}
+}
+
+func (ckv *CommitKVStoreCache) emitTelemetry() {
+	labels := []metrics.Label{telemetry.NewLabel("store_key", ckv.storeKey)}
+
+	hits, misses := ckv.hits.Load(), ckv.misses.Load()
+	telemetry.IncrCounterWithLabels([]string{"store", "inter_block_cache", "hits"}, float32(hits-ckv.emittedHits), labels)
+	telemetry.IncrCounterWithLabels([]string{"store", "inter_block_cache", "misses"}, float32(misses-ckv.emittedMisses), labels)
+	ckv.emittedHits, ckv.emittedMisses = hits, misses
+
+	telemetry.SetGaugeWithLabels([]string{"store", "inter_block_cache", "len"}, float32(ckv.cache.Len()), labels)
+	telemetry.SetGaugeWithLabels([]string{"store", "inter_block_cache", "capacity"}, float32(ckv.size.Load()), labels)
+}
@@ -0,0 +1,103 @@
+package interblockcache
+
+import (
+	"fmt"
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/iavl"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+)
+
+func newStore(t *testing.T, key storetypes.StoreKey) storetypes.CommitKVStore {
+	t.Helper()
+	store, err := iavl.LoadStore(dbm.NewMemDB(), log.NewNopLogger(), key, storetypes.CommitID{}, iavl.DefaultIAVLCacheSize, false, metrics.NewNoOpMetrics())
+	require.NoError(t, err)
+	return store
+}
+
+func TestGetAndSet(t *testing.T) {
+	key := storetypes.NewKVStoreKey("store1")
+	store := newStore(t, key)
+	manager := NewManager(Config{Size: 100})
+	cache := manager.GetStoreCache(key, store)
+	require.Same(t, cache, manager.GetStoreCache(key, store))
+	require.Same(t, store, manager.Unwrap(key))
+	require.Nil(t, manager.Unwrap(storetypes.NewKVStoreKey("store2")))
+
+	// writes go through the cache
+	cache.Set([]byte("key1"), []byte("value1"))
+	require.Equal(t, []byte("value1"), store.Get([]byte("key1")))
+	require.Equal(t, []byte("value1"), cache.Get([]byte("key1")))
+
+	// misses are cached
+	store.Set([]byte("key2"), []byte("value2"))
+	require.Equal(t, []byte("value2"), cache.Get([]byte("key2")))
+	require.Equal(t, []byte("value2"), cache.Get([]byte("key2")))
+	require.Nil(t, cache.Get([]byte("key3")))
+
+	cache.Delete([]byte("key1"))
+	require.Nil(t, store.Get([]byte("key1")))
+	require.Nil(t, cache.Get([]byte("key1")))
+
+	require.Equal(t, []Stats{{StoreKey: "store1", Len: 3, Capacity: 100, Hits: 2, Misses: 3}}, manager.Stats())
+
+	manager.Reset()
+	require.Nil(t, manager.Unwrap(key))
+	require.Empty(t, manager.Stats())
+}
+
+func TestAdaptiveSize(t *testing.T) {
+	manager := NewManager(Config{Size: 100, MinSize: 20, MemoryLimit: 1000})
+	var memory uint64
+	manager.readMemory = func() uint64 { return memory }
+
+	key1, key2 := storetypes.NewKVStoreKey("store1"), storetypes.NewKVStoreKey("store2")
+	cache1 := manager.GetStoreCache(key1, newStore(t, key1)).(*CommitKVStoreCache)
+	cache2 := manager.GetStoreCache(key2, newStore(t, key2)).(*CommitKVStoreCache)
+	for i := 0; i < 100; i++ {
+		cache1.Set([]byte(fmt.Sprintf("key%d", i)), []byte("value"))
+		cache2.Set([]byte(fmt.Sprintf("key%d", i)), []byte("value"))
+	}
+
+	commit := func() {
+		cache1.Commit()
+		cache2.Commit()
+	}
+	requireCapacity := func(capacity, len int) {
+		t.Helper()
+		for _, stats := range manager.Stats() {
+			require.Equal(t, capacity, stats.Capacity, stats.StoreKey)
+			require.Equal(t, len, stats.Len, stats.StoreKey)
+		}
+	}
+
+	// the caches shrink while the memory pressure is high, down to their
+	// minimum size
+	memory = 950
+	commit()
+	requireCapacity(50, 50)
+	commit()
+	requireCapacity(25, 25)
+	commit()
+	requireCapacity(20, 20)
+
+	// the caches keep their size while the memory pressure is moderate
+	memory = 800
+	commit()
+	requireCapacity(20, 20)
+
+	// the caches grow back while the memory pressure is low, up to their
+	// maximum size
+	memory = 500
+	commit()
+	requireCapacity(40, 20)
+	commit()
+	requireCapacity(80, 20)
+	commit()
+	requireCapacity(100, 20)
+}
@@ -6,9 +6,11 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"time"
 
 	dbm "github.com/cosmos/cosmos-db"
 
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/store/metrics"
 	pruningtypes "cosmossdk.io/store/pruning/types"
 	"cosmossdk.io/store/snapshots"
@@ -27,7 +29,13 @@ import (
 
 // SetPruning sets a pruning option on the multistore associated with the app
 func SetPruning(opts pruningtypes.PruningOptions) func(*BaseApp) {
-	return func(bapp *BaseApp) { bapp.cms.SetPruning(opts) }
+	return func(bapp *BaseApp) { bapp.setPruning(opts, bapp.pruningOverrides) }
+}
+
+// SetPruningOverrides sets the pruning options of individual stores, by store
+// key name, overriding the pruning options of the app for these stores.
+func SetPruningOverrides(overrides map[string]pruningtypes.PruningOptions) func(*BaseApp) {
+	return func(bapp *BaseApp) { bapp.setPruning(bapp.getPruning(), overrides) }
 }
 
 // SetMinGasPrices returns an option that sets the minimum gas prices on the app.
@@ -49,6 +57,33 @@ func SetQueryGasLimit(queryGasLimit uint64) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.queryGasLimit = queryGasLimit }
 }
 
+// SetHistoricalQueryEnabled returns an option that enables or disables the
+// queries at a height other than the latest one. They are enabled by default.
+func SetHistoricalQueryEnabled(enabled bool) func(*BaseApp) {
+	return func(bapp *BaseApp) { bapp.historicalQueryDisabled = !enabled }
+}
+
+// SetArchivedSnapshotQueries returns an option that enables the queries at a
+// pruned height from the state sync snapshot taken at that height, which is
+// restored on disk into dir with the given DB backend. Restoring a snapshot is
+// expensive: a single snapshot is restored at once, at most once per
+// minRestoreInterval, and the last restored one is kept for the next queries.
+// They are disabled by default, or if dir is empty.
+func SetArchivedSnapshotQueries(dir string, backend dbm.BackendType, minRestoreInterval time.Duration) func(*BaseApp) {
+	return func(bapp *BaseApp) {
+		if dir == "" {
+			bapp.archivedSnapshots = nil
+			return
+		}
+
+		bapp.archivedSnapshots = &archivedSnapshots{
+			dir:                dir,
+			backend:            backend,
+			minRestoreInterval: minRestoreInterval,
+		}
+	}
+}
+
 // SetHaltHeight returns a BaseApp option function that sets the halt block height.
 func SetHaltHeight(blockHeight uint64) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.setHaltHeight(blockHeight) }
@@ -119,6 +154,43 @@ func SetOptimisticExecution(opts ...func(*oe.OptimisticExecution)) func(*BaseApp
 	}
 }
 
+// SetParallelTxExecution enables the parallel execution of the transactions of
+// a block whose messages declare non-conflicting store accesses, see sdk.MsgAccessSpec.
+// The transactions actually accessing the same keys are executed serially, so
+// that the resulting state doesn't depend on this option.
+//
+// The option is currently ineffective for transactions paying fees: the
+// DeductFeeDecorator of every such transaction reads and writes the balance of
+// the fee collector account, so that a batch of fee-paying transactions always
+// conflicts, and is executed once in parallel and then again serially. It only
+// speeds up blocks of transactions paying no fees, until the fees are
+// accumulated outside of the transactions.
+//
+// The keepers called by the transactions, including in the AnteHandler and
+// PostHandler, are called concurrently and must not mutate shared in-memory
+// state. The keepers of the modules of this repository only keep state in their
+// stores, and only read their other fields, e.g. hooks, permissions or blocked
+// addresses, which are set when the app is wired. The caches filled lazily on
+// the execution path are either guarded by a mutex, like the address string
+// caches of the types package and the descriptor caches of the unknownproto
+// package, or filled before the transactions are executed, like the GetSigners
+// functions of the signing context. The keepers of other modules must be checked
+// before enabling the option.
+func SetParallelTxExecution(enabled bool) func(*BaseApp) {
+	return func(app *BaseApp) { app.parallelTxExecution = enabled }
+}
+
+// ValidateErrorCodespaces validates that no error codespace is registered by
+// different packages, i.e. that the modules of the app don't collide on their
+// error codespaces. It panics on collision.
+func ValidateErrorCodespaces() func(*BaseApp) {
+	return func(*BaseApp) {
+		if err := errorsmod.ValidateRegistry(); err != nil {
+			panic(err)
+		}
+	}
+}
+
 func (app *BaseApp) SetName(name string) {
 	if app.sealed {
 		panic("SetName() on sealed BaseApp")
@@ -284,6 +356,14 @@ func (app *BaseApp) SetCommitMultiStoreTracer(w io.Writer) {
 	app.cms.SetTracer(w)
 }
 
+// SetCacheMultiStoreWrapper sets a function wrapping the branches of the
+// multistore used by the execution states, e.g. to instrument the store accesses
+// of the blocks and transactions. The wrapper must return branches of the given
+// multistore, and is applied from the next state reset on.
+func (app *BaseApp) SetCacheMultiStoreWrapper(wrapper func(storetypes.CacheMultiStore) storetypes.CacheMultiStore) {
+	app.cacheMultiStoreWrapper = wrapper
+}
+
 // SetStoreLoader allows us to customize the rootMultiStore initialization.
 func (app *BaseApp) SetStoreLoader(loader StoreLoader) {
 	if app.sealed {
@@ -303,7 +383,7 @@ func (app *BaseApp) SetSnapshot(snapshotStore *snapshots.Store, opts snapshottyp
 		return
 	}
 	app.cms.SetSnapshotInterval(opts.Interval)
-	app.snapshotManager = snapshots.NewManager(snapshotStore, opts, app.cms, nil, app.logger)
+	app.snapshotManager = snapshots.NewManager(snapshotStore, opts, pruningSnapshotter{app.cms, app}, nil, app.logger)
 }
 
 // SetInterfaceRegistry sets the InterfaceRegistry.
@@ -383,9 +463,33 @@ func (app *BaseApp) SetStoreMetrics(gatherer metrics.StoreMetrics) {
 
 // SetStreamingManager sets the streaming manager for the BaseApp.
 func (app *BaseApp) SetStreamingManager(manager storetypes.StreamingManager) {
+	app.streamingMtx.Lock()
+	defer app.streamingMtx.Unlock()
 	app.streamingManager = manager
 }
 
+// AddABCIListener adds an ABCIListener to the streaming manager of the BaseApp.
+// The listener receives the state changes of the stores exposed to streaming.
+// It is safe to add a listener once the node started, e.g. from a gRPC service
+// registered after the start of CometBFT.
+func (app *BaseApp) AddABCIListener(listener storetypes.ABCIListener) {
+	app.streamingMtx.Lock()
+	defer app.streamingMtx.Unlock()
+
+	// the listeners are copied, as the streaming manager is also copied into
+	// the contexts which may still be iterating them
+	listeners := make([]storetypes.ABCIListener, 0, len(app.streamingManager.ABCIListeners)+1)
+	listeners = append(listeners, app.streamingManager.ABCIListeners...)
+	app.streamingManager.ABCIListeners = append(listeners, listener)
+}
+
+// getStreamingManager returns the streaming manager of the BaseApp.
+func (app *BaseApp) getStreamingManager() storetypes.StreamingManager {
+	app.streamingMtx.RLock()
+	defer app.streamingMtx.RUnlock()
+	return app.streamingManager
+}
+
 // SetMsgServiceRouter sets the MsgServiceRouter of a BaseApp.
 func (app *BaseApp) SetMsgServiceRouter(msgServiceRouter *MsgServiceRouter) {
 	app.msgServiceRouter = msgServiceRouter
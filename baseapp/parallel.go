@@ -0,0 +1,212 @@
+package baseapp
+
+import (
+	"context"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// executeTxs executes the raw transactions of a block proposal and returns their
+// results, in order.
+//
+// If parallel tx execution is enabled, consecutive transactions whose messages
+// all implement sdk.MsgAccessSpec and declare disjoint sets of stores are
+// executed in parallel, each one in its own branch of the FinalizeBlock state,
+// see deliverTxsInParallel. Other transactions are executed serially. The
+// declared stores are only used to schedule the transactions: the keys they
+// actually access are verified, so that the resulting state is always the one
+// of their serial execution.
+func (app *BaseApp) executeTxs(ctx context.Context, txs [][]byte) ([]*abci.ExecTxResult, error) {
+	txResults := make([]*abci.ExecTxResult, 0, len(txs))
+	for i := 0; i < len(txs); {
+		n := 1
+		if app.parallelTxExecution {
+			n = app.parallelTxBatchSize(txs[i:])
+		}
+
+		if n == 1 {
+			txResults = append(txResults, app.deliverRawTx(txs[i]))
+		} else {
+			txResults = append(txResults, app.deliverTxsInParallel(txs[i:i+n])...)
+		}
+		i += n
+
+		// check after every tx, or batch of txs, if we should abort
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+			// continue
+		}
+	}
+
+	return txResults, nil
+}
+
+// deliverRawTx executes the raw transaction in the FinalizeBlock state.
+func (app *BaseApp) deliverRawTx(rawTx []byte) *abci.ExecTxResult {
+	// NOTE: Not all raw transactions may adhere to the sdk.Tx interface, e.g.
+	// vote extensions, so skip those.
+	if _, err := app.txDecoder(rawTx); err != nil {
+		// In the case where a transaction included in a block proposal is malformed,
+		// we still want to return a default response to comet. This is because comet
+		// expects a response for each transaction included in a block proposal.
+		return sdkerrors.ResponseExecTxResultWithEvents(
+			sdkerrors.ErrTxDecode,
+			0,
+			0,
+			nil,
+			false,
+		)
+	}
+
+	return app.deliverTx(rawTx)
+}
+
+// parallelTxBatchSize returns the number of consecutive transactions, from the
+// start of txs, which can be executed in parallel, i.e. whose store accesses
+// don't conflict. It returns 1 if the first transaction must be executed serially.
+func (app *BaseApp) parallelTxBatchSize(txs [][]byte) int {
+	accessed := make(map[string]bool)
+	for i, rawTx := range txs {
+		storeKeys, ok := app.txAccessedStoreKeys(rawTx)
+		if !ok {
+			return max(i, 1)
+		}
+
+		for storeKey := range storeKeys {
+			if accessed[storeKey] {
+				return max(i, 1)
+			}
+		}
+
+		for storeKey := range storeKeys {
+			accessed[storeKey] = true
+		}
+	}
+
+	return len(txs)
+}
+
+// txAccessedStoreKeys returns the set of stores accessed by the messages of the
+// raw transaction. It returns false if the transaction can't be decoded or if
+// one of its messages doesn't declare the stores it accesses.
+func (app *BaseApp) txAccessedStoreKeys(rawTx []byte) (map[string]bool, bool) {
+	tx, err := app.txDecoder(rawTx)
+	if err != nil {
+		return nil, false
+	}
+
+	storeKeys := make(map[string]bool)
+	for _, msg := range tx.GetMsgs() {
+		spec, ok := msg.(sdk.MsgAccessSpec)
+		if !ok {
+			return nil, false
+		}
+
+		for _, storeKey := range spec.AccessedStoreKeys() {
+			storeKeys[storeKey] = true
+		}
+	}
+
+	return storeKeys, true
+}
+
+// deliverTxsInParallel executes the raw transactions in parallel, each one in
+// its own branch of the FinalizeBlock state recording the keys it accesses,
+// including in the AnteHandler and PostHandler. The branches are then written in
+// the order of the transactions, as long as each transaction doesn't read a key
+// written by the previous ones and doesn't exceed the block gas limit, which is
+// only known after its execution. Otherwise, the results of the transactions
+// would depend on their order, so the remaining transactions are executed
+// serially instead.
+//
+// NOTE: as the fees are sent to the fee collector account in the AnteHandler,
+// every fee-paying transaction reads the balance written by the previous one,
+// so a batch of them is executed serially again from its second transaction,
+// see SetParallelTxExecution.
+func (app *BaseApp) deliverTxsInParallel(txs [][]byte) []*abci.ExecTxResult {
+	blockGasMeter := app.finalizeBlockState.Context().BlockGasMeter()
+
+	txResults := make([]*abci.ExecTxResult, len(txs))
+	branches := make([]storetypes.CacheMultiStore, len(txs))
+	accesses := make([]*storeAccesses, len(txs))
+	gasMeters := make([]storetypes.GasMeter, len(txs))
+
+	var wg sync.WaitGroup
+	for i, rawTx := range txs {
+		app.cacheMsgSignersFns(rawTx)
+
+		ctx := app.getContextForTx(execModeFinalize, rawTx)
+		accesses[i] = newStoreAccesses()
+		branches[i] = accessTrackingMultiStore{
+			cacheMultiStore: ctx.MultiStore().CacheMultiStore(),
+			accesses:        accesses[i],
+		}
+		gasMeters[i] = storetypes.NewInfiniteGasMeter()
+
+		// the transactions must not share any mutable state
+		ctx = ctx.WithMultiStore(branches[i]).
+			WithBlockGasMeter(gasMeters[i]).
+			WithGasMeter(storetypes.NewInfiniteGasMeter()).
+			WithEventManager(sdk.NewEventManager())
+
+		wg.Add(1)
+		go func(i int, ctx sdk.Context, rawTx []byte) {
+			defer wg.Done()
+			txResults[i] = app.deliverTxWithContext(ctx, rawTx)
+		}(i, ctx, rawTx)
+	}
+	wg.Wait()
+
+	written := newStoreAccesses()
+	consumed, limit := blockGasMeter.GasConsumed(), blockGasMeter.Limit()
+	for i := range txs {
+		gas := gasMeters[i].GasConsumed()
+		conflict := accesses[i].readsAnyWrite(written)
+		if conflict || consumed >= limit || gas > limit-consumed {
+			app.logger.Debug("parallel txs conflict or exceed the block gas limit, executing them serially",
+				"txs", len(txs)-i, "conflict", conflict)
+
+			for j := i; j < len(txs); j++ {
+				txResults[j] = app.deliverRawTx(txs[j])
+			}
+
+			return txResults
+		}
+
+		branches[i].Write()
+		blockGasMeter.ConsumeGas(gas, "block gas meter")
+		consumed += gas
+		written.addWrites(accesses[i])
+	}
+
+	return txResults
+}
+
+// cacheMsgSignersFns resolves the signers of the messages of the raw transaction,
+// so that the functions getting the signers of their types, which the signing
+// context caches lazily, are cached before the transaction is executed
+// concurrently with others.
+func (app *BaseApp) cacheMsgSignersFns(rawTx []byte) {
+	tx, err := app.txDecoder(rawTx)
+	if err != nil {
+		return
+	}
+
+	msgsV2, err := tx.GetMsgsV2()
+	if err != nil {
+		return
+	}
+
+	for _, msg := range msgsV2 {
+		// errors are returned by the execution of the transaction
+		_, _ = app.cdc.GetMsgV2Signers(msg)
+	}
+}
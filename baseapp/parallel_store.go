@@ -0,0 +1,197 @@
+package baseapp
+
+import (
+	"bytes"
+	"io"
+
+	"cosmossdk.io/store/cachekv"
+	storetypes "cosmossdk.io/store/types"
+)
+
+// keyRange is a range of keys iterated over, from start, inclusive, to end,
+// exclusive, a nil bound being unbounded.
+type keyRange struct {
+	start, end []byte
+}
+
+func (r keyRange) contains(key []byte) bool {
+	return (r.start == nil || bytes.Compare(key, r.start) >= 0) &&
+		(r.end == nil || bytes.Compare(key, r.end) < 0)
+}
+
+// storeAccesses records the keys read and written, and the ranges iterated
+// over, in the KVStores of a multistore, by store key name. It is not safe for
+// concurrent use: each transaction executed in parallel records its accesses
+// in its own storeAccesses.
+type storeAccesses struct {
+	reads  map[string]map[string]struct{}
+	writes map[string]map[string]struct{}
+	ranges map[string][]keyRange
+}
+
+func newStoreAccesses() *storeAccesses {
+	return &storeAccesses{
+		reads:  make(map[string]map[string]struct{}),
+		writes: make(map[string]map[string]struct{}),
+		ranges: make(map[string][]keyRange),
+	}
+}
+
+func (a *storeAccesses) recordRead(storeKey string, key []byte) {
+	addKey(a.reads, storeKey, key)
+}
+
+func (a *storeAccesses) recordWrite(storeKey string, key []byte) {
+	addKey(a.writes, storeKey, key)
+}
+
+func (a *storeAccesses) recordIteration(storeKey string, start, end []byte) {
+	a.ranges[storeKey] = append(a.ranges[storeKey], keyRange{
+		start: bytes.Clone(start),
+		end:   bytes.Clone(end),
+	})
+}
+
+// addWrites records the keys written by other as written.
+func (a *storeAccesses) addWrites(other *storeAccesses) {
+	for storeKey, keys := range other.writes {
+		for key := range keys {
+			addKey(a.writes, storeKey, []byte(key))
+		}
+	}
+}
+
+// readsAnyWrite returns true if the keys read, or the ranges iterated over,
+// include a key written in other.
+func (a *storeAccesses) readsAnyWrite(other *storeAccesses) bool {
+	for storeKey, keys := range other.writes {
+		reads, ranges := a.reads[storeKey], a.ranges[storeKey]
+		for key := range keys {
+			if _, ok := reads[key]; ok {
+				return true
+			}
+
+			for _, r := range ranges {
+				if r.contains([]byte(key)) {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+func addKey(accesses map[string]map[string]struct{}, storeKey string, key []byte) {
+	keys, ok := accesses[storeKey]
+	if !ok {
+		keys = make(map[string]struct{})
+		accesses[storeKey] = keys
+	}
+	keys[string(key)] = struct{}{}
+}
+
+// cacheMultiStore is embedded by accessTrackingMultiStore, overriding its
+// CacheMultiStore method.
+type cacheMultiStore = storetypes.CacheMultiStore
+
+var _ storetypes.CacheMultiStore = accessTrackingMultiStore{}
+
+// accessTrackingMultiStore is a branch of a multistore whose KVStores, and
+// branches, record their accesses.
+type accessTrackingMultiStore struct {
+	cacheMultiStore
+
+	accesses *storeAccesses
+}
+
+// GetKVStore returns the KVStore of the given key, recording its accesses.
+func (ms accessTrackingMultiStore) GetKVStore(key storetypes.StoreKey) storetypes.KVStore {
+	return &accessTrackingKVStore{
+		KVStore:  ms.cacheMultiStore.GetKVStore(key),
+		storeKey: key.Name(),
+		accesses: ms.accesses,
+	}
+}
+
+// GetStore returns the KVStore of the given key, recording its accesses.
+func (ms accessTrackingMultiStore) GetStore(key storetypes.StoreKey) storetypes.Store {
+	return ms.GetKVStore(key)
+}
+
+// CacheMultiStore returns a branch of the multistore recording its accesses.
+// The writes of a branch are recorded even if it is discarded, which only
+// makes the conflicts detection more conservative.
+func (ms accessTrackingMultiStore) CacheMultiStore() storetypes.CacheMultiStore {
+	return accessTrackingMultiStore{
+		cacheMultiStore: ms.cacheMultiStore.CacheMultiStore(),
+		accesses:        ms.accesses,
+	}
+}
+
+// CacheWrap returns a branch of the multistore recording its accesses.
+func (ms accessTrackingMultiStore) CacheWrap() storetypes.CacheWrap {
+	return ms.CacheMultiStore()
+}
+
+// SetTracer sets the tracer of the multistore, still recording its accesses.
+func (ms accessTrackingMultiStore) SetTracer(w io.Writer) storetypes.MultiStore {
+	return accessTrackingMultiStore{
+		cacheMultiStore: ms.cacheMultiStore.SetTracer(w).(storetypes.CacheMultiStore),
+		accesses:        ms.accesses,
+	}
+}
+
+// SetTracingContext sets the tracing context of the multistore, still
+// recording its accesses.
+func (ms accessTrackingMultiStore) SetTracingContext(tc storetypes.TraceContext) storetypes.MultiStore {
+	return accessTrackingMultiStore{
+		cacheMultiStore: ms.cacheMultiStore.SetTracingContext(tc).(storetypes.CacheMultiStore),
+		accesses:        ms.accesses,
+	}
+}
+
+var _ storetypes.KVStore = (*accessTrackingKVStore)(nil)
+
+// accessTrackingKVStore is a KVStore recording its accesses.
+type accessTrackingKVStore struct {
+	storetypes.KVStore
+
+	storeKey string
+	accesses *storeAccesses
+}
+
+func (s *accessTrackingKVStore) Get(key []byte) []byte {
+	s.accesses.recordRead(s.storeKey, key)
+	return s.KVStore.Get(key)
+}
+
+func (s *accessTrackingKVStore) Has(key []byte) bool {
+	s.accesses.recordRead(s.storeKey, key)
+	return s.KVStore.Has(key)
+}
+
+func (s *accessTrackingKVStore) Set(key, value []byte) {
+	s.accesses.recordWrite(s.storeKey, key)
+	s.KVStore.Set(key, value)
+}
+
+func (s *accessTrackingKVStore) Delete(key []byte) {
+	s.accesses.recordWrite(s.storeKey, key)
+	s.KVStore.Delete(key)
+}
+
+func (s *accessTrackingKVStore) Iterator(start, end []byte) storetypes.Iterator {
+	s.accesses.recordIteration(s.storeKey, start, end)
+	return s.KVStore.Iterator(start, end)
+}
+
+func (s *accessTrackingKVStore) ReverseIterator(start, end []byte) storetypes.Iterator {
+	s.accesses.recordIteration(s.storeKey, start, end)
+	return s.KVStore.ReverseIterator(start, end)
+}
+
+// CacheWrap returns a branch of the KVStore recording its accesses.
+func (s *accessTrackingKVStore) CacheWrap() storetypes.CacheWrap {
+	return cachekv.NewStore(s)
+}
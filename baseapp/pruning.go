@@ -0,0 +1,263 @@
+package baseapp
+
+import (
+	"fmt"
+	"io"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/cachekv"
+	"cosmossdk.io/store/cachemulti"
+	"cosmossdk.io/store/dbadapter"
+	"cosmossdk.io/store/iavl"
+	"cosmossdk.io/store/pruning"
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	"cosmossdk.io/store/rootmulti"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+	storetypes "cosmossdk.io/store/types"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// setPruning sets the pruning options of the stores, overridden for some stores
+// by store key name.
+//
+// The multistore prunes all the stores with the options keeping the most states.
+// The stores whose options keep fewer states are then pruned further after each
+// commit by their own prune manager, see pruneStores.
+func (app *BaseApp) setPruning(opts pruningtypes.PruningOptions, overrides map[string]pruningtypes.PruningOptions) {
+	app.pruning = opts
+	app.pruningOverrides = overrides
+
+	multiStoreOpts := opts
+	for _, override := range overrides {
+		switch {
+		case multiStoreOpts.GetPruningStrategy() == pruningtypes.PruningNothing:
+		case override.GetPruningStrategy() == pruningtypes.PruningNothing:
+			multiStoreOpts = override
+		case override.KeepRecent > multiStoreOpts.KeepRecent:
+			multiStoreOpts = pruningtypes.NewCustomPruningOptions(override.KeepRecent, multiStoreOpts.Interval)
+		}
+	}
+
+	app.cms.SetPruning(multiStoreOpts)
+}
+
+// getPruning returns the pruning options of the stores which aren't overridden.
+func (app *BaseApp) getPruning() pruningtypes.PruningOptions {
+	if app.pruningOverrides == nil {
+		return app.cms.GetPruning()
+	}
+
+	return app.pruning
+}
+
+// storePruningPrefix is the prefix of the state of the prune managers of the
+// stores pruned further than the multistore in the application database.
+const storePruningPrefix = "baseapp/pruning/"
+
+// storePruning is the prune manager of a store pruned further than the
+// multistore.
+type storePruning struct {
+	key     storetypes.StoreKey
+	manager *pruning.Manager
+}
+
+// initStorePruning returns an error if the pruning of a store which isn't an
+// IAVL store of the multistore is overridden, or if the overriding options are
+// invalid. It then sets up the prune managers of the stores whose pruning
+// options keep fewer states than the multistore ones.
+func (app *BaseApp) initStorePruning() error {
+	if len(app.pruningOverrides) == 0 {
+		return nil
+	}
+
+	rms, ok := app.cms.(*rootmulti.Store)
+	if !ok {
+		return fmt.Errorf("cannot override the pruning of the stores of %T", app.cms)
+	}
+
+	keys := rms.StoreKeysByName()
+	for name, opts := range app.pruningOverrides {
+		key, ok := keys[name]
+		if !ok {
+			return fmt.Errorf("cannot override the pruning of unknown store %s", name)
+		}
+		if storeType := rms.GetCommitKVStore(key).GetStoreType(); storeType != storetypes.StoreTypeIAVL {
+			return fmt.Errorf("cannot override the pruning of store %s of type %s", name, storeType)
+		}
+		if err := opts.Validate(); err != nil {
+			return fmt.Errorf("invalid pruning options of store %s: %w", name, err)
+		}
+	}
+
+	var snapshotInterval uint64
+	if app.snapshotManager != nil {
+		snapshotInterval = app.snapshotManager.GetInterval()
+	}
+
+	multiStoreOpts := rms.GetPruning()
+	app.storePruning = make(map[string]storePruning)
+	for name, key := range keys {
+		if rms.GetCommitKVStore(key).GetStoreType() != storetypes.StoreTypeIAVL {
+			continue
+		}
+
+		opts, ok := app.pruningOverrides[name]
+		if !ok {
+			opts = app.pruning
+		}
+		if opts == multiStoreOpts {
+			continue
+		}
+
+		// the same prune manager as the multistore, keeping the heights of the
+		// snapshots until they are taken, with its own state
+		var db dbm.DB = dbm.NewMemDB()
+		if app.db != nil {
+			db = dbm.NewPrefixDB(app.db, []byte(storePruningPrefix+name+"/"))
+		}
+		manager := pruning.NewManager(db, app.logger.With("store", name))
+		manager.SetOptions(opts)
+		manager.SetSnapshotInterval(snapshotInterval)
+		if err := manager.LoadSnapshotHeights(db); err != nil {
+			return fmt.Errorf("failed to load the snapshot heights of store %s: %w", name, err)
+		}
+
+		app.storePruning[name] = storePruning{key: key, manager: manager}
+	}
+
+	return nil
+}
+
+// pruneStores prunes the stores whose pruning options keep fewer states than the
+// multistore ones with their prune managers, after the multistore committed
+// and pruned the given height.
+func (app *BaseApp) pruneStores(height int64) {
+	if len(app.storePruning) == 0 {
+		return
+	}
+
+	rms, ok := app.cms.(*rootmulti.Store)
+	if !ok {
+		return
+	}
+
+	for name, sp := range app.storePruning {
+		pruneHeight := sp.manager.GetPruningHeight(height)
+		if pruneHeight <= 0 {
+			continue
+		}
+
+		store, ok := rms.GetCommitKVStore(sp.key).(*iavl.Store)
+		if !ok {
+			continue
+		}
+
+		if err := store.DeleteVersionsTo(pruneHeight); err != nil {
+			app.logger.Error("failed to prune store", "store", name, "height", pruneHeight, "err", err)
+		}
+	}
+}
+
+// pruningSnapshotter is the Snapshotter of the snapshot manager, which also
+// notifies the prune managers of the stores pruned further than the multistore
+// of the snapshots taken, as the multistore does for its own.
+type pruningSnapshotter struct {
+	snapshottypes.Snapshotter
+	app *BaseApp
+}
+
+// PruneSnapshotHeight implements snapshottypes.Snapshotter.
+func (s pruningSnapshotter) PruneSnapshotHeight(height int64) {
+	s.Snapshotter.PruneSnapshotHeight(height)
+	for _, sp := range s.app.storePruning {
+		sp.manager.HandleSnapshotHeight(height)
+	}
+}
+
+// cacheMultiStoreWithPrunedStores returns a branch of the multistore at the
+// given version, in which the stores pruned further than the multistore may be
+// missing the version: accessing such a store then panics with an error
+// wrapping ErrPrunedHeight, which fails the query. It returns false if another
+// store is missing the version.
+func (app *BaseApp) cacheMultiStoreWithPrunedStores(version int64) (storetypes.CacheMultiStore, bool) {
+	if len(app.storePruning) == 0 || app.qms != nil {
+		return nil, false
+	}
+
+	rms, ok := app.cms.(*rootmulti.Store)
+	if !ok {
+		return nil, false
+	}
+
+	commitInfo, err := rms.GetCommitInfo(version)
+	if err != nil {
+		return nil, false
+	}
+	existed := make(map[string]bool)
+	for _, storeInfo := range commitInfo.StoreInfos {
+		existed[storeInfo.Name] = true
+	}
+
+	keys := rms.StoreKeysByName()
+	stores := make(map[storetypes.StoreKey]storetypes.CacheWrapper, len(keys))
+	for name, key := range keys {
+		store := rms.GetCommitKVStore(key)
+		iavlStore, ok := store.(*iavl.Store)
+		if !ok {
+			stores[key] = store
+			continue
+		}
+
+		immutable, err := iavlStore.GetImmutable(version)
+		switch {
+		case err == nil:
+			stores[key] = immutable
+		case !existed[name]:
+			// the store was added after the version
+			stores[key] = dbadapter.Store{DB: dbm.NewMemDB()}
+		default:
+			if _, ok := app.storePruning[name]; !ok {
+				return nil, false
+			}
+			stores[key] = prunedStore{name: name, version: version}
+		}
+	}
+
+	return cachemulti.NewStore(dbm.NewMemDB(), stores, keys, nil, nil), true
+}
+
+var _ storetypes.KVStore = prunedStore{}
+
+// prunedStore is a store whose state at a version has been pruned, which panics
+// when accessed.
+type prunedStore struct {
+	name    string
+	version int64
+}
+
+func (s prunedStore) panic() {
+	panic(errorsmod.Wrapf(sdkerrors.ErrPrunedHeight, "state of store %s at height %d is no longer available", s.name, s.version))
+}
+
+func (s prunedStore) GetStoreType() storetypes.StoreType { return storetypes.StoreTypeIAVL }
+
+func (s prunedStore) CacheWrap() storetypes.CacheWrap { return cachekv.NewStore(s) }
+
+func (s prunedStore) CacheWrapWithTrace(_ io.Writer, _ storetypes.TraceContext) storetypes.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+func (s prunedStore) Get([]byte) []byte { s.panic(); return nil }
+
+func (s prunedStore) Has([]byte) bool { s.panic(); return false }
+
+func (s prunedStore) Set([]byte, []byte) { s.panic() }
+
+func (s prunedStore) Delete([]byte) { s.panic() }
+
+func (s prunedStore) Iterator([]byte, []byte) storetypes.Iterator { s.panic(); return nil }
+
+func (s prunedStore) ReverseIterator([]byte, []byte) storetypes.Iterator { s.panic(); return nil }
@@ -0,0 +1,323 @@
+package baseapp
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	errorsmod "cosmossdk.io/errors"
+	storemetrics "cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	"cosmossdk.io/store/snapshots"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+	storetypes "cosmossdk.io/store/types"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DefaultArchivedRestoreInterval is the default minimum interval between two
+// restores of archived snapshots, see SetArchivedSnapshotQueries.
+const DefaultArchivedRestoreInterval = time.Minute
+
+// initialHeightKey is the key of the initial height of the chain in the
+// application database, persisted in InitChain to tell the heights which never
+// existed from the pruned ones after a restart.
+var initialHeightKey = []byte("baseapp/initial_height")
+
+// QueryMultiStore returns a branch of the multistore at the given version, i.e.
+// block height, for queries. The latest version is used if version is 0.
+//
+// If the state at the version has been pruned and archived snapshot queries
+// are enabled, see SetArchivedSnapshotQueries, it falls back to the state sync
+// snapshot taken at that version, if still archived.
+//
+// The returned errors distinguish the versions which never existed, wrapping
+// ErrInvalidHeight, from the ones which have been pruned, wrapping
+// ErrPrunedHeight. If historical queries are disabled, only the latest version
+// can be queried.
+func (app *BaseApp) QueryMultiStore(version int64) (storetypes.CacheMultiStore, error) {
+	if err := checkNegativeHeight(version); err != nil {
+		return nil, err
+	}
+
+	// use custom query multi-store if provided
+	qms := app.qms
+	if qms == nil {
+		qms = app.cms.(storetypes.MultiStore)
+	}
+
+	latest := qms.LatestVersion()
+	if latest == 0 {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidHeight, "%s is not ready; please wait for first block", app.Name())
+	}
+
+	if version == 0 {
+		version = latest
+	}
+
+	if version > latest {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidHeight, "height %d does not exist yet; latest height is %d", version, latest)
+	}
+
+	if version != latest && app.historicalQueryDisabled {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotSupported, "historical queries are disabled; only the latest height %d can be queried", latest)
+	}
+
+	cacheMS, err := qms.CacheMultiStoreWithVersion(version)
+	if err == nil {
+		return cacheMS, nil
+	}
+	if version == latest {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "failed to load state at height %d; %s", version, err)
+	}
+
+	// the stores pruned further than the multistore may be missing the version
+	if cacheMS, ok := app.cacheMultiStoreWithPrunedStores(version); ok {
+		return cacheMS, nil
+	}
+
+	// the initial height is only known once the state isn't found, as it is
+	// read from the database after a restart
+	if initialHeight, ok := app.chainInitialHeight(); ok && version < initialHeight {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidHeight, "height %d never existed; the chain starts at height %d", version, initialHeight)
+	}
+
+	if app.archivedSnapshots == nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrPrunedHeight,
+			"state at height %d is no longer available; %s (latest height: %d)", version, err, latest)
+	}
+
+	archivedStore, archiveErr := app.archivedMultiStore(version)
+	if archiveErr != nil {
+		app.logger.Debug("no archived snapshot to query pruned height", "height", version, "err", archiveErr)
+		return nil, errorsmod.Wrapf(sdkerrors.ErrPrunedHeight,
+			"state at height %d is no longer available; %s (latest height: %d)", version, archiveErr, latest)
+	}
+
+	return archivedStore.CacheMultiStoreWithVersion(version)
+}
+
+// chainInitialHeight returns the initial height of the chain, set in InitChain
+// or persisted in the application database, if known.
+func (app *BaseApp) chainInitialHeight() (int64, bool) {
+	if app.initialHeight > 0 {
+		return app.initialHeight, true
+	}
+	if app.db == nil {
+		return 0, false
+	}
+
+	bz, err := app.db.Get(initialHeightKey)
+	if err != nil || len(bz) != 8 {
+		// the chain was initialized before the initial height was persisted
+		return 0, false
+	}
+
+	return int64(binary.BigEndian.Uint64(bz)), true
+}
+
+// persistInitialHeight persists the initial height of the chain in the
+// application database.
+func (app *BaseApp) persistInitialHeight() error {
+	if app.db == nil {
+		return nil
+	}
+
+	return app.db.SetSync(initialHeightKey, binary.BigEndian.AppendUint64(nil, uint64(app.initialHeight)))
+}
+
+// archivedSnapshots holds the multistores restored from the archived state
+// sync snapshots, to serve the queries at pruned heights.
+type archivedSnapshots struct {
+	dir                string
+	backend            dbm.BackendType
+	minRestoreInterval time.Duration
+
+	mtx         sync.Mutex
+	restoring   bool
+	lastRestore time.Time
+	// store is the last restored multistore, and prev the one it replaced,
+	// which is only closed once replaced in turn so that the queries still
+	// using it complete.
+	store, prev *archivedStore
+}
+
+type archivedStore struct {
+	*rootmulti.Store
+	db     dbm.DB
+	dbName string
+}
+
+func (a *archivedSnapshots) newStore(version int64) (*archivedStore, error) {
+	name := fmt.Sprintf("snapshot-%d", version)
+	// remove the leftovers of a restore interrupted by a crash
+	if err := os.RemoveAll(filepath.Join(a.dir, name+".db")); err != nil {
+		return nil, err
+	}
+
+	db, err := dbm.NewDB(name, a.backend, a.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	return &archivedStore{db: db, dbName: name}, nil
+}
+
+func (a *archivedSnapshots) close(s *archivedStore) error {
+	if s == nil {
+		return nil
+	}
+
+	return errors.Join(s.db.Close(), os.RemoveAll(filepath.Join(a.dir, s.dbName+".db")))
+}
+
+// Close closes and removes the restored multistores.
+func (a *archivedSnapshots) Close() error {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	err := errors.Join(a.close(a.prev), a.close(a.store))
+	a.store, a.prev = nil, nil
+	return err
+}
+
+// archivedMultiStore returns the multistore restored from the snapshot taken at
+// the given version. A single snapshot is restored at once, at most once per
+// minimum restore interval.
+func (app *BaseApp) archivedMultiStore(version int64) (*rootmulti.Store, error) {
+	a := app.archivedSnapshots
+
+	a.mtx.Lock()
+	if a.store != nil && a.store.LatestVersion() == version {
+		defer a.mtx.Unlock()
+		return a.store.Store, nil
+	}
+	if a.restoring {
+		a.mtx.Unlock()
+		return nil, errors.New("another snapshot is being restored")
+	}
+	if next := a.lastRestore.Add(a.minRestoreInterval); time.Now().Before(next) {
+		a.mtx.Unlock()
+		return nil, fmt.Errorf("no snapshot can be restored before %s", next.Format(time.RFC3339))
+	}
+	a.restoring = true
+	a.mtx.Unlock()
+
+	store, err := app.restoreArchivedSnapshot(version)
+
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+	a.restoring = false
+	if err != nil {
+		return nil, err
+	}
+
+	a.lastRestore = time.Now()
+	if err := a.close(a.prev); err != nil {
+		app.logger.Error("failed to remove archived snapshot store", "err", err)
+	}
+	a.prev, a.store = a.store, store
+
+	return store.Store, nil
+}
+
+// restoreArchivedSnapshot restores the snapshot taken at the given version on
+// disk.
+func (app *BaseApp) restoreArchivedSnapshot(version int64) (*archivedStore, error) {
+	if app.snapshotManager == nil {
+		return nil, fmt.Errorf("snapshots are disabled")
+	}
+
+	rms, ok := app.cms.(*rootmulti.Store)
+	if !ok {
+		return nil, fmt.Errorf("cannot restore snapshots into %T", app.cms)
+	}
+
+	snapshotList, err := app.snapshotManager.List()
+	if err != nil {
+		return nil, err
+	}
+
+	var snapshot *snapshottypes.Snapshot
+	for _, s := range snapshotList {
+		if s.Height == uint64(version) && s.Format == snapshottypes.CurrentFormat {
+			snapshot = s
+			break
+		}
+	}
+	if snapshot == nil {
+		return nil, fmt.Errorf("no snapshot at height %d", version)
+	}
+
+	app.logger.Info("restoring archived snapshot for historical queries", "height", version)
+
+	// the snapshot is restored into the same stores as the multistore
+	store, err := app.archivedSnapshots.newStore(version)
+	if err != nil {
+		return nil, err
+	}
+	store.Store = rootmulti.NewStore(store.db, app.logger, storemetrics.NewNoOpMetrics())
+	for _, key := range rms.StoreKeysByName() {
+		switch key.(type) {
+		case *storetypes.KVStoreKey:
+			store.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+		case *storetypes.TransientStoreKey:
+			store.MountStoreWithDB(key, storetypes.StoreTypeTransient, nil)
+		case *storetypes.MemoryStoreKey:
+			store.MountStoreWithDB(key, storetypes.StoreTypeMemory, nil)
+		}
+	}
+
+	if err := app.restoreSnapshotInto(store.Store, snapshot); err != nil {
+		return nil, errors.Join(err, app.archivedSnapshots.close(store))
+	}
+
+	return store, nil
+}
+
+func (app *BaseApp) restoreSnapshotInto(store *rootmulti.Store, snapshot *snapshottypes.Snapshot) error {
+	if err := store.LoadLatestVersion(); err != nil {
+		return err
+	}
+
+	var loadErr error
+	chunks := make(chan io.ReadCloser)
+	go func() {
+		defer close(chunks)
+		for i := uint32(0); i < snapshot.Chunks; i++ {
+			chunk, err := app.snapshotManager.LoadChunk(snapshot.Height, snapshot.Format, i)
+			if err != nil || chunk == nil {
+				loadErr = fmt.Errorf("failed to load snapshot chunk %d: %v", i, err)
+				return
+			}
+			chunks <- io.NopCloser(bytes.NewReader(chunk))
+		}
+	}()
+
+	streamReader, err := snapshots.NewStreamReader(chunks)
+	if err != nil {
+		snapshots.DrainChunks(chunks)
+		return err
+	}
+
+	// the extension payloads following the stores aren't needed for queries
+	_, err = store.Restore(snapshot.Height, snapshot.Format, streamReader)
+	streamReader.Close()
+	snapshots.DrainChunks(chunks)
+	if loadErr != nil {
+		return loadErr
+	}
+	if err != nil {
+		return fmt.Errorf("failed to restore snapshot at height %d: %w", snapshot.Height, err)
+	}
+
+	return nil
+}
@@ -4,12 +4,17 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
 	"github.com/stretchr/testify/require"
 
 	pruningtypes "cosmossdk.io/store/pruning/types"
 	snapshottypes "cosmossdk.io/store/snapshots/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 func TestABCI_ListSnapshots(t *testing.T) {
@@ -168,6 +173,64 @@ func TestABCI_SnapshotWithPruning(t *testing.T) {
 	}
 }
 
+func TestQueryMultiStore(t *testing.T) {
+	ssCfg := SnapshotsConfig{
+		blocks:             20,
+		blockTxs:           1,
+		snapshotInterval:   5,
+		snapshotKeepRecent: 0,
+		pruningOpts:        pruningtypes.NewCustomPruningOptions(2, 10),
+	}
+	suite := NewBaseAppSuiteWithSnapshots(t, ssCfg)
+
+	// each block writes 100 keys, from "0" at height 1
+	requireKeys := func(version int64) {
+		t.Helper()
+		ms, err := suite.baseApp.QueryMultiStore(version)
+		require.NoError(t, err)
+		store := ms.GetKVStore(capKey2)
+		require.NotNil(t, store.Get([]byte(fmt.Sprintf("%d", version*100-1))), "height: %d", version)
+		require.Nil(t, store.Get([]byte(fmt.Sprintf("%d", version*100))), "height: %d", version)
+	}
+
+	requireKeys(20)
+	requireKeys(19)
+
+	// the archived snapshots aren't restored by default
+	_, err := suite.baseApp.QueryMultiStore(15)
+	require.ErrorIs(t, err, sdkerrors.ErrPrunedHeight)
+
+	// the pruned state at a snapshot height is restored from the snapshot
+	baseapp.SetArchivedSnapshotQueries(t.TempDir(), dbm.GoLevelDBBackend, time.Hour)(suite.baseApp)
+	t.Cleanup(func() { require.NoError(t, suite.baseApp.Close()) })
+	requireKeys(15)
+	requireKeys(15)
+
+	// at most once per minimum restore interval
+	_, err = suite.baseApp.QueryMultiStore(10)
+	require.ErrorIs(t, err, sdkerrors.ErrPrunedHeight)
+	require.ErrorContains(t, err, "no snapshot can be restored before")
+
+	_, err = suite.baseApp.QueryMultiStore(14)
+	require.ErrorIs(t, err, sdkerrors.ErrPrunedHeight)
+
+	_, err = suite.baseApp.QueryMultiStore(21)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidHeight)
+
+	_, err = suite.baseApp.CreateQueryContext(14, false)
+	require.ErrorIs(t, err, sdkerrors.ErrPrunedHeight)
+
+	ctx, err := suite.baseApp.CreateQueryContext(15, false)
+	require.NoError(t, err)
+	require.Equal(t, int64(15), ctx.HeaderInfo().Height)
+
+	// only the latest height can be queried when historical queries are disabled
+	baseapp.SetHistoricalQueryEnabled(false)(suite.baseApp)
+	_, err = suite.baseApp.QueryMultiStore(19)
+	require.ErrorIs(t, err, sdkerrors.ErrNotSupported)
+	requireKeys(20)
+}
+
 func TestABCI_LoadSnapshotChunk(t *testing.T) {
 	ssCfg := SnapshotsConfig{
 		blocks:             2,
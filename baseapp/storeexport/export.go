@@ -0,0 +1,124 @@
+// Package storeexport exports the IAVL stores of an application to a file and
+// imports them into the empty database of a node, without going through the
+// JSON genesis of the modules, e.g. to migrate the state of a chain to a new
+// chain.
+//
+// An export is a zlib compressed stream of length-prefixed protobuf messages:
+// the snapshottypes.Snapshot metadata, with the exported height and the
+// snapshot format, followed by the snapshot items of the stores, as written to
+// the state sync snapshots. The nodes of the IAVL trees are imported as is,
+// without rebuilding the trees, unless the store is migrated by an Importer.
+package storeexport
+
+import (
+	"bufio"
+	"compress/zlib"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	dbm "github.com/cosmos/cosmos-db"
+	protoio "github.com/cosmos/gogoproto/io"
+
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+	storetypes "cosmossdk.io/store/types"
+)
+
+// maxItemSize is the maximum size of an item of an export, matching the limit
+// of the state sync snapshots.
+const maxItemSize = int(64e6)
+
+// Importer imports the exported entries of a store through the keepers of a
+// module, migrating them, e.g. to change the encoding of the state between two
+// versions of the module. The store is written through the given context.
+//
+// The migrated store is rebuilt in the database of the node, and committed as
+// its entries are read, before being imported: an importer keeping the entries
+// it reads in memory, or writing them through a branch of the context, keeps
+// the whole store in memory.
+type Importer func(ctx context.Context, entries EntryReader) error
+
+// EntryReader reads the exported entries of a store, in key order.
+type EntryReader interface {
+	// Next returns the next entry, io.EOF once all the entries are read.
+	Next() (key, value []byte, err error)
+}
+
+// Export writes the IAVL stores of the multistore at the given height to w.
+func Export(w io.Writer, cms storetypes.CommitMultiStore, height uint64) error {
+	bw := bufio.NewWriter(w)
+	zw, err := zlib.NewWriterLevel(bw, zlib.BestSpeed)
+	if err != nil {
+		return err
+	}
+	protoWriter := protoio.NewDelimitedWriter(zw)
+
+	if err := protoWriter.WriteMsg(&snapshottypes.Snapshot{
+		Height: height,
+		Format: snapshottypes.CurrentFormat,
+	}); err != nil {
+		return err
+	}
+	if err := cms.Snapshot(height, protoWriter); err != nil {
+		return err
+	}
+
+	if err := protoWriter.Close(); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+// Import imports the stores exported to r into the multistore, which must be
+// empty, at the exported height, and returns the height. The stores of the
+// given importers, by store key name, are migrated while they are imported,
+// being rebuilt in db, the database of the multistore, under a prefix deleted
+// once they are imported. db may be nil if no store is migrated.
+func Import(r io.Reader, cms storetypes.CommitMultiStore, db dbm.DB, importers map[string]Importer) (_ uint64, err error) {
+	if version := cms.LastCommitID().Version; version != 0 {
+		return 0, fmt.Errorf("cannot import into a non-empty multistore, its latest version is %d", version)
+	}
+
+	zr, err := zlib.NewReader(bufio.NewReader(r))
+	if err != nil {
+		return 0, fmt.Errorf("invalid store export: %w", err)
+	}
+	defer zr.Close()
+	var protoReader protoio.Reader = protoio.NewDelimitedReader(zr, maxItemSize)
+
+	var snapshot snapshottypes.Snapshot
+	if err := protoReader.ReadMsg(&snapshot); err != nil {
+		return 0, fmt.Errorf("invalid store export: %w", err)
+	}
+	if snapshot.Format != snapshottypes.CurrentFormat {
+		return 0, fmt.Errorf("unsupported store export format %d", snapshot.Format)
+	}
+
+	if len(importers) > 0 {
+		keysByName, ok := cms.(interface {
+			StoreKeysByName() map[string]storetypes.StoreKey
+		})
+		if !ok {
+			return 0, fmt.Errorf("cannot migrate the stores of %T", cms)
+		}
+		if db == nil {
+			return 0, errors.New("cannot migrate the stores without a database")
+		}
+		migratingReader := newMigratingReader(protoReader, db, int64(snapshot.Height), keysByName.StoreKeysByName(), importers)
+		defer func() {
+			err = errors.Join(err, migratingReader.Close())
+		}()
+		protoReader = migratingReader
+	}
+
+	item, err := cms.Restore(snapshot.Height, snapshot.Format, protoReader)
+	if err != nil {
+		return 0, err
+	}
+	if item.Item != nil {
+		return 0, errors.New("invalid store export: unexpected snapshot extension")
+	}
+
+	return snapshot.Height, nil
+}
@@ -0,0 +1,166 @@
+package storeexport_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp/storeexport"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	keyA = storetypes.NewKVStoreKey("a")
+	keyB = storetypes.NewKVStoreKey("b")
+	keyC = storetypes.NewKVStoreKey("c")
+)
+
+func newMultiStore(t *testing.T) *rootmulti.Store {
+	t.Helper()
+
+	return newMultiStoreWithDB(t, dbm.NewMemDB())
+}
+
+func newMultiStoreWithDB(t *testing.T, db dbm.DB) *rootmulti.Store {
+	t.Helper()
+
+	cms := rootmulti.NewStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	cms.MountStoreWithDB(keyA, storetypes.StoreTypeIAVL, nil)
+	cms.MountStoreWithDB(keyB, storetypes.StoreTypeIAVL, nil)
+	cms.MountStoreWithDB(keyC, storetypes.StoreTypeIAVL, nil)
+	cms.MountStoreWithDB(storetypes.NewTransientStoreKey("transient"), storetypes.StoreTypeTransient, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+
+	return cms
+}
+
+// exportMultiStore exports a multistore with two versions, the second one
+// being exported.
+func exportMultiStore(t *testing.T) (*rootmulti.Store, []byte) {
+	t.Helper()
+
+	cms := newMultiStore(t)
+	for i := 0; i < 100; i++ {
+		cms.GetKVStore(keyA).Set([]byte(fmt.Sprintf("a%03d", i)), []byte("v1"))
+		cms.GetKVStore(keyB).Set([]byte(fmt.Sprintf("b%03d", i)), []byte("v1"))
+	}
+	cms.Commit()
+	for i := 0; i < 100; i += 2 {
+		cms.GetKVStore(keyA).Set([]byte(fmt.Sprintf("a%03d", i)), []byte("v2"))
+		cms.GetKVStore(keyB).Delete([]byte(fmt.Sprintf("b%03d", i)))
+	}
+	cms.Commit()
+
+	var buf bytes.Buffer
+	require.NoError(t, storeexport.Export(&buf, cms, 2))
+
+	// later versions are not exported
+	cms.GetKVStore(keyA).Set([]byte("a100"), []byte("v3"))
+	cms.Commit()
+
+	return cms, buf.Bytes()
+}
+
+func TestExportImport(t *testing.T) {
+	source, export := exportMultiStore(t)
+
+	cms := newMultiStore(t)
+	height, err := storeexport.Import(bytes.NewReader(export), cms, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), height)
+
+	expected, err := source.GetCommitInfo(2)
+	require.NoError(t, err)
+	require.Equal(t, storetypes.CommitID{Version: 2, Hash: expected.Hash()}, cms.LastCommitID())
+	require.Equal(t, []byte("v2"), cms.GetKVStore(keyA).Get([]byte("a000")))
+	require.Nil(t, cms.GetKVStore(keyA).Get([]byte("a100")))
+
+	// the multistore must be empty
+	_, err = storeexport.Import(bytes.NewReader(export), cms, nil, nil)
+	require.ErrorContains(t, err, "non-empty")
+
+	_, err = storeexport.Import(bytes.NewReader([]byte("invalid")), newMultiStore(t), nil, nil)
+	require.ErrorContains(t, err, "invalid store export")
+}
+
+func TestImportMigration(t *testing.T) {
+	_, export := exportMultiStore(t)
+
+	// the values of the store b are migrated and the keys below b050 dropped
+	var read int
+	importers := map[string]storeexport.Importer{
+		"b": func(ctx context.Context, entries storeexport.EntryReader) error {
+			store := sdk.UnwrapSDKContext(ctx).KVStore(keyB)
+			for {
+				key, value, err := entries.Next()
+				if errors.Is(err, io.EOF) {
+					return nil
+				}
+				require.NoError(t, err)
+				read++
+				if string(key) >= "b050" {
+					store.Set(key, append([]byte("migrated-"), value...))
+				}
+			}
+		},
+		"c": func(ctx context.Context, entries storeexport.EntryReader) error {
+			_, _, err := entries.Next()
+			require.ErrorIs(t, err, io.EOF)
+			sdk.UnwrapSDKContext(ctx).KVStore(keyC).Set([]byte("c"), []byte("new"))
+			return nil
+		},
+	}
+
+	db := dbm.NewMemDB()
+	cms := newMultiStoreWithDB(t, db)
+	height, err := storeexport.Import(bytes.NewReader(export), cms, db, importers)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), height)
+	require.Equal(t, 50, read)
+
+	require.Equal(t, int64(2), cms.LastCommitID().Version)
+	require.Equal(t, []byte("v2"), cms.GetKVStore(keyA).Get([]byte("a000")))
+	require.Nil(t, cms.GetKVStore(keyB).Get([]byte("b001")))
+	require.Equal(t, []byte("migrated-v1"), cms.GetKVStore(keyB).Get([]byte("b051")))
+	require.Equal(t, []byte("new"), cms.GetKVStore(keyC).Get([]byte("c")))
+
+	it := cms.GetKVStore(keyB).Iterator(nil, nil)
+	defer it.Close()
+	var count int
+	for ; it.Valid(); it.Next() {
+		count++
+	}
+	require.Equal(t, 25, count)
+
+	// the migrated stores are deleted from the database once imported
+	dbIt, err := db.Iterator(nil, nil)
+	require.NoError(t, err)
+	defer dbIt.Close()
+	for ; dbIt.Valid(); dbIt.Next() {
+		require.True(t, bytes.HasPrefix(dbIt.Key(), []byte("s/")), "unexpected key %q", dbIt.Key())
+	}
+
+	// the multistore keeps committing from the imported height
+	cms.GetKVStore(keyB).Set([]byte("b000"), []byte("v3"))
+	require.Equal(t, int64(3), cms.Commit().Version)
+
+	importers["b"] = func(context.Context, storeexport.EntryReader) error {
+		return errors.New("boom")
+	}
+	_, err = storeexport.Import(bytes.NewReader(export), newMultiStore(t), dbm.NewMemDB(), importers)
+	require.ErrorContains(t, err, "failed to migrate store b: boom")
+
+	_, err = storeexport.Import(bytes.NewReader(export), newMultiStore(t), nil, importers)
+	require.ErrorContains(t, err, "without a database")
+}
@@ -0,0 +1,259 @@
+package storeexport
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	dbm "github.com/cosmos/cosmos-db"
+	protoio "github.com/cosmos/gogoproto/io"
+	"github.com/cosmos/gogoproto/proto"
+	iavltree "github.com/cosmos/iavl"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/iavl"
+	"cosmossdk.io/store/metrics"
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	"cosmossdk.io/store/rootmulti"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// migrationPrefix is the prefix of the keys of the database under which the
+// migrated stores are rebuilt, deleted once they are imported.
+var migrationPrefix = []byte("storeexport/migration/")
+
+const (
+	// migrationCommitInterval is the number of entries read by an importer
+	// between two commits of the migrated store, bounding the nodes kept in
+	// memory.
+	migrationCommitInterval = 10_000
+	// deleteBatchSize is the number of keys deleted per batch when clearing
+	// the migrated stores.
+	deleteBatchSize = 10_000
+)
+
+var _ protoio.Reader = (*migratingReader)(nil)
+
+// migratingReader reads the snapshot items of an export, replacing the nodes
+// of the migrated stores with the nodes of the stores rebuilt by their
+// importers.
+type migratingReader struct {
+	protoio.Reader
+
+	db        dbm.DB
+	height    int64
+	keys      map[string]storetypes.StoreKey
+	importers map[string]Importer
+
+	// exporter exports the nodes of the migrated store being read
+	exporter *iavltree.Exporter
+	// pending is the item read past the nodes of the migrated store
+	pending *snapshottypes.SnapshotItem
+	// eof is set once the export is read past its last item
+	eof bool
+}
+
+func newMigratingReader(r protoio.Reader, db dbm.DB, height int64, keys map[string]storetypes.StoreKey, importers map[string]Importer) *migratingReader {
+	return &migratingReader{Reader: r, db: db, height: height, keys: keys, importers: importers}
+}
+
+// ReadMsg reads the next snapshot item of the export.
+func (r *migratingReader) ReadMsg(msg proto.Message) error {
+	item, ok := msg.(*snapshottypes.SnapshotItem)
+	if !ok {
+		return fmt.Errorf("unexpected message %T", msg)
+	}
+
+	if r.exporter != nil {
+		node, err := r.exporter.Next()
+		if err == nil {
+			// the nodes are imported at the exported height, whatever the
+			// version they were committed at while the store was rebuilt
+			*item = snapshottypes.SnapshotItem{
+				Item: &snapshottypes.SnapshotItem_IAVL{
+					IAVL: &snapshottypes.SnapshotIAVLItem{
+						Key:     node.Key,
+						Value:   node.Value,
+						Version: r.height,
+						Height:  int32(node.Height),
+					},
+				},
+			}
+			return nil
+		}
+
+		if closeErr := r.Close(); closeErr != nil {
+			return closeErr
+		}
+		if !errors.Is(err, iavltree.ErrorExportDone) {
+			return err
+		}
+	}
+
+	switch {
+	case r.pending != nil:
+		*item = *r.pending
+		r.pending = nil
+	case r.eof:
+		return io.EOF
+	default:
+		if err := r.Reader.ReadMsg(item); err != nil {
+			return err
+		}
+	}
+
+	if store, ok := item.Item.(*snapshottypes.SnapshotItem_Store); ok {
+		if importer, ok := r.importers[store.Store.Name]; ok {
+			return r.migrate(store.Store.Name, importer)
+		}
+	}
+
+	return nil
+}
+
+// Close stops exporting the migrated store being read, if any, and deletes it
+// from the database.
+func (r *migratingReader) Close() error {
+	if r.exporter == nil {
+		return nil
+	}
+
+	r.exporter.Close()
+	r.exporter = nil
+	return deletePrefix(r.db, migrationPrefix)
+}
+
+// migrate rebuilds the store of the given name in the database with its
+// importer, committing it as its entries are read, and exports its nodes.
+func (r *migratingReader) migrate(name string, importer Importer) error {
+	key, ok := r.keys[name]
+	if !ok {
+		return fmt.Errorf("cannot migrate unknown store %q", name)
+	}
+
+	// a previous import may have been interrupted while migrating a store
+	if err := deletePrefix(r.db, migrationPrefix); err != nil {
+		return err
+	}
+	ms := rootmulti.NewStore(dbm.NewPrefixDB(r.db, migrationPrefix), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	ms.SetPruning(pruningtypes.NewPruningOptions(pruningtypes.PruningEverything))
+	ms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	if err := ms.LoadLatestVersion(); err != nil {
+		return err
+	}
+
+	entries := &entryReader{r: r, ms: ms}
+	ctx := sdk.NewContext(ms, false, log.NewNopLogger()).WithBlockHeight(r.height)
+	if err := importer(ctx, entries); err != nil {
+		return fmt.Errorf("failed to migrate store %s: %w", name, err)
+	}
+	// skip the entries dropped by the importer
+	for {
+		if _, _, err := entries.Next(); errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return err
+		}
+	}
+
+	version := ms.Commit().Version
+	store, ok := ms.GetCommitKVStore(key).(*iavl.Store)
+	if !ok {
+		return fmt.Errorf("cannot migrate non-IAVL store %q", name)
+	}
+	exporter, err := store.Export(version)
+	if err != nil {
+		return err
+	}
+	r.exporter = exporter
+
+	return nil
+}
+
+// deletePrefix deletes the keys of the database with the given prefix, by
+// batches, without keeping an iterator open while they are deleted.
+func deletePrefix(db dbm.DB, prefix []byte) error {
+	for {
+		it, err := dbm.IteratePrefix(db, prefix)
+		if err != nil {
+			return err
+		}
+		var keys [][]byte
+		for ; it.Valid() && len(keys) < deleteBatchSize; it.Next() {
+			keys = append(keys, it.Key())
+		}
+		err = it.Error()
+		it.Close()
+		if err != nil {
+			return err
+		}
+		if len(keys) == 0 {
+			return nil
+		}
+
+		batch := db.NewBatch()
+		for _, key := range keys {
+			if err := batch.Delete(key); err != nil {
+				batch.Close()
+				return err
+			}
+		}
+		err = batch.Write()
+		batch.Close()
+		if err != nil {
+			return err
+		}
+	}
+}
+
+var _ EntryReader = (*entryReader)(nil)
+
+// entryReader reads the entries of the migrated store from the leaf nodes of
+// its export, committing the migrated store every migrationCommitInterval
+// entries.
+type entryReader struct {
+	r    *migratingReader
+	ms   storetypes.CommitMultiStore
+	read int
+	done bool
+}
+
+func (e *entryReader) Next() (key, value []byte, err error) {
+	for !e.done {
+		var item snapshottypes.SnapshotItem
+		err := e.r.Reader.ReadMsg(&item)
+		if errors.Is(err, io.EOF) {
+			e.done, e.r.eof = true, true
+			break
+		} else if err != nil {
+			return nil, nil, err
+		}
+
+		node, ok := item.Item.(*snapshottypes.SnapshotItem_IAVL)
+		if !ok {
+			e.done, e.r.pending = true, &item
+			break
+		}
+		if node.IAVL.Height != 0 {
+			continue
+		}
+		if e.read++; e.read%migrationCommitInterval == 0 {
+			e.ms.Commit()
+		}
+
+		// protobuf does not distinguish nil from empty keys and values
+		key, value = node.IAVL.Key, node.IAVL.Value
+		if key == nil {
+			key = []byte{}
+		}
+		if value == nil {
+			value = []byte{}
+		}
+		return key, value, nil
+	}
+
+	return nil, nil, io.EOF
+}
@@ -0,0 +1,214 @@
+// Package storeprofile profiles the usage of the KVStores of an application,
+// by store key, to plan the pruning and the sharding of the state.
+//
+// A Profiler instruments the branches of a multistore, see
+// BaseApp.SetCacheMultiStoreWrapper, and counts the reads, writes, deletes and
+// iterated keys of each store, the accesses of each key and the sizes of the
+// values read and written.
+package storeprofile
+
+import (
+	"encoding/hex"
+	"sort"
+	"sync"
+
+	storetypes "cosmossdk.io/store/types"
+)
+
+// valueSizeBounds are the upper bounds, in bytes, of the buckets of the value
+// size histograms. The last bucket is unbounded.
+var valueSizeBounds = []uint64{16, 64, 256, 1 << 10, 4 << 10, 16 << 10, 64 << 10, 256 << 10, 1 << 20}
+
+// Profiler records the accesses to the KVStores of the multistore branches it
+// wraps. It is safe for concurrent use.
+type Profiler struct {
+	mtx    sync.Mutex
+	stores map[string]*storeProfile
+}
+
+// NewProfiler returns a profiler with no recorded accesses.
+func NewProfiler() *Profiler {
+	return &Profiler{
+		stores: make(map[string]*storeProfile),
+	}
+}
+
+// Wrap returns the branch of a multistore with its KVStores, and its own
+// branches, instrumented by the profiler.
+func (p *Profiler) Wrap(ms storetypes.CacheMultiStore) storetypes.CacheMultiStore {
+	return multiStore{cacheMultiStore: ms, profiler: p}
+}
+
+func (p *Profiler) storeProfile(storeKey string) *storeProfile {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	profile, ok := p.stores[storeKey]
+	if !ok {
+		profile = &storeProfile{
+			mtx:        &p.mtx,
+			keys:       make(map[string]*keyCounts),
+			valueSizes: make([]uint64, len(valueSizeBounds)+1),
+		}
+		p.stores[storeKey] = profile
+	}
+
+	return profile
+}
+
+// Report returns the profile of the stores, sorted by store key, with their
+// given number of most accessed keys.
+func (p *Profiler) Report(hotKeys int) Report {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	var report Report
+	for storeKey, profile := range p.stores {
+		report.Stores = append(report.Stores, profile.report(storeKey, hotKeys))
+	}
+	sort.Slice(report.Stores, func(i, j int) bool {
+		return report.Stores[i].StoreKey < report.Stores[j].StoreKey
+	})
+
+	return report
+}
+
+// storeProfile records the accesses to a store, guarded by the mutex of the
+// profiler.
+type storeProfile struct {
+	mtx *sync.Mutex
+
+	reads    uint64
+	writes   uint64
+	deletes  uint64
+	iterated uint64
+
+	keys       map[string]*keyCounts
+	valueSizes []uint64 // number of values read or written by size bucket
+}
+
+type keyCounts struct {
+	reads  uint64
+	writes uint64 // including the deletes
+}
+
+func (s *storeProfile) recordRead(key, value []byte) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.reads++
+	s.key(key).reads++
+	if value != nil {
+		s.recordValueSize(value)
+	}
+}
+
+func (s *storeProfile) recordWrite(key, value []byte) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.writes++
+	s.key(key).writes++
+	s.recordValueSize(value)
+}
+
+func (s *storeProfile) recordDelete(key []byte) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.deletes++
+	s.key(key).writes++
+}
+
+func (s *storeProfile) recordIteration() {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.iterated++
+}
+
+func (s *storeProfile) key(key []byte) *keyCounts {
+	counts, ok := s.keys[string(key)]
+	if !ok {
+		counts = &keyCounts{}
+		s.keys[string(key)] = counts
+	}
+
+	return counts
+}
+
+func (s *storeProfile) recordValueSize(value []byte) {
+	bucket := sort.Search(len(valueSizeBounds), func(i int) bool {
+		return uint64(len(value)) <= valueSizeBounds[i]
+	})
+	s.valueSizes[bucket]++
+}
+
+func (s *storeProfile) report(storeKey string, hotKeys int) StoreReport {
+	report := StoreReport{
+		StoreKey:     storeKey,
+		Reads:        s.reads,
+		Writes:       s.writes,
+		Deletes:      s.deletes,
+		IteratedKeys: s.iterated,
+		DistinctKeys: len(s.keys),
+	}
+
+	keys := make([]KeyReport, 0, len(s.keys))
+	for key, counts := range s.keys {
+		keys = append(keys, KeyReport{Key: hex.EncodeToString([]byte(key)), Reads: counts.reads, Writes: counts.writes})
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		ai, aj := keys[i].Reads+keys[i].Writes, keys[j].Reads+keys[j].Writes
+		if ai != aj {
+			return ai > aj
+		}
+		return keys[i].Key < keys[j].Key
+	})
+	report.HotKeys = keys[:min(hotKeys, len(keys))]
+
+	for i, count := range s.valueSizes {
+		var maxSize uint64
+		if i < len(valueSizeBounds) {
+			maxSize = valueSizeBounds[i]
+		}
+		report.ValueSizes = append(report.ValueSizes, SizeBucket{MaxSize: maxSize, Count: count})
+	}
+
+	return report
+}
+
+// Report is the profile of the stores of an application.
+type Report struct {
+	FromHeight int64         `json:"from_height,omitempty"`
+	ToHeight   int64         `json:"to_height,omitempty"`
+	Stores     []StoreReport `json:"stores"`
+}
+
+// StoreReport is the profile of a store.
+type StoreReport struct {
+	StoreKey     string `json:"store_key"`
+	Reads        uint64 `json:"reads"`
+	Writes       uint64 `json:"writes"`
+	Deletes      uint64 `json:"deletes"`
+	IteratedKeys uint64 `json:"iterated_keys"`
+	DistinctKeys int    `json:"distinct_keys"` // number of keys read, written or deleted
+	// HotKeys are the most accessed keys, by number of reads and writes.
+	HotKeys []KeyReport `json:"hot_keys"`
+	// ValueSizes is the histogram of the sizes of the values read and written.
+	ValueSizes []SizeBucket `json:"value_sizes"`
+}
+
+// KeyReport is the number of accesses to a key.
+type KeyReport struct {
+	Key    string `json:"key"` // hex encoded
+	Reads  uint64 `json:"reads"`
+	Writes uint64 `json:"writes"` // including the deletes
+}
+
+// SizeBucket is a bucket of a value size histogram, counting the values larger
+// than the previous bucket and up to MaxSize bytes.
+type SizeBucket struct {
+	MaxSize uint64 `json:"max_size"` // 0 for the last, unbounded, bucket
+	Count   uint64 `json:"count"`
+}
@@ -0,0 +1,72 @@
+package storeprofile_test
+
+import (
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp/storeprofile"
+)
+
+func TestProfiler(t *testing.T) {
+	key1, key2 := storetypes.NewKVStoreKey("store1"), storetypes.NewKVStoreKey("store2")
+	cms := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	cms.MountStoreWithDB(key1, storetypes.StoreTypeIAVL, nil)
+	cms.MountStoreWithDB(key2, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+
+	profiler := storeprofile.NewProfiler()
+	ms := profiler.Wrap(cms.CacheMultiStore())
+
+	store1 := ms.GetKVStore(key1)
+	store1.Set([]byte{1}, make([]byte, 10))
+	store1.Set([]byte{2}, make([]byte, 100))
+	store1.Set([]byte{2}, make([]byte, 2<<20))
+	require.Len(t, store1.Get([]byte{2}), 2<<20)
+	require.Nil(t, store1.Get([]byte{3}))
+	require.True(t, store1.Has([]byte{1}))
+	store1.Delete([]byte{1})
+
+	// the accesses of the branches are recorded too
+	branch := ms.CacheMultiStore()
+	store2 := branch.GetKVStore(key2)
+	store2.Set([]byte{1}, []byte("value"))
+	store2.Set([]byte{2}, []byte("value"))
+	it := store2.Iterator(nil, nil)
+	for ; it.Valid(); it.Next() {
+	}
+	require.NoError(t, it.Close())
+	branch.Write()
+	require.Equal(t, []byte("value"), ms.GetKVStore(key2).Get([]byte{1}))
+
+	report := profiler.Report(2)
+	require.Len(t, report.Stores, 2)
+
+	s1 := report.Stores[0]
+	require.Equal(t, "store1", s1.StoreKey)
+	require.Equal(t, uint64(3), s1.Reads)
+	require.Equal(t, uint64(3), s1.Writes)
+	require.Equal(t, uint64(1), s1.Deletes)
+	require.Equal(t, uint64(0), s1.IteratedKeys)
+	require.Equal(t, 3, s1.DistinctKeys)
+	require.Equal(t, []storeprofile.KeyReport{
+		{Key: "01", Reads: 1, Writes: 2},
+		{Key: "02", Reads: 1, Writes: 2},
+	}, s1.HotKeys)
+	require.Equal(t, storeprofile.SizeBucket{MaxSize: 16, Count: 1}, s1.ValueSizes[0])
+	require.Equal(t, storeprofile.SizeBucket{MaxSize: 256, Count: 1}, s1.ValueSizes[2])
+	require.Equal(t, storeprofile.SizeBucket{MaxSize: 0, Count: 2}, s1.ValueSizes[len(s1.ValueSizes)-1])
+
+	s2 := report.Stores[1]
+	require.Equal(t, "store2", s2.StoreKey)
+	require.Equal(t, uint64(1), s2.Reads)
+	require.Equal(t, uint64(2), s2.Writes)
+	require.Equal(t, uint64(2), s2.IteratedKeys)
+	require.Equal(t, storeprofile.SizeBucket{MaxSize: 16, Count: 3}, s2.ValueSizes[0])
+}
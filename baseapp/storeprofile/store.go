@@ -0,0 +1,97 @@
+package storeprofile
+
+import (
+	storetypes "cosmossdk.io/store/types"
+)
+
+// cacheMultiStore is embedded by multiStore, overriding its CacheMultiStore
+// method.
+type cacheMultiStore = storetypes.CacheMultiStore
+
+var _ storetypes.CacheMultiStore = multiStore{}
+
+// multiStore is a branch of a multistore whose KVStores, and branches, are
+// instrumented by a profiler.
+type multiStore struct {
+	cacheMultiStore
+
+	profiler *Profiler
+}
+
+// GetKVStore returns the instrumented KVStore of the given key.
+func (ms multiStore) GetKVStore(key storetypes.StoreKey) storetypes.KVStore {
+	return &kvStore{
+		KVStore: ms.cacheMultiStore.GetKVStore(key),
+		profile: ms.profiler.storeProfile(key.Name()),
+	}
+}
+
+// CacheMultiStore returns an instrumented branch of the multistore.
+func (ms multiStore) CacheMultiStore() storetypes.CacheMultiStore {
+	return ms.profiler.Wrap(ms.cacheMultiStore.CacheMultiStore())
+}
+
+// CacheWrap returns an instrumented branch of the multistore.
+func (ms multiStore) CacheWrap() storetypes.CacheWrap {
+	return ms.CacheMultiStore()
+}
+
+var _ storetypes.KVStore = (*kvStore)(nil)
+
+// kvStore is a KVStore recording its accesses in the profile of its store key.
+type kvStore struct {
+	storetypes.KVStore
+
+	profile *storeProfile
+}
+
+func (s *kvStore) Get(key []byte) []byte {
+	value := s.KVStore.Get(key)
+	s.profile.recordRead(key, value)
+	return value
+}
+
+func (s *kvStore) Has(key []byte) bool {
+	s.profile.recordRead(key, nil)
+	return s.KVStore.Has(key)
+}
+
+func (s *kvStore) Set(key, value []byte) {
+	s.KVStore.Set(key, value)
+	s.profile.recordWrite(key, value)
+}
+
+func (s *kvStore) Delete(key []byte) {
+	s.KVStore.Delete(key)
+	s.profile.recordDelete(key)
+}
+
+func (s *kvStore) Iterator(start, end []byte) storetypes.Iterator {
+	return newIterator(s.KVStore.Iterator(start, end), s.profile)
+}
+
+func (s *kvStore) ReverseIterator(start, end []byte) storetypes.Iterator {
+	return newIterator(s.KVStore.ReverseIterator(start, end), s.profile)
+}
+
+// iterator counts the keys iterated over in the profile of its store key.
+type iterator struct {
+	storetypes.Iterator
+
+	profile *storeProfile
+}
+
+func newIterator(parent storetypes.Iterator, profile *storeProfile) *iterator {
+	if parent.Valid() {
+		profile.recordIteration()
+	}
+
+	return &iterator{Iterator: parent, profile: profile}
+}
+
+func (it *iterator) Next() {
+	it.Iterator.Next()
+	if it.Iterator.Valid() {
+		it.profile.recordIteration()
+	}
+}
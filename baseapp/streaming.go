@@ -2,6 +2,7 @@ package baseapp
 
 import (
 	"fmt"
+	"path/filepath"
 	"sort"
 	"strings"
 
@@ -10,6 +11,7 @@ import (
 	"cosmossdk.io/store/streaming"
 	storetypes "cosmossdk.io/store/types"
 
+	basestreaming "github.com/cosmos/cosmos-sdk/baseapp/streaming"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 )
@@ -20,6 +22,13 @@ const (
 	StreamingABCIPluginTomlKey        = "plugin"
 	StreamingABCIKeysTomlKey          = "keys"
 	StreamingABCIStopNodeOnErrTomlKey = "stop-node-on-err"
+
+	StreamingSinkTomlKey            = "sink"
+	StreamingSinkTypeTomlKey        = "type"
+	StreamingSinkAddressTomlKey     = "address"
+	StreamingSinkTopicTomlKey       = "topic"
+	StreamingSinkKeysTomlKey        = "keys"
+	StreamingSinkAtLeastOnceTomlKey = "at-least-once"
 )
 
 // RegisterStreamingServices registers streaming services with the BaseApp.
@@ -41,6 +50,62 @@ func (app *BaseApp) RegisterStreamingServices(appOpts servertypes.AppOptions, ke
 		}
 	}
 
+	if err := app.registerStreamingSink(appOpts, keys); err != nil {
+		return fmt.Errorf("failed to register streaming sink: %w", err)
+	}
+
+	return nil
+}
+
+// registerStreamingSink registers a listener publishing the state changes to the
+// built-in streaming sink, if configured. Its checkpoint and pending blocks are
+// persisted in the data/streaming directory of the node.
+func (app *BaseApp) registerStreamingSink(appOpts servertypes.AppOptions, keys map[string]*storetypes.KVStoreKey) error {
+	sinkOpt := func(key string) interface{} {
+		return appOpts.Get(fmt.Sprintf("%s.%s.%s", StreamingTomlKey, StreamingSinkTomlKey, key))
+	}
+
+	sinkType := strings.TrimSpace(cast.ToString(sinkOpt(StreamingSinkTypeTomlKey)))
+	if sinkType == "" {
+		return nil
+	}
+
+	address := cast.ToString(sinkOpt(StreamingSinkAddressTomlKey))
+	topic := cast.ToString(sinkOpt(StreamingSinkTopicTomlKey))
+
+	var sink basestreaming.Sink
+	switch sinkType {
+	case "file":
+		fileSink, err := basestreaming.NewFileSink(address)
+		if err != nil {
+			return err
+		}
+		sink = fileSink
+	case "nats-core":
+		sink = basestreaming.NewNATSCoreSink(address, topic)
+	case "kafka-rest-proxy":
+		sink = basestreaming.NewKafkaRESTProxySink(address, topic)
+	default:
+		return fmt.Errorf("unknown streaming sink type %q", sinkType)
+	}
+
+	exposeKeysStr := cast.ToStringSlice(sinkOpt(StreamingSinkKeysTomlKey))
+	if len(exposeKeysStr) == 0 {
+		exposeKeysStr = []string{"*"}
+	}
+	opts := []basestreaming.ListenerOption{basestreaming.WithStoreKeys(exposeKeysStr...)}
+	if cast.ToBool(sinkOpt(StreamingSinkAtLeastOnceTomlKey)) {
+		opts = append(opts, basestreaming.WithAtLeastOnce())
+	}
+
+	dir := filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), "data", "streaming")
+	listener, err := basestreaming.NewListener(sink, dir, app.logger, opts...)
+	if err != nil {
+		return err
+	}
+
+	app.cms.AddListeners(exposeStoreKeysSorted(exposeKeysStr, keys))
+	app.AddABCIListener(listener)
 	return nil
 }
 
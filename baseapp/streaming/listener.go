@@ -0,0 +1,364 @@
+package streaming
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	protoio "github.com/cosmos/gogoproto/io"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	checkpointFile = "checkpoint"
+	pendingDir     = "pending"
+
+	// DefaultQueueSize is the default number of blocks waiting for their
+	// delivery in best-effort mode, see WithQueueSize.
+	DefaultQueueSize = 100
+)
+
+var _ storetypes.ABCIListener = (*Listener)(nil)
+
+// Listener is an ABCIListener publishing the state changes of each committed
+// block to a Sink.
+//
+// The message of a block is made of the varint length-prefixed protobuf
+// encodings of its cosmos.store.v1beta1.BlockMetadata, followed by the
+// cosmos.store.v1beta1.StoreKVPair of its change set, in order.
+//
+// The messages are delivered by a background worker, so that a slow or
+// unavailable sink doesn't stall the commit of the blocks. In best-effort mode,
+// the blocks are queued in memory, up to the queue size beyond which they are
+// dropped, and the blocks which couldn't be delivered are dropped.
+//
+// The height of the last block delivered to the sink is checkpointed in the
+// directory of the listener, so that blocks replayed after a restart aren't
+// published twice. In at-least-once mode, the messages are first persisted in
+// the directory and only removed once delivered: the messages which couldn't be
+// delivered, e.g. because the sink is unavailable, are retried on the next
+// commit, including after a restart. A message may then be delivered more than
+// once if the node stops between its delivery and its checkpoint.
+type Listener struct {
+	sink        Sink
+	dir         string
+	logger      log.Logger
+	storeKeys   map[string]bool
+	atLeastOnce bool
+	queueSize   int
+
+	mtx        sync.Mutex
+	checkpoint int64
+	req        *abci.RequestFinalizeBlock
+	res        *abci.ResponseFinalizeBlock
+
+	// queue holds the blocks to deliver in best-effort mode, while notify
+	// wakes the worker up to deliver the pending blocks in at-least-once mode.
+	queue  chan queuedBlock
+	notify chan struct{}
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+type queuedBlock struct {
+	height int64
+	msg    []byte
+}
+
+// ListenerOption configures a Listener.
+type ListenerOption func(*Listener)
+
+// WithStoreKeys only streams the state changes of the stores with the given key
+// names. All state changes are streamed if no key, or "*", is given.
+func WithStoreKeys(keys ...string) ListenerOption {
+	return func(l *Listener) {
+		for _, key := range keys {
+			if key == "*" {
+				l.storeKeys = nil
+				return
+			}
+			if l.storeKeys == nil {
+				l.storeKeys = make(map[string]bool)
+			}
+			l.storeKeys[key] = true
+		}
+	}
+}
+
+// WithAtLeastOnce enables the at-least-once delivery of the messages.
+func WithAtLeastOnce() ListenerOption {
+	return func(l *Listener) { l.atLeastOnce = true }
+}
+
+// WithQueueSize sets the number of blocks waiting for their delivery in
+// best-effort mode, DefaultQueueSize by default.
+func WithQueueSize(size int) ListenerOption {
+	return func(l *Listener) { l.queueSize = size }
+}
+
+// NewListener returns a Listener publishing to the given sink, and persisting
+// its checkpoint and pending messages in dir. Its background worker runs until
+// it is closed.
+func NewListener(sink Sink, dir string, logger log.Logger, opts ...ListenerOption) (*Listener, error) {
+	l := &Listener{
+		sink:      sink,
+		dir:       dir,
+		logger:    logger.With(log.ModuleKey, "streaming"),
+		queueSize: DefaultQueueSize,
+	}
+	for _, opt := range opts {
+		opt(l)
+	}
+
+	if err := os.MkdirAll(filepath.Join(dir, pendingDir), 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create streaming directory: %w", err)
+	}
+
+	bz, err := os.ReadFile(filepath.Join(dir, checkpointFile))
+	switch {
+	case os.IsNotExist(err):
+	case err != nil:
+		return nil, fmt.Errorf("failed to read streaming checkpoint: %w", err)
+	default:
+		if l.checkpoint, err = strconv.ParseInt(strings.TrimSpace(string(bz)), 10, 64); err != nil {
+			return nil, fmt.Errorf("invalid streaming checkpoint: %w", err)
+		}
+	}
+
+	l.queue = make(chan queuedBlock, l.queueSize)
+	l.notify = make(chan struct{}, 1)
+	l.done = make(chan struct{})
+	ctx, cancel := context.WithCancel(context.Background())
+	l.cancel = cancel
+	go l.run(ctx)
+
+	// the blocks left pending by a previous run are delivered right away
+	if l.atLeastOnce {
+		l.notify <- struct{}{}
+	}
+
+	return l, nil
+}
+
+// Checkpoint returns the height of the last block delivered to the sink.
+func (l *Listener) Checkpoint() int64 {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	return l.checkpoint
+}
+
+// ListenFinalizeBlock implements storetypes.ABCIListener, it keeps the FinalizeBlock
+// messages until the block is committed.
+func (l *Listener) ListenFinalizeBlock(_ context.Context, req abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock) error {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	l.req, l.res = &req, &res
+	return nil
+}
+
+// ListenCommit implements storetypes.ABCIListener, it queues the message of the
+// committed block for its delivery to the sink, or persists it in at-least-once
+// mode.
+func (l *Listener) ListenCommit(ctx context.Context, res abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	if l.req != nil {
+		height = l.req.Height
+	}
+	defer func() { l.req, l.res = nil, nil }()
+
+	if height <= l.checkpoint {
+		l.logger.Debug("skipping block already delivered", "height", height, "checkpoint", l.checkpoint)
+		return nil
+	}
+
+	msg, err := l.encodeBlock(res, changeSet)
+	if err != nil {
+		return err
+	}
+
+	if !l.atLeastOnce {
+		select {
+		case l.queue <- queuedBlock{height: height, msg: msg}:
+			return nil
+		default:
+			return fmt.Errorf("streaming queue is full, block %d is dropped", height)
+		}
+	}
+
+	if err := writeFileAtomic(l.pendingPath(height), msg); err != nil {
+		return fmt.Errorf("failed to persist block %d: %w", height, err)
+	}
+
+	// the worker may already be delivering, in which case it lists the pending
+	// blocks again afterwards
+	select {
+	case l.notify <- struct{}{}:
+	default:
+	}
+
+	return nil
+}
+
+// Close stops the background worker, abandoning the delivery in progress, and
+// closes the sink of the listener.
+func (l *Listener) Close() error {
+	l.cancel()
+	<-l.done
+	return l.sink.Close()
+}
+
+// run delivers the messages until the context is canceled.
+func (l *Listener) run(ctx context.Context) {
+	defer close(l.done)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case b := <-l.queue:
+			l.deliver(ctx, b)
+		case <-l.notify:
+			l.deliverPending(ctx)
+		}
+	}
+}
+
+// deliver publishes a queued block in best-effort mode.
+func (l *Listener) deliver(ctx context.Context, b queuedBlock) {
+	if err := l.sink.Publish(ctx, b.height, b.msg); err != nil {
+		l.logger.Error("failed to publish block, dropped", "height", b.height, "err", err)
+		return
+	}
+
+	if err := l.saveCheckpoint(b.height); err != nil {
+		l.logger.Error("failed to save streaming checkpoint", "height", b.height, "err", err)
+	}
+}
+
+// encodeBlock returns the message of the block.
+func (l *Listener) encodeBlock(res abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) ([]byte, error) {
+	var buf bytes.Buffer
+	w := protoio.NewDelimitedWriter(&buf)
+
+	metadata := &storetypes.BlockMetadata{
+		ResponseCommit:        &res,
+		RequestFinalizeBlock:  l.req,
+		ResponseFinalizeBlock: l.res,
+	}
+	if err := w.WriteMsg(metadata); err != nil {
+		return nil, fmt.Errorf("failed to encode block metadata: %w", err)
+	}
+
+	for _, pair := range changeSet {
+		if l.storeKeys != nil && !l.storeKeys[pair.StoreKey] {
+			continue
+		}
+		if err := w.WriteMsg(pair); err != nil {
+			return nil, fmt.Errorf("failed to encode state change: %w", err)
+		}
+	}
+
+	return buf.Bytes(), nil
+}
+
+// deliverPending publishes the pending messages in height order, and stops at
+// the first one which can't be delivered.
+func (l *Listener) deliverPending(ctx context.Context) {
+	entries, err := os.ReadDir(filepath.Join(l.dir, pendingDir))
+	if err != nil {
+		l.logger.Error("failed to list pending blocks", "err", err)
+		return
+	}
+
+	heights := make([]int64, 0, len(entries))
+	for _, entry := range entries {
+		height, err := strconv.ParseInt(entry.Name(), 10, 64)
+		if err != nil {
+			continue
+		}
+		heights = append(heights, height)
+	}
+	sort.Slice(heights, func(i, j int) bool { return heights[i] < heights[j] })
+
+	for _, height := range heights {
+		path := l.pendingPath(height)
+		if height <= l.Checkpoint() {
+			_ = os.Remove(path)
+			continue
+		}
+
+		msg, err := os.ReadFile(path)
+		if err != nil {
+			l.logger.Error("failed to read pending block", "height", height, "err", err)
+			return
+		}
+
+		if err := l.sink.Publish(ctx, height, msg); err != nil {
+			l.logger.Error("failed to publish block, will retry", "height", height, "err", err)
+			return
+		}
+
+		if err := l.saveCheckpoint(height); err != nil {
+			l.logger.Error("failed to save streaming checkpoint", "height", height, "err", err)
+			return
+		}
+
+		if err := os.Remove(path); err != nil {
+			l.logger.Error("failed to remove delivered block", "height", height, "err", err)
+		}
+	}
+}
+
+func (l *Listener) pendingPath(height int64) string {
+	return filepath.Join(l.dir, pendingDir, fmt.Sprintf("%020d", height))
+}
+
+func (l *Listener) saveCheckpoint(height int64) error {
+	if err := writeFileAtomic(filepath.Join(l.dir, checkpointFile), []byte(strconv.FormatInt(height, 10))); err != nil {
+		return fmt.Errorf("failed to save streaming checkpoint: %w", err)
+	}
+
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	l.checkpoint = height
+	return nil
+}
+
+// writeFileAtomic writes the file through a temporary file, so that it is never
+// partially written.
+func writeFileAtomic(path string, data []byte) error {
+	tmp := path + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, path)
+}
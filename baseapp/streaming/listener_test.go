@@ -0,0 +1,198 @@
+package streaming_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	protoio "github.com/cosmos/gogoproto/io"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp/streaming"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type mockSink struct {
+	mtx      sync.Mutex
+	fail     bool
+	attempts int
+	heights  []int64
+	messages [][]byte
+	// block blocks the publications until closed, if set
+	block chan struct{}
+}
+
+func (s *mockSink) Publish(ctx context.Context, height int64, msg []byte) error {
+	if s.block != nil {
+		select {
+		case <-s.block:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.attempts++
+	if s.fail {
+		return errors.New("sink unavailable")
+	}
+	s.heights = append(s.heights, height)
+	s.messages = append(s.messages, msg)
+	return nil
+}
+
+func (s *mockSink) Close() error { return nil }
+
+func (s *mockSink) setFail(fail bool) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.fail = fail
+}
+
+func (s *mockSink) delivered() []int64 {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return append([]int64(nil), s.heights...)
+}
+
+func (s *mockSink) message(i int) []byte {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return s.messages[i]
+}
+
+func (s *mockSink) publishAttempts() int {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return s.attempts
+}
+
+// requireCheckpoint waits for the listener to deliver the block at height.
+func requireCheckpoint(t *testing.T, l *streaming.Listener, height int64) {
+	t.Helper()
+	require.Eventually(t, func() bool { return l.Checkpoint() == height }, time.Second, time.Millisecond)
+}
+
+func commitBlock(t *testing.T, l *streaming.Listener, height int64, changeSet []*storetypes.StoreKVPair) error {
+	t.Helper()
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	require.NoError(t, l.ListenFinalizeBlock(ctx, abci.RequestFinalizeBlock{Height: height}, abci.ResponseFinalizeBlock{}))
+	return l.ListenCommit(ctx, abci.ResponseCommit{}, changeSet)
+}
+
+func decodeBlock(t *testing.T, msg []byte) (*storetypes.BlockMetadata, []*storetypes.StoreKVPair) {
+	t.Helper()
+	r := protoio.NewDelimitedReader(bytes.NewReader(msg), len(msg))
+
+	metadata := &storetypes.BlockMetadata{}
+	require.NoError(t, r.ReadMsg(metadata))
+
+	var pairs []*storetypes.StoreKVPair
+	for {
+		pair := &storetypes.StoreKVPair{}
+		err := r.ReadMsg(pair)
+		if errors.Is(err, io.EOF) {
+			return metadata, pairs
+		}
+		require.NoError(t, err)
+		pairs = append(pairs, pair)
+	}
+}
+
+func TestListener(t *testing.T) {
+	dir := t.TempDir()
+	sink := &mockSink{}
+	l, err := streaming.NewListener(sink, dir, log.NewNopLogger(), streaming.WithStoreKeys("acc"))
+	require.NoError(t, err)
+	require.Equal(t, int64(0), l.Checkpoint())
+
+	changeSet := []*storetypes.StoreKVPair{
+		{StoreKey: "acc", Key: []byte("key1"), Value: []byte("value1")},
+		{StoreKey: "bank", Key: []byte("key2"), Value: []byte("value2")},
+	}
+	require.NoError(t, commitBlock(t, l, 1, changeSet))
+	requireCheckpoint(t, l, 1)
+	require.Equal(t, []int64{1}, sink.delivered())
+
+	// only the state changes of the streamed stores are published
+	metadata, pairs := decodeBlock(t, sink.message(0))
+	require.Equal(t, int64(1), metadata.RequestFinalizeBlock.Height)
+	require.Len(t, pairs, 1)
+	require.Equal(t, "acc", pairs[0].StoreKey)
+
+	// the blocks which can't be delivered are dropped in best-effort mode
+	sink.setFail(true)
+	require.NoError(t, commitBlock(t, l, 2, changeSet))
+	require.Eventually(t, func() bool { return sink.publishAttempts() == 2 }, time.Second, time.Millisecond)
+	require.Equal(t, int64(1), l.Checkpoint())
+	require.NoError(t, l.Close())
+
+	// the checkpoint is restored, and the blocks already delivered are skipped
+	sink.setFail(false)
+	l, err = streaming.NewListener(sink, dir, log.NewNopLogger())
+	require.NoError(t, err)
+	defer l.Close()
+	require.Equal(t, int64(1), l.Checkpoint())
+	require.NoError(t, commitBlock(t, l, 1, changeSet))
+	require.NoError(t, commitBlock(t, l, 2, changeSet))
+	requireCheckpoint(t, l, 2)
+	require.Equal(t, []int64{1, 2}, sink.delivered())
+
+	_, pairs = decodeBlock(t, sink.message(1))
+	require.Len(t, pairs, 2)
+}
+
+func TestListenerQueue(t *testing.T) {
+	sink := &mockSink{block: make(chan struct{})}
+	l, err := streaming.NewListener(sink, t.TempDir(), log.NewNopLogger(), streaming.WithQueueSize(1))
+	require.NoError(t, err)
+	defer l.Close()
+
+	// the commits don't wait for the sink, and the blocks beyond the queue size
+	// are dropped
+	require.NoError(t, commitBlock(t, l, 1, nil))
+	require.Eventually(t, func() bool { return commitBlock(t, l, 2, nil) == nil }, time.Second, time.Millisecond)
+	require.ErrorContains(t, commitBlock(t, l, 3, nil), "streaming queue is full")
+
+	close(sink.block)
+	requireCheckpoint(t, l, 2)
+	require.Equal(t, []int64{1, 2}, sink.delivered())
+}
+
+func TestListenerAtLeastOnce(t *testing.T) {
+	dir := t.TempDir()
+	sink := &mockSink{fail: true}
+	l, err := streaming.NewListener(sink, dir, log.NewNopLogger(), streaming.WithAtLeastOnce())
+	require.NoError(t, err)
+
+	// the blocks which can't be delivered are kept pending
+	require.NoError(t, commitBlock(t, l, 1, nil))
+	require.NoError(t, commitBlock(t, l, 2, nil))
+	require.Eventually(t, func() bool { return sink.publishAttempts() > 0 }, time.Second, time.Millisecond)
+	require.NoError(t, l.Close())
+	require.Empty(t, sink.delivered())
+	require.Equal(t, int64(0), l.Checkpoint())
+
+	// and retried in order after a restart
+	sink.setFail(false)
+	l, err = streaming.NewListener(sink, dir, log.NewNopLogger(), streaming.WithAtLeastOnce())
+	require.NoError(t, err)
+	defer l.Close()
+	requireCheckpoint(t, l, 2)
+	require.NoError(t, commitBlock(t, l, 3, nil))
+	requireCheckpoint(t, l, 3)
+	require.Equal(t, []int64{1, 2, 3}, sink.delivered())
+
+	require.NoError(t, commitBlock(t, l, 4, nil))
+	requireCheckpoint(t, l, 4)
+	require.Equal(t, []int64{1, 2, 3, 4}, sink.delivered())
+}
@@ -0,0 +1,264 @@
+package streaming
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// DefaultSinkTimeout is the default timeout for the delivery of a message.
+const DefaultSinkTimeout = 10 * time.Second
+
+// Sink is an external system to which the messages of the committed blocks are
+// published.
+type Sink interface {
+	// Publish delivers the message of the block at the given height. It returns
+	// an error if the delivery isn't confirmed.
+	Publish(ctx context.Context, height int64, msg []byte) error
+
+	// Close releases the resources of the sink.
+	Close() error
+}
+
+var (
+	_ Sink = (*FileSink)(nil)
+	_ Sink = (*NATSCoreSink)(nil)
+	_ Sink = (*KafkaRESTProxySink)(nil)
+)
+
+// FileSink writes the message of each block to a block-<height> file of a
+// directory.
+type FileSink struct {
+	dir string
+}
+
+// NewFileSink returns a FileSink writing to the given directory.
+func NewFileSink(dir string) (*FileSink, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create streaming file sink directory: %w", err)
+	}
+
+	return &FileSink{dir: dir}, nil
+}
+
+// Publish implements Sink.
+func (s *FileSink) Publish(_ context.Context, height int64, msg []byte) error {
+	return writeFileAtomic(filepath.Join(s.dir, fmt.Sprintf("block-%d", height)), msg)
+}
+
+// Close implements Sink.
+func (s *FileSink) Close() error { return nil }
+
+// NATSCoreSink publishes the messages to a subject of a NATS server, using the
+// core NATS client protocol. A message is delivered once the server
+// acknowledged a PING sent after it, which guarantees that the server processed
+// it. Core NATS doesn't persist the messages, which are lost if no subscriber
+// receives them: a JetStream stream capturing the subject can persist them.
+type NATSCoreSink struct {
+	address string
+	subject string
+	timeout time.Duration
+
+	conn   net.Conn
+	reader *bufio.Reader
+}
+
+// NewNATSCoreSink returns a NATSCoreSink publishing to the subject of the NATS
+// server at the given address, e.g. "nats://localhost:4222". The connection to
+// the server is established on the first publication, and re-established after
+// an error.
+func NewNATSCoreSink(address, subject string) *NATSCoreSink {
+	return &NATSCoreSink{
+		address: strings.TrimPrefix(address, "nats://"),
+		subject: subject,
+		timeout: DefaultSinkTimeout,
+	}
+}
+
+// Publish implements Sink.
+func (s *NATSCoreSink) Publish(ctx context.Context, _ int64, msg []byte) error {
+	if err := s.publish(ctx, msg); err != nil {
+		s.Close()
+		return err
+	}
+
+	return nil
+}
+
+func (s *NATSCoreSink) publish(ctx context.Context, msg []byte) error {
+	if s.conn == nil {
+		if err := s.connect(ctx); err != nil {
+			return fmt.Errorf("failed to connect to NATS server %s: %w", s.address, err)
+		}
+	}
+
+	if err := s.conn.SetDeadline(time.Now().Add(s.timeout)); err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "PUB %s %d\r\n", s.subject, len(msg))
+	buf.Write(msg)
+	buf.WriteString("\r\nPING\r\n")
+	if _, err := s.conn.Write(buf.Bytes()); err != nil {
+		return err
+	}
+
+	return s.waitPong()
+}
+
+func (s *NATSCoreSink) connect(ctx context.Context) (err error) {
+	dialer := net.Dialer{Timeout: s.timeout}
+	if s.conn, err = dialer.DialContext(ctx, "tcp", s.address); err != nil {
+		return err
+	}
+	s.reader = bufio.NewReader(s.conn)
+
+	if err := s.conn.SetDeadline(time.Now().Add(s.timeout)); err != nil {
+		return err
+	}
+
+	// the server greets the client with its INFO
+	line, err := s.reader.ReadString('\n')
+	if err != nil {
+		return err
+	}
+	if !strings.HasPrefix(line, "INFO") {
+		return fmt.Errorf("unexpected NATS server greeting: %q", strings.TrimSpace(line))
+	}
+
+	_, err = io.WriteString(s.conn, "CONNECT {\"verbose\":false,\"pedantic\":false,\"name\":\"cosmos-sdk-streaming\"}\r\n")
+	return err
+}
+
+// waitPong reads the server operations until the PONG replying to our PING.
+func (s *NATSCoreSink) waitPong() error {
+	for {
+		line, err := s.reader.ReadString('\n')
+		if err != nil {
+			return err
+		}
+
+		op := strings.TrimSpace(line)
+		switch {
+		case op == "PONG":
+			return nil
+		case op == "PING":
+			if _, err := io.WriteString(s.conn, "PONG\r\n"); err != nil {
+				return err
+			}
+		case strings.HasPrefix(op, "-ERR"):
+			return fmt.Errorf("NATS server error: %s", strings.TrimSpace(strings.TrimPrefix(op, "-ERR")))
+		}
+	}
+}
+
+// Close implements Sink.
+func (s *NATSCoreSink) Close() error {
+	if s.conn == nil {
+		return nil
+	}
+
+	err := s.conn.Close()
+	s.conn, s.reader = nil, nil
+	return err
+}
+
+// KafkaRESTProxySink publishes the messages to a Kafka topic through a Kafka
+// REST proxy, using its v2 API, rather than the Kafka protocol. All the
+// messages have the same key, so that they are appended to the same partition
+// of the topic, in order.
+type KafkaRESTProxySink struct {
+	endpoint string
+	client   *http.Client
+}
+
+// kafkaKey is the key of the messages published to Kafka.
+var kafkaKey = base64.StdEncoding.EncodeToString([]byte("block"))
+
+// NewKafkaRESTProxySink returns a KafkaRESTProxySink publishing to the topic
+// through the Kafka REST proxy at the given URL, e.g. "http://localhost:8082".
+func NewKafkaRESTProxySink(proxyURL, topic string) *KafkaRESTProxySink {
+	return &KafkaRESTProxySink{
+		endpoint: strings.TrimSuffix(proxyURL, "/") + "/topics/" + url.PathEscape(topic),
+		client:   &http.Client{Timeout: DefaultSinkTimeout},
+	}
+}
+
+type (
+	kafkaRecord struct {
+		Key   string `json:"key"`
+		Value string `json:"value"`
+	}
+
+	kafkaProduceRequest struct {
+		Records []kafkaRecord `json:"records"`
+	}
+
+	kafkaProduceResponse struct {
+		Offsets []struct {
+			Partition int32  `json:"partition"`
+			Offset    int64  `json:"offset"`
+			ErrorCode *int   `json:"error_code"`
+			Error     string `json:"error"`
+		} `json:"offsets"`
+	}
+)
+
+// Publish implements Sink.
+func (s *KafkaRESTProxySink) Publish(ctx context.Context, _ int64, msg []byte) error {
+	body, err := json.Marshal(kafkaProduceRequest{
+		Records: []kafkaRecord{{Key: kafkaKey, Value: base64.StdEncoding.EncodeToString(msg)}},
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/vnd.kafka.binary.v2+json")
+	req.Header.Set("Accept", "application/vnd.kafka.v2+json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		bz, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("kafka REST proxy returned %s: %s", resp.Status, strings.TrimSpace(string(bz)))
+	}
+
+	var produceResp kafkaProduceResponse
+	if err := json.NewDecoder(resp.Body).Decode(&produceResp); err != nil {
+		return fmt.Errorf("invalid kafka REST proxy response: %w", err)
+	}
+	if len(produceResp.Offsets) != 1 {
+		return fmt.Errorf("unexpected kafka REST proxy response with %d offsets", len(produceResp.Offsets))
+	}
+	if offset := produceResp.Offsets[0]; offset.ErrorCode != nil || offset.Error != "" {
+		return fmt.Errorf("kafka error: %s", offset.Error)
+	}
+
+	return nil
+}
+
+// Close implements Sink.
+func (s *KafkaRESTProxySink) Close() error {
+	s.client.CloseIdleConnections()
+	return nil
+}
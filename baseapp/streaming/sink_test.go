@@ -0,0 +1,134 @@
+package streaming_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/baseapp/streaming"
+)
+
+func TestFileSink(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "blocks")
+	sink, err := streaming.NewFileSink(dir)
+	require.NoError(t, err)
+
+	require.NoError(t, sink.Publish(context.Background(), 7, []byte("block")))
+	bz, err := os.ReadFile(filepath.Join(dir, "block-7"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("block"), bz)
+}
+
+// serveNATS runs a minimal NATS server accepting one connection, and sends the
+// payloads published to it on the returned channel. It replies -ERR to the
+// publications of messages equal to "reject".
+func serveNATS(t *testing.T) (string, <-chan string) {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { ln.Close() })
+
+	published := make(chan string, 10)
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go func(conn net.Conn) {
+				defer conn.Close()
+				r := bufio.NewReader(conn)
+				fmt.Fprint(conn, "INFO {\"server_id\":\"test\"}\r\n")
+				for {
+					line, err := r.ReadString('\n')
+					if err != nil {
+						return
+					}
+					op := strings.Fields(line)
+					switch {
+					case len(op) == 3 && op[0] == "PUB":
+						var size int
+						fmt.Sscan(op[2], &size)
+						payload := make([]byte, size+2)
+						if _, err := io.ReadFull(r, payload); err != nil {
+							return
+						}
+						msg := string(payload[:size])
+						if msg == "reject" {
+							fmt.Fprint(conn, "-ERR 'Permissions Violation'\r\n")
+							continue
+						}
+						published <- op[1] + ":" + msg
+					case len(op) == 1 && op[0] == "PING":
+						fmt.Fprint(conn, "PONG\r\n")
+					}
+				}
+			}(conn)
+		}
+	}()
+
+	return ln.Addr().String(), published
+}
+
+func TestNATSCoreSink(t *testing.T) {
+	addr, published := serveNATS(t)
+	sink := streaming.NewNATSCoreSink("nats://"+addr, "blocks")
+	defer sink.Close()
+
+	require.NoError(t, sink.Publish(context.Background(), 1, []byte("block1")))
+	require.Equal(t, "blocks:block1", <-published)
+
+	require.ErrorContains(t, sink.Publish(context.Background(), 2, []byte("reject")), "Permissions Violation")
+
+	// the sink reconnects after an error
+	require.NoError(t, sink.Publish(context.Background(), 2, []byte("block2")))
+	require.Equal(t, "blocks:block2", <-published)
+}
+
+func TestKafkaRESTProxySink(t *testing.T) {
+	var records []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/topics/blocks", r.URL.Path)
+		require.Equal(t, "application/vnd.kafka.binary.v2+json", r.Header.Get("Content-Type"))
+
+		var req struct {
+			Records []struct {
+				Value string `json:"value"`
+			} `json:"records"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		require.Len(t, req.Records, 1)
+		value, err := base64.StdEncoding.DecodeString(req.Records[0].Value)
+		require.NoError(t, err)
+
+		if string(value) == "reject" {
+			fmt.Fprint(w, `{"offsets":[{"partition":null,"offset":null,"error_code":50001,"error":"record too large"}]}`)
+			return
+		}
+		records = append(records, string(value))
+		fmt.Fprintf(w, `{"offsets":[{"partition":0,"offset":%d}]}`, len(records)-1)
+	}))
+	defer srv.Close()
+
+	sink := streaming.NewKafkaRESTProxySink(srv.URL+"/", "blocks")
+	defer sink.Close()
+
+	require.NoError(t, sink.Publish(context.Background(), 1, []byte("block1")))
+	require.Equal(t, []string{"block1"}, records)
+	require.ErrorContains(t, sink.Publish(context.Background(), 2, []byte("reject")), "record too large")
+
+	srv.Config.Handler = http.NotFoundHandler()
+	require.ErrorContains(t, sink.Publish(context.Background(), 2, []byte("block2")), "404")
+}
@@ -146,3 +146,35 @@ func Test_Ctx_with_StreamingManager(t *testing.T) {
 		require.NoError(t, err)
 	}
 }
+
+func TestAddABCIListenerWhileCommitting(t *testing.T) {
+	suite := NewBaseAppSuite(t)
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &tmproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	// listeners are added while blocks are committed, e.g. by a gRPC service
+	// registered once the node started
+	listeners := make([]MockABCIListener, 10)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := range listeners {
+			listeners[i] = NewMockABCIListener(fmt.Sprintf("lis_%d", i))
+			suite.baseApp.AddABCIListener(&listeners[i])
+		}
+	}()
+
+	for height := int64(1); height <= 10; height++ {
+		_, err = suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: height})
+		require.NoError(t, err)
+		_, err = suite.baseApp.Commit()
+		require.NoError(t, err)
+	}
+	<-done
+
+	_, err = suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 11})
+	require.NoError(t, err)
+	require.Len(t, getFinalizeBlockStateCtx(suite.baseApp).StreamingManager().ABCIListeners, len(listeners))
+}
@@ -4,6 +4,7 @@ import (
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
 	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -29,6 +30,24 @@ func (app *BaseApp) Simulate(txBytes []byte) (sdk.GasInfo, *sdk.Result, error) {
 	return gasInfo, result, err
 }
 
+// SimulateWithOverrides executes a tx in simulate mode like Simulate, after
+// applying the state overrides to the branched state of the simulation. The
+// overrides consume no gas, their events are discarded and, like the rest of
+// the simulation, they are never persisted.
+func (app *BaseApp) SimulateWithOverrides(txBytes []byte, overrides func(ctx sdk.Context) error) (sdk.GasInfo, *sdk.Result, error) {
+	ctx := app.getContextForTx(execModeSimulate, txBytes)
+
+	overridesCtx := ctx.
+		WithGasMeter(storetypes.NewInfiniteGasMeter()).
+		WithEventManager(sdk.NewEventManager())
+	if err := overrides(overridesCtx); err != nil {
+		return sdk.GasInfo{}, nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid state overrides: %s", err)
+	}
+
+	gasInfo, result, _, err := app.runTxWithContext(ctx, execModeSimulate, txBytes)
+	return gasInfo, result, err
+}
+
 func (app *BaseApp) SimDeliver(txEncoder sdk.TxEncoder, tx sdk.Tx) (sdk.GasInfo, *sdk.Result, error) {
 	// See comment for Check().
 	bz, err := txEncoder(tx)
@@ -34,7 +34,10 @@ func (msg *MsgCounter) ValidateBasic() error {
 	return errorsmod.Wrap(sdkerrors.ErrInvalidSequence, "counter should be a non-negative integer")
 }
 
-var _ sdk.Msg = &MsgCounter2{}
+var (
+	_ sdk.Msg           = &MsgCounter2{}
+	_ sdk.MsgAccessSpec = &MsgCounter2{}
+)
 
 func (msg *MsgCounter2) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{} }
 func (msg *MsgCounter2) ValidateBasic() error {
@@ -44,7 +47,14 @@ func (msg *MsgCounter2) ValidateBasic() error {
 	return errorsmod.Wrap(sdkerrors.ErrInvalidSequence, "counter should be a non-negative integer")
 }
 
-var _ sdk.Msg = &MsgKeyValue{}
+// AccessedStoreKeys implements sdk.MsgAccessSpec, MsgCounter2 increments a
+// counter in the key1 store.
+func (msg *MsgCounter2) AccessedStoreKeys() []string { return []string{"key1"} }
+
+var (
+	_ sdk.Msg           = &MsgKeyValue{}
+	_ sdk.MsgAccessSpec = &MsgKeyValue{}
+)
 
 func (msg *MsgKeyValue) GetSigners() []sdk.AccAddress {
 	if len(msg.Signer) == 0 {
@@ -63,3 +73,7 @@ func (msg *MsgKeyValue) ValidateBasic() error {
 	}
 	return nil
 }
+
+// AccessedStoreKeys implements sdk.MsgAccessSpec, MsgKeyValue sets the key in
+// the key2 store.
+func (msg *MsgKeyValue) AccessedStoreKeys() []string { return []string{"key2"} }
@@ -10,7 +10,9 @@ import (
 	"net/url"
 	"reflect"
 	"strconv"
+	"sync"
 	"testing"
+	"time"
 	"unsafe"
 
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
@@ -72,6 +74,48 @@ func (m MsgKeyValueImpl) Set(ctx context.Context, msg *baseapptestutil.MsgKeyVal
 	return &baseapptestutil.MsgCreateKeyValueResponse{}, nil
 }
 
+// concurrentServerImpl executes a MsgKeyValue or a MsgCounter2 once n messages
+// are executed concurrently, and fails if they aren't before a timeout.
+type concurrentServerImpl struct {
+	n     int
+	mtx   sync.Mutex
+	count int
+	ready chan struct{}
+}
+
+func newConcurrentServerImpl(n int) *concurrentServerImpl {
+	return &concurrentServerImpl{n: n, ready: make(chan struct{})}
+}
+
+func (m *concurrentServerImpl) wait() error {
+	m.mtx.Lock()
+	if m.count++; m.count == m.n {
+		close(m.ready)
+	}
+	m.mtx.Unlock()
+
+	select {
+	case <-m.ready:
+		return nil
+	case <-time.After(5 * time.Second):
+		return errors.New("messages not executed concurrently")
+	}
+}
+
+func (m *concurrentServerImpl) Set(ctx context.Context, msg *baseapptestutil.MsgKeyValue) (*baseapptestutil.MsgCreateKeyValueResponse, error) {
+	if err := m.wait(); err != nil {
+		return nil, err
+	}
+	return MsgKeyValueImpl{}.Set(ctx, msg)
+}
+
+func (m *concurrentServerImpl) IncrementCounter(_ context.Context, _ *baseapptestutil.MsgCounter2) (*baseapptestutil.MsgCreateCounterResponse, error) {
+	if err := m.wait(); err != nil {
+		return nil, err
+	}
+	return &baseapptestutil.MsgCreateCounterResponse{}, nil
+}
+
 type CounterServerImplGasMeterOnly struct {
 	gas uint64
 }
@@ -0,0 +1,129 @@
+package baseapp
+
+import (
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// VoteExtensionsEnabled returns true if the block proposal at the current height
+// carries the vote extensions of the previous height, i.e. if the current height
+// is after the vote extensions enable height.
+func VoteExtensionsEnabled(ctx sdk.Context) bool {
+	cp := ctx.ConsensusParams()
+	if cp.Abci == nil || cp.Abci.VoteExtensionsEnableHeight == 0 {
+		return false
+	}
+
+	return ctx.HeaderInfo().Height > cp.Abci.VoteExtensionsEnableHeight
+}
+
+// VoteExtensionInjector wraps PrepareProposal and ProcessProposal handlers to
+// inject the extended commit info of the previous height, i.e. the vote
+// extensions, as the first transaction of the block proposal. This allows
+// applications, e.g. price oracles or threshold decryption, to derive data from
+// the vote extensions in the PreBlocker, using ExtractInjectedVoteExtensions.
+//
+// The proposer validates the vote extensions it injects, and the other validators
+// reject proposals whose injected vote extensions are missing or invalid.
+type VoteExtensionInjector struct {
+	valStore        ValidatorStore
+	prepareProposal sdk.PrepareProposalHandler
+	processProposal sdk.ProcessProposalHandler
+}
+
+// NewVoteExtensionInjector returns a VoteExtensionInjector wrapping the given
+// PrepareProposal and ProcessProposal handlers, which are called with the
+// transactions of the proposal minus the injected vote extensions.
+func NewVoteExtensionInjector(valStore ValidatorStore, prepareProposal sdk.PrepareProposalHandler, processProposal sdk.ProcessProposalHandler) *VoteExtensionInjector {
+	return &VoteExtensionInjector{
+		valStore:        valStore,
+		prepareProposal: prepareProposal,
+		processProposal: processProposal,
+	}
+}
+
+// PrepareProposalHandler returns a PrepareProposalHandler injecting the vote
+// extensions of the previous height in front of the transactions selected by
+// the wrapped handler.
+func (h *VoteExtensionInjector) PrepareProposalHandler() sdk.PrepareProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+		if !VoteExtensionsEnabled(ctx) {
+			return h.prepareProposal(ctx, req)
+		}
+
+		if err := ValidateVoteExtensions(ctx, h.valStore, req.LocalLastCommit); err != nil {
+			return nil, fmt.Errorf("failed to validate vote extensions: %w", err)
+		}
+
+		bz, err := req.LocalLastCommit.Marshal()
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal vote extensions: %w", err)
+		}
+
+		// leave room in the block for the injected vote extensions
+		wrappedReq := *req
+		wrappedReq.MaxTxBytes -= int64(len(bz))
+		if wrappedReq.MaxTxBytes < 0 {
+			return nil, fmt.Errorf("vote extensions of %d bytes exceed the max tx bytes %d", len(bz), req.MaxTxBytes)
+		}
+
+		resp, err := h.prepareProposal(ctx, &wrappedReq)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Txs = append([][]byte{bz}, resp.Txs...)
+		return resp, nil
+	}
+}
+
+// ProcessProposalHandler returns a ProcessProposalHandler rejecting proposals
+// whose injected vote extensions are missing or invalid, and passing the
+// remaining transactions to the wrapped handler.
+func (h *VoteExtensionInjector) ProcessProposalHandler() sdk.ProcessProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+		if !VoteExtensionsEnabled(ctx) {
+			return h.processProposal(ctx, req)
+		}
+
+		extCommit, txs, err := ExtractInjectedVoteExtensions(ctx, req.Txs)
+		if err != nil {
+			ctx.Logger().Error("proposal has no valid injected vote extensions", "height", req.Height, "err", err)
+			return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+		}
+
+		if err := ValidateVoteExtensions(ctx, h.valStore, extCommit); err != nil {
+			ctx.Logger().Error("failed to validate injected vote extensions", "height", req.Height, "err", err)
+			return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+		}
+
+		wrappedReq := *req
+		wrappedReq.Txs = txs
+		return h.processProposal(ctx, &wrappedReq)
+	}
+}
+
+// ExtractInjectedVoteExtensions returns the vote extensions injected by a
+// VoteExtensionInjector in the transactions of a block proposal, along with the
+// remaining transactions. It is typically called in the PreBlocker, and returns
+// an error if vote extensions aren't enabled at the current height. The vote
+// extensions are only validated in ProcessProposal.
+func ExtractInjectedVoteExtensions(ctx sdk.Context, txs [][]byte) (abci.ExtendedCommitInfo, [][]byte, error) {
+	var extCommit abci.ExtendedCommitInfo
+	if !VoteExtensionsEnabled(ctx) {
+		return extCommit, nil, fmt.Errorf("vote extensions are not enabled at height %d", ctx.HeaderInfo().Height)
+	}
+
+	if len(txs) == 0 {
+		return extCommit, nil, fmt.Errorf("missing injected vote extensions")
+	}
+
+	if err := extCommit.Unmarshal(txs[0]); err != nil {
+		return extCommit, nil, fmt.Errorf("failed to unmarshal injected vote extensions: %w", err)
+	}
+
+	return extCommit, txs[1:], nil
+}
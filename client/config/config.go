@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
@@ -12,6 +13,8 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/pkcs11"
 )
 
 // DefaultConfig returns default config for the client.toml
@@ -31,13 +34,15 @@ func DefaultConfig() *Config {
 type ClientConfig Config
 
 type Config struct {
-	ChainID               string     `mapstructure:"chain-id" json:"chain-id"`
-	KeyringBackend        string     `mapstructure:"keyring-backend" json:"keyring-backend"`
-	KeyringDefaultKeyName string     `mapstructure:"keyring-default-keyname" json:"keyring-default-keyname"`
-	Output                string     `mapstructure:"output" json:"output"`
-	Node                  string     `mapstructure:"node" json:"node"`
-	BroadcastMode         string     `mapstructure:"broadcast-mode" json:"broadcast-mode"`
-	GRPC                  GRPCConfig `mapstructure:",squash"`
+	ChainID               string             `mapstructure:"chain-id" json:"chain-id"`
+	KeyringBackend        string             `mapstructure:"keyring-backend" json:"keyring-backend"`
+	KeyringDefaultKeyName string             `mapstructure:"keyring-default-keyname" json:"keyring-default-keyname"`
+	Output                string             `mapstructure:"output" json:"output"`
+	Node                  string             `mapstructure:"node" json:"node"`
+	BroadcastMode         string             `mapstructure:"broadcast-mode" json:"broadcast-mode"`
+	GRPC                  GRPCConfig         `mapstructure:",squash"`
+	PKCS11                PKCS11Config       `mapstructure:",squash"`
+	RemoteSigner          RemoteSignerConfig `mapstructure:",squash"`
 }
 
 // GRPCConfig holds the gRPC client configuration.
@@ -46,6 +51,23 @@ type GRPCConfig struct {
 	Insecure bool   `mapstructure:"grpc-insecure"  json:"grpc-insecure"`
 }
 
+// EnvPKCS11PIN is the environment variable holding the PIN of the user of the PKCS#11 token,
+// which is not stored in client.toml.
+const EnvPKCS11PIN = "PKCS11_PIN"
+
+// PKCS11Config holds the configuration of the PKCS#11 token holding the PKCS#11 keys of the keyring.
+// The PIN of the user of the token is read from the EnvPKCS11PIN environment variable.
+type PKCS11Config struct {
+	Module string `mapstructure:"pkcs11-module" json:"pkcs11-module"`
+	Slot   uint   `mapstructure:"pkcs11-slot" json:"pkcs11-slot"`
+}
+
+// RemoteSignerConfig holds the configuration of the remote signer of the remote keyring backend.
+type RemoteSignerConfig struct {
+	Address  string `mapstructure:"remote-signer-address" json:"remote-signer-address"`
+	Insecure bool   `mapstructure:"remote-signer-insecure" json:"remote-signer-insecure"`
+}
+
 // ReadFromClientConfig reads values from client.toml file and updates them in client.Context
 // It uses CreateClientConfig internally with no custom template and custom config.
 // Deprecated: use CreateClientConfig instead.
@@ -133,6 +155,23 @@ func CreateClientConfig(ctx client.Context, customClientTemplate string, customC
 		WithKeyringDir(ctx.HomeDir).
 		WithKeyringDefaultKeyName(conf.KeyringDefaultKeyName)
 
+	if conf.PKCS11.Module != "" {
+		pkcs11Config := pkcs11.Config{Module: conf.PKCS11.Module, Slot: conf.PKCS11.Slot, PIN: os.Getenv(EnvPKCS11PIN)}
+		ctx = ctx.WithKeyringOptions(append(slices.Clone(ctx.KeyringOptions), func(options *keyring.Options) {
+			options.PKCS11 = pkcs11Config
+		})...)
+	}
+
+	if conf.RemoteSigner.Address != "" {
+		remoteSigner, err := getGRPCClient(GRPCConfig(conf.RemoteSigner))
+		if err != nil {
+			return ctx, fmt.Errorf("couldn't get remote signer client: %w", err)
+		}
+		ctx = ctx.WithKeyringOptions(append(slices.Clone(ctx.KeyringOptions), func(options *keyring.Options) {
+			options.RemoteSigner = remoteSigner
+		})...)
+	}
+
 	keyring, err := client.NewKeyringFromBackend(ctx, conf.KeyringBackend)
 	if err != nil {
 		return ctx, fmt.Errorf("couldn't get keyring: %w", err)
@@ -13,6 +13,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/pkcs11"
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 )
 
@@ -200,3 +202,45 @@ func TestGRPCConfig(t *testing.T) {
 		require.Equal(t, expectedGRPCConfig.Insecure, clientCtx.Viper.GetBool("grpc-insecure"))
 	})
 }
+
+func TestPKCS11Config(t *testing.T) {
+	clientCfg := config.DefaultConfig()
+	clientCfg.KeyringBackend = "test"
+	clientCfg.PKCS11 = config.PKCS11Config{
+		Module: "/usr/lib/softhsm/libsofthsm2.so",
+		Slot:   1,
+	}
+	t.Setenv(config.EnvPKCS11PIN, "1234")
+
+	clientCtx, cleanup, err := initClientContextWithTemplate(t, "", config.DefaultClientConfigTemplate, clientCfg)
+	defer cleanup()
+	require.NoError(t, err)
+
+	// the token is configured in the keyring options
+	var options keyring.Options
+	for _, opt := range clientCtx.KeyringOptions {
+		opt(&options)
+	}
+	require.Equal(t, pkcs11.Config{Module: "/usr/lib/softhsm/libsofthsm2.so", Slot: 1, PIN: "1234"}, options.PKCS11)
+}
+
+func TestRemoteSignerConfig(t *testing.T) {
+	clientCfg := config.DefaultConfig()
+	clientCfg.KeyringBackend = keyring.BackendRemote
+	clientCfg.RemoteSigner = config.RemoteSignerConfig{
+		Address:  "localhost:9095",
+		Insecure: true,
+	}
+
+	clientCtx, cleanup, err := initClientContextWithTemplate(t, "", config.DefaultClientConfigTemplate, clientCfg)
+	defer cleanup()
+	require.NoError(t, err)
+	require.Equal(t, keyring.BackendRemote, clientCtx.Keyring.Backend())
+
+	// the connection to the signer is configured in the keyring options
+	var options keyring.Options
+	for _, opt := range clientCtx.KeyringOptions {
+		opt(&options)
+	}
+	require.NotNil(t, options.RemoteSigner)
+}
@@ -18,7 +18,7 @@ const (
 
 # The network chain ID
 chain-id = "{{ .ChainID }}"
-# The keyring's backend, where the keys are stored (os|file|kwallet|pass|test|memory)
+# The keyring's backend, where the keys are stored (os|file|kwallet|pass|test|memory|remote)
 keyring-backend = "{{ .KeyringBackend }}"
 # Default key name, if set, defines the default key to use for signing transaction when the --from flag is not specified
 keyring-default-keyname = "{{ .KeyringDefaultKeyName }}"
@@ -36,6 +36,19 @@ grpc-address = "{{ .GRPC.Address }}"
 # Allow the gRPC client to connect over insecure channels.
 # It can be overwritten by the --grpc-insecure flag in each command.
 grpc-insecure = {{ .GRPC.Insecure }}
+
+# Path of the PKCS#11 library of the token, e.g. a hardware security module, holding the PKCS#11 keys
+# of the keyring. The private keys of the PKCS#11 keys never leave the token.
+pkcs11-module = "{{ .PKCS11.Module }}"
+# ID of the slot of the token. The PIN of the user of the token is read from the PKCS11_PIN
+# environment variable, so that it is not stored in this file.
+pkcs11-slot = {{ .PKCS11.Slot }}
+
+# gRPC endpoint of the remote signer of the remote keyring backend, which holds the private keys
+# and signs the transactions allowed by its policy.
+remote-signer-address = "{{ .RemoteSigner.Address }}"
+# Allow the connection to the remote signer over an insecure channel.
+remote-signer-insecure = {{ .RemoteSigner.Insecure }}
 `
 )
 
@@ -14,6 +14,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/codec/aminocheck"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
@@ -41,6 +42,7 @@ func Cmd() *cobra.Command {
 	cmd.AddCommand(AddrCmd())
 	cmd.AddCommand(RawBytesCmd())
 	cmd.AddCommand(PrefixesCmd())
+	cmd.AddCommand(AminoCheckCmd())
 
 	return cmd
 }
@@ -338,3 +340,35 @@ func PrefixesCmd() *cobra.Command {
 		},
 	}
 }
+
+// AminoCheckCmd creates and returns a new cmd used for checking that the legacy amino JSON encoding of all the
+// registered messages matches their proto amino JSON encoding, used for SIGN_MODE_LEGACY_AMINO_JSON.
+func AminoCheckCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "amino-check",
+		Short: "Check the amino JSON encoding of all the registered messages",
+		Long: `Check that the legacy amino JSON encoding of all the registered messages matches their proto amino JSON
+encoding, as required for SIGN_MODE_LEGACY_AMINO_JSON signatures, e.g. made with Ledger devices.
+The messages are populated with sample values, and the differences are reported by field.`,
+		Example: fmt.Sprintf("$ %s debug amino-check", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			issues, err := aminocheck.Check(clientCtx.Codec.InterfaceRegistry(), clientCtx.LegacyAmino)
+			if err != nil {
+				return err
+			}
+
+			for _, issue := range issues {
+				cmd.Println(issue)
+			}
+			if len(issues) > 0 {
+				return fmt.Errorf("found %d amino JSON incompatibilities", len(issues))
+			}
+
+			cmd.Println("the amino JSON encodings of all the registered messages match")
+			return nil
+		},
+	}
+}
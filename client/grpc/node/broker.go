@@ -0,0 +1,132 @@
+package node
+
+import (
+	"context"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	storetypes "cosmossdk.io/store/types"
+)
+
+// subscriptionBufferSize is the number of blocks buffered for a subscriber,
+// which is dropped if it falls further behind.
+const subscriptionBufferSize = 100
+
+var _ storetypes.ABCIListener = (*BlockBroker)(nil)
+
+// BlockBroker is an ABCIListener broadcasting the results of the committed
+// blocks to the SubscribeBlocks and SubscribeTxs streams of the node service.
+// The blocks are never delayed by the subscribers: a subscriber which can't keep
+// up is dropped.
+type BlockBroker struct {
+	mtx         sync.Mutex
+	subscribers map[*blockSubscription]struct{}
+	req         *abci.RequestFinalizeBlock
+	res         *abci.ResponseFinalizeBlock
+}
+
+// blockSubscription is the subscription of a stream to the committed blocks.
+type blockSubscription struct {
+	blocks  chan committedBlock
+	dropped chan struct{} // closed when the subscriber is dropped
+}
+
+// committedBlock is the result of a committed block, along with its raw
+// transactions.
+type committedBlock struct {
+	result *SubscribeBlocksResponse
+	txs    [][]byte
+}
+
+// NewBlockBroker returns a BlockBroker without subscribers.
+func NewBlockBroker() *BlockBroker {
+	return &BlockBroker{subscribers: make(map[*blockSubscription]struct{})}
+}
+
+// ListenFinalizeBlock implements storetypes.ABCIListener, it keeps the FinalizeBlock
+// messages until the block is committed.
+func (b *BlockBroker) ListenFinalizeBlock(_ context.Context, req abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock) error {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	b.req, b.res = &req, &res
+	return nil
+}
+
+// ListenCommit implements storetypes.ABCIListener, it broadcasts the result of the
+// committed block to the subscribers.
+func (b *BlockBroker) ListenCommit(_ context.Context, _ abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	if b.req == nil || b.res == nil || len(b.subscribers) == 0 {
+		b.req, b.res = nil, nil
+		return nil
+	}
+
+	block := committedBlock{
+		result: &SubscribeBlocksResponse{
+			Height:       b.req.Height,
+			Time:         &b.req.Time,
+			AppHash:      b.res.AppHash,
+			Events:       b.res.Events,
+			TxResults:    b.res.TxResults,
+			StateChanges: summarizeChangeSet(changeSet),
+		},
+		txs: b.req.Txs,
+	}
+	b.req, b.res = nil, nil
+
+	for sub := range b.subscribers {
+		select {
+		case sub.blocks <- block:
+		default:
+			close(sub.dropped)
+			delete(b.subscribers, sub)
+		}
+	}
+
+	return nil
+}
+
+// subscribe returns a new subscription to the committed blocks.
+func (b *BlockBroker) subscribe() *blockSubscription {
+	sub := &blockSubscription{
+		blocks:  make(chan committedBlock, subscriptionBufferSize),
+		dropped: make(chan struct{}),
+	}
+
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	b.subscribers[sub] = struct{}{}
+	return sub
+}
+
+// unsubscribe removes the subscription.
+func (b *BlockBroker) unsubscribe(sub *blockSubscription) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	delete(b.subscribers, sub)
+}
+
+// summarizeChangeSet returns the number of keys written and deleted in each store
+// of the change set, which is sorted by store key.
+func summarizeChangeSet(changeSet []*storetypes.StoreKVPair) []StoreChanges {
+	var summary []StoreChanges
+	for _, pair := range changeSet {
+		if len(summary) == 0 || summary[len(summary)-1].StoreKey != pair.StoreKey {
+			summary = append(summary, StoreChanges{StoreKey: pair.StoreKey})
+		}
+
+		if pair.Delete {
+			summary[len(summary)-1].Deletes++
+		} else {
+			summary[len(summary)-1].Sets++
+		}
+	}
+
+	return summary
+}
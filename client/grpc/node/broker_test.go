@@ -0,0 +1,169 @@
+package node
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server/config"
+)
+
+type mockListenerRegistry struct {
+	listeners []storetypes.ABCIListener
+}
+
+func (r *mockListenerRegistry) AddABCIListener(listener storetypes.ABCIListener) {
+	r.listeners = append(r.listeners, listener)
+}
+
+// mockStream is a server stream sending its messages to a channel.
+type mockStream[T any] struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan T
+}
+
+func (s *mockStream[T]) Context() context.Context { return s.ctx }
+
+func (s *mockStream[T]) Send(msg T) error {
+	s.sent <- msg
+	return nil
+}
+
+func newMockStream[T any](ctx context.Context) *mockStream[T] {
+	return &mockStream[T]{ctx: ctx, sent: make(chan T, 10)}
+}
+
+func commitBlock(t *testing.T, broker *BlockBroker, req abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock, changeSet []*storetypes.StoreKVPair) {
+	t.Helper()
+	require.NoError(t, broker.ListenFinalizeBlock(context.Background(), req, res))
+	require.NoError(t, broker.ListenCommit(context.Background(), abci.ResponseCommit{}, changeSet))
+}
+
+// waitSubscribers waits until the broker has n subscribers.
+func waitSubscribers(t *testing.T, broker *BlockBroker, n int) {
+	t.Helper()
+	require.Eventually(t, func() bool {
+		broker.mtx.Lock()
+		defer broker.mtx.Unlock()
+		return len(broker.subscribers) == n
+	}, time.Second, time.Millisecond)
+}
+
+func transferEvent(recipient string) abci.Event {
+	return abci.Event{Type: "transfer", Attributes: []abci.EventAttribute{{Key: "recipient", Value: recipient}}}
+}
+
+func TestServiceServer_SubscribeBlocks(t *testing.T) {
+	registry := &mockListenerRegistry{}
+	svr := NewQueryServer(client.Context{}, *config.DefaultConfig(), WithBlockSubscriptions(registry))
+	require.Len(t, registry.listeners, 1)
+	broker := registry.listeners[0].(*BlockBroker)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := newMockStream[*SubscribeBlocksResponse](ctx)
+	done := make(chan error)
+	go func() { done <- svr.SubscribeBlocks(&SubscribeBlocksRequest{}, stream) }()
+	waitSubscribers(t, broker, 1)
+
+	blockTime := time.Unix(1000, 0).UTC()
+	commitBlock(t, broker,
+		abci.RequestFinalizeBlock{Height: 3, Time: blockTime, Txs: [][]byte{[]byte("tx")}},
+		abci.ResponseFinalizeBlock{
+			AppHash:   []byte("hash"),
+			Events:    []abci.Event{transferEvent("alice")},
+			TxResults: []*abci.ExecTxResult{{Code: 0}},
+		},
+		[]*storetypes.StoreKVPair{
+			{StoreKey: "acc", Key: []byte("a")},
+			{StoreKey: "bank", Key: []byte("b")},
+			{StoreKey: "bank", Key: []byte("c"), Delete: true},
+			{StoreKey: "bank", Key: []byte("d")},
+		},
+	)
+
+	block := <-stream.sent
+	require.Equal(t, int64(3), block.Height)
+	require.Equal(t, blockTime, *block.Time)
+	require.Equal(t, []byte("hash"), block.AppHash)
+	require.Len(t, block.Events, 1)
+	require.Len(t, block.TxResults, 1)
+	require.Equal(t, []StoreChanges{
+		{StoreKey: "acc", Sets: 1},
+		{StoreKey: "bank", Sets: 2, Deletes: 1},
+	}, block.StateChanges)
+
+	cancel()
+	require.ErrorIs(t, <-done, context.Canceled)
+	waitSubscribers(t, broker, 0)
+}
+
+func TestServiceServer_SubscribeTxs(t *testing.T) {
+	registry := &mockListenerRegistry{}
+	svr := NewQueryServer(client.Context{}, *config.DefaultConfig(), WithBlockSubscriptions(registry))
+	broker := registry.listeners[0].(*BlockBroker)
+
+	err := svr.SubscribeTxs(&SubscribeTxsRequest{Query: "transfer.recipient="}, newMockStream[*SubscribeTxsResponse](context.Background()))
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := newMockStream[*SubscribeTxsResponse](ctx)
+	go func() {
+		_ = svr.SubscribeTxs(&SubscribeTxsRequest{Query: "transfer.recipient='bob' AND tx.height=5"}, stream)
+	}()
+	waitSubscribers(t, broker, 1)
+
+	txs := [][]byte{[]byte("tx0"), []byte("tx1"), []byte("tx2")}
+	txResults := []*abci.ExecTxResult{
+		{Events: []abci.Event{transferEvent("alice")}},
+		{Events: []abci.Event{transferEvent("bob")}},
+		{Code: 5, Events: []abci.Event{transferEvent("bob")}},
+	}
+	commitBlock(t, broker, abci.RequestFinalizeBlock{Height: 4, Txs: txs}, abci.ResponseFinalizeBlock{TxResults: txResults}, nil)
+	commitBlock(t, broker, abci.RequestFinalizeBlock{Height: 5, Txs: txs}, abci.ResponseFinalizeBlock{TxResults: txResults}, nil)
+
+	for _, index := range []uint32{1, 2} {
+		tx := <-stream.sent
+		require.Equal(t, int64(5), tx.Height)
+		require.Equal(t, index, tx.Index)
+		require.Equal(t, txs[index], tx.Tx)
+		require.Equal(t, []byte(cmttypes.Tx(txs[index]).Hash()), tx.Hash)
+		require.Equal(t, txResults[index], tx.Result)
+	}
+	require.Empty(t, stream.sent)
+}
+
+func TestServiceServer_SubscribeBlocksDisabled(t *testing.T) {
+	svr := NewQueryServer(client.Context{}, *config.DefaultConfig())
+
+	err := svr.SubscribeBlocks(&SubscribeBlocksRequest{}, newMockStream[*SubscribeBlocksResponse](context.Background()))
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+}
+
+func TestBlockBroker_DropSlowSubscriber(t *testing.T) {
+	broker := NewBlockBroker()
+	sub := broker.subscribe()
+
+	for i := 0; i <= subscriptionBufferSize; i++ {
+		commitBlock(t, broker, abci.RequestFinalizeBlock{Height: int64(i + 1)}, abci.ResponseFinalizeBlock{}, nil)
+	}
+
+	require.Len(t, sub.blocks, subscriptionBufferSize)
+	select {
+	case <-sub.dropped:
+	default:
+		t.Fatal("slow subscriber not dropped")
+	}
+	waitSubscribers(t, broker, 0)
+}
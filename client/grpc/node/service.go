@@ -2,18 +2,62 @@ package node
 
 import (
 	context "context"
+	"fmt"
 
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/pubsub/query"
+	cmttypes "github.com/cometbft/cometbft/types"
 	gogogrpc "github.com/cosmos/gogoproto/grpc"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp/interblockcache"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // RegisterNodeService registers the node gRPC service on the provided gRPC router.
-func RegisterNodeService(clientCtx client.Context, server gogogrpc.Server, cfg config.Config) {
-	RegisterServiceServer(server, NewQueryServer(clientCtx, cfg))
+func RegisterNodeService(clientCtx client.Context, server gogogrpc.Server, cfg config.Config, opts ...ServiceOption) {
+	RegisterServiceServer(server, NewQueryServer(clientCtx, cfg, opts...))
+}
+
+// ABCIListenerRegistry registers the ABCIListeners of an application, e.g. a
+// BaseApp.
+type ABCIListenerRegistry interface {
+	AddABCIListener(listener storetypes.ABCIListener)
+}
+
+// ServiceOption configures the node gRPC service.
+type ServiceOption func(*queryServer)
+
+// WithBlockSubscriptions enables the SubscribeBlocks and SubscribeTxs streams,
+// registering a BlockBroker as ABCIListener of the application. The state
+// changes of the streamed blocks are empty unless streaming keys are
+// configured, with the streaming.abci.keys node config.
+func WithBlockSubscriptions(app ABCIListenerRegistry) ServiceOption {
+	return func(s *queryServer) {
+		s.broker = NewBlockBroker()
+		app.AddABCIListener(s.broker)
+	}
+}
+
+// CacheStatsProvider provides the statistics of the inter-block caches of an
+// application, e.g. a BaseApp.
+type CacheStatsProvider interface {
+	InterBlockCacheStats() []interblockcache.Stats
+}
+
+// WithCacheStats enables the CacheStats query, returning the statistics of the
+// inter-block caches of the application.
+func WithCacheStats(app CacheStatsProvider) ServiceOption {
+	return func(s *queryServer) {
+		s.cacheStats = app
+	}
 }
 
 // RegisterGRPCGatewayRoutes mounts the node gRPC service's GRPC-gateway routes
@@ -27,12 +71,20 @@ var _ ServiceServer = queryServer{}
 type queryServer struct {
 	clientCtx client.Context
 	cfg       config.Config
+	broker    *BlockBroker
+
+	cacheStats CacheStatsProvider
 }
 
-func NewQueryServer(clientCtx client.Context, cfg config.Config) ServiceServer {
-	return queryServer{
+func NewQueryServer(clientCtx client.Context, cfg config.Config, opts ...ServiceOption) ServiceServer {
+	s := queryServer{
 		clientCtx: clientCtx,
 	}
+	for _, opt := range opts {
+		opt(&s)
+	}
+
+	return s
 }
 
 func (s queryServer) Config(ctx context.Context, _ *ConfigRequest) (*ConfigResponse, error) {
@@ -62,3 +114,134 @@ func (s queryServer) Status(ctx context.Context, _ *StatusRequest) (*StatusRespo
 		ValidatorHash: sdkCtx.BlockHeader().NextValidatorsHash,
 	}, nil
 }
+
+func (s queryServer) ErrorCodes(_ context.Context, _ *ErrorCodesRequest) (*ErrorCodesResponse, error) {
+	errs := errorsmod.RegisteredErrors()
+	errorCodes := make([]ErrorCode, 0, len(errs))
+	for _, err := range errs {
+		errorCodes = append(errorCodes, ErrorCode{
+			Codespace:   err.Codespace(),
+			Code:        err.ABCICode(),
+			Description: err.Error(),
+			GrpcCode:    uint32(err.GRPCStatus().Code()),
+		})
+	}
+
+	return &ErrorCodesResponse{ErrorCodes: errorCodes}, nil
+}
+
+func (s queryServer) CacheStats(_ context.Context, _ *CacheStatsRequest) (*CacheStatsResponse, error) {
+	if s.cacheStats == nil {
+		return nil, status.Error(codes.Unimplemented, "cache statistics are not enabled by the application")
+	}
+
+	stats := s.cacheStats.InterBlockCacheStats()
+	stores := make([]StoreCacheStats, 0, len(stats))
+	for _, stat := range stats {
+		stores = append(stores, StoreCacheStats{
+			StoreKey: stat.StoreKey,
+			Len:      uint64(stat.Len),
+			Capacity: uint64(stat.Capacity),
+			Hits:     stat.Hits,
+			Misses:   stat.Misses,
+		})
+	}
+
+	return &CacheStatsResponse{Stores: stores}, nil
+}
+
+func (s queryServer) SubscribeBlocks(_ *SubscribeBlocksRequest, stream Service_SubscribeBlocksServer) error {
+	return s.streamBlocks(stream.Context(), func(block committedBlock) error {
+		return stream.Send(block.result)
+	})
+}
+
+func (s queryServer) SubscribeTxs(req *SubscribeTxsRequest, stream Service_SubscribeTxsServer) error {
+	var q *query.Query
+	if req.Query != "" {
+		var err error
+		if q, err = query.New(req.Query); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid query: %v", err)
+		}
+	}
+
+	return s.streamBlocks(stream.Context(), func(block committedBlock) error {
+		for i, txResult := range block.result.TxResults {
+			if i >= len(block.txs) {
+				break
+			}
+
+			tx := cmttypes.Tx(block.txs[i])
+			if q != nil {
+				matches, err := q.Matches(txEvents(block.result.Height, tx, txResult))
+				if err != nil {
+					return status.Errorf(codes.InvalidArgument, "failed to match query: %v", err)
+				}
+				if !matches {
+					continue
+				}
+			}
+
+			if err := stream.Send(&SubscribeTxsResponse{
+				Height: block.result.Height,
+				Index:  uint32(i),
+				Hash:   tx.Hash(),
+				Tx:     tx,
+				Result: txResult,
+			}); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// streamBlocks calls send with the committed blocks until the stream is closed,
+// or the subscriber is dropped.
+func (s queryServer) streamBlocks(ctx context.Context, send func(committedBlock) error) error {
+	if s.broker == nil {
+		return status.Error(codes.Unimplemented, "block subscriptions are not enabled by the application")
+	}
+
+	sub := s.broker.subscribe()
+	defer s.broker.unsubscribe(sub)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-sub.dropped:
+			return status.Error(codes.ResourceExhausted, "subscriber dropped for not keeping up with the committed blocks")
+		case block := <-sub.blocks:
+			if err := send(block); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// txEvents returns the events of the transaction indexed by composite key, as
+// expected by the event queries, including the tx.hash and tx.height events.
+func txEvents(height int64, tx cmttypes.Tx, result *abci.ExecTxResult) map[string][]string {
+	events := map[string][]string{
+		cmttypes.TxHashKey:   {fmt.Sprintf("%X", tx.Hash())},
+		cmttypes.TxHeightKey: {fmt.Sprintf("%d", height)},
+	}
+	for _, event := range result.Events {
+		if event.Type == "" {
+			continue
+		}
+
+		for _, attr := range event.Attributes {
+			if attr.Key == "" {
+				continue
+			}
+
+			key := event.Type + "." + attr.Key
+			events[key] = append(events[key], attr.Value)
+		}
+	}
+
+	return events
+}
@@ -4,10 +4,16 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/baseapp/interblockcache"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 func TestServiceServer_Config(t *testing.T) {
@@ -21,3 +27,51 @@ func TestServiceServer_Config(t *testing.T) {
 	require.Equal(t, ctx.MinGasPrices().String(), resp.MinimumGasPrice)
 	require.Equal(t, defaultCfg.HaltHeight, resp.HaltHeight)
 }
+
+func TestServiceServer_ErrorCodes(t *testing.T) {
+	svr := NewQueryServer(client.Context{}, *config.DefaultConfig())
+
+	resp, err := svr.ErrorCodes(sdk.Context{}, &ErrorCodesRequest{})
+	require.NoError(t, err)
+	require.Contains(t, resp.ErrorCodes, ErrorCode{
+		Codespace:   sdkerrors.RootCodespace,
+		Code:        sdkerrors.ErrInsufficientFunds.ABCICode(),
+		Description: "insufficient funds",
+		GrpcCode:    uint32(codes.Unknown),
+	})
+	require.Contains(t, resp.ErrorCodes, ErrorCode{
+		Codespace:   errorsmod.UndefinedCodespace,
+		Code:        errorsmod.ErrPanic.ABCICode(),
+		Description: "panic",
+		GrpcCode:    uint32(codes.Unknown),
+	})
+}
+
+type mockCacheStatsProvider []interblockcache.Stats
+
+func (m mockCacheStatsProvider) InterBlockCacheStats() []interblockcache.Stats {
+	return m
+}
+
+func TestServiceServer_CacheStats(t *testing.T) {
+	svr := NewQueryServer(client.Context{}, *config.DefaultConfig())
+	_, err := svr.CacheStats(sdk.Context{}, &CacheStatsRequest{})
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+
+	svr = NewQueryServer(client.Context{}, *config.DefaultConfig(), WithCacheStats(mockCacheStatsProvider{
+		{StoreKey: "acc", Len: 10, Capacity: 1000, Hits: 30, Misses: 10},
+		{StoreKey: "bank", Len: 2, Capacity: 1000, Hits: 1, Misses: 2},
+	}))
+	resp, err := svr.CacheStats(sdk.Context{}, &CacheStatsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []StoreCacheStats{
+		{StoreKey: "acc", Len: 10, Capacity: 1000, Hits: 30, Misses: 10},
+		{StoreKey: "bank", Len: 2, Capacity: 1000, Hits: 1, Misses: 2},
+	}, resp.Stores)
+
+	// the inter-block cache is disabled
+	svr = NewQueryServer(client.Context{}, *config.DefaultConfig(), WithCacheStats(mockCacheStatsProvider(nil)))
+	resp, err = svr.CacheStats(sdk.Context{}, &CacheStatsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, resp.Stores)
+}
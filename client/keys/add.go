@@ -38,6 +38,8 @@ const (
 	flagHDPath       = "hd-path"
 	flagPubKeyBase64 = "pubkey-base64"
 	flagIndiscreet   = "indiscreet"
+	flagPKCS11       = "pkcs11"
+	flagPKCS11Gen    = "pkcs11-generate"
 
 	// DefaultKeyPass contains the default key password for genesis transactions
 	DefaultKeyPass = "12345678"
@@ -66,6 +68,13 @@ sorted by address, unless the flag --nosort is set.
 Example:
 
     keys add mymultisig --multisig "keyname1,keyname2,keyname3" --multisig-threshold 2
+
+Use the --pkcs11 flag to store a reference to a key pair of the PKCS#11 token, e.g. a hardware
+security module, configured in client.toml, whose private key never leaves the token. The key pair
+is generated in the token with the --pkcs11-generate flag.
+Example:
+
+    keys add myoperator --pkcs11 operator --pkcs11-generate
 `,
 		Args: cobra.ExactArgs(1),
 		RunE: runAddCmdPrepare,
@@ -78,6 +87,8 @@ Example:
 	f.String(flagPubKeyBase64, "", "Parse a public key in base64 format and saves key info.")
 	f.BoolP(flagInteractive, "i", false, "Interactively prompt user for BIP39 passphrase and mnemonic")
 	f.Bool(flags.FlagUseLedger, false, "Store a local reference to a private key on a Ledger device")
+	f.String(flagPKCS11, "", "Store a local reference to the key pair with the given label of the PKCS#11 token configured in client.toml")
+	f.Bool(flagPKCS11Gen, false, "Generate the key pair of --pkcs11 in the PKCS#11 token")
 	f.Bool(flagRecover, false, "Provide seed phrase to recover existing key instead of creating")
 	f.Bool(flagNoBackup, false, "Don't print out seed phrase (if others are watching the terminal)")
 	f.Bool(flags.FlagDryRun, false, "Perform action, but don't add key to local keystore")
@@ -267,6 +278,25 @@ func runAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 		return errors.New("cannot set custom bip32 path with ledger")
 	}
 
+	// If we're using a PKCS#11 token, the key pair never leaves it.
+	if pkcs11Label, _ := cmd.Flags().GetString(flagPKCS11); pkcs11Label != "" {
+		if useLedger {
+			return fmt.Errorf("flags %s and %s cannot be used simultaneously", flagPKCS11, flags.FlagUseLedger)
+		}
+
+		generate, _ := cmd.Flags().GetBool(flagPKCS11Gen)
+		if dryRun, _ := cmd.Flags().GetBool(flags.FlagDryRun); dryRun && generate {
+			return fmt.Errorf("flags %s and %s cannot be used simultaneously", flagPKCS11Gen, flags.FlagDryRun)
+		}
+
+		k, err := kb.SavePKCS11Key(name, pkcs11Label, generate)
+		if err != nil {
+			return err
+		}
+
+		return printCreate(ctx, cmd, k, false, false, "", outputFormat)
+	}
+
 	// If we're using ledger, only thing we need is the path and the bech32 prefix.
 	if useLedger {
 		bech32PrefixAccAddr := ctx.AddressPrefix
@@ -16,6 +16,8 @@ import (
 	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/pkcs11"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -214,6 +216,84 @@ func Test_runAddCmdMultisigDupKeys(t *testing.T) {
 	require.EqualError(t, cmd.ExecuteContext(ctx), "duplicate multisig keys: keyname1")
 }
 
+// mockPKCS11Token is a PKCS#11 token generating its key pairs in memory.
+type mockPKCS11Token map[string]*secp256k1.PrivKey
+
+func (t mockPKCS11Token) GenerateKey(label string) (*secp256k1.PubKey, error) {
+	t[label] = secp256k1.GenPrivKey()
+	return t.PubKey(label)
+}
+
+func (t mockPKCS11Token) PubKey(label string) (*secp256k1.PubKey, error) {
+	priv, ok := t[label]
+	if !ok {
+		return nil, pkcs11.ErrKeyNotFound
+	}
+	return priv.PubKey().(*secp256k1.PubKey), nil
+}
+
+func (t mockPKCS11Token) Sign(label string, msg []byte) ([]byte, error) {
+	return t[label].Sign(msg)
+}
+
+func (mockPKCS11Token) Close() error { return nil }
+
+func Test_runAddCmdPKCS11(t *testing.T) {
+	token := mockPKCS11Token{}
+	openToken := pkcs11.SetOpenToken(func(pkcs11.Config) (pkcs11.Token, error) {
+		return token, nil
+	})
+	t.Cleanup(func() { pkcs11.SetOpenToken(openToken) })
+
+	cmd := AddKeyCommand()
+	cmd.Flags().AddFlagSet(Commands().PersistentFlags())
+	mockIn := testutil.ApplyMockIODiscardOutErr(cmd)
+	kbHome := t.TempDir()
+
+	cdc := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}).Codec
+	pkcs11Option := func(options *keyring.Options) {
+		options.PKCS11 = pkcs11.Config{Module: "module.so"}
+	}
+	kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, kbHome, mockIn, cdc, pkcs11Option)
+	require.NoError(t, err)
+
+	clientCtx := client.Context{}.
+		WithKeyringDir(kbHome).
+		WithKeyringOptions(pkcs11Option).
+		WithInput(mockIn).
+		WithCodec(cdc).
+		WithAddressCodec(addresscodec.NewBech32Codec("cosmos")).
+		WithValidatorAddressCodec(addresscodec.NewBech32Codec("cosmosvaloper")).
+		WithConsensusAddressCodec(addresscodec.NewBech32Codec("cosmosvalcons"))
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+	args := func(extra ...string) []string {
+		return append([]string{
+			"operator",
+			fmt.Sprintf("--%s=%s", flags.FlagKeyringDir, kbHome),
+			fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+			fmt.Sprintf("--%s=%s", flagPKCS11, "validator"),
+		}, extra...)
+	}
+
+	cmd.SetArgs(args())
+	require.ErrorIs(t, cmd.ExecuteContext(ctx), keyring.ErrPKCS11)
+
+	cmd.SetArgs(args("--"+flagPKCS11Gen, "--"+flags.FlagDryRun))
+	require.ErrorContains(t, cmd.ExecuteContext(ctx), "cannot be used simultaneously")
+	require.Empty(t, token)
+
+	cmd.SetArgs(args("--"+flagPKCS11Gen, "--"+flags.FlagDryRun+"=false"))
+	require.NoError(t, cmd.ExecuteContext(ctx))
+
+	k, err := kb.Key("operator")
+	require.NoError(t, err)
+	require.Equal(t, keyring.TypePKCS11, k.GetType())
+	pub, err := k.GetPubKey()
+	require.NoError(t, err)
+	require.Equal(t, token["validator"].PubKey(), pub)
+}
+
 func Test_runAddCmdDryRun(t *testing.T) {
 	pubkey1 := `{"@type":"/cosmos.crypto.secp256k1.PubKey","key":"AtObiFVE4s+9+RX5SP8TN9r2mxpoaT4eGj9CJfK7VRzN"}`
 	pubkey2 := `{"@type":"/cosmos.crypto.secp256k1.PubKey","key":"A/se1vkqgdQ7VJQCM4mxN+L+ciGhnnJ4XYsQCRBMrdRi"}`
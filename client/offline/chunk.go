@@ -0,0 +1,126 @@
+package offline
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// DefaultChunkSize is the default maximum size of the data of a chunk, which
+// keeps the frames of an animated QR code readable by most cameras.
+const DefaultChunkSize = 256
+
+// MaxPayloadSize is the maximum size of a payload split into chunks, the
+// default maximum size of a transaction.
+const MaxPayloadSize = 1 << 20
+
+// MaxChunks is the maximum number of chunks of a payload, the number of chunks
+// of a payload of MaxPayloadSize bytes split with DefaultChunkSize. It bounds
+// the memory allocated by Join for untrusted frames.
+const MaxChunks = MaxPayloadSize / DefaultChunkSize
+
+// Split splits a payload into chunks of at most size bytes of data, and
+// returns their frames: the base64url encoded binary encoding of the chunks.
+func Split(payload []byte, size int) ([]string, error) {
+	if size <= 0 {
+		return nil, fmt.Errorf("invalid chunk size %d", size)
+	}
+	if len(payload) == 0 {
+		return nil, errors.New("empty payload")
+	}
+
+	if len(payload) > MaxPayloadSize {
+		return nil, fmt.Errorf("payload of %d bytes exceeds the max payload size %d", len(payload), MaxPayloadSize)
+	}
+
+	total := (len(payload) + size - 1) / size
+	if total > MaxChunks {
+		return nil, fmt.Errorf("payload of %d bytes has more than %d chunks of %d bytes", len(payload), MaxChunks, size)
+	}
+
+	checksum := sha256.Sum256(payload)
+	frames := make([]string, total)
+	for i := range frames {
+		end := min((i+1)*size, len(payload))
+		chunk := Chunk{
+			Checksum: checksum[:],
+			Index:    uint32(i),
+			Total:    uint32(total),
+			Data:     payload[i*size : end],
+		}
+
+		bz, err := chunk.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		frames[i] = base64.RawURLEncoding.EncodeToString(bz)
+	}
+
+	return frames, nil
+}
+
+// Join joins the chunks of the given frames, as returned by Split, and returns
+// their payload. The frames may be in any order and contain duplicates, as
+// read from an animated QR code, but all must be of the same payload.
+func Join(frames []string) ([]byte, error) {
+	var (
+		checksum []byte
+		data     [][]byte
+	)
+	for _, frame := range frames {
+		frame = strings.TrimSpace(frame)
+		if frame == "" {
+			continue
+		}
+
+		bz, err := base64.RawURLEncoding.DecodeString(frame)
+		if err != nil {
+			return nil, fmt.Errorf("invalid frame: %w", err)
+		}
+		var chunk Chunk
+		if err := chunk.Unmarshal(bz); err != nil {
+			return nil, fmt.Errorf("invalid frame: %w", err)
+		}
+
+		if data == nil {
+			if chunk.Total == 0 {
+				return nil, errors.New("invalid frame: no chunks")
+			}
+			if chunk.Total > MaxChunks {
+				return nil, fmt.Errorf("invalid frame: %d chunks exceed the max number of chunks %d", chunk.Total, MaxChunks)
+			}
+			checksum = chunk.Checksum
+			data = make([][]byte, chunk.Total)
+		}
+		if !bytes.Equal(chunk.Checksum, checksum) || int(chunk.Total) != len(data) {
+			return nil, errors.New("frames of different payloads")
+		}
+		if chunk.Index >= chunk.Total {
+			return nil, fmt.Errorf("invalid frame: chunk %d of %d", chunk.Index, chunk.Total)
+		}
+		data[chunk.Index] = chunk.Data
+	}
+
+	if data == nil {
+		return nil, errors.New("no frames")
+	}
+	var missing []string
+	for i, d := range data {
+		if d == nil {
+			missing = append(missing, fmt.Sprint(i))
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("missing chunks %s of %d", strings.Join(missing, ", "), len(data))
+	}
+
+	payload := bytes.Join(data, nil)
+	if sum := sha256.Sum256(payload); !bytes.Equal(sum[:], checksum) {
+		return nil, errors.New("checksum mismatch")
+	}
+
+	return payload, nil
+}
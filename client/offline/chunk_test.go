@@ -0,0 +1,65 @@
+package offline_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client/offline"
+)
+
+func TestSplitJoin(t *testing.T) {
+	payload := bytes.Repeat([]byte("payload"), 100)
+
+	frames, err := offline.Split(payload, 64)
+	require.NoError(t, err)
+	require.Len(t, frames, 11)
+
+	// deterministic
+	again, err := offline.Split(payload, 64)
+	require.NoError(t, err)
+	require.Equal(t, frames, again)
+
+	joined, err := offline.Join(frames)
+	require.NoError(t, err)
+	require.Equal(t, payload, joined)
+
+	// frames read out of order and repeated from an animated QR code
+	shuffled := append([]string{frames[10], "", frames[3]}, frames...)
+	joined, err = offline.Join(shuffled)
+	require.NoError(t, err)
+	require.Equal(t, payload, joined)
+
+	_, err = offline.Join(append(frames[:4:4], frames[5:]...))
+	require.ErrorContains(t, err, "missing chunks 4 of 11")
+
+	otherFrames, err := offline.Split([]byte("other"), 64)
+	require.NoError(t, err)
+	_, err = offline.Join(append(otherFrames, frames...))
+	require.ErrorContains(t, err, "frames of different payloads")
+
+	_, err = offline.Join([]string{"not base64!"})
+	require.ErrorContains(t, err, "invalid frame")
+
+	_, err = offline.Join(nil)
+	require.ErrorContains(t, err, "no frames")
+
+	_, err = offline.Split(payload, 0)
+	require.ErrorContains(t, err, "invalid chunk size")
+
+	_, err = offline.Split(make([]byte, offline.MaxPayloadSize+1), offline.DefaultChunkSize)
+	require.ErrorContains(t, err, "exceeds the max payload size")
+
+	_, err = offline.Split(make([]byte, offline.MaxPayloadSize), offline.DefaultChunkSize-1)
+	require.ErrorContains(t, err, "chunks of 255 bytes")
+
+	// a crafted frame can't make Join allocate an unbounded number of chunks
+	crafted := offline.Chunk{Checksum: make([]byte, 32), Total: math.MaxUint32, Data: []byte("data")}
+	bz, err := crafted.Marshal()
+	require.NoError(t, err)
+	_, err = offline.Join([]string{base64.RawURLEncoding.EncodeToString(bz)})
+	require.ErrorContains(t, err, "exceed the max number of chunks")
+}
@@ -0,0 +1,290 @@
+package offline
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/mdp/qrterminal/v3"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const (
+	flagChunkSize = "chunk-size"
+	flagQRCode    = "qrcode"
+)
+
+// Cmd returns the offline signing group command.
+func Cmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "offline",
+		Short: "Sign transactions on an air-gapped machine",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Sign transactions on an air-gapped machine, exchanging them as frames that can be
+displayed as animated QR codes.
+
+Generate an unsigned transaction and export it with the signer data of its signer:
+$ %[1]s tx bank send <from> <to> 10stake --generate-only --offline --account-number 1 --sequence 0 --chain-id test > tx.json
+$ %[1]s tx offline export tx.json --account-number 1 --sequence 0 --chain-id test > request.txt
+
+Sign it on the air-gapped machine:
+$ %[1]s tx offline sign request.txt --from <from> > signatures.txt
+
+Import the signatures and broadcast the signed transaction:
+$ %[1]s tx offline import request.txt signatures.txt > signed.json
+$ %[1]s tx broadcast signed.json
+`, version.AppName),
+		),
+		RunE: client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		ExportCmd(),
+		SignCmd(),
+		ImportCmd(),
+	)
+
+	return cmd
+}
+
+// ExportCmd returns the command exporting the sign request of an unsigned
+// transaction.
+func ExportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export [file]",
+		Short: "Export the sign request of an unsigned transaction as frames",
+		Long: `Export the unsigned transaction of [file], or of STDIN if [file] is "-", with the
+signer data of its signer set with the --chain-id, --account-number and --sequence flags, as
+the frames of a sign request, one per line. No node is queried: the signer data must be set
+explicitly.
+
+The sign request is deterministically encoded, so that the same transaction and signer data
+always result in the same frames.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			txFactory, err := tx.NewFactoryCLI(clientCtx.WithOffline(true), cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			bz, err := readInput(cmd, args[0])
+			if err != nil {
+				return err
+			}
+			unsignedTx, err := clientCtx.TxConfig.TxJSONDecoder()(bz)
+			if err != nil {
+				return err
+			}
+
+			req, err := NewSignRequest(clientCtx.TxConfig, unsignedTx, txFactory.ChainID(), txFactory.AccountNumber(), txFactory.Sequence(), txFactory.SignMode())
+			if err != nil {
+				return err
+			}
+			payload, err := req.Marshal()
+			if err != nil {
+				return err
+			}
+
+			return printFrames(cmd, payload)
+		},
+	}
+
+	addFrameFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// SignCmd returns the command signing the transaction of a sign request.
+func SignCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign [frames-file]",
+		Short: "Sign the transaction of a sign request and export the signatures as frames",
+		Long: `Sign the transaction of the sign request of the frames of [frames-file], or of STDIN
+if [frames-file] is "-", with the key --from and the signer data of the request, and output the
+signatures as frames, one per line. The frames may be in any order and contain duplicates.
+
+The transaction is shown for confirmation before signing unless the --yes flag is set, which is
+required when the frames are read from STDIN.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			if clientCtx.FromName == "" {
+				return errors.New("set the signing key with the --from flag")
+			}
+
+			req, err := readSignRequest(cmd, args[0])
+			if err != nil {
+				return err
+			}
+
+			if !clientCtx.SkipConfirm {
+				txBuilder, err := req.Tx(clientCtx.TxConfig)
+				if err != nil {
+					return err
+				}
+				txJSON, err := clientCtx.TxConfig.TxJSONEncoder()(txBuilder.GetTx())
+				if err != nil {
+					return err
+				}
+
+				cmd.PrintErrf("%s\n\nchain-id: %s, account-number: %d, sequence: %d, sign-mode: %s\n\n",
+					txJSON, req.ChainId, req.AccountNumber, req.Sequence, req.SignMode)
+				ok, err := input.GetConfirmation("confirm transaction before signing", bufio.NewReader(cmd.InOrStdin()), cmd.ErrOrStderr())
+				if err != nil {
+					return err
+				}
+				if !ok {
+					return errors.New("transaction not signed")
+				}
+			}
+
+			sigs, err := req.Sign(cmd.Context(), clientCtx.TxConfig, clientCtx.Keyring, clientCtx.FromName)
+			if err != nil {
+				return err
+			}
+			payload, err := MarshalSignatures(clientCtx.Codec, sigs)
+			if err != nil {
+				return err
+			}
+
+			return printFrames(cmd, payload)
+		},
+	}
+
+	addFrameFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// ImportCmd returns the command importing the signatures of the transaction of
+// a sign request.
+func ImportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import [request-frames-file] [signature-frames-file]",
+		Short: "Import the signatures of the transaction of a sign request",
+		Long: `Import the signatures of the frames of [signature-frames-file], as output by the sign
+command, into the transaction of the sign request of the frames of [request-frames-file], after
+verifying them, and output the signed transaction, ready to be broadcast with the broadcast
+command.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req, err := readSignRequest(cmd, args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := readInput(cmd, args[1])
+			if err != nil {
+				return err
+			}
+			payload, err := Join(strings.Split(string(bz), "\n"))
+			if err != nil {
+				return err
+			}
+			sigs, err := UnmarshalSignatures(clientCtx.Codec, payload)
+			if err != nil {
+				return err
+			}
+
+			signedTx, err := req.ImportSignatures(cmd.Context(), clientCtx.TxConfig, sigs)
+			if err != nil {
+				return err
+			}
+			txJSON, err := clientCtx.TxConfig.TxJSONEncoder()(signedTx)
+			if err != nil {
+				return err
+			}
+
+			outputDoc, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
+			if outputDoc != "" {
+				return os.WriteFile(outputDoc, append(txJSON, '\n'), 0o644)
+			}
+
+			cmd.Printf("%s\n", txJSON)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The document is written to the given file instead of STDOUT")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func addFrameFlags(cmd *cobra.Command) {
+	cmd.Flags().Int(flagChunkSize, DefaultChunkSize, "The maximum size in bytes of the data of a frame")
+	cmd.Flags().Bool(flagQRCode, false, "Display the frames as QR codes")
+}
+
+// readInput reads the file name, or STDIN if name is "-".
+func readInput(cmd *cobra.Command, name string) ([]byte, error) {
+	if name == "-" {
+		return io.ReadAll(cmd.InOrStdin())
+	}
+
+	return os.ReadFile(name)
+}
+
+func readSignRequest(cmd *cobra.Command, name string) (*SignRequest, error) {
+	bz, err := readInput(cmd, name)
+	if err != nil {
+		return nil, err
+	}
+	payload, err := Join(strings.Split(string(bz), "\n"))
+	if err != nil {
+		return nil, err
+	}
+
+	var req SignRequest
+	if err := req.Unmarshal(payload); err != nil {
+		return nil, fmt.Errorf("invalid sign request: %w", err)
+	}
+
+	return &req, nil
+}
+
+// printFrames splits the payload into frames and prints them, one per line or
+// as QR codes.
+func printFrames(cmd *cobra.Command, payload []byte) error {
+	size, _ := cmd.Flags().GetInt(flagChunkSize)
+	frames, err := Split(payload, size)
+	if err != nil {
+		return err
+	}
+
+	qrCode, _ := cmd.Flags().GetBool(flagQRCode)
+	for i, frame := range frames {
+		if qrCode {
+			cmd.Printf("frame %d of %d:\n", i+1, len(frames))
+			qrterminal.GenerateHalfBlock(frame, qrterminal.M, cmd.OutOrStdout())
+			continue
+		}
+
+		cmd.Println(frame)
+	}
+
+	return nil
+}
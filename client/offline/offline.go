@@ -0,0 +1,183 @@
+// Package offline supports signing transactions without any network access,
+// e.g. on an air-gapped machine.
+//
+// An unsigned transaction is exported with the signer data of its signer as a
+// SignRequest, whose deterministic binary encoding is split into chunks small
+// enough to be encoded in the frames of an animated QR code. The signer joins
+// the chunks, signs the transaction and exports its signatures the same way,
+// which are then imported into the transaction to broadcast.
+package offline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/types/known/anypb"
+
+	authsigning "cosmossdk.io/x/auth/signing"
+	txsigning "cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// NewSignRequest returns the SignRequest of an unsigned transaction, for a
+// signer with the given signer data. The default sign mode of txConfig is used
+// if signMode is unspecified.
+func NewSignRequest(txConfig client.TxConfig, unsignedTx sdk.Tx, chainID string, accountNumber, sequence uint64, signMode signing.SignMode) (*SignRequest, error) {
+	if chainID == "" {
+		return nil, errors.New("chain ID required but not specified")
+	}
+	if signMode == signing.SignMode_SIGN_MODE_UNSPECIFIED {
+		// the sign mode is explicit so that the signer doesn't depend on its
+		// own default
+		var err error
+		signMode, err = authsigning.APISignModeToInternal(txConfig.SignModeHandler().DefaultMode())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	txBytes, err := txConfig.TxEncoder()(unsignedTx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SignRequest{
+		TxBytes:       txBytes,
+		ChainId:       chainID,
+		AccountNumber: accountNumber,
+		Sequence:      sequence,
+		SignMode:      signMode,
+	}, nil
+}
+
+// Tx decodes the unsigned transaction of the request.
+func (r *SignRequest) Tx(txConfig client.TxConfig) (client.TxBuilder, error) {
+	unsignedTx, err := txConfig.TxDecoder()(r.TxBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return txConfig.WrapTxBuilder(unsignedTx)
+}
+
+// Sign signs the transaction of the request with the key name of the keyring,
+// and returns its signatures.
+func (r *SignRequest) Sign(ctx context.Context, txConfig client.TxConfig, kr keyring.Keyring, name string) ([]signing.SignatureV2, error) {
+	txBuilder, err := r.Tx(txConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	txf := tx.Factory{}.
+		WithTxConfig(txConfig).
+		WithKeybase(kr).
+		WithChainID(r.ChainId).
+		WithAccountNumber(r.AccountNumber).
+		WithSequence(r.Sequence).
+		WithSignMode(r.SignMode)
+	if err := tx.Sign(ctx, txf, name, txBuilder, true); err != nil {
+		return nil, err
+	}
+
+	return txBuilder.GetTx().GetSignaturesV2()
+}
+
+// ImportSignatures verifies the signatures of the transaction of the request,
+// and returns the transaction with the signatures set.
+func (r *SignRequest) ImportSignatures(ctx context.Context, txConfig client.TxConfig, sigs []signing.SignatureV2) (sdk.Tx, error) {
+	txBuilder, err := r.Tx(txConfig)
+	if err != nil {
+		return nil, err
+	}
+	if err := txBuilder.SetSignatures(sigs...); err != nil {
+		return nil, err
+	}
+
+	signedTx := txBuilder.GetTx()
+	adaptableTx, ok := signedTx.(authsigning.V2AdaptableTx)
+	if !ok {
+		return nil, fmt.Errorf("expected Tx to be signing.V2AdaptableTx, got %T", signedTx)
+	}
+	txData := adaptableTx.GetSigningTxData()
+
+	for _, sig := range sigs {
+		if sig.Sequence != r.Sequence {
+			return nil, fmt.Errorf("signature of sequence %d, expected %d", sig.Sequence, r.Sequence)
+		}
+
+		anyPk, err := codectypes.NewAnyWithValue(sig.PubKey)
+		if err != nil {
+			return nil, err
+		}
+		signerData := txsigning.SignerData{
+			ChainID:       r.ChainId,
+			AccountNumber: r.AccountNumber,
+			Sequence:      r.Sequence,
+			Address:       sdk.AccAddress(sig.PubKey.Address()).String(),
+			PubKey: &anypb.Any{
+				TypeUrl: anyPk.TypeUrl,
+				Value:   anyPk.Value,
+			},
+		}
+
+		err = authsigning.VerifySignature(ctx, sig.PubKey, signerData, sig.Data, txConfig.SignModeHandler(), txData)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't verify signature for address %s: %w", sdk.AccAddress(sig.PubKey.Address()), err)
+		}
+	}
+
+	return signedTx, nil
+}
+
+// MarshalSignatures returns the deterministic binary encoding of signatures,
+// as cosmos.tx.signing.v1beta1.SignatureDescriptors.
+func MarshalSignatures(cdc codec.BinaryCodec, sigs []signing.SignatureV2) ([]byte, error) {
+	descs := make([]*signing.SignatureDescriptor, len(sigs))
+	for i, sig := range sigs {
+		anyPk, err := codectypes.NewAnyWithValue(sig.PubKey)
+		if err != nil {
+			return nil, err
+		}
+
+		descs[i] = &signing.SignatureDescriptor{
+			PublicKey: anyPk,
+			Data:      signing.SignatureDataToProto(sig.Data),
+			Sequence:  sig.Sequence,
+		}
+	}
+
+	return cdc.Marshal(&signing.SignatureDescriptors{Signatures: descs})
+}
+
+// UnmarshalSignatures decodes signatures encoded by MarshalSignatures.
+func UnmarshalSignatures(cdc codec.BinaryCodec, bz []byte) ([]signing.SignatureV2, error) {
+	var descs signing.SignatureDescriptors
+	if err := cdc.Unmarshal(bz, &descs); err != nil {
+		return nil, err
+	}
+
+	sigs := make([]signing.SignatureV2, len(descs.Signatures))
+	for i, desc := range descs.Signatures {
+		pubKey, ok := desc.PublicKey.GetCachedValue().(cryptotypes.PubKey)
+		if !ok {
+			return nil, fmt.Errorf("invalid public key of signature %d", i)
+		}
+
+		sigs[i] = signing.SignatureV2{
+			PubKey:   pubKey,
+			Data:     signing.SignatureDataFromProto(desc.Data),
+			Sequence: desc.Sequence,
+		}
+	}
+
+	return sigs, nil
+}
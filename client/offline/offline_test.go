@@ -0,0 +1,94 @@
+package offline_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	authsigning "cosmossdk.io/x/auth/signing"
+
+	"github.com/cosmos/cosmos-sdk/client/offline"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	_ "github.com/cosmos/cosmos-sdk/testutil/testdata/testpb"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+func TestSignRequest(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{})
+	testdata.RegisterInterfaces(encCfg.InterfaceRegistry)
+	ctx := context.Background()
+
+	kr := keyring.NewInMemory(encCfg.Codec)
+	k, _, err := kr.NewMnemonic("signer", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	addr, err := k.GetAddress()
+	require.NoError(t, err)
+
+	txBuilder := encCfg.TxConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	txBuilder.SetGasLimit(200000)
+
+	_, err = offline.NewSignRequest(encCfg.TxConfig, txBuilder.GetTx(), "", 1, 2, signing.SignMode_SIGN_MODE_DIRECT)
+	require.ErrorContains(t, err, "chain ID required")
+
+	for _, signMode := range []signing.SignMode{
+		signing.SignMode_SIGN_MODE_UNSPECIFIED,
+		signing.SignMode_SIGN_MODE_DIRECT,
+		signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+	} {
+		t.Run(signMode.String(), func(t *testing.T) {
+			req, err := offline.NewSignRequest(encCfg.TxConfig, txBuilder.GetTx(), "test-chain", 1, 2, signMode)
+			require.NoError(t, err)
+			require.NotEqual(t, signing.SignMode_SIGN_MODE_UNSPECIFIED, req.SignMode)
+
+			// the request goes through frames to the air-gapped signer
+			bz, err := req.Marshal()
+			require.NoError(t, err)
+			frames, err := offline.Split(bz, 64)
+			require.NoError(t, err)
+			bz, err = offline.Join(frames)
+			require.NoError(t, err)
+			var received offline.SignRequest
+			require.NoError(t, received.Unmarshal(bz))
+			require.Equal(t, *req, received)
+
+			sigs, err := received.Sign(ctx, encCfg.TxConfig, kr, "signer")
+			require.NoError(t, err)
+			require.Len(t, sigs, 1)
+
+			// and the signatures go back
+			bz, err = offline.MarshalSignatures(encCfg.Codec, sigs)
+			require.NoError(t, err)
+			frames, err = offline.Split(bz, 64)
+			require.NoError(t, err)
+			bz, err = offline.Join(frames)
+			require.NoError(t, err)
+			sigs, err = offline.UnmarshalSignatures(encCfg.Codec, bz)
+			require.NoError(t, err)
+
+			signedTx, err := req.ImportSignatures(ctx, encCfg.TxConfig, sigs)
+			require.NoError(t, err)
+			txSigs, err := signedTx.(authsigning.SigVerifiableTx).GetSignaturesV2()
+			require.NoError(t, err)
+			require.Len(t, txSigs, 1)
+			require.Equal(t, uint64(2), txSigs[0].Sequence)
+
+			// signatures of other signer data are rejected
+			other := *req
+			other.AccountNumber = 3
+			_, err = other.ImportSignatures(ctx, encCfg.TxConfig, sigs)
+			require.ErrorContains(t, err, "couldn't verify signature")
+			other = *req
+			other.Sequence = 3
+			_, err = other.ImportSignatures(ctx, encCfg.TxConfig, sigs)
+			require.ErrorContains(t, err, "signature of sequence 2, expected 3")
+		})
+	}
+}
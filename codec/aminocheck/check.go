@@ -0,0 +1,381 @@
+// Package aminocheck cross-checks the legacy amino JSON encoding of the
+// messages of an application, produced by the legacy amino codec, with the
+// amino JSON encoding produced from their protobuf definitions by the x/tx
+// aminojson encoder.
+//
+// Both encodings must match for SIGN_MODE_LEGACY_AMINO_JSON signatures, e.g.
+// made with Ledger devices, to be verified: any difference reported for a
+// message means that its signatures are rejected by one of the encodings.
+package aminocheck
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	cosmos_proto "github.com/cosmos/cosmos-proto"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"golang.org/x/exp/maps"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"cosmossdk.io/api/amino"
+	"cosmossdk.io/x/tx/signing/aminojson"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// maxAminoNameLen is the maximum length of the amino name of a message
+	// which can be signed with Ledger devices, see
+	// https://github.com/cosmos/cosmos-sdk/issues/10870.
+	maxAminoNameLen = 39
+
+	// maxDepth is the depth up to which the sample messages are populated.
+	maxDepth = 8
+
+	anyFullName protoreflect.FullName = "google.protobuf.Any"
+)
+
+// Issue is a difference between the legacy amino JSON encoding and the proto
+// amino JSON encoding of a message.
+type Issue struct {
+	// TypeURL is the type URL of the message.
+	TypeURL string
+	// Path is the path of the field in the amino JSON of the message, empty
+	// when the issue is about the whole message.
+	Path string
+	// Description describes the issue.
+	Description string
+}
+
+func (i Issue) String() string {
+	if i.Path == "" {
+		return fmt.Sprintf("%s: %s", i.TypeURL, i.Description)
+	}
+	return fmt.Sprintf("%s: %s: %s", i.TypeURL, i.Path, i.Description)
+}
+
+// Check checks the amino JSON encodings of all the messages registered in the
+// interface registry, and returns the issues found, by type URL.
+func Check(registry codectypes.InterfaceRegistry, cdc *codec.LegacyAmino) ([]Issue, error) {
+	typeURLs := registry.ListImplementations(sdk.MsgInterfaceProtoName)
+	slices.Sort(typeURLs)
+
+	var issues []Issue
+	for _, typeURL := range typeURLs {
+		msgIssues, err := CheckMsg(registry, cdc, typeURL)
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, msgIssues...)
+	}
+
+	return issues, nil
+}
+
+// CheckMsg checks the amino JSON encodings of the message of the given type
+// URL, populated with sample values in all its fields.
+func CheckMsg(registry codectypes.InterfaceRegistry, cdc *codec.LegacyAmino, typeURL string) ([]Issue, error) {
+	name := protoreflect.FullName(strings.TrimPrefix(typeURL, "/"))
+	desc, err := registry.FindDescriptorByName(name)
+	if err != nil {
+		return nil, err
+	}
+	msgDesc, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a message", name)
+	}
+
+	var issues []Issue
+	report := func(path, format string, args ...any) {
+		issues = append(issues, Issue{TypeURL: typeURL, Path: path, Description: fmt.Sprintf(format, args...)})
+	}
+
+	aminoName, _ := proto.GetExtension(msgDesc.Options(), amino.E_Name).(string)
+	switch {
+	case aminoName == "":
+		report("", "missing amino.name option")
+	case len(aminoName) > maxAminoNameLen:
+		report("", "amino name %s is longer than %d characters", aminoName, maxAminoNameLen)
+	}
+
+	msg := dynamicpb.NewMessage(msgDesc)
+	s := &sampler{registry: registry, unresolved: map[protoreflect.FullName]bool{}}
+	s.populate(msg, 0)
+	unresolved := maps.Keys(s.unresolved)
+	slices.Sort(unresolved)
+	for _, name := range unresolved {
+		report("", "message type %s cannot be resolved, its fields are not checked", name)
+	}
+
+	protoJSON, err := aminojson.NewEncoder(aminojson.EncoderOptions{FileResolver: registry}).Marshal(msg)
+	if err != nil {
+		report("", "proto amino JSON encoding failed: %v", err)
+		return issues, nil
+	}
+
+	legacyJSON, err := legacyAminoJSON(registry, cdc, typeURL, msg)
+	if err != nil {
+		report("", "legacy amino JSON encoding failed: %v", err)
+		return issues, nil
+	}
+
+	legacy, err := decodeJSON(legacyJSON)
+	if err != nil {
+		return nil, err
+	}
+	if value, ok := legacy.(map[string]any); !ok || value["type"] == nil {
+		// the fields cannot be compared with those of the proto amino JSON
+		report("", "not registered with the legacy amino codec")
+		return issues, nil
+	}
+
+	protoValue, err := decodeJSON(protoJSON)
+	if err != nil {
+		return nil, err
+	}
+	diff("", legacy, protoValue, report)
+
+	return issues, nil
+}
+
+// legacyAminoJSON returns the legacy amino JSON encoding of msg, converted to
+// its gogoproto type.
+func legacyAminoJSON(registry codectypes.InterfaceRegistry, cdc *codec.LegacyAmino, typeURL string, msg proto.Message) (bz []byte, err error) {
+	gogoMsg, err := registry.Resolve(typeURL)
+	if err != nil {
+		return nil, err
+	}
+	protoBz, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	if err := gogoproto.Unmarshal(protoBz, gogoMsg); err != nil {
+		return nil, err
+	}
+	if err := codectypes.UnpackInterfaces(gogoMsg, registry); err != nil {
+		return nil, err
+	}
+
+	// amino panics on the types it cannot encode
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	return cdc.MarshalJSON(gogoMsg)
+}
+
+func decodeJSON(bz []byte) (any, error) {
+	dec := json.NewDecoder(bytes.NewReader(bz))
+	dec.UseNumber()
+	var value any
+	err := dec.Decode(&value)
+	return value, err
+}
+
+// diff reports the differences between the legacy and proto amino JSON values
+// at the given path.
+func diff(path string, legacy, proto any, report func(path, format string, args ...any)) {
+	switch legacyValue := legacy.(type) {
+	case map[string]any:
+		protoValue, ok := proto.(map[string]any)
+		if !ok {
+			break
+		}
+
+		keys := make([]string, 0, len(legacyValue)+len(protoValue))
+		for key := range legacyValue {
+			keys = append(keys, key)
+		}
+		for key := range protoValue {
+			if _, ok := legacyValue[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+		slices.Sort(keys)
+
+		for _, key := range keys {
+			fieldPath := key
+			if path != "" {
+				fieldPath = path + "." + key
+			}
+
+			l, inLegacy := legacyValue[key]
+			p, inProto := protoValue[key]
+			switch {
+			case !inProto:
+				report(fieldPath, "only in the legacy amino JSON: %s", encodeJSON(l))
+			case !inLegacy:
+				report(fieldPath, "only in the proto amino JSON: %s", encodeJSON(p))
+			default:
+				diff(fieldPath, l, p, report)
+			}
+		}
+		return
+
+	case []any:
+		protoValue, ok := proto.([]any)
+		if !ok || len(legacyValue) != len(protoValue) {
+			break
+		}
+
+		for i := range legacyValue {
+			diff(fmt.Sprintf("%s[%d]", path, i), legacyValue[i], protoValue[i], report)
+		}
+		return
+	}
+
+	if !reflect.DeepEqual(legacy, proto) {
+		report(path, "legacy amino JSON %s, proto amino JSON %s", encodeJSON(legacy), encodeJSON(proto))
+	}
+}
+
+func encodeJSON(value any) string {
+	bz, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(bz)
+}
+
+// sampler populates sample messages.
+type sampler struct {
+	registry codectypes.InterfaceRegistry
+	// unresolved is the set of the message types which could not be
+	// resolved, and were not populated.
+	unresolved map[protoreflect.FullName]bool
+}
+
+// populate sets all the fields of msg to non-default sample values, setting
+// only the first field of each oneof. The Anys are set to the first
+// implementation of the interface they accept.
+func (s *sampler) populate(msg protoreflect.Message, depth int) {
+	fields := msg.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		if oneof := field.ContainingOneof(); oneof != nil && !oneof.IsSynthetic() && oneof.Fields().Get(0) != field {
+			continue
+		}
+		valueDesc := field.Message()
+		if field.IsMap() {
+			valueDesc = field.MapValue().Message()
+		}
+		if valueDesc != nil && !s.canPopulate(valueDesc, depth) {
+			continue
+		}
+
+		switch {
+		case field.IsMap():
+			value := msg.NewField(field).Map().NewValue()
+			if field.MapValue().Message() != nil && !s.populateField(field.MapValue(), value.Message(), depth+1) {
+				continue
+			} else if field.MapValue().Message() == nil {
+				value = sampleValue(field.MapValue())
+			}
+			msg.Mutable(field).Map().Set(sampleValue(field.MapKey()).MapKey(), value)
+		case field.IsList():
+			value := msg.NewField(field).List().NewElement()
+			if field.Message() != nil && !s.populateField(field, value.Message(), depth+1) {
+				continue
+			} else if field.Message() == nil {
+				value = sampleValue(field)
+			}
+			msg.Mutable(field).List().Append(value)
+		case field.Message() != nil:
+			value := msg.NewField(field)
+			if s.populateField(field, value.Message(), depth+1) {
+				msg.Set(field, value)
+			}
+		default:
+			msg.Set(field, sampleValue(field))
+		}
+	}
+}
+
+func (s *sampler) canPopulate(desc protoreflect.MessageDescriptor, depth int) bool {
+	if desc.IsPlaceholder() {
+		s.unresolved[desc.FullName()] = true
+		return false
+	}
+	return depth < maxDepth
+}
+
+// populateField populates the message value of field, and returns false if
+// it is an Any for which no implementation can be populated.
+func (s *sampler) populateField(field protoreflect.FieldDescriptor, msg protoreflect.Message, depth int) bool {
+	if msg.Descriptor().FullName() != anyFullName {
+		s.populate(msg, depth)
+		return true
+	}
+
+	iface, _ := proto.GetExtension(field.Options(), cosmos_proto.E_AcceptsInterface).(string)
+	typeURLs := s.registry.ListImplementations(iface)
+	slices.Sort(typeURLs)
+	for _, typeURL := range typeURLs {
+		desc, err := s.registry.FindDescriptorByName(protoreflect.FullName(strings.TrimPrefix(typeURL, "/")))
+		if err != nil {
+			continue
+		}
+		msgDesc, ok := desc.(protoreflect.MessageDescriptor)
+		if !ok || !s.canPopulate(msgDesc, depth) {
+			continue
+		}
+
+		value := dynamicpb.NewMessage(msgDesc)
+		s.populate(value, depth)
+		bz, err := proto.MarshalOptions{Deterministic: true}.Marshal(value)
+		if err != nil {
+			continue
+		}
+		msg.Set(msg.Descriptor().Fields().ByName("type_url"), protoreflect.ValueOfString(typeURL))
+		msg.Set(msg.Descriptor().Fields().ByName("value"), protoreflect.ValueOfBytes(bz))
+		return true
+	}
+
+	return false
+}
+
+// sampleValue returns a non-default sample value of a scalar field. The
+// strings and bytes are numbers, to be valid values of the fields encoding
+// decimals and integers.
+func sampleValue(field protoreflect.FieldDescriptor) protoreflect.Value {
+	switch field.Kind() {
+	case protoreflect.BoolKind:
+		return protoreflect.ValueOfBool(true)
+	case protoreflect.EnumKind:
+		values := field.Enum().Values()
+		for i := 0; i < values.Len(); i++ {
+			if values.Get(i).Number() != 0 {
+				return protoreflect.ValueOfEnum(values.Get(i).Number())
+			}
+		}
+		return protoreflect.ValueOfEnum(0)
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return protoreflect.ValueOfInt32(1)
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return protoreflect.ValueOfUint32(1)
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return protoreflect.ValueOfInt64(1)
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return protoreflect.ValueOfUint64(1)
+	case protoreflect.FloatKind:
+		return protoreflect.ValueOfFloat32(1)
+	case protoreflect.DoubleKind:
+		return protoreflect.ValueOfFloat64(1)
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString("1")
+	case protoreflect.BytesKind:
+		return protoreflect.ValueOfBytes([]byte("1"))
+	default:
+		panic(fmt.Sprintf("unexpected kind %s", field.Kind()))
+	}
+}
@@ -0,0 +1,56 @@
+package aminocheck_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/aminocheck"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	crisistypes "github.com/cosmos/cosmos-sdk/x/crisis/types"
+)
+
+func TestCheck(t *testing.T) {
+	registry := codectestutil.CodecOptions{}.NewInterfaceRegistry()
+	sdk.RegisterInterfaces(registry)
+	crisistypes.RegisterInterfaces(registry)
+	require.Len(t, registry.ListImplementations(sdk.MsgInterfaceProtoName), 2)
+	cdc := codec.NewLegacyAmino()
+	crisistypes.RegisterLegacyAminoCodec(cdc)
+
+	issues, err := aminocheck.Check(registry, cdc)
+	require.NoError(t, err)
+	require.Empty(t, issues)
+
+	// the messages not registered with amino
+	testdata.RegisterInterfaces(registry)
+	issues, err = aminocheck.CheckMsg(registry, cdc, "/testpb.MsgCreateDog")
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"/testpb.MsgCreateDog: missing amino.name option",
+		"/testpb.MsgCreateDog: not registered with the legacy amino codec",
+	}, issueStrings(issues))
+
+	// the amino name of a message differs from its legacy amino name
+	cdc = codec.NewLegacyAmino()
+	cdc.RegisterConcrete(&crisistypes.MsgVerifyInvariant{}, "crisis/MsgVerifyInvariant", nil)
+	issues, err = aminocheck.CheckMsg(registry, cdc, "/cosmos.crisis.v1beta1.MsgVerifyInvariant")
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		`/cosmos.crisis.v1beta1.MsgVerifyInvariant: type: legacy amino JSON "crisis/MsgVerifyInvariant", proto amino JSON "cosmos-sdk/MsgVerifyInvariant"`,
+	}, issueStrings(issues))
+
+	_, err = aminocheck.CheckMsg(registry, cdc, "/cosmos.crisis.v1beta1.Unknown")
+	require.Error(t, err)
+}
+
+func issueStrings(issues []aminocheck.Issue) []string {
+	res := make([]string, len(issues))
+	for i, issue := range issues {
+		res[i] = issue.String()
+	}
+	return res
+}
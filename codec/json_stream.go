@@ -0,0 +1,125 @@
+package codec
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// DecodeJSONObject decodes the JSON object read by dec one field at a time,
+// without reading the whole object in memory: fn is called with the name of
+// each field, and must read its value from dec, e.g. with dec.Decode or with
+// DecodeJSONObject for a nested object. A null value is decoded as an empty
+// object.
+func DecodeJSONObject(dec *json.Decoder, fn func(field string, dec *json.Decoder) error) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok == nil {
+		return nil
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '{' {
+		return fmt.Errorf("expected a JSON object, got %v", tok)
+	}
+
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		field, ok := tok.(string)
+		if !ok {
+			return fmt.Errorf("expected a JSON object field, got %v", tok)
+		}
+
+		if err := fn(field, dec); err != nil {
+			return err
+		}
+	}
+
+	// the closing brace
+	_, err = dec.Token()
+	return err
+}
+
+// JSONObjectEncoder writes a JSON object one field at a time, without holding
+// the whole object in memory, indented as json.MarshalIndent does with an
+// empty prefix.
+type JSONObjectEncoder struct {
+	w      io.Writer
+	prefix string
+	indent string
+	fields int
+	err    error
+}
+
+// NewJSONObjectEncoder returns a JSONObjectEncoder writing to w an object
+// indented with indent. Close must be called once all the fields are written.
+func NewJSONObjectEncoder(w io.Writer, indent string) *JSONObjectEncoder {
+	e := &JSONObjectEncoder{w: w, indent: indent}
+	e.write([]byte("{"))
+	return e
+}
+
+// WriteField writes a field of the object with the given JSON value.
+func (e *JSONObjectEncoder) WriteField(name string, value json.RawMessage) error {
+	e.writeName(name)
+	if e.err != nil {
+		return e.err
+	}
+
+	// compact, escape and indent the value as json.MarshalIndent does
+	var compact, escaped, indented bytes.Buffer
+	if e.err = json.Compact(&compact, value); e.err != nil {
+		return e.err
+	}
+	json.HTMLEscape(&escaped, compact.Bytes())
+	if e.err = json.Indent(&indented, escaped.Bytes(), e.prefix+e.indent, e.indent); e.err != nil {
+		return e.err
+	}
+	e.write(indented.Bytes())
+
+	return e.err
+}
+
+// Object starts a field of the object whose value is an object, written by
+// the returned encoder. The returned encoder must be closed before writing
+// the next field.
+func (e *JSONObjectEncoder) Object(name string) *JSONObjectEncoder {
+	e.writeName(name)
+	e.write([]byte("{"))
+	return &JSONObjectEncoder{w: e.w, prefix: e.prefix + e.indent, indent: e.indent, err: e.err}
+}
+
+// Close ends the object.
+func (e *JSONObjectEncoder) Close() error {
+	if e.fields > 0 {
+		e.write([]byte("\n" + e.prefix))
+	}
+	e.write([]byte("}"))
+	return e.err
+}
+
+func (e *JSONObjectEncoder) writeName(name string) {
+	if e.fields > 0 {
+		e.write([]byte(","))
+	}
+	e.fields++
+
+	quoted, err := json.Marshal(name)
+	if err != nil && e.err == nil {
+		e.err = err
+	}
+	e.write([]byte("\n" + e.prefix + e.indent))
+	e.write(quoted)
+	e.write([]byte(": "))
+}
+
+func (e *JSONObjectEncoder) write(b []byte) {
+	if e.err != nil {
+		return
+	}
+	_, e.err = e.w.Write(b)
+}
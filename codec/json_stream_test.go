@@ -0,0 +1,65 @@
+package codec_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+func TestJSONObjectStream(t *testing.T) {
+	value := map[string]any{
+		"a": map[string]any{"b": []int{1, 2}, "c": "<d>"},
+		"e": map[string]any{},
+		"f": nil,
+	}
+	expected, err := json.MarshalIndent(value, "", "  ")
+	require.NoError(t, err)
+
+	// the object is written as by json.MarshalIndent
+	var buf bytes.Buffer
+	enc := codec.NewJSONObjectEncoder(&buf, "  ")
+	a := enc.Object("a")
+	require.NoError(t, a.WriteField("b", json.RawMessage(`[1, 2]`)))
+	require.NoError(t, a.WriteField("c", json.RawMessage(`"<d>"`)))
+	require.NoError(t, a.Close())
+	require.NoError(t, enc.Object("e").Close())
+	require.NoError(t, enc.WriteField("f", json.RawMessage(`null`)))
+	require.NoError(t, enc.Close())
+	require.Equal(t, string(expected), buf.String())
+
+	require.Error(t, codec.NewJSONObjectEncoder(&bytes.Buffer{}, "  ").WriteField("a", json.RawMessage(`{`)))
+
+	// the fields are decoded one at a time
+	fields := map[string]string{}
+	err = codec.DecodeJSONObject(json.NewDecoder(&buf), func(field string, dec *json.Decoder) error {
+		if field != "a" {
+			var value json.RawMessage
+			err := dec.Decode(&value)
+			fields[field] = string(value)
+			return err
+		}
+
+		return codec.DecodeJSONObject(dec, func(field string, dec *json.Decoder) error {
+			var value json.RawMessage
+			err := dec.Decode(&value)
+			fields["a."+field] = string(value)
+			return err
+		})
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		"a.b": "[\n      1,\n      2\n    ]",
+		"a.c": `"\u003cd\u003e"`,
+		"e":   "{}",
+		"f":   "null",
+	}, fields)
+
+	noop := func(string, *json.Decoder) error { return nil }
+	require.NoError(t, codec.DecodeJSONObject(json.NewDecoder(strings.NewReader(`null`)), noop))
+	require.ErrorContains(t, codec.DecodeJSONObject(json.NewDecoder(strings.NewReader(`[]`)), noop), "expected a JSON object")
+}
@@ -0,0 +1,142 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+const (
+	anyFullName protoreflect.FullName = "google.protobuf.Any"
+
+	// maxAnyDepth is the maximum number of Anys nested in one another which
+	// are canonicalized.
+	maxAnyDepth = 32
+)
+
+// CanonicalizeAny returns the canonical form of any, without its cached
+// value: its type URL is "/" followed by the full name of the message type,
+// whatever the prefix of the original type URL, and its value is the
+// deterministic encoding of the message, with the Anys nested in the message
+// canonicalized as well. The message type must be registered with gogoproto
+// or protoregistry.
+//
+// Two Anys wrapping equal messages have the same canonical form, while their
+// values may differ, e.g. when they are encoded by different clients.
+func CanonicalizeAny(any *Any) (*Any, error) {
+	if any == nil {
+		return nil, nil
+	}
+
+	typeURL, value, err := canonicalizeAnyValue(any.TypeUrl, any.Value, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Any{TypeUrl: typeURL, Value: value}, nil
+}
+
+// AnyEqual reports whether a and b wrap equal messages, comparing their
+// canonical forms. If the type of a message is unknown or its value is
+// invalid, the type names and values of the Anys are compared as is.
+func AnyEqual(a, b *Any) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	ca, errA := CanonicalizeAny(a)
+	cb, errB := CanonicalizeAny(b)
+	if errA != nil || errB != nil {
+		return anyTypeName(a.TypeUrl) == anyTypeName(b.TypeUrl) && bytes.Equal(a.Value, b.Value)
+	}
+
+	return ca.TypeUrl == cb.TypeUrl && bytes.Equal(ca.Value, cb.Value)
+}
+
+// anyTypeName returns the full name of the message type of a type URL, i.e.
+// the part following its last slash.
+func anyTypeName(typeURL string) string {
+	return typeURL[strings.LastIndexByte(typeURL, '/')+1:]
+}
+
+// canonicalizeAnyValue returns the canonical type URL and value of an Any
+// nested in depth other Anys.
+func canonicalizeAnyValue(typeURL string, value []byte, depth int) (string, []byte, error) {
+	if depth > maxAnyDepth {
+		return "", nil, fmt.Errorf("max depth of nested Anys %d exceeded", maxAnyDepth)
+	}
+
+	name := anyTypeName(typeURL)
+	desc, err := gogoproto.HybridResolver.FindDescriptorByName(protoreflect.FullName(name))
+	if err != nil {
+		return "", nil, fmt.Errorf("unable to resolve type URL %s: %w", typeURL, err)
+	}
+	msgDesc, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return "", nil, fmt.Errorf("type URL %s is not a message", typeURL)
+	}
+
+	msg := dynamicpb.NewMessage(msgDesc)
+	if err := proto.Unmarshal(value, msg); err != nil {
+		return "", nil, fmt.Errorf("unable to unmarshal %s: %w", name, err)
+	}
+	if err := canonicalizeMessage(msg, depth); err != nil {
+		return "", nil, err
+	}
+
+	value, err = proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return "/" + name, value, nil
+}
+
+// canonicalizeMessage canonicalizes the Anys nested in msg, which is itself
+// nested in depth Anys.
+func canonicalizeMessage(msg protoreflect.Message, depth int) error {
+	desc := msg.Descriptor()
+	if desc.FullName() == anyFullName {
+		typeURLField, valueField := desc.Fields().ByNumber(1), desc.Fields().ByNumber(2)
+		typeURL, value, err := canonicalizeAnyValue(msg.Get(typeURLField).String(), msg.Get(valueField).Bytes(), depth+1)
+		if err != nil {
+			return err
+		}
+
+		msg.Set(typeURLField, protoreflect.ValueOfString(typeURL))
+		msg.Set(valueField, protoreflect.ValueOfBytes(value))
+		return nil
+	}
+
+	var err error
+	msg.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		switch {
+		case field.IsMap():
+			if field.MapValue().Message() == nil {
+				return true
+			}
+			value.Map().Range(func(_ protoreflect.MapKey, value protoreflect.Value) bool {
+				err = canonicalizeMessage(value.Message(), depth)
+				return err == nil
+			})
+		case field.IsList():
+			if field.Message() == nil {
+				return true
+			}
+			list := value.List()
+			for i := 0; i < list.Len() && err == nil; i++ {
+				err = canonicalizeMessage(list.Get(i).Message(), depth)
+			}
+		case field.Message() != nil:
+			err = canonicalizeMessage(value.Message(), depth)
+		}
+		return err == nil
+	})
+
+	return err
+}
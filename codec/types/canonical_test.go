@@ -0,0 +1,93 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+)
+
+func TestCanonicalizeAny(t *testing.T) {
+	dog := &testdata.Dog{Size_: "big", Name: "rex"}
+	canonical, err := types.NewAnyWithValue(dog)
+	require.NoError(t, err)
+
+	// the fields are encoded in the reverse order, with a type URL prefix
+	reversed := &types.Any{
+		TypeUrl: "type.googleapis.com/testpb.Dog",
+		Value:   append([]byte{0x12, 3, 'r', 'e', 'x'}, 0x0a, 3, 'b', 'i', 'g'),
+	}
+	require.NotEqual(t, canonical.Value, reversed.Value)
+
+	res, err := types.CanonicalizeAny(reversed)
+	require.NoError(t, err)
+	require.Equal(t, &types.Any{TypeUrl: "/testpb.Dog", Value: canonical.Value}, res)
+	require.Nil(t, res.GetCachedValue())
+
+	// the nested Anys are canonicalized
+	hasAnimal, err := types.NewAnyWithValue(&testdata.HasAnimal{Animal: reversed})
+	require.NoError(t, err)
+	res, err = types.CanonicalizeAny(hasAnimal)
+	require.NoError(t, err)
+	expected, err := types.NewAnyWithValue(&testdata.HasAnimal{Animal: canonical})
+	require.NoError(t, err)
+	require.Equal(t, expected.Value, res.Value)
+
+	res, err = types.CanonicalizeAny(nil)
+	require.NoError(t, err)
+	require.Nil(t, res)
+
+	_, err = types.CanonicalizeAny(&types.Any{TypeUrl: "/testpb.Unknown"})
+	require.ErrorContains(t, err, "unable to resolve type URL /testpb.Unknown")
+
+	_, err = types.CanonicalizeAny(&types.Any{TypeUrl: "/testpb.Dog", Value: []byte{0x0a, 3}})
+	require.ErrorContains(t, err, "unable to unmarshal testpb.Dog")
+}
+
+func TestAnyEqual(t *testing.T) {
+	dog, err := types.NewAnyWithValue(&testdata.Dog{Name: "rex"})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name  string
+		a, b  *types.Any
+		equal bool
+	}{
+		{"nil", nil, nil, true},
+		{"one nil", dog, nil, false},
+		{"same", dog, &types.Any{TypeUrl: dog.TypeUrl, Value: dog.Value}, true},
+		{
+			"type URL prefix",
+			dog, &types.Any{TypeUrl: "type.googleapis.com/testpb.Dog", Value: dog.Value},
+			true,
+		},
+		{
+			"empty field encoded",
+			dog, &types.Any{TypeUrl: "/testpb.Dog", Value: append([]byte{0x0a, 0}, dog.Value...)},
+			true,
+		},
+		{"different value", dog, &types.Any{TypeUrl: "/testpb.Dog"}, false},
+		{"different type", dog, &types.Any{TypeUrl: "/testpb.Cat", Value: dog.Value}, false},
+		{
+			"unknown type",
+			&types.Any{TypeUrl: "/testpb.Unknown", Value: []byte{1}},
+			&types.Any{TypeUrl: "type.googleapis.com/testpb.Unknown", Value: []byte{1}},
+			true,
+		},
+		{
+			"invalid value",
+			&types.Any{TypeUrl: "/testpb.Dog", Value: []byte{0x0a, 3}},
+			&types.Any{TypeUrl: "/testpb.Dog", Value: []byte{0x0a, 4}},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.equal, types.AnyEqual(tc.a, tc.b))
+			require.Equal(t, tc.equal, types.AnyEqual(tc.b, tc.a))
+		})
+	}
+}
@@ -37,4 +37,7 @@
 //		be unlocked and it should be used only for testing purposes.
 //	memory	Same instance as returned by NewInMemory. This backend uses a transient storage. Keys
 //		are discarded when the process terminates or the type instance is garbage collected.
+//	remote	This backend delegates the signing to a remote signer speaking the RemoteSigner gRPC
+//		protocol, which holds the private keys and may enforce a signing policy. The keys
+//		are the keys of the signer and cannot be managed from the keyring.
 package keyring
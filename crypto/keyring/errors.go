@@ -31,6 +31,15 @@ var (
 	ErrNotLedgerObj = errors.New("not a ledger object")
 	// ErrLedgerInvalidSignature is raised when ledger generates an invalid signature.
 	ErrLedgerInvalidSignature = errors.New("ledger generated an invalid signature. Perhaps you have multiple ledgers and need to try another one")
+	// ErrPKCS11 is raised when a PKCS#11 token can't be used
+	ErrPKCS11 = errors.New("failed to use the PKCS#11 token")
+	// ErrRemoteSigner is raised when the remote signer can't be used
+	ErrRemoteSigner = errors.New("failed to use the remote signer")
+	// ErrRemoteSignRefused is raised when the policy of the remote signer refuses to sign
+	ErrRemoteSignRefused = errors.New("the remote signer refused to sign")
+	// ErrRemoteKeyring is raised when trying to manage the keys of the remote backend,
+	// which are managed by the remote signer.
+	ErrRemoteKeyring = errors.New("the keys of the remote keyring backend are managed by the remote signer")
 	// ErrLegacyToRecord is raised when cannot be converted to a Record
 	ErrLegacyToRecord = errors.New("unable to convert LegacyInfo to Record")
 	// ErrUnknownLegacyType is raised when a LegacyInfo type is unknown.
@@ -13,6 +13,7 @@ import (
 
 	"github.com/99designs/keyring"
 	"github.com/cosmos/go-bip39"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
 	"golang.org/x/crypto/bcrypt"
 
 	errorsmod "cosmossdk.io/errors"
@@ -22,6 +23,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/ledger"
+	"github.com/cosmos/cosmos-sdk/crypto/pkcs11"
 	"github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -36,6 +38,7 @@ const (
 	BackendPass    = "pass"
 	BackendTest    = "test"
 	BackendMemory  = "memory"
+	BackendRemote  = "remote"
 )
 
 const (
@@ -56,7 +59,7 @@ var (
 
 // Keyring exposes operations over a backend supported by github.com/99designs/keyring.
 type Keyring interface {
-	// Backend get the backend type used in the keyring config: "file", "os", "kwallet", "pass", "test", "memory".
+	// Backend get the backend type used in the keyring config: "file", "os", "kwallet", "pass", "test", "memory", "remote".
 	Backend() string
 	// List all keys.
 	List() ([]*Record, error)
@@ -90,6 +93,11 @@ type Keyring interface {
 	// SaveLedgerKey retrieves a public key reference from a Ledger device and persists it.
 	SaveLedgerKey(uid string, algo SignatureAlgo, hrp string, coinType, account, index uint32) (*Record, error)
 
+	// SavePKCS11Key retrieves a public key reference to the key pair with the given label
+	// from the configured PKCS#11 token, generating the key pair first if generate is true,
+	// and persists it.
+	SavePKCS11Key(uid, label string, generate bool) (*Record, error)
+
 	// SaveOfflineKey stores a public key and returns the persisted Info structure.
 	SaveOfflineKey(uid string, pubkey types.PubKey) (*Record, error)
 
@@ -158,6 +166,10 @@ type Options struct {
 	// indicate whether Ledger should skip DER Conversion on signature,
 	// depending on which format (DER or BER) the Ledger app returns signatures
 	LedgerSigSkipDERConv bool
+	// PKCS#11 token holding the PKCS#11 keys
+	PKCS11 pkcs11.Config
+	// connection to the remote signer of the remote backend
+	RemoteSigner gogogrpc.ClientConn
 }
 
 // NewInMemory creates a transient keyring useful for testing
@@ -175,7 +187,7 @@ func NewInMemoryWithKeyring(kr keyring.Keyring, cdc codec.Codec, opts ...Option)
 
 // New creates a new instance of a keyring.
 // Keyring options can be applied when generating the new instance.
-// Available backends are "os", "file", "kwallet", "memory", "pass", "test".
+// Available backends are "os", "file", "kwallet", "memory", "pass", "remote", "test".
 func New(
 	appName, backend, rootDir string, userInput io.Reader, cdc codec.Codec, opts ...Option,
 ) (Keyring, error) {
@@ -187,6 +199,8 @@ func New(
 	switch backend {
 	case BackendMemory:
 		return NewInMemory(cdc, opts...), err
+	case BackendRemote:
+		return newRemoteKeyring(cdc, opts...)
 	case BackendTest:
 		db, err = keyring.Open(newTestBackendKeyringConfig(appName, rootDir))
 	case BackendFile:
@@ -407,6 +421,9 @@ func (ks keystore) Sign(uid string, msg []byte, signMode signing.SignMode) ([]by
 	case k.GetLedger() != nil:
 		return SignWithLedger(k, msg, signMode)
 
+	case k.GetPkcs11() != nil:
+		return ks.signWithPKCS11(k, msg)
+
 		// multi or offline record
 	default:
 		pub, err := k.GetPubKey()
@@ -450,6 +467,39 @@ func (ks keystore) writeLedgerKey(name string, pk types.PubKey, path *hd.BIP44Pa
 	return k, ks.writeRecord(k)
 }
 
+func (ks keystore) SavePKCS11Key(uid, label string, generate bool) (*Record, error) {
+	token, err := pkcs11.Open(ks.options.PKCS11)
+	if err != nil {
+		return nil, errorsmod.Wrap(ErrPKCS11, err.Error())
+	}
+	defer token.Close()
+
+	var pk types.PubKey
+	if generate {
+		// do not leave an unused key pair in the token
+		if _, err := ks.Key(uid); err == nil {
+			return nil, errorsmod.Wrap(ErrKeyAlreadyExists, uid)
+		}
+		pk, err = token.GenerateKey(label)
+	} else {
+		pk, err = token.PubKey(label)
+	}
+	if err != nil {
+		return nil, errorsmod.Wrap(ErrPKCS11, err.Error())
+	}
+
+	return ks.writePKCS11Key(uid, pk, label)
+}
+
+func (ks keystore) writePKCS11Key(name string, pk types.PubKey, label string) (*Record, error) {
+	k, err := NewPKCS11Record(name, pk, label)
+	if err != nil {
+		return nil, err
+	}
+
+	return k, ks.writeRecord(k)
+}
+
 func (ks keystore) SaveMultisig(uid string, pubkey types.PubKey) (*Record, error) {
 	return ks.writeMultisigKey(uid, pubkey)
 }
@@ -1052,3 +1102,35 @@ func (ks keystore) convertFromLegacyInfo(info LegacyInfo) (*Record, error) {
 func addrHexKeyAsString(address []byte) string {
 	return fmt.Sprintf("%s.%s", hex.EncodeToString(address), addressSuffix)
 }
+
+// signWithPKCS11 signs a binary message with the key pair of the PKCS#11 token referenced by
+// a record, and returns the signed bytes and the public key. It returns an error if the token
+// could not be used or its key pair does not match the record.
+func (ks keystore) signWithPKCS11(k *Record, msg []byte) (sig []byte, pub types.PubKey, err error) {
+	pubKey, err := k.GetPubKey()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	token, err := pkcs11.Open(ks.options.PKCS11)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(ErrPKCS11, err.Error())
+	}
+	defer token.Close()
+
+	label := k.GetPkcs11().Label
+	tokenPubKey, err := token.PubKey(label)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(ErrPKCS11, err.Error())
+	}
+	if !pubKey.Equals(tokenPubKey) {
+		return nil, nil, fmt.Errorf("the public key of the PKCS#11 key pair %s does not match the public key of the key %s", label, k.Name)
+	}
+
+	sig, err = token.Sign(label, msg)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(ErrPKCS11, err.Error())
+	}
+
+	return sig, pubKey, nil
+}
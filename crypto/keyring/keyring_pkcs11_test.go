@@ -0,0 +1,100 @@
+package keyring
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/pkcs11"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// mockToken is a PKCS#11 token holding its keys in memory.
+type mockToken struct {
+	keys map[string]*secp256k1.PrivKey
+}
+
+func (t mockToken) GenerateKey(label string) (*secp256k1.PubKey, error) {
+	if _, ok := t.keys[label]; ok {
+		return nil, fmt.Errorf("a PKCS#11 key pair labeled %s already exists", label)
+	}
+	t.keys[label] = secp256k1.GenPrivKey()
+	return t.PubKey(label)
+}
+
+func (t mockToken) PubKey(label string) (*secp256k1.PubKey, error) {
+	priv, ok := t.keys[label]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", pkcs11.ErrKeyNotFound, label)
+	}
+	return priv.PubKey().(*secp256k1.PubKey), nil
+}
+
+func (t mockToken) Sign(label string, msg []byte) ([]byte, error) {
+	priv, ok := t.keys[label]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", pkcs11.ErrKeyNotFound, label)
+	}
+	return priv.Sign(msg)
+}
+
+func (mockToken) Close() error { return nil }
+
+func TestPKCS11Key(t *testing.T) {
+	token := mockToken{keys: map[string]*secp256k1.PrivKey{}}
+	cfg := pkcs11.Config{Module: "module.so", Slot: 1, PIN: "1234"}
+	openToken := pkcs11.SetOpenToken(func(c pkcs11.Config) (pkcs11.Token, error) {
+		require.Equal(t, cfg, c)
+		return token, nil
+	})
+	t.Cleanup(func() { pkcs11.SetOpenToken(openToken) })
+
+	kb := NewInMemory(getCodec(), func(options *Options) {
+		options.PKCS11 = cfg
+	})
+
+	// the key pair is generated in the token
+	k, err := kb.SavePKCS11Key("operator", "validator", true)
+	require.NoError(t, err)
+	require.Equal(t, TypePKCS11, k.GetType())
+	require.Equal(t, "validator", k.GetPkcs11().Label)
+	pub, err := k.GetPubKey()
+	require.NoError(t, err)
+	require.Equal(t, token.keys["validator"].PubKey(), pub)
+
+	// the private key is never stored
+	_, err = kb.ExportPrivKeyArmor("operator", "passphrase")
+	require.Error(t, err)
+
+	msg := []byte("message")
+	sig, signPub, err := kb.Sign("operator", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.Equal(t, pub, signPub)
+	require.True(t, pub.VerifySignature(msg, sig))
+
+	// an existing key pair is referenced
+	k, err = kb.SavePKCS11Key("copy", "validator", false)
+	require.NoError(t, err)
+	copyPub, err := k.GetPubKey()
+	require.NoError(t, err)
+	require.Equal(t, pub, copyPub)
+	require.NoError(t, kb.Delete("copy"))
+
+	_, err = kb.SavePKCS11Key("other", "missing", false)
+	require.ErrorIs(t, err, ErrPKCS11)
+	_, err = kb.SavePKCS11Key("operator", "other", true)
+	require.ErrorIs(t, err, ErrKeyAlreadyExists)
+	require.NotContains(t, token.keys, "other")
+
+	// the key pair of the token must match the record
+	token.keys["validator"] = secp256k1.GenPrivKey()
+	_, _, err = kb.Sign("operator", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.ErrorContains(t, err, "does not match")
+
+	// without a configured token
+	kb = NewInMemory(getCodec())
+	_, err = kb.SavePKCS11Key("operator", "validator", false)
+	require.ErrorIs(t, err, ErrPKCS11)
+}
@@ -53,6 +53,13 @@ func (rl *Record_Ledger) GetPath() *hd.BIP44Params {
 	return rl.Path
 }
 
+// NewPKCS11Record creates a new Record with PKCS#11 item
+func NewPKCS11Record(name string, pk cryptotypes.PubKey, label string) (*Record, error) {
+	recordPKCS11 := &Record_Pkcs11{label}
+	recordPKCS11Item := &Record_Pkcs11_{recordPKCS11}
+	return newRecord(name, pk, recordPKCS11Item)
+}
+
 // NewOfflineRecord creates a new Record with offline item
 func NewOfflineRecord(name string, pk cryptotypes.PubKey) (*Record, error) {
 	recordOffline := &Record_Offline{}
@@ -97,6 +104,8 @@ func (k Record) GetType() KeyType {
 		return TypeMulti
 	case k.GetOffline() != nil:
 		return TypeOffline
+	case k.GetPkcs11() != nil:
+		return TypePKCS11
 	default:
 		panic("unrecognized record type")
 	}
@@ -0,0 +1,247 @@
+package keyring
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+var _ Keyring = remoteKeyring{}
+
+// remoteKeyring is the keyring of the remote backend, which delegates the
+// signing to a remote signer holding the private keys. Its keys are the keys
+// of the signer, hence it cannot create, import, export or delete keys.
+type remoteKeyring struct {
+	client  RemoteSignerClient
+	cdc     codec.Codec
+	options Options
+}
+
+func newRemoteKeyring(cdc codec.Codec, opts ...Option) (Keyring, error) {
+	options := Options{
+		SupportedAlgos:       SigningAlgoList{hd.Secp256k1},
+		SupportedAlgosLedger: SigningAlgoList{hd.Secp256k1},
+	}
+
+	for _, optionFn := range opts {
+		optionFn(&options)
+	}
+
+	if options.RemoteSigner == nil {
+		return nil, errorsmod.Wrap(ErrRemoteSigner, "no remote signer is configured")
+	}
+
+	return remoteKeyring{
+		client:  NewRemoteSignerClient(options.RemoteSigner),
+		cdc:     cdc,
+		options: options,
+	}, nil
+}
+
+// Backend returns the keyring backend option used in the config
+func (rk remoteKeyring) Backend() string {
+	return BackendRemote
+}
+
+func (rk remoteKeyring) List() ([]*Record, error) {
+	res, err := rk.client.Keys(context.Background(), &KeysRequest{})
+	if err != nil {
+		return nil, errorsmod.Wrap(ErrRemoteSigner, err.Error())
+	}
+
+	records := make([]*Record, 0, len(res.Keys))
+	for _, key := range res.Keys {
+		var pk types.PubKey
+		if err := rk.cdc.UnpackAny(key.PubKey, &pk); err != nil {
+			return nil, errorsmod.Wrapf(ErrRemoteSigner, "invalid public key of %s: %s", key.Name, err)
+		}
+
+		// the private key is held by the signer
+		k, err := NewOfflineRecord(key.Name, pk)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, k)
+	}
+
+	return records, nil
+}
+
+func (rk remoteKeyring) MigrateAll() ([]*Record, error) {
+	return rk.List()
+}
+
+// SupportedAlgorithms returns the keystore Options' supported signing algorithm.
+// for the keyring and Ledger.
+func (rk remoteKeyring) SupportedAlgorithms() (SigningAlgoList, SigningAlgoList) {
+	return rk.options.SupportedAlgos, rk.options.SupportedAlgosLedger
+}
+
+func (rk remoteKeyring) Key(uid string) (*Record, error) {
+	records, err := rk.List()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, k := range records {
+		if k.Name == uid {
+			return k, nil
+		}
+	}
+
+	return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, uid)
+}
+
+func (rk remoteKeyring) KeyByAddress(address []byte) (*Record, error) {
+	records, err := rk.List()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, k := range records {
+		addr, err := k.GetAddress()
+		if err != nil {
+			return nil, err
+		}
+		if bytes.Equal(addr, address) {
+			return k, nil
+		}
+	}
+
+	return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "key with given address not found")
+}
+
+func (rk remoteKeyring) Sign(uid string, msg []byte, signMode signing.SignMode) ([]byte, types.PubKey, error) {
+	k, err := rk.Key(uid)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pub, err := k.GetPubKey()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	res, err := rk.client.Sign(context.Background(), &SignRequest{
+		Name:      uid,
+		SignBytes: msg,
+		SignMode:  signMode,
+	})
+	if err != nil {
+		if status.Code(err) == codes.PermissionDenied {
+			return nil, nil, errorsmod.Wrap(ErrRemoteSignRefused, status.Convert(err).Message())
+		}
+		return nil, nil, errorsmod.Wrap(ErrRemoteSigner, err.Error())
+	}
+
+	if !pub.VerifySignature(msg, res.Signature) {
+		return nil, nil, errorsmod.Wrap(ErrRemoteSigner, fmt.Sprintf("invalid signature for key %s", uid))
+	}
+
+	return res.Signature, pub, nil
+}
+
+func (rk remoteKeyring) SignByAddress(address, msg []byte, signMode signing.SignMode) ([]byte, types.PubKey, error) {
+	k, err := rk.KeyByAddress(address)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return rk.Sign(k.Name, msg, signMode)
+}
+
+func (rk remoteKeyring) ExportPubKeyArmor(uid string) (string, error) {
+	k, err := rk.Key(uid)
+	if err != nil {
+		return "", err
+	}
+
+	key, err := k.GetPubKey()
+	if err != nil {
+		return "", err
+	}
+
+	bz, err := rk.cdc.MarshalInterface(key)
+	if err != nil {
+		return "", err
+	}
+
+	return crypto.ArmorPubKeyBytes(bz, key.Type()), nil
+}
+
+func (rk remoteKeyring) ExportPubKeyArmorByAddress(address []byte) (string, error) {
+	k, err := rk.KeyByAddress(address)
+	if err != nil {
+		return "", err
+	}
+
+	return rk.ExportPubKeyArmor(k.Name)
+}
+
+func (remoteKeyring) ExportPrivKeyArmor(string, string) (string, error) {
+	return "", ErrRemoteKeyring
+}
+
+func (remoteKeyring) ExportPrivKeyArmorByAddress([]byte, string) (string, error) {
+	return "", ErrRemoteKeyring
+}
+
+func (remoteKeyring) ImportPrivKey(string, string, string) error {
+	return ErrRemoteKeyring
+}
+
+func (remoteKeyring) ImportPrivKeyHex(string, string, string) error {
+	return ErrRemoteKeyring
+}
+
+func (remoteKeyring) ImportPubKey(string, string) error {
+	return ErrRemoteKeyring
+}
+
+func (remoteKeyring) Delete(string) error {
+	return ErrRemoteKeyring
+}
+
+func (remoteKeyring) DeleteByAddress([]byte) error {
+	return ErrRemoteKeyring
+}
+
+func (remoteKeyring) Rename(string, string) error {
+	return ErrRemoteKeyring
+}
+
+func (remoteKeyring) NewMnemonic(string, Language, string, string, SignatureAlgo) (*Record, string, error) {
+	return nil, "", ErrRemoteKeyring
+}
+
+func (remoteKeyring) NewAccount(string, string, string, string, SignatureAlgo) (*Record, error) {
+	return nil, ErrRemoteKeyring
+}
+
+func (remoteKeyring) SaveLedgerKey(string, SignatureAlgo, string, uint32, uint32, uint32) (*Record, error) {
+	return nil, ErrRemoteKeyring
+}
+
+func (remoteKeyring) SavePKCS11Key(string, string, bool) (*Record, error) {
+	return nil, ErrRemoteKeyring
+}
+
+func (remoteKeyring) SaveOfflineKey(string, types.PubKey) (*Record, error) {
+	return nil, ErrRemoteKeyring
+}
+
+func (remoteKeyring) SaveMultisig(string, types.PubKey) (*Record, error) {
+	return nil, ErrRemoteKeyring
+}
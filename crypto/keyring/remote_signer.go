@@ -0,0 +1,213 @@
+package keyring
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// anyFullName is the full name of the messages packing other messages.
+const anyFullName = "google.protobuf.Any"
+
+// RemoteSignTx is the transaction of a sign request, decoded from the sign
+// bytes of the SIGN_MODE_DIRECT and SIGN_MODE_DIRECT_AUX sign modes.
+type RemoteSignTx struct {
+	ChainID       string
+	AccountNumber uint64
+	Body          *tx.TxBody
+	// AuthInfo is nil in SIGN_MODE_DIRECT_AUX, whose signers do not sign it.
+	AuthInfo *tx.AuthInfo
+}
+
+// RemoteSignPolicy decides whether a remote signer signs a request, by
+// returning an error to refuse it. The transaction of the request is nil when
+// its sign mode cannot be decoded.
+type RemoteSignPolicy func(ctx context.Context, req *SignRequest, tx *RemoteSignTx) error
+
+// AllowRemoteSignMsgs returns a RemoteSignPolicy only signing the decoded
+// transactions whose messages all have one of the given type URLs, including
+// the messages nested in them, e.g. in an authz MsgExec or in a proposal. The
+// messages whose type isn't registered can't be inspected, so they are refused.
+func AllowRemoteSignMsgs(typeURLs ...string) RemoteSignPolicy {
+	return func(_ context.Context, req *SignRequest, tx *RemoteSignTx) error {
+		if tx == nil {
+			return fmt.Errorf("the transactions of sign mode %s cannot be inspected", req.SignMode)
+		}
+
+		for _, msg := range tx.Body.Messages {
+			if err := checkAllowedAny(typeURLs, msg.TypeUrl, msg.Value); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+// checkAllowedAny checks that the type URL of a packed message, and of all the
+// messages packed in it, are allowed.
+func checkAllowedAny(typeURLs []string, typeURL string, value []byte) error {
+	if !slices.Contains(typeURLs, typeURL) {
+		return fmt.Errorf("message %s is not allowed", typeURL)
+	}
+
+	name := typeURL[strings.LastIndex(typeURL, "/")+1:]
+	desc, err := gogoproto.HybridResolver.FindDescriptorByName(protoreflect.FullName(name))
+	if err != nil {
+		return fmt.Errorf("message %s cannot be inspected: %w", typeURL, err)
+	}
+	msgDesc, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return fmt.Errorf("message %s cannot be inspected: %s is not a message", typeURL, name)
+	}
+
+	msg := dynamicpb.NewMessage(msgDesc)
+	if err := proto.Unmarshal(value, msg); err != nil {
+		return fmt.Errorf("message %s cannot be inspected: %w", typeURL, err)
+	}
+
+	return checkAllowedNestedMsgs(typeURLs, msg)
+}
+
+// checkAllowedNestedMsgs checks that the type URLs of all the messages packed in
+// the fields of a message are allowed.
+func checkAllowedNestedMsgs(typeURLs []string, msg protoreflect.Message) error {
+	if msg.Descriptor().FullName() == anyFullName {
+		fields := msg.Descriptor().Fields()
+		return checkAllowedAny(typeURLs,
+			msg.Get(fields.ByName("type_url")).String(),
+			msg.Get(fields.ByName("value")).Bytes(),
+		)
+	}
+
+	var err error
+	msg.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsList() && fd.Message() != nil:
+			list := v.List()
+			for i := 0; i < list.Len() && err == nil; i++ {
+				err = checkAllowedNestedMsgs(typeURLs, list.Get(i).Message())
+			}
+		case fd.IsMap() && fd.MapValue().Message() != nil:
+			v.Map().Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+				err = checkAllowedNestedMsgs(typeURLs, v.Message())
+				return err == nil
+			})
+		case !fd.IsList() && !fd.IsMap() && fd.Message() != nil:
+			err = checkAllowedNestedMsgs(typeURLs, v.Message())
+		}
+		return err == nil
+	})
+
+	return err
+}
+
+var _ RemoteSignerServer = remoteSigner{}
+
+type remoteSigner struct {
+	kr     Keyring
+	cdc    codec.Codec
+	policy RemoteSignPolicy
+}
+
+// NewRemoteSigner returns a RemoteSigner service signing with the keys of a
+// keyring the requests allowed by a policy. A nil policy allows all requests.
+// The codec must decode the messages of the signed transactions.
+func NewRemoteSigner(kr Keyring, cdc codec.Codec, policy RemoteSignPolicy) RemoteSignerServer {
+	return remoteSigner{kr: kr, cdc: cdc, policy: policy}
+}
+
+func (rs remoteSigner) Keys(context.Context, *KeysRequest) (*KeysResponse, error) {
+	records, err := rs.kr.List()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := &KeysResponse{}
+	for _, k := range records {
+		// only the keys the keyring can sign with
+		if k.GetLocal() == nil && k.GetLedger() == nil && k.GetPkcs11() == nil {
+			continue
+		}
+		res.Keys = append(res.Keys, &RemoteKey{Name: k.Name, PubKey: k.PubKey})
+	}
+
+	return res, nil
+}
+
+func (rs remoteSigner) Sign(ctx context.Context, req *SignRequest) (*SignResponse, error) {
+	if rs.policy != nil {
+		tx, err := decodeRemoteSignTx(rs.cdc, req)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid sign bytes: %s", err)
+		}
+
+		if err := rs.policy(ctx, req, tx); err != nil {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+	}
+
+	sig, _, err := rs.kr.Sign(req.Name, req.SignBytes, req.SignMode)
+	if err != nil {
+		if errors.Is(err, sdkerrors.ErrKeyNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &SignResponse{Signature: sig}, nil
+}
+
+// decodeRemoteSignTx decodes the transaction of a sign request, or returns
+// nil if its sign mode cannot be decoded.
+func decodeRemoteSignTx(cdc codec.Codec, req *SignRequest) (*RemoteSignTx, error) {
+	var (
+		res       RemoteSignTx
+		bodyBytes []byte
+	)
+
+	switch req.SignMode {
+	case signing.SignMode_SIGN_MODE_DIRECT:
+		var doc tx.SignDoc
+		if err := cdc.Unmarshal(req.SignBytes, &doc); err != nil {
+			return nil, err
+		}
+		res.ChainID, res.AccountNumber, bodyBytes = doc.ChainId, doc.AccountNumber, doc.BodyBytes
+
+		res.AuthInfo = &tx.AuthInfo{}
+		if err := cdc.Unmarshal(doc.AuthInfoBytes, res.AuthInfo); err != nil {
+			return nil, err
+		}
+
+	case signing.SignMode_SIGN_MODE_DIRECT_AUX:
+		var doc tx.SignDocDirectAux
+		if err := cdc.Unmarshal(req.SignBytes, &doc); err != nil {
+			return nil, err
+		}
+		res.ChainID, res.AccountNumber, bodyBytes = doc.ChainId, doc.AccountNumber, doc.BodyBytes
+
+	default:
+		return nil, nil
+	}
+
+	res.Body = &tx.TxBody{}
+	if err := cdc.Unmarshal(bodyBytes, res.Body); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
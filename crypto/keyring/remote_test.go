@@ -0,0 +1,154 @@
+package keyring
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// startRemoteSigner serves a remote signer and returns a connection to it.
+func startRemoteSigner(t *testing.T, signer RemoteSignerServer) *grpc.ClientConn {
+	t.Helper()
+
+	lis := bufconn.Listen(1024 * 1024)
+	srv := grpc.NewServer()
+	RegisterRemoteSignerServer(srv, signer)
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return conn
+}
+
+func TestRemoteKeyring(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	testdata.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	signerKr := NewInMemory(cdc)
+	signerKey, _, err := signerKr.NewMnemonic("signer", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	signerPub, err := signerKey.GetPubKey()
+	require.NoError(t, err)
+	// keys the keyring of the signer cannot sign with are not listed
+	_, err = signerKr.SaveOfflineKey("offline", secp256k1.GenPrivKey().PubKey())
+	require.NoError(t, err)
+
+	testMsgURL := sdk.MsgTypeURL(&testdata.TestMsg{})
+	conn := startRemoteSigner(t, NewRemoteSigner(signerKr, cdc, AllowRemoteSignMsgs(testMsgURL)))
+
+	_, err = New(t.Name(), BackendRemote, t.TempDir(), nil, cdc)
+	require.ErrorIs(t, err, ErrRemoteSigner)
+
+	kr, err := New(t.Name(), BackendRemote, t.TempDir(), nil, cdc, func(options *Options) {
+		options.RemoteSigner = conn
+	})
+	require.NoError(t, err)
+	require.Equal(t, BackendRemote, kr.Backend())
+
+	records, err := kr.List()
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.Equal(t, "signer", records[0].Name)
+	pub, err := records[0].GetPubKey()
+	require.NoError(t, err)
+	require.Equal(t, signerPub, pub)
+
+	addr, err := records[0].GetAddress()
+	require.NoError(t, err)
+	k, err := kr.KeyByAddress(addr)
+	require.NoError(t, err)
+	require.Equal(t, "signer", k.Name)
+
+	msgAny, err := codectypes.NewAnyWithValue(testdata.NewTestMsg(addr))
+	require.NoError(t, err)
+	bodyBytes, err := cdc.Marshal(&tx.TxBody{Messages: []*codectypes.Any{msgAny}, Memo: "memo"})
+	require.NoError(t, err)
+	authInfoBytes, err := cdc.Marshal(&tx.AuthInfo{Fee: &tx.Fee{GasLimit: 100000}})
+	require.NoError(t, err)
+	signBytes, err := cdc.Marshal(&tx.SignDoc{
+		BodyBytes:     bodyBytes,
+		AuthInfoBytes: authInfoBytes,
+		ChainId:       "test-chain",
+		AccountNumber: 1,
+	})
+	require.NoError(t, err)
+
+	sig, sigPub, err := kr.Sign("signer", signBytes, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.Equal(t, signerPub, sigPub)
+	require.True(t, signerPub.VerifySignature(signBytes, sig))
+
+	sig, _, err = kr.SignByAddress(addr, signBytes, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.True(t, signerPub.VerifySignature(signBytes, sig))
+
+	// the policy cannot inspect the transactions of the other sign modes
+	_, _, err = kr.Sign("signer", signBytes, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+	require.ErrorIs(t, err, ErrRemoteSignRefused)
+
+	_, _, err = kr.Sign("offline", signBytes, signing.SignMode_SIGN_MODE_DIRECT)
+	require.Error(t, err)
+
+	// the keys are managed by the signer
+	_, err = kr.ExportPrivKeyArmor("signer", "passphrase")
+	require.ErrorIs(t, err, ErrRemoteKeyring)
+	require.ErrorIs(t, kr.Delete("signer"), ErrRemoteKeyring)
+	_, _, err = kr.NewMnemonic("new", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.ErrorIs(t, err, ErrRemoteKeyring)
+
+	armor, err := kr.ExportPubKeyArmor("signer")
+	require.NoError(t, err)
+	require.NoError(t, signerKr.ImportPubKey("imported", armor))
+}
+
+func TestAllowRemoteSignMsgs(t *testing.T) {
+	hasAnimalURL, dogURL, catURL := "/testpb.HasAnimal", "/testpb.Dog", "/testpb.Cat"
+	policy := AllowRemoteSignMsgs(hasAnimalURL, dogURL, "/unregistered.Msg")
+	req := &SignRequest{SignMode: signing.SignMode_SIGN_MODE_DIRECT}
+
+	newTx := func(msgs ...*codectypes.Any) *RemoteSignTx {
+		return &RemoteSignTx{Body: &tx.TxBody{Messages: msgs}}
+	}
+	pack := func(msg proto.Message) *codectypes.Any {
+		t.Helper()
+		msgAny, err := codectypes.NewAnyWithValue(msg)
+		require.NoError(t, err)
+		return msgAny
+	}
+
+	dog, cat := pack(&testdata.Dog{Name: "dog"}), pack(&testdata.Cat{Moniker: "cat"})
+	require.NoError(t, policy(context.Background(), req, newTx(dog, pack(&testdata.HasAnimal{Animal: dog}))))
+	require.ErrorContains(t, policy(context.Background(), req, newTx(dog, cat)), catURL+" is not allowed")
+
+	// the messages nested in an allowed message are inspected too
+	require.ErrorContains(t, policy(context.Background(), req, newTx(pack(&testdata.HasAnimal{Animal: cat}))), catURL+" is not allowed")
+
+	require.ErrorContains(t, policy(context.Background(), req, newTx(&codectypes.Any{TypeUrl: "/unregistered.Msg"})), "cannot be inspected")
+	require.Error(t, policy(context.Background(), req, nil))
+}
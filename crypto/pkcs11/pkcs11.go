@@ -0,0 +1,114 @@
+// Package pkcs11 signs with secp256k1 keys held by PKCS#11 tokens, such as
+// hardware security modules, which never expose the private keys.
+//
+// The access to the tokens requires cgo and the pkcs11 build tag.
+package pkcs11
+
+import (
+	"encoding/asn1"
+	"errors"
+	"fmt"
+
+	secp "github.com/decred/dcrd/dcrec/secp256k1/v4"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+)
+
+// ErrKeyNotFound is returned when a token has no key pair with a label.
+var ErrKeyNotFound = errors.New("PKCS#11 key pair not found")
+
+// secp256k1OID is the DER encoding of the OID of the secp256k1 curve,
+// 1.3.132.0.10, used as the CKA_EC_PARAMS of the key pairs.
+var secp256k1OID = []byte{0x06, 0x05, 0x2b, 0x81, 0x04, 0x00, 0x0a}
+
+// Config configures the access to a PKCS#11 token.
+type Config struct {
+	// Module is the path of the PKCS#11 library of the token, e.g.
+	// /usr/lib/softhsm/libsofthsm2.so.
+	Module string
+	// Slot is the ID of the slot of the token.
+	Slot uint
+	// PIN is the PIN of the user of the token.
+	PIN string
+}
+
+// Token is a session with a PKCS#11 token holding secp256k1 key pairs,
+// identified by their label.
+type Token interface {
+	// GenerateKey generates a key pair with the given label in the token,
+	// whose private key cannot be extracted, and returns its public key.
+	GenerateKey(label string) (*secp256k1.PubKey, error)
+	// PubKey returns the public key of the key pair with the given label.
+	PubKey(label string) (*secp256k1.PubKey, error)
+	// Sign signs the SHA-256 digest of msg with the private key of the key
+	// pair with the given label, and returns the signature in the format
+	// verified by secp256k1.PubKey.
+	Sign(label string, msg []byte) ([]byte, error)
+	// Close ends the session.
+	Close() error
+}
+
+// openTokenFn opens a session with the token of a configuration. It allows
+// to avoid CGO dependencies when PKCS#11 support is not enabled.
+type openTokenFn func(Config) (Token, error)
+
+var openToken openTokenFn
+
+// SetOpenToken sets the function opening the sessions with the tokens, e.g.
+// to use a mock token, and returns the previous one so that it can be restored.
+func SetOpenToken(fn openTokenFn) openTokenFn {
+	prev := openToken
+	openToken = fn
+	return prev
+}
+
+// Open opens a session with the token of the given configuration, which must
+// be closed after use.
+func Open(cfg Config) (Token, error) {
+	if cfg.Module == "" {
+		return nil, errors.New("no PKCS#11 module is configured")
+	}
+
+	return openToken(cfg)
+}
+
+// parseECPoint returns the compressed public key of the CKA_EC_POINT of a
+// public key, the uncompressed point wrapped in a DER octet string, or not
+// wrapped with some modules.
+func parseECPoint(ecPoint []byte) (*secp256k1.PubKey, error) {
+	var point []byte
+	if rest, err := asn1.Unmarshal(ecPoint, &point); err != nil || len(rest) > 0 {
+		point = ecPoint
+	}
+
+	pub, err := secp.ParsePubKey(point)
+	if err != nil {
+		// an unwrapped point can also be a valid octet string
+		if pub, err = secp.ParsePubKey(ecPoint); err != nil {
+			return nil, fmt.Errorf("invalid secp256k1 public key: %w", err)
+		}
+	}
+
+	return &secp256k1.PubKey{Key: pub.SerializeCompressed()}, nil
+}
+
+// normalizeSignature returns the lower-S form, the only one accepted by
+// secp256k1.PubKey, of an r || s ECDSA signature.
+func normalizeSignature(sig []byte) ([]byte, error) {
+	if len(sig) != 64 {
+		return nil, fmt.Errorf("invalid ECDSA signature length %d", len(sig))
+	}
+
+	var s secp.ModNScalar
+	if overflow := s.SetByteSlice(sig[32:]); overflow {
+		return nil, errors.New("invalid ECDSA signature S value")
+	}
+	if s.IsOverHalfOrder() {
+		s.Negate()
+	}
+
+	normalized := make([]byte, 64)
+	copy(normalized, sig[:32])
+	s.PutBytesUnchecked(normalized[32:])
+	return normalized, nil
+}
@@ -0,0 +1,13 @@
+//go:build !cgo || !pkcs11
+
+package pkcs11
+
+import "errors"
+
+// If PKCS#11 support (build tag) has not been enabled, which implies a CGO
+// dependency, opening a token returns an error.
+func init() {
+	openToken = func(Config) (Token, error) {
+		return nil, errors.New("support for PKCS#11 tokens is not available in this executable")
+	}
+}
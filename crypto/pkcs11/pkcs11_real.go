@@ -0,0 +1,163 @@
+//go:build cgo && pkcs11
+
+package pkcs11
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	p11 "github.com/miekg/pkcs11"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+)
+
+// If PKCS#11 support (build tag) has been enabled, which implies a CGO
+// dependency, set the openToken function which is responsible for loading
+// the PKCS#11 module at runtime and opening a session with the token.
+func init() {
+	openToken = openP11Token
+}
+
+type token struct {
+	ctx     *p11.Ctx
+	session p11.SessionHandle
+}
+
+var _ Token = &token{}
+
+func openP11Token(cfg Config) (Token, error) {
+	ctx := p11.New(cfg.Module)
+	if ctx == nil {
+		return nil, fmt.Errorf("unable to load the PKCS#11 module %s", cfg.Module)
+	}
+
+	if err := ctx.Initialize(); err != nil {
+		ctx.Destroy()
+		return nil, fmt.Errorf("unable to initialize the PKCS#11 module %s: %w", cfg.Module, err)
+	}
+
+	session, err := ctx.OpenSession(cfg.Slot, p11.CKF_SERIAL_SESSION|p11.CKF_RW_SESSION)
+	if err != nil {
+		_ = ctx.Finalize()
+		ctx.Destroy()
+		return nil, fmt.Errorf("unable to open a session with the PKCS#11 slot %d: %w", cfg.Slot, err)
+	}
+
+	t := &token{ctx: ctx, session: session}
+	err = ctx.Login(session, p11.CKU_USER, cfg.PIN)
+	if err != nil && !errors.Is(err, p11.Error(p11.CKR_USER_ALREADY_LOGGED_IN)) {
+		_ = t.Close()
+		return nil, fmt.Errorf("unable to log in the PKCS#11 slot %d: %w", cfg.Slot, err)
+	}
+
+	return t, nil
+}
+
+func (t *token) GenerateKey(label string) (*secp256k1.PubKey, error) {
+	if _, err := t.findObject(p11.CKO_PRIVATE_KEY, label); err == nil {
+		return nil, fmt.Errorf("a PKCS#11 key pair labeled %s already exists", label)
+	} else if !errors.Is(err, ErrKeyNotFound) {
+		return nil, err
+	}
+
+	public := []*p11.Attribute{
+		p11.NewAttribute(p11.CKA_TOKEN, true),
+		p11.NewAttribute(p11.CKA_VERIFY, true),
+		p11.NewAttribute(p11.CKA_EC_PARAMS, secp256k1OID),
+		p11.NewAttribute(p11.CKA_LABEL, label),
+	}
+	private := []*p11.Attribute{
+		p11.NewAttribute(p11.CKA_TOKEN, true),
+		p11.NewAttribute(p11.CKA_SIGN, true),
+		p11.NewAttribute(p11.CKA_PRIVATE, true),
+		p11.NewAttribute(p11.CKA_SENSITIVE, true),
+		p11.NewAttribute(p11.CKA_EXTRACTABLE, false),
+		p11.NewAttribute(p11.CKA_LABEL, label),
+	}
+	mechanism := []*p11.Mechanism{p11.NewMechanism(p11.CKM_EC_KEY_PAIR_GEN, nil)}
+
+	pub, _, err := t.ctx.GenerateKeyPair(t.session, mechanism, public, private)
+	if err != nil {
+		return nil, fmt.Errorf("unable to generate the PKCS#11 key pair %s: %w", label, err)
+	}
+
+	return t.pubKey(pub)
+}
+
+func (t *token) PubKey(label string) (*secp256k1.PubKey, error) {
+	pub, err := t.findObject(p11.CKO_PUBLIC_KEY, label)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.pubKey(pub)
+}
+
+func (t *token) pubKey(pub p11.ObjectHandle) (*secp256k1.PubKey, error) {
+	attrs, err := t.ctx.GetAttributeValue(t.session, pub, []*p11.Attribute{
+		p11.NewAttribute(p11.CKA_EC_POINT, nil),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return parseECPoint(attrs[0].Value)
+}
+
+func (t *token) Sign(label string, msg []byte) ([]byte, error) {
+	priv, err := t.findObject(p11.CKO_PRIVATE_KEY, label)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := t.ctx.SignInit(t.session, []*p11.Mechanism{p11.NewMechanism(p11.CKM_ECDSA, nil)}, priv); err != nil {
+		return nil, err
+	}
+	digest := sha256.Sum256(msg)
+	sig, err := t.ctx.Sign(t.session, digest[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return normalizeSignature(sig)
+}
+
+// findObject returns the only object of the given class with the given label.
+func (t *token) findObject(class uint, label string) (p11.ObjectHandle, error) {
+	template := []*p11.Attribute{
+		p11.NewAttribute(p11.CKA_CLASS, class),
+		p11.NewAttribute(p11.CKA_LABEL, label),
+	}
+	if err := t.ctx.FindObjectsInit(t.session, template); err != nil {
+		return 0, err
+	}
+
+	objects, _, err := t.ctx.FindObjects(t.session, 2)
+	if finalErr := t.ctx.FindObjectsFinal(t.session); err == nil {
+		err = finalErr
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	switch len(objects) {
+	case 0:
+		return 0, fmt.Errorf("%w: %s", ErrKeyNotFound, label)
+	case 1:
+		return objects[0], nil
+	default:
+		return 0, fmt.Errorf("several PKCS#11 key pairs are labeled %s", label)
+	}
+}
+
+func (t *token) Close() error {
+	_ = t.ctx.Logout(t.session)
+	err := t.ctx.CloseSession(t.session)
+	if finalizeErr := t.ctx.Finalize(); err == nil {
+		err = finalizeErr
+	}
+	t.ctx.Destroy()
+
+	return err
+}
@@ -0,0 +1,74 @@
+package pkcs11
+
+import (
+	"crypto/sha256"
+	"encoding/asn1"
+	"testing"
+
+	secp "github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+)
+
+func TestParseECPoint(t *testing.T) {
+	priv := secp256k1.GenPrivKey()
+	pub, err := secp.ParsePubKey(priv.PubKey().Bytes())
+	require.NoError(t, err)
+	point := pub.SerializeUncompressed()
+
+	wrapped, err := asn1.Marshal(point)
+	require.NoError(t, err)
+
+	for _, ecPoint := range [][]byte{wrapped, point} {
+		res, err := parseECPoint(ecPoint)
+		require.NoError(t, err)
+		require.Equal(t, priv.PubKey(), res)
+	}
+
+	_, err = parseECPoint([]byte{0x04, 0x01, 0x02})
+	require.Error(t, err)
+}
+
+func TestNormalizeSignature(t *testing.T) {
+	priv := secp256k1.GenPrivKey()
+	msg := []byte("message")
+	digest := sha256.Sum256(msg)
+
+	// the compact signature is a header byte followed by r and s
+	compact := ecdsa.SignCompact(secp.PrivKeyFromBytes(priv.Key), digest[:], false)
+	sig := compact[1:]
+
+	var s secp.ModNScalar
+	s.SetByteSlice(sig[32:])
+	highS := make([]byte, 64)
+	copy(highS, sig[:32])
+	s.Negate().PutBytesUnchecked(highS[32:])
+	require.False(t, priv.PubKey().VerifySignature(msg, highS))
+
+	for _, sig := range [][]byte{sig, highS} {
+		res, err := normalizeSignature(sig)
+		require.NoError(t, err)
+		require.True(t, priv.PubKey().VerifySignature(msg, res))
+	}
+
+	_, err := normalizeSignature(sig[1:])
+	require.Error(t, err)
+}
+
+func TestOpen(t *testing.T) {
+	_, err := Open(Config{})
+	require.ErrorContains(t, err, "no PKCS#11 module is configured")
+
+	defer SetOpenToken(openToken)
+	var opened Config
+	SetOpenToken(func(cfg Config) (Token, error) {
+		opened = cfg
+		return nil, nil
+	})
+	cfg := Config{Module: "module.so", Slot: 1, PIN: "1234"}
+	_, err = Open(cfg)
+	require.NoError(t, err)
+	require.Equal(t, cfg, opened)
+}
@@ -3,6 +3,9 @@ package errors
 import (
 	"fmt"
 	"reflect"
+	"runtime"
+	"sort"
+	"strings"
 
 	"github.com/pkg/errors"
 	grpccodes "google.golang.org/grpc/codes"
@@ -43,7 +46,7 @@ func RegisterWithGRPCCode(codespace string, code uint32, grpcCode grpccodes.Code
 		panic(fmt.Sprintf("error with code %d is already registered: %q", code, e.desc))
 	}
 
-	err := &Error{codespace: codespace, code: code, desc: description, grpcCode: grpcCode}
+	err := &Error{codespace: codespace, code: code, desc: description, grpcCode: grpcCode, pkg: registeringPackage()}
 	setUsed(err)
 
 	return err
@@ -65,6 +68,91 @@ func setUsed(err *Error) {
 	usedCodes[errorID(err.codespace, err.code)] = err
 }
 
+// RegisteredErrors returns all registered errors, sorted by codespace and code.
+func RegisteredErrors() []*Error {
+	errs := make([]*Error, 0, len(usedCodes))
+	for _, err := range usedCodes {
+		errs = append(errs, err)
+	}
+
+	sort.Slice(errs, func(i, j int) bool {
+		if errs[i].codespace != errs[j].codespace {
+			return errs[i].codespace < errs[j].codespace
+		}
+		return errs[i].code < errs[j].code
+	})
+
+	return errs
+}
+
+// ValidateRegistry returns an error if a codespace is used by errors registered
+// in different packages, which typically means that two modules collide on the
+// same codespace. It is meant to be called at application startup, once all
+// errors are registered.
+func ValidateRegistry() error {
+	pkgs := map[string]map[string]bool{}
+	for _, err := range usedCodes {
+		if pkgs[err.codespace] == nil {
+			pkgs[err.codespace] = map[string]bool{}
+		}
+		pkgs[err.codespace][err.pkg] = true
+	}
+
+	var collisions []string
+	for codespace, codespacePkgs := range pkgs {
+		if len(codespacePkgs) < 2 {
+			continue
+		}
+
+		names := make([]string, 0, len(codespacePkgs))
+		for pkg := range codespacePkgs {
+			names = append(names, pkg)
+		}
+		sort.Strings(names)
+		collisions = append(collisions, fmt.Sprintf("codespace %q is registered by packages %s", codespace, strings.Join(names, ", ")))
+	}
+
+	if len(collisions) > 0 {
+		sort.Strings(collisions)
+		return fmt.Errorf("error codespace collisions: %s", strings.Join(collisions, "; "))
+	}
+
+	return nil
+}
+
+// registrationFuncs are the functions of this package registering errors.
+var registrationFuncs = map[string]bool{
+	"cosmossdk.io/errors.Register":             true,
+	"cosmossdk.io/errors.RegisterWithGRPCCode": true,
+	"cosmossdk.io/errors.New":                  true,
+}
+
+// registeringPackage returns the import path of the package calling the error
+// registration functions.
+func registeringPackage() string {
+	pcs := make([]uintptr, 8)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(2, pcs)])
+	for {
+		frame, more := frames.Next()
+		if !registrationFuncs[frame.Function] {
+			return funcPackage(frame.Function)
+		}
+		if !more {
+			return ""
+		}
+	}
+}
+
+// funcPackage returns the import path of the package of a fully qualified
+// function name, e.g. "cosmossdk.io/x/bank/types.init".
+func funcPackage(name string) string {
+	slash := strings.LastIndex(name, "/")
+	if dot := strings.Index(name[slash+1:], "."); dot >= 0 {
+		return name[:slash+1+dot]
+	}
+	return name
+}
+
 // ABCIError will resolve an error code/log from an abci result into
 // an error message. If the code is registered, it will map it back to
 // the canonical error, so we can do eg. ErrNotFound.Is(err) on something
@@ -95,6 +183,7 @@ type Error struct {
 	code      uint32
 	desc      string
 	grpcCode  grpccodes.Code
+	pkg       string // the package registering the error
 }
 
 // New is an alias for Register.
@@ -226,6 +226,43 @@ func (s *errorsTestSuite) TestGRPCStatus() {
 	s.Require().Equal("codespace testtesttest code 38: not found: test", status.Message())
 }
 
+func (s *errorsTestSuite) TestRegisteredErrors() {
+	errs := RegisteredErrors()
+	s.Require().Contains(errs, ErrTxDecode)
+	s.Require().Contains(errs, ErrPanic)
+	for i := 1; i < len(errs); i++ {
+		prev, cur := errs[i-1], errs[i]
+		s.Require().True(prev.codespace < cur.codespace || (prev.codespace == cur.codespace && prev.code < cur.code))
+	}
+
+	s.Require().Equal("cosmossdk.io/errors", ErrTxDecode.pkg)
+}
+
+func (s *errorsTestSuite) TestValidateRegistry() {
+	s.Require().NoError(ValidateRegistry())
+
+	// an error of the test codespace registered by another package
+	collision := &Error{codespace: testCodespace, code: 1000, desc: "collision", pkg: "example.com/module/types"}
+	setUsed(collision)
+	defer delete(usedCodes, errorID(testCodespace, 1000))
+
+	err := ValidateRegistry()
+	s.Require().ErrorContains(err, `codespace "testtesttest" is registered by packages cosmossdk.io/errors, example.com/module/types`)
+}
+
+func TestFuncPackage(t *testing.T) {
+	for name, pkg := range map[string]string{
+		"cosmossdk.io/errors.Register":                     "cosmossdk.io/errors",
+		"cosmossdk.io/x/bank/types.init":                   "cosmossdk.io/x/bank/types",
+		"github.com/cosmos/cosmos-sdk/types/errors.init.0": "github.com/cosmos/cosmos-sdk/types/errors",
+		"main.init": "main",
+	} {
+		if got := funcPackage(name); got != pkg {
+			t.Errorf("funcPackage(%q) = %q, want %q", name, got, pkg)
+		}
+	}
+}
+
 const testCodespace = "testtesttest"
 
 var (
@@ -26,6 +26,10 @@ func ORMPluginRunner(p *protogen.Plugin) error {
 			continue
 		}
 
+		if err := genQueryServers(p, f); err != nil {
+			return err
+		}
+
 		if !hasTables(f) {
 			continue
 		}
@@ -45,6 +49,33 @@ func ORMPluginRunner(p *protogen.Plugin) error {
 	return nil
 }
 
+// genQueryServers generates the implementations of the query services of a
+// file generated by protoc-gen-go-cosmos-orm-proto.
+func genQueryServers(p *protogen.Plugin, f *protogen.File) error {
+	for _, svc := range f.Services {
+		tableFile := queryServiceTableFile(p, f, svc)
+		if tableFile == nil {
+			continue
+		}
+
+		gen := p.NewGeneratedFile(fmt.Sprintf("%s.cosmos_orm.go", f.GeneratedFilenamePrefix), f.GoImportPath)
+		err := queryServerGen{
+			GeneratedFile: &generator.GeneratedFile{
+				GeneratedFile: gen,
+				LocalPackages: map[string]bool{},
+			},
+			file:      f,
+			svc:       svc,
+			tableFile: tableFile,
+		}.gen()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func QueryProtoPluginRunner(p *protogen.Plugin) error {
 	p.SupportedFeatures = uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL)
 	for _, f := range p.Files {
@@ -0,0 +1,369 @@
+package codegen
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-proto/generator"
+	"github.com/iancoleman/strcase"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	ormv1 "cosmossdk.io/api/cosmos/orm/v1"
+
+	"cosmossdk.io/orm/internal/fieldnames"
+)
+
+// queryServerGen generates the implementation of a query service generated by
+// protoc-gen-go-cosmos-orm-proto, querying the tables of the store of the file
+// which declares them.
+type queryServerGen struct {
+	*generator.GeneratedFile
+	file      *protogen.File
+	svc       *protogen.Service
+	tableFile *protogen.File
+}
+
+// queryServiceTableFile returns the file declaring the tables queried by a
+// service generated by protoc-gen-go-cosmos-orm-proto, nil if the service
+// isn't one.
+func queryServiceTableFile(p *protogen.Plugin, f *protogen.File, svc *protogen.Service) *protogen.File {
+	imports := f.Desc.Imports()
+	for i := 0; i < imports.Len(); i++ {
+		tableFile, ok := p.FilesByPath[imports.Get(i).Path()]
+		if !ok || !hasTables(tableFile) {
+			continue
+		}
+
+		if svc.GoName == (queryProtoGen{File: tableFile}).queryServiceName()+"Service" {
+			return tableFile
+		}
+	}
+
+	return nil
+}
+
+func (g queryServerGen) gen() error {
+	if g.file.GoImportPath != g.tableFile.GoImportPath {
+		return fmt.Errorf("query service %s must be in the Go package of %s", g.svc.Desc.FullName(), g.tableFile.Desc.Path())
+	}
+
+	g.P("// Code generated by protoc-gen-go-cosmos-orm. DO NOT EDIT.")
+	g.P()
+	g.P("package ", g.file.GoPackageName)
+	g.P()
+
+	storeName := fileGen{file: g.tableFile}.storeInterfaceName()
+	g.P("// ", g.serverStructName(), " implements ", g.serverInterfaceName(), " by querying the tables of ", storeName, ".")
+	g.P("type ", g.serverStructName(), " struct {")
+	g.P("Unimplemented", g.serverInterfaceName())
+	g.P("store ", storeName)
+	g.P("}")
+	g.P()
+	g.P("var _ ", g.serverInterfaceName(), " = ", g.serverStructName(), "{}")
+	g.P()
+	g.P("// New", g.serverInterfaceName(), " returns a ", g.serverInterfaceName(), " querying the tables of the store.")
+	g.P("func New", g.serverInterfaceName(), "(store ", storeName, ") ", g.serverInterfaceName(), " {")
+	g.P("return ", g.serverStructName(), "{store: store}")
+	g.P("}")
+	g.P()
+
+	for _, msg := range g.tableFile.Messages {
+		tableDesc := proto.GetExtension(msg.Desc.Options(), ormv1.E_Table).(*ormv1.TableDescriptor)
+		if tableDesc != nil {
+			if err := g.genTableMethods(msg, tableDesc); err != nil {
+				return err
+			}
+		}
+		singletonDesc := proto.GetExtension(msg.Desc.Options(), ormv1.E_Singleton).(*ormv1.SingletonDescriptor)
+		if singletonDesc != nil {
+			if err := g.genGetMethod(msg, "Get"+msg.GoIdent.GoName, "Get", nil); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (g queryServerGen) serverInterfaceName() string {
+	return g.svc.GoName + "Server"
+}
+
+func (g queryServerGen) serverStructName() string {
+	return strcase.ToLowerCamel(g.svc.GoName) + "Server"
+}
+
+func (g queryServerGen) tableAccessor(msg *protogen.Message) string {
+	return "s.store." + msg.GoIdent.GoName + "Table()"
+}
+
+// message returns the request or response message of the given name.
+func (g queryServerGen) message(name string) (*protogen.Message, error) {
+	for _, msg := range g.file.Messages {
+		if msg.GoIdent.GoName == name {
+			return msg, nil
+		}
+	}
+
+	return nil, fmt.Errorf("can't find message %s in %s", name, g.file.Desc.Path())
+}
+
+func (g queryServerGen) genTableMethods(msg *protogen.Message, desc *ormv1.TableDescriptor) error {
+	name := msg.GoIdent.GoName
+	if err := g.genGetMethod(msg, "Get"+name, "Get", fieldnames.CommaSeparatedFieldNames(desc.PrimaryKey.Fields).Names()); err != nil {
+		return err
+	}
+
+	for _, idx := range desc.Index {
+		if !idx.Unique {
+			continue
+		}
+
+		fieldsCamel := fieldsToCamelCase(idx.Fields)
+		if err := g.genGetMethod(msg, fmt.Sprintf("Get%sBy%s", name, fieldsCamel), "GetBy"+fieldsCamel, fieldnames.CommaSeparatedFieldNames(idx.Fields).Names()); err != nil {
+			return err
+		}
+	}
+
+	return g.genListMethod(msg, desc)
+}
+
+// genGetMethod generates a method getting a value of the table by the given
+// key fields with its table method.
+func (g queryServerGen) genGetMethod(msg *protogen.Message, methodName, tableMethod string, fields []protoreflect.Name) error {
+	req, err := g.message(methodName + "Request")
+	if err != nil {
+		return err
+	}
+	res, err := g.message(methodName + "Response")
+	if err != nil {
+		return err
+	}
+
+	args := []string{"ctx"}
+	for _, fieldName := range fields {
+		field := g.field(req, fieldName)
+		if field == nil {
+			return fmt.Errorf("can't find field %s in %s", fieldName, req.Desc.FullName())
+		}
+		args = append(args, "req.Get"+field.GoName+"()")
+	}
+
+	reqParam := "req"
+	if len(fields) == 0 {
+		reqParam = "_"
+	}
+
+	g.P("// ", methodName, " implements the ", g.svc.GoName, "/", methodName, " method.")
+	g.P("func (s ", g.serverStructName(), ") ", methodName, "(ctx ", contextPkg.Ident("Context"), ", ", reqParam, " *", req.GoIdent, ") (*", res.GoIdent, ", error) {")
+	g.P("value, err := ", g.tableAccessor(msg), ".", tableMethod, "(", strings.Join(args, ", "), ")")
+	g.P("if err != nil {")
+	g.P("return nil, err")
+	g.P("}")
+	g.P()
+	g.P("return &", res.GoIdent, "{Value: value}, nil")
+	g.P("}")
+	g.P()
+
+	return nil
+}
+
+func (g queryServerGen) field(msg *protogen.Message, name protoreflect.Name) *protogen.Field {
+	for _, field := range msg.Fields {
+		if field.Desc.Name() == name {
+			return field
+		}
+	}
+
+	return nil
+}
+
+func (g queryServerGen) genListMethod(msg *protogen.Message, desc *ormv1.TableDescriptor) error {
+	name := msg.GoIdent.GoName
+	methodName := "List" + name
+	req, err := g.message(methodName + "Request")
+	if err != nil {
+		return err
+	}
+	res, err := g.message(methodName + "Response")
+	if err != nil {
+		return err
+	}
+
+	var prefixQuery, rangeQuery *protogen.Field
+	for _, field := range req.Fields {
+		switch field.Desc.Name() {
+		case "prefix_query":
+			prefixQuery = field
+		case "range_query":
+			rangeQuery = field
+		}
+	}
+	if prefixQuery == nil || rangeQuery == nil {
+		return fmt.Errorf("can't find the query fields of %s", req.Desc.FullName())
+	}
+
+	keyFunc := strcase.ToLowerCamel(name) + "IndexKey"
+	keyEndFunc := keyFunc + "End"
+	indexKeyType := name + "IndexKey"
+
+	g.P("// ", methodName, " implements the ", g.svc.GoName, "/", methodName, " method.")
+	g.P("func (s ", g.serverStructName(), ") ", methodName, "(ctx ", contextPkg.Ident("Context"), ", req *", req.GoIdent, ") (*", res.GoIdent, ", error) {")
+	g.P("opts := []", ormListPkg.Ident("Option"), "{", ormListPkg.Ident("Paginate"), "(req.GetPagination())}")
+	g.P("var (")
+	g.P("it ", name, "Iterator")
+	g.P("err error")
+	g.P(")")
+	g.P("switch query := req.GetQuery().(type) {")
+	g.P("case *", prefixQuery.GoIdent, ":")
+	g.P("var key ", indexKeyType)
+	g.P("if key, err = s.", keyFunc, "(query.", prefixQuery.GoName, "); err != nil {")
+	g.P("return nil, err")
+	g.P("}")
+	g.P("it, err = ", g.tableAccessor(msg), ".List(ctx, key, opts...)")
+	g.P("case *", rangeQuery.GoIdent, ":")
+	g.P("var from ", indexKeyType)
+	g.P("if from, err = s.", keyFunc, "(query.", rangeQuery.GoName, ".GetFrom()); err != nil {")
+	g.P("return nil, err")
+	g.P("}")
+	g.P("to := s.", keyEndFunc, "(from)")
+	g.P("if query.", rangeQuery.GoName, ".GetTo() != nil {")
+	g.P("if to, err = s.", keyFunc, "(query.", rangeQuery.GoName, ".GetTo()); err != nil {")
+	g.P("return nil, err")
+	g.P("}")
+	g.P("if from.id() != to.id() {")
+	g.P("return nil, ", ormErrPkg.Ident("InvalidRangeIterationKeys"), ".Wrap(\"from and to must be keys of the same index\")")
+	g.P("}")
+	g.P("}")
+	g.P("it, err = ", g.tableAccessor(msg), ".ListRange(ctx, from, to, opts...)")
+	g.P("default:")
+	g.P("it, err = ", g.tableAccessor(msg), ".List(ctx, ", name, "PrimaryKey{}, opts...)")
+	g.P("}")
+	g.P("if err != nil {")
+	g.P("return nil, err")
+	g.P("}")
+	g.P("defer it.Close()")
+	g.P()
+	g.P("var values []*", msg.GoIdent)
+	g.P("for it.Next() {")
+	g.P("value, err := it.Value()")
+	g.P("if err != nil {")
+	g.P("return nil, err")
+	g.P("}")
+	g.P("values = append(values, value)")
+	g.P("}")
+	g.P()
+	g.P("return &", res.GoIdent, "{Values: values, Pagination: it.PageResponse()}, nil")
+	g.P("}")
+	g.P()
+
+	indexes := map[protoreflect.FieldNumber]string{1: desc.PrimaryKey.Fields}
+	for _, idx := range desc.Index {
+		// index field numbers are their id + 1
+		indexes[protoreflect.FieldNumber(idx.Id+1)] = idx.Fields
+	}
+
+	keyMsg := prefixQuery.Message
+	g.P("// ", keyFunc, " returns the ", name, " index key of a ", methodName, " query.")
+	g.P("func (", g.serverStructName(), ") ", keyFunc, "(key *", keyMsg.GoIdent, ") (", indexKeyType, ", error) {")
+	g.P("switch key := key.GetKey().(type) {")
+	for _, field := range keyMsg.Fields {
+		fields, ok := indexes[field.Desc.Number()]
+		if !ok {
+			return fmt.Errorf("can't find the index of %s", field.Desc.FullName())
+		}
+		if err := g.genIndexKeyCase(msg, field, fields); err != nil {
+			return err
+		}
+	}
+	g.P("default:")
+	g.P("return nil, ", ormErrPkg.Ident("InvalidKeyField"), ".Wrap(\"missing index key\")")
+	g.P("}")
+	g.P("}")
+	g.P()
+
+	g.P("// ", keyEndFunc, " returns the key of the end of the index of the given key.")
+	g.P("func (", g.serverStructName(), ") ", keyEndFunc, "(key ", indexKeyType, ") ", indexKeyType, " {")
+	g.P("switch key.(type) {")
+	for _, field := range keyMsg.Fields {
+		structName := name + fieldsToCamelCase(indexes[field.Desc.Number()]) + indexKey
+		g.P("case ", structName, ":")
+		g.P("return ", structName, "{}")
+	}
+	g.P("default:")
+	g.P("return key")
+	g.P("}")
+	g.P("}")
+	g.P()
+
+	return nil
+}
+
+// genIndexKeyCase generates the conversion of an index key of a list query to
+// the index key of the table, whose set fields must be a prefix of the fields
+// of the index.
+func (g queryServerGen) genIndexKeyCase(msg *protogen.Message, field *protogen.Field, fields string) error {
+	structName := msg.GoIdent.GoName + fieldsToCamelCase(fields) + indexKey
+	g.P("case *", field.GoIdent, ":")
+	g.P("k := key.", field.GoName)
+	g.P("if k == nil {")
+	g.P("return ", structName, "{}, nil")
+	g.P("}")
+	g.P()
+
+	indexFields := fieldnames.CommaSeparatedFieldNames(fields).Names()
+	keyFields := make([]*protogen.Field, len(indexFields))
+	for i, name := range indexFields {
+		keyFields[i] = g.field(field.Message, name)
+		if keyFields[i] == nil {
+			return fmt.Errorf("can't find field %s in %s", name, field.Message.Desc.FullName())
+		}
+	}
+
+	g.P("switch {")
+	for n := len(keyFields); n >= 0; n-- {
+		var conds, values, names []string
+		for i, keyField := range keyFields {
+			set := "k." + keyField.GoName + " != nil"
+			if i >= n {
+				set = "k." + keyField.GoName + " == nil"
+			} else {
+				values = append(values, g.keyFieldValue(keyField))
+				names = append(names, strcase.ToCamel(string(keyField.Desc.Name())))
+			}
+			conds = append(conds, set)
+		}
+
+		// the cases of an index of a single field are exhaustive
+		if n == 0 && len(keyFields) == 1 {
+			g.P("default:")
+		} else {
+			g.P("case ", strings.Join(conds, " && "), ":")
+		}
+		if n == 0 {
+			g.P("return ", structName, "{}, nil")
+		} else {
+			g.P("return ", structName, "{}.With", strings.Join(names, ""), "(", strings.Join(values, ", "), "), nil")
+		}
+	}
+	if len(keyFields) > 1 {
+		g.P("default:")
+		g.P("return nil, ", ormErrPkg.Ident("InvalidKeyField"), ".Wrap(\"the fields of an index key must be a prefix of the fields of the index\")")
+	}
+	g.P("}")
+
+	return nil
+}
+
+// keyFieldValue returns the value of a field of an index key of a list query,
+// optional scalars being pointers.
+func (g queryServerGen) keyFieldValue(field *protogen.Field) string {
+	switch field.Desc.Kind() {
+	case protoreflect.MessageKind, protoreflect.BytesKind:
+		return "k." + field.GoName
+	default:
+		return "*k." + field.GoName
+	}
+}
@@ -25,11 +25,13 @@ import (
 type ModuleDB interface {
 	ormtable.Schema
 
-	// GenesisHandler returns an implementation of appmodule.HasGenesis
-	// to be embedded in or called from app module implementations.
+	// GenesisHandler returns an implementation of appmodule.HasGenesisAuto
+	// importing and exporting the tables of the module schema, to be embedded
+	// in or called from app module implementations. The module manager then
+	// handles the genesis of the module without any other genesis code.
 	// Ex:
 	//   type AppModule struct {
-	//     appmodule.HasGenesis
+	//     appmodule.HasGenesisAuto
 	//   }
 	//
 	//   func NewKeeper(db ModuleDB) *Keeper {
@@ -37,9 +39,9 @@ type ModuleDB interface {
 	//   }
 	//
 	//  func NewAppModule(keeper keeper.Keeper) AppModule {
-	//    return AppModule{HasGenesis: keeper.GenesisHandler()}
+	//    return AppModule{HasGenesisAuto: keeper.GenesisHandler()}
 	//  }
-	GenesisHandler() appmodule.HasGenesis // TODO should be appmodule.HasGenesisAuto with core v1
+	GenesisHandler() appmodule.HasGenesisAuto
 
 	private()
 }
@@ -212,7 +214,7 @@ func (m moduleDB) GetTable(message proto.Message) ormtable.Table {
 	return m.tablesByName[message.ProtoReflect().Descriptor().FullName()]
 }
 
-func (m moduleDB) GenesisHandler() appmodule.HasGenesis { // TODO should be appmodule.HasGenesisAuto with core v1
+func (m moduleDB) GenesisHandler() appmodule.HasGenesisAuto {
 	return appModuleGenesisWrapper{m}
 }
 
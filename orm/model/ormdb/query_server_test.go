@@ -0,0 +1,111 @@
+package ormdb_test
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	queryv1beta1 "cosmossdk.io/api/cosmos/base/query/v1beta1"
+	"cosmossdk.io/orm/internal/testpb"
+	"cosmossdk.io/orm/model/ormdb"
+	"cosmossdk.io/orm/model/ormtable"
+	"cosmossdk.io/orm/testing/ormtest"
+	"cosmossdk.io/orm/types/ormerrors"
+)
+
+func TestQueryServer(t *testing.T) {
+	db, err := ormdb.NewModuleDB(TestBankSchema, ormdb.ModuleDBOptions{})
+	assert.NilError(t, err)
+	store, err := testpb.NewBankStore(db)
+	assert.NilError(t, err)
+	ctx := ormtable.WrapContextDefault(ormtest.NewMemoryBackend())
+
+	for _, balance := range []*testpb.Balance{
+		{Address: "alice", Denom: "foo", Amount: 1},
+		{Address: "alice", Denom: "bar", Amount: 2},
+		{Address: "bob", Denom: "foo", Amount: 3},
+		{Address: "charlie", Denom: "baz", Amount: 4},
+	} {
+		assert.NilError(t, store.BalanceTable().Insert(ctx, balance))
+	}
+
+	server := testpb.NewBankQueryServiceServer(store)
+	str := func(s string) *string { return &s }
+	amounts := func(values []*testpb.Balance) []uint64 {
+		var res []uint64
+		for _, value := range values {
+			res = append(res, value.Amount)
+		}
+		return res
+	}
+
+	// get
+	balance, err := server.GetBalance(ctx, &testpb.GetBalanceRequest{Address: "bob", Denom: "foo"})
+	assert.NilError(t, err)
+	assert.Equal(t, uint64(3), balance.Value.Amount)
+	_, err = server.GetBalance(ctx, &testpb.GetBalanceRequest{Address: "bob", Denom: "bar"})
+	assert.Assert(t, ormerrors.IsNotFound(err))
+
+	// list by primary key
+	res, err := server.ListBalance(ctx, &testpb.ListBalanceRequest{})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []uint64{2, 1, 3, 4}, amounts(res.Values))
+
+	// prefix query
+	res, err = server.ListBalance(ctx, &testpb.ListBalanceRequest{
+		Query: &testpb.ListBalanceRequest_PrefixQuery{
+			PrefixQuery: &testpb.ListBalanceRequest_IndexKey{
+				Key: &testpb.ListBalanceRequest_IndexKey_Denom_{
+					Denom: &testpb.ListBalanceRequest_IndexKey_Denom{Denom: str("foo")},
+				},
+			},
+		},
+	})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []uint64{1, 3}, amounts(res.Values))
+
+	// range query to the end of the index, paginated
+	res, err = server.ListBalance(ctx, &testpb.ListBalanceRequest{
+		Query: &testpb.ListBalanceRequest_RangeQuery_{
+			RangeQuery: &testpb.ListBalanceRequest_RangeQuery{
+				From: &testpb.ListBalanceRequest_IndexKey{
+					Key: &testpb.ListBalanceRequest_IndexKey_AddressDenom_{
+						AddressDenom: &testpb.ListBalanceRequest_IndexKey_AddressDenom{Address: str("alice"), Denom: str("foo")},
+					},
+				},
+			},
+		},
+		Pagination: &queryv1beta1.PageRequest{Limit: 2, CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []uint64{1, 3}, amounts(res.Values))
+	assert.Equal(t, uint64(3), res.Pagination.Total)
+	assert.Assert(t, res.Pagination.NextKey != nil)
+
+	// the fields of an index key must be a prefix of the fields of the index
+	_, err = server.ListBalance(ctx, &testpb.ListBalanceRequest{
+		Query: &testpb.ListBalanceRequest_PrefixQuery{
+			PrefixQuery: &testpb.ListBalanceRequest_IndexKey{
+				Key: &testpb.ListBalanceRequest_IndexKey_AddressDenom_{
+					AddressDenom: &testpb.ListBalanceRequest_IndexKey_AddressDenom{Denom: str("foo")},
+				},
+			},
+		},
+	})
+	assert.ErrorIs(t, err, ormerrors.InvalidKeyField)
+
+	// the range keys must be keys of the same index
+	_, err = server.ListBalance(ctx, &testpb.ListBalanceRequest{
+		Query: &testpb.ListBalanceRequest_RangeQuery_{
+			RangeQuery: &testpb.ListBalanceRequest_RangeQuery{
+				From: &testpb.ListBalanceRequest_IndexKey{
+					Key: &testpb.ListBalanceRequest_IndexKey_AddressDenom_{},
+				},
+				To: &testpb.ListBalanceRequest_IndexKey{
+					Key: &testpb.ListBalanceRequest_IndexKey_Denom_{},
+				},
+			},
+		},
+	})
+	assert.ErrorIs(t, err, ormerrors.InvalidRangeIterationKeys)
+}
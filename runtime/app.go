@@ -15,6 +15,7 @@ import (
 	authtx "cosmossdk.io/x/auth/tx"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/baseapp/storeexport"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/grpc/cmtservice"
 	nodeservice "github.com/cosmos/cosmos-sdk/client/grpc/node"
@@ -145,6 +146,10 @@ func (a *App) Load(loadLatest bool) error {
 		a.ModuleManager.SetOrderMigrations(a.config.OrderMigrations...)
 	}
 
+	if err := a.ModuleManager.RegisterSnapshotExtensions(a.SnapshotManager()); err != nil {
+		return err
+	}
+
 	if loadLatest {
 		if err := a.LoadLatestVersion(); err != nil {
 			return err
@@ -229,7 +234,7 @@ func (a *App) RegisterTendermintService(clientCtx client.Context) {
 
 // RegisterNodeService registers the node gRPC service on the app gRPC router.
 func (a *App) RegisterNodeService(clientCtx client.Context, cfg config.Config) {
-	nodeservice.RegisterNodeService(clientCtx, a.GRPCQueryRouter(), cfg)
+	nodeservice.RegisterNodeService(clientCtx, a.GRPCQueryRouter(), cfg, nodeservice.WithBlockSubscriptions(a), nodeservice.WithCacheStats(a))
 }
 
 // Configurator returns the app's configurator.
@@ -247,6 +252,12 @@ func (a *App) DefaultGenesis() map[string]json.RawMessage {
 	return a.ModuleManager.DefaultGenesis()
 }
 
+// StoreImporters returns the store importers of the modules, migrating their
+// stores when an exported application state is imported.
+func (a *App) StoreImporters() (map[string]storeexport.Importer, error) {
+	return a.ModuleManager.StoreImporters()
+}
+
 // GetStoreKeys returns all the stored store keys.
 func (a *App) GetStoreKeys() []storetypes.StoreKey {
 	return a.storeKeys
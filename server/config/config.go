@@ -8,6 +8,7 @@ import (
 
 	pruningtypes "cosmossdk.io/store/pruning/types"
 
+	"github.com/cosmos/cosmos-sdk/baseapp/interblockcache"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -42,10 +43,18 @@ type BaseConfig struct {
 	// If set to 0, it is unbounded.
 	QueryGasLimit uint64 `mapstructure:"query-gas-limit"`
 
+	// HistoricalQueryEnabled enables the queries at a height other than the
+	// latest one, e.g. through the gRPC height header.
+	HistoricalQueryEnabled bool `mapstructure:"historical-query-enabled"`
+
 	Pruning           string `mapstructure:"pruning"`
 	PruningKeepRecent string `mapstructure:"pruning-keep-recent"`
 	PruningInterval   string `mapstructure:"pruning-interval"`
 
+	// PruningOverrides overrides the pruning options above for individual
+	// stores, by store key name.
+	PruningOverrides map[string]PruningOverride `mapstructure:"pruning-overrides"`
+
 	// HaltHeight contains a non-zero block height at which a node will gracefully
 	// halt and shutdown that can be used to assist upgrades and testing.
 	//
@@ -78,6 +87,11 @@ type BaseConfig struct {
 	// InterBlockCache enables inter-block caching.
 	InterBlockCache bool `mapstructure:"inter-block-cache"`
 
+	// InterBlockCacheSize is the maximum number of entries of the inter-block
+	// cache of each store. The caches shrink when the memory used by the node
+	// gets close to the Go runtime soft memory limit, i.e. GOMEMLIMIT.
+	InterBlockCacheSize uint `mapstructure:"inter-block-cache-size"`
+
 	// IndexEvents defines the set of events in the form {eventType}.{attributeKey},
 	// which informs CometBFT what to index. If empty, all events will be indexed.
 	IndexEvents []string `mapstructure:"index-events"`
@@ -93,6 +107,14 @@ type BaseConfig struct {
 	AppDBBackend string `mapstructure:"app-db-backend"`
 }
 
+// PruningOverride defines the pruning options of a store overriding the ones of
+// the app, see BaseConfig.
+type PruningOverride struct {
+	Pruning           string `mapstructure:"pruning"`
+	PruningKeepRecent string `mapstructure:"pruning-keep-recent"`
+	PruningInterval   string `mapstructure:"pruning-interval"`
+}
+
 // APIConfig defines the API listener configuration.
 type APIConfig struct {
 	// Enable defines if the API server should be enabled.
@@ -156,6 +178,11 @@ type StateSyncConfig struct {
 	// SnapshotKeepRecent sets the number of recent state sync snapshots to keep.
 	// 0 keeps all snapshots.
 	SnapshotKeepRecent uint32 `mapstructure:"snapshot-keep-recent"`
+
+	// ArchivedQueries serves the queries at a pruned height by restoring the
+	// snapshot taken at that height, if any, on disk. Restoring a snapshot is
+	// expensive, so they are disabled by default.
+	ArchivedQueries bool `mapstructure:"archived-queries"`
 }
 
 // MempoolConfig defines the configurations for the SDK built-in app-side mempool
@@ -172,7 +199,8 @@ type MempoolConfig struct {
 type (
 	// StreamingConfig defines application configuration for external streaming services
 	StreamingConfig struct {
-		ABCI ABCIListenerConfig `mapstructure:"abci"`
+		ABCI ABCIListenerConfig  `mapstructure:"abci"`
+		Sink StreamingSinkConfig `mapstructure:"sink"`
 	}
 	// ABCIListenerConfig defines application configuration for ABCIListener streaming service
 	ABCIListenerConfig struct {
@@ -180,6 +208,14 @@ type (
 		Plugin        string   `mapstructure:"plugin"`
 		StopNodeOnErr bool     `mapstructure:"stop-node-on-err"`
 	}
+	// StreamingSinkConfig defines application configuration for the built-in streaming sinks
+	StreamingSinkConfig struct {
+		Type        string   `mapstructure:"type"`
+		Address     string   `mapstructure:"address"`
+		Topic       string   `mapstructure:"topic"`
+		Keys        []string `mapstructure:"keys"`
+		AtLeastOnce bool     `mapstructure:"at-least-once"`
+	}
 )
 
 // Config defines the server's top level configuration
@@ -219,17 +255,19 @@ func (c *Config) GetMinGasPrices() sdk.DecCoins {
 func DefaultConfig() *Config {
 	return &Config{
 		BaseConfig: BaseConfig{
-			MinGasPrices:        defaultMinGasPrices,
-			QueryGasLimit:       0,
-			InterBlockCache:     true,
-			Pruning:             pruningtypes.PruningOptionDefault,
-			PruningKeepRecent:   "0",
-			PruningInterval:     "0",
-			MinRetainBlocks:     0,
-			IndexEvents:         make([]string, 0),
-			IAVLCacheSize:       781250,
-			IAVLDisableFastNode: false,
-			AppDBBackend:        "",
+			MinGasPrices:           defaultMinGasPrices,
+			QueryGasLimit:          0,
+			HistoricalQueryEnabled: true,
+			InterBlockCache:        true,
+			InterBlockCacheSize:    interblockcache.DefaultCacheSize,
+			Pruning:                pruningtypes.PruningOptionDefault,
+			PruningKeepRecent:      "0",
+			PruningInterval:        "0",
+			MinRetainBlocks:        0,
+			IndexEvents:            make([]string, 0),
+			IAVLCacheSize:          781250,
+			IAVLDisableFastNode:    false,
+			AppDBBackend:           "",
 		},
 		Telemetry: telemetry.Config{
 			Enabled:      false,
@@ -261,6 +299,9 @@ func DefaultConfig() *Config {
 				Keys:          []string{},
 				StopNodeOnErr: true,
 			},
+			Sink: StreamingSinkConfig{
+				Keys: []string{},
+			},
 		},
 		Mempool: MempoolConfig{
 			MaxTxs: 5_000,
@@ -287,6 +328,13 @@ func (c Config) ValidateBasic() error {
 			"cannot enable state sync snapshots with '%s' pruning setting", pruningtypes.PruningOptionEverything,
 		)
 	}
+	for storeKey, override := range c.PruningOverrides {
+		if override.Pruning == pruningtypes.PruningOptionEverything && c.StateSync.SnapshotInterval > 0 {
+			return sdkerrors.ErrAppConfig.Wrapf(
+				"cannot enable state sync snapshots with '%s' pruning setting of store %s", pruningtypes.PruningOptionEverything, storeKey,
+			)
+		}
+	}
 
 	return nil
 }
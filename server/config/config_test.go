@@ -52,6 +52,13 @@ func TestStreamingConfig(t *testing.T) {
 				Plugin:        "plugin-A",
 				StopNodeOnErr: false,
 			},
+			Sink: StreamingSinkConfig{
+				Type:        "nats-core",
+				Address:     "nats://localhost:4222",
+				Topic:       "blocks",
+				Keys:        []string{"bank"},
+				AtLeastOnce: true,
+			},
 		},
 	}
 
@@ -69,6 +76,11 @@ func TestStreamingConfig(t *testing.T) {
 		`keys = ["one", "two", ]`,
 		`plugin = "plugin-A"`,
 		`stop-node-on-err = false`,
+		`type = "nats-core"`,
+		`address = "nats://localhost:4222"`,
+		`topic = "blocks"`,
+		`keys = ["bank", ]`,
+		`at-least-once = true`,
 	}
 
 	for _, line := range expectedLines {
@@ -149,6 +161,35 @@ func TestIndexEventsWriteRead(t *testing.T) {
 	require.Equal(t, expected, actual, "config value")
 }
 
+func TestPruningOverridesWriteRead(t *testing.T) {
+	expected := map[string]PruningOverride{
+		"wasm": {Pruning: "custom", PruningKeepRecent: "100", PruningInterval: "10"},
+		"bank": {Pruning: "nothing", PruningKeepRecent: "0", PruningInterval: "0"},
+	}
+
+	// Create config with two PruningOverrides entries, and write it to a file.
+	confFile := filepath.Join(t.TempDir(), "app.toml")
+	conf := DefaultConfig()
+	conf.PruningOverrides = expected
+	err := WriteConfigFile(confFile, conf)
+	require.NoError(t, err)
+
+	// Read that file into viper.
+	vpr := viper.New()
+	vpr.SetConfigFile(confFile)
+	rerr := vpr.ReadInConfig()
+	require.NoError(t, rerr, "reading config file into viper")
+	require.Equal(t, "100", vpr.GetString("pruning-overrides.wasm.pruning-keep-recent"), "viper value")
+
+	// The settings following the overrides are not part of them.
+	require.Equal(t, conf.Telemetry.ServiceName, vpr.GetString("telemetry.service-name"), "viper value")
+
+	// Check that it is parsed into the config correctly.
+	cfg, perr := ParseConfig(vpr)
+	require.NoError(t, perr, "parsing config")
+	require.Equal(t, expected, cfg.PruningOverrides, "config value")
+}
+
 func TestGlobalLabelsEventsMarshalling(t *testing.T) {
 	expectedIn := `global-labels = [
   ["labelname1", "labelvalue1"],
@@ -25,6 +25,11 @@ minimum-gas-prices = "{{ .BaseConfig.MinGasPrices }}"
 # If this is set to zero, the query can consume an unbounded amount of gas.
 query-gas-limit = "{{ .BaseConfig.QueryGasLimit }}"
 
+# historical-query-enabled enables the queries at a height other than the latest one.
+# The state at a pruned height is served from the state sync snapshot taken at that
+# height, if still archived.
+historical-query-enabled = {{ .BaseConfig.HistoricalQueryEnabled }}
+
 # default: the last 362880 states are kept, pruning at 10 block intervals
 # nothing: all historic states will be saved, nothing will be deleted (i.e. archiving node)
 # everything: 2 latest states will be kept; pruning at 10 block intervals.
@@ -67,6 +72,12 @@ min-retain-blocks = {{ .BaseConfig.MinRetainBlocks }}
 # InterBlockCache enables inter-block caching.
 inter-block-cache = {{ .BaseConfig.InterBlockCache }}
 
+# InterBlockCacheSize is the maximum number of entries of the inter-block cache
+# of each store. The caches shrink when the memory used by the node gets close
+# to the Go runtime soft memory limit, i.e. the GOMEMLIMIT environment variable,
+# and grow back once the memory pressure is low.
+inter-block-cache-size = {{ .BaseConfig.InterBlockCacheSize }}
+
 # IndexEvents defines the set of events in the form {eventType}.{attributeKey},
 # which informs CometBFT what to index. If empty, all events will be indexed.
 #
@@ -86,6 +97,26 @@ iavl-disable-fastnode = {{ .BaseConfig.IAVLDisableFastNode }}
 # The fallback is the db_backend value set in CometBFT's config.toml.
 app-db-backend = "{{ .BaseConfig.AppDBBackend }}"
 
+###############################################################################
+###                      Pruning Overrides Configuration                    ###
+###############################################################################
+
+# The pruning options above can be overridden for individual stores by store key,
+# e.g. to keep fewer states of a large store, with the same settings.
+#
+# Example:
+# [pruning-overrides.wasm]
+# pruning = "custom"
+# pruning-keep-recent = "100"
+# pruning-interval = "10"
+{{- range $storeKey, $override := .BaseConfig.PruningOverrides }}
+
+[pruning-overrides.{{ $storeKey }}]
+pruning = "{{ $override.Pruning }}"
+pruning-keep-recent = "{{ $override.PruningKeepRecent }}"
+pruning-interval = "{{ $override.PruningInterval }}"
+{{- end }}
+
 ###############################################################################
 ###                         Telemetry Configuration                         ###
 ###############################################################################
@@ -208,6 +239,11 @@ snapshot-interval = {{ .StateSync.SnapshotInterval }}
 # snapshot-keep-recent specifies the number of recent snapshots to keep and serve (0 to keep all).
 snapshot-keep-recent = {{ .StateSync.SnapshotKeepRecent }}
 
+# archived-queries serves the queries at a pruned height by restoring the snapshot taken at that
+# height, if any, on disk. Restoring a snapshot is expensive: a single snapshot is restored at once,
+# at most once a minute.
+archived-queries = {{ .StateSync.ArchivedQueries }}
+
 ###############################################################################
 ###                              State Streaming                            ###
 ###############################################################################
@@ -234,6 +270,32 @@ plugin = "{{ .Streaming.ABCI.Plugin }}"
 # stop-node-on-err specifies whether to stop the node on message delivery error.
 stop-node-on-err = {{ .Streaming.ABCI.StopNodeOnErr }}
 
+# streaming.sink specifies the configuration for the built-in streaming sinks, publishing
+# the state changes of each committed block.
+[streaming.sink]
+
+# The type of the sink: "file", "nats-core" (core NATS, without JetStream persistence) or
+# "kafka-rest-proxy" (Kafka through a Kafka REST proxy v2).
+# Streaming to a sink is only enabled if this is set.
+type = "{{ .Streaming.Sink.Type }}"
+
+# The address of the sink: the output directory for "file", the NATS server address
+# (e.g. "nats://localhost:4222") for "nats-core", or the Kafka REST proxy URL
+# (e.g. "http://localhost:8082") for "kafka-rest-proxy".
+address = "{{ .Streaming.Sink.Address }}"
+
+# The NATS subject or Kafka topic to publish to.
+topic = "{{ .Streaming.Sink.Topic }}"
+
+# List of kv store keys to stream out to the sink, ["*"] or [] to expose all keys.
+keys = [{{ range .Streaming.Sink.Keys }}{{ printf "%q, " . }}{{end}}]
+
+# The blocks are delivered in the background. Without at-least-once, at most 100 blocks wait
+# for their delivery, and the blocks which couldn't be delivered are dropped.
+# at-least-once persists the blocks until their delivery, so that the blocks which
+# couldn't be delivered are retried, including after a restart.
+at-least-once = {{ .Streaming.Sink.AtLeastOnce }}
+
 ###############################################################################
 ###                         Mempool                                         ###
 ###############################################################################
@@ -0,0 +1,276 @@
+package server
+
+import (
+	"bytes"
+	"errors"
+
+	dbm "github.com/cosmos/cosmos-db"
+)
+
+// overlay value prefixes, distinguishing the deleted keys from the written ones
+const (
+	overlayDeleted byte = iota
+	overlayWritten
+)
+
+var errNilValue = errors.New("value cannot be nil")
+
+var _ dbm.DB = (*overlayDB)(nil)
+
+// overlayDB is a database keeping the writes to a parent database in memory,
+// leaving the parent untouched. Closing it closes the parent.
+type overlayDB struct {
+	parent  dbm.DB
+	overlay *dbm.MemDB
+}
+
+func newOverlayDB(parent dbm.DB) *overlayDB {
+	return &overlayDB{parent: parent, overlay: dbm.NewMemDB()}
+}
+
+func (db *overlayDB) Get(key []byte) ([]byte, error) {
+	bz, err := db.overlay.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	if bz != nil {
+		if bz[0] == overlayDeleted {
+			return nil, nil
+		}
+		return bz[1:], nil
+	}
+
+	return db.parent.Get(key)
+}
+
+func (db *overlayDB) Has(key []byte) (bool, error) {
+	bz, err := db.Get(key)
+	return bz != nil, err
+}
+
+func (db *overlayDB) Set(key, value []byte) error {
+	if value == nil {
+		return errNilValue
+	}
+
+	return db.overlay.Set(key, append([]byte{overlayWritten}, value...))
+}
+
+func (db *overlayDB) SetSync(key, value []byte) error {
+	return db.Set(key, value)
+}
+
+func (db *overlayDB) Delete(key []byte) error {
+	return db.overlay.Set(key, []byte{overlayDeleted})
+}
+
+func (db *overlayDB) DeleteSync(key []byte) error {
+	return db.Delete(key)
+}
+
+func (db *overlayDB) Iterator(start, end []byte) (dbm.Iterator, error) {
+	return db.newIterator(start, end, false)
+}
+
+func (db *overlayDB) ReverseIterator(start, end []byte) (dbm.Iterator, error) {
+	return db.newIterator(start, end, true)
+}
+
+func (db *overlayDB) newIterator(start, end []byte, reverse bool) (dbm.Iterator, error) {
+	var (
+		parent, overlay dbm.Iterator
+		err             error
+	)
+	if reverse {
+		parent, err = db.parent.ReverseIterator(start, end)
+	} else {
+		parent, err = db.parent.Iterator(start, end)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if reverse {
+		overlay, err = db.overlay.ReverseIterator(start, end)
+	} else {
+		overlay, err = db.overlay.Iterator(start, end)
+	}
+	if err != nil {
+		parent.Close()
+		return nil, err
+	}
+
+	it := &overlayIterator{parent: parent, overlay: overlay, reverse: reverse}
+	it.skipDeleted()
+	return it, nil
+}
+
+func (db *overlayDB) Close() error {
+	return db.parent.Close()
+}
+
+func (db *overlayDB) NewBatch() dbm.Batch {
+	return &overlayBatch{db: db}
+}
+
+func (db *overlayDB) NewBatchWithSize(int) dbm.Batch {
+	return db.NewBatch()
+}
+
+func (db *overlayDB) Print() error {
+	return db.overlay.Print()
+}
+
+func (db *overlayDB) Stats() map[string]string {
+	return db.parent.Stats()
+}
+
+// overlayIterator merges the iterators of the parent database and of the
+// overlay, the overlay taking precedence.
+type overlayIterator struct {
+	parent  dbm.Iterator
+	overlay dbm.Iterator
+	reverse bool
+}
+
+var _ dbm.Iterator = (*overlayIterator)(nil)
+
+func (it *overlayIterator) Domain() ([]byte, []byte) {
+	return it.parent.Domain()
+}
+
+func (it *overlayIterator) Valid() bool {
+	return it.parent.Valid() || it.overlay.Valid()
+}
+
+// compare compares the current keys of the parent and of the overlay in the
+// iteration order, the exhausted iterators coming last.
+func (it *overlayIterator) compare() int {
+	switch {
+	case !it.parent.Valid():
+		return 1
+	case !it.overlay.Valid():
+		return -1
+	}
+
+	cmp := bytes.Compare(it.parent.Key(), it.overlay.Key())
+	if it.reverse {
+		return -cmp
+	}
+	return cmp
+}
+
+func (it *overlayIterator) Next() {
+	switch cmp := it.compare(); {
+	case cmp < 0:
+		it.parent.Next()
+	case cmp > 0:
+		it.overlay.Next()
+	default:
+		it.parent.Next()
+		it.overlay.Next()
+	}
+	it.skipDeleted()
+}
+
+// skipDeleted moves the iterator past the keys deleted in the overlay.
+func (it *overlayIterator) skipDeleted() {
+	for it.Valid() {
+		cmp := it.compare()
+		if cmp < 0 || it.overlay.Value()[0] != overlayDeleted {
+			return
+		}
+
+		if cmp == 0 {
+			it.parent.Next()
+		}
+		it.overlay.Next()
+	}
+}
+
+func (it *overlayIterator) Key() []byte {
+	if it.compare() < 0 {
+		return it.parent.Key()
+	}
+	return it.overlay.Key()
+}
+
+func (it *overlayIterator) Value() []byte {
+	if it.compare() < 0 {
+		return it.parent.Value()
+	}
+	return it.overlay.Value()[1:]
+}
+
+func (it *overlayIterator) Error() error {
+	if err := it.parent.Error(); err != nil {
+		return err
+	}
+	return it.overlay.Error()
+}
+
+func (it *overlayIterator) Close() error {
+	err := it.parent.Close()
+	if overlayErr := it.overlay.Close(); err == nil {
+		err = overlayErr
+	}
+	return err
+}
+
+// overlayBatch buffers writes to an overlayDB.
+type overlayBatch struct {
+	db   *overlayDB
+	ops  []overlayOp
+	size int
+}
+
+type overlayOp struct {
+	key   []byte
+	value []byte // nil for a delete
+}
+
+func (b *overlayBatch) Set(key, value []byte) error {
+	if value == nil {
+		return errNilValue
+	}
+
+	b.ops = append(b.ops, overlayOp{key: bytes.Clone(key), value: bytes.Clone(value)})
+	b.size += len(key) + len(value)
+	return nil
+}
+
+func (b *overlayBatch) Delete(key []byte) error {
+	b.ops = append(b.ops, overlayOp{key: bytes.Clone(key)})
+	b.size += len(key)
+	return nil
+}
+
+func (b *overlayBatch) Write() error {
+	for _, op := range b.ops {
+		var err error
+		if op.value == nil {
+			err = b.db.Delete(op.key)
+		} else {
+			err = b.db.Set(op.key, op.value)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	b.ops = nil
+	b.size = 0
+
+	return nil
+}
+
+func (b *overlayBatch) WriteSync() error {
+	return b.Write()
+}
+
+func (b *overlayBatch) Close() error {
+	b.ops = nil
+	return nil
+}
+
+func (b *overlayBatch) GetByteSize() (int, error) {
+	return b.size, nil
+}
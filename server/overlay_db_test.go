@@ -0,0 +1,61 @@
+package server
+
+import (
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOverlayDB(t *testing.T) {
+	parent := dbm.NewMemDB()
+	for _, key := range []string{"a", "b", "c", "d"} {
+		require.NoError(t, parent.Set([]byte(key), []byte("parent-"+key)))
+	}
+
+	db := newOverlayDB(parent)
+	require.NoError(t, db.Set([]byte("b"), []byte("overlay-b")))
+	require.NoError(t, db.Set([]byte("e"), []byte("overlay-e")))
+	require.NoError(t, db.Delete([]byte("c")))
+	batch := db.NewBatch()
+	require.NoError(t, batch.Delete([]byte("a")))
+	require.NoError(t, batch.Set([]byte("0"), []byte{}))
+	require.NoError(t, batch.Write())
+	require.NoError(t, batch.Close())
+
+	// the parent is untouched
+	value, err := parent.Get([]byte("b"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("parent-b"), value)
+	has, err := parent.Has([]byte("a"))
+	require.NoError(t, err)
+	require.True(t, has)
+
+	expected := map[string]string{"0": "", "b": "overlay-b", "d": "parent-d", "e": "overlay-e"}
+	for _, key := range []string{"0", "a", "b", "c", "d", "e", "f"} {
+		value, err := db.Get([]byte(key))
+		require.NoError(t, err)
+		expectedValue, ok := expected[key]
+		if !ok {
+			require.Nil(t, value, key)
+			continue
+		}
+		require.Equal(t, []byte(expectedValue), value, key)
+	}
+
+	iterate := func(it dbm.Iterator, err error) []string {
+		require.NoError(t, err)
+		defer it.Close()
+
+		var entries []string
+		for ; it.Valid(); it.Next() {
+			entries = append(entries, string(it.Key())+"="+string(it.Value()))
+		}
+		require.NoError(t, it.Error())
+		return entries
+	}
+	require.Equal(t, []string{"0=", "b=overlay-b", "d=parent-d", "e=overlay-e"}, iterate(db.Iterator(nil, nil)))
+	require.Equal(t, []string{"e=overlay-e", "d=parent-d", "b=overlay-b", "0="}, iterate(db.ReverseIterator(nil, nil)))
+	require.Equal(t, []string{"b=overlay-b", "d=parent-d"}, iterate(db.Iterator([]byte("a"), []byte("e"))))
+	require.Equal(t, []string{"d=parent-d", "b=overlay-b"}, iterate(db.ReverseIterator([]byte("a"), []byte("e"))))
+}
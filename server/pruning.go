@@ -15,7 +15,45 @@ import (
 // PruningOptions. If a pruning strategy is provided, that will be parsed and
 // returned, otherwise, it is assumed custom pruning options are provided.
 func GetPruningOptionsFromFlags(appOpts types.AppOptions) (pruningtypes.PruningOptions, error) {
-	strategy := strings.ToLower(cast.ToString(appOpts.Get(FlagPruning)))
+	return parsePruningOptions(
+		appOpts.Get(FlagPruning),
+		appOpts.Get(FlagPruningKeepRecent),
+		appOpts.Get(FlagPruningInterval),
+	)
+}
+
+// GetPruningOverridesFromFlags parses the pruning options of individual stores,
+// by store key name, configured in the pruning-overrides tables of app.toml. Each
+// table has the same pruning, pruning-keep-recent and pruning-interval settings
+// as the app.
+func GetPruningOverridesFromFlags(appOpts types.AppOptions) (map[string]pruningtypes.PruningOptions, error) {
+	tables := cast.ToStringMap(appOpts.Get(FlagPruningOverrides))
+	if len(tables) == 0 {
+		return nil, nil
+	}
+
+	overrides := make(map[string]pruningtypes.PruningOptions, len(tables))
+	for storeKey, table := range tables {
+		settings := cast.ToStringMap(table)
+		opts, err := parsePruningOptions(
+			settings[FlagPruning],
+			settings[FlagPruningKeepRecent],
+			settings[FlagPruningInterval],
+		)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pruning overrides of store %s: %w", storeKey, err)
+		}
+
+		overrides[storeKey] = opts
+	}
+
+	return overrides, nil
+}
+
+// parsePruningOptions returns the PruningOptions of the given pruning strategy,
+// keep recent and interval settings, which are only used by the custom strategy.
+func parsePruningOptions(pruning, keepRecent, interval any) (pruningtypes.PruningOptions, error) {
+	strategy := strings.ToLower(cast.ToString(pruning))
 
 	switch strategy {
 	case pruningtypes.PruningOptionDefault, pruningtypes.PruningOptionNothing, pruningtypes.PruningOptionEverything:
@@ -23,8 +61,8 @@ func GetPruningOptionsFromFlags(appOpts types.AppOptions) (pruningtypes.PruningO
 
 	case pruningtypes.PruningOptionCustom:
 		opts := pruningtypes.NewCustomPruningOptions(
-			cast.ToUint64(appOpts.Get(FlagPruningKeepRecent)),
-			cast.ToUint64(appOpts.Get(FlagPruningInterval)),
+			cast.ToUint64(keepRecent),
+			cast.ToUint64(interval),
 		)
 
 		if err := opts.Validate(); err != nil {
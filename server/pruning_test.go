@@ -1,6 +1,7 @@
 package server
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/spf13/viper"
@@ -66,3 +67,70 @@ func TestGetPruningOptionsFromFlags(t *testing.T) {
 		})
 	}
 }
+
+func TestGetPruningOverridesFromFlags(t *testing.T) {
+	tests := []struct {
+		name              string
+		config            string
+		expectedOverrides map[string]pruningtypes.PruningOptions
+		wantErr           bool
+	}{
+		{
+			name:   "no overrides",
+			config: `pruning = "default"`,
+		},
+		{
+			name: "overrides",
+			config: `
+[pruning-overrides.wasm]
+pruning = "custom"
+pruning-keep-recent = "100"
+pruning-interval = "10"
+
+[pruning-overrides.bank]
+pruning = "nothing"
+`,
+			expectedOverrides: map[string]pruningtypes.PruningOptions{
+				"wasm": pruningtypes.NewCustomPruningOptions(100, 10),
+				"bank": pruningtypes.NewPruningOptions(pruningtypes.PruningNothing),
+			},
+		},
+		{
+			name: "invalid custom pruning options",
+			config: `
+[pruning-overrides.wasm]
+pruning = "custom"
+pruning-keep-recent = "100"
+pruning-interval = "0"
+`,
+			wantErr: true,
+		},
+		{
+			name: "unknown pruning strategy",
+			config: `
+[pruning-overrides.wasm]
+pruning = "sometimes"
+`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			v := viper.New()
+			v.SetConfigType("toml")
+			require.NoError(t, v.ReadConfig(strings.NewReader(tt.config)))
+
+			overrides, err := GetPruningOverridesFromFlags(v)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedOverrides, overrides)
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package server
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/baseapp/storeexport"
+	"github.com/cosmos/cosmos-sdk/server/types"
+)
+
+const flagExportStoreHeight = "height"
+
+// storeImportersProvider is implemented by the applications whose modules
+// migrate their stores while they are imported, see module.HasStoreImporters.
+type storeImportersProvider interface {
+	StoreImporters() (map[string]storeexport.Importer, error)
+}
+
+// ExportStoreToFileCmd creates a command exporting the stores of the
+// application to a file, to be imported with ImportStoreFromFileCmd.
+func ExportStoreToFileCmd[T types.Application](appCreator types.AppCreator[T]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-store <file>",
+		Short: "Export the stores of the application to a file",
+		Long: `Export the stores of the application at a height to a file, to be imported with the
+import-store command, e.g. into a new chain. Unlike the genesis export, the state is streamed
+from the stores, without going through the JSON genesis of the modules. The node must be stopped.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := GetServerContextFromCmd(cmd)
+
+			height, err := cmd.Flags().GetInt64(flagExportStoreHeight)
+			if err != nil {
+				return err
+			}
+
+			db, err := OpenDB(serverCtx.Config.RootDir, GetAppDBBackend(serverCtx.Viper))
+			if err != nil {
+				return err
+			}
+			app := appCreator(serverCtx.Logger, db, nil, serverCtx.Viper)
+			defer app.Close()
+
+			if height == 0 {
+				height = app.CommitMultiStore().LastCommitID().Version
+			}
+
+			file, err := os.Create(args[0])
+			if err != nil {
+				return err
+			}
+			if err := storeexport.Export(file, app.CommitMultiStore(), uint64(height)); err != nil {
+				file.Close()
+				return fmt.Errorf("failed to export the stores at height %d: %w", height, err)
+			}
+			if err := file.Close(); err != nil {
+				return err
+			}
+
+			cmd.Printf("Exported the stores at height %d to %s\n", height, args[0])
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(flagExportStoreHeight, 0, "Height to export, defaults to the latest height")
+
+	return cmd
+}
+
+// ImportStoreFromFileCmd creates a command importing the stores exported by
+// ExportStoreToFileCmd into the empty database of the node.
+func ImportStoreFromFileCmd[T types.Application](appCreator types.AppCreator[T]) *cobra.Command {
+	return &cobra.Command{
+		Use:   "import-store <file>",
+		Short: "Import the stores of the application from a file",
+		Long: `Import the stores exported by the export-store command into the empty database of the
+node, at the exported height. The IAVL trees are imported as is, unless the stores are migrated
+by their modules.
+
+The chain then starts from the imported state instead of initializing it from the genesis of the
+modules: the initial_height of the genesis file must be the imported height plus one, and its
+app_hash the app hash printed by the command.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := GetServerContextFromCmd(cmd)
+
+			db, err := OpenDB(serverCtx.Config.RootDir, GetAppDBBackend(serverCtx.Viper))
+			if err != nil {
+				return err
+			}
+			// the stores are imported into the IAVL stores, not their caches
+			serverCtx.Viper.Set(FlagInterBlockCache, false)
+			app := appCreator(serverCtx.Logger, db, nil, serverCtx.Viper)
+			defer app.Close()
+
+			var importers map[string]storeexport.Importer
+			if provider, ok := any(app).(storeImportersProvider); ok {
+				if importers, err = provider.StoreImporters(); err != nil {
+					return err
+				}
+			}
+
+			file, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			height, err := storeexport.Import(file, app.CommitMultiStore(), db, importers)
+			if err != nil {
+				return fmt.Errorf("failed to import the stores: %w", err)
+			}
+
+			cmd.Printf("Imported the stores at height %d, app hash %X\n", height, app.CommitMultiStore().LastCommitID().Hash)
+			return nil
+		},
+	}
+}
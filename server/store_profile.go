@@ -0,0 +1,234 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/store"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/baseapp/storeprofile"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server/types"
+)
+
+const (
+	flagProfileBlocks  = "blocks"
+	flagProfileHotKeys = "hot-keys"
+)
+
+// cacheMultiStoreWrapperSetter is implemented by the applications embedding a
+// BaseApp.
+type cacheMultiStoreWrapperSetter interface {
+	SetCacheMultiStoreWrapper(func(storetypes.CacheMultiStore) storetypes.CacheMultiStore)
+}
+
+// StoreProfileCmd creates a command profiling the usage of the stores of the
+// application by replaying the recent blocks of the node.
+func StoreProfileCmd[T types.Application](appCreator types.AppCreator[T]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "store-profile",
+		Short: "Profile the usage of the stores of the application by replaying the recent blocks",
+		Long: `Profile the usage of the stores of the application by replaying the recent blocks
+of the node against its database, e.g. to plan the pruning and the sharding of the state.
+
+The application state is rolled back to the height preceding the replayed blocks, which
+must not be pruned, and the blocks are executed again. The writes are kept in memory, the
+node's database is left untouched. The node must be stopped.
+
+For each store, the command reports the number of reads, writes, deletes and iterated
+keys, the most accessed keys and the histogram of the sizes of the values read and written.
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := GetServerContextFromCmd(cmd)
+
+			blocks, err := cmd.Flags().GetInt64(flagProfileBlocks)
+			if err != nil {
+				return err
+			}
+			if blocks <= 0 {
+				return fmt.Errorf("the number of blocks to replay must be positive, got %d", blocks)
+			}
+			hotKeys, err := cmd.Flags().GetInt(flagProfileHotKeys)
+			if err != nil {
+				return err
+			}
+			output, err := cmd.Flags().GetString(flags.FlagOutput)
+			if err != nil {
+				return err
+			}
+
+			report, err := profileStores(serverCtx, appCreator, blocks, hotKeys)
+			if err != nil {
+				return err
+			}
+
+			if output == flags.OutputFormatJSON {
+				bz, err := json.MarshalIndent(report, "", "  ")
+				if err != nil {
+					return err
+				}
+				cmd.Println(string(bz))
+				return nil
+			}
+
+			return printStoreProfile(cmd.OutOrStdout(), report)
+		},
+	}
+
+	cmd.Flags().Int64(flagProfileBlocks, 100, "Number of recent blocks to replay")
+	cmd.Flags().Int(flagProfileHotKeys, 10, "Number of most accessed keys to report per store")
+	cmd.Flags().StringP(flags.FlagOutput, "o", flags.OutputFormatText, "Output format (text|json)")
+
+	return cmd
+}
+
+// profileStores replays the given number of recent blocks, writing to an
+// in-memory overlay of the application database, and returns the profile of
+// the stores.
+func profileStores[T types.Application](serverCtx *Context, appCreator types.AppCreator[T], blocks int64, hotKeys int) (storeprofile.Report, error) {
+	cfg := serverCtx.Config
+
+	blockStoreDB, err := cmtcfg.DefaultDBProvider(&cmtcfg.DBContext{ID: "blockstore", Config: cfg})
+	if err != nil {
+		return storeprofile.Report{}, err
+	}
+	blockStore := store.NewBlockStore(blockStoreDB)
+	defer blockStore.Close()
+
+	stateDB, err := cmtcfg.DefaultDBProvider(&cmtcfg.DBContext{ID: "state", Config: cfg})
+	if err != nil {
+		return storeprofile.Report{}, err
+	}
+	stateStore := sm.NewStore(stateDB, sm.StoreOptions{DiscardABCIResponses: cfg.Storage.DiscardABCIResponses})
+	defer stateStore.Close()
+
+	state, err := stateStore.Load()
+	if err != nil {
+		return storeprofile.Report{}, err
+	}
+
+	db, err := OpenDB(cfg.RootDir, GetAppDBBackend(serverCtx.Viper))
+	if err != nil {
+		return storeprofile.Report{}, err
+	}
+
+	// the replay must neither prune the state, nor write outside of the overlay,
+	// nor read the fast nodes of IAVL, which index the latest state only
+	appOpts := serverCtx.Viper
+	appOpts.Set(FlagPruning, pruningtypes.PruningOptionNothing)
+	appOpts.Set(FlagPruningOverrides, nil)
+	appOpts.Set(FlagStateSyncSnapshotInterval, 0)
+	appOpts.Set(FlagHaltHeight, 0)
+	appOpts.Set(FlagHaltTime, 0)
+	appOpts.Set(FlagInterBlockCache, false)
+	appOpts.Set(FlagDisableIAVLFastNode, true)
+	for service := range cast.ToStringMap(appOpts.Get(baseapp.StreamingTomlKey)) {
+		appOpts.Set(fmt.Sprintf("%s.%s.%s", baseapp.StreamingTomlKey, service, baseapp.StreamingABCIPluginTomlKey), "")
+	}
+	appOpts.Set(fmt.Sprintf("%s.%s.%s", baseapp.StreamingTomlKey, baseapp.StreamingSinkTomlKey, baseapp.StreamingSinkTypeTomlKey), "")
+
+	app := appCreator(serverCtx.Logger, newOverlayDB(db), nil, appOpts)
+	defer app.Close()
+
+	wrapperSetter, ok := any(app).(cacheMultiStoreWrapperSetter)
+	if !ok {
+		return storeprofile.Report{}, fmt.Errorf("cannot instrument the stores of %T", app)
+	}
+
+	toHeight := min(app.CommitMultiStore().LastCommitID().Version, blockStore.Height())
+	// the initial block is executed after InitChain, which cannot be replayed
+	fromHeight := max(toHeight-blocks+1, blockStore.Base(), state.InitialHeight+1)
+	if fromHeight > toHeight {
+		return storeprofile.Report{}, fmt.Errorf("no block to replay, the latest height is %d", toHeight)
+	}
+
+	if err := app.CommitMultiStore().RollbackToVersion(fromHeight - 1); err != nil {
+		return storeprofile.Report{}, fmt.Errorf("failed to load the state at height %d, it may have been pruned: %w", fromHeight-1, err)
+	}
+
+	profiler := storeprofile.NewProfiler()
+	wrapperSetter.SetCacheMultiStoreWrapper(profiler.Wrap)
+
+	for height := fromHeight; height <= toHeight; height++ {
+		block := blockStore.LoadBlock(height)
+		if block == nil {
+			return storeprofile.Report{}, fmt.Errorf("block %d not found", height)
+		}
+		lastValSet, err := stateStore.LoadValidators(height - 1)
+		if err != nil {
+			return storeprofile.Report{}, err
+		}
+
+		if _, err := app.FinalizeBlock(&abci.RequestFinalizeBlock{
+			Txs:                block.Txs.ToSliceOfBytes(),
+			DecidedLastCommit:  sm.BuildLastCommitInfo(block, lastValSet, state.InitialHeight),
+			Misbehavior:        block.Evidence.Evidence.ToABCI(),
+			Hash:               block.Hash(),
+			Height:             height,
+			Time:               block.Time,
+			NextValidatorsHash: block.NextValidatorsHash,
+			ProposerAddress:    block.ProposerAddress,
+		}); err != nil {
+			return storeprofile.Report{}, fmt.Errorf("failed to replay block %d: %w", height, err)
+		}
+		if _, err := app.Commit(); err != nil {
+			return storeprofile.Report{}, fmt.Errorf("failed to commit block %d: %w", height, err)
+		}
+
+		// the app hash of a block is in the header of the next one
+		if next := blockStore.LoadBlockMeta(height + 1); next != nil {
+			if appHash := app.CommitMultiStore().LastCommitID().Hash; !bytes.Equal(appHash, next.Header.AppHash) {
+				serverCtx.Logger.Warn("replayed block diverged from the chain, the profile may be inaccurate",
+					"height", height, "app_hash", fmt.Sprintf("%X", appHash), "expected", next.Header.AppHash.String())
+			}
+		}
+	}
+
+	report := profiler.Report(hotKeys)
+	report.FromHeight, report.ToHeight = fromHeight, toHeight
+	return report, nil
+}
+
+// printStoreProfile prints a store profile as text.
+func printStoreProfile(w io.Writer, report storeprofile.Report) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintf(tw, "Store usage of blocks %d to %d\n\n", report.FromHeight, report.ToHeight)
+	fmt.Fprintln(tw, "STORE\tREADS\tWRITES\tDELETES\tITERATED KEYS\tDISTINCT KEYS")
+	for _, s := range report.Stores {
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%d\t%d\t%d\n", s.StoreKey, s.Reads, s.Writes, s.Deletes, s.IteratedKeys, s.DistinctKeys)
+	}
+
+	for _, s := range report.Stores {
+		fmt.Fprintf(tw, "\n%s\n", s.StoreKey)
+		if len(s.HotKeys) > 0 {
+			fmt.Fprintln(tw, "  HOT KEY\tREADS\tWRITES")
+			for _, k := range s.HotKeys {
+				fmt.Fprintf(tw, "  %s\t%d\t%d\n", k.Key, k.Reads, k.Writes)
+			}
+		}
+		fmt.Fprintln(tw, "  VALUE SIZE\tCOUNT")
+		for _, b := range s.ValueSizes {
+			label := fmt.Sprintf("<= %d B", b.MaxSize)
+			if b.MaxSize == 0 {
+				label = "larger"
+			}
+			fmt.Fprintf(tw, "  %s\t%d\n", label, b.Count)
+		}
+	}
+
+	return tw.Flush()
+}
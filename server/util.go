@@ -25,12 +25,12 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"cosmossdk.io/log"
-	"cosmossdk.io/store"
 	"cosmossdk.io/store/snapshots"
 	snapshottypes "cosmossdk.io/store/snapshots/types"
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/baseapp/interblockcache"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	"github.com/cosmos/cosmos-sdk/server/types"
@@ -486,7 +486,9 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 	var cache storetypes.MultiStorePersistentCache
 
 	if cast.ToBool(appOpts.Get(FlagInterBlockCache)) {
-		cache = store.NewCommitKVStoreCacheManager()
+		cache = interblockcache.NewManager(interblockcache.Config{
+			Size: cast.ToUint(appOpts.Get(FlagInterBlockCacheSize)),
+		})
 	}
 
 	pruningOpts, err := GetPruningOptionsFromFlags(appOpts)
@@ -494,6 +496,11 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 		panic(err)
 	}
 
+	pruningOverrides, err := GetPruningOverridesFromFlags(appOpts)
+	if err != nil {
+		panic(err)
+	}
+
 	homeDir := cast.ToString(appOpts.Get(flags.FlagHome))
 	chainID := cast.ToString(appOpts.Get(flags.FlagChainID))
 	if chainID == "" {
@@ -520,6 +527,16 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 		cast.ToUint32(appOpts.Get(FlagStateSyncSnapshotKeepRecent)),
 	)
 
+	historicalQueryEnabled := true
+	if enabled := appOpts.Get(FlagHistoricalQuery); enabled != nil {
+		historicalQueryEnabled = cast.ToBool(enabled)
+	}
+
+	archivedQueriesDir := ""
+	if cast.ToBool(appOpts.Get(FlagStateSyncArchivedQueries)) {
+		archivedQueriesDir = filepath.Join(homeDir, "data", "snapshots", "archived")
+	}
+
 	defaultMempool := baseapp.SetMempool(mempool.NoOpMempool{})
 	if maxTxs := cast.ToInt(appOpts.Get(FlagMempoolMaxTxs)); maxTxs >= 0 {
 		defaultMempool = baseapp.SetMempool(
@@ -531,6 +548,7 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 
 	return []func(*baseapp.BaseApp){
 		baseapp.SetPruning(pruningOpts),
+		baseapp.SetPruningOverrides(pruningOverrides),
 		baseapp.SetMinGasPrices(cast.ToString(appOpts.Get(FlagMinGasPrices))),
 		baseapp.SetHaltHeight(cast.ToUint64(appOpts.Get(FlagHaltHeight))),
 		baseapp.SetHaltTime(cast.ToUint64(appOpts.Get(FlagHaltTime))),
@@ -544,6 +562,8 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 		defaultMempool,
 		baseapp.SetChainID(chainID),
 		baseapp.SetQueryGasLimit(cast.ToUint64(appOpts.Get(FlagQueryGasLimit))),
+		baseapp.SetHistoricalQueryEnabled(historicalQueryEnabled),
+		baseapp.SetArchivedSnapshotQueries(archivedQueriesDir, GetAppDBBackend(appOpts), baseapp.DefaultArchivedRestoreInterval),
 	}
 }
 
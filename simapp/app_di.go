@@ -9,6 +9,7 @@ import (
 	"os"
 	"path/filepath"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/spf13/cast"
 
@@ -19,6 +20,7 @@ import (
 	"cosmossdk.io/x/auth/ante/unorderedtx"
 	authkeeper "cosmossdk.io/x/auth/keeper"
 	authsims "cosmossdk.io/x/auth/simulation"
+	authtx "cosmossdk.io/x/auth/tx"
 	authtypes "cosmossdk.io/x/auth/types"
 	authzkeeper "cosmossdk.io/x/authz/keeper"
 	bankkeeper "cosmossdk.io/x/bank/keeper"
@@ -48,6 +50,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/server/config"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	testdata_pulsar "github.com/cosmos/cosmos-sdk/testutil/testdata/testpb"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	consensuskeeper "github.com/cosmos/cosmos-sdk/x/consensus/keeper"
 )
@@ -74,7 +77,7 @@ type SimApp struct {
 
 	// keepers
 	AuthKeeper            authkeeper.AccountKeeper
-	BankKeeper            bankkeeper.Keeper
+	BankKeeper            bankkeeper.BaseKeeper
 	StakingKeeper         *stakingkeeper.Keeper
 	SlashingKeeper        slashingkeeper.Keeper
 	MintKeeper            mintkeeper.Keeper
@@ -171,7 +174,8 @@ func NewSimApp(
 
 				// For providing a custom inflation function for x/mint add here your
 				// custom function that implements the minttypes.InflationCalculationFn
-				// interface.
+				// interface. Otherwise the built-in strategy selected by the x/mint
+				// params is used.
 			),
 		)
 	)
@@ -262,6 +266,20 @@ func NewSimApp(
 
 	app.sm.RegisterStoreDecoders()
 
+	// The balance changes of the deferred module accounts made during genesis are
+	// written at the end of InitChain, as the bank end blocker would otherwise
+	// only write them in the first block.
+	app.SetInitChainer(func(ctx sdk.Context, req *abci.RequestInitChain) (*abci.ResponseInitChain, error) {
+		res, err := app.App.InitChainer(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		if err := app.BankKeeper.FlushDeferredBalances(ctx); err != nil {
+			return nil, err
+		}
+		return res, nil
+	})
+
 	// A custom InitChainer can be set if extra pre-init-genesis logic is required.
 	// By default, when using app wiring enabled module, this is not required.
 	// For instance, the upgrade module will set automatically the module version map in its init genesis thanks to app wiring.
@@ -369,6 +387,19 @@ func (app *SimApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APICon
 	}
 }
 
+// RegisterTxService overrides runtime.App#RegisterTxService to serve the
+// SimulateWithOverrides RPC method of the tx service.
+func (app *SimApp) RegisterTxService(clientCtx client.Context) {
+	authtx.RegisterTxServiceWithOverrides(
+		app.GRPCQueryRouter(),
+		clientCtx,
+		app.Simulate,
+		app.SimulateWithOverrides,
+		authtx.NewStateOverrides(app.AuthKeeper, app.BankKeeper),
+		app.interfaceRegistry,
+	)
+}
+
 // GetMaccPerms returns a copy of the module account permissions
 //
 // NOTE: This is solely to be used for testing purposes.
@@ -20,6 +20,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/debug"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/keys"
+	"github.com/cosmos/cosmos-sdk/client/offline"
 	"github.com/cosmos/cosmos-sdk/client/pruning"
 	"github.com/cosmos/cosmos-sdk/client/rpc"
 	"github.com/cosmos/cosmos-sdk/client/snapshot"
@@ -41,10 +42,12 @@ func initRootCmd(
 	rootCmd.AddCommand(
 		genutilcli.InitCmd(moduleManager),
 		NewTestnetCmd(moduleManager, banktypes.GenesisBalancesIterator{}),
-		debug.Cmd(),
+		debugCommand(),
 		confixcmd.ConfigCommand(),
 		pruning.Cmd(newApp),
 		snapshot.Cmd(newApp),
+		server.ExportStoreToFileCmd(newApp),
+		server.ImportStoreFromFileCmd(newApp),
 	)
 
 	server.AddCommands(rootCmd, newApp, server.StartCmdOptions[servertypes.Application]{})
@@ -60,6 +63,14 @@ func initRootCmd(
 	)
 }
 
+// debugCommand builds the `simd debug` command, with the commands inspecting the application state.
+func debugCommand() *cobra.Command {
+	cmd := debug.Cmd()
+	cmd.AddCommand(server.StoreProfileCmd(newApp))
+
+	return cmd
+}
+
 // genesisCommand builds genesis-related `simd genesis` command. Users may provide application specific commands as a parameter
 func genesisCommand(txConfig client.TxConfig, moduleManager *module.Manager, appExport servertypes.AppExporter, cmds ...*cobra.Command) *cobra.Command {
 	cmd := genutilcli.Commands(txConfig, moduleManager, appExport)
@@ -106,11 +117,14 @@ func txCommand() *cobra.Command {
 		authcmd.GetSignBatchCommand(),
 		authcmd.GetMultiSignCommand(),
 		authcmd.GetMultiSignBatchCmd(),
+		authcmd.GetMultiSignServeCommand(),
+		authcmd.GetMultiSignJoinCommand(),
 		authcmd.GetValidateSignaturesCommand(),
 		authcmd.GetBroadcastCommand(),
 		authcmd.GetEncodeCommand(),
 		authcmd.GetDecodeCommand(),
 		authcmd.GetSimulateCmd(),
+		offline.Cmd(),
 	)
 
 	return cmd
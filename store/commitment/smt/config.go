@@ -0,0 +1,14 @@
+package smt
+
+// Config is the configuration for the sparse merkle tree.
+type Config struct {
+	// CacheSize is the number of saved nodes kept in memory, 0 disables the cache.
+	CacheSize int `mapstructure:"cache_size"`
+}
+
+// DefaultConfig returns the default configuration for the sparse merkle tree.
+func DefaultConfig() *Config {
+	return &Config{
+		CacheSize: 100000,
+	}
+}
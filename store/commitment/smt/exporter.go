@@ -0,0 +1,52 @@
+package smt
+
+import (
+	"encoding/binary"
+
+	"cosmossdk.io/store/v2/commitment"
+	snapshotstypes "cosmossdk.io/store/v2/snapshots/types"
+)
+
+// Exporter exports the leaves of a version of the tree, ordered by path. The
+// inner nodes aren't exported since the tree is rebuilt from its leaves.
+type Exporter struct {
+	tree  *SmtTree
+	stack []childRef
+}
+
+// Next returns the next item in the exporter.
+func (e *Exporter) Next() (*snapshotstypes.SnapshotIAVLItem, error) {
+	for len(e.stack) > 0 {
+		ref := e.stack[len(e.stack)-1]
+		e.stack = e.stack[:len(e.stack)-1]
+
+		n, err := e.tree.resolve(ref)
+		if err != nil {
+			return nil, err
+		}
+		if !n.leaf {
+			for _, child := range []childRef{n.right, n.left} {
+				if !child.isEmpty() {
+					e.stack = append(e.stack, child)
+				}
+			}
+			continue
+		}
+
+		return &snapshotstypes.SnapshotIAVLItem{
+			Key:     n.key,
+			Value:   n.value,
+			Version: int64(binary.BigEndian.Uint64(n.nodeKey)),
+			Height:  0,
+		}, nil
+	}
+
+	return nil, commitment.ErrorExportDone
+}
+
+// Close closes the exporter.
+func (e *Exporter) Close() error {
+	e.stack = nil
+
+	return nil
+}
@@ -0,0 +1,229 @@
+package smt
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+)
+
+const (
+	leafNodeType  byte = 0
+	innerNodeType byte = 1
+
+	// nodeKeySize is the size of a node key, i.e. the version in which the node
+	// was saved followed by its nonce in that version.
+	nodeKeySize = 12
+)
+
+var (
+	leafPrefix  = []byte{0}
+	innerPrefix = []byte{1}
+
+	// emptyHash is the hash of an empty subtree.
+	emptyHash = make([]byte, sha256.Size)
+)
+
+// node is a node of the tree, either a leaf holding a key-value pair or an
+// inner node with two children. Each inner node has at least two leaves in its
+// subtree, a leaf being stored at the lowest depth where it is alone in its
+// subtree.
+//
+// The saved nodes are immutable, the tree is updated by copying the nodes along
+// the path of the updated leaves.
+type node struct {
+	nodeKey []byte // nil until the node is saved
+	hash    []byte // nil until the hash of an unsaved node is computed
+
+	leaf  bool
+	key   []byte
+	value []byte
+	path  []byte // the hash of the key, locating the leaf in the tree
+
+	left  childRef
+	right childRef
+}
+
+// childRef references a child of an inner node, either a saved node or a node
+// which isn't saved yet. The zero value references an empty subtree.
+type childRef struct {
+	nodeKey []byte
+	hash    []byte
+	node    *node
+}
+
+// newLeaf returns an unsaved leaf node for the key-value pair.
+func newLeaf(key, value []byte) *node {
+	path := sha256.Sum256(key)
+	return &node{
+		leaf:  true,
+		key:   key,
+		value: value,
+		path:  path[:],
+	}
+}
+
+func (r childRef) isEmpty() bool {
+	return r.node == nil && r.nodeKey == nil
+}
+
+// getHash returns the hash of the referenced subtree.
+func (r childRef) getHash() []byte {
+	switch {
+	case r.node != nil:
+		return r.node.getHash()
+	case r.nodeKey != nil:
+		return r.hash
+	default:
+		return emptyHash
+	}
+}
+
+// getHash returns the hash of the node, computing it if needed.
+func (n *node) getHash() []byte {
+	if n.hash == nil {
+		if n.leaf {
+			n.hash = leafHash(n.path, n.value)
+		} else {
+			n.hash = innerHash(n.left.getHash(), n.right.getHash())
+		}
+	}
+
+	return n.hash
+}
+
+// child returns the child at the side of the given bit, and the other one.
+func (n *node) child(bit byte) (childRef, childRef) {
+	if bit == 0 {
+		return n.left, n.right
+	}
+	return n.right, n.left
+}
+
+// withChild returns a copy of the inner node with the child at the side of the
+// given bit replaced.
+func (n *node) withChild(bit byte, child childRef) *node {
+	inner := &node{left: n.left, right: n.right}
+	if bit == 0 {
+		inner.left = child
+	} else {
+		inner.right = child
+	}
+
+	return inner
+}
+
+// leafHash returns the hash of a leaf, as defined by the ics23 SMT proof spec.
+func leafHash(path, value []byte) []byte {
+	valueHash := sha256.Sum256(value)
+	h := sha256.New()
+	h.Write(leafPrefix)
+	h.Write(path)
+	h.Write(valueHash[:])
+	return h.Sum(nil)
+}
+
+// innerHash returns the hash of an inner node, as defined by the ics23 SMT proof
+// spec.
+func innerHash(left, right []byte) []byte {
+	h := sha256.New()
+	h.Write(innerPrefix)
+	h.Write(left)
+	h.Write(right)
+	return h.Sum(nil)
+}
+
+// getBit returns the bit of the path at the given depth.
+func getBit(path []byte, depth int) byte {
+	return (path[depth/8] >> (7 - depth%8)) & 1
+}
+
+// newNodeKey returns the key of the node saved in the given version with the
+// given nonce.
+func newNodeKey(version uint64, nonce uint32) []byte {
+	nodeKey := make([]byte, nodeKeySize)
+	binary.BigEndian.PutUint64(nodeKey, version)
+	binary.BigEndian.PutUint32(nodeKey[8:], nonce)
+	return nodeKey
+}
+
+// encode returns the encoding of a node.
+//
+// A leaf is encoded as its type, the length of its key as an uvarint, its key
+// and its value. An inner node is encoded as its type and, for each child, 0 if
+// it is empty or 1 followed by the key and the hash of the child.
+func (n *node) encode() []byte {
+	if n.leaf {
+		buf := make([]byte, 0, 1+binary.MaxVarintLen64+len(n.key)+len(n.value))
+		buf = append(buf, leafNodeType)
+		buf = binary.AppendUvarint(buf, uint64(len(n.key)))
+		buf = append(buf, n.key...)
+		return append(buf, n.value...)
+	}
+
+	buf := make([]byte, 0, 1+2*(1+nodeKeySize+sha256.Size))
+	buf = append(buf, innerNodeType)
+	for _, child := range []childRef{n.left, n.right} {
+		if child.isEmpty() {
+			buf = append(buf, 0)
+			continue
+		}
+		if child.node != nil {
+			child = childRef{nodeKey: child.node.nodeKey, hash: child.node.getHash()}
+		}
+		buf = append(buf, 1)
+		buf = append(buf, child.nodeKey...)
+		buf = append(buf, child.hash...)
+	}
+
+	return buf
+}
+
+// decodeNode decodes the node saved with the given key.
+func decodeNode(nodeKey, bz []byte) (*node, error) {
+	if len(bz) == 0 {
+		return nil, fmt.Errorf("empty node %x", nodeKey)
+	}
+
+	switch bz[0] {
+	case leafNodeType:
+		keyLen, n := binary.Uvarint(bz[1:])
+		if n <= 0 || uint64(len(bz)-1-n) < keyLen {
+			return nil, fmt.Errorf("invalid leaf node %x", nodeKey)
+		}
+		key := bytes.Clone(bz[1+n : 1+n+int(keyLen)])
+		value := bytes.Clone(bz[1+n+int(keyLen):])
+
+		leaf := newLeaf(key, value)
+		leaf.nodeKey = nodeKey
+		leaf.getHash()
+		return leaf, nil
+
+	case innerNodeType:
+		inner := &node{nodeKey: nodeKey}
+		bz = bz[1:]
+		for _, child := range []*childRef{&inner.left, &inner.right} {
+			if len(bz) == 0 {
+				return nil, fmt.Errorf("invalid inner node %x", nodeKey)
+			}
+			if bz[0] == 0 {
+				bz = bz[1:]
+				continue
+			}
+			if len(bz) < 1+nodeKeySize+sha256.Size {
+				return nil, fmt.Errorf("invalid inner node %x", nodeKey)
+			}
+			child.nodeKey = bytes.Clone(bz[1 : 1+nodeKeySize])
+			child.hash = bytes.Clone(bz[1+nodeKeySize : 1+nodeKeySize+sha256.Size])
+			bz = bz[1+nodeKeySize+sha256.Size:]
+		}
+		if len(bz) != 0 || (inner.left.isEmpty() && inner.right.isEmpty()) {
+			return nil, fmt.Errorf("invalid inner node %x", nodeKey)
+		}
+		inner.getHash()
+		return inner, nil
+
+	default:
+		return nil, fmt.Errorf("invalid type %d of node %x", bz[0], nodeKey)
+	}
+}
@@ -0,0 +1,725 @@
+package smt
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+
+	ics23 "github.com/cosmos/ics23/go"
+	lru "github.com/hashicorp/golang-lru"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/commitment"
+	"cosmossdk.io/store/v2/proof"
+)
+
+const (
+	nodePrefix   = 'n' // n<node_key> -> node
+	rootPrefix   = 'r' // r<version> -> root node key
+	orphanPrefix = 'o' // o<version><node_key> -> node key of a node orphaned in version
+)
+
+var (
+	_ commitment.Tree         = (*SmtTree)(nil)
+	_ commitment.ProofBuilder = (*SmtTree)(nil)
+)
+
+// SmtTree is a versioned sparse merkle tree, whose leaves are located by the
+// SHA-256 hash of their key. The tree is compacted: a subtree with a single leaf
+// is replaced by the leaf.
+//
+// Each version is saved by writing the nodes updated since the previous version,
+// along with the nodes orphaned by this version, which are deleted once all the
+// versions referencing them are pruned.
+type SmtTree struct {
+	mtx    sync.RWMutex
+	db     store.RawDB
+	logger log.Logger
+	cache  *lru.Cache // saved nodes by node key, nil if disabled
+
+	root           childRef // root of the working tree
+	version        uint64   // latest saved version
+	hash           []byte   // hash of the latest saved version
+	initialVersion uint64
+	orphans        [][]byte // keys of the saved nodes replaced in the working tree
+}
+
+// NewSmtTree creates a new SmtTree instance.
+func NewSmtTree(db store.RawDB, logger log.Logger, cfg *Config) *SmtTree {
+	var cache *lru.Cache
+	if cfg.CacheSize > 0 {
+		cache, _ = lru.New(cfg.CacheSize)
+	}
+
+	return &SmtTree{
+		db:     db,
+		logger: logger,
+		cache:  cache,
+		hash:   emptyHash,
+	}
+}
+
+// Set sets the given key-value pair in the tree.
+func (t *SmtTree) Set(key, value []byte) error {
+	if len(key) == 0 {
+		return errors.New("key cannot be empty")
+	}
+	if value == nil {
+		return errors.New("value cannot be nil")
+	}
+
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	root, err := t.insert(t.root, 0, newLeaf(bytes.Clone(key), bytes.Clone(value)))
+	if err != nil {
+		return err
+	}
+	t.root = root
+	return nil
+}
+
+// Remove removes the given key from the tree.
+func (t *SmtTree) Remove(key []byte) error {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	path := sha256.Sum256(key)
+	root, found, err := t.remove(t.root, 0, path[:])
+	if err != nil {
+		return err
+	}
+	if !found {
+		return fmt.Errorf("key %x not found", key)
+	}
+	t.root = root
+	return nil
+}
+
+// GetLatestVersion returns the latest version of the tree.
+func (t *SmtTree) GetLatestVersion() uint64 {
+	t.mtx.RLock()
+	defer t.mtx.RUnlock()
+
+	return t.version
+}
+
+// Hash returns the hash of the latest saved version of the tree.
+func (t *SmtTree) Hash() []byte {
+	t.mtx.RLock()
+	defer t.mtx.RUnlock()
+
+	return t.hash
+}
+
+// WorkingHash returns the working hash of the tree.
+func (t *SmtTree) WorkingHash() []byte {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	return t.root.getHash()
+}
+
+// LoadVersion loads the state at the given version, or at the latest version
+// if 0, deleting the versions after it.
+func (t *SmtTree) LoadVersion(version uint64) error {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	latestVersion, err := t.getLatestSavedVersion()
+	if err != nil {
+		return err
+	}
+	if version == 0 {
+		version = latestVersion
+	}
+
+	root := childRef{}
+	if version > 0 {
+		if root, err = t.getRoot(version); err != nil {
+			return err
+		}
+	}
+
+	if version < latestVersion {
+		if err := t.deleteVersionsFrom(version + 1); err != nil {
+			return err
+		}
+	}
+
+	if root.nodeKey != nil {
+		n, err := t.getNode(root.nodeKey)
+		if err != nil {
+			return err
+		}
+		root.hash = n.getHash()
+	}
+
+	t.root = root
+	t.version = version
+	t.hash = root.getHash()
+	t.orphans = nil
+	return nil
+}
+
+// Commit commits the current state to the tree.
+func (t *SmtTree) Commit() ([]byte, uint64, error) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	version := t.version + 1
+	if t.version == 0 && t.initialVersion > 0 {
+		version = t.initialVersion
+	}
+
+	batch := t.db.NewBatch()
+	defer batch.Close()
+
+	var nonce uint32
+	root, err := t.saveNode(batch, t.root, version, &nonce)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rootValue := []byte{0}
+	if root.nodeKey != nil {
+		rootValue = append([]byte{1}, root.nodeKey...)
+	}
+	if err := batch.Set(rootKey(version), rootValue); err != nil {
+		return nil, 0, err
+	}
+	for _, nodeKey := range t.orphans {
+		if err := batch.Set(orphanKey(version, nodeKey), nodeKey); err != nil {
+			return nil, 0, err
+		}
+	}
+	if err := batch.WriteSync(); err != nil {
+		return nil, 0, err
+	}
+
+	// the saved nodes are loaded again from the database when needed
+	t.root = root
+	t.version = version
+	t.hash = root.getHash()
+	t.orphans = nil
+	return t.hash, version, nil
+}
+
+// SetInitialVersion sets the initial version of the database.
+func (t *SmtTree) SetInitialVersion(version uint64) error {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	t.initialVersion = version
+	return nil
+}
+
+// GetProof returns a proof for the given key and version.
+func (t *SmtTree) GetProof(version uint64, key []byte) (*ics23.CommitmentProof, error) {
+	t.mtx.RLock()
+	defer t.mtx.RUnlock()
+
+	root, err := t.getRoot(version)
+	if err != nil {
+		return nil, err
+	}
+
+	path := sha256.Sum256(key)
+	exist, err := t.getExistenceProof(root, path[:])
+	if err != nil {
+		return nil, err
+	}
+	if exist != nil {
+		return &ics23.CommitmentProof{
+			Proof: &ics23.CommitmentProof_Exist{Exist: exist},
+		}, nil
+	}
+
+	nonexist := &ics23.NonExistenceProof{Key: key}
+	for _, greater := range []bool{false, true} {
+		neighbor, err := t.getNeighbor(root, 0, path[:], greater)
+		if err != nil {
+			return nil, err
+		}
+		if neighbor == nil {
+			continue
+		}
+
+		exist, err := t.getExistenceProof(root, neighbor.path)
+		if err != nil {
+			return nil, err
+		}
+		if greater {
+			nonexist.Right = exist
+		} else {
+			nonexist.Left = exist
+		}
+	}
+	if nonexist.Left == nil && nonexist.Right == nil {
+		return nil, fmt.Errorf("cannot prove the absence of key %x in the empty tree at version %d", key, version)
+	}
+
+	return &ics23.CommitmentProof{
+		Proof: &ics23.CommitmentProof_Nonexist{Nonexist: nonexist},
+	}, nil
+}
+
+// CommitmentOp implements commitment.ProofBuilder.
+func (t *SmtTree) CommitmentOp(key []byte, p *ics23.CommitmentProof) proof.CommitmentOp {
+	return proof.NewSMTCommitmentOp(key, p)
+}
+
+// Get returns the value of the given key at the given version, or nil if the
+// key doesn't exist.
+func (t *SmtTree) Get(version uint64, key []byte) ([]byte, error) {
+	t.mtx.RLock()
+	defer t.mtx.RUnlock()
+
+	ref, err := t.getRoot(version)
+	if err != nil {
+		return nil, err
+	}
+
+	path := sha256.Sum256(key)
+	for depth := 0; ; depth++ {
+		n, err := t.resolve(ref)
+		if err != nil || n == nil {
+			return nil, err
+		}
+		if n.leaf {
+			if bytes.Equal(n.path, path[:]) {
+				return n.value, nil
+			}
+			return nil, nil
+		}
+		ref, _ = n.child(getBit(path[:], depth))
+	}
+}
+
+// Prune prunes all versions up to and including the provided version.
+func (t *SmtTree) Prune(version uint64) error {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	if version >= t.version {
+		return fmt.Errorf("cannot prune version %d, the latest version is %d", version, t.version)
+	}
+
+	batch := t.db.NewBatch()
+	defer batch.Close()
+
+	// the nodes orphaned in version v are only referenced by the versions before v
+	orphans, err := t.collect(orphanPrefix, nil, versionKey(orphanPrefix, version+2))
+	if err != nil {
+		return err
+	}
+	for _, kv := range orphans {
+		if err := batch.Delete(nodeDBKey(kv[1])); err != nil {
+			return err
+		}
+		if err := batch.Delete(kv[0]); err != nil {
+			return err
+		}
+		if t.cache != nil {
+			t.cache.Remove(string(kv[1]))
+		}
+	}
+
+	roots, err := t.collect(rootPrefix, nil, versionKey(rootPrefix, version+1))
+	if err != nil {
+		return err
+	}
+	for _, kv := range roots {
+		if err := batch.Delete(kv[0]); err != nil {
+			return err
+		}
+	}
+
+	t.logger.Debug("pruning the tree", "version", version, "versions", len(roots), "nodes", len(orphans))
+	return batch.WriteSync()
+}
+
+// Export exports the tree exporter at the given version.
+func (t *SmtTree) Export(version uint64) (commitment.Exporter, error) {
+	t.mtx.RLock()
+	defer t.mtx.RUnlock()
+
+	root, err := t.getRoot(version)
+	if err != nil {
+		return nil, err
+	}
+
+	exporter := &Exporter{tree: t}
+	if !root.isEmpty() {
+		exporter.stack = []childRef{root}
+	}
+	return exporter, nil
+}
+
+// Import imports the tree importer at the given version.
+func (t *SmtTree) Import(version uint64) (commitment.Importer, error) {
+	t.mtx.RLock()
+	defer t.mtx.RUnlock()
+
+	if t.version != 0 || !t.root.isEmpty() {
+		return nil, fmt.Errorf("cannot import into a non-empty tree at version %d", t.version)
+	}
+
+	return &Importer{
+		tree:    t,
+		version: version,
+	}, nil
+}
+
+// Close closes the tree.
+func (t *SmtTree) Close() error {
+	if t.cache != nil {
+		t.cache.Purge()
+	}
+	return nil
+}
+
+// insert inserts the leaf in the subtree at the given depth, and returns the
+// updated subtree.
+func (t *SmtTree) insert(ref childRef, depth int, leaf *node) (childRef, error) {
+	n, err := t.resolve(ref)
+	if err != nil {
+		return childRef{}, err
+	}
+	if n == nil {
+		return childRef{node: leaf}, nil
+	}
+
+	if n.leaf {
+		if bytes.Equal(n.path, leaf.path) {
+			t.orphan(ref)
+			return childRef{node: leaf}, nil
+		}
+		// the leaf hash doesn't depend on its depth, the leaf is kept as is
+		return split(ref, n.path, leaf, depth), nil
+	}
+
+	bit := getBit(leaf.path, depth)
+	child, _ := n.child(bit)
+	child, err = t.insert(child, depth+1, leaf)
+	if err != nil {
+		return childRef{}, err
+	}
+
+	t.orphan(ref)
+	return childRef{node: n.withChild(bit, child)}, nil
+}
+
+// split returns the subtree at the given depth with the existing leaf and the
+// new one.
+func split(existing childRef, existingPath []byte, leaf *node, depth int) childRef {
+	inner := &node{}
+	existingBit, bit := getBit(existingPath, depth), getBit(leaf.path, depth)
+	switch {
+	case existingBit != bit:
+		inner = inner.withChild(existingBit, existing).withChild(bit, childRef{node: leaf})
+	default:
+		inner = inner.withChild(bit, split(existing, existingPath, leaf, depth+1))
+	}
+
+	return childRef{node: inner}
+}
+
+// remove removes the leaf with the given path from the subtree at the given
+// depth, and returns the updated subtree.
+func (t *SmtTree) remove(ref childRef, depth int, path []byte) (childRef, bool, error) {
+	n, err := t.resolve(ref)
+	if err != nil || n == nil {
+		return ref, false, err
+	}
+
+	if n.leaf {
+		if !bytes.Equal(n.path, path) {
+			return ref, false, nil
+		}
+		t.orphan(ref)
+		return childRef{}, true, nil
+	}
+
+	bit := getBit(path, depth)
+	child, sibling := n.child(bit)
+	child, found, err := t.remove(child, depth+1, path)
+	if err != nil || !found {
+		return ref, found, err
+	}
+	t.orphan(ref)
+
+	// a leaf left alone in the subtree replaces it
+	var alone childRef
+	switch {
+	case child.isEmpty():
+		alone = sibling
+	case sibling.isEmpty():
+		alone = child
+	}
+	if !alone.isEmpty() {
+		aloneNode, err := t.resolve(alone)
+		if err != nil {
+			return childRef{}, false, err
+		}
+		if aloneNode.leaf {
+			return alone, true, nil
+		}
+	}
+
+	return childRef{node: n.withChild(bit, child)}, true, nil
+}
+
+// orphan records the replacement of the node in the working tree.
+func (t *SmtTree) orphan(ref childRef) {
+	if ref.nodeKey != nil {
+		t.orphans = append(t.orphans, ref.nodeKey)
+	}
+}
+
+// saveNode writes the unsaved nodes of the subtree to the batch, children first,
+// and returns the reference to the saved subtree.
+func (t *SmtTree) saveNode(batch store.RawBatch, ref childRef, version uint64, nonce *uint32) (childRef, error) {
+	n := ref.node
+	if n == nil {
+		return ref, nil
+	}
+	if n.nodeKey != nil {
+		return childRef{nodeKey: n.nodeKey, hash: n.getHash()}, nil
+	}
+
+	if !n.leaf {
+		var err error
+		if n.left, err = t.saveNode(batch, n.left, version, nonce); err != nil {
+			return childRef{}, err
+		}
+		if n.right, err = t.saveNode(batch, n.right, version, nonce); err != nil {
+			return childRef{}, err
+		}
+	}
+
+	*nonce++
+	n.nodeKey = newNodeKey(version, *nonce)
+	if err := batch.Set(nodeDBKey(n.nodeKey), n.encode()); err != nil {
+		return childRef{}, err
+	}
+	if t.cache != nil {
+		t.cache.Add(string(n.nodeKey), n)
+	}
+
+	return childRef{nodeKey: n.nodeKey, hash: n.getHash()}, nil
+}
+
+// resolve returns the referenced node, or nil if the subtree is empty.
+func (t *SmtTree) resolve(ref childRef) (*node, error) {
+	if ref.node != nil || ref.nodeKey == nil {
+		return ref.node, nil
+	}
+
+	return t.getNode(ref.nodeKey)
+}
+
+// getNode returns the saved node with the given key.
+func (t *SmtTree) getNode(nodeKey []byte) (*node, error) {
+	if t.cache != nil {
+		if n, ok := t.cache.Get(string(nodeKey)); ok {
+			return n.(*node), nil
+		}
+	}
+
+	bz, err := t.db.Get(nodeDBKey(nodeKey))
+	if err != nil {
+		return nil, err
+	}
+	if bz == nil {
+		return nil, fmt.Errorf("node %x not found", nodeKey)
+	}
+	n, err := decodeNode(nodeKey, bz)
+	if err != nil {
+		return nil, err
+	}
+
+	if t.cache != nil {
+		t.cache.Add(string(nodeKey), n)
+	}
+	return n, nil
+}
+
+// getRoot returns the reference to the root of the given version.
+func (t *SmtTree) getRoot(version uint64) (childRef, error) {
+	bz, err := t.db.Get(rootKey(version))
+	if err != nil {
+		return childRef{}, err
+	}
+	if len(bz) == 0 {
+		return childRef{}, fmt.Errorf("version %d does not exist", version)
+	}
+	if bz[0] == 0 {
+		return childRef{}, nil
+	}
+
+	return childRef{nodeKey: bz[1:]}, nil
+}
+
+// getLatestSavedVersion returns the latest version saved in the database.
+func (t *SmtTree) getLatestSavedVersion() (uint64, error) {
+	itr, err := t.db.ReverseIterator([]byte{rootPrefix}, []byte{rootPrefix + 1})
+	if err != nil {
+		return 0, err
+	}
+	defer itr.Close()
+
+	if !itr.Valid() {
+		return 0, itr.Error()
+	}
+	return binary.BigEndian.Uint64(itr.Key()[1:]), nil
+}
+
+// deleteVersionsFrom deletes the given version and the ones after it.
+func (t *SmtTree) deleteVersionsFrom(version uint64) error {
+	batch := t.db.NewBatch()
+	defer batch.Close()
+
+	// the orphan records are deleted as the nodes are referenced again
+	for _, prefix := range []byte{nodePrefix, rootPrefix, orphanPrefix} {
+		kvs, err := t.collect(prefix, versionKey(prefix, version), nil)
+		if err != nil {
+			return err
+		}
+		for _, kv := range kvs {
+			if err := batch.Delete(kv[0]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// the node keys of the deleted nodes will be reused
+	if t.cache != nil {
+		t.cache.Purge()
+	}
+
+	return batch.WriteSync()
+}
+
+// collect returns the key-value pairs with the given prefix in the range, the
+// bounds being nil for the whole prefix.
+func (t *SmtTree) collect(prefix byte, start, end []byte) ([][2][]byte, error) {
+	if start == nil {
+		start = []byte{prefix}
+	}
+	if end == nil {
+		end = []byte{prefix + 1}
+	}
+
+	itr, err := t.db.Iterator(start, end)
+	if err != nil {
+		return nil, err
+	}
+	defer itr.Close()
+
+	var kvs [][2][]byte
+	for ; itr.Valid(); itr.Next() {
+		kvs = append(kvs, [2][]byte{bytes.Clone(itr.Key()), bytes.Clone(itr.Value())})
+	}
+
+	return kvs, itr.Error()
+}
+
+// getExistenceProof returns the proof of the leaf with the given path, or nil if
+// there is no such leaf.
+func (t *SmtTree) getExistenceProof(ref childRef, path []byte) (*ics23.ExistenceProof, error) {
+	var ops []*ics23.InnerOp
+	for depth := 0; ; depth++ {
+		n, err := t.resolve(ref)
+		if err != nil || n == nil {
+			return nil, err
+		}
+
+		if n.leaf {
+			if !bytes.Equal(n.path, path) {
+				return nil, nil
+			}
+
+			// the path goes from the leaf to the root
+			for i, j := 0, len(ops)-1; i < j; i, j = i+1, j-1 {
+				ops[i], ops[j] = ops[j], ops[i]
+			}
+			return &ics23.ExistenceProof{
+				Key:   n.key,
+				Value: n.value,
+				Leaf:  ics23.SmtSpec.LeafSpec,
+				Path:  ops,
+			}, nil
+		}
+
+		bit := getBit(path, depth)
+		child, sibling := n.child(bit)
+		op := &ics23.InnerOp{Hash: ics23.HashOp_SHA256}
+		if bit == 0 {
+			op.Prefix = innerPrefix
+			op.Suffix = sibling.getHash()
+		} else {
+			op.Prefix = append(bytes.Clone(innerPrefix), sibling.getHash()...)
+		}
+		ops = append(ops, op)
+		ref = child
+	}
+}
+
+// getNeighbor returns the leaf of the subtree at the given depth with the
+// greatest path lower than the given path, or with the lowest path greater than
+// it if greater is set. It returns nil if there is no such leaf.
+func (t *SmtTree) getNeighbor(ref childRef, depth int, path []byte, greater bool) (*node, error) {
+	n, err := t.resolve(ref)
+	if err != nil || n == nil {
+		return nil, err
+	}
+
+	if n.leaf {
+		if c := bytes.Compare(n.path, path); (greater && c > 0) || (!greater && c < 0) {
+			return n, nil
+		}
+		return nil, nil
+	}
+
+	bit := getBit(path, depth)
+	child, sibling := n.child(bit)
+	neighbor, err := t.getNeighbor(child, depth+1, path, greater)
+	if err != nil || neighbor != nil {
+		return neighbor, err
+	}
+
+	// the sibling is entirely lower or greater than the path
+	if (bit == 0) != greater {
+		return nil, nil
+	}
+	for ref = sibling; ; {
+		n, err := t.resolve(ref)
+		if err != nil || n == nil || n.leaf {
+			return n, err
+		}
+		if ref = n.left; greater && n.left.isEmpty() || !greater && !n.right.isEmpty() {
+			ref = n.right
+		}
+	}
+}
+
+func nodeDBKey(nodeKey []byte) []byte {
+	return append([]byte{nodePrefix}, nodeKey...)
+}
+
+func versionKey(prefix byte, version uint64) []byte {
+	return binary.BigEndian.AppendUint64([]byte{prefix}, version)
+}
+
+func rootKey(version uint64) []byte {
+	return versionKey(rootPrefix, version)
+}
+
+func orphanKey(version uint64, nodeKey []byte) []byte {
+	return append(versionKey(orphanPrefix, version), nodeKey...)
+}
@@ -0,0 +1,187 @@
+package smt
+
+import (
+	"fmt"
+	"testing"
+
+	ics23 "github.com/cosmos/ics23/go"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/commitment"
+	dbm "cosmossdk.io/store/v2/db"
+)
+
+func TestCommitterSuite(t *testing.T) {
+	s := &commitment.CommitStoreTestSuite{
+		NewStore: func(db store.RawDB, storeKeys []string, pruneOpts *store.PruneOptions, logger log.Logger) (*commitment.CommitStore, error) {
+			multiTrees := make(map[string]commitment.Tree)
+			cfg := DefaultConfig()
+			for _, storeKey := range storeKeys {
+				prefixDB := dbm.NewPrefixDB(db, []byte(storeKey))
+				multiTrees[storeKey] = NewSmtTree(prefixDB, logger, cfg)
+			}
+			return commitment.NewCommitStore(multiTrees, db, pruneOpts, logger)
+		},
+	}
+
+	suite.Run(t, s)
+}
+
+func generateTree() *SmtTree {
+	cfg := DefaultConfig()
+	db := dbm.NewMemDB()
+	return NewSmtTree(db, log.NewNopLogger(), cfg)
+}
+
+func TestSmtTree(t *testing.T) {
+	// generate a new tree
+	tree := generateTree()
+	require.NotNil(t, tree)
+
+	initVersion := tree.GetLatestVersion()
+	require.Equal(t, uint64(0), initVersion)
+
+	// write a batch of version 1
+	require.NoError(t, tree.Set([]byte("key1"), []byte("value1")))
+	require.NoError(t, tree.Set([]byte("key2"), []byte("value2")))
+	require.NoError(t, tree.Set([]byte("key3"), []byte("value3")))
+
+	workingHash := tree.WorkingHash()
+	require.NotNil(t, workingHash)
+	require.Equal(t, uint64(0), tree.GetLatestVersion())
+
+	// commit the batch
+	commitHash, version, err := tree.Commit()
+	require.NoError(t, err)
+	require.Equal(t, version, uint64(1))
+	require.Equal(t, workingHash, commitHash)
+	require.Equal(t, uint64(1), tree.GetLatestVersion())
+
+	// ensure we can get expected values
+	bz, err := tree.Get(1, []byte("key1"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value1"), bz)
+
+	bz, err = tree.Get(2, []byte("key1"))
+	require.Error(t, err)
+	require.Nil(t, bz)
+
+	// write a batch of version 2
+	require.NoError(t, tree.Set([]byte("key4"), []byte("value4")))
+	require.NoError(t, tree.Set([]byte("key5"), []byte("value5")))
+	require.NoError(t, tree.Set([]byte("key6"), []byte("value6")))
+	require.NoError(t, tree.Remove([]byte("key1"))) // delete key1
+	require.Error(t, tree.Remove([]byte("key1")))
+	version2Hash := tree.WorkingHash()
+	require.NotNil(t, version2Hash)
+	commitHash, version, err = tree.Commit()
+	require.NoError(t, err)
+	require.Equal(t, version, uint64(2))
+	require.Equal(t, version2Hash, commitHash)
+
+	// get proof for key1
+	proof, err := tree.GetProof(1, []byte("key1"))
+	require.NoError(t, err)
+	require.NotNil(t, proof.GetExist())
+	require.True(t, ics23.VerifyMembership(ics23.SmtSpec, workingHash, proof, []byte("key1"), []byte("value1")))
+
+	proof, err = tree.GetProof(2, []byte("key1"))
+	require.NoError(t, err)
+	require.NotNil(t, proof.GetNonexist())
+	require.True(t, ics23.VerifyNonMembership(ics23.SmtSpec, version2Hash, proof, []byte("key1")))
+
+	// write a batch of version 3
+	require.NoError(t, tree.Set([]byte("key7"), []byte("value7")))
+	require.NoError(t, tree.Set([]byte("key8"), []byte("value8")))
+	require.NoError(t, err)
+	_, _, err = tree.Commit()
+	require.NoError(t, err)
+
+	// prune version 1
+	err = tree.Prune(1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), tree.GetLatestVersion())
+	err = tree.LoadVersion(1)
+	require.Error(t, err)
+
+	// the nodes of version 2 are not pruned
+	bz, err = tree.Get(2, []byte("key2"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value2"), bz)
+
+	// load version 2
+	err = tree.LoadVersion(2)
+	require.NoError(t, err)
+	require.Equal(t, version2Hash, tree.WorkingHash())
+
+	// close the db
+	require.NoError(t, tree.Close())
+}
+
+func TestSmtTreeHashIsCanonical(t *testing.T) {
+	tree := generateTree()
+	for i := 0; i < 100; i++ {
+		require.NoError(t, tree.Set([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i))))
+	}
+	_, _, err := tree.Commit()
+	require.NoError(t, err)
+	for i := 0; i < 100; i += 2 {
+		require.NoError(t, tree.Remove([]byte(fmt.Sprintf("key%d", i))))
+	}
+	hash, _, err := tree.Commit()
+	require.NoError(t, err)
+
+	// the hash only depends on the leaves, not on the order of the updates
+	other := generateTree()
+	for i := 99; i >= 0; i -= 2 {
+		require.NoError(t, other.Set([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i))))
+	}
+	require.Equal(t, hash, other.WorkingHash())
+
+	for i := 0; i < 100; i++ {
+		key := []byte(fmt.Sprintf("key%d", i))
+		proof, err := tree.GetProof(2, key)
+		require.NoError(t, err)
+		if i%2 == 0 {
+			require.True(t, ics23.VerifyNonMembership(ics23.SmtSpec, hash, proof, key))
+		} else {
+			require.True(t, ics23.VerifyMembership(ics23.SmtSpec, hash, proof, key, []byte(fmt.Sprintf("value%d", i))))
+		}
+	}
+}
+
+func TestSmtTreeExportImport(t *testing.T) {
+	tree := generateTree()
+	for i := 0; i < 50; i++ {
+		require.NoError(t, tree.Set([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i))))
+	}
+	hash, version, err := tree.Commit()
+	require.NoError(t, err)
+
+	exporter, err := tree.Export(version)
+	require.NoError(t, err)
+	defer exporter.Close()
+
+	target := generateTree()
+	importer, err := target.Import(version)
+	require.NoError(t, err)
+	count := 0
+	for {
+		item, err := exporter.Next()
+		if err == commitment.ErrorExportDone {
+			break
+		}
+		require.NoError(t, err)
+		require.NoError(t, importer.Add(item))
+		count++
+	}
+	require.Equal(t, 50, count)
+	require.NoError(t, importer.Commit())
+	require.NoError(t, importer.Close())
+
+	require.Equal(t, version, target.GetLatestVersion())
+	require.Equal(t, hash, target.Hash())
+}
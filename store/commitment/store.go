@@ -268,6 +268,9 @@ func (c *CommitStore) GetProof(storeKey []byte, version uint64, key []byte) ([]p
 		return nil, fmt.Errorf("commit info not found for version %d", version)
 	}
 	commitOp := proof.NewIAVLCommitmentOp(key, iProof)
+	if builder, ok := tree.(ProofBuilder); ok {
+		commitOp = builder.CommitmentOp(key, iProof)
+	}
 	_, storeCommitmentOp, err := cInfo.GetStoreProof(storeKey)
 	if err != nil {
 		return nil, err
@@ -6,6 +6,7 @@ import (
 
 	ics23 "github.com/cosmos/ics23/go"
 
+	"cosmossdk.io/store/v2/proof"
 	snapshotstypes "cosmossdk.io/store/v2/snapshots/types"
 )
 
@@ -42,6 +43,12 @@ type Tree interface {
 	io.Closer
 }
 
+// ProofBuilder is optionally implemented by a Tree whose proofs are not IAVL
+// proofs, it returns the CommitmentOp verifying a proof of the tree.
+type ProofBuilder interface {
+	CommitmentOp(key []byte, p *ics23.CommitmentProof) proof.CommitmentOp
+}
+
 // Exporter is the interface that wraps the basic Export methods.
 type Exporter interface {
 	Next() (*snapshotstypes.SnapshotIAVLItem, error)
@@ -8,6 +8,11 @@ type PruneOptions struct {
 	// Interval sets the number of how often to prune.
 	// If set to 0, no pruning will be done.
 	Interval uint64
+
+	// Async sets whether the state storage (SS) is pruned in the background,
+	// without blocking the commits. A pruning is skipped while the previous one
+	// is still running, the next one covering its versions.
+	Async bool
 }
 
 // DefaultPruneOptions returns the default pruning options.
@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	corestore "cosmossdk.io/core/store"
 	"cosmossdk.io/log"
 	"cosmossdk.io/store/v2"
 	"cosmossdk.io/store/v2/storage"
@@ -199,3 +200,43 @@ func TestParallelWriteAndPruning(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, []byte(fmt.Sprintf("val-%d-%03d", version-1, 0)), val)
 }
+
+func TestAsyncPruning(t *testing.T) {
+	dir := t.TempDir()
+	db, err := New(dir)
+	require.NoError(t, err)
+
+	pruneOpts := &store.PruneOptions{
+		KeepRecent: 10,
+		Interval:   5,
+		Async:      true,
+	}
+	ss := storage.NewStorageStore(db, pruneOpts, log.NewNopLogger())
+
+	latestVersion := uint64(50)
+	for v := uint64(1); v <= latestVersion; v++ {
+		cs := corestore.NewChangesetWithPairs(map[string]corestore.KVPairs{string(storeKey1): {}})
+		for i := 0; i < 10; i++ {
+			key := fmt.Sprintf("key-%d-%03d", v, i)
+			val := fmt.Sprintf("val-%d-%03d", v, i)
+			cs.AddKVPair(storeKey1, corestore.KVPair{Key: []byte(key), Value: []byte(val)})
+		}
+		require.NoError(t, ss.ApplyChangeset(v, cs))
+	}
+
+	// closing the store waits for the pruning running in the background
+	require.NoError(t, ss.Close())
+
+	db, err = New(dir)
+	require.NoError(t, err)
+	defer db.Close()
+
+	// the first versions are pruned, whichever prunings were skipped
+	val, err := db.Get(storeKey1, 1, []byte("key-1-000"))
+	require.Error(t, err)
+	require.Nil(t, val)
+
+	val, err = db.Get(storeKey1, latestVersion, []byte(fmt.Sprintf("key-%d-000", latestVersion)))
+	require.NoError(t, err)
+	require.Equal(t, []byte(fmt.Sprintf("val-%d-000", latestVersion)), val)
+}
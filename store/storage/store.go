@@ -2,6 +2,8 @@ package storage
 
 import (
 	"fmt"
+	"sync"
+	"sync/atomic"
 
 	corestore "cosmossdk.io/core/store"
 	"cosmossdk.io/log"
@@ -26,6 +28,10 @@ type StorageStore struct {
 
 	// pruneOptions defines the pruning configuration.
 	pruneOptions *store.PruneOptions
+
+	// pruning is set while a pruning runs in the background.
+	pruning atomic.Bool
+	pruneWg sync.WaitGroup
 }
 
 // NewStorageStore returns a reference to a new StorageStore.
@@ -77,7 +83,9 @@ func (ss *StorageStore) ApplyChangeset(version uint64, cs *corestore.Changeset)
 	}
 
 	if prune, pruneVersion := ss.pruneOptions.ShouldPrune(version); prune {
-		if err := ss.Prune(pruneVersion); err != nil {
+		if ss.pruneOptions.Async {
+			ss.pruneAsync(pruneVersion)
+		} else if err := ss.Prune(pruneVersion); err != nil {
 			ss.logger.Info("failed to prune SS", "prune_version", pruneVersion, "err", err)
 		}
 	}
@@ -85,6 +93,25 @@ func (ss *StorageStore) ApplyChangeset(version uint64, cs *corestore.Changeset)
 	return nil
 }
 
+// pruneAsync prunes the store up to the given version in the background, unless
+// the previous pruning is still running.
+func (ss *StorageStore) pruneAsync(version uint64) {
+	if !ss.pruning.CompareAndSwap(false, true) {
+		ss.logger.Debug("skipping SS pruning, the previous one is still running", "prune_version", version)
+		return
+	}
+
+	ss.pruneWg.Add(1)
+	go func() {
+		defer ss.pruneWg.Done()
+		defer ss.pruning.Store(false)
+
+		if err := ss.Prune(version); err != nil {
+			ss.logger.Info("failed to prune SS", "prune_version", version, "err", err)
+		}
+	}()
+}
+
 // GetLatestVersion returns the latest version of the store.
 func (ss *StorageStore) GetLatestVersion() (uint64, error) {
 	return ss.db.GetLatestVersion()
@@ -150,7 +177,8 @@ func (ss *StorageStore) Restore(version uint64, chStorage <-chan *corestore.Stat
 	return nil
 }
 
-// Close closes the store.
+// Close closes the store, after waiting for the pruning running in the background.
 func (ss *StorageStore) Close() error {
+	ss.pruneWg.Wait()
 	return ss.db.Close()
 }
@@ -247,7 +247,7 @@ func TestGRPCQuerySpendableBalances(t *testing.T) {
 	assert.NilError(t, err)
 
 	req := banktypes.NewQuerySpendableBalancesRequest(addr1Str, nil)
-	testdata.DeterministicIterations(t, f.ctx, req, f.queryClient.SpendableBalances, 1777, false)
+	testdata.DeterministicIterations(t, f.ctx, req, f.queryClient.SpendableBalances, 1807, false)
 }
 
 func TestGRPCQueryTotalSupply(t *testing.T) {
@@ -292,7 +292,7 @@ func TestGRPCQueryTotalSupply(t *testing.T) {
 	assert.NilError(t, f.bankKeeper.MintCoins(f.ctx, minttypes.ModuleName, coins))
 
 	req := &banktypes.QueryTotalSupplyRequest{}
-	testdata.DeterministicIterations(t, f.ctx, req, f.queryClient.TotalSupply, 150, false)
+	testdata.DeterministicIterations(t, f.ctx, req, f.queryClient.TotalSupply, 2174, false)
 }
 
 func TestGRPCQueryTotalSupplyOf(t *testing.T) {
@@ -315,7 +315,7 @@ func TestGRPCQueryTotalSupplyOf(t *testing.T) {
 
 	assert.NilError(t, f.bankKeeper.MintCoins(f.ctx, minttypes.ModuleName, sdk.NewCoins(coin)))
 	req := &banktypes.QuerySupplyOfRequest{Denom: coin.GetDenom()}
-	testdata.DeterministicIterations(t, f.ctx, req, f.queryClient.SupplyOf, 1021, false)
+	testdata.DeterministicIterations(t, f.ctx, req, f.queryClient.SupplyOf, 2033, false)
 }
 
 func TestGRPCQueryParams(t *testing.T) {
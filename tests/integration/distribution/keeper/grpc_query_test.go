@@ -594,3 +594,128 @@ func TestGRPCDelegationRewards(t *testing.T) {
 		})
 	}
 }
+
+func TestGRPCDelegationRewardsByDenom(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	// set module account coins
+	initTokens := f.stakingKeeper.TokensFromConsensusPower(f.sdkCtx, int64(1000))
+	assert.NilError(t, f.bankKeeper.MintCoins(f.sdkCtx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initTokens))))
+
+	// Set default staking params
+	assert.NilError(t, f.stakingKeeper.Params.Set(f.sdkCtx, stakingtypes.DefaultParams()))
+
+	qr := f.app.QueryHelper()
+	queryClient := types.NewQueryClient(qr)
+
+	delAddr := sdk.AccAddress(PKS[2].Address())
+
+	// send funds to val addr
+	funds := f.stakingKeeper.TokensFromConsensusPower(f.sdkCtx, int64(1000))
+	assert.NilError(t, f.bankKeeper.SendCoinsFromModuleToAccount(f.sdkCtx, types.ModuleName, sdk.AccAddress(f.valAddr), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, funds))))
+	f.accountKeeper.SetAccount(f.sdkCtx, f.accountKeeper.NewAccountWithAddress(f.sdkCtx, sdk.AccAddress(f.valAddr)))
+	initialStake := int64(10)
+	tstaking := stakingtestutil.NewHelper(t, f.sdkCtx, f.stakingKeeper)
+	tstaking.Commission = stakingtypes.NewCommissionRates(math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDec(0))
+	tstaking.CreateValidator(f.valAddr, valConsPk0, math.NewInt(initialStake), true)
+
+	val, found := f.stakingKeeper.GetValidator(f.sdkCtx, f.valAddr)
+	assert.Assert(t, found)
+
+	// setup delegation
+	delTokens := sdk.TokensFromConsensusPower(2, sdk.DefaultPowerReduction)
+	validator, issuedShares := val.AddTokensFromDel(delTokens)
+	delegation := stakingtypes.NewDelegation(delAddr.String(), f.valAddr.String(), issuedShares)
+	assert.NilError(t, f.stakingKeeper.SetDelegation(f.sdkCtx, delegation))
+	valBz, err := f.stakingKeeper.ValidatorAddressCodec().StringToBytes(validator.GetOperator())
+	assert.NilError(t, err)
+	assert.NilError(t, f.distrKeeper.DelegatorStartingInfo.Set(f.sdkCtx, collections.Join(sdk.ValAddress(valBz), delAddr), types.NewDelegatorStartingInfo(2, math.LegacyNewDec(initialStake), 20)))
+
+	// setup validator rewards in two denoms
+	decCoins := sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyOneDec()),
+		sdk.NewDecCoinFromDec("foo", math.LegacyNewDec(3)),
+	)
+	historicalRewards := types.NewValidatorHistoricalRewards(decCoins, 2)
+	assert.NilError(t, f.distrKeeper.ValidatorHistoricalRewards.Set(f.sdkCtx, collections.Join(sdk.ValAddress(valBz), uint64(2)), historicalRewards))
+	// setup current rewards and outstanding rewards
+	currentRewards := types.NewValidatorCurrentRewards(decCoins, 3)
+	assert.NilError(t, f.distrKeeper.ValidatorCurrentRewards.Set(f.sdkCtx, f.valAddr, currentRewards))
+	assert.NilError(t, f.distrKeeper.ValidatorOutstandingRewards.Set(f.sdkCtx, f.valAddr, types.ValidatorOutstandingRewards{Rewards: decCoins}))
+
+	testCases := []struct {
+		name      string
+		msg       *types.QueryDelegationRewardsByDenomRequest
+		expRes    *types.QueryDelegationRewardsByDenomResponse
+		expErrMsg string
+	}{
+		{
+			name:      "empty request",
+			msg:       &types.QueryDelegationRewardsByDenomRequest{},
+			expErrMsg: "empty delegator address",
+		},
+		{
+			name: "invalid denom",
+			msg: &types.QueryDelegationRewardsByDenomRequest{
+				DelegatorAddress: delAddr.String(),
+				Denom:            "1",
+			},
+			expErrMsg: "invalid denom",
+		},
+		{
+			name: "bond denom",
+			msg: &types.QueryDelegationRewardsByDenomRequest{
+				DelegatorAddress: delAddr.String(),
+				Denom:            sdk.DefaultBondDenom,
+			},
+			expRes: &types.QueryDelegationRewardsByDenomResponse{
+				Rewards: []types.DelegationDenomReward{
+					{ValidatorAddress: f.valAddr.String(), Reward: sdk.NewDecCoin(sdk.DefaultBondDenom, math.NewInt(initialStake/10))},
+				},
+				Total: sdk.NewDecCoin(sdk.DefaultBondDenom, math.NewInt(initialStake/10)),
+			},
+		},
+		{
+			name: "other denom",
+			msg: &types.QueryDelegationRewardsByDenomRequest{
+				DelegatorAddress: delAddr.String(),
+				Denom:            "foo",
+			},
+			expRes: &types.QueryDelegationRewardsByDenomResponse{
+				Rewards: []types.DelegationDenomReward{
+					{ValidatorAddress: f.valAddr.String(), Reward: sdk.NewDecCoin("foo", math.NewInt(3*initialStake/10))},
+				},
+				Total: sdk.NewDecCoin("foo", math.NewInt(3*initialStake/10)),
+			},
+		},
+		{
+			name: "denom without rewards",
+			msg: &types.QueryDelegationRewardsByDenomRequest{
+				DelegatorAddress: delAddr.String(),
+				Denom:            "bar",
+			},
+			expRes: &types.QueryDelegationRewardsByDenomResponse{
+				Rewards: []types.DelegationDenomReward{
+					{ValidatorAddress: f.valAddr.String(), Reward: sdk.NewDecCoin("bar", math.ZeroInt())},
+				},
+				Total: sdk.NewDecCoin("bar", math.ZeroInt()),
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(fmt.Sprintf("Case %s", tc.name), func(t *testing.T) {
+			rewards, err := queryClient.DelegationRewardsByDenom(f.sdkCtx, tc.msg)
+
+			if tc.expErrMsg == "" {
+				assert.NilError(t, err)
+				assert.DeepEqual(t, tc.expRes, rewards)
+			} else {
+				assert.ErrorContains(t, err, tc.expErrMsg)
+				assert.Assert(t, rewards == nil)
+			}
+		})
+	}
+}
@@ -114,6 +114,7 @@ func initFixture(t *testing.T) *fixture {
 	distrKeeper := distrkeeper.NewKeeper(
 		cdc, runtime.NewEnvironment(runtime.NewKVStoreService(keys[distrtypes.StoreKey]), logger), accountKeeper, bankKeeper, stakingKeeper, poolKeeper, distrtypes.ModuleName, authority.String(),
 	)
+	require.NoError(t, distrKeeper.Params.Set(newCtx, distrtypes.DefaultParams()))
 
 	authModule := auth.NewAppModule(cdc, accountKeeper, authsims.RandomGenesisAccounts)
 	bankModule := bank.NewAppModule(cdc, bankKeeper, accountKeeper)
@@ -766,10 +767,11 @@ func TestMsgUpdateParams(t *testing.T) {
 			msg: &distrtypes.MsgUpdateParams{
 				Authority: f.distrKeeper.GetAuthority(),
 				Params: distrtypes.Params{
-					CommunityTax:        communityTax,
-					BaseProposerReward:  math.LegacyZeroDec(),
-					BonusProposerReward: math.LegacyZeroDec(),
-					WithdrawAddrEnabled: withdrawAddrEnabled,
+					CommunityTax:             communityTax,
+					BaseProposerReward:       math.LegacyZeroDec(),
+					BonusProposerReward:      math.LegacyZeroDec(),
+					WithdrawAddrEnabled:      withdrawAddrEnabled,
+					MaxAutoCompoundsPerBlock: distrtypes.DefaultMaxAutoCompoundsPerBlock,
 				},
 			},
 			expErr: false,
@@ -9,6 +9,7 @@ import (
 	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
 	authtypes "cosmossdk.io/x/auth/types"
+	banktestutil "cosmossdk.io/x/bank/testutil"
 	banktypes "cosmossdk.io/x/bank/types"
 	"cosmossdk.io/x/gov/keeper"
 	"cosmossdk.io/x/gov/types"
@@ -471,6 +472,81 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	proposal, err = suite.GovKeeper.Proposals.Get(ctx, proposal.Id)
 	require.Nil(t, err)
 	require.Equal(t, v1.StatusFailed, proposal.Status)
+
+	executionResult, err := suite.GovKeeper.ExecutionResults.Get(ctx, proposal.Id)
+	require.NoError(t, err)
+	require.Len(t, executionResult.MessageResults, 1)
+	require.Equal(t, sdk.MsgTypeURL(msg), executionResult.MessageResults[0].MsgTypeUrl)
+	require.False(t, executionResult.MessageResults[0].Success)
+	require.Equal(t, proposal.FailedReason, executionResult.MessageResults[0].Error)
+	require.Empty(t, executionResult.MessageResults[0].ResponseDigest)
+}
+
+func TestEndBlockerProposalExecutionResult(t *testing.T) {
+	suite := createTestSuite(t)
+	app := suite.app
+	ctx := app.BaseApp.NewContext(false)
+	addrs := simtestutil.AddTestAddrs(suite.BankKeeper, suite.StakingKeeper, ctx, 1, valTokens)
+
+	SortAddresses(addrs)
+
+	stakingMsgSvr := stakingkeeper.NewMsgServerImpl(suite.StakingKeeper)
+
+	valAddr := sdk.ValAddress(addrs[0])
+	proposer := addrs[0]
+
+	ac := addresscodec.NewBech32Codec("cosmos")
+	addrStr, err := ac.BytesToString(authtypes.NewModuleAddress(types.ModuleName))
+	require.NoError(t, err)
+	toAddrStr, err := ac.BytesToString(addrs[0])
+	require.NoError(t, err)
+
+	acc := suite.AccountKeeper.NewAccountWithAddress(ctx, addrs[0])
+	suite.AccountKeeper.SetAccount(ctx, acc)
+
+	createValidators(t, stakingMsgSvr, ctx, []sdk.ValAddress{valAddr}, []int64{10})
+	_, err = suite.StakingKeeper.EndBlocker(ctx)
+	require.NoError(t, err)
+
+	// fund the governance account so that the proposal message can be executed
+	sendCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100000)))
+	require.NoError(t, banktestutil.FundModuleAccount(ctx, suite.BankKeeper, types.ModuleName, sendCoins))
+
+	msg := banktypes.NewMsgSend(addrStr, toAddrStr, sendCoins)
+	proposal, err := suite.GovKeeper.SubmitProposal(ctx, []sdk.Msg{msg}, "", "title", "summary", proposer, v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+	require.NoError(t, err)
+
+	proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, suite.StakingKeeper.TokensFromConsensusPower(ctx, 10)))
+	newDepositMsg := v1.NewMsgDeposit(toAddrStr, proposal.Id, proposalCoins)
+
+	govMsgSvr := keeper.NewMsgServerImpl(suite.GovKeeper)
+	res, err := govMsgSvr.Deposit(ctx, newDepositMsg)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	err = suite.GovKeeper.AddVote(ctx, proposal.Id, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), "")
+	require.NoError(t, err)
+
+	params, _ := suite.GovKeeper.Params.Get(ctx)
+	newHeader := ctx.HeaderInfo()
+	newHeader.Time = ctx.HeaderInfo().Time.Add(*params.MaxDepositPeriod).Add(*params.VotingPeriod)
+	ctx = ctx.WithHeaderInfo(newHeader)
+
+	err = suite.GovKeeper.EndBlocker(ctx)
+	require.NoError(t, err)
+
+	proposal, err = suite.GovKeeper.Proposals.Get(ctx, proposal.Id)
+	require.NoError(t, err)
+	require.Equal(t, v1.StatusPassed, proposal.Status)
+
+	executionResult, err := suite.GovKeeper.ExecutionResults.Get(ctx, proposal.Id)
+	require.NoError(t, err)
+	require.Equal(t, proposal.Id, executionResult.ProposalId)
+	require.Len(t, executionResult.MessageResults, 1)
+	require.Equal(t, sdk.MsgTypeURL(msg), executionResult.MessageResults[0].MsgTypeUrl)
+	require.True(t, executionResult.MessageResults[0].Success)
+	require.Empty(t, executionResult.MessageResults[0].Error)
+	require.Len(t, executionResult.MessageResults[0].ResponseDigest, 32)
 }
 
 func TestExpeditedProposal_PassAndConversionToRegular(t *testing.T) {
@@ -573,6 +649,11 @@ func TestExpeditedProposal_PassAndConversionToRegular(t *testing.T) {
 
 				require.Equal(t, v1.StatusPassed, proposal.Status)
 
+				// the proposal has no messages to execute
+				executionResult, err := suite.GovKeeper.ExecutionResults.Get(ctx, proposal.Id)
+				require.NoError(t, err)
+				require.Empty(t, executionResult.MessageResults)
+
 				submitterEventualBalance := suite.BankKeeper.GetAllBalances(ctx, addrs[0])
 				depositorEventualBalance := suite.BankKeeper.GetAllBalances(ctx, addrs[1])
 
@@ -135,6 +135,7 @@ var (
 			GenOpts.WithAnyTypes(&evidenceapi.Equivocation{}).
 				WithDisallowNil().
 				WithInterfaceHint("cosmos.evidence.v1beta1.Evidence", &evidenceapi.Equivocation{})),
+		GenType(&evidencetypes.MsgUpdateParams{}, &evidenceapi.MsgUpdateParams{}, GenOpts.WithDisallowNil()),
 
 		// feegrant
 		GenType(&feegranttypes.MsgGrantAllowance{}, &feegrantapi.MsgGrantAllowance{},
@@ -186,6 +187,8 @@ var (
 		GenType(&grouptypes.MsgUpdateGroupPolicyDecisionPolicy{}, &groupapi.MsgUpdateGroupPolicyDecisionPolicy{},
 			WithDecisionPolicy(GenOpts)),
 		GenType(&grouptypes.MsgUpdateGroupPolicyMetadata{}, &groupapi.MsgUpdateGroupPolicyMetadata{}, GenOpts),
+		GenType(&grouptypes.MsgUpdateGroupPolicyVetoAuthority{}, &groupapi.MsgUpdateGroupPolicyVetoAuthority{}, GenOpts),
+		GenType(&grouptypes.MsgUpdateGroupPolicySpendLimit{}, &groupapi.MsgUpdateGroupPolicySpendLimit{}, GenOpts),
 		GenType(&grouptypes.MsgSubmitProposal{}, &groupapi.MsgSubmitProposal{},
 			GenOpts.WithDisallowNil().
 				WithAnyTypes(&groupapi.MsgCreateGroup{}, &groupapi.MsgUpdateGroupMembers{}).
@@ -193,7 +196,9 @@ var (
 				WithInterfaceHint("cosmos.base.v1beta1.Msg", &groupapi.MsgUpdateGroupMembers{}),
 		),
 		GenType(&grouptypes.MsgVote{}, &groupapi.MsgVote{}, GenOpts),
+		GenType(&grouptypes.MsgVoteBatch{}, &groupapi.MsgVoteBatch{}, GenOpts),
 		GenType(&grouptypes.MsgExec{}, &groupapi.MsgExec{}, GenOpts),
+		GenType(&grouptypes.MsgVetoProposal{}, &groupapi.MsgVetoProposal{}, GenOpts),
 		GenType(&grouptypes.MsgLeaveGroup{}, &groupapi.MsgLeaveGroup{}, GenOpts),
 
 		// mint
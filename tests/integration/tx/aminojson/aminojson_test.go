@@ -369,8 +369,9 @@ func TestAminoJSON_LegacyParity(t *testing.T) {
 			},
 			pulsar: &stakingapi.MsgUpdateParams{
 				Params: &stakingapi.Params{
-					UnbondingTime:  &durationpb.Duration{Seconds: 0},
-					KeyRotationFee: &v1beta1.Coin{},
+					UnbondingTime:                &durationpb.Duration{Seconds: 0},
+					KeyRotationFee:               &v1beta1.Coin{},
+					CommissionChangeNoticePeriod: &durationpb.Duration{Seconds: 0},
 				},
 			},
 		},
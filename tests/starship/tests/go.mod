@@ -18,6 +18,7 @@ replace (
 	cosmossdk.io/client/v2 => ../../../client/v2
 	cosmossdk.io/core => ../../../core
 	cosmossdk.io/depinject => ../../../depinject
+	cosmossdk.io/errors => ../../../errors
 	cosmossdk.io/simapp => ../../../simapp
 	cosmossdk.io/x/accounts => ../../../x/accounts
 	cosmossdk.io/x/accounts/defaults/lockup => ../../../x/accounts/defaults/lockup
@@ -184,6 +185,7 @@ require (
 	github.com/manifoldco/promptui v0.9.0 // indirect
 	github.com/mattn/go-colorable v0.1.13 // indirect
 	github.com/mattn/go-isatty v0.0.20 // indirect
+	github.com/miekg/pkcs11 v1.1.2 // indirect
 	github.com/minio/highwayhash v1.0.2 // indirect
 	github.com/mitchellh/go-homedir v1.1.0 // indirect
 	github.com/mitchellh/go-testing-interface v1.14.1 // indirect
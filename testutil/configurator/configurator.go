@@ -13,6 +13,7 @@ import (
 	epochsmodulev1 "cosmossdk.io/api/cosmos/epochs/module/v1"
 	evidencemodulev1 "cosmossdk.io/api/cosmos/evidence/module/v1"
 	feegrantmodulev1 "cosmossdk.io/api/cosmos/feegrant/module/v1"
+	feemarketmodulev1 "cosmossdk.io/api/cosmos/feemarket/module/v1"
 	genutilmodulev1 "cosmossdk.io/api/cosmos/genutil/module/v1"
 	govmodulev1 "cosmossdk.io/api/cosmos/gov/module/v1"
 	groupmodulev1 "cosmossdk.io/api/cosmos/group/module/v1"
@@ -87,6 +88,7 @@ func defaultConfig() *Config {
 			"vesting",
 			testutil.CircuitModuleName,
 			testutil.ProtocolPoolModuleName,
+			testutil.FeeMarketModuleName,
 		},
 		InitGenesisOrder: []string{
 			testutil.AuthModuleName,
@@ -109,6 +111,7 @@ func defaultConfig() *Config {
 			testutil.CircuitModuleName,
 			testutil.ProtocolPoolModuleName,
 			testutil.EpochsModuleName,
+			testutil.FeeMarketModuleName,
 		},
 		setInitGenesis: true,
 	}
@@ -161,6 +164,7 @@ func AuthModule() ModuleOption {
 					{Account: testutil.MintModuleName, Permissions: []string{"minter"}},
 					{Account: "bonded_tokens_pool", Permissions: []string{"burner", testutil.StakingModuleName}},
 					{Account: "not_bonded_tokens_pool", Permissions: []string{"burner", testutil.StakingModuleName}},
+					{Account: testutil.StakingModuleName, Permissions: []string{"minter"}},
 					{Account: testutil.GovModuleName, Permissions: []string{"burner"}},
 					{Account: testutil.NFTModuleName},
 					{Account: testutil.ProtocolPoolModuleName},
@@ -348,6 +352,15 @@ func EpochsModule() ModuleOption {
 	}
 }
 
+func FeeMarketModule() ModuleOption {
+	return func(config *Config) {
+		config.ModuleConfigs[testutil.FeeMarketModuleName] = &appv1alpha1.ModuleConfig{
+			Name:   testutil.FeeMarketModuleName,
+			Config: appconfig.WrapAny(&feemarketmodulev1.Module{}),
+		}
+	}
+}
+
 func OmitInitGenesis() ModuleOption {
 	return func(config *Config) {
 		config.setInitGenesis = false
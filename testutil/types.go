@@ -23,4 +23,5 @@ const (
 	TxModuleName           = "tx"
 	UpgradeModuleName      = "upgrade"
 	EpochsModuleName       = "epochs"
+	FeeMarketModuleName    = "feemarket"
 )
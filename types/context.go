@@ -62,6 +62,7 @@ type Context struct {
 	streamingManager     storetypes.StreamingManager
 	cometInfo            comet.Info
 	headerInfo           header.Info
+	txResult             *Result // The result of the tx messages, only set for the PostHandler
 }
 
 // Proposed rename, not done to avoid API breakage
@@ -90,6 +91,7 @@ func (c Context) TransientKVGasConfig() storetypes.GasConfig    { return c.trans
 func (c Context) StreamingManager() storetypes.StreamingManager { return c.streamingManager }
 func (c Context) CometInfo() comet.Info                         { return c.cometInfo }
 func (c Context) HeaderInfo() header.Info                       { return c.headerInfo }
+func (c Context) TxResult() *Result                             { return c.txResult }
 
 // clone the header before returning
 func (c Context) BlockHeader() cmtproto.Header {
@@ -314,6 +316,12 @@ func (c Context) WithHeaderInfo(headerInfo header.Info) Context {
 	return c
 }
 
+// WithTxResult returns a Context with an updated tx result
+func (c Context) WithTxResult(result *Result) Context {
+	c.txResult = result
+	return c
+}
+
 // TODO: remove???
 func (c Context) IsZero() bool {
 	return c.ms == nil
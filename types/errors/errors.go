@@ -140,6 +140,10 @@ var (
 	// supplied.
 	ErrInvalidGasLimit = errorsmod.Register(RootCodespace, 41, "invalid gas limit")
 
+	// ErrPrunedHeight defines an error when the state at a height which existed
+	// is no longer available, e.g. because it has been pruned.
+	ErrPrunedHeight = errorsmod.Register(RootCodespace, 42, "height pruned")
+
 	// ErrPanic should only be set when we recovering from a panic
 	ErrPanic = errorsmod.ErrPanic
 )
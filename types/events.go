@@ -262,10 +262,11 @@ const (
 
 	EventTypeMessage = "message"
 
-	AttributeKeyAction = "action"
-	AttributeKeyModule = "module"
-	AttributeKeySender = "sender"
-	AttributeKeyAmount = "amount"
+	AttributeKeyAction  = "action"
+	AttributeKeyModule  = "module"
+	AttributeKeySender  = "sender"
+	AttributeKeyAmount  = "amount"
+	AttributeKeyGasUsed = "gas_used"
 )
 
 type (
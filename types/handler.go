@@ -12,7 +12,9 @@ package types
 type AnteHandler func(ctx Context, tx Tx, _ bool) (newCtx Context, err error)
 
 // PostHandler like AnteHandler but it executes after RunMsgs. Runs on success
-// or failure and enables use cases like gas refunding.
+// or failure and enables use cases like gas refunding. The result of the message
+// execution is available through ctx.TxResult() (nil when it failed), and the gas
+// consumed so far through ctx.GasMeter().
 type PostHandler func(ctx Context, tx Tx, _, success bool) (newCtx Context, err error)
 
 // AnteDecorator wraps the next AnteHandler to perform custom pre-processing.
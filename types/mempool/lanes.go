@@ -0,0 +1,199 @@
+package mempool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ Mempool  = (*LaneMempool)(nil)
+	_ Iterator = (*laneIterator)(nil)
+)
+
+// ErrNoLaneMatched is returned when inserting a transaction matched by no lane.
+var ErrNoLaneMatched = errors.New("tx matches no mempool lane")
+
+type (
+	// Lane defines a partition of the app-side mempool. Transactions are stored in
+	// the first lane matching them, and lanes are selected in order when building a
+	// block proposal, so that critical transactions (e.g. oracle or IBC transactions)
+	// are guaranteed to be included before the others.
+	Lane struct {
+		// Name is the unique name of the lane.
+		Name string
+
+		// Match returns true if the transaction belongs to the lane. A nil Match
+		// matches all transactions, which is typically used for the last (default)
+		// lane. Match must be deterministic, as it is used to find the lane of a
+		// transaction both on insertion and removal.
+		Match func(tx sdk.Tx) bool
+
+		// Mempool stores the transactions of the lane, and defines their order
+		// within the lane, e.g. a PriorityNonceMempool or a SenderNonceMempool.
+		Mempool Mempool
+
+		// MaxTxs sets the maximum number of transactions selected from the lane
+		// for a block proposal. It prevents a lane from starving the following
+		// lanes. If MaxTxs == 0, there is no limit.
+		MaxTxs int
+	}
+
+	// LaneMempool is a mempool implementation that partitions transactions into
+	// ordered lanes, each lane being backed by its own mempool.
+	LaneMempool struct {
+		lanes []Lane
+	}
+
+	// laneIterator iterates over the transactions of all lanes, in lane order.
+	laneIterator struct {
+		ctx     context.Context
+		txs     [][]byte
+		lanes   []Lane
+		laneIdx int
+		iter    Iterator
+		count   int
+	}
+)
+
+// NewLaneMempool returns a new LaneMempool made of the given lanes, ordered from
+// the highest inclusion priority to the lowest. It panics if no lane is given,
+// if lane names aren't unique or if a lane has no mempool.
+func NewLaneMempool(lanes ...Lane) *LaneMempool {
+	if len(lanes) == 0 {
+		panic("lane mempool requires at least one lane")
+	}
+
+	names := make(map[string]bool, len(lanes))
+	for _, lane := range lanes {
+		if names[lane.Name] {
+			panic(fmt.Sprintf("duplicate mempool lane %s", lane.Name))
+		}
+		names[lane.Name] = true
+
+		if lane.Mempool == nil {
+			panic(fmt.Sprintf("mempool lane %s has no mempool", lane.Name))
+		}
+	}
+
+	return &LaneMempool{lanes: lanes}
+}
+
+// NewMsgTypeURLsMatcher returns a lane Match function matching the transactions
+// made only of messages with the given type URLs.
+func NewMsgTypeURLsMatcher(msgTypeURLs ...string) func(tx sdk.Tx) bool {
+	urls := make(map[string]bool, len(msgTypeURLs))
+	for _, url := range msgTypeURLs {
+		urls[url] = true
+	}
+
+	return func(tx sdk.Tx) bool {
+		msgs := tx.GetMsgs()
+		if len(msgs) == 0 {
+			return false
+		}
+
+		for _, msg := range msgs {
+			if !urls[sdk.MsgTypeURL(msg)] {
+				return false
+			}
+		}
+
+		return true
+	}
+}
+
+// Lanes returns the lanes of the mempool, in order.
+func (mp *LaneMempool) Lanes() []Lane {
+	return mp.lanes
+}
+
+// Insert inserts the transaction in the first lane matching it.
+func (mp *LaneMempool) Insert(ctx context.Context, tx sdk.Tx) error {
+	lane, ok := mp.laneOf(tx)
+	if !ok {
+		return ErrNoLaneMatched
+	}
+
+	return lane.Mempool.Insert(ctx, tx)
+}
+
+// Select returns an iterator over the transactions of all lanes. The transactions
+// of a lane are returned in the order of its mempool, after those of the previous
+// lanes and up to the lane's MaxTxs.
+func (mp *LaneMempool) Select(ctx context.Context, txs [][]byte) Iterator {
+	iter := &laneIterator{
+		ctx:     ctx,
+		txs:     txs,
+		lanes:   mp.lanes,
+		laneIdx: -1,
+	}
+
+	return iter.nextLane()
+}
+
+// CountTx returns the number of transactions in all lanes.
+func (mp *LaneMempool) CountTx() int {
+	count := 0
+	for _, lane := range mp.lanes {
+		count += lane.Mempool.CountTx()
+	}
+
+	return count
+}
+
+// Remove removes the transaction from the first lane matching it.
+func (mp *LaneMempool) Remove(tx sdk.Tx) error {
+	lane, ok := mp.laneOf(tx)
+	if !ok {
+		return ErrTxNotFound
+	}
+
+	return lane.Mempool.Remove(tx)
+}
+
+// laneOf returns the first lane matching the transaction.
+func (mp *LaneMempool) laneOf(tx sdk.Tx) (Lane, bool) {
+	for _, lane := range mp.lanes {
+		if lane.Match == nil || lane.Match(tx) {
+			return lane, true
+		}
+	}
+
+	return Lane{}, false
+}
+
+// nextLane moves the iterator to the first transaction of the next non-empty lane.
+// It returns nil once all lanes are exhausted.
+func (i *laneIterator) nextLane() Iterator {
+	for i.laneIdx++; i.laneIdx < len(i.lanes); i.laneIdx++ {
+		if iter := i.lanes[i.laneIdx].Mempool.Select(i.ctx, i.txs); iter != nil {
+			i.iter = iter
+			i.count = 1
+			return i
+		}
+	}
+
+	return nil
+}
+
+// Next returns the next transaction of the current lane, or moves to the next
+// lane once the current one is exhausted or reached its MaxTxs.
+func (i *laneIterator) Next() Iterator {
+	if maxTxs := i.lanes[i.laneIdx].MaxTxs; maxTxs == 0 || i.count < maxTxs {
+		if iter := i.iter.Next(); iter != nil {
+			i.iter = iter
+			i.count++
+			return i
+		}
+	}
+
+	return i.nextLane()
+}
+
+// Tx returns the transaction at the current position of the iterator.
+func (i *laneIterator) Tx() sdk.Tx {
+	return i.iter.Tx()
+}
@@ -0,0 +1,100 @@
+package mempool_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	countertypes "github.com/cosmos/cosmos-sdk/x/counter/types"
+)
+
+// msgsTx is a testTx with messages.
+type msgsTx struct {
+	testTx
+	msgs []sdk.Msg
+}
+
+func (tx msgsTx) GetMsgs() []sdk.Msg { return tx.msgs }
+
+func TestLaneMempool(t *testing.T) {
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 3)
+	sa, sb, sc := accounts[0].Address, accounts[1].Address, accounts[2].Address
+
+	isCritical := func(tx sdk.Tx) bool { return tx.(testTx).priority >= 100 }
+	mp := mempool.NewLaneMempool(
+		mempool.Lane{Name: "critical", Match: isCritical, Mempool: mempool.NewSenderNonceMempool(), MaxTxs: 2},
+		mempool.Lane{Name: "default", Mempool: mempool.DefaultPriorityMempool()},
+	)
+	require.Len(t, mp.Lanes(), 2)
+
+	txs := []testTx{
+		{id: 0, priority: 20, nonce: 0, address: sa},
+		{id: 1, priority: 10, nonce: 0, address: sb},
+		{id: 2, priority: 100, nonce: 0, address: sc},
+		{id: 3, priority: 100, nonce: 1, address: sc},
+		{id: 4, priority: 100, nonce: 2, address: sc},
+	}
+	for _, tx := range txs {
+		c := ctx.WithPriority(tx.priority)
+		require.NoError(t, mp.Insert(c, tx))
+	}
+	require.Equal(t, 5, mp.CountTx())
+	require.Equal(t, 3, mp.Lanes()[0].Mempool.CountTx())
+
+	// critical txs come first, up to the lane limit, then the default lane by priority
+	var selected []int
+	for iter := mp.Select(ctx, nil); iter != nil; iter = iter.Next() {
+		selected = append(selected, iter.Tx().(testTx).id)
+	}
+	require.Equal(t, []int{2, 3, 0, 1}, selected)
+
+	require.NoError(t, mp.Remove(txs[2]))
+	require.NoError(t, mp.Remove(txs[0]))
+	require.Equal(t, 3, mp.CountTx())
+	require.Equal(t, 2, mp.Lanes()[0].Mempool.CountTx())
+
+	// an empty lane is skipped
+	require.NoError(t, mp.Remove(txs[1]))
+	selected = nil
+	for iter := mp.Select(ctx, nil); iter != nil; iter = iter.Next() {
+		selected = append(selected, iter.Tx().(testTx).id)
+	}
+	require.Equal(t, []int{3, 4}, selected)
+}
+
+func TestLaneMempoolNoLaneMatched(t *testing.T) {
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 1)
+
+	mp := mempool.NewLaneMempool(
+		mempool.Lane{Name: "counter", Match: mempool.NewMsgTypeURLsMatcher(sdk.MsgTypeURL(&countertypes.MsgIncreaseCounter{})), Mempool: mempool.NewSenderNonceMempool()},
+	)
+	require.Nil(t, mp.Select(ctx, nil))
+
+	counterTx := msgsTx{testTx: testTx{address: accounts[0].Address}, msgs: []sdk.Msg{&countertypes.MsgIncreaseCounter{}}}
+	require.NoError(t, mp.Insert(ctx, counterTx))
+
+	mixedTx := msgsTx{testTx: testTx{address: accounts[0].Address, nonce: 1}, msgs: []sdk.Msg{&countertypes.MsgIncreaseCounter{}, &testdata.TestMsg{}}}
+	require.ErrorIs(t, mp.Insert(ctx, mixedTx), mempool.ErrNoLaneMatched)
+	require.ErrorIs(t, mp.Remove(mixedTx), mempool.ErrTxNotFound)
+	require.Equal(t, 1, mp.CountTx())
+}
+
+func TestNewLaneMempoolPanics(t *testing.T) {
+	require.Panics(t, func() { mempool.NewLaneMempool() })
+	require.Panics(t, func() {
+		mempool.NewLaneMempool(
+			mempool.Lane{Name: "default", Mempool: mempool.NewSenderNonceMempool()},
+			mempool.Lane{Name: "default", Mempool: mempool.NewSenderNonceMempool()},
+		)
+	})
+	require.Panics(t, func() { mempool.NewLaneMempool(mempool.Lane{Name: "default"}) })
+}
@@ -37,8 +37,11 @@ import (
 	"cosmossdk.io/core/genesis"
 	"cosmossdk.io/core/registry"
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/snapshots"
+	snapshot "cosmossdk.io/store/snapshots/types"
 	storetypes "cosmossdk.io/store/types"
 
+	"github.com/cosmos/cosmos-sdk/baseapp/storeexport"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -110,6 +113,24 @@ type HasServices interface {
 	RegisterServices(Configurator)
 }
 
+// HasSnapshotExtensions is the extension interface for modules with state kept
+// outside of their stores, e.g. lazily rebuilt indexes or caches, which must be
+// included in the state sync snapshots. The extensions are appended to the
+// snapshots after the stores and restored by the receiving nodes.
+type HasSnapshotExtensions interface {
+	SnapshotExtensions() []snapshot.ExtensionSnapshotter
+}
+
+// HasStoreImporters is the extension interface for modules migrating the entries
+// of their stores while an exported application state is imported, e.g. into a
+// new chain, instead of migrating their JSON genesis. The stores without an
+// importer are imported as is.
+type HasStoreImporters interface {
+	// StoreImporters returns the importers of the stores of the module, by
+	// store key name.
+	StoreImporters() map[string]storeexport.Importer
+}
+
 // MigrationHandler is the migration function that each module registers.
 type MigrationHandler func(sdk.Context) error
 
@@ -321,7 +342,19 @@ func (m *Manager) RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 func (m *Manager) DefaultGenesis() map[string]json.RawMessage {
 	genesisData := make(map[string]json.RawMessage)
 	for name, b := range m.Modules {
-		if mod, ok := b.(HasGenesisBasics); ok {
+		if mod, ok := b.(appmodule.HasGenesisAuto); ok {
+			// core API genesis
+			target := genesis.RawJSONTarget{}
+			if err := mod.DefaultGenesis(target.Target()); err != nil {
+				panic(err)
+			}
+
+			res, err := target.JSON()
+			if err != nil {
+				panic(err)
+			}
+			genesisData[name] = res
+		} else if mod, ok := b.(HasGenesisBasics); ok {
 			genesisData[mod.Name()] = mod.DefaultGenesis()
 		} else if mod, ok := b.(appmodule.HasGenesis); ok {
 			genesisData[name] = mod.DefaultGenesis()
@@ -335,16 +368,35 @@ func (m *Manager) DefaultGenesis() map[string]json.RawMessage {
 
 // ValidateGenesis performs genesis state validation for all modules
 func (m *Manager) ValidateGenesis(genesisData map[string]json.RawMessage) error {
-	for name, b := range m.Modules {
-		if mod, ok := b.(HasGenesisBasics); ok {
-			if err := mod.ValidateGenesis(genesisData[mod.Name()]); err != nil {
-				return err
-			}
-		} else if mod, ok := b.(appmodule.HasGenesis); ok {
-			if err := mod.ValidateGenesis(genesisData[name]); err != nil {
-				return err
-			}
+	for name := range m.Modules {
+		if err := m.ValidateModuleGenesis(name, genesisData[name]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ValidateModuleGenesis performs genesis state validation for a single module,
+// e.g. while a genesis file is streamed one module at a time. Unknown modules
+// are ignored.
+func (m *Manager) ValidateModuleGenesis(name string, data json.RawMessage) error {
+	if mod, ok := m.Modules[name].(appmodule.HasGenesisAuto); ok {
+		// core API genesis
+		if data == nil {
+			return nil
+		}
+
+		source, err := genesis.SourceFromRawJSON(data)
+		if err != nil {
+			return err
 		}
+
+		return mod.ValidateGenesis(source)
+	} else if mod, ok := m.Modules[name].(HasGenesisBasics); ok {
+		return mod.ValidateGenesis(data)
+	} else if mod, ok := m.Modules[name].(appmodule.HasGenesis); ok {
+		return mod.ValidateGenesis(data)
 	}
 
 	return nil
@@ -394,6 +446,54 @@ func (m *Manager) RegisterInvariants(ir sdk.InvariantRegistry) {
 	}
 }
 
+// RegisterSnapshotExtensions registers the snapshot extensions of the modules
+// implementing HasSnapshotExtensions with the snapshot manager. It is a no-op if
+// snapshots are disabled, i.e. the manager is nil.
+func (m *Manager) RegisterSnapshotExtensions(manager *snapshots.Manager) error {
+	if manager == nil {
+		return nil
+	}
+
+	moduleNames := m.ModuleNames()
+	sort.Strings(moduleNames)
+	for _, moduleName := range moduleNames {
+		module, ok := m.Modules[moduleName].(HasSnapshotExtensions)
+		if !ok {
+			continue
+		}
+
+		if err := manager.RegisterExtensions(module.SnapshotExtensions()...); err != nil {
+			return fmt.Errorf("failed to register snapshot extensions of module %s: %w", moduleName, err)
+		}
+	}
+
+	return nil
+}
+
+// StoreImporters returns the store importers of the modules implementing
+// HasStoreImporters, by store key name. A store must be imported by a single
+// module.
+func (m *Manager) StoreImporters() (map[string]storeexport.Importer, error) {
+	importers := make(map[string]storeexport.Importer)
+	moduleNames := m.ModuleNames()
+	sort.Strings(moduleNames)
+	for _, moduleName := range moduleNames {
+		module, ok := m.Modules[moduleName].(HasStoreImporters)
+		if !ok {
+			continue
+		}
+
+		for storeKey, importer := range module.StoreImporters() {
+			if _, ok := importers[storeKey]; ok {
+				return nil, fmt.Errorf("store %s is imported by several modules, including %s", storeKey, moduleName)
+			}
+			importers[storeKey] = importer
+		}
+	}
+
+	return importers, nil
+}
+
 // RegisterServices registers all module services
 func (m *Manager) RegisterServices(cfg Configurator) error {
 	for _, module := range m.Modules {
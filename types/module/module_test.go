@@ -15,8 +15,11 @@ import (
 
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/log"
+	"cosmossdk.io/store/snapshots"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
 	authtypes "cosmossdk.io/x/auth/types"
 
+	"github.com/cosmos/cosmos-sdk/baseapp/storeexport"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/testutil/mock"
@@ -125,6 +128,44 @@ func TestManager_RegisterInvariants(t *testing.T) {
 	mm.RegisterInvariants(mockInvariantRegistry)
 }
 
+func TestManager_RegisterSnapshotExtensions(t *testing.T) {
+	mm := module.NewManagerFromMap(map[string]appmodule.AppModule{
+		"module1": MockSnapshotAppModule{extensions: []snapshottypes.ExtensionSnapshotter{mockExtensionSnapshotter{"ext1"}, mockExtensionSnapshotter{"ext2"}}},
+		"module2": MockCoreAppModule{},
+	})
+
+	// snapshots are disabled
+	require.NoError(t, mm.RegisterSnapshotExtensions(nil))
+
+	manager := snapshots.NewManager(nil, snapshottypes.SnapshotOptions{}, nil, nil, log.NewNopLogger())
+	require.NoError(t, mm.RegisterSnapshotExtensions(manager))
+
+	// the extensions are already registered
+	require.Error(t, manager.RegisterExtensions(mockExtensionSnapshotter{"ext1"}))
+	require.Error(t, manager.RegisterExtensions(mockExtensionSnapshotter{"ext2"}))
+	require.ErrorContains(t, mm.RegisterSnapshotExtensions(manager), "module1")
+}
+
+func TestManager_StoreImporters(t *testing.T) {
+	importer := func(context.Context, storeexport.EntryReader) error { return nil }
+	mm := module.NewManagerFromMap(map[string]appmodule.AppModule{
+		"module1": MockStoreImportAppModule{importers: map[string]storeexport.Importer{"store1": importer, "store2": importer}},
+		"module2": MockStoreImportAppModule{importers: map[string]storeexport.Importer{"store3": importer}},
+		"module3": MockCoreAppModule{},
+	})
+
+	importers, err := mm.StoreImporters()
+	require.NoError(t, err)
+	require.Len(t, importers, 3)
+	require.Contains(t, importers, "store1")
+	require.Contains(t, importers, "store2")
+	require.Contains(t, importers, "store3")
+
+	mm.Modules["module3"] = MockStoreImportAppModule{importers: map[string]storeexport.Importer{"store1": importer}}
+	_, err = mm.StoreImporters()
+	require.ErrorContains(t, err, "store store1 is imported by several modules")
+}
+
 func TestManager_RegisterQueryServices(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	t.Cleanup(mockCtrl.Finish)
@@ -356,6 +397,32 @@ func TestCoreAPIManager_ExportGenesis(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestCoreAPIManager_DefaultValidateGenesis(t *testing.T) {
+	mm := module.NewManagerFromMap(map[string]appmodule.AppModule{
+		"module1": MockCoreAppModule{},
+		"module2": MockCoreAppModule{},
+	})
+
+	want := json.RawMessage(`{
+  "someField": "someKey"
+}`)
+	require.Equal(t, map[string]json.RawMessage{"module1": want, "module2": want}, mm.DefaultGenesis())
+
+	// the genesis of module2 is missing
+	require.NoError(t, mm.ValidateGenesis(map[string]json.RawMessage{
+		"module1": json.RawMessage(`{"someField": "dummy validation"}`),
+	}))
+	require.ErrorIs(t, mm.ValidateGenesis(map[string]json.RawMessage{
+		"module1": json.RawMessage(`{"someField": "dummy validation"}`),
+		"module2": want,
+	}), errFoo)
+
+	// the modules can be validated one at a time
+	require.NoError(t, mm.ValidateModuleGenesis("module1", json.RawMessage(`{"someField": "dummy validation"}`)))
+	require.ErrorIs(t, mm.ValidateModuleGenesis("module2", want), errFoo)
+	require.NoError(t, mm.ValidateModuleGenesis("unknown", want))
+}
+
 func TestCoreAPIManagerOrderSetters(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	t.Cleanup(mockCtrl.Finish)
@@ -519,6 +586,41 @@ func TestManager_Precommit(t *testing.T) {
 	require.EqualError(t, err, "some error")
 }
 
+// MockSnapshotAppModule is a module with snapshot extensions.
+type MockSnapshotAppModule struct {
+	MockCoreAppModule
+	extensions []snapshottypes.ExtensionSnapshotter
+}
+
+func (m MockSnapshotAppModule) SnapshotExtensions() []snapshottypes.ExtensionSnapshotter {
+	return m.extensions
+}
+
+// MockStoreImportAppModule is a module with store importers.
+type MockStoreImportAppModule struct {
+	MockCoreAppModule
+	importers map[string]storeexport.Importer
+}
+
+func (m MockStoreImportAppModule) StoreImporters() map[string]storeexport.Importer {
+	return m.importers
+}
+
+type mockExtensionSnapshotter struct {
+	name string
+}
+
+func (m mockExtensionSnapshotter) SnapshotName() string     { return m.name }
+func (mockExtensionSnapshotter) SnapshotFormat() uint32     { return 1 }
+func (mockExtensionSnapshotter) SupportedFormats() []uint32 { return []uint32{1} }
+func (mockExtensionSnapshotter) SnapshotExtension(uint64, snapshottypes.ExtensionPayloadWriter) error {
+	return nil
+}
+
+func (mockExtensionSnapshotter) RestoreExtension(uint64, uint32, snapshottypes.ExtensionPayloadReader) error {
+	return nil
+}
+
 // MockCoreAppModule allows us to test functions like DefaultGenesis
 type MockCoreAppModule struct{}
 
@@ -112,11 +112,14 @@ func CollectionFilteredPaginate[K, V any, C Collection[K, V], T any](
 	if errors.Is(err, collections.ErrInvalidIterator) {
 		return results, new(PageResponse), nil
 	}
+	if err != nil {
+		return nil, nil, err
+	}
 	// strip the prefix from next key
 	if len(pageRes.NextKey) != 0 && prefix != nil {
 		pageRes.NextKey = pageRes.NextKey[len(prefix):]
 	}
-	return results, pageRes, err
+	return results, pageRes, nil
 }
 
 // collFilteredPaginateNoKey applies the provided pagination on the collection when the starting key is not set.
@@ -7,10 +7,14 @@ import (
 	context "context"
 	fmt "fmt"
 	types1 "github.com/cometbft/cometbft/proto/tendermint/types"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	any "github.com/cosmos/gogoproto/types/any"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -499,6 +503,248 @@ func (m *SimulateResponse) GetResult() *types.Result {
 	return nil
 }
 
+// SimulateWithOverridesRequest is the request type for the
+// Service.SimulateWithOverrides RPC method.
+type SimulateWithOverridesRequest struct {
+	// tx_bytes is the raw transaction.
+	TxBytes []byte `protobuf:"bytes,1,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+	// accounts are the account overrides, applied before the balance overrides.
+	Accounts []*AccountOverride `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// balances are the balance overrides.
+	Balances []*BalanceOverride `protobuf:"bytes,3,rep,name=balances,proto3" json:"balances,omitempty"`
+}
+
+func (m *SimulateWithOverridesRequest) Reset()         { *m = SimulateWithOverridesRequest{} }
+func (m *SimulateWithOverridesRequest) String() string { return proto.CompactTextString(m) }
+func (*SimulateWithOverridesRequest) ProtoMessage()    {}
+func (*SimulateWithOverridesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{6}
+}
+func (m *SimulateWithOverridesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulateWithOverridesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulateWithOverridesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SimulateWithOverridesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateWithOverridesRequest.Merge(m, src)
+}
+func (m *SimulateWithOverridesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulateWithOverridesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateWithOverridesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateWithOverridesRequest proto.InternalMessageInfo
+
+func (m *SimulateWithOverridesRequest) GetTxBytes() []byte {
+	if m != nil {
+		return m.TxBytes
+	}
+	return nil
+}
+
+func (m *SimulateWithOverridesRequest) GetAccounts() []*AccountOverride {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+func (m *SimulateWithOverridesRequest) GetBalances() []*BalanceOverride {
+	if m != nil {
+		return m.Balances
+	}
+	return nil
+}
+
+// AccountOverride overrides an account for a simulation. The account is
+// created if it doesn't exist.
+type AccountOverride struct {
+	// address is the address of the account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// sequence is the sequence of the account.
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// pub_key is the public key of the account, left unchanged if empty.
+	PubKey *any.Any `protobuf:"bytes,3,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+}
+
+func (m *AccountOverride) Reset()         { *m = AccountOverride{} }
+func (m *AccountOverride) String() string { return proto.CompactTextString(m) }
+func (*AccountOverride) ProtoMessage()    {}
+func (*AccountOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{7}
+}
+func (m *AccountOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountOverride.Merge(m, src)
+}
+func (m *AccountOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountOverride proto.InternalMessageInfo
+
+func (m *AccountOverride) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AccountOverride) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *AccountOverride) GetPubKey() *any.Any {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+// BalanceOverride overrides the balance of an account for a simulation. The
+// account is created if it doesn't exist.
+type BalanceOverride struct {
+	// address is the address of the account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// balance replaces all the balances of the account.
+	Balance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=balance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balance"`
+}
+
+func (m *BalanceOverride) Reset()         { *m = BalanceOverride{} }
+func (m *BalanceOverride) String() string { return proto.CompactTextString(m) }
+func (*BalanceOverride) ProtoMessage()    {}
+func (*BalanceOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{8}
+}
+func (m *BalanceOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BalanceOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BalanceOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BalanceOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BalanceOverride.Merge(m, src)
+}
+func (m *BalanceOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *BalanceOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_BalanceOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BalanceOverride proto.InternalMessageInfo
+
+func (m *BalanceOverride) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *BalanceOverride) GetBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balance
+	}
+	return nil
+}
+
+// SimulateWithOverridesResponse is the response type for the
+// Service.SimulateWithOverrides RPC method.
+type SimulateWithOverridesResponse struct {
+	// gas_info is the information about gas used in the simulation.
+	GasInfo *types.GasInfo `protobuf:"bytes,1,opt,name=gas_info,json=gasInfo,proto3" json:"gas_info,omitempty"`
+	// result is the result of the simulation.
+	Result *types.Result `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (m *SimulateWithOverridesResponse) Reset()         { *m = SimulateWithOverridesResponse{} }
+func (m *SimulateWithOverridesResponse) String() string { return proto.CompactTextString(m) }
+func (*SimulateWithOverridesResponse) ProtoMessage()    {}
+func (*SimulateWithOverridesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{9}
+}
+func (m *SimulateWithOverridesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulateWithOverridesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulateWithOverridesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SimulateWithOverridesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateWithOverridesResponse.Merge(m, src)
+}
+func (m *SimulateWithOverridesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulateWithOverridesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateWithOverridesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateWithOverridesResponse proto.InternalMessageInfo
+
+func (m *SimulateWithOverridesResponse) GetGasInfo() *types.GasInfo {
+	if m != nil {
+		return m.GasInfo
+	}
+	return nil
+}
+
+func (m *SimulateWithOverridesResponse) GetResult() *types.Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
 // GetTxRequest is the request type for the Service.GetTx
 // RPC method.
 type GetTxRequest struct {
@@ -510,7 +756,7 @@ func (m *GetTxRequest) Reset()         { *m = GetTxRequest{} }
 func (m *GetTxRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTxRequest) ProtoMessage()    {}
 func (*GetTxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{6}
+	return fileDescriptor_e0b00a618705eca7, []int{10}
 }
 func (m *GetTxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -558,7 +804,7 @@ func (m *GetTxResponse) Reset()         { *m = GetTxResponse{} }
 func (m *GetTxResponse) String() string { return proto.CompactTextString(m) }
 func (*GetTxResponse) ProtoMessage()    {}
 func (*GetTxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{7}
+	return fileDescriptor_e0b00a618705eca7, []int{11}
 }
 func (m *GetTxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -616,7 +862,7 @@ func (m *GetBlockWithTxsRequest) Reset()         { *m = GetBlockWithTxsRequest{}
 func (m *GetBlockWithTxsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetBlockWithTxsRequest) ProtoMessage()    {}
 func (*GetBlockWithTxsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{8}
+	return fileDescriptor_e0b00a618705eca7, []int{12}
 }
 func (m *GetBlockWithTxsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -676,7 +922,7 @@ func (m *GetBlockWithTxsResponse) Reset()         { *m = GetBlockWithTxsResponse
 func (m *GetBlockWithTxsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetBlockWithTxsResponse) ProtoMessage()    {}
 func (*GetBlockWithTxsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{9}
+	return fileDescriptor_e0b00a618705eca7, []int{13}
 }
 func (m *GetBlockWithTxsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -746,7 +992,7 @@ func (m *TxDecodeRequest) Reset()         { *m = TxDecodeRequest{} }
 func (m *TxDecodeRequest) String() string { return proto.CompactTextString(m) }
 func (*TxDecodeRequest) ProtoMessage()    {}
 func (*TxDecodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{10}
+	return fileDescriptor_e0b00a618705eca7, []int{14}
 }
 func (m *TxDecodeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -795,7 +1041,7 @@ func (m *TxDecodeResponse) Reset()         { *m = TxDecodeResponse{} }
 func (m *TxDecodeResponse) String() string { return proto.CompactTextString(m) }
 func (*TxDecodeResponse) ProtoMessage()    {}
 func (*TxDecodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{11}
+	return fileDescriptor_e0b00a618705eca7, []int{15}
 }
 func (m *TxDecodeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -844,7 +1090,7 @@ func (m *TxEncodeRequest) Reset()         { *m = TxEncodeRequest{} }
 func (m *TxEncodeRequest) String() string { return proto.CompactTextString(m) }
 func (*TxEncodeRequest) ProtoMessage()    {}
 func (*TxEncodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{12}
+	return fileDescriptor_e0b00a618705eca7, []int{16}
 }
 func (m *TxEncodeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -893,7 +1139,7 @@ func (m *TxEncodeResponse) Reset()         { *m = TxEncodeResponse{} }
 func (m *TxEncodeResponse) String() string { return proto.CompactTextString(m) }
 func (*TxEncodeResponse) ProtoMessage()    {}
 func (*TxEncodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{13}
+	return fileDescriptor_e0b00a618705eca7, []int{17}
 }
 func (m *TxEncodeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -941,7 +1187,7 @@ func (m *TxEncodeAminoRequest) Reset()         { *m = TxEncodeAminoRequest{} }
 func (m *TxEncodeAminoRequest) String() string { return proto.CompactTextString(m) }
 func (*TxEncodeAminoRequest) ProtoMessage()    {}
 func (*TxEncodeAminoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{14}
+	return fileDescriptor_e0b00a618705eca7, []int{18}
 }
 func (m *TxEncodeAminoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -989,7 +1235,7 @@ func (m *TxEncodeAminoResponse) Reset()         { *m = TxEncodeAminoResponse{} }
 func (m *TxEncodeAminoResponse) String() string { return proto.CompactTextString(m) }
 func (*TxEncodeAminoResponse) ProtoMessage()    {}
 func (*TxEncodeAminoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{15}
+	return fileDescriptor_e0b00a618705eca7, []int{19}
 }
 func (m *TxEncodeAminoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1037,7 +1283,7 @@ func (m *TxDecodeAminoRequest) Reset()         { *m = TxDecodeAminoRequest{} }
 func (m *TxDecodeAminoRequest) String() string { return proto.CompactTextString(m) }
 func (*TxDecodeAminoRequest) ProtoMessage()    {}
 func (*TxDecodeAminoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{16}
+	return fileDescriptor_e0b00a618705eca7, []int{20}
 }
 func (m *TxDecodeAminoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1085,7 +1331,7 @@ func (m *TxDecodeAminoResponse) Reset()         { *m = TxDecodeAminoResponse{} }
 func (m *TxDecodeAminoResponse) String() string { return proto.CompactTextString(m) }
 func (*TxDecodeAminoResponse) ProtoMessage()    {}
 func (*TxDecodeAminoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{17}
+	return fileDescriptor_e0b00a618705eca7, []int{21}
 }
 func (m *TxDecodeAminoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1130,6 +1376,10 @@ func init() {
 	proto.RegisterType((*BroadcastTxResponse)(nil), "cosmos.tx.v1beta1.BroadcastTxResponse")
 	proto.RegisterType((*SimulateRequest)(nil), "cosmos.tx.v1beta1.SimulateRequest")
 	proto.RegisterType((*SimulateResponse)(nil), "cosmos.tx.v1beta1.SimulateResponse")
+	proto.RegisterType((*SimulateWithOverridesRequest)(nil), "cosmos.tx.v1beta1.SimulateWithOverridesRequest")
+	proto.RegisterType((*AccountOverride)(nil), "cosmos.tx.v1beta1.AccountOverride")
+	proto.RegisterType((*BalanceOverride)(nil), "cosmos.tx.v1beta1.BalanceOverride")
+	proto.RegisterType((*SimulateWithOverridesResponse)(nil), "cosmos.tx.v1beta1.SimulateWithOverridesResponse")
 	proto.RegisterType((*GetTxRequest)(nil), "cosmos.tx.v1beta1.GetTxRequest")
 	proto.RegisterType((*GetTxResponse)(nil), "cosmos.tx.v1beta1.GetTxResponse")
 	proto.RegisterType((*GetBlockWithTxsRequest)(nil), "cosmos.tx.v1beta1.GetBlockWithTxsRequest")
@@ -1147,85 +1397,103 @@ func init() {
 func init() { proto.RegisterFile("cosmos/tx/v1beta1/service.proto", fileDescriptor_e0b00a618705eca7) }
 
 var fileDescriptor_e0b00a618705eca7 = []byte{
-	// 1237 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x4d, 0x4f, 0x1b, 0x47,
-	0x18, 0x66, 0x6d, 0x83, 0xcd, 0x6b, 0x08, 0xce, 0x40, 0xc0, 0x38, 0xc4, 0x98, 0x0d, 0x1f, 0x8e,
-	0x55, 0xbc, 0x0a, 0x4d, 0xaa, 0x24, 0xaa, 0x54, 0xe1, 0x8f, 0x50, 0x92, 0x26, 0x44, 0x6b, 0xaa,
-	0x28, 0x55, 0x25, 0x6b, 0x6d, 0x4f, 0xec, 0x6d, 0xec, 0x5d, 0xe3, 0x19, 0xd0, 0x5a, 0x14, 0xb5,
-	0xea, 0xb1, 0x87, 0xaa, 0x52, 0x0f, 0xfd, 0x0f, 0xfd, 0x25, 0x3d, 0x46, 0xea, 0xa5, 0xc7, 0x0a,
-	0x7a, 0xea, 0xa9, 0x52, 0xff, 0x40, 0xb5, 0x33, 0xb3, 0xb6, 0xd7, 0xde, 0xc5, 0x90, 0x0b, 0xcc,
-	0xc7, 0xf3, 0xbe, 0xcf, 0x33, 0xef, 0xcc, 0x3c, 0x3b, 0x86, 0xd5, 0xaa, 0x49, 0x5a, 0x26, 0x51,
-	0xa8, 0xa5, 0x9c, 0xdc, 0xaf, 0x60, 0xaa, 0xdd, 0x57, 0x08, 0xee, 0x9c, 0xe8, 0x55, 0x9c, 0x6d,
-	0x77, 0x4c, 0x6a, 0xa2, 0x9b, 0x1c, 0x90, 0xa5, 0x56, 0x56, 0x00, 0x12, 0x2b, 0x75, 0xd3, 0xac,
-	0x37, 0xb1, 0xa2, 0xb5, 0x75, 0x45, 0x33, 0x0c, 0x93, 0x6a, 0x54, 0x37, 0x0d, 0xc2, 0x03, 0x12,
-	0x77, 0x45, 0xc6, 0x8a, 0x46, 0xb0, 0xa2, 0x55, 0xaa, 0x7a, 0x2f, 0xb1, 0xdd, 0x11, 0xa0, 0xc4,
-	0x28, 0x2d, 0xb5, 0xc4, 0x5c, 0x66, 0x30, 0xc1, 0xd1, 0x31, 0xee, 0x74, 0x7b, 0x98, 0xb6, 0x56,
-	0xd7, 0x0d, 0xc6, 0x26, 0xb0, 0x2b, 0x14, 0x1b, 0x35, 0xdc, 0x69, 0xe9, 0x06, 0x55, 0x68, 0xb7,
-	0x8d, 0x89, 0x52, 0x69, 0x9a, 0xd5, 0x77, 0xbe, 0xb3, 0xec, 0x2f, 0x9f, 0x95, 0xff, 0x93, 0x00,
-	0xed, 0x61, 0x7a, 0x68, 0x91, 0xe2, 0x09, 0x36, 0xa8, 0x8a, 0x8f, 0x8e, 0x31, 0xa1, 0x28, 0x01,
-	0x53, 0xd8, 0xee, 0x93, 0xb8, 0x94, 0x0a, 0xa6, 0xa7, 0x73, 0x81, 0xb8, 0xa4, 0x8a, 0x11, 0xf4,
-	0x0c, 0xa0, 0x2f, 0x21, 0x1e, 0x48, 0x49, 0xe9, 0xe8, 0xce, 0x66, 0x56, 0x54, 0xc8, 0xd6, 0x9b,
-	0x65, 0x7a, 0x9d, 0x4a, 0x65, 0x5f, 0x69, 0x75, 0x2c, 0xf2, 0xb2, 0x3c, 0x03, 0xd1, 0xe8, 0x21,
-	0x44, 0xcc, 0x4e, 0x0d, 0x77, 0xca, 0x95, 0x6e, 0x3c, 0x98, 0x92, 0xd2, 0x37, 0x76, 0x12, 0xd9,
-	0x91, 0x5a, 0x67, 0x0f, 0x6c, 0x48, 0xae, 0xab, 0x86, 0x4d, 0xde, 0x40, 0x08, 0x42, 0x6d, 0xad,
-	0x8e, 0xe3, 0xa1, 0x94, 0x94, 0x0e, 0xa9, 0xac, 0x8d, 0x16, 0x60, 0xb2, 0xa9, 0xb7, 0x74, 0x1a,
-	0x9f, 0x64, 0x83, 0xbc, 0x63, 0x8f, 0x32, 0x35, 0xf1, 0xa9, 0x94, 0x94, 0x9e, 0x56, 0x79, 0x47,
-	0xfe, 0x47, 0x82, 0x79, 0xd7, 0xaa, 0x49, 0xdb, 0x34, 0x08, 0x46, 0x5b, 0x10, 0xa4, 0x16, 0x5f,
-	0x73, 0x74, 0xe7, 0x96, 0x87, 0x92, 0x43, 0x4b, 0xb5, 0x11, 0x68, 0x0f, 0x66, 0xa8, 0x55, 0xee,
-	0x88, 0x38, 0x12, 0x0f, 0xb0, 0x88, 0x75, 0x57, 0x15, 0xd8, 0x4e, 0x0f, 0x04, 0x0a, 0xb0, 0x1a,
-	0xa5, 0xbd, 0x36, 0x41, 0xcf, 0x5d, 0xc5, 0x0c, 0xb2, 0x62, 0x6e, 0x8d, 0x2d, 0x26, 0x8f, 0x1e,
-	0xa9, 0xe6, 0x02, 0x4c, 0x52, 0x93, 0x6a, 0x4d, 0x51, 0x17, 0xde, 0x91, 0x31, 0xa0, 0x5c, 0xc7,
-	0xd4, 0x6a, 0x55, 0x8d, 0x50, 0x5b, 0x06, 0xdf, 0xe1, 0x65, 0x88, 0x50, 0xab, 0x5c, 0xe9, 0x52,
-	0x6c, 0xaf, 0x57, 0x4a, 0xcf, 0xa8, 0x61, 0x6a, 0xe5, 0xec, 0x2e, 0x7a, 0x00, 0xa1, 0x96, 0x59,
-	0xc3, 0x6c, 0x6b, 0x6f, 0xec, 0xa4, 0x3c, 0xca, 0xd0, 0xcb, 0xf7, 0xc2, 0xac, 0x61, 0x95, 0xa1,
-	0xe5, 0xaf, 0x61, 0xde, 0x45, 0x23, 0x4a, 0x5a, 0x84, 0xe8, 0x40, 0xa5, 0x18, 0xd5, 0x55, 0x0b,
-	0x05, 0xfd, 0x42, 0xc9, 0xaf, 0x61, 0xae, 0xa4, 0xb7, 0x8e, 0x9b, 0x1a, 0x75, 0xce, 0x12, 0xba,
-	0x07, 0x01, 0x6a, 0x89, 0x84, 0xde, 0x7b, 0xc5, 0x0a, 0x14, 0xa0, 0x96, 0x6b, 0xb1, 0x01, 0xd7,
-	0x62, 0xe5, 0x1f, 0x25, 0x88, 0xf5, 0x33, 0x0b, 0xd1, 0x9f, 0x42, 0xa4, 0xae, 0x91, 0xb2, 0x6e,
-	0xbc, 0x35, 0x05, 0xc1, 0x9a, 0xbf, 0xe2, 0x3d, 0x8d, 0xec, 0x1b, 0x6f, 0x4d, 0x35, 0x5c, 0xe7,
-	0x0d, 0xf4, 0x08, 0xa6, 0x3a, 0x98, 0x1c, 0x37, 0xa9, 0xb8, 0x1c, 0x29, 0xff, 0x58, 0x95, 0xe1,
-	0x54, 0x81, 0x97, 0x65, 0x98, 0x61, 0xc7, 0xd2, 0x59, 0x22, 0x82, 0x50, 0x43, 0x23, 0x0d, 0xa6,
-	0x61, 0x5a, 0x65, 0x6d, 0xf9, 0x0c, 0x66, 0x05, 0x46, 0x88, 0xdd, 0x18, 0x5b, 0x07, 0x56, 0x83,
-	0xa1, 0x8d, 0x08, 0x7c, 0xe0, 0x46, 0x58, 0xb0, 0xb8, 0x87, 0x69, 0xce, 0x36, 0x98, 0xd7, 0x3a,
-	0x6d, 0x1c, 0x5a, 0xc4, 0x11, 0xbb, 0x08, 0x53, 0x0d, 0xac, 0xd7, 0x1b, 0x94, 0x69, 0x09, 0xaa,
-	0xa2, 0x87, 0x9e, 0x7e, 0xb8, 0x5f, 0x0c, 0x9e, 0x6e, 0xf9, 0x5f, 0x09, 0x96, 0x46, 0xa8, 0xaf,
-	0x7b, 0x71, 0x1f, 0x40, 0x84, 0x99, 0x63, 0x59, 0xaf, 0x09, 0x29, 0xcb, 0xd9, 0xbe, 0x41, 0x66,
-	0xb9, 0x35, 0x32, 0x8a, 0xfd, 0x82, 0x1a, 0x66, 0xd0, 0xfd, 0x1a, 0xda, 0x86, 0x49, 0xd6, 0x14,
-	0x17, 0x74, 0xc9, 0x27, 0x44, 0xe5, 0x28, 0xb4, 0xe7, 0x5a, 0x71, 0xe8, 0x5a, 0x97, 0xda, 0xb5,
-	0xe4, 0x8f, 0x60, 0xee, 0xd0, 0x2a, 0xe0, 0xaa, 0x7d, 0xcb, 0xc6, 0xde, 0x5b, 0xf9, 0x31, 0xc4,
-	0xfa, 0xe8, 0x6b, 0x1d, 0x0e, 0xf9, 0x91, 0x4d, 0x54, 0x34, 0x06, 0x89, 0xae, 0x18, 0xb9, 0x6d,
-	0x93, 0x3a, 0x91, 0x82, 0xf4, 0x12, 0x8d, 0x0f, 0x61, 0xc1, 0x81, 0xef, 0xb6, 0x74, 0xc3, 0x74,
-	0xd8, 0xee, 0x00, 0x68, 0x76, 0xbf, 0xfc, 0x0d, 0x31, 0x0d, 0x71, 0xde, 0xa7, 0xd9, 0xc8, 0x33,
-	0x62, 0x1a, 0xf2, 0x13, 0xb8, 0x35, 0x14, 0x26, 0xa8, 0xd6, 0x60, 0x86, 0xc7, 0x55, 0x74, 0x43,
-	0xeb, 0x74, 0x05, 0x5d, 0x94, 0x8d, 0xe5, 0xd8, 0x90, 0xfc, 0xd8, 0xa6, 0xe4, 0x65, 0x71, 0x51,
-	0x5e, 0x21, 0xf4, 0x13, 0x9b, 0xd6, 0x15, 0x2a, 0x68, 0x2f, 0x97, 0x9b, 0xf9, 0x1c, 0xc2, 0xe2,
-	0x9b, 0x85, 0xe2, 0xb0, 0x70, 0xa0, 0x16, 0x8a, 0x6a, 0x39, 0xf7, 0xa6, 0xfc, 0xe5, 0xcb, 0xd2,
-	0xab, 0x62, 0x7e, 0xff, 0xe9, 0x7e, 0xb1, 0x10, 0x9b, 0x40, 0x31, 0x98, 0xe9, 0xcd, 0xec, 0x96,
-	0xf2, 0x31, 0x09, 0xdd, 0x84, 0xd9, 0xde, 0x48, 0xa1, 0x58, 0xca, 0xc7, 0x02, 0x99, 0xef, 0x25,
-	0x98, 0x75, 0xb9, 0x2d, 0x4a, 0x42, 0x22, 0xa7, 0x1e, 0xec, 0x16, 0xf2, 0xbb, 0xa5, 0xc3, 0xf2,
-	0x8b, 0x83, 0x42, 0x71, 0x28, 0xed, 0x0a, 0x2c, 0x0c, 0xcd, 0xe7, 0xbe, 0x38, 0xc8, 0x3f, 0x8f,
-	0x49, 0x89, 0x40, 0x44, 0x42, 0x4b, 0x30, 0x3f, 0x34, 0x5b, 0x7a, 0xf3, 0x32, 0x1f, 0x0b, 0xd8,
-	0x3a, 0x87, 0x26, 0x76, 0xd9, 0x4c, 0x70, 0xe7, 0xb7, 0x69, 0x08, 0x97, 0xf8, 0x73, 0x08, 0x9d,
-	0x42, 0xc4, 0x31, 0x4b, 0x24, 0x7b, 0x1c, 0x8a, 0x21, 0x8f, 0x4e, 0xdc, 0xbd, 0x14, 0x23, 0x2c,
-	0x65, 0xf3, 0x87, 0x3f, 0xfe, 0xfe, 0x25, 0x90, 0x7a, 0x22, 0x65, 0xe4, 0xdb, 0x8a, 0xc7, 0x53,
-	0xcc, 0x21, 0x3c, 0x82, 0x49, 0xe6, 0x7c, 0x68, 0xd5, 0x23, 0xeb, 0xa0, 0x6f, 0x26, 0x52, 0xfe,
-	0x00, 0xc1, 0xb9, 0xc1, 0x38, 0x57, 0xd1, 0x1d, 0xc5, 0xeb, 0x11, 0x46, 0x94, 0x53, 0xdb, 0x6b,
-	0xcf, 0xd0, 0x77, 0x10, 0x1d, 0xf8, 0xa8, 0xa1, 0x8d, 0xcb, 0xbe, 0x85, 0x7d, 0xfa, 0xcd, 0x71,
-	0x30, 0x21, 0x62, 0x8d, 0x89, 0xb8, 0x6d, 0x2f, 0x7c, 0xd1, 0x5b, 0x07, 0xfa, 0x16, 0xa2, 0x03,
-	0x0f, 0x15, 0x4f, 0x01, 0xa3, 0xcf, 0x37, 0x4f, 0x01, 0x1e, 0xef, 0x1d, 0x39, 0xc9, 0x04, 0xc4,
-	0x91, 0x1f, 0xfb, 0xaf, 0x12, 0xcc, 0x0d, 0x59, 0x2e, 0xba, 0xe7, 0x9d, 0xdb, 0xe3, 0x8b, 0x90,
-	0xc8, 0x5c, 0x05, 0x2a, 0xa4, 0x6c, 0x33, 0x29, 0x5b, 0x68, 0xc3, 0x67, 0x43, 0x98, 0xb3, 0x2a,
-	0xa7, 0xfc, 0x9b, 0x72, 0x86, 0xba, 0x10, 0x71, 0x6e, 0xa6, 0xe7, 0x41, 0x1c, 0xb2, 0x4d, 0xcf,
-	0x83, 0x38, 0x6c, 0x96, 0xf2, 0x3a, 0xd3, 0x90, 0xb4, 0xf7, 0x63, 0xd9, 0x43, 0x46, 0x8d, 0xd3,
-	0x31, 0x6a, 0xee, 0x45, 0x3e, 0xd4, 0x2e, 0x23, 0xf5, 0xa1, 0x76, 0x5b, 0xe6, 0x38, 0x6a, 0xcc,
-	0xe9, 0x7e, 0x92, 0x60, 0xd6, 0xe5, 0x83, 0x68, 0xeb, 0x92, 0xe4, 0x83, 0x6e, 0x97, 0x48, 0x8f,
-	0x07, 0x0a, 0x29, 0x19, 0x26, 0x65, 0xdd, 0x96, 0xb2, 0xea, 0x2b, 0x45, 0x61, 0x66, 0x27, 0x04,
-	0x0d, 0x38, 0xa4, 0x8f, 0xa0, 0x51, 0xfb, 0xf5, 0x11, 0xe4, 0x61, 0xb6, 0xe3, 0x04, 0xf1, 0x6d,
-	0xe1, 0x82, 0x72, 0x9f, 0xfd, 0x7e, 0x9e, 0x94, 0xde, 0x9f, 0x27, 0xa5, 0xbf, 0xce, 0x93, 0xd2,
-	0xcf, 0x17, 0xc9, 0x89, 0xf7, 0x17, 0xc9, 0x89, 0x3f, 0x2f, 0x92, 0x13, 0x5f, 0x6d, 0xd4, 0x75,
-	0xda, 0x38, 0xae, 0x64, 0xab, 0x66, 0xcb, 0x49, 0xc2, 0xff, 0x6d, 0x93, 0xda, 0x3b, 0xe7, 0x97,
-	0x91, 0x55, 0x99, 0x62, 0xbf, 0x8b, 0x3e, 0xfe, 0x3f, 0x00, 0x00, 0xff, 0xff, 0x42, 0xa8, 0xd7,
-	0x97, 0x14, 0x0e, 0x00, 0x00,
+	// 1523 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcf, 0x6f, 0x13, 0xc7,
+	0x17, 0xcf, 0x3a, 0x3f, 0xec, 0xbc, 0x24, 0xc4, 0x0c, 0x0e, 0x38, 0x4b, 0x70, 0xcc, 0x42, 0x12,
+	0x63, 0x7d, 0xb3, 0x0b, 0xf9, 0x42, 0x05, 0xa8, 0x6a, 0xe5, 0xb5, 0x4d, 0x1a, 0x28, 0x04, 0xad,
+	0x53, 0x21, 0xaa, 0x4a, 0xd6, 0xda, 0x1e, 0xec, 0x2d, 0xf6, 0x8e, 0xd9, 0x1d, 0xa7, 0x6b, 0x51,
+	0xd4, 0xaa, 0xc7, 0x1e, 0xaa, 0x4a, 0x3d, 0xf0, 0x3f, 0xa0, 0x5e, 0x2a, 0xf5, 0xd2, 0xff, 0x00,
+	0xf5, 0x84, 0xda, 0x4b, 0x4f, 0xb4, 0x82, 0x9e, 0x7a, 0xaa, 0xd4, 0x7f, 0xa0, 0xda, 0xd9, 0x59,
+	0xff, 0xca, 0xfa, 0x07, 0x5c, 0x7a, 0xb1, 0x67, 0xe6, 0x7d, 0xde, 0x7c, 0x3e, 0xf3, 0x66, 0xe6,
+	0xbd, 0x59, 0x58, 0x2f, 0x13, 0xbb, 0x41, 0x6c, 0x85, 0x3a, 0xca, 0xe1, 0xa5, 0x12, 0xa6, 0xfa,
+	0x25, 0xc5, 0xc6, 0xd6, 0xa1, 0x51, 0xc6, 0x72, 0xd3, 0x22, 0x94, 0xa0, 0xe3, 0x1e, 0x40, 0xa6,
+	0x8e, 0xcc, 0x01, 0xe2, 0x5a, 0x95, 0x90, 0x6a, 0x1d, 0x2b, 0x7a, 0xd3, 0x50, 0x74, 0xd3, 0x24,
+	0x54, 0xa7, 0x06, 0x31, 0x6d, 0xcf, 0x41, 0x5c, 0xe5, 0x56, 0xd6, 0x2b, 0xb5, 0x1e, 0x28, 0xba,
+	0xd9, 0xe6, 0xa6, 0x58, 0x95, 0x54, 0x09, 0x6b, 0x2a, 0x6e, 0xcb, 0x77, 0xf0, 0x18, 0x8a, 0x9e,
+	0x81, 0xd3, 0x79, 0xa6, 0x73, 0x5c, 0x5d, 0x49, 0xb7, 0xb1, 0xa2, 0x97, 0xca, 0x46, 0x47, 0xa4,
+	0xdb, 0xe1, 0xa0, 0x44, 0x2f, 0xc8, 0xb7, 0x97, 0x89, 0x61, 0x72, 0xbb, 0x78, 0x74, 0x89, 0xd4,
+	0xe1, 0xb6, 0x74, 0xaf, 0xef, 0xa3, 0x16, 0xb6, 0xda, 0x1d, 0x4c, 0x53, 0xaf, 0x1a, 0x26, 0x5b,
+	0x19, 0xc7, 0xae, 0x51, 0x6c, 0x56, 0xb0, 0xd5, 0x30, 0x4c, 0xaa, 0xd0, 0x76, 0x13, 0xdb, 0x4a,
+	0xa9, 0x4e, 0xca, 0x0f, 0x87, 0x5a, 0xd9, 0xaf, 0x67, 0x95, 0xfe, 0x11, 0x00, 0xed, 0x62, 0x7a,
+	0xe0, 0xd8, 0xf9, 0x43, 0x6c, 0x52, 0x0d, 0x3f, 0x6a, 0x61, 0x9b, 0x22, 0x11, 0xe6, 0xb0, 0xdb,
+	0xb7, 0xe3, 0x42, 0x72, 0x3a, 0x35, 0xaf, 0x86, 0xe2, 0x82, 0xc6, 0x47, 0xd0, 0x4d, 0x80, 0xae,
+	0x84, 0x78, 0x28, 0x29, 0xa4, 0x16, 0x76, 0x36, 0x65, 0x1e, 0x1e, 0x57, 0xaf, 0xcc, 0xf4, 0xfa,
+	0xbb, 0x22, 0xdf, 0xd5, 0xab, 0x98, 0xcf, 0xcb, 0xe6, 0xe9, 0xf1, 0x46, 0x57, 0x20, 0x42, 0xac,
+	0x0a, 0xb6, 0x8a, 0xa5, 0x76, 0x7c, 0x3a, 0x29, 0xa4, 0x8e, 0xed, 0x88, 0xf2, 0x91, 0x7d, 0x95,
+	0xf7, 0x5d, 0x88, 0xda, 0xd6, 0xc2, 0xc4, 0x6b, 0x20, 0x04, 0x33, 0x4d, 0xbd, 0x8a, 0xe3, 0x33,
+	0x49, 0x21, 0x35, 0xa3, 0xb1, 0x36, 0x8a, 0xc1, 0x6c, 0xdd, 0x68, 0x18, 0x34, 0x3e, 0xcb, 0x06,
+	0xbd, 0x8e, 0x3b, 0xca, 0xd4, 0xc4, 0xe7, 0x92, 0x42, 0x6a, 0x5e, 0xf3, 0x3a, 0xd2, 0x5f, 0x02,
+	0x9c, 0xe8, 0x5b, 0xb5, 0xdd, 0x24, 0xa6, 0x8d, 0xd1, 0x16, 0x4c, 0x53, 0xc7, 0x5b, 0xf3, 0xc2,
+	0xce, 0x4a, 0x80, 0x92, 0x03, 0x47, 0x73, 0x11, 0x68, 0x17, 0x16, 0xa9, 0x53, 0xb4, 0xb8, 0x9f,
+	0x1d, 0x0f, 0x31, 0x8f, 0xf3, 0x7d, 0x51, 0x60, 0x27, 0xa1, 0xc7, 0x91, 0x83, 0xb5, 0x05, 0xda,
+	0x69, 0xdb, 0xe8, 0x56, 0x5f, 0x30, 0xa7, 0x59, 0x30, 0xb7, 0xc6, 0x06, 0xd3, 0xf3, 0x3e, 0x12,
+	0xcd, 0x18, 0xcc, 0x52, 0x42, 0xf5, 0x3a, 0x8f, 0x8b, 0xd7, 0x91, 0x30, 0x20, 0xd5, 0x22, 0x7a,
+	0xa5, 0xac, 0xdb, 0xf4, 0xc0, 0xe1, 0x3b, 0x81, 0x56, 0x21, 0x42, 0x9d, 0x62, 0xa9, 0x4d, 0xb1,
+	0xbb, 0x5e, 0x21, 0xb5, 0xa8, 0x85, 0xa9, 0xa3, 0xba, 0x5d, 0x74, 0x19, 0x66, 0x1a, 0xa4, 0x82,
+	0xd9, 0xd6, 0x1e, 0xdb, 0x49, 0x06, 0x84, 0xa1, 0x33, 0xdf, 0x6d, 0x52, 0xc1, 0x1a, 0x43, 0x4b,
+	0x9f, 0xc0, 0x89, 0x3e, 0x1a, 0x1e, 0xd2, 0x3c, 0x2c, 0xf4, 0x44, 0x8a, 0x51, 0x4d, 0x1a, 0x28,
+	0xe8, 0x06, 0x4a, 0xba, 0x07, 0xcb, 0x05, 0xa3, 0xd1, 0xaa, 0xeb, 0xd4, 0x3f, 0x4b, 0xe8, 0x02,
+	0x84, 0xa8, 0xc3, 0x27, 0x0c, 0xde, 0x2b, 0x16, 0xa0, 0x10, 0x75, 0xfa, 0x16, 0x1b, 0xea, 0x5b,
+	0xac, 0xf4, 0xb5, 0x00, 0xd1, 0xee, 0xcc, 0x5c, 0xf4, 0xbb, 0x10, 0xa9, 0xea, 0x76, 0xd1, 0x30,
+	0x1f, 0x10, 0x4e, 0x70, 0x76, 0xb8, 0xe2, 0x5d, 0xdd, 0xde, 0x33, 0x1f, 0x10, 0x2d, 0x5c, 0xf5,
+	0x1a, 0xe8, 0x2a, 0xcc, 0x59, 0xd8, 0x6e, 0xd5, 0x29, 0xbf, 0x1c, 0xc9, 0xe1, 0xbe, 0x1a, 0xc3,
+	0x69, 0x1c, 0x2f, 0xfd, 0x24, 0xc0, 0x9a, 0x2f, 0xe6, 0x9e, 0x41, 0x6b, 0xfb, 0x87, 0xd8, 0xb2,
+	0x8c, 0x0a, 0xb6, 0x27, 0xd8, 0xb5, 0xf7, 0x20, 0xa2, 0x97, 0xcb, 0xa4, 0x65, 0x52, 0xff, 0x38,
+	0x4a, 0x01, 0x41, 0xc9, 0x78, 0x10, 0x7f, 0x62, 0xad, 0xe3, 0xe3, 0xfa, 0x97, 0xf4, 0xba, 0x6e,
+	0x96, 0xb1, 0x1d, 0x9f, 0x1e, 0xea, 0xaf, 0x7a, 0x90, 0xae, 0xbf, 0xef, 0x23, 0x3d, 0x13, 0x60,
+	0x79, 0x60, 0x76, 0xb4, 0x03, 0x61, 0xbd, 0x52, 0xb1, 0xb0, 0xed, 0xa9, 0x9d, 0x57, 0xe3, 0xbf,
+	0xfc, 0xb8, 0x1d, 0xe3, 0xb3, 0x66, 0x3c, 0x4b, 0x81, 0x5a, 0x86, 0x59, 0xd5, 0x7c, 0x20, 0x12,
+	0x21, 0x62, 0xbb, 0xab, 0x35, 0xcb, 0xde, 0x09, 0x9c, 0xd1, 0x3a, 0x7d, 0xb4, 0x0b, 0xe1, 0x66,
+	0xab, 0x54, 0x7c, 0x88, 0xdb, 0xfc, 0xaa, 0xc4, 0x64, 0x2f, 0xa9, 0xcb, 0x7e, 0x52, 0x97, 0x33,
+	0x66, 0x5b, 0x8d, 0xff, 0xdc, 0x65, 0x29, 0x5b, 0xed, 0x26, 0x25, 0xf2, 0xdd, 0x56, 0xe9, 0x16,
+	0x6e, 0x6b, 0x73, 0x4d, 0xf6, 0x2f, 0x7d, 0x2f, 0xc0, 0xf2, 0xc0, 0x52, 0xde, 0x4a, 0x2c, 0x86,
+	0x30, 0x0f, 0x00, 0x8f, 0xf9, 0x6a, 0xdf, 0x5e, 0xfb, 0x51, 0xcb, 0x12, 0xc3, 0x54, 0x2f, 0x3e,
+	0x7f, 0xb9, 0x3e, 0xf5, 0xec, 0xf7, 0xf5, 0x54, 0xd5, 0xa0, 0xb5, 0x56, 0x49, 0x2e, 0x93, 0x06,
+	0x2f, 0x2a, 0xfc, 0x6f, 0xdb, 0xae, 0x3c, 0xe4, 0xc9, 0xd9, 0x75, 0xb0, 0x35, 0x7f, 0x6e, 0xe9,
+	0xa9, 0x00, 0x67, 0x86, 0x9c, 0x8b, 0xff, 0xf8, 0xc4, 0x4a, 0xb0, 0xc8, 0x12, 0xa9, 0x7f, 0x40,
+	0x11, 0xcc, 0xd4, 0x74, 0xbb, 0xe6, 0x45, 0x50, 0x63, 0x6d, 0xe9, 0x09, 0x2c, 0x71, 0x0c, 0x17,
+	0xbb, 0x31, 0xf6, 0xe6, 0xb2, 0x5b, 0x3b, 0x90, 0x3a, 0x42, 0x6f, 0x99, 0x3a, 0x1c, 0x38, 0xb9,
+	0x8b, 0xa9, 0xea, 0x96, 0x44, 0x37, 0x76, 0x07, 0x4e, 0xe7, 0x36, 0x9d, 0x84, 0xb9, 0x1a, 0x36,
+	0xaa, 0x35, 0xca, 0xb4, 0x4c, 0x6b, 0xbc, 0x87, 0x6e, 0xbc, 0x7d, 0x85, 0xeb, 0xcd, 0xc7, 0xd2,
+	0xdf, 0x02, 0x9c, 0x3a, 0x42, 0xfd, 0xa6, 0xa5, 0xe6, 0x32, 0x44, 0x58, 0x39, 0x2f, 0x1a, 0x15,
+	0x2e, 0x65, 0x55, 0xee, 0x96, 0x74, 0xd9, 0x3b, 0x2f, 0x8c, 0x62, 0x2f, 0xa7, 0x85, 0x19, 0x74,
+	0xaf, 0x82, 0xb6, 0x61, 0x96, 0x35, 0xf9, 0x3d, 0x39, 0x35, 0xc4, 0x45, 0xf3, 0x50, 0x68, 0xb7,
+	0x6f, 0xc5, 0x33, 0x6f, 0x54, 0x86, 0xfa, 0x96, 0xfc, 0x3f, 0x58, 0x3e, 0x70, 0x72, 0xb8, 0x4c,
+	0x2a, 0x7e, 0x44, 0x46, 0xe4, 0x2c, 0xe9, 0x1a, 0x44, 0xbb, 0xe8, 0x37, 0x3a, 0x1c, 0xd2, 0x55,
+	0x97, 0x28, 0x6f, 0xf6, 0x12, 0x4d, 0xe8, 0xb9, 0x0d, 0xd1, 0xae, 0x27, 0x27, 0x1d, 0xa1, 0xf1,
+	0x0a, 0xc4, 0x7c, 0x78, 0xa6, 0x61, 0x98, 0xc4, 0x67, 0x3b, 0x03, 0xa0, 0xbb, 0xfd, 0xe2, 0xa7,
+	0x36, 0x31, 0xf9, 0x79, 0x9f, 0x67, 0x23, 0x37, 0x6d, 0x62, 0x4a, 0xd7, 0x61, 0x65, 0xc0, 0x8d,
+	0x53, 0x9d, 0x85, 0x45, 0xcf, 0xaf, 0x64, 0x98, 0xba, 0xd5, 0xe6, 0x74, 0x0b, 0x6c, 0x4c, 0x65,
+	0x43, 0xd2, 0x35, 0x88, 0xf9, 0x61, 0xe9, 0xa3, 0x9c, 0xc0, 0xf5, 0x1d, 0x58, 0x19, 0x70, 0xe5,
+	0xb4, 0xa3, 0xe5, 0xa6, 0x3f, 0x80, 0x30, 0x7f, 0x65, 0xa1, 0x38, 0xc4, 0xf6, 0xb5, 0x5c, 0x5e,
+	0x2b, 0xaa, 0xf7, 0x8b, 0x1f, 0xdd, 0x29, 0xdc, 0xcd, 0x67, 0xf7, 0x6e, 0xec, 0xe5, 0x73, 0xd1,
+	0x29, 0x14, 0x85, 0xc5, 0x8e, 0x25, 0x53, 0xc8, 0x46, 0x05, 0x74, 0x1c, 0x96, 0x3a, 0x23, 0xb9,
+	0x7c, 0x21, 0x1b, 0x0d, 0xa5, 0xbf, 0x14, 0x60, 0xa9, 0xef, 0x7d, 0x80, 0x12, 0x20, 0xaa, 0xda,
+	0x7e, 0x26, 0x97, 0xcd, 0x14, 0x0e, 0x8a, 0xb7, 0xf7, 0x73, 0xf9, 0x81, 0x69, 0xd7, 0x20, 0x36,
+	0x60, 0x57, 0x3f, 0xdc, 0xcf, 0xde, 0x8a, 0x0a, 0x62, 0x28, 0x22, 0xa0, 0x53, 0x70, 0x62, 0xc0,
+	0x5a, 0xb8, 0x7f, 0x27, 0x1b, 0x0d, 0xb9, 0x3a, 0x07, 0x0c, 0x19, 0x66, 0x99, 0xde, 0x79, 0x09,
+	0x10, 0x2e, 0x78, 0x1f, 0x0b, 0xe8, 0x31, 0x44, 0xfc, 0xcc, 0x89, 0x82, 0x0a, 0xda, 0xc0, 0xab,
+	0x42, 0x3c, 0x37, 0x12, 0xc3, 0x53, 0xca, 0xe6, 0x57, 0xbf, 0xfe, 0xf9, 0x5d, 0x28, 0x79, 0x5d,
+	0x48, 0x4b, 0xa7, 0x95, 0x80, 0x0f, 0x15, 0x9f, 0xf0, 0x07, 0x01, 0x56, 0x02, 0xf3, 0x36, 0x52,
+	0x46, 0xd0, 0x04, 0x55, 0x7e, 0xf1, 0xe2, 0xe4, 0x0e, 0x5c, 0xe4, 0x15, 0x26, 0x52, 0x91, 0xd2,
+	0x23, 0x14, 0x16, 0x3f, 0x33, 0x68, 0xad, 0x48, 0x7c, 0xdf, 0xeb, 0x42, 0x1a, 0x3d, 0x82, 0x59,
+	0x96, 0xad, 0xd1, 0x7a, 0x00, 0x63, 0x6f, 0xae, 0x17, 0x93, 0xc3, 0x01, 0x5c, 0xc2, 0x06, 0x93,
+	0xb0, 0x8e, 0xce, 0x28, 0x41, 0x9f, 0x3a, 0xb6, 0xf2, 0xd8, 0xad, 0x0f, 0x4f, 0xd0, 0x17, 0xb0,
+	0xd0, 0xf3, 0x74, 0x44, 0x1b, 0xa3, 0x5e, 0x9c, 0x5d, 0xfa, 0xcd, 0x71, 0x30, 0x2e, 0xe2, 0x2c,
+	0x13, 0x71, 0x5a, 0x3a, 0x19, 0x2c, 0xc2, 0x5d, 0xf3, 0xe7, 0xb0, 0xd0, 0xf3, 0x39, 0x10, 0x28,
+	0xe0, 0xe8, 0x47, 0x92, 0xb8, 0x39, 0x0e, 0xc6, 0x05, 0x24, 0x98, 0x80, 0x38, 0x1a, 0x22, 0x00,
+	0x3d, 0x15, 0x60, 0x79, 0xa0, 0x4c, 0xa0, 0x0b, 0xc1, 0x73, 0x07, 0x54, 0x31, 0x31, 0x3d, 0x09,
+	0x94, 0x4b, 0xd9, 0x66, 0x52, 0xb6, 0xd0, 0xc6, 0x90, 0x0d, 0x61, 0xd5, 0x40, 0x79, 0xec, 0xd5,
+	0xc1, 0x27, 0xa8, 0x0d, 0x11, 0x3f, 0x9b, 0x04, 0x5e, 0x9e, 0x81, 0x54, 0x2f, 0x9e, 0x1b, 0x89,
+	0xe1, 0x1a, 0xce, 0x33, 0x0d, 0x09, 0x69, 0x35, 0x40, 0x43, 0x85, 0x41, 0xdd, 0x2d, 0x61, 0xd4,
+	0x79, 0x73, 0x04, 0x75, 0xde, 0x1c, 0x4f, 0x9d, 0x37, 0x27, 0xa6, 0xc6, 0xa6, 0x4f, 0xfd, 0x8d,
+	0x00, 0x4b, 0x7d, 0xb9, 0x1b, 0x6d, 0x8d, 0x98, 0xbc, 0x37, 0x43, 0x8b, 0xa9, 0xf1, 0x40, 0x2e,
+	0x25, 0xcd, 0xa4, 0x9c, 0x97, 0xd6, 0x87, 0x4a, 0x51, 0x58, 0x76, 0xee, 0x0a, 0xca, 0xe1, 0x71,
+	0x82, 0x72, 0x78, 0x42, 0x41, 0x39, 0x3c, 0x54, 0x90, 0x9b, 0xd3, 0xd6, 0x87, 0xee, 0x8c, 0xa7,
+	0x49, 0x7d, 0xff, 0xf9, 0xab, 0x84, 0xf0, 0xe2, 0x55, 0x42, 0xf8, 0xe3, 0x55, 0x42, 0xf8, 0xf6,
+	0x75, 0x62, 0xea, 0xc5, 0xeb, 0xc4, 0xd4, 0x6f, 0xaf, 0x13, 0x53, 0x1f, 0x6f, 0x8c, 0x7f, 0xdc,
+	0x2a, 0xd4, 0x29, 0xcd, 0xb1, 0xf7, 0xfa, 0xff, 0xff, 0x1d, 0x00, 0x74, 0x02, 0xae, 0x0a, 0xe6,
+	0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1242,6 +1510,11 @@ const _ = grpc.SupportPackageIsVersion4
 type ServiceClient interface {
 	// Simulate simulates executing a transaction for estimating gas usage.
 	Simulate(ctx context.Context, in *SimulateRequest, opts ...grpc.CallOption) (*SimulateResponse, error)
+	// SimulateWithOverrides simulates executing a transaction like Simulate,
+	// against the latest state with the given account and balance overrides,
+	// which are never persisted. It allows previewing the outcome of a
+	// transaction of an account which doesn't exist or isn't funded yet.
+	SimulateWithOverrides(ctx context.Context, in *SimulateWithOverridesRequest, opts ...grpc.CallOption) (*SimulateWithOverridesResponse, error)
 	// GetTx fetches a tx by hash.
 	GetTx(ctx context.Context, in *GetTxRequest, opts ...grpc.CallOption) (*GetTxResponse, error)
 	// BroadcastTx broadcast transaction.
@@ -1287,6 +1560,15 @@ func (c *serviceClient) Simulate(ctx context.Context, in *SimulateRequest, opts
 	return out, nil
 }
 
+func (c *serviceClient) SimulateWithOverrides(ctx context.Context, in *SimulateWithOverridesRequest, opts ...grpc.CallOption) (*SimulateWithOverridesResponse, error) {
+	out := new(SimulateWithOverridesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.tx.v1beta1.Service/SimulateWithOverrides", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *serviceClient) GetTx(ctx context.Context, in *GetTxRequest, opts ...grpc.CallOption) (*GetTxResponse, error) {
 	out := new(GetTxResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.tx.v1beta1.Service/GetTx", in, out, opts...)
@@ -1363,6 +1645,11 @@ func (c *serviceClient) TxDecodeAmino(ctx context.Context, in *TxDecodeAminoRequ
 type ServiceServer interface {
 	// Simulate simulates executing a transaction for estimating gas usage.
 	Simulate(context.Context, *SimulateRequest) (*SimulateResponse, error)
+	// SimulateWithOverrides simulates executing a transaction like Simulate,
+	// against the latest state with the given account and balance overrides,
+	// which are never persisted. It allows previewing the outcome of a
+	// transaction of an account which doesn't exist or isn't funded yet.
+	SimulateWithOverrides(context.Context, *SimulateWithOverridesRequest) (*SimulateWithOverridesResponse, error)
 	// GetTx fetches a tx by hash.
 	GetTx(context.Context, *GetTxRequest) (*GetTxResponse, error)
 	// BroadcastTx broadcast transaction.
@@ -1398,6 +1685,9 @@ type UnimplementedServiceServer struct {
 func (*UnimplementedServiceServer) Simulate(ctx context.Context, req *SimulateRequest) (*SimulateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Simulate not implemented")
 }
+func (*UnimplementedServiceServer) SimulateWithOverrides(ctx context.Context, req *SimulateWithOverridesRequest) (*SimulateWithOverridesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateWithOverrides not implemented")
+}
 func (*UnimplementedServiceServer) GetTx(ctx context.Context, req *GetTxRequest) (*GetTxResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTx not implemented")
 }
@@ -1445,6 +1735,24 @@ func _Service_Simulate_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_SimulateWithOverrides_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateWithOverridesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).SimulateWithOverrides(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.tx.v1beta1.Service/SimulateWithOverrides",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).SimulateWithOverrides(ctx, req.(*SimulateWithOverridesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Service_GetTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetTxRequest)
 	if err := dec(in); err != nil {
@@ -1597,6 +1905,10 @@ var _Service_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Simulate",
 			Handler:    _Service_Simulate_Handler,
 		},
+		{
+			MethodName: "SimulateWithOverrides",
+			Handler:    _Service_SimulateWithOverrides_Handler,
+		},
 		{
 			MethodName: "GetTx",
 			Handler:    _Service_GetTx_Handler,
@@ -1927,7 +2239,7 @@ func (m *SimulateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *GetTxRequest) Marshal() (dAtA []byte, err error) {
+func (m *SimulateWithOverridesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1937,27 +2249,55 @@ func (m *GetTxRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetTxRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SimulateWithOverridesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SimulateWithOverridesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintService(dAtA, i, uint64(len(m.Hash)))
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.TxBytes) > 0 {
+		i -= len(m.TxBytes)
+		copy(dAtA[i:], m.TxBytes)
+		i = encodeVarintService(dAtA, i, uint64(len(m.TxBytes)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetTxResponse) Marshal() (dAtA []byte, err error) {
+func (m *AccountOverride) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1967,19 +2307,19 @@ func (m *GetTxResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetTxResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *AccountOverride) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AccountOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.TxResponse != nil {
+	if m.PubKey != nil {
 		{
-			size, err := m.TxResponse.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.PubKey.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1987,24 +2327,24 @@ func (m *GetTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1a
 	}
-	if m.Tx != nil {
-		{
-			size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
+	if m.Sequence != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Address)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetBlockWithTxsRequest) Marshal() (dAtA []byte, err error) {
+func (m *BalanceOverride) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2014,24 +2354,192 @@ func (m *GetBlockWithTxsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetBlockWithTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *BalanceOverride) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetBlockWithTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BalanceOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Balance) > 0 {
+		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SimulateWithOverridesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulateWithOverridesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulateWithOverridesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Result != nil {
+		{
+			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.GasInfo != nil {
+		{
+			size, err := m.GasInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TxResponse != nil {
+		{
+			size, err := m.TxResponse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Tx != nil {
+		{
+			size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetBlockWithTxsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBlockWithTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetBlockWithTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
 		dAtA[i] = 0x12
@@ -2501,6 +3009,87 @@ func (m *SimulateResponse) Size() (n int) {
 	return n
 }
 
+func (m *SimulateWithOverridesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxBytes)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AccountOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovService(uint64(m.Sequence))
+	}
+	if m.PubKey != nil {
+		l = m.PubKey.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	return n
+}
+
+func (m *BalanceOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if len(m.Balance) > 0 {
+		for _, e := range m.Balance {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SimulateWithOverridesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GasInfo != nil {
+		l = m.GasInfo.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Result != nil {
+		l = m.Result.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	return n
+}
+
 func (m *GetTxRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3495,6 +4084,533 @@ func (m *SimulateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SimulateWithOverridesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulateWithOverridesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulateWithOverridesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxBytes = append(m.TxBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxBytes == nil {
+				m.TxBytes = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, &AccountOverride{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, &BalanceOverride{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PubKey == nil {
+				m.PubKey = &any.Any{}
+			}
+			if err := m.PubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BalanceOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BalanceOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BalanceOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = append(m.Balance, types.Coin{})
+			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SimulateWithOverridesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulateWithOverridesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulateWithOverridesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GasInfo == nil {
+				m.GasInfo = &types.GasInfo{}
+			}
+			if err := m.GasInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Result == nil {
+				m.Result = &types.Result{}
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GetTxRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -20,6 +20,7 @@ const MaxGasWanted = uint64((1 << 63) - 1)
 // Interface implementation checks.
 var (
 	_, _, _, _ codectypes.UnpackInterfacesMessage = &Tx{}, &TxBody{}, &AuthInfo{}, &SignerInfo{}
+	_, _       codectypes.UnpackInterfacesMessage = &SimulateWithOverridesRequest{}, &AccountOverride{}
 )
 
 // GetMsgs implements the GetMsgs method on sdk.Tx.
@@ -223,6 +224,21 @@ func (m *SignerInfo) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	return unpacker.UnpackAny(m.PublicKey, new(cryptotypes.PubKey))
 }
 
+// UnpackInterfaces implements the UnpackInterfaceMessages.UnpackInterfaces method
+func (m *SimulateWithOverridesRequest) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, account := range m.Accounts {
+		if err := account.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UnpackInterfaces implements the UnpackInterfaceMessages.UnpackInterfaces method
+func (m *AccountOverride) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpacker.UnpackAny(m.PubKey, new(cryptotypes.PubKey))
+}
+
 // RegisterInterfaces registers the sdk.Tx and MsgResponse interfaces.
 // Note: the registration of sdk.Msg is done in sdk.RegisterInterfaces, but it
 // could be moved inside this function.
@@ -95,6 +95,17 @@ type (
 		// doesn't require access to any other information.
 		ValidateBasic() error
 	}
+
+	// MsgAccessSpec defines a message declaring the stores accessed by its
+	// execution, allowing BaseApp to schedule transactions with non-conflicting
+	// messages in parallel. The declaration is only a hint: BaseApp verifies the
+	// keys actually accessed by the transactions, including by the AnteHandler
+	// and PostHandler, and executes them serially if they conflict.
+	MsgAccessSpec interface {
+		// AccessedStoreKeys returns the names of the store keys read or written
+		// by the execution of the message.
+		AccessedStoreKeys() []string
+	}
 )
 
 // TxDecoder unmarshals transaction bytes
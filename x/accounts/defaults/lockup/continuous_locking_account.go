@@ -186,6 +186,12 @@ func (cva ContinuousLockingAccount) GetLockedCoinsWithDenoms(ctx context.Context
 	return lockedCoins, nil
 }
 
+func (cva *ContinuousLockingAccount) Clawback(ctx context.Context, msg *lockuptypes.MsgClawback) (
+	*lockuptypes.MsgClawbackResponse, error,
+) {
+	return cva.BaseLockup.Clawback(ctx, msg, cva.GetLockedCoinsWithDenoms)
+}
+
 func (cva ContinuousLockingAccount) QueryLockupAccountInfo(ctx context.Context, req *lockuptypes.QueryLockupAccountInfoRequest) (
 	*lockuptypes.QueryLockupAccountInfoResponse, error,
 ) {
@@ -203,6 +209,10 @@ func (cva ContinuousLockingAccount) QueryLockupAccountInfo(ctx context.Context,
 		return nil, err
 	}
 	resp.StartTime = &startTime
+	lockedCoins, err = cva.applyClawback(ctx, lockedCoins, resp.OriginalLocking.Denoms())
+	if err != nil {
+		return nil, err
+	}
 	resp.LockedCoins = lockedCoins
 	resp.UnlockedCoins = unlockedCoins
 	return resp, nil
@@ -218,6 +228,7 @@ func (cva ContinuousLockingAccount) RegisterExecuteHandlers(builder *accountstd.
 	accountstd.RegisterExecuteHandler(builder, cva.Undelegate)
 	accountstd.RegisterExecuteHandler(builder, cva.SendCoins)
 	accountstd.RegisterExecuteHandler(builder, cva.WithdrawUnlockedCoins)
+	accountstd.RegisterExecuteHandler(builder, cva.Clawback)
 }
 
 func (cva ContinuousLockingAccount) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
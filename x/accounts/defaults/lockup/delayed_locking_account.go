@@ -124,6 +124,12 @@ func (dva DelayedLockingAccount) GetLockedCoinsWithDenoms(ctx context.Context, b
 	return vestingCoins, nil
 }
 
+func (dva *DelayedLockingAccount) Clawback(ctx context.Context, msg *lockuptypes.MsgClawback) (
+	*lockuptypes.MsgClawbackResponse, error,
+) {
+	return dva.BaseLockup.Clawback(ctx, msg, dva.GetLockedCoinsWithDenoms)
+}
+
 func (dva DelayedLockingAccount) QueryVestingAccountInfo(ctx context.Context, req *lockuptypes.QueryLockupAccountInfoRequest) (
 	*lockuptypes.QueryLockupAccountInfoResponse, error,
 ) {
@@ -136,6 +142,10 @@ func (dva DelayedLockingAccount) QueryVestingAccountInfo(ctx context.Context, re
 	if err != nil {
 		return nil, err
 	}
+	lockedCoins, err = dva.applyClawback(ctx, lockedCoins, resp.OriginalLocking.Denoms())
+	if err != nil {
+		return nil, err
+	}
 	resp.LockedCoins = lockedCoins
 	resp.UnlockedCoins = unlockedCoins
 	return resp, nil
@@ -151,6 +161,7 @@ func (dva DelayedLockingAccount) RegisterExecuteHandlers(builder *accountstd.Exe
 	accountstd.RegisterExecuteHandler(builder, dva.Undelegate)
 	accountstd.RegisterExecuteHandler(builder, dva.SendCoins)
 	accountstd.RegisterExecuteHandler(builder, dva.WithdrawUnlockedCoins)
+	accountstd.RegisterExecuteHandler(builder, dva.Clawback)
 }
 
 func (dva DelayedLockingAccount) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
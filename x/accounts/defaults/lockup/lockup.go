@@ -3,6 +3,7 @@ package lockup
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -32,6 +33,8 @@ var (
 	LockingPeriodsPrefix   = collections.NewPrefix(5)
 	OwnerPrefix            = collections.NewPrefix(6)
 	WithdrawedCoinsPrefix  = collections.NewPrefix(7)
+	FunderPrefix           = collections.NewPrefix(8)
+	ClawbackLockedPrefix   = collections.NewPrefix(9)
 )
 
 var (
@@ -51,6 +54,8 @@ func newBaseLockup(d accountstd.Dependencies) *BaseLockup {
 		DelegatedFree:    collections.NewMap(d.SchemaBuilder, DelegatedFreePrefix, "delegated_free", collections.StringKey, sdk.IntValue),
 		DelegatedLocking: collections.NewMap(d.SchemaBuilder, DelegatedLockingPrefix, "delegated_locking", collections.StringKey, sdk.IntValue),
 		WithdrawedCoins:  collections.NewMap(d.SchemaBuilder, WithdrawedCoinsPrefix, "withdrawed_coins", collections.StringKey, sdk.IntValue),
+		Funder:           collections.NewItem(d.SchemaBuilder, FunderPrefix, "funder", collections.BytesValue),
+		ClawbackLocked:   collections.NewMap(d.SchemaBuilder, ClawbackLockedPrefix, "clawback_locked", collections.StringKey, sdk.IntValue),
 		addressCodec:     d.AddressCodec,
 		headerService:    d.Environment.HeaderService,
 		EndTime:          collections.NewItem(d.SchemaBuilder, EndTimePrefix, "end_time", collcodec.KeyToValueCodec[time.Time](sdk.TimeKey)),
@@ -70,6 +75,13 @@ type BaseLockup struct {
 	headerService    header.Service
 	// lockup end time.
 	EndTime collections.Item[time.Time]
+	// Funder is the address allowed to claw back the locked coins, it is only
+	// set when clawback is enabled.
+	Funder collections.Item[[]byte]
+	// ClawbackLocked holds, for each denom that was clawed back, the locked
+	// coins that could not be reclaimed because they were delegated. They stay
+	// locked until the funder reclaims them once undelegated.
+	ClawbackLocked collections.Map[string, math.Int]
 }
 
 func (bva *BaseLockup) Init(ctx context.Context, msg *lockuptypes.MsgInitLockupAccount) (
@@ -84,6 +96,13 @@ func (bva *BaseLockup) Init(ctx context.Context, msg *lockuptypes.MsgInitLockupA
 		return nil, err
 	}
 
+	if msg.EnableClawback {
+		err = bva.Funder.Set(ctx, accountstd.Sender(ctx))
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	funds := accountstd.Funds(ctx)
 
 	sortedAmt := funds.Sort()
@@ -141,7 +160,7 @@ func (bva *BaseLockup) Delegate(
 	if err != nil {
 		return nil, err
 	}
-	lockedCoins, err := getLockedCoinsFunc(ctx, hs.Time, msg.Amount.Denom)
+	lockedCoins, err := bva.withClawback(getLockedCoinsFunc)(ctx, hs.Time, msg.Amount.Denom)
 	if err != nil {
 		return nil, err
 	}
@@ -211,7 +230,7 @@ func (bva *BaseLockup) SendCoins(
 
 	hs := bva.headerService.GetHeaderInfo(ctx)
 
-	lockedCoins, err := getLockedCoinsFunc(ctx, hs.Time, msg.Amount.Denoms()...)
+	lockedCoins, err := bva.withClawback(getLockedCoinsFunc)(ctx, hs.Time, msg.Amount.Denoms()...)
 	if err != nil {
 		return nil, err
 	}
@@ -248,7 +267,7 @@ func (bva *BaseLockup) WithdrawUnlockedCoins(
 	}
 
 	hs := bva.headerService.GetHeaderInfo(ctx)
-	lockedCoins, err := getLockedCoinsFunc(ctx, hs.Time, msg.Denoms...)
+	lockedCoins, err := bva.withClawback(getLockedCoinsFunc)(ctx, hs.Time, msg.Denoms...)
 	if err != nil {
 		return nil, err
 	}
@@ -317,6 +336,129 @@ func (bva *BaseLockup) WithdrawUnlockedCoins(
 	}, nil
 }
 
+// Clawback allows the funder of the lockup account to reclaim the coins that are
+// still locked for the given denoms, or for every locked denom if none is given.
+// Locked coins that are delegated cannot be reclaimed right away: they remain
+// locked and can be clawed back by a subsequent call once undelegated. After a
+// clawback the lockup schedule of the denom stops, and coins that were unlocked
+// before the clawback stay with the owner.
+func (bva *BaseLockup) Clawback(
+	ctx context.Context, msg *lockuptypes.MsgClawback, getLockedCoinsFunc getLockedCoinsFunc,
+) (
+	*lockuptypes.MsgClawbackResponse, error,
+) {
+	funder, err := bva.Funder.Get(ctx)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, fmt.Errorf("clawback is not enabled for this lockup account")
+		}
+		return nil, err
+	}
+	// authorize the actual sender of the message, the funder field must match it
+	sender := accountstd.Sender(ctx)
+	if !bytes.Equal(funder, sender) {
+		return nil, fmt.Errorf("sender is not the funder of this lockup account")
+	}
+	funderBytes, err := bva.addressCodec.StringToBytes(msg.Funder)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid funder address: %s", err.Error())
+	}
+	if !bytes.Equal(funderBytes, sender) {
+		return nil, fmt.Errorf("funder address does not match the sender")
+	}
+
+	whoami := accountstd.Whoami(ctx)
+	fromAddress, err := bva.addressCodec.BytesToString(whoami)
+	if err != nil {
+		return nil, err
+	}
+
+	denoms := msg.Denoms
+	if len(denoms) == 0 {
+		err = bva.IterateCoinEntries(ctx, bva.OriginalLocking, func(denom string, _ math.Int) (bool, error) {
+			denoms = append(denoms, denom)
+			return false, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	hs := bva.headerService.GetHeaderInfo(ctx)
+	lockedCoins, err := bva.withClawback(getLockedCoinsFunc)(ctx, hs.Time, denoms...)
+	if err != nil {
+		return nil, err
+	}
+
+	amount := sdk.Coins{}
+	for _, denom := range denoms {
+		balance, err := bva.getBalance(ctx, fromAddress, denom)
+		if err != nil {
+			return nil, err
+		}
+		lockedAmt := lockedCoins.AmountOf(denom)
+
+		// only the locked coins that are not bonded can be reclaimed
+		notBondedLockedCoin, err := bva.GetNotBondedLockedCoin(ctx, sdk.NewCoin(denom, lockedAmt), denom)
+		if err != nil {
+			return nil, err
+		}
+		clawbackAmt := math.MinInt(notBondedLockedCoin.Amount, balance.Amount)
+
+		// the remaining locked coins are frozen until they can be reclaimed
+		err = bva.ClawbackLocked.Set(ctx, denom, lockedAmt.Sub(clawbackAmt))
+		if err != nil {
+			return nil, err
+		}
+
+		if !clawbackAmt.IsZero() {
+			amount = append(amount, sdk.NewCoin(denom, clawbackAmt))
+		}
+	}
+
+	if !amount.Empty() {
+		msgSend := makeMsgSend(fromAddress, msg.ToAddress, amount.Sort())
+		_, err = sendMessage(ctx, msgSend)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &lockuptypes.MsgClawbackResponse{Amount: amount.Sort()}, nil
+}
+
+// withClawback wraps the locked coins computation of a lockup account so that
+// denoms which were clawed back report the locked coins left after the clawback
+// instead of following the lockup schedule.
+func (bva *BaseLockup) withClawback(getLockedCoinsFunc getLockedCoinsFunc) getLockedCoinsFunc {
+	return func(ctx context.Context, time time.Time, denoms ...string) (sdk.Coins, error) {
+		lockedCoins, err := getLockedCoinsFunc(ctx, time, denoms...)
+		if err != nil {
+			return nil, err
+		}
+		return bva.applyClawback(ctx, lockedCoins, denoms)
+	}
+}
+
+// applyClawback returns the locked coins of the given denoms, where the locked
+// amount of the clawed back denoms is replaced with the locked coins left after
+// the clawback.
+func (bva BaseLockup) applyClawback(ctx context.Context, lockedCoins sdk.Coins, denoms []string) (sdk.Coins, error) {
+	result := sdk.Coins{}
+	for _, denom := range denoms {
+		lockedAmt, err := bva.ClawbackLocked.Get(ctx, denom)
+		if errors.Is(err, collections.ErrNotFound) {
+			lockedAmt, err = lockedCoins.AmountOf(denom), nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, sdk.NewCoin(denom, lockedAmt))
+	}
+
+	return result, nil
+}
+
 func (bva *BaseLockup) checkSender(ctx context.Context, sender string) error {
 	owner, err := bva.Owner.Get(ctx)
 	if err != nil {
@@ -578,8 +720,21 @@ func (bva BaseLockup) QueryLockupAccountBaseInfo(ctx context.Context, _ *lockupt
 		return nil, err
 	}
 
+	var funderAddress string
+	funder, err := bva.Funder.Get(ctx)
+	switch {
+	case err == nil:
+		funderAddress, err = bva.addressCodec.BytesToString(funder)
+		if err != nil {
+			return nil, err
+		}
+	case !errors.Is(err, collections.ErrNotFound):
+		return nil, err
+	}
+
 	return &lockuptypes.QueryLockupAccountInfoResponse{
 		Owner:            ownerAddress,
+		Funder:           funderAddress,
 		OriginalLocking:  originalLocking,
 		DelegatedLocking: delegatedLocking,
 		DelegatedFree:    delegatedFree,
@@ -96,6 +96,13 @@ func (pva PeriodicLockingAccount) Init(ctx context.Context, msg *lockuptypes.Msg
 		return nil, err
 	}
 
+	if msg.EnableClawback {
+		err = pva.Funder.Set(ctx, accountstd.Sender(ctx))
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &lockuptypes.MsgInitPeriodicLockingAccountResponse{}, nil
 }
 
@@ -279,6 +286,12 @@ func (pva PeriodicLockingAccount) GetLockedCoinsWithDenoms(ctx context.Context,
 	return lockedCoins, nil
 }
 
+func (pva *PeriodicLockingAccount) Clawback(ctx context.Context, msg *lockuptypes.MsgClawback) (
+	*lockuptypes.MsgClawbackResponse, error,
+) {
+	return pva.BaseLockup.Clawback(ctx, msg, pva.GetLockedCoinsWithDenoms)
+}
+
 func (pva PeriodicLockingAccount) QueryLockupAccountInfo(ctx context.Context, req *lockuptypes.QueryLockupAccountInfoRequest) (
 	*lockuptypes.QueryLockupAccountInfoResponse, error,
 ) {
@@ -296,6 +309,10 @@ func (pva PeriodicLockingAccount) QueryLockupAccountInfo(ctx context.Context, re
 		return nil, err
 	}
 	resp.StartTime = &startTime
+	lockedCoins, err = pva.applyClawback(ctx, lockedCoins, resp.OriginalLocking.Denoms())
+	if err != nil {
+		return nil, err
+	}
 	resp.LockedCoins = lockedCoins
 	resp.UnlockedCoins = unlockedCoins
 	return resp, nil
@@ -327,6 +344,7 @@ func (pva PeriodicLockingAccount) RegisterExecuteHandlers(builder *accountstd.Ex
 	accountstd.RegisterExecuteHandler(builder, pva.Undelegate)
 	accountstd.RegisterExecuteHandler(builder, pva.SendCoins)
 	accountstd.RegisterExecuteHandler(builder, pva.WithdrawUnlockedCoins)
+	accountstd.RegisterExecuteHandler(builder, pva.Clawback)
 }
 
 func (pva PeriodicLockingAccount) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
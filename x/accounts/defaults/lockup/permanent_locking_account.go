@@ -72,6 +72,12 @@ func (plva *PermanentLockingAccount) SendCoins(ctx context.Context, msg *lockupt
 	return plva.BaseLockup.SendCoins(ctx, msg, plva.GetlockedCoinsWithDenoms)
 }
 
+func (plva *PermanentLockingAccount) Clawback(ctx context.Context, msg *lockuptypes.MsgClawback) (
+	*lockuptypes.MsgClawbackResponse, error,
+) {
+	return plva.BaseLockup.Clawback(ctx, msg, plva.GetlockedCoinsWithDenoms)
+}
+
 func (plva PermanentLockingAccount) QueryLockupAccountInfo(ctx context.Context, req *lockuptypes.QueryLockupAccountInfoRequest) (
 	*lockuptypes.QueryLockupAccountInfoResponse, error,
 ) {
@@ -88,7 +94,11 @@ func (plva PermanentLockingAccount) QueryLockupAccountInfo(ctx context.Context,
 		return nil, err
 	}
 
-	resp.LockedCoins = originalLocking
+	lockedCoins, err := plva.applyClawback(ctx, originalLocking, originalLocking.Denoms())
+	if err != nil {
+		return nil, err
+	}
+	resp.LockedCoins = lockedCoins
 	resp.UnlockedCoins = sdk.Coins{}
 	return resp, nil
 }
@@ -102,6 +112,7 @@ func (plva PermanentLockingAccount) RegisterExecuteHandlers(builder *accountstd.
 	accountstd.RegisterExecuteHandler(builder, plva.Delegate)
 	accountstd.RegisterExecuteHandler(builder, plva.Undelegate)
 	accountstd.RegisterExecuteHandler(builder, plva.SendCoins)
+	accountstd.RegisterExecuteHandler(builder, plva.Clawback)
 }
 
 func (plva PermanentLockingAccount) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
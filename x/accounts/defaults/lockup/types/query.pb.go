@@ -84,6 +84,9 @@ type QueryLockupAccountInfoResponse struct {
 	UnlockedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=unlocked_coins,json=unlockedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"unlocked_coins"`
 	// owner defines the value of the owner of the lockup account.
 	Owner string `protobuf:"bytes,8,opt,name=owner,proto3" json:"owner,omitempty"`
+	// funder defines the address allowed to claw back the locked coins, it is empty
+	// when clawback is disabled.
+	Funder string `protobuf:"bytes,9,opt,name=funder,proto3" json:"funder,omitempty"`
 }
 
 func (m *QueryLockupAccountInfoResponse) Reset()         { *m = QueryLockupAccountInfoResponse{} }
@@ -175,6 +178,13 @@ func (m *QueryLockupAccountInfoResponse) GetOwner() string {
 	return ""
 }
 
+func (m *QueryLockupAccountInfoResponse) GetFunder() string {
+	if m != nil {
+		return m.Funder
+	}
+	return ""
+}
+
 // QueryLockingPeriodsRequest is used to query the periodic lockup account locking periods.
 type QueryLockingPeriodsRequest struct {
 }
@@ -270,39 +280,40 @@ func init() {
 }
 
 var fileDescriptor_f06fad50e16c8e9b = []byte{
-	// 507 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xc7, 0x63, 0xda, 0xf4, 0x63, 0x03, 0x6d, 0x89, 0x7a, 0x30, 0x01, 0xec, 0x28, 0x17, 0x22,
-	0x41, 0x77, 0x69, 0x39, 0x72, 0x40, 0x04, 0x09, 0x84, 0xd4, 0x43, 0xb1, 0x38, 0x71, 0xb1, 0xfc,
-	0x31, 0x31, 0xab, 0x38, 0x3b, 0xae, 0x77, 0x5d, 0xda, 0xb7, 0xe8, 0x73, 0xf0, 0x24, 0x3d, 0xf6,
-	0xc8, 0x89, 0xa2, 0xe4, 0x3d, 0x10, 0xb2, 0x77, 0x37, 0xa8, 0x12, 0x55, 0x39, 0xa4, 0xa7, 0xfd,
-	0x9a, 0x99, 0xdf, 0xcc, 0xfe, 0x77, 0x96, 0x3c, 0x4f, 0x50, 0x4e, 0x51, 0xb2, 0x28, 0x49, 0xb0,
-	0x12, 0x4a, 0xb2, 0x14, 0xc6, 0x51, 0x95, 0x2b, 0xc9, 0x72, 0x4c, 0x26, 0x55, 0xc1, 0x8e, 0x2b,
-	0x28, 0xcf, 0x68, 0x51, 0xa2, 0xc2, 0xae, 0xaf, 0x8d, 0xa9, 0x35, 0xa6, 0xd6, 0x98, 0x6a, 0xe3,
-	0xde, 0x8b, 0xdb, 0xa2, 0xe9, 0x41, 0x87, 0xeb, 0x79, 0xc6, 0x3a, 0x8e, 0x24, 0xb0, 0x93, 0xfd,
-	0x18, 0x54, 0xb4, 0xcf, 0x12, 0xe4, 0xc2, 0x9c, 0xef, 0x66, 0x98, 0x61, 0x33, 0x65, 0xf5, 0xcc,
-	0xec, 0xfa, 0x19, 0x62, 0x96, 0x03, 0x6b, 0x56, 0x71, 0x35, 0x66, 0x8a, 0x4f, 0x41, 0xaa, 0x68,
-	0x6a, 0xc2, 0x0e, 0x7c, 0xf2, 0xf4, 0x53, 0x9d, 0xf4, 0x61, 0xc3, 0x7a, 0xab, 0x53, 0xf9, 0x28,
-	0xc6, 0x18, 0xc0, 0x71, 0x05, 0x52, 0x0d, 0x7e, 0xb7, 0x89, 0x77, 0x93, 0x85, 0x2c, 0x50, 0x48,
-	0xe8, 0x9e, 0x90, 0x1d, 0x2c, 0x79, 0xc6, 0x45, 0x94, 0x87, 0x75, 0xce, 0x5c, 0x64, 0xae, 0xd3,
-	0x5f, 0x19, 0x76, 0x0e, 0x1e, 0x51, 0x73, 0x09, 0x75, 0xd6, 0xd4, 0x64, 0x4d, 0xdf, 0x21, 0x17,
-	0xa3, 0x97, 0x17, 0x3f, 0xfd, 0xd6, 0xf7, 0x2b, 0x7f, 0x98, 0x71, 0xf5, 0xb5, 0x8a, 0x69, 0x82,
-	0x53, 0x66, 0x4a, 0xd4, 0xc3, 0x9e, 0x4c, 0x27, 0x4c, 0x9d, 0x15, 0x20, 0x1b, 0x07, 0x19, 0x6c,
-	0x5b, 0xc8, 0xa1, 0x66, 0x74, 0x4b, 0xb2, 0x95, 0x42, 0x0e, 0x59, 0xa4, 0x20, 0x0d, 0xc7, 0x25,
-	0x80, 0x7b, 0x6f, 0xf9, 0xd4, 0x07, 0x0b, 0xc4, 0xfb, 0x12, 0xa0, 0x7b, 0x4a, 0x1e, 0xfe, 0x65,
-	0xda, 0x62, 0x57, 0x96, 0x8f, 0xdd, 0x59, 0x50, 0x6c, 0xb5, 0x6f, 0x08, 0x91, 0x2a, 0x2a, 0x55,
-	0x58, 0x4b, 0xe8, 0xae, 0xf6, 0x9d, 0x61, 0xe7, 0xa0, 0x47, 0xb5, 0xbe, 0xd4, 0xea, 0x4b, 0x3f,
-	0x5b, 0x7d, 0x47, 0xab, 0xe7, 0x57, 0xbe, 0x13, 0x6c, 0x36, 0x3e, 0xf5, 0x6e, 0xf7, 0x35, 0xd9,
-	0x00, 0x91, 0x6a, 0xf7, 0xf6, 0x7f, 0xba, 0xaf, 0x83, 0x48, 0x1b, 0x67, 0x41, 0xee, 0xd7, 0xd5,
-	0x42, 0x1a, 0xd6, 0x6f, 0x4e, 0xba, 0x6b, 0xcb, 0x2f, 0xb9, 0xa3, 0x01, 0xcd, 0xa2, 0xd6, 0xb6,
-	0x12, 0xd7, 0x88, 0xeb, 0x77, 0xa0, 0xad, 0x45, 0x68, 0xe6, 0x2e, 0x69, 0xe3, 0x37, 0x01, 0xa5,
-	0xbb, 0xd1, 0x77, 0x86, 0x9b, 0x81, 0x5e, 0x0c, 0x9e, 0x90, 0xde, 0xe2, 0xfd, 0x73, 0x91, 0x1d,
-	0x41, 0xc9, 0x31, 0x95, 0xb6, 0x3d, 0x90, 0x3c, 0xfe, 0xe7, 0xa9, 0x69, 0x8d, 0x23, 0xb2, 0x6d,
-	0x1e, 0x49, 0x58, 0xe8, 0x23, 0xd3, 0x19, 0xcf, 0xe8, 0x2d, 0xdf, 0x03, 0xd5, 0xa1, 0x82, 0xad,
-	0xfc, 0x5a, 0xe4, 0xd1, 0x87, 0x8b, 0x99, 0xe7, 0x5c, 0xce, 0x3c, 0xe7, 0xd7, 0xcc, 0x73, 0xce,
-	0xe7, 0x5e, 0xeb, 0x72, 0xee, 0xb5, 0x7e, 0xcc, 0xbd, 0xd6, 0x97, 0x3d, 0x1d, 0x51, 0xa6, 0x13,
-	0xca, 0x91, 0x9d, 0xde, 0xfc, 0xaf, 0x34, 0x57, 0x10, 0xaf, 0x35, 0xa2, 0xbf, 0xfa, 0x13, 0x00,
-	0x00, 0xff, 0xff, 0x8d, 0xa3, 0x8b, 0xf7, 0xd5, 0x04, 0x00, 0x00,
+	// 521 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0xc1, 0x6e, 0x13, 0x31,
+	0x10, 0x40, 0xb3, 0xb4, 0x4d, 0x5b, 0x07, 0xda, 0xb2, 0xaa, 0xd0, 0x12, 0x60, 0x37, 0xca, 0x85,
+	0x48, 0x50, 0x9b, 0x96, 0x23, 0x07, 0x44, 0x90, 0x40, 0x48, 0x3d, 0x94, 0x88, 0x13, 0x97, 0x68,
+	0xb3, 0x3b, 0x59, 0xac, 0x6c, 0x3c, 0x5b, 0xdb, 0x5b, 0xda, 0xbf, 0xe8, 0x89, 0x8f, 0xe0, 0x4b,
+	0x7a, 0xec, 0x91, 0x13, 0x45, 0xc9, 0x8f, 0xa0, 0xb5, 0xbd, 0x41, 0x95, 0xa8, 0xca, 0x21, 0x9c,
+	0xec, 0xb1, 0x67, 0xe6, 0xcd, 0x78, 0x66, 0x4c, 0x9e, 0x25, 0xa8, 0xa6, 0xa8, 0x58, 0x9c, 0x24,
+	0x58, 0x0a, 0xad, 0x58, 0x0a, 0xe3, 0xb8, 0xcc, 0xb5, 0x62, 0x39, 0x26, 0x93, 0xb2, 0x60, 0xc7,
+	0x25, 0xc8, 0x33, 0x5a, 0x48, 0xd4, 0xe8, 0x47, 0x56, 0x99, 0xd6, 0xca, 0xb4, 0x56, 0xa6, 0x56,
+	0xb9, 0xfd, 0xfc, 0x36, 0x6f, 0x76, 0xb1, 0xee, 0xda, 0xa1, 0xd3, 0x1e, 0xc5, 0x0a, 0xd8, 0xc9,
+	0xfe, 0x08, 0x74, 0xbc, 0xcf, 0x12, 0xe4, 0xc2, 0xdd, 0xef, 0x66, 0x98, 0xa1, 0xd9, 0xb2, 0x6a,
+	0xe7, 0x4e, 0xa3, 0x0c, 0x31, 0xcb, 0x81, 0x19, 0x69, 0x54, 0x8e, 0x99, 0xe6, 0x53, 0x50, 0x3a,
+	0x9e, 0x3a, 0xb7, 0xdd, 0x88, 0x3c, 0xf9, 0x58, 0x05, 0x7d, 0x68, 0x58, 0x6f, 0x6c, 0x28, 0x1f,
+	0xc4, 0x18, 0x07, 0x70, 0x5c, 0x82, 0xd2, 0xdd, 0x6f, 0x4d, 0x12, 0xde, 0xa4, 0xa1, 0x0a, 0x14,
+	0x0a, 0xfc, 0x13, 0xb2, 0x83, 0x92, 0x67, 0x5c, 0xc4, 0xf9, 0xb0, 0x8a, 0x99, 0x8b, 0x2c, 0xf0,
+	0x3a, 0x2b, 0xbd, 0xd6, 0xc1, 0x43, 0xea, 0x1e, 0xa1, 0x8a, 0x9a, 0xba, 0xa8, 0xe9, 0x5b, 0xe4,
+	0xa2, 0xff, 0xe2, 0xe2, 0x67, 0xd4, 0xf8, 0x7e, 0x15, 0xf5, 0x32, 0xae, 0xbf, 0x94, 0x23, 0x9a,
+	0xe0, 0x94, 0xb9, 0x14, 0xed, 0xb2, 0xa7, 0xd2, 0x09, 0xd3, 0x67, 0x05, 0x28, 0x63, 0xa0, 0x06,
+	0xdb, 0x35, 0xe4, 0xd0, 0x32, 0x7c, 0x49, 0xb6, 0x52, 0xc8, 0x21, 0x8b, 0x35, 0xa4, 0xc3, 0xb1,
+	0x04, 0x08, 0xee, 0x2c, 0x9f, 0x7a, 0x6f, 0x81, 0x78, 0x27, 0x01, 0xfc, 0x53, 0x72, 0xff, 0x0f,
+	0xb3, 0x4e, 0x76, 0x65, 0xf9, 0xd8, 0x9d, 0x05, 0xa5, 0xce, 0xf6, 0x35, 0x21, 0x4a, 0xc7, 0x52,
+	0x0f, 0xab, 0x12, 0x06, 0xab, 0x1d, 0xaf, 0xd7, 0x3a, 0x68, 0x53, 0x5b, 0x5f, 0x5a, 0xd7, 0x97,
+	0x7e, 0xaa, 0xeb, 0xdb, 0x5f, 0x3d, 0xbf, 0x8a, 0xbc, 0xc1, 0xa6, 0xb1, 0xa9, 0x4e, 0xfd, 0x57,
+	0x64, 0x03, 0x44, 0x6a, 0xcd, 0xd7, 0xfe, 0xd1, 0x7c, 0x1d, 0x44, 0x6a, 0x8c, 0x05, 0xb9, 0x5b,
+	0x65, 0x0b, 0xe9, 0xb0, 0xea, 0x39, 0x15, 0x34, 0x97, 0x9f, 0x72, 0xcb, 0x02, 0x8c, 0x50, 0xd5,
+	0xb6, 0x14, 0xd7, 0x88, 0xeb, 0xff, 0xa1, 0xb6, 0x35, 0xc2, 0x32, 0x77, 0xc9, 0x1a, 0x7e, 0x15,
+	0x20, 0x83, 0x8d, 0x8e, 0xd7, 0xdb, 0x1c, 0x58, 0xc1, 0x7f, 0x40, 0x9a, 0xe3, 0x52, 0xa4, 0x20,
+	0x83, 0x4d, 0x73, 0xec, 0xa4, 0xee, 0x63, 0xd2, 0x5e, 0xcc, 0x05, 0x17, 0xd9, 0x11, 0x48, 0x8e,
+	0xa9, 0xaa, 0xc7, 0x06, 0xc9, 0xa3, 0xbf, 0xde, 0xba, 0x91, 0x39, 0x22, 0xdb, 0xae, 0x79, 0x86,
+	0x85, 0xbd, 0x72, 0x13, 0xf3, 0x94, 0xde, 0xf2, 0x6d, 0x50, 0xeb, 0x6a, 0xb0, 0x95, 0x5f, 0xf3,
+	0xdc, 0x7f, 0x7f, 0x31, 0x0b, 0xbd, 0xcb, 0x59, 0xe8, 0xfd, 0x9a, 0x85, 0xde, 0xf9, 0x3c, 0x6c,
+	0x5c, 0xce, 0xc3, 0xc6, 0x8f, 0x79, 0xd8, 0xf8, 0xbc, 0x67, 0x3d, 0xaa, 0x74, 0x42, 0x39, 0xb2,
+	0xd3, 0x9b, 0xff, 0x1b, 0xf3, 0x34, 0xa3, 0xa6, 0x69, 0x86, 0x97, 0xbf, 0x03, 0x00, 0x00, 0xff,
+	0xff, 0x6d, 0xe0, 0x2b, 0xfc, 0xed, 0x04, 0x00, 0x00,
 }
 
 func (m *QueryLockupAccountInfoRequest) Marshal() (dAtA []byte, err error) {
@@ -348,6 +359,13 @@ func (m *QueryLockupAccountInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if len(m.Funder) > 0 {
+		i -= len(m.Funder)
+		copy(dAtA[i:], m.Funder)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Funder)))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.Owner) > 0 {
 		i -= len(m.Owner)
 		copy(dAtA[i:], m.Owner)
@@ -576,6 +594,10 @@ func (m *QueryLockupAccountInfoResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	l = len(m.Funder)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -962,6 +984,38 @@ func (m *QueryLockupAccountInfoResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -42,6 +42,9 @@ type MsgInitLockupAccount struct {
 	EndTime time.Time `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
 	// start of lockup
 	StartTime time.Time `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// enable_clawback allows the sender funding the account to claw back the
+	// coins that are still locked.
+	EnableClawback bool `protobuf:"varint,4,opt,name=enable_clawback,json=enableClawback,proto3" json:"enable_clawback,omitempty"`
 }
 
 func (m *MsgInitLockupAccount) Reset()         { *m = MsgInitLockupAccount{} }
@@ -98,6 +101,13 @@ func (m *MsgInitLockupAccount) GetStartTime() time.Time {
 	return time.Time{}
 }
 
+func (m *MsgInitLockupAccount) GetEnableClawback() bool {
+	if m != nil {
+		return m.EnableClawback
+	}
+	return false
+}
+
 // MsgInitLockupAccountResponse defines the Msg/InitLockupAccount response type.
 type MsgInitLockupAccountResponse struct {
 }
@@ -143,6 +153,9 @@ type MsgInitPeriodicLockingAccount struct {
 	// start of lockup
 	StartTime      time.Time `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
 	LockingPeriods []Period  `protobuf:"bytes,3,rep,name=locking_periods,json=lockingPeriods,proto3" json:"locking_periods"`
+	// enable_clawback allows the sender funding the account to claw back the
+	// coins that are still locked.
+	EnableClawback bool `protobuf:"varint,4,opt,name=enable_clawback,json=enableClawback,proto3" json:"enable_clawback,omitempty"`
 }
 
 func (m *MsgInitPeriodicLockingAccount) Reset()         { *m = MsgInitPeriodicLockingAccount{} }
@@ -199,6 +212,13 @@ func (m *MsgInitPeriodicLockingAccount) GetLockingPeriods() []Period {
 	return nil
 }
 
+func (m *MsgInitPeriodicLockingAccount) GetEnableClawback() bool {
+	if m != nil {
+		return m.EnableClawback
+	}
+	return false
+}
+
 // MsgInitPeriodicLockingAccountResponse defines the Msg/InitPeriodicLockingAccount
 // response type.
 type MsgInitPeriodicLockingAccountResponse struct {
@@ -497,6 +517,92 @@ func (m *MsgWithdrawResponse) GetAmountReceived() github_com_cosmos_cosmos_sdk_t
 	return nil
 }
 
+// MsgClawback defines a message that the funder of a lockup account can perform
+// to reclaim the coins that are still locked.
+type MsgClawback struct {
+	Funder    string   `protobuf:"bytes,1,opt,name=funder,proto3" json:"funder,omitempty"`
+	ToAddress string   `protobuf:"bytes,2,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	Denoms    []string `protobuf:"bytes,3,rep,name=denoms,proto3" json:"denoms,omitempty"`
+}
+
+func (m *MsgClawback) Reset()         { *m = MsgClawback{} }
+func (m *MsgClawback) String() string { return proto.CompactTextString(m) }
+func (*MsgClawback) ProtoMessage()    {}
+func (*MsgClawback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5f39108a4d67f92, []int{10}
+}
+func (m *MsgClawback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClawback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClawback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClawback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClawback.Merge(m, src)
+}
+func (m *MsgClawback) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClawback) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClawback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClawback proto.InternalMessageInfo
+
+// MsgClawbackResponse defines the response for MsgClawback
+type MsgClawbackResponse struct {
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgClawbackResponse) Reset()         { *m = MsgClawbackResponse{} }
+func (m *MsgClawbackResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClawbackResponse) ProtoMessage()    {}
+func (*MsgClawbackResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5f39108a4d67f92, []int{11}
+}
+func (m *MsgClawbackResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClawbackResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClawbackResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClawbackResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClawbackResponse.Merge(m, src)
+}
+func (m *MsgClawbackResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClawbackResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClawbackResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClawbackResponse proto.InternalMessageInfo
+
+func (m *MsgClawbackResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgInitLockupAccount)(nil), "cosmos.accounts.defaults.lockup.MsgInitLockupAccount")
 	proto.RegisterType((*MsgInitLockupAccountResponse)(nil), "cosmos.accounts.defaults.lockup.MsgInitLockupAccountResponse")
@@ -508,6 +614,8 @@ func init() {
 	proto.RegisterType((*MsgExecuteMessagesResponse)(nil), "cosmos.accounts.defaults.lockup.MsgExecuteMessagesResponse")
 	proto.RegisterType((*MsgWithdraw)(nil), "cosmos.accounts.defaults.lockup.MsgWithdraw")
 	proto.RegisterType((*MsgWithdrawResponse)(nil), "cosmos.accounts.defaults.lockup.MsgWithdrawResponse")
+	proto.RegisterType((*MsgClawback)(nil), "cosmos.accounts.defaults.lockup.MsgClawback")
+	proto.RegisterType((*MsgClawbackResponse)(nil), "cosmos.accounts.defaults.lockup.MsgClawbackResponse")
 }
 
 func init() {
@@ -515,57 +623,61 @@ func init() {
 }
 
 var fileDescriptor_e5f39108a4d67f92 = []byte{
-	// 797 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0x3d, 0x4f, 0x1b, 0x49,
-	0x18, 0xf6, 0xda, 0x3a, 0x83, 0x87, 0x03, 0x8e, 0xc5, 0x3a, 0x8c, 0x75, 0xec, 0x72, 0x96, 0x10,
-	0x96, 0x75, 0xde, 0x3d, 0xb8, 0x93, 0xee, 0x64, 0x5d, 0x83, 0x8f, 0x7c, 0x49, 0x71, 0x84, 0x4c,
-	0x3e, 0xa4, 0xa4, 0xb0, 0xd6, 0xbb, 0xc3, 0xb0, 0xc2, 0x3b, 0x63, 0xed, 0x8c, 0x0d, 0xee, 0xa2,
-	0x28, 0x45, 0x44, 0x45, 0x9d, 0x8a, 0x2a, 0x8a, 0x52, 0x39, 0x12, 0x3f, 0x82, 0x12, 0x51, 0x51,
-	0x85, 0xc8, 0x44, 0x32, 0x3f, 0x23, 0x9a, 0x9d, 0x59, 0x62, 0x3e, 0x02, 0x8e, 0x8b, 0x14, 0x69,
-	0xbc, 0x33, 0xf3, 0x3e, 0xef, 0xfb, 0x3e, 0xcf, 0x33, 0x1f, 0x32, 0xc8, 0xda, 0x84, 0x7a, 0x84,
-	0x9a, 0x96, 0x6d, 0x93, 0x06, 0x66, 0xd4, 0x74, 0xe0, 0x9a, 0xd5, 0xa8, 0x31, 0x6a, 0xd6, 0x88,
-	0xbd, 0xd1, 0xa8, 0x9b, 0x6c, 0xcb, 0xa8, 0xfb, 0x84, 0x11, 0x55, 0x17, 0x48, 0x23, 0x44, 0x1a,
-	0x21, 0xd2, 0x10, 0xc8, 0xf4, 0x84, 0xe5, 0xb9, 0x98, 0x98, 0xc1, 0xaf, 0xc8, 0x49, 0x6b, 0xb2,
-	0x7a, 0xd5, 0xa2, 0xd0, 0x6c, 0x2e, 0x54, 0x21, 0xb3, 0x16, 0x4c, 0x9b, 0xb8, 0x58, 0xc6, 0xff,
-	0xb8, 0xa9, 0xbb, 0xf8, 0x48, 0xf4, 0x94, 0x44, 0x7b, 0x14, 0x99, 0xcd, 0x05, 0xfe, 0x91, 0x81,
-	0x69, 0x11, 0xa8, 0x04, 0x33, 0x53, 0xf2, 0x14, 0xa1, 0x24, 0x22, 0x88, 0x88, 0x75, 0x3e, 0x0a,
-	0x13, 0x10, 0x21, 0xa8, 0x06, 0xcd, 0x60, 0x56, 0x6d, 0xac, 0x99, 0x16, 0x6e, 0xc9, 0x90, 0x7e,
-	0x31, 0xc4, 0x5c, 0x0f, 0x52, 0x66, 0x79, 0x92, 0x45, 0xe6, 0x79, 0x14, 0x24, 0x4b, 0x14, 0xdd,
-	0xc3, 0x2e, 0xbb, 0x1f, 0xb0, 0x5b, 0x12, 0xe4, 0x55, 0x03, 0xfc, 0x44, 0x36, 0x31, 0xf4, 0x53,
-	0xca, 0xac, 0x92, 0x4d, 0x14, 0x53, 0x87, 0x7b, 0xf9, 0xa4, 0xe4, 0xb2, 0xe4, 0x38, 0x3e, 0xa4,
-	0x74, 0x95, 0xf9, 0x2e, 0x46, 0x65, 0x01, 0x53, 0x97, 0xc1, 0x30, 0xc4, 0x4e, 0x85, 0xd7, 0x4f,
-	0x45, 0x67, 0x95, 0xec, 0xc8, 0x62, 0xda, 0x10, 0xcd, 0x8d, 0xb0, 0xb9, 0xf1, 0x30, 0x6c, 0x5e,
-	0x1c, 0xdd, 0xff, 0xa0, 0x47, 0x76, 0x8e, 0x75, 0xe5, 0x6d, 0xb7, 0x9d, 0x53, 0xca, 0x43, 0x10,
-	0x3b, 0x3c, 0xa8, 0xde, 0x05, 0x80, 0x32, 0xcb, 0x67, 0xa2, 0x4e, 0xec, 0x5b, 0xeb, 0x24, 0x82,
-	0x64, 0x1e, 0x2e, 0x64, 0x4f, 0x77, 0x75, 0x65, 0xbb, 0xdb, 0xce, 0xc9, 0x9d, 0xce, 0x53, 0x67,
-	0xc3, 0xbc, 0x4a, 0x69, 0x46, 0x03, 0xbf, 0x5d, 0xb5, 0x5e, 0x86, 0xb4, 0x4e, 0x30, 0x85, 0x99,
-	0x37, 0x51, 0x30, 0x23, 0x01, 0x2b, 0xd0, 0x77, 0x89, 0xe3, 0xda, 0x1c, 0xe8, 0x62, 0x34, 0xa8,
-	0x57, 0xe7, 0x55, 0x46, 0x07, 0x57, 0xa9, 0x3e, 0x03, 0xe3, 0x35, 0xc1, 0xa5, 0x52, 0x0f, 0xb8,
-	0xd1, 0x54, 0x6c, 0x36, 0x96, 0x1d, 0x59, 0x9c, 0x37, 0x6e, 0x38, 0xe0, 0x86, 0xd0, 0x52, 0x4c,
-	0xf0, 0xda, 0xa2, 0xee, 0x98, 0x2c, 0x25, 0x22, 0xb4, 0x60, 0x9c, 0xee, 0xea, 0x11, 0x6e, 0xe1,
-	0xdc, 0x65, 0x0b, 0x05, 0xe6, 0xbc, 0x91, 0xf3, 0x60, 0xee, 0x5a, 0x9f, 0xce, 0x1c, 0xed, 0x28,
-	0x60, 0xa4, 0x44, 0xd1, 0x32, 0xac, 0x41, 0x64, 0x31, 0xa8, 0xfe, 0x09, 0xe2, 0x14, 0x62, 0xa7,
-	0x0f, 0x03, 0x25, 0x4e, 0x7d, 0x00, 0x26, 0x9a, 0x56, 0xcd, 0x75, 0x2c, 0x46, 0xfc, 0x8a, 0x25,
-	0x20, 0x81, 0x91, 0x89, 0xe2, 0xef, 0x87, 0x7b, 0xf9, 0x19, 0x99, 0xfc, 0x38, 0xc4, 0x9c, 0xaf,
-	0xf2, 0x4b, 0xf3, 0xc2, 0xba, 0xfa, 0x1f, 0x88, 0x5b, 0x1e, 0xe7, 0x28, 0xcf, 0xdc, 0x74, 0x68,
-	0x1f, 0xbf, 0xeb, 0x86, 0xbc, 0xeb, 0xc6, 0xff, 0xc4, 0xc5, 0xbd, 0x86, 0xc9, 0x9c, 0xc2, 0xe4,
-	0xab, 0x5d, 0x3d, 0xc2, 0xcd, 0x7a, 0xd1, 0x6d, 0xe7, 0x24, 0xc5, 0xcc, 0x27, 0x05, 0x8c, 0x96,
-	0x28, 0x7a, 0x84, 0x9d, 0x1f, 0x5a, 0xe6, 0xcb, 0x28, 0x18, 0x2a, 0x51, 0xb4, 0x0a, 0xb1, 0x33,
-	0x80, 0xc0, 0x7f, 0x00, 0x60, 0xe4, 0x82, 0xb2, 0xaf, 0x67, 0x25, 0x18, 0x09, 0x95, 0xb4, 0x7a,
-	0x94, 0xc4, 0xae, 0x57, 0x72, 0x9b, 0x2b, 0x79, 0x77, 0xac, 0x67, 0x91, 0xcb, 0xd6, 0x1b, 0x55,
-	0xc3, 0x26, 0x9e, 0x7c, 0x55, 0xcd, 0x9e, 0x73, 0xcd, 0x5a, 0x75, 0x48, 0x83, 0x04, 0xfa, 0xba,
-	0xdb, 0xce, 0xfd, 0xcc, 0xf7, 0xcc, 0x6e, 0x55, 0xf8, 0xf3, 0x4e, 0xfb, 0xb0, 0x61, 0x05, 0xa4,
-	0x4b, 0x14, 0xdd, 0xda, 0x82, 0x76, 0x83, 0xc1, 0x12, 0xa4, 0xd4, 0x42, 0x90, 0x86, 0x07, 0x5e,
-	0x5d, 0x04, 0x09, 0x5f, 0x8e, 0x69, 0x4a, 0x09, 0x08, 0x27, 0x2f, 0xdd, 0xf7, 0x25, 0xdc, 0x2a,
-	0x7f, 0x81, 0x65, 0xde, 0x8b, 0x4b, 0xf2, 0xc4, 0x65, 0xeb, 0x8e, 0x6f, 0x6d, 0xaa, 0xff, 0x02,
-	0xb0, 0x29, 0xc7, 0x7d, 0x18, 0xdc, 0x83, 0x1d, 0xdc, 0xe4, 0x5f, 0x41, 0xdc, 0x81, 0x98, 0x78,
-	0xe2, 0x51, 0x49, 0x94, 0xe5, 0xac, 0x30, 0xd5, 0xeb, 0x40, 0x4f, 0xa7, 0xcc, 0x91, 0x02, 0x26,
-	0x7b, 0x38, 0x9f, 0xe9, 0xff, 0x1b, 0x0c, 0xfb, 0xd0, 0x86, 0x6e, 0xb3, 0x0f, 0xe6, 0x67, 0x48,
-	0x75, 0x5b, 0x01, 0xe3, 0xc2, 0xf3, 0x8a, 0x5c, 0x73, 0x52, 0xd1, 0xef, 0xb5, 0xdb, 0x63, 0xa2,
-	0x73, 0x59, 0x36, 0x2e, 0xde, 0xd9, 0xef, 0x68, 0xca, 0x41, 0x47, 0x53, 0x3e, 0x76, 0x34, 0x65,
-	0xe7, 0x44, 0x8b, 0x1c, 0x9c, 0x68, 0x91, 0xa3, 0x13, 0x2d, 0xf2, 0x34, 0x2f, 0xea, 0x52, 0x67,
-	0xc3, 0x70, 0x89, 0xb9, 0x75, 0xcd, 0x9f, 0x0f, 0xde, 0xb4, 0x1a, 0x0f, 0x36, 0xfc, 0xaf, 0xcf,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0xf7, 0xd7, 0x1d, 0x22, 0xac, 0x08, 0x00, 0x00,
+	// 864 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0xcf, 0x4f, 0x2b, 0x55,
+	0x14, 0xee, 0x6d, 0xb5, 0x8f, 0x5e, 0x7c, 0xe0, 0x9b, 0xd7, 0xf8, 0x4a, 0x23, 0x33, 0x75, 0x12,
+	0x42, 0xd3, 0xd8, 0x19, 0x41, 0x13, 0x0d, 0x71, 0x43, 0xc1, 0x5f, 0x89, 0x35, 0xa4, 0xf8, 0x23,
+	0xd1, 0x45, 0x73, 0x3b, 0x73, 0x19, 0x26, 0x9d, 0xb9, 0xb7, 0x99, 0x7b, 0xdb, 0xd2, 0xad, 0x71,
+	0x61, 0x58, 0xb1, 0x76, 0xc5, 0xc6, 0x44, 0x5d, 0xd5, 0x84, 0x3f, 0x82, 0x9d, 0x84, 0x15, 0x2b,
+	0x31, 0xc5, 0xa4, 0xfc, 0x19, 0x66, 0xe6, 0xde, 0x29, 0x2d, 0x20, 0x54, 0x34, 0x2c, 0xdc, 0x30,
+	0x33, 0xf7, 0x7c, 0xe7, 0x9c, 0xef, 0x7c, 0xe7, 0xdc, 0x43, 0x61, 0xd1, 0xa2, 0xcc, 0xa7, 0xcc,
+	0x44, 0x96, 0x45, 0xdb, 0x84, 0x33, 0xd3, 0xc6, 0x3b, 0xa8, 0xed, 0x71, 0x66, 0x7a, 0xd4, 0x6a,
+	0xb6, 0x5b, 0x26, 0xdf, 0x33, 0x5a, 0x01, 0xe5, 0x54, 0xd1, 0x04, 0xd2, 0x88, 0x91, 0x46, 0x8c,
+	0x34, 0x04, 0x32, 0xff, 0x0c, 0xf9, 0x2e, 0xa1, 0x66, 0xf4, 0x57, 0xf8, 0xe4, 0x55, 0x19, 0xbd,
+	0x81, 0x18, 0x36, 0x3b, 0x2b, 0x0d, 0xcc, 0xd1, 0x8a, 0x69, 0x51, 0x97, 0x48, 0xfb, 0x9b, 0xf7,
+	0x65, 0x17, 0x0f, 0x89, 0x7e, 0x21, 0xd1, 0x3e, 0x73, 0xcc, 0xce, 0x4a, 0xf8, 0x90, 0x86, 0x05,
+	0x61, 0xa8, 0x47, 0x5f, 0xa6, 0xe4, 0x29, 0x4c, 0x59, 0x87, 0x3a, 0x54, 0x9c, 0x87, 0x6f, 0xb1,
+	0x83, 0x43, 0xa9, 0xe3, 0x61, 0x33, 0xfa, 0x6a, 0xb4, 0x77, 0x4c, 0x44, 0x7a, 0xd2, 0xa4, 0x5d,
+	0x37, 0x71, 0xd7, 0xc7, 0x8c, 0x23, 0x5f, 0xb2, 0xd0, 0x7f, 0x4e, 0xc2, 0x6c, 0x95, 0x39, 0x9f,
+	0x10, 0x97, 0x7f, 0x1a, 0xb1, 0x5b, 0x17, 0xe4, 0x15, 0x03, 0xbe, 0x4c, 0xbb, 0x04, 0x07, 0x39,
+	0x50, 0x00, 0xc5, 0x4c, 0x25, 0x77, 0x7a, 0x54, 0xce, 0x4a, 0x2e, 0xeb, 0xb6, 0x1d, 0x60, 0xc6,
+	0xb6, 0x79, 0xe0, 0x12, 0xa7, 0x26, 0x60, 0xca, 0x26, 0x9c, 0xc1, 0xc4, 0xae, 0x87, 0xf1, 0x73,
+	0xc9, 0x02, 0x28, 0xce, 0xae, 0xe6, 0x0d, 0x91, 0xdc, 0x88, 0x93, 0x1b, 0x9f, 0xc7, 0xc9, 0x2b,
+	0x4f, 0x8f, 0x7f, 0xd7, 0x12, 0x07, 0xe7, 0x1a, 0xf8, 0x69, 0xd8, 0x2f, 0x81, 0xda, 0x13, 0x4c,
+	0xec, 0xd0, 0xa8, 0x7c, 0x0c, 0x21, 0xe3, 0x28, 0xe0, 0x22, 0x4e, 0xea, 0x9f, 0xc6, 0xc9, 0x44,
+	0xce, 0x51, 0xa4, 0x65, 0x38, 0x8f, 0x09, 0x6a, 0x78, 0xb8, 0x6e, 0x79, 0xa8, 0xdb, 0x40, 0x56,
+	0x33, 0xf7, 0x52, 0x01, 0x14, 0x67, 0x6a, 0x73, 0xe2, 0x78, 0x43, 0x9e, 0xae, 0x15, 0x2f, 0x0f,
+	0x35, 0xb0, 0x3f, 0xec, 0x97, 0xe4, 0x48, 0x94, 0x99, 0xdd, 0x34, 0x6f, 0x93, 0x44, 0x57, 0xe1,
+	0xeb, 0xb7, 0x9d, 0xd7, 0x30, 0x6b, 0x51, 0xc2, 0xb0, 0xfe, 0x5b, 0x12, 0x2e, 0x4a, 0xc0, 0x16,
+	0x0e, 0x5c, 0x6a, 0xbb, 0x56, 0x08, 0x74, 0x89, 0xf3, 0x50, 0x51, 0x27, 0xe5, 0x48, 0xfe, 0x0b,
+	0x39, 0xbe, 0x81, 0xf3, 0x9e, 0xe0, 0x52, 0x6f, 0x45, 0xdc, 0x58, 0x2e, 0x55, 0x48, 0x15, 0x67,
+	0x57, 0x97, 0x8d, 0x7b, 0x6e, 0x82, 0x21, 0x6a, 0xa9, 0x64, 0xc2, 0xd8, 0x22, 0xee, 0x9c, 0x0c,
+	0x25, 0x2c, 0x6c, 0x7a, 0xad, 0x8d, 0xcb, 0x43, 0x2d, 0x11, 0x6a, 0xbd, 0x74, 0x53, 0x6b, 0x11,
+	0x6c, 0x52, 0xf1, 0x65, 0xb8, 0x74, 0xa7, 0xa0, 0x23, 0xe9, 0x07, 0x00, 0xce, 0x56, 0x99, 0xb3,
+	0x89, 0x3d, 0xec, 0x20, 0x8e, 0x95, 0xb7, 0x60, 0x9a, 0x61, 0x62, 0x4f, 0xa1, 0xb4, 0xc4, 0x29,
+	0x9f, 0xc1, 0x67, 0x1d, 0xe4, 0xb9, 0x36, 0xe2, 0x34, 0xa8, 0x23, 0x01, 0x89, 0x14, 0xcf, 0x54,
+	0xde, 0x38, 0x3d, 0x2a, 0x2f, 0x4a, 0xe7, 0x2f, 0x63, 0xcc, 0x64, 0x94, 0x57, 0x3b, 0xd7, 0xce,
+	0x95, 0xf7, 0x61, 0x1a, 0xf9, 0x21, 0x47, 0x39, 0xc5, 0x0b, 0xb1, 0xce, 0xe1, 0xf6, 0x30, 0xe4,
+	0xf6, 0x30, 0x36, 0xa8, 0x4b, 0xc6, 0x95, 0x95, 0x3e, 0x6b, 0xcf, 0xbf, 0x3f, 0xd4, 0x12, 0xa1,
+	0x58, 0xdf, 0x0e, 0xfb, 0x25, 0x49, 0x51, 0xff, 0x13, 0xc0, 0xa7, 0x55, 0xe6, 0x7c, 0x41, 0xec,
+	0xff, 0x75, 0x99, 0xdf, 0x25, 0xe1, 0x93, 0x2a, 0x73, 0xb6, 0x31, 0xb1, 0x1f, 0x50, 0xe0, 0xbb,
+	0x10, 0x72, 0x7a, 0xad, 0xb2, 0xbf, 0xf7, 0xca, 0x70, 0x1a, 0x57, 0xd2, 0x1b, 0xab, 0x24, 0x75,
+	0x77, 0x25, 0x1f, 0x86, 0x95, 0xfc, 0x72, 0xae, 0x15, 0x1d, 0x97, 0xef, 0xb6, 0x1b, 0x86, 0x45,
+	0x7d, 0xb9, 0xa7, 0xcd, 0xb1, 0xb9, 0xe6, 0xbd, 0x16, 0x66, 0x91, 0x03, 0xfb, 0x61, 0xd8, 0x2f,
+	0xbd, 0x12, 0xf6, 0xcc, 0xea, 0xd5, 0xc3, 0x7f, 0x18, 0x6c, 0x0a, 0x19, 0xb6, 0x60, 0xbe, 0xca,
+	0x9c, 0x0f, 0xf6, 0xb0, 0xd5, 0xe6, 0xb8, 0x8a, 0x19, 0x43, 0x0e, 0x66, 0xf1, 0xc0, 0x2b, 0xab,
+	0x30, 0x13, 0xc8, 0x77, 0x96, 0x03, 0x11, 0xe1, 0xec, 0x8d, 0xc5, 0xb0, 0x4e, 0x7a, 0xb5, 0x2b,
+	0x98, 0xfe, 0xab, 0xb8, 0x24, 0x5f, 0xb9, 0x7c, 0xd7, 0x0e, 0x50, 0x57, 0x79, 0x0f, 0xc2, 0xae,
+	0x7c, 0x9f, 0x42, 0xe0, 0x31, 0xec, 0xc3, 0x45, 0x7e, 0x0d, 0xa6, 0x6d, 0x4c, 0xa8, 0x2f, 0xb6,
+	0x4f, 0xa6, 0x26, 0xbf, 0xd6, 0x5e, 0x8c, 0x2b, 0x30, 0x96, 0x49, 0x3f, 0x03, 0xf0, 0xf9, 0x18,
+	0xe7, 0x51, 0xfd, 0xef, 0xc0, 0x99, 0x00, 0x5b, 0xd8, 0xed, 0x4c, 0xc1, 0x7c, 0x84, 0x54, 0xf6,
+	0x01, 0x9c, 0x17, 0x9a, 0xd7, 0xe5, 0x99, 0x9d, 0x4b, 0x3e, 0x56, 0xb7, 0xe7, 0x44, 0xe6, 0x9a,
+	0x4c, 0xac, 0xff, 0x28, 0xda, 0x11, 0x2f, 0xc7, 0x70, 0xd6, 0x77, 0xda, 0xd3, 0xcd, 0xba, 0xc0,
+	0xfd, 0xf7, 0x6d, 0x98, 0x1c, 0x44, 0x91, 0x45, 0x3f, 0x10, 0x2d, 0x88, 0x79, 0x8e, 0x5a, 0x70,
+	0x75, 0x61, 0xc0, 0x23, 0x5f, 0x98, 0xca, 0x47, 0xc7, 0x03, 0x15, 0x9c, 0x0c, 0x54, 0xf0, 0xc7,
+	0x40, 0x05, 0x07, 0x17, 0x6a, 0xe2, 0xe4, 0x42, 0x4d, 0x9c, 0x5d, 0xa8, 0x89, 0xaf, 0xcb, 0x22,
+	0x1e, 0xb3, 0x9b, 0x86, 0x4b, 0xcd, 0xbd, 0x3b, 0x7e, 0x09, 0x86, 0xc9, 0x1a, 0xe9, 0xe8, 0xae,
+	0xbc, 0xfd, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0x4a, 0x8a, 0xdc, 0xda, 0x39, 0x0a, 0x00, 0x00,
 }
 
 func (this *MsgInitLockupAccount) Equal(that interface{}) bool {
@@ -596,6 +708,9 @@ func (this *MsgInitLockupAccount) Equal(that interface{}) bool {
 	if !this.StartTime.Equal(that1.StartTime) {
 		return false
 	}
+	if this.EnableClawback != that1.EnableClawback {
+		return false
+	}
 	return true
 }
 func (m *MsgInitLockupAccount) Marshal() (dAtA []byte, err error) {
@@ -618,6 +733,16 @@ func (m *MsgInitLockupAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EnableClawback {
+		i--
+		if m.EnableClawback {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
 	if err1 != nil {
 		return 0, err1
@@ -687,6 +812,16 @@ func (m *MsgInitPeriodicLockingAccount) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if m.EnableClawback {
+		i--
+		if m.EnableClawback {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.LockingPeriods) > 0 {
 		for iNdEx := len(m.LockingPeriods) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1014,6 +1149,89 @@ func (m *MsgWithdrawResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgClawback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClawback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClawback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Funder) > 0 {
+		i -= len(m.Funder)
+		copy(dAtA[i:], m.Funder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Funder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClawbackResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClawbackResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClawbackResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1039,6 +1257,9 @@ func (m *MsgInitLockupAccount) Size() (n int) {
 	n += 1 + l + sovTx(uint64(l))
 	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
 	n += 1 + l + sovTx(uint64(l))
+	if m.EnableClawback {
+		n += 2
+	}
 	return n
 }
 
@@ -1069,6 +1290,9 @@ func (m *MsgInitPeriodicLockingAccount) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
+	if m.EnableClawback {
+		n += 2
+	}
 	return n
 }
 
@@ -1199,6 +1423,44 @@ func (m *MsgWithdrawResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgClawback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Funder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgClawbackResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1332,6 +1594,26 @@ func (m *MsgInitLockupAccount) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableClawback", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableClawback = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1531,6 +1813,26 @@ func (m *MsgInitPeriodicLockingAccount) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableClawback", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableClawback = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2390,6 +2692,236 @@ func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgClawback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClawback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClawback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClawbackResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClawbackResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClawbackResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
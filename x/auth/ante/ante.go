@@ -16,11 +16,13 @@ type HandlerOptions struct {
 	AccountKeeper            AccountKeeper
 	AccountAbstractionKeeper AccountAbstractionKeeper
 	BankKeeper               types.BankKeeper
+	CredentialVerifiers      map[string]types.CredentialVerifier
 	ExtensionOptionChecker   ExtensionOptionChecker
 	FeegrantKeeper           FeegrantKeeper
 	SignModeHandler          *txsigning.HandlerMap
 	SigGasConsumer           func(meter storetypes.GasMeter, sig signing.SignatureV2, params types.Params) error
 	TxFeeChecker             TxFeeChecker
+	TxPriorityFn             TxPriorityFn
 }
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
@@ -46,9 +48,11 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		NewTxTimeoutHeightDecorator(),
 		NewValidateMemoDecorator(options.AccountKeeper),
 		NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
-		NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
+		NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker).
+			WithTxPriorityFn(options.TxPriorityFn),
 		NewValidateSigCountDecorator(options.AccountKeeper),
-		NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler, options.SigGasConsumer, options.AccountAbstractionKeeper),
+		NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler, options.SigGasConsumer, options.AccountAbstractionKeeper).
+			WithCredentialVerifiers(options.CredentialVerifiers),
 	}
 
 	return sdk.ChainAnteDecorators(anteDecorators...), nil
@@ -20,6 +20,13 @@ type AccountKeeper interface {
 	NewAccountWithAddress(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
 }
 
+// RateLimitKeeper defines the contract needed by the RateLimitDecorator to track
+// the number of transactions signed by an account.
+type RateLimitKeeper interface {
+	AccountKeeper
+	IncrementTxCount(ctx context.Context, accNum uint64) (uint64, error)
+}
+
 // FeegrantKeeper defines the expected feegrant keeper.
 type FeegrantKeeper interface {
 	UseGrantedFees(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
@@ -15,6 +15,11 @@ import (
 // the effective fee should be deducted later, and the priority should be returned in abci response.
 type TxFeeChecker func(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error)
 
+// TxPriorityFn overrides the tx priority computed by the TxFeeChecker, e.g. to
+// prioritize some message types regardless of the fees they pay. It receives the
+// priority derived from the fees and returns the priority to set on the context.
+type TxPriorityFn func(ctx sdk.Context, tx sdk.Tx, feePriority int64) (int64, error)
+
 // DeductFeeDecorator deducts fees from the fee payer. The fee payer is the fee granter (if specified) or first signer of the tx.
 // If the fee payer does not have the funds to pay for the fees, return an InsufficientFunds error.
 // Call next AnteHandler if fees successfully deducted.
@@ -24,6 +29,7 @@ type DeductFeeDecorator struct {
 	bankKeeper     types.BankKeeper
 	feegrantKeeper FeegrantKeeper
 	txFeeChecker   TxFeeChecker
+	txPriorityFn   TxPriorityFn
 }
 
 func NewDeductFeeDecorator(ak AccountKeeper, bk types.BankKeeper, fk FeegrantKeeper, tfc TxFeeChecker) DeductFeeDecorator {
@@ -39,6 +45,13 @@ func NewDeductFeeDecorator(ak AccountKeeper, bk types.BankKeeper, fk FeegrantKee
 	}
 }
 
+// WithTxPriorityFn returns a copy of the decorator which sets the tx priority
+// returned by fn instead of the one computed by the TxFeeChecker.
+func (dfd DeductFeeDecorator) WithTxPriorityFn(fn TxPriorityFn) DeductFeeDecorator {
+	dfd.txPriorityFn = fn
+	return dfd
+}
+
 func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, _ bool, next sdk.AnteHandler) (sdk.Context, error) {
 	feeTx, ok := tx.(sdk.FeeTx)
 	if !ok {
@@ -60,6 +73,12 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, _ bool, nex
 		if err != nil {
 			return ctx, err
 		}
+		if dfd.txPriorityFn != nil {
+			priority, err = dfd.txPriorityFn(ctx, tx, priority)
+			if err != nil {
+				return ctx, err
+			}
+		}
 	}
 	if err := dfd.checkDeductFee(ctx, tx, fee); err != nil {
 		return ctx, err
@@ -103,8 +122,7 @@ func (dfd DeductFeeDecorator) checkDeductFee(ctx sdk.Context, sdkTx sdk.Tx, fee
 
 	// deduct the fees
 	if !fee.IsZero() {
-		err := DeductFees(dfd.bankKeeper, ctx, deductFeesFrom, fee)
-		if err != nil {
+		if err := dfd.deductFees(ctx, deductFeesFrom, fee); err != nil {
 			return err
 		}
 	}
@@ -121,6 +139,29 @@ func (dfd DeductFeeDecorator) checkDeductFee(ctx sdk.Context, sdkTx sdk.Tx, fee
 	return nil
 }
 
+// deductFees deducts fees from the given account. In simulation mode the
+// deduction is attempted on a cached context so that its gas is still accounted
+// for, but an account lacking the funds (e.g. a zero balance fee payer used for
+// gas estimation) does not fail the simulation. Fee grants have already been
+// evaluated at this point, so an invalid or exhausted allowance still errors.
+func (dfd DeductFeeDecorator) deductFees(ctx sdk.Context, acc []byte, fee sdk.Coins) error {
+	if ctx.ExecMode() != sdk.ExecModeSimulate {
+		return DeductFees(dfd.bankKeeper, ctx, acc, fee)
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	err := DeductFees(dfd.bankKeeper, cacheCtx, acc, fee)
+	switch {
+	case err == nil:
+		write()
+		return nil
+	case errorsmod.IsOf(err, sdkerrors.ErrInsufficientFunds):
+		return nil
+	default:
+		return err
+	}
+}
+
 // DeductFees deducts fees from the given account.
 func DeductFees(bankKeeper types.BankKeeper, ctx sdk.Context, acc []byte, fees sdk.Coins) error {
 	if !fees.IsValid() {
@@ -1,6 +1,8 @@
 package ante_test
 
 import (
+	gomath "math"
+	"math/big"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -105,6 +107,84 @@ func TestEnsureMempoolFees(t *testing.T) {
 	require.Equal(t, int64(10), newCtx.Priority())
 }
 
+func TestDeductFeeDecorator_TxPriorityFn(t *testing.T) {
+	s := SetupTestSuite(t, true)
+	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+
+	accs := s.CreateTestAccounts(1)
+	msg := testdata.NewTestMsg(accs[0].acc.GetAddress())
+	feeAmount := testdata.NewTestFeeAmount()
+	require.NoError(t, s.txBuilder.SetMsgs(msg))
+	s.txBuilder.SetFeeAmount(feeAmount)
+	s.txBuilder.SetGasLimit(15)
+
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), accs[0].acc.GetAddress(), authtypes.FeeCollectorName, feeAmount).Return(nil).Times(2)
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{accs[0].priv}, []uint64{0}, []uint64{0}
+	tx, err := s.CreateTestTx(s.ctx, privs, accNums, accSeqs, s.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+
+	// the gas price of 10atom is twice the oracle minimum gas price
+	minGasPrice := math.LegacyNewDec(5)
+	oracle := func(sdk.Context) (sdk.DecCoins, error) {
+		return sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", minGasPrice), sdk.NewInt64DecCoin("stake", 1)), nil
+	}
+
+	mfd := ante.NewDeductFeeDecorator(s.accountKeeper, s.bankKeeper, s.feeGrantKeeper, ante.NewOracleTxFeeChecker(oracle)).
+		WithTxPriorityFn(func(_ sdk.Context, tx sdk.Tx, feePriority int64) (int64, error) {
+			require.Equal(t, int64(2*ante.OracleTxPriorityScale), feePriority)
+			if _, ok := tx.GetMsgs()[0].(*testdata.TestMsg); ok {
+				return feePriority + 100, nil
+			}
+			return feePriority, nil
+		})
+
+	newCtx, err := sdk.ChainAnteDecorators(mfd)(s.ctx, tx, false)
+	require.NoError(t, err)
+	require.Equal(t, int64(2*ante.OracleTxPriorityScale+100), newCtx.Priority())
+
+	// without priority function, the priority is relative to the oracle minimum gas price
+	minGasPrice = math.LegacyNewDec(20)
+	mfd = mfd.WithTxPriorityFn(nil)
+	newCtx, err = sdk.ChainAnteDecorators(mfd)(s.ctx, tx, false)
+	require.NoError(t, err)
+	require.Equal(t, int64(ante.OracleTxPriorityScale/2), newCtx.Priority())
+
+	// errors returned by the priority function abort the tx before the fees are deducted
+	mfd = mfd.WithTxPriorityFn(func(sdk.Context, sdk.Tx, int64) (int64, error) {
+		return 0, sdkerrors.ErrInvalidRequest
+	})
+	_, err = sdk.ChainAnteDecorators(mfd)(s.ctx, tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
+
+func TestOracleTxFeeChecker_LargeFee(t *testing.T) {
+	s := SetupTestSuite(t, true)
+	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+
+	accs := s.CreateTestAccounts(1)
+	msg := testdata.NewTestMsg(accs[0].acc.GetAddress())
+	require.NoError(t, s.txBuilder.SetMsgs(msg))
+	// a fee amount which overflows a LegacyDec once scaled by the priority scale
+	hugeFee := sdk.NewCoins(sdk.NewCoin("atom", math.NewIntFromBigInt(new(big.Int).Lsh(big.NewInt(1), 250))))
+	s.txBuilder.SetFeeAmount(hugeFee)
+	s.txBuilder.SetGasLimit(15)
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{accs[0].priv}, []uint64{0}, []uint64{0}
+	tx, err := s.CreateTestTx(s.ctx, privs, accNums, accSeqs, s.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+
+	oracle := func(sdk.Context) (sdk.DecCoins, error) {
+		return sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", math.LegacyNewDecWithPrec(1, math.LegacyPrecision))), nil
+	}
+
+	// the priority is capped instead of panicking
+	fee, priority, err := ante.NewOracleTxFeeChecker(oracle)(s.ctx, tx)
+	require.NoError(t, err)
+	require.Equal(t, hugeFee, fee)
+	require.Equal(t, int64(gomath.MaxInt64), priority)
+}
+
 func TestDeductFees(t *testing.T) {
 	s := SetupTestSuite(t, false)
 	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
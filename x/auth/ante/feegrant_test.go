@@ -190,6 +190,55 @@ func TestDeductFeesNoDelegation(t *testing.T) {
 	}
 }
 
+func TestDeductFeesSimulateFeeGrant(t *testing.T) {
+	cases := map[string]struct {
+		valid    bool
+		err      error
+		malleate func(*AnteTestSuite, TestAccount, TestAccount)
+	}{
+		"valid fee grant, granter cannot cover fee": {
+			valid: true,
+			malleate: func(suite *AnteTestSuite, grantee, granter TestAccount) {
+				suite.feeGrantKeeper.EXPECT().UseGrantedFees(gomock.Any(), granter.acc.GetAddress(), grantee.acc.GetAddress(), gomock.Any(), gomock.Any()).Return(nil)
+				suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), granter.acc.GetAddress(), authtypes.FeeCollectorName, gomock.Any()).Return(sdkerrors.ErrInsufficientFunds)
+			},
+		},
+		"no fee grant": {
+			valid: false,
+			err:   sdkerrors.ErrNotFound,
+			malleate: func(suite *AnteTestSuite, grantee, granter TestAccount) {
+				suite.feeGrantKeeper.EXPECT().
+					UseGrantedFees(gomock.Any(), granter.acc.GetAddress(), grantee.acc.GetAddress(), gomock.Any(), gomock.Any()).
+					Return(sdkerrors.ErrNotFound.Wrap("fee-grant not found"))
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			suite := SetupTestSuite(t, false)
+			dfd := ante.NewDeductFeeDecorator(suite.accountKeeper, suite.bankKeeper, suite.feeGrantKeeper, nil)
+			feeAnteHandler := sdk.ChainAnteDecorators(dfd)
+
+			accs := suite.CreateTestAccounts(2)
+			tc.malleate(suite, accs[0], accs[1])
+
+			fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 50))
+			msgs := []sdk.Msg{testdata.NewTestMsg(accs[0].acc.GetAddress())}
+			tx, err := genTxWithFeeGranter(suite.clientCtx.TxConfig, msgs, fee, 0, suite.ctx.ChainID(), []uint64{0}, []uint64{0}, accs[1].acc.GetAddress(), accs[0].priv)
+			require.NoError(t, err)
+
+			simCtx := suite.ctx.WithExecMode(sdk.ExecModeSimulate)
+			_, err = feeAnteHandler(simCtx, tx, true)
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.err)
+			}
+		})
+	}
+}
+
 func genTxWithFeeGranter(gen client.TxConfig, msgs []sdk.Msg, feeAmt sdk.Coins, gas uint64, chainID string, accNums,
 	accSeqs []uint64, feeGranter sdk.AccAddress, priv ...cryptotypes.PrivKey,
 ) (sdk.Tx, error) {
@@ -0,0 +1,66 @@
+package ante
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	authsigning "cosmossdk.io/x/auth/signing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.AnteDecorator = RateLimitDecorator{}
+
+// RateLimitDecorator limits the number of transactions each signer can submit
+// within a window of blocks. The limit and the window length are set by the
+// MaxTxsPerWindow and RateLimitWindow auth parameters; a zero MaxTxsPerWindow
+// disables the decorator. Counters are kept per account number, so the decorator
+// must be placed after the SigVerificationDecorator has ensured every signer exists.
+//
+// The RateLimitDecorator is not part of the default AnteHandler and must be
+// added explicitly by chains that want to rate limit transactions.
+type RateLimitDecorator struct {
+	ak RateLimitKeeper
+}
+
+func NewRateLimitDecorator(ak RateLimitKeeper) RateLimitDecorator {
+	return RateLimitDecorator{
+		ak: ak,
+	}
+}
+
+func (rld RateLimitDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	params := rld.ak.GetParams(ctx)
+	if params.MaxTxsPerWindow == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+
+	signers, err := sigTx.GetSigners()
+	if err != nil {
+		return ctx, err
+	}
+
+	for _, signer := range signers {
+		acc := rld.ak.GetAccount(ctx, signer)
+		if acc == nil {
+			return ctx, errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", sdk.AccAddress(signer))
+		}
+
+		count, err := rld.ak.IncrementTxCount(ctx, acc.GetAccountNumber())
+		if err != nil {
+			return ctx, err
+		}
+		if count > params.MaxTxsPerWindow {
+			return ctx, errorsmod.Wrapf(
+				sdkerrors.ErrInvalidRequest,
+				"account %s exceeded the limit of %d transactions per %d blocks", sdk.AccAddress(signer), params.MaxTxsPerWindow, params.RateLimitWindow,
+			)
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,61 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/auth/ante"
+	"cosmossdk.io/x/auth/types"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+func TestRateLimitDecorator(t *testing.T) {
+	suite := SetupTestSuite(t, true)
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	accs := suite.CreateTestAccounts(1)
+	msg := testdata.NewTestMsg(accs[0].acc.GetAddress())
+	require.NoError(t, suite.txBuilder.SetMsgs(msg))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{accs[0].priv}, []uint64{accs[0].acc.GetAccountNumber()}, []uint64{0}
+	tx, err := suite.CreateTestTx(suite.ctx, privs, accNums, accSeqs, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+
+	antehandler := sdk.ChainAnteDecorators(ante.NewRateLimitDecorator(suite.accountKeeper))
+	ctx := suite.ctx.WithHeaderInfo(header.Info{Height: 10})
+
+	// rate limiting is disabled by default
+	for i := 0; i < 5; i++ {
+		_, err = antehandler(ctx, tx, false)
+		require.NoError(t, err)
+	}
+
+	params := types.DefaultParams()
+	params.MaxTxsPerWindow = 2
+	params.RateLimitWindow = 10
+	require.NoError(t, suite.accountKeeper.Params.Set(ctx, params))
+
+	for i := 0; i < 2; i++ {
+		_, err = antehandler(ctx, tx, false)
+		require.NoError(t, err)
+	}
+	_, err = antehandler(ctx, tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// the limit still applies until the end of the window
+	_, err = antehandler(ctx.WithHeaderInfo(header.Info{Height: 19}), tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// the counter is reset when a new window starts
+	_, err = antehandler(ctx.WithHeaderInfo(header.Info{Height: 20}), tx, false)
+	require.NoError(t, err)
+}
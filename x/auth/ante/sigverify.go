@@ -72,6 +72,10 @@ type SigVerificationDecorator struct {
 	aaKeeper        AccountAbstractionKeeper
 	signModeHandler *txsigning.HandlerMap
 	sigGasConsumer  SignatureVerificationGasConsumer
+
+	// credentialVerifiers maps an account credential type URL to the verifier
+	// authenticating the accounts holding such a credential.
+	credentialVerifiers map[string]types.CredentialVerifier
 }
 
 func NewSigVerificationDecorator(ak AccountKeeper, signModeHandler *txsigning.HandlerMap, sigGasConsumer SignatureVerificationGasConsumer, aaKeeper AccountAbstractionKeeper) SigVerificationDecorator {
@@ -218,6 +222,15 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, _ boo
 	return next(ctx, tx, ctx.ExecMode() == sdk.ExecModeSimulate)
 }
 
+// WithCredentialVerifiers returns a copy of the decorator which delegates the
+// authentication of the accounts whose credential type URL is a key of verifiers
+// to the corresponding CredentialVerifier instead of verifying their signature
+// against a public key.
+func (svd SigVerificationDecorator) WithCredentialVerifiers(verifiers map[string]types.CredentialVerifier) SigVerificationDecorator {
+	svd.credentialVerifiers = verifiers
+	return svd
+}
+
 // authenticate the authentication of the TX for a specific tx signer.
 func (svd SigVerificationDecorator) authenticate(ctx sdk.Context, tx authsigning.Tx, signer []byte, sig signing.SignatureV2, txPubKey cryptotypes.PubKey, signerIndex int) error {
 	// first we check if it's an AA
@@ -246,6 +259,11 @@ func (svd SigVerificationDecorator) authenticate(ctx sdk.Context, tx authsigning
 		newlyCreated = true
 	}
 
+	// the account credential is verified by a registered credential verifier.
+	if verifier, ok := svd.credentialVerifier(acc); ok {
+		return svd.authenticateWithCredential(ctx, tx, acc, sig, verifier)
+	}
+
 	// the account is without a pubkey, let's attempt to check if in the
 	// tx we were correctly provided a valid pubkey.
 	if acc.GetPubKey() == nil {
@@ -274,6 +292,43 @@ func (svd SigVerificationDecorator) authenticate(ctx sdk.Context, tx authsigning
 	return nil
 }
 
+// credentialVerifier returns the verifier registered for the credential of the
+// provided account, if any.
+func (svd SigVerificationDecorator) credentialVerifier(acc sdk.AccountI) (types.CredentialVerifier, bool) {
+	credential := acc.GetPubKey()
+	if credential == nil || len(svd.credentialVerifiers) == 0 {
+		return nil, false
+	}
+
+	verifier, ok := svd.credentialVerifiers[codectypes.MsgTypeURL(credential)]
+	return verifier, ok
+}
+
+// authenticateWithCredential authenticates the signer through the verifier of
+// its account credential. The verifier is responsible for consuming the gas of
+// the verification.
+func (svd SigVerificationDecorator) authenticateWithCredential(ctx sdk.Context, tx authsigning.Tx, acc sdk.AccountI, sig signing.SignatureV2, verifier types.CredentialVerifier) error {
+	if !isUnordered(tx) && sig.Sequence != acc.GetSequence() {
+		return errorsmod.Wrapf(
+			sdkerrors.ErrWrongSequence,
+			"account sequence mismatch, expected %d, got %d", acc.GetSequence(), sig.Sequence,
+		)
+	}
+
+	if ctx.ExecMode() != sdk.ExecModeSimulate && !ctx.IsReCheckTx() && ctx.IsSigverifyTx() {
+		if err := verifier.VerifyCredential(ctx, acc, tx, sig); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "credential verification failed: %s", err)
+		}
+	}
+
+	err := svd.increaseSequence(tx, acc)
+	if err != nil {
+		return err
+	}
+	svd.ak.SetAccount(ctx, acc)
+	return nil
+}
+
 // consumeSignatureGas will consume gas according to the pub-key being verified.
 func (svd SigVerificationDecorator) consumeSignatureGas(
 	ctx sdk.Context,
@@ -300,7 +355,10 @@ func (svd SigVerificationDecorator) consumeSignatureGas(
 
 // verifySig will verify the signature of the provided signer account.
 func (svd SigVerificationDecorator) verifySig(ctx sdk.Context, tx sdk.Tx, acc sdk.AccountI, sig signing.SignatureV2, newlyCreated bool) error {
-	if sig.Sequence != acc.GetSequence() {
+	// unordered transactions are protected against replays by the UnorderedTxDecorator,
+	// they can be signed with any sequence and are verified against the signed one.
+	unordered := isUnordered(tx)
+	if !unordered && sig.Sequence != acc.GetSequence() {
 		return errorsmod.Wrapf(
 			sdkerrors.ErrWrongSequence,
 			"account sequence mismatch, expected %d, got %d", acc.GetSequence(), sig.Sequence,
@@ -337,13 +395,18 @@ func (svd SigVerificationDecorator) verifySig(ctx sdk.Context, tx sdk.Tx, acc sd
 		accNum = 0
 	}
 
+	sequence := acc.GetSequence()
+	if unordered {
+		sequence = sig.Sequence
+	}
+
 	anyPk, _ := codectypes.NewAnyWithValue(pubKey)
 
 	signerData := txsigning.SignerData{
 		Address:       acc.GetAddress().String(),
 		ChainID:       chainID,
 		AccountNumber: accNum,
-		Sequence:      acc.GetSequence(),
+		Sequence:      sequence,
 		PubKey: &anypb.Any{
 			TypeUrl: anyPk.TypeUrl,
 			Value:   anyPk.Value,
@@ -360,7 +423,7 @@ func (svd SigVerificationDecorator) verifySig(ctx sdk.Context, tx sdk.Tx, acc sd
 		if OnlyLegacyAminoSigners(sig.Data) {
 			// If all signers are using SIGN_MODE_LEGACY_AMINO, we rely on VerifySignature to check account sequence number,
 			// and therefore communicate sequence number as a potential cause of error.
-			errMsg = fmt.Sprintf("signature verification failed; please verify account number (%d), sequence (%d) and chain-id (%s)", accNum, acc.GetSequence(), chainID)
+			errMsg = fmt.Sprintf("signature verification failed; please verify account number (%d), sequence (%d) and chain-id (%s)", accNum, sequence, chainID)
 		} else {
 			errMsg = fmt.Sprintf("signature verification failed; please verify account number (%d) and chain-id (%s): (%s)", accNum, chainID, err.Error())
 		}
@@ -416,14 +479,19 @@ func (svd SigVerificationDecorator) increaseSequence(tx authsigning.Tx, acc sdk.
 	// Bypass incrementing sequence for transactions with unordered set to true.
 	// The actual parameters of the un-ordered tx will be checked in a separate
 	// decorator.
-	unorderedTx, ok := tx.(sdk.TxWithUnordered)
-	if ok && unorderedTx.GetUnordered() {
+	if isUnordered(tx) {
 		return nil
 	}
 
 	return acc.SetSequence(acc.GetSequence() + 1)
 }
 
+// isUnordered reports whether the tx has unordered set to true.
+func isUnordered(tx sdk.Tx) bool {
+	unorderedTx, ok := tx.(sdk.TxWithUnordered)
+	return ok && unorderedTx.GetUnordered()
+}
+
 // authenticateAbstractedAccount computes an AA authentication instruction and invokes the auth flow on the AA.
 func (svd SigVerificationDecorator) authenticateAbstractedAccount(ctx sdk.Context, authTx authsigning.Tx, signer []byte, index int) error {
 	// the bundler is the AA itself.
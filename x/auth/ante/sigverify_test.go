@@ -1,6 +1,9 @@
 package ante_test
 
 import (
+	"bytes"
+	"context"
+	"errors"
 	"fmt"
 	"testing"
 
@@ -18,6 +21,7 @@ import (
 	txsigning "cosmossdk.io/x/tx/signing"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
@@ -26,6 +30,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 )
 
@@ -256,6 +261,116 @@ func TestSigIntegration(t *testing.T) {
 	require.Equal(t, initialSigCost*uint64(len(privs)), doubleCost-initialCost)
 }
 
+func TestSigVerificationUnorderedTx(t *testing.T) {
+	suite := SetupTestSuite(t, true)
+	suite.ctx = suite.ctx.WithBlockHeight(1).WithIsSigverifyTx(true)
+
+	priv, _, addr := testdata.KeyTestPubAddr()
+	acc := suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr)
+	require.NoError(t, acc.SetAccountNumber(1000))
+	require.NoError(t, acc.SetPubKey(priv.PubKey()))
+	require.NoError(t, acc.SetSequence(5))
+	suite.accountKeeper.SetAccount(suite.ctx, acc)
+
+	svd := ante.NewSigVerificationDecorator(suite.accountKeeper, suite.clientCtx.TxConfig.SignModeHandler(), ante.DefaultSigVerificationGasConsumer, nil)
+	antehandler := sdk.ChainAnteDecorators(svd)
+
+	newTx := func(unordered bool, seq uint64) sdk.Tx {
+		suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+		require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+		suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+		suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+		suite.txBuilder.SetUnordered(unordered)
+		suite.txBuilder.SetTimeoutHeight(10)
+
+		tx, err := suite.CreateTestTx(suite.ctx, []cryptotypes.PrivKey{priv}, []uint64{acc.GetAccountNumber()}, []uint64{seq}, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+		require.NoError(t, err)
+		return tx
+	}
+
+	// ordered txs must be signed with the account sequence
+	_, err := antehandler(suite.ctx, newTx(false, 0), false)
+	require.ErrorIs(t, err, sdkerrors.ErrWrongSequence)
+
+	// unordered txs are verified against the signed sequence, which is not incremented
+	_, err = antehandler(suite.ctx, newTx(true, 0), false)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), suite.accountKeeper.GetAccount(suite.ctx, addr).GetSequence())
+
+	// the signature still has to match the signed sequence
+	tx := newTx(true, 0)
+	sigs, err := tx.(authsign.SigVerifiableTx).GetSignaturesV2()
+	require.NoError(t, err)
+	sigs[0].Sequence = 1
+	require.NoError(t, suite.txBuilder.SetSignatures(sigs...))
+	_, err = antehandler(suite.ctx, suite.txBuilder.GetTx(), false)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+}
+
+type mockCredentialVerifier struct{}
+
+func (mockCredentialVerifier) VerifyCredential(_ context.Context, _ sdk.AccountI, _ sdk.Tx, sig signing.SignatureV2) error {
+	data, ok := sig.Data.(*signing.SingleSignatureData)
+	if !ok || !bytes.Equal(data.Signature, []byte("ok")) {
+		return errors.New("invalid credential signature")
+	}
+	return nil
+}
+
+func TestSigVerificationCredentialVerifier(t *testing.T) {
+	suite := SetupTestSuite(t, true)
+	suite.ctx = suite.ctx.WithBlockHeight(1).WithIsSigverifyTx(true)
+
+	credential, err := types.NewModuleCredential("smartaccount", []byte("derivation"))
+	require.NoError(t, err)
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	acc := suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr)
+	require.NoError(t, acc.SetAccountNumber(1000))
+	require.NoError(t, acc.SetPubKey(credential))
+	suite.accountKeeper.SetAccount(suite.ctx, acc)
+
+	svd := ante.NewSigVerificationDecorator(suite.accountKeeper, suite.clientCtx.TxConfig.SignModeHandler(), ante.DefaultSigVerificationGasConsumer, nil).
+		WithCredentialVerifiers(map[string]types.CredentialVerifier{
+			codectypes.MsgTypeURL(credential): mockCredentialVerifier{},
+		})
+	antehandler := sdk.ChainAnteDecorators(svd)
+
+	newTx := func(seq uint64, sig []byte) sdk.Tx {
+		suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+		require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+		suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+		suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+		require.NoError(t, suite.txBuilder.SetSignatures(signing.SignatureV2{
+			PubKey: credential,
+			Data: &signing.SingleSignatureData{
+				SignMode:  signing.SignMode_SIGN_MODE_DIRECT,
+				Signature: sig,
+			},
+			Sequence: seq,
+		}))
+		return suite.txBuilder.GetTx()
+	}
+
+	// the credential verifier rejects the signature
+	_, err = antehandler(suite.ctx, newTx(0, []byte("invalid")), false)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// the sequence is still checked
+	_, err = antehandler(suite.ctx, newTx(1, []byte("ok")), false)
+	require.ErrorIs(t, err, sdkerrors.ErrWrongSequence)
+
+	// the credential verifier accepts the signature and the sequence is incremented
+	_, err = antehandler(suite.ctx, newTx(0, []byte("ok")), false)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), suite.accountKeeper.GetAccount(suite.ctx, addr).GetSequence())
+
+	// without a registered verifier, the credential can not verify signatures
+	svd = ante.NewSigVerificationDecorator(suite.accountKeeper, suite.clientCtx.TxConfig.SignModeHandler(), ante.DefaultSigVerificationGasConsumer, nil)
+	_, err = sdk.ChainAnteDecorators(svd)(suite.ctx, newTx(1, []byte("ok")), false)
+	require.Error(t, err)
+}
+
 func runSigDecorators(t *testing.T, params types.Params, _ bool, privs ...cryptotypes.PrivKey) (storetypes.Gas, error) {
 	t.Helper()
 	suite := SetupTestSuite(t, true)
@@ -2,6 +2,7 @@ package ante
 
 import (
 	"math"
+	"math/big"
 
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
@@ -66,3 +67,73 @@ func getTxPriority(fee sdk.Coins, gas int64) int64 {
 
 	return priority
 }
+
+// OracleTxPriorityScale is the tx priority of a tx paying exactly the minimum gas price returned by a
+// MinGasPricesOracle, see NewOracleTxFeeChecker.
+const OracleTxPriorityScale = 1_000_000
+
+// MinGasPricesOracle returns the reference minimum gas prices the tx priority is computed relative to, e.g. the
+// prices maintained by a fee market module.
+type MinGasPricesOracle func(ctx sdk.Context) (sdk.DecCoins, error)
+
+// NewOracleTxFeeChecker returns a TxFeeChecker which checks the fees against the validator minimum gas prices like
+// the default one, but computes the tx priority from the gas price relative to the minimum gas prices returned by
+// oracle: the priority is the smallest ratio of the gas price of a fee denom to its oracle minimum gas price, in
+// units of 1/OracleTxPriorityScale. Fee denoms without oracle price are ignored.
+func NewOracleTxFeeChecker(oracle MinGasPricesOracle) TxFeeChecker {
+	return func(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
+		feeCoins, _, err := checkTxFeeWithValidatorMinGasPrices(ctx, tx)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		minGasPrices, err := oracle(ctx)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		gas := tx.(sdk.FeeTx).GetGas()
+		return feeCoins, getOracleTxPriority(feeCoins, gas, minGasPrices), nil
+	}
+}
+
+// getOracleTxPriority returns the smallest ratio of the gas prices provided in a transaction to the positive minimum
+// gas prices of the same denoms, scaled by OracleTxPriorityScale and capped to math.MaxInt64.
+//
+// The ratio is computed on big integers rather than LegacyDec, which could overflow with a large fee amount or a
+// small minimum gas price: as LegacyDec.BigInt returns the minimum gas price scaled by 10^LegacyPrecision,
+// ratio = amount * OracleTxPriorityScale * 10^LegacyPrecision / (gas * minGasPrice.BigInt()).
+func getOracleTxPriority(fee sdk.Coins, gas uint64, minGasPrices sdk.DecCoins) int64 {
+	if gas == 0 {
+		return 0
+	}
+
+	scale := new(big.Int).Mul(
+		big.NewInt(OracleTxPriorityScale),
+		new(big.Int).Exp(big.NewInt(10), big.NewInt(sdkmath.LegacyPrecision), nil),
+	)
+	gasLimit := new(big.Int).SetUint64(gas)
+
+	var (
+		priority int64
+		priced   bool
+	)
+	for _, c := range fee {
+		minGasPrice := minGasPrices.AmountOf(c.Denom)
+		if !minGasPrice.IsPositive() {
+			continue
+		}
+
+		p := int64(math.MaxInt64)
+		ratio := new(big.Int).Mul(c.Amount.BigInt(), scale)
+		ratio.Quo(ratio, new(big.Int).Mul(gasLimit, minGasPrice.BigInt()))
+		if ratio.IsInt64() {
+			p = ratio.Int64()
+		}
+		if !priced || p < priority {
+			priority, priced = p, true
+		}
+	}
+
+	return priority
+}
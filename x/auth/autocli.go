@@ -22,6 +22,11 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "accounts",
 					Short:     "Query all the accounts",
 				},
+				{
+					RpcMethod: "AccountsCount",
+					Use:       "accounts-count",
+					Short:     "Query the number of accounts",
+				},
 				{
 					RpcMethod:      "Account",
 					Use:            "account [address]",
@@ -40,6 +45,13 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Query account address by account number",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
 				},
+				{
+					RpcMethod:      "AccountsByNumberRange",
+					Use:            "accounts-by-acc-num [start] [end]",
+					Short:          "Query the addresses of accounts with an account number in [start, end)",
+					Example:        fmt.Sprintf("%s q auth accounts-by-acc-num 100 200", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "start"}, {ProtoField: "end"}},
+				},
 				{
 					RpcMethod: "ModuleAccounts",
 					Use:       "module-accounts",
@@ -74,6 +86,12 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "params",
 					Short:     "Query the current auth parameters",
 				},
+				{
+					RpcMethod:      "PubKeyHistory",
+					Use:            "pubkey-history [address]",
+					Short:          "Query the public keys an account rotated away from",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -87,6 +105,13 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
 					GovProposal:    true,
 				},
+				{
+					RpcMethod:      "RotatePubKey",
+					Use:            "rotate-pubkey [new-pub-key]",
+					Short:          "Replace the public key of the sender account, preserving its address, account number and sequence",
+					Example:        fmt.Sprintf(`%s tx auth rotate-pubkey '{"@type":"/cosmos.crypto.secp256k1.PubKey","key":"A..."}' --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "new_pub_key"}},
+				},
 			},
 		},
 	}
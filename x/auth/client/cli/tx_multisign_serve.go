@@ -0,0 +1,280 @@
+package cli
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	errorsmod "cosmossdk.io/errors"
+	authclient "cosmossdk.io/x/auth/client"
+	"cosmossdk.io/x/auth/client/multisign"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const (
+	flagListen     = "listen"
+	flagBroadcast  = "broadcast"
+	flagSessionTTL = "session-ttl"
+)
+
+// GetMultiSignServeCommand returns the command serving a multisig signing session.
+func GetMultiSignServeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "multi-sign-serve [file] [name]",
+		Aliases: []string{"multisign-serve"},
+		Short:   "Collect the signatures of a multisig transaction from its signers over HTTP",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Start a signing session of a transaction created with the --generate-only flag that
+requires the signatures of the multisig key [name], and serve it over HTTP until enough signers submitted
+their signature with the multi-sign-join command. Then write the signed transaction, or broadcast it if
+the --broadcast flag is on. The session expires after --session-ttl.
+
+Example:
+$ %s tx multisign-serve transaction.json k1k2k3 --listen localhost:8090 --broadcast
+
+The signers join the session with:
+$ %s tx multisign-join http://localhost:8090 <session-id> --from k1
+
+If the --offline flag is on, the client will not reach out to an external node.
+Account number or sequence number lookups are not performed so you must
+set these parameters manually.
+
+The current multisig implementation defaults to amino-json sign mode.
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: makeMultiSignServeCmd(),
+		Args: cobra.ExactArgs(2),
+	}
+
+	cmd.Flags().String(flagListen, "localhost:8090", "Address on which the session is served")
+	cmd.Flags().Bool(flagBroadcast, false, "Broadcast the transaction once signed, instead of writing it")
+	cmd.Flags().Duration(flagSessionTTL, multisign.DefaultSessionTTL, "Duration after which the session expires")
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The document is written to the given file instead of STDOUT")
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.Flags().MarkHidden(flags.FlagOutput)
+
+	return cmd
+}
+
+func makeMultiSignServeCmd() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) (err error) {
+		file, name := args[0], args[1]
+		_ = cmd.Flags().Set(flags.FlagFrom, name)
+
+		clientCtx, err := client.GetClientTxContext(cmd)
+		if err != nil {
+			return err
+		}
+		parsedTx, err := authclient.ReadTxFromFile(clientCtx, file)
+		if err != nil {
+			return err
+		}
+
+		txFactory, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+		if err != nil {
+			return err
+		}
+		if txFactory.ChainID() == "" {
+			return fmt.Errorf("set the chain id with either the --chain-id flag or config file")
+		}
+
+		k, err := clientCtx.Keyring.Key(name)
+		if err != nil {
+			return errorsmod.Wrap(err, "error getting keybase multisig account")
+		}
+		pubKey, err := k.GetPubKey()
+		if err != nil {
+			return err
+		}
+		multisigPub, ok := pubKey.(*kmultisig.LegacyAminoPubKey)
+		if !ok {
+			return fmt.Errorf("%s is not a multisig key", name)
+		}
+
+		if !clientCtx.Offline {
+			addr, err := k.GetAddress()
+			if err != nil {
+				return err
+			}
+			accnum, seq, err := clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, addr)
+			if err != nil {
+				return err
+			}
+
+			txFactory = txFactory.WithAccountNumber(accnum).WithSequence(seq)
+		}
+
+		broadcast, _ := cmd.Flags().GetBool(flagBroadcast)
+		sessionTTL, _ := cmd.Flags().GetDuration(flagSessionTTL)
+		coordinator := multisign.NewCoordinator(clientCtx, broadcast, sessionTTL)
+		id, err := coordinator.NewSession(parsedTx, multisign.SessionParams{
+			Multisig:      multisigPub,
+			ChainID:       txFactory.ChainID(),
+			AccountNumber: txFactory.AccountNumber(),
+			Sequence:      txFactory.Sequence(),
+			SignMode:      txFactory.SignMode(),
+		})
+		if err != nil {
+			return err
+		}
+		done, err := coordinator.Done(id)
+		if err != nil {
+			return err
+		}
+
+		listenAddr, _ := cmd.Flags().GetString(flagListen)
+		listener, err := net.Listen("tcp", listenAddr)
+		if err != nil {
+			return err
+		}
+		srv := &http.Server{Handler: coordinator, ReadHeaderTimeout: 10 * time.Second}
+		serveErr := make(chan error, 1)
+		go func() { serveErr <- srv.Serve(listener) }()
+		defer srv.Close()
+
+		cmd.PrintErrf("serving signing session %s at http://%s\n", id, listener.Addr())
+
+		expired := time.NewTimer(sessionTTL)
+		defer expired.Stop()
+
+		select {
+		case <-done:
+		case <-expired.C:
+			return fmt.Errorf("signing session %s expired", id)
+		case err := <-serveErr:
+			return err
+		case <-cmd.Context().Done():
+			return cmd.Context().Err()
+		}
+
+		signedTx, res, err := coordinator.Result(id)
+		if broadcast && err == nil {
+			return clientCtx.PrintProto(res)
+		}
+		if signedTx == nil {
+			return err
+		}
+
+		// the signed transaction is written even if its broadcast failed
+		txJSON, jsonErr := clientCtx.TxConfig.TxJSONEncoder()(signedTx)
+		if jsonErr != nil {
+			return jsonErr
+		}
+
+		closeFunc, outputErr := setOutputFile(cmd)
+		if outputErr != nil {
+			return outputErr
+		}
+
+		defer closeFunc()
+
+		cmd.Printf("%s\n", txJSON)
+		return err
+	}
+}
+
+// GetMultiSignJoinCommand returns the command signing the transaction of a
+// multisig signing session.
+func GetMultiSignJoinCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "multi-sign-join [url] [session-id]",
+		Aliases: []string{"multisign-join"},
+		Short:   "Sign the transaction of a multisig signing session served by multi-sign-serve",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Sign the transaction of the signing session [session-id] served at [url] by the
+multi-sign-serve command with the key --from, a signer of the multisig account of the session, and submit
+the signature to the session. The signer data and sign mode of the session are used, but the chain ID of
+the session must be the configured one.
+
+The transaction and the signer data are printed before signing, which must be confirmed unless the --yes
+flag is on.
+
+Example:
+$ %s tx multisign-join http://localhost:8090 <session-id> --from k1
+`,
+				version.AppName,
+			),
+		),
+		RunE: makeMultiSignJoinCmd(),
+		Args: cobra.ExactArgs(2),
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func makeMultiSignJoinCmd() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		url, id := args[0], args[1]
+
+		clientCtx, err := client.GetClientTxContext(cmd)
+		if err != nil {
+			return err
+		}
+		if clientCtx.FromName == "" {
+			return errors.New("set the signing key with the --from flag")
+		}
+
+		txFactory, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+		if err != nil {
+			return err
+		}
+		if txFactory.ChainID() == "" {
+			return fmt.Errorf("set the chain id with either the --chain-id flag or config file")
+		}
+
+		coordinator := multisign.NewClient(url)
+		status, err := coordinator.Session(cmd.Context(), id)
+		if err != nil {
+			return err
+		}
+		if status.ChainID != txFactory.ChainID() {
+			return fmt.Errorf("chain ID of the session %q doesn't match the configured chain ID %q", status.ChainID, txFactory.ChainID())
+		}
+
+		if !clientCtx.SkipConfirm {
+			cmd.PrintErrf("%s\n\nmultisig: %s, chain-id: %s, account-number: %d, sequence: %d, sign-mode: %s\n\n",
+				status.Tx, status.Multisig, status.ChainID, status.AccountNumber, status.Sequence, status.SignMode)
+			ok, err := input.GetConfirmation("confirm transaction before signing", bufio.NewReader(cmd.InOrStdin()), cmd.ErrOrStderr())
+			if err != nil {
+				return err
+			}
+			if !ok {
+				return errors.New("transaction not signed")
+			}
+		}
+
+		sigs, err := multisign.SignSession(clientCtx, txFactory, status, clientCtx.FromName)
+		if err != nil {
+			return err
+		}
+
+		status, err = coordinator.SubmitSignatures(cmd.Context(), id, sigs)
+		if err != nil {
+			return err
+		}
+
+		bz, err := json.Marshal(status)
+		if err != nil {
+			return err
+		}
+
+		return clientCtx.PrintRaw(bz)
+	}
+}
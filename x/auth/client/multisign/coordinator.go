@@ -0,0 +1,416 @@
+// Package multisign coordinates the interactive signing of transactions by
+// the signers of multisig accounts, instead of exchanging signature files for
+// the multi-sign command.
+//
+// A Coordinator holds signing sessions, each collecting the signatures of a
+// transaction until the threshold of its multisig account is reached, when the
+// transaction can be broadcast. It serves the sessions over HTTP to the
+// signers, who submit their signatures with a Client.
+package multisign
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"cosmossdk.io/x/auth/signing"
+	txsigning "cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+var (
+	// ErrSessionNotFound is returned for an unknown session.
+	ErrSessionNotFound = errors.New("signing session not found")
+	// ErrSessionComplete is returned when submitting signatures to a session
+	// which already reached its threshold.
+	ErrSessionComplete = errors.New("signing session is complete")
+)
+
+// DefaultSessionTTL is the default duration of the signing sessions, after
+// which they are evicted.
+const DefaultSessionTTL = time.Hour
+
+// SessionParams are the parameters of a signing session.
+type SessionParams struct {
+	// Multisig is the public key of the multisig account signing the transaction.
+	Multisig *kmultisig.LegacyAminoPubKey
+	// ChainID, AccountNumber and Sequence are the signer data of the multisig account.
+	ChainID       string
+	AccountNumber uint64
+	Sequence      uint64
+	// SignMode is the sign mode of the signers, LEGACY_AMINO_JSON if unspecified.
+	SignMode signingtypes.SignMode
+}
+
+// SignerStatus is the status of a signer of a signing session.
+type SignerStatus struct {
+	Address string `json:"address"`
+	Signed  bool   `json:"signed"`
+}
+
+// SessionStatus is the status of a signing session, from which the signers
+// sign its transaction.
+type SessionStatus struct {
+	ID string `json:"id"`
+	// Tx is the JSON encoding of the unsigned transaction.
+	Tx            json.RawMessage `json:"tx"`
+	Multisig      string          `json:"multisig"`
+	Threshold     uint32          `json:"threshold"`
+	ChainID       string          `json:"chain_id"`
+	AccountNumber uint64          `json:"account_number"`
+	Sequence      uint64          `json:"sequence"`
+	SignMode      string          `json:"sign_mode"`
+	Signers       []SignerStatus  `json:"signers"`
+	// Complete is true once the threshold is reached.
+	Complete bool `json:"complete"`
+	// TxHash is the hash of the transaction, once broadcast.
+	TxHash string `json:"txhash,omitempty"`
+	// BroadcastError is the error of the broadcast of the transaction, if any.
+	BroadcastError string `json:"broadcast_error,omitempty"`
+}
+
+type session struct {
+	id        string
+	params    SessionParams
+	txBuilder client.TxBuilder
+	txJSON    []byte
+	// sigs are the signatures of the signers, by index in the multisig public keys
+	sigs     []*signingtypes.SignatureV2
+	complete bool
+	done     chan struct{}
+	expires  time.Time
+
+	broadcastRes *sdk.TxResponse
+	broadcastErr error
+}
+
+// Coordinator holds the signing sessions of multisig transactions.
+type Coordinator struct {
+	clientCtx  client.Context
+	broadcast  bool
+	sessionTTL time.Duration
+
+	mu       sync.Mutex
+	sessions map[string]*session
+}
+
+// NewCoordinator returns a Coordinator encoding and verifying the
+// transactions with the TxConfig of the client context. If broadcast is true,
+// the transactions are broadcast with the client context once signed. Sessions
+// are evicted sessionTTL after they started, DefaultSessionTTL if zero.
+func NewCoordinator(clientCtx client.Context, broadcast bool, sessionTTL time.Duration) *Coordinator {
+	if sessionTTL <= 0 {
+		sessionTTL = DefaultSessionTTL
+	}
+
+	return &Coordinator{
+		clientCtx:  clientCtx,
+		broadcast:  broadcast,
+		sessionTTL: sessionTTL,
+		sessions:   map[string]*session{},
+	}
+}
+
+// NewSession starts a signing session of a transaction, and returns its ID.
+func (c *Coordinator) NewSession(tx sdk.Tx, params SessionParams) (string, error) {
+	if params.Multisig == nil {
+		return "", errors.New("the multisig public key of the session is not set")
+	}
+	if params.SignMode == signingtypes.SignMode_SIGN_MODE_UNSPECIFIED {
+		params.SignMode = signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
+	}
+
+	txBuilder, err := c.clientCtx.TxConfig.WrapTxBuilder(tx)
+	if err != nil {
+		return "", err
+	}
+	txJSON, err := c.clientCtx.TxConfig.TxJSONEncoder()(txBuilder.GetTx())
+	if err != nil {
+		return "", err
+	}
+
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return "", err
+	}
+
+	s := &session{
+		id:        hex.EncodeToString(id),
+		params:    params,
+		txBuilder: txBuilder,
+		txJSON:    txJSON,
+		sigs:      make([]*signingtypes.SignatureV2, len(params.Multisig.PubKeys)),
+		done:      make(chan struct{}),
+		expires:   time.Now().Add(c.sessionTTL),
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.evictExpired()
+	c.sessions[s.id] = s
+
+	return s.id, nil
+}
+
+// Status returns the status of a session.
+func (c *Coordinator) Status(id string) (*SessionStatus, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	s, err := c.session(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.status(), nil
+}
+
+// Done returns a channel closed once a session is complete, and its
+// transaction broadcast if enabled.
+func (c *Coordinator) Done(id string) (<-chan struct{}, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	s, err := c.session(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.done, nil
+}
+
+// Result returns the signed transaction of a complete session, and the
+// response of its broadcast if enabled.
+func (c *Coordinator) Result(id string) (sdk.Tx, *sdk.TxResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	s, err := c.session(id)
+	if err != nil {
+		return nil, nil, err
+	}
+	select {
+	case <-s.done:
+	default:
+		return nil, nil, fmt.Errorf("signing session %s is not complete", id)
+	}
+
+	return s.txBuilder.GetTx(), s.broadcastRes, s.broadcastErr
+}
+
+// AddSignatures verifies and adds signatures of signers of the multisig
+// account to a session, replacing their previous signatures. Once the
+// threshold is reached, the multisig signature is set to the transaction,
+// which is broadcast if enabled.
+func (c *Coordinator) AddSignatures(ctx context.Context, id string, sigs []signingtypes.SignatureV2) (*SessionStatus, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	s, err := c.session(id)
+	if err != nil {
+		return nil, err
+	}
+	if s.complete {
+		return nil, fmt.Errorf("%w: %s", ErrSessionComplete, id)
+	}
+
+	// verify all the signatures before adding them
+	signerIndexes := make([]int, len(sigs))
+	for i, sig := range sigs {
+		signerIndex, err := s.signerIndex(sig)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := c.verifySignature(ctx, s, sig); err != nil {
+			return nil, err
+		}
+
+		signerIndexes[i] = signerIndex
+	}
+
+	for i := range sigs {
+		s.sigs[signerIndexes[i]] = &sigs[i]
+	}
+
+	if s.signatures() < int(s.params.Multisig.Threshold) {
+		return s.status(), nil
+	}
+
+	if err := s.setMultisigSignature(); err != nil {
+		return nil, err
+	}
+	s.complete = true
+
+	if !c.broadcast {
+		close(s.done)
+		return s.status(), nil
+	}
+
+	// the session is complete so no other signature is added to it, and the
+	// transaction is broadcast without holding the lock
+	c.mu.Unlock()
+	res, err := c.broadcastTx(s.txBuilder.GetTx())
+	c.mu.Lock()
+
+	s.broadcastRes, s.broadcastErr = res, err
+	close(s.done)
+
+	return s.status(), nil
+}
+
+func (c *Coordinator) verifySignature(ctx context.Context, s *session, sig signingtypes.SignatureV2) error {
+	anyPk, err := codectypes.NewAnyWithValue(sig.PubKey)
+	if err != nil {
+		return err
+	}
+	txSignerData := txsigning.SignerData{
+		ChainID:       s.params.ChainID,
+		AccountNumber: s.params.AccountNumber,
+		Sequence:      s.params.Sequence,
+		Address:       sdk.AccAddress(sig.PubKey.Address()).String(),
+		PubKey: &anypb.Any{
+			TypeUrl: anyPk.TypeUrl,
+			Value:   anyPk.Value,
+		},
+	}
+
+	builtTx := s.txBuilder.GetTx()
+	adaptableTx, ok := builtTx.(signing.V2AdaptableTx)
+	if !ok {
+		return fmt.Errorf("expected Tx to be signing.V2AdaptableTx, got %T", builtTx)
+	}
+
+	err = signing.VerifySignature(ctx, sig.PubKey, txSignerData, sig.Data,
+		c.clientCtx.TxConfig.SignModeHandler(), adaptableTx.GetSigningTxData())
+	if err != nil {
+		return fmt.Errorf("couldn't verify signature for address %s: %w", sdk.AccAddress(sig.PubKey.Address()), err)
+	}
+
+	return nil
+}
+
+// setMultisigSignature sets the multisig signature of the signatures of a
+// session to its transaction.
+func (s *session) setMultisigSignature() error {
+	multisigSig := multisig.NewMultisig(len(s.params.Multisig.PubKeys))
+	for _, sig := range s.sigs {
+		if sig == nil {
+			continue
+		}
+		if err := multisig.AddSignatureV2(multisigSig, *sig, s.params.Multisig.GetPubKeys()); err != nil {
+			return err
+		}
+	}
+
+	return s.txBuilder.SetSignatures(signingtypes.SignatureV2{
+		PubKey:   s.params.Multisig,
+		Data:     multisigSig,
+		Sequence: s.params.Sequence,
+	})
+}
+
+// broadcastTx broadcasts a signed transaction with the client context.
+func (c *Coordinator) broadcastTx(tx sdk.Tx) (*sdk.TxResponse, error) {
+	txBytes, err := c.clientCtx.TxConfig.TxEncoder()(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.clientCtx.BroadcastTx(txBytes)
+	if err == nil && res.Code != 0 {
+		err = fmt.Errorf("transaction failed with code %d: %s", res.Code, res.RawLog)
+	}
+
+	return res, err
+}
+
+// session returns a session, evicting it if expired. c.mu must be held.
+func (c *Coordinator) session(id string) (*session, error) {
+	s, ok := c.sessions[id]
+	if ok && time.Now().After(s.expires) {
+		delete(c.sessions, id)
+		ok = false
+	}
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrSessionNotFound, id)
+	}
+
+	return s, nil
+}
+
+// evictExpired evicts the expired sessions. c.mu must be held.
+func (c *Coordinator) evictExpired() {
+	now := time.Now()
+	for id, s := range c.sessions {
+		if now.After(s.expires) {
+			delete(c.sessions, id)
+		}
+	}
+}
+
+// signerIndex returns the index of the signer of a signature in the multisig
+// public keys.
+func (s *session) signerIndex(sig signingtypes.SignatureV2) (int, error) {
+	for i, pk := range s.params.Multisig.GetPubKeys() {
+		if pk.Equals(sig.PubKey) {
+			return i, nil
+		}
+	}
+
+	return 0, fmt.Errorf("address %s is not a signer of the multisig account", sdk.AccAddress(sig.PubKey.Address()))
+}
+
+func (s *session) signatures() int {
+	n := 0
+	for _, sig := range s.sigs {
+		if sig != nil {
+			n++
+		}
+	}
+
+	return n
+}
+
+func (s *session) status() *SessionStatus {
+	res := &SessionStatus{
+		ID:            s.id,
+		Tx:            s.txJSON,
+		Multisig:      sdk.AccAddress(s.params.Multisig.Address()).String(),
+		Threshold:     s.params.Multisig.Threshold,
+		ChainID:       s.params.ChainID,
+		AccountNumber: s.params.AccountNumber,
+		Sequence:      s.params.Sequence,
+		SignMode:      s.params.SignMode.String(),
+		Complete:      s.complete,
+	}
+
+	for i, pk := range s.params.Multisig.GetPubKeys() {
+		res.Signers = append(res.Signers, SignerStatus{
+			Address: sdk.AccAddress(pk.Address()).String(),
+			Signed:  s.sigs[i] != nil,
+		})
+	}
+
+	if s.broadcastRes != nil {
+		res.TxHash = s.broadcastRes.TxHash
+	}
+	if s.broadcastErr != nil {
+		res.BroadcastError = s.broadcastErr.Error()
+	}
+
+	return res
+}
@@ -0,0 +1,177 @@
+package multisign_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"cosmossdk.io/x/auth/client/multisign"
+	authsigning "cosmossdk.io/x/auth/signing"
+	txsigning "cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	_ "github.com/cosmos/cosmos-sdk/testutil/testdata/testpb"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+func TestCoordinator(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{})
+	testdata.RegisterInterfaces(encCfg.InterfaceRegistry)
+
+	kr := keyring.NewInMemory(encCfg.Codec)
+	var pubKeys []cryptotypes.PubKey
+	for _, name := range []string{"k1", "k2", "k3", "other"} {
+		k, _, err := kr.NewMnemonic(name, keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+		require.NoError(t, err)
+		pub, err := k.GetPubKey()
+		require.NoError(t, err)
+		pubKeys = append(pubKeys, pub)
+	}
+	multisigPub := kmultisig.NewLegacyAminoPubKey(2, pubKeys[:3])
+	multisigAddr := sdk.AccAddress(multisigPub.Address())
+
+	clientCtx := client.Context{}.
+		WithTxConfig(encCfg.TxConfig).
+		WithCodec(encCfg.Codec).
+		WithInterfaceRegistry(encCfg.InterfaceRegistry).
+		WithKeyring(kr).
+		WithOffline(true).
+		WithCmdContext(context.Background())
+	txFactory := tx.Factory{}.WithKeybase(kr).WithTxConfig(encCfg.TxConfig).WithChainID("test-chain")
+
+	txBuilder := encCfg.TxConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(multisigAddr)))
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	txBuilder.SetGasLimit(200000)
+
+	coordinator := multisign.NewCoordinator(clientCtx, false, 0)
+	id, err := coordinator.NewSession(txBuilder.GetTx(), multisign.SessionParams{
+		Multisig:      multisigPub,
+		ChainID:       "test-chain",
+		AccountNumber: 1,
+		Sequence:      2,
+	})
+	require.NoError(t, err)
+
+	srv := httptest.NewServer(coordinator)
+	defer srv.Close()
+	c := multisign.NewClient(srv.URL)
+	ctx := context.Background()
+
+	status, err := c.Session(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, multisigAddr.String(), status.Multisig)
+	require.Equal(t, uint32(2), status.Threshold)
+	require.Equal(t, "SIGN_MODE_LEGACY_AMINO_JSON", status.SignMode)
+	require.Len(t, status.Signers, 3)
+	require.False(t, status.Complete)
+
+	_, err = c.Session(ctx, "unknown")
+	require.ErrorContains(t, err, "signing session not found")
+
+	// a session of another chain
+	_, err = multisign.SignSession(clientCtx, txFactory.WithChainID("other-chain"), status, "k1")
+	require.ErrorContains(t, err, "doesn't match the configured chain ID")
+
+	// a key which is not a signer of the multisig account
+	sigs, err := multisign.SignSession(clientCtx, txFactory, status, "other")
+	require.NoError(t, err)
+	_, err = c.SubmitSignatures(ctx, id, sigs)
+	require.ErrorContains(t, err, "is not a signer of the multisig account")
+
+	sigs, err = multisign.SignSession(clientCtx, txFactory, status, "k1")
+	require.NoError(t, err)
+	status, err = c.SubmitSignatures(ctx, id, sigs)
+	require.NoError(t, err)
+	require.Equal(t, []multisign.SignerStatus{
+		{Address: sdk.AccAddress(pubKeys[0].Address()).String(), Signed: true},
+		{Address: sdk.AccAddress(pubKeys[1].Address()).String(), Signed: false},
+		{Address: sdk.AccAddress(pubKeys[2].Address()).String(), Signed: false},
+	}, status.Signers)
+	require.False(t, status.Complete)
+
+	_, _, err = coordinator.Result(id)
+	require.ErrorContains(t, err, "is not complete")
+
+	// a signature of other signer data
+	otherStatus := *status
+	otherStatus.Sequence = 3
+	sigs, err = multisign.SignSession(clientCtx, txFactory, &otherStatus, "k3")
+	require.NoError(t, err)
+	_, err = c.SubmitSignatures(ctx, id, sigs)
+	require.ErrorContains(t, err, "couldn't verify signature")
+
+	sigs, err = multisign.SignSession(clientCtx, txFactory, status, "k3")
+	require.NoError(t, err)
+	status, err = c.SubmitSignatures(ctx, id, sigs)
+	require.NoError(t, err)
+	require.True(t, status.Complete)
+
+	done, err := coordinator.Done(id)
+	require.NoError(t, err)
+	select {
+	case <-done:
+	default:
+		t.Fatal("the session is not done")
+	}
+
+	signedTx, res, err := coordinator.Result(id)
+	require.NoError(t, err)
+	require.Nil(t, res)
+
+	// the multisig signature is valid
+	signedTxBuilder, err := encCfg.TxConfig.WrapTxBuilder(signedTx)
+	require.NoError(t, err)
+	txSigs, err := signedTxBuilder.GetTx().GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, txSigs, 1)
+	anyPk, err := codectypes.NewAnyWithValue(multisigPub)
+	require.NoError(t, err)
+	err = authsigning.VerifySignature(ctx, multisigPub, txsigning.SignerData{
+		ChainID:       "test-chain",
+		AccountNumber: 1,
+		Sequence:      2,
+		Address:       multisigAddr.String(),
+		PubKey:        &anypb.Any{TypeUrl: anyPk.TypeUrl, Value: anyPk.Value},
+	}, txSigs[0].Data, encCfg.TxConfig.SignModeHandler(), signedTx.(authsigning.V2AdaptableTx).GetSigningTxData())
+	require.NoError(t, err)
+
+	sigs, err = multisign.SignSession(clientCtx, txFactory, status, "k2")
+	require.NoError(t, err)
+	_, err = c.SubmitSignatures(ctx, id, sigs)
+	require.ErrorContains(t, err, "signing session is complete")
+}
+
+func TestCoordinatorSessionTTL(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{})
+	testdata.RegisterInterfaces(encCfg.InterfaceRegistry)
+	_, _, addr := testdata.KeyTestPubAddr()
+	_, pub, _ := testdata.KeyTestPubAddr()
+	multisigPub := kmultisig.NewLegacyAminoPubKey(1, []cryptotypes.PubKey{pub})
+
+	txBuilder := encCfg.TxConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+
+	coordinator := multisign.NewCoordinator(client.Context{}.WithTxConfig(encCfg.TxConfig), false, time.Millisecond)
+	id, err := coordinator.NewSession(txBuilder.GetTx(), multisign.SessionParams{Multisig: multisigPub, ChainID: "test-chain"})
+	require.NoError(t, err)
+	_, err = coordinator.Status(id)
+	require.NoError(t, err)
+
+	time.Sleep(2 * time.Millisecond)
+	_, err = coordinator.Status(id)
+	require.ErrorIs(t, err, multisign.ErrSessionNotFound)
+}
@@ -0,0 +1,138 @@
+package multisign
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// maxRequestSize is the maximum size of the signatures submitted to a session.
+const maxRequestSize = 1 << 20
+
+// errorResponse is the body of the error responses.
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// ServeHTTP serves the sessions of the coordinator:
+//
+//	GET  /sessions/{id}             returns the SessionStatus of a session
+//	POST /sessions/{id}/signatures  adds the signatures of the body, encoded as
+//	                                by the sign command with --signature-only,
+//	                                and returns the SessionStatus of the session
+func (c *Coordinator) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path, ok := strings.CutPrefix(r.URL.Path, "/sessions/")
+	if !ok {
+		writeError(w, http.StatusNotFound, errors.New("not found"))
+		return
+	}
+
+	id, action, _ := strings.Cut(path, "/")
+	switch {
+	case action == "" && r.Method == http.MethodGet:
+		status, err := c.Status(id)
+		if err != nil {
+			writeError(w, http.StatusNotFound, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, status)
+
+	case action == "signatures" && r.Method == http.MethodPost:
+		bz, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxRequestSize))
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+		sigs, err := c.clientCtx.TxConfig.UnmarshalSignatureJSON(bz)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, fmt.Errorf("invalid signatures: %w", err))
+			return
+		}
+
+		status, err := c.AddSignatures(r.Context(), id, sigs)
+		switch {
+		case errors.Is(err, ErrSessionNotFound):
+			writeError(w, http.StatusNotFound, err)
+		case errors.Is(err, ErrSessionComplete):
+			writeError(w, http.StatusConflict, err)
+		case err != nil:
+			writeError(w, http.StatusBadRequest, err)
+		default:
+			writeJSON(w, http.StatusOK, status)
+		}
+
+	default:
+		writeError(w, http.StatusNotFound, errors.New("not found"))
+	}
+}
+
+func writeJSON(w http.ResponseWriter, code int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, code int, err error) {
+	writeJSON(w, code, errorResponse{Error: err.Error()})
+}
+
+// Client is a client of the sessions served by a Coordinator.
+type Client struct {
+	url        string
+	httpClient *http.Client
+}
+
+// NewClient returns a Client of the coordinator served at the given URL.
+func NewClient(url string) *Client {
+	return &Client{
+		url:        strings.TrimSuffix(url, "/"),
+		httpClient: http.DefaultClient,
+	}
+}
+
+// Session returns the status of a session.
+func (c *Client) Session(ctx context.Context, id string) (*SessionStatus, error) {
+	return c.do(ctx, http.MethodGet, "/sessions/"+id, nil)
+}
+
+// SubmitSignatures submits signatures, encoded as by the sign command with
+// --signature-only, to a session and returns its status.
+func (c *Client) SubmitSignatures(ctx context.Context, id string, sigs []byte) (*SessionStatus, error) {
+	return c.do(ctx, http.MethodPost, "/sessions/"+id+"/signatures", sigs)
+}
+
+func (c *Client) do(ctx context.Context, method, path string, body []byte) (*SessionStatus, error) {
+	req, err := http.NewRequestWithContext(ctx, method, c.url+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		var errRes errorResponse
+		if err := json.NewDecoder(res.Body).Decode(&errRes); err != nil || errRes.Error == "" {
+			return nil, fmt.Errorf("coordinator returned %s", res.Status)
+		}
+		return nil, fmt.Errorf("coordinator returned %s: %s", res.Status, errRes.Error)
+	}
+
+	var status SessionStatus
+	if err := json.NewDecoder(res.Body).Decode(&status); err != nil {
+		return nil, err
+	}
+
+	return &status, nil
+}
@@ -0,0 +1,57 @@
+package multisign
+
+import (
+	"fmt"
+
+	authclient "cosmossdk.io/x/auth/client"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// SignSession signs the transaction of a session with the key name of a
+// signer of its multisig account, with the signer data and sign mode of the
+// session, and returns the signatures to submit to the session. The chain ID
+// of the session must be the one of txFactory.
+func SignSession(clientCtx client.Context, txFactory tx.Factory, status *SessionStatus, name string) ([]byte, error) {
+	if status.ChainID != txFactory.ChainID() {
+		return nil, fmt.Errorf("chain ID of the session %q doesn't match the configured chain ID %q", status.ChainID, txFactory.ChainID())
+	}
+
+	sdkTx, err := clientCtx.TxConfig.TxJSONDecoder()(status.Tx)
+	if err != nil {
+		return nil, err
+	}
+	txBuilder, err := clientCtx.TxConfig.WrapTxBuilder(sdkTx)
+	if err != nil {
+		return nil, err
+	}
+
+	signMode, ok := signingtypes.SignMode_value[status.SignMode]
+	if !ok {
+		return nil, fmt.Errorf("unknown sign mode %s", status.SignMode)
+	}
+	multisigAddr, err := sdk.AccAddressFromBech32(status.Multisig)
+	if err != nil {
+		return nil, err
+	}
+
+	txFactory = txFactory.
+		WithChainID(status.ChainID).
+		WithAccountNumber(status.AccountNumber).
+		WithSequence(status.Sequence).
+		WithSignMode(signingtypes.SignMode(signMode))
+	err = authclient.SignTxWithSignerAddress(txFactory, clientCtx, multisigAddr, name, txBuilder, true, true)
+	if err != nil {
+		return nil, err
+	}
+
+	sigs, err := txBuilder.GetTx().GetSignaturesV2()
+	if err != nil {
+		return nil, err
+	}
+
+	return clientCtx.TxConfig.MarshalSignatureJSON(sigs)
+}
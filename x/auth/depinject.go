@@ -22,6 +22,7 @@ func (am AppModule) IsOnePerModuleType() {}
 func init() {
 	appconfig.RegisterModule(&modulev1.Module{},
 		appconfig.Provide(ProvideModule),
+		appconfig.Invoke(InvokeSetBalanceKeeper),
 	)
 }
 
@@ -69,8 +70,27 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		panic(err)
 	}
 
-	k := keeper.NewAccountKeeper(in.Environment, in.Cdc, in.AccountI, maccPerms, in.AddressCodec, in.Config.Bech32Prefix, auth)
+	var opts []keeper.AccountKeeperOption
+	if in.Config.InitModuleAccountsAtGenesis {
+		opts = append(opts, keeper.WithInitModuleAccounts())
+	}
+
+	k := keeper.NewAccountKeeper(in.Environment, in.Cdc, in.AccountI, maccPerms, in.AddressCodec, in.Config.Bech32Prefix, auth, opts...)
 	m := NewAppModule(in.Cdc, k, in.RandomGenesisAccountsFn)
 
 	return ModuleOutputs{AccountKeeper: k, Module: m}
 }
+
+type BalanceKeeperInputs struct {
+	depinject.In
+
+	AccountKeeper keeper.AccountKeeper
+	BalanceKeeper types.BalanceKeeper `optional:"true"`
+}
+
+// InvokeSetBalanceKeeper sets the balance keeper used by account pruning, if any.
+func InvokeSetBalanceKeeper(in BalanceKeeperInputs) {
+	if in.BalanceKeeper != nil {
+		in.AccountKeeper.SetBalanceKeeper(in.BalanceKeeper)
+	}
+}
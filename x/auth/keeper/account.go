@@ -3,10 +3,15 @@ package keeper
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/auth/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 // NewAccountWithAddress implements AccountKeeperI.
@@ -60,3 +65,186 @@ func (ak AccountKeeper) RemoveAccount(ctx context.Context, acc sdk.AccountI) {
 		panic(err)
 	}
 }
+
+// ImportAccounts stores accounts which already carry their account number, as
+// done by chain migrations that do not go through genesis. All the accounts are
+// validated before any of them is written: their addresses must not be in use
+// and their account numbers must be unique, both within the batch and against
+// the existing accounts. The account numbers below the global account number
+// counter have already been handed out, possibly reserved by
+// ReserveAccountNumbers for accounts which do not exist yet, so the imported
+// account numbers must not be lower than the counter. The counter is then moved
+// past the largest imported account number once, instead of once per account.
+func (ak AccountKeeper) ImportAccounts(ctx context.Context, accounts []sdk.AccountI) error {
+	if len(accounts) == 0 {
+		return nil
+	}
+
+	next, err := ak.AccountNumber.Peek(ctx)
+	if err != nil {
+		return err
+	}
+
+	addrs := make(map[string]struct{}, len(accounts))
+	accNums := make(map[uint64]struct{}, len(accounts))
+	var maxAccNum uint64
+	for _, acc := range accounts {
+		addr, accNum := acc.GetAddress(), acc.GetAccountNumber()
+		if _, ok := addrs[string(addr)]; ok {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate account %s", addr)
+		}
+		if _, ok := accNums[accNum]; ok {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate account number %d", accNum)
+		}
+		addrs[string(addr)], accNums[accNum] = struct{}{}, struct{}{}
+
+		has, err := ak.Accounts.Has(ctx, addr)
+		if err != nil {
+			return err
+		}
+		if has {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", addr)
+		}
+		_, err = ak.Accounts.Indexes.Number.MatchExact(ctx, accNum)
+		switch {
+		case err == nil:
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account number %d is already in use", accNum)
+		case !errors.Is(err, collections.ErrNotFound):
+			return err
+		}
+		if accNum < next {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account number %d is already handed out, the next one is %d", accNum, next)
+		}
+
+		if accNum > maxAccNum {
+			maxAccNum = accNum
+		}
+	}
+
+	for _, acc := range accounts {
+		if err := ak.Accounts.Set(ctx, acc.GetAddress(), acc); err != nil {
+			return err
+		}
+	}
+
+	return ak.AccountNumber.Set(ctx, maxAccNum+1)
+}
+
+// IterateAccountsPaginated calls cb on every account of the requested page,
+// reading accounts from the store one at a time instead of loading the whole
+// set in memory. Iteration stops at the first error returned by cb.
+func (ak AccountKeeper) IterateAccountsPaginated(
+	ctx context.Context, pageReq *query.PageRequest, cb func(sdk.AccountI) error,
+) (*query.PageResponse, error) {
+	_, pageRes, err := query.CollectionPaginate(
+		ctx,
+		ak.Accounts,
+		pageReq,
+		func(_ sdk.AccAddress, acc sdk.AccountI) (struct{}, error) {
+			return struct{}{}, cb(acc)
+		},
+	)
+	return pageRes, err
+}
+
+// GetAccountsPaginated implements AccountKeeperI.
+// The filter is applied before the page limit is counted, so a page holds up
+// to Limit matching accounts. A nil filter matches every account. The returned
+// NextKey is the raw store key of the next account, not its bech32 encoding.
+func (ak AccountKeeper) GetAccountsPaginated(
+	ctx context.Context, pageReq *query.PageRequest, filter func(sdk.AccountI) bool,
+) ([]sdk.AccountI, *query.PageResponse, error) {
+	var predicateFunc func(sdk.AccAddress, sdk.AccountI) (bool, error)
+	if filter != nil {
+		predicateFunc = func(_ sdk.AccAddress, acc sdk.AccountI) (bool, error) {
+			return filter(acc), nil
+		}
+	}
+
+	return query.CollectionFilteredPaginate(
+		ctx,
+		ak.Accounts,
+		pageReq,
+		predicateFunc,
+		func(_ sdk.AccAddress, acc sdk.AccountI) (sdk.AccountI, error) {
+			return acc, nil
+		},
+	)
+}
+
+// GetAccountNumbersPaginated returns a page of the account number to address index,
+// restricted to account numbers in [start, end). An end of zero means no upper bound.
+// The returned NextKey is the big endian encoding of the next account number.
+func (ak AccountKeeper) GetAccountNumbersPaginated(
+	ctx context.Context, start, end uint64, pageReq *query.PageRequest,
+) ([]types.AccountNumberAddress, *query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if pageReq.Offset > 0 && len(pageReq.Key) != 0 {
+		return nil, nil, fmt.Errorf("invalid request, either offset or key is expected, got both")
+	}
+
+	limit, countTotal := pageReq.Limit, pageReq.CountTotal
+	if limit == 0 {
+		limit, countTotal = query.DefaultLimit, true
+	}
+
+	rng := new(collections.Range[uint64])
+	if len(pageReq.Key) != 0 {
+		if len(pageReq.Key) != 8 {
+			return nil, nil, fmt.Errorf("invalid pagination key length %d", len(pageReq.Key))
+		}
+		key := sdk.BigEndianToUint64(pageReq.Key)
+		// a key based request never counts the total, as in query.Paginate.
+		countTotal = false
+		if pageReq.Reverse {
+			if end == 0 || key < end {
+				end = key + 1
+			}
+		} else if key > start {
+			start = key
+		}
+	}
+	rng = rng.StartInclusive(start)
+	if end != 0 {
+		rng = rng.EndExclusive(end)
+	}
+	if pageReq.Reverse {
+		rng = rng.Descending()
+	}
+
+	var (
+		count   uint64
+		results []types.AccountNumberAddress
+		nextKey []byte
+	)
+	err := ak.Accounts.Indexes.Number.Walk(ctx, rng, func(accNum uint64, addr sdk.AccAddress) (bool, error) {
+		count++
+		switch {
+		case count <= pageReq.Offset:
+			return false, nil
+		case count <= pageReq.Offset+limit:
+			addrStr, err := ak.addressCodec.BytesToString(addr)
+			if err != nil {
+				return true, err
+			}
+			results = append(results, types.AccountNumberAddress{AccountNumber: accNum, Address: addrStr})
+			return false, nil
+		default:
+			if nextKey == nil {
+				nextKey = sdk.Uint64ToBigEndian(accNum)
+			}
+			return !countTotal, nil
+		}
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pageRes := &query.PageResponse{NextKey: nextKey}
+	if countTotal {
+		pageRes.Total = count
+	}
+	return results, pageRes, nil
+}
@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"context"
+	"sort"
+
+	collcodec "cosmossdk.io/collections/codec"
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// accountProtos maps the type URL of every concrete account type known to the
+// keeper to its prototypical constructor. It is shared by all copies of the
+// AccountKeeper.
+type accountProtos map[string]func() sdk.AccountI
+
+func (p accountProtos) register(proto func() sdk.AccountI) {
+	p[codectypes.MsgTypeURL(proto())] = proto
+}
+
+// WithAccountProtos registers additional concrete account types, such as
+// vesting or chain specific accounts, alongside the keeper's default account
+// type. Each constructor is keyed by the type URL of the account it returns.
+// The account types must also be registered in the interface registry.
+func WithAccountProtos(protos ...func() sdk.AccountI) AccountKeeperOption {
+	return func(ak *AccountKeeper) {
+		for _, proto := range protos {
+			ak.accountProtos.register(proto)
+		}
+	}
+}
+
+// AccountTypeURLs returns the sorted type URLs of the registered account types.
+func (ak AccountKeeper) AccountTypeURLs() []string {
+	typeURLs := make([]string, 0, len(ak.accountProtos))
+	for typeURL := range ak.accountProtos {
+		typeURLs = append(typeURLs, typeURL)
+	}
+	sort.Strings(typeURLs)
+	return typeURLs
+}
+
+// NewAccountWithTypeURL creates a new account of the registered type identified
+// by typeURL with the given address and the next account number. It does not
+// persist the account.
+func (ak AccountKeeper) NewAccountWithTypeURL(ctx context.Context, typeURL string, addr sdk.AccAddress) (sdk.AccountI, error) {
+	proto, ok := ak.accountProtos[typeURL]
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidType, "account type %s is not registered", typeURL)
+	}
+
+	acc := proto()
+	if err := acc.SetAddress(addr); err != nil {
+		return nil, err
+	}
+
+	return ak.NewAccount(ctx, acc), nil
+}
+
+// accountValueCodec decodes stored accounts using the constructor registered
+// for their type URL, falling back to the interface registry for account types
+// unknown to the keeper.
+type accountValueCodec struct {
+	collcodec.ValueCodec[sdk.AccountI]
+	cdc    codec.BinaryCodec
+	protos accountProtos
+}
+
+func newAccountValueCodec(cdc codec.BinaryCodec, protos accountProtos) accountValueCodec {
+	return accountValueCodec{
+		ValueCodec: codec.CollInterfaceValue[sdk.AccountI](cdc),
+		cdc:        cdc,
+		protos:     protos,
+	}
+}
+
+func (c accountValueCodec) Decode(b []byte) (sdk.AccountI, error) {
+	var accAny codectypes.Any
+	if err := accAny.Unmarshal(b); err != nil {
+		return nil, err
+	}
+
+	proto, ok := c.protos[accAny.TypeUrl]
+	if !ok {
+		return c.ValueCodec.Decode(b)
+	}
+
+	acc := proto()
+	if err := c.cdc.Unmarshal(accAny.Value, acc); err != nil {
+		return nil, err
+	}
+	return acc, nil
+}
@@ -0,0 +1,293 @@
+package keeper_test
+
+import (
+	"errors"
+	"math"
+
+	authcodec "cosmossdk.io/x/auth/codec"
+	"cosmossdk.io/x/auth/keeper"
+	"cosmossdk.io/x/auth/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func isModuleAccount(acc sdk.AccountI) bool {
+	_, ok := acc.(sdk.ModuleAccountI)
+	return ok
+}
+
+func (suite *KeeperTestSuite) TestGetAccountsPaginated() {
+	ctx := suite.ctx
+
+	// empty store
+	accs, pageRes, err := suite.accountKeeper.GetAccountsPaginated(ctx, &query.PageRequest{CountTotal: true}, nil)
+	suite.Require().NoError(err)
+	suite.Require().Empty(accs)
+	suite.Require().Nil(pageRes.NextKey)
+	suite.Require().Zero(pageRes.Total)
+
+	// interleave base accounts and module accounts
+	for i := 0; i < 10; i++ {
+		addr := sdk.AccAddress([]byte{byte(i), 0x01})
+		suite.accountKeeper.SetAccount(ctx, suite.accountKeeper.NewAccountWithAddress(ctx, addr))
+	}
+	moduleNames := []string{"fee_collector", "mint", "bonded_tokens_pool", "not_bonded_tokens_pool"}
+	for _, name := range moduleNames {
+		suite.accountKeeper.GetModuleAccount(ctx, name)
+	}
+
+	// nil filter returns every account
+	accs, pageRes, err = suite.accountKeeper.GetAccountsPaginated(ctx, &query.PageRequest{CountTotal: true}, nil)
+	suite.Require().NoError(err)
+	suite.Require().Len(accs, 14)
+	suite.Require().Equal(uint64(14), pageRes.Total)
+
+	// count total honours the filter
+	accs, pageRes, err = suite.accountKeeper.GetAccountsPaginated(ctx, &query.PageRequest{Limit: 2, CountTotal: true}, isModuleAccount)
+	suite.Require().NoError(err)
+	suite.Require().Len(accs, 2)
+	suite.Require().Equal(uint64(len(moduleNames)), pageRes.Total)
+	for _, acc := range accs {
+		suite.Require().True(isModuleAccount(acc))
+	}
+
+	// the next key is the raw store key of the next account
+	suite.Require().NotNil(pageRes.NextKey)
+	_, nextAddr, err := sdk.AccAddressKey.Decode(pageRes.NextKey)
+	suite.Require().NoError(err)
+	suite.Require().True(suite.accountKeeper.HasAccount(ctx, nextAddr))
+
+	// resuming from the next key yields the remaining module accounts
+	seen := map[string]bool{}
+	for _, acc := range accs {
+		seen[acc.GetAddress().String()] = true
+	}
+	rest, pageRes, err := suite.accountKeeper.GetAccountsPaginated(ctx, &query.PageRequest{Key: pageRes.NextKey, Limit: 10}, isModuleAccount)
+	suite.Require().NoError(err)
+	suite.Require().Len(rest, len(moduleNames)-2)
+	suite.Require().Nil(pageRes.NextKey)
+	for _, acc := range rest {
+		suite.Require().True(isModuleAccount(acc))
+		suite.Require().False(seen[acc.GetAddress().String()])
+	}
+
+	// reverse order returns the last account first
+	all, _, err := suite.accountKeeper.GetAccountsPaginated(ctx, nil, nil)
+	suite.Require().NoError(err)
+	reversed, _, err := suite.accountKeeper.GetAccountsPaginated(ctx, &query.PageRequest{Limit: 1, Reverse: true}, nil)
+	suite.Require().NoError(err)
+	suite.Require().Len(reversed, 1)
+	suite.Require().Equal(all[len(all)-1].GetAddress(), reversed[0].GetAddress())
+
+	// filtering to base accounts only
+	accs, _, err = suite.accountKeeper.GetAccountsPaginated(ctx, &query.PageRequest{Limit: 50}, func(acc sdk.AccountI) bool {
+		_, ok := acc.(*types.BaseAccount)
+		return ok
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(accs, 10)
+}
+
+func (suite *KeeperTestSuite) TestIterateAccountsPaginated() {
+	ctx := suite.ctx
+
+	for i := 0; i < 5; i++ {
+		addr := sdk.AccAddress([]byte{byte(i), 0x04})
+		suite.accountKeeper.SetAccount(ctx, suite.accountKeeper.NewAccountWithAddress(ctx, addr))
+	}
+
+	var visited []sdk.AccountI
+	pageRes, err := suite.accountKeeper.IterateAccountsPaginated(ctx, &query.PageRequest{Limit: 3, CountTotal: true}, func(acc sdk.AccountI) error {
+		visited = append(visited, acc)
+		return nil
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(visited, 3)
+	suite.Require().Equal(uint64(5), pageRes.Total)
+	suite.Require().NotNil(pageRes.NextKey)
+
+	pageRes, err = suite.accountKeeper.IterateAccountsPaginated(ctx, &query.PageRequest{Key: pageRes.NextKey}, func(acc sdk.AccountI) error {
+		visited = append(visited, acc)
+		return nil
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(visited, 5)
+	suite.Require().Nil(pageRes.NextKey)
+
+	// errors returned by the callback are propagated
+	_, err = suite.accountKeeper.IterateAccountsPaginated(ctx, nil, func(acc sdk.AccountI) error {
+		return errors.New("stop")
+	})
+	suite.Require().EqualError(err, "stop")
+}
+
+func (suite *KeeperTestSuite) TestGetAccountsCount() {
+	ctx := suite.ctx
+
+	count, err := suite.accountKeeper.GetAccountsCount(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Zero(count)
+
+	addr1 := sdk.AccAddress([]byte{0x01, 0x05})
+	addr2 := sdk.AccAddress([]byte{0x02, 0x05})
+	acc1 := suite.accountKeeper.NewAccountWithAddress(ctx, addr1)
+	suite.accountKeeper.SetAccount(ctx, acc1)
+	suite.accountKeeper.SetAccount(ctx, suite.accountKeeper.NewAccountWithAddress(ctx, addr2))
+
+	// updating an existing account does not change the count
+	suite.Require().NoError(acc1.SetSequence(1))
+	suite.accountKeeper.SetAccount(ctx, acc1)
+
+	count, err = suite.accountKeeper.GetAccountsCount(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), count)
+
+	suite.accountKeeper.RemoveAccount(ctx, acc1)
+	count, err = suite.accountKeeper.GetAccountsCount(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), count)
+
+	res, err := suite.queryClient.AccountsCount(ctx, &types.QueryAccountsCountRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), res.Count)
+}
+
+func (suite *KeeperTestSuite) TestNewAccountWithTypeURL() {
+	ctx := suite.ctx
+	moduleAccountProto := func() sdk.AccountI {
+		return &types.ModuleAccount{BaseAccount: &types.BaseAccount{}}
+	}
+	baseAccountURL := codectypes.MsgTypeURL(&types.BaseAccount{})
+	moduleAccountURL := codectypes.MsgTypeURL(&types.ModuleAccount{})
+
+	// only the default account type is registered
+	suite.Require().Equal([]string{baseAccountURL}, suite.accountKeeper.AccountTypeURLs())
+	_, err := suite.accountKeeper.NewAccountWithTypeURL(ctx, moduleAccountURL, sdk.AccAddress([]byte{0x01, 0x06}))
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidType)
+
+	ak := keeper.NewAccountKeeper(
+		suite.env,
+		suite.encCfg.Codec,
+		types.ProtoBaseAccount,
+		nil,
+		authcodec.NewBech32Codec("cosmos"),
+		"cosmos",
+		types.NewModuleAddress("gov").String(),
+		keeper.WithAccountProtos(moduleAccountProto),
+	)
+	suite.Require().Equal([]string{baseAccountURL, moduleAccountURL}, ak.AccountTypeURLs())
+
+	addr := sdk.AccAddress([]byte{0x02, 0x06})
+	acc, err := ak.NewAccountWithTypeURL(ctx, moduleAccountURL, addr)
+	suite.Require().NoError(err)
+	suite.Require().IsType(&types.ModuleAccount{}, acc)
+	suite.Require().Equal(addr, acc.GetAddress())
+	ak.SetAccount(ctx, acc)
+
+	// stored accounts are decoded with the constructor registered for their type
+	got := ak.GetAccount(ctx, addr)
+	suite.Require().IsType(&types.ModuleAccount{}, got)
+	suite.Require().Equal(acc.GetAccountNumber(), got.GetAccountNumber())
+
+	acc, err = ak.NewAccountWithTypeURL(ctx, baseAccountURL, sdk.AccAddress([]byte{0x03, 0x06}))
+	suite.Require().NoError(err)
+	suite.Require().IsType(&types.BaseAccount{}, acc)
+}
+
+func (suite *KeeperTestSuite) TestImportAccounts() {
+	ctx := suite.ctx
+	existing := suite.accountKeeper.NewAccountWithAddress(ctx, sdk.AccAddress([]byte{0x01, 0x07}))
+	suite.accountKeeper.SetAccount(ctx, existing)
+
+	newAccount := func(addr byte, accNum uint64) sdk.AccountI {
+		return types.NewBaseAccount(sdk.AccAddress([]byte{addr, 0x07}), nil, accNum, 0)
+	}
+
+	testCases := []struct {
+		name     string
+		accounts []sdk.AccountI
+		expErr   string
+	}{
+		{"duplicate address", []sdk.AccountI{newAccount(0x02, 10), newAccount(0x02, 11)}, "duplicate account"},
+		{"duplicate account number", []sdk.AccountI{newAccount(0x02, 10), newAccount(0x03, 10)}, "duplicate account number 10"},
+		{"existing address", []sdk.AccountI{newAccount(0x02, 10), newAccount(0x01, 11)}, "already exists"},
+		{"existing account number", []sdk.AccountI{newAccount(0x02, 10), newAccount(0x03, existing.GetAccountNumber())}, "is already in use"},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := suite.accountKeeper.ImportAccounts(ctx, tc.accounts)
+			suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+			suite.Require().ErrorContains(err, tc.expErr)
+			// nothing is written when the batch is invalid
+			suite.Require().False(suite.accountKeeper.HasAccount(ctx, tc.accounts[0].GetAddress()))
+		})
+	}
+
+	accounts := []sdk.AccountI{newAccount(0x02, 20), newAccount(0x03, 10)}
+	suite.Require().NoError(suite.accountKeeper.ImportAccounts(ctx, accounts))
+	for _, acc := range accounts {
+		suite.Require().Equal(acc.GetAccountNumber(), suite.accountKeeper.GetAccount(ctx, acc.GetAddress()).GetAccountNumber())
+	}
+	suite.Require().Equal(uint64(21), suite.accountKeeper.NextAccountNumber(ctx))
+
+	count, err := suite.accountKeeper.GetAccountsCount(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(3), count)
+
+	// account numbers below the counter are already handed out, even unused
+	err = suite.accountKeeper.ImportAccounts(ctx, []sdk.AccountI{newAccount(0x04, 15)})
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	suite.Require().ErrorContains(err, "account number 15 is already handed out")
+	suite.Require().Equal(uint64(22), suite.accountKeeper.NextAccountNumber(ctx))
+}
+
+func (suite *KeeperTestSuite) TestImportAccountsAfterReserveAccountNumbers() {
+	ctx := suite.ctx
+	newAccount := func(addr byte, accNum uint64) sdk.AccountI {
+		return types.NewBaseAccount(sdk.AccAddress([]byte{addr, 0x08}), nil, accNum, 0)
+	}
+
+	// the reserved account numbers cannot be imported before the accounts using
+	// them are created
+	start := suite.accountKeeper.ReserveAccountNumbers(ctx, 5)
+	for accNum := start; accNum < start+5; accNum++ {
+		err := suite.accountKeeper.ImportAccounts(ctx, []sdk.AccountI{newAccount(0x01, accNum)})
+		suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	}
+
+	// importing after the reservation moves the counter past the imported
+	// accounts, so that the next reservation does not overlap them
+	suite.Require().NoError(suite.accountKeeper.ImportAccounts(ctx, []sdk.AccountI{newAccount(0x01, start+5), newAccount(0x02, start+7)}))
+	next := suite.accountKeeper.ReserveAccountNumbers(ctx, 3)
+	suite.Require().Equal(start+8, next)
+
+	for i, accNum := range []uint64{start, start + 4, next} {
+		acc := newAccount(byte(0x10+i), accNum)
+		suite.accountKeeper.SetAccount(ctx, acc)
+		suite.Require().Equal(accNum, suite.accountKeeper.GetAccount(ctx, acc.GetAddress()).GetAccountNumber())
+	}
+	count, err := suite.accountKeeper.GetAccountsCount(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(5), count)
+}
+
+func (suite *KeeperTestSuite) TestReserveAccountNumbers() {
+	ctx := suite.ctx
+	next := suite.accountKeeper.NextAccountNumber(ctx)
+
+	start := suite.accountKeeper.ReserveAccountNumbers(ctx, 5)
+	suite.Require().Equal(next+1, start)
+	suite.Require().Equal(start+5, suite.accountKeeper.NextAccountNumber(ctx))
+
+	// reserving no account number leaves the counter untouched
+	start = suite.accountKeeper.ReserveAccountNumbers(ctx, 0)
+	suite.Require().Equal(next+7, start)
+	suite.Require().Equal(next+7, suite.accountKeeper.NextAccountNumber(ctx))
+
+	suite.Require().Panics(func() {
+		suite.accountKeeper.ReserveAccountNumbers(ctx, math.MaxUint64)
+	})
+}
@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/auth/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ collections.Index[sdk.AccAddress, sdk.AccountI] = accountsCounter{}
+
+// accountsCounter is an index of the accounts map that maintains the number of
+// accounts in state. It reuses the old value lookup shared by every index of
+// the map, so it only costs a read and a write when an account is created or
+// removed.
+type accountsCounter struct {
+	count collections.Item[uint64]
+}
+
+func newAccountsCounter(sb *collections.SchemaBuilder) accountsCounter {
+	return accountsCounter{
+		count: collections.NewItem(sb, types.AccountsCountKey, "accounts_count", collections.Uint64Value),
+	}
+}
+
+func (c accountsCounter) Reference(ctx context.Context, _ sdk.AccAddress, _ sdk.AccountI, lazyOldValue func() (sdk.AccountI, error)) error {
+	_, err := lazyOldValue()
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, collections.ErrNotFound):
+		return c.add(ctx, 1)
+	default:
+		return err
+	}
+}
+
+func (c accountsCounter) Unreference(ctx context.Context, _ sdk.AccAddress, lazyOldValue func() (sdk.AccountI, error)) error {
+	_, err := lazyOldValue()
+	if err != nil {
+		return err
+	}
+	return c.add(ctx, -1)
+}
+
+func (c accountsCounter) get(ctx context.Context) (uint64, error) {
+	count, err := c.count.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, nil
+	}
+	return count, err
+}
+
+func (c accountsCounter) add(ctx context.Context, delta int64) error {
+	count, err := c.get(ctx)
+	if err != nil {
+		return err
+	}
+	if delta < 0 && count < uint64(-delta) {
+		return errors.New("accounts count underflow")
+	}
+	return c.count.Set(ctx, uint64(int64(count)+delta))
+}
+
+// GetAccountsCount returns the number of accounts stored in state.
+func (ak AccountKeeper) GetAccountsCount(ctx context.Context) (uint64, error) {
+	return ak.Accounts.Indexes.count.get(ctx)
+}
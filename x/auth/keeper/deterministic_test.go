@@ -28,6 +28,10 @@ import (
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 )
 
+// accountNumberLanesStart is the first account number of the accounts generated
+// by createAndSetAccounts, above the account numbers of the regression tests.
+const accountNumberLanesStart = 1 << 32
+
 type DeterministicTestSuite struct {
 	suite.Suite
 
@@ -96,14 +100,15 @@ func (suite *DeterministicTestSuite) createAndSetAccounts(t *rapid.T, count int)
 	accs := make([]sdk.AccountI, 0, count)
 
 	// We need all generated account-numbers unique
-	accNums := rapid.SliceOfNDistinct(rapid.Uint64(), count, count, func(i uint64) uint64 {
+	accNums := rapid.SliceOfNDistinct(rapid.Uint64Range(0, 999), count, count, func(i uint64) uint64 {
 		return i
 	}).Draw(t, "acc-nums")
 
-	// then we change account numbers in such a way that there cannot be accounts with the same account number
+	// then we change account numbers in such a way that there cannot be accounts with the same account number,
+	// including the accounts of the regression tests
 	lane := atomic.AddUint64(&suite.accountNumberLanes, 1)
 	for i := range accNums {
-		accNums[i] += lane * 1000
+		accNums[i] += accountNumberLanesStart + lane*1000
 	}
 
 	for i := 0; i < count; i++ {
@@ -3,7 +3,9 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"sort"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/x/auth/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -36,6 +38,32 @@ func (ak AccountKeeper) InitGenesis(ctx context.Context, data types.GenesisState
 	}
 
 	ak.GetModuleAccount(ctx, types.FeeCollectorName)
+
+	if ak.initModuleAccounts {
+		return ak.initAllModuleAccounts(ctx)
+	}
+	return nil
+}
+
+// initAllModuleAccounts creates every module account declared in the module
+// account permissions, in lexicographic order of module names, and persists
+// the permissions of each of them.
+func (ak AccountKeeper) initAllModuleAccounts(ctx context.Context) error {
+	names := make([]string, 0, len(ak.permAddrs))
+	for name := range ak.permAddrs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		ak.GetModuleAccount(ctx, name)
+		for _, perm := range ak.permAddrs[name].GetPermissions() {
+			if err := ak.ModulePermissions.Set(ctx, collections.Join(name, perm)); err != nil {
+				return err
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -52,5 +80,18 @@ func (ak AccountKeeper) ExportGenesis(ctx context.Context) (*types.GenesisState,
 		genAccounts = append(genAccounts, genAcc)
 		return false, nil
 	})
-	return types.NewGenesisState(params, genAccounts), err
+	if err != nil {
+		return nil, err
+	}
+
+	genState := types.NewGenesisState(params, genAccounts)
+	err = ak.Accounts.Indexes.Number.Walk(ctx, nil, func(accNum uint64, addr sdk.AccAddress) (stop bool, err error) {
+		addrStr, err := ak.addressCodec.BytesToString(addr)
+		if err != nil {
+			return true, err
+		}
+		genState.AccountNumbers = append(genState.AccountNumbers, types.AccountNumberAddress{AccountNumber: accNum, Address: addrStr})
+		return false, nil
+	})
+	return genState, err
 }
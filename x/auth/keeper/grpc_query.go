@@ -9,6 +9,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/x/auth/types"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -53,18 +54,33 @@ func (s queryServer) Accounts(ctx context.Context, req *types.QueryAccountsReque
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
-	accounts, pageRes, err := query.CollectionPaginate(
-		ctx,
-		s.k.Accounts,
-		req.Pagination,
-		func(_ sdk.AccAddress, value sdk.AccountI) (*codectypes.Any, error) {
-			return codectypes.NewAnyWithValue(value)
-		},
-	)
+	var accounts []*codectypes.Any
+	pageRes, err := s.k.IterateAccountsPaginated(ctx, req.Pagination, func(acc sdk.AccountI) error {
+		accAny, err := codectypes.NewAnyWithValue(acc)
+		if err != nil {
+			return err
+		}
+		accounts = append(accounts, accAny)
+		return nil
+	})
 
 	return &types.QueryAccountsResponse{Accounts: accounts, Pagination: pageRes}, err
 }
 
+// AccountsCount returns the number of accounts stored in state.
+func (s queryServer) AccountsCount(ctx context.Context, req *types.QueryAccountsCountRequest) (*types.QueryAccountsCountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	count, err := s.k.GetAccountsCount(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAccountsCountResponse{Count: count}, nil
+}
+
 // Account returns account details based on address
 func (s queryServer) Account(ctx context.Context, req *types.QueryAccountRequest) (*types.QueryAccountResponse, error) {
 	if req == nil {
@@ -116,6 +132,7 @@ func (s queryServer) ModuleAccounts(ctx context.Context, req *types.QueryModuleA
 	sort.Strings(sortedPermAddrs)
 
 	modAccounts := make([]*codectypes.Any, 0, len(s.k.permAddrs))
+	modAccountInfos := make([]types.ModuleAccountInfo, 0, len(s.k.permAddrs))
 
 	for _, moduleName := range sortedPermAddrs {
 		account := s.k.GetModuleAccount(ctx, moduleName)
@@ -127,9 +144,19 @@ func (s queryServer) ModuleAccounts(ctx context.Context, req *types.QueryModuleA
 			return nil, status.Errorf(codes.Internal, err.Error())
 		}
 		modAccounts = append(modAccounts, any)
+
+		addr, err := s.k.addressCodec.BytesToString(account.GetAddress())
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, err.Error())
+		}
+		modAccountInfos = append(modAccountInfos, types.ModuleAccountInfo{
+			Name:        account.GetName(),
+			Address:     addr,
+			Permissions: account.GetPermissions(),
+		})
 	}
 
-	return &types.QueryModuleAccountsResponse{Accounts: modAccounts}, nil
+	return &types.QueryModuleAccountsResponse{Accounts: modAccounts, ModuleAccounts: modAccountInfos}, nil
 }
 
 // ModuleAccountByName returns module account by module name
@@ -247,3 +274,52 @@ func (s queryServer) AccountInfo(ctx context.Context, req *types.QueryAccountInf
 		},
 	}, nil
 }
+
+// AccountsByNumberRange returns the account number to address index restricted to a range of account numbers.
+func (s queryServer) AccountsByNumberRange(ctx context.Context, req *types.QueryAccountsByNumberRangeRequest) (*types.QueryAccountsByNumberRangeResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.End != 0 && req.End <= req.Start {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid account number range [%d, %d)", req.Start, req.End)
+	}
+
+	accounts, pageRes, err := s.k.GetAccountNumbersPaginated(ctx, req.Start, req.End, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryAccountsByNumberRangeResponse{Accounts: accounts, Pagination: pageRes}, nil
+}
+
+// PubKeyHistory returns the public keys an account rotated away from, oldest first.
+func (s queryServer) PubKeyHistory(ctx context.Context, req *types.QueryPubKeyHistoryRequest) (*types.QueryPubKeyHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "address cannot be empty")
+	}
+
+	addr, err := s.k.addressCodec.StringToBytes(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	rotations, pageRes, err := query.CollectionPaginate(
+		ctx,
+		s.k.PubKeyHistory,
+		req.Pagination,
+		func(_ collections.Pair[sdk.AccAddress, uint64], value types.PubKeyRotation) (types.PubKeyRotation, error) {
+			return value, nil
+		},
+		query.WithCollectionPaginationPairPrefix[sdk.AccAddress, uint64](addr),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPubKeyHistoryResponse{Rotations: rotations, Pagination: pageRes}, nil
+}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 const addrStr = "cosmos13c3d4wq2t22dl0dstraf8jc3f902e3fsy9n3wv"
@@ -223,6 +224,62 @@ func (suite *KeeperTestSuite) TestGRPCQueryAccountAddressByID() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGRPCQueryAccountsByNumberRange() {
+	var addrs []sdk.AccAddress
+	for i := 0; i < 5; i++ {
+		addr := sdk.AccAddress([]byte{byte(i), 0x04})
+		suite.accountKeeper.SetAccount(suite.ctx, suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr))
+		addrs = append(addrs, addr)
+	}
+
+	_, err := suite.queryClient.AccountsByNumberRange(suite.ctx, &types.QueryAccountsByNumberRangeRequest{Start: 3, End: 3})
+	suite.Require().Error(err)
+
+	res, err := suite.queryClient.AccountsByNumberRange(suite.ctx, &types.QueryAccountsByNumberRangeRequest{Start: 1, End: 4})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Accounts, 3)
+	suite.Require().Equal(uint64(3), res.Pagination.Total)
+	for i, entry := range res.Accounts {
+		suite.Require().Equal(uint64(i+1), entry.AccountNumber)
+		suite.Require().Equal(addrs[i+1].String(), entry.Address)
+	}
+
+	// resume from the next key
+	res, err = suite.queryClient.AccountsByNumberRange(suite.ctx, &types.QueryAccountsByNumberRangeRequest{
+		Start:      1,
+		Pagination: &query.PageRequest{Limit: 2},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Accounts, 2)
+	suite.Require().NotNil(res.Pagination.NextKey)
+
+	res, err = suite.queryClient.AccountsByNumberRange(suite.ctx, &types.QueryAccountsByNumberRangeRequest{
+		Start:      1,
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Accounts, 2)
+	suite.Require().Equal(uint64(3), res.Accounts[0].AccountNumber)
+	suite.Require().Equal(uint64(4), res.Accounts[1].AccountNumber)
+	suite.Require().Nil(res.Pagination.NextKey)
+
+	// reverse order
+	res, err = suite.queryClient.AccountsByNumberRange(suite.ctx, &types.QueryAccountsByNumberRangeRequest{
+		End:        4,
+		Pagination: &query.PageRequest{Limit: 1, Reverse: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Accounts, 1)
+	suite.Require().Equal(uint64(3), res.Accounts[0].AccountNumber)
+
+	// the index is exported in genesis
+	suite.Require().NoError(suite.accountKeeper.Params.Set(suite.ctx, types.DefaultParams()))
+	genState, err := suite.accountKeeper.ExportGenesis(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Len(genState.AccountNumbers, 5)
+	suite.Require().NoError(types.ValidateGenesis(*genState))
+}
+
 func (suite *KeeperTestSuite) TestGRPCQueryParams() {
 	var (
 		req       *types.QueryParamsRequest
@@ -341,6 +398,15 @@ func (suite *KeeperTestSuite) TestGRPCQueryModuleAccounts() {
 					moduleNames = append(moduleNames, moduleAccount.GetName())
 				}
 				suite.Require().True(sort.StringsAreSorted(moduleNames))
+
+				// the module account infos match the module accounts
+				suite.Require().Len(res.ModuleAccounts, len(res.Accounts))
+				for i, info := range res.ModuleAccounts {
+					suite.Require().Equal(moduleNames[i], info.Name)
+					moduleAccount := suite.accountKeeper.GetModuleAccount(suite.ctx, info.Name)
+					suite.Require().Equal(moduleAccount.GetAddress().String(), info.Address)
+					suite.Require().Equal(moduleAccount.GetPermissions(), info.Permissions)
+				}
 			} else {
 				suite.Require().Error(err)
 				suite.Require().Nil(res)
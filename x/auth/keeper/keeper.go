@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
 	"cosmossdk.io/collections/indexes"
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
@@ -17,6 +18,7 @@ import (
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 // AccountKeeperI is the interface contract that x/auth's keeper implements.
@@ -39,6 +41,9 @@ type AccountKeeperI interface {
 	// Remove an account from the store.
 	RemoveAccount(context.Context, sdk.AccountI)
 
+	// Retrieve a page of accounts from the store, keeping only those matching the filter.
+	GetAccountsPaginated(context.Context, *query.PageRequest, func(sdk.AccountI) bool) ([]sdk.AccountI, *query.PageResponse, error)
+
 	// Fetch the public key of an account at a specified address
 	GetPubKey(context.Context, sdk.AccAddress) (cryptotypes.PubKey, error)
 
@@ -48,6 +53,9 @@ type AccountKeeperI interface {
 	// Fetch the next account number, and increment the internal counter.
 	NextAccountNumber(context.Context) uint64
 
+	// Reserve a contiguous block of account numbers, returning the first one.
+	ReserveAccountNumbers(context.Context, uint64) uint64
+
 	// GetModulePermissions fetches per-module account permissions
 	GetModulePermissions() map[string]types.PermissionsForAddress
 
@@ -63,17 +71,22 @@ func NewAccountIndexes(sb *collections.SchemaBuilder) AccountsIndexes {
 				return v.GetAccountNumber(), nil
 			},
 		),
+		count: newAccountsCounter(sb),
 	}
 }
 
 type AccountsIndexes struct {
 	// Number is a unique index that indexes accounts by their account number.
 	Number *indexes.Unique[uint64, sdk.AccAddress, sdk.AccountI]
+
+	// count keeps track of the number of accounts.
+	count accountsCounter
 }
 
 func (a AccountsIndexes) IndexesList() []collections.Index[sdk.AccAddress, sdk.AccountI] {
 	return []collections.Index[sdk.AccAddress, sdk.AccountI]{
 		a.Number,
+		a.count,
 	}
 }
 
@@ -90,20 +103,51 @@ type AccountKeeper struct {
 	// The prototypical AccountI constructor.
 	proto func() sdk.AccountI
 
+	// accountProtos holds the constructors of every registered account type,
+	// keyed by type URL.
+	accountProtos accountProtos
+
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
 	authority string
 
+	// pruning holds the balance keeper consulted before an account is pruned.
+	pruning *accountPruning
+
+	// initModuleAccounts makes InitGenesis create every module account declared
+	// in permAddrs and persist their permissions.
+	initModuleAccounts bool
+
 	// State
 	Schema        collections.Schema
 	Params        collections.Item[types.Params]
 	AccountNumber collections.Sequence
+	// PruningCursor is the account number from which the next end block pruning pass resumes.
+	PruningCursor collections.Item[uint64]
 	// Accounts key: AccAddr | value: AccountI | index: AccountsIndex
 	Accounts *collections.IndexedMap[sdk.AccAddress, sdk.AccountI, AccountsIndexes]
+	// PubKeyHistory key: AccAddr+RotationIndex | value: PubKeyRotation
+	PubKeyHistory collections.Map[collections.Pair[sdk.AccAddress, uint64], types.PubKeyRotation]
+	// ModulePermissions key: ModuleName+Permission
+	ModulePermissions collections.KeySet[collections.Pair[string, string]]
+	// TxRateLimits key: AccountNumber | value: RateLimitWindowIndex+TxCount
+	TxRateLimits collections.Map[uint64, collections.Pair[uint64, uint64]]
 }
 
 var _ AccountKeeperI = &AccountKeeper{}
 
+// AccountKeeperOption configures optional AccountKeeper behaviour.
+type AccountKeeperOption func(*AccountKeeper)
+
+// WithInitModuleAccounts makes InitGenesis eagerly create all the module accounts
+// declared in the module account permissions, rather than lazily on first access,
+// and persist their permissions in state.
+func WithInitModuleAccounts() AccountKeeperOption {
+	return func(ak *AccountKeeper) {
+		ak.initModuleAccounts = true
+	}
+}
+
 // NewAccountKeeper returns a new AccountKeeperI that uses go-amino to
 // (binary) encode and decode concrete sdk.Accounts.
 // `maccPerms` is a map that takes accounts' addresses as keys, and their respective permissions as values. This map is used to construct
@@ -113,31 +157,45 @@ var _ AccountKeeperI = &AccountKeeper{}
 func NewAccountKeeper(
 	env appmodule.Environment, cdc codec.BinaryCodec, proto func() sdk.AccountI,
 	maccPerms map[string][]string, ac address.Codec, bech32Prefix, authority string,
+	opts ...AccountKeeperOption,
 ) AccountKeeper {
 	permAddrs := make(map[string]types.PermissionsForAddress)
 	for name, perms := range maccPerms {
 		permAddrs[name] = types.NewPermissionsForAddress(name, perms)
 	}
 
+	protos := accountProtos{}
+	protos.register(proto)
+
 	sb := collections.NewSchemaBuilder(env.KVStoreService)
 
 	ak := AccountKeeper{
-		addressCodec:  ac,
-		bech32Prefix:  bech32Prefix,
-		environment:   env,
-		proto:         proto,
-		cdc:           cdc,
-		permAddrs:     permAddrs,
-		authority:     authority,
-		Params:        collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
-		AccountNumber: collections.NewSequence(sb, types.GlobalAccountNumberKey, "account_number"),
-		Accounts:      collections.NewIndexedMap(sb, types.AddressStoreKeyPrefix, "accounts", sdk.AccAddressKey, codec.CollInterfaceValue[sdk.AccountI](cdc), NewAccountIndexes(sb)),
+		addressCodec:      ac,
+		bech32Prefix:      bech32Prefix,
+		environment:       env,
+		proto:             proto,
+		accountProtos:     protos,
+		cdc:               cdc,
+		permAddrs:         permAddrs,
+		authority:         authority,
+		pruning:           &accountPruning{},
+		Params:            collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		AccountNumber:     collections.NewSequence(sb, types.GlobalAccountNumberKey, "account_number"),
+		PruningCursor:     collections.NewItem(sb, types.AccountPruningCursorKey, "pruning_cursor", collections.Uint64Value),
+		Accounts:          collections.NewIndexedMap(sb, types.AddressStoreKeyPrefix, "accounts", sdk.AccAddressKey, newAccountValueCodec(cdc, protos), NewAccountIndexes(sb)),
+		PubKeyHistory:     collections.NewMap(sb, types.PubKeyHistoryPrefix, "pub_key_history", collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key), codec.CollValue[types.PubKeyRotation](cdc)),
+		ModulePermissions: collections.NewKeySet(sb, types.ModulePermissionsPrefix, "module_permissions", collections.PairKeyCodec(collections.StringKey, collections.StringKey)),
+		TxRateLimits:      collections.NewMap(sb, types.TxRateLimitPrefix, "tx_rate_limits", collections.Uint64Key, collcodec.KeyToValueCodec(collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key))),
 	}
 	schema, err := sb.Build()
 	if err != nil {
 		panic(err)
 	}
 	ak.Schema = schema
+
+	for _, opt := range opts {
+		opt(&ak)
+	}
 	return ak
 }
 
@@ -187,6 +245,24 @@ func (ak AccountKeeper) NextAccountNumber(ctx context.Context) uint64 {
 	return n
 }
 
+// ReserveAccountNumbers reserves the n account numbers following the global
+// account number counter and returns the first of them, so that a module
+// creating many accounts at once can assign them [start, start+n) without
+// interleaving with the account numbers handed out to other accounts.
+func (ak AccountKeeper) ReserveAccountNumbers(ctx context.Context, n uint64) (start uint64) {
+	start, err := ak.AccountNumber.Peek(ctx)
+	if err != nil {
+		panic(err)
+	}
+	if start+n < start {
+		panic(fmt.Errorf("cannot reserve %d account numbers from %d: overflow", n, start))
+	}
+	if err := ak.AccountNumber.Set(ctx, start+n); err != nil {
+		panic(err)
+	}
+	return start
+}
+
 // GetModulePermissions fetches per-module account permissions.
 func (ak AccountKeeper) GetModulePermissions() map[string]types.PermissionsForAddress {
 	return ak.permAddrs
@@ -205,6 +281,34 @@ func (ak AccountKeeper) ValidatePermissions(macc sdk.ModuleAccountI) error {
 	return nil
 }
 
+// ValidateStoredPermissions validates that the module account has been granted
+// permissions within the set of allowed permissions persisted in state.
+// Permissions are only persisted when the keeper is built WithInitModuleAccounts.
+func (ak AccountKeeper) ValidateStoredPermissions(ctx context.Context, macc sdk.ModuleAccountI) error {
+	rng := collections.NewPrefixedPairRange[string, string](macc.GetName())
+	stored, err := ak.ModulePermissions.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+	keys, err := stored.Keys()
+	if err != nil {
+		return err
+	}
+
+	allowed := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		allowed[key.K2()] = true
+	}
+
+	for _, perm := range macc.GetPermissions() {
+		if !allowed[perm] {
+			return fmt.Errorf("invalid module permission %s", perm)
+		}
+	}
+
+	return nil
+}
+
 // GetModuleAddress returns an address based on the module name
 func (ak AccountKeeper) GetModuleAddress(moduleName string) sdk.AccAddress {
 	permAddr, ok := ak.permAddrs[moduleName]
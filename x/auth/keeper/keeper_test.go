@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/header"
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
@@ -39,6 +40,7 @@ type KeeperTestSuite struct {
 	suite.Suite
 
 	ctx sdk.Context
+	env appmodule.Environment
 
 	queryClient   types.QueryClient
 	accountKeeper keeper.AccountKeeper
@@ -52,6 +54,7 @@ func (suite *KeeperTestSuite) SetupTest() {
 	key := storetypes.NewKVStoreKey(types.StoreKey)
 	storeService := runtime.NewKVStoreService(key)
 	env := runtime.NewEnvironment(storeService, log.NewNopLogger())
+	suite.env = env
 	testCtx := testutil.DefaultContextWithDB(suite.T(), key, storetypes.NewTransientStoreKey("transient_test"))
 	suite.ctx = testCtx.Ctx.WithHeaderInfo(header.Info{})
 
@@ -121,6 +124,22 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	suite.Require().Equal(genState.Params.SigVerifyCostED25519, params.SigVerifyCostED25519, "SigVerifyCostED25519")
 	suite.Require().Equal(genState.Params.SigVerifyCostSecp256k1, params.SigVerifyCostSecp256k1, "SigVerifyCostSecp256k1")
 
+	maxMemoCharacters, err := suite.accountKeeper.MaxMemoCharacters(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(genState.Params.MaxMemoCharacters, maxMemoCharacters)
+	txSigLimit, err := suite.accountKeeper.TxSigLimit(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(genState.Params.TxSigLimit, txSigLimit)
+	txSizeCostPerByte, err := suite.accountKeeper.TxSizeCostPerByte(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(genState.Params.TxSizeCostPerByte, txSizeCostPerByte)
+	sigVerifyCostED25519, err := suite.accountKeeper.SigVerifyCostED25519(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(genState.Params.SigVerifyCostED25519, sigVerifyCostED25519)
+	sigVerifyCostSecp256k1, err := suite.accountKeeper.SigVerifyCostSecp256k1(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(genState.Params.SigVerifyCostSecp256k1, sigVerifyCostSecp256k1)
+
 	suite.SetupTest() // reset
 	ctx = suite.ctx
 	// Fix duplicate account numbers
@@ -232,3 +251,41 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	// we expect nextNum to be 2 because we initialize fee_collector as account number 1
 	suite.Require().Equal(2, int(nextNum))
 }
+
+func (suite *KeeperTestSuite) TestInitGenesisWithInitModuleAccounts() {
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	env := runtime.NewEnvironment(runtime.NewKVStoreService(key), log.NewNopLogger())
+	ctx := testutil.DefaultContextWithDB(suite.T(), key, storetypes.NewTransientStoreKey("transient_test")).Ctx.WithHeaderInfo(header.Info{})
+
+	maccPerms := map[string][]string{
+		"fee_collector": nil,
+		"mint":          {"minter"},
+		multiPerm:       {"burner", "minter", "staking"},
+		randomPerm:      {"random"},
+	}
+
+	ak := keeper.NewAccountKeeper(
+		env,
+		suite.encCfg.Codec,
+		types.ProtoBaseAccount,
+		maccPerms,
+		authcodec.NewBech32Codec("cosmos"),
+		"cosmos",
+		types.NewModuleAddress("gov").String(),
+		keeper.WithInitModuleAccounts(),
+	)
+
+	// nothing is persisted before genesis
+	suite.Require().Error(ak.ValidateStoredPermissions(ctx, multiPermAcc))
+
+	suite.Require().NoError(ak.InitGenesis(ctx, *types.DefaultGenesisState()))
+
+	for name := range maccPerms {
+		suite.Require().True(ak.HasAccount(ctx, types.NewModuleAddress(name)), name)
+	}
+
+	suite.Require().NoError(ak.ValidateStoredPermissions(ctx, multiPermAcc))
+	suite.Require().NoError(ak.ValidateStoredPermissions(ctx, randomPermAcc))
+	suite.Require().Error(ak.ValidateStoredPermissions(ctx, types.NewEmptyModuleAccount("other", "other")))
+	suite.Require().Error(ak.ValidateStoredPermissions(ctx, types.NewEmptyModuleAccount("mint", "burner")))
+}
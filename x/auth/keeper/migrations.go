@@ -4,6 +4,7 @@ import (
 	"context"
 
 	v5 "cosmossdk.io/x/auth/migrations/v5"
+	v6 "cosmossdk.io/x/auth/migrations/v6"
 	"cosmossdk.io/x/auth/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -66,3 +67,9 @@ func (m Migrator) V45SetAccount(ctx context.Context, acc sdk.AccountI) error {
 func addressStoreKey(addr sdk.AccAddress) []byte {
 	return append(types.AddressStoreKeyPrefix, addr.Bytes()...)
 }
+
+// Migrate5To6 migrates the x/auth module state from the consensus version 5 to 6.
+// It initializes the accounts count with the number of accounts in state.
+func (m Migrator) Migrate5To6(ctx context.Context) error {
+	return v6.Migrate(ctx, m.keeper.environment.KVStoreService, m.keeper.Accounts.Indexes.count.count)
+}
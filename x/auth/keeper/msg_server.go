@@ -4,7 +4,11 @@ import (
 	"context"
 	"fmt"
 
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/x/auth/types"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 var _ types.MsgServer = msgServer{}
@@ -37,3 +41,25 @@ func (ms msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+func (ms msgServer) RotatePubKey(ctx context.Context, msg *types.MsgRotatePubKey) (*types.MsgRotatePubKeyResponse, error) {
+	signer, err := ms.ak.addressCodec.StringToBytes(msg.Signer)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid signer address: %s", err)
+	}
+
+	if msg.NewPubKey == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "new public key cannot be empty")
+	}
+
+	newPubKey, ok := msg.NewPubKey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidType, "expected %T, got %T", (cryptotypes.PubKey)(nil), msg.NewPubKey.GetCachedValue())
+	}
+
+	if err := ms.ak.RotatePubKey(ctx, signer, newPubKey); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRotatePubKeyResponse{}, nil
+}
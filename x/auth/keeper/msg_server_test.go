@@ -2,6 +2,13 @@ package keeper_test
 
 import (
 	"cosmossdk.io/x/auth/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 func (s *KeeperTestSuite) TestUpdateParams() {
@@ -109,3 +116,69 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestRotatePubKey() {
+	oldPubKey := secp256k1.GenPrivKey().PubKey()
+	newPubKey := secp256k1.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(oldPubKey.Address())
+
+	acc := s.accountKeeper.NewAccountWithAddress(s.ctx, addr)
+	s.Require().NoError(acc.SetSequence(3))
+	s.accountKeeper.SetAccount(s.ctx, acc)
+
+	// an account without a public key cannot rotate it
+	msg, err := types.NewMsgRotatePubKey(addr.String(), newPubKey)
+	s.Require().NoError(err)
+	_, err = s.msgServer.RotatePubKey(s.ctx, msg)
+	s.Require().ErrorContains(err, "has no public key to rotate")
+
+	s.Require().NoError(acc.SetPubKey(oldPubKey))
+	s.accountKeeper.SetAccount(s.ctx, acc)
+
+	// unknown account
+	unknown, err := types.NewMsgRotatePubKey(sdk.AccAddress(newPubKey.Address()).String(), newPubKey)
+	s.Require().NoError(err)
+	_, err = s.msgServer.RotatePubKey(s.ctx, unknown)
+	s.Require().ErrorContains(err, "does not exist")
+
+	// rotating to the same key
+	same, err := types.NewMsgRotatePubKey(addr.String(), oldPubKey)
+	s.Require().NoError(err)
+	_, err = s.msgServer.RotatePubKey(s.ctx, same)
+	s.Require().ErrorContains(err, "identical")
+
+	// multisig and unsupported key types are rejected
+	multisigPubKey := kmultisig.NewLegacyAminoPubKey(1, []cryptotypes.PubKey{newPubKey, secp256k1.GenPrivKey().PubKey()})
+	for _, pubKey := range []cryptotypes.PubKey{multisigPubKey, ed25519.GenPrivKey().PubKey()} {
+		unsupported, err := types.NewMsgRotatePubKey(addr.String(), pubKey)
+		s.Require().NoError(err)
+		_, err = s.msgServer.RotatePubKey(s.ctx, unsupported)
+		s.Require().ErrorContains(err, "unsupported key type")
+	}
+
+	_, err = s.msgServer.RotatePubKey(s.ctx, msg)
+	s.Require().NoError(err)
+
+	rotated := s.accountKeeper.GetAccount(s.ctx, addr)
+	s.Require().Equal(newPubKey, rotated.GetPubKey())
+	s.Require().Equal(acc.GetAccountNumber(), rotated.GetAccountNumber())
+	s.Require().Equal(uint64(3), rotated.GetSequence())
+
+	// rotate a second time and check the history
+	thirdPubKey := secp256k1.GenPrivKey().PubKey()
+	s.Require().NoError(s.accountKeeper.RotatePubKey(s.ctx, addr, thirdPubKey))
+
+	res, err := s.queryClient.PubKeyHistory(s.ctx, &types.QueryPubKeyHistoryRequest{Address: addr.String()})
+	s.Require().NoError(err)
+	s.Require().Len(res.Rotations, 2)
+	s.Require().Equal(oldPubKey, res.Rotations[0].GetReplacedPubKey())
+	s.Require().Equal(newPubKey, res.Rotations[1].GetReplacedPubKey())
+
+	res, err = s.queryClient.PubKeyHistory(s.ctx, &types.QueryPubKeyHistoryRequest{
+		Address:    addr.String(),
+		Pagination: &query.PageRequest{Limit: 1, Reverse: true},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.Rotations, 1)
+	s.Require().Equal(newPubKey, res.Rotations[0].GetReplacedPubKey())
+}
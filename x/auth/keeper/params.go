@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"context"
+)
+
+// MaxMemoCharacters - Maximum number of characters in a tx memo
+func (ak AccountKeeper) MaxMemoCharacters(ctx context.Context) (uint64, error) {
+	params, err := ak.Params.Get(ctx)
+	return params.MaxMemoCharacters, err
+}
+
+// TxSigLimit - Maximum number of signatures in a tx
+func (ak AccountKeeper) TxSigLimit(ctx context.Context) (uint64, error) {
+	params, err := ak.Params.Get(ctx)
+	return params.TxSigLimit, err
+}
+
+// TxSizeCostPerByte - Gas consumed per byte of tx size
+func (ak AccountKeeper) TxSizeCostPerByte(ctx context.Context) (uint64, error) {
+	params, err := ak.Params.Get(ctx)
+	return params.TxSizeCostPerByte, err
+}
+
+// SigVerifyCostED25519 - Gas consumed to verify an ED25519 signature
+func (ak AccountKeeper) SigVerifyCostED25519(ctx context.Context) (uint64, error) {
+	params, err := ak.Params.Get(ctx)
+	return params.SigVerifyCostED25519, err
+}
+
+// SigVerifyCostSecp256k1 - Gas consumed to verify a Secp256k1 signature
+func (ak AccountKeeper) SigVerifyCostSecp256k1(ctx context.Context) (uint64, error) {
+	params, err := ak.Params.Get(ctx)
+	return params.SigVerifyCostSecp256k1, err
+}
+
+// MaxTxsPerWindow - Maximum number of txs an account can send per rate limit window
+func (ak AccountKeeper) MaxTxsPerWindow(ctx context.Context) (uint64, error) {
+	params, err := ak.Params.Get(ctx)
+	return params.MaxTxsPerWindow, err
+}
+
+// RateLimitWindow - Number of blocks of a rate limit window
+func (ak AccountKeeper) RateLimitWindow(ctx context.Context) (uint64, error) {
+	params, err := ak.Params.Get(ctx)
+	return params.RateLimitWindow, err
+}
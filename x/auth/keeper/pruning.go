@@ -0,0 +1,139 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/auth/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// accountPruningBatchSize is the maximum number of accounts inspected by a
+// single end block pruning pass.
+const accountPruningBatchSize = 100
+
+// accountPruning houses the balance keeper used to make sure an account is
+// empty before it is pruned. It is shared by all copies of the AccountKeeper.
+type accountPruning struct {
+	balanceKeeper types.BalanceKeeper
+}
+
+// SetBalanceKeeper sets the balance keeper consulted by account pruning.
+// Pruning is a no-op until a balance keeper is set.
+func (ak AccountKeeper) SetBalanceKeeper(bk types.BalanceKeeper) {
+	ak.pruning.balanceKeeper = bk
+}
+
+// PruneEmptyAccounts removes every empty base account with an account number
+// lower than olderThan and returns the number of accounts removed.
+// An account is empty when it has a zero sequence, no public key and no balances.
+// Module accounts and vesting accounts are never pruned.
+func (ak AccountKeeper) PruneEmptyAccounts(ctx context.Context, olderThan uint64) (uint64, error) {
+	if ak.pruning.balanceKeeper == nil {
+		return 0, errors.New("account pruning requires a balance keeper")
+	}
+
+	_, pruned, err := ak.pruneEmptyAccounts(ctx, 0, olderThan, 0)
+	return pruned, err
+}
+
+// EndBlocker clears the transaction counters of the rate limit window ending
+// with the current block, and prunes empty accounts when enabled by the module
+// parameters. Each call inspects at most accountPruningBatchSize accounts,
+// resuming from where the previous call stopped and wrapping around once every
+// account has been inspected.
+func (ak AccountKeeper) EndBlocker(ctx context.Context) error {
+	params := ak.GetParams(ctx)
+	if err := ak.clearExpiredTxCounts(ctx, params); err != nil {
+		return err
+	}
+
+	if ak.pruning.balanceKeeper == nil || !params.EnableAccountPruning {
+		return nil
+	}
+
+	start, err := ak.PruningCursor.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	end, err := ak.AccountNumber.Peek(ctx)
+	if err != nil {
+		return err
+	}
+
+	next, _, err := ak.pruneEmptyAccounts(ctx, start, end, accountPruningBatchSize)
+	if err != nil {
+		return err
+	}
+	if next >= end {
+		next = 0
+	}
+
+	return ak.PruningCursor.Set(ctx, next)
+}
+
+// pruneEmptyAccounts inspects accounts by ascending account number in the range
+// [start, end), stopping after limit accounts when limit is non-zero. It returns
+// the account number from which a subsequent call should resume and the number
+// of accounts removed.
+func (ak AccountKeeper) pruneEmptyAccounts(ctx context.Context, start, end, limit uint64) (next, pruned uint64, err error) {
+	if start >= end {
+		return end, 0, nil
+	}
+
+	var (
+		inspected uint64
+		prunable  []sdk.AccountI
+	)
+	next = end
+	rng := new(collections.Range[uint64]).StartInclusive(start).EndExclusive(end)
+	err = ak.Accounts.Indexes.Number.Walk(ctx, rng, func(accNum uint64, addr sdk.AccAddress) (bool, error) {
+		if limit != 0 && inspected == limit {
+			next = accNum
+			return true, nil
+		}
+		inspected++
+
+		acc, err := ak.Accounts.Get(ctx, addr)
+		if err != nil {
+			return true, err
+		}
+		if ak.isPrunable(ctx, acc) {
+			prunable = append(prunable, acc)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// accounts are removed once the iteration is over, as the store must not be
+	// written to while an iterator is open on it. The transaction counter of an
+	// account goes along with it, as it is keyed by its account number.
+	for _, acc := range prunable {
+		if err := ak.Accounts.Remove(ctx, acc.GetAddress()); err != nil {
+			return 0, 0, err
+		}
+		if err := ak.TxRateLimits.Remove(ctx, acc.GetAccountNumber()); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	return next, uint64(len(prunable)), nil
+}
+
+// isPrunable reports whether an account can be safely removed from state.
+func (ak AccountKeeper) isPrunable(ctx context.Context, acc sdk.AccountI) bool {
+	if _, ok := acc.(*types.BaseAccount); !ok {
+		return false
+	}
+
+	if acc.GetSequence() != 0 || acc.GetPubKey() != nil {
+		return false
+	}
+
+	return ak.pruning.balanceKeeper.GetAllBalances(ctx, acc.GetAddress()).IsZero()
+}
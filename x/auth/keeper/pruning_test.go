@@ -0,0 +1,93 @@
+package keeper_test
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/auth/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type mockBalanceKeeper map[string]sdk.Coins
+
+func (m mockBalanceKeeper) GetAllBalances(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	return m[addr.String()]
+}
+
+func (suite *KeeperTestSuite) TestPruneEmptyAccounts() {
+	ctx := suite.ctx
+
+	// pruning without a balance keeper fails
+	_, err := suite.accountKeeper.PruneEmptyAccounts(ctx, 100)
+	suite.Require().Error(err)
+
+	balances := mockBalanceKeeper{}
+	suite.accountKeeper.SetBalanceKeeper(balances)
+
+	newAccount := func(i byte) sdk.AccountI {
+		acc := suite.accountKeeper.NewAccountWithAddress(ctx, sdk.AccAddress([]byte{i, 0x02}))
+		suite.accountKeeper.SetAccount(ctx, acc)
+		return acc
+	}
+
+	empty := newAccount(1)
+	funded := newAccount(2)
+	balances[funded.GetAddress().String()] = sdk.NewCoins(sdk.NewInt64Coin("stake", 1))
+	withSequence := newAccount(3)
+	suite.Require().NoError(withSequence.SetSequence(1))
+	suite.accountKeeper.SetAccount(ctx, withSequence)
+	withPubKey := newAccount(4)
+	suite.Require().NoError(withPubKey.SetPubKey(secp256k1.GenPrivKey().PubKey()))
+	suite.accountKeeper.SetAccount(ctx, withPubKey)
+	moduleAcc := suite.accountKeeper.GetModuleAccount(ctx, "mint")
+	emptyNew := newAccount(5)
+	suite.Require().NoError(suite.accountKeeper.TxRateLimits.Set(ctx, empty.GetAccountNumber(), collections.Join(uint64(0), uint64(1))))
+	suite.Require().NoError(suite.accountKeeper.TxRateLimits.Set(ctx, funded.GetAccountNumber(), collections.Join(uint64(0), uint64(1))))
+
+	// only accounts older than the cutoff are pruned
+	pruned, err := suite.accountKeeper.PruneEmptyAccounts(ctx, emptyNew.GetAccountNumber())
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), pruned)
+	suite.Require().False(suite.accountKeeper.HasAccount(ctx, empty.GetAddress()))
+	// the transaction counter of a pruned account is removed along with it
+	has, err := suite.accountKeeper.TxRateLimits.Has(ctx, empty.GetAccountNumber())
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+	has, err = suite.accountKeeper.TxRateLimits.Has(ctx, funded.GetAccountNumber())
+	suite.Require().NoError(err)
+	suite.Require().True(has)
+	for _, acc := range []sdk.AccountI{funded, withSequence, withPubKey, moduleAcc, emptyNew} {
+		suite.Require().True(suite.accountKeeper.HasAccount(ctx, acc.GetAddress()))
+	}
+
+	pruned, err = suite.accountKeeper.PruneEmptyAccounts(ctx, emptyNew.GetAccountNumber()+1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), pruned)
+	suite.Require().False(suite.accountKeeper.HasAccount(ctx, emptyNew.GetAddress()))
+}
+
+func (suite *KeeperTestSuite) TestEndBlockerPruning() {
+	ctx := suite.ctx
+	suite.accountKeeper.SetBalanceKeeper(mockBalanceKeeper{})
+
+	acc := suite.accountKeeper.NewAccountWithAddress(ctx, sdk.AccAddress([]byte{0x01, 0x03}))
+	suite.accountKeeper.SetAccount(ctx, acc)
+
+	// pruning is disabled by default
+	suite.Require().NoError(suite.accountKeeper.Params.Set(ctx, types.DefaultParams()))
+	suite.Require().NoError(suite.accountKeeper.EndBlocker(ctx))
+	suite.Require().True(suite.accountKeeper.HasAccount(ctx, acc.GetAddress()))
+
+	params := types.DefaultParams()
+	params.EnableAccountPruning = true
+	suite.Require().NoError(suite.accountKeeper.Params.Set(ctx, params))
+	suite.Require().NoError(suite.accountKeeper.EndBlocker(ctx))
+	suite.Require().False(suite.accountKeeper.HasAccount(ctx, acc.GetAddress()))
+
+	// the cursor wraps around once every account has been inspected
+	cursor, err := suite.accountKeeper.PruningCursor.Get(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Zero(cursor)
+}
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/auth/types"
+)
+
+// IncrementTxCount records a transaction signed by the account with the given
+// account number and returns the number of transactions the account signed in
+// the current rate limit window, including this one. The counter is reset at
+// the start of every window of RateLimitWindow blocks.
+func (ak AccountKeeper) IncrementTxCount(ctx context.Context, accNum uint64) (uint64, error) {
+	params := ak.GetParams(ctx)
+	if params.RateLimitWindow == 0 {
+		return 0, errors.New("transaction rate limiting is disabled")
+	}
+
+	window := uint64(ak.environment.HeaderService.GetHeaderInfo(ctx).Height) / params.RateLimitWindow
+
+	counter, err := ak.TxRateLimits.Get(ctx, accNum)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return 0, err
+	}
+
+	count := uint64(1)
+	if err == nil && counter.K1() == window {
+		count = counter.K2() + 1
+	}
+
+	return count, ak.TxRateLimits.Set(ctx, accNum, collections.Join(window, count))
+}
+
+// clearExpiredTxCounts removes every transaction counter when the current block
+// is the last one of its rate limit window, as the counters only apply to the
+// window in which they were recorded. They are removed at every block while
+// rate limiting is disabled.
+func (ak AccountKeeper) clearExpiredTxCounts(ctx context.Context, params types.Params) error {
+	height := uint64(ak.environment.HeaderService.GetHeaderInfo(ctx).Height)
+	if params.RateLimitWindow != 0 && (height+1)%params.RateLimitWindow != 0 {
+		return nil
+	}
+
+	return ak.TxRateLimits.Clear(ctx, nil)
+}
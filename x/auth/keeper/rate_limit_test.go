@@ -0,0 +1,38 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/auth/types"
+)
+
+func (suite *KeeperTestSuite) TestEndBlockerClearsTxCounts() {
+	params := types.DefaultParams()
+	params.MaxTxsPerWindow = 2
+	params.RateLimitWindow = 10
+	suite.Require().NoError(suite.accountKeeper.Params.Set(suite.ctx, params))
+
+	ctx := suite.ctx.WithHeaderInfo(header.Info{Height: 10})
+	count, err := suite.accountKeeper.IncrementTxCount(ctx, 1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), count)
+
+	// the counters are kept until the end of the window
+	suite.Require().NoError(suite.accountKeeper.EndBlocker(ctx.WithHeaderInfo(header.Info{Height: 18})))
+	has, err := suite.accountKeeper.TxRateLimits.Has(ctx, 1)
+	suite.Require().NoError(err)
+	suite.Require().True(has)
+
+	suite.Require().NoError(suite.accountKeeper.EndBlocker(ctx.WithHeaderInfo(header.Info{Height: 19})))
+	has, err = suite.accountKeeper.TxRateLimits.Has(ctx, 1)
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+
+	// they are cleared at every block while rate limiting is disabled
+	_, err = suite.accountKeeper.IncrementTxCount(ctx.WithHeaderInfo(header.Info{Height: 21}), 1)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.accountKeeper.Params.Set(ctx, types.DefaultParams()))
+	suite.Require().NoError(suite.accountKeeper.EndBlocker(ctx.WithHeaderInfo(header.Info{Height: 21})))
+	has, err = suite.accountKeeper.TxRateLimits.Has(ctx, 1)
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+}
@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	"context"
+
+	secp256k1dcrd "github.com/decred/dcrd/dcrec/secp256k1/v4"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/auth/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// RotatePubKey replaces the public key of the account at addr, preserving its
+// address, account number and sequence. The replaced public key is appended to
+// the account's public key history. The new public key must be a single
+// secp256k1 or secp256r1 key.
+func (ak AccountKeeper) RotatePubKey(ctx context.Context, addr sdk.AccAddress, newPubKey cryptotypes.PubKey) error {
+	if newPubKey == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "new public key cannot be empty")
+	}
+	if err := validateRotatedPubKey(newPubKey); err != nil {
+		return err
+	}
+
+	acc := ak.GetAccount(ctx, addr)
+	if acc == nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", addr)
+	}
+
+	oldPubKey := acc.GetPubKey()
+	if oldPubKey == nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey, "account %s has no public key to rotate", addr)
+	}
+	if oldPubKey.Equals(newPubKey) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "new public key is identical to the current one")
+	}
+
+	rotation, err := types.NewPubKeyRotation(oldPubKey, ak.environment.HeaderService.GetHeaderInfo(ctx).Height)
+	if err != nil {
+		return err
+	}
+
+	index, err := ak.nextPubKeyRotationIndex(ctx, addr)
+	if err != nil {
+		return err
+	}
+
+	if err := ak.PubKeyHistory.Set(ctx, collections.Join(addr, index), rotation); err != nil {
+		return err
+	}
+
+	if err := acc.SetPubKey(newPubKey); err != nil {
+		return err
+	}
+	ak.SetAccount(ctx, acc)
+
+	return nil
+}
+
+// validateRotatedPubKey checks that an account can rotate to pubKey: only single
+// keys whose signatures are verified by the default signature verification are
+// accepted, multisig and other key types being rejected.
+func validateRotatedPubKey(pubKey cryptotypes.PubKey) error {
+	switch typedPubKey := pubKey.(type) {
+	case *secp256k1.PubKey:
+		if _, err := secp256k1dcrd.ParsePubKey(typedPubKey.Bytes()); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey, "invalid secp256k1 key: %s", err)
+		}
+
+	case *secp256r1.PubKey:
+		if typedPubKey.Key == nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "secp256r1 key cannot be empty")
+		}
+		pubKeyObject := typedPubKey.Key.PublicKey
+		if !pubKeyObject.IsOnCurve(pubKeyObject.X, pubKeyObject.Y) {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "secp256r1 key is not on curve")
+		}
+
+	default:
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey, "unsupported key type: %T", typedPubKey)
+	}
+
+	return nil
+}
+
+// nextPubKeyRotationIndex returns the index under which the next rotation of the
+// account at addr is stored.
+func (ak AccountKeeper) nextPubKeyRotationIndex(ctx context.Context, addr sdk.AccAddress) (uint64, error) {
+	rng := collections.NewPrefixedPairRange[sdk.AccAddress, uint64](addr).Descending()
+	iter, err := ak.PubKeyHistory.Iterate(ctx, rng)
+	if err != nil {
+		return 0, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return 0, nil
+	}
+
+	key, err := iter.Key()
+	if err != nil {
+		return 0, err
+	}
+	return key.K2() + 1, nil
+}
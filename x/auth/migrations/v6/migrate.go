@@ -0,0 +1,30 @@
+package v6
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/core/store"
+	"cosmossdk.io/store/types"
+)
+
+// AddressStoreKeyPrefix is the prefix under which accounts are stored by address.
+var AddressStoreKeyPrefix = []byte{0x01}
+
+// Migrate initializes the accounts count with the number of accounts stored
+// under AddressStoreKeyPrefix.
+func Migrate(ctx context.Context, storeService storetypes.KVStoreService, count collections.Item[uint64]) error {
+	store := storeService.OpenKVStore(ctx)
+	iter, err := store.Iterator(AddressStoreKeyPrefix, types.PrefixEndBytes(AddressStoreKeyPrefix))
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	var n uint64
+	for ; iter.Valid(); iter.Next() {
+		n++
+	}
+
+	return count.Set(ctx, n)
+}
@@ -0,0 +1,36 @@
+package v6
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/colltest"
+)
+
+func TestMigrate(t *testing.T) {
+	kv, ctx := colltest.MockStore()
+	sb := collections.NewSchemaBuilder(kv)
+	count := collections.NewItem(sb, collections.NewPrefix(6), "accounts_count", collections.Uint64Value)
+
+	// no accounts
+	err := Migrate(ctx, kv, count)
+	require.NoError(t, err)
+	gotValue, err := count.Get(ctx)
+	require.NoError(t, err)
+	require.Zero(t, gotValue)
+
+	store := kv.OpenKVStore(ctx)
+	for i := byte(0); i < 5; i++ {
+		require.NoError(t, store.Set(append([]byte{0x01}, i), []byte{i}))
+	}
+	// keys of other prefixes are not accounts
+	require.NoError(t, store.Set([]byte{0x02}, []byte{0x01}))
+
+	err = Migrate(ctx, kv, count)
+	require.NoError(t, err)
+	gotValue, err = count.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), gotValue)
+}
@@ -22,7 +22,7 @@ import (
 
 // ConsensusVersion defines the current x/auth module consensus version.
 const (
-	ConsensusVersion = 5
+	ConsensusVersion = 6
 	GovModuleName    = "gov"
 )
 
@@ -34,6 +34,7 @@ var (
 	_ appmodule.AppModule     = AppModule{}
 	_ appmodule.HasServices   = AppModule{}
 	_ appmodule.HasMigrations = AppModule{}
+	_ appmodule.HasEndBlocker = AppModule{}
 )
 
 // AppModule implements an application module for the auth module.
@@ -101,6 +102,9 @@ func (am AppModule) RegisterMigrations(mr appmodule.MigrationRegistrar) error {
 	if err := mr.Register(types.ModuleName, 4, m.Migrate4To5); err != nil {
 		return fmt.Errorf("failed to migrate x/%s from version 4 to 5: %w", types.ModuleName, err)
 	}
+	if err := mr.Register(types.ModuleName, 5, m.Migrate5To6); err != nil {
+		return fmt.Errorf("failed to migrate x/%s from version 5 to 6: %w", types.ModuleName, err)
+	}
 
 	return nil
 }
@@ -139,6 +143,11 @@ func (am AppModule) ExportGenesis(ctx context.Context) (json.RawMessage, error)
 	return am.cdc.MarshalJSON(gs)
 }
 
+// EndBlock returns the end blocker for the auth module.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	return am.accountKeeper.EndBlocker(ctx)
+}
+
 // ConsensusVersion implements HasConsensusVersion
 func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 
@@ -0,0 +1,85 @@
+package tx
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/core/address"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// StateOverridesFn applies the account and balance overrides of a
+// SimulateWithOverrides request to the branched state of the simulation.
+type StateOverridesFn func(ctx sdk.Context, accounts []*txtypes.AccountOverride, balances []*txtypes.BalanceOverride) error
+
+// OverridesAccountKeeper defines the account keeper used to apply account
+// overrides.
+type OverridesAccountKeeper interface {
+	AddressCodec() address.Codec
+	GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
+	HasAccount(ctx context.Context, addr sdk.AccAddress) bool
+	NewAccountWithAddress(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
+	SetAccount(ctx context.Context, acc sdk.AccountI)
+}
+
+// OverridesBankKeeper defines the bank keeper used to apply balance overrides,
+// implemented by the x/bank BaseKeeper but not by its Keeper interface.
+type OverridesBankKeeper interface {
+	OverrideBalances(ctx context.Context, addr sdk.AccAddress, balances sdk.Coins) error
+}
+
+// NewStateOverrides returns the StateOverridesFn applying account overrides
+// with ak and balance overrides with bk. The accounts of the overrides are
+// created if they don't exist.
+func NewStateOverrides(ak OverridesAccountKeeper, bk OverridesBankKeeper) StateOverridesFn {
+	return func(ctx sdk.Context, accounts []*txtypes.AccountOverride, balances []*txtypes.BalanceOverride) error {
+		for _, override := range accounts {
+			addr, err := ak.AddressCodec().StringToBytes(override.Address)
+			if err != nil {
+				return fmt.Errorf("invalid account address %s: %w", override.Address, err)
+			}
+
+			acc := ak.GetAccount(ctx, addr)
+			if acc == nil {
+				acc = ak.NewAccountWithAddress(ctx, addr)
+			}
+			if err := acc.SetSequence(override.Sequence); err != nil {
+				return err
+			}
+
+			if override.PubKey != nil {
+				pubKey, ok := override.PubKey.GetCachedValue().(cryptotypes.PubKey)
+				if !ok {
+					return fmt.Errorf("invalid public key of account %s", override.Address)
+				}
+				if !sdk.AccAddress(pubKey.Address()).Equals(sdk.AccAddress(addr)) {
+					return fmt.Errorf("public key doesn't match account %s", override.Address)
+				}
+				if err := acc.SetPubKey(pubKey); err != nil {
+					return err
+				}
+			}
+
+			ak.SetAccount(ctx, acc)
+		}
+
+		for _, override := range balances {
+			addr, err := ak.AddressCodec().StringToBytes(override.Address)
+			if err != nil {
+				return fmt.Errorf("invalid balance address %s: %w", override.Address, err)
+			}
+			if !ak.HasAccount(ctx, addr) {
+				ak.SetAccount(ctx, ak.NewAccountWithAddress(ctx, addr))
+			}
+
+			if err := bk.OverrideBalances(ctx, addr, override.Balance); err != nil {
+				return fmt.Errorf("invalid balance of %s: %w", override.Address, err)
+			}
+		}
+
+		return nil
+	}
+}
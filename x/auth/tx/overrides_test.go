@@ -0,0 +1,120 @@
+package tx
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/core/address"
+	authtypes "cosmossdk.io/x/auth/types"
+
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+type overridesKeepers struct {
+	accounts map[string]sdk.AccountI
+	balances map[string]sdk.Coins
+}
+
+func (k *overridesKeepers) AddressCodec() address.Codec {
+	return addresscodec.NewBech32Codec("cosmos")
+}
+
+func (k *overridesKeepers) GetAccount(_ context.Context, addr sdk.AccAddress) sdk.AccountI {
+	return k.accounts[addr.String()]
+}
+
+func (k *overridesKeepers) HasAccount(_ context.Context, addr sdk.AccAddress) bool {
+	_, ok := k.accounts[addr.String()]
+	return ok
+}
+
+func (k *overridesKeepers) NewAccountWithAddress(_ context.Context, addr sdk.AccAddress) sdk.AccountI {
+	return authtypes.NewBaseAccountWithAddress(addr)
+}
+
+func (k *overridesKeepers) SetAccount(_ context.Context, acc sdk.AccountI) {
+	k.accounts[acc.GetAddress().String()] = acc
+}
+
+func (k *overridesKeepers) OverrideBalances(_ context.Context, addr sdk.AccAddress, balances sdk.Coins) error {
+	if err := balances.Validate(); err != nil {
+		return err
+	}
+	k.balances[addr.String()] = balances
+	return nil
+}
+
+func TestSimulateWithOverrides(t *testing.T) {
+	keepers := &overridesKeepers{accounts: map[string]sdk.AccountI{}, balances: map[string]sdk.Coins{}}
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(interfaceRegistry)
+
+	pubKey := secp256k1.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(pubKey.Address())
+	other := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	anyPk, err := codectypes.NewAnyWithValue(pubKey)
+	require.NoError(t, err)
+
+	var simulated []byte
+	srv := txServer{
+		interfaceRegistry: interfaceRegistry,
+		simulateWithOverrides: func(txBytes []byte, overrides func(ctx sdk.Context) error) (sdk.GasInfo, *sdk.Result, error) {
+			if err := overrides(sdk.Context{}); err != nil {
+				return sdk.GasInfo{}, nil, err
+			}
+			simulated = txBytes
+			return sdk.GasInfo{GasUsed: 10}, &sdk.Result{}, nil
+		},
+		stateOverrides: NewStateOverrides(keepers, keepers),
+	}
+	ctx := context.Background()
+
+	res, err := srv.SimulateWithOverrides(ctx, &txtypes.SimulateWithOverridesRequest{
+		TxBytes: []byte("tx"),
+		Accounts: []*txtypes.AccountOverride{
+			{Address: addr.String(), Sequence: 5, PubKey: anyPk},
+		},
+		Balances: []*txtypes.BalanceOverride{
+			{Address: addr.String(), Balance: sdk.NewCoins(sdk.NewInt64Coin("stake", 100))},
+			{Address: other.String(), Balance: sdk.NewCoins(sdk.NewInt64Coin("stake", 10))},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), res.GasInfo.GasUsed)
+	require.Equal(t, []byte("tx"), simulated)
+
+	acc := keepers.accounts[addr.String()]
+	require.Equal(t, uint64(5), acc.GetSequence())
+	require.True(t, pubKey.Equals(acc.GetPubKey()))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), keepers.balances[addr.String()])
+	// the account of a balance override is created
+	require.NotNil(t, keepers.accounts[other.String()])
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), keepers.balances[other.String()])
+
+	_, err = srv.SimulateWithOverrides(ctx, &txtypes.SimulateWithOverridesRequest{
+		TxBytes:  []byte("tx"),
+		Accounts: []*txtypes.AccountOverride{{Address: other.String(), PubKey: anyPk}},
+	})
+	require.ErrorContains(t, err, "public key doesn't match account")
+
+	_, err = srv.SimulateWithOverrides(ctx, &txtypes.SimulateWithOverridesRequest{
+		TxBytes:  []byte("tx"),
+		Balances: []*txtypes.BalanceOverride{{Address: "invalid"}},
+	})
+	require.ErrorContains(t, err, "invalid balance address")
+
+	_, err = srv.SimulateWithOverrides(ctx, &txtypes.SimulateWithOverridesRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = txServer{}.SimulateWithOverrides(ctx, &txtypes.SimulateWithOverridesRequest{TxBytes: []byte("tx")})
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+}
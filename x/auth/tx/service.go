@@ -24,11 +24,17 @@ import (
 // baseAppSimulateFn is the signature of the Baseapp#Simulate function.
 type baseAppSimulateFn func(txBytes []byte) (sdk.GasInfo, *sdk.Result, error)
 
+// baseAppSimulateWithOverridesFn is the signature of the
+// Baseapp#SimulateWithOverrides function.
+type baseAppSimulateWithOverridesFn func(txBytes []byte, overrides func(ctx sdk.Context) error) (sdk.GasInfo, *sdk.Result, error)
+
 // txServer is the server for the protobuf Tx service.
 type txServer struct {
-	clientCtx         client.Context
-	simulate          baseAppSimulateFn
-	interfaceRegistry codectypes.InterfaceRegistry
+	clientCtx             client.Context
+	simulate              baseAppSimulateFn
+	simulateWithOverrides baseAppSimulateWithOverridesFn
+	stateOverrides        StateOverridesFn
+	interfaceRegistry     codectypes.InterfaceRegistry
 }
 
 // NewTxServer creates a new Tx service server.
@@ -106,6 +112,35 @@ func (s txServer) Simulate(ctx context.Context, req *txtypes.SimulateRequest) (*
 	}, nil
 }
 
+// SimulateWithOverrides implements the ServiceServer.SimulateWithOverrides RPC method.
+func (s txServer) SimulateWithOverrides(ctx context.Context, req *txtypes.SimulateWithOverridesRequest) (*txtypes.SimulateWithOverridesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid empty tx")
+	}
+	if s.simulateWithOverrides == nil || s.stateOverrides == nil {
+		return nil, status.Error(codes.Unimplemented, "simulation with state overrides is not supported by the app")
+	}
+
+	if len(req.TxBytes) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "empty txBytes is not allowed")
+	}
+	if err := req.UnpackInterfaces(s.interfaceRegistry); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid overrides; %v", err)
+	}
+
+	gasInfo, result, err := s.simulateWithOverrides(req.TxBytes, func(ctx sdk.Context) error {
+		return s.stateOverrides(ctx, req.Accounts, req.Balances)
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Unknown, "%v with gas used: '%d'", err, gasInfo.GasUsed)
+	}
+
+	return &txtypes.SimulateWithOverridesResponse{
+		GasInfo: &gasInfo,
+		Result:  result,
+	}, nil
+}
+
 // GetTx implements the ServiceServer.GetTx RPC method.
 func (s txServer) GetTx(ctx context.Context, req *txtypes.GetTxRequest) (*txtypes.GetTxResponse, error) {
 	if req == nil {
@@ -323,6 +358,30 @@ func RegisterTxService(
 	)
 }
 
+// RegisterTxServiceWithOverrides registers the tx service on the gRPC router
+// like RegisterTxService, with its SimulateWithOverrides RPC method served by
+// simulateWithOverridesFn, applying the overrides with stateOverridesFn, e.g.
+// as returned by NewStateOverrides.
+func RegisterTxServiceWithOverrides(
+	qrt gogogrpc.Server,
+	clientCtx client.Context,
+	simulateFn baseAppSimulateFn,
+	simulateWithOverridesFn baseAppSimulateWithOverridesFn,
+	stateOverridesFn StateOverridesFn,
+	interfaceRegistry codectypes.InterfaceRegistry,
+) {
+	txtypes.RegisterServiceServer(
+		qrt,
+		txServer{
+			clientCtx:             clientCtx,
+			simulate:              simulateFn,
+			simulateWithOverrides: simulateWithOverridesFn,
+			stateOverrides:        stateOverridesFn,
+			interfaceRegistry:     interfaceRegistry,
+		},
+	)
+}
+
 // RegisterGRPCGatewayRoutes mounts the tx service's GRPC-gateway routes on the
 // given Mux.
 func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
@@ -174,6 +174,19 @@ type Params struct {
 	TxSizeCostPerByte      uint64 `protobuf:"varint,3,opt,name=tx_size_cost_per_byte,json=txSizeCostPerByte,proto3" json:"tx_size_cost_per_byte,omitempty"`
 	SigVerifyCostED25519   uint64 `protobuf:"varint,4,opt,name=sig_verify_cost_ed25519,json=sigVerifyCostEd25519,proto3" json:"sig_verify_cost_ed25519,omitempty"`
 	SigVerifyCostSecp256k1 uint64 `protobuf:"varint,5,opt,name=sig_verify_cost_secp256k1,json=sigVerifyCostSecp256k1,proto3" json:"sig_verify_cost_secp256k1,omitempty"`
+	// enable_account_pruning enables the removal of empty base accounts at the end of each block.
+	//
+	// Since: cosmos-sdk 0.51
+	EnableAccountPruning bool `protobuf:"varint,6,opt,name=enable_account_pruning,json=enableAccountPruning,proto3" json:"enable_account_pruning,omitempty"`
+	// max_txs_per_window is the maximum number of transactions an account can sign
+	// within a rate limit window. Zero disables transaction rate limiting.
+	//
+	// Since: cosmos-sdk 0.51
+	MaxTxsPerWindow uint64 `protobuf:"varint,7,opt,name=max_txs_per_window,json=maxTxsPerWindow,proto3" json:"max_txs_per_window,omitempty"`
+	// rate_limit_window is the length, in blocks, of a transaction rate limit window.
+	//
+	// Since: cosmos-sdk 0.51
+	RateLimitWindow uint64 `protobuf:"varint,8,opt,name=rate_limit_window,json=rateLimitWindow,proto3" json:"rate_limit_window,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -244,63 +257,210 @@ func (m *Params) GetSigVerifyCostSecp256k1() uint64 {
 	return 0
 }
 
+func (m *Params) GetEnableAccountPruning() bool {
+	if m != nil {
+		return m.EnableAccountPruning
+	}
+	return false
+}
+
+func (m *Params) GetMaxTxsPerWindow() uint64 {
+	if m != nil {
+		return m.MaxTxsPerWindow
+	}
+	return 0
+}
+
+func (m *Params) GetRateLimitWindow() uint64 {
+	if m != nil {
+		return m.RateLimitWindow
+	}
+	return 0
+}
+
+// PubKeyRotation records a public key that was replaced by a MsgRotatePubKey.
+//
+// Since: cosmos-sdk 0.51
+type PubKeyRotation struct {
+	// pub_key is the public key that was replaced.
+	PubKey *any.Any `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// block_height is the height at which the public key was replaced.
+	BlockHeight int64 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (m *PubKeyRotation) Reset()         { *m = PubKeyRotation{} }
+func (m *PubKeyRotation) String() string { return proto.CompactTextString(m) }
+func (*PubKeyRotation) ProtoMessage()    {}
+func (*PubKeyRotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1f7e915d020d2d, []int{4}
+}
+func (m *PubKeyRotation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PubKeyRotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PubKeyRotation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PubKeyRotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PubKeyRotation.Merge(m, src)
+}
+func (m *PubKeyRotation) XXX_Size() int {
+	return m.Size()
+}
+func (m *PubKeyRotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PubKeyRotation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PubKeyRotation proto.InternalMessageInfo
+
+func (m *PubKeyRotation) GetPubKey() *any.Any {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+func (m *PubKeyRotation) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+// AccountNumberAddress maps an account number to the address of the account.
+//
+// Since: cosmos-sdk 0.51
+type AccountNumberAddress struct {
+	// account_number is the number of the account.
+	AccountNumber uint64 `protobuf:"varint,1,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	// address is the address of the account.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *AccountNumberAddress) Reset()         { *m = AccountNumberAddress{} }
+func (m *AccountNumberAddress) String() string { return proto.CompactTextString(m) }
+func (*AccountNumberAddress) ProtoMessage()    {}
+func (*AccountNumberAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1f7e915d020d2d, []int{5}
+}
+func (m *AccountNumberAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountNumberAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountNumberAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountNumberAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountNumberAddress.Merge(m, src)
+}
+func (m *AccountNumberAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountNumberAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountNumberAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountNumberAddress proto.InternalMessageInfo
+
+func (m *AccountNumberAddress) GetAccountNumber() uint64 {
+	if m != nil {
+		return m.AccountNumber
+	}
+	return 0
+}
+
+func (m *AccountNumberAddress) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*BaseAccount)(nil), "cosmos.auth.v1beta1.BaseAccount")
 	proto.RegisterType((*ModuleAccount)(nil), "cosmos.auth.v1beta1.ModuleAccount")
 	proto.RegisterType((*ModuleCredential)(nil), "cosmos.auth.v1beta1.ModuleCredential")
 	proto.RegisterType((*Params)(nil), "cosmos.auth.v1beta1.Params")
+	proto.RegisterType((*PubKeyRotation)(nil), "cosmos.auth.v1beta1.PubKeyRotation")
+	proto.RegisterType((*AccountNumberAddress)(nil), "cosmos.auth.v1beta1.AccountNumberAddress")
 }
 
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/auth.proto", fileDescriptor_7e1f7e915d020d2d) }
 
 var fileDescriptor_7e1f7e915d020d2d = []byte{
-	// 728 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0x41, 0x4f, 0xe3, 0x46,
-	0x18, 0x8d, 0x93, 0x14, 0xca, 0x04, 0x68, 0x31, 0x29, 0x35, 0x51, 0x15, 0x9b, 0x48, 0x2d, 0x11,
-	0x2a, 0x4e, 0x13, 0x44, 0xa5, 0xe6, 0x46, 0xd2, 0xaa, 0x42, 0x14, 0x8a, 0x1c, 0x2d, 0x07, 0x2e,
-	0xd6, 0xd8, 0x1e, 0xcc, 0x28, 0xb1, 0xc7, 0xeb, 0x19, 0xa3, 0x98, 0xf3, 0x1e, 0xd0, 0x9e, 0x56,
-	0xfb, 0x0b, 0xd8, 0xfd, 0x05, 0x1c, 0xf8, 0x11, 0xab, 0x3d, 0xa1, 0xbd, 0xec, 0x9e, 0xa2, 0x55,
-	0x38, 0x80, 0x56, 0xfb, 0x23, 0x56, 0x9e, 0x71, 0x20, 0x41, 0xb9, 0x44, 0x9e, 0xf7, 0xde, 0xf7,
-	0x7d, 0xef, 0x7b, 0x9e, 0x18, 0x94, 0x6d, 0x42, 0x3d, 0x42, 0x6b, 0x30, 0x62, 0xa7, 0xb5, 0xb3,
-	0xba, 0x85, 0x18, 0xac, 0xf3, 0x83, 0x1e, 0x84, 0x84, 0x11, 0x79, 0x59, 0xf0, 0x3a, 0x87, 0x52,
-	0xbe, 0xb4, 0x04, 0x3d, 0xec, 0x93, 0x1a, 0xff, 0x15, 0xba, 0xd2, 0xaa, 0xd0, 0x99, 0xfc, 0x54,
-	0x4b, 0x8b, 0x04, 0x55, 0x74, 0x89, 0x4b, 0x04, 0x9e, 0x3c, 0x8d, 0x0a, 0x5c, 0x42, 0xdc, 0x1e,
-	0xaa, 0xf1, 0x93, 0x15, 0x9d, 0xd4, 0xa0, 0x1f, 0x0b, 0xaa, 0xf2, 0x26, 0x0b, 0x0a, 0x2d, 0x48,
-	0xd1, 0x8e, 0x6d, 0x93, 0xc8, 0x67, 0x72, 0x03, 0xcc, 0x42, 0xc7, 0x09, 0x11, 0xa5, 0x8a, 0xa4,
-	0x49, 0xd5, 0xb9, 0x96, 0xf2, 0xe1, 0x7a, 0xb3, 0x98, 0xce, 0xd8, 0x11, 0x4c, 0x87, 0x85, 0xd8,
-	0x77, 0x8d, 0x91, 0x50, 0x3e, 0x02, 0xb3, 0x41, 0x64, 0x99, 0x5d, 0x14, 0x2b, 0x59, 0x4d, 0xaa,
-	0x16, 0x1a, 0x45, 0x5d, 0x0c, 0xd4, 0x47, 0x03, 0xf5, 0x1d, 0x3f, 0x6e, 0xad, 0x7f, 0x19, 0xa8,
-	0xc5, 0x20, 0xb2, 0x7a, 0xd8, 0x4e, 0xb4, 0xbf, 0x13, 0x0f, 0x33, 0xe4, 0x05, 0x2c, 0x7e, 0x7b,
-	0x77, 0xb5, 0x01, 0x1e, 0x09, 0x63, 0x26, 0x88, 0xac, 0x3d, 0x14, 0xcb, 0xbf, 0x82, 0x45, 0x28,
-	0x6c, 0x99, 0x7e, 0xe4, 0x59, 0x28, 0x54, 0x72, 0x9a, 0x54, 0xcd, 0x1b, 0x0b, 0x29, 0x7a, 0xc0,
-	0x41, 0xb9, 0x04, 0xbe, 0xa7, 0xe8, 0x79, 0x84, 0x7c, 0x1b, 0x29, 0x79, 0x2e, 0x78, 0x38, 0x37,
-	0xdb, 0x17, 0x97, 0x6a, 0xe6, 0xfe, 0x52, 0xcd, 0xbc, 0xbf, 0xde, 0xfc, 0x65, 0x4a, 0xbc, 0x7a,
-	0xba, 0xf7, 0xee, 0xcb, 0xbb, 0xab, 0x8d, 0x15, 0x21, 0xd8, 0xa4, 0x4e, 0xb7, 0x36, 0x96, 0x49,
-	0xe5, 0xab, 0x04, 0x16, 0xf6, 0x89, 0x13, 0xf5, 0x1e, 0x52, 0xda, 0x05, 0xf3, 0x16, 0xa4, 0xc8,
-	0x4c, 0x8d, 0xf0, 0xa8, 0x0a, 0x0d, 0x4d, 0x9f, 0x36, 0x61, 0xac, 0x53, 0x2b, 0x7f, 0x33, 0x50,
-	0x25, 0xa3, 0x60, 0x8d, 0x05, 0x2e, 0x83, 0xbc, 0x0f, 0x3d, 0xc4, 0x93, 0x9b, 0x33, 0xf8, 0xb3,
-	0xac, 0x81, 0x42, 0x80, 0x42, 0x0f, 0x53, 0x8a, 0x89, 0x4f, 0x95, 0x9c, 0x96, 0xab, 0xce, 0x19,
-	0xe3, 0x50, 0xf3, 0xf8, 0x42, 0xec, 0x54, 0x99, 0x36, 0x71, 0xc2, 0x2b, 0xdf, 0x4c, 0x19, 0xdb,
-	0x6c, 0x82, 0x7d, 0x7d, 0x77, 0xb5, 0xb1, 0xe8, 0x71, 0x64, 0xb4, 0x4c, 0xe5, 0x85, 0x04, 0x7e,
-	0x14, 0xa2, 0x76, 0x88, 0x1c, 0xe4, 0x33, 0x0c, 0x7b, 0xb2, 0x0a, 0x0a, 0xa9, 0x8c, 0xbb, 0xe5,
-	0x77, 0xc3, 0x00, 0x02, 0x3a, 0x48, 0x3c, 0xaf, 0x83, 0x1f, 0x1c, 0x14, 0xe2, 0x33, 0xc8, 0x30,
-	0xf1, 0x93, 0xd7, 0x48, 0x95, 0xac, 0x96, 0xab, 0xce, 0x1b, 0x8b, 0x8f, 0xf0, 0x1e, 0x8a, 0x69,
-	0xf3, 0xb7, 0xc4, 0xd0, 0xda, 0x98, 0xa1, 0x7f, 0x43, 0x12, 0x05, 0xa9, 0x9f, 0xc7, 0x89, 0x95,
-	0x8f, 0x59, 0x30, 0x73, 0x08, 0x43, 0xe8, 0x51, 0x59, 0x07, 0xcb, 0x1e, 0xec, 0x9b, 0x1e, 0xf2,
-	0x88, 0x69, 0x9f, 0xc2, 0x10, 0xda, 0x0c, 0x85, 0xe2, 0x82, 0xe6, 0x8d, 0x25, 0x0f, 0xf6, 0xf7,
-	0x91, 0x47, 0xda, 0x0f, 0x84, 0xac, 0x81, 0x79, 0xd6, 0x37, 0x29, 0x76, 0xcd, 0x1e, 0xf6, 0x30,
-	0xe3, 0xd9, 0xe6, 0x0d, 0xc0, 0xfa, 0x1d, 0xec, 0xfe, 0x97, 0x20, 0xf2, 0x1f, 0xe0, 0x27, 0xae,
-	0x38, 0x47, 0xa6, 0x4d, 0x28, 0x33, 0x03, 0x14, 0x9a, 0x56, 0xcc, 0x50, 0x7a, 0xc3, 0x96, 0x12,
-	0xe9, 0x39, 0x6a, 0x13, 0xca, 0x0e, 0x51, 0xd8, 0x8a, 0x19, 0x92, 0xff, 0x07, 0x3f, 0x27, 0x0d,
-	0xcf, 0x50, 0x88, 0x4f, 0x62, 0x51, 0x84, 0x9c, 0xc6, 0xf6, 0x76, 0xfd, 0x2f, 0x71, 0xe9, 0x5a,
-	0xca, 0x70, 0xa0, 0x16, 0x3b, 0xd8, 0x3d, 0xe2, 0x8a, 0xa4, 0xf4, 0x9f, 0xbf, 0x39, 0x6f, 0x14,
-	0xe9, 0x04, 0x2a, 0xaa, 0xe4, 0x67, 0x60, 0xf5, 0x69, 0x43, 0x8a, 0xec, 0xa0, 0xb1, 0xfd, 0x67,
-	0xb7, 0xae, 0x7c, 0xc7, 0x5b, 0x96, 0x86, 0x03, 0x75, 0x65, 0xa2, 0x65, 0x67, 0xa4, 0x30, 0x56,
-	0xe8, 0x54, 0xbc, 0xb9, 0x76, 0x7f, 0xa9, 0x4a, 0x4f, 0xdf, 0x79, 0x5f, 0x7c, 0x73, 0x44, 0x9c,
-	0xad, 0xad, 0x77, 0xc3, 0xb2, 0x74, 0x33, 0x2c, 0x4b, 0x9f, 0x87, 0x65, 0xe9, 0xd5, 0x6d, 0x39,
-	0x73, 0x73, 0x5b, 0xce, 0x7c, 0xba, 0x2d, 0x67, 0x8e, 0xd3, 0x2f, 0x0b, 0x75, 0xba, 0x3a, 0x26,
-	0xa3, 0x2a, 0x16, 0x07, 0x88, 0x5a, 0x33, 0xfc, 0xbf, 0xbc, 0xf5, 0x2d, 0x00, 0x00, 0xff, 0xff,
-	0x78, 0xbd, 0x49, 0x53, 0xc5, 0x04, 0x00, 0x00,
+	// 882 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x4f, 0x6f, 0x1b, 0x45,
+	0x1c, 0xf5, 0xc6, 0x26, 0x7f, 0xc6, 0x69, 0x4a, 0xa6, 0x26, 0x6c, 0x23, 0x64, 0x6f, 0x2c, 0x41,
+	0xad, 0x40, 0xd6, 0xc4, 0x25, 0x48, 0xe4, 0x16, 0x07, 0x54, 0xaa, 0xd2, 0x62, 0x6d, 0xa0, 0x48,
+	0xbd, 0xac, 0x66, 0x77, 0x7f, 0xdd, 0x8c, 0xec, 0xdd, 0xd9, 0xce, 0xcc, 0xa6, 0xde, 0x8a, 0x23,
+	0x87, 0x8a, 0x13, 0xe2, 0x13, 0x04, 0x3e, 0x41, 0x0e, 0xfd, 0x10, 0xa8, 0xa7, 0x88, 0x13, 0xa7,
+	0x08, 0x39, 0x12, 0xa9, 0x10, 0x1f, 0x02, 0xed, 0xcc, 0x3a, 0x76, 0x22, 0x0b, 0x71, 0xb1, 0x76,
+	0xde, 0x7b, 0x33, 0xf3, 0xfb, 0xbd, 0xf9, 0xf9, 0xa1, 0xba, 0xcf, 0x44, 0xc4, 0x44, 0x9b, 0xa4,
+	0xf2, 0xb0, 0x7d, 0xb4, 0xed, 0x81, 0x24, 0xdb, 0x6a, 0x61, 0x27, 0x9c, 0x49, 0x86, 0x6f, 0x69,
+	0xde, 0x56, 0x50, 0xc1, 0xaf, 0xaf, 0x92, 0x88, 0xc6, 0xac, 0xad, 0x7e, 0xb5, 0x6e, 0xfd, 0xb6,
+	0xd6, 0xb9, 0x6a, 0xd5, 0x2e, 0x36, 0x69, 0xaa, 0x16, 0xb2, 0x90, 0x69, 0x3c, 0xff, 0x1a, 0x6f,
+	0x08, 0x19, 0x0b, 0x07, 0xd0, 0x56, 0x2b, 0x2f, 0x7d, 0xda, 0x26, 0x71, 0xa6, 0xa9, 0xe6, 0x2f,
+	0x73, 0xa8, 0xda, 0x25, 0x02, 0xf6, 0x7c, 0x9f, 0xa5, 0xb1, 0xc4, 0x1d, 0xb4, 0x40, 0x82, 0x80,
+	0x83, 0x10, 0xa6, 0x61, 0x19, 0xad, 0xa5, 0xae, 0xf9, 0xfb, 0xab, 0xad, 0x5a, 0x71, 0xc7, 0x9e,
+	0x66, 0x0e, 0x24, 0xa7, 0x71, 0xe8, 0x8c, 0x85, 0xf8, 0x31, 0x5a, 0x48, 0x52, 0xcf, 0xed, 0x43,
+	0x66, 0xce, 0x59, 0x46, 0xab, 0xda, 0xa9, 0xd9, 0xfa, 0x42, 0x7b, 0x7c, 0xa1, 0xbd, 0x17, 0x67,
+	0xdd, 0x3b, 0x7f, 0x9f, 0x35, 0x6a, 0x49, 0xea, 0x0d, 0xa8, 0x9f, 0x6b, 0x3f, 0x62, 0x11, 0x95,
+	0x10, 0x25, 0x32, 0xfb, 0xf5, 0xe2, 0x64, 0x13, 0x4d, 0x08, 0x67, 0x3e, 0x49, 0xbd, 0x07, 0x90,
+	0xe1, 0xf7, 0xd1, 0x0a, 0xd1, 0x65, 0xb9, 0x71, 0x1a, 0x79, 0xc0, 0xcd, 0xb2, 0x65, 0xb4, 0x2a,
+	0xce, 0x8d, 0x02, 0x7d, 0xa4, 0x40, 0xbc, 0x8e, 0x16, 0x05, 0x3c, 0x4b, 0x21, 0xf6, 0xc1, 0xac,
+	0x28, 0xc1, 0xe5, 0x7a, 0x77, 0xff, 0xe5, 0x71, 0xa3, 0xf4, 0xe6, 0xb8, 0x51, 0x7a, 0xfd, 0x6a,
+	0xeb, 0xbd, 0x19, 0xf6, 0xda, 0x45, 0xdf, 0xf7, 0x7f, 0xbc, 0x38, 0xd9, 0x5c, 0xd3, 0x82, 0x2d,
+	0x11, 0xf4, 0xdb, 0x53, 0x9e, 0x34, 0xff, 0x31, 0xd0, 0x8d, 0x87, 0x2c, 0x48, 0x07, 0x97, 0x2e,
+	0xdd, 0x47, 0xcb, 0x1e, 0x11, 0xe0, 0x16, 0x85, 0x28, 0xab, 0xaa, 0x1d, 0xcb, 0x9e, 0x75, 0xc3,
+	0xd4, 0x49, 0xdd, 0xca, 0xe9, 0x59, 0xc3, 0x70, 0xaa, 0xde, 0x94, 0xe1, 0x18, 0x55, 0x62, 0x12,
+	0x81, 0x72, 0x6e, 0xc9, 0x51, 0xdf, 0xd8, 0x42, 0xd5, 0x04, 0x78, 0x44, 0x85, 0xa0, 0x2c, 0x16,
+	0x66, 0xd9, 0x2a, 0xb7, 0x96, 0x9c, 0x69, 0x68, 0xf7, 0xc9, 0x4b, 0xdd, 0x53, 0x73, 0xd6, 0x8d,
+	0x57, 0x6a, 0x55, 0x9d, 0x99, 0x53, 0x9d, 0x5d, 0x61, 0x7f, 0xbe, 0x38, 0xd9, 0x5c, 0x89, 0x14,
+	0x32, 0x6e, 0xa6, 0xf9, 0x83, 0x81, 0xde, 0xd6, 0xa2, 0x7d, 0x0e, 0x01, 0xc4, 0x92, 0x92, 0x01,
+	0x6e, 0xa0, 0x6a, 0x21, 0x53, 0xd5, 0xaa, 0xd9, 0x70, 0x90, 0x86, 0x1e, 0xe5, 0x35, 0xdf, 0x41,
+	0x37, 0x03, 0xe0, 0xf4, 0x88, 0x48, 0xca, 0xe2, 0xfc, 0x19, 0x85, 0x39, 0x67, 0x95, 0x5b, 0xcb,
+	0xce, 0xca, 0x04, 0x7e, 0x00, 0x99, 0xd8, 0xfd, 0x20, 0x2f, 0x68, 0x63, 0xaa, 0xa0, 0x7b, 0x9c,
+	0xa5, 0x49, 0x51, 0xcf, 0xe4, 0xc6, 0xe6, 0x5f, 0x65, 0x34, 0xdf, 0x23, 0x9c, 0x44, 0x02, 0xdb,
+	0xe8, 0x56, 0x44, 0x86, 0x6e, 0x04, 0x11, 0x73, 0xfd, 0x43, 0xc2, 0x89, 0x2f, 0x81, 0xeb, 0x01,
+	0xad, 0x38, 0xab, 0x11, 0x19, 0x3e, 0x84, 0x88, 0xed, 0x5f, 0x12, 0xd8, 0x42, 0xcb, 0x72, 0xe8,
+	0x0a, 0x1a, 0xba, 0x03, 0x1a, 0x51, 0xa9, 0xbc, 0xad, 0x38, 0x48, 0x0e, 0x0f, 0x68, 0xf8, 0x55,
+	0x8e, 0xe0, 0x8f, 0xd1, 0x3b, 0x4a, 0xf1, 0x02, 0x5c, 0x9f, 0x09, 0xe9, 0x26, 0xc0, 0x5d, 0x2f,
+	0x93, 0x50, 0x4c, 0xd8, 0x6a, 0x2e, 0x7d, 0x01, 0xfb, 0x4c, 0xc8, 0x1e, 0xf0, 0x6e, 0x26, 0x01,
+	0x7f, 0x8d, 0xde, 0xcd, 0x0f, 0x3c, 0x02, 0x4e, 0x9f, 0x66, 0x7a, 0x13, 0x04, 0x9d, 0x9d, 0x9d,
+	0xed, 0xcf, 0xf4, 0xd0, 0x75, 0xcd, 0xd1, 0x59, 0xa3, 0x76, 0x40, 0xc3, 0xc7, 0x4a, 0x91, 0x6f,
+	0xfd, 0xe2, 0x73, 0xc5, 0x3b, 0x35, 0x71, 0x05, 0xd5, 0xbb, 0xf0, 0xb7, 0xe8, 0xf6, 0xf5, 0x03,
+	0x05, 0xf8, 0x49, 0x67, 0xe7, 0xd3, 0xfe, 0xb6, 0xf9, 0x96, 0x3a, 0x72, 0x7d, 0x74, 0xd6, 0x58,
+	0xbb, 0x72, 0xe4, 0xc1, 0x58, 0xe1, 0xac, 0x89, 0x99, 0x38, 0xfe, 0x04, 0xad, 0x41, 0x4c, 0xbc,
+	0xc9, 0x7b, 0xba, 0x09, 0x4f, 0x63, 0x1a, 0x87, 0xe6, 0xbc, 0x65, 0xb4, 0x16, 0x9d, 0x9a, 0x66,
+	0x0b, 0xbf, 0x7b, 0x9a, 0xc3, 0x1f, 0x22, 0x9c, 0x3b, 0x2c, 0x87, 0x42, 0x59, 0xf1, 0x9c, 0xc6,
+	0x01, 0x7b, 0x6e, 0x2e, 0x28, 0x33, 0x6e, 0x46, 0x64, 0xf8, 0xcd, 0x50, 0xf4, 0x80, 0x7f, 0xa7,
+	0x60, 0xbc, 0x89, 0x56, 0x39, 0x91, 0xa0, 0xcd, 0x1d, 0x6b, 0x17, 0xb5, 0x36, 0x27, 0x94, 0xc5,
+	0x5a, 0xbb, 0xbb, 0xf1, 0xe6, 0xb8, 0x61, 0x5c, 0x1f, 0xc1, 0xa1, 0x8e, 0x40, 0xfd, 0xba, 0xcd,
+	0xef, 0xd1, 0x4a, 0x4f, 0xfd, 0xe1, 0x1d, 0x26, 0xd5, 0x98, 0xe0, 0x7b, 0x93, 0x40, 0x31, 0xfe,
+	0x23, 0x50, 0xcc, 0xd7, 0x93, 0x68, 0xf2, 0x79, 0x96, 0x48, 0x66, 0x17, 0x07, 0x8d, 0x13, 0x64,
+	0x03, 0x2d, 0x7b, 0x03, 0xe6, 0xf7, 0xdd, 0x43, 0xa0, 0xe1, 0xa1, 0x1e, 0x84, 0xb2, 0x53, 0x55,
+	0xd8, 0x97, 0x0a, 0x6a, 0x3e, 0x43, 0xb5, 0xbd, 0xe9, 0x38, 0x29, 0x32, 0x6e, 0x46, 0xf8, 0x18,
+	0xb3, 0xc2, 0x67, 0x2a, 0x2f, 0xe7, 0xfe, 0x67, 0x5e, 0x76, 0xef, 0xfe, 0x36, 0xaa, 0x1b, 0xa7,
+	0xa3, 0xba, 0xf1, 0xe7, 0xa8, 0x6e, 0xfc, 0x74, 0x5e, 0x2f, 0x9d, 0x9e, 0xd7, 0x4b, 0x7f, 0x9c,
+	0xd7, 0x4b, 0x4f, 0x8a, 0x64, 0x17, 0x41, 0xdf, 0xa6, 0x6c, 0x6c, 0x93, 0xcc, 0x12, 0x10, 0xde,
+	0xbc, 0x6a, 0xfd, 0xee, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x7d, 0x45, 0xa9, 0xa3, 0x45, 0x06,
+	0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -337,6 +497,15 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.SigVerifyCostSecp256k1 != that1.SigVerifyCostSecp256k1 {
 		return false
 	}
+	if this.EnableAccountPruning != that1.EnableAccountPruning {
+		return false
+	}
+	if this.MaxTxsPerWindow != that1.MaxTxsPerWindow {
+		return false
+	}
+	if this.RateLimitWindow != that1.RateLimitWindow {
+		return false
+	}
 	return true
 }
 func (m *BaseAccount) Marshal() (dAtA []byte, err error) {
@@ -501,6 +670,26 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RateLimitWindow != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.RateLimitWindow))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxTxsPerWindow != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.MaxTxsPerWindow))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.EnableAccountPruning {
+		i--
+		if m.EnableAccountPruning {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.SigVerifyCostSecp256k1 != 0 {
 		i = encodeVarintAuth(dAtA, i, uint64(m.SigVerifyCostSecp256k1))
 		i--
@@ -529,6 +718,81 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PubKeyRotation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PubKeyRotation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PubKeyRotation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PubKey != nil {
+		{
+			size, err := m.PubKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuth(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountNumberAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountNumberAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountNumberAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AccountNumber != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.AccountNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAuth(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAuth(v)
 	base := offset
@@ -626,6 +890,47 @@ func (m *Params) Size() (n int) {
 	if m.SigVerifyCostSecp256k1 != 0 {
 		n += 1 + sovAuth(uint64(m.SigVerifyCostSecp256k1))
 	}
+	if m.EnableAccountPruning {
+		n += 2
+	}
+	if m.MaxTxsPerWindow != 0 {
+		n += 1 + sovAuth(uint64(m.MaxTxsPerWindow))
+	}
+	if m.RateLimitWindow != 0 {
+		n += 1 + sovAuth(uint64(m.RateLimitWindow))
+	}
+	return n
+}
+
+func (m *PubKeyRotation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PubKey != nil {
+		l = m.PubKey.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovAuth(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *AccountNumberAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AccountNumber != 0 {
+		n += 1 + sovAuth(uint64(m.AccountNumber))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
 	return n
 }
 
@@ -1179,6 +1484,270 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableAccountPruning", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableAccountPruning = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxsPerWindow", wireType)
+			}
+			m.MaxTxsPerWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTxsPerWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitWindow", wireType)
+			}
+			m.RateLimitWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RateLimitWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PubKeyRotation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PubKeyRotation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PubKeyRotation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PubKey == nil {
+				m.PubKey = &any.Any{}
+			}
+			if err := m.PubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountNumberAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountNumberAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountNumberAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountNumber", wireType)
+			}
+			m.AccountNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -22,6 +22,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&ModuleCredential{}, "cosmos-sdk/GroupAccountCredential", nil)
 
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/auth/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgRotatePubKey{}, "cosmos-sdk/x/auth/MsgRotatePubKey")
 
 	legacytx.RegisterLegacyAminoCodec(cdc)
 }
@@ -58,5 +59,6 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 
 	registrar.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgRotatePubKey{},
 	)
 }
@@ -2,13 +2,24 @@ package types
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 )
 
+// CredentialVerifier authenticates the signers whose account credential, stored
+// in place of the account public key, is not a regular public key. It lets smart
+// account modules plug their own authentication into the ante handler.
+type CredentialVerifier interface {
+	// VerifyCredential verifies the signature provided by the account for the
+	// given tx. The account credential is available through acc.GetPubKey().
+	VerifyCredential(ctx context.Context, acc sdk.AccountI, tx sdk.Tx, sig signing.SignatureV2) error
+}
+
 // NewBaseAccountWithPubKey creates an account with an a pubkey.
 func NewBaseAccountWithPubKey(pubkey cryptotypes.PubKey) (*BaseAccount, error) {
 	if pubkey == nil {
@@ -72,7 +72,39 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
-	return ValidateGenAccounts(genAccs)
+	if err := ValidateGenAccounts(genAccs); err != nil {
+		return err
+	}
+
+	return validateAccountNumbers(genAccs, data.AccountNumbers)
+}
+
+// validateAccountNumbers checks that every entry of the exported account number
+// index refers to a genesis account with the same account number.
+func validateAccountNumbers(genAccs GenesisAccounts, accountNumbers []AccountNumberAddress) error {
+	if len(accountNumbers) == 0 {
+		return nil
+	}
+
+	byAddr := make(map[string]uint64, len(genAccs))
+	for _, acc := range genAccs {
+		byAddr[acc.GetAddress().String()] = acc.GetAccountNumber()
+	}
+
+	for i, entry := range accountNumbers {
+		if i > 0 && accountNumbers[i-1].AccountNumber >= entry.AccountNumber {
+			return fmt.Errorf("account numbers are not strictly increasing: %d followed by %d", accountNumbers[i-1].AccountNumber, entry.AccountNumber)
+		}
+		accNum, ok := byAddr[entry.Address]
+		if !ok {
+			return fmt.Errorf("account number %d refers to unknown account %s", entry.AccountNumber, entry.Address)
+		}
+		if accNum != entry.AccountNumber {
+			return fmt.Errorf("account %s has account number %d, indexed as %d", entry.Address, accNum, entry.AccountNumber)
+		}
+	}
+
+	return nil
 }
 
 // SanitizeGenesisAccounts sorts accounts and coin sets.
@@ -54,6 +54,38 @@ func TestValidateGenesisDuplicateAccounts(t *testing.T) {
 	require.Error(t, types.ValidateGenAccounts(genAccs))
 }
 
+func TestValidateGenesisAccountNumbers(t *testing.T) {
+	acc1 := types.NewBaseAccountWithAddress(sdk.AccAddress(addr1))
+	require.NoError(t, acc1.SetAccountNumber(1))
+	acc2 := types.NewBaseAccountWithAddress(sdk.AccAddress(addr2))
+	require.NoError(t, acc2.SetAccountNumber(2))
+
+	testCases := []struct {
+		name           string
+		accountNumbers []types.AccountNumberAddress
+		expErr         string
+	}{
+		{"no index", nil, ""},
+		{"valid index", []types.AccountNumberAddress{{AccountNumber: 1, Address: acc1.Address}, {AccountNumber: 2, Address: acc2.Address}}, ""},
+		{"unordered index", []types.AccountNumberAddress{{AccountNumber: 2, Address: acc2.Address}, {AccountNumber: 1, Address: acc1.Address}}, "not strictly increasing"},
+		{"unknown account", []types.AccountNumberAddress{{AccountNumber: 1, Address: sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()}}, "unknown account"},
+		{"mismatched number", []types.AccountNumberAddress{{AccountNumber: 3, Address: acc1.Address}}, "indexed as 3"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			genState := types.NewGenesisState(types.DefaultParams(), types.GenesisAccounts{acc1, acc2})
+			genState.AccountNumbers = tc.accountNumbers
+			err := types.ValidateGenesis(*genState)
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
 func TestGenesisAccountIterator(t *testing.T) {
 	encodingConfig := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, auth.AppModule{})
 	cdc := encodingConfig.Codec
@@ -26,6 +26,22 @@ var (
 	// account number is stored.
 	GlobalAccountNumberKey = collections.NewPrefix(2)
 
+	// AccountPruningCursorKey identifies the prefix where the account number from
+	// which the next pruning pass resumes is stored.
+	AccountPruningCursorKey = collections.NewPrefix(3)
+
+	// PubKeyHistoryPrefix prefix for the public keys replaced by a pubkey rotation
+	PubKeyHistoryPrefix = collections.NewPrefix(4)
+
+	// ModulePermissionsPrefix prefix for the persisted module account permissions
+	ModulePermissionsPrefix = collections.NewPrefix(5)
+
+	// AccountsCountKey identifies the prefix where the number of accounts is stored.
+	AccountsCountKey = collections.NewPrefix(6)
+
+	// TxRateLimitPrefix prefix for the per account transaction rate limit counters
+	TxRateLimitPrefix = collections.NewPrefix(7)
+
 	// AccountNumberStoreKeyPrefix prefix for account-by-id store
 	AccountNumberStoreKeyPrefix = collections.NewPrefix("accountNumber")
 )
@@ -0,0 +1,27 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+var _ codectypes.UnpackInterfacesMessage = (*MsgRotatePubKey)(nil)
+
+// NewMsgRotatePubKey creates a new MsgRotatePubKey instance.
+func NewMsgRotatePubKey(signer string, newPubKey cryptotypes.PubKey) (*MsgRotatePubKey, error) {
+	pkAny, err := codectypes.NewAnyWithValue(newPubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgRotatePubKey{Signer: signer, NewPubKey: pkAny}, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgRotatePubKey) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if msg.NewPubKey == nil {
+		return nil
+	}
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(msg.NewPubKey, &pubKey)
+}
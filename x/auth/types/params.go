@@ -11,6 +11,9 @@ const (
 	DefaultTxSizeCostPerByte      uint64 = 10
 	DefaultSigVerifyCostED25519   uint64 = 590
 	DefaultSigVerifyCostSecp256k1 uint64 = 1000
+	DefaultEnableAccountPruning          = false
+	DefaultMaxTxsPerWindow        uint64 = 0
+	DefaultRateLimitWindow        uint64 = 0
 )
 
 // NewParams creates a new Params object
@@ -32,6 +35,9 @@ func DefaultParams() Params {
 		TxSizeCostPerByte:      DefaultTxSizeCostPerByte,
 		SigVerifyCostED25519:   DefaultSigVerifyCostED25519,
 		SigVerifyCostSecp256k1: DefaultSigVerifyCostSecp256k1,
+		EnableAccountPruning:   DefaultEnableAccountPruning,
+		MaxTxsPerWindow:        DefaultMaxTxsPerWindow,
+		RateLimitWindow:        DefaultRateLimitWindow,
 	}
 }
 
@@ -112,6 +118,14 @@ func validateTxSizeCostPerByte(i interface{}) error {
 	return nil
 }
 
+func validateTxRateLimit(maxTxsPerWindow, rateLimitWindow uint64) error {
+	if maxTxsPerWindow != 0 && rateLimitWindow == 0 {
+		return fmt.Errorf("invalid rate limit window: %d", rateLimitWindow)
+	}
+
+	return nil
+}
+
 // Validate checks that the parameters have valid values.
 func (p Params) Validate() error {
 	if err := validateTxSigLimit(p.TxSigLimit); err != nil {
@@ -129,6 +143,9 @@ func (p Params) Validate() error {
 	if err := validateTxSizeCostPerByte(p.TxSizeCostPerByte); err != nil {
 		return err
 	}
+	if err := validateTxRateLimit(p.MaxTxsPerWindow, p.RateLimitWindow); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -35,6 +35,10 @@ func TestParams_Validate(t *testing.T) {
 			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1), fmt.Errorf("invalid max memo characters: 0")},
 		{"invalid tx size cost per byte", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, 0,
 			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1), fmt.Errorf("invalid tx size cost per byte: 0")},
+		{"invalid rate limit window", types.Params{
+			MaxMemoCharacters: types.DefaultMaxMemoCharacters, TxSigLimit: types.DefaultTxSigLimit, TxSizeCostPerByte: types.DefaultTxSizeCostPerByte,
+			SigVerifyCostED25519: types.DefaultSigVerifyCostED25519, SigVerifyCostSecp256k1: types.DefaultSigVerifyCostSecp256k1, MaxTxsPerWindow: 1,
+		}, fmt.Errorf("invalid rate limit window: 0")},
 	}
 	for _, tt := range tests {
 		tt := tt
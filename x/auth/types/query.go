@@ -11,3 +11,14 @@ func (m *QueryAccountResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker)
 }
 
 var _ codectypes.UnpackInterfacesMessage = &QueryAccountResponse{}
+
+func (m *QueryPubKeyHistoryResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, rotation := range m.Rotations {
+		if err := rotation.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var _ codectypes.UnpackInterfacesMessage = &QueryPubKeyHistoryResponse{}
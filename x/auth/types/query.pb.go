@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -349,6 +350,11 @@ var xxx_messageInfo_QueryModuleAccountsRequest proto.InternalMessageInfo
 // Since: cosmos-sdk 0.46
 type QueryModuleAccountsResponse struct {
 	Accounts []*any.Any `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// module_accounts lists the name, address and permissions of every module
+	// account, in the same order as accounts, without requiring to unpack them.
+	//
+	// Since: cosmos-sdk 0.51
+	ModuleAccounts []ModuleAccountInfo `protobuf:"bytes,2,rep,name=module_accounts,json=moduleAccounts,proto3" json:"module_accounts"`
 }
 
 func (m *QueryModuleAccountsResponse) Reset()         { *m = QueryModuleAccountsResponse{} }
@@ -391,6 +397,79 @@ func (m *QueryModuleAccountsResponse) GetAccounts() []*any.Any {
 	return nil
 }
 
+func (m *QueryModuleAccountsResponse) GetModuleAccounts() []ModuleAccountInfo {
+	if m != nil {
+		return m.ModuleAccounts
+	}
+	return nil
+}
+
+// ModuleAccountInfo describes a registered module account.
+//
+// Since: cosmos-sdk 0.51
+type ModuleAccountInfo struct {
+	// name is the name of the module owning the account.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// address is the bech32 address of the module account.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// permissions are the permissions granted to the module account.
+	Permissions []string `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions,omitempty"`
+}
+
+func (m *ModuleAccountInfo) Reset()         { *m = ModuleAccountInfo{} }
+func (m *ModuleAccountInfo) String() string { return proto.CompactTextString(m) }
+func (*ModuleAccountInfo) ProtoMessage()    {}
+func (*ModuleAccountInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{8}
+}
+func (m *ModuleAccountInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModuleAccountInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModuleAccountInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ModuleAccountInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModuleAccountInfo.Merge(m, src)
+}
+func (m *ModuleAccountInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModuleAccountInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModuleAccountInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModuleAccountInfo proto.InternalMessageInfo
+
+func (m *ModuleAccountInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ModuleAccountInfo) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ModuleAccountInfo) GetPermissions() []string {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
 // QueryModuleAccountByNameRequest is the request type for the Query/ModuleAccountByName RPC method.
 type QueryModuleAccountByNameRequest struct {
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -400,7 +479,7 @@ func (m *QueryModuleAccountByNameRequest) Reset()         { *m = QueryModuleAcco
 func (m *QueryModuleAccountByNameRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryModuleAccountByNameRequest) ProtoMessage()    {}
 func (*QueryModuleAccountByNameRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c451370b3929a27c, []int{8}
+	return fileDescriptor_c451370b3929a27c, []int{9}
 }
 func (m *QueryModuleAccountByNameRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -445,7 +524,7 @@ func (m *QueryModuleAccountByNameResponse) Reset()         { *m = QueryModuleAcc
 func (m *QueryModuleAccountByNameResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryModuleAccountByNameResponse) ProtoMessage()    {}
 func (*QueryModuleAccountByNameResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c451370b3929a27c, []int{9}
+	return fileDescriptor_c451370b3929a27c, []int{10}
 }
 func (m *QueryModuleAccountByNameResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -491,7 +570,7 @@ func (m *Bech32PrefixRequest) Reset()         { *m = Bech32PrefixRequest{} }
 func (m *Bech32PrefixRequest) String() string { return proto.CompactTextString(m) }
 func (*Bech32PrefixRequest) ProtoMessage()    {}
 func (*Bech32PrefixRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c451370b3929a27c, []int{10}
+	return fileDescriptor_c451370b3929a27c, []int{11}
 }
 func (m *Bech32PrefixRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -531,7 +610,7 @@ func (m *Bech32PrefixResponse) Reset()         { *m = Bech32PrefixResponse{} }
 func (m *Bech32PrefixResponse) String() string { return proto.CompactTextString(m) }
 func (*Bech32PrefixResponse) ProtoMessage()    {}
 func (*Bech32PrefixResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c451370b3929a27c, []int{11}
+	return fileDescriptor_c451370b3929a27c, []int{12}
 }
 func (m *Bech32PrefixResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -578,7 +657,7 @@ func (m *AddressBytesToStringRequest) Reset()         { *m = AddressBytesToStrin
 func (m *AddressBytesToStringRequest) String() string { return proto.CompactTextString(m) }
 func (*AddressBytesToStringRequest) ProtoMessage()    {}
 func (*AddressBytesToStringRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c451370b3929a27c, []int{12}
+	return fileDescriptor_c451370b3929a27c, []int{13}
 }
 func (m *AddressBytesToStringRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -625,7 +704,7 @@ func (m *AddressBytesToStringResponse) Reset()         { *m = AddressBytesToStri
 func (m *AddressBytesToStringResponse) String() string { return proto.CompactTextString(m) }
 func (*AddressBytesToStringResponse) ProtoMessage()    {}
 func (*AddressBytesToStringResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c451370b3929a27c, []int{13}
+	return fileDescriptor_c451370b3929a27c, []int{14}
 }
 func (m *AddressBytesToStringResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -672,7 +751,7 @@ func (m *AddressStringToBytesRequest) Reset()         { *m = AddressStringToByte
 func (m *AddressStringToBytesRequest) String() string { return proto.CompactTextString(m) }
 func (*AddressStringToBytesRequest) ProtoMessage()    {}
 func (*AddressStringToBytesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c451370b3929a27c, []int{14}
+	return fileDescriptor_c451370b3929a27c, []int{15}
 }
 func (m *AddressStringToBytesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -719,7 +798,7 @@ func (m *AddressStringToBytesResponse) Reset()         { *m = AddressStringToByt
 func (m *AddressStringToBytesResponse) String() string { return proto.CompactTextString(m) }
 func (*AddressStringToBytesResponse) ProtoMessage()    {}
 func (*AddressStringToBytesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c451370b3929a27c, []int{15}
+	return fileDescriptor_c451370b3929a27c, []int{16}
 }
 func (m *AddressStringToBytesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -775,7 +854,7 @@ func (m *QueryAccountAddressByIDRequest) Reset()         { *m = QueryAccountAddr
 func (m *QueryAccountAddressByIDRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAccountAddressByIDRequest) ProtoMessage()    {}
 func (*QueryAccountAddressByIDRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c451370b3929a27c, []int{16}
+	return fileDescriptor_c451370b3929a27c, []int{17}
 }
 func (m *QueryAccountAddressByIDRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -830,7 +909,7 @@ func (m *QueryAccountAddressByIDResponse) Reset()         { *m = QueryAccountAdd
 func (m *QueryAccountAddressByIDResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAccountAddressByIDResponse) ProtoMessage()    {}
 func (*QueryAccountAddressByIDResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c451370b3929a27c, []int{17}
+	return fileDescriptor_c451370b3929a27c, []int{18}
 }
 func (m *QueryAccountAddressByIDResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -878,7 +957,7 @@ func (m *QueryAccountInfoRequest) Reset()         { *m = QueryAccountInfoRequest
 func (m *QueryAccountInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAccountInfoRequest) ProtoMessage()    {}
 func (*QueryAccountInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c451370b3929a27c, []int{18}
+	return fileDescriptor_c451370b3929a27c, []int{19}
 }
 func (m *QueryAccountInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -926,7 +1005,7 @@ func (m *QueryAccountInfoResponse) Reset()         { *m = QueryAccountInfoRespon
 func (m *QueryAccountInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAccountInfoResponse) ProtoMessage()    {}
 func (*QueryAccountInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c451370b3929a27c, []int{19}
+	return fileDescriptor_c451370b3929a27c, []int{20}
 }
 func (m *QueryAccountInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -962,369 +1041,783 @@ func (m *QueryAccountInfoResponse) GetInfo() *BaseAccount {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryAccountsRequest)(nil), "cosmos.auth.v1beta1.QueryAccountsRequest")
-	proto.RegisterType((*QueryAccountsResponse)(nil), "cosmos.auth.v1beta1.QueryAccountsResponse")
-	proto.RegisterType((*QueryAccountRequest)(nil), "cosmos.auth.v1beta1.QueryAccountRequest")
-	proto.RegisterType((*QueryAccountResponse)(nil), "cosmos.auth.v1beta1.QueryAccountResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.auth.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.auth.v1beta1.QueryParamsResponse")
-	proto.RegisterType((*QueryModuleAccountsRequest)(nil), "cosmos.auth.v1beta1.QueryModuleAccountsRequest")
-	proto.RegisterType((*QueryModuleAccountsResponse)(nil), "cosmos.auth.v1beta1.QueryModuleAccountsResponse")
-	proto.RegisterType((*QueryModuleAccountByNameRequest)(nil), "cosmos.auth.v1beta1.QueryModuleAccountByNameRequest")
-	proto.RegisterType((*QueryModuleAccountByNameResponse)(nil), "cosmos.auth.v1beta1.QueryModuleAccountByNameResponse")
-	proto.RegisterType((*Bech32PrefixRequest)(nil), "cosmos.auth.v1beta1.Bech32PrefixRequest")
-	proto.RegisterType((*Bech32PrefixResponse)(nil), "cosmos.auth.v1beta1.Bech32PrefixResponse")
-	proto.RegisterType((*AddressBytesToStringRequest)(nil), "cosmos.auth.v1beta1.AddressBytesToStringRequest")
-	proto.RegisterType((*AddressBytesToStringResponse)(nil), "cosmos.auth.v1beta1.AddressBytesToStringResponse")
-	proto.RegisterType((*AddressStringToBytesRequest)(nil), "cosmos.auth.v1beta1.AddressStringToBytesRequest")
-	proto.RegisterType((*AddressStringToBytesResponse)(nil), "cosmos.auth.v1beta1.AddressStringToBytesResponse")
-	proto.RegisterType((*QueryAccountAddressByIDRequest)(nil), "cosmos.auth.v1beta1.QueryAccountAddressByIDRequest")
-	proto.RegisterType((*QueryAccountAddressByIDResponse)(nil), "cosmos.auth.v1beta1.QueryAccountAddressByIDResponse")
-	proto.RegisterType((*QueryAccountInfoRequest)(nil), "cosmos.auth.v1beta1.QueryAccountInfoRequest")
-	proto.RegisterType((*QueryAccountInfoResponse)(nil), "cosmos.auth.v1beta1.QueryAccountInfoResponse")
+// QueryPubKeyHistoryRequest is the Query/PubKeyHistory request type.
+//
+// Since: cosmos-sdk 0.51
+type QueryPubKeyHistoryRequest struct {
+	// address is the account address string.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func init() { proto.RegisterFile("cosmos/auth/v1beta1/query.proto", fileDescriptor_c451370b3929a27c) }
-
-var fileDescriptor_c451370b3929a27c = []byte{
-	// 1056 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x97, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0xc7, 0xe3, 0x6e, 0x69, 0xbb, 0xaf, 0xd9, 0x22, 0x4d, 0xb3, 0x22, 0x38, 0x6d, 0x12, 0xb9,
-	0xd0, 0xa6, 0x65, 0x63, 0xd3, 0x24, 0x2b, 0xf1, 0xe3, 0x54, 0xb3, 0x80, 0x7a, 0x58, 0x14, 0xdc,
-	0x15, 0x42, 0x1c, 0x88, 0x9c, 0xda, 0x49, 0x2d, 0x36, 0x9e, 0x6c, 0xec, 0xc0, 0x86, 0x2a, 0x17,
-	0x24, 0xa4, 0x5e, 0x90, 0x90, 0xe0, 0x0f, 0xd8, 0x03, 0xe2, 0xbc, 0x48, 0xe5, 0xc6, 0x1f, 0xb0,
-	0xda, 0xd3, 0x0a, 0x2e, 0x9c, 0x10, 0x6a, 0x91, 0xe0, 0xc6, 0xbf, 0x80, 0x32, 0xf3, 0xec, 0xd8,
-	0xed, 0x24, 0x71, 0xd9, 0x9b, 0x33, 0xf3, 0xde, 0xf7, 0x7d, 0xe6, 0xcd, 0xb3, 0xbf, 0x2d, 0x14,
-	0x0e, 0xa9, 0xd7, 0xa1, 0x9e, 0x66, 0xf6, 0xfd, 0x23, 0xed, 0xf3, 0xdd, 0xa6, 0xed, 0x9b, 0xbb,
-	0xda, 0x83, 0xbe, 0xdd, 0x1b, 0xa8, 0xdd, 0x1e, 0xf5, 0x29, 0x59, 0xe5, 0x01, 0xea, 0x28, 0x40,
-	0xc5, 0x00, 0x79, 0x07, 0xb3, 0x9a, 0xa6, 0x67, 0xf3, 0xe8, 0x30, 0xb7, 0x6b, 0xb6, 0x1d, 0xd7,
-	0xf4, 0x1d, 0xea, 0x72, 0x01, 0x39, 0xd3, 0xa6, 0x6d, 0xca, 0x1e, 0xb5, 0xd1, 0x13, 0xae, 0xbe,
-	0xdc, 0xa6, 0xb4, 0x7d, 0xdf, 0xd6, 0xd8, 0xaf, 0x66, 0xbf, 0xa5, 0x99, 0x2e, 0x56, 0x94, 0xd7,
-	0x70, 0xcb, 0xec, 0x3a, 0x9a, 0xe9, 0xba, 0xd4, 0x67, 0x6a, 0x1e, 0xee, 0xe6, 0x45, 0xc0, 0x0c,
-	0x0e, 0x85, 0xf9, 0x7e, 0x83, 0x57, 0x44, 0x78, 0xbe, 0x95, 0xc3, 0xd4, 0x00, 0x38, 0x7a, 0x4e,
-	0xe5, 0x53, 0xc8, 0x7c, 0x38, 0xfa, 0xb9, 0x77, 0x78, 0x48, 0xfb, 0xae, 0xef, 0x19, 0xf6, 0x83,
-	0xbe, 0xed, 0xf9, 0xe4, 0x3d, 0x80, 0xf1, 0x91, 0xb2, 0x52, 0x51, 0x2a, 0x2d, 0x57, 0x36, 0x55,
-	0xd4, 0x1d, 0x9d, 0x5f, 0xe5, 0x2a, 0x88, 0xa2, 0xd6, 0xcd, 0xb6, 0x8d, 0xb9, 0x46, 0x24, 0x53,
-	0x39, 0x95, 0xe0, 0xe6, 0x85, 0x02, 0x5e, 0x97, 0xba, 0x9e, 0x4d, 0x0c, 0x58, 0x32, 0x71, 0x2d,
-	0x2b, 0x15, 0xaf, 0x95, 0x96, 0x2b, 0x19, 0x95, 0xb7, 0x40, 0x0d, 0xba, 0xa3, 0xee, 0xb9, 0x03,
-	0xbd, 0xf8, 0xf4, 0xb4, 0xbc, 0x26, 0xb8, 0x0d, 0x15, 0x15, 0xf7, 0x8d, 0x50, 0x87, 0xbc, 0x1f,
-	0xa3, 0x9e, 0x63, 0xd4, 0x5b, 0x33, 0xa9, 0x39, 0x50, 0x0c, 0xfb, 0x00, 0x56, 0xa3, 0xd4, 0x41,
-	0x57, 0x2a, 0xb0, 0x68, 0x5a, 0x56, 0xcf, 0xf6, 0x3c, 0xd6, 0x92, 0xeb, 0x7a, 0xf6, 0xd7, 0xd3,
-	0x72, 0x06, 0xf5, 0xf7, 0xf8, 0xce, 0x81, 0xdf, 0x73, 0xdc, 0xb6, 0x11, 0x04, 0xbe, 0xb5, 0x74,
-	0xf2, 0xa8, 0x90, 0xfa, 0xe7, 0x51, 0x21, 0xa5, 0x1c, 0xc5, 0x7b, 0x1d, 0x76, 0xa2, 0x0e, 0x8b,
-	0x78, 0x02, 0x6c, 0xf4, 0xff, 0x6d, 0x44, 0x20, 0xa3, 0x64, 0x80, 0xb0, 0x4a, 0x75, 0xb3, 0x67,
-	0x76, 0x82, 0x3b, 0x55, 0xea, 0x78, 0xa8, 0x60, 0x15, 0xcb, 0xbf, 0x09, 0x0b, 0x5d, 0xb6, 0x82,
-	0xd5, 0x73, 0xaa, 0xa8, 0x08, 0x4f, 0xd2, 0xe7, 0x9f, 0xfc, 0x51, 0x48, 0x19, 0x98, 0xa0, 0xac,
-	0x81, 0xcc, 0x14, 0xef, 0x52, 0xab, 0x7f, 0xdf, 0xbe, 0x30, 0x43, 0xca, 0x17, 0x90, 0x13, 0xee,
-	0x62, 0xdd, 0x8f, 0x13, 0x0e, 0xc0, 0xe6, 0xd3, 0xd3, 0xb2, 0x22, 0x42, 0x8a, 0xe9, 0x46, 0xc6,
-	0x40, 0xb9, 0x0d, 0x85, 0xcb, 0x85, 0xf5, 0xc1, 0x07, 0x66, 0x27, 0x98, 0x51, 0x42, 0x60, 0xde,
-	0x35, 0x3b, 0x36, 0xbf, 0x46, 0x83, 0x3d, 0x2b, 0x5f, 0x42, 0x71, 0x72, 0x1a, 0x42, 0x7f, 0x94,
-	0xec, 0xae, 0x92, 0x32, 0x87, 0x37, 0x76, 0x13, 0x56, 0x75, 0xfb, 0xf0, 0xa8, 0x5a, 0xa9, 0xf7,
-	0xec, 0x96, 0xf3, 0x30, 0x68, 0xe1, 0xdb, 0x90, 0x89, 0x2f, 0x23, 0xc6, 0x06, 0xdc, 0x68, 0xb2,
-	0xf5, 0x46, 0x97, 0x6d, 0xe0, 0x39, 0xd2, 0xcd, 0x48, 0xb0, 0xa2, 0x43, 0x0e, 0x67, 0x52, 0x1f,
-	0xf8, 0xb6, 0x77, 0x8f, 0xe2, 0x68, 0x62, 0x0b, 0x36, 0xe0, 0x06, 0xce, 0x68, 0xa3, 0x39, 0xda,
-	0x67, 0x1a, 0x69, 0x23, 0x6d, 0x46, 0x72, 0x94, 0x77, 0x61, 0x4d, 0xac, 0x81, 0x20, 0xaf, 0xc2,
-	0x4a, 0x20, 0xe2, 0xb1, 0x1d, 0x24, 0x09, 0xa4, 0x79, 0xb8, 0x72, 0x27, 0x44, 0xe1, 0x0b, 0xf7,
-	0x28, 0x93, 0x0b, 0x50, 0x12, 0xaa, 0xbc, 0x13, 0xc2, 0x5c, 0x50, 0x19, 0x77, 0x65, 0xf6, 0x89,
-	0x0e, 0x20, 0x1f, 0x7d, 0x0b, 0xc3, 0xd3, 0xed, 0xdf, 0x19, 0xcf, 0xc6, 0x9c, 0x63, 0xb1, 0xdc,
-	0x6b, 0xfa, 0x5c, 0x56, 0x32, 0xe6, 0x1c, 0x8b, 0xac, 0x03, 0xe0, 0x55, 0x35, 0x1c, 0x8b, 0x7d,
-	0x59, 0xe6, 0x8d, 0xeb, 0xb8, 0xb2, 0x6f, 0x29, 0x16, 0x4e, 0x9c, 0x48, 0x14, 0xe1, 0xf6, 0xe0,
-	0xc5, 0x40, 0x21, 0xe9, 0x37, 0x64, 0xc5, 0x8c, 0xc9, 0x29, 0x77, 0xe1, 0xa5, 0x68, 0x95, 0x7d,
-	0xb7, 0x45, 0x9f, 0xe3, 0xcb, 0xa4, 0xd4, 0x21, 0x7b, 0x59, 0x0e, 0x69, 0x6b, 0x30, 0xef, 0xb8,
-	0x2d, 0x8a, 0x43, 0x5e, 0x14, 0x7e, 0x12, 0x74, 0xd3, 0x0b, 0x26, 0xd9, 0x60, 0xd1, 0x95, 0x7f,
-	0xd3, 0xf0, 0x02, 0x93, 0x24, 0xdf, 0x48, 0xb0, 0x14, 0xbc, 0xf1, 0x64, 0x5b, 0x98, 0x2e, 0xf2,
-	0x1d, 0x79, 0x27, 0x49, 0x28, 0x67, 0x54, 0x76, 0x4e, 0xfe, 0x7e, 0xbc, 0x23, 0x7d, 0xf5, 0xdb,
-	0x5f, 0xdf, 0xcd, 0x15, 0xc8, 0xba, 0x26, 0x74, 0xc8, 0x00, 0xe1, 0x7b, 0x09, 0x16, 0x51, 0x80,
-	0x94, 0x66, 0xd6, 0x08, 0x68, 0xb6, 0x13, 0x44, 0x22, 0x4c, 0x6d, 0x0c, 0xb3, 0x4d, 0xb6, 0xa6,
-	0xc2, 0x68, 0xc7, 0x78, 0x03, 0x43, 0xf2, 0xb3, 0x04, 0xe4, 0xf2, 0xcc, 0x90, 0xea, 0xcc, 0xba,
-	0x97, 0xc7, 0x56, 0xae, 0x5d, 0x2d, 0xe9, 0x0a, 0xdc, 0xe1, 0x3b, 0xd5, 0x70, 0x2c, 0xed, 0xd8,
-	0xb1, 0x86, 0xe4, 0x6b, 0x09, 0x16, 0xb8, 0x23, 0x90, 0xad, 0xc9, 0x65, 0x63, 0xf6, 0x23, 0x97,
-	0x66, 0x07, 0x22, 0x53, 0x69, 0xcc, 0xb4, 0x4e, 0x72, 0x42, 0x26, 0x6e, 0x40, 0xe4, 0x47, 0x09,
-	0x56, 0xe2, 0xf6, 0x42, 0xb4, 0xc9, 0x65, 0x84, 0x36, 0x25, 0xbf, 0x9e, 0x3c, 0x01, 0xf9, 0x76,
-	0xc7, 0x7c, 0x9b, 0xe4, 0x15, 0x21, 0x5f, 0x87, 0x65, 0x36, 0xc2, 0xf9, 0xfb, 0x45, 0x82, 0x55,
-	0x81, 0xaf, 0x90, 0x5a, 0xc2, 0xe2, 0x31, 0xf7, 0x92, 0x6f, 0x5f, 0x31, 0x0b, 0xb9, 0xdf, 0x18,
-	0x73, 0x97, 0xc9, 0x6b, 0x49, 0xb8, 0xb5, 0xe3, 0x91, 0x33, 0x0e, 0xc9, 0x89, 0x04, 0xe9, 0xa8,
-	0x11, 0x4d, 0x78, 0x87, 0x04, 0x16, 0x36, 0xe1, 0x1d, 0x12, 0xb9, 0x9a, 0xb2, 0x31, 0xf5, 0xca,
-	0xb9, 0xb7, 0x91, 0xc7, 0x12, 0x64, 0x44, 0x96, 0x44, 0xc4, 0xf7, 0x38, 0xc5, 0x01, 0xe5, 0xdd,
-	0x2b, 0x64, 0x20, 0x62, 0x75, 0x6a, 0xf7, 0x38, 0x62, 0xf8, 0x7e, 0x73, 0x17, 0x1a, 0x92, 0x9f,
-	0xc6, 0xc8, 0x31, 0xe3, 0x9a, 0x8e, 0x2c, 0x72, 0xca, 0xe9, 0xc8, 0x42, 0x57, 0x54, 0x6a, 0x0c,
-	0x59, 0x25, 0xb7, 0x12, 0x21, 0x73, 0xff, 0x1d, 0x92, 0x1f, 0x24, 0x58, 0x8e, 0x18, 0x03, 0xb9,
-	0x35, 0xf3, 0xeb, 0x12, 0xb1, 0x23, 0xb9, 0x9c, 0x30, 0x3a, 0xf9, 0x60, 0x86, 0xee, 0xeb, 0xb6,
-	0xe8, 0xf8, 0x03, 0xaa, 0x57, 0x9f, 0x9c, 0xe5, 0xa5, 0x67, 0x67, 0x79, 0xe9, 0xcf, 0xb3, 0xbc,
-	0xf4, 0xed, 0x79, 0x3e, 0xf5, 0xec, 0x3c, 0x9f, 0xfa, 0xfd, 0x3c, 0x9f, 0xfa, 0x04, 0xff, 0x23,
-	0xf2, 0xac, 0xcf, 0x54, 0x87, 0x6a, 0x0f, 0xb9, 0x9a, 0x3f, 0xe8, 0xda, 0x5e, 0x73, 0x81, 0xfd,
-	0xad, 0x56, 0xfd, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x0e, 0xde, 0xbd, 0x53, 0x06, 0x0e, 0x00, 0x00,
+func (m *QueryPubKeyHistoryRequest) Reset()         { *m = QueryPubKeyHistoryRequest{} }
+func (m *QueryPubKeyHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPubKeyHistoryRequest) ProtoMessage()    {}
+func (*QueryPubKeyHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{21}
+}
+func (m *QueryPubKeyHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPubKeyHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPubKeyHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPubKeyHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPubKeyHistoryRequest.Merge(m, src)
+}
+func (m *QueryPubKeyHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPubKeyHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPubKeyHistoryRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryPubKeyHistoryRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Accounts returns all the existing accounts.
-	//
-	// When called from another module, this query might consume a high amount of
-	// gas if the pagination field is incorrectly set.
-	//
-	// Since: cosmos-sdk 0.43
-	Accounts(ctx context.Context, in *QueryAccountsRequest, opts ...grpc.CallOption) (*QueryAccountsResponse, error)
-	// Account returns account details based on address.
-	Account(ctx context.Context, in *QueryAccountRequest, opts ...grpc.CallOption) (*QueryAccountResponse, error)
-	// AccountAddressByID returns account address based on account number.
-	//
-	// Since: cosmos-sdk 0.46.2
-	AccountAddressByID(ctx context.Context, in *QueryAccountAddressByIDRequest, opts ...grpc.CallOption) (*QueryAccountAddressByIDResponse, error)
-	// Params queries all parameters.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// ModuleAccounts returns all the existing module accounts.
-	//
-	// Since: cosmos-sdk 0.46
-	ModuleAccounts(ctx context.Context, in *QueryModuleAccountsRequest, opts ...grpc.CallOption) (*QueryModuleAccountsResponse, error)
-	// ModuleAccountByName returns the module account info by module name
-	ModuleAccountByName(ctx context.Context, in *QueryModuleAccountByNameRequest, opts ...grpc.CallOption) (*QueryModuleAccountByNameResponse, error)
-	// Bech32Prefix queries bech32Prefix
-	//
-	// Since: cosmos-sdk 0.46
-	Bech32Prefix(ctx context.Context, in *Bech32PrefixRequest, opts ...grpc.CallOption) (*Bech32PrefixResponse, error)
-	// AddressBytesToString converts Account Address bytes to string
-	//
-	// Since: cosmos-sdk 0.46
-	AddressBytesToString(ctx context.Context, in *AddressBytesToStringRequest, opts ...grpc.CallOption) (*AddressBytesToStringResponse, error)
-	// AddressStringToBytes converts Address string to bytes
-	//
-	// Since: cosmos-sdk 0.46
-	AddressStringToBytes(ctx context.Context, in *AddressStringToBytesRequest, opts ...grpc.CallOption) (*AddressStringToBytesResponse, error)
-	// AccountInfo queries account info which is common to all account types.
-	//
-	// Since: cosmos-sdk 0.47
-	AccountInfo(ctx context.Context, in *QueryAccountInfoRequest, opts ...grpc.CallOption) (*QueryAccountInfoResponse, error)
+func (m *QueryPubKeyHistoryRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryPubKeyHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+// QueryPubKeyHistoryResponse is the Query/PubKeyHistory response type.
+//
+// Since: cosmos-sdk 0.51
+type QueryPubKeyHistoryResponse struct {
+	// rotations are the replaced public keys, oldest first.
+	Rotations []PubKeyRotation `protobuf:"bytes,1,rep,name=rotations,proto3" json:"rotations"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) Accounts(ctx context.Context, in *QueryAccountsRequest, opts ...grpc.CallOption) (*QueryAccountsResponse, error) {
-	out := new(QueryAccountsResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/Accounts", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPubKeyHistoryResponse) Reset()         { *m = QueryPubKeyHistoryResponse{} }
+func (m *QueryPubKeyHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPubKeyHistoryResponse) ProtoMessage()    {}
+func (*QueryPubKeyHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{22}
+}
+func (m *QueryPubKeyHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPubKeyHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPubKeyHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryPubKeyHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPubKeyHistoryResponse.Merge(m, src)
+}
+func (m *QueryPubKeyHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPubKeyHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPubKeyHistoryResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Account(ctx context.Context, in *QueryAccountRequest, opts ...grpc.CallOption) (*QueryAccountResponse, error) {
-	out := new(QueryAccountResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/Account", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPubKeyHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryPubKeyHistoryResponse) GetRotations() []PubKeyRotation {
+	if m != nil {
+		return m.Rotations
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) AccountAddressByID(ctx context.Context, in *QueryAccountAddressByIDRequest, opts ...grpc.CallOption) (*QueryAccountAddressByIDResponse, error) {
-	out := new(QueryAccountAddressByIDResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/AccountAddressByID", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPubKeyHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryAccountsByNumberRangeRequest is the Query/AccountsByNumberRange request type.
+//
+// Since: cosmos-sdk 0.51
+type QueryAccountsByNumberRangeRequest struct {
+	// start is the first account number of the range (inclusive).
+	Start uint64 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	// end is the last account number of the range (exclusive). Zero means no upper bound.
+	End uint64 `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) ModuleAccounts(ctx context.Context, in *QueryModuleAccountsRequest, opts ...grpc.CallOption) (*QueryModuleAccountsResponse, error) {
-	out := new(QueryModuleAccountsResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/ModuleAccounts", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAccountsByNumberRangeRequest) Reset()         { *m = QueryAccountsByNumberRangeRequest{} }
+func (m *QueryAccountsByNumberRangeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountsByNumberRangeRequest) ProtoMessage()    {}
+func (*QueryAccountsByNumberRangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{23}
+}
+func (m *QueryAccountsByNumberRangeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountsByNumberRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountsByNumberRangeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAccountsByNumberRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountsByNumberRangeRequest.Merge(m, src)
+}
+func (m *QueryAccountsByNumberRangeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountsByNumberRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountsByNumberRangeRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) ModuleAccountByName(ctx context.Context, in *QueryModuleAccountByNameRequest, opts ...grpc.CallOption) (*QueryModuleAccountByNameResponse, error) {
-	out := new(QueryModuleAccountByNameResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/ModuleAccountByName", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAccountsByNumberRangeRequest proto.InternalMessageInfo
+
+func (m *QueryAccountsByNumberRangeRequest) GetStart() uint64 {
+	if m != nil {
+		return m.Start
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) Bech32Prefix(ctx context.Context, in *Bech32PrefixRequest, opts ...grpc.CallOption) (*Bech32PrefixResponse, error) {
-	out := new(Bech32PrefixResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/Bech32Prefix", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAccountsByNumberRangeRequest) GetEnd() uint64 {
+	if m != nil {
+		return m.End
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) AddressBytesToString(ctx context.Context, in *AddressBytesToStringRequest, opts ...grpc.CallOption) (*AddressBytesToStringResponse, error) {
-	out := new(AddressBytesToStringResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/AddressBytesToString", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAccountsByNumberRangeRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) AddressStringToBytes(ctx context.Context, in *AddressStringToBytesRequest, opts ...grpc.CallOption) (*AddressStringToBytesResponse, error) {
-	out := new(AddressStringToBytesResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/AddressStringToBytes", in, out, opts...)
-	if err != nil {
-		return nil, err
+// QueryAccountsByNumberRangeResponse is the Query/AccountsByNumberRange response type.
+//
+// Since: cosmos-sdk 0.51
+type QueryAccountsByNumberRangeResponse struct {
+	// accounts are the account number to address mappings in the range.
+	Accounts []AccountNumberAddress `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAccountsByNumberRangeResponse) Reset()         { *m = QueryAccountsByNumberRangeResponse{} }
+func (m *QueryAccountsByNumberRangeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountsByNumberRangeResponse) ProtoMessage()    {}
+func (*QueryAccountsByNumberRangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{24}
+}
+func (m *QueryAccountsByNumberRangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountsByNumberRangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountsByNumberRangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAccountsByNumberRangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountsByNumberRangeResponse.Merge(m, src)
+}
+func (m *QueryAccountsByNumberRangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountsByNumberRangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountsByNumberRangeResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) AccountInfo(ctx context.Context, in *QueryAccountInfoRequest, opts ...grpc.CallOption) (*QueryAccountInfoResponse, error) {
-	out := new(QueryAccountInfoResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/AccountInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAccountsByNumberRangeResponse proto.InternalMessageInfo
+
+func (m *QueryAccountsByNumberRangeResponse) GetAccounts() []AccountNumberAddress {
+	if m != nil {
+		return m.Accounts
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
+func (m *QueryAccountsByNumberRangeResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAccountsCountRequest is the Query/AccountsCount request type.
+//
+// Since: cosmos-sdk 0.51
+type QueryAccountsCountRequest struct {
+}
+
+func (m *QueryAccountsCountRequest) Reset()         { *m = QueryAccountsCountRequest{} }
+func (m *QueryAccountsCountRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountsCountRequest) ProtoMessage()    {}
+func (*QueryAccountsCountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{25}
+}
+func (m *QueryAccountsCountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountsCountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountsCountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountsCountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountsCountRequest.Merge(m, src)
+}
+func (m *QueryAccountsCountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountsCountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountsCountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountsCountRequest proto.InternalMessageInfo
+
+// QueryAccountsCountResponse is the Query/AccountsCount response type.
+//
+// Since: cosmos-sdk 0.51
+type QueryAccountsCountResponse struct {
+	// count is the number of accounts stored in state.
+	Count uint64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *QueryAccountsCountResponse) Reset()         { *m = QueryAccountsCountResponse{} }
+func (m *QueryAccountsCountResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountsCountResponse) ProtoMessage()    {}
+func (*QueryAccountsCountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{26}
+}
+func (m *QueryAccountsCountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountsCountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountsCountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountsCountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountsCountResponse.Merge(m, src)
+}
+func (m *QueryAccountsCountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountsCountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountsCountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountsCountResponse proto.InternalMessageInfo
+
+func (m *QueryAccountsCountResponse) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*QueryAccountsRequest)(nil), "cosmos.auth.v1beta1.QueryAccountsRequest")
+	proto.RegisterType((*QueryAccountsResponse)(nil), "cosmos.auth.v1beta1.QueryAccountsResponse")
+	proto.RegisterType((*QueryAccountRequest)(nil), "cosmos.auth.v1beta1.QueryAccountRequest")
+	proto.RegisterType((*QueryAccountResponse)(nil), "cosmos.auth.v1beta1.QueryAccountResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.auth.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.auth.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryModuleAccountsRequest)(nil), "cosmos.auth.v1beta1.QueryModuleAccountsRequest")
+	proto.RegisterType((*QueryModuleAccountsResponse)(nil), "cosmos.auth.v1beta1.QueryModuleAccountsResponse")
+	proto.RegisterType((*ModuleAccountInfo)(nil), "cosmos.auth.v1beta1.ModuleAccountInfo")
+	proto.RegisterType((*QueryModuleAccountByNameRequest)(nil), "cosmos.auth.v1beta1.QueryModuleAccountByNameRequest")
+	proto.RegisterType((*QueryModuleAccountByNameResponse)(nil), "cosmos.auth.v1beta1.QueryModuleAccountByNameResponse")
+	proto.RegisterType((*Bech32PrefixRequest)(nil), "cosmos.auth.v1beta1.Bech32PrefixRequest")
+	proto.RegisterType((*Bech32PrefixResponse)(nil), "cosmos.auth.v1beta1.Bech32PrefixResponse")
+	proto.RegisterType((*AddressBytesToStringRequest)(nil), "cosmos.auth.v1beta1.AddressBytesToStringRequest")
+	proto.RegisterType((*AddressBytesToStringResponse)(nil), "cosmos.auth.v1beta1.AddressBytesToStringResponse")
+	proto.RegisterType((*AddressStringToBytesRequest)(nil), "cosmos.auth.v1beta1.AddressStringToBytesRequest")
+	proto.RegisterType((*AddressStringToBytesResponse)(nil), "cosmos.auth.v1beta1.AddressStringToBytesResponse")
+	proto.RegisterType((*QueryAccountAddressByIDRequest)(nil), "cosmos.auth.v1beta1.QueryAccountAddressByIDRequest")
+	proto.RegisterType((*QueryAccountAddressByIDResponse)(nil), "cosmos.auth.v1beta1.QueryAccountAddressByIDResponse")
+	proto.RegisterType((*QueryAccountInfoRequest)(nil), "cosmos.auth.v1beta1.QueryAccountInfoRequest")
+	proto.RegisterType((*QueryAccountInfoResponse)(nil), "cosmos.auth.v1beta1.QueryAccountInfoResponse")
+	proto.RegisterType((*QueryPubKeyHistoryRequest)(nil), "cosmos.auth.v1beta1.QueryPubKeyHistoryRequest")
+	proto.RegisterType((*QueryPubKeyHistoryResponse)(nil), "cosmos.auth.v1beta1.QueryPubKeyHistoryResponse")
+	proto.RegisterType((*QueryAccountsByNumberRangeRequest)(nil), "cosmos.auth.v1beta1.QueryAccountsByNumberRangeRequest")
+	proto.RegisterType((*QueryAccountsByNumberRangeResponse)(nil), "cosmos.auth.v1beta1.QueryAccountsByNumberRangeResponse")
+	proto.RegisterType((*QueryAccountsCountRequest)(nil), "cosmos.auth.v1beta1.QueryAccountsCountRequest")
+	proto.RegisterType((*QueryAccountsCountResponse)(nil), "cosmos.auth.v1beta1.QueryAccountsCountResponse")
+}
+
+func init() { proto.RegisterFile("cosmos/auth/v1beta1/query.proto", fileDescriptor_c451370b3929a27c) }
+
+var fileDescriptor_c451370b3929a27c = []byte{
+	// 1378 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0x38, 0xe9, 0x47, 0x5e, 0x3e, 0x4a, 0x27, 0xae, 0x48, 0x37, 0xa9, 0x63, 0x26, 0x34,
+	0x4d, 0x43, 0xb3, 0xdb, 0x7c, 0x94, 0xaf, 0x9e, 0xe2, 0x96, 0x8f, 0x0a, 0x5a, 0x99, 0x6d, 0x41,
+	0x88, 0x03, 0xd6, 0x3a, 0xde, 0x38, 0xab, 0xd6, 0xbb, 0xae, 0x77, 0x8d, 0x6a, 0x2a, 0x5f, 0x90,
+	0x2a, 0xf5, 0x82, 0x84, 0x54, 0x24, 0xae, 0x15, 0x42, 0x88, 0x0b, 0x52, 0x11, 0xe1, 0x86, 0xe0,
+	0x5a, 0xf5, 0x80, 0x2a, 0xb8, 0x70, 0x42, 0xa8, 0x45, 0x82, 0x3f, 0x03, 0xed, 0xcc, 0xdb, 0x2f,
+	0x67, 0xb2, 0x5e, 0xb7, 0x70, 0xa9, 0xd6, 0x33, 0xef, 0xfd, 0xde, 0xef, 0xbd, 0x79, 0xf3, 0xe6,
+	0x97, 0xc2, 0xdc, 0xa6, 0xe3, 0x36, 0x1c, 0x57, 0x33, 0xda, 0xde, 0xb6, 0xf6, 0xd1, 0x4a, 0xd5,
+	0xf4, 0x8c, 0x15, 0xed, 0x7a, 0xdb, 0x6c, 0x75, 0xd4, 0x66, 0xcb, 0xf1, 0x1c, 0x3a, 0x25, 0x0c,
+	0x54, 0xdf, 0x40, 0x45, 0x03, 0x65, 0x09, 0xbd, 0xaa, 0x86, 0x6b, 0x0a, 0xeb, 0xd0, 0xb7, 0x69,
+	0xd4, 0x2d, 0xdb, 0xf0, 0x2c, 0xc7, 0x16, 0x00, 0x4a, 0xbe, 0xee, 0xd4, 0x1d, 0xfe, 0xa9, 0xf9,
+	0x5f, 0xb8, 0x7a, 0xb4, 0xee, 0x38, 0xf5, 0x6b, 0xa6, 0xc6, 0x7f, 0x55, 0xdb, 0x5b, 0x9a, 0x61,
+	0x63, 0x44, 0x65, 0x16, 0xb7, 0x8c, 0xa6, 0xa5, 0x19, 0xb6, 0xed, 0x78, 0x1c, 0xcd, 0xc5, 0xdd,
+	0x82, 0x8c, 0x30, 0x27, 0x87, 0xc0, 0x62, 0xbf, 0x22, 0x22, 0x22, 0x79, 0xb1, 0x35, 0x83, 0xae,
+	0x01, 0xe1, 0x78, 0x9e, 0xca, 0x61, 0xa3, 0x61, 0xd9, 0x8e, 0xc6, 0xff, 0x15, 0x4b, 0xec, 0x43,
+	0xc8, 0xbf, 0xe3, 0x5b, 0x6c, 0x6c, 0x6e, 0x3a, 0x6d, 0xdb, 0x73, 0x75, 0xf3, 0x7a, 0xdb, 0x74,
+	0x3d, 0xfa, 0x3a, 0x40, 0x94, 0xe5, 0x34, 0x29, 0x92, 0xc5, 0xb1, 0xd5, 0x05, 0x15, 0x43, 0xf9,
+	0x25, 0x51, 0x05, 0x30, 0xb2, 0x53, 0xcb, 0x46, 0xdd, 0x44, 0x5f, 0x3d, 0xe6, 0xc9, 0x76, 0x08,
+	0x1c, 0xe9, 0x09, 0xe0, 0x36, 0x1d, 0xdb, 0x35, 0xa9, 0x0e, 0x07, 0x0d, 0x5c, 0x9b, 0x26, 0xc5,
+	0xe1, 0xc5, 0xb1, 0xd5, 0xbc, 0x2a, 0xaa, 0xa2, 0x06, 0x05, 0x53, 0x37, 0xec, 0x4e, 0xa9, 0xf8,
+	0x60, 0x67, 0x79, 0x56, 0x72, 0x40, 0x2a, 0x22, 0x5e, 0xd0, 0x43, 0x1c, 0xfa, 0x46, 0x82, 0x75,
+	0x8e, 0xb3, 0x3e, 0xd1, 0x97, 0xb5, 0x20, 0x94, 0xa0, 0x7d, 0x19, 0xa6, 0xe2, 0xac, 0x83, 0xaa,
+	0xac, 0xc2, 0x01, 0xa3, 0x56, 0x6b, 0x99, 0xae, 0xcb, 0x4b, 0x32, 0x5a, 0x9a, 0xfe, 0x75, 0x67,
+	0x39, 0x8f, 0xf8, 0x1b, 0x62, 0xe7, 0xb2, 0xd7, 0xb2, 0xec, 0xba, 0x1e, 0x18, 0xbe, 0x7a, 0xf0,
+	0xf6, 0xdd, 0xb9, 0xa1, 0x7f, 0xee, 0xce, 0x0d, 0xb1, 0xed, 0x64, 0xad, 0xc3, 0x4a, 0x94, 0xe1,
+	0x00, 0x66, 0x80, 0x85, 0x7e, 0xd2, 0x42, 0x04, 0x30, 0x2c, 0x0f, 0x94, 0x47, 0x2a, 0x1b, 0x2d,
+	0xa3, 0x11, 0x9c, 0x29, 0x2b, 0x63, 0x52, 0xc1, 0x2a, 0x86, 0x7f, 0x05, 0xf6, 0x37, 0xf9, 0x0a,
+	0x46, 0x9f, 0x51, 0x65, 0x41, 0x84, 0x53, 0x69, 0xe4, 0xfe, 0x1f, 0x73, 0x43, 0x3a, 0x3a, 0xb0,
+	0x59, 0x50, 0x38, 0xe2, 0x45, 0xa7, 0xd6, 0xbe, 0x66, 0xf6, 0xf4, 0x10, 0xfb, 0x85, 0xc0, 0x8c,
+	0x74, 0x1b, 0x03, 0xbf, 0x9f, 0xb1, 0x03, 0x16, 0x1e, 0xec, 0x2c, 0x33, 0x19, 0xa7, 0x04, 0x6e,
+	0xbc, 0x0f, 0xde, 0x85, 0x43, 0x0d, 0xbe, 0x57, 0x09, 0x03, 0xe4, 0x78, 0x80, 0x05, 0xb5, 0x3f,
+	0x8e, 0xbd, 0xe5, 0x60, 0x9a, 0x93, 0x8d, 0x04, 0x71, 0xd6, 0x85, 0xc3, 0xbb, 0x4c, 0x29, 0x85,
+	0x11, 0xdb, 0x68, 0x98, 0xa2, 0x21, 0x74, 0xfe, 0x1d, 0xef, 0x93, 0x5c, 0xc6, 0x3e, 0xa1, 0x45,
+	0x18, 0x6b, 0x9a, 0xad, 0x86, 0xe5, 0xba, 0xfe, 0x24, 0x98, 0x1e, 0x2e, 0x0e, 0x2f, 0x8e, 0xea,
+	0xf1, 0x25, 0x76, 0x06, 0xe6, 0x76, 0x97, 0xb3, 0xd4, 0xb9, 0x64, 0x34, 0x82, 0xab, 0x27, 0x23,
+	0xc3, 0x3e, 0x86, 0xe2, 0xde, 0x6e, 0x78, 0x14, 0xef, 0x65, 0x6b, 0xc1, 0xac, 0x27, 0x11, 0x36,
+	0xe2, 0x11, 0x98, 0x2a, 0x99, 0x9b, 0xdb, 0x6b, 0xab, 0xe5, 0x96, 0xb9, 0x65, 0xdd, 0x08, 0x3a,
+	0xe3, 0x2c, 0xe4, 0x93, 0xcb, 0x48, 0x63, 0x1e, 0x26, 0xaa, 0x7c, 0xbd, 0xd2, 0xe4, 0x1b, 0x98,
+	0xc7, 0x78, 0x35, 0x66, 0xcc, 0x4a, 0x30, 0x83, 0x25, 0x2c, 0x75, 0x3c, 0xd3, 0xbd, 0xe2, 0x60,
+	0x25, 0xb1, 0x04, 0xf3, 0x30, 0x81, 0x25, 0xad, 0x54, 0xfd, 0x7d, 0x8e, 0x31, 0xae, 0x8f, 0x1b,
+	0x31, 0x1f, 0xf6, 0x1a, 0xcc, 0xca, 0x31, 0x90, 0xc8, 0x71, 0x98, 0x0c, 0x40, 0x5c, 0xbe, 0x83,
+	0x4c, 0x02, 0x68, 0x61, 0xce, 0xce, 0x87, 0x54, 0xc4, 0xc2, 0x15, 0x87, 0xc3, 0x05, 0x54, 0x32,
+	0xa2, 0x9c, 0x0b, 0xc9, 0xf4, 0xa0, 0x44, 0x55, 0xe9, 0x9f, 0xd1, 0x65, 0x28, 0xc4, 0x87, 0x4b,
+	0x98, 0xdd, 0x85, 0xf3, 0x51, 0x6f, 0xe4, 0xac, 0x1a, 0xf7, 0x1d, 0x2e, 0xe5, 0xa6, 0x89, 0x9e,
+	0xb3, 0x6a, 0xf4, 0x18, 0x00, 0x1e, 0x55, 0xc5, 0xaa, 0xf1, 0x5e, 0x1d, 0xd1, 0x47, 0x71, 0xe5,
+	0x42, 0x8d, 0xd5, 0xb0, 0xe3, 0x64, 0xa0, 0x48, 0x6e, 0x03, 0x0e, 0x05, 0x08, 0x59, 0x47, 0xe3,
+	0xa4, 0x91, 0x80, 0x63, 0x17, 0xe1, 0xd9, 0x78, 0x14, 0xff, 0x56, 0x3d, 0xc5, 0xc0, 0x65, 0x65,
+	0x98, 0xde, 0x0d, 0x87, 0x6c, 0xd7, 0x61, 0xc4, 0xb2, 0xb7, 0x1c, 0x6c, 0xf2, 0xa2, 0x74, 0x1a,
+	0x94, 0x0c, 0x37, 0xe8, 0x64, 0x9d, 0x5b, 0xb3, 0x2f, 0x08, 0x1c, 0x15, 0x93, 0xb3, 0x5d, 0x7d,
+	0xcb, 0xec, 0xbc, 0x69, 0xb9, 0x9e, 0xd3, 0xea, 0x3c, 0x05, 0xc7, 0x9e, 0xe7, 0x35, 0xf7, 0xc4,
+	0xcf, 0xeb, 0xf7, 0x04, 0x27, 0x70, 0x0f, 0x33, 0x4c, 0xf7, 0x6d, 0x18, 0x6d, 0x05, 0xda, 0x02,
+	0x47, 0xec, 0xbc, 0x7c, 0xba, 0x73, 0x77, 0x1d, 0x6d, 0x4b, 0xa3, 0xfe, 0xf8, 0xfb, 0xe6, 0xef,
+	0x7b, 0x4b, 0x44, 0x8f, 0x00, 0xfe, 0xbb, 0xd7, 0xf5, 0x0e, 0x81, 0xe7, 0x12, 0xa2, 0xa0, 0xd4,
+	0xb9, 0xd4, 0x6e, 0x54, 0xcd, 0x96, 0x6e, 0xd8, 0x61, 0x9e, 0x34, 0x0f, 0xfb, 0x5c, 0xcf, 0x68,
+	0x89, 0x89, 0x34, 0xa2, 0x8b, 0x1f, 0xf4, 0x19, 0x18, 0x36, 0xed, 0xa0, 0x55, 0xfd, 0xcf, 0x9e,
+	0x5a, 0x0e, 0x3f, 0x71, 0x2d, 0x7f, 0x26, 0xc0, 0xd2, 0x58, 0x85, 0xaf, 0x75, 0xef, 0xab, 0x75,
+	0x52, 0x4d, 0x79, 0x95, 0x05, 0x44, 0x70, 0x73, 0x62, 0x85, 0xfd, 0x1f, 0x54, 0xcb, 0x0c, 0xb6,
+	0x69, 0x90, 0xc0, 0xb9, 0x98, 0x76, 0x61, 0xab, 0xd8, 0x29, 0x3d, 0x9b, 0x98, 0x55, 0x1e, 0xf6,
+	0x45, 0xe3, 0x7f, 0x44, 0x17, 0x3f, 0x56, 0x6f, 0x1d, 0x86, 0x7d, 0xdc, 0x89, 0x7e, 0x4a, 0xe0,
+	0x60, 0xe0, 0x49, 0xe5, 0x09, 0xcb, 0x74, 0xa4, 0xb2, 0x94, 0xc5, 0x54, 0x70, 0x60, 0x4b, 0xb7,
+	0xfd, 0xc2, 0x7c, 0xf2, 0xdb, 0x5f, 0x77, 0x72, 0x73, 0xf4, 0x98, 0x26, 0x15, 0xc1, 0x01, 0x85,
+	0xcf, 0x09, 0x1c, 0x40, 0x00, 0xba, 0xd8, 0x37, 0x46, 0xc0, 0xe6, 0x64, 0x06, 0x4b, 0x24, 0xb3,
+	0x1e, 0x91, 0x39, 0x49, 0x4f, 0xa4, 0x92, 0xd1, 0x6e, 0xe2, 0xb5, 0xee, 0xd2, 0x1f, 0x08, 0xd0,
+	0xdd, 0xc3, 0x92, 0xae, 0xf5, 0x8d, 0xbb, 0x7b, 0x5e, 0x2b, 0xeb, 0x83, 0x39, 0x0d, 0xc0, 0x3b,
+	0x7c, 0x4c, 0x2a, 0x56, 0x4d, 0xbb, 0x69, 0xd5, 0xba, 0xf4, 0x16, 0x81, 0xfd, 0x42, 0xe1, 0xd1,
+	0x13, 0x7b, 0x87, 0x4d, 0xc8, 0x49, 0x65, 0xb1, 0xbf, 0x21, 0x72, 0x5a, 0x8c, 0x38, 0x1d, 0xa3,
+	0x33, 0x52, 0x4e, 0x42, 0x50, 0xd2, 0xaf, 0x09, 0x4c, 0x26, 0xd5, 0x22, 0xd5, 0xf6, 0x0e, 0x23,
+	0x95, 0x9d, 0xca, 0xe9, 0xec, 0x0e, 0xc8, 0x6f, 0x25, 0xe2, 0xb7, 0x40, 0x9f, 0x97, 0xf2, 0xeb,
+	0x91, 0x93, 0xf4, 0x47, 0x02, 0x53, 0x12, 0x41, 0x45, 0xd7, 0x33, 0x06, 0x4f, 0xc8, 0x36, 0xe5,
+	0xcc, 0x80, 0x5e, 0xc8, 0xfb, 0xe5, 0x88, 0xf7, 0x32, 0x7d, 0x21, 0x0b, 0x6f, 0xed, 0xa6, 0x2f,
+	0x09, 0xbb, 0xf4, 0x36, 0x81, 0xf1, 0xb8, 0x02, 0xdb, 0xe3, 0x0e, 0x49, 0xb4, 0xdb, 0x1e, 0x77,
+	0x48, 0x26, 0xe7, 0xd8, 0x7c, 0xea, 0x91, 0x0b, 0x51, 0x47, 0xef, 0x11, 0xc8, 0xcb, 0xb4, 0x18,
+	0x95, 0x9f, 0x63, 0x8a, 0xf4, 0x53, 0x56, 0x06, 0xf0, 0x40, 0x8a, 0x6b, 0xa9, 0xd5, 0x13, 0x14,
+	0xc3, 0xfb, 0x2d, 0xe4, 0x57, 0x97, 0x7e, 0x17, 0x51, 0x4e, 0x28, 0xb6, 0x74, 0xca, 0x32, 0x89,
+	0x98, 0x4e, 0x59, 0x2a, 0x07, 0xd9, 0x3a, 0xa7, 0xac, 0xd2, 0x53, 0x99, 0x28, 0x0b, 0xe1, 0xd9,
+	0xa5, 0x5f, 0x11, 0x18, 0x8b, 0xff, 0xd9, 0x72, 0xaa, 0xef, 0x74, 0x89, 0xe9, 0x30, 0x65, 0x39,
+	0xa3, 0x75, 0xf6, 0xc6, 0x0c, 0x65, 0xa7, 0xbd, 0xe5, 0xc4, 0x06, 0xe8, 0x4f, 0x04, 0x8e, 0x48,
+	0xdf, 0x5f, 0xfa, 0x62, 0xff, 0x97, 0x44, 0x26, 0x23, 0x94, 0x97, 0x06, 0xf6, 0x1b, 0xfc, 0x05,
+	0xf0, 0x47, 0xa9, 0xcd, 0x21, 0xe8, 0xb7, 0x04, 0x26, 0x12, 0x62, 0x8c, 0xaa, 0x29, 0x73, 0x52,
+	0xa2, 0x27, 0x15, 0x2d, 0xb3, 0x3d, 0x12, 0x3d, 0x1b, 0x11, 0x3d, 0x4d, 0x55, 0xf9, 0x78, 0x6d,
+	0x57, 0x2b, 0x57, 0xcd, 0x4e, 0x65, 0x5b, 0xb8, 0xc6, 0x0a, 0xfe, 0x25, 0x81, 0x89, 0x84, 0x24,
+	0x48, 0xe3, 0x2b, 0x13, 0x16, 0x69, 0x7c, 0xa5, 0x5a, 0x83, 0x9d, 0x8e, 0xf8, 0x1e, 0xa7, 0xf3,
+	0xe9, 0x85, 0x15, 0x63, 0x6f, 0xed, 0xfe, 0xa3, 0x02, 0x79, 0xf8, 0xa8, 0x40, 0xfe, 0x7c, 0x54,
+	0x20, 0x9f, 0x3d, 0x2e, 0x0c, 0x3d, 0x7c, 0x5c, 0x18, 0xfa, 0xfd, 0x71, 0x61, 0xe8, 0x03, 0xfc,
+	0xaf, 0x30, 0xb7, 0x76, 0x55, 0xb5, 0x1c, 0xed, 0x86, 0x40, 0xf1, 0x3a, 0x4d, 0xd3, 0xad, 0xee,
+	0xe7, 0x7f, 0xba, 0xae, 0xfd, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x73, 0x32, 0x2b, 0xc7, 0xff, 0x13,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
 	// Accounts returns all the existing accounts.
 	//
 	// When called from another module, this query might consume a high amount of
 	// gas if the pagination field is incorrectly set.
 	//
 	// Since: cosmos-sdk 0.43
-	Accounts(context.Context, *QueryAccountsRequest) (*QueryAccountsResponse, error)
+	Accounts(ctx context.Context, in *QueryAccountsRequest, opts ...grpc.CallOption) (*QueryAccountsResponse, error)
 	// Account returns account details based on address.
-	Account(context.Context, *QueryAccountRequest) (*QueryAccountResponse, error)
+	Account(ctx context.Context, in *QueryAccountRequest, opts ...grpc.CallOption) (*QueryAccountResponse, error)
 	// AccountAddressByID returns account address based on account number.
 	//
 	// Since: cosmos-sdk 0.46.2
-	AccountAddressByID(context.Context, *QueryAccountAddressByIDRequest) (*QueryAccountAddressByIDResponse, error)
+	AccountAddressByID(ctx context.Context, in *QueryAccountAddressByIDRequest, opts ...grpc.CallOption) (*QueryAccountAddressByIDResponse, error)
 	// Params queries all parameters.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// ModuleAccounts returns all the existing module accounts.
 	//
 	// Since: cosmos-sdk 0.46
-	ModuleAccounts(context.Context, *QueryModuleAccountsRequest) (*QueryModuleAccountsResponse, error)
+	ModuleAccounts(ctx context.Context, in *QueryModuleAccountsRequest, opts ...grpc.CallOption) (*QueryModuleAccountsResponse, error)
 	// ModuleAccountByName returns the module account info by module name
-	ModuleAccountByName(context.Context, *QueryModuleAccountByNameRequest) (*QueryModuleAccountByNameResponse, error)
+	ModuleAccountByName(ctx context.Context, in *QueryModuleAccountByNameRequest, opts ...grpc.CallOption) (*QueryModuleAccountByNameResponse, error)
 	// Bech32Prefix queries bech32Prefix
 	//
 	// Since: cosmos-sdk 0.46
-	Bech32Prefix(context.Context, *Bech32PrefixRequest) (*Bech32PrefixResponse, error)
+	Bech32Prefix(ctx context.Context, in *Bech32PrefixRequest, opts ...grpc.CallOption) (*Bech32PrefixResponse, error)
 	// AddressBytesToString converts Account Address bytes to string
 	//
 	// Since: cosmos-sdk 0.46
-	AddressBytesToString(context.Context, *AddressBytesToStringRequest) (*AddressBytesToStringResponse, error)
+	AddressBytesToString(ctx context.Context, in *AddressBytesToStringRequest, opts ...grpc.CallOption) (*AddressBytesToStringResponse, error)
 	// AddressStringToBytes converts Address string to bytes
 	//
 	// Since: cosmos-sdk 0.46
-	AddressStringToBytes(context.Context, *AddressStringToBytesRequest) (*AddressStringToBytesResponse, error)
+	AddressStringToBytes(ctx context.Context, in *AddressStringToBytesRequest, opts ...grpc.CallOption) (*AddressStringToBytesResponse, error)
 	// AccountInfo queries account info which is common to all account types.
 	//
 	// Since: cosmos-sdk 0.47
-	AccountInfo(context.Context, *QueryAccountInfoRequest) (*QueryAccountInfoResponse, error)
-}
-
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+	AccountInfo(ctx context.Context, in *QueryAccountInfoRequest, opts ...grpc.CallOption) (*QueryAccountInfoResponse, error)
+	// AccountsByNumberRange returns the addresses of the accounts whose account
+	// number lies in the requested range, ordered by account number.
+	//
+	// Since: cosmos-sdk 0.51
+	AccountsByNumberRange(ctx context.Context, in *QueryAccountsByNumberRangeRequest, opts ...grpc.CallOption) (*QueryAccountsByNumberRangeResponse, error)
+	// PubKeyHistory queries the public keys an account rotated away from.
+	//
+	// Since: cosmos-sdk 0.51
+	PubKeyHistory(ctx context.Context, in *QueryPubKeyHistoryRequest, opts ...grpc.CallOption) (*QueryPubKeyHistoryResponse, error)
+	// AccountsCount returns the number of accounts stored in state.
+	//
+	// Since: cosmos-sdk 0.51
+	AccountsCount(ctx context.Context, in *QueryAccountsCountRequest, opts ...grpc.CallOption) (*QueryAccountsCountResponse, error)
 }
 
-func (*UnimplementedQueryServer) Accounts(ctx context.Context, req *QueryAccountsRequest) (*QueryAccountsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Accounts not implemented")
-}
-func (*UnimplementedQueryServer) Account(ctx context.Context, req *QueryAccountRequest) (*QueryAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Account not implemented")
-}
-func (*UnimplementedQueryServer) AccountAddressByID(ctx context.Context, req *QueryAccountAddressByIDRequest) (*QueryAccountAddressByIDResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AccountAddressByID not implemented")
-}
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
-}
-func (*UnimplementedQueryServer) ModuleAccounts(ctx context.Context, req *QueryModuleAccountsRequest) (*QueryModuleAccountsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ModuleAccounts not implemented")
-}
-func (*UnimplementedQueryServer) ModuleAccountByName(ctx context.Context, req *QueryModuleAccountByNameRequest) (*QueryModuleAccountByNameResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ModuleAccountByName not implemented")
-}
-func (*UnimplementedQueryServer) Bech32Prefix(ctx context.Context, req *Bech32PrefixRequest) (*Bech32PrefixResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Bech32Prefix not implemented")
-}
-func (*UnimplementedQueryServer) AddressBytesToString(ctx context.Context, req *AddressBytesToStringRequest) (*AddressBytesToStringResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddressBytesToString not implemented")
-}
-func (*UnimplementedQueryServer) AddressStringToBytes(ctx context.Context, req *AddressStringToBytesRequest) (*AddressStringToBytesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddressStringToBytes not implemented")
-}
-func (*UnimplementedQueryServer) AccountInfo(ctx context.Context, req *QueryAccountInfoRequest) (*QueryAccountInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AccountInfo not implemented")
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
 }
 
-func _Query_Accounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAccountsRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) Accounts(ctx context.Context, in *QueryAccountsRequest, opts ...grpc.CallOption) (*QueryAccountsResponse, error) {
+	out := new(QueryAccountsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/Accounts", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Accounts(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.auth.v1beta1.Query/Accounts",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Accounts(ctx, req.(*QueryAccountsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_Account_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAccountRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) Account(ctx context.Context, in *QueryAccountRequest, opts ...grpc.CallOption) (*QueryAccountResponse, error) {
+	out := new(QueryAccountResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/Account", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Account(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.auth.v1beta1.Query/Account",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Account(ctx, req.(*QueryAccountRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_AccountAddressByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAccountAddressByIDRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) AccountAddressByID(ctx context.Context, in *QueryAccountAddressByIDRequest, opts ...grpc.CallOption) (*QueryAccountAddressByIDResponse, error) {
+	out := new(QueryAccountAddressByIDResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/AccountAddressByID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ModuleAccounts(ctx context.Context, in *QueryModuleAccountsRequest, opts ...grpc.CallOption) (*QueryModuleAccountsResponse, error) {
+	out := new(QueryModuleAccountsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/ModuleAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ModuleAccountByName(ctx context.Context, in *QueryModuleAccountByNameRequest, opts ...grpc.CallOption) (*QueryModuleAccountByNameResponse, error) {
+	out := new(QueryModuleAccountByNameResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/ModuleAccountByName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Bech32Prefix(ctx context.Context, in *Bech32PrefixRequest, opts ...grpc.CallOption) (*Bech32PrefixResponse, error) {
+	out := new(Bech32PrefixResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/Bech32Prefix", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AddressBytesToString(ctx context.Context, in *AddressBytesToStringRequest, opts ...grpc.CallOption) (*AddressBytesToStringResponse, error) {
+	out := new(AddressBytesToStringResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/AddressBytesToString", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AddressStringToBytes(ctx context.Context, in *AddressStringToBytesRequest, opts ...grpc.CallOption) (*AddressStringToBytesResponse, error) {
+	out := new(AddressStringToBytesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/AddressStringToBytes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountInfo(ctx context.Context, in *QueryAccountInfoRequest, opts ...grpc.CallOption) (*QueryAccountInfoResponse, error) {
+	out := new(QueryAccountInfoResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/AccountInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountsByNumberRange(ctx context.Context, in *QueryAccountsByNumberRangeRequest, opts ...grpc.CallOption) (*QueryAccountsByNumberRangeResponse, error) {
+	out := new(QueryAccountsByNumberRangeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/AccountsByNumberRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PubKeyHistory(ctx context.Context, in *QueryPubKeyHistoryRequest, opts ...grpc.CallOption) (*QueryPubKeyHistoryResponse, error) {
+	out := new(QueryPubKeyHistoryResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/PubKeyHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountsCount(ctx context.Context, in *QueryAccountsCountRequest, opts ...grpc.CallOption) (*QueryAccountsCountResponse, error) {
+	out := new(QueryAccountsCountResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/AccountsCount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Accounts returns all the existing accounts.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	//
+	// Since: cosmos-sdk 0.43
+	Accounts(context.Context, *QueryAccountsRequest) (*QueryAccountsResponse, error)
+	// Account returns account details based on address.
+	Account(context.Context, *QueryAccountRequest) (*QueryAccountResponse, error)
+	// AccountAddressByID returns account address based on account number.
+	//
+	// Since: cosmos-sdk 0.46.2
+	AccountAddressByID(context.Context, *QueryAccountAddressByIDRequest) (*QueryAccountAddressByIDResponse, error)
+	// Params queries all parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ModuleAccounts returns all the existing module accounts.
+	//
+	// Since: cosmos-sdk 0.46
+	ModuleAccounts(context.Context, *QueryModuleAccountsRequest) (*QueryModuleAccountsResponse, error)
+	// ModuleAccountByName returns the module account info by module name
+	ModuleAccountByName(context.Context, *QueryModuleAccountByNameRequest) (*QueryModuleAccountByNameResponse, error)
+	// Bech32Prefix queries bech32Prefix
+	//
+	// Since: cosmos-sdk 0.46
+	Bech32Prefix(context.Context, *Bech32PrefixRequest) (*Bech32PrefixResponse, error)
+	// AddressBytesToString converts Account Address bytes to string
+	//
+	// Since: cosmos-sdk 0.46
+	AddressBytesToString(context.Context, *AddressBytesToStringRequest) (*AddressBytesToStringResponse, error)
+	// AddressStringToBytes converts Address string to bytes
+	//
+	// Since: cosmos-sdk 0.46
+	AddressStringToBytes(context.Context, *AddressStringToBytesRequest) (*AddressStringToBytesResponse, error)
+	// AccountInfo queries account info which is common to all account types.
+	//
+	// Since: cosmos-sdk 0.47
+	AccountInfo(context.Context, *QueryAccountInfoRequest) (*QueryAccountInfoResponse, error)
+	// AccountsByNumberRange returns the addresses of the accounts whose account
+	// number lies in the requested range, ordered by account number.
+	//
+	// Since: cosmos-sdk 0.51
+	AccountsByNumberRange(context.Context, *QueryAccountsByNumberRangeRequest) (*QueryAccountsByNumberRangeResponse, error)
+	// PubKeyHistory queries the public keys an account rotated away from.
+	//
+	// Since: cosmos-sdk 0.51
+	PubKeyHistory(context.Context, *QueryPubKeyHistoryRequest) (*QueryPubKeyHistoryResponse, error)
+	// AccountsCount returns the number of accounts stored in state.
+	//
+	// Since: cosmos-sdk 0.51
+	AccountsCount(context.Context, *QueryAccountsCountRequest) (*QueryAccountsCountResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Accounts(ctx context.Context, req *QueryAccountsRequest) (*QueryAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Accounts not implemented")
+}
+func (*UnimplementedQueryServer) Account(ctx context.Context, req *QueryAccountRequest) (*QueryAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Account not implemented")
+}
+func (*UnimplementedQueryServer) AccountAddressByID(ctx context.Context, req *QueryAccountAddressByIDRequest) (*QueryAccountAddressByIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountAddressByID not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) ModuleAccounts(ctx context.Context, req *QueryModuleAccountsRequest) (*QueryModuleAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModuleAccounts not implemented")
+}
+func (*UnimplementedQueryServer) ModuleAccountByName(ctx context.Context, req *QueryModuleAccountByNameRequest) (*QueryModuleAccountByNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModuleAccountByName not implemented")
+}
+func (*UnimplementedQueryServer) Bech32Prefix(ctx context.Context, req *Bech32PrefixRequest) (*Bech32PrefixResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Bech32Prefix not implemented")
+}
+func (*UnimplementedQueryServer) AddressBytesToString(ctx context.Context, req *AddressBytesToStringRequest) (*AddressBytesToStringResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddressBytesToString not implemented")
+}
+func (*UnimplementedQueryServer) AddressStringToBytes(ctx context.Context, req *AddressStringToBytesRequest) (*AddressStringToBytesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddressStringToBytes not implemented")
+}
+func (*UnimplementedQueryServer) AccountInfo(ctx context.Context, req *QueryAccountInfoRequest) (*QueryAccountInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountInfo not implemented")
+}
+func (*UnimplementedQueryServer) AccountsByNumberRange(ctx context.Context, req *QueryAccountsByNumberRangeRequest) (*QueryAccountsByNumberRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountsByNumberRange not implemented")
+}
+func (*UnimplementedQueryServer) PubKeyHistory(ctx context.Context, req *QueryPubKeyHistoryRequest) (*QueryPubKeyHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PubKeyHistory not implemented")
+}
+func (*UnimplementedQueryServer) AccountsCount(ctx context.Context, req *QueryAccountsCountRequest) (*QueryAccountsCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountsCount not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Accounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Accounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.auth.v1beta1.Query/Accounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Accounts(ctx, req.(*QueryAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Account_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Account(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.auth.v1beta1.Query/Account",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Account(ctx, req.(*QueryAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountAddressByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountAddressByIDRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
@@ -1466,6 +1959,60 @@ func _Query_AccountInfo_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AccountsByNumberRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountsByNumberRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountsByNumberRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.auth.v1beta1.Query/AccountsByNumberRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountsByNumberRange(ctx, req.(*QueryAccountsByNumberRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PubKeyHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPubKeyHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PubKeyHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.auth.v1beta1.Query/PubKeyHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PubKeyHistory(ctx, req.(*QueryPubKeyHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountsCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountsCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountsCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.auth.v1beta1.Query/AccountsCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountsCount(ctx, req.(*QueryAccountsCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.auth.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1510,6 +2057,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AccountInfo",
 			Handler:    _Query_AccountInfo_Handler,
 		},
+		{
+			MethodName: "AccountsByNumberRange",
+			Handler:    _Query_AccountsByNumberRange_Handler,
+		},
+		{
+			MethodName: "PubKeyHistory",
+			Handler:    _Query_PubKeyHistory_Handler,
+		},
+		{
+			MethodName: "AccountsCount",
+			Handler:    _Query_AccountsCount_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/auth/v1beta1/query.proto",
@@ -1763,6 +2322,20 @@ func (m *QueryModuleAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	_ = i
 	var l int
 	_ = l
+	if len(m.ModuleAccounts) > 0 {
+		for iNdEx := len(m.ModuleAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ModuleAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Accounts) > 0 {
 		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1780,6 +2353,52 @@ func (m *QueryModuleAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *ModuleAccountInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModuleAccountInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModuleAccountInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Permissions) > 0 {
+		for iNdEx := len(m.Permissions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Permissions[iNdEx])
+			copy(dAtA[i:], m.Permissions[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Permissions[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryModuleAccountByNameRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2146,6 +2765,242 @@ func (m *QueryAccountInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryPubKeyHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPubKeyHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPubKeyHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPubKeyHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPubKeyHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPubKeyHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Rotations) > 0 {
+		for iNdEx := len(m.Rotations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rotations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountsByNumberRangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountsByNumberRangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountsByNumberRangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.End != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.End))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Start != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Start))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountsByNumberRangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountsByNumberRangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountsByNumberRangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountsCountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountsCountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountsCountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountsCountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountsCountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountsCountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2256,7 +3111,36 @@ func (m *QueryModuleAccountsResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	return n
+	if len(m.ModuleAccounts) > 0 {
+		for _, e := range m.ModuleAccounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ModuleAccountInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Permissions) > 0 {
+		for _, s := range m.Permissions {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
 }
 
 func (m *QueryModuleAccountByNameRequest) Size() (n int) {
@@ -2397,29 +3281,548 @@ func (m *QueryAccountInfoRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *QueryAccountInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Info != nil {
+		l = m.Info.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPubKeyHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPubKeyHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rotations) > 0 {
+		for _, e := range m.Rotations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountsByNumberRangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Start != 0 {
+		n += 1 + sovQuery(uint64(m.Start))
+	}
+	if m.End != 0 {
+		n += 1 + sovQuery(uint64(m.End))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountsByNumberRangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountsCountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAccountsCountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovQuery(uint64(m.Count))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryAccountsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, &any.Any{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Account == nil {
+				m.Account = &any.Any{}
+			}
+			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryAccountInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Info != nil {
-		l = m.Info.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryAccountsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2442,15 +3845,15 @@ func (m *QueryAccountsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2477,10 +3880,7 @@ func (m *QueryAccountsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2505,7 +3905,7 @@ func (m *QueryAccountsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccountsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryModuleAccountsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2528,10 +3928,60 @@ func (m *QueryAccountsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryModuleAccountsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryModuleAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryModuleAccountsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModuleAccountsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModuleAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2568,11 +4018,159 @@ func (m *QueryAccountsResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleAccounts = append(m.ModuleAccounts, ModuleAccountInfo{})
+			if err := m.ModuleAccounts[len(m.ModuleAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ModuleAccountInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModuleAccountInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModuleAccountInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2582,27 +4180,23 @@ func (m *QueryAccountsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Permissions = append(m.Permissions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2625,7 +4219,7 @@ func (m *QueryAccountsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryModuleAccountByNameRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2648,15 +4242,15 @@ func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryModuleAccountByNameRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryModuleAccountByNameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2684,7 +4278,7 @@ func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2707,7 +4301,7 @@ func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryModuleAccountByNameResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2730,10 +4324,10 @@ func (m *QueryAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryModuleAccountByNameResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryModuleAccountByNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2793,7 +4387,7 @@ func (m *QueryAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *Bech32PrefixRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2816,10 +4410,10 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Bech32PrefixRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Bech32PrefixRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2843,7 +4437,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *Bech32PrefixResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2866,17 +4460,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: Bech32PrefixResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Bech32PrefixResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bech32Prefix", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2886,24 +4480,23 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Bech32Prefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2926,7 +4519,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryModuleAccountsRequest) Unmarshal(dAtA []byte) error {
+func (m *AddressBytesToStringRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2949,12 +4542,46 @@ func (m *QueryModuleAccountsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryModuleAccountsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddressBytesToStringRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryModuleAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddressBytesToStringRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddressBytes = append(m.AddressBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.AddressBytes == nil {
+				m.AddressBytes = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2976,7 +4603,7 @@ func (m *QueryModuleAccountsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryModuleAccountsResponse) Unmarshal(dAtA []byte) error {
+func (m *AddressBytesToStringResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2999,17 +4626,17 @@ func (m *QueryModuleAccountsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryModuleAccountsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddressBytesToStringResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryModuleAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddressBytesToStringResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressString", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3019,25 +4646,23 @@ func (m *QueryModuleAccountsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Accounts = append(m.Accounts, &any.Any{})
-			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AddressString = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3060,7 +4685,7 @@ func (m *QueryModuleAccountsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryModuleAccountByNameRequest) Unmarshal(dAtA []byte) error {
+func (m *AddressStringToBytesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3083,15 +4708,15 @@ func (m *QueryModuleAccountByNameRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryModuleAccountByNameRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddressStringToBytesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryModuleAccountByNameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddressStringToBytesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressString", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3119,7 +4744,7 @@ func (m *QueryModuleAccountByNameRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.AddressString = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3142,7 +4767,7 @@ func (m *QueryModuleAccountByNameRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryModuleAccountByNameResponse) Unmarshal(dAtA []byte) error {
+func (m *AddressStringToBytesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3165,17 +4790,17 @@ func (m *QueryModuleAccountByNameResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryModuleAccountByNameResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddressStringToBytesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryModuleAccountByNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddressStringToBytesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressBytes", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3185,26 +4810,24 @@ func (m *QueryModuleAccountByNameResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Account == nil {
-				m.Account = &any.Any{}
-			}
-			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.AddressBytes = append(m.AddressBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.AddressBytes == nil {
+				m.AddressBytes = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -3228,7 +4851,7 @@ func (m *QueryModuleAccountByNameResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Bech32PrefixRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountAddressByIDRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3251,12 +4874,50 @@ func (m *Bech32PrefixRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Bech32PrefixRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountAddressByIDRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Bech32PrefixRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountAddressByIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountId", wireType)
+			}
+			m.AccountId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3278,7 +4939,7 @@ func (m *Bech32PrefixRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Bech32PrefixResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountAddressByIDResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3301,15 +4962,15 @@ func (m *Bech32PrefixResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Bech32PrefixResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountAddressByIDResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Bech32PrefixResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountAddressByIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bech32Prefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3337,7 +4998,7 @@ func (m *Bech32PrefixResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bech32Prefix = string(dAtA[iNdEx:postIndex])
+			m.AccountAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3360,7 +5021,7 @@ func (m *Bech32PrefixResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddressBytesToStringRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountInfoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3383,17 +5044,17 @@ func (m *AddressBytesToStringRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddressBytesToStringRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountInfoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddressBytesToStringRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddressBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3403,25 +5064,23 @@ func (m *AddressBytesToStringRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AddressBytes = append(m.AddressBytes[:0], dAtA[iNdEx:postIndex]...)
-			if m.AddressBytes == nil {
-				m.AddressBytes = []byte{}
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3444,7 +5103,7 @@ func (m *AddressBytesToStringRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddressBytesToStringResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3467,17 +5126,17 @@ func (m *AddressBytesToStringResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddressBytesToStringResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddressBytesToStringResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddressString", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3487,23 +5146,27 @@ func (m *AddressBytesToStringResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AddressString = string(dAtA[iNdEx:postIndex])
+			if m.Info == nil {
+				m.Info = &BaseAccount{}
+			}
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3526,7 +5189,7 @@ func (m *AddressBytesToStringResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddressStringToBytesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPubKeyHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3549,15 +5212,15 @@ func (m *AddressStringToBytesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddressStringToBytesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPubKeyHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddressStringToBytesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPubKeyHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddressString", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3585,7 +5248,43 @@ func (m *AddressStringToBytesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AddressString = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3608,7 +5307,7 @@ func (m *AddressStringToBytesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddressStringToBytesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPubKeyHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3631,17 +5330,17 @@ func (m *AddressStringToBytesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddressStringToBytesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPubKeyHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddressStringToBytesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPubKeyHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddressBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rotations", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3651,24 +5350,60 @@ func (m *AddressStringToBytesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AddressBytes = append(m.AddressBytes[:0], dAtA[iNdEx:postIndex]...)
-			if m.AddressBytes == nil {
-				m.AddressBytes = []byte{}
+			m.Rotations = append(m.Rotations, PubKeyRotation{})
+			if err := m.Rotations[len(m.Rotations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -3692,7 +5427,7 @@ func (m *AddressStringToBytesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccountAddressByIDRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountsByNumberRangeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3715,17 +5450,17 @@ func (m *QueryAccountAddressByIDRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountAddressByIDRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountsByNumberRangeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountAddressByIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountsByNumberRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
 			}
-			m.Id = 0
+			m.Start = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3735,16 +5470,16 @@ func (m *QueryAccountAddressByIDRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= int64(b&0x7F) << shift
+				m.Start |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
 			}
-			m.AccountId = 0
+			m.End = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3754,11 +5489,47 @@ func (m *QueryAccountAddressByIDRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AccountId |= uint64(b&0x7F) << shift
+				m.End |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3780,7 +5551,7 @@ func (m *QueryAccountAddressByIDRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccountAddressByIDResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountsByNumberRangeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3803,17 +5574,17 @@ func (m *QueryAccountAddressByIDResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountAddressByIDResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountsByNumberRangeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountAddressByIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountsByNumberRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3823,23 +5594,61 @@ func (m *QueryAccountAddressByIDResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AccountAddress = string(dAtA[iNdEx:postIndex])
+			m.Accounts = append(m.Accounts, AccountNumberAddress{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3862,7 +5671,7 @@ func (m *QueryAccountAddressByIDResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccountInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountsCountRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3885,44 +5694,12 @@ func (m *QueryAccountInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountsCountRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountsCountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3944,7 +5721,7 @@ func (m *QueryAccountInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccountInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountsCountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3967,17 +5744,17 @@ func (m *QueryAccountInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountsCountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountsCountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var msglen int
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3987,28 +5764,11 @@ func (m *QueryAccountInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Count |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Info == nil {
-				m.Info = &BaseAccount{}
-			}
-			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
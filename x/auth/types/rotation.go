@@ -0,0 +1,36 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+var _ codectypes.UnpackInterfacesMessage = (*PubKeyRotation)(nil)
+
+// NewPubKeyRotation creates a record of a public key replaced at the given height.
+func NewPubKeyRotation(pubKey cryptotypes.PubKey, height int64) (PubKeyRotation, error) {
+	pkAny, err := codectypes.NewAnyWithValue(pubKey)
+	if err != nil {
+		return PubKeyRotation{}, err
+	}
+
+	return PubKeyRotation{PubKey: pkAny, BlockHeight: height}, nil
+}
+
+// GetReplacedPubKey returns the cached public key that was replaced.
+func (r PubKeyRotation) GetReplacedPubKey() cryptotypes.PubKey {
+	if r.PubKey == nil {
+		return nil
+	}
+	pk, _ := r.PubKey.GetCachedValue().(cryptotypes.PubKey)
+	return pk
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (r PubKeyRotation) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if r.PubKey == nil {
+		return nil
+	}
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(r.PubKey, &pubKey)
+}
@@ -6,6 +6,8 @@ package authz
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -70,6 +72,106 @@ func (m *GenericAuthorization) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenericAuthorization proto.InternalMessageInfo
 
+// RuleBasedAuthorization wraps any authorization with additional constraints
+// on its execution. All the constraints must be satisfied, in addition to the
+// ones of the wrapped authorization, for a message to be accepted.
+type RuleBasedAuthorization struct {
+	// authorization is the wrapped authorization, which also determines the
+	// message type this authorization applies to.
+	Authorization *any.Any `protobuf:"bytes,1,opt,name=authorization,proto3" json:"authorization,omitempty"`
+	// max_executions is the maximum number of times the authorization can be
+	// executed. The grant is deleted once it is reached. If zero, the number of
+	// executions is not limited.
+	MaxExecutions uint64 `protobuf:"varint,2,opt,name=max_executions,json=maxExecutions,proto3" json:"max_executions,omitempty"`
+	// executions is the number of times the authorization has been executed.
+	Executions uint64 `protobuf:"varint,3,opt,name=executions,proto3" json:"executions,omitempty"`
+	// daily_spend_limit is the maximum amount of coins which can be spent per
+	// UTC day. If empty, the spending is not limited.
+	DailySpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=daily_spend_limit,json=dailySpendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"daily_spend_limit"`
+	// daily_spent is the amount of coins spent since day_reset minus one day.
+	DailySpent github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=daily_spent,json=dailySpent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"daily_spent"`
+	// day_reset is the time at which daily_spent is reset.
+	DayReset *time.Time `protobuf:"bytes,6,opt,name=day_reset,json=dayReset,proto3,stdtime" json:"day_reset,omitempty"`
+	// allowed_windows are the time windows during which the authorization can
+	// be executed. If empty, it can be executed at any time.
+	AllowedWindows []ExecutionWindow `protobuf:"bytes,7,rep,name=allowed_windows,json=allowedWindows,proto3" json:"allowed_windows"`
+}
+
+func (m *RuleBasedAuthorization) Reset()         { *m = RuleBasedAuthorization{} }
+func (m *RuleBasedAuthorization) String() string { return proto.CompactTextString(m) }
+func (*RuleBasedAuthorization) ProtoMessage()    {}
+func (*RuleBasedAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{1}
+}
+func (m *RuleBasedAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RuleBasedAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RuleBasedAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RuleBasedAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RuleBasedAuthorization.Merge(m, src)
+}
+func (m *RuleBasedAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *RuleBasedAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_RuleBasedAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RuleBasedAuthorization proto.InternalMessageInfo
+
+// ExecutionWindow defines a time window during which an authorization can be
+// executed.
+type ExecutionWindow struct {
+	// start is the time from which the authorization can be executed.
+	Start time.Time `protobuf:"bytes,1,opt,name=start,proto3,stdtime" json:"start"`
+	// end is the time until which (exclusive) the authorization can be executed.
+	End time.Time `protobuf:"bytes,2,opt,name=end,proto3,stdtime" json:"end"`
+}
+
+func (m *ExecutionWindow) Reset()         { *m = ExecutionWindow{} }
+func (m *ExecutionWindow) String() string { return proto.CompactTextString(m) }
+func (*ExecutionWindow) ProtoMessage()    {}
+func (*ExecutionWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{2}
+}
+func (m *ExecutionWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecutionWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecutionWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExecutionWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutionWindow.Merge(m, src)
+}
+func (m *ExecutionWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecutionWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutionWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecutionWindow proto.InternalMessageInfo
+
 // Grant gives permissions to execute
 // the provide method with expiration time.
 type Grant struct {
@@ -84,7 +186,7 @@ func (m *Grant) Reset()         { *m = Grant{} }
 func (m *Grant) String() string { return proto.CompactTextString(m) }
 func (*Grant) ProtoMessage()    {}
 func (*Grant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_544dc2e84b61c637, []int{1}
+	return fileDescriptor_544dc2e84b61c637, []int{3}
 }
 func (m *Grant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -126,7 +228,7 @@ func (m *GrantAuthorization) Reset()         { *m = GrantAuthorization{} }
 func (m *GrantAuthorization) String() string { return proto.CompactTextString(m) }
 func (*GrantAuthorization) ProtoMessage()    {}
 func (*GrantAuthorization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_544dc2e84b61c637, []int{2}
+	return fileDescriptor_544dc2e84b61c637, []int{4}
 }
 func (m *GrantAuthorization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -165,7 +267,7 @@ func (m *GrantQueueItem) Reset()         { *m = GrantQueueItem{} }
 func (m *GrantQueueItem) String() string { return proto.CompactTextString(m) }
 func (*GrantQueueItem) ProtoMessage()    {}
 func (*GrantQueueItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_544dc2e84b61c637, []int{3}
+	return fileDescriptor_544dc2e84b61c637, []int{5}
 }
 func (m *GrantQueueItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -196,6 +298,8 @@ var xxx_messageInfo_GrantQueueItem proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*GenericAuthorization)(nil), "cosmos.authz.v1beta1.GenericAuthorization")
+	proto.RegisterType((*RuleBasedAuthorization)(nil), "cosmos.authz.v1beta1.RuleBasedAuthorization")
+	proto.RegisterType((*ExecutionWindow)(nil), "cosmos.authz.v1beta1.ExecutionWindow")
 	proto.RegisterType((*Grant)(nil), "cosmos.authz.v1beta1.Grant")
 	proto.RegisterType((*GrantAuthorization)(nil), "cosmos.authz.v1beta1.GrantAuthorization")
 	proto.RegisterType((*GrantQueueItem)(nil), "cosmos.authz.v1beta1.GrantQueueItem")
@@ -204,35 +308,53 @@ func init() {
 func init() { proto.RegisterFile("cosmos/authz/v1beta1/authz.proto", fileDescriptor_544dc2e84b61c637) }
 
 var fileDescriptor_544dc2e84b61c637 = []byte{
-	// 439 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
-	0x18, 0xc6, 0xe3, 0x75, 0xfc, 0x99, 0xa7, 0x21, 0x88, 0x72, 0x28, 0x3d, 0x24, 0x55, 0x0e, 0x68,
-	0x42, 0x6a, 0xac, 0x15, 0x4e, 0x9c, 0x68, 0x84, 0x34, 0xc1, 0x8d, 0x30, 0x2e, 0x5c, 0x2a, 0x67,
-	0x31, 0x9e, 0xb5, 0x3a, 0x8e, 0x6c, 0x07, 0x2d, 0xfb, 0x08, 0x9c, 0xf6, 0x19, 0xf8, 0x04, 0x20,
-	0xed, 0x43, 0x54, 0x9c, 0x2a, 0x4e, 0x9c, 0xf8, 0xd3, 0x1e, 0xf8, 0x1a, 0xa8, 0x76, 0x22, 0x1a,
-	0x5a, 0x89, 0x1e, 0xb8, 0x44, 0x7e, 0xfd, 0x3e, 0xcf, 0xfb, 0x3e, 0xf9, 0x45, 0x81, 0xfd, 0x53,
-	0xa1, 0xb8, 0x50, 0x08, 0x97, 0xfa, 0xec, 0x12, 0xbd, 0x3b, 0x4a, 0x89, 0xc6, 0x47, 0xb6, 0x8a,
-	0x0a, 0x29, 0xb4, 0x70, 0x3d, 0xab, 0x88, 0xec, 0x5d, 0xad, 0xe8, 0xdd, 0xc3, 0x9c, 0xe5, 0x02,
-	0x99, 0xa7, 0x15, 0xf6, 0xee, 0x5b, 0xe1, 0xd8, 0x54, 0xa8, 0x76, 0xd9, 0x56, 0x40, 0x85, 0xa0,
-	0x13, 0x82, 0x4c, 0x95, 0x96, 0x6f, 0x91, 0x66, 0x9c, 0x28, 0x8d, 0x79, 0x51, 0x0b, 0x3c, 0x2a,
-	0xa8, 0xb0, 0xc6, 0xe5, 0xa9, 0x99, 0xf8, 0xb7, 0x0d, 0xe7, 0x95, 0x6d, 0x85, 0x1a, 0x7a, 0xc7,
-	0x24, 0x27, 0x92, 0x9d, 0x8e, 0x4a, 0x7d, 0x26, 0x24, 0xbb, 0xc4, 0x9a, 0x89, 0xdc, 0xbd, 0x0b,
-	0x3b, 0x5c, 0xd1, 0x2e, 0xe8, 0x83, 0xc3, 0xbd, 0x64, 0x79, 0x7c, 0xf2, 0xe2, 0xf3, 0xf5, 0x20,
-	0xdc, 0xf4, 0x0e, 0x51, 0xcb, 0xf9, 0xfe, 0xd7, 0xc7, 0x87, 0x81, 0x95, 0x0d, 0x54, 0x76, 0x8e,
-	0x36, 0x4d, 0x0f, 0x3f, 0x01, 0x78, 0xe3, 0x58, 0xe2, 0x5c, 0xbb, 0x29, 0x3c, 0xc0, 0xab, 0x2d,
-	0xb3, 0x71, 0x7f, 0xe8, 0x45, 0x36, 0x72, 0xd4, 0x44, 0x8e, 0x46, 0x79, 0x15, 0x3f, 0xd8, 0x2e,
-	0x42, 0xd2, 0x1e, 0xe9, 0x3e, 0x83, 0x90, 0x5c, 0x14, 0x4c, 0xda, 0x05, 0x3b, 0x66, 0x41, 0x6f,
-	0x6d, 0xc1, 0x49, 0x83, 0x32, 0xbe, 0x3d, 0xfd, 0x16, 0x80, 0xab, 0xef, 0x01, 0x48, 0x56, 0x7c,
-	0xe1, 0x87, 0x1d, 0xe8, 0x9a, 0xcc, 0x6d, 0x50, 0x43, 0x78, 0x8b, 0x2e, 0x6f, 0x89, 0xb4, 0xb0,
-	0xe2, 0xee, 0x97, 0xeb, 0x41, 0xf3, 0xad, 0x47, 0x59, 0x26, 0x89, 0x52, 0xaf, 0xb4, 0x64, 0x39,
-	0x4d, 0x1a, 0xe1, 0x1f, 0x0f, 0x31, 0x69, 0xb6, 0xf0, 0x90, 0x75, 0x50, 0x9d, 0xff, 0x0f, 0xea,
-	0x69, 0x0b, 0xd4, 0xee, 0x3f, 0x41, 0xed, 0xae, 0x41, 0x7a, 0x0c, 0xef, 0x18, 0x46, 0x2f, 0x4b,
-	0x52, 0x92, 0xe7, 0x9a, 0x70, 0x37, 0x84, 0x07, 0x5c, 0xd1, 0xb1, 0xae, 0x0a, 0x32, 0x2e, 0xe5,
-	0x44, 0x75, 0x41, 0xbf, 0x73, 0xb8, 0x97, 0xec, 0x73, 0x45, 0x4f, 0xaa, 0x82, 0xbc, 0x96, 0x13,
-	0x15, 0x0f, 0xa7, 0x3f, 0x7d, 0x67, 0x3a, 0xf7, 0xc1, 0x6c, 0xee, 0x83, 0x1f, 0x73, 0x1f, 0x5c,
-	0x2d, 0x7c, 0x67, 0xb6, 0xf0, 0x9d, 0xaf, 0x0b, 0xdf, 0x79, 0x53, 0x83, 0x51, 0xd9, 0x79, 0xc4,
-	0x04, 0xba, 0xb0, 0x3f, 0x55, 0x7a, 0xd3, 0xe4, 0x79, 0xf4, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x60,
-	0x57, 0xde, 0x7c, 0x79, 0x03, 0x00, 0x00,
+	// 724 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xcf, 0x4f, 0x13, 0x4d,
+	0x18, 0xee, 0xf4, 0x07, 0xd0, 0xe9, 0x57, 0xf8, 0xd8, 0x34, 0x5f, 0x16, 0x0e, 0xdb, 0x7e, 0x4d,
+	0x30, 0x0d, 0x09, 0xbb, 0xa1, 0x7a, 0xc2, 0x18, 0xa5, 0xfe, 0x20, 0x1a, 0x2e, 0x2e, 0x18, 0xa3,
+	0x97, 0xcd, 0xb4, 0x3b, 0x2e, 0x13, 0x76, 0x77, 0x9a, 0x9d, 0x59, 0xe9, 0x72, 0xf4, 0x68, 0x62,
+	0xe4, 0xec, 0xd1, 0x93, 0xf1, 0x84, 0x09, 0x7f, 0x80, 0xc7, 0xc6, 0x13, 0xf1, 0xe4, 0x09, 0x14,
+	0x0e, 0xfc, 0x1b, 0x66, 0x76, 0xb6, 0xa5, 0x85, 0x26, 0xd4, 0xc4, 0x70, 0x69, 0x76, 0xde, 0x79,
+	0x9e, 0x79, 0x9f, 0xf7, 0x79, 0xe7, 0x9d, 0xc2, 0x4a, 0x8b, 0x32, 0x8f, 0x32, 0x03, 0x85, 0x7c,
+	0x6b, 0xd7, 0x78, 0xbd, 0xdc, 0xc4, 0x1c, 0x2d, 0xcb, 0x95, 0xde, 0x0e, 0x28, 0xa7, 0x4a, 0x49,
+	0x22, 0x74, 0x19, 0x4b, 0x10, 0xf3, 0xb3, 0xc8, 0x23, 0x3e, 0x35, 0xe2, 0x5f, 0x09, 0x9c, 0x9f,
+	0x93, 0x40, 0x2b, 0x5e, 0x19, 0x09, 0x4b, 0x6e, 0x95, 0x1d, 0x4a, 0x1d, 0x17, 0x1b, 0xf1, 0xaa,
+	0x19, 0xbe, 0x32, 0x38, 0xf1, 0x30, 0xe3, 0xc8, 0x6b, 0x27, 0x80, 0x92, 0x43, 0x1d, 0x2a, 0x89,
+	0xe2, 0xab, 0x77, 0xe2, 0x45, 0x1a, 0xf2, 0xa3, 0x64, 0x4b, 0x4b, 0x74, 0x37, 0x11, 0xc3, 0x7d,
+	0xd9, 0x2d, 0x4a, 0x7c, 0xb9, 0x5f, 0xe5, 0xb0, 0xb4, 0x86, 0x7d, 0x1c, 0x90, 0xd6, 0x6a, 0xc8,
+	0xb7, 0x68, 0x40, 0x76, 0x11, 0x27, 0xd4, 0x57, 0xfe, 0x85, 0x19, 0x8f, 0x39, 0x2a, 0xa8, 0x80,
+	0x5a, 0xde, 0x14, 0x9f, 0x2b, 0x4f, 0xbe, 0x1d, 0x2c, 0x55, 0x47, 0xd5, 0xa8, 0x0f, 0x31, 0xdf,
+	0x9e, 0xed, 0x2f, 0x96, 0x25, 0x6c, 0x89, 0xd9, 0xdb, 0xc6, 0xa8, 0xd3, 0xab, 0x5f, 0x73, 0xf0,
+	0x3f, 0x33, 0x74, 0x71, 0x03, 0x31, 0x6c, 0x0f, 0x27, 0x6e, 0xc2, 0x22, 0x1a, 0x0c, 0xc4, 0x12,
+	0x0a, 0xf5, 0x92, 0x2e, 0x6b, 0xd4, 0x7b, 0x35, 0xea, 0xab, 0x7e, 0xd4, 0xb8, 0x31, 0x9e, 0x26,
+	0x73, 0xf8, 0x48, 0x65, 0x01, 0x4e, 0x7b, 0xa8, 0x63, 0xe1, 0x0e, 0x6e, 0x85, 0x22, 0xc0, 0xd4,
+	0x74, 0x05, 0xd4, 0xb2, 0x66, 0xd1, 0x43, 0x9d, 0x87, 0xfd, 0xa0, 0xa2, 0x41, 0x38, 0x00, 0xc9,
+	0xc4, 0x90, 0x81, 0x88, 0xf2, 0x0e, 0xc0, 0x59, 0x1b, 0x11, 0x37, 0xb2, 0x58, 0x1b, 0xfb, 0xb6,
+	0xe5, 0x12, 0x8f, 0x70, 0x35, 0x5b, 0xc9, 0xd4, 0x0a, 0xf5, 0x39, 0x3d, 0x91, 0x25, 0x8c, 0xef,
+	0xab, 0xba, 0x4f, 0x89, 0xdf, 0x78, 0xd4, 0x3d, 0x2a, 0xa7, 0x3e, 0x1f, 0x97, 0x6b, 0x0e, 0xe1,
+	0x5b, 0x61, 0x53, 0x6f, 0x51, 0x2f, 0xb9, 0x05, 0xc6, 0x80, 0x6f, 0x3c, 0x6a, 0x63, 0x16, 0x13,
+	0xd8, 0x87, 0xb3, 0xfd, 0xc5, 0x7f, 0x5c, 0xec, 0xa0, 0x56, 0x64, 0x89, 0xd6, 0xb1, 0x4f, 0x67,
+	0xfb, 0x8b, 0xc0, 0x9c, 0x89, 0x73, 0x6f, 0x88, 0xd4, 0xeb, 0x22, 0xb3, 0xf2, 0x06, 0xc0, 0xc2,
+	0xb9, 0x1e, 0xae, 0xe6, 0xae, 0x4b, 0x09, 0xec, 0x2b, 0xe1, 0xca, 0x1d, 0x98, 0xb7, 0x51, 0x64,
+	0x05, 0x98, 0x61, 0xae, 0x4e, 0xc4, 0xbd, 0x9b, 0xbf, 0xd4, 0xbb, 0xcd, 0xde, 0xb5, 0x6e, 0x64,
+	0xf7, 0x8e, 0xcb, 0xc0, 0x9c, 0xb2, 0x51, 0x64, 0x0a, 0x86, 0xf2, 0x02, 0xce, 0x20, 0xd7, 0xa5,
+	0x3b, 0xd8, 0xb6, 0x76, 0x88, 0x6f, 0xd3, 0x1d, 0xa6, 0x4e, 0xc6, 0x65, 0x2c, 0xe8, 0x23, 0xfb,
+	0xdc, 0x6f, 0xd7, 0xf3, 0x18, 0xdd, 0xc8, 0x8b, 0x92, 0xa4, 0xaa, 0xe9, 0xe4, 0x20, 0xb9, 0xc3,
+	0x56, 0xd6, 0xc7, 0xbf, 0xc0, 0xff, 0x0f, 0x94, 0x3f, 0xfa, 0x9e, 0x56, 0xdf, 0x03, 0x38, 0x73,
+	0x21, 0xb9, 0x72, 0x17, 0xe6, 0x18, 0x47, 0x01, 0x57, 0xc1, 0x95, 0x75, 0x17, 0x85, 0x4e, 0x51,
+	0xbb, 0xd4, 0x2a, 0x79, 0xca, 0x6d, 0x98, 0xc1, 0xbe, 0xad, 0xa6, 0xff, 0x94, 0x2e, 0x58, 0xd5,
+	0x2f, 0x00, 0xe6, 0xd6, 0x02, 0xe4, 0xf3, 0x6b, 0x99, 0xa1, 0x07, 0x62, 0x38, 0xda, 0x24, 0x90,
+	0x09, 0xae, 0x56, 0x3c, 0xd5, 0x3d, 0x2a, 0x83, 0xb8, 0xd9, 0x03, 0xbc, 0xea, 0xc7, 0x34, 0x54,
+	0x62, 0xcd, 0xc3, 0x8f, 0x40, 0x1d, 0x4e, 0x3a, 0x22, 0x8a, 0x03, 0xf9, 0x02, 0x35, 0xd4, 0xef,
+	0x07, 0x4b, 0xbd, 0x07, 0x76, 0xd5, 0xb6, 0x03, 0xcc, 0xd8, 0x06, 0x0f, 0x88, 0xef, 0x98, 0x3d,
+	0xe0, 0x39, 0x07, 0xab, 0xe9, 0xf1, 0x38, 0xf8, 0xb2, 0x51, 0x99, 0xbf, 0x6f, 0xd4, 0xbd, 0x21,
+	0xa3, 0xb2, 0x63, 0x4e, 0xc4, 0xa0, 0x49, 0xb7, 0xe0, 0x74, 0xec, 0xd1, 0xd3, 0x10, 0x87, 0xf8,
+	0x31, 0xc7, 0x9e, 0x52, 0x85, 0x45, 0x8f, 0x39, 0x96, 0x98, 0x4c, 0x2b, 0x0c, 0x5c, 0xa6, 0x82,
+	0x4a, 0xa6, 0x96, 0x37, 0x0b, 0x1e, 0x73, 0x36, 0xa3, 0x36, 0x7e, 0x16, 0xb8, 0xac, 0x51, 0xef,
+	0xfe, 0xd2, 0x52, 0xdd, 0x13, 0x0d, 0x1c, 0x9e, 0x68, 0xe0, 0xe7, 0x89, 0x06, 0xf6, 0x4e, 0xb5,
+	0xd4, 0xe1, 0xa9, 0x96, 0xfa, 0x71, 0xaa, 0xa5, 0x5e, 0x26, 0xc6, 0x30, 0x7b, 0x5b, 0x27, 0xd4,
+	0xe8, 0xc8, 0x7f, 0xb2, 0xe6, 0x44, 0xac, 0xe7, 0xe6, 0xef, 0x01, 0x00, 0x4e, 0x04, 0x41, 0x46,
+	0xee, 0x06, 0x00, 0x00,
 }
 
 func (m *GenericAuthorization) Marshal() (dAtA []byte, err error) {
@@ -265,7 +387,7 @@ func (m *GenericAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Grant) Marshal() (dAtA []byte, err error) {
+func (m *RuleBasedAuthorization) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -275,24 +397,160 @@ func (m *Grant) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Grant) MarshalTo(dAtA []byte) (int, error) {
+func (m *RuleBasedAuthorization) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Grant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RuleBasedAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Expiration != nil {
-		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+	if len(m.AllowedWindows) > 0 {
+		for iNdEx := len(m.AllowedWindows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllowedWindows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.DayReset != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DayReset, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DayReset):])
 		if err1 != nil {
 			return 0, err1
 		}
 		i -= n1
 		i = encodeVarintAuthz(dAtA, i, uint64(n1))
 		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.DailySpent) > 0 {
+		for iNdEx := len(m.DailySpent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DailySpent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.DailySpendLimit) > 0 {
+		for iNdEx := len(m.DailySpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DailySpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Executions != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.Executions))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxExecutions != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.MaxExecutions))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Authorization != nil {
+		{
+			size, err := m.Authorization.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuthz(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecutionWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutionWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutionWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.End, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.End):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintAuthz(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Start, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Start):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintAuthz(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Grant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Grant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Grant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiration != nil {
+		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintAuthz(dAtA, i, uint64(n5))
+		i--
 		dAtA[i] = 0x12
 	}
 	if m.Authorization != nil {
@@ -331,12 +589,12 @@ func (m *GrantAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Expiration != nil {
-		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
-		if err3 != nil {
-			return 0, err3
+		n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err7 != nil {
+			return 0, err7
 		}
-		i -= n3
-		i = encodeVarintAuthz(dAtA, i, uint64(n3))
+		i -= n7
+		i = encodeVarintAuthz(dAtA, i, uint64(n7))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -425,6 +683,60 @@ func (m *GenericAuthorization) Size() (n int) {
 	return n
 }
 
+func (m *RuleBasedAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Authorization != nil {
+		l = m.Authorization.Size()
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if m.MaxExecutions != 0 {
+		n += 1 + sovAuthz(uint64(m.MaxExecutions))
+	}
+	if m.Executions != 0 {
+		n += 1 + sovAuthz(uint64(m.Executions))
+	}
+	if len(m.DailySpendLimit) > 0 {
+		for _, e := range m.DailySpendLimit {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.DailySpent) > 0 {
+		for _, e := range m.DailySpent {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if m.DayReset != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DayReset)
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if len(m.AllowedWindows) > 0 {
+		for _, e := range m.AllowedWindows {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ExecutionWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Start)
+	n += 1 + l + sovAuthz(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.End)
+	n += 1 + l + sovAuthz(uint64(l))
+	return n
+}
+
 func (m *Grant) Size() (n int) {
 	if m == nil {
 		return 0
@@ -570,6 +882,384 @@ func (m *GenericAuthorization) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RuleBasedAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RuleBasedAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RuleBasedAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorization", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Authorization == nil {
+				m.Authorization = &any.Any{}
+			}
+			if err := m.Authorization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExecutions", wireType)
+			}
+			m.MaxExecutions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExecutions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executions", wireType)
+			}
+			m.Executions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Executions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DailySpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DailySpendLimit = append(m.DailySpendLimit, types.Coin{})
+			if err := m.DailySpendLimit[len(m.DailySpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DailySpent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DailySpent = append(m.DailySpent, types.Coin{})
+			if err := m.DailySpent[len(m.DailySpent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DayReset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DayReset == nil {
+				m.DayReset = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.DayReset, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedWindows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedWindows = append(m.AllowedWindows, ExecutionWindow{})
+			if err := m.AllowedWindows[len(m.AllowedWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecutionWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecutionWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecutionWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Start, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.End, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Grant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -20,4 +20,8 @@ var (
 	ErrAuthorizationNumOfSigners = errors.Register(ModuleName, 9, "authorization can be given to msg with only one signer")
 	// ErrNegativeMaxTokens error if the max tokens is negative
 	ErrNegativeMaxTokens = errors.Register(ModuleName, 12, "max tokens should be positive")
+	// ErrMaxExecDepthExceeded error if MsgExec are nested deeper than allowed
+	ErrMaxExecDepthExceeded = errors.Register(ModuleName, 13, "maximum exec depth exceeded")
+	// ErrExecCycle error if a nested MsgExec is executed by a grantee of an enclosing MsgExec
+	ErrExecCycle = errors.Register(ModuleName, 14, "cyclic exec")
 )
@@ -2,6 +2,7 @@ package authz
 
 import (
 	context "context"
+	"time"
 
 	"cosmossdk.io/core/address"
 
@@ -20,3 +21,16 @@ type BankKeeper interface {
 	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
 	IsSendEnabledCoins(ctx context.Context, coins ...sdk.Coin) error
 }
+
+// AuthzHooks event hooks for authorization grants (noalias)
+type AuthzHooks interface {
+	AfterGrantCreated(ctx context.Context, granter, grantee sdk.AccAddress, authorization Authorization, expiration *time.Time) error // Must be called after a grant is saved
+	AfterGrantRevoked(ctx context.Context, granter, grantee sdk.AccAddress, msgTypeURL string) error                                  // Must be called after a grant is deleted
+	BeforeExec(ctx context.Context, granter, grantee sdk.AccAddress, msg sdk.Msg) error                                               // Must be called before a message is executed on behalf of the granter
+}
+
+// AuthzHooksWrapper is a wrapper for modules to inject AuthzHooks using depinject.
+type AuthzHooksWrapper struct{ AuthzHooks }
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (AuthzHooksWrapper) IsOnePerModuleType() {}
@@ -0,0 +1,45 @@
+package authz
+
+import (
+	"context"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ AuthzHooks = MultiAuthzHooks{}
+
+// MultiAuthzHooks combines multiple authz hooks, all hook functions are run in array sequence
+// until one of them returns an error
+type MultiAuthzHooks []AuthzHooks
+
+func NewMultiAuthzHooks(hooks ...AuthzHooks) MultiAuthzHooks {
+	return hooks
+}
+
+func (h MultiAuthzHooks) AfterGrantCreated(ctx context.Context, granter, grantee sdk.AccAddress, authorization Authorization, expiration *time.Time) error {
+	for i := range h {
+		if err := h[i].AfterGrantCreated(ctx, granter, grantee, authorization, expiration); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiAuthzHooks) AfterGrantRevoked(ctx context.Context, granter, grantee sdk.AccAddress, msgTypeURL string) error {
+	for i := range h {
+		if err := h[i].AfterGrantRevoked(ctx, granter, grantee, msgTypeURL); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiAuthzHooks) BeforeExec(ctx context.Context, granter, grantee sdk.AccAddress, msg sdk.Msg) error {
+	for i := range h {
+		if err := h[i].BeforeExec(ctx, granter, grantee, msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
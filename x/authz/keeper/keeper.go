@@ -26,10 +26,23 @@ import (
 // https://github.com/cosmos/cosmos-sdk/discussions/9072
 const gasCostPerIteration = uint64(20)
 
+// DefaultMaxExecDepth is the default maximum number of nested MsgExec.
+const DefaultMaxExecDepth = uint64(5)
+
 type Keeper struct {
 	environment appmodule.Environment
 	cdc         codec.Codec
 	authKeeper  authz.AccountKeeper
+	hooks       *hooks
+
+	// maxExecDepth is the maximum number of nested MsgExec.
+	maxExecDepth uint64
+}
+
+// hooks houses the AuthzHooks. It exists so that the hooks can be set on the
+// Keeper, which is passed around by value, without needing a pointer receiver.
+type hooks struct {
+	authz.AuthzHooks
 }
 
 // NewKeeper constructs a message authorization Keeper
@@ -38,7 +51,37 @@ func NewKeeper(env appmodule.Environment, cdc codec.Codec, ak authz.AccountKeepe
 		environment: env,
 		cdc:         cdc,
 		authKeeper:  ak,
+		hooks:       &hooks{},
+
+		maxExecDepth: DefaultMaxExecDepth,
+	}
+}
+
+// WithMaxExecDepth returns a copy of the keeper which allows at most depth
+// nested MsgExec.
+func (k Keeper) WithMaxExecDepth(depth uint64) Keeper {
+	k.maxExecDepth = depth
+	return k
+}
+
+// Hooks gets the hooks for the authz Keeper.
+func (k Keeper) Hooks() authz.AuthzHooks {
+	if k.hooks.AuthzHooks == nil {
+		// return a no-op implementation if no hooks are set
+		return authz.MultiAuthzHooks{}
 	}
+
+	return k.hooks.AuthzHooks
+}
+
+// SetHooks sets the hooks for authz. Hooks are shared by all the copies of the
+// Keeper, so they must be set before the Keeper is used.
+func (k Keeper) SetHooks(ah authz.AuthzHooks) {
+	if k.hooks.AuthzHooks != nil {
+		panic("cannot set authz hooks twice")
+	}
+
+	k.hooks.AuthzHooks = ah
 }
 
 // Logger returns a module-specific logger.
@@ -146,6 +189,10 @@ func (k Keeper) DispatchActions(ctx context.Context, grantee sdk.AccAddress, msg
 			}
 		}
 
+		if err := k.Hooks().BeforeExec(ctx, granter, grantee, msg); err != nil {
+			return nil, err
+		}
+
 		// no need to use the branch service here, as if the transaction fails, the transaction will be reverted
 		_, err = k.environment.RouterService.MessageRouterService().InvokeUntyped(ctx, msg)
 		if err != nil {
@@ -206,11 +253,16 @@ func (k Keeper) SaveGrant(ctx context.Context, grantee, granter sdk.AccAddress,
 		return err
 	}
 
-	return k.environment.EventService.EventManager(ctx).Emit(&authz.EventGrant{
+	err = k.environment.EventService.EventManager(ctx).Emit(&authz.EventGrant{
 		MsgTypeUrl: authorization.MsgTypeURL(),
 		Granter:    granterAddr,
 		Grantee:    granteeAddr,
 	})
+	if err != nil {
+		return err
+	}
+
+	return k.Hooks().AfterGrantCreated(ctx, granter, grantee, authorization, expiration)
 }
 
 // DeleteGrant revokes any authorization for the provided message type granted to the grantee
@@ -244,11 +296,51 @@ func (k Keeper) DeleteGrant(ctx context.Context, grantee, granter sdk.AccAddress
 	if err != nil {
 		return err
 	}
-	return k.environment.EventService.EventManager(ctx).Emit(&authz.EventRevoke{
+	err = k.environment.EventService.EventManager(ctx).Emit(&authz.EventRevoke{
 		MsgTypeUrl: msgType,
 		Granter:    granterAddr,
 		Grantee:    granteeAddr,
 	})
+	if err != nil {
+		return err
+	}
+
+	return k.Hooks().AfterGrantRevoked(ctx, granter, grantee, msgType)
+}
+
+// DeleteAllGrants revokes all the grants issued by the granter, or only the ones for
+// msgType if it is not empty. It returns an error if no grant was found.
+func (k Keeper) DeleteAllGrants(ctx context.Context, granter sdk.AccAddress, msgType string) error {
+	store := runtime.KVStoreAdapter(k.environment.KVStoreService.OpenKVStore(ctx))
+	iter := storetypes.KVStorePrefixIterator(store, grantStoreKey(nil, granter, ""))
+
+	type grantKey struct {
+		grantee sdk.AccAddress
+		msgType string
+	}
+
+	var keys []grantKey
+	for ; iter.Valid(); iter.Next() {
+		_, grantee, t := parseGrantStoreKey(iter.Key())
+		if msgType == "" || t == msgType {
+			keys = append(keys, grantKey{grantee: grantee, msgType: t})
+		}
+	}
+	if err := iter.Close(); err != nil {
+		return err
+	}
+
+	if len(keys) == 0 {
+		return errorsmod.Wrapf(authz.ErrNoAuthorizationFound, "failed to get grants with given granter: %s", granter)
+	}
+
+	for _, key := range keys {
+		if err := k.DeleteGrant(ctx, key.grantee, granter, key.msgType); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 // GetAuthorizations Returns list of `Authorizations` granted to the grantee by the granter.
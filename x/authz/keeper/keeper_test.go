@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -23,6 +24,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 )
 
@@ -368,6 +370,100 @@ func (s *TestSuite) TestDispatchedEvents() {
 	}
 }
 
+func (s *TestSuite) TestDispatchActionRuleBasedAuthorization() {
+	require := s.Require()
+	granterAddr, granteeAddr := s.addrs[0], s.addrs[1]
+	granterStrAddr, err := s.accountKeeper.AddressCodec().BytesToString(granterAddr)
+	require.NoError(err)
+	recipientStrAddr, err := s.accountKeeper.AddressCodec().BytesToString(s.addrs[2])
+	require.NoError(err)
+
+	authorization, err := authz.NewRuleBasedAuthorization(authz.NewGenericAuthorization(bankSendAuthMsgType))
+	require.NoError(err)
+	authorization.MaxExecutions = 2
+	authorization.DailySpendLimit = coins10
+
+	expiration := s.ctx.HeaderInfo().Time.AddDate(1, 0, 0)
+	require.NoError(s.authzKeeper.SaveGrant(s.ctx, granteeAddr, granterAddr, authorization, &expiration))
+
+	send := func(amount sdk.Coins) []sdk.Msg {
+		return []sdk.Msg{&banktypes.MsgSend{Amount: amount, FromAddress: granterStrAddr, ToAddress: recipientStrAddr}}
+	}
+
+	// exceeds the daily spend limit
+	_, err = s.authzKeeper.DispatchActions(s.ctx, granteeAddr, send(coins100))
+	require.ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	_, err = s.authzKeeper.DispatchActions(s.ctx, granteeAddr, send(sdk.NewCoins(sdk.NewInt64Coin("stake", 4))))
+	require.NoError(err)
+
+	stored, _ := s.authzKeeper.GetAuthorization(s.ctx, granteeAddr, granterAddr, bankSendAuthMsgType)
+	require.NotNil(stored)
+	require.Equal(uint64(1), stored.(*authz.RuleBasedAuthorization).Executions)
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 4)), stored.(*authz.RuleBasedAuthorization).DailySpent)
+
+	// the grant is deleted after the last allowed execution
+	_, err = s.authzKeeper.DispatchActions(s.ctx, granteeAddr, send(sdk.NewCoins(sdk.NewInt64Coin("stake", 4))))
+	require.NoError(err)
+
+	stored, _ = s.authzKeeper.GetAuthorization(s.ctx, granteeAddr, granterAddr, bankSendAuthMsgType)
+	require.Nil(stored)
+}
+
+// mockHooks records the authz hooks calls and optionally fails BeforeExec.
+type mockHooks struct {
+	created, revoked, executed int
+	execErr                    error
+}
+
+func (h *mockHooks) AfterGrantCreated(context.Context, sdk.AccAddress, sdk.AccAddress, authz.Authorization, *time.Time) error {
+	h.created++
+	return nil
+}
+
+func (h *mockHooks) AfterGrantRevoked(context.Context, sdk.AccAddress, sdk.AccAddress, string) error {
+	h.revoked++
+	return nil
+}
+
+func (h *mockHooks) BeforeExec(context.Context, sdk.AccAddress, sdk.AccAddress, sdk.Msg) error {
+	h.executed++
+	return h.execErr
+}
+
+func (s *TestSuite) TestHooks() {
+	require := s.Require()
+	granterAddr, granteeAddr := s.addrs[0], s.addrs[1]
+	granterStrAddr, err := s.accountKeeper.AddressCodec().BytesToString(granterAddr)
+	require.NoError(err)
+	recipientStrAddr, err := s.accountKeeper.AddressCodec().BytesToString(s.addrs[2])
+	require.NoError(err)
+
+	hooks, next := &mockHooks{}, &mockHooks{}
+	s.authzKeeper.SetHooks(authz.NewMultiAuthzHooks(hooks, next))
+	require.Panics(func() { s.authzKeeper.SetHooks(hooks) })
+
+	expiration := s.ctx.HeaderInfo().Time.AddDate(1, 0, 0)
+	require.NoError(s.authzKeeper.SaveGrant(s.ctx, granteeAddr, granterAddr, &banktypes.SendAuthorization{SpendLimit: coins100}, &expiration))
+	require.Equal(1, hooks.created)
+
+	msgs := []sdk.Msg{&banktypes.MsgSend{Amount: coins10, FromAddress: granterStrAddr, ToAddress: recipientStrAddr}}
+	_, err = s.authzKeeper.DispatchActions(s.ctx, granteeAddr, msgs)
+	require.NoError(err)
+	require.Equal(1, hooks.executed)
+
+	// an error in BeforeExec aborts the execution
+	hooks.execErr = sdkerrors.ErrUnauthorized
+	_, err = s.authzKeeper.DispatchActions(s.ctx, granteeAddr, msgs)
+	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	require.Equal(2, hooks.executed)
+	// the hooks after the failing one are not run
+	require.Equal(1, next.executed)
+
+	require.NoError(s.authzKeeper.DeleteGrant(s.ctx, granteeAddr, granterAddr, bankSendAuthMsgType))
+	require.Equal(1, hooks.revoked)
+}
+
 func (s *TestSuite) TestDequeueAllGrantsQueue() {
 	require := s.Require()
 	addrs := s.addrs
@@ -79,6 +79,20 @@ func (k Keeper) Revoke(ctx context.Context, msg *authz.MsgRevoke) (*authz.MsgRev
 	return &authz.MsgRevokeResponse{}, nil
 }
 
+// RevokeAll implements the MsgServer.RevokeAll method.
+func (k Keeper) RevokeAll(ctx context.Context, msg *authz.MsgRevokeAll) (*authz.MsgRevokeAllResponse, error) {
+	granter, err := k.authKeeper.AddressCodec().StringToBytes(msg.Granter)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid granter address: %s", err)
+	}
+
+	if err = k.DeleteAllGrants(ctx, granter, msg.MsgTypeUrl); err != nil {
+		return nil, err
+	}
+
+	return &authz.MsgRevokeAllResponse{}, nil
+}
+
 // Exec implements the MsgServer.Exec method.
 func (k Keeper) Exec(ctx context.Context, msg *authz.MsgExec) (*authz.MsgExecResponse, error) {
 	if msg.Grantee == "" {
@@ -94,6 +108,12 @@ func (k Keeper) Exec(ctx context.Context, msg *authz.MsgExec) (*authz.MsgExecRes
 		return nil, sdkerrors.ErrInvalidRequest.Wrapf("messages cannot be empty")
 	}
 
+	// a nested MsgExec is copied when routed, which drops the cached values of
+	// its messages, so make sure they are unpacked.
+	if err := msg.UnpackInterfaces(k.cdc.InterfaceRegistry()); err != nil {
+		return nil, err
+	}
+
 	msgs, err := msg.GetMessages()
 	if err != nil {
 		return nil, err
@@ -103,6 +123,11 @@ func (k Keeper) Exec(ctx context.Context, msg *authz.MsgExec) (*authz.MsgExecRes
 		return nil, err
 	}
 
+	ctx, err = k.enterExec(ctx, grantee)
+	if err != nil {
+		return nil, err
+	}
+
 	results, err := k.DispatchActions(ctx, grantee, msgs)
 	if err != nil {
 		return nil, err
@@ -111,6 +136,32 @@ func (k Keeper) Exec(ctx context.Context, msg *authz.MsgExec) (*authz.MsgExecRes
 	return &authz.MsgExecResponse{Results: results}, nil
 }
 
+// execGranteesKey is the context key of the grantees of the MsgExec being
+// executed, from the outermost to the innermost one.
+type execGranteesKey struct{}
+
+// enterExec returns a context recording that grantee executes a MsgExec. It
+// fails if the MsgExec is nested deeper than allowed, or if grantee already
+// executes one of the enclosing MsgExec, which would make the execution cyclic.
+func (k Keeper) enterExec(ctx context.Context, grantee sdk.AccAddress) (context.Context, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	grantees, _ := sdkCtx.Value(execGranteesKey{}).([]sdk.AccAddress)
+
+	for _, g := range grantees {
+		if g.Equals(grantee) {
+			return nil, authz.ErrExecCycle.Wrap("grantee already executes an enclosing MsgExec")
+		}
+	}
+
+	if uint64(len(grantees)) >= k.maxExecDepth {
+		return nil, authz.ErrMaxExecDepthExceeded.Wrapf("max depth is %d", k.maxExecDepth)
+	}
+
+	// copy the grantees so that sibling MsgExec don't share the backing array
+	grantees = append(grantees[:len(grantees):len(grantees)], grantee)
+	return sdkCtx.WithValue(execGranteesKey{}, grantees), nil
+}
+
 func (k Keeper) PruneExpiredGrants(ctx context.Context, msg *authz.MsgPruneExpiredGrants) (*authz.MsgPruneExpiredGrantsResponse, error) {
 	// 75 is an arbitrary value, we can change it later if needed
 	if err := k.DequeueAndDeleteExpiredGrants(ctx, 75); err != nil {
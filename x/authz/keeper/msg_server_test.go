@@ -316,6 +316,52 @@ func (suite *TestSuite) TestRevoke() {
 	}
 }
 
+func (suite *TestSuite) TestRevokeAll() {
+	granter, grantee1, grantee2 := suite.addrs[0], suite.addrs[1], suite.addrs[2]
+	granterStrAddr, err := suite.accountKeeper.AddressCodec().BytesToString(granter)
+	suite.Require().NoError(err)
+
+	genericMsgType := sdk.MsgTypeURL(&banktypes.MsgMultiSend{})
+	grantAll := func() {
+		suite.createSendAuthorization(grantee1, granter)
+		suite.createSendAuthorization(grantee2, granter)
+		exp := suite.ctx.HeaderInfo().Time.Add(time.Hour)
+		suite.Require().NoError(suite.authzKeeper.SaveGrant(suite.ctx, grantee1, granter, authz.NewGenericAuthorization(genericMsgType), &exp))
+		// grants issued by other granters are not revoked
+		suite.createSendAuthorization(grantee1, grantee2)
+	}
+
+	_, err = suite.msgSrvr.RevokeAll(suite.ctx, &authz.MsgRevokeAll{Granter: "invalid"})
+	suite.Require().ErrorContains(err, "invalid bech32 string")
+
+	_, err = suite.msgSrvr.RevokeAll(suite.ctx, &authz.MsgRevokeAll{Granter: granterStrAddr})
+	suite.Require().ErrorIs(err, authz.ErrNoAuthorizationFound)
+
+	// revoke only the grants for a msg type
+	grantAll()
+	_, err = suite.msgSrvr.RevokeAll(suite.ctx, &authz.MsgRevokeAll{Granter: granterStrAddr, MsgTypeUrl: bankSendAuthMsgType})
+	suite.Require().NoError(err)
+
+	authorizations, err := suite.authzKeeper.GetAuthorizations(suite.ctx, grantee1, granter)
+	suite.Require().NoError(err)
+	suite.Require().Len(authorizations, 1)
+	suite.Require().Equal(genericMsgType, authorizations[0].MsgTypeURL())
+	authorizations, err = suite.authzKeeper.GetAuthorizations(suite.ctx, grantee2, granter)
+	suite.Require().NoError(err)
+	suite.Require().Len(authorizations, 0)
+
+	// revoke all the grants
+	_, err = suite.msgSrvr.RevokeAll(suite.ctx, &authz.MsgRevokeAll{Granter: granterStrAddr})
+	suite.Require().NoError(err)
+
+	authorizations, err = suite.authzKeeper.GetAuthorizations(suite.ctx, grantee1, granter)
+	suite.Require().NoError(err)
+	suite.Require().Len(authorizations, 0)
+	authorizations, err = suite.authzKeeper.GetAuthorizations(suite.ctx, grantee1, grantee2)
+	suite.Require().NoError(err)
+	suite.Require().Len(authorizations, 1)
+}
+
 func (suite *TestSuite) TestExec() {
 	addrs := suite.createAccounts()
 
@@ -385,6 +431,51 @@ func (suite *TestSuite) TestExec() {
 	}
 }
 
+func (suite *TestSuite) TestNestedExec() {
+	require := suite.Require()
+	a, b, c := suite.addrs[0], suite.addrs[1], suite.addrs[2]
+	aStr, err := suite.accountKeeper.AddressCodec().BytesToString(a)
+	require.NoError(err)
+	bStr, err := suite.accountKeeper.AddressCodec().BytesToString(b)
+	require.NoError(err)
+	cStr, err := suite.accountKeeper.AddressCodec().BytesToString(c)
+	require.NoError(err)
+
+	// nested MsgExec are routed back to the authz message server
+	k := suite.authzKeeper.WithMaxExecDepth(2)
+	authz.RegisterMsgServer(suite.baseApp.MsgServiceRouter(), k)
+
+	execType := sdk.MsgTypeURL(&authz.MsgExec{})
+	exp := suite.ctx.HeaderInfo().Time.Add(time.Hour)
+	// b can execute on behalf of a, and c on behalf of b
+	require.NoError(k.SaveGrant(suite.ctx, b, a, authz.NewGenericAuthorization(execType), &exp))
+	require.NoError(k.SaveGrant(suite.ctx, c, b, authz.NewGenericAuthorization(execType), &exp))
+	require.NoError(k.SaveGrant(suite.ctx, b, a, authz.NewGenericAuthorization(bankSendAuthMsgType), &exp))
+	require.NoError(k.SaveGrant(suite.ctx, a, b, authz.NewGenericAuthorization(execType), &exp))
+
+	send := &banktypes.MsgSend{FromAddress: aStr, ToAddress: cStr, Amount: coins10}
+	nested := func(grantee string, msg sdk.Msg) *authz.MsgExec {
+		m := authz.NewMsgExec(grantee, []sdk.Msg{msg})
+		return &m
+	}
+
+	// c executes as b a MsgExec sending from a
+	_, err = k.Exec(suite.ctx, nested(cStr, nested(bStr, send)))
+	require.NoError(err)
+
+	// one MsgExec too many
+	_, err = k.Exec(suite.ctx, nested(cStr, nested(bStr, nested(aStr, send))))
+	require.ErrorIs(err, authz.ErrMaxExecDepthExceeded)
+
+	// b executes as a a MsgExec executed by b again
+	_, err = k.Exec(suite.ctx, nested(bStr, nested(aStr, nested(bStr, send))))
+	require.ErrorIs(err, authz.ErrExecCycle)
+
+	// a grantee executing its own nested MsgExec is a cycle as well
+	_, err = k.Exec(suite.ctx, nested(bStr, nested(bStr, send)))
+	require.ErrorIs(err, authz.ErrExecCycle)
+}
+
 func (suite *TestSuite) TestPruneExpiredGrants() {
 	addrs := suite.createAccounts()
 
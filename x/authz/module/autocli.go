@@ -70,6 +70,15 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "msg_type_url"},
 					},
 				},
+				{
+					RpcMethod: "RevokeAll",
+					Use:       "revoke-all --from [granter]",
+					Short:     "Revoke all authorizations issued by a granter",
+					Long:      "Revoke all authorizations issued by a granter to any grantee, optionally only the ones for the given msg type url.",
+					Example: fmt.Sprintf(`%s tx authz revoke-all --from=cosmos1skj.. or
+%[1]s tx authz revoke-all --msg-type-url %s --from=cosmos1skj..`,
+						version.AppName, bank.SendAuthorization{}.MsgTypeURL()),
+				},
 				{
 					RpcMethod: "PruneExpiredGrants",
 					Use:       "prune-grants --from [granter]",
@@ -1,6 +1,11 @@
 package module
 
 import (
+	"fmt"
+	"sort"
+
+	"golang.org/x/exp/maps"
+
 	modulev1 "cosmossdk.io/api/cosmos/authz/module/v1"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/depinject"
@@ -21,12 +26,14 @@ func init() {
 	appconfig.RegisterModule(
 		&modulev1.Module{},
 		appconfig.Provide(ProvideModule),
+		appconfig.Invoke(InvokeSetHooks),
 	)
 }
 
 type ModuleInputs struct {
 	depinject.In
 
+	Config        *modulev1.Module
 	Cdc           codec.Codec
 	AccountKeeper authz.AccountKeeper
 	BankKeeper    authz.BankKeeper
@@ -43,6 +50,36 @@ type ModuleOutputs struct {
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
 	k := keeper.NewKeeper(in.Environment, in.Cdc, in.AccountKeeper)
+	if in.Config.MaxExecDepth != 0 {
+		k = k.WithMaxExecDepth(in.Config.MaxExecDepth)
+	}
+
 	m := NewAppModule(in.Cdc, k, in.AccountKeeper, in.BankKeeper, in.Registry)
 	return ModuleOutputs{AuthzKeeper: k, Module: m}
 }
+
+// InvokeSetHooks sets the authz hooks provided by other modules on the keeper.
+// The keeper shares its hooks across copies, so it can be received by value.
+func InvokeSetHooks(keeper keeper.Keeper, authzHooks map[string]authz.AuthzHooksWrapper) error {
+	if len(authzHooks) == 0 {
+		return nil
+	}
+
+	// Default ordering is lexical by module name.
+	// Explicit ordering can be added to the module config if required.
+	modNames := maps.Keys(authzHooks)
+	order := modNames
+	sort.Strings(order)
+
+	var multiHooks authz.MultiAuthzHooks
+	for _, modName := range order {
+		hook, ok := authzHooks[modName]
+		if !ok {
+			return fmt.Errorf("can't find authz hooks for module %s", modName)
+		}
+		multiHooks = append(multiHooks, hook)
+	}
+
+	keeper.SetHooks(multiHooks)
+	return nil
+}
@@ -13,6 +13,7 @@ import (
 var (
 	_ sdk.Msg = &MsgGrant{}
 	_ sdk.Msg = &MsgRevoke{}
+	_ sdk.Msg = &MsgRevokeAll{}
 	_ sdk.Msg = &MsgExec{}
 
 	_ cdctypes.UnpackInterfacesMessage = &MsgGrant{}
@@ -79,6 +80,14 @@ func NewMsgRevoke(granter, grantee, msgTypeURL string) MsgRevoke {
 	}
 }
 
+// NewMsgRevokeAll creates a new MsgRevokeAll
+func NewMsgRevokeAll(granter, msgTypeURL string) MsgRevokeAll {
+	return MsgRevokeAll{
+		Granter:    granter,
+		MsgTypeUrl: msgTypeURL,
+	}
+}
+
 // NewMsgExec creates a new MsgExecAuthorized
 func NewMsgExec(grantee string, msgs []sdk.Msg) MsgExec {
 	msgsAny := make([]*cdctypes.Any, len(msgs))
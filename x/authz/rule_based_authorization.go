@@ -0,0 +1,217 @@
+package authz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	errorsmod "cosmossdk.io/errors"
+	bank "cosmossdk.io/x/bank/types"
+	staking "cosmossdk.io/x/staking/types"
+
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/authz"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const day = 24 * time.Hour
+
+var (
+	_ Authorization                    = &RuleBasedAuthorization{}
+	_ cdctypes.UnpackInterfacesMessage = &RuleBasedAuthorization{}
+)
+
+// NewRuleBasedAuthorization creates a new RuleBasedAuthorization wrapping the given
+// authorization. Constraints are added by setting the corresponding fields.
+func NewRuleBasedAuthorization(authorization Authorization) (*RuleBasedAuthorization, error) {
+	a := &RuleBasedAuthorization{}
+	if err := a.SetAuthorization(authorization); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a RuleBasedAuthorization) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	var authorization Authorization
+	return unpacker.UnpackAny(a.Authorization, &authorization)
+}
+
+// GetAuthorization returns the wrapped authorization.
+func (a RuleBasedAuthorization) GetAuthorization() (Authorization, error) {
+	if a.Authorization == nil {
+		return nil, errors.New("authorization cannot be empty")
+	}
+
+	authorization, ok := a.Authorization.GetCachedValue().(Authorization)
+	if !ok {
+		return nil, fmt.Errorf("expected authorization, got %T", a.Authorization.GetCachedValue())
+	}
+
+	return authorization, nil
+}
+
+// SetAuthorization sets the wrapped authorization.
+func (a *RuleBasedAuthorization) SetAuthorization(authorization Authorization) error {
+	msg, ok := authorization.(proto.Message)
+	if !ok {
+		return errorsmod.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", authorization)
+	}
+
+	any, err := cdctypes.NewAnyWithValue(msg)
+	if err != nil {
+		return err
+	}
+
+	a.Authorization = any
+	return nil
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a RuleBasedAuthorization) MsgTypeURL() string {
+	authorization, err := a.GetAuthorization()
+	if err != nil {
+		return ""
+	}
+
+	return authorization.MsgTypeURL()
+}
+
+// Accept implements Authorization.Accept. It checks the execution window, daily
+// spend limit and number of executions before deferring to the wrapped authorization.
+func (a RuleBasedAuthorization) Accept(ctx context.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	now := sdk.UnwrapSDKContext(ctx).HeaderInfo().Time
+
+	if !a.inAllowedWindow(now) {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrap("outside of the allowed execution windows")
+	}
+
+	if a.MaxExecutions > 0 && a.Executions >= a.MaxExecutions {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrap("maximum number of executions reached")
+	}
+
+	if !a.DailySpendLimit.Empty() {
+		if a.DayReset == nil || !now.Before(*a.DayReset) {
+			reset := now.Truncate(day).Add(day)
+			a.DayReset = &reset
+			a.DailySpent = nil
+		}
+
+		spent, err := msgSpendAmount(msg)
+		if err != nil {
+			return authz.AcceptResponse{}, err
+		}
+
+		a.DailySpent = a.DailySpent.Add(spent...)
+		if !a.DailySpent.IsAllLTE(a.DailySpendLimit) {
+			return authz.AcceptResponse{}, sdkerrors.ErrInsufficientFunds.Wrapf("daily spend limit exceeded: %s > %s", a.DailySpent, a.DailySpendLimit)
+		}
+	}
+
+	authorization, err := a.GetAuthorization()
+	if err != nil {
+		return authz.AcceptResponse{}, err
+	}
+
+	resp, err := authorization.Accept(ctx, msg)
+	if err != nil || !resp.Accept || resp.Delete {
+		return resp, err
+	}
+
+	if resp.Updated != nil {
+		updated, ok := resp.Updated.(Authorization)
+		if !ok {
+			return authz.AcceptResponse{}, fmt.Errorf("expected authz.Authorization but got %T", resp.Updated)
+		}
+		if err := a.SetAuthorization(updated); err != nil {
+			return authz.AcceptResponse{}, err
+		}
+	}
+
+	a.Executions++
+	if a.MaxExecutions > 0 && a.Executions == a.MaxExecutions {
+		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+
+	return authz.AcceptResponse{Accept: true, Updated: &a}, nil
+}
+
+func (a RuleBasedAuthorization) inAllowedWindow(now time.Time) bool {
+	if len(a.AllowedWindows) == 0 {
+		return true
+	}
+
+	for _, w := range a.AllowedWindows {
+		if !now.Before(w.Start) && now.Before(w.End) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a RuleBasedAuthorization) ValidateBasic() error {
+	authorization, err := a.GetAuthorization()
+	if err != nil {
+		return err
+	}
+	if err := authorization.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if a.MaxExecutions > 0 && a.Executions >= a.MaxExecutions {
+		return fmt.Errorf("executions (%d) must be less than max executions (%d)", a.Executions, a.MaxExecutions)
+	}
+
+	if !a.DailySpendLimit.Empty() {
+		if !a.DailySpendLimit.IsValid() || !a.DailySpendLimit.IsAllPositive() {
+			return sdkerrors.ErrInvalidCoins.Wrapf("invalid daily spend limit: %s", a.DailySpendLimit)
+		}
+		if _, ok := spendMsgTypeURLs[authorization.MsgTypeURL()]; !ok {
+			return sdkerrors.ErrInvalidRequest.Wrapf("daily spend limit is not supported for %s", authorization.MsgTypeURL())
+		}
+	}
+
+	if !a.DailySpent.IsValid() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("invalid daily spent amount: %s", a.DailySpent)
+	}
+
+	for _, w := range a.AllowedWindows {
+		if !w.Start.Before(w.End) {
+			return fmt.Errorf("execution window start (%s) must be before end (%s)", w.Start, w.End)
+		}
+	}
+
+	return nil
+}
+
+// spendMsgTypeURLs are the messages whose spent amount can be computed by msgSpendAmount.
+var spendMsgTypeURLs = map[string]struct{}{
+	sdk.MsgTypeURL(&bank.MsgSend{}):        {},
+	sdk.MsgTypeURL(&bank.MsgMultiSend{}):   {},
+	sdk.MsgTypeURL(&staking.MsgDelegate{}): {},
+}
+
+// msgSpendAmount returns the coins leaving the signer account when executing msg.
+func msgSpendAmount(msg sdk.Msg) (sdk.Coins, error) {
+	switch msg := msg.(type) {
+	case *bank.MsgSend:
+		return msg.Amount, nil
+	case *bank.MsgMultiSend:
+		var spent sdk.Coins
+		for _, in := range msg.Inputs {
+			spent = spent.Add(in.Coins...)
+		}
+		return spent, nil
+	case *staking.MsgDelegate:
+		return sdk.Coins{msg.Amount}, nil
+	default:
+		return nil, sdkerrors.ErrInvalidType.Wrapf("cannot compute the amount spent by %T", msg)
+	}
+}
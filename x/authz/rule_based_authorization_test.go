@@ -0,0 +1,137 @@
+package authz_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/header"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/authz"
+	banktypes "cosmossdk.io/x/bank/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func TestRuleBasedAuthorizationValidateBasic(t *testing.T) {
+	sendURL := banktypes.SendAuthorization{}.MsgTypeURL()
+	now := time.Now()
+
+	newAuthorization := func(inner authz.Authorization) *authz.RuleBasedAuthorization {
+		a, err := authz.NewRuleBasedAuthorization(inner)
+		require.NoError(t, err)
+		return a
+	}
+
+	a := newAuthorization(authz.NewGenericAuthorization(sendURL))
+	require.NoError(t, a.ValidateBasic())
+	require.Equal(t, sendURL, a.MsgTypeURL())
+
+	require.Error(t, (&authz.RuleBasedAuthorization{}).ValidateBasic())
+	require.Error(t, newAuthorization(authz.NewGenericAuthorization("")).ValidateBasic())
+
+	a = newAuthorization(authz.NewGenericAuthorization(sendURL))
+	a.MaxExecutions, a.Executions = 1, 1
+	require.Error(t, a.ValidateBasic())
+
+	a = newAuthorization(authz.NewGenericAuthorization(sendURL))
+	a.AllowedWindows = []authz.ExecutionWindow{{Start: now, End: now}}
+	require.Error(t, a.ValidateBasic())
+
+	a = newAuthorization(authz.NewGenericAuthorization(sendURL))
+	a.DailySpendLimit = sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	require.NoError(t, a.ValidateBasic())
+
+	// the amount spent by the message must be known to enforce a spend limit
+	a = newAuthorization(authz.NewGenericAuthorization(sdk.MsgTypeURL(&stakingtypes.MsgUndelegate{})))
+	a.DailySpendLimit = sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	require.Error(t, a.ValidateBasic())
+}
+
+func TestRuleBasedAuthorizationAccept(t *testing.T) {
+	ctx := testutil.DefaultContextWithDB(t, storetypes.NewKVStoreKey(authz.ModuleName), storetypes.NewTransientStoreKey("transient_test")).Ctx
+	now := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	ctx = ctx.WithHeaderInfo(header.Info{Time: now})
+
+	sendURL := banktypes.SendAuthorization{}.MsgTypeURL()
+	send := func(amount int64) sdk.Msg {
+		return banktypes.NewMsgSend("from", "to", sdk.NewCoins(sdk.NewInt64Coin("stake", amount)))
+	}
+
+	t.Log("max executions")
+	a, err := authz.NewRuleBasedAuthorization(authz.NewGenericAuthorization(sendURL))
+	require.NoError(t, err)
+	a.MaxExecutions = 2
+
+	resp, err := a.Accept(ctx, send(1))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	updated := resp.Updated.(*authz.RuleBasedAuthorization)
+	require.Equal(t, uint64(1), updated.Executions)
+
+	resp, err = updated.Accept(ctx, send(1))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.True(t, resp.Delete)
+
+	t.Log("execution windows")
+	a, err = authz.NewRuleBasedAuthorization(authz.NewGenericAuthorization(sendURL))
+	require.NoError(t, err)
+	a.AllowedWindows = []authz.ExecutionWindow{{Start: now.Add(time.Hour), End: now.Add(2 * time.Hour)}}
+
+	_, err = a.Accept(ctx, send(1))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	resp, err = a.Accept(ctx.WithHeaderInfo(header.Info{Time: now.Add(time.Hour)}), send(1))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+
+	_, err = a.Accept(ctx.WithHeaderInfo(header.Info{Time: now.Add(2 * time.Hour)}), send(1))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	t.Log("daily spend limit")
+	a, err = authz.NewRuleBasedAuthorization(authz.NewGenericAuthorization(sendURL))
+	require.NoError(t, err)
+	a.DailySpendLimit = sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+
+	resp, err = a.Accept(ctx, send(60))
+	require.NoError(t, err)
+	updated = resp.Updated.(*authz.RuleBasedAuthorization)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 60)), updated.DailySpent)
+	require.Equal(t, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), *updated.DayReset)
+
+	_, err = updated.Accept(ctx, send(60))
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+
+	// the spent amount is reset on the next day
+	resp, err = updated.Accept(ctx.WithHeaderInfo(header.Info{Time: now.Add(14 * time.Hour)}), send(60))
+	require.NoError(t, err)
+	updated = resp.Updated.(*authz.RuleBasedAuthorization)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 60)), updated.DailySpent)
+	require.Equal(t, time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC), *updated.DayReset)
+
+	t.Log("wrapped authorization is updated")
+	ac := codectestutil.CodecOptions{}.GetAddressCodec()
+	a, err = authz.NewRuleBasedAuthorization(banktypes.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), nil, ac))
+	require.NoError(t, err)
+
+	resp, err = a.Accept(ctx, send(40))
+	require.NoError(t, err)
+	updated = resp.Updated.(*authz.RuleBasedAuthorization)
+	inner, err := updated.GetAuthorization()
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 60)), inner.(*banktypes.SendAuthorization).SpendLimit)
+
+	_, err = updated.Accept(ctx, send(80))
+	require.Error(t, err)
+
+	resp, err = updated.Accept(ctx, send(60))
+	require.NoError(t, err)
+	require.True(t, resp.Delete)
+}
@@ -27,3 +27,11 @@ func (k MockBankKeeper) UpdateParams(ctx context.Context, req *bank.MsgUpdatePar
 func (k MockBankKeeper) SetSendEnabled(ctx context.Context, req *bank.MsgSetSendEnabled) (*bank.MsgSetSendEnabledResponse, error) {
 	return nil, nil
 }
+
+func (k MockBankKeeper) SetDenomMetadata(ctx context.Context, req *bank.MsgSetDenomMetadata) (*bank.MsgSetDenomMetadataResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) RemoveDenomMetadata(ctx context.Context, req *bank.MsgRemoveDenomMetadata) (*bank.MsgRemoveDenomMetadataResponse, error) {
+	return nil, nil
+}
@@ -349,6 +349,84 @@ func (m *MsgPruneExpiredGrantsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgPruneExpiredGrantsResponse proto.InternalMessageInfo
 
+// MsgRevokeAll revokes all the authorizations granted by the granter, to any
+// grantee. If msg_type_url is set, only the authorizations for that sdk.Msg
+// type are revoked.
+type MsgRevokeAll struct {
+	Granter    string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	MsgTypeUrl string `protobuf:"bytes,2,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+}
+
+func (m *MsgRevokeAll) Reset()         { *m = MsgRevokeAll{} }
+func (m *MsgRevokeAll) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeAll) ProtoMessage()    {}
+func (*MsgRevokeAll) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ceddab7d8589ad1, []int{8}
+}
+func (m *MsgRevokeAll) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeAll) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeAll.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeAll) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeAll.Merge(m, src)
+}
+func (m *MsgRevokeAll) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeAll) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeAll.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeAll proto.InternalMessageInfo
+
+// MsgRevokeAllResponse defines the Msg/MsgRevokeAllResponse response type.
+type MsgRevokeAllResponse struct {
+}
+
+func (m *MsgRevokeAllResponse) Reset()         { *m = MsgRevokeAllResponse{} }
+func (m *MsgRevokeAllResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeAllResponse) ProtoMessage()    {}
+func (*MsgRevokeAllResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ceddab7d8589ad1, []int{9}
+}
+func (m *MsgRevokeAllResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeAllResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeAllResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeAllResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeAllResponse.Merge(m, src)
+}
+func (m *MsgRevokeAllResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeAllResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeAllResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeAllResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgGrant)(nil), "cosmos.authz.v1beta1.MsgGrant")
 	proto.RegisterType((*MsgGrantResponse)(nil), "cosmos.authz.v1beta1.MsgGrantResponse")
@@ -358,50 +436,55 @@ func init() {
 	proto.RegisterType((*MsgRevokeResponse)(nil), "cosmos.authz.v1beta1.MsgRevokeResponse")
 	proto.RegisterType((*MsgPruneExpiredGrants)(nil), "cosmos.authz.v1beta1.MsgPruneExpiredGrants")
 	proto.RegisterType((*MsgPruneExpiredGrantsResponse)(nil), "cosmos.authz.v1beta1.MsgPruneExpiredGrantsResponse")
+	proto.RegisterType((*MsgRevokeAll)(nil), "cosmos.authz.v1beta1.MsgRevokeAll")
+	proto.RegisterType((*MsgRevokeAllResponse)(nil), "cosmos.authz.v1beta1.MsgRevokeAllResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/authz/v1beta1/tx.proto", fileDescriptor_3ceddab7d8589ad1) }
 
 var fileDescriptor_3ceddab7d8589ad1 = []byte{
-	// 604 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0x4f, 0x6f, 0x12, 0x41,
-	0x1c, 0x65, 0x4a, 0x0b, 0x32, 0x34, 0xd1, 0x6e, 0x31, 0x6e, 0xb7, 0x61, 0xd9, 0xac, 0x56, 0x09,
-	0x0d, 0xbb, 0x42, 0x6f, 0xc4, 0x4b, 0x49, 0x88, 0x17, 0x89, 0x66, 0xfd, 0x73, 0xf0, 0x42, 0x96,
-	0x32, 0x8e, 0x04, 0x76, 0x67, 0xb3, 0xb3, 0x10, 0xf0, 0x64, 0x3c, 0x7a, 0xf2, 0x63, 0xe8, 0x8d,
-	0x43, 0x8f, 0x7e, 0x00, 0xe2, 0xa9, 0xf1, 0x60, 0x3c, 0x19, 0x85, 0x03, 0x1f, 0xc1, 0xab, 0xd9,
-	0x99, 0xd9, 0xb5, 0x55, 0x68, 0xeb, 0xc5, 0x0b, 0xfc, 0xfe, 0xbc, 0xdf, 0xcc, 0x7b, 0xf3, 0x66,
-	0x16, 0xe6, 0x8f, 0x08, 0x75, 0x08, 0x35, 0xed, 0x41, 0xf0, 0xf2, 0x95, 0x39, 0xac, 0xb4, 0x51,
-	0x60, 0x57, 0xcc, 0x60, 0x64, 0x78, 0x3e, 0x09, 0x88, 0x94, 0xe3, 0x6d, 0x83, 0xb5, 0x0d, 0xd1,
-	0x56, 0x76, 0x78, 0xb5, 0xc5, 0x30, 0xa6, 0x80, 0xb0, 0x44, 0xc9, 0x61, 0x82, 0x09, 0xaf, 0x87,
-	0x91, 0xa8, 0xee, 0x60, 0x42, 0x70, 0x1f, 0x99, 0x2c, 0x6b, 0x0f, 0x5e, 0x98, 0xb6, 0x3b, 0x16,
-	0x2d, 0x6d, 0x29, 0x01, 0xbe, 0x1f, 0x47, 0xdc, 0x10, 0x08, 0x87, 0x62, 0x73, 0x58, 0x09, 0xff,
-	0x44, 0x63, 0xcb, 0x76, 0xba, 0x2e, 0x31, 0xd9, 0x2f, 0x2f, 0xe9, 0x5f, 0x00, 0xbc, 0xd2, 0xa4,
-	0xf8, 0xbe, 0x6f, 0xbb, 0x81, 0x54, 0x85, 0x69, 0x1c, 0x06, 0xc8, 0x97, 0x81, 0x06, 0x8a, 0x99,
-	0xba, 0xfc, 0xf9, 0xb8, 0x1c, 0x29, 0x3a, 0xec, 0x74, 0x7c, 0x44, 0xe9, 0xe3, 0xc0, 0xef, 0xba,
-	0xd8, 0x8a, 0x80, 0xbf, 0x67, 0x90, 0xbc, 0x76, 0xb9, 0x19, 0x24, 0xdd, 0x83, 0x1b, 0x2c, 0x94,
-	0x93, 0x1a, 0x28, 0x66, 0xab, 0xbb, 0xc6, 0xb2, 0x43, 0x33, 0x18, 0xa7, 0x7a, 0x66, 0xfa, 0xad,
-	0x90, 0x78, 0xbf, 0x98, 0x94, 0x80, 0xc5, 0x87, 0x6a, 0xb7, 0xde, 0x2c, 0x26, 0xa5, 0x68, 0xff,
-	0xb7, 0x8b, 0x49, 0x69, 0x9b, 0x8f, 0x97, 0x69, 0xa7, 0x67, 0x46, 0x5a, 0x74, 0x09, 0x5e, 0x8b,
-	0x62, 0x0b, 0x51, 0x8f, 0xb8, 0x14, 0xe9, 0x1f, 0x00, 0x4c, 0x37, 0x29, 0x6e, 0x8c, 0xd0, 0xd1,
-	0x69, 0xde, 0xe0, 0xb2, 0xbc, 0x1b, 0x70, 0xdd, 0xa1, 0x98, 0xca, 0x6b, 0x5a, 0xb2, 0x98, 0xad,
-	0xe6, 0x0c, 0x6e, 0x92, 0x11, 0x99, 0x64, 0x1c, 0xba, 0xe3, 0xfa, 0xee, 0xa7, 0xe3, 0xb2, 0x30,
-	0xc0, 0x68, 0xdb, 0x14, 0xc5, 0x72, 0x9a, 0x14, 0x5b, 0x6c, 0xbc, 0x76, 0xf3, 0x94, 0x00, 0x14,
-	0x0a, 0x90, 0xce, 0x0a, 0x08, 0xf9, 0xe9, 0xfb, 0xf0, 0xaa, 0x08, 0x23, 0xfa, 0x92, 0x0c, 0xd3,
-	0x3e, 0xa2, 0x83, 0x7e, 0x40, 0x65, 0xa0, 0x25, 0x8b, 0x9b, 0x56, 0x94, 0xea, 0x1f, 0x01, 0xcc,
-	0x84, 0xeb, 0xa3, 0x21, 0xe9, 0xa1, 0xff, 0x66, 0xa3, 0x06, 0x37, 0x1d, 0x8a, 0x5b, 0xc1, 0xd8,
-	0x43, 0xad, 0x81, 0xdf, 0x67, 0x6e, 0x66, 0x2c, 0xe8, 0x50, 0xfc, 0x64, 0xec, 0xa1, 0xa7, 0x7e,
-	0xbf, 0xb6, 0xf7, 0xa7, 0x55, 0xb9, 0xb3, 0x4a, 0x39, 0x61, 0x7d, 0x1b, 0x6e, 0xc5, 0x49, 0x6c,
-	0xd6, 0x33, 0x78, 0xbd, 0x49, 0xf1, 0x23, 0x7f, 0xe0, 0xa2, 0xc6, 0xc8, 0xeb, 0xfa, 0xa8, 0xc3,
-	0xcc, 0xa4, 0xd2, 0x5d, 0x98, 0xf2, 0xc2, 0xea, 0xc5, 0xea, 0x04, 0xae, 0x96, 0x0d, 0x69, 0x88,
-	0x44, 0x2f, 0xc0, 0xfc, 0xd2, 0x75, 0xa3, 0x8d, 0xab, 0x3f, 0xd7, 0x60, 0xb2, 0x49, 0xb1, 0xf4,
-	0x10, 0x6e, 0xf0, 0x67, 0xa1, 0x2e, 0xbf, 0x9f, 0xd1, 0xf5, 0x52, 0x6e, 0x9f, 0xdf, 0x8f, 0xfd,
-	0x7b, 0x00, 0xd7, 0xd9, 0xd5, 0xcb, 0xaf, 0xc4, 0x87, 0x6d, 0x65, 0xef, 0xdc, 0x76, 0xbc, 0x9a,
-	0x05, 0x53, 0xc2, 0xef, 0xc2, 0xca, 0x01, 0x0e, 0x50, 0xee, 0x5c, 0x00, 0x88, 0xd7, 0x1c, 0x42,
-	0x69, 0xc9, 0x81, 0xef, 0xaf, 0x1c, 0xff, 0x1b, 0xac, 0x1c, 0xfc, 0x03, 0x38, 0xda, 0x57, 0xd9,
-	0x78, 0x1d, 0x3e, 0xf0, 0x7a, 0x75, 0xfa, 0x43, 0x4d, 0x4c, 0x67, 0x2a, 0x38, 0x99, 0xa9, 0xe0,
-	0xfb, 0x4c, 0x05, 0xef, 0xe6, 0x6a, 0xe2, 0x64, 0xae, 0x26, 0xbe, 0xce, 0xd5, 0xc4, 0x73, 0xe1,
-	0x31, 0xed, 0xf4, 0x8c, 0x2e, 0x31, 0x47, 0xfc, 0x93, 0xd7, 0x4e, 0xb1, 0xd7, 0x77, 0xf0, 0x2b,
-	0x00, 0x00, 0xff, 0xff, 0x06, 0x4f, 0xd8, 0xf8, 0x98, 0x05, 0x00, 0x00,
+	// 654 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0xbb, 0x6f, 0xd3, 0x40,
+	0x18, 0xcf, 0x35, 0x7d, 0x90, 0xaf, 0x95, 0xa0, 0x6e, 0xa0, 0xae, 0xab, 0xba, 0x91, 0xa1, 0x10,
+	0xa5, 0xaa, 0x4d, 0xd3, 0x2d, 0x62, 0x49, 0xa4, 0x8a, 0x85, 0x08, 0x64, 0x1e, 0x03, 0x0c, 0x95,
+	0xd3, 0x1c, 0x47, 0x54, 0xdb, 0x67, 0xf9, 0x9c, 0x28, 0x61, 0x42, 0x8c, 0x0c, 0x88, 0x3f, 0x03,
+	0xb6, 0x0e, 0x1d, 0xf9, 0x03, 0x2a, 0xa6, 0x0a, 0x21, 0xc4, 0x84, 0xa0, 0x1d, 0xfa, 0x6f, 0x20,
+	0xdf, 0x9d, 0xdd, 0x97, 0xdb, 0x14, 0x06, 0x16, 0xfb, 0x7b, 0xfc, 0xbe, 0xef, 0x7e, 0xdf, 0xe3,
+	0x0e, 0x16, 0x36, 0x29, 0xf3, 0x28, 0xb3, 0x9c, 0x6e, 0xf4, 0xea, 0xb5, 0xd5, 0x5b, 0x6d, 0xe1,
+	0xc8, 0x59, 0xb5, 0xa2, 0xbe, 0x19, 0x84, 0x34, 0xa2, 0x4a, 0x51, 0xb8, 0x4d, 0xee, 0x36, 0xa5,
+	0x5b, 0x9b, 0x13, 0xd6, 0x0d, 0x8e, 0xb1, 0x24, 0x84, 0x2b, 0x5a, 0x91, 0x50, 0x42, 0x85, 0x3d,
+	0x96, 0xa4, 0x75, 0x8e, 0x50, 0x4a, 0x5c, 0x6c, 0x71, 0xad, 0xd5, 0x7d, 0x69, 0x39, 0xfe, 0x40,
+	0xba, 0x4a, 0x99, 0x04, 0xc4, 0x79, 0x02, 0x31, 0x2b, 0x11, 0x1e, 0x23, 0x56, 0x6f, 0x35, 0xfe,
+	0x49, 0xc7, 0xb4, 0xe3, 0x75, 0x7c, 0x6a, 0xf1, 0xaf, 0x30, 0x19, 0xdf, 0x11, 0x5c, 0x69, 0x32,
+	0x72, 0x3f, 0x74, 0xfc, 0x48, 0xa9, 0xc2, 0x04, 0x89, 0x05, 0x1c, 0xaa, 0xa8, 0x84, 0xca, 0x85,
+	0x86, 0xfa, 0x75, 0x67, 0x25, 0xa9, 0xa8, 0xde, 0x6e, 0x87, 0x98, 0xb1, 0xc7, 0x51, 0xd8, 0xf1,
+	0x89, 0x9d, 0x00, 0x8f, 0x62, 0xb0, 0x3a, 0x72, 0xb9, 0x18, 0xac, 0xdc, 0x83, 0x31, 0x2e, 0xaa,
+	0xf9, 0x12, 0x2a, 0x4f, 0x56, 0xe7, 0xcd, 0xac, 0xa6, 0x99, 0x9c, 0x53, 0xa3, 0xb0, 0xfb, 0x73,
+	0x31, 0xf7, 0xf1, 0x70, 0xbb, 0x82, 0x6c, 0x11, 0x54, 0xbb, 0xf5, 0xf6, 0x70, 0xbb, 0x92, 0x9c,
+	0xff, 0xee, 0x70, 0xbb, 0x32, 0x23, 0xc2, 0x57, 0x58, 0x7b, 0xcb, 0x4a, 0x6a, 0x31, 0x14, 0xb8,
+	0x96, 0xc8, 0x36, 0x66, 0x01, 0xf5, 0x19, 0x36, 0x3e, 0x21, 0x98, 0x68, 0x32, 0xb2, 0xde, 0xc7,
+	0x9b, 0xc7, 0x79, 0xa3, 0xcb, 0xf2, 0x5e, 0x87, 0x51, 0x8f, 0x11, 0xa6, 0x8e, 0x94, 0xf2, 0xe5,
+	0xc9, 0x6a, 0xd1, 0x14, 0x43, 0x32, 0x93, 0x21, 0x99, 0x75, 0x7f, 0xd0, 0x98, 0xff, 0xb2, 0xb3,
+	0x22, 0x07, 0x60, 0xb6, 0x1c, 0x86, 0xd3, 0x72, 0x9a, 0x8c, 0xd8, 0x3c, 0xbc, 0x76, 0xf3, 0x58,
+	0x01, 0x38, 0x2e, 0x40, 0x39, 0x59, 0x40, 0xcc, 0xcf, 0x58, 0x86, 0xab, 0x52, 0x4c, 0xe8, 0x2b,
+	0x2a, 0x4c, 0x84, 0x98, 0x75, 0xdd, 0x88, 0xa9, 0xa8, 0x94, 0x2f, 0x4f, 0xd9, 0x89, 0x6a, 0x7c,
+	0x46, 0x50, 0x88, 0xf3, 0xe3, 0x1e, 0xdd, 0xc2, 0xff, 0x6d, 0x8c, 0x25, 0x98, 0xf2, 0x18, 0xd9,
+	0x88, 0x06, 0x01, 0xde, 0xe8, 0x86, 0x2e, 0x9f, 0x66, 0xc1, 0x06, 0x8f, 0x91, 0x27, 0x83, 0x00,
+	0x3f, 0x0d, 0xdd, 0xda, 0xd2, 0xe9, 0x51, 0x15, 0x4f, 0x56, 0x2a, 0x08, 0x1b, 0x33, 0x30, 0x9d,
+	0x2a, 0xe9, 0xb0, 0x9e, 0xc1, 0xf5, 0x26, 0x23, 0x8f, 0xc2, 0xae, 0x8f, 0xd7, 0xfb, 0x41, 0x27,
+	0xc4, 0x6d, 0x3e, 0x4c, 0xa6, 0xdc, 0x85, 0xf1, 0x20, 0xb6, 0x0e, 0xaf, 0x4e, 0xe2, 0x6a, 0x93,
+	0x31, 0x0d, 0xa9, 0x18, 0x8b, 0xb0, 0x90, 0x99, 0x37, 0x3d, 0xf8, 0x3d, 0x82, 0xa9, 0x94, 0x4e,
+	0xdd, 0x75, 0xff, 0xa9, 0x9f, 0xa7, 0x7b, 0x33, 0x72, 0xa6, 0x37, 0xe5, 0xd3, 0xbd, 0x99, 0xcd,
+	0xea, 0x4d, 0xdd, 0x75, 0x8d, 0x1b, 0x50, 0x3c, 0xae, 0x27, 0x44, 0xab, 0xdf, 0xf2, 0x90, 0x6f,
+	0x32, 0xa2, 0x3c, 0x84, 0x31, 0x71, 0x7f, 0xf5, 0xec, 0x8b, 0x94, 0xdc, 0x03, 0xed, 0xf6, 0xc5,
+	0xfe, 0x74, 0xd1, 0x1e, 0xc0, 0x28, 0xbf, 0x23, 0x0b, 0xe7, 0xe2, 0x63, 0xb7, 0xb6, 0x74, 0xa1,
+	0x3b, 0xcd, 0x66, 0xc3, 0xb8, 0x5c, 0xcc, 0xc5, 0x73, 0x03, 0x04, 0x40, 0xbb, 0x33, 0x04, 0x90,
+	0xe6, 0xec, 0x81, 0x92, 0xb1, 0x19, 0xcb, 0xe7, 0x86, 0x9f, 0x05, 0x6b, 0x6b, 0x7f, 0x01, 0x4e,
+	0xcf, 0x7d, 0x01, 0x85, 0xa3, 0xbd, 0x30, 0x86, 0xb0, 0xad, 0xbb, 0xae, 0x56, 0x19, 0x8e, 0x49,
+	0x92, 0x6b, 0x63, 0x6f, 0xe2, 0x67, 0xae, 0x51, 0xdd, 0xfd, 0xad, 0xe7, 0x76, 0xf7, 0x75, 0xb4,
+	0xb7, 0xaf, 0xa3, 0x5f, 0xfb, 0x3a, 0xfa, 0x70, 0xa0, 0xe7, 0xf6, 0x0e, 0xf4, 0xdc, 0x8f, 0x03,
+	0x3d, 0xf7, 0x5c, 0xee, 0x1d, 0x6b, 0x6f, 0x99, 0x1d, 0x6a, 0xf5, 0xc5, 0xc3, 0xdf, 0x1a, 0xe7,
+	0x6f, 0xd0, 0xda, 0x9f, 0x01, 0x00, 0x42, 0x32, 0x01, 0x51, 0x9e, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -432,6 +515,9 @@ type MsgClient interface {
 	//
 	// Since cosmos-sdk 0.51
 	PruneExpiredGrants(ctx context.Context, in *MsgPruneExpiredGrants, opts ...grpc.CallOption) (*MsgPruneExpiredGrantsResponse, error)
+	// RevokeAll revokes all the authorizations issued by the granter, optionally
+	// only the ones for the provided method name.
+	RevokeAll(ctx context.Context, in *MsgRevokeAll, opts ...grpc.CallOption) (*MsgRevokeAllResponse, error)
 }
 
 type msgClient struct {
@@ -478,6 +564,15 @@ func (c *msgClient) PruneExpiredGrants(ctx context.Context, in *MsgPruneExpiredG
 	return out, nil
 }
 
+func (c *msgClient) RevokeAll(ctx context.Context, in *MsgRevokeAll, opts ...grpc.CallOption) (*MsgRevokeAllResponse, error) {
+	out := new(MsgRevokeAllResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.authz.v1beta1.Msg/RevokeAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Grant grants the provided authorization to the grantee on the granter's
@@ -496,6 +591,9 @@ type MsgServer interface {
 	//
 	// Since cosmos-sdk 0.51
 	PruneExpiredGrants(context.Context, *MsgPruneExpiredGrants) (*MsgPruneExpiredGrantsResponse, error)
+	// RevokeAll revokes all the authorizations issued by the granter, optionally
+	// only the ones for the provided method name.
+	RevokeAll(context.Context, *MsgRevokeAll) (*MsgRevokeAllResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -514,6 +612,9 @@ func (*UnimplementedMsgServer) Revoke(ctx context.Context, req *MsgRevoke) (*Msg
 func (*UnimplementedMsgServer) PruneExpiredGrants(ctx context.Context, req *MsgPruneExpiredGrants) (*MsgPruneExpiredGrantsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PruneExpiredGrants not implemented")
 }
+func (*UnimplementedMsgServer) RevokeAll(ctx context.Context, req *MsgRevokeAll) (*MsgRevokeAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAll not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -591,6 +692,24 @@ func _Msg_PruneExpiredGrants_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RevokeAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeAll)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.authz.v1beta1.Msg/RevokeAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeAll(ctx, req.(*MsgRevokeAll))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.authz.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -611,6 +730,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PruneExpiredGrants",
 			Handler:    _Msg_PruneExpiredGrants_Handler,
 		},
+		{
+			MethodName: "RevokeAll",
+			Handler:    _Msg_RevokeAll_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/authz/v1beta1/tx.proto",
@@ -882,6 +1005,66 @@ func (m *MsgPruneExpiredGrantsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgRevokeAll) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeAll) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeAll) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeAllResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeAllResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1007,6 +1190,32 @@ func (m *MsgPruneExpiredGrantsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgRevokeAll) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeAllResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1736,6 +1945,170 @@ func (m *MsgPruneExpiredGrantsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgRevokeAll) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeAll: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeAll: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeAllResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeAllResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -70,6 +70,12 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "denoms-metadata",
 					Short:     "Query the client metadata for all registered coin denominations",
 				},
+				{
+					RpcMethod: "DenomsMetadataByQuery",
+					Use:       "denoms-metadata-by-query",
+					Short:     "Query the client metadata of the coin denominations matching a symbol and/or display denomination",
+					Example:   fmt.Sprintf(`%s query bank denoms-metadata-by-query --symbol ATOM`, version.AppName),
+				},
 				{
 					RpcMethod:      "DenomOwners",
 					Use:            "denom-owners [denom]",
@@ -87,6 +93,16 @@ To look up all denoms, do not provide any arguments.`,
 					),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denoms"}},
 				},
+				{
+					RpcMethod:      "Locks",
+					Use:            "locks [address]",
+					Short:          "Query the coins locked in the balance of an account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod: "AllBalancesStream",
+					Skip:      true, // skipped because server streams are only served over gRPC
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -128,6 +144,21 @@ Note: multiple coins can be send by space separated.`,
 					},
 					GovProposal: true,
 				},
+				{
+					RpcMethod:      "SetDenomMetadata",
+					Use:            "set-denom-metadata-proposal [metadata]",
+					Short:          "Submit a proposal to set the client metadata of a coin denomination",
+					Example:        fmt.Sprintf(`%s tx bank set-denom-metadata-proposal '{"base":"ibc/27394FB...","display":"atom","symbol":"ATOM","denom_units":[{"denom":"ibc/27394FB...","exponent":0},{"denom":"atom","exponent":6}]}'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "metadata"}},
+					GovProposal:    true,
+				},
+				{
+					RpcMethod:      "RemoveDenomMetadata",
+					Use:            "remove-denom-metadata-proposal [denom]",
+					Short:          "Submit a proposal to remove the client metadata of a coin denomination",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+					GovProposal:    true,
+				},
 			},
 		},
 	}
@@ -1,6 +1,11 @@
 package bank
 
 import (
+	"fmt"
+	"sort"
+
+	"golang.org/x/exp/maps"
+
 	modulev1 "cosmossdk.io/api/cosmos/bank/module/v1"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/depinject"
@@ -10,6 +15,7 @@ import (
 	"cosmossdk.io/x/bank/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 var _ depinject.OnePerModuleType = AppModule{}
@@ -20,6 +26,7 @@ func (am AppModule) IsOnePerModuleType() {}
 func init() {
 	appconfig.RegisterModule(&modulev1.Module{},
 		appconfig.Provide(ProvideModule),
+		appconfig.Invoke(InvokeSetSendRestrictions),
 	)
 }
 
@@ -82,7 +89,54 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		blockedAddresses,
 		authStr,
 	)
+
+	if len(in.Config.DeferredModuleAccounts) > 0 {
+		deferredAddrs := make([]sdk.AccAddress, len(in.Config.DeferredModuleAccounts))
+		for i, moduleName := range in.Config.DeferredModuleAccounts {
+			deferredAddrs[i] = authtypes.NewModuleAddress(moduleName)
+		}
+		bankKeeper.SetDeferredAddresses(deferredAddrs...)
+	}
+
 	m := NewAppModule(in.Cdc, bankKeeper, in.AccountKeeper)
 
 	return ModuleOutputs{BankKeeper: bankKeeper, Module: m}
 }
+
+// InvokeSetSendRestrictions appends the send restrictions provided by other
+// modules to the keeper, in the order of the module config if set.
+func InvokeSetSendRestrictions(
+	config *modulev1.Module,
+	keeper keeper.BaseKeeper,
+	restrictions map[string]types.SendRestrictionFn,
+) error {
+	if config == nil {
+		return nil
+	}
+
+	modules := maps.Keys(restrictions)
+	order := config.RestrictionsOrder
+	if len(order) == 0 {
+		order = modules
+		sort.Strings(order)
+	}
+
+	if len(order) != len(modules) {
+		return fmt.Errorf("len(restrictions order: %v) != len(restriction modules: %v)", order, modules)
+	}
+
+	if len(modules) == 0 {
+		return nil
+	}
+
+	for _, module := range order {
+		restriction, ok := restrictions[module]
+		if !ok {
+			return fmt.Errorf("can't find send restriction for module %s", module)
+		}
+
+		keeper.AppendSendRestriction(restriction)
+	}
+
+	return nil
+}
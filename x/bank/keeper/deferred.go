@@ -0,0 +1,140 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"sort"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// deferredBalances holds the addresses whose balances are kept pending in the
+// DeferredBalances store during a block. It is shared by pointer between all
+// the copies of the keeper.
+type deferredBalances struct {
+	addrs map[string]bool
+}
+
+func (d *deferredBalances) isDeferred(addr sdk.AccAddress) bool {
+	return d.addrs[string(addr)]
+}
+
+// addresses returns the deferred addresses in ascending order.
+func (d *deferredBalances) addresses() []sdk.AccAddress {
+	addrs := make([]sdk.AccAddress, 0, len(d.addrs))
+	for addr := range d.addrs {
+		addrs = append(addrs, sdk.AccAddress(addr))
+	}
+	sort.Slice(addrs, func(i, j int) bool { return bytes.Compare(addrs[i], addrs[j]) < 0 })
+	return addrs
+}
+
+// SetDeferredAddresses configures the addresses, typically hot module accounts
+// like the fee collector, whose balances are kept pending in the DeferredBalances
+// store and only written to the balances store by FlushDeferredBalances. It must
+// be called at most once, when wiring the app.
+func (k BaseKeeper) SetDeferredAddresses(addrs ...sdk.AccAddress) {
+	if k.deferred.addrs != nil {
+		panic("deferred addresses already set")
+	}
+
+	k.deferred.addrs = make(map[string]bool, len(addrs))
+	for _, addr := range addrs {
+		k.deferred.addrs[string(addr)] = true
+	}
+}
+
+// FlushDeferredBalances writes the pending balances of the deferred addresses to
+// the balances store. It is called by the bank module end blocker, before the
+// bank invariants run, and must be called by the app at the end of InitChain,
+// after the genesis of all the modules is initialized. The pending balances are
+// part of the module state, so the balance changes made after the bank end
+// blocker are flushed with those of the next block.
+func (k BaseKeeper) FlushDeferredBalances(ctx context.Context) error {
+	iter, err := k.DeferredBalances.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	kvs, err := iter.KeyValues()
+	if err != nil {
+		return err
+	}
+
+	for _, kv := range kvs {
+		if kv.Value.IsZero() {
+			err = k.Balances.Remove(ctx, kv.Key)
+		} else {
+			err = k.Balances.Set(ctx, kv.Key, kv.Value)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return k.DeferredBalances.Clear(ctx, nil)
+}
+
+// storedBalance returns the balance of addr in the balances store, ignoring any
+// pending balance.
+func (k BaseViewKeeper) storedBalance(ctx context.Context, addr sdk.AccAddress, denom string) math.Int {
+	amt, err := k.Balances.Get(ctx, collections.Join(addr, denom))
+	if err != nil {
+		return math.ZeroInt()
+	}
+	return amt
+}
+
+// pendingBalance returns the pending balance of a deferred address, if any.
+func (k BaseViewKeeper) pendingBalance(ctx context.Context, addr sdk.AccAddress, denom string) (math.Int, bool) {
+	amt, err := k.DeferredBalances.Get(ctx, collections.Join(addr, denom))
+	if err != nil {
+		return math.Int{}, false
+	}
+	return amt, true
+}
+
+// deferredAccountBalances returns the balances of a deferred address, its
+// pending balances taking precedence over the stored ones, sorted by denom.
+func (k BaseViewKeeper) deferredAccountBalances(ctx context.Context, addr sdk.AccAddress) ([]sdk.Coin, error) {
+	amounts := make(map[string]math.Int)
+	err := k.Balances.Walk(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, string](addr), func(key collections.Pair[sdk.AccAddress, string], value math.Int) (bool, error) {
+		amounts[key.K2()] = value
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.DeferredBalances.Walk(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, string](addr), func(key collections.Pair[sdk.AccAddress, string], value math.Int) (bool, error) {
+		amounts[key.K2()] = value
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	balances := make([]sdk.Coin, 0, len(amounts))
+	for denom, amt := range amounts {
+		if amt.IsZero() {
+			continue
+		}
+		balances = append(balances, sdk.NewCoin(denom, amt))
+	}
+	sort.Slice(balances, func(i, j int) bool { return balances[i].Denom < balances[j].Denom })
+
+	return balances, nil
+}
+
+// setDeferredBalance records balance as the pending balance of a deferred
+// address. The balance is validated by setBalance, so that an invalid balance
+// fails the send rather than the flush.
+func (k BaseViewKeeper) setDeferredBalance(ctx context.Context, addr sdk.AccAddress, balance sdk.Coin) error {
+	key := collections.Join(addr, balance.Denom)
+	if balance.Amount.Equal(k.storedBalance(ctx, addr, balance.Denom)) {
+		return k.DeferredBalances.Remove(ctx, key)
+	}
+	return k.DeferredBalances.Set(ctx, key, balance.Amount)
+}
@@ -0,0 +1,153 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// enforceMinimumBalance checks the balances left in addr after spending amt
+// against the minimum balances of the params. A balance below the minimum is
+// swept to the dust collector or, if none is set, an ErrBelowMinimumBalance is
+// returned. Module accounts and locked balances are exempt from the check.
+func (k BaseSendKeeper) enforceMinimumBalance(ctx context.Context, addr sdk.AccAddress, amt sdk.Coins) error {
+	params := k.GetParams(ctx)
+	if params.MinimumBalances.Empty() {
+		return nil
+	}
+
+	dust := k.dustBalances(ctx, addr, amt, params.MinimumBalances)
+	if dust.Empty() {
+		return nil
+	}
+
+	if _, ok := k.ak.GetAccount(ctx, addr).(sdk.ModuleAccountI); ok {
+		return nil
+	}
+
+	if params.DustCollector == "" {
+		return errorsmod.Wrapf(types.ErrBelowMinimumBalance, "balance %s is below the minimum balance %s", dust, params.MinimumBalances)
+	}
+
+	return k.sweepDust(ctx, addr, dust, params.DustCollector)
+}
+
+// dustBalances returns the balances of addr, among the denoms of amt, which are
+// positive but below their minimum balance and hold no locked coins.
+func (k BaseSendKeeper) dustBalances(ctx context.Context, addr sdk.AccAddress, amt, minimumBalances sdk.Coins) sdk.Coins {
+	var (
+		dust   sdk.Coins
+		locked sdk.Coins
+	)
+	for _, coin := range amt {
+		minimum := minimumBalances.AmountOf(coin.Denom)
+		if !minimum.IsPositive() {
+			continue
+		}
+
+		balance := k.GetBalance(ctx, addr, coin.Denom)
+		if !balance.IsPositive() || balance.Amount.GTE(minimum) {
+			continue
+		}
+
+		if locked == nil {
+			locked = k.LockedCoins(ctx, addr)
+		}
+		if locked.AmountOf(coin.Denom).IsPositive() {
+			continue
+		}
+
+		dust = dust.Add(balance)
+	}
+
+	return dust
+}
+
+// sweepDust moves the dust coins of addr to the dust collector.
+func (k BaseSendKeeper) sweepDust(ctx context.Context, addr sdk.AccAddress, dust sdk.Coins, dustCollector string) error {
+	collector, err := k.ak.AddressCodec().StringToBytes(dustCollector)
+	if err != nil {
+		return err
+	}
+
+	// the collector accumulates the dust of other accounts
+	if addr.Equals(sdk.AccAddress(collector)) {
+		return nil
+	}
+
+	if err := k.subUnlockedCoins(ctx, addr, dust); err != nil {
+		return err
+	}
+
+	if err := k.addCoins(ctx, collector, dust); err != nil {
+		return err
+	}
+
+	addrStr, err := k.ak.AddressCodec().BytesToString(addr)
+	if err != nil {
+		return err
+	}
+
+	return k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeDustSweep,
+		event.NewAttribute(types.AttributeKeyOwner, addrStr),
+		event.NewAttribute(types.AttributeKeyCollector, dustCollector),
+		event.NewAttribute(sdk.AttributeKeyAmount, dust.String()),
+	)
+}
+
+// SweepDust moves every existing balance below its minimum balance to the dust
+// collector. It is meant to be called from an upgrade handler after setting the
+// minimum balances, since only the balances left by later transfers are checked.
+// Module accounts and locked balances are left untouched.
+func (k BaseKeeper) SweepDust(ctx context.Context) error {
+	params := k.GetParams(ctx)
+	if params.MinimumBalances.Empty() {
+		return nil
+	}
+
+	if params.DustCollector == "" {
+		return errorsmod.Wrap(types.ErrBelowMinimumBalance, "cannot sweep dust without a dust collector")
+	}
+
+	collector, err := k.ak.AddressCodec().StringToBytes(params.DustCollector)
+	if err != nil {
+		return err
+	}
+
+	var (
+		addrs []sdk.AccAddress
+		dust  = make(map[string]sdk.Coins)
+	)
+	k.IterateAllBalances(ctx, func(addr sdk.AccAddress, balance sdk.Coin) bool {
+		minimum := params.MinimumBalances.AmountOf(balance.Denom)
+		if balance.IsPositive() && balance.Amount.LT(minimum) && !addr.Equals(sdk.AccAddress(collector)) {
+			if _, ok := dust[string(addr)]; !ok {
+				addrs = append(addrs, addr)
+			}
+			dust[string(addr)] = dust[string(addr)].Add(balance)
+		}
+		return false
+	})
+
+	for _, addr := range addrs {
+		if _, ok := k.ak.GetAccount(ctx, addr).(sdk.ModuleAccountI); ok {
+			continue
+		}
+
+		coins := k.dustBalances(ctx, addr, dust[string(addr)], params.MinimumBalances)
+		if coins.Empty() {
+			continue
+		}
+
+		if err := k.sweepDust(ctx, addr, coins, params.DustCollector); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
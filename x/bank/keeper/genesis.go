@@ -57,6 +57,23 @@ func (k BaseKeeper) InitGenesis(ctx context.Context, genState *types.GenesisStat
 	for _, meta := range genState.DenomMetadata {
 		k.SetDenomMetaData(ctx, meta)
 	}
+
+	for _, offset := range genState.SupplyOffsets {
+		if err := k.AddSupplyOffset(ctx, offset.Denom, offset.Offset); err != nil {
+			return err
+		}
+	}
+
+	for _, lock := range genState.Locks {
+		bz, err := k.ak.AddressCodec().StringToBytes(lock.Address)
+		if err != nil {
+			return err
+		}
+
+		if err := k.BaseViewKeeper.Locks.Set(ctx, collections.Join(sdk.AccAddress(bz), lock.LockId), lock); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -74,5 +91,7 @@ func (k BaseKeeper) ExportGenesis(ctx context.Context) (*types.GenesisState, err
 		k.GetAllDenomMetaData(ctx),
 		k.GetAllSendEnabledEntries(ctx),
 	)
+	rv.Locks = k.GetAllLocks(ctx)
+	rv.SupplyOffsets = k.GetAllSupplyOffsets(ctx)
 	return rv, nil
 }
@@ -138,6 +138,11 @@ func (k BaseKeeper) TotalSupply(ctx context.Context, req *types.QueryTotalSupply
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	// report the circulating supply
+	for i, coin := range totalSupply {
+		totalSupply[i] = k.applySupplyOffset(ctx, coin)
+	}
+
 	return &types.QueryTotalSupplyResponse{Supply: totalSupply, Pagination: pageRes}, nil
 }
 
@@ -151,7 +156,7 @@ func (k BaseKeeper) SupplyOf(ctx context.Context, req *types.QuerySupplyOfReques
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	supply := k.GetSupply(ctx, req.Denom)
+	supply := k.GetSupplyWithOffset(ctx, req.Denom)
 
 	return &types.QuerySupplyOfResponse{Amount: sdk.NewCoin(req.Denom, supply.Amount)}, nil
 }
@@ -193,6 +198,38 @@ func (k BaseKeeper) DenomsMetadata(c context.Context, req *types.QueryDenomsMeta
 	}, nil
 }
 
+// DenomsMetadataByQuery implements Query/DenomsMetadataByQuery gRPC method.
+func (k BaseKeeper) DenomsMetadataByQuery(ctx context.Context, req *types.QueryDenomsMetadataByQueryRequest) (*types.QueryDenomsMetadataByQueryResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Symbol == "" && req.Display == "" {
+		return nil, status.Error(codes.InvalidArgument, "either symbol or display must be provided")
+	}
+
+	metadatas, pageRes, err := query.CollectionFilteredPaginate(
+		ctx,
+		k.BaseViewKeeper.DenomMetadata,
+		req.Pagination,
+		func(_ string, metadata types.Metadata) (bool, error) {
+			return (req.Symbol == "" || metadata.Symbol == req.Symbol) &&
+				(req.Display == "" || metadata.Display == req.Display), nil
+		},
+		func(_ string, metadata types.Metadata) (types.Metadata, error) {
+			return metadata, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDenomsMetadataByQueryResponse{
+		Metadatas:  metadatas,
+		Pagination: pageRes,
+	}, nil
+}
+
 // DenomMetadata implements Query/DenomMetadata gRPC method.
 func (k BaseKeeper) DenomMetadata(ctx context.Context, req *types.QueryDenomMetadataRequest) (*types.QueryDenomMetadataResponse, error) {
 	if req == nil {
@@ -336,6 +373,113 @@ func (k BaseKeeper) SendEnabled(ctx context.Context, req *types.QuerySendEnabled
 	return resp, nil
 }
 
+// Locks implements the Query/Locks gRPC method
+func (k BaseKeeper) Locks(ctx context.Context, req *types.QueryLocksRequest) (*types.QueryLocksResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := k.ak.AddressCodec().StringToBytes(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	locks, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.BaseViewKeeper.Locks,
+		req.Pagination,
+		func(_ collections.Pair[sdk.AccAddress, string], value types.Lock) (types.Lock, error) {
+			return value, nil
+		},
+		query.WithCollectionPaginationPairPrefix[sdk.AccAddress, string](addr),
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryLocksResponse{Locks: locks, Pagination: pageRes}, nil
+}
+
+// AllBalancesStream implements the Query/AllBalancesStream gRPC method
+func (k BaseKeeper) AllBalancesStream(req *types.QueryAllBalancesStreamRequest, stream types.Query_AllBalancesStreamServer) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var cursor *collections.Pair[sdk.AccAddress, string]
+	if len(req.Cursor) > 0 {
+		_, key, err := k.Balances.KeyCodec().Decode(req.Cursor)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid cursor: %s", err.Error())
+		}
+		cursor = &key
+	}
+
+	ctx := stream.Context()
+
+	var sendErr error
+	send := func(addr sdk.AccAddress, balance sdk.Coin) bool {
+		sendErr = k.sendStreamBalance(stream, addr, balance)
+		return sendErr != nil
+	}
+
+	var err error
+	if req.Address != "" {
+		var addr []byte
+		addr, err = k.ak.AddressCodec().StringToBytes(req.Address)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+		}
+
+		startAfter := ""
+		if cursor != nil {
+			if !cursor.K1().Equals(sdk.AccAddress(addr)) {
+				return status.Error(codes.InvalidArgument, "cursor does not match the address")
+			}
+			startAfter = cursor.K2()
+		}
+
+		err = k.IterateAccountBalancesPaginated(ctx, addr, startAfter, func(balance sdk.Coin) bool {
+			return send(addr, balance)
+		})
+	} else {
+		rng := new(collections.Range[collections.Pair[sdk.AccAddress, string]])
+		if cursor != nil {
+			rng = rng.StartExclusive(*cursor)
+		}
+
+		err = k.Balances.Walk(ctx, rng, func(key collections.Pair[sdk.AccAddress, string], value math.Int) (bool, error) {
+			return send(key.K1(), sdk.NewCoin(key.K2(), value)), nil
+		})
+	}
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	return sendErr
+}
+
+// sendStreamBalance sends a single balance of an AllBalancesStream query along
+// with the cursor to resume the stream after it.
+func (k BaseKeeper) sendStreamBalance(stream types.Query_AllBalancesStreamServer, addr sdk.AccAddress, balance sdk.Coin) error {
+	addrStr, err := k.ak.AddressCodec().BytesToString(addr)
+	if err != nil {
+		return err
+	}
+
+	key := collections.Join(addr, balance.Denom)
+	cursor := make([]byte, k.Balances.KeyCodec().Size(key))
+	if _, err := k.Balances.KeyCodec().Encode(cursor, key); err != nil {
+		return err
+	}
+
+	return stream.Send(&types.QueryAllBalancesStreamResponse{
+		Address: addrStr,
+		Balance: balance,
+		Cursor:  cursor,
+	})
+}
+
 // DenomOwnersByQuery is identical to DenomOwner query, but receives denom values via query string.
 func (k BaseKeeper) DenomOwnersByQuery(ctx context.Context, req *types.QueryDenomOwnersByQueryRequest) (*types.QueryDenomOwnersByQueryResponse, error) {
 	if req == nil {
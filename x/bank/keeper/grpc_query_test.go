@@ -2,10 +2,14 @@ package keeper_test
 
 import (
 	gocontext "context"
+	"errors"
 	"fmt"
 	"time"
 
+	"google.golang.org/grpc"
+
 	"cosmossdk.io/core/header"
+	"cosmossdk.io/math"
 	authtypes "cosmossdk.io/x/auth/types"
 	vestingtypes "cosmossdk.io/x/auth/vesting/types"
 	"cosmossdk.io/x/bank/testutil"
@@ -233,6 +237,130 @@ func (suite *KeeperTestSuite) TestSpendableBalances() {
 	suite.EqualValues(25, res.Balances[1].Amount.Int64())
 }
 
+// allBalancesStream collects the responses of an AllBalancesStream query. If
+// limit is set, the stream is closed after limit responses.
+type allBalancesStream struct {
+	grpc.ServerStream
+	ctx       gocontext.Context
+	limit     int
+	responses []*types.QueryAllBalancesStreamResponse
+}
+
+func (s *allBalancesStream) Context() gocontext.Context {
+	return s.ctx
+}
+
+func (s *allBalancesStream) Send(res *types.QueryAllBalancesStreamResponse) error {
+	if s.limit > 0 && len(s.responses) == s.limit {
+		return errors.New("stream closed")
+	}
+	s.responses = append(s.responses, res)
+	return nil
+}
+
+func (suite *KeeperTestSuite) TestQueryAllBalancesStream() {
+	ctx := sdk.UnwrapSDKContext(suite.ctx)
+	require := suite.Require()
+
+	addr0Str, err := codectestutil.CodecOptions{}.GetAddressCodec().BytesToString(accAddrs[0])
+	require.NoError(err)
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(testutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(50), newBarCoin(30))))
+	suite.mockFundAccount(accAddrs[1])
+	require.NoError(testutil.FundAccount(ctx, suite.bankKeeper, accAddrs[1], sdk.NewCoins(newFooCoin(20))))
+
+	require.Error(suite.bankKeeper.AllBalancesStream(nil, &allBalancesStream{ctx: ctx}))
+	require.Error(suite.bankKeeper.AllBalancesStream(&types.QueryAllBalancesStreamRequest{Cursor: []byte{0xff}}, &allBalancesStream{ctx: ctx}))
+
+	// all balances
+	all := &allBalancesStream{ctx: ctx}
+	require.NoError(suite.bankKeeper.AllBalancesStream(&types.QueryAllBalancesStreamRequest{}, all))
+	require.Len(all.responses, 3)
+
+	// an interrupted stream is resumed after the cursor of the last balance received
+	first := &allBalancesStream{ctx: ctx, limit: 1}
+	require.Error(suite.bankKeeper.AllBalancesStream(&types.QueryAllBalancesStreamRequest{}, first))
+	require.Len(first.responses, 1)
+
+	rest := &allBalancesStream{ctx: ctx}
+	require.NoError(suite.bankKeeper.AllBalancesStream(&types.QueryAllBalancesStreamRequest{Cursor: first.responses[0].Cursor}, rest))
+	require.Equal(all.responses, append(first.responses, rest.responses...))
+
+	// balances of a single account, in denom order
+	account := &allBalancesStream{ctx: ctx}
+	require.NoError(suite.bankKeeper.AllBalancesStream(&types.QueryAllBalancesStreamRequest{Address: addr0Str}, account))
+	require.Len(account.responses, 2)
+	require.Equal(newBarCoin(30), account.responses[0].Balance)
+	require.Equal(newFooCoin(50), account.responses[1].Balance)
+	require.Equal(addr0Str, account.responses[1].Address)
+
+	rest = &allBalancesStream{ctx: ctx}
+	req := &types.QueryAllBalancesStreamRequest{Address: addr0Str, Cursor: account.responses[0].Cursor}
+	require.NoError(suite.bankKeeper.AllBalancesStream(req, rest))
+	require.Equal(account.responses[1:], rest.responses)
+
+	// the cursor must belong to the streamed account
+	for _, res := range all.responses {
+		if res.Address != addr0Str {
+			req.Cursor = res.Cursor
+		}
+	}
+	require.Error(suite.bankKeeper.AllBalancesStream(req, &allBalancesStream{ctx: ctx}))
+}
+
+func (suite *KeeperTestSuite) TestIterateAccountBalancesPaginated() {
+	ctx := sdk.UnwrapSDKContext(suite.ctx)
+	require := suite.Require()
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(testutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(50), newBarCoin(30), newIbcCoin(20))))
+
+	var denoms []string
+	collect := func(coin sdk.Coin) bool {
+		denoms = append(denoms, coin.Denom)
+		return len(denoms) == 2
+	}
+
+	require.NoError(suite.bankKeeper.IterateAccountBalancesPaginated(ctx, accAddrs[0], "", collect))
+	require.Equal([]string{barDenom, fooDenom}, denoms)
+
+	require.NoError(suite.bankKeeper.IterateAccountBalancesPaginated(ctx, accAddrs[0], denoms[1], collect))
+	require.Equal([]string{barDenom, fooDenom, newIbcCoin(0).Denom}, denoms)
+}
+
+func (suite *KeeperTestSuite) TestQueryLocks() {
+	ctx := sdk.UnwrapSDKContext(suite.ctx)
+	queryClient := suite.mockQueryClient(ctx)
+
+	_, err := queryClient.Locks(ctx, &types.QueryLocksRequest{})
+	suite.Require().Error(err)
+
+	addrStr, err := codectestutil.CodecOptions{}.GetAddressCodec().BytesToString(accAddrs[0])
+	suite.Require().NoError(err)
+	req := &types.QueryLocksRequest{Address: addrStr}
+
+	res, err := queryClient.Locks(ctx, req)
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Locks)
+
+	acc := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	suite.mockFundAccount(accAddrs[0])
+	suite.Require().NoError(testutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(50))))
+
+	for _, lockID := range []string{"lock1", "lock2"} {
+		suite.mockSpendableCoins(ctx, acc)
+		suite.Require().NoError(suite.bankKeeper.LockCoins(ctx, accAddrs[0], sdk.NewCoins(newFooCoin(10)), lockID))
+	}
+
+	req.Pagination = &query.PageRequest{Limit: 1, CountTotal: true}
+	res, err = queryClient.Locks(ctx, req)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.Lock{types.NewLock(addrStr, "lock1", sdk.NewCoins(newFooCoin(10)))}, res.Locks)
+	suite.Require().EqualValues(2, res.Pagination.Total)
+	suite.Require().NotNil(res.Pagination.NextKey)
+}
+
 func (suite *KeeperTestSuite) TestSpendableBalanceByDenom() {
 	_, _, addr := testdata.KeyTestPubAddr()
 
@@ -335,6 +463,23 @@ func (suite *KeeperTestSuite) TestQueryTotalSupplyOf() {
 	suite.Require().Equal(sdk.NewInt64Coin("bogus", 0), res.Amount)
 }
 
+func (suite *KeeperTestSuite) TestQuerySupplyWithOffset() {
+	ctx, queryClient := suite.ctx, suite.queryClient
+	testCoins := sdk.NewCoins(sdk.NewInt64Coin("test", 1000))
+
+	suite.mockMintCoins(mintAcc)
+	suite.Require().NoError(suite.bankKeeper.MintCoins(ctx, types.MintModuleName, testCoins))
+	suite.Require().NoError(suite.bankKeeper.AddSupplyOffset(ctx, "test", math.NewInt(-400)))
+
+	res, err := queryClient.SupplyOf(gocontext.Background(), &types.QuerySupplyOfRequest{Denom: "test"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin("test", 600), res.Amount)
+
+	totalRes, err := queryClient.TotalSupply(gocontext.Background(), &types.QueryTotalSupplyRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(math.NewInt(600), totalRes.Supply.AmountOf("test"))
+}
+
 func (suite *KeeperTestSuite) TestQueryParams() {
 	res, err := suite.queryClient.Params(gocontext.Background(), &types.QueryParamsRequest{})
 	suite.Require().NoError(err)
@@ -444,6 +589,65 @@ func (suite *KeeperTestSuite) TestQueryDenomsMetadata() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryDenomsMetadataByQuery() {
+	ctx := suite.ctx
+	queryClient := suite.queryClient
+
+	for _, metadata := range []types.Metadata{
+		{
+			DenomUnits: []*types.DenomUnit{{Denom: "uatom", Exponent: 0}, {Denom: "atom", Exponent: 6}},
+			Base:       "uatom",
+			Display:    "atom",
+			Symbol:     "ATOM",
+		},
+		{
+			DenomUnits: []*types.DenomUnit{{Denom: "ibc/ATOM", Exponent: 0}, {Denom: "atom", Exponent: 6}},
+			Base:       "ibc/ATOM",
+			Display:    "atom",
+			Symbol:     "ATOM",
+		},
+		{
+			DenomUnits: []*types.DenomUnit{{Denom: "ibc/XATOM", Exponent: 0}, {Denom: "xatom", Exponent: 6}},
+			Base:       "ibc/XATOM",
+			Display:    "xatom",
+			Symbol:     "ATOM",
+		},
+		{
+			DenomUnits: []*types.DenomUnit{{Denom: "uosmo", Exponent: 0}, {Denom: "osmo", Exponent: 6}},
+			Base:       "uosmo",
+			Display:    "osmo",
+			Symbol:     "OSMO",
+		},
+	} {
+		suite.bankKeeper.SetDenomMetaData(ctx, metadata)
+	}
+
+	_, err := queryClient.DenomsMetadataByQuery(ctx, &types.QueryDenomsMetadataByQueryRequest{})
+	suite.Require().Error(err)
+
+	res, err := queryClient.DenomsMetadataByQuery(ctx, &types.QueryDenomsMetadataByQueryRequest{Symbol: "ATOM"})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Metadatas, 3)
+
+	res, err = queryClient.DenomsMetadataByQuery(ctx, &types.QueryDenomsMetadataByQueryRequest{Symbol: "ATOM", Display: "atom"})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Metadatas, 2)
+	suite.Require().Equal("ibc/ATOM", res.Metadatas[0].Base)
+	suite.Require().Equal("uatom", res.Metadatas[1].Base)
+
+	res, err = queryClient.DenomsMetadataByQuery(ctx, &types.QueryDenomsMetadataByQueryRequest{
+		Display:    "atom",
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Metadatas, 1)
+	suite.Require().EqualValues(2, res.Pagination.Total)
+
+	res, err = queryClient.DenomsMetadataByQuery(ctx, &types.QueryDenomsMetadataByQueryRequest{Display: "unknown"})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Metadatas)
+}
+
 func (suite *KeeperTestSuite) TestQueryDenomMetadata() {
 	var (
 		req         *types.QueryDenomMetadataRequest
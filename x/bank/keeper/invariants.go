@@ -11,18 +11,36 @@ import (
 
 // RegisterInvariants registers the bank module invariants
 func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
-	ir.RegisterRoute(types.ModuleName, "nonnegative-outstanding", NonnegativeBalanceInvariant(k))
-	ir.RegisterRoute(types.ModuleName, "total-supply", TotalSupply(k))
+	ir.RegisterRoute(types.ModuleName, "nonnegative-outstanding", flushDeferred(k, NonnegativeBalanceInvariant(k)))
+	ir.RegisterRoute(types.ModuleName, "total-supply", flushDeferred(k, TotalSupply(k)))
+	ir.RegisterRoute(types.ModuleName, "locked-coins", flushDeferred(k, LockedCoinsInvariant(k)))
 }
 
 // AllInvariants runs all invariants of the X/bank module.
 func AllInvariants(k Keeper) sdk.Invariant {
-	return func(ctx sdk.Context) (string, bool) {
+	return flushDeferred(k, func(ctx sdk.Context) (string, bool) {
 		res, stop := NonnegativeBalanceInvariant(k)(ctx)
 		if stop {
 			return res, stop
 		}
-		return TotalSupply(k)(ctx)
+		res, stop = TotalSupply(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return LockedCoinsInvariant(k)(ctx)
+	})
+}
+
+// flushDeferred returns an invariant writing the pending balances of the
+// deferred addresses before running invariant, so that it checks the balances
+// as they will be committed.
+func flushDeferred(k Keeper, invariant sdk.Invariant) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		if err := k.FlushDeferredBalances(ctx); err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "deferred balances",
+				fmt.Sprintf("error flushing deferred balances %v", err)), true
+		}
+		return invariant(ctx)
 	}
 }
 
@@ -76,3 +94,39 @@ func TotalSupply(k Keeper) sdk.Invariant {
 				expectedTotal, supply)), broken
 	}
 }
+
+// LockedCoinsInvariant checks that no account has more coins locked than its balance
+func LockedCoinsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		var addrs []sdk.AccAddress
+		locked := make(map[string]sdk.Coins)
+		k.IterateAllLocks(ctx, func(addr sdk.AccAddress, lock types.Lock) bool {
+			if _, ok := locked[string(addr)]; !ok {
+				addrs = append(addrs, addr)
+			}
+			locked[string(addr)] = locked[string(addr)].Add(lock.Amount...)
+			return false
+		})
+
+		for _, addr := range addrs {
+			coins := locked[string(addr)]
+			balance := k.GetAllBalances(ctx, addr)
+			if !coins.IsAllLTE(balance) {
+				count++
+				msg += fmt.Sprintf("\t%s has %s locked but a balance of %s\n", addr, coins, balance)
+			}
+		}
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "locked-coins",
+			fmt.Sprintf("amount of accounts with more coins locked than their balance %d\n%s", count, msg),
+		), broken
+	}
+}
@@ -33,9 +33,15 @@ type Keeper interface {
 	HasSupply(ctx context.Context, denom string) bool
 	GetPaginatedTotalSupply(ctx context.Context, pagination *query.PageRequest) (sdk.Coins, *query.PageResponse, error)
 	IterateTotalSupply(ctx context.Context, cb func(sdk.Coin) bool)
+	AddSupplyOffset(ctx context.Context, denom string, offsetAmount math.Int) error
+	GetSupplyOffset(ctx context.Context, denom string) math.Int
+	GetSupplyWithOffset(ctx context.Context, denom string) sdk.Coin
+	GetAllSupplyOffsets(ctx context.Context) []types.SupplyOffset
+	FlushDeferredBalances(ctx context.Context) error
 	GetDenomMetaData(ctx context.Context, denom string) (types.Metadata, bool)
 	HasDenomMetaData(ctx context.Context, denom string) bool
 	SetDenomMetaData(ctx context.Context, denomMetaData types.Metadata)
+	RemoveDenomMetaData(ctx context.Context, denom string) error
 	GetAllDenomMetaData(ctx context.Context) []types.Metadata
 	IterateAllDenomMetaData(ctx context.Context, cb func(types.Metadata) bool)
 
@@ -50,6 +56,9 @@ type Keeper interface {
 	DelegateCoins(ctx context.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) error
 	UndelegateCoins(ctx context.Context, moduleAccAddr, delegatorAddr sdk.AccAddress, amt sdk.Coins) error
 
+	LockCoins(ctx context.Context, addr sdk.AccAddress, amt sdk.Coins, lockID string) error
+	UnlockCoins(ctx context.Context, addr sdk.AccAddress, amt sdk.Coins, lockID string) error
+
 	types.QueryServer
 }
 
@@ -130,6 +139,7 @@ func (k BaseKeeper) DelegateCoins(ctx context.Context, delegatorAddr, moduleAccA
 	}
 
 	balances := sdk.NewCoins()
+	locked := k.lockedCoins(ctx, delegatorAddr)
 
 	for _, coin := range amt {
 		balance := k.GetBalance(ctx, delegatorAddr, coin.GetDenom())
@@ -139,6 +149,13 @@ func (k BaseKeeper) DelegateCoins(ctx context.Context, delegatorAddr, moduleAccA
 			)
 		}
 
+		// coins locked with LockCoins cannot be delegated, unlike vesting coins
+		if unlocked := balance.SubAmount(locked.AmountOf(coin.Denom)); unlocked.IsLT(coin) {
+			return errorsmod.Wrapf(
+				sdkerrors.ErrInsufficientFunds, "failed to delegate; unlocked balance %s is smaller than %s", unlocked, amt,
+			)
+		}
+
 		balances = balances.Add(balance)
 		err := k.setBalance(ctx, delegatorAddr, balance.Sub(coin))
 		if err != nil {
@@ -258,6 +275,11 @@ func (k BaseKeeper) SetDenomMetaData(ctx context.Context, denomMetaData types.Me
 	_ = k.BaseViewKeeper.DenomMetadata.Set(ctx, denomMetaData.Base, denomMetaData)
 }
 
+// RemoveDenomMetaData removes the metadata of the given base denomination.
+func (k BaseKeeper) RemoveDenomMetaData(ctx context.Context, denom string) error {
+	return k.BaseViewKeeper.DenomMetadata.Remove(ctx, denom)
+}
+
 // SendCoinsFromModuleToAccount transfers coins from a ModuleAccount to an AccAddress.
 // An error is returned if the module account does not exist or if
 // the recipient address is black-listed or if sending the tokens fails.
@@ -425,6 +447,36 @@ func (k BaseKeeper) BurnCoins(ctx context.Context, address []byte, amounts sdk.C
 	)
 }
 
+// OverrideBalances replaces all the balances of an account with the given
+// balances, adjusting the supply, without any restriction nor event. It is
+// meant for the state overrides of simulations and must not be used otherwise,
+// which is why it is not part of the Keeper interface.
+func (k BaseKeeper) OverrideBalances(ctx context.Context, addr sdk.AccAddress, balances sdk.Coins) error {
+	if err := balances.Validate(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+
+	current := k.GetAllBalances(ctx, addr)
+	overrides := append(sdk.Coins{}, balances...)
+	for _, coin := range current {
+		if balances.AmountOf(coin.Denom).IsZero() {
+			overrides = append(overrides, sdk.NewCoin(coin.Denom, math.ZeroInt()))
+		}
+	}
+
+	for _, balance := range overrides {
+		if err := k.setBalance(ctx, addr, balance); err != nil {
+			return err
+		}
+
+		supply := k.GetSupply(ctx, balance.Denom)
+		supply.Amount = supply.Amount.Add(balance.Amount).Sub(current.AmountOf(balance.Denom))
+		k.setSupply(ctx, supply)
+	}
+
+	return nil
+}
+
 // setSupply sets the supply for the given coin
 func (k BaseKeeper) setSupply(ctx context.Context, coin sdk.Coin) {
 	// Bank invariants and IBC requires to remove zero coins.
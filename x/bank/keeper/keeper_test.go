@@ -14,6 +14,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/header"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
@@ -371,6 +372,59 @@ func (suite *KeeperTestSuite) TestSupply() {
 	require.Equal(total, genesisSupply)
 }
 
+func (suite *KeeperTestSuite) TestSupplyOffset() {
+	ctx := suite.ctx
+	require := suite.Require()
+	keeper := suite.bankKeeper
+
+	supply := sdk.NewCoins(sdk.NewInt64Coin("foo", 100))
+	suite.mockMintCoins(minterAcc)
+	require.NoError(keeper.MintCoins(ctx, authtypes.Minter, supply))
+
+	require.Error(keeper.AddSupplyOffset(ctx, "", math.NewInt(-1)))
+
+	require.NoError(keeper.AddSupplyOffset(ctx, "foo", math.NewInt(-30)))
+	require.NoError(keeper.AddSupplyOffset(ctx, "foo", math.NewInt(-10)))
+	require.Equal(math.NewInt(-40), keeper.GetSupplyOffset(ctx, "foo"))
+	require.Equal(sdk.NewInt64Coin("foo", 60), keeper.GetSupplyWithOffset(ctx, "foo"))
+
+	// the offset does not change the supply itself
+	require.Equal(sdk.NewInt64Coin("foo", 100), keeper.GetSupply(ctx, "foo"))
+	require.Equal([]banktypes.SupplyOffset{{Denom: "foo", Offset: math.NewInt(-40)}}, keeper.GetAllSupplyOffsets(ctx))
+
+	// the circulating supply is never negative
+	require.NoError(keeper.AddSupplyOffset(ctx, "foo", math.NewInt(-100)))
+	require.Equal(sdk.NewInt64Coin("foo", 0), keeper.GetSupplyWithOffset(ctx, "foo"))
+
+	// a zero offset is removed
+	require.NoError(keeper.AddSupplyOffset(ctx, "foo", math.NewInt(140)))
+	require.True(keeper.GetSupplyOffset(ctx, "foo").IsZero())
+	require.Empty(keeper.GetAllSupplyOffsets(ctx))
+}
+
+func (suite *KeeperTestSuite) TestOverrideBalances() {
+	ctx := suite.ctx
+	require := suite.Require()
+	keeper := suite.bankKeeper
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(ctx, keeper, accAddrs[0], sdk.NewCoins(sdk.NewInt64Coin("foo", 100), sdk.NewInt64Coin("bar", 50))))
+
+	// the balances are replaced and the supply is adjusted
+	balances := sdk.NewCoins(sdk.NewInt64Coin("foo", 30), sdk.NewInt64Coin("baz", 20))
+	require.NoError(keeper.OverrideBalances(ctx, accAddrs[0], balances))
+	require.Equal(balances, keeper.GetAllBalances(ctx, accAddrs[0]))
+	require.Equal(sdk.NewInt64Coin("foo", 30), keeper.GetSupply(ctx, "foo"))
+	require.Equal(sdk.NewInt64Coin("bar", 0), keeper.GetSupply(ctx, "bar"))
+	require.Equal(sdk.NewInt64Coin("baz", 20), keeper.GetSupply(ctx, "baz"))
+
+	require.NoError(keeper.OverrideBalances(ctx, accAddrs[0], nil))
+	require.True(keeper.GetAllBalances(ctx, accAddrs[0]).IsZero())
+	require.Equal(sdk.NewInt64Coin("foo", 0), keeper.GetSupply(ctx, "foo"))
+
+	require.ErrorContains(keeper.OverrideBalances(ctx, accAddrs[0], sdk.Coins{sdk.Coin{Denom: "foo", Amount: math.NewInt(-10)}}), "invalid coins")
+}
+
 func (suite *KeeperTestSuite) TestSendCoinsFromModuleToAccount_Blocklist() {
 	ctx := suite.ctx
 	require := suite.Require()
@@ -1513,6 +1567,203 @@ func (suite *KeeperTestSuite) TestSpendableCoins() {
 
 	suite.mockSpendableCoins(ctx, vacc)
 	require.Equal(origCoins.Sub(lockedCoins...)[0], suite.bankKeeper.SpendableCoin(ctx, accAddrs[0], "stake"))
+
+	// only the locks of the requested denom are subtracted
+	acc2 := authtypes.NewBaseAccountWithAddress(accAddrs[2])
+	suite.mockFundAccount(accAddrs[2])
+	require.NoError(banktestutil.FundAccount(suite.ctx, suite.bankKeeper, accAddrs[2], origCoins.Add(newFooCoin(100))))
+	suite.mockSpendableCoins(ctx, acc2)
+	require.NoError(suite.bankKeeper.LockCoins(ctx, accAddrs[2], sdk.NewCoins(sdk.NewInt64Coin("stake", 30), newFooCoin(100)), "lock"))
+
+	suite.mockSpendableCoins(ctx, acc2)
+	require.Equal(sdk.NewInt64Coin("stake", 70), suite.bankKeeper.SpendableCoin(ctx, accAddrs[2], "stake"))
+	suite.mockSpendableCoins(ctx, acc2)
+	require.Equal(sdk.NewInt64Coin(fooDenom, 0), suite.bankKeeper.SpendableCoin(ctx, accAddrs[2], fooDenom))
+}
+
+func (suite *KeeperTestSuite) TestLockCoins() {
+	ctx := sdk.UnwrapSDKContext(suite.ctx)
+	require := suite.Require()
+
+	origCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], origCoins))
+
+	// invalid lock requests
+	require.ErrorIs(suite.bankKeeper.LockCoins(ctx, accAddrs[0], origCoins, ""), sdkerrors.ErrInvalidRequest)
+	require.ErrorIs(suite.bankKeeper.LockCoins(ctx, accAddrs[0], sdk.NewCoins(), "lock1"), sdkerrors.ErrInvalidCoins)
+
+	suite.mockSpendableCoins(ctx, acc0)
+	require.ErrorIs(suite.bankKeeper.LockCoins(ctx, accAddrs[0], origCoins.Add(origCoins...), "lock1"), sdkerrors.ErrInsufficientFunds)
+
+	// lock coins under two lock ids
+	suite.mockSpendableCoins(ctx, acc0)
+	require.NoError(suite.bankKeeper.LockCoins(ctx, accAddrs[0], sdk.NewCoins(sdk.NewInt64Coin("stake", 30)), "lock1"))
+	suite.mockSpendableCoins(ctx, acc0)
+	require.NoError(suite.bankKeeper.LockCoins(ctx, accAddrs[0], sdk.NewCoins(sdk.NewInt64Coin("stake", 20)), "lock1"))
+	suite.mockSpendableCoins(ctx, acc0)
+	require.NoError(suite.bankKeeper.LockCoins(ctx, accAddrs[0], sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), "lock2"))
+
+	lock, found := suite.bankKeeper.GetLock(ctx, accAddrs[0], "lock1")
+	require.True(found)
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), lock.Amount)
+	require.Len(suite.bankKeeper.GetLocks(ctx, accAddrs[0]), 2)
+
+	// locked coins stay in the balance but cannot be spent
+	require.Equal(origCoins, suite.bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+	suite.mockSpendableCoins(ctx, acc0)
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 40)), suite.bankKeeper.SpendableCoins(ctx, accAddrs[0]))
+
+	suite.mockSpendableCoins(ctx, acc0)
+	require.ErrorIs(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(sdk.NewInt64Coin("stake", 50))), sdkerrors.ErrInsufficientFunds)
+
+	// locked coins cannot be delegated either
+	suite.authKeeper.EXPECT().GetAccount(ctx, burnerAcc.GetAddress()).Return(burnerAcc)
+	require.ErrorIs(suite.bankKeeper.DelegateCoins(ctx, accAddrs[0], burnerAcc.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin("stake", 50))), sdkerrors.ErrInsufficientFunds)
+
+	// unlock coins
+	require.ErrorIs(suite.bankKeeper.UnlockCoins(ctx, accAddrs[0], sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), "unknown"), banktypes.ErrLockNotFound)
+	require.ErrorIs(suite.bankKeeper.UnlockCoins(ctx, accAddrs[0], sdk.NewCoins(sdk.NewInt64Coin("stake", 20)), "lock2"), sdkerrors.ErrInsufficientFunds)
+	require.NoError(suite.bankKeeper.UnlockCoins(ctx, accAddrs[0], sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), "lock2"))
+
+	_, found = suite.bankKeeper.GetLock(ctx, accAddrs[0], "lock2")
+	require.False(found)
+
+	suite.mockSpendableCoins(ctx, acc0)
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), suite.bankKeeper.SpendableCoins(ctx, accAddrs[0]))
+
+	// the unlocked coins can be sent
+	suite.mockSendCoins(ctx, acc0, accAddrs[1])
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(sdk.NewInt64Coin("stake", 50))))
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+}
+
+func (suite *KeeperTestSuite) TestMinimumBalance() {
+	ctx := sdk.UnwrapSDKContext(suite.ctx)
+	require := suite.Require()
+
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	acc3 := authtypes.NewBaseAccountWithAddress(accAddrs[3])
+	collector, err := suite.authKeeper.AddressCodec().BytesToString(accAddrs[2])
+	require.NoError(err)
+
+	params := banktypes.DefaultParams()
+	params.MinimumBalances = sdk.NewCoins(newFooCoin(10))
+	require.NoError(suite.bankKeeper.SetParams(ctx, params))
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(100), newBarCoin(100))))
+
+	// denoms without a minimum balance can be left with any amount
+	suite.mockSendCoins(ctx, acc0, accAddrs[1])
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newBarCoin(99))))
+
+	// leaving less than the minimum fails without a dust collector
+	cacheCtx, _ := ctx.CacheContext()
+	suite.authKeeper.EXPECT().GetAccount(cacheCtx, accAddrs[0]).Return(acc0).Times(3)
+	err = suite.bankKeeper.SendCoins(cacheCtx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(95)))
+	require.ErrorIs(err, banktypes.ErrBelowMinimumBalance)
+
+	// the dust collector must be a valid address
+	params.DustCollector = "invalid"
+	require.ErrorContains(suite.bankKeeper.SetParams(ctx, params), "invalid dust collector address")
+
+	// and sweeps the remainder to the dust collector if one is set
+	params.DustCollector = collector
+	require.NoError(suite.bankKeeper.SetParams(ctx, params))
+
+	suite.authKeeper.EXPECT().GetAccount(ctx, accAddrs[0]).Return(acc0).Times(4)
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(95))))
+	require.Equal(sdk.NewCoins(newBarCoin(1)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+	require.Equal(sdk.NewCoins(newFooCoin(95), newBarCoin(99)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[1]))
+	require.Equal(sdk.NewCoins(newFooCoin(5)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[2]))
+
+	// existing dust is swept by SweepDust, except for the dust collector
+	suite.mockFundAccount(accAddrs[3])
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[3], sdk.NewCoins(newFooCoin(3))))
+
+	suite.authKeeper.EXPECT().GetAccount(ctx, accAddrs[3]).Return(acc3).Times(3)
+	require.NoError(suite.bankKeeper.SweepDust(ctx))
+	require.True(suite.bankKeeper.GetAllBalances(ctx, accAddrs[3]).IsZero())
+	require.Equal(sdk.NewCoins(newFooCoin(8)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[2]))
+}
+
+func (suite *KeeperTestSuite) TestDeferredBalances() {
+	require := suite.Require()
+
+	key := storetypes.NewKVStoreKey(banktypes.StoreKey)
+	ctx := testutil.DefaultContextWithDB(suite.T(), key, storetypes.NewTransientStoreKey("transient_test")).Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+	env := runtime.NewEnvironment(runtime.NewKVStoreService(key), log.NewNopLogger())
+
+	authority, err := suite.authKeeper.AddressCodec().BytesToString(authtypes.NewModuleAddress(banktypes.GovModuleName))
+	require.NoError(err)
+	bankKeeper := keeper.NewBaseKeeper(env, suite.encCfg.Codec, suite.authKeeper, map[string]bool{}, authority)
+	bankKeeper.SetDeferredAddresses(accAddrs[1])
+	require.Panics(func() { bankKeeper.SetDeferredAddresses(accAddrs[2]) })
+
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	acc1 := authtypes.NewBaseAccountWithAddress(accAddrs[1])
+	suite.authKeeper.EXPECT().GetModuleAccount(ctx, mintAcc.Name).Return(mintAcc)
+	suite.authKeeper.EXPECT().GetModuleAddress(mintAcc.Name).Return(mintAcc.GetAddress())
+	suite.authKeeper.EXPECT().GetAccount(ctx, mintAcc.GetAddress()).Return(mintAcc)
+	require.NoError(banktestutil.FundAccount(ctx, bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(100), newBarCoin(100))))
+
+	// balance changes of a deferred address are pending until flushed
+	suite.authKeeper.EXPECT().GetAccount(ctx, accAddrs[0]).Return(acc0).Times(2)
+	require.NoError(bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(30))))
+	require.NoError(bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(20), newBarCoin(10))))
+	require.Equal(newFooCoin(50), bankKeeper.GetBalance(ctx, accAddrs[1], fooDenom))
+	require.Equal(sdk.NewCoins(newFooCoin(50), newBarCoin(10)), bankKeeper.GetAllBalances(ctx, accAddrs[1]))
+	require.Equal(sdk.NewCoins(newFooCoin(50), newBarCoin(90)), bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+	has, err := bankKeeper.Balances.Has(ctx, collections.Join(accAddrs[1], fooDenom))
+	require.NoError(err)
+	require.False(has)
+	pending, err := bankKeeper.DeferredBalances.Get(ctx, collections.Join(accAddrs[1], fooDenom))
+	require.NoError(err)
+	require.Equal(math.NewInt(50), pending)
+
+	// spending more than the pending balance fails when sending, not when flushing
+	suite.authKeeper.EXPECT().GetAccount(ctx, accAddrs[1]).Return(acc1)
+	err = bankKeeper.SendCoins(ctx, accAddrs[1], accAddrs[0], sdk.NewCoins(newFooCoin(51)))
+	require.ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	// pending changes are included when iterating over all the balances, and
+	// flushed before the invariants run
+	addr1Str, err := suite.authKeeper.AddressCodec().BytesToString(accAddrs[1])
+	require.NoError(err)
+	require.Contains(bankKeeper.GetAccountsBalances(ctx), banktypes.Balance{Address: addr1Str, Coins: sdk.NewCoins(newFooCoin(50), newBarCoin(10))})
+	invCtx, _ := ctx.CacheContext()
+	_, broken := keeper.AllInvariants(bankKeeper)(invCtx)
+	require.False(broken)
+	has, err = bankKeeper.Balances.Has(invCtx, collections.Join(accAddrs[1], fooDenom))
+	require.NoError(err)
+	require.True(has)
+
+	require.NoError(bankKeeper.FlushDeferredBalances(ctx))
+	amt, err := bankKeeper.Balances.Get(ctx, collections.Join(accAddrs[1], fooDenom))
+	require.NoError(err)
+	require.Equal(math.NewInt(50), amt)
+	has, err = bankKeeper.DeferredBalances.Has(ctx, collections.Join(accAddrs[1], fooDenom))
+	require.NoError(err)
+	require.False(has)
+	require.Equal(sdk.NewCoins(newFooCoin(50), newBarCoin(10)), bankKeeper.GetAllBalances(ctx, accAddrs[1]))
+
+	// spending from a deferred address is pending as well, and emptied balances
+	// are removed on flush
+	suite.authKeeper.EXPECT().GetAccount(ctx, accAddrs[1]).Return(acc1)
+	require.NoError(bankKeeper.SendCoins(ctx, accAddrs[1], accAddrs[0], sdk.NewCoins(newBarCoin(10))))
+	require.Equal(sdk.NewCoins(newFooCoin(50)), bankKeeper.GetAllBalances(ctx, accAddrs[1]))
+	has, err = bankKeeper.Balances.Has(ctx, collections.Join(accAddrs[1], barDenom))
+	require.NoError(err)
+	require.True(has)
+
+	require.NoError(bankKeeper.FlushDeferredBalances(ctx))
+	has, err = bankKeeper.Balances.Has(ctx, collections.Join(accAddrs[1], barDenom))
+	require.NoError(err)
+	require.False(has)
+	require.Equal(sdk.NewCoins(newFooCoin(50)), bankKeeper.GetAllBalances(ctx, accAddrs[1]))
 }
 
 func (suite *KeeperTestSuite) TestVestingAccountSend() {
@@ -2070,6 +2321,43 @@ func (suite *KeeperTestSuite) TestIsSendEnabledDenom() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestIsSendEnabledDenomWildcard() {
+	ctx, bankKeeper := suite.ctx, suite.bankKeeper
+	require := suite.Require()
+
+	require.NoError(bankKeeper.SetDefaultSendEnabled(ctx, true))
+	bankKeeper.SetSendEnabled(ctx, "ibc/*", false)
+	bankKeeper.SetSendEnabled(ctx, "ibc/ABCD", true)
+	bankKeeper.SetSendEnabled(ctx, "factory/*", false)
+	bankKeeper.SetSendEnabled(ctx, "factory/creator/*", true)
+
+	tests := []struct {
+		denom string
+		exp   bool
+	}{
+		{denom: "stake", exp: true},
+		{denom: "ibc/1234", exp: false},
+		{denom: "ibc/ABCD", exp: true},
+		{denom: "ibcfoo", exp: true},
+		{denom: "factory/other/foo", exp: false},
+		{denom: "factory/creator/foo", exp: true},
+		{denom: "factory/creator/sub/foo", exp: true},
+	}
+
+	for _, tc := range tests {
+		require.Equal(tc.exp, bankKeeper.IsSendEnabledDenom(ctx, tc.denom), tc.denom)
+	}
+
+	// the default only applies to denoms matching no entry
+	require.NoError(bankKeeper.SetDefaultSendEnabled(ctx, false))
+	require.False(bankKeeper.GetParams(ctx).DefaultSendEnabled)
+	require.False(bankKeeper.IsSendEnabledDenom(ctx, "stake"))
+	require.True(bankKeeper.IsSendEnabledDenom(ctx, "factory/creator/foo"))
+
+	err := bankKeeper.IsSendEnabledCoins(ctx, sdk.NewInt64Coin("ibc/ABCD", 1), sdk.NewInt64Coin("ibc/1234", 1))
+	require.ErrorIs(err, banktypes.ErrSendDisabled)
+}
+
 func (suite *KeeperTestSuite) TestGetSendEnabledEntry() {
 	ctx, bankKeeper := suite.ctx, suite.bankKeeper
 	require := suite.Require()
@@ -0,0 +1,174 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// LockCoins locks amt in the balance of addr under lockID, adding to the coins
+// already locked under the same lockID. Locked coins stay in the account balance
+// but are excluded from its spendable coins until they are unlocked. It returns
+// an error if the account cannot spend amt.
+func (k BaseKeeper) LockCoins(ctx context.Context, addr sdk.AccAddress, amt sdk.Coins, lockID string) error {
+	if lockID == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "lock id cannot be empty")
+	}
+
+	if !amt.IsValid() || amt.IsZero() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
+	}
+
+	spendable := k.SpendableCoins(ctx, addr)
+	if !amt.IsAllLTE(spendable) {
+		return errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "spendable balance %s is smaller than %s", spendable, amt)
+	}
+
+	addrStr, err := k.ak.AddressCodec().BytesToString(addr)
+	if err != nil {
+		return err
+	}
+
+	lock, found := k.GetLock(ctx, addr, lockID)
+	if !found {
+		lock = types.NewLock(addrStr, lockID, sdk.NewCoins())
+	}
+	lock.Amount = lock.Amount.Add(amt...)
+
+	if err := k.BaseViewKeeper.Locks.Set(ctx, collections.Join(addr, lockID), lock); err != nil {
+		return err
+	}
+
+	return k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeCoinLock,
+		event.NewAttribute(types.AttributeKeyOwner, addrStr),
+		event.NewAttribute(types.AttributeKeyLockID, lockID),
+		event.NewAttribute(sdk.AttributeKeyAmount, amt.String()),
+	)
+}
+
+// UnlockCoins unlocks amt from the coins locked in the balance of addr under
+// lockID, making them spendable again. The lock is removed once all its coins
+// are unlocked.
+func (k BaseKeeper) UnlockCoins(ctx context.Context, addr sdk.AccAddress, amt sdk.Coins, lockID string) error {
+	if !amt.IsValid() || amt.IsZero() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
+	}
+
+	addrStr, err := k.ak.AddressCodec().BytesToString(addr)
+	if err != nil {
+		return err
+	}
+
+	lock, found := k.GetLock(ctx, addr, lockID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrLockNotFound, "lock %s of address %s", lockID, addrStr)
+	}
+
+	remaining, hasNeg := lock.Amount.SafeSub(amt...)
+	if hasNeg {
+		return errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "locked amount %s is smaller than %s", lock.Amount, amt)
+	}
+
+	if remaining.IsZero() {
+		err = k.BaseViewKeeper.Locks.Remove(ctx, collections.Join(addr, lockID))
+	} else {
+		lock.Amount = remaining
+		err = k.BaseViewKeeper.Locks.Set(ctx, collections.Join(addr, lockID), lock)
+	}
+	if err != nil {
+		return err
+	}
+
+	return k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeCoinUnlock,
+		event.NewAttribute(types.AttributeKeyOwner, addrStr),
+		event.NewAttribute(types.AttributeKeyLockID, lockID),
+		event.NewAttribute(sdk.AttributeKeyAmount, amt.String()),
+	)
+}
+
+// GetLock returns the coins locked in the balance of addr under lockID.
+func (k BaseViewKeeper) GetLock(ctx context.Context, addr sdk.AccAddress, lockID string) (types.Lock, bool) {
+	lock, err := k.Locks.Get(ctx, collections.Join(addr, lockID))
+	if err != nil {
+		return types.Lock{}, false
+	}
+
+	return lock, true
+}
+
+// GetLocks returns all the locks of the given account address.
+func (k BaseViewKeeper) GetLocks(ctx context.Context, addr sdk.AccAddress) []types.Lock {
+	var locks []types.Lock
+	k.IterateAccountLocks(ctx, addr, func(lock types.Lock) bool {
+		locks = append(locks, lock)
+		return false
+	})
+
+	return locks
+}
+
+// GetAllLocks returns the locks of all the accounts.
+func (k BaseViewKeeper) GetAllLocks(ctx context.Context) []types.Lock {
+	var locks []types.Lock
+	k.IterateAllLocks(ctx, func(_ sdk.AccAddress, lock types.Lock) bool {
+		locks = append(locks, lock)
+		return false
+	})
+
+	return locks
+}
+
+// IterateAllLocks iterates over the locks of all the accounts and provides the
+// owner address and lock to a callback. If true is returned from the callback,
+// iteration is halted.
+func (k BaseViewKeeper) IterateAllLocks(ctx context.Context, cb func(sdk.AccAddress, types.Lock) bool) {
+	err := k.Locks.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, string], lock types.Lock) (bool, error) {
+		return cb(key.K1(), lock), nil
+	})
+	if err != nil {
+		panic(err)
+	}
+}
+
+// IterateAccountLocks iterates over the locks of a single account and provides
+// them to a callback. If true is returned from the callback, iteration is halted.
+func (k BaseViewKeeper) IterateAccountLocks(ctx context.Context, addr sdk.AccAddress, cb func(types.Lock) bool) {
+	err := k.Locks.Walk(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, string](addr), func(_ collections.Pair[sdk.AccAddress, string], lock types.Lock) (bool, error) {
+		return cb(lock), nil
+	})
+	if err != nil {
+		panic(err)
+	}
+}
+
+// lockedCoins returns the sum of the coins locked with LockCoins in the balance
+// of the given account address.
+func (k BaseViewKeeper) lockedCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins {
+	locked := sdk.NewCoins()
+	k.IterateAccountLocks(ctx, addr, func(lock types.Lock) bool {
+		locked = locked.Add(lock.Amount...)
+		return false
+	})
+
+	return locked
+}
+
+// lockedCoinsOf returns the amount of denom locked in all the locks of addr.
+func (k BaseViewKeeper) lockedCoinsOf(ctx context.Context, addr sdk.AccAddress, denom string) math.Int {
+	locked := math.ZeroInt()
+	k.IterateAccountLocks(ctx, addr, func(lock types.Lock) bool {
+		locked = locked.Add(lock.Amount.AmountOf(denom))
+		return false
+	})
+
+	return locked
+}
@@ -162,7 +162,7 @@ func (k msgServer) SetSendEnabled(ctx context.Context, msg *types.MsgSetSendEnab
 	}
 
 	for _, denom := range msg.UseDefaultFor {
-		if err := sdk.ValidateDenom(denom); err != nil {
+		if err := (types.SendEnabled{Denom: denom}).Validate(); err != nil {
 			return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid UseDefaultFor denom %q: %s", denom, err)
 		}
 	}
@@ -177,6 +177,36 @@ func (k msgServer) SetSendEnabled(ctx context.Context, msg *types.MsgSetSendEnab
 	return &types.MsgSetSendEnabledResponse{}, nil
 }
 
+func (k msgServer) SetDenomMetadata(ctx context.Context, msg *types.MsgSetDenomMetadata) (*types.MsgSetDenomMetadataResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	if err := msg.Metadata.Validate(); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid metadata: %s", err)
+	}
+
+	k.SetDenomMetaData(ctx, msg.Metadata)
+
+	return &types.MsgSetDenomMetadataResponse{}, nil
+}
+
+func (k msgServer) RemoveDenomMetadata(ctx context.Context, msg *types.MsgRemoveDenomMetadata) (*types.MsgRemoveDenomMetadataResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	if !k.HasDenomMetaData(ctx, msg.Denom) {
+		return nil, errorsmod.Wrapf(types.ErrDenomMetadataNotFound, "denom %s", msg.Denom)
+	}
+
+	if err := k.RemoveDenomMetaData(ctx, msg.Denom); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveDenomMetadataResponse{}, nil
+}
+
 func (k msgServer) Burn(goCtx context.Context, msg *types.MsgBurn) (*types.MsgBurnResponse, error) {
 	var (
 		from []byte
@@ -301,6 +301,16 @@ func (suite *KeeperTestSuite) TestMsgSetSendEnabled() {
 				[]string{"defcoinc", "defcoind"},
 			),
 		},
+		{
+			name: "all good with wildcards",
+			req: banktypes.NewMsgSetSendEnabled(
+				govAccAddr,
+				[]*banktypes.SendEnabled{
+					banktypes.NewSendEnabled("ibc/*", false),
+				},
+				[]string{"factory/*"},
+			),
+		},
 		{
 			name: "duplicate denoms",
 			req: banktypes.NewMsgSetSendEnabled(
@@ -379,6 +389,132 @@ func (suite *KeeperTestSuite) TestMsgSetSendEnabled() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestMsgSetDenomMetadata() {
+	metadata := banktypes.Metadata{
+		Description: "IBC voucher of the Cosmos Hub Atom",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", Exponent: 0},
+			{Denom: "atom", Exponent: 6},
+		},
+		Base:    "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2",
+		Display: "atom",
+		Name:    "Cosmos Hub Atom",
+		Symbol:  "ATOM",
+	}
+
+	testCases := []struct {
+		name      string
+		input     *banktypes.MsgSetDenomMetadata
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name: "invalid authority",
+			input: &banktypes.MsgSetDenomMetadata{
+				Authority: "invalid",
+				Metadata:  metadata,
+			},
+			expErr:    true,
+			expErrMsg: "invalid authority",
+		},
+		{
+			name: "invalid metadata",
+			input: &banktypes.MsgSetDenomMetadata{
+				Authority: suite.bankKeeper.GetAuthority(),
+				Metadata:  banktypes.Metadata{Base: metadata.Base},
+			},
+			expErr:    true,
+			expErrMsg: "invalid metadata",
+		},
+		{
+			name: "all good",
+			input: &banktypes.MsgSetDenomMetadata{
+				Authority: suite.bankKeeper.GetAuthority(),
+				Metadata:  metadata,
+			},
+			expErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			_, err := suite.msgServer.SetDenomMetadata(suite.ctx, tc.input)
+
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				suite.Require().NoError(err)
+
+				actual, found := suite.bankKeeper.GetDenomMetaData(suite.ctx, tc.input.Metadata.Base)
+				suite.Require().True(found)
+				suite.Require().Equal(tc.input.Metadata, actual)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgRemoveDenomMetadata() {
+	metadata := banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{{Denom: "ufoo", Exponent: 0}, {Denom: "foo", Exponent: 6}},
+		Base:       "ufoo",
+		Display:    "foo",
+		Name:       "Foo",
+		Symbol:     "FOO",
+	}
+	suite.bankKeeper.SetDenomMetaData(suite.ctx, metadata)
+
+	testCases := []struct {
+		name      string
+		input     *banktypes.MsgRemoveDenomMetadata
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name: "invalid authority",
+			input: &banktypes.MsgRemoveDenomMetadata{
+				Authority: "invalid",
+				Denom:     metadata.Base,
+			},
+			expErr:    true,
+			expErrMsg: "invalid authority",
+		},
+		{
+			name: "unknown denom",
+			input: &banktypes.MsgRemoveDenomMetadata{
+				Authority: suite.bankKeeper.GetAuthority(),
+				Denom:     "ubar",
+			},
+			expErr:    true,
+			expErrMsg: "client denom metadata not found",
+		},
+		{
+			name: "all good",
+			input: &banktypes.MsgRemoveDenomMetadata{
+				Authority: suite.bankKeeper.GetAuthority(),
+				Denom:     metadata.Base,
+			},
+			expErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			_, err := suite.msgServer.RemoveDenomMetadata(suite.ctx, tc.input)
+
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().False(suite.bankKeeper.HasDenomMetaData(suite.ctx, tc.input.Denom))
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestMsgBurn() {
 	origCoins := sdk.NewInt64Coin("eth", 100)
 	atom0 := sdk.NewInt64Coin("atom", 0)
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/appmodule"
@@ -34,6 +35,7 @@ type SendKeeper interface {
 	IsSendEnabledDenom(ctx context.Context, denom string) bool
 	GetSendEnabledEntry(ctx context.Context, denom string) (types.SendEnabled, bool)
 	SetSendEnabled(ctx context.Context, denom string, value bool)
+	SetDefaultSendEnabled(ctx context.Context, value bool) error
 	SetAllSendEnabled(ctx context.Context, sendEnableds []*types.SendEnabled)
 	DeleteSendEnabled(ctx context.Context, denoms ...string)
 	IterateSendEnabledEntries(ctx context.Context, cb func(denom string, sendEnabled bool) (stop bool))
@@ -128,8 +130,15 @@ func (k BaseSendKeeper) SetParams(ctx context.Context, params types.Params) erro
 		k.SetAllSendEnabled(ctx, params.SendEnabled)
 
 		// override params without SendEnabled
-		params = types.NewParams(params.DefaultSendEnabled)
+		params.SendEnabled = nil
 	}
+
+	if params.DustCollector != "" {
+		if _, err := k.ak.AddressCodec().StringToBytes(params.DustCollector); err != nil {
+			return fmt.Errorf("invalid dust collector address: %w", err)
+		}
+	}
+
 	return k.Params.Set(ctx, params)
 }
 
@@ -153,6 +162,10 @@ func (k BaseSendKeeper) InputOutputCoins(ctx context.Context, input types.Input,
 		return err
 	}
 
+	if err := k.enforceMinimumBalance(ctx, inAddress, input.Coins); err != nil {
+		return err
+	}
+
 	var outAddress sdk.AccAddress
 	for _, out := range outputs {
 		outAddress, err = k.ak.AddressCodec().StringToBytes(out.Address)
@@ -190,6 +203,11 @@ func (k BaseSendKeeper) SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccA
 		return err
 	}
 
+	err = k.enforceMinimumBalance(ctx, fromAddr, amt)
+	if err != nil {
+		return err
+	}
+
 	toAddr, err = k.sendRestriction.apply(ctx, fromAddr, toAddr, amt)
 	if err != nil {
 		return err
@@ -302,6 +320,10 @@ func (k BaseSendKeeper) setBalance(ctx context.Context, addr sdk.AccAddress, bal
 		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, balance.String())
 	}
 
+	if k.deferred.isDeferred(addr) {
+		return k.setDeferredBalance(ctx, addr, balance)
+	}
+
 	// x/bank invariants prohibit persistence of zero balances
 	if balance.IsZero() {
 		err := k.Balances.Remove(ctx, collections.Join(addr, balance.Denom))
@@ -373,6 +395,14 @@ func (k BaseSendKeeper) SetSendEnabled(ctx context.Context, denom string, value
 	_ = k.SendEnabled.Set(ctx, denom, value)
 }
 
+// SetDefaultSendEnabled sets the SendEnabled value of the denoms that have
+// neither an entry of their own nor a matching wildcard entry.
+func (k BaseSendKeeper) SetDefaultSendEnabled(ctx context.Context, value bool) error {
+	params := k.GetParams(ctx)
+	params.DefaultSendEnabled = value
+	return k.SetParams(ctx, params)
+}
+
 // SetAllSendEnabled sets all the provided SendEnabled entries in the bank store.
 func (k BaseSendKeeper) SetAllSendEnabled(ctx context.Context, entries []*types.SendEnabled) {
 	for _, entry := range entries {
@@ -435,13 +465,21 @@ func (k BaseSendKeeper) getSendEnabled(ctx context.Context, denom string) (bool,
 }
 
 // getSendEnabledOrDefault gets the SendEnabled value for a denom. If it's not
-// in the store, this will return defaultVal.
+// in the store, the value of the most specific wildcard entry matching the
+// denom is used, e.g. "ibc/*" for "ibc/ABCD". Otherwise, this will return defaultVal.
 func (k BaseSendKeeper) getSendEnabledOrDefault(ctx context.Context, denom string, defaultVal bool) bool {
 	sendEnabled, found := k.getSendEnabled(ctx, denom)
 	if found {
 		return sendEnabled
 	}
 
+	for i := strings.LastIndex(denom, "/"); i > 0; i = strings.LastIndex(denom[:i], "/") {
+		sendEnabled, found = k.getSendEnabled(ctx, denom[:i]+types.SendEnabledWildcard)
+		if found {
+			return sendEnabled
+		}
+	}
+
 	return defaultVal
 }
 
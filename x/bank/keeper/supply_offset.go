@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AddSupplyOffset adds offsetAmount to the offset of the supply of denom. The
+// offset is applied to the supply returned by the Supply queries and lets
+// modules, e.g. liquid staking ones, represent tokens which exist but are not
+// circulating without burning them. A negative offsetAmount removes tokens from
+// the circulating supply.
+func (k BaseKeeper) AddSupplyOffset(ctx context.Context, denom string, offsetAmount math.Int) error {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return err
+	}
+
+	offset := k.GetSupplyOffset(ctx, denom).Add(offsetAmount)
+	if offset.IsZero() {
+		return k.BaseViewKeeper.SupplyOffsets.Remove(ctx, denom)
+	}
+
+	return k.BaseViewKeeper.SupplyOffsets.Set(ctx, denom, offset)
+}
+
+// GetSupplyOffset returns the offset of the supply of denom.
+func (k BaseKeeper) GetSupplyOffset(ctx context.Context, denom string) math.Int {
+	offset, err := k.BaseViewKeeper.SupplyOffsets.Get(ctx, denom)
+	if err != nil {
+		return math.ZeroInt()
+	}
+
+	return offset
+}
+
+// GetSupplyWithOffset returns the circulating supply of denom, i.e. its supply
+// with the offset applied. The result is never negative.
+func (k BaseKeeper) GetSupplyWithOffset(ctx context.Context, denom string) sdk.Coin {
+	return k.applySupplyOffset(ctx, k.GetSupply(ctx, denom))
+}
+
+// GetAllSupplyOffsets returns the offsets of the supply of all the denoms.
+func (k BaseKeeper) GetAllSupplyOffsets(ctx context.Context) []types.SupplyOffset {
+	var offsets []types.SupplyOffset
+	err := k.BaseViewKeeper.SupplyOffsets.Walk(ctx, nil, func(denom string, offset math.Int) (bool, error) {
+		offsets = append(offsets, types.SupplyOffset{Denom: denom, Offset: offset})
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return offsets
+}
+
+func (k BaseKeeper) applySupplyOffset(ctx context.Context, supply sdk.Coin) sdk.Coin {
+	amount := supply.Amount.Add(k.GetSupplyOffset(ctx, supply.Denom))
+	if amount.IsNegative() {
+		amount = math.ZeroInt()
+	}
+
+	return sdk.NewCoin(supply.Denom, amount)
+}
@@ -32,8 +32,15 @@ type ViewKeeper interface {
 	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
 	SpendableCoin(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
 
+	GetLock(ctx context.Context, addr sdk.AccAddress, lockID string) (types.Lock, bool)
+	GetLocks(ctx context.Context, addr sdk.AccAddress) []types.Lock
+	GetAllLocks(ctx context.Context) []types.Lock
+
 	IterateAccountBalances(ctx context.Context, addr sdk.AccAddress, cb func(coin sdk.Coin) (stop bool))
+	IterateAccountBalancesPaginated(ctx context.Context, addr sdk.AccAddress, startAfter string, cb func(coin sdk.Coin) (stop bool)) error
 	IterateAllBalances(ctx context.Context, cb func(address sdk.AccAddress, coin sdk.Coin) (stop bool))
+	IterateAccountLocks(ctx context.Context, addr sdk.AccAddress, cb func(lock types.Lock) (stop bool))
+	IterateAllLocks(ctx context.Context, cb func(address sdk.AccAddress, lock types.Lock) (stop bool))
 }
 
 func newBalancesIndexes(sb *collections.SchemaBuilder) BalancesIndexes {
@@ -66,20 +73,31 @@ type BaseViewKeeper struct {
 	SendEnabled   collections.Map[string, bool]
 	Balances      *collections.IndexedMap[collections.Pair[sdk.AccAddress, string], math.Int, BalancesIndexes]
 	Params        collections.Item[types.Params]
+	Locks         collections.Map[collections.Pair[sdk.AccAddress, string], types.Lock]
+	SupplyOffsets collections.Map[string, math.Int]
+	// DeferredBalances holds the pending balances of the deferred addresses,
+	// written to Balances by FlushDeferredBalances.
+	DeferredBalances collections.Map[collections.Pair[sdk.AccAddress, string], math.Int]
+
+	deferred *deferredBalances
 }
 
 // NewBaseViewKeeper returns a new BaseViewKeeper.
 func NewBaseViewKeeper(env appmodule.Environment, cdc codec.BinaryCodec, ak types.AccountKeeper) BaseViewKeeper {
 	sb := collections.NewSchemaBuilder(env.KVStoreService)
 	k := BaseViewKeeper{
-		cdc:           cdc,
-		environment:   env,
-		ak:            ak,
-		Supply:        collections.NewMap(sb, types.SupplyKey, "supply", collections.StringKey, sdk.IntValue),
-		DenomMetadata: collections.NewMap(sb, types.DenomMetadataPrefix, "denom_metadata", collections.StringKey, codec.CollValue[types.Metadata](cdc)),
-		SendEnabled:   collections.NewMap(sb, types.SendEnabledPrefix, "send_enabled", collections.StringKey, codec.BoolValue), // NOTE: we use a bool value which uses protobuf to retain state backwards compat
-		Balances:      collections.NewIndexedMap(sb, types.BalancesPrefix, "balances", collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), types.BalanceValueCodec, newBalancesIndexes(sb)),
-		Params:        collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		cdc:              cdc,
+		environment:      env,
+		ak:               ak,
+		Supply:           collections.NewMap(sb, types.SupplyKey, "supply", collections.StringKey, sdk.IntValue),
+		DenomMetadata:    collections.NewMap(sb, types.DenomMetadataPrefix, "denom_metadata", collections.StringKey, codec.CollValue[types.Metadata](cdc)),
+		SendEnabled:      collections.NewMap(sb, types.SendEnabledPrefix, "send_enabled", collections.StringKey, codec.BoolValue), // NOTE: we use a bool value which uses protobuf to retain state backwards compat
+		Balances:         collections.NewIndexedMap(sb, types.BalancesPrefix, "balances", collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), types.BalanceValueCodec, newBalancesIndexes(sb)),
+		Params:           collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		Locks:            collections.NewMap(sb, types.LocksPrefix, "locks", collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), codec.CollValue[types.Lock](cdc)),
+		SupplyOffsets:    collections.NewMap(sb, types.SupplyOffsetPrefix, "supply_offsets", collections.StringKey, sdk.IntValue),
+		DeferredBalances: collections.NewMap(sb, types.DeferredBalancesPrefix, "deferred_balances", collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), sdk.IntValue),
+		deferred:         &deferredBalances{},
 	}
 
 	schema, err := sb.Build()
@@ -144,17 +162,31 @@ func (k BaseViewKeeper) GetAccountsBalances(ctx context.Context) []types.Balance
 // GetBalance returns the balance of a specific denomination for a given account
 // by address.
 func (k BaseViewKeeper) GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin {
-	amt, err := k.Balances.Get(ctx, collections.Join(addr, denom))
-	if err != nil {
-		return sdk.NewCoin(denom, math.ZeroInt())
+	if k.deferred.isDeferred(addr) {
+		if amt, ok := k.pendingBalance(ctx, addr, denom); ok {
+			return sdk.NewCoin(denom, amt)
+		}
 	}
-	return sdk.NewCoin(denom, amt)
+	return sdk.NewCoin(denom, k.storedBalance(ctx, addr, denom))
 }
 
 // IterateAccountBalances iterates over the balances of a single account and
-// provides the token balance to a callback. If true is returned from the
-// callback, iteration is halted.
+// provides the token balance to a callback, in ascending denom order. If true
+// is returned from the callback, iteration is halted.
 func (k BaseViewKeeper) IterateAccountBalances(ctx context.Context, addr sdk.AccAddress, cb func(sdk.Coin) bool) {
+	if k.deferred.isDeferred(addr) {
+		balances, err := k.deferredAccountBalances(ctx, addr)
+		if err != nil {
+			panic(err)
+		}
+		for _, balance := range balances {
+			if cb(balance) {
+				break
+			}
+		}
+		return
+	}
+
 	err := k.Balances.Walk(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, string](addr), func(key collections.Pair[sdk.AccAddress, string], value math.Int) (stop bool, err error) {
 		return cb(sdk.NewCoin(key.K2(), value)), nil
 	})
@@ -163,32 +195,103 @@ func (k BaseViewKeeper) IterateAccountBalances(ctx context.Context, addr sdk.Acc
 	}
 }
 
+// IterateAccountBalancesPaginated iterates over the balances of a single account
+// in ascending denom order, starting after the startAfter denom, or from the
+// first balance if startAfter is empty, and provides the token balance to a
+// callback. If true is returned from the callback, iteration is halted. The denom
+// of the last balance provided is the cursor to resume the iteration from.
+func (k BaseViewKeeper) IterateAccountBalancesPaginated(ctx context.Context, addr sdk.AccAddress, startAfter string, cb func(sdk.Coin) bool) error {
+	if k.deferred.isDeferred(addr) {
+		balances, err := k.deferredAccountBalances(ctx, addr)
+		if err != nil {
+			return err
+		}
+		for _, balance := range balances {
+			if balance.Denom <= startAfter {
+				continue
+			}
+			if cb(balance) {
+				break
+			}
+		}
+		return nil
+	}
+
+	rng := collections.NewPrefixedPairRange[sdk.AccAddress, string](addr)
+	if startAfter != "" {
+		rng = rng.StartExclusive(startAfter)
+	}
+
+	return k.Balances.Walk(ctx, rng, func(key collections.Pair[sdk.AccAddress, string], value math.Int) (stop bool, err error) {
+		return cb(sdk.NewCoin(key.K2(), value)), nil
+	})
+}
+
 // IterateAllBalances iterates over all the balances of all accounts and
 // denominations that are provided to a callback. If true is returned from the
-// callback, iteration is halted.
+// callback, iteration is halted. The balances of the deferred addresses,
+// including their pending changes, are provided last.
 func (k BaseViewKeeper) IterateAllBalances(ctx context.Context, cb func(sdk.AccAddress, sdk.Coin) bool) {
+	stopped := false
 	err := k.Balances.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, string], value math.Int) (stop bool, err error) {
-		return cb(key.K1(), sdk.NewCoin(key.K2(), value)), nil
+		if k.deferred.isDeferred(key.K1()) {
+			return false, nil
+		}
+		stopped = cb(key.K1(), sdk.NewCoin(key.K2(), value))
+		return stopped, nil
 	})
 	if err != nil {
 		panic(err)
 	}
+	if stopped {
+		return
+	}
+
+	for _, addr := range k.deferred.addresses() {
+		balances, err := k.deferredAccountBalances(ctx, addr)
+		if err != nil {
+			panic(err)
+		}
+		for _, balance := range balances {
+			if cb(addr, balance) {
+				return
+			}
+		}
+	}
 }
 
 // LockedCoins returns all the coins that are not spendable (i.e. locked) for an
-// account by address. For standard accounts, the result will always be no coins.
-// For vesting accounts, LockedCoins is delegated to the concrete vesting account
-// type.
+// account by address. It is the sum of the coins locked with LockCoins and, for
+// vesting accounts, of the coins still vesting, which is delegated to the
+// concrete vesting account type.
 func (k BaseViewKeeper) LockedCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins {
+	locked := k.lockedCoins(ctx, addr)
+
 	acc := k.ak.GetAccount(ctx, addr)
 	if acc != nil {
 		vacc, ok := acc.(types.VestingAccount)
 		if ok {
-			return vacc.LockedCoins(k.environment.HeaderService.GetHeaderInfo(ctx).Time)
+			return locked.Add(vacc.LockedCoins(k.environment.HeaderService.GetHeaderInfo(ctx).Time)...)
 		}
 	}
 
-	return sdk.NewCoins()
+	return locked
+}
+
+// lockedAmountOf returns the amount of denom locked for an account by address,
+// as included in LockedCoins.
+func (k BaseViewKeeper) lockedAmountOf(ctx context.Context, addr sdk.AccAddress, denom string) math.Int {
+	locked := k.lockedCoinsOf(ctx, addr, denom)
+
+	acc := k.ak.GetAccount(ctx, addr)
+	if acc != nil {
+		vacc, ok := acc.(types.VestingAccount)
+		if ok {
+			return locked.Add(vacc.LockedCoins(k.environment.HeaderService.GetHeaderInfo(ctx).Time).AmountOf(denom))
+		}
+	}
+
+	return locked
 }
 
 // SpendableCoins returns the total balances of spendable coins for an account
@@ -200,12 +303,17 @@ func (k BaseViewKeeper) SpendableCoins(ctx context.Context, addr sdk.AccAddress)
 }
 
 // SpendableCoin returns the balance of specific denomination of spendable coins
-// for an account by address. If the account has no spendable coin, a zero Coin
-// is returned.
+// for an account by address: its balance of the denom minus the amount of the
+// denom locked, by locks or vesting. If the account has no spendable coin, or
+// more of the denom is locked than it holds, a zero Coin is returned. Unlike
+// SpendableCoins, the balances of the other denoms are not read.
 func (k BaseViewKeeper) SpendableCoin(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin {
 	balance := k.GetBalance(ctx, addr, denom)
-	locked := k.LockedCoins(ctx, addr)
-	return balance.SubAmount(locked.AmountOf(denom))
+	locked := k.lockedAmountOf(ctx, addr, denom)
+	if locked.GTE(balance.Amount) {
+		return sdk.NewCoin(denom, math.ZeroInt())
+	}
+	return balance.SubAmount(locked)
 }
 
 // spendableCoins returns the coins the given address can spend alongside the total amount of coins it holds.
@@ -38,6 +38,7 @@ var (
 	_ appmodule.HasMigrations         = AppModule{}
 	_ appmodule.HasGenesis            = AppModule{}
 	_ appmodule.HasRegisterInterfaces = AppModule{}
+	_ appmodule.HasEndBlocker         = AppModule{}
 )
 
 // AppModule implements an application module for the bank module.
@@ -151,6 +152,12 @@ func (am AppModule) ExportGenesis(ctx context.Context) (json.RawMessage, error)
 	return am.cdc.MarshalJSON(gs)
 }
 
+// EndBlock writes the pending balances of the deferred module accounts
+// to the store.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	return am.keeper.FlushDeferredBalances(ctx)
+}
+
 // ConsensusVersion implements HasConsensusVersion
 func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 
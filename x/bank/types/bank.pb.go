@@ -37,6 +37,13 @@ type Params struct {
 	// As of cosmos-sdk 0.47, this only exists for backwards compatibility of genesis files.
 	SendEnabled        []*SendEnabled `protobuf:"bytes,1,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled,omitempty"` // Deprecated: Do not use.
 	DefaultSendEnabled bool           `protobuf:"varint,2,opt,name=default_send_enabled,json=defaultSendEnabled,proto3" json:"default_send_enabled,omitempty"`
+	// minimum_balances are the smallest non-zero balances, per denom, that an
+	// account can be left with after a transfer. The check is disabled for the
+	// denoms without a minimum balance.
+	MinimumBalances github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=minimum_balances,json=minimumBalances,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"minimum_balances"`
+	// dust_collector is the address receiving the remainder of a transfer which
+	// would leave a balance below the minimum. If empty, such transfers fail.
+	DustCollector string `protobuf:"bytes,4,opt,name=dust_collector,json=dustCollector,proto3" json:"dust_collector,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -87,6 +94,20 @@ func (m *Params) GetDefaultSendEnabled() bool {
 	return false
 }
 
+func (m *Params) GetMinimumBalances() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MinimumBalances
+	}
+	return nil
+}
+
+func (m *Params) GetDustCollector() string {
+	if m != nil {
+		return m.DustCollector
+	}
+	return ""
+}
+
 // SendEnabled maps coin denom to a send_enabled status (whether a denom is
 // sendable).
 type SendEnabled struct {
@@ -451,6 +472,72 @@ func (m *Metadata) GetURIHash() string {
 	return ""
 }
 
+// Lock defines an amount of coins of an account locked under an identifier, e.g.
+// by a module holding the coins in escrow. Locked coins remain in the account
+// balance but are not spendable until they are unlocked.
+type Lock struct {
+	// address is the address of the account holding the locked coins.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// lock_id identifies the lock within the account.
+	LockId string `protobuf:"bytes,2,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+	// amount is the locked amount of coins.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *Lock) Reset()         { *m = Lock{} }
+func (m *Lock) String() string { return proto.CompactTextString(m) }
+func (*Lock) ProtoMessage()    {}
+func (*Lock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{7}
+}
+func (m *Lock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Lock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Lock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Lock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Lock.Merge(m, src)
+}
+func (m *Lock) XXX_Size() int {
+	return m.Size()
+}
+func (m *Lock) XXX_DiscardUnknown() {
+	xxx_messageInfo_Lock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Lock proto.InternalMessageInfo
+
+func (m *Lock) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Lock) GetLockId() string {
+	if m != nil {
+		return m.LockId
+	}
+	return ""
+}
+
+func (m *Lock) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.bank.v1beta1.Params")
 	proto.RegisterType((*SendEnabled)(nil), "cosmos.bank.v1beta1.SendEnabled")
@@ -459,55 +546,62 @@ func init() {
 	proto.RegisterType((*Supply)(nil), "cosmos.bank.v1beta1.Supply")
 	proto.RegisterType((*DenomUnit)(nil), "cosmos.bank.v1beta1.DenomUnit")
 	proto.RegisterType((*Metadata)(nil), "cosmos.bank.v1beta1.Metadata")
+	proto.RegisterType((*Lock)(nil), "cosmos.bank.v1beta1.Lock")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/bank.proto", fileDescriptor_dd052eee12edf988) }
 
 var fileDescriptor_dd052eee12edf988 = []byte{
-	// 683 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x54, 0xbf, 0x6f, 0x13, 0x4b,
-	0x10, 0xf6, 0xda, 0xf1, 0x8f, 0xac, 0xf3, 0x8a, 0xb7, 0xcf, 0x7a, 0x6f, 0x93, 0x27, 0xce, 0x96,
-	0x0b, 0x64, 0x2c, 0xc5, 0x47, 0x92, 0xce, 0x0d, 0xc2, 0xe1, 0x97, 0x0b, 0x04, 0xba, 0x28, 0x42,
-	0xa2, 0xb1, 0xd6, 0xbe, 0xc5, 0x5e, 0xe5, 0x6e, 0xf7, 0x74, 0xbb, 0x17, 0xe2, 0x96, 0x0a, 0xa5,
-	0xa2, 0xa6, 0x8a, 0xa8, 0x10, 0xa2, 0x70, 0x91, 0x9e, 0x36, 0x4a, 0x15, 0x51, 0x51, 0x05, 0xe4,
-	0x14, 0xce, 0x9f, 0x81, 0x6e, 0xf7, 0xce, 0x71, 0xa4, 0xd0, 0x22, 0xd1, 0xd8, 0x33, 0xf3, 0x7d,
-	0x3b, 0xf3, 0xcd, 0xec, 0xdc, 0x42, 0x6b, 0x20, 0xa4, 0x2f, 0xa4, 0xdd, 0x27, 0x7c, 0xcf, 0xde,
-	0xdf, 0xe8, 0x53, 0x45, 0x36, 0xb4, 0xd3, 0x0a, 0x42, 0xa1, 0x04, 0xfa, 0xc7, 0xe0, 0x2d, 0x1d,
-	0x4a, 0xf0, 0xb5, 0xca, 0x50, 0x0c, 0x85, 0xc6, 0xed, 0xd8, 0x32, 0xd4, 0xb5, 0x55, 0x43, 0xed,
-	0x19, 0x20, 0x39, 0x67, 0xa0, 0xab, 0x2a, 0x92, 0xce, 0xab, 0x0c, 0x04, 0xe3, 0x09, 0xfe, 0x5f,
-	0x82, 0xfb, 0x72, 0x68, 0xef, 0x6f, 0xc4, 0x7f, 0x09, 0xf0, 0x37, 0xf1, 0x19, 0x17, 0xb6, 0xfe,
-	0x35, 0xa1, 0xfa, 0x07, 0x00, 0x0b, 0xcf, 0x49, 0x48, 0x7c, 0x89, 0x1e, 0xc3, 0x15, 0x49, 0xb9,
-	0xdb, 0xa3, 0x9c, 0xf4, 0x3d, 0xea, 0x62, 0x50, 0xcb, 0x35, 0xca, 0x9b, 0xb5, 0xd6, 0x0d, 0x9a,
-	0x5b, 0x3b, 0x94, 0xbb, 0x0f, 0x0d, 0xaf, 0x93, 0xc5, 0xc0, 0x29, 0xcb, 0xab, 0x00, 0xba, 0x0b,
-	0x2b, 0x2e, 0x7d, 0x45, 0x22, 0x4f, 0xf5, 0xae, 0x25, 0xcc, 0xd6, 0x40, 0xa3, 0xe4, 0xa0, 0x04,
-	0x5b, 0x48, 0xd1, 0xbe, 0x75, 0x38, 0x9b, 0x34, 0xb1, 0x29, 0xb4, 0x2e, 0xdd, 0x3d, 0xfb, 0xc0,
-	0x8c, 0xd0, 0x28, 0xab, 0x6f, 0xc3, 0xf2, 0x02, 0x1b, 0x55, 0x60, 0xde, 0xa5, 0x5c, 0xf8, 0x18,
-	0xd4, 0x40, 0x63, 0xd9, 0x31, 0x0e, 0xc2, 0xb0, 0x78, 0xbd, 0x50, 0xea, 0xb6, 0x97, 0x2e, 0x8f,
-	0xaa, 0xa0, 0x7e, 0x0a, 0x60, 0xbe, 0xcb, 0x83, 0x48, 0xa1, 0x4d, 0x58, 0x24, 0xae, 0x1b, 0x52,
-	0x29, 0x4d, 0x86, 0x0e, 0xfe, 0x7a, 0xbc, 0x5e, 0x49, 0xda, 0xbc, 0x6f, 0x90, 0x1d, 0x15, 0x32,
-	0x3e, 0x74, 0x52, 0x22, 0x7a, 0x0d, 0xf3, 0xf1, 0x84, 0x25, 0xce, 0xea, 0xa9, 0xac, 0x5e, 0x4d,
-	0x45, 0xd2, 0xf9, 0x54, 0xb6, 0x05, 0xe3, 0x9d, 0x47, 0x27, 0xe7, 0xd5, 0xcc, 0xa7, 0xef, 0xd5,
-	0xc6, 0x90, 0xa9, 0x51, 0xd4, 0x6f, 0x0d, 0x84, 0x9f, 0x5c, 0x9f, 0xbd, 0xd0, 0xa0, 0x1a, 0x07,
-	0x54, 0xea, 0x03, 0xf2, 0xfd, 0x6c, 0xd2, 0x5c, 0xf1, 0xe8, 0x90, 0x0c, 0xc6, 0x3d, 0x5d, 0xe3,
-	0xe3, 0x6c, 0xd2, 0x04, 0x8e, 0xa9, 0xd7, 0xae, 0xbc, 0x3d, 0xaa, 0x66, 0x2e, 0x8f, 0xaa, 0x99,
-	0x37, 0xb3, 0x49, 0x33, 0x95, 0x53, 0xff, 0x02, 0x60, 0xe1, 0x59, 0xa4, 0xfe, 0xb8, 0x6e, 0x4a,
-	0x69, 0x37, 0xf5, 0xcf, 0x00, 0x16, 0x76, 0xa2, 0x20, 0xf0, 0xc6, 0xb1, 0x1a, 0x25, 0x14, 0xf1,
-	0x92, 0x8d, 0xfb, 0x1d, 0x6a, 0x74, 0xbd, 0xf6, 0x9d, 0x44, 0x0d, 0x38, 0x3d, 0x5e, 0xff, 0xff,
-	0xc6, 0x35, 0xd7, 0x02, 0xbb, 0x18, 0xd4, 0x5f, 0xc0, 0xe5, 0x07, 0xf1, 0x9a, 0xed, 0x72, 0xa6,
-	0x7e, 0xb1, 0x80, 0x6b, 0xb0, 0x44, 0x0f, 0x02, 0xc1, 0x29, 0x57, 0x7a, 0x03, 0xff, 0x72, 0xe6,
-	0x7e, 0xbc, 0x9c, 0xc4, 0x63, 0x44, 0x52, 0x89, 0x73, 0xb5, 0x5c, 0x63, 0xd9, 0x49, 0xdd, 0xfa,
-	0x61, 0x16, 0x96, 0x9e, 0x52, 0x45, 0x5c, 0xa2, 0x08, 0xaa, 0xc1, 0xb2, 0x4b, 0xe5, 0x20, 0x64,
-	0x81, 0x62, 0x82, 0x27, 0xe9, 0x17, 0x43, 0xe8, 0x5e, 0xcc, 0xe0, 0xc2, 0xef, 0x45, 0x9c, 0xa9,
-	0xf4, 0xfe, 0xac, 0x1b, 0xbf, 0xd1, 0xb9, 0x5e, 0x07, 0xba, 0xa9, 0x29, 0x11, 0x82, 0x4b, 0xf1,
-	0x5c, 0x71, 0x4e, 0xe7, 0xd6, 0x76, 0xac, 0xce, 0x65, 0x32, 0xf0, 0xc8, 0x18, 0x2f, 0xe9, 0x70,
-	0xea, 0xc6, 0x6c, 0x4e, 0x7c, 0x8a, 0xf3, 0x86, 0x1d, 0xdb, 0xe8, 0x5f, 0x58, 0x90, 0x63, 0xbf,
-	0x2f, 0x3c, 0x5c, 0xd0, 0xd1, 0xc4, 0x43, 0xab, 0x30, 0x17, 0x85, 0x0c, 0x17, 0xf5, 0x12, 0x16,
-	0xa7, 0xe7, 0xd5, 0xdc, 0xae, 0xd3, 0x75, 0xe2, 0x18, 0xba, 0x0d, 0x4b, 0x51, 0xc8, 0x7a, 0x23,
-	0x22, 0x47, 0xb8, 0xa4, 0xf1, 0xf2, 0xf4, 0xbc, 0x5a, 0xdc, 0x75, 0xba, 0x4f, 0x88, 0x1c, 0x39,
-	0xc5, 0x28, 0x64, 0xb1, 0xd1, 0xd9, 0x3a, 0x99, 0x5a, 0xe0, 0x6c, 0x6a, 0x81, 0x1f, 0x53, 0x0b,
-	0xbc, 0xbb, 0xb0, 0x32, 0x67, 0x17, 0x56, 0xe6, 0xdb, 0x85, 0x95, 0x79, 0x99, 0x3c, 0x87, 0xd2,
-	0xdd, 0x6b, 0x31, 0x91, 0x3e, 0x0f, 0xfa, 0xa2, 0xfb, 0x05, 0xfd, 0x92, 0x6d, 0xfd, 0x0c, 0x00,
-	0x00, 0xff, 0xff, 0xd4, 0xee, 0xbd, 0x69, 0x7d, 0x05, 0x00, 0x00,
+	// 777 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0xbf, 0x8f, 0x1b, 0x45,
+	0x14, 0xf6, 0xd8, 0x77, 0x6b, 0x7b, 0x9c, 0xf0, 0x63, 0xb0, 0xc8, 0xdc, 0x21, 0xd6, 0xd6, 0x16,
+	0xc8, 0x58, 0x3a, 0x9b, 0x4b, 0x3a, 0x37, 0x51, 0x7c, 0xfc, 0xb2, 0x04, 0x02, 0xed, 0xe9, 0x84,
+	0x44, 0xb3, 0x1a, 0xef, 0x0e, 0xf6, 0xc8, 0xbb, 0x33, 0xab, 0x9d, 0xd9, 0x10, 0xb7, 0x34, 0xa0,
+	0x54, 0xd4, 0x54, 0x57, 0x22, 0x44, 0xe1, 0x22, 0x3d, 0x6d, 0x94, 0x02, 0x45, 0x54, 0x54, 0x07,
+	0xf2, 0x15, 0x4e, 0xc3, 0xff, 0x80, 0x66, 0x76, 0xd6, 0xe7, 0x48, 0x87, 0x22, 0x81, 0x14, 0x89,
+	0xc6, 0x3b, 0xef, 0x7d, 0xdf, 0xbe, 0xef, 0x9b, 0xe7, 0x37, 0xb3, 0xd0, 0x0d, 0x85, 0x4c, 0x84,
+	0x1c, 0x4e, 0x09, 0x5f, 0x0c, 0xef, 0x1f, 0x4f, 0xa9, 0x22, 0xc7, 0x26, 0x18, 0xa4, 0x99, 0x50,
+	0x02, 0xbd, 0x51, 0xe0, 0x03, 0x93, 0xb2, 0xf8, 0x61, 0x7b, 0x26, 0x66, 0xc2, 0xe0, 0x43, 0xbd,
+	0x2a, 0xa8, 0x87, 0x07, 0x05, 0x35, 0x28, 0x00, 0xfb, 0x5e, 0x01, 0x5d, 0xa9, 0x48, 0xba, 0x55,
+	0x09, 0x05, 0xe3, 0x16, 0xbf, 0x65, 0xf1, 0x44, 0xce, 0x86, 0xf7, 0x8f, 0xf5, 0xc3, 0x02, 0xaf,
+	0x93, 0x84, 0x71, 0x31, 0x34, 0xbf, 0x45, 0xca, 0xfb, 0xab, 0x0a, 0x9d, 0xcf, 0x49, 0x46, 0x12,
+	0x89, 0x3e, 0x82, 0x37, 0x24, 0xe5, 0x51, 0x40, 0x39, 0x99, 0xc6, 0x34, 0xc2, 0xa0, 0x5b, 0xeb,
+	0xb5, 0x6e, 0x77, 0x07, 0xd7, 0x78, 0x1e, 0x9c, 0x52, 0x1e, 0x7d, 0x50, 0xf0, 0xc6, 0x55, 0x0c,
+	0xfc, 0x96, 0xbc, 0x4a, 0xa0, 0xf7, 0x60, 0x3b, 0xa2, 0x5f, 0x91, 0x3c, 0x56, 0xc1, 0x73, 0x05,
+	0xab, 0x5d, 0xd0, 0x6b, 0xf8, 0xc8, 0x62, 0x3b, 0x25, 0xd0, 0xb7, 0x00, 0xbe, 0x96, 0x30, 0xce,
+	0x92, 0x3c, 0x09, 0xa6, 0x24, 0x26, 0x3c, 0xa4, 0x12, 0xd7, 0x8c, 0xfe, 0xc1, 0x95, 0xbe, 0xa4,
+	0x5b, 0xfd, 0x13, 0xc1, 0xf8, 0xf8, 0xde, 0xe3, 0x8b, 0x4e, 0xe5, 0xa7, 0x3f, 0x3a, 0xbd, 0x19,
+	0x53, 0xf3, 0x7c, 0x3a, 0x08, 0x45, 0x62, 0x1b, 0x65, 0x1f, 0x47, 0x32, 0x5a, 0x0c, 0xd5, 0x32,
+	0xa5, 0xd2, 0xbc, 0x20, 0x7f, 0xd8, 0xac, 0xfa, 0x37, 0x62, 0x3a, 0x23, 0xe1, 0x32, 0xd0, 0xfd,
+	0x92, 0xfe, 0xab, 0x56, 0x75, 0x6c, 0x45, 0xd1, 0x5d, 0xf8, 0x4a, 0x94, 0x4b, 0x15, 0x84, 0x22,
+	0x8e, 0x69, 0xa8, 0x44, 0x86, 0xf7, 0xba, 0xa0, 0xd7, 0x1c, 0xe3, 0xdf, 0x1e, 0x1d, 0xb5, 0xad,
+	0x93, 0x7b, 0x51, 0x94, 0x51, 0x29, 0x4f, 0x55, 0xc6, 0xf8, 0xcc, 0xbf, 0xa9, 0xf9, 0x27, 0x25,
+	0x7d, 0xf4, 0xf6, 0xc3, 0xcd, 0xaa, 0x8f, 0x77, 0xf4, 0x1f, 0x14, 0xd3, 0x50, 0x34, 0xd9, 0x3b,
+	0x81, 0xad, 0xdd, 0x8d, 0xb7, 0xe1, 0x7e, 0x44, 0xb9, 0x48, 0x30, 0xd0, 0x2a, 0x7e, 0x11, 0x20,
+	0x0c, 0xeb, 0xcf, 0xf7, 0xac, 0x0c, 0x47, 0x7b, 0xcf, 0xce, 0x3b, 0xc0, 0x7b, 0x02, 0xe0, 0xfe,
+	0x84, 0xa7, 0xb9, 0x42, 0xb7, 0x61, 0x9d, 0x14, 0x6e, 0x30, 0x78, 0x81, 0xcf, 0x92, 0x88, 0xbe,
+	0x86, 0xfb, 0x66, 0xf3, 0xb8, 0xfa, 0xa2, 0x06, 0x7f, 0xf8, 0x9f, 0x1b, 0xfc, 0xe3, 0x66, 0xd5,
+	0x07, 0x7e, 0xa1, 0x37, 0x6a, 0x7f, 0x77, 0xde, 0xa9, 0x3c, 0x3b, 0xef, 0x54, 0xbe, 0xd9, 0xac,
+	0xfa, 0xa5, 0x1d, 0xef, 0x17, 0x00, 0x9d, 0xcf, 0x72, 0xf5, 0xbf, 0xdb, 0x4d, 0xa3, 0xdc, 0x8d,
+	0xf7, 0x33, 0x80, 0xce, 0x69, 0x9e, 0xa6, 0xf1, 0x52, 0xbb, 0x51, 0x42, 0x91, 0x18, 0x83, 0x97,
+	0xe6, 0xc6, 0xe8, 0x8d, 0xde, 0xb5, 0x6e, 0xc0, 0x93, 0x47, 0x47, 0x6f, 0x5d, 0x7b, 0x62, 0x8d,
+	0xc1, 0x09, 0x06, 0xde, 0x17, 0xb0, 0xf9, 0xbe, 0x1e, 0xb3, 0x33, 0xce, 0xd4, 0x3f, 0x0c, 0xe0,
+	0x21, 0x6c, 0xd0, 0x07, 0xa9, 0xe0, 0x94, 0x2b, 0x33, 0x81, 0x37, 0xfd, 0x6d, 0xac, 0x87, 0x93,
+	0xc4, 0x8c, 0x48, 0x7b, 0x42, 0x9b, 0x7e, 0x19, 0x7a, 0x0f, 0xab, 0xb0, 0xf1, 0x29, 0x55, 0x24,
+	0x22, 0x8a, 0xa0, 0x2e, 0x6c, 0x45, 0x54, 0x86, 0x19, 0x4b, 0x15, 0x13, 0xdc, 0x96, 0xdf, 0x4d,
+	0xa1, 0xbb, 0x9a, 0xc1, 0x45, 0x12, 0xe4, 0x9c, 0xa9, 0xf2, 0xff, 0x73, 0xaf, 0xbd, 0x6e, 0xb6,
+	0x7e, 0x7d, 0x18, 0x95, 0x4b, 0x89, 0x10, 0xdc, 0xd3, 0x7d, 0xc5, 0x35, 0x53, 0xdb, 0xac, 0xb5,
+	0xbb, 0x88, 0xc9, 0x34, 0x26, 0xcb, 0xe2, 0xe0, 0xfa, 0x65, 0xa8, 0xd9, 0x9c, 0x24, 0x14, 0xef,
+	0x17, 0x6c, 0xbd, 0x46, 0x6f, 0x42, 0x47, 0x2e, 0x93, 0xa9, 0x88, 0xb1, 0x63, 0xb2, 0x36, 0x42,
+	0x07, 0xb0, 0x96, 0x67, 0x0c, 0xd7, 0xcd, 0x10, 0xd6, 0xd7, 0x17, 0x9d, 0xda, 0x99, 0x3f, 0xf1,
+	0x75, 0x0e, 0xbd, 0x03, 0x1b, 0x79, 0xc6, 0x82, 0x39, 0x91, 0x73, 0xdc, 0x30, 0x78, 0x6b, 0x7d,
+	0xd1, 0xa9, 0x9f, 0xf9, 0x93, 0x8f, 0x89, 0x9c, 0xfb, 0xf5, 0x3c, 0x63, 0x7a, 0xe1, 0xfd, 0x0a,
+	0xe0, 0xde, 0x27, 0x22, 0x5c, 0xfc, 0xab, 0xa1, 0xbe, 0x05, 0xeb, 0xb1, 0x08, 0x17, 0x01, 0x2b,
+	0x2e, 0x80, 0xa6, 0xef, 0xe8, 0x70, 0x12, 0xa1, 0x25, 0x74, 0x48, 0x22, 0x72, 0xae, 0x70, 0xed,
+	0x65, 0x0d, 0x98, 0x15, 0x1c, 0xdf, 0x79, 0xbc, 0x76, 0xc1, 0xd3, 0xb5, 0x0b, 0xfe, 0x5c, 0xbb,
+	0xe0, 0xfb, 0x4b, 0xb7, 0xf2, 0xf4, 0xd2, 0xad, 0xfc, 0x7e, 0xe9, 0x56, 0xbe, 0xb4, 0x9f, 0x2a,
+	0x19, 0x2d, 0x06, 0x4c, 0x94, 0xf7, 0x9d, 0x29, 0x3c, 0x75, 0xcc, 0x57, 0xe6, 0xce, 0xdf, 0x03,
+	0x00, 0x4c, 0xb3, 0x2e, 0xa4, 0x19, 0x07, 0x00, 0x00,
 }
 
 func (this *SendEnabled) Equal(that interface{}) bool {
@@ -586,6 +680,27 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DustCollector) > 0 {
+		i -= len(m.DustCollector)
+		copy(dAtA[i:], m.DustCollector)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.DustCollector)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MinimumBalances) > 0 {
+		for iNdEx := len(m.MinimumBalances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinimumBalances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.DefaultSendEnabled {
 		i--
 		if m.DefaultSendEnabled {
@@ -908,6 +1023,57 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Lock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Lock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Lock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.LockId) > 0 {
+		i -= len(m.LockId)
+		copy(dAtA[i:], m.LockId)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.LockId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintBank(dAtA []byte, offset int, v uint64) int {
 	offset -= sovBank(v)
 	base := offset
@@ -934,6 +1100,16 @@ func (m *Params) Size() (n int) {
 	if m.DefaultSendEnabled {
 		n += 2
 	}
+	if len(m.MinimumBalances) > 0 {
+		for _, e := range m.MinimumBalances {
+			l = e.Size()
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	l = len(m.DustCollector)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
 	return n
 }
 
@@ -1071,6 +1247,29 @@ func (m *Metadata) Size() (n int) {
 	return n
 }
 
+func (m *Lock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = len(m.LockId)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovBank(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1160,6 +1359,72 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.DefaultSendEnabled = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumBalances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinimumBalances = append(m.MinimumBalances, types.Coin{})
+			if err := m.MinimumBalances[len(m.MinimumBalances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DustCollector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DustCollector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBank(dAtA[iNdEx:])
@@ -2040,6 +2305,154 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Lock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Lock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Lock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipBank(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
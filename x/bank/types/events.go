@@ -16,9 +16,15 @@ const (
 	EventTypeCoinReceived = "coin_received"
 	EventTypeCoinMint     = "coinbase" // NOTE(fdymylja): using mint clashes with mint module event
 	EventTypeCoinBurn     = "burn"
+	EventTypeCoinLock     = "coin_lock"
+	EventTypeCoinUnlock   = "coin_unlock"
+	EventTypeDustSweep    = "dust_sweep"
 
-	AttributeKeySpender  = "spender"
-	AttributeKeyReceiver = "receiver"
-	AttributeKeyMinter   = "minter"
-	AttributeKeyBurner   = "burner"
+	AttributeKeySpender   = "spender"
+	AttributeKeyReceiver  = "receiver"
+	AttributeKeyMinter    = "minter"
+	AttributeKeyBurner    = "burner"
+	AttributeKeyOwner     = "owner"
+	AttributeKeyLockID    = "lock_id"
+	AttributeKeyCollector = "collector"
 )
@@ -23,6 +23,9 @@ func (gs GenesisState) Validate() error {
 	seenSendEnabled := make(map[string]bool)
 	seenBalances := make(map[string]bool)
 	seenMetadatas := make(map[string]bool)
+	seenLocks := make(map[string]bool)
+	balances := make(map[string]sdk.Coins)
+	locked := make(map[string]sdk.Coins)
 
 	totalSupply := sdk.Coins{}
 
@@ -46,6 +49,7 @@ func (gs GenesisState) Validate() error {
 		}
 
 		seenBalances[balance.Address] = true
+		balances[balance.Address] = balance.Coins
 
 		totalSupply = totalSupply.Add(balance.Coins...)
 	}
@@ -62,6 +66,39 @@ func (gs GenesisState) Validate() error {
 		seenMetadatas[metadata.Base] = true
 	}
 
+	for _, lock := range gs.Locks {
+		key := lock.Address + "/" + lock.LockId
+		if seenLocks[key] {
+			return fmt.Errorf("duplicate lock %s for address %s", lock.LockId, lock.Address)
+		}
+
+		if err := lock.Validate(); err != nil {
+			return err
+		}
+
+		seenLocks[key] = true
+		locked[lock.Address] = locked[lock.Address].Add(lock.Amount...)
+	}
+
+	seenOffsets := make(map[string]bool)
+	for _, offset := range gs.SupplyOffsets {
+		if seenOffsets[offset.Denom] {
+			return fmt.Errorf("duplicate supply offset for denom %s", offset.Denom)
+		}
+
+		if err := offset.Validate(); err != nil {
+			return err
+		}
+
+		seenOffsets[offset.Denom] = true
+	}
+
+	for addr, amount := range locked {
+		if !amount.IsAllLTE(balances[addr]) {
+			return fmt.Errorf("locked amount %s exceeds the balance %s of address %s", amount, balances[addr], addr)
+		}
+	}
+
 	if !gs.Supply.Empty() {
 		// NOTE: this errors if supply for any given coin is zero
 		err := gs.Supply.Validate()
@@ -142,3 +179,16 @@ func (gs GenesisState) GetAllSendEnabled() []SendEnabled {
 
 	return rv
 }
+
+// Validate checks for denom and offset correctness.
+func (o SupplyOffset) Validate() error {
+	if err := sdk.ValidateDenom(o.Denom); err != nil {
+		return err
+	}
+
+	if o.Offset.IsNil() || o.Offset.IsZero() {
+		return fmt.Errorf("supply offset of denom %s cannot be zero", o.Denom)
+	}
+
+	return nil
+}
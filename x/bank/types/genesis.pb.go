@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
@@ -42,6 +43,11 @@ type GenesisState struct {
 	//
 	// Since: cosmos-sdk 0.47
 	SendEnabled []SendEnabled `protobuf:"bytes,5,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled"`
+	// locks defines the coins locked in account balances.
+	Locks []Lock `protobuf:"bytes,6,rep,name=locks,proto3" json:"locks"`
+	// supply_offsets defines the offsets applied to the supply of the different
+	// coins to compute their circulating supply.
+	SupplyOffsets []SupplyOffset `protobuf:"bytes,7,rep,name=supply_offsets,json=supplyOffsets,proto3" json:"supply_offsets"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -112,6 +118,20 @@ func (m *GenesisState) GetSendEnabled() []SendEnabled {
 	return nil
 }
 
+func (m *GenesisState) GetLocks() []Lock {
+	if m != nil {
+		return m.Locks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSupplyOffsets() []SupplyOffset {
+	if m != nil {
+		return m.SupplyOffsets
+	}
+	return nil
+}
+
 // Balance defines an account address and balance pair used in the bank module's
 // genesis state.
 type Balance struct {
@@ -154,44 +174,101 @@ func (m *Balance) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Balance proto.InternalMessageInfo
 
+// SupplyOffset defines the offset applied to the supply of a coin to compute its
+// circulating supply.
+type SupplyOffset struct {
+	// denom is the denomination of the coin.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// offset is added to the supply of the coin, it is usually negative.
+	Offset cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=offset,proto3,customtype=cosmossdk.io/math.Int" json:"offset"`
+}
+
+func (m *SupplyOffset) Reset()         { *m = SupplyOffset{} }
+func (m *SupplyOffset) String() string { return proto.CompactTextString(m) }
+func (*SupplyOffset) ProtoMessage()    {}
+func (*SupplyOffset) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f007de11b420c6e, []int{2}
+}
+func (m *SupplyOffset) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SupplyOffset) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SupplyOffset.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SupplyOffset) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SupplyOffset.Merge(m, src)
+}
+func (m *SupplyOffset) XXX_Size() int {
+	return m.Size()
+}
+func (m *SupplyOffset) XXX_DiscardUnknown() {
+	xxx_messageInfo_SupplyOffset.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SupplyOffset proto.InternalMessageInfo
+
+func (m *SupplyOffset) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.bank.v1beta1.GenesisState")
 	proto.RegisterType((*Balance)(nil), "cosmos.bank.v1beta1.Balance")
+	proto.RegisterType((*SupplyOffset)(nil), "cosmos.bank.v1beta1.SupplyOffset")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/genesis.proto", fileDescriptor_8f007de11b420c6e) }
 
 var fileDescriptor_8f007de11b420c6e = []byte{
-	// 463 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x92, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x6d, 0x42, 0xd3, 0xf6, 0x12, 0x90, 0x38, 0x3a, 0xb8, 0x05, 0x9c, 0xd0, 0x29, 0xaa,
-	0x54, 0x5b, 0x4d, 0x37, 0x06, 0x24, 0x5c, 0x01, 0x13, 0x3f, 0xd4, 0x6c, 0x2c, 0xd1, 0xd9, 0xf7,
-	0x64, 0xac, 0xc4, 0x77, 0x56, 0xde, 0x15, 0xc8, 0x7f, 0xc0, 0xc8, 0xcc, 0xd4, 0x11, 0xb1, 0xd0,
-	0x81, 0x3f, 0x80, 0xb1, 0x63, 0xc5, 0xc4, 0x04, 0x28, 0x19, 0xca, 0x9f, 0x81, 0x7c, 0x77, 0x75,
-	0x2c, 0xe1, 0x99, 0xc5, 0x3e, 0xdd, 0xf7, 0xfb, 0x3e, 0xef, 0xc7, 0x3d, 0x72, 0x3f, 0x91, 0x98,
-	0x4b, 0x0c, 0x63, 0x26, 0x26, 0xe1, 0x9b, 0x83, 0x18, 0x14, 0x3b, 0x08, 0x53, 0x10, 0x80, 0x19,
-	0x06, 0xc5, 0x4c, 0x2a, 0x49, 0x6f, 0x1b, 0x4b, 0x50, 0x5a, 0x02, 0x6b, 0xd9, 0xd9, 0x4a, 0x65,
-	0x2a, 0xb5, 0x1e, 0x96, 0x27, 0x63, 0xdd, 0xf1, 0x2b, 0x1a, 0x42, 0x45, 0x4b, 0x64, 0x26, 0xfe,
-	0xd1, 0x6b, 0xd9, 0x34, 0xd7, 0xe8, 0xdb, 0x46, 0x1f, 0x1b, 0xb0, 0xcd, 0x6b, 0xa4, 0x5b, 0x2c,
-	0xcf, 0x84, 0x0c, 0xf5, 0xd7, 0x5c, 0xed, 0x7e, 0x69, 0x91, 0xee, 0x53, 0x53, 0xea, 0x48, 0x31,
-	0x05, 0xf4, 0x21, 0x69, 0x17, 0x6c, 0xc6, 0x72, 0xf4, 0xdc, 0xbe, 0x3b, 0xe8, 0x0c, 0xef, 0x04,
-	0x0d, 0xa5, 0x07, 0x2f, 0xb5, 0x25, 0xda, 0x3c, 0xff, 0xd9, 0x73, 0x3e, 0x5d, 0x9e, 0xed, 0xb9,
-	0xc7, 0x36, 0x8a, 0x1e, 0x91, 0x8d, 0x98, 0x4d, 0x99, 0x48, 0x00, 0xbd, 0x6b, 0xfd, 0xd6, 0xa0,
-	0x33, 0xbc, 0xdb, 0x48, 0x88, 0x8c, 0xa9, 0x8e, 0xa8, 0x02, 0xe9, 0x9c, 0xb4, 0xf1, 0xa4, 0x28,
-	0xa6, 0x73, 0xaf, 0xa5, 0x11, 0xdb, 0x2b, 0x04, 0x42, 0x85, 0x38, 0x92, 0x99, 0x88, 0x9e, 0x94,
-	0xf1, 0x9f, 0x7f, 0xf5, 0x06, 0x69, 0xa6, 0x5e, 0x9f, 0xc4, 0x41, 0x22, 0x73, 0xdb, 0xb4, 0xfd,
-	0xed, 0x23, 0x9f, 0x84, 0x6a, 0x5e, 0x00, 0xea, 0x00, 0xfc, 0x78, 0x79, 0xb6, 0xd7, 0x9d, 0x42,
-	0xca, 0x92, 0xf9, 0xb8, 0x1c, 0x2b, 0xda, 0xfa, 0x4d, 0x42, 0xfa, 0x82, 0xdc, 0xe4, 0x20, 0x64,
-	0x3e, 0xce, 0x41, 0x31, 0xce, 0x14, 0xf3, 0xae, 0xeb, 0x12, 0xee, 0x35, 0x76, 0xf1, 0xcc, 0x9a,
-	0xea, 0x6d, 0xdc, 0xd0, 0xf1, 0x57, 0x0a, 0x7d, 0x4e, 0xba, 0x08, 0x82, 0x8f, 0x41, 0xb0, 0x78,
-	0x0a, 0xdc, 0x5b, 0xd3, 0xb8, 0x7e, 0x23, 0x6e, 0x04, 0x82, 0x3f, 0x36, 0xbe, 0x3a, 0xb1, 0x83,
-	0xab, 0xfb, 0xdd, 0x6f, 0x2e, 0x59, 0xb7, 0xc3, 0xa3, 0x43, 0xb2, 0xce, 0x38, 0x9f, 0x01, 0x9a,
-	0xd7, 0xda, 0x8c, 0xbc, 0xef, 0x5f, 0xf7, 0xb7, 0x2c, 0xf9, 0x91, 0x51, 0x46, 0x6a, 0x96, 0x89,
-	0xf4, 0xf8, 0xca, 0x48, 0xdf, 0x92, 0x35, 0xdd, 0xb6, 0x7d, 0x9d, 0xff, 0x30, 0x5a, 0x93, 0xef,
-	0xc1, 0xc6, 0xfb, 0xd3, 0x9e, 0xf3, 0xe7, 0xb4, 0xe7, 0x44, 0x87, 0xe7, 0x0b, 0xdf, 0xbd, 0x58,
-	0xf8, 0xee, 0xef, 0x85, 0xef, 0x7e, 0x58, 0xfa, 0xce, 0xc5, 0xd2, 0x77, 0x7e, 0x2c, 0x7d, 0xe7,
-	0x95, 0x5d, 0x5e, 0xe4, 0x93, 0x20, 0x93, 0xe1, 0x3b, 0xb3, 0xe4, 0x3a, 0x43, 0xdc, 0xd6, 0x0b,
-	0x7b, 0xf8, 0x37, 0x00, 0x00, 0xff, 0xff, 0x9d, 0xa7, 0xab, 0xb1, 0x6e, 0x03, 0x00, 0x00,
+	// 564 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x93, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0xed, 0xb4, 0x49, 0xda, 0x4b, 0xa8, 0xc4, 0x11, 0x24, 0xa7, 0x80, 0x93, 0x66, 0x8a,
+	0x2a, 0xc5, 0xa6, 0xe9, 0xd6, 0x01, 0x89, 0x54, 0xfc, 0xa8, 0x04, 0x14, 0x25, 0x1b, 0x4b, 0x74,
+	0xb6, 0xaf, 0xae, 0x95, 0xf8, 0x2e, 0xca, 0xbb, 0x02, 0xf9, 0x0f, 0x18, 0x99, 0x99, 0x3a, 0x22,
+	0xa6, 0x0e, 0xfd, 0x03, 0x3a, 0x76, 0xac, 0x3a, 0x21, 0x86, 0x82, 0x92, 0xa1, 0xfc, 0x19, 0x28,
+	0xf7, 0xdc, 0xd4, 0x2d, 0x59, 0xbb, 0xe4, 0x87, 0xbf, 0xdf, 0xf7, 0x79, 0xef, 0x7b, 0xcf, 0x47,
+	0xd6, 0x7c, 0x09, 0xb1, 0x04, 0xd7, 0x63, 0xa2, 0xe7, 0x7e, 0xdc, 0xf0, 0xb8, 0x62, 0x1b, 0x6e,
+	0xc8, 0x05, 0x87, 0x08, 0x9c, 0xc1, 0x50, 0x2a, 0x49, 0x1f, 0xa0, 0xc5, 0x99, 0x5a, 0x9c, 0xc4,
+	0xb2, 0x5a, 0x0a, 0x65, 0x28, 0xb5, 0xee, 0x4e, 0x7f, 0xa1, 0x75, 0xd5, 0x9e, 0xd1, 0x80, 0xcf,
+	0x68, 0xbe, 0x8c, 0xc4, 0x7f, 0x7a, 0xaa, 0x9b, 0xe6, 0xa2, 0x5e, 0x46, 0xbd, 0x8b, 0xe0, 0xa4,
+	0x2f, 0x4a, 0xf7, 0x59, 0x1c, 0x09, 0xe9, 0xea, 0x4f, 0x7c, 0x54, 0x3b, 0x59, 0x24, 0xc5, 0x57,
+	0x38, 0x6a, 0x47, 0x31, 0xc5, 0xe9, 0x33, 0x92, 0x1b, 0xb0, 0x21, 0x8b, 0xc1, 0x32, 0xab, 0x66,
+	0xbd, 0xd0, 0x7c, 0xe4, 0xcc, 0x19, 0xdd, 0x79, 0xaf, 0x2d, 0xad, 0xe5, 0xd3, 0x8b, 0x8a, 0xf1,
+	0xfd, 0xf2, 0x68, 0xdd, 0x6c, 0x27, 0x55, 0x74, 0x9b, 0x2c, 0x79, 0xac, 0xcf, 0x84, 0xcf, 0xc1,
+	0xca, 0x54, 0x17, 0xea, 0x85, 0xe6, 0xe3, 0xb9, 0x84, 0x16, 0x9a, 0xd2, 0x88, 0x59, 0x21, 0x1d,
+	0x91, 0x1c, 0x1c, 0x0c, 0x06, 0xfd, 0x91, 0xb5, 0xa0, 0x11, 0xe5, 0x6b, 0x04, 0xf0, 0x19, 0x62,
+	0x5b, 0x46, 0xa2, 0xf5, 0x72, 0x5a, 0xff, 0xe3, 0x77, 0xa5, 0x1e, 0x46, 0x6a, 0xff, 0xc0, 0x73,
+	0x7c, 0x19, 0x27, 0xa1, 0x93, 0xaf, 0x06, 0x04, 0x3d, 0x57, 0x8d, 0x06, 0x1c, 0x74, 0x01, 0x7c,
+	0xbb, 0x3c, 0x5a, 0x2f, 0xf6, 0x79, 0xc8, 0xfc, 0x51, 0x77, 0x7a, 0xac, 0x90, 0xcc, 0x8f, 0x0d,
+	0xe9, 0x2e, 0x59, 0x09, 0xb8, 0x90, 0x71, 0x37, 0xe6, 0x8a, 0x05, 0x4c, 0x31, 0x6b, 0x51, 0x8f,
+	0xf0, 0x64, 0x6e, 0x8a, 0xb7, 0x89, 0x29, 0x1d, 0xe3, 0x9e, 0xae, 0xbf, 0x52, 0xe8, 0x3b, 0x52,
+	0x04, 0x2e, 0x82, 0x2e, 0x17, 0xcc, 0xeb, 0xf3, 0xc0, 0xca, 0x6a, 0x5c, 0x75, 0x2e, 0xae, 0xc3,
+	0x45, 0xf0, 0x02, 0x7d, 0x69, 0x62, 0x01, 0xae, 0x9f, 0xd3, 0x2d, 0x92, 0xed, 0x4b, 0xbf, 0x07,
+	0x56, 0xee, 0xf6, 0xd1, 0xa4, 0x40, 0x6f, 0xa4, 0xdf, 0x4b, 0x13, 0xb0, 0x84, 0x76, 0xc8, 0x0a,
+	0xc6, 0xec, 0xca, 0xbd, 0x3d, 0xe0, 0x0a, 0xac, 0xbc, 0x86, 0xac, 0xcd, 0x9f, 0x46, 0x5b, 0x77,
+	0xb5, 0xf3, 0x46, 0x40, 0x48, 0x09, 0x50, 0x3b, 0x31, 0x49, 0x3e, 0xd9, 0x26, 0x6d, 0x92, 0x3c,
+	0x0b, 0x82, 0x21, 0x07, 0x7c, 0x7d, 0x96, 0x5b, 0xd6, 0xf9, 0x71, 0xa3, 0x94, 0xc0, 0x9f, 0xa3,
+	0xd2, 0x51, 0xc3, 0x48, 0x84, 0xed, 0x2b, 0x23, 0xfd, 0x44, 0xb2, 0x7a, 0x0f, 0x56, 0xe6, 0x76,
+	0xa0, 0xbb, 0xda, 0x35, 0xf6, 0xdb, 0x5a, 0xfa, 0x72, 0x58, 0x31, 0xfe, 0x1e, 0x56, 0x8c, 0x9a,
+	0x20, 0xc5, 0x74, 0x58, 0x5a, 0x22, 0x59, 0xbd, 0x44, 0x0c, 0xd1, 0xc6, 0x3f, 0xf4, 0x35, 0xc9,
+	0xe1, 0xb1, 0x59, 0x19, 0x9d, 0xed, 0xe9, 0x74, 0x9c, 0x5f, 0x17, 0x95, 0x87, 0xd8, 0x1c, 0x82,
+	0x9e, 0x13, 0x49, 0x37, 0x66, 0x6a, 0xdf, 0xd9, 0x11, 0xea, 0xfc, 0xb8, 0x41, 0x92, 0x24, 0x3b,
+	0x42, 0x25, 0x2f, 0x19, 0xd6, 0xb7, 0x36, 0x4f, 0xc7, 0xb6, 0x79, 0x36, 0xb6, 0xcd, 0x3f, 0x63,
+	0xdb, 0xfc, 0x3a, 0xb1, 0x8d, 0xb3, 0x89, 0x6d, 0xfc, 0x9c, 0xd8, 0xc6, 0x87, 0xf2, 0x0d, 0xd6,
+	0x67, 0xbc, 0xe5, 0x3a, 0x91, 0x97, 0xd3, 0x37, 0x76, 0xf3, 0xdf, 0x00, 0x22, 0xbd, 0xa1, 0x8c,
+	0x6f, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -214,6 +291,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SupplyOffsets) > 0 {
+		for iNdEx := len(m.SupplyOffsets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SupplyOffsets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Locks) > 0 {
+		for iNdEx := len(m.Locks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.SendEnabled) > 0 {
 		for iNdEx := len(m.SendEnabled) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -327,6 +432,46 @@ func (m *Balance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SupplyOffset) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SupplyOffset) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SupplyOffset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Offset.Size()
+		i -= size
+		if _, err := m.Offset.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -370,6 +515,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Locks) > 0 {
+		for _, e := range m.Locks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SupplyOffsets) > 0 {
+		for _, e := range m.SupplyOffsets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -392,6 +549,21 @@ func (m *Balance) Size() (n int) {
 	return n
 }
 
+func (m *SupplyOffset) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Offset.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -596,6 +768,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locks = append(m.Locks, Lock{})
+			if err := m.Locks[len(m.Locks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyOffsets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SupplyOffsets = append(m.SupplyOffsets, SupplyOffset{})
+			if err := m.SupplyOffsets[len(m.SupplyOffsets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -733,6 +973,122 @@ func (m *Balance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SupplyOffset) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SupplyOffset: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SupplyOffset: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Offset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
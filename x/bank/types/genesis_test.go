@@ -136,6 +136,93 @@ func TestGenesisStateValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"valid locks",
+			GenesisState{
+				Balances: []Balance{
+					{
+						Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t",
+						Coins:   sdk.Coins{sdk.NewInt64Coin("uatom", 2)},
+					},
+				},
+				Locks: []Lock{
+					NewLock("cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", "lock1", sdk.Coins{sdk.NewInt64Coin("uatom", 1)}),
+					NewLock("cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", "lock2", sdk.Coins{sdk.NewInt64Coin("uatom", 1)}),
+				},
+			},
+			false,
+		},
+		{
+			"dup locks",
+			GenesisState{
+				Balances: []Balance{
+					{
+						Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t",
+						Coins:   sdk.Coins{sdk.NewInt64Coin("uatom", 2)},
+					},
+				},
+				Locks: []Lock{
+					NewLock("cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", "lock1", sdk.Coins{sdk.NewInt64Coin("uatom", 1)}),
+					NewLock("cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", "lock1", sdk.Coins{sdk.NewInt64Coin("uatom", 1)}),
+				},
+			},
+			true,
+		},
+		{
+			"invalid lock",
+			GenesisState{
+				Balances: []Balance{
+					{
+						Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t",
+						Coins:   sdk.Coins{sdk.NewInt64Coin("uatom", 2)},
+					},
+				},
+				Locks: []Lock{
+					NewLock("cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", "", sdk.Coins{sdk.NewInt64Coin("uatom", 1)}),
+				},
+			},
+			true,
+		},
+		{
+			"locks exceed balance",
+			GenesisState{
+				Balances: []Balance{
+					{
+						Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t",
+						Coins:   sdk.Coins{sdk.NewInt64Coin("uatom", 1)},
+					},
+				},
+				Locks: []Lock{
+					NewLock("cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", "lock1", sdk.Coins{sdk.NewInt64Coin("uatom", 1)}),
+					NewLock("cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", "lock2", sdk.Coins{sdk.NewInt64Coin("uatom", 1)}),
+				},
+			},
+			true,
+		},
+		{
+			"valid supply offsets",
+			GenesisState{
+				SupplyOffsets: []SupplyOffset{{Denom: "uatom", Offset: math.NewInt(-1)}},
+			},
+			false,
+		},
+		{
+			"dup supply offsets",
+			GenesisState{
+				SupplyOffsets: []SupplyOffset{
+					{Denom: "uatom", Offset: math.NewInt(-1)},
+					{Denom: "uatom", Offset: math.NewInt(1)},
+				},
+			},
+			true,
+		},
+		{
+			"zero supply offset",
+			GenesisState{
+				SupplyOffsets: []SupplyOffset{{Denom: "uatom", Offset: math.ZeroInt()}},
+			},
+			true,
+		},
 		{
 			"invalid supply",
 			GenesisState{
@@ -39,6 +39,16 @@ var (
 
 	// ParamsKey is the prefix for x/bank parameters
 	ParamsKey = collections.NewPrefix(5)
+
+	// LocksPrefix is the prefix for the coins locked in account balances.
+	LocksPrefix = collections.NewPrefix(6)
+
+	// SupplyOffsetPrefix is the prefix for the offsets applied to the supply of coins.
+	SupplyOffsetPrefix = collections.NewPrefix(7)
+
+	// DeferredBalancesPrefix is the prefix for the pending balances of the
+	// deferred addresses.
+	DeferredBalancesPrefix = collections.NewPrefix(8)
 )
 
 // BalanceValueCodec is a codec for encoding bank balances in a backwards compatible way.
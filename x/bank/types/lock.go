@@ -0,0 +1,33 @@
+package types
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewLock creates a new Lock object.
+func NewLock(address, lockID string, amount sdk.Coins) Lock {
+	return Lock{
+		Address: address,
+		LockId:  lockID,
+		Amount:  amount,
+	}
+}
+
+// Validate checks for address, lock id and amount correctness.
+func (l Lock) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(l.Address); err != nil {
+		return err
+	}
+
+	if l.LockId == "" {
+		return errors.New("lock id cannot be empty")
+	}
+
+	if l.Amount.Empty() {
+		return errors.New("locked amount cannot be empty")
+	}
+
+	return l.Amount.Validate()
+}
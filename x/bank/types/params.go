@@ -3,6 +3,7 @@ package types
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -31,11 +32,32 @@ func (p Params) Validate() error {
 	if len(p.SendEnabled) > 0 {
 		return errors.New("use of send_enabled in params is no longer supported")
 	}
+	if err := p.MinimumBalances.Validate(); err != nil {
+		return fmt.Errorf("invalid minimum balances: %w", err)
+	}
+	// the dust collector address is validated with the address codec by SetParams
 	return validateIsBool(p.DefaultSendEnabled)
 }
 
+// SendEnabledWildcard is the suffix of a SendEnabled entry applying to all the
+// denoms of a namespace, e.g. "ibc/*" applies to every IBC denom.
+const SendEnabledWildcard = "/*"
+
+// IsSendEnabledWildcard returns true if denom is a wildcard SendEnabled entry.
+func IsSendEnabledWildcard(denom string) bool {
+	return strings.HasSuffix(denom, SendEnabledWildcard)
+}
+
 // Validate gets any errors with this SendEnabled entry.
 func (se SendEnabled) Validate() error {
+	if IsSendEnabledWildcard(se.Denom) {
+		prefix := strings.TrimSuffix(se.Denom, SendEnabledWildcard)
+		if strings.Contains(prefix, "*") {
+			return fmt.Errorf("invalid send enabled wildcard: %s", se.Denom)
+		}
+		return sdk.ValidateDenom(prefix)
+	}
+
 	return sdk.ValidateDenom(se.Denom)
 }
 
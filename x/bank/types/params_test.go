@@ -38,22 +38,22 @@ func Test_ParamsString(t *testing.T) {
 	}{
 		{
 			name:     "default true empty send enabled",
-			params:   Params{[]*SendEnabled{}, true},
+			params:   Params{SendEnabled: []*SendEnabled{}, DefaultSendEnabled: true},
 			expected: "default_send_enabled:true ",
 		},
 		{
 			name:     "default false empty send enabled",
-			params:   Params{[]*SendEnabled{}, false},
+			params:   Params{SendEnabled: []*SendEnabled{}, DefaultSendEnabled: false},
 			expected: "",
 		},
 		{
 			name:     "default true one true send enabled",
-			params:   Params{[]*SendEnabled{{"foocoin", true}}, true},
+			params:   Params{SendEnabled: []*SendEnabled{{"foocoin", true}}, DefaultSendEnabled: true},
 			expected: "send_enabled:<denom:\"foocoin\" enabled:true > default_send_enabled:true ",
 		},
 		{
 			name:     "default true one false send enabled",
-			params:   Params{[]*SendEnabled{{"barcoin", false}}, true},
+			params:   Params{SendEnabled: []*SendEnabled{{"barcoin", false}}, DefaultSendEnabled: true},
 			expected: "send_enabled:<denom:\"barcoin\" > default_send_enabled:true ",
 		},
 	}
@@ -69,5 +69,21 @@ func Test_validateParams(t *testing.T) {
 	assert.NoError(t, DefaultParams().Validate(), "default")
 	assert.NoError(t, NewParams(true).Validate(), "true")
 	assert.NoError(t, NewParams(false).Validate(), "false")
-	assert.Error(t, Params{[]*SendEnabled{{"foocoing", false}}, true}.Validate(), "with SendEnabled entry")
+	assert.Error(t, Params{SendEnabled: []*SendEnabled{{"foocoing", false}}, DefaultSendEnabled: true}.Validate(), "with SendEnabled entry")
+
+	minimumBalances := sdk.NewCoins(sdk.NewInt64Coin("foocoin", 10))
+	assert.NoError(t, Params{MinimumBalances: minimumBalances}.Validate(), "minimum balances without dust collector")
+	assert.NoError(t, Params{MinimumBalances: minimumBalances, DustCollector: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t"}.Validate(), "minimum balances with dust collector")
+	assert.Error(t, Params{MinimumBalances: sdk.Coins{sdk.NewInt64Coin("foocoin", 0)}}.Validate(), "zero minimum balance")
+	assert.Error(t, Params{MinimumBalances: sdk.Coins{sdk.NewInt64Coin("foocoin", 1), sdk.NewInt64Coin("barcoin", 1)}}.Validate(), "unsorted minimum balances")
+}
+
+func Test_validateSendEnabled(t *testing.T) {
+	assert.NoError(t, NewSendEnabled("foocoin", true).Validate(), "denom")
+	assert.NoError(t, NewSendEnabled("ibc/*", true).Validate(), "wildcard")
+	assert.NoError(t, NewSendEnabled("factory/cosmos1abc/*", true).Validate(), "nested wildcard")
+	assert.Error(t, NewSendEnabled("", true).Validate(), "empty")
+	assert.Error(t, NewSendEnabled("ibc*", true).Validate(), "wildcard without namespace")
+	assert.Error(t, NewSendEnabled("*/*", true).Validate(), "wildcard namespace")
+	assert.Error(t, NewSendEnabled("/*", true).Validate(), "empty namespace")
 }
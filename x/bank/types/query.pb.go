@@ -794,6 +794,127 @@ func (m *QueryDenomsMetadataResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryDenomsMetadataByQueryRequest is the request type for the
+// Query/DenomsMetadataByQuery RPC method.
+type QueryDenomsMetadataByQueryRequest struct {
+	// symbol filters the metadata by symbol, it is ignored if empty.
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// display filters the metadata by display denomination, it is ignored if empty.
+	Display string `protobuf:"bytes,2,opt,name=display,proto3" json:"display,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDenomsMetadataByQueryRequest) Reset()         { *m = QueryDenomsMetadataByQueryRequest{} }
+func (m *QueryDenomsMetadataByQueryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomsMetadataByQueryRequest) ProtoMessage()    {}
+func (*QueryDenomsMetadataByQueryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{16}
+}
+func (m *QueryDenomsMetadataByQueryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomsMetadataByQueryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomsMetadataByQueryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomsMetadataByQueryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomsMetadataByQueryRequest.Merge(m, src)
+}
+func (m *QueryDenomsMetadataByQueryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomsMetadataByQueryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomsMetadataByQueryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomsMetadataByQueryRequest proto.InternalMessageInfo
+
+func (m *QueryDenomsMetadataByQueryRequest) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *QueryDenomsMetadataByQueryRequest) GetDisplay() string {
+	if m != nil {
+		return m.Display
+	}
+	return ""
+}
+
+func (m *QueryDenomsMetadataByQueryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDenomsMetadataByQueryResponse is the response type for the
+// Query/DenomsMetadataByQuery RPC method.
+type QueryDenomsMetadataByQueryResponse struct {
+	// metadatas provides the client information of the matching tokens.
+	Metadatas []Metadata `protobuf:"bytes,1,rep,name=metadatas,proto3" json:"metadatas"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDenomsMetadataByQueryResponse) Reset()         { *m = QueryDenomsMetadataByQueryResponse{} }
+func (m *QueryDenomsMetadataByQueryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomsMetadataByQueryResponse) ProtoMessage()    {}
+func (*QueryDenomsMetadataByQueryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{17}
+}
+func (m *QueryDenomsMetadataByQueryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomsMetadataByQueryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomsMetadataByQueryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomsMetadataByQueryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomsMetadataByQueryResponse.Merge(m, src)
+}
+func (m *QueryDenomsMetadataByQueryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomsMetadataByQueryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomsMetadataByQueryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomsMetadataByQueryResponse proto.InternalMessageInfo
+
+func (m *QueryDenomsMetadataByQueryResponse) GetMetadatas() []Metadata {
+	if m != nil {
+		return m.Metadatas
+	}
+	return nil
+}
+
+func (m *QueryDenomsMetadataByQueryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryDenomMetadataRequest is the request type for the Query/DenomMetadata RPC method.
 type QueryDenomMetadataRequest struct {
 	// denom is the coin denom to query the metadata for.
@@ -804,7 +925,7 @@ func (m *QueryDenomMetadataRequest) Reset()         { *m = QueryDenomMetadataReq
 func (m *QueryDenomMetadataRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDenomMetadataRequest) ProtoMessage()    {}
 func (*QueryDenomMetadataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c6fc1939682df13, []int{16}
+	return fileDescriptor_9c6fc1939682df13, []int{18}
 }
 func (m *QueryDenomMetadataRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -851,7 +972,7 @@ func (m *QueryDenomMetadataResponse) Reset()         { *m = QueryDenomMetadataRe
 func (m *QueryDenomMetadataResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDenomMetadataResponse) ProtoMessage()    {}
 func (*QueryDenomMetadataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c6fc1939682df13, []int{17}
+	return fileDescriptor_9c6fc1939682df13, []int{19}
 }
 func (m *QueryDenomMetadataResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -900,7 +1021,7 @@ func (m *QueryDenomMetadataByQueryStringRequest) Reset() {
 func (m *QueryDenomMetadataByQueryStringRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDenomMetadataByQueryStringRequest) ProtoMessage()    {}
 func (*QueryDenomMetadataByQueryStringRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c6fc1939682df13, []int{18}
+	return fileDescriptor_9c6fc1939682df13, []int{20}
 }
 func (m *QueryDenomMetadataByQueryStringRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -949,7 +1070,7 @@ func (m *QueryDenomMetadataByQueryStringResponse) Reset() {
 func (m *QueryDenomMetadataByQueryStringResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDenomMetadataByQueryStringResponse) ProtoMessage()    {}
 func (*QueryDenomMetadataByQueryStringResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c6fc1939682df13, []int{19}
+	return fileDescriptor_9c6fc1939682df13, []int{21}
 }
 func (m *QueryDenomMetadataByQueryStringResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -999,7 +1120,7 @@ func (m *QueryDenomOwnersRequest) Reset()         { *m = QueryDenomOwnersRequest
 func (m *QueryDenomOwnersRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDenomOwnersRequest) ProtoMessage()    {}
 func (*QueryDenomOwnersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c6fc1939682df13, []int{20}
+	return fileDescriptor_9c6fc1939682df13, []int{22}
 }
 func (m *QueryDenomOwnersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1058,7 +1179,7 @@ func (m *DenomOwner) Reset()         { *m = DenomOwner{} }
 func (m *DenomOwner) String() string { return proto.CompactTextString(m) }
 func (*DenomOwner) ProtoMessage()    {}
 func (*DenomOwner) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c6fc1939682df13, []int{21}
+	return fileDescriptor_9c6fc1939682df13, []int{23}
 }
 func (m *DenomOwner) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1114,7 +1235,7 @@ func (m *QueryDenomOwnersResponse) Reset()         { *m = QueryDenomOwnersRespon
 func (m *QueryDenomOwnersResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDenomOwnersResponse) ProtoMessage()    {}
 func (*QueryDenomOwnersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c6fc1939682df13, []int{22}
+	return fileDescriptor_9c6fc1939682df13, []int{24}
 }
 func (m *QueryDenomOwnersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1173,7 +1294,7 @@ func (m *QueryDenomOwnersByQueryRequest) Reset()         { *m = QueryDenomOwners
 func (m *QueryDenomOwnersByQueryRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDenomOwnersByQueryRequest) ProtoMessage()    {}
 func (*QueryDenomOwnersByQueryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c6fc1939682df13, []int{23}
+	return fileDescriptor_9c6fc1939682df13, []int{25}
 }
 func (m *QueryDenomOwnersByQueryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1229,7 +1350,7 @@ func (m *QueryDenomOwnersByQueryResponse) Reset()         { *m = QueryDenomOwner
 func (m *QueryDenomOwnersByQueryResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDenomOwnersByQueryResponse) ProtoMessage()    {}
 func (*QueryDenomOwnersByQueryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c6fc1939682df13, []int{24}
+	return fileDescriptor_9c6fc1939682df13, []int{26}
 }
 func (m *QueryDenomOwnersByQueryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1287,7 +1408,7 @@ func (m *QuerySendEnabledRequest) Reset()         { *m = QuerySendEnabledRequest
 func (m *QuerySendEnabledRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySendEnabledRequest) ProtoMessage()    {}
 func (*QuerySendEnabledRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c6fc1939682df13, []int{25}
+	return fileDescriptor_9c6fc1939682df13, []int{27}
 }
 func (m *QuerySendEnabledRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1344,7 +1465,7 @@ func (m *QuerySendEnabledResponse) Reset()         { *m = QuerySendEnabledRespon
 func (m *QuerySendEnabledResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySendEnabledResponse) ProtoMessage()    {}
 func (*QuerySendEnabledResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c6fc1939682df13, []int{26}
+	return fileDescriptor_9c6fc1939682df13, []int{28}
 }
 func (m *QuerySendEnabledResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1387,6 +1508,237 @@ func (m *QuerySendEnabledResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryLocksRequest defines the RPC request for looking up the locks of an account.
+type QueryLocksRequest struct {
+	// address is the address to query locks for.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryLocksRequest) Reset()         { *m = QueryLocksRequest{} }
+func (m *QueryLocksRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLocksRequest) ProtoMessage()    {}
+func (*QueryLocksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{29}
+}
+func (m *QueryLocksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLocksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLocksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLocksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLocksRequest.Merge(m, src)
+}
+func (m *QueryLocksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLocksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLocksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLocksRequest proto.InternalMessageInfo
+
+func (m *QueryLocksRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryLocksRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryLocksResponse defines the RPC response of a Locks query.
+type QueryLocksResponse struct {
+	Locks []Lock `protobuf:"bytes,1,rep,name=locks,proto3" json:"locks"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryLocksResponse) Reset()         { *m = QueryLocksResponse{} }
+func (m *QueryLocksResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLocksResponse) ProtoMessage()    {}
+func (*QueryLocksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{30}
+}
+func (m *QueryLocksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLocksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLocksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLocksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLocksResponse.Merge(m, src)
+}
+func (m *QueryLocksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLocksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLocksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLocksResponse proto.InternalMessageInfo
+
+func (m *QueryLocksResponse) GetLocks() []Lock {
+	if m != nil {
+		return m.Locks
+	}
+	return nil
+}
+
+func (m *QueryLocksResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAllBalancesStreamRequest defines the RPC request of an AllBalancesStream query.
+type QueryAllBalancesStreamRequest struct {
+	// address is the address to stream the balances of. Leave empty to stream the
+	// balances of all accounts.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// cursor is the cursor of the last balance received, to resume the stream
+	// after it. Leave empty to start from the first balance.
+	Cursor []byte `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (m *QueryAllBalancesStreamRequest) Reset()         { *m = QueryAllBalancesStreamRequest{} }
+func (m *QueryAllBalancesStreamRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllBalancesStreamRequest) ProtoMessage()    {}
+func (*QueryAllBalancesStreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{31}
+}
+func (m *QueryAllBalancesStreamRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllBalancesStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllBalancesStreamRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllBalancesStreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllBalancesStreamRequest.Merge(m, src)
+}
+func (m *QueryAllBalancesStreamRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllBalancesStreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllBalancesStreamRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllBalancesStreamRequest proto.InternalMessageInfo
+
+func (m *QueryAllBalancesStreamRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryAllBalancesStreamRequest) GetCursor() []byte {
+	if m != nil {
+		return m.Cursor
+	}
+	return nil
+}
+
+// QueryAllBalancesStreamResponse defines a single balance streamed by an
+// AllBalancesStream query.
+type QueryAllBalancesStreamResponse struct {
+	// address is the address holding the balance.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// balance is the balance of a single denom.
+	Balance types.Coin `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance"`
+	// cursor resumes the stream after this balance.
+	Cursor []byte `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (m *QueryAllBalancesStreamResponse) Reset()         { *m = QueryAllBalancesStreamResponse{} }
+func (m *QueryAllBalancesStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllBalancesStreamResponse) ProtoMessage()    {}
+func (*QueryAllBalancesStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{32}
+}
+func (m *QueryAllBalancesStreamResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllBalancesStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllBalancesStreamResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllBalancesStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllBalancesStreamResponse.Merge(m, src)
+}
+func (m *QueryAllBalancesStreamResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllBalancesStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllBalancesStreamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllBalancesStreamResponse proto.InternalMessageInfo
+
+func (m *QueryAllBalancesStreamResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryAllBalancesStreamResponse) GetBalance() types.Coin {
+	if m != nil {
+		return m.Balance
+	}
+	return types.Coin{}
+}
+
+func (m *QueryAllBalancesStreamResponse) GetCursor() []byte {
+	if m != nil {
+		return m.Cursor
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryBalanceRequest)(nil), "cosmos.bank.v1beta1.QueryBalanceRequest")
 	proto.RegisterType((*QueryBalanceResponse)(nil), "cosmos.bank.v1beta1.QueryBalanceResponse")
@@ -1404,6 +1756,8 @@ func init() {
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.bank.v1beta1.QueryParamsResponse")
 	proto.RegisterType((*QueryDenomsMetadataRequest)(nil), "cosmos.bank.v1beta1.QueryDenomsMetadataRequest")
 	proto.RegisterType((*QueryDenomsMetadataResponse)(nil), "cosmos.bank.v1beta1.QueryDenomsMetadataResponse")
+	proto.RegisterType((*QueryDenomsMetadataByQueryRequest)(nil), "cosmos.bank.v1beta1.QueryDenomsMetadataByQueryRequest")
+	proto.RegisterType((*QueryDenomsMetadataByQueryResponse)(nil), "cosmos.bank.v1beta1.QueryDenomsMetadataByQueryResponse")
 	proto.RegisterType((*QueryDenomMetadataRequest)(nil), "cosmos.bank.v1beta1.QueryDenomMetadataRequest")
 	proto.RegisterType((*QueryDenomMetadataResponse)(nil), "cosmos.bank.v1beta1.QueryDenomMetadataResponse")
 	proto.RegisterType((*QueryDenomMetadataByQueryStringRequest)(nil), "cosmos.bank.v1beta1.QueryDenomMetadataByQueryStringRequest")
@@ -1415,96 +1769,113 @@ func init() {
 	proto.RegisterType((*QueryDenomOwnersByQueryResponse)(nil), "cosmos.bank.v1beta1.QueryDenomOwnersByQueryResponse")
 	proto.RegisterType((*QuerySendEnabledRequest)(nil), "cosmos.bank.v1beta1.QuerySendEnabledRequest")
 	proto.RegisterType((*QuerySendEnabledResponse)(nil), "cosmos.bank.v1beta1.QuerySendEnabledResponse")
+	proto.RegisterType((*QueryLocksRequest)(nil), "cosmos.bank.v1beta1.QueryLocksRequest")
+	proto.RegisterType((*QueryLocksResponse)(nil), "cosmos.bank.v1beta1.QueryLocksResponse")
+	proto.RegisterType((*QueryAllBalancesStreamRequest)(nil), "cosmos.bank.v1beta1.QueryAllBalancesStreamRequest")
+	proto.RegisterType((*QueryAllBalancesStreamResponse)(nil), "cosmos.bank.v1beta1.QueryAllBalancesStreamResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/query.proto", fileDescriptor_9c6fc1939682df13) }
 
 var fileDescriptor_9c6fc1939682df13 = []byte{
-	// 1341 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xc1, 0x6f, 0x1b, 0xc5,
-	0x17, 0xf6, 0xb4, 0xaa, 0x9b, 0x3c, 0xa7, 0x3f, 0x29, 0xd3, 0xfc, 0x68, 0xb2, 0x21, 0x76, 0xd8,
-	0x54, 0x89, 0x13, 0x92, 0xdd, 0xc6, 0x8e, 0x0a, 0x2d, 0x21, 0x52, 0xdc, 0x92, 0x1e, 0x10, 0x6a,
-	0x71, 0xe8, 0x05, 0x0e, 0xd6, 0xda, 0xbb, 0x18, 0x2b, 0xf6, 0xae, 0xeb, 0xd9, 0xb4, 0x58, 0x55,
-	0x10, 0x42, 0x42, 0xea, 0x11, 0x89, 0x9e, 0x2a, 0x21, 0x45, 0x48, 0x40, 0x05, 0x52, 0xd5, 0x03,
-	0x07, 0x0e, 0x1c, 0x39, 0x54, 0x9c, 0x2a, 0x38, 0x80, 0x38, 0x14, 0x94, 0x20, 0xb5, 0x7f, 0x06,
-	0xf2, 0xcc, 0x5b, 0xef, 0xae, 0xbd, 0x5e, 0x6f, 0x52, 0x53, 0x55, 0x5c, 0x12, 0x7b, 0xe6, 0xbd,
-	0x79, 0xdf, 0xfb, 0xe6, 0xcd, 0x9b, 0x6f, 0x0c, 0xa9, 0x92, 0xc5, 0x6a, 0x16, 0x53, 0x8b, 0x9a,
-	0xb9, 0xa5, 0x5e, 0x5f, 0x2e, 0x1a, 0xb6, 0xb6, 0xac, 0x5e, 0xdb, 0x36, 0x1a, 0x4d, 0xa5, 0xde,
-	0xb0, 0x6c, 0x8b, 0x9e, 0x14, 0x06, 0x4a, 0xcb, 0x40, 0x41, 0x03, 0x69, 0xa1, 0xed, 0xc5, 0x0c,
-	0x61, 0xdd, 0xf6, 0xad, 0x6b, 0xe5, 0x8a, 0xa9, 0xd9, 0x15, 0xcb, 0x14, 0x0b, 0x48, 0x63, 0x65,
-	0xab, 0x6c, 0xf1, 0x8f, 0x6a, 0xeb, 0x13, 0x8e, 0xbe, 0x58, 0xb6, 0xac, 0x72, 0xd5, 0x50, 0xb5,
-	0x7a, 0x45, 0xd5, 0x4c, 0xd3, 0xb2, 0xb9, 0x0b, 0xc3, 0xd9, 0xa4, 0x77, 0x7d, 0x67, 0xe5, 0x92,
-	0x55, 0x31, 0xbb, 0xe6, 0x3d, 0xa8, 0x39, 0x42, 0x31, 0x3f, 0x21, 0xe6, 0x0b, 0x22, 0x2c, 0x66,
-	0x20, 0xa6, 0x26, 0xd1, 0xd5, 0x41, 0xed, 0x4d, 0x56, 0x1a, 0xd5, 0x6a, 0x15, 0xd3, 0x52, 0xf9,
-	0x5f, 0x31, 0x24, 0x57, 0xe0, 0xe4, 0xdb, 0x2d, 0x8b, 0x9c, 0x56, 0xd5, 0xcc, 0x92, 0x91, 0x37,
-	0xae, 0x6d, 0x1b, 0xcc, 0xa6, 0x19, 0x38, 0xae, 0xe9, 0x7a, 0xc3, 0x60, 0x6c, 0x9c, 0x4c, 0x93,
-	0xf4, 0x70, 0x6e, 0xfc, 0x97, 0xef, 0x97, 0xc6, 0x30, 0xd2, 0xba, 0x98, 0xd9, 0xb4, 0x1b, 0x15,
-	0xb3, 0x9c, 0x77, 0x0c, 0xe9, 0x18, 0x1c, 0xd3, 0x0d, 0xd3, 0xaa, 0x8d, 0x1f, 0x69, 0x79, 0xe4,
-	0xc5, 0x97, 0xf3, 0x43, 0xb7, 0x76, 0x53, 0xb1, 0x27, 0xbb, 0xa9, 0x98, 0xfc, 0x26, 0x8c, 0xf9,
-	0x43, 0xb1, 0xba, 0x65, 0x32, 0x83, 0x66, 0xe1, 0x78, 0x51, 0x0c, 0xf1, 0x58, 0x89, 0xcc, 0x84,
-	0xd2, 0xde, 0x14, 0x66, 0x38, 0x9b, 0xa2, 0x5c, 0xb0, 0x2a, 0x66, 0xde, 0xb1, 0x94, 0x7f, 0x22,
-	0x70, 0x8a, 0xaf, 0xb6, 0x5e, 0xad, 0xe2, 0x82, 0xec, 0x69, 0xc0, 0x6f, 0x00, 0xb8, 0x5b, 0xcb,
-	0x33, 0x48, 0x64, 0x66, 0x7d, 0x38, 0x04, 0x91, 0x0e, 0x9a, 0x2b, 0x5a, 0xd9, 0x21, 0x2b, 0xef,
-	0xf1, 0xa4, 0x33, 0x70, 0xa2, 0x61, 0x30, 0xab, 0x7a, 0xdd, 0x28, 0x08, 0x32, 0x8e, 0x4e, 0x93,
-	0xf4, 0x50, 0x7e, 0x04, 0x07, 0x2f, 0x76, 0x70, 0xb2, 0x47, 0x60, 0xbc, 0x3b, 0x0d, 0x24, 0x66,
-	0x07, 0x86, 0x30, 0xdd, 0x56, 0x22, 0x47, 0x43, 0x99, 0xc9, 0x6d, 0x3c, 0x78, 0x94, 0x8a, 0x7d,
-	0xfb, 0x67, 0x2a, 0x5d, 0xae, 0xd8, 0x1f, 0x6c, 0x17, 0x95, 0x92, 0x55, 0xc3, 0xca, 0xc0, 0x7f,
-	0x4b, 0x4c, 0xdf, 0x52, 0xed, 0x66, 0xdd, 0x60, 0xdc, 0x81, 0xdd, 0x79, 0x7c, 0x7f, 0x61, 0xa4,
-	0x6a, 0x94, 0xb5, 0x52, 0xb3, 0xd0, 0xaa, 0x3d, 0x76, 0xf7, 0xf1, 0xfd, 0x05, 0x92, 0x6f, 0x87,
-	0xa4, 0x97, 0x02, 0x28, 0x99, 0xeb, 0x4b, 0x89, 0xc0, 0xee, 0xe5, 0x44, 0xfe, 0x8a, 0xc0, 0x14,
-	0x4f, 0x72, 0xb3, 0x6e, 0x98, 0xba, 0x56, 0xac, 0x1a, 0xcf, 0xd1, 0x8e, 0x79, 0x36, 0xe3, 0x09,
-	0x81, 0x64, 0x2f, 0x9c, 0xff, 0xb1, 0x2d, 0x69, 0xc2, 0x4c, 0x60, 0xa6, 0xb9, 0x26, 0xaf, 0xd0,
-	0x7f, 0xb3, 0x0d, 0xbc, 0x07, 0xa7, 0xc3, 0x43, 0x3f, 0x4d, 0x5b, 0xd8, 0xc2, 0xae, 0xf0, 0x8e,
-	0x65, 0x6b, 0xd5, 0xcd, 0xed, 0x7a, 0xbd, 0xda, 0x74, 0x72, 0xf1, 0xd7, 0x0b, 0x19, 0x40, 0xbd,
-	0x3c, 0x72, 0x0e, 0xaf, 0x2f, 0x1a, 0xc2, 0x6f, 0x42, 0x9c, 0xf1, 0x91, 0x67, 0x57, 0x27, 0x18,
-	0x70, 0x70, 0x55, 0xb2, 0x88, 0x1d, 0x5b, 0xa4, 0x76, 0xf9, 0x7d, 0x87, 0xca, 0xf6, 0x16, 0x13,
-	0xcf, 0x16, 0xcb, 0x57, 0xe1, 0xff, 0x1d, 0xd6, 0x48, 0xc5, 0x2a, 0xc4, 0xb5, 0x9a, 0xb5, 0x6d,
-	0xda, 0x7d, 0x37, 0x32, 0x37, 0xdc, 0xa2, 0x02, 0xb3, 0x11, 0x3e, 0xf2, 0x18, 0x50, 0xbe, 0xec,
-	0x15, 0xad, 0xa1, 0xd5, 0x9c, 0x8e, 0x21, 0x5f, 0xc5, 0x7b, 0xcb, 0x19, 0xc5, 0x50, 0x6b, 0x10,
-	0xaf, 0xf3, 0x11, 0x0c, 0x35, 0xa9, 0x04, 0xdc, 0xef, 0x8a, 0x70, 0xf2, 0x05, 0x13, 0x5e, 0xb2,
-	0x0e, 0x12, 0x5f, 0x96, 0x97, 0x22, 0x7b, 0xcb, 0xb0, 0x35, 0x5d, 0xb3, 0xb5, 0x01, 0x97, 0x90,
-	0x7c, 0x8f, 0xc0, 0x64, 0x60, 0x18, 0xcc, 0x62, 0x03, 0x86, 0x6b, 0x38, 0xe6, 0xb4, 0x99, 0xa9,
-	0xc0, 0x44, 0x1c, 0x4f, 0x6f, 0x2a, 0xae, 0xeb, 0xe0, 0x0a, 0x61, 0x19, 0x26, 0x5c, 0xbc, 0x9d,
-	0xac, 0x04, 0x57, 0x43, 0xd1, 0xcb, 0x64, 0x57, 0x86, 0x17, 0x61, 0xc8, 0x81, 0x89, 0x3c, 0x46,
-	0x4f, 0xb0, 0xed, 0x29, 0xaf, 0xc1, 0x6c, 0x77, 0x8c, 0x5c, 0x53, 0x54, 0xa1, 0x68, 0x4b, 0xa1,
-	0x18, 0x2d, 0x98, 0xeb, 0xeb, 0x3f, 0x50, 0xc0, 0x37, 0xb0, 0x3d, 0xf1, 0x80, 0x97, 0x6f, 0x98,
-	0x46, 0x83, 0x85, 0x22, 0x1c, 0xd4, 0x25, 0x27, 0x7f, 0x4c, 0x00, 0xdc, 0xa0, 0x87, 0xea, 0xeb,
-	0x6b, 0x6e, 0x3f, 0x3e, 0x72, 0x80, 0x63, 0xdc, 0x6e, 0xcd, 0xdf, 0x38, 0xdd, 0xd2, 0x97, 0x3c,
-	0xd2, 0x9b, 0x83, 0x11, 0x9e, 0x70, 0xc1, 0xe2, 0xe3, 0x58, 0xf4, 0xa9, 0x40, 0x8a, 0x5d, 0xff,
-	0x7c, 0x42, 0x77, 0xd7, 0x1a, 0x5c, 0xb5, 0x7f, 0x84, 0x32, 0xc0, 0x03, 0x14, 0x8b, 0xe2, 0xd9,
-	0x6c, 0xd6, 0x3d, 0x02, 0xa9, 0x9e, 0x00, 0x9e, 0x47, 0xc2, 0x9a, 0x58, 0xd6, 0x9b, 0x86, 0xa9,
-	0xbf, 0x61, 0xb6, 0xee, 0x74, 0xdd, 0x61, 0xea, 0x05, 0x88, 0xf3, 0x90, 0x02, 0xe1, 0x70, 0x1e,
-	0xbf, 0x75, 0x70, 0x55, 0x3a, 0x34, 0x57, 0x77, 0x9d, 0xaa, 0xf2, 0xc5, 0x46, 0x92, 0x2e, 0xc0,
-	0x08, 0x33, 0x4c, 0xbd, 0x60, 0x88, 0x71, 0x24, 0x69, 0x3a, 0x90, 0x24, 0xaf, 0x7f, 0x82, 0xb9,
-	0x5f, 0x3a, 0x58, 0x2a, 0x1d, 0x9a, 0xa5, 0xcc, 0xcf, 0xa3, 0x70, 0x8c, 0x43, 0xa5, 0x5f, 0x10,
-	0x38, 0x8e, 0xaa, 0x87, 0xa6, 0x03, 0xd1, 0x04, 0xbc, 0xc9, 0xa4, 0xf9, 0x08, 0x96, 0x22, 0xac,
-	0xfc, 0xfa, 0xad, 0xd6, 0xd9, 0xfb, 0xe4, 0xd7, 0xbf, 0x3f, 0x3f, 0x92, 0xa1, 0x67, 0xd4, 0xe0,
-	0xe7, 0xa4, 0xd0, 0x94, 0xea, 0x4d, 0x3c, 0xe0, 0x3b, 0x6a, 0xb1, 0x29, 0xde, 0x2c, 0x74, 0x97,
-	0x40, 0xc2, 0xf3, 0x20, 0xa1, 0x8b, 0xbd, 0x23, 0x77, 0x3f, 0xbf, 0xa4, 0xa5, 0x88, 0xd6, 0x88,
-	0x75, 0xc5, 0xc5, 0x3a, 0x4f, 0xe7, 0x22, 0x62, 0xa5, 0x3f, 0x12, 0x18, 0xed, 0x92, 0xe9, 0x34,
-	0xd3, 0x3b, 0x74, 0xaf, 0xb7, 0x87, 0x94, 0x3d, 0x90, 0x0f, 0x82, 0x5e, 0x73, 0x41, 0x67, 0xe9,
-	0x72, 0x20, 0x68, 0xe6, 0x38, 0x17, 0x02, 0xe0, 0xff, 0x46, 0xe0, 0x54, 0x0f, 0x01, 0x4c, 0x5f,
-	0x8d, 0x0e, 0xc8, 0x2f, 0xd7, 0xa5, 0x73, 0x87, 0xf0, 0xc4, 0x84, 0x2e, 0xb9, 0x09, 0xad, 0xd2,
-	0xf3, 0x07, 0x4e, 0xc8, 0xad, 0x9d, 0xdb, 0x04, 0x12, 0x1e, 0x3d, 0x1c, 0x56, 0x3b, 0xdd, 0x22,
-	0x3d, 0xac, 0x76, 0x02, 0x44, 0xb6, 0x9c, 0x76, 0x51, 0x4f, 0xd1, 0xc9, 0x60, 0xd4, 0x02, 0xc6,
-	0x6d, 0x02, 0x43, 0x8e, 0x30, 0xa5, 0x21, 0x27, 0xa9, 0x43, 0xea, 0x4a, 0x0b, 0x51, 0x4c, 0x11,
-	0xcd, 0xb2, 0x8b, 0x66, 0x96, 0x9e, 0x0e, 0x41, 0xe3, 0xb2, 0xf5, 0x29, 0x81, 0xb8, 0x50, 0xa3,
-	0x74, 0xae, 0x77, 0x24, 0x9f, 0xf4, 0x95, 0xd2, 0xfd, 0x0d, 0xa3, 0xd3, 0x23, 0x74, 0x2f, 0xfd,
-	0x8e, 0xc0, 0x09, 0x9f, 0x0a, 0xa2, 0x4a, 0xef, 0x28, 0x41, 0x2a, 0x50, 0x52, 0x23, 0xdb, 0x23,
-	0xb8, 0x73, 0x2e, 0x38, 0x85, 0x2e, 0x06, 0x82, 0x13, 0x77, 0x45, 0xc1, 0x91, 0x4f, 0xea, 0x4d,
-	0x3e, 0xb0, 0x43, 0xff, 0x20, 0x20, 0xf5, 0xd6, 0x6c, 0xf4, 0xb5, 0x88, 0x50, 0x82, 0x94, 0xa2,
-	0xb4, 0x7a, 0x38, 0x67, 0x4c, 0x6a, 0xdd, 0x4d, 0xea, 0x2c, 0x5d, 0x89, 0x92, 0x54, 0xa1, 0xd8,
-	0x2c, 0xf0, 0x0b, 0xa4, 0xc0, 0x04, 0xfa, 0xaf, 0x09, 0xfc, 0xcf, 0xff, 0x2e, 0xa0, 0xfd, 0xb8,
-	0xed, 0x7c, 0xa8, 0x48, 0x67, 0xa2, 0x3b, 0x44, 0xaf, 0xdd, 0x0e, 0xe0, 0xf4, 0x4b, 0x02, 0x09,
-	0x8f, 0x42, 0x09, 0x3b, 0xe9, 0xdd, 0x7a, 0x37, 0xec, 0xa4, 0x07, 0x08, 0x44, 0xf9, 0xac, 0x8b,
-	0xef, 0x65, 0x3a, 0xdf, 0x1b, 0x1f, 0xea, 0xa1, 0x76, 0xa9, 0xfc, 0x40, 0x80, 0x76, 0xcb, 0x28,
-	0x9a, 0x8d, 0x14, 0xdd, 0xaf, 0xfa, 0xa4, 0x95, 0x83, 0x39, 0x21, 0xf2, 0x57, 0x5c, 0xe4, 0x8b,
-	0x74, 0xa1, 0x2f, 0xf2, 0x76, 0x3d, 0xd0, 0x3b, 0x04, 0x12, 0x1e, 0x55, 0x12, 0xc6, 0x6f, 0xb7,
-	0xf0, 0x0a, 0xe3, 0x37, 0x40, 0x2a, 0xc9, 0x8a, 0x8b, 0x72, 0x86, 0xbe, 0x14, 0xdc, 0xbb, 0x3c,
-	0x52, 0x2a, 0x97, 0x7d, 0xb0, 0x97, 0x24, 0x0f, 0xf7, 0x92, 0xe4, 0xaf, 0xbd, 0x24, 0xf9, 0x6c,
-	0x3f, 0x19, 0x7b, 0xb8, 0x9f, 0x8c, 0xfd, 0xbe, 0x9f, 0x8c, 0xbd, 0x8b, 0x3f, 0x4e, 0x33, 0x7d,
-	0x4b, 0xa9, 0x58, 0xea, 0x87, 0x62, 0x0d, 0xfe, 0xe3, 0x45, 0x31, 0xce, 0x7f, 0x73, 0xce, 0xfe,
-	0x13, 0x00, 0x00, 0xff, 0xff, 0xcd, 0x9a, 0x88, 0xc1, 0x96, 0x17, 0x00, 0x00,
+	// 1539 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x99, 0xc1, 0x6f, 0x13, 0xc7,
+	0x17, 0xc7, 0x3d, 0x44, 0x71, 0x92, 0xe7, 0xf0, 0x93, 0x32, 0x04, 0x08, 0x9b, 0x5f, 0x6c, 0x58,
+	0x50, 0x12, 0xd2, 0xd8, 0x4b, 0x62, 0x04, 0x25, 0xa5, 0x91, 0x30, 0x14, 0x0e, 0x6d, 0x05, 0x75,
+	0xca, 0xa5, 0x3d, 0x58, 0x6b, 0xef, 0xd6, 0xb5, 0x62, 0xef, 0x1a, 0xcf, 0x06, 0xba, 0x42, 0x54,
+	0xa8, 0x52, 0x25, 0xa4, 0x1e, 0x5a, 0xa9, 0x5c, 0x8a, 0x84, 0x84, 0x90, 0xda, 0xa2, 0xb6, 0x42,
+	0x1c, 0x5a, 0xa9, 0x87, 0x1e, 0x39, 0x70, 0x44, 0xed, 0xa1, 0x55, 0x0f, 0xb4, 0x0a, 0x95, 0xe0,
+	0xcf, 0xa8, 0x3c, 0xf3, 0xd6, 0xbb, 0x6b, 0xaf, 0xed, 0xb5, 0x63, 0xda, 0xa8, 0x17, 0xc8, 0xce,
+	0xce, 0x9b, 0xf7, 0x79, 0xdf, 0x79, 0x3b, 0xfb, 0xde, 0x1a, 0x12, 0x05, 0x93, 0x55, 0x4c, 0xa6,
+	0xe4, 0x55, 0x63, 0x5d, 0xb9, 0xbc, 0x94, 0xd7, 0x2d, 0x75, 0x49, 0xb9, 0xb4, 0xa1, 0xd7, 0xec,
+	0x54, 0xb5, 0x66, 0x5a, 0x26, 0xdd, 0x25, 0x26, 0xa4, 0xea, 0x13, 0x52, 0x38, 0x41, 0x5a, 0x68,
+	0x58, 0x31, 0x5d, 0xcc, 0x6e, 0xd8, 0x56, 0xd5, 0x62, 0xc9, 0x50, 0xad, 0x92, 0x69, 0x88, 0x05,
+	0xa4, 0xc9, 0xa2, 0x59, 0x34, 0xf9, 0x9f, 0x4a, 0xfd, 0x2f, 0x1c, 0xfd, 0x7f, 0xd1, 0x34, 0x8b,
+	0x65, 0x5d, 0x51, 0xab, 0x25, 0x45, 0x35, 0x0c, 0xd3, 0xe2, 0x26, 0x0c, 0xef, 0xc6, 0xbd, 0xeb,
+	0x3b, 0x2b, 0x17, 0xcc, 0x92, 0xd1, 0x72, 0xdf, 0x43, 0xcd, 0x09, 0xc5, 0xfd, 0x7d, 0xe2, 0x7e,
+	0x4e, 0xb8, 0xc5, 0x08, 0xc4, 0xad, 0x69, 0x34, 0x75, 0xa8, 0xbd, 0xc1, 0x4a, 0x13, 0x6a, 0xa5,
+	0x64, 0x98, 0x0a, 0xff, 0x57, 0x0c, 0xc9, 0x25, 0xd8, 0xf5, 0x56, 0x7d, 0x46, 0x46, 0x2d, 0xab,
+	0x46, 0x41, 0xcf, 0xea, 0x97, 0x36, 0x74, 0x66, 0xd1, 0x65, 0x18, 0x51, 0x35, 0xad, 0xa6, 0x33,
+	0x36, 0x45, 0xf6, 0x93, 0xf9, 0xb1, 0xcc, 0xd4, 0xcf, 0xdf, 0x27, 0x27, 0xd1, 0xd3, 0x29, 0x71,
+	0x67, 0xcd, 0xaa, 0x95, 0x8c, 0x62, 0xd6, 0x99, 0x48, 0x27, 0x61, 0x58, 0xd3, 0x0d, 0xb3, 0x32,
+	0xb5, 0xa3, 0x6e, 0x91, 0x15, 0x17, 0x2b, 0xa3, 0x37, 0xee, 0x24, 0x22, 0xcf, 0xef, 0x24, 0x22,
+	0xf2, 0xeb, 0x30, 0xe9, 0x77, 0xc5, 0xaa, 0xa6, 0xc1, 0x74, 0x9a, 0x86, 0x91, 0xbc, 0x18, 0xe2,
+	0xbe, 0x62, 0xcb, 0xfb, 0x52, 0x8d, 0x4d, 0x61, 0xba, 0xb3, 0x29, 0xa9, 0xd3, 0x66, 0xc9, 0xc8,
+	0x3a, 0x33, 0xe5, 0x87, 0x04, 0xf6, 0xf2, 0xd5, 0x4e, 0x95, 0xcb, 0xb8, 0x20, 0xdb, 0x0a, 0xfc,
+	0x59, 0x00, 0x77, 0x6b, 0x79, 0x04, 0xb1, 0xe5, 0x59, 0x1f, 0x87, 0x10, 0xd2, 0xa1, 0xb9, 0xa0,
+	0x16, 0x1d, 0xb1, 0xb2, 0x1e, 0x4b, 0x7a, 0x10, 0x76, 0xd6, 0x74, 0x66, 0x96, 0x2f, 0xeb, 0x39,
+	0x21, 0xc6, 0xd0, 0x7e, 0x32, 0x3f, 0x9a, 0x1d, 0xc7, 0xc1, 0x33, 0x4d, 0x9a, 0x6c, 0x12, 0x98,
+	0x6a, 0x0d, 0x03, 0x85, 0xb9, 0x06, 0xa3, 0x18, 0x6e, 0x3d, 0x90, 0xa1, 0x8e, 0xca, 0x64, 0xce,
+	0x3e, 0x7a, 0x92, 0x88, 0x7c, 0xf3, 0x47, 0x62, 0xbe, 0x58, 0xb2, 0xde, 0xdf, 0xc8, 0xa7, 0x0a,
+	0x66, 0x05, 0x33, 0x03, 0xff, 0x4b, 0x32, 0x6d, 0x5d, 0xb1, 0xec, 0xaa, 0xce, 0xb8, 0x01, 0xbb,
+	0xf5, 0xec, 0xc1, 0xc2, 0x78, 0x59, 0x2f, 0xaa, 0x05, 0x3b, 0x57, 0xcf, 0x3d, 0x76, 0xef, 0xd9,
+	0x83, 0x05, 0x92, 0x6d, 0xb8, 0xa4, 0xe7, 0x02, 0x24, 0x99, 0xeb, 0x2a, 0x89, 0x60, 0xf7, 0x6a,
+	0x22, 0x7f, 0x49, 0x60, 0x86, 0x07, 0xb9, 0x56, 0xd5, 0x0d, 0x4d, 0xcd, 0x97, 0xf5, 0x6d, 0xb4,
+	0x63, 0x9e, 0xcd, 0x78, 0x4e, 0x20, 0xde, 0x8e, 0xf3, 0x3f, 0xb6, 0x25, 0x36, 0x1c, 0x0c, 0x8c,
+	0x34, 0x63, 0xf3, 0x0c, 0x7d, 0x91, 0xc7, 0xc0, 0xbb, 0x70, 0xa8, 0xb3, 0xeb, 0xad, 0x1c, 0x0b,
+	0xeb, 0x78, 0x2a, 0xbc, 0x6d, 0x5a, 0x6a, 0x79, 0x6d, 0xa3, 0x5a, 0x2d, 0xdb, 0x4e, 0x2c, 0xfe,
+	0x7c, 0x21, 0x03, 0xc8, 0x97, 0x27, 0xce, 0xc3, 0xeb, 0xf3, 0x86, 0xf8, 0x36, 0x44, 0x19, 0x1f,
+	0xf9, 0xe7, 0xf2, 0x04, 0x1d, 0x0e, 0x2e, 0x4b, 0x16, 0xf1, 0xc4, 0x16, 0xa1, 0x9d, 0x7f, 0xcf,
+	0x91, 0xb2, 0xb1, 0xc5, 0xc4, 0xb3, 0xc5, 0xf2, 0x45, 0xd8, 0xdd, 0x34, 0x1b, 0xa5, 0x38, 0x09,
+	0x51, 0xb5, 0x62, 0x6e, 0x18, 0x56, 0xd7, 0x8d, 0xcc, 0x8c, 0xd5, 0xa5, 0xc0, 0x68, 0x84, 0x8d,
+	0x3c, 0x09, 0x94, 0x2f, 0x7b, 0x41, 0xad, 0xa9, 0x15, 0xe7, 0xc4, 0x90, 0x2f, 0xc2, 0x2e, 0xdf,
+	0x28, 0xba, 0x5a, 0x85, 0x68, 0x95, 0x8f, 0xa0, 0xab, 0xe9, 0x54, 0xc0, 0xfb, 0x3d, 0x25, 0x8c,
+	0x7c, 0xce, 0x84, 0x95, 0xac, 0x81, 0xc4, 0x97, 0xe5, 0xa9, 0xc8, 0xde, 0xd4, 0x2d, 0x55, 0x53,
+	0x2d, 0x75, 0xc0, 0x29, 0x24, 0xdf, 0x27, 0x30, 0x1d, 0xe8, 0x06, 0xa3, 0x38, 0x0b, 0x63, 0x15,
+	0x1c, 0x73, 0x8e, 0x99, 0x99, 0xc0, 0x40, 0x1c, 0x4b, 0x6f, 0x28, 0xae, 0xe9, 0xe0, 0x12, 0xe1,
+	0x36, 0x81, 0x03, 0x01, 0xc0, 0x19, 0x9b, 0x0f, 0x3a, 0xf2, 0xec, 0x81, 0x28, 0xb3, 0x2b, 0x79,
+	0xb3, 0x8c, 0x79, 0x81, 0x57, 0x74, 0x0a, 0x46, 0xb4, 0x12, 0xab, 0x96, 0x55, 0x1b, 0xcf, 0x04,
+	0xe7, 0xb2, 0x49, 0xd0, 0xa1, 0xbe, 0x05, 0xfd, 0x81, 0x80, 0xdc, 0x89, 0x6f, 0xbb, 0xea, 0xba,
+	0x04, 0xfb, 0x5c, 0xec, 0xe6, 0x6c, 0x0b, 0x7e, 0xca, 0xf2, 0x20, 0x05, 0x99, 0x60, 0x84, 0x67,
+	0x60, 0xd4, 0xc1, 0xc4, 0xfc, 0x0c, 0x1f, 0x60, 0xc3, 0x52, 0x5e, 0x85, 0xd9, 0x56, 0x1f, 0x28,
+	0x26, 0x1e, 0xf7, 0x1d, 0x19, 0x4d, 0x98, 0xeb, 0x6a, 0x3f, 0x50, 0xe0, 0x2b, 0xb0, 0xd7, 0x75,
+	0x78, 0xfe, 0x8a, 0xa1, 0xd7, 0x58, 0x47, 0xc2, 0x41, 0x15, 0x0f, 0xf2, 0x75, 0x02, 0xe0, 0x3a,
+	0xed, 0xeb, 0x7d, 0xb9, 0xea, 0xbe, 0xe7, 0x76, 0xf4, 0x70, 0x3c, 0x36, 0x5e, 0x79, 0x5f, 0x3b,
+	0x6f, 0x21, 0x5f, 0xf0, 0x28, 0x6f, 0x06, 0xc6, 0x79, 0xc0, 0x39, 0x93, 0x8f, 0x63, 0xd2, 0x27,
+	0x02, 0x25, 0x76, 0xed, 0xb3, 0x31, 0xcd, 0x5d, 0x6b, 0x70, 0xd9, 0xfe, 0x21, 0x96, 0x57, 0x1e,
+	0xd0, 0xa6, 0x13, 0xe4, 0xc5, 0x6e, 0xd6, 0x7d, 0x02, 0x89, 0xb6, 0x00, 0xdb, 0x51, 0x30, 0x1b,
+	0xd3, 0x7a, 0x4d, 0x37, 0xb4, 0xd7, 0x8c, 0x7a, 0xad, 0xa4, 0x79, 0xce, 0x5a, 0xee, 0x52, 0x10,
+	0x8e, 0x65, 0xf1, 0xaa, 0x49, 0xab, 0x42, 0xdf, 0x5a, 0xdd, 0x73, 0xb2, 0xca, 0xe7, 0x1b, 0x45,
+	0x3a, 0x0d, 0xe3, 0x4c, 0x37, 0xb4, 0x9c, 0x2e, 0xc6, 0x51, 0xa4, 0xfd, 0x81, 0x22, 0x79, 0xed,
+	0x63, 0xcc, 0xbd, 0xa0, 0xe7, 0x02, 0x48, 0xfb, 0x52, 0xe9, 0x53, 0x02, 0x13, 0x1c, 0xf5, 0x0d,
+	0xb3, 0xb0, 0xbe, 0x1d, 0x5a, 0x0a, 0xf9, 0x0b, 0x02, 0xd4, 0x4b, 0x84, 0xb2, 0xad, 0xc0, 0x70,
+	0xb9, 0x3e, 0xd0, 0x5a, 0x11, 0x7a, 0xf4, 0xaa, 0x9b, 0x78, 0x9f, 0x73, 0x61, 0x32, 0xb8, 0x9c,
+	0x5a, 0x87, 0x99, 0xe6, 0x86, 0x73, 0xcd, 0xaa, 0xe9, 0xea, 0x96, 0x6a, 0xfe, 0x3d, 0x10, 0x2d,
+	0x6c, 0xd4, 0x98, 0x59, 0xe3, 0x64, 0xe3, 0x59, 0xbc, 0x92, 0xbf, 0x73, 0x3a, 0xaa, 0x00, 0x6f,
+	0x28, 0xca, 0xbf, 0x70, 0x64, 0x7a, 0x70, 0x87, 0xbc, 0xb8, 0xcb, 0x77, 0x77, 0xc3, 0x30, 0xc7,
+	0xa5, 0xb7, 0x09, 0x8c, 0x20, 0x30, 0x9d, 0x0f, 0xdc, 0xa7, 0x80, 0xaf, 0x26, 0xd2, 0xe1, 0x10,
+	0x33, 0x45, 0xd8, 0xf2, 0xab, 0x37, 0xea, 0x48, 0x1f, 0xfd, 0xf2, 0xd7, 0xe7, 0x3b, 0x96, 0xe9,
+	0x11, 0x25, 0xf8, 0x83, 0x8f, 0x10, 0x4c, 0xb9, 0x8a, 0x71, 0x5f, 0x53, 0xf2, 0xb6, 0xf8, 0xaa,
+	0x40, 0xef, 0x10, 0x88, 0x79, 0x34, 0xa5, 0x8b, 0xed, 0x3d, 0xb7, 0x7e, 0x20, 0x91, 0x92, 0x21,
+	0x67, 0x23, 0xeb, 0x51, 0x97, 0xf5, 0x30, 0x9d, 0x0b, 0xc9, 0x4a, 0x7f, 0x22, 0x30, 0xd1, 0xd2,
+	0x48, 0xd3, 0xe5, 0xf6, 0xae, 0xdb, 0x7d, 0x1d, 0x90, 0xd2, 0x3d, 0xd9, 0x20, 0xf4, 0xaa, 0x0b,
+	0x9d, 0xa6, 0x4b, 0x81, 0xd0, 0xcc, 0x31, 0xce, 0x05, 0xe0, 0xff, 0x4a, 0x60, 0x6f, 0x9b, 0x16,
+	0x95, 0xbe, 0x1c, 0x1e, 0xc8, 0xdf, 0x50, 0x4b, 0x27, 0xfa, 0xb0, 0xc4, 0x80, 0xce, 0xb9, 0x01,
+	0x9d, 0xa4, 0x2b, 0x3d, 0x07, 0xe4, 0xe6, 0xce, 0x4d, 0x02, 0x31, 0x4f, 0xc7, 0xda, 0x29, 0x77,
+	0x5a, 0xdb, 0x68, 0x29, 0x19, 0x72, 0x36, 0x52, 0xcf, 0xbb, 0xd4, 0x33, 0x74, 0x3a, 0x98, 0x5a,
+	0x60, 0xdc, 0x24, 0x30, 0xea, 0xb4, 0x8e, 0xb4, 0xc3, 0x93, 0xd4, 0xd4, 0x8c, 0x4a, 0x0b, 0x61,
+	0xa6, 0x22, 0xcd, 0x92, 0x4b, 0x33, 0x4b, 0x0f, 0x75, 0xa0, 0x71, 0xd5, 0xfa, 0x98, 0x40, 0x54,
+	0xf4, 0x8b, 0x74, 0xae, 0xbd, 0x27, 0x5f, 0x73, 0x2a, 0xcd, 0x77, 0x9f, 0x18, 0x5e, 0x1e, 0xd1,
+	0x99, 0xd2, 0x6f, 0x09, 0xec, 0xf4, 0xd5, 0xd3, 0x34, 0xd5, 0xde, 0x4b, 0x50, 0x3f, 0x21, 0x29,
+	0xa1, 0xe7, 0x23, 0xdc, 0x09, 0x17, 0x2e, 0x45, 0x17, 0x03, 0xe1, 0x44, 0xd5, 0x91, 0x73, 0x0a,
+	0x71, 0xe5, 0x2a, 0x1f, 0xb8, 0x46, 0x7f, 0x27, 0x20, 0xb5, 0xaf, 0xfe, 0xe9, 0x2b, 0x21, 0x51,
+	0x82, 0x7a, 0x0e, 0xe9, 0x64, 0x7f, 0xc6, 0x18, 0xd4, 0x29, 0x37, 0xa8, 0x63, 0xf4, 0x68, 0x98,
+	0xa0, 0x72, 0x79, 0x3b, 0xc7, 0x5f, 0xae, 0x39, 0x26, 0xe8, 0xbf, 0x22, 0xf0, 0x3f, 0x7f, 0xa3,
+	0x49, 0xbb, 0x69, 0xdb, 0xfc, 0x29, 0x41, 0x3a, 0x12, 0xde, 0x20, 0x7c, 0xee, 0x36, 0x81, 0xd3,
+	0x87, 0x04, 0x76, 0x07, 0x76, 0xc4, 0xf4, 0x58, 0x58, 0xf7, 0xfe, 0x02, 0x5d, 0x3a, 0xde, 0xb3,
+	0x1d, 0xd2, 0xaf, 0xb8, 0xf4, 0x0a, 0x4d, 0xf6, 0x24, 0x3b, 0xbd, 0x4b, 0x20, 0xe6, 0x29, 0xd9,
+	0x3b, 0x1d, 0x58, 0xad, 0x0d, 0xa0, 0x94, 0x0c, 0x39, 0x1b, 0x41, 0x8f, 0xb9, 0xa0, 0x2f, 0xd1,
+	0xc3, 0xed, 0x41, 0xb1, 0x41, 0x68, 0x64, 0xfc, 0x8f, 0x04, 0x68, 0x6b, 0x5f, 0x41, 0xd3, 0xa1,
+	0xbc, 0x37, 0xa9, 0x7c, 0xb4, 0x37, 0x23, 0x24, 0x3f, 0xee, 0x92, 0x2f, 0xd2, 0x85, 0xae, 0xe4,
+	0xae, 0xbe, 0xb7, 0x08, 0xc4, 0x3c, 0x65, 0x7a, 0x27, 0x7d, 0x5b, 0x3b, 0x11, 0x29, 0x19, 0x72,
+	0x36, 0x52, 0xa6, 0x5c, 0xca, 0x83, 0xf4, 0x40, 0xf0, 0x11, 0xec, 0xe9, 0x2d, 0xe8, 0x27, 0x04,
+	0x86, 0x79, 0x19, 0x4d, 0x67, 0xdb, 0x3b, 0xf2, 0x56, 0xfe, 0xd2, 0x5c, 0xd7, 0x79, 0xe1, 0x9f,
+	0x28, 0x5e, 0x7c, 0x7b, 0xaa, 0x82, 0xeb, 0x04, 0x26, 0x5a, 0x6a, 0xd9, 0x4e, 0x45, 0x4d, 0xbb,
+	0x32, 0x5b, 0x4a, 0xf7, 0x64, 0x83, 0xc4, 0x91, 0x23, 0x24, 0x93, 0x7e, 0xb4, 0x19, 0x27, 0x8f,
+	0x37, 0xe3, 0xe4, 0xcf, 0xcd, 0x38, 0xf9, 0xec, 0x69, 0x3c, 0xf2, 0xf8, 0x69, 0x3c, 0xf2, 0xdb,
+	0xd3, 0x78, 0xe4, 0x1d, 0xfc, 0x59, 0x90, 0x69, 0xeb, 0xa9, 0x92, 0xa9, 0x7c, 0x20, 0x22, 0xe1,
+	0x9f, 0x8d, 0xf3, 0x51, 0xfe, 0x6b, 0x5f, 0xfa, 0xef, 0x01, 0x00, 0xda, 0xe1, 0x18, 0xbb, 0x10,
+	0x1d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1561,6 +1932,9 @@ type QueryClient interface {
 	// DenomsMetadata queries the client metadata for all registered coin
 	// denominations.
 	DenomsMetadata(ctx context.Context, in *QueryDenomsMetadataRequest, opts ...grpc.CallOption) (*QueryDenomsMetadataResponse, error)
+	// DenomsMetadataByQuery queries the client metadata of the registered coin
+	// denominations matching the given symbol and/or display denomination.
+	DenomsMetadataByQuery(ctx context.Context, in *QueryDenomsMetadataByQueryRequest, opts ...grpc.CallOption) (*QueryDenomsMetadataByQueryResponse, error)
 	// DenomOwners queries for all account addresses that own a particular token
 	// denomination.
 	//
@@ -1582,6 +1956,15 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	SendEnabled(ctx context.Context, in *QuerySendEnabledRequest, opts ...grpc.CallOption) (*QuerySendEnabledResponse, error)
+	// Locks queries the coins locked in the balance of an account.
+	Locks(ctx context.Context, in *QueryLocksRequest, opts ...grpc.CallOption) (*QueryLocksResponse, error)
+	// AllBalancesStream streams the balances of all accounts, or of a single
+	// account, ordered by address and then denom. Each response carries a cursor
+	// which can be sent back in a new request to resume the stream after it, so
+	// that indexers can snapshot large sets of balances without a query timeout.
+	//
+	// It is only served by the gRPC server and cannot be called from other modules.
+	AllBalancesStream(ctx context.Context, in *QueryAllBalancesStreamRequest, opts ...grpc.CallOption) (Query_AllBalancesStreamClient, error)
 }
 
 type queryClient struct {
@@ -1682,6 +2065,15 @@ func (c *queryClient) DenomsMetadata(ctx context.Context, in *QueryDenomsMetadat
 	return out, nil
 }
 
+func (c *queryClient) DenomsMetadataByQuery(ctx context.Context, in *QueryDenomsMetadataByQueryRequest, opts ...grpc.CallOption) (*QueryDenomsMetadataByQueryResponse, error) {
+	out := new(QueryDenomsMetadataByQueryResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Query/DenomsMetadataByQuery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) DenomOwners(ctx context.Context, in *QueryDenomOwnersRequest, opts ...grpc.CallOption) (*QueryDenomOwnersResponse, error) {
 	out := new(QueryDenomOwnersResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Query/DenomOwners", in, out, opts...)
@@ -1709,16 +2101,57 @@ func (c *queryClient) SendEnabled(ctx context.Context, in *QuerySendEnabledReque
 	return out, nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Balance queries the balance of a single coin for a single account.
-	Balance(context.Context, *QueryBalanceRequest) (*QueryBalanceResponse, error)
-	// AllBalances queries the balance of all coins for a single account.
-	//
-	// When called from another module, this query might consume a high amount of
-	// gas if the pagination field is incorrectly set.
-	AllBalances(context.Context, *QueryAllBalancesRequest) (*QueryAllBalancesResponse, error)
-	// SpendableBalances queries the spendable balance of all coins for a single
+func (c *queryClient) Locks(ctx context.Context, in *QueryLocksRequest, opts ...grpc.CallOption) (*QueryLocksResponse, error) {
+	out := new(QueryLocksResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Query/Locks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllBalancesStream(ctx context.Context, in *QueryAllBalancesStreamRequest, opts ...grpc.CallOption) (Query_AllBalancesStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Query_serviceDesc.Streams[0], "/cosmos.bank.v1beta1.Query/AllBalancesStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &queryAllBalancesStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Query_AllBalancesStreamClient interface {
+	Recv() (*QueryAllBalancesStreamResponse, error)
+	grpc.ClientStream
+}
+
+type queryAllBalancesStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *queryAllBalancesStreamClient) Recv() (*QueryAllBalancesStreamResponse, error) {
+	m := new(QueryAllBalancesStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Balance queries the balance of a single coin for a single account.
+	Balance(context.Context, *QueryBalanceRequest) (*QueryBalanceResponse, error)
+	// AllBalances queries the balance of all coins for a single account.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	AllBalances(context.Context, *QueryAllBalancesRequest) (*QueryAllBalancesResponse, error)
+	// SpendableBalances queries the spendable balance of all coins for a single
 	// account.
 	//
 	// When called from another module, this query might consume a high amount of
@@ -1753,6 +2186,9 @@ type QueryServer interface {
 	// DenomsMetadata queries the client metadata for all registered coin
 	// denominations.
 	DenomsMetadata(context.Context, *QueryDenomsMetadataRequest) (*QueryDenomsMetadataResponse, error)
+	// DenomsMetadataByQuery queries the client metadata of the registered coin
+	// denominations matching the given symbol and/or display denomination.
+	DenomsMetadataByQuery(context.Context, *QueryDenomsMetadataByQueryRequest) (*QueryDenomsMetadataByQueryResponse, error)
 	// DenomOwners queries for all account addresses that own a particular token
 	// denomination.
 	//
@@ -1774,6 +2210,15 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	SendEnabled(context.Context, *QuerySendEnabledRequest) (*QuerySendEnabledResponse, error)
+	// Locks queries the coins locked in the balance of an account.
+	Locks(context.Context, *QueryLocksRequest) (*QueryLocksResponse, error)
+	// AllBalancesStream streams the balances of all accounts, or of a single
+	// account, ordered by address and then denom. Each response carries a cursor
+	// which can be sent back in a new request to resume the stream after it, so
+	// that indexers can snapshot large sets of balances without a query timeout.
+	//
+	// It is only served by the gRPC server and cannot be called from other modules.
+	AllBalancesStream(*QueryAllBalancesStreamRequest, Query_AllBalancesStreamServer) error
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1810,6 +2255,9 @@ func (*UnimplementedQueryServer) DenomMetadataByQueryString(ctx context.Context,
 func (*UnimplementedQueryServer) DenomsMetadata(ctx context.Context, req *QueryDenomsMetadataRequest) (*QueryDenomsMetadataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DenomsMetadata not implemented")
 }
+func (*UnimplementedQueryServer) DenomsMetadataByQuery(ctx context.Context, req *QueryDenomsMetadataByQueryRequest) (*QueryDenomsMetadataByQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomsMetadataByQuery not implemented")
+}
 func (*UnimplementedQueryServer) DenomOwners(ctx context.Context, req *QueryDenomOwnersRequest) (*QueryDenomOwnersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DenomOwners not implemented")
 }
@@ -1819,6 +2267,12 @@ func (*UnimplementedQueryServer) DenomOwnersByQuery(ctx context.Context, req *Qu
 func (*UnimplementedQueryServer) SendEnabled(ctx context.Context, req *QuerySendEnabledRequest) (*QuerySendEnabledResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendEnabled not implemented")
 }
+func (*UnimplementedQueryServer) Locks(ctx context.Context, req *QueryLocksRequest) (*QueryLocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Locks not implemented")
+}
+func (*UnimplementedQueryServer) AllBalancesStream(req *QueryAllBalancesStreamRequest, srv Query_AllBalancesStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method AllBalancesStream not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2004,6 +2458,24 @@ func _Query_DenomsMetadata_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DenomsMetadataByQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomsMetadataByQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomsMetadataByQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Query/DenomsMetadataByQuery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomsMetadataByQuery(ctx, req.(*QueryDenomsMetadataByQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_DenomOwners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryDenomOwnersRequest)
 	if err := dec(in); err != nil {
@@ -2058,6 +2530,45 @@ func _Query_SendEnabled_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Locks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Locks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Query/Locks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Locks(ctx, req.(*QueryLocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllBalancesStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(QueryAllBalancesStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueryServer).AllBalancesStream(m, &queryAllBalancesStreamServer{stream})
+}
+
+type Query_AllBalancesStreamServer interface {
+	Send(*QueryAllBalancesStreamResponse) error
+	grpc.ServerStream
+}
+
+type queryAllBalancesStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *queryAllBalancesStreamServer) Send(m *QueryAllBalancesStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.bank.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2102,6 +2613,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DenomsMetadata",
 			Handler:    _Query_DenomsMetadata_Handler,
 		},
+		{
+			MethodName: "DenomsMetadataByQuery",
+			Handler:    _Query_DenomsMetadataByQuery_Handler,
+		},
 		{
 			MethodName: "DenomOwners",
 			Handler:    _Query_DenomOwners_Handler,
@@ -2114,8 +2629,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SendEnabled",
 			Handler:    _Query_SendEnabled_Handler,
 		},
+		{
+			MethodName: "Locks",
+			Handler:    _Query_Locks_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "AllBalancesStream",
+			Handler:       _Query_AllBalancesStream_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/bank/v1beta1/query.proto",
 }
 
@@ -2742,6 +3267,104 @@ func (m *QueryDenomsMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryDenomsMetadataByQueryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomsMetadataByQueryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomsMetadataByQueryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Display) > 0 {
+		i -= len(m.Display)
+		copy(dAtA[i:], m.Display)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Display)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomsMetadataByQueryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomsMetadataByQueryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomsMetadataByQueryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Metadatas) > 0 {
+		for iNdEx := len(m.Metadatas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Metadatas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryDenomMetadataRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3187,74 +3810,249 @@ func (m *QuerySendEnabledResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryBalanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryLocksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryBalanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Balance != nil {
-		l = m.Balance.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryLocksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllBalancesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryLocksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.ResolveDenom {
-		n += 2
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllBalancesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryLocksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLocksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLocksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Balances) > 0 {
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Locks) > 0 {
+		for iNdEx := len(m.Locks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllBalancesStreamRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllBalancesStreamRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllBalancesStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Cursor) > 0 {
+		i -= len(m.Cursor)
+		copy(dAtA[i:], m.Cursor)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Cursor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllBalancesStreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllBalancesStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllBalancesStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Cursor) > 0 {
+		i -= len(m.Cursor)
+		copy(dAtA[i:], m.Cursor)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Cursor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Balance != nil {
+		l = m.Balance.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllBalancesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ResolveDenom {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryAllBalancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Balances) > 0 {
 		for _, e := range m.Balances {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
@@ -3441,6 +4239,46 @@ func (m *QueryDenomsMetadataResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryDenomsMetadataByQueryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Display)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomsMetadataByQueryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Metadatas) > 0 {
+		for _, e := range m.Metadatas {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryDenomMetadataRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3614,43 +4452,115 @@ func (m *QuerySendEnabledResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QueryLocksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
-func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBalanceRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+
+func (m *QueryLocksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Locks) > 0 {
+		for _, e := range m.Locks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
 		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllBalancesStreamRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Cursor)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllBalancesStreamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Balance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Cursor)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBalanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
@@ -3936,7 +4846,651 @@ func (m *QueryAllBalancesRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.ResolveDenom = bool(v != 0)
+			m.ResolveDenom = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllBalancesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllBalancesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllBalancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, types.Coin{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySpendableBalancesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySpendableBalancesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySpendableBalancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySpendableBalancesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySpendableBalancesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySpendableBalancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, types.Coin{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySpendableBalanceByDenomRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySpendableBalanceByDenomRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySpendableBalanceByDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySpendableBalanceByDenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySpendableBalanceByDenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySpendableBalanceByDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Balance == nil {
+				m.Balance = &types.Coin{}
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalSupplyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalSupplyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3958,7 +5512,7 @@ func (m *QueryAllBalancesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllBalancesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTotalSupplyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3981,15 +5535,15 @@ func (m *QueryAllBalancesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllBalancesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTotalSupplyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllBalancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTotalSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4016,8 +5570,8 @@ func (m *QueryAllBalancesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Balances = append(m.Balances, types.Coin{})
-			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Supply = append(m.Supply, types.Coin{})
+			if err := m.Supply[len(m.Supply)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4078,7 +5632,7 @@ func (m *QueryAllBalancesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySpendableBalancesRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySupplyOfRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4101,15 +5655,15 @@ func (m *QuerySpendableBalancesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySpendableBalancesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySupplyOfRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySpendableBalancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySupplyOfRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4137,11 +5691,61 @@ func (m *QuerySpendableBalancesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySupplyOfResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySupplyOfResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySupplyOfResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4168,10 +5772,7 @@ func (m *QuerySpendableBalancesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4196,7 +5797,7 @@ func (m *QuerySpendableBalancesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySpendableBalancesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4219,49 +5820,65 @@ func (m *QuerySpendableBalancesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySpendableBalancesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySpendableBalancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Balances = append(m.Balances, types.Coin{})
-			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 2:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4288,10 +5905,7 @@ func (m *QuerySpendableBalancesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4316,7 +5930,7 @@ func (m *QuerySpendableBalancesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySpendableBalanceByDenomRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomsMetadataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4339,17 +5953,17 @@ func (m *QuerySpendableBalanceByDenomRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySpendableBalanceByDenomRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomsMetadataRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySpendableBalanceByDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomsMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4359,55 +5973,27 @@ func (m *QuerySpendableBalanceByDenomRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4430,7 +6016,7 @@ func (m *QuerySpendableBalanceByDenomRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySpendableBalanceByDenomResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomsMetadataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4453,15 +6039,49 @@ func (m *QuerySpendableBalanceByDenomResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySpendableBalanceByDenomResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomsMetadataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySpendableBalanceByDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomsMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadatas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadatas = append(m.Metadatas, Metadata{})
+			if err := m.Metadatas[len(m.Metadatas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4488,10 +6108,10 @@ func (m *QuerySpendableBalanceByDenomResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Balance == nil {
-				m.Balance = &types.Coin{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4516,7 +6136,7 @@ func (m *QuerySpendableBalanceByDenomResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalSupplyRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomsMetadataByQueryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4539,13 +6159,77 @@ func (m *QueryTotalSupplyRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalSupplyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomsMetadataByQueryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomsMetadataByQueryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Display", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Display = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -4602,7 +6286,7 @@ func (m *QueryTotalSupplyRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalSupplyResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomsMetadataByQueryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4625,15 +6309,15 @@ func (m *QueryTotalSupplyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalSupplyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomsMetadataByQueryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomsMetadataByQueryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadatas", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4660,8 +6344,8 @@ func (m *QueryTotalSupplyResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Supply = append(m.Supply, types.Coin{})
-			if err := m.Supply[len(m.Supply)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Metadatas = append(m.Metadatas, Metadata{})
+			if err := m.Metadatas[len(m.Metadatas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4722,7 +6406,7 @@ func (m *QueryTotalSupplyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySupplyOfRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomMetadataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4745,10 +6429,10 @@ func (m *QuerySupplyOfRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySupplyOfRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomMetadataRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySupplyOfRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4804,7 +6488,7 @@ func (m *QuerySupplyOfRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySupplyOfResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomMetadataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4827,15 +6511,15 @@ func (m *QuerySupplyOfResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySupplyOfResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomMetadataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySupplyOfResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4862,7 +6546,7 @@ func (m *QuerySupplyOfResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4887,57 +6571,7 @@ func (m *QuerySupplyOfResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomMetadataByQueryStringRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4960,17 +6594,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomMetadataByQueryStringRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomMetadataByQueryStringRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4980,24 +6614,23 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5020,7 +6653,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomsMetadataRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomMetadataByQueryStringResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5043,15 +6676,15 @@ func (m *QueryDenomsMetadataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomsMetadataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomMetadataByQueryStringResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomsMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomMetadataByQueryStringResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5078,10 +6711,7 @@ func (m *QueryDenomsMetadataRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5106,7 +6736,7 @@ func (m *QueryDenomsMetadataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomsMetadataResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomOwnersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5129,17 +6759,17 @@ func (m *QueryDenomsMetadataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomsMetadataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomOwnersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomsMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomOwnersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadatas", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5149,25 +6779,23 @@ func (m *QueryDenomsMetadataResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadatas = append(m.Metadatas, Metadata{})
-			if err := m.Metadatas[len(m.Metadatas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -5199,7 +6827,7 @@ func (m *QueryDenomsMetadataResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -5226,7 +6854,7 @@ func (m *QueryDenomsMetadataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomMetadataRequest) Unmarshal(dAtA []byte) error {
+func (m *DenomOwner) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5249,15 +6877,15 @@ func (m *QueryDenomMetadataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomMetadataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DenomOwner: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DenomOwner: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5285,7 +6913,40 @@ func (m *QueryDenomMetadataRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5308,7 +6969,7 @@ func (m *QueryDenomMetadataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomMetadataResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomOwnersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5331,15 +6992,15 @@ func (m *QueryDenomMetadataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomMetadataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomOwnersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomOwnersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomOwners", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5366,7 +7027,44 @@ func (m *QueryDenomMetadataResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DenomOwners = append(m.DenomOwners, &DenomOwner{})
+			if err := m.DenomOwners[len(m.DenomOwners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5391,7 +7089,7 @@ func (m *QueryDenomMetadataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomMetadataByQueryStringRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomOwnersByQueryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5414,10 +7112,10 @@ func (m *QueryDenomMetadataByQueryStringRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomMetadataByQueryStringRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomOwnersByQueryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomMetadataByQueryStringRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomOwnersByQueryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5452,59 +7150,9 @@ func (m *QueryDenomMetadataByQueryStringRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryDenomMetadataByQueryStringResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomMetadataByQueryStringResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomMetadataByQueryStringResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5531,7 +7179,10 @@ func (m *QueryDenomMetadataByQueryStringResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5556,7 +7207,7 @@ func (m *QueryDenomMetadataByQueryStringResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomOwnersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomOwnersByQueryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5579,17 +7230,17 @@ func (m *QueryDenomOwnersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomOwnersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomOwnersByQueryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomOwnersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomOwnersByQueryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomOwners", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5599,23 +7250,25 @@ func (m *QueryDenomOwnersRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.DenomOwners = append(m.DenomOwners, &DenomOwner{})
+			if err := m.DenomOwners[len(m.DenomOwners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -5647,7 +7300,7 @@ func (m *QueryDenomOwnersRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -5674,7 +7327,7 @@ func (m *QueryDenomOwnersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DenomOwner) Unmarshal(dAtA []byte) error {
+func (m *QuerySendEnabledRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5697,15 +7350,15 @@ func (m *DenomOwner) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DenomOwner: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySendEnabledRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DenomOwner: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySendEnabledRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5733,11 +7386,11 @@ func (m *DenomOwner) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 99:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5764,7 +7417,10 @@ func (m *DenomOwner) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5789,7 +7445,7 @@ func (m *DenomOwner) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomOwnersResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySendEnabledResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5812,15 +7468,15 @@ func (m *QueryDenomOwnersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomOwnersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySendEnabledResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomOwnersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySendEnabledResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DenomOwners", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SendEnabled", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5847,12 +7503,12 @@ func (m *QueryDenomOwnersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DenomOwners = append(m.DenomOwners, &DenomOwner{})
-			if err := m.DenomOwners[len(m.DenomOwners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SendEnabled = append(m.SendEnabled, &SendEnabled{})
+			if err := m.SendEnabled[len(m.SendEnabled)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 99:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -5909,7 +7565,7 @@ func (m *QueryDenomOwnersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomOwnersByQueryRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLocksRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5932,15 +7588,15 @@ func (m *QueryDenomOwnersByQueryRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomOwnersByQueryRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLocksRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomOwnersByQueryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5968,7 +7624,7 @@ func (m *QueryDenomOwnersByQueryRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -6027,7 +7683,7 @@ func (m *QueryDenomOwnersByQueryRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomOwnersByQueryResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLocksResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6050,15 +7706,15 @@ func (m *QueryDenomOwnersByQueryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomOwnersByQueryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLocksResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomOwnersByQueryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLocksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DenomOwners", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Locks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6085,8 +7741,8 @@ func (m *QueryDenomOwnersByQueryResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DenomOwners = append(m.DenomOwners, &DenomOwner{})
-			if err := m.DenomOwners[len(m.DenomOwners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Locks = append(m.Locks, Lock{})
+			if err := m.Locks[len(m.Locks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6147,7 +7803,7 @@ func (m *QueryDenomOwnersByQueryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySendEnabledRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllBalancesStreamRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6170,15 +7826,15 @@ func (m *QuerySendEnabledRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySendEnabledRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllBalancesStreamRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySendEnabledRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllBalancesStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6206,13 +7862,13 @@ func (m *QuerySendEnabledRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 99:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6222,26 +7878,24 @@ func (m *QuerySendEnabledRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Cursor = append(m.Cursor[:0], dAtA[iNdEx:postIndex]...)
+			if m.Cursor == nil {
+				m.Cursor = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -6265,7 +7919,7 @@ func (m *QuerySendEnabledRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySendEnabledResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllBalancesStreamResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6288,15 +7942,47 @@ func (m *QuerySendEnabledResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySendEnabledResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllBalancesStreamResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySendEnabledResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllBalancesStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SendEnabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6323,16 +8009,15 @@ func (m *QuerySendEnabledResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SendEnabled = append(m.SendEnabled, &SendEnabled{})
-			if err := m.SendEnabled[len(m.SendEnabled)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 99:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6342,26 +8027,24 @@ func (m *QuerySendEnabledResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Cursor = append(m.Cursor[:0], dAtA[iNdEx:postIndex]...)
+			if m.Cursor == nil {
+				m.Cursor = []byte{}
 			}
 			iNdEx = postIndex
 		default:
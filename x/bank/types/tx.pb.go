@@ -493,6 +493,190 @@ func (m *MsgBurnResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgBurnResponse proto.InternalMessageInfo
 
+// MsgSetDenomMetadata is the Msg/SetDenomMetadata request type.
+type MsgSetDenomMetadata struct {
+	// authority is the address that controls the module.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// metadata is the client metadata to set for the metadata.base denom.
+	Metadata Metadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata"`
+}
+
+func (m *MsgSetDenomMetadata) Reset()         { *m = MsgSetDenomMetadata{} }
+func (m *MsgSetDenomMetadata) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDenomMetadata) ProtoMessage()    {}
+func (*MsgSetDenomMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8cb1613481f5b7, []int{10}
+}
+func (m *MsgSetDenomMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDenomMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDenomMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetDenomMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDenomMetadata.Merge(m, src)
+}
+func (m *MsgSetDenomMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDenomMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDenomMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetDenomMetadata proto.InternalMessageInfo
+
+func (m *MsgSetDenomMetadata) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetDenomMetadata) GetMetadata() Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return Metadata{}
+}
+
+// MsgSetDenomMetadataResponse defines the Msg/SetDenomMetadata response type.
+type MsgSetDenomMetadataResponse struct {
+}
+
+func (m *MsgSetDenomMetadataResponse) Reset()         { *m = MsgSetDenomMetadataResponse{} }
+func (m *MsgSetDenomMetadataResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDenomMetadataResponse) ProtoMessage()    {}
+func (*MsgSetDenomMetadataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8cb1613481f5b7, []int{11}
+}
+func (m *MsgSetDenomMetadataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDenomMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDenomMetadataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetDenomMetadataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDenomMetadataResponse.Merge(m, src)
+}
+func (m *MsgSetDenomMetadataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDenomMetadataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDenomMetadataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetDenomMetadataResponse proto.InternalMessageInfo
+
+// MsgRemoveDenomMetadata is the Msg/RemoveDenomMetadata request type.
+type MsgRemoveDenomMetadata struct {
+	// authority is the address that controls the module.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// denom is the base denom of the client metadata to remove.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *MsgRemoveDenomMetadata) Reset()         { *m = MsgRemoveDenomMetadata{} }
+func (m *MsgRemoveDenomMetadata) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveDenomMetadata) ProtoMessage()    {}
+func (*MsgRemoveDenomMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8cb1613481f5b7, []int{12}
+}
+func (m *MsgRemoveDenomMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveDenomMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveDenomMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveDenomMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveDenomMetadata.Merge(m, src)
+}
+func (m *MsgRemoveDenomMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveDenomMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveDenomMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveDenomMetadata proto.InternalMessageInfo
+
+func (m *MsgRemoveDenomMetadata) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRemoveDenomMetadata) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// MsgRemoveDenomMetadataResponse defines the Msg/RemoveDenomMetadata response type.
+type MsgRemoveDenomMetadataResponse struct {
+}
+
+func (m *MsgRemoveDenomMetadataResponse) Reset()         { *m = MsgRemoveDenomMetadataResponse{} }
+func (m *MsgRemoveDenomMetadataResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveDenomMetadataResponse) ProtoMessage()    {}
+func (*MsgRemoveDenomMetadataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8cb1613481f5b7, []int{13}
+}
+func (m *MsgRemoveDenomMetadataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveDenomMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveDenomMetadataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveDenomMetadataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveDenomMetadataResponse.Merge(m, src)
+}
+func (m *MsgRemoveDenomMetadataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveDenomMetadataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveDenomMetadataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveDenomMetadataResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSend)(nil), "cosmos.bank.v1beta1.MsgSend")
 	proto.RegisterType((*MsgSendResponse)(nil), "cosmos.bank.v1beta1.MsgSendResponse")
@@ -504,60 +688,71 @@ func init() {
 	proto.RegisterType((*MsgSetSendEnabledResponse)(nil), "cosmos.bank.v1beta1.MsgSetSendEnabledResponse")
 	proto.RegisterType((*MsgBurn)(nil), "cosmos.bank.v1beta1.MsgBurn")
 	proto.RegisterType((*MsgBurnResponse)(nil), "cosmos.bank.v1beta1.MsgBurnResponse")
+	proto.RegisterType((*MsgSetDenomMetadata)(nil), "cosmos.bank.v1beta1.MsgSetDenomMetadata")
+	proto.RegisterType((*MsgSetDenomMetadataResponse)(nil), "cosmos.bank.v1beta1.MsgSetDenomMetadataResponse")
+	proto.RegisterType((*MsgRemoveDenomMetadata)(nil), "cosmos.bank.v1beta1.MsgRemoveDenomMetadata")
+	proto.RegisterType((*MsgRemoveDenomMetadataResponse)(nil), "cosmos.bank.v1beta1.MsgRemoveDenomMetadataResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/tx.proto", fileDescriptor_1d8cb1613481f5b7) }
 
 var fileDescriptor_1d8cb1613481f5b7 = []byte{
-	// 753 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xcf, 0x4f, 0x13, 0x5b,
-	0x18, 0xed, 0xb4, 0xbc, 0x92, 0x5e, 0xfa, 0x1e, 0x61, 0x1e, 0x79, 0xd0, 0x81, 0x4c, 0xa1, 0x79,
-	0x21, 0x88, 0x32, 0x63, 0xc1, 0x68, 0x52, 0xa3, 0xd1, 0xa2, 0x24, 0x92, 0x34, 0x9a, 0x12, 0x17,
-	0xba, 0x69, 0xa6, 0x9d, 0xcb, 0x30, 0xa1, 0x33, 0xb7, 0x99, 0x7b, 0x87, 0xd0, 0x9d, 0x71, 0x65,
-	0x5c, 0xb9, 0x70, 0xe5, 0x8a, 0xa5, 0x31, 0x2e, 0x58, 0xb8, 0x34, 0x71, 0xcb, 0xc6, 0x84, 0xb8,
-	0x72, 0xa5, 0x06, 0x16, 0xe8, 0x7f, 0x61, 0xee, 0x8f, 0xb9, 0x0c, 0xa5, 0x63, 0x89, 0x6e, 0x68,
-	0xb9, 0xdf, 0x77, 0xce, 0xf7, 0x9d, 0x33, 0xf7, 0x74, 0xc0, 0x74, 0x0b, 0x61, 0x0f, 0x61, 0xb3,
-	0x69, 0xf9, 0x5b, 0xe6, 0x76, 0xb9, 0x09, 0x89, 0x55, 0x36, 0xc9, 0x8e, 0xd1, 0x09, 0x10, 0x41,
-	0xea, 0xbf, 0xbc, 0x6a, 0xd0, 0xaa, 0x21, 0xaa, 0xda, 0xb8, 0x83, 0x1c, 0xc4, 0xea, 0x26, 0xfd,
-	0xc6, 0x5b, 0x35, 0x5d, 0x12, 0x61, 0x28, 0x89, 0x5a, 0xc8, 0xf5, 0xcf, 0xd4, 0x63, 0x83, 0x18,
-	0x2f, 0xaf, 0x17, 0x78, 0xbd, 0xc1, 0x89, 0xc5, 0x5c, 0x5e, 0x9a, 0x10, 0x50, 0x0f, 0x3b, 0xe6,
-	0x76, 0x99, 0x7e, 0x88, 0xc2, 0x98, 0xe5, 0xb9, 0x3e, 0x32, 0xd9, 0x5f, 0x7e, 0x54, 0x7a, 0x9b,
-	0x06, 0xc3, 0x35, 0xec, 0xac, 0x43, 0xdf, 0x56, 0xaf, 0x83, 0xfc, 0x46, 0x80, 0xbc, 0x86, 0x65,
-	0xdb, 0x01, 0xc4, 0x78, 0x52, 0x99, 0x51, 0xe6, 0x73, 0xd5, 0xc9, 0x4f, 0xef, 0x16, 0xc7, 0x05,
-	0xff, 0x6d, 0x5e, 0x59, 0x27, 0x81, 0xeb, 0x3b, 0xf5, 0x11, 0xda, 0x2d, 0x8e, 0xd4, 0x6b, 0x00,
-	0x10, 0x24, 0xa1, 0xe9, 0x01, 0xd0, 0x1c, 0x41, 0x11, 0xb0, 0x0b, 0xb2, 0x96, 0x87, 0x42, 0x9f,
-	0x4c, 0x66, 0x66, 0x32, 0xf3, 0x23, 0x4b, 0x05, 0x43, 0x9a, 0x88, 0x61, 0x64, 0xa2, 0xb1, 0x82,
-	0x5c, 0xbf, 0xba, 0xba, 0xff, 0xa5, 0x98, 0x7a, 0xf3, 0xb5, 0x38, 0xef, 0xb8, 0x64, 0x33, 0x6c,
-	0x1a, 0x2d, 0xe4, 0x09, 0xe5, 0xe2, 0x63, 0x11, 0xdb, 0x5b, 0x26, 0xe9, 0x76, 0x20, 0x66, 0x00,
-	0xfc, 0xea, 0x78, 0x6f, 0x21, 0xdf, 0x86, 0x8e, 0xd5, 0xea, 0x36, 0xa8, 0xb7, 0xf8, 0xf5, 0xf1,
-	0xde, 0x82, 0x52, 0x17, 0x03, 0x2b, 0x97, 0x9f, 0xed, 0x16, 0x53, 0xdf, 0x77, 0x8b, 0xa9, 0xa7,
-	0xb4, 0x2f, 0xae, 0xfd, 0xf9, 0xf1, 0xde, 0x82, 0x1a, 0xe3, 0x14, 0x16, 0x95, 0xc6, 0xc0, 0xa8,
-	0xf8, 0x5a, 0x87, 0xb8, 0x83, 0x7c, 0x0c, 0x4b, 0xef, 0x15, 0x90, 0xaf, 0x61, 0xa7, 0x16, 0xb6,
-	0x89, 0xcb, 0x6c, 0xbc, 0x01, 0xb2, 0xae, 0xdf, 0x09, 0x09, 0x35, 0x90, 0x0a, 0xd2, 0x8c, 0x3e,
-	0xb7, 0xc2, 0xb8, 0x47, 0x5b, 0xaa, 0x39, 0xaa, 0x48, 0x2c, 0xc5, 0x41, 0xea, 0x2d, 0x30, 0x8c,
-	0x42, 0xc2, 0xf0, 0x69, 0x86, 0x9f, 0xea, 0x8b, 0xbf, 0xcf, 0x7a, 0xe2, 0x04, 0x11, 0xac, 0x72,
-	0x31, 0x92, 0x24, 0x28, 0xa9, 0x98, 0x89, 0xd3, 0x62, 0xe4, 0xb6, 0xa5, 0xff, 0xc0, 0x78, 0xfc,
-	0x7f, 0x29, 0xeb, 0x83, 0xc2, 0xa4, 0x3e, 0xec, 0xd8, 0x16, 0x81, 0x0f, 0xac, 0xc0, 0xf2, 0xb0,
-	0x7a, 0x15, 0xe4, 0xac, 0x90, 0x6c, 0xa2, 0xc0, 0x25, 0xdd, 0x81, 0xb7, 0xe3, 0xa4, 0x55, 0xbd,
-	0x09, 0xb2, 0x1d, 0xc6, 0xc0, 0xee, 0x45, 0x92, 0x22, 0x3e, 0xe4, 0x94, 0x25, 0x1c, 0x55, 0xb9,
-	0x42, 0xc5, 0x9c, 0xf0, 0x51, 0x3d, 0xb3, 0x31, 0x3d, 0x3b, 0x3c, 0x24, 0x3d, 0xdb, 0x96, 0x0a,
-	0x60, 0xa2, 0xe7, 0x48, 0x8a, 0xfb, 0xa1, 0x80, 0x31, 0xf6, 0x1c, 0x09, 0xd5, 0x7c, 0xd7, 0xb7,
-	0x9a, 0x6d, 0x68, 0xff, 0xb6, 0xbc, 0x15, 0x90, 0xc7, 0xd0, 0xb7, 0x1b, 0x90, 0xf3, 0x88, 0xc7,
-	0x36, 0xd3, 0x57, 0x64, 0x6c, 0x5e, 0x7d, 0x04, 0xc7, 0x86, 0xcf, 0x81, 0xd1, 0x10, 0xc3, 0x86,
-	0x0d, 0x37, 0xac, 0xb0, 0x4d, 0x1a, 0x1b, 0x28, 0x60, 0x79, 0xc8, 0xd5, 0xff, 0x0e, 0x31, 0xbc,
-	0xc3, 0x4f, 0x57, 0x51, 0x50, 0x31, 0xcf, 0x7a, 0x31, 0xdd, 0x7b, 0x51, 0xe3, 0xaa, 0x4a, 0x53,
-	0xa0, 0x70, 0xe6, 0x50, 0x1a, 0xf1, 0x52, 0x61, 0xf1, 0xaf, 0x86, 0x81, 0xff, 0x67, 0xf1, 0x2f,
-	0xcb, 0x14, 0xa7, 0x07, 0xa4, 0x58, 0xa6, 0xaf, 0x90, 0x98, 0x3e, 0x11, 0x33, 0xba, 0x55, 0xb4,
-	0xe9, 0xd2, 0xc7, 0x0c, 0xc8, 0xd4, 0xb0, 0xa3, 0xae, 0x81, 0x21, 0x96, 0xb2, 0xe9, 0xbe, 0xf6,
-	0x8a, 0x70, 0x6a, 0xff, 0xff, 0xaa, 0x1a, 0x71, 0xaa, 0x8f, 0x40, 0xee, 0x24, 0xb6, 0xb3, 0x49,
-	0x10, 0xd9, 0xa2, 0x5d, 0x18, 0xd8, 0x22, 0xa9, 0xd7, 0xc0, 0x10, 0x33, 0x35, 0x71, 0x4d, 0x5a,
-	0x4d, 0x5e, 0x33, 0x2e, 0x5d, 0x6d, 0x82, 0xfc, 0xa9, 0x18, 0x26, 0xa2, 0xe2, 0x5d, 0xda, 0xa5,
-	0xf3, 0x74, 0xc9, 0x19, 0x9b, 0xe0, 0x9f, 0x9e, 0x34, 0xcc, 0x25, 0x5b, 0x18, 0xef, 0xd3, 0x8c,
-	0xf3, 0xf5, 0x45, 0x93, 0xb4, 0xbf, 0x9e, 0xd0, 0x6c, 0x57, 0x97, 0xf7, 0x0f, 0x75, 0xe5, 0xe0,
-	0x50, 0x57, 0xbe, 0x1d, 0xea, 0xca, 0x8b, 0x23, 0x3d, 0x75, 0x70, 0xa4, 0xa7, 0x3e, 0x1f, 0xe9,
-	0xa9, 0xc7, 0xe2, 0xcd, 0x86, 0xed, 0x2d, 0xc3, 0x45, 0x51, 0xb8, 0xd9, 0x8f, 0x7a, 0x33, 0xcb,
-	0x5e, 0x5a, 0xcb, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xe0, 0x58, 0x76, 0xf8, 0x86, 0x07, 0x00,
-	0x00,
+	// 880 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0x26, 0x4d, 0x8a, 0x5f, 0x0c, 0x25, 0x1b, 0xab, 0x89, 0x37, 0xe9, 0xda, 0x5d, 0x55,
+	0x95, 0x49, 0xe9, 0x6e, 0xdd, 0x20, 0x90, 0x8c, 0x40, 0xe0, 0x86, 0x4a, 0x54, 0xb2, 0x40, 0xae,
+	0x38, 0xc0, 0xc5, 0x5a, 0x7b, 0x27, 0x9b, 0x55, 0xbc, 0x3b, 0xd6, 0xce, 0x6c, 0xa8, 0x6f, 0x88,
+	0x13, 0xe2, 0xc4, 0x81, 0x13, 0xe2, 0xd0, 0x23, 0x42, 0x1c, 0x72, 0x40, 0x9c, 0x90, 0xb8, 0xa1,
+	0x1e, 0x2b, 0x4e, 0x9c, 0x00, 0x25, 0x87, 0xc0, 0x7f, 0x81, 0xe6, 0xc7, 0x4e, 0xd6, 0xf6, 0x3a,
+	0x0e, 0xa5, 0x17, 0xef, 0xee, 0xbc, 0xef, 0x7d, 0xef, 0x7d, 0x6f, 0xde, 0x1b, 0x0f, 0x6c, 0xf5,
+	0x31, 0x09, 0x31, 0x71, 0x7a, 0x6e, 0x74, 0xe0, 0x1c, 0x36, 0x7a, 0x88, 0xba, 0x0d, 0x87, 0x3e,
+	0xb2, 0x87, 0x31, 0xa6, 0x58, 0x5f, 0x13, 0x56, 0x9b, 0x59, 0x6d, 0x69, 0x35, 0xca, 0x3e, 0xf6,
+	0x31, 0xb7, 0x3b, 0xec, 0x4d, 0x40, 0x0d, 0x53, 0x11, 0x11, 0xa4, 0x88, 0xfa, 0x38, 0x88, 0xa6,
+	0xec, 0x99, 0x40, 0x9c, 0x57, 0xd8, 0x2b, 0xc2, 0xde, 0x15, 0xc4, 0x32, 0xae, 0x30, 0xad, 0x4b,
+	0xd7, 0x90, 0xf8, 0xce, 0x61, 0x83, 0x3d, 0xa4, 0x61, 0xd5, 0x0d, 0x83, 0x08, 0x3b, 0xfc, 0x57,
+	0x2c, 0x59, 0x3f, 0x2c, 0xc0, 0xe5, 0x36, 0xf1, 0x1f, 0xa2, 0xc8, 0xd3, 0xdf, 0x84, 0xd2, 0x5e,
+	0x8c, 0xc3, 0xae, 0xeb, 0x79, 0x31, 0x22, 0x64, 0x43, 0xab, 0x69, 0xf5, 0x62, 0x6b, 0xe3, 0xb7,
+	0x1f, 0x6f, 0x97, 0x25, 0xff, 0xbb, 0xc2, 0xf2, 0x90, 0xc6, 0x41, 0xe4, 0x77, 0x56, 0x18, 0x5a,
+	0x2e, 0xe9, 0x6f, 0x00, 0x50, 0xac, 0x5c, 0x17, 0xe6, 0xb8, 0x16, 0x29, 0x4e, 0x1d, 0x47, 0xb0,
+	0xec, 0x86, 0x38, 0x89, 0xe8, 0xc6, 0x62, 0x6d, 0xb1, 0xbe, 0x72, 0xb7, 0x62, 0xab, 0x22, 0x12,
+	0x94, 0x16, 0xd1, 0xbe, 0x87, 0x83, 0xa8, 0x75, 0xff, 0xc9, 0x1f, 0xd5, 0xc2, 0xf7, 0x7f, 0x56,
+	0xeb, 0x7e, 0x40, 0xf7, 0x93, 0x9e, 0xdd, 0xc7, 0xa1, 0x54, 0x2e, 0x1f, 0xb7, 0x89, 0x77, 0xe0,
+	0xd0, 0xd1, 0x10, 0x11, 0xee, 0x40, 0xbe, 0x39, 0x3d, 0xda, 0x2e, 0x0d, 0x90, 0xef, 0xf6, 0x47,
+	0x5d, 0x56, 0x5b, 0xf2, 0xdd, 0xe9, 0xd1, 0xb6, 0xd6, 0x91, 0x01, 0x9b, 0x77, 0xbe, 0x78, 0x5c,
+	0x2d, 0xfc, 0xfd, 0xb8, 0x5a, 0xf8, 0x9c, 0xe1, 0xb2, 0xda, 0xbf, 0x3c, 0x3d, 0xda, 0xd6, 0x33,
+	0x9c, 0xb2, 0x44, 0xd6, 0x2a, 0x5c, 0x91, 0xaf, 0x1d, 0x44, 0x86, 0x38, 0x22, 0xc8, 0xfa, 0x59,
+	0x83, 0x52, 0x9b, 0xf8, 0xed, 0x64, 0x40, 0x03, 0x5e, 0xc6, 0xb7, 0x60, 0x39, 0x88, 0x86, 0x09,
+	0x65, 0x05, 0x64, 0x82, 0x0c, 0x3b, 0xa7, 0x2b, 0xec, 0xf7, 0x19, 0xa4, 0x55, 0x64, 0x8a, 0x64,
+	0x52, 0xc2, 0x49, 0x7f, 0x07, 0x2e, 0xe3, 0x84, 0x72, 0xff, 0x05, 0xee, 0xbf, 0x99, 0xeb, 0xff,
+	0x41, 0x42, 0x27, 0x08, 0x52, 0xb7, 0xe6, 0xad, 0x54, 0x92, 0xa4, 0x64, 0x62, 0xd6, 0xc7, 0xc5,
+	0xa8, 0x6c, 0xad, 0xab, 0x50, 0xce, 0x7e, 0x2b, 0x59, 0xbf, 0x68, 0x5c, 0xea, 0x47, 0x43, 0xcf,
+	0xa5, 0xe8, 0x43, 0x37, 0x76, 0x43, 0xa2, 0xbf, 0x0e, 0x45, 0x37, 0xa1, 0xfb, 0x38, 0x0e, 0xe8,
+	0x68, 0x6e, 0x77, 0x9c, 0x41, 0xf5, 0xb7, 0x61, 0x79, 0xc8, 0x19, 0x78, 0x5f, 0xcc, 0x52, 0x24,
+	0x82, 0x8c, 0x95, 0x44, 0x78, 0x35, 0x5f, 0x63, 0x62, 0xce, 0xf8, 0x98, 0x9e, 0xeb, 0x19, 0x3d,
+	0x8f, 0xc4, 0x90, 0x4c, 0x64, 0x6b, 0x55, 0x60, 0x7d, 0x62, 0x49, 0x89, 0xfb, 0x47, 0x83, 0x55,
+	0xbe, 0x8f, 0x94, 0x69, 0x7e, 0x2f, 0x72, 0x7b, 0x03, 0xe4, 0x3d, 0xb3, 0xbc, 0x7b, 0x50, 0x22,
+	0x28, 0xf2, 0xba, 0x48, 0xf0, 0xc8, 0x6d, 0xab, 0xe5, 0x8a, 0xcc, 0xc4, 0xeb, 0xac, 0x90, 0x4c,
+	0xf0, 0x9b, 0x70, 0x25, 0x21, 0xa8, 0xeb, 0xa1, 0x3d, 0x37, 0x19, 0xd0, 0xee, 0x1e, 0x8e, 0xf9,
+	0x3c, 0x14, 0x3b, 0x2f, 0x26, 0x04, 0xed, 0x8a, 0xd5, 0xfb, 0x38, 0x6e, 0x3a, 0xd3, 0xb5, 0xd8,
+	0x9a, 0x6c, 0xd4, 0xac, 0x2a, 0x6b, 0x13, 0x2a, 0x53, 0x8b, 0xaa, 0x10, 0x5f, 0x6b, 0x7c, 0xfc,
+	0x5b, 0x49, 0x1c, 0xfd, 0xbf, 0xf1, 0x6f, 0xa8, 0x29, 0x5e, 0x98, 0x33, 0xc5, 0x6a, 0xfa, 0x2a,
+	0x33, 0xa7, 0x4f, 0x8e, 0x19, 0xcb, 0x4a, 0x65, 0xfa, 0xab, 0x06, 0x6b, 0x42, 0xc7, 0x2e, 0x8a,
+	0x70, 0xd8, 0x46, 0xd4, 0xf5, 0x5c, 0xea, 0x3e, 0xf3, 0xa6, 0xed, 0xc2, 0x0b, 0xa1, 0xe4, 0x90,
+	0x5d, 0x79, 0x2d, 0x77, 0xc3, 0xd2, 0x40, 0xd9, 0xbe, 0x54, 0x9e, 0xcd, 0xc6, 0xf4, 0x6e, 0x98,
+	0x53, 0xbb, 0x31, 0x96, 0xb0, 0x75, 0x0d, 0x36, 0x73, 0x96, 0x95, 0xce, 0x6f, 0x35, 0xb8, 0xda,
+	0x26, 0x7e, 0x07, 0x85, 0xf8, 0x10, 0x3d, 0x1f, 0xa9, 0x65, 0x58, 0xf2, 0x18, 0x91, 0x38, 0x95,
+	0x3b, 0xe2, 0x63, 0xde, 0x50, 0xe5, 0xe7, 0x60, 0xd5, 0xc0, 0xcc, 0xb7, 0xa4, 0x02, 0xee, 0xfe,
+	0xb4, 0x04, 0x8b, 0x6d, 0xe2, 0xeb, 0x0f, 0xe0, 0x12, 0x3f, 0x0e, 0xb7, 0xf2, 0xcb, 0x2a, 0x4e,
+	0x51, 0xe3, 0xc6, 0x79, 0xd6, 0x94, 0x53, 0xff, 0x18, 0x8a, 0x67, 0xe7, 0xeb, 0xf5, 0x59, 0x2e,
+	0x0a, 0x62, 0xbc, 0x32, 0x17, 0xa2, 0xa8, 0x1f, 0xc0, 0x25, 0xde, 0xfd, 0x33, 0xd3, 0x64, 0x56,
+	0xe3, 0xc6, 0x79, 0x56, 0xc5, 0xd5, 0x83, 0xd2, 0xd8, 0x79, 0x39, 0xd3, 0x2b, 0x8b, 0x32, 0x5e,
+	0xbd, 0x08, 0x4a, 0xc5, 0xd8, 0x87, 0x97, 0x26, 0x8e, 0xad, 0x9b, 0xb3, 0x4b, 0x98, 0xc5, 0x19,
+	0xf6, 0xc5, 0x70, 0x2a, 0x52, 0x04, 0x2f, 0x4f, 0x4d, 0x5b, 0xfd, 0x1c, 0x8e, 0x31, 0xa4, 0x71,
+	0xe7, 0xa2, 0x48, 0x15, 0xef, 0x53, 0x58, 0xcb, 0xeb, 0xfa, 0x5b, 0xb3, 0x88, 0x72, 0xc0, 0xc6,
+	0xce, 0x7f, 0x00, 0xa7, 0x81, 0x8d, 0xa5, 0xcf, 0xd8, 0x54, 0xb7, 0x76, 0x9e, 0x1c, 0x9b, 0xda,
+	0xd3, 0x63, 0x53, 0xfb, 0xeb, 0xd8, 0xd4, 0xbe, 0x3a, 0x31, 0x0b, 0x4f, 0x4f, 0xcc, 0xc2, 0xef,
+	0x27, 0x66, 0xe1, 0x13, 0x79, 0xd7, 0x22, 0xde, 0x81, 0x1d, 0xe0, 0xf4, 0xef, 0x86, 0x5f, 0x33,
+	0x7a, 0xcb, 0xfc, 0x1a, 0xb5, 0xf3, 0xef, 0x00, 0x33, 0x99, 0x9b, 0x95, 0x18, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -592,6 +787,13 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	SetSendEnabled(ctx context.Context, in *MsgSetSendEnabled, opts ...grpc.CallOption) (*MsgSetSendEnabledResponse, error)
+	// SetDenomMetadata is a governance operation for setting the client metadata
+	// of a coin denomination, e.g. the display denomination of an IBC voucher.
+	// Existing metadata for the same base denom is replaced.
+	SetDenomMetadata(ctx context.Context, in *MsgSetDenomMetadata, opts ...grpc.CallOption) (*MsgSetDenomMetadataResponse, error)
+	// RemoveDenomMetadata is a governance operation for removing the client
+	// metadata of a coin denomination.
+	RemoveDenomMetadata(ctx context.Context, in *MsgRemoveDenomMetadata, opts ...grpc.CallOption) (*MsgRemoveDenomMetadataResponse, error)
 }
 
 type msgClient struct {
@@ -647,6 +849,24 @@ func (c *msgClient) SetSendEnabled(ctx context.Context, in *MsgSetSendEnabled, o
 	return out, nil
 }
 
+func (c *msgClient) SetDenomMetadata(ctx context.Context, in *MsgSetDenomMetadata, opts ...grpc.CallOption) (*MsgSetDenomMetadataResponse, error) {
+	out := new(MsgSetDenomMetadataResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Msg/SetDenomMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveDenomMetadata(ctx context.Context, in *MsgRemoveDenomMetadata, opts ...grpc.CallOption) (*MsgRemoveDenomMetadataResponse, error) {
+	out := new(MsgRemoveDenomMetadataResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Msg/RemoveDenomMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Send defines a method for sending coins from one account to another account.
@@ -669,6 +889,13 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	SetSendEnabled(context.Context, *MsgSetSendEnabled) (*MsgSetSendEnabledResponse, error)
+	// SetDenomMetadata is a governance operation for setting the client metadata
+	// of a coin denomination, e.g. the display denomination of an IBC voucher.
+	// Existing metadata for the same base denom is replaced.
+	SetDenomMetadata(context.Context, *MsgSetDenomMetadata) (*MsgSetDenomMetadataResponse, error)
+	// RemoveDenomMetadata is a governance operation for removing the client
+	// metadata of a coin denomination.
+	RemoveDenomMetadata(context.Context, *MsgRemoveDenomMetadata) (*MsgRemoveDenomMetadataResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -690,6 +917,12 @@ func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateP
 func (*UnimplementedMsgServer) SetSendEnabled(ctx context.Context, req *MsgSetSendEnabled) (*MsgSetSendEnabledResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetSendEnabled not implemented")
 }
+func (*UnimplementedMsgServer) SetDenomMetadata(ctx context.Context, req *MsgSetDenomMetadata) (*MsgSetDenomMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDenomMetadata not implemented")
+}
+func (*UnimplementedMsgServer) RemoveDenomMetadata(ctx context.Context, req *MsgRemoveDenomMetadata) (*MsgRemoveDenomMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveDenomMetadata not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -785,6 +1018,42 @@ func _Msg_SetSendEnabled_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetDenomMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDenomMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDenomMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Msg/SetDenomMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDenomMetadata(ctx, req.(*MsgSetDenomMetadata))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveDenomMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveDenomMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveDenomMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Msg/RemoveDenomMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveDenomMetadata(ctx, req.(*MsgRemoveDenomMetadata))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.bank.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -809,6 +1078,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetSendEnabled",
 			Handler:    _Msg_SetSendEnabled_Handler,
 		},
+		{
+			MethodName: "SetDenomMetadata",
+			Handler:    _Msg_SetDenomMetadata_Handler,
+		},
+		{
+			MethodName: "RemoveDenomMetadata",
+			Handler:    _Msg_RemoveDenomMetadata_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/bank/v1beta1/tx.proto",
@@ -1168,82 +1445,205 @@ func (m *MsgBurnResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgSend) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FromAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ToAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+func (m *MsgSetDenomMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgSendResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgSetDenomMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgMultiSend) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSetDenomMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Inputs) > 0 {
-		for _, e := range m.Inputs {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if len(m.Outputs) > 0 {
-		for _, e := range m.Outputs {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgMultiSendResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetDenomMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDenomMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDenomMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRemoveDenomMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveDenomMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveDenomMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveDenomMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveDenomMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveDenomMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSend) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSendResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMultiSend) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Inputs) > 0 {
+		for _, e := range m.Inputs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Outputs) > 0 {
+		for _, e := range m.Outputs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgMultiSendResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -1327,13 +1727,379 @@ func (m *MsgBurnResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *MsgSetDenomMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetDenomMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveDenomMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveDenomMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSend) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSend: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSend: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMultiSend) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMultiSend: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMultiSend: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Inputs = append(m.Inputs, Input{})
+			if err := m.Inputs[len(m.Inputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Outputs = append(m.Outputs, Output{})
+			if err := m.Outputs[len(m.Outputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgSend) Unmarshal(dAtA []byte) error {
+func (m *MsgMultiSendResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1356,47 +2122,65 @@ func (m *MsgSend) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSend: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMultiSendResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSend: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMultiSendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1424,11 +2208,11 @@ func (m *MsgSend) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1455,8 +2239,7 @@ func (m *MsgSend) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1481,7 +2264,7 @@ func (m *MsgSend) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSendResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1504,10 +2287,10 @@ func (m *MsgSendResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSendResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1531,7 +2314,7 @@ func (m *MsgSendResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMultiSend) Unmarshal(dAtA []byte) error {
+func (m *MsgSetSendEnabled) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1554,17 +2337,17 @@ func (m *MsgMultiSend) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMultiSend: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetSendEnabled: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMultiSend: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetSendEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1574,29 +2357,27 @@ func (m *MsgMultiSend) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Inputs = append(m.Inputs, Input{})
-			if err := m.Inputs[len(m.Inputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SendEnabled", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1623,11 +2404,43 @@ func (m *MsgMultiSend) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Outputs = append(m.Outputs, Output{})
-			if err := m.Outputs[len(m.Outputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SendEnabled = append(m.SendEnabled, &SendEnabled{})
+			if err := m.SendEnabled[len(m.SendEnabled)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseDefaultFor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UseDefaultFor = append(m.UseDefaultFor, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1649,7 +2462,7 @@ func (m *MsgMultiSend) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMultiSendResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetSendEnabledResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1672,10 +2485,10 @@ func (m *MsgMultiSendResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMultiSendResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetSendEnabledResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMultiSendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetSendEnabledResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1699,7 +2512,7 @@ func (m *MsgMultiSendResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1722,15 +2535,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBurn: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBurn: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1758,11 +2571,11 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1789,7 +2602,8 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, &types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1814,7 +2628,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1837,10 +2651,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBurnResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBurnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1864,7 +2678,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetSendEnabled) Unmarshal(dAtA []byte) error {
+func (m *MsgSetDenomMetadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1887,10 +2701,10 @@ func (m *MsgSetSendEnabled) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetSendEnabled: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetDenomMetadata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetSendEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetDenomMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1927,7 +2741,7 @@ func (m *MsgSetSendEnabled) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SendEnabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1954,43 +2768,10 @@ func (m *MsgSetSendEnabled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SendEnabled = append(m.SendEnabled, &SendEnabled{})
-			if err := m.SendEnabled[len(m.SendEnabled)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UseDefaultFor", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.UseDefaultFor = append(m.UseDefaultFor, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2012,7 +2793,7 @@ func (m *MsgSetSendEnabled) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetSendEnabledResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetDenomMetadataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2035,10 +2816,10 @@ func (m *MsgSetSendEnabledResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetSendEnabledResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetDenomMetadataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetSendEnabledResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetDenomMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2062,7 +2843,7 @@ func (m *MsgSetSendEnabledResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBurn) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveDenomMetadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2085,15 +2866,15 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBurn: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveDenomMetadata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBurn: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveDenomMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2121,13 +2902,13 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2137,25 +2918,23 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, &types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2178,7 +2957,7 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveDenomMetadataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2201,10 +2980,10 @@ func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBurnResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveDenomMetadataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBurnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveDenomMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
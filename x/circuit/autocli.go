@@ -30,6 +30,17 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "disabled-list",
 					Short:     "Query a list of all disabled message types",
 				},
+				{
+					RpcMethod: "TripCommittee",
+					Use:       "trip-committee",
+					Short:     "Query the members of the trip committee",
+				},
+				{
+					RpcMethod:      "TripVotes",
+					Use:            "trip-votes [msg_type_url]",
+					Short:          "Query the trip committee members that voted to disable a message type",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "msg_type_url"}},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -67,6 +78,24 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "msg_type_urls", Varargs: true},
 					},
 				},
+				{
+					RpcMethod: "SetTripCommittee",
+					Use:       "set-trip-committee [members]",
+					Short:     "Set the accounts whose supermajority can disable messages",
+					Example:   fmt.Sprintf(`%s circuit set-trip-committee "cosmos1... cosmos1... cosmos1..."`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "members", Varargs: true},
+					},
+				},
+				{
+					RpcMethod: "VoteTripCircuitBreaker",
+					Use:       "vote-disable [msg_type_urls] --from [voter]",
+					Short:     "Vote as a trip committee member to disable messages from being executed",
+					Example:   fmt.Sprintf(`%s circuit vote-disable "cosmos.bank.v1beta1.MsgSend cosmos.bank.v1beta1.MsgMultiSend"`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "msg_type_urls", Varargs: true},
+					},
+				},
 			},
 		},
 	}
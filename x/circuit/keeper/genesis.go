@@ -3,13 +3,16 @@ package keeper
 import (
 	context "context"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/x/circuit/types"
 )
 
 func (k *Keeper) ExportGenesis(ctx context.Context) (data *types.GenesisState, err error) {
 	var (
-		permissions  []*types.GenesisAccountPermissions
-		disabledMsgs []string
+		permissions   []*types.GenesisAccountPermissions
+		disabledMsgs  []string
+		tripCommittee []string
+		tripVotes     []*types.TripVote
 	)
 
 	err = k.Permissions.Walk(ctx, nil, func(address []byte, perm types.Permissions) (stop bool, err error) {
@@ -37,9 +40,38 @@ func (k *Keeper) ExportGenesis(ctx context.Context) (data *types.GenesisState, e
 		return nil, err
 	}
 
+	err = k.TripCommittee.Walk(ctx, nil, func(member []byte) (stop bool, err error) {
+		memberStr, err := k.addressCodec.BytesToString(member)
+		if err != nil {
+			return true, err
+		}
+		tripCommittee = append(tripCommittee, memberStr)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.TripVotes.Walk(ctx, nil, func(key collections.Pair[string, []byte]) (stop bool, err error) {
+		voter, err := k.addressCodec.BytesToString(key.K2())
+		if err != nil {
+			return true, err
+		}
+		tripVotes = append(tripVotes, &types.TripVote{
+			MsgTypeUrl: key.K1(),
+			Voter:      voter,
+		})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &types.GenesisState{
 		AccountPermissions: permissions,
 		DisabledTypeUrls:   disabledMsgs,
+		TripCommittee:      tripCommittee,
+		TripVotes:          tripVotes,
 	}, nil
 }
 
@@ -62,6 +94,26 @@ func (k *Keeper) InitGenesis(ctx context.Context, genState *types.GenesisState)
 			return err
 		}
 	}
+	for _, member := range genState.TripCommittee {
+		add, err := k.addressCodec.StringToBytes(member)
+		if err != nil {
+			return err
+		}
+
+		if err := k.TripCommittee.Set(ctx, add); err != nil {
+			return err
+		}
+	}
+	for _, vote := range genState.TripVotes {
+		voter, err := k.addressCodec.StringToBytes(vote.Voter)
+		if err != nil {
+			return err
+		}
+
+		if err := k.TripVotes.Set(ctx, collections.Join(vote.MsgTypeUrl, voter)); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
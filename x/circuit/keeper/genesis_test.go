@@ -70,10 +70,15 @@ func (s *GenesisTestSuite) TestInitExportGenesis() {
 
 	url := "test_url"
 
+	member := sdk.AccAddress(s.addrBytes).String()
+
 	genesisState := &types.GenesisState{
 		AccountPermissions: accounts,
 		DisabledTypeUrls:   []string{url},
+		TripCommittee:      []string{member},
+		TripVotes:          []*types.TripVote{{MsgTypeUrl: "test_vote_url", Voter: member}},
 	}
+	s.Require().NoError(genesisState.Validate())
 
 	err = s.keeper.InitGenesis(s.ctx, genesisState)
 	s.Require().NoError(err)
@@ -89,4 +94,6 @@ func (s *GenesisTestSuite) TestInitExportGenesis() {
 
 	s.Require().Equal(genesisState.AccountPermissions, exportedGenesisState.AccountPermissions)
 	s.Require().Equal(genesisState.DisabledTypeUrls, exportedGenesisState.DisabledTypeUrls)
+	s.Require().Equal(genesisState.TripCommittee, exportedGenesisState.TripCommittee)
+	s.Require().Equal(genesisState.TripVotes, exportedGenesisState.TripVotes)
 }
@@ -25,6 +25,10 @@ type Keeper struct {
 	Permissions collections.Map[[]byte, types.Permissions]
 	// DisableList contains the message URLs that are disabled
 	DisableList collections.KeySet[string]
+	// TripCommittee contains the accounts whose supermajority can trip the circuit breaker
+	TripCommittee collections.KeySet[[]byte]
+	// TripVotes contains the trip committee votes, keyed by message URL and voter
+	TripVotes collections.KeySet[collections.Pair[string, []byte]]
 }
 
 // NewKeeper constructs a new Circuit Keeper instance
@@ -54,6 +58,18 @@ func NewKeeper(env appmodule.Environment, cdc codec.BinaryCodec, authority strin
 			"disable_list",
 			collections.StringKey,
 		),
+		TripCommittee: collections.NewKeySet(
+			sb,
+			types.TripCommitteePrefix,
+			"trip_committee",
+			collections.BytesKey,
+		),
+		TripVotes: collections.NewKeySet(
+			sb,
+			types.TripVotesPrefix,
+			"trip_votes",
+			collections.PairKeyCodec(collections.StringKey, collections.BytesKey),
+		),
 	}
 
 	schema, err := sb.Build()
@@ -69,6 +85,21 @@ func (k *Keeper) GetAuthority() []byte {
 	return k.authority
 }
 
+// IsTripCommitteeSupermajority returns true when the given number of votes is
+// more than two thirds of the trip committee members.
+func (k *Keeper) IsTripCommitteeSupermajority(ctx context.Context, votes int) (bool, error) {
+	members := 0
+	err := k.TripCommittee.Walk(ctx, nil, func([]byte) (bool, error) {
+		members++
+		return false, nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return members > 0 && 3*votes > 2*members, nil
+}
+
 // IsAllowed returns true when msg URL is not found in the DisableList for given context, else false.
 func (k *Keeper) IsAllowed(ctx context.Context, msgURL string) (bool, error) {
 	has, err := k.DisableList.Has(ctx, msgURL)
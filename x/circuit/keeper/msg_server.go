@@ -189,6 +189,156 @@ func (srv msgServer) ResetCircuitBreaker(ctx context.Context, msg *types.MsgRese
 	return &types.MsgResetCircuitBreakerResponse{Success: true}, nil
 }
 
+// SetTripCommittee sets the permissioned set of accounts whose supermajority
+// can trip the circuit breaker.
+func (srv msgServer) SetTripCommittee(ctx context.Context, msg *types.MsgSetTripCommittee) (*types.MsgSetTripCommitteeResponse, error) {
+	address, err := srv.addressCodec.StringToBytes(msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+
+	// if the sender is the module authority no need to check perms
+	if !bytes.Equal(address, srv.GetAuthority()) {
+		// Check that the sender has the permission level of "super admin"
+		perms, err := srv.Permissions.Get(ctx, address)
+		if err != nil {
+			if errorsmod.IsOf(err, collections.ErrNotFound) {
+				return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only super admins can set the trip committee")
+			}
+
+			return nil, err
+		}
+
+		if perms.Level != types.Permissions_LEVEL_SUPER_ADMIN {
+			return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only super admins can set the trip committee")
+		}
+	}
+
+	members := make([][]byte, 0, len(msg.Members))
+	seen := make(map[string]bool, len(msg.Members))
+	for _, member := range msg.Members {
+		if seen[member] {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate trip committee member %s", member)
+		}
+		seen[member] = true
+
+		memberAddr, err := srv.addressCodec.StringToBytes(member)
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, memberAddr)
+	}
+
+	// the new committee replaces the existing one along with its pending votes
+	if err = srv.TripCommittee.Clear(ctx, nil); err != nil {
+		return nil, err
+	}
+	if err = srv.TripVotes.Clear(ctx, nil); err != nil {
+		return nil, err
+	}
+	for _, member := range members {
+		if err = srv.TripCommittee.Set(ctx, member); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = srv.Keeper.env.EventService.EventManager(ctx).EmitKV(
+		"set_trip_committee",
+		event.NewAttribute("authority", msg.Authority),
+		event.NewAttribute("members", strings.Join(msg.Members, ",")),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetTripCommitteeResponse{}, nil
+}
+
+// VoteTripCircuitBreaker records a trip committee member's vote to pause
+// processing of Msg's, and trips the circuit breaker for the Msg type URLs
+// reaching the supermajority of the trip committee.
+func (srv msgServer) VoteTripCircuitBreaker(ctx context.Context, msg *types.MsgVoteTripCircuitBreaker) (*types.MsgVoteTripCircuitBreakerResponse, error) {
+	voter, err := srv.addressCodec.StringToBytes(msg.Voter)
+	if err != nil {
+		return nil, err
+	}
+
+	isMember, err := srv.TripCommittee.Has(ctx, voter)
+	if err != nil {
+		return nil, err
+	}
+	if !isMember {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "account is not a trip committee member")
+	}
+
+	if len(msg.MsgTypeUrls) == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "msg type urls cannot be empty")
+	}
+
+	var tripped []string
+	for _, msgTypeURL := range msg.MsgTypeUrls {
+		// check if the message is in the list of allowed messages
+		isAllowed, err := srv.IsAllowed(ctx, msgTypeURL)
+		if err != nil {
+			return nil, err
+		}
+
+		if !isAllowed {
+			return nil, fmt.Errorf("message %s is already disabled", msgTypeURL)
+		}
+
+		if err = srv.TripVotes.Set(ctx, collections.Join(msgTypeURL, voter)); err != nil {
+			return nil, err
+		}
+
+		votes := 0
+		rng := collections.NewPrefixedPairRange[string, []byte](msgTypeURL)
+		err = srv.TripVotes.Walk(ctx, rng, func(collections.Pair[string, []byte]) (bool, error) {
+			votes++
+			return false, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		isSupermajority, err := srv.IsTripCommitteeSupermajority(ctx, votes)
+		if err != nil {
+			return nil, err
+		}
+		if !isSupermajority {
+			continue
+		}
+
+		if err = srv.DisableList.Set(ctx, msgTypeURL); err != nil {
+			return nil, err
+		}
+		if err = srv.TripVotes.Clear(ctx, rng); err != nil {
+			return nil, err
+		}
+		tripped = append(tripped, msgTypeURL)
+	}
+
+	eventManager := srv.Keeper.env.EventService.EventManager(ctx)
+	if err = eventManager.EmitKV(
+		"vote_trip_circuit_breaker",
+		event.NewAttribute("voter", msg.Voter),
+		event.NewAttribute("msg_url", strings.Join(msg.MsgTypeUrls, ",")),
+	); err != nil {
+		return nil, err
+	}
+
+	if len(tripped) > 0 {
+		if err = eventManager.EmitKV(
+			"trip_circuit_breaker",
+			event.NewAttribute("authority", "trip_committee"),
+			event.NewAttribute("msg_url", strings.Join(tripped, ",")),
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgVoteTripCircuitBreakerResponse{TrippedMsgTypeUrls: tripped}, nil
+}
+
 // hasPermissionForMsg returns true if the account can trip or reset the message.
 func hasPermissionForMsg(perms types.Permissions, msg string) bool {
 	for _, msgurl := range perms.LimitTypeUrls {
@@ -373,3 +373,116 @@ func TestResetCircuitBreakerSomeMsgs(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, allowed, "circuit breaker should be reset")
 }
+
+func TestSetTripCommittee(t *testing.T) {
+	ft := initFixture(t)
+
+	srv := keeper.NewMsgServerImpl(ft.keeper)
+	authority, err := ft.ac.BytesToString(ft.mockAddr)
+	require.NoError(t, err)
+
+	// user without permissions tries to set the trip committee
+	msg := &types.MsgSetTripCommittee{Authority: addresses[0], Members: addresses[1:3]}
+	_, err = srv.SetTripCommittee(ft.ctx, msg)
+	require.ErrorContains(t, err, "only super admins can set the trip committee")
+
+	// duplicate members
+	msg = &types.MsgSetTripCommittee{Authority: authority, Members: []string{addresses[1], addresses[1]}}
+	_, err = srv.SetTripCommittee(ft.ctx, msg)
+	require.ErrorContains(t, err, "duplicate trip committee member")
+
+	// authority sets the trip committee
+	msg = &types.MsgSetTripCommittee{Authority: authority, Members: addresses[1:3]}
+	_, err = srv.SetTripCommittee(ft.ctx, msg)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		sdk.NewEvent(
+			"set_trip_committee",
+			sdk.NewAttribute("authority", authority),
+			sdk.NewAttribute("members", addresses[1]+","+addresses[2]),
+		),
+		lastEvent(ft.ctx),
+	)
+
+	add1, err := ft.ac.StringToBytes(addresses[1])
+	require.NoError(t, err)
+	isMember, err := ft.keeper.TripCommittee.Has(ft.ctx, add1)
+	require.NoError(t, err)
+	require.True(t, isMember)
+
+	// pending votes are cleared along with the previous committee
+	require.NoError(t, ft.keeper.TripVotes.Set(ft.ctx, collections.Join(msgSend, add1)))
+
+	// super admin replaces the trip committee
+	add0, err := ft.ac.StringToBytes(addresses[0])
+	require.NoError(t, err)
+	require.NoError(t, ft.keeper.Permissions.Set(ft.ctx, add0, types.Permissions{Level: types.Permissions_LEVEL_SUPER_ADMIN}))
+	msg = &types.MsgSetTripCommittee{Authority: addresses[0], Members: addresses[3:]}
+	_, err = srv.SetTripCommittee(ft.ctx, msg)
+	require.NoError(t, err)
+
+	isMember, err = ft.keeper.TripCommittee.Has(ft.ctx, add1)
+	require.NoError(t, err)
+	require.False(t, isMember)
+	hasVote, err := ft.keeper.TripVotes.Has(ft.ctx, collections.Join(msgSend, add1))
+	require.NoError(t, err)
+	require.False(t, hasVote)
+}
+
+func TestVoteTripCircuitBreaker(t *testing.T) {
+	ft := initFixture(t)
+
+	srv := keeper.NewMsgServerImpl(ft.keeper)
+	authority, err := ft.ac.BytesToString(ft.mockAddr)
+	require.NoError(t, err)
+
+	_, err = srv.SetTripCommittee(ft.ctx, &types.MsgSetTripCommittee{Authority: authority, Members: addresses[1:]})
+	require.NoError(t, err)
+
+	// non member tries to vote
+	_, err = srv.VoteTripCircuitBreaker(ft.ctx, &types.MsgVoteTripCircuitBreaker{Voter: addresses[0], MsgTypeUrls: []string{msgSend}})
+	require.ErrorContains(t, err, "account is not a trip committee member")
+
+	// member votes without msg type urls
+	_, err = srv.VoteTripCircuitBreaker(ft.ctx, &types.MsgVoteTripCircuitBreaker{Voter: addresses[1]})
+	require.ErrorContains(t, err, "msg type urls cannot be empty")
+
+	// two out of four members, voting twice doesn't count twice
+	for _, voter := range []string{addresses[1], addresses[1], addresses[2]} {
+		res, err := srv.VoteTripCircuitBreaker(ft.ctx, &types.MsgVoteTripCircuitBreaker{Voter: voter, MsgTypeUrls: []string{msgSend}})
+		require.NoError(t, err)
+		require.Empty(t, res.TrippedMsgTypeUrls)
+	}
+	allowed, err := ft.keeper.IsAllowed(ft.ctx, msgSend)
+	require.NoError(t, err)
+	require.True(t, allowed, "two votes out of four don't trip the circuit breaker")
+
+	// the third vote reaches the supermajority
+	res, err := srv.VoteTripCircuitBreaker(ft.ctx, &types.MsgVoteTripCircuitBreaker{Voter: addresses[3], MsgTypeUrls: []string{msgSend}})
+	require.NoError(t, err)
+	require.Equal(t, []string{msgSend}, res.TrippedMsgTypeUrls)
+	require.Equal(
+		t,
+		sdk.NewEvent(
+			"trip_circuit_breaker",
+			sdk.NewAttribute("authority", "trip_committee"),
+			sdk.NewAttribute("msg_url", msgSend),
+		),
+		lastEvent(ft.ctx),
+	)
+
+	allowed, err = ft.keeper.IsAllowed(ft.ctx, msgSend)
+	require.NoError(t, err)
+	require.False(t, allowed, "circuit breaker is tripped by the supermajority")
+
+	add1, err := ft.ac.StringToBytes(addresses[1])
+	require.NoError(t, err)
+	hasVote, err := ft.keeper.TripVotes.Has(ft.ctx, collections.Join(msgSend, add1))
+	require.NoError(t, err)
+	require.False(t, hasVote, "votes are cleared once the circuit breaker is tripped")
+
+	// member votes for an already disabled message
+	_, err = srv.VoteTripCircuitBreaker(ft.ctx, &types.MsgVoteTripCircuitBreaker{Voter: addresses[4], MsgTypeUrls: []string{msgSend}})
+	require.ErrorContains(t, err, "already disabled")
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/x/circuit/types"
 
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -73,3 +74,41 @@ func (qs QueryServer) DisabledList(ctx context.Context, req *types.QueryDisabled
 
 	return &types.DisabledListResponse{DisabledList: msgs}, nil
 }
+
+// TripCommittee returns the members of the trip committee.
+func (qs QueryServer) TripCommittee(ctx context.Context, req *types.QueryTripCommitteeRequest) (*types.TripCommitteeResponse, error) {
+	var members []string
+	err := qs.keeper.TripCommittee.Walk(ctx, nil, func(member []byte) (bool, error) {
+		memberStr, err := qs.keeper.addressCodec.BytesToString(member)
+		if err != nil {
+			return true, err
+		}
+		members = append(members, memberStr)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.TripCommitteeResponse{Members: members}, nil
+}
+
+// TripVotes returns the trip committee members that voted to trip the circuit
+// breaker for a message url.
+func (qs QueryServer) TripVotes(ctx context.Context, req *types.QueryTripVotesRequest) (*types.TripVotesResponse, error) {
+	var voters []string
+	rng := collections.NewPrefixedPairRange[string, []byte](req.MsgTypeUrl)
+	err := qs.keeper.TripVotes.Walk(ctx, rng, func(key collections.Pair[string, []byte]) (bool, error) {
+		voter, err := qs.keeper.addressCodec.BytesToString(key.K2())
+		if err != nil {
+			return true, err
+		}
+		voters = append(voters, voter)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.TripVotesResponse{Voters: voters}, nil
+}
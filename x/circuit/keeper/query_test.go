@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/x/circuit/keeper"
 	"cosmossdk.io/x/circuit/types"
 
@@ -98,3 +99,32 @@ func TestQueryDisabledList(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, []string{f.mockMsgURL}, disabledList.DisabledList)
 }
+
+func TestQueryTripCommitteeAndVotes(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	// create a new query server
+	qs := keeper.NewQueryServer(f.keeper)
+
+	committee, err := qs.TripCommittee(f.ctx, &types.QueryTripCommitteeRequest{})
+	require.NoError(t, err)
+	require.Len(t, committee.Members, 0)
+
+	add1, err := f.ac.StringToBytes(addresses[1])
+	require.NoError(t, err)
+	add2, err := f.ac.StringToBytes(addresses[2])
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.TripCommittee.Set(f.ctx, add1))
+	require.NoError(t, f.keeper.TripCommittee.Set(f.ctx, add2))
+	require.NoError(t, f.keeper.TripVotes.Set(f.ctx, collections.Join(f.mockMsgURL, add2)))
+	require.NoError(t, f.keeper.TripVotes.Set(f.ctx, collections.Join("other_url", add1)))
+
+	committee, err = qs.TripCommittee(f.ctx, &types.QueryTripCommitteeRequest{})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{addresses[1], addresses[2]}, committee.Members)
+
+	votes, err := qs.TripVotes(f.ctx, &types.QueryTripVotesRequest{MsgTypeUrl: f.mockMsgURL})
+	require.NoError(t, err)
+	require.Equal(t, []string{addresses[2]}, votes.Voters)
+}
@@ -13,6 +13,8 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 		&MsgAuthorizeCircuitBreaker{},
 		&MsgResetCircuitBreaker{},
 		&MsgTripCircuitBreaker{},
+		&MsgSetTripCommittee{},
+		&MsgVoteTripCircuitBreaker{},
 	)
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
 }
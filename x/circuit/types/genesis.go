@@ -20,6 +20,26 @@ func (gs *GenesisState) Validate() error {
 		}
 	}
 
+	members := make(map[string]bool, len(gs.TripCommittee))
+	for _, member := range gs.TripCommittee {
+		if member == "" {
+			return fmt.Errorf("invalid trip committee member address: %s", member)
+		}
+		if members[member] {
+			return fmt.Errorf("duplicate trip committee member address: %s", member)
+		}
+		members[member] = true
+	}
+
+	for _, vote := range gs.TripVotes {
+		if vote.MsgTypeUrl == "" {
+			return fmt.Errorf("trip vote has empty msg type url, voter address: %s", vote.Voter)
+		}
+		if !members[vote.Voter] {
+			return fmt.Errorf("trip vote voter is not a trip committee member, voter address: %s", vote.Voter)
+		}
+	}
+
 	return nil
 }
 
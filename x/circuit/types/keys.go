@@ -14,4 +14,6 @@ const (
 var (
 	AccountPermissionPrefix = collections.NewPrefix(1)
 	DisableListPrefix       = collections.NewPrefix(2)
+	TripCommitteePrefix     = collections.NewPrefix(3)
+	TripVotesPrefix         = collections.NewPrefix(4)
 )
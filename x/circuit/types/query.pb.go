@@ -301,6 +301,178 @@ func (m *DisabledListResponse) GetDisabledList() []string {
 	return nil
 }
 
+// QueryTripCommitteeRequest is the request type for the Query/TripCommittee RPC method.
+type QueryTripCommitteeRequest struct {
+}
+
+func (m *QueryTripCommitteeRequest) Reset()         { *m = QueryTripCommitteeRequest{} }
+func (m *QueryTripCommitteeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTripCommitteeRequest) ProtoMessage()    {}
+func (*QueryTripCommitteeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87c65073a3d3c1e1, []int{6}
+}
+func (m *QueryTripCommitteeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTripCommitteeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTripCommitteeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTripCommitteeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTripCommitteeRequest.Merge(m, src)
+}
+func (m *QueryTripCommitteeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTripCommitteeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTripCommitteeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTripCommitteeRequest proto.InternalMessageInfo
+
+// TripCommitteeResponse is the response type for the Query/TripCommittee RPC method.
+type TripCommitteeResponse struct {
+	Members []string `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+}
+
+func (m *TripCommitteeResponse) Reset()         { *m = TripCommitteeResponse{} }
+func (m *TripCommitteeResponse) String() string { return proto.CompactTextString(m) }
+func (*TripCommitteeResponse) ProtoMessage()    {}
+func (*TripCommitteeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87c65073a3d3c1e1, []int{7}
+}
+func (m *TripCommitteeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TripCommitteeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TripCommitteeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TripCommitteeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TripCommitteeResponse.Merge(m, src)
+}
+func (m *TripCommitteeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TripCommitteeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TripCommitteeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TripCommitteeResponse proto.InternalMessageInfo
+
+func (m *TripCommitteeResponse) GetMembers() []string {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+// QueryTripVotesRequest is the request type for the Query/TripVotes RPC method.
+type QueryTripVotesRequest struct {
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+}
+
+func (m *QueryTripVotesRequest) Reset()         { *m = QueryTripVotesRequest{} }
+func (m *QueryTripVotesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTripVotesRequest) ProtoMessage()    {}
+func (*QueryTripVotesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87c65073a3d3c1e1, []int{8}
+}
+func (m *QueryTripVotesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTripVotesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTripVotesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTripVotesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTripVotesRequest.Merge(m, src)
+}
+func (m *QueryTripVotesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTripVotesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTripVotesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTripVotesRequest proto.InternalMessageInfo
+
+func (m *QueryTripVotesRequest) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+// TripVotesResponse is the response type for the Query/TripVotes RPC method.
+type TripVotesResponse struct {
+	Voters []string `protobuf:"bytes,1,rep,name=voters,proto3" json:"voters,omitempty"`
+}
+
+func (m *TripVotesResponse) Reset()         { *m = TripVotesResponse{} }
+func (m *TripVotesResponse) String() string { return proto.CompactTextString(m) }
+func (*TripVotesResponse) ProtoMessage()    {}
+func (*TripVotesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87c65073a3d3c1e1, []int{9}
+}
+func (m *TripVotesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TripVotesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TripVotesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TripVotesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TripVotesResponse.Merge(m, src)
+}
+func (m *TripVotesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TripVotesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TripVotesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TripVotesResponse proto.InternalMessageInfo
+
+func (m *TripVotesResponse) GetVoters() []string {
+	if m != nil {
+		return m.Voters
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryAccountRequest)(nil), "cosmos.circuit.v1.QueryAccountRequest")
 	proto.RegisterType((*AccountResponse)(nil), "cosmos.circuit.v1.AccountResponse")
@@ -308,45 +480,58 @@ func init() {
 	proto.RegisterType((*AccountsResponse)(nil), "cosmos.circuit.v1.AccountsResponse")
 	proto.RegisterType((*QueryDisabledListRequest)(nil), "cosmos.circuit.v1.QueryDisabledListRequest")
 	proto.RegisterType((*DisabledListResponse)(nil), "cosmos.circuit.v1.DisabledListResponse")
+	proto.RegisterType((*QueryTripCommitteeRequest)(nil), "cosmos.circuit.v1.QueryTripCommitteeRequest")
+	proto.RegisterType((*TripCommitteeResponse)(nil), "cosmos.circuit.v1.TripCommitteeResponse")
+	proto.RegisterType((*QueryTripVotesRequest)(nil), "cosmos.circuit.v1.QueryTripVotesRequest")
+	proto.RegisterType((*TripVotesResponse)(nil), "cosmos.circuit.v1.TripVotesResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/circuit/v1/query.proto", fileDescriptor_87c65073a3d3c1e1) }
 
 var fileDescriptor_87c65073a3d3c1e1 = []byte{
-	// 516 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0xc1, 0x6b, 0x13, 0x4f,
-	0x14, 0xc7, 0x33, 0x2d, 0xbf, 0x5f, 0xdb, 0xd7, 0x8a, 0x3a, 0xf6, 0x10, 0xb6, 0xed, 0x5a, 0xb7,
-	0xd8, 0x84, 0x5a, 0x76, 0x48, 0x04, 0x2f, 0x82, 0xa0, 0x88, 0xf5, 0xe0, 0xa1, 0xdd, 0xa3, 0x07,
-	0x65, 0xb2, 0x3b, 0x84, 0xc1, 0x74, 0x67, 0xbb, 0x6f, 0x13, 0x2c, 0xe2, 0xa5, 0x27, 0xbd, 0x89,
-	0xfe, 0x0d, 0x1e, 0x05, 0xff, 0x0c, 0x8f, 0x05, 0x2f, 0x1e, 0x25, 0x11, 0xfc, 0x37, 0xa4, 0xb3,
-	0x33, 0x9b, 0x8d, 0x9d, 0xda, 0xe3, 0xcc, 0xbc, 0xef, 0xcb, 0xe7, 0xfb, 0xbe, 0x2f, 0x0b, 0x1b,
-	0xb1, 0xc2, 0x43, 0x85, 0x2c, 0x96, 0x79, 0x3c, 0x94, 0x05, 0x1b, 0x75, 0xd8, 0xd1, 0x50, 0xe4,
-	0xc7, 0x61, 0x96, 0xab, 0x42, 0xd1, 0xeb, 0xe5, 0x73, 0x68, 0x9e, 0xc3, 0x51, 0xc7, 0xdb, 0x31,
-	0x8a, 0x1e, 0x47, 0x51, 0xd6, 0xb2, 0x51, 0xa7, 0x27, 0x0a, 0xde, 0x61, 0x19, 0xef, 0xcb, 0x94,
-	0x17, 0x52, 0xa5, 0xa5, 0xdc, 0x73, 0x74, 0x2f, 0x8e, 0x33, 0x81, 0xe6, 0x79, 0xbd, 0xaf, 0x54,
-	0x7f, 0x20, 0x18, 0xcf, 0x24, 0xe3, 0x69, 0xaa, 0x0a, 0xad, 0xb5, 0xaf, 0x6b, 0x46, 0x6c, 0x7f,
-	0xa3, 0x0e, 0x16, 0x30, 0xb8, 0x71, 0x70, 0x76, 0x7c, 0x18, 0xc7, 0x6a, 0x98, 0x16, 0x91, 0x38,
-	0x1a, 0x0a, 0x2c, 0x68, 0x13, 0x16, 0x78, 0x92, 0xe4, 0x02, 0xb1, 0x49, 0x36, 0x49, 0x7b, 0x29,
-	0xb2, 0xc7, 0xe0, 0x00, 0xae, 0x56, 0xb5, 0x98, 0xa9, 0x14, 0x05, 0x7d, 0x00, 0x90, 0x89, 0xfc,
-	0x50, 0x22, 0x4a, 0x95, 0xea, 0xfa, 0xe5, 0xae, 0x1f, 0x9e, 0x73, 0x1c, 0xee, 0x57, 0x45, 0x18,
-	0xd5, 0x14, 0xc1, 0x0b, 0x58, 0xad, 0x33, 0xa0, 0x85, 0x78, 0x02, 0x30, 0x9d, 0x84, 0xe9, 0xbb,
-	0x6d, 0xfb, 0x9e, 0x8d, 0x2d, 0x2c, 0x9d, 0x98, 0xb1, 0x85, 0xfb, 0xbc, 0x2f, 0x8c, 0x36, 0xaa,
-	0x29, 0x83, 0xcf, 0x04, 0xae, 0x4d, 0x7b, 0x1b, 0xe8, 0xa7, 0xb0, 0xc8, 0xcd, 0x5d, 0x93, 0x6c,
-	0xce, 0xb7, 0x97, 0xbb, 0xbb, 0x0e, 0xe4, 0x3d, 0x91, 0x0a, 0x94, 0x68, 0xd4, 0x75, 0x03, 0x95,
-	0x9a, 0xee, 0xcd, 0x60, 0xce, 0x69, 0xcc, 0xd6, 0xa5, 0x98, 0x25, 0xc6, 0x0c, 0xa7, 0x07, 0x4d,
-	0x3d, 0x87, 0xc7, 0x12, 0x79, 0x6f, 0x20, 0x92, 0x67, 0x12, 0x6d, 0x20, 0xc1, 0x7d, 0x58, 0x9d,
-	0xbd, 0x36, 0x36, 0xb6, 0xe0, 0x4a, 0x62, 0xee, 0x5f, 0x0e, 0x24, 0x16, 0xda, 0xcb, 0x52, 0xb4,
-	0x92, 0xd4, 0x8a, 0xbb, 0x5f, 0xe6, 0xe1, 0x3f, 0xdd, 0x99, 0xbe, 0x27, 0xb0, 0x60, 0xcc, 0xd0,
-	0x6d, 0x87, 0x5f, 0xc7, 0x2e, 0x78, 0x81, 0xa3, 0xee, 0xaf, 0x15, 0x08, 0xba, 0xef, 0x7e, 0x7f,
-	0xdd, 0x21, 0x27, 0xdf, 0x7f, 0x7d, 0x9a, 0x6b, 0xd1, 0xdb, 0xec, 0xfc, 0xba, 0xda, 0x69, 0xb1,
-	0x37, 0x66, 0x91, 0xde, 0xd2, 0x13, 0x02, 0x8b, 0x36, 0x16, 0xda, 0xba, 0x04, 0xc6, 0x2e, 0x85,
-	0xb7, 0x75, 0x31, 0x4d, 0x15, 0x6e, 0xd0, 0x9e, 0xe2, 0x6c, 0xd0, 0xb5, 0x7f, 0xe0, 0xd0, 0x8f,
-	0x04, 0x56, 0xea, 0x83, 0xa5, 0x77, 0x2e, 0x02, 0x71, 0xa4, 0xe2, 0xb9, 0xa8, 0x5d, 0x31, 0x05,
-	0xbb, 0x53, 0xa0, 0x5b, 0xf4, 0xa6, 0x03, 0xc8, 0xe4, 0xa5, 0x33, 0x7c, 0x74, 0xef, 0xdb, 0xd8,
-	0x27, 0xa7, 0x63, 0x9f, 0xfc, 0x1c, 0xfb, 0xe4, 0xc3, 0xc4, 0x6f, 0x9c, 0x4e, 0xfc, 0xc6, 0x8f,
-	0x89, 0xdf, 0x78, 0xbe, 0x5e, 0x2a, 0x31, 0x79, 0x15, 0x4a, 0xc5, 0x5e, 0x57, 0x1d, 0xf4, 0xd7,
-	0xa0, 0xf7, 0xbf, 0xfe, 0x4f, 0xdf, 0xfd, 0x13, 0x00, 0x00, 0xff, 0xff, 0xd7, 0xdd, 0xdb, 0x03,
-	0x8d, 0x04, 0x00, 0x00,
+	// 660 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0xcf, 0x4f, 0xd4, 0x40,
+	0x14, 0xc7, 0x19, 0x0c, 0xbf, 0x1e, 0x10, 0x65, 0x04, 0xb3, 0x16, 0x28, 0x30, 0x28, 0x6c, 0x80,
+	0xb4, 0xd9, 0x35, 0x31, 0x31, 0x26, 0x26, 0xa8, 0x11, 0x0f, 0x1e, 0x60, 0x83, 0x1e, 0x3c, 0xb8,
+	0xe9, 0xb6, 0x93, 0x66, 0xe2, 0xb6, 0x53, 0x3a, 0xb3, 0x1b, 0x89, 0xf1, 0xc2, 0x45, 0xbd, 0x19,
+	0x3d, 0xf8, 0x17, 0x78, 0xf7, 0xcf, 0xf0, 0x88, 0xf1, 0xe2, 0xd1, 0x80, 0x89, 0xff, 0x86, 0xd9,
+	0x76, 0xfa, 0x63, 0x61, 0x16, 0x8e, 0x33, 0xf3, 0xbe, 0xef, 0x7d, 0xde, 0x7b, 0xdf, 0x16, 0x16,
+	0x5d, 0x2e, 0x02, 0x2e, 0x6c, 0x97, 0xc5, 0x6e, 0x87, 0x49, 0xbb, 0x5b, 0xb3, 0x0f, 0x3a, 0x34,
+	0x3e, 0xb4, 0xa2, 0x98, 0x4b, 0x8e, 0x67, 0xd2, 0x67, 0x4b, 0x3d, 0x5b, 0xdd, 0x9a, 0xb1, 0xa1,
+	0x14, 0x2d, 0x47, 0xd0, 0x34, 0xd6, 0xee, 0xd6, 0x5a, 0x54, 0x3a, 0x35, 0x3b, 0x72, 0x7c, 0x16,
+	0x3a, 0x92, 0xf1, 0x30, 0x95, 0x1b, 0x9a, 0xec, 0xf2, 0x30, 0xa2, 0x42, 0x3d, 0x2f, 0xf8, 0x9c,
+	0xfb, 0x6d, 0x6a, 0x3b, 0x11, 0xb3, 0x9d, 0x30, 0xe4, 0x32, 0xd1, 0x66, 0xaf, 0xf3, 0x4a, 0x9c,
+	0xd5, 0x28, 0x83, 0x11, 0x1b, 0xae, 0xef, 0xf5, 0x8e, 0xdb, 0xae, 0xcb, 0x3b, 0xa1, 0x6c, 0xd0,
+	0x83, 0x0e, 0x15, 0x12, 0x57, 0x60, 0xcc, 0xf1, 0xbc, 0x98, 0x0a, 0x51, 0x41, 0xcb, 0xa8, 0x3a,
+	0xd1, 0xc8, 0x8e, 0x64, 0x0f, 0xae, 0xe6, 0xb1, 0x22, 0xe2, 0xa1, 0xa0, 0xf8, 0x01, 0x40, 0x44,
+	0xe3, 0x80, 0x09, 0xc1, 0x78, 0x98, 0xc4, 0x4f, 0xd6, 0x4d, 0xeb, 0x5c, 0xc7, 0xd6, 0x6e, 0x1e,
+	0x24, 0x1a, 0x25, 0x05, 0x79, 0x05, 0xb3, 0x65, 0x06, 0x91, 0x41, 0x3c, 0x01, 0x28, 0x26, 0xa1,
+	0xf2, 0xae, 0x65, 0x79, 0x7b, 0x63, 0xb3, 0xd2, 0x4e, 0xd4, 0xd8, 0xac, 0x5d, 0xc7, 0xa7, 0x4a,
+	0xdb, 0x28, 0x29, 0xc9, 0x37, 0x04, 0xd7, 0x8a, 0xdc, 0x0a, 0xfa, 0x29, 0x8c, 0x3b, 0xea, 0xae,
+	0x82, 0x96, 0xaf, 0x54, 0x27, 0xeb, 0x5b, 0x1a, 0xe4, 0x1d, 0x1a, 0x52, 0xc1, 0x84, 0x52, 0x97,
+	0x1b, 0xc8, 0xd5, 0x78, 0xa7, 0x0f, 0x73, 0x38, 0xc1, 0x5c, 0xbf, 0x14, 0x33, 0xc5, 0xe8, 0xe3,
+	0x34, 0xa0, 0x92, 0xcc, 0xe1, 0x31, 0x13, 0x4e, 0xab, 0x4d, 0xbd, 0x67, 0x4c, 0x64, 0x0b, 0x21,
+	0xf7, 0x61, 0xb6, 0xff, 0x5a, 0xb5, 0xb1, 0x0a, 0xd3, 0x9e, 0xba, 0x6f, 0xb6, 0x99, 0x90, 0x49,
+	0x2f, 0x13, 0x8d, 0x29, 0xaf, 0x14, 0x4c, 0xe6, 0xe1, 0x66, 0x92, 0x78, 0x3f, 0x66, 0xd1, 0x23,
+	0x1e, 0x04, 0x4c, 0x4a, 0x9a, 0x4d, 0x8a, 0xd4, 0x60, 0xee, 0xcc, 0xbd, 0x4a, 0x5d, 0x81, 0xb1,
+	0x80, 0x06, 0x2d, 0x1a, 0x0b, 0x95, 0x34, 0x3b, 0x92, 0x7b, 0x30, 0x97, 0xe7, 0x7b, 0xc1, 0x25,
+	0xcd, 0x37, 0xb6, 0x0c, 0x53, 0x81, 0xf0, 0x9b, 0x3d, 0x6f, 0x36, 0x3b, 0x71, 0x5b, 0x79, 0x07,
+	0x02, 0xe1, 0xef, 0x1f, 0x46, 0xf4, 0x79, 0xdc, 0x26, 0x9b, 0x30, 0x53, 0x52, 0xa9, 0x4a, 0x37,
+	0x60, 0xb4, 0xcb, 0x65, 0x51, 0x48, 0x9d, 0xea, 0x3f, 0x47, 0x60, 0x24, 0x29, 0x84, 0x3f, 0x22,
+	0x18, 0x53, 0x4b, 0xc0, 0x6b, 0x9a, 0x3d, 0x69, 0x3c, 0x6c, 0x10, 0x4d, 0xdc, 0x19, 0xeb, 0x92,
+	0xfa, 0x87, 0x7f, 0xdf, 0x37, 0xd0, 0xd1, 0xaf, 0xbf, 0x5f, 0x86, 0xd7, 0xf1, 0x6d, 0xfb, 0xfc,
+	0x67, 0x96, 0x6d, 0xd9, 0x7e, 0xab, 0x3e, 0x80, 0x77, 0xf8, 0x08, 0xc1, 0xf8, 0x76, 0xb6, 0xfc,
+	0xf5, 0x4b, 0x60, 0xb2, 0xd1, 0x18, 0xab, 0x83, 0x69, 0xf2, 0x41, 0x90, 0x6a, 0x81, 0xb3, 0x88,
+	0xe7, 0x2f, 0xc0, 0xc1, 0x9f, 0x11, 0x4c, 0x95, 0x0d, 0x81, 0x37, 0x07, 0x81, 0x68, 0xdc, 0x64,
+	0xe8, 0xa8, 0x75, 0xf6, 0x22, 0x5b, 0x05, 0xd0, 0x0a, 0x5e, 0xd2, 0x00, 0x29, 0x9f, 0x25, 0xde,
+	0xc3, 0x5f, 0x11, 0x4c, 0xf7, 0x79, 0x09, 0x6f, 0x0d, 0xa2, 0xd2, 0x59, 0xd1, 0xa8, 0x6a, 0xa2,
+	0xb5, 0xde, 0x24, 0x56, 0xc1, 0xb5, 0x8a, 0x57, 0x34, 0x5c, 0x32, 0x66, 0x51, 0xd3, 0xcd, 0x39,
+	0xde, 0x23, 0x98, 0xc8, 0x7d, 0x87, 0xab, 0x17, 0x51, 0x95, 0x0d, 0x6d, 0xdc, 0x1a, 0x40, 0xd4,
+	0xe7, 0x5f, 0xb2, 0x51, 0xd0, 0x2c, 0xe1, 0xc5, 0x41, 0x34, 0x3d, 0x53, 0x8b, 0x87, 0x77, 0x7f,
+	0x9c, 0x98, 0xe8, 0xf8, 0xc4, 0x44, 0x7f, 0x4e, 0x4c, 0xf4, 0xe9, 0xd4, 0x1c, 0x3a, 0x3e, 0x35,
+	0x87, 0x7e, 0x9f, 0x9a, 0x43, 0x2f, 0x17, 0x52, 0x9d, 0xf0, 0x5e, 0x5b, 0x8c, 0xdb, 0x6f, 0x72,
+	0x7d, 0xf2, 0xa7, 0x6f, 0x8d, 0x26, 0xff, 0xeb, 0x3b, 0xff, 0x07, 0x00, 0x2e, 0xf4, 0x1c, 0x02,
+	0x69, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -367,6 +552,11 @@ type QueryClient interface {
 	Accounts(ctx context.Context, in *QueryAccountsRequest, opts ...grpc.CallOption) (*AccountsResponse, error)
 	// DisabledList returns a list of disabled message urls
 	DisabledList(ctx context.Context, in *QueryDisabledListRequest, opts ...grpc.CallOption) (*DisabledListResponse, error)
+	// TripCommittee returns the members of the trip committee.
+	TripCommittee(ctx context.Context, in *QueryTripCommitteeRequest, opts ...grpc.CallOption) (*TripCommitteeResponse, error)
+	// TripVotes returns the trip committee members that voted to trip the
+	// circuit breaker for a Msg type URL.
+	TripVotes(ctx context.Context, in *QueryTripVotesRequest, opts ...grpc.CallOption) (*TripVotesResponse, error)
 }
 
 type queryClient struct {
@@ -404,6 +594,24 @@ func (c *queryClient) DisabledList(ctx context.Context, in *QueryDisabledListReq
 	return out, nil
 }
 
+func (c *queryClient) TripCommittee(ctx context.Context, in *QueryTripCommitteeRequest, opts ...grpc.CallOption) (*TripCommitteeResponse, error) {
+	out := new(TripCommitteeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.circuit.v1.Query/TripCommittee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TripVotes(ctx context.Context, in *QueryTripVotesRequest, opts ...grpc.CallOption) (*TripVotesResponse, error) {
+	out := new(TripVotesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.circuit.v1.Query/TripVotes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Account returns account permissions.
@@ -412,6 +620,11 @@ type QueryServer interface {
 	Accounts(context.Context, *QueryAccountsRequest) (*AccountsResponse, error)
 	// DisabledList returns a list of disabled message urls
 	DisabledList(context.Context, *QueryDisabledListRequest) (*DisabledListResponse, error)
+	// TripCommittee returns the members of the trip committee.
+	TripCommittee(context.Context, *QueryTripCommitteeRequest) (*TripCommitteeResponse, error)
+	// TripVotes returns the trip committee members that voted to trip the
+	// circuit breaker for a Msg type URL.
+	TripVotes(context.Context, *QueryTripVotesRequest) (*TripVotesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -427,6 +640,12 @@ func (*UnimplementedQueryServer) Accounts(ctx context.Context, req *QueryAccount
 func (*UnimplementedQueryServer) DisabledList(ctx context.Context, req *QueryDisabledListRequest) (*DisabledListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DisabledList not implemented")
 }
+func (*UnimplementedQueryServer) TripCommittee(ctx context.Context, req *QueryTripCommitteeRequest) (*TripCommitteeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TripCommittee not implemented")
+}
+func (*UnimplementedQueryServer) TripVotes(ctx context.Context, req *QueryTripVotesRequest) (*TripVotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TripVotes not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -486,6 +705,42 @@ func _Query_DisabledList_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TripCommittee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTripCommitteeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TripCommittee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.circuit.v1.Query/TripCommittee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TripCommittee(ctx, req.(*QueryTripCommitteeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TripVotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTripVotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TripVotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.circuit.v1.Query/TripVotes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TripVotes(ctx, req.(*QueryTripVotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.circuit.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -502,6 +757,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DisabledList",
 			Handler:    _Query_DisabledList_Handler,
 		},
+		{
+			MethodName: "TripCommittee",
+			Handler:    _Query_TripCommittee_Handler,
+		},
+		{
+			MethodName: "TripVotes",
+			Handler:    _Query_TripVotes_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/circuit/v1/query.proto",
@@ -711,6 +974,123 @@ func (m *DisabledListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryTripCommitteeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTripCommitteeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTripCommitteeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *TripCommitteeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TripCommitteeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TripCommitteeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Members[iNdEx])
+			copy(dAtA[i:], m.Members[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Members[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTripVotesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTripVotesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTripVotesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TripVotesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TripVotesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TripVotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Voters) > 0 {
+		for iNdEx := len(m.Voters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Voters[iNdEx])
+			copy(dAtA[i:], m.Voters[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Voters[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -804,13 +1184,65 @@ func (m *DisabledListResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryTripCommitteeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *TripCommitteeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Members) > 0 {
+		for _, s := range m.Members {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
 }
-func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
+
+func (m *QueryTripVotesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *TripVotesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Voters) > 0 {
+		for _, s := range m.Voters {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1316,6 +1748,302 @@ func (m *DisabledListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryTripCommitteeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTripCommitteeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTripCommitteeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TripCommitteeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TripCommitteeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TripCommitteeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTripVotesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTripVotesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTripVotesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TripVotesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TripVotesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TripVotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voters = append(m.Voters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
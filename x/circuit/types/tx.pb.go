@@ -346,6 +346,202 @@ func (m *MsgResetCircuitBreakerResponse) GetSuccess() bool {
 	return false
 }
 
+// MsgSetTripCommittee defines the Msg/SetTripCommittee request type.
+type MsgSetTripCommittee struct {
+	// authority is the module authority or an account with LEVEL_SUPER_ADMIN.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// members are the accounts of the trip committee. They replace the existing
+	// committee and all its pending votes. An empty list removes the committee.
+	Members []string `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+}
+
+func (m *MsgSetTripCommittee) Reset()         { *m = MsgSetTripCommittee{} }
+func (m *MsgSetTripCommittee) String() string { return proto.CompactTextString(m) }
+func (*MsgSetTripCommittee) ProtoMessage()    {}
+func (*MsgSetTripCommittee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a02145e57a6fbb1d, []int{6}
+}
+func (m *MsgSetTripCommittee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetTripCommittee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetTripCommittee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetTripCommittee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetTripCommittee.Merge(m, src)
+}
+func (m *MsgSetTripCommittee) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetTripCommittee) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetTripCommittee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetTripCommittee proto.InternalMessageInfo
+
+func (m *MsgSetTripCommittee) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetTripCommittee) GetMembers() []string {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+// MsgSetTripCommitteeResponse defines the Msg/SetTripCommittee response type.
+type MsgSetTripCommitteeResponse struct {
+}
+
+func (m *MsgSetTripCommitteeResponse) Reset()         { *m = MsgSetTripCommitteeResponse{} }
+func (m *MsgSetTripCommitteeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetTripCommitteeResponse) ProtoMessage()    {}
+func (*MsgSetTripCommitteeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a02145e57a6fbb1d, []int{7}
+}
+func (m *MsgSetTripCommitteeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetTripCommitteeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetTripCommitteeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetTripCommitteeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetTripCommitteeResponse.Merge(m, src)
+}
+func (m *MsgSetTripCommitteeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetTripCommitteeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetTripCommitteeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetTripCommitteeResponse proto.InternalMessageInfo
+
+// MsgVoteTripCircuitBreaker defines the Msg/VoteTripCircuitBreaker request type.
+type MsgVoteTripCircuitBreaker struct {
+	// voter is the trip committee member voting.
+	Voter string `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty"`
+	// msg_type_urls specifies the list of Msg type URLs to vote to stop
+	// processing. It must not be empty.
+	MsgTypeUrls []string `protobuf:"bytes,2,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty"`
+}
+
+func (m *MsgVoteTripCircuitBreaker) Reset()         { *m = MsgVoteTripCircuitBreaker{} }
+func (m *MsgVoteTripCircuitBreaker) String() string { return proto.CompactTextString(m) }
+func (*MsgVoteTripCircuitBreaker) ProtoMessage()    {}
+func (*MsgVoteTripCircuitBreaker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a02145e57a6fbb1d, []int{8}
+}
+func (m *MsgVoteTripCircuitBreaker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVoteTripCircuitBreaker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVoteTripCircuitBreaker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVoteTripCircuitBreaker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoteTripCircuitBreaker.Merge(m, src)
+}
+func (m *MsgVoteTripCircuitBreaker) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVoteTripCircuitBreaker) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoteTripCircuitBreaker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVoteTripCircuitBreaker proto.InternalMessageInfo
+
+func (m *MsgVoteTripCircuitBreaker) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
+func (m *MsgVoteTripCircuitBreaker) GetMsgTypeUrls() []string {
+	if m != nil {
+		return m.MsgTypeUrls
+	}
+	return nil
+}
+
+// MsgVoteTripCircuitBreakerResponse defines the Msg/VoteTripCircuitBreaker response type.
+type MsgVoteTripCircuitBreakerResponse struct {
+	// tripped_msg_type_urls are the Msg type URLs for which the vote reached
+	// the supermajority and the circuit breaker was tripped.
+	TrippedMsgTypeUrls []string `protobuf:"bytes,1,rep,name=tripped_msg_type_urls,json=trippedMsgTypeUrls,proto3" json:"tripped_msg_type_urls,omitempty"`
+}
+
+func (m *MsgVoteTripCircuitBreakerResponse) Reset()         { *m = MsgVoteTripCircuitBreakerResponse{} }
+func (m *MsgVoteTripCircuitBreakerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgVoteTripCircuitBreakerResponse) ProtoMessage()    {}
+func (*MsgVoteTripCircuitBreakerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a02145e57a6fbb1d, []int{9}
+}
+func (m *MsgVoteTripCircuitBreakerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVoteTripCircuitBreakerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVoteTripCircuitBreakerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVoteTripCircuitBreakerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoteTripCircuitBreakerResponse.Merge(m, src)
+}
+func (m *MsgVoteTripCircuitBreakerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVoteTripCircuitBreakerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoteTripCircuitBreakerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVoteTripCircuitBreakerResponse proto.InternalMessageInfo
+
+func (m *MsgVoteTripCircuitBreakerResponse) GetTrippedMsgTypeUrls() []string {
+	if m != nil {
+		return m.TrippedMsgTypeUrls
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgAuthorizeCircuitBreaker)(nil), "cosmos.circuit.v1.MsgAuthorizeCircuitBreaker")
 	proto.RegisterType((*MsgAuthorizeCircuitBreakerResponse)(nil), "cosmos.circuit.v1.MsgAuthorizeCircuitBreakerResponse")
@@ -353,39 +549,51 @@ func init() {
 	proto.RegisterType((*MsgTripCircuitBreakerResponse)(nil), "cosmos.circuit.v1.MsgTripCircuitBreakerResponse")
 	proto.RegisterType((*MsgResetCircuitBreaker)(nil), "cosmos.circuit.v1.MsgResetCircuitBreaker")
 	proto.RegisterType((*MsgResetCircuitBreakerResponse)(nil), "cosmos.circuit.v1.MsgResetCircuitBreakerResponse")
+	proto.RegisterType((*MsgSetTripCommittee)(nil), "cosmos.circuit.v1.MsgSetTripCommittee")
+	proto.RegisterType((*MsgSetTripCommitteeResponse)(nil), "cosmos.circuit.v1.MsgSetTripCommitteeResponse")
+	proto.RegisterType((*MsgVoteTripCircuitBreaker)(nil), "cosmos.circuit.v1.MsgVoteTripCircuitBreaker")
+	proto.RegisterType((*MsgVoteTripCircuitBreakerResponse)(nil), "cosmos.circuit.v1.MsgVoteTripCircuitBreakerResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/circuit/v1/tx.proto", fileDescriptor_a02145e57a6fbb1d) }
 
 var fileDescriptor_a02145e57a6fbb1d = []byte{
-	// 429 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0xcf, 0xae, 0xd2, 0x40,
-	0x14, 0x87, 0x19, 0x1a, 0xff, 0x30, 0xa8, 0x89, 0x35, 0x7a, 0x9b, 0xc9, 0xbd, 0x13, 0xd2, 0x55,
-	0xbd, 0x89, 0xad, 0x60, 0x34, 0x91, 0x85, 0x51, 0x5c, 0x37, 0x31, 0x0d, 0x6e, 0xdc, 0x10, 0xac,
-	0x93, 0x71, 0x84, 0x32, 0xcd, 0x9c, 0x96, 0x80, 0x1b, 0x8d, 0x4f, 0xe0, 0x23, 0xf8, 0x08, 0x3c,
-	0x86, 0x4b, 0x96, 0x2e, 0x0d, 0x2c, 0x78, 0x01, 0x1f, 0xc0, 0x94, 0x52, 0x4a, 0x64, 0x08, 0x98,
-	0xbb, 0x1c, 0xce, 0x37, 0xe7, 0xf7, 0x0d, 0xa7, 0x07, 0x93, 0x50, 0x42, 0x24, 0xc1, 0x0b, 0x85,
-	0x0a, 0x53, 0x91, 0x78, 0xe3, 0xa6, 0x97, 0x4c, 0xdc, 0x58, 0xc9, 0x44, 0x9a, 0x77, 0xf3, 0x9a,
-	0xbb, 0xa9, 0xb9, 0xe3, 0x26, 0x39, 0xdb, 0xe0, 0x11, 0xf0, 0x0c, 0x8d, 0x80, 0xe7, 0x2c, 0xb9,
-	0xd0, 0xf4, 0x99, 0xc6, 0x0c, 0xf2, 0xb2, 0xfd, 0x03, 0x61, 0xe2, 0x03, 0x7f, 0x95, 0x26, 0x1f,
-	0xa5, 0x12, 0x9f, 0xd9, 0xeb, 0x1c, 0xeb, 0x28, 0xd6, 0x1f, 0x30, 0x65, 0x5a, 0xf8, 0x06, 0x57,
-	0xfd, 0x51, 0xc2, 0x94, 0x85, 0x1a, 0xc8, 0xa9, 0x05, 0xc5, 0xb1, 0xac, 0x30, 0xab, 0xba, 0x5b,
-	0x61, 0xe6, 0x4b, 0x5c, 0x8f, 0x99, 0x8a, 0x04, 0x80, 0x90, 0x23, 0xb0, 0x8c, 0x06, 0x72, 0xea,
-	0x2d, 0xea, 0xee, 0x39, 0xbb, 0x6f, 0x4a, 0x2a, 0xd8, 0xbd, 0xd2, 0xbe, 0xf5, 0x6d, 0x35, 0xbb,
-	0x2c, 0x92, 0xec, 0x17, 0xd8, 0x3e, 0x6c, 0x18, 0x30, 0x88, 0xe5, 0x08, 0x58, 0xe6, 0x03, 0x69,
-	0x18, 0x32, 0x80, 0xb5, 0xe9, 0xcd, 0xa0, 0x38, 0xda, 0x02, 0xdf, 0xf7, 0x81, 0x77, 0x95, 0x88,
-	0xff, 0x79, 0xdc, 0x39, 0xae, 0xf5, 0xf3, 0xae, 0xc9, 0x74, 0xf3, 0xbc, 0xf2, 0x07, 0xd3, 0xc6,
-	0xb7, 0x23, 0xe0, 0xbd, 0xec, 0xcf, 0xea, 0xa5, 0x6a, 0x08, 0x56, 0xb5, 0x61, 0x38, 0xb5, 0xa0,
-	0x1e, 0x01, 0xef, 0x4e, 0x63, 0xf6, 0x56, 0x0d, 0xa1, 0x7d, 0x27, 0x13, 0x2d, 0xef, 0xd8, 0xcf,
-	0xf1, 0x85, 0x36, 0xea, 0x04, 0xcb, 0x4f, 0xf8, 0x81, 0x0f, 0x3c, 0x60, 0xc0, 0x92, 0xab, 0x69,
-	0x1a, 0xc7, 0x35, 0xdb, 0x98, 0xea, 0xb3, 0x8e, 0x7b, 0xb6, 0xfe, 0x54, 0xb1, 0xe1, 0x03, 0x37,
-	0xbf, 0xe0, 0xb3, 0x43, 0x1f, 0xcd, 0x23, 0xcd, 0xac, 0x0f, 0x4f, 0x90, 0x3c, 0xfd, 0x2f, 0x7c,
-	0xab, 0x18, 0x63, 0x53, 0x33, 0x53, 0x47, 0xdf, 0x6c, 0x9f, 0x24, 0x8f, 0x4f, 0x25, 0xb7, 0x89,
-	0x80, 0xef, 0xe9, 0xe6, 0xf3, 0x50, 0xdf, 0x48, 0x83, 0x92, 0xe6, 0xc9, 0x68, 0x11, 0x4a, 0xae,
-	0x7d, 0x5d, 0xcd, 0x2e, 0x51, 0xe7, 0xd9, 0xcf, 0x05, 0x45, 0xf3, 0x05, 0x45, 0xbf, 0x17, 0x14,
-	0x7d, 0x5f, 0xd2, 0xca, 0x7c, 0x49, 0x2b, 0xbf, 0x96, 0xb4, 0xf2, 0xee, 0x3c, 0x6f, 0x09, 0x1f,
-	0x06, 0xae, 0x90, 0xde, 0x64, 0xbb, 0xe8, 0xeb, 0x2d, 0x7f, 0x7f, 0x7d, 0xbd, 0xe6, 0x4f, 0xfe,
-	0x06, 0x00, 0x00, 0xff, 0xff, 0x89, 0x07, 0x74, 0x02, 0x4f, 0x04, 0x00, 0x00,
+	// 551 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0x4f, 0x6e, 0xd3, 0x40,
+	0x14, 0xc6, 0xeb, 0x86, 0x52, 0xf2, 0x02, 0x08, 0x5c, 0xda, 0x1a, 0xd3, 0x58, 0xc1, 0x0b, 0x14,
+	0x2a, 0x70, 0x48, 0xf9, 0x23, 0x91, 0x05, 0x82, 0xb2, 0xb6, 0x84, 0x4c, 0xe9, 0x02, 0x09, 0x45,
+	0x69, 0x3a, 0x1a, 0xa6, 0xed, 0x64, 0xac, 0x79, 0x93, 0xa8, 0x41, 0x48, 0x20, 0x4e, 0xc0, 0x11,
+	0x38, 0x42, 0x8f, 0xc1, 0xb2, 0x4b, 0x56, 0x08, 0x25, 0x8b, 0x5e, 0x03, 0x39, 0x8e, 0xed, 0xd2,
+	0x8c, 0xc1, 0x15, 0xcb, 0xf1, 0xfb, 0xf9, 0xfb, 0xbe, 0x79, 0xf3, 0x46, 0x03, 0x76, 0x57, 0x20,
+	0x17, 0xd8, 0xe8, 0x32, 0xd9, 0xed, 0x33, 0xd5, 0x18, 0x34, 0x1b, 0xea, 0xd0, 0x0b, 0xa5, 0x50,
+	0xc2, 0xbc, 0x1e, 0xd7, 0xbc, 0x69, 0xcd, 0x1b, 0x34, 0xed, 0xd5, 0x29, 0xce, 0x91, 0x46, 0x28,
+	0x47, 0x1a, 0xb3, 0x76, 0x55, 0xa3, 0x33, 0x0c, 0x09, 0xc6, 0x65, 0xf7, 0x9b, 0x01, 0xb6, 0x8f,
+	0xf4, 0x45, 0x5f, 0xbd, 0x17, 0x92, 0x7d, 0x20, 0x2f, 0x63, 0x6c, 0x53, 0x92, 0xce, 0x3e, 0x91,
+	0xa6, 0x05, 0x8b, 0x54, 0x76, 0x7a, 0x8a, 0x48, 0xcb, 0xa8, 0x19, 0xf5, 0x72, 0x90, 0x2c, 0xb3,
+	0x0a, 0xb1, 0xe6, 0x4f, 0x57, 0x88, 0xf9, 0x1c, 0x2a, 0x21, 0x91, 0x9c, 0x21, 0x32, 0xd1, 0x43,
+	0xab, 0x54, 0x33, 0xea, 0x95, 0x0d, 0xc7, 0x9b, 0xc9, 0xec, 0xbd, 0xca, 0xa8, 0xe0, 0xf4, 0x2f,
+	0xad, 0xcb, 0x5f, 0x4e, 0x8e, 0xd6, 0x13, 0x27, 0xf7, 0x19, 0xb8, 0xf9, 0x09, 0x03, 0x82, 0xa1,
+	0xe8, 0x21, 0x89, 0xf2, 0x60, 0xbf, 0xdb, 0x25, 0x88, 0x93, 0xa4, 0x97, 0x82, 0x64, 0xe9, 0x32,
+	0x58, 0xf6, 0x91, 0x6e, 0x49, 0x16, 0x9e, 0xd9, 0xdc, 0x1a, 0x94, 0x3b, 0xb1, 0xaa, 0x1a, 0x4e,
+	0xb7, 0x97, 0x7d, 0x30, 0x5d, 0xb8, 0xc2, 0x91, 0xb6, 0xa3, 0x66, 0xb5, 0xfb, 0xf2, 0x00, 0xad,
+	0xf9, 0x5a, 0xa9, 0x5e, 0x0e, 0x2a, 0x1c, 0xe9, 0xd6, 0x30, 0x24, 0x6f, 0xe4, 0x01, 0xb6, 0xae,
+	0x46, 0x41, 0xb3, 0x7f, 0xdc, 0xa7, 0x50, 0xd5, 0x5a, 0x15, 0x48, 0xb9, 0x07, 0x2b, 0x3e, 0xd2,
+	0x80, 0x20, 0x51, 0xff, 0x17, 0xb3, 0xf4, 0xef, 0x98, 0x2d, 0x70, 0xf4, 0x5e, 0x05, 0x72, 0xbe,
+	0x83, 0x25, 0x1f, 0xe9, 0x6b, 0xa2, 0x26, 0xbb, 0x14, 0x9c, 0x33, 0x15, 0x1d, 0xfa, 0xdf, 0x43,
+	0x5a, 0xb0, 0xc8, 0x09, 0xdf, 0x21, 0x32, 0xe9, 0x62, 0xb2, 0x9c, 0x89, 0x56, 0x85, 0x5b, 0x1a,
+	0xf9, 0x24, 0x97, 0xdb, 0x81, 0x9b, 0x3e, 0xd2, 0x6d, 0xa1, 0x88, 0xe6, 0x3c, 0x6f, 0xc0, 0xc2,
+	0x40, 0x64, 0xa3, 0x1a, 0x2f, 0x0a, 0x9d, 0x23, 0x44, 0x29, 0x62, 0xde, 0xdd, 0x86, 0xdb, 0xb9,
+	0x16, 0x69, 0x7f, 0x9a, 0xb0, 0xac, 0x24, 0x0b, 0x43, 0xb2, 0xdb, 0xfe, 0x53, 0xdc, 0x98, 0x88,
+	0x9b, 0xd3, 0xa2, 0x9f, 0x79, 0x6c, 0xfc, 0xbc, 0x00, 0x25, 0x1f, 0xa9, 0xf9, 0x09, 0x56, 0xf3,
+	0x6e, 0xdb, 0x7d, 0xcd, 0x25, 0xc9, 0x1f, 0x7d, 0xfb, 0xf1, 0xb9, 0xf0, 0x34, 0x7b, 0x08, 0xa6,
+	0xa6, 0x79, 0x75, 0xbd, 0xd8, 0x2c, 0x69, 0x3f, 0x28, 0x4a, 0xa6, 0x8e, 0x08, 0x4b, 0xba, 0xc1,
+	0xbe, 0xab, 0x17, 0xd2, 0xa0, 0x76, 0xb3, 0x30, 0x9a, 0x9a, 0xee, 0xc1, 0xb5, 0x99, 0x29, 0xbd,
+	0xa3, 0x97, 0x39, 0xcb, 0xd9, 0x5e, 0x31, 0x2e, 0xf5, 0xfa, 0x08, 0x2b, 0x39, 0x33, 0x79, 0x4f,
+	0xaf, 0xa4, 0xa7, 0xed, 0x47, 0xe7, 0xa1, 0x13, 0x77, 0x7b, 0xe1, 0xf3, 0xc9, 0xd1, 0xba, 0xb1,
+	0xf9, 0xe4, 0xfb, 0xc8, 0x31, 0x8e, 0x47, 0x8e, 0xf1, 0x6b, 0xe4, 0x18, 0x5f, 0xc7, 0xce, 0xdc,
+	0xf1, 0xd8, 0x99, 0xfb, 0x31, 0x76, 0xe6, 0xde, 0xae, 0xc5, 0xaa, 0xb8, 0xbb, 0xef, 0x31, 0xd1,
+	0x38, 0x4c, 0xdf, 0x82, 0xc9, 0x43, 0xb0, 0x73, 0x71, 0xf2, 0x12, 0x3c, 0xfc, 0x3d, 0x00, 0x21,
+	0x6c, 0x07, 0xc4, 0x72, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -408,6 +616,14 @@ type MsgClient interface {
 	// ResetCircuitBreaker resumes processing of Msg's in the state machine that
 	// have been been paused using TripCircuitBreaker.
 	ResetCircuitBreaker(ctx context.Context, in *MsgResetCircuitBreaker, opts ...grpc.CallOption) (*MsgResetCircuitBreakerResponse, error)
+	// SetTripCommittee allows the module authority or a super-admin to set the
+	// permissioned set of accounts whose supermajority can trip the circuit
+	// breaker.
+	SetTripCommittee(ctx context.Context, in *MsgSetTripCommittee, opts ...grpc.CallOption) (*MsgSetTripCommitteeResponse, error)
+	// VoteTripCircuitBreaker records a trip committee member's vote to pause
+	// processing of Msg's. The circuit breaker is tripped for a Msg type URL once
+	// more than two thirds of the trip committee voted for it.
+	VoteTripCircuitBreaker(ctx context.Context, in *MsgVoteTripCircuitBreaker, opts ...grpc.CallOption) (*MsgVoteTripCircuitBreakerResponse, error)
 }
 
 type msgClient struct {
@@ -445,6 +661,24 @@ func (c *msgClient) ResetCircuitBreaker(ctx context.Context, in *MsgResetCircuit
 	return out, nil
 }
 
+func (c *msgClient) SetTripCommittee(ctx context.Context, in *MsgSetTripCommittee, opts ...grpc.CallOption) (*MsgSetTripCommitteeResponse, error) {
+	out := new(MsgSetTripCommitteeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.circuit.v1.Msg/SetTripCommittee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) VoteTripCircuitBreaker(ctx context.Context, in *MsgVoteTripCircuitBreaker, opts ...grpc.CallOption) (*MsgVoteTripCircuitBreakerResponse, error) {
+	out := new(MsgVoteTripCircuitBreakerResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.circuit.v1.Msg/VoteTripCircuitBreaker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// AuthorizeCircuitBreaker allows a super-admin to grant (or revoke) another
@@ -455,6 +689,14 @@ type MsgServer interface {
 	// ResetCircuitBreaker resumes processing of Msg's in the state machine that
 	// have been been paused using TripCircuitBreaker.
 	ResetCircuitBreaker(context.Context, *MsgResetCircuitBreaker) (*MsgResetCircuitBreakerResponse, error)
+	// SetTripCommittee allows the module authority or a super-admin to set the
+	// permissioned set of accounts whose supermajority can trip the circuit
+	// breaker.
+	SetTripCommittee(context.Context, *MsgSetTripCommittee) (*MsgSetTripCommitteeResponse, error)
+	// VoteTripCircuitBreaker records a trip committee member's vote to pause
+	// processing of Msg's. The circuit breaker is tripped for a Msg type URL once
+	// more than two thirds of the trip committee voted for it.
+	VoteTripCircuitBreaker(context.Context, *MsgVoteTripCircuitBreaker) (*MsgVoteTripCircuitBreakerResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -470,6 +712,12 @@ func (*UnimplementedMsgServer) TripCircuitBreaker(ctx context.Context, req *MsgT
 func (*UnimplementedMsgServer) ResetCircuitBreaker(ctx context.Context, req *MsgResetCircuitBreaker) (*MsgResetCircuitBreakerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResetCircuitBreaker not implemented")
 }
+func (*UnimplementedMsgServer) SetTripCommittee(ctx context.Context, req *MsgSetTripCommittee) (*MsgSetTripCommitteeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTripCommittee not implemented")
+}
+func (*UnimplementedMsgServer) VoteTripCircuitBreaker(ctx context.Context, req *MsgVoteTripCircuitBreaker) (*MsgVoteTripCircuitBreakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteTripCircuitBreaker not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -529,6 +777,42 @@ func _Msg_ResetCircuitBreaker_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetTripCommittee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetTripCommittee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetTripCommittee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.circuit.v1.Msg/SetTripCommittee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetTripCommittee(ctx, req.(*MsgSetTripCommittee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_VoteTripCircuitBreaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVoteTripCircuitBreaker)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VoteTripCircuitBreaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.circuit.v1.Msg/VoteTripCircuitBreaker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VoteTripCircuitBreaker(ctx, req.(*MsgVoteTripCircuitBreaker))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.circuit.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -545,6 +829,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ResetCircuitBreaker",
 			Handler:    _Msg_ResetCircuitBreaker_Handler,
 		},
+		{
+			MethodName: "SetTripCommittee",
+			Handler:    _Msg_SetTripCommittee_Handler,
+		},
+		{
+			MethodName: "VoteTripCircuitBreaker",
+			Handler:    _Msg_VoteTripCircuitBreaker_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/circuit/v1/tx.proto",
@@ -776,64 +1068,197 @@ func (m *MsgResetCircuitBreakerResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgSetTripCommittee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgAuthorizeCircuitBreaker) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgSetTripCommittee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetTripCommittee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Granter)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Grantee)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Members[iNdEx])
+			copy(dAtA[i:], m.Members[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Members[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.Permissions != nil {
-		l = m.Permissions.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAuthorizeCircuitBreakerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetTripCommitteeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetTripCommitteeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetTripCommitteeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Success {
-		n += 2
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgTripCircuitBreaker) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgVoteTripCircuitBreaker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoteTripCircuitBreaker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteTripCircuitBreaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
 	if len(m.MsgTypeUrls) > 0 {
-		for _, s := range m.MsgTypeUrls {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.MsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.MsgTypeUrls[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.MsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVoteTripCircuitBreakerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoteTripCircuitBreakerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteTripCircuitBreakerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TrippedMsgTypeUrls) > 0 {
+		for iNdEx := len(m.TrippedMsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TrippedMsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.TrippedMsgTypeUrls[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.TrippedMsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgAuthorizeCircuitBreaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Permissions != nil {
+		l = m.Permissions.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAuthorizeCircuitBreakerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgTripCircuitBreaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for _, s := range m.MsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
 		}
 	}
 	return n
@@ -882,13 +1307,295 @@ func (m *MsgResetCircuitBreakerResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *MsgSetTripCommittee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Members) > 0 {
+		for _, s := range m.Members {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetTripCommitteeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgVoteTripCircuitBreaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for _, s := range m.MsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgVoteTripCircuitBreakerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TrippedMsgTypeUrls) > 0 {
+		for _, s := range m.TrippedMsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgAuthorizeCircuitBreaker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAuthorizeCircuitBreaker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAuthorizeCircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Permissions == nil {
+				m.Permissions = &Permissions{}
+			}
+			if err := m.Permissions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAuthorizeCircuitBreakerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAuthorizeCircuitBreakerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAuthorizeCircuitBreakerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgAuthorizeCircuitBreaker) Unmarshal(dAtA []byte) error {
+func (m *MsgTripCircuitBreaker) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -911,15 +1618,15 @@ func (m *MsgAuthorizeCircuitBreaker) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAuthorizeCircuitBreaker: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTripCircuitBreaker: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAuthorizeCircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTripCircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -947,11 +1654,11 @@ func (m *MsgAuthorizeCircuitBreaker) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Granter = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrls", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -979,13 +1686,133 @@ func (m *MsgAuthorizeCircuitBreaker) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Grantee = string(dAtA[iNdEx:postIndex])
+			m.MsgTypeUrls = append(m.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTripCircuitBreakerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTripCircuitBreakerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTripCircuitBreakerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResetCircuitBreaker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResetCircuitBreaker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResetCircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -995,27 +1822,55 @@ func (m *MsgAuthorizeCircuitBreaker) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Permissions == nil {
-				m.Permissions = &Permissions{}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrls", wireType)
 			}
-			if err := m.Permissions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrls = append(m.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1038,7 +1893,7 @@ func (m *MsgAuthorizeCircuitBreaker) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAuthorizeCircuitBreakerResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgResetCircuitBreakerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1061,10 +1916,10 @@ func (m *MsgAuthorizeCircuitBreakerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAuthorizeCircuitBreakerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResetCircuitBreakerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAuthorizeCircuitBreakerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResetCircuitBreakerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1108,7 +1963,7 @@ func (m *MsgAuthorizeCircuitBreakerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTripCircuitBreaker) Unmarshal(dAtA []byte) error {
+func (m *MsgSetTripCommittee) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1131,10 +1986,10 @@ func (m *MsgTripCircuitBreaker) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTripCircuitBreaker: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetTripCommittee: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTripCircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetTripCommittee: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1171,7 +2026,7 @@ func (m *MsgTripCircuitBreaker) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrls", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1199,7 +2054,7 @@ func (m *MsgTripCircuitBreaker) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MsgTypeUrls = append(m.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			m.Members = append(m.Members, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1222,7 +2077,7 @@ func (m *MsgTripCircuitBreaker) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTripCircuitBreakerResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetTripCommitteeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1245,32 +2100,12 @@ func (m *MsgTripCircuitBreakerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTripCircuitBreakerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetTripCommitteeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTripCircuitBreakerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetTripCommitteeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Success = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1292,7 +2127,7 @@ func (m *MsgTripCircuitBreakerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgResetCircuitBreaker) Unmarshal(dAtA []byte) error {
+func (m *MsgVoteTripCircuitBreaker) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1315,15 +2150,15 @@ func (m *MsgResetCircuitBreaker) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgResetCircuitBreaker: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVoteTripCircuitBreaker: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgResetCircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVoteTripCircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1351,9 +2186,9 @@ func (m *MsgResetCircuitBreaker) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Voter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrls", wireType)
 			}
@@ -1406,7 +2241,7 @@ func (m *MsgResetCircuitBreaker) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgResetCircuitBreakerResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgVoteTripCircuitBreakerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1429,17 +2264,17 @@ func (m *MsgResetCircuitBreakerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgResetCircuitBreakerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVoteTripCircuitBreakerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgResetCircuitBreakerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVoteTripCircuitBreakerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrippedMsgTypeUrls", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1449,12 +2284,24 @@ func (m *MsgResetCircuitBreakerResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Success = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrippedMsgTypeUrls = append(m.TrippedMsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -175,17 +175,76 @@ func (m *GenesisAccountPermissions) GetPermissions() *Permissions {
 	return nil
 }
 
+// TripVote is the vote of a trip committee member to trip the circuit breaker
+// for a Msg type URL.
+type TripVote struct {
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	Voter      string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+}
+
+func (m *TripVote) Reset()         { *m = TripVote{} }
+func (m *TripVote) String() string { return proto.CompactTextString(m) }
+func (*TripVote) ProtoMessage()    {}
+func (*TripVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f5fe523f8a09dbc, []int{2}
+}
+func (m *TripVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TripVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TripVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TripVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TripVote.Merge(m, src)
+}
+func (m *TripVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *TripVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_TripVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TripVote proto.InternalMessageInfo
+
+func (m *TripVote) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *TripVote) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
 // GenesisState is the state that must be provided at genesis.
 type GenesisState struct {
 	AccountPermissions []*GenesisAccountPermissions `protobuf:"bytes,1,rep,name=account_permissions,json=accountPermissions,proto3" json:"account_permissions,omitempty"`
 	DisabledTypeUrls   []string                     `protobuf:"bytes,2,rep,name=disabled_type_urls,json=disabledTypeUrls,proto3" json:"disabled_type_urls,omitempty"`
+	// trip_committee is the permissioned set of accounts whose supermajority can
+	// trip the circuit breaker.
+	TripCommittee []string `protobuf:"bytes,3,rep,name=trip_committee,json=tripCommittee,proto3" json:"trip_committee,omitempty"`
+	// trip_votes are the pending votes of the trip committee members.
+	TripVotes []*TripVote `protobuf:"bytes,4,rep,name=trip_votes,json=tripVotes,proto3" json:"trip_votes,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1f5fe523f8a09dbc, []int{2}
+	return fileDescriptor_1f5fe523f8a09dbc, []int{3}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -228,42 +287,62 @@ func (m *GenesisState) GetDisabledTypeUrls() []string {
 	return nil
 }
 
+func (m *GenesisState) GetTripCommittee() []string {
+	if m != nil {
+		return m.TripCommittee
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTripVotes() []*TripVote {
+	if m != nil {
+		return m.TripVotes
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.circuit.v1.Permissions_Level", Permissions_Level_name, Permissions_Level_value)
 	proto.RegisterType((*Permissions)(nil), "cosmos.circuit.v1.Permissions")
 	proto.RegisterType((*GenesisAccountPermissions)(nil), "cosmos.circuit.v1.GenesisAccountPermissions")
+	proto.RegisterType((*TripVote)(nil), "cosmos.circuit.v1.TripVote")
 	proto.RegisterType((*GenesisState)(nil), "cosmos.circuit.v1.GenesisState")
 }
 
 func init() { proto.RegisterFile("cosmos/circuit/v1/types.proto", fileDescriptor_1f5fe523f8a09dbc) }
 
 var fileDescriptor_1f5fe523f8a09dbc = []byte{
-	// 388 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0x41, 0x4f, 0xea, 0x40,
-	0x18, 0xec, 0x42, 0x78, 0x2f, 0x6c, 0xdf, 0x83, 0xb2, 0x44, 0x53, 0x8d, 0x36, 0x4d, 0x63, 0x4c,
-	0x0f, 0xa4, 0x0d, 0x98, 0x78, 0xf0, 0x24, 0x4a, 0x25, 0x24, 0xa5, 0x90, 0x56, 0x3c, 0x98, 0x98,
-	0xa6, 0xb4, 0x7b, 0xd8, 0x58, 0x68, 0xd3, 0x2d, 0x28, 0xff, 0xc2, 0xbb, 0x7f, 0xc8, 0x23, 0x27,
-	0xe3, 0xd1, 0xc0, 0x1f, 0x31, 0xb4, 0xa0, 0x4d, 0x50, 0x8f, 0xfb, 0xcd, 0xcc, 0x7e, 0xdf, 0x4c,
-	0x06, 0x1e, 0xba, 0x01, 0x1d, 0x05, 0x54, 0x75, 0x49, 0xe4, 0x4e, 0x48, 0xac, 0x4e, 0xeb, 0x6a,
-	0x3c, 0x0b, 0x31, 0x55, 0xc2, 0x28, 0x88, 0x03, 0x54, 0x49, 0x61, 0x65, 0x0d, 0x2b, 0xd3, 0xba,
-	0xf4, 0x0a, 0x20, 0xdb, 0xc7, 0xd1, 0x88, 0x50, 0x4a, 0x82, 0x31, 0x45, 0x67, 0xb0, 0xe0, 0xe3,
-	0x29, 0xf6, 0x79, 0x20, 0x02, 0xb9, 0xd4, 0x38, 0x52, 0xb6, 0x24, 0x4a, 0x86, 0xae, 0xe8, 0x2b,
-	0xae, 0x99, 0x4a, 0xd0, 0x31, 0x2c, 0xfb, 0x64, 0x44, 0x62, 0x7b, 0xb5, 0xd3, 0x9e, 0x44, 0x3e,
-	0xe5, 0x73, 0x62, 0x5e, 0x2e, 0x9a, 0xff, 0x93, 0xf1, 0xf5, 0x2c, 0xc4, 0x83, 0xc8, 0xa7, 0x92,
-	0x0b, 0x0b, 0x89, 0x0e, 0xed, 0xc3, 0x5d, 0x5d, 0xbb, 0xd1, 0x74, 0xdb, 0xe8, 0x19, 0x9a, 0x3d,
-	0x30, 0xac, 0xbe, 0x76, 0xd9, 0xb9, 0xea, 0x68, 0x2d, 0x8e, 0x41, 0x55, 0x58, 0x4e, 0x31, 0xab,
-	0xd7, 0xd5, 0xec, 0xae, 0xd5, 0xb6, 0x38, 0x80, 0x10, 0x2c, 0xa5, 0xc3, 0xa6, 0xae, 0xa7, 0xb3,
-	0x1c, 0xda, 0x81, 0x95, 0x35, 0x71, 0xd0, 0xd7, 0x4c, 0xbb, 0xd9, 0xea, 0x76, 0x0c, 0x2e, 0x2f,
-	0x3d, 0xc0, 0xbd, 0x36, 0x1e, 0x63, 0x4a, 0x68, 0xd3, 0x75, 0x83, 0xc9, 0x38, 0xce, 0xba, 0xe4,
-	0xe1, 0x5f, 0xc7, 0xf3, 0x22, 0x4c, 0x69, 0xe2, 0xb3, 0x68, 0x6e, 0x9e, 0xe8, 0x1c, 0xb2, 0xe1,
-	0x17, 0x91, 0xcf, 0x89, 0x40, 0x66, 0x1b, 0xc2, 0xef, 0x29, 0x98, 0x59, 0x89, 0xf4, 0x0c, 0xe0,
-	0xbf, 0xf5, 0x66, 0x2b, 0x76, 0x62, 0x8c, 0xee, 0x60, 0xd5, 0x49, 0x4f, 0xb0, 0xb3, 0x5f, 0x03,
-	0x31, 0x2f, 0xb3, 0x8d, 0xda, 0x37, 0x5f, 0xff, 0x78, 0xb7, 0x89, 0x9c, 0x6d, 0x2f, 0x35, 0x88,
-	0x3c, 0x42, 0x9d, 0xa1, 0x8f, 0xbd, 0xad, 0xe0, 0xb9, 0x0d, 0xb2, 0xc9, 0xfe, 0xe2, 0xf4, 0x65,
-	0x21, 0x80, 0xf9, 0x42, 0x00, 0xef, 0x0b, 0x01, 0x3c, 0x2d, 0x05, 0x66, 0xbe, 0x14, 0x98, 0xb7,
-	0xa5, 0xc0, 0xdc, 0x1e, 0xa4, 0x87, 0x50, 0xef, 0x5e, 0x21, 0x81, 0xfa, 0xf8, 0xd9, 0xa1, 0xa4,
-	0x40, 0xc3, 0x3f, 0x49, 0x83, 0x4e, 0x3e, 0x02, 0x00, 0x00, 0xff, 0xff, 0x3a, 0xff, 0xdc, 0xa4,
-	0x62, 0x02, 0x00, 0x00,
+	// 467 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0xcd, 0x26, 0x04, 0xc8, 0xa4, 0xa4, 0xe9, 0x16, 0x90, 0xf9, 0xb2, 0x2c, 0x0b, 0x50, 0x0e,
+	0x95, 0xa3, 0x06, 0x89, 0x43, 0x4f, 0xa4, 0xad, 0xa9, 0x22, 0x39, 0x69, 0x64, 0x37, 0x3d, 0x20,
+	0x21, 0xcb, 0xb5, 0x57, 0xd5, 0x0a, 0x3b, 0x6b, 0x79, 0x36, 0x81, 0xfe, 0x0b, 0x7e, 0x16, 0xc7,
+	0x9e, 0x10, 0x47, 0x94, 0xfc, 0x0f, 0x84, 0xe2, 0xb5, 0x4b, 0xa4, 0x94, 0xde, 0xbc, 0x6f, 0xde,
+	0x78, 0xde, 0x7b, 0x33, 0xf0, 0x2a, 0x14, 0x98, 0x08, 0xec, 0x86, 0x3c, 0x0b, 0x67, 0x5c, 0x76,
+	0xe7, 0xfb, 0x5d, 0x79, 0x95, 0x32, 0xb4, 0xd2, 0x4c, 0x48, 0x41, 0x77, 0x54, 0xd9, 0x2a, 0xca,
+	0xd6, 0x7c, 0xdf, 0xfc, 0x49, 0xa0, 0x39, 0x66, 0x59, 0xc2, 0x11, 0xb9, 0x98, 0x22, 0x3d, 0x80,
+	0x7a, 0xcc, 0xe6, 0x2c, 0xd6, 0x88, 0x41, 0x3a, 0xad, 0xde, 0x6b, 0x6b, 0xa3, 0xc5, 0x5a, 0xa3,
+	0x5b, 0xce, 0x8a, 0xeb, 0xaa, 0x16, 0xfa, 0x16, 0xb6, 0x63, 0x9e, 0x70, 0xe9, 0xaf, 0x66, 0xfa,
+	0xb3, 0x2c, 0x46, 0xad, 0x6a, 0xd4, 0x3a, 0x0d, 0xf7, 0x51, 0x0e, 0x9f, 0x5d, 0xa5, 0x6c, 0x92,
+	0xc5, 0x68, 0x86, 0x50, 0xcf, 0xfb, 0xe8, 0x73, 0x78, 0xea, 0xd8, 0xe7, 0xb6, 0xe3, 0x8f, 0x4e,
+	0x47, 0xb6, 0x3f, 0x19, 0x79, 0x63, 0xfb, 0x68, 0xf0, 0x71, 0x60, 0x1f, 0xb7, 0x2b, 0x74, 0x17,
+	0xb6, 0x55, 0xcd, 0x3b, 0x1d, 0xda, 0xfe, 0xd0, 0x3b, 0xf1, 0xda, 0x84, 0x52, 0x68, 0x29, 0xb0,
+	0xef, 0x38, 0x0a, 0xab, 0xd2, 0x27, 0xb0, 0x53, 0x10, 0x27, 0x63, 0xdb, 0xf5, 0xfb, 0xc7, 0xc3,
+	0xc1, 0xa8, 0x5d, 0x33, 0xbf, 0xc2, 0xb3, 0x13, 0x36, 0x65, 0xc8, 0xb1, 0x1f, 0x86, 0x62, 0x36,
+	0x95, 0xeb, 0x2e, 0x35, 0x78, 0x10, 0x44, 0x51, 0xc6, 0x10, 0x73, 0x9f, 0x0d, 0xb7, 0x7c, 0xd2,
+	0x0f, 0xd0, 0x4c, 0xff, 0x11, 0xb5, 0xaa, 0x41, 0x3a, 0xcd, 0x9e, 0x7e, 0x77, 0x0a, 0xee, 0x7a,
+	0x8b, 0x79, 0x08, 0x0f, 0xcf, 0x32, 0x9e, 0x9e, 0x0b, 0xc9, 0xa8, 0x01, 0x5b, 0x09, 0x5e, 0xde,
+	0xe4, 0x51, 0x0c, 0x83, 0x04, 0x2f, 0x8b, 0x30, 0xe8, 0x63, 0xa8, 0xcf, 0x85, 0x64, 0x59, 0x3e,
+	0xa9, 0xe1, 0xaa, 0x87, 0xf9, 0x87, 0xc0, 0x56, 0xa1, 0xde, 0x93, 0x81, 0x64, 0xf4, 0x33, 0xec,
+	0x06, 0xca, 0x86, 0xbf, 0x2e, 0x8f, 0x18, 0xb5, 0x4e, 0xb3, 0xb7, 0x77, 0x8b, 0xbc, 0xff, 0x7a,
+	0x77, 0x69, 0xb0, 0x99, 0xc7, 0x1e, 0xd0, 0x88, 0x63, 0x70, 0x11, 0xb3, 0x68, 0x63, 0x79, 0xed,
+	0xb2, 0x52, 0xee, 0x8f, 0xbe, 0x81, 0x96, 0xcc, 0x78, 0xea, 0x87, 0x22, 0x49, 0xb8, 0x94, 0x8c,
+	0x69, 0x35, 0xb5, 0xe6, 0x15, 0x7a, 0x54, 0x82, 0xf4, 0x00, 0x20, 0xa7, 0xad, 0x2c, 0xa1, 0x76,
+	0x2f, 0x97, 0xfa, 0xe2, 0x16, 0xa9, 0x65, 0x5a, 0x6e, 0x43, 0x16, 0x5f, 0x78, 0xf8, 0xfe, 0xc7,
+	0x42, 0x27, 0xd7, 0x0b, 0x9d, 0xfc, 0x5e, 0xe8, 0xe4, 0xfb, 0x52, 0xaf, 0x5c, 0x2f, 0xf5, 0xca,
+	0xaf, 0xa5, 0x5e, 0xf9, 0xf4, 0x52, 0xfd, 0x00, 0xa3, 0x2f, 0x16, 0x17, 0xdd, 0x6f, 0x37, 0xa7,
+	0x9e, 0xdf, 0xf9, 0xc5, 0xfd, 0xfc, 0xd0, 0xdf, 0xfd, 0x1d, 0x00, 0xb9, 0xca, 0xdd, 0x8e, 0x09,
+	0x03, 0x00, 0x00,
 }
 
 func (m *Permissions) Marshal() (dAtA []byte, err error) {
@@ -345,6 +424,43 @@ func (m *GenesisAccountPermissions) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *TripVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TripVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TripVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -365,6 +481,29 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TripVotes) > 0 {
+		for iNdEx := len(m.TripVotes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TripVotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.TripCommittee) > 0 {
+		for iNdEx := len(m.TripCommittee) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TripCommittee[iNdEx])
+			copy(dAtA[i:], m.TripCommittee[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.TripCommittee[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.DisabledTypeUrls) > 0 {
 		for iNdEx := len(m.DisabledTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.DisabledTypeUrls[iNdEx])
@@ -437,6 +576,23 @@ func (m *GenesisAccountPermissions) Size() (n int) {
 	return n
 }
 
+func (m *TripVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
 func (m *GenesisState) Size() (n int) {
 	if m == nil {
 		return 0
@@ -455,6 +611,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovTypes(uint64(l))
 		}
 	}
+	if len(m.TripCommittee) > 0 {
+		for _, s := range m.TripCommittee {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.TripVotes) > 0 {
+		for _, e := range m.TripVotes {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -683,6 +851,120 @@ func (m *GenesisAccountPermissions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TripVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TripVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TripVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -778,6 +1060,72 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.DisabledTypeUrls = append(m.DisabledTypeUrls, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TripCommittee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TripCommittee = append(m.TripCommittee, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TripVotes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TripVotes = append(m.TripVotes, &TripVote{})
+			if err := m.TripVotes[len(m.TripVotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -19,5 +19,11 @@ func EndBlocker(ctx context.Context, k keeper.Keeper) {
 		// skip running the invariant check
 		return
 	}
+
+	if k.AsyncInvariantChecks() {
+		// the check runs in the background once the block is committed
+		k.ScheduleInvariantCheck(sdkCtx.BlockHeight())
+		return
+	}
 	k.AssertInvariants(sdkCtx)
 }
@@ -0,0 +1,6 @@
+package keeper
+
+// WaitInvariantCheck waits for the running background invariant check, if any, to finish.
+func (k *Keeper) WaitInvariantCheck() {
+	k.invChecker.wg.Wait()
+}
@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	"context"
+	"sync"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+)
+
+// invariantChecker tracks the invariant checks run in the background against
+// read-only snapshots of the committed state.
+type invariantChecker struct {
+	mu            sync.Mutex
+	wg            sync.WaitGroup
+	store         types.VersionedMultiStore
+	pendingHeight int64
+	running       bool
+	violations    chan error
+}
+
+func newInvariantChecker() *invariantChecker {
+	return &invariantChecker{
+		violations: make(chan error, 1),
+	}
+}
+
+// SetVersionedMultiStore sets the multi-store from which snapshots of the
+// committed state are opened, typically the app's CommitMultiStore. Once set,
+// the periodic invariant checks no longer block block execution: they run on a
+// separate goroutine and a broken invariant halts the chain at the next commit.
+func (k *Keeper) SetVersionedMultiStore(store types.VersionedMultiStore) {
+	k.invChecker.mu.Lock()
+	defer k.invChecker.mu.Unlock()
+	k.invChecker.store = store
+}
+
+// AsyncInvariantChecks returns true if the periodic invariant checks run in
+// the background.
+func (k *Keeper) AsyncInvariantChecks() bool {
+	k.invChecker.mu.Lock()
+	defer k.invChecker.mu.Unlock()
+	return k.invChecker.store != nil
+}
+
+// ScheduleInvariantCheck schedules an invariant check of the state committed
+// at the given height. The check is started by StartScheduledInvariantCheck
+// once the state is committed.
+func (k *Keeper) ScheduleInvariantCheck(height int64) {
+	k.invChecker.mu.Lock()
+	defer k.invChecker.mu.Unlock()
+	k.invChecker.pendingHeight = height
+}
+
+// StartScheduledInvariantCheck starts the scheduled invariant check, if any, on
+// a separate goroutine against a read-only snapshot of the committed state.
+// The check is skipped if the previous one is still running.
+func (k *Keeper) StartScheduledInvariantCheck(ctx context.Context) error {
+	c := k.invChecker
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	height := c.pendingHeight
+	if height == 0 || c.store == nil {
+		return nil
+	}
+	c.pendingHeight = 0
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	logger := k.Logger(ctx)
+	if c.running {
+		logger.Error("skipping invariant check, previous check still running", "height", height)
+		return nil
+	}
+
+	snapshot, err := c.store.CacheMultiStoreWithVersion(height)
+	if err != nil {
+		return err
+	}
+
+	// the goroutine gets a fresh context sharing nothing mutable with the
+	// check state context, which is reused for the next blocks
+	header := sdkCtx.BlockHeader()
+	snapshotCtx := sdk.NewContext(snapshot, false, logger).
+		WithBlockHeader(cmtproto.Header{ChainID: header.ChainID, Height: height, Time: header.Time}).
+		WithGasMeter(storetypes.NewInfiniteGasMeter())
+	c.running = true
+	c.wg.Add(1)
+	go func() {
+		defer func() {
+			c.mu.Lock()
+			c.running = false
+			c.mu.Unlock()
+			c.wg.Done()
+		}()
+
+		if err := k.assertInvariants(snapshotCtx); err != nil {
+			logger.Error("invariant broken", "height", height, "err", err)
+			select {
+			case c.violations <- err:
+			default:
+				// a violation is already waiting to halt the chain
+			}
+		}
+	}()
+
+	return nil
+}
+
+// InvariantViolation returns the error of the first broken invariant reported
+// by a background check since the last call, if any. It doesn't block.
+func (k *Keeper) InvariantViolation() error {
+	select {
+	case err := <-k.invChecker.violations:
+		return err
+	default:
+		return nil
+	}
+}
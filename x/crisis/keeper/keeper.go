@@ -32,6 +32,10 @@ type Keeper struct {
 
 	addressCodec address.Codec
 
+	// invChecker runs the invariant checks on a snapshot of the committed state
+	// when a VersionedMultiStore is set, it is shared by all copies of the keeper.
+	invChecker *invariantChecker
+
 	Schema      collections.Schema
 	ConstantFee collections.Item[sdk.Coin]
 }
@@ -51,6 +55,7 @@ func NewKeeper(
 		feeCollectorName: feeCollectorName,
 		authority:        authority,
 		addressCodec:     ac,
+		invChecker:       newInvariantChecker(),
 
 		ConstantFee: collections.NewItem(sb, types.ConstantFeeKey, "constant_fee", codec.CollValue[sdk.Coin](cdc)),
 	}
@@ -96,12 +101,19 @@ func (k *Keeper) Invariants() []sdk.Invariant {
 // AssertInvariants asserts all registered invariants. If any invariant fails,
 // the method panics.
 func (k *Keeper) AssertInvariants(ctx context.Context) {
-	logger := k.Logger(ctx)
+	if err := k.assertInvariants(sdk.UnwrapSDKContext(ctx)); err != nil {
+		panic(err)
+	}
+}
+
+// assertInvariants asserts all registered invariants and returns an error
+// describing the first broken one.
+func (k *Keeper) assertInvariants(sdkCtx sdk.Context) error {
+	logger := k.Logger(sdkCtx)
 
 	start := time.Now()
 	invarRoutes := k.Routes()
 	n := len(invarRoutes)
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	for i, ir := range invarRoutes {
 		logger.Info("asserting crisis invariants", "inv", fmt.Sprint(i+1, "/", n), "name", ir.FullRoute())
 
@@ -109,14 +121,15 @@ func (k *Keeper) AssertInvariants(ctx context.Context) {
 		if res, stop := ir.Invar(invCtx); stop {
 			// TODO: Include app name as part of context to allow for this to be
 			// variable.
-			panic(fmt.Errorf("invariant broken: %s\n"+
+			return fmt.Errorf("invariant broken: %s\n"+
 				"\tCRITICAL please submit the following transaction:\n"+
-				"\t\t tx crisis invariant-broken %s %s", res, ir.ModuleName, ir.Route))
+				"\t\t tx crisis invariant-broken %s %s", res, ir.ModuleName, ir.Route)
 		}
 	}
 
 	diff := time.Since(start)
 	logger.Info("asserted all invariants", "duration", diff, "height", sdkCtx.BlockHeight())
+	return nil
 }
 
 // InvCheckPeriod returns the invariant checks period.
@@ -67,3 +67,83 @@ func TestAssertInvariants(t *testing.T) {
 	keeper.RegisterRoute("testModule", "testRoute2", func(sdk.Context) (string, bool) { return "", true })
 	require.Panics(t, func() { keeper.AssertInvariants(testCtx.Ctx) })
 }
+
+func TestAsyncInvariantChecks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	supplyKeeper := crisistestutil.NewMockSupplyKeeper(ctrl)
+
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, crisis.AppModule{})
+	keeper := keeper.NewKeeper(encCfg.Codec, storeService, 5, supplyKeeper, "", "", addresscodec.NewBech32Codec("cosmos"))
+
+	keeper.RegisterRoute("testModule", "testRoute", func(ctx sdk.Context) (string, bool) {
+		return "bad state", string(ctx.KVStore(key).Get([]byte("state"))) == "bad"
+	})
+	require.False(t, keeper.AsyncInvariantChecks())
+	keeper.SetVersionedMultiStore(testCtx.CMS)
+	require.True(t, keeper.AsyncInvariantChecks())
+
+	// nothing scheduled
+	require.NoError(t, keeper.StartScheduledInvariantCheck(testCtx.Ctx))
+	keeper.WaitInvariantCheck()
+	require.NoError(t, keeper.InvariantViolation())
+
+	testCtx.Ctx.KVStore(key).Set([]byte("state"), []byte("good"))
+	commitID := testCtx.CMS.Commit()
+	keeper.ScheduleInvariantCheck(commitID.Version)
+	require.NoError(t, keeper.StartScheduledInvariantCheck(testCtx.Ctx))
+	keeper.WaitInvariantCheck()
+	require.NoError(t, keeper.InvariantViolation())
+
+	// the check runs against the committed snapshot, not the working state
+	testCtx.Ctx.KVStore(key).Set([]byte("state"), []byte("bad"))
+	commitID = testCtx.CMS.Commit()
+	testCtx.Ctx.KVStore(key).Set([]byte("state"), []byte("good"))
+	keeper.ScheduleInvariantCheck(commitID.Version)
+	require.NoError(t, keeper.StartScheduledInvariantCheck(testCtx.Ctx))
+	keeper.WaitInvariantCheck()
+	require.ErrorContains(t, keeper.InvariantViolation(), "invariant broken: bad state")
+	require.NoError(t, keeper.InvariantViolation())
+}
+
+func TestAsyncInvariantChecksModule(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	supplyKeeper := crisistestutil.NewMockSupplyKeeper(ctrl)
+
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, crisis.AppModule{})
+	keeper := keeper.NewKeeper(encCfg.Codec, storeService, 2, supplyKeeper, "", "", addresscodec.NewBech32Codec("cosmos"))
+	keeper.SetVersionedMultiStore(testCtx.CMS)
+	module := crisis.NewAppModule(keeper, encCfg.Codec, true)
+
+	keeper.RegisterRoute("testModule", "testRoute", func(ctx sdk.Context) (string, bool) {
+		return "bad state", string(ctx.KVStore(key).Get([]byte("state"))) == "bad"
+	})
+
+	// commits a block at the given height with the given state, running the
+	// end blocker before the commit and starting the check after it
+	commitBlock := func(height int64, state string) {
+		ctx := testCtx.Ctx.WithBlockHeight(height)
+		ctx.KVStore(key).Set([]byte("state"), []byte(state))
+		require.NoError(t, module.EndBlock(ctx))
+		require.NoError(t, module.Precommit(ctx))
+		require.Equal(t, height, testCtx.CMS.Commit().Version)
+		require.NoError(t, module.PrepareCheckState(ctx))
+		keeper.WaitInvariantCheck()
+	}
+
+	commitBlock(1, "good")
+	commitBlock(2, "good")
+
+	// the broken state of a block not checked is not reported
+	commitBlock(3, "bad")
+	require.NoError(t, module.Precommit(testCtx.Ctx))
+
+	// the broken state of a checked block halts the chain at the next commit
+	commitBlock(4, "bad")
+	require.ErrorContains(t, module.Precommit(testCtx.Ctx), "invariant broken: bad state")
+}
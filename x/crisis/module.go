@@ -32,6 +32,8 @@ var (
 	_ appmodule.HasMigrations         = AppModule{}
 	_ appmodule.HasRegisterInterfaces = AppModule{}
 	_ appmodule.HasGenesis            = AppModule{}
+	_ appmodule.HasPrecommit          = AppModule{}
+	_ appmodule.HasPrepareCheckState  = AppModule{}
 )
 
 // Module init related flags
@@ -150,3 +152,15 @@ func (am AppModule) EndBlock(ctx context.Context) error {
 	EndBlocker(ctx, *am.keeper)
 	return nil
 }
+
+// Precommit returns the first invariant violation reported by a background
+// invariant check, halting the chain before the block is committed.
+func (am AppModule) Precommit(_ context.Context) error {
+	return am.keeper.InvariantViolation()
+}
+
+// PrepareCheckState starts the invariant check scheduled by the end blocker
+// against a snapshot of the freshly committed state.
+func (am AppModule) PrepareCheckState(ctx context.Context) error {
+	return am.keeper.StartScheduledInvariantCheck(ctx)
+}
@@ -3,6 +3,8 @@ package types
 import (
 	context "context"
 
+	storetypes "cosmossdk.io/store/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -10,3 +12,9 @@ import (
 type SupplyKeeper interface {
 	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 }
+
+// VersionedMultiStore defines the expected multi-store from which read-only
+// snapshots of committed state are opened, typically the app's CommitMultiStore (noalias)
+type VersionedMultiStore interface {
+	CacheMultiStoreWithVersion(version int64) (storetypes.CacheMultiStore, error)
+}
@@ -79,6 +79,35 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "delegator_address"},
 					},
 				},
+				{
+					RpcMethod: "DelegationRewardsByDenom",
+					Use:       "rewards-by-denom [delegator-addr] [denom]",
+					Short:     "Query the distribution delegator rewards of a single denom",
+					Long:      "Query the rewards of a single denom earned by each delegation of a delegator",
+					Example:   fmt.Sprintf("$ %s query distribution rewards-by-denom [delegator-address] stake", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "delegator_address"},
+						{ProtoField: "denom"},
+					},
+				},
+				{
+					RpcMethod: "DelegatorAutoCompounds",
+					Use:       "auto-compounds [delegator-addr]",
+					Short:     "Query the delegations of a delegator whose rewards are automatically compounded",
+					Example:   fmt.Sprintf("$ %s query distribution auto-compounds [delegator-address]", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "delegator_address"},
+					},
+				},
+				{
+					RpcMethod: "DelegatorWithdrawAddressPolicy",
+					Use:       "withdraw-address-policy [delegator-addr]",
+					Short:     "Query the split of the withdrawn rewards of a delegator across several addresses",
+					Example:   fmt.Sprintf("$ %s query distribution withdraw-address-policy [delegator-address]", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "delegator_address"},
+					},
+				},
 				{
 					RpcMethod: "CommunityPool",
 					Use:       "community-pool",
@@ -125,6 +154,34 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "amount", Varargs: true},
 					},
 				},
+				{
+					RpcMethod: "SetAutoCompound",
+					Use:       "set-auto-compound [validator-addr] [enabled]",
+					Short:     "Enable or disable the automatic re-delegation of the rewards of a delegation",
+					Example:   fmt.Sprintf("%s tx distribution set-auto-compound cosmosvaloper1x20lytyf6zkcrv5edpkfkn8sz578qg5sqfyqnp true --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_address"},
+						{ProtoField: "enabled"},
+					},
+				},
+				{
+					RpcMethod: "SetWithdrawAddressPolicy",
+					Use:       "set-withdraw-address-policy [split]...",
+					Short:     "Split the withdrawn rewards of a delegator across several addresses, or remove the split if none is given",
+					Example:   fmt.Sprintf(`%s tx distribution set-withdraw-address-policy '{"address":"cosmos1...","weight":"0.8"}' '{"address":"cosmos1...","weight":"0.2"}' --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "splits", Varargs: true},
+					},
+				},
+				{
+					RpcMethod: "WithdrawTokenizeShareRecordReward",
+					Use:       "withdraw-tokenize-share-record-reward [record-id] --from [owner]",
+					Short:     "Withdraw the rewards of the delegation of a tokenize share record to its owner",
+					Example:   fmt.Sprintf("%s tx distribution withdraw-tokenize-share-record-reward 1 --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "record_id"},
+					},
+				},
 				{
 					RpcMethod:  "FundCommunityPool",
 					Deprecated: fmt.Sprintf("Use %s tx protocolpool fund-community-pool", version.AppName),
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"context"
 	"time"
 
 	"cosmossdk.io/x/distribution/types"
@@ -40,3 +41,11 @@ func (k Keeper) BeginBlocker(ctx sdk.Context) error {
 	consAddr := sdk.ConsAddress(ctx.BlockHeader().ProposerAddress)
 	return k.PreviousProposer.Set(ctx, consAddr)
 }
+
+// EndBlocker re-delegates the rewards of the auto-compounded delegations which
+// are due at the current height.
+func (k Keeper) EndBlocker(ctx context.Context) error {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	return k.ProcessAutoCompounds(ctx)
+}
@@ -0,0 +1,259 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/distribution/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetAutoCompound opts the delegation in or out of the automatic re-delegation
+// of its rewards. A delegation opted in is first compounded after
+// AutoCompoundInterval blocks. Opting in an already auto-compounded delegation
+// keeps its schedule.
+func (k Keeper) SetAutoCompound(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, enabled bool) error {
+	var err error
+	if enabled {
+		err = k.addAutoCompound(ctx, delAddr, valAddr)
+	} else {
+		err = k.removeAutoCompound(ctx, delAddr, valAddr)
+	}
+	if err != nil {
+		return err
+	}
+
+	delStr, err := k.authKeeper.AddressCodec().BytesToString(delAddr)
+	if err != nil {
+		return err
+	}
+
+	valStr, err := k.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+	if err != nil {
+		return err
+	}
+
+	return k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeSetAutoCompound,
+		event.NewAttribute(types.AttributeKeyDelegator, delStr),
+		event.NewAttribute(types.AttributeKeyValidator, valStr),
+		event.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(enabled)),
+	)
+}
+
+// GetDelegatorAutoCompounds returns the auto-compounded delegations of the delegator.
+func (k Keeper) GetDelegatorAutoCompounds(ctx context.Context, delAddr sdk.AccAddress) ([]types.AutoCompound, error) {
+	delStr, err := k.authKeeper.AddressCodec().BytesToString(delAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	autoCompounds := []types.AutoCompound{}
+	rng := collections.NewPrefixedPairRange[sdk.AccAddress, sdk.ValAddress](delAddr)
+	err = k.AutoCompounds.Walk(ctx, rng, func(key collections.Pair[sdk.AccAddress, sdk.ValAddress], nextHeight uint64) (bool, error) {
+		valStr, err := k.stakingKeeper.ValidatorAddressCodec().BytesToString(key.K2())
+		if err != nil {
+			return true, err
+		}
+
+		autoCompounds = append(autoCompounds, types.AutoCompound{
+			DelegatorAddress: delStr,
+			ValidatorAddress: valStr,
+			NextHeight:       nextHeight,
+		})
+		return false, nil
+	})
+
+	return autoCompounds, err
+}
+
+// ProcessAutoCompounds re-delegates the rewards of the delegations due for
+// auto-compounding at the current height, up to MaxAutoCompoundsPerBlock of
+// them in key order, so that the processing cost of a block is bounded. The
+// delegations left over are processed in the following blocks. A delegation
+// which cannot be compounded, e.g. because its withdraw address has been
+// changed, is skipped until its next interval.
+func (k Keeper) ProcessAutoCompounds(ctx context.Context) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.AutoCompoundInterval == 0 || params.MaxAutoCompoundsPerBlock == 0 {
+		return nil
+	}
+
+	height := uint64(k.environment.HeaderService.GetHeaderInfo(ctx).Height)
+
+	var due []collections.Triple[uint64, sdk.AccAddress, sdk.ValAddress]
+	rng := collections.NewPrefixUntilTripleRange[uint64, sdk.AccAddress, sdk.ValAddress](height)
+	err = k.AutoCompoundQueue.Walk(ctx, rng, func(key collections.Triple[uint64, sdk.AccAddress, sdk.ValAddress]) (bool, error) {
+		due = append(due, key)
+		return uint32(len(due)) >= params.MaxAutoCompoundsPerBlock, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, key := range due {
+		delAddr, valAddr := key.K2(), key.K3()
+		if err := k.AutoCompoundQueue.Remove(ctx, key); err != nil {
+			return err
+		}
+
+		_, err := k.stakingKeeper.Delegation(ctx, delAddr, valAddr)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+		if err != nil {
+			// the delegation has been removed since it was scheduled
+			if err := k.AutoCompounds.Remove(ctx, collections.Join(delAddr, valAddr)); err != nil {
+				return err
+			}
+			continue
+		}
+
+		err = k.environment.BranchService.Execute(ctx, func(ctx context.Context) error {
+			return k.autoCompound(ctx, delAddr, valAddr)
+		})
+		if err != nil {
+			k.Logger(ctx).Info("skipping auto-compounding of delegation", "delegator", delAddr.String(), "validator", valAddr.String(), "err", err)
+		}
+
+		if err := k.scheduleAutoCompound(ctx, delAddr, valAddr, height+params.AutoCompoundInterval); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// autoCompound withdraws the rewards of the delegation and re-delegates those
+// in the bond denom to its validator.
+func (k Keeper) autoCompound(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	if err := k.checkRewardsWithdrawnToDelegator(ctx, delAddr); err != nil {
+		return err
+	}
+
+	rewards, err := k.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+	if err != nil {
+		return err
+	}
+
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+
+	amount := rewards.AmountOf(bondDenom)
+	if !amount.IsPositive() {
+		return nil
+	}
+
+	validator, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	if _, err := k.stakingKeeper.Delegate(ctx, delAddr, amount, stakingtypes.Unbonded, validator, true); err != nil {
+		return err
+	}
+
+	delStr, err := k.authKeeper.AddressCodec().BytesToString(delAddr)
+	if err != nil {
+		return err
+	}
+
+	return k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeAutoCompound,
+		event.NewAttribute(types.AttributeKeyDelegator, delStr),
+		event.NewAttribute(types.AttributeKeyValidator, validator.GetOperator()),
+		event.NewAttribute(sdk.AttributeKeyAmount, sdk.NewCoin(bondDenom, amount).String()),
+	)
+}
+
+// addAutoCompound opts the delegation in to auto-compounding, if it was not
+// opted in yet.
+func (k Keeper) addAutoCompound(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.AutoCompoundInterval == 0 {
+		return types.ErrAutoCompoundDisabled
+	}
+
+	if _, err := k.stakingKeeper.Delegation(ctx, delAddr, valAddr); err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.ErrNoDelegationExists
+		}
+		return err
+	}
+
+	if err := k.checkRewardsWithdrawnToDelegator(ctx, delAddr); err != nil {
+		return err
+	}
+
+	has, err := k.AutoCompounds.Has(ctx, collections.Join(delAddr, valAddr))
+	if err != nil || has {
+		return err
+	}
+
+	height := uint64(k.environment.HeaderService.GetHeaderInfo(ctx).Height)
+	return k.scheduleAutoCompound(ctx, delAddr, valAddr, height+params.AutoCompoundInterval)
+}
+
+// checkRewardsWithdrawnToDelegator checks that the withdrawn rewards of the
+// delegator are sent back to it, so that they can be re-delegated.
+func (k Keeper) checkRewardsWithdrawnToDelegator(ctx context.Context, delAddr sdk.AccAddress) error {
+	withdrawAddr, err := k.GetDelegatorWithdrawAddr(ctx, delAddr)
+	if err != nil {
+		return err
+	}
+	if !withdrawAddr.Equals(delAddr) {
+		return types.ErrAutoCompoundWithdrawAddr
+	}
+
+	has, err := k.WithdrawAddressPolicies.Has(ctx, delAddr)
+	if err != nil {
+		return err
+	}
+	if has {
+		return errorsmod.Wrap(types.ErrAutoCompoundWithdrawAddr, "the delegator has a withdraw address policy")
+	}
+
+	return nil
+}
+
+// scheduleAutoCompound schedules the auto-compounding of the delegation at the given height.
+func (k Keeper) scheduleAutoCompound(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, height uint64) error {
+	if err := k.AutoCompounds.Set(ctx, collections.Join(delAddr, valAddr), height); err != nil {
+		return err
+	}
+
+	return k.AutoCompoundQueue.Set(ctx, collections.Join3(height, delAddr, valAddr))
+}
+
+// removeAutoCompound opts the delegation out of auto-compounding, if it was opted in.
+func (k Keeper) removeAutoCompound(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	height, err := k.AutoCompounds.Get(ctx, collections.Join(delAddr, valAddr))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	if err := k.AutoCompounds.Remove(ctx, collections.Join(delAddr, valAddr)); err != nil {
+		return err
+	}
+
+	return k.AutoCompoundQueue.Remove(ctx, collections.Join3(height, delAddr, valAddr))
+}
@@ -0,0 +1,118 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/distribution/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestSetAutoCompound(t *testing.T) {
+	ctx, addrs, distrKeeper, dep := initFixture(t)
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 10})
+
+	delAddr := addrs[0]
+	valAddr := sdk.ValAddress(addrs[1])
+
+	// auto-compounding is disabled by default
+	err := distrKeeper.SetAutoCompound(ctx, delAddr, valAddr, true)
+	require.ErrorIs(t, err, types.ErrAutoCompoundDisabled)
+
+	params := types.DefaultParams()
+	params.AutoCompoundInterval = 5
+	require.NoError(t, distrKeeper.Params.Set(ctx, params))
+
+	// the delegation must exist
+	dep.stakingKeeper.EXPECT().Delegation(gomock.Any(), delAddr, valAddr).Return(stakingtypes.Delegation{}, collections.ErrNotFound)
+	err = distrKeeper.SetAutoCompound(ctx, delAddr, valAddr, true)
+	require.ErrorIs(t, err, types.ErrNoDelegationExists)
+
+	dep.stakingKeeper.EXPECT().Delegation(gomock.Any(), delAddr, valAddr).Return(stakingtypes.Delegation{}, nil).AnyTimes()
+
+	// the rewards must be withdrawn to the delegator
+	require.NoError(t, distrKeeper.DelegatorsWithdrawAddress.Set(ctx, delAddr, addrs[1]))
+	err = distrKeeper.SetAutoCompound(ctx, delAddr, valAddr, true)
+	require.ErrorIs(t, err, types.ErrAutoCompoundWithdrawAddr)
+	require.NoError(t, distrKeeper.DelegatorsWithdrawAddress.Remove(ctx, delAddr))
+
+	require.NoError(t, distrKeeper.SetAutoCompound(ctx, delAddr, valAddr, true))
+	autoCompounds, err := distrKeeper.GetDelegatorAutoCompounds(ctx, delAddr)
+	require.NoError(t, err)
+	require.Len(t, autoCompounds, 1)
+	require.Equal(t, uint64(15), autoCompounds[0].NextHeight)
+
+	// opting in again keeps the schedule
+	require.NoError(t, distrKeeper.SetAutoCompound(ctx.WithHeaderInfo(header.Info{Height: 12}), delAddr, valAddr, true))
+	next, err := distrKeeper.AutoCompounds.Get(ctx, collections.Join(delAddr, valAddr))
+	require.NoError(t, err)
+	require.Equal(t, uint64(15), next)
+
+	require.NoError(t, distrKeeper.SetAutoCompound(ctx, delAddr, valAddr, false))
+	has, err := distrKeeper.AutoCompounds.Has(ctx, collections.Join(delAddr, valAddr))
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = distrKeeper.AutoCompoundQueue.Has(ctx, collections.Join3(uint64(15), delAddr, valAddr))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// opting out is a no-op when not opted in
+	require.NoError(t, distrKeeper.SetAutoCompound(ctx, delAddr, valAddr, false))
+}
+
+func TestProcessAutoCompounds(t *testing.T) {
+	ctx, addrs, distrKeeper, dep := initFixture(t)
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 10})
+
+	params := types.DefaultParams()
+	params.AutoCompoundInterval = 5
+	params.MaxAutoCompoundsPerBlock = 1
+	require.NoError(t, distrKeeper.Params.Set(ctx, params))
+
+	valAddr := sdk.ValAddress(addrs[1])
+	removedDel := sdk.AccAddress([]byte("removed_delegator___"))
+
+	dep.stakingKeeper.EXPECT().Delegation(gomock.Any(), addrs[0], valAddr).Return(stakingtypes.Delegation{}, nil).AnyTimes()
+	dep.stakingKeeper.EXPECT().Delegation(gomock.Any(), removedDel, valAddr).Return(stakingtypes.Delegation{}, collections.ErrNotFound).AnyTimes()
+	// withdrawing fails as the validator is unknown, so that the delegation is
+	// only rescheduled
+	dep.stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(nil, nil).AnyTimes()
+
+	require.NoError(t, distrKeeper.SetAutoCompound(ctx, addrs[0], valAddr, true))
+	require.NoError(t, distrKeeper.AutoCompounds.Set(ctx, collections.Join(removedDel, valAddr), 15))
+	require.NoError(t, distrKeeper.AutoCompoundQueue.Set(ctx, collections.Join3(uint64(15), removedDel, valAddr)))
+
+	// nothing is due yet
+	require.NoError(t, distrKeeper.ProcessAutoCompounds(ctx.WithHeaderInfo(header.Info{Height: 14})))
+	next, err := distrKeeper.AutoCompounds.Get(ctx, collections.Join(addrs[0], valAddr))
+	require.NoError(t, err)
+	require.Equal(t, uint64(15), next)
+
+	// only one delegation is processed per block, in key order: the removed
+	// delegation is dropped first
+	require.NoError(t, distrKeeper.ProcessAutoCompounds(ctx.WithHeaderInfo(header.Info{Height: 15})))
+	has, err := distrKeeper.AutoCompounds.Has(ctx, collections.Join(removedDel, valAddr))
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = distrKeeper.AutoCompoundQueue.Has(ctx, collections.Join3(uint64(15), removedDel, valAddr))
+	require.NoError(t, err)
+	require.False(t, has)
+	next, err = distrKeeper.AutoCompounds.Get(ctx, collections.Join(addrs[0], valAddr))
+	require.NoError(t, err)
+	require.Equal(t, uint64(15), next)
+
+	// the other delegation is rescheduled in the next block
+	require.NoError(t, distrKeeper.ProcessAutoCompounds(ctx.WithHeaderInfo(header.Info{Height: 16})))
+	next, err = distrKeeper.AutoCompounds.Get(ctx, collections.Join(addrs[0], valAddr))
+	require.NoError(t, err)
+	require.Equal(t, uint64(21), next)
+	has, err = distrKeeper.AutoCompoundQueue.Has(ctx, collections.Join3(uint64(21), addrs[0], valAddr))
+	require.NoError(t, err)
+	require.True(t, has)
+}
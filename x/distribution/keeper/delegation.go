@@ -257,13 +257,7 @@ func (k Keeper) withdrawDelegationRewards(ctx context.Context, val sdk.Validator
 
 	// add coins to user account
 	if !finalRewards.IsZero() {
-		withdrawAddr, err := k.GetDelegatorWithdrawAddr(ctx, delAddr)
-		if err != nil {
-			return nil, err
-		}
-
-		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, finalRewards)
-		if err != nil {
+		if err := k.sendDelegationRewards(ctx, delAddr, finalRewards); err != nil {
 			return nil, err
 		}
 	}
@@ -590,6 +590,108 @@ func TestWithdrawDelegationRewardsBasic(t *testing.T) {
 	require.Nil(t, err)
 }
 
+func TestWithdrawDelegationRewardsWithPolicy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, distribution.AppModule{})
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Height: 1})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+	poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec(sdk.Bech32PrefixValAddr)).AnyTimes()
+	accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec(sdk.Bech32MainPrefix)).AnyTimes()
+
+	env := runtime.NewEnvironment(runtime.NewKVStoreService(key), log.NewNopLogger())
+
+	authorityAddr, err := accountKeeper.AddressCodec().BytesToString(authtypes.NewModuleAddress("gov"))
+	require.NoError(t, err)
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		env,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		poolKeeper,
+		"fee_collector",
+		authorityAddr,
+	)
+
+	// reset fee pool
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+	require.NoError(t, distrKeeper.Params.Set(ctx, disttypes.DefaultParams()))
+
+	// create validator with 50% commission
+	valAddr := sdk.ValAddress(valConsAddr0)
+	addr := sdk.AccAddress(valAddr)
+	operatorAddr, err := stakingKeeper.ValidatorAddressCodec().BytesToString(valConsPk0.Address())
+	require.NoError(t, err)
+	val, err := distrtestutil.CreateValidator(valConsPk0, operatorAddr, math.NewInt(100))
+	require.NoError(t, err)
+
+	val.Commission = stakingtypes.NewCommission(math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDec(0))
+
+	addrStr, err := accountKeeper.AddressCodec().BytesToString(addr)
+	require.NoError(t, err)
+	valAddrStr, err := stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+	require.NoError(t, err)
+
+	// delegation mock
+	del := stakingtypes.NewDelegation(addrStr, valAddrStr, val.DelegatorShares)
+	stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val, nil).Times(5)
+	stakingKeeper.EXPECT().Delegation(gomock.Any(), addr, valAddr).Return(del, nil).Times(3)
+
+	// run the necessary hooks manually (given that we are not running an actual staking module)
+	err = distrtestutil.CallCreateValidatorHooks(ctx, distrKeeper, addr, valAddr)
+	require.NoError(t, err)
+
+	// next block
+	ctx = ctx.WithHeaderInfo(header.Info{Height: ctx.HeaderInfo().Height + 1})
+
+	// allocate some rewards
+	initial := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
+	tokens := sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)}
+
+	require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, val, tokens))
+
+	// historical count should be 2 (initial + latest for delegation)
+	require.Equal(t, 2, getValHistoricalReferenceCount(distrKeeper, ctx))
+
+	// split the rewards of the delegator across two addresses
+	opsAddr, treasuryAddr := sdk.AccAddress([]byte("ops_________________")), sdk.AccAddress([]byte("treasury____________"))
+	opsAddrStr, err := accountKeeper.AddressCodec().BytesToString(opsAddr)
+	require.NoError(t, err)
+	treasuryAddrStr, err := accountKeeper.AddressCodec().BytesToString(treasuryAddr)
+	require.NoError(t, err)
+	require.NoError(t, distrKeeper.WithdrawAddressPolicies.Set(ctx, addr, disttypes.WithdrawAddressPolicy{
+		DelegatorAddress: addrStr,
+		Splits: []disttypes.WithdrawAddressSplit{
+			{Address: opsAddrStr, Weight: math.LegacyNewDecWithPrec(8, 1)},
+			{Address: treasuryAddrStr, Weight: math.LegacyNewDecWithPrec(2, 1)},
+		},
+	}))
+
+	// withdraw rewards (the bank keeper should be called once per split)
+	expOpsRewards := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, initial.QuoRaw(2).MulRaw(8).QuoRaw(10))}
+	expTreasuryRewards := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, initial.QuoRaw(2).MulRaw(2).QuoRaw(10))}
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, disttypes.ModuleName, opsAddr, expOpsRewards)
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, disttypes.ModuleName, treasuryAddr, expTreasuryRewards)
+	rewards, err := distrKeeper.WithdrawDelegationRewards(ctx, addr, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, expOpsRewards.Add(expTreasuryRewards...), rewards)
+
+	// commissions are still sent to the withdraw address
+	expCommission := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, initial.QuoRaw(2))}
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, disttypes.ModuleName, addr, expCommission)
+	_, err = distrKeeper.WithdrawValidatorCommission(ctx, valAddr)
+	require.NoError(t, err)
+}
+
 func TestCalculateRewardsAfterManySlashesInSameBlock(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
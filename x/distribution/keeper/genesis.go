@@ -125,6 +125,34 @@ func (k Keeper) InitGenesis(ctx context.Context, data types.GenesisState) error
 			return err
 		}
 	}
+	for _, ac := range data.AutoCompounds {
+		delAddr, err := k.authKeeper.AddressCodec().StringToBytes(ac.DelegatorAddress)
+		if err != nil {
+			return err
+		}
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(ac.ValidatorAddress)
+		if err != nil {
+			return err
+		}
+
+		if err := k.scheduleAutoCompound(ctx, delAddr, valAddr, ac.NextHeight); err != nil {
+			return err
+		}
+	}
+	for _, policy := range data.WithdrawAddressPolicies {
+		delAddr, err := k.authKeeper.AddressCodec().StringToBytes(policy.DelegatorAddress)
+		if err != nil {
+			return err
+		}
+
+		if err := types.ValidateWithdrawAddressSplits(policy.Splits); err != nil {
+			return err
+		}
+
+		if err := k.WithdrawAddressPolicies.Set(ctx, delAddr, policy); err != nil {
+			return err
+		}
+	}
 
 	moduleHoldings = moduleHoldings.Add(data.FeePool.DecimalPool...)
 	moduleHoldingsInt, _ := moduleHoldings.TruncateDecimal()
@@ -303,9 +331,44 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 
+	autoCompounds := make([]types.AutoCompound, 0)
+	err = k.AutoCompounds.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, sdk.ValAddress], nextHeight uint64) (stop bool, err error) {
+		delAddr, err := k.authKeeper.AddressCodec().BytesToString(key.K1())
+		if err != nil {
+			return true, err
+		}
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().BytesToString(key.K2())
+		if err != nil {
+			return true, err
+		}
+
+		autoCompounds = append(autoCompounds, types.AutoCompound{
+			DelegatorAddress: delAddr,
+			ValidatorAddress: valAddr,
+			NextHeight:       nextHeight,
+		})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	policies := make([]types.WithdrawAddressPolicy, 0)
+	err = k.WithdrawAddressPolicies.Walk(ctx, nil, func(_ sdk.AccAddress, policy types.WithdrawAddressPolicy) (stop bool, err error) {
+		policies = append(policies, policy)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	ppAddr, err := k.stakingKeeper.ConsensusAddressCodec().BytesToString(pp)
 	if err != nil {
 		return nil, err
 	}
-	return types.NewGenesisState(params, feePool, dwi, ppAddr, outstanding, acc, his, cur, dels, slashes), nil
+
+	genState := types.NewGenesisState(params, feePool, dwi, ppAddr, outstanding, acc, his, cur, dels, slashes)
+	genState.AutoCompounds = autoCompounds
+	genState.WithdrawAddressPolicies = policies
+	return genState, nil
 }
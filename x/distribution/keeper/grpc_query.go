@@ -8,6 +8,7 @@ import (
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/distribution/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -261,17 +262,66 @@ func (k Querier) DelegationTotalRewards(ctx context.Context, req *types.QueryDel
 		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
 	}
 
+	delAdr, err := k.authKeeper.AddressCodec().StringToBytes(req.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
 	total := sdk.DecCoins{}
 	var delRewards []types.DelegationDelegatorReward
 
+	err = k.iterateDelegationRewards(ctx, delAdr, func(valAddr string, rewards sdk.DecCoins) {
+		delRewards = append(delRewards, types.NewDelegationDelegatorReward(valAddr, rewards))
+		total = total.Add(rewards...)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDelegationTotalRewardsResponse{Rewards: delRewards, Total: total}, nil
+}
+
+// DelegationRewardsByDenom the rewards of a single denom accrued by each delegation of a delegator
+func (k Querier) DelegationRewardsByDenom(ctx context.Context, req *types.QueryDelegationRewardsByDenomRequest) (*types.QueryDelegationRewardsByDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	delAdr, err := k.authKeeper.AddressCodec().StringToBytes(req.DelegatorAddress)
 	if err != nil {
 		return nil, err
 	}
 
+	total := sdk.NewDecCoin(req.Denom, math.ZeroInt())
+	var delRewards []types.DelegationDenomReward
+
+	err = k.iterateDelegationRewards(ctx, delAdr, func(valAddr string, rewards sdk.DecCoins) {
+		reward := sdk.NewDecCoinFromDec(req.Denom, rewards.AmountOf(req.Denom))
+		delRewards = append(delRewards, types.DelegationDenomReward{ValidatorAddress: valAddr, Reward: reward})
+		total = total.Add(reward)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDelegationRewardsByDenomResponse{Rewards: delRewards, Total: total}, nil
+}
+
+// iterateDelegationRewards calls fn with the validator address and the rewards
+// accrued by each delegation of the delegator, after incrementing the period of
+// the validator.
+func (k Querier) iterateDelegationRewards(ctx context.Context, delAddr sdk.AccAddress, fn func(valAddr string, rewards sdk.DecCoins)) error {
 	var iterErr error
-	err = k.stakingKeeper.IterateDelegations(
-		ctx, delAdr,
+	err := k.stakingKeeper.IterateDelegations(
+		ctx, delAddr,
 		func(_ int64, del sdk.DelegationI) (stop bool) {
 			valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(del.GetValidatorAddr())
 			if err != nil {
@@ -297,19 +347,15 @@ func (k Querier) DelegationTotalRewards(ctx context.Context, req *types.QueryDel
 				return true
 			}
 
-			delRewards = append(delRewards, types.NewDelegationDelegatorReward(del.GetValidatorAddr(), delReward))
-			total = total.Add(delReward...)
+			fn(del.GetValidatorAddr(), delReward)
 			return false
 		},
 	)
 	if iterErr != nil {
-		return nil, iterErr
-	}
-	if err != nil {
-		return nil, err
+		return iterErr
 	}
 
-	return &types.QueryDelegationTotalRewardsResponse{Rewards: delRewards, Total: total}, nil
+	return err
 }
 
 // DelegatorValidators queries the validators list of a delegator
@@ -369,6 +415,50 @@ func (k Querier) DelegatorWithdrawAddress(ctx context.Context, req *types.QueryD
 	return &types.QueryDelegatorWithdrawAddressResponse{WithdrawAddress: addr}, nil
 }
 
+// DelegatorAutoCompounds queries the auto-compounded delegations of a delegator
+func (k Querier) DelegatorAutoCompounds(ctx context.Context, req *types.QueryDelegatorAutoCompoundsRequest) (*types.QueryDelegatorAutoCompoundsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+	delAdr, err := k.authKeeper.AddressCodec().StringToBytes(req.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	autoCompounds, err := k.GetDelegatorAutoCompounds(ctx, delAdr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDelegatorAutoCompoundsResponse{AutoCompounds: autoCompounds}, nil
+}
+
+// DelegatorWithdrawAddressPolicy queries the withdraw address policy of a delegator
+func (k Querier) DelegatorWithdrawAddressPolicy(ctx context.Context, req *types.QueryDelegatorWithdrawAddressPolicyRequest) (*types.QueryDelegatorWithdrawAddressPolicyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+	delAdr, err := k.authKeeper.AddressCodec().StringToBytes(req.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	splits, err := k.GetWithdrawAddressSplits(ctx, delAdr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDelegatorWithdrawAddressPolicyResponse{Splits: splits}, nil
+}
+
 // Deprecated: DO NOT USE
 // This method uses deprecated query request. Use CommunityPool from x/protocolpool module instead.
 // CommunityPool queries the community pool coins
@@ -52,6 +52,12 @@ type Keeper struct {
 	PreviousProposer           collections.Item[sdk.ConsAddress]
 	// ValidatorSlashEvents key: valAddr+height+period | value: ValidatorSlashEvent
 	ValidatorSlashEvents collections.Map[collections.Triple[sdk.ValAddress, uint64, uint64], types.ValidatorSlashEvent]
+	// AutoCompounds key: delAddr+valAddr | value: next auto-compounding height
+	AutoCompounds collections.Map[collections.Pair[sdk.AccAddress, sdk.ValAddress], uint64]
+	// AutoCompoundQueue key: height+delAddr+valAddr | value: none used (index key for AutoCompounds by next height)
+	AutoCompoundQueue collections.KeySet[collections.Triple[uint64, sdk.AccAddress, sdk.ValAddress]]
+	// WithdrawAddressPolicies key: delAddr | value: WithdrawAddressPolicy
+	WithdrawAddressPolicies collections.Map[sdk.AccAddress, types.WithdrawAddressPolicy]
 
 	feeCollectorName string // name of the FeeCollector ModuleAccount
 }
@@ -130,6 +136,26 @@ func NewKeeper(
 			collections.TripleKeyCodec(sdk.LengthPrefixedAddressKey(sdk.ValAddressKey), collections.Uint64Key, collections.Uint64Key), //nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed to retain state compatibility
 			codec.CollValue[types.ValidatorSlashEvent](cdc),
 		),
+		AutoCompounds: collections.NewMap(
+			sb,
+			types.AutoCompoundPrefix,
+			"auto_compounds",
+			collections.PairKeyCodec(sdk.AccAddressKey, sdk.ValAddressKey),
+			collections.Uint64Value,
+		),
+		AutoCompoundQueue: collections.NewKeySet(
+			sb,
+			types.AutoCompoundQueuePrefix,
+			"auto_compound_queue",
+			collections.TripleKeyCodec(collections.Uint64Key, sdk.AccAddressKey, sdk.ValAddressKey),
+		),
+		WithdrawAddressPolicies: collections.NewMap(
+			sb,
+			types.WithdrawAddressPolicyPrefix,
+			"withdraw_address_policies",
+			sdk.AccAddressKey,
+			codec.CollValue[types.WithdrawAddressPolicy](cdc),
+		),
 	}
 
 	schema, err := sb.Build()
@@ -211,6 +211,51 @@ func (k msgServer) DepositValidatorRewardsPool(ctx context.Context, msg *types.M
 	return &types.MsgDepositValidatorRewardsPoolResponse{}, nil
 }
 
+func (k msgServer) SetAutoCompound(ctx context.Context, msg *types.MsgSetAutoCompound) (*types.MsgSetAutoCompoundResponse, error) {
+	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	if err := k.Keeper.SetAutoCompound(ctx, delegatorAddress, valAddr, msg.Enabled); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetAutoCompoundResponse{}, nil
+}
+
+func (k msgServer) SetWithdrawAddressPolicy(ctx context.Context, msg *types.MsgSetWithdrawAddressPolicy) (*types.MsgSetWithdrawAddressPolicyResponse, error) {
+	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	if err := k.Keeper.SetWithdrawAddressPolicy(ctx, delegatorAddress, msg.Splits); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetWithdrawAddressPolicyResponse{}, nil
+}
+
+func (k msgServer) WithdrawTokenizeShareRecordReward(ctx context.Context, msg *types.MsgWithdrawTokenizeShareRecordReward) (*types.MsgWithdrawTokenizeShareRecordRewardResponse, error) {
+	ownerAddress, err := k.authKeeper.AddressCodec().StringToBytes(msg.OwnerAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid owner address: %s", err)
+	}
+
+	amount, err := k.Keeper.WithdrawTokenizeShareRecordReward(ctx, ownerAddress, msg.RecordId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWithdrawTokenizeShareRecordRewardResponse{Amount: amount}, nil
+}
+
 func (k *Keeper) validateAuthority(authority string) error {
 	if _, err := k.authKeeper.AddressCodec().StringToBytes(authority); err != nil {
 		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/distribution/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// WithdrawTokenizeShareRecordReward withdraws the rewards of the delegation of
+// a tokenize share record to its owner. The rewards already withdrawn to the
+// module account of the record, e.g. when share tokens were redeemed, are paid
+// out as well.
+func (k Keeper) WithdrawTokenizeShareRecordReward(ctx context.Context, ownerAddr sdk.AccAddress, recordID uint64) (sdk.Coins, error) {
+	record, err := k.stakingKeeper.GetTokenizeShareRecord(ctx, recordID)
+	if err != nil {
+		return nil, err
+	}
+
+	ownerStr, err := k.authKeeper.AddressCodec().BytesToString(ownerAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	if record.Owner != ownerStr {
+		return nil, errorsmod.Wrapf(types.ErrNotTokenizeShareRecordOwner, "%s is not the owner of record %d", ownerStr, recordID)
+	}
+
+	valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(record.Validator)
+	if err != nil {
+		return nil, err
+	}
+
+	// the delegation of the record is gone once all its share tokens are redeemed
+	recordAddr := record.GetModuleAddress()
+	_, err = k.stakingKeeper.Delegation(ctx, recordAddr, valAddr)
+	switch {
+	case err == nil:
+		if _, err := k.WithdrawDelegationRewards(ctx, recordAddr, valAddr); err != nil {
+			return nil, err
+		}
+	case !errors.Is(err, collections.ErrNotFound):
+		return nil, err
+	}
+
+	rewards := k.bankKeeper.GetAllBalances(ctx, recordAddr)
+	if !rewards.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, recordAddr, ownerAddr, rewards); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeWithdrawTokenizeShareRecordReward,
+		event.NewAttribute(types.AttributeKeyOwner, ownerStr),
+		event.NewAttribute(types.AttributeKeyRecordID, strconv.FormatUint(recordID, 10)),
+		event.NewAttribute(sdk.AttributeKeyAmount, rewards.String()),
+	); err != nil {
+		return nil, err
+	}
+
+	return rewards, nil
+}
@@ -0,0 +1,91 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/math"
+	distrtestutil "cosmossdk.io/x/distribution/testutil"
+	"cosmossdk.io/x/distribution/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestWithdrawTokenizeShareRecordReward(t *testing.T) {
+	ctx, addrs, distrKeeper, dep := initFixture(t)
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 1})
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, types.InitialFeePool()))
+
+	ownerAddr := addrs[0]
+	ownerStr, err := codectestutil.CodecOptions{}.GetAddressCodec().BytesToString(ownerAddr)
+	require.NoError(t, err)
+
+	// create validator with 50% commission
+	valAddr := sdk.ValAddress(valConsAddr0)
+	valStr, err := dep.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+	require.NoError(t, err)
+	val, err := distrtestutil.CreateValidator(valConsPk0, valStr, math.NewInt(100))
+	require.NoError(t, err)
+	val.Commission = stakingtypes.NewCommission(math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDec(0))
+	dep.stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val, nil).AnyTimes()
+
+	// a record holding the only delegation of the validator, and a fully
+	// redeemed one whose module account holds the rewards withdrawn when
+	// redeeming
+	record := stakingtypes.TokenizeShareRecord{
+		Id:            1,
+		Owner:         ownerStr,
+		ModuleAccount: stakingtypes.TokenizeShareModuleAccountName(1),
+		Validator:     valStr,
+		Delegator:     ownerStr,
+	}
+	redeemedRecord := record
+	redeemedRecord.Id = 2
+	redeemedRecord.ModuleAccount = stakingtypes.TokenizeShareModuleAccountName(2)
+	dep.stakingKeeper.EXPECT().GetTokenizeShareRecord(gomock.Any(), uint64(1)).Return(record, nil).AnyTimes()
+	dep.stakingKeeper.EXPECT().GetTokenizeShareRecord(gomock.Any(), uint64(2)).Return(redeemedRecord, nil).AnyTimes()
+
+	recordAddr := record.GetModuleAddress()
+	recordStr, err := codectestutil.CodecOptions{}.GetAddressCodec().BytesToString(recordAddr)
+	require.NoError(t, err)
+	del := stakingtypes.NewDelegation(recordStr, valStr, val.DelegatorShares)
+	dep.stakingKeeper.EXPECT().Delegation(gomock.Any(), recordAddr, valAddr).Return(del, nil).AnyTimes()
+	dep.stakingKeeper.EXPECT().Delegation(gomock.Any(), redeemedRecord.GetModuleAddress(), valAddr).Return(nil, collections.ErrNotFound).AnyTimes()
+
+	require.NoError(t, distrtestutil.CallCreateValidatorHooks(ctx, distrKeeper, recordAddr, valAddr))
+
+	// next block
+	ctx = ctx.WithHeaderInfo(header.Info{Height: ctx.HeaderInfo().Height + 1})
+
+	initial := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
+	require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)}))
+
+	// only the owner can withdraw the rewards
+	_, err = distrKeeper.WithdrawTokenizeShareRecordReward(ctx, addrs[1], 1)
+	require.ErrorIs(t, err, types.ErrNotTokenizeShareRecordOwner)
+
+	// the rewards are withdrawn to the module account of the record, then paid
+	// out to the owner along with the rewards already there
+	expRewards := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initial.QuoRaw(2)))
+	held := expRewards.Add(sdk.NewInt64Coin(sdk.DefaultBondDenom, 7))
+	dep.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, recordAddr, expRewards).Return(nil)
+	dep.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), recordAddr).Return(held)
+	dep.bankKeeper.EXPECT().SendCoins(gomock.Any(), recordAddr, ownerAddr, held).Return(nil)
+	rewards, err := distrKeeper.WithdrawTokenizeShareRecordReward(ctx, ownerAddr, 1)
+	require.NoError(t, err)
+	require.Equal(t, held, rewards)
+
+	// without a delegation only the rewards already withdrawn are paid out
+	redeemedRewards := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 3))
+	dep.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), redeemedRecord.GetModuleAddress()).Return(redeemedRewards)
+	dep.bankKeeper.EXPECT().SendCoins(gomock.Any(), redeemedRecord.GetModuleAddress(), ownerAddr, redeemedRewards).Return(nil)
+	rewards, err = distrKeeper.WithdrawTokenizeShareRecordReward(ctx, ownerAddr, 2)
+	require.NoError(t, err)
+	require.Equal(t, redeemedRewards, rewards)
+}
@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/distribution/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SetWithdrawAddressPolicy sets the policy splitting the withdrawn delegation
+// rewards of the delegator across several addresses. The policy takes
+// precedence over the withdraw address of the delegator. An empty list of
+// splits removes the policy.
+func (k Keeper) SetWithdrawAddressPolicy(ctx context.Context, delegatorAddr sdk.AccAddress, splits []types.WithdrawAddressSplit) error {
+	delStr, err := k.authKeeper.AddressCodec().BytesToString(delegatorAddr)
+	if err != nil {
+		return err
+	}
+
+	if len(splits) == 0 {
+		if err := k.WithdrawAddressPolicies.Remove(ctx, delegatorAddr); err != nil {
+			return err
+		}
+
+		return k.environment.EventService.EventManager(ctx).EmitKV(
+			types.EventTypeSetWithdrawAddressPolicy,
+			event.NewAttribute(types.AttributeKeyDelegator, delStr),
+			event.NewAttribute(types.AttributeKeySplits, ""),
+		)
+	}
+
+	withdrawAddrEnabled, err := k.GetWithdrawAddrEnabled(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !withdrawAddrEnabled {
+		return types.ErrSetWithdrawAddrDisabled
+	}
+
+	if err := types.ValidateWithdrawAddressSplits(splits); err != nil {
+		return err
+	}
+
+	formatted := make([]string, len(splits))
+	for i, split := range splits {
+		addr, err := k.authKeeper.AddressCodec().StringToBytes(split.Address)
+		if err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid withdraw address: %s", err)
+		}
+
+		if k.bankKeeper.BlockedAddr(addr) {
+			return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive external funds", split.Address)
+		}
+
+		formatted[i] = split.Address + ":" + split.Weight.String()
+	}
+
+	if err := k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeSetWithdrawAddressPolicy,
+		event.NewAttribute(types.AttributeKeyDelegator, delStr),
+		event.NewAttribute(types.AttributeKeySplits, strings.Join(formatted, ",")),
+	); err != nil {
+		return err
+	}
+
+	return k.WithdrawAddressPolicies.Set(ctx, delegatorAddr, types.WithdrawAddressPolicy{
+		DelegatorAddress: delStr,
+		Splits:           splits,
+	})
+}
+
+// GetWithdrawAddressSplits returns the splits of the withdraw address policy of
+// the delegator, or nil if it has none.
+func (k Keeper) GetWithdrawAddressSplits(ctx context.Context, delegatorAddr sdk.AccAddress) ([]types.WithdrawAddressSplit, error) {
+	policy, err := k.WithdrawAddressPolicies.Get(ctx, delegatorAddr)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return policy.Splits, nil
+}
+
+// sendDelegationRewards sends the withdrawn delegation rewards of the delegator
+// from the module account, split according to its withdraw address policy if
+// it has one, or else to its withdraw address.
+func (k Keeper) sendDelegationRewards(ctx context.Context, delAddr sdk.AccAddress, rewards sdk.Coins) error {
+	splits, err := k.GetWithdrawAddressSplits(ctx, delAddr)
+	if err != nil {
+		return err
+	}
+
+	if len(splits) == 0 {
+		withdrawAddr, err := k.GetDelegatorWithdrawAddr(ctx, delAddr)
+		if err != nil {
+			return err
+		}
+
+		return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, rewards)
+	}
+
+	for i, share := range types.SplitCoins(rewards, splits) {
+		if share.IsZero() {
+			continue
+		}
+
+		addr, err := k.authKeeper.AddressCodec().StringToBytes(splits[i].Address)
+		if err != nil {
+			return err
+		}
+
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, share); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/distribution/types"
+
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestSetWithdrawAddressPolicy(t *testing.T) {
+	ctx, addrs, distrKeeper, dep := initFixture(t)
+
+	delegatorAddr := addrs[0]
+	addr1Str, err := codectestutil.CodecOptions{}.GetAddressCodec().BytesToString(addrs[1])
+	require.NoError(t, err)
+	distrAccStr, err := codectestutil.CodecOptions{}.GetAddressCodec().BytesToString(distrAcc.GetAddress())
+	require.NoError(t, err)
+	delegatorStr, err := codectestutil.CodecOptions{}.GetAddressCodec().BytesToString(delegatorAddr)
+	require.NoError(t, err)
+	dep.bankKeeper.EXPECT().BlockedAddr(delegatorAddr).Return(false).AnyTimes()
+
+	splits := []types.WithdrawAddressSplit{
+		{Address: delegatorStr, Weight: math.LegacyNewDecWithPrec(8, 1)},
+		{Address: addr1Str, Weight: math.LegacyNewDecWithPrec(2, 1)},
+	}
+
+	params := types.DefaultParams()
+	params.WithdrawAddrEnabled = false
+	require.NoError(t, distrKeeper.Params.Set(ctx, params))
+	err = distrKeeper.SetWithdrawAddressPolicy(ctx, delegatorAddr, splits)
+	require.ErrorIs(t, err, types.ErrSetWithdrawAddrDisabled)
+
+	params.WithdrawAddrEnabled = true
+	require.NoError(t, distrKeeper.Params.Set(ctx, params))
+
+	// the weights must sum up to one
+	err = distrKeeper.SetWithdrawAddressPolicy(ctx, delegatorAddr, splits[:1])
+	require.ErrorIs(t, err, types.ErrInvalidWithdrawAddressPolicy)
+
+	// blocked addresses cannot receive rewards
+	err = distrKeeper.SetWithdrawAddressPolicy(ctx, delegatorAddr, []types.WithdrawAddressSplit{
+		{Address: distrAccStr, Weight: math.LegacyNewDecWithPrec(8, 1)},
+		{Address: addr1Str, Weight: math.LegacyNewDecWithPrec(2, 1)},
+	})
+	require.Error(t, err)
+
+	require.NoError(t, distrKeeper.SetWithdrawAddressPolicy(ctx, delegatorAddr, splits))
+	got, err := distrKeeper.GetWithdrawAddressSplits(ctx, delegatorAddr)
+	require.NoError(t, err)
+	require.Equal(t, splits, got)
+
+	// empty splits remove the policy
+	require.NoError(t, distrKeeper.SetWithdrawAddressPolicy(ctx, delegatorAddr, nil))
+	got, err = distrKeeper.GetWithdrawAddressSplits(ctx, delegatorAddr)
+	require.NoError(t, err)
+	require.Empty(t, got)
+}
+
+func TestWithdrawAddressPolicyPreventsAutoCompound(t *testing.T) {
+	ctx, addrs, distrKeeper, dep := initFixture(t)
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 10})
+
+	delAddr := addrs[0]
+	valAddr := sdk.ValAddress(addrs[1])
+	delStr, err := codectestutil.CodecOptions{}.GetAddressCodec().BytesToString(delAddr)
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.AutoCompoundInterval = 5
+	require.NoError(t, distrKeeper.Params.Set(ctx, params))
+	dep.stakingKeeper.EXPECT().Delegation(gomock.Any(), delAddr, valAddr).Return(nil, nil).AnyTimes()
+
+	require.NoError(t, distrKeeper.WithdrawAddressPolicies.Set(ctx, delAddr, types.WithdrawAddressPolicy{
+		DelegatorAddress: delStr,
+		Splits:           []types.WithdrawAddressSplit{{Address: delStr, Weight: math.LegacyOneDec()}},
+	}))
+
+	err = distrKeeper.SetAutoCompound(ctx, delAddr, valAddr, true)
+	require.ErrorIs(t, err, types.ErrAutoCompoundWithdrawAddr)
+}
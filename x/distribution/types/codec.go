@@ -18,6 +18,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgSetWithdrawAddress{}, "cosmos-sdk/MsgModifyWithdrawAddress")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/distribution/MsgUpdateParams")
 	legacy.RegisterAminoMsg(cdc, &MsgDepositValidatorRewardsPool{}, "cosmos-sdk/distr/MsgDepositValRewards")
+	legacy.RegisterAminoMsg(cdc, &MsgSetAutoCompound{}, "cosmos-sdk/distr/MsgSetAutoCompound")
+	legacy.RegisterAminoMsg(cdc, &MsgSetWithdrawAddressPolicy{}, "cosmos-sdk/distr/MsgSetWithdrawPolicy")
+	legacy.RegisterAminoMsg(cdc, &MsgWithdrawTokenizeShareRecordReward{}, "cosmos-sdk/distr/MsgWithdrawTSRReward")
 
 	cdc.RegisterConcrete(Params{}, "cosmos-sdk/x/distribution/Params", nil)
 }
@@ -30,6 +33,9 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 		&MsgSetWithdrawAddress{},
 		&MsgUpdateParams{},
 		&MsgDepositValidatorRewardsPool{},
+		&MsgSetAutoCompound{},
+		&MsgSetWithdrawAddressPolicy{},
+		&MsgWithdrawTokenizeShareRecordReward{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
@@ -38,6 +38,18 @@ type Params struct {
 	// in the x/distribution module's reward mechanism.
 	BonusProposerReward cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=bonus_proposer_reward,json=bonusProposerReward,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"bonus_proposer_reward"` // Deprecated: Do not use.
 	WithdrawAddrEnabled bool                        `protobuf:"varint,4,opt,name=withdraw_addr_enabled,json=withdrawAddrEnabled,proto3" json:"withdraw_addr_enabled,omitempty"`
+	// auto_compound_interval is the number of blocks between two automatic
+	// compoundings of the rewards of a delegation opted in with
+	// MsgSetAutoCompound. Zero disables auto-compounding.
+	//
+	// Since: cosmos-sdk 0.51
+	AutoCompoundInterval uint64 `protobuf:"varint,5,opt,name=auto_compound_interval,json=autoCompoundInterval,proto3" json:"auto_compound_interval,omitempty"`
+	// max_auto_compounds_per_block is the maximum number of delegations whose
+	// rewards are compounded in a single block. The delegations exceeding it are
+	// compounded in the following blocks.
+	//
+	// Since: cosmos-sdk 0.51
+	MaxAutoCompoundsPerBlock uint32 `protobuf:"varint,6,opt,name=max_auto_compounds_per_block,json=maxAutoCompoundsPerBlock,proto3" json:"max_auto_compounds_per_block,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -80,6 +92,194 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 	return false
 }
 
+func (m *Params) GetAutoCompoundInterval() uint64 {
+	if m != nil {
+		return m.AutoCompoundInterval
+	}
+	return 0
+}
+
+func (m *Params) GetMaxAutoCompoundsPerBlock() uint32 {
+	if m != nil {
+		return m.MaxAutoCompoundsPerBlock
+	}
+	return 0
+}
+
+// AutoCompound defines a delegation whose rewards are automatically
+// re-delegated to its validator.
+//
+// Since: cosmos-sdk 0.51
+type AutoCompound struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// next_height is the height at which the rewards of the delegation are
+	// compounded next.
+	NextHeight uint64 `protobuf:"varint,3,opt,name=next_height,json=nextHeight,proto3" json:"next_height,omitempty"`
+}
+
+func (m *AutoCompound) Reset()         { *m = AutoCompound{} }
+func (m *AutoCompound) String() string { return proto.CompactTextString(m) }
+func (*AutoCompound) ProtoMessage()    {}
+func (*AutoCompound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{1}
+}
+func (m *AutoCompound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoCompound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoCompound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoCompound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoCompound.Merge(m, src)
+}
+func (m *AutoCompound) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoCompound) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoCompound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoCompound proto.InternalMessageInfo
+
+func (m *AutoCompound) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *AutoCompound) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *AutoCompound) GetNextHeight() uint64 {
+	if m != nil {
+		return m.NextHeight
+	}
+	return 0
+}
+
+// WithdrawAddressSplit defines the share of the withdrawn rewards of a
+// delegator sent to an address.
+//
+// Since: cosmos-sdk 0.51
+type WithdrawAddressSplit struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// weight is the fraction of the withdrawn rewards sent to the address.
+	Weight cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"weight"`
+}
+
+func (m *WithdrawAddressSplit) Reset()         { *m = WithdrawAddressSplit{} }
+func (m *WithdrawAddressSplit) String() string { return proto.CompactTextString(m) }
+func (*WithdrawAddressSplit) ProtoMessage()    {}
+func (*WithdrawAddressSplit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{2}
+}
+func (m *WithdrawAddressSplit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WithdrawAddressSplit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WithdrawAddressSplit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WithdrawAddressSplit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithdrawAddressSplit.Merge(m, src)
+}
+func (m *WithdrawAddressSplit) XXX_Size() int {
+	return m.Size()
+}
+func (m *WithdrawAddressSplit) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithdrawAddressSplit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WithdrawAddressSplit proto.InternalMessageInfo
+
+func (m *WithdrawAddressSplit) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// WithdrawAddressPolicy defines how the withdrawn delegation rewards of a
+// delegator are split across several addresses. It takes precedence over the
+// withdraw address of the delegator.
+//
+// Since: cosmos-sdk 0.51
+type WithdrawAddressPolicy struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// splits are the shares of the withdrawn rewards, whose weights sum up to one.
+	Splits []WithdrawAddressSplit `protobuf:"bytes,2,rep,name=splits,proto3" json:"splits"`
+}
+
+func (m *WithdrawAddressPolicy) Reset()         { *m = WithdrawAddressPolicy{} }
+func (m *WithdrawAddressPolicy) String() string { return proto.CompactTextString(m) }
+func (*WithdrawAddressPolicy) ProtoMessage()    {}
+func (*WithdrawAddressPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{3}
+}
+func (m *WithdrawAddressPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WithdrawAddressPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WithdrawAddressPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WithdrawAddressPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithdrawAddressPolicy.Merge(m, src)
+}
+func (m *WithdrawAddressPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *WithdrawAddressPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithdrawAddressPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WithdrawAddressPolicy proto.InternalMessageInfo
+
+func (m *WithdrawAddressPolicy) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *WithdrawAddressPolicy) GetSplits() []WithdrawAddressSplit {
+	if m != nil {
+		return m.Splits
+	}
+	return nil
+}
+
 // ValidatorHistoricalRewards represents historical rewards for a validator.
 // Height is implicit within the store key.
 // Cumulative reward ratio is the sum from the zeroeth period
@@ -102,7 +302,7 @@ func (m *ValidatorHistoricalRewards) Reset()         { *m = ValidatorHistoricalR
 func (m *ValidatorHistoricalRewards) String() string { return proto.CompactTextString(m) }
 func (*ValidatorHistoricalRewards) ProtoMessage()    {}
 func (*ValidatorHistoricalRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{1}
+	return fileDescriptor_cd78a31ea281a992, []int{4}
 }
 func (m *ValidatorHistoricalRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -157,7 +357,7 @@ func (m *ValidatorCurrentRewards) Reset()         { *m = ValidatorCurrentRewards
 func (m *ValidatorCurrentRewards) String() string { return proto.CompactTextString(m) }
 func (*ValidatorCurrentRewards) ProtoMessage()    {}
 func (*ValidatorCurrentRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{2}
+	return fileDescriptor_cd78a31ea281a992, []int{5}
 }
 func (m *ValidatorCurrentRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -210,7 +410,7 @@ func (m *ValidatorAccumulatedCommission) Reset()         { *m = ValidatorAccumul
 func (m *ValidatorAccumulatedCommission) String() string { return proto.CompactTextString(m) }
 func (*ValidatorAccumulatedCommission) ProtoMessage()    {}
 func (*ValidatorAccumulatedCommission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{3}
+	return fileDescriptor_cd78a31ea281a992, []int{6}
 }
 func (m *ValidatorAccumulatedCommission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -256,7 +456,7 @@ func (m *ValidatorOutstandingRewards) Reset()         { *m = ValidatorOutstandin
 func (m *ValidatorOutstandingRewards) String() string { return proto.CompactTextString(m) }
 func (*ValidatorOutstandingRewards) ProtoMessage()    {}
 func (*ValidatorOutstandingRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{4}
+	return fileDescriptor_cd78a31ea281a992, []int{7}
 }
 func (m *ValidatorOutstandingRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -305,7 +505,7 @@ func (m *ValidatorSlashEvent) Reset()         { *m = ValidatorSlashEvent{} }
 func (m *ValidatorSlashEvent) String() string { return proto.CompactTextString(m) }
 func (*ValidatorSlashEvent) ProtoMessage()    {}
 func (*ValidatorSlashEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{5}
+	return fileDescriptor_cd78a31ea281a992, []int{8}
 }
 func (m *ValidatorSlashEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -350,7 +550,7 @@ func (m *ValidatorSlashEvents) Reset()         { *m = ValidatorSlashEvents{} }
 func (m *ValidatorSlashEvents) String() string { return proto.CompactTextString(m) }
 func (*ValidatorSlashEvents) ProtoMessage()    {}
 func (*ValidatorSlashEvents) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{6}
+	return fileDescriptor_cd78a31ea281a992, []int{9}
 }
 func (m *ValidatorSlashEvents) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -397,7 +597,7 @@ func (m *FeePool) Reset()         { *m = FeePool{} }
 func (m *FeePool) String() string { return proto.CompactTextString(m) }
 func (*FeePool) ProtoMessage()    {}
 func (*FeePool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{7}
+	return fileDescriptor_cd78a31ea281a992, []int{10}
 }
 func (m *FeePool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -462,7 +662,7 @@ func (m *CommunityPoolSpendProposal) Reset()         { *m = CommunityPoolSpendPr
 func (m *CommunityPoolSpendProposal) String() string { return proto.CompactTextString(m) }
 func (*CommunityPoolSpendProposal) ProtoMessage()    {}
 func (*CommunityPoolSpendProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{8}
+	return fileDescriptor_cd78a31ea281a992, []int{11}
 }
 func (m *CommunityPoolSpendProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -507,7 +707,7 @@ func (m *DelegatorStartingInfo) Reset()         { *m = DelegatorStartingInfo{} }
 func (m *DelegatorStartingInfo) String() string { return proto.CompactTextString(m) }
 func (*DelegatorStartingInfo) ProtoMessage()    {}
 func (*DelegatorStartingInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{9}
+	return fileDescriptor_cd78a31ea281a992, []int{12}
 }
 func (m *DelegatorStartingInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -561,7 +761,7 @@ func (m *DelegationDelegatorReward) Reset()         { *m = DelegationDelegatorRe
 func (m *DelegationDelegatorReward) String() string { return proto.CompactTextString(m) }
 func (*DelegationDelegatorReward) ProtoMessage()    {}
 func (*DelegationDelegatorReward) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{10}
+	return fileDescriptor_cd78a31ea281a992, []int{13}
 }
 func (m *DelegationDelegatorReward) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -608,7 +808,7 @@ func (m *CommunityPoolSpendProposalWithDeposit) Reset()         { *m = Community
 func (m *CommunityPoolSpendProposalWithDeposit) String() string { return proto.CompactTextString(m) }
 func (*CommunityPoolSpendProposalWithDeposit) ProtoMessage()    {}
 func (*CommunityPoolSpendProposalWithDeposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{11}
+	return fileDescriptor_cd78a31ea281a992, []int{14}
 }
 func (m *CommunityPoolSpendProposalWithDeposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -639,6 +839,9 @@ var xxx_messageInfo_CommunityPoolSpendProposalWithDeposit proto.InternalMessageI
 
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.distribution.v1beta1.Params")
+	proto.RegisterType((*AutoCompound)(nil), "cosmos.distribution.v1beta1.AutoCompound")
+	proto.RegisterType((*WithdrawAddressSplit)(nil), "cosmos.distribution.v1beta1.WithdrawAddressSplit")
+	proto.RegisterType((*WithdrawAddressPolicy)(nil), "cosmos.distribution.v1beta1.WithdrawAddressPolicy")
 	proto.RegisterType((*ValidatorHistoricalRewards)(nil), "cosmos.distribution.v1beta1.ValidatorHistoricalRewards")
 	proto.RegisterType((*ValidatorCurrentRewards)(nil), "cosmos.distribution.v1beta1.ValidatorCurrentRewards")
 	proto.RegisterType((*ValidatorAccumulatedCommission)(nil), "cosmos.distribution.v1beta1.ValidatorAccumulatedCommission")
@@ -657,73 +860,84 @@ func init() {
 }
 
 var fileDescriptor_cd78a31ea281a992 = []byte{
-	// 1044 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xe4, 0x87, 0xd3, 0x4c, 0xd2, 0x84, 0x4e, 0x7e, 0xd4, 0x71, 0x8b, 0x9d, 0xae, 0xa8,
-	0x08, 0x81, 0xd8, 0xa4, 0x95, 0x10, 0xf2, 0x05, 0x35, 0x76, 0x2b, 0x90, 0x0a, 0x44, 0x1b, 0x04,
-	0x12, 0x1c, 0x56, 0xe3, 0xdd, 0x89, 0x3d, 0x64, 0x77, 0x66, 0x99, 0x19, 0x3b, 0xc9, 0x09, 0x8e,
-	0x85, 0x03, 0x70, 0x03, 0xf5, 0x54, 0xc1, 0xa5, 0xe2, 0x94, 0x43, 0xfe, 0x88, 0x8a, 0x53, 0x55,
-	0x01, 0x42, 0x1c, 0x02, 0x24, 0x87, 0x20, 0xfe, 0x0a, 0x34, 0x3b, 0xe3, 0x5d, 0x27, 0x04, 0x14,
-	0x82, 0xa2, 0x5e, 0x2c, 0xcf, 0x7b, 0xb3, 0xef, 0xfb, 0xbe, 0x37, 0xef, 0xbd, 0x19, 0x58, 0xf1,
-	0xb9, 0x8c, 0xb8, 0xac, 0x06, 0x54, 0x2a, 0x41, 0x9b, 0x1d, 0x45, 0x39, 0xab, 0x76, 0x97, 0x9b,
-	0x44, 0xe1, 0xe5, 0x23, 0xc6, 0x4a, 0x2c, 0xb8, 0xe2, 0xe8, 0x8a, 0xd9, 0x5f, 0x39, 0xe2, 0xb2,
-	0xfb, 0x8b, 0xd3, 0x2d, 0xde, 0xe2, 0xc9, 0xbe, 0xaa, 0xfe, 0x67, 0x3e, 0x29, 0x96, 0x2c, 0x44,
-	0x13, 0x4b, 0x92, 0x86, 0xf6, 0x39, 0xb5, 0x21, 0x8b, 0x73, 0xc6, 0xef, 0x99, 0x0f, 0x6d, 0x7c,
-	0xe3, 0xba, 0x84, 0x23, 0xca, 0x78, 0x35, 0xf9, 0x35, 0x26, 0xe7, 0xfe, 0x20, 0xcc, 0xaf, 0x62,
-	0x81, 0x23, 0x89, 0x3e, 0x80, 0x17, 0x7d, 0x1e, 0x45, 0x1d, 0x46, 0xd5, 0xb6, 0xa7, 0xf0, 0x56,
-	0x01, 0xcc, 0x83, 0x85, 0xd1, 0x95, 0x57, 0x1e, 0xed, 0x95, 0x73, 0xbf, 0xec, 0x95, 0x2d, 0x55,
-	0x19, 0x6c, 0x54, 0x28, 0xaf, 0x46, 0x58, 0xb5, 0x2b, 0x77, 0x49, 0x0b, 0xfb, 0xdb, 0x0d, 0xe2,
-	0x3f, 0xd9, 0x5d, 0x82, 0x16, 0xa9, 0x41, 0xfc, 0x87, 0x87, 0x3b, 0x8b, 0xc0, 0x1d, 0x4f, 0x83,
-	0xbd, 0x83, 0xb7, 0xd0, 0x87, 0x70, 0x5a, 0x13, 0xd6, 0xac, 0x62, 0x2e, 0x89, 0xf0, 0x04, 0xd9,
-	0xc4, 0x22, 0x28, 0x0c, 0x24, 0x18, 0xaf, 0x9e, 0x0d, 0xa3, 0x00, 0x5c, 0xa4, 0xa3, 0xae, 0xda,
-	0xa0, 0x6e, 0x12, 0x13, 0x85, 0x70, 0xa6, 0xc9, 0x59, 0x47, 0xfe, 0x0d, 0x6c, 0xf0, 0x7f, 0x82,
-	0x4d, 0x25, 0x61, 0x8f, 0xa1, 0xdd, 0x80, 0x33, 0x9b, 0x54, 0xb5, 0x03, 0x81, 0x37, 0x3d, 0x1c,
-	0x04, 0xc2, 0x23, 0x0c, 0x37, 0x43, 0x12, 0x14, 0x86, 0xe6, 0xc1, 0xc2, 0x05, 0x77, 0xaa, 0xe7,
-	0xbc, 0x15, 0x04, 0xe2, 0xb6, 0x71, 0xd5, 0xae, 0x7f, 0x76, 0xb8, 0xb3, 0x38, 0x6f, 0x00, 0x96,
-	0x64, 0xb0, 0x51, 0xdd, 0x3a, 0x5a, 0x31, 0xe6, 0x44, 0x9c, 0x9f, 0x00, 0x2c, 0xbe, 0x8b, 0x43,
-	0x1a, 0x60, 0xc5, 0xc5, 0xeb, 0x54, 0x2a, 0x2e, 0xa8, 0x8f, 0x43, 0x03, 0x2c, 0xd1, 0xe7, 0x00,
-	0x5e, 0xf6, 0x3b, 0x51, 0x27, 0xc4, 0x8a, 0x76, 0x89, 0x15, 0xe9, 0x09, 0xac, 0x28, 0x2f, 0x80,
-	0xf9, 0xc1, 0x85, 0xb1, 0x1b, 0x57, 0x6d, 0x3d, 0x56, 0x74, 0x96, 0x7a, 0x75, 0xa5, 0x15, 0xd5,
-	0x39, 0x65, 0x26, 0x11, 0xdf, 0xfd, 0x5a, 0x7e, 0xb1, 0x45, 0x55, 0xbb, 0xd3, 0xac, 0xf8, 0x3c,
-	0xb2, 0xf5, 0x52, 0xed, 0xa3, 0xa6, 0xb6, 0x63, 0x22, 0x7b, 0xdf, 0x48, 0x73, 0xb6, 0x33, 0x19,
-	0xac, 0x21, 0xe3, 0x6a, 0x50, 0xf4, 0x3c, 0x9c, 0x14, 0x64, 0x9d, 0x08, 0xc2, 0x7c, 0xe2, 0xf9,
-	0xbc, 0xc3, 0x54, 0x72, 0xbe, 0x17, 0xdd, 0x89, 0xd4, 0x5c, 0xd7, 0x56, 0xe7, 0x5b, 0x00, 0x2f,
-	0xa7, 0xc2, 0xea, 0x1d, 0x21, 0x08, 0x53, 0x3d, 0x55, 0x31, 0x1c, 0x31, 0x4a, 0xe4, 0x39, 0x8b,
-	0xe8, 0xc1, 0xa0, 0x59, 0x98, 0x8f, 0x89, 0xa0, 0xdc, 0x54, 0xe3, 0x90, 0x6b, 0x57, 0xce, 0xd7,
-	0x00, 0x96, 0x52, 0x96, 0xb7, 0x7c, 0xab, 0x99, 0x04, 0x75, 0x1e, 0x45, 0x54, 0x4a, 0xca, 0x19,
-	0xea, 0x42, 0xe8, 0xa7, 0xab, 0x73, 0xe6, 0xdb, 0x87, 0xe4, 0x7c, 0x01, 0xe0, 0x95, 0x94, 0xda,
-	0xdb, 0x1d, 0x25, 0x15, 0x66, 0x01, 0x65, 0xad, 0xa7, 0x96, 0x44, 0xcd, 0x68, 0x2a, 0x65, 0xb4,
-	0x16, 0x62, 0xd9, 0xbe, 0xdd, 0x25, 0x4c, 0xa1, 0x17, 0xe0, 0x33, 0xdd, 0x9e, 0xd9, 0xb3, 0x69,
-	0x06, 0x49, 0x9a, 0x27, 0x53, 0xfb, 0x6a, 0x62, 0x46, 0x6f, 0xc2, 0x0b, 0xeb, 0x02, 0xfb, 0xba,
-	0x03, 0xec, 0x5c, 0x58, 0xfe, 0xcf, 0xad, 0xea, 0xa6, 0x21, 0x9c, 0x4f, 0x01, 0x9c, 0x3e, 0x81,
-	0x91, 0x44, 0x1f, 0xc1, 0xd9, 0x8c, 0x92, 0xd4, 0x0e, 0x8f, 0x24, 0x1e, 0x9b, 0xab, 0x97, 0x2b,
-	0xff, 0x32, 0x95, 0x2b, 0x27, 0x84, 0x5c, 0x19, 0xd5, 0x3c, 0x4d, 0x42, 0xa6, 0xbb, 0x27, 0x40,
-	0x3a, 0x9f, 0x0c, 0xc0, 0x91, 0x3b, 0x84, 0xac, 0x72, 0x1e, 0xa2, 0x8f, 0xe1, 0x44, 0x36, 0x67,
-	0x63, 0xce, 0xc3, 0x53, 0x1d, 0x51, 0xed, 0xac, 0x47, 0x54, 0x00, 0x6e, 0x36, 0xd7, 0x13, 0x02,
-	0x0a, 0x8e, 0x07, 0xc4, 0xa7, 0x11, 0x0e, 0x0d, 0xfc, 0xc0, 0x29, 0xe0, 0x6f, 0x9e, 0x01, 0xde,
-	0x1d, 0xb3, 0x30, 0x1a, 0xd5, 0xf9, 0x6a, 0x00, 0x16, 0xeb, 0xfd, 0x3c, 0xd6, 0x62, 0xc2, 0x02,
-	0x33, 0x4c, 0x71, 0x88, 0xa6, 0xe1, 0xb0, 0xa2, 0x2a, 0x24, 0xe6, 0xd6, 0x71, 0xcd, 0x02, 0xcd,
-	0xc3, 0xb1, 0x80, 0x48, 0x5f, 0xd0, 0x38, 0xab, 0x0a, 0xb7, 0xdf, 0x84, 0xae, 0xc2, 0x51, 0x41,
-	0x7c, 0x1a, 0x53, 0xc2, 0x94, 0x19, 0xf0, 0x6e, 0x66, 0x40, 0xdb, 0x30, 0x8f, 0xa3, 0x64, 0x10,
-	0x0d, 0x25, 0x22, 0xe7, 0x4e, 0x14, 0x99, 0x28, 0xbc, 0x63, 0x15, 0x2e, 0x9c, 0x42, 0x61, 0x22,
-	0xef, 0xfe, 0xe1, 0xce, 0xe2, 0x78, 0x98, 0x94, 0xa1, 0xe7, 0x67, 0x1d, 0x61, 0x01, 0x6b, 0x0b,
-	0xf7, 0x1e, 0x94, 0x73, 0x7f, 0x3c, 0x28, 0xe7, 0xbe, 0xdf, 0x5d, 0x2a, 0x5a, 0xd4, 0x16, 0xef,
-	0xf6, 0x81, 0x32, 0xa5, 0x39, 0x03, 0xe7, 0x07, 0x00, 0x67, 0x1a, 0x44, 0x47, 0xd2, 0x55, 0xa3,
-	0xb0, 0x50, 0x94, 0xb5, 0xde, 0x60, 0xeb, 0xc9, 0x40, 0x8d, 0x05, 0xe9, 0x52, 0xae, 0x2f, 0xb3,
-	0xfe, 0xde, 0x99, 0xe8, 0x99, 0x6d, 0xeb, 0xdc, 0x85, 0xc3, 0x52, 0xe1, 0x0d, 0x62, 0xfb, 0xe6,
-	0xac, 0x77, 0xb6, 0x09, 0x82, 0x1a, 0x30, 0xdf, 0x26, 0xb4, 0xd5, 0x36, 0x09, 0x1d, 0x5a, 0x79,
-	0xe9, 0xcf, 0xbd, 0xf2, 0xa4, 0x2f, 0x88, 0x1e, 0xf2, 0xcc, 0x33, 0xae, 0x6f, 0x0e, 0x77, 0x16,
-	0x8f, 0xdb, 0x6c, 0x02, 0xcc, 0xc2, 0xf9, 0x1d, 0xc0, 0x39, 0x2b, 0x8b, 0x72, 0x96, 0x0a, 0xb4,
-	0xd7, 0xe6, 0x5b, 0xf0, 0x52, 0xd6, 0x84, 0xfa, 0xde, 0x24, 0x52, 0xda, 0x17, 0xc7, 0xb5, 0x27,
-	0xbb, 0x4b, 0xcf, 0x5a, 0x6a, 0xd9, 0xfc, 0x35, 0x5b, 0xd6, 0x94, 0xd0, 0x63, 0x2e, 0x9b, 0x29,
-	0xd6, 0x8e, 0x18, 0xcc, 0xa7, 0x4f, 0x8a, 0xf3, 0x1c, 0x78, 0x16, 0xa5, 0x36, 0xa4, 0x8f, 0xd7,
-	0xf9, 0x11, 0xc0, 0xeb, 0xff, 0x5c, 0xd4, 0xef, 0x51, 0xd5, 0x6e, 0x90, 0x98, 0x4b, 0xaa, 0xce,
-	0xa9, 0xbe, 0x67, 0xfb, 0xea, 0x5b, 0xbb, 0xec, 0x0a, 0x15, 0xe0, 0x48, 0x60, 0x80, 0x0b, 0xc3,
-	0x89, 0xa3, 0xb7, 0xac, 0x3d, 0x77, 0xef, 0x14, 0x25, 0xb9, 0xf2, 0xda, 0xc3, 0xfd, 0x12, 0x78,
-	0xb4, 0x5f, 0x02, 0x8f, 0xf7, 0x4b, 0xe0, 0xb7, 0xfd, 0x12, 0xf8, 0xf2, 0xa0, 0x94, 0x7b, 0x7c,
-	0x50, 0xca, 0xfd, 0x7c, 0x50, 0xca, 0xbd, 0x7f, 0xed, 0x48, 0x59, 0x1d, 0x7b, 0xbb, 0x24, 0x49,
-	0x6b, 0xe6, 0x93, 0xe7, 0xe5, 0xcd, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x5f, 0x08, 0x33, 0x8d,
-	0x11, 0x0b, 0x00, 0x00,
+	// 1225 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0xa4, 0xae, 0xd3, 0xbc, 0xa4, 0x2d, 0x9d, 0x3a, 0xa9, 0x9b, 0x16, 0x3b, 0x5d, 0x51,
+	0x61, 0x02, 0xb1, 0x49, 0x8b, 0x10, 0xca, 0x01, 0xd4, 0x24, 0xad, 0x5a, 0xa9, 0x94, 0x68, 0x53,
+	0x81, 0x04, 0x87, 0xd5, 0x78, 0x77, 0xea, 0x0c, 0xd9, 0xdd, 0x59, 0x66, 0x66, 0x9d, 0xe4, 0x04,
+	0xc7, 0xc2, 0x01, 0x7a, 0x03, 0xf5, 0x54, 0xc1, 0xa5, 0xe2, 0x94, 0x43, 0x7e, 0x03, 0xaa, 0x38,
+	0x55, 0x15, 0x20, 0xc4, 0xa1, 0x40, 0x7a, 0x08, 0xe2, 0x57, 0xa0, 0xd9, 0x19, 0xaf, 0xed, 0x10,
+	0xaa, 0x90, 0x12, 0x71, 0x89, 0x32, 0xef, 0xed, 0xbc, 0xef, 0xfb, 0xde, 0xbc, 0xf7, 0x66, 0x0c,
+	0x75, 0x9f, 0xcb, 0x88, 0xcb, 0x46, 0xc0, 0xa4, 0x12, 0xac, 0x99, 0x2a, 0xc6, 0xe3, 0x46, 0x7b,
+	0xa6, 0x49, 0x15, 0x99, 0xe9, 0x33, 0xd6, 0x13, 0xc1, 0x15, 0xc7, 0x67, 0xcc, 0xf7, 0xf5, 0x3e,
+	0x97, 0xfd, 0x7e, 0xa2, 0xd4, 0xe2, 0x2d, 0x9e, 0x7d, 0xd7, 0xd0, 0xff, 0x99, 0x2d, 0x13, 0x15,
+	0x0b, 0xd1, 0x24, 0x92, 0xe6, 0xa1, 0x7d, 0xce, 0x6c, 0xc8, 0x89, 0xd3, 0xc6, 0xef, 0x99, 0x8d,
+	0x36, 0xbe, 0x71, 0x9d, 0x20, 0x11, 0x8b, 0x79, 0x23, 0xfb, 0x6b, 0x4c, 0xce, 0x9d, 0x02, 0x14,
+	0x17, 0x89, 0x20, 0x91, 0xc4, 0x1f, 0xc0, 0x51, 0x9f, 0x47, 0x51, 0x1a, 0x33, 0xb5, 0xee, 0x29,
+	0xb2, 0x56, 0x46, 0x93, 0xa8, 0x36, 0x3c, 0xf7, 0xfa, 0x83, 0xc7, 0xd5, 0x81, 0x5f, 0x1e, 0x57,
+	0x2d, 0x55, 0x19, 0xac, 0xd4, 0x19, 0x6f, 0x44, 0x44, 0x2d, 0xd7, 0xaf, 0xd3, 0x16, 0xf1, 0xd7,
+	0x17, 0xa8, 0xff, 0x68, 0x73, 0x1a, 0x2c, 0xd2, 0x02, 0xf5, 0xef, 0x6f, 0x6f, 0x4c, 0x21, 0x77,
+	0x34, 0x0f, 0x76, 0x93, 0xac, 0xe1, 0x0f, 0xa1, 0xa4, 0x09, 0x6b, 0x56, 0x09, 0x97, 0x54, 0x78,
+	0x82, 0xae, 0x12, 0x11, 0x94, 0x07, 0x33, 0x8c, 0x37, 0xf6, 0x87, 0x51, 0x46, 0x2e, 0xd6, 0x51,
+	0x17, 0x6d, 0x50, 0x37, 0x8b, 0x89, 0x43, 0x18, 0x6b, 0xf2, 0x38, 0x95, 0x7f, 0x03, 0x3b, 0xf4,
+	0x8c, 0x60, 0x27, 0xb3, 0xb0, 0x3b, 0xd0, 0x2e, 0xc0, 0xd8, 0x2a, 0x53, 0xcb, 0x81, 0x20, 0xab,
+	0x1e, 0x09, 0x02, 0xe1, 0xd1, 0x98, 0x34, 0x43, 0x1a, 0x94, 0x0b, 0x93, 0xa8, 0x76, 0xc4, 0x3d,
+	0xd9, 0x71, 0x5e, 0x0a, 0x02, 0x71, 0xd9, 0xb8, 0xf0, 0x6b, 0x30, 0x4e, 0x52, 0xc5, 0x3d, 0x9f,
+	0x47, 0x09, 0x4f, 0xe3, 0xc0, 0x63, 0xb1, 0xa2, 0xa2, 0x4d, 0xc2, 0xf2, 0xe1, 0x49, 0x54, 0x2b,
+	0xb8, 0x25, 0xed, 0x9d, 0xb7, 0xce, 0x6b, 0xd6, 0x87, 0xdf, 0x84, 0xb3, 0x11, 0x59, 0xf3, 0xfa,
+	0x76, 0x4a, 0x2f, 0xa1, 0xc2, 0x6b, 0x86, 0xdc, 0x5f, 0x29, 0x17, 0x27, 0x51, 0xed, 0xa8, 0x5b,
+	0x8e, 0xc8, 0xda, 0xa5, 0x9e, 0xed, 0x72, 0x91, 0x8a, 0x39, 0xed, 0x9f, 0x3d, 0xff, 0xd9, 0xf6,
+	0xc6, 0xd4, 0xa4, 0x91, 0x35, 0x2d, 0x83, 0x95, 0xc6, 0x5a, 0x7f, 0x9d, 0x9a, 0x3a, 0x70, 0xbe,
+	0x43, 0x30, 0xda, 0x1b, 0x00, 0x5f, 0x86, 0x13, 0x01, 0x0d, 0x69, 0x8b, 0x28, 0x2e, 0x32, 0x89,
+	0x54, 0x4a, 0x5b, 0x1c, 0xe5, 0x47, 0x9b, 0xd3, 0x25, 0x9b, 0xa8, 0x4b, 0xc6, 0xb3, 0xa4, 0x04,
+	0x8b, 0x5b, 0xee, 0x73, 0xf9, 0x16, 0x6b, 0xc7, 0x37, 0xe0, 0x44, 0x9b, 0x84, 0x2c, 0xe8, 0x0b,
+	0x63, 0xce, 0xff, 0xdc, 0xa3, 0xcd, 0xe9, 0xe7, 0x6d, 0x98, 0x77, 0x3b, 0xdf, 0xec, 0x88, 0xd7,
+	0xde, 0x61, 0xc7, 0x55, 0x18, 0x89, 0xe9, 0x9a, 0xf2, 0x96, 0x29, 0x6b, 0x2d, 0xab, 0xec, 0x70,
+	0x0b, 0x2e, 0x68, 0xd3, 0xd5, 0xcc, 0xe2, 0xdc, 0x45, 0x50, 0x7a, 0xaf, 0x27, 0xfb, 0x3a, 0x58,
+	0x12, 0x32, 0x85, 0x2f, 0xc0, 0xd0, 0x5e, 0x65, 0x74, 0x3e, 0xc4, 0x37, 0xa0, 0xb8, 0x6a, 0x80,
+	0x06, 0x9f, 0xa9, 0x2d, 0x6c, 0x14, 0x67, 0x13, 0xc1, 0xd8, 0x0e, 0x72, 0x8b, 0x3c, 0x64, 0xfe,
+	0xfa, 0x7f, 0x95, 0xee, 0x9b, 0x50, 0x94, 0x5a, 0xad, 0xce, 0xf1, 0xa1, 0xda, 0xc8, 0x85, 0x99,
+	0xfa, 0x53, 0x66, 0x4d, 0x7d, 0xb7, 0x3c, 0xcd, 0x0d, 0x6b, 0x8d, 0x96, 0xb6, 0x89, 0xe5, 0xfc,
+	0x84, 0x60, 0x22, 0x3f, 0xa1, 0xab, 0x4c, 0x2a, 0x2e, 0x98, 0x4f, 0x42, 0xd3, 0x0b, 0x12, 0x7f,
+	0x8e, 0xe0, 0x94, 0x9f, 0x46, 0x69, 0x48, 0x14, 0x6b, 0x53, 0xdb, 0x77, 0x9e, 0x20, 0x8a, 0xf1,
+	0x32, 0xca, 0x68, 0x9c, 0xed, 0xd0, 0xd0, 0x8d, 0x9b, 0xc3, 0x2f, 0x50, 0x7f, 0x9e, 0xb3, 0xd8,
+	0xf4, 0xe6, 0xb7, 0xbf, 0x56, 0x5f, 0x6e, 0x31, 0xb5, 0x9c, 0x36, 0xeb, 0x3e, 0x8f, 0xec, 0x08,
+	0x6b, 0xf4, 0xd4, 0xad, 0x5a, 0x4f, 0xa8, 0xec, 0xec, 0x91, 0x86, 0xe0, 0x58, 0x17, 0xd6, 0x90,
+	0x71, 0x35, 0x28, 0x7e, 0x11, 0x8e, 0x0b, 0x7a, 0x8b, 0x0a, 0x1a, 0xfb, 0xd4, 0xf3, 0x79, 0x1a,
+	0x9b, 0xf3, 0x3b, 0xea, 0x1e, 0xcb, 0xcd, 0xf3, 0xda, 0xea, 0x7c, 0x83, 0xe0, 0x54, 0x2e, 0x6c,
+	0x3e, 0x15, 0x82, 0xc6, 0xaa, 0xa3, 0x2a, 0x81, 0x21, 0xa3, 0x44, 0x1e, 0xb0, 0x88, 0x0e, 0x0c,
+	0x1e, 0x87, 0x62, 0x42, 0x05, 0xe3, 0x66, 0x40, 0x16, 0x5c, 0xbb, 0x72, 0xbe, 0x42, 0x50, 0xe9,
+	0x36, 0x88, 0x6f, 0x35, 0xd3, 0x60, 0x9e, 0x47, 0x11, 0x93, 0x92, 0xf1, 0x18, 0xb7, 0x01, 0xfc,
+	0x7c, 0x75, 0xc0, 0x7c, 0x7b, 0x90, 0x9c, 0x2f, 0x10, 0x9c, 0xc9, 0xa9, 0xbd, 0x93, 0x2a, 0xa9,
+	0x48, 0x1c, 0xe8, 0xd2, 0xfc, 0xbf, 0x92, 0xa8, 0x19, 0x9d, 0xcc, 0x19, 0x2d, 0x85, 0x44, 0x2e,
+	0x5f, 0x6e, 0xd3, 0x58, 0xe1, 0x97, 0xa0, 0x3b, 0x4c, 0x3c, 0x9b, 0x66, 0x94, 0xa5, 0xf9, 0x78,
+	0x6e, 0x5f, 0xcc, 0xcc, 0xf8, 0x6d, 0x38, 0x72, 0x4b, 0x10, 0x5f, 0xb7, 0x8a, 0xed, 0xfb, 0x99,
+	0x7f, 0xdd, 0xf7, 0x6e, 0x1e, 0xc2, 0xf9, 0x14, 0x41, 0x69, 0x17, 0x46, 0x12, 0x7f, 0x04, 0xe3,
+	0x5d, 0x4a, 0x52, 0x3b, 0x3c, 0x9a, 0x79, 0x6c, 0xae, 0x5e, 0x7d, 0x6a, 0xf3, 0xee, 0x12, 0xb2,
+	0xb7, 0x77, 0x4b, 0xed, 0x5d, 0x20, 0x9d, 0x4f, 0x06, 0x61, 0xe8, 0x0a, 0xa5, 0x8b, 0x9c, 0x87,
+	0xf8, 0x63, 0x38, 0xd6, 0xbd, 0xfa, 0x13, 0xce, 0xc3, 0x3d, 0x1d, 0xd1, 0xec, 0x7e, 0x8f, 0xa8,
+	0x8c, 0xdc, 0xee, 0x53, 0x23, 0x23, 0xa0, 0x60, 0x34, 0xa0, 0x3e, 0x8b, 0x48, 0x68, 0xe0, 0x07,
+	0xf7, 0x00, 0x7f, 0x71, 0x1f, 0xf0, 0xee, 0x88, 0x85, 0xd1, 0xa8, 0xce, 0x97, 0x83, 0x30, 0x31,
+	0xdf, 0xcb, 0x63, 0x29, 0xa1, 0x71, 0x60, 0xee, 0x77, 0x12, 0xe2, 0x12, 0x1c, 0x56, 0x4c, 0x85,
+	0xd4, 0x0c, 0x5f, 0xd7, 0x2c, 0xf0, 0x24, 0x8c, 0x04, 0x54, 0xfa, 0x82, 0x25, 0xdd, 0xaa, 0x70,
+	0x7b, 0x4d, 0xf8, 0x2c, 0x0c, 0x0b, 0xea, 0xb3, 0x84, 0xd1, 0xd8, 0x5c, 0x4b, 0xc3, 0x6e, 0xd7,
+	0x80, 0xd7, 0xa1, 0x48, 0xa2, 0x6c, 0x10, 0x15, 0x32, 0x91, 0xa7, 0x77, 0x15, 0x99, 0x29, 0xbc,
+	0x62, 0x15, 0xd6, 0xf6, 0xa0, 0x30, 0x93, 0x77, 0x77, 0x7b, 0x63, 0x6a, 0x34, 0xcc, 0xca, 0xd0,
+	0xf3, 0xbb, 0x1d, 0x61, 0x01, 0x67, 0x6b, 0xb7, 0xef, 0x55, 0x07, 0xfe, 0xb8, 0x57, 0x1d, 0xf8,
+	0x7e, 0x73, 0x7a, 0xc2, 0xa2, 0xb6, 0x78, 0xbb, 0x07, 0x34, 0x56, 0x9a, 0x33, 0x72, 0x7e, 0x40,
+	0x30, 0xb6, 0xd0, 0xb9, 0x51, 0x96, 0x14, 0x11, 0x8a, 0xc5, 0xad, 0x6b, 0xf1, 0xad, 0x6c, 0xa0,
+	0x26, 0x82, 0xb6, 0x19, 0x4f, 0x65, 0x7f, 0xef, 0x1c, 0xeb, 0x98, 0x6d, 0xeb, 0x5c, 0x87, 0xc3,
+	0x52, 0x91, 0x15, 0xfa, 0x8c, 0xf7, 0xa5, 0x09, 0x82, 0x17, 0xa0, 0xd8, 0x7b, 0xcf, 0xcf, 0xbd,
+	0xf2, 0xe7, 0xe3, 0xea, 0x71, 0x5f, 0x50, 0x3d, 0xe4, 0x63, 0xfb, 0x04, 0xf8, 0x7a, 0x7b, 0x63,
+	0x6a, 0xa7, 0xcd, 0x26, 0xc0, 0x2c, 0x9c, 0xdf, 0x11, 0x9c, 0xb6, 0xb2, 0x18, 0x8f, 0x73, 0x81,
+	0xf6, 0x25, 0xb7, 0xeb, 0x03, 0x05, 0xed, 0xff, 0x81, 0x12, 0x43, 0x31, 0x7f, 0xe5, 0x1e, 0xe4,
+	0xc0, 0xb3, 0x28, 0xb3, 0x05, 0x7d, 0xbc, 0xce, 0x8f, 0x08, 0xce, 0xff, 0x73, 0x51, 0xeb, 0x7b,
+	0x7e, 0x81, 0x26, 0x5c, 0x32, 0x75, 0x40, 0xf5, 0x3d, 0xde, 0x53, 0xdf, 0xda, 0x65, 0x57, 0xb8,
+	0x0c, 0x43, 0x81, 0x01, 0xce, 0x1e, 0xb9, 0xc3, 0x6e, 0x67, 0x39, 0xfb, 0xc2, 0xed, 0x3d, 0x94,
+	0xe4, 0xdc, 0x5b, 0xf7, 0xb7, 0x2a, 0xe8, 0xc1, 0x56, 0x05, 0x3d, 0xdc, 0xaa, 0xa0, 0xdf, 0xb6,
+	0x2a, 0xe8, 0xce, 0x93, 0xca, 0xc0, 0xc3, 0x27, 0x95, 0x81, 0x9f, 0x9f, 0x54, 0x06, 0xde, 0x3f,
+	0xd7, 0x57, 0x56, 0x3b, 0x1e, 0xb6, 0x59, 0xd2, 0x9a, 0xc5, 0xec, 0x17, 0xcf, 0xc5, 0xbf, 0x06,
+	0x00, 0x01, 0x50, 0x27, 0x1f, 0xa4, 0x0d, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -757,6 +971,101 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.WithdrawAddrEnabled != that1.WithdrawAddrEnabled {
 		return false
 	}
+	if this.AutoCompoundInterval != that1.AutoCompoundInterval {
+		return false
+	}
+	if this.MaxAutoCompoundsPerBlock != that1.MaxAutoCompoundsPerBlock {
+		return false
+	}
+	return true
+}
+func (this *AutoCompound) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AutoCompound)
+	if !ok {
+		that2, ok := that.(AutoCompound)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DelegatorAddress != that1.DelegatorAddress {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if this.NextHeight != that1.NextHeight {
+		return false
+	}
+	return true
+}
+func (this *WithdrawAddressSplit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WithdrawAddressSplit)
+	if !ok {
+		that2, ok := that.(WithdrawAddressSplit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if !this.Weight.Equal(that1.Weight) {
+		return false
+	}
+	return true
+}
+func (this *WithdrawAddressPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WithdrawAddressPolicy)
+	if !ok {
+		that2, ok := that.(WithdrawAddressPolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DelegatorAddress != that1.DelegatorAddress {
+		return false
+	}
+	if len(this.Splits) != len(that1.Splits) {
+		return false
+	}
+	for i := range this.Splits {
+		if !this.Splits[i].Equal(&that1.Splits[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *ValidatorHistoricalRewards) Equal(that interface{}) bool {
@@ -1092,6 +1401,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxAutoCompoundsPerBlock != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.MaxAutoCompoundsPerBlock))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.AutoCompoundInterval != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.AutoCompoundInterval))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.WithdrawAddrEnabled {
 		i--
 		if m.WithdrawAddrEnabled {
@@ -1135,7 +1454,7 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ValidatorHistoricalRewards) Marshal() (dAtA []byte, err error) {
+func (m *AutoCompound) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1145,39 +1464,39 @@ func (m *ValidatorHistoricalRewards) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ValidatorHistoricalRewards) MarshalTo(dAtA []byte) (int, error) {
+func (m *AutoCompound) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ValidatorHistoricalRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AutoCompound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ReferenceCount != 0 {
-		i = encodeVarintDistribution(dAtA, i, uint64(m.ReferenceCount))
+	if m.NextHeight != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.NextHeight))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x18
 	}
-	if len(m.CumulativeRewardRatio) > 0 {
-		for iNdEx := len(m.CumulativeRewardRatio) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CumulativeRewardRatio[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDistribution(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ValidatorCurrentRewards) Marshal() (dAtA []byte, err error) {
+func (m *WithdrawAddressSplit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1187,36 +1506,162 @@ func (m *ValidatorCurrentRewards) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ValidatorCurrentRewards) MarshalTo(dAtA []byte) (int, error) {
+func (m *WithdrawAddressSplit) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ValidatorCurrentRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *WithdrawAddressSplit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Period != 0 {
-		i = encodeVarintDistribution(dAtA, i, uint64(m.Period))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Rewards) > 0 {
-		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDistribution(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
 	}
-	return len(dAtA) - i, nil
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WithdrawAddressPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WithdrawAddressPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WithdrawAddressPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Splits) > 0 {
+		for iNdEx := len(m.Splits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Splits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorHistoricalRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorHistoricalRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorHistoricalRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReferenceCount != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.ReferenceCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.CumulativeRewardRatio) > 0 {
+		for iNdEx := len(m.CumulativeRewardRatio) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CumulativeRewardRatio[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorCurrentRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorCurrentRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorCurrentRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Period != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
 func (m *ValidatorAccumulatedCommission) Marshal() (dAtA []byte, err error) {
@@ -1648,6 +2093,66 @@ func (m *Params) Size() (n int) {
 	if m.WithdrawAddrEnabled {
 		n += 2
 	}
+	if m.AutoCompoundInterval != 0 {
+		n += 1 + sovDistribution(uint64(m.AutoCompoundInterval))
+	}
+	if m.MaxAutoCompoundsPerBlock != 0 {
+		n += 1 + sovDistribution(uint64(m.MaxAutoCompoundsPerBlock))
+	}
+	return n
+}
+
+func (m *AutoCompound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if m.NextHeight != 0 {
+		n += 1 + sovDistribution(uint64(m.NextHeight))
+	}
+	return n
+}
+
+func (m *WithdrawAddressSplit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	return n
+}
+
+func (m *WithdrawAddressPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if len(m.Splits) > 0 {
+		for _, e := range m.Splits {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2016,6 +2521,409 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.WithdrawAddrEnabled = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundInterval", wireType)
+			}
+			m.AutoCompoundInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoCompoundInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAutoCompoundsPerBlock", wireType)
+			}
+			m.MaxAutoCompoundsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAutoCompoundsPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AutoCompound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoCompound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoCompound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextHeight", wireType)
+			}
+			m.NextHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WithdrawAddressSplit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WithdrawAddressSplit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WithdrawAddressSplit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WithdrawAddressPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WithdrawAddressPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WithdrawAddressPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Splits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Splits = append(m.Splits, WithdrawAddressSplit{})
+			if err := m.Splits[len(m.Splits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
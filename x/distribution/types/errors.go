@@ -18,4 +18,11 @@ var (
 	ErrNoDelegationExists      = errors.Register(ModuleName, 13, "delegation does not exist")
 	ErrInvalidProposalContent  = errors.Register(ModuleName, 14, "invalid proposal content")
 	ErrInvalidSigner           = errors.Register(ModuleName, 15, "expected authority account as only signer for proposal message")
+
+	ErrAutoCompoundDisabled     = errors.Register(ModuleName, 16, "auto-compounding is disabled")
+	ErrAutoCompoundWithdrawAddr = errors.Register(ModuleName, 17, "auto-compounding requires the withdraw address to be the delegator")
+
+	ErrInvalidWithdrawAddressPolicy = errors.Register(ModuleName, 18, "invalid withdraw address policy")
+
+	ErrNotTokenizeShareRecordOwner = errors.Register(ModuleName, 19, "not the owner of the tokenize share record")
 )
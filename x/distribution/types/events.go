@@ -8,8 +8,17 @@ const (
 	EventTypeWithdrawRewards    = "withdraw_rewards"
 	EventTypeWithdrawCommission = "withdraw_commission"
 	EventTypeProposerReward     = "proposer_reward"
+	EventTypeSetAutoCompound    = "set_auto_compound"
+	EventTypeAutoCompound       = "auto_compound"
+
+	EventTypeSetWithdrawAddressPolicy          = "set_withdraw_address_policy"
+	EventTypeWithdrawTokenizeShareRecordReward = "withdraw_tokenize_share_record_reward"
 
 	AttributeKeyWithdrawAddress = "withdraw_address"
 	AttributeKeyValidator       = "validator"
 	AttributeKeyDelegator       = "delegator"
+	AttributeKeyEnabled         = "enabled"
+	AttributeKeySplits          = "splits"
+	AttributeKeyOwner           = "owner"
+	AttributeKeyRecordID        = "record_id"
 )
@@ -348,6 +348,14 @@ type GenesisState struct {
 	DelegatorStartingInfos []DelegatorStartingInfoRecord `protobuf:"bytes,9,rep,name=delegator_starting_infos,json=delegatorStartingInfos,proto3" json:"delegator_starting_infos"`
 	// fee_pool defines the validator slash events at genesis.
 	ValidatorSlashEvents []ValidatorSlashEventRecord `protobuf:"bytes,10,rep,name=validator_slash_events,json=validatorSlashEvents,proto3" json:"validator_slash_events"`
+	// auto_compounds defines the auto-compounded delegations at genesis.
+	//
+	// Since: cosmos-sdk 0.51
+	AutoCompounds []AutoCompound `protobuf:"bytes,11,rep,name=auto_compounds,json=autoCompounds,proto3" json:"auto_compounds"`
+	// withdraw_address_policies defines the withdraw address policies at genesis.
+	//
+	// Since: cosmos-sdk 0.51
+	WithdrawAddressPolicies []WithdrawAddressPolicy `protobuf:"bytes,12,rep,name=withdraw_address_policies,json=withdrawAddressPolicies,proto3" json:"withdraw_address_policies"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -399,66 +407,70 @@ func init() {
 }
 
 var fileDescriptor_76eed0f9489db580 = []byte{
-	// 943 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x3a, 0x25, 0x4d, 0x26, 0x45, 0x34, 0xd3, 0x34, 0x6c, 0xd2, 0x76, 0x9d, 0x94, 0x1e,
-	0x0a, 0x28, 0x6b, 0x1a, 0x10, 0x54, 0x45, 0x80, 0x1a, 0xb7, 0xe5, 0xc7, 0x01, 0x22, 0x5b, 0x02,
-	0x81, 0x90, 0xac, 0xf1, 0xee, 0x78, 0x3d, 0xaa, 0xbd, 0x63, 0xcd, 0x8c, 0xd7, 0x80, 0xc4, 0x81,
-	0x53, 0x11, 0xe2, 0xc0, 0x09, 0x71, 0xac, 0x38, 0x55, 0x48, 0x48, 0x1c, 0xfa, 0x47, 0xf4, 0x58,
-	0x55, 0x1c, 0x38, 0x01, 0x72, 0x0e, 0x20, 0x8e, 0xfc, 0x05, 0x68, 0x67, 0x66, 0x77, 0xc7, 0xd9,
-	0xed, 0xd6, 0x0d, 0xf5, 0x25, 0xf1, 0xce, 0xbc, 0xf7, 0xbe, 0xef, 0x7b, 0xef, 0xed, 0x7b, 0x0b,
-	0x9e, 0xf7, 0x28, 0x1f, 0x50, 0x5e, 0xf7, 0x09, 0x17, 0x8c, 0x74, 0x46, 0x82, 0xd0, 0xb0, 0x1e,
-	0x5d, 0xea, 0x60, 0x81, 0x2e, 0xd5, 0x03, 0x1c, 0x62, 0x4e, 0xb8, 0x3b, 0x64, 0x54, 0x50, 0x78,
-	0x46, 0x99, 0xba, 0xa6, 0xa9, 0xab, 0x4d, 0x37, 0xd7, 0x02, 0x1a, 0x50, 0x69, 0x57, 0x8f, 0x7f,
-	0x29, 0x97, 0x4d, 0x47, 0x47, 0xef, 0x20, 0x8e, 0xd3, 0xa8, 0x1e, 0x25, 0xa1, 0xbe, 0x77, 0xcb,
-	0xd0, 0xa7, 0x70, 0x94, 0xfd, 0x86, 0xb2, 0x6f, 0x2b, 0x20, 0xcd, 0x47, 0x5d, 0xad, 0xa2, 0x01,
-	0x09, 0x69, 0x5d, 0xfe, 0x55, 0x47, 0xe7, 0x7f, 0xb6, 0xc0, 0xe9, 0x6b, 0xb8, 0x8f, 0x03, 0x24,
-	0x28, 0xfb, 0x88, 0x88, 0x9e, 0xcf, 0xd0, 0xf8, 0xdd, 0xb0, 0x4b, 0xe1, 0x75, 0xb0, 0xea, 0x27,
-	0x17, 0x6d, 0xe4, 0xfb, 0x0c, 0x73, 0x6e, 0x5b, 0x5b, 0xd6, 0xc5, 0xe5, 0x3d, 0xfb, 0xc1, 0xdd,
-	0x9d, 0x35, 0x1d, 0xf9, 0xaa, 0xba, 0x69, 0x09, 0x46, 0xc2, 0xa0, 0x79, 0x32, 0x75, 0xd1, 0xe7,
-	0xb0, 0x01, 0x4e, 0x8e, 0x75, 0xd8, 0x34, 0x4a, 0xf5, 0x11, 0x51, 0x9e, 0x49, 0x3c, 0xf4, 0xf1,
-	0x95, 0xa5, 0xaf, 0x6f, 0xd7, 0x2a, 0x7f, 0xdf, 0xae, 0x55, 0xce, 0xdf, 0xaa, 0x82, 0xed, 0x0f,
-	0x51, 0x9f, 0xf8, 0x31, 0xc6, 0x07, 0x23, 0xc1, 0x05, 0x0a, 0xfd, 0xd8, 0x07, 0x8f, 0x11, 0xf3,
-	0x79, 0x13, 0x7b, 0x94, 0xf9, 0xf0, 0x7d, 0xb0, 0x1a, 0x25, 0x46, 0x87, 0xb8, 0x6f, 0x3f, 0xb8,
-	0xbb, 0x73, 0x4e, 0xa3, 0xa6, 0x81, 0x0e, 0x89, 0x88, 0x0e, 0x9d, 0xc3, 0x5b, 0x16, 0x38, 0x45,
-	0x33, 0xb0, 0x36, 0x53, 0x68, 0x76, 0x75, 0x6b, 0xe1, 0xe2, 0xca, 0xee, 0x59, 0x5d, 0x22, 0x37,
-	0x2e, 0x61, 0x52, 0x6d, 0xf7, 0x1a, 0xf6, 0x1a, 0x94, 0x84, 0x7b, 0x97, 0xef, 0xfd, 0x5e, 0xab,
-	0xfc, 0xf4, 0x47, 0xed, 0xc5, 0x80, 0x88, 0xde, 0xa8, 0xe3, 0x7a, 0x74, 0xa0, 0xab, 0xa2, 0xff,
-	0xed, 0x70, 0xff, 0x66, 0x5d, 0x7c, 0x3e, 0xc4, 0x3c, 0xf1, 0xe1, 0x77, 0xfe, 0xfa, 0xe5, 0x05,
-	0xab, 0x09, 0x69, 0x4e, 0x9f, 0x91, 0x89, 0x7f, 0x2c, 0x70, 0x21, 0x13, 0xe0, 0x79, 0xa3, 0xc1,
-	0xa8, 0x8f, 0x04, 0xf6, 0x1b, 0x74, 0x30, 0x20, 0x9c, 0x13, 0x1a, 0xce, 0x29, 0x19, 0x3d, 0xb0,
-	0x82, 0x32, 0x38, 0x59, 0xcc, 0x95, 0xdd, 0xd7, 0xdd, 0x92, 0xce, 0x77, 0xcb, 0x79, 0xee, 0x2d,
-	0xc7, 0x29, 0x52, 0x9a, 0xcd, 0xd0, 0x86, 0xd8, 0x7f, 0x2d, 0xb0, 0x95, 0x06, 0x79, 0x87, 0x70,
-	0x41, 0x19, 0xf1, 0x50, 0x7f, 0xbe, 0x55, 0x5f, 0x07, 0x8b, 0x43, 0xcc, 0x08, 0x55, 0x1a, 0x8f,
-	0x35, 0xf5, 0x13, 0xfc, 0x14, 0x1c, 0x4f, 0x1a, 0x60, 0x41, 0x8a, 0x7f, 0x6d, 0x36, 0xf1, 0x39,
-	0xde, 0xa6, 0xf0, 0x24, 0xa4, 0x21, 0xfa, 0x57, 0x0b, 0x9c, 0x4b, 0x9d, 0x1b, 0x23, 0xc6, 0x70,
-	0x28, 0xe6, 0xab, 0xf8, 0xe3, 0x4c, 0x99, 0x2a, 0xeb, 0x2b, 0xb3, 0x29, 0x9b, 0x26, 0xf7, 0x08,
-	0x59, 0x3f, 0x56, 0xc1, 0x99, 0x74, 0xe4, 0xb4, 0x04, 0x62, 0x82, 0x84, 0x41, 0x3c, 0x72, 0xb4,
-	0xa8, 0x27, 0x34, 0x78, 0x0a, 0x73, 0x53, 0x3d, 0x7a, 0x6e, 0x3a, 0xe0, 0x69, 0xae, 0xc9, 0xb6,
-	0x49, 0xd8, 0xa5, 0xba, 0xf6, 0xbb, 0xa5, 0x19, 0x2a, 0xd4, 0x69, 0xe6, 0xe7, 0x04, 0x37, 0x2e,
-	0x8c, 0x24, 0x7d, 0x5f, 0x05, 0x1b, 0x29, 0xb5, 0x56, 0x1f, 0xf1, 0xde, 0xf5, 0x48, 0x66, 0x78,
-	0x5e, 0x9d, 0xde, 0xc3, 0x24, 0xe8, 0x89, 0xa4, 0xd3, 0xd5, 0x93, 0xf1, 0x06, 0x2c, 0x4c, 0xbd,
-	0x01, 0x14, 0x9c, 0xce, 0xf0, 0x79, 0xcc, 0xae, 0x8d, 0x63, 0x7a, 0xf6, 0x31, 0x99, 0x93, 0x97,
-	0x66, 0xeb, 0x9a, 0x4c, 0x96, 0x99, 0x91, 0x53, 0x51, 0xfe, 0xde, 0x48, 0xcc, 0xb7, 0xcb, 0xe0,
-	0xc4, 0xdb, 0x6a, 0xe7, 0xb6, 0x04, 0x12, 0x18, 0xde, 0x00, 0x8b, 0x43, 0xc4, 0xd0, 0x40, 0x25,
-	0x60, 0x65, 0xf7, 0xb9, 0x52, 0xf0, 0x7d, 0x69, 0x6a, 0xe2, 0x69, 0x6f, 0xf8, 0x1e, 0x58, 0xea,
-	0x62, 0xdc, 0x1e, 0x52, 0xda, 0xd7, 0xcd, 0x7f, 0xa1, 0x34, 0xd2, 0x0d, 0x8c, 0xf7, 0x29, 0xed,
-	0x4f, 0x35, 0x7b, 0x57, 0x9d, 0xc1, 0x31, 0xb0, 0xb3, 0x16, 0x4e, 0xd7, 0x5f, 0xdc, 0x35, 0xf1,
-	0xc8, 0x58, 0x98, 0xbd, 0x6d, 0xcc, 0x8d, 0x6c, 0x22, 0xad, 0xfb, 0x45, 0x16, 0x3c, 0x7e, 0x77,
-	0x86, 0x0c, 0x47, 0x84, 0x8e, 0xe4, 0x07, 0xc0, 0x90, 0x72, 0xcc, 0x64, 0x51, 0x4a, 0xdf, 0x9d,
-	0xc4, 0x65, 0x5f, 0x7b, 0xc0, 0x2f, 0x8a, 0xd7, 0xdd, 0x53, 0x92, 0xfa, 0x9b, 0xb3, 0x55, 0xf7,
-	0x61, 0xcb, 0xd9, 0x94, 0x51, 0xb0, 0xe1, 0xe0, 0x0f, 0x16, 0xd8, 0x36, 0x9a, 0x3b, 0x5b, 0x07,
-	0x6d, 0x2f, 0xdd, 0x18, 0xdc, 0x5e, 0x94, 0x54, 0xae, 0xfe, 0x8f, 0xad, 0x93, 0x67, 0x53, 0x8b,
-	0x4a, 0x1d, 0x38, 0xfc, 0xc6, 0x02, 0x67, 0x33, 0x6a, 0xbd, 0x74, 0x9c, 0xa7, 0x09, 0x3a, 0x2e,
-	0x59, 0xbd, 0x71, 0xc4, 0x75, 0x90, 0x67, 0xb4, 0x19, 0x3d, 0xd4, 0x18, 0x7e, 0x65, 0x81, 0x8d,
-	0x8c, 0x8c, 0xa7, 0x26, 0x70, 0xca, 0x64, 0x49, 0x32, 0xb9, 0x72, 0x94, 0xf1, 0x9d, 0xa7, 0xf1,
-	0x6c, 0x54, 0x6c, 0x09, 0xbf, 0x34, 0xfb, 0x7c, 0x6a, 0x3a, 0x72, 0x7b, 0x59, 0x32, 0xb8, 0xfc,
-	0xf8, 0xe3, 0x31, 0x8f, 0x9f, 0x75, 0xbb, 0x69, 0xc7, 0xe1, 0x18, 0xac, 0x17, 0x8e, 0x21, 0x6e,
-	0x03, 0x09, 0xfe, 0xea, 0xe3, 0xce, 0xa1, 0x3c, 0xf4, 0x5a, 0xc1, 0x34, 0x32, 0x96, 0xd9, 0xde,
-	0x5b, 0x77, 0x26, 0x8e, 0x75, 0x6f, 0xe2, 0x58, 0xf7, 0x27, 0x8e, 0xf5, 0xe7, 0xc4, 0xb1, 0xbe,
-	0x3b, 0x70, 0x2a, 0xf7, 0x0f, 0x9c, 0xca, 0x6f, 0x07, 0x4e, 0xe5, 0x93, 0x6d, 0x85, 0xcf, 0xfd,
-	0x9b, 0x2e, 0xa1, 0xf5, 0xcf, 0xa6, 0xbf, 0xe1, 0xe5, 0x27, 0x5f, 0x67, 0x51, 0x7e, 0x87, 0xbf,
-	0xfc, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x95, 0x53, 0xd9, 0xb1, 0x65, 0x0c, 0x00, 0x00,
+	// 1003 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0x3a, 0x21, 0x4d, 0xc6, 0x29, 0x34, 0xd3, 0x34, 0x6c, 0xd2, 0xd6, 0x4e, 0x4a, 0x0f,
+	0x2d, 0x28, 0x6b, 0x1a, 0x10, 0x54, 0x45, 0x80, 0x12, 0xb7, 0xe5, 0xc7, 0x01, 0xa2, 0x44, 0xa2,
+	0xe2, 0x87, 0x64, 0x8d, 0x77, 0x27, 0xf6, 0xa8, 0xf6, 0xbe, 0xd5, 0xce, 0xec, 0x9a, 0x20, 0x71,
+	0xe0, 0x54, 0xc4, 0x89, 0x13, 0xe2, 0x58, 0x71, 0xaa, 0x90, 0x90, 0x38, 0xf4, 0x8f, 0xe8, 0xb1,
+	0xaa, 0x38, 0x70, 0x02, 0x94, 0x20, 0x81, 0x38, 0xf2, 0x17, 0xa0, 0x9d, 0x99, 0xdd, 0x1d, 0xc7,
+	0x5b, 0xc7, 0x0d, 0xcd, 0x25, 0xb1, 0x67, 0xde, 0x7b, 0xdf, 0xf7, 0xbd, 0xf7, 0xf6, 0xbd, 0x35,
+	0xba, 0xec, 0x02, 0xef, 0x01, 0xaf, 0x7b, 0x8c, 0x8b, 0x90, 0xb5, 0x22, 0xc1, 0xc0, 0xaf, 0xc7,
+	0x57, 0x5a, 0x54, 0x90, 0x2b, 0xf5, 0x36, 0xf5, 0x29, 0x67, 0xdc, 0x09, 0x42, 0x10, 0x80, 0xcf,
+	0x2a, 0x53, 0xc7, 0x34, 0x75, 0xb4, 0xe9, 0xd2, 0x7c, 0x1b, 0xda, 0x20, 0xed, 0xea, 0xc9, 0x27,
+	0xe5, 0xb2, 0x54, 0xd5, 0xd1, 0x5b, 0x84, 0xd3, 0x2c, 0xaa, 0x0b, 0xcc, 0xd7, 0xf7, 0xce, 0x28,
+	0xf4, 0x01, 0x1c, 0x65, 0xbf, 0xa8, 0xec, 0x9b, 0x0a, 0x48, 0xf3, 0x51, 0x57, 0x73, 0xa4, 0xc7,
+	0x7c, 0xa8, 0xcb, 0xbf, 0xea, 0xe8, 0xc2, 0x4f, 0x16, 0x3a, 0x73, 0x9d, 0x76, 0x69, 0x9b, 0x08,
+	0x08, 0x6f, 0x31, 0xd1, 0xf1, 0x42, 0xd2, 0x7f, 0xcf, 0xdf, 0x01, 0x7c, 0x03, 0xcd, 0x79, 0xe9,
+	0x45, 0x93, 0x78, 0x5e, 0x48, 0x39, 0xb7, 0xad, 0x65, 0xeb, 0xd2, 0xcc, 0x86, 0xfd, 0xe8, 0xfe,
+	0xea, 0xbc, 0x8e, 0xbc, 0xae, 0x6e, 0xb6, 0x45, 0xc8, 0xfc, 0xf6, 0xd6, 0xa9, 0xcc, 0x45, 0x9f,
+	0xe3, 0x06, 0x3a, 0xd5, 0xd7, 0x61, 0xb3, 0x28, 0xe5, 0x43, 0xa2, 0x3c, 0x97, 0x7a, 0xe8, 0xe3,
+	0x6b, 0xd3, 0x5f, 0xdf, 0xad, 0x95, 0xfe, 0xbe, 0x5b, 0x2b, 0x5d, 0xb8, 0x53, 0x46, 0x2b, 0x1f,
+	0x91, 0x2e, 0xf3, 0x12, 0x8c, 0x0f, 0x23, 0xc1, 0x05, 0xf1, 0xbd, 0xc4, 0x87, 0xf6, 0x49, 0xe8,
+	0xf1, 0x2d, 0xea, 0x42, 0xe8, 0xe1, 0x0f, 0xd0, 0x5c, 0x9c, 0x1a, 0x1d, 0xe0, 0xbe, 0xf2, 0xe8,
+	0xfe, 0xea, 0x79, 0x8d, 0x9a, 0x05, 0x3a, 0x20, 0x22, 0x3e, 0x70, 0x8e, 0xef, 0x58, 0xe8, 0x34,
+	0xe4, 0x60, 0xcd, 0x50, 0xa1, 0xd9, 0xe5, 0xe5, 0x89, 0x4b, 0x95, 0xb5, 0x73, 0xba, 0x44, 0x4e,
+	0x52, 0xc2, 0xb4, 0xda, 0xce, 0x75, 0xea, 0x36, 0x80, 0xf9, 0x1b, 0x57, 0x1f, 0xfc, 0x56, 0x2b,
+	0xfd, 0xf8, 0x7b, 0xed, 0xa5, 0x36, 0x13, 0x9d, 0xa8, 0xe5, 0xb8, 0xd0, 0xd3, 0x55, 0xd1, 0xff,
+	0x56, 0xb9, 0x77, 0xbb, 0x2e, 0x76, 0x03, 0xca, 0x53, 0x1f, 0x7e, 0xef, 0xaf, 0x9f, 0x5f, 0xb4,
+	0xb6, 0x30, 0x0c, 0xe9, 0x33, 0x32, 0xf1, 0x8f, 0x85, 0x2e, 0xe6, 0x02, 0x5c, 0x37, 0xea, 0x45,
+	0x5d, 0x22, 0xa8, 0xd7, 0x80, 0x5e, 0x8f, 0x71, 0xce, 0xc0, 0x3f, 0xa6, 0x64, 0x74, 0x50, 0x85,
+	0xe4, 0x70, 0xb2, 0x98, 0x95, 0xb5, 0x37, 0x9c, 0x11, 0x9d, 0xef, 0x8c, 0xe6, 0xb9, 0x31, 0x93,
+	0xa4, 0x48, 0x69, 0x36, 0x43, 0x1b, 0x62, 0xff, 0xb5, 0xd0, 0x72, 0x16, 0xe4, 0x5d, 0xc6, 0x05,
+	0x84, 0xcc, 0x25, 0xdd, 0xe3, 0xad, 0xfa, 0x02, 0x9a, 0x0a, 0x68, 0xc8, 0x40, 0x69, 0x9c, 0xdc,
+	0xd2, 0xdf, 0xf0, 0x67, 0xe8, 0x44, 0xda, 0x00, 0x13, 0x52, 0xfc, 0xeb, 0xe3, 0x89, 0x1f, 0xe2,
+	0x6d, 0x0a, 0x4f, 0x43, 0x1a, 0xa2, 0x7f, 0xb1, 0xd0, 0xf9, 0xcc, 0xb9, 0x11, 0x85, 0x21, 0xf5,
+	0xc5, 0xf1, 0x2a, 0xfe, 0x38, 0x57, 0xa6, 0xca, 0xfa, 0xea, 0x78, 0xca, 0x06, 0xc9, 0x1d, 0x22,
+	0xeb, 0x87, 0x32, 0x3a, 0x9b, 0x8d, 0x9c, 0x6d, 0x41, 0x42, 0xc1, 0xfc, 0x76, 0x32, 0x72, 0xb4,
+	0xa8, 0xa7, 0x34, 0x78, 0x0a, 0x73, 0x53, 0x3e, 0x7a, 0x6e, 0x5a, 0xe8, 0x24, 0xd7, 0x64, 0x9b,
+	0xcc, 0xdf, 0x01, 0x5d, 0xfb, 0xb5, 0x91, 0x19, 0x2a, 0xd4, 0x69, 0xe6, 0x67, 0x96, 0x1b, 0x17,
+	0x46, 0x92, 0xbe, 0x2b, 0xa3, 0xc5, 0x8c, 0xda, 0x76, 0x97, 0xf0, 0xce, 0x8d, 0x58, 0x66, 0xf8,
+	0xb8, 0x3a, 0xbd, 0x43, 0x59, 0xbb, 0x23, 0xd2, 0x4e, 0x57, 0xdf, 0x8c, 0x27, 0x60, 0x62, 0xe0,
+	0x09, 0x00, 0x74, 0x26, 0xc7, 0xe7, 0x09, 0xbb, 0x26, 0x4d, 0xe8, 0xd9, 0x93, 0x32, 0x27, 0x2f,
+	0x8f, 0xd7, 0x35, 0xb9, 0x2c, 0x33, 0x23, 0xa7, 0xe3, 0xe1, 0x7b, 0x23, 0x31, 0x7f, 0x22, 0x34,
+	0xfb, 0x8e, 0xda, 0xb9, 0xdb, 0x82, 0x08, 0x8a, 0x6f, 0xa2, 0xa9, 0x80, 0x84, 0xa4, 0xa7, 0x12,
+	0x50, 0x59, 0x7b, 0x61, 0x24, 0xf8, 0xa6, 0x34, 0x35, 0xf1, 0xb4, 0x37, 0x7e, 0x1f, 0x4d, 0xef,
+	0x50, 0xda, 0x0c, 0x00, 0xba, 0xba, 0xf9, 0x2f, 0x8e, 0x8c, 0x74, 0x93, 0xd2, 0x4d, 0x80, 0xee,
+	0x40, 0xb3, 0xef, 0xa8, 0x33, 0xdc, 0x47, 0x76, 0xde, 0xc2, 0xd9, 0xfa, 0x4b, 0xba, 0x26, 0x19,
+	0x19, 0x13, 0xe3, 0xb7, 0x8d, 0xb9, 0x91, 0x4d, 0xa4, 0x05, 0xaf, 0xc8, 0x82, 0x27, 0xcf, 0x4e,
+	0x10, 0xd2, 0x98, 0x41, 0x24, 0x5f, 0x00, 0x02, 0xe0, 0x34, 0xb4, 0x27, 0x0f, 0x7b, 0x76, 0x52,
+	0x97, 0x4d, 0xed, 0x81, 0xbf, 0x28, 0x5e, 0x77, 0xcf, 0x48, 0xea, 0x6f, 0x8d, 0x57, 0xdd, 0xc7,
+	0x2d, 0x67, 0x53, 0x46, 0xc1, 0x86, 0xc3, 0xdf, 0x5b, 0x68, 0xc5, 0x68, 0xee, 0x7c, 0x1d, 0x34,
+	0xdd, 0x6c, 0x63, 0x70, 0x7b, 0x4a, 0x52, 0x59, 0xff, 0x1f, 0x5b, 0x67, 0x98, 0x4d, 0x2d, 0x1e,
+	0xe9, 0xc0, 0xf1, 0x37, 0x16, 0x3a, 0x97, 0x53, 0xeb, 0x64, 0xe3, 0x3c, 0x4b, 0xd0, 0x09, 0xc9,
+	0xea, 0xcd, 0x23, 0xae, 0x83, 0x61, 0x46, 0x4b, 0xf1, 0x63, 0x8d, 0xf1, 0x57, 0x16, 0x5a, 0xcc,
+	0xc9, 0xb8, 0x6a, 0x02, 0x67, 0x4c, 0xa6, 0x25, 0x93, 0x6b, 0x47, 0x19, 0xdf, 0xc3, 0x34, 0x9e,
+	0x8f, 0x8b, 0x2d, 0xf1, 0x97, 0x66, 0x9f, 0x0f, 0x4c, 0x47, 0x6e, 0xcf, 0x48, 0x06, 0x57, 0x9f,
+	0x7c, 0x3c, 0x0e, 0xe3, 0x2f, 0x78, 0x45, 0x76, 0x1c, 0xf7, 0xd1, 0x42, 0xe1, 0x18, 0xe2, 0x36,
+	0x92, 0xe0, 0xaf, 0x3d, 0xe9, 0x1c, 0x1a, 0x86, 0x9e, 0x2f, 0x98, 0x46, 0x1c, 0x7f, 0x8a, 0x9e,
+	0x25, 0x91, 0x80, 0xa4, 0x23, 0x03, 0x88, 0x7c, 0x8f, 0xdb, 0x15, 0x09, 0x78, 0x79, 0x24, 0xe0,
+	0x7a, 0x24, 0xa0, 0xa1, 0x3d, 0x4c, 0x8c, 0x93, 0xc4, 0xb8, 0xe0, 0x78, 0x17, 0x2d, 0x1e, 0x7c,
+	0x63, 0x6e, 0x06, 0xd0, 0x65, 0x2e, 0xa3, 0xdc, 0x9e, 0x1d, 0x63, 0x7a, 0xdc, 0x1a, 0x7c, 0x7b,
+	0xde, 0x4c, 0x7c, 0x77, 0x07, 0xea, 0xd9, 0x2f, 0xb0, 0x60, 0xd4, 0x58, 0xd2, 0x1b, 0x6f, 0xdf,
+	0xdb, 0xab, 0x5a, 0x0f, 0xf6, 0xaa, 0xd6, 0xc3, 0xbd, 0xaa, 0xf5, 0xc7, 0x5e, 0xd5, 0xfa, 0x76,
+	0xbf, 0x5a, 0x7a, 0xb8, 0x5f, 0x2d, 0xfd, 0xba, 0x5f, 0x2d, 0x7d, 0xb2, 0xa2, 0xe0, 0xb9, 0x77,
+	0xdb, 0x61, 0x50, 0xff, 0x7c, 0xf0, 0xb7, 0x89, 0x7c, 0x95, 0x6d, 0x4d, 0xc9, 0xdf, 0x17, 0xaf,
+	0xfc, 0x37, 0x00, 0x32, 0x9c, 0x87, 0xde, 0x3d, 0x0d, 0x00, 0x00,
 }
 
 func (m *DelegatorWithdrawInfo) Marshal() (dAtA []byte, err error) {
@@ -784,6 +796,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.WithdrawAddressPolicies) > 0 {
+		for iNdEx := len(m.WithdrawAddressPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WithdrawAddressPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.AutoCompounds) > 0 {
+		for iNdEx := len(m.AutoCompounds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoCompounds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.ValidatorSlashEvents) > 0 {
 		for iNdEx := len(m.ValidatorSlashEvents) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1103,6 +1143,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.AutoCompounds) > 0 {
+		for _, e := range m.AutoCompounds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.WithdrawAddressPolicies) > 0 {
+		for _, e := range m.WithdrawAddressPolicies {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2371,6 +2423,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompounds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoCompounds = append(m.AutoCompounds, AutoCompound{})
+			if err := m.AutoCompounds[len(m.AutoCompounds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddressPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawAddressPolicies = append(m.WithdrawAddressPolicies, WithdrawAddressPolicy{})
+			if err := m.WithdrawAddressPolicies[len(m.WithdrawAddressPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -50,6 +50,12 @@ const (
 // - 0x08<valAddrLen (1 Byte)><valAddr_Bytes><height><period>: ValidatorSlashEvent
 //
 // - 0x09: Params
+//
+// - 0x0A<accAddrLen (1 Byte)><accAddr_Bytes><valAddr_Bytes>: AutoCompound next height
+//
+// - 0x0B<height><accAddrLen (1 Byte)><accAddr_Bytes><valAddr_Bytes>: AutoCompoundQueue
+//
+// - 0x0C<accAddr_Bytes>: WithdrawAddressPolicy
 var (
 	FeePoolKey                           = collections.NewPrefix(0) // key for global distribution state
 	ProposerKey                          = collections.NewPrefix(1) // key for the proposer operator address
@@ -61,6 +67,11 @@ var (
 	ValidatorAccumulatedCommissionPrefix = collections.NewPrefix(7) // key for accumulated validator commission
 	ValidatorSlashEventPrefix            = collections.NewPrefix(8) // key for validator slash fraction
 	ParamsKey                            = collections.NewPrefix(9) // key for distribution module params
+
+	AutoCompoundPrefix      = collections.NewPrefix(10) // key for the auto-compounded delegations
+	AutoCompoundQueuePrefix = collections.NewPrefix(11) // key for the auto-compounded delegations by next height
+
+	WithdrawAddressPolicyPrefix = collections.NewPrefix(12) // key for delegator withdraw address policy
 )
 
 // GetValidatorSlashEventAddressHeight creates the height from a validator's slash event key.
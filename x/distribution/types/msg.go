@@ -53,3 +53,33 @@ func NewMsgDepositValidatorRewardsPool(depositor, valAddr string, amount sdk.Coi
 		ValidatorAddress: valAddr,
 	}
 }
+
+// NewMsgSetAutoCompound returns a new MsgSetAutoCompound opting the delegation
+// in or out of auto-compounding.
+func NewMsgSetAutoCompound(delAddr, valAddr string, enabled bool) *MsgSetAutoCompound {
+	return &MsgSetAutoCompound{
+		DelegatorAddress: delAddr,
+		ValidatorAddress: valAddr,
+		Enabled:          enabled,
+	}
+}
+
+// NewMsgSetWithdrawAddressPolicy returns a new MsgSetWithdrawAddressPolicy
+// splitting the withdrawn rewards of the delegator, or removing its policy if
+// no split is given.
+func NewMsgSetWithdrawAddressPolicy(delAddr string, splits []WithdrawAddressSplit) *MsgSetWithdrawAddressPolicy {
+	return &MsgSetWithdrawAddressPolicy{
+		DelegatorAddress: delAddr,
+		Splits:           splits,
+	}
+}
+
+// NewMsgWithdrawTokenizeShareRecordReward returns a new
+// MsgWithdrawTokenizeShareRecordReward withdrawing the rewards of a tokenize
+// share record to its owner.
+func NewMsgWithdrawTokenizeShareRecordReward(ownerAddr string, recordID uint64) *MsgWithdrawTokenizeShareRecordReward {
+	return &MsgWithdrawTokenizeShareRecordReward{
+		OwnerAddress: ownerAddr,
+		RecordId:     recordID,
+	}
+}
@@ -13,12 +13,23 @@ func DefaultParams() Params {
 		BaseProposerReward:  math.LegacyZeroDec(),            // deprecated
 		BonusProposerReward: math.LegacyZeroDec(),            // deprecated
 		WithdrawAddrEnabled: true,
+
+		AutoCompoundInterval:     0, // disabled
+		MaxAutoCompoundsPerBlock: DefaultMaxAutoCompoundsPerBlock,
 	}
 }
 
+// DefaultMaxAutoCompoundsPerBlock is the default maximum number of delegations
+// whose rewards are compounded in a single block.
+const DefaultMaxAutoCompoundsPerBlock uint32 = 100
+
 // ValidateBasic performs basic validation on distribution parameters.
 func (p Params) ValidateBasic() error {
-	return validateCommunityTax(p.CommunityTax)
+	if err := validateCommunityTax(p.CommunityTax); err != nil {
+		return err
+	}
+
+	return validateAutoCompound(p.AutoCompoundInterval, p.MaxAutoCompoundsPerBlock)
 }
 
 func validateCommunityTax(i interface{}) error {
@@ -39,3 +50,11 @@ func validateCommunityTax(i interface{}) error {
 
 	return nil
 }
+
+func validateAutoCompound(interval uint64, maxPerBlock uint32) error {
+	if interval > 0 && maxPerBlock == 0 {
+		return fmt.Errorf("max auto-compounds per block must be positive when auto-compounding is enabled")
+	}
+
+	return nil
+}
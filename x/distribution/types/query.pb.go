@@ -707,6 +707,150 @@ func (m *QueryDelegationTotalRewardsResponse) GetTotal() github_com_cosmos_cosmo
 	return nil
 }
 
+// QueryDelegationRewardsByDenomRequest is the request type for the
+// Query/DelegationRewardsByDenom RPC method.
+//
+// Since: cosmos-sdk 0.51
+type QueryDelegationRewardsByDenomRequest struct {
+	// delegator_address defines the delegator address to query for.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// denom defines the denom of the rewards to query for.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryDelegationRewardsByDenomRequest) Reset()         { *m = QueryDelegationRewardsByDenomRequest{} }
+func (m *QueryDelegationRewardsByDenomRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegationRewardsByDenomRequest) ProtoMessage()    {}
+func (*QueryDelegationRewardsByDenomRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{14}
+}
+func (m *QueryDelegationRewardsByDenomRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegationRewardsByDenomRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegationRewardsByDenomRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegationRewardsByDenomRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegationRewardsByDenomRequest.Merge(m, src)
+}
+func (m *QueryDelegationRewardsByDenomRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegationRewardsByDenomRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegationRewardsByDenomRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegationRewardsByDenomRequest proto.InternalMessageInfo
+
+// QueryDelegationRewardsByDenomResponse is the response type for the
+// Query/DelegationRewardsByDenom RPC method.
+//
+// Since: cosmos-sdk 0.51
+type QueryDelegationRewardsByDenomResponse struct {
+	// rewards defines the rewards of the denom accrued by each delegation.
+	Rewards []DelegationDenomReward `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards"`
+	// total defines the sum of all the rewards of the denom.
+	Total types.DecCoin `protobuf:"bytes,2,opt,name=total,proto3" json:"total"`
+}
+
+func (m *QueryDelegationRewardsByDenomResponse) Reset()         { *m = QueryDelegationRewardsByDenomResponse{} }
+func (m *QueryDelegationRewardsByDenomResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegationRewardsByDenomResponse) ProtoMessage()    {}
+func (*QueryDelegationRewardsByDenomResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{15}
+}
+func (m *QueryDelegationRewardsByDenomResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegationRewardsByDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegationRewardsByDenomResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegationRewardsByDenomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegationRewardsByDenomResponse.Merge(m, src)
+}
+func (m *QueryDelegationRewardsByDenomResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegationRewardsByDenomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegationRewardsByDenomResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegationRewardsByDenomResponse proto.InternalMessageInfo
+
+func (m *QueryDelegationRewardsByDenomResponse) GetRewards() []DelegationDenomReward {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+func (m *QueryDelegationRewardsByDenomResponse) GetTotal() types.DecCoin {
+	if m != nil {
+		return m.Total
+	}
+	return types.DecCoin{}
+}
+
+// DelegationDenomReward represents the rewards of a single denom accrued by a
+// delegation.
+//
+// Since: cosmos-sdk 0.51
+type DelegationDenomReward struct {
+	ValidatorAddress string        `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Reward           types.DecCoin `protobuf:"bytes,2,opt,name=reward,proto3" json:"reward"`
+}
+
+func (m *DelegationDenomReward) Reset()         { *m = DelegationDenomReward{} }
+func (m *DelegationDenomReward) String() string { return proto.CompactTextString(m) }
+func (*DelegationDenomReward) ProtoMessage()    {}
+func (*DelegationDenomReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{16}
+}
+func (m *DelegationDenomReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegationDenomReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegationDenomReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegationDenomReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegationDenomReward.Merge(m, src)
+}
+func (m *DelegationDenomReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegationDenomReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegationDenomReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegationDenomReward proto.InternalMessageInfo
+
 // QueryDelegatorValidatorsRequest is the request type for the
 // Query/DelegatorValidators RPC method.
 type QueryDelegatorValidatorsRequest struct {
@@ -718,7 +862,7 @@ func (m *QueryDelegatorValidatorsRequest) Reset()         { *m = QueryDelegatorV
 func (m *QueryDelegatorValidatorsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegatorValidatorsRequest) ProtoMessage()    {}
 func (*QueryDelegatorValidatorsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{14}
+	return fileDescriptor_5efd02cbc06efdc9, []int{17}
 }
 func (m *QueryDelegatorValidatorsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -758,7 +902,7 @@ func (m *QueryDelegatorValidatorsResponse) Reset()         { *m = QueryDelegator
 func (m *QueryDelegatorValidatorsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegatorValidatorsResponse) ProtoMessage()    {}
 func (*QueryDelegatorValidatorsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{15}
+	return fileDescriptor_5efd02cbc06efdc9, []int{18}
 }
 func (m *QueryDelegatorValidatorsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -798,7 +942,7 @@ func (m *QueryDelegatorWithdrawAddressRequest) Reset()         { *m = QueryDeleg
 func (m *QueryDelegatorWithdrawAddressRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegatorWithdrawAddressRequest) ProtoMessage()    {}
 func (*QueryDelegatorWithdrawAddressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{16}
+	return fileDescriptor_5efd02cbc06efdc9, []int{19}
 }
 func (m *QueryDelegatorWithdrawAddressRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -838,7 +982,7 @@ func (m *QueryDelegatorWithdrawAddressResponse) Reset()         { *m = QueryDele
 func (m *QueryDelegatorWithdrawAddressResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegatorWithdrawAddressResponse) ProtoMessage()    {}
 func (*QueryDelegatorWithdrawAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{17}
+	return fileDescriptor_5efd02cbc06efdc9, []int{20}
 }
 func (m *QueryDelegatorWithdrawAddressResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -867,6 +1011,196 @@ func (m *QueryDelegatorWithdrawAddressResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryDelegatorWithdrawAddressResponse proto.InternalMessageInfo
 
+// QueryDelegatorAutoCompoundsRequest is the request type for the
+// Query/DelegatorAutoCompounds RPC method.
+//
+// Since: cosmos-sdk 0.51
+type QueryDelegatorAutoCompoundsRequest struct {
+	// delegator_address defines the delegator address to query for.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+}
+
+func (m *QueryDelegatorAutoCompoundsRequest) Reset()         { *m = QueryDelegatorAutoCompoundsRequest{} }
+func (m *QueryDelegatorAutoCompoundsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorAutoCompoundsRequest) ProtoMessage()    {}
+func (*QueryDelegatorAutoCompoundsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{21}
+}
+func (m *QueryDelegatorAutoCompoundsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorAutoCompoundsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorAutoCompoundsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorAutoCompoundsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorAutoCompoundsRequest.Merge(m, src)
+}
+func (m *QueryDelegatorAutoCompoundsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorAutoCompoundsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorAutoCompoundsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorAutoCompoundsRequest proto.InternalMessageInfo
+
+// QueryDelegatorAutoCompoundsResponse is the response type for the
+// Query/DelegatorAutoCompounds RPC method.
+//
+// Since: cosmos-sdk 0.51
+type QueryDelegatorAutoCompoundsResponse struct {
+	AutoCompounds []AutoCompound `protobuf:"bytes,1,rep,name=auto_compounds,json=autoCompounds,proto3" json:"auto_compounds"`
+}
+
+func (m *QueryDelegatorAutoCompoundsResponse) Reset()         { *m = QueryDelegatorAutoCompoundsResponse{} }
+func (m *QueryDelegatorAutoCompoundsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorAutoCompoundsResponse) ProtoMessage()    {}
+func (*QueryDelegatorAutoCompoundsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{22}
+}
+func (m *QueryDelegatorAutoCompoundsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorAutoCompoundsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorAutoCompoundsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorAutoCompoundsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorAutoCompoundsResponse.Merge(m, src)
+}
+func (m *QueryDelegatorAutoCompoundsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorAutoCompoundsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorAutoCompoundsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorAutoCompoundsResponse proto.InternalMessageInfo
+
+func (m *QueryDelegatorAutoCompoundsResponse) GetAutoCompounds() []AutoCompound {
+	if m != nil {
+		return m.AutoCompounds
+	}
+	return nil
+}
+
+// QueryDelegatorWithdrawAddressPolicyRequest is the request type for the
+// Query/DelegatorWithdrawAddressPolicy RPC method.
+//
+// Since: cosmos-sdk 0.51
+type QueryDelegatorWithdrawAddressPolicyRequest struct {
+	// delegator_address defines the delegator address to query for.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+}
+
+func (m *QueryDelegatorWithdrawAddressPolicyRequest) Reset() {
+	*m = QueryDelegatorWithdrawAddressPolicyRequest{}
+}
+func (m *QueryDelegatorWithdrawAddressPolicyRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryDelegatorWithdrawAddressPolicyRequest) ProtoMessage() {}
+func (*QueryDelegatorWithdrawAddressPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{23}
+}
+func (m *QueryDelegatorWithdrawAddressPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorWithdrawAddressPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorWithdrawAddressPolicyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorWithdrawAddressPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorWithdrawAddressPolicyRequest.Merge(m, src)
+}
+func (m *QueryDelegatorWithdrawAddressPolicyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorWithdrawAddressPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorWithdrawAddressPolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorWithdrawAddressPolicyRequest proto.InternalMessageInfo
+
+// QueryDelegatorWithdrawAddressPolicyResponse is the response type for the
+// Query/DelegatorWithdrawAddressPolicy RPC method.
+//
+// Since: cosmos-sdk 0.51
+type QueryDelegatorWithdrawAddressPolicyResponse struct {
+	// splits are the shares of the withdrawn rewards of the delegator. It is
+	// empty if the delegator has no withdraw address policy.
+	Splits []WithdrawAddressSplit `protobuf:"bytes,1,rep,name=splits,proto3" json:"splits"`
+}
+
+func (m *QueryDelegatorWithdrawAddressPolicyResponse) Reset() {
+	*m = QueryDelegatorWithdrawAddressPolicyResponse{}
+}
+func (m *QueryDelegatorWithdrawAddressPolicyResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryDelegatorWithdrawAddressPolicyResponse) ProtoMessage() {}
+func (*QueryDelegatorWithdrawAddressPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{24}
+}
+func (m *QueryDelegatorWithdrawAddressPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorWithdrawAddressPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorWithdrawAddressPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorWithdrawAddressPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorWithdrawAddressPolicyResponse.Merge(m, src)
+}
+func (m *QueryDelegatorWithdrawAddressPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorWithdrawAddressPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorWithdrawAddressPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorWithdrawAddressPolicyResponse proto.InternalMessageInfo
+
+func (m *QueryDelegatorWithdrawAddressPolicyResponse) GetSplits() []WithdrawAddressSplit {
+	if m != nil {
+		return m.Splits
+	}
+	return nil
+}
+
 // QueryCommunityPoolRequest is the request type for the Query/CommunityPool RPC
 // method.
 //
@@ -881,7 +1215,7 @@ func (m *QueryCommunityPoolRequest) Reset()         { *m = QueryCommunityPoolReq
 func (m *QueryCommunityPoolRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCommunityPoolRequest) ProtoMessage()    {}
 func (*QueryCommunityPoolRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{18}
+	return fileDescriptor_5efd02cbc06efdc9, []int{25}
 }
 func (m *QueryCommunityPoolRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -926,7 +1260,7 @@ func (m *QueryCommunityPoolResponse) Reset()         { *m = QueryCommunityPoolRe
 func (m *QueryCommunityPoolResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCommunityPoolResponse) ProtoMessage()    {}
 func (*QueryCommunityPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{19}
+	return fileDescriptor_5efd02cbc06efdc9, []int{26}
 }
 func (m *QueryCommunityPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -977,10 +1311,17 @@ func init() {
 	proto.RegisterType((*QueryDelegationRewardsResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegationRewardsResponse")
 	proto.RegisterType((*QueryDelegationTotalRewardsRequest)(nil), "cosmos.distribution.v1beta1.QueryDelegationTotalRewardsRequest")
 	proto.RegisterType((*QueryDelegationTotalRewardsResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegationTotalRewardsResponse")
+	proto.RegisterType((*QueryDelegationRewardsByDenomRequest)(nil), "cosmos.distribution.v1beta1.QueryDelegationRewardsByDenomRequest")
+	proto.RegisterType((*QueryDelegationRewardsByDenomResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegationRewardsByDenomResponse")
+	proto.RegisterType((*DelegationDenomReward)(nil), "cosmos.distribution.v1beta1.DelegationDenomReward")
 	proto.RegisterType((*QueryDelegatorValidatorsRequest)(nil), "cosmos.distribution.v1beta1.QueryDelegatorValidatorsRequest")
 	proto.RegisterType((*QueryDelegatorValidatorsResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorValidatorsResponse")
 	proto.RegisterType((*QueryDelegatorWithdrawAddressRequest)(nil), "cosmos.distribution.v1beta1.QueryDelegatorWithdrawAddressRequest")
 	proto.RegisterType((*QueryDelegatorWithdrawAddressResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorWithdrawAddressResponse")
+	proto.RegisterType((*QueryDelegatorAutoCompoundsRequest)(nil), "cosmos.distribution.v1beta1.QueryDelegatorAutoCompoundsRequest")
+	proto.RegisterType((*QueryDelegatorAutoCompoundsResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorAutoCompoundsResponse")
+	proto.RegisterType((*QueryDelegatorWithdrawAddressPolicyRequest)(nil), "cosmos.distribution.v1beta1.QueryDelegatorWithdrawAddressPolicyRequest")
+	proto.RegisterType((*QueryDelegatorWithdrawAddressPolicyResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorWithdrawAddressPolicyResponse")
 	proto.RegisterType((*QueryCommunityPoolRequest)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolRequest")
 	proto.RegisterType((*QueryCommunityPoolResponse)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolResponse")
 }
@@ -990,88 +1331,103 @@ func init() {
 }
 
 var fileDescriptor_5efd02cbc06efdc9 = []byte{
-	// 1283 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4f, 0x6c, 0x1b, 0xc5,
-	0x17, 0xce, 0x38, 0x69, 0xfa, 0xcb, 0xeb, 0xaf, 0x24, 0x9e, 0x46, 0xc8, 0xd9, 0xa6, 0x4e, 0xba,
-	0xa1, 0x4d, 0xd4, 0x28, 0xde, 0x36, 0x95, 0x42, 0x49, 0xa8, 0x20, 0x76, 0x12, 0x8a, 0x88, 0xd2,
-	0xd6, 0x2d, 0x44, 0x80, 0x2a, 0x6b, 0xed, 0xdd, 0x38, 0x4b, 0xed, 0x1d, 0x67, 0x67, 0x1d, 0x13,
-	0x55, 0xbd, 0x94, 0x4b, 0xa9, 0x38, 0x20, 0xb8, 0x70, 0xe4, 0x88, 0x38, 0x71, 0xe8, 0x81, 0x1b,
-	0xdc, 0xe8, 0xb1, 0x2a, 0x12, 0xe2, 0x04, 0x28, 0x41, 0xa2, 0x1c, 0x40, 0xdc, 0xb8, 0xa2, 0x9d,
-	0x99, 0xb5, 0x77, 0xed, 0xf5, 0xfa, 0x9f, 0x7c, 0x49, 0xac, 0xd9, 0x79, 0xdf, 0xf7, 0xbe, 0xf7,
-	0xde, 0xcc, 0x7e, 0x36, 0xcc, 0xe6, 0x08, 0x2d, 0x12, 0xaa, 0x68, 0x06, 0xb5, 0x2d, 0x23, 0x5b,
-	0xb6, 0x0d, 0x62, 0x2a, 0xfb, 0x97, 0xb2, 0xba, 0xad, 0x5e, 0x52, 0xf6, 0xca, 0xba, 0x75, 0x90,
-	0x28, 0x59, 0xc4, 0x26, 0xf8, 0x34, 0xdf, 0x98, 0xf0, 0x6e, 0x4c, 0x88, 0x8d, 0xd2, 0x05, 0x81,
-	0x92, 0x55, 0xa9, 0xce, 0xa3, 0xaa, 0x18, 0x25, 0x35, 0x6f, 0x98, 0x2a, 0xdb, 0xcd, 0x80, 0xa4,
-	0xf1, 0x3c, 0xc9, 0x13, 0xf6, 0x51, 0x71, 0x3e, 0x89, 0xd5, 0xc9, 0x3c, 0x21, 0xf9, 0x82, 0xae,
-	0xa8, 0x25, 0x43, 0x51, 0x4d, 0x93, 0xd8, 0x2c, 0x84, 0x8a, 0xa7, 0x71, 0x2f, 0xbe, 0x8b, 0x9c,
-	0x23, 0x86, 0x8b, 0x99, 0x08, 0x53, 0xe1, 0xcb, 0x98, 0xef, 0x9f, 0xe0, 0xfb, 0x33, 0x3c, 0x0d,
-	0xa1, 0x8c, 0x3f, 0x8a, 0xaa, 0x45, 0xc3, 0x24, 0x0a, 0xfb, 0xcb, 0x97, 0xe4, 0x71, 0xc0, 0x37,
-	0x1d, 0x4d, 0x37, 0x54, 0x4b, 0x2d, 0xd2, 0xb4, 0xbe, 0x57, 0xd6, 0xa9, 0x2d, 0xdf, 0x81, 0x53,
-	0xbe, 0x55, 0x5a, 0x22, 0x26, 0xd5, 0xf1, 0x06, 0x0c, 0x97, 0xd8, 0x4a, 0x0c, 0x4d, 0xa3, 0xb9,
-	0x13, 0x8b, 0x33, 0x89, 0x90, 0xc2, 0x25, 0x78, 0x70, 0x72, 0xe4, 0xc9, 0x2f, 0x53, 0x03, 0x5f,
-	0xfd, 0xf1, 0xcd, 0x05, 0x94, 0x16, 0xd1, 0x72, 0x05, 0xce, 0x31, 0xf8, 0x77, 0xd4, 0x82, 0xa1,
-	0xa9, 0x36, 0xb1, 0xd6, 0x3c, 0xf1, 0x6f, 0x9a, 0x3b, 0x44, 0xe4, 0x81, 0xb7, 0x20, 0xba, 0xef,
-	0xee, 0xc9, 0xa8, 0x9a, 0x66, 0xe9, 0x94, 0x73, 0x8f, 0x24, 0xcf, 0x3e, 0x7b, 0xbc, 0x70, 0x46,
-	0xd0, 0x57, 0x71, 0x56, 0xf9, 0x96, 0x5b, 0xb6, 0x65, 0x98, 0xf9, 0xf4, 0xd8, 0x7e, 0xdd, 0xba,
-	0xfc, 0x77, 0x04, 0xce, 0xb7, 0x62, 0x16, 0x5a, 0x37, 0x61, 0x8c, 0x94, 0x74, 0xab, 0x3b, 0xe6,
-	0x51, 0x37, 0x54, 0x2c, 0xe3, 0x07, 0x08, 0xa2, 0x54, 0x2f, 0xec, 0x64, 0xb2, 0xc4, 0xd4, 0x32,
-	0x96, 0x5e, 0x51, 0x2d, 0x8d, 0xc6, 0x22, 0xd3, 0x83, 0x73, 0x27, 0x16, 0x27, 0xdd, 0x2a, 0x3a,
-	0x13, 0x50, 0xad, 0xde, 0x9a, 0x9e, 0x4b, 0x11, 0xc3, 0x4c, 0x5e, 0x71, 0xca, 0xf7, 0xf5, 0xaf,
-	0x53, 0xf3, 0x79, 0xc3, 0xde, 0x2d, 0x67, 0x13, 0x39, 0x52, 0x14, 0x4d, 0x15, 0xff, 0x16, 0xa8,
-	0x76, 0x57, 0xb1, 0x0f, 0x4a, 0x3a, 0x75, 0x63, 0x28, 0xaf, 0xf6, 0xa8, 0x43, 0x98, 0x24, 0xa6,
-	0x96, 0xe6, 0x74, 0x78, 0x0f, 0x20, 0x47, 0x8a, 0x45, 0x83, 0x52, 0x83, 0x98, 0xb1, 0xc1, 0x36,
-	0xc8, 0x2f, 0x77, 0x41, 0x9e, 0xf6, 0x90, 0xc8, 0x07, 0x30, 0xeb, 0xaf, 0xf7, 0xf5, 0xb2, 0x4d,
-	0x6d, 0xd5, 0xd4, 0x9c, 0x2a, 0xf1, 0xb4, 0xfa, 0xd5, 0xeb, 0x8f, 0x11, 0xcc, 0xb5, 0xe6, 0x16,
-	0xdd, 0xbe, 0x03, 0xc7, 0xdd, 0xa6, 0xf0, 0xd1, 0xbe, 0x12, 0x3a, 0xda, 0x21, 0x90, 0xde, 0x79,
-	0x77, 0x31, 0xe5, 0x3d, 0x98, 0xf2, 0xa7, 0x92, 0xaa, 0x96, 0xa8, 0x5f, 0xf2, 0x1f, 0x21, 0x98,
-	0x6e, 0xce, 0x29, 0x64, 0xef, 0xf8, 0x26, 0x82, 0x2b, 0x5f, 0x69, 0x4f, 0xf9, 0x6a, 0x2e, 0x57,
-	0x2e, 0x96, 0x0b, 0xaa, 0xad, 0x6b, 0x35, 0x60, 0xaf, 0x78, 0xef, 0x18, 0x3c, 0x8a, 0xc0, 0xa4,
-	0x3f, 0x99, 0x5b, 0x05, 0x95, 0xee, 0xea, 0xfd, 0x6a, 0x3e, 0x9e, 0x85, 0x51, 0x6a, 0xab, 0x96,
-	0x6d, 0x98, 0xf9, 0xcc, 0xae, 0x6e, 0xe4, 0x77, 0xed, 0x58, 0x64, 0x1a, 0xcd, 0x0d, 0xa5, 0x5f,
-	0x70, 0x97, 0xaf, 0xb1, 0x55, 0x3c, 0x03, 0x27, 0x75, 0xd6, 0x3e, 0x77, 0xdb, 0x20, 0xdb, 0xf6,
-	0x7f, 0xbe, 0x28, 0x36, 0x6d, 0x00, 0xd4, 0xae, 0xfa, 0xd8, 0x10, 0x2b, 0xd3, 0x79, 0xdf, 0xc1,
-	0xe1, 0x6f, 0x93, 0xda, 0xcd, 0x97, 0xd7, 0x85, 0xb2, 0xb4, 0x27, 0x72, 0x79, 0xe8, 0xe1, 0x97,
-	0x53, 0x03, 0xf2, 0x77, 0x08, 0xce, 0x34, 0x29, 0x86, 0x68, 0xcb, 0xdb, 0x70, 0x9c, 0xf2, 0xa5,
-	0x18, 0x62, 0xa7, 0xf4, 0x62, 0x7b, 0x3d, 0x61, 0x38, 0xeb, 0xfb, 0xba, 0x69, 0xfb, 0xa6, 0x50,
-	0x60, 0xe1, 0x37, 0x7c, 0x32, 0x22, 0x4c, 0xc6, 0x6c, 0x4b, 0x19, 0x3c, 0x27, 0xaf, 0x0e, 0xf9,
-	0x7b, 0x57, 0xc1, 0x9a, 0x5e, 0xd0, 0xf3, 0x6c, 0xad, 0xee, 0x30, 0xaf, 0x43, 0x54, 0xe3, 0xcf,
-	0x1a, 0xfa, 0x19, 0x7b, 0xf6, 0x78, 0x61, 0x5c, 0x90, 0xd6, 0xb5, 0xb1, 0x1a, 0xe2, 0xb6, 0x31,
-	0x70, 0x2c, 0x22, 0x5d, 0x8f, 0xc5, 0xf2, 0xff, 0x9c, 0x06, 0x3c, 0x77, 0x9a, 0xf0, 0x19, 0x82,
-	0x78, 0x33, 0x09, 0xa2, 0x0b, 0x25, 0xef, 0x9d, 0xd0, 0xcf, 0x8b, 0xba, 0x7a, 0x4d, 0x94, 0x41,
-	0xae, 0xcb, 0xe9, 0x36, 0xb1, 0xd5, 0x42, 0x5f, 0x6a, 0xeb, 0xa9, 0xc5, 0x3f, 0x08, 0x66, 0x42,
-	0x79, 0x45, 0x41, 0xde, 0xaf, 0x2f, 0xc8, 0x52, 0xe8, 0x58, 0xd6, 0xd0, 0xd6, 0x5c, 0x6e, 0x8e,
-	0x18, 0x74, 0x45, 0xe2, 0x02, 0x1c, 0xb3, 0x1d, 0xd2, 0x3e, 0xbf, 0x14, 0x39, 0x89, 0x6c, 0x89,
-	0x0b, 0xb9, 0x9a, 0x59, 0x75, 0x84, 0xfa, 0x57, 0xe6, 0x4d, 0x71, 0x21, 0x07, 0x72, 0x8a, 0x12,
-	0xc7, 0x01, 0xaa, 0x43, 0xcb, 0xab, 0x3c, 0x92, 0xf6, 0xac, 0x78, 0xd0, 0x2a, 0xf0, 0x92, 0x1f,
-	0x6d, 0xdb, 0xb0, 0x77, 0x35, 0x4b, 0xad, 0x08, 0xe2, 0xbe, 0xc9, 0xd8, 0x17, 0xe6, 0xad, 0x39,
-	0xb1, 0xd0, 0x92, 0x82, 0xb1, 0x8a, 0x78, 0xd4, 0x36, 0xf1, 0x68, 0xc5, 0x0f, 0xe6, 0xe1, 0x9d,
-	0x82, 0x09, 0xc6, 0xeb, 0xbc, 0x6d, 0xca, 0xa6, 0x61, 0x1f, 0xdc, 0x20, 0xa4, 0x20, 0x54, 0x2e,
-	0x47, 0x62, 0x48, 0xfe, 0x04, 0x81, 0x14, 0xb4, 0x43, 0xa4, 0xf3, 0x01, 0x0c, 0x95, 0x08, 0x29,
-	0xf4, 0xf9, 0x2c, 0x33, 0x0e, 0x27, 0x9d, 0xc5, 0x1f, 0xa2, 0x70, 0x8c, 0xa5, 0x83, 0xbf, 0x40,
-	0x30, 0xcc, 0xcd, 0x30, 0x56, 0x42, 0x4f, 0x4c, 0xa3, 0x13, 0x97, 0x2e, 0xb6, 0x1f, 0xc0, 0x75,
-	0xca, 0xf3, 0x0f, 0x7e, 0xfc, 0xfd, 0xf3, 0xc8, 0x39, 0x3c, 0xa3, 0x84, 0x7d, 0x71, 0xe0, 0x4e,
-	0x1c, 0xff, 0x89, 0x60, 0xa2, 0xa9, 0x17, 0xc6, 0xc9, 0xd6, 0xe4, 0xad, 0x2c, 0xbc, 0x94, 0xea,
-	0x09, 0x43, 0x68, 0x4a, 0x31, 0x4d, 0x57, 0xf1, 0x4a, 0xa8, 0xa6, 0xda, 0x39, 0x51, 0xee, 0x35,
-	0xbc, 0x36, 0xee, 0xe3, 0x8f, 0x22, 0x70, 0x3a, 0xc4, 0xb8, 0xe1, 0xb5, 0x0e, 0x32, 0x6d, 0x6a,
-	0x63, 0xa5, 0xf5, 0x1e, 0x51, 0x84, 0xe2, 0x6d, 0xa6, 0xf8, 0x26, 0xbe, 0xde, 0x83, 0x62, 0x85,
-	0xd4, 0xf0, 0xdd, 0xef, 0x1c, 0xf8, 0x10, 0xc1, 0xa9, 0x00, 0x4b, 0x88, 0x5f, 0xed, 0x20, 0xef,
-	0x06, 0xf7, 0x2a, 0x5d, 0xed, 0x32, 0x5a, 0xa8, 0xdd, 0x62, 0x6a, 0xaf, 0xe1, 0x8d, 0x5e, 0xd4,
-	0xd6, 0xfc, 0x26, 0xfe, 0x09, 0xc1, 0x58, 0xbd, 0xbb, 0xc2, 0xaf, 0x74, 0x90, 0xa3, 0xdf, 0x9e,
-	0x4a, 0xcb, 0xdd, 0x84, 0x0a, 0x6d, 0x6f, 0x31, 0x6d, 0xeb, 0x38, 0xd5, 0x8b, 0x36, 0xd7, 0xc2,
-	0xfd, 0x85, 0x20, 0xda, 0xe0, 0x58, 0x70, 0x1b, 0xe9, 0x35, 0x73, 0x6a, 0xd2, 0x4a, 0x57, 0xb1,
-	0x42, 0x5b, 0x86, 0x69, 0x7b, 0x17, 0x6f, 0x87, 0x6a, 0xab, 0xbe, 0x4c, 0xa8, 0x72, 0xaf, 0xe1,
-	0x5d, 0x74, 0x5f, 0x11, 0x93, 0x19, 0x78, 0x66, 0x9f, 0x23, 0x78, 0x31, 0xd8, 0x95, 0xe0, 0xd7,
-	0x3a, 0x49, 0x3c, 0xc0, 0x47, 0x49, 0xaf, 0x77, 0x0f, 0xd0, 0x51, 0x6b, 0xdb, 0x93, 0xcf, 0x0e,
-	0x66, 0x80, 0x35, 0x68, 0xe7, 0x60, 0x36, 0x77, 0x31, 0xed, 0x1c, 0xcc, 0x10, 0x3f, 0xd2, 0xe6,
-	0xc1, 0x6c, 0xa1, 0xb0, 0x36, 0xdb, 0xf8, 0x5f, 0x04, 0xb1, 0x66, 0xc6, 0x01, 0xaf, 0x76, 0x90,
-	0x6b, 0xb0, 0xdb, 0x91, 0x92, 0xbd, 0x40, 0x08, 0xcd, 0xb7, 0x99, 0xe6, 0x2d, 0xbc, 0xd9, 0x8b,
-	0xe6, 0x7a, 0xe7, 0x83, 0xbf, 0x45, 0x70, 0xd2, 0x67, 0x4c, 0xf0, 0x52, 0xeb, 0x5c, 0x83, 0xbc,
-	0x8e, 0xf4, 0x72, 0xc7, 0x71, 0x42, 0xd8, 0x12, 0x13, 0xb6, 0x80, 0xe7, 0x43, 0x85, 0xe5, 0xdc,
-	0xd8, 0x8c, 0x63, 0x65, 0x1e, 0x46, 0x50, 0x72, 0xe5, 0xc9, 0x61, 0x1c, 0x3d, 0x3d, 0x8c, 0xa3,
-	0xdf, 0x0e, 0xe3, 0xe8, 0xd3, 0xa3, 0xf8, 0xc0, 0xd3, 0xa3, 0xf8, 0xc0, 0xcf, 0x47, 0xf1, 0x81,
-	0xf7, 0xce, 0x72, 0x20, 0xaa, 0xdd, 0x4d, 0x18, 0x44, 0xf9, 0xd0, 0x0f, 0xc8, 0xec, 0x51, 0x76,
-	0x98, 0xfd, 0xce, 0x78, 0xf9, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xfb, 0xd3, 0xf1, 0xe8, 0x8d,
-	0x15, 0x00, 0x00,
+	// 1535 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0x4d, 0x6c, 0x13, 0xc7,
+	0x17, 0xcf, 0x98, 0x10, 0xfe, 0x3c, 0xfe, 0x90, 0x64, 0x48, 0x5b, 0xb3, 0x80, 0x13, 0x36, 0x0d,
+	0x49, 0x89, 0xe2, 0x85, 0x20, 0x51, 0x9a, 0x34, 0x82, 0xd8, 0x09, 0xd0, 0x36, 0xe2, 0xc3, 0x81,
+	0x46, 0x2d, 0x42, 0xd6, 0xda, 0xbb, 0x38, 0x5b, 0xec, 0x1d, 0x67, 0x77, 0x1c, 0xd7, 0x42, 0x5c,
+	0xe0, 0x42, 0x51, 0x2b, 0xa1, 0xf6, 0xd2, 0x63, 0x8f, 0x55, 0x0f, 0x55, 0x0f, 0x1c, 0x7a, 0xa3,
+	0xa7, 0x8a, 0x23, 0xa2, 0x52, 0xd5, 0x53, 0x5b, 0x85, 0x4a, 0xa5, 0x87, 0x7e, 0xdc, 0x7a, 0xad,
+	0x76, 0x66, 0xd6, 0xde, 0xf5, 0xc7, 0xfa, 0x2b, 0xbe, 0x24, 0xce, 0xec, 0xbc, 0xdf, 0x7b, 0xbf,
+	0xf7, 0x7b, 0xf3, 0x76, 0x9e, 0x03, 0x93, 0x69, 0x62, 0xe7, 0x88, 0xad, 0x68, 0x86, 0x4d, 0x2d,
+	0x23, 0x55, 0xa0, 0x06, 0x31, 0x95, 0xcd, 0x13, 0x29, 0x9d, 0xaa, 0x27, 0x94, 0x8d, 0x82, 0x6e,
+	0x95, 0xa2, 0x79, 0x8b, 0x50, 0x82, 0x0f, 0xf2, 0x8d, 0x51, 0xef, 0xc6, 0xa8, 0xd8, 0x28, 0x1d,
+	0x13, 0x28, 0x29, 0xd5, 0xd6, 0xb9, 0x55, 0x19, 0x23, 0xaf, 0x66, 0x0c, 0x53, 0x65, 0xbb, 0x19,
+	0x90, 0x34, 0x92, 0x21, 0x19, 0xc2, 0x3e, 0x2a, 0xce, 0x27, 0xb1, 0x7a, 0x28, 0x43, 0x48, 0x26,
+	0xab, 0x2b, 0x6a, 0xde, 0x50, 0x54, 0xd3, 0x24, 0x94, 0x99, 0xd8, 0xe2, 0x69, 0xc4, 0x8b, 0xef,
+	0x22, 0xa7, 0x89, 0xe1, 0x62, 0x46, 0x83, 0x58, 0xf8, 0x22, 0xe6, 0xfb, 0x0f, 0xf0, 0xfd, 0x49,
+	0x1e, 0x86, 0x60, 0xc6, 0x1f, 0x0d, 0xab, 0x39, 0xc3, 0x24, 0x0a, 0xfb, 0xc9, 0x97, 0xe4, 0x11,
+	0xc0, 0x57, 0x1c, 0x4e, 0x97, 0x55, 0x4b, 0xcd, 0xd9, 0x09, 0x7d, 0xa3, 0xa0, 0xdb, 0x54, 0xbe,
+	0x01, 0xfb, 0x7d, 0xab, 0x76, 0x9e, 0x98, 0xb6, 0x8e, 0xcf, 0xc1, 0x40, 0x9e, 0xad, 0x84, 0xd1,
+	0x18, 0x9a, 0xda, 0x33, 0x3b, 0x1e, 0x0d, 0x48, 0x5c, 0x94, 0x1b, 0xc7, 0x76, 0x3f, 0xf9, 0x79,
+	0xb4, 0xef, 0xcb, 0xdf, 0xbf, 0x39, 0x86, 0x12, 0xc2, 0x5a, 0x2e, 0xc2, 0x04, 0x83, 0x7f, 0x57,
+	0xcd, 0x1a, 0x9a, 0x4a, 0x89, 0xb5, 0xe4, 0xb1, 0x7f, 0xcb, 0xbc, 0x49, 0x44, 0x1c, 0xf8, 0x22,
+	0x0c, 0x6f, 0xba, 0x7b, 0x92, 0xaa, 0xa6, 0x59, 0xba, 0xcd, 0x7d, 0xef, 0x8e, 0x1d, 0x79, 0xf6,
+	0x68, 0xe6, 0xb0, 0x70, 0x5f, 0xc6, 0x59, 0xe4, 0x5b, 0x56, 0xa9, 0x65, 0x98, 0x99, 0xc4, 0xd0,
+	0x66, 0xd5, 0xba, 0xfc, 0x57, 0x08, 0x8e, 0x36, 0xf3, 0x2c, 0xb8, 0xae, 0xc0, 0x10, 0xc9, 0xeb,
+	0x56, 0x67, 0x9e, 0x07, 0x5d, 0x53, 0xb1, 0x8c, 0xef, 0x22, 0x18, 0xb6, 0xf5, 0xec, 0xcd, 0x64,
+	0x8a, 0x98, 0x5a, 0xd2, 0xd2, 0x8b, 0xaa, 0xa5, 0xd9, 0xe1, 0xd0, 0xd8, 0x8e, 0xa9, 0x3d, 0xb3,
+	0x87, 0xdc, 0x2c, 0x3a, 0x15, 0x50, 0xce, 0xde, 0x92, 0x9e, 0x8e, 0x13, 0xc3, 0x8c, 0x9d, 0x76,
+	0xd2, 0xf7, 0xd5, 0x2f, 0xa3, 0xd3, 0x19, 0x83, 0xae, 0x17, 0x52, 0xd1, 0x34, 0xc9, 0x09, 0x51,
+	0xc5, 0xaf, 0x19, 0x5b, 0xbb, 0xa5, 0xd0, 0x52, 0x5e, 0xb7, 0x5d, 0x1b, 0x9b, 0x67, 0x7b, 0xd0,
+	0x71, 0x18, 0x23, 0xa6, 0x96, 0xe0, 0xee, 0xf0, 0x06, 0x40, 0x9a, 0xe4, 0x72, 0x86, 0x6d, 0x1b,
+	0xc4, 0x0c, 0xef, 0x68, 0xc1, 0xf9, 0xc9, 0x0e, 0x9c, 0x27, 0x3c, 0x4e, 0xe4, 0x12, 0x4c, 0xfa,
+	0xf3, 0x7d, 0xa9, 0x40, 0x6d, 0xaa, 0x9a, 0x9a, 0x93, 0x25, 0x1e, 0x56, 0xaf, 0xb4, 0xfe, 0x08,
+	0xc1, 0x54, 0x73, 0xdf, 0x42, 0xed, 0x1b, 0xb0, 0xcb, 0x15, 0x85, 0x97, 0xf6, 0xe9, 0xc0, 0xd2,
+	0x0e, 0x80, 0xf4, 0xd6, 0xbb, 0x8b, 0x29, 0x6f, 0xc0, 0xa8, 0x3f, 0x94, 0x78, 0x39, 0x45, 0xbd,
+	0xa2, 0xff, 0x00, 0xc1, 0x58, 0x63, 0x9f, 0x82, 0xf6, 0x4d, 0x5f, 0x45, 0x70, 0xe6, 0xf3, 0xad,
+	0x31, 0x5f, 0x4c, 0xa7, 0x0b, 0xb9, 0x42, 0x56, 0xa5, 0xba, 0x56, 0x01, 0xf6, 0x92, 0xf7, 0x96,
+	0xc1, 0x83, 0x10, 0x1c, 0xf2, 0x07, 0xb3, 0x9a, 0x55, 0xed, 0x75, 0xbd, 0x57, 0xe2, 0xe3, 0x49,
+	0x18, 0xb4, 0xa9, 0x6a, 0x51, 0xc3, 0xcc, 0x24, 0xd7, 0x75, 0x23, 0xb3, 0x4e, 0xc3, 0xa1, 0x31,
+	0x34, 0xd5, 0x9f, 0xd8, 0xe7, 0x2e, 0x5f, 0x60, 0xab, 0x78, 0x1c, 0xf6, 0xea, 0xa6, 0xe6, 0xd9,
+	0xb6, 0x83, 0x6d, 0xfb, 0x3f, 0x5f, 0x14, 0x9b, 0xce, 0x01, 0x54, 0x5a, 0x7d, 0xb8, 0x9f, 0xa5,
+	0xe9, 0xa8, 0xef, 0xe0, 0xf0, 0xb7, 0x49, 0xa5, 0xf3, 0x65, 0x74, 0xc1, 0x2c, 0xe1, 0xb1, 0x9c,
+	0xeb, 0xbf, 0xff, 0xc5, 0x68, 0x9f, 0xfc, 0x18, 0xc1, 0xe1, 0x06, 0xc9, 0x10, 0xb2, 0x5c, 0x83,
+	0x5d, 0x36, 0x5f, 0x0a, 0x23, 0x76, 0x4a, 0x8f, 0xb7, 0xa6, 0x09, 0xc3, 0x59, 0xde, 0xd4, 0x4d,
+	0xea, 0xab, 0x42, 0x81, 0x85, 0xcf, 0xfb, 0x68, 0x84, 0x18, 0x8d, 0xc9, 0xa6, 0x34, 0x78, 0x4c,
+	0x5e, 0x1e, 0xf2, 0x77, 0x2e, 0x83, 0x25, 0x3d, 0xab, 0x67, 0xd8, 0x5a, 0xd5, 0x61, 0x5e, 0x86,
+	0x61, 0x8d, 0x3f, 0xab, 0xd1, 0x33, 0xfc, 0xec, 0xd1, 0xcc, 0x88, 0x70, 0x5a, 0x25, 0x63, 0xd9,
+	0xc4, 0x95, 0xb1, 0x6e, 0x59, 0x84, 0x3a, 0x2e, 0x8b, 0xb9, 0xff, 0x39, 0x02, 0xbc, 0x70, 0x44,
+	0xf8, 0x14, 0x41, 0xa4, 0x11, 0x05, 0xa1, 0x42, 0xde, 0xdb, 0x13, 0x7a, 0xd9, 0xa8, 0xcb, 0x6d,
+	0xa2, 0x00, 0x72, 0x55, 0x4c, 0x57, 0x09, 0x55, 0xb3, 0x3d, 0xc9, 0xad, 0x27, 0x17, 0xff, 0x20,
+	0x18, 0x0f, 0xf4, 0x2b, 0x12, 0x72, 0xbd, 0x3a, 0x21, 0xa7, 0x02, 0xcb, 0xb2, 0x82, 0xb6, 0xe4,
+	0xfa, 0xe6, 0x88, 0xf5, 0x5a, 0x24, 0xce, 0xc2, 0x4e, 0xea, 0x38, 0xed, 0xf1, 0x4b, 0x91, 0x3b,
+	0x91, 0x3f, 0x41, 0xf0, 0x6a, 0x7d, 0xf9, 0x63, 0xa5, 0x25, 0xdd, 0x24, 0xb9, 0x6d, 0x2e, 0xe4,
+	0x11, 0xd8, 0xa9, 0x39, 0xb0, 0xbc, 0x78, 0x13, 0xfc, 0x0f, 0x8f, 0x04, 0x8f, 0x11, 0x4c, 0x34,
+	0x89, 0x47, 0x88, 0xb0, 0x56, 0x2d, 0xc2, 0x6c, 0xcb, 0x22, 0x30, 0x98, 0x86, 0x02, 0x2c, 0x54,
+	0x04, 0x40, 0x4d, 0x05, 0xf0, 0x00, 0x88, 0x8c, 0x7e, 0x8d, 0xe0, 0xa5, 0xba, 0xce, 0xb6, 0xbd,
+	0xb7, 0x9f, 0x81, 0x01, 0x1e, 0x73, 0xbb, 0x91, 0x0a, 0x33, 0xd1, 0x86, 0x2d, 0xf1, 0x4e, 0x2e,
+	0x17, 0x67, 0x39, 0x80, 0xde, 0x9d, 0xb4, 0x15, 0x18, 0x6b, 0xec, 0x53, 0x08, 0x1c, 0x01, 0x28,
+	0x53, 0xe6, 0x1a, 0xef, 0x4e, 0x78, 0x56, 0x3c, 0x68, 0x45, 0x7f, 0x0d, 0x13, 0x6b, 0xcd, 0xa0,
+	0xeb, 0x9a, 0xa5, 0x16, 0x85, 0xe3, 0x9e, 0xd1, 0xd8, 0x84, 0x89, 0x26, 0x8e, 0x05, 0x97, 0x38,
+	0x0c, 0x15, 0xc5, 0xa3, 0x96, 0x1d, 0x0f, 0x16, 0xfd, 0x60, 0x1e, 0xbf, 0x55, 0xfd, 0x91, 0x58,
+	0x8b, 0x05, 0x4a, 0xe2, 0x24, 0x97, 0x27, 0x05, 0xb3, 0x87, 0xfd, 0xf1, 0x6e, 0x55, 0x7f, 0xac,
+	0xf1, 0x5b, 0xee, 0x8f, 0xfb, 0xd4, 0x02, 0x25, 0xc9, 0xb4, 0xfb, 0x44, 0x9c, 0xd0, 0xd7, 0x02,
+	0x4f, 0xa8, 0x17, 0xcb, 0x5b, 0xad, 0x7b, 0x55, 0xaf, 0x13, 0xf9, 0x0e, 0x1c, 0x0b, 0xcc, 0xf9,
+	0x65, 0x92, 0x35, 0xd2, 0xa5, 0x9e, 0xe5, 0xe0, 0x1e, 0x82, 0xe9, 0x96, 0xfc, 0x8b, 0x5c, 0x5c,
+	0x85, 0x01, 0x3b, 0x9f, 0x35, 0xa8, 0x9b, 0x83, 0x13, 0x81, 0x39, 0xa8, 0xc2, 0x5a, 0x75, 0x2c,
+	0x7d, 0x27, 0x97, 0x63, 0xc9, 0xa3, 0x70, 0x80, 0x05, 0xe1, 0xdc, 0x38, 0x0b, 0xa6, 0x41, 0x4b,
+	0x97, 0x09, 0xc9, 0x0a, 0xce, 0x73, 0xa1, 0x30, 0x92, 0x3f, 0x46, 0x20, 0xd5, 0xdb, 0x21, 0xa2,
+	0xfa, 0x00, 0xfa, 0xf3, 0x84, 0x64, 0x7b, 0xfc, 0x3e, 0x67, 0x3e, 0x9c, 0x70, 0x66, 0xbf, 0x7f,
+	0x05, 0x76, 0xb2, 0x70, 0xf0, 0xe7, 0x08, 0x06, 0xf8, 0x40, 0x8c, 0x95, 0xc0, 0x54, 0xd4, 0x4e,
+	0xe3, 0xd2, 0xf1, 0xd6, 0x0d, 0x38, 0x4f, 0x79, 0xfa, 0xee, 0x0f, 0xbf, 0x7d, 0x16, 0x9a, 0xc0,
+	0xe3, 0x4a, 0xd0, 0x97, 0x07, 0x7c, 0x1a, 0xc7, 0x7f, 0x20, 0x38, 0xd0, 0x70, 0x1e, 0xc6, 0xb1,
+	0xe6, 0xce, 0x9b, 0x8d, 0xf1, 0x52, 0xbc, 0x2b, 0x0c, 0xc1, 0x29, 0xce, 0x38, 0x2d, 0xe0, 0xf9,
+	0x40, 0x4e, 0x95, 0x46, 0xa9, 0xdc, 0xae, 0x79, 0xeb, 0xdc, 0xc1, 0xf7, 0x42, 0x70, 0x30, 0x60,
+	0x78, 0xc3, 0x4b, 0x6d, 0x44, 0xda, 0x70, 0x94, 0x95, 0x96, 0xbb, 0x44, 0x11, 0x8c, 0xd7, 0x18,
+	0xe3, 0x2b, 0xf8, 0x52, 0x17, 0x8c, 0x15, 0x52, 0xc1, 0x77, 0xbf, 0x77, 0xc0, 0x5b, 0x08, 0xf6,
+	0xd7, 0x19, 0x0b, 0xf1, 0x9b, 0x6d, 0xc4, 0x5d, 0x33, 0xc1, 0x4a, 0x0b, 0x1d, 0x5a, 0x0b, 0xb6,
+	0x17, 0x19, 0xdb, 0x0b, 0xf8, 0x5c, 0x37, 0x6c, 0x2b, 0x33, 0x27, 0xfe, 0x11, 0xc1, 0x50, 0xf5,
+	0x84, 0x85, 0xdf, 0x68, 0x23, 0x46, 0xff, 0x88, 0x2a, 0xcd, 0x75, 0x62, 0x2a, 0xb8, 0xbd, 0xc3,
+	0xb8, 0x2d, 0xe3, 0x78, 0x37, 0xdc, 0xdc, 0x31, 0xee, 0x4f, 0x04, 0xc3, 0x35, 0xd7, 0x44, 0xdc,
+	0x42, 0x78, 0x8d, 0xa6, 0x35, 0x69, 0xbe, 0x23, 0x5b, 0xc1, 0x2d, 0xc9, 0xb8, 0xbd, 0x87, 0xd7,
+	0x02, 0xb9, 0x95, 0x5f, 0x2d, 0xb6, 0x72, 0xbb, 0xe6, 0xcd, 0x74, 0x47, 0x11, 0x95, 0x59, 0xf7,
+	0xcc, 0xbe, 0x40, 0xf0, 0x72, 0xfd, 0xc9, 0x04, 0x9f, 0x69, 0x27, 0xf0, 0x3a, 0xb3, 0x94, 0x74,
+	0xb6, 0x73, 0x80, 0xb6, 0xa4, 0x6d, 0x8d, 0x3e, 0xfe, 0x17, 0x41, 0xb8, 0xd1, 0x04, 0x80, 0x17,
+	0x3b, 0x50, 0xc9, 0x3f, 0xcd, 0x48, 0xb1, 0x6e, 0x20, 0x04, 0xe1, 0xab, 0x8c, 0xf0, 0x45, 0xbc,
+	0xb2, 0x0d, 0x84, 0x93, 0xa9, 0x52, 0x92, 0x8d, 0x42, 0xac, 0x25, 0xd5, 0xb9, 0x15, 0xb7, 0xd2,
+	0x92, 0x1a, 0x5f, 0xe0, 0xa5, 0x85, 0x0e, 0xad, 0xdb, 0x6a, 0x49, 0x4d, 0xa8, 0x56, 0x4e, 0xb5,
+	0x57, 0xde, 0x9a, 0xfb, 0x53, 0x1b, 0xf2, 0x36, 0xba, 0xe8, 0x4b, 0xb1, 0x6e, 0x20, 0xb6, 0x53,
+	0xde, 0xea, 0x4b, 0x3f, 0xfe, 0xbb, 0x72, 0x86, 0xab, 0x6e, 0xcf, 0x6d, 0x9c, 0xe1, 0xfa, 0xf7,
+	0x7d, 0xe9, 0x6c, 0xe7, 0x00, 0x82, 0x73, 0x82, 0x71, 0x5e, 0xc1, 0x6f, 0x77, 0xc3, 0xd9, 0x7f,
+	0xf5, 0xc7, 0x0f, 0x43, 0x10, 0x09, 0xbe, 0x2b, 0xe3, 0xf3, 0x9d, 0xcb, 0xe5, 0xbb, 0xed, 0x4b,
+	0x17, 0xba, 0x07, 0x12, 0x99, 0xb8, 0xce, 0x32, 0x71, 0x0d, 0xaf, 0x6e, 0xa7, 0xfa, 0xc9, 0x3c,
+	0xe7, 0xfb, 0x2d, 0x82, 0xbd, 0xbe, 0x7b, 0x39, 0x3e, 0xd5, 0x3c, 0xf0, 0x7a, 0x57, 0x7d, 0xe9,
+	0xf5, 0xb6, 0xed, 0x04, 0xbf, 0x53, 0x8c, 0xdf, 0x0c, 0x9e, 0x0e, 0xe4, 0x97, 0x76, 0x6d, 0x93,
+	0xce, 0x4d, 0xfe, 0x7e, 0x08, 0xc5, 0xe6, 0x9f, 0x6c, 0x45, 0xd0, 0xd3, 0xad, 0x08, 0xfa, 0x75,
+	0x2b, 0x82, 0x1e, 0x3e, 0x8f, 0xf4, 0x3d, 0x7d, 0x1e, 0xe9, 0xfb, 0xe9, 0x79, 0xa4, 0xef, 0xfd,
+	0x23, 0x1c, 0xc8, 0xd6, 0x6e, 0x45, 0x0d, 0xa2, 0x7c, 0xe8, 0x07, 0x64, 0xd3, 0x41, 0x6a, 0x80,
+	0xfd, 0xab, 0xed, 0xe4, 0x7f, 0x03, 0x00, 0x79, 0x7d, 0x28, 0xd0, 0x90, 0x1c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1101,10 +1457,25 @@ type QueryClient interface {
 	// DelegationTotalRewards queries the total rewards accrued by each
 	// validator.
 	DelegationTotalRewards(ctx context.Context, in *QueryDelegationTotalRewardsRequest, opts ...grpc.CallOption) (*QueryDelegationTotalRewardsResponse, error)
+	// DelegationRewardsByDenom queries the rewards of a single denom accrued by
+	// each delegation of a delegator.
+	//
+	// Since: cosmos-sdk 0.51
+	DelegationRewardsByDenom(ctx context.Context, in *QueryDelegationRewardsByDenomRequest, opts ...grpc.CallOption) (*QueryDelegationRewardsByDenomResponse, error)
 	// DelegatorValidators queries the validators of a delegator.
 	DelegatorValidators(ctx context.Context, in *QueryDelegatorValidatorsRequest, opts ...grpc.CallOption) (*QueryDelegatorValidatorsResponse, error)
 	// DelegatorWithdrawAddress queries withdraw address of a delegator.
 	DelegatorWithdrawAddress(ctx context.Context, in *QueryDelegatorWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryDelegatorWithdrawAddressResponse, error)
+	// DelegatorAutoCompounds queries the delegations of a delegator whose rewards
+	// are auto-compounded.
+	//
+	// Since: cosmos-sdk 0.51
+	DelegatorAutoCompounds(ctx context.Context, in *QueryDelegatorAutoCompoundsRequest, opts ...grpc.CallOption) (*QueryDelegatorAutoCompoundsResponse, error)
+	// DelegatorWithdrawAddressPolicy queries the withdraw address policy of a
+	// delegator.
+	//
+	// Since: cosmos-sdk 0.51
+	DelegatorWithdrawAddressPolicy(ctx context.Context, in *QueryDelegatorWithdrawAddressPolicyRequest, opts ...grpc.CallOption) (*QueryDelegatorWithdrawAddressPolicyResponse, error)
 	// CommunityPool queries the community pool coins.
 	//
 	// Deprecated: Prefer to use x/protocolpool module's CommunityPool rpc method.
@@ -1183,6 +1554,15 @@ func (c *queryClient) DelegationTotalRewards(ctx context.Context, in *QueryDeleg
 	return out, nil
 }
 
+func (c *queryClient) DelegationRewardsByDenom(ctx context.Context, in *QueryDelegationRewardsByDenomRequest, opts ...grpc.CallOption) (*QueryDelegationRewardsByDenomResponse, error) {
+	out := new(QueryDelegationRewardsByDenomResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/DelegationRewardsByDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) DelegatorValidators(ctx context.Context, in *QueryDelegatorValidatorsRequest, opts ...grpc.CallOption) (*QueryDelegatorValidatorsResponse, error) {
 	out := new(QueryDelegatorValidatorsResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/DelegatorValidators", in, out, opts...)
@@ -1201,6 +1581,24 @@ func (c *queryClient) DelegatorWithdrawAddress(ctx context.Context, in *QueryDel
 	return out, nil
 }
 
+func (c *queryClient) DelegatorAutoCompounds(ctx context.Context, in *QueryDelegatorAutoCompoundsRequest, opts ...grpc.CallOption) (*QueryDelegatorAutoCompoundsResponse, error) {
+	out := new(QueryDelegatorAutoCompoundsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/DelegatorAutoCompounds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegatorWithdrawAddressPolicy(ctx context.Context, in *QueryDelegatorWithdrawAddressPolicyRequest, opts ...grpc.CallOption) (*QueryDelegatorWithdrawAddressPolicyResponse, error) {
+	out := new(QueryDelegatorWithdrawAddressPolicyResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/DelegatorWithdrawAddressPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Deprecated: Do not use.
 func (c *queryClient) CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error) {
 	out := new(QueryCommunityPoolResponse)
@@ -1228,10 +1626,25 @@ type QueryServer interface {
 	// DelegationTotalRewards queries the total rewards accrued by each
 	// validator.
 	DelegationTotalRewards(context.Context, *QueryDelegationTotalRewardsRequest) (*QueryDelegationTotalRewardsResponse, error)
+	// DelegationRewardsByDenom queries the rewards of a single denom accrued by
+	// each delegation of a delegator.
+	//
+	// Since: cosmos-sdk 0.51
+	DelegationRewardsByDenom(context.Context, *QueryDelegationRewardsByDenomRequest) (*QueryDelegationRewardsByDenomResponse, error)
 	// DelegatorValidators queries the validators of a delegator.
 	DelegatorValidators(context.Context, *QueryDelegatorValidatorsRequest) (*QueryDelegatorValidatorsResponse, error)
 	// DelegatorWithdrawAddress queries withdraw address of a delegator.
 	DelegatorWithdrawAddress(context.Context, *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error)
+	// DelegatorAutoCompounds queries the delegations of a delegator whose rewards
+	// are auto-compounded.
+	//
+	// Since: cosmos-sdk 0.51
+	DelegatorAutoCompounds(context.Context, *QueryDelegatorAutoCompoundsRequest) (*QueryDelegatorAutoCompoundsResponse, error)
+	// DelegatorWithdrawAddressPolicy queries the withdraw address policy of a
+	// delegator.
+	//
+	// Since: cosmos-sdk 0.51
+	DelegatorWithdrawAddressPolicy(context.Context, *QueryDelegatorWithdrawAddressPolicyRequest) (*QueryDelegatorWithdrawAddressPolicyResponse, error)
 	// CommunityPool queries the community pool coins.
 	//
 	// Deprecated: Prefer to use x/protocolpool module's CommunityPool rpc method.
@@ -1264,12 +1677,21 @@ func (*UnimplementedQueryServer) DelegationRewards(ctx context.Context, req *Que
 func (*UnimplementedQueryServer) DelegationTotalRewards(ctx context.Context, req *QueryDelegationTotalRewardsRequest) (*QueryDelegationTotalRewardsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegationTotalRewards not implemented")
 }
+func (*UnimplementedQueryServer) DelegationRewardsByDenom(ctx context.Context, req *QueryDelegationRewardsByDenomRequest) (*QueryDelegationRewardsByDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegationRewardsByDenom not implemented")
+}
 func (*UnimplementedQueryServer) DelegatorValidators(ctx context.Context, req *QueryDelegatorValidatorsRequest) (*QueryDelegatorValidatorsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegatorValidators not implemented")
 }
 func (*UnimplementedQueryServer) DelegatorWithdrawAddress(ctx context.Context, req *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegatorWithdrawAddress not implemented")
 }
+func (*UnimplementedQueryServer) DelegatorAutoCompounds(ctx context.Context, req *QueryDelegatorAutoCompoundsRequest) (*QueryDelegatorAutoCompoundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorAutoCompounds not implemented")
+}
+func (*UnimplementedQueryServer) DelegatorWithdrawAddressPolicy(ctx context.Context, req *QueryDelegatorWithdrawAddressPolicyRequest) (*QueryDelegatorWithdrawAddressPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorWithdrawAddressPolicy not implemented")
+}
 func (*UnimplementedQueryServer) CommunityPool(ctx context.Context, req *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CommunityPool not implemented")
 }
@@ -1404,6 +1826,24 @@ func _Query_DelegationTotalRewards_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DelegationRewardsByDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegationRewardsByDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegationRewardsByDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/DelegationRewardsByDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegationRewardsByDenom(ctx, req.(*QueryDelegationRewardsByDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_DelegatorValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryDelegatorValidatorsRequest)
 	if err := dec(in); err != nil {
@@ -1440,40 +1880,76 @@ func _Query_DelegatorWithdrawAddress_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_CommunityPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCommunityPoolRequest)
+func _Query_DelegatorAutoCompounds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorAutoCompoundsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).CommunityPool(ctx, in)
+		return srv.(QueryServer).DelegatorAutoCompounds(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/cosmos.distribution.v1beta1.Query/CommunityPool",
+		FullMethod: "/cosmos.distribution.v1beta1.Query/DelegatorAutoCompounds",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CommunityPool(ctx, req.(*QueryCommunityPoolRequest))
+		return srv.(QueryServer).DelegatorAutoCompounds(ctx, req.(*QueryDelegatorAutoCompoundsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cosmos.distribution.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "ValidatorDistributionInfo",
-			Handler:    _Query_ValidatorDistributionInfo_Handler,
-		},
-		{
-			MethodName: "ValidatorOutstandingRewards",
-			Handler:    _Query_ValidatorOutstandingRewards_Handler,
-		},
+func _Query_DelegatorWithdrawAddressPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorWithdrawAddressPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorWithdrawAddressPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/DelegatorWithdrawAddressPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorWithdrawAddressPolicy(ctx, req.(*QueryDelegatorWithdrawAddressPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CommunityPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCommunityPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CommunityPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/CommunityPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CommunityPool(ctx, req.(*QueryCommunityPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.distribution.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ValidatorDistributionInfo",
+			Handler:    _Query_ValidatorDistributionInfo_Handler,
+		},
+		{
+			MethodName: "ValidatorOutstandingRewards",
+			Handler:    _Query_ValidatorOutstandingRewards_Handler,
+		},
 		{
 			MethodName: "ValidatorCommission",
 			Handler:    _Query_ValidatorCommission_Handler,
@@ -1490,6 +1966,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DelegationTotalRewards",
 			Handler:    _Query_DelegationTotalRewards_Handler,
 		},
+		{
+			MethodName: "DelegationRewardsByDenom",
+			Handler:    _Query_DelegationRewardsByDenom_Handler,
+		},
 		{
 			MethodName: "DelegatorValidators",
 			Handler:    _Query_DelegatorValidators_Handler,
@@ -1498,6 +1978,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DelegatorWithdrawAddress",
 			Handler:    _Query_DelegatorWithdrawAddress_Handler,
 		},
+		{
+			MethodName: "DelegatorAutoCompounds",
+			Handler:    _Query_DelegatorAutoCompounds_Handler,
+		},
+		{
+			MethodName: "DelegatorWithdrawAddressPolicy",
+			Handler:    _Query_DelegatorWithdrawAddressPolicy_Handler,
+		},
 		{
 			MethodName: "CommunityPool",
 			Handler:    _Query_CommunityPool_Handler,
@@ -2033,6 +2521,130 @@ func (m *QueryDelegationTotalRewardsResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryDelegationRewardsByDenomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegationRewardsByDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationRewardsByDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegationRewardsByDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegationRewardsByDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationRewardsByDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Total.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegationDenomReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegationDenomReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegationDenomReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Reward.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryDelegatorValidatorsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2155,7 +2767,7 @@ func (m *QueryDelegatorWithdrawAddressResponse) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryCommunityPoolRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryDelegatorAutoCompoundsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2165,20 +2777,27 @@ func (m *QueryCommunityPoolRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryCommunityPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDelegatorAutoCompoundsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCommunityPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDelegatorAutoCompoundsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryCommunityPoolResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryDelegatorAutoCompoundsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2188,20 +2807,20 @@ func (m *QueryCommunityPoolResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryCommunityPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDelegatorAutoCompoundsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCommunityPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDelegatorAutoCompoundsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Pool) > 0 {
-		for iNdEx := len(m.Pool) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.AutoCompounds) > 0 {
+		for iNdEx := len(m.AutoCompounds) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Pool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.AutoCompounds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2215,56 +2834,183 @@ func (m *QueryCommunityPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryDelegatorWithdrawAddressPolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryDelegatorWithdrawAddressPolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryDelegatorWithdrawAddressPolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidatorDistributionInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDelegatorWithdrawAddressPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorWithdrawAddressPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorWithdrawAddressPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Splits) > 0 {
+		for iNdEx := len(m.Splits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Splits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidatorDistributionInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *QueryCommunityPoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCommunityPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCommunityPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCommunityPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCommunityPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCommunityPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pool) > 0 {
+		for iNdEx := len(m.Pool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorDistributionInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorDistributionInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	l = len(m.OperatorAddress)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
@@ -2440,6 +3186,55 @@ func (m *QueryDelegationTotalRewardsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryDelegationRewardsByDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegationRewardsByDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Total.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *DelegationDenomReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Reward.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func (m *QueryDelegatorValidatorsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2494,6 +3289,62 @@ func (m *QueryDelegatorWithdrawAddressResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryDelegatorAutoCompoundsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorAutoCompoundsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AutoCompounds) > 0 {
+		for _, e := range m.AutoCompounds {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDelegatorWithdrawAddressPolicyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorWithdrawAddressPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Splits) > 0 {
+		for _, e := range m.Splits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *QueryCommunityPoolRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3194,7 +4045,681 @@ func (m *QueryValidatorCommissionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorSlashesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorSlashesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorSlashesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartingHeight", wireType)
+			}
+			m.StartingHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartingHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndingHeight", wireType)
+			}
+			m.EndingHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndingHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorSlashesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorSlashesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorSlashesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Slashes = append(m.Slashes, ValidatorSlashEvent{})
+			if err := m.Slashes[len(m.Slashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegationRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegationRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegationRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegationRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegationRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegationRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.DecCoin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegationTotalRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegationTotalRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegationTotalRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegationTotalRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegationTotalRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegationTotalRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, DelegationDelegatorReward{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Total = append(m.Total, types.DecCoin{})
+			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3219,7 +4744,7 @@ func (m *QueryValidatorCommissionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorSlashesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegationRewardsByDenomRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3242,15 +4767,15 @@ func (m *QueryValidatorSlashesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorSlashesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegationRewardsByDenomRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorSlashesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegationRewardsByDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3278,51 +4803,13 @@ func (m *QueryValidatorSlashesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartingHeight", wireType)
-			}
-			m.StartingHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StartingHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndingHeight", wireType)
-			}
-			m.EndingHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EndingHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3332,27 +4819,23 @@ func (m *QueryValidatorSlashesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3375,7 +4858,7 @@ func (m *QueryValidatorSlashesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorSlashesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegationRewardsByDenomResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3398,15 +4881,15 @@ func (m *QueryValidatorSlashesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorSlashesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegationRewardsByDenomResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorSlashesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegationRewardsByDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slashes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3433,14 +4916,14 @@ func (m *QueryValidatorSlashesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Slashes = append(m.Slashes, ValidatorSlashEvent{})
-			if err := m.Slashes[len(m.Slashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Rewards = append(m.Rewards, DelegationDenomReward{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3467,10 +4950,7 @@ func (m *QueryValidatorSlashesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3495,7 +4975,7 @@ func (m *QueryValidatorSlashesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegationRewardsRequest) Unmarshal(dAtA []byte) error {
+func (m *DelegationDenomReward) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3518,15 +4998,15 @@ func (m *QueryDelegationRewardsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegationRewardsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegationDenomReward: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegationRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegationDenomReward: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3554,13 +5034,13 @@ func (m *QueryDelegationRewardsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3570,23 +5050,24 @@ func (m *QueryDelegationRewardsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.Reward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3609,7 +5090,7 @@ func (m *QueryDelegationRewardsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegationRewardsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorValidatorsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3632,17 +5113,17 @@ func (m *QueryDelegationRewardsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegationRewardsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorValidatorsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegationRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3652,25 +5133,23 @@ func (m *QueryDelegationRewardsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rewards = append(m.Rewards, types.DecCoin{})
-			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3693,7 +5172,7 @@ func (m *QueryDelegationRewardsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegationTotalRewardsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorValidatorsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3716,15 +5195,15 @@ func (m *QueryDelegationTotalRewardsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegationTotalRewardsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorValidatorsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegationTotalRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3752,7 +5231,7 @@ func (m *QueryDelegationTotalRewardsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Validators = append(m.Validators, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3775,7 +5254,7 @@ func (m *QueryDelegationTotalRewardsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegationTotalRewardsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorWithdrawAddressRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3798,17 +5277,17 @@ func (m *QueryDelegationTotalRewardsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegationTotalRewardsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorWithdrawAddressRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegationTotalRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorWithdrawAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3818,31 +5297,79 @@ func (m *QueryDelegationTotalRewardsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rewards = append(m.Rewards, DelegationDelegatorReward{})
-			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 2:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegatorWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorWithdrawAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorWithdrawAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3852,25 +5379,23 @@ func (m *QueryDelegationTotalRewardsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Total = append(m.Total, types.DecCoin{})
-			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3893,7 +5418,7 @@ func (m *QueryDelegationTotalRewardsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegatorValidatorsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorAutoCompoundsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3916,10 +5441,10 @@ func (m *QueryDelegatorValidatorsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorValidatorsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorAutoCompoundsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorAutoCompoundsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3975,7 +5500,7 @@ func (m *QueryDelegatorValidatorsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegatorValidatorsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorAutoCompoundsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3998,17 +5523,17 @@ func (m *QueryDelegatorValidatorsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorValidatorsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorAutoCompoundsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorAutoCompoundsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompounds", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4018,23 +5543,25 @@ func (m *QueryDelegatorValidatorsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validators = append(m.Validators, string(dAtA[iNdEx:postIndex]))
+			m.AutoCompounds = append(m.AutoCompounds, AutoCompound{})
+			if err := m.AutoCompounds[len(m.AutoCompounds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4057,7 +5584,7 @@ func (m *QueryDelegatorValidatorsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegatorWithdrawAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorWithdrawAddressPolicyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4080,10 +5607,10 @@ func (m *QueryDelegatorWithdrawAddressRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorWithdrawAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorWithdrawAddressPolicyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorWithdrawAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorWithdrawAddressPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4139,7 +5666,7 @@ func (m *QueryDelegatorWithdrawAddressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegatorWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorWithdrawAddressPolicyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4162,17 +5689,17 @@ func (m *QueryDelegatorWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorWithdrawAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorWithdrawAddressPolicyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorWithdrawAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorWithdrawAddressPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Splits", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4182,23 +5709,25 @@ func (m *QueryDelegatorWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+			m.Splits = append(m.Splits, WithdrawAddressSplit{})
+			if err := m.Splits[len(m.Splits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -665,6 +665,269 @@ func (m *MsgDepositValidatorRewardsPoolResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDepositValidatorRewardsPoolResponse proto.InternalMessageInfo
 
+// MsgSetAutoCompound opts a delegation in or out of the automatic
+// re-delegation of its rewards to its validator.
+//
+// Since: cosmos-sdk 0.51
+type MsgSetAutoCompound struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// enabled defines whether the rewards of the delegation are auto-compounded.
+	Enabled bool `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *MsgSetAutoCompound) Reset()         { *m = MsgSetAutoCompound{} }
+func (m *MsgSetAutoCompound) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoCompound) ProtoMessage()    {}
+func (*MsgSetAutoCompound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{14}
+}
+func (m *MsgSetAutoCompound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoCompound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoCompound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAutoCompound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoCompound.Merge(m, src)
+}
+func (m *MsgSetAutoCompound) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoCompound) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoCompound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoCompound proto.InternalMessageInfo
+
+// MsgSetAutoCompoundResponse defines the Msg/SetAutoCompound response type.
+//
+// Since: cosmos-sdk 0.51
+type MsgSetAutoCompoundResponse struct {
+}
+
+func (m *MsgSetAutoCompoundResponse) Reset()         { *m = MsgSetAutoCompoundResponse{} }
+func (m *MsgSetAutoCompoundResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoCompoundResponse) ProtoMessage()    {}
+func (*MsgSetAutoCompoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{15}
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoCompoundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoCompoundResponse.Merge(m, src)
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoCompoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoCompoundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoCompoundResponse proto.InternalMessageInfo
+
+// MsgSetWithdrawAddressPolicy sets the withdraw address policy of a delegator,
+// which splits its withdrawn rewards across several addresses. An empty list of
+// splits removes the policy.
+//
+// Since: cosmos-sdk 0.51
+type MsgSetWithdrawAddressPolicy struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// splits are the shares of the withdrawn rewards, whose weights must sum up
+	// to one.
+	Splits []WithdrawAddressSplit `protobuf:"bytes,2,rep,name=splits,proto3" json:"splits"`
+}
+
+func (m *MsgSetWithdrawAddressPolicy) Reset()         { *m = MsgSetWithdrawAddressPolicy{} }
+func (m *MsgSetWithdrawAddressPolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgSetWithdrawAddressPolicy) ProtoMessage()    {}
+func (*MsgSetWithdrawAddressPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{16}
+}
+func (m *MsgSetWithdrawAddressPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetWithdrawAddressPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetWithdrawAddressPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetWithdrawAddressPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetWithdrawAddressPolicy.Merge(m, src)
+}
+func (m *MsgSetWithdrawAddressPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetWithdrawAddressPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetWithdrawAddressPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetWithdrawAddressPolicy proto.InternalMessageInfo
+
+// MsgSetWithdrawAddressPolicyResponse defines the Msg/SetWithdrawAddressPolicy
+// response type.
+//
+// Since: cosmos-sdk 0.51
+type MsgSetWithdrawAddressPolicyResponse struct {
+}
+
+func (m *MsgSetWithdrawAddressPolicyResponse) Reset()         { *m = MsgSetWithdrawAddressPolicyResponse{} }
+func (m *MsgSetWithdrawAddressPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetWithdrawAddressPolicyResponse) ProtoMessage()    {}
+func (*MsgSetWithdrawAddressPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{17}
+}
+func (m *MsgSetWithdrawAddressPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetWithdrawAddressPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetWithdrawAddressPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetWithdrawAddressPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetWithdrawAddressPolicyResponse.Merge(m, src)
+}
+func (m *MsgSetWithdrawAddressPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetWithdrawAddressPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetWithdrawAddressPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetWithdrawAddressPolicyResponse proto.InternalMessageInfo
+
+// MsgWithdrawTokenizeShareRecordReward withdraws the rewards of the delegation
+// of a tokenize share record, along with the rewards already withdrawn to its
+// module account, to the owner of the record.
+//
+// Since: cosmos-sdk 0.51
+type MsgWithdrawTokenizeShareRecordReward struct {
+	OwnerAddress string `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	RecordId     uint64 `protobuf:"varint,2,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+}
+
+func (m *MsgWithdrawTokenizeShareRecordReward) Reset()         { *m = MsgWithdrawTokenizeShareRecordReward{} }
+func (m *MsgWithdrawTokenizeShareRecordReward) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawTokenizeShareRecordReward) ProtoMessage()    {}
+func (*MsgWithdrawTokenizeShareRecordReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{18}
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawTokenizeShareRecordReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawTokenizeShareRecordReward.Merge(m, src)
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawTokenizeShareRecordReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawTokenizeShareRecordReward proto.InternalMessageInfo
+
+// MsgWithdrawTokenizeShareRecordRewardResponse defines the
+// Msg/WithdrawTokenizeShareRecordReward response type.
+//
+// Since: cosmos-sdk 0.51
+type MsgWithdrawTokenizeShareRecordRewardResponse struct {
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) Reset() {
+	*m = MsgWithdrawTokenizeShareRecordRewardResponse{}
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgWithdrawTokenizeShareRecordRewardResponse) ProtoMessage() {}
+func (*MsgWithdrawTokenizeShareRecordRewardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{19}
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawTokenizeShareRecordRewardResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawTokenizeShareRecordRewardResponse.Merge(m, src)
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawTokenizeShareRecordRewardResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawTokenizeShareRecordRewardResponse proto.InternalMessageInfo
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgSetWithdrawAddress)(nil), "cosmos.distribution.v1beta1.MsgSetWithdrawAddress")
 	proto.RegisterType((*MsgSetWithdrawAddressResponse)(nil), "cosmos.distribution.v1beta1.MsgSetWithdrawAddressResponse")
@@ -680,6 +943,12 @@ func init() {
 	proto.RegisterType((*MsgCommunityPoolSpendResponse)(nil), "cosmos.distribution.v1beta1.MsgCommunityPoolSpendResponse")
 	proto.RegisterType((*MsgDepositValidatorRewardsPool)(nil), "cosmos.distribution.v1beta1.MsgDepositValidatorRewardsPool")
 	proto.RegisterType((*MsgDepositValidatorRewardsPoolResponse)(nil), "cosmos.distribution.v1beta1.MsgDepositValidatorRewardsPoolResponse")
+	proto.RegisterType((*MsgSetAutoCompound)(nil), "cosmos.distribution.v1beta1.MsgSetAutoCompound")
+	proto.RegisterType((*MsgSetAutoCompoundResponse)(nil), "cosmos.distribution.v1beta1.MsgSetAutoCompoundResponse")
+	proto.RegisterType((*MsgSetWithdrawAddressPolicy)(nil), "cosmos.distribution.v1beta1.MsgSetWithdrawAddressPolicy")
+	proto.RegisterType((*MsgSetWithdrawAddressPolicyResponse)(nil), "cosmos.distribution.v1beta1.MsgSetWithdrawAddressPolicyResponse")
+	proto.RegisterType((*MsgWithdrawTokenizeShareRecordReward)(nil), "cosmos.distribution.v1beta1.MsgWithdrawTokenizeShareRecordReward")
+	proto.RegisterType((*MsgWithdrawTokenizeShareRecordRewardResponse)(nil), "cosmos.distribution.v1beta1.MsgWithdrawTokenizeShareRecordRewardResponse")
 }
 
 func init() {
@@ -687,67 +956,82 @@ func init() {
 }
 
 var fileDescriptor_ed4f433d965e58ca = []byte{
-	// 948 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x41, 0x8f, 0xdb, 0x44,
-	0x14, 0xce, 0x24, 0x62, 0x51, 0xa6, 0x95, 0xda, 0x58, 0x8b, 0x36, 0xeb, 0xb6, 0x4e, 0xeb, 0x85,
-	0x25, 0x8a, 0x58, 0x5b, 0x09, 0x08, 0x84, 0x8b, 0x54, 0x48, 0x4a, 0x24, 0x0e, 0x41, 0x55, 0x56,
-	0x80, 0xc4, 0x65, 0xe5, 0xc4, 0xc6, 0x3b, 0xea, 0xda, 0x63, 0x79, 0x26, 0x49, 0x73, 0x43, 0x08,
-	0x41, 0xc5, 0x09, 0x89, 0x1b, 0x97, 0x56, 0x42, 0x48, 0x15, 0xa7, 0x1c, 0x7a, 0xe0, 0x27, 0xf4,
-	0x82, 0x54, 0xf5, 0xc4, 0x09, 0x50, 0x56, 0x28, 0x48, 0x9c, 0xf8, 0x03, 0x08, 0xd9, 0x1e, 0x3b,
-	0x76, 0xec, 0xd8, 0xbb, 0x4b, 0x45, 0xb9, 0xec, 0xae, 0x66, 0xde, 0xfb, 0xe6, 0x7b, 0xdf, 0x7c,
-	0xf3, 0x9e, 0x17, 0xbe, 0x38, 0xc4, 0xc4, 0xc4, 0x44, 0xd6, 0x10, 0xa1, 0x0e, 0x1a, 0x8c, 0x28,
-	0xc2, 0x96, 0x3c, 0x6e, 0x0e, 0x74, 0xaa, 0x36, 0x65, 0x7a, 0x47, 0xb2, 0x1d, 0x4c, 0x31, 0x77,
-	0xc9, 0x8f, 0x92, 0xa2, 0x51, 0x12, 0x8b, 0xe2, 0x37, 0x0d, 0x6c, 0x60, 0x2f, 0x4e, 0x76, 0xff,
-	0xf2, 0x53, 0x78, 0x81, 0x01, 0x0f, 0x54, 0xa2, 0x87, 0x80, 0x43, 0x8c, 0x2c, 0xb6, 0xbf, 0xed,
-	0xef, 0x1f, 0xf8, 0x89, 0x0c, 0xdf, 0xdf, 0xda, 0x62, 0xa9, 0x26, 0x31, 0xe4, 0x71, 0xd3, 0xfd,
-	0xc5, 0x36, 0x2a, 0xaa, 0x89, 0x2c, 0x2c, 0x7b, 0x3f, 0xd9, 0x92, 0x94, 0xc5, 0x3f, 0x46, 0xd7,
-	0x8b, 0x17, 0xff, 0x04, 0xf0, 0x85, 0x1e, 0x31, 0xf6, 0x75, 0xfa, 0x11, 0xa2, 0x87, 0x9a, 0xa3,
-	0x4e, 0xde, 0xd1, 0x34, 0x47, 0x27, 0x84, 0x7b, 0x17, 0x56, 0x34, 0xfd, 0x48, 0x37, 0x54, 0x8a,
-	0x9d, 0x03, 0xd5, 0x5f, 0xac, 0x82, 0xab, 0xa0, 0x5e, 0x6e, 0x57, 0x9f, 0x3c, 0xdc, 0xdb, 0x64,
-	0x14, 0x59, 0xf8, 0x3e, 0x75, 0x90, 0x65, 0xf4, 0x2f, 0x86, 0x29, 0x01, 0x4c, 0x07, 0x5e, 0x9c,
-	0x30, 0xe4, 0x10, 0xa5, 0x98, 0x83, 0x72, 0x61, 0x12, 0xe7, 0xa2, 0x74, 0xef, 0xde, 0xaf, 0x15,
-	0xfe, 0xb8, 0x5f, 0x2b, 0x7c, 0xb6, 0x98, 0x35, 0x92, 0xb4, 0xbe, 0x5a, 0xcc, 0x1a, 0x3b, 0x3e,
-	0xd2, 0x1e, 0xd1, 0x6e, 0xcb, 0x3d, 0x62, 0xf4, 0xb0, 0x86, 0x3e, 0x99, 0xae, 0xd4, 0x24, 0xd6,
-	0xe0, 0x95, 0xd4, 0x62, 0xfb, 0x3a, 0xb1, 0xb1, 0x45, 0x74, 0xf1, 0x6f, 0x00, 0xf9, 0x1e, 0x31,
-	0x82, 0xed, 0x9b, 0xc1, 0x49, 0x7d, 0x7d, 0xa2, 0x3a, 0xda, 0xd3, 0xd2, 0xe4, 0x7d, 0x58, 0x19,
-	0xab, 0x47, 0x48, 0x8b, 0xc1, 0xf8, 0xa2, 0x5c, 0x7b, 0xf2, 0x70, 0xef, 0x0a, 0x83, 0xf9, 0x30,
-	0x88, 0x59, 0xc1, 0x1b, 0xaf, 0xac, 0x2b, 0xef, 0xe5, 0xcb, 0xb3, 0x1b, 0x97, 0x67, 0xa5, 0x40,
-	0x84, 0x2d, 0xbf, 0x42, 0xf1, 0x1e, 0x80, 0xe2, 0x7a, 0x01, 0x02, 0x9d, 0xb8, 0x29, 0xdc, 0x50,
-	0x4d, 0x3c, 0xb2, 0x68, 0x15, 0x5c, 0x2d, 0xd5, 0xcf, 0xb5, 0xb6, 0x99, 0xef, 0x24, 0xd7, 0xde,
-	0xc1, 0x4b, 0x90, 0x3a, 0x18, 0x59, 0xed, 0xee, 0xa3, 0x5f, 0x6a, 0x85, 0x1f, 0x7e, 0xad, 0xd5,
-	0x0d, 0x44, 0x0f, 0x47, 0x03, 0x69, 0x88, 0x4d, 0x66, 0x6f, 0x39, 0xc2, 0x89, 0x4e, 0x6d, 0x9d,
-	0x78, 0x09, 0xe4, 0xdb, 0xc5, 0xac, 0x71, 0xde, 0x3d, 0x76, 0x38, 0x3d, 0x70, 0x1f, 0x08, 0x79,
-	0xb0, 0x98, 0x35, 0x40, 0x9f, 0x1d, 0x28, 0xfe, 0x08, 0xa0, 0x10, 0x61, 0x18, 0x8a, 0xd4, 0xc1,
-	0xa6, 0x89, 0x08, 0x41, 0xd8, 0x4a, 0xd7, 0x17, 0x9c, 0x5d, 0xdf, 0xb8, 0xfd, 0x12, 0xd0, 0x29,
-	0xf6, 0x8b, 0xb0, 0x5b, 0xf2, 0x12, 0xbf, 0x03, 0x70, 0x37, 0x9b, 0xfa, 0xff, 0x41, 0xe0, 0x2f,
-	0x8b, 0x70, 0xb3, 0x47, 0x8c, 0xee, 0xc8, 0xd2, 0x5c, 0x62, 0x23, 0x0b, 0xd1, 0xe9, 0x2d, 0x8c,
-	0x8f, 0x9e, 0x21, 0x27, 0xee, 0x75, 0x58, 0xd6, 0x74, 0x1b, 0x13, 0x44, 0xb1, 0x93, 0xdb, 0x3e,
-	0x96, 0xa1, 0xca, 0x5b, 0xd1, 0x9b, 0x5b, 0xae, 0xbb, 0x37, 0x56, 0x8b, 0xdf, 0x58, 0xa2, 0xdc,
-	0x2a, 0x10, 0x45, 0x78, 0x39, 0x6d, 0x27, 0xb8, 0x24, 0xa5, 0x58, 0x05, 0xe2, 0x4f, 0x00, 0x5e,
-	0xe8, 0x11, 0xe3, 0x03, 0x5b, 0x53, 0xa9, 0x7e, 0x4b, 0x75, 0x54, 0x93, 0xb8, 0x6c, 0xd5, 0x11,
-	0x3d, 0xc4, 0x0e, 0xa2, 0xd3, 0xdc, 0xf6, 0xb0, 0x0c, 0xe5, 0xba, 0x70, 0xc3, 0xf6, 0x10, 0xbc,
-	0x12, 0xcf, 0xb5, 0x76, 0xa4, 0x8c, 0x39, 0x23, 0xf9, 0x87, 0xb5, 0xcb, 0xae, 0xd4, 0x4c, 0x2d,
-	0x3f, 0x5b, 0x51, 0xbc, 0x6a, 0x43, 0x5c, 0xb7, 0xda, 0x97, 0x23, 0xd5, 0xc6, 0x66, 0xc3, 0x0a,
-	0x77, 0x71, 0x1b, 0x6e, 0xad, 0x2c, 0x85, 0xcd, 0xf1, 0xfb, 0xa2, 0x37, 0x2b, 0x62, 0x5a, 0xec,
-	0xdb, 0xba, 0xa5, 0x9d, 0xb9, 0xe0, 0xcb, 0xb0, 0xec, 0xe8, 0x43, 0x64, 0x23, 0xdd, 0xa2, 0xfe,
-	0xb5, 0xf6, 0x97, 0x0b, 0x11, 0xbf, 0x95, 0xfe, 0x63, 0xbf, 0x29, 0xd7, 0x93, 0x0a, 0xee, 0xae,
-	0x2a, 0x28, 0xa7, 0x6a, 0x51, 0x05, 0xe2, 0x8e, 0x37, 0x65, 0x92, 0x5b, 0x31, 0xdf, 0xfc, 0x5e,
-	0xf4, 0xda, 0xd8, 0x4d, 0xdf, 0x92, 0x61, 0x2b, 0xf0, 0xfb, 0x2c, 0xf1, 0xde, 0x5b, 0xcc, 0xf4,
-	0xe0, 0xc4, 0xa6, 0x7f, 0xda, 0xe3, 0xe5, 0x59, 0xde, 0xc3, 0xdb, 0xeb, 0xdf, 0xef, 0x4b, 0x69,
-	0xf7, 0xb1, 0x94, 0x93, 0x09, 0x29, 0xd6, 0xbd, 0x96, 0x9b, 0x21, 0x73, 0x70, 0x2b, 0xad, 0xbf,
-	0x9e, 0x87, 0xa5, 0x1e, 0x31, 0xb8, 0xcf, 0x01, 0xe4, 0x52, 0xbe, 0x87, 0x5a, 0x99, 0x8f, 0x31,
-	0xf5, 0xb3, 0x82, 0x57, 0x4e, 0x9f, 0x13, 0x4e, 0x80, 0x6f, 0x00, 0xdc, 0x5a, 0xf7, 0x1d, 0xf2,
-	0x46, 0x1e, 0xee, 0x9a, 0x44, 0xfe, 0xc6, 0x19, 0x13, 0x43, 0x56, 0xf7, 0x00, 0xbc, 0x94, 0x35,
-	0x7a, 0xaf, 0x9f, 0xf4, 0x80, 0x94, 0x64, 0xbe, 0xf3, 0x2f, 0x92, 0x43, 0x86, 0x5f, 0x00, 0x58,
-	0x49, 0xce, 0xae, 0x66, 0x1e, 0x74, 0x22, 0x85, 0x7f, 0xf3, 0xd4, 0x29, 0x61, 0xa7, 0x2c, 0xdd,
-	0x2d, 0x02, 0xce, 0x81, 0xe7, 0x63, 0x53, 0xe1, 0x95, 0x3c, 0xbc, 0x68, 0x34, 0xff, 0xda, 0x69,
-	0xa2, 0xc3, 0xe2, 0x5d, 0xef, 0xa6, 0xf4, 0xe7, 0x5c, 0xef, 0x26, 0x73, 0xf2, 0xbd, 0xbb, 0xbe,
-	0xc1, 0x79, 0x2e, 0xc9, 0xea, 0x6c, 0xb9, 0x2e, 0xc9, 0x48, 0xce, 0x77, 0xc9, 0x09, 0x1e, 0x3b,
-	0xff, 0xdc, 0xa7, 0x6e, 0x9f, 0x69, 0xdf, 0x78, 0x30, 0x17, 0xc0, 0xa3, 0xb9, 0x00, 0x1e, 0xcf,
-	0x05, 0xf0, 0xdb, 0x5c, 0x00, 0x5f, 0x1f, 0x0b, 0x85, 0xc7, 0xc7, 0x42, 0xe1, 0xe7, 0x63, 0xa1,
-	0xf0, 0xf1, 0x35, 0xff, 0x20, 0xa2, 0xdd, 0x96, 0x10, 0x96, 0xef, 0xc4, 0x07, 0xa7, 0xd7, 0xc4,
-	0x06, 0x1b, 0xde, 0xbf, 0x51, 0xaf, 0xfe, 0x13, 0x00, 0x00, 0xff, 0xff, 0x70, 0xb5, 0x49, 0xbe,
-	0x38, 0x0e, 0x00, 0x00,
+	// 1192 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xd1, 0x6b, 0x23, 0xc5,
+	0x1f, 0xcf, 0xa4, 0xbf, 0x5f, 0xbd, 0x4e, 0x2b, 0xbd, 0x2e, 0x95, 0xa6, 0xdb, 0x36, 0x69, 0xb7,
+	0xd7, 0x5a, 0xca, 0x35, 0x4b, 0xab, 0x78, 0xba, 0xa7, 0xdc, 0xb5, 0xa9, 0xc5, 0x3e, 0x44, 0x4a,
+	0x72, 0x2a, 0xf8, 0x52, 0x36, 0xd9, 0x71, 0x3b, 0x34, 0xd9, 0x09, 0x3b, 0x9b, 0xe6, 0xa2, 0x2f,
+	0x22, 0x87, 0x1e, 0x82, 0x20, 0x08, 0x3e, 0xf8, 0x72, 0x07, 0x22, 0x9c, 0x3e, 0x55, 0xb8, 0x07,
+	0xc1, 0x7f, 0xa0, 0x2f, 0xc2, 0x71, 0x4f, 0x3e, 0xa9, 0xb4, 0x48, 0x05, 0xff, 0x07, 0x91, 0xdd,
+	0xd9, 0x9d, 0xec, 0x6e, 0x36, 0xd9, 0xa4, 0x2d, 0x5e, 0x5f, 0xee, 0xda, 0x99, 0xef, 0xe7, 0x3b,
+	0x9f, 0xef, 0x67, 0x3e, 0xf3, 0x9d, 0xd9, 0xc2, 0x6b, 0x65, 0x42, 0xab, 0x84, 0xca, 0x1a, 0xa6,
+	0x96, 0x89, 0x4b, 0x75, 0x0b, 0x13, 0x43, 0x3e, 0x58, 0x2d, 0x21, 0x4b, 0x5d, 0x95, 0xad, 0xbb,
+	0xd9, 0x9a, 0x49, 0x2c, 0x22, 0x4c, 0xb1, 0xa8, 0xac, 0x3f, 0x2a, 0xeb, 0x46, 0x89, 0xe3, 0x3a,
+	0xd1, 0x89, 0x13, 0x27, 0xdb, 0x3f, 0x31, 0x88, 0x98, 0x76, 0x13, 0x97, 0x54, 0x8a, 0x78, 0xc2,
+	0x32, 0xc1, 0x86, 0x3b, 0x3f, 0xc9, 0xe6, 0x77, 0x19, 0xd0, 0xcd, 0xcf, 0xa6, 0x26, 0x5c, 0x68,
+	0x95, 0xea, 0xf2, 0xc1, 0xaa, 0xfd, 0x9f, 0x3b, 0x31, 0xa6, 0x56, 0xb1, 0x41, 0x64, 0xe7, 0x5f,
+	0x77, 0x28, 0xdb, 0x8d, 0x7f, 0x80, 0xae, 0x13, 0x2f, 0xfd, 0x0d, 0xe0, 0x0b, 0x79, 0xaa, 0x17,
+	0x91, 0xf5, 0x1e, 0xb6, 0xf6, 0x34, 0x53, 0x6d, 0xac, 0x6b, 0x9a, 0x89, 0x28, 0x15, 0xde, 0x84,
+	0x63, 0x1a, 0xaa, 0x20, 0x5d, 0xb5, 0x88, 0xb9, 0xab, 0xb2, 0xc1, 0x14, 0x98, 0x05, 0x4b, 0x43,
+	0x1b, 0xa9, 0xa7, 0x8f, 0x57, 0xc6, 0x5d, 0x8a, 0x6e, 0x78, 0xd1, 0x32, 0xb1, 0xa1, 0x17, 0xae,
+	0x72, 0x88, 0x97, 0x26, 0x07, 0xaf, 0x36, 0xdc, 0xcc, 0x3c, 0x4b, 0x32, 0x26, 0xcb, 0x68, 0x23,
+	0xc8, 0x45, 0xd9, 0xba, 0xff, 0x30, 0x93, 0xf8, 0xeb, 0x61, 0x26, 0xf1, 0xc9, 0xe9, 0xe1, 0x72,
+	0x3b, 0xad, 0xcf, 0x4f, 0x0f, 0x97, 0xe7, 0x59, 0xa6, 0x15, 0xaa, 0xed, 0xcb, 0x79, 0xaa, 0xe7,
+	0x89, 0x86, 0x3f, 0x68, 0x86, 0x6a, 0x92, 0x32, 0x70, 0x26, 0xb2, 0xd8, 0x02, 0xa2, 0x35, 0x62,
+	0x50, 0x24, 0xfd, 0x03, 0xa0, 0x98, 0xa7, 0xba, 0x37, 0xbd, 0xe9, 0xad, 0x54, 0x40, 0x0d, 0xd5,
+	0xd4, 0x2e, 0x4a, 0x93, 0xb7, 0xe1, 0xd8, 0x81, 0x5a, 0xc1, 0x5a, 0x20, 0x0d, 0x13, 0x65, 0xee,
+	0xe9, 0xe3, 0x95, 0x19, 0x37, 0xcd, 0xbb, 0x5e, 0x4c, 0x28, 0xdf, 0x41, 0x68, 0x5c, 0xd9, 0x8e,
+	0x97, 0x67, 0x31, 0x28, 0x4f, 0xa8, 0x40, 0x4c, 0x0c, 0x56, 0xa1, 0xf4, 0x00, 0x40, 0xa9, 0xb3,
+	0x00, 0x9e, 0x4e, 0x42, 0x13, 0x0e, 0xaa, 0x55, 0x52, 0x37, 0xac, 0x14, 0x98, 0x1d, 0x58, 0x1a,
+	0x5e, 0x9b, 0x74, 0x7d, 0x97, 0xb5, 0xed, 0xed, 0x9d, 0x84, 0x6c, 0x8e, 0x60, 0x63, 0x63, 0xeb,
+	0xe8, 0xb7, 0x4c, 0xe2, 0x87, 0xdf, 0x33, 0x4b, 0x3a, 0xb6, 0xf6, 0xea, 0xa5, 0x6c, 0x99, 0x54,
+	0x5d, 0x7b, 0xcb, 0x3e, 0x4e, 0x56, 0xb3, 0x86, 0xa8, 0x03, 0xa0, 0xdf, 0x9c, 0x1e, 0x2e, 0x8f,
+	0xd8, 0xcb, 0x96, 0x9b, 0xbb, 0xf6, 0x01, 0xa1, 0x8f, 0x4e, 0x0f, 0x97, 0x41, 0xc1, 0x5d, 0x50,
+	0xfa, 0x09, 0xc0, 0xb4, 0x8f, 0x21, 0x17, 0x29, 0x47, 0xaa, 0x55, 0x4c, 0x29, 0x26, 0x46, 0xb4,
+	0xbe, 0xe0, 0xec, 0xfa, 0x06, 0xed, 0xd7, 0x96, 0x3a, 0xc2, 0x7e, 0x3e, 0x76, 0x2d, 0x5e, 0xd2,
+	0xb7, 0x00, 0x2e, 0x76, 0xa7, 0x7e, 0x19, 0x04, 0xfe, 0x2c, 0x09, 0xc7, 0xf3, 0x54, 0xdf, 0xaa,
+	0x1b, 0x9a, 0x4d, 0xac, 0x6e, 0x60, 0xab, 0xb9, 0x43, 0x48, 0xe5, 0x19, 0x72, 0x12, 0x5e, 0x81,
+	0x43, 0x1a, 0xaa, 0x11, 0x8a, 0x2d, 0x62, 0xc6, 0xb6, 0x8f, 0x56, 0xa8, 0xf2, 0xba, 0x7f, 0xe7,
+	0x5a, 0xe3, 0xf6, 0x8e, 0x65, 0x82, 0x3b, 0xd6, 0x56, 0x6e, 0x0a, 0x48, 0x12, 0x9c, 0x8e, 0x9a,
+	0xf1, 0x36, 0x49, 0x49, 0xa6, 0x80, 0xf4, 0x0b, 0x80, 0xa3, 0x79, 0xaa, 0xbf, 0x53, 0xd3, 0x54,
+	0x0b, 0xed, 0xa8, 0xa6, 0x5a, 0xa5, 0x36, 0x5b, 0xb5, 0x6e, 0xed, 0x11, 0x13, 0x5b, 0xcd, 0xd8,
+	0xf6, 0xd0, 0x0a, 0x15, 0xb6, 0xe0, 0x60, 0xcd, 0xc9, 0xe0, 0x94, 0x38, 0xbc, 0x36, 0x9f, 0xed,
+	0x72, 0xcf, 0x64, 0xd9, 0x62, 0x1b, 0x43, 0xb6, 0xd4, 0xae, 0x5a, 0x0c, 0xad, 0x28, 0x4e, 0xb5,
+	0x3c, 0xaf, 0x5d, 0xed, 0x8b, 0xbe, 0x6a, 0x03, 0x77, 0x43, 0x88, 0xbb, 0x34, 0x09, 0x27, 0x42,
+	0x43, 0xbc, 0x39, 0x7e, 0x97, 0x74, 0xee, 0x8a, 0x80, 0x16, 0xc5, 0x1a, 0x32, 0xb4, 0x33, 0x17,
+	0x3c, 0x0d, 0x87, 0x4c, 0x54, 0xc6, 0x35, 0x8c, 0x0c, 0x8b, 0x6d, 0x6b, 0xa1, 0x35, 0xe0, 0xf3,
+	0xdb, 0xc0, 0x7f, 0xec, 0x37, 0xe5, 0x66, 0xbb, 0x82, 0x8b, 0x61, 0x05, 0xe5, 0x48, 0x2d, 0x52,
+	0x40, 0x9a, 0x87, 0x33, 0x91, 0x53, 0x01, 0xdf, 0xfc, 0x99, 0x74, 0xda, 0xd8, 0x26, 0xb3, 0x24,
+	0x6f, 0x05, 0xac, 0xcf, 0x52, 0xe7, 0xbc, 0x05, 0x4c, 0x0f, 0x7a, 0x36, 0xfd, 0x45, 0x5f, 0x2f,
+	0xcf, 0x72, 0x1f, 0x6e, 0x77, 0x3e, 0xbf, 0x0b, 0x51, 0xfb, 0xd1, 0x92, 0xd3, 0x15, 0x52, 0x5a,
+	0x82, 0x8b, 0x81, 0xf1, 0x36, 0x99, 0xb9, 0xbd, 0xbf, 0x48, 0x42, 0x81, 0xbd, 0x0e, 0xd6, 0xeb,
+	0x16, 0xc9, 0x91, 0x6a, 0x8d, 0xd4, 0x8d, 0xcb, 0x7a, 0xe7, 0x0b, 0x29, 0xf8, 0x1c, 0x32, 0xd4,
+	0x52, 0x05, 0x69, 0xa9, 0x81, 0x59, 0xb0, 0x74, 0xa5, 0xe0, 0xfd, 0xda, 0xef, 0x63, 0x89, 0x6b,
+	0x17, 0x2a, 0x5c, 0x9a, 0x86, 0x62, 0xfb, 0x28, 0x57, 0xeb, 0x5e, 0x12, 0x4e, 0x45, 0xbe, 0xa5,
+	0x76, 0x48, 0x05, 0x97, 0x9b, 0x17, 0x25, 0xdb, 0x1d, 0x38, 0x48, 0x6b, 0x15, 0x6c, 0xd9, 0x5a,
+	0xd9, 0xde, 0x5b, 0xed, 0xda, 0x12, 0x43, 0x54, 0x8a, 0x36, 0x32, 0xd0, 0x20, 0x59, 0x2e, 0xe5,
+	0xad, 0x78, 0x89, 0x16, 0x3a, 0x48, 0xe4, 0x2d, 0xc1, 0xca, 0x94, 0x16, 0xe0, 0x7c, 0x17, 0x15,
+	0xb8, 0x5a, 0x47, 0x00, 0x5e, 0xf3, 0xdd, 0xfc, 0x77, 0xc8, 0x3e, 0x32, 0xf0, 0x87, 0xa8, 0xb8,
+	0xa7, 0x9a, 0xa8, 0x80, 0xca, 0xc4, 0xd4, 0x98, 0x23, 0x85, 0x37, 0xe0, 0xf3, 0xa4, 0x61, 0xa0,
+	0xde, 0x25, 0x1b, 0x71, 0xc2, 0x3d, 0xb9, 0xa6, 0x9c, 0x86, 0x4a, 0x4c, 0x6d, 0x17, 0x6b, 0x8e,
+	0xbb, 0xfe, 0x57, 0xb8, 0xc2, 0x06, 0xb6, 0x35, 0x65, 0xd3, 0x5f, 0x75, 0x70, 0x99, 0x8e, 0x15,
+	0x73, 0xc6, 0xc5, 0x82, 0xfb, 0x42, 0xfc, 0x1e, 0xc0, 0xeb, 0xbd, 0x94, 0x72, 0x09, 0x9e, 0x32,
+	0x6b, 0x3f, 0x0f, 0xc3, 0x81, 0x3c, 0xd5, 0x85, 0x7b, 0x00, 0x0a, 0x11, 0x9f, 0x38, 0x6b, 0x5d,
+	0xcd, 0x14, 0xb9, 0xaf, 0xa2, 0xd2, 0x3f, 0x86, 0x2b, 0xf1, 0x15, 0x80, 0x13, 0x9d, 0x3e, 0x2d,
+	0x6e, 0xc4, 0xe5, 0xed, 0x00, 0x14, 0x6f, 0x9d, 0x11, 0xc8, 0x59, 0x3d, 0x00, 0x70, 0xaa, 0xdb,
+	0x6b, 0xfa, 0x66, 0xaf, 0x0b, 0x44, 0x80, 0xc5, 0xdc, 0x39, 0xc0, 0x9c, 0xe1, 0xa7, 0x00, 0x8e,
+	0xb5, 0x3f, 0x47, 0x57, 0xe3, 0x52, 0xb7, 0x41, 0xc4, 0xd7, 0xfa, 0x86, 0xf0, 0x13, 0x3c, 0x70,
+	0x3f, 0x09, 0x04, 0x13, 0x8e, 0x04, 0x1e, 0x7a, 0xd7, 0xe3, 0xf2, 0xf9, 0xa3, 0xc5, 0x97, 0xfb,
+	0x89, 0xe6, 0xc5, 0xdb, 0xde, 0x8d, 0x78, 0x72, 0xc5, 0x7a, 0xb7, 0x1d, 0x23, 0x2a, 0xfd, 0x63,
+	0x02, 0x2e, 0xe9, 0xf6, 0x58, 0x89, 0x75, 0x49, 0x17, 0xb0, 0x98, 0x3b, 0x07, 0x98, 0x33, 0xfc,
+	0x08, 0x8e, 0x86, 0xef, 0x6e, 0xb9, 0x87, 0xc3, 0xea, 0x07, 0x88, 0x37, 0xfa, 0x04, 0xf0, 0xc5,
+	0xbf, 0x06, 0x30, 0xd5, 0xf1, 0x2e, 0x7c, 0xb5, 0xff, 0x9e, 0xc1, 0x90, 0xe2, 0xed, 0xb3, 0x22,
+	0x39, 0xb1, 0x1f, 0x01, 0x9c, 0x8b, 0xbf, 0x76, 0xd6, 0x7b, 0x3d, 0xa6, 0x1d, 0x53, 0x88, 0xdb,
+	0xe7, 0x4e, 0xe1, 0x71, 0x16, 0xff, 0xff, 0xb1, 0xdd, 0xc5, 0x37, 0x6e, 0x3d, 0x3a, 0x4e, 0x83,
+	0xa3, 0xe3, 0x34, 0x78, 0x72, 0x9c, 0x06, 0x7f, 0x1c, 0xa7, 0xc1, 0x97, 0x27, 0xe9, 0xc4, 0x93,
+	0x93, 0x74, 0xe2, 0xd7, 0x93, 0x74, 0xe2, 0xfd, 0x39, 0xb6, 0x1c, 0xd5, 0xf6, 0xb3, 0x98, 0xc8,
+	0x77, 0x83, 0x5f, 0x35, 0xce, 0x15, 0x51, 0x1a, 0x74, 0xfe, 0xc6, 0xf5, 0xd2, 0xbf, 0x03, 0x00,
+	0x66, 0xb6, 0x58, 0x2e, 0xd5, 0x13, 0x00, 0x00,
 }
 
 func (this *MsgSetWithdrawAddressResponse) Equal(that interface{}) bool {
@@ -975,6 +1259,77 @@ func (this *MsgDepositValidatorRewardsPoolResponse) Equal(that interface{}) bool
 	}
 	return true
 }
+func (this *MsgSetAutoCompoundResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgSetAutoCompoundResponse)
+	if !ok {
+		that2, ok := that.(MsgSetAutoCompoundResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *MsgSetWithdrawAddressPolicyResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgSetWithdrawAddressPolicyResponse)
+	if !ok {
+		that2, ok := that.(MsgSetWithdrawAddressPolicyResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *MsgWithdrawTokenizeShareRecordRewardResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgWithdrawTokenizeShareRecordRewardResponse)
+	if !ok {
+		that2, ok := that.(MsgWithdrawTokenizeShareRecordRewardResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -1021,6 +1376,21 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.50
 	DepositValidatorRewardsPool(ctx context.Context, in *MsgDepositValidatorRewardsPool, opts ...grpc.CallOption) (*MsgDepositValidatorRewardsPoolResponse, error)
+	// SetAutoCompound defines a method to opt in or out of the automatic
+	// re-delegation of the rewards of a delegation to its validator.
+	//
+	// Since: cosmos-sdk 0.51
+	SetAutoCompound(ctx context.Context, in *MsgSetAutoCompound, opts ...grpc.CallOption) (*MsgSetAutoCompoundResponse, error)
+	// SetWithdrawAddressPolicy defines a method to split the withdrawn rewards of
+	// a delegator across several addresses.
+	//
+	// Since: cosmos-sdk 0.51
+	SetWithdrawAddressPolicy(ctx context.Context, in *MsgSetWithdrawAddressPolicy, opts ...grpc.CallOption) (*MsgSetWithdrawAddressPolicyResponse, error)
+	// WithdrawTokenizeShareRecordReward withdraws the rewards of the delegation
+	// of a tokenize share record to its owner.
+	//
+	// Since: cosmos-sdk 0.51
+	WithdrawTokenizeShareRecordReward(ctx context.Context, in *MsgWithdrawTokenizeShareRecordReward, opts ...grpc.CallOption) (*MsgWithdrawTokenizeShareRecordRewardResponse, error)
 }
 
 type msgClient struct {
@@ -1095,6 +1465,33 @@ func (c *msgClient) DepositValidatorRewardsPool(ctx context.Context, in *MsgDepo
 	return out, nil
 }
 
+func (c *msgClient) SetAutoCompound(ctx context.Context, in *MsgSetAutoCompound, opts ...grpc.CallOption) (*MsgSetAutoCompoundResponse, error) {
+	out := new(MsgSetAutoCompoundResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Msg/SetAutoCompound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetWithdrawAddressPolicy(ctx context.Context, in *MsgSetWithdrawAddressPolicy, opts ...grpc.CallOption) (*MsgSetWithdrawAddressPolicyResponse, error) {
+	out := new(MsgSetWithdrawAddressPolicyResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Msg/SetWithdrawAddressPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawTokenizeShareRecordReward(ctx context.Context, in *MsgWithdrawTokenizeShareRecordReward, opts ...grpc.CallOption) (*MsgWithdrawTokenizeShareRecordRewardResponse, error) {
+	out := new(MsgWithdrawTokenizeShareRecordRewardResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Msg/WithdrawTokenizeShareRecordReward", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SetWithdrawAddress defines a method to change the withdraw address
@@ -1130,6 +1527,21 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.50
 	DepositValidatorRewardsPool(context.Context, *MsgDepositValidatorRewardsPool) (*MsgDepositValidatorRewardsPoolResponse, error)
+	// SetAutoCompound defines a method to opt in or out of the automatic
+	// re-delegation of the rewards of a delegation to its validator.
+	//
+	// Since: cosmos-sdk 0.51
+	SetAutoCompound(context.Context, *MsgSetAutoCompound) (*MsgSetAutoCompoundResponse, error)
+	// SetWithdrawAddressPolicy defines a method to split the withdrawn rewards of
+	// a delegator across several addresses.
+	//
+	// Since: cosmos-sdk 0.51
+	SetWithdrawAddressPolicy(context.Context, *MsgSetWithdrawAddressPolicy) (*MsgSetWithdrawAddressPolicyResponse, error)
+	// WithdrawTokenizeShareRecordReward withdraws the rewards of the delegation
+	// of a tokenize share record to its owner.
+	//
+	// Since: cosmos-sdk 0.51
+	WithdrawTokenizeShareRecordReward(context.Context, *MsgWithdrawTokenizeShareRecordReward) (*MsgWithdrawTokenizeShareRecordRewardResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1157,6 +1569,15 @@ func (*UnimplementedMsgServer) CommunityPoolSpend(ctx context.Context, req *MsgC
 func (*UnimplementedMsgServer) DepositValidatorRewardsPool(ctx context.Context, req *MsgDepositValidatorRewardsPool) (*MsgDepositValidatorRewardsPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DepositValidatorRewardsPool not implemented")
 }
+func (*UnimplementedMsgServer) SetAutoCompound(ctx context.Context, req *MsgSetAutoCompound) (*MsgSetAutoCompoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoCompound not implemented")
+}
+func (*UnimplementedMsgServer) SetWithdrawAddressPolicy(ctx context.Context, req *MsgSetWithdrawAddressPolicy) (*MsgSetWithdrawAddressPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetWithdrawAddressPolicy not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawTokenizeShareRecordReward(ctx context.Context, req *MsgWithdrawTokenizeShareRecordReward) (*MsgWithdrawTokenizeShareRecordRewardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawTokenizeShareRecordReward not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1288,28 +1709,82 @@ func _Msg_DepositValidatorRewardsPool_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cosmos.distribution.v1beta1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "SetWithdrawAddress",
-			Handler:    _Msg_SetWithdrawAddress_Handler,
-		},
-		{
-			MethodName: "WithdrawDelegatorReward",
-			Handler:    _Msg_WithdrawDelegatorReward_Handler,
-		},
-		{
-			MethodName: "WithdrawValidatorCommission",
-			Handler:    _Msg_WithdrawValidatorCommission_Handler,
-		},
-		{
-			MethodName: "FundCommunityPool",
-			Handler:    _Msg_FundCommunityPool_Handler,
-		},
-		{
-			MethodName: "UpdateParams",
+func _Msg_SetAutoCompound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAutoCompound)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAutoCompound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Msg/SetAutoCompound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAutoCompound(ctx, req.(*MsgSetAutoCompound))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetWithdrawAddressPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetWithdrawAddressPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetWithdrawAddressPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Msg/SetWithdrawAddressPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetWithdrawAddressPolicy(ctx, req.(*MsgSetWithdrawAddressPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawTokenizeShareRecordReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawTokenizeShareRecordReward)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawTokenizeShareRecordReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Msg/WithdrawTokenizeShareRecordReward",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawTokenizeShareRecordReward(ctx, req.(*MsgWithdrawTokenizeShareRecordReward))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.distribution.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetWithdrawAddress",
+			Handler:    _Msg_SetWithdrawAddress_Handler,
+		},
+		{
+			MethodName: "WithdrawDelegatorReward",
+			Handler:    _Msg_WithdrawDelegatorReward_Handler,
+		},
+		{
+			MethodName: "WithdrawValidatorCommission",
+			Handler:    _Msg_WithdrawValidatorCommission_Handler,
+		},
+		{
+			MethodName: "FundCommunityPool",
+			Handler:    _Msg_FundCommunityPool_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
 		{
@@ -1320,6 +1795,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DepositValidatorRewardsPool",
 			Handler:    _Msg_DepositValidatorRewardsPool_Handler,
 		},
+		{
+			MethodName: "SetAutoCompound",
+			Handler:    _Msg_SetAutoCompound_Handler,
+		},
+		{
+			MethodName: "SetWithdrawAddressPolicy",
+			Handler:    _Msg_SetWithdrawAddressPolicy_Handler,
+		},
+		{
+			MethodName: "WithdrawTokenizeShareRecordReward",
+			Handler:    _Msg_WithdrawTokenizeShareRecordReward_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/distribution/v1beta1/tx.proto",
@@ -1804,6 +2291,215 @@ func (m *MsgDepositValidatorRewardsPoolResponse) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetAutoCompound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoCompound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoCompound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAutoCompoundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoCompoundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoCompoundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetWithdrawAddressPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetWithdrawAddressPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetWithdrawAddressPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Splits) > 0 {
+		for iNdEx := len(m.Splits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Splits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetWithdrawAddressPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetWithdrawAddressPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetWithdrawAddressPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawTokenizeShareRecordReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawTokenizeShareRecordReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawTokenizeShareRecordReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RecordId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RecordId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -2017,20 +2713,108 @@ func (m *MsgDepositValidatorRewardsPoolResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *MsgSetWithdrawAddress) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
+func (m *MsgSetAutoCompound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetAutoCompoundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetWithdrawAddressPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Splits) > 0 {
+		for _, e := range m.Splits {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetWithdrawAddressPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawTokenizeShareRecordReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RecordId != 0 {
+		n += 1 + sovTx(uint64(m.RecordId))
+	}
+	return n
+}
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSetWithdrawAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
 				return ErrIntOverflowTx
 			}
 			if iNdEx >= l {
@@ -2529,7 +3313,536 @@ func (m *MsgWithdrawValidatorCommissionResponse) Unmarshal(dAtA []byte) error {
 			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundCommunityPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundCommunityPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundCommunityPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundCommunityPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundCommunityPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundCommunityPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCommunityPoolSpend) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCommunityPoolSpend: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCommunityPoolSpend: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCommunityPoolSpendResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCommunityPoolSpendResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCommunityPoolSpendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2551,7 +3864,7 @@ func (m *MsgWithdrawValidatorCommissionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFundCommunityPool) Unmarshal(dAtA []byte) error {
+func (m *MsgDepositValidatorRewardsPool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2574,17 +3887,17 @@ func (m *MsgFundCommunityPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFundCommunityPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDepositValidatorRewardsPool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFundCommunityPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDepositValidatorRewardsPool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2594,29 +3907,27 @@ func (m *MsgFundCommunityPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2644,7 +3955,41 @@ func (m *MsgFundCommunityPool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2667,7 +4012,7 @@ func (m *MsgFundCommunityPool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFundCommunityPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDepositValidatorRewardsPoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2690,10 +4035,10 @@ func (m *MsgFundCommunityPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFundCommunityPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDepositValidatorRewardsPoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFundCommunityPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDepositValidatorRewardsPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2717,7 +4062,7 @@ func (m *MsgFundCommunityPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAutoCompound) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2740,15 +4085,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAutoCompound: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAutoCompound: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2776,13 +4121,13 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2792,25 +4137,44 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2832,7 +4196,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAutoCompoundResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2855,10 +4219,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAutoCompoundResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAutoCompoundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2882,7 +4246,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCommunityPoolSpend) Unmarshal(dAtA []byte) error {
+func (m *MsgSetWithdrawAddressPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2905,15 +4269,15 @@ func (m *MsgCommunityPoolSpend) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCommunityPoolSpend: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetWithdrawAddressPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCommunityPoolSpend: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetWithdrawAddressPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2941,43 +4305,11 @@ func (m *MsgCommunityPoolSpend) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Splits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3004,8 +4336,8 @@ func (m *MsgCommunityPoolSpend) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Splits = append(m.Splits, WithdrawAddressSplit{})
+			if err := m.Splits[len(m.Splits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3030,7 +4362,7 @@ func (m *MsgCommunityPoolSpend) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCommunityPoolSpendResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetWithdrawAddressPolicyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3053,10 +4385,10 @@ func (m *MsgCommunityPoolSpendResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCommunityPoolSpendResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetWithdrawAddressPolicyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCommunityPoolSpendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetWithdrawAddressPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3080,7 +4412,7 @@ func (m *MsgCommunityPoolSpendResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositValidatorRewardsPool) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawTokenizeShareRecordReward) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3103,15 +4435,15 @@ func (m *MsgDepositValidatorRewardsPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositValidatorRewardsPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordReward: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositValidatorRewardsPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordReward: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3139,45 +4471,13 @@ func (m *MsgDepositValidatorRewardsPool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = string(dAtA[iNdEx:postIndex])
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
 			}
-			var msglen int
+			m.RecordId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3187,26 +4487,11 @@ func (m *MsgDepositValidatorRewardsPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.RecordId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3228,7 +4513,7 @@ func (m *MsgDepositValidatorRewardsPool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositValidatorRewardsPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3251,12 +4536,46 @@ func (m *MsgDepositValidatorRewardsPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositValidatorRewardsPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordRewardResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositValidatorRewardsPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxWithdrawAddressSplits is the maximum number of addresses the withdrawn
+// rewards of a delegator can be split across.
+const MaxWithdrawAddressSplits = 10
+
+// ValidateWithdrawAddressSplits validates the splits of a withdraw address
+// policy: there must be between one and MaxWithdrawAddressSplits of them, to
+// distinct addresses, with positive weights summing up to one.
+func ValidateWithdrawAddressSplits(splits []WithdrawAddressSplit) error {
+	if len(splits) == 0 {
+		return errorsmod.Wrap(ErrInvalidWithdrawAddressPolicy, "no splits")
+	}
+
+	if len(splits) > MaxWithdrawAddressSplits {
+		return errorsmod.Wrapf(ErrInvalidWithdrawAddressPolicy, "too many splits: %d > %d", len(splits), MaxWithdrawAddressSplits)
+	}
+
+	total := sdkmath.LegacyZeroDec()
+	seen := make(map[string]bool, len(splits))
+	for _, split := range splits {
+		if strings.TrimSpace(split.Address) == "" {
+			return errorsmod.Wrap(ErrInvalidWithdrawAddressPolicy, "empty address")
+		}
+
+		if seen[split.Address] {
+			return errorsmod.Wrapf(ErrInvalidWithdrawAddressPolicy, "duplicate address %s", split.Address)
+		}
+		seen[split.Address] = true
+
+		if split.Weight.IsNil() || !split.Weight.IsPositive() {
+			return errorsmod.Wrapf(ErrInvalidWithdrawAddressPolicy, "weight of %s must be positive", split.Address)
+		}
+		total = total.Add(split.Weight)
+	}
+
+	if !total.Equal(sdkmath.LegacyOneDec()) {
+		return errorsmod.Wrapf(ErrInvalidWithdrawAddressPolicy, "weights must sum up to one, got %s", total)
+	}
+
+	return nil
+}
+
+// SplitCoins splits the coins according to the weights of the splits. The
+// shares are truncated, and the last split receives the remainder, so that the
+// shares always sum up to the coins.
+func SplitCoins(coins sdk.Coins, splits []WithdrawAddressSplit) []sdk.Coins {
+	shares := make([]sdk.Coins, len(splits))
+	remaining := coins
+	for i, split := range splits {
+		if i == len(splits)-1 {
+			shares[i] = remaining
+			break
+		}
+
+		shares[i], _ = sdk.NewDecCoinsFromCoins(coins...).MulDecTruncate(split.Weight).TruncateDecimal()
+		remaining = remaining.Sub(shares[i]...)
+	}
+
+	return shares
+}
@@ -0,0 +1,68 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/distribution/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestValidateWithdrawAddressSplits(t *testing.T) {
+	split := func(addr string, weight math.LegacyDec) types.WithdrawAddressSplit {
+		return types.WithdrawAddressSplit{Address: addr, Weight: weight}
+	}
+	tenth := math.LegacyNewDecWithPrec(1, 1)
+
+	tooMany := make([]types.WithdrawAddressSplit, types.MaxWithdrawAddressSplits+1)
+	for i := range tooMany {
+		tooMany[i] = split(string(rune('a'+i)), math.LegacyOneDec().QuoInt64(int64(len(tooMany))))
+	}
+
+	tests := []struct {
+		name    string
+		splits  []types.WithdrawAddressSplit
+		wantErr bool
+	}{
+		{"valid", []types.WithdrawAddressSplit{split("a", tenth.MulInt64(8)), split("b", tenth.MulInt64(2))}, false},
+		{"single address", []types.WithdrawAddressSplit{split("a", math.LegacyOneDec())}, false},
+		{"no splits", nil, true},
+		{"too many splits", tooMany, true},
+		{"empty address", []types.WithdrawAddressSplit{split("", math.LegacyOneDec())}, true},
+		{"duplicate address", []types.WithdrawAddressSplit{split("a", tenth.MulInt64(5)), split("a", tenth.MulInt64(5))}, true},
+		{"zero weight", []types.WithdrawAddressSplit{split("a", math.LegacyOneDec()), split("b", math.LegacyZeroDec())}, true},
+		{"nil weight", []types.WithdrawAddressSplit{split("a", math.LegacyDec{})}, true},
+		{"sum below one", []types.WithdrawAddressSplit{split("a", tenth.MulInt64(5)), split("b", tenth.MulInt64(4))}, true},
+		{"sum above one", []types.WithdrawAddressSplit{split("a", tenth.MulInt64(5)), split("b", tenth.MulInt64(6))}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := types.ValidateWithdrawAddressSplits(tt.splits)
+			if tt.wantErr {
+				require.ErrorIs(t, err, types.ErrInvalidWithdrawAddressPolicy)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSplitCoins(t *testing.T) {
+	third := math.LegacyOneDec().QuoInt64(3)
+	splits := []types.WithdrawAddressSplit{
+		{Address: "a", Weight: third},
+		{Address: "b", Weight: third},
+		{Address: "c", Weight: math.LegacyOneDec().Sub(third.MulInt64(2))},
+	}
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("atom", 2))
+
+	shares := types.SplitCoins(coins, splits)
+	require.Equal(t, []sdk.Coins{
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 33)),
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 33)),
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 34), sdk.NewInt64Coin("atom", 2)),
+	}, shares)
+}
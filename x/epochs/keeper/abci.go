@@ -43,6 +43,7 @@ func (k Keeper) BeginBlocker(ctx context.Context) error {
 			} else {
 				err := k.environment.EventService.EventManager(ctx).Emit(&types.EventEpochEnd{
 					EpochNumber: epochInfo.CurrentEpoch,
+					Identifier:  epochInfo.Identifier,
 				})
 				if err != nil {
 					return false, nil
@@ -64,6 +65,7 @@ func (k Keeper) BeginBlocker(ctx context.Context) error {
 			err = k.environment.EventService.EventManager(ctx).Emit(&types.EventEpochStart{
 				EpochNumber:    epochInfo.CurrentEpoch,
 				EpochStartTime: epochInfo.CurrentEpochStartTime.Unix(),
+				Identifier:     epochInfo.Identifier,
 			})
 			if err != nil {
 				return false, err
@@ -10,6 +10,8 @@ import (
 
 	"cosmossdk.io/core/header"
 	"cosmossdk.io/x/epochs/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // This test is responsible for testing how epochs increment based off
@@ -106,6 +108,36 @@ func (suite *KeeperTestSuite) TestEpochInfoBeginBlockChanges() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestEpochEventsIncludeIdentifier() {
+	block1Time := time.Unix(1656907200, 0).UTC()
+	suite.SetupTest()
+	suite.Ctx = suite.Ctx.WithHeaderInfo(header.Info{Height: 1, Time: block1Time})
+	err := suite.EpochsKeeper.AddEpochInfo(suite.Ctx, types.EpochInfo{
+		Identifier: "hello",
+		Duration:   time.Minute,
+		StartTime:  block1Time,
+	})
+	suite.Require().NoError(err)
+	err = suite.EpochsKeeper.BeginBlocker(suite.Ctx)
+	suite.Require().NoError(err)
+
+	suite.Ctx = suite.Ctx.WithHeaderInfo(header.Info{Height: 2, Time: block1Time.Add(time.Minute).Add(time.Nanosecond)}).WithEventManager(sdk.NewEventManager())
+	err = suite.EpochsKeeper.BeginBlocker(suite.Ctx)
+	suite.Require().NoError(err)
+
+	var ended, started bool
+	for _, event := range suite.Ctx.EventManager().Events() {
+		attr, ok := event.GetAttribute("identifier")
+		if !ok || attr.Value != `"hello"` {
+			continue
+		}
+		ended = ended || event.Type == "cosmos.epochs.v1beta1.EventEpochEnd"
+		started = started || event.Type == "cosmos.epochs.v1beta1.EventEpochStart"
+	}
+	suite.Require().True(ended)
+	suite.Require().True(started)
+}
+
 // initializeBlankEpochInfoFields set identifier, duration and epochCountingStarted if blank in epoch
 func initializeBlankEpochInfoFields(epoch types.EpochInfo, identifier string, duration time.Duration) types.EpochInfo {
 	if epoch.Identifier == "" {
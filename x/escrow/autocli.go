@@ -0,0 +1,62 @@
+package escrow
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	escrowv1 "cosmossdk.io/api/cosmos/escrow/v1"
+)
+
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: escrowv1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "Escrow",
+					Use:            "escrow [id]",
+					Short:          "Query a pending escrow by its id",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod: "Escrows",
+					Use:       "escrows",
+					Short:     "Query all the pending escrows",
+				},
+				{
+					RpcMethod:      "EscrowsByDepositor",
+					Use:            "escrows-by-depositor [depositor]",
+					Short:          "Query the pending escrows funded by an address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "depositor"}},
+				},
+				{
+					RpcMethod:      "EscrowsByRecipient",
+					Use:            "escrows-by-recipient [recipient]",
+					Short:          "Query the pending escrows to be released to an address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "recipient"}},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: escrowv1.Msg_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "CreateEscrow",
+					Use:            "create [depositor] [recipient] [amount]",
+					Short:          "Create an escrow, locked until released by an arbiter, a release time or a hash lock",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "depositor"}, {ProtoField: "recipient"}, {ProtoField: "amount", Varargs: true}},
+				},
+				{
+					RpcMethod:      "ReleaseEscrow",
+					Use:            "release [sender] [id]",
+					Short:          "Release the coins of an escrow to its recipient",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "sender"}, {ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "RefundEscrow",
+					Use:            "refund [sender] [id]",
+					Short:          "Refund the coins of an escrow to its depositor",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "sender"}, {ProtoField: "id"}},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,77 @@
+package escrow
+
+import (
+	"fmt"
+	"sort"
+
+	"golang.org/x/exp/maps"
+
+	modulev1 "cosmossdk.io/api/cosmos/escrow/module/v1"
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/depinject"
+	"cosmossdk.io/depinject/appconfig"
+	"cosmossdk.io/x/escrow/keeper"
+	"cosmossdk.io/x/escrow/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+var _ depinject.OnePerModuleType = AppModule{}
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (am AppModule) IsOnePerModuleType() {}
+
+func init() {
+	appconfig.RegisterModule(&modulev1.Module{},
+		appconfig.Invoke(InvokeSetHooks),
+		appconfig.Provide(ProvideModule),
+	)
+}
+
+type ModuleInputs struct {
+	depinject.In
+
+	Config      *modulev1.Module
+	Environment appmodule.Environment
+	Cdc         codec.Codec
+
+	AccountKeeper types.AccountKeeper
+	BankKeeper    types.BankKeeper
+}
+
+type ModuleOutputs struct {
+	depinject.Out
+
+	EscrowKeeper *keeper.Keeper
+	Module       appmodule.AppModule
+}
+
+func ProvideModule(in ModuleInputs) ModuleOutputs {
+	k := keeper.NewKeeper(in.Cdc, in.Environment, in.AccountKeeper, in.BankKeeper)
+	m := NewAppModule(in.Cdc, k)
+
+	return ModuleOutputs{EscrowKeeper: k, Module: m}
+}
+
+func InvokeSetHooks(keeper *keeper.Keeper, escrowHooks map[string]types.EscrowHooksWrapper) error {
+	if keeper == nil || escrowHooks == nil {
+		return nil
+	}
+
+	// Default ordering is lexical by module name.
+	// Explicit ordering can be added to the module config if required.
+	order := maps.Keys(escrowHooks)
+	sort.Strings(order)
+
+	var multiHooks types.MultiEscrowHooks
+	for _, modName := range order {
+		hook, ok := escrowHooks[modName]
+		if !ok {
+			return fmt.Errorf("can't find escrow hooks for module %s", modName)
+		}
+		multiHooks = append(multiHooks, hook)
+	}
+
+	keeper.SetHooks(multiHooks)
+	return nil
+}
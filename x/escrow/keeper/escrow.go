@@ -0,0 +1,199 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/escrow/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CreateEscrow moves the amount of the escrow from the depositor to the escrow
+// module account and records the escrow under a new id, which is returned. The
+// id of the given escrow is ignored. It can be called by other modules to create
+// escrows programmatically.
+func (k *Keeper) CreateEscrow(ctx context.Context, escrow types.Escrow) (uint64, error) {
+	if err := escrow.Validate(); err != nil {
+		return 0, err
+	}
+
+	now := k.environment.HeaderService.GetHeaderInfo(ctx).Time
+	if escrow.IsExpired(now) {
+		return 0, errorsmod.Wrap(types.ErrInvalidEscrow, "expire time must be in the future")
+	}
+
+	depositor, err := k.ak.AddressCodec().StringToBytes(escrow.Depositor)
+	if err != nil {
+		return 0, errorsmod.Wrapf(types.ErrInvalidEscrow, "invalid depositor address: %s", err)
+	}
+	recipient, err := k.ak.AddressCodec().StringToBytes(escrow.Recipient)
+	if err != nil {
+		return 0, errorsmod.Wrapf(types.ErrInvalidEscrow, "invalid recipient address: %s", err)
+	}
+	if escrow.Arbiter != "" {
+		if _, err := k.ak.AddressCodec().StringToBytes(escrow.Arbiter); err != nil {
+			return 0, errorsmod.Wrapf(types.ErrInvalidEscrow, "invalid arbiter address: %s", err)
+		}
+	}
+
+	escrow.Id, err = k.NextEscrowID.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := k.bk.SendCoinsFromAccountToModule(ctx, depositor, types.ModuleName, escrow.Amount); err != nil {
+		return 0, err
+	}
+
+	if err := k.setEscrow(ctx, depositor, recipient, escrow); err != nil {
+		return 0, err
+	}
+
+	if err := k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeCreateEscrow,
+		event.NewAttribute(types.AttributeKeyEscrowID, strconv.FormatUint(escrow.Id, 10)),
+		event.NewAttribute(types.AttributeKeyDepositor, escrow.Depositor),
+		event.NewAttribute(types.AttributeKeyRecipient, escrow.Recipient),
+		event.NewAttribute(sdk.AttributeKeyAmount, escrow.Amount.String()),
+	); err != nil {
+		return 0, err
+	}
+
+	if err := k.Hooks().AfterEscrowCreated(ctx, escrow); err != nil {
+		return 0, err
+	}
+
+	return escrow.Id, nil
+}
+
+// ReleaseEscrow releases the coins of an escrow to its recipient. The depositor
+// and the arbiter can release the escrow at any time, the recipient only by
+// satisfying its locks before it expires, if it has a release time or a hash
+// lock.
+func (k *Keeper) ReleaseEscrow(ctx context.Context, sender string, id uint64, secret []byte) error {
+	escrow, err := k.GetEscrow(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case sender == "":
+		return errorsmod.Wrapf(types.ErrUnauthorized, "empty sender cannot release escrow %d", id)
+	case sender == escrow.Depositor, sender == escrow.Arbiter:
+	case sender == escrow.Recipient:
+		if err := escrow.CanBeClaimed(k.environment.HeaderService.GetHeaderInfo(ctx).Time, secret); err != nil {
+			return err
+		}
+	default:
+		return errorsmod.Wrapf(types.ErrUnauthorized, "%s cannot release escrow %d", sender, id)
+	}
+
+	if err := k.closeEscrow(ctx, escrow, escrow.Recipient); err != nil {
+		return err
+	}
+
+	if err := k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeReleaseEscrow,
+		event.NewAttribute(types.AttributeKeyEscrowID, strconv.FormatUint(escrow.Id, 10)),
+		event.NewAttribute(types.AttributeKeySender, sender),
+		event.NewAttribute(types.AttributeKeyRecipient, escrow.Recipient),
+		event.NewAttribute(sdk.AttributeKeyAmount, escrow.Amount.String()),
+	); err != nil {
+		return err
+	}
+
+	return k.Hooks().AfterEscrowReleased(ctx, escrow)
+}
+
+// RefundEscrow refunds the coins of an escrow to its depositor. The recipient
+// and the arbiter can refund the escrow at any time, the depositor only once
+// it expired.
+func (k *Keeper) RefundEscrow(ctx context.Context, sender string, id uint64) error {
+	escrow, err := k.GetEscrow(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case sender == "":
+		return errorsmod.Wrapf(types.ErrUnauthorized, "empty sender cannot refund escrow %d", id)
+	case sender == escrow.Recipient, sender == escrow.Arbiter:
+	case sender == escrow.Depositor:
+		if !escrow.IsExpired(k.environment.HeaderService.GetHeaderInfo(ctx).Time) {
+			return errorsmod.Wrap(types.ErrEscrowLocked, "escrow cannot be refunded to the depositor before it expires")
+		}
+	default:
+		return errorsmod.Wrapf(types.ErrUnauthorized, "%s cannot refund escrow %d", sender, id)
+	}
+
+	if err := k.closeEscrow(ctx, escrow, escrow.Depositor); err != nil {
+		return err
+	}
+
+	if err := k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeRefundEscrow,
+		event.NewAttribute(types.AttributeKeyEscrowID, strconv.FormatUint(escrow.Id, 10)),
+		event.NewAttribute(types.AttributeKeySender, sender),
+		event.NewAttribute(types.AttributeKeyDepositor, escrow.Depositor),
+		event.NewAttribute(sdk.AttributeKeyAmount, escrow.Amount.String()),
+	); err != nil {
+		return err
+	}
+
+	return k.Hooks().AfterEscrowRefunded(ctx, escrow)
+}
+
+// GetEscrow returns a pending escrow by its id.
+func (k Keeper) GetEscrow(ctx context.Context, id uint64) (types.Escrow, error) {
+	escrow, err := k.Escrows.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.Escrow{}, errorsmod.Wrapf(types.ErrEscrowNotFound, "escrow %d", id)
+	}
+	return escrow, err
+}
+
+// setEscrow records an escrow and indexes it by depositor and recipient.
+func (k Keeper) setEscrow(ctx context.Context, depositor, recipient sdk.AccAddress, escrow types.Escrow) error {
+	if err := k.Escrows.Set(ctx, escrow.Id, escrow); err != nil {
+		return err
+	}
+
+	if err := k.EscrowsByDepositor.Set(ctx, collections.Join(depositor, escrow.Id)); err != nil {
+		return err
+	}
+
+	return k.EscrowsByRecipient.Set(ctx, collections.Join(recipient, escrow.Id))
+}
+
+// closeEscrow sends the coins of an escrow to an address and removes the escrow.
+func (k Keeper) closeEscrow(ctx context.Context, escrow types.Escrow, to string) error {
+	depositor, err := k.ak.AddressCodec().StringToBytes(escrow.Depositor)
+	if err != nil {
+		return err
+	}
+	recipient, err := k.ak.AddressCodec().StringToBytes(escrow.Recipient)
+	if err != nil {
+		return err
+	}
+	toAddr, err := k.ak.AddressCodec().StringToBytes(to)
+	if err != nil {
+		return err
+	}
+
+	if err := k.Escrows.Remove(ctx, escrow.Id); err != nil {
+		return err
+	}
+	if err := k.EscrowsByDepositor.Remove(ctx, collections.Join(sdk.AccAddress(depositor), escrow.Id)); err != nil {
+		return err
+	}
+	if err := k.EscrowsByRecipient.Remove(ctx, collections.Join(sdk.AccAddress(recipient), escrow.Id)); err != nil {
+		return err
+	}
+
+	return k.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, toAddr, escrow.Amount)
+}
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/x/escrow/types"
+)
+
+// InitGenesis initializes the escrow module's state from a given genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) error {
+	if err := k.NextEscrowID.Set(ctx, data.NextEscrowId); err != nil {
+		return err
+	}
+
+	for _, escrow := range data.Escrows {
+		depositor, err := k.ak.AddressCodec().StringToBytes(escrow.Depositor)
+		if err != nil {
+			return err
+		}
+		recipient, err := k.ak.AddressCodec().StringToBytes(escrow.Recipient)
+		if err != nil {
+			return err
+		}
+
+		if err := k.setEscrow(ctx, depositor, recipient, escrow); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	nextEscrowID, err := k.NextEscrowID.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	escrows := []types.Escrow{}
+	err = k.Escrows.Walk(ctx, nil, func(_ uint64, escrow types.Escrow) (bool, error) {
+		escrows = append(escrows, escrow)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewGenesisState(escrows, nextEscrowID), nil
+}
@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/escrow/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+var _ types.QueryServer = queryServer{}
+
+func NewQueryServerImpl(k *Keeper) types.QueryServer {
+	return queryServer{k}
+}
+
+type queryServer struct {
+	k *Keeper
+}
+
+// Escrow returns a pending escrow by its id.
+func (q queryServer) Escrow(ctx context.Context, req *types.QueryEscrowRequest) (*types.QueryEscrowResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	escrow, err := q.k.GetEscrow(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEscrowResponse{Escrow: escrow}, nil
+}
+
+// Escrows returns all the pending escrows.
+func (q queryServer) Escrows(ctx context.Context, req *types.QueryEscrowsRequest) (*types.QueryEscrowsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	escrows, pageRes, err := query.CollectionPaginate(ctx, q.k.Escrows, req.Pagination, func(_ uint64, escrow types.Escrow) (types.Escrow, error) {
+		return escrow, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEscrowsResponse{Escrows: escrows, Pagination: pageRes}, nil
+}
+
+// EscrowsByDepositor returns the pending escrows funded by an address.
+func (q queryServer) EscrowsByDepositor(ctx context.Context, req *types.QueryEscrowsByDepositorRequest) (*types.QueryEscrowsByDepositorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	escrows, pageRes, err := q.paginateIndex(ctx, q.k.EscrowsByDepositor, req.Depositor, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEscrowsByDepositorResponse{Escrows: escrows, Pagination: pageRes}, nil
+}
+
+// EscrowsByRecipient returns the pending escrows to be released to an address.
+func (q queryServer) EscrowsByRecipient(ctx context.Context, req *types.QueryEscrowsByRecipientRequest) (*types.QueryEscrowsByRecipientResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	escrows, pageRes, err := q.paginateIndex(ctx, q.k.EscrowsByRecipient, req.Recipient, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEscrowsByRecipientResponse{Escrows: escrows, Pagination: pageRes}, nil
+}
+
+// paginateIndex returns the escrows referenced by an address in an index.
+func (q queryServer) paginateIndex(ctx context.Context, index collections.KeySet[collections.Pair[sdk.AccAddress, uint64]], address string, pagination *query.PageRequest) ([]types.Escrow, *query.PageResponse, error) {
+	addr, err := q.k.ak.AddressCodec().StringToBytes(address)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+	}
+
+	return query.CollectionPaginate(ctx, index, pagination, func(key collections.Pair[sdk.AccAddress, uint64], _ collections.NoValue) (types.Escrow, error) {
+		return q.k.Escrows.Get(ctx, key.K2())
+	}, query.WithCollectionPaginationPairPrefix[sdk.AccAddress, uint64](addr))
+}
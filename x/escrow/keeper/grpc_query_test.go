@@ -0,0 +1,51 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/x/escrow/types"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func (s *KeeperTestSuite) TestGRPCQueries() {
+	first := s.createEscrow(types.Escrow{Arbiter: s.arbiter})
+	second := s.createEscrow(types.Escrow{Arbiter: s.arbiter})
+
+	escrow, err := s.queryServer.Escrow(s.ctx, &types.QueryEscrowRequest{Id: first})
+	s.Require().NoError(err)
+	s.Require().Equal(first, escrow.Escrow.Id)
+
+	_, err = s.queryServer.Escrow(s.ctx, &types.QueryEscrowRequest{Id: 42})
+	s.Require().ErrorIs(err, types.ErrEscrowNotFound)
+
+	escrows, err := s.queryServer.Escrows(s.ctx, &types.QueryEscrowsRequest{Pagination: &query.PageRequest{Limit: 1}})
+	s.Require().NoError(err)
+	s.Require().Len(escrows.Escrows, 1)
+	s.Require().Equal(first, escrows.Escrows[0].Id)
+	s.Require().NotNil(escrows.Pagination.NextKey)
+
+	byDepositor, err := s.queryServer.EscrowsByDepositor(s.ctx, &types.QueryEscrowsByDepositorRequest{Depositor: s.depositor})
+	s.Require().NoError(err)
+	s.Require().Len(byDepositor.Escrows, 2)
+	s.Require().Equal(second, byDepositor.Escrows[1].Id)
+
+	byRecipient, err := s.queryServer.EscrowsByRecipient(s.ctx, &types.QueryEscrowsByRecipientRequest{Recipient: s.recipient, Pagination: &query.PageRequest{Offset: 1}})
+	s.Require().NoError(err)
+	s.Require().Len(byRecipient.Escrows, 1)
+	s.Require().Equal(second, byRecipient.Escrows[0].Id)
+
+	byRecipient, err = s.queryServer.EscrowsByRecipient(s.ctx, &types.QueryEscrowsByRecipientRequest{Recipient: s.depositor})
+	s.Require().NoError(err)
+	s.Require().Empty(byRecipient.Escrows)
+
+	// released escrows are no longer indexed
+	s.expectPayout("recipient")
+	s.Require().NoError(s.escrowKeeper.ReleaseEscrow(s.ctx, s.arbiter, first, nil))
+
+	byDepositor, err = s.queryServer.EscrowsByDepositor(s.ctx, &types.QueryEscrowsByDepositorRequest{Depositor: s.depositor})
+	s.Require().NoError(err)
+	s.Require().Len(byDepositor.Escrows, 1)
+	s.Require().Equal(second, byDepositor.Escrows[0].Id)
+
+	_, err = s.queryServer.EscrowsByDepositor(s.ctx, &types.QueryEscrowsByDepositorRequest{Depositor: "invalid"})
+	s.Require().Error(err)
+}
@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/log"
+	"cosmossdk.io/x/escrow/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Keeper of the escrow store
+type Keeper struct {
+	cdc         codec.BinaryCodec
+	environment appmodule.Environment
+
+	ak types.AccountKeeper
+	bk types.BankKeeper
+
+	// hooks let other modules react to the lifecycle of the escrows
+	hooks types.EscrowHooks
+
+	Schema collections.Schema
+	// Escrows key: escrow id | value: the pending escrow
+	Escrows collections.Map[uint64, types.Escrow]
+	// EscrowsByDepositor key: depositor address + escrow id
+	EscrowsByDepositor collections.KeySet[collections.Pair[sdk.AccAddress, uint64]]
+	// EscrowsByRecipient key: recipient address + escrow id
+	EscrowsByRecipient collections.KeySet[collections.Pair[sdk.AccAddress, uint64]]
+	// NextEscrowID is the id of the next escrow to be created
+	NextEscrowID collections.Sequence
+}
+
+// NewKeeper creates a new escrow Keeper instance
+func NewKeeper(cdc codec.BinaryCodec, env appmodule.Environment, ak types.AccountKeeper, bk types.BankKeeper) *Keeper {
+	sb := collections.NewSchemaBuilder(env.KVStoreService)
+	k := &Keeper{
+		cdc:                cdc,
+		environment:        env,
+		ak:                 ak,
+		bk:                 bk,
+		Escrows:            collections.NewMap(sb, types.EscrowsPrefix, "escrows", collections.Uint64Key, codec.CollValue[types.Escrow](cdc)),
+		EscrowsByDepositor: collections.NewKeySet(sb, types.EscrowsByDepositorPrefix, "escrows_by_depositor", collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key)),
+		EscrowsByRecipient: collections.NewKeySet(sb, types.EscrowsByRecipientPrefix, "escrows_by_recipient", collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key)),
+		NextEscrowID:       collections.NewSequence(sb, types.NextEscrowIDKey, "next_escrow_id"),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+	return k
+}
+
+// Hooks gets the hooks for escrow
+func (k *Keeper) Hooks() types.EscrowHooks {
+	if k.hooks == nil {
+		// return a no-op implementation if no hooks are set
+		return types.MultiEscrowHooks{}
+	}
+
+	return k.hooks
+}
+
+// SetHooks sets the hooks for escrow
+func (k *Keeper) SetHooks(eh types.EscrowHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set escrow hooks twice")
+	}
+
+	k.hooks = eh
+
+	return k
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx context.Context) log.Logger {
+	return k.environment.Logger.With("module", "x/"+types.ModuleName)
+}
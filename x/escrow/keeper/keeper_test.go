@@ -0,0 +1,238 @@
+package keeper_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/escrow"
+	"cosmossdk.io/x/escrow/keeper"
+	escrowtestutil "cosmossdk.io/x/escrow/testutil"
+	"cosmossdk.io/x/escrow/types"
+
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+var (
+	secret = []byte("secret")
+	now    = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	amount = sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx          sdk.Context
+	addressCodec address.Codec
+	escrowKeeper *keeper.Keeper
+	bankKeeper   *escrowtestutil.MockBankKeeper
+	hooks        *mockHooks
+	msgServer    types.MsgServer
+	queryServer  types.QueryServer
+
+	depositor, recipient, arbiter, other string
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, escrow.AppModule{})
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	env := runtime.NewEnvironment(runtime.NewKVStoreService(key), log.NewNopLogger())
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	s.ctx = testCtx.Ctx.WithHeaderInfo(header.Info{Time: now})
+	s.addressCodec = addresscodec.NewBech32Codec("cosmos")
+
+	ctrl := gomock.NewController(s.T())
+	accountKeeper := escrowtestutil.NewMockAccountKeeper(ctrl)
+	accountKeeper.EXPECT().AddressCodec().Return(s.addressCodec).AnyTimes()
+	s.bankKeeper = escrowtestutil.NewMockBankKeeper(ctrl)
+
+	for name, addr := range map[string]*string{"depositor": &s.depositor, "recipient": &s.recipient, "arbiter": &s.arbiter, "other": &s.other} {
+		var err error
+		*addr, err = s.addressCodec.BytesToString(sdk.AccAddress(name))
+		s.Require().NoError(err)
+	}
+
+	s.hooks = &mockHooks{}
+	s.escrowKeeper = keeper.NewKeeper(encCfg.Codec, env, accountKeeper, s.bankKeeper)
+	s.escrowKeeper.SetHooks(types.NewMultiEscrowHooks(s.hooks))
+	s.Require().NoError(s.escrowKeeper.InitGenesis(s.ctx, types.DefaultGenesisState()))
+	s.msgServer = keeper.NewMsgServerImpl(s.escrowKeeper)
+	s.queryServer = keeper.NewQueryServerImpl(s.escrowKeeper)
+}
+
+// createEscrow creates an escrow of amount from the depositor to the recipient,
+// completed with the given locks.
+func (s *KeeperTestSuite) createEscrow(e types.Escrow) uint64 {
+	e.Depositor, e.Recipient, e.Amount = s.depositor, s.recipient, amount
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), sdk.AccAddress("depositor"), types.ModuleName, amount).Return(nil)
+
+	id, err := s.escrowKeeper.CreateEscrow(s.ctx, e)
+	s.Require().NoError(err)
+	return id
+}
+
+func (s *KeeperTestSuite) expectPayout(to string) {
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, sdk.AccAddress(to), amount).Return(nil)
+}
+
+func (s *KeeperTestSuite) TestCreateEscrow() {
+	id := s.createEscrow(types.Escrow{Arbiter: s.arbiter})
+	s.Require().Equal(uint64(1), id)
+	s.Require().Equal([]uint64{1}, s.hooks.created)
+
+	escrow, err := s.escrowKeeper.GetEscrow(s.ctx, id)
+	s.Require().NoError(err)
+	s.Require().Equal(types.Escrow{Id: 1, Depositor: s.depositor, Recipient: s.recipient, Arbiter: s.arbiter, Amount: amount}, escrow)
+
+	s.Require().Equal(uint64(2), s.createEscrow(types.Escrow{Arbiter: s.arbiter}))
+
+	// an escrow needs a lock
+	_, err = s.escrowKeeper.CreateEscrow(s.ctx, types.Escrow{Depositor: s.depositor, Recipient: s.recipient, Amount: amount})
+	s.Require().ErrorIs(err, types.ErrInvalidEscrow)
+
+	// which cannot already be expired
+	past := now.Add(-time.Hour)
+	_, err = s.escrowKeeper.CreateEscrow(s.ctx, types.Escrow{Depositor: s.depositor, Recipient: s.recipient, Arbiter: s.arbiter, Amount: amount, ExpireTime: &past})
+	s.Require().ErrorIs(err, types.ErrInvalidEscrow)
+
+	_, err = s.escrowKeeper.CreateEscrow(s.ctx, types.Escrow{Depositor: s.depositor, Recipient: s.recipient, Arbiter: "invalid", Amount: amount})
+	s.Require().ErrorIs(err, types.ErrInvalidEscrow)
+}
+
+func (s *KeeperTestSuite) TestReleaseEscrow() {
+	releaseTime, expireTime := now.Add(time.Hour), now.Add(2*time.Hour)
+	hash := sha256.Sum256(secret)
+	id := s.createEscrow(types.Escrow{ReleaseTime: &releaseTime, HashLock: hash[:], ExpireTime: &expireTime})
+
+	// the recipient must wait for the release time
+	err := s.escrowKeeper.ReleaseEscrow(s.ctx, s.recipient, id, secret)
+	s.Require().ErrorIs(err, types.ErrEscrowLocked)
+
+	// and reveal the secret
+	ctx := s.ctx.WithHeaderInfo(header.Info{Time: releaseTime})
+	err = s.escrowKeeper.ReleaseEscrow(ctx, s.recipient, id, []byte("wrong"))
+	s.Require().ErrorIs(err, types.ErrEscrowLocked)
+
+	// before the escrow expires
+	err = s.escrowKeeper.ReleaseEscrow(s.ctx.WithHeaderInfo(header.Info{Time: expireTime}), s.recipient, id, secret)
+	s.Require().ErrorIs(err, types.ErrEscrowLocked)
+
+	err = s.escrowKeeper.ReleaseEscrow(ctx, s.other, id, secret)
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	s.expectPayout("recipient")
+	s.Require().NoError(s.escrowKeeper.ReleaseEscrow(ctx, s.recipient, id, secret))
+	s.Require().Equal([]uint64{id}, s.hooks.released)
+
+	_, err = s.escrowKeeper.GetEscrow(s.ctx, id)
+	s.Require().ErrorIs(err, types.ErrEscrowNotFound)
+
+	// the depositor and the arbiter can release an escrow at any time
+	id = s.createEscrow(types.Escrow{Arbiter: s.arbiter, ReleaseTime: &releaseTime})
+	s.expectPayout("recipient")
+	s.Require().NoError(s.escrowKeeper.ReleaseEscrow(s.ctx, s.arbiter, id, nil))
+
+	id = s.createEscrow(types.Escrow{ReleaseTime: &releaseTime})
+	s.expectPayout("recipient")
+	s.Require().NoError(s.escrowKeeper.ReleaseEscrow(s.ctx, s.depositor, id, nil))
+}
+
+func (s *KeeperTestSuite) TestReleaseArbiterOnlyEscrow() {
+	id := s.createEscrow(types.Escrow{Arbiter: s.arbiter})
+
+	// the recipient of an escrow without a time lock nor a hash lock cannot
+	// release it
+	err := s.escrowKeeper.ReleaseEscrow(s.ctx, s.recipient, id, nil)
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+	err = s.escrowKeeper.ReleaseEscrow(s.ctx.WithHeaderInfo(header.Info{Time: now.Add(time.Hour)}), s.recipient, id, secret)
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	s.expectPayout("recipient")
+	s.Require().NoError(s.escrowKeeper.ReleaseEscrow(s.ctx, s.arbiter, id, nil))
+	s.Require().Equal([]uint64{id}, s.hooks.released)
+}
+
+func (s *KeeperTestSuite) TestRefundEscrow() {
+	expireTime := now.Add(time.Hour)
+	id := s.createEscrow(types.Escrow{Arbiter: s.arbiter, ExpireTime: &expireTime})
+
+	// the depositor must wait for the escrow to expire
+	err := s.escrowKeeper.RefundEscrow(s.ctx, s.depositor, id)
+	s.Require().ErrorIs(err, types.ErrEscrowLocked)
+
+	err = s.escrowKeeper.RefundEscrow(s.ctx, s.other, id)
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	s.expectPayout("depositor")
+	s.Require().NoError(s.escrowKeeper.RefundEscrow(s.ctx.WithHeaderInfo(header.Info{Time: expireTime}), s.depositor, id))
+	s.Require().Equal([]uint64{id}, s.hooks.refunded)
+
+	// the recipient and the arbiter can refund an escrow at any time
+	id = s.createEscrow(types.Escrow{Arbiter: s.arbiter})
+	s.expectPayout("depositor")
+	s.Require().NoError(s.escrowKeeper.RefundEscrow(s.ctx, s.arbiter, id))
+
+	id = s.createEscrow(types.Escrow{Arbiter: s.arbiter})
+	s.expectPayout("depositor")
+	s.Require().NoError(s.escrowKeeper.RefundEscrow(s.ctx, s.recipient, id))
+
+	err = s.escrowKeeper.RefundEscrow(s.ctx, s.recipient, id)
+	s.Require().ErrorIs(err, types.ErrEscrowNotFound)
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	s.createEscrow(types.Escrow{Arbiter: s.arbiter})
+	s.createEscrow(types.Escrow{Arbiter: s.arbiter})
+
+	exported, err := s.escrowKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().NoError(types.ValidateGenesis(*exported))
+	s.Require().Len(exported.Escrows, 2)
+	s.Require().Equal(uint64(3), exported.NextEscrowId)
+
+	s.SetupTest()
+	s.Require().NoError(s.escrowKeeper.InitGenesis(s.ctx, exported))
+
+	imported, err := s.escrowKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(exported, imported)
+
+	s.Require().Equal(uint64(3), s.createEscrow(types.Escrow{Arbiter: s.arbiter}))
+}
+
+// mockHooks records the ids of the escrows passed to the hooks.
+type mockHooks struct {
+	created, released, refunded []uint64
+}
+
+func (h *mockHooks) AfterEscrowCreated(_ context.Context, escrow types.Escrow) error {
+	h.created = append(h.created, escrow.Id)
+	return nil
+}
+
+func (h *mockHooks) AfterEscrowReleased(_ context.Context, escrow types.Escrow) error {
+	h.released = append(h.released, escrow.Id)
+	return nil
+}
+
+func (h *mockHooks) AfterEscrowRefunded(_ context.Context, escrow types.Escrow) error {
+	h.refunded = append(h.refunded, escrow.Id)
+	return nil
+}
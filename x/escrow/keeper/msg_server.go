@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/x/escrow/types"
+)
+
+var _ types.MsgServer = msgServer{}
+
+// msgServer is a wrapper of Keeper.
+type msgServer struct {
+	*Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the x/escrow MsgServer interface.
+func NewMsgServerImpl(k *Keeper) types.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+// CreateEscrow moves coins from the depositor to a new escrow.
+func (ms msgServer) CreateEscrow(ctx context.Context, msg *types.MsgCreateEscrow) (*types.MsgCreateEscrowResponse, error) {
+	id, err := ms.Keeper.CreateEscrow(ctx, types.Escrow{
+		Depositor:   msg.Depositor,
+		Recipient:   msg.Recipient,
+		Arbiter:     msg.Arbiter,
+		Amount:      msg.Amount,
+		ReleaseTime: msg.ReleaseTime,
+		HashLock:    msg.HashLock,
+		ExpireTime:  msg.ExpireTime,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateEscrowResponse{Id: id}, nil
+}
+
+// ReleaseEscrow releases the coins of an escrow to its recipient.
+func (ms msgServer) ReleaseEscrow(ctx context.Context, msg *types.MsgReleaseEscrow) (*types.MsgReleaseEscrowResponse, error) {
+	if err := ms.Keeper.ReleaseEscrow(ctx, msg.Sender, msg.Id, msg.Secret); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgReleaseEscrowResponse{}, nil
+}
+
+// RefundEscrow refunds the coins of an escrow to its depositor.
+func (ms msgServer) RefundEscrow(ctx context.Context, msg *types.MsgRefundEscrow) (*types.MsgRefundEscrowResponse, error) {
+	if err := ms.Keeper.RefundEscrow(ctx, msg.Sender, msg.Id); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRefundEscrowResponse{}, nil
+}
@@ -0,0 +1,35 @@
+package keeper_test
+
+import (
+	"crypto/sha256"
+
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/x/escrow/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestMsgServer() {
+	hash := sha256.Sum256(secret)
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), sdk.AccAddress("depositor"), types.ModuleName, amount).Return(nil).Times(2)
+
+	res, err := s.msgServer.CreateEscrow(s.ctx, &types.MsgCreateEscrow{Depositor: s.depositor, Recipient: s.recipient, Amount: amount, HashLock: hash[:]})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), res.Id)
+
+	s.expectPayout("recipient")
+	_, err = s.msgServer.ReleaseEscrow(s.ctx, &types.MsgReleaseEscrow{Sender: s.recipient, Id: res.Id, Secret: secret})
+	s.Require().NoError(err)
+
+	res, err = s.msgServer.CreateEscrow(s.ctx, &types.MsgCreateEscrow{Depositor: s.depositor, Recipient: s.recipient, Amount: amount, Arbiter: s.arbiter})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), res.Id)
+
+	s.expectPayout("depositor")
+	_, err = s.msgServer.RefundEscrow(s.ctx, &types.MsgRefundEscrow{Sender: s.arbiter, Id: res.Id})
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.CreateEscrow(s.ctx, &types.MsgCreateEscrow{Depositor: s.depositor, Recipient: s.depositor, Amount: amount, Arbiter: s.arbiter})
+	s.Require().ErrorIs(err, types.ErrInvalidEscrow)
+}
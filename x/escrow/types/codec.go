@@ -0,0 +1,29 @@
+package types
+
+import (
+	"cosmossdk.io/core/registry"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers concrete types on the LegacyAmino codec
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	legacy.RegisterAminoMsg(cdc, &MsgCreateEscrow{}, "cosmos-sdk/escrow/MsgCreateEscrow")
+	legacy.RegisterAminoMsg(cdc, &MsgReleaseEscrow{}, "cosmos-sdk/escrow/MsgReleaseEscrow")
+	legacy.RegisterAminoMsg(cdc, &MsgRefundEscrow{}, "cosmos-sdk/escrow/MsgRefundEscrow")
+}
+
+// RegisterInterfaces registers the interfaces types with the interface registry.
+func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
+	registrar.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgCreateEscrow{},
+		&MsgReleaseEscrow{},
+		&MsgRefundEscrow{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
+}
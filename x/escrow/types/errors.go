@@ -0,0 +1,10 @@
+package types
+
+import "cosmossdk.io/errors"
+
+var (
+	ErrInvalidEscrow  = errors.Register(ModuleName, 1, "invalid escrow")
+	ErrEscrowNotFound = errors.Register(ModuleName, 2, "escrow not found")
+	ErrUnauthorized   = errors.Register(ModuleName, 3, "unauthorized")
+	ErrEscrowLocked   = errors.Register(ModuleName, 4, "escrow is locked")
+)
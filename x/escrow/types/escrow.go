@@ -0,0 +1,70 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// Validate performs a stateless validation of the escrow. Address fields are
+// only checked to be set, as they are decoded by the keeper.
+func (e Escrow) Validate() error {
+	if e.Depositor == "" || e.Recipient == "" {
+		return errorsmod.Wrap(ErrInvalidEscrow, "depositor and recipient must be set")
+	}
+
+	if e.Depositor == e.Recipient {
+		return errorsmod.Wrap(ErrInvalidEscrow, "depositor and recipient must differ")
+	}
+
+	if !e.Amount.IsValid() || e.Amount.Empty() {
+		return errorsmod.Wrapf(ErrInvalidEscrow, "invalid amount %s", e.Amount)
+	}
+
+	if e.Arbiter == "" && e.ReleaseTime == nil && len(e.HashLock) == 0 {
+		return errorsmod.Wrap(ErrInvalidEscrow, "at least one of the arbiter, release time or hash lock must be set")
+	}
+
+	if len(e.HashLock) != 0 && len(e.HashLock) != sha256.Size {
+		return errorsmod.Wrapf(ErrInvalidEscrow, "hash lock must be a %d bytes SHA-256 hash", sha256.Size)
+	}
+
+	if e.ReleaseTime != nil && e.ExpireTime != nil && !e.ReleaseTime.Before(*e.ExpireTime) {
+		return errorsmod.Wrap(ErrInvalidEscrow, "release time must be before expire time")
+	}
+
+	return nil
+}
+
+// IsExpired returns true if the escrow expired at the given time.
+func (e Escrow) IsExpired(now time.Time) bool {
+	return e.ExpireTime != nil && !now.Before(*e.ExpireTime)
+}
+
+// CanBeClaimed checks that the recipient can claim the escrow at the given time
+// by revealing secret. An escrow without a release time nor a hash lock can
+// only be released by its depositor or arbiter.
+func (e Escrow) CanBeClaimed(now time.Time, secret []byte) error {
+	if e.ReleaseTime == nil && len(e.HashLock) == 0 {
+		return errorsmod.Wrap(ErrUnauthorized, "escrow without a release time nor a hash lock cannot be claimed by its recipient")
+	}
+
+	if e.IsExpired(now) {
+		return errorsmod.Wrapf(ErrEscrowLocked, "escrow expired at %s", e.ExpireTime)
+	}
+
+	if e.ReleaseTime != nil && now.Before(*e.ReleaseTime) {
+		return errorsmod.Wrapf(ErrEscrowLocked, "escrow cannot be claimed before %s", e.ReleaseTime)
+	}
+
+	if len(e.HashLock) != 0 {
+		hash := sha256.Sum256(secret)
+		if !bytes.Equal(hash[:], e.HashLock) {
+			return errorsmod.Wrap(ErrEscrowLocked, "secret does not match the hash lock")
+		}
+	}
+
+	return nil
+}
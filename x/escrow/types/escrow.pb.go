@@ -0,0 +1,720 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/escrow/v1/escrow.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Escrow defines coins held by the escrow module on behalf of a depositor until
+// they are released to a recipient or refunded to the depositor.
+type Escrow struct {
+	Id        uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Depositor string `protobuf:"bytes,2,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// arbiter, if set, is the address allowed to release the escrow to the
+	// recipient or to refund it to the depositor at any time.
+	Arbiter string                                   `protobuf:"bytes,4,opt,name=arbiter,proto3" json:"arbiter,omitempty"`
+	Amount  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// release_time, if set, is the time before which the recipient cannot claim
+	// the escrow.
+	ReleaseTime *time.Time `protobuf:"bytes,6,opt,name=release_time,json=releaseTime,proto3,stdtime" json:"release_time,omitempty"`
+	// hash_lock, if set, is the SHA-256 hash of the secret the recipient must
+	// reveal to claim the escrow.
+	HashLock []byte `protobuf:"bytes,7,opt,name=hash_lock,json=hashLock,proto3" json:"hash_lock,omitempty"`
+	// expire_time, if set, is the time from which the recipient can no longer
+	// claim the escrow and the depositor can be refunded.
+	ExpireTime *time.Time `protobuf:"bytes,8,opt,name=expire_time,json=expireTime,proto3,stdtime" json:"expire_time,omitempty"`
+}
+
+func (m *Escrow) Reset()         { *m = Escrow{} }
+func (m *Escrow) String() string { return proto.CompactTextString(m) }
+func (*Escrow) ProtoMessage()    {}
+func (*Escrow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b5bc1765516fae6, []int{0}
+}
+func (m *Escrow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Escrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Escrow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Escrow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Escrow.Merge(m, src)
+}
+func (m *Escrow) XXX_Size() int {
+	return m.Size()
+}
+func (m *Escrow) XXX_DiscardUnknown() {
+	xxx_messageInfo_Escrow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Escrow proto.InternalMessageInfo
+
+func (m *Escrow) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Escrow) GetDepositor() string {
+	if m != nil {
+		return m.Depositor
+	}
+	return ""
+}
+
+func (m *Escrow) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *Escrow) GetArbiter() string {
+	if m != nil {
+		return m.Arbiter
+	}
+	return ""
+}
+
+func (m *Escrow) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *Escrow) GetReleaseTime() *time.Time {
+	if m != nil {
+		return m.ReleaseTime
+	}
+	return nil
+}
+
+func (m *Escrow) GetHashLock() []byte {
+	if m != nil {
+		return m.HashLock
+	}
+	return nil
+}
+
+func (m *Escrow) GetExpireTime() *time.Time {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Escrow)(nil), "cosmos.escrow.v1.Escrow")
+}
+
+func init() { proto.RegisterFile("cosmos/escrow/v1/escrow.proto", fileDescriptor_5b5bc1765516fae6) }
+
+var fileDescriptor_5b5bc1765516fae6 = []byte{
+	// 450 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x3f, 0x6f, 0xd3, 0x40,
+	0x14, 0xcf, 0x25, 0x21, 0x6d, 0x2e, 0x11, 0x02, 0xab, 0x83, 0x9b, 0x0a, 0xc7, 0x62, 0xb2, 0x2a,
+	0xf5, 0x4e, 0x09, 0x82, 0xbd, 0xa9, 0x60, 0x62, 0x32, 0x4c, 0x2c, 0xd1, 0xd9, 0x3e, 0x9c, 0x53,
+	0x62, 0x3f, 0xeb, 0xee, 0x12, 0x9a, 0x6f, 0xd1, 0x99, 0x4f, 0x80, 0x98, 0x3a, 0xf0, 0x21, 0x3a,
+	0x56, 0x4c, 0x0c, 0x88, 0xa2, 0x64, 0xe8, 0xd7, 0x40, 0xe7, 0x3b, 0xc3, 0x58, 0x16, 0xfb, 0xfc,
+	0xfb, 0xf3, 0x7e, 0x7e, 0xef, 0x1d, 0x7e, 0x96, 0x82, 0x2a, 0x40, 0x51, 0xae, 0x52, 0x09, 0x9f,
+	0xe8, 0x66, 0xe2, 0x4e, 0xa4, 0x92, 0xa0, 0xc1, 0x7b, 0x62, 0x69, 0xe2, 0xc0, 0xcd, 0x64, 0x74,
+	0x94, 0x43, 0x0e, 0x35, 0x49, 0xcd, 0xc9, 0xea, 0x46, 0xe3, 0x1c, 0x20, 0x5f, 0x71, 0x5a, 0x7f,
+	0x25, 0xeb, 0x8f, 0x54, 0x8b, 0x82, 0x2b, 0xcd, 0x8a, 0xca, 0x09, 0x02, 0x97, 0x93, 0x30, 0xc5,
+	0xe9, 0x66, 0x92, 0x70, 0xcd, 0x26, 0x34, 0x05, 0x51, 0x3a, 0xfe, 0xd8, 0xf2, 0x73, 0x5b, 0xd9,
+	0xa5, 0x5a, 0xea, 0x29, 0x2b, 0x44, 0x09, 0xb4, 0x7e, 0x5a, 0xe8, 0xf9, 0xcf, 0x0e, 0xee, 0xbd,
+	0xae, 0x7f, 0xc9, 0x7b, 0x8c, 0xdb, 0x22, 0xf3, 0x51, 0x88, 0xa2, 0x6e, 0xdc, 0x16, 0x99, 0xf7,
+	0x0a, 0xf7, 0x33, 0x5e, 0x81, 0x12, 0x1a, 0xa4, 0xdf, 0x0e, 0x51, 0xd4, 0x9f, 0xf9, 0xdf, 0xbf,
+	0x9d, 0x1d, 0xb9, 0x92, 0xe7, 0x59, 0x26, 0xb9, 0x52, 0xef, 0xb4, 0x14, 0x65, 0x1e, 0xff, 0x93,
+	0x1a, 0x9f, 0xe4, 0xa9, 0xa8, 0x04, 0x2f, 0xb5, 0xdf, 0x79, 0xc8, 0xf7, 0x57, 0xea, 0x4d, 0xf1,
+	0x01, 0x93, 0x89, 0xd0, 0x5c, 0xfa, 0xdd, 0x07, 0x5c, 0x8d, 0xd0, 0xdb, 0xe2, 0x1e, 0x2b, 0x60,
+	0x5d, 0x6a, 0xff, 0x51, 0xd8, 0x89, 0x06, 0xd3, 0x63, 0xe2, 0xf4, 0x66, 0x3a, 0xc4, 0x4d, 0x87,
+	0x5c, 0x80, 0x28, 0x67, 0x6f, 0x6e, 0x7e, 0x8d, 0x5b, 0x5f, 0xef, 0xc6, 0x51, 0x2e, 0xf4, 0x62,
+	0x9d, 0x90, 0x14, 0x0a, 0x37, 0x1d, 0xf7, 0x3a, 0x53, 0xd9, 0x92, 0xea, 0x6d, 0xc5, 0x55, 0x6d,
+	0x50, 0x9f, 0xef, 0xaf, 0x4f, 0x87, 0x2b, 0x9e, 0xb3, 0x74, 0x3b, 0x37, 0xf3, 0x55, 0x5f, 0xee,
+	0xaf, 0x4f, 0x51, 0xec, 0x02, 0xbd, 0x0b, 0x3c, 0x94, 0x7c, 0xc5, 0x99, 0xe2, 0x73, 0xb3, 0x22,
+	0xbf, 0x17, 0xa2, 0x68, 0x30, 0x1d, 0x11, 0xbb, 0x3f, 0xd2, 0xec, 0x8f, 0xbc, 0x6f, 0xf6, 0x37,
+	0xeb, 0x5e, 0xdd, 0x8d, 0x51, 0x3c, 0x70, 0x2e, 0x83, 0x7b, 0x27, 0xb8, 0xbf, 0x60, 0x6a, 0x31,
+	0x5f, 0x41, 0xba, 0xf4, 0x0f, 0x42, 0x14, 0x0d, 0xe3, 0x43, 0x03, 0xbc, 0x85, 0x74, 0xe9, 0x9d,
+	0xe3, 0x01, 0xbf, 0xac, 0x84, 0x74, 0x01, 0x87, 0xff, 0x19, 0x80, 0xad, 0xc9, 0xc0, 0xb3, 0x97,
+	0x37, 0xbb, 0x00, 0xdd, 0xee, 0x02, 0xf4, 0x7b, 0x17, 0xa0, 0xab, 0x7d, 0xd0, 0xba, 0xdd, 0x07,
+	0xad, 0x1f, 0xfb, 0xa0, 0xf5, 0xe1, 0xc4, 0x36, 0xad, 0xb2, 0x25, 0x11, 0x40, 0x2f, 0x9b, 0x6b,
+	0x5b, 0xf7, 0x9f, 0xf4, 0xea, 0xe2, 0x2f, 0xfe, 0x0c, 0x00, 0xa8, 0x3c, 0x23, 0xc7, 0xd4, 0x02,
+	0x00, 0x00,
+}
+
+func (m *Escrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Escrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Escrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpireTime != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpireTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpireTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintEscrow(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.HashLock) > 0 {
+		i -= len(m.HashLock)
+		copy(dAtA[i:], m.HashLock)
+		i = encodeVarintEscrow(dAtA, i, uint64(len(m.HashLock)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ReleaseTime != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ReleaseTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ReleaseTime):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintEscrow(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEscrow(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Arbiter) > 0 {
+		i -= len(m.Arbiter)
+		copy(dAtA[i:], m.Arbiter)
+		i = encodeVarintEscrow(dAtA, i, uint64(len(m.Arbiter)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintEscrow(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintEscrow(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintEscrow(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEscrow(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEscrow(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Escrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEscrow(uint64(m.Id))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovEscrow(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovEscrow(uint64(l))
+	}
+	l = len(m.Arbiter)
+	if l > 0 {
+		n += 1 + l + sovEscrow(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovEscrow(uint64(l))
+		}
+	}
+	if m.ReleaseTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ReleaseTime)
+		n += 1 + l + sovEscrow(uint64(l))
+	}
+	l = len(m.HashLock)
+	if l > 0 {
+		n += 1 + l + sovEscrow(uint64(l))
+	}
+	if m.ExpireTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpireTime)
+		n += 1 + l + sovEscrow(uint64(l))
+	}
+	return n
+}
+
+func sovEscrow(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEscrow(x uint64) (n int) {
+	return sovEscrow(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Escrow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEscrow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Escrow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Escrow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Arbiter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Arbiter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReleaseTime == nil {
+				m.ReleaseTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ReleaseTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HashLock", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HashLock = append(m.HashLock[:0], dAtA[iNdEx:postIndex]...)
+			if m.HashLock == nil {
+				m.HashLock = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpireTime == nil {
+				m.ExpireTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpireTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEscrow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEscrow(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEscrow
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEscrow
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEscrow
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEscrow
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEscrow        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEscrow          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEscrow = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,59 @@
+package types_test
+
+import (
+	"crypto/sha256"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/escrow/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestEscrowValidate(t *testing.T) {
+	now := time.Now()
+	later := now.Add(time.Hour)
+	hash := sha256.Sum256([]byte("secret"))
+	valid := func() types.Escrow {
+		return types.Escrow{Depositor: "depositor", Recipient: "recipient", Arbiter: "arbiter", Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 1))}
+	}
+
+	require.NoError(t, valid().Validate())
+
+	e := valid()
+	e.Arbiter = ""
+	require.ErrorIs(t, e.Validate(), types.ErrInvalidEscrow)
+	e.HashLock = hash[:]
+	require.NoError(t, e.Validate())
+	e.HashLock = []byte("short")
+	require.ErrorIs(t, e.Validate(), types.ErrInvalidEscrow)
+
+	e = valid()
+	e.Amount = nil
+	require.ErrorIs(t, e.Validate(), types.ErrInvalidEscrow)
+
+	e = valid()
+	e.Recipient = e.Depositor
+	require.ErrorIs(t, e.Validate(), types.ErrInvalidEscrow)
+
+	e = valid()
+	e.ReleaseTime, e.ExpireTime = &later, &now
+	require.ErrorIs(t, e.Validate(), types.ErrInvalidEscrow)
+}
+
+func TestEscrowCanBeClaimed(t *testing.T) {
+	now := time.Now()
+	releaseTime, expireTime := now.Add(time.Hour), now.Add(2*time.Hour)
+	hash := sha256.Sum256([]byte("secret"))
+	e := types.Escrow{ReleaseTime: &releaseTime, ExpireTime: &expireTime, HashLock: hash[:]}
+
+	require.ErrorIs(t, e.CanBeClaimed(now, []byte("secret")), types.ErrEscrowLocked)
+	require.ErrorIs(t, e.CanBeClaimed(releaseTime, []byte("wrong")), types.ErrEscrowLocked)
+	require.NoError(t, e.CanBeClaimed(releaseTime, []byte("secret")))
+	require.ErrorIs(t, e.CanBeClaimed(expireTime, []byte("secret")), types.ErrEscrowLocked)
+
+	require.False(t, e.IsExpired(releaseTime))
+	require.True(t, e.IsExpired(expireTime))
+}
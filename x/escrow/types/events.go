@@ -0,0 +1,13 @@
+package types
+
+// Escrow module event types
+const (
+	EventTypeCreateEscrow  = "create_escrow"
+	EventTypeReleaseEscrow = "release_escrow"
+	EventTypeRefundEscrow  = "refund_escrow"
+
+	AttributeKeyEscrowID  = "escrow_id"
+	AttributeKeyDepositor = "depositor"
+	AttributeKeyRecipient = "recipient"
+	AttributeKeySender    = "sender"
+)
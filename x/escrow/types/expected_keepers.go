@@ -0,0 +1,34 @@
+package types
+
+import (
+	"context"
+
+	"cosmossdk.io/core/address"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AccountKeeper defines the expected account keeper.
+type AccountKeeper interface {
+	AddressCodec() address.Codec
+}
+
+// BankKeeper defines the expected bank keeper, used to move the escrowed coins
+// to and from the escrow module account.
+type BankKeeper interface {
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// EscrowHooks event hooks for escrow objects, letting other modules react to
+// the lifecycle of the escrows.
+type EscrowHooks interface {
+	AfterEscrowCreated(ctx context.Context, escrow Escrow) error  // Must be called after an escrow is created
+	AfterEscrowReleased(ctx context.Context, escrow Escrow) error // Must be called after an escrow is released to its recipient
+	AfterEscrowRefunded(ctx context.Context, escrow Escrow) error // Must be called after an escrow is refunded to its depositor
+}
+
+type EscrowHooksWrapper struct{ EscrowHooks }
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (EscrowHooksWrapper) IsOnePerModuleType() {}
@@ -0,0 +1,38 @@
+package types
+
+import "fmt"
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(escrows []Escrow, nextEscrowID uint64) *GenesisState {
+	return &GenesisState{
+		Escrows:      escrows,
+		NextEscrowId: nextEscrowID,
+	}
+}
+
+// DefaultGenesisState creates a default GenesisState object
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState([]Escrow{}, 1)
+}
+
+// ValidateGenesis validates the provided genesis state to ensure the
+// expected invariants holds.
+func ValidateGenesis(data GenesisState) error {
+	seen := make(map[uint64]bool, len(data.Escrows))
+	for _, escrow := range data.Escrows {
+		if seen[escrow.Id] {
+			return fmt.Errorf("duplicate escrow id %d", escrow.Id)
+		}
+		seen[escrow.Id] = true
+
+		if escrow.Id >= data.NextEscrowId {
+			return fmt.Errorf("escrow id %d must be lower than the next escrow id %d", escrow.Id, data.NextEscrowId)
+		}
+
+		if err := escrow.Validate(); err != nil {
+			return fmt.Errorf("invalid escrow %d: %w", escrow.Id, err)
+		}
+	}
+
+	return nil
+}
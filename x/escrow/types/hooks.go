@@ -0,0 +1,39 @@
+package types
+
+import (
+	"context"
+	"errors"
+)
+
+var _ EscrowHooks = MultiEscrowHooks{}
+
+// combine multiple escrow hooks, all hook functions are run in array sequence
+type MultiEscrowHooks []EscrowHooks
+
+func NewMultiEscrowHooks(hooks ...EscrowHooks) MultiEscrowHooks {
+	return hooks
+}
+
+func (h MultiEscrowHooks) AfterEscrowCreated(ctx context.Context, escrow Escrow) error {
+	var errs error
+	for i := range h {
+		errs = errors.Join(errs, h[i].AfterEscrowCreated(ctx, escrow))
+	}
+	return errs
+}
+
+func (h MultiEscrowHooks) AfterEscrowReleased(ctx context.Context, escrow Escrow) error {
+	var errs error
+	for i := range h {
+		errs = errors.Join(errs, h[i].AfterEscrowReleased(ctx, escrow))
+	}
+	return errs
+}
+
+func (h MultiEscrowHooks) AfterEscrowRefunded(ctx context.Context, escrow Escrow) error {
+	var errs error
+	for i := range h {
+		errs = errors.Join(errs, h[i].AfterEscrowRefunded(ctx, escrow))
+	}
+	return errs
+}
@@ -0,0 +1,22 @@
+package types
+
+import "cosmossdk.io/collections"
+
+var (
+	// EscrowsPrefix is the prefix for the pending escrows.
+	EscrowsPrefix = collections.NewPrefix(0)
+	// EscrowsByDepositorPrefix is the prefix for the index of the escrows by depositor.
+	EscrowsByDepositorPrefix = collections.NewPrefix(1)
+	// EscrowsByRecipientPrefix is the prefix for the index of the escrows by recipient.
+	EscrowsByRecipientPrefix = collections.NewPrefix(2)
+	// NextEscrowIDKey is the key of the sequence of the escrow ids.
+	NextEscrowIDKey = collections.NewPrefix(3)
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "escrow"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+)
@@ -28,6 +28,11 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:     "Query all (paginated) submitted evidence",
 					Example:   fmt.Sprintf("%s query evidence --page=2 --page-limit=50", version.AppName),
 				},
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the current evidence parameters",
+				},
 			},
 		},
 	}
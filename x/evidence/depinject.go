@@ -6,6 +6,7 @@ import (
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/depinject/appconfig"
+	authtypes "cosmossdk.io/x/auth/types"
 	eviclient "cosmossdk.io/x/evidence/client"
 	"cosmossdk.io/x/evidence/keeper"
 	"cosmossdk.io/x/evidence/types"
@@ -27,6 +28,7 @@ func init() {
 type ModuleInputs struct {
 	depinject.In
 
+	Config           *modulev1.Module
 	Environment      appmodule.Environment
 	Cdc              codec.Codec
 	EvidenceHandlers []eviclient.EvidenceHandler `optional:"true"`
@@ -44,7 +46,13 @@ type ModuleOutputs struct {
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
-	k := keeper.NewKeeper(in.Cdc, in.Environment, in.StakingKeeper, in.SlashingKeeper, in.AddressCodec)
+	// default to governance authority if not provided
+	authority := authtypes.NewModuleAddress("gov")
+	if in.Config.Authority != "" {
+		authority = authtypes.NewModuleAddressOrBech32Address(in.Config.Authority)
+	}
+
+	k := keeper.NewKeeper(in.Cdc, in.Environment, in.StakingKeeper, in.SlashingKeeper, in.AddressCodec, authority.String())
 	m := NewAppModule(in.Cdc, *k, in.EvidenceHandlers...)
 
 	return ModuleOutputs{EvidenceKeeper: *k, Module: m}
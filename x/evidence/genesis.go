@@ -19,6 +19,12 @@ func InitGenesis(ctx context.Context, k keeper.Keeper, gs *types.GenesisState) e
 		return fmt.Errorf("failed to validate %s genesis state: %s", types.ModuleName, err)
 	}
 
+	if gs.Params != nil {
+		if err := k.Params.Set(ctx, *gs.Params); err != nil {
+			return err
+		}
+	}
+
 	for _, e := range gs.Evidence {
 		evi, ok := e.GetCachedValue().(exported.Evidence)
 		if !ok {
@@ -49,5 +55,11 @@ func ExportGenesis(ctx context.Context, k keeper.Keeper) (*types.GenesisState, e
 	if err != nil {
 		return nil, err
 	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gs.Params = &params
 	return gs, nil
 }
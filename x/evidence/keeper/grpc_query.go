@@ -74,3 +74,17 @@ func (k Querier) AllEvidence(ctx context.Context, req *types.QueryAllEvidenceReq
 
 	return &types.QueryAllEvidenceResponse{Evidence: evidences, Pagination: pageRes}, nil
 }
+
+// Params implements the Query/Params gRPC method
+func (k Querier) Params(ctx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	params, err := k.k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryParamsResponse{Params: &params}, nil
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
@@ -16,6 +18,7 @@ import (
 	"cosmossdk.io/x/evidence/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Keeper defines the evidence module's keeper. The keeper is responsible for
@@ -29,15 +32,21 @@ type Keeper struct {
 	slashingKeeper types.SlashingKeeper
 	addressCodec   address.Codec
 
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+
 	Schema collections.Schema
 	// Evidences key: evidence hash bytes | value: Evidence
 	Evidences collections.Map[[]byte, exported.Evidence]
+	// Params key: ParamsKey | value: Params
+	Params collections.Item[types.Params]
 }
 
 // NewKeeper creates a new Keeper object.
 func NewKeeper(
 	cdc codec.BinaryCodec, env appmodule.Environment, stakingKeeper types.StakingKeeper,
-	slashingKeeper types.SlashingKeeper, ac address.Codec,
+	slashingKeeper types.SlashingKeeper, ac address.Codec, authority string,
 ) *Keeper {
 	sb := collections.NewSchemaBuilder(env.KVStoreService)
 	k := &Keeper{
@@ -46,7 +55,9 @@ func NewKeeper(
 		stakingKeeper:  stakingKeeper,
 		slashingKeeper: slashingKeeper,
 		addressCodec:   ac,
+		authority:      authority,
 		Evidences:      collections.NewMap(sb, types.KeyPrefixEvidence, "evidences", collections.BytesKey, codec.CollInterfaceValue[exported.Evidence](cdc)),
+		Params:         collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
 	}
 	schema, err := sb.Build()
 	if err != nil {
@@ -56,6 +67,21 @@ func NewKeeper(
 	return k
 }
 
+// GetAuthority returns the x/evidence module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetParams returns the parameters of the x/evidence module, or the default
+// ones if they were never set.
+func (k Keeper) GetParams(ctx context.Context) (types.Params, error) {
+	params, err := k.Params.Get(ctx)
+	if errors.IsOf(err, collections.ErrNotFound) {
+		return types.DefaultParams(), nil
+	}
+	return params, err
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger() log.Logger {
 	return k.environment.Logger.With("module", "x/"+types.ModuleName)
@@ -90,20 +116,29 @@ func (k Keeper) GetEvidenceHandler(evidenceRoute string) (types.Handler, error)
 }
 
 // SubmitEvidence attempts to match evidence against the keepers router and execute
-// the corresponding registered Evidence Handler. An error is returned if no
-// registered Handler exists or if the Handler fails. Otherwise, the evidence is
-// persisted.
+// the corresponding registered Evidence Handler. Evidence is matched by its type
+// URL first, and by its route otherwise. An error is returned if no registered
+// Handler exists, if evidence matched by type URL is stale, or if the Handler
+// fails. Otherwise, the evidence is persisted.
 func (k Keeper) SubmitEvidence(ctx context.Context, evidence exported.Evidence) error {
 	if _, err := k.Evidences.Get(ctx, evidence.Hash()); err == nil {
 		return errors.Wrap(types.ErrEvidenceExists, strings.ToUpper(hex.EncodeToString(evidence.Hash())))
 	}
-	if !k.router.HasRoute(evidence.Route()) {
-		return errors.Wrap(types.ErrNoEvidenceHandlerExists, evidence.Route())
-	}
 
-	handler := k.router.GetRoute(evidence.Route())
-	if err := handler(ctx, evidence); err != nil {
-		return errors.Wrap(types.ErrInvalidEvidence, err.Error())
+	typeURL := sdk.MsgTypeURL(evidence)
+	if k.router.HasTypeRoute(typeURL) {
+		if err := k.handleTypeRoutedEvidence(ctx, typeURL, evidence); err != nil {
+			return err
+		}
+	} else {
+		if !k.router.HasRoute(evidence.Route()) {
+			return errors.Wrap(types.ErrNoEvidenceHandlerExists, evidence.Route())
+		}
+
+		handler := k.router.GetRoute(evidence.Route())
+		if err := handler(ctx, evidence); err != nil {
+			return errors.Wrap(types.ErrInvalidEvidence, err.Error())
+		}
 	}
 
 	if err := k.environment.EventService.EventManager(ctx).EmitKV(
@@ -115,3 +150,36 @@ func (k Keeper) SubmitEvidence(ctx context.Context, evidence exported.Evidence)
 
 	return k.Evidences.Set(ctx, evidence.Hash(), evidence)
 }
+
+// handleTypeRoutedEvidence rejects stale evidence according to the max-age params
+// of its type, and executes the Handler registered for its type URL.
+func (k Keeper) handleTypeRoutedEvidence(ctx context.Context, typeURL string, evidence exported.Evidence) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if typeParams, ok := params.GetEvidenceTypeParams(typeURL); ok {
+		headerInfo := k.environment.HeaderService.GetHeaderInfo(ctx)
+		ageBlocks := headerInfo.Height - evidence.GetHeight()
+		stale := ageBlocks > typeParams.MaxAgeNumBlocks
+		if timed, ok := evidence.(interface{ GetTime() time.Time }); ok {
+			stale = stale && headerInfo.Time.Sub(timed.GetTime()) > typeParams.MaxAgeDuration
+		}
+		if stale {
+			return errors.Wrapf(types.ErrStaleEvidence, "evidence of type %s at height %d is older than its max age", typeURL, evidence.GetHeight())
+		}
+	}
+
+	handler := k.router.GetTypeRoute(typeURL)
+	if err := handler(ctx, evidence); err != nil {
+		return errors.Wrap(types.ErrInvalidEvidence, err.Error())
+	}
+
+	return k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeHandleEvidence,
+		event.NewAttribute(types.AttributeKeyEvidenceHash, strings.ToUpper(hex.EncodeToString(evidence.Hash()))),
+		event.NewAttribute(types.AttributeKeyEvidenceTypeURL, typeURL),
+		event.NewAttribute(types.AttributeKeyEvidenceHeight, strconv.FormatInt(evidence.GetHeight(), 10)),
+	)
+}
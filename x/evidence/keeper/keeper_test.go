@@ -10,9 +10,11 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/header"
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
+	authtypes "cosmossdk.io/x/auth/types"
 	"cosmossdk.io/x/evidence"
 	"cosmossdk.io/x/evidence/exported"
 	"cosmossdk.io/x/evidence/keeper"
@@ -73,6 +75,7 @@ type KeeperTestSuite struct {
 	suite.Suite
 
 	ctx sdk.Context
+	env appmodule.Environment
 
 	evidenceKeeper keeper.Keeper
 	bankKeeper     *evidencetestutil.MockBankKeeper
@@ -82,6 +85,7 @@ type KeeperTestSuite struct {
 	queryClient    types.QueryClient
 	encCfg         moduletestutil.TestEncodingConfig
 	msgServer      types.MsgServer
+	authority      string
 }
 
 func (suite *KeeperTestSuite) SetupTest() {
@@ -91,6 +95,8 @@ func (suite *KeeperTestSuite) SetupTest() {
 	tkey := storetypes.NewTransientStoreKey("evidence_transient_store")
 	testCtx := testutil.DefaultContextWithDB(suite.T(), key, tkey)
 	suite.ctx = testCtx.Ctx
+	suite.env = env
+	suite.authority = authtypes.NewModuleAddress("gov").String()
 
 	ctrl := gomock.NewController(suite.T())
 
@@ -105,6 +111,7 @@ func (suite *KeeperTestSuite) SetupTest() {
 		stakingKeeper,
 		slashingKeeper,
 		address.NewBech32Codec("cosmos"),
+		suite.authority,
 	)
 
 	suite.stakingKeeper = stakingKeeper
@@ -227,3 +234,64 @@ func (suite *KeeperTestSuite) TestGetEvidenceHandler() {
 	suite.Error(err)
 	suite.Nil(handler)
 }
+
+func (suite *KeeperTestSuite) TestSubmitTypeRoutedEvidence() {
+	evidenceKeeper := keeper.NewKeeper(
+		suite.encCfg.Codec,
+		suite.env,
+		suite.stakingKeeper,
+		suite.slashingKeeper,
+		address.NewBech32Codec("cosmos"),
+		suite.authority,
+	)
+
+	var handled []exported.Evidence
+	router := types.NewRouter()
+	router = router.AddRoute(types.RouteEquivocation, func(context.Context, exported.Evidence) error {
+		return fmt.Errorf("routed by route")
+	})
+	router = router.AddTypeRoute(&types.Equivocation{}, func(_ context.Context, e exported.Evidence) error {
+		handled = append(handled, e)
+		return nil
+	})
+	evidenceKeeper.SetRouter(router)
+
+	now := time.Now().UTC()
+	err := evidenceKeeper.Params.Set(suite.ctx, types.Params{EvidenceTypes: []types.EvidenceTypeParams{{
+		TypeUrl:         sdk.MsgTypeURL(&types.Equivocation{}),
+		MaxAgeNumBlocks: 10,
+		MaxAgeDuration:  time.Hour,
+	}}})
+	suite.Require().NoError(err)
+	ctx := suite.ctx.WithHeaderInfo(header.Info{Height: 100, Time: now}).WithEventManager(sdk.NewEventManager())
+
+	newEquivocation := func(height int64, t time.Time) *types.Equivocation {
+		return &types.Equivocation{
+			Height:           height,
+			Power:            100,
+			Time:             t,
+			ConsensusAddress: sdk.ConsAddress(ed25519.GenPrivKey().PubKey().Address().Bytes()).String(),
+		}
+	}
+
+	// older than both max ages
+	stale := newEquivocation(80, now.Add(-2*time.Hour))
+	err = evidenceKeeper.SubmitEvidence(ctx, stale)
+	suite.Require().ErrorIs(err, types.ErrStaleEvidence)
+
+	// older in blocks only
+	recent := newEquivocation(80, now.Add(-time.Minute))
+	suite.Require().NoError(evidenceKeeper.SubmitEvidence(ctx, recent))
+	suite.Require().Equal([]exported.Evidence{recent}, handled)
+
+	var found bool
+	for _, e := range ctx.EventManager().Events() {
+		if e.Type == types.EventTypeHandleEvidence {
+			found = true
+			typeURL, ok := e.GetAttribute(types.AttributeKeyEvidenceTypeURL)
+			suite.Require().True(ok)
+			suite.Require().Equal(sdk.MsgTypeURL(recent), typeURL.Value)
+		}
+	}
+	suite.Require().True(found)
+}
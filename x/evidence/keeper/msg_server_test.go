@@ -78,3 +78,64 @@ func (s *KeeperTestSuite) TestSubmitEvidence() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	params := types.Params{EvidenceTypes: []types.EvidenceTypeParams{{
+		TypeUrl:         "/cosmos.evidence.v1beta1.Equivocation",
+		MaxAgeNumBlocks: 100,
+		MaxAgeDuration:  time.Hour,
+	}}}
+
+	testCases := []struct {
+		name   string
+		req    *types.MsgUpdateParams
+		expErr string
+	}{
+		{
+			name:   "invalid authority",
+			req:    &types.MsgUpdateParams{Authority: "invalid", Params: params},
+			expErr: "invalid authority",
+		},
+		{
+			name: "invalid type url",
+			req: &types.MsgUpdateParams{Authority: s.authority, Params: types.Params{EvidenceTypes: []types.EvidenceTypeParams{{
+				TypeUrl:         "cosmos.evidence.v1beta1.Equivocation",
+				MaxAgeNumBlocks: 100,
+			}}}},
+			expErr: "invalid evidence type URL",
+		},
+		{
+			name: "duplicate type url",
+			req: &types.MsgUpdateParams{Authority: s.authority, Params: types.Params{EvidenceTypes: []types.EvidenceTypeParams{
+				params.EvidenceTypes[0], params.EvidenceTypes[0],
+			}}},
+			expErr: "duplicate params",
+		},
+		{
+			name: "non positive max age num blocks",
+			req: &types.MsgUpdateParams{Authority: s.authority, Params: types.Params{EvidenceTypes: []types.EvidenceTypeParams{{
+				TypeUrl: "/cosmos.evidence.v1beta1.Equivocation",
+			}}}},
+			expErr: "must be positive",
+		},
+		{
+			name: "valid",
+			req:  &types.MsgUpdateParams{Authority: s.authority, Params: params},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.UpdateParams(s.ctx, tc.req)
+			if tc.expErr != "" {
+				s.Require().ErrorContains(err, tc.expErr)
+				return
+			}
+			s.Require().NoError(err)
+
+			res, err := s.queryClient.Params(s.ctx, &types.QueryParamsRequest{})
+			s.Require().NoError(err)
+			s.Require().Equal(params, *res.Params)
+		})
+	}
+}
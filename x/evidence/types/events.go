@@ -3,6 +3,9 @@ package types
 // evidence module events
 const (
 	EventTypeSubmitEvidence = "submit_evidence"
+	EventTypeHandleEvidence = "handle_evidence"
 
-	AttributeKeyEvidenceHash = "evidence_hash"
+	AttributeKeyEvidenceHash    = "evidence_hash"
+	AttributeKeyEvidenceTypeURL = "evidence_type_url"
+	AttributeKeyEvidenceHeight  = "evidence_height"
 )
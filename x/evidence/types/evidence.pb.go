@@ -10,6 +10,7 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -75,8 +76,126 @@ func (m *Equivocation) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Equivocation proto.InternalMessageInfo
 
+// Params defines the parameters of the evidence module.
+//
+// Since: x/evidence v0.2.0
+type Params struct {
+	// evidence_types defines the max-age parameters of the evidence types routed by type URL.
+	EvidenceTypes []EvidenceTypeParams `protobuf:"bytes,1,rep,name=evidence_types,json=evidenceTypes,proto3" json:"evidence_types"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd143e71a177f0dd, []int{1}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetEvidenceTypes() []EvidenceTypeParams {
+	if m != nil {
+		return m.EvidenceTypes
+	}
+	return nil
+}
+
+// EvidenceTypeParams defines the max-age parameters of an evidence type routed by type URL.
+// Evidence is considered stale, and rejected, once it is older than both max_age_num_blocks
+// and max_age_duration. The age in time is only checked for evidence exposing its time.
+//
+// Since: x/evidence v0.2.0
+type EvidenceTypeParams struct {
+	// type_url is the type URL of the evidence type.
+	TypeUrl string `protobuf:"bytes,1,opt,name=type_url,json=typeUrl,proto3" json:"type_url,omitempty"`
+	// max_age_num_blocks is the maximum age of the evidence in blocks.
+	MaxAgeNumBlocks int64 `protobuf:"varint,2,opt,name=max_age_num_blocks,json=maxAgeNumBlocks,proto3" json:"max_age_num_blocks,omitempty"`
+	// max_age_duration is the maximum age of the evidence in time.
+	MaxAgeDuration time.Duration `protobuf:"bytes,3,opt,name=max_age_duration,json=maxAgeDuration,proto3,stdduration" json:"max_age_duration"`
+}
+
+func (m *EvidenceTypeParams) Reset()         { *m = EvidenceTypeParams{} }
+func (m *EvidenceTypeParams) String() string { return proto.CompactTextString(m) }
+func (*EvidenceTypeParams) ProtoMessage()    {}
+func (*EvidenceTypeParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd143e71a177f0dd, []int{2}
+}
+func (m *EvidenceTypeParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EvidenceTypeParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EvidenceTypeParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EvidenceTypeParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvidenceTypeParams.Merge(m, src)
+}
+func (m *EvidenceTypeParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *EvidenceTypeParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvidenceTypeParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvidenceTypeParams proto.InternalMessageInfo
+
+func (m *EvidenceTypeParams) GetTypeUrl() string {
+	if m != nil {
+		return m.TypeUrl
+	}
+	return ""
+}
+
+func (m *EvidenceTypeParams) GetMaxAgeNumBlocks() int64 {
+	if m != nil {
+		return m.MaxAgeNumBlocks
+	}
+	return 0
+}
+
+func (m *EvidenceTypeParams) GetMaxAgeDuration() time.Duration {
+	if m != nil {
+		return m.MaxAgeDuration
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Equivocation)(nil), "cosmos.evidence.v1beta1.Equivocation")
+	proto.RegisterType((*Params)(nil), "cosmos.evidence.v1beta1.Params")
+	proto.RegisterType((*EvidenceTypeParams)(nil), "cosmos.evidence.v1beta1.EvidenceTypeParams")
 }
 
 func init() {
@@ -84,31 +203,100 @@ func init() {
 }
 
 var fileDescriptor_dd143e71a177f0dd = []byte{
-	// 349 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x51, 0x41, 0x4e, 0xc2, 0x40,
-	0x14, 0xed, 0x08, 0x92, 0x58, 0x35, 0x91, 0x86, 0x68, 0x25, 0xb1, 0x25, 0xc6, 0x18, 0x42, 0x42,
-	0x27, 0xe8, 0x4e, 0xe3, 0x42, 0x12, 0x2e, 0x80, 0xae, 0xdc, 0x90, 0xd2, 0x8e, 0x65, 0x82, 0xed,
-	0xaf, 0x9d, 0x01, 0xf5, 0x06, 0xc6, 0x15, 0x47, 0x60, 0xc9, 0x92, 0x85, 0x87, 0x60, 0x49, 0x5c,
-	0xb9, 0x52, 0x53, 0x16, 0x70, 0x0c, 0xc3, 0xcc, 0x80, 0x6e, 0x26, 0xff, 0xbd, 0x79, 0xef, 0xbf,
-	0xf9, 0xf3, 0xf5, 0x53, 0x0f, 0x58, 0x08, 0x0c, 0x93, 0x3e, 0xf5, 0x49, 0xe4, 0x11, 0xdc, 0xaf,
-	0xb5, 0x09, 0x77, 0x6b, 0x6b, 0xc2, 0x89, 0x13, 0xe0, 0x60, 0x1c, 0x48, 0x9d, 0xb3, 0xa6, 0x95,
-	0xae, 0x98, 0x77, 0x43, 0x1a, 0x01, 0x16, 0xa7, 0xd4, 0x16, 0x0b, 0x01, 0x04, 0x20, 0x4a, 0xbc,
-	0xac, 0x14, 0x6b, 0x07, 0x00, 0xc1, 0x03, 0xc1, 0x02, 0xb5, 0x7b, 0xf7, 0x98, 0xd3, 0x90, 0x30,
-	0xee, 0x86, 0xb1, 0x12, 0x1c, 0xca, 0x88, 0x96, 0x74, 0xaa, 0x3c, 0x01, 0x8e, 0x17, 0x48, 0xdf,
-	0x69, 0x3c, 0xf6, 0x68, 0x1f, 0x3c, 0x97, 0x53, 0x88, 0x8c, 0x7d, 0x3d, 0xd7, 0x21, 0x34, 0xe8,
-	0x70, 0x13, 0x95, 0x50, 0x39, 0xd3, 0x54, 0xc8, 0xb8, 0xd2, 0xb3, 0xcb, 0xb6, 0xe6, 0x46, 0x09,
-	0x95, 0xb7, 0xcf, 0x8a, 0x8e, 0xcc, 0x74, 0x56, 0x99, 0xce, 0xed, 0x2a, 0xb3, 0xbe, 0x3b, 0xf9,
-	0xb2, 0xb5, 0xc1, 0xb7, 0x8d, 0x46, 0xf3, 0x71, 0x05, 0x35, 0x85, 0xcd, 0x28, 0xe8, 0x9b, 0x31,
-	0x3c, 0x91, 0xc4, 0xcc, 0x88, 0xae, 0x12, 0x18, 0x0d, 0x3d, 0xef, 0x41, 0xc4, 0x48, 0xc4, 0x7a,
-	0xac, 0xe5, 0xfa, 0x7e, 0x42, 0x18, 0x33, 0xb3, 0x25, 0x54, 0xde, 0xaa, 0x9b, 0x1f, 0xef, 0xd5,
-	0x82, 0x7a, 0xea, 0xb5, 0xbc, 0xb9, 0xe1, 0x09, 0x8d, 0x82, 0xe6, 0xde, 0xda, 0xa2, 0xf8, 0x8b,
-	0x93, 0xd7, 0xa1, 0xad, 0x2d, 0x86, 0xb6, 0xf6, 0x36, 0x1f, 0x57, 0xd4, 0x7f, 0x56, 0x99, 0xdf,
-	0xc5, 0xff, 0x27, 0xab, 0x5f, 0x8e, 0x52, 0x0b, 0x4d, 0x52, 0x0b, 0x4d, 0x53, 0x0b, 0xfd, 0xa4,
-	0x16, 0x1a, 0xcc, 0x2c, 0x6d, 0x3a, 0xb3, 0xb4, 0xcf, 0x99, 0xa5, 0xdd, 0x1d, 0x49, 0x1b, 0xf3,
-	0xbb, 0x0e, 0x05, 0xfc, 0xfc, 0xb7, 0x36, 0xfe, 0x12, 0x13, 0xd6, 0xce, 0x89, 0x41, 0xcf, 0x7f,
-	0x03, 0x00, 0x00, 0xff, 0xff, 0x67, 0x41, 0x63, 0x62, 0xd6, 0x01, 0x00, 0x00,
+	// 504 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xf6, 0x91, 0x12, 0xc8, 0x85, 0x96, 0xf6, 0x14, 0x81, 0x13, 0x81, 0x1d, 0x22, 0x84, 0xa2,
+	0x56, 0xb5, 0xd5, 0xb0, 0x15, 0x31, 0x34, 0x22, 0x2b, 0x42, 0xa6, 0x2c, 0x48, 0xc8, 0xba, 0xd8,
+	0x87, 0x6b, 0x25, 0xe7, 0x33, 0x3e, 0x3b, 0xa4, 0xff, 0x00, 0x75, 0xea, 0xc8, 0xd8, 0xb1, 0x63,
+	0x06, 0x7e, 0x44, 0xc7, 0x8a, 0x89, 0x09, 0x50, 0x32, 0xb4, 0x3f, 0x03, 0xf9, 0xee, 0x6c, 0x2c,
+	0x2a, 0x16, 0xcb, 0xef, 0xbd, 0xef, 0x7d, 0xef, 0x7b, 0xef, 0x3b, 0xf8, 0xcc, 0x63, 0x9c, 0x32,
+	0x6e, 0x93, 0x59, 0xe8, 0x93, 0xc8, 0x23, 0xf6, 0x6c, 0x6f, 0x4c, 0x52, 0xbc, 0x57, 0x26, 0xac,
+	0x38, 0x61, 0x29, 0x43, 0x0f, 0x25, 0xce, 0x2a, 0xd3, 0x0a, 0xd7, 0xd9, 0xc2, 0x34, 0x8c, 0x98,
+	0x2d, 0xbe, 0x12, 0xdb, 0x69, 0x05, 0x2c, 0x60, 0xe2, 0xd7, 0xce, 0xff, 0x54, 0xd6, 0x08, 0x18,
+	0x0b, 0xa6, 0xc4, 0x16, 0xd1, 0x38, 0xfb, 0x68, 0xfb, 0x59, 0x82, 0xd3, 0x90, 0x45, 0xaa, 0x6e,
+	0xfe, 0x5b, 0x4f, 0x43, 0x4a, 0x78, 0x8a, 0x69, 0xac, 0x00, 0x6d, 0x29, 0xc1, 0x95, 0xcc, 0x4a,
+	0x8f, 0x08, 0x7a, 0xd7, 0x00, 0xde, 0x1b, 0x7d, 0xca, 0xc2, 0x19, 0xf3, 0x04, 0x25, 0x7a, 0x00,
+	0xeb, 0x47, 0x24, 0x0c, 0x8e, 0x52, 0x1d, 0x74, 0x41, 0xbf, 0xe6, 0xa8, 0x08, 0xbd, 0x84, 0x6b,
+	0x39, 0xad, 0x7e, 0xab, 0x0b, 0xfa, 0xcd, 0x41, 0xc7, 0x92, 0x33, 0xad, 0x62, 0xa6, 0x75, 0x58,
+	0xcc, 0x1c, 0xae, 0x5f, 0xfc, 0x34, 0xb5, 0xd3, 0x5f, 0x26, 0x38, 0xbf, 0x5a, 0x6c, 0x03, 0x47,
+	0xb4, 0xa1, 0x16, 0xbc, 0x1d, 0xb3, 0xcf, 0x24, 0xd1, 0x6b, 0x82, 0x55, 0x06, 0x68, 0x04, 0xb7,
+	0x3c, 0x16, 0x71, 0x12, 0xf1, 0x8c, 0xbb, 0xd8, 0xf7, 0x13, 0xc2, 0xb9, 0xbe, 0xd6, 0x05, 0xfd,
+	0xc6, 0x50, 0xff, 0xfe, 0x6d, 0xb7, 0xa5, 0xa4, 0x1e, 0xc8, 0xca, 0xdb, 0x34, 0x09, 0xa3, 0xc0,
+	0xd9, 0x2c, 0x5b, 0x54, 0x7e, 0xff, 0xe9, 0x97, 0x33, 0x53, 0xbb, 0x3e, 0x33, 0xb5, 0x93, 0xab,
+	0xc5, 0xb6, 0xba, 0xf7, 0x2e, 0xf7, 0x27, 0x76, 0x75, 0xb3, 0xde, 0x09, 0x80, 0xf5, 0x37, 0x38,
+	0xc1, 0x94, 0xa3, 0x0f, 0x70, 0xa3, 0xb0, 0xc3, 0x4d, 0x8f, 0x63, 0xc2, 0x75, 0xd0, 0xad, 0xf5,
+	0x9b, 0x83, 0x1d, 0xeb, 0x3f, 0x66, 0x59, 0x23, 0x95, 0x38, 0x3c, 0x8e, 0x89, 0x24, 0x19, 0x36,
+	0xf2, 0x3d, 0xe5, 0x8e, 0xeb, 0xa4, 0x52, 0xe6, 0xfb, 0x4f, 0x72, 0x1d, 0x8f, 0x2a, 0x3a, 0xe6,
+	0x7f, 0x5f, 0x89, 0x6c, 0xee, 0x2d, 0x00, 0x44, 0x37, 0x39, 0x51, 0x1b, 0xde, 0xcd, 0xf5, 0xb8,
+	0x59, 0x32, 0x15, 0xf7, 0x6f, 0x38, 0x77, 0xf2, 0xf8, 0x5d, 0x32, 0x45, 0x3b, 0x10, 0x51, 0x3c,
+	0x77, 0x71, 0x40, 0xdc, 0x28, 0xa3, 0xee, 0x78, 0xca, 0xbc, 0x09, 0x17, 0x76, 0xd4, 0x9c, 0xfb,
+	0x14, 0xcf, 0x0f, 0x02, 0xf2, 0x3a, 0xa3, 0x43, 0x91, 0x46, 0x0e, 0xdc, 0x2c, 0xc0, 0xc5, 0x63,
+	0x11, 0x97, 0x6f, 0x0e, 0xda, 0x37, 0x9c, 0x7b, 0xa5, 0x00, 0xd2, 0xb8, 0xaf, 0xa5, 0x71, 0x1b,
+	0x92, 0xb4, 0x2c, 0xbf, 0x38, 0x5f, 0x1a, 0xe0, 0x62, 0x69, 0x80, 0xcb, 0xa5, 0x01, 0x7e, 0x2f,
+	0x0d, 0x70, 0xba, 0x32, 0xb4, 0xcb, 0x95, 0xa1, 0xfd, 0x58, 0x19, 0xda, 0xfb, 0xc7, 0x72, 0x5d,
+	0xee, 0x4f, 0xac, 0x90, 0x55, 0x17, 0x16, 0xf7, 0x1d, 0xd7, 0xc5, 0xb8, 0xe7, 0x7f, 0x06, 0x00,
+	0xf6, 0x3b, 0xb6, 0x6a, 0x36, 0x03, 0x00, 0x00,
 }
 
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.EvidenceTypes) != len(that1.EvidenceTypes) {
+		return false
+	}
+	for i := range this.EvidenceTypes {
+		if !this.EvidenceTypes[i].Equal(&that1.EvidenceTypes[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *EvidenceTypeParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EvidenceTypeParams)
+	if !ok {
+		that2, ok := that.(EvidenceTypeParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TypeUrl != that1.TypeUrl {
+		return false
+	}
+	if this.MaxAgeNumBlocks != that1.MaxAgeNumBlocks {
+		return false
+	}
+	if this.MaxAgeDuration != that1.MaxAgeDuration {
+		return false
+	}
+	return true
+}
 func (m *Equivocation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -157,6 +345,86 @@ func (m *Equivocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EvidenceTypes) > 0 {
+		for iNdEx := len(m.EvidenceTypes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EvidenceTypes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvidence(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EvidenceTypeParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EvidenceTypeParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EvidenceTypeParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxAgeDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxAgeDuration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintEvidence(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	if m.MaxAgeNumBlocks != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.MaxAgeNumBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TypeUrl) > 0 {
+		i -= len(m.TypeUrl)
+		copy(dAtA[i:], m.TypeUrl)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.TypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvidence(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvidence(v)
 	base := offset
@@ -189,6 +457,39 @@ func (m *Equivocation) Size() (n int) {
 	return n
 }
 
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.EvidenceTypes) > 0 {
+		for _, e := range m.EvidenceTypes {
+			l = e.Size()
+			n += 1 + l + sovEvidence(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EvidenceTypeParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	if m.MaxAgeNumBlocks != 0 {
+		n += 1 + sovEvidence(uint64(m.MaxAgeNumBlocks))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxAgeDuration)
+	n += 1 + l + sovEvidence(uint64(l))
+	return n
+}
+
 func sovEvidence(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -348,6 +649,224 @@ func (m *Equivocation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceTypes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvidenceTypes = append(m.EvidenceTypes, EvidenceTypeParams{})
+			if err := m.EvidenceTypes[len(m.EvidenceTypes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EvidenceTypeParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EvidenceTypeParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EvidenceTypeParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAgeNumBlocks", wireType)
+			}
+			m.MaxAgeNumBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAgeNumBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAgeDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxAgeDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvidence(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
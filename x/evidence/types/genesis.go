@@ -33,8 +33,10 @@ func NewGenesisState(e []exported.Evidence) *GenesisState {
 
 // DefaultGenesisState returns the evidence module's default genesis state.
 func DefaultGenesisState() *GenesisState {
+	params := DefaultParams()
 	return &GenesisState{
 		Evidence: []*types.Any{},
+		Params:   &params,
 	}
 }
 
@@ -51,6 +53,10 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	if gs.Params != nil {
+		return gs.Params.Validate()
+	}
+
 	return nil
 }
 
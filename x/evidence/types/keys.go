@@ -13,4 +13,5 @@ const (
 // KVStore key prefixes
 var (
 	KeyPrefixEvidence = collections.NewPrefix(0)
+	ParamsKey         = collections.NewPrefix(1)
 )
@@ -13,6 +13,7 @@ import (
 
 var (
 	_ sdk.Msg                       = &MsgSubmitEvidence{}
+	_ sdk.Msg                       = &MsgUpdateParams{}
 	_ types.UnpackInterfacesMessage = MsgSubmitEvidence{}
 	_ exported.MsgSubmitEvidenceI   = &MsgSubmitEvidence{}
 )
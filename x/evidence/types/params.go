@@ -1,9 +1,53 @@
 package types
 
 import (
+	"fmt"
+	"strings"
 	"time"
 )
 
 // DoubleSignJailEndTime period ends at Max Time supported by Amino
 // (Dec 31, 9999 - 23:59:59 GMT).
 var DoubleSignJailEndTime = time.Unix(253402300799, 0)
+
+// DefaultParams returns the default parameters of the evidence module, which
+// define no max-age for any evidence type.
+func DefaultParams() Params {
+	return Params{
+		EvidenceTypes: []EvidenceTypeParams{},
+	}
+}
+
+// Validate performs basic validation of the evidence module parameters.
+func (p Params) Validate() error {
+	seen := make(map[string]bool, len(p.EvidenceTypes))
+	for _, tp := range p.EvidenceTypes {
+		if !strings.HasPrefix(tp.TypeUrl, "/") || len(tp.TypeUrl) == 1 {
+			return fmt.Errorf("invalid evidence type URL: %q", tp.TypeUrl)
+		}
+		if seen[tp.TypeUrl] {
+			return fmt.Errorf("duplicate params for evidence type %s", tp.TypeUrl)
+		}
+		seen[tp.TypeUrl] = true
+
+		if tp.MaxAgeNumBlocks <= 0 {
+			return fmt.Errorf("max age num blocks of evidence type %s must be positive: %d", tp.TypeUrl, tp.MaxAgeNumBlocks)
+		}
+		if tp.MaxAgeDuration < 0 {
+			return fmt.Errorf("max age duration of evidence type %s must not be negative: %s", tp.TypeUrl, tp.MaxAgeDuration)
+		}
+	}
+
+	return nil
+}
+
+// GetEvidenceTypeParams returns the max-age parameters of the given evidence
+// type URL, and whether any are defined.
+func (p Params) GetEvidenceTypeParams(typeURL string) (EvidenceTypeParams, bool) {
+	for _, tp := range p.EvidenceTypes {
+		if tp.TypeUrl == typeURL {
+			return tp, true
+		}
+	}
+	return EvidenceTypeParams{}, false
+}
@@ -238,11 +238,100 @@ func (m *QueryAllEvidenceResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+//
+// Since: x/evidence v0.2.0
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07043de1a84d215a, []int{4}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC method.
+//
+// Since: x/evidence v0.2.0
+type QueryParamsResponse struct {
+	// params defines the parameters of the evidence module.
+	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07043de1a84d215a, []int{5}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() *Params {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryEvidenceRequest)(nil), "cosmos.evidence.v1beta1.QueryEvidenceRequest")
 	proto.RegisterType((*QueryEvidenceResponse)(nil), "cosmos.evidence.v1beta1.QueryEvidenceResponse")
 	proto.RegisterType((*QueryAllEvidenceRequest)(nil), "cosmos.evidence.v1beta1.QueryAllEvidenceRequest")
 	proto.RegisterType((*QueryAllEvidenceResponse)(nil), "cosmos.evidence.v1beta1.QueryAllEvidenceResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.evidence.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.evidence.v1beta1.QueryParamsResponse")
 }
 
 func init() {
@@ -250,34 +339,38 @@ func init() {
 }
 
 var fileDescriptor_07043de1a84d215a = []byte{
-	// 428 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xcb, 0x6e, 0x1a, 0x31,
-	0x18, 0x85, 0xf1, 0xf4, 0x22, 0x6a, 0xe8, 0xc6, 0xa2, 0x82, 0x8e, 0xda, 0x11, 0x1d, 0xa4, 0x42,
-	0x91, 0x6a, 0x03, 0x5d, 0x74, 0x0d, 0x52, 0x6f, 0xbb, 0x76, 0xba, 0xeb, 0xa6, 0xf2, 0x80, 0x3b,
-	0x8c, 0x4a, 0xed, 0x01, 0x0f, 0xa8, 0xa8, 0xea, 0xa6, 0x4f, 0x50, 0x29, 0xca, 0x2a, 0xca, 0xe3,
-	0x44, 0xca, 0x12, 0x29, 0x9b, 0x2c, 0x23, 0xc8, 0x83, 0x44, 0xd8, 0x1e, 0x20, 0x5c, 0x42, 0xb2,
-	0x83, 0xf9, 0xcf, 0x39, 0xff, 0xa7, 0xf3, 0x1b, 0x96, 0xda, 0x42, 0xfe, 0x12, 0x92, 0xb0, 0x51,
-	0xd8, 0x61, 0xbc, 0xcd, 0xc8, 0xa8, 0xee, 0xb3, 0x98, 0xd6, 0x49, 0x7f, 0xc8, 0x06, 0x63, 0x1c,
-	0x0d, 0x44, 0x2c, 0x50, 0x5e, 0x8b, 0x70, 0x22, 0xc2, 0x46, 0x64, 0x57, 0x8d, 0xdb, 0xa7, 0x92,
-	0x69, 0xc7, 0xc2, 0x1f, 0xd1, 0x20, 0xe4, 0x34, 0x0e, 0x05, 0xd7, 0x21, 0xf6, 0xd3, 0x40, 0x88,
-	0xa0, 0xc7, 0x88, 0xfa, 0xe7, 0x0f, 0x7f, 0x10, 0xca, 0x4d, 0xbe, 0xfd, 0xcc, 0x8c, 0x68, 0x14,
-	0x12, 0xca, 0xb9, 0x88, 0x95, 0x4f, 0xea, 0xa9, 0xfb, 0x15, 0xe6, 0xbe, 0xcc, 0xa3, 0xdf, 0x99,
-	0xed, 0x1e, 0xeb, 0x0f, 0x99, 0x8c, 0x51, 0x19, 0x3e, 0x4e, 0x80, 0xbe, 0x77, 0xa9, 0xec, 0x16,
-	0x40, 0x11, 0x54, 0xb2, 0x2d, 0xab, 0x00, 0xbc, 0x6c, 0x32, 0xf8, 0x48, 0x65, 0x17, 0x21, 0x78,
-	0x5f, 0xcd, 0xad, 0x22, 0xa8, 0x3c, 0xf2, 0xd4, 0x6f, 0xf7, 0x13, 0x7c, 0xb2, 0x16, 0x2a, 0x23,
-	0xc1, 0x25, 0x43, 0x35, 0x98, 0x4e, 0xcc, 0x2a, 0x30, 0xd3, 0xc8, 0x61, 0x8d, 0x87, 0x13, 0x72,
-	0xdc, 0xe4, 0x63, 0x6f, 0xa1, 0x72, 0x29, 0xcc, 0xab, 0xa8, 0x66, 0xaf, 0xb7, 0x8e, 0xf8, 0x1e,
-	0xc2, 0x65, 0x0f, 0x26, 0xee, 0x25, 0x36, 0x6d, 0xce, 0x4b, 0xc3, 0xba, 0x66, 0x53, 0x1a, 0xfe,
-	0x4c, 0x83, 0xc4, 0xeb, 0xad, 0x38, 0xdd, 0x43, 0x00, 0x0b, 0x9b, 0x3b, 0xb6, 0x12, 0xdf, 0xdb,
-	0x4f, 0x8c, 0x3e, 0x5c, 0xc3, 0xb2, 0x14, 0x56, 0x79, 0x2f, 0x96, 0x5e, 0xb7, 0xca, 0xd5, 0x38,
-	0xb1, 0xe0, 0x03, 0xc5, 0x85, 0x8e, 0x00, 0x4c, 0x27, 0x64, 0xe8, 0x35, 0xde, 0xf1, 0x60, 0xf0,
-	0xb6, 0x43, 0xda, 0xf8, 0xb6, 0x72, 0x4d, 0xe0, 0xd6, 0xfe, 0x9d, 0x5d, 0x1e, 0x58, 0x55, 0x54,
-	0x21, 0xbb, 0x1e, 0xef, 0xe2, 0xc3, 0x9f, 0xf9, 0xb1, 0xff, 0xa2, 0x63, 0x00, 0x33, 0x2b, 0xd5,
-	0xa1, 0xda, 0xcd, 0x1b, 0x37, 0x2f, 0x69, 0xd7, 0xef, 0xe0, 0x30, 0x98, 0xaf, 0x14, 0x66, 0x09,
-	0xbd, 0xd8, 0x8b, 0xd9, 0x7a, 0x7b, 0x3a, 0x75, 0xc0, 0x64, 0xea, 0x80, 0x8b, 0xa9, 0x03, 0xfe,
-	0xcf, 0x9c, 0xd4, 0x64, 0xe6, 0xa4, 0xce, 0x67, 0x4e, 0xea, 0xdb, 0x73, 0xed, 0x95, 0x9d, 0x9f,
-	0x38, 0x14, 0xe4, 0xf7, 0x32, 0x23, 0x1e, 0x47, 0x4c, 0xfa, 0x0f, 0xd5, 0x85, 0xdf, 0x5c, 0x05,
-	0x00, 0x00, 0xff, 0xff, 0x94, 0x9c, 0x7a, 0xc6, 0xc7, 0x03, 0x00, 0x00,
+	// 491 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x4f, 0x4f, 0x13, 0x41,
+	0x18, 0xc6, 0x3b, 0x45, 0x1b, 0x7c, 0xc1, 0xcb, 0x6b, 0x0d, 0x75, 0xa3, 0x0b, 0x2c, 0x09, 0xad,
+	0xa8, 0x33, 0x2d, 0x1e, 0x38, 0x43, 0xe2, 0xbf, 0x8b, 0xc1, 0xf5, 0xe6, 0xc5, 0x4c, 0x61, 0x6c,
+	0x37, 0x96, 0x9d, 0xa5, 0xb3, 0x25, 0x36, 0xc6, 0x8b, 0x67, 0x0f, 0x26, 0xc6, 0x93, 0xf1, 0xfb,
+	0x78, 0x24, 0xd1, 0x83, 0x47, 0xd3, 0xfa, 0x41, 0x4c, 0x67, 0xde, 0x2d, 0x14, 0x28, 0x5b, 0x6f,
+	0xbb, 0x33, 0xcf, 0xf3, 0xcc, 0xef, 0xfd, 0x03, 0x6b, 0x7b, 0xda, 0x1c, 0x68, 0x23, 0xd4, 0x51,
+	0xb4, 0xaf, 0xe2, 0x3d, 0x25, 0x8e, 0x1a, 0x4d, 0x95, 0xca, 0x86, 0x38, 0xec, 0xa9, 0x6e, 0x9f,
+	0x27, 0x5d, 0x9d, 0x6a, 0x5c, 0x72, 0x22, 0x9e, 0x89, 0x38, 0x89, 0xbc, 0x0d, 0x72, 0x37, 0xa5,
+	0x51, 0xce, 0x31, 0xf6, 0x27, 0xb2, 0x15, 0xc5, 0x32, 0x8d, 0x74, 0xec, 0x42, 0xbc, 0x5b, 0x2d,
+	0xad, 0x5b, 0x1d, 0x25, 0xec, 0x5f, 0xb3, 0xf7, 0x46, 0xc8, 0x98, 0xf2, 0xbd, 0xdb, 0x74, 0x25,
+	0x93, 0x48, 0xc8, 0x38, 0xd6, 0xa9, 0xf5, 0x19, 0xba, 0x5d, 0x9f, 0x86, 0x38, 0xc6, 0xb1, 0xba,
+	0xe0, 0x25, 0x94, 0x5f, 0x8c, 0x10, 0x1e, 0xd1, 0x71, 0xa8, 0x0e, 0x7b, 0xca, 0xa4, 0x58, 0x85,
+	0xeb, 0x99, 0xf2, 0x75, 0x5b, 0x9a, 0x76, 0x85, 0xad, 0xb0, 0xda, 0xe2, 0x4e, 0xb1, 0xc2, 0xc2,
+	0xc5, 0xec, 0xe2, 0xa9, 0x34, 0x6d, 0x44, 0xb8, 0x62, 0xef, 0x8b, 0x2b, 0xac, 0x76, 0x2d, 0xb4,
+	0xdf, 0xc1, 0x33, 0xb8, 0x79, 0x26, 0xd4, 0x24, 0x3a, 0x36, 0x0a, 0xeb, 0x30, 0x9f, 0x99, 0x6d,
+	0xe0, 0xc2, 0x66, 0x99, 0xbb, 0x32, 0x78, 0x56, 0x21, 0xdf, 0x8e, 0xfb, 0xe1, 0x58, 0x15, 0x48,
+	0x58, 0xb2, 0x51, 0xdb, 0x9d, 0xce, 0x59, 0xc4, 0xc7, 0x00, 0x27, 0xfd, 0xa2, 0xb8, 0x75, 0x4e,
+	0x5d, 0x1f, 0x35, 0x97, 0xbb, 0x71, 0x50, 0xe5, 0x7c, 0x57, 0xb6, 0x32, 0x6f, 0x78, 0xca, 0x19,
+	0x7c, 0x65, 0x50, 0x39, 0xff, 0xc6, 0x85, 0xc4, 0x73, 0xf9, 0xc4, 0xf8, 0x64, 0x02, 0xab, 0x68,
+	0xb1, 0xaa, 0xb9, 0x58, 0xee, 0xb9, 0x09, 0xae, 0x32, 0xa0, 0xc5, 0xda, 0x95, 0x5d, 0x79, 0x60,
+	0x88, 0x3c, 0x78, 0x0e, 0x37, 0x26, 0x4e, 0x89, 0x73, 0x0b, 0x4a, 0x89, 0x3d, 0xa1, 0x46, 0x2c,
+	0xf3, 0x29, 0xeb, 0xc7, 0xc9, 0x48, 0xf2, 0xcd, 0x5f, 0x73, 0x70, 0xd5, 0x06, 0xe2, 0x37, 0x06,
+	0xf3, 0x59, 0xfd, 0xf8, 0x60, 0xaa, 0xff, 0xa2, 0x75, 0xf1, 0xf8, 0xac, 0x72, 0x87, 0x1b, 0xd4,
+	0x3f, 0xfe, 0xfc, 0xfb, 0xa5, 0xb8, 0x81, 0x35, 0x91, 0xb7, 0xa7, 0xe2, 0xfd, 0x68, 0xa5, 0x3e,
+	0xe0, 0x77, 0x06, 0x0b, 0xa7, 0x06, 0x84, 0xf5, 0xcb, 0x5f, 0x3c, 0xbf, 0x2f, 0x5e, 0xe3, 0x3f,
+	0x1c, 0x84, 0x79, 0xd7, 0x62, 0xae, 0xe1, 0x6a, 0x2e, 0x26, 0x7e, 0x62, 0x50, 0x72, 0xad, 0xc5,
+	0x7b, 0x97, 0x3f, 0x34, 0x31, 0x4f, 0xef, 0xfe, 0x6c, 0x62, 0x02, 0xaa, 0x5a, 0xa0, 0x55, 0x5c,
+	0x9e, 0x0a, 0xe4, 0xc6, 0xba, 0xb3, 0xf5, 0x63, 0xe0, 0xb3, 0xe3, 0x81, 0xcf, 0xfe, 0x0c, 0x7c,
+	0xf6, 0x79, 0xe8, 0x17, 0x8e, 0x87, 0x7e, 0xe1, 0xf7, 0xd0, 0x2f, 0xbc, 0xba, 0xe3, 0x9c, 0x66,
+	0xff, 0x2d, 0x8f, 0xb4, 0x78, 0x77, 0x92, 0x90, 0xf6, 0x13, 0x65, 0x9a, 0x25, 0xbb, 0xd6, 0x0f,
+	0xff, 0x0d, 0x00, 0xd9, 0xf4, 0xe3, 0x28, 0xe4, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -296,6 +389,10 @@ type QueryClient interface {
 	Evidence(ctx context.Context, in *QueryEvidenceRequest, opts ...grpc.CallOption) (*QueryEvidenceResponse, error)
 	// AllEvidence queries all evidence.
 	AllEvidence(ctx context.Context, in *QueryAllEvidenceRequest, opts ...grpc.CallOption) (*QueryAllEvidenceResponse, error)
+	// Params queries the parameters of the evidence module.
+	//
+	// Since: x/evidence v0.2.0
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
 
 type queryClient struct {
@@ -324,12 +421,25 @@ func (c *queryClient) AllEvidence(ctx context.Context, in *QueryAllEvidenceReque
 	return out, nil
 }
 
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evidence.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Evidence queries evidence based on evidence hash.
 	Evidence(context.Context, *QueryEvidenceRequest) (*QueryEvidenceResponse, error)
 	// AllEvidence queries all evidence.
 	AllEvidence(context.Context, *QueryAllEvidenceRequest) (*QueryAllEvidenceResponse, error)
+	// Params queries the parameters of the evidence module.
+	//
+	// Since: x/evidence v0.2.0
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -342,6 +452,9 @@ func (*UnimplementedQueryServer) Evidence(ctx context.Context, req *QueryEvidenc
 func (*UnimplementedQueryServer) AllEvidence(ctx context.Context, req *QueryAllEvidenceRequest) (*QueryAllEvidenceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllEvidence not implemented")
 }
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -383,6 +496,24 @@ func _Query_AllEvidence_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evidence.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.evidence.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -395,6 +526,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AllEvidence",
 			Handler:    _Query_AllEvidence_Handler,
 		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evidence/v1beta1/query.proto",
@@ -556,6 +691,64 @@ func (m *QueryAllEvidenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -629,6 +822,28 @@ func (m *QueryAllEvidenceResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1043,6 +1258,142 @@ func (m *QueryAllEvidenceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -18,23 +18,31 @@ type (
 
 	// Router defines a contract for which any Evidence handling module must
 	// implement in order to route Evidence to registered Handlers.
+	//
+	// Evidence is routed by its type URL when a Handler is registered for it
+	// with AddTypeRoute, and by its Route otherwise.
 	Router interface {
 		AddRoute(r string, h Handler) Router
 		HasRoute(r string) bool
 		GetRoute(path string) Handler
+		AddTypeRoute(evidence exported.Evidence, h Handler) Router
+		HasTypeRoute(typeURL string) bool
+		GetTypeRoute(typeURL string) Handler
 		Seal()
 		Sealed() bool
 	}
 
 	router struct {
-		routes map[string]Handler
-		sealed bool
+		routes     map[string]Handler
+		typeRoutes map[string]Handler
+		sealed     bool
 	}
 )
 
 func NewRouter() Router {
 	return &router{
-		routes: make(map[string]Handler),
+		routes:     make(map[string]Handler),
+		typeRoutes: make(map[string]Handler),
 	}
 }
 
@@ -81,3 +89,37 @@ func (rtr *router) GetRoute(path string) Handler {
 	}
 	return rtr.routes[path]
 }
+
+// AddTypeRoute adds an evidence handler for the type URL of the given evidence,
+// which is only used to derive the type URL. It returns the Router so calls can
+// be linked. It will panic if the router is sealed or if the type URL already
+// has a handler registered.
+//
+// The evidence type must also be registered as an implementation of the
+// cosmos.evidence.v1beta1.Evidence interface, so that it can be submitted
+// through MsgSubmitEvidence.
+func (rtr *router) AddTypeRoute(evidence exported.Evidence, h Handler) Router {
+	typeURL := sdk.MsgTypeURL(evidence)
+	if rtr.sealed {
+		panic(fmt.Sprintf("router sealed; cannot register %s type route handler", typeURL))
+	}
+	if rtr.HasTypeRoute(typeURL) {
+		panic(fmt.Sprintf("type route %s has already been registered", typeURL))
+	}
+
+	rtr.typeRoutes[typeURL] = h
+	return rtr
+}
+
+// HasTypeRoute returns true if the router has a handler registered for the type URL or false otherwise.
+func (rtr *router) HasTypeRoute(typeURL string) bool {
+	return rtr.typeRoutes[typeURL] != nil
+}
+
+// GetTypeRoute returns the Handler registered for a given type URL.
+func (rtr *router) GetTypeRoute(typeURL string) Handler {
+	if !rtr.HasTypeRoute(typeURL) {
+		panic(fmt.Sprintf("type route does not exist for type URL %s", typeURL))
+	}
+	return rtr.typeRoutes[typeURL]
+}
@@ -26,3 +26,17 @@ func TestRouter(t *testing.T) {
 	require.Panics(t, func() { r.AddRoute("test", testHandler) })
 	require.Panics(t, func() { r.AddRoute("    ", testHandler) })
 }
+
+func TestRouterTypeRoute(t *testing.T) {
+	r := types.NewRouter()
+	r.AddTypeRoute(&types.Equivocation{}, testHandler)
+	require.True(t, r.HasTypeRoute("/cosmos.evidence.v1beta1.Equivocation"))
+	require.False(t, r.HasRoute(types.RouteEquivocation))
+	require.NotNil(t, r.GetTypeRoute("/cosmos.evidence.v1beta1.Equivocation"))
+	require.Panics(t, func() { r.AddTypeRoute(&types.Equivocation{}, testHandler) })
+	require.Panics(t, func() { r.GetTypeRoute("/unknown") })
+
+	sealed := types.NewRouter()
+	sealed.Seal()
+	require.Panics(t, func() { sealed.AddTypeRoute(&types.Equivocation{}, testHandler) })
+}
@@ -121,39 +121,147 @@ func (m *MsgSubmitEvidenceResponse) GetHash() []byte {
 	return nil
 }
 
+// MsgUpdateParams is the Msg/UpdateParams request type.
+//
+// Since: x/evidence v0.2.0
+type MsgUpdateParams struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the x/evidence parameters to update.
+	//
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3242cb23c956e0, []int{2}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// MsgUpdateParamsResponse defines the response structure for executing a
+// MsgUpdateParams message.
+//
+// Since: x/evidence v0.2.0
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3242cb23c956e0, []int{3}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSubmitEvidence)(nil), "cosmos.evidence.v1beta1.MsgSubmitEvidence")
 	proto.RegisterType((*MsgSubmitEvidenceResponse)(nil), "cosmos.evidence.v1beta1.MsgSubmitEvidenceResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.evidence.v1beta1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.evidence.v1beta1.MsgUpdateParamsResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/evidence/v1beta1/tx.proto", fileDescriptor_3e3242cb23c956e0) }
 
 var fileDescriptor_3e3242cb23c956e0 = []byte{
-	// 383 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2d, 0xcb, 0x4c, 0x49, 0xcd, 0x4b, 0x4e, 0xd5, 0x2f, 0x33, 0x4c, 0x4a,
-	0x2d, 0x49, 0x34, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x87, 0xa8,
-	0xd0, 0x83, 0xa9, 0xd0, 0x83, 0xaa, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xab, 0xd1, 0x07,
-	0xb1, 0x20, 0xca, 0xa5, 0x24, 0xd3, 0xf3, 0xf3, 0xd3, 0x73, 0x52, 0xf5, 0xc1, 0xbc, 0xa4, 0xd2,
-	0x34, 0xfd, 0xc4, 0xbc, 0x4a, 0x98, 0x14, 0xc4, 0xa4, 0x78, 0x88, 0x1e, 0xa8, 0xb1, 0x10, 0x29,
-	0xa8, 0x25, 0xfa, 0xb9, 0xc5, 0xe9, 0xfa, 0x65, 0x86, 0x20, 0x0a, 0x2a, 0x21, 0x98, 0x98, 0x9b,
-	0x99, 0x97, 0xaf, 0x0f, 0x26, 0x21, 0x42, 0x4a, 0x77, 0x18, 0xb9, 0x04, 0x7d, 0x8b, 0xd3, 0x83,
-	0x4b, 0x93, 0x72, 0x33, 0x4b, 0x5c, 0xa1, 0xae, 0x12, 0x32, 0xe3, 0xe2, 0x2c, 0x06, 0x8b, 0x94,
-	0xa4, 0x16, 0x49, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x3a, 0x49, 0x5c, 0xda, 0xa2, 0x2b, 0x02, 0xb5,
-	0xc6, 0x31, 0x25, 0xa5, 0x28, 0xb5, 0xb8, 0x38, 0xb8, 0xa4, 0x28, 0x33, 0x2f, 0x3d, 0x08, 0xa1,
-	0x54, 0x28, 0x8c, 0x8b, 0x03, 0xe6, 0x33, 0x09, 0x26, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x11, 0x3d,
-	0x88, 0x17, 0xf4, 0x60, 0x5e, 0xd0, 0x73, 0xcc, 0xab, 0x74, 0x52, 0x39, 0xb5, 0x45, 0x57, 0x01,
-	0x47, 0x50, 0xe8, 0xc1, 0x5c, 0x11, 0x04, 0x37, 0xcb, 0xca, 0xbc, 0x63, 0x81, 0x3c, 0xc3, 0x8b,
-	0x05, 0xf2, 0x0c, 0x4d, 0xcf, 0x37, 0x68, 0x21, 0xec, 0xeb, 0x7a, 0xbe, 0x41, 0x4b, 0x06, 0x62,
-	0x8c, 0x6e, 0x71, 0x4a, 0xb6, 0x3e, 0x86, 0x47, 0x94, 0xf4, 0xb9, 0x24, 0x31, 0x04, 0x83, 0x52,
-	0x8b, 0x0b, 0xf2, 0xf3, 0x8a, 0x53, 0x85, 0x84, 0xb8, 0x58, 0x32, 0x12, 0x8b, 0x33, 0x24, 0x58,
-	0x14, 0x18, 0x35, 0x78, 0x82, 0xc0, 0x6c, 0xa3, 0x3a, 0x2e, 0x66, 0xdf, 0xe2, 0x74, 0xa1, 0x02,
-	0x2e, 0x3e, 0xb4, 0x20, 0xd1, 0xd2, 0xc3, 0xe5, 0x5e, 0x0c, 0x0b, 0xa4, 0x8c, 0x88, 0x57, 0x0b,
-	0x73, 0x8c, 0x14, 0x6b, 0xc3, 0xf3, 0x0d, 0x5a, 0x8c, 0x4e, 0xd6, 0x2b, 0x1e, 0xc9, 0x31, 0x9e,
-	0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31,
-	0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x2c, 0xc4, 0xdc, 0xe2, 0x94, 0x6c,
-	0xbd, 0xcc, 0x7c, 0xfd, 0x0a, 0x44, 0x42, 0x2b, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0x07,
-	0xb2, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xde, 0xaf, 0x50, 0x22, 0x88, 0x02, 0x00, 0x00,
+	// 493 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x41, 0x8b, 0xd3, 0x40,
+	0x18, 0xcd, 0xe8, 0xba, 0xd8, 0x71, 0x51, 0x36, 0x14, 0xb6, 0x0d, 0x9a, 0x86, 0xa2, 0x52, 0x0a,
+	0x9d, 0xb1, 0x15, 0x54, 0xd6, 0xd3, 0x06, 0x3c, 0x16, 0x24, 0x8b, 0x1e, 0xbc, 0x48, 0xba, 0x19,
+	0xa7, 0x71, 0x4d, 0x26, 0x64, 0xa6, 0x65, 0x7b, 0x13, 0x4f, 0xe2, 0xc9, 0x9f, 0xb0, 0xc7, 0x3d,
+	0xf6, 0xb0, 0xff, 0xc1, 0xc5, 0xd3, 0xe2, 0xc9, 0x83, 0x88, 0xb4, 0x87, 0x8a, 0xbf, 0x42, 0x32,
+	0x33, 0x49, 0x6b, 0x4b, 0xdd, 0xbd, 0x24, 0x93, 0xef, 0x7b, 0xf3, 0xbe, 0xf7, 0xde, 0x17, 0xe8,
+	0x1c, 0x30, 0x1e, 0x31, 0x8e, 0xc9, 0x30, 0x0c, 0x48, 0x7c, 0x40, 0xf0, 0xb0, 0xdd, 0x23, 0xc2,
+	0x6f, 0x63, 0x71, 0x84, 0x92, 0x94, 0x09, 0x66, 0xee, 0x28, 0x04, 0xca, 0x11, 0x48, 0x23, 0xac,
+	0x32, 0x65, 0x94, 0x49, 0x0c, 0xce, 0x4e, 0x0a, 0x6e, 0x55, 0x29, 0x63, 0xf4, 0x1d, 0xc1, 0xf2,
+	0xab, 0x37, 0x78, 0x83, 0xfd, 0x78, 0x94, 0xb7, 0x14, 0xd3, 0x6b, 0x75, 0x47, 0xd3, 0xaa, 0x96,
+	0x1e, 0x82, 0x23, 0x4e, 0xf1, 0xb0, 0x9d, 0xbd, 0x74, 0x63, 0xdb, 0x8f, 0xc2, 0x98, 0x61, 0xf9,
+	0xd4, 0xa5, 0xfb, 0xeb, 0x24, 0x17, 0x0a, 0x25, 0xae, 0xfe, 0x03, 0xc0, 0xed, 0x2e, 0xa7, 0xfb,
+	0x83, 0x5e, 0x14, 0x8a, 0x67, 0xba, 0x67, 0x3e, 0x82, 0x25, 0x2e, 0x2b, 0x82, 0xa4, 0x15, 0xe0,
+	0x80, 0x46, 0xc9, 0xad, 0x7c, 0x3b, 0x6d, 0x95, 0xb5, 0x9c, 0xbd, 0x20, 0x48, 0x09, 0xe7, 0xfb,
+	0x22, 0x0d, 0x63, 0xea, 0xcd, 0xa1, 0xe6, 0x4b, 0x78, 0x3d, 0xe7, 0xaf, 0x5c, 0x71, 0x40, 0xe3,
+	0x46, 0xa7, 0x8c, 0x94, 0x55, 0x94, 0x5b, 0x45, 0x7b, 0xf1, 0xc8, 0xbd, 0xfb, 0xf5, 0xb4, 0xe5,
+	0xac, 0x89, 0x0c, 0xe5, 0x2a, 0xbc, 0x82, 0x6b, 0xf7, 0xf1, 0xc7, 0xe3, 0x9a, 0xf1, 0xfb, 0xb8,
+	0x66, 0x7c, 0x98, 0x8d, 0x9b, 0xf3, 0x79, 0x9f, 0x66, 0xe3, 0xe6, 0x6d, 0x45, 0xd3, 0xe2, 0xc1,
+	0x21, 0x5e, 0x31, 0x52, 0xc7, 0xb0, 0xba, 0x52, 0xf4, 0x08, 0x4f, 0x58, 0xcc, 0x89, 0x69, 0xc2,
+	0x8d, 0xbe, 0xcf, 0xfb, 0x95, 0x0d, 0x07, 0x34, 0xb6, 0x3c, 0x79, 0xae, 0x7f, 0x01, 0xf0, 0x56,
+	0x97, 0xd3, 0x17, 0x49, 0xe0, 0x0b, 0xf2, 0xdc, 0x4f, 0xfd, 0x88, 0x67, 0x69, 0xf8, 0x03, 0xd1,
+	0x67, 0x69, 0x28, 0x46, 0x17, 0xa7, 0x51, 0x40, 0x4d, 0x17, 0x6e, 0x26, 0x92, 0x41, 0x67, 0x51,
+	0x43, 0xeb, 0x2c, 0xab, 0x41, 0x6e, 0xe9, 0xec, 0x67, 0xcd, 0x38, 0x99, 0x8d, 0x9b, 0xc0, 0xd3,
+	0x37, 0x77, 0x9f, 0x48, 0xc7, 0x05, 0x67, 0xe6, 0xf8, 0xde, 0x82, 0xe3, 0xa3, 0xf9, 0x82, 0x97,
+	0x54, 0xd7, 0xab, 0x70, 0x67, 0xa9, 0x94, 0x1b, 0xef, 0xfc, 0x01, 0xf0, 0x6a, 0x97, 0x53, 0x33,
+	0x81, 0x37, 0x97, 0x16, 0xdf, 0x5c, 0x2b, 0x71, 0x25, 0x46, 0xab, 0x73, 0x79, 0x6c, 0x11, 0xf9,
+	0x5b, 0xb8, 0xf5, 0x4f, 0xb4, 0x8d, 0xff, 0x71, 0x2c, 0x22, 0xad, 0x07, 0x97, 0x45, 0xe6, 0xb3,
+	0xac, 0x6b, 0xef, 0xb3, 0x24, 0xdd, 0xa7, 0x27, 0x13, 0x1b, 0x9c, 0x4d, 0x6c, 0x70, 0x3e, 0xb1,
+	0xc1, 0xaf, 0x89, 0x0d, 0x3e, 0x4f, 0x6d, 0xe3, 0x7c, 0x6a, 0x1b, 0xdf, 0xa7, 0xb6, 0xf1, 0xea,
+	0x8e, 0x62, 0xe5, 0xc1, 0x21, 0x0a, 0xd9, 0x62, 0x9c, 0x62, 0x94, 0x10, 0xde, 0xdb, 0x94, 0xbf,
+	0xed, 0xc3, 0xbf, 0x03, 0x00, 0x94, 0x34, 0x87, 0x20, 0x02, 0x04, 0x00, 0x00,
 }
 
 func (this *MsgSubmitEvidenceResponse) Equal(that interface{}) bool {
@@ -180,6 +288,54 @@ func (this *MsgSubmitEvidenceResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgUpdateParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgUpdateParams)
+	if !ok {
+		that2, ok := that.(MsgUpdateParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Authority != that1.Authority {
+		return false
+	}
+	if !this.Params.Equal(&that1.Params) {
+		return false
+	}
+	return true
+}
+func (this *MsgUpdateParamsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgUpdateParamsResponse)
+	if !ok {
+		that2, ok := that.(MsgUpdateParamsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -196,6 +352,11 @@ type MsgClient interface {
 	// SubmitEvidence submits an arbitrary Evidence of misbehavior such as equivocation or
 	// counterfactual signing.
 	SubmitEvidence(ctx context.Context, in *MsgSubmitEvidence, opts ...grpc.CallOption) (*MsgSubmitEvidenceResponse, error)
+	// UpdateParams defines a governance operation for updating the x/evidence module
+	// parameters. The authority defaults to the x/gov module account.
+	//
+	// Since: x/evidence v0.2.0
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -215,11 +376,25 @@ func (c *msgClient) SubmitEvidence(ctx context.Context, in *MsgSubmitEvidence, o
 	return out, nil
 }
 
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evidence.v1beta1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SubmitEvidence submits an arbitrary Evidence of misbehavior such as equivocation or
 	// counterfactual signing.
 	SubmitEvidence(context.Context, *MsgSubmitEvidence) (*MsgSubmitEvidenceResponse, error)
+	// UpdateParams defines a governance operation for updating the x/evidence module
+	// parameters. The authority defaults to the x/gov module account.
+	//
+	// Since: x/evidence v0.2.0
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -229,6 +404,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) SubmitEvidence(ctx context.Context, req *MsgSubmitEvidence) (*MsgSubmitEvidenceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitEvidence not implemented")
 }
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -252,6 +430,24 @@ func _Msg_SubmitEvidence_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evidence.v1beta1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.evidence.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -260,6 +456,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SubmitEvidence",
 			Handler:    _Msg_SubmitEvidence_Handler,
 		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evidence/v1beta1/tx.proto",
@@ -337,6 +537,69 @@ func (m *MsgSubmitEvidenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -378,6 +641,30 @@ func (m *MsgSubmitEvidenceResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -586,6 +873,171 @@ func (m *MsgSubmitEvidenceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
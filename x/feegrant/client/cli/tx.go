@@ -19,11 +19,12 @@ import (
 
 // flag for feegrant module
 const (
-	FlagExpiration  = "expiration"
-	FlagPeriod      = "period"
-	FlagPeriodLimit = "period-limit"
-	FlagSpendLimit  = "spend-limit"
-	FlagAllowedMsgs = "allowed-messages"
+	FlagExpiration     = "expiration"
+	FlagPeriod         = "period"
+	FlagPeriodLimit    = "period-limit"
+	FlagSpendLimit     = "spend-limit"
+	FlagAllowedMsgs    = "allowed-messages"
+	FlagMsgPeriodLimit = "msg-period-limit"
 )
 
 // GetTxCmd returns the transaction commands for feegrant module
@@ -60,8 +61,11 @@ Examples:
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --expiration 2022-01-30T15:04:05Z or
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --period 3600 --period-limit 10stake --expiration 2022-01-30T15:04:05Z or
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --expiration 2022-01-30T15:04:05Z 
-	--allowed-messages "/cosmos.gov.v1beta1.MsgSubmitProposal,/cosmos.gov.v1beta1.MsgVote"
+	--allowed-messages "/cosmos.gov.v1beta1.MsgSubmitProposal,/cosmos.gov.v1beta1.MsgVote" or
+%s tx %s grant cosmos1skjw... cosmos1skjw... --period 3600 --period-limit 1000stake
+	--msg-period-limit /cosmos.gov.v1.MsgVote=1000stake --msg-period-limit /cosmos.bank.v1beta1.MsgSend=100stake
 				`, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName,
+				version.AppName, feegrant.ModuleName,
 			),
 		),
 		Args: cobra.ExactArgs(2),
@@ -157,6 +161,20 @@ Examples:
 				}
 
 				grant = &periodic
+
+				msgPeriodLimitVals, err := cmd.Flags().GetStringArray(FlagMsgPeriodLimit)
+				if err != nil {
+					return err
+				}
+
+				if len(msgPeriodLimitVals) > 0 {
+					msgPeriodLimits, err := parseMessageSpendLimits(msgPeriodLimitVals)
+					if err != nil {
+						return err
+					}
+
+					grant = feegrant.NewPeriodicMsgAllowance(periodic, msgPeriodLimits)
+				}
 			}
 
 			allowedMsgs, err := cmd.Flags().GetStringSlice(FlagAllowedMsgs)
@@ -186,10 +204,33 @@ Examples:
 	cmd.Flags().String(FlagSpendLimit, "", "Spend limit specifies the max limit can be used, if not mentioned there is no limit")
 	cmd.Flags().Int64(FlagPeriod, 0, "period specifies the time duration(in seconds) in which period_limit coins can be spent before that allowance is reset (ex: 3600)")
 	cmd.Flags().String(FlagPeriodLimit, "", "period limit specifies the maximum number of coins that can be spent in the period")
+	cmd.Flags().StringArray(FlagMsgPeriodLimit, []string{}, "msg period limit specifies the maximum number of coins that can be spent in the period on a message type, as type_url=coins (ex: /cosmos.gov.v1.MsgVote=1000stake)")
 
 	return cmd
 }
 
+func parseMessageSpendLimits(vals []string) ([]feegrant.MessageSpendLimit, error) {
+	limits := make([]feegrant.MessageSpendLimit, len(vals))
+	for i, val := range vals {
+		typeURL, coins, ok := strings.Cut(val, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid message spend limit %q, expected type_url=coins", val)
+		}
+
+		spendLimit, err := sdk.ParseCoinsNormalized(coins)
+		if err != nil {
+			return nil, err
+		}
+
+		limits[i] = feegrant.MessageSpendLimit{
+			TypeUrl:    typeURL,
+			SpendLimit: spendLimit,
+		}
+	}
+
+	return limits, nil
+}
+
 func getPeriodReset(duration int64) time.Time {
 	return time.Now().Add(getPeriod(duration))
 }
@@ -219,6 +219,132 @@ func (m *AllowedMsgAllowance) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AllowedMsgAllowance proto.InternalMessageInfo
 
+// MessageSpendLimit defines the fees that can be spent on transactions
+// containing a given message type.
+type MessageSpendLimit struct {
+	// type_url is the type URL of the message, e.g. "/cosmos.gov.v1.MsgVote".
+	TypeUrl string `protobuf:"bytes,1,opt,name=type_url,json=typeUrl,proto3" json:"type_url,omitempty"`
+	// spend_limit specifies the maximum amount of coins that can be spent on
+	// fees for transactions containing this message type.
+	SpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit"`
+}
+
+func (m *MessageSpendLimit) Reset()         { *m = MessageSpendLimit{} }
+func (m *MessageSpendLimit) String() string { return proto.CompactTextString(m) }
+func (*MessageSpendLimit) ProtoMessage()    {}
+func (*MessageSpendLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7279582900c30aea, []int{3}
+}
+func (m *MessageSpendLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessageSpendLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessageSpendLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MessageSpendLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageSpendLimit.Merge(m, src)
+}
+func (m *MessageSpendLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessageSpendLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageSpendLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessageSpendLimit proto.InternalMessageInfo
+
+func (m *MessageSpendLimit) GetTypeUrl() string {
+	if m != nil {
+		return m.TypeUrl
+	}
+	return ""
+}
+
+func (m *MessageSpendLimit) GetSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpendLimit
+	}
+	return nil
+}
+
+// PeriodicMsgAllowance extends PeriodicAllowance with a spend limit per
+// message type and period. Only transactions whose messages all have a spend
+// limit are accepted, and the fee counts towards the limit of every message
+// type present in the transaction.
+type PeriodicMsgAllowance struct {
+	// periodic specifies a struct of `PeriodicAllowance`
+	Periodic PeriodicAllowance `protobuf:"bytes,1,opt,name=periodic,proto3" json:"periodic"`
+	// msg_period_spend_limits specifies the maximum number of coins that can be
+	// spent in the period, per message type.
+	MsgPeriodSpendLimits []MessageSpendLimit `protobuf:"bytes,2,rep,name=msg_period_spend_limits,json=msgPeriodSpendLimits,proto3" json:"msg_period_spend_limits"`
+	// msg_period_can_spend is the number of coins left to be spent before the
+	// period reset time, per message type.
+	MsgPeriodCanSpend []MessageSpendLimit `protobuf:"bytes,3,rep,name=msg_period_can_spend,json=msgPeriodCanSpend,proto3" json:"msg_period_can_spend"`
+}
+
+func (m *PeriodicMsgAllowance) Reset()         { *m = PeriodicMsgAllowance{} }
+func (m *PeriodicMsgAllowance) String() string { return proto.CompactTextString(m) }
+func (*PeriodicMsgAllowance) ProtoMessage()    {}
+func (*PeriodicMsgAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7279582900c30aea, []int{4}
+}
+func (m *PeriodicMsgAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PeriodicMsgAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PeriodicMsgAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PeriodicMsgAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeriodicMsgAllowance.Merge(m, src)
+}
+func (m *PeriodicMsgAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *PeriodicMsgAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeriodicMsgAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeriodicMsgAllowance proto.InternalMessageInfo
+
+func (m *PeriodicMsgAllowance) GetPeriodic() PeriodicAllowance {
+	if m != nil {
+		return m.Periodic
+	}
+	return PeriodicAllowance{}
+}
+
+func (m *PeriodicMsgAllowance) GetMsgPeriodSpendLimits() []MessageSpendLimit {
+	if m != nil {
+		return m.MsgPeriodSpendLimits
+	}
+	return nil
+}
+
+func (m *PeriodicMsgAllowance) GetMsgPeriodCanSpend() []MessageSpendLimit {
+	if m != nil {
+		return m.MsgPeriodCanSpend
+	}
+	return nil
+}
+
 // Grant is stored in the KVStore to record a grant with full context
 type Grant struct {
 	// granter is the address of the user granting an allowance of their funds.
@@ -233,7 +359,7 @@ func (m *Grant) Reset()         { *m = Grant{} }
 func (m *Grant) String() string { return proto.CompactTextString(m) }
 func (*Grant) ProtoMessage()    {}
 func (*Grant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7279582900c30aea, []int{3}
+	return fileDescriptor_7279582900c30aea, []int{5}
 }
 func (m *Grant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -287,6 +413,8 @@ func init() {
 	proto.RegisterType((*BasicAllowance)(nil), "cosmos.feegrant.v1beta1.BasicAllowance")
 	proto.RegisterType((*PeriodicAllowance)(nil), "cosmos.feegrant.v1beta1.PeriodicAllowance")
 	proto.RegisterType((*AllowedMsgAllowance)(nil), "cosmos.feegrant.v1beta1.AllowedMsgAllowance")
+	proto.RegisterType((*MessageSpendLimit)(nil), "cosmos.feegrant.v1beta1.MessageSpendLimit")
+	proto.RegisterType((*PeriodicMsgAllowance)(nil), "cosmos.feegrant.v1beta1.PeriodicMsgAllowance")
 	proto.RegisterType((*Grant)(nil), "cosmos.feegrant.v1beta1.Grant")
 }
 
@@ -295,49 +423,56 @@ func init() {
 }
 
 var fileDescriptor_7279582900c30aea = []byte{
-	// 658 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xcf, 0x4f, 0xd4, 0x4e,
-	0x14, 0xdf, 0x61, 0x81, 0x6f, 0x76, 0x96, 0x2f, 0x42, 0x25, 0xb1, 0x4b, 0x4c, 0x4b, 0x36, 0x51,
-	0x17, 0x12, 0xda, 0x80, 0x37, 0x4e, 0x50, 0x0c, 0xa8, 0x81, 0x84, 0x14, 0x4f, 0x26, 0xa6, 0x99,
-	0xb6, 0x43, 0x9d, 0xb0, 0xed, 0x34, 0x9d, 0xa2, 0xec, 0xd5, 0x93, 0xd1, 0x83, 0x1c, 0x8d, 0x27,
-	0x8e, 0xc6, 0x13, 0x07, 0xfe, 0x08, 0xe2, 0xc1, 0x10, 0x4f, 0x7a, 0x11, 0x03, 0x07, 0xce, 0xfe,
-	0x07, 0xa6, 0x33, 0xd3, 0x6e, 0x61, 0x25, 0x42, 0x62, 0xb8, 0xec, 0x76, 0xde, 0xbc, 0xf7, 0xf9,
-	0xf1, 0xde, 0x6b, 0x0a, 0xef, 0x7a, 0x94, 0x85, 0x94, 0x99, 0x1b, 0x18, 0x07, 0x09, 0x8a, 0x52,
-	0xf3, 0xc5, 0x8c, 0x8b, 0x53, 0x34, 0x53, 0x04, 0x8c, 0x38, 0xa1, 0x29, 0x55, 0x6e, 0x89, 0x3c,
-	0xa3, 0x08, 0xcb, 0xbc, 0xf1, 0xb1, 0x80, 0x06, 0x94, 0xe7, 0x98, 0xd9, 0x93, 0x48, 0x1f, 0x6f,
-	0x04, 0x94, 0x06, 0x6d, 0x6c, 0xf2, 0x93, 0xbb, 0xb5, 0x61, 0xa2, 0xa8, 0x93, 0x5f, 0x09, 0x24,
-	0x47, 0xd4, 0x48, 0x58, 0x71, 0xa5, 0x49, 0x31, 0x2e, 0x62, 0xb8, 0x10, 0xe2, 0x51, 0x12, 0xc9,
-	0xfb, 0x51, 0x14, 0x92, 0x88, 0x9a, 0xfc, 0x57, 0x86, 0xf4, 0xf3, 0x44, 0x29, 0x09, 0x31, 0x4b,
-	0x51, 0x18, 0xe7, 0x98, 0xe7, 0x13, 0xfc, 0xad, 0x04, 0xa5, 0x84, 0x4a, 0xcc, 0xe6, 0x6e, 0x1f,
-	0x1c, 0xb6, 0x10, 0x23, 0xde, 0x42, 0xbb, 0x4d, 0x5f, 0xa2, 0xc8, 0xc3, 0xca, 0x2b, 0x00, 0xeb,
-	0x2c, 0xc6, 0x91, 0xef, 0xb4, 0x49, 0x48, 0x52, 0x15, 0x4c, 0x54, 0x5b, 0xf5, 0xd9, 0x86, 0x21,
-	0xb5, 0x66, 0xea, 0x72, 0xfb, 0xc6, 0x22, 0x25, 0x91, 0xb5, 0x74, 0xf0, 0x43, 0xaf, 0x7c, 0x3a,
-	0xd2, 0x5b, 0x01, 0x49, 0x9f, 0x6f, 0xb9, 0x86, 0x47, 0x43, 0x69, 0x4c, 0xfe, 0x4d, 0x33, 0x7f,
-	0xd3, 0x4c, 0x3b, 0x31, 0x66, 0xbc, 0x80, 0x7d, 0x38, 0xdd, 0x9b, 0x1a, 0x6a, 0xe3, 0x00, 0x79,
-	0x1d, 0x27, 0xf3, 0xc7, 0x3e, 0x9e, 0xee, 0x4d, 0x01, 0x1b, 0x72, 0xd6, 0x95, 0x8c, 0x54, 0x99,
-	0x87, 0x10, 0x6f, 0xc7, 0x44, 0x68, 0x55, 0xfb, 0x26, 0x40, 0xab, 0x3e, 0x3b, 0x6e, 0x08, 0x33,
-	0x46, 0x6e, 0xc6, 0x78, 0x92, 0xbb, 0xb5, 0xfa, 0x77, 0x8e, 0x74, 0x60, 0x97, 0x6a, 0xe6, 0x96,
-	0x3f, 0xef, 0x4f, 0xdf, 0xb9, 0x60, 0x6c, 0xc6, 0x12, 0xc6, 0x85, 0xe1, 0x47, 0x6f, 0x4e, 0xf7,
-	0xa6, 0x1a, 0x25, 0xa5, 0x67, 0xfb, 0xd1, 0xfc, 0xde, 0x0f, 0x47, 0xd7, 0x70, 0x42, 0xa8, 0x5f,
-	0xee, 0xd2, 0x43, 0x38, 0xe0, 0x66, 0x79, 0x2a, 0xe0, 0xda, 0xee, 0x19, 0x17, 0x51, 0x9d, 0x45,
-	0xb3, 0x6a, 0x59, 0xb3, 0x84, 0x5f, 0x01, 0xa0, 0xcc, 0xc3, 0xc1, 0x98, 0xc3, 0x4b, 0x9b, 0x8d,
-	0x1e, 0x9b, 0x0f, 0xe4, 0xcc, 0xac, 0xff, 0xb3, 0xe2, 0xf7, 0x47, 0x3a, 0x10, 0x00, 0xb2, 0x4e,
-	0x79, 0x07, 0xa0, 0x22, 0x1e, 0x9d, 0xf2, 0xe0, 0xaa, 0xd7, 0x35, 0xb8, 0x11, 0x41, 0xbe, 0xde,
-	0x1d, 0xdf, 0x5b, 0x00, 0x65, 0xd0, 0xf1, 0x50, 0x24, 0x54, 0xa9, 0xfd, 0xd7, 0xa5, 0x67, 0x58,
-	0x50, 0x2f, 0xa2, 0x88, 0x4b, 0x52, 0x56, 0xe0, 0x90, 0x14, 0x93, 0x60, 0x86, 0x53, 0x75, 0xe0,
-	0xaf, 0xeb, 0xc4, 0x1b, 0xbd, 0x53, 0x34, 0xba, 0x2e, 0xca, 0xed, 0xac, 0x7a, 0xee, 0xf1, 0x95,
-	0x16, 0xeb, 0x76, 0x49, 0x79, 0xcf, 0x16, 0x35, 0x7f, 0x01, 0x78, 0x93, 0x9f, 0xb0, 0xbf, 0xca,
-	0x82, 0xee, 0x76, 0x3d, 0x83, 0x35, 0x94, 0x1f, 0xe4, 0x86, 0x8d, 0xf5, 0xc8, 0x5d, 0x88, 0x3a,
-	0xd6, 0xe4, 0xa5, 0xc5, 0xd8, 0x5d, 0x44, 0x65, 0x12, 0x8e, 0x20, 0xc1, 0xea, 0x84, 0x98, 0x31,
-	0x14, 0x60, 0xa6, 0xf6, 0x4d, 0x54, 0x5b, 0x35, 0xfb, 0x86, 0x8c, 0xaf, 0xca, 0xf0, 0xdc, 0xda,
-	0xeb, 0x5d, 0xbd, 0x72, 0x25, 0xc7, 0x5a, 0xc9, 0xf1, 0x1f, 0xbc, 0x35, 0xbf, 0x00, 0x38, 0xb0,
-	0x9c, 0x41, 0x28, 0xb3, 0xf0, 0x3f, 0x8e, 0x85, 0x13, 0xee, 0xb1, 0x66, 0xa9, 0x5f, 0xf7, 0xa7,
-	0xc7, 0x24, 0xd1, 0x82, 0xef, 0x27, 0x98, 0xb1, 0xf5, 0x34, 0x21, 0x51, 0x60, 0xe7, 0x89, 0xdd,
-	0x1a, 0xcc, 0x5f, 0x97, 0x4b, 0xd4, 0x9c, 0xeb, 0x66, 0xf5, 0x5f, 0x77, 0xd3, 0x9a, 0x39, 0x38,
-	0xd6, 0xc0, 0xe1, 0xb1, 0x06, 0x7e, 0x1e, 0x6b, 0x60, 0xe7, 0x44, 0xab, 0x1c, 0x9e, 0x68, 0x95,
-	0x6f, 0x27, 0x5a, 0xe5, 0xa9, 0xfc, 0x6c, 0x30, 0x7f, 0xd3, 0x20, 0xd4, 0xdc, 0x2e, 0xbe, 0x2a,
-	0xee, 0x20, 0xa7, 0xbd, 0xff, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x0d, 0x76, 0xbd, 0x15, 0x80, 0x06,
-	0x00, 0x00,
+	// 771 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x3f, 0x6f, 0xd3, 0x4c,
+	0x18, 0xcf, 0x25, 0xfd, 0x97, 0x4b, 0xdf, 0xbe, 0x8d, 0xdf, 0x48, 0x75, 0xaa, 0x57, 0x4e, 0x15,
+	0x09, 0x48, 0x23, 0xd5, 0x56, 0xcb, 0x96, 0xa9, 0x75, 0x51, 0x0b, 0xa8, 0x95, 0x4a, 0x0a, 0x0b,
+	0x12, 0xb2, 0x2e, 0xf1, 0xd5, 0x58, 0xb5, 0x7d, 0x91, 0xcf, 0x81, 0x66, 0x65, 0x42, 0x20, 0x41,
+	0x47, 0xc4, 0xd4, 0x11, 0x31, 0x55, 0xa2, 0x1f, 0xa2, 0x62, 0x40, 0x15, 0x13, 0x2c, 0x14, 0xb5,
+	0x43, 0x67, 0xbe, 0x01, 0xb2, 0xef, 0xec, 0x38, 0x71, 0x23, 0x5a, 0x81, 0xca, 0x92, 0xf8, 0xce,
+	0xcf, 0xf3, 0xfb, 0x73, 0xcf, 0xf3, 0x9c, 0x0c, 0xaf, 0x37, 0x09, 0xb5, 0x09, 0x55, 0xb6, 0x30,
+	0x36, 0x5c, 0xe4, 0x78, 0xca, 0x93, 0xf9, 0x06, 0xf6, 0xd0, 0x7c, 0xb4, 0x21, 0xb7, 0x5c, 0xe2,
+	0x11, 0x61, 0x8a, 0xc5, 0xc9, 0xd1, 0x36, 0x8f, 0x9b, 0x2e, 0x18, 0xc4, 0x20, 0x41, 0x8c, 0xe2,
+	0x3f, 0xb1, 0xf0, 0xe9, 0xa2, 0x41, 0x88, 0x61, 0x61, 0x25, 0x58, 0x35, 0xda, 0x5b, 0x0a, 0x72,
+	0x3a, 0xe1, 0x2b, 0x86, 0xa4, 0xb1, 0x1c, 0x0e, 0xcb, 0x5e, 0x49, 0x5c, 0x4c, 0x03, 0x51, 0x1c,
+	0x09, 0x69, 0x12, 0xd3, 0xe1, 0xef, 0xf3, 0xc8, 0x36, 0x1d, 0xa2, 0x04, 0xbf, 0x7c, 0xab, 0xd4,
+	0x4f, 0xe4, 0x99, 0x36, 0xa6, 0x1e, 0xb2, 0x5b, 0x21, 0x66, 0x7f, 0x80, 0xde, 0x76, 0x91, 0x67,
+	0x12, 0x8e, 0x59, 0xde, 0x4b, 0xc3, 0x09, 0x15, 0x51, 0xb3, 0xb9, 0x64, 0x59, 0xe4, 0x29, 0x72,
+	0x9a, 0x58, 0x78, 0x06, 0x60, 0x8e, 0xb6, 0xb0, 0xa3, 0x6b, 0x96, 0x69, 0x9b, 0x9e, 0x08, 0x66,
+	0x32, 0x95, 0xdc, 0x42, 0x51, 0xe6, 0x5a, 0x7d, 0x75, 0xa1, 0x7d, 0x79, 0x99, 0x98, 0x8e, 0xba,
+	0x72, 0xf8, 0xad, 0x94, 0x7a, 0x7f, 0x5c, 0xaa, 0x18, 0xa6, 0xf7, 0xb8, 0xdd, 0x90, 0x9b, 0xc4,
+	0xe6, 0xc6, 0xf8, 0xdf, 0x1c, 0xd5, 0xb7, 0x15, 0xaf, 0xd3, 0xc2, 0x34, 0x48, 0xa0, 0x6f, 0xcf,
+	0xf6, 0xab, 0xe3, 0x16, 0x36, 0x50, 0xb3, 0xa3, 0xf9, 0xfe, 0xe8, 0xbb, 0xb3, 0xfd, 0x2a, 0xa8,
+	0xc3, 0x80, 0x75, 0xcd, 0x27, 0x15, 0x16, 0x21, 0xc4, 0x3b, 0x2d, 0x93, 0x69, 0x15, 0xd3, 0x33,
+	0xa0, 0x92, 0x5b, 0x98, 0x96, 0x99, 0x19, 0x39, 0x34, 0x23, 0xdf, 0x0f, 0xdd, 0xaa, 0x43, 0xbb,
+	0xc7, 0x25, 0x50, 0x8f, 0xe5, 0xd4, 0x56, 0x3f, 0x1e, 0xcc, 0x5d, 0x1b, 0x50, 0x36, 0x79, 0x05,
+	0xe3, 0xc8, 0xf0, 0x9d, 0x17, 0x67, 0xfb, 0xd5, 0x62, 0x4c, 0x69, 0xef, 0x79, 0x94, 0xbf, 0x0e,
+	0xc1, 0xfc, 0x06, 0x76, 0x4d, 0xa2, 0xc7, 0x4f, 0xe9, 0x36, 0x1c, 0x6e, 0xf8, 0x71, 0x22, 0x08,
+	0xb4, 0xdd, 0x90, 0x07, 0x51, 0xf5, 0xa2, 0xa9, 0x59, 0xff, 0xb0, 0x98, 0x5f, 0x06, 0x20, 0x2c,
+	0xc2, 0x91, 0x56, 0x00, 0xcf, 0x6d, 0x16, 0x13, 0x36, 0x6f, 0xf1, 0x9a, 0xa9, 0xff, 0xf8, 0xc9,
+	0x6f, 0x8e, 0x4b, 0x80, 0x01, 0xf0, 0x3c, 0xe1, 0x35, 0x80, 0x02, 0x7b, 0xd4, 0xe2, 0x85, 0xcb,
+	0x5c, 0x55, 0xe1, 0x26, 0x19, 0xf9, 0x66, 0xb7, 0x7c, 0x2f, 0x01, 0xe4, 0x9b, 0x5a, 0x13, 0x39,
+	0x4c, 0x95, 0x38, 0x74, 0x55, 0x7a, 0x26, 0x18, 0xf5, 0x32, 0x72, 0x02, 0x49, 0xc2, 0x1a, 0x1c,
+	0xe7, 0x62, 0x5c, 0x4c, 0xb1, 0x27, 0x0e, 0xff, 0xb2, 0x9d, 0x82, 0x83, 0xde, 0x8d, 0x0e, 0x3a,
+	0xc7, 0xd2, 0xeb, 0x7e, 0x76, 0xed, 0xee, 0xa5, 0x1a, 0xeb, 0xff, 0x98, 0xf2, 0x44, 0x17, 0x95,
+	0x7f, 0x00, 0xf8, 0x5f, 0xb0, 0xc2, 0xfa, 0x3a, 0x35, 0xba, 0xdd, 0xf5, 0x08, 0x66, 0x51, 0xb8,
+	0xe0, 0x1d, 0x56, 0x48, 0xc8, 0x5d, 0x72, 0x3a, 0xea, 0xec, 0x85, 0xc5, 0xd4, 0xbb, 0x88, 0xc2,
+	0x2c, 0x9c, 0x44, 0x8c, 0x55, 0xb3, 0x31, 0xa5, 0xc8, 0xc0, 0x54, 0x4c, 0xcf, 0x64, 0x2a, 0xd9,
+	0xfa, 0xbf, 0x7c, 0x7f, 0x9d, 0x6f, 0xd7, 0x36, 0x9e, 0xef, 0x95, 0x52, 0x97, 0x72, 0x2c, 0xc5,
+	0x1c, 0x9f, 0xe3, 0xad, 0xfc, 0x01, 0xc0, 0x3c, 0x87, 0x8f, 0x75, 0x4c, 0x11, 0x8e, 0xf9, 0x85,
+	0xd5, 0xda, 0xae, 0x15, 0x18, 0xce, 0xd6, 0x47, 0xfd, 0xf5, 0x03, 0xd7, 0x4a, 0x5c, 0x48, 0xe9,
+	0xbf, 0x70, 0x21, 0x95, 0x5f, 0x65, 0x60, 0x21, 0xac, 0x5f, 0x4f, 0xa9, 0xee, 0xc1, 0xb1, 0x16,
+	0xdf, 0xe7, 0x95, 0xaa, 0x0e, 0xbc, 0x0b, 0x12, 0x0d, 0x10, 0xbf, 0x0e, 0x22, 0x18, 0xc1, 0x82,
+	0x53, 0x36, 0x35, 0xb4, 0xe4, 0x48, 0x53, 0xee, 0x7d, 0x30, 0x43, 0xe2, 0x60, 0xe3, 0x0c, 0x05,
+	0x9b, 0x1a, 0x1b, 0x7d, 0xa3, 0x4a, 0x85, 0x2d, 0x58, 0x88, 0xb1, 0x75, 0xc7, 0x35, 0xf3, 0x3b,
+	0x54, 0xf9, 0x88, 0x2a, 0x9c, 0xc2, 0xda, 0xfa, 0xa5, 0xba, 0xa8, 0x74, 0xce, 0xdc, 0xf4, 0xb4,
+	0xd1, 0x27, 0x00, 0x87, 0x57, 0x7d, 0x0c, 0x61, 0x01, 0x8e, 0x06, 0x60, 0xd8, 0x65, 0x9d, 0xa3,
+	0x8a, 0x9f, 0x0f, 0xe6, 0x0a, 0x9c, 0x69, 0x49, 0xd7, 0x5d, 0x4c, 0xe9, 0xa6, 0xe7, 0x9a, 0x8e,
+	0x51, 0x0f, 0x03, 0xbb, 0x39, 0x58, 0x4c, 0x5f, 0x2c, 0xa7, 0x6f, 0x28, 0x33, 0x7f, 0x7a, 0x28,
+	0xd5, 0xf9, 0xc3, 0x13, 0x09, 0x1c, 0x9d, 0x48, 0xe0, 0xfb, 0x89, 0x04, 0x76, 0x4f, 0xa5, 0xd4,
+	0xd1, 0xa9, 0x94, 0xfa, 0x72, 0x2a, 0xa5, 0x1e, 0xf2, 0xaf, 0x0f, 0xaa, 0x6f, 0xcb, 0x26, 0x51,
+	0x76, 0xa2, 0x8f, 0x93, 0xc6, 0x48, 0x40, 0x7b, 0xf3, 0xe7, 0x00, 0xc7, 0x9e, 0x99, 0x42, 0xc7,
+	0x08, 0x00, 0x00,
 }
 
 func (m *BasicAllowance) Marshal() (dAtA []byte, err error) {
@@ -508,6 +643,111 @@ func (m *AllowedMsgAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MessageSpendLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageSpendLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageSpendLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.TypeUrl) > 0 {
+		i -= len(m.TypeUrl)
+		copy(dAtA[i:], m.TypeUrl)
+		i = encodeVarintFeegrant(dAtA, i, uint64(len(m.TypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PeriodicMsgAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PeriodicMsgAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PeriodicMsgAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgPeriodCanSpend) > 0 {
+		for iNdEx := len(m.MsgPeriodCanSpend) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgPeriodCanSpend[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.MsgPeriodSpendLimits) > 0 {
+		for iNdEx := len(m.MsgPeriodSpendLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgPeriodSpendLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Periodic.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFeegrant(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Grant) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -633,6 +873,48 @@ func (m *AllowedMsgAllowance) Size() (n int) {
 	return n
 }
 
+func (m *MessageSpendLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TypeUrl)
+	if l > 0 {
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PeriodicMsgAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Periodic.Size()
+	n += 1 + l + sovFeegrant(uint64(l))
+	if len(m.MsgPeriodSpendLimits) > 0 {
+		for _, e := range m.MsgPeriodSpendLimits {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	if len(m.MsgPeriodCanSpend) > 0 {
+		for _, e := range m.MsgPeriodCanSpend {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Grant) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1115,6 +1397,273 @@ func (m *AllowedMsgAllowance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MessageSpendLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageSpendLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageSpendLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PeriodicMsgAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PeriodicMsgAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PeriodicMsgAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Periodic", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Periodic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgPeriodSpendLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgPeriodSpendLimits = append(m.MsgPeriodSpendLimits, MessageSpendLimit{})
+			if err := m.MsgPeriodSpendLimits[len(m.MsgPeriodSpendLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgPeriodCanSpend", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgPeriodCanSpend = append(m.MsgPeriodCanSpend, MessageSpendLimit{})
+			if err := m.MsgPeriodCanSpend[len(m.MsgPeriodCanSpend)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Grant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
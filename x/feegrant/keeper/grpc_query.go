@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"time"
 
 	"github.com/cosmos/gogoproto/proto"
 	"google.golang.org/grpc/codes"
@@ -116,3 +117,96 @@ func (q Keeper) AllowancesByGranter(c context.Context, req *feegrant.QueryAllowa
 
 	return &feegrant.QueryAllowancesByGranterResponse{Allowances: grants, Pagination: pageRes}, nil
 }
+
+// ExpiredAllowances queries all the allowances which have expired as of the current block
+// time but have not been pruned yet, ranging over the allowance queue up to the block time.
+func (q Keeper) ExpiredAllowances(c context.Context, req *feegrant.QueryExpiredAllowancesRequest) (*feegrant.QueryExpiredAllowancesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	pageReq := req.Pagination
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if pageReq.Offset > 0 && pageReq.Key != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request, either offset or key is expected, got both")
+	}
+	if pageReq.Reverse {
+		return nil, status.Error(codes.InvalidArgument, "reverse pagination is not supported")
+	}
+
+	limit, countTotal := pageReq.Limit, pageReq.CountTotal
+	if limit == 0 {
+		limit = query.DefaultLimit
+		countTotal = true
+	}
+
+	rng := expiredAllowancesRange{end: q.environment.HeaderService.GetHeaderInfo(c).Time}
+	if pageReq.Key != nil {
+		_, key, err := q.FeeAllowanceQueue.KeyCodec().Decode(pageReq.Key)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid pagination key: %s", err)
+		}
+		rng.start = &key
+	}
+
+	iter, err := q.FeeAllowanceQueue.Iterate(c, rng)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer iter.Close()
+
+	grants := []*feegrant.Grant{}
+	pageRes := &query.PageResponse{}
+	var count uint64
+
+	for ; iter.Valid(); iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		count++
+		switch {
+		case count <= pageReq.Offset:
+		case count <= pageReq.Offset+limit:
+			grant, err := q.FeeAllowance.Get(c, collections.Join(key.K2(), key.K3()))
+			if err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+			grants = append(grants, &grant)
+		default:
+			if pageRes.NextKey == nil {
+				pageRes.NextKey, err = collections.EncodeKeyWithPrefix(nil, q.FeeAllowanceQueue.KeyCodec(), key)
+				if err != nil {
+					return nil, status.Error(codes.Internal, err.Error())
+				}
+			}
+		}
+		if pageRes.NextKey != nil && !countTotal {
+			break
+		}
+	}
+
+	if countTotal {
+		pageRes.Total = count
+	}
+
+	return &feegrant.QueryExpiredAllowancesResponse{Allowances: grants, Pagination: pageRes}, nil
+}
+
+// expiredAllowancesRange ranges over the allowance queue entries expiring until
+// end, inclusive, from start, inclusive, if set.
+type expiredAllowancesRange struct {
+	start *collections.Triple[time.Time, sdk.AccAddress, sdk.AccAddress]
+	end   time.Time
+}
+
+func (r expiredAllowancesRange) RangeValues() (start, end *collections.RangeKey[collections.Triple[time.Time, sdk.AccAddress, sdk.AccAddress]], order collections.Order, err error) {
+	if r.start != nil {
+		start = collections.RangeKeyExact(*r.start)
+	}
+	end = collections.RangeKeyPrefixEnd(collections.TriplePrefix[time.Time, sdk.AccAddress, sdk.AccAddress](r.end))
+	return start, end, collections.OrderAscending, nil
+}
@@ -1,9 +1,11 @@
 package keeper_test
 
 import (
+	"cosmossdk.io/core/header"
 	"cosmossdk.io/x/feegrant"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 const (
@@ -233,6 +235,58 @@ func (suite *KeeperTestSuite) TestFeeAllowancesByGranter() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestExpiredFeeAllowances() {
+	_, err := suite.feegrantKeeper.ExpiredAllowances(suite.ctx, nil)
+	suite.Require().Error(err)
+
+	suite.grantFeeAllowance(suite.addrs[0], suite.addrs[1])
+	suite.grantFeeAllowance(suite.addrs[1], suite.addrs[2])
+
+	// allowances without expiration are never returned
+	err = suite.feegrantKeeper.GrantAllowance(suite.ctx, suite.addrs[2], suite.addrs[3], &feegrant.BasicAllowance{})
+	suite.Require().NoError(err)
+
+	resp, err := suite.feegrantKeeper.ExpiredAllowances(suite.ctx, &feegrant.QueryExpiredAllowancesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Allowances, 0)
+
+	ctx := suite.ctx.WithHeaderInfo(header.Info{Time: suite.ctx.HeaderInfo().Time.AddDate(2, 0, 0)})
+	resp, err = suite.feegrantKeeper.ExpiredAllowances(ctx, &feegrant.QueryExpiredAllowancesRequest{
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Allowances, 2)
+	suite.Require().Equal(uint64(2), resp.Pagination.Total)
+
+	// the expired allowances are paginated by queue entry
+	resp, err = suite.feegrantKeeper.ExpiredAllowances(ctx, &feegrant.QueryExpiredAllowancesRequest{
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Allowances, 1)
+	suite.Require().NotNil(resp.Pagination.NextKey)
+	first := resp.Allowances[0]
+	resp, err = suite.feegrantKeeper.ExpiredAllowances(ctx, &feegrant.QueryExpiredAllowancesRequest{
+		Pagination: &query.PageRequest{Key: resp.Pagination.NextKey, Limit: 1},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Allowances, 1)
+	suite.Require().NotEqual(first.Grantee, resp.Allowances[0].Grantee)
+	suite.Require().Nil(resp.Pagination.NextKey)
+	resp, err = suite.feegrantKeeper.ExpiredAllowances(ctx, &feegrant.QueryExpiredAllowancesRequest{
+		Pagination: &query.PageRequest{Offset: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Allowances, 1)
+	suite.Require().Equal(uint64(2), resp.Pagination.Total)
+
+	// once pruned, expired allowances are no longer returned
+	suite.Require().NoError(suite.feegrantKeeper.RemoveExpiredAllowances(ctx, 200))
+	resp, err = suite.feegrantKeeper.ExpiredAllowances(ctx, &feegrant.QueryExpiredAllowancesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Allowances, 0)
+}
+
 func (suite *KeeperTestSuite) grantFeeAllowance(granter, grantee sdk.AccAddress) {
 	exp := suite.ctx.HeaderInfo().Time.AddDate(1, 0, 0)
 	err := suite.feegrantKeeper.GrantAllowance(suite.ctx, granter, grantee, &feegrant.BasicAllowance{
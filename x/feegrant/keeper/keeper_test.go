@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
@@ -11,6 +12,7 @@ import (
 	sdkmath "cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	authtypes "cosmossdk.io/x/auth/types"
+	banktypes "cosmossdk.io/x/bank/types"
 	"cosmossdk.io/x/feegrant"
 	"cosmossdk.io/x/feegrant/keeper"
 	"cosmossdk.io/x/feegrant/module"
@@ -305,6 +307,37 @@ func (suite *KeeperTestSuite) TestUseGrantedFee() {
 	suite.Require().NoError(err)
 }
 
+func (suite *KeeperTestSuite) TestUseGrantedFeePeriodicMsg() {
+	sendURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	periodic := feegrant.PeriodicAllowance{
+		Period:           time.Hour,
+		PeriodSpendLimit: suite.atom,
+		PeriodCanSpend:   suite.atom,
+	}
+	allowance := feegrant.NewPeriodicMsgAllowance(periodic, []feegrant.MessageSpendLimit{
+		{TypeUrl: sendURL, SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 100))},
+	})
+
+	err := suite.feegrantKeeper.GrantAllowance(suite.ctx, suite.addrs[0], suite.addrs[1], allowance)
+	suite.Require().NoError(err)
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 60))
+	err = suite.feegrantKeeper.UseGrantedFees(suite.ctx, suite.addrs[0], suite.addrs[1], fee, []sdk.Msg{&banktypes.MsgSend{}})
+	suite.Require().NoError(err)
+
+	loaded, err := suite.feegrantKeeper.GetAllowance(suite.ctx, suite.addrs[0], suite.addrs[1])
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("atom", 40)), loaded.(*feegrant.PeriodicMsgAllowance).MsgPeriodCanSpend[0].SpendLimit)
+
+	// the message spend limit is exhausted although the period limit is not
+	err = suite.feegrantKeeper.UseGrantedFees(suite.ctx, suite.addrs[0], suite.addrs[1], fee, []sdk.Msg{&banktypes.MsgSend{}})
+	suite.Require().ErrorIs(err, feegrant.ErrFeeLimitExceeded)
+
+	// messages without a spend limit are rejected
+	err = suite.feegrantKeeper.UseGrantedFees(suite.ctx, suite.addrs[0], suite.addrs[1], fee, []sdk.Msg{&banktypes.MsgMultiSend{}})
+	suite.Require().ErrorIs(err, feegrant.ErrMessageNotAllowed)
+}
+
 func (suite *KeeperTestSuite) TestIterateGrants() {
 	eth := sdk.NewCoins(sdk.NewInt64Coin("eth", 123))
 	exp := suite.ctx.HeaderInfo().Time.AddDate(1, 0, 0)
@@ -44,6 +44,12 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "granter"},
 					},
 				},
+				{
+					RpcMethod: "ExpiredAllowances",
+					Use:       "expired-grants",
+					Short:     "Query all expired grants which have not been pruned yet",
+					Example:   fmt.Sprintf(`$ %s query feegrant expired-grants`, version.AppName),
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -0,0 +1,161 @@
+package feegrant
+
+import (
+	"context"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ FeeAllowanceI = (*PeriodicMsgAllowance)(nil)
+
+// NewPeriodicMsgAllowance creates a new PeriodicMsgAllowance which can spend at
+// most the given limits per message type and period, on top of the limits of the
+// provided periodic allowance.
+func NewPeriodicMsgAllowance(periodic PeriodicAllowance, msgPeriodSpendLimits []MessageSpendLimit) *PeriodicMsgAllowance {
+	return &PeriodicMsgAllowance{
+		Periodic:             periodic,
+		MsgPeriodSpendLimits: msgPeriodSpendLimits,
+		MsgPeriodCanSpend:    copyMessageSpendLimits(msgPeriodSpendLimits),
+	}
+}
+
+// Accept checks that every message of the transaction has a spend limit and
+// deducts the fee from the period limit of each distinct message type, before
+// deferring to the embedded PeriodicAllowance. See FeeAllowanceI.Accept for the
+// meaning of the return values.
+func (a *PeriodicMsgAllowance) Accept(ctx context.Context, fee sdk.Coins, msgs []sdk.Msg) (bool, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	blockTime := sdkCtx.HeaderInfo().Time
+
+	// the embedded allowance resets its own period in Accept, so reset the
+	// per message limits under the same condition.
+	if !blockTime.Before(a.Periodic.PeriodReset) {
+		a.MsgPeriodCanSpend = copyMessageSpendLimits(a.MsgPeriodSpendLimits)
+	}
+
+	charged := make(map[string]bool, len(msgs))
+	for _, msg := range msgs {
+		sdkCtx.GasMeter().ConsumeGas(gasCostPerIteration, "check msg")
+
+		typeURL := sdk.MsgTypeURL(msg)
+		if charged[typeURL] {
+			continue
+		}
+
+		i := a.canSpendIndex(ctx, typeURL)
+		if i < 0 {
+			if !a.hasSpendLimit(ctx, typeURL) {
+				return false, errorsmod.Wrapf(ErrMessageNotAllowed, "no spend limit for %s", typeURL)
+			}
+
+			// nothing left to spend until the period resets
+			a.MsgPeriodCanSpend = append(a.MsgPeriodCanSpend, MessageSpendLimit{TypeUrl: typeURL})
+			i = len(a.MsgPeriodCanSpend) - 1
+		}
+
+		left, isNeg := a.MsgPeriodCanSpend[i].SpendLimit.SafeSub(fee...)
+		if isNeg {
+			return false, errorsmod.Wrapf(ErrFeeLimitExceeded, "period limit for %s", typeURL)
+		}
+
+		a.MsgPeriodCanSpend[i].SpendLimit = left
+		charged[typeURL] = true
+	}
+
+	return a.Periodic.Accept(ctx, fee, msgs)
+}
+
+func (a *PeriodicMsgAllowance) canSpendIndex(ctx context.Context, typeURL string) int {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for i, l := range a.MsgPeriodCanSpend {
+		sdkCtx.GasMeter().ConsumeGas(gasCostPerIteration, "check msg")
+		if l.TypeUrl == typeURL {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func (a *PeriodicMsgAllowance) hasSpendLimit(ctx context.Context, typeURL string) bool {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, l := range a.MsgPeriodSpendLimits {
+		sdkCtx.GasMeter().ConsumeGas(gasCostPerIteration, "check msg")
+		if l.TypeUrl == typeURL {
+			return true
+		}
+	}
+
+	return false
+}
+
+func copyMessageSpendLimits(limits []MessageSpendLimit) []MessageSpendLimit {
+	res := make([]MessageSpendLimit, len(limits))
+	for i, l := range limits {
+		res[i] = MessageSpendLimit{
+			TypeUrl:    l.TypeUrl,
+			SpendLimit: append(sdk.Coins(nil), l.SpendLimit...),
+		}
+	}
+
+	return res
+}
+
+// ValidateBasic implements FeeAllowance and enforces basic sanity checks
+func (a PeriodicMsgAllowance) ValidateBasic() error {
+	if err := a.Periodic.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if len(a.MsgPeriodSpendLimits) == 0 {
+		return errorsmod.Wrap(ErrNoMessages, "message spend limits shouldn't be empty")
+	}
+
+	limits := make(map[string]bool, len(a.MsgPeriodSpendLimits))
+	for _, l := range a.MsgPeriodSpendLimits {
+		if l.TypeUrl == "" {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "message type url cannot be empty")
+		}
+		if limits[l.TypeUrl] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate spend limit for %s", l.TypeUrl)
+		}
+		if !l.SpendLimit.IsValid() {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "spend limit for %s is invalid: %s", l.TypeUrl, l.SpendLimit)
+		}
+		if !l.SpendLimit.IsAllPositive() {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "spend limit for %s must be positive", l.TypeUrl)
+		}
+		limits[l.TypeUrl] = true
+	}
+
+	canSpend := make(map[string]bool, len(a.MsgPeriodCanSpend))
+	for _, l := range a.MsgPeriodCanSpend {
+		if !limits[l.TypeUrl] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "no spend limit for %s", l.TypeUrl)
+		}
+		if canSpend[l.TypeUrl] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate can spend for %s", l.TypeUrl)
+		}
+		// We allow 0 for `MsgPeriodCanSpend`
+		if !l.SpendLimit.IsValid() {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "can spend amount for %s is invalid: %s", l.TypeUrl, l.SpendLimit)
+		}
+		canSpend[l.TypeUrl] = true
+	}
+
+	return nil
+}
+
+// ExpiresAt returns the expiry time of the PeriodicMsgAllowance.
+func (a PeriodicMsgAllowance) ExpiresAt() (*time.Time, error) {
+	return a.Periodic.ExpiresAt()
+}
+
+// UpdatePeriodReset update "PeriodReset" of the PeriodicMsgAllowance.
+func (a *PeriodicMsgAllowance) UpdatePeriodReset(validTime time.Time) error {
+	return a.Periodic.UpdatePeriodReset(validTime)
+}
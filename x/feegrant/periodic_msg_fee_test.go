@@ -0,0 +1,197 @@
+package feegrant_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/header"
+	storetypes "cosmossdk.io/store/types"
+	banktypes "cosmossdk.io/x/bank/types"
+	"cosmossdk.io/x/feegrant"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestPeriodicMsgFeeValidAllow(t *testing.T) {
+	key := storetypes.NewKVStoreKey(feegrant.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+
+	now := time.Now()
+	oneHour := now.Add(1 * time.Hour)
+	tenMinutes := time.Duration(10) * time.Minute
+
+	sendURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	multiSendURL := sdk.MsgTypeURL(&banktypes.MsgMultiSend{})
+
+	periodic := feegrant.PeriodicAllowance{
+		Basic:            feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 5000))},
+		Period:           tenMinutes,
+		PeriodSpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 1500)),
+		PeriodCanSpend:   sdk.NewCoins(sdk.NewInt64Coin("atom", 1500)),
+		PeriodReset:      now.Add(tenMinutes),
+	}
+	limits := []feegrant.MessageSpendLimit{
+		{TypeUrl: sendURL, SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 100))},
+		{TypeUrl: multiSendURL, SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 1000))},
+	}
+
+	cases := map[string]struct {
+		allow          *feegrant.PeriodicMsgAllowance
+		fee            sdk.Coins
+		msgs           []sdk.Msg
+		blockTime      time.Time
+		valid          bool // all other checks are ignored if valid=false
+		accept         bool
+		remainsPeriod  sdk.Coins
+		remainsByMsg   map[string]sdk.Coins
+		remainsOverall sdk.Coins
+	}{
+		"empty": {
+			allow: &feegrant.PeriodicMsgAllowance{},
+			valid: false,
+		},
+		"no message limits": {
+			allow: feegrant.NewPeriodicMsgAllowance(periodic, nil),
+			valid: false,
+		},
+		"duplicate message limit": {
+			allow: feegrant.NewPeriodicMsgAllowance(periodic, []feegrant.MessageSpendLimit{limits[0], limits[0]}),
+			valid: false,
+		},
+		"zero message limit": {
+			allow: feegrant.NewPeriodicMsgAllowance(periodic, []feegrant.MessageSpendLimit{{TypeUrl: sendURL}}),
+			valid: false,
+		},
+		"can spend without limit": {
+			allow: &feegrant.PeriodicMsgAllowance{
+				Periodic:             periodic,
+				MsgPeriodSpendLimits: limits[:1],
+				MsgPeriodCanSpend:    limits[1:],
+			},
+			valid: false,
+		},
+		"within message limit": {
+			allow:          feegrant.NewPeriodicMsgAllowance(periodic, limits),
+			fee:            sdk.NewCoins(sdk.NewInt64Coin("atom", 60)),
+			msgs:           []sdk.Msg{&banktypes.MsgSend{}, &banktypes.MsgSend{}},
+			blockTime:      now,
+			valid:          true,
+			accept:         true,
+			remainsPeriod:  sdk.NewCoins(sdk.NewInt64Coin("atom", 1440)),
+			remainsOverall: sdk.NewCoins(sdk.NewInt64Coin("atom", 4940)),
+			remainsByMsg: map[string]sdk.Coins{
+				sendURL:      sdk.NewCoins(sdk.NewInt64Coin("atom", 40)),
+				multiSendURL: sdk.NewCoins(sdk.NewInt64Coin("atom", 1000)),
+			},
+		},
+		"charges every message type": {
+			allow:          feegrant.NewPeriodicMsgAllowance(periodic, limits),
+			fee:            sdk.NewCoins(sdk.NewInt64Coin("atom", 60)),
+			msgs:           []sdk.Msg{&banktypes.MsgSend{}, &banktypes.MsgMultiSend{}},
+			blockTime:      now,
+			valid:          true,
+			accept:         true,
+			remainsPeriod:  sdk.NewCoins(sdk.NewInt64Coin("atom", 1440)),
+			remainsOverall: sdk.NewCoins(sdk.NewInt64Coin("atom", 4940)),
+			remainsByMsg: map[string]sdk.Coins{
+				sendURL:      sdk.NewCoins(sdk.NewInt64Coin("atom", 40)),
+				multiSendURL: sdk.NewCoins(sdk.NewInt64Coin("atom", 940)),
+			},
+		},
+		"exceeds message limit": {
+			allow:     feegrant.NewPeriodicMsgAllowance(periodic, limits),
+			fee:       sdk.NewCoins(sdk.NewInt64Coin("atom", 200)),
+			msgs:      []sdk.Msg{&banktypes.MsgSend{}},
+			blockTime: now,
+			valid:     true,
+			accept:    false,
+		},
+		"message without limit": {
+			allow:     feegrant.NewPeriodicMsgAllowance(periodic, limits),
+			fee:       sdk.NewCoins(sdk.NewInt64Coin("atom", 1)),
+			msgs:      []sdk.Msg{&banktypes.MsgSend{}, &banktypes.MsgUpdateParams{}},
+			blockTime: now,
+			valid:     true,
+			accept:    false,
+		},
+		"message limit in other denom": {
+			allow:     feegrant.NewPeriodicMsgAllowance(periodic, limits),
+			fee:       sdk.NewCoins(sdk.NewInt64Coin("eth", 1)),
+			msgs:      []sdk.Msg{&banktypes.MsgSend{}},
+			blockTime: now,
+			valid:     true,
+			accept:    false,
+		},
+		"message limit resets with period": {
+			allow: &feegrant.PeriodicMsgAllowance{
+				Periodic:             periodic,
+				MsgPeriodSpendLimits: limits,
+				MsgPeriodCanSpend:    []feegrant.MessageSpendLimit{{TypeUrl: sendURL}},
+			},
+			fee:            sdk.NewCoins(sdk.NewInt64Coin("atom", 100)),
+			msgs:           []sdk.Msg{&banktypes.MsgSend{}},
+			blockTime:      oneHour,
+			valid:          true,
+			accept:         true,
+			remainsPeriod:  sdk.NewCoins(sdk.NewInt64Coin("atom", 1400)),
+			remainsOverall: sdk.NewCoins(sdk.NewInt64Coin("atom", 4900)),
+			remainsByMsg: map[string]sdk.Coins{
+				sendURL:      sdk.Coins{},
+				multiSendURL: sdk.NewCoins(sdk.NewInt64Coin("atom", 1000)),
+			},
+		},
+		"message limit exhausted before reset": {
+			allow: &feegrant.PeriodicMsgAllowance{
+				Periodic:             periodic,
+				MsgPeriodSpendLimits: limits,
+				MsgPeriodCanSpend:    []feegrant.MessageSpendLimit{{TypeUrl: sendURL}},
+			},
+			fee:       sdk.NewCoins(sdk.NewInt64Coin("atom", 1)),
+			msgs:      []sdk.Msg{&banktypes.MsgSend{}},
+			blockTime: now,
+			valid:     true,
+			accept:    false,
+		},
+		"message limit missing from can spend": {
+			allow: &feegrant.PeriodicMsgAllowance{
+				Periodic:             periodic,
+				MsgPeriodSpendLimits: limits,
+			},
+			fee:       sdk.NewCoins(sdk.NewInt64Coin("atom", 1)),
+			msgs:      []sdk.Msg{&banktypes.MsgMultiSend{}},
+			blockTime: now,
+			valid:     true,
+			accept:    false,
+		},
+	}
+
+	for name, stc := range cases {
+		tc := stc // to make scopelint happy
+		t.Run(name, func(t *testing.T) {
+			err := tc.allow.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: tc.blockTime})
+			// now try to deduct
+			_, err = tc.allow.Accept(ctx, tc.fee, tc.msgs)
+			if !tc.accept {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, tc.remainsPeriod, tc.allow.Periodic.PeriodCanSpend)
+			require.Equal(t, tc.remainsOverall, tc.allow.Periodic.Basic.SpendLimit)
+			for _, l := range tc.allow.MsgPeriodCanSpend {
+				require.Equal(t, tc.remainsByMsg[l.TypeUrl], l.SpendLimit, l.TypeUrl)
+			}
+		})
+	}
+}
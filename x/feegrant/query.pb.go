@@ -353,6 +353,107 @@ func (m *QueryAllowancesByGranterResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryExpiredAllowancesRequest is the request type for the Query/ExpiredAllowances RPC method.
+type QueryExpiredAllowancesRequest struct {
+	// pagination defines an pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryExpiredAllowancesRequest) Reset()         { *m = QueryExpiredAllowancesRequest{} }
+func (m *QueryExpiredAllowancesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryExpiredAllowancesRequest) ProtoMessage()    {}
+func (*QueryExpiredAllowancesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59efc303945de53f, []int{6}
+}
+func (m *QueryExpiredAllowancesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExpiredAllowancesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExpiredAllowancesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExpiredAllowancesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExpiredAllowancesRequest.Merge(m, src)
+}
+func (m *QueryExpiredAllowancesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExpiredAllowancesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExpiredAllowancesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExpiredAllowancesRequest proto.InternalMessageInfo
+
+func (m *QueryExpiredAllowancesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryExpiredAllowancesResponse is the response type for the Query/ExpiredAllowances RPC method.
+type QueryExpiredAllowancesResponse struct {
+	// allowances that have expired and are awaiting pruning.
+	Allowances []*Grant `protobuf:"bytes,1,rep,name=allowances,proto3" json:"allowances,omitempty"`
+	// pagination defines an pagination for the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryExpiredAllowancesResponse) Reset()         { *m = QueryExpiredAllowancesResponse{} }
+func (m *QueryExpiredAllowancesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExpiredAllowancesResponse) ProtoMessage()    {}
+func (*QueryExpiredAllowancesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59efc303945de53f, []int{7}
+}
+func (m *QueryExpiredAllowancesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExpiredAllowancesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExpiredAllowancesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExpiredAllowancesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExpiredAllowancesResponse.Merge(m, src)
+}
+func (m *QueryExpiredAllowancesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExpiredAllowancesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExpiredAllowancesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExpiredAllowancesResponse proto.InternalMessageInfo
+
+func (m *QueryExpiredAllowancesResponse) GetAllowances() []*Grant {
+	if m != nil {
+		return m.Allowances
+	}
+	return nil
+}
+
+func (m *QueryExpiredAllowancesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryAllowanceRequest)(nil), "cosmos.feegrant.v1beta1.QueryAllowanceRequest")
 	proto.RegisterType((*QueryAllowanceResponse)(nil), "cosmos.feegrant.v1beta1.QueryAllowanceResponse")
@@ -360,6 +461,8 @@ func init() {
 	proto.RegisterType((*QueryAllowancesResponse)(nil), "cosmos.feegrant.v1beta1.QueryAllowancesResponse")
 	proto.RegisterType((*QueryAllowancesByGranterRequest)(nil), "cosmos.feegrant.v1beta1.QueryAllowancesByGranterRequest")
 	proto.RegisterType((*QueryAllowancesByGranterResponse)(nil), "cosmos.feegrant.v1beta1.QueryAllowancesByGranterResponse")
+	proto.RegisterType((*QueryExpiredAllowancesRequest)(nil), "cosmos.feegrant.v1beta1.QueryExpiredAllowancesRequest")
+	proto.RegisterType((*QueryExpiredAllowancesResponse)(nil), "cosmos.feegrant.v1beta1.QueryExpiredAllowancesResponse")
 }
 
 func init() {
@@ -367,40 +470,43 @@ func init() {
 }
 
 var fileDescriptor_59efc303945de53f = []byte{
-	// 516 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x95, 0xbd, 0x8b, 0x13, 0x41,
-	0x18, 0xc6, 0x33, 0xe7, 0x17, 0x79, 0xaf, 0x1b, 0x3f, 0x2e, 0x2e, 0xb2, 0x86, 0x15, 0xce, 0x2f,
-	0x6e, 0xc6, 0x44, 0x94, 0x13, 0xe4, 0xe0, 0x52, 0x98, 0x56, 0x23, 0x58, 0xd8, 0xc8, 0xe4, 0xf2,
-	0xba, 0x2c, 0xc6, 0x99, 0xdc, 0xce, 0x46, 0x3d, 0xe4, 0x10, 0xfc, 0x0b, 0x04, 0x6d, 0x45, 0xb0,
-	0xb0, 0xd1, 0xd2, 0xd6, 0xde, 0xf2, 0xd0, 0xc6, 0x52, 0x12, 0xff, 0x10, 0xc9, 0xec, 0xcc, 0x6e,
-	0xcc, 0x65, 0xc9, 0xa2, 0x16, 0xd7, 0x65, 0xb2, 0xcf, 0xf3, 0xee, 0xef, 0x79, 0xdf, 0x99, 0x59,
-	0x38, 0xb7, 0xa5, 0xf4, 0x63, 0xa5, 0xf9, 0x43, 0xc4, 0x30, 0x16, 0x32, 0xe1, 0x4f, 0x1a, 0x5d,
-	0x4c, 0x44, 0x83, 0x6f, 0x0f, 0x31, 0xde, 0x61, 0x83, 0x58, 0x25, 0x8a, 0xae, 0xa4, 0x22, 0xe6,
-	0x44, 0xcc, 0x8a, 0xbc, 0xd5, 0x22, 0x77, 0xa6, 0x34, 0x05, 0xbc, 0x4b, 0x56, 0xd7, 0x15, 0x1a,
-	0xd3, 0xca, 0x99, 0x72, 0x20, 0xc2, 0x48, 0x8a, 0x24, 0x52, 0xd2, 0x6a, 0xcf, 0x84, 0x4a, 0x85,
-	0x7d, 0xe4, 0x62, 0x10, 0x71, 0x21, 0xa5, 0x4a, 0xcc, 0x43, 0x6d, 0x9f, 0x9e, 0x4e, 0x2b, 0x3d,
-	0x30, 0x2b, 0x6e, 0xb9, 0xcc, 0x22, 0x78, 0x01, 0x27, 0xef, 0x4c, 0x4a, 0x6f, 0xf6, 0xfb, 0xea,
-	0xa9, 0x90, 0x5b, 0xd8, 0xc1, 0xed, 0x21, 0xea, 0x84, 0x36, 0xe1, 0x98, 0x81, 0xc1, 0xb8, 0x46,
-	0xea, 0xe4, 0x42, 0xb5, 0x55, 0xfb, 0xf6, 0x79, 0xed, 0x84, 0xf5, 0x6e, 0xf6, 0x7a, 0x31, 0x6a,
-	0x7d, 0x37, 0x89, 0x23, 0x19, 0x76, 0x9c, 0x30, 0xf7, 0x60, 0x6d, 0xa9, 0x9c, 0x07, 0x83, 0x7b,
-	0x70, 0x6a, 0x16, 0x40, 0x0f, 0x94, 0xd4, 0x48, 0x6f, 0x42, 0x55, 0xb8, 0x3f, 0x0d, 0xc3, 0x72,
-	0xd3, 0x67, 0x05, 0x4d, 0x65, 0xed, 0xc9, 0xaa, 0x93, 0x1b, 0x82, 0x37, 0x64, 0xb6, 0xb0, 0xde,
-	0x17, 0x0d, 0xcb, 0x46, 0x43, 0x7a, 0x0b, 0x20, 0x6f, 0xba, 0x49, 0xb7, 0xdc, 0x5c, 0x75, 0x34,
-	0x93, 0x09, 0xb1, 0x74, 0xf6, 0x8e, 0xe7, 0xb6, 0x08, 0x5d, 0x2b, 0x3b, 0x53, 0xce, 0xe0, 0x3d,
-	0x81, 0x95, 0x7d, 0x58, 0x36, 0xf0, 0x06, 0x40, 0xc6, 0xaf, 0x6b, 0xa4, 0x7e, 0xa8, 0x44, 0xe2,
-	0x29, 0x07, 0x6d, 0xcf, 0x61, 0x3c, 0xbf, 0x90, 0x31, 0x7d, 0xf9, 0x1f, 0x90, 0x6f, 0x09, 0x9c,
-	0x9d, 0x81, 0x6c, 0xed, 0xb4, 0xd3, 0x21, 0xff, 0xcb, 0xfe, 0xf8, 0x5f, 0x4d, 0xfc, 0x48, 0xa0,
-	0x5e, 0xcc, 0x77, 0xc0, 0xba, 0xd9, 0x7c, 0x77, 0x18, 0x8e, 0x18, 0x5a, 0xfa, 0x89, 0x40, 0x35,
-	0x43, 0xa6, 0xac, 0x10, 0x66, 0xee, 0x89, 0xf4, 0x78, 0x69, 0x7d, 0x0a, 0x11, 0x6c, 0xbc, 0xfc,
-	0xfe, 0xeb, 0xf5, 0xd2, 0x3a, 0xbd, 0xce, 0x8b, 0x6e, 0x9c, 0x2c, 0x2e, 0x7f, 0x6e, 0x67, 0xb4,
-	0xeb, 0x7e, 0xe1, 0x2e, 0xfd, 0x40, 0x00, 0xf2, 0x0e, 0xd3, 0xb2, 0xef, 0x77, 0xe7, 0xcc, 0xbb,
-	0x52, 0xde, 0x60, 0x89, 0xaf, 0x19, 0x62, 0x4e, 0xd7, 0x16, 0x13, 0xeb, 0x29, 0xd0, 0x2f, 0x04,
-	0x8e, 0xcf, 0xd9, 0x0a, 0x74, 0xbd, 0x2c, 0xc0, 0xec, 0xee, 0xf6, 0x6e, 0xfc, 0x85, 0xd3, 0x66,
-	0x68, 0x98, 0x0c, 0x97, 0xe9, 0xc5, 0xc2, 0x0c, 0x91, 0xd6, 0x43, 0xec, 0xe5, 0x2d, 0x6f, 0x35,
-	0xbe, 0x8e, 0x7c, 0xb2, 0x37, 0xf2, 0xc9, 0xcf, 0x91, 0x4f, 0x5e, 0x8d, 0xfd, 0xca, 0xde, 0xd8,
-	0xaf, 0xfc, 0x18, 0xfb, 0x95, 0xfb, 0xf6, 0x23, 0xa2, 0x7b, 0x8f, 0x58, 0xa4, 0xf8, 0xb3, 0xac,
-	0x56, 0xf7, 0xa8, 0xb9, 0xbe, 0xaf, 0xfe, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x3d, 0x3a, 0x28, 0x67,
-	0x8b, 0x06, 0x00, 0x00,
+	// 570 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0xcf, 0x6b, 0x13, 0x41,
+	0x14, 0xc7, 0x33, 0x95, 0x2a, 0x79, 0x3d, 0x39, 0xfe, 0x68, 0x5c, 0x74, 0x0d, 0x2b, 0xd4, 0xaa,
+	0x74, 0xc7, 0x44, 0xac, 0x15, 0xa4, 0xd0, 0x80, 0xe6, 0xaa, 0x11, 0x3c, 0x78, 0x91, 0x49, 0xf7,
+	0xb9, 0x2c, 0xc6, 0x9d, 0xed, 0xce, 0x46, 0x5b, 0xa4, 0x08, 0xfe, 0x05, 0x82, 0x5e, 0xbd, 0x78,
+	0x10, 0x44, 0xf1, 0xe4, 0xd5, 0xbb, 0xc7, 0xa0, 0x17, 0x8f, 0x92, 0xf8, 0x87, 0x48, 0x66, 0x67,
+	0x77, 0xd3, 0x24, 0x6b, 0xd7, 0xea, 0x21, 0xb7, 0x4c, 0xf6, 0x7d, 0xdf, 0x7c, 0xbe, 0xdf, 0x79,
+	0xb3, 0x09, 0x9c, 0xdb, 0x14, 0xf2, 0xb1, 0x90, 0xec, 0x21, 0xa2, 0x1b, 0x72, 0x3f, 0x62, 0x4f,
+	0x6a, 0x6d, 0x8c, 0x78, 0x8d, 0x6d, 0x75, 0x31, 0xdc, 0xb1, 0x83, 0x50, 0x44, 0x82, 0x2e, 0xc6,
+	0x45, 0x76, 0x52, 0x64, 0xeb, 0x22, 0x63, 0x29, 0x4f, 0x9d, 0x56, 0xaa, 0x06, 0xc6, 0x45, 0x5d,
+	0xd7, 0xe6, 0x12, 0xe3, 0xce, 0x69, 0x65, 0xc0, 0x5d, 0xcf, 0xe7, 0x91, 0x27, 0x7c, 0x5d, 0x7b,
+	0xda, 0x15, 0xc2, 0xed, 0x20, 0xe3, 0x81, 0xc7, 0xb8, 0xef, 0x8b, 0x48, 0x3d, 0x94, 0xfa, 0xe9,
+	0xa9, 0xb8, 0xd3, 0x03, 0xb5, 0x62, 0x9a, 0x4b, 0x2d, 0xac, 0xe7, 0x70, 0xe2, 0xce, 0xb0, 0xf5,
+	0x46, 0xa7, 0x23, 0x9e, 0x72, 0x7f, 0x13, 0x5b, 0xb8, 0xd5, 0x45, 0x19, 0xd1, 0x3a, 0x1c, 0x51,
+	0x30, 0x18, 0x56, 0x48, 0x95, 0x2c, 0x97, 0x1b, 0x95, 0x6f, 0x9f, 0x57, 0x8e, 0x6b, 0xed, 0x86,
+	0xe3, 0x84, 0x28, 0xe5, 0xdd, 0x28, 0xf4, 0x7c, 0xb7, 0x95, 0x14, 0x66, 0x1a, 0xac, 0xcc, 0x15,
+	0xd3, 0xa0, 0x75, 0x0f, 0x4e, 0x8e, 0x03, 0xc8, 0x40, 0xf8, 0x12, 0xe9, 0x0d, 0x28, 0xf3, 0xe4,
+	0x4b, 0xc5, 0xb0, 0x50, 0x37, 0xed, 0x9c, 0x50, 0xed, 0xe6, 0x70, 0xd5, 0xca, 0x04, 0xd6, 0x6b,
+	0x32, 0xde, 0x58, 0x4e, 0x58, 0xc3, 0xa2, 0xd6, 0x90, 0xde, 0x02, 0xc8, 0x42, 0x57, 0xee, 0x16,
+	0xea, 0x4b, 0x09, 0xcd, 0xf0, 0x84, 0xec, 0xf8, 0xec, 0x13, 0x9e, 0xdb, 0xdc, 0x4d, 0xa2, 0x6c,
+	0x8d, 0x28, 0xad, 0xb7, 0x04, 0x16, 0x27, 0xb0, 0xb4, 0xe1, 0x75, 0x80, 0x94, 0x5f, 0x56, 0x48,
+	0xf5, 0x50, 0x01, 0xc7, 0x23, 0x0a, 0xda, 0x9c, 0xc2, 0x78, 0x7e, 0x5f, 0xc6, 0x78, 0xf3, 0x3d,
+	0x90, 0x6f, 0x08, 0x9c, 0x1d, 0x83, 0x6c, 0xec, 0x34, 0xe3, 0x43, 0xfe, 0x97, 0xf9, 0xf8, 0x5f,
+	0x21, 0x7e, 0x20, 0x50, 0xcd, 0xe7, 0x9b, 0xb5, 0x34, 0x5d, 0x38, 0xa3, 0x60, 0x6f, 0x6e, 0x07,
+	0x5e, 0x88, 0xce, 0xe4, 0x3c, 0xee, 0x8d, 0x85, 0x1c, 0x38, 0x96, 0xf7, 0x04, 0xcc, 0xbc, 0x9d,
+	0x66, 0x2c, 0x94, 0x7a, 0x6f, 0x1e, 0xe6, 0x15, 0x2b, 0xfd, 0x48, 0xa0, 0x9c, 0x92, 0x52, 0x3b,
+	0x17, 0x66, 0xea, 0x6b, 0xca, 0x60, 0x85, 0xeb, 0x63, 0x08, 0x6b, 0xfd, 0xc5, 0xf7, 0x5f, 0xaf,
+	0xe6, 0xd6, 0xe8, 0x2a, 0xcb, 0x7b, 0x0d, 0xa7, 0x76, 0xd9, 0x33, 0x3d, 0xb8, 0xbb, 0xc9, 0x27,
+	0xdc, 0xa5, 0xef, 0x08, 0x40, 0x16, 0x2c, 0x2d, 0xba, 0x7f, 0x72, 0xd8, 0xc6, 0xe5, 0xe2, 0x02,
+	0x4d, 0x7c, 0x55, 0x11, 0x33, 0xba, 0xb2, 0x3f, 0xb1, 0x1c, 0x01, 0xfd, 0x42, 0xe0, 0xd8, 0x94,
+	0xfb, 0x41, 0xd7, 0x8a, 0x02, 0x8c, 0x5f, 0x79, 0xe3, 0xfa, 0x01, 0x94, 0xda, 0x43, 0x4d, 0x79,
+	0xb8, 0x44, 0x2f, 0xe4, 0x7a, 0xf0, 0xa4, 0xec, 0xa2, 0x93, 0x45, 0x4e, 0x3f, 0x11, 0x38, 0x3a,
+	0x31, 0xc8, 0x74, 0xf5, 0xcf, 0x0c, 0x79, 0x77, 0xcc, 0xb8, 0xf6, 0xd7, 0x3a, 0x4d, 0xbe, 0xac,
+	0xc8, 0x2d, 0x5a, 0xcd, 0x25, 0xc7, 0x58, 0xdb, 0xa8, 0x7d, 0xed, 0x9b, 0xa4, 0xd7, 0x37, 0xc9,
+	0xcf, 0xbe, 0x49, 0x5e, 0x0e, 0xcc, 0x52, 0x6f, 0x60, 0x96, 0x7e, 0x0c, 0xcc, 0xd2, 0x7d, 0xfd,
+	0x57, 0x40, 0x3a, 0x8f, 0x6c, 0x4f, 0xb0, 0xed, 0xb4, 0x45, 0xfb, 0xb0, 0xfa, 0x11, 0xbe, 0xf2,
+	0x7b, 0x00, 0x08, 0x20, 0x02, 0xf0, 0x51, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -423,6 +529,9 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.46
 	AllowancesByGranter(ctx context.Context, in *QueryAllowancesByGranterRequest, opts ...grpc.CallOption) (*QueryAllowancesByGranterResponse, error)
+	// ExpiredAllowances returns the grants which have expired as of the current
+	// block time but have not been pruned yet.
+	ExpiredAllowances(ctx context.Context, in *QueryExpiredAllowancesRequest, opts ...grpc.CallOption) (*QueryExpiredAllowancesResponse, error)
 }
 
 type queryClient struct {
@@ -460,6 +569,15 @@ func (c *queryClient) AllowancesByGranter(ctx context.Context, in *QueryAllowanc
 	return out, nil
 }
 
+func (c *queryClient) ExpiredAllowances(ctx context.Context, in *QueryExpiredAllowancesRequest, opts ...grpc.CallOption) (*QueryExpiredAllowancesResponse, error) {
+	out := new(QueryExpiredAllowancesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Query/ExpiredAllowances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Allowance returns granted allwance to the grantee by the granter.
@@ -470,6 +588,9 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.46
 	AllowancesByGranter(context.Context, *QueryAllowancesByGranterRequest) (*QueryAllowancesByGranterResponse, error)
+	// ExpiredAllowances returns the grants which have expired as of the current
+	// block time but have not been pruned yet.
+	ExpiredAllowances(context.Context, *QueryExpiredAllowancesRequest) (*QueryExpiredAllowancesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -485,6 +606,9 @@ func (*UnimplementedQueryServer) Allowances(ctx context.Context, req *QueryAllow
 func (*UnimplementedQueryServer) AllowancesByGranter(ctx context.Context, req *QueryAllowancesByGranterRequest) (*QueryAllowancesByGranterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllowancesByGranter not implemented")
 }
+func (*UnimplementedQueryServer) ExpiredAllowances(ctx context.Context, req *QueryExpiredAllowancesRequest) (*QueryExpiredAllowancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExpiredAllowances not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -544,6 +668,24 @@ func _Query_AllowancesByGranter_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ExpiredAllowances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExpiredAllowancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExpiredAllowances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Query/ExpiredAllowances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExpiredAllowances(ctx, req.(*QueryExpiredAllowancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.feegrant.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -560,6 +702,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AllowancesByGranter",
 			Handler:    _Query_AllowancesByGranter_Handler,
 		},
+		{
+			MethodName: "ExpiredAllowances",
+			Handler:    _Query_ExpiredAllowances_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/feegrant/v1beta1/query.proto",
@@ -819,6 +965,90 @@ func (m *QueryAllowancesByGranterResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryExpiredAllowancesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExpiredAllowancesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExpiredAllowancesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExpiredAllowancesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExpiredAllowancesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExpiredAllowancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Allowances) > 0 {
+		for iNdEx := len(m.Allowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -932,6 +1162,38 @@ func (m *QueryAllowancesByGranterResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryExpiredAllowancesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExpiredAllowancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Allowances) > 0 {
+		for _, e := range m.Allowances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1614,6 +1876,212 @@ func (m *QueryAllowancesByGranterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryExpiredAllowancesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExpiredAllowancesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExpiredAllowancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExpiredAllowancesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExpiredAllowancesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExpiredAllowancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowances = append(m.Allowances, &Grant{})
+			if err := m.Allowances[len(m.Allowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
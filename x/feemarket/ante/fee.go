@@ -0,0 +1,62 @@
+package ante
+
+import (
+	"context"
+	"math"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	authante "cosmossdk.io/x/auth/ante"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// FeeMarketKeeper defines the fee market keeper methods used by the fee checker.
+type FeeMarketKeeper interface {
+	BaseFee(ctx context.Context) (sdk.DecCoin, error)
+}
+
+// NewTxFeeChecker returns a TxFeeChecker, to be set on the x/auth ante handler
+// options, which requires the tx fee to cover the current base fee for the
+// whole gas limit of the tx. Unlike the validator minimum gas prices, the base
+// fee is part of the consensus state and is therefore also enforced when
+// executing blocks. The tx priority is the gas price paid in the fee denom.
+func NewTxFeeChecker(fk FeeMarketKeeper) authante.TxFeeChecker {
+	return func(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
+		feeTx, ok := tx.(sdk.FeeTx)
+		if !ok {
+			return nil, 0, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+		}
+
+		baseFee, err := fk.BaseFee(ctx)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		feeCoins := feeTx.GetFee()
+		gas := feeTx.GetGas()
+
+		// fee = ceil(baseFee * gasLimit)
+		requiredFee := sdk.NewCoin(baseFee.Denom, baseFee.Amount.MulInt(sdkmath.NewIntFromUint64(gas)).Ceil().RoundInt())
+		if ctx.ExecMode() != sdk.ExecModeSimulate && feeCoins.AmountOf(requiredFee.Denom).LT(requiredFee.Amount) {
+			return nil, 0, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", feeCoins, requiredFee)
+		}
+
+		return feeCoins, getTxPriority(feeCoins.AmountOf(baseFee.Denom), gas), nil
+	}
+}
+
+// getTxPriority returns the gas price paid in the fee denom, capped to math.MaxInt64.
+func getTxPriority(fee sdkmath.Int, gas uint64) int64 {
+	if gas == 0 {
+		return 0
+	}
+
+	gasPrice := fee.Quo(sdkmath.NewIntFromUint64(gas))
+	if !gasPrice.IsInt64() {
+		return math.MaxInt64
+	}
+
+	return gasPrice.Int64()
+}
@@ -0,0 +1,59 @@
+package ante_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/feemarket/ante"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+type mockFeeMarketKeeper struct {
+	baseFee sdk.DecCoin
+}
+
+func (m mockFeeMarketKeeper) BaseFee(context.Context) (sdk.DecCoin, error) {
+	return m.baseFee, nil
+}
+
+type mockFeeTx struct {
+	sdk.Tx
+	fee sdk.Coins
+	gas uint64
+}
+
+func (tx mockFeeTx) GetGas() uint64     { return tx.gas }
+func (tx mockFeeTx) GetFee() sdk.Coins  { return tx.fee }
+func (tx mockFeeTx) FeePayer() []byte   { return nil }
+func (tx mockFeeTx) FeeGranter() []byte { return nil }
+
+func TestTxFeeChecker(t *testing.T) {
+	checker := ante.NewTxFeeChecker(mockFeeMarketKeeper{
+		baseFee: sdk.NewDecCoinFromDec("stake", math.LegacyMustNewDecFromStr("0.5")),
+	})
+	ctx := sdk.Context{}.WithExecMode(sdk.ExecModeFinalize)
+
+	// 0.5stake * 100gas = 50stake
+	fee, priority, err := checker(ctx, mockFeeTx{fee: sdk.NewCoins(sdk.NewInt64Coin("stake", 200)), gas: 100})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 200)), fee)
+	require.Equal(t, int64(2), priority)
+
+	_, _, err = checker(ctx, mockFeeTx{fee: sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), gas: 100})
+	require.NoError(t, err)
+
+	_, _, err = checker(ctx, mockFeeTx{fee: sdk.NewCoins(sdk.NewInt64Coin("stake", 49)), gas: 100})
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+
+	_, _, err = checker(ctx, mockFeeTx{fee: sdk.NewCoins(sdk.NewInt64Coin("atom", 1000)), gas: 100})
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+
+	// the base fee is not enforced when simulating
+	_, _, err = checker(ctx.WithExecMode(sdk.ExecModeSimulate), mockFeeTx{gas: 100})
+	require.NoError(t, err)
+}
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/x/feemarket/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker updates the base fee for the next block according to the gas
+// consumed by the current one.
+func (k Keeper) EndBlocker(ctx context.Context) error {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	state, err := k.State.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	var blockGas uint64
+	if meter := sdk.UnwrapSDKContext(ctx).BlockGasMeter(); meter != nil {
+		blockGas = meter.GasConsumedToLimit()
+	}
+
+	state.BaseFee = state.NextBaseFee(params, blockGas)
+	state.LastBlockGas = blockGas
+	if err := k.State.Set(ctx, state); err != nil {
+		return err
+	}
+
+	return k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeFeeMarket,
+		event.NewAttribute(types.AttributeKeyBaseFee, state.BaseFee.String()),
+		event.NewAttribute(types.AttributeKeyLastBlockGas, strconv.FormatUint(blockGas, 10)),
+	)
+}
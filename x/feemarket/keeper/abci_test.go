@@ -0,0 +1,84 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/depinject"
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/feemarket/ante"
+	"cosmossdk.io/x/feemarket/keeper"
+	feemarkettestutil "cosmossdk.io/x/feemarket/testutil"
+	"cosmossdk.io/x/feemarket/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// TestEndBlockerIntegration runs the module in an app, checking that the base
+// fee moves with the blocks and that the fee checker requires it.
+func TestEndBlockerIntegration(t *testing.T) {
+	var (
+		feeMarketKeeper keeper.Keeper
+		txConfig        client.TxConfig
+	)
+	app, err := simtestutil.Setup(
+		depinject.Configs(
+			feemarkettestutil.AppConfig,
+			depinject.Supply(log.NewNopLogger()),
+		),
+		&feeMarketKeeper,
+		&txConfig,
+	)
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	ctx := app.BaseApp.NewUncachedContext(false, cmtproto.Header{Height: app.LastBlockHeight() + 1, Time: time.Now()})
+	require.NoError(t, feeMarketKeeper.State.Set(ctx, types.NewState(math.LegacyOneDec())))
+
+	// an empty block decreases the base fee by the learning rate
+	ctx, err = simtestutil.NextBlock(app, ctx, time.Second)
+	require.NoError(t, err)
+
+	state, err := feeMarketKeeper.State.Get(ctx)
+	require.NoError(t, err)
+	expected := math.LegacyOneDec().Sub(params.LearningRate)
+	require.True(t, expected.Equal(state.BaseFee), state.BaseFee.String())
+	require.Zero(t, state.LastBlockGas)
+
+	// the fee checker requires the base fee for the whole gas limit
+	const gasLimit = 100_000
+	checker := ante.NewTxFeeChecker(feeMarketKeeper)
+	newTx := func(fee int64) sdk.Tx {
+		txBuilder := txConfig.NewTxBuilder()
+		txBuilder.SetGasLimit(gasLimit)
+		txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin(params.FeeDenom, fee)))
+		return txBuilder.GetTx()
+	}
+	required := expected.MulInt64(gasLimit).Ceil().TruncateInt64()
+
+	_, _, err = checker(ctx, newTx(required-1))
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+
+	fee, _, err := checker(ctx, newTx(required))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(params.FeeDenom, required)), fee)
+
+	// once at the minimum, empty blocks keep the base fee there
+	require.NoError(t, feeMarketKeeper.State.Set(ctx, types.NewState(params.MinBaseFee)))
+	for i := 0; i < 3; i++ {
+		ctx, err = simtestutil.NextBlock(app, ctx, time.Second)
+		require.NoError(t, err)
+	}
+
+	state, err = feeMarketKeeper.State.Get(ctx)
+	require.NoError(t, err)
+	require.True(t, params.MinBaseFee.Equal(state.BaseFee), state.BaseFee.String())
+	require.True(t, state.BaseFee.IsPositive())
+}
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/x/feemarket/types"
+)
+
+var _ types.QueryServer = queryServer{}
+
+func NewQueryServerImpl(k Keeper) types.QueryServer {
+	return queryServer{k}
+}
+
+type queryServer struct {
+	k Keeper
+}
+
+// Params returns params of the feemarket module.
+func (q queryServer) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// State returns the current state of the feemarket module.
+func (q queryServer) State(ctx context.Context, _ *types.QueryStateRequest) (*types.QueryStateResponse, error) {
+	state, err := q.k.State.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryStateResponse{State: state}, nil
+}
+
+// BaseFee returns the current base fee, denominated in the fee denom.
+func (q queryServer) BaseFee(ctx context.Context, _ *types.QueryBaseFeeRequest) (*types.QueryBaseFeeResponse, error) {
+	baseFee, err := q.k.BaseFee(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryBaseFeeResponse{BaseFee: baseFee}, nil
+}
@@ -0,0 +1,37 @@
+package keeper_test
+
+import (
+	gocontext "context"
+
+	"cosmossdk.io/x/feemarket"
+	"cosmossdk.io/x/feemarket/keeper"
+	"cosmossdk.io/x/feemarket/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+func (s *KeeperTestSuite) TestGRPCQueries() {
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, feemarket.AppModule{})
+	queryHelper := baseapp.NewQueryServerTestHelper(s.ctx, encCfg.InterfaceRegistry)
+	types.RegisterQueryServer(queryHelper, keeper.NewQueryServerImpl(s.feeMarketKeeper))
+	queryClient := types.NewQueryClient(queryHelper)
+
+	params, err := queryClient.Params(gocontext.Background(), &types.QueryParamsRequest{})
+	s.Require().NoError(err)
+	kparams, err := s.feeMarketKeeper.Params.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(kparams, params.Params)
+
+	state, err := queryClient.State(gocontext.Background(), &types.QueryStateRequest{})
+	s.Require().NoError(err)
+	kstate, err := s.feeMarketKeeper.State.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(kstate, state.State)
+
+	baseFee, err := queryClient.BaseFee(gocontext.Background(), &types.QueryBaseFeeRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewDecCoinFromDec(kparams.FeeDenom, kstate.BaseFee), baseFee.BaseFee)
+}
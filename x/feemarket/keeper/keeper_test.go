@@ -0,0 +1,83 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/feemarket"
+	"cosmossdk.io/x/feemarket/keeper"
+	"cosmossdk.io/x/feemarket/types"
+
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+const govModuleNameStr = "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn"
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx             sdk.Context
+	feeMarketKeeper keeper.Keeper
+	msgServer       types.MsgServer
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, feemarket.AppModule{})
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	env := runtime.NewEnvironment(runtime.NewKVStoreService(key), log.NewNopLogger())
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	s.ctx = testCtx.Ctx
+
+	s.feeMarketKeeper = keeper.NewKeeper(encCfg.Codec, env, govModuleNameStr)
+	s.Require().NoError(s.feeMarketKeeper.InitGenesis(s.ctx, types.DefaultGenesisState()))
+	s.msgServer = keeper.NewMsgServerImpl(s.feeMarketKeeper)
+}
+
+func (s *KeeperTestSuite) TestEndBlocker() {
+	params := types.DefaultParams()
+	s.Require().NoError(s.feeMarketKeeper.State.Set(s.ctx, types.NewState(math.LegacyNewDec(1))))
+
+	// a block consuming twice the target increases the base fee by the learning rate
+	blockGasMeter := storetypes.NewInfiniteGasMeter()
+	blockGasMeter.ConsumeGas(2*params.TargetBlockGas, "test")
+	s.Require().NoError(s.feeMarketKeeper.EndBlocker(s.ctx.WithBlockGasMeter(blockGasMeter)))
+
+	state, err := s.feeMarketKeeper.State.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(2*params.TargetBlockGas, state.LastBlockGas)
+	s.Require().True(math.LegacyOneDec().Add(params.LearningRate).Equal(state.BaseFee), state.BaseFee.String())
+
+	// an empty block decreases it
+	s.Require().NoError(s.feeMarketKeeper.EndBlocker(s.ctx.WithBlockGasMeter(storetypes.NewInfiniteGasMeter())))
+
+	state, err = s.feeMarketKeeper.State.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Zero(state.LastBlockGas)
+	expected := math.LegacyOneDec().Add(params.LearningRate).Mul(math.LegacyOneDec().Sub(params.LearningRate))
+	s.Require().True(expected.Equal(state.BaseFee), state.BaseFee.String())
+
+	baseFee, err := s.feeMarketKeeper.BaseFee(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewDecCoinFromDec(params.FeeDenom, expected), baseFee)
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	gs := types.NewGenesisState(types.DefaultParams(), types.State{BaseFee: math.LegacyNewDec(3), LastBlockGas: 42})
+	s.Require().NoError(s.feeMarketKeeper.InitGenesis(s.ctx, gs))
+
+	exported, err := s.feeMarketKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(gs, exported)
+}
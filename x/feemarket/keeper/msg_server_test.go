@@ -0,0 +1,63 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/feemarket/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	testCases := []struct {
+		name      string
+		request   *types.MsgUpdateParams
+		expectErr bool
+	}{
+		{
+			name: "set invalid authority (not defined authority)",
+			request: &types.MsgUpdateParams{
+				Authority: "cosmos139f7kncmglres2nf3h4hc4tade85ekfr8sulz5",
+			},
+			expectErr: true,
+		},
+		{
+			name: "set invalid params",
+			request: &types.MsgUpdateParams{
+				Authority: s.feeMarketKeeper.GetAuthority(),
+				Params: types.Params{
+					FeeDenom:       sdk.DefaultBondDenom,
+					MinBaseFee:     sdkmath.LegacyNewDec(2),
+					MaxBaseFee:     sdkmath.LegacyNewDec(1),
+					LearningRate:   sdkmath.LegacyNewDecWithPrec(125, 3),
+					TargetBlockGas: 1_000_000,
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "set full valid params",
+			request: &types.MsgUpdateParams{
+				Authority: s.feeMarketKeeper.GetAuthority(),
+				Params: types.Params{
+					FeeDenom:       sdk.DefaultBondDenom,
+					MinBaseFee:     sdkmath.LegacyNewDecWithPrec(1, 2),
+					MaxBaseFee:     sdkmath.LegacyNewDec(10),
+					LearningRate:   sdkmath.LegacyNewDecWithPrec(1, 1),
+					TargetBlockGas: 1_000_000,
+				},
+			},
+			expectErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.UpdateParams(s.ctx, tc.request)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,9 @@
+package types
+
+// Fee market module event types
+const (
+	EventTypeFeeMarket = ModuleName
+
+	AttributeKeyBaseFee      = "base_fee"
+	AttributeKeyLastBlockGas = "last_block_gas"
+)
@@ -0,0 +1,27 @@
+package types
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, state State) *GenesisState {
+	return &GenesisState{
+		Params: params,
+		State:  state,
+	}
+}
+
+// DefaultGenesisState creates a default GenesisState object
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+		State:  DefaultInitialState(),
+	}
+}
+
+// ValidateGenesis validates the provided genesis state to ensure the
+// expected invariants holds.
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	return ValidateState(data.State, data.Params)
+}
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewParams returns Params instance with the given values.
+func NewParams(feeDenom string, minBaseFee, maxBaseFee, learningRate math.LegacyDec, targetBlockGas uint64) Params {
+	return Params{
+		FeeDenom:       feeDenom,
+		MinBaseFee:     minBaseFee,
+		MaxBaseFee:     maxBaseFee,
+		LearningRate:   learningRate,
+		TargetBlockGas: targetBlockGas,
+	}
+}
+
+// DefaultParams returns default x/feemarket module parameters.
+func DefaultParams() Params {
+	return Params{
+		FeeDenom:       sdk.DefaultBondDenom,
+		MinBaseFee:     math.LegacyNewDecWithPrec(1, 3),
+		MaxBaseFee:     math.LegacyNewDec(1_000),
+		LearningRate:   math.LegacyNewDecWithPrec(125, 3), // as in EIP-1559
+		TargetBlockGas: 15_000_000,
+	}
+}
+
+// Validate does the sanity check on the params.
+func (p Params) Validate() error {
+	if strings.TrimSpace(p.FeeDenom) == "" {
+		return errors.New("fee denom cannot be blank")
+	}
+	if err := sdk.ValidateDenom(p.FeeDenom); err != nil {
+		return err
+	}
+	// the base fee changes by a fraction of itself, so that it could not grow
+	// again once it reaches zero
+	if p.MinBaseFee.IsNil() || !p.MinBaseFee.IsPositive() {
+		return fmt.Errorf("min base fee must be positive: %s", p.MinBaseFee)
+	}
+	if p.MaxBaseFee.IsNil() || p.MaxBaseFee.IsNegative() {
+		return fmt.Errorf("max base fee cannot be nil or negative: %s", p.MaxBaseFee)
+	}
+	if p.MaxBaseFee.LT(p.MinBaseFee) {
+		return fmt.Errorf(
+			"max base fee (%s) must be greater than or equal to min base fee (%s)",
+			p.MaxBaseFee, p.MinBaseFee,
+		)
+	}
+	if p.LearningRate.IsNil() || p.LearningRate.IsNegative() {
+		return fmt.Errorf("learning rate cannot be nil or negative: %s", p.LearningRate)
+	}
+	if p.LearningRate.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("learning rate too large: %s", p.LearningRate)
+	}
+	if p.TargetBlockGas == 0 {
+		return fmt.Errorf("target block gas must be positive: %d", p.TargetBlockGas)
+	}
+
+	return nil
+}
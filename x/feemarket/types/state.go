@@ -0,0 +1,60 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// NewState returns a new State object with the given base fee.
+func NewState(baseFee math.LegacyDec) State {
+	return State{BaseFee: baseFee}
+}
+
+// DefaultInitialState returns a default initial State object, where the base
+// fee starts at the default minimum base fee.
+func DefaultInitialState() State {
+	return NewState(DefaultParams().MinBaseFee)
+}
+
+// ValidateState validates the fee market state against the module params.
+func ValidateState(state State, params Params) error {
+	if state.BaseFee.IsNil() || state.BaseFee.IsNegative() {
+		return fmt.Errorf("base fee cannot be nil or negative: %s", state.BaseFee)
+	}
+	if state.BaseFee.LT(params.MinBaseFee) || state.BaseFee.GT(params.MaxBaseFee) {
+		return fmt.Errorf(
+			"base fee (%s) must be between min base fee (%s) and max base fee (%s)",
+			state.BaseFee, params.MinBaseFee, params.MaxBaseFee,
+		)
+	}
+
+	return nil
+}
+
+// NextBaseFee returns the base fee to apply to the next block given the gas
+// consumed by the current one. As in EIP-1559, the base fee increases when the
+// block gas is above the target and decreases when it is below, by at most
+// LearningRate of its current value. The result is bounded by the min and max
+// base fees.
+func (s State) NextBaseFee(params Params, blockGas uint64) math.LegacyDec {
+	target := math.LegacyNewDecFromInt(math.NewIntFromUint64(params.TargetBlockGas))
+	used := math.LegacyNewDecFromInt(math.NewIntFromUint64(blockGas))
+
+	// the relative distance to the target is capped to 1 so that the base fee
+	// never moves by more than the learning rate in a single block.
+	delta := used.Sub(target).Quo(target)
+	if delta.GT(math.LegacyOneDec()) {
+		delta = math.LegacyOneDec()
+	}
+
+	baseFee := s.BaseFee.Add(s.BaseFee.Mul(params.LearningRate).Mul(delta))
+	if baseFee.LT(params.MinBaseFee) {
+		return params.MinBaseFee
+	}
+	if baseFee.GT(params.MaxBaseFee) {
+		return params.MaxBaseFee
+	}
+
+	return baseFee
+}
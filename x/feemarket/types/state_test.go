@@ -0,0 +1,59 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+)
+
+func TestNextBaseFee(t *testing.T) {
+	params := DefaultParams()
+	params.MinBaseFee = math.LegacyNewDec(1)
+	params.MaxBaseFee = math.LegacyNewDec(1_000)
+	params.TargetBlockGas = 1_000
+
+	tests := []struct {
+		name       string
+		baseFee    math.LegacyDec
+		blockGas   uint64
+		expBaseFee math.LegacyDec
+	}{
+		{"at target", math.LegacyNewDec(100), 1_000, math.LegacyNewDec(100)},
+		{"full block", math.LegacyNewDec(100), 2_000, math.LegacyMustNewDecFromStr("112.5")},
+		{"over full block is capped to the learning rate", math.LegacyNewDec(100), 10_000, math.LegacyMustNewDecFromStr("112.5")},
+		{"half target", math.LegacyNewDec(100), 500, math.LegacyMustNewDecFromStr("93.75")},
+		{"empty block", math.LegacyNewDec(100), 0, math.LegacyMustNewDecFromStr("87.5")},
+		{"bounded by min base fee", math.LegacyNewDec(1), 0, math.LegacyNewDec(1)},
+		{"bounded by max base fee", math.LegacyNewDec(1_000), 2_000, math.LegacyNewDec(1_000)},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			baseFee := NewState(tc.baseFee).NextBaseFee(params, tc.blockGas)
+			require.True(t, tc.expBaseFee.Equal(baseFee), "expected %s, got %s", tc.expBaseFee, baseFee)
+		})
+	}
+}
+
+func TestValidateGenesis(t *testing.T) {
+	require.NoError(t, ValidateGenesis(*DefaultGenesisState()))
+
+	gs := DefaultGenesisState()
+	gs.State.BaseFee = gs.Params.MaxBaseFee.Add(math.LegacyOneDec())
+	require.Error(t, ValidateGenesis(*gs))
+
+	gs = DefaultGenesisState()
+	gs.Params.MaxBaseFee = gs.Params.MinBaseFee.Sub(math.LegacyOneDec())
+	require.Error(t, ValidateGenesis(*gs))
+
+	// a zero base fee could not grow again
+	gs = DefaultGenesisState()
+	gs.Params.MinBaseFee = math.LegacyZeroDec()
+	gs.State.BaseFee = math.LegacyZeroDec()
+	require.Error(t, ValidateGenesis(*gs))
+
+	gs = DefaultGenesisState()
+	gs.Params.TargetBlockGas = 0
+	require.Error(t, ValidateGenesis(*gs))
+}
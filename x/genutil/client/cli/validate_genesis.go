@@ -1,8 +1,11 @@
 package cli
 
 import (
+	"bufio"
 	"encoding/json"
 	"fmt"
+	"os"
+	"path/filepath"
 
 	"github.com/spf13/cobra"
 
@@ -31,23 +34,43 @@ func ValidateGenesisCmd(mm *module.Manager) *cobra.Command {
 				genesis = args[0]
 			}
 
-			appGenesis, err := types.AppGenesisFromFile(genesis)
+			file, err := os.Open(filepath.Clean(genesis))
 			if err != nil {
 				return err
 			}
+			defer file.Close()
 
-			if err := appGenesis.ValidateAndComplete(); err != nil {
-				return fmt.Errorf("make sure that you have correctly migrated all CometBFT consensus params. Refer the UPGRADING.md (%s): %w", chainUpgradeGuide, err)
+			// the genesis of the modules is validated while the file is
+			// streamed, so that large genesis files are not held in memory
+			validated := map[string]bool{}
+			var validationErr error
+			appGenesis, err := types.DecodeAppGenesis(bufio.NewReader(file), func(module string, state json.RawMessage) error {
+				validated[module] = true
+				if mm != nil {
+					validationErr = mm.ValidateModuleGenesis(module, state)
+				}
+				return validationErr
+			})
+			if validationErr != nil {
+				return fmt.Errorf("error validating genesis file %s: %w", genesis, validationErr)
 			}
-
-			var genState map[string]json.RawMessage
-			if err = json.Unmarshal(appGenesis.AppState, &genState); err != nil {
+			if err != nil {
 				return fmt.Errorf("error unmarshalling genesis doc %s: %w", genesis, err)
 			}
 
+			if err := appGenesis.ValidateAndComplete(); err != nil {
+				return fmt.Errorf("make sure that you have correctly migrated all CometBFT consensus params. Refer the UPGRADING.md (%s): %w", chainUpgradeGuide, err)
+			}
+
 			if mm != nil {
-				if err = mm.ValidateGenesis(genState); err != nil {
-					return fmt.Errorf("error validating genesis file %s: %w", genesis, err)
+				// the modules missing from the genesis file
+				for name := range mm.Modules {
+					if validated[name] {
+						continue
+					}
+					if err := mm.ValidateModuleGenesis(name, nil); err != nil {
+						return fmt.Errorf("error validating genesis file %s: %w", genesis, err)
+					}
 				}
 			}
 
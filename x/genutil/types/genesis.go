@@ -15,6 +15,7 @@ import (
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	cmttypes "github.com/cometbft/cometbft/types"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/version"
 )
 
@@ -139,6 +140,107 @@ func AppGenesisFromFile(genFile string) (*AppGenesis, error) {
 	return appGenesis, nil
 }
 
+// DecodeAppGenesis reads the AppGenesis from the reader as a stream, one module
+// genesis at a time: the genesis of each module of the app state is passed to
+// moduleState as soon as it is read, and the AppState of the returned
+// AppGenesis is left empty. The genesis of a single module is still read whole,
+// so the memory used is bounded by the largest module genesis.
+func DecodeAppGenesis(reader io.Reader, moduleState func(module string, state json.RawMessage) error) (*AppGenesis, error) {
+	fields := map[string]json.RawMessage{}
+	err := codec.DecodeJSONObject(json.NewDecoder(reader), func(field string, dec *json.Decoder) error {
+		if field == "app_state" {
+			return codec.DecodeJSONObject(dec, func(module string, dec *json.Decoder) error {
+				var state json.RawMessage
+				if err := dec.Decode(&state); err != nil {
+					return err
+				}
+
+				return moduleState(module, state)
+			})
+		}
+
+		var value json.RawMessage
+		if err := dec.Decode(&value); err != nil {
+			return err
+		}
+		fields[field] = value
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// the genesis without its app state is small enough to be decoded at once
+	jsonBlob, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	return AppGenesisFromReader(bytes.NewReader(jsonBlob))
+}
+
+// EncodeAppGenesis writes the AppGenesis to the writer as SaveAs does, except
+// that the app state is written one module at a time, in the given order, the
+// genesis of each module being returned by moduleState, so that only one
+// module genesis is held in memory at a time. The AppState of the AppGenesis is
+// ignored. It is meant for tools producing large genesis files: the app
+// exporter used by the `genesis export` command still returns the whole app
+// state.
+func EncodeAppGenesis(writer io.Writer, ag *AppGenesis, modules []string, moduleState func(module string) (json.RawMessage, error)) error {
+	header := *ag
+	header.AppState = nil
+	jsonBlob, err := json.Marshal(header)
+	if err != nil {
+		return err
+	}
+
+	enc := codec.NewJSONObjectEncoder(writer, "  ")
+	writeAppState := func() error {
+		appState := enc.Object("app_state")
+		for _, module := range modules {
+			state, err := moduleState(module)
+			if err != nil {
+				return err
+			}
+
+			if err := appState.WriteField(module, state); err != nil {
+				return err
+			}
+		}
+
+		return appState.Close()
+	}
+
+	// the app state is written before the consensus genesis, as by SaveAs
+	var appStateWritten bool
+	err = codec.DecodeJSONObject(json.NewDecoder(bytes.NewReader(jsonBlob)), func(field string, dec *json.Decoder) error {
+		if field == "consensus" {
+			if err := writeAppState(); err != nil {
+				return err
+			}
+			appStateWritten = true
+		}
+
+		var value json.RawMessage
+		if err := dec.Decode(&value); err != nil {
+			return err
+		}
+
+		return enc.WriteField(field, value)
+	})
+	if err != nil {
+		return err
+	}
+
+	if !appStateWritten {
+		if err := writeAppState(); err != nil {
+			return err
+		}
+	}
+
+	return enc.Close()
+}
+
 // --------------------------
 // CometBFT Genesis Handling
 // --------------------------
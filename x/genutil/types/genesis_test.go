@@ -1,7 +1,9 @@
 package types_test
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
 	"os"
 	"testing"
 
@@ -67,3 +69,55 @@ func TestAppGenesis_ValidGenesis(t *testing.T) {
 	assert.NilError(t, err)
 	golden.Assert(t, string(rawAppGenesis), "app_genesis.json")
 }
+
+func TestDecodeEncodeAppGenesis(t *testing.T) {
+	expected, err := types.AppGenesisFromFile("testdata/app_genesis.json")
+	assert.NilError(t, err)
+	var expectedState map[string]json.RawMessage
+	assert.NilError(t, json.Unmarshal(expected.AppState, &expectedState))
+
+	file, err := os.Open("testdata/app_genesis.json")
+	assert.NilError(t, err)
+	defer file.Close()
+
+	var modules []string
+	states := map[string]json.RawMessage{}
+	genesis, err := types.DecodeAppGenesis(file, func(module string, state json.RawMessage) error {
+		modules = append(modules, module)
+		states[module] = state
+		return nil
+	})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, expectedState, states)
+	assert.Assert(t, genesis.AppState == nil)
+	genesis.AppState = expected.AppState
+	assert.DeepEqual(t, expected, genesis)
+
+	// the genesis is written as by SaveAs
+	var buf bytes.Buffer
+	err = types.EncodeAppGenesis(&buf, genesis, modules, func(module string) (json.RawMessage, error) {
+		return states[module], nil
+	})
+	assert.NilError(t, err)
+	expectedJSON, err := json.MarshalIndent(expected, "", "  ")
+	assert.NilError(t, err)
+	assert.Equal(t, string(expectedJSON), buf.String())
+
+	// the errors of the modules are returned
+	file, err = os.Open("testdata/app_genesis.json")
+	assert.NilError(t, err)
+	defer file.Close()
+	_, err = types.DecodeAppGenesis(file, func(module string, state json.RawMessage) error {
+		return errors.New("invalid " + module)
+	})
+	assert.Error(t, err, "invalid "+modules[0])
+
+	// the CometBFT genesis is supported as well
+	file, err = os.Open("testdata/cmt_genesis.json")
+	assert.NilError(t, err)
+	defer file.Close()
+	genesis, err = types.DecodeAppGenesis(file, func(string, json.RawMessage) error { return nil })
+	assert.NilError(t, err)
+	assert.DeepEqual(t, genesis.ChainID, "demo")
+	assert.DeepEqual(t, genesis.Consensus.Validators[0].Name, "test")
+}
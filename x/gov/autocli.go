@@ -32,7 +32,7 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "Proposals",
 					Use:       "proposals",
 					Short:     "Query proposals with optional filters",
-					Example:   fmt.Sprintf("%[1]s query gov proposals --depositor cosmos1...\n%[1]s query gov proposals --voter cosmos1...\n%[1]s query gov proposals --proposal-status (PROPOSAL_STATUS_DEPOSIT_PERIOD|PROPOSAL_STATUS_VOTING_PERIOD|PROPOSAL_STATUS_PASSED|PROPOSAL_STATUS_REJECTED|PROPOSAL_STATUS_FAILED)", version.AppName),
+					Example:   fmt.Sprintf("%[1]s query gov proposals --depositor cosmos1...\n%[1]s query gov proposals --voter cosmos1...\n%[1]s query gov proposals --proposal-status (PROPOSAL_STATUS_DEPOSIT_PERIOD|PROPOSAL_STATUS_VOTING_PERIOD|PROPOSAL_STATUS_PASSED|PROPOSAL_STATUS_REJECTED|PROPOSAL_STATUS_FAILED|PROPOSAL_STATUS_CANCELED)", version.AppName),
 				},
 				{
 					RpcMethod: "Proposal",
@@ -89,6 +89,43 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "proposal_id"},
 					},
 				},
+				{
+					RpcMethod: "ProposalVoteOptions",
+					Use:       "proposal-vote-options [proposal-id]",
+					Short:     "Query the vote options of a proposal",
+					Example:   fmt.Sprintf("%s query gov proposal-vote-options 1", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "proposal_id"},
+					},
+				},
+				{
+					RpcMethod: "LiveTally",
+					Use:       "live-tally [proposal-id]",
+					Short:     "Query the live tally of a proposal in voting period",
+					Long:      "Query the live tally of a proposal in voting period. The live tally is updated on each vote with the voting power derived from the voter's own delegations, it does not account for inherited validator votes, vote delegations nor delegation changes after the vote. The final tally is computed at the end of the voting period.",
+					Example:   fmt.Sprintf("%s query gov live-tally 1", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "proposal_id"},
+					},
+				},
+				{
+					RpcMethod: "ProposalExecutionResult",
+					Use:       "proposal-execution-result [proposal-id]",
+					Short:     "Query the execution result of the messages of a passed proposal",
+					Example:   fmt.Sprintf("%s query gov proposal-execution-result 1", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "proposal_id"},
+					},
+				},
+				{
+					RpcMethod: "VoteDelegation",
+					Use:       "vote-delegation [delegator]",
+					Short:     "Query the vote delegation of a delegator",
+					Example:   fmt.Sprintf("%s query gov vote-delegation cosmos1...", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "delegator"},
+					},
+				},
 				{
 					RpcMethod: "Constitution",
 					Use:       "constitution",
@@ -134,6 +171,16 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						"metadata": {Name: "metadata", Usage: "Add a description to the vote"},
 					},
 				},
+				{
+					RpcMethod: "DelegateVote",
+					Use:       "delegate-vote [delegate]",
+					Short:     "Delegate your governance votes to another account, omit the delegate to remove the vote delegation",
+					Long:      "Delegate your governance votes to another account. Whenever you do not vote on a proposal, the direct vote of the delegate applies to your voting power. Voting directly always overrides the vote delegation.",
+					Example:   fmt.Sprintf("$ %s tx gov delegate-vote cosmos1... --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "delegate", Optional: true},
+					},
+				},
 				{
 					RpcMethod:      "UpdateParams",
 					Use:            "update-params-proposal [params]",
@@ -71,6 +71,7 @@ func NewTxCmd(legacyPropCmds []*cobra.Command) *cobra.Command {
 	govTxCmd.AddCommand(
 		NewCmdWeightedVote(),
 		NewCmdSubmitProposal(),
+		NewCmdSubmitMultipleChoiceProposal(),
 		NewCmdDraftProposal(),
 
 		// Deprecated
@@ -157,6 +158,67 @@ metadata example:
 	return cmd
 }
 
+// NewCmdSubmitMultipleChoiceProposal implements submitting a multiple choice proposal transaction command.
+func NewCmdSubmitMultipleChoiceProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-multiple-choice-proposal [path/to/proposal.json]",
+		Short: "Submit a multiple choice proposal along with metadata, vote options and deposit",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a multiple choice proposal along with metadata, vote options and deposit.
+They should be defined in a JSON file. Only the first two vote options are mandatory.
+
+Example:
+$ %s tx gov submit-multiple-choice-proposal path/to/proposal.json
+
+Where proposal.json contains:
+
+{
+  "metadata": "4pIMOgIGx1vZGU=",
+  "deposit": "10stake",
+  "title": "My proposal",
+  "summary": "A short summary of my proposal",
+  "vote_options": {
+    "option_one": "Option A",
+    "option_two": "Option B",
+    "option_three": "Option C",
+    "option_four": "Option D"
+  }
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, deposit, err := parseSubmitMultipleChoiceProposal(args[0])
+			if err != nil {
+				return err
+			}
+
+			addr, err := clientCtx.AddressCodec.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			msg, err := v1.NewMultipleChoiceMsgSubmitProposal(deposit, addr, proposal.Metadata, proposal.Title, proposal.Summary, proposal.VoteOptions)
+			if err != nil {
+				return fmt.Errorf("invalid message: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // NewCmdSubmitLegacyProposal implements submitting a proposal transaction command.
 // Deprecated: please use NewCmdSubmitProposal instead.
 func NewCmdSubmitLegacyProposal() *cobra.Command {
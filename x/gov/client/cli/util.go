@@ -137,6 +137,41 @@ func parseSubmitProposal(cdc codec.Codec, path string) (proposal, []sdk.Msg, sdk
 	return proposal, msgs, deposit, nil
 }
 
+// multipleChoiceProposal defines the Msg-based multiple choice proposal.
+type multipleChoiceProposal struct {
+	Metadata    string                     `json:"metadata"`
+	Deposit     string                     `json:"deposit"`
+	Title       string                     `json:"title"`
+	Summary     string                     `json:"summary"`
+	VoteOptions *govv1.ProposalVoteOptions `json:"vote_options"`
+}
+
+// parseSubmitMultipleChoiceProposal reads and parses the multiple choice proposal.
+func parseSubmitMultipleChoiceProposal(path string) (multipleChoiceProposal, sdk.Coins, error) {
+	var proposal multipleChoiceProposal
+
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return proposal, nil, err
+	}
+
+	err = json.Unmarshal(contents, &proposal)
+	if err != nil {
+		return proposal, nil, err
+	}
+
+	if proposal.VoteOptions == nil {
+		return proposal, nil, fmt.Errorf("vote options are required")
+	}
+
+	deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+	if err != nil {
+		return proposal, nil, err
+	}
+
+	return proposal, deposit, nil
+}
+
 // AddGovPropFlagsToCmd adds flags for defining MsgSubmitProposal fields.
 //
 // See also ReadGovPropFlags.
@@ -235,6 +235,56 @@ func TestParseSubmitProposal(t *testing.T) {
 	require.Nil(t, err, "unexpected error")
 }
 
+func TestParseSubmitMultipleChoiceProposal(t *testing.T) {
+	okJSON := testutil.WriteToNewTempFile(t, `
+{
+	"metadata": "metadata",
+	"title": "My awesome title",
+	"summary": "My awesome summary",
+	"deposit": "1000test",
+	"vote_options": {
+		"option_one": "Option A",
+		"option_two": "Option B"
+	}
+}
+`)
+	noOptionsJSON := testutil.WriteToNewTempFile(t, `
+{
+	"title": "My awesome title",
+	"summary": "My awesome summary",
+	"deposit": "1000test"
+}
+`)
+	badJSON := testutil.WriteToNewTempFile(t, "bad json")
+
+	// nonexistent json
+	_, _, err := parseSubmitMultipleChoiceProposal("fileDoesNotExist")
+	require.Error(t, err)
+
+	// invalid json
+	_, _, err = parseSubmitMultipleChoiceProposal(badJSON.Name())
+	require.Error(t, err)
+
+	// missing vote options
+	_, _, err = parseSubmitMultipleChoiceProposal(noOptionsJSON.Name())
+	require.ErrorContains(t, err, "vote options are required")
+
+	// ok json
+	proposal, deposit, err := parseSubmitMultipleChoiceProposal(okJSON.Name())
+	require.NoError(t, err, "unexpected error")
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("test", sdkmath.NewInt(1000))), deposit)
+	require.Equal(t, "metadata", proposal.Metadata)
+	require.Equal(t, "My awesome title", proposal.Title)
+	require.Equal(t, "My awesome summary", proposal.Summary)
+	require.Equal(t, "Option A", proposal.VoteOptions.OptionOne)
+	require.Equal(t, "Option B", proposal.VoteOptions.OptionTwo)
+	require.Empty(t, proposal.VoteOptions.OptionThree)
+
+	require.NoError(t, okJSON.Close())
+	require.NoError(t, noOptionsJSON.Close())
+	require.NoError(t, badJSON.Close())
+}
+
 func getCommandHelp(t *testing.T, cmd *cobra.Command) string {
 	t.Helper()
 	// Create a pipe, so we can capture the help sent to stdout.
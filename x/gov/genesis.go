@@ -55,6 +55,21 @@ func InitGenesis(ctx context.Context, ak types.AccountKeeper, bk types.BankKeepe
 		}
 	}
 
+	for _, voteDelegation := range data.VoteDelegations {
+		delegator, err := ak.AddressCodec().StringToBytes(voteDelegation.Delegator)
+		if err != nil {
+			return err
+		}
+		delegate, err := ak.AddressCodec().StringToBytes(voteDelegation.Delegate)
+		if err != nil {
+			return err
+		}
+		err = k.SetVoteDelegation(ctx, delegator, delegate)
+		if err != nil {
+			return err
+		}
+	}
+
 	for _, proposal := range data.Proposals {
 		switch proposal.Status {
 		case v1.StatusDepositPeriod:
@@ -67,12 +82,22 @@ func InitGenesis(ctx context.Context, ak types.AccountKeeper, bk types.BankKeepe
 			if err != nil {
 				return err
 			}
+			// rebuild the live tally from the votes, the vote delegations and the delegations
+			if err := k.RebuildLiveTally(ctx, proposal.Id); err != nil {
+				return err
+			}
 		}
 		if err := k.Proposals.Set(ctx, proposal.Id, *proposal); err != nil {
 			return err
 		}
 	}
 
+	for _, executionResult := range data.ProposalExecutionResults {
+		if err := k.ExecutionResults.Set(ctx, executionResult.ProposalId, *executionResult); err != nil {
+			return err
+		}
+	}
+
 	// if account has zero balance it probably means it's not set, so we set it
 	balance := bk.GetAllBalances(ctx, moduleAcc.GetAddress())
 	if balance.IsZero() {
@@ -131,12 +156,34 @@ func ExportGenesis(ctx context.Context, k *keeper.Keeper) (*v1.GenesisState, err
 		return nil, err
 	}
 
+	// export vote delegations
+	var voteDelegations []*v1.VoteDelegation
+	err = k.VoteDelegations.Walk(ctx, nil, func(_ sdk.AccAddress, value v1.VoteDelegation) (stop bool, err error) {
+		voteDelegations = append(voteDelegations, &value)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// export proposal execution results
+	var executionResults []*v1.ProposalExecutionResult
+	err = k.ExecutionResults.Walk(ctx, nil, func(_ uint64, value v1.ProposalExecutionResult) (stop bool, err error) {
+		executionResults = append(executionResults, &value)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &v1.GenesisState{
-		StartingProposalId: startingProposalID,
-		Deposits:           proposalsDeposits,
-		Votes:              proposalsVotes,
-		Proposals:          proposals,
-		Params:             &params,
-		Constitution:       constitution,
+		StartingProposalId:       startingProposalID,
+		Deposits:                 proposalsDeposits,
+		Votes:                    proposalsVotes,
+		Proposals:                proposals,
+		Params:                   &params,
+		Constitution:             constitution,
+		VoteDelegations:          voteDelegations,
+		ProposalExecutionResults: executionResults,
 	}, nil
 }
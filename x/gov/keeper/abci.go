@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"crypto/sha256"
 	"errors"
 	"fmt"
 	"time"
@@ -139,7 +140,10 @@ func (k Keeper) EndBlocker(ctx context.Context) error {
 			return err
 		}
 
-		var tagValue, logMsg string
+		var (
+			tagValue, logMsg string
+			executed         bool
+		)
 
 		passes, burnDeposits, tallyResults, err := k.Tally(ctx, proposal)
 		if err != nil {
@@ -195,18 +199,31 @@ func (k Keeper) EndBlocker(ctx context.Context) error {
 			// Messages may mutate state thus we use a cached context. If one of
 			// the handlers fails, no state mutation is written and the error
 			// message is logged.
-			if err := k.environment.BranchService.Execute(ctx, func(ctx context.Context) error {
+			// The execution result of each executed message is recorded.
+			// We do not anything with the execution error. Returning an error halts the chain, and proposal struct is already updated.
+			executionResult := v1.ProposalExecutionResult{ProposalId: proposal.Id}
+			_ = k.environment.BranchService.Execute(ctx, func(ctx context.Context) error {
 				// execute all messages
 				for idx, msg = range messages {
-					if _, err := safeExecuteHandler(ctx, msg, k.environment.RouterService.MessageRouterService()); err != nil {
+					msgResult := &v1.MessageExecutionResult{MsgTypeUrl: sdk.MsgTypeURL(msg)}
+					executionResult.MessageResults = append(executionResult.MessageResults, msgResult)
+
+					res, err := safeExecuteHandler(ctx, msg, k.environment.RouterService.MessageRouterService())
+					if err == nil {
+						msgResult.ResponseDigest, err = k.responseDigest(res)
+					}
+					if err != nil {
 						// `idx` and `err` are populated with the msg index and error.
 						proposal.Status = v1.StatusFailed
 						proposal.FailedReason = err.Error()
 						tagValue = types.AttributeValueProposalFailed
 						logMsg = fmt.Sprintf("passed, but msg %d (%s) failed on execution: %s", idx, sdk.MsgTypeURL(msg), err)
+						msgResult.Error = err.Error()
 
 						return err
 					}
+
+					msgResult.Success = true
 				}
 
 				proposal.Status = v1.StatusPassed
@@ -214,9 +231,13 @@ func (k Keeper) EndBlocker(ctx context.Context) error {
 				logMsg = "passed"
 
 				return nil
-			}); err != nil {
-				break // We do not anything with the error. Returning an error halts the chain, and proposal struct is already updated.
+			})
+
+			if err := k.ExecutionResults.Set(ctx, proposal.Id, executionResult); err != nil {
+				return err
 			}
+
+			executed = true
 		case !burnDeposits && (proposal.ProposalType == v1.ProposalType_PROPOSAL_TYPE_EXPEDITED ||
 			proposal.ProposalType == v1.ProposalType_PROPOSAL_TYPE_OPTIMISTIC):
 			// When a non spammy expedited/optimistic proposal fails, it is converted
@@ -265,6 +286,16 @@ func (k Keeper) EndBlocker(ctx context.Context) error {
 			logger.Error("failed to execute AfterProposalVotingPeriodEnded hook", "error", err)
 		}
 
+		// call hook when the messages of a passed proposal have been executed
+		if executed {
+			if err := k.environment.BranchService.Execute(ctx, func(ctx context.Context) error {
+				return k.Hooks().AfterProposalExecuted(ctx, proposal.Id)
+			}); err != nil {
+				// purposely ignoring the error here not to halt the chain if the hook fails
+				logger.Error("failed to execute AfterProposalExecuted hook", "error", err)
+			}
+		}
+
 		logger.Info(
 			"proposal tallied",
 			"proposal", proposal.Id,
@@ -297,6 +328,21 @@ func safeExecuteHandler(ctx context.Context, msg sdk.Msg, router router.Router)
 	return
 }
 
+// responseDigest returns the SHA-256 digest of the encoded message response.
+func (k Keeper) responseDigest(res protoiface.MessageV1) ([]byte, error) {
+	if res == nil {
+		return nil, nil
+	}
+
+	bz, err := k.cdc.Marshal(res)
+	if err != nil {
+		return nil, err
+	}
+
+	digest := sha256.Sum256(bz)
+	return digest[:], nil
+}
+
 // failUnsupportedProposal fails a proposal that cannot be processed by gov
 func failUnsupportedProposal(
 	logger log.Logger,
@@ -148,7 +148,7 @@ func (k Keeper) AddDeposit(ctx context.Context, proposalID uint64, depositorAddr
 
 	// Check if deposit has provided sufficient total funds to transition the proposal into the voting period
 	activatedVotingPeriod := false
-	if proposal.Status == v1.StatusDepositPeriod && sdk.NewCoins(proposal.TotalDeposit...).IsAllGTE(minDepositAmount) {
+	if proposal.Status == v1.StatusDepositPeriod && isMinDepositReached(params, sdk.NewCoins(proposal.TotalDeposit...), minDepositAmount) {
 		err = k.ActivateVotingPeriod(ctx, proposal)
 		if err != nil {
 			return false, err
@@ -317,12 +317,29 @@ func (k Keeper) validateInitialDeposit(params v1.Params, initialDeposit sdk.Coin
 	for i := range minDepositCoins {
 		minDepositCoins[i].Amount = sdkmath.LegacyNewDecFromInt(minDepositCoins[i].Amount).Mul(minInitialDepositRatio).RoundInt()
 	}
-	if !initialDeposit.IsAllGTE(minDepositCoins) {
+	if !isMinDepositReached(params, initialDeposit, minDepositCoins) {
 		return errors.Wrapf(types.ErrMinDepositTooSmall, "was (%s), need (%s)", initialDeposit, minDepositCoins)
 	}
 	return nil
 }
 
+// isMinDepositReached returns whether the deposit reaches the given minimum deposit.
+// When MinDepositAnyDenom is set, reaching the minimum amount of a single denom is enough,
+// otherwise the minimum amount of every denom must be reached.
+func isMinDepositReached(params v1.Params, deposit, minDeposit sdk.Coins) bool {
+	if !params.MinDepositAnyDenom {
+		return deposit.IsAllGTE(minDeposit)
+	}
+
+	for _, coin := range minDeposit {
+		if deposit.AmountOf(coin.Denom).GTE(coin.Amount) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // validateDepositDenom validates if the deposit denom is accepted by the governance module.
 func (k Keeper) validateDepositDenom(params v1.Params, depositAmount sdk.Coins) error {
 	denoms := []string{}
@@ -247,12 +247,55 @@ func TestDepositAmount(t *testing.T) {
 	}
 }
 
+func TestDepositActivationMinDepositAnyDenom(t *testing.T) {
+	testcases := map[string]struct {
+		minDepositAnyDenom bool
+		expActivated       bool
+	}{
+		"min deposit reached in a single denom: not activated": {
+			minDepositAnyDenom: false,
+			expActivated:       false,
+		},
+		"min deposit reached in a single denom, any denom: activated": {
+			minDepositAnyDenom: true,
+			expActivated:       true,
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			govKeeper, mocks, _, ctx := setupGovKeeper(t)
+			authKeeper, bankKeeper, stakingKeeper := mocks.acctKeeper, mocks.bankKeeper, mocks.stakingKeeper
+			err := trackMockBalances(bankKeeper)
+			require.NoError(t, err)
+
+			testAddrs := simtestutil.AddTestAddrsIncremental(bankKeeper, stakingKeeper, ctx, 2, sdkmath.NewInt(1000000000000000))
+			authKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
+
+			params, _ := govKeeper.Params.Get(ctx)
+			params.MinDeposit = sdk.NewCoins(params.MinDeposit...).Add(sdk.NewCoin("zcoin", sdkmath.NewInt(10000))) // coins must be sorted by denom
+			params.MinDepositAnyDenom = tc.minDepositAnyDenom
+			err = govKeeper.Params.Set(ctx, params)
+			require.NoError(t, err)
+
+			proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", testAddrs[0], v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+			require.NoError(t, err)
+
+			deposit := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.Coins(params.MinDeposit).AmountOf(sdk.DefaultBondDenom)))
+			activated, err := govKeeper.AddDeposit(ctx, proposal.Id, testAddrs[0], deposit)
+			require.NoError(t, err)
+			require.Equal(t, tc.expActivated, activated)
+		})
+	}
+}
+
 func TestValidateInitialDeposit(t *testing.T) {
 	testcases := map[string]struct {
 		minDeposit               sdk.Coins
 		minInitialDepositPercent int64
 		initialDeposit           sdk.Coins
 		expedited                bool
+		minDepositAnyDenom       bool
 
 		expectError bool
 	}{
@@ -326,6 +369,27 @@ func TestValidateInitialDeposit(t *testing.T) {
 			initialDeposit:           sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(baseDepositTestAmount*baseDepositTestPercent/100))),
 			expedited:                true,
 		},
+		"min deposit * initial percent == initial deposit in a single denom (multiple coins, any denom): success": {
+			minDeposit: sdk.NewCoins(
+				sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(baseDepositTestAmount)),
+				sdk.NewCoin("uosmo", sdkmath.NewInt(baseDepositTestAmount*2))),
+			minInitialDepositPercent: baseDepositTestPercent,
+			initialDeposit:           sdk.NewCoins(sdk.NewCoin("uosmo", sdkmath.NewInt(baseDepositTestAmount*2*baseDepositTestPercent/100))),
+			minDepositAnyDenom:       true,
+		},
+		"min deposit * initial percent > initial deposit in every denom (multiple coins, any denom): error": {
+			minDeposit: sdk.NewCoins(
+				sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(baseDepositTestAmount)),
+				sdk.NewCoin("uosmo", sdkmath.NewInt(baseDepositTestAmount*2))),
+			minInitialDepositPercent: baseDepositTestPercent,
+			initialDeposit: sdk.NewCoins(
+				sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(baseDepositTestAmount*baseDepositTestPercent/100-1)),
+				sdk.NewCoin("uosmo", sdkmath.NewInt(baseDepositTestAmount*2*baseDepositTestPercent/100-1)),
+			),
+			minDepositAnyDenom: true,
+
+			expectError: true,
+		},
 		"expedited - 0 initial percent: success": {
 			minDeposit:               sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(baseDepositTestAmount))),
 			minInitialDepositPercent: 0,
@@ -345,6 +409,7 @@ func TestValidateInitialDeposit(t *testing.T) {
 				params.MinDeposit = tc.minDeposit
 			}
 			params.MinInitialDepositRatio = sdkmath.LegacyNewDec(tc.minInitialDepositPercent).Quo(sdkmath.LegacyNewDec(100)).String()
+			params.MinDepositAnyDenom = tc.minDepositAnyDenom
 
 			err := govKeeper.Params.Set(ctx, params)
 			require.NoError(t, err)
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"cosmossdk.io/math"
 	v1 "cosmossdk.io/x/gov/types/v1"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -16,3 +17,9 @@ func (k Keeper) ValidateInitialDeposit(ctx sdk.Context, initialDeposit sdk.Coins
 
 	return k.validateInitialDeposit(params, initialDeposit, proposalType)
 }
+
+// LiveTallyResults is a helper function used only in live tally tests which returns the same
+// functionality of liveTallyResults private function.
+func (k Keeper) LiveTallyResults(ctx sdk.Context, proposalID uint64, validators map[string]v1.ValidatorGovInfo) (math.LegacyDec, map[v1.VoteOption]math.LegacyDec, error) {
+	return k.liveTallyResults(ctx, proposalID, validators)
+}
@@ -186,6 +186,89 @@ func (q queryServer) Vote(ctx context.Context, req *v1.QueryVoteRequest) (*v1.Qu
 	return nil, status.Error(codes.Internal, err.Error())
 }
 
+// VoteDelegation returns the governance vote delegation of a delegator
+func (q queryServer) VoteDelegation(ctx context.Context, req *v1.QueryVoteDelegationRequest) (*v1.QueryVoteDelegationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Delegator == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+
+	delegator, err := q.k.authKeeper.AddressCodec().StringToBytes(req.Delegator)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	voteDelegation, err := q.k.VoteDelegations.Get(ctx, delegator)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "vote delegation for %s not found", req.Delegator)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryVoteDelegationResponse{VoteDelegation: &voteDelegation}, nil
+}
+
+// LiveTally returns the tally of a proposal in voting period, computed from its live tally with the current validators
+func (q queryServer) LiveTally(ctx context.Context, req *v1.QueryLiveTallyRequest) (*v1.QueryLiveTallyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	proposal, err := q.k.Proposals.Get(ctx, req.ProposalId)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "proposal %d doesn't exist", req.ProposalId)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if proposal.Status != v1.StatusVotingPeriod {
+		return nil, status.Errorf(codes.FailedPrecondition, "proposal %d is not in voting period", req.ProposalId)
+	}
+
+	validators, err := q.k.getCurrentValidators(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	_, results, err := q.k.liveTallyResults(ctx, req.ProposalId, validators)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	tally := v1.NewTallyResultFromMap(results)
+	return &v1.QueryLiveTallyResponse{Tally: &tally}, nil
+}
+
+// ProposalExecutionResult returns the execution result of the messages of a passed proposal
+func (q queryServer) ProposalExecutionResult(ctx context.Context, req *v1.QueryProposalExecutionResultRequest) (*v1.QueryProposalExecutionResultResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	executionResult, err := q.k.ExecutionResults.Get(ctx, req.ProposalId)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "execution result for proposal %d not found", req.ProposalId)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryProposalExecutionResultResponse{ExecutionResult: &executionResult}, nil
+}
+
 // Votes returns single proposal's votes
 func (q queryServer) Votes(ctx context.Context, req *v1.QueryVotesRequest) (*v1.QueryVotesResponse, error) {
 	if req == nil {
@@ -316,7 +399,7 @@ func (q queryServer) TallyResult(ctx context.Context, req *v1.QueryTallyResultRe
 	var tallyResult v1.TallyResult
 
 	switch {
-	case proposal.Status == v1.StatusDepositPeriod:
+	case proposal.Status == v1.StatusDepositPeriod || proposal.Status == v1.StatusCanceled:
 		tallyResult = v1.EmptyTallyResult()
 
 	case proposal.Status == v1.StatusPassed || proposal.Status == v1.StatusRejected || proposal.Status == v1.StatusFailed:
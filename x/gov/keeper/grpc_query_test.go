@@ -2041,3 +2041,67 @@ func (suite *KeeperTestSuite) TestProposalVoteOptions() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestProposalExecutionResult() {
+	suite.reset()
+
+	executionResult := v1.ProposalExecutionResult{
+		ProposalId: 1,
+		MessageResults: []*v1.MessageExecutionResult{
+			{
+				MsgTypeUrl:     "/cosmos.bank.v1beta1.MsgSend",
+				Success:        true,
+				ResponseDigest: []byte("digest"),
+			},
+			{
+				MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend",
+				Error:      "insufficient funds",
+			},
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		req      *v1.QueryProposalExecutionResultRequest
+		expResp  *v1.QueryProposalExecutionResultResponse
+		errStr   string
+	}{
+		{
+			name:   "invalid proposal id",
+			req:    &v1.QueryProposalExecutionResultRequest{},
+			errStr: "proposal id can not be 0",
+		},
+		{
+			name:   "execution result not found",
+			req:    &v1.QueryProposalExecutionResultRequest{ProposalId: 1},
+			errStr: "execution result for proposal 1 not found",
+		},
+		{
+			name: "valid request",
+			malleate: func() {
+				err := suite.govKeeper.ExecutionResults.Set(suite.ctx, executionResult.ProposalId, executionResult)
+				suite.Require().NoError(err)
+			},
+			req:     &v1.QueryProposalExecutionResultRequest{ProposalId: 1},
+			expResp: &v1.QueryProposalExecutionResultResponse{ExecutionResult: &executionResult},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			if tc.malleate != nil {
+				tc.malleate()
+			}
+
+			resp, err := suite.queryClient.ProposalExecutionResult(suite.ctx, tc.req)
+			if tc.errStr != "" {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.errStr)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expResp, resp)
+			}
+		})
+	}
+}
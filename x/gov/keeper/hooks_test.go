@@ -25,6 +25,7 @@ type MockGovHooksReceiver struct {
 	AfterProposalVoteValid              bool
 	AfterProposalFailedMinDepositValid  bool
 	AfterProposalVotingPeriodEndedValid bool
+	AfterProposalExecutedValid          bool
 }
 
 func (h *MockGovHooksReceiver) AfterProposalSubmission(ctx context.Context, proposalID uint64) error {
@@ -52,6 +53,11 @@ func (h *MockGovHooksReceiver) AfterProposalVotingPeriodEnded(ctx context.Contex
 	return nil
 }
 
+func (h *MockGovHooksReceiver) AfterProposalExecuted(ctx context.Context, proposalID uint64) error {
+	h.AfterProposalExecutedValid = true
+	return nil
+}
+
 func TestHooks(t *testing.T) {
 	minDeposit := v1.DefaultParams().MinDeposit
 	govKeeper, mocks, _, ctx := setupGovKeeper(t)
@@ -72,6 +78,7 @@ func TestHooks(t *testing.T) {
 	require.False(t, govHooksReceiver.AfterProposalVoteValid)
 	require.False(t, govHooksReceiver.AfterProposalFailedMinDepositValid)
 	require.False(t, govHooksReceiver.AfterProposalVotingPeriodEndedValid)
+	require.False(t, govHooksReceiver.AfterProposalExecutedValid)
 
 	tp := TestProposal
 	_, err := govKeeper.SubmitProposal(ctx, tp, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), v1.ProposalType_PROPOSAL_TYPE_STANDARD)
@@ -105,4 +112,5 @@ func TestHooks(t *testing.T) {
 	err = govKeeper.EndBlocker(ctx)
 	require.NoError(t, err)
 	require.True(t, govHooksReceiver.AfterProposalVotingPeriodEndedValid)
+	require.False(t, govHooksReceiver.AfterProposalExecutedValid) // proposal did not pass
 }
@@ -7,8 +7,11 @@ import (
 	"time"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
+	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/gov/types"
 	v1 "cosmossdk.io/x/gov/types/v1"
 	"cosmossdk.io/x/gov/types/v1beta1"
@@ -67,6 +70,45 @@ type Keeper struct {
 	ActiveProposalsQueue collections.Map[collections.Pair[time.Time, uint64], uint64] // TODO(tip): this should be simplified and go into an index.
 	// InactiveProposalsQueue key: depositEndTime+proposalID | value: proposalID
 	InactiveProposalsQueue collections.Map[collections.Pair[time.Time, uint64], uint64] // TODO(tip): this should be simplified and go into an index.
+	// VoteDelegations key: delegatorAddr | value: VoteDelegation
+	// It is indexed by delegate, so that the tally only ranges over the delegations to the voters
+	VoteDelegations *collections.IndexedMap[sdk.AccAddress, v1.VoteDelegation, VoteDelegationsIndexes]
+	// ExecutionResults key: proposalID | value: ProposalExecutionResult
+	// This is used to store the execution results of the messages of passed proposals
+	ExecutionResults collections.Map[uint64, v1.ProposalExecutionResult]
+	// LiveTallies key: proposalID+valAddr+option | value: shares
+	// This is used to store the incremental tally of proposals in voting period: the delegation shares of the
+	// counted voters, weighted by their vote option, and in total under VOTE_OPTION_UNSPECIFIED
+	LiveTallies collections.Map[collections.Triple[uint64, sdk.ValAddress, int32], math.LegacyDec]
+	// LiveTallyShares key: proposalID+voterAddr+valAddr | value: shares
+	// This is used to store the delegation shares of each voter counted in LiveTallies
+	LiveTallyShares collections.Map[collections.Triple[uint64, sdk.AccAddress, sdk.ValAddress], math.LegacyDec]
+}
+
+// VoteDelegationsIndexes defines the indexes of the vote delegations.
+type VoteDelegationsIndexes struct {
+	// Delegate key: delegateAddr+delegatorAddr
+	Delegate *indexes.Multi[sdk.AccAddress, sdk.AccAddress, v1.VoteDelegation]
+}
+
+// IndexesList implements collections.Indexes.
+func (i VoteDelegationsIndexes) IndexesList() []collections.Index[sdk.AccAddress, v1.VoteDelegation] {
+	return []collections.Index[sdk.AccAddress, v1.VoteDelegation]{i.Delegate}
+}
+
+func newVoteDelegationsIndexes(sb *collections.SchemaBuilder, addressCodec address.Codec) VoteDelegationsIndexes {
+	return VoteDelegationsIndexes{
+		Delegate: indexes.NewMulti(
+			sb,
+			types.VoteDelegationsByDelegateKey,
+			"vote_delegations_by_delegate",
+			sdk.AccAddressKey,
+			sdk.AccAddressKey,
+			func(_ sdk.AccAddress, voteDelegation v1.VoteDelegation) (sdk.AccAddress, error) {
+				return addressCodec.StringToBytes(voteDelegation.Delegate)
+			},
+		),
+	}
 }
 
 // GetAuthority returns the x/gov module's authority.
@@ -129,6 +171,10 @@ func NewKeeper(
 		ProposalVoteOptions:    collections.NewMap(sb, types.ProposalVoteOptionsKeyPrefix, "proposal_vote_options", collections.Uint64Key, codec.CollValue[v1.ProposalVoteOptions](cdc)),
 		ActiveProposalsQueue:   collections.NewMap(sb, types.ActiveProposalQueuePrefix, "active_proposals_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key), collections.Uint64Value),     // sdk.TimeKey is needed to retain state compatibility
 		InactiveProposalsQueue: collections.NewMap(sb, types.InactiveProposalQueuePrefix, "inactive_proposals_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key), collections.Uint64Value), // sdk.TimeKey is needed to retain state compatibility
+		VoteDelegations:        collections.NewIndexedMap(sb, types.VoteDelegationsKeyPrefix, "vote_delegations", sdk.AccAddressKey, codec.CollValue[v1.VoteDelegation](cdc), newVoteDelegationsIndexes(sb, authKeeper.AddressCodec())),
+		ExecutionResults:       collections.NewMap(sb, types.ExecutionResultsKeyPrefix, "execution_results", collections.Uint64Key, codec.CollValue[v1.ProposalExecutionResult](cdc)),
+		LiveTallies:            collections.NewMap(sb, types.LiveTalliesKeyPrefix, "live_tallies", collections.TripleKeyCodec(collections.Uint64Key, sdk.ValAddressKey, collections.Int32Key), sdk.LegacyDecValue),
+		LiveTallyShares:        collections.NewMap(sb, types.LiveTallySharesKeyPrefix, "live_tally_shares", collections.TripleKeyCodec(collections.Uint64Key, sdk.AccAddressKey, sdk.ValAddressKey), sdk.LegacyDecValue),
 	}
 	schema, err := sb.Build()
 	if err != nil {
@@ -0,0 +1,438 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	v1 "cosmossdk.io/x/gov/types/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// The live tally of a proposal in voting period counts, for each validator, the delegation shares of the voters
+// weighted by their vote options, and the total shares of the voters under OptionEmpty. It is kept up to date on
+// each vote, vote delegation and delegation change of the voters, so that the tally only has to convert the shares
+// to voting power with the current validators instead of iterating over all votes and delegations.
+
+// effectiveVoteOptions returns the options the shares of voter are counted with in the live tally of a proposal:
+// the options of its vote or, if it did not vote, of the vote of its delegate. Vote delegations are not transitive.
+// It returns nil if the shares of voter are not counted.
+func (k Keeper) effectiveVoteOptions(ctx context.Context, proposalID uint64, voter sdk.AccAddress) ([]*v1.WeightedVoteOption, error) {
+	vote, err := k.Votes.Get(ctx, collections.Join(proposalID, voter))
+	if err == nil {
+		return vote.Options, nil
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+
+	voteDelegation, err := k.VoteDelegations.Get(ctx, voter)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	delegate, err := k.authKeeper.AddressCodec().StringToBytes(voteDelegation.Delegate)
+	if err != nil {
+		return nil, err
+	}
+
+	return k.voteOptions(ctx, proposalID, delegate)
+}
+
+// voteOptions returns the options of the vote of voter on a proposal, nil if it did not vote.
+func (k Keeper) voteOptions(ctx context.Context, proposalID uint64, voter sdk.AccAddress) ([]*v1.WeightedVoteOption, error) {
+	vote, err := k.Votes.Get(ctx, collections.Join(proposalID, voter))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return vote.Options, nil
+}
+
+// countVote updates the live tally of a proposal with a new vote of voter, before it is stored: the shares of voter,
+// and of its delegators that did not vote, are counted with options instead of their previous ones.
+func (k Keeper) countVote(ctx context.Context, proposalID uint64, voter sdk.AccAddress, options []*v1.WeightedVoteOption) error {
+	prevOptions, err := k.effectiveVoteOptions(ctx, proposalID, voter)
+	if err != nil {
+		return err
+	}
+	// the delegators that did not vote are counted with the previous vote of voter, if any
+	prevVoteOptions, err := k.voteOptions(ctx, proposalID, voter)
+	if err != nil {
+		return err
+	}
+
+	if err := k.recountVoter(ctx, proposalID, voter, prevOptions, options); err != nil {
+		return err
+	}
+
+	iter, err := k.VoteDelegations.Indexes.Delegate.MatchExact(ctx, voter)
+	if err != nil {
+		return err
+	}
+	delegators, err := iter.PrimaryKeys()
+	if err != nil {
+		return err
+	}
+
+	for _, delegator := range delegators {
+		voted, err := k.Votes.Has(ctx, collections.Join(proposalID, delegator))
+		if err != nil {
+			return err
+		}
+		if voted {
+			// a direct vote overrides the vote delegation
+			continue
+		}
+
+		if err := k.recountVoter(ctx, proposalID, delegator, prevVoteOptions, options); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// countVoteDelegation updates the live tallies of the proposals in voting period with a change of the delegate of
+// delegator from prevDelegate to delegate, either of which may be empty.
+func (k Keeper) countVoteDelegation(ctx context.Context, delegator, prevDelegate, delegate sdk.AccAddress) error {
+	return k.ActiveProposalsQueue.Walk(ctx, nil, func(_ collections.Pair[time.Time, uint64], proposalID uint64) (bool, error) {
+		voted, err := k.Votes.Has(ctx, collections.Join(proposalID, delegator))
+		if err != nil || voted {
+			// a direct vote overrides the vote delegation
+			return err != nil, err
+		}
+
+		var prevOptions, options []*v1.WeightedVoteOption
+		if !prevDelegate.Empty() {
+			prevOptions, err = k.voteOptions(ctx, proposalID, prevDelegate)
+			if err != nil {
+				return true, err
+			}
+		}
+		if !delegate.Empty() {
+			options, err = k.voteOptions(ctx, proposalID, delegate)
+			if err != nil {
+				return true, err
+			}
+		}
+
+		return false, k.recountVoter(ctx, proposalID, delegator, prevOptions, options)
+	})
+}
+
+// countDelegation updates the live tallies of the proposals in voting period counting the shares of delegator with
+// the new shares of its delegation to valAddr, zero if the delegation is removed.
+func (k Keeper) countDelegation(ctx context.Context, delegator sdk.AccAddress, valAddr sdk.ValAddress, shares math.LegacyDec) error {
+	return k.ActiveProposalsQueue.Walk(ctx, nil, func(_ collections.Pair[time.Time, uint64], proposalID uint64) (bool, error) {
+		options, err := k.effectiveVoteOptions(ctx, proposalID, delegator)
+		if err != nil || options == nil {
+			return err != nil, err
+		}
+
+		key := collections.Join3(proposalID, delegator, valAddr)
+		prevShares, err := k.LiveTallyShares.Get(ctx, key)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return true, err
+		} else if err == nil {
+			if err := k.updateLiveTally(ctx, proposalID, valAddr, prevShares.Neg(), options); err != nil {
+				return true, err
+			}
+		}
+
+		if shares.IsZero() {
+			return false, k.LiveTallyShares.Remove(ctx, key)
+		}
+		if err := k.updateLiveTally(ctx, proposalID, valAddr, shares, options); err != nil {
+			return true, err
+		}
+		return false, k.LiveTallyShares.Set(ctx, key, shares)
+	})
+}
+
+// recountVoter counts the shares of voter in the live tally of a proposal with options instead of prevOptions,
+// either of which may be nil. The delegations of voter are only iterated if its shares were not counted yet, the
+// recorded shares being kept up to date by the staking hooks otherwise.
+func (k Keeper) recountVoter(ctx context.Context, proposalID uint64, voter sdk.AccAddress, prevOptions, options []*v1.WeightedVoteOption) error {
+	switch {
+	case prevOptions == nil:
+		return k.countVoter(ctx, proposalID, voter, options)
+	case options == nil:
+		return k.uncountVoter(ctx, proposalID, voter, prevOptions)
+	}
+
+	rng := collections.NewSuperPrefixedTripleRange[uint64, sdk.AccAddress, sdk.ValAddress](proposalID, voter)
+	iter, err := k.LiveTallyShares.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+	kvs, err := iter.KeyValues()
+	if err != nil {
+		return err
+	}
+
+	for _, kv := range kvs {
+		if err := k.updateLiveTally(ctx, proposalID, kv.Key.K3(), kv.Value.Neg(), prevOptions); err != nil {
+			return err
+		}
+		if err := k.updateLiveTally(ctx, proposalID, kv.Key.K3(), kv.Value, options); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// countVoter counts the shares of the delegations of voter in the live tally of a proposal with options.
+func (k Keeper) countVoter(ctx context.Context, proposalID uint64, voter sdk.AccAddress, options []*v1.WeightedVoteOption) error {
+	if options == nil {
+		return nil
+	}
+
+	var (
+		valAddrs []sdk.ValAddress
+		shares   []math.LegacyDec
+		iterErr  error
+	)
+	err := k.sk.IterateDelegations(ctx, voter, func(_ int64, delegation sdk.DelegationI) (stop bool) {
+		valAddr, err := k.sk.ValidatorAddressCodec().StringToBytes(delegation.GetValidatorAddr())
+		if err != nil {
+			iterErr = err
+			return true
+		}
+
+		valAddrs = append(valAddrs, valAddr)
+		shares = append(shares, delegation.GetShares())
+		return false
+	})
+	if err != nil {
+		return err
+	}
+	if iterErr != nil {
+		return iterErr
+	}
+
+	for i, valAddr := range valAddrs {
+		if err := k.updateLiveTally(ctx, proposalID, valAddr, shares[i], options); err != nil {
+			return err
+		}
+		if err := k.LiveTallyShares.Set(ctx, collections.Join3(proposalID, voter, valAddr), shares[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// votingPower returns the voting power of the shares of voter counted in the live tally of a proposal with the
+// current bonded validators. Contrary to the tally, it does not take into account inherited validator votes.
+func (k Keeper) votingPower(ctx context.Context, proposalID uint64, voter sdk.AccAddress) (math.LegacyDec, error) {
+	votingPower := math.LegacyZeroDec()
+
+	rng := collections.NewSuperPrefixedTripleRange[uint64, sdk.AccAddress, sdk.ValAddress](proposalID, voter)
+	err := k.LiveTallyShares.Walk(ctx, rng, func(key collections.Triple[uint64, sdk.AccAddress, sdk.ValAddress], shares math.LegacyDec) (bool, error) {
+		validator, err := k.sk.Validator(ctx, key.K3())
+		if err != nil {
+			return true, err
+		}
+
+		if !validator.IsBonded() || validator.GetDelegatorShares().IsZero() {
+			return false, nil
+		}
+
+		// delegation shares * bonded / total shares
+		votingPower = votingPower.Add(shares.MulInt(validator.GetBondedTokens()).Quo(validator.GetDelegatorShares()))
+		return false, nil
+	})
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	return votingPower, nil
+}
+
+// uncountVoter removes the shares of voter counted in the live tally of a proposal with options.
+func (k Keeper) uncountVoter(ctx context.Context, proposalID uint64, voter sdk.AccAddress, options []*v1.WeightedVoteOption) error {
+	if options == nil {
+		return nil
+	}
+
+	rng := collections.NewSuperPrefixedTripleRange[uint64, sdk.AccAddress, sdk.ValAddress](proposalID, voter)
+	iter, err := k.LiveTallyShares.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+	kvs, err := iter.KeyValues()
+	if err != nil {
+		return err
+	}
+
+	for _, kv := range kvs {
+		if err := k.updateLiveTally(ctx, proposalID, kv.Key.K3(), kv.Value.Neg(), options); err != nil {
+			return err
+		}
+		if err := k.LiveTallyShares.Remove(ctx, kv.Key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// updateLiveTally adds shares of a validator, negative to remove them, to the live tally of a proposal with options.
+// The shares are removed with the same options they were added with, so that the live tally is updated exactly.
+func (k Keeper) updateLiveTally(ctx context.Context, proposalID uint64, valAddr sdk.ValAddress, shares math.LegacyDec, options []*v1.WeightedVoteOption) error {
+	if err := k.addLiveTallyShares(ctx, collections.Join3(proposalID, valAddr, int32(v1.OptionEmpty)), shares); err != nil {
+		return err
+	}
+
+	for _, option := range options {
+		weight, err := math.LegacyNewDecFromStr(option.Weight)
+		if err != nil {
+			return err
+		}
+		if err := k.addLiveTallyShares(ctx, collections.Join3(proposalID, valAddr, int32(option.Option)), shares.Mul(weight)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) addLiveTallyShares(ctx context.Context, key collections.Triple[uint64, sdk.ValAddress, int32], shares math.LegacyDec) error {
+	total, err := k.LiveTallies.Get(ctx, key)
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+		total = math.LegacyZeroDec()
+	}
+
+	total = total.Add(shares)
+	if total.IsZero() {
+		return k.LiveTallies.Remove(ctx, key)
+	}
+	return k.LiveTallies.Set(ctx, key, total)
+}
+
+// RebuildLiveTally counts the shares of the voters of a proposal, and of their delegators that did not vote, in its
+// live tally. It is used at genesis, once the votes, the vote delegations and the delegations are known.
+func (k Keeper) RebuildLiveTally(ctx context.Context, proposalID uint64) error {
+	if err := k.clearLiveTally(ctx, proposalID); err != nil {
+		return err
+	}
+
+	var voters []sdk.AccAddress
+	votes := make(map[string][]*v1.WeightedVoteOption)
+	rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposalID)
+	err := k.Votes.Walk(ctx, rng, func(key collections.Pair[uint64, sdk.AccAddress], vote v1.Vote) (bool, error) {
+		voters = append(voters, key.K2())
+		votes[string(key.K2())] = vote.Options
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, voter := range voters {
+		if err := k.countVoter(ctx, proposalID, voter, votes[string(voter)]); err != nil {
+			return err
+		}
+
+		iter, err := k.VoteDelegations.Indexes.Delegate.MatchExact(ctx, voter)
+		if err != nil {
+			return err
+		}
+		delegators, err := iter.PrimaryKeys()
+		if err != nil {
+			return err
+		}
+		for _, delegator := range delegators {
+			if _, ok := votes[string(delegator)]; ok {
+				continue
+			}
+			if err := k.countVoter(ctx, proposalID, delegator, votes[string(voter)]); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// clearLiveTally removes the live tally of a proposal.
+func (k Keeper) clearLiveTally(ctx context.Context, proposalID uint64) error {
+	err := k.LiveTallies.Clear(ctx, collections.NewPrefixedTripleRange[uint64, sdk.ValAddress, int32](proposalID))
+	if err != nil {
+		return err
+	}
+
+	return k.LiveTallyShares.Clear(ctx, collections.NewPrefixedTripleRange[uint64, sdk.AccAddress, sdk.ValAddress](proposalID))
+}
+
+// liveTallyResults converts the live tally of a proposal to voting power with the current bonded validators. The
+// validators that voted are counted with the voting power of their shares not counted for their delegators.
+func (k Keeper) liveTallyResults(ctx context.Context, proposalID uint64, validators map[string]v1.ValidatorGovInfo) (math.LegacyDec, map[v1.VoteOption]math.LegacyDec, error) {
+	totalVP := math.LegacyZeroDec()
+	results := createEmptyResults()
+
+	rng := collections.NewPrefixedTripleRange[uint64, sdk.ValAddress, int32](proposalID)
+	err := k.LiveTallies.Walk(ctx, rng, func(key collections.Triple[uint64, sdk.ValAddress, int32], shares math.LegacyDec) (bool, error) {
+		valAddrStr, err := k.sk.ValidatorAddressCodec().BytesToString(key.K2())
+		if err != nil {
+			return true, err
+		}
+
+		val, ok := validators[valAddrStr]
+		if !ok {
+			return false, nil
+		}
+
+		// shares * bonded / total shares
+		votingPower := shares.MulInt(val.BondedTokens).Quo(val.DelegatorShares)
+		if option := v1.VoteOption(key.K3()); option == v1.OptionEmpty {
+			val.DelegatorDeductions = val.DelegatorDeductions.Add(shares)
+			validators[valAddrStr] = val
+			totalVP = totalVP.Add(votingPower)
+		} else {
+			results[option] = results[option].Add(votingPower)
+		}
+
+		return false, nil
+	})
+	if err != nil {
+		return math.LegacyDec{}, nil, err
+	}
+
+	for valAddrStr, val := range validators {
+		options, err := k.voteOptions(ctx, proposalID, sdk.AccAddress(val.Address))
+		if err != nil {
+			return math.LegacyDec{}, nil, err
+		}
+		if len(options) == 0 {
+			continue
+		}
+		val.Vote = options
+		validators[valAddrStr] = val
+
+		sharesAfterDeductions := val.DelegatorShares.Sub(val.DelegatorDeductions)
+		votingPower := sharesAfterDeductions.MulInt(val.BondedTokens).Quo(val.DelegatorShares)
+
+		for _, option := range options {
+			weight, _ := math.LegacyNewDecFromStr(option.Weight)
+			subPower := votingPower.Mul(weight)
+			results[option.Option] = results[option.Option].Add(subPower)
+		}
+		totalVP = totalVP.Add(votingPower)
+	}
+
+	return totalVP, results, nil
+}
@@ -0,0 +1,175 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/gov/keeper"
+	v1 "cosmossdk.io/x/gov/types/v1"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestLiveTally(t *testing.T) {
+	govKeeper, mocks, _, ctx := setupGovKeeper(t, mockAccountKeeperExpectations)
+	queryServer := keeper.NewQueryServer(govKeeper)
+
+	addrs := simtestutil.CreateRandomAccounts(3)
+	voter, delegator, valAddr := addrs[0], addrs[1], sdk.ValAddress(addrs[2])
+
+	valCodec := address.NewBech32Codec("cosmosvaloper")
+	mocks.stakingKeeper.EXPECT().ValidatorAddressCodec().Return(valCodec).AnyTimes()
+	valAddrStr, err := valCodec.BytesToString(valAddr)
+	require.NoError(t, err)
+
+	// the voter has 42 shares of a bonded validator with 2 tokens per share
+	mocks.stakingKeeper.EXPECT().
+		IterateDelegations(ctx, voter, gomock.Any()).
+		DoAndReturn(
+			func(ctx context.Context, voter sdk.AccAddress, fn func(index int64, d sdk.DelegationI) bool) error {
+				fn(0, stakingtypes.Delegation{ValidatorAddress: valAddrStr, Shares: sdkmath.LegacyNewDec(42)})
+				return nil
+			}).
+		AnyTimes()
+	// the delegator has 8 shares of the same validator
+	mocks.stakingKeeper.EXPECT().
+		IterateDelegations(ctx, delegator, gomock.Any()).
+		DoAndReturn(
+			func(ctx context.Context, voter sdk.AccAddress, fn func(index int64, d sdk.DelegationI) bool) error {
+				fn(0, stakingtypes.Delegation{ValidatorAddress: valAddrStr, Shares: sdkmath.LegacyNewDec(8)})
+				return nil
+			}).
+		AnyTimes()
+	validator := stakingtypes.Validator{
+		OperatorAddress: valAddrStr,
+		Status:          stakingtypes.Bonded,
+		Tokens:          sdkmath.NewInt(2000000),
+		DelegatorShares: sdkmath.LegacyNewDec(1000000),
+	}
+	mocks.stakingKeeper.EXPECT().Validator(ctx, valAddr).Return(validator, nil).AnyTimes()
+	mocks.stakingKeeper.EXPECT().
+		IterateBondedValidatorsByPower(ctx, gomock.Any()).
+		DoAndReturn(
+			func(ctx context.Context, fn func(index int64, validator sdk.ValidatorI) bool) error {
+				fn(0, validator)
+				return nil
+			}).
+		AnyTimes()
+
+	proposal := v1.Proposal{Id: 1, Status: v1.StatusDepositPeriod, ProposalType: v1.ProposalType_PROPOSAL_TYPE_STANDARD}
+	require.NoError(t, govKeeper.Proposals.Set(ctx, proposal.Id, proposal))
+
+	_, err = queryServer.LiveTally(ctx, &v1.QueryLiveTallyRequest{ProposalId: 0})
+	require.ErrorContains(t, err, "proposal id can not be 0")
+	_, err = queryServer.LiveTally(ctx, &v1.QueryLiveTallyRequest{ProposalId: 2})
+	require.ErrorContains(t, err, "proposal 2 doesn't exist")
+	_, err = queryServer.LiveTally(ctx, &v1.QueryLiveTallyRequest{ProposalId: proposal.Id})
+	require.ErrorContains(t, err, "proposal 1 is not in voting period")
+
+	proposal.Status = v1.StatusVotingPeriod
+	require.NoError(t, govKeeper.Proposals.Set(ctx, proposal.Id, proposal))
+	require.NoError(t, govKeeper.ActiveProposalsQueue.Set(ctx, collections.Join(ctx.HeaderInfo().Time, proposal.Id), proposal.Id))
+
+	// no votes yet
+	res, err := queryServer.LiveTally(ctx, &v1.QueryLiveTallyRequest{ProposalId: proposal.Id})
+	require.NoError(t, err)
+	require.Equal(t, v1.EmptyTallyResult(), *res.Tally)
+
+	// the voting power is recorded in the vote and added to the live tally
+	require.NoError(t, govKeeper.AddVote(ctx, proposal.Id, voter, v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+	vote, err := govKeeper.Votes.Get(ctx, collections.Join(proposal.Id, voter))
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.LegacyNewDec(84).String(), vote.VotingPower)
+
+	res, err = queryServer.LiveTally(ctx, &v1.QueryLiveTallyRequest{ProposalId: proposal.Id})
+	require.NoError(t, err)
+	require.Equal(t, v1.NewTallyResult(sdkmath.NewInt(84), sdkmath.ZeroInt(), sdkmath.ZeroInt(), sdkmath.ZeroInt(), sdkmath.ZeroInt()), *res.Tally)
+
+	// a new vote replaces the contribution of the previous one
+	options := v1.WeightedVoteOptions{
+		v1.NewWeightedVoteOption(v1.OptionAbstain, sdkmath.LegacyNewDecWithPrec(5, 1)),
+		v1.NewWeightedVoteOption(v1.OptionNo, sdkmath.LegacyNewDecWithPrec(5, 1)),
+	}
+	require.NoError(t, govKeeper.AddVote(ctx, proposal.Id, voter, options, ""))
+
+	res, err = queryServer.LiveTally(ctx, &v1.QueryLiveTallyRequest{ProposalId: proposal.Id})
+	require.NoError(t, err)
+	require.Equal(t, v1.NewTallyResult(sdkmath.ZeroInt(), sdkmath.NewInt(42), sdkmath.NewInt(42), sdkmath.ZeroInt(), sdkmath.ZeroInt()), *res.Tally)
+
+	// the delegator delegating its vote is counted with the vote of its delegate
+	require.NoError(t, govKeeper.SetVoteDelegation(ctx, delegator, voter))
+	res, err = queryServer.LiveTally(ctx, &v1.QueryLiveTallyRequest{ProposalId: proposal.Id})
+	require.NoError(t, err)
+	require.Equal(t, v1.NewTallyResult(sdkmath.ZeroInt(), sdkmath.NewInt(50), sdkmath.NewInt(50), sdkmath.ZeroInt(), sdkmath.ZeroInt()), *res.Tally)
+
+	// a modified delegation is recounted through the staking hooks
+	mocks.stakingKeeper.EXPECT().
+		Delegation(ctx, voter, valAddr).
+		Return(stakingtypes.Delegation{ValidatorAddress: valAddrStr, Shares: sdkmath.LegacyNewDec(92)}, nil)
+	require.NoError(t, govKeeper.StakingHooks().AfterDelegationModified(ctx, voter, valAddr))
+	res, err = queryServer.LiveTally(ctx, &v1.QueryLiveTallyRequest{ProposalId: proposal.Id})
+	require.NoError(t, err)
+	require.Equal(t, v1.NewTallyResult(sdkmath.ZeroInt(), sdkmath.NewInt(100), sdkmath.NewInt(100), sdkmath.ZeroInt(), sdkmath.ZeroInt()), *res.Tally)
+
+	// removing the vote delegation uncounts the delegator
+	require.NoError(t, govKeeper.SetVoteDelegation(ctx, delegator, nil))
+	res, err = queryServer.LiveTally(ctx, &v1.QueryLiveTallyRequest{ProposalId: proposal.Id})
+	require.NoError(t, err)
+	require.Equal(t, v1.NewTallyResult(sdkmath.ZeroInt(), sdkmath.NewInt(92), sdkmath.NewInt(92), sdkmath.ZeroInt(), sdkmath.ZeroInt()), *res.Tally)
+
+	// a removed delegation is uncounted through the staking hooks
+	require.NoError(t, govKeeper.StakingHooks().BeforeDelegationRemoved(ctx, voter, valAddr))
+	res, err = queryServer.LiveTally(ctx, &v1.QueryLiveTallyRequest{ProposalId: proposal.Id})
+	require.NoError(t, err)
+	require.Equal(t, v1.EmptyTallyResult(), *res.Tally)
+}
+
+func TestRebuildLiveTally(t *testing.T) {
+	govKeeper, mocks, _, ctx := setupGovKeeper(t, mockAccountKeeperExpectations)
+
+	addrs := simtestutil.CreateRandomAccounts(3)
+	voter, delegator, valAddr := addrs[0], addrs[1], sdk.ValAddress(addrs[2])
+
+	valCodec := address.NewBech32Codec("cosmosvaloper")
+	mocks.stakingKeeper.EXPECT().ValidatorAddressCodec().Return(valCodec).AnyTimes()
+	valAddrStr, err := valCodec.BytesToString(valAddr)
+	require.NoError(t, err)
+
+	for addr, shares := range map[string]int64{string(voter): 42, string(delegator): 8} {
+		shares := shares
+		mocks.stakingKeeper.EXPECT().
+			IterateDelegations(ctx, sdk.AccAddress(addr), gomock.Any()).
+			DoAndReturn(
+				func(ctx context.Context, voter sdk.AccAddress, fn func(index int64, d sdk.DelegationI) bool) error {
+					fn(0, stakingtypes.Delegation{ValidatorAddress: valAddrStr, Shares: sdkmath.LegacyNewDec(shares)})
+					return nil
+				}).
+			Times(1)
+	}
+	validators := map[string]v1.ValidatorGovInfo{
+		valAddrStr: v1.NewValidatorGovInfo(valAddr, sdkmath.NewInt(2000000), sdkmath.LegacyNewDec(1000000), sdkmath.LegacyZeroDec(), v1.WeightedVoteOptions{}),
+	}
+
+	// votes and vote delegations as imported at genesis, without live tally
+	addrCodec := address.NewBech32Codec("cosmos")
+	voterStr, err := addrCodec.BytesToString(voter)
+	require.NoError(t, err)
+	delegatorStr, err := addrCodec.BytesToString(delegator)
+	require.NoError(t, err)
+	require.NoError(t, govKeeper.Votes.Set(ctx, collections.Join(uint64(1), voter), v1.NewVote(1, voterStr, v1.NewNonSplitVoteOption(v1.OptionYes), "")))
+	require.NoError(t, govKeeper.VoteDelegations.Set(ctx, delegator, v1.VoteDelegation{Delegator: delegatorStr, Delegate: voterStr}))
+
+	require.NoError(t, govKeeper.RebuildLiveTally(ctx, 1))
+	totalVP, results, err := govKeeper.LiveTallyResults(ctx, 1, validators)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.LegacyNewDec(100), totalVP)
+	require.Equal(t, sdkmath.LegacyNewDec(100), results[v1.OptionYes])
+}
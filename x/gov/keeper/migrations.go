@@ -2,7 +2,9 @@ package keeper
 
 import (
 	"context"
+	"time"
 
+	"cosmossdk.io/collections"
 	v5 "cosmossdk.io/x/gov/migrations/v5"
 	v6 "cosmossdk.io/x/gov/migrations/v6"
 )
@@ -43,3 +45,12 @@ func (m Migrator) Migrate4to5(ctx context.Context) error {
 func (m Migrator) Migrate5to6(ctx context.Context) error {
 	return v6.MigrateStore(ctx, m.keeper.environment.KVStoreService, m.keeper.Params, m.keeper.Proposals)
 }
+
+// Migrate6to7 migrates from version 6 to 7. It builds the live tallies of the
+// proposals in voting period, from which they are tallied, so that the votes
+// cast before the upgrade are counted.
+func (m Migrator) Migrate6to7(ctx context.Context) error {
+	return m.keeper.ActiveProposalsQueue.Walk(ctx, nil, func(_ collections.Pair[time.Time, uint64], proposalID uint64) (bool, error) {
+		return false, m.keeper.RebuildLiveTally(ctx, proposalID)
+	})
+}
@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/gov/keeper"
+	v1 "cosmossdk.io/x/gov/types/v1"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestMigrate6to7(t *testing.T) {
+	govKeeper, mocks, _, ctx := setupGovKeeper(t, mockAccountKeeperExpectations)
+
+	addrs := simtestutil.CreateRandomAccounts(3)
+	voter, delegator, valAddr := addrs[0], addrs[1], sdk.ValAddress(addrs[2])
+
+	valCodec := address.NewBech32Codec("cosmosvaloper")
+	mocks.stakingKeeper.EXPECT().ValidatorAddressCodec().Return(valCodec).AnyTimes()
+	valAddrStr, err := valCodec.BytesToString(valAddr)
+	require.NoError(t, err)
+
+	// the delegations are only read for the proposal in voting period
+	for addr, shares := range map[string]int64{string(voter): 42, string(delegator): 8} {
+		shares := shares
+		mocks.stakingKeeper.EXPECT().
+			IterateDelegations(ctx, sdk.AccAddress(addr), gomock.Any()).
+			DoAndReturn(
+				func(ctx context.Context, voter sdk.AccAddress, fn func(index int64, d sdk.DelegationI) bool) error {
+					fn(0, stakingtypes.Delegation{ValidatorAddress: valAddrStr, Shares: sdkmath.LegacyNewDec(shares)})
+					return nil
+				}).
+			Times(1)
+	}
+	validators := map[string]v1.ValidatorGovInfo{
+		valAddrStr: v1.NewValidatorGovInfo(valAddr, sdkmath.NewInt(2000000), sdkmath.LegacyNewDec(1000000), sdkmath.LegacyZeroDec(), v1.WeightedVoteOptions{}),
+	}
+
+	// votes cast before the upgrade, on a proposal in voting period and on one
+	// which is not anymore, without live tallies
+	addrCodec := address.NewBech32Codec("cosmos")
+	voterStr, err := addrCodec.BytesToString(voter)
+	require.NoError(t, err)
+	delegatorStr, err := addrCodec.BytesToString(delegator)
+	require.NoError(t, err)
+	require.NoError(t, govKeeper.ActiveProposalsQueue.Set(ctx, collections.Join(ctx.HeaderInfo().Time, uint64(1)), 1))
+	require.NoError(t, govKeeper.Votes.Set(ctx, collections.Join(uint64(1), voter), v1.NewVote(1, voterStr, v1.NewNonSplitVoteOption(v1.OptionYes), "")))
+	require.NoError(t, govKeeper.Votes.Set(ctx, collections.Join(uint64(2), voter), v1.NewVote(2, voterStr, v1.NewNonSplitVoteOption(v1.OptionNo), "")))
+	require.NoError(t, govKeeper.VoteDelegations.Set(ctx, delegator, v1.VoteDelegation{Delegator: delegatorStr, Delegate: voterStr}))
+
+	totalVP, _, err := govKeeper.LiveTallyResults(ctx, 1, validators)
+	require.NoError(t, err)
+	require.True(t, totalVP.IsZero())
+
+	require.NoError(t, keeper.NewMigrator(govKeeper).Migrate6to7(ctx))
+
+	totalVP, results, err := govKeeper.LiveTallyResults(ctx, 1, validators)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.LegacyNewDec(100), totalVP)
+	require.Equal(t, sdkmath.LegacyNewDec(100), results[v1.OptionYes])
+
+	totalVP, _, err = govKeeper.LiveTallyResults(ctx, 2, validators)
+	require.NoError(t, err)
+	require.True(t, totalVP.IsZero())
+}
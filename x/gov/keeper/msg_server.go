@@ -402,6 +402,36 @@ func (k msgServer) SudoExec(ctx context.Context, msg *v1.MsgSudoExec) (*v1.MsgSu
 	}, nil
 }
 
+// DelegateVote implements the MsgServer.DelegateVote method.
+func (k msgServer) DelegateVote(ctx context.Context, msg *v1.MsgDelegateVote) (*v1.MsgDelegateVoteResponse, error) {
+	delegator, err := k.authKeeper.AddressCodec().StringToBytes(msg.Delegator)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	var delegate []byte
+	if msg.Delegate != "" {
+		delegate, err = k.authKeeper.AddressCodec().StringToBytes(msg.Delegate)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegate address: %s", err)
+		}
+	}
+
+	if err := k.Keeper.SetVoteDelegation(ctx, delegator, delegate); err != nil {
+		return nil, err
+	}
+
+	if err := k.environment.EventService.EventManager(ctx).EmitKV(
+		govtypes.EventTypeDelegateVote,
+		event.NewAttribute(govtypes.AttributeKeyVoteDelegator, msg.Delegator),
+		event.NewAttribute(govtypes.AttributeKeyVoteDelegate, msg.Delegate),
+	); err != nil {
+		return nil, errors.Wrapf(err, "failed to emit event: %s", govtypes.EventTypeDelegateVote)
+	}
+
+	return &v1.MsgDelegateVoteResponse{}, nil
+}
+
 type legacyMsgServer struct {
 	govAcct string
 	server  v1.MsgServer
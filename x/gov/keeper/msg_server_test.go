@@ -4,6 +4,7 @@ import (
 	"strings"
 	"time"
 
+	"cosmossdk.io/collections"
 	sdkmath "cosmossdk.io/math"
 	banktypes "cosmossdk.io/x/bank/types"
 	v1 "cosmossdk.io/x/gov/types/v1"
@@ -483,6 +484,78 @@ func (suite *KeeperTestSuite) TestMsgCancelProposal() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestMsgDelegateVote() {
+	addrs := suite.addrs
+	delegatorAddr, err := suite.acctKeeper.AddressCodec().BytesToString(addrs[0])
+	suite.Require().NoError(err)
+	delegateAddr, err := suite.acctKeeper.AddressCodec().BytesToString(addrs[1])
+	suite.Require().NoError(err)
+
+	cases := []struct {
+		name      string
+		delegator string
+		delegate  string
+		expErr    bool
+		expErrMsg string
+		expFound  bool
+	}{
+		{
+			name:      "empty delegator",
+			delegator: "",
+			delegate:  delegateAddr,
+			expErr:    true,
+			expErrMsg: "invalid delegator address",
+		},
+		{
+			name:      "invalid delegate",
+			delegator: delegatorAddr,
+			delegate:  "invalid",
+			expErr:    true,
+			expErrMsg: "invalid delegate address",
+		},
+		{
+			name:      "delegate to self",
+			delegator: delegatorAddr,
+			delegate:  delegatorAddr,
+			expErr:    true,
+			expErrMsg: "cannot delegate voting power to self",
+		},
+		{
+			name:      "all good",
+			delegator: delegatorAddr,
+			delegate:  delegateAddr,
+			expFound:  true,
+		},
+		{
+			name:      "remove vote delegation",
+			delegator: delegatorAddr,
+			delegate:  "",
+			expFound:  false,
+		},
+	}
+
+	// cases are run in order, the vote delegation is removed after being set
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			_, err := suite.msgSrvr.DelegateVote(suite.ctx, v1.NewMsgDelegateVote(tc.delegator, tc.delegate))
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+				return
+			}
+			suite.Require().NoError(err)
+
+			voteDelegation, err := suite.govKeeper.VoteDelegations.Get(suite.ctx, addrs[0])
+			if !tc.expFound {
+				suite.Require().ErrorIs(err, collections.ErrNotFound)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.delegate, voteDelegation.Delegate)
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestMsgVote() {
 	suite.reset()
 	addrs := suite.addrs
@@ -229,6 +229,15 @@ func (k Keeper) CancelProposal(ctx context.Context, proposalID uint64, proposer
 		return err
 	}
 
+	// the proposal is stored back with a canceled status so that it remains
+	// queryable, out of the deposit and voting queues. Like the other finished
+	// proposals, it is kept on purpose and never pruned.
+	proposal.Status = v1.StatusCanceled
+	err = k.Proposals.Set(ctx, proposal.Id, proposal)
+	if err != nil {
+		return err
+	}
+
 	k.Logger().Info(
 		"proposal is canceled by proposer",
 		"proposal", proposal.Id,
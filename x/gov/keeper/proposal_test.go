@@ -322,6 +322,14 @@ func (suite *KeeperTestSuite) TestCancelProposal() {
 	_, err = suite.govKeeper.Votes.Get(suite.ctx, collections.Join(proposalID, suite.addrs[0]))
 	suite.Require().ErrorContains(err, collections.ErrNotFound.Error())
 
+	// check that the canceled proposal is kept in state with a canceled status
+	proposal, err = suite.govKeeper.Proposals.Get(suite.ctx, proposalID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(v1.StatusCanceled, proposal.Status)
+	has, err := suite.govKeeper.ActiveProposalsQueue.Has(suite.ctx, collections.Join(*proposal.VotingEndTime, proposalID))
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+
 	// check that proposal 3 votes are still present in the state
 	votes, err := suite.govKeeper.Votes.Get(suite.ctx, collections.Join(proposal3ID, suite.addrs[0]))
 	suite.Require().NoError(err)
@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ stakingtypes.StakingHooks = StakingHooks{}
+
+// StakingHooks wrapper struct for gov keeper, keeping the live tallies of the
+// proposals in voting period up to date with delegation changes.
+type StakingHooks struct {
+	k Keeper
+}
+
+// StakingHooks returns the staking hooks of the gov keeper.
+func (k Keeper) StakingHooks() StakingHooks {
+	return StakingHooks{k}
+}
+
+// AfterDelegationModified recounts the modified delegation in the live tallies the delegator takes part in.
+func (h StakingHooks) AfterDelegationModified(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	delegation, err := h.k.sk.Delegation(ctx, delAddr, valAddr)
+	if err != nil {
+		return err
+	}
+
+	return h.k.countDelegation(ctx, delAddr, valAddr, delegation.GetShares())
+}
+
+// BeforeDelegationRemoved uncounts the removed delegation from the live tallies the delegator takes part in.
+func (h StakingHooks) BeforeDelegationRemoved(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return h.k.countDelegation(ctx, delAddr, valAddr, math.LegacyZeroDec())
+}
+
+func (h StakingHooks) AfterValidatorCreated(_ context.Context, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) BeforeValidatorModified(_ context.Context, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) AfterValidatorRemoved(_ context.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) AfterValidatorBonded(_ context.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) AfterValidatorBeginUnbonding(_ context.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) BeforeDelegationCreated(_ context.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) BeforeDelegationSharesModified(_ context.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) BeforeValidatorSlashed(_ context.Context, _ sdk.ValAddress, _ math.LegacyDec) error {
+	return nil
+}
+
+func (h StakingHooks) AfterUnbondingInitiated(_ context.Context, _ uint64) error {
+	return nil
+}
+
+func (h StakingHooks) AfterConsensusPubKeyUpdate(_ context.Context, _, _ cryptotypes.PubKey, _ sdk.Coin) error {
+	return nil
+}
+
+func (h StakingHooks) AfterSelfDelegationBelowMinimum(_ context.Context, _ sdk.ValAddress, _ stakingtypes.MinSelfDelegationBreachAction) error {
+	return nil
+}
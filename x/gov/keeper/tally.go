@@ -27,6 +27,11 @@ func (k Keeper) Tally(ctx context.Context, proposal v1.Proposal) (passes, burnDe
 		return false, false, v1.TallyResult{}, err
 	}
 
+	// the live tally is superseded by the tally
+	if err := k.clearLiveTally(ctx, proposal.Id); err != nil {
+		return false, false, v1.TallyResult{}, err
+	}
+
 	params, err := k.Params.Get(ctx)
 	if err != nil {
 		return false, false, v1.TallyResult{}, err
@@ -183,8 +188,9 @@ func (k Keeper) tallyOptimistic(totalVoterPower math.LegacyDec, totalBonded math
 		return true, false, tallyResults, nil
 	}
 
-	// If the threshold of no is reached, proposal fails
-	if results[v1.OptionNo].Quo(totalBonded.ToLegacyDec()).GT(optimisticNoThreshold) {
+	// If the threshold of no (including no with veto) is reached, proposal fails
+	rejected := results[v1.OptionNo].Add(results[v1.OptionNoWithVeto])
+	if rejected.Quo(totalBonded.ToLegacyDec()).GT(optimisticNoThreshold) {
 		return false, false, tallyResults, nil
 	}
 
@@ -234,93 +240,21 @@ func (k Keeper) getCurrentValidators(ctx context.Context) (map[string]v1.Validat
 	return currValidators, nil
 }
 
-// calculateVoteResultsAndVotingPower iterate over all votes, tally up the voting power of each validator
-// and returns the votes results from voters
+// defaultCalculateVoteResultsAndVotingPower converts the live tally of a proposal to voting power with the current
+// bonded validators, see liveTallyResults, and removes the votes of the proposal.
 func defaultCalculateVoteResultsAndVotingPower(
 	ctx context.Context,
 	k Keeper,
 	proposalID uint64,
 	validators map[string]v1.ValidatorGovInfo,
 ) (math.LegacyDec, map[v1.VoteOption]math.LegacyDec, error) {
-	totalVP := math.LegacyZeroDec()
-	results := createEmptyResults()
-
-	// iterate over all votes, tally up the voting power of each validator
-	rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposalID)
-	votesToRemove := []collections.Pair[uint64, sdk.AccAddress]{}
-	if err := k.Votes.Walk(ctx, rng, func(key collections.Pair[uint64, sdk.AccAddress], vote v1.Vote) (bool, error) {
-		// if validator, just record it in the map
-		voter, err := k.authKeeper.AddressCodec().StringToBytes(vote.Voter)
-		if err != nil {
-			return false, err
-		}
-
-		valAddrStr, err := k.sk.ValidatorAddressCodec().BytesToString(voter)
-		if err != nil {
-			return false, err
-		}
-
-		if val, ok := validators[valAddrStr]; ok {
-			val.Vote = vote.Options
-			validators[valAddrStr] = val
-		}
-
-		// iterate over all delegations from voter, deduct from any delegated-to validators
-		err = k.sk.IterateDelegations(ctx, voter, func(index int64, delegation sdk.DelegationI) (stop bool) {
-			valAddrStr := delegation.GetValidatorAddr()
-
-			if val, ok := validators[valAddrStr]; ok {
-				// There is no need to handle the special case that validator address equal to voter address.
-				// Because voter's voting power will tally again even if there will be deduction of voter's voting power from validator.
-				val.DelegatorDeductions = val.DelegatorDeductions.Add(delegation.GetShares())
-				validators[valAddrStr] = val
-
-				// delegation shares * bonded / total shares
-				votingPower := delegation.GetShares().MulInt(val.BondedTokens).Quo(val.DelegatorShares)
-
-				for _, option := range vote.Options {
-					weight, _ := math.LegacyNewDecFromStr(option.Weight)
-					subPower := votingPower.Mul(weight)
-					results[option.Option] = results[option.Option].Add(subPower)
-				}
-
-				totalVP = totalVP.Add(votingPower)
-			}
-
-			return false
-		})
-		if err != nil {
-			return false, err
-		}
-
-		votesToRemove = append(votesToRemove, key)
-		return false, nil
-	}); err != nil {
+	totalVP, results, err := k.liveTallyResults(ctx, proposalID, validators)
+	if err != nil {
 		return math.LegacyDec{}, nil, err
 	}
 
-	// remove all votes from store
-	for _, key := range votesToRemove {
-		if err := k.Votes.Remove(ctx, key); err != nil {
-			return math.LegacyDec{}, nil, err
-		}
-	}
-
-	// iterate over the validators again to tally their voting power
-	for _, val := range validators {
-		if len(val.Vote) == 0 {
-			continue
-		}
-
-		sharesAfterDeductions := val.DelegatorShares.Sub(val.DelegatorDeductions)
-		votingPower := sharesAfterDeductions.MulInt(val.BondedTokens).Quo(val.DelegatorShares)
-
-		for _, option := range val.Vote {
-			weight, _ := math.LegacyNewDecFromStr(option.Weight)
-			subPower := votingPower.Mul(weight)
-			results[option.Option] = results[option.Option].Add(subPower)
-		}
-		totalVP = totalVP.Add(votingPower)
+	if err := k.deleteVotes(ctx, proposalID); err != nil {
+		return math.LegacyDec{}, nil, err
 	}
 
 	return totalVP, results, nil
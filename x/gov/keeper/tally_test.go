@@ -36,8 +36,7 @@ var (
 		s.mocks.stakingKeeper.EXPECT().TotalBondedTokens(gomock.Any()).Return(sdkmath.NewInt(n), nil)
 	}
 	delegatorVote = func(s tallyFixture, voter sdk.AccAddress, delegations []stakingtypes.Delegation, vote v1.VoteOption) {
-		err := s.keeper.AddVote(s.ctx, s.proposal.Id, voter, v1.NewNonSplitVoteOption(vote), "")
-		require.NoError(s.t, err)
+		// delegations are only iterated once, when the voter is counted in the live tally
 		s.mocks.stakingKeeper.EXPECT().
 			IterateDelegations(s.ctx, voter, gomock.Any()).
 			DoAndReturn(
@@ -47,11 +46,30 @@ var (
 					}
 					return nil
 				})
+		err := s.keeper.AddVote(s.ctx, s.proposal.Id, voter, v1.NewNonSplitVoteOption(vote), "")
+		require.NoError(s.t, err)
 	}
 	validatorVote = func(s tallyFixture, voter sdk.ValAddress, vote v1.VoteOption) {
 		// validatorVote is like delegatorVote but without delegations
 		delegatorVote(s, sdk.AccAddress(voter), nil, vote)
 	}
+	delegatedVote = func(s tallyFixture, delegator, delegate sdk.AccAddress, delegations []stakingtypes.Delegation) {
+		// delegatedVote delegates the vote of delegator to delegate, delegations are
+		// only iterated if the delegate votes and the delegator does not.
+		if len(delegations) > 0 {
+			s.mocks.stakingKeeper.EXPECT().
+				IterateDelegations(s.ctx, delegator, gomock.Any()).
+				DoAndReturn(
+					func(ctx context.Context, voter sdk.AccAddress, fn func(index int64, d sdk.DelegationI) bool) error {
+						for i, d := range delegations {
+							fn(int64(i), d)
+						}
+						return nil
+					})
+		}
+		err := s.keeper.SetVoteDelegation(s.ctx, delegator, delegate)
+		require.NoError(s.t, err)
+	}
 )
 
 func TestTally_Standard(t *testing.T) {
@@ -276,6 +294,90 @@ func TestTally_Standard(t *testing.T) {
 				SpamCount:        "0",
 			},
 		},
+		{
+			name: "one delegator delegates its vote, delegate votes yes, validator votes no: prop fails/burn deposit",
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				del0Addr, err := s.mocks.acctKeeper.AddressCodec().BytesToString(s.delAddrs[0])
+				require.NoError(t, err)
+				val0Addr, err := s.mocks.stakingKeeper.ValidatorAddressCodec().BytesToString(s.valAddrs[0])
+				require.NoError(t, err)
+				delegations := []stakingtypes.Delegation{{
+					DelegatorAddress: del0Addr,
+					ValidatorAddress: val0Addr,
+					Shares:           sdkmath.LegacyNewDec(42),
+				}}
+				delegatedVote(s, s.delAddrs[0], s.delAddrs[1], delegations)
+				delegatorVote(s, s.delAddrs[1], nil, v1.VoteOption_VOTE_OPTION_ONE)
+				validatorVote(s, s.valAddrs[0], v1.VoteOption_VOTE_OPTION_THREE)
+			},
+			expectedPass: false,
+			expectedBurn: true, // burn because quorum not reached
+			expectedTally: v1.TallyResult{
+				YesCount:         "42",
+				AbstainCount:     "0",
+				NoCount:          "999958",
+				NoWithVetoCount:  "0",
+				OptionOneCount:   "42",
+				OptionTwoCount:   "0",
+				OptionThreeCount: "999958",
+				OptionFourCount:  "0",
+				SpamCount:        "0",
+			},
+		},
+		{
+			name: "one delegator delegates its vote, delegate does not vote: prop fails/burn deposit",
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				delegatedVote(s, s.delAddrs[0], s.delAddrs[1], nil)
+				validatorVote(s, s.valAddrs[0], v1.VoteOption_VOTE_OPTION_THREE)
+			},
+			expectedPass: false,
+			expectedBurn: true, // burn because quorum not reached
+			expectedTally: v1.TallyResult{
+				YesCount:         "0",
+				AbstainCount:     "0",
+				NoCount:          "1000000",
+				NoWithVetoCount:  "0",
+				OptionOneCount:   "0",
+				OptionTwoCount:   "0",
+				OptionThreeCount: "1000000",
+				OptionFourCount:  "0",
+				SpamCount:        "0",
+			},
+		},
+		{
+			name: "one delegator delegates its vote but votes no, delegate votes yes: prop fails/burn deposit",
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				del0Addr, err := s.mocks.acctKeeper.AddressCodec().BytesToString(s.delAddrs[0])
+				require.NoError(t, err)
+				val0Addr, err := s.mocks.stakingKeeper.ValidatorAddressCodec().BytesToString(s.valAddrs[0])
+				require.NoError(t, err)
+				delegations := []stakingtypes.Delegation{{
+					DelegatorAddress: del0Addr,
+					ValidatorAddress: val0Addr,
+					Shares:           sdkmath.LegacyNewDec(42),
+				}}
+				// the direct vote overrides the vote delegation, so delegations are only iterated once
+				delegatedVote(s, s.delAddrs[0], s.delAddrs[1], nil)
+				delegatorVote(s, s.delAddrs[0], delegations, v1.VoteOption_VOTE_OPTION_THREE)
+				delegatorVote(s, s.delAddrs[1], nil, v1.VoteOption_VOTE_OPTION_ONE)
+			},
+			expectedPass: false,
+			expectedBurn: true, // burn because quorum not reached
+			expectedTally: v1.TallyResult{
+				YesCount:         "0",
+				AbstainCount:     "0",
+				NoCount:          "42",
+				NoWithVetoCount:  "0",
+				OptionOneCount:   "0",
+				OptionTwoCount:   "0",
+				OptionThreeCount: "42",
+				OptionFourCount:  "0",
+				SpamCount:        "0",
+			},
+		},
 		{
 			name: "quorum reached with only abstain: prop fails",
 			setup: func(s tallyFixture) {
@@ -472,6 +574,15 @@ func TestTally_Standard(t *testing.T) {
 				valAddrs      = simtestutil.ConvertAddrsToValAddrs(addrs[:numVals])
 				delAddrs      = addrs[numVals:]
 			)
+			// Mocks the validator lookups of the live tally
+			mocks.stakingKeeper.EXPECT().
+				Validator(ctx, gomock.Any()).
+				Return(stakingtypes.Validator{
+					Status:          stakingtypes.Bonded,
+					Tokens:          sdkmath.NewInt(1000000),
+					DelegatorShares: sdkmath.LegacyNewDec(1000000),
+				}, nil).
+				AnyTimes()
 			// Mocks a bunch of validators
 			mocks.stakingKeeper.EXPECT().
 				IterateBondedValidatorsByPower(ctx, gomock.Any()).
@@ -942,6 +1053,15 @@ func TestTally_Expedited(t *testing.T) {
 				valAddrs      = simtestutil.ConvertAddrsToValAddrs(addrs[:numVals])
 				delAddrs      = addrs[numVals:]
 			)
+			// Mocks the validator lookups of the live tally
+			mocks.stakingKeeper.EXPECT().
+				Validator(ctx, gomock.Any()).
+				Return(stakingtypes.Validator{
+					Status:          stakingtypes.Bonded,
+					Tokens:          sdkmath.NewInt(1000000),
+					DelegatorShares: sdkmath.LegacyNewDec(1000000),
+				}, nil).
+				AnyTimes()
 			// Mocks a bunch of validators
 			mocks.stakingKeeper.EXPECT().
 				IterateBondedValidatorsByPower(ctx, gomock.Any()).
@@ -1089,6 +1209,27 @@ func TestTally_Optimistic(t *testing.T) {
 				SpamCount:        "0",
 			},
 		},
+		{
+			name: "no and no with veto votes together reach threshold: prop fails",
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				validatorVote(s, s.valAddrs[0], v1.VoteOption_VOTE_OPTION_THREE)
+				validatorVote(s, s.valAddrs[1], v1.VoteOption_VOTE_OPTION_FOUR)
+			},
+			expectedPass: false,
+			expectedBurn: false,
+			expectedTally: v1.TallyResult{
+				YesCount:         "0",
+				AbstainCount:     "0",
+				NoCount:          "1000000",
+				NoWithVetoCount:  "1000000",
+				OptionOneCount:   "0",
+				OptionTwoCount:   "0",
+				OptionThreeCount: "1000000",
+				OptionFourCount:  "1000000",
+				SpamCount:        "0",
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1106,6 +1247,15 @@ func TestTally_Optimistic(t *testing.T) {
 				valAddrs      = simtestutil.ConvertAddrsToValAddrs(addrs[:numVals])
 				delAddrs      = addrs[numVals:]
 			)
+			// Mocks the validator lookups of the live tally
+			mocks.stakingKeeper.EXPECT().
+				Validator(ctx, gomock.Any()).
+				Return(stakingtypes.Validator{
+					Status:          stakingtypes.Bonded,
+					Tokens:          sdkmath.NewInt(1000000),
+					DelegatorShares: sdkmath.LegacyNewDec(1000000),
+				}, nil).
+				AnyTimes()
 			// Mocks a bunch of validators
 			mocks.stakingKeeper.EXPECT().
 				IterateBondedValidatorsByPower(ctx, gomock.Any()).
@@ -1492,6 +1642,15 @@ func TestTally_MultipleChoice(t *testing.T) {
 				valAddrs      = simtestutil.ConvertAddrsToValAddrs(addrs[:numVals])
 				delAddrs      = addrs[numVals:]
 			)
+			// Mocks the validator lookups of the live tally
+			mocks.stakingKeeper.EXPECT().
+				Validator(ctx, gomock.Any()).
+				Return(stakingtypes.Validator{
+					Status:          stakingtypes.Bonded,
+					Tokens:          sdkmath.NewInt(1000000),
+					DelegatorShares: sdkmath.LegacyNewDec(1000000),
+				}, nil).
+				AnyTimes()
 			// Mocks a bunch of validators
 			mocks.stakingKeeper.EXPECT().
 				IterateBondedValidatorsByPower(ctx, gomock.Any()).
@@ -38,7 +38,7 @@ func (k Keeper) AddVote(ctx context.Context, proposalID uint64, voterAddr sdk.Ac
 	for _, option := range options {
 		switch proposal.ProposalType {
 		case v1.ProposalType_PROPOSAL_TYPE_OPTIMISTIC:
-			if option.Option != v1.OptionNo {
+			if option.Option != v1.OptionNo && option.Option != v1.OptionNoWithVeto {
 				return errors.Wrap(types.ErrInvalidVote, "optimistic proposals can only be rejected")
 			}
 		case v1.ProposalType_PROPOSAL_TYPE_MULTIPLE_CHOICE:
@@ -72,7 +72,19 @@ func (k Keeper) AddVote(ctx context.Context, proposalID uint64, voterAddr sdk.Ac
 	if err != nil {
 		return err
 	}
+	if err := k.countVote(ctx, proposalID, voterAddr, options); err != nil {
+		return err
+	}
+	votingPower, err := k.votingPower(ctx, proposalID, voterAddr)
+	if err != nil {
+		return err
+	}
+
 	vote := v1.NewVote(proposalID, voterStrAddr, options, metadata)
+	if !votingPower.IsZero() {
+		vote.VotingPower = votingPower.String()
+	}
+
 	err = k.Votes.Set(ctx, collections.Join(proposalID, voterAddr), vote)
 	if err != nil {
 		return err
@@ -98,5 +110,5 @@ func (k Keeper) deleteVotes(ctx context.Context, proposalID uint64) error {
 		return err
 	}
 
-	return nil
+	return k.clearLiveTally(ctx, proposalID)
 }
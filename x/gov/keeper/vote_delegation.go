@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/gov/types"
+	v1 "cosmossdk.io/x/gov/types/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetVoteDelegation delegates the governance voting power of delegator to delegate.
+// An empty delegate removes the existing vote delegation of the delegator.
+// The live tallies of the proposals in voting period are updated accordingly.
+func (k Keeper) SetVoteDelegation(ctx context.Context, delegator, delegate sdk.AccAddress) error {
+	if delegator.Equals(delegate) {
+		return types.ErrInvalidVoteDelegation.Wrap("cannot delegate voting power to self")
+	}
+
+	var prevDelegate sdk.AccAddress
+	prevVoteDelegation, err := k.VoteDelegations.Get(ctx, delegator)
+	if err == nil {
+		prevDelegate, err = k.authKeeper.AddressCodec().StringToBytes(prevVoteDelegation.Delegate)
+		if err != nil {
+			return err
+		}
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	if err := k.countVoteDelegation(ctx, delegator, prevDelegate, delegate); err != nil {
+		return err
+	}
+
+	if delegate.Empty() {
+		return k.VoteDelegations.Remove(ctx, delegator)
+	}
+
+	delegatorStr, err := k.authKeeper.AddressCodec().BytesToString(delegator)
+	if err != nil {
+		return err
+	}
+
+	delegateStr, err := k.authKeeper.AddressCodec().BytesToString(delegate)
+	if err != nil {
+		return err
+	}
+
+	return k.VoteDelegations.Set(ctx, delegator, v1.VoteDelegation{
+		Delegator: delegatorStr,
+		Delegate:  delegateStr,
+	})
+}
@@ -143,6 +143,20 @@ func (m *MockBankKeeper) EXPECT() *MockBankKeeperMockRecorder {
 	return m.recorder
 }
 
+// AddSupplyOffset mocks base method.
+func (m *MockBankKeeper) AddSupplyOffset(ctx context.Context, denom string, offsetAmount math.Int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddSupplyOffset", ctx, denom, offsetAmount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddSupplyOffset indicates an expected call of AddSupplyOffset.
+func (mr *MockBankKeeperMockRecorder) AddSupplyOffset(ctx, denom, offsetAmount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSupplyOffset", reflect.TypeOf((*MockBankKeeper)(nil).AddSupplyOffset), ctx, denom, offsetAmount)
+}
+
 // AllBalances mocks base method.
 func (m *MockBankKeeper) AllBalances(arg0 context.Context, arg1 *types.QueryAllBalancesRequest) (*types.QueryAllBalancesResponse, error) {
 	m.ctrl.T.Helper()
@@ -158,6 +172,20 @@ func (mr *MockBankKeeperMockRecorder) AllBalances(arg0, arg1 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllBalances", reflect.TypeOf((*MockBankKeeper)(nil).AllBalances), arg0, arg1)
 }
 
+// AllBalancesStream mocks base method.
+func (m *MockBankKeeper) AllBalancesStream(arg0 *types.QueryAllBalancesStreamRequest, arg1 types.Query_AllBalancesStreamServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllBalancesStream", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AllBalancesStream indicates an expected call of AllBalancesStream.
+func (mr *MockBankKeeperMockRecorder) AllBalancesStream(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllBalancesStream", reflect.TypeOf((*MockBankKeeper)(nil).AllBalancesStream), arg0, arg1)
+}
+
 // AppendSendRestriction mocks base method.
 func (m *MockBankKeeper) AppendSendRestriction(restriction types.SendRestrictionFn) {
 	m.ctrl.T.Helper()
@@ -345,12 +373,28 @@ func (mr *MockBankKeeperMockRecorder) DenomsMetadata(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DenomsMetadata", reflect.TypeOf((*MockBankKeeper)(nil).DenomsMetadata), arg0, arg1)
 }
 
+// DenomsMetadataByQuery mocks base method.
+func (m *MockBankKeeper) DenomsMetadataByQuery(arg0 context.Context, arg1 *types.QueryDenomsMetadataByQueryRequest) (*types.QueryDenomsMetadataByQueryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DenomsMetadataByQuery", arg0, arg1)
+	ret0, _ := ret[0].(*types.QueryDenomsMetadataByQueryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DenomsMetadataByQuery indicates an expected call of DenomsMetadataByQuery.
+func (mr *MockBankKeeperMockRecorder) DenomsMetadataByQuery(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DenomsMetadataByQuery", reflect.TypeOf((*MockBankKeeper)(nil).DenomsMetadataByQuery), arg0, arg1)
+}
+
 // ExportGenesis mocks base method.
-func (m *MockBankKeeper) ExportGenesis(arg0 context.Context) *types.GenesisState {
+func (m *MockBankKeeper) ExportGenesis(arg0 context.Context) (*types.GenesisState, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ExportGenesis", arg0)
 	ret0, _ := ret[0].(*types.GenesisState)
-	return ret0
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // ExportGenesis indicates an expected call of ExportGenesis.
@@ -359,6 +403,20 @@ func (mr *MockBankKeeperMockRecorder) ExportGenesis(arg0 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportGenesis", reflect.TypeOf((*MockBankKeeper)(nil).ExportGenesis), arg0)
 }
 
+// FlushDeferredBalances mocks base method.
+func (m *MockBankKeeper) FlushDeferredBalances(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FlushDeferredBalances", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FlushDeferredBalances indicates an expected call of FlushDeferredBalances.
+func (mr *MockBankKeeperMockRecorder) FlushDeferredBalances(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FlushDeferredBalances", reflect.TypeOf((*MockBankKeeper)(nil).FlushDeferredBalances), ctx)
+}
+
 // GetAccountsBalances mocks base method.
 func (m *MockBankKeeper) GetAccountsBalances(ctx context.Context) []types.Balance {
 	m.ctrl.T.Helper()
@@ -401,6 +459,20 @@ func (mr *MockBankKeeperMockRecorder) GetAllDenomMetaData(ctx interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllDenomMetaData", reflect.TypeOf((*MockBankKeeper)(nil).GetAllDenomMetaData), ctx)
 }
 
+// GetAllLocks mocks base method.
+func (m *MockBankKeeper) GetAllLocks(ctx context.Context) []types.Lock {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllLocks", ctx)
+	ret0, _ := ret[0].([]types.Lock)
+	return ret0
+}
+
+// GetAllLocks indicates an expected call of GetAllLocks.
+func (mr *MockBankKeeperMockRecorder) GetAllLocks(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllLocks", reflect.TypeOf((*MockBankKeeper)(nil).GetAllLocks), ctx)
+}
+
 // GetAllSendEnabledEntries mocks base method.
 func (m *MockBankKeeper) GetAllSendEnabledEntries(ctx context.Context) []types.SendEnabled {
 	m.ctrl.T.Helper()
@@ -415,6 +487,20 @@ func (mr *MockBankKeeperMockRecorder) GetAllSendEnabledEntries(ctx interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllSendEnabledEntries", reflect.TypeOf((*MockBankKeeper)(nil).GetAllSendEnabledEntries), ctx)
 }
 
+// GetAllSupplyOffsets mocks base method.
+func (m *MockBankKeeper) GetAllSupplyOffsets(ctx context.Context) []types.SupplyOffset {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllSupplyOffsets", ctx)
+	ret0, _ := ret[0].([]types.SupplyOffset)
+	return ret0
+}
+
+// GetAllSupplyOffsets indicates an expected call of GetAllSupplyOffsets.
+func (mr *MockBankKeeperMockRecorder) GetAllSupplyOffsets(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllSupplyOffsets", reflect.TypeOf((*MockBankKeeper)(nil).GetAllSupplyOffsets), ctx)
+}
+
 // GetAuthority mocks base method.
 func (m *MockBankKeeper) GetAuthority() string {
 	m.ctrl.T.Helper()
@@ -472,6 +558,35 @@ func (mr *MockBankKeeperMockRecorder) GetDenomMetaData(ctx, denom interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDenomMetaData", reflect.TypeOf((*MockBankKeeper)(nil).GetDenomMetaData), ctx, denom)
 }
 
+// GetLock mocks base method.
+func (m *MockBankKeeper) GetLock(ctx context.Context, addr types0.AccAddress, lockID string) (types.Lock, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLock", ctx, addr, lockID)
+	ret0, _ := ret[0].(types.Lock)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetLock indicates an expected call of GetLock.
+func (mr *MockBankKeeperMockRecorder) GetLock(ctx, addr, lockID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLock", reflect.TypeOf((*MockBankKeeper)(nil).GetLock), ctx, addr, lockID)
+}
+
+// GetLocks mocks base method.
+func (m *MockBankKeeper) GetLocks(ctx context.Context, addr types0.AccAddress) []types.Lock {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLocks", ctx, addr)
+	ret0, _ := ret[0].([]types.Lock)
+	return ret0
+}
+
+// GetLocks indicates an expected call of GetLocks.
+func (mr *MockBankKeeperMockRecorder) GetLocks(ctx, addr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLocks", reflect.TypeOf((*MockBankKeeper)(nil).GetLocks), ctx, addr)
+}
+
 // GetPaginatedTotalSupply mocks base method.
 func (m *MockBankKeeper) GetPaginatedTotalSupply(ctx context.Context, pagination *query.PageRequest) (types0.Coins, *query.PageResponse, error) {
 	m.ctrl.T.Helper()
@@ -531,6 +646,34 @@ func (mr *MockBankKeeperMockRecorder) GetSupply(ctx, denom interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSupply", reflect.TypeOf((*MockBankKeeper)(nil).GetSupply), ctx, denom)
 }
 
+// GetSupplyOffset mocks base method.
+func (m *MockBankKeeper) GetSupplyOffset(ctx context.Context, denom string) math.Int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSupplyOffset", ctx, denom)
+	ret0, _ := ret[0].(math.Int)
+	return ret0
+}
+
+// GetSupplyOffset indicates an expected call of GetSupplyOffset.
+func (mr *MockBankKeeperMockRecorder) GetSupplyOffset(ctx, denom interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSupplyOffset", reflect.TypeOf((*MockBankKeeper)(nil).GetSupplyOffset), ctx, denom)
+}
+
+// GetSupplyWithOffset mocks base method.
+func (m *MockBankKeeper) GetSupplyWithOffset(ctx context.Context, denom string) types0.Coin {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSupplyWithOffset", ctx, denom)
+	ret0, _ := ret[0].(types0.Coin)
+	return ret0
+}
+
+// GetSupplyWithOffset indicates an expected call of GetSupplyWithOffset.
+func (mr *MockBankKeeperMockRecorder) GetSupplyWithOffset(ctx, denom interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSupplyWithOffset", reflect.TypeOf((*MockBankKeeper)(nil).GetSupplyWithOffset), ctx, denom)
+}
+
 // HasBalance mocks base method.
 func (m *MockBankKeeper) HasBalance(ctx context.Context, addr types0.AccAddress, amt types0.Coin) bool {
 	m.ctrl.T.Helper()
@@ -660,6 +803,32 @@ func (mr *MockBankKeeperMockRecorder) IterateAccountBalances(ctx, addr, cb inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateAccountBalances", reflect.TypeOf((*MockBankKeeper)(nil).IterateAccountBalances), ctx, addr, cb)
 }
 
+// IterateAccountBalancesPaginated mocks base method.
+func (m *MockBankKeeper) IterateAccountBalancesPaginated(ctx context.Context, addr types0.AccAddress, startAfter string, cb func(types0.Coin) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IterateAccountBalancesPaginated", ctx, addr, startAfter, cb)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IterateAccountBalancesPaginated indicates an expected call of IterateAccountBalancesPaginated.
+func (mr *MockBankKeeperMockRecorder) IterateAccountBalancesPaginated(ctx, addr, startAfter, cb interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateAccountBalancesPaginated", reflect.TypeOf((*MockBankKeeper)(nil).IterateAccountBalancesPaginated), ctx, addr, startAfter, cb)
+}
+
+// IterateAccountLocks mocks base method.
+func (m *MockBankKeeper) IterateAccountLocks(ctx context.Context, addr types0.AccAddress, cb func(types.Lock) bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IterateAccountLocks", ctx, addr, cb)
+}
+
+// IterateAccountLocks indicates an expected call of IterateAccountLocks.
+func (mr *MockBankKeeperMockRecorder) IterateAccountLocks(ctx, addr, cb interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateAccountLocks", reflect.TypeOf((*MockBankKeeper)(nil).IterateAccountLocks), ctx, addr, cb)
+}
+
 // IterateAllBalances mocks base method.
 func (m *MockBankKeeper) IterateAllBalances(ctx context.Context, cb func(types0.AccAddress, types0.Coin) bool) {
 	m.ctrl.T.Helper()
@@ -684,6 +853,18 @@ func (mr *MockBankKeeperMockRecorder) IterateAllDenomMetaData(ctx, cb interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateAllDenomMetaData", reflect.TypeOf((*MockBankKeeper)(nil).IterateAllDenomMetaData), ctx, cb)
 }
 
+// IterateAllLocks mocks base method.
+func (m *MockBankKeeper) IterateAllLocks(ctx context.Context, cb func(types0.AccAddress, types.Lock) bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IterateAllLocks", ctx, cb)
+}
+
+// IterateAllLocks indicates an expected call of IterateAllLocks.
+func (mr *MockBankKeeperMockRecorder) IterateAllLocks(ctx, cb interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateAllLocks", reflect.TypeOf((*MockBankKeeper)(nil).IterateAllLocks), ctx, cb)
+}
+
 // IterateSendEnabledEntries mocks base method.
 func (m *MockBankKeeper) IterateSendEnabledEntries(ctx context.Context, cb func(string, bool) bool) {
 	m.ctrl.T.Helper()
@@ -708,6 +889,20 @@ func (mr *MockBankKeeperMockRecorder) IterateTotalSupply(ctx, cb interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateTotalSupply", reflect.TypeOf((*MockBankKeeper)(nil).IterateTotalSupply), ctx, cb)
 }
 
+// LockCoins mocks base method.
+func (m *MockBankKeeper) LockCoins(ctx context.Context, addr types0.AccAddress, amt types0.Coins, lockID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockCoins", ctx, addr, amt, lockID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockCoins indicates an expected call of LockCoins.
+func (mr *MockBankKeeperMockRecorder) LockCoins(ctx, addr, amt, lockID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockCoins", reflect.TypeOf((*MockBankKeeper)(nil).LockCoins), ctx, addr, amt, lockID)
+}
+
 // LockedCoins mocks base method.
 func (m *MockBankKeeper) LockedCoins(ctx context.Context, addr types0.AccAddress) types0.Coins {
 	m.ctrl.T.Helper()
@@ -722,6 +917,21 @@ func (mr *MockBankKeeperMockRecorder) LockedCoins(ctx, addr interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockedCoins", reflect.TypeOf((*MockBankKeeper)(nil).LockedCoins), ctx, addr)
 }
 
+// Locks mocks base method.
+func (m *MockBankKeeper) Locks(arg0 context.Context, arg1 *types.QueryLocksRequest) (*types.QueryLocksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Locks", arg0, arg1)
+	ret0, _ := ret[0].(*types.QueryLocksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Locks indicates an expected call of Locks.
+func (mr *MockBankKeeperMockRecorder) Locks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Locks", reflect.TypeOf((*MockBankKeeper)(nil).Locks), arg0, arg1)
+}
+
 // MintCoins mocks base method.
 func (m *MockBankKeeper) MintCoins(ctx context.Context, moduleName string, amt types0.Coins) error {
 	m.ctrl.T.Helper()
@@ -763,6 +973,20 @@ func (mr *MockBankKeeperMockRecorder) PrependSendRestriction(restriction interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrependSendRestriction", reflect.TypeOf((*MockBankKeeper)(nil).PrependSendRestriction), restriction)
 }
 
+// RemoveDenomMetaData mocks base method.
+func (m *MockBankKeeper) RemoveDenomMetaData(ctx context.Context, denom string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveDenomMetaData", ctx, denom)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveDenomMetaData indicates an expected call of RemoveDenomMetaData.
+func (mr *MockBankKeeperMockRecorder) RemoveDenomMetaData(ctx, denom interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveDenomMetaData", reflect.TypeOf((*MockBankKeeper)(nil).RemoveDenomMetaData), ctx, denom)
+}
+
 // SendCoins mocks base method.
 func (m *MockBankKeeper) SendCoins(ctx context.Context, fromAddr, toAddr types0.AccAddress, amt types0.Coins) error {
 	m.ctrl.T.Helper()
@@ -846,6 +1070,20 @@ func (mr *MockBankKeeperMockRecorder) SetAllSendEnabled(ctx, sendEnableds interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAllSendEnabled", reflect.TypeOf((*MockBankKeeper)(nil).SetAllSendEnabled), ctx, sendEnableds)
 }
 
+// SetDefaultSendEnabled mocks base method.
+func (m *MockBankKeeper) SetDefaultSendEnabled(ctx context.Context, value bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDefaultSendEnabled", ctx, value)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetDefaultSendEnabled indicates an expected call of SetDefaultSendEnabled.
+func (mr *MockBankKeeperMockRecorder) SetDefaultSendEnabled(ctx, value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDefaultSendEnabled", reflect.TypeOf((*MockBankKeeper)(nil).SetDefaultSendEnabled), ctx, value)
+}
+
 // SetDenomMetaData mocks base method.
 func (m *MockBankKeeper) SetDenomMetaData(ctx context.Context, denomMetaData types.Metadata) {
 	m.ctrl.T.Helper()
@@ -1000,6 +1238,20 @@ func (mr *MockBankKeeperMockRecorder) UndelegateCoinsFromModuleToAccount(ctx, se
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UndelegateCoinsFromModuleToAccount", reflect.TypeOf((*MockBankKeeper)(nil).UndelegateCoinsFromModuleToAccount), ctx, senderModule, recipientAddr, amt)
 }
 
+// UnlockCoins mocks base method.
+func (m *MockBankKeeper) UnlockCoins(ctx context.Context, addr types0.AccAddress, amt types0.Coins, lockID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnlockCoins", ctx, addr, amt, lockID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnlockCoins indicates an expected call of UnlockCoins.
+func (mr *MockBankKeeperMockRecorder) UnlockCoins(ctx, addr, amt, lockID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlockCoins", reflect.TypeOf((*MockBankKeeper)(nil).UnlockCoins), ctx, addr, amt, lockID)
+}
+
 // ValidateBalance mocks base method.
 func (m *MockBankKeeper) ValidateBalance(ctx context.Context, addr types0.AccAddress) error {
 	m.ctrl.T.Helper()
@@ -1103,6 +1355,21 @@ func (mr *MockStakingKeeperMockRecorder) BondDenom(ctx interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BondDenom", reflect.TypeOf((*MockStakingKeeper)(nil).BondDenom), ctx)
 }
 
+// Delegation mocks base method.
+func (m *MockStakingKeeper) Delegation(arg0 context.Context, arg1 types0.AccAddress, arg2 types0.ValAddress) (types0.DelegationI, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delegation", arg0, arg1, arg2)
+	ret0, _ := ret[0].(types0.DelegationI)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delegation indicates an expected call of Delegation.
+func (mr *MockStakingKeeperMockRecorder) Delegation(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delegation", reflect.TypeOf((*MockStakingKeeper)(nil).Delegation), arg0, arg1, arg2)
+}
+
 // IterateBondedValidatorsByPower mocks base method.
 func (m *MockStakingKeeper) IterateBondedValidatorsByPower(arg0 context.Context, arg1 func(int64, types0.ValidatorI) bool) error {
 	m.ctrl.T.Helper()
@@ -1160,6 +1427,21 @@ func (mr *MockStakingKeeperMockRecorder) TotalBondedTokens(arg0 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TotalBondedTokens", reflect.TypeOf((*MockStakingKeeper)(nil).TotalBondedTokens), arg0)
 }
 
+// Validator mocks base method.
+func (m *MockStakingKeeper) Validator(arg0 context.Context, arg1 types0.ValAddress) (types0.ValidatorI, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Validator", arg0, arg1)
+	ret0, _ := ret[0].(types0.ValidatorI)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Validator indicates an expected call of Validator.
+func (mr *MockStakingKeeperMockRecorder) Validator(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Validator", reflect.TypeOf((*MockStakingKeeper)(nil).Validator), arg0, arg1)
+}
+
 // ValidatorAddressCodec mocks base method.
 func (m *MockStakingKeeper) ValidatorAddressCodec() address.Codec {
 	m.ctrl.T.Helper()
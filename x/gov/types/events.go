@@ -8,6 +8,7 @@ const (
 	EventTypeInactiveProposal = "inactive_proposal"
 	EventTypeActiveProposal   = "active_proposal"
 	EventTypeCancelProposal   = "cancel_proposal"
+	EventTypeDelegateVote     = "delegate_vote"
 
 	AttributeKeyProposalResult       = "proposal_result"
 	AttributeKeyVoter                = "voter"
@@ -19,6 +20,8 @@ const (
 	AttributeKeyProposalLog          = "proposal_log"           // log of proposal execution
 	AttributeKeyProposalDepositError = "proposal_deposit_error" // error on proposal deposit refund/burn
 	AttributeKeyProposalProposer     = "proposal_proposer"      // account address of the proposer
+	AttributeKeyVoteDelegator        = "delegator"              // account address delegating its voting power
+	AttributeKeyVoteDelegate         = "delegate"               // account address voting on behalf of the delegator
 
 	AttributeValueProposalDropped            = "proposal_dropped"             // didn't meet min deposit
 	AttributeValueProposalPassed             = "proposal_passed"              // met vote quorum
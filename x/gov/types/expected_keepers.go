@@ -22,6 +22,10 @@ type StakingKeeper interface {
 		ctx context.Context, delegator sdk.AccAddress,
 		fn func(index int64, delegation sdk.DelegationI) (stop bool),
 	) error
+	// get a particular validator by operator address
+	Validator(context.Context, sdk.ValAddress) (sdk.ValidatorI, error)
+	// get a particular delegation by delegator and validator addresses
+	Delegation(context.Context, sdk.AccAddress, sdk.ValAddress) (sdk.DelegationI, error)
 }
 
 // AccountKeeper defines the expected account keeper (noalias)
@@ -65,6 +69,7 @@ type GovHooks interface {
 	AfterProposalVote(ctx context.Context, proposalID uint64, voterAddr sdk.AccAddress) error        // Must be called after a vote on a proposal is cast
 	AfterProposalFailedMinDeposit(ctx context.Context, proposalID uint64) error                      // Must be called when proposal fails to reach min deposit
 	AfterProposalVotingPeriodEnded(ctx context.Context, proposalID uint64) error                     // Must be called when proposal's finishes it's voting period
+	AfterProposalExecuted(ctx context.Context, proposalID uint64) error                              // Must be called after the messages of a passed proposal are executed
 }
 
 type GovHooksWrapper struct{ GovHooks }
@@ -56,3 +56,11 @@ func (h MultiGovHooks) AfterProposalVotingPeriodEnded(ctx context.Context, propo
 	}
 	return errs
 }
+
+func (h MultiGovHooks) AfterProposalExecuted(ctx context.Context, proposalID uint64) error {
+	var errs error
+	for i := range h {
+		errs = errors.Join(errs, h[i].AfterProposalExecuted(ctx, proposalID))
+	}
+	return errs
+}
@@ -26,6 +26,11 @@ var (
 	ConstitutionKey              = collections.NewPrefix(49) // ConstitutionKey stores a chain's constitution.
 	ProposalVoteOptionsKeyPrefix = collections.NewPrefix(50) // ProposalVoteOptionsKeyPrefix stores the vote options of proposals.
 	MessageBasedParamsKey        = collections.NewPrefix(51) // MessageBasedParamsKey stores the message based gov params.
+	VoteDelegationsKeyPrefix     = collections.NewPrefix(52) // VoteDelegationsKeyPrefix stores the governance vote delegations.
+	ExecutionResultsKeyPrefix    = collections.NewPrefix(53) // ExecutionResultsKeyPrefix stores the execution results of passed proposals.
+	LiveTalliesKeyPrefix         = collections.NewPrefix(54) // LiveTalliesKeyPrefix stores the shares counted per validator and option in the tallies of proposals in voting period.
+	VoteDelegationsByDelegateKey = collections.NewPrefix(55) // VoteDelegationsByDelegateKey indexes the governance vote delegations by delegate.
+	LiveTallySharesKeyPrefix     = collections.NewPrefix(56) // LiveTallySharesKeyPrefix stores the shares of each voter counted in the tallies of proposals in voting period.
 )
 
 // Reserved kvstore keys
@@ -21,6 +21,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/gov/v1/MsgUpdateParams")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateMessageParams{}, "x/gov/v1/MsgUpdateMessageParams")
 	legacy.RegisterAminoMsg(cdc, &MsgSudoExec{}, "cosmos-sdk/x/gov/v1/MsgSudoExec")
+	legacy.RegisterAminoMsg(cdc, &MsgDelegateVote{}, "cosmos-sdk/v1/MsgDelegateVote")
 }
 
 // RegisterInterfaces registers the interfaces types with the Interface Registry.
@@ -35,6 +36,7 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 		&MsgUpdateParams{},
 		&MsgUpdateMessageParams{},
 		&MsgSudoExec{},
+		&MsgDelegateVote{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
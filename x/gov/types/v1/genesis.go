@@ -99,6 +99,47 @@ func ValidateGenesis(ac address.Codec, data *GenesisState) error {
 		return nil
 	})
 
+	// weed out duplicate execution results and execution results for non-existent proposals
+	errGroup.Go(func() error {
+		executionResultIds := make(map[uint64]struct{})
+		for _, r := range data.ProposalExecutionResults {
+			if _, ok := proposalIds[r.ProposalId]; !ok {
+				return fmt.Errorf("execution result %v has non-existent proposal id: %d", r, r.ProposalId)
+			}
+
+			if _, ok := executionResultIds[r.ProposalId]; ok {
+				return fmt.Errorf("duplicate execution result: %v", r)
+			}
+
+			executionResultIds[r.ProposalId] = struct{}{}
+		}
+
+		return nil
+	})
+
+	// weed out duplicate and self vote delegations
+	errGroup.Go(func() error {
+		delegators := make(map[string]struct{})
+		for _, d := range data.VoteDelegations {
+			if _, err := ac.StringToBytes(d.Delegator); err != nil {
+				return fmt.Errorf("invalid vote delegator address %s: %w", d.Delegator, err)
+			}
+			if _, err := ac.StringToBytes(d.Delegate); err != nil {
+				return fmt.Errorf("invalid vote delegate address %s: %w", d.Delegate, err)
+			}
+			if d.Delegator == d.Delegate {
+				return fmt.Errorf("vote delegation to self: %v", d)
+			}
+			if _, ok := delegators[d.Delegator]; ok {
+				return fmt.Errorf("duplicate vote delegation: %v", d)
+			}
+
+			delegators[d.Delegator] = struct{}{}
+		}
+
+		return nil
+	})
+
 	// verify params
 	errGroup.Go(func() error {
 		return data.Params.ValidateBasic(ac)
@@ -52,6 +52,14 @@ type GenesisState struct {
 	//
 	// Since: cosmos-sdk 0.50
 	Constitution string `protobuf:"bytes,9,opt,name=constitution,proto3" json:"constitution,omitempty"`
+	// vote_delegations defines all the governance vote delegations present at genesis.
+	//
+	// Since: x/gov v1.0.0
+	VoteDelegations []*VoteDelegation `protobuf:"bytes,10,rep,name=vote_delegations,json=voteDelegations,proto3" json:"vote_delegations,omitempty"`
+	// proposal_execution_results defines all the proposal execution results present at genesis.
+	//
+	// Since: x/gov v1.0.0
+	ProposalExecutionResults []*ProposalExecutionResult `protobuf:"bytes,11,rep,name=proposal_execution_results,json=proposalExecutionResults,proto3" json:"proposal_execution_results,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -153,6 +161,20 @@ func (m *GenesisState) GetConstitution() string {
 	return ""
 }
 
+func (m *GenesisState) GetVoteDelegations() []*VoteDelegation {
+	if m != nil {
+		return m.VoteDelegations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProposalExecutionResults() []*ProposalExecutionResult {
+	if m != nil {
+		return m.ProposalExecutionResults
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.gov.v1.GenesisState")
 }
@@ -160,30 +182,34 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1/genesis.proto", fileDescriptor_ef7cfd15e3ded621) }
 
 var fileDescriptor_ef7cfd15e3ded621 = []byte{
-	// 365 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xcd, 0x4e, 0xc2, 0x40,
-	0x14, 0x85, 0x19, 0xfe, 0x84, 0xa1, 0xb8, 0x18, 0x7f, 0x68, 0xc0, 0x34, 0x0d, 0xab, 0xba, 0xb0,
-	0x15, 0x0c, 0x0f, 0x20, 0x21, 0x21, 0xee, 0x48, 0x35, 0x2e, 0xdc, 0x90, 0x4a, 0x9b, 0xa6, 0x11,
-	0x7a, 0x9b, 0xce, 0x75, 0x22, 0x6f, 0xe1, 0x63, 0xb9, 0x64, 0xe9, 0xd2, 0xc0, 0x63, 0xb8, 0x31,
-	0x4c, 0x5b, 0x81, 0xea, 0xf6, 0x9e, 0xef, 0x9c, 0x7b, 0x72, 0x73, 0x69, 0x67, 0x06, 0x7c, 0x01,
-	0xdc, 0xf2, 0x41, 0x58, 0xa2, 0x67, 0xf9, 0x5e, 0xe8, 0xf1, 0x80, 0x9b, 0x51, 0x0c, 0x08, 0xac,
-	0x99, 0x88, 0xa6, 0x0f, 0xc2, 0x14, 0xbd, 0x76, 0x2b, 0xc7, 0x82, 0x48, 0xb8, 0xee, 0x77, 0x89,
-	0x2a, 0xe3, 0xc4, 0x79, 0x8f, 0x0e, 0x7a, 0xec, 0x9a, 0x9e, 0x72, 0x74, 0x62, 0x0c, 0x42, 0x7f,
-	0x1a, 0xc5, 0x10, 0x01, 0x77, 0xe6, 0xd3, 0xc0, 0x55, 0x89, 0x4e, 0x8c, 0xb2, 0xcd, 0x32, 0x6d,
-	0x92, 0x4a, 0x77, 0x2e, 0xeb, 0xd3, 0x9a, 0xeb, 0x45, 0xc0, 0x03, 0xe4, 0x6a, 0x51, 0x2f, 0x19,
-	0x8d, 0xfe, 0xb9, 0x79, 0xb0, 0xdd, 0x1c, 0x25, 0xb2, 0xfd, 0xcb, 0xb1, 0x4b, 0x5a, 0x11, 0x80,
-	0x1e, 0x57, 0x4b, 0xd2, 0x70, 0x92, 0x33, 0x3c, 0x02, 0x7a, 0x76, 0x42, 0xb0, 0x01, 0xad, 0x67,
-	0x3d, 0xb8, 0x5a, 0x96, 0x78, 0x2b, 0x87, 0x67, 0x65, 0xec, 0x1d, 0xc9, 0xc6, 0xf4, 0x38, 0xdd,
-	0x36, 0x8d, 0x9c, 0xd8, 0x59, 0x70, 0xb5, 0xa2, 0x13, 0xa3, 0xd1, 0xbf, 0xf8, 0xbf, 0xdb, 0x44,
-	0x32, 0xc3, 0xa2, 0x4a, 0xec, 0xa6, 0xbb, 0x3f, 0x62, 0x23, 0xda, 0x14, 0x90, 0x9c, 0x23, 0xc9,
-	0xa9, 0xca, 0x9c, 0xce, 0xdf, 0xca, 0xdb, 0xb3, 0xec, 0x62, 0x14, 0xb1, 0x37, 0x61, 0xb7, 0x54,
-	0x41, 0x67, 0x3e, 0x5f, 0x66, 0x21, 0x47, 0x32, 0xa4, 0x9d, 0x0b, 0x79, 0xd8, 0x22, 0x7b, 0x19,
-	0x0d, 0xdc, 0x0d, 0xd8, 0x15, 0xad, 0xa6, 0xe6, 0x9a, 0x34, 0x9f, 0xe5, 0xaf, 0x20, 0x45, 0x3b,
-	0x85, 0x58, 0x97, 0x2a, 0x33, 0x08, 0x39, 0x06, 0xf8, 0x8a, 0x01, 0x84, 0x6a, 0x5d, 0x27, 0x46,
-	0xdd, 0x3e, 0x98, 0x0d, 0x07, 0x1f, 0x6b, 0x8d, 0xac, 0xd6, 0x1a, 0xf9, 0x5a, 0x6b, 0xe4, 0x7d,
-	0xa3, 0x15, 0x56, 0x1b, 0xad, 0xf0, 0xb9, 0xd1, 0x0a, 0x4f, 0xe9, 0x73, 0x71, 0xf7, 0xc5, 0x0c,
-	0xc0, 0x7a, 0x93, 0x8f, 0x83, 0xcb, 0xc8, 0xe3, 0x96, 0xe8, 0x3d, 0x57, 0xe5, 0xef, 0xdc, 0xfc,
-	0x04, 0x00, 0x00, 0xff, 0xff, 0x61, 0x60, 0xe9, 0xdf, 0x82, 0x02, 0x00, 0x00,
+	// 430 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0xcd, 0x6e, 0xd3, 0x40,
+	0x14, 0x85, 0x33, 0x6d, 0x13, 0x9a, 0xb1, 0x03, 0x68, 0xf8, 0xe9, 0x28, 0x05, 0xcb, 0xea, 0x02,
+	0x99, 0x05, 0x36, 0x09, 0xea, 0x03, 0x50, 0x05, 0x15, 0x76, 0xd5, 0x80, 0x58, 0xb0, 0xb1, 0x4c,
+	0x3c, 0xb2, 0x2c, 0x5c, 0x5f, 0xcb, 0x77, 0x3a, 0x6a, 0xdf, 0x82, 0xf7, 0xe0, 0x45, 0x58, 0x66,
+	0xc9, 0x12, 0x25, 0x2f, 0x82, 0x3c, 0xb6, 0xf3, 0x63, 0xd2, 0xa5, 0xef, 0xf9, 0xce, 0x99, 0xab,
+	0x33, 0x63, 0x7a, 0x3a, 0x07, 0xbc, 0x06, 0x0c, 0x12, 0xd0, 0x81, 0x9e, 0x04, 0x89, 0xcc, 0x25,
+	0xa6, 0xe8, 0x17, 0x25, 0x28, 0x60, 0xa3, 0x5a, 0xf4, 0x13, 0xd0, 0xbe, 0x9e, 0x8c, 0x4f, 0x3a,
+	0x2c, 0xe8, 0x9a, 0x3b, 0xfb, 0xd5, 0xa7, 0xf6, 0x65, 0xed, 0xfc, 0xac, 0x22, 0x25, 0xd9, 0x5b,
+	0xfa, 0x14, 0x55, 0x54, 0xaa, 0x34, 0x4f, 0xc2, 0xa2, 0x84, 0x02, 0x30, 0xca, 0xc2, 0x34, 0xe6,
+	0xc4, 0x25, 0xde, 0x91, 0x60, 0xad, 0x76, 0xd5, 0x48, 0x9f, 0x62, 0x36, 0xa5, 0xc7, 0xb1, 0x2c,
+	0x00, 0x53, 0x85, 0xfc, 0xc0, 0x3d, 0xf4, 0xac, 0xe9, 0x73, 0x7f, 0xe7, 0x74, 0x7f, 0x56, 0xcb,
+	0x62, 0xcd, 0xb1, 0xd7, 0xb4, 0xaf, 0x41, 0x49, 0xe4, 0x87, 0xc6, 0xf0, 0xa4, 0x63, 0xf8, 0x0a,
+	0x4a, 0x8a, 0x9a, 0x60, 0xe7, 0x74, 0xd8, 0xee, 0x81, 0xfc, 0xc8, 0xe0, 0x27, 0x1d, 0xbc, 0x5d,
+	0x46, 0x6c, 0x48, 0x76, 0x49, 0x1f, 0x36, 0xa7, 0x85, 0x45, 0x54, 0x46, 0xd7, 0xc8, 0xfb, 0x2e,
+	0xf1, 0xac, 0xe9, 0x8b, 0xfd, 0xbb, 0x5d, 0x19, 0xe6, 0xe2, 0x80, 0x13, 0x31, 0x8a, 0xb7, 0x47,
+	0x6c, 0x46, 0x47, 0x1a, 0xea, 0x3a, 0xea, 0x9c, 0x81, 0xc9, 0x39, 0xfd, 0x7f, 0xe5, 0xaa, 0x96,
+	0x4d, 0x8c, 0xad, 0xb7, 0x26, 0xec, 0x3d, 0xb5, 0x55, 0x94, 0x65, 0x77, 0x6d, 0xc8, 0x03, 0x13,
+	0x32, 0xee, 0x84, 0x7c, 0xa9, 0x90, 0xad, 0x0c, 0x4b, 0x6d, 0x06, 0xec, 0x0d, 0x1d, 0x34, 0xe6,
+	0x63, 0x63, 0x7e, 0xd6, 0x6d, 0xc1, 0x88, 0xa2, 0x81, 0xd8, 0x19, 0xb5, 0xe7, 0x90, 0xa3, 0x4a,
+	0xd5, 0x8d, 0x4a, 0x21, 0xe7, 0x43, 0x97, 0x78, 0x43, 0xb1, 0x33, 0x63, 0x1f, 0xe9, 0xe3, 0xaa,
+	0xe4, 0x30, 0x96, 0x99, 0x4c, 0xa2, 0x6a, 0x84, 0x9c, 0x9a, 0x8a, 0x5f, 0xee, 0xb9, 0x91, 0xd9,
+	0x9a, 0x12, 0x8f, 0xf4, 0xce, 0x37, 0xb2, 0x98, 0x8e, 0xd7, 0xaf, 0x45, 0xde, 0xca, 0xb9, 0xc9,
+	0x0f, 0x4b, 0x89, 0x37, 0x99, 0x42, 0x6e, 0x99, 0xcc, 0x57, 0xf7, 0x5c, 0xdb, 0x87, 0x96, 0x17,
+	0x06, 0x17, 0xbc, 0xd8, 0x2f, 0xe0, 0xc5, 0xf9, 0xef, 0xa5, 0x43, 0x16, 0x4b, 0x87, 0xfc, 0x5d,
+	0x3a, 0xe4, 0xe7, 0xca, 0xe9, 0x2d, 0x56, 0x4e, 0xef, 0xcf, 0xca, 0xe9, 0x7d, 0x6b, 0x7e, 0x06,
+	0x8c, 0x7f, 0xf8, 0x29, 0x04, 0xb7, 0xe6, 0xa1, 0xab, 0xbb, 0x42, 0x62, 0xa0, 0x27, 0xdf, 0x07,
+	0xe6, 0xad, 0xbf, 0xfb, 0x37, 0x00, 0xe5, 0xf7, 0xb0, 0x82, 0x32, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -206,6 +232,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ProposalExecutionResults) > 0 {
+		for iNdEx := len(m.ProposalExecutionResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProposalExecutionResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.VoteDelegations) > 0 {
+		for iNdEx := len(m.VoteDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VoteDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if len(m.Constitution) > 0 {
 		i -= len(m.Constitution)
 		copy(dAtA[i:], m.Constitution)
@@ -369,6 +423,18 @@ func (m *GenesisState) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.VoteDelegations) > 0 {
+		for _, e := range m.VoteDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ProposalExecutionResults) > 0 {
+		for _, e := range m.ProposalExecutionResults {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -704,6 +770,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.Constitution = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteDelegations = append(m.VoteDelegations, &VoteDelegation{})
+			if err := m.VoteDelegations[len(m.VoteDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalExecutionResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalExecutionResults = append(m.ProposalExecutionResults, &ProposalExecutionResult{})
+			if err := m.ProposalExecutionResults[len(m.ProposalExecutionResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
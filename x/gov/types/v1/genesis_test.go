@@ -23,6 +23,10 @@ func TestEmptyGenesis(t *testing.T) {
 func TestValidateGenesis(t *testing.T) {
 	codec := address.NewBech32Codec("cosmos")
 	params := v1.DefaultParams()
+	delegator, err := codec.BytesToString(sdk.AccAddress("delegator"))
+	require.NoError(t, err)
+	delegate, err := codec.BytesToString(sdk.AccAddress("delegate"))
+	require.NoError(t, err)
 
 	testCases := []struct {
 		name         string
@@ -172,6 +176,98 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expErrMsg: "deposit proposal_id:1 depositor:\"depositor\"",
 		},
+		{
+			name: "non-existent proposal id in execution results",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.ProposalExecutionResults = append(state.ProposalExecutionResults,
+					&v1.ProposalExecutionResult{
+						ProposalId: 1,
+					})
+
+				return state
+			},
+			expErrMsg: "execution result proposal_id:1  has non-existent proposal id: 1",
+		},
+		{
+			name: "duplicate execution results",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.Proposals = append(state.Proposals,
+					&v1.Proposal{
+						Id: 1,
+					})
+				state.ProposalExecutionResults = append(state.ProposalExecutionResults,
+					&v1.ProposalExecutionResult{
+						ProposalId: 1,
+					},
+					&v1.ProposalExecutionResult{
+						ProposalId: 1,
+					})
+
+				return state
+			},
+			expErrMsg: "duplicate execution result",
+		},
+		{
+			name: "valid vote delegation",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.VoteDelegations = append(state.VoteDelegations,
+					&v1.VoteDelegation{
+						Delegator: delegator,
+						Delegate:  delegate,
+					})
+
+				return state
+			},
+		},
+		{
+			name: "invalid vote delegate",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.VoteDelegations = append(state.VoteDelegations,
+					&v1.VoteDelegation{
+						Delegator: delegator,
+						Delegate:  "delegate",
+					})
+
+				return state
+			},
+			expErrMsg: "invalid vote delegate address delegate",
+		},
+		{
+			name: "vote delegation to self",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.VoteDelegations = append(state.VoteDelegations,
+					&v1.VoteDelegation{
+						Delegator: delegator,
+						Delegate:  delegator,
+					})
+
+				return state
+			},
+			expErrMsg: "vote delegation to self",
+		},
+		{
+			name: "duplicate vote delegations",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.VoteDelegations = append(state.VoteDelegations,
+					&v1.VoteDelegation{
+						Delegator: delegator,
+						Delegate:  delegate,
+					},
+					&v1.VoteDelegation{
+						Delegator: delegator,
+						Delegate:  delegate,
+					})
+
+				return state
+			},
+			expErrMsg: "duplicate vote delegation",
+		},
 	}
 
 	for _, tc := range testCases {
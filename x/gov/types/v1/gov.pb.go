@@ -154,6 +154,12 @@ const (
 	// PROPOSAL_STATUS_FAILED defines a proposal status of a proposal that has
 	// failed.
 	ProposalStatus_PROPOSAL_STATUS_FAILED ProposalStatus = 5
+	// PROPOSAL_STATUS_CANCELED defines a proposal status of a proposal that has
+	// been canceled by its proposer. Canceled proposals are kept in state, like
+	// the other finished proposals, so that they can still be queried.
+	//
+	// Since: x/gov v1.0.0
+	ProposalStatus_PROPOSAL_STATUS_CANCELED ProposalStatus = 6
 )
 
 var ProposalStatus_name = map[int32]string{
@@ -163,6 +169,7 @@ var ProposalStatus_name = map[int32]string{
 	3: "PROPOSAL_STATUS_PASSED",
 	4: "PROPOSAL_STATUS_REJECTED",
 	5: "PROPOSAL_STATUS_FAILED",
+	6: "PROPOSAL_STATUS_CANCELED",
 }
 
 var ProposalStatus_value = map[string]int32{
@@ -172,6 +179,7 @@ var ProposalStatus_value = map[string]int32{
 	"PROPOSAL_STATUS_PASSED":         3,
 	"PROPOSAL_STATUS_REJECTED":       4,
 	"PROPOSAL_STATUS_FAILED":         5,
+	"PROPOSAL_STATUS_CANCELED":       6,
 }
 
 func (x ProposalStatus) String() string {
@@ -723,6 +731,11 @@ type Vote struct {
 	// metadata is any arbitrary metadata attached to the vote.
 	// the recommended format of the metadata is to be found here: https://docs.cosmos.network/v0.47/modules/gov#vote-5
 	Metadata string `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// voting_power is the voting power derived from the voter's own delegations to bonded validators
+	// at the time of the vote. It is used for the live tally of the proposal and is empty if zero.
+	//
+	// Since: x/gov v1.0.0
+	VotingPower string `protobuf:"bytes,6,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
 }
 
 func (m *Vote) Reset()         { *m = Vote{} }
@@ -786,6 +799,13 @@ func (m *Vote) GetMetadata() string {
 	return ""
 }
 
+func (m *Vote) GetVotingPower() string {
+	if m != nil {
+		return m.VotingPower
+	}
+	return ""
+}
+
 // DepositParams defines the params for deposits on governance proposals.
 //
 // Deprecated: Do not use.
@@ -971,15 +991,15 @@ type Params struct {
 	MaxDepositPeriod *time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty"`
 	// Duration of the voting period.
 	VotingPeriod *time.Duration `protobuf:"bytes,3,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
-	// Minimum percentage of total stake needed to vote for a result to be
-	// considered valid.
+	//  Minimum percentage of total stake needed to vote for a result to be
+	//  considered valid.
 	Quorum string `protobuf:"bytes,4,opt,name=quorum,proto3" json:"quorum,omitempty"`
-	// Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
+	//  Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
 	Threshold string `protobuf:"bytes,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
-	// Minimum value of Veto votes to Total votes ratio for proposal to be
-	// vetoed. Default value: 1/3.
+	//  Minimum value of Veto votes to Total votes ratio for proposal to be
+	//  vetoed. Default value: 1/3.
 	VetoThreshold string `protobuf:"bytes,6,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
-	// The ratio representing the proportion of the deposit value that must be paid at proposal submission.
+	//  The ratio representing the proportion of the deposit value that must be paid at proposal submission.
 	MinInitialDepositRatio string `protobuf:"bytes,7,opt,name=min_initial_deposit_ratio,json=minInitialDepositRatio,proto3" json:"min_initial_deposit_ratio,omitempty"`
 	// The cancel ratio which will not be returned back to the depositors when a proposal is cancelled.
 	//
@@ -998,7 +1018,7 @@ type Params struct {
 	//
 	// Since: cosmos-sdk 0.50
 	ExpeditedThreshold string `protobuf:"bytes,11,opt,name=expedited_threshold,json=expeditedThreshold,proto3" json:"expedited_threshold,omitempty"`
-	// Minimum expedited deposit for a proposal to enter voting period.
+	//  Minimum expedited deposit for a proposal to enter voting period.
 	ExpeditedMinDeposit []types.Coin `protobuf:"bytes,12,rep,name=expedited_min_deposit,json=expeditedMinDeposit,proto3" json:"expedited_min_deposit"`
 	// burn deposits if a proposal does not meet quorum
 	//
@@ -1045,6 +1065,13 @@ type Params struct {
 	//
 	// Since: x/gov v1.0.0
 	ExpeditedQuorum string `protobuf:"bytes,21,opt,name=expedited_quorum,json=expeditedQuorum,proto3" json:"expedited_quorum,omitempty"`
+	// min_deposit_any_denom defines whether the minimum deposit (or expedited minimum deposit) is reached
+	// as soon as the deposit meets the minimum amount of any single one of its denoms, instead of all of them.
+	// This allows chains with multiple native assets to accept either of them for proposal deposits.
+	// Default value: false.
+	//
+	// Since: x/gov v1.0.0
+	MinDepositAnyDenom bool `protobuf:"varint,22,opt,name=min_deposit_any_denom,json=minDepositAnyDenom,proto3" json:"min_deposit_any_denom,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -1227,6 +1254,13 @@ func (m *Params) GetExpeditedQuorum() string {
 	return ""
 }
 
+func (m *Params) GetMinDepositAnyDenom() bool {
+	if m != nil {
+		return m.MinDepositAnyDenom
+	}
+	return false
+}
+
 // MessageBasedParams defines the parameters of specific messages in a proposal.
 // It is used to define the parameters of a proposal that is based on a specific message.
 // Once a message has message based params, it only supports a standard proposal type.
@@ -1314,6 +1348,199 @@ func (m *MessageBasedParams) GetVetoThreshold() string {
 	return ""
 }
 
+// VoteDelegation defines a delegation of governance voting power from a delegator to a delegate.
+// The voting power derived from the bonded stake of the delegator follows the vote of the delegate,
+// unless the delegator votes directly on a proposal.
+//
+// Since: x/gov v1.0.0
+type VoteDelegation struct {
+	// delegator is the account address delegating its governance voting power.
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	// delegate is the account address voting on behalf of the delegator.
+	Delegate string `protobuf:"bytes,2,opt,name=delegate,proto3" json:"delegate,omitempty"`
+}
+
+func (m *VoteDelegation) Reset()         { *m = VoteDelegation{} }
+func (m *VoteDelegation) String() string { return proto.CompactTextString(m) }
+func (*VoteDelegation) ProtoMessage()    {}
+func (*VoteDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{11}
+}
+func (m *VoteDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoteDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoteDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoteDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteDelegation.Merge(m, src)
+}
+func (m *VoteDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoteDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteDelegation proto.InternalMessageInfo
+
+func (m *VoteDelegation) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+func (m *VoteDelegation) GetDelegate() string {
+	if m != nil {
+		return m.Delegate
+	}
+	return ""
+}
+
+// MessageExecutionResult defines the execution result of a single message of a passed proposal.
+//
+// Since: x/gov v1.0.0
+type MessageExecutionResult struct {
+	// msg_type_url is the type URL of the executed message.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// success is true if the message was executed without error.
+	Success bool `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	// error is the error returned by the message handler, if the message execution failed.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// response_digest is the SHA-256 digest of the encoded message response, if the message execution succeeded.
+	ResponseDigest []byte `protobuf:"bytes,4,opt,name=response_digest,json=responseDigest,proto3" json:"response_digest,omitempty"`
+}
+
+func (m *MessageExecutionResult) Reset()         { *m = MessageExecutionResult{} }
+func (m *MessageExecutionResult) String() string { return proto.CompactTextString(m) }
+func (*MessageExecutionResult) ProtoMessage()    {}
+func (*MessageExecutionResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{12}
+}
+func (m *MessageExecutionResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessageExecutionResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessageExecutionResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MessageExecutionResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageExecutionResult.Merge(m, src)
+}
+func (m *MessageExecutionResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessageExecutionResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageExecutionResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessageExecutionResult proto.InternalMessageInfo
+
+func (m *MessageExecutionResult) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *MessageExecutionResult) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *MessageExecutionResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *MessageExecutionResult) GetResponseDigest() []byte {
+	if m != nil {
+		return m.ResponseDigest
+	}
+	return nil
+}
+
+// ProposalExecutionResult defines the execution result of the messages of a passed proposal.
+// Messages are executed in order until one of them fails, in which case the state changes
+// of all the messages of the proposal are reverted.
+//
+// Since: x/gov v1.0.0
+type ProposalExecutionResult struct {
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// message_results defines the execution results of the executed messages, in order.
+	MessageResults []*MessageExecutionResult `protobuf:"bytes,2,rep,name=message_results,json=messageResults,proto3" json:"message_results,omitempty"`
+}
+
+func (m *ProposalExecutionResult) Reset()         { *m = ProposalExecutionResult{} }
+func (m *ProposalExecutionResult) String() string { return proto.CompactTextString(m) }
+func (*ProposalExecutionResult) ProtoMessage()    {}
+func (*ProposalExecutionResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{13}
+}
+func (m *ProposalExecutionResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposalExecutionResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposalExecutionResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposalExecutionResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalExecutionResult.Merge(m, src)
+}
+func (m *ProposalExecutionResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposalExecutionResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalExecutionResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalExecutionResult proto.InternalMessageInfo
+
+func (m *ProposalExecutionResult) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *ProposalExecutionResult) GetMessageResults() []*MessageExecutionResult {
+	if m != nil {
+		return m.MessageResults
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.gov.v1.ProposalType", ProposalType_name, ProposalType_value)
 	proto.RegisterEnum("cosmos.gov.v1.VoteOption", VoteOption_name, VoteOption_value)
@@ -1329,129 +1556,145 @@ func init() {
 	proto.RegisterType((*TallyParams)(nil), "cosmos.gov.v1.TallyParams")
 	proto.RegisterType((*Params)(nil), "cosmos.gov.v1.Params")
 	proto.RegisterType((*MessageBasedParams)(nil), "cosmos.gov.v1.MessageBasedParams")
+	proto.RegisterType((*VoteDelegation)(nil), "cosmos.gov.v1.VoteDelegation")
+	proto.RegisterType((*MessageExecutionResult)(nil), "cosmos.gov.v1.MessageExecutionResult")
+	proto.RegisterType((*ProposalExecutionResult)(nil), "cosmos.gov.v1.ProposalExecutionResult")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/gov.proto", fileDescriptor_e05cb1c0d030febb) }
 
 var fileDescriptor_e05cb1c0d030febb = []byte{
-	// 1867 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4f, 0x73, 0xe3, 0x48,
-	0x15, 0x1f, 0xd9, 0x8e, 0x63, 0xbf, 0x38, 0x8e, 0xa6, 0x93, 0xd9, 0x28, 0xc9, 0xe6, 0xcf, 0x98,
-	0xad, 0xad, 0xd4, 0xb0, 0xb1, 0xc9, 0xc2, 0x50, 0xb0, 0x2c, 0x05, 0x76, 0xac, 0x21, 0x1a, 0x92,
-	0xd8, 0xc8, 0x9a, 0x64, 0x86, 0x8b, 0x50, 0xa2, 0x9e, 0x44, 0x60, 0xa9, 0x8d, 0xd4, 0x4e, 0x62,
-	0x3e, 0xc5, 0x9e, 0x28, 0x4e, 0x14, 0x37, 0x38, 0x72, 0xd8, 0xa2, 0xf8, 0x08, 0x5b, 0x1c, 0xa8,
-	0xad, 0x3d, 0x71, 0x61, 0xa0, 0x66, 0x0e, 0x54, 0xed, 0x47, 0xa0, 0xf6, 0x40, 0xf5, 0x1f, 0x59,
-	0xb2, 0xe3, 0x4c, 0x92, 0x2d, 0x2e, 0x89, 0xf5, 0xde, 0xef, 0xf7, 0xfa, 0xf5, 0xfb, 0xd7, 0x2d,
-	0xc1, 0xe2, 0x09, 0x89, 0x7c, 0x12, 0xd5, 0x4e, 0xc9, 0x79, 0xed, 0x7c, 0x9b, 0xfd, 0xab, 0xf6,
-	0x42, 0x42, 0x09, 0x9a, 0x15, 0x8a, 0x2a, 0x93, 0x9c, 0x6f, 0x2f, 0xaf, 0x49, 0xdc, 0xb1, 0x13,
-	0xe1, 0xda, 0xf9, 0xf6, 0x31, 0xa6, 0xce, 0x76, 0xed, 0x84, 0x78, 0x81, 0x80, 0x2f, 0x2f, 0x9c,
-	0x92, 0x53, 0xc2, 0x7f, 0xd6, 0xd8, 0x2f, 0x29, 0x5d, 0x3f, 0x25, 0xe4, 0xb4, 0x8b, 0x6b, 0xfc,
-	0xe9, 0xb8, 0xff, 0xb2, 0x46, 0x3d, 0x1f, 0x47, 0xd4, 0xf1, 0x7b, 0x12, 0xb0, 0x34, 0x0e, 0x70,
-	0x82, 0x81, 0x54, 0xad, 0x8d, 0xab, 0xdc, 0x7e, 0xe8, 0x50, 0x8f, 0xc4, 0x2b, 0x2e, 0x09, 0x8f,
-	0x6c, 0xb1, 0xa8, 0xf4, 0x56, 0xa8, 0xee, 0x3b, 0xbe, 0x17, 0x90, 0x1a, 0xff, 0x2b, 0x44, 0x15,
-	0x02, 0xe8, 0x08, 0x7b, 0xa7, 0x67, 0x14, 0xbb, 0x87, 0x84, 0xe2, 0x56, 0x8f, 0x59, 0x42, 0xdb,
-	0x90, 0x27, 0xfc, 0x97, 0xa6, 0x6c, 0x28, 0x9b, 0xe5, 0x0f, 0x97, 0xaa, 0x23, 0xbb, 0xae, 0x26,
-	0x50, 0x53, 0x02, 0xd1, 0xfb, 0x90, 0xbf, 0xe0, 0x86, 0xb4, 0xcc, 0x86, 0xb2, 0x59, 0x6c, 0x94,
-	0xbf, 0xf8, 0x74, 0x0b, 0x24, 0xab, 0x89, 0x4f, 0x4c, 0xa9, 0xad, 0xfc, 0x41, 0x81, 0xe9, 0x26,
-	0xee, 0x91, 0xc8, 0xa3, 0x68, 0x1d, 0x66, 0x7a, 0x21, 0xe9, 0x91, 0xc8, 0xe9, 0xda, 0x9e, 0xcb,
-	0xd7, 0xca, 0x99, 0x10, 0x8b, 0x0c, 0x17, 0x7d, 0x17, 0x8a, 0xae, 0xc0, 0x92, 0x50, 0xda, 0xd5,
-	0xbe, 0xf8, 0x74, 0x6b, 0x41, 0xda, 0xad, 0xbb, 0x6e, 0x88, 0xa3, 0xa8, 0x43, 0x43, 0x2f, 0x38,
-	0x35, 0x13, 0x28, 0xfa, 0x18, 0xf2, 0x8e, 0x4f, 0xfa, 0x01, 0xd5, 0xb2, 0x1b, 0xd9, 0xcd, 0x99,
-	0xc4, 0x7f, 0x96, 0xa6, 0xaa, 0x4c, 0x53, 0x75, 0x87, 0x78, 0x41, 0xa3, 0xf8, 0xd9, 0xab, 0xf5,
-	0x7b, 0x7f, 0xfa, 0xcf, 0x9f, 0x1f, 0x29, 0xa6, 0xe4, 0x54, 0xfe, 0x96, 0x87, 0x42, 0x5b, 0x3a,
-	0x81, 0xca, 0x90, 0x19, 0xba, 0x96, 0xf1, 0x5c, 0xf4, 0x2d, 0x28, 0xf8, 0x38, 0x8a, 0x9c, 0x53,
-	0x1c, 0x69, 0x19, 0x6e, 0x7c, 0xa1, 0x2a, 0x32, 0x52, 0x8d, 0x33, 0x52, 0xad, 0x07, 0x03, 0x73,
-	0x88, 0x42, 0x8f, 0x21, 0x1f, 0x51, 0x87, 0xf6, 0x23, 0x2d, 0xcb, 0x83, 0xb9, 0x3a, 0x16, 0xcc,
-	0x78, 0xa9, 0x0e, 0x07, 0x99, 0x12, 0x8c, 0x76, 0x01, 0xbd, 0xf4, 0x02, 0xa7, 0x6b, 0x53, 0xa7,
-	0xdb, 0x1d, 0xd8, 0x21, 0x8e, 0xfa, 0x5d, 0xaa, 0xe5, 0x36, 0x94, 0xcd, 0x99, 0x0f, 0x97, 0xc7,
-	0x4c, 0x58, 0x0c, 0x62, 0x72, 0x84, 0xa9, 0x72, 0x56, 0x4a, 0x82, 0xea, 0x30, 0x13, 0xf5, 0x8f,
-	0x7d, 0x8f, 0xda, 0xac, 0xcc, 0xb4, 0x29, 0x69, 0x62, 0xdc, 0x6b, 0x2b, 0xae, 0xc1, 0x46, 0xee,
-	0x93, 0x7f, 0xad, 0x2b, 0x26, 0x08, 0x12, 0x13, 0xa3, 0xa7, 0xa0, 0xca, 0xe8, 0xda, 0x38, 0x70,
-	0x85, 0x9d, 0xfc, 0x2d, 0xed, 0x94, 0x25, 0x53, 0x0f, 0x5c, 0x6e, 0xcb, 0x80, 0x59, 0x4a, 0xa8,
-	0xd3, 0xb5, 0xa5, 0x5c, 0x9b, 0xbe, 0x43, 0x8e, 0x4a, 0x9c, 0x1a, 0x17, 0xd0, 0x1e, 0xdc, 0x3f,
-	0x27, 0xd4, 0x0b, 0x4e, 0xed, 0x88, 0x3a, 0xa1, 0xdc, 0x5f, 0xe1, 0x96, 0x7e, 0xcd, 0x09, 0x6a,
-	0x87, 0x31, 0xb9, 0x63, 0xbb, 0x20, 0x45, 0xc9, 0x1e, 0x8b, 0xb7, 0xb4, 0x35, 0x2b, 0x88, 0xf1,
-	0x16, 0x97, 0x59, 0x91, 0x50, 0xc7, 0x75, 0xa8, 0xa3, 0x01, 0x2b, 0x5b, 0x73, 0xf8, 0x8c, 0x16,
-	0x60, 0x8a, 0x7a, 0xb4, 0x8b, 0xb5, 0x19, 0xae, 0x10, 0x0f, 0x48, 0x83, 0xe9, 0xa8, 0xef, 0xfb,
-	0x4e, 0x38, 0xd0, 0x4a, 0x5c, 0x1e, 0x3f, 0xa2, 0xef, 0x40, 0x41, 0x74, 0x04, 0x0e, 0xb5, 0xd9,
-	0x1b, 0x5a, 0x60, 0x88, 0x44, 0x1b, 0x50, 0xc4, 0x97, 0x3d, 0xec, 0x7a, 0x14, 0xbb, 0x5a, 0x79,
-	0x43, 0xd9, 0x2c, 0x34, 0x32, 0x9a, 0x62, 0x26, 0x42, 0xf4, 0x0d, 0x98, 0x7d, 0xe9, 0x78, 0x5d,
-	0xec, 0xda, 0x21, 0x76, 0x22, 0x12, 0x68, 0x73, 0x7c, 0xdd, 0x92, 0x10, 0x9a, 0x5c, 0x86, 0x7e,
-	0x0c, 0xb3, 0xc3, 0x0e, 0xa5, 0x83, 0x1e, 0xd6, 0x54, 0x5e, 0xc2, 0x2b, 0xd7, 0x94, 0xb0, 0x35,
-	0xe8, 0x61, 0xb3, 0xd4, 0x4b, 0x3d, 0x55, 0xfe, 0xaa, 0xc0, 0x7c, 0xac, 0x4e, 0xc6, 0x46, 0x84,
-	0x56, 0x01, 0xc4, 0xe4, 0xb0, 0x49, 0x80, 0x79, 0x7f, 0x15, 0xcd, 0xa2, 0x90, 0xb4, 0x02, 0x9c,
-	0x52, 0xd3, 0x0b, 0x22, 0x5a, 0x3f, 0x56, 0x5b, 0x17, 0x04, 0x3d, 0x84, 0x52, 0xac, 0x3e, 0x0b,
-	0x31, 0xe6, 0x9d, 0x55, 0x34, 0x67, 0x24, 0x80, 0x89, 0xd8, 0x70, 0x91, 0x90, 0x97, 0xa4, 0x1f,
-	0xf2, 0xc6, 0x29, 0x9a, 0xd2, 0xe8, 0x13, 0xd2, 0x0f, 0x53, 0x80, 0xa8, 0xe7, 0xf8, 0xbc, 0x2d,
-	0x86, 0x80, 0x4e, 0xcf, 0xf1, 0x2b, 0x5f, 0x65, 0x61, 0x26, 0xdd, 0x47, 0x5b, 0x50, 0x1c, 0xe0,
-	0xc8, 0x3e, 0xe1, 0x83, 0x85, 0x7b, 0xdc, 0x50, 0x53, 0x53, 0xce, 0x60, 0x52, 0xb3, 0x30, 0xc0,
-	0xd1, 0x0e, 0x43, 0xa0, 0xc7, 0x30, 0xeb, 0x1c, 0x47, 0xd4, 0xf1, 0x02, 0x49, 0xc9, 0x5c, 0x43,
-	0x29, 0x49, 0x98, 0xa0, 0x7d, 0x13, 0x0a, 0x01, 0x91, 0x8c, 0xec, 0x35, 0x8c, 0xe9, 0x80, 0x08,
-	0xf0, 0x0f, 0x01, 0x05, 0xc4, 0xbe, 0xf0, 0xe8, 0x99, 0x7d, 0x8e, 0x69, 0x4c, 0xcb, 0x5d, 0x43,
-	0x9b, 0x0b, 0xc8, 0x91, 0x47, 0xcf, 0x0e, 0x31, 0x95, 0xf4, 0xef, 0x81, 0x9a, 0x24, 0x41, 0x92,
-	0xa7, 0xae, 0x8c, 0x6f, 0x23, 0xa0, 0x66, 0x79, 0x98, 0x9a, 0x71, 0x26, 0xbd, 0x88, 0x97, 0xcd,
-	0xbf, 0x8d, 0x69, 0x5d, 0xc8, 0x35, 0x3f, 0x06, 0x94, 0x4e, 0x9d, 0xe4, 0x4e, 0x4f, 0xe4, 0xaa,
-	0xa9, 0x84, 0x0a, 0xf6, 0x47, 0x70, 0x3f, 0x95, 0x55, 0x49, 0x2e, 0x4c, 0x24, 0xcf, 0x25, 0xb9,
-	0x16, 0xdc, 0x2d, 0x00, 0x96, 0x69, 0x49, 0x2a, 0x4e, 0x24, 0x15, 0x19, 0x82, 0xc3, 0x2b, 0x7f,
-	0x51, 0x20, 0xc7, 0x2a, 0xf6, 0xe6, 0x63, 0xaa, 0x0a, 0x53, 0xe7, 0x84, 0xe2, 0x9b, 0x8f, 0x28,
-	0x01, 0x43, 0x3f, 0x80, 0x69, 0xe1, 0x5b, 0xa4, 0xe5, 0xf8, 0xec, 0x7b, 0x38, 0xd6, 0x4f, 0x57,
-	0x8f, 0x64, 0x33, 0x66, 0x8c, 0xcc, 0x96, 0xa9, 0xd1, 0xd9, 0xf2, 0x34, 0x57, 0xc8, 0xaa, 0xb9,
-	0xca, 0x3f, 0x15, 0x98, 0x95, 0x13, 0xb2, 0xed, 0x84, 0x8e, 0x1f, 0xa1, 0x17, 0x30, 0xe3, 0x7b,
-	0xc1, 0x70, 0xe0, 0x2a, 0x37, 0x0d, 0xdc, 0x55, 0x36, 0x70, 0xbf, 0x7c, 0xb5, 0xfe, 0x20, 0xc5,
-	0xfa, 0x80, 0xf8, 0x1e, 0xc5, 0x7e, 0x8f, 0x0e, 0x4c, 0xf0, 0xbd, 0x20, 0x1e, 0xc1, 0x3e, 0x20,
-	0xdf, 0xb9, 0x8c, 0x41, 0x76, 0x0f, 0x87, 0x1e, 0x71, 0x79, 0x20, 0xd8, 0x0a, 0xe3, 0x73, 0xb3,
-	0x29, 0xef, 0x2a, 0x8d, 0xf7, 0xbe, 0x7c, 0xb5, 0xfe, 0xee, 0x55, 0x62, 0xb2, 0xc8, 0xef, 0xd8,
-	0x58, 0x55, 0x7d, 0xe7, 0x32, 0xde, 0x09, 0xd7, 0x7f, 0x94, 0xd1, 0x94, 0xca, 0x73, 0x28, 0x1d,
-	0xf2, 0x71, 0x2b, 0x77, 0xd7, 0x04, 0x39, 0x7e, 0xe3, 0xd5, 0x95, 0x9b, 0x56, 0xcf, 0x71, 0xeb,
-	0x25, 0xc1, 0x4a, 0x59, 0xfe, 0xbd, 0x22, 0x3b, 0x5e, 0x5a, 0x7e, 0x1f, 0xf2, 0xbf, 0xee, 0x93,
-	0xb0, 0xef, 0xcb, 0x76, 0xbf, 0x72, 0xa9, 0x11, 0x5a, 0xf4, 0x01, 0x14, 0x59, 0x31, 0x47, 0x67,
-	0xa4, 0xeb, 0x5e, 0x73, 0xff, 0x49, 0x00, 0xe8, 0x31, 0x94, 0x79, 0xb3, 0x26, 0x94, 0xec, 0x44,
-	0xca, 0x2c, 0x43, 0x59, 0x31, 0x88, 0x3b, 0xf8, 0x15, 0x40, 0x5e, 0xfa, 0xa6, 0xdf, 0x31, 0xa7,
-	0xa9, 0x43, 0x34, 0x9d, 0xbf, 0xfd, 0xaf, 0x97, 0xbf, 0xdc, 0xe4, 0xfc, 0x5c, 0xcd, 0x45, 0xf6,
-	0x6b, 0xe4, 0x22, 0x15, 0xf7, 0xdc, 0xed, 0xe3, 0x3e, 0x75, 0xf7, 0xb8, 0xe7, 0x6f, 0x11, 0x77,
-	0x64, 0xc0, 0x12, 0x0b, 0xb4, 0x17, 0x78, 0xd4, 0x4b, 0x6e, 0x2d, 0x36, 0x77, 0x7f, 0xc2, 0xdc,
-	0x62, 0x16, 0xde, 0xf1, 0xbd, 0xc0, 0x10, 0x78, 0x19, 0x1e, 0x93, 0xa1, 0x51, 0x03, 0x1e, 0x0c,
-	0x27, 0xc9, 0x89, 0x13, 0x9c, 0xe0, 0xae, 0x34, 0x53, 0x98, 0x68, 0x66, 0x3e, 0x06, 0xef, 0x70,
-	0xac, 0xb0, 0xf1, 0x14, 0x16, 0xc6, 0x6d, 0xb8, 0x38, 0x8a, 0xe7, 0xd9, 0xf5, 0xb3, 0x07, 0x8d,
-	0x1a, 0x6b, 0xe2, 0x88, 0xa2, 0x23, 0x58, 0x1c, 0x5e, 0x08, 0xec, 0xd1, 0xbc, 0xc1, 0xed, 0xf2,
-	0xf6, 0x60, 0xc8, 0x3f, 0x4c, 0x27, 0xf0, 0x47, 0x30, 0x9f, 0x18, 0x4e, 0xe2, 0x3d, 0x33, 0x71,
-	0x9b, 0x68, 0x08, 0x4d, 0x82, 0xfe, 0x1c, 0x12, 0xcb, 0x76, 0xba, 0xce, 0x4b, 0x77, 0xa8, 0xf3,
-	0xc4, 0x87, 0xfd, 0xa4, 0xe0, 0x37, 0x41, 0x3d, 0xee, 0x87, 0x01, 0xdb, 0x2e, 0xb6, 0x65, 0x95,
-	0xb1, 0x7b, 0x55, 0xc1, 0x2c, 0x33, 0x39, 0x1b, 0xb9, 0x3f, 0x13, 0xd5, 0x55, 0x87, 0x55, 0x8e,
-	0x1c, 0x86, 0x7b, 0xd8, 0x24, 0x21, 0x66, 0x6c, 0x71, 0xaf, 0x32, 0x97, 0x19, 0x28, 0xbe, 0xe2,
-	0xc4, 0xdd, 0x20, 0x10, 0xe8, 0x3d, 0x28, 0x27, 0x8b, 0xb1, 0xb2, 0xe2, 0xb7, 0xac, 0x82, 0x59,
-	0x8a, 0x97, 0x62, 0x67, 0x31, 0x3b, 0xd4, 0x52, 0x5b, 0x94, 0x25, 0xa1, 0x4e, 0x8c, 0xd5, 0x5c,
-	0xd2, 0xba, 0xa2, 0x1c, 0x7e, 0x0a, 0xcb, 0xe3, 0xe5, 0xc0, 0xfa, 0x59, 0x66, 0xf1, 0xfe, 0x44,
-	0x23, 0x8b, 0xa3, 0xa5, 0xb0, 0xef, 0x5c, 0xca, 0xb4, 0xfd, 0x02, 0xd6, 0xd9, 0x31, 0xe3, 0x7b,
-	0x11, 0xf5, 0x4e, 0x6c, 0xa7, 0x4f, 0xcf, 0x48, 0xe8, 0xfd, 0x06, 0xbb, 0xb6, 0x23, 0x4a, 0x09,
-	0x47, 0x1a, 0xda, 0xc8, 0xbe, 0xb5, 0xcc, 0x56, 0x13, 0x03, 0xf5, 0x21, 0xbf, 0x1e, 0xd3, 0x91,
-	0x09, 0x29, 0x80, 0x1d, 0xe2, 0x5f, 0xe2, 0x93, 0xd1, 0x12, 0x99, 0x9f, 0xe8, 0xf1, 0x4a, 0x42,
-	0x32, 0x25, 0x27, 0xa9, 0x95, 0x2d, 0x00, 0x76, 0x2f, 0x93, 0xb9, 0x5c, 0x98, 0x3c, 0x06, 0x06,
-	0x38, 0x92, 0x69, 0xfd, 0x3e, 0xa8, 0x49, 0x69, 0x49, 0xd2, 0x83, 0xc9, 0xc1, 0x1e, 0xe2, 0x04,
-	0xb5, 0xf2, 0xdb, 0x0c, 0xa0, 0x7d, 0xf1, 0x5e, 0xd7, 0x70, 0x22, 0xec, 0xfe, 0x3f, 0x0f, 0xa0,
-	0xd4, 0xd0, 0xcb, 0xbc, 0x75, 0xe8, 0xdd, 0x71, 0xbb, 0x23, 0x33, 0x32, 0x7b, 0xf7, 0x19, 0x99,
-	0xbb, 0xc5, 0x8c, 0x7c, 0xf4, 0x47, 0x05, 0x4a, 0xe9, 0x97, 0x00, 0xb4, 0x0a, 0x4b, 0x6d, 0xb3,
-	0xd5, 0x6e, 0x75, 0xea, 0x7b, 0xb6, 0xf5, 0xa2, 0xad, 0xdb, 0xcf, 0x0e, 0x3a, 0x6d, 0x7d, 0xc7,
-	0x78, 0x62, 0xe8, 0x4d, 0xf5, 0x1e, 0x5a, 0x86, 0x77, 0x46, 0xd5, 0x1d, 0xab, 0x7e, 0xd0, 0xac,
-	0x9b, 0x4d, 0x55, 0x41, 0x0f, 0x61, 0x75, 0x54, 0xb7, 0xff, 0x6c, 0xcf, 0x32, 0xda, 0x7b, 0xba,
-	0xbd, 0xb3, 0xdb, 0x32, 0x76, 0x74, 0x35, 0x83, 0xde, 0x05, 0x6d, 0x14, 0xd2, 0x6a, 0x5b, 0xc6,
-	0xbe, 0xd1, 0xb1, 0x8c, 0x1d, 0x35, 0x8b, 0x56, 0x60, 0x71, 0x54, 0xab, 0x3f, 0x6f, 0xeb, 0x4d,
-	0xc3, 0xd2, 0x9b, 0x6a, 0xee, 0xd1, 0x7f, 0x15, 0x80, 0xd4, 0x97, 0x8e, 0x15, 0x58, 0x3c, 0x6c,
-	0x59, 0xc2, 0x40, 0xeb, 0x60, 0xcc, 0xcb, 0x79, 0x98, 0x4b, 0x2b, 0x5f, 0xe8, 0x1d, 0x55, 0x19,
-	0x17, 0xb6, 0x0e, 0x74, 0x55, 0x41, 0x8b, 0x30, 0x9f, 0x16, 0xd6, 0x1b, 0x1d, 0xab, 0x6e, 0x1c,
-	0xa8, 0x99, 0x71, 0xb4, 0x75, 0xd4, 0x52, 0x33, 0x08, 0x41, 0x39, 0x2d, 0x3c, 0x68, 0xa9, 0x59,
-	0xf4, 0x00, 0xee, 0x8f, 0x00, 0x77, 0x4d, 0x5d, 0x57, 0xb3, 0x6c, 0xa7, 0xa3, 0x50, 0xfb, 0xc8,
-	0xb0, 0x76, 0xed, 0x43, 0xdd, 0x6a, 0xa9, 0x39, 0xb4, 0x00, 0x6a, 0x5a, 0xfb, 0xa4, 0xf5, 0xcc,
-	0xbc, 0x2a, 0xed, 0xb4, 0xeb, 0xfb, 0xea, 0xd4, 0x72, 0x46, 0x55, 0x1e, 0xfd, 0x5d, 0x81, 0xf2,
-	0xe8, 0xe7, 0x06, 0xb4, 0x0e, 0x2b, 0xc3, 0x60, 0x75, 0xac, 0xba, 0xf5, 0xac, 0x33, 0x16, 0x84,
-	0x0a, 0xac, 0x8d, 0x03, 0x9a, 0x7a, 0xbb, 0xd5, 0x31, 0x2c, 0xbb, 0xad, 0x9b, 0x46, 0x6b, 0x3c,
-	0x65, 0x12, 0x73, 0xd8, 0xb2, 0x8c, 0x83, 0x9f, 0xc4, 0x90, 0xcc, 0x48, 0xc6, 0x25, 0xa4, 0x5d,
-	0xef, 0x74, 0xf4, 0xa6, 0xd8, 0xe4, 0xb8, 0xce, 0xd4, 0x9f, 0xea, 0x3b, 0x3c, 0x63, 0x93, 0x98,
-	0x4f, 0xea, 0xc6, 0x9e, 0xde, 0x54, 0xa7, 0x1a, 0x8f, 0x3f, 0x7b, 0xbd, 0xa6, 0x7c, 0xfe, 0x7a,
-	0x4d, 0xf9, 0xf7, 0xeb, 0x35, 0xe5, 0x93, 0x37, 0x6b, 0xf7, 0x3e, 0x7f, 0xb3, 0x76, 0xef, 0x1f,
-	0x6f, 0xd6, 0xee, 0xfd, 0x7c, 0x45, 0x14, 0x6b, 0xe4, 0xfe, 0xaa, 0xea, 0x91, 0xda, 0x25, 0xff,
-	0x90, 0xc7, 0xde, 0x60, 0xa3, 0xda, 0xf9, 0xf6, 0x71, 0x9e, 0x77, 0xe4, 0xb7, 0xff, 0x17, 0x00,
-	0x00, 0xff, 0xff, 0xb0, 0x5e, 0x88, 0xa8, 0xe6, 0x13, 0x00, 0x00,
+	// 2068 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4f, 0x73, 0x1b, 0x49,
+	0x15, 0xcf, 0x48, 0xb2, 0x2c, 0x3d, 0xc9, 0xf2, 0xa4, 0xed, 0xc4, 0x13, 0x7b, 0xfd, 0x27, 0x62,
+	0x59, 0x5c, 0x61, 0x23, 0xe3, 0x85, 0x50, 0xb0, 0x2c, 0x05, 0xb2, 0x34, 0x21, 0x0a, 0xb1, 0x25,
+	0x46, 0x13, 0x27, 0xe1, 0x32, 0x8c, 0x35, 0x1d, 0x79, 0x40, 0x33, 0x2d, 0xa6, 0x47, 0xb6, 0xc5,
+	0x81, 0x0f, 0xc0, 0x69, 0xab, 0xa8, 0xa2, 0x38, 0x51, 0xdc, 0xe0, 0x06, 0x87, 0x3d, 0xf0, 0x11,
+	0xb6, 0x38, 0x6d, 0xed, 0x89, 0x0b, 0x81, 0x4a, 0x0e, 0x14, 0x5b, 0x7c, 0x02, 0x8a, 0x03, 0xd5,
+	0x7f, 0x46, 0x33, 0x9a, 0xc8, 0xb1, 0xbd, 0xb5, 0x17, 0x5b, 0xfd, 0xde, 0xef, 0xf7, 0xfa, 0xf5,
+	0x7b, 0xaf, 0x5f, 0x77, 0x0f, 0xac, 0xf4, 0x08, 0xf5, 0x08, 0xdd, 0xe9, 0x93, 0x93, 0x9d, 0x93,
+	0x5d, 0xf6, 0xaf, 0x36, 0x0c, 0x48, 0x48, 0xd0, 0x82, 0x50, 0xd4, 0x98, 0xe4, 0x64, 0x77, 0x75,
+	0x43, 0xe2, 0x8e, 0x6c, 0x8a, 0x77, 0x4e, 0x76, 0x8f, 0x70, 0x68, 0xef, 0xee, 0xf4, 0x88, 0xeb,
+	0x0b, 0xf8, 0xea, 0x72, 0x9f, 0xf4, 0x09, 0xff, 0xb9, 0xc3, 0x7e, 0x49, 0xe9, 0x66, 0x9f, 0x90,
+	0xfe, 0x00, 0xef, 0xf0, 0xd1, 0xd1, 0xe8, 0xf9, 0x4e, 0xe8, 0x7a, 0x98, 0x86, 0xb6, 0x37, 0x94,
+	0x80, 0x5b, 0x69, 0x80, 0xed, 0x8f, 0xa5, 0x6a, 0x23, 0xad, 0x72, 0x46, 0x81, 0x1d, 0xba, 0x24,
+	0x9a, 0xf1, 0x96, 0xf0, 0xc8, 0x12, 0x93, 0x4a, 0x6f, 0x85, 0xea, 0xba, 0xed, 0xb9, 0x3e, 0xd9,
+	0xe1, 0x7f, 0x85, 0xa8, 0x4a, 0x00, 0x3d, 0xc1, 0x6e, 0xff, 0x38, 0xc4, 0xce, 0x21, 0x09, 0x71,
+	0x7b, 0xc8, 0x2c, 0xa1, 0x5d, 0xc8, 0x13, 0xfe, 0x4b, 0x53, 0xb6, 0x94, 0xed, 0xca, 0x7b, 0xb7,
+	0x6a, 0x53, 0xab, 0xae, 0xc5, 0x50, 0x43, 0x02, 0xd1, 0x3b, 0x90, 0x3f, 0xe5, 0x86, 0xb4, 0xcc,
+	0x96, 0xb2, 0x5d, 0xdc, 0xab, 0x7c, 0xfa, 0xd1, 0x5d, 0x90, 0xac, 0x26, 0xee, 0x19, 0x52, 0x5b,
+	0xfd, 0xbd, 0x02, 0xf3, 0x4d, 0x3c, 0x24, 0xd4, 0x0d, 0xd1, 0x26, 0x94, 0x86, 0x01, 0x19, 0x12,
+	0x6a, 0x0f, 0x2c, 0xd7, 0xe1, 0x73, 0xe5, 0x0c, 0x88, 0x44, 0x2d, 0x07, 0x7d, 0x13, 0x8a, 0x8e,
+	0xc0, 0x92, 0x40, 0xda, 0xd5, 0x3e, 0xfd, 0xe8, 0xee, 0xb2, 0xb4, 0x5b, 0x77, 0x9c, 0x00, 0x53,
+	0xda, 0x0d, 0x03, 0xd7, 0xef, 0x1b, 0x31, 0x14, 0x7d, 0x00, 0x79, 0xdb, 0x23, 0x23, 0x3f, 0xd4,
+	0xb2, 0x5b, 0xd9, 0xed, 0x52, 0xec, 0x3f, 0x4b, 0x53, 0x4d, 0xa6, 0xa9, 0xd6, 0x20, 0xae, 0xbf,
+	0x57, 0xfc, 0xf8, 0xc5, 0xe6, 0xb5, 0x3f, 0xfe, 0xeb, 0xcf, 0x77, 0x14, 0x43, 0x72, 0xaa, 0x7f,
+	0xcd, 0x43, 0xa1, 0x23, 0x9d, 0x40, 0x15, 0xc8, 0x4c, 0x5c, 0xcb, 0xb8, 0x0e, 0xfa, 0x1a, 0x14,
+	0x3c, 0x4c, 0xa9, 0xdd, 0xc7, 0x54, 0xcb, 0x70, 0xe3, 0xcb, 0x35, 0x91, 0x91, 0x5a, 0x94, 0x91,
+	0x5a, 0xdd, 0x1f, 0x1b, 0x13, 0x14, 0xba, 0x07, 0x79, 0x1a, 0xda, 0xe1, 0x88, 0x6a, 0x59, 0x1e,
+	0xcc, 0xf5, 0x54, 0x30, 0xa3, 0xa9, 0xba, 0x1c, 0x64, 0x48, 0x30, 0x7a, 0x00, 0xe8, 0xb9, 0xeb,
+	0xdb, 0x03, 0x2b, 0xb4, 0x07, 0x83, 0xb1, 0x15, 0x60, 0x3a, 0x1a, 0x84, 0x5a, 0x6e, 0x4b, 0xd9,
+	0x2e, 0xbd, 0xb7, 0x9a, 0x32, 0x61, 0x32, 0x88, 0xc1, 0x11, 0x86, 0xca, 0x59, 0x09, 0x09, 0xaa,
+	0x43, 0x89, 0x8e, 0x8e, 0x3c, 0x37, 0xb4, 0x58, 0x99, 0x69, 0x73, 0xd2, 0x44, 0xda, 0x6b, 0x33,
+	0xaa, 0xc1, 0xbd, 0xdc, 0x87, 0xff, 0xd8, 0x54, 0x0c, 0x10, 0x24, 0x26, 0x46, 0x0f, 0x41, 0x95,
+	0xd1, 0xb5, 0xb0, 0xef, 0x08, 0x3b, 0xf9, 0x4b, 0xda, 0xa9, 0x48, 0xa6, 0xee, 0x3b, 0xdc, 0x56,
+	0x0b, 0x16, 0x42, 0x12, 0xda, 0x03, 0x4b, 0xca, 0xb5, 0xf9, 0x2b, 0xe4, 0xa8, 0xcc, 0xa9, 0x51,
+	0x01, 0x3d, 0x82, 0xeb, 0x27, 0x24, 0x74, 0xfd, 0xbe, 0x45, 0x43, 0x3b, 0x90, 0xeb, 0x2b, 0x5c,
+	0xd2, 0xaf, 0x45, 0x41, 0xed, 0x32, 0x26, 0x77, 0xec, 0x01, 0x48, 0x51, 0xbc, 0xc6, 0xe2, 0x25,
+	0x6d, 0x2d, 0x08, 0x62, 0xb4, 0xc4, 0x55, 0x56, 0x24, 0xa1, 0xed, 0xd8, 0xa1, 0xad, 0x01, 0x2b,
+	0x5b, 0x63, 0x32, 0x46, 0xcb, 0x30, 0x17, 0xba, 0xe1, 0x00, 0x6b, 0x25, 0xae, 0x10, 0x03, 0xa4,
+	0xc1, 0x3c, 0x1d, 0x79, 0x9e, 0x1d, 0x8c, 0xb5, 0x32, 0x97, 0x47, 0x43, 0xf4, 0x0d, 0x28, 0x88,
+	0x1d, 0x81, 0x03, 0x6d, 0xe1, 0x82, 0x2d, 0x30, 0x41, 0xa2, 0x2d, 0x28, 0xe2, 0xb3, 0x21, 0x76,
+	0xdc, 0x10, 0x3b, 0x5a, 0x65, 0x4b, 0xd9, 0x2e, 0xec, 0x65, 0x34, 0xc5, 0x88, 0x85, 0xe8, 0x4b,
+	0xb0, 0xf0, 0xdc, 0x76, 0x07, 0xd8, 0xb1, 0x02, 0x6c, 0x53, 0xe2, 0x6b, 0x8b, 0x7c, 0xde, 0xb2,
+	0x10, 0x1a, 0x5c, 0x86, 0xbe, 0x0f, 0x0b, 0x93, 0x1d, 0x1a, 0x8e, 0x87, 0x58, 0x53, 0x79, 0x09,
+	0xaf, 0x9d, 0x53, 0xc2, 0xe6, 0x78, 0x88, 0x8d, 0xf2, 0x30, 0x31, 0xaa, 0xfe, 0x45, 0x81, 0xa5,
+	0x48, 0x1d, 0xb7, 0x0d, 0x8a, 0xd6, 0x01, 0x44, 0xe7, 0xb0, 0x88, 0x8f, 0xf9, 0xfe, 0x2a, 0x1a,
+	0x45, 0x21, 0x69, 0xfb, 0x38, 0xa1, 0x0e, 0x4f, 0x89, 0x96, 0x49, 0xaa, 0xcd, 0x53, 0x82, 0x6e,
+	0x43, 0x39, 0x52, 0x1f, 0x07, 0x18, 0xf3, 0x9d, 0x55, 0x34, 0x4a, 0x12, 0xc0, 0x44, 0xac, 0xb9,
+	0x48, 0xc8, 0x73, 0x32, 0x0a, 0xf8, 0xc6, 0x29, 0x1a, 0xd2, 0xe8, 0x7d, 0x32, 0x0a, 0x12, 0x00,
+	0x3a, 0xb4, 0x3d, 0x6d, 0x2e, 0x09, 0xe8, 0x0e, 0x6d, 0xaf, 0xfa, 0xbf, 0x2c, 0x94, 0x92, 0xfb,
+	0xe8, 0x2e, 0x14, 0xc7, 0x98, 0x5a, 0x3d, 0xde, 0x58, 0xb8, 0xc7, 0x7b, 0x6a, 0xa2, 0xcb, 0xb5,
+	0x98, 0xd4, 0x28, 0x8c, 0x31, 0x6d, 0x30, 0x04, 0xba, 0x07, 0x0b, 0xf6, 0x11, 0x0d, 0x6d, 0xd7,
+	0x97, 0x94, 0xcc, 0x39, 0x94, 0xb2, 0x84, 0x09, 0xda, 0x57, 0xa1, 0xe0, 0x13, 0xc9, 0xc8, 0x9e,
+	0xc3, 0x98, 0xf7, 0x89, 0x00, 0x7f, 0x17, 0x90, 0x4f, 0xac, 0x53, 0x37, 0x3c, 0xb6, 0x4e, 0x70,
+	0x18, 0xd1, 0x72, 0xe7, 0xd0, 0x16, 0x7d, 0xf2, 0xc4, 0x0d, 0x8f, 0x0f, 0x71, 0x28, 0xe9, 0xdf,
+	0x02, 0x35, 0x4e, 0x82, 0x24, 0xcf, 0xbd, 0xd6, 0xbe, 0x5b, 0x7e, 0x68, 0x54, 0x26, 0xa9, 0x49,
+	0x33, 0xc3, 0xd3, 0x68, 0xda, 0xfc, 0x9b, 0x98, 0xe6, 0xa9, 0x9c, 0xf3, 0x03, 0x40, 0xc9, 0xd4,
+	0x49, 0xee, 0xfc, 0x4c, 0xae, 0x9a, 0x48, 0xa8, 0x60, 0xbf, 0x0f, 0xd7, 0x13, 0x59, 0x95, 0xe4,
+	0xc2, 0x4c, 0xf2, 0x62, 0x9c, 0x6b, 0xc1, 0xbd, 0x0b, 0xc0, 0x32, 0x2d, 0x49, 0xc5, 0x99, 0xa4,
+	0x22, 0x43, 0x70, 0x78, 0xf5, 0xdf, 0x0a, 0xe4, 0x58, 0xc5, 0x5e, 0x7c, 0x4c, 0xd5, 0x60, 0xee,
+	0x84, 0x84, 0xf8, 0xe2, 0x23, 0x4a, 0xc0, 0xd0, 0x77, 0x60, 0x5e, 0xf8, 0x46, 0xb5, 0x1c, 0xef,
+	0x7d, 0xb7, 0x53, 0xfb, 0xe9, 0xf5, 0x23, 0xd9, 0x88, 0x18, 0x53, 0xbd, 0x65, 0x2e, 0xd5, 0x5b,
+	0x76, 0xa1, 0x2c, 0x3b, 0xd8, 0x90, 0x9c, 0xe2, 0x40, 0xcb, 0xcf, 0x3c, 0x8a, 0x4b, 0x02, 0xd3,
+	0x61, 0x90, 0x87, 0xb9, 0x42, 0x56, 0xcd, 0x55, 0xff, 0xae, 0xc0, 0x82, 0x6c, 0xaa, 0x1d, 0x3b,
+	0xb0, 0x3d, 0x8a, 0x9e, 0x41, 0xc9, 0x73, 0xfd, 0x49, 0x8f, 0x56, 0x2e, 0xea, 0xd1, 0xeb, 0xac,
+	0x47, 0x7f, 0xf6, 0x62, 0xf3, 0x46, 0x82, 0xf5, 0x2e, 0xf1, 0xdc, 0x10, 0x7b, 0xc3, 0x70, 0x6c,
+	0x80, 0xe7, 0xfa, 0x51, 0xd7, 0xf6, 0x00, 0x79, 0xf6, 0x59, 0x04, 0xb2, 0x86, 0x38, 0x70, 0x89,
+	0xc3, 0x63, 0xc7, 0x66, 0x48, 0xb7, 0xda, 0xa6, 0xbc, 0xde, 0xec, 0xbd, 0xfd, 0xd9, 0x8b, 0xcd,
+	0xb7, 0x5e, 0x27, 0xc6, 0x93, 0xfc, 0x96, 0x75, 0x62, 0xd5, 0xb3, 0xcf, 0xa2, 0x95, 0x70, 0xfd,
+	0xfb, 0x19, 0x4d, 0xa9, 0x3e, 0x85, 0xf2, 0xa1, 0x58, 0xb4, 0x58, 0x5d, 0x13, 0x16, 0xa2, 0x40,
+	0x89, 0xd9, 0x95, 0x8b, 0x66, 0xcf, 0x71, 0xeb, 0x32, 0xbc, 0x09, 0xcb, 0xbf, 0x53, 0x64, 0x93,
+	0x90, 0x96, 0xdf, 0x81, 0xfc, 0xcf, 0x47, 0x24, 0x18, 0x79, 0x9a, 0x32, 0x33, 0xf8, 0x52, 0x8b,
+	0xde, 0x85, 0x22, 0xab, 0x7f, 0x7a, 0x4c, 0x06, 0xce, 0x39, 0x57, 0xa6, 0x18, 0x80, 0xee, 0x41,
+	0x85, 0xef, 0xef, 0x98, 0x92, 0x9d, 0x49, 0x59, 0x60, 0x28, 0x33, 0x02, 0x71, 0x07, 0xff, 0x54,
+	0x82, 0xbc, 0xf4, 0x4d, 0xbf, 0x62, 0x4e, 0x13, 0xe7, 0x6e, 0x32, 0x7f, 0xfb, 0x9f, 0x2f, 0x7f,
+	0xb9, 0xd9, 0xf9, 0x79, 0x3d, 0x17, 0xd9, 0xcf, 0x91, 0x8b, 0x44, 0xdc, 0x73, 0x97, 0x8f, 0xfb,
+	0xdc, 0xd5, 0xe3, 0x9e, 0xbf, 0x44, 0xdc, 0x51, 0x0b, 0x6e, 0xb1, 0x40, 0xbb, 0xbe, 0x1b, 0xba,
+	0xf1, 0x45, 0xc7, 0xe2, 0xee, 0x6b, 0xf3, 0x33, 0x2d, 0xdc, 0xf4, 0x5c, 0xbf, 0x25, 0xf0, 0x32,
+	0x3c, 0x06, 0x43, 0xa3, 0x3d, 0xb8, 0x31, 0x69, 0x3e, 0x3d, 0xdb, 0xef, 0xe1, 0x81, 0x34, 0x53,
+	0x98, 0x69, 0x66, 0x29, 0x02, 0x37, 0x38, 0x56, 0xd8, 0x78, 0x08, 0xcb, 0x69, 0x1b, 0x0e, 0xa6,
+	0x51, 0x0b, 0x3c, 0xbf, 0x5d, 0xa1, 0x69, 0x63, 0x4d, 0x4c, 0x43, 0xf4, 0x04, 0x56, 0x26, 0x77,
+	0x08, 0x6b, 0x3a, 0x6f, 0x70, 0xb9, 0xbc, 0xdd, 0x98, 0xf0, 0x0f, 0x93, 0x09, 0xfc, 0x1e, 0x2c,
+	0xc5, 0x86, 0xe3, 0x78, 0x97, 0x66, 0x2e, 0x13, 0x4d, 0xa0, 0x71, 0xd0, 0x9f, 0x42, 0x6c, 0xd9,
+	0x4a, 0xd6, 0x79, 0xf9, 0x0a, 0x75, 0x1e, 0xfb, 0xb0, 0x1f, 0x17, 0xfc, 0x36, 0xa8, 0x47, 0xa3,
+	0xc0, 0x67, 0xcb, 0xc5, 0x96, 0xac, 0x32, 0x76, 0x15, 0x2b, 0x18, 0x15, 0x26, 0x67, 0x5d, 0xfa,
+	0x47, 0xa2, 0xba, 0xea, 0xb0, 0xce, 0x91, 0x93, 0x70, 0x4f, 0x36, 0x49, 0x80, 0x19, 0x5b, 0x5c,
+	0xc5, 0x8c, 0x55, 0x06, 0x8a, 0x6e, 0x45, 0xd1, 0x6e, 0x10, 0x08, 0xf4, 0x36, 0x54, 0xe2, 0xc9,
+	0x58, 0x59, 0xf1, 0x8b, 0x59, 0xc1, 0x28, 0x47, 0x53, 0xb1, 0xe3, 0x9b, 0x9d, 0x83, 0x89, 0x25,
+	0xca, 0x92, 0x50, 0x67, 0xc6, 0x6a, 0x31, 0xde, 0xba, 0xa2, 0x1c, 0x7e, 0x08, 0xab, 0xe9, 0x72,
+	0x60, 0xfb, 0x59, 0x66, 0xf1, 0xfa, 0x4c, 0x23, 0x2b, 0xd3, 0xa5, 0xb0, 0x6f, 0x9f, 0xc9, 0xb4,
+	0xfd, 0x04, 0x36, 0xd9, 0xc9, 0xe4, 0xb9, 0x34, 0x74, 0x7b, 0x96, 0x3d, 0x0a, 0x8f, 0x49, 0xe0,
+	0xfe, 0x02, 0x3b, 0x96, 0x2d, 0x4a, 0x09, 0x53, 0x0d, 0x6d, 0x65, 0xdf, 0x58, 0x66, 0xeb, 0xb1,
+	0x81, 0xfa, 0x84, 0x5f, 0x8f, 0xe8, 0xc8, 0x80, 0x04, 0xc0, 0x0a, 0xf0, 0x4f, 0x71, 0x6f, 0xba,
+	0x44, 0x96, 0x66, 0x7a, 0xbc, 0x16, 0x93, 0x0c, 0xc9, 0x89, 0x6b, 0xe5, 0x2e, 0x00, 0xbb, 0xca,
+	0xc9, 0x5c, 0x2e, 0xcf, 0x6e, 0x03, 0x63, 0x4c, 0x65, 0x5a, 0xbf, 0x0d, 0x6a, 0x5c, 0x5a, 0x92,
+	0x74, 0x63, 0x76, 0xb0, 0x27, 0x38, 0x49, 0xdd, 0x85, 0xe4, 0x89, 0x68, 0xd9, 0xfe, 0xd8, 0x72,
+	0xb0, 0x4f, 0x3c, 0xed, 0x26, 0xcf, 0x2a, 0x8a, 0x93, 0x53, 0xf7, 0xc7, 0x4d, 0xa6, 0xa9, 0xfe,
+	0x26, 0x03, 0x68, 0x5f, 0xbc, 0x1e, 0xf7, 0x6c, 0x8a, 0x9d, 0x2f, 0xf2, 0xcc, 0x4a, 0xf4, 0xc9,
+	0xcc, 0x1b, 0xfb, 0xe4, 0x15, 0x23, 0x34, 0xd5, 0x56, 0xb3, 0x57, 0x6f, 0xab, 0xb9, 0x4b, 0xb4,
+	0xd5, 0xea, 0x2f, 0xa1, 0xc2, 0x36, 0x40, 0x13, 0x0f, 0x70, 0x9f, 0xaf, 0x50, 0x7c, 0x20, 0xe0,
+	0x23, 0x12, 0xc8, 0x03, 0xf7, 0x8d, 0x1f, 0x08, 0x24, 0x94, 0x3d, 0xaa, 0xe4, 0x00, 0x5f, 0x78,
+	0x69, 0x9b, 0x20, 0xab, 0xbf, 0x56, 0xe0, 0xa6, 0x4c, 0x8c, 0x7e, 0x86, 0x7b, 0x23, 0x7e, 0x31,
+	0x13, 0x6f, 0x83, 0x2d, 0x28, 0x7b, 0xb4, 0xcf, 0xdf, 0x48, 0xd6, 0x28, 0x18, 0xc8, 0x07, 0x0d,
+	0x78, 0xb4, 0xcf, 0x5e, 0x41, 0x8f, 0x83, 0x81, 0x78, 0xe1, 0xf5, 0x7a, 0x98, 0x52, 0x3e, 0x63,
+	0xc1, 0x88, 0x86, 0xec, 0x45, 0x88, 0x83, 0x80, 0x04, 0xf2, 0x15, 0x23, 0x06, 0xe8, 0x2b, 0xb0,
+	0x18, 0x60, 0x3a, 0x24, 0x3e, 0xc5, 0x96, 0xe3, 0xf6, 0x59, 0xbf, 0x66, 0x41, 0x2a, 0x1b, 0x95,
+	0x48, 0xdc, 0xe4, 0xd2, 0xea, 0xaf, 0x14, 0x58, 0x89, 0x7a, 0x49, 0xda, 0xad, 0x0b, 0xaf, 0xae,
+	0x07, 0xb0, 0x28, 0x3f, 0x54, 0xc8, 0x2f, 0x0c, 0xd1, 0x57, 0x8d, 0x2f, 0xa7, 0xae, 0xa4, 0xb3,
+	0xd7, 0x6d, 0x54, 0x24, 0x5b, 0x0c, 0xe9, 0x9d, 0x3f, 0x28, 0x50, 0x4e, 0xbe, 0x06, 0xd1, 0x3a,
+	0xdc, 0xea, 0x18, 0xed, 0x4e, 0xbb, 0x5b, 0x7f, 0x64, 0x99, 0xcf, 0x3a, 0xba, 0xf5, 0xf8, 0xa0,
+	0xdb, 0xd1, 0x1b, 0xad, 0xfb, 0x2d, 0xbd, 0xa9, 0x5e, 0x43, 0xab, 0x70, 0x73, 0x5a, 0xdd, 0x35,
+	0xeb, 0x07, 0xcd, 0xba, 0xd1, 0x54, 0x15, 0x74, 0x1b, 0xd6, 0xa7, 0x75, 0xfb, 0x8f, 0x1f, 0x99,
+	0xad, 0xce, 0x23, 0xdd, 0x6a, 0x3c, 0x68, 0xb7, 0x1a, 0xba, 0x9a, 0x41, 0x6f, 0x81, 0x36, 0x0d,
+	0x69, 0x77, 0xcc, 0xd6, 0x7e, 0xab, 0x6b, 0xb6, 0x1a, 0x6a, 0x16, 0xad, 0xc1, 0xca, 0xb4, 0x56,
+	0x7f, 0xda, 0xd1, 0x9b, 0x2d, 0x53, 0x6f, 0xaa, 0xb9, 0x3b, 0xff, 0x55, 0x00, 0x12, 0x9f, 0xbc,
+	0xd6, 0x60, 0xe5, 0xb0, 0x6d, 0x0a, 0x03, 0xed, 0x83, 0x94, 0x97, 0x4b, 0xb0, 0x98, 0x54, 0x3e,
+	0xd3, 0xbb, 0xaa, 0x92, 0x16, 0xb6, 0x0f, 0x74, 0x55, 0x41, 0x2b, 0xb0, 0x94, 0x14, 0xd6, 0xf7,
+	0xba, 0x66, 0xbd, 0x75, 0xa0, 0x66, 0xd2, 0x68, 0xf3, 0x49, 0x5b, 0xcd, 0x20, 0x04, 0x95, 0xa4,
+	0xf0, 0xa0, 0xad, 0x66, 0xd1, 0x0d, 0xb8, 0x3e, 0x05, 0x7c, 0x60, 0xe8, 0xba, 0x9a, 0x65, 0x2b,
+	0x9d, 0x86, 0x5a, 0x4f, 0x5a, 0xe6, 0x03, 0xeb, 0x50, 0x37, 0xdb, 0x6a, 0x0e, 0x2d, 0x83, 0x9a,
+	0xd4, 0xde, 0x6f, 0x3f, 0x36, 0x5e, 0x97, 0x76, 0x3b, 0xf5, 0x7d, 0x75, 0x6e, 0x35, 0xa3, 0x2a,
+	0x77, 0xfe, 0xa3, 0x40, 0x65, 0xfa, 0xbb, 0x13, 0xda, 0x84, 0xb5, 0x49, 0xb0, 0xba, 0x66, 0xdd,
+	0x7c, 0xdc, 0x4d, 0x05, 0xa1, 0x0a, 0x1b, 0x69, 0x40, 0x53, 0xef, 0xb4, 0xbb, 0x2d, 0xd3, 0xea,
+	0xe8, 0x46, 0xab, 0x9d, 0x4e, 0x99, 0xc4, 0x1c, 0xb6, 0xcd, 0xd6, 0xc1, 0x0f, 0x22, 0x48, 0x66,
+	0x2a, 0xe3, 0x12, 0xd2, 0xa9, 0x77, 0xbb, 0x7a, 0x53, 0x2c, 0x32, 0xad, 0x33, 0xf4, 0x87, 0x7a,
+	0x83, 0x67, 0x6c, 0x16, 0xf3, 0x7e, 0xbd, 0xf5, 0x48, 0x6f, 0xaa, 0x73, 0xb3, 0x98, 0x8d, 0xfa,
+	0x41, 0x43, 0x67, 0xda, 0xfc, 0xde, 0xbd, 0x8f, 0x5f, 0x6e, 0x28, 0x9f, 0xbc, 0xdc, 0x50, 0xfe,
+	0xf9, 0x72, 0x43, 0xf9, 0xf0, 0xd5, 0xc6, 0xb5, 0x4f, 0x5e, 0x6d, 0x5c, 0xfb, 0xdb, 0xab, 0x8d,
+	0x6b, 0x3f, 0x5e, 0x13, 0x55, 0x4e, 0x9d, 0x9f, 0xd5, 0x5c, 0xb2, 0x73, 0xc6, 0xbf, 0xf7, 0xb2,
+	0x4d, 0x4c, 0xd9, 0xc7, 0xdc, 0x3c, 0x6f, 0xa9, 0x5f, 0xff, 0xff, 0x00, 0x01, 0xc2, 0x0e, 0x99,
+	0x0d, 0x16, 0x00, 0x00,
 }
 
 func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
@@ -1867,6 +2110,13 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.VotingPower) > 0 {
+		i -= len(m.VotingPower)
+		copy(dAtA[i:], m.VotingPower)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.VotingPower)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.Metadata) > 0 {
 		i -= len(m.Metadata)
 		copy(dAtA[i:], m.Metadata)
@@ -2047,6 +2297,18 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MinDepositAnyDenom {
+		i--
+		if m.MinDepositAnyDenom {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
 	if len(m.ExpeditedQuorum) > 0 {
 		i -= len(m.ExpeditedQuorum)
 		copy(dAtA[i:], m.ExpeditedQuorum)
@@ -2306,6 +2568,139 @@ func (m *MessageBasedParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *VoteDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoteDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoteDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MessageExecutionResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageExecutionResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageExecutionResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ResponseDigest) > 0 {
+		i -= len(m.ResponseDigest)
+		copy(dAtA[i:], m.ResponseDigest)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ResponseDigest)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProposalExecutionResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalExecutionResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalExecutionResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MessageResults) > 0 {
+		for iNdEx := len(m.MessageResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MessageResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGov(v)
 	base := offset
@@ -2525,6 +2920,10 @@ func (m *Vote) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGov(uint64(l))
 	}
+	l = len(m.VotingPower)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -2674,6 +3073,9 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovGov(uint64(l))
 	}
+	if m.MinDepositAnyDenom {
+		n += 3
+	}
 	return n
 }
 
@@ -2706,18 +3108,77 @@ func (m *MessageBasedParams) Size() (n int) {
 	return n
 }
 
-func sovGov(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGov(x uint64) (n int) {
-	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
+func (m *VoteDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *MessageExecutionResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.ResponseDigest)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *ProposalExecutionResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovGov(uint64(m.ProposalId))
+	}
+	if len(m.MessageResults) > 0 {
+		for _, e := range m.MessageResults {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGov(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGov(x uint64) (n int) {
+	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowGov
@@ -4177,6 +4638,38 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 			}
 			m.Metadata = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VotingPower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -5231,6 +5724,26 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.ExpeditedQuorum = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDepositAnyDenom", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MinDepositAnyDenom = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -5466,6 +5979,391 @@ func (m *MessageBasedParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *VoteDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoteDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoteDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MessageExecutionResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageExecutionResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageExecutionResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseDigest", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResponseDigest = append(m.ResponseDigest[:0], dAtA[iNdEx:postIndex]...)
+			if m.ResponseDigest == nil {
+				m.ResponseDigest = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposalExecutionResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposalExecutionResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposalExecutionResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageResults = append(m.MessageResults, &MessageExecutionResult{})
+			if err := m.MessageResults[len(m.MessageResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGov(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
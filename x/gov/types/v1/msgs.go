@@ -101,6 +101,12 @@ func NewMsgVoteWeighted(voter string, proposalID uint64, options WeightedVoteOpt
 	return &MsgVoteWeighted{proposalID, voter, options, metadata}
 }
 
+// NewMsgDelegateVote creates a message to delegate the governance voting power of delegator to delegate.
+// An empty delegate removes the existing vote delegation of the delegator.
+func NewMsgDelegateVote(delegator, delegate string) *MsgDelegateVote {
+	return &MsgDelegateVote{Delegator: delegator, Delegate: delegate}
+}
+
 // NewMsgExecLegacyContent creates a new MsgExecLegacyContent instance.
 func NewMsgExecLegacyContent(content *codectypes.Any, authority string) *MsgExecLegacyContent {
 	return &MsgExecLegacyContent{
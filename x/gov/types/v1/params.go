@@ -37,6 +37,7 @@ var (
 	DefaultMinDepositRatio              = sdkmath.LegacyMustNewDecFromStr("0.01")
 	DefaultOptimisticRejectedThreshold  = sdkmath.LegacyMustNewDecFromStr("0.1")
 	DefaultOptimisticAuthorizedAddreses = []string(nil)
+	DefaultMinDepositAnyDenom           = false
 )
 
 // NewParams creates a new Params instance with given values.
@@ -47,6 +48,7 @@ func NewParams(
 	burnProposalDeposit, burnVoteQuorum, burnVoteVeto bool,
 	minDepositRatio, optimisticRejectedThreshold string,
 	optimisticAuthorizedAddresses []string,
+	minDepositAnyDenom bool,
 ) Params {
 	return Params{
 		MinDeposit:                    minDeposit,
@@ -70,6 +72,7 @@ func NewParams(
 		MinDepositRatio:               minDepositRatio,
 		OptimisticRejectedThreshold:   optimisticRejectedThreshold,
 		OptimisticAuthorizedAddresses: optimisticAuthorizedAddresses,
+		MinDepositAnyDenom:            minDepositAnyDenom,
 	}
 }
 
@@ -97,6 +100,7 @@ func DefaultParams() Params {
 		DefaultMinDepositRatio.String(),
 		DefaultOptimisticRejectedThreshold.String(),
 		DefaultOptimisticAuthorizedAddreses,
+		DefaultMinDepositAnyDenom,
 	)
 }
 
@@ -20,6 +20,7 @@ const (
 	StatusPassed        = ProposalStatus_PROPOSAL_STATUS_PASSED
 	StatusRejected      = ProposalStatus_PROPOSAL_STATUS_REJECTED
 	StatusFailed        = ProposalStatus_PROPOSAL_STATUS_FAILED
+	StatusCanceled      = ProposalStatus_PROPOSAL_STATUS_CANCELED
 )
 
 // NewProposal creates a new Proposal instance
@@ -67,7 +68,7 @@ func (p Proposal) GetMsgs() ([]sdk.Msg, error) {
 // the proposal is expedited. Otherwise, returns the regular min deposit from
 // gov params.
 func (p Proposal) GetMinDepositFromParams(params Params) sdk.Coins {
-	if p.Expedited {
+	if p.ProposalType == ProposalType_PROPOSAL_TYPE_EXPEDITED {
 		return params.ExpeditedMinDeposit
 	}
 	return params.MinDeposit
@@ -136,7 +137,8 @@ func ValidProposalStatus(status ProposalStatus) bool {
 		status == StatusVotingPeriod ||
 		status == StatusPassed ||
 		status == StatusRejected ||
-		status == StatusFailed {
+		status == StatusFailed ||
+		status == StatusCanceled {
 		return true
 	}
 	return false
@@ -83,3 +83,15 @@ func TestProposalGetMinDepositFromParams(t *testing.T) {
 		require.Equal(t, tc.expectedMinDeposit, actualMinDeposit[0].Amount)
 	}
 }
+
+func TestProposalGetMinDepositFromParamsConverted(t *testing.T) {
+	proposal, err := v1.NewProposal([]sdk.Msg{}, 1, time.Now(), time.Now(), "", "title", "summary", "cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r", v1.ProposalType_PROPOSAL_TYPE_EXPEDITED)
+	require.NoError(t, err)
+
+	// a failed expedited proposal is converted to a standard one and must
+	// then be held to the regular minimum deposit
+	proposal.ProposalType = v1.ProposalType_PROPOSAL_TYPE_STANDARD
+
+	actualMinDeposit := proposal.GetMinDepositFromParams(v1.DefaultParams())
+	require.Equal(t, v1.DefaultMinDepositTokens, actualMinDeposit.AmountOf(sdk.DefaultBondDenom))
+}
@@ -1163,6 +1163,294 @@ func (m *QueryMessageBasedParamsResponse) GetParams() *MessageBasedParams {
 	return nil
 }
 
+// QueryVoteDelegationRequest is the request type for the Query/VoteDelegation RPC method.
+//
+// Since: x/gov 1.0.0
+type QueryVoteDelegationRequest struct {
+	// delegator defines the account address delegating its governance voting power.
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+}
+
+func (m *QueryVoteDelegationRequest) Reset()         { *m = QueryVoteDelegationRequest{} }
+func (m *QueryVoteDelegationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVoteDelegationRequest) ProtoMessage()    {}
+func (*QueryVoteDelegationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a436d1109b50d0, []int{22}
+}
+func (m *QueryVoteDelegationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVoteDelegationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVoteDelegationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVoteDelegationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVoteDelegationRequest.Merge(m, src)
+}
+func (m *QueryVoteDelegationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVoteDelegationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVoteDelegationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVoteDelegationRequest proto.InternalMessageInfo
+
+func (m *QueryVoteDelegationRequest) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+// QueryVoteDelegationResponse is the response type for the Query/VoteDelegation RPC method.
+//
+// Since: x/gov 1.0.0
+type QueryVoteDelegationResponse struct {
+	// vote_delegation defines the queried vote delegation.
+	VoteDelegation *VoteDelegation `protobuf:"bytes,1,opt,name=vote_delegation,json=voteDelegation,proto3" json:"vote_delegation,omitempty"`
+}
+
+func (m *QueryVoteDelegationResponse) Reset()         { *m = QueryVoteDelegationResponse{} }
+func (m *QueryVoteDelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVoteDelegationResponse) ProtoMessage()    {}
+func (*QueryVoteDelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a436d1109b50d0, []int{23}
+}
+func (m *QueryVoteDelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVoteDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVoteDelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVoteDelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVoteDelegationResponse.Merge(m, src)
+}
+func (m *QueryVoteDelegationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVoteDelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVoteDelegationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVoteDelegationResponse proto.InternalMessageInfo
+
+func (m *QueryVoteDelegationResponse) GetVoteDelegation() *VoteDelegation {
+	if m != nil {
+		return m.VoteDelegation
+	}
+	return nil
+}
+
+// QueryProposalExecutionResultRequest is the request type for the Query/ProposalExecutionResult RPC method.
+//
+// Since: x/gov 1.0.0
+type QueryProposalExecutionResultRequest struct {
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *QueryProposalExecutionResultRequest) Reset()         { *m = QueryProposalExecutionResultRequest{} }
+func (m *QueryProposalExecutionResultRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalExecutionResultRequest) ProtoMessage()    {}
+func (*QueryProposalExecutionResultRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a436d1109b50d0, []int{24}
+}
+func (m *QueryProposalExecutionResultRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalExecutionResultRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalExecutionResultRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProposalExecutionResultRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalExecutionResultRequest.Merge(m, src)
+}
+func (m *QueryProposalExecutionResultRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalExecutionResultRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalExecutionResultRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProposalExecutionResultRequest proto.InternalMessageInfo
+
+func (m *QueryProposalExecutionResultRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+// QueryProposalExecutionResultResponse is the response type for the Query/ProposalExecutionResult RPC method.
+//
+// Since: x/gov 1.0.0
+type QueryProposalExecutionResultResponse struct {
+	// execution_result defines the execution result of the messages of the proposal.
+	ExecutionResult *ProposalExecutionResult `protobuf:"bytes,1,opt,name=execution_result,json=executionResult,proto3" json:"execution_result,omitempty"`
+}
+
+func (m *QueryProposalExecutionResultResponse) Reset()         { *m = QueryProposalExecutionResultResponse{} }
+func (m *QueryProposalExecutionResultResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalExecutionResultResponse) ProtoMessage()    {}
+func (*QueryProposalExecutionResultResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a436d1109b50d0, []int{25}
+}
+func (m *QueryProposalExecutionResultResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalExecutionResultResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalExecutionResultResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProposalExecutionResultResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalExecutionResultResponse.Merge(m, src)
+}
+func (m *QueryProposalExecutionResultResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalExecutionResultResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalExecutionResultResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProposalExecutionResultResponse proto.InternalMessageInfo
+
+func (m *QueryProposalExecutionResultResponse) GetExecutionResult() *ProposalExecutionResult {
+	if m != nil {
+		return m.ExecutionResult
+	}
+	return nil
+}
+
+// QueryLiveTallyRequest is the request type for the Query/LiveTally RPC method.
+//
+// Since: x/gov 1.0.0
+type QueryLiveTallyRequest struct {
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *QueryLiveTallyRequest) Reset()         { *m = QueryLiveTallyRequest{} }
+func (m *QueryLiveTallyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLiveTallyRequest) ProtoMessage()    {}
+func (*QueryLiveTallyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a436d1109b50d0, []int{26}
+}
+func (m *QueryLiveTallyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLiveTallyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLiveTallyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLiveTallyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLiveTallyRequest.Merge(m, src)
+}
+func (m *QueryLiveTallyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLiveTallyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLiveTallyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLiveTallyRequest proto.InternalMessageInfo
+
+func (m *QueryLiveTallyRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+// QueryLiveTallyResponse is the response type for the Query/LiveTally RPC method.
+//
+// Since: x/gov 1.0.0
+type QueryLiveTallyResponse struct {
+	// tally defines the incremental tally of the proposal, updated on each vote.
+	Tally *TallyResult `protobuf:"bytes,1,opt,name=tally,proto3" json:"tally,omitempty"`
+}
+
+func (m *QueryLiveTallyResponse) Reset()         { *m = QueryLiveTallyResponse{} }
+func (m *QueryLiveTallyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLiveTallyResponse) ProtoMessage()    {}
+func (*QueryLiveTallyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a436d1109b50d0, []int{27}
+}
+func (m *QueryLiveTallyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLiveTallyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLiveTallyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLiveTallyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLiveTallyResponse.Merge(m, src)
+}
+func (m *QueryLiveTallyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLiveTallyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLiveTallyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLiveTallyResponse proto.InternalMessageInfo
+
+func (m *QueryLiveTallyResponse) GetTally() *TallyResult {
+	if m != nil {
+		return m.Tally
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryConstitutionRequest)(nil), "cosmos.gov.v1.QueryConstitutionRequest")
 	proto.RegisterType((*QueryConstitutionResponse)(nil), "cosmos.gov.v1.QueryConstitutionResponse")
@@ -1186,86 +1474,105 @@ func init() {
 	proto.RegisterType((*QueryProposalVoteOptionsResponse)(nil), "cosmos.gov.v1.QueryProposalVoteOptionsResponse")
 	proto.RegisterType((*QueryMessageBasedParamsRequest)(nil), "cosmos.gov.v1.QueryMessageBasedParamsRequest")
 	proto.RegisterType((*QueryMessageBasedParamsResponse)(nil), "cosmos.gov.v1.QueryMessageBasedParamsResponse")
+	proto.RegisterType((*QueryVoteDelegationRequest)(nil), "cosmos.gov.v1.QueryVoteDelegationRequest")
+	proto.RegisterType((*QueryVoteDelegationResponse)(nil), "cosmos.gov.v1.QueryVoteDelegationResponse")
+	proto.RegisterType((*QueryProposalExecutionResultRequest)(nil), "cosmos.gov.v1.QueryProposalExecutionResultRequest")
+	proto.RegisterType((*QueryProposalExecutionResultResponse)(nil), "cosmos.gov.v1.QueryProposalExecutionResultResponse")
+	proto.RegisterType((*QueryLiveTallyRequest)(nil), "cosmos.gov.v1.QueryLiveTallyRequest")
+	proto.RegisterType((*QueryLiveTallyResponse)(nil), "cosmos.gov.v1.QueryLiveTallyResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/query.proto", fileDescriptor_46a436d1109b50d0) }
 
 var fileDescriptor_46a436d1109b50d0 = []byte{
-	// 1172 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4d, 0x6f, 0xdc, 0x54,
-	0x17, 0x8e, 0x27, 0xdf, 0x67, 0x92, 0xbc, 0x2f, 0x27, 0x4d, 0x33, 0x75, 0x9a, 0x49, 0xe2, 0x40,
-	0x12, 0x10, 0xb1, 0x99, 0xa4, 0x69, 0x15, 0x5a, 0x84, 0x9a, 0x96, 0x14, 0x24, 0x10, 0x61, 0x5a,
-	0x58, 0x20, 0xa4, 0x91, 0x93, 0xb1, 0x2c, 0x8b, 0x89, 0xaf, 0x3b, 0xd7, 0x63, 0x11, 0x42, 0x84,
-	0x54, 0x89, 0x8f, 0x15, 0x20, 0x51, 0x01, 0x0b, 0x7e, 0x01, 0x5b, 0xf8, 0x11, 0x2c, 0x2b, 0xd8,
-	0xb0, 0x44, 0x09, 0x3f, 0x04, 0xf9, 0xde, 0x63, 0x8f, 0xed, 0x78, 0x26, 0x33, 0x55, 0xc5, 0x72,
-	0xae, 0x9f, 0xf3, 0x9c, 0xe7, 0x9c, 0x7b, 0x3e, 0xee, 0xc0, 0x95, 0x03, 0xc6, 0x0f, 0x19, 0x37,
-	0x6c, 0x16, 0x18, 0x41, 0xc5, 0x78, 0xd8, 0xb2, 0x9a, 0x47, 0xba, 0xd7, 0x64, 0x3e, 0xc3, 0x49,
-	0xf9, 0x49, 0xb7, 0x59, 0xa0, 0x07, 0x15, 0xf5, 0x25, 0x42, 0xee, 0x9b, 0xdc, 0x92, 0x38, 0x23,
-	0xa8, 0xec, 0x5b, 0xbe, 0x59, 0x31, 0x3c, 0xd3, 0x76, 0x5c, 0xd3, 0x77, 0x98, 0x2b, 0x4d, 0xd5,
-	0xab, 0x36, 0x63, 0x76, 0xc3, 0x32, 0x4c, 0xcf, 0x31, 0x4c, 0xd7, 0x65, 0xbe, 0xf8, 0xc8, 0xe9,
-	0xeb, 0x6c, 0xda, 0x67, 0xc8, 0x2f, 0x3f, 0x90, 0x98, 0x9a, 0xf8, 0x65, 0x90, 0x7b, 0xf1, 0x43,
-	0x53, 0xa1, 0xf4, 0x5e, 0xe8, 0xf3, 0x0e, 0x73, 0xb9, 0xef, 0xf8, 0xad, 0x90, 0xaf, 0x6a, 0x3d,
-	0x6c, 0x59, 0xdc, 0xd7, 0x5e, 0x87, 0x2b, 0x39, 0xdf, 0xb8, 0xc7, 0x5c, 0x6e, 0xa1, 0x06, 0x13,
-	0x07, 0x89, 0xf3, 0x92, 0xb2, 0xa8, 0xac, 0x8d, 0x57, 0x53, 0x67, 0xda, 0x0d, 0xb8, 0x24, 0x08,
-	0xf6, 0x9a, 0xcc, 0x63, 0xdc, 0x6c, 0x10, 0x31, 0x2e, 0x40, 0xd1, 0xa3, 0xa3, 0x9a, 0x53, 0x17,
-	0xa6, 0x43, 0x55, 0x88, 0x8e, 0xde, 0xaa, 0x6b, 0x6f, 0xc3, 0x4c, 0xc6, 0x90, 0xbc, 0x6e, 0xc2,
-	0x58, 0x04, 0x13, 0x66, 0xc5, 0x8d, 0x59, 0x3d, 0x95, 0x4e, 0x3d, 0x36, 0x89, 0x81, 0xda, 0xb7,
-	0x85, 0x0c, 0x1d, 0x8f, 0x84, 0xec, 0xc2, 0xff, 0x62, 0x21, 0xdc, 0x37, 0xfd, 0x16, 0x17, 0xac,
-	0x53, 0x1b, 0xf3, 0x1d, 0x58, 0xef, 0x0b, 0x50, 0x75, 0xca, 0x4b, 0xfd, 0x46, 0x1d, 0x86, 0x03,
-	0xe6, 0x5b, 0xcd, 0x52, 0x21, 0xcc, 0xc2, 0x4e, 0xe9, 0x8f, 0xdf, 0xd6, 0x2f, 0x11, 0xc1, 0xed,
-	0x7a, 0xbd, 0x69, 0x71, 0x7e, 0xdf, 0x6f, 0x3a, 0xae, 0x5d, 0x95, 0x30, 0xbc, 0x0e, 0xe3, 0x75,
-	0xcb, 0x63, 0xdc, 0xf1, 0x59, 0xb3, 0x34, 0x78, 0x81, 0x4d, 0x1b, 0x8a, 0xbb, 0x00, 0xed, 0x9a,
-	0x28, 0x0d, 0x89, 0x04, 0xac, 0x44, 0x52, 0xc3, 0x02, 0xd2, 0x65, 0xa1, 0x51, 0x01, 0xe9, 0x7b,
-	0xa6, 0x6d, 0x51, 0xac, 0xd5, 0x84, 0xa5, 0xf6, 0x93, 0x02, 0x97, 0xb3, 0x19, 0xa1, 0x0c, 0x6f,
-	0xc1, 0x78, 0x14, 0x5c, 0x98, 0x8c, 0xc1, 0x6e, 0x29, 0x6e, 0x23, 0xf1, 0x5e, 0x4a, 0x59, 0x41,
-	0x28, 0x5b, 0xbd, 0x50, 0x99, 0xf4, 0x99, 0x92, 0x76, 0x00, 0xff, 0x17, 0xca, 0x3e, 0x60, 0xbe,
-	0xd5, 0x6b, 0xbd, 0xf4, 0x9b, 0x7f, 0xed, 0x16, 0x3c, 0x97, 0x70, 0x42, 0x91, 0xaf, 0xc2, 0x50,
-	0xf8, 0x95, 0xea, 0x6a, 0x3a, 0x13, 0xb4, 0x80, 0x0a, 0x80, 0xf6, 0x59, 0xc2, 0x9a, 0xf7, 0xac,
-	0x71, 0x37, 0x27, 0x43, 0x4f, 0x73, 0x77, 0x5f, 0x2b, 0x80, 0x49, 0xf7, 0xa4, 0xfe, 0x45, 0x99,
-	0x82, 0xe8, 0xce, 0x72, 0xe5, 0x4b, 0xc4, 0xb3, 0xbb, 0xab, 0x6d, 0x52, 0xb2, 0x67, 0x36, 0xcd,
-	0xc3, 0x38, 0x13, 0xcb, 0x50, 0xf4, 0xc4, 0x41, 0xcd, 0x3f, 0xf2, 0x64, 0x3a, 0xc7, 0x77, 0x0a,
-	0x25, 0x25, 0x34, 0x0d, 0x8f, 0x1f, 0x1c, 0x79, 0x96, 0xf6, 0x43, 0x01, 0xa6, 0x53, 0xb6, 0x14,
-	0xc6, 0x5d, 0x98, 0x0c, 0x98, 0xef, 0xb8, 0x76, 0x4d, 0x82, 0xe9, 0x36, 0xe6, 0xce, 0x87, 0xe3,
-	0xb8, 0xb6, 0xb4, 0x15, 0xdc, 0x13, 0x41, 0xe2, 0x04, 0xef, 0xc1, 0x14, 0x35, 0x4d, 0x44, 0x23,
-	0xa3, 0xbc, 0x9a, 0xa1, 0xb9, 0x2b, 0x41, 0x09, 0x9e, 0xc9, 0x7a, 0xf2, 0x08, 0x6f, 0xc3, 0x84,
-	0x6f, 0x36, 0x1a, 0x47, 0x11, 0xcd, 0xa0, 0xa0, 0x51, 0x33, 0x34, 0x0f, 0x42, 0x48, 0x82, 0xa4,
-	0xe8, 0xb7, 0x0f, 0x70, 0x1d, 0x46, 0xc8, 0x58, 0xf6, 0xeb, 0x4c, 0xb6, 0x9b, 0x64, 0x02, 0x08,
-	0xa4, 0xb9, 0x94, 0x17, 0x92, 0xd6, 0x73, 0x79, 0xa5, 0x46, 0x4a, 0xa1, 0xe7, 0x91, 0xa2, 0xbd,
-	0x49, 0x33, 0x3a, 0xf6, 0x47, 0x17, 0xf1, 0x0a, 0x8c, 0x12, 0x88, 0xae, 0xe0, 0x72, 0x7e, 0xee,
-	0xaa, 0x11, 0x4c, 0xfb, 0x3c, 0xcd, 0xf4, 0xdf, 0x77, 0xc6, 0x63, 0x85, 0xe6, 0x7c, 0x5b, 0x01,
-	0x05, 0xb3, 0x01, 0x63, 0xa4, 0x32, 0xea, 0x8f, 0x4e, 0xd1, 0xc4, 0xb8, 0x67, 0xd7, 0x25, 0xaf,
-	0xc2, 0xac, 0x50, 0x25, 0xaa, 0xa4, 0x6a, 0xf1, 0x56, 0xc3, 0xef, 0x63, 0x11, 0x96, 0xce, 0xdb,
-	0xc6, 0x37, 0x34, 0x2c, 0xea, 0x8c, 0xee, 0x27, 0xb7, 0x28, 0xc9, 0x44, 0x02, 0xb5, 0x1d, 0x58,
-	0x48, 0x4d, 0xfd, 0x70, 0x28, 0xbc, 0xeb, 0x89, 0x27, 0x44, 0xcf, 0x8a, 0x1c, 0x58, 0xec, 0xcc,
-	0x41, 0xca, 0xde, 0x80, 0xb0, 0x1d, 0xad, 0x1a, 0x93, 0xe7, 0x24, 0x50, 0xeb, 0xb0, 0x46, 0x92,
-	0x0c, 0xc5, 0xa0, 0xfd, 0x43, 0xdb, 0x86, 0xb2, 0x70, 0xf5, 0x8e, 0xc5, 0xb9, 0x69, 0x5b, 0x3b,
-	0x26, 0xb7, 0xea, 0xe9, 0x51, 0x33, 0x0b, 0xa3, 0x87, 0xdc, 0xae, 0xb5, 0x9a, 0x0d, 0x7a, 0x7f,
-	0x8c, 0x1c, 0x72, 0xfb, 0xfd, 0x66, 0x43, 0xfb, 0x88, 0x22, 0xcd, 0x33, 0x25, 0x91, 0xdb, 0x71,
-	0x5f, 0x4a, 0x79, 0x4b, 0x19, 0x79, 0x39, 0xa6, 0x64, 0xb0, 0xf1, 0xcb, 0x04, 0x0c, 0x0b, 0x7a,
-	0xfc, 0x52, 0x81, 0x89, 0xe4, 0xf3, 0x08, 0x57, 0x33, 0x2c, 0x9d, 0x1e, 0x57, 0xea, 0xda, 0xc5,
-	0x40, 0x29, 0x54, 0x5b, 0x7e, 0xf4, 0xe7, 0x3f, 0xdf, 0x17, 0xe6, 0x71, 0xce, 0x48, 0xbf, 0xef,
-	0x92, 0x4f, 0x2d, 0xfc, 0x42, 0x81, 0xb1, 0x28, 0xa1, 0xb8, 0x9c, 0xc7, 0x9d, 0x79, 0x84, 0xa9,
-	0xcf, 0x77, 0x07, 0x91, 0x73, 0x5d, 0x38, 0x5f, 0xc3, 0x95, 0x8c, 0xf3, 0x78, 0xf3, 0x1b, 0xc7,
-	0x89, 0x7a, 0x39, 0xc1, 0x4f, 0x61, 0x3c, 0x7e, 0x53, 0x60, 0x57, 0x17, 0xd1, 0x25, 0xaa, 0x2f,
-	0x5c, 0x80, 0x22, 0x25, 0x8b, 0x42, 0x89, 0x8a, 0xa5, 0x4e, 0x4a, 0xf0, 0x2b, 0x05, 0x86, 0xc2,
-	0x62, 0xc2, 0x85, 0x3c, 0xc6, 0xc4, 0x83, 0x42, 0x5d, 0xec, 0x0c, 0x20, 0x6f, 0xb7, 0x84, 0xb7,
-	0xeb, 0x78, 0xad, 0xb7, 0xb8, 0x0d, 0xb1, 0x59, 0x8d, 0x63, 0xf1, 0xbc, 0x38, 0xc1, 0x47, 0x0a,
-	0x0c, 0x8b, 0xf5, 0x8c, 0x1d, 0x3d, 0xc5, 0xe1, 0x2f, 0x75, 0x41, 0x90, 0x98, 0x6b, 0x42, 0x8c,
-	0x8e, 0x2f, 0xf7, 0x23, 0x06, 0x5d, 0x18, 0xa1, 0x15, 0x94, 0xeb, 0x22, 0xd5, 0x49, 0xaa, 0xd6,
-	0x0d, 0x42, 0x32, 0xe6, 0x85, 0x8c, 0x59, 0x9c, 0xc9, 0xca, 0x90, 0x5e, 0x7e, 0x54, 0x60, 0x94,
-	0xc6, 0x28, 0xe6, 0xd2, 0xa5, 0x57, 0x9a, 0xba, 0xdc, 0x15, 0x43, 0x3e, 0xef, 0x08, 0x9f, 0xaf,
-	0xe1, 0xcd, 0x1e, 0x43, 0x8f, 0xc6, 0xb7, 0x71, 0x1c, 0xaf, 0xb8, 0x13, 0xfc, 0x46, 0x81, 0xb1,
-	0x68, 0x27, 0x60, 0x37, 0xb7, 0xbc, 0x6b, 0x73, 0x64, 0xd7, 0x8a, 0x76, 0x43, 0x88, 0xab, 0xa0,
-	0xd1, 0xa7, 0x38, 0x7c, 0xac, 0x40, 0x31, 0x31, 0x9f, 0x71, 0x25, 0xcf, 0xdd, 0xf9, 0x7d, 0xa1,
-	0xae, 0x5e, 0x88, 0x7b, 0xca, 0x8a, 0x11, 0xfb, 0x01, 0x7f, 0x55, 0x60, 0x3a, 0x67, 0x2a, 0xa3,
-	0xde, 0xad, 0x43, 0xcf, 0x2f, 0x11, 0xd5, 0xe8, 0x19, 0x4f, 0x72, 0x6f, 0x0a, 0xb9, 0x5b, 0xb8,
-	0xd9, 0x47, 0x81, 0x47, 0xdb, 0x05, 0x7f, 0x56, 0x00, 0xcf, 0x0f, 0x6b, 0x5c, 0xcf, 0x13, 0xd1,
-	0x71, 0x95, 0xa8, 0x7a, 0xaf, 0x70, 0x92, 0xbc, 0x2a, 0x24, 0x2f, 0xe1, 0x42, 0x6e, 0x33, 0x18,
-	0xc7, 0xb4, 0x97, 0x4e, 0x76, 0xb6, 0x7e, 0x3f, 0x2d, 0x2b, 0x4f, 0x4e, 0xcb, 0xca, 0xdf, 0xa7,
-	0x65, 0xe5, 0xbb, 0xb3, 0xf2, 0xc0, 0x93, 0xb3, 0xf2, 0xc0, 0x5f, 0x67, 0xe5, 0x81, 0x0f, 0xe7,
-	0xa4, 0x25, 0xaf, 0x7f, 0xac, 0x3b, 0xcc, 0xf8, 0x44, 0x30, 0x84, 0x4f, 0x66, 0x6e, 0x04, 0x95,
-	0xfd, 0x11, 0xf1, 0xff, 0x7c, 0xf3, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2d, 0x36, 0xe1, 0xe8,
-	0x49, 0x10, 0x00, 0x00,
+	// 1380 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xdf, 0x6f, 0xd4, 0xc6,
+	0x13, 0xc7, 0x47, 0x12, 0x92, 0x49, 0x08, 0x7c, 0x07, 0x42, 0x0e, 0x87, 0x5c, 0xc2, 0x06, 0x08,
+	0xf0, 0x6d, 0xec, 0x26, 0xe1, 0x47, 0x53, 0xa8, 0x10, 0x01, 0x42, 0x5b, 0x51, 0x15, 0x0e, 0xda,
+	0x87, 0xaa, 0xd2, 0xc9, 0xe4, 0x56, 0x96, 0xd5, 0xcb, 0xf9, 0xf0, 0x3a, 0x16, 0x69, 0x1a, 0x55,
+	0x42, 0xea, 0x8f, 0xa7, 0xb6, 0x12, 0xa8, 0xed, 0x43, 0x5f, 0xfb, 0x17, 0xb4, 0xff, 0x41, 0x5f,
+	0xfa, 0x88, 0xda, 0x97, 0x3e, 0x56, 0xd0, 0x3f, 0xa4, 0xf2, 0xee, 0xf8, 0x62, 0xfb, 0xec, 0x3b,
+	0x5f, 0x84, 0xfa, 0x04, 0xde, 0xfd, 0xcc, 0x67, 0x3e, 0x33, 0x3b, 0xb3, 0x3b, 0x39, 0x38, 0xbe,
+	0xee, 0x8a, 0x0d, 0x57, 0x98, 0xb6, 0x1b, 0x98, 0xc1, 0xa2, 0xf9, 0x68, 0x93, 0x7b, 0x5b, 0x46,
+	0xcb, 0x73, 0x7d, 0x17, 0x0f, 0xaa, 0x2d, 0xc3, 0x76, 0x03, 0x23, 0x58, 0xd4, 0xcf, 0x13, 0xf2,
+	0xa1, 0x25, 0xb8, 0xc2, 0x99, 0xc1, 0xe2, 0x43, 0xee, 0x5b, 0x8b, 0x66, 0xcb, 0xb2, 0x9d, 0xa6,
+	0xe5, 0x3b, 0x6e, 0x53, 0x99, 0xea, 0x27, 0x6c, 0xd7, 0xb5, 0x1b, 0xdc, 0xb4, 0x5a, 0x8e, 0x69,
+	0x35, 0x9b, 0xae, 0x2f, 0x37, 0x05, 0xed, 0x4e, 0x26, 0x7d, 0x86, 0xfc, 0x6a, 0x83, 0xc4, 0xd4,
+	0xe4, 0x97, 0x49, 0xee, 0xe5, 0x07, 0xd3, 0xa1, 0x7c, 0x2f, 0xf4, 0x79, 0xc3, 0x6d, 0x0a, 0xdf,
+	0xf1, 0x37, 0x43, 0xbe, 0x2a, 0x7f, 0xb4, 0xc9, 0x85, 0xcf, 0xae, 0xc1, 0xf1, 0x8c, 0x3d, 0xd1,
+	0x72, 0x9b, 0x82, 0x23, 0x83, 0xb1, 0xf5, 0xd8, 0x7a, 0x59, 0x9b, 0xd5, 0xce, 0x8e, 0x54, 0x13,
+	0x6b, 0xec, 0x32, 0x1c, 0x95, 0x04, 0x77, 0x3d, 0xb7, 0xe5, 0x0a, 0xab, 0x41, 0xc4, 0x38, 0x03,
+	0xa3, 0x2d, 0x5a, 0xaa, 0x39, 0x75, 0x69, 0x3a, 0x50, 0x85, 0x68, 0xe9, 0x9d, 0x3a, 0xbb, 0x03,
+	0x13, 0x29, 0x43, 0xf2, 0xba, 0x0c, 0xc3, 0x11, 0x4c, 0x9a, 0x8d, 0x2e, 0x4d, 0x1a, 0x89, 0x74,
+	0x1a, 0x6d, 0x93, 0x36, 0x90, 0x7d, 0x5b, 0x4a, 0xd1, 0x89, 0x48, 0xc8, 0x1a, 0x1c, 0x6a, 0x0b,
+	0x11, 0xbe, 0xe5, 0x6f, 0x0a, 0xc9, 0x3a, 0xbe, 0x34, 0x9d, 0xc3, 0x7a, 0x5f, 0x82, 0xaa, 0xe3,
+	0xad, 0xc4, 0x37, 0x1a, 0x30, 0x18, 0xb8, 0x3e, 0xf7, 0xca, 0xa5, 0x30, 0x0b, 0xab, 0xe5, 0x3f,
+	0x7e, 0x5d, 0x38, 0x4a, 0x04, 0xd7, 0xeb, 0x75, 0x8f, 0x0b, 0x71, 0xdf, 0xf7, 0x9c, 0xa6, 0x5d,
+	0x55, 0x30, 0xbc, 0x04, 0x23, 0x75, 0xde, 0x72, 0x85, 0xe3, 0xbb, 0x5e, 0x79, 0x7f, 0x0f, 0x9b,
+	0x5d, 0x28, 0xae, 0x01, 0xec, 0xd6, 0x44, 0x79, 0x40, 0x26, 0xe0, 0x4c, 0x24, 0x35, 0x2c, 0x20,
+	0x43, 0x15, 0x1a, 0x15, 0x90, 0x71, 0xd7, 0xb2, 0x39, 0xc5, 0x5a, 0x8d, 0x59, 0xb2, 0x1f, 0x35,
+	0x38, 0x96, 0xce, 0x08, 0x65, 0xf8, 0x22, 0x8c, 0x44, 0xc1, 0x85, 0xc9, 0xd8, 0xdf, 0x2d, 0xc5,
+	0xbb, 0x48, 0xbc, 0x9d, 0x50, 0x56, 0x92, 0xca, 0xe6, 0x7b, 0x2a, 0x53, 0x3e, 0x13, 0xd2, 0xd6,
+	0xe1, 0xb0, 0x54, 0xf6, 0xa1, 0xeb, 0xf3, 0xa2, 0xf5, 0xd2, 0x6f, 0xfe, 0xd9, 0x55, 0xf8, 0x5f,
+	0xcc, 0x09, 0x45, 0x3e, 0x0f, 0x03, 0xe1, 0x2e, 0xd5, 0xd5, 0x91, 0x54, 0xd0, 0x12, 0x2a, 0x01,
+	0xec, 0xb3, 0x98, 0xb5, 0x28, 0xac, 0x71, 0x2d, 0x23, 0x43, 0x7b, 0x39, 0xbb, 0xaf, 0x35, 0xc0,
+	0xb8, 0x7b, 0x52, 0x7f, 0x4e, 0xa5, 0x20, 0x3a, 0xb3, 0x4c, 0xf9, 0x0a, 0xf1, 0xea, 0xce, 0x6a,
+	0x85, 0x94, 0xdc, 0xb5, 0x3c, 0x6b, 0xa3, 0x9d, 0x89, 0x39, 0x18, 0x6d, 0xc9, 0x85, 0x9a, 0xbf,
+	0xd5, 0x52, 0xe9, 0x1c, 0x59, 0x2d, 0x95, 0xb5, 0x2a, 0xa8, 0xe5, 0x07, 0x5b, 0x2d, 0xce, 0xbe,
+	0x2f, 0xc1, 0x91, 0x84, 0x2d, 0x85, 0x71, 0x13, 0x0e, 0x06, 0xae, 0xef, 0x34, 0xed, 0x9a, 0x02,
+	0xd3, 0x69, 0x4c, 0x75, 0x86, 0xe3, 0x34, 0x6d, 0x65, 0x2b, 0xb9, 0xc7, 0x82, 0xd8, 0x0a, 0xde,
+	0x86, 0x71, 0x6a, 0x9a, 0x88, 0x46, 0x45, 0x79, 0x22, 0x45, 0x73, 0x53, 0x81, 0x62, 0x3c, 0x07,
+	0xeb, 0xf1, 0x25, 0xbc, 0x0e, 0x63, 0xbe, 0xd5, 0x68, 0x6c, 0x45, 0x34, 0xfb, 0x25, 0x8d, 0x9e,
+	0xa2, 0x79, 0x10, 0x42, 0x62, 0x24, 0xa3, 0xfe, 0xee, 0x02, 0x2e, 0xc0, 0x10, 0x19, 0xab, 0x7e,
+	0x9d, 0x48, 0x77, 0x93, 0x4a, 0x00, 0x81, 0x58, 0x93, 0xf2, 0x42, 0xd2, 0x0a, 0x97, 0x57, 0xe2,
+	0x4a, 0x29, 0x15, 0xbe, 0x52, 0xd8, 0xdb, 0x70, 0x34, 0xe9, 0x8f, 0x0e, 0xe2, 0x75, 0x38, 0x40,
+	0x20, 0x3a, 0x82, 0x63, 0xd9, 0xb9, 0xab, 0x46, 0x30, 0xf6, 0x79, 0x92, 0xe9, 0xbf, 0xef, 0x8c,
+	0x67, 0x1a, 0x4c, 0xa4, 0x14, 0x50, 0x30, 0x4b, 0x30, 0x4c, 0x2a, 0xa3, 0xfe, 0xc8, 0x8b, 0xa6,
+	0x8d, 0x7b, 0x75, 0x5d, 0xf2, 0x26, 0x4c, 0x4a, 0x55, 0xb2, 0x4a, 0xaa, 0x5c, 0x6c, 0x36, 0xfc,
+	0x3e, 0x1e, 0xc2, 0x72, 0xa7, 0x6d, 0xfb, 0x84, 0x06, 0x65, 0x9d, 0x95, 0xb5, 0xfc, 0xa2, 0x24,
+	0x13, 0x05, 0x64, 0xab, 0x30, 0x93, 0xb8, 0xf5, 0xc3, 0x4b, 0xe1, 0xfd, 0x56, 0x28, 0xb2, 0xf0,
+	0x61, 0x31, 0x07, 0x66, 0xf3, 0x39, 0x48, 0xd9, 0x2d, 0x08, 0xdb, 0x91, 0xd7, 0x5c, 0xb5, 0x4e,
+	0x02, 0x59, 0xce, 0x33, 0x12, 0x67, 0x18, 0x0d, 0x76, 0x3f, 0xd8, 0x0a, 0x54, 0xa4, 0xab, 0xf7,
+	0xb8, 0x10, 0x96, 0xcd, 0x57, 0x2d, 0xc1, 0xeb, 0xc9, 0xab, 0x66, 0x12, 0x0e, 0x6c, 0x08, 0xbb,
+	0xb6, 0xe9, 0x35, 0x68, 0xfe, 0x18, 0xda, 0x10, 0xf6, 0x07, 0x5e, 0x83, 0x7d, 0x0c, 0x33, 0xb9,
+	0xa6, 0x24, 0x72, 0xa5, 0xdd, 0x97, 0x4a, 0xde, 0xc9, 0x94, 0xbc, 0x0c, 0xd3, 0xa8, 0x47, 0x1f,
+	0x80, 0xde, 0xbe, 0x81, 0x6f, 0xf2, 0x06, 0xb7, 0xad, 0xd8, 0xd8, 0xa4, 0x3a, 0x51, 0x2e, 0xba,
+	0x5e, 0x59, 0xeb, 0xdd, 0x89, 0x04, 0x65, 0x1c, 0xa6, 0x32, 0x59, 0x49, 0xef, 0x1a, 0x1c, 0x92,
+	0x49, 0xad, 0xb7, 0xb7, 0x48, 0xf8, 0x74, 0xc6, 0x55, 0x1f, 0xb3, 0x1f, 0x0f, 0x12, 0xdf, 0x6c,
+	0x0d, 0xe6, 0x12, 0x07, 0x78, 0xeb, 0x31, 0x5f, 0x8f, 0x46, 0xbb, 0x7e, 0x4a, 0x73, 0x0b, 0x4e,
+	0x75, 0xe7, 0x21, 0xdd, 0xf7, 0xe0, 0x30, 0x8f, 0xb6, 0x6a, 0x9e, 0xdc, 0x2b, 0x6b, 0xc9, 0x1e,
+	0x4f, 0x15, 0x44, 0x9a, 0xe9, 0x10, 0x4f, 0x2e, 0xb0, 0x37, 0xa8, 0xcf, 0xef, 0x38, 0x01, 0xa7,
+	0x32, 0x2f, 0x28, 0xfa, 0x5d, 0x38, 0x96, 0xb6, 0xdc, 0x6b, 0x37, 0x2d, 0xfd, 0x7c, 0x18, 0x06,
+	0x25, 0x19, 0x7e, 0xa9, 0xc1, 0x58, 0x7c, 0x48, 0xc6, 0xf9, 0x94, 0x75, 0xde, 0x88, 0xad, 0x9f,
+	0xed, 0x0d, 0x54, 0xfa, 0xd8, 0xdc, 0x93, 0x3f, 0xff, 0x79, 0x5a, 0x9a, 0xc6, 0x29, 0x33, 0x39,
+	0xe5, 0xc7, 0x07, 0x6e, 0xfc, 0x42, 0x83, 0xe1, 0x28, 0x8b, 0x38, 0x97, 0xc5, 0x9d, 0x1a, 0xc5,
+	0xf5, 0x53, 0xdd, 0x41, 0xe4, 0xdc, 0x90, 0xce, 0xcf, 0xe2, 0x99, 0x94, 0xf3, 0x28, 0xb3, 0xc2,
+	0xdc, 0x8e, 0xe5, 0x7d, 0x07, 0x3f, 0x85, 0x91, 0x88, 0x43, 0x60, 0x57, 0x17, 0x51, 0x2b, 0xeb,
+	0xa7, 0x7b, 0xa0, 0x48, 0xc9, 0xac, 0x54, 0xa2, 0x63, 0x39, 0x4f, 0x09, 0x7e, 0xa5, 0xc1, 0x40,
+	0xd8, 0x02, 0x38, 0x93, 0xc5, 0x18, 0x1b, 0x2b, 0xf5, 0xd9, 0x7c, 0x00, 0x79, 0xbb, 0x2a, 0xbd,
+	0x5d, 0xc2, 0x0b, 0xc5, 0xe2, 0x36, 0xe5, 0x7c, 0x65, 0x6e, 0x87, 0xff, 0x78, 0x3b, 0xf8, 0x44,
+	0x83, 0xc1, 0x90, 0x4e, 0x60, 0xae, 0xa7, 0x76, 0xf8, 0x27, 0xbb, 0x20, 0x48, 0xcc, 0x05, 0x29,
+	0xc6, 0xc0, 0xd7, 0xfa, 0x11, 0x83, 0x4d, 0x18, 0xa2, 0x41, 0x24, 0xd3, 0x45, 0xe2, 0x3e, 0xd5,
+	0x59, 0x37, 0x08, 0xc9, 0x98, 0x96, 0x32, 0x26, 0x71, 0x22, 0x2d, 0x43, 0x79, 0xf9, 0x41, 0x83,
+	0x03, 0xf4, 0x98, 0x62, 0x26, 0x5d, 0x72, 0xb0, 0xd1, 0xe7, 0xba, 0x62, 0xc8, 0xe7, 0x0d, 0xe9,
+	0xf3, 0x2d, 0xbc, 0x52, 0x30, 0xf4, 0xe8, 0x11, 0x37, 0xb7, 0xe9, 0x7f, 0xae, 0xb7, 0x83, 0xdf,
+	0x68, 0x30, 0x4c, 0xc4, 0x02, 0xbb, 0xb9, 0x15, 0x5d, 0x9b, 0x23, 0x3d, 0x5c, 0xb0, 0xcb, 0x52,
+	0xdc, 0x22, 0x9a, 0x7d, 0x8a, 0xc3, 0x67, 0x1a, 0x8c, 0xc6, 0xee, 0x15, 0x3c, 0x93, 0xe5, 0xae,
+	0x73, 0x6a, 0xd0, 0xe7, 0x7b, 0xe2, 0xf6, 0x58, 0x31, 0xf2, 0x5e, 0xc3, 0x5f, 0x34, 0x38, 0x92,
+	0xf1, 0x36, 0xa3, 0xd1, 0xad, 0x43, 0x3b, 0x47, 0x09, 0xdd, 0x2c, 0x8c, 0x27, 0xb9, 0x57, 0xa4,
+	0xdc, 0x8b, 0xb8, 0xdc, 0x47, 0x81, 0x47, 0x33, 0x06, 0xfe, 0xa4, 0x01, 0x76, 0x3e, 0xd9, 0xb8,
+	0x90, 0x25, 0x22, 0x77, 0xa0, 0xd0, 0x8d, 0xa2, 0x70, 0x92, 0x3c, 0x2f, 0x25, 0x9f, 0xc4, 0x99,
+	0xcc, 0x66, 0x30, 0xb7, 0x69, 0x3a, 0xd9, 0x09, 0xe5, 0x8d, 0x27, 0x1f, 0x66, 0x3c, 0x97, 0xd7,
+	0xf2, 0x1d, 0x23, 0x85, 0x7e, 0xbe, 0x08, 0x94, 0x24, 0x2d, 0x4b, 0x49, 0x0b, 0xf8, 0xff, 0x94,
+	0xa4, 0xd4, 0xf0, 0x20, 0x7b, 0x43, 0x7e, 0x84, 0xbd, 0xf1, 0x9b, 0x06, 0x93, 0x39, 0xcf, 0x2f,
+	0x2e, 0x75, 0x3b, 0xc7, 0xec, 0xe9, 0x41, 0x5f, 0xee, 0xcb, 0x86, 0x94, 0x5f, 0x93, 0xca, 0x57,
+	0xf0, 0x72, 0xc1, 0xf3, 0x4f, 0x8f, 0x15, 0xf8, 0x54, 0x83, 0x91, 0xf6, 0xcb, 0x9e, 0xfd, 0xee,
+	0xa4, 0x47, 0x06, 0xfd, 0x74, 0x0f, 0x14, 0x69, 0x5b, 0x91, 0xda, 0x96, 0x71, 0xb1, 0xa0, 0xb6,
+	0x86, 0x13, 0xf0, 0x9a, 0xec, 0xa7, 0xd5, 0x8b, 0xbf, 0xbf, 0xa8, 0x68, 0xcf, 0x5f, 0x54, 0xb4,
+	0xbf, 0x5f, 0x54, 0xb4, 0xef, 0x5e, 0x56, 0xf6, 0x3d, 0x7f, 0x59, 0xd9, 0xf7, 0xd7, 0xcb, 0xca,
+	0xbe, 0x8f, 0xa6, 0x14, 0x97, 0xa8, 0x7f, 0x62, 0x38, 0xae, 0xf9, 0x58, 0x72, 0x86, 0x7f, 0x33,
+	0x8b, 0xf0, 0x17, 0xc0, 0x21, 0xf9, 0x03, 0xdd, 0xf2, 0xbf, 0x03, 0x00, 0xcc, 0x07, 0xb3, 0x43,
+	0x4a, 0x14, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1304,6 +1611,15 @@ type QueryClient interface {
 	// MessageBasedParams queries the message specific governance params based on a msg url.
 	// Since: cosmos-sdk x/gov v1.0.0
 	MessageBasedParams(ctx context.Context, in *QueryMessageBasedParamsRequest, opts ...grpc.CallOption) (*QueryMessageBasedParamsResponse, error)
+	// VoteDelegation queries the governance vote delegation of a delegator.
+	// Since: cosmos-sdk x/gov v1.0.0
+	VoteDelegation(ctx context.Context, in *QueryVoteDelegationRequest, opts ...grpc.CallOption) (*QueryVoteDelegationResponse, error)
+	// ProposalExecutionResult queries the execution result of the messages of a passed proposal.
+	// Since: cosmos-sdk x/gov v1.0.0
+	ProposalExecutionResult(ctx context.Context, in *QueryProposalExecutionResultRequest, opts ...grpc.CallOption) (*QueryProposalExecutionResultResponse, error)
+	// LiveTally queries the incremental tally of a proposal in voting period.
+	// Since: cosmos-sdk x/gov v1.0.0
+	LiveTally(ctx context.Context, in *QueryLiveTallyRequest, opts ...grpc.CallOption) (*QueryLiveTallyResponse, error)
 }
 
 type queryClient struct {
@@ -1413,6 +1729,33 @@ func (c *queryClient) MessageBasedParams(ctx context.Context, in *QueryMessageBa
 	return out, nil
 }
 
+func (c *queryClient) VoteDelegation(ctx context.Context, in *QueryVoteDelegationRequest, opts ...grpc.CallOption) (*QueryVoteDelegationResponse, error) {
+	out := new(QueryVoteDelegationResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Query/VoteDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProposalExecutionResult(ctx context.Context, in *QueryProposalExecutionResultRequest, opts ...grpc.CallOption) (*QueryProposalExecutionResultResponse, error) {
+	out := new(QueryProposalExecutionResultResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Query/ProposalExecutionResult", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LiveTally(ctx context.Context, in *QueryLiveTallyRequest, opts ...grpc.CallOption) (*QueryLiveTallyResponse, error) {
+	out := new(QueryLiveTallyResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Query/LiveTally", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Constitution queries the chain's constitution.
@@ -1439,6 +1782,15 @@ type QueryServer interface {
 	// MessageBasedParams queries the message specific governance params based on a msg url.
 	// Since: cosmos-sdk x/gov v1.0.0
 	MessageBasedParams(context.Context, *QueryMessageBasedParamsRequest) (*QueryMessageBasedParamsResponse, error)
+	// VoteDelegation queries the governance vote delegation of a delegator.
+	// Since: cosmos-sdk x/gov v1.0.0
+	VoteDelegation(context.Context, *QueryVoteDelegationRequest) (*QueryVoteDelegationResponse, error)
+	// ProposalExecutionResult queries the execution result of the messages of a passed proposal.
+	// Since: cosmos-sdk x/gov v1.0.0
+	ProposalExecutionResult(context.Context, *QueryProposalExecutionResultRequest) (*QueryProposalExecutionResultResponse, error)
+	// LiveTally queries the incremental tally of a proposal in voting period.
+	// Since: cosmos-sdk x/gov v1.0.0
+	LiveTally(context.Context, *QueryLiveTallyRequest) (*QueryLiveTallyResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1478,6 +1830,15 @@ func (*UnimplementedQueryServer) ProposalVoteOptions(ctx context.Context, req *Q
 func (*UnimplementedQueryServer) MessageBasedParams(ctx context.Context, req *QueryMessageBasedParamsRequest) (*QueryMessageBasedParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MessageBasedParams not implemented")
 }
+func (*UnimplementedQueryServer) VoteDelegation(ctx context.Context, req *QueryVoteDelegationRequest) (*QueryVoteDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteDelegation not implemented")
+}
+func (*UnimplementedQueryServer) ProposalExecutionResult(ctx context.Context, req *QueryProposalExecutionResultRequest) (*QueryProposalExecutionResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalExecutionResult not implemented")
+}
+func (*UnimplementedQueryServer) LiveTally(ctx context.Context, req *QueryLiveTallyRequest) (*QueryLiveTallyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiveTally not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1681,6 +2042,60 @@ func _Query_MessageBasedParams_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_VoteDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVoteDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VoteDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1.Query/VoteDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VoteDelegation(ctx, req.(*QueryVoteDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProposalExecutionResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalExecutionResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalExecutionResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1.Query/ProposalExecutionResult",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalExecutionResult(ctx, req.(*QueryProposalExecutionResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LiveTally_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLiveTallyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LiveTally(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1.Query/LiveTally",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiveTally(ctx, req.(*QueryLiveTallyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.gov.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1729,6 +2144,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "MessageBasedParams",
 			Handler:    _Query_MessageBasedParams_Handler,
 		},
+		{
+			MethodName: "VoteDelegation",
+			Handler:    _Query_VoteDelegation_Handler,
+		},
+		{
+			MethodName: "ProposalExecutionResult",
+			Handler:    _Query_ProposalExecutionResult_Handler,
+		},
+		{
+			MethodName: "LiveTally",
+			Handler:    _Query_LiveTally_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/query.proto",
@@ -2563,14 +2990,205 @@ func (m *QueryMessageBasedParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
+func (m *QueryVoteDelegationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoteDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoteDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVoteDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoteDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoteDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VoteDelegation != nil {
+		{
+			size, err := m.VoteDelegation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalExecutionResultRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalExecutionResultRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalExecutionResultRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalExecutionResultResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalExecutionResultResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalExecutionResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExecutionResult != nil {
+		{
+			size, err := m.ExecutionResult.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiveTallyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiveTallyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiveTallyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiveTallyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiveTallyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiveTallyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Tally != nil {
+		{
+			size, err := m.Tally.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
 	dAtA[offset] = uint8(v)
 	return base
 }
@@ -2906,6 +3524,82 @@ func (m *QueryMessageBasedParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryVoteDelegationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVoteDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VoteDelegation != nil {
+		l = m.VoteDelegation.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProposalExecutionResultRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryProposalExecutionResultResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExecutionResult != nil {
+		l = m.ExecutionResult.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLiveTallyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryLiveTallyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Tally != nil {
+		l = m.Tally.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -5066,6 +5760,484 @@ func (m *QueryMessageBasedParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryVoteDelegationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVoteDelegationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVoteDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVoteDelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVoteDelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVoteDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteDelegation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VoteDelegation == nil {
+				m.VoteDelegation = &VoteDelegation{}
+			}
+			if err := m.VoteDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalExecutionResultRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalExecutionResultRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalExecutionResultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalExecutionResultResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalExecutionResultResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalExecutionResultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionResult", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecutionResult == nil {
+				m.ExecutionResult = &ProposalExecutionResult{}
+			}
+			if err := m.ExecutionResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiveTallyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiveTallyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiveTallyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiveTallyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiveTallyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiveTallyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tally", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tally == nil {
+				m.Tally = &TallyResult{}
+			}
+			if err := m.Tally.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1197,6 +1197,103 @@ func (m *MsgSudoExecResponse) GetResult() []byte {
 	return nil
 }
 
+// MsgDelegateVote defines a message to delegate the governance voting power of an account to another address.
+//
+// Since: x/gov 1.0.0
+type MsgDelegateVote struct {
+	// delegator is the account address delegating its governance voting power.
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	// delegate is the account address voting on behalf of the delegator.
+	// An empty delegate removes the existing vote delegation of the delegator.
+	Delegate string `protobuf:"bytes,2,opt,name=delegate,proto3" json:"delegate,omitempty"`
+}
+
+func (m *MsgDelegateVote) Reset()         { *m = MsgDelegateVote{} }
+func (m *MsgDelegateVote) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateVote) ProtoMessage()    {}
+func (*MsgDelegateVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{20}
+}
+func (m *MsgDelegateVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegateVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDelegateVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateVote.Merge(m, src)
+}
+func (m *MsgDelegateVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDelegateVote proto.InternalMessageInfo
+
+func (m *MsgDelegateVote) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+func (m *MsgDelegateVote) GetDelegate() string {
+	if m != nil {
+		return m.Delegate
+	}
+	return ""
+}
+
+// MsgDelegateVoteResponse defines the Msg/DelegateVote response type.
+//
+// Since: x/gov 1.0.0
+type MsgDelegateVoteResponse struct {
+}
+
+func (m *MsgDelegateVoteResponse) Reset()         { *m = MsgDelegateVoteResponse{} }
+func (m *MsgDelegateVoteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateVoteResponse) ProtoMessage()    {}
+func (*MsgDelegateVoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{21}
+}
+func (m *MsgDelegateVoteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegateVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateVoteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDelegateVoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateVoteResponse.Merge(m, src)
+}
+func (m *MsgDelegateVoteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateVoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateVoteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDelegateVoteResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSubmitProposal)(nil), "cosmos.gov.v1.MsgSubmitProposal")
 	proto.RegisterType((*MsgSubmitProposalResponse)(nil), "cosmos.gov.v1.MsgSubmitProposalResponse")
@@ -1218,98 +1315,104 @@ func init() {
 	proto.RegisterType((*MsgUpdateMessageParamsResponse)(nil), "cosmos.gov.v1.MsgUpdateMessageParamsResponse")
 	proto.RegisterType((*MsgSudoExec)(nil), "cosmos.gov.v1.MsgSudoExec")
 	proto.RegisterType((*MsgSudoExecResponse)(nil), "cosmos.gov.v1.MsgSudoExecResponse")
+	proto.RegisterType((*MsgDelegateVote)(nil), "cosmos.gov.v1.MsgDelegateVote")
+	proto.RegisterType((*MsgDelegateVoteResponse)(nil), "cosmos.gov.v1.MsgDelegateVoteResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/tx.proto", fileDescriptor_9ff8f4a63b6fc9a9) }
 
 var fileDescriptor_9ff8f4a63b6fc9a9 = []byte{
-	// 1362 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0xc6, 0x89, 0x9d, 0x4c, 0xbe, 0x94, 0xad, 0x9b, 0x6c, 0xb6, 0xc5, 0x76, 0xb7, 0x40,
-	0xad, 0x94, 0xac, 0x71, 0xa0, 0x15, 0x84, 0x0a, 0x51, 0x87, 0x02, 0xad, 0x30, 0x54, 0xdb, 0x0f,
-	0x24, 0x84, 0x64, 0x6d, 0xbc, 0xc3, 0x66, 0x55, 0xef, 0xce, 0xca, 0x33, 0xb6, 0xe2, 0x1b, 0xe2,
-	0xd0, 0x43, 0x4f, 0x3d, 0xf7, 0x2f, 0x40, 0x3d, 0xe5, 0x90, 0x5b, 0x4f, 0xdc, 0xaa, 0x9e, 0x2a,
-	0x4e, 0x9c, 0x5a, 0xd4, 0x0a, 0x22, 0xc1, 0x1f, 0x01, 0x9a, 0xd9, 0xd9, 0xf1, 0x7e, 0xd9, 0x49,
-	0x8b, 0x84, 0xb8, 0xd8, 0x3b, 0xef, 0x6b, 0xde, 0xfb, 0xbd, 0x37, 0xef, 0x3d, 0xb0, 0xd2, 0x46,
-	0xd8, 0x45, 0xb8, 0x66, 0xa3, 0x7e, 0xad, 0x5f, 0xaf, 0x91, 0x3d, 0xdd, 0xef, 0x22, 0x82, 0xe4,
-	0x85, 0x80, 0xae, 0xdb, 0xa8, 0xaf, 0xf7, 0xeb, 0x6a, 0x89, 0x8b, 0xed, 0x98, 0x18, 0xd6, 0xfa,
-	0xf5, 0x1d, 0x48, 0xcc, 0x7a, 0xad, 0x8d, 0x1c, 0x2f, 0x10, 0x57, 0x57, 0xe3, 0x66, 0xa8, 0x56,
-	0xc0, 0x28, 0xda, 0xc8, 0x46, 0xec, 0xb3, 0x46, 0xbf, 0x38, 0x75, 0x2d, 0x10, 0x6f, 0x05, 0x0c,
-	0x7e, 0x15, 0x67, 0xd9, 0x08, 0xd9, 0x1d, 0x58, 0x63, 0xa7, 0x9d, 0xde, 0xf7, 0x35, 0xd3, 0x1b,
-	0x24, 0x2e, 0x71, 0xb1, 0x4d, 0x2f, 0x71, 0xb1, 0xcd, 0x19, 0xcb, 0xa6, 0xeb, 0x78, 0xa8, 0xc6,
-	0x7e, 0x39, 0xa9, 0x9c, 0x34, 0x43, 0x1c, 0x17, 0x62, 0x62, 0xba, 0x7e, 0x20, 0xa0, 0xfd, 0x95,
-	0x03, 0xcb, 0x4d, 0x6c, 0xdf, 0xe8, 0xed, 0xb8, 0x0e, 0xb9, 0xde, 0x45, 0x3e, 0xc2, 0x66, 0x47,
-	0x7e, 0x17, 0xcc, 0xb8, 0x10, 0x63, 0xd3, 0x86, 0x58, 0x91, 0x2a, 0xb9, 0xea, 0xdc, 0x66, 0x51,
-	0x0f, 0x2c, 0xe9, 0xa1, 0x25, 0xfd, 0xb2, 0x37, 0x30, 0x84, 0x94, 0x7c, 0x4f, 0x02, 0x4b, 0x8e,
-	0xe7, 0x10, 0xc7, 0xec, 0xb4, 0x2c, 0xe8, 0x23, 0xec, 0x10, 0x65, 0x92, 0x69, 0xae, 0xe9, 0x3c,
-	0x30, 0x0a, 0x9a, 0xce, 0x41, 0xd3, 0xb7, 0x91, 0xe3, 0x35, 0x3e, 0x7b, 0xfc, 0xac, 0x3c, 0xf1,
-	0xf0, 0x79, 0xb9, 0x6a, 0x3b, 0x64, 0xb7, 0xb7, 0xa3, 0xb7, 0x91, 0xcb, 0x51, 0xe0, 0x7f, 0x1b,
-	0xd8, 0xba, 0x53, 0x23, 0x03, 0x1f, 0x62, 0xa6, 0x80, 0x1f, 0x1c, 0xee, 0xaf, 0xcf, 0x77, 0xa0,
-	0x6d, 0xb6, 0x07, 0x2d, 0x0a, 0x3b, 0xfe, 0xe9, 0x70, 0x7f, 0x5d, 0x32, 0x16, 0xf9, 0xcd, 0x9f,
-	0x06, 0x17, 0xcb, 0xef, 0x83, 0x19, 0x9f, 0x85, 0x02, 0xbb, 0x4a, 0xae, 0x22, 0x55, 0x67, 0x1b,
-	0xca, 0x2f, 0x07, 0x1b, 0x45, 0xee, 0xc7, 0x65, 0xcb, 0xea, 0x42, 0x8c, 0x6f, 0x90, 0xae, 0xe3,
-	0xd9, 0x86, 0x90, 0x94, 0x55, 0x1a, 0x34, 0x31, 0x2d, 0x93, 0x98, 0xca, 0x14, 0xd5, 0x32, 0xc4,
-	0x59, 0x2e, 0x82, 0x69, 0xe2, 0x90, 0x0e, 0x54, 0xa6, 0x19, 0x23, 0x38, 0xc8, 0x0a, 0x28, 0xe0,
-	0x9e, 0xeb, 0x9a, 0xdd, 0x81, 0x92, 0x67, 0xf4, 0xf0, 0x28, 0x57, 0xc0, 0x2c, 0xdc, 0xf3, 0xa1,
-	0xe5, 0x10, 0x68, 0x29, 0x85, 0x8a, 0x54, 0x9d, 0x69, 0x4c, 0x2a, 0x92, 0x31, 0x24, 0xca, 0x9f,
-	0x80, 0x05, 0x9f, 0xc3, 0xdd, 0xa2, 0x11, 0x2a, 0x33, 0x15, 0xa9, 0xba, 0xb8, 0x79, 0x4a, 0x8f,
-	0x55, 0x9c, 0x1e, 0xa6, 0xe4, 0xe6, 0xc0, 0x87, 0xc6, 0xbc, 0x1f, 0x39, 0x6d, 0xd5, 0x7f, 0x3c,
-	0xdc, 0x5f, 0x17, 0xee, 0xdf, 0x3b, 0xdc, 0x5f, 0x2f, 0x47, 0x50, 0xeb, 0xd7, 0x6b, 0xa9, 0xbc,
-	0x6a, 0x97, 0xc0, 0x5a, 0x8a, 0x68, 0x40, 0xec, 0x23, 0x0f, 0x43, 0xb9, 0x0c, 0xe6, 0x84, 0x47,
-	0x8e, 0xa5, 0x48, 0x15, 0xa9, 0x3a, 0x65, 0x80, 0x90, 0x74, 0xd5, 0xd2, 0x1e, 0x49, 0xa0, 0xd8,
-	0xc4, 0xf6, 0x95, 0x3d, 0xd8, 0xfe, 0x92, 0xe5, 0x60, 0x1b, 0x79, 0x04, 0x7a, 0x44, 0xfe, 0x0a,
-	0x14, 0xda, 0xc1, 0x27, 0xd3, 0x1a, 0x51, 0x2d, 0x8d, 0xd2, 0x93, 0x83, 0x0d, 0x35, 0x16, 0x5e,
-	0x58, 0x0b, 0x4c, 0xd7, 0x08, 0x8d, 0xc8, 0xa7, 0xc1, 0xac, 0xd9, 0x23, 0xbb, 0xa8, 0xeb, 0x90,
-	0x81, 0x32, 0xc9, 0x90, 0x1d, 0x12, 0xb6, 0x2e, 0xd0, 0xb8, 0x87, 0x67, 0x1a, 0xb8, 0x96, 0x0a,
-	0x3c, 0xe5, 0xa4, 0x56, 0x02, 0xa7, 0xb3, 0xe8, 0x61, 0xf8, 0xda, 0xef, 0x12, 0x28, 0x34, 0xb1,
-	0x7d, 0x1b, 0x11, 0x28, 0x5f, 0xc8, 0x80, 0xa2, 0x51, 0xfc, 0xf3, 0x59, 0x39, 0x4a, 0x0e, 0x6a,
-	0x2f, 0x02, 0x90, 0xac, 0x83, 0xe9, 0x3e, 0x22, 0xb0, 0x1b, 0xf8, 0x3c, 0xa6, 0xe8, 0x02, 0x31,
-	0xb9, 0x0e, 0xf2, 0xc8, 0x27, 0x0e, 0xf2, 0x58, 0x95, 0x2e, 0x0e, 0x9f, 0x0a, 0x4f, 0x3e, 0xf5,
-	0xe5, 0x6b, 0x26, 0x60, 0x70, 0xc1, 0x71, 0x45, 0xba, 0xf5, 0x26, 0x05, 0x26, 0x30, 0x4d, 0x41,
-	0x39, 0x99, 0x02, 0x85, 0xda, 0xd3, 0x96, 0xc1, 0x12, 0xff, 0x14, 0xa1, 0xff, 0x2d, 0x09, 0xda,
-	0x37, 0xd0, 0xb1, 0x77, 0x69, 0x7d, 0xfe, 0x47, 0x10, 0x7c, 0x04, 0x0a, 0x41, 0x64, 0x58, 0xc9,
-	0xb1, 0x76, 0x71, 0x26, 0x81, 0x41, 0xe8, 0x50, 0x04, 0x8b, 0x50, 0x63, 0x2c, 0x18, 0xef, 0xc4,
-	0xc1, 0x78, 0x23, 0x13, 0x8c, 0xd0, 0xb8, 0xb6, 0x06, 0x56, 0x13, 0x24, 0x01, 0xce, 0x1f, 0x12,
-	0x00, 0x4d, 0x6c, 0x87, 0xbd, 0xe5, 0x35, 0x71, 0xb9, 0x08, 0x66, 0x79, 0x5b, 0x44, 0x47, 0x63,
-	0x33, 0x14, 0x95, 0x2f, 0x81, 0xbc, 0xe9, 0xa2, 0x9e, 0x47, 0x38, 0x3c, 0x63, 0xba, 0xe9, 0x2c,
-	0xed, 0xa6, 0xc1, 0xcd, 0x5c, 0x67, 0xeb, 0x3c, 0x7b, 0x2a, 0xc2, 0x1a, 0x05, 0x42, 0x49, 0x01,
-	0xc1, 0x23, 0xd3, 0x8a, 0x40, 0x1e, 0x9e, 0x44, 0xf8, 0x8f, 0x82, 0xda, 0xb8, 0xe5, 0x5b, 0x26,
-	0x81, 0xd7, 0xcd, 0xae, 0xe9, 0x62, 0x1a, 0xcc, 0xf0, 0x7d, 0x4a, 0x47, 0x05, 0x23, 0x44, 0xe5,
-	0x0f, 0x40, 0xde, 0x67, 0x16, 0x18, 0x02, 0x73, 0x9b, 0x27, 0x93, 0xcd, 0x8e, 0x31, 0x63, 0x81,
-	0x04, 0xf2, 0x5b, 0x17, 0xd3, 0x6f, 0xfe, 0x6c, 0x24, 0x90, 0xbd, 0x70, 0xe2, 0x26, 0x3c, 0xe5,
-	0x79, 0x8d, 0x92, 0x44, 0x60, 0xf7, 0x24, 0x36, 0xf9, 0xb6, 0x4d, 0xaf, 0x0d, 0x3b, 0x91, 0xc9,
-	0x97, 0x91, 0xde, 0xa5, 0x44, 0x7a, 0x63, 0x99, 0x8d, 0x0e, 0x9b, 0xc9, 0xe3, 0x0e, 0x9b, 0xad,
-	0x85, 0x58, 0xf3, 0xd6, 0x7e, 0x96, 0x58, 0x67, 0x8e, 0x3b, 0x23, 0x3a, 0xf3, 0xab, 0x3b, 0x75,
-	0x15, 0x2c, 0xb4, 0x99, 0x2d, 0x68, 0xb5, 0xe8, 0xc8, 0xe7, 0x80, 0xab, 0xa9, 0xbe, 0x7c, 0x33,
-	0xdc, 0x07, 0x1a, 0x33, 0x14, 0xf5, 0xfb, 0xcf, 0xcb, 0x92, 0x31, 0x1f, 0xaa, 0x52, 0xa6, 0x7c,
-	0x0e, 0x2c, 0x09, 0x53, 0xbb, 0xec, 0x71, 0xb0, 0x6e, 0x35, 0x65, 0x2c, 0x86, 0xe4, 0x2f, 0x18,
-	0x55, 0xbb, 0x9b, 0x03, 0x65, 0x31, 0x5d, 0x9a, 0xbd, 0x0e, 0x71, 0xfc, 0x0e, 0xdc, 0xde, 0x45,
-	0x4e, 0x1b, 0x0a, 0x78, 0xb3, 0xd6, 0x04, 0xe9, 0xff, 0xb0, 0x26, 0x4c, 0xbe, 0xd6, 0x9a, 0x90,
-	0x1b, 0xb5, 0x26, 0x4c, 0x8d, 0x58, 0x13, 0xa6, 0xe3, 0x6b, 0xc2, 0x15, 0x30, 0x4f, 0x3b, 0x54,
-	0x2b, 0x6c, 0x81, 0x79, 0x96, 0x25, 0x6d, 0xc4, 0x0e, 0x30, 0x6c, 0x81, 0xd8, 0x98, 0xeb, 0x0f,
-	0x0f, 0xc9, 0x62, 0xba, 0x06, 0xce, 0x1d, 0x91, 0x87, 0xe3, 0xcf, 0xfc, 0x03, 0x09, 0xac, 0x88,
-	0x17, 0xd4, 0x0c, 0xb6, 0xbd, 0x7f, 0xd9, 0x05, 0x56, 0x41, 0xc1, 0xc5, 0x76, 0xab, 0xd7, 0xed,
-	0xf0, 0xd9, 0x9e, 0x77, 0xb1, 0x7d, 0xab, 0xdb, 0x91, 0x3f, 0x14, 0xed, 0x21, 0xc7, 0x70, 0x48,
-	0x8e, 0x02, 0x7e, 0x7d, 0xc3, 0xc4, 0xd0, 0xe2, 0x8f, 0x39, 0xec, 0x0f, 0x8b, 0xf1, 0xfe, 0xa0,
-	0x55, 0x40, 0x29, 0xdb, 0x6b, 0xf1, 0xfc, 0x1f, 0x48, 0x60, 0x8e, 0xa1, 0x64, 0x21, 0xba, 0x13,
-	0xbc, 0x76, 0x34, 0xdb, 0x20, 0xe7, 0x62, 0x9b, 0xbf, 0xaf, 0xec, 0xbd, 0xe7, 0xd4, 0x93, 0x83,
-	0x8d, 0xd5, 0xac, 0xea, 0x6e, 0x62, 0xdb, 0xa0, 0xda, 0x29, 0xf7, 0x37, 0xc0, 0x89, 0x88, 0x6f,
-	0x22, 0x5b, 0x2b, 0x20, 0xdf, 0x85, 0xb8, 0xd7, 0x09, 0xd6, 0xac, 0x79, 0x83, 0x9f, 0x36, 0x1f,
-	0x16, 0x40, 0xae, 0x89, 0x6d, 0xf9, 0x3b, 0xb0, 0x98, 0x58, 0xe4, 0x2b, 0x49, 0x08, 0x93, 0xdb,
-	0x9f, 0x5a, 0x3d, 0x4a, 0x42, 0xdc, 0x0e, 0xc1, 0x72, 0x7a, 0xf5, 0x3b, 0x9b, 0x56, 0x4f, 0x09,
-	0xa9, 0xe7, 0x8f, 0x21, 0x24, 0xae, 0xf9, 0x18, 0x4c, 0xb1, 0x1d, 0x6c, 0x25, 0xad, 0x44, 0xe9,
-	0x6a, 0x29, 0x9b, 0x2e, 0xf4, 0x6f, 0x83, 0xf9, 0xd8, 0x22, 0x33, 0x42, 0x3e, 0xe4, 0xab, 0x6f,
-	0x8f, 0xe7, 0x0b, 0xbb, 0x9f, 0x83, 0x42, 0xd8, 0x38, 0xd6, 0xd2, 0x2a, 0x9c, 0xa5, 0x9e, 0x19,
-	0xc9, 0x8a, 0x3a, 0x18, 0x9b, 0xa6, 0x19, 0x0e, 0x46, 0xf9, 0x59, 0x0e, 0x66, 0x0d, 0x34, 0x9a,
-	0xfd, 0xc4, 0x30, 0xcb, 0xc8, 0x7e, 0x5c, 0x22, 0x2b, 0xfb, 0x23, 0x66, 0xd0, 0x5d, 0x09, 0x9c,
-	0x1e, 0xdb, 0xda, 0xf5, 0x51, 0x85, 0x94, 0x2d, 0xaf, 0x5e, 0x7c, 0x35, 0x79, 0xe1, 0xc8, 0x1d,
-	0x70, 0x22, 0xab, 0x1b, 0xbd, 0x35, 0x0a, 0xa5, 0x98, 0x98, 0xba, 0x71, 0x2c, 0x31, 0x71, 0xd9,
-	0x35, 0x30, 0x23, 0x3a, 0x84, 0x9a, 0xe5, 0x70, 0xc0, 0x53, 0xb5, 0xd1, 0xbc, 0xd0, 0x96, 0x3a,
-	0xfd, 0x03, 0x9d, 0x42, 0x8d, 0x0b, 0x8f, 0x5f, 0x94, 0xa4, 0xa7, 0x2f, 0x4a, 0xd2, 0x6f, 0x2f,
-	0x4a, 0xd2, 0xfd, 0x97, 0xa5, 0x89, 0xa7, 0x2f, 0x4b, 0x13, 0xbf, 0xbe, 0x2c, 0x4d, 0x7c, 0x7b,
-	0x2a, 0xb0, 0x81, 0xad, 0x3b, 0xba, 0x83, 0xf8, 0x4e, 0xc3, 0xa6, 0x5a, 0xad, 0x5f, 0xdf, 0xc9,
-	0xb3, 0x96, 0xf2, 0xde, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x1d, 0x5a, 0x98, 0xb8, 0xaa, 0x10,
-	0x00, 0x00,
+	// 1425 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcf, 0x6f, 0x13, 0xc7,
+	0x17, 0xcf, 0xc6, 0x89, 0xe3, 0x4c, 0x12, 0x47, 0x59, 0x4c, 0xb2, 0x59, 0xf8, 0xda, 0x66, 0xf9,
+	0x16, 0xac, 0xd0, 0xac, 0x71, 0x5a, 0x50, 0xeb, 0xa2, 0xaa, 0x38, 0xd0, 0x16, 0x54, 0xb7, 0x68,
+	0xf9, 0x51, 0xa9, 0x42, 0xb2, 0x36, 0xde, 0xe9, 0x66, 0x85, 0xd7, 0xb3, 0xf2, 0x8c, 0xad, 0xf8,
+	0x56, 0x71, 0xe0, 0xc0, 0x89, 0x33, 0x7f, 0x41, 0xd5, 0x53, 0x2a, 0xe5, 0xc6, 0xa9, 0x37, 0xc4,
+	0x09, 0xf5, 0xd4, 0x13, 0x54, 0xa0, 0x36, 0x52, 0xfb, 0x47, 0xb4, 0x9a, 0xd9, 0xd9, 0xf1, 0xae,
+	0x77, 0x6d, 0x07, 0x2a, 0x55, 0xbd, 0x24, 0x3b, 0xef, 0xd7, 0xbc, 0xf7, 0x79, 0x6f, 0xde, 0x7b,
+	0x09, 0x58, 0x6d, 0x22, 0xec, 0x22, 0x5c, 0xb6, 0x51, 0xaf, 0xdc, 0xab, 0x94, 0xc9, 0x9e, 0xee,
+	0x75, 0x10, 0x41, 0xf2, 0x92, 0x4f, 0xd7, 0x6d, 0xd4, 0xd3, 0x7b, 0x15, 0x35, 0xcf, 0xc5, 0x76,
+	0x4c, 0x0c, 0xcb, 0xbd, 0xca, 0x0e, 0x24, 0x66, 0xa5, 0xdc, 0x44, 0x4e, 0xdb, 0x17, 0x57, 0xd7,
+	0xa2, 0x66, 0xa8, 0x96, 0xcf, 0xc8, 0xd9, 0xc8, 0x46, 0xec, 0xb3, 0x4c, 0xbf, 0x38, 0x75, 0xdd,
+	0x17, 0x6f, 0xf8, 0x0c, 0x7e, 0x15, 0x67, 0xd9, 0x08, 0xd9, 0x2d, 0x58, 0x66, 0xa7, 0x9d, 0xee,
+	0xb7, 0x65, 0xb3, 0xdd, 0x1f, 0xba, 0xc4, 0xc5, 0x36, 0xbd, 0xc4, 0xc5, 0x36, 0x67, 0xac, 0x98,
+	0xae, 0xd3, 0x46, 0x65, 0xf6, 0x93, 0x93, 0x0a, 0xc3, 0x66, 0x88, 0xe3, 0x42, 0x4c, 0x4c, 0xd7,
+	0xf3, 0x05, 0xb4, 0x3f, 0x53, 0x60, 0xa5, 0x8e, 0xed, 0x9b, 0xdd, 0x1d, 0xd7, 0x21, 0x37, 0x3a,
+	0xc8, 0x43, 0xd8, 0x6c, 0xc9, 0xe7, 0x41, 0xc6, 0x85, 0x18, 0x9b, 0x36, 0xc4, 0x8a, 0x54, 0x4c,
+	0x95, 0x16, 0xb6, 0x72, 0xba, 0x6f, 0x49, 0x0f, 0x2c, 0xe9, 0x97, 0xdb, 0x7d, 0x43, 0x48, 0xc9,
+	0x0f, 0x25, 0xb0, 0xec, 0xb4, 0x1d, 0xe2, 0x98, 0xad, 0x86, 0x05, 0x3d, 0x84, 0x1d, 0xa2, 0x4c,
+	0x33, 0xcd, 0x75, 0x9d, 0x07, 0x46, 0x41, 0xd3, 0x39, 0x68, 0xfa, 0x36, 0x72, 0xda, 0xb5, 0x4f,
+	0x9f, 0xbe, 0x28, 0x4c, 0xfd, 0xf0, 0xb2, 0x50, 0xb2, 0x1d, 0xb2, 0xdb, 0xdd, 0xd1, 0x9b, 0xc8,
+	0xe5, 0x28, 0xf0, 0x5f, 0x9b, 0xd8, 0xba, 0x57, 0x26, 0x7d, 0x0f, 0x62, 0xa6, 0x80, 0x1f, 0x1f,
+	0xee, 0x6f, 0x2c, 0xb6, 0xa0, 0x6d, 0x36, 0xfb, 0x0d, 0x0a, 0x3b, 0xfe, 0xfe, 0x70, 0x7f, 0x43,
+	0x32, 0xb2, 0xfc, 0xe6, 0x2b, 0xfe, 0xc5, 0xf2, 0xfb, 0x20, 0xe3, 0xb1, 0x50, 0x60, 0x47, 0x49,
+	0x15, 0xa5, 0xd2, 0x7c, 0x4d, 0xf9, 0xf9, 0x60, 0x33, 0xc7, 0xfd, 0xb8, 0x6c, 0x59, 0x1d, 0x88,
+	0xf1, 0x4d, 0xd2, 0x71, 0xda, 0xb6, 0x21, 0x24, 0x65, 0x95, 0x06, 0x4d, 0x4c, 0xcb, 0x24, 0xa6,
+	0x32, 0x43, 0xb5, 0x0c, 0x71, 0x96, 0x73, 0x60, 0x96, 0x38, 0xa4, 0x05, 0x95, 0x59, 0xc6, 0xf0,
+	0x0f, 0xb2, 0x02, 0xe6, 0x70, 0xd7, 0x75, 0xcd, 0x4e, 0x5f, 0x49, 0x33, 0x7a, 0x70, 0x94, 0x8b,
+	0x60, 0x1e, 0xee, 0x79, 0xd0, 0x72, 0x08, 0xb4, 0x94, 0xb9, 0xa2, 0x54, 0xca, 0xd4, 0xa6, 0x15,
+	0xc9, 0x18, 0x10, 0xe5, 0x4f, 0xc0, 0x92, 0xc7, 0xe1, 0x6e, 0xd0, 0x08, 0x95, 0x4c, 0x51, 0x2a,
+	0x65, 0xb7, 0x4e, 0xe8, 0x91, 0x8a, 0xd3, 0x83, 0x94, 0xdc, 0xea, 0x7b, 0xd0, 0x58, 0xf4, 0x42,
+	0xa7, 0x6a, 0xe5, 0xfe, 0xe1, 0xfe, 0x86, 0x70, 0xff, 0xe1, 0xe1, 0xfe, 0x46, 0x21, 0x84, 0x5a,
+	0xaf, 0x52, 0x8e, 0xe5, 0x55, 0xbb, 0x04, 0xd6, 0x63, 0x44, 0x03, 0x62, 0x0f, 0xb5, 0x31, 0x94,
+	0x0b, 0x60, 0x41, 0x78, 0xe4, 0x58, 0x8a, 0x54, 0x94, 0x4a, 0x33, 0x06, 0x08, 0x48, 0xd7, 0x2c,
+	0xed, 0x89, 0x04, 0x72, 0x75, 0x6c, 0x5f, 0xdd, 0x83, 0xcd, 0x2f, 0x58, 0x0e, 0xb6, 0x51, 0x9b,
+	0xc0, 0x36, 0x91, 0xbf, 0x04, 0x73, 0x4d, 0xff, 0x93, 0x69, 0x8d, 0xa8, 0x96, 0x5a, 0xfe, 0xd9,
+	0xc1, 0xa6, 0x1a, 0x09, 0x2f, 0xa8, 0x05, 0xa6, 0x6b, 0x04, 0x46, 0xe4, 0x93, 0x60, 0xde, 0xec,
+	0x92, 0x5d, 0xd4, 0x71, 0x48, 0x5f, 0x99, 0x66, 0xc8, 0x0e, 0x08, 0xd5, 0x0b, 0x34, 0xee, 0xc1,
+	0x99, 0x06, 0xae, 0xc5, 0x02, 0x8f, 0x39, 0xa9, 0xe5, 0xc1, 0xc9, 0x24, 0x7a, 0x10, 0xbe, 0xf6,
+	0x9b, 0x04, 0xe6, 0xea, 0xd8, 0xbe, 0x83, 0x08, 0x94, 0x2f, 0x24, 0x40, 0x51, 0xcb, 0xfd, 0xf1,
+	0xa2, 0x10, 0x26, 0xfb, 0xb5, 0x17, 0x02, 0x48, 0xd6, 0xc1, 0x6c, 0x0f, 0x11, 0xd8, 0x51, 0xa6,
+	0x27, 0x14, 0x9d, 0x2f, 0x26, 0x57, 0x40, 0x1a, 0x79, 0xc4, 0x41, 0x6d, 0x56, 0xa5, 0xd9, 0xc1,
+	0x53, 0xe1, 0xc9, 0xa7, 0xbe, 0x7c, 0xc5, 0x04, 0x0c, 0x2e, 0x38, 0xae, 0x48, 0xab, 0xff, 0xa7,
+	0xc0, 0xf8, 0xa6, 0x29, 0x28, 0xc7, 0x63, 0xa0, 0x50, 0x7b, 0xda, 0x0a, 0x58, 0xe6, 0x9f, 0x22,
+	0xf4, 0xbf, 0x24, 0x41, 0xfb, 0x1a, 0x3a, 0xf6, 0x2e, 0xad, 0xcf, 0x7f, 0x09, 0x82, 0x8f, 0xc0,
+	0x9c, 0x1f, 0x19, 0x56, 0x52, 0xac, 0x5d, 0x9c, 0x1a, 0xc2, 0x20, 0x70, 0x28, 0x84, 0x45, 0xa0,
+	0x31, 0x16, 0x8c, 0x77, 0xa3, 0x60, 0xfc, 0x2f, 0x11, 0x8c, 0xc0, 0xb8, 0xb6, 0x0e, 0xd6, 0x86,
+	0x48, 0x02, 0x9c, 0xdf, 0x25, 0x00, 0xea, 0xd8, 0x0e, 0x7a, 0xcb, 0x5b, 0xe2, 0x72, 0x11, 0xcc,
+	0xf3, 0xb6, 0x88, 0x26, 0x63, 0x33, 0x10, 0x95, 0x2f, 0x81, 0xb4, 0xe9, 0xa2, 0x6e, 0x9b, 0x70,
+	0x78, 0xc6, 0x74, 0xd3, 0x79, 0xda, 0x4d, 0xfd, 0x9b, 0xb9, 0x4e, 0xf5, 0x1c, 0x7b, 0x2a, 0xc2,
+	0x1a, 0x05, 0x42, 0x89, 0x01, 0xc1, 0x23, 0xd3, 0x72, 0x40, 0x1e, 0x9c, 0x44, 0xf8, 0x4f, 0xfc,
+	0xda, 0xb8, 0xed, 0x59, 0x26, 0x81, 0x37, 0xcc, 0x8e, 0xe9, 0x62, 0x1a, 0xcc, 0xe0, 0x7d, 0x4a,
+	0x93, 0x82, 0x11, 0xa2, 0xf2, 0x07, 0x20, 0xed, 0x31, 0x0b, 0x0c, 0x81, 0x85, 0xad, 0xe3, 0xc3,
+	0xcd, 0x8e, 0x31, 0x23, 0x81, 0xf8, 0xf2, 0xd5, 0x8b, 0xf1, 0x37, 0x7f, 0x3a, 0x14, 0xc8, 0x5e,
+	0x30, 0x71, 0x87, 0x3c, 0xe5, 0x79, 0x0d, 0x93, 0x44, 0x60, 0x0f, 0x25, 0x36, 0xf9, 0xb6, 0xcd,
+	0x76, 0x13, 0xb6, 0x42, 0x93, 0x2f, 0x21, 0xbd, 0xcb, 0x43, 0xe9, 0x8d, 0x64, 0x36, 0x3c, 0x6c,
+	0xa6, 0x8f, 0x3a, 0x6c, 0xaa, 0x4b, 0x91, 0xe6, 0xad, 0xfd, 0x24, 0x81, 0xf5, 0x98, 0x33, 0xa2,
+	0x33, 0xbf, 0xb9, 0x53, 0xd7, 0xc0, 0x52, 0x93, 0xd9, 0x82, 0x56, 0x83, 0x8e, 0x7c, 0x0e, 0xb8,
+	0x1a, 0xeb, 0xcb, 0xb7, 0x82, 0x7d, 0xa0, 0x96, 0xa1, 0xa8, 0x3f, 0x7a, 0x59, 0x90, 0x8c, 0xc5,
+	0x40, 0x95, 0x32, 0xe5, 0xb3, 0x60, 0x59, 0x98, 0xda, 0x65, 0x8f, 0x83, 0x75, 0xab, 0x19, 0x23,
+	0x1b, 0x90, 0x3f, 0x67, 0x54, 0xed, 0x41, 0x0a, 0x14, 0xc4, 0x74, 0xa9, 0x77, 0x5b, 0xc4, 0xf1,
+	0x5a, 0x70, 0x7b, 0x17, 0x39, 0x4d, 0x28, 0xe0, 0x4d, 0x5a, 0x13, 0xa4, 0xff, 0xc2, 0x9a, 0x30,
+	0xfd, 0x56, 0x6b, 0x42, 0x6a, 0xd4, 0x9a, 0x30, 0x33, 0x62, 0x4d, 0x98, 0x8d, 0xae, 0x09, 0x57,
+	0xc1, 0x22, 0xed, 0x50, 0x8d, 0xa0, 0x05, 0xa6, 0x59, 0x96, 0xb4, 0x11, 0x3b, 0xc0, 0xa0, 0x05,
+	0x62, 0x63, 0xa1, 0x37, 0x38, 0x0c, 0x17, 0xd3, 0x75, 0x70, 0x76, 0x42, 0x1e, 0x8e, 0x3e, 0xf3,
+	0x0f, 0x24, 0xb0, 0x2a, 0x5e, 0x50, 0xdd, 0xdf, 0xf6, 0xfe, 0x61, 0x17, 0x58, 0x03, 0x73, 0x2e,
+	0xb6, 0x1b, 0xdd, 0x4e, 0x8b, 0xcf, 0xf6, 0xb4, 0x8b, 0xed, 0xdb, 0x9d, 0x96, 0xfc, 0xa1, 0x68,
+	0x0f, 0xa9, 0xa2, 0x94, 0x30, 0x0a, 0xf8, 0xf5, 0x35, 0x13, 0x43, 0x8b, 0x3f, 0xe6, 0xa0, 0x3f,
+	0x64, 0xa3, 0xfd, 0x41, 0x2b, 0x82, 0x7c, 0xb2, 0xd7, 0xe2, 0xf9, 0x3f, 0x96, 0xc0, 0x02, 0x43,
+	0xc9, 0x42, 0x74, 0x27, 0x78, 0xeb, 0x68, 0xb6, 0x41, 0xca, 0xc5, 0xb6, 0x32, 0x3d, 0x66, 0xef,
+	0x39, 0xf1, 0xec, 0x60, 0x73, 0x2d, 0xa9, 0xba, 0xeb, 0xd8, 0x36, 0xa8, 0x76, 0xcc, 0xfd, 0x4d,
+	0x70, 0x2c, 0xe4, 0x9b, 0xc8, 0xd6, 0x2a, 0x48, 0x77, 0x20, 0xee, 0xb6, 0xfc, 0x35, 0x6b, 0xd1,
+	0xe0, 0x27, 0xed, 0x47, 0xbf, 0x47, 0x5f, 0x81, 0xb4, 0xe6, 0x09, 0x64, 0x2b, 0x0c, 0x1b, 0x38,
+	0xec, 0x8c, 0x3a, 0x93, 0xe3, 0x11, 0xa2, 0xf4, 0x51, 0xf0, 0x03, 0x9c, 0xfc, 0x28, 0x02, 0xc9,
+	0xea, 0x79, 0x3e, 0x68, 0xb8, 0x95, 0xe4, 0x89, 0x1b, 0xf6, 0x8f, 0x77, 0xe6, 0x30, 0x29, 0x08,
+	0x73, 0xeb, 0x7e, 0x06, 0xa4, 0xea, 0xd8, 0x96, 0xef, 0x82, 0xec, 0xd0, 0xdf, 0x25, 0xc5, 0xe1,
+	0x8a, 0x18, 0x5e, 0x66, 0xd5, 0xd2, 0x24, 0x09, 0x01, 0x26, 0x04, 0x2b, 0xf1, 0x4d, 0xf6, 0x74,
+	0x5c, 0x3d, 0x26, 0xa4, 0x9e, 0x3b, 0x82, 0x90, 0xb8, 0xe6, 0x63, 0x30, 0xc3, 0xf2, 0xb1, 0x1a,
+	0x57, 0xa2, 0x74, 0x35, 0x9f, 0x4c, 0x17, 0xfa, 0x77, 0xc0, 0x62, 0x64, 0x2f, 0x1b, 0x21, 0x1f,
+	0xf0, 0xd5, 0x33, 0xe3, 0xf9, 0xc2, 0xee, 0x67, 0x60, 0x2e, 0xe8, 0x83, 0xeb, 0x71, 0x15, 0xce,
+	0x52, 0x4f, 0x8d, 0x64, 0x85, 0x1d, 0x8c, 0x2c, 0x07, 0x09, 0x0e, 0x86, 0xf9, 0xea, 0x99, 0xf1,
+	0x7c, 0x61, 0xf7, 0x2e, 0xc8, 0x0e, 0xcd, 0xe6, 0x84, 0xec, 0x47, 0x25, 0xd4, 0xd2, 0x24, 0x09,
+	0x61, 0xfd, 0x81, 0x04, 0x4e, 0x8e, 0x9d, 0x54, 0xfa, 0xa8, 0x42, 0x4a, 0x96, 0x57, 0x2f, 0xbe,
+	0x99, 0xbc, 0x70, 0xe4, 0x1e, 0x38, 0x96, 0xd4, 0x5c, 0xdf, 0x19, 0x85, 0x52, 0x44, 0x4c, 0xdd,
+	0x3c, 0x92, 0x98, 0xb8, 0xec, 0x3a, 0xc8, 0x88, 0x86, 0xa7, 0x26, 0x39, 0xec, 0xf3, 0x54, 0x6d,
+	0x34, 0x2f, 0x9c, 0xf7, 0x48, 0xc3, 0xc9, 0x27, 0x95, 0xca, 0x80, 0xaf, 0x9e, 0x19, 0xcf, 0x0f,
+	0xec, 0xaa, 0xb3, 0xdf, 0xd1, 0x61, 0x5d, 0xbb, 0xf0, 0xf4, 0x55, 0x5e, 0x7a, 0xfe, 0x2a, 0x2f,
+	0xfd, 0xfa, 0x2a, 0x2f, 0x3d, 0x7a, 0x9d, 0x9f, 0x7a, 0xfe, 0x3a, 0x3f, 0xf5, 0xcb, 0xeb, 0xfc,
+	0xd4, 0x37, 0x27, 0x7c, 0x3b, 0xd8, 0xba, 0xa7, 0x3b, 0x88, 0xaf, 0x7e, 0x6c, 0xf8, 0xd3, 0xff,
+	0xc8, 0xa4, 0x59, 0xe7, 0x7d, 0xef, 0xef, 0x01, 0x00, 0xa2, 0xb1, 0x57, 0x0b, 0xd1, 0x11, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1357,6 +1460,11 @@ type MsgClient interface {
 	//
 	// Since: x/gov 1.0.0
 	SudoExec(ctx context.Context, in *MsgSudoExec, opts ...grpc.CallOption) (*MsgSudoExecResponse, error)
+	// DelegateVote defines a method to delegate the governance voting power of an account to another address,
+	// without redelegating its tokens.
+	//
+	// Since: x/gov 1.0.0
+	DelegateVote(ctx context.Context, in *MsgDelegateVote, opts ...grpc.CallOption) (*MsgDelegateVoteResponse, error)
 }
 
 type msgClient struct {
@@ -1457,6 +1565,15 @@ func (c *msgClient) SudoExec(ctx context.Context, in *MsgSudoExec, opts ...grpc.
 	return out, nil
 }
 
+func (c *msgClient) DelegateVote(ctx context.Context, in *MsgDelegateVote, opts ...grpc.CallOption) (*MsgDelegateVoteResponse, error) {
+	out := new(MsgDelegateVoteResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Msg/DelegateVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SubmitProposal defines a method to create new proposal given the messages.
@@ -1492,6 +1609,11 @@ type MsgServer interface {
 	//
 	// Since: x/gov 1.0.0
 	SudoExec(context.Context, *MsgSudoExec) (*MsgSudoExecResponse, error)
+	// DelegateVote defines a method to delegate the governance voting power of an account to another address,
+	// without redelegating its tokens.
+	//
+	// Since: x/gov 1.0.0
+	DelegateVote(context.Context, *MsgDelegateVote) (*MsgDelegateVoteResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1528,6 +1650,9 @@ func (*UnimplementedMsgServer) UpdateMessageParams(ctx context.Context, req *Msg
 func (*UnimplementedMsgServer) SudoExec(ctx context.Context, req *MsgSudoExec) (*MsgSudoExecResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SudoExec not implemented")
 }
+func (*UnimplementedMsgServer) DelegateVote(ctx context.Context, req *MsgDelegateVote) (*MsgDelegateVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateVote not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1713,6 +1838,24 @@ func _Msg_SudoExec_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_DelegateVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateVote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1.Msg/DelegateVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateVote(ctx, req.(*MsgDelegateVote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.gov.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1757,6 +1900,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SudoExec",
 			Handler:    _Msg_SudoExec_Handler,
 		},
+		{
+			MethodName: "DelegateVote",
+			Handler:    _Msg_DelegateVote_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/tx.proto",
@@ -2558,6 +2705,66 @@ func (m *MsgSudoExecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgDelegateVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelegateVoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateVoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -2912,6 +3119,32 @@ func (m *MsgSudoExecResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgDelegateVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDelegateVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -5150,6 +5383,170 @@ func (m *MsgSudoExecResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgDelegateVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegateVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegateVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDelegateVoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegateVoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegateVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -20,6 +20,13 @@ const (
 	FlagExec               = "exec"
 	ExecTry                = "try"
 	FlagGroupPolicyAsAdmin = "group-policy-as-admin"
+
+	FlagMembershipChangeBehavior = "membership-change-behavior"
+	MembershipChangeRetally      = "retally"
+	MembershipChangeInvalidate   = "invalidate"
+
+	FlagVetoAuthority = "veto-authority"
+	FlagSpendLimit    = "spend-limit"
 )
 
 var errZeroGroupID = errors.New("group id cannot be 0")
@@ -283,6 +290,16 @@ and policy.json contains:
 				return err
 			}
 
+			behaviorStr, err := cmd.Flags().GetString(FlagMembershipChangeBehavior)
+			if err != nil {
+				return err
+			}
+
+			membershipChangeBehavior, err := membershipChangeBehaviorFromString(behaviorStr)
+			if err != nil {
+				return err
+			}
+
 			msg, err := group.NewMsgCreateGroupWithPolicy(
 				admin,
 				members,
@@ -294,11 +311,27 @@ and policy.json contains:
 			if err != nil {
 				return err
 			}
+			msg.MembershipChangeBehavior = membershipChangeBehavior
+			msg.VetoAuthority, err = cmd.Flags().GetString(FlagVetoAuthority)
+			if err != nil {
+				return err
+			}
+			spendLimitFile, err := cmd.Flags().GetString(FlagSpendLimit)
+			if err != nil {
+				return err
+			}
+			msg.SpendLimit, err = parseSpendLimit(clientCtx.Codec, spendLimitFile)
+			if err != nil {
+				return err
+			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 	cmd.Flags().Bool(FlagGroupPolicyAsAdmin, false, "Sets admin of the newly created group and group policy with group policy address itself when true")
+	cmd.Flags().String(FlagMembershipChangeBehavior, "", fmt.Sprintf("Set to %q or %q to re-tally or abort the group policy proposals in voting period when the group members change", MembershipChangeRetally, MembershipChangeInvalidate))
+	cmd.Flags().String(FlagVetoAuthority, "", "Address which can veto the group policy proposals once accepted, as long as they are not executed")
+	cmd.Flags().String(FlagSpendLimit, "", "Path to a JSON file with the spend limit capping the coins sent by the group policy proposals")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -370,6 +403,16 @@ Here, we can use percentage decision policy when needed, where 0 < percentage <=
 				return err
 			}
 
+			behaviorStr, err := cmd.Flags().GetString(FlagMembershipChangeBehavior)
+			if err != nil {
+				return err
+			}
+
+			membershipChangeBehavior, err := membershipChangeBehaviorFromString(behaviorStr)
+			if err != nil {
+				return err
+			}
+
 			msg, err := group.NewMsgCreateGroupPolicy(
 				admin,
 				groupID,
@@ -379,11 +422,27 @@ Here, we can use percentage decision policy when needed, where 0 < percentage <=
 			if err != nil {
 				return err
 			}
+			msg.MembershipChangeBehavior = membershipChangeBehavior
+			msg.VetoAuthority, err = cmd.Flags().GetString(FlagVetoAuthority)
+			if err != nil {
+				return err
+			}
+			spendLimitFile, err := cmd.Flags().GetString(FlagSpendLimit)
+			if err != nil {
+				return err
+			}
+			msg.SpendLimit, err = parseSpendLimit(clientCtx.Codec, spendLimitFile)
+			if err != nil {
+				return err
+			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().String(FlagMembershipChangeBehavior, "", fmt.Sprintf("Set to %q or %q to re-tally or abort the group policy proposals in voting period when the group members change", MembershipChangeRetally, MembershipChangeInvalidate))
+	cmd.Flags().String(FlagVetoAuthority, "", "Address which can veto the group policy proposals once accepted, as long as they are not executed")
+	cmd.Flags().String(FlagSpendLimit, "", "Path to a JSON file with the spend limit capping the coins sent by the group policy proposals")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -30,6 +30,25 @@ func parseDecisionPolicy(cdc codec.Codec, decisionPolicyFile string) (group.Deci
 	return policy, nil
 }
 
+// parseSpendLimit reads and parses the optional spend limit.
+func parseSpendLimit(cdc codec.Codec, spendLimitFile string) (*group.SpendLimit, error) {
+	if spendLimitFile == "" {
+		return nil, nil
+	}
+
+	contents, err := os.ReadFile(spendLimitFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var spendLimit group.SpendLimit
+	if err := cdc.UnmarshalJSON(contents, &spendLimit); err != nil {
+		return nil, fmt.Errorf("failed to parse spend limit: %w", err)
+	}
+
+	return &spendLimit, nil
+}
+
 // parseMembers reads and parses the members.
 func parseMembers(membersFile string) ([]group.MemberRequest, error) {
 	members := struct {
@@ -61,6 +80,21 @@ func execFromString(execStr string) group.Exec {
 	return exec
 }
 
+// membershipChangeBehaviorFromString parses the value of the
+// membership-change-behavior flag.
+func membershipChangeBehaviorFromString(behaviorStr string) (group.MembershipChangeBehavior, error) {
+	switch behaviorStr {
+	case "":
+		return group.MEMBERSHIP_CHANGE_BEHAVIOR_UNSPECIFIED, nil
+	case MembershipChangeRetally:
+		return group.MEMBERSHIP_CHANGE_BEHAVIOR_RETALLY, nil
+	case MembershipChangeInvalidate:
+		return group.MEMBERSHIP_CHANGE_BEHAVIOR_INVALIDATE, nil
+	default:
+		return group.MEMBERSHIP_CHANGE_BEHAVIOR_UNSPECIFIED, fmt.Errorf("invalid membership change behavior %q, expected %q or %q", behaviorStr, MembershipChangeRetally, MembershipChangeInvalidate)
+	}
+}
+
 // Proposal defines a Msg-based group proposal for CLI purposes.
 type Proposal struct {
 	GroupPolicyAddress string `json:"group_policy_address"`
@@ -26,10 +26,14 @@ func RegisterLegacyAminoCodec(cdc *codectypes.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateGroupPolicyAdmin{}, "cosmos-sdk/MsgUpdateGroupPolicyAdmin")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateGroupPolicyDecisionPolicy{}, "cosmos-sdk/MsgUpdateGroupDecisionPolicy")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateGroupPolicyMetadata{}, "cosmos-sdk/MsgUpdateGroupPolicyMetadata")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateGroupPolicyVetoAuthority{}, "cosmos-sdk/MsgUpdateGroupVetoAuthority")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateGroupPolicySpendLimit{}, "cosmos-sdk/MsgUpdateGroupSpendLimit")
 	legacy.RegisterAminoMsg(cdc, &MsgSubmitProposal{}, "cosmos-sdk/group/MsgSubmitProposal")
 	legacy.RegisterAminoMsg(cdc, &MsgWithdrawProposal{}, "cosmos-sdk/group/MsgWithdrawProposal")
 	legacy.RegisterAminoMsg(cdc, &MsgVote{}, "cosmos-sdk/group/MsgVote")
+	legacy.RegisterAminoMsg(cdc, &MsgVoteBatch{}, "cosmos-sdk/group/MsgVoteBatch")
 	legacy.RegisterAminoMsg(cdc, &MsgExec{}, "cosmos-sdk/group/MsgExec")
+	legacy.RegisterAminoMsg(cdc, &MsgVetoProposal{}, "cosmos-sdk/group/MsgVetoProposal")
 	legacy.RegisterAminoMsg(cdc, &MsgLeaveGroup{}, "cosmos-sdk/group/MsgLeaveGroup")
 }
 
@@ -45,10 +49,14 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 		&MsgUpdateGroupPolicyAdmin{},
 		&MsgUpdateGroupPolicyDecisionPolicy{},
 		&MsgUpdateGroupPolicyMetadata{},
+		&MsgUpdateGroupPolicyVetoAuthority{},
+		&MsgUpdateGroupPolicySpendLimit{},
 		&MsgSubmitProposal{},
 		&MsgWithdrawProposal{},
 		&MsgVote{},
+		&MsgVoteBatch{},
 		&MsgExec{},
+		&MsgVetoProposal{},
 		&MsgLeaveGroup{},
 	)
 
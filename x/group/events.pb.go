@@ -464,11 +464,68 @@ func (m *EventLeaveGroup) GetAddress() string {
 	return ""
 }
 
+// EventVetoProposal is an event emitted when a proposal is vetoed.
+//
+// Since: x/group v1.0.0
+type EventVetoProposal struct {
+	// proposal_id is the unique ID of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// veto_authority is the account address of the group policy's veto authority.
+	VetoAuthority string `protobuf:"bytes,2,opt,name=veto_authority,json=vetoAuthority,proto3" json:"veto_authority,omitempty"`
+}
+
+func (m *EventVetoProposal) Reset()         { *m = EventVetoProposal{} }
+func (m *EventVetoProposal) String() string { return proto.CompactTextString(m) }
+func (*EventVetoProposal) ProtoMessage()    {}
+func (*EventVetoProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8d753981546f032, []int{9}
+}
+func (m *EventVetoProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventVetoProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventVetoProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventVetoProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventVetoProposal.Merge(m, src)
+}
+func (m *EventVetoProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventVetoProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventVetoProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventVetoProposal proto.InternalMessageInfo
+
+func (m *EventVetoProposal) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *EventVetoProposal) GetVetoAuthority() string {
+	if m != nil {
+		return m.VetoAuthority
+	}
+	return ""
+}
+
 // EventProposalPruned is an event emitted when a proposal is pruned.
 type EventProposalPruned struct {
 	// proposal_id is the unique ID of the proposal.
 	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
-	// status is the proposal status (UNSPECIFIED, SUBMITTED, ACCEPTED, REJECTED, ABORTED, WITHDRAWN).
+	// status is the proposal status (UNSPECIFIED, SUBMITTED, ACCEPTED, REJECTED, ABORTED, WITHDRAWN, VETOED).
 	Status ProposalStatus `protobuf:"varint,2,opt,name=status,proto3,enum=cosmos.group.v1.ProposalStatus" json:"status,omitempty"`
 	// tally_result is the proposal tally result (when applicable).
 	TallyResult *TallyResult `protobuf:"bytes,3,opt,name=tally_result,json=tallyResult,proto3" json:"tally_result,omitempty"`
@@ -478,7 +535,7 @@ func (m *EventProposalPruned) Reset()         { *m = EventProposalPruned{} }
 func (m *EventProposalPruned) String() string { return proto.CompactTextString(m) }
 func (*EventProposalPruned) ProtoMessage()    {}
 func (*EventProposalPruned) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e8d753981546f032, []int{9}
+	return fileDescriptor_e8d753981546f032, []int{10}
 }
 func (m *EventProposalPruned) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -528,6 +585,94 @@ func (m *EventProposalPruned) GetTallyResult() *TallyResult {
 	return nil
 }
 
+// EventProposalStatusChanged is an event emitted when a proposal is submitted
+// or changes status, with its tally result.
+//
+// Since: x/group v1.0.0
+type EventProposalStatusChanged struct {
+	// proposal_id is the unique ID of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// previous_status is the proposal status before the transition, or
+	// UNSPECIFIED when the proposal is submitted.
+	PreviousStatus ProposalStatus `protobuf:"varint,2,opt,name=previous_status,json=previousStatus,proto3,enum=cosmos.group.v1.ProposalStatus" json:"previous_status,omitempty"`
+	// status is the proposal status after the transition.
+	Status ProposalStatus `protobuf:"varint,3,opt,name=status,proto3,enum=cosmos.group.v1.ProposalStatus" json:"status,omitempty"`
+	// executor_result is the proposal executor result after the transition.
+	ExecutorResult ProposalExecutorResult `protobuf:"varint,4,opt,name=executor_result,json=executorResult,proto3,enum=cosmos.group.v1.ProposalExecutorResult" json:"executor_result,omitempty"`
+	// tally_result is the stored tally result of the proposal: its final tally
+	// result once it has been tallied, an empty tally result when it is
+	// withdrawn or aborted during its voting period.
+	TallyResult *TallyResult `protobuf:"bytes,5,opt,name=tally_result,json=tallyResult,proto3" json:"tally_result,omitempty"`
+}
+
+func (m *EventProposalStatusChanged) Reset()         { *m = EventProposalStatusChanged{} }
+func (m *EventProposalStatusChanged) String() string { return proto.CompactTextString(m) }
+func (*EventProposalStatusChanged) ProtoMessage()    {}
+func (*EventProposalStatusChanged) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8d753981546f032, []int{11}
+}
+func (m *EventProposalStatusChanged) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventProposalStatusChanged) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventProposalStatusChanged.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventProposalStatusChanged) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventProposalStatusChanged.Merge(m, src)
+}
+func (m *EventProposalStatusChanged) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventProposalStatusChanged) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventProposalStatusChanged.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventProposalStatusChanged proto.InternalMessageInfo
+
+func (m *EventProposalStatusChanged) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *EventProposalStatusChanged) GetPreviousStatus() ProposalStatus {
+	if m != nil {
+		return m.PreviousStatus
+	}
+	return PROPOSAL_STATUS_UNSPECIFIED
+}
+
+func (m *EventProposalStatusChanged) GetStatus() ProposalStatus {
+	if m != nil {
+		return m.Status
+	}
+	return PROPOSAL_STATUS_UNSPECIFIED
+}
+
+func (m *EventProposalStatusChanged) GetExecutorResult() ProposalExecutorResult {
+	if m != nil {
+		return m.ExecutorResult
+	}
+	return PROPOSAL_EXECUTOR_RESULT_UNSPECIFIED
+}
+
+func (m *EventProposalStatusChanged) GetTallyResult() *TallyResult {
+	if m != nil {
+		return m.TallyResult
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*EventCreateGroup)(nil), "cosmos.group.v1.EventCreateGroup")
 	proto.RegisterType((*EventUpdateGroup)(nil), "cosmos.group.v1.EventUpdateGroup")
@@ -538,41 +683,48 @@ func init() {
 	proto.RegisterType((*EventVote)(nil), "cosmos.group.v1.EventVote")
 	proto.RegisterType((*EventExec)(nil), "cosmos.group.v1.EventExec")
 	proto.RegisterType((*EventLeaveGroup)(nil), "cosmos.group.v1.EventLeaveGroup")
+	proto.RegisterType((*EventVetoProposal)(nil), "cosmos.group.v1.EventVetoProposal")
 	proto.RegisterType((*EventProposalPruned)(nil), "cosmos.group.v1.EventProposalPruned")
+	proto.RegisterType((*EventProposalStatusChanged)(nil), "cosmos.group.v1.EventProposalStatusChanged")
 }
 
 func init() { proto.RegisterFile("cosmos/group/v1/events.proto", fileDescriptor_e8d753981546f032) }
 
 var fileDescriptor_e8d753981546f032 = []byte{
-	// 433 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x4f, 0x8b, 0xda, 0x40,
-	0x18, 0xc6, 0x1d, 0x2b, 0x5a, 0xc7, 0x52, 0xcb, 0xf4, 0x0f, 0xd1, 0x4a, 0x14, 0x2f, 0xf5, 0x50,
-	0x13, 0xb4, 0xd0, 0xf6, 0x26, 0xb5, 0x48, 0x11, 0x3c, 0x48, 0xec, 0x1f, 0xe8, 0xc5, 0x46, 0x67,
-	0xb0, 0xa1, 0xd1, 0x09, 0x33, 0x93, 0x54, 0x8f, 0xfb, 0x0d, 0xf6, 0xa3, 0xec, 0x61, 0x3f, 0xc4,
-	0x1e, 0x65, 0x4f, 0x7b, 0x5c, 0xf4, 0x8b, 0x2c, 0x99, 0x4c, 0x54, 0x5c, 0x96, 0x08, 0x7b, 0x9b,
-	0x99, 0xf7, 0xf7, 0x3c, 0x79, 0x9f, 0x79, 0x33, 0xb0, 0x32, 0xa5, 0x7c, 0x4e, 0xb9, 0x39, 0x63,
-	0xd4, 0xf7, 0xcc, 0xa0, 0x65, 0x92, 0x80, 0x2c, 0x04, 0x37, 0x3c, 0x46, 0x05, 0x45, 0xc5, 0xa8,
-	0x6a, 0xc8, 0xaa, 0x11, 0xb4, 0xca, 0xa5, 0xe8, 0x60, 0x2c, 0xcb, 0xa6, 0xaa, 0xca, 0x4d, 0xf9,
-	0xed, 0xb1, 0x93, 0x58, 0x79, 0x44, 0x15, 0xeb, 0x4d, 0xf8, 0xa2, 0x17, 0x1a, 0x7f, 0x65, 0xc4,
-	0x16, 0xe4, 0x5b, 0x88, 0xa0, 0x12, 0x7c, 0x2a, 0xd9, 0xb1, 0x83, 0x35, 0x50, 0x03, 0x8d, 0x8c,
-	0x95, 0x93, 0xfb, 0x3e, 0xde, 0xe1, 0x3f, 0x3c, 0x7c, 0x0a, 0x3e, 0x80, 0x6f, 0x8e, 0xdd, 0x87,
-	0xd4, 0x75, 0xa6, 0x2b, 0xd4, 0x86, 0x39, 0x1b, 0x63, 0x46, 0x38, 0x97, 0x9a, 0x7c, 0x57, 0xbb,
-	0xbe, 0x6c, 0xbe, 0x52, 0x7d, 0x7f, 0x89, 0x2a, 0x23, 0xc1, 0x9c, 0xc5, 0xcc, 0x8a, 0xc1, 0x9d,
-	0xdb, 0xc1, 0xc7, 0x1f, 0xe1, 0xf6, 0x11, 0xbe, 0x94, 0x6e, 0x23, 0x7f, 0x32, 0x77, 0xc4, 0x90,
-	0x51, 0x8f, 0x72, 0xdb, 0x45, 0x55, 0x58, 0xf0, 0xd4, 0x7a, 0x1f, 0x08, 0xc6, 0x47, 0x7d, 0x5c,
-	0xff, 0x0c, 0x5f, 0x4b, 0xdd, 0x2f, 0x47, 0xfc, 0xc5, 0xcc, 0xfe, 0x7f, 0xba, 0xf2, 0x3d, 0xcc,
-	0x4b, 0xe5, 0x4f, 0x2a, 0x48, 0x32, 0x7d, 0x06, 0x14, 0xde, 0x5b, 0x92, 0x69, 0x22, 0x8e, 0x3a,
-	0x30, 0xcb, 0x08, 0xf7, 0x5d, 0xa1, 0xa5, 0x6b, 0xa0, 0xf1, 0xbc, 0xfd, 0xce, 0x38, 0xfa, 0x45,
-	0x8c, 0xb8, 0xd1, 0xd0, 0xcf, 0x17, 0x94, 0x59, 0x12, 0xb7, 0x94, 0x0c, 0x21, 0x98, 0x71, 0xe9,
-	0x8c, 0x6b, 0x4f, 0xc2, 0x0b, 0xb4, 0xe4, 0xba, 0xfe, 0x07, 0x16, 0x65, 0x0b, 0x03, 0x62, 0x07,
-	0x89, 0xd3, 0x3e, 0x9c, 0x42, 0xfa, 0xd4, 0x29, 0x5c, 0x00, 0x35, 0x86, 0xb8, 0xbb, 0x21, 0xf3,
-	0x17, 0x04, 0x27, 0xe7, 0xfd, 0x04, 0xb3, 0x5c, 0xd8, 0xc2, 0xe7, 0x2a, 0x6f, 0xf5, 0xc1, 0xbc,
-	0x23, 0x89, 0x59, 0x0a, 0x47, 0x1d, 0xf8, 0x4c, 0xd8, 0xae, 0xbb, 0x1a, 0xab, 0xeb, 0x0a, 0xf3,
-	0x16, 0xda, 0x95, 0x7b, 0xf2, 0xef, 0x21, 0xa4, 0xee, 0xa8, 0x20, 0xf6, 0x9b, 0xae, 0x71, 0xb5,
-	0xd1, 0xc1, 0x7a, 0xa3, 0x83, 0xdb, 0x8d, 0x0e, 0xce, 0xb7, 0x7a, 0x6a, 0xbd, 0xd5, 0x53, 0x37,
-	0x5b, 0x3d, 0xf5, 0x5b, 0x65, 0xe5, 0xf8, 0x9f, 0xe1, 0x50, 0x73, 0x19, 0xbd, 0xb8, 0x49, 0x56,
-	0xbe, 0xb4, 0x0f, 0x77, 0x01, 0x00, 0x00, 0xff, 0xff, 0x9e, 0x96, 0x2a, 0x36, 0xd2, 0x03, 0x00,
-	0x00,
+	// 521 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xc7, 0xe3, 0x34, 0xa4, 0x74, 0x02, 0x09, 0x98, 0x0f, 0xa5, 0xa1, 0x72, 0xab, 0x5c, 0xe8,
+	0x81, 0xda, 0x6a, 0x90, 0x80, 0x5b, 0xd4, 0x56, 0x15, 0x54, 0xea, 0x21, 0x72, 0xf8, 0x90, 0xb8,
+	0x18, 0x37, 0xbb, 0x4a, 0x2c, 0xdc, 0xac, 0xb5, 0x3b, 0x6b, 0x9a, 0x23, 0x6f, 0xc0, 0xa3, 0x70,
+	0xe0, 0x21, 0x38, 0x56, 0x70, 0xe1, 0x88, 0x92, 0x17, 0x41, 0x5e, 0xaf, 0xdb, 0x34, 0x15, 0xb2,
+	0xa3, 0xde, 0x76, 0x76, 0x7e, 0xf3, 0xb1, 0xff, 0x19, 0x2d, 0x6c, 0x0c, 0x98, 0x38, 0x65, 0xc2,
+	0x19, 0x72, 0x26, 0x23, 0x27, 0xde, 0x75, 0x68, 0x4c, 0xc7, 0x28, 0xec, 0x88, 0x33, 0x64, 0x66,
+	0x23, 0xf5, 0xda, 0xca, 0x6b, 0xc7, 0xbb, 0xad, 0xf5, 0xf4, 0xc2, 0x53, 0x6e, 0x47, 0x7b, 0x95,
+	0xd1, 0x7a, 0xb2, 0x98, 0x09, 0x27, 0x11, 0xd5, 0xce, 0xf6, 0x0e, 0xdc, 0x3b, 0x4c, 0x12, 0x1f,
+	0x70, 0xea, 0x23, 0x7d, 0x9d, 0x20, 0xe6, 0x3a, 0xdc, 0x56, 0xac, 0x17, 0x90, 0xa6, 0xb1, 0x65,
+	0x6c, 0x57, 0xdc, 0x55, 0x65, 0x1f, 0x91, 0x0b, 0xfc, 0x5d, 0x44, 0x8a, 0xe0, 0xc7, 0xf0, 0x78,
+	0x31, 0x7b, 0x8f, 0x85, 0xc1, 0x60, 0x62, 0x76, 0x60, 0xd5, 0x27, 0x84, 0x53, 0x21, 0x54, 0xcc,
+	0xda, 0x7e, 0xf3, 0xd7, 0x8f, 0x9d, 0x87, 0xba, 0xef, 0xbd, 0xd4, 0xd3, 0x47, 0x1e, 0x8c, 0x87,
+	0x6e, 0x06, 0x5e, 0x64, 0x9b, 0x2b, 0x7e, 0x83, 0x6c, 0x2f, 0xe0, 0x81, 0xca, 0xd6, 0x97, 0x27,
+	0xa7, 0x01, 0xf6, 0x38, 0x8b, 0x98, 0xf0, 0x43, 0x73, 0x13, 0x6a, 0x91, 0x3e, 0x5f, 0x3e, 0x08,
+	0xb2, 0xab, 0x23, 0xd2, 0x7e, 0x05, 0x8f, 0x54, 0xdc, 0x87, 0x00, 0x47, 0x84, 0xfb, 0x5f, 0x8a,
+	0x47, 0x3e, 0x83, 0x35, 0x15, 0xf9, 0x9e, 0x21, 0xcd, 0xa7, 0xbf, 0x1a, 0x1a, 0x3f, 0x3c, 0xa3,
+	0x83, 0x5c, 0xdc, 0xec, 0x42, 0x95, 0x53, 0x21, 0x43, 0x6c, 0x96, 0xb7, 0x8c, 0xed, 0x7a, 0xe7,
+	0xa9, 0xbd, 0xb0, 0x22, 0x76, 0xd6, 0x68, 0x92, 0x4f, 0x22, 0xe3, 0xae, 0xc2, 0x5d, 0x1d, 0x66,
+	0x9a, 0x50, 0x09, 0xd9, 0x50, 0x34, 0x57, 0x12, 0x01, 0x5d, 0x75, 0x6e, 0x7f, 0x82, 0x86, 0x6a,
+	0xe1, 0x98, 0xfa, 0x71, 0xee, 0xb4, 0xe7, 0xa7, 0x50, 0x2e, 0x3a, 0x05, 0x09, 0xf7, 0x53, 0x4d,
+	0x28, 0xb2, 0xc2, 0x4a, 0x9a, 0x5d, 0xa8, 0xc7, 0x14, 0x99, 0xe7, 0x4b, 0x1c, 0x31, 0x1e, 0xe0,
+	0x24, 0xb7, 0xe0, 0xdd, 0x84, 0xdf, 0xcb, 0xf0, 0xf6, 0x77, 0x43, 0x4f, 0x3f, 0xab, 0xd9, 0xe3,
+	0x72, 0x4c, 0x49, 0x7e, 0xe5, 0x97, 0x50, 0x15, 0xe8, 0xa3, 0x14, 0x5a, 0xe6, 0xcd, 0xff, 0xca,
+	0xdc, 0x57, 0x98, 0xab, 0x71, 0xb3, 0x0b, 0x77, 0xd0, 0x0f, 0xc3, 0x89, 0xa7, 0xa7, 0x94, 0xc8,
+	0x5c, 0xeb, 0x6c, 0x5c, 0x0b, 0x7f, 0x9b, 0x40, 0x7a, 0x34, 0x35, 0xbc, 0x34, 0xda, 0xbf, 0xcb,
+	0xd0, 0xba, 0xd2, 0x72, 0x5a, 0xe0, 0x60, 0xe4, 0x8f, 0x87, 0x45, 0x3a, 0x7f, 0x03, 0x8d, 0x88,
+	0xd3, 0x38, 0x60, 0x52, 0x78, 0xcb, 0x3d, 0xa1, 0x9e, 0xc5, 0xa5, 0xf6, 0x9c, 0x06, 0x2b, 0xcb,
+	0x69, 0xd0, 0x83, 0x06, 0xd5, 0xcb, 0x97, 0xc9, 0x50, 0x59, 0x6e, 0x59, 0xeb, 0xf4, 0x8a, 0x7d,
+	0x4d, 0xd5, 0x5b, 0x4b, 0xaa, 0xba, 0x6f, 0xff, 0x9c, 0x5a, 0xc6, 0xf9, 0xd4, 0x32, 0xfe, 0x4e,
+	0x2d, 0xe3, 0xdb, 0xcc, 0x2a, 0x9d, 0xcf, 0xac, 0xd2, 0x9f, 0x99, 0x55, 0xfa, 0xa8, 0xf7, 0x48,
+	0x90, 0xcf, 0x76, 0xc0, 0x9c, 0xb3, 0xf4, 0xfb, 0x3c, 0xa9, 0xaa, 0x6f, 0xf3, 0xf9, 0xbf, 0x01,
+	0x00, 0x4e, 0xa9, 0xfa, 0xca, 0x9f, 0x05, 0x00, 0x00,
 }
 
 func (m *EventCreateGroup) Marshal() (dAtA []byte, err error) {
@@ -850,6 +1002,41 @@ func (m *EventLeaveGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventVetoProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventVetoProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventVetoProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VetoAuthority) > 0 {
+		i -= len(m.VetoAuthority)
+		copy(dAtA[i:], m.VetoAuthority)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.VetoAuthority)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventProposalPruned) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -895,6 +1082,61 @@ func (m *EventProposalPruned) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventProposalStatusChanged) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventProposalStatusChanged) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventProposalStatusChanged) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TallyResult != nil {
+		{
+			size, err := m.TallyResult.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ExecutorResult != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ExecutorResult))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Status != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PreviousStatus != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PreviousStatus))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -1027,6 +1269,22 @@ func (m *EventLeaveGroup) Size() (n int) {
 	return n
 }
 
+func (m *EventVetoProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovEvents(uint64(m.ProposalId))
+	}
+	l = len(m.VetoAuthority)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func (m *EventProposalPruned) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1046,6 +1304,31 @@ func (m *EventProposalPruned) Size() (n int) {
 	return n
 }
 
+func (m *EventProposalStatusChanged) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovEvents(uint64(m.ProposalId))
+	}
+	if m.PreviousStatus != 0 {
+		n += 1 + sovEvents(uint64(m.PreviousStatus))
+	}
+	if m.Status != 0 {
+		n += 1 + sovEvents(uint64(m.Status))
+	}
+	if m.ExecutorResult != 0 {
+		n += 1 + sovEvents(uint64(m.ExecutorResult))
+	}
+	if m.TallyResult != nil {
+		l = m.TallyResult.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1782,6 +2065,107 @@ func (m *EventLeaveGroup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventVetoProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventVetoProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventVetoProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoAuthority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VetoAuthority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EventProposalPruned) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1906,6 +2290,168 @@ func (m *EventProposalPruned) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventProposalStatusChanged) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventProposalStatusChanged: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventProposalStatusChanged: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousStatus", wireType)
+			}
+			m.PreviousStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousStatus |= ProposalStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ProposalStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorResult", wireType)
+			}
+			m.ExecutorResult = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutorResult |= ProposalExecutorResult(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TallyResult", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TallyResult == nil {
+				m.TallyResult = &TallyResult{}
+			}
+			if err := m.TallyResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -141,6 +141,37 @@ func (a table) Set(store storetypes.KVStore, rowID RowID, newValue proto.Message
 	return nil
 }
 
+// Reindex iterates through all the persisted objects and runs the registered
+// callbacks on them as if they were newly created. It can be used to populate
+// secondary index keys of an index added to a table which already has data.
+func (a table) Reindex(store storetypes.KVStore) error {
+	pStore := prefixstore.New(store, a.prefix[:])
+	it, err := pStore.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	// Collect all rows first, as the callbacks write to the store.
+	var rowIDs []RowID
+	for ; it.Valid(); it.Next() {
+		rowIDs = append(rowIDs, it.Key())
+	}
+
+	for _, rowID := range rowIDs {
+		value := reflect.New(a.model).Interface().(proto.Message)
+		if err := a.GetOne(store, rowID, value); err != nil {
+			return err
+		}
+		for i, itc := range a.afterSet {
+			if err := itc(store, rowID, value, nil); err != nil {
+				return errorsmod.Wrapf(err, "interceptor %d failed", i)
+			}
+		}
+	}
+	return nil
+}
+
 func assertValid(obj proto.Message) error {
 	if v, ok := obj.(Validateable); ok {
 		if err := v.ValidateBasic(); err != nil {
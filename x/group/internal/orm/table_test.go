@@ -241,3 +241,51 @@ func TestDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestReindex(t *testing.T) {
+	interfaceRegistry := types.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+
+	key := storetypes.NewKVStoreKey("test")
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	store := runtime.NewKVStoreService(key).OpenKVStore(testCtx.Ctx)
+
+	anyPrefix := [2]byte{0x10}
+	oldTable, err := newTable(anyPrefix, &testdata.TableModel{}, cdc)
+	require.NoError(t, err)
+	for i := uint64(1); i <= 3; i++ {
+		err := oldTable.Create(store, EncodeSequence(i), &testdata.TableModel{Id: i, Name: "some name"})
+		require.NoError(t, err)
+	}
+
+	// a table with the same prefix and a new index
+	myTable, err := newTable(anyPrefix, &testdata.TableModel{}, cdc)
+	require.NoError(t, err)
+	idx, err := NewIndex(myTable, 0x11, func(val interface{}) ([]interface{}, error) {
+		return []interface{}{val.(*testdata.TableModel).Name}, nil
+	}, "")
+	require.NoError(t, err)
+
+	has, err := idx.Has(store, "some name")
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// when
+	require.NoError(t, myTable.Reindex(store))
+
+	// then
+	it, err := idx.Get(store, "some name")
+	require.NoError(t, err)
+	var loaded []testdata.TableModel
+	_, err = ReadAll(it, &loaded)
+	require.NoError(t, err)
+	require.Len(t, loaded, 3)
+
+	// reindexing again is a no-op
+	require.NoError(t, myTable.Reindex(store))
+	it, err = idx.Get(store, "some name")
+	require.NoError(t, err)
+	_, err = ReadAll(it, &loaded)
+	require.NoError(t, err)
+	require.Len(t, loaded, 3)
+}
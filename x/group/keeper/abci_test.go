@@ -313,7 +313,7 @@ func (s *IntegrationTestSuite) TestEndBlockerPruning() {
 				pID, err := submitProposalHelper(s, s.app, sdkCtx, []sdk.Msg{msgSend2}, proposers, groupPolicyAddr2)
 				s.Require().NoError(err)
 
-				policy := group.NewThresholdDecisionPolicy("3", time.Second, 0)
+				policy := group.NewThresholdDecisionPolicy("3", 2*time.Second, 0)
 				msg := &group.MsgUpdateGroupPolicyDecisionPolicy{
 					Admin:              addr2,
 					GroupPolicyAddress: policyRes2.Address,
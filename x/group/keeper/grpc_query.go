@@ -371,3 +371,29 @@ func (k Keeper) Groups(ctx context.Context, request *group.QueryGroupsRequest) (
 		Pagination: pageRes,
 	}, nil
 }
+
+// ProposalsByStatus queries all proposals with a given status.
+func (k Keeper) ProposalsByStatus(ctx context.Context, request *group.QueryProposalsByStatusRequest) (*group.QueryProposalsByStatusResponse, error) {
+	if request.Status == group.PROPOSAL_STATUS_UNSPECIFIED {
+		return nil, errorsmod.Wrap(errors.ErrEmpty, "proposal status")
+	}
+	if _, ok := group.ProposalStatus_name[int32(request.Status)]; !ok {
+		return nil, errorsmod.Wrapf(errors.ErrInvalid, "proposal status %d", request.Status)
+	}
+
+	it, err := k.proposalsByStatus.GetPaginated(k.environment.KVStoreService.OpenKVStore(ctx), uint64(request.Status), request.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	var proposals []*group.Proposal
+	pageRes, err := orm.Paginate(it, request.Pagination, &proposals)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryProposalsByStatusResponse{
+		Proposals:  proposals,
+		Pagination: pageRes,
+	}, nil
+}
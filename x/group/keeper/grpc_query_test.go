@@ -448,3 +448,82 @@ func TestQueryGroups(t *testing.T) {
 		})
 	}
 }
+
+func TestQueryProposalsByStatus(t *testing.T) {
+	fixture := initKeeper(t)
+
+	var proposalIDs []uint64
+	for i := 0; i < 3; i++ {
+		res, err := fixture.keeper.SubmitProposal(fixture.ctx, &group.MsgSubmitProposal{
+			GroupPolicyAddress: fixture.defaultGroup.GroupPolicyAddress,
+			Proposers:          []string{fixture.addrs[1]},
+		})
+		require.NoError(t, err)
+		proposalIDs = append(proposalIDs, res.ProposalId)
+	}
+	_, err := fixture.keeper.WithdrawProposal(fixture.ctx, &group.MsgWithdrawProposal{
+		ProposalId: proposalIDs[1],
+		Address:    fixture.addrs[1],
+	})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name         string
+		status       group.ProposalStatus
+		itemsPerPage uint64
+		expErrMsg    string
+		expIDs       []uint64
+	}{
+		{
+			name:   "submitted proposals",
+			status: group.PROPOSAL_STATUS_SUBMITTED,
+			expIDs: []uint64{proposalIDs[0], proposalIDs[2]},
+		},
+		{
+			name:         "submitted proposals, with pagination",
+			status:       group.PROPOSAL_STATUS_SUBMITTED,
+			itemsPerPage: 1,
+			expIDs:       []uint64{proposalIDs[0]},
+		},
+		{
+			name:   "withdrawn proposals",
+			status: group.PROPOSAL_STATUS_WITHDRAWN,
+			expIDs: []uint64{proposalIDs[1]},
+		},
+		{
+			name:   "no accepted proposals",
+			status: group.PROPOSAL_STATUS_ACCEPTED,
+		},
+		{
+			name:      "unspecified status",
+			status:    group.PROPOSAL_STATUS_UNSPECIFIED,
+			expErrMsg: "proposal status",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := fixture.queryClient.ProposalsByStatus(context.Background(), &group.QueryProposalsByStatusRequest{
+				Status: tc.status,
+				Pagination: &query.PageRequest{
+					Limit: tc.itemsPerPage,
+				},
+			})
+			if tc.expErrMsg != "" {
+				require.ErrorContains(t, err, tc.expErrMsg)
+				return
+			}
+			require.NoError(t, err)
+
+			ids := make([]uint64, 0, len(resp.Proposals))
+			for _, proposal := range resp.Proposals {
+				require.Equal(t, tc.status, proposal.Status)
+				ids = append(ids, proposal.Id)
+			}
+			require.Equal(t, len(tc.expIDs), len(ids))
+			if len(tc.expIDs) > 0 {
+				require.Equal(t, tc.expIDs, ids)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"time"
@@ -34,10 +35,13 @@ const (
 	GroupPolicyByAdminIndexPrefix byte = 0x23
 
 	// Proposal Table
-	ProposalTablePrefix              byte = 0x30
-	ProposalTableSeqPrefix           byte = 0x31
-	ProposalByGroupPolicyIndexPrefix byte = 0x32
-	ProposalsByVotingPeriodEndPrefix byte = 0x33
+	ProposalTablePrefix               byte = 0x30
+	ProposalTableSeqPrefix            byte = 0x31
+	ProposalByGroupPolicyIndexPrefix  byte = 0x32
+	ProposalsByVotingPeriodEndPrefix  byte = 0x33
+	ProposalByGroupVersionIndexPrefix byte = 0x34
+	ProposalsByStatusPrefix           byte = 0x35
+	ProposalsByStatusAndVPEndPrefix   byte = 0x36
 
 	// Vote Table
 	VoteTablePrefix           byte = 0x40
@@ -65,9 +69,12 @@ type Keeper struct {
 	groupPolicyByAdminIndex orm.Index
 
 	// Proposal Table
-	proposalTable              orm.AutoUInt64Table
-	proposalByGroupPolicyIndex orm.Index
-	proposalsByVotingPeriodEnd orm.Index
+	proposalTable                       orm.AutoUInt64Table
+	proposalByGroupPolicyIndex          orm.Index
+	proposalsByVotingPeriodEnd          orm.Index
+	proposalByGroupVersionIndex         orm.Index
+	proposalsByStatus                   orm.Index
+	proposalsByStatusAndVotingPeriodEnd orm.Index
 
 	// Vote Table
 	voteTable           orm.PrimaryKeyTable
@@ -203,6 +210,30 @@ func NewKeeper(env appmodule.Environment, cdc codec.Codec, accKeeper group.Accou
 	if err != nil {
 		panic(err.Error())
 	}
+	k.proposalByGroupVersionIndex, err = orm.NewIndex(proposalTable, ProposalByGroupVersionIndexPrefix, func(value interface{}) ([]interface{}, error) {
+		proposal := value.(*group.Proposal)
+		addr, err := accKeeper.AddressCodec().StringToBytes(proposal.GroupPolicyAddress)
+		if err != nil {
+			return nil, err
+		}
+		return []interface{}{groupVersionKey(addr, proposal.GroupVersion)}, nil
+	}, []byte{})
+	if err != nil {
+		panic(err.Error())
+	}
+	k.proposalsByStatus, err = orm.NewIndex(proposalTable, ProposalsByStatusPrefix, func(value interface{}) ([]interface{}, error) {
+		return []interface{}{uint64(value.(*group.Proposal).Status)}, nil
+	}, uint64(0))
+	if err != nil {
+		panic(err.Error())
+	}
+	k.proposalsByStatusAndVotingPeriodEnd, err = orm.NewIndex(proposalTable, ProposalsByStatusAndVPEndPrefix, func(value interface{}) ([]interface{}, error) {
+		proposal := value.(*group.Proposal)
+		return []interface{}{statusAndVPEndKey(proposal.Status, proposal.VotingPeriodEnd)}, nil
+	}, []byte{})
+	if err != nil {
+		panic(err.Error())
+	}
 	k.proposalTable = *proposalTable
 
 	// Vote Table
@@ -279,6 +310,44 @@ func (k Keeper) proposalsByVPEnd(ctx context.Context, endTime time.Time) (propos
 	return proposals, nil
 }
 
+// statusAndVPEndKey returns the proposalsByStatusAndVotingPeriodEnd index key of the
+// proposals with a given status and voting period end.
+func statusAndVPEndKey(status group.ProposalStatus, votingPeriodEnd time.Time) []byte {
+	return append(orm.EncodeSequence(uint64(status)), sdk.FormatTimeBytes(votingPeriodEnd)...)
+}
+
+// proposalsByStatusAndVPEnd returns all proposals with a given status whose
+// voting period has ended before endTime.
+func (k Keeper) proposalsByStatusAndVPEnd(ctx context.Context, status group.ProposalStatus, endTime time.Time) (proposals []group.Proposal, err error) {
+	start := statusAndVPEndKey(status, time.Time{})
+	end := statusAndVPEndKey(status, endTime)
+	if bytes.Compare(start, end) >= 0 {
+		return proposals, nil
+	}
+
+	it, err := k.proposalsByStatusAndVotingPeriodEnd.PrefixScan(k.environment.KVStoreService.OpenKVStore(ctx), start, end)
+	if err != nil {
+		return proposals, err
+	}
+	defer it.Close()
+
+	for {
+		// Important: this following line cannot be outside of the for loop,
+		// see proposalsByVPEnd.
+		var proposal group.Proposal
+		_, err := it.LoadNext(&proposal)
+		if errors.ErrORMIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return proposals, err
+		}
+		proposals = append(proposals, proposal)
+	}
+
+	return proposals, nil
+}
+
 // pruneProposal deletes a proposal from state.
 func (k Keeper) pruneProposal(ctx context.Context, proposalID uint64) error {
 	err := k.proposalTable.Delete(k.environment.KVStoreService.OpenKVStore(ctx), proposalID)
@@ -291,27 +360,50 @@ func (k Keeper) pruneProposal(ctx context.Context, proposalID uint64) error {
 }
 
 // abortProposals iterates through all proposals by group policy index
-// and marks submitted proposals as aborted.
+// and marks submitted proposals, as well as accepted proposals which are
+// still timelocked, as aborted.
 func (k Keeper) abortProposals(ctx context.Context, groupPolicyAddr sdk.AccAddress) error {
 	proposals, err := k.proposalsByGroupPolicy(ctx, groupPolicyAddr)
 	if err != nil {
 		return err
 	}
 
+	currentTime := k.environment.HeaderService.GetHeaderInfo(ctx).Time
 	//nolint:gosec // "implicit memory aliasing in the for loop (because of the pointer on &proposalInfo)"
 	for _, proposalInfo := range proposals {
-		// Mark all proposals still in the voting phase as aborted.
-		if proposalInfo.Status == group.PROPOSAL_STATUS_SUBMITTED {
+		// Mark all proposals still in the voting phase as aborted, as well as
+		// accepted proposals whose timelock has not ended yet.
+		timelocked := proposalInfo.Status == group.PROPOSAL_STATUS_ACCEPTED &&
+			proposalInfo.TimelockEnd != nil && currentTime.Before(*proposalInfo.TimelockEnd)
+		if proposalInfo.Status == group.PROPOSAL_STATUS_SUBMITTED || timelocked {
+			previousStatus := proposalInfo.Status
 			proposalInfo.Status = group.PROPOSAL_STATUS_ABORTED
 
 			if err := k.proposalTable.Update(k.environment.KVStoreService.OpenKVStore(ctx), proposalInfo.Id, &proposalInfo); err != nil {
 				return err
 			}
+
+			if err := k.emitProposalStatusChanged(ctx, proposalInfo, previousStatus); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
 }
 
+// emitProposalStatusChanged emits an EventProposalStatusChanged for a proposal
+// which just transitioned from the given previous status, along with its
+// stored tally result.
+func (k Keeper) emitProposalStatusChanged(ctx context.Context, p group.Proposal, previousStatus group.ProposalStatus) error {
+	return k.environment.EventService.EventManager(ctx).Emit(&group.EventProposalStatusChanged{
+		ProposalId:     p.Id,
+		PreviousStatus: previousStatus,
+		Status:         p.Status,
+		ExecutorResult: p.ExecutorResult,
+		TallyResult:    &p.FinalTallyResult,
+	})
+}
+
 // proposalsByGroupPolicy returns all proposals for a given group policy.
 func (k Keeper) proposalsByGroupPolicy(ctx context.Context, groupPolicyAddr sdk.AccAddress) ([]group.Proposal, error) {
 	proposalIt, err := k.proposalByGroupPolicyIndex.Get(k.environment.KVStoreService.OpenKVStore(ctx), groupPolicyAddr.Bytes())
@@ -336,6 +428,98 @@ func (k Keeper) proposalsByGroupPolicy(ctx context.Context, groupPolicyAddr sdk.
 	return proposals, nil
 }
 
+// groupVersionKey returns the proposalByGroupVersionIndex key of the proposals
+// of a group policy submitted with a given group version.
+func groupVersionKey(groupPolicyAddr sdk.AccAddress, groupVersion uint64) []byte {
+	return append(append([]byte{}, groupPolicyAddr...), orm.EncodeSequence(groupVersion)...)
+}
+
+// proposalsBeforeGroupVersion returns all proposals for a given group policy
+// which were submitted with a group version lower than the given one.
+func (k Keeper) proposalsBeforeGroupVersion(ctx context.Context, groupPolicyAddr sdk.AccAddress, groupVersion uint64) ([]group.Proposal, error) {
+	it, err := k.proposalByGroupVersionIndex.PrefixScan(k.environment.KVStoreService.OpenKVStore(ctx), groupVersionKey(groupPolicyAddr, 0), groupVersionKey(groupPolicyAddr, groupVersion))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var proposals []group.Proposal
+	for {
+		var proposal group.Proposal
+		_, err = it.LoadNext(&proposal)
+		if errors.ErrORMIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return proposals, err
+		}
+		proposals = append(proposals, proposal)
+	}
+	return proposals, nil
+}
+
+// updateProposalsOnMembershipChange applies the membership change behavior of
+// each group policy of the given group to their proposals which are still in
+// their voting period, and were submitted with a previous group version.
+func (k Keeper) updateProposalsOnMembershipChange(ctx context.Context, groupInfo group.GroupInfo) error {
+	kvStore := k.environment.KVStoreService.OpenKVStore(ctx)
+	it, err := k.groupPolicyByGroupIndex.Get(kvStore, groupInfo.Id)
+	if err != nil {
+		return err
+	}
+	var policies []group.GroupPolicyInfo
+	for {
+		var policyInfo group.GroupPolicyInfo
+		_, err = it.LoadNext(&policyInfo)
+		if errors.ErrORMIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			it.Close()
+			return err
+		}
+		if policyInfo.MembershipChangeBehavior != group.MEMBERSHIP_CHANGE_BEHAVIOR_UNSPECIFIED {
+			policies = append(policies, policyInfo)
+		}
+	}
+	it.Close()
+
+	for _, policyInfo := range policies {
+		addr, err := k.accKeeper.AddressCodec().StringToBytes(policyInfo.Address)
+		if err != nil {
+			return err
+		}
+		proposals, err := k.proposalsBeforeGroupVersion(ctx, addr, groupInfo.Version)
+		if err != nil {
+			return err
+		}
+
+		//nolint:gosec // "implicit memory aliasing in the for loop (because of the pointer on &proposal)"
+		for _, proposal := range proposals {
+			if proposal.Status != group.PROPOSAL_STATUS_SUBMITTED {
+				continue
+			}
+
+			switch policyInfo.MembershipChangeBehavior {
+			case group.MEMBERSHIP_CHANGE_BEHAVIOR_INVALIDATE:
+				proposal.Status = group.PROPOSAL_STATUS_ABORTED
+				if err := k.emitProposalStatusChanged(ctx, proposal, group.PROPOSAL_STATUS_SUBMITTED); err != nil {
+					return err
+				}
+			case group.MEMBERSHIP_CHANGE_BEHAVIOR_RETALLY:
+				if err := k.doTallyAndUpdate(ctx, &proposal, groupInfo, policyInfo); err != nil {
+					return errorsmod.Wrapf(err, "re-tally proposal %d", proposal.Id)
+				}
+			}
+
+			if err := k.proposalTable.Update(kvStore, proposal.Id, &proposal); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 // pruneVotes prunes all votes for a proposal from state.
 func (k Keeper) pruneVotes(ctx context.Context, proposalID uint64) error {
 	votes, err := k.votesByProposal(ctx, proposalID)
@@ -412,9 +596,17 @@ func (k Keeper) PruneProposals(ctx context.Context, env appmodule.Environment) e
 // has ended, tallies their votes, prunes them, and updates the proposal's
 // `FinalTallyResult` field.
 func (k Keeper) TallyProposalsAtVPEnd(ctx context.Context, env appmodule.Environment) error {
-	proposals, err := k.proposalsByVPEnd(ctx, env.HeaderService.GetHeaderInfo(ctx).Time)
-	if err != nil {
-		return nil
+	// Only proposals which are still in voting phase, or which were aborted or
+	// withdrawn during it, have anything to do at the end of the voting
+	// period. Accepted and rejected proposals are left to PruneProposals.
+	now := env.HeaderService.GetHeaderInfo(ctx).Time
+	var proposals []group.Proposal
+	for _, status := range []group.ProposalStatus{group.PROPOSAL_STATUS_SUBMITTED, group.PROPOSAL_STATUS_ABORTED, group.PROPOSAL_STATUS_WITHDRAWN} {
+		statusProposals, err := k.proposalsByStatusAndVPEnd(ctx, status, now)
+		if err != nil {
+			return err
+		}
+		proposals = append(proposals, statusProposals...)
 	}
 	//nolint:gosec // "implicit memory aliasing in the for loop (because of the pointers in the loop)"
 	for _, proposal := range proposals {
@@ -16,6 +16,7 @@ import (
 	"cosmossdk.io/x/group/internal/math"
 	"cosmossdk.io/x/group/internal/orm"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -208,7 +209,11 @@ func (k Keeper) UpdateGroupMembers(ctx context.Context, msg *group.MsgUpdateGrou
 			return err
 		}
 
-		return k.groupTable.Update(kvStore, g.Id, g)
+		if err := k.groupTable.Update(kvStore, g.Id, g); err != nil {
+			return err
+		}
+
+		return k.updateProposalsOnMembershipChange(ctx, *g)
 	}
 
 	if err := k.doUpdateGroup(ctx, msg.GetGroupID(), msg.GetAdmin(), action, "members updated"); err != nil {
@@ -291,10 +296,13 @@ func (k Keeper) CreateGroupWithPolicy(ctx context.Context, msg *group.MsgCreateG
 
 	// NOTE: group policy message validation is performed in the CreateGroupPolicy method
 	groupPolicyRes, err := k.CreateGroupPolicy(ctx, &group.MsgCreateGroupPolicy{
-		Admin:          msg.Admin,
-		GroupId:        groupID,
-		Metadata:       msg.GroupPolicyMetadata,
-		DecisionPolicy: msg.DecisionPolicy,
+		Admin:                    msg.Admin,
+		GroupId:                  groupID,
+		Metadata:                 msg.GroupPolicyMetadata,
+		DecisionPolicy:           msg.DecisionPolicy,
+		MembershipChangeBehavior: msg.MembershipChangeBehavior,
+		VetoAuthority:            msg.VetoAuthority,
+		SpendLimit:               msg.SpendLimit,
 	})
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "group policy response")
@@ -343,6 +351,22 @@ func (k Keeper) CreateGroupPolicy(ctx context.Context, msg *group.MsgCreateGroup
 		return nil, errorsmod.Wrap(err, "decision policy")
 	}
 
+	if _, ok := group.MembershipChangeBehavior_name[int32(msg.MembershipChangeBehavior)]; !ok {
+		return nil, errorsmod.Wrapf(errors.ErrInvalid, "membership change behavior %d", msg.MembershipChangeBehavior)
+	}
+
+	if msg.VetoAuthority != "" {
+		if _, err := k.accKeeper.AddressCodec().StringToBytes(msg.VetoAuthority); err != nil {
+			return nil, errorsmod.Wrap(err, "veto authority")
+		}
+	}
+
+	if msg.SpendLimit != nil {
+		if err := msg.SpendLimit.ValidateBasic(); err != nil {
+			return nil, errorsmod.Wrap(err, "spend limit")
+		}
+	}
+
 	reqGroupAdmin, err := k.accKeeper.AddressCodec().StringToBytes(msg.GetAdmin())
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "request admin")
@@ -418,6 +442,9 @@ func (k Keeper) CreateGroupPolicy(ctx context.Context, msg *group.MsgCreateGroup
 	if err != nil {
 		return nil, err
 	}
+	groupPolicy.MembershipChangeBehavior = msg.MembershipChangeBehavior
+	groupPolicy.VetoAuthority = msg.VetoAuthority
+	groupPolicy.SpendLimit = msg.SpendLimit
 
 	if err := k.groupPolicyTable.Create(kvStore, &groupPolicy); err != nil {
 		return nil, errorsmod.Wrap(err, "could not create group policy")
@@ -465,6 +492,12 @@ func (k Keeper) UpdateGroupPolicyDecisionPolicy(ctx context.Context, msg *group.
 
 	kvStore := k.environment.KVStoreService.OpenKVStore(ctx)
 	action := func(groupPolicy *group.GroupPolicyInfo) error {
+		// the encodings of equal decision policies may differ, e.g. by their
+		// type URL prefix, so their canonical forms are compared
+		if codectypes.AnyEqual(groupPolicy.DecisionPolicy, msg.DecisionPolicy) {
+			return errorsmod.Wrap(errors.ErrInvalid, "new and old decision policies are the same")
+		}
+
 		groupInfo, err := k.getGroupInfo(ctx, groupPolicy.GroupId)
 		if err != nil {
 			return err
@@ -513,6 +546,57 @@ func (k Keeper) UpdateGroupPolicyMetadata(ctx context.Context, msg *group.MsgUpd
 	return &group.MsgUpdateGroupPolicyMetadataResponse{}, nil
 }
 
+func (k Keeper) UpdateGroupPolicyVetoAuthority(ctx context.Context, msg *group.MsgUpdateGroupPolicyVetoAuthority) (*group.MsgUpdateGroupPolicyVetoAuthorityResponse, error) {
+	kvStore := k.environment.KVStoreService.OpenKVStore(ctx)
+
+	action := func(groupPolicy *group.GroupPolicyInfo) error {
+		groupPolicy.VetoAuthority = msg.VetoAuthority
+		groupPolicy.Version++
+		return k.groupPolicyTable.Update(kvStore, groupPolicy)
+	}
+
+	if msg.VetoAuthority != "" {
+		if _, err := k.accKeeper.AddressCodec().StringToBytes(msg.VetoAuthority); err != nil {
+			return nil, errorsmod.Wrap(err, "veto authority")
+		}
+	}
+
+	err := k.doUpdateGroupPolicy(ctx, msg.GroupPolicyAddress, msg.Admin, action, "group policy veto authority updated")
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.MsgUpdateGroupPolicyVetoAuthorityResponse{}, nil
+}
+
+func (k Keeper) UpdateGroupPolicySpendLimit(ctx context.Context, msg *group.MsgUpdateGroupPolicySpendLimit) (*group.MsgUpdateGroupPolicySpendLimitResponse, error) {
+	kvStore := k.environment.KVStoreService.OpenKVStore(ctx)
+
+	action := func(groupPolicy *group.GroupPolicyInfo) error {
+		groupPolicy.SpendLimit = msg.SpendLimit
+		// The coins spent during the current epoch are kept unless the spend
+		// limit is removed, so that updating it does not reset them.
+		if msg.SpendLimit == nil {
+			groupPolicy.SpendLimitEpoch = nil
+		}
+		groupPolicy.Version++
+		return k.groupPolicyTable.Update(kvStore, groupPolicy)
+	}
+
+	if msg.SpendLimit != nil {
+		if err := msg.SpendLimit.ValidateBasic(); err != nil {
+			return nil, errorsmod.Wrap(err, "spend limit")
+		}
+	}
+
+	err := k.doUpdateGroupPolicy(ctx, msg.GroupPolicyAddress, msg.Admin, action, "group policy spend limit updated")
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.MsgUpdateGroupPolicySpendLimitResponse{}, nil
+}
+
 func (k Keeper) SubmitProposal(ctx context.Context, msg *group.MsgSubmitProposal) (*group.MsgSubmitProposalResponse, error) {
 	if len(msg.Proposers) == 0 {
 		return nil, errorsmod.Wrap(errors.ErrEmpty, "proposers")
@@ -627,6 +711,10 @@ func (k Keeper) SubmitProposal(ctx context.Context, msg *group.MsgSubmitProposal
 		return nil, err
 	}
 
+	if err := k.emitProposalStatusChanged(ctx, *m, group.PROPOSAL_STATUS_UNSPECIFIED); err != nil {
+		return nil, err
+	}
+
 	// Try to execute proposal immediately
 	if msg.Exec == group.Exec_EXEC_TRY {
 		// Consider proposers as Yes votes
@@ -692,6 +780,10 @@ func (k Keeper) WithdrawProposal(ctx context.Context, msg *group.MsgWithdrawProp
 		return nil, err
 	}
 
+	if err := k.emitProposalStatusChanged(ctx, proposal, group.PROPOSAL_STATUS_SUBMITTED); err != nil {
+		return nil, err
+	}
+
 	if err := k.environment.EventService.EventManager(ctx).Emit(&group.EventWithdrawProposal{ProposalId: msg.ProposalId}); err != nil {
 		return nil, err
 	}
@@ -699,6 +791,54 @@ func (k Keeper) WithdrawProposal(ctx context.Context, msg *group.MsgWithdrawProp
 	return &group.MsgWithdrawProposalResponse{}, nil
 }
 
+// VetoProposal vetoes an accepted proposal which is not executed yet.
+func (k Keeper) VetoProposal(ctx context.Context, msg *group.MsgVetoProposal) (*group.MsgVetoProposalResponse, error) {
+	if msg.ProposalId == 0 {
+		return nil, errorsmod.Wrap(errors.ErrEmpty, "proposal id")
+	}
+
+	if _, err := k.accKeeper.AddressCodec().StringToBytes(msg.VetoAuthority); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid veto authority address: %s", msg.VetoAuthority)
+	}
+
+	proposal, err := k.getProposal(ctx, msg.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	var policyInfo group.GroupPolicyInfo
+	if policyInfo, err = k.getGroupPolicyInfo(ctx, proposal.GroupPolicyAddress); err != nil {
+		return nil, errorsmod.Wrap(err, "load group policy")
+	}
+
+	if policyInfo.VetoAuthority == "" || msg.VetoAuthority != policyInfo.VetoAuthority {
+		return nil, errorsmod.Wrapf(errors.ErrUnauthorized, "given address is not the group policy veto authority: %s", msg.VetoAuthority)
+	}
+
+	// Ensure the proposal can be vetoed, i.e. it passed but was not executed.
+	if proposal.Status != group.PROPOSAL_STATUS_ACCEPTED || proposal.ExecutorResult == group.PROPOSAL_EXECUTOR_RESULT_SUCCESS {
+		return nil, errorsmod.Wrapf(errors.ErrInvalid, "cannot veto a proposal with the status of %s and executor result of %s", proposal.Status, proposal.ExecutorResult)
+	}
+
+	proposal.Status = group.PROPOSAL_STATUS_VETOED
+	if err := k.proposalTable.Update(k.environment.KVStoreService.OpenKVStore(ctx), msg.ProposalId, &proposal); err != nil {
+		return nil, err
+	}
+
+	if err := k.emitProposalStatusChanged(ctx, proposal, group.PROPOSAL_STATUS_ACCEPTED); err != nil {
+		return nil, err
+	}
+
+	if err := k.environment.EventService.EventManager(ctx).Emit(&group.EventVetoProposal{
+		ProposalId:    msg.ProposalId,
+		VetoAuthority: msg.VetoAuthority,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &group.MsgVetoProposalResponse{}, nil
+}
+
 func (k Keeper) Vote(ctx context.Context, msg *group.MsgVote) (*group.MsgVoteResponse, error) {
 	if msg.ProposalId == 0 {
 		return nil, errorsmod.Wrap(errors.ErrEmpty, "proposal id")
@@ -780,6 +920,64 @@ func (k Keeper) Vote(ctx context.Context, msg *group.MsgVote) (*group.MsgVoteRes
 	return &group.MsgVoteResponse{}, nil
 }
 
+// VoteBatch votes on several proposals of the same group policy at once. The
+// votes are processed in order and atomically: if any of them fails, none of
+// them is stored.
+func (k Keeper) VoteBatch(ctx context.Context, msg *group.MsgVoteBatch) (*group.MsgVoteBatchResponse, error) {
+	if len(msg.Votes) == 0 {
+		return nil, errorsmod.Wrap(errors.ErrEmpty, "votes")
+	}
+
+	if _, err := k.accKeeper.AddressCodec().StringToBytes(msg.Voter); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid voter address: %s", msg.Voter)
+	}
+
+	if _, err := k.accKeeper.AddressCodec().StringToBytes(msg.GroupPolicyAddress); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid group policy address: %s", msg.GroupPolicyAddress)
+	}
+
+	proposalIDs := make(map[uint64]struct{}, len(msg.Votes))
+	for _, vote := range msg.Votes {
+		if _, ok := proposalIDs[vote.ProposalId]; ok {
+			return nil, errorsmod.Wrapf(errors.ErrDuplicate, "proposal id %d", vote.ProposalId)
+		}
+		proposalIDs[vote.ProposalId] = struct{}{}
+	}
+
+	if err := k.environment.BranchService.Execute(ctx, func(ctx context.Context) error {
+		for _, vote := range msg.Votes {
+			if vote.ProposalId == 0 {
+				return errorsmod.Wrap(errors.ErrEmpty, "proposal id")
+			}
+
+			proposal, err := k.getProposal(ctx, vote.ProposalId)
+			if err != nil {
+				return err
+			}
+
+			if proposal.GroupPolicyAddress != msg.GroupPolicyAddress {
+				return errorsmod.Wrapf(errors.ErrInvalid, "proposal %d does not belong to group policy %s", vote.ProposalId, msg.GroupPolicyAddress)
+			}
+
+			if _, err := k.Vote(ctx, &group.MsgVote{
+				ProposalId: vote.ProposalId,
+				Voter:      msg.Voter,
+				Option:     vote.Option,
+				Metadata:   vote.Metadata,
+				Exec:       msg.Exec,
+			}); err != nil {
+				return errorsmod.Wrapf(err, "proposal %d", vote.ProposalId)
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &group.MsgVoteBatchResponse{}, nil
+}
+
 // doTallyAndUpdate performs a tally, and, if the tally result is final, then:
 // - updates the proposal's `Status` and `FinalTallyResult` fields,
 // - prune all the votes.
@@ -808,10 +1006,17 @@ func (k Keeper) doTallyAndUpdate(ctx context.Context, p *group.Proposal, groupIn
 		p.FinalTallyResult = tallyResult
 		if result.Allow {
 			p.Status = group.PROPOSAL_STATUS_ACCEPTED
+			if delay := policy.GetMinExecutionDelay(); delay > 0 {
+				timelockEnd := k.environment.HeaderService.GetHeaderInfo(ctx).Time.Add(delay)
+				p.TimelockEnd = &timelockEnd
+			}
 		} else {
 			p.Status = group.PROPOSAL_STATUS_REJECTED
 		}
 
+		if err := k.emitProposalStatusChanged(ctx, *p, group.PROPOSAL_STATUS_SUBMITTED); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -967,6 +1172,10 @@ func (k Keeper) LeaveGroup(ctx context.Context, msg *group.MsgLeaveGroup) (*grou
 		return nil, err
 	}
 
+	if err := k.updateProposalsOnMembershipChange(ctx, groupInfo); err != nil {
+		return nil, err
+	}
+
 	if err := k.environment.EventService.EventManager(ctx).Emit(&group.EventLeaveGroup{
 		GroupId: msg.GroupId,
 		Address: msg.Address,
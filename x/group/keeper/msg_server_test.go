@@ -1329,6 +1329,20 @@ func (s *TestSuite) TestUpdateGroupPolicyDecisionPolicy() {
 			expErr:    true,
 			expErrMsg: "percentage must be > 0 and <= 1",
 		},
+		"same decision policy": {
+			preRun: func(admin sdk.AccAddress) (string, uint64) {
+				s.setNextAccount()
+				return s.createGroupAndGroupPolicy(admin, nil, policy)
+			},
+			req: &group.MsgUpdateGroupPolicyDecisionPolicy{
+				Admin:              adminAddr,
+				GroupPolicyAddress: groupPolicyAddr,
+			},
+			policy:         policy,
+			expGroupPolicy: &group.GroupPolicyInfo{},
+			expErr:         true,
+			expErrMsg:      "new and old decision policies are the same",
+		},
 		"correct data": {
 			req: &group.MsgUpdateGroupPolicyDecisionPolicy{
 				Admin:              adminAddr,
@@ -2540,6 +2554,124 @@ func (s *TestSuite) TestVote() {
 	s.Require().NotEqual(tallyResult.String(), tallyResult1.String())
 }
 
+func (s *TestSuite) TestVoteBatch() {
+	msgSend := &banktypes.MsgSend{
+		FromAddress: s.groupPolicyStrAddr,
+		ToAddress:   s.addrsStr[1],
+		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+	}
+	proposers := []string{s.addrsStr[4]}
+
+	specs := map[string]struct {
+		groupPolicyAddress string
+		voter              string
+		// votes are built from the IDs of the two submitted proposals.
+		votes     func(id1, id2 uint64) []group.BatchVote
+		preRun    func(ctx context.Context, id1, id2 uint64)
+		expErrMsg string
+	}{
+		"all proposals": {
+			votes: func(id1, id2 uint64) []group.BatchVote {
+				return []group.BatchVote{
+					{ProposalId: id1, Option: group.VOTE_OPTION_YES},
+					{ProposalId: id2, Option: group.VOTE_OPTION_NO, Metadata: "metadata"},
+				}
+			},
+		},
+		"empty votes": {
+			votes:     func(id1, id2 uint64) []group.BatchVote { return nil },
+			expErrMsg: "votes: value is empty",
+		},
+		"duplicate proposal": {
+			votes: func(id1, id2 uint64) []group.BatchVote {
+				return []group.BatchVote{
+					{ProposalId: id1, Option: group.VOTE_OPTION_YES},
+					{ProposalId: id1, Option: group.VOTE_OPTION_NO},
+				}
+			},
+			expErrMsg: "duplicate value",
+		},
+		"invalid group policy address": {
+			groupPolicyAddress: "invalid",
+			votes: func(id1, id2 uint64) []group.BatchVote {
+				return []group.BatchVote{{ProposalId: id1, Option: group.VOTE_OPTION_YES}}
+			},
+			expErrMsg: "invalid group policy address",
+		},
+		"proposal of another group policy": {
+			groupPolicyAddress: s.addrsStr[2],
+			votes: func(id1, id2 uint64) []group.BatchVote {
+				return []group.BatchVote{{ProposalId: id1, Option: group.VOTE_OPTION_YES}}
+			},
+			expErrMsg: "does not belong to group policy",
+		},
+		"voter not a member": {
+			voter: s.addrsStr[3],
+			votes: func(id1, id2 uint64) []group.BatchVote {
+				return []group.BatchVote{{ProposalId: id1, Option: group.VOTE_OPTION_YES}}
+			},
+			expErrMsg: "voter address",
+		},
+		"one failing vote": {
+			votes: func(id1, id2 uint64) []group.BatchVote {
+				return []group.BatchVote{
+					{ProposalId: id1, Option: group.VOTE_OPTION_YES},
+					{ProposalId: id2, Option: group.VOTE_OPTION_YES},
+				}
+			},
+			preRun: func(ctx context.Context, id1, id2 uint64) {
+				_, err := s.groupKeeper.Vote(ctx, &group.MsgVote{ProposalId: id2, Voter: s.addrsStr[4], Option: group.VOTE_OPTION_NO})
+				s.Require().NoError(err)
+			},
+			expErrMsg: "store vote",
+		},
+	}
+	for msg, spec := range specs {
+		spec := spec
+		s.Run(msg, func() {
+			sdkCtx, _ := s.sdkCtx.CacheContext()
+
+			id1 := submitProposal(sdkCtx, s, []sdk.Msg{msgSend}, proposers)
+			id2 := submitProposal(sdkCtx, s, []sdk.Msg{msgSend}, proposers)
+			s.Require().Equal(id1+1, id2)
+			if spec.preRun != nil {
+				spec.preRun(sdkCtx, id1, id2)
+			}
+
+			req := &group.MsgVoteBatch{
+				Voter:              s.addrsStr[4],
+				GroupPolicyAddress: s.groupPolicyStrAddr,
+				Votes:              spec.votes(id1, id2),
+			}
+			if spec.voter != "" {
+				req.Voter = spec.voter
+			}
+			if spec.groupPolicyAddress != "" {
+				req.GroupPolicyAddress = spec.groupPolicyAddress
+			}
+
+			_, err := s.groupKeeper.VoteBatch(sdkCtx, req)
+			if spec.expErrMsg != "" {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), spec.expErrMsg)
+
+				// no vote of the batch should be stored
+				_, err = s.groupKeeper.VoteByProposalVoter(sdkCtx, &group.QueryVoteByProposalVoterRequest{ProposalId: id1, Voter: req.Voter})
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			for _, vote := range req.Votes {
+				res, err := s.groupKeeper.VoteByProposalVoter(sdkCtx, &group.QueryVoteByProposalVoterRequest{ProposalId: vote.ProposalId, Voter: req.Voter})
+				s.Require().NoError(err)
+				s.Require().Equal(vote.Option, res.Vote.Option)
+				s.Require().Equal(vote.Metadata, res.Vote.Metadata)
+			}
+		})
+	}
+}
+
 func (s *TestSuite) TestExecProposal() {
 	addrs := s.addrs
 	addr2 := addrs[1]
@@ -2792,6 +2924,604 @@ func (s *TestSuite) TestExecProposal() {
 	}
 }
 
+func (s *TestSuite) TestExecProposalWithMinExecutionDelay() {
+	msgSend := &banktypes.MsgSend{
+		FromAddress: s.groupPolicyStrAddr,
+		ToAddress:   s.addrsStr[1],
+		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+	}
+	proposers := []string{s.addrsStr[1]}
+	minExecutionDelay := 10 * time.Second
+	// The proposal is accepted (and its timelock starts) on its first exec.
+	acceptTime := s.blockTime.Add(minExecutionPeriod)
+
+	specs := map[string]struct {
+		srcBlockTime      time.Time
+		malleate          func(ctx context.Context)
+		expErrMsg         string
+		expProposalStatus group.ProposalStatus
+		expExecutorResult group.ProposalExecutorResult
+	}{
+		"exec proposal before timelock end should fail": {
+			srcBlockTime:      acceptTime.Add(minExecutionDelay - time.Second),
+			expProposalStatus: group.PROPOSAL_STATUS_ACCEPTED,
+			expExecutorResult: group.PROPOSAL_EXECUTOR_RESULT_FAILURE,
+		},
+		"exec proposal at exactly timelock end should pass": {
+			srcBlockTime: acceptTime.Add(minExecutionDelay),
+			malleate: func(ctx context.Context) {
+				s.bankKeeper.EXPECT().Send(gomock.Any(), msgSend).Return(nil, nil)
+			},
+			expExecutorResult: group.PROPOSAL_EXECUTOR_RESULT_SUCCESS,
+		},
+		"group policy update during timelock aborts proposal": {
+			srcBlockTime: acceptTime.Add(minExecutionDelay),
+			malleate: func(ctx context.Context) {
+				sdkCtx := sdk.UnwrapSDKContext(ctx).WithHeaderInfo(header.Info{Time: acceptTime.Add(time.Second)})
+				_, err := s.groupKeeper.UpdateGroupPolicyMetadata(sdkCtx, &group.MsgUpdateGroupPolicyMetadata{
+					Admin:              s.addrsStr[0],
+					GroupPolicyAddress: s.groupPolicyStrAddr,
+				})
+				s.Require().NoError(err)
+			},
+			expErrMsg: "PROPOSAL_STATUS_ABORTED",
+		},
+		"group policy update after timelock end does not abort proposal": {
+			srcBlockTime: acceptTime.Add(minExecutionDelay),
+			malleate: func(ctx context.Context) {
+				sdkCtx := sdk.UnwrapSDKContext(ctx).WithHeaderInfo(header.Info{Time: acceptTime.Add(minExecutionDelay)})
+				_, err := s.groupKeeper.UpdateGroupPolicyMetadata(sdkCtx, &group.MsgUpdateGroupPolicyMetadata{
+					Admin:              s.addrsStr[0],
+					GroupPolicyAddress: s.groupPolicyStrAddr,
+				})
+				s.Require().NoError(err)
+				s.bankKeeper.EXPECT().Send(gomock.Any(), msgSend).Return(nil, nil)
+			},
+			expExecutorResult: group.PROPOSAL_EXECUTOR_RESULT_SUCCESS,
+		},
+	}
+	for msg, spec := range specs {
+		spec := spec
+		s.Run(msg, func() {
+			sdkCtx, _ := s.sdkCtx.CacheContext()
+
+			policy := &group.ThresholdDecisionPolicy{
+				Threshold: "2",
+				Windows: &group.DecisionPolicyWindows{
+					VotingPeriod:       time.Second,
+					MinExecutionPeriod: minExecutionPeriod,
+					MinExecutionDelay:  minExecutionDelay,
+				},
+			}
+			policyReq := &group.MsgUpdateGroupPolicyDecisionPolicy{
+				Admin:              s.addrsStr[0],
+				GroupPolicyAddress: s.groupPolicyStrAddr,
+			}
+			s.Require().NoError(policyReq.SetDecisionPolicy(policy))
+			_, err := s.groupKeeper.UpdateGroupPolicyDecisionPolicy(sdkCtx, policyReq)
+			s.Require().NoError(err)
+
+			proposalID := submitProposalAndVote(sdkCtx, s, []sdk.Msg{msgSend}, proposers, group.VOTE_OPTION_YES)
+
+			// The first exec accepts the proposal, but fails because of the timelock.
+			res, err := s.groupKeeper.Exec(sdkCtx.WithHeaderInfo(header.Info{Time: acceptTime}), &group.MsgExec{Executor: s.addrsStr[0], ProposalId: proposalID})
+			s.Require().NoError(err)
+			s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_FAILURE, res.Result)
+
+			proposal, err := s.groupKeeper.Proposal(sdkCtx, &group.QueryProposalRequest{ProposalId: proposalID})
+			s.Require().NoError(err)
+			s.Require().Equal(group.PROPOSAL_STATUS_ACCEPTED, proposal.Proposal.Status)
+			s.Require().NotNil(proposal.Proposal.TimelockEnd)
+			s.Require().Equal(acceptTime.Add(minExecutionDelay), *proposal.Proposal.TimelockEnd)
+
+			if spec.malleate != nil {
+				spec.malleate(sdkCtx)
+			}
+
+			sdkCtx = sdkCtx.WithHeaderInfo(header.Info{Time: spec.srcBlockTime})
+			res, err = s.groupKeeper.Exec(sdkCtx, &group.MsgExec{Executor: s.addrsStr[0], ProposalId: proposalID})
+			if spec.expErrMsg != "" {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), spec.expErrMsg)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(spec.expExecutorResult, res.Result)
+
+			if spec.expExecutorResult != group.PROPOSAL_EXECUTOR_RESULT_SUCCESS {
+				proposal, err := s.groupKeeper.Proposal(sdkCtx, &group.QueryProposalRequest{ProposalId: proposalID})
+				s.Require().NoError(err)
+				s.Require().Equal(spec.expProposalStatus, proposal.Proposal.Status)
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestMembershipChangeBehavior() {
+	updateWeight := func(ctx context.Context) error {
+		_, err := s.groupKeeper.UpdateGroupMembers(ctx, &group.MsgUpdateGroupMembers{
+			Admin:         s.addrsStr[0],
+			GroupId:       s.groupID,
+			MemberUpdates: []group.MemberRequest{{Address: s.addrsStr[4], Weight: "2"}},
+		})
+		return err
+	}
+	leaveGroup := func(ctx context.Context) error {
+		_, err := s.groupKeeper.LeaveGroup(ctx, &group.MsgLeaveGroup{
+			Address: s.addrsStr[1],
+			GroupId: s.groupID,
+		})
+		return err
+	}
+	updateMetadata := func(ctx context.Context) error {
+		_, err := s.groupKeeper.UpdateGroupMetadata(ctx, &group.MsgUpdateGroupMetadata{
+			Admin:   s.addrsStr[0],
+			GroupId: s.groupID,
+		})
+		return err
+	}
+
+	specs := map[string]struct {
+		behavior          group.MembershipChangeBehavior
+		update            func(ctx context.Context) error
+		expProposalStatus group.ProposalStatus
+	}{
+		"unspecified behavior leaves proposal untouched": {
+			behavior:          group.MEMBERSHIP_CHANGE_BEHAVIOR_UNSPECIFIED,
+			update:            updateWeight,
+			expProposalStatus: group.PROPOSAL_STATUS_SUBMITTED,
+		},
+		"retally with new member weights": {
+			behavior:          group.MEMBERSHIP_CHANGE_BEHAVIOR_RETALLY,
+			update:            updateWeight,
+			expProposalStatus: group.PROPOSAL_STATUS_ACCEPTED,
+		},
+		"retally on member leaving the group": {
+			// the threshold is capped to the remaining total weight
+			behavior:          group.MEMBERSHIP_CHANGE_BEHAVIOR_RETALLY,
+			update:            leaveGroup,
+			expProposalStatus: group.PROPOSAL_STATUS_ACCEPTED,
+		},
+		"invalidate on member weight update": {
+			behavior:          group.MEMBERSHIP_CHANGE_BEHAVIOR_INVALIDATE,
+			update:            updateWeight,
+			expProposalStatus: group.PROPOSAL_STATUS_ABORTED,
+		},
+		"invalidate on member leaving the group": {
+			behavior:          group.MEMBERSHIP_CHANGE_BEHAVIOR_INVALIDATE,
+			update:            leaveGroup,
+			expProposalStatus: group.PROPOSAL_STATUS_ABORTED,
+		},
+		"group metadata update is not a membership change": {
+			behavior:          group.MEMBERSHIP_CHANGE_BEHAVIOR_INVALIDATE,
+			update:            updateMetadata,
+			expProposalStatus: group.PROPOSAL_STATUS_SUBMITTED,
+		},
+	}
+	for msg, spec := range specs {
+		spec := spec
+		s.Run(msg, func() {
+			sdkCtx, _ := s.sdkCtx.CacheContext()
+
+			s.setNextAccount()
+			policyReq := &group.MsgCreateGroupPolicy{
+				Admin:                    s.addrsStr[0],
+				GroupId:                  s.groupID,
+				MembershipChangeBehavior: spec.behavior,
+			}
+			s.Require().NoError(policyReq.SetDecisionPolicy(s.policy))
+			policyRes, err := s.groupKeeper.CreateGroupPolicy(sdkCtx, policyReq)
+			s.Require().NoError(err)
+
+			policyInfo, err := s.groupKeeper.GroupPolicyInfo(sdkCtx, &group.QueryGroupPolicyInfoRequest{Address: policyRes.Address})
+			s.Require().NoError(err)
+			s.Require().Equal(spec.behavior, policyInfo.Info.MembershipChangeBehavior)
+
+			proposalReq := &group.MsgSubmitProposal{
+				GroupPolicyAddress: policyRes.Address,
+				Proposers:          []string{s.addrsStr[4]},
+			}
+			s.Require().NoError(proposalReq.SetMsgs([]sdk.Msg{&banktypes.MsgSend{
+				FromAddress: policyRes.Address,
+				ToAddress:   s.addrsStr[1],
+				Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+			}}))
+			proposalRes, err := s.groupKeeper.SubmitProposal(sdkCtx, proposalReq)
+			s.Require().NoError(err)
+			_, err = s.groupKeeper.Vote(sdkCtx, &group.MsgVote{
+				ProposalId: proposalRes.ProposalId,
+				Voter:      s.addrsStr[4],
+				Option:     group.VOTE_OPTION_YES,
+			})
+			s.Require().NoError(err)
+
+			s.Require().NoError(spec.update(sdkCtx))
+
+			res, err := s.groupKeeper.Proposal(sdkCtx, &group.QueryProposalRequest{ProposalId: proposalRes.ProposalId})
+			s.Require().NoError(err)
+			s.Require().Equal(spec.expProposalStatus, res.Proposal.Status)
+		})
+	}
+}
+
+func (s *TestSuite) TestCreateGroupPolicyInvalidMembershipChangeBehavior() {
+	policyReq := &group.MsgCreateGroupPolicy{
+		Admin:                    s.addrsStr[0],
+		GroupId:                  s.groupID,
+		MembershipChangeBehavior: group.MembershipChangeBehavior(3),
+	}
+	s.Require().NoError(policyReq.SetDecisionPolicy(s.policy))
+	_, err := s.groupKeeper.CreateGroupPolicy(s.ctx, policyReq)
+	s.Require().ErrorContains(err, "membership change behavior 3")
+}
+
+func (s *TestSuite) TestVetoProposal() {
+	msgSend := &banktypes.MsgSend{
+		FromAddress: s.groupPolicyStrAddr,
+		ToAddress:   s.addrsStr[1],
+		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+	}
+	proposers := []string{s.addrsStr[1]}
+	vetoAuthority := s.addrsStr[3]
+
+	// acceptProposal submits a proposal which is accepted on its first exec,
+	// but not executed as its min execution period is not over yet.
+	acceptProposal := func(ctx context.Context) uint64 {
+		proposalID := submitProposalAndVote(ctx, s, []sdk.Msg{msgSend}, proposers, group.VOTE_OPTION_YES)
+		res, err := s.groupKeeper.Exec(ctx, &group.MsgExec{Executor: s.addrsStr[0], ProposalId: proposalID})
+		s.Require().NoError(err)
+		s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_FAILURE, res.Result)
+		return proposalID
+	}
+
+	specs := map[string]struct {
+		vetoAuthority string
+		preRun        func(ctx context.Context) uint64
+		voter         string
+		expErrMsg     string
+	}{
+		"accepted proposal": {
+			vetoAuthority: vetoAuthority,
+			preRun:        acceptProposal,
+			voter:         vetoAuthority,
+		},
+		"not the veto authority": {
+			vetoAuthority: vetoAuthority,
+			preRun:        acceptProposal,
+			voter:         s.addrsStr[0],
+			expErrMsg:     "not the group policy veto authority",
+		},
+		"no veto authority": {
+			preRun:    acceptProposal,
+			voter:     vetoAuthority,
+			expErrMsg: "not the group policy veto authority",
+		},
+		"submitted proposal": {
+			vetoAuthority: vetoAuthority,
+			preRun: func(ctx context.Context) uint64 {
+				return submitProposal(ctx, s, []sdk.Msg{msgSend}, proposers)
+			},
+			voter:     vetoAuthority,
+			expErrMsg: "cannot veto a proposal with the status of PROPOSAL_STATUS_SUBMITTED",
+		},
+		"already vetoed proposal": {
+			vetoAuthority: vetoAuthority,
+			preRun: func(ctx context.Context) uint64 {
+				proposalID := acceptProposal(ctx)
+				_, err := s.groupKeeper.VetoProposal(ctx, &group.MsgVetoProposal{ProposalId: proposalID, VetoAuthority: vetoAuthority})
+				s.Require().NoError(err)
+				return proposalID
+			},
+			voter:     vetoAuthority,
+			expErrMsg: "cannot veto a proposal with the status of PROPOSAL_STATUS_VETOED",
+		},
+	}
+	for msg, spec := range specs {
+		spec := spec
+		s.Run(msg, func() {
+			sdkCtx, _ := s.sdkCtx.CacheContext()
+
+			if spec.vetoAuthority != "" {
+				_, err := s.groupKeeper.UpdateGroupPolicyVetoAuthority(sdkCtx, &group.MsgUpdateGroupPolicyVetoAuthority{
+					Admin:              s.addrsStr[0],
+					GroupPolicyAddress: s.groupPolicyStrAddr,
+					VetoAuthority:      spec.vetoAuthority,
+				})
+				s.Require().NoError(err)
+			}
+
+			proposalID := spec.preRun(sdkCtx)
+
+			_, err := s.groupKeeper.VetoProposal(sdkCtx, &group.MsgVetoProposal{ProposalId: proposalID, VetoAuthority: spec.voter})
+			if spec.expErrMsg != "" {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), spec.expErrMsg)
+				return
+			}
+			s.Require().NoError(err)
+
+			res, err := s.groupKeeper.Proposal(sdkCtx, &group.QueryProposalRequest{ProposalId: proposalID})
+			s.Require().NoError(err)
+			s.Require().Equal(group.PROPOSAL_STATUS_VETOED, res.Proposal.Status)
+			s.Require().True(eventTypeFound(sdkCtx.EventManager().ABCIEvents(), "cosmos.group.v1.EventVetoProposal"))
+
+			// a vetoed proposal can no longer be executed
+			_, err = s.groupKeeper.Exec(sdkCtx.WithHeaderInfo(header.Info{Time: s.blockTime.Add(minExecutionPeriod)}), &group.MsgExec{Executor: s.addrsStr[0], ProposalId: proposalID})
+			s.Require().ErrorContains(err, "not possible to exec with proposal status PROPOSAL_STATUS_VETOED")
+		})
+	}
+}
+
+func (s *TestSuite) TestUpdateGroupPolicyVetoAuthority() {
+	specs := map[string]struct {
+		admin         string
+		vetoAuthority string
+		expErrMsg     string
+	}{
+		"set veto authority": {
+			admin:         s.addrsStr[0],
+			vetoAuthority: s.addrsStr[3],
+		},
+		"remove veto authority": {
+			admin: s.addrsStr[0],
+		},
+		"not the group policy admin": {
+			admin:         s.addrsStr[1],
+			vetoAuthority: s.addrsStr[3],
+			expErrMsg:     "not group policy admin",
+		},
+		"invalid veto authority": {
+			admin:         s.addrsStr[0],
+			vetoAuthority: "invalid",
+			expErrMsg:     "veto authority",
+		},
+	}
+	for msg, spec := range specs {
+		spec := spec
+		s.Run(msg, func() {
+			sdkCtx, _ := s.sdkCtx.CacheContext()
+
+			_, err := s.groupKeeper.UpdateGroupPolicyVetoAuthority(sdkCtx, &group.MsgUpdateGroupPolicyVetoAuthority{
+				Admin:              spec.admin,
+				GroupPolicyAddress: s.groupPolicyStrAddr,
+				VetoAuthority:      spec.vetoAuthority,
+			})
+			if spec.expErrMsg != "" {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), spec.expErrMsg)
+				return
+			}
+			s.Require().NoError(err)
+
+			res, err := s.groupKeeper.GroupPolicyInfo(sdkCtx, &group.QueryGroupPolicyInfoRequest{Address: s.groupPolicyStrAddr})
+			s.Require().NoError(err)
+			s.Require().Equal(spec.vetoAuthority, res.Info.VetoAuthority)
+			s.Require().Equal(uint64(2), res.Info.Version)
+		})
+	}
+}
+
+func (s *TestSuite) TestUpdateGroupPolicySpendLimit() {
+	specs := map[string]struct {
+		admin      string
+		spendLimit *group.SpendLimit
+		expErrMsg  string
+	}{
+		"set spend limit": {
+			admin: s.addrsStr[0],
+			spendLimit: &group.SpendLimit{
+				PerProposal:   sdk.NewCoins(sdk.NewInt64Coin("test", 100)),
+				PerEpoch:      sdk.NewCoins(sdk.NewInt64Coin("test", 1000)),
+				EpochDuration: time.Hour,
+			},
+		},
+		"remove spend limit": {
+			admin: s.addrsStr[0],
+		},
+		"not the group policy admin": {
+			admin:      s.addrsStr[1],
+			spendLimit: &group.SpendLimit{PerProposal: sdk.NewCoins(sdk.NewInt64Coin("test", 100))},
+			expErrMsg:  "not group policy admin",
+		},
+		"invalid coins": {
+			admin:      s.addrsStr[0],
+			spendLimit: &group.SpendLimit{PerProposal: sdk.Coins{sdk.NewInt64Coin("test", 0)}},
+			expErrMsg:  "per proposal",
+		},
+		"per epoch without epoch duration": {
+			admin:      s.addrsStr[0],
+			spendLimit: &group.SpendLimit{PerEpoch: sdk.NewCoins(sdk.NewInt64Coin("test", 1000))},
+			expErrMsg:  "epoch duration",
+		},
+	}
+	for msg, spec := range specs {
+		spec := spec
+		s.Run(msg, func() {
+			sdkCtx, _ := s.sdkCtx.CacheContext()
+
+			_, err := s.groupKeeper.UpdateGroupPolicySpendLimit(sdkCtx, &group.MsgUpdateGroupPolicySpendLimit{
+				Admin:              spec.admin,
+				GroupPolicyAddress: s.groupPolicyStrAddr,
+				SpendLimit:         spec.spendLimit,
+			})
+			if spec.expErrMsg != "" {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), spec.expErrMsg)
+				return
+			}
+			s.Require().NoError(err)
+
+			res, err := s.groupKeeper.GroupPolicyInfo(sdkCtx, &group.QueryGroupPolicyInfoRequest{Address: s.groupPolicyStrAddr})
+			s.Require().NoError(err)
+			s.Require().Equal(spec.spendLimit, res.Info.SpendLimit)
+			s.Require().Nil(res.Info.SpendLimitEpoch)
+			s.Require().Equal(uint64(2), res.Info.Version)
+		})
+	}
+}
+
+func (s *TestSuite) TestExecProposalWithSpendLimit() {
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	proposers := []string{s.addrsStr[1]}
+	epochDuration := time.Minute
+
+	_, err := s.groupKeeper.UpdateGroupPolicySpendLimit(sdkCtx, &group.MsgUpdateGroupPolicySpendLimit{
+		Admin:              s.addrsStr[0],
+		GroupPolicyAddress: s.groupPolicyStrAddr,
+		SpendLimit: &group.SpendLimit{
+			PerProposal:   sdk.NewCoins(sdk.NewInt64Coin("test", 150)),
+			PerEpoch:      sdk.NewCoins(sdk.NewInt64Coin("test", 250)),
+			EpochDuration: epochDuration,
+		},
+	})
+	s.Require().NoError(err)
+
+	send := func(coins ...sdk.Coin) *banktypes.MsgSend {
+		return &banktypes.MsgSend{
+			FromAddress: s.groupPolicyStrAddr,
+			ToAddress:   s.addrsStr[1],
+			Amount:      sdk.NewCoins(coins...),
+		}
+	}
+	execTime := s.blockTime.Add(minExecutionPeriod)
+	exec := func(execTime time.Time, proposalID uint64) group.ProposalExecutorResult {
+		res, err := s.groupKeeper.Exec(sdkCtx.WithHeaderInfo(header.Info{Time: execTime}), &group.MsgExec{Executor: s.addrsStr[0], ProposalId: proposalID})
+		s.Require().NoError(err)
+		return res.Result
+	}
+	spentInEpoch := func() sdk.Coins {
+		res, err := s.groupKeeper.GroupPolicyInfo(sdkCtx, &group.QueryGroupPolicyInfoRequest{Address: s.groupPolicyStrAddr})
+		s.Require().NoError(err)
+		s.Require().NotNil(res.Info.SpendLimitEpoch)
+		return res.Info.SpendLimitEpoch.Spent
+	}
+
+	// a single proposal cannot send more than the per proposal limit
+	tooLarge := submitProposalAndVote(sdkCtx, s, []sdk.Msg{send(sdk.NewInt64Coin("test", 200))}, proposers, group.VOTE_OPTION_YES)
+	s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_FAILURE, exec(execTime, tooLarge))
+
+	// denoms absent from the limit are not capped
+	msg := send(sdk.NewInt64Coin("other", 1000), sdk.NewInt64Coin("test", 100))
+	s.bankKeeper.EXPECT().Send(gomock.Any(), msg).Return(nil, nil)
+	first := submitProposalAndVote(sdkCtx, s, []sdk.Msg{msg}, proposers, group.VOTE_OPTION_YES)
+	s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_SUCCESS, exec(execTime, first))
+	s.Require().Equal(msg.Amount, spentInEpoch())
+
+	// multi sends are capped too
+	multiSend := &banktypes.MsgMultiSend{
+		Inputs:  []banktypes.Input{{Address: s.groupPolicyStrAddr, Coins: sdk.NewCoins(sdk.NewInt64Coin("test", 100))}},
+		Outputs: []banktypes.Output{{Address: s.addrsStr[1], Coins: sdk.NewCoins(sdk.NewInt64Coin("test", 100))}},
+	}
+	s.bankKeeper.EXPECT().MultiSend(gomock.Any(), multiSend).Return(nil, nil)
+	second := submitProposalAndVote(sdkCtx, s, []sdk.Msg{multiSend}, proposers, group.VOTE_OPTION_YES)
+	s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_SUCCESS, exec(execTime, second))
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("other", 1000), sdk.NewInt64Coin("test", 200)), spentInEpoch())
+
+	// the per epoch limit is reached until the end of the epoch
+	third := submitProposalAndVote(sdkCtx, s, []sdk.Msg{send(sdk.NewInt64Coin("test", 100))}, proposers, group.VOTE_OPTION_YES)
+	s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_FAILURE, exec(execTime, third))
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("other", 1000), sdk.NewInt64Coin("test", 200)), spentInEpoch())
+
+	s.bankKeeper.EXPECT().Send(gomock.Any(), send(sdk.NewInt64Coin("test", 100))).Return(nil, nil)
+	s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_SUCCESS, exec(execTime.Add(epochDuration), third))
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("test", 100)), spentInEpoch())
+}
+
+func (s *TestSuite) TestProposalStatusChangedEvents() {
+	msgSend := &banktypes.MsgSend{
+		FromAddress: s.groupPolicyStrAddr,
+		ToAddress:   s.addrsStr[1],
+		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+	}
+	proposers := []string{s.addrsStr[1]}
+	yesTally := group.TallyResult{YesCount: "2", NoCount: "0", AbstainCount: "0", NoWithVetoCount: "0"}
+	emptyTally := group.DefaultTallyResult()
+
+	specs := map[string]struct {
+		run        func(ctx sdk.Context, proposalID uint64)
+		expChanges []group.EventProposalStatusChanged
+	}{
+		"accepted and executed": {
+			run: func(ctx sdk.Context, proposalID uint64) {
+				s.bankKeeper.EXPECT().Send(gomock.Any(), msgSend).Return(nil, nil)
+				ctx = ctx.WithHeaderInfo(header.Info{Time: s.blockTime.Add(minExecutionPeriod)})
+				_, err := s.groupKeeper.Exec(ctx, &group.MsgExec{Executor: s.addrsStr[0], ProposalId: proposalID})
+				s.Require().NoError(err)
+			},
+			expChanges: []group.EventProposalStatusChanged{
+				{PreviousStatus: group.PROPOSAL_STATUS_SUBMITTED, Status: group.PROPOSAL_STATUS_ACCEPTED, ExecutorResult: group.PROPOSAL_EXECUTOR_RESULT_NOT_RUN, TallyResult: &yesTally},
+			},
+		},
+		"accepted and failed to execute": {
+			run: func(ctx sdk.Context, proposalID uint64) {
+				s.bankKeeper.EXPECT().Send(gomock.Any(), msgSend).Return(nil, fmt.Errorf("error"))
+				ctx = ctx.WithHeaderInfo(header.Info{Time: s.blockTime.Add(minExecutionPeriod)})
+				_, err := s.groupKeeper.Exec(ctx, &group.MsgExec{Executor: s.addrsStr[0], ProposalId: proposalID})
+				s.Require().NoError(err)
+			},
+			expChanges: []group.EventProposalStatusChanged{
+				{PreviousStatus: group.PROPOSAL_STATUS_SUBMITTED, Status: group.PROPOSAL_STATUS_ACCEPTED, ExecutorResult: group.PROPOSAL_EXECUTOR_RESULT_NOT_RUN, TallyResult: &yesTally},
+			},
+		},
+		"withdrawn": {
+			run: func(ctx sdk.Context, proposalID uint64) {
+				_, err := s.groupKeeper.WithdrawProposal(ctx, &group.MsgWithdrawProposal{ProposalId: proposalID, Address: proposers[0]})
+				s.Require().NoError(err)
+			},
+			expChanges: []group.EventProposalStatusChanged{
+				{PreviousStatus: group.PROPOSAL_STATUS_SUBMITTED, Status: group.PROPOSAL_STATUS_WITHDRAWN, ExecutorResult: group.PROPOSAL_EXECUTOR_RESULT_NOT_RUN, TallyResult: &emptyTally},
+			},
+		},
+		"aborted": {
+			run: func(ctx sdk.Context, proposalID uint64) {
+				_, err := s.groupKeeper.UpdateGroupPolicyMetadata(ctx, &group.MsgUpdateGroupPolicyMetadata{
+					Admin:              s.addrsStr[0],
+					GroupPolicyAddress: s.groupPolicyStrAddr,
+					Metadata:           "updated",
+				})
+				s.Require().NoError(err)
+			},
+			expChanges: []group.EventProposalStatusChanged{
+				{PreviousStatus: group.PROPOSAL_STATUS_SUBMITTED, Status: group.PROPOSAL_STATUS_ABORTED, ExecutorResult: group.PROPOSAL_EXECUTOR_RESULT_NOT_RUN, TallyResult: &emptyTally},
+			},
+		},
+	}
+	for msg, spec := range specs {
+		spec := spec
+		s.Run(msg, func() {
+			sdkCtx, _ := s.sdkCtx.CacheContext()
+			sdkCtx = sdkCtx.WithEventManager(sdk.NewEventManager())
+
+			proposalID := submitProposalAndVote(sdkCtx, s, []sdk.Msg{msgSend}, proposers, group.VOTE_OPTION_YES)
+			spec.run(sdkCtx, proposalID)
+
+			var changes []group.EventProposalStatusChanged
+			for _, event := range sdkCtx.EventManager().ABCIEvents() {
+				event, err := sdk.ParseTypedEvent(event)
+				s.Require().NoError(err)
+
+				if e, ok := event.(*group.EventProposalStatusChanged); ok {
+					changes = append(changes, *e)
+				}
+			}
+
+			submitted := group.EventProposalStatusChanged{
+				ProposalId:     proposalID,
+				PreviousStatus: group.PROPOSAL_STATUS_UNSPECIFIED,
+				Status:         group.PROPOSAL_STATUS_SUBMITTED,
+				ExecutorResult: group.PROPOSAL_EXECUTOR_RESULT_NOT_RUN,
+				TallyResult:    &emptyTally,
+			}
+			expChanges := []group.EventProposalStatusChanged{submitted}
+			for _, change := range spec.expChanges {
+				change.ProposalId = proposalID
+				expChanges = append(expChanges, change)
+			}
+			s.Require().Equal(expChanges, changes)
+		})
+	}
+}
+
 func (s *TestSuite) TestExecPrunedProposalsAndVotes() {
 	proposers := []string{s.addrsStr[1]}
 	specs := map[string]struct {
@@ -6,6 +6,7 @@ import (
 
 	"cosmossdk.io/core/address"
 	errorsmod "cosmossdk.io/errors"
+	banktypes "cosmossdk.io/x/bank/types"
 	"cosmossdk.io/x/group"
 	"cosmossdk.io/x/group/errors"
 
@@ -25,6 +26,11 @@ func (k Keeper) doExecuteMsgs(ctx context.Context, proposal group.Proposal, grou
 		return errors.ErrInvalid.Wrapf("must wait until %s to execute proposal %d", minExecutionDate, proposal.Id)
 	}
 
+	// Ensure the proposal is not timelocked anymore.
+	if proposal.TimelockEnd != nil && currentTime.Before(*proposal.TimelockEnd) {
+		return errors.ErrInvalid.Wrapf("proposal %d is timelocked until %s", proposal.Id, *proposal.TimelockEnd)
+	}
+
 	// Ensure it's not too late to execute the messages.
 	// After https://github.com/cosmos/cosmos-sdk/issues/11245, proposals should
 	// be pruned automatically, so this function should not even be called, as
@@ -44,6 +50,10 @@ func (k Keeper) doExecuteMsgs(ctx context.Context, proposal group.Proposal, grou
 		return err
 	}
 
+	if err := k.applySpendLimit(ctx, proposal, msgs); err != nil {
+		return err
+	}
+
 	for i, msg := range msgs {
 		if _, err := k.environment.RouterService.MessageRouterService().InvokeUntyped(ctx, msg); err != nil {
 			return errorsmod.Wrapf(err, "message %s at position %d", sdk.MsgTypeURL(msg), i)
@@ -76,3 +86,89 @@ func ensureMsgAuthZ(msgs []sdk.Msg, groupPolicyAcc sdk.AccAddress, cdc codec.Cod
 	}
 	return nil
 }
+
+// applySpendLimit ensures that the coins sent by the messages of a proposal
+// stay within the spend limit of its group policy, and adds them to the coins
+// spent during the current spend limit epoch. A new epoch starts with the
+// first proposal sending coins after the end of the previous one.
+func (k Keeper) applySpendLimit(ctx context.Context, proposal group.Proposal, msgs []sdk.Msg) error {
+	policyInfo, err := k.getGroupPolicyInfo(ctx, proposal.GroupPolicyAddress)
+	if err != nil {
+		return errorsmod.Wrap(err, "load group policy")
+	}
+
+	limit := policyInfo.SpendLimit
+	if limit == nil {
+		return nil
+	}
+
+	spent, err := spentCoins(msgs)
+	if err != nil {
+		return err
+	}
+
+	if spent.IsZero() {
+		return nil
+	}
+
+	if coin, ok := exceedsLimit(spent, limit.PerProposal); ok {
+		return errors.ErrMaxLimit.Wrapf("proposal %d spends more than the group policy spend limit of %s per proposal", proposal.Id, coin)
+	}
+
+	if limit.PerEpoch.Empty() {
+		return nil
+	}
+
+	currentTime := k.environment.HeaderService.GetHeaderInfo(ctx).Time
+	epoch := policyInfo.SpendLimitEpoch
+	if epoch == nil || !currentTime.Before(epoch.Start.Add(limit.EpochDuration)) {
+		epoch = &group.SpendLimitEpoch{Start: currentTime}
+	}
+
+	epoch.Spent = epoch.Spent.Add(spent...)
+	if coin, ok := exceedsLimit(epoch.Spent, limit.PerEpoch); ok {
+		return errors.ErrMaxLimit.Wrapf("proposal %d spends more than the group policy spend limit of %s per epoch", proposal.Id, coin)
+	}
+
+	policyInfo.SpendLimitEpoch = epoch
+	return k.groupPolicyTable.Update(k.environment.KVStoreService.OpenKVStore(ctx), &policyInfo)
+}
+
+// spentCoins returns the coins sent by the bank messages of a proposal. As
+// ensureMsgAuthZ checked that the group policy is the signer of all the
+// messages, these coins are all sent from the group policy account.
+func spentCoins(msgs []sdk.Msg) (sdk.Coins, error) {
+	spent := sdk.NewCoins()
+	for i, msg := range msgs {
+		var sends []sdk.Coins
+		switch msg := msg.(type) {
+		case *banktypes.MsgSend:
+			sends = append(sends, msg.Amount)
+		case *banktypes.MsgMultiSend:
+			for _, input := range msg.Inputs {
+				sends = append(sends, input.Coins)
+			}
+		}
+
+		for _, coins := range sends {
+			if err := coins.Validate(); err != nil {
+				return nil, errorsmod.Wrapf(err, "message %s at position %d", sdk.MsgTypeURL(msg), i)
+			}
+			spent = spent.Add(coins...)
+		}
+	}
+
+	return spent, nil
+}
+
+// exceedsLimit returns the first coin of the limit whose amount is exceeded by
+// the spent coins. The denoms absent from the limit are not capped.
+func exceedsLimit(spent, limit sdk.Coins) (sdk.Coin, bool) {
+	for _, coin := range limit {
+		if spent.AmountOf(coin.Denom).GT(coin.Amount) {
+			return coin, true
+		}
+	}
+
+	return sdk.Coin{}, false
+}
@@ -15,11 +15,12 @@ import (
 // and returns the tally result without modifying the proposal or any state.
 func (k Keeper) Tally(ctx context.Context, p group.Proposal, groupID uint64) (group.TallyResult, error) {
 	// If proposal has already been tallied and updated, then its status is
-	// accepted/rejected, in which case we just return the previously stored result.
+	// accepted/rejected (or vetoed after being accepted), in which case we just
+	// return the previously stored result.
 	//
 	// In all other cases (including withdrawn, aborted...) we do the tally
 	// again.
-	if p.Status == group.PROPOSAL_STATUS_ACCEPTED || p.Status == group.PROPOSAL_STATUS_REJECTED {
+	if p.Status == group.PROPOSAL_STATUS_ACCEPTED || p.Status == group.PROPOSAL_STATUS_REJECTED || p.Status == group.PROPOSAL_STATUS_VETOED {
 		return p.FinalTallyResult, nil
 	}
 
@@ -0,0 +1,24 @@
+package v3
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/x/group/internal/orm"
+)
+
+// Migrate migrates the x/group module state from the consensus version 2 to version 3.
+// Specifically, it populates the proposals by group version, by status, and by
+// status and voting period end indexes of the existing proposals.
+func Migrate(
+	ctx context.Context,
+	storeService store.KVStoreService,
+	proposalTable orm.AutoUInt64Table,
+) error {
+	if err := proposalTable.Reindex(storeService.OpenKVStore(ctx)); err != nil {
+		return fmt.Errorf("failed to reindex proposals: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package v3_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/group"
+	"cosmossdk.io/x/group/internal/orm"
+	groupkeeper "cosmossdk.io/x/group/keeper"
+	v3 "cosmossdk.io/x/group/migrations/v3"
+	groupmodule "cosmossdk.io/x/group/module"
+
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+const policyAddr = "cosmos1q32tjg5qm3n9fj8wjgpd7gl98prefntrckjkyvh8tntp7q33zj0s5tkjrk"
+
+func TestMigrate(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, groupmodule.AppModule{}).Codec
+	storeKey := storetypes.NewKVStoreKey(group.ModuleName)
+	storeService := runtime.NewKVStoreService(storeKey)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	kvStore := storeService.OpenKVStore(ctx)
+
+	// proposals stored before the migration are not indexed by group version
+	oldProposalTable, err := orm.NewAutoUInt64Table([2]byte{groupkeeper.ProposalTablePrefix}, groupkeeper.ProposalTableSeqPrefix, &group.Proposal{}, cdc)
+	require.NoError(t, err)
+	for i, version := range []uint64{1, 1, 2} {
+		_, err := oldProposalTable.Create(kvStore, &group.Proposal{
+			Id:                 uint64(i + 1),
+			GroupPolicyAddress: policyAddr,
+			GroupVersion:       version,
+			GroupPolicyVersion: 1,
+			FinalTallyResult:   group.DefaultTallyResult(),
+		})
+		require.NoError(t, err)
+	}
+
+	proposalTable, err := orm.NewAutoUInt64Table([2]byte{groupkeeper.ProposalTablePrefix}, groupkeeper.ProposalTableSeqPrefix, &group.Proposal{}, cdc)
+	require.NoError(t, err)
+	byGroupVersion, err := orm.NewIndex(proposalTable, groupkeeper.ProposalByGroupVersionIndexPrefix, func(value interface{}) ([]interface{}, error) {
+		return []interface{}{value.(*group.Proposal).GroupVersion}, nil
+	}, uint64(0))
+	require.NoError(t, err)
+
+	has, err := byGroupVersion.Has(kvStore, uint64(1))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	require.NoError(t, v3.Migrate(ctx, storeService, *proposalTable))
+
+	for version, expCount := range map[uint64]int{1: 2, 2: 1} {
+		it, err := byGroupVersion.Get(kvStore, version)
+		require.NoError(t, err)
+		var proposals []*group.Proposal
+		_, err = orm.ReadAll(it, &proposals)
+		require.NoError(t, err)
+		require.Len(t, proposals, expCount)
+	}
+	require.Equal(t, uint64(3), proposalTable.Sequence().CurVal(kvStore))
+}
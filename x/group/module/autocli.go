@@ -75,6 +75,14 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "address"},
 					},
 				},
+				{
+					RpcMethod: "ProposalsByStatus",
+					Use:       "proposals-by-status [status]",
+					Short:     "Query for proposals by status",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "status"},
+					},
+				},
 				{
 					RpcMethod: "VoteByProposalVoter",
 					Use:       "vote [proposal-id] [voter]",
@@ -159,6 +167,27 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "admin"}, {ProtoField: "group_policy_address"}, {ProtoField: "metadata"},
 					},
 				},
+				{
+					RpcMethod: "UpdateGroupPolicyVetoAuthority",
+					Use:       "update-group-policy-veto-authority [admin] [group-policy-account] [new-veto-authority]",
+					Short:     "Update a group policy veto authority",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "admin"}, {ProtoField: "group_policy_address"}, {ProtoField: "veto_authority"},
+					},
+				},
+				{
+					RpcMethod: "UpdateGroupPolicySpendLimit",
+					Use:       "update-group-policy-spend-limit [admin] [group-policy-account] [spend-limit-json]",
+					Long: `Update a group policy spend limit.
+Parameters:
+	admin: account address of the group policy admin
+	group-policy-account: account address of the group policy
+	spend-limit-json: JSON encoded spend limit, e.g. {"per_proposal": [{"denom": "stake", "amount": "100"}], "per_epoch": [{"denom": "stake", "amount": "1000"}], "epoch_duration": "86400s"}
+`,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "admin"}, {ProtoField: "group_policy_address"}, {ProtoField: "spend_limit"},
+					},
+				},
 				{
 					RpcMethod: "WithdrawProposal",
 					Use:       "withdraw-proposal [proposal-id] [group-policy-admin-or-proposer]",
@@ -189,6 +218,22 @@ Parameters:
 						"exec": {Name: "exec", DefaultValue: "", Usage: "Set to 'try' for trying to execute proposal immediately after voting"},
 					},
 				},
+				{
+					RpcMethod: "VoteBatch",
+					Use:       "vote-batch [voter] [group-policy-account] --votes [vote-json] --votes [vote-json]",
+					Long: `Vote on several proposals of the same group policy at once.
+Parameters:
+	voter: voter account address.
+	group-policy-account: account address of the group policy of the proposals
+	votes: JSON encoded vote on a proposal, e.g. {"proposal_id": 1, "option": "VOTE_OPTION_YES", "metadata": ""}
+`,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "voter"}, {ProtoField: "group_policy_address"},
+					},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"exec": {Name: "exec", DefaultValue: "", Usage: "Set to 'try' for trying to execute the proposals immediately after voting"},
+					},
+				},
 				{
 					RpcMethod: "Exec",
 					Use:       "exec [proposal-id]",
@@ -197,6 +242,14 @@ Parameters:
 						{ProtoField: "proposal_id"},
 					},
 				},
+				{
+					RpcMethod: "VetoProposal",
+					Use:       "veto-proposal [proposal-id] [veto-authority]",
+					Short:     "Veto an accepted proposal which is not executed yet",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "proposal_id"}, {ProtoField: "veto_authority"},
+					},
+				},
 				{
 					RpcMethod: "LeaveGroup",
 					Use:       "leave-group [member-address] [group-id]",
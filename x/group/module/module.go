@@ -25,7 +25,7 @@ import (
 )
 
 // ConsensusVersion defines the current x/group module consensus version.
-const ConsensusVersion = 2
+const ConsensusVersion = 3
 
 var (
 	_ module.HasName             = AppModule{}
@@ -112,6 +112,10 @@ func (am AppModule) RegisterMigrations(mr appmodule.MigrationRegistrar) error {
 		return fmt.Errorf("failed to migrate x/%s from version 1 to 2: %v", group.ModuleName, err)
 	}
 
+	if err := mr.Register(group.ModuleName, 2, m.Migrate2to3); err != nil {
+		return fmt.Errorf("failed to migrate x/%s from version 2 to 3: %v", group.ModuleName, err)
+	}
+
 	return nil
 }
 
@@ -20,9 +20,13 @@ var (
 	_ sdk.Msg = &MsgUpdateGroupPolicyAdmin{}
 	_ sdk.Msg = &MsgUpdateGroupPolicyDecisionPolicy{}
 	_ sdk.Msg = &MsgUpdateGroupPolicyMetadata{}
+	_ sdk.Msg = &MsgUpdateGroupPolicyVetoAuthority{}
+	_ sdk.Msg = &MsgUpdateGroupPolicySpendLimit{}
 	_ sdk.Msg = &MsgLeaveGroup{}
 	_ sdk.Msg = &MsgExec{}
+	_ sdk.Msg = &MsgVetoProposal{}
 	_ sdk.Msg = &MsgVote{}
+	_ sdk.Msg = &MsgVoteBatch{}
 	_ sdk.Msg = &MsgWithdrawProposal{}
 	_ sdk.Msg = &MsgSubmitProposal{}
 	_ sdk.Msg = &MsgCreateGroupPolicy{}
@@ -1486,6 +1486,120 @@ func (m *QueryGroupsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryProposalsByStatusRequest is the Query/ProposalsByStatus request type.
+//
+// Since: x/group v1.0.0
+type QueryProposalsByStatusRequest struct {
+	// status is the status of the proposals.
+	Status ProposalStatus `protobuf:"varint,1,opt,name=status,proto3,enum=cosmos.group.v1.ProposalStatus" json:"status,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryProposalsByStatusRequest) Reset()         { *m = QueryProposalsByStatusRequest{} }
+func (m *QueryProposalsByStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalsByStatusRequest) ProtoMessage()    {}
+func (*QueryProposalsByStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{28}
+}
+func (m *QueryProposalsByStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalsByStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalsByStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProposalsByStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalsByStatusRequest.Merge(m, src)
+}
+func (m *QueryProposalsByStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalsByStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalsByStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProposalsByStatusRequest proto.InternalMessageInfo
+
+func (m *QueryProposalsByStatusRequest) GetStatus() ProposalStatus {
+	if m != nil {
+		return m.Status
+	}
+	return PROPOSAL_STATUS_UNSPECIFIED
+}
+
+func (m *QueryProposalsByStatusRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryProposalsByStatusResponse is the Query/ProposalsByStatus response type.
+//
+// Since: x/group v1.0.0
+type QueryProposalsByStatusResponse struct {
+	// proposals are the proposals with given status.
+	Proposals []*Proposal `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryProposalsByStatusResponse) Reset()         { *m = QueryProposalsByStatusResponse{} }
+func (m *QueryProposalsByStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalsByStatusResponse) ProtoMessage()    {}
+func (*QueryProposalsByStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{29}
+}
+func (m *QueryProposalsByStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalsByStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalsByStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProposalsByStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalsByStatusResponse.Merge(m, src)
+}
+func (m *QueryProposalsByStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalsByStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalsByStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProposalsByStatusResponse proto.InternalMessageInfo
+
+func (m *QueryProposalsByStatusResponse) GetProposals() []*Proposal {
+	if m != nil {
+		return m.Proposals
+	}
+	return nil
+}
+
+func (m *QueryProposalsByStatusResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryGroupInfoRequest)(nil), "cosmos.group.v1.QueryGroupInfoRequest")
 	proto.RegisterType((*QueryGroupInfoResponse)(nil), "cosmos.group.v1.QueryGroupInfoResponse")
@@ -1515,94 +1629,100 @@ func init() {
 	proto.RegisterType((*QueryTallyResultResponse)(nil), "cosmos.group.v1.QueryTallyResultResponse")
 	proto.RegisterType((*QueryGroupsRequest)(nil), "cosmos.group.v1.QueryGroupsRequest")
 	proto.RegisterType((*QueryGroupsResponse)(nil), "cosmos.group.v1.QueryGroupsResponse")
+	proto.RegisterType((*QueryProposalsByStatusRequest)(nil), "cosmos.group.v1.QueryProposalsByStatusRequest")
+	proto.RegisterType((*QueryProposalsByStatusResponse)(nil), "cosmos.group.v1.QueryProposalsByStatusResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/group/v1/query.proto", fileDescriptor_0fcf9f1d74302290) }
 
 var fileDescriptor_0fcf9f1d74302290 = []byte{
-	// 1298 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x98, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0x33, 0xa5, 0xf9, 0xf5, 0xd2, 0x36, 0xea, 0x34, 0x6d, 0x93, 0x6d, 0xe4, 0x84, 0x2d,
-	0xe4, 0x77, 0x76, 0x63, 0x27, 0x4d, 0x11, 0x50, 0xa1, 0x5a, 0x82, 0x90, 0x43, 0x51, 0x6a, 0x2a,
-	0x24, 0x10, 0x52, 0xb4, 0xae, 0x37, 0xd6, 0x0a, 0x7b, 0xc7, 0xf5, 0x6e, 0x22, 0xac, 0xc8, 0x17,
-	0x24, 0x38, 0x20, 0x0e, 0xd0, 0x22, 0x54, 0x22, 0x0e, 0x3d, 0x20, 0xd1, 0x23, 0x07, 0x10, 0x12,
-	0xb7, 0xde, 0x7a, 0xac, 0xe0, 0xc2, 0x09, 0xa1, 0x04, 0x89, 0x3f, 0x82, 0x0b, 0xda, 0x99, 0xb7,
-	0xf6, 0xfe, 0xf6, 0x5a, 0x58, 0xe0, 0x4b, 0x55, 0xef, 0xbc, 0x99, 0xf7, 0x99, 0xef, 0x7b, 0x3b,
-	0xfb, 0x9d, 0xc0, 0x95, 0xbb, 0xcc, 0xaa, 0x32, 0x4b, 0x2d, 0xd7, 0xd9, 0x7e, 0x4d, 0x3d, 0xc8,
-	0xaa, 0xf7, 0xf6, 0xf5, 0x7a, 0x43, 0xa9, 0xd5, 0x99, 0xcd, 0xe8, 0xb8, 0x18, 0x54, 0xf8, 0xa0,
-	0x72, 0x90, 0x95, 0x26, 0xca, 0xac, 0xcc, 0xf8, 0x98, 0xea, 0xfc, 0x4f, 0x84, 0x49, 0xd3, 0x65,
-	0xc6, 0xca, 0x15, 0x5d, 0xd5, 0x6a, 0x86, 0xaa, 0x99, 0x26, 0xb3, 0x35, 0xdb, 0x60, 0xa6, 0x85,
-	0xa3, 0xa1, 0x0c, 0x76, 0xa3, 0xa6, 0xbb, 0x83, 0x4b, 0x38, 0x58, 0xd4, 0x2c, 0x5d, 0xa4, 0x56,
-	0x0f, 0xb2, 0x45, 0xdd, 0xd6, 0xb2, 0x6a, 0x4d, 0x2b, 0x1b, 0x26, 0x5f, 0x09, 0x63, 0xa7, 0x44,
-	0xec, 0xae, 0xc8, 0x8f, 0x68, 0x62, 0xe8, 0xbc, 0x56, 0x35, 0x4c, 0xa6, 0xf2, 0x7f, 0xc5, 0x23,
-	0x39, 0x07, 0x17, 0x6f, 0x3b, 0xeb, 0x6d, 0x39, 0x69, 0xb7, 0xcd, 0x3d, 0x56, 0xd0, 0xef, 0xed,
-	0xeb, 0x96, 0x4d, 0xa7, 0x60, 0x84, 0xa3, 0xec, 0x1a, 0xa5, 0x49, 0x32, 0x4b, 0x16, 0x4e, 0x17,
-	0x86, 0xf9, 0xef, 0xed, 0x92, 0xfc, 0x26, 0x5c, 0x0a, 0xce, 0xb1, 0x6a, 0xcc, 0xb4, 0x74, 0xaa,
-	0xc0, 0x69, 0xc3, 0xdc, 0x63, 0x7c, 0xc2, 0x58, 0x4e, 0x52, 0x02, 0xc2, 0x28, 0xed, 0x19, 0x3c,
-	0x4e, 0xbe, 0x0d, 0x57, 0xda, 0x2b, 0xed, 0xb0, 0x8a, 0x71, 0xb7, 0xe1, 0x65, 0xc8, 0xc1, 0xb0,
-	0x56, 0x2a, 0xd5, 0x75, 0xcb, 0xe2, 0x2b, 0x8e, 0xe6, 0x27, 0x7f, 0xf9, 0x61, 0x75, 0x02, 0x17,
-	0xbd, 0x29, 0x46, 0xde, 0xb6, 0xeb, 0x86, 0x59, 0x2e, 0xb8, 0x81, 0xf2, 0x1d, 0x98, 0x8e, 0x5e,
-	0x12, 0x11, 0x37, 0x7c, 0x88, 0xb3, 0xd1, 0x88, 0x9e, 0x79, 0x02, 0xb4, 0x09, 0x93, 0xed, 0x55,
-	0x6f, 0xe9, 0xd5, 0xa2, 0x5e, 0xb7, 0x3a, 0x2b, 0x45, 0xdf, 0x00, 0x68, 0xd7, 0x67, 0xf2, 0x14,
-	0x4f, 0x39, 0xe7, 0xa6, 0x74, 0x8a, 0xa9, 0x88, 0x3e, 0xc2, 0x62, 0x2a, 0x3b, 0x5a, 0x59, 0xc7,
-	0x65, 0x0b, 0x9e, 0x99, 0xf2, 0x37, 0x04, 0xa6, 0x22, 0xf2, 0xe3, 0x96, 0x36, 0x61, 0xb8, 0x2a,
-	0x1e, 0x4d, 0x92, 0xd9, 0xe7, 0x16, 0xc6, 0x72, 0xd3, 0xd1, 0xbb, 0x12, 0xf3, 0x0a, 0x6e, 0x30,
-	0xdd, 0x8a, 0xa0, 0x9b, 0xef, 0x48, 0x27, 0x92, 0xfa, 0xf0, 0x1e, 0xf8, 0xf0, 0xac, 0x7c, 0xe3,
-	0x66, 0xa9, 0x6a, 0x98, 0xae, 0x3e, 0x0a, 0x0c, 0x6a, 0xce, 0xef, 0x8e, 0x35, 0x14, 0x61, 0x3d,
-	0x13, 0xed, 0x6b, 0x02, 0x52, 0x14, 0x15, 0xaa, 0x96, 0x83, 0x21, 0x2e, 0x8f, 0x2b, 0x5a, 0x52,
-	0xb7, 0x62, 0x64, 0xef, 0x14, 0xfb, 0x98, 0xc0, 0x6c, 0xa0, 0x4d, 0x0d, 0xdd, 0xca, 0x8b, 0x9f,
-	0xff, 0x61, 0x63, 0xfd, 0x48, 0xe0, 0xf9, 0x04, 0x0e, 0x94, 0x6a, 0x0b, 0xce, 0x09, 0x90, 0x1a,
-	0x06, 0xa0, 0x64, 0x9d, 0xdf, 0x9e, 0xb3, 0x65, 0xef, 0xba, 0xbd, 0xd3, 0xef, 0x28, 0x46, 0xbf,
-	0xbe, 0x68, 0xbc, 0x38, 0x51, 0xfd, 0xfd, 0xd7, 0x7f, 0xa2, 0x5e, 0x87, 0x09, 0x8e, 0xbd, 0x53,
-	0x67, 0x35, 0x66, 0x69, 0x15, 0x57, 0xc7, 0x19, 0x18, 0xab, 0xe1, 0xa3, 0x76, 0x2b, 0x82, 0xfb,
-	0x68, 0xbb, 0x24, 0xbf, 0x85, 0x1f, 0x91, 0xf6, 0x44, 0xdc, 0xe3, 0x35, 0x18, 0x71, 0xc3, 0xf0,
-	0xc0, 0x9d, 0x0a, 0xed, 0xae, 0x35, 0xa9, 0x15, 0x2a, 0x3f, 0x22, 0x20, 0xfb, 0x16, 0x74, 0x3b,
-	0x52, 0x88, 0xf0, 0x2f, 0x3e, 0x0f, 0x3d, 0xab, 0xf1, 0x77, 0x04, 0xae, 0x26, 0x22, 0xa2, 0x02,
-	0xd7, 0x61, 0xd4, 0xdd, 0x96, 0x5b, 0xe0, 0x04, 0x09, 0xda, 0xb1, 0xbd, 0xab, 0x6a, 0x1d, 0x66,
-	0x38, 0xe8, 0x3b, 0xcc, 0xd6, 0xf3, 0x2d, 0x5c, 0xe7, 0x57, 0x3d, 0x6d, 0x81, 0x9d, 0x37, 0xe9,
-	0xc0, 0x99, 0xc0, 0x39, 0x12, 0xdf, 0x24, 0x1e, 0x26, 0xdf, 0xc2, 0xb7, 0x33, 0x32, 0x27, 0x2a,
-	0xb3, 0x08, 0xa7, 0x9d, 0x60, 0xec, 0x8b, 0x8b, 0x21, 0x51, 0x9c, 0xe8, 0x02, 0x0f, 0x91, 0x3f,
-	0x21, 0xe8, 0x13, 0x9c, 0x67, 0x56, 0xbe, 0xeb, 0x06, 0xed, 0x59, 0xd5, 0xbf, 0x24, 0xe8, 0x2e,
-	0x42, 0x20, 0xb8, 0xa9, 0x65, 0x21, 0x94, 0x5b, 0xea, 0x98, 0x5d, 0x89, 0x98, 0xde, 0x95, 0xf8,
-	0x3e, 0x41, 0x7b, 0x82, 0x58, 0xbe, 0xe2, 0xb6, 0x6a, 0x47, 0x52, 0xd5, 0xae, 0x67, 0x5a, 0x7d,
-	0xe1, 0x9a, 0x02, 0x3f, 0xd4, 0xff, 0x2a, 0xd4, 0xc3, 0xa0, 0x25, 0x40, 0x4b, 0xd4, 0x07, 0x07,
-	0xca, 0x11, 0xf1, 0x7a, 0x61, 0x0f, 0x5a, 0x3f, 0xd8, 0x95, 0x97, 0xe1, 0x32, 0x67, 0xbb, 0xa3,
-	0x55, 0x2a, 0xce, 0xd9, 0xb6, 0x5f, 0xb1, 0x53, 0x7f, 0x1c, 0xde, 0xc5, 0xde, 0xf4, 0xcd, 0xc5,
-	0x4d, 0xdd, 0x80, 0x41, 0xdb, 0x79, 0x8c, 0x87, 0x40, 0xd8, 0xb7, 0x7a, 0x26, 0xe5, 0x47, 0x9f,
-	0xfe, 0x3e, 0x33, 0xf0, 0xf8, 0xaf, 0xef, 0x97, 0x48, 0x41, 0xcc, 0x92, 0xdf, 0x07, 0xea, 0x91,
-	0xcc, 0x25, 0xea, 0x55, 0x45, 0xee, 0x13, 0xb8, 0xe0, 0x5b, 0xbe, 0x0f, 0x2a, 0x91, 0xfb, 0xfb,
-	0x3c, 0x0c, 0x72, 0x28, 0xfa, 0x19, 0x81, 0xd1, 0x56, 0x22, 0x3a, 0x17, 0x82, 0x88, 0xbc, 0xd6,
-	0x49, 0xf3, 0x1d, 0xe3, 0x44, 0x52, 0x59, 0xf9, 0xe8, 0xd7, 0x3f, 0x1f, 0x9c, 0x5a, 0xa0, 0x73,
-	0x6a, 0xf0, 0x62, 0x8a, 0x9e, 0xd4, 0xdc, 0x63, 0xea, 0xa1, 0xeb, 0x4f, 0x9b, 0xf4, 0x5b, 0x02,
-	0xe3, 0x01, 0xa3, 0x42, 0x57, 0x12, 0x92, 0x85, 0x6e, 0x7b, 0xd2, 0x6a, 0xca, 0x68, 0x04, 0xdc,
-	0xe0, 0x80, 0x0a, 0x5d, 0x89, 0x01, 0xe4, 0xb6, 0xaa, 0x81, 0x9c, 0xf8, 0xb6, 0x36, 0xe9, 0x43,
-	0x02, 0x67, 0xbc, 0x97, 0x28, 0xba, 0x98, 0x90, 0xd5, 0x7f, 0xd1, 0x93, 0x96, 0xd2, 0x84, 0x22,
-	0x5d, 0x96, 0xd3, 0x2d, 0xd3, 0xc5, 0x18, 0x3a, 0xbc, 0x83, 0x79, 0x15, 0x3c, 0x22, 0x70, 0xd6,
-	0x77, 0x55, 0xa1, 0x49, 0x09, 0x03, 0x66, 0x57, 0x5a, 0x4e, 0x15, 0x8b, 0x74, 0x6b, 0x9c, 0x6e,
-	0x89, 0x2e, 0x44, 0xd3, 0x59, 0xbb, 0xc5, 0xc6, 0x2e, 0xf7, 0xc4, 0x8e, 0x72, 0x55, 0xc3, 0x6c,
-	0xd2, 0x9f, 0x09, 0x4c, 0x44, 0xdd, 0x11, 0x68, 0xb6, 0x53, 0xd5, 0x42, 0xf7, 0x1a, 0x29, 0xd7,
-	0xcd, 0x14, 0x24, 0x7e, 0x85, 0x13, 0x5f, 0xa3, 0xeb, 0x49, 0xd5, 0x36, 0x74, 0x4e, 0x2e, 0x86,
-	0x3c, 0xca, 0xfe, 0x14, 0x86, 0x17, 0x02, 0xa7, 0x83, 0xf7, 0xe9, 0x9c, 0xeb, 0x66, 0x0a, 0xc2,
-	0xbf, 0xc4, 0xe1, 0x73, 0x74, 0x2d, 0x05, 0xbc, 0x5f, 0xf6, 0x4f, 0x09, 0x8c, 0xb8, 0x26, 0x83,
-	0xbe, 0x18, 0x9d, 0x3a, 0xe0, 0x86, 0xa4, 0xb9, 0x4e, 0x61, 0x48, 0xa5, 0x72, 0xaa, 0x45, 0x3a,
-	0x1f, 0xa2, 0x72, 0x4f, 0x6f, 0xf5, 0xd0, 0x73, 0xb4, 0x37, 0xe9, 0x13, 0x02, 0x97, 0xa2, 0xed,
-	0x2e, 0x5d, 0x4f, 0xce, 0x19, 0xe9, 0xdf, 0xa5, 0x8d, 0xee, 0x26, 0x21, 0xf6, 0xab, 0x1c, 0x7b,
-	0x93, 0x6e, 0xc4, 0x62, 0xb7, 0x9b, 0x00, 0x0f, 0x01, 0xcf, 0xfb, 0xff, 0x84, 0xc0, 0x85, 0x08,
-	0x57, 0x4a, 0xd7, 0xa2, 0x59, 0xe2, 0x4d, 0xb3, 0x94, 0xed, 0x62, 0x06, 0xa2, 0xbf, 0xce, 0xd1,
-	0x5f, 0xa3, 0x37, 0x42, 0xe8, 0x8e, 0xcf, 0x71, 0xa8, 0x5b, 0x7a, 0x73, 0x2f, 0xe6, 0xd7, 0x5f,
-	0x3d, 0xe4, 0x0f, 0x9b, 0xf4, 0x31, 0x81, 0xf1, 0x80, 0x01, 0x8d, 0x3b, 0x6a, 0xa3, 0x0d, 0x73,
-	0xdc, 0x51, 0x1b, 0xe3, 0x6a, 0x13, 0xfa, 0x97, 0xfb, 0x33, 0x2f, 0x78, 0xa0, 0x65, 0xbe, 0x22,
-	0x70, 0xc6, 0xeb, 0xff, 0xe2, 0x8e, 0xdb, 0x08, 0xe3, 0x1a, 0x77, 0xdc, 0x46, 0xd9, 0xc9, 0x84,
-	0x5e, 0x6e, 0x11, 0xa2, 0xa2, 0xa8, 0xe1, 0x23, 0x02, 0xe7, 0xfc, 0x4e, 0x8b, 0x76, 0x38, 0x41,
-	0x7d, 0x56, 0x51, 0x5a, 0x49, 0x17, 0x8c, 0x78, 0xeb, 0x1c, 0x6f, 0x95, 0x2e, 0x27, 0x9c, 0xb7,
-	0xe2, 0x8b, 0xe0, 0x69, 0xd5, 0x23, 0x02, 0x63, 0x1e, 0xff, 0x43, 0x17, 0xa2, 0x53, 0x86, 0x3d,
-	0x99, 0xb4, 0x98, 0x22, 0x12, 0xc9, 0x36, 0x39, 0xd9, 0x1a, 0x55, 0xe2, 0xdf, 0xa6, 0x40, 0x17,
-	0x72, 0xeb, 0x45, 0x6d, 0x18, 0x12, 0x7b, 0xa5, 0x57, 0x93, 0x94, 0x70, 0x89, 0x5e, 0x48, 0x0e,
-	0x42, 0x98, 0x19, 0x0e, 0x33, 0x45, 0x2f, 0xc7, 0xc8, 0x94, 0x57, 0x9e, 0x1e, 0x67, 0xc8, 0xb3,
-	0xe3, 0x0c, 0xf9, 0xe3, 0x38, 0x43, 0x3e, 0x3f, 0xc9, 0x0c, 0x3c, 0x3b, 0xc9, 0x0c, 0xfc, 0x76,
-	0x92, 0x19, 0x78, 0x0f, 0x5d, 0xba, 0x55, 0xfa, 0x40, 0x31, 0x98, 0xfa, 0xa1, 0x98, 0x50, 0x1c,
-	0xe2, 0x7f, 0xe4, 0x5e, 0xff, 0x27, 0x00, 0x00, 0xff, 0xff, 0x52, 0x63, 0x6a, 0x95, 0xbf, 0x17,
-	0x00, 0x00,
+	// 1367 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x99, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0x33, 0x25, 0x3f, 0x5f, 0xda, 0x44, 0x9d, 0x24, 0xad, 0xb3, 0x09, 0x4e, 0xd8, 0x42,
+	0x7e, 0x67, 0x37, 0x76, 0x7e, 0x21, 0xa0, 0x42, 0xb5, 0x04, 0x21, 0x87, 0xa2, 0xd4, 0xad, 0x90,
+	0x40, 0x48, 0xd1, 0xa6, 0xde, 0x58, 0x2b, 0xec, 0x5d, 0xd7, 0xbb, 0x89, 0xb0, 0x22, 0x5f, 0x90,
+	0xe0, 0x80, 0x38, 0x40, 0x8b, 0x50, 0x89, 0x38, 0x44, 0x08, 0x89, 0x22, 0x2e, 0x1c, 0x40, 0x48,
+	0xdc, 0x7a, 0xeb, 0xb1, 0x82, 0x0b, 0x27, 0x84, 0x12, 0x24, 0xfe, 0x0d, 0xb4, 0x33, 0x6f, 0xed,
+	0xfd, 0xed, 0x8d, 0xb0, 0xc0, 0x97, 0xd6, 0x3b, 0xf3, 0xde, 0xcc, 0x67, 0xbe, 0xef, 0xed, 0xec,
+	0x7b, 0x0a, 0x4c, 0xdc, 0x35, 0xcc, 0xb2, 0x61, 0xca, 0xc5, 0xaa, 0x71, 0x50, 0x91, 0x0f, 0x33,
+	0xf2, 0xbd, 0x03, 0xb5, 0x5a, 0x93, 0x2a, 0x55, 0xc3, 0x32, 0xe8, 0x30, 0x9f, 0x94, 0xd8, 0xa4,
+	0x74, 0x98, 0x11, 0x46, 0x8b, 0x46, 0xd1, 0x60, 0x73, 0xb2, 0xfd, 0x8b, 0x9b, 0x09, 0x93, 0x45,
+	0xc3, 0x28, 0x96, 0x54, 0x59, 0xa9, 0x68, 0xb2, 0xa2, 0xeb, 0x86, 0xa5, 0x58, 0x9a, 0xa1, 0x9b,
+	0x38, 0x1b, 0xd8, 0xc1, 0xaa, 0x55, 0x54, 0x67, 0x72, 0x01, 0x27, 0xf7, 0x14, 0x53, 0xe5, 0x5b,
+	0xcb, 0x87, 0x99, 0x3d, 0xd5, 0x52, 0x32, 0x72, 0x45, 0x29, 0x6a, 0x3a, 0x5b, 0x09, 0x6d, 0xc7,
+	0xb9, 0xed, 0x2e, 0xdf, 0x9f, 0x3f, 0xe0, 0xd4, 0x65, 0xa5, 0xac, 0xe9, 0x86, 0xcc, 0xfe, 0xe5,
+	0x43, 0x62, 0x16, 0xc6, 0x6e, 0xd9, 0xeb, 0x6d, 0xd9, 0xdb, 0x6e, 0xeb, 0xfb, 0x46, 0x5e, 0xbd,
+	0x77, 0xa0, 0x9a, 0x16, 0x1d, 0x87, 0x7e, 0x86, 0xb2, 0xab, 0x15, 0x52, 0x64, 0x9a, 0xcc, 0x75,
+	0xe7, 0xfb, 0xd8, 0xf3, 0x76, 0x41, 0x7c, 0x03, 0xae, 0xf8, 0x7d, 0xcc, 0x8a, 0xa1, 0x9b, 0x2a,
+	0x95, 0xa0, 0x5b, 0xd3, 0xf7, 0x0d, 0xe6, 0x30, 0x98, 0x15, 0x24, 0x9f, 0x30, 0x52, 0xd3, 0x83,
+	0xd9, 0x89, 0xb7, 0x60, 0xa2, 0xb9, 0xd2, 0x8e, 0x51, 0xd2, 0xee, 0xd6, 0xdc, 0x0c, 0x59, 0xe8,
+	0x53, 0x0a, 0x85, 0xaa, 0x6a, 0x9a, 0x6c, 0xc5, 0x81, 0x5c, 0xea, 0xd7, 0x1f, 0x97, 0x47, 0x71,
+	0xd1, 0x1b, 0x7c, 0xe6, 0xb6, 0x55, 0xd5, 0xf4, 0x62, 0xde, 0x31, 0x14, 0xef, 0xc0, 0x64, 0xf8,
+	0x92, 0x88, 0xb8, 0xe6, 0x41, 0x9c, 0x0e, 0x47, 0x74, 0xf9, 0x71, 0xd0, 0x3a, 0xa4, 0x9a, 0xab,
+	0xde, 0x54, 0xcb, 0x7b, 0x6a, 0xd5, 0x6c, 0xad, 0x14, 0x7d, 0x1d, 0xa0, 0x19, 0x9f, 0xd4, 0x05,
+	0xb6, 0xe5, 0x8c, 0xb3, 0xa5, 0x1d, 0x4c, 0x89, 0xe7, 0x11, 0x06, 0x53, 0xda, 0x51, 0x8a, 0x2a,
+	0x2e, 0x9b, 0x77, 0x79, 0x8a, 0x5f, 0x11, 0x18, 0x0f, 0xd9, 0x1f, 0x8f, 0xb4, 0x01, 0x7d, 0x65,
+	0x3e, 0x94, 0x22, 0xd3, 0xcf, 0xcc, 0x0d, 0x66, 0x27, 0xc3, 0x4f, 0xc5, 0xfd, 0xf2, 0x8e, 0x31,
+	0xdd, 0x0a, 0xa1, 0x9b, 0x6d, 0x49, 0xc7, 0x37, 0xf5, 0xe0, 0x3d, 0xf0, 0xe0, 0x99, 0xb9, 0xda,
+	0x8d, 0x42, 0x59, 0xd3, 0x1d, 0x7d, 0x24, 0xe8, 0x51, 0xec, 0xe7, 0x96, 0x31, 0xe4, 0x66, 0x6d,
+	0x13, 0xed, 0x4b, 0x02, 0x42, 0x18, 0x15, 0xaa, 0x96, 0x85, 0x5e, 0x26, 0x8f, 0x23, 0x5a, 0x5c,
+	0xb6, 0xa2, 0x65, 0xfb, 0x14, 0xfb, 0x90, 0xc0, 0xb4, 0x2f, 0x4d, 0x35, 0xd5, 0xcc, 0xf1, 0xc7,
+	0xff, 0x30, 0xb1, 0x7e, 0x22, 0xf0, 0x5c, 0x0c, 0x07, 0x4a, 0xb5, 0x05, 0x43, 0x1c, 0xa4, 0x82,
+	0x06, 0x28, 0x59, 0xeb, 0xb7, 0xe7, 0x52, 0xd1, 0xbd, 0x6e, 0xfb, 0xf4, 0x3b, 0x8e, 0xd0, 0xaf,
+	0x23, 0x12, 0x2f, 0x4a, 0x54, 0x6f, 0xfe, 0x75, 0x9e, 0xa8, 0x9b, 0x30, 0xca, 0xb0, 0x77, 0xaa,
+	0x46, 0xc5, 0x30, 0x95, 0x92, 0xa3, 0xe3, 0x14, 0x0c, 0x56, 0x70, 0xa8, 0x99, 0x8a, 0xe0, 0x0c,
+	0x6d, 0x17, 0xc4, 0x37, 0x61, 0xcc, 0xe7, 0x88, 0x67, 0x5c, 0x87, 0x7e, 0xc7, 0x0c, 0x2f, 0xdc,
+	0xf1, 0xc0, 0xe9, 0x1a, 0x4e, 0x0d, 0x53, 0xf1, 0x84, 0x80, 0xe8, 0x59, 0xd0, 0xc9, 0x48, 0x2e,
+	0xc2, 0xbf, 0xf8, 0x3c, 0xb4, 0x2d, 0xc6, 0xdf, 0x12, 0xb8, 0x16, 0x8b, 0x88, 0x0a, 0x6c, 0xc2,
+	0x80, 0x73, 0x2c, 0x27, 0xc0, 0x31, 0x12, 0x34, 0x6d, 0xdb, 0x17, 0xd5, 0x2a, 0x4c, 0x31, 0xd0,
+	0xb7, 0x0c, 0x4b, 0xcd, 0x35, 0x70, 0xed, 0xa7, 0x6a, 0xd2, 0x00, 0xdb, 0x6f, 0xd2, 0xa1, 0xed,
+	0x90, 0xba, 0xd0, 0x42, 0x67, 0x6e, 0x26, 0xde, 0xc4, 0xb7, 0x33, 0x74, 0x4f, 0x54, 0x66, 0x1e,
+	0xba, 0x6d, 0x63, 0xcc, 0x8b, 0xb1, 0x80, 0x28, 0xb6, 0x75, 0x9e, 0x99, 0x88, 0x1f, 0x11, 0xac,
+	0x13, 0xec, 0x31, 0x33, 0x77, 0xee, 0x04, 0x6d, 0x5b, 0xd4, 0x3f, 0x27, 0x30, 0x19, 0x0e, 0x82,
+	0x87, 0x5a, 0xe4, 0x42, 0x39, 0xa1, 0x8e, 0x38, 0x15, 0xb7, 0x69, 0x5f, 0x88, 0xef, 0x13, 0x2c,
+	0x4f, 0x10, 0xcb, 0x13, 0xdc, 0x46, 0xec, 0x48, 0xa2, 0xd8, 0xb5, 0x4d, 0xab, 0xcf, 0x9c, 0xa2,
+	0xc0, 0x0b, 0xf5, 0xbf, 0x0a, 0xf5, 0xd0, 0x5f, 0x12, 0x60, 0x49, 0xd4, 0x01, 0x17, 0xca, 0x31,
+	0x81, 0x89, 0x50, 0xb4, 0x4e, 0x28, 0x57, 0x5e, 0x82, 0xab, 0x8c, 0xed, 0x8e, 0x52, 0x2a, 0xd9,
+	0x77, 0xdb, 0x41, 0xc9, 0x4a, 0xfc, 0x71, 0x78, 0x1b, 0x52, 0x41, 0x5f, 0x3c, 0xd4, 0x75, 0xe8,
+	0xb1, 0xec, 0x61, 0xbc, 0x04, 0x82, 0x75, 0xab, 0xcb, 0x29, 0x37, 0xf0, 0xe4, 0x8f, 0xa9, 0xae,
+	0x47, 0x7f, 0xff, 0xb0, 0x40, 0xf2, 0xdc, 0x4b, 0x7c, 0x17, 0xa8, 0x4b, 0x32, 0x87, 0xa8, 0x5d,
+	0x11, 0xb9, 0x4f, 0x60, 0xc4, 0xb3, 0x7c, 0x27, 0x44, 0xe2, 0x84, 0xc0, 0xb3, 0xfe, 0xef, 0xce,
+	0x6d, 0x4b, 0xb1, 0x0e, 0x1a, 0xc7, 0xdf, 0x84, 0x5e, 0x93, 0x0d, 0x30, 0x51, 0x87, 0xb2, 0x53,
+	0x91, 0x9f, 0x1b, 0xf4, 0x43, 0xf3, 0xb6, 0xe9, 0xf6, 0x35, 0x81, 0x74, 0x14, 0x62, 0xa7, 0x7c,
+	0x15, 0xb3, 0xdf, 0x8d, 0x40, 0x0f, 0x83, 0xa4, 0x9f, 0x10, 0x18, 0x68, 0x04, 0x8c, 0xce, 0x04,
+	0x30, 0x42, 0xdb, 0x63, 0x61, 0xb6, 0xa5, 0x1d, 0xdf, 0x54, 0x94, 0x3e, 0xf8, 0xed, 0xaf, 0x07,
+	0x17, 0xe6, 0xe8, 0x8c, 0xec, 0x6f, 0xf0, 0xb1, 0xb6, 0xd7, 0xf7, 0x0d, 0xf9, 0x08, 0x7f, 0x17,
+	0xea, 0xf4, 0x1b, 0x02, 0xc3, 0xbe, 0x82, 0x8f, 0x2e, 0xc5, 0x6c, 0x16, 0xe8, 0x9a, 0x85, 0xe5,
+	0x84, 0xd6, 0x08, 0xb8, 0xc6, 0x00, 0x25, 0xba, 0x14, 0x01, 0xc8, 0xca, 0xd3, 0x1a, 0x72, 0xe2,
+	0xad, 0x57, 0xa7, 0x0f, 0x09, 0x5c, 0x74, 0x37, 0xa3, 0x74, 0x3e, 0x66, 0x57, 0x6f, 0xc3, 0x2c,
+	0x2c, 0x24, 0x31, 0x45, 0xba, 0x0c, 0xa3, 0x5b, 0xa4, 0xf3, 0x11, 0x74, 0xd8, 0xcb, 0xba, 0x15,
+	0x3c, 0x26, 0x70, 0xc9, 0xd3, 0xf2, 0xd1, 0xb8, 0x0d, 0x7d, 0x4d, 0x83, 0xb0, 0x98, 0xc8, 0x16,
+	0xe9, 0x56, 0x18, 0xdd, 0x02, 0x9d, 0x0b, 0xa7, 0x33, 0x77, 0xf7, 0x6a, 0xbb, 0xac, 0xb7, 0xb0,
+	0x95, 0x2b, 0x6b, 0x7a, 0x9d, 0xfe, 0x42, 0x60, 0x34, 0xac, 0xd7, 0xa2, 0x99, 0x56, 0x51, 0x0b,
+	0xf4, 0x87, 0x42, 0xf6, 0x3c, 0x2e, 0x48, 0xfc, 0x32, 0x23, 0x5e, 0xa7, 0xab, 0x71, 0xd1, 0xd6,
+	0x54, 0x46, 0xce, 0xa7, 0x5c, 0xca, 0xfe, 0x1c, 0x84, 0xe7, 0x02, 0x27, 0x83, 0xf7, 0xe8, 0x9c,
+	0x3d, 0x8f, 0x0b, 0xc2, 0xbf, 0xc8, 0xe0, 0xb3, 0x74, 0x25, 0x01, 0xbc, 0x57, 0xf6, 0x8f, 0x09,
+	0xf4, 0x3b, 0xf7, 0x09, 0x7d, 0x21, 0x7c, 0x6b, 0x5f, 0x55, 0x29, 0xcc, 0xb4, 0x32, 0x43, 0x2a,
+	0x99, 0x51, 0xcd, 0xd3, 0xd9, 0x00, 0x95, 0x73, 0x6f, 0xc9, 0x47, 0xae, 0x4f, 0x64, 0x9d, 0x3e,
+	0x26, 0x70, 0x25, 0xbc, 0x6d, 0xa0, 0xab, 0xf1, 0x7b, 0x86, 0xf6, 0x41, 0xc2, 0xda, 0xf9, 0x9c,
+	0x10, 0xfb, 0x15, 0x86, 0xbd, 0x41, 0xd7, 0x22, 0xb1, 0x9b, 0x49, 0x80, 0x97, 0x80, 0xeb, 0xfd,
+	0x7f, 0x4c, 0x60, 0x24, 0xa4, 0xba, 0xa7, 0x2b, 0xe1, 0x2c, 0xd1, 0xcd, 0x87, 0x90, 0x39, 0x87,
+	0x07, 0xa2, 0xbf, 0xc6, 0xd0, 0x5f, 0xa5, 0xd7, 0x03, 0xe8, 0x76, 0xbd, 0x68, 0x53, 0x37, 0xf4,
+	0xb6, 0x07, 0xaa, 0x5e, 0xfd, 0xe5, 0x23, 0x36, 0x58, 0xa7, 0x8f, 0x08, 0x0c, 0xfb, 0x0a, 0xf9,
+	0xa8, 0xab, 0x36, 0xbc, 0xf1, 0x10, 0x96, 0x13, 0x5a, 0xb7, 0xcc, 0x5f, 0x9b, 0xc8, 0x74, 0x83,
+	0xfb, 0x52, 0xe6, 0x0b, 0x02, 0x17, 0xdd, 0x75, 0x74, 0xd4, 0x75, 0x1b, 0xd2, 0x00, 0x44, 0x5d,
+	0xb7, 0x61, 0x65, 0x79, 0x4c, 0x2e, 0x37, 0x08, 0x51, 0x51, 0xd4, 0xf0, 0x84, 0xc0, 0x90, 0xb7,
+	0x62, 0xa5, 0x2d, 0x6e, 0x50, 0x4f, 0xc9, 0x2d, 0x2c, 0x25, 0x33, 0x46, 0xbc, 0x55, 0x86, 0xb7,
+	0x4c, 0x17, 0x63, 0xee, 0x5b, 0xfe, 0x45, 0x70, 0xa5, 0xea, 0x31, 0x81, 0x41, 0x57, 0x1d, 0x49,
+	0xe7, 0xc2, 0xb7, 0x0c, 0xd6, 0xb6, 0xc2, 0x7c, 0x02, 0x4b, 0x24, 0xdb, 0x60, 0x64, 0x2b, 0x54,
+	0x8a, 0x7e, 0x9b, 0x7c, 0x59, 0xc8, 0x4a, 0x58, 0x6a, 0x41, 0x2f, 0x3f, 0x2b, 0xbd, 0x16, 0xa7,
+	0x84, 0x43, 0xf4, 0x7c, 0xbc, 0x11, 0xc2, 0x4c, 0x31, 0x98, 0x71, 0x7a, 0x35, 0x42, 0x26, 0xfa,
+	0x3d, 0x81, 0xcb, 0x81, 0xea, 0x8c, 0x4a, 0x2d, 0xef, 0x11, 0x4f, 0xa5, 0x29, 0xc8, 0x89, 0xed,
+	0x91, 0x6b, 0x9d, 0x71, 0xc9, 0x74, 0x39, 0xfe, 0xca, 0xe1, 0xf5, 0xa8, 0x7c, 0xc4, 0xff, 0xaf,
+	0xe7, 0xa4, 0x27, 0xa7, 0x69, 0xf2, 0xf4, 0x34, 0x4d, 0xfe, 0x3c, 0x4d, 0x93, 0x4f, 0xcf, 0xd2,
+	0x5d, 0x4f, 0xcf, 0xd2, 0x5d, 0xbf, 0x9f, 0xa5, 0xbb, 0xde, 0xc1, 0xde, 0xcc, 0x2c, 0xbc, 0x27,
+	0x69, 0x86, 0xfc, 0x3e, 0x5f, 0x6f, 0xaf, 0x97, 0xfd, 0x69, 0x63, 0xf5, 0x9f, 0x01, 0x00, 0xab,
+	0x26, 0xca, 0x33, 0xb5, 0x19, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1651,6 +1771,10 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.47.1
 	Groups(ctx context.Context, in *QueryGroupsRequest, opts ...grpc.CallOption) (*QueryGroupsResponse, error)
+	// ProposalsByStatus queries proposals based on their status.
+	//
+	// Since: x/group v1.0.0
+	ProposalsByStatus(ctx context.Context, in *QueryProposalsByStatusRequest, opts ...grpc.CallOption) (*QueryProposalsByStatusResponse, error)
 }
 
 type queryClient struct {
@@ -1787,6 +1911,15 @@ func (c *queryClient) Groups(ctx context.Context, in *QueryGroupsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) ProposalsByStatus(ctx context.Context, in *QueryProposalsByStatusRequest, opts ...grpc.CallOption) (*QueryProposalsByStatusResponse, error) {
+	out := new(QueryProposalsByStatusResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Query/ProposalsByStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// GroupInfo queries group info based on group id.
@@ -1823,6 +1956,10 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.47.1
 	Groups(context.Context, *QueryGroupsRequest) (*QueryGroupsResponse, error)
+	// ProposalsByStatus queries proposals based on their status.
+	//
+	// Since: x/group v1.0.0
+	ProposalsByStatus(context.Context, *QueryProposalsByStatusRequest) (*QueryProposalsByStatusResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1871,6 +2008,9 @@ func (*UnimplementedQueryServer) TallyResult(ctx context.Context, req *QueryTall
 func (*UnimplementedQueryServer) Groups(ctx context.Context, req *QueryGroupsRequest) (*QueryGroupsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Groups not implemented")
 }
+func (*UnimplementedQueryServer) ProposalsByStatus(ctx context.Context, req *QueryProposalsByStatusRequest) (*QueryProposalsByStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalsByStatus not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2128,6 +2268,24 @@ func _Query_Groups_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ProposalsByStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalsByStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalsByStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Query/ProposalsByStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalsByStatus(ctx, req.(*QueryProposalsByStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.group.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2188,6 +2346,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Groups",
 			Handler:    _Query_Groups_Handler,
 		},
+		{
+			MethodName: "ProposalsByStatus",
+			Handler:    _Query_ProposalsByStatus_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/group/v1/query.proto",
@@ -3321,6 +3483,95 @@ func (m *QueryGroupsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryProposalsByStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalsByStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalsByStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalsByStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalsByStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalsByStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Proposals) > 0 {
+		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -3777,6 +4028,41 @@ func (m *QueryGroupsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryProposalsByStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProposalsByStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -6671,6 +6957,231 @@ func (m *QueryGroupsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryProposalsByStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalsByStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalsByStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ProposalStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalsByStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalsByStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalsByStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposals = append(m.Proposals, &Proposal{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -184,6 +184,21 @@ func (mr *MockBankKeeperMockRecorder) MultiSend(arg0, arg1 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MultiSend", reflect.TypeOf((*MockBankKeeper)(nil).MultiSend), arg0, arg1)
 }
 
+// RemoveDenomMetadata mocks base method.
+func (m *MockBankKeeper) RemoveDenomMetadata(arg0 context.Context, arg1 *types.MsgRemoveDenomMetadata) (*types.MsgRemoveDenomMetadataResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveDenomMetadata", arg0, arg1)
+	ret0, _ := ret[0].(*types.MsgRemoveDenomMetadataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveDenomMetadata indicates an expected call of RemoveDenomMetadata.
+func (mr *MockBankKeeperMockRecorder) RemoveDenomMetadata(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveDenomMetadata", reflect.TypeOf((*MockBankKeeper)(nil).RemoveDenomMetadata), arg0, arg1)
+}
+
 // Send mocks base method.
 func (m *MockBankKeeper) Send(arg0 context.Context, arg1 *types.MsgSend) (*types.MsgSendResponse, error) {
 	m.ctrl.T.Helper()
@@ -213,6 +228,21 @@ func (mr *MockBankKeeperMockRecorder) SendCoinsFromModuleToAccount(ctx, senderMo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromModuleToAccount", reflect.TypeOf((*MockBankKeeper)(nil).SendCoinsFromModuleToAccount), ctx, senderModule, recipientAddr, amt)
 }
 
+// SetDenomMetadata mocks base method.
+func (m *MockBankKeeper) SetDenomMetadata(arg0 context.Context, arg1 *types.MsgSetDenomMetadata) (*types.MsgSetDenomMetadataResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDenomMetadata", arg0, arg1)
+	ret0, _ := ret[0].(*types.MsgSetDenomMetadataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetDenomMetadata indicates an expected call of SetDenomMetadata.
+func (mr *MockBankKeeperMockRecorder) SetDenomMetadata(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDenomMetadata", reflect.TypeOf((*MockBankKeeper)(nil).SetDenomMetadata), arg0, arg1)
+}
+
 // SetSendEnabled mocks base method.
 func (m *MockBankKeeper) SetSendEnabled(arg0 context.Context, arg1 *types.MsgSetSendEnabled) (*types.MsgSetSendEnabledResponse, error) {
 	m.ctrl.T.Helper()
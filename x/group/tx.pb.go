@@ -488,6 +488,21 @@ type MsgCreateGroupPolicy struct {
 	Metadata string `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	// decision_policy specifies the group policy's decision policy.
 	DecisionPolicy *any.Any `protobuf:"bytes,4,opt,name=decision_policy,json=decisionPolicy,proto3" json:"decision_policy,omitempty"`
+	// membership_change_behavior defines how the group policy's proposals still
+	// in their voting period are affected by a change of the group's members.
+	//
+	// Since: x/group v1.0.0
+	MembershipChangeBehavior MembershipChangeBehavior `protobuf:"varint,5,opt,name=membership_change_behavior,json=membershipChangeBehavior,proto3,enum=cosmos.group.v1.MembershipChangeBehavior" json:"membership_change_behavior,omitempty"`
+	// veto_authority is an optional account address which can veto the group
+	// policy's accepted proposals before they are executed.
+	//
+	// Since: x/group v1.0.0
+	VetoAuthority string `protobuf:"bytes,6,opt,name=veto_authority,json=vetoAuthority,proto3" json:"veto_authority,omitempty"`
+	// spend_limit optionally caps the coins the group policy's proposals can
+	// send from the group policy account.
+	//
+	// Since: x/group v1.0.0
+	SpendLimit *SpendLimit `protobuf:"bytes,7,opt,name=spend_limit,json=spendLimit,proto3" json:"spend_limit,omitempty"`
 }
 
 func (m *MsgCreateGroupPolicy) Reset()         { *m = MsgCreateGroupPolicy{} }
@@ -685,6 +700,21 @@ type MsgCreateGroupWithPolicy struct {
 	GroupPolicyAsAdmin bool `protobuf:"varint,5,opt,name=group_policy_as_admin,json=groupPolicyAsAdmin,proto3" json:"group_policy_as_admin,omitempty"`
 	// decision_policy specifies the group policy's decision policy.
 	DecisionPolicy *any.Any `protobuf:"bytes,6,opt,name=decision_policy,json=decisionPolicy,proto3" json:"decision_policy,omitempty"`
+	// membership_change_behavior defines how the group policy's proposals still
+	// in their voting period are affected by a change of the group's members.
+	//
+	// Since: x/group v1.0.0
+	MembershipChangeBehavior MembershipChangeBehavior `protobuf:"varint,7,opt,name=membership_change_behavior,json=membershipChangeBehavior,proto3,enum=cosmos.group.v1.MembershipChangeBehavior" json:"membership_change_behavior,omitempty"`
+	// veto_authority is an optional account address which can veto the group
+	// policy's accepted proposals before they are executed.
+	//
+	// Since: x/group v1.0.0
+	VetoAuthority string `protobuf:"bytes,8,opt,name=veto_authority,json=vetoAuthority,proto3" json:"veto_authority,omitempty"`
+	// spend_limit optionally caps the coins the group policy's proposals can
+	// send from the group policy account.
+	//
+	// Since: x/group v1.0.0
+	SpendLimit *SpendLimit `protobuf:"bytes,9,opt,name=spend_limit,json=spendLimit,proto3" json:"spend_limit,omitempty"`
 }
 
 func (m *MsgCreateGroupWithPolicy) Reset()         { *m = MsgCreateGroupWithPolicy{} }
@@ -960,6 +990,224 @@ func (m *MsgUpdateGroupPolicyMetadataResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateGroupPolicyMetadataResponse proto.InternalMessageInfo
 
+// MsgUpdateGroupPolicyVetoAuthority is the Msg/UpdateGroupPolicyVetoAuthority request type.
+//
+// Since: x/group v1.0.0
+type MsgUpdateGroupPolicyVetoAuthority struct {
+	// admin is the account address of the group admin.
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	// group_policy_address is the account address of group policy.
+	GroupPolicyAddress string `protobuf:"bytes,2,opt,name=group_policy_address,json=groupPolicyAddress,proto3" json:"group_policy_address,omitempty"`
+	// veto_authority is the new veto authority of the group policy.
+	// An empty value removes the veto authority.
+	VetoAuthority string `protobuf:"bytes,3,opt,name=veto_authority,json=vetoAuthority,proto3" json:"veto_authority,omitempty"`
+}
+
+func (m *MsgUpdateGroupPolicyVetoAuthority) Reset()         { *m = MsgUpdateGroupPolicyVetoAuthority{} }
+func (m *MsgUpdateGroupPolicyVetoAuthority) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateGroupPolicyVetoAuthority) ProtoMessage()    {}
+func (*MsgUpdateGroupPolicyVetoAuthority) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{18}
+}
+func (m *MsgUpdateGroupPolicyVetoAuthority) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateGroupPolicyVetoAuthority) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateGroupPolicyVetoAuthority.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateGroupPolicyVetoAuthority) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateGroupPolicyVetoAuthority.Merge(m, src)
+}
+func (m *MsgUpdateGroupPolicyVetoAuthority) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateGroupPolicyVetoAuthority) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateGroupPolicyVetoAuthority.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateGroupPolicyVetoAuthority proto.InternalMessageInfo
+
+func (m *MsgUpdateGroupPolicyVetoAuthority) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func (m *MsgUpdateGroupPolicyVetoAuthority) GetGroupPolicyAddress() string {
+	if m != nil {
+		return m.GroupPolicyAddress
+	}
+	return ""
+}
+
+func (m *MsgUpdateGroupPolicyVetoAuthority) GetVetoAuthority() string {
+	if m != nil {
+		return m.VetoAuthority
+	}
+	return ""
+}
+
+// MsgUpdateGroupPolicyVetoAuthorityResponse is the Msg/UpdateGroupPolicyVetoAuthority response type.
+//
+// Since: x/group v1.0.0
+type MsgUpdateGroupPolicyVetoAuthorityResponse struct {
+}
+
+func (m *MsgUpdateGroupPolicyVetoAuthorityResponse) Reset() {
+	*m = MsgUpdateGroupPolicyVetoAuthorityResponse{}
+}
+func (m *MsgUpdateGroupPolicyVetoAuthorityResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgUpdateGroupPolicyVetoAuthorityResponse) ProtoMessage() {}
+func (*MsgUpdateGroupPolicyVetoAuthorityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{19}
+}
+func (m *MsgUpdateGroupPolicyVetoAuthorityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateGroupPolicyVetoAuthorityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateGroupPolicyVetoAuthorityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateGroupPolicyVetoAuthorityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateGroupPolicyVetoAuthorityResponse.Merge(m, src)
+}
+func (m *MsgUpdateGroupPolicyVetoAuthorityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateGroupPolicyVetoAuthorityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateGroupPolicyVetoAuthorityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateGroupPolicyVetoAuthorityResponse proto.InternalMessageInfo
+
+// MsgUpdateGroupPolicySpendLimit is the Msg/UpdateGroupPolicySpendLimit request type.
+//
+// Since: x/group v1.0.0
+type MsgUpdateGroupPolicySpendLimit struct {
+	// admin is the account address of the group admin.
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	// group_policy_address is the account address of group policy.
+	GroupPolicyAddress string `protobuf:"bytes,2,opt,name=group_policy_address,json=groupPolicyAddress,proto3" json:"group_policy_address,omitempty"`
+	// spend_limit is the new spend limit of the group policy.
+	// An empty value removes the spend limit.
+	SpendLimit *SpendLimit `protobuf:"bytes,3,opt,name=spend_limit,json=spendLimit,proto3" json:"spend_limit,omitempty"`
+}
+
+func (m *MsgUpdateGroupPolicySpendLimit) Reset()         { *m = MsgUpdateGroupPolicySpendLimit{} }
+func (m *MsgUpdateGroupPolicySpendLimit) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateGroupPolicySpendLimit) ProtoMessage()    {}
+func (*MsgUpdateGroupPolicySpendLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{20}
+}
+func (m *MsgUpdateGroupPolicySpendLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateGroupPolicySpendLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateGroupPolicySpendLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateGroupPolicySpendLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateGroupPolicySpendLimit.Merge(m, src)
+}
+func (m *MsgUpdateGroupPolicySpendLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateGroupPolicySpendLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateGroupPolicySpendLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateGroupPolicySpendLimit proto.InternalMessageInfo
+
+func (m *MsgUpdateGroupPolicySpendLimit) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func (m *MsgUpdateGroupPolicySpendLimit) GetGroupPolicyAddress() string {
+	if m != nil {
+		return m.GroupPolicyAddress
+	}
+	return ""
+}
+
+func (m *MsgUpdateGroupPolicySpendLimit) GetSpendLimit() *SpendLimit {
+	if m != nil {
+		return m.SpendLimit
+	}
+	return nil
+}
+
+// MsgUpdateGroupPolicySpendLimitResponse is the Msg/UpdateGroupPolicySpendLimit response type.
+//
+// Since: x/group v1.0.0
+type MsgUpdateGroupPolicySpendLimitResponse struct {
+}
+
+func (m *MsgUpdateGroupPolicySpendLimitResponse) Reset() {
+	*m = MsgUpdateGroupPolicySpendLimitResponse{}
+}
+func (m *MsgUpdateGroupPolicySpendLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateGroupPolicySpendLimitResponse) ProtoMessage()    {}
+func (*MsgUpdateGroupPolicySpendLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{21}
+}
+func (m *MsgUpdateGroupPolicySpendLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateGroupPolicySpendLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateGroupPolicySpendLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateGroupPolicySpendLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateGroupPolicySpendLimitResponse.Merge(m, src)
+}
+func (m *MsgUpdateGroupPolicySpendLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateGroupPolicySpendLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateGroupPolicySpendLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateGroupPolicySpendLimitResponse proto.InternalMessageInfo
+
 // MsgSubmitProposal is the Msg/SubmitProposal request type.
 type MsgSubmitProposal struct {
 	// group_policy_address is the account address of group policy.
@@ -989,7 +1237,7 @@ func (m *MsgSubmitProposal) Reset()         { *m = MsgSubmitProposal{} }
 func (m *MsgSubmitProposal) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitProposal) ProtoMessage()    {}
 func (*MsgSubmitProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{18}
+	return fileDescriptor_6b8d3d629f136420, []int{22}
 }
 func (m *MsgSubmitProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1028,7 +1276,7 @@ func (m *MsgSubmitProposalResponse) Reset()         { *m = MsgSubmitProposalResp
 func (m *MsgSubmitProposalResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitProposalResponse) ProtoMessage()    {}
 func (*MsgSubmitProposalResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{19}
+	return fileDescriptor_6b8d3d629f136420, []int{23}
 }
 func (m *MsgSubmitProposalResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1076,7 +1324,7 @@ func (m *MsgWithdrawProposal) Reset()         { *m = MsgWithdrawProposal{} }
 func (m *MsgWithdrawProposal) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawProposal) ProtoMessage()    {}
 func (*MsgWithdrawProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{20}
+	return fileDescriptor_6b8d3d629f136420, []int{24}
 }
 func (m *MsgWithdrawProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1127,7 +1375,7 @@ func (m *MsgWithdrawProposalResponse) Reset()         { *m = MsgWithdrawProposal
 func (m *MsgWithdrawProposalResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawProposalResponse) ProtoMessage()    {}
 func (*MsgWithdrawProposalResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{21}
+	return fileDescriptor_6b8d3d629f136420, []int{25}
 }
 func (m *MsgWithdrawProposalResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1175,7 +1423,7 @@ func (m *MsgVote) Reset()         { *m = MsgVote{} }
 func (m *MsgVote) String() string { return proto.CompactTextString(m) }
 func (*MsgVote) ProtoMessage()    {}
 func (*MsgVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{22}
+	return fileDescriptor_6b8d3d629f136420, []int{26}
 }
 func (m *MsgVote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1247,7 +1495,7 @@ func (m *MsgVoteResponse) Reset()         { *m = MsgVoteResponse{} }
 func (m *MsgVoteResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgVoteResponse) ProtoMessage()    {}
 func (*MsgVoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{23}
+	return fileDescriptor_6b8d3d629f136420, []int{27}
 }
 func (m *MsgVoteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1276,26 +1524,34 @@ func (m *MsgVoteResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgVoteResponse proto.InternalMessageInfo
 
-// MsgExec is the Msg/Exec request type.
-type MsgExec struct {
-	// proposal is the unique ID of the proposal.
-	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
-	// executor is the account address used to execute the proposal.
-	Executor string `protobuf:"bytes,2,opt,name=executor,proto3" json:"executor,omitempty"`
+// MsgVoteBatch is the Msg/VoteBatch request type.
+//
+// Since: x/group v1.0.0
+type MsgVoteBatch struct {
+	// voter is the voter account address.
+	Voter string `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty"`
+	// group_policy_address is the account address of the group policy all
+	// the voted proposals belong to.
+	GroupPolicyAddress string `protobuf:"bytes,2,opt,name=group_policy_address,json=groupPolicyAddress,proto3" json:"group_policy_address,omitempty"`
+	// votes are the voter's choices on each proposal.
+	Votes []BatchVote `protobuf:"bytes,3,rep,name=votes,proto3" json:"votes"`
+	// exec defines whether the proposals should be executed
+	// immediately after voting or not.
+	Exec Exec `protobuf:"varint,4,opt,name=exec,proto3,enum=cosmos.group.v1.Exec" json:"exec,omitempty"`
 }
 
-func (m *MsgExec) Reset()         { *m = MsgExec{} }
-func (m *MsgExec) String() string { return proto.CompactTextString(m) }
-func (*MsgExec) ProtoMessage()    {}
-func (*MsgExec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{24}
+func (m *MsgVoteBatch) Reset()         { *m = MsgVoteBatch{} }
+func (m *MsgVoteBatch) String() string { return proto.CompactTextString(m) }
+func (*MsgVoteBatch) ProtoMessage()    {}
+func (*MsgVoteBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{28}
 }
-func (m *MsgExec) XXX_Unmarshal(b []byte) error {
+func (m *MsgVoteBatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgExec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgVoteBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgExec.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgVoteBatch.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1305,50 +1561,70 @@ func (m *MsgExec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *MsgExec) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgExec.Merge(m, src)
+func (m *MsgVoteBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoteBatch.Merge(m, src)
 }
-func (m *MsgExec) XXX_Size() int {
+func (m *MsgVoteBatch) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgExec) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgExec.DiscardUnknown(m)
+func (m *MsgVoteBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoteBatch.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgExec proto.InternalMessageInfo
+var xxx_messageInfo_MsgVoteBatch proto.InternalMessageInfo
 
-func (m *MsgExec) GetProposalId() uint64 {
+func (m *MsgVoteBatch) GetVoter() string {
 	if m != nil {
-		return m.ProposalId
+		return m.Voter
 	}
-	return 0
+	return ""
 }
 
-func (m *MsgExec) GetExecutor() string {
+func (m *MsgVoteBatch) GetGroupPolicyAddress() string {
 	if m != nil {
-		return m.Executor
+		return m.GroupPolicyAddress
 	}
 	return ""
 }
 
-// MsgExecResponse is the Msg/Exec request type.
-type MsgExecResponse struct {
-	// result is the final result of the proposal execution.
-	Result ProposalExecutorResult `protobuf:"varint,2,opt,name=result,proto3,enum=cosmos.group.v1.ProposalExecutorResult" json:"result,omitempty"`
+func (m *MsgVoteBatch) GetVotes() []BatchVote {
+	if m != nil {
+		return m.Votes
+	}
+	return nil
 }
 
-func (m *MsgExecResponse) Reset()         { *m = MsgExecResponse{} }
-func (m *MsgExecResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgExecResponse) ProtoMessage()    {}
-func (*MsgExecResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{25}
+func (m *MsgVoteBatch) GetExec() Exec {
+	if m != nil {
+		return m.Exec
+	}
+	return Exec_EXEC_UNSPECIFIED
 }
-func (m *MsgExecResponse) XXX_Unmarshal(b []byte) error {
+
+// BatchVote is a single vote of a MsgVoteBatch.
+//
+// Since: x/group v1.0.0
+type BatchVote struct {
+	// proposal is the unique ID of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// option is the voter's choice on the proposal.
+	Option VoteOption `protobuf:"varint,2,opt,name=option,proto3,enum=cosmos.group.v1.VoteOption" json:"option,omitempty"`
+	// metadata is any arbitrary metadata attached to the vote.
+	Metadata string `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (m *BatchVote) Reset()         { *m = BatchVote{} }
+func (m *BatchVote) String() string { return proto.CompactTextString(m) }
+func (*BatchVote) ProtoMessage()    {}
+func (*BatchVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{29}
+}
+func (m *BatchVote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgExecResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BatchVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgExecResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BatchVote.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1358,45 +1634,57 @@ func (m *MsgExecResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *MsgExecResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgExecResponse.Merge(m, src)
+func (m *BatchVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchVote.Merge(m, src)
 }
-func (m *MsgExecResponse) XXX_Size() int {
+func (m *BatchVote) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgExecResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgExecResponse.DiscardUnknown(m)
+func (m *BatchVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchVote.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgExecResponse proto.InternalMessageInfo
+var xxx_messageInfo_BatchVote proto.InternalMessageInfo
 
-func (m *MsgExecResponse) GetResult() ProposalExecutorResult {
+func (m *BatchVote) GetProposalId() uint64 {
 	if m != nil {
-		return m.Result
+		return m.ProposalId
 	}
-	return PROPOSAL_EXECUTOR_RESULT_UNSPECIFIED
+	return 0
 }
 
-// MsgLeaveGroup is the Msg/LeaveGroup request type.
-type MsgLeaveGroup struct {
-	// address is the account address of the group member.
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	// group_id is the unique ID of the group.
-	GroupId uint64 `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+func (m *BatchVote) GetOption() VoteOption {
+	if m != nil {
+		return m.Option
+	}
+	return VOTE_OPTION_UNSPECIFIED
 }
 
-func (m *MsgLeaveGroup) Reset()         { *m = MsgLeaveGroup{} }
-func (m *MsgLeaveGroup) String() string { return proto.CompactTextString(m) }
-func (*MsgLeaveGroup) ProtoMessage()    {}
-func (*MsgLeaveGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{26}
+func (m *BatchVote) GetMetadata() string {
+	if m != nil {
+		return m.Metadata
+	}
+	return ""
 }
-func (m *MsgLeaveGroup) XXX_Unmarshal(b []byte) error {
+
+// MsgVoteBatchResponse is the Msg/VoteBatch response type.
+//
+// Since: x/group v1.0.0
+type MsgVoteBatchResponse struct {
+}
+
+func (m *MsgVoteBatchResponse) Reset()         { *m = MsgVoteBatchResponse{} }
+func (m *MsgVoteBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgVoteBatchResponse) ProtoMessage()    {}
+func (*MsgVoteBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{30}
+}
+func (m *MsgVoteBatchResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgLeaveGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgVoteBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgLeaveGroup.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgVoteBatchResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1406,7 +1694,245 @@ func (m *MsgLeaveGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *MsgLeaveGroup) XXX_Merge(src proto.Message) {
+func (m *MsgVoteBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoteBatchResponse.Merge(m, src)
+}
+func (m *MsgVoteBatchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVoteBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoteBatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVoteBatchResponse proto.InternalMessageInfo
+
+// MsgExec is the Msg/Exec request type.
+type MsgExec struct {
+	// proposal is the unique ID of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// executor is the account address used to execute the proposal.
+	Executor string `protobuf:"bytes,2,opt,name=executor,proto3" json:"executor,omitempty"`
+}
+
+func (m *MsgExec) Reset()         { *m = MsgExec{} }
+func (m *MsgExec) String() string { return proto.CompactTextString(m) }
+func (*MsgExec) ProtoMessage()    {}
+func (*MsgExec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{31}
+}
+func (m *MsgExec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExec.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgExec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExec.Merge(m, src)
+}
+func (m *MsgExec) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExec) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgExec proto.InternalMessageInfo
+
+func (m *MsgExec) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *MsgExec) GetExecutor() string {
+	if m != nil {
+		return m.Executor
+	}
+	return ""
+}
+
+// MsgExecResponse is the Msg/Exec request type.
+type MsgExecResponse struct {
+	// result is the final result of the proposal execution.
+	Result ProposalExecutorResult `protobuf:"varint,2,opt,name=result,proto3,enum=cosmos.group.v1.ProposalExecutorResult" json:"result,omitempty"`
+}
+
+func (m *MsgExecResponse) Reset()         { *m = MsgExecResponse{} }
+func (m *MsgExecResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgExecResponse) ProtoMessage()    {}
+func (*MsgExecResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{32}
+}
+func (m *MsgExecResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExecResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExecResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgExecResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExecResponse.Merge(m, src)
+}
+func (m *MsgExecResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExecResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExecResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgExecResponse proto.InternalMessageInfo
+
+func (m *MsgExecResponse) GetResult() ProposalExecutorResult {
+	if m != nil {
+		return m.Result
+	}
+	return PROPOSAL_EXECUTOR_RESULT_UNSPECIFIED
+}
+
+// MsgVetoProposal is the Msg/VetoProposal request type.
+//
+// Since: x/group v1.0.0
+type MsgVetoProposal struct {
+	// proposal is the unique ID of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// veto_authority is the veto authority of the proposal's group policy.
+	VetoAuthority string `protobuf:"bytes,2,opt,name=veto_authority,json=vetoAuthority,proto3" json:"veto_authority,omitempty"`
+}
+
+func (m *MsgVetoProposal) Reset()         { *m = MsgVetoProposal{} }
+func (m *MsgVetoProposal) String() string { return proto.CompactTextString(m) }
+func (*MsgVetoProposal) ProtoMessage()    {}
+func (*MsgVetoProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{33}
+}
+func (m *MsgVetoProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVetoProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVetoProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVetoProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVetoProposal.Merge(m, src)
+}
+func (m *MsgVetoProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVetoProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVetoProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVetoProposal proto.InternalMessageInfo
+
+func (m *MsgVetoProposal) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *MsgVetoProposal) GetVetoAuthority() string {
+	if m != nil {
+		return m.VetoAuthority
+	}
+	return ""
+}
+
+// MsgVetoProposalResponse is the Msg/VetoProposal response type.
+//
+// Since: x/group v1.0.0
+type MsgVetoProposalResponse struct {
+}
+
+func (m *MsgVetoProposalResponse) Reset()         { *m = MsgVetoProposalResponse{} }
+func (m *MsgVetoProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgVetoProposalResponse) ProtoMessage()    {}
+func (*MsgVetoProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{34}
+}
+func (m *MsgVetoProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVetoProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVetoProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVetoProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVetoProposalResponse.Merge(m, src)
+}
+func (m *MsgVetoProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVetoProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVetoProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVetoProposalResponse proto.InternalMessageInfo
+
+// MsgLeaveGroup is the Msg/LeaveGroup request type.
+type MsgLeaveGroup struct {
+	// address is the account address of the group member.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// group_id is the unique ID of the group.
+	GroupId uint64 `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+}
+
+func (m *MsgLeaveGroup) Reset()         { *m = MsgLeaveGroup{} }
+func (m *MsgLeaveGroup) String() string { return proto.CompactTextString(m) }
+func (*MsgLeaveGroup) ProtoMessage()    {}
+func (*MsgLeaveGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{35}
+}
+func (m *MsgLeaveGroup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLeaveGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLeaveGroup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgLeaveGroup) XXX_Merge(src proto.Message) {
 	xxx_messageInfo_MsgLeaveGroup.Merge(m, src)
 }
 func (m *MsgLeaveGroup) XXX_Size() int {
@@ -1440,7 +1966,7 @@ func (m *MsgLeaveGroupResponse) Reset()         { *m = MsgLeaveGroupResponse{} }
 func (m *MsgLeaveGroupResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgLeaveGroupResponse) ProtoMessage()    {}
 func (*MsgLeaveGroupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{27}
+	return fileDescriptor_6b8d3d629f136420, []int{36}
 }
 func (m *MsgLeaveGroupResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1489,14 +2015,23 @@ func init() {
 	proto.RegisterType((*MsgUpdateGroupPolicyDecisionPolicyResponse)(nil), "cosmos.group.v1.MsgUpdateGroupPolicyDecisionPolicyResponse")
 	proto.RegisterType((*MsgUpdateGroupPolicyMetadata)(nil), "cosmos.group.v1.MsgUpdateGroupPolicyMetadata")
 	proto.RegisterType((*MsgUpdateGroupPolicyMetadataResponse)(nil), "cosmos.group.v1.MsgUpdateGroupPolicyMetadataResponse")
+	proto.RegisterType((*MsgUpdateGroupPolicyVetoAuthority)(nil), "cosmos.group.v1.MsgUpdateGroupPolicyVetoAuthority")
+	proto.RegisterType((*MsgUpdateGroupPolicyVetoAuthorityResponse)(nil), "cosmos.group.v1.MsgUpdateGroupPolicyVetoAuthorityResponse")
+	proto.RegisterType((*MsgUpdateGroupPolicySpendLimit)(nil), "cosmos.group.v1.MsgUpdateGroupPolicySpendLimit")
+	proto.RegisterType((*MsgUpdateGroupPolicySpendLimitResponse)(nil), "cosmos.group.v1.MsgUpdateGroupPolicySpendLimitResponse")
 	proto.RegisterType((*MsgSubmitProposal)(nil), "cosmos.group.v1.MsgSubmitProposal")
 	proto.RegisterType((*MsgSubmitProposalResponse)(nil), "cosmos.group.v1.MsgSubmitProposalResponse")
 	proto.RegisterType((*MsgWithdrawProposal)(nil), "cosmos.group.v1.MsgWithdrawProposal")
 	proto.RegisterType((*MsgWithdrawProposalResponse)(nil), "cosmos.group.v1.MsgWithdrawProposalResponse")
 	proto.RegisterType((*MsgVote)(nil), "cosmos.group.v1.MsgVote")
 	proto.RegisterType((*MsgVoteResponse)(nil), "cosmos.group.v1.MsgVoteResponse")
+	proto.RegisterType((*MsgVoteBatch)(nil), "cosmos.group.v1.MsgVoteBatch")
+	proto.RegisterType((*BatchVote)(nil), "cosmos.group.v1.BatchVote")
+	proto.RegisterType((*MsgVoteBatchResponse)(nil), "cosmos.group.v1.MsgVoteBatchResponse")
 	proto.RegisterType((*MsgExec)(nil), "cosmos.group.v1.MsgExec")
 	proto.RegisterType((*MsgExecResponse)(nil), "cosmos.group.v1.MsgExecResponse")
+	proto.RegisterType((*MsgVetoProposal)(nil), "cosmos.group.v1.MsgVetoProposal")
+	proto.RegisterType((*MsgVetoProposalResponse)(nil), "cosmos.group.v1.MsgVetoProposalResponse")
 	proto.RegisterType((*MsgLeaveGroup)(nil), "cosmos.group.v1.MsgLeaveGroup")
 	proto.RegisterType((*MsgLeaveGroupResponse)(nil), "cosmos.group.v1.MsgLeaveGroupResponse")
 }
@@ -1504,97 +2039,119 @@ func init() {
 func init() { proto.RegisterFile("cosmos/group/v1/tx.proto", fileDescriptor_6b8d3d629f136420) }
 
 var fileDescriptor_6b8d3d629f136420 = []byte{
-	// 1432 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0xda, 0xce, 0xd7, 0x0b, 0x75, 0x93, 0x6d, 0xd2, 0x6e, 0xb6, 0xad, 0xed, 0x4e, 0x3f,
-	0x92, 0x5a, 0x8d, 0xdd, 0x38, 0xb4, 0x12, 0x06, 0x09, 0x35, 0xa9, 0x41, 0x41, 0x18, 0xa2, 0x6d,
-	0x4b, 0x81, 0x8b, 0xd9, 0x64, 0xb7, 0xcb, 0xaa, 0xb1, 0xd7, 0x78, 0xd6, 0x69, 0x72, 0xe3, 0xe3,
-	0x02, 0x08, 0x09, 0x24, 0xf8, 0x03, 0xe0, 0xc6, 0xb1, 0x48, 0x3d, 0x70, 0xe3, 0x86, 0xaa, 0x72,
-	0xa9, 0x38, 0x71, 0x42, 0xd0, 0x0a, 0xf5, 0xc6, 0xbf, 0x00, 0xda, 0x99, 0xdd, 0xf1, 0x8e, 0x77,
-	0xd7, 0xbb, 0xb1, 0x2c, 0xb8, 0x44, 0xde, 0x79, 0xbf, 0xf7, 0xf5, 0x7b, 0x6f, 0xde, 0xcc, 0x04,
-	0xa4, 0x1d, 0x0b, 0x37, 0x2d, 0x5c, 0x36, 0x3a, 0x56, 0xb7, 0x5d, 0xde, 0x5b, 0x2d, 0xdb, 0xfb,
-	0xa5, 0x76, 0xc7, 0xb2, 0x2d, 0xf1, 0x28, 0x95, 0x94, 0x88, 0xa4, 0xb4, 0xb7, 0x2a, 0xcf, 0x1b,
-	0x96, 0x61, 0x11, 0x59, 0xd9, 0xf9, 0x45, 0x61, 0xf2, 0x22, 0x85, 0x35, 0xa8, 0xc0, 0xd5, 0x71,
-	0x45, 0x86, 0x65, 0x19, 0xbb, 0x7a, 0x99, 0x7c, 0x6d, 0x77, 0xef, 0x94, 0xd5, 0xd6, 0x81, 0x2b,
-	0x3a, 0x19, 0x70, 0x7b, 0xd0, 0xd6, 0x3d, 0xbd, 0x13, 0xae, 0xb0, 0x89, 0x0d, 0x47, 0xd4, 0xc4,
-	0x86, 0x2b, 0x98, 0x53, 0x9b, 0x66, 0xcb, 0x2a, 0x93, 0xbf, 0x74, 0x09, 0xfd, 0x22, 0x40, 0xb6,
-	0x8e, 0x8d, 0x8d, 0x8e, 0xae, 0xda, 0xfa, 0xab, 0x8e, 0x35, 0xb1, 0x04, 0xe3, 0xaa, 0xd6, 0x34,
-	0x5b, 0x92, 0x50, 0x10, 0x96, 0xa7, 0xd7, 0xa5, 0x5f, 0x1f, 0xac, 0xcc, 0xbb, 0x71, 0x5d, 0xd3,
-	0xb4, 0x8e, 0x8e, 0xf1, 0x0d, 0xbb, 0x63, 0xb6, 0x0c, 0x85, 0xc2, 0xc4, 0x0d, 0x98, 0x6c, 0xea,
-	0xcd, 0x6d, 0xbd, 0x83, 0xa5, 0x54, 0x21, 0xbd, 0x3c, 0x53, 0xc9, 0x95, 0xfa, 0x52, 0x2f, 0xd5,
-	0x89, 0x5c, 0xd1, 0x3f, 0xe8, 0xea, 0xd8, 0x5e, 0x9f, 0x7e, 0xf8, 0x7b, 0x7e, 0xec, 0xfb, 0x67,
-	0xf7, 0x8b, 0x82, 0xe2, 0x69, 0x8a, 0x32, 0x4c, 0x35, 0x75, 0x5b, 0xd5, 0x54, 0x5b, 0x95, 0xd2,
-	0x8e, 0x5f, 0x85, 0x7d, 0x57, 0x97, 0x3f, 0x7e, 0x76, 0xbf, 0x48, 0x9d, 0x7d, 0xfe, 0xec, 0x7e,
-	0xd1, 0x65, 0x6c, 0x05, 0x6b, 0x77, 0xcb, 0x7c, 0xe8, 0x68, 0x0d, 0x8e, 0xf3, 0x2b, 0x8a, 0x8e,
-	0xdb, 0x56, 0x0b, 0xeb, 0xe2, 0x22, 0x4c, 0x91, 0x68, 0x1a, 0xa6, 0x46, 0xf2, 0xca, 0x28, 0x93,
-	0xe4, 0x7b, 0x53, 0x43, 0x7f, 0x09, 0xb0, 0x50, 0xc7, 0xc6, 0xad, 0xb6, 0xe6, 0x69, 0xd5, 0xdd,
-	0xa0, 0x0e, 0xcb, 0x84, 0xdf, 0x49, 0x8a, 0x73, 0x22, 0x6e, 0x41, 0x96, 0xa6, 0xda, 0xe8, 0x12,
-	0x3f, 0x58, 0x4a, 0x1f, 0x96, 0xab, 0x23, 0xd4, 0x00, 0x8d, 0x13, 0x57, 0xcb, 0x3c, 0x2b, 0x05,
-	0x9e, 0x95, 0x60, 0x36, 0x28, 0x0f, 0xa7, 0x43, 0x05, 0x1e, 0x47, 0xe8, 0x67, 0x01, 0x8e, 0xf1,
-	0x88, 0x6b, 0x24, 0xad, 0x11, 0xd2, 0x70, 0x05, 0xa6, 0x5b, 0xfa, 0xbd, 0x06, 0x35, 0x97, 0x8e,
-	0x31, 0x37, 0xd5, 0xd2, 0xef, 0x91, 0x08, 0xaa, 0x2b, 0x7c, 0xae, 0xb9, 0xc8, 0x5c, 0x09, 0x1c,
-	0x9d, 0x86, 0x93, 0x21, 0xcb, 0x2c, 0xcf, 0x1f, 0x04, 0xd2, 0x26, 0x1c, 0x13, 0xb4, 0xd5, 0x46,
-	0x99, 0xea, 0xa0, 0x8e, 0xbe, 0xcc, 0xe7, 0x73, 0x66, 0x40, 0xed, 0xa8, 0x06, 0x2a, 0x40, 0x2e,
-	0x5c, 0xc2, 0xb2, 0xfa, 0x26, 0x05, 0xf3, 0x7c, 0xf3, 0x6f, 0x59, 0xbb, 0xe6, 0xce, 0xc1, 0x7f,
-	0x94, 0x93, 0xa8, 0xc2, 0x51, 0x4d, 0xdf, 0x31, 0xb1, 0x69, 0xb5, 0x1a, 0x6d, 0xe2, 0x59, 0xca,
-	0x14, 0x84, 0xe5, 0x99, 0xca, 0x7c, 0x89, 0xce, 0xb1, 0x92, 0x37, 0xc7, 0x4a, 0xd7, 0x5a, 0x07,
-	0xeb, 0xe8, 0xd1, 0x83, 0x95, 0x5c, 0x7f, 0xef, 0x5f, 0x77, 0x0d, 0xd0, 0xc8, 0x95, 0xac, 0xc6,
-	0x7d, 0x57, 0x2b, 0x9f, 0x7e, 0x9b, 0x1f, 0xe3, 0xa9, 0xcb, 0x47, 0x0e, 0x03, 0xaa, 0x83, 0x14,
-	0x38, 0x15, 0xb6, 0xce, 0x06, 0x43, 0x05, 0x26, 0x55, 0xca, 0x42, 0x2c, 0x3f, 0x1e, 0x10, 0x7d,
-	0x92, 0x82, 0x45, 0xbe, 0x1a, 0xd4, 0xe8, 0x70, 0xdb, 0xe5, 0x35, 0x98, 0xa7, 0x7c, 0x53, 0xd6,
-	0x1a, 0x5e, 0x38, 0xa9, 0x18, 0x75, 0xd1, 0xf0, 0x7b, 0x26, 0x92, 0x61, 0xf7, 0xd7, 0x1a, 0x4f,
-	0xea, 0xb9, 0xc8, 0x7e, 0xf4, 0xe5, 0x89, 0xce, 0xc2, 0x99, 0x48, 0x21, 0xeb, 0xca, 0x1f, 0xd3,
-	0x20, 0xf1, 0xfc, 0xdf, 0x36, 0xed, 0xf7, 0x87, 0xec, 0xcc, 0x91, 0x9c, 0x34, 0xe7, 0x21, 0x4b,
-	0xe9, 0xee, 0xeb, 0xe4, 0x23, 0x06, 0x37, 0x09, 0x2a, 0xb0, 0xc0, 0x55, 0x85, 0xa1, 0x33, 0x04,
-	0x7d, 0xcc, 0x47, 0x3e, 0xd3, 0x59, 0xed, 0xd3, 0x51, 0xb1, 0x5b, 0x89, 0xf1, 0x82, 0xb0, 0x3c,
-	0xc5, 0x17, 0x0c, 0xd3, 0x66, 0x09, 0xd9, 0x35, 0x13, 0x23, 0xde, 0x35, 0x57, 0x83, 0xbb, 0xe6,
-	0x6c, 0xe4, 0xae, 0xe9, 0x55, 0x07, 0x7d, 0x26, 0x40, 0x21, 0x4a, 0x98, 0xe0, 0x5c, 0x1d, 0x65,
-	0x5f, 0xa3, 0x9f, 0x52, 0x80, 0xc2, 0x9a, 0x8d, 0x4f, 0xfd, 0x7f, 0xdd, 0x7a, 0x21, 0x95, 0x4c,
-	0x8f, 0xb8, 0x92, 0xd5, 0x60, 0x25, 0x97, 0x22, 0xb7, 0x2a, 0x6f, 0x0b, 0x5d, 0x82, 0x62, 0x3c,
-	0x81, 0x6c, 0xdb, 0xfe, 0x2d, 0x90, 0xb1, 0x19, 0x80, 0x0f, 0x7d, 0x50, 0x8e, 0x92, 0xe9, 0x41,
-	0x27, 0xeb, 0xd5, 0xa4, 0xf4, 0xf0, 0xf9, 0xa0, 0x0b, 0x70, 0x6e, 0x90, 0x9c, 0x11, 0xf3, 0x67,
-	0x0a, 0xe6, 0xea, 0xd8, 0xb8, 0xd1, 0xdd, 0x6e, 0x9a, 0xf6, 0x56, 0xc7, 0x6a, 0x5b, 0x58, 0xdd,
-	0x8d, 0xcc, 0x4e, 0x18, 0x22, 0xbb, 0x53, 0x30, 0xdd, 0x26, 0x76, 0xbd, 0x31, 0x37, 0xad, 0xf4,
-	0x16, 0x06, 0x9e, 0xc0, 0x97, 0x1d, 0x19, 0xc6, 0xaa, 0xa1, 0x63, 0x29, 0x43, 0xe6, 0x63, 0x68,
-	0xeb, 0x29, 0x0c, 0x25, 0x5e, 0x84, 0x8c, 0xbe, 0xaf, 0xef, 0x90, 0xf9, 0x94, 0xad, 0x2c, 0x04,
-	0xa6, 0x69, 0x6d, 0x5f, 0xdf, 0x51, 0x08, 0x44, 0x9c, 0x87, 0x71, 0xdb, 0xb4, 0x77, 0x75, 0x32,
-	0x9e, 0xa6, 0x15, 0xfa, 0x21, 0x4a, 0x30, 0x89, 0xbb, 0xcd, 0xa6, 0xda, 0x39, 0x90, 0x26, 0xc9,
-	0xba, 0xf7, 0x59, 0x7d, 0xc1, 0xeb, 0xd5, 0x5e, 0xf0, 0x4e, 0x41, 0x90, 0xaf, 0x20, 0xf4, 0xf1,
-	0x12, 0x60, 0x13, 0xbd, 0x44, 0x4e, 0x57, 0x7e, 0x91, 0x0d, 0x9c, 0x3c, 0xcc, 0xb4, 0xdd, 0xb5,
-	0xde, 0xcc, 0x01, 0x6f, 0x69, 0x53, 0x43, 0xdf, 0xd1, 0x5b, 0xac, 0x33, 0xab, 0xb4, 0x8e, 0x7a,
-	0x8f, 0xd5, 0x28, 0x4e, 0xd1, 0x7f, 0x13, 0x48, 0x25, 0xbc, 0x09, 0x54, 0xaf, 0x38, 0x19, 0x7a,
-	0x5f, 0xfd, 0x47, 0x27, 0xcb, 0xaf, 0x3f, 0x16, 0xf7, 0x82, 0xda, 0xbf, 0xcc, 0x9a, 0xec, 0x1f,
-	0x01, 0x26, 0xeb, 0xd8, 0x78, 0xcb, 0xb2, 0xe3, 0xf3, 0x75, 0x76, 0xe2, 0x9e, 0x65, 0xeb, 0x9d,
-	0xd8, 0xa0, 0x29, 0x4c, 0x5c, 0x83, 0x09, 0xab, 0x6d, 0x9b, 0x16, 0xbd, 0x1f, 0x64, 0x2b, 0x27,
-	0x03, 0x55, 0x77, 0xfc, 0xbe, 0x49, 0x20, 0x8a, 0x0b, 0xe5, 0xda, 0x2e, 0xd3, 0xd7, 0x76, 0xc9,
-	0x9b, 0xa8, 0xba, 0x44, 0x76, 0x27, 0x89, 0xc3, 0x21, 0x4b, 0x0a, 0x23, 0xcb, 0xf1, 0x8e, 0xe6,
-	0xe0, 0xa8, 0xfb, 0x93, 0x91, 0xf2, 0x05, 0x25, 0xc5, 0xb1, 0x16, 0x4f, 0xca, 0xf3, 0x30, 0xe5,
-	0x38, 0xec, 0xda, 0x56, 0x3c, 0x2f, 0x0c, 0x59, 0x2d, 0x3a, 0xe1, 0xb1, 0xcf, 0xc8, 0x08, 0x9d,
-	0x10, 0x90, 0x42, 0x22, 0x24, 0xb9, 0x79, 0xad, 0xf9, 0x32, 0x4c, 0x74, 0x74, 0xdc, 0xdd, 0xb5,
-	0x89, 0xcb, 0x6c, 0x65, 0x29, 0x40, 0x85, 0x57, 0xe9, 0x9a, 0xeb, 0x42, 0x21, 0x70, 0xc5, 0x55,
-	0x43, 0x5f, 0x0a, 0x70, 0xa4, 0x8e, 0x8d, 0xd7, 0x75, 0x75, 0xcf, 0x7d, 0x8b, 0x0f, 0x71, 0x3b,
-	0x1d, 0x70, 0x7f, 0xa7, 0x6f, 0x46, 0x7f, 0xbb, 0xe6, 0xc2, 0xf2, 0xeb, 0xf9, 0x47, 0x27, 0xc8,
-	0xd3, 0xb8, 0xb7, 0xe0, 0xe5, 0x5a, 0x2c, 0x42, 0xa6, 0x46, 0xc7, 0xc2, 0x6c, 0xed, 0xed, 0xda,
-	0x46, 0xe3, 0xd6, 0x1b, 0x37, 0xb6, 0x6a, 0x1b, 0x9b, 0xaf, 0x6c, 0xd6, 0xae, 0xcf, 0x8e, 0x89,
-	0xcf, 0xc1, 0x14, 0x59, 0xbd, 0xa9, 0xbc, 0x33, 0x2b, 0x54, 0x1e, 0xcd, 0x40, 0xba, 0x8e, 0x0d,
-	0xf1, 0x36, 0xcc, 0xf8, 0xff, 0xcf, 0x90, 0x0f, 0x5e, 0xde, 0xb8, 0xdb, 0x86, 0xbc, 0x14, 0x03,
-	0x60, 0xc4, 0xef, 0x82, 0x18, 0xf2, 0x7a, 0xbf, 0x10, 0xa6, 0x1e, 0xc4, 0xc9, 0xa5, 0x64, 0x38,
-	0xe6, 0xed, 0x0e, 0xcc, 0x06, 0x9e, 0xc8, 0xe7, 0x62, 0x6c, 0x10, 0x94, 0x7c, 0x29, 0x09, 0x8a,
-	0xf9, 0xb1, 0xe0, 0x58, 0xd8, 0x13, 0x75, 0x29, 0x36, 0x5c, 0x0a, 0x94, 0xcb, 0x09, 0x81, 0xcc,
-	0xa1, 0x09, 0x73, 0xc1, 0xd7, 0xe3, 0xf9, 0x98, 0x22, 0x50, 0x98, 0xbc, 0x92, 0x08, 0xc6, 0x5c,
-	0x75, 0x61, 0x21, 0xfc, 0x49, 0x70, 0x31, 0xc6, 0x4e, 0x0f, 0x2a, 0xaf, 0x26, 0x86, 0x32, 0xb7,
-	0xfb, 0x70, 0x3c, 0xe2, 0xd1, 0x56, 0x8c, 0x21, 0xcb, 0x87, 0x95, 0x2b, 0xc9, 0xb1, 0xcc, 0xf3,
-	0xd7, 0x02, 0xe4, 0xe3, 0x6e, 0xaf, 0x6b, 0x89, 0xec, 0xf2, 0x4a, 0xf2, 0x8b, 0x43, 0x28, 0xb1,
-	0xa8, 0x3e, 0x12, 0x60, 0x31, 0xfa, 0x8e, 0xb7, 0x92, 0xc8, 0x34, 0xeb, 0xb7, 0x2b, 0x87, 0x82,
-	0xb3, 0x18, 0xde, 0x83, 0x6c, 0xdf, 0x6d, 0x0a, 0x85, 0x19, 0xe2, 0x31, 0x72, 0x31, 0x1e, 0xe3,
-	0xdf, 0xb0, 0x81, 0xdb, 0x40, 0xe8, 0x86, 0xed, 0x47, 0x85, 0x6f, 0xd8, 0xa8, 0x63, 0x5b, 0x5c,
-	0x87, 0x0c, 0x39, 0xb2, 0xa5, 0x30, 0x2d, 0x47, 0x22, 0x17, 0xa2, 0x24, 0x7e, 0x1b, 0x64, 0xae,
-	0x86, 0xda, 0x70, 0x24, 0xe1, 0x36, 0xb8, 0x73, 0xe8, 0x26, 0x80, 0xef, 0x08, 0xc9, 0x85, 0xe1,
-	0x7b, 0x72, 0xf9, 0xc2, 0x60, 0xb9, 0x67, 0x55, 0x1e, 0xff, 0xd0, 0x79, 0x47, 0xaf, 0x97, 0x1e,
-	0x3e, 0xc9, 0x09, 0x8f, 0x9f, 0xe4, 0x84, 0x3f, 0x9e, 0xe4, 0x84, 0xaf, 0x9e, 0xe6, 0xc6, 0x1e,
-	0x3f, 0xcd, 0x8d, 0xfd, 0xf6, 0x34, 0x37, 0xf6, 0xae, 0x7b, 0x2c, 0x61, 0xed, 0x6e, 0xc9, 0xb4,
-	0xca, 0xfb, 0xf4, 0x3c, 0xd9, 0x9e, 0x20, 0x57, 0xcf, 0xb5, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff,
-	0xaa, 0x49, 0x9b, 0xdc, 0x29, 0x17, 0x00, 0x00,
+	// 1780 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xdb, 0x6f, 0xdb, 0x54,
+	0x18, 0xaf, 0x93, 0xf4, 0x92, 0xaf, 0x6b, 0xda, 0x7a, 0x6d, 0xe7, 0xba, 0x6b, 0x9a, 0x79, 0x5b,
+	0x2f, 0xa1, 0x4d, 0xd6, 0x94, 0x0d, 0xc8, 0x26, 0x4d, 0x6d, 0x57, 0x50, 0xd1, 0x02, 0xc5, 0xdd,
+	0x05, 0xf6, 0x12, 0xdc, 0xc6, 0x73, 0xad, 0x35, 0x71, 0x88, 0x9d, 0xae, 0x7d, 0x40, 0xe2, 0xf2,
+	0xc0, 0x45, 0x08, 0x26, 0x21, 0xc1, 0xeb, 0x78, 0xe3, 0x05, 0x69, 0x48, 0x7b, 0xe7, 0x0d, 0x4d,
+	0xf0, 0x32, 0xf1, 0xc4, 0x13, 0x82, 0x4d, 0x68, 0x6f, 0xfc, 0x05, 0x48, 0x20, 0x9f, 0x63, 0x9f,
+	0xf8, 0xc4, 0x76, 0xec, 0x84, 0xb2, 0xbd, 0x44, 0xf1, 0xf9, 0x7e, 0xdf, 0xed, 0x77, 0xbe, 0xf3,
+	0xf9, 0xf3, 0x01, 0x6e, 0x5b, 0xd3, 0xcb, 0x9a, 0x9e, 0x55, 0x6a, 0x5a, 0xbd, 0x9a, 0xdd, 0x5b,
+	0xcc, 0x1a, 0xfb, 0x99, 0x6a, 0x4d, 0x33, 0x34, 0x76, 0x10, 0x4b, 0x32, 0x48, 0x92, 0xd9, 0x5b,
+	0xe4, 0x47, 0x14, 0x4d, 0xd1, 0x90, 0x2c, 0x6b, 0xfe, 0xc3, 0x30, 0x7e, 0x1c, 0xc3, 0x8a, 0x58,
+	0x60, 0xe9, 0x58, 0x22, 0x45, 0xd3, 0x94, 0x5d, 0x39, 0x8b, 0x9e, 0xb6, 0xea, 0x37, 0xb3, 0x52,
+	0xe5, 0xc0, 0x12, 0x4d, 0xb8, 0xdc, 0x1e, 0x54, 0x65, 0x5b, 0xef, 0x98, 0x25, 0x2c, 0xeb, 0x8a,
+	0x29, 0x2a, 0xeb, 0x8a, 0x25, 0x18, 0x96, 0xca, 0x6a, 0x45, 0xcb, 0xa2, 0x5f, 0xbc, 0x24, 0xfc,
+	0xcc, 0x40, 0xa2, 0xa0, 0x2b, 0xab, 0x35, 0x59, 0x32, 0xe4, 0x57, 0x4c, 0x6b, 0x6c, 0x06, 0xba,
+	0xa5, 0x52, 0x59, 0xad, 0x70, 0x4c, 0x8a, 0x99, 0x8d, 0xaf, 0x70, 0xbf, 0xdc, 0x5f, 0x18, 0xb1,
+	0xe2, 0x5a, 0x2e, 0x95, 0x6a, 0xb2, 0xae, 0x6f, 0x1a, 0x35, 0xb5, 0xa2, 0x88, 0x18, 0xc6, 0xae,
+	0x42, 0x6f, 0x59, 0x2e, 0x6f, 0xc9, 0x35, 0x9d, 0x8b, 0xa4, 0xa2, 0xb3, 0xfd, 0xb9, 0x64, 0xa6,
+	0x29, 0xf5, 0x4c, 0x01, 0xc9, 0x45, 0xf9, 0x9d, 0xba, 0xac, 0x1b, 0x2b, 0xf1, 0x07, 0xbf, 0x4d,
+	0x75, 0x7d, 0xfb, 0xe4, 0x5e, 0x9a, 0x11, 0x6d, 0x4d, 0x96, 0x87, 0xbe, 0xb2, 0x6c, 0x48, 0x25,
+	0xc9, 0x90, 0xb8, 0xa8, 0xe9, 0x57, 0x24, 0xcf, 0xf9, 0xd9, 0x0f, 0x9e, 0xdc, 0x4b, 0x63, 0x67,
+	0x9f, 0x3e, 0xb9, 0x97, 0xb6, 0x18, 0x5b, 0xd0, 0x4b, 0xb7, 0xb2, 0x74, 0xe8, 0xc2, 0x12, 0x8c,
+	0xd1, 0x2b, 0xa2, 0xac, 0x57, 0xb5, 0x8a, 0x2e, 0xb3, 0xe3, 0xd0, 0x87, 0xa2, 0x29, 0xaa, 0x25,
+	0x94, 0x57, 0x4c, 0xec, 0x45, 0xcf, 0xeb, 0x25, 0xe1, 0x4f, 0x06, 0x46, 0x0b, 0xba, 0x72, 0xb5,
+	0x5a, 0xb2, 0xb5, 0x0a, 0x56, 0x50, 0xed, 0x32, 0xe1, 0x74, 0x12, 0xa1, 0x9c, 0xb0, 0x1b, 0x90,
+	0xc0, 0xa9, 0x16, 0xeb, 0xc8, 0x8f, 0xce, 0x45, 0xdb, 0xe5, 0x6a, 0x00, 0x1b, 0xc0, 0x71, 0xea,
+	0xf9, 0x2c, 0xcd, 0x4a, 0x8a, 0x66, 0xc5, 0x9d, 0x8d, 0x30, 0x05, 0x93, 0x9e, 0x02, 0x9b, 0x23,
+	0xe1, 0x47, 0x06, 0x8e, 0xd2, 0x88, 0x65, 0x94, 0xd6, 0x21, 0xd2, 0x70, 0x16, 0xe2, 0x15, 0xf9,
+	0x76, 0x11, 0x9b, 0x8b, 0x06, 0x98, 0xeb, 0xab, 0xc8, 0xb7, 0x51, 0x04, 0xf9, 0x05, 0x3a, 0xd7,
+	0xa4, 0x6f, 0xae, 0x08, 0x2e, 0x4c, 0xc2, 0x84, 0xc7, 0x32, 0xc9, 0xf3, 0x7b, 0x06, 0xc6, 0x68,
+	0x79, 0xc1, 0x2a, 0xb5, 0xc3, 0x4c, 0xb5, 0x55, 0x45, 0x9f, 0xa1, 0xf3, 0x39, 0xd1, 0x62, 0xef,
+	0xb0, 0x86, 0x90, 0x82, 0xa4, 0xb7, 0x84, 0x64, 0xf5, 0x77, 0x14, 0x46, 0xe8, 0xe2, 0xdf, 0xd0,
+	0x76, 0xd5, 0xed, 0x83, 0xa7, 0x94, 0x13, 0x2b, 0xc1, 0x60, 0x49, 0xde, 0x56, 0x75, 0x55, 0xab,
+	0x14, 0xab, 0xc8, 0x33, 0x17, 0x4b, 0x31, 0xb3, 0xfd, 0xb9, 0x91, 0x0c, 0xee, 0x63, 0x19, 0xbb,
+	0x8f, 0x65, 0x96, 0x2b, 0x07, 0x2b, 0xc2, 0x4f, 0xf7, 0x17, 0x92, 0xcd, 0xb5, 0x7f, 0xc9, 0x32,
+	0x80, 0x23, 0x17, 0x13, 0x25, 0xea, 0x99, 0x55, 0x80, 0xb7, 0xfa, 0xc5, 0x8e, 0x5a, 0x2d, 0x6e,
+	0xef, 0x48, 0x15, 0x45, 0x2e, 0x6e, 0xc9, 0x3b, 0xd2, 0x9e, 0xaa, 0xd5, 0xb8, 0xee, 0x14, 0x33,
+	0x9b, 0xc8, 0xcd, 0xf9, 0x1c, 0x28, 0x53, 0x65, 0x15, 0x69, 0xac, 0x58, 0x0a, 0x22, 0x57, 0xf6,
+	0x91, 0xb0, 0x17, 0x21, 0xb1, 0x27, 0x1b, 0x5a, 0x51, 0xaa, 0x1b, 0x3b, 0x5a, 0x4d, 0x35, 0x0e,
+	0xb8, 0x9e, 0x00, 0xee, 0x06, 0x4c, 0xfc, 0xb2, 0x0d, 0x67, 0x2f, 0x40, 0xbf, 0x5e, 0x95, 0x2b,
+	0xa5, 0xe2, 0xae, 0x5a, 0x56, 0x0d, 0xae, 0x17, 0x11, 0x31, 0xe1, 0x0a, 0x6d, 0xd3, 0xc4, 0x5c,
+	0x36, 0x21, 0x22, 0xe8, 0xe4, 0x7f, 0x3e, 0xf7, 0xf1, 0xdd, 0xa9, 0x2e, 0xba, 0x44, 0xa6, 0x7c,
+	0x9b, 0x1e, 0xe6, 0x46, 0x10, 0xe1, 0xb8, 0xd7, 0x3a, 0x69, 0x80, 0x39, 0xe8, 0x95, 0x70, 0xc4,
+	0x81, 0x75, 0x60, 0x03, 0x85, 0x0f, 0x23, 0x30, 0x4e, 0x57, 0x1d, 0x36, 0xda, 0x59, 0x5b, 0x78,
+	0x15, 0x46, 0x70, 0x5d, 0xe1, 0xea, 0x28, 0xda, 0xe1, 0x44, 0x02, 0xd4, 0x59, 0xc5, 0xe9, 0x19,
+	0x49, 0x3a, 0xed, 0x23, 0x4b, 0x34, 0xa9, 0xa7, 0x7c, 0xcf, 0x9d, 0x23, 0x4f, 0xe1, 0x24, 0x9c,
+	0xf0, 0x15, 0x92, 0xd3, 0x77, 0xb7, 0x1b, 0x38, 0x9a, 0xff, 0xeb, 0xaa, 0xb1, 0xd3, 0xe1, 0x09,
+	0x3c, 0x94, 0x37, 0xea, 0x69, 0x48, 0x60, 0xba, 0x9b, 0x4e, 0xec, 0x80, 0x42, 0x75, 0xbc, 0x1c,
+	0x8c, 0x52, 0xbb, 0x42, 0xd0, 0x31, 0x84, 0x3e, 0xea, 0x20, 0x9f, 0xe8, 0x2c, 0x36, 0xe9, 0x48,
+	0xba, 0xb5, 0x13, 0xe6, 0x11, 0xec, 0xa3, 0x37, 0x4c, 0xc7, 0xc5, 0xe2, 0xd1, 0x1d, 0x7a, 0x9e,
+	0x6a, 0x77, 0xe8, 0xfd, 0x3f, 0xbb, 0x43, 0xdf, 0x7f, 0xea, 0x0e, 0xf1, 0xf6, 0xba, 0xc3, 0x39,
+	0x77, 0x77, 0x38, 0xe9, 0xdb, 0x1d, 0x1a, 0x55, 0x28, 0x7c, 0xc2, 0x40, 0xca, 0x4f, 0x18, 0x62,
+	0x4e, 0x3a, 0xcc, 0xf3, 0x2b, 0xfc, 0x10, 0x01, 0xc1, 0xeb, 0x50, 0xd1, 0x5b, 0xfc, 0x4c, 0x5b,
+	0x8c, 0x47, 0xc5, 0x46, 0x0f, 0xb7, 0x62, 0xf3, 0x79, 0xf7, 0x4e, 0xce, 0xf8, 0xb6, 0x24, 0xda,
+	0x96, 0x30, 0x0f, 0xe9, 0x60, 0x02, 0x49, 0x7b, 0xfa, 0x8b, 0x81, 0xe3, 0x5e, 0xf0, 0x8e, 0x07,
+	0x9f, 0xc3, 0x64, 0xba, 0xd5, 0xa4, 0x74, 0x2e, 0x2c, 0x3d, 0x74, 0x3e, 0xc2, 0x34, 0x9c, 0x6a,
+	0x25, 0x27, 0xc4, 0x7c, 0x1d, 0xf1, 0xee, 0xee, 0xd7, 0xa8, 0x03, 0xfb, 0x2c, 0xd9, 0x71, 0x77,
+	0x9b, 0x68, 0x5b, 0xdd, 0x26, 0x7f, 0x96, 0xa6, 0x70, 0xda, 0x97, 0x42, 0x2a, 0x67, 0xe1, 0x39,
+	0x98, 0x0b, 0x24, 0x86, 0xd0, 0xf8, 0x79, 0x04, 0x92, 0x5e, 0xe8, 0x46, 0x0b, 0x7b, 0xa6, 0x1c,
+	0x36, 0x35, 0xdc, 0x68, 0x9b, 0xe3, 0x58, 0xcb, 0x66, 0xeb, 0xc8, 0xb9, 0xa1, 0x2f, 0xcc, 0xc2,
+	0x74, 0x6b, 0x3e, 0x08, 0x75, 0x7f, 0x44, 0x60, 0xb8, 0xa0, 0x2b, 0x9b, 0xf5, 0xad, 0xb2, 0x6a,
+	0x6c, 0xd4, 0xb4, 0xaa, 0xa6, 0x4b, 0xbb, 0xbe, 0xd9, 0x33, 0x1d, 0x64, 0x7f, 0x1c, 0xe2, 0x55,
+	0x64, 0xd7, 0x1e, 0x28, 0xe2, 0x62, 0x63, 0xa1, 0xe5, 0x4c, 0x7f, 0xc6, 0x94, 0xe9, 0xba, 0xa4,
+	0xc8, 0x3a, 0x17, 0x4b, 0x45, 0xfd, 0x9a, 0x9f, 0x48, 0x50, 0xec, 0x1c, 0xc4, 0xe4, 0x7d, 0x79,
+	0xdb, 0x1a, 0xc6, 0x47, 0x5d, 0x14, 0xaf, 0xed, 0xcb, 0xdb, 0x22, 0x82, 0xb0, 0x23, 0xd0, 0x6d,
+	0xa8, 0xc6, 0xae, 0x8c, 0x67, 0x6b, 0x11, 0x3f, 0xb0, 0x1c, 0xf4, 0xea, 0xf5, 0x72, 0x59, 0xaa,
+	0x1d, 0xa0, 0x57, 0x76, 0x5c, 0xb4, 0x1f, 0xf3, 0x2f, 0xd9, 0xdd, 0xb2, 0x11, 0xbc, 0xb9, 0x1d,
+	0x82, 0x63, 0x3b, 0xf0, 0x75, 0x88, 0x8b, 0x4d, 0xe1, 0x02, 0x8c, 0xbb, 0x16, 0xc9, 0x2b, 0x6f,
+	0x0a, 0xfa, 0xab, 0xd6, 0x5a, 0xe3, 0xad, 0x07, 0xf6, 0xd2, 0x7a, 0x49, 0xf8, 0x06, 0x7f, 0x17,
+	0x9b, 0x6f, 0xcb, 0x52, 0x4d, 0xba, 0x4d, 0xf6, 0x28, 0x48, 0xd1, 0x39, 0x73, 0x47, 0x42, 0xce,
+	0xdc, 0xf8, 0xb4, 0xda, 0x4f, 0xcd, 0x43, 0x2a, 0xc9, 0xaf, 0x39, 0x16, 0xeb, 0x93, 0xb7, 0x79,
+	0x99, 0x14, 0xd9, 0x3f, 0x0c, 0xf4, 0x16, 0x74, 0xe5, 0x9a, 0x66, 0x04, 0xe7, 0x6b, 0x9e, 0xd4,
+	0x3d, 0xcd, 0x90, 0x6b, 0x81, 0x41, 0x63, 0x18, 0xbb, 0x04, 0x3d, 0x5a, 0xd5, 0x50, 0x35, 0x3c,
+	0x89, 0x27, 0x3c, 0x0e, 0x96, 0xe9, 0xf7, 0x75, 0x04, 0x11, 0x2d, 0x28, 0x55, 0x76, 0xb1, 0xa6,
+	0xb2, 0x0b, 0x5f, 0x44, 0xf9, 0x19, 0x74, 0x36, 0x51, 0x1c, 0x26, 0x59, 0x9c, 0x17, 0x59, 0xa6,
+	0x77, 0x61, 0x18, 0x06, 0xad, 0xbf, 0x8d, 0x99, 0x3d, 0x02, 0x47, 0xac, 0xb5, 0x15, 0xc9, 0xd8,
+	0xde, 0x69, 0x24, 0xce, 0x84, 0x4b, 0xfc, 0x30, 0x5b, 0xd4, 0x79, 0xec, 0xdb, 0xbe, 0x17, 0xe2,
+	0x5d, 0x49, 0xa3, 0x10, 0x51, 0xac, 0x8e, 0x69, 0x1f, 0xeb, 0x10, 0xc2, 0x62, 0xc1, 0x84, 0xcd,
+	0xd3, 0x84, 0x4d, 0xfa, 0x11, 0x86, 0xdc, 0x09, 0xef, 0x42, 0x9c, 0xf8, 0x0d, 0x2e, 0x9c, 0x46,
+	0x21, 0x44, 0x3a, 0x2b, 0x84, 0xa6, 0xfe, 0x23, 0x8c, 0xa1, 0x2b, 0x0d, 0x12, 0x0e, 0xd9, 0xb9,
+	0xcf, 0x70, 0x39, 0x9b, 0x69, 0x05, 0x47, 0xf5, 0x3c, 0xf4, 0x99, 0x99, 0xd7, 0x0d, 0x2d, 0xb8,
+	0xa2, 0x09, 0x32, 0x9f, 0x36, 0x79, 0x22, 0x8f, 0xbe, 0xb5, 0x65, 0x86, 0x20, 0x88, 0x30, 0x68,
+	0xfd, 0x25, 0x4d, 0xe5, 0x22, 0xf4, 0xd4, 0x64, 0xbd, 0xbe, 0x6b, 0x58, 0x54, 0xcc, 0xb8, 0xa8,
+	0xb0, 0xcf, 0xe8, 0x9a, 0xe5, 0x42, 0x44, 0x70, 0xd1, 0x52, 0x13, 0xbe, 0x63, 0x70, 0xc1, 0xca,
+	0x86, 0x16, 0xbe, 0xe1, 0xb8, 0x67, 0x85, 0x48, 0x7b, 0xb3, 0xc2, 0x8b, 0x66, 0xd6, 0x4d, 0x36,
+	0x9a, 0x6f, 0x17, 0x1b, 0x65, 0xe2, 0x88, 0x4d, 0x18, 0x87, 0x63, 0x4d, 0x4b, 0x64, 0xb7, 0xbe,
+	0x60, 0x60, 0xa0, 0xa0, 0x2b, 0x97, 0x65, 0x69, 0xcf, 0xba, 0x62, 0xee, 0xe0, 0x32, 0xa2, 0xc5,
+	0xb5, 0x14, 0xbe, 0x0a, 0x75, 0xf6, 0xcc, 0xa4, 0x57, 0xb8, 0x0d, 0xff, 0xc2, 0x31, 0x18, 0xa5,
+	0x16, 0xec, 0x50, 0xd3, 0x69, 0x88, 0xad, 0xe1, 0x77, 0xd3, 0xd0, 0xda, 0x9b, 0x6b, 0xab, 0xc5,
+	0xab, 0xaf, 0x6d, 0x6e, 0xac, 0xad, 0xae, 0xbf, 0xbc, 0xbe, 0x76, 0x69, 0xa8, 0x8b, 0x3d, 0x02,
+	0x7d, 0x68, 0xf5, 0x8a, 0xf8, 0xd6, 0x10, 0x93, 0xfb, 0x6a, 0x10, 0xa2, 0x05, 0x5d, 0x61, 0xaf,
+	0x43, 0xbf, 0xf3, 0xfa, 0x7c, 0xca, 0xfd, 0x89, 0x49, 0x7d, 0x74, 0xf1, 0x33, 0x01, 0x00, 0x52,
+	0x43, 0xbb, 0xc0, 0x7a, 0x5c, 0x4a, 0x4f, 0x7b, 0xa9, 0xbb, 0x71, 0x7c, 0x26, 0x1c, 0x8e, 0x78,
+	0xbb, 0x09, 0x43, 0xae, 0x9b, 0xdf, 0x53, 0x01, 0x36, 0x10, 0x8a, 0x9f, 0x0f, 0x83, 0x22, 0x7e,
+	0x34, 0x38, 0xea, 0x75, 0xf3, 0x3a, 0x13, 0x18, 0x2e, 0x06, 0xf2, 0xd9, 0x90, 0x40, 0xe2, 0x50,
+	0x85, 0x61, 0xf7, 0xa5, 0xe8, 0xe9, 0x80, 0x4d, 0xc0, 0x30, 0x7e, 0x21, 0x14, 0x8c, 0xb8, 0xaa,
+	0xc3, 0xa8, 0xf7, 0x0d, 0xd0, 0x5c, 0x80, 0x9d, 0x06, 0x94, 0x5f, 0x0c, 0x0d, 0x25, 0x6e, 0xf7,
+	0x61, 0xcc, 0xe7, 0x8e, 0x2e, 0x1d, 0x40, 0x96, 0x03, 0xcb, 0xe7, 0xc2, 0x63, 0x89, 0xe7, 0x2f,
+	0x19, 0x98, 0x0a, 0xfa, 0x88, 0x5f, 0x0a, 0x65, 0x97, 0x56, 0xe2, 0xcf, 0x77, 0xa0, 0x44, 0xa2,
+	0x7a, 0x9f, 0x81, 0x71, 0xff, 0x4f, 0xdd, 0x85, 0x50, 0xa6, 0x49, 0xbd, 0x9d, 0x6d, 0x0b, 0x4e,
+	0x62, 0xb8, 0xc3, 0x40, 0x32, 0xe0, 0xab, 0x32, 0x1c, 0xe1, 0x94, 0x0e, 0x9f, 0x6f, 0x5f, 0x87,
+	0x84, 0xf4, 0x11, 0x03, 0x13, 0xad, 0xbe, 0xd0, 0xb2, 0xa1, 0x6c, 0x37, 0x14, 0xf8, 0x17, 0xda,
+	0x54, 0x20, 0x91, 0xbc, 0x0d, 0x89, 0xa6, 0xef, 0x1d, 0xc1, 0xcb, 0x14, 0x8d, 0xe1, 0xd3, 0xc1,
+	0x18, 0x67, 0x37, 0x73, 0xcd, 0xeb, 0x9e, 0xdd, 0xac, 0x19, 0xc5, 0xcf, 0x87, 0x41, 0x11, 0x3f,
+	0x2b, 0x10, 0x43, 0xb3, 0x11, 0xe7, 0xa5, 0x65, 0x4a, 0xf8, 0x94, 0x9f, 0x84, 0xd8, 0x78, 0x03,
+	0xe2, 0x8d, 0x19, 0x74, 0xd2, 0x0f, 0x8e, 0xc4, 0xfc, 0xe9, 0x96, 0x62, 0x67, 0x58, 0xe8, 0x3d,
+	0xe6, 0x19, 0x96, 0x29, 0xe1, 0x53, 0x7e, 0x12, 0x62, 0xe3, 0x06, 0x1c, 0xa1, 0xa6, 0x0f, 0xef,
+	0x44, 0x1c, 0x08, 0x7e, 0x36, 0x08, 0x41, 0x6c, 0x5f, 0x01, 0x70, 0x8c, 0x03, 0x49, 0x2f, 0xbd,
+	0x86, 0x9c, 0x9f, 0x6e, 0x2d, 0xb7, 0xad, 0xf2, 0xdd, 0xef, 0x99, 0x43, 0xf1, 0x4a, 0xe6, 0xc1,
+	0xa3, 0x24, 0xf3, 0xf0, 0x51, 0x92, 0xf9, 0xfd, 0x51, 0x92, 0xb9, 0xf3, 0x38, 0xd9, 0xf5, 0xf0,
+	0x71, 0xb2, 0xeb, 0xd7, 0xc7, 0xc9, 0xae, 0x1b, 0xd6, 0x88, 0xa1, 0x97, 0x6e, 0x65, 0x54, 0x2d,
+	0xbb, 0x8f, 0x67, 0x83, 0xad, 0x1e, 0xf4, 0x2d, 0xbb, 0xf4, 0xef, 0x00, 0x82, 0x73, 0x01, 0x11,
+	0xcc, 0x1f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1627,14 +2184,32 @@ type MsgClient interface {
 	UpdateGroupPolicyDecisionPolicy(ctx context.Context, in *MsgUpdateGroupPolicyDecisionPolicy, opts ...grpc.CallOption) (*MsgUpdateGroupPolicyDecisionPolicyResponse, error)
 	// UpdateGroupPolicyMetadata updates a group policy metadata.
 	UpdateGroupPolicyMetadata(ctx context.Context, in *MsgUpdateGroupPolicyMetadata, opts ...grpc.CallOption) (*MsgUpdateGroupPolicyMetadataResponse, error)
+	// UpdateGroupPolicyVetoAuthority updates a group policy veto authority.
+	//
+	// Since: x/group v1.0.0
+	UpdateGroupPolicyVetoAuthority(ctx context.Context, in *MsgUpdateGroupPolicyVetoAuthority, opts ...grpc.CallOption) (*MsgUpdateGroupPolicyVetoAuthorityResponse, error)
+	// UpdateGroupPolicySpendLimit updates a group policy spend limit.
+	//
+	// Since: x/group v1.0.0
+	UpdateGroupPolicySpendLimit(ctx context.Context, in *MsgUpdateGroupPolicySpendLimit, opts ...grpc.CallOption) (*MsgUpdateGroupPolicySpendLimitResponse, error)
 	// SubmitProposal submits a new proposal.
 	SubmitProposal(ctx context.Context, in *MsgSubmitProposal, opts ...grpc.CallOption) (*MsgSubmitProposalResponse, error)
 	// WithdrawProposal withdraws a proposal.
 	WithdrawProposal(ctx context.Context, in *MsgWithdrawProposal, opts ...grpc.CallOption) (*MsgWithdrawProposalResponse, error)
 	// Vote allows a voter to vote on a proposal.
 	Vote(ctx context.Context, in *MsgVote, opts ...grpc.CallOption) (*MsgVoteResponse, error)
+	// VoteBatch allows a voter to vote on several proposals of the same group
+	// policy at once.
+	//
+	// Since: x/group v1.0.0
+	VoteBatch(ctx context.Context, in *MsgVoteBatch, opts ...grpc.CallOption) (*MsgVoteBatchResponse, error)
 	// Exec executes a proposal.
 	Exec(ctx context.Context, in *MsgExec, opts ...grpc.CallOption) (*MsgExecResponse, error)
+	// VetoProposal allows the veto authority of a group policy to veto an
+	// accepted proposal which is not executed yet.
+	//
+	// Since: x/group v1.0.0
+	VetoProposal(ctx context.Context, in *MsgVetoProposal, opts ...grpc.CallOption) (*MsgVetoProposalResponse, error)
 	// LeaveGroup allows a group member to leave the group.
 	LeaveGroup(ctx context.Context, in *MsgLeaveGroup, opts ...grpc.CallOption) (*MsgLeaveGroupResponse, error)
 }
@@ -1728,6 +2303,24 @@ func (c *msgClient) UpdateGroupPolicyMetadata(ctx context.Context, in *MsgUpdate
 	return out, nil
 }
 
+func (c *msgClient) UpdateGroupPolicyVetoAuthority(ctx context.Context, in *MsgUpdateGroupPolicyVetoAuthority, opts ...grpc.CallOption) (*MsgUpdateGroupPolicyVetoAuthorityResponse, error) {
+	out := new(MsgUpdateGroupPolicyVetoAuthorityResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Msg/UpdateGroupPolicyVetoAuthority", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateGroupPolicySpendLimit(ctx context.Context, in *MsgUpdateGroupPolicySpendLimit, opts ...grpc.CallOption) (*MsgUpdateGroupPolicySpendLimitResponse, error) {
+	out := new(MsgUpdateGroupPolicySpendLimitResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Msg/UpdateGroupPolicySpendLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) SubmitProposal(ctx context.Context, in *MsgSubmitProposal, opts ...grpc.CallOption) (*MsgSubmitProposalResponse, error) {
 	out := new(MsgSubmitProposalResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Msg/SubmitProposal", in, out, opts...)
@@ -1755,6 +2348,15 @@ func (c *msgClient) Vote(ctx context.Context, in *MsgVote, opts ...grpc.CallOpti
 	return out, nil
 }
 
+func (c *msgClient) VoteBatch(ctx context.Context, in *MsgVoteBatch, opts ...grpc.CallOption) (*MsgVoteBatchResponse, error) {
+	out := new(MsgVoteBatchResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Msg/VoteBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) Exec(ctx context.Context, in *MsgExec, opts ...grpc.CallOption) (*MsgExecResponse, error) {
 	out := new(MsgExecResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Msg/Exec", in, out, opts...)
@@ -1764,6 +2366,15 @@ func (c *msgClient) Exec(ctx context.Context, in *MsgExec, opts ...grpc.CallOpti
 	return out, nil
 }
 
+func (c *msgClient) VetoProposal(ctx context.Context, in *MsgVetoProposal, opts ...grpc.CallOption) (*MsgVetoProposalResponse, error) {
+	out := new(MsgVetoProposalResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Msg/VetoProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) LeaveGroup(ctx context.Context, in *MsgLeaveGroup, opts ...grpc.CallOption) (*MsgLeaveGroupResponse, error) {
 	out := new(MsgLeaveGroupResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Msg/LeaveGroup", in, out, opts...)
@@ -1793,14 +2404,32 @@ type MsgServer interface {
 	UpdateGroupPolicyDecisionPolicy(context.Context, *MsgUpdateGroupPolicyDecisionPolicy) (*MsgUpdateGroupPolicyDecisionPolicyResponse, error)
 	// UpdateGroupPolicyMetadata updates a group policy metadata.
 	UpdateGroupPolicyMetadata(context.Context, *MsgUpdateGroupPolicyMetadata) (*MsgUpdateGroupPolicyMetadataResponse, error)
+	// UpdateGroupPolicyVetoAuthority updates a group policy veto authority.
+	//
+	// Since: x/group v1.0.0
+	UpdateGroupPolicyVetoAuthority(context.Context, *MsgUpdateGroupPolicyVetoAuthority) (*MsgUpdateGroupPolicyVetoAuthorityResponse, error)
+	// UpdateGroupPolicySpendLimit updates a group policy spend limit.
+	//
+	// Since: x/group v1.0.0
+	UpdateGroupPolicySpendLimit(context.Context, *MsgUpdateGroupPolicySpendLimit) (*MsgUpdateGroupPolicySpendLimitResponse, error)
 	// SubmitProposal submits a new proposal.
 	SubmitProposal(context.Context, *MsgSubmitProposal) (*MsgSubmitProposalResponse, error)
 	// WithdrawProposal withdraws a proposal.
 	WithdrawProposal(context.Context, *MsgWithdrawProposal) (*MsgWithdrawProposalResponse, error)
 	// Vote allows a voter to vote on a proposal.
 	Vote(context.Context, *MsgVote) (*MsgVoteResponse, error)
+	// VoteBatch allows a voter to vote on several proposals of the same group
+	// policy at once.
+	//
+	// Since: x/group v1.0.0
+	VoteBatch(context.Context, *MsgVoteBatch) (*MsgVoteBatchResponse, error)
 	// Exec executes a proposal.
 	Exec(context.Context, *MsgExec) (*MsgExecResponse, error)
+	// VetoProposal allows the veto authority of a group policy to veto an
+	// accepted proposal which is not executed yet.
+	//
+	// Since: x/group v1.0.0
+	VetoProposal(context.Context, *MsgVetoProposal) (*MsgVetoProposalResponse, error)
 	// LeaveGroup allows a group member to leave the group.
 	LeaveGroup(context.Context, *MsgLeaveGroup) (*MsgLeaveGroupResponse, error)
 }
@@ -1836,6 +2465,12 @@ func (*UnimplementedMsgServer) UpdateGroupPolicyDecisionPolicy(ctx context.Conte
 func (*UnimplementedMsgServer) UpdateGroupPolicyMetadata(ctx context.Context, req *MsgUpdateGroupPolicyMetadata) (*MsgUpdateGroupPolicyMetadataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroupPolicyMetadata not implemented")
 }
+func (*UnimplementedMsgServer) UpdateGroupPolicyVetoAuthority(ctx context.Context, req *MsgUpdateGroupPolicyVetoAuthority) (*MsgUpdateGroupPolicyVetoAuthorityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroupPolicyVetoAuthority not implemented")
+}
+func (*UnimplementedMsgServer) UpdateGroupPolicySpendLimit(ctx context.Context, req *MsgUpdateGroupPolicySpendLimit) (*MsgUpdateGroupPolicySpendLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroupPolicySpendLimit not implemented")
+}
 func (*UnimplementedMsgServer) SubmitProposal(ctx context.Context, req *MsgSubmitProposal) (*MsgSubmitProposalResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitProposal not implemented")
 }
@@ -1845,9 +2480,15 @@ func (*UnimplementedMsgServer) WithdrawProposal(ctx context.Context, req *MsgWit
 func (*UnimplementedMsgServer) Vote(ctx context.Context, req *MsgVote) (*MsgVoteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Vote not implemented")
 }
+func (*UnimplementedMsgServer) VoteBatch(ctx context.Context, req *MsgVoteBatch) (*MsgVoteBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteBatch not implemented")
+}
 func (*UnimplementedMsgServer) Exec(ctx context.Context, req *MsgExec) (*MsgExecResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Exec not implemented")
 }
+func (*UnimplementedMsgServer) VetoProposal(ctx context.Context, req *MsgVetoProposal) (*MsgVetoProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VetoProposal not implemented")
+}
 func (*UnimplementedMsgServer) LeaveGroup(ctx context.Context, req *MsgLeaveGroup) (*MsgLeaveGroupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LeaveGroup not implemented")
 }
@@ -2018,6 +2659,42 @@ func _Msg_UpdateGroupPolicyMetadata_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateGroupPolicyVetoAuthority_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateGroupPolicyVetoAuthority)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateGroupPolicyVetoAuthority(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Msg/UpdateGroupPolicyVetoAuthority",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateGroupPolicyVetoAuthority(ctx, req.(*MsgUpdateGroupPolicyVetoAuthority))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateGroupPolicySpendLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateGroupPolicySpendLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateGroupPolicySpendLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Msg/UpdateGroupPolicySpendLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateGroupPolicySpendLimit(ctx, req.(*MsgUpdateGroupPolicySpendLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_SubmitProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgSubmitProposal)
 	if err := dec(in); err != nil {
@@ -2072,8 +2749,26 @@ func _Msg_Vote_Handler(srv interface{}, ctx context.Context, dec func(interface{
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_Exec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgExec)
+func _Msg_VoteBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVoteBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VoteBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Msg/VoteBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VoteBatch(ctx, req.(*MsgVoteBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Exec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExec)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -2090,6 +2785,24 @@ func _Msg_Exec_Handler(srv interface{}, ctx context.Context, dec func(interface{
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_VetoProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVetoProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VetoProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Msg/VetoProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VetoProposal(ctx, req.(*MsgVetoProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_LeaveGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgLeaveGroup)
 	if err := dec(in); err != nil {
@@ -2148,6 +2861,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateGroupPolicyMetadata",
 			Handler:    _Msg_UpdateGroupPolicyMetadata_Handler,
 		},
+		{
+			MethodName: "UpdateGroupPolicyVetoAuthority",
+			Handler:    _Msg_UpdateGroupPolicyVetoAuthority_Handler,
+		},
+		{
+			MethodName: "UpdateGroupPolicySpendLimit",
+			Handler:    _Msg_UpdateGroupPolicySpendLimit_Handler,
+		},
 		{
 			MethodName: "SubmitProposal",
 			Handler:    _Msg_SubmitProposal_Handler,
@@ -2160,10 +2881,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Vote",
 			Handler:    _Msg_Vote_Handler,
 		},
+		{
+			MethodName: "VoteBatch",
+			Handler:    _Msg_VoteBatch_Handler,
+		},
 		{
 			MethodName: "Exec",
 			Handler:    _Msg_Exec_Handler,
 		},
+		{
+			MethodName: "VetoProposal",
+			Handler:    _Msg_VetoProposal_Handler,
+		},
 		{
 			MethodName: "LeaveGroup",
 			Handler:    _Msg_LeaveGroup_Handler,
@@ -2474,6 +3203,30 @@ func (m *MsgCreateGroupPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SpendLimit != nil {
+		{
+			size, err := m.SpendLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.VetoAuthority) > 0 {
+		i -= len(m.VetoAuthority)
+		copy(dAtA[i:], m.VetoAuthority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VetoAuthority)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.MembershipChangeBehavior != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MembershipChangeBehavior))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.DecisionPolicy != nil {
 		{
 			size, err := m.DecisionPolicy.MarshalToSizedBuffer(dAtA[:i])
@@ -2625,6 +3378,30 @@ func (m *MsgCreateGroupWithPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if m.SpendLimit != nil {
+		{
+			size, err := m.SpendLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.VetoAuthority) > 0 {
+		i -= len(m.VetoAuthority)
+		copy(dAtA[i:], m.VetoAuthority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VetoAuthority)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.MembershipChangeBehavior != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MembershipChangeBehavior))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.DecisionPolicy != nil {
 		{
 			size, err := m.DecisionPolicy.MarshalToSizedBuffer(dAtA[:i])
@@ -2859,6 +3636,145 @@ func (m *MsgUpdateGroupPolicyMetadataResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateGroupPolicyVetoAuthority) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateGroupPolicyVetoAuthority) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateGroupPolicyVetoAuthority) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VetoAuthority) > 0 {
+		i -= len(m.VetoAuthority)
+		copy(dAtA[i:], m.VetoAuthority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VetoAuthority)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.GroupPolicyAddress) > 0 {
+		i -= len(m.GroupPolicyAddress)
+		copy(dAtA[i:], m.GroupPolicyAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupPolicyAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateGroupPolicyVetoAuthorityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateGroupPolicyVetoAuthorityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateGroupPolicyVetoAuthorityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateGroupPolicySpendLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateGroupPolicySpendLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateGroupPolicySpendLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SpendLimit != nil {
+		{
+			size, err := m.SpendLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.GroupPolicyAddress) > 0 {
+		i -= len(m.GroupPolicyAddress)
+		copy(dAtA[i:], m.GroupPolicyAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupPolicyAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateGroupPolicySpendLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateGroupPolicySpendLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateGroupPolicySpendLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgSubmitProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3099,7 +4015,7 @@ func (m *MsgVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgExec) Marshal() (dAtA []byte, err error) {
+func (m *MsgVoteBatch) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3109,32 +4025,53 @@ func (m *MsgExec) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgExec) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgVoteBatch) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgExec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgVoteBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Executor) > 0 {
-		i -= len(m.Executor)
-		copy(dAtA[i:], m.Executor)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Executor)))
+	if m.Exec != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Exec))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Votes) > 0 {
+		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.GroupPolicyAddress) > 0 {
+		i -= len(m.GroupPolicyAddress)
+		copy(dAtA[i:], m.GroupPolicyAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupPolicyAddress)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.ProposalId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Voter)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgExecResponse) Marshal() (dAtA []byte, err error) {
+func (m *BatchVote) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3144,25 +4081,37 @@ func (m *MsgExecResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgExecResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *BatchVote) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgExecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BatchVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Result != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Result))
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Option != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Option))
 		i--
 		dAtA[i] = 0x10
 	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgLeaveGroup) Marshal() (dAtA []byte, err error) {
+func (m *MsgVoteBatchResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3172,32 +4121,176 @@ func (m *MsgLeaveGroup) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgLeaveGroup) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgVoteBatchResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgLeaveGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgVoteBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.GroupId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.GroupId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgLeaveGroupResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgExec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Executor) > 0 {
+		i -= len(m.Executor)
+		copy(dAtA[i:], m.Executor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Executor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExecResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Result != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Result))
+		i--
+		dAtA[i] = 0x10
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVetoProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVetoProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVetoProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VetoAuthority) > 0 {
+		i -= len(m.VetoAuthority)
+		copy(dAtA[i:], m.VetoAuthority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VetoAuthority)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVetoProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVetoProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVetoProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLeaveGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLeaveGroup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLeaveGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLeaveGroupResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3376,6 +4469,17 @@ func (m *MsgCreateGroupPolicy) Size() (n int) {
 		l = m.DecisionPolicy.Size()
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.MembershipChangeBehavior != 0 {
+		n += 1 + sovTx(uint64(m.MembershipChangeBehavior))
+	}
+	l = len(m.VetoAuthority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SpendLimit != nil {
+		l = m.SpendLimit.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -3453,6 +4557,17 @@ func (m *MsgCreateGroupWithPolicy) Size() (n int) {
 		l = m.DecisionPolicy.Size()
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.MembershipChangeBehavior != 0 {
+		n += 1 + sovTx(uint64(m.MembershipChangeBehavior))
+	}
+	l = len(m.VetoAuthority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SpendLimit != nil {
+		l = m.SpendLimit.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -3532,6 +4647,66 @@ func (m *MsgUpdateGroupPolicyMetadataResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateGroupPolicyVetoAuthority) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GroupPolicyAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VetoAuthority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateGroupPolicyVetoAuthorityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateGroupPolicySpendLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GroupPolicyAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SpendLimit != nil {
+		l = m.SpendLimit.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateGroupPolicySpendLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgSubmitProposal) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3644,7 +4819,33 @@ func (m *MsgVoteResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgExec) Size() (n int) {
+func (m *MsgVoteBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GroupPolicyAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Votes) > 0 {
+		for _, e := range m.Votes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Exec != 0 {
+		n += 1 + sovTx(uint64(m.Exec))
+	}
+	return n
+}
+
+func (m *BatchVote) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -3653,42 +4854,95 @@ func (m *MsgExec) Size() (n int) {
 	if m.ProposalId != 0 {
 		n += 1 + sovTx(uint64(m.ProposalId))
 	}
-	l = len(m.Executor)
+	if m.Option != 0 {
+		n += 1 + sovTx(uint64(m.Option))
+	}
+	l = len(m.Metadata)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
 	return n
 }
 
-func (m *MsgExecResponse) Size() (n int) {
+func (m *MsgVoteBatchResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Result != 0 {
-		n += 1 + sovTx(uint64(m.Result))
-	}
 	return n
 }
 
-func (m *MsgLeaveGroup) Size() (n int) {
+func (m *MsgExec) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Address)
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	l = len(m.Executor)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if m.GroupId != 0 {
-		n += 1 + sovTx(uint64(m.GroupId))
-	}
 	return n
 }
 
-func (m *MsgLeaveGroupResponse) Size() (n int) {
+func (m *MsgExecResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result != 0 {
+		n += 1 + sovTx(uint64(m.Result))
+	}
+	return n
+}
+
+func (m *MsgVetoProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	l = len(m.VetoAuthority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgVetoProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgLeaveGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GroupId != 0 {
+		n += 1 + sovTx(uint64(m.GroupId))
+	}
+	return n
+}
+
+func (m *MsgLeaveGroupResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -4619,6 +5873,93 @@ func (m *MsgCreateGroupPolicy) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MembershipChangeBehavior", wireType)
+			}
+			m.MembershipChangeBehavior = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MembershipChangeBehavior |= MembershipChangeBehavior(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoAuthority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VetoAuthority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SpendLimit == nil {
+				m.SpendLimit = &SpendLimit{}
+			}
+			if err := m.SpendLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5133,6 +6474,93 @@ func (m *MsgCreateGroupWithPolicy) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MembershipChangeBehavior", wireType)
+			}
+			m.MembershipChangeBehavior = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MembershipChangeBehavior |= MembershipChangeBehavior(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoAuthority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VetoAuthority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SpendLimit == nil {
+				m.SpendLimit = &SpendLimit{}
+			}
+			if err := m.SpendLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5651,7 +7079,7 @@ func (m *MsgUpdateGroupPolicyMetadataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateGroupPolicyVetoAuthority) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5674,15 +7102,15 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateGroupPolicyVetoAuthority: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateGroupPolicyVetoAuthority: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupPolicyAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5710,11 +7138,11 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupPolicyAddress = string(dAtA[iNdEx:postIndex])
+			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupPolicyAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5742,11 +7170,11 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proposers = append(m.Proposers, string(dAtA[iNdEx:postIndex]))
+			m.GroupPolicyAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoAuthority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5774,47 +7202,1017 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
+			m.VetoAuthority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Messages = append(m.Messages, &any.Any{})
-			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exec", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateGroupPolicyVetoAuthorityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateGroupPolicyVetoAuthorityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateGroupPolicyVetoAuthorityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateGroupPolicySpendLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateGroupPolicySpendLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateGroupPolicySpendLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupPolicyAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupPolicyAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SpendLimit == nil {
+				m.SpendLimit = &SpendLimit{}
+			}
+			if err := m.SpendLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateGroupPolicySpendLimitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateGroupPolicySpendLimitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateGroupPolicySpendLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupPolicyAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupPolicyAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposers = append(m.Proposers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &any.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exec", wireType)
+			}
+			m.Exec = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Exec |= Exec(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Summary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			}
+			m.Option = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Option |= VoteOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exec", wireType)
+			}
+			m.Exec = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Exec |= Exec(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVoteBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVoteBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVoteBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
-			m.Exec = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5824,14 +8222,27 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Exec |= Exec(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupPolicyAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5859,13 +8270,13 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.GroupPolicyAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5875,79 +8286,31 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Summary = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
+			m.Votes = append(m.Votes, BatchVote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgSubmitProposalResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitProposalResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Exec", wireType)
 			}
-			m.ProposalId = 0
+			m.Exec = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5957,7 +8320,7 @@ func (m *MsgSubmitProposalResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
+				m.Exec |= Exec(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5983,7 +8346,7 @@ func (m *MsgSubmitProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawProposal) Unmarshal(dAtA []byte) error {
+func (m *BatchVote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6006,10 +8369,10 @@ func (m *MsgWithdrawProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchVote: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchVote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6032,8 +8395,27 @@ func (m *MsgWithdrawProposal) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			}
+			m.Option = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Option |= VoteOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6061,7 +8443,7 @@ func (m *MsgWithdrawProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Metadata = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6084,7 +8466,7 @@ func (m *MsgWithdrawProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgVoteBatchResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6107,10 +8489,10 @@ func (m *MsgWithdrawProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVoteBatchResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVoteBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6134,7 +8516,7 @@ func (m *MsgWithdrawProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVote) Unmarshal(dAtA []byte) error {
+func (m *MsgExec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6157,10 +8539,10 @@ func (m *MsgVote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVote: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6184,58 +8566,7 @@ func (m *MsgVote) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
-			}
-			m.Option = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Option |= VoteOption(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6263,27 +8594,8 @@ func (m *MsgVote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
+			m.Executor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exec", wireType)
-			}
-			m.Exec = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Exec |= Exec(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6305,7 +8617,7 @@ func (m *MsgVote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVoteResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgExecResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6328,12 +8640,31 @@ func (m *MsgVoteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVoteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExecResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= ProposalExecutorResult(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6355,7 +8686,7 @@ func (m *MsgVoteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExec) Unmarshal(dAtA []byte) error {
+func (m *MsgVetoProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6378,10 +8709,10 @@ func (m *MsgExec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExec: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVetoProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVetoProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6405,7 +8736,7 @@ func (m *MsgExec) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoAuthority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6433,7 +8764,7 @@ func (m *MsgExec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Executor = string(dAtA[iNdEx:postIndex])
+			m.VetoAuthority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6456,7 +8787,7 @@ func (m *MsgExec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExecResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgVetoProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6479,31 +8810,12 @@ func (m *MsgExecResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExecResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVetoProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVetoProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
-			}
-			m.Result = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Result |= ProposalExecutorResult(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
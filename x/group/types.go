@@ -38,6 +38,10 @@ type DecisionPolicy interface {
 	// where we can execution a proposal. It can be set to 0 or to a value
 	// lesser than VotingPeriod to allow TRY_EXEC.
 	GetMinExecutionPeriod() time.Duration
+	// GetMinExecutionDelay returns the minimum duration after a proposal is
+	// accepted where we can execute it. It can be set to 0 to disable the
+	// timelock.
+	GetMinExecutionDelay() time.Duration
 	// Allow defines policy-specific logic to allow a proposal to pass or not,
 	// based on its tally result, the group's total power and the time since
 	// the proposal was submitted.
@@ -52,7 +56,7 @@ var _ DecisionPolicy = &ThresholdDecisionPolicy{}
 
 // NewThresholdDecisionPolicy creates a threshold DecisionPolicy
 func NewThresholdDecisionPolicy(threshold string, votingPeriod, minExecutionPeriod time.Duration) DecisionPolicy {
-	return &ThresholdDecisionPolicy{threshold, &DecisionPolicyWindows{votingPeriod, minExecutionPeriod}}
+	return &ThresholdDecisionPolicy{threshold, &DecisionPolicyWindows{VotingPeriod: votingPeriod, MinExecutionPeriod: minExecutionPeriod}}
 }
 
 // GetVotingPeriod returns the voitng period of ThresholdDecisionPolicy
@@ -65,6 +69,11 @@ func (p ThresholdDecisionPolicy) GetMinExecutionPeriod() time.Duration {
 	return p.Windows.MinExecutionPeriod
 }
 
+// GetMinExecutionDelay returns the minimum execution delay of ThresholdDecisionPolicy
+func (p ThresholdDecisionPolicy) GetMinExecutionDelay() time.Duration {
+	return p.Windows.MinExecutionDelay
+}
+
 // ValidateBasic does basic validation on ThresholdDecisionPolicy
 func (p ThresholdDecisionPolicy) ValidateBasic() error {
 	if _, err := math.NewPositiveDecFromString(p.Threshold); err != nil {
@@ -75,6 +84,10 @@ func (p ThresholdDecisionPolicy) ValidateBasic() error {
 		return errorsmod.Wrap(errors.ErrInvalid, "voting period cannot be zero")
 	}
 
+	if p.Windows.MinExecutionDelay < 0 {
+		return errorsmod.Wrap(errors.ErrInvalid, "min execution delay cannot be negative")
+	}
+
 	return nil
 }
 
@@ -149,6 +162,9 @@ func (p *ThresholdDecisionPolicy) Validate(g GroupInfo, config Config) error {
 	if p.Windows.MinExecutionPeriod > p.Windows.VotingPeriod+config.MaxExecutionPeriod {
 		return errorsmod.Wrap(errors.ErrInvalid, "min_execution_period should be smaller than voting_period + max_execution_period")
 	}
+	if p.Windows.MinExecutionDelay > config.MaxExecutionPeriod {
+		return errorsmod.Wrap(errors.ErrInvalid, "min_execution_delay should be smaller than max_execution_period")
+	}
 	return nil
 }
 
@@ -157,7 +173,7 @@ var _ DecisionPolicy = &PercentageDecisionPolicy{}
 
 // NewPercentageDecisionPolicy creates a new percentage DecisionPolicy
 func NewPercentageDecisionPolicy(percentage string, votingPeriod, executionPeriod time.Duration) DecisionPolicy {
-	return &PercentageDecisionPolicy{percentage, &DecisionPolicyWindows{votingPeriod, executionPeriod}}
+	return &PercentageDecisionPolicy{percentage, &DecisionPolicyWindows{VotingPeriod: votingPeriod, MinExecutionPeriod: executionPeriod}}
 }
 
 // GetVotingPeriod returns the voitng period of PercentageDecisionPolicy
@@ -170,6 +186,11 @@ func (p PercentageDecisionPolicy) GetMinExecutionPeriod() time.Duration {
 	return p.Windows.MinExecutionPeriod
 }
 
+// GetMinExecutionDelay returns the minimum execution delay of PercentageDecisionPolicy
+func (p PercentageDecisionPolicy) GetMinExecutionDelay() time.Duration {
+	return p.Windows.MinExecutionDelay
+}
+
 // ValidateBasic does basic validation on PercentageDecisionPolicy
 func (p PercentageDecisionPolicy) ValidateBasic() error {
 	percentage, err := math.NewPositiveDecFromString(p.Percentage)
@@ -184,6 +205,10 @@ func (p PercentageDecisionPolicy) ValidateBasic() error {
 		return errorsmod.Wrap(errors.ErrInvalid, "voting period cannot be 0")
 	}
 
+	if p.Windows.MinExecutionDelay < 0 {
+		return errorsmod.Wrap(errors.ErrInvalid, "min execution delay cannot be negative")
+	}
+
 	return nil
 }
 
@@ -192,6 +217,9 @@ func (p *PercentageDecisionPolicy) Validate(g GroupInfo, config Config) error {
 	if p.Windows.MinExecutionPeriod > p.Windows.VotingPeriod+config.MaxExecutionPeriod {
 		return errorsmod.Wrap(errors.ErrInvalid, "min_execution_period should be smaller than voting_period + max_execution_period")
 	}
+	if p.Windows.MinExecutionDelay > config.MaxExecutionPeriod {
+		return errorsmod.Wrap(errors.ErrInvalid, "min_execution_delay should be smaller than max_execution_period")
+	}
 	return nil
 }
 
@@ -349,6 +377,33 @@ func (g GroupPolicyInfo) ValidateBasic() error {
 	if err := policy.ValidateBasic(); err != nil {
 		return errorsmod.Wrap(err, "group policy's decision policy")
 	}
+
+	if g.SpendLimit != nil {
+		if err := g.SpendLimit.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(err, "group policy's spend limit")
+		}
+	}
+	return nil
+}
+
+// ValidateBasic does basic validation on spend limit.
+func (s SpendLimit) ValidateBasic() error {
+	if err := s.PerProposal.Validate(); err != nil {
+		return errorsmod.Wrap(err, "per proposal")
+	}
+
+	if err := s.PerEpoch.Validate(); err != nil {
+		return errorsmod.Wrap(err, "per epoch")
+	}
+
+	if s.EpochDuration < 0 {
+		return errorsmod.Wrap(errors.ErrInvalid, "epoch duration must be positive")
+	}
+
+	if !s.PerEpoch.Empty() && s.EpochDuration == 0 {
+		return errorsmod.Wrap(errors.ErrEmpty, "epoch duration")
+	}
+
 	return nil
 }
 
@@ -6,6 +6,8 @@ package group
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -72,6 +74,46 @@ func (VoteOption) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_f5bddd15d7a54a9d, []int{0}
 }
 
+// MembershipChangeBehavior defines how a group policy's proposals still in
+// their voting period are affected by a change of the group's members.
+//
+// Since: x/group v1.0.0
+type MembershipChangeBehavior int32
+
+const (
+	// MEMBERSHIP_CHANGE_BEHAVIOR_UNSPECIFIED defines the default behavior:
+	// proposals are left untouched, and are tallied with the members' weights at
+	// the time of tallying.
+	MEMBERSHIP_CHANGE_BEHAVIOR_UNSPECIFIED MembershipChangeBehavior = 0
+	// MEMBERSHIP_CHANGE_BEHAVIOR_RETALLY defines a behavior where proposals are
+	// re-tallied with the new members' weights right away, so they are accepted
+	// or rejected as soon as the outcome is final.
+	MEMBERSHIP_CHANGE_BEHAVIOR_RETALLY MembershipChangeBehavior = 1
+	// MEMBERSHIP_CHANGE_BEHAVIOR_INVALIDATE defines a behavior where proposals are
+	// marked as aborted.
+	MEMBERSHIP_CHANGE_BEHAVIOR_INVALIDATE MembershipChangeBehavior = 2
+)
+
+var MembershipChangeBehavior_name = map[int32]string{
+	0: "MEMBERSHIP_CHANGE_BEHAVIOR_UNSPECIFIED",
+	1: "MEMBERSHIP_CHANGE_BEHAVIOR_RETALLY",
+	2: "MEMBERSHIP_CHANGE_BEHAVIOR_INVALIDATE",
+}
+
+var MembershipChangeBehavior_value = map[string]int32{
+	"MEMBERSHIP_CHANGE_BEHAVIOR_UNSPECIFIED": 0,
+	"MEMBERSHIP_CHANGE_BEHAVIOR_RETALLY":     1,
+	"MEMBERSHIP_CHANGE_BEHAVIOR_INVALIDATE":  2,
+}
+
+func (x MembershipChangeBehavior) String() string {
+	return proto.EnumName(MembershipChangeBehavior_name, int32(x))
+}
+
+func (MembershipChangeBehavior) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f5bddd15d7a54a9d, []int{1}
+}
+
 // ProposalStatus defines proposal statuses.
 type ProposalStatus int32
 
@@ -92,6 +134,11 @@ const (
 	// A proposal can be withdrawn before the voting start time by the owner.
 	// When this happens the final status is Withdrawn.
 	PROPOSAL_STATUS_WITHDRAWN ProposalStatus = 5
+	// Final status of an accepted proposal when it is vetoed by the group
+	// policy's veto authority before being executed.
+	//
+	// Since: x/group v1.0.0
+	PROPOSAL_STATUS_VETOED ProposalStatus = 6
 )
 
 var ProposalStatus_name = map[int32]string{
@@ -101,6 +148,7 @@ var ProposalStatus_name = map[int32]string{
 	3: "PROPOSAL_STATUS_REJECTED",
 	4: "PROPOSAL_STATUS_ABORTED",
 	5: "PROPOSAL_STATUS_WITHDRAWN",
+	6: "PROPOSAL_STATUS_VETOED",
 }
 
 var ProposalStatus_value = map[string]int32{
@@ -110,6 +158,7 @@ var ProposalStatus_value = map[string]int32{
 	"PROPOSAL_STATUS_REJECTED":    3,
 	"PROPOSAL_STATUS_ABORTED":     4,
 	"PROPOSAL_STATUS_WITHDRAWN":   5,
+	"PROPOSAL_STATUS_VETOED":      6,
 }
 
 func (x ProposalStatus) String() string {
@@ -117,7 +166,7 @@ func (x ProposalStatus) String() string {
 }
 
 func (ProposalStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{1}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{2}
 }
 
 // ProposalExecutorResult defines types of proposal executor results.
@@ -153,7 +202,7 @@ func (x ProposalExecutorResult) String() string {
 }
 
 func (ProposalExecutorResult) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{2}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{3}
 }
 
 // Member represents a group member with an account address,
@@ -435,6 +484,14 @@ type DecisionPolicyWindows struct {
 	// is empty, meaning that all proposals created with this decision policy
 	// won't be able to be executed.
 	MinExecutionPeriod time.Duration `protobuf:"bytes,2,opt,name=min_execution_period,json=minExecutionPeriod,proto3,stdduration" json:"min_execution_period"`
+	// min_execution_delay is the minimum duration after a proposal is accepted
+	// before it can be executed. It acts as a timelock, giving group members
+	// time to leave the group, or the group policy admin time to abort the
+	// proposal by updating the group policy, before its messages are executed.
+	// If not set, min_execution_delay will default to 0.
+	//
+	// Since: x/group v1.0.0
+	MinExecutionDelay time.Duration `protobuf:"bytes,3,opt,name=min_execution_delay,json=minExecutionDelay,proto3,stdduration" json:"min_execution_delay"`
 }
 
 func (m *DecisionPolicyWindows) Reset()         { *m = DecisionPolicyWindows{} }
@@ -484,6 +541,13 @@ func (m *DecisionPolicyWindows) GetMinExecutionPeriod() time.Duration {
 	return 0
 }
 
+func (m *DecisionPolicyWindows) GetMinExecutionDelay() time.Duration {
+	if m != nil {
+		return m.MinExecutionDelay
+	}
+	return 0
+}
+
 // GroupInfo represents the high-level on-chain information for a group.
 type GroupInfo struct {
 	// id is the unique ID of the group.
@@ -653,6 +717,26 @@ type GroupPolicyInfo struct {
 	DecisionPolicy *any.Any `protobuf:"bytes,6,opt,name=decision_policy,json=decisionPolicy,proto3" json:"decision_policy,omitempty"`
 	// created_at is a timestamp specifying when a group policy was created.
 	CreatedAt time.Time `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
+	// membership_change_behavior defines how the group policy's proposals still
+	// in their voting period are affected by a change of the group's members.
+	//
+	// Since: x/group v1.0.0
+	MembershipChangeBehavior MembershipChangeBehavior `protobuf:"varint,8,opt,name=membership_change_behavior,json=membershipChangeBehavior,proto3,enum=cosmos.group.v1.MembershipChangeBehavior" json:"membership_change_behavior,omitempty"`
+	// veto_authority is an optional account address which can veto the group
+	// policy's proposals once accepted, as long as they are not executed.
+	//
+	// Since: x/group v1.0.0
+	VetoAuthority string `protobuf:"bytes,9,opt,name=veto_authority,json=vetoAuthority,proto3" json:"veto_authority,omitempty"`
+	// spend_limit optionally caps the coins the group policy's proposals can
+	// send from the group policy account.
+	//
+	// Since: x/group v1.0.0
+	SpendLimit *SpendLimit `protobuf:"bytes,10,opt,name=spend_limit,json=spendLimit,proto3" json:"spend_limit,omitempty"`
+	// spend_limit_epoch tracks the coins sent by the group policy's proposals
+	// during the current epoch of its spend limit.
+	//
+	// Since: x/group v1.0.0
+	SpendLimitEpoch *SpendLimitEpoch `protobuf:"bytes,11,opt,name=spend_limit_epoch,json=spendLimitEpoch,proto3" json:"spend_limit_epoch,omitempty"`
 }
 
 func (m *GroupPolicyInfo) Reset()         { *m = GroupPolicyInfo{} }
@@ -688,6 +772,134 @@ func (m *GroupPolicyInfo) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GroupPolicyInfo proto.InternalMessageInfo
 
+// SpendLimit caps the coins a group policy can send from its account with the
+// bank MsgSend and MsgMultiSend messages of its proposals. Only the denoms
+// listed in a limit are capped.
+//
+// Since: x/group v1.0.0
+type SpendLimit struct {
+	// per_proposal caps the coins sent by the execution of a single proposal.
+	PerProposal github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=per_proposal,json=perProposal,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"per_proposal"`
+	// per_epoch caps the coins sent by the execution of all the proposals
+	// within an epoch of epoch_duration.
+	PerEpoch github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=per_epoch,json=perEpoch,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"per_epoch"`
+	// epoch_duration is the duration of a per_epoch spend limit epoch. It is
+	// required when per_epoch is set.
+	EpochDuration time.Duration `protobuf:"bytes,3,opt,name=epoch_duration,json=epochDuration,proto3,stdduration" json:"epoch_duration"`
+}
+
+func (m *SpendLimit) Reset()         { *m = SpendLimit{} }
+func (m *SpendLimit) String() string { return proto.CompactTextString(m) }
+func (*SpendLimit) ProtoMessage()    {}
+func (*SpendLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5bddd15d7a54a9d, []int{8}
+}
+func (m *SpendLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpendLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpendLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SpendLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpendLimit.Merge(m, src)
+}
+func (m *SpendLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpendLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpendLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpendLimit proto.InternalMessageInfo
+
+func (m *SpendLimit) GetPerProposal() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PerProposal
+	}
+	return nil
+}
+
+func (m *SpendLimit) GetPerEpoch() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PerEpoch
+	}
+	return nil
+}
+
+func (m *SpendLimit) GetEpochDuration() time.Duration {
+	if m != nil {
+		return m.EpochDuration
+	}
+	return 0
+}
+
+// SpendLimitEpoch tracks the coins sent by a group policy during an epoch of
+// its spend limit.
+//
+// Since: x/group v1.0.0
+type SpendLimitEpoch struct {
+	// start is the start time of the epoch.
+	Start time.Time `protobuf:"bytes,1,opt,name=start,proto3,stdtime" json:"start"`
+	// spent are the coins sent since the start of the epoch.
+	Spent github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=spent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spent"`
+}
+
+func (m *SpendLimitEpoch) Reset()         { *m = SpendLimitEpoch{} }
+func (m *SpendLimitEpoch) String() string { return proto.CompactTextString(m) }
+func (*SpendLimitEpoch) ProtoMessage()    {}
+func (*SpendLimitEpoch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5bddd15d7a54a9d, []int{9}
+}
+func (m *SpendLimitEpoch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpendLimitEpoch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpendLimitEpoch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SpendLimitEpoch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpendLimitEpoch.Merge(m, src)
+}
+func (m *SpendLimitEpoch) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpendLimitEpoch) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpendLimitEpoch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpendLimitEpoch proto.InternalMessageInfo
+
+func (m *SpendLimitEpoch) GetStart() time.Time {
+	if m != nil {
+		return m.Start
+	}
+	return time.Time{}
+}
+
+func (m *SpendLimitEpoch) GetSpent() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Spent
+	}
+	return nil
+}
+
 // Proposal defines a group proposal. Any member of a group can submit a proposal
 // for a group policy to decide upon.
 // A proposal consists of a set of `sdk.Msg`s that will be executed if the proposal
@@ -738,13 +950,20 @@ type Proposal struct {
 	//
 	// Since: cosmos-sdk 0.47
 	Summary string `protobuf:"bytes,14,opt,name=summary,proto3" json:"summary,omitempty"`
+	// timelock_end is the timestamp before which an accepted proposal cannot be
+	// executed. It is set when the proposal is accepted, from the
+	// `min_execution_delay` of the group policy's decision policy, and is empty
+	// if the decision policy has no execution delay.
+	//
+	// Since: x/group v1.0.0
+	TimelockEnd *time.Time `protobuf:"bytes,15,opt,name=timelock_end,json=timelockEnd,proto3,stdtime" json:"timelock_end,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
 func (m *Proposal) String() string { return proto.CompactTextString(m) }
 func (*Proposal) ProtoMessage()    {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{8}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{10}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -789,7 +1008,7 @@ func (m *TallyResult) Reset()         { *m = TallyResult{} }
 func (m *TallyResult) String() string { return proto.CompactTextString(m) }
 func (*TallyResult) ProtoMessage()    {}
 func (*TallyResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{9}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{11}
 }
 func (m *TallyResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -837,7 +1056,7 @@ func (m *Vote) Reset()         { *m = Vote{} }
 func (m *Vote) String() string { return proto.CompactTextString(m) }
 func (*Vote) ProtoMessage()    {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{10}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{12}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -903,6 +1122,7 @@ func (m *Vote) GetSubmitTime() time.Time {
 
 func init() {
 	proto.RegisterEnum("cosmos.group.v1.VoteOption", VoteOption_name, VoteOption_value)
+	proto.RegisterEnum("cosmos.group.v1.MembershipChangeBehavior", MembershipChangeBehavior_name, MembershipChangeBehavior_value)
 	proto.RegisterEnum("cosmos.group.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
 	proto.RegisterEnum("cosmos.group.v1.ProposalExecutorResult", ProposalExecutorResult_name, ProposalExecutorResult_value)
 	proto.RegisterType((*Member)(nil), "cosmos.group.v1.Member")
@@ -913,6 +1133,8 @@ func init() {
 	proto.RegisterType((*GroupInfo)(nil), "cosmos.group.v1.GroupInfo")
 	proto.RegisterType((*GroupMember)(nil), "cosmos.group.v1.GroupMember")
 	proto.RegisterType((*GroupPolicyInfo)(nil), "cosmos.group.v1.GroupPolicyInfo")
+	proto.RegisterType((*SpendLimit)(nil), "cosmos.group.v1.SpendLimit")
+	proto.RegisterType((*SpendLimitEpoch)(nil), "cosmos.group.v1.SpendLimitEpoch")
 	proto.RegisterType((*Proposal)(nil), "cosmos.group.v1.Proposal")
 	proto.RegisterType((*TallyResult)(nil), "cosmos.group.v1.TallyResult")
 	proto.RegisterType((*Vote)(nil), "cosmos.group.v1.Vote")
@@ -921,92 +1143,116 @@ func init() {
 func init() { proto.RegisterFile("cosmos/group/v1/types.proto", fileDescriptor_f5bddd15d7a54a9d) }
 
 var fileDescriptor_f5bddd15d7a54a9d = []byte{
-	// 1358 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcd, 0x6f, 0x1b, 0xc5,
-	0x1b, 0xf6, 0xda, 0x8e, 0x3f, 0x5e, 0x27, 0xb6, 0x3b, 0xcd, 0xaf, 0xd9, 0x24, 0xfd, 0xd9, 0xc1,
-	0xad, 0x20, 0x0a, 0xaa, 0xdd, 0xa6, 0x12, 0x48, 0x3d, 0x61, 0x3b, 0x5b, 0xea, 0xa8, 0xb5, 0xad,
-	0xf5, 0x3a, 0xa1, 0xbd, 0xac, 0x36, 0xde, 0xa9, 0xb3, 0xaa, 0xbd, 0x63, 0x76, 0xc7, 0x49, 0xfd,
-	0x1f, 0x54, 0x5c, 0xe8, 0x91, 0x0b, 0x52, 0x25, 0x2e, 0x1c, 0x7b, 0xa8, 0x38, 0x70, 0x44, 0x1c,
-	0x2a, 0x0e, 0xa8, 0xe2, 0xc4, 0x09, 0x50, 0x73, 0x28, 0x27, 0x4e, 0x5c, 0x41, 0x68, 0x67, 0x66,
-	0x1d, 0x7f, 0x24, 0x2e, 0xa9, 0x2a, 0x2e, 0x51, 0x66, 0x9e, 0xe7, 0x7d, 0xe7, 0x7d, 0xde, 0xaf,
-	0x4d, 0x60, 0xb5, 0x45, 0xdc, 0x2e, 0x71, 0x0b, 0x6d, 0x87, 0xf4, 0x7b, 0x85, 0x83, 0x6b, 0x05,
-	0x3a, 0xe8, 0x61, 0x37, 0xdf, 0x73, 0x08, 0x25, 0x28, 0xc5, 0xc1, 0x3c, 0x03, 0xf3, 0x07, 0xd7,
-	0x56, 0x16, 0xdb, 0xa4, 0x4d, 0x18, 0x56, 0xf0, 0x7e, 0xe3, 0xb4, 0x95, 0x4c, 0x9b, 0x90, 0x76,
-	0x07, 0x17, 0xd8, 0x69, 0xaf, 0x7f, 0xbf, 0x60, 0xf6, 0x1d, 0x83, 0x5a, 0xc4, 0x16, 0x78, 0x76,
-	0x12, 0xa7, 0x56, 0x17, 0xbb, 0xd4, 0xe8, 0xf6, 0x04, 0x61, 0x99, 0xbf, 0xa3, 0x73, 0xcf, 0xe2,
-	0x51, 0x01, 0x4d, 0xda, 0x1a, 0xf6, 0x40, 0x40, 0xe7, 0x8c, 0xae, 0x65, 0x93, 0x02, 0xfb, 0xc9,
-	0xaf, 0x72, 0xdf, 0x48, 0x10, 0xb9, 0x83, 0xbb, 0x7b, 0xd8, 0x41, 0x9b, 0x10, 0x35, 0x4c, 0xd3,
-	0xc1, 0xae, 0x2b, 0x4b, 0x6b, 0xd2, 0x7a, 0xbc, 0x24, 0xff, 0xf4, 0xec, 0xca, 0xa2, 0xf0, 0x5d,
-	0xe4, 0x48, 0x83, 0x3a, 0x96, 0xdd, 0x56, 0x7d, 0x22, 0xba, 0x00, 0x91, 0x43, 0x6c, 0xb5, 0xf7,
-	0xa9, 0x1c, 0xf4, 0x4c, 0x54, 0x71, 0x42, 0x2b, 0x10, 0xeb, 0x62, 0x6a, 0x98, 0x06, 0x35, 0xe4,
-	0x10, 0x43, 0x86, 0x67, 0xb4, 0x05, 0x31, 0xc3, 0x34, 0xb1, 0xa9, 0x1b, 0x54, 0x0e, 0xaf, 0x49,
-	0xeb, 0x89, 0xcd, 0x95, 0x3c, 0x8f, 0x39, 0xef, 0xc7, 0x9c, 0xd7, 0x7c, 0xbd, 0xa5, 0x85, 0xe7,
-	0xbf, 0x64, 0x03, 0x8f, 0x7f, 0xcd, 0x4a, 0x5f, 0xbf, 0x7a, 0xba, 0x21, 0xb1, 0x97, 0xb1, 0x59,
-	0xa4, 0xb9, 0x43, 0x58, 0xe0, 0x71, 0xab, 0xf8, 0xd3, 0x3e, 0x76, 0xe9, 0x7f, 0x15, 0x7e, 0xee,
-	0x7b, 0x09, 0x96, 0xb4, 0x7d, 0x07, 0xbb, 0xfb, 0xa4, 0x63, 0x6e, 0xe1, 0x96, 0xe5, 0x5a, 0xc4,
-	0xae, 0x93, 0x8e, 0xd5, 0x1a, 0xa0, 0x8b, 0x10, 0xa7, 0x3e, 0xc4, 0xa3, 0x50, 0x8f, 0x2f, 0xd0,
-	0x47, 0x10, 0x3d, 0xb4, 0x6c, 0x93, 0x1c, 0xba, 0xec, 0xb9, 0xc4, 0xe6, 0xbb, 0xf9, 0x89, 0x76,
-	0xc9, 0x8f, 0xfb, 0xdb, 0xe5, 0x6c, 0xd5, 0x37, 0xbb, 0x51, 0xf9, 0xe1, 0xd9, 0x95, 0xcc, 0x6c,
-	0x9b, 0xcf, 0x5e, 0x3d, 0xdd, 0xc8, 0x71, 0xca, 0x15, 0xd7, 0x7c, 0x50, 0x38, 0x25, 0xd4, 0xdc,
-	0x73, 0x09, 0xe4, 0x3a, 0x76, 0x5a, 0xd8, 0xa6, 0x46, 0x1b, 0x4f, 0xe8, 0xc8, 0x00, 0xf4, 0x86,
-	0x98, 0x10, 0x32, 0x72, 0xf3, 0x16, 0x94, 0x6c, 0xff, 0x3b, 0x25, 0x97, 0x46, 0x94, 0x9c, 0x16,
-	0x6d, 0xee, 0x3b, 0x09, 0xfe, 0x77, 0xe2, 0x73, 0xe8, 0x0e, 0x2c, 0x1c, 0x10, 0x6a, 0xd9, 0x6d,
-	0xbd, 0x87, 0x1d, 0x8b, 0xf0, 0x9a, 0x24, 0x36, 0x97, 0xa7, 0xfa, 0x6d, 0x4b, 0xcc, 0x1f, 0x6f,
-	0xb7, 0x2f, 0x86, 0xed, 0x36, 0xcf, 0xcd, 0xeb, 0xcc, 0x1a, 0xdd, 0x83, 0xc5, 0xae, 0x65, 0xeb,
-	0xf8, 0x21, 0x6e, 0xf5, 0x3d, 0xb6, 0xef, 0x35, 0x78, 0x46, 0xaf, 0xa8, 0x6b, 0xd9, 0x8a, 0xef,
-	0x84, 0xfb, 0xce, 0xfd, 0x21, 0x41, 0xfc, 0x63, 0x2f, 0x11, 0x15, 0xfb, 0x3e, 0x41, 0x49, 0x08,
-	0x5a, 0x3c, 0xda, 0xb0, 0x1a, 0xb4, 0x4c, 0x94, 0x87, 0x39, 0xc3, 0xec, 0x5a, 0x36, 0xef, 0xd3,
-	0x19, 0xad, 0xcd, 0x69, 0x33, 0xe7, 0x4f, 0x86, 0xe8, 0x01, 0x76, 0xbc, 0x64, 0xb1, 0xf1, 0x0b,
-	0xab, 0xfe, 0x11, 0xbd, 0x03, 0xf3, 0x94, 0x50, 0xa3, 0xa3, 0x8b, 0xa1, 0x98, 0x63, 0x96, 0x09,
-	0x76, 0xb7, 0xcb, 0x27, 0xe3, 0x16, 0x40, 0xcb, 0xc1, 0x06, 0xe5, 0xe3, 0x1b, 0x39, 0xeb, 0xf8,
-	0xc6, 0x85, 0x71, 0x91, 0xe6, 0xee, 0x42, 0x82, 0xe9, 0x15, 0xdb, 0x67, 0x19, 0x62, 0xac, 0x0f,
-	0xf4, 0xa1, 0xee, 0x28, 0x3b, 0x57, 0x4c, 0x54, 0x80, 0x48, 0x97, 0x91, 0x44, 0xa2, 0x97, 0xa6,
-	0x9a, 0x4d, 0x6c, 0x02, 0x41, 0xcb, 0xfd, 0x15, 0x84, 0x14, 0xf3, 0xcd, 0xbb, 0x81, 0x65, 0xf4,
-	0x4d, 0xd6, 0xc3, 0x68, 0x4c, 0xc1, 0xf1, 0x98, 0x86, 0x05, 0x09, 0x9d, 0xbd, 0x20, 0xe1, 0xd3,
-	0x0b, 0x32, 0x37, 0x5e, 0x10, 0x03, 0x52, 0xa6, 0x68, 0x6c, 0xbd, 0xc7, 0xb4, 0x88, 0x94, 0x2f,
-	0x4e, 0xa5, 0xbc, 0x68, 0x0f, 0x4a, 0xb9, 0xd7, 0x0f, 0x95, 0x9a, 0x34, 0xc7, 0x47, 0x7d, 0xbc,
-	0xa0, 0xd1, 0x37, 0x2f, 0xe8, 0x8d, 0xd8, 0xa3, 0x27, 0xd9, 0xc0, 0xef, 0x4f, 0xb2, 0x52, 0xee,
-	0xef, 0x39, 0x88, 0xd5, 0x1d, 0xd2, 0x23, 0xae, 0xd1, 0x99, 0x6a, 0xe5, 0x6d, 0x58, 0xe4, 0x49,
-	0xe5, 0x82, 0x74, 0xbf, 0x2a, 0xaf, 0xeb, 0x6c, 0xd4, 0x3e, 0xae, 0xa8, 0x40, 0x66, 0xb6, 0xf9,
-	0x07, 0x10, 0xef, 0xb1, 0x18, 0xb0, 0xe3, 0xca, 0xe1, 0xb5, 0xd0, 0x4c, 0xe7, 0xc7, 0x54, 0xb4,
-	0x0d, 0x09, 0xb7, 0xbf, 0xd7, 0xb5, 0xa8, 0xee, 0x7d, 0x74, 0x59, 0x45, 0xce, 0x94, 0x11, 0xe0,
-	0xd6, 0x1e, 0x8e, 0x2e, 0xc1, 0x02, 0xd7, 0xea, 0xd7, 0x37, 0xc2, 0xd2, 0x30, 0xcf, 0x2e, 0x77,
-	0x44, 0x91, 0xaf, 0x4e, 0x24, 0xc4, 0xe7, 0x46, 0x19, 0x77, 0x54, 0xb6, 0x6f, 0xf1, 0x21, 0x44,
-	0x5c, 0x6a, 0xd0, 0xbe, 0x2b, 0xc7, 0xd6, 0xa4, 0xf5, 0xe4, 0x66, 0x76, 0x6a, 0x20, 0xfc, 0xec,
-	0x37, 0x18, 0x4d, 0x15, 0x74, 0xd4, 0x04, 0x74, 0xdf, 0xb2, 0x8d, 0x8e, 0x4e, 0x8d, 0x4e, 0x67,
-	0xa0, 0x3b, 0xd8, 0xed, 0x77, 0xa8, 0x1c, 0x67, 0x12, 0x2f, 0x4e, 0x39, 0xd1, 0x3c, 0x92, 0xca,
-	0x38, 0xa5, 0xb8, 0x27, 0x92, 0x0b, 0x4c, 0x33, 0x17, 0x23, 0x20, 0x6a, 0xc2, 0xb9, 0xb1, 0x35,
-	0xab, 0x63, 0xdb, 0x94, 0xe1, 0xac, 0x89, 0x4b, 0x8d, 0xee, 0x5a, 0xc5, 0x36, 0x51, 0x1d, 0x52,
-	0x7c, 0xd5, 0x12, 0xc7, 0x0f, 0x35, 0xc1, 0xf4, 0xbe, 0x77, 0xaa, 0x5e, 0x45, 0xf0, 0x79, 0x60,
-	0x6a, 0x12, 0x8f, 0x9d, 0xd1, 0x55, 0xaf, 0x5f, 0x5c, 0xd7, 0x68, 0x63, 0x57, 0x9e, 0x5f, 0x0b,
-	0x9d, 0x36, 0x48, 0xea, 0x90, 0x85, 0x16, 0x61, 0x8e, 0x5a, 0xb4, 0x83, 0xe5, 0x05, 0xd6, 0x5e,
-	0xfc, 0xe0, 0x4d, 0xac, 0xdb, 0xef, 0x76, 0x0d, 0x67, 0x20, 0x27, 0xd9, 0xbd, 0x7f, 0xbc, 0x11,
-	0xf6, 0x86, 0x20, 0xf7, 0xa5, 0x04, 0x89, 0xd1, 0x04, 0xad, 0x42, 0x7c, 0x80, 0x5d, 0xbd, 0x45,
-	0xfa, 0x36, 0x15, 0x9f, 0xd3, 0xd8, 0x00, 0xbb, 0x65, 0xef, 0xec, 0x35, 0x89, 0xb1, 0xe7, 0x52,
-	0xc3, 0xb2, 0x05, 0x81, 0xff, 0x2d, 0x32, 0x2f, 0x2e, 0x39, 0x69, 0x19, 0x62, 0x36, 0x11, 0x38,
-	0xef, 0xf4, 0xa8, 0x4d, 0x38, 0xf4, 0x3e, 0x20, 0x9b, 0xe8, 0x87, 0x16, 0xdd, 0xd7, 0x0f, 0x30,
-	0xf5, 0x49, 0x7c, 0xc9, 0xa4, 0x6c, 0xb2, 0x6b, 0xd1, 0xfd, 0x1d, 0x4c, 0x39, 0x59, 0xc4, 0xf7,
-	0xa7, 0x04, 0xe1, 0x1d, 0x42, 0x31, 0xca, 0x42, 0xa2, 0x27, 0x52, 0x77, 0xbc, 0x78, 0xc1, 0xbf,
-	0xe2, 0x7b, 0xee, 0x80, 0x50, 0xb1, 0x7a, 0x67, 0xee, 0x39, 0x46, 0x43, 0xd7, 0x21, 0x42, 0x7a,
-	0xde, 0x67, 0x8d, 0x45, 0x99, 0xdc, 0x5c, 0x9d, 0x2a, 0x95, 0xf7, 0x6e, 0x8d, 0x51, 0x54, 0x41,
-	0x9d, 0xb9, 0x1c, 0xdf, 0xe2, 0x38, 0x6e, 0x7c, 0x2e, 0x01, 0x1c, 0x3f, 0x8f, 0x56, 0x61, 0x69,
-	0xa7, 0xa6, 0x29, 0x7a, 0xad, 0xae, 0x55, 0x6a, 0x55, 0xbd, 0x59, 0x6d, 0xd4, 0x95, 0x72, 0xe5,
-	0x66, 0x45, 0xd9, 0x4a, 0x07, 0xd0, 0x79, 0x48, 0x8d, 0x82, 0x77, 0x95, 0x46, 0x5a, 0x42, 0x4b,
-	0x70, 0x7e, 0xf4, 0xb2, 0x58, 0x6a, 0x68, 0xc5, 0x4a, 0x35, 0x1d, 0x44, 0x08, 0x92, 0xa3, 0x40,
-	0xb5, 0x96, 0x0e, 0xa1, 0x8b, 0x20, 0x8f, 0xdf, 0xe9, 0xbb, 0x15, 0xed, 0x96, 0xbe, 0xa3, 0x68,
-	0xb5, 0x74, 0x78, 0x25, 0xfc, 0xe8, 0xab, 0x4c, 0x60, 0xe3, 0x47, 0x09, 0x92, 0xe3, 0xb3, 0x8a,
-	0xb2, 0xb0, 0x5a, 0x57, 0x6b, 0xf5, 0x5a, 0xa3, 0x78, 0x5b, 0x6f, 0x68, 0x45, 0xad, 0xd9, 0x98,
-	0x88, 0xec, 0xff, 0xb0, 0x3c, 0x49, 0x68, 0x34, 0x4b, 0x77, 0x2a, 0x9a, 0xa6, 0x6c, 0xa5, 0x25,
-	0xef, 0xd9, 0x49, 0xb8, 0x58, 0x2e, 0x2b, 0x75, 0x0f, 0x0d, 0x9e, 0x84, 0xaa, 0xca, 0xb6, 0x52,
-	0xf6, 0xd0, 0x90, 0x97, 0x91, 0x29, 0xdb, 0x52, 0x4d, 0xf5, 0xc0, 0xf0, 0x49, 0xef, 0x7a, 0x82,
-	0xb6, 0xd4, 0xe2, 0x6e, 0x35, 0x3d, 0x27, 0x04, 0x7d, 0x2b, 0xc1, 0x85, 0x93, 0x87, 0x11, 0xad,
-	0xc3, 0xe5, 0xa1, 0xbd, 0xf2, 0x89, 0x52, 0x6e, 0x6a, 0x35, 0x55, 0x57, 0x95, 0x46, 0xf3, 0xb6,
-	0x36, 0xa1, 0xf0, 0x32, 0xac, 0x9d, 0xca, 0xac, 0xd6, 0x34, 0x5d, 0x6d, 0x56, 0xd3, 0xd2, 0x4c,
-	0x56, 0xa3, 0x59, 0x2e, 0x2b, 0x8d, 0x46, 0x3a, 0x38, 0x93, 0x75, 0xb3, 0x58, 0xb9, 0xdd, 0x54,
-	0x95, 0x74, 0x88, 0x07, 0x5f, 0xca, 0x3f, 0x7f, 0x99, 0x91, 0x5e, 0xbc, 0xcc, 0x48, 0xbf, 0xbd,
-	0xcc, 0x48, 0x8f, 0x8f, 0x32, 0x81, 0x17, 0x47, 0x99, 0xc0, 0xcf, 0x47, 0x99, 0xc0, 0x3d, 0xd1,
-	0xf3, 0xae, 0xf9, 0x20, 0x6f, 0x91, 0xc2, 0x43, 0xfe, 0xcf, 0xdf, 0x5e, 0x84, 0xb5, 0xdf, 0xf5,
-	0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x1e, 0x5f, 0xf0, 0x46, 0x13, 0x0e, 0x00, 0x00,
+	// 1741 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcd, 0x6f, 0x23, 0x67,
+	0x19, 0xcf, 0xf8, 0x2b, 0xf6, 0xe3, 0xc4, 0xf6, 0xbe, 0x1b, 0x76, 0x67, 0x93, 0xc5, 0x0e, 0x6e,
+	0x59, 0xd2, 0xa0, 0xb5, 0xbb, 0xa9, 0x00, 0x69, 0x85, 0x54, 0xfc, 0x31, 0x6d, 0xbc, 0x4a, 0x6c,
+	0x6b, 0x3c, 0x4e, 0xba, 0xbd, 0x8c, 0xc6, 0x9e, 0x77, 0xed, 0xd1, 0xda, 0xf3, 0x9a, 0x99, 0xd7,
+	0x49, 0xfd, 0x1f, 0x54, 0x5c, 0xe8, 0x91, 0x03, 0x88, 0x4a, 0x5c, 0x10, 0xa7, 0x4a, 0x54, 0x1c,
+	0x38, 0x21, 0x81, 0xc4, 0x8a, 0x53, 0xc5, 0x89, 0x13, 0x45, 0xbb, 0x87, 0x22, 0x0e, 0x9c, 0xf8,
+	0x03, 0xd0, 0xfb, 0x31, 0x8e, 0xed, 0xc4, 0x0e, 0xa9, 0x5a, 0x2e, 0x2b, 0xbf, 0xef, 0xef, 0xf7,
+	0x7c, 0xbf, 0xcf, 0x33, 0xcf, 0x06, 0x76, 0xba, 0xc4, 0x1f, 0x12, 0xbf, 0xd8, 0xf3, 0xc8, 0x78,
+	0x54, 0x3c, 0x7b, 0x54, 0xa4, 0x93, 0x11, 0xf6, 0x0b, 0x23, 0x8f, 0x50, 0x82, 0xd2, 0x02, 0x2c,
+	0x70, 0xb0, 0x70, 0xf6, 0x68, 0x7b, 0xab, 0x47, 0x7a, 0x84, 0x63, 0x45, 0xf6, 0x4b, 0xd0, 0xb6,
+	0xb3, 0x3d, 0x42, 0x7a, 0x03, 0x5c, 0xe4, 0xa7, 0xce, 0xf8, 0x59, 0xd1, 0x1e, 0x7b, 0x16, 0x75,
+	0x88, 0x2b, 0xf1, 0xdc, 0x22, 0x4e, 0x9d, 0x21, 0xf6, 0xa9, 0x35, 0x1c, 0x49, 0xc2, 0x3d, 0x61,
+	0xc7, 0x14, 0x9a, 0xa5, 0x51, 0x09, 0x2d, 0xca, 0x5a, 0xee, 0x44, 0x42, 0xb7, 0xac, 0xa1, 0xe3,
+	0x92, 0x22, 0xff, 0x37, 0xf0, 0x44, 0x46, 0xd3, 0xb1, 0x7c, 0x5c, 0x3c, 0x7b, 0xd4, 0xc1, 0xd4,
+	0x7a, 0x54, 0xec, 0x12, 0x47, 0x7a, 0x92, 0xff, 0x9d, 0x02, 0xb1, 0x63, 0x3c, 0xec, 0x60, 0x0f,
+	0x1d, 0xc0, 0xba, 0x65, 0xdb, 0x1e, 0xf6, 0x7d, 0x55, 0xd9, 0x55, 0xf6, 0x12, 0x65, 0xf5, 0xaf,
+	0x9f, 0x3e, 0xdc, 0x92, 0xb6, 0x4b, 0x02, 0x69, 0x51, 0xcf, 0x71, 0x7b, 0x7a, 0x40, 0x44, 0x77,
+	0x20, 0x76, 0x8e, 0x9d, 0x5e, 0x9f, 0xaa, 0x21, 0x26, 0xa2, 0xcb, 0x13, 0xda, 0x86, 0xf8, 0x10,
+	0x53, 0xcb, 0xb6, 0xa8, 0xa5, 0x86, 0x39, 0x32, 0x3d, 0xa3, 0x2a, 0xc4, 0x2d, 0xdb, 0xc6, 0xb6,
+	0x69, 0x51, 0x35, 0xb2, 0xab, 0xec, 0x25, 0x0f, 0xb6, 0x0b, 0x22, 0xa6, 0x42, 0x10, 0x53, 0xc1,
+	0x08, 0xf2, 0x51, 0xde, 0x7c, 0xf1, 0xf7, 0xdc, 0xda, 0x47, 0x9f, 0xe7, 0x94, 0x5f, 0x7f, 0xf1,
+	0xc9, 0xbe, 0xc2, 0x2d, 0x63, 0xbb, 0x44, 0xf3, 0xe7, 0xb0, 0x29, 0xfc, 0xd6, 0xf1, 0x8f, 0xc7,
+	0xd8, 0xa7, 0xff, 0x2f, 0xf7, 0xf3, 0x7f, 0x52, 0xe0, 0xae, 0xd1, 0xf7, 0xb0, 0xdf, 0x27, 0x03,
+	0xbb, 0x8a, 0xbb, 0x8e, 0xef, 0x10, 0xb7, 0x49, 0x06, 0x4e, 0x77, 0x82, 0xee, 0x43, 0x82, 0x06,
+	0x90, 0xf0, 0x42, 0xbf, 0xb8, 0x40, 0x3f, 0x82, 0xf5, 0x73, 0xc7, 0xb5, 0xc9, 0xb9, 0xcf, 0xcd,
+	0x25, 0x0f, 0x1e, 0x14, 0x16, 0x9e, 0x53, 0x61, 0x5e, 0xdf, 0xa9, 0x60, 0xeb, 0x81, 0xd8, 0xe3,
+	0xda, 0x5f, 0x3e, 0x7d, 0x98, 0x5d, 0x2d, 0xf3, 0x93, 0x2f, 0x3e, 0xd9, 0xcf, 0x0b, 0xca, 0x43,
+	0xdf, 0x7e, 0x5e, 0x5c, 0xe2, 0x6a, 0xfe, 0x85, 0x02, 0x6a, 0x13, 0x7b, 0x5d, 0xec, 0x52, 0xab,
+	0x87, 0x17, 0xe2, 0xc8, 0x02, 0x8c, 0xa6, 0x98, 0x0c, 0x64, 0xe6, 0xe6, 0x2b, 0x88, 0xe4, 0xc9,
+	0xff, 0x16, 0xc9, 0x6b, 0x33, 0x91, 0x2c, 0xf3, 0x36, 0xff, 0xf3, 0x10, 0x7c, 0xe3, 0x4a, 0x73,
+	0xe8, 0x18, 0x36, 0xcf, 0x08, 0x75, 0xdc, 0x9e, 0x39, 0xc2, 0x9e, 0x43, 0x44, 0x4d, 0x92, 0x07,
+	0xf7, 0x2e, 0xbd, 0xb7, 0xaa, 0xec, 0x4f, 0xf1, 0xdc, 0x7e, 0x36, 0x7d, 0x6e, 0x1b, 0x42, 0xbc,
+	0xc9, 0xa5, 0xd1, 0xfb, 0xb0, 0x35, 0x74, 0x5c, 0x13, 0x7f, 0x80, 0xbb, 0x63, 0xc6, 0x0e, 0xb4,
+	0x86, 0x6e, 0xa8, 0x15, 0x0d, 0x1d, 0x57, 0x0b, 0x94, 0x48, 0xdd, 0xef, 0xc1, 0xed, 0x79, 0xdd,
+	0x36, 0x1e, 0x58, 0x13, 0x35, 0x7c, 0x43, 0xd5, 0xb7, 0x66, 0x55, 0x57, 0x99, 0x8a, 0xfc, 0xbf,
+	0x15, 0x48, 0xbc, 0xcb, 0x52, 0x5c, 0x73, 0x9f, 0x11, 0x94, 0x82, 0x90, 0x23, 0xf2, 0x10, 0xd1,
+	0x43, 0x8e, 0x8d, 0x0a, 0x10, 0xb5, 0xec, 0xa1, 0xe3, 0xaa, 0xa1, 0x6b, 0x9a, 0x46, 0xd0, 0x56,
+	0x76, 0xb6, 0x0a, 0xeb, 0x67, 0xd8, 0x63, 0x65, 0xe0, 0x8d, 0x1d, 0xd1, 0x83, 0x23, 0xfa, 0x16,
+	0x6c, 0x50, 0x42, 0xad, 0x81, 0x29, 0xdb, 0x2d, 0xca, 0x25, 0x93, 0xfc, 0xee, 0x54, 0xf4, 0xdc,
+	0x21, 0x40, 0xd7, 0xc3, 0x16, 0x15, 0x83, 0x21, 0x76, 0xd3, 0xc1, 0x90, 0x90, 0xc2, 0x25, 0x9a,
+	0x7f, 0x0a, 0x49, 0x1e, 0xaf, 0x9c, 0x6b, 0xf7, 0x20, 0xce, 0x5f, 0x98, 0x39, 0x8d, 0x7b, 0x9d,
+	0x9f, 0x6b, 0x36, 0x2a, 0x42, 0x6c, 0xc8, 0x49, 0xb2, 0x84, 0x77, 0x2f, 0x3d, 0x63, 0x39, 0x63,
+	0x24, 0x2d, 0xff, 0xdb, 0x28, 0xa4, 0xb9, 0x6e, 0xf1, 0xce, 0x78, 0x46, 0xbf, 0xcc, 0xe0, 0x99,
+	0xf5, 0x29, 0x34, 0xef, 0xd3, 0xb4, 0x20, 0xe1, 0x9b, 0x17, 0x24, 0xb2, 0xbc, 0x20, 0xd1, 0xf9,
+	0x82, 0x58, 0x90, 0xb6, 0x65, 0xcb, 0x98, 0x23, 0x1e, 0x8b, 0x4c, 0xf9, 0xd6, 0xa5, 0x94, 0x97,
+	0xdc, 0x49, 0x39, 0x7f, 0x7d, 0xbb, 0xea, 0x29, 0x7b, 0xee, 0xbc, 0x50, 0xd0, 0xf5, 0x2f, 0x5f,
+	0x50, 0xd4, 0x83, 0x6d, 0x91, 0x7f, 0xbf, 0xef, 0x8c, 0xcc, 0x6e, 0xdf, 0x72, 0x7b, 0xd8, 0xec,
+	0xe0, 0xbe, 0x75, 0xe6, 0x10, 0x4f, 0x8d, 0xef, 0x2a, 0x7b, 0xa9, 0x83, 0x37, 0x96, 0x94, 0x8e,
+	0x89, 0x54, 0xb8, 0x44, 0x59, 0x0a, 0xe8, 0xea, 0x70, 0x09, 0x82, 0xde, 0x86, 0xd4, 0x19, 0xa6,
+	0xc4, 0xb4, 0xc6, 0xb4, 0x4f, 0x3c, 0x87, 0x4e, 0xd4, 0xc4, 0x35, 0x45, 0xd8, 0x64, 0xfc, 0x52,
+	0x40, 0x47, 0x3f, 0x84, 0xa4, 0x3f, 0xc2, 0xae, 0x6d, 0x0e, 0x9c, 0xa1, 0x43, 0x55, 0xe0, 0x41,
+	0xef, 0x5c, 0x72, 0xad, 0xc5, 0x38, 0x47, 0x8c, 0xa2, 0x83, 0x3f, 0xfd, 0x8d, 0x8e, 0xe0, 0xd6,
+	0x8c, 0xb4, 0x89, 0x47, 0xa4, 0xdb, 0x57, 0x93, 0x5c, 0xc7, 0xee, 0x0a, 0x1d, 0x1a, 0xe3, 0xe9,
+	0x69, 0x7f, 0xfe, 0xe2, 0x71, 0xfc, 0xc3, 0x8f, 0x73, 0x6b, 0xff, 0xfc, 0x38, 0xa7, 0xe4, 0xff,
+	0x1c, 0x02, 0xb8, 0xa0, 0x23, 0x1f, 0x36, 0x46, 0xd8, 0x63, 0xbb, 0xc5, 0x88, 0xf8, 0xd6, 0x40,
+	0x55, 0x76, 0xc3, 0x7c, 0xc6, 0x48, 0x0b, 0x6c, 0x55, 0x28, 0xc8, 0x55, 0xa1, 0x50, 0x21, 0x8e,
+	0x5b, 0xfe, 0x1e, 0xab, 0xcc, 0x6f, 0x3e, 0xcf, 0xed, 0xf5, 0x1c, 0xda, 0x1f, 0x77, 0x0a, 0x5d,
+	0x32, 0x94, 0x3b, 0x49, 0x71, 0x66, 0x3e, 0x8b, 0x3d, 0x89, 0x09, 0xf8, 0xa2, 0x82, 0xc9, 0x11,
+	0xf6, 0x9a, 0xd2, 0x08, 0x1a, 0x42, 0x82, 0x19, 0x15, 0x31, 0x85, 0xbe, 0x26, 0x8b, 0xf1, 0x11,
+	0xf6, 0x78, 0xf0, 0xa8, 0x01, 0x29, 0x6e, 0xca, 0x0c, 0x36, 0xaf, 0x1b, 0x4f, 0xd2, 0x4d, 0x2e,
+	0x1f, 0xa0, 0x8f, 0x23, 0x3c, 0x93, 0x7f, 0x50, 0x20, 0xbd, 0x90, 0x78, 0xf4, 0x36, 0x44, 0x7d,
+	0x6a, 0x79, 0x54, 0x55, 0x6e, 0xfa, 0xc4, 0x85, 0x1c, 0x7a, 0x06, 0x51, 0x56, 0x3b, 0xfa, 0xb5,
+	0xa5, 0x45, 0xa8, 0x97, 0x21, 0xfc, 0x31, 0x06, 0xf1, 0x69, 0x55, 0x16, 0xbf, 0x06, 0x4f, 0x60,
+	0x4b, 0xcc, 0x25, 0x31, 0x13, 0xcc, 0x60, 0xb0, 0x5d, 0xf7, 0x71, 0x40, 0xbd, 0x8b, 0xa1, 0x28,
+	0x91, 0x95, 0x5f, 0x8a, 0xef, 0x43, 0x42, 0x3c, 0x3f, 0xec, 0xf9, 0x6a, 0x64, 0x37, 0xbc, 0x52,
+	0xf9, 0x05, 0x15, 0x3d, 0x81, 0xa4, 0x3f, 0xee, 0xb0, 0xe6, 0x60, 0x1b, 0xb3, 0x1a, 0xbd, 0x69,
+	0xc6, 0x41, 0x48, 0x33, 0x1c, 0xbd, 0x06, 0x9b, 0x22, 0xd6, 0x60, 0x44, 0xc6, 0x78, 0x1a, 0x36,
+	0xf8, 0xe5, 0x89, 0xb8, 0x43, 0x6f, 0x2e, 0x24, 0x24, 0xe0, 0xae, 0x73, 0xee, 0x6c, 0xd8, 0x81,
+	0xc4, 0x0f, 0x20, 0xe6, 0x53, 0x8b, 0x8e, 0x7d, 0x39, 0x98, 0x72, 0x97, 0x3a, 0x37, 0xc8, 0x7e,
+	0x8b, 0xd3, 0x74, 0x49, 0x47, 0x6d, 0x40, 0xcf, 0x1c, 0xd7, 0x1a, 0x98, 0xd4, 0x1a, 0x0c, 0x26,
+	0xa6, 0x87, 0xfd, 0xf1, 0x80, 0xf2, 0x01, 0x94, 0x3c, 0xb8, 0x7f, 0x49, 0x89, 0xc1, 0x48, 0x3a,
+	0xe7, 0x94, 0x13, 0x2c, 0x48, 0x11, 0x60, 0x86, 0xab, 0x98, 0x01, 0x51, 0x1b, 0x6e, 0xcd, 0xed,
+	0x40, 0x26, 0x76, 0x6d, 0x15, 0x6e, 0x9a, 0xb8, 0xf4, 0xec, 0x22, 0xa4, 0xb9, 0x36, 0x6a, 0x42,
+	0x5a, 0xec, 0x2a, 0xc4, 0x0b, 0x5c, 0x4d, 0xf2, 0x78, 0xbf, 0xb3, 0x34, 0x5e, 0x4d, 0xf2, 0x85,
+	0x63, 0x7a, 0x0a, 0xcf, 0x9d, 0xd1, 0x9b, 0xec, 0xbd, 0xf8, 0xbe, 0xd5, 0xc3, 0xbe, 0xba, 0xb1,
+	0x1b, 0x5e, 0xf6, 0x2d, 0xd2, 0xa7, 0x2c, 0xb4, 0x05, 0x51, 0xea, 0xd0, 0x01, 0x56, 0x37, 0xf9,
+	0xf3, 0x12, 0x07, 0xf6, 0xd1, 0xf3, 0xc7, 0xc3, 0xa1, 0xe5, 0x4d, 0xd4, 0x14, 0xbf, 0x0f, 0x8e,
+	0xa8, 0x02, 0x1b, 0xec, 0xd9, 0x0c, 0x48, 0xf7, 0x39, 0xcf, 0x42, 0xfa, 0xda, 0x2c, 0x44, 0x58,
+	0x06, 0xf4, 0x64, 0x20, 0xa5, 0xb9, 0xf6, 0xe3, 0x08, 0x1b, 0xab, 0xf9, 0x5f, 0x28, 0x90, 0x9c,
+	0xcd, 0xf2, 0x0e, 0x24, 0x26, 0xd8, 0x37, 0xbb, 0x64, 0xec, 0x52, 0xb9, 0x30, 0xc7, 0x27, 0xd8,
+	0xaf, 0xb0, 0x33, 0x7b, 0x69, 0x56, 0xc7, 0xa7, 0x96, 0xe3, 0x4a, 0x82, 0xf8, 0xdf, 0xc6, 0x86,
+	0xbc, 0x14, 0xa4, 0x7b, 0x10, 0x77, 0x89, 0xc4, 0x45, 0xbb, 0xac, 0xbb, 0x44, 0x40, 0xdf, 0x05,
+	0xe4, 0x12, 0xf3, 0xdc, 0xa1, 0x7d, 0x93, 0x7f, 0x9e, 0x04, 0x49, 0x7c, 0xec, 0xd3, 0x2e, 0x39,
+	0x75, 0x68, 0xff, 0x04, 0x53, 0x41, 0x96, 0xfe, 0xfd, 0x47, 0x81, 0xc8, 0x09, 0xa1, 0x18, 0xe5,
+	0x20, 0x19, 0x0c, 0xfa, 0x8b, 0x05, 0x08, 0x82, 0x2b, 0xb1, 0x6f, 0x9c, 0x11, 0x2a, 0x57, 0xa0,
+	0x95, 0xfb, 0x06, 0xa7, 0xa1, 0xb7, 0x20, 0x46, 0x46, 0xd3, 0x89, 0x9a, 0xba, 0xe2, 0xeb, 0xc6,
+	0xec, 0x36, 0x38, 0x45, 0x97, 0xd4, 0x95, 0x4b, 0xca, 0x57, 0xd8, 0xd3, 0xfb, 0x3f, 0x55, 0x00,
+	0x2e, 0xcc, 0xa3, 0x1d, 0xb8, 0x7b, 0xd2, 0x30, 0x34, 0xb3, 0xd1, 0x34, 0x6a, 0x8d, 0xba, 0xd9,
+	0xae, 0xb7, 0x9a, 0x5a, 0xa5, 0xf6, 0x4e, 0x4d, 0xab, 0x66, 0xd6, 0xd0, 0x6d, 0x48, 0xcf, 0x82,
+	0x4f, 0xb5, 0x56, 0x46, 0x41, 0x77, 0xe1, 0xf6, 0xec, 0x65, 0xa9, 0xdc, 0x32, 0x4a, 0xb5, 0x7a,
+	0x26, 0x84, 0x10, 0xa4, 0x66, 0x81, 0x7a, 0x23, 0x13, 0x46, 0xf7, 0x41, 0x9d, 0xbf, 0x33, 0x4f,
+	0x6b, 0xc6, 0xa1, 0x79, 0xa2, 0x19, 0x8d, 0x4c, 0x64, 0x3b, 0xf2, 0xe1, 0xaf, 0xb2, 0x6b, 0xfb,
+	0xbf, 0x54, 0x40, 0x5d, 0xb6, 0x89, 0xa0, 0x7d, 0x78, 0x70, 0xac, 0x1d, 0x97, 0x35, 0xbd, 0x75,
+	0x58, 0x6b, 0x9a, 0x95, 0xc3, 0x52, 0xfd, 0x5d, 0xcd, 0x2c, 0x6b, 0x87, 0xa5, 0x93, 0x5a, 0x43,
+	0x5f, 0x70, 0xf7, 0x01, 0xe4, 0x57, 0x70, 0x75, 0xcd, 0x28, 0x1d, 0x1d, 0x3d, 0xcd, 0x28, 0xe8,
+	0x0d, 0xf8, 0xf6, 0x0a, 0x5e, 0xad, 0x7e, 0x52, 0x3a, 0xaa, 0x55, 0x4b, 0x86, 0x96, 0x09, 0x49,
+	0x0f, 0xff, 0xa5, 0x40, 0x6a, 0x7e, 0x24, 0xa1, 0x1c, 0xec, 0x34, 0xf5, 0x46, 0xb3, 0xd1, 0x2a,
+	0x1d, 0x99, 0x2d, 0xa3, 0x64, 0xb4, 0x5b, 0x0b, 0xce, 0x7c, 0x13, 0xee, 0x2d, 0x12, 0x5a, 0xed,
+	0xf2, 0x71, 0xcd, 0x30, 0xb4, 0x6a, 0x46, 0x61, 0x89, 0x59, 0x84, 0x4b, 0x95, 0x8a, 0xd6, 0x64,
+	0x68, 0xe8, 0x2a, 0x54, 0xd7, 0x9e, 0x68, 0x15, 0x86, 0x86, 0x59, 0xcd, 0x2e, 0xc9, 0x96, 0x1b,
+	0x3a, 0x03, 0x23, 0x57, 0xd9, 0x65, 0x29, 0xaf, 0xea, 0xa5, 0xd3, 0x7a, 0x26, 0x8a, 0xb6, 0xe1,
+	0xce, 0x22, 0xcc, 0x8a, 0xa1, 0x55, 0x33, 0x31, 0x19, 0xec, 0xef, 0x15, 0xb8, 0x73, 0xf5, 0x3c,
+	0x42, 0x7b, 0xf0, 0xfa, 0x54, 0x58, 0x7b, 0x4f, 0xab, 0xb4, 0x0d, 0x9e, 0xd7, 0x56, 0xfb, 0xc8,
+	0x58, 0x88, 0xfe, 0x75, 0xd8, 0x5d, 0xca, 0xac, 0x37, 0x0c, 0x53, 0x6f, 0xd7, 0x33, 0xca, 0x4a,
+	0x56, 0xab, 0x5d, 0xa9, 0x68, 0xad, 0x56, 0x26, 0xb4, 0x92, 0xf5, 0x4e, 0xa9, 0x76, 0xd4, 0xd6,
+	0xb5, 0x4c, 0x58, 0x38, 0x5f, 0x2e, 0xbc, 0x78, 0x99, 0x55, 0x3e, 0x7b, 0x99, 0x55, 0xfe, 0xf1,
+	0x32, 0xab, 0x7c, 0xf4, 0x2a, 0xbb, 0xf6, 0xd9, 0xab, 0xec, 0xda, 0xdf, 0x5e, 0x65, 0xd7, 0xde,
+	0x97, 0x1d, 0xeb, 0xdb, 0xcf, 0x0b, 0x0e, 0x29, 0x7e, 0x20, 0xfe, 0x78, 0xd5, 0x89, 0xf1, 0xe6,
+	0x79, 0xeb, 0xbf, 0x03, 0x00, 0xcc, 0x1e, 0x97, 0x16, 0xd3, 0x12, 0x00, 0x00,
 }
 
 func (this *GroupPolicyInfo) Equal(that interface{}) bool {
@@ -1049,6 +1295,90 @@ func (this *GroupPolicyInfo) Equal(that interface{}) bool {
 	if !this.CreatedAt.Equal(that1.CreatedAt) {
 		return false
 	}
+	if this.MembershipChangeBehavior != that1.MembershipChangeBehavior {
+		return false
+	}
+	if this.VetoAuthority != that1.VetoAuthority {
+		return false
+	}
+	if !this.SpendLimit.Equal(that1.SpendLimit) {
+		return false
+	}
+	if !this.SpendLimitEpoch.Equal(that1.SpendLimitEpoch) {
+		return false
+	}
+	return true
+}
+func (this *SpendLimit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SpendLimit)
+	if !ok {
+		that2, ok := that.(SpendLimit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.PerProposal) != len(that1.PerProposal) {
+		return false
+	}
+	for i := range this.PerProposal {
+		if !this.PerProposal[i].Equal(&that1.PerProposal[i]) {
+			return false
+		}
+	}
+	if len(this.PerEpoch) != len(that1.PerEpoch) {
+		return false
+	}
+	for i := range this.PerEpoch {
+		if !this.PerEpoch[i].Equal(&that1.PerEpoch[i]) {
+			return false
+		}
+	}
+	if this.EpochDuration != that1.EpochDuration {
+		return false
+	}
+	return true
+}
+func (this *SpendLimitEpoch) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SpendLimitEpoch)
+	if !ok {
+		that2, ok := that.(SpendLimitEpoch)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Start.Equal(that1.Start) {
+		return false
+	}
+	if len(this.Spent) != len(that1.Spent) {
+		return false
+	}
+	for i := range this.Spent {
+		if !this.Spent[i].Equal(&that1.Spent[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (m *Member) Marshal() (dAtA []byte, err error) {
@@ -1251,21 +1581,29 @@ func (m *DecisionPolicyWindows) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinExecutionPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinExecutionPeriod):])
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinExecutionDelay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinExecutionDelay):])
 	if err4 != nil {
 		return 0, err4
 	}
 	i -= n4
 	i = encodeVarintTypes(dAtA, i, uint64(n4))
 	i--
-	dAtA[i] = 0x12
-	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VotingPeriod):])
+	dAtA[i] = 0x1a
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinExecutionPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinExecutionPeriod):])
 	if err5 != nil {
 		return 0, err5
 	}
 	i -= n5
 	i = encodeVarintTypes(dAtA, i, uint64(n5))
 	i--
+	dAtA[i] = 0x12
+	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VotingPeriod):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintTypes(dAtA, i, uint64(n6))
+	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
@@ -1290,12 +1628,12 @@ func (m *GroupInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
-	if err6 != nil {
-		return 0, err6
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
+	if err7 != nil {
+		return 0, err7
 	}
-	i -= n6
-	i = encodeVarintTypes(dAtA, i, uint64(n6))
+	i -= n7
+	i = encodeVarintTypes(dAtA, i, uint64(n7))
 	i--
 	dAtA[i] = 0x32
 	if len(m.TotalWeight) > 0 {
@@ -1392,12 +1730,48 @@ func (m *GroupPolicyInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
-	if err8 != nil {
-		return 0, err8
+	if m.SpendLimitEpoch != nil {
+		{
+			size, err := m.SpendLimitEpoch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.SpendLimit != nil {
+		{
+			size, err := m.SpendLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
 	}
-	i -= n8
-	i = encodeVarintTypes(dAtA, i, uint64(n8))
+	if len(m.VetoAuthority) > 0 {
+		i -= len(m.VetoAuthority)
+		copy(dAtA[i:], m.VetoAuthority)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.VetoAuthority)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.MembershipChangeBehavior != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MembershipChangeBehavior))
+		i--
+		dAtA[i] = 0x40
+	}
+	n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintTypes(dAtA, i, uint64(n11))
 	i--
 	dAtA[i] = 0x3a
 	if m.DecisionPolicy != nil {
@@ -1446,7 +1820,7 @@ func (m *GroupPolicyInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Proposal) Marshal() (dAtA []byte, err error) {
+func (m *SpendLimit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1456,34 +1830,28 @@ func (m *Proposal) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Proposal) MarshalTo(dAtA []byte) (int, error) {
+func (m *SpendLimit) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SpendLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Summary) > 0 {
-		i -= len(m.Summary)
-		copy(dAtA[i:], m.Summary)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Summary)))
-		i--
-		dAtA[i] = 0x72
-	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0x6a
+	n13, err13 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.EpochDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.EpochDuration):])
+	if err13 != nil {
+		return 0, err13
 	}
-	if len(m.Messages) > 0 {
-		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+	i -= n13
+	i = encodeVarintTypes(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.PerEpoch) > 0 {
+		for iNdEx := len(m.PerEpoch) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.PerEpoch[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1491,53 +1859,173 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintTypes(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x62
+			dAtA[i] = 0x12
 		}
 	}
-	if m.ExecutorResult != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.ExecutorResult))
-		i--
-		dAtA[i] = 0x58
-	}
-	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.VotingPeriodEnd, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.VotingPeriodEnd):])
-	if err10 != nil {
-		return 0, err10
-	}
-	i -= n10
-	i = encodeVarintTypes(dAtA, i, uint64(n10))
-	i--
-	dAtA[i] = 0x52
-	{
-		size, err := m.FinalTallyResult.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.PerProposal) > 0 {
+		for iNdEx := len(m.PerProposal) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PerProposal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i -= size
-		i = encodeVarintTypes(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x4a
-	if m.Status != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x40
 	}
-	if m.GroupPolicyVersion != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.GroupPolicyVersion))
-		i--
-		dAtA[i] = 0x38
+	return len(dAtA) - i, nil
+}
+
+func (m *SpendLimitEpoch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpendLimitEpoch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpendLimitEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Spent) > 0 {
+		for iNdEx := len(m.Spent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Start, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Start):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintTypes(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Proposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Proposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimelockEnd != nil {
+		n15, err15 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.TimelockEnd, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.TimelockEnd):])
+		if err15 != nil {
+			return 0, err15
+		}
+		i -= n15
+		i = encodeVarintTypes(dAtA, i, uint64(n15))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.Summary) > 0 {
+		i -= len(m.Summary)
+		copy(dAtA[i:], m.Summary)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Summary)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.ExecutorResult != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExecutorResult))
+		i--
+		dAtA[i] = 0x58
+	}
+	n16, err16 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.VotingPeriodEnd, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.VotingPeriodEnd):])
+	if err16 != nil {
+		return 0, err16
+	}
+	i -= n16
+	i = encodeVarintTypes(dAtA, i, uint64(n16))
+	i--
+	dAtA[i] = 0x52
+	{
+		size, err := m.FinalTallyResult.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.Status != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.GroupPolicyVersion != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GroupPolicyVersion))
+		i--
+		dAtA[i] = 0x38
 	}
 	if m.GroupVersion != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.GroupVersion))
 		i--
 		dAtA[i] = 0x30
 	}
-	n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmitTime):])
-	if err12 != nil {
-		return 0, err12
+	n18, err18 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmitTime):])
+	if err18 != nil {
+		return 0, err18
 	}
-	i -= n12
-	i = encodeVarintTypes(dAtA, i, uint64(n12))
+	i -= n18
+	i = encodeVarintTypes(dAtA, i, uint64(n18))
 	i--
 	dAtA[i] = 0x2a
 	if len(m.Proposers) > 0 {
@@ -1642,12 +2130,12 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmitTime):])
-	if err13 != nil {
-		return 0, err13
+	n19, err19 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmitTime):])
+	if err19 != nil {
+		return 0, err19
 	}
-	i -= n13
-	i = encodeVarintTypes(dAtA, i, uint64(n13))
+	i -= n19
+	i = encodeVarintTypes(dAtA, i, uint64(n19))
 	i--
 	dAtA[i] = 0x2a
 	if len(m.Metadata) > 0 {
@@ -1776,6 +2264,8 @@ func (m *DecisionPolicyWindows) Size() (n int) {
 	n += 1 + l + sovTypes(uint64(l))
 	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinExecutionPeriod)
 	n += 1 + l + sovTypes(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinExecutionDelay)
+	n += 1 + l + sovTypes(uint64(l))
 	return n
 }
 
@@ -1854,6 +2344,61 @@ func (m *GroupPolicyInfo) Size() (n int) {
 	}
 	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt)
 	n += 1 + l + sovTypes(uint64(l))
+	if m.MembershipChangeBehavior != 0 {
+		n += 1 + sovTypes(uint64(m.MembershipChangeBehavior))
+	}
+	l = len(m.VetoAuthority)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.SpendLimit != nil {
+		l = m.SpendLimit.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.SpendLimitEpoch != nil {
+		l = m.SpendLimitEpoch.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *SpendLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PerProposal) > 0 {
+		for _, e := range m.PerProposal {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.PerEpoch) > 0 {
+		for _, e := range m.PerEpoch {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.EpochDuration)
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *SpendLimitEpoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Start)
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.Spent) > 0 {
+		for _, e := range m.Spent {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1912,6 +2457,10 @@ func (m *Proposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.TimelockEnd != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.TimelockEnd)
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -2627,6 +3176,39 @@ func (m *DecisionPolicyWindows) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinExecutionDelay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MinExecutionDelay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -3202,61 +3784,11 @@ func (m *GroupPolicyInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Proposal) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MembershipChangeBehavior", wireType)
 			}
-			m.Id = 0
+			m.MembershipChangeBehavior = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3266,14 +3798,14 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.MembershipChangeBehavior |= MembershipChangeBehavior(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupPolicyAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoAuthority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3301,13 +3833,13 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupPolicyAddress = string(dAtA[iNdEx:postIndex])
+			m.VetoAuthority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3317,7 +3849,448 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SpendLimit == nil {
+				m.SpendLimit = &SpendLimit{}
+			}
+			if err := m.SpendLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimitEpoch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SpendLimitEpoch == nil {
+				m.SpendLimitEpoch = &SpendLimitEpoch{}
+			}
+			if err := m.SpendLimitEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpendLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpendLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpendLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerProposal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PerProposal = append(m.PerProposal, types.Coin{})
+			if err := m.PerProposal[len(m.PerProposal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerEpoch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PerEpoch = append(m.PerEpoch, types.Coin{})
+			if err := m.PerEpoch[len(m.PerEpoch)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.EpochDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpendLimitEpoch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpendLimitEpoch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpendLimitEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Start, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spent = append(m.Spent, types.Coin{})
+			if err := m.Spent[len(m.Spent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Proposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupPolicyAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupPolicyAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3640,6 +4613,42 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			}
 			m.Summary = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimelockEnd", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TimelockEnd == nil {
+				m.TimelockEnd = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.TimelockEnd, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -30,6 +30,17 @@ func TestThresholdDecisionPolicyValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"min exec delay too big",
+			group.ThresholdDecisionPolicy{
+				Threshold: "5",
+				Windows: &group.DecisionPolicyWindows{
+					VotingPeriod:      time.Hour,
+					MinExecutionDelay: time.Hour * 24 * 30,
+				},
+			},
+			true,
+		},
 		{
 			"all good",
 			group.ThresholdDecisionPolicy{
@@ -73,6 +84,17 @@ func TestPercentageDecisionPolicyValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"min exec delay too big",
+			group.PercentageDecisionPolicy{
+				Percentage: "0.5",
+				Windows: &group.DecisionPolicyWindows{
+					VotingPeriod:      time.Hour,
+					MinExecutionDelay: time.Hour * 24 * 30,
+				},
+			},
+			true,
+		},
 		{
 			"all good",
 			group.PercentageDecisionPolicy{
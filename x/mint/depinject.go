@@ -71,8 +71,11 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		as,
 	)
 
-	// when no inflation calculation function is provided it will use the default types.DefaultInflationCalculationFn
-	m := NewAppModule(in.Cdc, k, in.AccountKeeper, in.InflationCalculationFn)
+	// when no inflation calculation function is provided it will use the built-in strategy selected by the params
+	if in.InflationCalculationFn != nil {
+		k.SetInflationCalculationFn(in.InflationCalculationFn)
+	}
+	m := NewAppModule(in.Cdc, k, in.AccountKeeper, nil)
 
 	return ModuleOutputs{MintKeeper: k, Module: m}
 }
@@ -12,7 +12,7 @@ import (
 )
 
 // BeginBlocker mints new tokens for the previous block.
-func (k Keeper) BeginBlocker(ctx context.Context, ic types.InflationCalculationFn) error {
+func (k Keeper) BeginBlocker(ctx context.Context) error {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 
 	// fetch stored minter & params
@@ -37,7 +37,7 @@ func (k Keeper) BeginBlocker(ctx context.Context, ic types.InflationCalculationF
 		return err
 	}
 
-	minter.Inflation = ic(ctx, minter, params, bondedRatio)
+	minter.Inflation = k.InflationCalculationFn(params)(ctx, minter, params, bondedRatio)
 	minter.AnnualProvisions = minter.NextAnnualProvisions(params, totalStakingSupply)
 	if err = k.Minter.Set(ctx, minter); err != nil {
 		return err
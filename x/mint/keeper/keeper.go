@@ -25,6 +25,9 @@ type Keeper struct {
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
 	authority string
+	// inflationCalculationFn calculates the inflation rate during BeginBlock.
+	// If nil, the built-in strategy selected by the params is used.
+	inflationCalculationFn types.InflationCalculationFn
 
 	Schema collections.Schema
 	Params collections.Item[types.Params]
@@ -72,6 +75,32 @@ func (k Keeper) GetAuthority() string {
 	return k.authority
 }
 
+// SetInflationCalculationFn sets the function calculating the inflation rate
+// during BeginBlock, overriding the built-in strategy selected by the params.
+func (k *Keeper) SetInflationCalculationFn(ic types.InflationCalculationFn) {
+	k.inflationCalculationFn = ic
+}
+
+// InflationCalculationFn returns the function calculating the inflation rate
+// during BeginBlock: the one set by the app if any, or else the built-in
+// strategy selected by the params.
+func (k Keeper) InflationCalculationFn(params types.Params) types.InflationCalculationFn {
+	if k.inflationCalculationFn != nil {
+		return k.inflationCalculationFn
+	}
+
+	switch params.InflationStrategy {
+	case types.InflationStrategyFixed:
+		return types.FixedInflationCalculationFn
+	case types.InflationStrategyEpochDecay:
+		return func(ctx context.Context, minter types.Minter, params types.Params, _ math.LegacyDec) math.LegacyDec {
+			return minter.NextEpochInflationRate(params, k.environment.HeaderService.GetHeaderInfo(ctx).Height)
+		}
+	default:
+		return types.DefaultInflationCalculationFn
+	}
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx context.Context) log.Logger {
 	return k.environment.Logger.With("module", "x/"+types.ModuleName)
@@ -1,11 +1,13 @@
 package keeper_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
 
+	"cosmossdk.io/core/header"
 	"cosmossdk.io/log"
 	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
@@ -98,3 +100,34 @@ func (s *IntegrationTestSuite) TestAliasFunctions() {
 	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(s.ctx, types.ModuleName, authtypes.FeeCollectorName, fees).Return(nil)
 	s.Require().Nil(s.mintKeeper.AddCollectedFees(s.ctx, fees))
 }
+
+func (s *IntegrationTestSuite) TestInflationCalculationFn() {
+	params := types.DefaultParams()
+	params.EpochBlocks = 10
+	minter := types.InitialMinter(math.LegacyNewDecWithPrec(10, 2))
+	bondedRatio := math.LegacyOneDec()
+	ctx := s.ctx.WithHeaderInfo(header.Info{Height: 20})
+
+	// the bonded ratio strategy is used by default
+	inflation := s.mintKeeper.InflationCalculationFn(params)(ctx, minter, params, bondedRatio)
+	s.Require().Equal(minter.NextInflationRate(params, bondedRatio), inflation)
+
+	params.InflationStrategy = types.InflationStrategyFixed
+	inflation = s.mintKeeper.InflationCalculationFn(params)(ctx, minter, params, bondedRatio)
+	s.Require().Equal(params.InflationMax, inflation)
+
+	// the inflation rate is halved at the beginning of each epoch
+	params.InflationStrategy = types.InflationStrategyEpochDecay
+	inflation = s.mintKeeper.InflationCalculationFn(params)(ctx, minter, params, bondedRatio)
+	s.Require().Equal(params.InflationMin, inflation)
+	inflation = s.mintKeeper.InflationCalculationFn(params)(ctx.WithHeaderInfo(header.Info{Height: 21}), minter, params, bondedRatio)
+	s.Require().Equal(minter.Inflation, inflation)
+
+	// the function set by the app takes precedence over the params
+	custom := math.LegacyNewDecWithPrec(3, 2)
+	s.mintKeeper.SetInflationCalculationFn(func(context.Context, types.Minter, types.Params, math.LegacyDec) math.LegacyDec {
+		return custom
+	})
+	inflation = s.mintKeeper.InflationCalculationFn(params)(ctx, minter, params, bondedRatio)
+	s.Require().Equal(custom, inflation)
+}
@@ -57,6 +57,58 @@ func (s *IntegrationTestSuite) TestUpdateParams() {
 			},
 			expectErr: false,
 		},
+		{
+			name: "set invalid epoch decay params",
+			request: &types.MsgUpdateParams{
+				Authority: s.mintKeeper.GetAuthority(),
+				Params: types.Params{
+					MintDenom:           sdk.DefaultBondDenom,
+					InflationRateChange: sdkmath.LegacyNewDecWithPrec(8, 2),
+					InflationMax:        sdkmath.LegacyNewDecWithPrec(20, 2),
+					InflationMin:        sdkmath.LegacyNewDecWithPrec(2, 2),
+					GoalBonded:          sdkmath.LegacyNewDecWithPrec(37, 2),
+					BlocksPerYear:       uint64(60 * 60 * 8766 / 5),
+					InflationStrategy:   types.InflationStrategyEpochDecay,
+					EpochBlocks:         0,
+					EpochDecay:          sdkmath.LegacyNewDecWithPrec(5, 1),
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "set valid epoch decay params",
+			request: &types.MsgUpdateParams{
+				Authority: s.mintKeeper.GetAuthority(),
+				Params: types.Params{
+					MintDenom:           sdk.DefaultBondDenom,
+					InflationRateChange: sdkmath.LegacyNewDecWithPrec(8, 2),
+					InflationMax:        sdkmath.LegacyNewDecWithPrec(20, 2),
+					InflationMin:        sdkmath.LegacyNewDecWithPrec(2, 2),
+					GoalBonded:          sdkmath.LegacyNewDecWithPrec(37, 2),
+					BlocksPerYear:       uint64(60 * 60 * 8766 / 5),
+					InflationStrategy:   types.InflationStrategyEpochDecay,
+					EpochBlocks:         1000,
+					EpochDecay:          sdkmath.LegacyNewDecWithPrec(5, 1),
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name: "set unknown inflation strategy",
+			request: &types.MsgUpdateParams{
+				Authority: s.mintKeeper.GetAuthority(),
+				Params: types.Params{
+					MintDenom:           sdk.DefaultBondDenom,
+					InflationRateChange: sdkmath.LegacyNewDecWithPrec(8, 2),
+					InflationMax:        sdkmath.LegacyNewDecWithPrec(20, 2),
+					InflationMin:        sdkmath.LegacyNewDecWithPrec(2, 2),
+					GoalBonded:          sdkmath.LegacyNewDecWithPrec(37, 2),
+					BlocksPerYear:       uint64(60 * 60 * 8766 / 5),
+					InflationStrategy:   types.InflationStrategy(42),
+				},
+			},
+			expectErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
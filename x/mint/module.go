@@ -42,29 +42,25 @@ type AppModule struct {
 	cdc        codec.Codec
 	keeper     keeper.Keeper
 	authKeeper types.AccountKeeper
-
-	// inflationCalculator is used to calculate the inflation rate during BeginBlock.
-	// If inflationCalculator is nil, the default inflation calculation logic is used.
-	inflationCalculator types.InflationCalculationFn
 }
 
 // NewAppModule creates a new AppModule object.
-// If the InflationCalculationFn argument is nil, then the SDK's default inflation function will be used.
+// If the InflationCalculationFn argument is nil, then the built-in inflation
+// strategy selected by the module params will be used.
 func NewAppModule(
 	cdc codec.Codec,
 	keeper keeper.Keeper,
 	ak types.AccountKeeper,
 	ic types.InflationCalculationFn,
 ) AppModule {
-	if ic == nil {
-		ic = types.DefaultInflationCalculationFn
+	if ic != nil {
+		keeper.SetInflationCalculationFn(ic)
 	}
 
 	return AppModule{
-		cdc:                 cdc,
-		keeper:              keeper,
-		authKeeper:          ak,
-		inflationCalculator: ic,
+		cdc:        cdc,
+		keeper:     keeper,
+		authKeeper: ak,
 	}
 }
 
@@ -152,7 +148,7 @@ func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 
 // BeginBlock returns the begin blocker for the mint module.
 func (am AppModule) BeginBlock(ctx context.Context) error {
-	return am.keeper.BeginBlocker(ctx, am.inflationCalculator)
+	return am.keeper.BeginBlocker(ctx)
 }
 
 // AppModuleSimulation functions
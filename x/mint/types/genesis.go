@@ -14,10 +14,17 @@ import (
 type InflationCalculationFn func(ctx context.Context, minter Minter, params Params, bondedRatio math.LegacyDec) math.LegacyDec
 
 // DefaultInflationCalculationFn is the default function used to calculate inflation.
+// It implements the InflationStrategyBondedRatio strategy.
 func DefaultInflationCalculationFn(_ context.Context, minter Minter, params Params, bondedRatio math.LegacyDec) math.LegacyDec {
 	return minter.NextInflationRate(params, bondedRatio)
 }
 
+// FixedInflationCalculationFn implements the InflationStrategyFixed strategy,
+// fixing the inflation rate to the maximum inflation rate.
+func FixedInflationCalculationFn(_ context.Context, _ Minter, params Params, _ math.LegacyDec) math.LegacyDec {
+	return params.InflationMax
+}
+
 // NewGenesisState creates a new GenesisState object
 func NewGenesisState(minter Minter, params Params) *GenesisState {
 	return &GenesisState{
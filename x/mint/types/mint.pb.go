@@ -26,6 +26,43 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// InflationStrategy enumerates the built-in strategies calculating the
+// inflation rate.
+//
+// Since: cosmos-sdk 0.51
+type InflationStrategy int32
+
+const (
+	// INFLATION_STRATEGY_BONDED_RATIO moves the inflation rate between the
+	// minimum and maximum inflation rates towards the goal bonded ratio.
+	InflationStrategyBondedRatio InflationStrategy = 0
+	// INFLATION_STRATEGY_FIXED fixes the inflation rate to the maximum inflation rate.
+	InflationStrategyFixed InflationStrategy = 1
+	// INFLATION_STRATEGY_EPOCH_DECAY reduces the inflation rate by the epoch decay
+	// at the beginning of each epoch, down to the minimum inflation rate.
+	InflationStrategyEpochDecay InflationStrategy = 2
+)
+
+var InflationStrategy_name = map[int32]string{
+	0: "INFLATION_STRATEGY_BONDED_RATIO",
+	1: "INFLATION_STRATEGY_FIXED",
+	2: "INFLATION_STRATEGY_EPOCH_DECAY",
+}
+
+var InflationStrategy_value = map[string]int32{
+	"INFLATION_STRATEGY_BONDED_RATIO": 0,
+	"INFLATION_STRATEGY_FIXED":        1,
+	"INFLATION_STRATEGY_EPOCH_DECAY":  2,
+}
+
+func (x InflationStrategy) String() string {
+	return proto.EnumName(InflationStrategy_name, int32(x))
+}
+
+func (InflationStrategy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{0}
+}
+
 // Minter represents the minting state.
 type Minter struct {
 	// current annual inflation rate
@@ -81,6 +118,20 @@ type Params struct {
 	GoalBonded cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=goal_bonded,json=goalBonded,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"goal_bonded"`
 	// expected blocks per year
 	BlocksPerYear uint64 `protobuf:"varint,6,opt,name=blocks_per_year,json=blocksPerYear,proto3" json:"blocks_per_year,omitempty"`
+	// built-in strategy used to calculate the inflation rate, when the app does
+	// not provide its own inflation calculation function
+	//
+	// Since: cosmos-sdk 0.51
+	InflationStrategy InflationStrategy `protobuf:"varint,7,opt,name=inflation_strategy,json=inflationStrategy,proto3,enum=cosmos.mint.v1beta1.InflationStrategy" json:"inflation_strategy,omitempty"`
+	// number of blocks in an epoch of the epoch decay inflation strategy
+	//
+	// Since: cosmos-sdk 0.51
+	EpochBlocks uint64 `protobuf:"varint,8,opt,name=epoch_blocks,json=epochBlocks,proto3" json:"epoch_blocks,omitempty"`
+	// fraction the inflation rate is reduced by at the beginning of each epoch
+	// of the epoch decay inflation strategy
+	//
+	// Since: cosmos-sdk 0.51
+	EpochDecay cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=epoch_decay,json=epochDecay,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"epoch_decay"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -130,7 +181,22 @@ func (m *Params) GetBlocksPerYear() uint64 {
 	return 0
 }
 
+func (m *Params) GetInflationStrategy() InflationStrategy {
+	if m != nil {
+		return m.InflationStrategy
+	}
+	return InflationStrategyBondedRatio
+}
+
+func (m *Params) GetEpochBlocks() uint64 {
+	if m != nil {
+		return m.EpochBlocks
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("cosmos.mint.v1beta1.InflationStrategy", InflationStrategy_name, InflationStrategy_value)
 	proto.RegisterType((*Minter)(nil), "cosmos.mint.v1beta1.Minter")
 	proto.RegisterType((*Params)(nil), "cosmos.mint.v1beta1.Params")
 }
@@ -138,34 +204,45 @@ func init() {
 func init() { proto.RegisterFile("cosmos/mint/v1beta1/mint.proto", fileDescriptor_2df116d183c1e223) }
 
 var fileDescriptor_2df116d183c1e223 = []byte{
-	// 426 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x4f, 0x8b, 0xd3, 0x40,
-	0x18, 0xc6, 0x33, 0xee, 0x1a, 0xe8, 0xe8, 0xa2, 0x3b, 0xab, 0x90, 0x5d, 0xd9, 0xec, 0xb2, 0x07,
-	0x59, 0x0a, 0x4d, 0x28, 0x05, 0x0f, 0x1e, 0x6b, 0x8f, 0x16, 0x4b, 0x2e, 0xa2, 0x82, 0xe1, 0x4d,
-	0x32, 0xa6, 0x63, 0x93, 0x99, 0x32, 0x33, 0x96, 0xf6, 0x2b, 0x78, 0xf2, 0x63, 0x78, 0xec, 0xc1,
-	0x8b, 0xdf, 0xa0, 0xc7, 0xe2, 0x49, 0x3c, 0x14, 0x69, 0x0f, 0x3d, 0xf9, 0x1d, 0x24, 0x99, 0x90,
-	0xa2, 0x37, 0xed, 0x5e, 0x42, 0xe6, 0x79, 0xde, 0xf7, 0xf7, 0x3e, 0xcc, 0x1f, 0xec, 0xc6, 0x42,
-	0xe5, 0x42, 0xf9, 0x39, 0xe3, 0xda, 0x9f, 0xb4, 0x23, 0xaa, 0xa1, 0x5d, 0x2e, 0xbc, 0xb1, 0x14,
-	0x5a, 0x90, 0x13, 0xe3, 0x7b, 0xa5, 0x54, 0xf9, 0x67, 0x0f, 0x52, 0x91, 0x8a, 0xd2, 0xf7, 0x8b,
-	0x3f, 0x53, 0x7a, 0x76, 0x6a, 0x4a, 0x43, 0x63, 0x54, 0x7d, 0xc6, 0x3a, 0x86, 0x9c, 0x71, 0xe1,
-	0x97, 0x5f, 0x23, 0x5d, 0x7d, 0x45, 0xd8, 0xee, 0x33, 0xae, 0xa9, 0x24, 0x2f, 0x70, 0x83, 0xf1,
-	0x77, 0x19, 0x68, 0x26, 0xb8, 0x83, 0x2e, 0xd1, 0x75, 0xa3, 0xdb, 0x5e, 0xac, 0x2e, 0xac, 0x1f,
-	0xab, 0x8b, 0x47, 0x06, 0xa3, 0x92, 0x91, 0xc7, 0x84, 0x9f, 0x83, 0x1e, 0x7a, 0xcf, 0x69, 0x0a,
-	0xf1, 0xac, 0x47, 0xe3, 0x6f, 0x5f, 0x5a, 0xb8, 0x9a, 0xd2, 0xa3, 0x71, 0xb0, 0x63, 0x90, 0xb7,
-	0xf8, 0x18, 0x38, 0xff, 0x00, 0x59, 0x91, 0x65, 0xc2, 0x14, 0x13, 0x5c, 0x39, 0xb7, 0xfe, 0x17,
-	0x7c, 0xdf, 0xb0, 0x06, 0x35, 0xea, 0xea, 0xd7, 0x01, 0xb6, 0x07, 0x20, 0x21, 0x57, 0xe4, 0x1c,
-	0xe3, 0x62, 0x6b, 0xc2, 0x84, 0x72, 0x91, 0x9b, 0xf0, 0x41, 0xa3, 0x50, 0x7a, 0x85, 0x40, 0xde,
-	0xe3, 0x87, 0x75, 0xac, 0x50, 0x82, 0xa6, 0x61, 0x3c, 0x04, 0x9e, 0xd2, 0x2a, 0xcd, 0x93, 0x7f,
-	0x4e, 0xf3, 0x79, 0x3b, 0x6f, 0xa2, 0xe0, 0xa4, 0x86, 0x06, 0xa0, 0xe9, 0xb3, 0x12, 0x49, 0xde,
-	0xe0, 0xa3, 0xdd, 0xac, 0x1c, 0xa6, 0xce, 0xc1, 0x5e, 0x33, 0xee, 0xd6, 0xb0, 0x3e, 0x4c, 0xff,
-	0x82, 0x33, 0xee, 0x1c, 0xde, 0x14, 0x9c, 0x71, 0xf2, 0x12, 0xdf, 0x49, 0x05, 0x64, 0x61, 0x24,
-	0x78, 0x42, 0x13, 0xe7, 0xf6, 0x5e, 0x68, 0x5c, 0xa0, 0xba, 0x25, 0x89, 0x3c, 0xc6, 0xf7, 0xa2,
-	0x4c, 0xc4, 0x23, 0x15, 0x8e, 0xa9, 0x0c, 0x67, 0x14, 0xa4, 0x63, 0x5f, 0xa2, 0xeb, 0xc3, 0xe0,
-	0xc8, 0xc8, 0x03, 0x2a, 0x5f, 0x51, 0x90, 0x4f, 0xcf, 0x3f, 0x6e, 0xe7, 0x4d, 0xc7, 0x90, 0x5a,
-	0x2a, 0x19, 0xf9, 0x53, 0xf3, 0x20, 0xcc, 0x21, 0x77, 0x3b, 0x8b, 0xb5, 0x8b, 0x96, 0x6b, 0x17,
-	0xfd, 0x5c, 0xbb, 0xe8, 0xd3, 0xc6, 0xb5, 0x96, 0x1b, 0xd7, 0xfa, 0xbe, 0x71, 0xad, 0xd7, 0xa7,
-	0x7f, 0x84, 0xab, 0xba, 0xf4, 0x6c, 0x4c, 0x55, 0x64, 0x97, 0xf7, 0xbc, 0xf3, 0x3b, 0x00, 0x00,
-	0xff, 0xff, 0xcd, 0x30, 0x64, 0xe0, 0x62, 0x03, 0x00, 0x00,
+	// 608 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x4f, 0x4f, 0xd4, 0x4e,
+	0x18, 0xc7, 0x5b, 0x7e, 0xb0, 0x3f, 0x77, 0x00, 0xdd, 0x1d, 0xd4, 0x94, 0x22, 0xdd, 0xca, 0x81,
+	0x10, 0x12, 0xda, 0x20, 0x89, 0x31, 0xde, 0xd8, 0x6d, 0xd1, 0x4d, 0x80, 0xdd, 0x14, 0x8c, 0xa2,
+	0x89, 0x93, 0xd9, 0x76, 0x2c, 0x23, 0xdb, 0x99, 0x4d, 0x5b, 0xc9, 0xee, 0x3b, 0x30, 0x7b, 0xf2,
+	0x0d, 0x70, 0xf2, 0xe2, 0x91, 0x83, 0x17, 0x6f, 0x1e, 0x39, 0x12, 0x4f, 0xc6, 0x03, 0x1a, 0x38,
+	0xf0, 0x36, 0x4c, 0x3b, 0x75, 0x37, 0xb2, 0x7b, 0xd1, 0xf5, 0xd2, 0xb4, 0xdf, 0xe7, 0xfb, 0x7c,
+	0x9e, 0x3f, 0xed, 0x14, 0x68, 0x2e, 0x8f, 0x02, 0x1e, 0x99, 0x01, 0x65, 0xb1, 0x79, 0xb8, 0xda,
+	0x20, 0x31, 0x5e, 0x4d, 0x1f, 0x8c, 0x56, 0xc8, 0x63, 0x0e, 0x67, 0x44, 0xdc, 0x48, 0xa5, 0x2c,
+	0xae, 0xde, 0xf4, 0xb9, 0xcf, 0xd3, 0xb8, 0x99, 0xdc, 0x09, 0xab, 0x3a, 0x2b, 0xac, 0x48, 0x04,
+	0xb2, 0x3c, 0x11, 0x2a, 0xe2, 0x80, 0x32, 0x6e, 0xa6, 0x57, 0x21, 0x2d, 0x7c, 0x92, 0x41, 0x6e,
+	0x8b, 0xb2, 0x98, 0x84, 0xb0, 0x06, 0xf2, 0x94, 0xbd, 0x6a, 0xe2, 0x98, 0x72, 0xa6, 0xc8, 0xba,
+	0xbc, 0x94, 0x2f, 0xaf, 0x9e, 0x9c, 0x95, 0xa4, 0x6f, 0x67, 0xa5, 0x39, 0x81, 0x89, 0xbc, 0x03,
+	0x83, 0x72, 0x33, 0xc0, 0xf1, 0xbe, 0xb1, 0x49, 0x7c, 0xec, 0x76, 0x2c, 0xe2, 0x7e, 0xf9, 0xb8,
+	0x02, 0xb2, 0x2a, 0x16, 0x71, 0x9d, 0x3e, 0x03, 0xbe, 0x04, 0x45, 0xcc, 0xd8, 0x1b, 0xdc, 0x4c,
+	0x7a, 0x39, 0xa4, 0x11, 0xe5, 0x2c, 0x52, 0xc6, 0xfe, 0x16, 0x5c, 0x10, 0xac, 0x7a, 0x0f, 0xb5,
+	0xf0, 0x79, 0x02, 0xe4, 0xea, 0x38, 0xc4, 0x41, 0x04, 0xe7, 0x01, 0x48, 0x56, 0x83, 0x3c, 0xc2,
+	0x78, 0x20, 0x9a, 0x77, 0xf2, 0x89, 0x62, 0x25, 0x02, 0x7c, 0x0d, 0x6e, 0xf5, 0xda, 0x42, 0x21,
+	0x8e, 0x09, 0x72, 0xf7, 0x31, 0xf3, 0x49, 0xd6, 0xcd, 0xfd, 0x3f, 0xee, 0xe6, 0xc3, 0xe5, 0xf1,
+	0xb2, 0xec, 0xcc, 0xf4, 0xa0, 0x0e, 0x8e, 0x49, 0x25, 0x45, 0xc2, 0x17, 0x60, 0xba, 0x5f, 0x2b,
+	0xc0, 0x6d, 0xe5, 0xbf, 0x91, 0x6a, 0x4c, 0xf5, 0x60, 0x5b, 0xb8, 0x7d, 0x05, 0x4e, 0x99, 0x32,
+	0xfe, 0xaf, 0xe0, 0x94, 0xc1, 0xa7, 0x60, 0xd2, 0xe7, 0xb8, 0x89, 0x1a, 0x9c, 0x79, 0xc4, 0x53,
+	0x26, 0x46, 0x42, 0x83, 0x04, 0x55, 0x4e, 0x49, 0x70, 0x11, 0xdc, 0x68, 0x34, 0xb9, 0x7b, 0x10,
+	0xa1, 0x16, 0x09, 0x51, 0x87, 0xe0, 0x50, 0xc9, 0xe9, 0xf2, 0xd2, 0xb8, 0x33, 0x2d, 0xe4, 0x3a,
+	0x09, 0xf7, 0x08, 0x0e, 0xe1, 0x13, 0x00, 0xfb, 0xd3, 0x45, 0x71, 0xf2, 0xa2, 0xfc, 0x8e, 0xf2,
+	0xbf, 0x2e, 0x2f, 0x5d, 0xbf, 0xb7, 0x68, 0x0c, 0x39, 0x02, 0x46, 0xf5, 0x97, 0x7d, 0x27, 0x73,
+	0x3b, 0x45, 0x7a, 0x55, 0x82, 0x77, 0xc1, 0x14, 0x69, 0x71, 0x77, 0x1f, 0x89, 0x6a, 0xca, 0xb5,
+	0xb4, 0xf6, 0x64, 0xaa, 0x95, 0x53, 0x29, 0x19, 0x5d, 0x58, 0x3c, 0xe2, 0xe2, 0x8e, 0x92, 0x1f,
+	0x6d, 0xf4, 0x14, 0x65, 0x25, 0xa4, 0x87, 0xf3, 0xdd, 0xcb, 0xe3, 0x65, 0x45, 0x38, 0x56, 0x22,
+	0xef, 0xc0, 0x6c, 0x8b, 0x33, 0x2e, 0xbe, 0xdb, 0xe5, 0xef, 0x32, 0x28, 0x0e, 0xcc, 0x00, 0x6d,
+	0x50, 0xaa, 0x6e, 0x6f, 0x6c, 0xae, 0xef, 0x56, 0x6b, 0xdb, 0x68, 0x67, 0xd7, 0x59, 0xdf, 0xb5,
+	0x1f, 0xed, 0xa1, 0x72, 0x6d, 0xdb, 0xb2, 0x2d, 0xe4, 0x24, 0x72, 0x41, 0x52, 0xf5, 0xee, 0x91,
+	0x7e, 0x67, 0x20, 0x57, 0x6c, 0xdc, 0x49, 0x34, 0xf8, 0x00, 0x28, 0x43, 0x30, 0x1b, 0xd5, 0x67,
+	0xb6, 0x55, 0x90, 0x55, 0xb5, 0x7b, 0xa4, 0xdf, 0x1e, 0xc8, 0xdf, 0xa0, 0x6d, 0xe2, 0xc1, 0x0a,
+	0xd0, 0x86, 0x64, 0xda, 0xf5, 0x5a, 0xe5, 0x31, 0xb2, 0xec, 0xca, 0xfa, 0x5e, 0x61, 0x4c, 0x2d,
+	0x75, 0x8f, 0xf4, 0xb9, 0x81, 0x7c, 0xbb, 0x37, 0xba, 0x3a, 0xfe, 0xf6, 0xbd, 0x26, 0x95, 0xd7,
+	0x4e, 0xce, 0x35, 0xf9, 0xf4, 0x5c, 0x93, 0x7f, 0x9c, 0x6b, 0xf2, 0xbb, 0x0b, 0x4d, 0x3a, 0xbd,
+	0xd0, 0xa4, 0xaf, 0x17, 0x9a, 0xf4, 0x7c, 0xf6, 0xb7, 0xb5, 0x66, 0x7b, 0x89, 0x3b, 0x2d, 0x12,
+	0x35, 0x72, 0xe9, 0xcf, 0x69, 0xed, 0xe7, 0x00, 0x45, 0x8a, 0x53, 0x83, 0x17, 0x05, 0x00, 0x00,
 }
 
 func (m *Minter) Marshal() (dAtA []byte, err error) {
@@ -231,6 +308,26 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.EpochDecay.Size()
+		i -= size
+		if _, err := m.EpochDecay.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.EpochBlocks != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.EpochBlocks))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.InflationStrategy != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.InflationStrategy))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.BlocksPerYear != 0 {
 		i = encodeVarintMint(dAtA, i, uint64(m.BlocksPerYear))
 		i--
@@ -331,6 +428,14 @@ func (m *Params) Size() (n int) {
 	if m.BlocksPerYear != 0 {
 		n += 1 + sovMint(uint64(m.BlocksPerYear))
 	}
+	if m.InflationStrategy != 0 {
+		n += 1 + sovMint(uint64(m.InflationStrategy))
+	}
+	if m.EpochBlocks != 0 {
+		n += 1 + sovMint(uint64(m.EpochBlocks))
+	}
+	l = m.EpochDecay.Size()
+	n += 1 + l + sovMint(uint64(l))
 	return n
 }
 
@@ -674,6 +779,78 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationStrategy", wireType)
+			}
+			m.InflationStrategy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InflationStrategy |= InflationStrategy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochBlocks", wireType)
+			}
+			m.EpochBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochDecay", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EpochDecay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMint(dAtA[iNdEx:])
@@ -68,6 +68,26 @@ func (m Minter) NextInflationRate(params Params, bondedRatio math.LegacyDec) mat
 	return inflation
 }
 
+// NextEpochInflationRate returns the new inflation rate for the block at the
+// given height, following the epoch decay inflation strategy: the inflation
+// rate is reduced by EpochDecay at the beginning of each epoch of EpochBlocks
+// blocks, and capped as between the minimum and maximum inflation rates.
+func (m Minter) NextEpochInflationRate(params Params, height int64) math.LegacyDec {
+	inflation := m.Inflation
+	if params.EpochBlocks > 0 && height > 0 && uint64(height)%params.EpochBlocks == 0 {
+		inflation = inflation.Mul(math.LegacyOneDec().Sub(params.EpochDecay))
+	}
+
+	if inflation.GT(params.InflationMax) {
+		inflation = params.InflationMax
+	}
+	if inflation.LT(params.InflationMin) {
+		inflation = params.InflationMin
+	}
+
+	return inflation
+}
+
 // NextAnnualProvisions returns the annual provisions based on current total
 // supply and inflation rate.
 func (m Minter) NextAnnualProvisions(_ Params, totalSupply math.Int) math.LegacyDec {
@@ -60,6 +60,39 @@ func TestNextInflation(t *testing.T) {
 	}
 }
 
+func TestNextEpochInflation(t *testing.T) {
+	params := DefaultParams()
+	params.EpochBlocks = 100
+	params.EpochDecay = math.LegacyNewDecWithPrec(25, 2)
+
+	tests := []struct {
+		height                     int64
+		setInflation, expInflation math.LegacyDec
+	}{
+		// the inflation rate is unchanged within an epoch
+		{99, math.LegacyNewDecWithPrec(16, 2), math.LegacyNewDecWithPrec(16, 2)},
+		{101, math.LegacyNewDecWithPrec(16, 2), math.LegacyNewDecWithPrec(16, 2)},
+
+		// the inflation rate decays at the beginning of each epoch
+		{100, math.LegacyNewDecWithPrec(16, 2), math.LegacyNewDecWithPrec(12, 2)},
+		{200, math.LegacyNewDecWithPrec(12, 2), math.LegacyNewDecWithPrec(9, 2)},
+
+		// test 7% minimum stop
+		{300, math.LegacyNewDecWithPrec(9, 2), math.LegacyNewDecWithPrec(7, 2)},
+
+		// test 20% maximum stop
+		{101, math.LegacyNewDecWithPrec(30, 2), math.LegacyNewDecWithPrec(20, 2)},
+	}
+	for i, tc := range tests {
+		minter := InitialMinter(tc.setInflation)
+
+		inflation := minter.NextEpochInflationRate(params, tc.height)
+
+		require.True(t, inflation.Equal(tc.expInflation),
+			"Test Index: %v\nGot:  %v\nExpected: %v\n", i, inflation, tc.expInflation)
+	}
+}
+
 func TestBlockProvision(t *testing.T) {
 	minter := InitialMinter(math.LegacyNewDecWithPrec(1, 1))
 	params := DefaultParams()
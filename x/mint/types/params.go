@@ -10,7 +10,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// NewParams returns Params instance with the given values.
+// NewParams returns Params instance with the given values, using the bonded
+// ratio inflation strategy.
 func NewParams(mintDenom string, inflationRateChange, inflationMax, inflationMin, goalBonded math.LegacyDec, blocksPerYear uint64) Params {
 	return Params{
 		MintDenom:           mintDenom,
@@ -19,6 +20,8 @@ func NewParams(mintDenom string, inflationRateChange, inflationMax, inflationMin
 		InflationMin:        inflationMin,
 		GoalBonded:          goalBonded,
 		BlocksPerYear:       blocksPerYear,
+		InflationStrategy:   InflationStrategyBondedRatio,
+		EpochDecay:          math.LegacyZeroDec(),
 	}
 }
 
@@ -31,6 +34,9 @@ func DefaultParams() Params {
 		InflationMin:        math.LegacyNewDecWithPrec(7, 2),
 		GoalBonded:          math.LegacyNewDecWithPrec(67, 2),
 		BlocksPerYear:       uint64(60 * 60 * 8766 / 5), // assuming 5 second block times
+		InflationStrategy:   InflationStrategyBondedRatio,
+		EpochBlocks:         uint64(60 * 60 * 8766 / 5), // yearly epochs, assuming 5 second block times
+		EpochDecay:          math.LegacyNewDecWithPrec(5, 1),
 	}
 }
 
@@ -54,6 +60,17 @@ func (p Params) Validate() error {
 	if err := validateBlocksPerYear(p.BlocksPerYear); err != nil {
 		return err
 	}
+	if err := validateInflationStrategy(p.InflationStrategy); err != nil {
+		return err
+	}
+	if p.InflationStrategy == InflationStrategyEpochDecay {
+		if err := validateEpochBlocks(p.EpochBlocks); err != nil {
+			return err
+		}
+		if err := validateEpochDecay(p.EpochDecay); err != nil {
+			return err
+		}
+	}
 	if p.InflationMax.LT(p.InflationMin) {
 		return fmt.Errorf(
 			"max inflation (%s) must be greater than or equal to min inflation (%s)",
@@ -168,3 +185,48 @@ func validateBlocksPerYear(i interface{}) error {
 
 	return nil
 }
+
+func validateInflationStrategy(i interface{}) error {
+	v, ok := i.(InflationStrategy)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := InflationStrategy_name[int32(v)]; !ok {
+		return fmt.Errorf("unknown inflation strategy: %d", v)
+	}
+
+	return nil
+}
+
+func validateEpochBlocks(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("epoch blocks must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateEpochDecay(i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("epoch decay cannot be nil: %s", v)
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("epoch decay cannot be negative: %s", v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("epoch decay too large: %s", v)
+	}
+
+	return nil
+}
@@ -11,6 +11,11 @@ import (
 func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 	registrar.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgSend{},
+		&MsgUpdateClass{},
+		&MsgBatchMint{},
+		&MsgBatchTransfer{},
+		&MsgBatchBurn{},
+		&MsgUpdateParams{},
 	)
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
 }
@@ -12,4 +12,6 @@ var (
 	ErrNFTNotExists   = errors.Register(ModuleName, 6, "nft does not exist")
 	ErrEmptyClassID   = errors.Register(ModuleName, 7, "empty class id")
 	ErrEmptyNFTID     = errors.Register(ModuleName, 8, "empty nft id")
+	ErrInvalidRoyalty = errors.Register(ModuleName, 9, "invalid royalty")
+	ErrInvalidBatch   = errors.Register(ModuleName, 10, "invalid batch")
 )
@@ -21,10 +21,14 @@ func ValidateGenesis(data GenesisState, ac address.Codec) error {
 			}
 		}
 	}
+	if data.Params != nil {
+		return data.Params.Validate()
+	}
 	return nil
 }
 
 // DefaultGenesisState - Returns a default genesis state
 func DefaultGenesisState() *GenesisState {
-	return &GenesisState{}
+	params := DefaultParams()
+	return &GenesisState{Params: &params}
 }
@@ -0,0 +1,14 @@
+package nft
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TransferHook defines the hook called by the keeper before a nft of the class
+// it is registered for is transferred, which can reject the transfer by
+// returning an error.
+type TransferHook interface {
+	BeforeTransfer(ctx context.Context, classID, nftID string, sender, receiver sdk.AccAddress) error
+}
@@ -8,6 +8,7 @@ import (
 	"cosmossdk.io/x/nft"
 
 	"github.com/cosmos/cosmos-sdk/runtime"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // SaveClass defines a method for creating a new nft class
@@ -15,6 +16,9 @@ func (k Keeper) SaveClass(ctx context.Context, class nft.Class) error {
 	if k.HasClass(ctx, class.Id) {
 		return errors.Wrap(nft.ErrClassExists, class.Id)
 	}
+	if err := k.validateClass(class); err != nil {
+		return err
+	}
 	bz, err := k.cdc.Marshal(&class)
 	if err != nil {
 		return errors.Wrap(err, "Marshal nft.Class failed")
@@ -28,6 +32,9 @@ func (k Keeper) UpdateClass(ctx context.Context, class nft.Class) error {
 	if !k.HasClass(ctx, class.Id) {
 		return errors.Wrap(nft.ErrClassNotExists, class.Id)
 	}
+	if err := k.validateClass(class); err != nil {
+		return err
+	}
 	bz, err := k.cdc.Marshal(&class)
 	if err != nil {
 		return errors.Wrap(err, "Marshal nft.Class failed")
@@ -75,3 +82,22 @@ func (k Keeper) HasClass(ctx context.Context, classID string) bool {
 	}
 	return has
 }
+
+// validateClass checks the optional admin and royalty of a nft class
+func (k Keeper) validateClass(class nft.Class) error {
+	if len(class.Admin) > 0 {
+		if _, err := k.ac.StringToBytes(class.Admin); err != nil {
+			return errors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid admin address (%s)", class.Admin)
+		}
+	}
+
+	if class.Royalty != nil {
+		if _, err := k.ac.StringToBytes(class.Royalty.Receiver); err != nil {
+			return errors.Wrapf(nft.ErrInvalidRoyalty, "invalid receiver address (%s)", class.Royalty.Receiver)
+		}
+		if class.Royalty.BasisPoints > maxRoyaltyBasisPoints {
+			return errors.Wrapf(nft.ErrInvalidRoyalty, "basis points %d greater than %d", class.Royalty.BasisPoints, maxRoyaltyBasisPoints)
+		}
+	}
+	return nil
+}
@@ -10,6 +10,11 @@ import (
 // InitGenesis initializes the nft module's genesis state from a given
 // genesis state.
 func (k Keeper) InitGenesis(ctx context.Context, data *nft.GenesisState) error {
+	if data.Params != nil {
+		if err := k.SetParams(ctx, *data.Params); err != nil {
+			return err
+		}
+	}
 	for _, class := range data.Classes {
 		if err := k.SaveClass(ctx, *class); err != nil {
 			return err
@@ -63,8 +68,10 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*nft.GenesisState, error) {
 			Nfts:  nftMap[owner],
 		})
 	}
+	params := k.GetParams(ctx)
 	return &nft.GenesisState{
 		Classes: classes,
 		Entries: entries,
+		Params:  &params,
 	}, nil
 }
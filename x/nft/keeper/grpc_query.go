@@ -259,3 +259,13 @@ func (k Keeper) Classes(ctx context.Context, r *nft.QueryClassesRequest) (*nft.Q
 		Pagination: pageRes,
 	}, nil
 }
+
+// Params return the parameters of the nft module
+func (k Keeper) Params(ctx context.Context, r *nft.QueryParamsRequest) (*nft.QueryParamsResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	params := k.GetParams(ctx)
+	return &nft.QueryParamsResponse{Params: &params}, nil
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/x/nft"
@@ -14,11 +16,18 @@ type Keeper struct {
 	bk  nft.BankKeeper
 	ac  address.Codec
 	env appmodule.Environment
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+
+	// transferHooks are the transfer hooks registered per class id
+	transferHooks map[string]nft.TransferHook
 }
 
 // NewKeeper creates a new nft Keeper instance
 func NewKeeper(env appmodule.Environment,
-	cdc codec.BinaryCodec, ak nft.AccountKeeper, bk nft.BankKeeper,
+	cdc codec.BinaryCodec, ak nft.AccountKeeper, bk nft.BankKeeper, authority string,
 ) Keeper {
 	// ensure nft module account is set
 	if addr := ak.GetModuleAddress(nft.ModuleName); addr == nil {
@@ -26,9 +35,26 @@ func NewKeeper(env appmodule.Environment,
 	}
 
 	return Keeper{
-		cdc: cdc,
-		env: env,
-		bk:  bk,
-		ac:  ak.AddressCodec(),
+		cdc:           cdc,
+		env:           env,
+		bk:            bk,
+		ac:            ak.AddressCodec(),
+		authority:     authority,
+		transferHooks: make(map[string]nft.TransferHook),
+	}
+}
+
+// RegisterTransferHook registers the hook called before every transfer of a nft
+// of the given class. It is meant to be called when wiring the application,
+// and panics if a hook is already registered for the class.
+func (k Keeper) RegisterTransferHook(classID string, hook nft.TransferHook) {
+	if _, ok := k.transferHooks[classID]; ok {
+		panic(fmt.Sprintf("transfer hook already registered for class %s", classID))
 	}
+	k.transferHooks[classID] = hook
+}
+
+// GetAuthority returns the x/nft module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
 }
@@ -22,6 +22,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	addresstypes "github.com/cosmos/cosmos-sdk/types/address"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 )
 
@@ -46,6 +47,9 @@ type TestSuite struct {
 	queryClient   nft.QueryClient
 	nftKeeper     keeper.Keeper
 	accountKeeper *nfttestutil.MockAccountKeeper
+	bankKeeper    *nfttestutil.MockBankKeeper
+	msgServer     nft.MsgServer
+	authority     string
 
 	encCfg moduletestutil.TestEncodingConfig
 }
@@ -73,13 +77,16 @@ func (s *TestSuite) SetupTest() {
 	}
 
 	s.accountKeeper = accountKeeper
+	s.authority = sdk.AccAddress(addresstypes.Module("gov")).String()
+	s.bankKeeper = bankKeeper
 
 	env := runtime.NewEnvironment(runtime.NewKVStoreService(key), log.NewNopLogger())
-	nftKeeper := keeper.NewKeeper(env, s.encCfg.Codec, accountKeeper, bankKeeper)
+	nftKeeper := keeper.NewKeeper(env, s.encCfg.Codec, accountKeeper, bankKeeper, s.authority)
 	queryHelper := baseapp.NewQueryServerTestHelper(ctx, s.encCfg.InterfaceRegistry)
 	nft.RegisterQueryServer(queryHelper, nftKeeper)
 
 	s.nftKeeper = nftKeeper
+	s.msgServer = keeper.NewMsgServerImpl(nftKeeper)
 	s.queryClient = nft.NewQueryClient(queryHelper)
 	s.ctx = ctx
 }
@@ -354,12 +361,14 @@ func (s *TestSuite) TestExportGenesis() {
 	err = s.nftKeeper.Mint(s.ctx, expNFT, s.addrs[0])
 	s.Require().NoError(err)
 
+	params := nft.DefaultParams()
 	expGenesis := &nft.GenesisState{
 		Classes: []*nft.Class{&class},
 		Entries: []*nft.Entry{{
 			Owner: s.encodedAddrs[0],
 			Nfts:  []*nft.NFT{&expNFT},
 		}},
+		Params: &params,
 	}
 	genesis, err := s.nftKeeper.ExportGenesis(s.ctx)
 	s.Require().NoError(err)
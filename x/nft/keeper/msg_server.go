@@ -10,10 +10,20 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-var _ nft.MsgServer = Keeper{}
+type msgServer struct {
+	Keeper
+}
+
+var _ nft.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the nft MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) nft.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
 
 // Send implements Send method of the types.MsgServer.
-func (k Keeper) Send(ctx context.Context, msg *nft.MsgSend) (*nft.MsgSendResponse, error) {
+func (k msgServer) Send(ctx context.Context, msg *nft.MsgSend) (*nft.MsgSendResponse, error) {
 	if len(msg.ClassId) == 0 {
 		return nil, nft.ErrEmptyClassID
 	}
@@ -52,3 +62,207 @@ func (k Keeper) Send(ctx context.Context, msg *nft.MsgSend) (*nft.MsgSendRespons
 
 	return &nft.MsgSendResponse{}, nil
 }
+
+// UpdateClass implements UpdateClass method of the types.MsgServer.
+func (k msgServer) UpdateClass(ctx context.Context, msg *nft.MsgUpdateClass) (*nft.MsgUpdateClassResponse, error) {
+	if msg.Class == nil || len(msg.Class.Id) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	if _, err := k.ac.StringToBytes(msg.Admin); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid admin address (%s)", msg.Admin)
+	}
+
+	class, has := k.GetClass(ctx, msg.Class.Id)
+	if !has {
+		return nil, errorsmod.Wrap(nft.ErrClassNotExists, msg.Class.Id)
+	}
+
+	if len(class.Admin) == 0 || class.Admin != msg.Admin {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the admin of nft class %s", msg.Admin, msg.Class.Id)
+	}
+
+	if err := k.Keeper.UpdateClass(ctx, *msg.Class); err != nil {
+		return nil, err
+	}
+
+	if err := k.env.EventService.EventManager(ctx).Emit(&nft.EventUpdateClass{
+		ClassId: msg.Class.Id,
+		Admin:   msg.Admin,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &nft.MsgUpdateClassResponse{}, nil
+}
+
+// BatchMint implements BatchMint method of the types.MsgServer.
+func (k msgServer) BatchMint(ctx context.Context, msg *nft.MsgBatchMint) (*nft.MsgBatchMintResponse, error) {
+	if _, err := k.ac.StringToBytes(msg.Admin); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid admin address (%s)", msg.Admin)
+	}
+
+	receiver, err := k.ac.StringToBytes(msg.Receiver)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid receiver address (%s)", msg.Receiver)
+	}
+
+	if err := k.validateBatchSize(ctx, len(msg.Nfts)); err != nil {
+		return nil, err
+	}
+
+	checked := make(map[string]bool)
+	for _, token := range msg.Nfts {
+		if len(token.ClassId) == 0 {
+			return nil, nft.ErrEmptyClassID
+		}
+
+		if len(token.Id) == 0 {
+			return nil, nft.ErrEmptyNFTID
+		}
+
+		if checked[token.ClassId] {
+			continue
+		}
+
+		class, has := k.GetClass(ctx, token.ClassId)
+		if !has {
+			return nil, errorsmod.Wrap(nft.ErrClassNotExists, token.ClassId)
+		}
+
+		if len(class.Admin) == 0 || class.Admin != msg.Admin {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the admin of nft class %s", msg.Admin, token.ClassId)
+		}
+		checked[token.ClassId] = true
+	}
+
+	if err := k.env.BranchService.Execute(ctx, func(ctx context.Context) error {
+		return k.Keeper.BatchMint(ctx, msg.Nfts, receiver)
+	}); err != nil {
+		return nil, err
+	}
+
+	return &nft.MsgBatchMintResponse{}, nil
+}
+
+// BatchTransfer implements BatchTransfer method of the types.MsgServer.
+func (k msgServer) BatchTransfer(ctx context.Context, msg *nft.MsgBatchTransfer) (*nft.MsgBatchTransferResponse, error) {
+	if len(msg.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	sender, err := k.ac.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", msg.Sender)
+	}
+
+	receiver, err := k.ac.StringToBytes(msg.Receiver)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid receiver address (%s)", msg.Receiver)
+	}
+
+	if err := k.validateBatchOwnership(ctx, msg.ClassId, msg.Ids, msg.Sender, sender); err != nil {
+		return nil, err
+	}
+
+	if err := k.env.BranchService.Execute(ctx, func(ctx context.Context) error {
+		if err := k.Keeper.BatchTransfer(ctx, msg.ClassId, msg.Ids, receiver); err != nil {
+			return err
+		}
+
+		for _, id := range msg.Ids {
+			if err := k.env.EventService.EventManager(ctx).Emit(&nft.EventSend{
+				ClassId:  msg.ClassId,
+				Id:       id,
+				Sender:   msg.Sender,
+				Receiver: msg.Receiver,
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &nft.MsgBatchTransferResponse{}, nil
+}
+
+// BatchBurn implements BatchBurn method of the types.MsgServer.
+func (k msgServer) BatchBurn(ctx context.Context, msg *nft.MsgBatchBurn) (*nft.MsgBatchBurnResponse, error) {
+	if len(msg.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	sender, err := k.ac.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", msg.Sender)
+	}
+
+	if err := k.validateBatchOwnership(ctx, msg.ClassId, msg.Ids, msg.Sender, sender); err != nil {
+		return nil, err
+	}
+
+	if err := k.env.BranchService.Execute(ctx, func(ctx context.Context) error {
+		return k.Keeper.BatchBurn(ctx, msg.ClassId, msg.Ids)
+	}); err != nil {
+		return nil, err
+	}
+
+	return &nft.MsgBatchBurnResponse{}, nil
+}
+
+// UpdateParams implements UpdateParams method of the types.MsgServer.
+func (k msgServer) UpdateParams(ctx context.Context, msg *nft.MsgUpdateParams) (*nft.MsgUpdateParamsResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err := k.SetParams(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &nft.MsgUpdateParamsResponse{}, nil
+}
+
+// validateBatchSize checks that a batch of the given size is not empty and does not exceed the max batch size param
+func (k Keeper) validateBatchSize(ctx context.Context, size int) error {
+	if size == 0 {
+		return errorsmod.Wrap(nft.ErrInvalidBatch, "empty batch")
+	}
+
+	if maxSize := k.GetParams(ctx).MaxBatchSize; size > int(maxSize) {
+		return errorsmod.Wrapf(nft.ErrInvalidBatch, "batch of %d nfts exceeds the max batch size %d", size, maxSize)
+	}
+	return nil
+}
+
+// validateBatchOwnership checks that a batch of nft ids of a class has a valid size, no duplicates,
+// and that all of its nfts are owned by the sender
+func (k Keeper) validateBatchOwnership(ctx context.Context, classID string, nftIDs []string, senderStr string, sender []byte) error {
+	if err := k.validateBatchSize(ctx, len(nftIDs)); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(nftIDs))
+	for _, id := range nftIDs {
+		if len(id) == 0 {
+			return nft.ErrEmptyNFTID
+		}
+
+		if seen[id] {
+			return errorsmod.Wrapf(nft.ErrInvalidBatch, "duplicate nft %s", id)
+		}
+		seen[id] = true
+
+		owner := k.GetOwner(ctx, classID, id)
+		if !bytes.Equal(owner, sender) {
+			return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft %s", senderStr, id)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,185 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	"cosmossdk.io/x/nft"
+)
+
+func (s *TestSuite) saveAdminClass() {
+	class := ExpClass
+	class.Admin = s.encodedAddrs[0]
+	err := s.nftKeeper.SaveClass(s.ctx, class)
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) batchNFTs(n int) []nft.NFT {
+	nfts := make([]nft.NFT, n)
+	for i := range nfts {
+		nfts[i] = nft.NFT{ClassId: testClassID, Id: fmt.Sprintf("kitty%d", i)}
+	}
+	return nfts
+}
+
+func (s *TestSuite) TestBatchMintMsg() {
+	s.saveAdminClass()
+	err := s.nftKeeper.SetParams(s.ctx, nft.Params{MaxBatchSize: 3})
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name   string
+		req    *nft.MsgBatchMint
+		errMsg string
+	}{
+		{
+			name:   "empty batch",
+			req:    &nft.MsgBatchMint{Admin: s.encodedAddrs[0], Receiver: s.encodedAddrs[1]},
+			errMsg: "empty batch",
+		},
+		{
+			name:   "batch too large",
+			req:    &nft.MsgBatchMint{Admin: s.encodedAddrs[0], Nfts: s.batchNFTs(4), Receiver: s.encodedAddrs[1]},
+			errMsg: "exceeds the max batch size 3",
+		},
+		{
+			name:   "not the class admin",
+			req:    &nft.MsgBatchMint{Admin: s.encodedAddrs[1], Nfts: s.batchNFTs(2), Receiver: s.encodedAddrs[1]},
+			errMsg: "unauthorized",
+		},
+		{
+			name: "class not found",
+			req: &nft.MsgBatchMint{Admin: s.encodedAddrs[0], Nfts: []nft.NFT{
+				{ClassId: "unknown", Id: testID},
+			}, Receiver: s.encodedAddrs[1]},
+			errMsg: "nft class does not exist",
+		},
+		{
+			name: "duplicate nft is atomic",
+			req: &nft.MsgBatchMint{Admin: s.encodedAddrs[0], Nfts: []nft.NFT{
+				{ClassId: testClassID, Id: testID},
+				{ClassId: testClassID, Id: testID},
+			}, Receiver: s.encodedAddrs[1]},
+			errMsg: "nft already exists",
+		},
+		{
+			name: "valid",
+			req:  &nft.MsgBatchMint{Admin: s.encodedAddrs[0], Nfts: s.batchNFTs(3), Receiver: s.encodedAddrs[1]},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.BatchMint(s.ctx, tc.req)
+			if tc.errMsg != "" {
+				s.Require().ErrorContains(err, tc.errMsg)
+				s.Require().False(s.nftKeeper.HasNFT(s.ctx, testClassID, testID))
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(uint64(3), s.nftKeeper.GetBalance(s.ctx, testClassID, s.addrs[1]))
+		})
+	}
+}
+
+func (s *TestSuite) TestBatchTransferMsg() {
+	s.saveAdminClass()
+	err := s.nftKeeper.BatchMint(s.ctx, s.batchNFTs(3), s.addrs[0])
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name   string
+		req    *nft.MsgBatchTransfer
+		errMsg string
+	}{
+		{
+			name:   "empty class id",
+			req:    &nft.MsgBatchTransfer{Ids: []string{"kitty0"}, Sender: s.encodedAddrs[0], Receiver: s.encodedAddrs[1]},
+			errMsg: "empty class id",
+		},
+		{
+			name:   "duplicate nft",
+			req:    &nft.MsgBatchTransfer{ClassId: testClassID, Ids: []string{"kitty0", "kitty0"}, Sender: s.encodedAddrs[0], Receiver: s.encodedAddrs[1]},
+			errMsg: "duplicate nft kitty0",
+		},
+		{
+			name:   "not the owner",
+			req:    &nft.MsgBatchTransfer{ClassId: testClassID, Ids: []string{"kitty0", "kitty1"}, Sender: s.encodedAddrs[1], Receiver: s.encodedAddrs[2]},
+			errMsg: fmt.Sprintf("%s is not the owner of nft kitty0", s.encodedAddrs[1]),
+		},
+		{
+			name: "valid",
+			req:  &nft.MsgBatchTransfer{ClassId: testClassID, Ids: []string{"kitty0", "kitty1"}, Sender: s.encodedAddrs[0], Receiver: s.encodedAddrs[1]},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.BatchTransfer(s.ctx, tc.req)
+			if tc.errMsg != "" {
+				s.Require().ErrorContains(err, tc.errMsg)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(uint64(2), s.nftKeeper.GetBalance(s.ctx, testClassID, s.addrs[1]))
+			s.Require().Equal(uint64(1), s.nftKeeper.GetBalance(s.ctx, testClassID, s.addrs[0]))
+		})
+	}
+}
+
+func (s *TestSuite) TestBatchBurnMsg() {
+	s.saveAdminClass()
+	err := s.nftKeeper.BatchMint(s.ctx, s.batchNFTs(3), s.addrs[0])
+	s.Require().NoError(err)
+	err = s.nftKeeper.SetParams(s.ctx, nft.Params{MaxBatchSize: 2})
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name   string
+		req    *nft.MsgBatchBurn
+		errMsg string
+	}{
+		{
+			name:   "batch too large",
+			req:    &nft.MsgBatchBurn{ClassId: testClassID, Ids: []string{"kitty0", "kitty1", "kitty2"}, Sender: s.encodedAddrs[0]},
+			errMsg: "exceeds the max batch size 2",
+		},
+		{
+			name:   "not the owner",
+			req:    &nft.MsgBatchBurn{ClassId: testClassID, Ids: []string{"kitty0"}, Sender: s.encodedAddrs[1]},
+			errMsg: "unauthorized",
+		},
+		{
+			name: "valid",
+			req:  &nft.MsgBatchBurn{ClassId: testClassID, Ids: []string{"kitty0", "kitty1"}, Sender: s.encodedAddrs[0]},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.BatchBurn(s.ctx, tc.req)
+			if tc.errMsg != "" {
+				s.Require().ErrorContains(err, tc.errMsg)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(uint64(1), s.nftKeeper.GetTotalSupply(s.ctx, testClassID))
+		})
+	}
+}
+
+func (s *TestSuite) TestUpdateParamsMsg() {
+	s.Require().Equal(nft.DefaultParams(), s.nftKeeper.GetParams(s.ctx))
+
+	_, err := s.msgServer.UpdateParams(s.ctx, &nft.MsgUpdateParams{Authority: s.encodedAddrs[0], Params: nft.Params{MaxBatchSize: 10}})
+	s.Require().ErrorContains(err, "invalid authority")
+
+	_, err = s.msgServer.UpdateParams(s.ctx, &nft.MsgUpdateParams{Authority: s.authority, Params: nft.Params{}})
+	s.Require().ErrorContains(err, "max batch size must be positive")
+
+	_, err = s.msgServer.UpdateParams(s.ctx, &nft.MsgUpdateParams{Authority: s.authority, Params: nft.Params{MaxBatchSize: 10}})
+	s.Require().NoError(err)
+
+	res, err := s.queryClient.Params(s.ctx, &nft.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(uint32(10), res.Params.MaxBatchSize)
+}
@@ -34,12 +34,14 @@ func (s *TestSuite) TestSend() {
 	err = s.nftKeeper.Mint(s.ctx, ExpNFT, s.addrs[0])
 	s.Require().NoError(err)
 
+	params := nft.DefaultParams()
 	expGenesis := &nft.GenesisState{
 		Classes: []*nft.Class{&ExpClass},
 		Entries: []*nft.Entry{{
 			Owner: s.encodedAddrs[0],
 			Nfts:  []*nft.NFT{&ExpNFT},
 		}},
+		Params: &params,
 	}
 	genesis, err := s.nftKeeper.ExportGenesis(s.ctx)
 	s.Require().NoError(err)
@@ -121,7 +123,7 @@ func (s *TestSuite) TestSend() {
 
 	for _, tc := range testCases {
 		s.Run(tc.name, func() {
-			_, err := s.nftKeeper.Send(s.ctx, tc.req)
+			_, err := s.msgServer.Send(s.ctx, tc.req)
 			if tc.expErr {
 				s.Require().Error(err)
 				s.Require().Contains(err.Error(), tc.errMsg)
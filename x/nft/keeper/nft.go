@@ -137,6 +137,12 @@ func (k Keeper) transferWithNoCheck(ctx context.Context,
 	receiver sdk.AccAddress,
 ) error {
 	owner := k.GetOwner(ctx, classID, nftID)
+	if hook, ok := k.transferHooks[classID]; ok {
+		if err := hook.BeforeTransfer(ctx, classID, nftID, owner, receiver); err != nil {
+			return err
+		}
+	}
+
 	k.deleteOwner(ctx, classID, nftID, owner)
 	k.setOwner(ctx, classID, nftID, receiver)
 	return nil
@@ -82,7 +82,7 @@ func (k Keeper) BatchTransfer(ctx context.Context,
 			return errors.Wrap(nft.ErrNFTNotExists, nftID)
 		}
 		if err := k.transferWithNoCheck(ctx, classID, nftID, receiver); err != nil {
-			return errors.Wrap(nft.ErrNFTNotExists, nftID)
+			return err
 		}
 	}
 	return nil
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/x/nft"
+)
+
+// GetParams returns the parameters of the nft module, or the default ones if they were never set
+func (k Keeper) GetParams(ctx context.Context) nft.Params {
+	store := k.env.KVStoreService.OpenKVStore(ctx)
+	bz, err := store.Get(ParamsKey)
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return nft.DefaultParams()
+	}
+
+	var params nft.Params
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the parameters of the nft module
+func (k Keeper) SetParams(ctx context.Context, params nft.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store := k.env.KVStoreService.OpenKVStore(ctx)
+	return store.Set(ParamsKey, k.cdc.MustMarshal(&params))
+}
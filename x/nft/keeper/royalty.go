@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/x/nft"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// maxRoyaltyBasisPoints is the basis points of a royalty taking the whole price
+const maxRoyaltyBasisPoints = 10000
+
+// TransferWithPayment defines a method for sending a nft to a buyer paying the given price for it.
+// The royalty of the nft class, if any, is paid to its receiver, and the rest of the price to the nft owner.
+// It is the only transfer paying the royalty, as the other transfers carry no price.
+// Note: When the upper module uses this method, it needs to authenticate the buyer
+func (k Keeper) TransferWithPayment(ctx context.Context,
+	classID string,
+	nftID string,
+	buyer sdk.AccAddress,
+	price sdk.Coins,
+) error {
+	class, has := k.GetClass(ctx, classID)
+	if !has {
+		return errors.Wrap(nft.ErrClassNotExists, classID)
+	}
+
+	if !k.HasNFT(ctx, classID, nftID) {
+		return errors.Wrap(nft.ErrNFTNotExists, nftID)
+	}
+
+	if err := price.Validate(); err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+
+	royalty := RoyaltyAmount(class.Royalty, price)
+	if !royalty.IsZero() {
+		receiver, err := k.ac.StringToBytes(class.Royalty.Receiver)
+		if err != nil {
+			return errors.Wrapf(nft.ErrInvalidRoyalty, "invalid receiver address (%s)", class.Royalty.Receiver)
+		}
+		if err := k.bk.SendCoins(ctx, buyer, receiver, royalty); err != nil {
+			return err
+		}
+	}
+
+	if proceeds := price.Sub(royalty...); !proceeds.IsZero() {
+		if err := k.bk.SendCoins(ctx, buyer, k.GetOwner(ctx, classID, nftID), proceeds); err != nil {
+			return err
+		}
+	}
+
+	return k.transferWithNoCheck(ctx, classID, nftID, buyer)
+}
+
+// RoyaltyAmount returns the share of the price paid as royalty, rounded down
+func RoyaltyAmount(royalty *nft.Royalty, price sdk.Coins) sdk.Coins {
+	if royalty == nil || royalty.BasisPoints == 0 {
+		return sdk.NewCoins()
+	}
+
+	amount := sdk.NewCoins()
+	for _, coin := range price {
+		share := coin.Amount.MulRaw(int64(royalty.BasisPoints)).QuoRaw(maxRoyaltyBasisPoints)
+		amount = amount.Add(sdk.NewCoin(coin.Denom, share))
+	}
+	return amount
+}
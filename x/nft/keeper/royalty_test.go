@@ -0,0 +1,155 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/nft"
+	"cosmossdk.io/x/nft/keeper"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type rejectHook struct {
+	rejected string
+}
+
+func (h rejectHook) BeforeTransfer(_ context.Context, _, nftID string, _, _ sdk.AccAddress) error {
+	if nftID == h.rejected {
+		return errors.New("transfer rejected")
+	}
+	return nil
+}
+
+func (s *TestSuite) TestTransferHook() {
+	err := s.nftKeeper.SaveClass(s.ctx, ExpClass)
+	s.Require().NoError(err)
+
+	s.nftKeeper.RegisterTransferHook(testClassID, rejectHook{rejected: testID})
+	s.Require().Panics(func() {
+		s.nftKeeper.RegisterTransferHook(testClassID, rejectHook{})
+	})
+
+	err = s.nftKeeper.Mint(s.ctx, ExpNFT, s.addrs[0])
+	s.Require().NoError(err)
+	otherNFT := nft.NFT{ClassId: testClassID, Id: "kitty2"}
+	err = s.nftKeeper.Mint(s.ctx, otherNFT, s.addrs[0])
+	s.Require().NoError(err)
+
+	err = s.nftKeeper.Transfer(s.ctx, testClassID, testID, s.addrs[1])
+	s.Require().ErrorContains(err, "transfer rejected")
+	s.Require().Equal(s.addrs[0], s.nftKeeper.GetOwner(s.ctx, testClassID, testID))
+
+	err = s.nftKeeper.Transfer(s.ctx, testClassID, otherNFT.Id, s.addrs[1])
+	s.Require().NoError(err)
+	s.Require().Equal(s.addrs[1], s.nftKeeper.GetOwner(s.ctx, testClassID, otherNFT.Id))
+}
+
+func (s *TestSuite) TestSaveClassWithRoyalty() {
+	class := ExpClass
+	class.Royalty = &nft.Royalty{Receiver: s.encodedAddrs[2], BasisPoints: 10001}
+	err := s.nftKeeper.SaveClass(s.ctx, class)
+	s.Require().ErrorIs(err, nft.ErrInvalidRoyalty)
+
+	class.Royalty = &nft.Royalty{Receiver: "invalid", BasisPoints: 500}
+	err = s.nftKeeper.SaveClass(s.ctx, class)
+	s.Require().ErrorIs(err, nft.ErrInvalidRoyalty)
+
+	class.Royalty = &nft.Royalty{Receiver: s.encodedAddrs[2], BasisPoints: 500}
+	err = s.nftKeeper.SaveClass(s.ctx, class)
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestTransferWithPayment() {
+	class := ExpClass
+	class.Royalty = &nft.Royalty{Receiver: s.encodedAddrs[2], BasisPoints: 250}
+	err := s.nftKeeper.SaveClass(s.ctx, class)
+	s.Require().NoError(err)
+
+	err = s.nftKeeper.Mint(s.ctx, ExpNFT, s.addrs[0])
+	s.Require().NoError(err)
+
+	price := sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(1000)))
+	royalty := sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(25)))
+	s.Require().Equal(royalty, keeper.RoyaltyAmount(class.Royalty, price))
+
+	s.bankKeeper.EXPECT().SendCoins(s.ctx, s.addrs[1], s.addrs[2], royalty).Return(nil)
+	s.bankKeeper.EXPECT().SendCoins(s.ctx, s.addrs[1], s.addrs[0], price.Sub(royalty...)).Return(nil)
+
+	err = s.nftKeeper.TransferWithPayment(s.ctx, testClassID, testID, s.addrs[1], price)
+	s.Require().NoError(err)
+	s.Require().Equal(s.addrs[1], s.nftKeeper.GetOwner(s.ctx, testClassID, testID))
+
+	err = s.nftKeeper.TransferWithPayment(s.ctx, testClassID, "kitty2", s.addrs[1], price)
+	s.Require().ErrorIs(err, nft.ErrNFTNotExists)
+}
+
+func (s *TestSuite) TestMsgUpdateClass() {
+	class := ExpClass
+	class.Admin = s.encodedAddrs[0]
+	err := s.nftKeeper.SaveClass(s.ctx, class)
+	s.Require().NoError(err)
+
+	noAdmin := ExpClass
+	noAdmin.Id = "noadmin"
+	err = s.nftKeeper.SaveClass(s.ctx, noAdmin)
+	s.Require().NoError(err)
+
+	updated := class
+	updated.Name = "Crypto Kitty v2"
+
+	testCases := []struct {
+		name   string
+		req    *nft.MsgUpdateClass
+		errMsg string
+	}{
+		{
+			name:   "empty class",
+			req:    &nft.MsgUpdateClass{Admin: s.encodedAddrs[0]},
+			errMsg: "empty class id",
+		},
+		{
+			name:   "class not found",
+			req:    &nft.MsgUpdateClass{Admin: s.encodedAddrs[0], Class: &nft.Class{Id: "unknown"}},
+			errMsg: "nft class does not exist",
+		},
+		{
+			name:   "class without admin",
+			req:    &nft.MsgUpdateClass{Admin: s.encodedAddrs[0], Class: &noAdmin},
+			errMsg: "unauthorized",
+		},
+		{
+			name:   "not the admin",
+			req:    &nft.MsgUpdateClass{Admin: s.encodedAddrs[1], Class: &updated},
+			errMsg: "unauthorized",
+		},
+		{
+			name: "invalid royalty",
+			req: &nft.MsgUpdateClass{Admin: s.encodedAddrs[0], Class: &nft.Class{
+				Id:      testClassID,
+				Admin:   s.encodedAddrs[0],
+				Royalty: &nft.Royalty{Receiver: s.encodedAddrs[2], BasisPoints: 20000},
+			}},
+			errMsg: "invalid royalty",
+		},
+		{
+			name: "valid",
+			req:  &nft.MsgUpdateClass{Admin: s.encodedAddrs[0], Class: &updated},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.UpdateClass(s.ctx, tc.req)
+			if tc.errMsg != "" {
+				s.Require().ErrorContains(err, tc.errMsg)
+				return
+			}
+			s.Require().NoError(err)
+			actual, has := s.nftKeeper.GetClass(s.ctx, testClassID)
+			s.Require().True(has)
+			s.Require().Equal(updated, actual)
+		})
+	}
+}
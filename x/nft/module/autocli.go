@@ -79,6 +79,11 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:     "Query all NFT classes.",
 					Example:   fmt.Sprintf(`%s query %s classes`, version.AppName, nft.ModuleName),
 				},
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the current nft parameters",
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -95,6 +100,45 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					},
 					// Sender is the signer of the transaction and is automatically added as from flag by AutoCLI.
 				},
+				{
+					RpcMethod: "UpdateClass",
+					Use:       "update-class --class [class-json] --from [admin]",
+					Short:     "Update an NFT class as its admin",
+					Example:   fmt.Sprintf(`%s tx %s update-class --class '{"id":"kitty","name":"Crypto Kitty","admin":"cosmos1..."}' --from <admin>`, version.AppName, nft.ModuleName),
+					// Admin is the signer of the transaction and is automatically added as from flag by AutoCLI.
+				},
+				{
+					RpcMethod: "BatchMint",
+					Use:       "batch-mint [receiver] --nfts [nfts-json] --from [admin]",
+					Short:     "Mint a batch of NFTs of classes administered by the sender",
+					Example:   fmt.Sprintf(`%s tx %s batch-mint <receiver> --nfts '[{"class_id":"kitty","id":"kitty1"},{"class_id":"kitty","id":"kitty2"}]' --from <admin>`, version.AppName, nft.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "receiver"},
+					},
+				},
+				{
+					RpcMethod: "BatchTransfer",
+					Use:       "batch-transfer [class-id] [receiver] [nft-id]... --from [sender]",
+					Short:     "Transfer ownership of a batch of NFTs of a class",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "class_id"},
+						{ProtoField: "receiver"},
+						{ProtoField: "ids", Varargs: true},
+					},
+				},
+				{
+					RpcMethod: "BatchBurn",
+					Use:       "batch-burn [class-id] [nft-id]... --from [sender]",
+					Short:     "Burn a batch of owned NFTs of a class",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "class_id"},
+						{ProtoField: "ids", Varargs: true},
+					},
+				},
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // skipped because authority gated
+				},
 			},
 		},
 	}
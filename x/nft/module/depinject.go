@@ -10,6 +10,8 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 var _ depinject.OnePerModuleType = AppModule{}
@@ -26,6 +28,7 @@ func init() {
 type ModuleInputs struct {
 	depinject.In
 
+	Config      *modulev1.Module
 	Environment appmodule.Environment
 	Cdc         codec.Codec
 	Registry    cdctypes.InterfaceRegistry
@@ -42,7 +45,17 @@ type ModuleOutputs struct {
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
-	k := keeper.NewKeeper(in.Environment, in.Cdc, in.AccountKeeper, in.BankKeeper)
+	// default to governance authority if not provided
+	var authority sdk.AccAddress = address.Module("gov")
+	if in.Config.Authority != "" {
+		if addr, err := sdk.AccAddressFromBech32(in.Config.Authority); err == nil {
+			authority = addr
+		} else {
+			authority = address.Module(in.Config.Authority)
+		}
+	}
+
+	k := keeper.NewKeeper(in.Environment, in.Cdc, in.AccountKeeper, in.BankKeeper, authority.String())
 	m := NewAppModule(in.Cdc, k, in.AccountKeeper, in.BankKeeper, in.Registry)
 
 	return ModuleOutputs{NFTKeeper: k, Module: m}
@@ -5,6 +5,7 @@ package nft
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	proto "github.com/cosmos/gogoproto/proto"
 	any "github.com/cosmos/gogoproto/types/any"
 	io "io"
@@ -39,6 +40,14 @@ type Class struct {
 	UriHash string `protobuf:"bytes,6,opt,name=uri_hash,json=uriHash,proto3" json:"uri_hash,omitempty"`
 	// data is the app specific metadata of the NFT class. Optional
 	Data *any.Any `protobuf:"bytes,7,opt,name=data,proto3" json:"data,omitempty"`
+	// admin is the address allowed to update the NFT class with Msg/UpdateClass. Optional
+	//
+	// Since: x/nft v0.2.0
+	Admin string `protobuf:"bytes,8,opt,name=admin,proto3" json:"admin,omitempty"`
+	// royalty defines the royalty paid when a NFT of the class is sold through the keeper. Optional
+	//
+	// Since: x/nft v0.2.0
+	Royalty *Royalty `protobuf:"bytes,9,opt,name=royalty,proto3" json:"royalty,omitempty"`
 }
 
 func (m *Class) Reset()         { *m = Class{} }
@@ -123,6 +132,77 @@ func (m *Class) GetData() *any.Any {
 	return nil
 }
 
+func (m *Class) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func (m *Class) GetRoyalty() *Royalty {
+	if m != nil {
+		return m.Royalty
+	}
+	return nil
+}
+
+// Royalty defines the share of the price of a NFT sale paid to a royalty receiver.
+//
+// Since: x/nft v0.2.0
+type Royalty struct {
+	// receiver is the address receiving the royalties
+	Receiver string `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// basis_points is the share of the sale price paid as royalty, in hundredths of a percent (at most 10000)
+	BasisPoints uint32 `protobuf:"varint,2,opt,name=basis_points,json=basisPoints,proto3" json:"basis_points,omitempty"`
+}
+
+func (m *Royalty) Reset()         { *m = Royalty{} }
+func (m *Royalty) String() string { return proto.CompactTextString(m) }
+func (*Royalty) ProtoMessage()    {}
+func (*Royalty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb8ebf8e8053172c, []int{1}
+}
+func (m *Royalty) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Royalty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Royalty.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Royalty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Royalty.Merge(m, src)
+}
+func (m *Royalty) XXX_Size() int {
+	return m.Size()
+}
+func (m *Royalty) XXX_DiscardUnknown() {
+	xxx_messageInfo_Royalty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Royalty proto.InternalMessageInfo
+
+func (m *Royalty) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *Royalty) GetBasisPoints() uint32 {
+	if m != nil {
+		return m.BasisPoints
+	}
+	return 0
+}
+
 // NFT defines the NFT.
 type NFT struct {
 	// class_id associated with the NFT, similar to the contract address of ERC721
@@ -141,7 +221,7 @@ func (m *NFT) Reset()         { *m = NFT{} }
 func (m *NFT) String() string { return proto.CompactTextString(m) }
 func (*NFT) ProtoMessage()    {}
 func (*NFT) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eb8ebf8e8053172c, []int{1}
+	return fileDescriptor_eb8ebf8e8053172c, []int{2}
 }
 func (m *NFT) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -205,35 +285,94 @@ func (m *NFT) GetData() *any.Any {
 	return nil
 }
 
+// Params defines the parameters of the nft module.
+//
+// Since: x/nft v0.2.0
+type Params struct {
+	// max_batch_size is the maximum number of nfts a single batch message can mint, transfer or burn
+	MaxBatchSize uint32 `protobuf:"varint,1,opt,name=max_batch_size,json=maxBatchSize,proto3" json:"max_batch_size,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb8ebf8e8053172c, []int{3}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMaxBatchSize() uint32 {
+	if m != nil {
+		return m.MaxBatchSize
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Class)(nil), "cosmos.nft.v1beta1.Class")
+	proto.RegisterType((*Royalty)(nil), "cosmos.nft.v1beta1.Royalty")
 	proto.RegisterType((*NFT)(nil), "cosmos.nft.v1beta1.NFT")
+	proto.RegisterType((*Params)(nil), "cosmos.nft.v1beta1.Params")
 }
 
 func init() { proto.RegisterFile("cosmos/nft/v1beta1/nft.proto", fileDescriptor_eb8ebf8e8053172c) }
 
 var fileDescriptor_eb8ebf8e8053172c = []byte{
-	// 308 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xc1, 0x4a, 0xf3, 0x40,
-	0x14, 0x85, 0x3b, 0x49, 0x9a, 0xfc, 0xff, 0x14, 0x44, 0x06, 0x91, 0xa9, 0xc8, 0x10, 0xba, 0xca,
-	0x42, 0x26, 0x54, 0x9f, 0x40, 0x05, 0xd1, 0x8d, 0x8b, 0xe2, 0xca, 0x4d, 0x99, 0x64, 0xd2, 0x66,
-	0x30, 0xc9, 0x94, 0x4c, 0x22, 0xe6, 0x09, 0xdc, 0xfa, 0x40, 0x3e, 0x80, 0xcb, 0x2e, 0x5d, 0x4a,
-	0xf2, 0x22, 0x32, 0x93, 0x58, 0x5c, 0x14, 0xdc, 0xdd, 0x7b, 0xce, 0xe1, 0xf2, 0x1d, 0x2e, 0x3c,
-	0x8d, 0xa5, 0xca, 0xa5, 0x0a, 0x8b, 0x55, 0x15, 0x3e, 0xcf, 0xa3, 0xa4, 0x62, 0x73, 0x3d, 0xd3,
-	0x4d, 0x29, 0x2b, 0x89, 0x50, 0xef, 0x52, 0xad, 0x0c, 0xee, 0xc9, 0x74, 0x2d, 0xe5, 0x3a, 0x4b,
-	0x42, 0x93, 0x88, 0xea, 0x55, 0xc8, 0x8a, 0xa6, 0x8f, 0xcf, 0xde, 0x01, 0x1c, 0x5f, 0x67, 0x4c,
-	0x29, 0x74, 0x00, 0x2d, 0xc1, 0x31, 0xf0, 0x41, 0xf0, 0x7f, 0x61, 0x09, 0x8e, 0x10, 0x74, 0x0a,
-	0x96, 0x27, 0xd8, 0x32, 0x8a, 0x99, 0xd1, 0x31, 0x74, 0x55, 0x93, 0x47, 0x32, 0xc3, 0xb6, 0x51,
-	0x87, 0x0d, 0xf9, 0x70, 0xc2, 0x13, 0x15, 0x97, 0x62, 0x53, 0x09, 0x59, 0x60, 0xc7, 0x98, 0xbf,
-	0x25, 0x74, 0x08, 0xed, 0xba, 0x14, 0x78, 0x6c, 0x1c, 0x3d, 0xa2, 0x29, 0xfc, 0x57, 0x97, 0x62,
-	0x99, 0x32, 0x95, 0x62, 0xd7, 0xc8, 0x5e, 0x5d, 0x8a, 0x5b, 0xa6, 0x52, 0x14, 0x40, 0x87, 0xb3,
-	0x8a, 0x61, 0xcf, 0x07, 0xc1, 0xe4, 0xfc, 0x88, 0xf6, 0xf8, 0xf4, 0x07, 0x9f, 0x5e, 0x16, 0xcd,
-	0xc2, 0x24, 0x66, 0xaf, 0x00, 0xda, 0xf7, 0x37, 0x0f, 0xfa, 0x58, 0xac, 0x5b, 0x2c, 0x77, 0x15,
-	0x3c, 0xb3, 0xdf, 0xf1, 0xa1, 0x97, 0xb5, 0xeb, 0x35, 0x90, 0xd8, 0xfb, 0x49, 0x9c, 0xfd, 0x24,
-	0xf0, 0x2f, 0x92, 0xab, 0xb3, 0x8f, 0x96, 0x80, 0x6d, 0x4b, 0xc0, 0x57, 0x4b, 0xc0, 0x5b, 0x47,
-	0x46, 0xdb, 0x8e, 0x8c, 0x3e, 0x3b, 0x32, 0x7a, 0x1c, 0x3e, 0xa2, 0xf8, 0x13, 0x15, 0x32, 0x7c,
-	0xd1, 0xbf, 0x8a, 0x5c, 0x73, 0xe1, 0xe2, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xf2, 0xf7, 0xce, 0x23,
-	0xcc, 0x01, 0x00, 0x00,
+	// 452 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x4f, 0x6e, 0xd3, 0x40,
+	0x14, 0xc6, 0x63, 0xe7, 0x8f, 0xd3, 0x97, 0xb6, 0x42, 0xa3, 0x0a, 0x4d, 0x0a, 0xb2, 0x42, 0xc4,
+	0x22, 0x0b, 0x18, 0xab, 0xfc, 0x39, 0x40, 0x83, 0x84, 0x60, 0x83, 0x2a, 0x97, 0x15, 0x1b, 0x6b,
+	0xec, 0x99, 0x26, 0x23, 0x62, 0x4f, 0x34, 0x33, 0xa9, 0xe2, 0x5e, 0x80, 0x2d, 0x77, 0xe0, 0x0a,
+	0x1c, 0x82, 0x65, 0xc5, 0x8a, 0x25, 0x4a, 0x2e, 0x82, 0xfc, 0xec, 0x46, 0x48, 0xad, 0x94, 0xdd,
+	0x7b, 0xbf, 0xf7, 0x79, 0xc6, 0xef, 0xfb, 0x06, 0x9e, 0x66, 0xda, 0xe6, 0xda, 0x46, 0xc5, 0x95,
+	0x8b, 0xae, 0xcf, 0x52, 0xe9, 0xf8, 0x59, 0x55, 0xb3, 0xa5, 0xd1, 0x4e, 0x13, 0x52, 0x4f, 0x59,
+	0x45, 0x9a, 0xe9, 0xe9, 0x70, 0xa6, 0xf5, 0x6c, 0x21, 0x23, 0x54, 0xa4, 0xab, 0xab, 0x88, 0x17,
+	0x65, 0x2d, 0x3f, 0x1d, 0xd6, 0xf2, 0x04, 0xbb, 0xa8, 0xf9, 0x16, 0x9b, 0xf1, 0x0f, 0x1f, 0xba,
+	0xef, 0x16, 0xdc, 0x5a, 0x72, 0x0c, 0xbe, 0x12, 0xd4, 0x1b, 0x79, 0x93, 0x83, 0xd8, 0x57, 0x82,
+	0x10, 0xe8, 0x14, 0x3c, 0x97, 0xd4, 0x47, 0x82, 0x35, 0x79, 0x0c, 0x3d, 0x5b, 0xe6, 0xa9, 0x5e,
+	0xd0, 0x36, 0xd2, 0xa6, 0x23, 0x23, 0x18, 0x08, 0x69, 0x33, 0xa3, 0x96, 0x4e, 0xe9, 0x82, 0x76,
+	0x70, 0xf8, 0x3f, 0x22, 0x8f, 0xa0, 0xbd, 0x32, 0x8a, 0x76, 0x71, 0x52, 0x95, 0x64, 0x08, 0xfd,
+	0x95, 0x51, 0xc9, 0x9c, 0xdb, 0x39, 0xed, 0x21, 0x0e, 0x56, 0x46, 0x7d, 0xe0, 0x76, 0x4e, 0x26,
+	0xd0, 0x11, 0xdc, 0x71, 0x1a, 0x8c, 0xbc, 0xc9, 0xe0, 0xd5, 0x09, 0xab, 0x37, 0x63, 0x77, 0x9b,
+	0xb1, 0xf3, 0xa2, 0x8c, 0x51, 0x41, 0x18, 0x74, 0xb9, 0xc8, 0x55, 0x41, 0xfb, 0xd5, 0x09, 0x53,
+	0xfa, 0xfb, 0xe7, 0xcb, 0x93, 0x66, 0xbf, 0x73, 0x21, 0x8c, 0xb4, 0xf6, 0xd2, 0x19, 0x55, 0xcc,
+	0xe2, 0x5a, 0x46, 0xde, 0x42, 0x60, 0x74, 0xc9, 0x17, 0xae, 0xa4, 0x07, 0x78, 0xf8, 0x13, 0x76,
+	0xdf, 0x4a, 0x16, 0xd7, 0x92, 0xf8, 0x4e, 0x3b, 0x4e, 0x21, 0x68, 0x18, 0x79, 0x03, 0x7d, 0x23,
+	0x33, 0xa9, 0xae, 0xa5, 0xa1, 0xde, 0x9e, 0x4b, 0x77, 0x4a, 0xf2, 0x0c, 0x0e, 0x53, 0x6e, 0x95,
+	0x4d, 0x96, 0x5a, 0x15, 0xce, 0xa2, 0xa9, 0x47, 0xf1, 0x00, 0xd9, 0x05, 0xa2, 0xf1, 0x37, 0x0f,
+	0xda, 0x9f, 0xde, 0x7f, 0xae, 0x7c, 0xc9, 0xaa, 0x40, 0x92, 0x5d, 0x1a, 0x01, 0xf6, 0x1f, 0x45,
+	0x13, 0x91, 0xbf, 0x8b, 0xa8, 0x31, 0xb5, 0xfd, 0xb0, 0xa9, 0x9d, 0x87, 0x4d, 0x85, 0x7d, 0xa6,
+	0x8e, 0x19, 0xf4, 0x2e, 0xb8, 0xe1, 0xb9, 0x25, 0xcf, 0xe1, 0x38, 0xe7, 0xeb, 0x24, 0xe5, 0x2e,
+	0x9b, 0x27, 0x56, 0xdd, 0x48, 0xfc, 0xa3, 0xa3, 0xf8, 0x30, 0xe7, 0xeb, 0x69, 0x05, 0x2f, 0xd5,
+	0x8d, 0x9c, 0xbe, 0xf8, 0xb5, 0x09, 0xbd, 0xdb, 0x4d, 0xe8, 0xfd, 0xdd, 0x84, 0xde, 0xf7, 0x6d,
+	0xd8, 0xba, 0xdd, 0x86, 0xad, 0x3f, 0xdb, 0xb0, 0xf5, 0xa5, 0x79, 0xa7, 0x56, 0x7c, 0x65, 0x4a,
+	0x47, 0xeb, 0xea, 0x05, 0xa7, 0x3d, 0xbc, 0xf1, 0xf5, 0xbf, 0x01, 0x00, 0xcf, 0xa7, 0xe7, 0x70,
+	0xe2, 0x02, 0x00, 0x00,
 }
 
 func (m *Class) Marshal() (dAtA []byte, err error) {
@@ -256,6 +395,25 @@ func (m *Class) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Royalty != nil {
+		{
+			size, err := m.Royalty.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNft(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.Data != nil {
 		{
 			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
@@ -313,6 +471,41 @@ func (m *Class) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Royalty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Royalty) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Royalty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BasisPoints != 0 {
+		i = encodeVarintNft(dAtA, i, uint64(m.BasisPoints))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *NFT) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -376,6 +569,34 @@ func (m *NFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxBatchSize != 0 {
+		i = encodeVarintNft(dAtA, i, uint64(m.MaxBatchSize))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintNft(dAtA []byte, offset int, v uint64) int {
 	offset -= sovNft(v)
 	base := offset
@@ -421,6 +642,30 @@ func (m *Class) Size() (n int) {
 		l = m.Data.Size()
 		n += 1 + l + sovNft(uint64(l))
 	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	if m.Royalty != nil {
+		l = m.Royalty.Size()
+		n += 1 + l + sovNft(uint64(l))
+	}
+	return n
+}
+
+func (m *Royalty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	if m.BasisPoints != 0 {
+		n += 1 + sovNft(uint64(m.BasisPoints))
+	}
 	return n
 }
 
@@ -453,6 +698,18 @@ func (m *NFT) Size() (n int) {
 	return n
 }
 
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxBatchSize != 0 {
+		n += 1 + sovNft(uint64(m.MaxBatchSize))
+	}
+	return n
+}
+
 func sovNft(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -716,6 +973,175 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Royalty == nil {
+				m.Royalty = &Royalty{}
+			}
+			if err := m.Royalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNft(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNft
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Royalty) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Royalty: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Royalty: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasisPoints", wireType)
+			}
+			m.BasisPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BasisPoints |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNft(dAtA[iNdEx:])
@@ -951,6 +1377,75 @@ func (m *NFT) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBatchSize", wireType)
+			}
+			m.MaxBatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBatchSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNft(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNft
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipNft(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,23 @@
+package nft
+
+import (
+	"fmt"
+)
+
+// DefaultMaxBatchSize is the default maximum number of nfts a single batch message can handle
+const DefaultMaxBatchSize uint32 = 100
+
+// DefaultParams returns the default parameters of the nft module
+func DefaultParams() Params {
+	return Params{
+		MaxBatchSize: DefaultMaxBatchSize,
+	}
+}
+
+// Validate checks that the parameters have valid values
+func (p Params) Validate() error {
+	if p.MaxBatchSize == 0 {
+		return fmt.Errorf("max batch size must be positive")
+	}
+	return nil
+}
@@ -65,9 +65,16 @@ func RandomizedGenState(simState *module.SimulationState, ac address.Codec) {
 		},
 	)
 
+	var maxBatchSize uint32
+	simState.AppParams.GetOrGenerate(
+		"max_batch_size", &maxBatchSize, simState.Rand,
+		func(r *rand.Rand) { maxBatchSize = uint32(simtypes.RandIntBetween(r, 1, 200)) },
+	)
+
 	nftGenesis := &nft.GenesisState{
 		Classes: classes,
 		Entries: entries,
+		Params:  &nft.Params{MaxBatchSize: maxBatchSize},
 	}
 	simState.GenState[nft.ModuleName] = simState.Cdc.MustMarshalJSON(nftGenesis)
 }
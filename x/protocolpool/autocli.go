@@ -28,6 +28,19 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Example:        fmt.Sprintf(`$ %s query protocolpool unclaimed-budget cosmos1...`, version.AppName),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
 				},
+				{
+					RpcMethod:      "FundingStream",
+					Use:            "funding-stream [recipient-address]",
+					Short:          "Query the funding stream of a recipient",
+					Example:        fmt.Sprintf(`$ %s query protocolpool funding-stream cosmos1...`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "recipient"}},
+				},
+				{
+					RpcMethod: "FundingStreams",
+					Use:       "funding-streams",
+					Short:     "Query all the funding streams",
+					Example:   fmt.Sprintf(`$ %s query protocolpool funding-streams`, version.AppName),
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -89,6 +102,30 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					},
 					GovProposal: true,
 				},
+				{
+					RpcMethod: "CreateFundingStream",
+					Use:       "create-funding-stream [recipient] [amount-per-period] [period-blocks] [periods] <start-height>",
+					Short:     "Create a funding stream paying out the recipient from the community pool every period, with optional start height",
+					Example:   fmt.Sprintf(`$ %s tx protocolpool create-funding-stream cosmos1... 1000uatom 100 12 --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "recipient"},
+						{ProtoField: "amount_per_period"},
+						{ProtoField: "period_blocks"},
+						{ProtoField: "periods"},
+						{ProtoField: "start_height", Optional: true},
+					},
+					GovProposal: true,
+				},
+				{
+					RpcMethod: "CancelFundingStream",
+					Use:       "cancel-funding-stream [recipient_address]",
+					Short:     "Cancel the funding stream of a specific recipient",
+					Example:   fmt.Sprintf(`$ %s tx protocolpool cancel-funding-stream cosmos1... --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "recipient_address"},
+					},
+					GovProposal: true,
+				},
 			},
 		},
 	}
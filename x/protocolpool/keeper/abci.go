@@ -0,0 +1,17 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/x/protocolpool/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// EndBlocker pays out the funding streams which are due at the current height.
+func (k Keeper) EndBlocker(ctx context.Context) error {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	return k.DistributeFundingStreams(ctx)
+}
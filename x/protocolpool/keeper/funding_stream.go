@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/protocolpool/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetFundingStream sets the funding stream of the recipient, replacing any
+// stream it already has, and queues it by the height of its next period.
+func (k Keeper) SetFundingStream(ctx context.Context, recipient sdk.AccAddress, stream types.FundingStream) error {
+	if err := k.RemoveFundingStream(ctx, recipient); err != nil {
+		return err
+	}
+
+	if err := k.FundingStreams.Set(ctx, recipient, stream); err != nil {
+		return err
+	}
+
+	return k.FundingStreamsQueue.Set(ctx, collections.Join(stream.NextHeight, recipient))
+}
+
+// RemoveFundingStream removes the funding stream of the recipient, if any, and
+// its queue entry.
+func (k Keeper) RemoveFundingStream(ctx context.Context, recipient sdk.AccAddress) error {
+	stream, err := k.FundingStreams.Get(ctx, recipient)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if err := k.FundingStreamsQueue.Remove(ctx, collections.Join(stream.NextHeight, recipient)); err != nil {
+		return err
+	}
+
+	return k.FundingStreams.Remove(ctx, recipient)
+}
+
+// DistributeFundingStreams pays out from the community pool the funding streams
+// due at the current height, ranging over their queue up to that height. A
+// stream that cannot be paid out because the community pool lacks the funds
+// skips the period, which is then appended to the end of the stream. Streams
+// are removed once all their periods are paid out.
+func (k Keeper) DistributeFundingStreams(ctx context.Context) error {
+	height := uint64(k.environment.HeaderService.GetHeaderInfo(ctx).Height)
+
+	var due []sdk.AccAddress
+	rng := collections.NewPrefixUntilPairRange[uint64, sdk.AccAddress](height)
+	err := k.FundingStreamsQueue.Walk(ctx, rng, func(key collections.Pair[uint64, sdk.AccAddress]) (stop bool, err error) {
+		due = append(due, key.K2())
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, recipient := range due {
+		stream, err := k.FundingStreams.Get(ctx, recipient)
+		if err != nil {
+			return err
+		}
+
+		stream.NextHeight = height + stream.PeriodBlocks
+
+		err = k.environment.BranchService.Execute(ctx, func(ctx context.Context) error {
+			return k.DistributeFromCommunityPool(ctx, stream.AmountPerPeriod, recipient)
+		})
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("failed to pay out funding stream for recipient %s, deferring to the next period", stream.Recipient), "error", err)
+			if err := k.SetFundingStream(ctx, recipient, stream); err != nil {
+				return err
+			}
+			continue
+		}
+
+		k.Logger(ctx).Debug(fmt.Sprintf("paid out funding stream for recipient: %s. Amount: %s", stream.Recipient, stream.AmountPerPeriod))
+
+		stream.PeriodsLeft--
+		if stream.PeriodsLeft == 0 {
+			if err := k.RemoveFundingStream(ctx, recipient); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := k.SetFundingStream(ctx, recipient, stream); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
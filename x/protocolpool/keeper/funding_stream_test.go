@@ -0,0 +1,78 @@
+package keeper_test
+
+import (
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/protocolpool/types"
+
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (suite *KeeperTestSuite) TestDistributeFundingStreams() {
+	recipientStrAddr, err := codectestutil.CodecOptions{}.GetAddressCodec().BytesToString(recipientAddr)
+	suite.Require().NoError(err)
+	recipient2 := sdk.AccAddress([]byte("recipientAddr2___________________"))
+	recipient2StrAddr, err := codectestutil.CodecOptions{}.GetAddressCodec().BytesToString(recipient2)
+	suite.Require().NoError(err)
+
+	// a stream paid out every block, and a stream paid out every 10 blocks
+	err = suite.poolKeeper.SetFundingStream(suite.ctx, recipientAddr, types.FundingStream{
+		Recipient:       recipientStrAddr,
+		AmountPerPeriod: sdk.NewCoins(fooCoin),
+		PeriodBlocks:    1,
+		PeriodsLeft:     2,
+		NextHeight:      10,
+	})
+	suite.Require().NoError(err)
+	err = suite.poolKeeper.SetFundingStream(suite.ctx, recipient2, types.FundingStream{
+		Recipient:       recipient2StrAddr,
+		AmountPerPeriod: sdk.NewCoins(fooCoin2),
+		PeriodBlocks:    10,
+		PeriodsLeft:     3,
+		NextHeight:      11,
+	})
+	suite.Require().NoError(err)
+
+	// only the first stream is due
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, recipientAddr, sdk.NewCoins(fooCoin)).Return(nil)
+	suite.Require().NoError(suite.poolKeeper.DistributeFundingStreams(suite.ctx.WithHeaderInfo(header.Info{Height: 10})))
+	stream, err := suite.poolKeeper.FundingStreams.Get(suite.ctx, recipientAddr)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), stream.PeriodsLeft)
+	suite.Require().Equal(uint64(11), stream.NextHeight)
+
+	// both streams are due, the first one is removed once its last period is
+	// paid out, and the second one is deferred as the community pool lacks the
+	// funds
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, recipientAddr, sdk.NewCoins(fooCoin)).Return(nil)
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, recipient2, sdk.NewCoins(fooCoin2)).Return(sdkerrors.ErrInsufficientFunds)
+	suite.Require().NoError(suite.poolKeeper.DistributeFundingStreams(suite.ctx.WithHeaderInfo(header.Info{Height: 11})))
+	has, err := suite.poolKeeper.FundingStreams.Has(suite.ctx, recipientAddr)
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+	stream, err = suite.poolKeeper.FundingStreams.Get(suite.ctx, recipient2)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(3), stream.PeriodsLeft)
+	suite.Require().Equal(uint64(21), stream.NextHeight)
+
+	// nothing is due
+	suite.Require().NoError(suite.poolKeeper.DistributeFundingStreams(suite.ctx.WithHeaderInfo(header.Info{Height: 20})))
+
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, recipient2, sdk.NewCoins(fooCoin2)).Return(nil)
+	suite.Require().NoError(suite.poolKeeper.DistributeFundingStreams(suite.ctx.WithHeaderInfo(header.Info{Height: 21})))
+	stream, err = suite.poolKeeper.FundingStreams.Get(suite.ctx, recipient2)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), stream.PeriodsLeft)
+	suite.Require().Equal(uint64(31), stream.NextHeight)
+
+	// the streams are only queued at the height of their next period
+	queued, err := suite.poolKeeper.FundingStreamsQueue.Iterate(suite.ctx, nil)
+	suite.Require().NoError(err)
+	keys, err := queued.Keys()
+	suite.Require().NoError(err)
+	suite.Require().Equal([]collections.Pair[uint64, sdk.AccAddress]{collections.Join(uint64(31), recipient2)}, keys)
+}
@@ -44,6 +44,16 @@ func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) error
 		}
 	}
 
+	for _, stream := range data.FundingStreams {
+		recipientAddress, err := k.authKeeper.AddressCodec().StringToBytes(stream.Recipient)
+		if err != nil {
+			return fmt.Errorf("failed to decode recipient address: %w", err)
+		}
+		if err := k.SetFundingStream(ctx, recipientAddress, stream); err != nil {
+			return fmt.Errorf("failed to set funding stream for recipient %s: %w", stream.Recipient, err)
+		}
+	}
+
 	if err := k.ToDistribute.Set(ctx, data.ToDistribute); err != nil {
 		return fmt.Errorf("failed to set to distribute: %w", err)
 	}
@@ -93,6 +103,14 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 
 	genState := types.NewGenesisState(cf, budget)
 
+	err = k.FundingStreams.Walk(ctx, nil, func(_ sdk.AccAddress, value types.FundingStream) (stop bool, err error) {
+		genState.FundingStreams = append(genState.FundingStreams, value)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	genState.ToDistribute, err = k.ToDistribute.Get(ctx)
 	if err != nil {
 		return nil, err
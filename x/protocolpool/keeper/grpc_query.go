@@ -12,6 +12,7 @@ import (
 	"cosmossdk.io/x/protocolpool/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 var _ types.QueryServer = Querier{}
@@ -76,3 +77,39 @@ func (k Querier) UnclaimedBudget(ctx context.Context, req *types.QueryUnclaimedB
 		TranchesLeft:    budget.TranchesLeft,
 	}, nil
 }
+
+// FundingStream queries the funding stream of a recipient
+func (k Querier) FundingStream(ctx context.Context, req *types.QueryFundingStreamRequest) (*types.QueryFundingStreamResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	recipient, err := k.Keeper.authKeeper.AddressCodec().StringToBytes(req.Recipient)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid recipient address: %s", err.Error())
+	}
+	stream, err := k.Keeper.FundingStreams.Get(ctx, recipient)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "no funding stream found for address: %s", req.Recipient)
+		}
+		return nil, err
+	}
+
+	return &types.QueryFundingStreamResponse{FundingStream: stream}, nil
+}
+
+// FundingStreams queries all the funding streams
+func (k Querier) FundingStreams(ctx context.Context, req *types.QueryFundingStreamsRequest) (*types.QueryFundingStreamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	streams, pageRes, err := query.CollectionPaginate(ctx, k.Keeper.FundingStreams, req.Pagination, func(_ sdk.AccAddress, stream types.FundingStream) (types.FundingStream, error) {
+		return stream, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryFundingStreamsResponse{FundingStreams: streams, Pagination: pageRes}, nil
+}
@@ -39,6 +39,10 @@ type Keeper struct {
 	RecipientFundDistribution collections.Map[sdk.AccAddress, math.Int]
 	// ToDistribute is to keep track of funds distributed
 	ToDistribute collections.Item[math.Int]
+	// FundingStreams key: RecipientAddr | value: FundingStream
+	FundingStreams collections.Map[sdk.AccAddress, types.FundingStream]
+	// FundingStreamsQueue key: NextHeight | RecipientAddr
+	FundingStreamsQueue collections.KeySet[collections.Pair[uint64, sdk.AccAddress]]
 }
 
 func NewKeeper(cdc codec.BinaryCodec, env appmodule.Environment, ak types.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper, authority string,
@@ -66,6 +70,8 @@ func NewKeeper(cdc codec.BinaryCodec, env appmodule.Environment, ak types.Accoun
 		RecipientFundPercentage:   collections.NewMap(sb, types.RecipientFundPercentageKey, "recipient_fund_percentage", sdk.AccAddressKey, sdk.IntValue),
 		RecipientFundDistribution: collections.NewMap(sb, types.RecipientFundDistributionKey, "recipient_fund_distribution", sdk.AccAddressKey, sdk.IntValue),
 		ToDistribute:              collections.NewItem(sb, types.ToDistributeKey, "to_distribute", sdk.IntValue),
+		FundingStreams:            collections.NewMap(sb, types.FundingStreamKey, "funding_streams", sdk.AccAddressKey, codec.CollValue[types.FundingStream](cdc)),
+		FundingStreamsQueue:       collections.NewKeySet(sb, types.FundingStreamQueueKey, "funding_streams_queue", collections.PairKeyCodec(collections.Uint64Key, sdk.AccAddressKey)),
 	}
 
 	schema, err := sb.Build()
@@ -5,6 +5,7 @@ import (
 	errorspkg "errors"
 	"fmt"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	"cosmossdk.io/x/protocolpool/types"
@@ -49,6 +50,15 @@ func (k MsgServer) SubmitBudgetProposal(ctx context.Context, msg *types.MsgSubmi
 		return nil, err
 	}
 
+	// a recipient is funded either by a budget, which it claims, or by a funding stream
+	hasStream, err := k.FundingStreams.Has(ctx, recipient)
+	if err != nil {
+		return nil, err
+	}
+	if hasStream {
+		return nil, fmt.Errorf("invalid budget proposal: recipient %s already has a funding stream", msg.RecipientAddress)
+	}
+
 	// set budget proposal in state
 	// Note: If two budgets to the same address are created, the budget would be updated with the new budget.
 	err = k.BudgetProposal.Set(ctx, recipient, *budgetProposal)
@@ -209,6 +219,79 @@ func (k MsgServer) CancelContinuousFund(ctx context.Context, msg *types.MsgCance
 	}, nil
 }
 
+func (k MsgServer) CreateFundingStream(ctx context.Context, msg *types.MsgCreateFundingStream) (*types.MsgCreateFundingStreamResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	recipient, err := k.authKeeper.AddressCodec().StringToBytes(msg.Recipient)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+
+	if err := types.ValidateFundingStream(msg.AmountPerPeriod, msg.PeriodBlocks, msg.Periods); err != nil {
+		return nil, err
+	}
+
+	// the first period is paid out at the end of the next block at the earliest
+	height := uint64(k.environment.HeaderService.GetHeaderInfo(ctx).Height)
+	startHeight := msg.StartHeight
+	if startHeight == 0 {
+		startHeight = height + 1
+	}
+	if startHeight <= height {
+		return nil, errors.Wrapf(types.ErrInvalidStream, "start height %d must be greater than the current height %d", startHeight, height)
+	}
+
+	// a recipient is funded either by a budget, which it claims, or by a funding stream
+	hasBudget, err := k.BudgetProposal.Has(ctx, recipient)
+	if err != nil {
+		return nil, err
+	}
+	if hasBudget {
+		return nil, errors.Wrapf(types.ErrInvalidStream, "recipient %s already has a budget", msg.Recipient)
+	}
+
+	// Note: If two funding streams to the same address are created, the stream would be replaced with the new stream.
+	err = k.SetFundingStream(ctx, recipient, types.FundingStream{
+		Recipient:       msg.Recipient,
+		AmountPerPeriod: msg.AmountPerPeriod,
+		PeriodBlocks:    msg.PeriodBlocks,
+		PeriodsLeft:     msg.Periods,
+		NextHeight:      startHeight,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateFundingStreamResponse{}, nil
+}
+
+func (k MsgServer) CancelFundingStream(ctx context.Context, msg *types.MsgCancelFundingStream) (*types.MsgCancelFundingStreamResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	recipient, err := k.authKeeper.AddressCodec().StringToBytes(msg.RecipientAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+
+	stream, err := k.FundingStreams.Get(ctx, recipient)
+	if err != nil {
+		if errorspkg.Is(err, collections.ErrNotFound) {
+			return nil, errors.Wrapf(types.ErrNoFundingStream, "recipient %s", msg.RecipientAddress)
+		}
+		return nil, err
+	}
+
+	if err := k.RemoveFundingStream(ctx, recipient); err != nil {
+		return nil, fmt.Errorf("failed to remove funding stream for recipient %s: %w", msg.RecipientAddress, err)
+	}
+
+	return &types.MsgCancelFundingStreamResponse{PeriodsLeft: stream.PeriodsLeft}, nil
+}
+
 func (k *Keeper) validateAuthority(authority string) error {
 	if _, err := k.authKeeper.AddressCodec().StringToBytes(authority); err != nil {
 		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
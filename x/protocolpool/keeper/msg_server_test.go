@@ -28,6 +28,7 @@ func (suite *KeeperTestSuite) TestMsgSubmitBudgetProposal() {
 	recipientStrAddr, err := codectestutil.CodecOptions{}.GetAddressCodec().BytesToString(recipientAddr)
 	suite.Require().NoError(err)
 	testCases := map[string]struct {
+		preRun    func()
 		input     *types.MsgSubmitBudgetProposal
 		expErr    bool
 		expErrMsg string
@@ -116,6 +117,28 @@ func (suite *KeeperTestSuite) TestMsgSubmitBudgetProposal() {
 			expErr:    true,
 			expErrMsg: "period length should be greater than zero",
 		},
+		"recipient with a funding stream": {
+			preRun: func() {
+				err := suite.poolKeeper.SetFundingStream(suite.ctx, recipientAddr, types.FundingStream{
+					Recipient:       recipientStrAddr,
+					AmountPerPeriod: sdk.NewCoins(fooCoin),
+					PeriodBlocks:    1,
+					PeriodsLeft:     2,
+					NextHeight:      10,
+				})
+				suite.Require().NoError(err)
+			},
+			input: &types.MsgSubmitBudgetProposal{
+				Authority:        suite.poolKeeper.GetAuthority(),
+				RecipientAddress: recipientStrAddr,
+				TotalBudget:      &fooCoin,
+				StartTime:        &startTime,
+				Tranches:         2,
+				Period:           &period,
+			},
+			expErr:    true,
+			expErrMsg: "already has a funding stream",
+		},
 		"all good": {
 			input: &types.MsgSubmitBudgetProposal{
 				Authority:        suite.poolKeeper.GetAuthority(),
@@ -132,6 +155,9 @@ func (suite *KeeperTestSuite) TestMsgSubmitBudgetProposal() {
 	for name, tc := range testCases {
 		suite.Run(name, func() {
 			suite.SetupTest()
+			if tc.preRun != nil {
+				tc.preRun()
+			}
 
 			_, err := suite.msgServer.SubmitBudgetProposal(suite.ctx, tc.input)
 			if tc.expErr {
@@ -918,3 +944,194 @@ func (suite *KeeperTestSuite) TestCancelContinuousFund() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestCreateFundingStream() {
+	recipientStrAddr, err := codectestutil.CodecOptions{}.GetAddressCodec().BytesToString(recipientAddr)
+	suite.Require().NoError(err)
+	testCases := map[string]struct {
+		preRun    func()
+		input     *types.MsgCreateFundingStream
+		expErr    bool
+		expErrMsg string
+		expStream types.FundingStream
+	}{
+		"invalid authority": {
+			input: &types.MsgCreateFundingStream{
+				Authority:       "invalid_authority",
+				Recipient:       recipientStrAddr,
+				AmountPerPeriod: sdk.NewCoins(fooCoin),
+				PeriodBlocks:    10,
+				Periods:         3,
+			},
+			expErr:    true,
+			expErrMsg: "invalid authority",
+		},
+		"empty recipient address": {
+			input: &types.MsgCreateFundingStream{
+				Authority:       suite.poolKeeper.GetAuthority(),
+				Recipient:       "",
+				AmountPerPeriod: sdk.NewCoins(fooCoin),
+				PeriodBlocks:    10,
+				Periods:         3,
+			},
+			expErr:    true,
+			expErrMsg: "empty address string is not allowed",
+		},
+		"zero amount per period": {
+			input: &types.MsgCreateFundingStream{
+				Authority:    suite.poolKeeper.GetAuthority(),
+				Recipient:    recipientStrAddr,
+				PeriodBlocks: 10,
+				Periods:      3,
+			},
+			expErr:    true,
+			expErrMsg: "amount per period cannot be zero",
+		},
+		"zero period blocks": {
+			input: &types.MsgCreateFundingStream{
+				Authority:       suite.poolKeeper.GetAuthority(),
+				Recipient:       recipientStrAddr,
+				AmountPerPeriod: sdk.NewCoins(fooCoin),
+				Periods:         3,
+			},
+			expErr:    true,
+			expErrMsg: "period length should be greater than zero",
+		},
+		"zero periods": {
+			input: &types.MsgCreateFundingStream{
+				Authority:       suite.poolKeeper.GetAuthority(),
+				Recipient:       recipientStrAddr,
+				AmountPerPeriod: sdk.NewCoins(fooCoin),
+				PeriodBlocks:    10,
+			},
+			expErr:    true,
+			expErrMsg: "periods must be greater than zero",
+		},
+		"start height in the past": {
+			input: &types.MsgCreateFundingStream{
+				Authority:       suite.poolKeeper.GetAuthority(),
+				Recipient:       recipientStrAddr,
+				AmountPerPeriod: sdk.NewCoins(fooCoin),
+				PeriodBlocks:    10,
+				Periods:         3,
+				StartHeight:     5,
+			},
+			expErr:    true,
+			expErrMsg: "start height 5 must be greater than the current height 10",
+		},
+		"recipient with a budget": {
+			preRun: func() {
+				period := time.Minute
+				err := suite.poolKeeper.BudgetProposal.Set(suite.ctx, recipientAddr, types.Budget{
+					RecipientAddress: recipientStrAddr,
+					TotalBudget:      &fooCoin,
+					Tranches:         2,
+					Period:           &period,
+				})
+				suite.Require().NoError(err)
+			},
+			input: &types.MsgCreateFundingStream{
+				Authority:       suite.poolKeeper.GetAuthority(),
+				Recipient:       recipientStrAddr,
+				AmountPerPeriod: sdk.NewCoins(fooCoin),
+				PeriodBlocks:    10,
+				Periods:         3,
+			},
+			expErr:    true,
+			expErrMsg: "already has a budget",
+		},
+		"all good with default start height": {
+			input: &types.MsgCreateFundingStream{
+				Authority:       suite.poolKeeper.GetAuthority(),
+				Recipient:       recipientStrAddr,
+				AmountPerPeriod: sdk.NewCoins(fooCoin),
+				PeriodBlocks:    1,
+				Periods:         3,
+			},
+			expStream: types.FundingStream{
+				Recipient:       recipientStrAddr,
+				AmountPerPeriod: sdk.NewCoins(fooCoin),
+				PeriodBlocks:    1,
+				PeriodsLeft:     3,
+				NextHeight:      11,
+			},
+		},
+		"all good with start height": {
+			input: &types.MsgCreateFundingStream{
+				Authority:       suite.poolKeeper.GetAuthority(),
+				Recipient:       recipientStrAddr,
+				AmountPerPeriod: sdk.NewCoins(fooCoin),
+				PeriodBlocks:    100,
+				Periods:         12,
+				StartHeight:     50,
+			},
+			expStream: types.FundingStream{
+				Recipient:       recipientStrAddr,
+				AmountPerPeriod: sdk.NewCoins(fooCoin),
+				PeriodBlocks:    100,
+				PeriodsLeft:     12,
+				NextHeight:      50,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			suite.ctx = suite.ctx.WithHeaderInfo(header.Info{Height: 10})
+			if tc.preRun != nil {
+				tc.preRun()
+			}
+
+			_, err := suite.msgServer.CreateFundingStream(suite.ctx, tc.input)
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+				return
+			}
+
+			suite.Require().NoError(err)
+			stream, err := suite.poolKeeper.FundingStreams.Get(suite.ctx, recipientAddr)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expStream, stream)
+			has, err := suite.poolKeeper.FundingStreamsQueue.Has(suite.ctx, collections.Join(tc.expStream.NextHeight, recipientAddr))
+			suite.Require().NoError(err)
+			suite.Require().True(has)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestCancelFundingStream() {
+	recipientStrAddr, err := codectestutil.CodecOptions{}.GetAddressCodec().BytesToString(recipientAddr)
+	suite.Require().NoError(err)
+
+	msg := &types.MsgCancelFundingStream{
+		Authority:        suite.poolKeeper.GetAuthority(),
+		RecipientAddress: recipientStrAddr,
+	}
+	_, err = suite.msgServer.CancelFundingStream(suite.ctx, msg)
+	suite.Require().ErrorIs(err, types.ErrNoFundingStream)
+
+	err = suite.poolKeeper.SetFundingStream(suite.ctx, recipientAddr, types.FundingStream{
+		Recipient:       recipientStrAddr,
+		AmountPerPeriod: sdk.NewCoins(fooCoin),
+		PeriodBlocks:    10,
+		PeriodsLeft:     2,
+		NextHeight:      20,
+	})
+	suite.Require().NoError(err)
+
+	_, err = suite.msgServer.CancelFundingStream(suite.ctx, &types.MsgCancelFundingStream{
+		Authority:        "invalid_authority",
+		RecipientAddress: recipientStrAddr,
+	})
+	suite.Require().ErrorContains(err, "invalid authority")
+
+	resp, err := suite.msgServer.CancelFundingStream(suite.ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), resp.PeriodsLeft)
+
+	has, err := suite.poolKeeper.FundingStreams.Has(suite.ctx, recipientAddr)
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+}
@@ -17,6 +17,8 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 		&MsgCreateContinuousFund{},
 		&MsgCancelContinuousFund{},
 		&MsgWithdrawContinuousFund{},
+		&MsgCreateFundingStream{},
+		&MsgCancelFundingStream{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
@@ -21,6 +21,7 @@ func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
 		ContinuousFund: []*ContinuousFund{},
 		Budget:         []*Budget{},
+		FundingStreams: []FundingStream{},
 	}
 }
 
@@ -31,10 +32,47 @@ func ValidateGenesis(gs *GenesisState) error {
 			return err
 		}
 	}
+	budgetRecipients := make(map[string]bool, len(gs.Budget))
 	for _, bp := range gs.Budget {
 		if err := validateBudget(*bp); err != nil {
 			return err
 		}
+		budgetRecipients[bp.RecipientAddress] = true
+	}
+	seenRecipients := make(map[string]bool, len(gs.FundingStreams))
+	for _, fs := range gs.FundingStreams {
+		if err := ValidateFundingStream(fs.AmountPerPeriod, fs.PeriodBlocks, fs.PeriodsLeft); err != nil {
+			return err
+		}
+		if fs.Recipient == "" {
+			return fmt.Errorf("recipient cannot be empty")
+		}
+		if seenRecipients[fs.Recipient] {
+			return fmt.Errorf("duplicate funding stream for recipient %s", fs.Recipient)
+		}
+		if budgetRecipients[fs.Recipient] {
+			return fmt.Errorf("recipient %s has both a budget and a funding stream", fs.Recipient)
+		}
+		seenRecipients[fs.Recipient] = true
+	}
+	return nil
+}
+
+// ValidateFundingStream validates the terms of a funding stream: a valid
+// non-zero amount per period, paid out over a positive number of periods of
+// at least one block.
+func ValidateFundingStream(amountPerPeriod sdk.Coins, periodBlocks, periods uint64) error {
+	if amountPerPeriod.IsZero() {
+		return errors.Wrap(ErrInvalidStream, "amount per period cannot be zero")
+	}
+	if err := amountPerPeriod.Validate(); err != nil {
+		return errors.Wrapf(ErrInvalidStream, "invalid amount per period %s: %s", amountPerPeriod, err)
+	}
+	if periodBlocks == 0 {
+		return errors.Wrap(ErrInvalidStream, "period length should be greater than zero")
+	}
+	if periods == 0 {
+		return errors.Wrap(ErrInvalidStream, "periods must be greater than zero")
 	}
 	return nil
 }
@@ -32,6 +32,8 @@ type GenesisState struct {
 	// Budget defines the budget proposals at genesis.
 	Budget       []*Budget             `protobuf:"bytes,2,rep,name=budget,proto3" json:"budget,omitempty"`
 	ToDistribute cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=to_distribute,json=toDistribute,proto3,customtype=cosmossdk.io/math.Int" json:"to_distribute"`
+	// funding_streams defines the funding streams at genesis.
+	FundingStreams []FundingStream `protobuf:"bytes,4,rep,name=funding_streams,json=fundingStreams,proto3" json:"funding_streams"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -81,6 +83,13 @@ func (m *GenesisState) GetBudget() []*Budget {
 	return nil
 }
 
+func (m *GenesisState) GetFundingStreams() []FundingStream {
+	if m != nil {
+		return m.FundingStreams
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.protocolpool.v1.GenesisState")
 }
@@ -90,26 +99,28 @@ func init() {
 }
 
 var fileDescriptor_72560a99455b4146 = []byte{
-	// 290 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x49, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0xce, 0xcf, 0x29, 0xc8, 0xcf, 0xcf, 0xd1,
-	0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x03, 0x8b, 0x0b, 0x89, 0x41,
-	0x54, 0xe9, 0x21, 0xab, 0xd2, 0x2b, 0x33, 0x94, 0x52, 0xc2, 0xa1, 0xbb, 0xa4, 0xb2, 0x20, 0x15,
-	0xaa, 0x5a, 0x4a, 0x24, 0x3d, 0x3f, 0x3d, 0x1f, 0xcc, 0xd4, 0x07, 0xb1, 0xa0, 0xa2, 0x92, 0x10,
-	0x9d, 0xf1, 0x10, 0x09, 0x64, 0xe3, 0x95, 0x5e, 0x32, 0x72, 0xf1, 0xb8, 0x43, 0xac, 0x0f, 0x2e,
-	0x49, 0x2c, 0x49, 0x15, 0xf2, 0xe7, 0xe2, 0x4f, 0xce, 0xcf, 0x2b, 0xc9, 0xcc, 0x2b, 0xcd, 0x2f,
-	0x2d, 0x8e, 0x4f, 0x2b, 0xcd, 0x4b, 0x91, 0x60, 0x54, 0x60, 0xd6, 0xe0, 0x36, 0x52, 0xd3, 0xc3,
-	0xee, 0x2e, 0x3d, 0x67, 0xb8, 0x72, 0xb7, 0xd2, 0xbc, 0x94, 0x20, 0xbe, 0x64, 0x14, 0xbe, 0x90,
-	0x19, 0x17, 0x5b, 0x52, 0x69, 0x4a, 0x7a, 0x6a, 0x89, 0x04, 0x13, 0xd8, 0x1c, 0x39, 0x5c, 0xe6,
-	0x38, 0x81, 0x55, 0x05, 0x41, 0x55, 0x0b, 0x05, 0x70, 0xf1, 0x96, 0xe4, 0xc7, 0xa7, 0x64, 0x16,
-	0x97, 0x14, 0x65, 0x26, 0x95, 0x96, 0xa4, 0x4a, 0x30, 0x2b, 0x30, 0x6a, 0x70, 0x3a, 0x69, 0x9f,
-	0xb8, 0x27, 0xcf, 0x70, 0xeb, 0x9e, 0xbc, 0x28, 0xc4, 0x94, 0xe2, 0x94, 0x6c, 0xbd, 0xcc, 0x7c,
-	0xfd, 0xdc, 0xc4, 0x92, 0x0c, 0x3d, 0xcf, 0xbc, 0x92, 0x4b, 0x5b, 0x74, 0xb9, 0xa0, 0xc6, 0x7b,
-	0xe6, 0x95, 0x04, 0xf1, 0x94, 0xe4, 0xbb, 0xc0, 0x0d, 0x70, 0xb2, 0x3e, 0xf1, 0x48, 0x8e, 0xf1,
-	0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e,
-	0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0x45, 0x14, 0xc3, 0x2a, 0x50, 0x83, 0x18, 0x1c, 0xbe, 0x49,
-	0x6c, 0x60, 0x31, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x1e, 0x8d, 0xab, 0x75, 0xc4, 0x01,
-	0x00, 0x00,
+	// 330 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xc1, 0x4b, 0x3a, 0x41,
+	0x14, 0xc7, 0x77, 0x55, 0x84, 0xdf, 0xfc, 0x4c, 0x61, 0xa9, 0xd8, 0x3c, 0x8c, 0x26, 0x15, 0x42,
+	0x34, 0x8b, 0x05, 0x5d, 0xba, 0x6d, 0x61, 0x78, 0x2a, 0xd6, 0x4e, 0x5d, 0x16, 0xdd, 0x1d, 0xb7,
+	0x25, 0x9d, 0x27, 0xce, 0x5b, 0xa9, 0xff, 0xa2, 0x3f, 0xa6, 0x7b, 0x57, 0x8f, 0xd2, 0x29, 0x3a,
+	0x48, 0xe8, 0x3f, 0x12, 0xce, 0x2c, 0xa2, 0x90, 0xb7, 0x37, 0xdf, 0xf9, 0xbc, 0xef, 0xfb, 0x3e,
+	0x1e, 0x39, 0x0a, 0x40, 0x0e, 0x40, 0x3a, 0xc3, 0x11, 0x20, 0x04, 0xd0, 0x1f, 0x02, 0xf4, 0x9d,
+	0x71, 0xc3, 0x89, 0xb8, 0xe0, 0x32, 0x96, 0x4c, 0xe9, 0xd6, 0xbe, 0xa6, 0xd8, 0x3a, 0xc5, 0xc6,
+	0x8d, 0x72, 0x6d, 0x4b, 0x37, 0xbe, 0x0e, 0x79, 0x4a, 0x97, 0x77, 0x23, 0x88, 0x40, 0x95, 0xce,
+	0xb2, 0x4a, 0xd5, 0x03, 0xdd, 0xe9, 0xeb, 0x8f, 0x75, 0xfb, 0xda, 0x47, 0x86, 0x14, 0x6e, 0xf5,
+	0xf8, 0x36, 0x76, 0x90, 0x5b, 0x77, 0xa4, 0x14, 0x80, 0xc0, 0x58, 0x24, 0x90, 0x48, 0xbf, 0x97,
+	0x88, 0xd0, 0x36, 0xab, 0xd9, 0xfa, 0xff, 0xf3, 0x13, 0xf6, 0x77, 0x2e, 0x76, 0xbd, 0xc2, 0x9b,
+	0x89, 0x08, 0xbd, 0x62, 0xb0, 0xf1, 0xb6, 0x2e, 0x49, 0xbe, 0x9b, 0x84, 0x11, 0x47, 0x3b, 0xa3,
+	0x7c, 0xe8, 0x36, 0x1f, 0x57, 0x51, 0x5e, 0x4a, 0x5b, 0xf7, 0x64, 0x07, 0xc1, 0x0f, 0x63, 0x89,
+	0xa3, 0xb8, 0x9b, 0x20, 0xb7, 0xb3, 0x55, 0xb3, 0xfe, 0xcf, 0x3d, 0x9d, 0xcc, 0x2a, 0xc6, 0xf7,
+	0xac, 0xb2, 0xa7, 0x5d, 0x64, 0xf8, 0xcc, 0x62, 0x70, 0x06, 0x1d, 0x7c, 0x62, 0x2d, 0x81, 0x9f,
+	0xef, 0x67, 0x24, 0xb5, 0x6f, 0x09, 0xf4, 0x0a, 0x08, 0x37, 0x2b, 0x03, 0xeb, 0x81, 0x94, 0x96,
+	0xfb, 0xc4, 0x22, 0xf2, 0x25, 0x8e, 0x78, 0x67, 0x20, 0xed, 0x9c, 0x8a, 0x74, 0xbc, 0x2d, 0x52,
+	0x53, 0xe3, 0x6d, 0x45, 0xbb, 0xb9, 0xe5, 0x68, 0xaf, 0xd8, 0x5b, 0x17, 0xa5, 0x7b, 0x35, 0x99,
+	0x53, 0x73, 0x3a, 0xa7, 0xe6, 0xcf, 0x9c, 0x9a, 0x6f, 0x0b, 0x6a, 0x4c, 0x17, 0xd4, 0xf8, 0x5a,
+	0x50, 0xe3, 0xf1, 0x70, 0x23, 0xe2, 0xcb, 0xe6, 0xe1, 0xd4, 0xd5, 0xba, 0x79, 0xa5, 0x5d, 0xfc,
+	0x0e, 0x00, 0x81, 0xc5, 0x95, 0x3a, 0x1a, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -132,6 +143,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FundingStreams) > 0 {
+		for iNdEx := len(m.FundingStreams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FundingStreams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	{
 		size := m.ToDistribute.Size()
 		i -= size
@@ -204,6 +229,12 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.ToDistribute.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.FundingStreams) > 0 {
+		for _, e := range m.FundingStreams {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -344,6 +375,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingStreams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FundingStreams = append(m.FundingStreams, FundingStream{})
+			if err := m.FundingStreams[len(m.FundingStreams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -27,4 +27,6 @@ var (
 	RecipientFundPercentageKey   = collections.NewPrefix(4)
 	RecipientFundDistributionKey = collections.NewPrefix(5)
 	ToDistributeKey              = collections.NewPrefix(6)
+	FundingStreamKey             = collections.NewPrefix(7)
+	FundingStreamQueueKey        = collections.NewPrefix(8)
 )
@@ -9,6 +9,7 @@ import (
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -262,11 +263,212 @@ func (m *QueryUnclaimedBudgetResponse) GetTranchesLeft() uint64 {
 	return 0
 }
 
+// QueryFundingStreamRequest is the request type for the Query/FundingStream
+// RPC method.
+type QueryFundingStreamRequest struct {
+	// recipient is the recipient address to query the funding stream for.
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+}
+
+func (m *QueryFundingStreamRequest) Reset()         { *m = QueryFundingStreamRequest{} }
+func (m *QueryFundingStreamRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFundingStreamRequest) ProtoMessage()    {}
+func (*QueryFundingStreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51500a0a77d57843, []int{4}
+}
+func (m *QueryFundingStreamRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFundingStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFundingStreamRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFundingStreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFundingStreamRequest.Merge(m, src)
+}
+func (m *QueryFundingStreamRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFundingStreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFundingStreamRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFundingStreamRequest proto.InternalMessageInfo
+
+func (m *QueryFundingStreamRequest) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+// QueryFundingStreamResponse is the response type for the Query/FundingStream
+// RPC method.
+type QueryFundingStreamResponse struct {
+	// funding_stream is the funding stream of the recipient.
+	FundingStream FundingStream `protobuf:"bytes,1,opt,name=funding_stream,json=fundingStream,proto3" json:"funding_stream"`
+}
+
+func (m *QueryFundingStreamResponse) Reset()         { *m = QueryFundingStreamResponse{} }
+func (m *QueryFundingStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFundingStreamResponse) ProtoMessage()    {}
+func (*QueryFundingStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51500a0a77d57843, []int{5}
+}
+func (m *QueryFundingStreamResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFundingStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFundingStreamResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFundingStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFundingStreamResponse.Merge(m, src)
+}
+func (m *QueryFundingStreamResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFundingStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFundingStreamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFundingStreamResponse proto.InternalMessageInfo
+
+func (m *QueryFundingStreamResponse) GetFundingStream() FundingStream {
+	if m != nil {
+		return m.FundingStream
+	}
+	return FundingStream{}
+}
+
+// QueryFundingStreamsRequest is the request type for the Query/FundingStreams
+// RPC method.
+type QueryFundingStreamsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFundingStreamsRequest) Reset()         { *m = QueryFundingStreamsRequest{} }
+func (m *QueryFundingStreamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFundingStreamsRequest) ProtoMessage()    {}
+func (*QueryFundingStreamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51500a0a77d57843, []int{6}
+}
+func (m *QueryFundingStreamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFundingStreamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFundingStreamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFundingStreamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFundingStreamsRequest.Merge(m, src)
+}
+func (m *QueryFundingStreamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFundingStreamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFundingStreamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFundingStreamsRequest proto.InternalMessageInfo
+
+func (m *QueryFundingStreamsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryFundingStreamsResponse is the response type for the Query/FundingStreams
+// RPC method.
+type QueryFundingStreamsResponse struct {
+	// funding_streams is the list of funding streams.
+	FundingStreams []FundingStream `protobuf:"bytes,1,rep,name=funding_streams,json=fundingStreams,proto3" json:"funding_streams"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFundingStreamsResponse) Reset()         { *m = QueryFundingStreamsResponse{} }
+func (m *QueryFundingStreamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFundingStreamsResponse) ProtoMessage()    {}
+func (*QueryFundingStreamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51500a0a77d57843, []int{7}
+}
+func (m *QueryFundingStreamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFundingStreamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFundingStreamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFundingStreamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFundingStreamsResponse.Merge(m, src)
+}
+func (m *QueryFundingStreamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFundingStreamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFundingStreamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFundingStreamsResponse proto.InternalMessageInfo
+
+func (m *QueryFundingStreamsResponse) GetFundingStreams() []FundingStream {
+	if m != nil {
+		return m.FundingStreams
+	}
+	return nil
+}
+
+func (m *QueryFundingStreamsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryCommunityPoolRequest)(nil), "cosmos.protocolpool.v1.QueryCommunityPoolRequest")
 	proto.RegisterType((*QueryCommunityPoolResponse)(nil), "cosmos.protocolpool.v1.QueryCommunityPoolResponse")
 	proto.RegisterType((*QueryUnclaimedBudgetRequest)(nil), "cosmos.protocolpool.v1.QueryUnclaimedBudgetRequest")
 	proto.RegisterType((*QueryUnclaimedBudgetResponse)(nil), "cosmos.protocolpool.v1.QueryUnclaimedBudgetResponse")
+	proto.RegisterType((*QueryFundingStreamRequest)(nil), "cosmos.protocolpool.v1.QueryFundingStreamRequest")
+	proto.RegisterType((*QueryFundingStreamResponse)(nil), "cosmos.protocolpool.v1.QueryFundingStreamResponse")
+	proto.RegisterType((*QueryFundingStreamsRequest)(nil), "cosmos.protocolpool.v1.QueryFundingStreamsRequest")
+	proto.RegisterType((*QueryFundingStreamsResponse)(nil), "cosmos.protocolpool.v1.QueryFundingStreamsResponse")
 }
 
 func init() {
@@ -274,47 +476,60 @@ func init() {
 }
 
 var fileDescriptor_51500a0a77d57843 = []byte{
-	// 630 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x3f, 0x4f, 0x14, 0x41,
-	0x18, 0xc6, 0x6f, 0xe1, 0xc0, 0x38, 0x70, 0x62, 0x26, 0xc4, 0x2c, 0x0b, 0xd9, 0xc3, 0x33, 0x31,
-	0x97, 0x18, 0x66, 0x72, 0x07, 0x89, 0x89, 0x5a, 0xc8, 0x41, 0x8c, 0x85, 0x85, 0x9c, 0xda, 0xd8,
-	0x6c, 0xf6, 0xcf, 0xdc, 0xb2, 0x61, 0x77, 0xde, 0x65, 0x67, 0x96, 0x40, 0x8c, 0x8d, 0xb6, 0x16,
-	0x24, 0x36, 0x7e, 0x02, 0x0b, 0x6b, 0x0b, 0x3f, 0x02, 0x25, 0xd1, 0xc6, 0x4a, 0x0c, 0xd8, 0xf9,
-	0x25, 0xcc, 0xce, 0xce, 0x2a, 0x5c, 0x0e, 0x09, 0xd5, 0xdd, 0xbe, 0xef, 0xfb, 0xfc, 0xe6, 0x79,
-	0x33, 0xcf, 0xa0, 0x96, 0x0f, 0x22, 0x01, 0x41, 0xd3, 0x0c, 0x24, 0xf8, 0x10, 0xa7, 0x00, 0x31,
-	0xdd, 0xe9, 0xd0, 0xed, 0x9c, 0x65, 0x7b, 0x44, 0x55, 0xf1, 0x8d, 0x72, 0x86, 0x9c, 0x9e, 0x21,
-	0x3b, 0x1d, 0x6b, 0x36, 0x84, 0x10, 0x54, 0x91, 0x16, 0xff, 0xca, 0xbe, 0xb5, 0x10, 0x02, 0x84,
-	0x31, 0xa3, 0x6e, 0x1a, 0x51, 0x97, 0x73, 0x90, 0xae, 0x8c, 0x80, 0x6b, 0xb5, 0x65, 0xeb, 0xf3,
-	0x3c, 0x57, 0x30, 0xba, 0xd3, 0xf1, 0x98, 0x74, 0x3b, 0xd4, 0x87, 0x88, 0xeb, 0xfe, 0x5c, 0xd9,
-	0x77, 0x4a, 0xec, 0xe9, 0x83, 0xad, 0xa6, 0x06, 0xab, 0x2f, 0x2f, 0x1f, 0x50, 0x19, 0x25, 0x4c,
-	0x48, 0x37, 0x49, 0x2b, 0xf6, 0xf0, 0x40, 0x90, 0x67, 0xea, 0xf0, 0xb2, 0xdf, 0x9a, 0x47, 0x73,
-	0x1b, 0xc5, 0x5a, 0x6b, 0x90, 0x24, 0x39, 0x8f, 0xe4, 0xde, 0x53, 0x80, 0xb8, 0xcf, 0xb6, 0x73,
-	0x26, 0x64, 0xeb, 0xad, 0x81, 0xac, 0x51, 0x5d, 0x91, 0x02, 0x17, 0x0c, 0x33, 0x54, 0x2f, 0xd6,
-	0x36, 0x8d, 0xc5, 0xf1, 0xf6, 0x54, 0x77, 0x81, 0x68, 0x67, 0xc5, 0x1a, 0x44, 0xaf, 0x41, 0xd6,
-	0x99, 0xbf, 0x06, 0x11, 0xef, 0x2d, 0x1f, 0xfc, 0x68, 0xd6, 0x3e, 0x1d, 0x35, 0xef, 0x84, 0x91,
-	0xdc, 0xcc, 0x3d, 0xe2, 0x43, 0xa2, 0x37, 0xd1, 0x3f, 0x4b, 0x22, 0xd8, 0xa2, 0x72, 0x2f, 0x65,
-	0xa2, 0xd2, 0x88, 0xbe, 0xc2, 0xb7, 0x36, 0xd0, 0xbc, 0x32, 0xf1, 0x82, 0xfb, 0xb1, 0x1b, 0x25,
-	0x2c, 0xe8, 0xe5, 0x41, 0xc8, 0xa4, 0x36, 0x89, 0xbb, 0xe8, 0x8a, 0x1b, 0x04, 0x19, 0x13, 0xc2,
-	0x34, 0x16, 0x8d, 0xf6, 0xd5, 0x9e, 0xf9, 0xf5, 0xf3, 0xd2, 0xac, 0xf6, 0xb2, 0x5a, 0x76, 0x9e,
-	0xc9, 0x2c, 0xe2, 0x61, 0xbf, 0x1a, 0x6c, 0xbd, 0x1b, 0x47, 0x0b, 0xa3, 0x99, 0x7a, 0xb5, 0x07,
-	0x68, 0x5a, 0x82, 0x74, 0x63, 0xc7, 0x53, 0x75, 0x45, 0x9e, 0xea, 0xce, 0x8d, 0x5c, 0xb1, 0xf0,
-	0xda, 0x9f, 0x52, 0xe3, 0x25, 0x05, 0x3f, 0x44, 0xd7, 0x34, 0xd6, 0x71, 0x13, 0xc8, 0xb9, 0x34,
-	0xc7, 0x2e, 0xd2, 0x37, 0xb4, 0x60, 0x55, 0xcd, 0xe3, 0x75, 0x74, 0x3d, 0xe7, 0x43, 0x8c, 0xf1,
-	0x8b, 0x18, 0x33, 0x7f, 0x25, 0x9a, 0xf2, 0x18, 0xcd, 0x70, 0xb6, 0x2b, 0x1d, 0x55, 0x75, 0x06,
-	0x19, 0x24, 0x66, 0x5d, 0x41, 0x2c, 0x52, 0xc6, 0x82, 0x54, 0xb1, 0x20, 0xcf, 0xab, 0xdc, 0xf4,
-	0xea, 0xfb, 0x47, 0x4d, 0xa3, 0xdf, 0x28, 0x84, 0x6b, 0x85, 0xee, 0x51, 0x06, 0x09, 0xbe, 0x8b,
-	0x26, 0x53, 0x96, 0x45, 0x10, 0x98, 0x13, 0xda, 0xc5, 0x30, 0x60, 0x5d, 0xe7, 0xaa, 0x57, 0xff,
-	0x50, 0xe8, 0xf5, 0x38, 0xbe, 0x85, 0x1a, 0x32, 0x73, 0xb9, 0xbf, 0xc9, 0x84, 0x13, 0xb3, 0x81,
-	0x34, 0x27, 0x17, 0x8d, 0x76, 0xbd, 0x3f, 0x5d, 0x15, 0x9f, 0xb0, 0x81, 0xec, 0xfe, 0x1e, 0x43,
-	0x13, 0xea, 0x3a, 0xf0, 0x47, 0x03, 0x35, 0xce, 0x84, 0x0d, 0x77, 0xc8, 0xe8, 0x97, 0x46, 0xce,
-	0x8d, 0xad, 0xd5, 0xbd, 0x8c, 0xa4, 0xbc, 0xf0, 0x16, 0x79, 0xf3, 0xed, 0xd7, 0xfb, 0xb1, 0x36,
-	0xbe, 0x4d, 0xcf, 0x79, 0xfc, 0x7e, 0x25, 0x73, 0x8a, 0x0a, 0xfe, 0x62, 0xa0, 0x99, 0xa1, 0xf0,
-	0xe0, 0xe5, 0xff, 0x9e, 0x3b, 0x3a, 0xbe, 0xd6, 0xca, 0xe5, 0x44, 0xda, 0xee, 0x3d, 0x65, 0x77,
-	0x05, 0x77, 0xcf, 0xb3, 0xfb, 0x2f, 0x3d, 0x65, 0x82, 0xe9, 0x2b, 0x9d, 0xfd, 0xd7, 0xbd, 0xfb,
-	0x07, 0xc7, 0xb6, 0x71, 0x78, 0x6c, 0x1b, 0x3f, 0x8f, 0x6d, 0x63, 0xff, 0xc4, 0xae, 0x1d, 0x9e,
-	0xd8, 0xb5, 0xef, 0x27, 0x76, 0xed, 0xe5, 0xcd, 0x12, 0x26, 0x82, 0x2d, 0x12, 0x01, 0xdd, 0x3d,
-	0x0b, 0x55, 0x6f, 0xd3, 0x9b, 0x54, 0xb5, 0xe5, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x5a, 0xe5,
-	0xc6, 0x53, 0x24, 0x05, 0x00, 0x00,
+	// 838 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x95, 0x41, 0x4f, 0xdb, 0x48,
+	0x14, 0xc7, 0x63, 0x08, 0xac, 0x18, 0x48, 0xb2, 0x1a, 0xa1, 0x55, 0x30, 0x28, 0x61, 0xbd, 0x5a,
+	0xc8, 0xee, 0x0a, 0x5b, 0x49, 0xd8, 0x5d, 0x69, 0xd9, 0xc3, 0x12, 0x10, 0xdb, 0x43, 0x0f, 0x60,
+	0xe8, 0xa5, 0x17, 0xcb, 0xb1, 0x27, 0xc6, 0x22, 0x9e, 0x31, 0x9e, 0x31, 0x02, 0x21, 0x2e, 0xed,
+	0xb5, 0x07, 0xa4, 0x5e, 0xfa, 0x09, 0x7a, 0xe0, 0xda, 0x1e, 0x7a, 0xe8, 0x07, 0xe0, 0x54, 0xa1,
+	0xf6, 0xd2, 0x53, 0xa9, 0xa0, 0x1f, 0xa4, 0xf2, 0x78, 0x0c, 0x71, 0xe4, 0x34, 0xcd, 0x09, 0x78,
+	0xf3, 0xfe, 0xff, 0xf7, 0x7b, 0x9e, 0x37, 0x0f, 0xa0, 0x58, 0x84, 0x7a, 0x84, 0x6a, 0x7e, 0x40,
+	0x18, 0xb1, 0x48, 0xd7, 0x27, 0xa4, 0xab, 0x1d, 0xd5, 0xb5, 0xc3, 0x10, 0x05, 0x27, 0x2a, 0x8f,
+	0xc2, 0x9f, 0xe2, 0x1c, 0xb5, 0x37, 0x47, 0x3d, 0xaa, 0xcb, 0xb3, 0x0e, 0x71, 0x08, 0x0f, 0x6a,
+	0xd1, 0x6f, 0xf1, 0xb9, 0xbc, 0xe0, 0x10, 0xe2, 0x74, 0x91, 0x66, 0xfa, 0xae, 0x66, 0x62, 0x4c,
+	0x98, 0xc9, 0x5c, 0x82, 0x85, 0x5a, 0xae, 0x88, 0x7a, 0x6d, 0x93, 0x22, 0xed, 0xa8, 0xde, 0x46,
+	0xcc, 0xac, 0x6b, 0x16, 0x71, 0xb1, 0x38, 0x9f, 0x8b, 0xcf, 0x8d, 0xd8, 0xb6, 0xb7, 0xb0, 0xfc,
+	0x7b, 0xaf, 0x94, 0xf3, 0xdd, 0x19, 0xf8, 0xa6, 0xe3, 0x62, 0x5e, 0x47, 0xe4, 0x0e, 0x6a, 0x8b,
+	0x9d, 0xf8, 0x28, 0xf1, 0xab, 0x0a, 0x50, 0xfe, 0x57, 0x3b, 0xec, 0x68, 0xcc, 0xf5, 0x10, 0x65,
+	0xa6, 0xe7, 0x27, 0xac, 0xfd, 0x09, 0x76, 0x18, 0xf4, 0x14, 0x51, 0xe6, 0xc1, 0xdc, 0x4e, 0x84,
+	0xb1, 0x41, 0x3c, 0x2f, 0xc4, 0x2e, 0x3b, 0xd9, 0x26, 0xa4, 0xab, 0xa3, 0xc3, 0x10, 0x51, 0xa6,
+	0x3c, 0x95, 0x80, 0x9c, 0x75, 0x4a, 0x7d, 0x82, 0x29, 0x82, 0x08, 0xe4, 0x23, 0xa6, 0xb2, 0xb4,
+	0x38, 0x5e, 0x9b, 0x6e, 0x2c, 0xa8, 0xa2, 0xd3, 0xa8, 0x37, 0x55, 0x74, 0xa5, 0x6e, 0x22, 0x6b,
+	0x83, 0xb8, 0xb8, 0xd5, 0xbc, 0xfc, 0x54, 0xcd, 0x5d, 0x5c, 0x57, 0xff, 0x70, 0x5c, 0xb6, 0x1f,
+	0xb6, 0x55, 0x8b, 0x78, 0xe2, 0xcb, 0x88, 0x1f, 0x2b, 0xd4, 0x3e, 0x10, 0xad, 0x09, 0x0d, 0xd5,
+	0xb9, 0xbd, 0xb2, 0x03, 0xe6, 0x39, 0xc4, 0x23, 0x6c, 0x75, 0x4d, 0xd7, 0x43, 0x76, 0x2b, 0xb4,
+	0x1d, 0xc4, 0x04, 0x24, 0x6c, 0x80, 0x1f, 0x4c, 0xdb, 0x0e, 0x10, 0xa5, 0x65, 0x69, 0x51, 0xaa,
+	0x4d, 0xb5, 0xca, 0xef, 0x5f, 0xaf, 0xcc, 0x0a, 0x96, 0xf5, 0xf8, 0x64, 0x97, 0x05, 0x2e, 0x76,
+	0xf4, 0x24, 0x51, 0x79, 0x36, 0x0e, 0x16, 0xb2, 0x3d, 0x45, 0x6b, 0xff, 0x82, 0x19, 0x46, 0x98,
+	0xd9, 0x35, 0xda, 0x3c, 0xce, 0x9d, 0xa7, 0x1b, 0x73, 0x99, 0x2d, 0x46, 0xac, 0xfa, 0x34, 0x4f,
+	0x8f, 0x5d, 0xe0, 0x7f, 0xa0, 0x28, 0x6c, 0x0d, 0xd3, 0x23, 0x21, 0x66, 0xe5, 0xb1, 0x61, 0xfa,
+	0x82, 0x10, 0xac, 0xf3, 0x7c, 0xb8, 0x09, 0x7e, 0x0c, 0x71, 0x9f, 0xc7, 0xf8, 0x30, 0x8f, 0x52,
+	0x88, 0xd3, 0x2e, 0x0f, 0x40, 0x09, 0xa3, 0x63, 0x66, 0xf0, 0xa8, 0xd1, 0x09, 0x88, 0x57, 0xce,
+	0x73, 0x13, 0x59, 0x8d, 0xc7, 0x42, 0x4d, 0xc6, 0x42, 0xdd, 0x4b, 0xe6, 0xa6, 0x95, 0x3f, 0xbf,
+	0xae, 0x4a, 0x7a, 0x21, 0x12, 0x6e, 0x44, 0xba, 0xad, 0x80, 0x78, 0xf0, 0x6f, 0x30, 0xe9, 0xa3,
+	0xc0, 0x25, 0x76, 0x79, 0x42, 0x50, 0xf4, 0x1b, 0x6c, 0x8a, 0xb9, 0x6a, 0xe5, 0x5f, 0x44, 0x7a,
+	0x91, 0x0e, 0x7f, 0x01, 0x05, 0x16, 0x98, 0xd8, 0xda, 0x47, 0xd4, 0xe8, 0xa2, 0x0e, 0x2b, 0x4f,
+	0x2e, 0x4a, 0xb5, 0xbc, 0x3e, 0x93, 0x04, 0x1f, 0xa2, 0x0e, 0x53, 0x76, 0xc5, 0x10, 0x6e, 0x85,
+	0xd8, 0x76, 0xb1, 0xb3, 0xcb, 0x02, 0x64, 0x7a, 0xc9, 0xfd, 0xfe, 0x05, 0xa6, 0x02, 0x64, 0xb9,
+	0xbe, 0x8b, 0x30, 0x1b, 0x7a, 0xc3, 0xf7, 0xa9, 0x8a, 0x0f, 0xe4, 0x2c, 0x53, 0x71, 0xc1, 0x3a,
+	0x28, 0x76, 0xe2, 0x03, 0x83, 0xf2, 0x13, 0x71, 0xc5, 0xbf, 0xaa, 0xd9, 0x8b, 0x42, 0x4d, 0xd9,
+	0xb4, 0xf2, 0xd1, 0x38, 0xeb, 0x85, 0x4e, 0x6f, 0x50, 0xb1, 0xb3, 0x2a, 0xd2, 0xa4, 0x8f, 0x2d,
+	0x00, 0xee, 0x9f, 0xb8, 0xa8, 0xb6, 0x94, 0xba, 0xcc, 0x78, 0x5f, 0x25, 0x57, 0xba, 0x6d, 0x3a,
+	0x48, 0x68, 0xf5, 0x1e, 0xa5, 0xf2, 0x56, 0x02, 0xf3, 0x99, 0x65, 0x44, 0x67, 0x7b, 0xa0, 0x94,
+	0xee, 0x8c, 0x8a, 0x07, 0x3a, 0x52, 0x6b, 0xc5, 0x54, 0x6b, 0x14, 0xfe, 0x9f, 0xa2, 0x8f, 0xc7,
+	0x79, 0x79, 0x28, 0x7d, 0x8c, 0xd4, 0x8b, 0xdf, 0x78, 0x37, 0x01, 0x26, 0x38, 0x3e, 0x7c, 0x29,
+	0x81, 0x42, 0x6a, 0xb1, 0xc0, 0xfa, 0x20, 0xc2, 0x81, 0x2b, 0x4a, 0x6e, 0x8c, 0x22, 0x89, 0x71,
+	0x14, 0xf5, 0xc9, 0x87, 0x2f, 0xcf, 0xc7, 0x6a, 0x70, 0x49, 0x1b, 0xb0, 0x61, 0xad, 0x44, 0x66,
+	0x44, 0x11, 0xf8, 0x46, 0x02, 0xa5, 0xbe, 0x45, 0x01, 0x9b, 0xdf, 0xac, 0x9b, 0xbd, 0xaa, 0xe4,
+	0xd5, 0xd1, 0x44, 0x02, 0xf7, 0x1f, 0x8e, 0xbb, 0x0a, 0x1b, 0x83, 0x70, 0xef, 0x37, 0x45, 0xbc,
+	0xad, 0xb4, 0x53, 0xb1, 0xe7, 0xce, 0xe0, 0x2b, 0x09, 0x14, 0x52, 0xd7, 0x3b, 0xe4, 0x1b, 0x67,
+	0xbd, 0x40, 0xb9, 0x31, 0x8a, 0x44, 0x40, 0xaf, 0x71, 0xe8, 0x3f, 0x61, 0x73, 0x10, 0x74, 0xdf,
+	0x8c, 0x6a, 0xa7, 0x77, 0x2f, 0xf7, 0x0c, 0x5e, 0x48, 0xa0, 0x98, 0x9e, 0x6e, 0x38, 0x02, 0x43,
+	0xf2, 0xe2, 0xe4, 0xe6, 0x48, 0x1a, 0x01, 0xae, 0x71, 0xf0, 0xdf, 0xe0, 0xf2, 0x77, 0x82, 0xb7,
+	0xd6, 0x2e, 0x6f, 0x2a, 0xd2, 0xd5, 0x4d, 0x45, 0xfa, 0x7c, 0x53, 0x91, 0xce, 0x6f, 0x2b, 0xb9,
+	0xab, 0xdb, 0x4a, 0xee, 0xe3, 0x6d, 0x25, 0xf7, 0xf8, 0xe7, 0xd8, 0x81, 0xda, 0x07, 0xaa, 0x4b,
+	0xb4, 0xe3, 0xb4, 0x13, 0xff, 0x57, 0xd7, 0x9e, 0xe4, 0xb1, 0xe6, 0xd7, 0x01, 0x00, 0x27, 0x38,
+	0x38, 0x56, 0xc3, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -333,6 +548,10 @@ type QueryClient interface {
 	CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error)
 	// UnclaimedBudget queries the remaining budget left to be claimed and it gives overall budget allocation view.
 	UnclaimedBudget(ctx context.Context, in *QueryUnclaimedBudgetRequest, opts ...grpc.CallOption) (*QueryUnclaimedBudgetResponse, error)
+	// FundingStream queries the funding stream of a recipient.
+	FundingStream(ctx context.Context, in *QueryFundingStreamRequest, opts ...grpc.CallOption) (*QueryFundingStreamResponse, error)
+	// FundingStreams queries all the funding streams.
+	FundingStreams(ctx context.Context, in *QueryFundingStreamsRequest, opts ...grpc.CallOption) (*QueryFundingStreamsResponse, error)
 }
 
 type queryClient struct {
@@ -361,12 +580,34 @@ func (c *queryClient) UnclaimedBudget(ctx context.Context, in *QueryUnclaimedBud
 	return out, nil
 }
 
+func (c *queryClient) FundingStream(ctx context.Context, in *QueryFundingStreamRequest, opts ...grpc.CallOption) (*QueryFundingStreamResponse, error) {
+	out := new(QueryFundingStreamResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.protocolpool.v1.Query/FundingStream", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FundingStreams(ctx context.Context, in *QueryFundingStreamsRequest, opts ...grpc.CallOption) (*QueryFundingStreamsResponse, error) {
+	out := new(QueryFundingStreamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.protocolpool.v1.Query/FundingStreams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// CommunityPool queries the community pool coins.
 	CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error)
 	// UnclaimedBudget queries the remaining budget left to be claimed and it gives overall budget allocation view.
 	UnclaimedBudget(context.Context, *QueryUnclaimedBudgetRequest) (*QueryUnclaimedBudgetResponse, error)
+	// FundingStream queries the funding stream of a recipient.
+	FundingStream(context.Context, *QueryFundingStreamRequest) (*QueryFundingStreamResponse, error)
+	// FundingStreams queries all the funding streams.
+	FundingStreams(context.Context, *QueryFundingStreamsRequest) (*QueryFundingStreamsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -379,6 +620,12 @@ func (*UnimplementedQueryServer) CommunityPool(ctx context.Context, req *QueryCo
 func (*UnimplementedQueryServer) UnclaimedBudget(ctx context.Context, req *QueryUnclaimedBudgetRequest) (*QueryUnclaimedBudgetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnclaimedBudget not implemented")
 }
+func (*UnimplementedQueryServer) FundingStream(ctx context.Context, req *QueryFundingStreamRequest) (*QueryFundingStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundingStream not implemented")
+}
+func (*UnimplementedQueryServer) FundingStreams(ctx context.Context, req *QueryFundingStreamsRequest) (*QueryFundingStreamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundingStreams not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -420,6 +667,42 @@ func _Query_UnclaimedBudget_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FundingStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFundingStreamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FundingStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.protocolpool.v1.Query/FundingStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FundingStream(ctx, req.(*QueryFundingStreamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FundingStreams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFundingStreamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FundingStreams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.protocolpool.v1.Query/FundingStreams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FundingStreams(ctx, req.(*QueryFundingStreamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.protocolpool.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -432,6 +715,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UnclaimedBudget",
 			Handler:    _Query_UnclaimedBudget_Handler,
 		},
+		{
+			MethodName: "FundingStream",
+			Handler:    _Query_FundingStream_Handler,
+		},
+		{
+			MethodName: "FundingStreams",
+			Handler:    _Query_FundingStreams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/protocolpool/v1/query.proto",
@@ -611,63 +902,210 @@ func (m *QueryUnclaimedBudgetResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryFundingStreamRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryCommunityPoolRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryFundingStreamRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFundingStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryCommunityPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFundingStreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFundingStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFundingStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Pool) > 0 {
-		for _, e := range m.Pool {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.FundingStream.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryUnclaimedBudgetRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFundingStreamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFundingStreamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFundingStreamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryUnclaimedBudgetResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TotalBudget != nil {
-		l = m.TotalBudget.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryFundingStreamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFundingStreamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFundingStreamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FundingStreams) > 0 {
+		for iNdEx := len(m.FundingStreams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FundingStreams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryCommunityPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCommunityPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pool) > 0 {
+		for _, e := range m.Pool {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryUnclaimedBudgetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnclaimedBudgetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TotalBudget != nil {
+		l = m.TotalBudget.Size()
+		n += 1 + l + sovQuery(uint64(l))
 	}
 	if m.ClaimedAmount != nil {
 		l = m.ClaimedAmount.Size()
@@ -691,6 +1129,62 @@ func (m *QueryUnclaimedBudgetResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryFundingStreamRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFundingStreamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FundingStream.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFundingStreamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFundingStreamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FundingStreams) > 0 {
+		for _, e := range m.FundingStreams {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1162,6 +1656,377 @@ func (m *QueryUnclaimedBudgetResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryFundingStreamRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFundingStreamRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFundingStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFundingStreamResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFundingStreamResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFundingStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingStream", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FundingStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFundingStreamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFundingStreamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFundingStreamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFundingStreamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFundingStreamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFundingStreamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingStreams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FundingStreams = append(m.FundingStreams, FundingStream{})
+			if err := m.FundingStreams[len(m.FundingStreams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -766,6 +766,239 @@ func (m *MsgWithdrawContinuousFundResponse) GetAmount() types.Coin {
 	return types.Coin{}
 }
 
+// MsgCreateFundingStream defines a message for registering a funding stream.
+type MsgCreateFundingStream struct {
+	// Authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Recipient is the address of the account receiving the funds.
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// AmountPerPeriod is the amount paid out to the recipient every period.
+	AmountPerPeriod github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount_per_period,json=amountPerPeriod,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount_per_period"`
+	// PeriodBlocks is the length of a period in blocks. A period of one block
+	// pays the recipient out at every block.
+	PeriodBlocks uint64 `protobuf:"varint,4,opt,name=period_blocks,json=periodBlocks,proto3" json:"period_blocks,omitempty"`
+	// Periods is the number of periods to be paid out.
+	Periods uint64 `protobuf:"varint,5,opt,name=periods,proto3" json:"periods,omitempty"`
+	// StartHeight is the height at which the first period is paid out.
+	// Optional, if not set the first period is paid out at the end of the next block.
+	StartHeight uint64 `protobuf:"varint,6,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+}
+
+func (m *MsgCreateFundingStream) Reset()         { *m = MsgCreateFundingStream{} }
+func (m *MsgCreateFundingStream) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateFundingStream) ProtoMessage()    {}
+func (*MsgCreateFundingStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09efe14517e7f6dc, []int{14}
+}
+func (m *MsgCreateFundingStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateFundingStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateFundingStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateFundingStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateFundingStream.Merge(m, src)
+}
+func (m *MsgCreateFundingStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateFundingStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateFundingStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateFundingStream proto.InternalMessageInfo
+
+func (m *MsgCreateFundingStream) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgCreateFundingStream) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *MsgCreateFundingStream) GetAmountPerPeriod() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.AmountPerPeriod
+	}
+	return nil
+}
+
+func (m *MsgCreateFundingStream) GetPeriodBlocks() uint64 {
+	if m != nil {
+		return m.PeriodBlocks
+	}
+	return 0
+}
+
+func (m *MsgCreateFundingStream) GetPeriods() uint64 {
+	if m != nil {
+		return m.Periods
+	}
+	return 0
+}
+
+func (m *MsgCreateFundingStream) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+// MsgCreateFundingStreamResponse defines the response to executing a
+// MsgCreateFundingStream message.
+type MsgCreateFundingStreamResponse struct {
+}
+
+func (m *MsgCreateFundingStreamResponse) Reset()         { *m = MsgCreateFundingStreamResponse{} }
+func (m *MsgCreateFundingStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateFundingStreamResponse) ProtoMessage()    {}
+func (*MsgCreateFundingStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09efe14517e7f6dc, []int{15}
+}
+func (m *MsgCreateFundingStreamResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateFundingStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateFundingStreamResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateFundingStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateFundingStreamResponse.Merge(m, src)
+}
+func (m *MsgCreateFundingStreamResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateFundingStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateFundingStreamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateFundingStreamResponse proto.InternalMessageInfo
+
+// MsgCancelFundingStream defines a message to cancel the funding stream of a recipient.
+type MsgCancelFundingStream struct {
+	// Authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// RecipientAddress is the address of the recipient whose funding stream is cancelled.
+	RecipientAddress string `protobuf:"bytes,2,opt,name=recipient_address,json=recipientAddress,proto3" json:"recipient_address,omitempty"`
+}
+
+func (m *MsgCancelFundingStream) Reset()         { *m = MsgCancelFundingStream{} }
+func (m *MsgCancelFundingStream) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelFundingStream) ProtoMessage()    {}
+func (*MsgCancelFundingStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09efe14517e7f6dc, []int{16}
+}
+func (m *MsgCancelFundingStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelFundingStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelFundingStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelFundingStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelFundingStream.Merge(m, src)
+}
+func (m *MsgCancelFundingStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelFundingStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelFundingStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelFundingStream proto.InternalMessageInfo
+
+func (m *MsgCancelFundingStream) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgCancelFundingStream) GetRecipientAddress() string {
+	if m != nil {
+		return m.RecipientAddress
+	}
+	return ""
+}
+
+// MsgCancelFundingStreamResponse defines the response to executing a
+// MsgCancelFundingStream message.
+type MsgCancelFundingStreamResponse struct {
+	// PeriodsLeft is the number of periods of the funding stream that were not paid out.
+	PeriodsLeft uint64 `protobuf:"varint,1,opt,name=periods_left,json=periodsLeft,proto3" json:"periods_left,omitempty"`
+}
+
+func (m *MsgCancelFundingStreamResponse) Reset()         { *m = MsgCancelFundingStreamResponse{} }
+func (m *MsgCancelFundingStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelFundingStreamResponse) ProtoMessage()    {}
+func (*MsgCancelFundingStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09efe14517e7f6dc, []int{17}
+}
+func (m *MsgCancelFundingStreamResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelFundingStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelFundingStreamResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelFundingStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelFundingStreamResponse.Merge(m, src)
+}
+func (m *MsgCancelFundingStreamResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelFundingStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelFundingStreamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelFundingStreamResponse proto.InternalMessageInfo
+
+func (m *MsgCancelFundingStreamResponse) GetPeriodsLeft() uint64 {
+	if m != nil {
+		return m.PeriodsLeft
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*MsgFundCommunityPool)(nil), "cosmos.protocolpool.v1.MsgFundCommunityPool")
 	proto.RegisterType((*MsgFundCommunityPoolResponse)(nil), "cosmos.protocolpool.v1.MsgFundCommunityPoolResponse")
@@ -781,75 +1014,89 @@ func init() {
 	proto.RegisterType((*MsgCancelContinuousFundResponse)(nil), "cosmos.protocolpool.v1.MsgCancelContinuousFundResponse")
 	proto.RegisterType((*MsgWithdrawContinuousFund)(nil), "cosmos.protocolpool.v1.MsgWithdrawContinuousFund")
 	proto.RegisterType((*MsgWithdrawContinuousFundResponse)(nil), "cosmos.protocolpool.v1.MsgWithdrawContinuousFundResponse")
+	proto.RegisterType((*MsgCreateFundingStream)(nil), "cosmos.protocolpool.v1.MsgCreateFundingStream")
+	proto.RegisterType((*MsgCreateFundingStreamResponse)(nil), "cosmos.protocolpool.v1.MsgCreateFundingStreamResponse")
+	proto.RegisterType((*MsgCancelFundingStream)(nil), "cosmos.protocolpool.v1.MsgCancelFundingStream")
+	proto.RegisterType((*MsgCancelFundingStreamResponse)(nil), "cosmos.protocolpool.v1.MsgCancelFundingStreamResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/protocolpool/v1/tx.proto", fileDescriptor_09efe14517e7f6dc) }
 
 var fileDescriptor_09efe14517e7f6dc = []byte{
-	// 1001 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xc4, 0xae, 0x55, 0x4f, 0x4a, 0xa0, 0xab, 0xe0, 0x6e, 0x96, 0xe2, 0x4d, 0x7c, 0x80,
-	0xa8, 0x22, 0xbb, 0xb8, 0x40, 0x0b, 0x01, 0x09, 0xd5, 0x09, 0x08, 0x24, 0x2c, 0x95, 0x0d, 0x12,
-	0x12, 0x17, 0x6b, 0xbc, 0x3b, 0x1d, 0x8f, 0xba, 0xbb, 0xb3, 0xda, 0x99, 0x4d, 0xe2, 0x4a, 0x48,
-	0x15, 0x12, 0xa8, 0xc7, 0x1e, 0x39, 0xf6, 0x84, 0x10, 0xa7, 0x1e, 0xfa, 0x47, 0x54, 0xe2, 0x52,
-	0xf5, 0x84, 0x38, 0xb4, 0x28, 0x41, 0x2a, 0x7f, 0x03, 0xe2, 0x80, 0x76, 0x77, 0xbc, 0xb6, 0xe3,
-	0xf5, 0xa6, 0xae, 0x92, 0x9e, 0x6c, 0xbf, 0x79, 0x3f, 0xbe, 0xef, 0x9b, 0xf7, 0x66, 0xc6, 0x50,
-	0xb7, 0x19, 0xf7, 0x18, 0x37, 0x83, 0x90, 0x09, 0x66, 0x33, 0x37, 0x60, 0xcc, 0x35, 0x77, 0x5b,
-	0xa6, 0xd8, 0x37, 0x12, 0x93, 0x52, 0x4f, 0x1d, 0x8c, 0x71, 0x07, 0x63, 0xb7, 0xa5, 0x2d, 0x13,
-	0x46, 0x58, 0x62, 0x34, 0xe3, 0x6f, 0xe9, 0xba, 0xd6, 0x90, 0xe9, 0x7a, 0x88, 0x63, 0x73, 0xb7,
-	0xd5, 0xc3, 0x02, 0xb5, 0x4c, 0x9b, 0x51, 0x5f, 0xae, 0xaf, 0xa4, 0xeb, 0xdd, 0x34, 0x70, 0x3c,
-	0xb5, 0x76, 0x41, 0x86, 0x7a, 0x9c, 0xc4, 0x00, 0x3c, 0x4e, 0xe4, 0x82, 0x4e, 0x18, 0x23, 0x2e,
-	0x4e, 0x21, 0xf6, 0xa2, 0x1b, 0xa6, 0xa0, 0x1e, 0xe6, 0x02, 0x79, 0xc1, 0xb0, 0xe8, 0x51, 0x07,
-	0x27, 0x0a, 0x91, 0xa0, 0x4c, 0x16, 0x6d, 0xfe, 0x0e, 0xe0, 0x72, 0x87, 0x93, 0xcf, 0x23, 0xdf,
-	0xd9, 0x62, 0x9e, 0x17, 0xf9, 0x54, 0x0c, 0xae, 0x33, 0xe6, 0x2a, 0x36, 0xac, 0x22, 0x8f, 0x45,
-	0xbe, 0x50, 0xc1, 0x6a, 0x79, 0x7d, 0xf1, 0xf2, 0x8a, 0x21, 0x11, 0xc5, 0xf0, 0x0d, 0x09, 0xdf,
-	0xd8, 0x62, 0xd4, 0x6f, 0xbf, 0xfb, 0xf0, 0x89, 0x5e, 0xfa, 0xed, 0xa9, 0xbe, 0x4e, 0xa8, 0xe8,
-	0x47, 0x3d, 0xc3, 0x66, 0x9e, 0x84, 0x2f, 0x3f, 0x36, 0xb8, 0x73, 0xd3, 0x14, 0x83, 0x00, 0xf3,
-	0x24, 0x80, 0x5b, 0x32, 0xb5, 0x72, 0x05, 0xd6, 0x1c, 0x1c, 0x30, 0x4e, 0x05, 0x0b, 0xd5, 0x85,
-	0x55, 0xb0, 0x5e, 0x6b, 0xab, 0x8f, 0x1f, 0x6c, 0x2c, 0xcb, 0x52, 0xd7, 0x1c, 0x27, 0xc4, 0x9c,
-	0xef, 0x88, 0x90, 0xfa, 0xc4, 0x1a, 0xb9, 0x6e, 0xd6, 0xef, 0xdc, 0xd3, 0x4b, 0xff, 0xdc, 0xd3,
-	0x4b, 0x3f, 0x3c, 0xbb, 0x7f, 0x69, 0x64, 0x6f, 0x36, 0xe0, 0xc5, 0x3c, 0x32, 0x16, 0xe6, 0x01,
-	0xf3, 0x39, 0x6e, 0x1e, 0x00, 0xf8, 0x7a, 0x87, 0x93, 0x89, 0xc5, 0x9d, 0x00, 0xfb, 0x4e, 0x8c,
-	0x04, 0x45, 0xa2, 0xcf, 0x42, 0x2a, 0x06, 0x2a, 0x38, 0x0e, 0x49, 0xe6, 0xaa, 0x5c, 0x84, 0xb5,
-	0x10, 0xdb, 0x34, 0xa0, 0xd8, 0x17, 0x29, 0x03, 0x6b, 0x64, 0x18, 0x13, 0xb1, 0x7c, 0x6a, 0x22,
-	0x6e, 0x2e, 0x25, 0x22, 0x64, 0x90, 0x9a, 0x3a, 0x7c, 0x33, 0x97, 0x63, 0xa6, 0xc2, 0x7f, 0x0b,
-	0xf0, 0x42, 0x87, 0x93, 0x9d, 0xa8, 0xe7, 0x51, 0xd1, 0x8e, 0x1c, 0x82, 0xc5, 0xf5, 0x90, 0x05,
-	0x8c, 0x23, 0xf7, 0x85, 0x75, 0xf8, 0x0c, 0x9e, 0xcf, 0x68, 0x77, 0x51, 0xea, 0x75, 0xec, 0x8e,
-	0xbe, 0x96, 0x85, 0x48, 0xbb, 0xf2, 0x09, 0x3c, 0x27, 0x98, 0x40, 0x6e, 0xb7, 0x97, 0xc0, 0x52,
-	0xcb, 0xab, 0xa0, 0x50, 0x36, 0x6b, 0x31, 0x71, 0x4f, 0x49, 0x28, 0x9f, 0x42, 0xc8, 0x05, 0x0a,
-	0x45, 0x37, 0x9e, 0x02, 0xb5, 0x92, 0xc4, 0x6a, 0x46, 0x3a, 0x01, 0xc6, 0x70, 0x02, 0x8c, 0x6f,
-	0x86, 0x23, 0xd2, 0xae, 0xdc, 0x7d, 0xaa, 0x03, 0xab, 0x96, 0xc4, 0xc4, 0x56, 0x45, 0x83, 0x67,
-	0x45, 0x88, 0x7c, 0xbb, 0x8f, 0xb9, 0x7a, 0x66, 0x15, 0xac, 0x57, 0xac, 0xec, 0xb7, 0x72, 0x15,
-	0x56, 0x03, 0x1c, 0x52, 0xe6, 0xa8, 0x55, 0x09, 0xea, 0x68, 0xe2, 0x6d, 0x39, 0x5a, 0xed, 0xca,
-	0xcf, 0x71, 0x5e, 0xe9, 0x3e, 0xb5, 0x3f, 0x6b, 0x50, 0x9f, 0xa1, 0x7e, 0xb6, 0x43, 0x0c, 0x2e,
-	0xc5, 0x5b, 0xe8, 0x22, 0xea, 0x49, 0x6a, 0xb9, 0xfa, 0x82, 0x79, 0xf5, 0xdd, 0xac, 0xc7, 0x58,
-	0xa6, 0x33, 0x35, 0xbf, 0x87, 0xf5, 0xc9, 0x82, 0x43, 0x28, 0x13, 0xe7, 0x00, 0x38, 0xa5, 0x16,
-	0x6e, 0xfe, 0x92, 0x76, 0xe4, 0x56, 0x88, 0x91, 0xc0, 0x5b, 0xcc, 0x17, 0xd4, 0x8f, 0x58, 0xc4,
-	0xe3, 0x41, 0x7e, 0xe1, 0x8e, 0xbc, 0x32, 0x35, 0x99, 0x45, 0x71, 0xa3, 0x99, 0xfd, 0x1a, 0xc2,
-	0x00, 0x87, 0x36, 0xf6, 0x05, 0x22, 0x38, 0x69, 0xc0, 0x5a, 0xbb, 0x15, 0x33, 0xfb, 0xf3, 0x89,
-	0xfe, 0x46, 0x1a, 0xcc, 0x9d, 0x9b, 0x06, 0x65, 0xa6, 0x87, 0x44, 0xdf, 0xf8, 0x0a, 0x13, 0x64,
-	0x0f, 0xb6, 0xb1, 0xfd, 0xf8, 0xc1, 0x06, 0x94, 0xb9, 0xb7, 0xb1, 0x6d, 0x8d, 0x25, 0x51, 0x3e,
-	0x84, 0x55, 0xbc, 0x1f, 0xd0, 0x70, 0xf0, 0xdc, 0x3d, 0x29, 0xfd, 0x67, 0xf4, 0x4e, 0x9e, 0x4e,
-	0x59, 0xef, 0xfc, 0x0a, 0x52, 0x2d, 0x91, 0x6f, 0x63, 0xf7, 0x84, 0xb4, 0x3c, 0x99, 0xe9, 0x9e,
-	0x62, 0xf3, 0xf7, 0x42, 0x4a, 0x27, 0x07, 0x6a, 0xd6, 0x7f, 0x5f, 0xc2, 0x57, 0xec, 0x64, 0x1d,
-	0x3b, 0xe9, 0x58, 0x83, 0x63, 0x25, 0x3c, 0x1b, 0xef, 0x56, 0x22, 0xe3, 0xb9, 0x61, 0x68, 0x32,
-	0xdd, 0x6f, 0xc3, 0x57, 0xb3, 0x54, 0x7d, 0x4c, 0x49, 0x3f, 0xed, 0x8b, 0x8a, 0xb5, 0x34, 0x34,
-	0x7f, 0x91, 0x58, 0xf3, 0xe9, 0x96, 0xe7, 0x3e, 0xcc, 0x7e, 0x04, 0x50, 0xdd, 0xa3, 0xa2, 0xef,
-	0x84, 0x68, 0xcf, 0xef, 0x22, 0xd7, 0x65, 0x36, 0x12, 0xd8, 0xe9, 0xde, 0x88, 0x7c, 0x47, 0x76,
-	0xc2, 0x89, 0x4e, 0x53, 0x3d, 0x2b, 0x76, 0x6d, 0x58, 0x2b, 0x96, 0xb2, 0x79, 0x0b, 0xae, 0x74,
-	0x38, 0xf9, 0x56, 0x2e, 0x1e, 0x69, 0x89, 0x53, 0x3e, 0x58, 0xee, 0x00, 0xb8, 0x36, 0xb3, 0xf8,
-	0x4b, 0x3d, 0x64, 0x2e, 0xff, 0x5b, 0x85, 0xe5, 0x0e, 0x27, 0xca, 0x1e, 0x3c, 0x3f, 0xfd, 0xdc,
-	0x79, 0xc7, 0xc8, 0x7f, 0xcb, 0x19, 0x79, 0xef, 0x09, 0xed, 0xfd, 0x79, 0xbc, 0x33, 0x96, 0xb7,
-	0xa0, 0x92, 0xf3, 0xf2, 0xd8, 0x28, 0xc8, 0x35, 0xed, 0xae, 0x7d, 0x30, 0x97, 0x7b, 0x56, 0xfb,
-	0x36, 0x80, 0xcb, 0xb9, 0x17, 0xbe, 0x59, 0x90, 0x2f, 0x2f, 0x40, 0xbb, 0x3a, 0x67, 0x40, 0x06,
-	0x01, 0xc3, 0xc5, 0xf1, 0x1b, 0xed, 0xad, 0x22, 0x22, 0x23, 0x3f, 0xcd, 0x78, 0x3e, 0xbf, 0x09,
-	0xa6, 0xb9, 0x17, 0x49, 0x11, 0xd3, 0xbc, 0x80, 0x42, 0xa6, 0x45, 0x47, 0xb0, 0xf2, 0x13, 0x80,
-	0xf5, 0x19, 0xe3, 0xd6, 0x2a, 0xc8, 0x99, 0x1f, 0xa2, 0x7d, 0x34, 0x77, 0xc8, 0xa4, 0x16, 0x79,
-	0x17, 0x41, 0xa1, 0x16, 0x39, 0x01, 0xc5, 0x5a, 0x14, 0x9c, 0xdf, 0xda, 0x99, 0xdb, 0xcf, 0xee,
-	0x5f, 0x02, 0xed, 0x8f, 0x1f, 0x1e, 0x34, 0xc0, 0xa3, 0x83, 0x06, 0xf8, 0xeb, 0xa0, 0x01, 0xee,
-	0x1e, 0x36, 0x4a, 0x8f, 0x0e, 0x1b, 0xa5, 0x3f, 0x0e, 0x1b, 0xa5, 0xef, 0xd6, 0x26, 0xee, 0xd4,
-	0xfd, 0xc9, 0x7f, 0x5b, 0xc9, 0x1c, 0xf7, 0xaa, 0x89, 0xed, 0xbd, 0xff, 0x03, 0x00, 0x00, 0xff,
-	0xff, 0xbe, 0xf0, 0xd2, 0xdd, 0x91, 0x0d, 0x00, 0x00,
+	// 1159 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcd, 0x6f, 0xdc, 0x44,
+	0x14, 0x5f, 0x27, 0xdb, 0x25, 0x79, 0x49, 0x53, 0x6a, 0xc2, 0xd6, 0x31, 0x65, 0x9d, 0x2c, 0x12,
+	0x44, 0x15, 0xf1, 0xb2, 0x05, 0x12, 0x08, 0x48, 0xa8, 0x9b, 0x80, 0x40, 0x6a, 0xa4, 0xe0, 0x20,
+	0x21, 0x71, 0x59, 0xcd, 0xda, 0x13, 0xaf, 0x15, 0xdb, 0x63, 0x79, 0xc6, 0xf9, 0xa8, 0x54, 0xa9,
+	0x42, 0x02, 0xf5, 0xd8, 0x23, 0xc7, 0x9e, 0x00, 0x71, 0xea, 0xa1, 0x7f, 0x44, 0x25, 0x2e, 0x55,
+	0x4f, 0x88, 0x43, 0x8b, 0x12, 0xa4, 0x72, 0xe7, 0xc2, 0x81, 0x43, 0xe5, 0x99, 0x59, 0x27, 0x9b,
+	0xf5, 0x6e, 0xba, 0x55, 0xd2, 0x53, 0xe2, 0x37, 0xef, 0xe3, 0xf7, 0x7e, 0xf3, 0xde, 0x9b, 0x97,
+	0x80, 0x61, 0x13, 0x1a, 0x10, 0x5a, 0x8b, 0x62, 0xc2, 0x88, 0x4d, 0xfc, 0x88, 0x10, 0xbf, 0xb6,
+	0x5d, 0xaf, 0xb1, 0x5d, 0x93, 0x8b, 0xd4, 0xb2, 0x50, 0x30, 0x8f, 0x2a, 0x98, 0xdb, 0x75, 0x7d,
+	0xda, 0x25, 0x2e, 0xe1, 0xc2, 0x5a, 0xfa, 0x9b, 0x38, 0xd7, 0x2b, 0xd2, 0x5d, 0x0b, 0x51, 0x5c,
+	0xdb, 0xae, 0xb7, 0x30, 0x43, 0xf5, 0x9a, 0x4d, 0xbc, 0x50, 0x9e, 0xcf, 0x88, 0xf3, 0xa6, 0x30,
+	0x3c, 0xea, 0x5a, 0xbf, 0x24, 0x4d, 0x03, 0xea, 0xa6, 0x00, 0x02, 0xea, 0xca, 0x03, 0xc3, 0x25,
+	0xc4, 0xf5, 0xb1, 0x80, 0xd8, 0x4a, 0x36, 0x6b, 0xcc, 0x0b, 0x30, 0x65, 0x28, 0x88, 0x3a, 0x41,
+	0x8f, 0x2b, 0x38, 0x49, 0x8c, 0x98, 0x47, 0x64, 0xd0, 0xea, 0xef, 0x0a, 0x4c, 0xaf, 0x51, 0xf7,
+	0x8b, 0x24, 0x74, 0x56, 0x48, 0x10, 0x24, 0xa1, 0xc7, 0xf6, 0xd6, 0x09, 0xf1, 0x55, 0x1b, 0x4a,
+	0x28, 0x20, 0x49, 0xc8, 0x34, 0x65, 0x76, 0x74, 0x7e, 0xe2, 0xea, 0x8c, 0x29, 0x11, 0xa5, 0xf0,
+	0x4d, 0x09, 0xdf, 0x5c, 0x21, 0x5e, 0xd8, 0x78, 0xef, 0xc1, 0x63, 0xa3, 0xf0, 0xdb, 0x13, 0x63,
+	0xde, 0xf5, 0x58, 0x3b, 0x69, 0x99, 0x36, 0x09, 0x24, 0x7c, 0xf9, 0x63, 0x81, 0x3a, 0x5b, 0x35,
+	0xb6, 0x17, 0x61, 0xca, 0x0d, 0xa8, 0x25, 0x5d, 0xab, 0x8b, 0x30, 0xee, 0xe0, 0x88, 0x50, 0x8f,
+	0x91, 0x58, 0x1b, 0x99, 0x55, 0xe6, 0xc7, 0x1b, 0xda, 0xa3, 0xfb, 0x0b, 0xd3, 0x32, 0xd4, 0x35,
+	0xc7, 0x89, 0x31, 0xa5, 0x1b, 0x2c, 0xf6, 0x42, 0xd7, 0x3a, 0x54, 0x5d, 0x2e, 0xdf, 0xbe, 0x6b,
+	0x14, 0xfe, 0xb9, 0x6b, 0x14, 0xbe, 0x7f, 0x7a, 0xef, 0xca, 0xa1, 0xbc, 0x5a, 0x81, 0xcb, 0x79,
+	0xc9, 0x58, 0x98, 0x46, 0x24, 0xa4, 0xb8, 0xba, 0xaf, 0xc0, 0xeb, 0x6b, 0xd4, 0xed, 0x3a, 0xdc,
+	0x88, 0x70, 0xe8, 0xa4, 0x48, 0x50, 0xc2, 0xda, 0x24, 0xf6, 0xd8, 0x9e, 0xa6, 0x9c, 0x84, 0x24,
+	0x53, 0x55, 0x2f, 0xc3, 0x78, 0x8c, 0x6d, 0x2f, 0xf2, 0x70, 0xc8, 0x44, 0x06, 0xd6, 0xa1, 0xe0,
+	0x08, 0x89, 0xa3, 0x67, 0x46, 0xe2, 0xf2, 0x14, 0x27, 0x21, 0x83, 0x54, 0x35, 0xe0, 0xcd, 0xdc,
+	0x1c, 0x33, 0x16, 0xfe, 0x1f, 0x81, 0x4b, 0x6b, 0xd4, 0xdd, 0x48, 0x5a, 0x81, 0xc7, 0x1a, 0x89,
+	0xe3, 0x62, 0xb6, 0x1e, 0x93, 0x88, 0x50, 0xe4, 0xbf, 0x30, 0x0f, 0x9f, 0xc3, 0xc5, 0x2c, 0xed,
+	0x26, 0x12, 0x5a, 0x27, 0xde, 0xe8, 0xab, 0x99, 0x89, 0x94, 0xab, 0x9f, 0xc2, 0x24, 0x23, 0x0c,
+	0xf9, 0xcd, 0x16, 0x87, 0xa5, 0x8d, 0xce, 0x2a, 0x03, 0x69, 0xb3, 0x26, 0xb8, 0xba, 0x48, 0x42,
+	0xfd, 0x0c, 0x80, 0x32, 0x14, 0xb3, 0x66, 0xda, 0x05, 0x5a, 0x91, 0xdb, 0xea, 0xa6, 0xe8, 0x00,
+	0xb3, 0xd3, 0x01, 0xe6, 0x37, 0x9d, 0x16, 0x69, 0x14, 0xef, 0x3c, 0x31, 0x14, 0x6b, 0x9c, 0xdb,
+	0xa4, 0x52, 0x55, 0x87, 0x31, 0x16, 0xa3, 0xd0, 0x6e, 0x63, 0xaa, 0x9d, 0x9b, 0x55, 0xe6, 0x8b,
+	0x56, 0xf6, 0xad, 0x2e, 0x41, 0x29, 0xc2, 0xb1, 0x47, 0x1c, 0xad, 0x24, 0x41, 0x1d, 0x77, 0xbc,
+	0x2a, 0x5b, 0xab, 0x51, 0xfc, 0x29, 0xf5, 0x2b, 0xd5, 0x7b, 0xee, 0x67, 0x0e, 0x8c, 0x3e, 0xec,
+	0x67, 0x37, 0x44, 0x60, 0x2a, 0xbd, 0x42, 0x1f, 0x79, 0x81, 0x4c, 0x2d, 0x97, 0x5f, 0x65, 0x58,
+	0x7e, 0x97, 0xcb, 0x29, 0x96, 0x5e, 0x4f, 0xd5, 0x9b, 0x50, 0xee, 0x0e, 0xd8, 0x81, 0xd2, 0x35,
+	0x07, 0x94, 0x33, 0x2a, 0xe1, 0xea, 0xcf, 0xa2, 0x22, 0x57, 0x62, 0x8c, 0x18, 0x5e, 0x21, 0x21,
+	0xf3, 0xc2, 0x84, 0x24, 0x34, 0x6d, 0xe4, 0x17, 0xae, 0xc8, 0xc5, 0x9e, 0xce, 0x1c, 0x64, 0x77,
+	0xd8, 0xb3, 0x5f, 0x03, 0x44, 0x38, 0xb6, 0x71, 0xc8, 0x90, 0x8b, 0x79, 0x01, 0x8e, 0x37, 0xea,
+	0x69, 0x66, 0x7f, 0x3e, 0x36, 0xde, 0x10, 0xc6, 0xd4, 0xd9, 0x32, 0x3d, 0x52, 0x0b, 0x10, 0x6b,
+	0x9b, 0xd7, 0xb1, 0x8b, 0xec, 0xbd, 0x55, 0x6c, 0x3f, 0xba, 0xbf, 0x00, 0xd2, 0xf7, 0x2a, 0xb6,
+	0xad, 0x23, 0x4e, 0xd4, 0x8f, 0xa0, 0x84, 0x77, 0x23, 0x2f, 0xde, 0x7b, 0xee, 0x9a, 0x94, 0xfa,
+	0x7d, 0x6a, 0x27, 0x8f, 0xa7, 0xac, 0x76, 0x7e, 0x55, 0x04, 0x97, 0x28, 0xb4, 0xb1, 0x7f, 0x4a,
+	0x5c, 0x9e, 0x4e, 0x77, 0xf7, 0x64, 0xf3, 0xf7, 0x08, 0x18, 0x7d, 0xa0, 0x66, 0xf5, 0xf7, 0x15,
+	0x9c, 0xb7, 0xf9, 0x39, 0x76, 0x44, 0x5b, 0x2b, 0x27, 0x52, 0x38, 0x96, 0xde, 0x16, 0xa7, 0x71,
+	0xb2, 0x63, 0xca, 0xbb, 0xfb, 0x1d, 0xb8, 0x90, 0xb9, 0x6a, 0x63, 0xcf, 0x6d, 0x8b, 0xba, 0x28,
+	0x5a, 0x53, 0x1d, 0xf1, 0x97, 0x5c, 0x9a, 0x9f, 0xee, 0xe8, 0xd0, 0xc3, 0xec, 0x07, 0x05, 0xb4,
+	0x1d, 0x8f, 0xb5, 0x9d, 0x18, 0xed, 0x84, 0x4d, 0xe4, 0xfb, 0xc4, 0x46, 0x0c, 0x3b, 0xcd, 0xcd,
+	0x24, 0x74, 0xb4, 0xe2, 0xe9, 0x77, 0x53, 0x39, 0x0b, 0x76, 0xad, 0x13, 0x2b, 0xa5, 0xb2, 0x7a,
+	0x03, 0x66, 0xd6, 0xa8, 0xfb, 0xad, 0x3c, 0x3c, 0x56, 0x12, 0x67, 0x3c, 0x58, 0x6e, 0x2b, 0x30,
+	0xd7, 0x37, 0xf8, 0xcb, 0x1d, 0x32, 0xff, 0x8e, 0x40, 0x39, 0x6b, 0x9e, 0x34, 0xbc, 0x17, 0xba,
+	0x1b, 0x2c, 0xc6, 0x28, 0x78, 0xe9, 0x33, 0x66, 0x07, 0x2e, 0x0a, 0x50, 0xcd, 0x08, 0xc7, 0x4d,
+	0xf9, 0xac, 0x9c, 0xc1, 0x8a, 0x70, 0x41, 0x44, 0x59, 0xc7, 0xf1, 0x3a, 0x8f, 0xa1, 0xbe, 0x05,
+	0xe7, 0x45, 0xb4, 0x66, 0xcb, 0x27, 0xf6, 0x16, 0xe5, 0x65, 0x58, 0xb4, 0x26, 0x85, 0xb0, 0xc1,
+	0x65, 0xaa, 0x06, 0xaf, 0x88, 0xef, 0xce, 0x23, 0xd8, 0xf9, 0x54, 0xe7, 0x60, 0x52, 0x3c, 0xb0,
+	0xb2, 0x7d, 0x4a, 0xfc, 0x78, 0x82, 0xcb, 0x44, 0xef, 0xf4, 0xf4, 0xf8, 0x2c, 0x54, 0xf2, 0x49,
+	0xcf, 0x06, 0xd6, 0x2f, 0x8a, 0xb8, 0x17, 0xde, 0x83, 0xa7, 0x73, 0x2f, 0x67, 0x34, 0xaf, 0x56,
+	0xa0, 0x92, 0x0f, 0x34, 0x2b, 0xe4, 0x39, 0x90, 0x54, 0xd2, 0xa6, 0x8f, 0x37, 0x45, 0x39, 0x17,
+	0xad, 0x09, 0x29, 0xbb, 0x8e, 0x37, 0xd9, 0xd5, 0xff, 0xc6, 0x60, 0x74, 0x8d, 0xba, 0x69, 0x0d,
+	0xf4, 0x6e, 0xdd, 0xef, 0x9a, 0xf9, 0x7f, 0x52, 0x98, 0x79, 0x6b, 0xad, 0xfe, 0xc1, 0x30, 0xda,
+	0x19, 0xc6, 0x1b, 0xa0, 0xe6, 0x2c, 0xc0, 0x0b, 0x03, 0x7c, 0xf5, 0xaa, 0xeb, 0x1f, 0x0e, 0xa5,
+	0x9e, 0xc5, 0xbe, 0xa5, 0xc0, 0x74, 0xee, 0xde, 0x59, 0x1b, 0xe0, 0x2f, 0xcf, 0x40, 0x5f, 0x1a,
+	0xd2, 0x20, 0x83, 0x80, 0x61, 0xe2, 0xe8, 0x62, 0xf5, 0xf6, 0xa0, 0x44, 0x0e, 0xf5, 0x74, 0xf3,
+	0xf9, 0xf4, 0xba, 0x32, 0xcd, 0xdd, 0x67, 0x06, 0x65, 0x9a, 0x67, 0xa0, 0x2f, 0x0d, 0x69, 0x90,
+	0x41, 0xf8, 0x51, 0x81, 0x72, 0x9f, 0xa9, 0x5f, 0x1f, 0xe0, 0x33, 0xdf, 0x44, 0xff, 0x78, 0x68,
+	0x93, 0x6e, 0x2e, 0xf2, 0xf6, 0x91, 0x81, 0x5c, 0xe4, 0x18, 0xe8, 0x4b, 0x43, 0x1a, 0x64, 0x10,
+	0x6e, 0xc2, 0x6b, 0x79, 0x83, 0xdf, 0x3c, 0x91, 0xdb, 0x2e, 0x7d, 0x7d, 0x71, 0x38, 0xfd, 0xae,
+	0xf0, 0x39, 0xf3, 0xcd, 0x3c, 0x31, 0x9d, 0x21, 0xc2, 0xf7, 0x1f, 0x4b, 0xfa, 0xb9, 0x5b, 0x4f,
+	0xef, 0x5d, 0x51, 0x1a, 0x9f, 0x3c, 0xd8, 0xaf, 0x28, 0x0f, 0xf7, 0x2b, 0xca, 0x5f, 0xfb, 0x15,
+	0xe5, 0xce, 0x41, 0xa5, 0xf0, 0xf0, 0xa0, 0x52, 0xf8, 0xe3, 0xa0, 0x52, 0xf8, 0x6e, 0xae, 0x6b,
+	0xb1, 0xdd, 0xed, 0xfe, 0x97, 0x07, 0x7f, 0x51, 0x5a, 0x25, 0x2e, 0x7b, 0xff, 0xd9, 0x00, 0xe9,
+	0xfa, 0x4c, 0x23, 0x16, 0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -882,6 +1129,12 @@ type MsgClient interface {
 	WithdrawContinuousFund(ctx context.Context, in *MsgWithdrawContinuousFund, opts ...grpc.CallOption) (*MsgWithdrawContinuousFundResponse, error)
 	// CancelContinuousFund defines a method for cancelling continuous fund.
 	CancelContinuousFund(ctx context.Context, in *MsgCancelContinuousFund, opts ...grpc.CallOption) (*MsgCancelContinuousFundResponse, error)
+	// CreateFundingStream defines a governance operation for registering a
+	// recurring grant paid out of the community pool to a recipient every period.
+	CreateFundingStream(ctx context.Context, in *MsgCreateFundingStream, opts ...grpc.CallOption) (*MsgCreateFundingStreamResponse, error)
+	// CancelFundingStream defines a governance operation for cancelling the
+	// funding stream of a recipient.
+	CancelFundingStream(ctx context.Context, in *MsgCancelFundingStream, opts ...grpc.CallOption) (*MsgCancelFundingStreamResponse, error)
 }
 
 type msgClient struct {
@@ -955,6 +1208,24 @@ func (c *msgClient) CancelContinuousFund(ctx context.Context, in *MsgCancelConti
 	return out, nil
 }
 
+func (c *msgClient) CreateFundingStream(ctx context.Context, in *MsgCreateFundingStream, opts ...grpc.CallOption) (*MsgCreateFundingStreamResponse, error) {
+	out := new(MsgCreateFundingStreamResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.protocolpool.v1.Msg/CreateFundingStream", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelFundingStream(ctx context.Context, in *MsgCancelFundingStream, opts ...grpc.CallOption) (*MsgCancelFundingStreamResponse, error) {
+	out := new(MsgCancelFundingStreamResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.protocolpool.v1.Msg/CancelFundingStream", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// FundCommunityPool defines a method to allow an account to directly
@@ -975,6 +1246,12 @@ type MsgServer interface {
 	WithdrawContinuousFund(context.Context, *MsgWithdrawContinuousFund) (*MsgWithdrawContinuousFundResponse, error)
 	// CancelContinuousFund defines a method for cancelling continuous fund.
 	CancelContinuousFund(context.Context, *MsgCancelContinuousFund) (*MsgCancelContinuousFundResponse, error)
+	// CreateFundingStream defines a governance operation for registering a
+	// recurring grant paid out of the community pool to a recipient every period.
+	CreateFundingStream(context.Context, *MsgCreateFundingStream) (*MsgCreateFundingStreamResponse, error)
+	// CancelFundingStream defines a governance operation for cancelling the
+	// funding stream of a recipient.
+	CancelFundingStream(context.Context, *MsgCancelFundingStream) (*MsgCancelFundingStreamResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1002,6 +1279,12 @@ func (*UnimplementedMsgServer) WithdrawContinuousFund(ctx context.Context, req *
 func (*UnimplementedMsgServer) CancelContinuousFund(ctx context.Context, req *MsgCancelContinuousFund) (*MsgCancelContinuousFundResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelContinuousFund not implemented")
 }
+func (*UnimplementedMsgServer) CreateFundingStream(ctx context.Context, req *MsgCreateFundingStream) (*MsgCreateFundingStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFundingStream not implemented")
+}
+func (*UnimplementedMsgServer) CancelFundingStream(ctx context.Context, req *MsgCancelFundingStream) (*MsgCancelFundingStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelFundingStream not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1133,6 +1416,42 @@ func _Msg_CancelContinuousFund_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CreateFundingStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateFundingStream)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateFundingStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.protocolpool.v1.Msg/CreateFundingStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateFundingStream(ctx, req.(*MsgCreateFundingStream))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelFundingStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelFundingStream)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelFundingStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.protocolpool.v1.Msg/CancelFundingStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelFundingStream(ctx, req.(*MsgCancelFundingStream))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.protocolpool.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1165,6 +1484,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CancelContinuousFund",
 			Handler:    _Msg_CancelContinuousFund_Handler,
 		},
+		{
+			MethodName: "CreateFundingStream",
+			Handler:    _Msg_CreateFundingStream_Handler,
+		},
+		{
+			MethodName: "CancelFundingStream",
+			Handler:    _Msg_CancelFundingStream_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/protocolpool/v1/tx.proto",
@@ -1704,43 +2031,197 @@ func (m *MsgWithdrawContinuousFundResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgCreateFundingStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgFundCommunityPool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgCreateFundingStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateFundingStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if m.StartHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Periods != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Periods))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PeriodBlocks != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PeriodBlocks))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.AmountPerPeriod) > 0 {
+		for iNdEx := len(m.AmountPerPeriod) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AmountPerPeriod[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	l = len(m.Depositor)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgFundCommunityPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCreateFundingStreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateFundingStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateFundingStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelFundingStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelFundingStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelFundingStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RecipientAddress) > 0 {
+		i -= len(m.RecipientAddress)
+		copy(dAtA[i:], m.RecipientAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RecipientAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelFundingStreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelFundingStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelFundingStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PeriodsLeft != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PeriodsLeft))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgFundCommunityPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFundCommunityPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
 
 func (m *MsgCommunityPoolSpend) Size() (n int) {
@@ -1933,6 +2414,76 @@ func (m *MsgWithdrawContinuousFundResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgCreateFundingStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.AmountPerPeriod) > 0 {
+		for _, e := range m.AmountPerPeriod {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.PeriodBlocks != 0 {
+		n += 1 + sovTx(uint64(m.PeriodBlocks))
+	}
+	if m.Periods != 0 {
+		n += 1 + sovTx(uint64(m.Periods))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovTx(uint64(m.StartHeight))
+	}
+	return n
+}
+
+func (m *MsgCreateFundingStreamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelFundingStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RecipientAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelFundingStreamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PeriodsLeft != 0 {
+		n += 1 + sovTx(uint64(m.PeriodsLeft))
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3439,6 +3990,444 @@ func (m *MsgWithdrawContinuousFundResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgCreateFundingStream) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateFundingStream: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateFundingStream: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountPerPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AmountPerPeriod = append(m.AmountPerPeriod, types.Coin{})
+			if err := m.AmountPerPeriod[len(m.AmountPerPeriod)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodBlocks", wireType)
+			}
+			m.PeriodBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Periods", wireType)
+			}
+			m.Periods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Periods |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateFundingStreamResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateFundingStreamResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateFundingStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelFundingStream) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelFundingStream: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelFundingStream: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipientAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelFundingStreamResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelFundingStreamResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelFundingStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodsLeft", wireType)
+			}
+			m.PeriodsLeft = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodsLeft |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
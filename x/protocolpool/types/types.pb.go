@@ -7,6 +7,7 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -200,9 +201,94 @@ func (m *ContinuousFund) GetExpiry() *time.Time {
 	return nil
 }
 
+// FundingStream defines a recurring grant paid out of the community pool to a
+// recipient every period, until all of its periods have been paid out.
+type FundingStream struct {
+	// recipient is the address of the account receiving the funds.
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// amount_per_period is the amount paid out to the recipient every period.
+	AmountPerPeriod github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount_per_period,json=amountPerPeriod,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount_per_period"`
+	// period_blocks is the length of a period in blocks. A period of one block
+	// pays the recipient out at every block.
+	PeriodBlocks uint64 `protobuf:"varint,3,opt,name=period_blocks,json=periodBlocks,proto3" json:"period_blocks,omitempty"`
+	// periods_left is the number of periods left to be paid out.
+	PeriodsLeft uint64 `protobuf:"varint,4,opt,name=periods_left,json=periodsLeft,proto3" json:"periods_left,omitempty"`
+	// next_height is the height at which the next period is paid out.
+	NextHeight uint64 `protobuf:"varint,5,opt,name=next_height,json=nextHeight,proto3" json:"next_height,omitempty"`
+}
+
+func (m *FundingStream) Reset()         { *m = FundingStream{} }
+func (m *FundingStream) String() string { return proto.CompactTextString(m) }
+func (*FundingStream) ProtoMessage()    {}
+func (*FundingStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b7d0ea246d7f44, []int{2}
+}
+func (m *FundingStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FundingStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FundingStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FundingStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundingStream.Merge(m, src)
+}
+func (m *FundingStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *FundingStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundingStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FundingStream proto.InternalMessageInfo
+
+func (m *FundingStream) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *FundingStream) GetAmountPerPeriod() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.AmountPerPeriod
+	}
+	return nil
+}
+
+func (m *FundingStream) GetPeriodBlocks() uint64 {
+	if m != nil {
+		return m.PeriodBlocks
+	}
+	return 0
+}
+
+func (m *FundingStream) GetPeriodsLeft() uint64 {
+	if m != nil {
+		return m.PeriodsLeft
+	}
+	return 0
+}
+
+func (m *FundingStream) GetNextHeight() uint64 {
+	if m != nil {
+		return m.NextHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Budget)(nil), "cosmos.protocolpool.v1.Budget")
 	proto.RegisterType((*ContinuousFund)(nil), "cosmos.protocolpool.v1.ContinuousFund")
+	proto.RegisterType((*FundingStream)(nil), "cosmos.protocolpool.v1.FundingStream")
 }
 
 func init() {
@@ -210,41 +296,48 @@ func init() {
 }
 
 var fileDescriptor_c1b7d0ea246d7f44 = []byte{
-	// 531 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
-	0x14, 0x8c, 0x69, 0x08, 0xcd, 0xb6, 0x29, 0x60, 0x55, 0xc8, 0x0d, 0x92, 0x13, 0xc2, 0x25, 0x97,
-	0xda, 0x0a, 0x48, 0x80, 0x04, 0x12, 0xd4, 0x09, 0x15, 0x87, 0x5e, 0x08, 0x9c, 0xb8, 0x58, 0x1b,
-	0xfb, 0xc5, 0x5d, 0x61, 0xfb, 0x59, 0xbb, 0xeb, 0x28, 0xf9, 0x8b, 0x1e, 0xf9, 0x90, 0x7e, 0x44,
-	0x8f, 0x55, 0x05, 0x12, 0xe2, 0x50, 0x50, 0xf2, 0x23, 0xc8, 0xeb, 0x4d, 0x48, 0x7b, 0x09, 0xb7,
-	0xf5, 0xbc, 0x99, 0xf1, 0xec, 0x3c, 0x2d, 0xe9, 0x04, 0x28, 0x12, 0x14, 0x6e, 0xc6, 0x51, 0x62,
-	0x80, 0x71, 0x86, 0x18, 0xbb, 0x93, 0x9e, 0x2b, 0x67, 0x19, 0x08, 0x47, 0xa1, 0xe6, 0xa3, 0x92,
-	0xe3, 0xac, 0x73, 0x9c, 0x49, 0xaf, 0xb9, 0x1f, 0x61, 0x84, 0x0a, 0x74, 0x8b, 0x53, 0x39, 0x6f,
-	0x1e, 0x94, 0x6c, 0xbf, 0x1c, 0xac, 0x4b, 0x9b, 0xb6, 0xfe, 0xd9, 0x88, 0x0a, 0x70, 0x27, 0xbd,
-	0x11, 0x48, 0xda, 0x73, 0x03, 0x64, 0xa9, 0x9e, 0xb7, 0x22, 0xc4, 0x28, 0x86, 0x32, 0xcc, 0x28,
-	0x1f, 0xbb, 0x92, 0x25, 0x20, 0x24, 0x4d, 0xb2, 0xa5, 0xc1, 0x6d, 0x42, 0x98, 0x73, 0x2a, 0x19,
-	0x6a, 0x83, 0xce, 0xf7, 0x2d, 0x52, 0xf3, 0xf2, 0x30, 0x02, 0x69, 0xbe, 0x27, 0x0f, 0x39, 0x04,
-	0x2c, 0x63, 0x90, 0x4a, 0x9f, 0x86, 0x21, 0x07, 0x21, 0x2c, 0xa3, 0x6d, 0x74, 0xeb, 0x9e, 0x75,
-	0x75, 0x7e, 0xb8, 0xaf, 0x83, 0x1d, 0x95, 0x93, 0x4f, 0x92, 0xb3, 0x34, 0x1a, 0x3e, 0x58, 0x49,
-	0x34, 0x6e, 0xbe, 0x21, 0xbb, 0x12, 0x25, 0x8d, 0xfd, 0x91, 0xb2, 0xb5, 0xee, 0xb4, 0x8d, 0xee,
-	0xce, 0xb3, 0x03, 0x47, 0xcb, 0x8b, 0x9b, 0x38, 0xfa, 0x26, 0x4e, 0x1f, 0x59, 0x3a, 0xdc, 0x51,
-	0x74, 0x1d, 0xe2, 0x1d, 0xd9, 0x0b, 0x62, 0xca, 0x12, 0x08, 0x7d, 0x9a, 0x60, 0x9e, 0x4a, 0x6b,
-	0x6b, 0x93, 0xbe, 0xa1, 0x05, 0x47, 0x8a, 0x6f, 0xbe, 0x25, 0x44, 0x48, 0xca, 0xa5, 0x5f, 0x54,
-	0x61, 0x55, 0x95, 0xba, 0xe9, 0x94, 0x35, 0x38, 0xcb, 0x1a, 0x9c, 0xcf, 0xcb, 0x9e, 0xbc, 0xea,
-	0xd9, 0xef, 0x96, 0x31, 0xac, 0x2b, 0x4d, 0x81, 0x9a, 0x1f, 0xc8, 0xfd, 0x14, 0xa6, 0xd2, 0x57,
-	0xb6, 0xfe, 0x98, 0x63, 0x62, 0xdd, 0xfd, 0x4f, 0x97, 0x46, 0x21, 0xec, 0x17, 0xba, 0x63, 0x8e,
-	0x89, 0xd9, 0x24, 0xdb, 0x92, 0xd3, 0x34, 0x38, 0x05, 0x61, 0xd5, 0xda, 0x46, 0xb7, 0x3a, 0x5c,
-	0x7d, 0x9b, 0x4f, 0x49, 0x63, 0x79, 0xf6, 0x63, 0x18, 0x4b, 0xeb, 0x9e, 0x22, 0xec, 0x2e, 0xc1,
-	0x13, 0x18, 0x4b, 0xf3, 0x25, 0xa9, 0x65, 0xc0, 0x19, 0x86, 0xd6, 0xb6, 0x6e, 0xe1, 0x76, 0x82,
-	0x81, 0x5e, 0xa7, 0x57, 0xfd, 0x56, 0x04, 0xd0, 0xf4, 0xce, 0x0f, 0x83, 0xec, 0xf5, 0x31, 0x95,
-	0x2c, 0xcd, 0x31, 0x17, 0xc7, 0x79, 0x1a, 0x9a, 0x2f, 0x48, 0x7d, 0xb5, 0xab, 0x8d, 0x6b, 0xfd,
-	0x47, 0x35, 0x3f, 0x12, 0x92, 0x01, 0x0f, 0x20, 0x95, 0x34, 0x02, 0xb5, 0xcd, 0xba, 0xd7, 0xbb,
-	0xb8, 0x6e, 0x55, 0x7e, 0x5d, 0xb7, 0x1e, 0x97, 0x62, 0x11, 0x7e, 0x75, 0x18, 0xba, 0x09, 0x95,
-	0xa7, 0xce, 0x09, 0x44, 0x34, 0x98, 0x0d, 0x20, 0xb8, 0x3a, 0x3f, 0x24, 0xda, 0x7b, 0x00, 0xc1,
-	0x70, 0xcd, 0xc4, 0x7c, 0x45, 0x6a, 0x30, 0xcd, 0x18, 0x9f, 0xe9, 0xe5, 0x6e, 0x2e, 0x56, 0xf3,
-	0xbd, 0xd7, 0x17, 0x73, 0xdb, 0xb8, 0x9c, 0xdb, 0xc6, 0x9f, 0xb9, 0x6d, 0x9c, 0x2d, 0xec, 0xca,
-	0xe5, 0xc2, 0xae, 0xfc, 0x5c, 0xd8, 0x95, 0x2f, 0x4f, 0x6e, 0x44, 0x99, 0xde, 0x7c, 0x9e, 0xea,
-	0x6d, 0x8e, 0x6a, 0x0a, 0x7b, 0xfe, 0x37, 0x00, 0x00, 0xff, 0xff, 0xb0, 0x0b, 0xb8, 0xdb, 0xc2,
-	0x03, 0x00, 0x00,
+	// 651 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcd, 0x4e, 0xdb, 0x4c,
+	0x14, 0x8d, 0x89, 0xbf, 0x7c, 0x64, 0x42, 0xa0, 0x58, 0xa8, 0x32, 0xa9, 0xe4, 0x84, 0x74, 0x93,
+	0x0d, 0x76, 0xd3, 0x4a, 0x6d, 0xa5, 0x56, 0x6a, 0x31, 0x14, 0xb1, 0x60, 0x41, 0x4d, 0x57, 0xdd,
+	0x58, 0x63, 0xfb, 0xc6, 0x19, 0x61, 0x7b, 0xac, 0x99, 0x31, 0x85, 0xb7, 0x60, 0xd9, 0x57, 0x68,
+	0xd7, 0x3c, 0x04, 0x4b, 0x84, 0x5a, 0xa9, 0xea, 0x02, 0x2a, 0x78, 0x91, 0xca, 0xe3, 0x09, 0x0d,
+	0x2c, 0x0a, 0xea, 0x2a, 0xf6, 0xb9, 0xe7, 0xdc, 0x9f, 0x73, 0x6f, 0x8c, 0xfa, 0x21, 0xe5, 0x29,
+	0xe5, 0x4e, 0xce, 0xa8, 0xa0, 0x21, 0x4d, 0x72, 0x4a, 0x13, 0x67, 0x7f, 0xe8, 0x88, 0xc3, 0x1c,
+	0xb8, 0x2d, 0x51, 0xe3, 0x61, 0xc5, 0xb1, 0xa7, 0x39, 0xf6, 0xfe, 0xb0, 0xb3, 0x14, 0xd3, 0x98,
+	0x4a, 0xd0, 0x29, 0x9f, 0xaa, 0x78, 0x67, 0xb9, 0x62, 0xfb, 0x55, 0x60, 0x5a, 0xda, 0xb1, 0x54,
+	0xb1, 0x00, 0x73, 0x70, 0xf6, 0x87, 0x01, 0x08, 0x3c, 0x74, 0x42, 0x4a, 0x32, 0x15, 0xef, 0xc6,
+	0x94, 0xc6, 0x09, 0x54, 0xcd, 0x04, 0xc5, 0xc8, 0x11, 0x24, 0x05, 0x2e, 0x70, 0x9a, 0x4f, 0x12,
+	0xdc, 0x26, 0x44, 0x05, 0xc3, 0x82, 0x50, 0x95, 0xa0, 0xff, 0xad, 0x8e, 0x1a, 0x6e, 0x11, 0xc5,
+	0x20, 0x8c, 0x77, 0x68, 0x91, 0x41, 0x48, 0x72, 0x02, 0x99, 0xf0, 0x71, 0x14, 0x31, 0xe0, 0xdc,
+	0xd4, 0x7a, 0xda, 0xa0, 0xe9, 0x9a, 0x67, 0xc7, 0xab, 0x4b, 0xaa, 0xb1, 0xb5, 0x2a, 0xb2, 0x2b,
+	0x18, 0xc9, 0x62, 0xef, 0xc1, 0xb5, 0x44, 0xe1, 0xc6, 0x6b, 0x34, 0x27, 0xa8, 0xc0, 0x89, 0x1f,
+	0xc8, 0xb4, 0xe6, 0x4c, 0x4f, 0x1b, 0xb4, 0x9e, 0x2e, 0xdb, 0x4a, 0x5e, 0x4e, 0x62, 0xab, 0x49,
+	0xec, 0x75, 0x4a, 0x32, 0xaf, 0x25, 0xe9, 0xaa, 0x89, 0xb7, 0x68, 0x3e, 0x4c, 0x30, 0x49, 0x21,
+	0xf2, 0x71, 0x4a, 0x8b, 0x4c, 0x98, 0xf5, 0xbb, 0xf4, 0x6d, 0x25, 0x58, 0x93, 0x7c, 0xe3, 0x0d,
+	0x42, 0x5c, 0x60, 0x26, 0xfc, 0xd2, 0x0a, 0x53, 0x97, 0xea, 0x8e, 0x5d, 0xd9, 0x60, 0x4f, 0x6c,
+	0xb0, 0x3f, 0x4c, 0x7c, 0x72, 0xf5, 0xa3, 0x8b, 0xae, 0xe6, 0x35, 0xa5, 0xa6, 0x44, 0x8d, 0x2d,
+	0xb4, 0x90, 0xc1, 0x81, 0xf0, 0x65, 0x5a, 0x7f, 0xc4, 0x68, 0x6a, 0xfe, 0x77, 0xcf, 0x2c, 0xed,
+	0x52, 0xb8, 0x5e, 0xea, 0x36, 0x19, 0x4d, 0x8d, 0x0e, 0x9a, 0x15, 0x0c, 0x67, 0xe1, 0x18, 0xb8,
+	0xd9, 0xe8, 0x69, 0x03, 0xdd, 0xbb, 0x7e, 0x37, 0x1e, 0xa3, 0xf6, 0xe4, 0xd9, 0x4f, 0x60, 0x24,
+	0xcc, 0xff, 0x25, 0x61, 0x6e, 0x02, 0x6e, 0xc3, 0x48, 0x18, 0x2f, 0x50, 0x23, 0x07, 0x46, 0x68,
+	0x64, 0xce, 0x2a, 0x17, 0x6e, 0x77, 0xb0, 0xa1, 0xd6, 0xe9, 0xea, 0x9f, 0xcb, 0x06, 0x14, 0xbd,
+	0xff, 0x5d, 0x43, 0xf3, 0xeb, 0x34, 0x13, 0x24, 0x2b, 0x68, 0xc1, 0x37, 0x8b, 0x2c, 0x32, 0x9e,
+	0xa3, 0xe6, 0xf5, 0xae, 0xee, 0x5c, 0xeb, 0x1f, 0xaa, 0xf1, 0x1e, 0xa1, 0x1c, 0x58, 0x08, 0x99,
+	0xc0, 0x31, 0xc8, 0x6d, 0x36, 0xdd, 0xe1, 0xc9, 0x79, 0xb7, 0xf6, 0xf3, 0xbc, 0xfb, 0xa8, 0x12,
+	0xf3, 0x68, 0xcf, 0x26, 0xd4, 0x49, 0xb1, 0x18, 0xdb, 0xdb, 0x10, 0xe3, 0xf0, 0x70, 0x03, 0xc2,
+	0xb3, 0xe3, 0x55, 0xa4, 0x72, 0x6f, 0x40, 0xe8, 0x4d, 0x25, 0x31, 0x5e, 0xa2, 0x06, 0x1c, 0xe4,
+	0x84, 0x1d, 0x9a, 0xf5, 0x7b, 0x1a, 0xab, 0xf8, 0xfd, 0x2f, 0x33, 0xa8, 0x5d, 0x4e, 0x43, 0xb2,
+	0x78, 0x57, 0x30, 0xc0, 0xe9, 0x3f, 0x8f, 0xf5, 0x09, 0x2d, 0x56, 0x07, 0xe6, 0xe7, 0xc0, 0x7c,
+	0xe5, 0xf2, 0x4c, 0xaf, 0xfe, 0xd7, 0x5b, 0x73, 0x9f, 0x94, 0x83, 0x7f, 0xbd, 0xe8, 0x0e, 0x62,
+	0x22, 0xc6, 0x45, 0x60, 0x87, 0x34, 0x55, 0x7f, 0x58, 0xf5, 0xb3, 0xca, 0xa3, 0x3d, 0xf5, 0x29,
+	0x28, 0x05, 0xdc, 0x5b, 0xa8, 0xaa, 0xec, 0x00, 0xdb, 0x91, 0x35, 0xca, 0xc5, 0x57, 0xd5, 0xfc,
+	0x20, 0xa1, 0xe1, 0x1e, 0x97, 0x1e, 0xe8, 0xde, 0x5c, 0x05, 0xba, 0x12, 0x33, 0x56, 0x90, 0x7a,
+	0x57, 0xc7, 0xa1, 0x4b, 0x4e, 0x4b, 0x61, 0xf2, 0x36, 0xba, 0xa8, 0x25, 0xcf, 0x74, 0x0c, 0x24,
+	0x1e, 0x0b, 0x79, 0xa2, 0xba, 0x87, 0x4a, 0x68, 0x4b, 0x22, 0xee, 0xab, 0x93, 0x4b, 0x4b, 0x3b,
+	0xbd, 0xb4, 0xb4, 0x5f, 0x97, 0x96, 0x76, 0x74, 0x65, 0xd5, 0x4e, 0xaf, 0xac, 0xda, 0x8f, 0x2b,
+	0xab, 0xf6, 0x71, 0xe5, 0xc6, 0xda, 0x0e, 0x6e, 0x7e, 0xca, 0x64, 0xf3, 0x41, 0x43, 0x62, 0xcf,
+	0x7e, 0x0f, 0x00, 0x9c, 0xf4, 0x07, 0xd7, 0xee, 0x04, 0x00, 0x00,
 }
 
 func (m *Budget) Marshal() (dAtA []byte, err error) {
@@ -391,6 +484,65 @@ func (m *ContinuousFund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FundingStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FundingStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FundingStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.NextHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PeriodsLeft != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.PeriodsLeft))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PeriodBlocks != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.PeriodBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.AmountPerPeriod) > 0 {
+		for iNdEx := len(m.AmountPerPeriod) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AmountPerPeriod[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -460,6 +612,34 @@ func (m *ContinuousFund) Size() (n int) {
 	return n
 }
 
+func (m *FundingStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.AmountPerPeriod) > 0 {
+		for _, e := range m.AmountPerPeriod {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.PeriodBlocks != 0 {
+		n += 1 + sovTypes(uint64(m.PeriodBlocks))
+	}
+	if m.PeriodsLeft != 0 {
+		n += 1 + sovTypes(uint64(m.PeriodsLeft))
+	}
+	if m.NextHeight != 0 {
+		n += 1 + sovTypes(uint64(m.NextHeight))
+	}
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -918,6 +1098,179 @@ func (m *ContinuousFund) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *FundingStream) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FundingStream: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FundingStream: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountPerPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AmountPerPeriod = append(m.AmountPerPeriod, types.Coin{})
+			if err := m.AmountPerPeriod[len(m.AmountPerPeriod)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodBlocks", wireType)
+			}
+			m.PeriodBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodsLeft", wireType)
+			}
+			m.PeriodsLeft = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodsLeft |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextHeight", wireType)
+			}
+			m.NextHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -34,6 +34,11 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "signing-infos",
 					Short:     "Query signing information of all validators",
 				},
+				{
+					RpcMethod: "SigningInfosExtended",
+					Use:       "signing-infos-extended",
+					Short:     "Query signing information of all validators along with their uptime and missed block streaks",
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -59,6 +59,28 @@ func (keeper Keeper) InitGenesis(ctx context.Context, stakingKeeper types.Stakin
 		}
 	}
 
+	for _, streak := range data.MissedBlockStreaks {
+		address, err := keeper.sk.ConsensusAddressCodec().StringToBytes(streak.Address)
+		if err != nil {
+			return err
+		}
+
+		if err := keeper.ValidatorMissedBlockStreak.Set(ctx, address, streak); err != nil {
+			return err
+		}
+	}
+
+	for _, offenses := range data.DowntimeOffenses {
+		address, err := keeper.sk.ConsensusAddressCodec().StringToBytes(offenses.Address)
+		if err != nil {
+			return err
+		}
+
+		if err := keeper.ValidatorDowntimeOffenses.Set(ctx, address, offenses); err != nil {
+			return err
+		}
+	}
+
 	if err := keeper.Params.Set(ctx, data.Params); err != nil {
 		return err
 	}
@@ -100,5 +122,27 @@ func (keeper Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, er
 	if err != nil {
 		return nil, err
 	}
-	return types.NewGenesisState(params, signingInfos, missedBlocks), nil
+
+	missedBlockStreaks := make([]types.ValidatorMissedBlockStreak, 0)
+	err = keeper.ValidatorMissedBlockStreak.Walk(ctx, nil, func(_ sdk.ConsAddress, streak types.ValidatorMissedBlockStreak) (stop bool, err error) {
+		missedBlockStreaks = append(missedBlockStreaks, streak)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	downtimeOffenses := make([]types.ValidatorDowntimeOffenses, 0)
+	err = keeper.ValidatorDowntimeOffenses.Walk(ctx, nil, func(_ sdk.ConsAddress, offenses types.ValidatorDowntimeOffenses) (stop bool, err error) {
+		downtimeOffenses = append(downtimeOffenses, offenses)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	genState := types.NewGenesisState(params, signingInfos, missedBlocks)
+	genState.MissedBlockStreaks = missedBlockStreaks
+	genState.DowntimeOffenses = downtimeOffenses
+	return genState, nil
 }
@@ -30,12 +30,18 @@ func (s *KeeperTestSuite) TestExportAndInitGenesis() {
 
 	s.Require().NoError(keeper.ValidatorSigningInfo.Set(ctx, consAddr1, info1))
 	s.Require().NoError(keeper.ValidatorSigningInfo.Set(ctx, consAddr2, info2))
+	streak1 := types.ValidatorMissedBlockStreak{Address: consStr1, Current: 2, Longest: 5}
+	s.Require().NoError(keeper.ValidatorMissedBlockStreak.Set(ctx, consAddr1, streak1))
+	offenses2 := types.ValidatorDowntimeOffenses{Address: consStr2, Count: 2, LastOffenseHeight: 3}
+	s.Require().NoError(keeper.ValidatorDowntimeOffenses.Set(ctx, consAddr2, offenses2))
 	genesisState, err := keeper.ExportGenesis(ctx)
 	require.NoError(err)
 
 	require.Equal(genesisState.Params, testutil.TestParams())
 	require.Len(genesisState.SigningInfos, 2)
 	require.Equal(genesisState.SigningInfos[0].ValidatorSigningInfo, info1)
+	require.Equal([]types.ValidatorMissedBlockStreak{streak1}, genesisState.MissedBlockStreaks)
+	require.Equal([]types.ValidatorDowntimeOffenses{offenses2}, genesisState.DowntimeOffenses)
 
 	// Tombstone validators after genesis shouldn't effect genesis state
 	err = keeper.Tombstone(ctx, consAddr1)
@@ -62,4 +68,12 @@ func (s *KeeperTestSuite) TestExportAndInitGenesis() {
 	newInfo2, _ := keeper.ValidatorSigningInfo.Get(ctx, consAddr2)
 	require.Equal(info1, newInfo1)
 	require.Equal(info2, newInfo2)
+
+	newStreak1, err := keeper.GetValidatorMissedBlockStreak(ctx, consAddr1)
+	require.NoError(err)
+	require.Equal(streak1, newStreak1)
+
+	newOffenses2, err := keeper.ValidatorDowntimeOffenses.Get(ctx, consAddr2)
+	require.NoError(err)
+	require.Equal(offenses2, newOffenses2)
 }
@@ -10,6 +10,7 @@ import (
 	"cosmossdk.io/x/slashing/types"
 
 	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
@@ -80,3 +81,37 @@ func (k Keeper) SigningInfos(ctx context.Context, req *types.QuerySigningInfosRe
 	}
 	return &types.QuerySigningInfosResponse{Info: signInfos, Pagination: pageRes}, nil
 }
+
+// SigningInfosExtended returns signing-infos of all validators along with their
+// uptime and missed block streaks.
+func (k Keeper) SigningInfosExtended(ctx context.Context, req *types.QuerySigningInfosExtendedRequest) (*types.QuerySigningInfosExtendedResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	signedBlocksWindow, err := k.SignedBlocksWindow(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	infos, pageRes, err := query.CollectionPaginate(ctx, k.ValidatorSigningInfo, req.Pagination,
+		func(consAddr sdk.ConsAddress, info types.ValidatorSigningInfo) (types.ValidatorSigningInfoExtended, error) {
+			streak, err := k.GetValidatorMissedBlockStreak(ctx, consAddr)
+			if err != nil {
+				return types.ValidatorSigningInfoExtended{}, err
+			}
+
+			return types.ValidatorSigningInfoExtended{
+				SigningInfo:               info,
+				Uptime:                    k.GetValidatorUptime(ctx, signedBlocksWindow, info),
+				MissedBlocksStreak:        streak.Current,
+				LongestMissedBlocksStreak: streak.Longest,
+			}, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySigningInfosExtendedResponse{Info: infos, Pagination: pageRes}, nil
+}
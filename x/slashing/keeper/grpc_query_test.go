@@ -4,6 +4,8 @@ import (
 	gocontext "context"
 	"time"
 
+	"cosmossdk.io/core/header"
+	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/x/slashing/testutil"
 	slashingtypes "cosmossdk.io/x/slashing/types"
 
@@ -94,3 +96,33 @@ func (s *KeeperTestSuite) TestGRPCSigningInfos() {
 	require.NotNil(infoResp.Pagination.NextKey)
 	require.Equal(uint64(2), infoResp.Pagination.Total)
 }
+
+func (s *KeeperTestSuite) TestGRPCSigningInfosExtended() {
+	queryClient, ctx, keeper := s.queryClient, s.ctx, s.slashingKeeper
+	require := s.Require()
+
+	consStr, err := s.stakingKeeper.ConsensusAddressCodec().BytesToString(consAddr)
+	require.NoError(err)
+
+	// 10 blocks expected to be signed, 4 of them missed
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 14})
+	signingInfo := slashingtypes.NewValidatorSigningInfo(consStr, 5, time.Unix(2, 0).UTC(), false, int64(4))
+	require.NoError(keeper.ValidatorSigningInfo.Set(ctx, consAddr, signingInfo))
+	require.NoError(keeper.ValidatorMissedBlockStreak.Set(ctx, consAddr, slashingtypes.ValidatorMissedBlockStreak{Address: consStr, Current: 1, Longest: 3}))
+
+	res, err := keeper.SigningInfosExtended(ctx, &slashingtypes.QuerySigningInfosExtendedRequest{})
+	require.NoError(err)
+	require.Equal([]slashingtypes.ValidatorSigningInfoExtended{{
+		SigningInfo:               signingInfo,
+		Uptime:                    sdkmath.LegacyNewDecWithPrec(6, 1),
+		MissedBlocksStreak:        1,
+		LongestMissedBlocksStreak: 3,
+	}}, res.Info)
+
+	_, err = keeper.SigningInfosExtended(ctx, nil)
+	require.Error(err)
+
+	infoResp, err := queryClient.SigningInfosExtended(gocontext.Background(), &slashingtypes.QuerySigningInfosExtendedRequest{})
+	require.NoError(err)
+	require.Len(infoResp.Info, 1)
+}
@@ -8,6 +8,7 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/x/slashing/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
 
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -112,3 +113,7 @@ func (h Hooks) AfterConsensusPubKeyUpdate(ctx context.Context, oldPubKey, newPub
 
 	return nil
 }
+
+func (h Hooks) AfterSelfDelegationBelowMinimum(_ context.Context, _ sdk.ValAddress, _ stakingtypes.MinSelfDelegationBreachAction) error {
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/cockroachdb/errors"
 
 	st "cosmossdk.io/api/cosmos/staking/v1beta1"
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/comet"
 	"cosmossdk.io/core/event"
 	"cosmossdk.io/x/slashing/types"
@@ -107,6 +108,10 @@ func (k Keeper) HandleValidatorSignatureWithParams(ctx context.Context, params t
 		// bitmap value at this index has not changed, no need to update counter
 	}
 
+	if err := k.updateMissedBlockStreak(ctx, consAddr, missed); err != nil {
+		return err
+	}
+
 	minSignedPerWindow := params.MinSignedPerWindowInt()
 
 	consStr, err := k.sk.ConsensusAddressCodec().BytesToString(consAddr)
@@ -152,12 +157,14 @@ func (k Keeper) HandleValidatorSignatureWithParams(ctx context.Context, params t
 			// That's fine since this is just used to filter unbonding delegations & redelegations.
 			distributionHeight := height - sdk.ValidatorUpdateDelay - 1
 
-			slashFractionDowntime, err := k.SlashFractionDowntime(ctx)
+			// repeated offenses climb the downtime slashing ladder
+			offenses, err := k.recordDowntimeOffense(ctx, params, consAddr, consStr, height)
 			if err != nil {
 				return err
 			}
+			tier := params.DowntimeSlashingTier(offenses)
 
-			coinsBurned, err := k.sk.SlashWithInfractionReason(ctx, consAddr, distributionHeight, power, slashFractionDowntime, st.Infraction_INFRACTION_DOWNTIME)
+			coinsBurned, err := k.sk.SlashWithInfractionReason(ctx, consAddr, distributionHeight, power, tier.SlashFraction, st.Infraction_INFRACTION_DOWNTIME)
 			if err != nil {
 				return err
 			}
@@ -177,11 +184,7 @@ func (k Keeper) HandleValidatorSignatureWithParams(ctx context.Context, params t
 			if err != nil {
 				return err
 			}
-			downtimeJailDur, err := k.DowntimeJailDuration(ctx)
-			if err != nil {
-				return err
-			}
-			signInfo.JailedUntil = k.environment.HeaderService.GetHeaderInfo(ctx).Time.Add(downtimeJailDur)
+			signInfo.JailedUntil = k.environment.HeaderService.GetHeaderInfo(ctx).Time.Add(tier.JailDuration)
 
 			// We need to reset the counter & bitmap so that the validator won't be
 			// immediately slashed for downtime upon re-bonding.
@@ -199,7 +202,8 @@ func (k Keeper) HandleValidatorSignatureWithParams(ctx context.Context, params t
 				"validator", consStr,
 				"min_height", minHeight,
 				"threshold", minSignedPerWindow,
-				"slashed", slashFractionDowntime.String(),
+				"offenses", offenses,
+				"slashed", tier.SlashFraction.String(),
 				"jailed_until", signInfo.JailedUntil,
 			)
 		} else {
@@ -217,3 +221,26 @@ func (k Keeper) HandleValidatorSignatureWithParams(ctx context.Context, params t
 	}
 	return nil
 }
+
+// recordDowntimeOffense records a downtime offense of the validator at the given
+// height and returns the number of its repeated downtime offenses, including
+// this one. An offense committed more than DowntimeOffenseWindow blocks after
+// the previous one starts a new count.
+func (k Keeper) recordDowntimeOffense(ctx context.Context, params types.Params, consAddr sdk.ConsAddress, consStr string, height int64) (int64, error) {
+	offenses, err := k.ValidatorDowntimeOffenses.Get(ctx, consAddr)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		offenses = types.ValidatorDowntimeOffenses{Address: consStr}
+	case err != nil:
+		return 0, err
+	}
+
+	if params.DowntimeOffenseWindow > 0 && height-offenses.LastOffenseHeight > params.DowntimeOffenseWindow {
+		offenses.Count = 0
+	}
+
+	offenses.Count++
+	offenses.LastOffenseHeight = height
+
+	return offenses.Count, k.ValidatorDowntimeOffenses.Set(ctx, consAddr, offenses)
+}
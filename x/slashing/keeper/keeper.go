@@ -35,6 +35,10 @@ type Keeper struct {
 	AddrPubkeyRelation collections.Map[[]byte, cryptotypes.PubKey]
 	// ValidatorMissedBlockBitmap key: ConsAddr | value: byte key for a validator's missed block bitmap chunk
 	ValidatorMissedBlockBitmap collections.Map[collections.Pair[[]byte, uint64], []byte]
+	// ValidatorMissedBlockStreak key: ConsAddr | value: ValidatorMissedBlockStreak
+	ValidatorMissedBlockStreak collections.Map[sdk.ConsAddress, types.ValidatorMissedBlockStreak]
+	// ValidatorDowntimeOffenses key: ConsAddr | value: ValidatorDowntimeOffenses
+	ValidatorDowntimeOffenses collections.Map[sdk.ConsAddress, types.ValidatorDowntimeOffenses]
 }
 
 // NewKeeper creates a slashing keeper
@@ -68,6 +72,20 @@ func NewKeeper(environment appmodule.Environment, cdc codec.BinaryCodec, legacyA
 			collections.PairKeyCodec(sdk.LengthPrefixedBytesKey, collections.Uint64Key),
 			collections.BytesValue,
 		),
+		ValidatorMissedBlockStreak: collections.NewMap(
+			sb,
+			types.ValidatorMissedBlockStreakKeyPrefix,
+			"validator_missed_block_streak",
+			sdk.ConsAddressKey,
+			codec.CollValue[types.ValidatorMissedBlockStreak](cdc),
+		),
+		ValidatorDowntimeOffenses: collections.NewMap(
+			sb,
+			types.ValidatorDowntimeOffensesKeyPrefix,
+			"validator_downtime_offenses",
+			sdk.ConsAddressKey,
+			codec.CollValue[types.ValidatorDowntimeOffenses](cdc),
+		),
 	}
 
 	schema, err := sb.Build()
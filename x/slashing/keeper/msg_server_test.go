@@ -118,6 +118,41 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 			expectErr: true,
 			expErrMsg: "downtime slash fraction cannot be negative",
 		},
+		{
+			name: "set invalid downtime slashing ladder",
+			request: &slashingtypes.MsgUpdateParams{
+				Authority: s.slashingKeeper.GetAuthority(),
+				Params: slashingtypes.Params{
+					SignedBlocksWindow:      int64(750),
+					MinSignedPerWindow:      minSignedPerWindow,
+					DowntimeJailDuration:    time.Duration(10),
+					SlashFractionDoubleSign: slashFractionDoubleSign,
+					SlashFractionDowntime:   slashFractionDowntime,
+					DowntimeSlashingLadder: []slashingtypes.DowntimeSlashingTier{
+						{SlashFraction: slashFractionDowntime, JailDuration: time.Duration(10)},
+						{SlashFraction: slashFractionDowntime, JailDuration: time.Duration(0)},
+					},
+				},
+			},
+			expectErr: true,
+			expErrMsg: "downtime jail duration must be positive",
+		},
+		{
+			name: "set invalid downtime offense window",
+			request: &slashingtypes.MsgUpdateParams{
+				Authority: s.slashingKeeper.GetAuthority(),
+				Params: slashingtypes.Params{
+					SignedBlocksWindow:      int64(750),
+					MinSignedPerWindow:      minSignedPerWindow,
+					DowntimeJailDuration:    time.Duration(10),
+					SlashFractionDoubleSign: slashFractionDoubleSign,
+					SlashFractionDowntime:   slashFractionDowntime,
+					DowntimeOffenseWindow:   -1,
+				},
+			},
+			expectErr: true,
+			expErrMsg: "downtime offense window cannot be negative",
+		},
 		{
 			name: "set full valid params",
 			request: &slashingtypes.MsgUpdateParams{
@@ -128,6 +163,11 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 					DowntimeJailDuration:    time.Duration(34800000000000),
 					SlashFractionDoubleSign: slashFractionDoubleSign,
 					SlashFractionDowntime:   slashFractionDowntime,
+					DowntimeSlashingLadder: []slashingtypes.DowntimeSlashingTier{
+						{SlashFraction: slashFractionDowntime, JailDuration: time.Duration(34800000000000)},
+						{SlashFraction: slashFractionDoubleSign, JailDuration: time.Duration(69600000000000)},
+					},
+					DowntimeOffenseWindow: 100000,
 				},
 			},
 			expectErr: false,
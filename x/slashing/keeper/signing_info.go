@@ -10,6 +10,7 @@ import (
 
 	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/x/slashing/types"
 
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
@@ -232,6 +233,59 @@ func (k Keeper) GetValidatorMissedBlocks(ctx context.Context, addr sdk.ConsAddre
 	return missedBlocks, err
 }
 
+// GetValidatorMissedBlockStreak returns the missed block streak of the given
+// validator, or an empty streak if the validator never missed a block.
+func (k Keeper) GetValidatorMissedBlockStreak(ctx context.Context, addr sdk.ConsAddress) (types.ValidatorMissedBlockStreak, error) {
+	streak, err := k.ValidatorMissedBlockStreak.Get(ctx, addr)
+	if errors.Is(err, collections.ErrNotFound) {
+		consAddr, err := k.sk.ConsensusAddressCodec().BytesToString(addr)
+		return types.ValidatorMissedBlockStreak{Address: consAddr}, err
+	}
+
+	return streak, err
+}
+
+// updateMissedBlockStreak extends the missed block streak of the given
+// validator if it missed the block, and resets it otherwise. The streak is only
+// written when it changes.
+func (k Keeper) updateMissedBlockStreak(ctx context.Context, addr sdk.ConsAddress, missed bool) error {
+	streak, err := k.GetValidatorMissedBlockStreak(ctx, addr)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case missed:
+		streak.Current++
+		if streak.Current > streak.Longest {
+			streak.Longest = streak.Current
+		}
+
+	case streak.Current > 0:
+		streak.Current = 0
+
+	default:
+		return nil
+	}
+
+	return k.ValidatorMissedBlockStreak.Set(ctx, addr, streak)
+}
+
+// GetValidatorUptime returns the fraction of the blocks of the current signed
+// blocks window signed by the validator. A validator that was not expected to
+// sign any block yet has an uptime of 1.
+func (k Keeper) GetValidatorUptime(ctx context.Context, signedBlocksWindow int64, signInfo types.ValidatorSigningInfo) sdkmath.LegacyDec {
+	height := k.environment.HeaderService.GetHeaderInfo(ctx).Height
+
+	blocks := min(height-signInfo.StartHeight+1, signedBlocksWindow)
+	if blocks <= 0 {
+		return sdkmath.LegacyOneDec()
+	}
+
+	signed := blocks - min(signInfo.MissedBlocksCounter, blocks)
+	return sdkmath.LegacyNewDec(signed).QuoInt64(blocks)
+}
+
 // performConsensusPubKeyUpdate updates cons address to its pub key relation
 // Updates signing info, missed blocks (removes old one, and sets new one)
 func (k Keeper) performConsensusPubKeyUpdate(ctx context.Context, oldPubKey, newPubKey cryptotypes.PubKey) error {
@@ -256,5 +310,38 @@ func (k Keeper) performConsensusPubKeyUpdate(ctx context.Context, oldPubKey, new
 		return err
 	}
 
-	return k.ValidatorSigningInfo.Remove(ctx, sdk.ConsAddress(oldPubKey.Address()))
+	if err := k.ValidatorSigningInfo.Remove(ctx, sdk.ConsAddress(oldPubKey.Address())); err != nil {
+		return err
+	}
+
+	// Migrate ValidatorMissedBlockStreak from oldPubKey to newPubKey
+	streak, err := k.ValidatorMissedBlockStreak.Get(ctx, sdk.ConsAddress(oldPubKey.Address()))
+	switch {
+	case err == nil:
+		streak.Address = consAddr
+		if err := k.ValidatorMissedBlockStreak.Set(ctx, sdk.ConsAddress(newPubKey.Address()), streak); err != nil {
+			return err
+		}
+
+		if err := k.ValidatorMissedBlockStreak.Remove(ctx, sdk.ConsAddress(oldPubKey.Address())); err != nil {
+			return err
+		}
+	case !errors.Is(err, collections.ErrNotFound):
+		return err
+	}
+
+	// Migrate ValidatorDowntimeOffenses from oldPubKey to newPubKey
+	offenses, err := k.ValidatorDowntimeOffenses.Get(ctx, sdk.ConsAddress(oldPubKey.Address()))
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	offenses.Address = consAddr
+	if err := k.ValidatorDowntimeOffenses.Set(ctx, sdk.ConsAddress(newPubKey.Address()), offenses); err != nil {
+		return err
+	}
+
+	return k.ValidatorDowntimeOffenses.Remove(ctx, sdk.ConsAddress(oldPubKey.Address()))
 }
@@ -5,8 +5,13 @@ import (
 
 	"github.com/golang/mock/gomock"
 
+	st "cosmossdk.io/api/cosmos/staking/v1beta1"
+	"cosmossdk.io/core/comet"
+	"cosmossdk.io/core/header"
+	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/x/slashing/testutil"
 	slashingtypes "cosmossdk.io/x/slashing/types"
+	stakingtestutil "cosmossdk.io/x/staking/testutil"
 
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -136,6 +141,9 @@ func (s *KeeperTestSuite) TestPerformConsensusPubKeyUpdate() {
 	err = slashingKeeper.SetMissedBlockBitmapValue(ctx, oldConsAddr, 10, true)
 	require.NoError(err)
 
+	err = slashingKeeper.ValidatorMissedBlockStreak.Set(ctx, oldConsAddr, slashingtypes.ValidatorMissedBlockStreak{Current: 1, Longest: 3})
+	require.NoError(err)
+
 	err = slashingKeeper.Hooks().AfterConsensusPubKeyUpdate(ctx, pks[0], pks[1], sdk.Coin{})
 	require.NoError(err)
 
@@ -149,9 +157,127 @@ func (s *KeeperTestSuite) TestPerformConsensusPubKeyUpdate() {
 	require.NoError(err)
 	require.Equal(signingInfo, newInfo)
 
+	// check missed block streak is moved to new consensus pubkey
+	streak, err := slashingKeeper.ValidatorMissedBlockStreak.Get(ctx, newConsAddr)
+	require.NoError(err)
+	require.Equal(int64(3), streak.Longest)
+	has, err := slashingKeeper.ValidatorMissedBlockStreak.Has(ctx, oldConsAddr)
+	require.NoError(err)
+	require.False(has)
+
 	// missed blocks maps to old cons key only since there is a identifier added to get the missed blocks using the new cons key.
 	missedBlocks, err := slashingKeeper.GetValidatorMissedBlocks(ctx, oldConsAddr)
 	require.NoError(err)
 
 	require.Len(missedBlocks, 1)
 }
+
+func (s *KeeperTestSuite) TestValidatorMissedBlockStreak() {
+	ctx, keeper := s.ctx, s.slashingKeeper
+	require := s.Require()
+
+	pk := simtestutil.CreateTestPubKeys(1)[0]
+	valConsAddr := sdk.ConsAddress(pk.Address())
+	validator := stakingtestutil.NewValidator(s.T(), sdk.ValAddress(pk.Address()), pk)
+	s.stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), valConsAddr).Return(validator, nil).AnyTimes()
+	s.stakingKeeper.EXPECT().ValidatorIdentifier(gomock.Any(), valConsAddr).Return(valConsAddr, nil).AnyTimes()
+
+	consStr, err := s.stakingKeeper.ConsensusAddressCodec().BytesToString(valConsAddr)
+	require.NoError(err)
+	require.NoError(keeper.ValidatorSigningInfo.Set(ctx, valConsAddr, slashingtypes.NewValidatorSigningInfo(consStr, 0, time.Unix(0, 0), false, 0)))
+
+	testCases := []struct {
+		signed     comet.BlockIDFlag
+		expCurrent int64
+		expLongest int64
+	}{
+		{comet.BlockIDFlagAbsent, 1, 1},
+		{comet.BlockIDFlagAbsent, 2, 2},
+		{comet.BlockIDFlagCommit, 0, 2},
+		{comet.BlockIDFlagAbsent, 1, 2},
+		{comet.BlockIDFlagNil, 0, 2},
+		{comet.BlockIDFlagAbsent, 1, 2},
+		{comet.BlockIDFlagAbsent, 2, 2},
+		{comet.BlockIDFlagAbsent, 3, 3},
+	}
+	for i, tc := range testCases {
+		ctx = ctx.WithHeaderInfo(header.Info{Height: int64(i)})
+		require.NoError(keeper.HandleValidatorSignature(ctx, pk.Address(), 100, tc.signed))
+
+		streak, err := keeper.GetValidatorMissedBlockStreak(ctx, valConsAddr)
+		require.NoError(err)
+		require.Equal(slashingtypes.ValidatorMissedBlockStreak{Address: consStr, Current: tc.expCurrent, Longest: tc.expLongest}, streak, "block %d", i)
+	}
+
+	// 6 of the 8 blocks were missed
+	info, err := keeper.ValidatorSigningInfo.Get(ctx, valConsAddr)
+	require.NoError(err)
+	require.Equal(int64(6), info.MissedBlocksCounter)
+	require.Equal(sdkmath.LegacyNewDecWithPrec(25, 2), keeper.GetValidatorUptime(ctx, 1000, info))
+
+	// only the last 4 blocks are in a window of 4 blocks
+	info.MissedBlocksCounter = 3
+	require.Equal(sdkmath.LegacyNewDecWithPrec(25, 2), keeper.GetValidatorUptime(ctx, 4, info))
+
+	// a validator not expected to sign any block yet has a full uptime
+	info.StartHeight = ctx.HeaderInfo().Height + 1
+	require.Equal(sdkmath.LegacyOneDec(), keeper.GetValidatorUptime(ctx, 1000, info))
+}
+
+func (s *KeeperTestSuite) TestDowntimeSlashingLadder() {
+	ctx, keeper := s.ctx, s.slashingKeeper
+	require := s.Require()
+
+	params := testutil.TestParams()
+	params.SignedBlocksWindow = 10
+	params.DowntimeSlashingLadder = []slashingtypes.DowntimeSlashingTier{
+		{SlashFraction: sdkmath.LegacyNewDecWithPrec(1, 2), JailDuration: time.Minute},
+		{SlashFraction: sdkmath.LegacyNewDecWithPrec(5, 2), JailDuration: time.Hour},
+		{SlashFraction: sdkmath.LegacyNewDecWithPrec(1, 1), JailDuration: 24 * time.Hour},
+	}
+	params.DowntimeOffenseWindow = 1000
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	pk := simtestutil.CreateTestPubKeys(1)[0]
+	valConsAddr := sdk.ConsAddress(pk.Address())
+	validator := stakingtestutil.NewValidator(s.T(), sdk.ValAddress(pk.Address()), pk)
+	s.stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), valConsAddr).Return(validator, nil).AnyTimes()
+	s.stakingKeeper.EXPECT().ValidatorIdentifier(gomock.Any(), valConsAddr).Return(valConsAddr, nil).AnyTimes()
+	s.stakingKeeper.EXPECT().Jail(gomock.Any(), valConsAddr).Return(nil).AnyTimes()
+
+	consStr, err := s.stakingKeeper.ConsensusAddressCodec().BytesToString(valConsAddr)
+	require.NoError(err)
+
+	testCases := []struct {
+		height      int64
+		expOffenses int64
+		expTier     slashingtypes.DowntimeSlashingTier
+	}{
+		{100, 1, params.DowntimeSlashingLadder[0]},
+		{200, 2, params.DowntimeSlashingLadder[1]},
+		{300, 3, params.DowntimeSlashingLadder[2]},
+		// the last tier applies to any further offense
+		{400, 4, params.DowntimeSlashingLadder[2]},
+		// the previous offense is outside of the offense window
+		{1401, 1, params.DowntimeSlashingLadder[0]},
+	}
+	for _, tc := range testCases {
+		ctx = ctx.WithHeaderInfo(header.Info{Height: tc.height, Time: s.ctx.HeaderInfo().Time})
+
+		// the validator has missed every block of the window
+		info := slashingtypes.NewValidatorSigningInfo(consStr, 0, time.Unix(0, 0), false, params.SignedBlocksWindow)
+		require.NoError(keeper.ValidatorSigningInfo.Set(ctx, valConsAddr, info))
+
+		s.stakingKeeper.EXPECT().SlashWithInfractionReason(gomock.Any(), valConsAddr, tc.height-sdk.ValidatorUpdateDelay-1,
+			gomock.Any(), tc.expTier.SlashFraction, st.Infraction_INFRACTION_DOWNTIME).Return(sdkmath.ZeroInt(), nil)
+		require.NoError(keeper.HandleValidatorSignature(ctx, pk.Address(), 100, comet.BlockIDFlagAbsent))
+
+		info, err = keeper.ValidatorSigningInfo.Get(ctx, valConsAddr)
+		require.NoError(err)
+		require.Equal(ctx.HeaderInfo().Time.Add(tc.expTier.JailDuration), info.JailedUntil, "height %d", tc.height)
+
+		offenses, err := keeper.ValidatorDowntimeOffenses.Get(ctx, valConsAddr)
+		require.NoError(err)
+		require.Equal(slashingtypes.ValidatorDowntimeOffenses{Address: consStr, Count: tc.expOffenses, LastOffenseHeight: tc.height}, offenses)
+	}
+}
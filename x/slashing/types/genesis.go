@@ -32,6 +32,9 @@ func DefaultGenesisState() *GenesisState {
 		Params:       DefaultParams(),
 		SigningInfos: []SigningInfo{},
 		MissedBlocks: []ValidatorMissedBlocks{},
+
+		MissedBlockStreaks: []ValidatorMissedBlockStreak{},
+		DowntimeOffenses:   []ValidatorDowntimeOffenses{},
 	}
 }
 
@@ -57,10 +60,30 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("downtime unjail duration must be at least 1 minute, is %s", downtimeJail.String())
 	}
 
+	if err := validateDowntimeSlashingLadder(data.Params.DowntimeSlashingLadder); err != nil {
+		return err
+	}
+
+	if err := validateDowntimeOffenseWindow(data.Params.DowntimeOffenseWindow); err != nil {
+		return err
+	}
+
 	signedWindow := data.Params.SignedBlocksWindow
 	if signedWindow < 10 {
 		return fmt.Errorf("signed blocks window must be at least 10, is %d", signedWindow)
 	}
 
+	for _, streak := range data.MissedBlockStreaks {
+		if streak.Current < 0 || streak.Longest < streak.Current {
+			return fmt.Errorf("invalid missed block streak of validator %s: current %d, longest %d", streak.Address, streak.Current, streak.Longest)
+		}
+	}
+
+	for _, offenses := range data.DowntimeOffenses {
+		if offenses.Count <= 0 {
+			return fmt.Errorf("downtime offense count of validator %s must be positive, is %d", offenses.Address, offenses.Count)
+		}
+	}
+
 	return nil
 }
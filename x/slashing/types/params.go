@@ -61,6 +61,12 @@ func (p Params) Validate() error {
 	if err := validateSlashFractionDowntime(p.SlashFractionDowntime); err != nil {
 		return err
 	}
+	if err := validateDowntimeSlashingLadder(p.DowntimeSlashingLadder); err != nil {
+		return err
+	}
+	if err := validateDowntimeOffenseWindow(p.DowntimeOffenseWindow); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -147,6 +153,51 @@ func validateSlashFractionDowntime(i interface{}) error {
 	return nil
 }
 
+func validateDowntimeSlashingLadder(i interface{}) error {
+	v, ok := i.([]DowntimeSlashingTier)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for _, tier := range v {
+		if err := validateSlashFractionDowntime(tier.SlashFraction); err != nil {
+			return err
+		}
+		if err := validateDowntimeJailDuration(tier.JailDuration); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateDowntimeOffenseWindow(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("downtime offense window cannot be negative: %d", v)
+	}
+
+	return nil
+}
+
+// DowntimeSlashingTier returns the slash fraction and jail duration of the
+// given downtime offense of a validator, starting at 1 for a first offense.
+func (p Params) DowntimeSlashingTier(offense int64) DowntimeSlashingTier {
+	if len(p.DowntimeSlashingLadder) == 0 {
+		return DowntimeSlashingTier{
+			SlashFraction: p.SlashFractionDowntime,
+			JailDuration:  p.DowntimeJailDuration,
+		}
+	}
+
+	i := min(offense, int64(len(p.DowntimeSlashingLadder))) - 1
+	return p.DowntimeSlashingLadder[max(i, 0)]
+}
+
 // MinSignedPerWindowInt returns min signed per window as an integer (vs the decimal in the param)
 func (p *Params) MinSignedPerWindowInt() int64 {
 	signedBlocksWindow := p.SignedBlocksWindow
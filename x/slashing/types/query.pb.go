@@ -5,6 +5,7 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	query "github.com/cosmos/cosmos-sdk/types/query"
@@ -309,6 +310,182 @@ func (m *QuerySigningInfosResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QuerySigningInfosExtendedRequest is the request type for the
+// Query/SigningInfosExtended RPC method
+//
+// Since: cosmos-sdk 0.51
+type QuerySigningInfosExtendedRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySigningInfosExtendedRequest) Reset()         { *m = QuerySigningInfosExtendedRequest{} }
+func (m *QuerySigningInfosExtendedRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySigningInfosExtendedRequest) ProtoMessage()    {}
+func (*QuerySigningInfosExtendedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_791b11d41a861ed0, []int{6}
+}
+func (m *QuerySigningInfosExtendedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySigningInfosExtendedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySigningInfosExtendedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySigningInfosExtendedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySigningInfosExtendedRequest.Merge(m, src)
+}
+func (m *QuerySigningInfosExtendedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySigningInfosExtendedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySigningInfosExtendedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySigningInfosExtendedRequest proto.InternalMessageInfo
+
+func (m *QuerySigningInfosExtendedRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySigningInfosExtendedResponse is the response type for the
+// Query/SigningInfosExtended RPC method
+//
+// Since: cosmos-sdk 0.51
+type QuerySigningInfosExtendedResponse struct {
+	// info is the extended signing info of all validators
+	Info       []ValidatorSigningInfoExtended `protobuf:"bytes,1,rep,name=info,proto3" json:"info"`
+	Pagination *query.PageResponse            `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySigningInfosExtendedResponse) Reset()         { *m = QuerySigningInfosExtendedResponse{} }
+func (m *QuerySigningInfosExtendedResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySigningInfosExtendedResponse) ProtoMessage()    {}
+func (*QuerySigningInfosExtendedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_791b11d41a861ed0, []int{7}
+}
+func (m *QuerySigningInfosExtendedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySigningInfosExtendedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySigningInfosExtendedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySigningInfosExtendedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySigningInfosExtendedResponse.Merge(m, src)
+}
+func (m *QuerySigningInfosExtendedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySigningInfosExtendedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySigningInfosExtendedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySigningInfosExtendedResponse proto.InternalMessageInfo
+
+func (m *QuerySigningInfosExtendedResponse) GetInfo() []ValidatorSigningInfoExtended {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
+func (m *QuerySigningInfosExtendedResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// ValidatorSigningInfoExtended is the signing info of a validator along with
+// performance metrics derived from it.
+//
+// Since: cosmos-sdk 0.51
+type ValidatorSigningInfoExtended struct {
+	SigningInfo ValidatorSigningInfo `protobuf:"bytes,1,opt,name=signing_info,json=signingInfo,proto3" json:"signing_info"`
+	// uptime is the fraction of the blocks of the current signed blocks window
+	// signed by the validator.
+	Uptime cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=uptime,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"uptime"`
+	// missed_blocks_streak is the number of consecutive blocks missed by the
+	// validator up to the last block it was expected to sign.
+	MissedBlocksStreak int64 `protobuf:"varint,3,opt,name=missed_blocks_streak,json=missedBlocksStreak,proto3" json:"missed_blocks_streak,omitempty"`
+	// longest_missed_blocks_streak is the longest streak of consecutive blocks
+	// missed by the validator.
+	LongestMissedBlocksStreak int64 `protobuf:"varint,4,opt,name=longest_missed_blocks_streak,json=longestMissedBlocksStreak,proto3" json:"longest_missed_blocks_streak,omitempty"`
+}
+
+func (m *ValidatorSigningInfoExtended) Reset()         { *m = ValidatorSigningInfoExtended{} }
+func (m *ValidatorSigningInfoExtended) String() string { return proto.CompactTextString(m) }
+func (*ValidatorSigningInfoExtended) ProtoMessage()    {}
+func (*ValidatorSigningInfoExtended) Descriptor() ([]byte, []int) {
+	return fileDescriptor_791b11d41a861ed0, []int{8}
+}
+func (m *ValidatorSigningInfoExtended) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorSigningInfoExtended) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorSigningInfoExtended.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorSigningInfoExtended) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorSigningInfoExtended.Merge(m, src)
+}
+func (m *ValidatorSigningInfoExtended) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorSigningInfoExtended) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorSigningInfoExtended.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorSigningInfoExtended proto.InternalMessageInfo
+
+func (m *ValidatorSigningInfoExtended) GetSigningInfo() ValidatorSigningInfo {
+	if m != nil {
+		return m.SigningInfo
+	}
+	return ValidatorSigningInfo{}
+}
+
+func (m *ValidatorSigningInfoExtended) GetMissedBlocksStreak() int64 {
+	if m != nil {
+		return m.MissedBlocksStreak
+	}
+	return 0
+}
+
+func (m *ValidatorSigningInfoExtended) GetLongestMissedBlocksStreak() int64 {
+	if m != nil {
+		return m.LongestMissedBlocksStreak
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.slashing.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.slashing.v1beta1.QueryParamsResponse")
@@ -316,6 +493,9 @@ func init() {
 	proto.RegisterType((*QuerySigningInfoResponse)(nil), "cosmos.slashing.v1beta1.QuerySigningInfoResponse")
 	proto.RegisterType((*QuerySigningInfosRequest)(nil), "cosmos.slashing.v1beta1.QuerySigningInfosRequest")
 	proto.RegisterType((*QuerySigningInfosResponse)(nil), "cosmos.slashing.v1beta1.QuerySigningInfosResponse")
+	proto.RegisterType((*QuerySigningInfosExtendedRequest)(nil), "cosmos.slashing.v1beta1.QuerySigningInfosExtendedRequest")
+	proto.RegisterType((*QuerySigningInfosExtendedResponse)(nil), "cosmos.slashing.v1beta1.QuerySigningInfosExtendedResponse")
+	proto.RegisterType((*ValidatorSigningInfoExtended)(nil), "cosmos.slashing.v1beta1.ValidatorSigningInfoExtended")
 }
 
 func init() {
@@ -323,43 +503,54 @@ func init() {
 }
 
 var fileDescriptor_791b11d41a861ed0 = []byte{
-	// 563 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xc7, 0x33, 0xad, 0x06, 0x3a, 0x29, 0xa2, 0x63, 0xa1, 0x6d, 0xd0, 0x8d, 0x5d, 0x21, 0x2d,
-	0xd5, 0xee, 0x98, 0x88, 0xf4, 0xe4, 0xc1, 0x28, 0x8a, 0xe0, 0x41, 0x53, 0x10, 0xf4, 0x12, 0x66,
-	0x9b, 0xe9, 0x38, 0xb8, 0x99, 0xd9, 0xee, 0x6c, 0x82, 0x45, 0xf4, 0xe0, 0xd9, 0x83, 0xe0, 0x67,
-	0x10, 0x3c, 0xaa, 0xf8, 0x21, 0x7a, 0x2c, 0x7a, 0xf1, 0x24, 0x92, 0x08, 0xde, 0xfd, 0x04, 0xb2,
-	0x33, 0x93, 0x64, 0x4a, 0x5c, 0x4d, 0xe9, 0x25, 0x0c, 0x6f, 0xde, 0xff, 0xfd, 0x7f, 0xef, 0xe5,
-	0xcd, 0xc2, 0x8b, 0xdb, 0x52, 0x75, 0xa4, 0xc2, 0x2a, 0x22, 0xea, 0x09, 0x17, 0x0c, 0xf7, 0x6a,
-	0x21, 0x4d, 0x49, 0x0d, 0xef, 0x76, 0x69, 0xb2, 0x17, 0xc4, 0x89, 0x4c, 0x25, 0x5a, 0x34, 0x49,
-	0xc1, 0x30, 0x29, 0xb0, 0x49, 0xe5, 0x75, 0xab, 0x0e, 0x89, 0xa2, 0x46, 0x31, 0xd2, 0xc7, 0x84,
-	0x71, 0x41, 0x52, 0x2e, 0x85, 0x29, 0x52, 0x5e, 0x60, 0x92, 0x49, 0x7d, 0xc4, 0xd9, 0xc9, 0x46,
-	0xcf, 0x31, 0x29, 0x59, 0x44, 0x31, 0x89, 0x39, 0x26, 0x42, 0xc8, 0x54, 0x4b, 0x94, 0xbd, 0xad,
-	0xe6, 0xd1, 0x8d, 0x48, 0x4c, 0xde, 0xb2, 0xc9, 0x6b, 0x99, 0xf2, 0x96, 0xd6, 0x5c, 0x9d, 0x21,
-	0x1d, 0x2e, 0x24, 0xd6, 0xbf, 0x26, 0xe4, 0x2f, 0x40, 0xf4, 0x20, 0x63, 0xbd, 0x4f, 0x12, 0xd2,
-	0x51, 0x4d, 0xba, 0xdb, 0xa5, 0x2a, 0xf5, 0x1f, 0xc1, 0xb3, 0x87, 0xa2, 0x2a, 0x96, 0x42, 0x51,
-	0xd4, 0x80, 0xc5, 0x58, 0x47, 0x96, 0xc0, 0x05, 0xb0, 0x56, 0xaa, 0x57, 0x82, 0x9c, 0x61, 0x04,
-	0x46, 0xd8, 0x98, 0xdb, 0xff, 0x5e, 0x29, 0xbc, 0xff, 0xf5, 0x61, 0x1d, 0x34, 0xad, 0xd2, 0x6f,
-	0xc1, 0x45, 0x5d, 0x7a, 0x8b, 0x33, 0xc1, 0x05, 0xbb, 0x2b, 0x76, 0xa4, 0x75, 0x45, 0xb7, 0xe0,
-	0xfc, 0xb6, 0x14, 0xaa, 0x45, 0xda, 0xed, 0x84, 0x2a, 0x63, 0x32, 0xd7, 0x58, 0xf9, 0xf2, 0x79,
-	0xe3, 0xbc, 0xf5, 0xb9, 0x99, 0x61, 0x08, 0xd5, 0x55, 0x37, 0x4c, 0xca, 0x56, 0x9a, 0x70, 0xc1,
-	0x9a, 0xa5, 0x4c, 0x66, 0x43, 0xfe, 0x4b, 0xb8, 0x34, 0x69, 0x60, 0x1b, 0x08, 0xe1, 0xe9, 0x1e,
-	0x89, 0x5a, 0xca, 0x5c, 0xb5, 0xb8, 0xd8, 0x91, 0xb6, 0x95, 0x8d, 0xdc, 0x56, 0x1e, 0x92, 0x88,
-	0xb7, 0x49, 0x2a, 0x13, 0xa7, 0xa0, 0xdb, 0xd8, 0xa9, 0x1e, 0x89, 0x9c, 0x2b, 0x3f, 0x9c, 0xf4,
-	0x1f, 0xce, 0x15, 0xdd, 0x86, 0x70, 0xbc, 0x0b, 0xd6, 0xb9, 0x3a, 0x74, 0xce, 0x16, 0x27, 0x30,
-	0xab, 0x36, 0x1e, 0x23, 0xa3, 0x56, 0xdb, 0x74, 0x94, 0xfe, 0x27, 0x00, 0x97, 0xff, 0x62, 0x62,
-	0xbb, 0xbc, 0x07, 0x4f, 0xd8, 0xce, 0x66, 0x8f, 0xd5, 0x99, 0xae, 0x82, 0xee, 0x1c, 0x62, 0x9e,
-	0xd1, 0xcc, 0xab, 0xff, 0x65, 0x36, 0x28, 0x2e, 0x74, 0xfd, 0xf7, 0x2c, 0x3c, 0xa9, 0xa1, 0xd1,
-	0x6b, 0x00, 0x8b, 0x66, 0x43, 0xd0, 0xa5, 0x5c, 0xba, 0xc9, 0xb5, 0x2c, 0x5f, 0x9e, 0x2e, 0xd9,
-	0x78, 0xfb, 0xab, 0xaf, 0xbe, 0xfe, 0x7c, 0x3b, 0xb3, 0x82, 0x2a, 0x38, 0xef, 0xe5, 0x98, 0x95,
-	0x44, 0x1f, 0x01, 0x2c, 0x39, 0x23, 0x40, 0x57, 0xfe, 0x6d, 0x33, 0xb9, 0xb9, 0xe5, 0xda, 0x11,
-	0x14, 0x96, 0xee, 0xba, 0xa6, 0xdb, 0x44, 0xd7, 0x72, 0xe9, 0xdc, 0x2d, 0x55, 0xf8, 0xb9, 0xfb,
-	0x34, 0x5e, 0xa0, 0x77, 0x00, 0xce, 0xbb, 0x7f, 0x3e, 0x9a, 0x1e, 0x61, 0x34, 0xce, 0xfa, 0x51,
-	0x24, 0x16, 0x3b, 0xd0, 0xd8, 0x6b, 0xa8, 0x3a, 0x1d, 0x76, 0x63, 0x73, 0xbf, 0xef, 0x81, 0x83,
-	0xbe, 0x07, 0x7e, 0xf4, 0x3d, 0xf0, 0x66, 0xe0, 0x15, 0x0e, 0x06, 0x5e, 0xe1, 0xdb, 0xc0, 0x2b,
-	0x3c, 0xb6, 0x6f, 0x5a, 0xb5, 0x9f, 0x06, 0x5c, 0xe2, 0x67, 0xe3, 0x42, 0xe9, 0x5e, 0x4c, 0x55,
-	0x58, 0xd4, 0xdf, 0xa7, 0xab, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x12, 0x73, 0x05, 0x05, 0x95,
-	0x05, 0x00, 0x00,
+	// 748 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcf, 0x6b, 0x13, 0x41,
+	0x14, 0xce, 0xf6, 0x47, 0xa0, 0x93, 0x22, 0x3a, 0x06, 0xda, 0xc6, 0x36, 0x69, 0x57, 0x68, 0x4b,
+	0xb5, 0xbb, 0x4d, 0xa5, 0x16, 0x05, 0x11, 0x63, 0x55, 0x84, 0x2a, 0x9a, 0x8a, 0xa0, 0x1e, 0x96,
+	0x49, 0x76, 0xba, 0x1d, 0xbb, 0x99, 0xd9, 0xee, 0x4c, 0x4a, 0x8b, 0xe8, 0xc1, 0xb3, 0x07, 0xc1,
+	0xbf, 0x41, 0xf0, 0xa8, 0xd2, 0x9b, 0xe0, 0xc1, 0x83, 0xf4, 0x58, 0xea, 0x45, 0x3c, 0x14, 0x69,
+	0x05, 0xff, 0x0d, 0xc9, 0xcc, 0xa4, 0xd9, 0x98, 0xa6, 0x26, 0x5a, 0x2f, 0x25, 0xbc, 0xf7, 0xbe,
+	0xf7, 0x7d, 0xef, 0xdb, 0x37, 0xaf, 0xe0, 0x74, 0x91, 0xf1, 0x12, 0xe3, 0x36, 0xf7, 0x11, 0x5f,
+	0x22, 0xd4, 0xb3, 0x57, 0xb3, 0x05, 0x2c, 0x50, 0xd6, 0x5e, 0x29, 0xe3, 0x70, 0xdd, 0x0a, 0x42,
+	0x26, 0x18, 0xec, 0x53, 0x45, 0x56, 0xb5, 0xc8, 0xd2, 0x45, 0xa9, 0x09, 0x8d, 0x2e, 0x20, 0x8e,
+	0x15, 0x62, 0x1f, 0x1f, 0x20, 0x8f, 0x50, 0x24, 0x08, 0xa3, 0xaa, 0x49, 0x2a, 0xe9, 0x31, 0x8f,
+	0xc9, 0x9f, 0x76, 0xe5, 0x97, 0x8e, 0x0e, 0x7a, 0x8c, 0x79, 0x3e, 0xb6, 0x51, 0x40, 0x6c, 0x44,
+	0x29, 0x13, 0x12, 0xc2, 0x75, 0x76, 0xb4, 0x99, 0xba, 0x7d, 0x25, 0xaa, 0x6e, 0x40, 0xd5, 0x39,
+	0xaa, 0xbd, 0x56, 0xab, 0x52, 0x27, 0x50, 0x89, 0x50, 0x66, 0xcb, 0xbf, 0x2a, 0x64, 0x26, 0x01,
+	0xbc, 0x5b, 0xd1, 0x7a, 0x07, 0x85, 0xa8, 0xc4, 0xf3, 0x78, 0xa5, 0x8c, 0xb9, 0x30, 0x1f, 0x80,
+	0x93, 0x75, 0x51, 0x1e, 0x30, 0xca, 0x31, 0xcc, 0x81, 0x78, 0x20, 0x23, 0xfd, 0xc6, 0xb0, 0x31,
+	0x9e, 0x98, 0xce, 0x58, 0x4d, 0xcc, 0xb0, 0x14, 0x30, 0xd7, 0xb3, 0xb9, 0x93, 0x89, 0xbd, 0xf9,
+	0xf9, 0x76, 0xc2, 0xc8, 0x6b, 0xa4, 0xe9, 0x80, 0x3e, 0xd9, 0x7a, 0x81, 0x78, 0x94, 0x50, 0xef,
+	0x26, 0x5d, 0x64, 0x9a, 0x15, 0xce, 0x81, 0xde, 0x22, 0xa3, 0xdc, 0x41, 0xae, 0x1b, 0x62, 0xae,
+	0x48, 0x7a, 0x72, 0x23, 0xdb, 0x1b, 0x93, 0x43, 0x9a, 0xe7, 0x6a, 0x45, 0x06, 0xe5, 0x65, 0x7e,
+	0x45, 0x95, 0x2c, 0x88, 0x90, 0x50, 0x2f, 0x9f, 0xa8, 0xc0, 0x74, 0xc8, 0x7c, 0x06, 0xfa, 0x1b,
+	0x09, 0xf4, 0x00, 0x05, 0x70, 0x7c, 0x15, 0xf9, 0x0e, 0x57, 0x29, 0x87, 0xd0, 0x45, 0xa6, 0x47,
+	0x99, 0x6c, 0x3a, 0xca, 0x7d, 0xe4, 0x13, 0x17, 0x09, 0x16, 0x46, 0x1a, 0x46, 0x07, 0x3b, 0xb6,
+	0x8a, 0xfc, 0x48, 0xca, 0x2c, 0x34, 0xf2, 0x57, 0x7d, 0x85, 0xd7, 0x01, 0xa8, 0xed, 0x82, 0x66,
+	0x1e, 0xad, 0x32, 0x57, 0x16, 0xc7, 0x52, 0xab, 0x56, 0xb3, 0xd1, 0xc3, 0x1a, 0x9b, 0x8f, 0x20,
+	0xcd, 0xf7, 0x06, 0x18, 0x38, 0x80, 0x44, 0x4f, 0x39, 0x0f, 0xba, 0xf4, 0x64, 0x9d, 0xff, 0x34,
+	0x99, 0xec, 0x02, 0x6f, 0xd4, 0x69, 0xee, 0x90, 0x9a, 0xc7, 0xfe, 0xa8, 0x59, 0x49, 0xa9, 0x13,
+	0xfd, 0x18, 0x0c, 0x37, 0x68, 0xbe, 0xb6, 0x26, 0x30, 0x75, 0xb1, 0x7b, 0xd4, 0x06, 0x7d, 0x32,
+	0xc0, 0xc8, 0x21, 0x64, 0xda, 0xa8, 0x7b, 0x75, 0x46, 0xcd, 0xb4, 0x65, 0x54, 0xb5, 0xd9, 0x7f,
+	0x34, 0xec, 0x63, 0x07, 0x18, 0x3c, 0x8c, 0x1a, 0x3e, 0x02, 0xbd, 0x47, 0xba, 0xca, 0x09, 0x5e,
+	0x8b, 0xc3, 0xdb, 0x20, 0x5e, 0x0e, 0x04, 0x29, 0x61, 0x39, 0x42, 0x4f, 0xee, 0x7c, 0xa5, 0xee,
+	0xdb, 0x4e, 0xe6, 0x94, 0xea, 0xce, 0xdd, 0x65, 0x8b, 0x30, 0xbb, 0x84, 0xc4, 0x92, 0x35, 0x8f,
+	0x3d, 0x54, 0x5c, 0x9f, 0xc3, 0xc5, 0xed, 0x8d, 0x49, 0xa0, 0xc9, 0xe7, 0x70, 0x51, 0x3f, 0x7c,
+	0xd5, 0x05, 0x4e, 0x81, 0x64, 0x89, 0x70, 0x8e, 0x5d, 0xa7, 0xe0, 0xb3, 0xe2, 0x32, 0x77, 0xb8,
+	0x08, 0x31, 0x5a, 0xee, 0xef, 0x1c, 0x36, 0xc6, 0x3b, 0xf3, 0x50, 0xe5, 0x72, 0x32, 0xb5, 0x20,
+	0x33, 0xf0, 0x32, 0x18, 0xf4, 0x19, 0xf5, 0x30, 0x17, 0xce, 0x81, 0xc8, 0x2e, 0x89, 0x1c, 0xd0,
+	0x35, 0xb7, 0x1a, 0x1a, 0x4c, 0x7f, 0xe8, 0x06, 0xdd, 0x72, 0x0b, 0xe0, 0x0b, 0x03, 0xc4, 0xd5,
+	0x4d, 0x82, 0x67, 0x9a, 0xda, 0xd3, 0x78, 0x08, 0x53, 0x67, 0x5b, 0x2b, 0x56, 0x1f, 0xcf, 0x1c,
+	0x7b, 0xfe, 0xe5, 0xc7, 0xab, 0x8e, 0x11, 0x98, 0xb1, 0x9b, 0xdd, 0x6a, 0x75, 0x04, 0xe1, 0x3b,
+	0x03, 0x24, 0x22, 0xdf, 0x00, 0x4e, 0x1d, 0x4e, 0xd3, 0x78, 0x2b, 0x53, 0xd9, 0x36, 0x10, 0x5a,
+	0xdd, 0x25, 0xa9, 0x6e, 0x16, 0xce, 0x34, 0x55, 0x17, 0x5d, 0x26, 0x6e, 0x3f, 0x89, 0x1e, 0xe3,
+	0xa7, 0xf0, 0xb5, 0x01, 0x7a, 0xa3, 0xaf, 0x09, 0xb6, 0x2e, 0x61, 0xdf, 0xce, 0xe9, 0x76, 0x20,
+	0x5a, 0xb6, 0x25, 0x65, 0x8f, 0xc3, 0xd1, 0xd6, 0x64, 0xc3, 0xcf, 0x06, 0x48, 0x1e, 0xf4, 0xea,
+	0xe1, 0x85, 0xd6, 0xc9, 0x7f, 0x3b, 0x4b, 0xa9, 0x8b, 0x7f, 0x03, 0xd5, 0xfa, 0x67, 0xa5, 0xfe,
+	0x2c, 0xb4, 0x5b, 0xd3, 0xef, 0xe0, 0xea, 0x61, 0x99, 0xdd, 0xdc, 0x4d, 0x1b, 0x5b, 0xbb, 0x69,
+	0xe3, 0xfb, 0x6e, 0xda, 0x78, 0xb9, 0x97, 0x8e, 0x6d, 0xed, 0xa5, 0x63, 0x5f, 0xf7, 0xd2, 0xb1,
+	0x87, 0x43, 0x75, 0x4f, 0x70, 0xad, 0xd6, 0x51, 0xac, 0x07, 0x98, 0x17, 0xe2, 0xf2, 0x5f, 0xfb,
+	0xb9, 0x5f, 0x03, 0x00, 0xfc, 0x41, 0x8f, 0xab, 0xd0, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -380,6 +571,11 @@ type QueryClient interface {
 	SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(ctx context.Context, in *QuerySigningInfosRequest, opts ...grpc.CallOption) (*QuerySigningInfosResponse, error)
+	// SigningInfosExtended queries the signing info of all validators along with
+	// their uptime and missed block streaks.
+	//
+	// Since: cosmos-sdk 0.51
+	SigningInfosExtended(ctx context.Context, in *QuerySigningInfosExtendedRequest, opts ...grpc.CallOption) (*QuerySigningInfosExtendedResponse, error)
 }
 
 type queryClient struct {
@@ -417,6 +613,15 @@ func (c *queryClient) SigningInfos(ctx context.Context, in *QuerySigningInfosReq
 	return out, nil
 }
 
+func (c *queryClient) SigningInfosExtended(ctx context.Context, in *QuerySigningInfosExtendedRequest, opts ...grpc.CallOption) (*QuerySigningInfosExtendedResponse, error) {
+	out := new(QuerySigningInfosExtendedResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.slashing.v1beta1.Query/SigningInfosExtended", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of slashing module
@@ -425,6 +630,11 @@ type QueryServer interface {
 	SigningInfo(context.Context, *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error)
+	// SigningInfosExtended queries the signing info of all validators along with
+	// their uptime and missed block streaks.
+	//
+	// Since: cosmos-sdk 0.51
+	SigningInfosExtended(context.Context, *QuerySigningInfosExtendedRequest) (*QuerySigningInfosExtendedResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -440,6 +650,9 @@ func (*UnimplementedQueryServer) SigningInfo(ctx context.Context, req *QuerySign
 func (*UnimplementedQueryServer) SigningInfos(ctx context.Context, req *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SigningInfos not implemented")
 }
+func (*UnimplementedQueryServer) SigningInfosExtended(ctx context.Context, req *QuerySigningInfosExtendedRequest) (*QuerySigningInfosExtendedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SigningInfosExtended not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -499,6 +712,24 @@ func _Query_SigningInfos_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SigningInfosExtended_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySigningInfosExtendedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SigningInfosExtended(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.slashing.v1beta1.Query/SigningInfosExtended",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SigningInfosExtended(ctx, req.(*QuerySigningInfosExtendedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.slashing.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -515,6 +746,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SigningInfos",
 			Handler:    _Query_SigningInfos_Handler,
 		},
+		{
+			MethodName: "SigningInfosExtended",
+			Handler:    _Query_SigningInfosExtended_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/slashing/v1beta1/query.proto",
@@ -723,6 +958,143 @@ func (m *QuerySigningInfosResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *QuerySigningInfosExtendedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySigningInfosExtendedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningInfosExtendedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySigningInfosExtendedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySigningInfosExtendedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningInfosExtendedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Info) > 0 {
+		for iNdEx := len(m.Info) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Info[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorSigningInfoExtended) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorSigningInfoExtended) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorSigningInfoExtended) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LongestMissedBlocksStreak != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LongestMissedBlocksStreak))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MissedBlocksStreak != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MissedBlocksStreak))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Uptime.Size()
+		i -= size
+		if _, err := m.Uptime.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.SigningInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -810,18 +1182,69 @@ func (m *QuerySigningInfosResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QuerySigningInfosExtendedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
+
+func (m *QuerySigningInfosExtendedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Info) > 0 {
+		for _, e := range m.Info {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorSigningInfoExtended) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SigningInfo.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Uptime.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.MissedBlocksStreak != 0 {
+		n += 1 + sovQuery(uint64(m.MissedBlocksStreak))
+	}
+	if m.LongestMissedBlocksStreak != 0 {
+		n += 1 + sovQuery(uint64(m.LongestMissedBlocksStreak))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
@@ -1320,6 +1743,367 @@ func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QuerySigningInfosExtendedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningInfosExtendedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningInfosExtendedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningInfosExtendedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningInfosExtendedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningInfosExtendedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Info = append(m.Info, ValidatorSigningInfoExtended{})
+			if err := m.Info[len(m.Info)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorSigningInfoExtended) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSigningInfoExtended: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSigningInfoExtended: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SigningInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uptime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Uptime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksStreak", wireType)
+			}
+			m.MissedBlocksStreak = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedBlocksStreak |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LongestMissedBlocksStreak", wireType)
+			}
+			m.LongestMissedBlocksStreak = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LongestMissedBlocksStreak |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
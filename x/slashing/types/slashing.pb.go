@@ -128,6 +128,73 @@ func (m *ValidatorSigningInfo) GetMissedBlocksCounter() int64 {
 	return 0
 }
 
+// ValidatorMissedBlockStreak tracks the consecutive blocks missed by a
+// validator.
+//
+// Since: cosmos-sdk 0.51
+type ValidatorMissedBlockStreak struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Number of consecutive blocks missed by the validator up to the last block
+	// it was expected to sign.
+	Current int64 `protobuf:"varint,2,opt,name=current,proto3" json:"current,omitempty"`
+	// Longest streak of consecutive blocks missed by the validator.
+	Longest int64 `protobuf:"varint,3,opt,name=longest,proto3" json:"longest,omitempty"`
+}
+
+func (m *ValidatorMissedBlockStreak) Reset()         { *m = ValidatorMissedBlockStreak{} }
+func (m *ValidatorMissedBlockStreak) String() string { return proto.CompactTextString(m) }
+func (*ValidatorMissedBlockStreak) ProtoMessage()    {}
+func (*ValidatorMissedBlockStreak) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{1}
+}
+func (m *ValidatorMissedBlockStreak) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorMissedBlockStreak) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorMissedBlockStreak.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorMissedBlockStreak) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorMissedBlockStreak.Merge(m, src)
+}
+func (m *ValidatorMissedBlockStreak) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorMissedBlockStreak) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorMissedBlockStreak.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorMissedBlockStreak proto.InternalMessageInfo
+
+func (m *ValidatorMissedBlockStreak) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ValidatorMissedBlockStreak) GetCurrent() int64 {
+	if m != nil {
+		return m.Current
+	}
+	return 0
+}
+
+func (m *ValidatorMissedBlockStreak) GetLongest() int64 {
+	if m != nil {
+		return m.Longest
+	}
+	return 0
+}
+
 // Params represents the parameters used for by the slashing module.
 type Params struct {
 	SignedBlocksWindow      int64                       `protobuf:"varint,1,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty"`
@@ -135,13 +202,27 @@ type Params struct {
 	DowntimeJailDuration    time.Duration               `protobuf:"bytes,3,opt,name=downtime_jail_duration,json=downtimeJailDuration,proto3,stdduration" json:"downtime_jail_duration"`
 	SlashFractionDoubleSign cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=slash_fraction_double_sign,json=slashFractionDoubleSign,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction_double_sign"`
 	SlashFractionDowntime   cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=slash_fraction_downtime,json=slashFractionDowntime,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction_downtime"`
+	// downtime_slashing_ladder defines the slash fraction and jail duration of
+	// repeated downtime offenses: the first tier applies to a first offense, the
+	// second tier to a second offense, and so on, the last tier applying to all
+	// the subsequent offenses. When empty, slash_fraction_downtime and
+	// downtime_jail_duration apply to every offense.
+	//
+	// Since: cosmos-sdk 0.51
+	DowntimeSlashingLadder []DowntimeSlashingTier `protobuf:"bytes,6,rep,name=downtime_slashing_ladder,json=downtimeSlashingLadder,proto3" json:"downtime_slashing_ladder"`
+	// downtime_offense_window is the number of blocks after a downtime offense
+	// within which a new offense of the validator is a repeated offense. Zero
+	// means that downtime offenses never expire.
+	//
+	// Since: cosmos-sdk 0.51
+	DowntimeOffenseWindow int64 `protobuf:"varint,7,opt,name=downtime_offense_window,json=downtimeOffenseWindow,proto3" json:"downtime_offense_window,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1078e5d96a74cc52, []int{1}
+	return fileDescriptor_1078e5d96a74cc52, []int{2}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -184,9 +265,142 @@ func (m *Params) GetDowntimeJailDuration() time.Duration {
 	return 0
 }
 
+func (m *Params) GetDowntimeSlashingLadder() []DowntimeSlashingTier {
+	if m != nil {
+		return m.DowntimeSlashingLadder
+	}
+	return nil
+}
+
+func (m *Params) GetDowntimeOffenseWindow() int64 {
+	if m != nil {
+		return m.DowntimeOffenseWindow
+	}
+	return 0
+}
+
+// DowntimeSlashingTier defines the slash fraction and jail duration of a
+// downtime offense in the downtime slashing ladder.
+//
+// Since: cosmos-sdk 0.51
+type DowntimeSlashingTier struct {
+	SlashFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=slash_fraction,json=slashFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction"`
+	JailDuration  time.Duration               `protobuf:"bytes,2,opt,name=jail_duration,json=jailDuration,proto3,stdduration" json:"jail_duration"`
+}
+
+func (m *DowntimeSlashingTier) Reset()         { *m = DowntimeSlashingTier{} }
+func (m *DowntimeSlashingTier) String() string { return proto.CompactTextString(m) }
+func (*DowntimeSlashingTier) ProtoMessage()    {}
+func (*DowntimeSlashingTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{3}
+}
+func (m *DowntimeSlashingTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DowntimeSlashingTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DowntimeSlashingTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DowntimeSlashingTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DowntimeSlashingTier.Merge(m, src)
+}
+func (m *DowntimeSlashingTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *DowntimeSlashingTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_DowntimeSlashingTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DowntimeSlashingTier proto.InternalMessageInfo
+
+func (m *DowntimeSlashingTier) GetJailDuration() time.Duration {
+	if m != nil {
+		return m.JailDuration
+	}
+	return 0
+}
+
+// ValidatorDowntimeOffenses tracks the repeated downtime offenses of a
+// validator.
+//
+// Since: cosmos-sdk 0.51
+type ValidatorDowntimeOffenses struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Number of downtime offenses of the validator, each one committed within
+	// downtime_offense_window blocks of the previous one.
+	Count int64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// Height of the last downtime offense of the validator.
+	LastOffenseHeight int64 `protobuf:"varint,3,opt,name=last_offense_height,json=lastOffenseHeight,proto3" json:"last_offense_height,omitempty"`
+}
+
+func (m *ValidatorDowntimeOffenses) Reset()         { *m = ValidatorDowntimeOffenses{} }
+func (m *ValidatorDowntimeOffenses) String() string { return proto.CompactTextString(m) }
+func (*ValidatorDowntimeOffenses) ProtoMessage()    {}
+func (*ValidatorDowntimeOffenses) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{4}
+}
+func (m *ValidatorDowntimeOffenses) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorDowntimeOffenses) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorDowntimeOffenses.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorDowntimeOffenses) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorDowntimeOffenses.Merge(m, src)
+}
+func (m *ValidatorDowntimeOffenses) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorDowntimeOffenses) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorDowntimeOffenses.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorDowntimeOffenses proto.InternalMessageInfo
+
+func (m *ValidatorDowntimeOffenses) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ValidatorDowntimeOffenses) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ValidatorDowntimeOffenses) GetLastOffenseHeight() int64 {
+	if m != nil {
+		return m.LastOffenseHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ValidatorSigningInfo)(nil), "cosmos.slashing.v1beta1.ValidatorSigningInfo")
+	proto.RegisterType((*ValidatorMissedBlockStreak)(nil), "cosmos.slashing.v1beta1.ValidatorMissedBlockStreak")
 	proto.RegisterType((*Params)(nil), "cosmos.slashing.v1beta1.Params")
+	proto.RegisterType((*DowntimeSlashingTier)(nil), "cosmos.slashing.v1beta1.DowntimeSlashingTier")
+	proto.RegisterType((*ValidatorDowntimeOffenses)(nil), "cosmos.slashing.v1beta1.ValidatorDowntimeOffenses")
 }
 
 func init() {
@@ -194,47 +408,59 @@ func init() {
 }
 
 var fileDescriptor_1078e5d96a74cc52 = []byte{
-	// 633 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4f, 0x4f, 0x13, 0x4f,
-	0x18, 0xee, 0x00, 0x3f, 0x7e, 0x3a, 0xad, 0x07, 0xc7, 0x22, 0x4b, 0x95, 0x6d, 0x21, 0xd1, 0x34,
-	0x24, 0xec, 0x0a, 0x26, 0x1e, 0xe0, 0x64, 0x69, 0x8c, 0x1a, 0x12, 0xc9, 0xe2, 0x9f, 0xc4, 0x83,
-	0x9b, 0xd9, 0x9d, 0xe9, 0x76, 0x64, 0x77, 0xa6, 0xd9, 0x99, 0x15, 0xf8, 0x0a, 0x26, 0x26, 0x1c,
-	0x3d, 0x7a, 0xe4, 0xc8, 0x81, 0xaf, 0x60, 0xc2, 0x91, 0x70, 0x32, 0x1e, 0xd0, 0x94, 0x03, 0x7e,
-	0x0c, 0xb3, 0x33, 0xbb, 0x80, 0x70, 0xe3, 0xd2, 0xb4, 0xcf, 0xf3, 0xbc, 0xcf, 0x3b, 0xef, 0xf3,
-	0xbe, 0x29, 0x7c, 0x18, 0x0a, 0x99, 0x08, 0xe9, 0xca, 0x18, 0xcb, 0x3e, 0xe3, 0x91, 0xfb, 0x69,
-	0x21, 0xa0, 0x0a, 0x2f, 0x9c, 0x01, 0xce, 0x20, 0x15, 0x4a, 0xa0, 0x49, 0xa3, 0x73, 0xce, 0xe0,
-	0x42, 0xd7, 0xa8, 0x47, 0x22, 0x12, 0x5a, 0xe3, 0xe6, 0xdf, 0x8c, 0xbc, 0x61, 0x47, 0x42, 0x44,
-	0x31, 0x75, 0xf5, 0xaf, 0x20, 0xeb, 0xb9, 0x24, 0x4b, 0xb1, 0x62, 0x82, 0x17, 0x7c, 0xf3, 0x32,
-	0xaf, 0x58, 0x42, 0xa5, 0xc2, 0xc9, 0xa0, 0x10, 0x4c, 0x99, 0x7e, 0xbe, 0x71, 0x2e, 0x9a, 0x1b,
-	0xea, 0x36, 0x4e, 0x18, 0x17, 0xae, 0xfe, 0x34, 0xd0, 0xec, 0xf7, 0x11, 0x58, 0x7f, 0x8b, 0x63,
-	0x46, 0xb0, 0x12, 0xe9, 0x3a, 0x8b, 0x38, 0xe3, 0xd1, 0x0b, 0xde, 0x13, 0x68, 0x19, 0xfe, 0x8f,
-	0x09, 0x49, 0xa9, 0x94, 0x16, 0x68, 0x81, 0xf6, 0xcd, 0xce, 0xcc, 0xd1, 0xfe, 0xfc, 0x74, 0x61,
-	0xb7, 0x22, 0xb8, 0xa4, 0x5c, 0x66, 0xf2, 0xa9, 0x91, 0xac, 0xab, 0x94, 0xf1, 0xc8, 0x2b, 0x2b,
-	0xd0, 0x0c, 0xac, 0x49, 0x85, 0x53, 0xe5, 0xf7, 0x29, 0x8b, 0xfa, 0xca, 0x1a, 0x69, 0x81, 0xf6,
-	0xa8, 0x57, 0xd5, 0xd8, 0x73, 0x0d, 0xa1, 0x07, 0xb0, 0xc6, 0x38, 0xa1, 0x5b, 0xbe, 0xe8, 0xf5,
-	0x24, 0x55, 0xd6, 0x68, 0x2e, 0xe9, 0x8c, 0x58, 0xc0, 0xab, 0x6a, 0xfc, 0x95, 0x86, 0xd1, 0x2a,
-	0xac, 0x7d, 0xc4, 0x2c, 0xa6, 0xc4, 0xcf, 0xb8, 0x62, 0xb1, 0x35, 0xd6, 0x02, 0xed, 0xea, 0x62,
-	0xc3, 0x31, 0x29, 0x38, 0x65, 0x0a, 0xce, 0xeb, 0x32, 0x85, 0xce, 0xad, 0x83, 0xe3, 0x66, 0x65,
-	0xe7, 0x57, 0x13, 0xec, 0x9e, 0xee, 0xcd, 0x01, 0xaf, 0x6a, 0xca, 0xdf, 0xe4, 0xd5, 0xc8, 0x86,
-	0x50, 0x89, 0x24, 0x90, 0x4a, 0x70, 0x4a, 0xac, 0xff, 0x5a, 0xa0, 0x7d, 0xc3, 0xbb, 0x80, 0xa0,
-	0x45, 0x38, 0x91, 0x30, 0x29, 0x29, 0xf1, 0x83, 0x58, 0x84, 0x1b, 0xd2, 0x0f, 0x45, 0xc6, 0x15,
-	0x4d, 0xad, 0x71, 0x3d, 0xc0, 0x1d, 0x43, 0x76, 0x34, 0xb7, 0x62, 0xa8, 0xa5, 0xb1, 0x3f, 0xdf,
-	0x9a, 0x60, 0xf6, 0xcb, 0x18, 0x1c, 0x5f, 0xc3, 0x29, 0x4e, 0x24, 0x7a, 0x04, 0xeb, 0x92, 0x45,
-	0xfc, 0xdc, 0x64, 0x93, 0x71, 0x22, 0x36, 0x75, 0x8c, 0xa3, 0x1e, 0x32, 0x9c, 0xf1, 0x78, 0xa7,
-	0x19, 0xc4, 0xf2, 0xb6, 0xdc, 0x2f, 0xaa, 0x06, 0x34, 0x2d, 0x4b, 0xf2, 0xdc, 0x6a, 0x9d, 0x27,
-	0xf9, 0x44, 0x3f, 0x8f, 0x9b, 0xf7, 0x4c, 0xfa, 0x92, 0x6c, 0x38, 0x4c, 0xb8, 0x09, 0x56, 0x7d,
-	0x67, 0x95, 0x46, 0x38, 0xdc, 0xee, 0xd2, 0xf0, 0x68, 0x7f, 0x1e, 0x16, 0xcb, 0xe9, 0xd2, 0xd0,
-	0x8c, 0x8e, 0x12, 0xc6, 0xd7, 0xb5, 0xe7, 0x1a, 0x4d, 0x8b, 0x56, 0x1f, 0xe0, 0x5d, 0x22, 0x36,
-	0x79, 0x7e, 0x34, 0x7e, 0x9e, 0x8c, 0x5f, 0x9e, 0x97, 0x5e, 0x40, 0x75, 0x71, 0xea, 0x4a, 0xb2,
-	0xdd, 0x42, 0x60, 0x82, 0xfd, 0x7a, 0x16, 0x6c, 0xbd, 0xf4, 0x79, 0x89, 0x59, 0x5c, 0x8a, 0x90,
-	0x84, 0x0d, 0x7d, 0xe8, 0x7e, 0x2f, 0xc5, 0x61, 0x8e, 0xf8, 0x44, 0x64, 0x41, 0x4c, 0xf5, 0x70,
-	0x7a, 0x7b, 0xd7, 0x9f, 0x67, 0x52, 0x3b, 0x3f, 0x2b, 0x8c, 0xbb, 0xda, 0x37, 0x9f, 0x0f, 0x71,
-	0x38, 0x79, 0xa5, 0xa9, 0x79, 0x9b, 0xde, 0xf1, 0xf5, 0x3b, 0x4e, 0x5c, 0xea, 0x68, 0x4c, 0x97,
-	0x66, 0x3e, 0x9f, 0xee, 0xcd, 0xdd, 0x37, 0xe2, 0x79, 0x49, 0x36, 0xdc, 0xad, 0xf3, 0x7f, 0x01,
-	0x73, 0x04, 0x9d, 0xe5, 0xdd, 0xa1, 0x0d, 0x0e, 0x86, 0x36, 0x38, 0x1c, 0xda, 0xe0, 0xf7, 0xd0,
-	0x06, 0x3b, 0x27, 0x76, 0xe5, 0xf0, 0xc4, 0xae, 0xfc, 0x38, 0xb1, 0x2b, 0xef, 0xa7, 0xff, 0x79,
-	0xc7, 0x85, 0x6a, 0xb5, 0x3d, 0xa0, 0x32, 0x18, 0xd7, 0xe1, 0x3f, 0xfe, 0x1b, 0x00, 0x00, 0xff,
-	0xff, 0x57, 0xe6, 0xe5, 0x80, 0x63, 0x04, 0x00, 0x00,
+	// 817 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xbf, 0x8f, 0x1b, 0x45,
+	0x14, 0xbe, 0xb9, 0x9f, 0x64, 0xec, 0x43, 0xca, 0xc4, 0x17, 0xef, 0x19, 0xb2, 0xf6, 0x59, 0x02,
+	0x59, 0x91, 0xbc, 0x4b, 0x8c, 0x94, 0x22, 0xa9, 0x70, 0x2c, 0x04, 0xe8, 0xa2, 0x44, 0x76, 0x00,
+	0x09, 0x09, 0x56, 0xe3, 0x9d, 0xf1, 0x7a, 0xf0, 0xee, 0x8c, 0x35, 0x33, 0xe6, 0x92, 0x7f, 0x81,
+	0x2a, 0x12, 0x0d, 0x15, 0xa2, 0x4c, 0x99, 0x22, 0x0d, 0x3d, 0x48, 0x29, 0xa3, 0x54, 0x88, 0x22,
+	0x20, 0x5f, 0x11, 0xfe, 0x0c, 0xb4, 0x33, 0xb3, 0x7b, 0xf1, 0x1d, 0x14, 0xc8, 0x8d, 0xe5, 0x79,
+	0xdf, 0xfb, 0xf9, 0xbd, 0xb7, 0x1f, 0x7c, 0x3f, 0x16, 0x2a, 0x13, 0x2a, 0x54, 0x29, 0x56, 0x53,
+	0xc6, 0x93, 0xf0, 0xbb, 0x1b, 0x63, 0xaa, 0xf1, 0x8d, 0xd2, 0x10, 0xcc, 0xa5, 0xd0, 0x02, 0xd5,
+	0xad, 0x5f, 0x50, 0x9a, 0x9d, 0x5f, 0xa3, 0x96, 0x88, 0x44, 0x18, 0x9f, 0x30, 0xff, 0x67, 0xdd,
+	0x1b, 0x7e, 0x22, 0x44, 0x92, 0xd2, 0xd0, 0xbc, 0xc6, 0x8b, 0x49, 0x48, 0x16, 0x12, 0x6b, 0x26,
+	0xb8, 0xc3, 0x9b, 0xe7, 0x71, 0xcd, 0x32, 0xaa, 0x34, 0xce, 0xe6, 0xce, 0xe1, 0xd0, 0xd6, 0x8b,
+	0x6c, 0x66, 0x57, 0xdc, 0x42, 0x97, 0x71, 0xc6, 0xb8, 0x08, 0xcd, 0xaf, 0x35, 0xb5, 0x7f, 0xdb,
+	0x84, 0xb5, 0x2f, 0x70, 0xca, 0x08, 0xd6, 0x42, 0x8e, 0x58, 0xc2, 0x19, 0x4f, 0x3e, 0xe5, 0x13,
+	0x81, 0x6e, 0xc3, 0x3d, 0x4c, 0x88, 0xa4, 0x4a, 0x79, 0xa0, 0x05, 0x3a, 0x97, 0xfa, 0x47, 0x2f,
+	0x9f, 0x75, 0xaf, 0xb9, 0x74, 0x77, 0x04, 0x57, 0x94, 0xab, 0x85, 0xfa, 0xc8, 0xba, 0x8c, 0xb4,
+	0x64, 0x3c, 0x19, 0x16, 0x11, 0xe8, 0x08, 0x56, 0x95, 0xc6, 0x52, 0x47, 0x53, 0xca, 0x92, 0xa9,
+	0xf6, 0x36, 0x5b, 0xa0, 0xb3, 0x35, 0xac, 0x18, 0xdb, 0x27, 0xc6, 0x84, 0xde, 0x83, 0x55, 0xc6,
+	0x09, 0x7d, 0x18, 0x89, 0xc9, 0x44, 0x51, 0xed, 0x6d, 0xe5, 0x2e, 0xfd, 0x4d, 0x0f, 0x0c, 0x2b,
+	0xc6, 0x7e, 0xcf, 0x98, 0xd1, 0x31, 0xac, 0x7e, 0x8b, 0x59, 0x4a, 0x49, 0xb4, 0xe0, 0x9a, 0xa5,
+	0xde, 0x76, 0x0b, 0x74, 0x2a, 0xbd, 0x46, 0x60, 0x59, 0x08, 0x0a, 0x16, 0x82, 0x07, 0x05, 0x0b,
+	0xfd, 0xfd, 0xe7, 0xaf, 0x9a, 0x1b, 0x8f, 0xff, 0x6c, 0x82, 0x27, 0xaf, 0x9f, 0x5e, 0x07, 0xc3,
+	0x8a, 0x0d, 0xff, 0x3c, 0x8f, 0x46, 0x3e, 0x84, 0x5a, 0x64, 0x63, 0xa5, 0x05, 0xa7, 0xc4, 0xdb,
+	0x69, 0x81, 0xce, 0x5b, 0xc3, 0x37, 0x2c, 0xa8, 0x07, 0x0f, 0x32, 0xa6, 0x14, 0x25, 0xd1, 0x38,
+	0x15, 0xf1, 0x4c, 0x45, 0xb1, 0x58, 0x70, 0x4d, 0xa5, 0xb7, 0x6b, 0x06, 0xb8, 0x62, 0xc1, 0xbe,
+	0xc1, 0xee, 0x58, 0xe8, 0xd6, 0xf6, 0xdf, 0x3f, 0x37, 0x41, 0xfb, 0x07, 0x00, 0x1b, 0x25, 0x8f,
+	0x77, 0xcf, 0xdc, 0x46, 0x5a, 0x52, 0x3c, 0x5b, 0x8f, 0x4d, 0x0f, 0xee, 0xc5, 0x0b, 0x29, 0x29,
+	0x2f, 0x88, 0x2c, 0x9e, 0x39, 0x92, 0x0a, 0x9e, 0x50, 0xe5, 0xf8, 0x1b, 0x16, 0x4f, 0xd7, 0xd5,
+	0x2f, 0x3b, 0x70, 0xf7, 0x3e, 0x96, 0x38, 0x53, 0xe8, 0x03, 0x58, 0x53, 0x2c, 0xe1, 0x67, 0xa3,
+	0x9d, 0x30, 0x4e, 0xc4, 0x89, 0x69, 0x67, 0x6b, 0x88, 0x2c, 0x66, 0x27, 0xfb, 0xd2, 0x20, 0x88,
+	0xe5, 0x64, 0xf0, 0xc8, 0x45, 0xcd, 0xa9, 0x2c, 0x42, 0xf2, 0x26, 0xaa, 0xfd, 0x9b, 0x39, 0xcf,
+	0x7f, 0xbc, 0x6a, 0xbe, 0x63, 0xa7, 0x50, 0x64, 0x16, 0x30, 0x11, 0x66, 0x58, 0x4f, 0x83, 0x63,
+	0x9a, 0xe0, 0xf8, 0xd1, 0x80, 0xc6, 0x2f, 0x9f, 0x75, 0xa1, 0x1b, 0x72, 0x40, 0x63, 0xbb, 0x10,
+	0x94, 0x31, 0x3e, 0x32, 0x39, 0xef, 0x53, 0xe9, 0x4a, 0x7d, 0x03, 0xaf, 0x12, 0x71, 0xc2, 0xf3,
+	0x53, 0x8e, 0xf2, 0x7d, 0x45, 0xc5, 0xd1, 0x9b, 0xb1, 0x2a, 0xbd, 0xc3, 0x0b, 0xfb, 0x1e, 0x38,
+	0x07, 0xbb, 0xee, 0x1f, 0xcb, 0x75, 0xd7, 0x8a, 0x3c, 0x9f, 0x61, 0x96, 0x16, 0x4e, 0x48, 0xc1,
+	0x86, 0xf9, 0xfc, 0xa2, 0x89, 0xc4, 0x71, 0x6e, 0x89, 0x88, 0x58, 0x8c, 0x53, 0x6a, 0x86, 0xf3,
+	0xb6, 0xd7, 0x9a, 0xa7, 0x6e, 0x32, 0x7f, 0xec, 0x12, 0x0f, 0x4c, 0xde, 0x7c, 0x3e, 0xc4, 0x61,
+	0xfd, 0x42, 0x51, 0xdb, 0x9b, 0xb7, 0xb3, 0x56, 0xc5, 0x83, 0x73, 0x15, 0x6d, 0x52, 0x24, 0xa1,
+	0x57, 0x92, 0x58, 0x88, 0x4d, 0x94, 0x62, 0x42, 0xcc, 0xfd, 0x6e, 0x75, 0x2a, 0xbd, 0x6e, 0xf0,
+	0x1f, 0x5a, 0x14, 0x14, 0x49, 0x46, 0x0e, 0x78, 0xc0, 0xa8, 0xec, 0x5f, 0xca, 0xfb, 0xb3, 0x25,
+	0xaf, 0x92, 0x73, 0x0e, 0xc7, 0x26, 0x2f, 0xba, 0x09, 0xeb, 0x65, 0x4d, 0x31, 0x99, 0x50, 0xae,
+	0x68, 0x71, 0x25, 0x7b, 0xe6, 0xb0, 0x0e, 0x0a, 0xf8, 0x9e, 0x45, 0xed, 0xc2, 0x6f, 0x1d, 0x7d,
+	0xff, 0xfa, 0xe9, 0xf5, 0x77, 0x6d, 0x37, 0x5d, 0x45, 0x66, 0xe1, 0xc3, 0x33, 0x1d, 0xb5, 0x07,
+	0xdb, 0xfe, 0x15, 0xc0, 0xda, 0xbf, 0xb5, 0x85, 0xbe, 0x86, 0x6f, 0xaf, 0xf2, 0xea, 0x81, 0xb5,
+	0xe8, 0xdc, 0x5f, 0xa1, 0x13, 0xdd, 0x85, 0xfb, 0xab, 0x27, 0xb8, 0xf9, 0x3f, 0x4f, 0xd0, 0x08,
+	0x56, 0x01, 0xb6, 0x7f, 0x02, 0xf0, 0xb0, 0x14, 0x86, 0xc1, 0x2a, 0x19, 0x6a, 0x3d, 0x5d, 0xa8,
+	0xc1, 0x1d, 0xa3, 0x4f, 0x4e, 0x15, 0xec, 0x03, 0x05, 0xf0, 0x4a, 0x8a, 0x95, 0x2e, 0xd7, 0xe1,
+	0x24, 0xd8, 0xea, 0xc3, 0xe5, 0x1c, 0x72, 0xd5, 0xad, 0x10, 0xf7, 0x6f, 0x3f, 0x59, 0xfa, 0xe0,
+	0xf9, 0xd2, 0x07, 0x2f, 0x96, 0x3e, 0xf8, 0x6b, 0xe9, 0x83, 0xc7, 0xa7, 0xfe, 0xc6, 0x8b, 0x53,
+	0x7f, 0xe3, 0xf7, 0x53, 0x7f, 0xe3, 0xab, 0x6b, 0x2b, 0x64, 0xbe, 0xb1, 0x25, 0xfd, 0x68, 0x4e,
+	0xd5, 0x78, 0xd7, 0xb0, 0xf1, 0xe1, 0x3f, 0x03, 0x00, 0x13, 0xb6, 0x84, 0x79, 0x0d, 0x07, 0x00,
+	0x00,
 }
 
 func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
@@ -276,6 +502,36 @@ func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ValidatorMissedBlockStreak) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidatorMissedBlockStreak)
+	if !ok {
+		that2, ok := that.(ValidatorMissedBlockStreak)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.Current != that1.Current {
+		return false
+	}
+	if this.Longest != that1.Longest {
+		return false
+	}
+	return true
+}
 func (this *Params) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -310,6 +566,74 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.SlashFractionDowntime.Equal(that1.SlashFractionDowntime) {
 		return false
 	}
+	if len(this.DowntimeSlashingLadder) != len(that1.DowntimeSlashingLadder) {
+		return false
+	}
+	for i := range this.DowntimeSlashingLadder {
+		if !this.DowntimeSlashingLadder[i].Equal(&that1.DowntimeSlashingLadder[i]) {
+			return false
+		}
+	}
+	if this.DowntimeOffenseWindow != that1.DowntimeOffenseWindow {
+		return false
+	}
+	return true
+}
+func (this *DowntimeSlashingTier) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DowntimeSlashingTier)
+	if !ok {
+		that2, ok := that.(DowntimeSlashingTier)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.SlashFraction.Equal(that1.SlashFraction) {
+		return false
+	}
+	if this.JailDuration != that1.JailDuration {
+		return false
+	}
+	return true
+}
+func (this *ValidatorDowntimeOffenses) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidatorDowntimeOffenses)
+	if !ok {
+		that2, ok := that.(ValidatorDowntimeOffenses)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.Count != that1.Count {
+		return false
+	}
+	if this.LastOffenseHeight != that1.LastOffenseHeight {
+		return false
+	}
 	return true
 }
 func (m *ValidatorSigningInfo) Marshal() (dAtA []byte, err error) {
@@ -375,6 +699,46 @@ func (m *ValidatorSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ValidatorMissedBlockStreak) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorMissedBlockStreak) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorMissedBlockStreak) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Longest != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Longest))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Current != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Current))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -395,6 +759,25 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DowntimeOffenseWindow != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.DowntimeOffenseWindow))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.DowntimeSlashingLadder) > 0 {
+		for iNdEx := len(m.DowntimeSlashingLadder) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DowntimeSlashingLadder[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSlashing(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	{
 		size := m.SlashFractionDowntime.Size()
 		i -= size
@@ -441,26 +824,107 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintSlashing(dAtA []byte, offset int, v uint64) int {
-	offset -= sovSlashing(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *DowntimeSlashingTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ValidatorSigningInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovSlashing(uint64(l))
+
+func (m *DowntimeSlashingTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DowntimeSlashingTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.JailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.JailDuration):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintSlashing(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.SlashFraction.Size()
+		i -= size
+		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorDowntimeOffenses) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorDowntimeOffenses) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorDowntimeOffenses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastOffenseHeight != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.LastOffenseHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Count != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSlashing(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSlashing(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ValidatorSigningInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
 	}
 	if m.StartHeight != 0 {
 		n += 1 + sovSlashing(uint64(m.StartHeight))
@@ -479,6 +943,25 @@ func (m *ValidatorSigningInfo) Size() (n int) {
 	return n
 }
 
+func (m *ValidatorMissedBlockStreak) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if m.Current != 0 {
+		n += 1 + sovSlashing(uint64(m.Current))
+	}
+	if m.Longest != 0 {
+		n += 1 + sovSlashing(uint64(m.Longest))
+	}
+	return n
+}
+
 func (m *Params) Size() (n int) {
 	if m == nil {
 		return 0
@@ -496,6 +979,47 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovSlashing(uint64(l))
 	l = m.SlashFractionDowntime.Size()
 	n += 1 + l + sovSlashing(uint64(l))
+	if len(m.DowntimeSlashingLadder) > 0 {
+		for _, e := range m.DowntimeSlashingLadder {
+			l = e.Size()
+			n += 1 + l + sovSlashing(uint64(l))
+		}
+	}
+	if m.DowntimeOffenseWindow != 0 {
+		n += 1 + sovSlashing(uint64(m.DowntimeOffenseWindow))
+	}
+	return n
+}
+
+func (m *DowntimeSlashingTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.JailDuration)
+	n += 1 + l + sovSlashing(uint64(l))
+	return n
+}
+
+func (m *ValidatorDowntimeOffenses) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovSlashing(uint64(m.Count))
+	}
+	if m.LastOffenseHeight != 0 {
+		n += 1 + sovSlashing(uint64(m.LastOffenseHeight))
+	}
 	return n
 }
 
@@ -505,7 +1029,319 @@ func sovSlashing(x uint64) (n int) {
 func sozSlashing(x uint64) (n int) {
 	return sovSlashing(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
+func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSigningInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSigningInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexOffset", wireType)
+			}
+			m.IndexOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexOffset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.JailedUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstoned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Tombstoned = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksCounter", wireType)
+			}
+			m.MissedBlocksCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedBlocksCounter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorMissedBlockStreak) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorMissedBlockStreak: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorMissedBlockStreak: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Current", wireType)
+			}
+			m.Current = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Current |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Longest", wireType)
+			}
+			m.Longest = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Longest |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -528,17 +1364,36 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorSigningInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorSigningInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBlocksWindow", wireType)
+			}
+			m.SignedBlocksWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedBlocksWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSignedPerWindow", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -548,29 +1403,30 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthSlashing
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSlashing
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if err := m.MinSignedPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeJailDuration", wireType)
 			}
-			m.StartHeight = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -580,16 +1436,30 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartHeight |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexOffset", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
 			}
-			m.IndexOffset = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DowntimeJailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDoubleSign", wireType)
+			}
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -599,16 +1469,30 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.IndexOffset |= int64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionDoubleSign.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDowntime", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -618,30 +1502,30 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthSlashing
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSlashing
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.JailedUntil, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SlashFractionDowntime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tombstoned", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeSlashingLadder", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -651,17 +1535,31 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Tombstoned = bool(v != 0)
-		case 6:
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DowntimeSlashingLadder = append(m.DowntimeSlashingLadder, DowntimeSlashingTier{})
+			if err := m.DowntimeSlashingLadder[len(m.DowntimeSlashingLadder)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksCounter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeOffenseWindow", wireType)
 			}
-			m.MissedBlocksCounter = 0
+			m.DowntimeOffenseWindow = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -671,7 +1569,7 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MissedBlocksCounter |= int64(b&0x7F) << shift
+				m.DowntimeOffenseWindow |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -697,7 +1595,7 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *DowntimeSlashingTier) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -720,34 +1618,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: DowntimeSlashingTier: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DowntimeSlashingTier: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignedBlocksWindow", wireType)
-			}
-			m.SignedBlocksWindow = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSlashing
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SignedBlocksWindow |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSignedPerWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -774,13 +1653,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinSignedPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeJailDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JailDuration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -807,15 +1686,65 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DowntimeJailDuration, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.JailDuration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorDowntimeOffenses) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorDowntimeOffenses: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorDowntimeOffenses: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDoubleSign", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -825,30 +1754,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSlashing
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSlashing
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionDoubleSign.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDowntime", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var byteLen int
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -858,25 +1786,30 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Count |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthSlashing
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSlashing
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastOffenseHeight", wireType)
 			}
-			if err := m.SlashFractionDowntime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.LastOffenseHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastOffenseHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSlashing(dAtA[iNdEx:])
@@ -137,6 +137,69 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:     "Query the current staking parameters information",
 					Long:      "Query values set as staking parameters.",
 				},
+				{
+					RpcMethod: "TokenizeShareRecordById",
+					Use:       "tokenize-share-record [id]",
+					Short:     "Query a tokenize share record by its id",
+					Example:   fmt.Sprintf("$ %s query staking tokenize-share-record 1", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "id"},
+					},
+				},
+				{
+					RpcMethod: "TokenizeShareRecordByDenom",
+					Use:       "tokenize-share-record-by-denom [denom]",
+					Short:     "Query a tokenize share record by the denom of its share tokens",
+					Example:   fmt.Sprintf("$ %s query staking tokenize-share-record-by-denom cosmosvaloper.../1", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+					},
+				},
+				{
+					RpcMethod: "TokenizeShareRecordsOwned",
+					Use:       "tokenize-share-records-owned [owner]",
+					Short:     "Query the tokenize share records owned by an address",
+					Example:   fmt.Sprintf("$ %s query staking tokenize-share-records-owned cosmos1...", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "owner"},
+					},
+				},
+				{
+					RpcMethod: "AllTokenizeShareRecords",
+					Use:       "tokenize-share-records",
+					Short:     "Query all the tokenize share records",
+				},
+				{
+					RpcMethod: "TotalLiquidStaked",
+					Use:       "total-liquid-staked",
+					Short:     "Query the amount of tokenized bonded tokens, in total or for the validator given by --validator-addr",
+				},
+				{
+					RpcMethod: "RedelegationsGraph",
+					Use:       "redelegations-graph",
+					Short:     "Query all the active redelegations as edges from their source to their destination validator",
+				},
+				{
+					RpcMethod: "UnbondingDelegationsByCompletionTime",
+					Use:       "unbonding-queue",
+					Short:     "Query the unbonding delegation entries of the unbonding queue, ordered by completion time",
+					Example:   fmt.Sprintf("$ %s query staking unbonding-queue --start-time 2024-01-01T00:00:00Z --end-time 2024-01-22T00:00:00Z", version.AppName),
+				},
+				{
+					RpcMethod: "TotalUnbondingAmount",
+					Use:       "total-unbonding-amount",
+					Short:     "Query the total amount of tokens of the unbonding delegation entries completing until the given time",
+					Example:   fmt.Sprintf("$ %s query staking total-unbonding-amount --end-time 2024-01-22T00:00:00Z", version.AppName),
+				},
+				{
+					RpcMethod: "ScheduledCommissionChange",
+					Use:       "scheduled-commission-change [validator-addr]",
+					Short:     "Query the commission change scheduled by a validator",
+					Example:   fmt.Sprintf("$ %s query staking scheduled-commission-change cosmosvaloper...", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_addr"},
+					},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -166,6 +229,21 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Example:        fmt.Sprintf(`%s tx staking unbond cosmosvaloper... 100stake --from mykey`, version.AppName),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}, {ProtoField: "amount"}},
 				},
+				{
+					RpcMethod:      "UndelegateShares",
+					Use:            "unbond-shares [validator-addr] [shares] --from [delegator_address]",
+					Short:          "Unbond an amount of shares from a validator",
+					Long:           "Unbond an amount of delegation shares, rather than of tokens, from a validator. The whole delegation is unbonded if the remaining shares are worth less than one token.",
+					Example:        fmt.Sprintf(`%s tx staking unbond-shares cosmosvaloper... 100.5 --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}, {ProtoField: "shares"}},
+				},
+				{
+					RpcMethod:      "UndelegateAll",
+					Use:            "unbond-all [validator-addr] --from [delegator_address]",
+					Short:          "Unbond all the shares of a delegation from a validator",
+					Example:        fmt.Sprintf(`%s tx staking unbond-all cosmosvaloper... --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}},
+				},
 				{
 					RpcMethod:      "CancelUnbondingDelegation",
 					Use:            "cancel-unbond [validator-addr] [amount] [creation-height]",
@@ -173,6 +251,44 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Example:        fmt.Sprintf(`%s tx staking cancel-unbond cosmosvaloper... 100stake 2 --from mykey`, version.AppName),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}, {ProtoField: "amount"}, {ProtoField: "creation_height"}},
 				},
+				{
+					RpcMethod:      "DecreaseMinSelfDelegation",
+					Use:            "decrease-min-self-delegation [validator-addr] [min-self-delegation]",
+					Short:          "Lower the minimum self delegation of a validator",
+					Example:        fmt.Sprintf(`%s tx staking decrease-min-self-delegation cosmosvaloper... 100 --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}, {ProtoField: "min_self_delegation"}},
+				},
+				{
+					RpcMethod:      "ScheduleCommissionChange",
+					Use:            "schedule-commission-change [validator-addr] [commission-rate]",
+					Short:          "Schedule a change of the commission rate of a validator",
+					Long:           "Announce a change of the commission rate of a validator, which takes effect once the commission change notice period has elapsed. It replaces any change already scheduled by the validator.",
+					Example:        fmt.Sprintf(`%s tx staking schedule-commission-change cosmosvaloper... 0.1 --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}, {ProtoField: "commission_rate"}},
+				},
+				{
+					RpcMethod:      "TokenizeShares",
+					Use:            "tokenize-share [validator-addr] [amount] [rewards-owner] --from [delegator_address]",
+					Short:          "Tokenize a delegation into share tokens",
+					Long:           "Convert an amount of a delegation into transferable share tokens. The rewards owner is entitled to the rewards of the tokenized delegation.",
+					Example:        fmt.Sprintf(`%s tx staking tokenize-share cosmosvaloper... 100stake cosmos1... --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}, {ProtoField: "amount"}, {ProtoField: "tokenized_share_owner"}},
+				},
+				{
+					RpcMethod:      "RedeemTokensForShares",
+					Use:            "redeem-tokens [amount] --from [delegator_address]",
+					Short:          "Redeem share tokens for a delegation",
+					Example:        fmt.Sprintf(`%s tx staking redeem-tokens 100cosmosvaloper.../1 --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "TransferTokenizeShareRecord",
+					Use:            "transfer-tokenize-share-record [record-id] [new-owner] --from [owner]",
+					Short:          "Transfer the ownership of a tokenize share record",
+					Long:           "Transfer the ownership of a tokenize share record, and of the rewards of its delegation, to a new owner.",
+					Example:        fmt.Sprintf(`%s tx staking transfer-tokenize-share-record 1 cosmos1... --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "tokenize_share_record_id"}, {ProtoField: "new_owner"}},
+				},
 				{
 					RpcMethod:      "RotateConsPubKey",
 					Use:            "rotate-cons-pubkey [validator-address] [new-pubkey]",
@@ -6,6 +6,7 @@ import (
 	cmttypes "github.com/cometbft/cometbft/types"
 	gogotypes "github.com/cosmos/gogoproto/types"
 
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/staking/keeper"
 	"cosmossdk.io/x/staking/types"
 
@@ -55,6 +56,14 @@ func ValidateGenesis(data *types.GenesisState) error {
 		return err
 	}
 
+	if err := validateGenesisStateTokenizeShareRecords(data.TokenizeShareRecords, data.LastTokenizeShareRecordId); err != nil {
+		return err
+	}
+
+	if err := validateGenesisStateScheduledCommissionChanges(data.ScheduledCommissionChanges); err != nil {
+		return err
+	}
+
 	return data.Params.Validate()
 }
 
@@ -95,3 +104,43 @@ func validateGenesisStateValidators(validators []types.Validator) error {
 
 	return nil
 }
+
+func validateGenesisStateTokenizeShareRecords(records []types.TokenizeShareRecord, lastID uint64) error {
+	ids := make(map[uint64]bool, len(records))
+
+	for _, record := range records {
+		if record.Id == 0 || record.Id > lastID {
+			return fmt.Errorf("tokenize share record id %d must be between 1 and the last record id %d", record.Id, lastID)
+		}
+
+		if ids[record.Id] {
+			return fmt.Errorf("duplicate tokenize share record id %d", record.Id)
+		}
+
+		if record.ModuleAccount != types.TokenizeShareModuleAccountName(record.Id) {
+			return fmt.Errorf("invalid module account %s of tokenize share record %d", record.ModuleAccount, record.Id)
+		}
+
+		ids[record.Id] = true
+	}
+
+	return nil
+}
+
+func validateGenesisStateScheduledCommissionChanges(changes []types.ScheduledCommissionChange) error {
+	validators := make(map[string]bool, len(changes))
+
+	for _, change := range changes {
+		if validators[change.ValidatorAddress] {
+			return fmt.Errorf("duplicate scheduled commission change of validator %s", change.ValidatorAddress)
+		}
+
+		if change.CommissionRate.IsNil() || change.CommissionRate.IsNegative() || change.CommissionRate.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("invalid scheduled commission rate of validator %s", change.ValidatorAddress)
+		}
+
+		validators[change.ValidatorAddress] = true
+	}
+
+	return nil
+}
@@ -41,6 +41,39 @@ func TestValidateGenesis(t *testing.T) {
 			data.Validators[0].Jailed = true
 			data.Validators[0].Status = types.Bonded
 		}, true},
+		// validate genesis tokenize share records
+		{"valid tokenize share record", func(data *types.GenesisState) {
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{{Id: 1, ModuleAccount: types.TokenizeShareModuleAccountName(1)}}
+			data.LastTokenizeShareRecordId = 1
+		}, false},
+		{"tokenize share record id above the last id", func(data *types.GenesisState) {
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{{Id: 2, ModuleAccount: types.TokenizeShareModuleAccountName(2)}}
+			data.LastTokenizeShareRecordId = 1
+		}, true},
+		{"duplicate tokenize share record", func(data *types.GenesisState) {
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{
+				{Id: 1, ModuleAccount: types.TokenizeShareModuleAccountName(1)},
+				{Id: 1, ModuleAccount: types.TokenizeShareModuleAccountName(1)},
+			}
+			data.LastTokenizeShareRecordId = 1
+		}, true},
+		{"tokenize share record with invalid module account", func(data *types.GenesisState) {
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{{Id: 1, ModuleAccount: types.TokenizeShareModuleAccountName(2)}}
+			data.LastTokenizeShareRecordId = 1
+		}, true},
+		// validate genesis scheduled commission changes
+		{"valid scheduled commission change", func(data *types.GenesisState) {
+			data.ScheduledCommissionChanges = []types.ScheduledCommissionChange{{ValidatorAddress: "val1", CommissionRate: math.LegacyNewDecWithPrec(1, 1)}}
+		}, false},
+		{"duplicate scheduled commission change", func(data *types.GenesisState) {
+			data.ScheduledCommissionChanges = []types.ScheduledCommissionChange{
+				{ValidatorAddress: "val1", CommissionRate: math.LegacyNewDecWithPrec(1, 1)},
+				{ValidatorAddress: "val1", CommissionRate: math.LegacyNewDecWithPrec(2, 1)},
+			}
+		}, true},
+		{"scheduled commission rate above one", func(data *types.GenesisState) {
+			data.ScheduledCommissionChanges = []types.ScheduledCommissionChange{{ValidatorAddress: "val1", CommissionRate: math.LegacyNewDec(2)}}
+		}, true},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,164 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetScheduledCommissionChange returns the commission change scheduled by the
+// validator.
+func (k Keeper) GetScheduledCommissionChange(ctx context.Context, valAddr sdk.ValAddress) (types.ScheduledCommissionChange, error) {
+	change, err := k.ScheduledCommissionChanges.Get(ctx, valAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return change, types.ErrNoScheduledCommissionChange
+	}
+	return change, err
+}
+
+// ScheduleCommissionChange schedules a change of the validator's commission
+// rate to newRate, which takes effect once the commission change notice period
+// has elapsed. Any change already scheduled by the validator is replaced. The
+// new rate is validated against the validator's current commission, and is
+// validated again when the change is applied.
+func (k Keeper) ScheduleCommissionChange(ctx context.Context, validator types.Validator, newRate math.LegacyDec) (time.Time, error) {
+	valAddr, err := k.validatorAddressCodec.StringToBytes(validator.GetOperator())
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	blockTime := k.environment.HeaderService.GetHeaderInfo(ctx).Time
+	if err := validator.Commission.ValidateNewRate(newRate, blockTime); err != nil {
+		return time.Time{}, err
+	}
+
+	minCommissionRate, err := k.MinCommissionRate(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if newRate.LT(minCommissionRate) {
+		return time.Time{}, fmt.Errorf("cannot set validator commission to less than minimum rate of %s", minCommissionRate)
+	}
+
+	noticePeriod, err := k.CommissionChangeNoticePeriod(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	change := types.ScheduledCommissionChange{
+		ValidatorAddress: validator.GetOperator(),
+		CommissionRate:   newRate,
+		EffectiveTime:    blockTime.Add(noticePeriod),
+	}
+
+	if err := k.SetScheduledCommissionChange(ctx, valAddr, change); err != nil {
+		return time.Time{}, err
+	}
+
+	return change.EffectiveTime, nil
+}
+
+// SetScheduledCommissionChange sets the commission change scheduled by the
+// validator, replacing any change it already scheduled, and queues it by
+// effective time.
+func (k Keeper) SetScheduledCommissionChange(ctx context.Context, valAddr sdk.ValAddress, change types.ScheduledCommissionChange) error {
+	if err := k.RemoveScheduledCommissionChange(ctx, valAddr); err != nil {
+		return err
+	}
+
+	if err := k.ScheduledCommissionChanges.Set(ctx, valAddr, change); err != nil {
+		return err
+	}
+
+	return k.ScheduledCommissionChangesQueue.Set(ctx, collections.Join(change.EffectiveTime, valAddr))
+}
+
+// RemoveScheduledCommissionChange removes the commission change scheduled by
+// the validator, if any, and its queue entry.
+func (k Keeper) RemoveScheduledCommissionChange(ctx context.Context, valAddr sdk.ValAddress) error {
+	change, err := k.ScheduledCommissionChanges.Get(ctx, valAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if err := k.ScheduledCommissionChangesQueue.Remove(ctx, collections.Join(change.EffectiveTime, valAddr)); err != nil {
+		return err
+	}
+
+	return k.ScheduledCommissionChanges.Remove(ctx, valAddr)
+}
+
+// ApplyMatureCommissionChanges applies all the scheduled commission changes
+// whose effective time is not after the given block time, ranging over their
+// queue up to that time. A change which is no longer valid, e.g. because the
+// minimum commission rate has been raised in the meantime, is dropped.
+func (k Keeper) ApplyMatureCommissionChanges(ctx context.Context, blockTime time.Time) error {
+	var matured []sdk.ValAddress
+	rng := collections.NewPrefixUntilPairRange[time.Time, sdk.ValAddress](blockTime)
+	err := k.ScheduledCommissionChangesQueue.Walk(ctx, rng, func(key collections.Pair[time.Time, sdk.ValAddress]) (bool, error) {
+		matured = append(matured, key.K2())
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, valAddr := range matured {
+		change, err := k.ScheduledCommissionChanges.Get(ctx, valAddr)
+		if err != nil {
+			return err
+		}
+
+		if err := k.RemoveScheduledCommissionChange(ctx, valAddr); err != nil {
+			return err
+		}
+
+		validator, err := k.GetValidator(ctx, valAddr)
+		if err != nil {
+			k.Logger().Info("dropping commission change of missing validator", "validator", change.ValidatorAddress)
+			continue
+		}
+
+		commission, err := k.UpdateValidatorCommission(ctx, validator, change.CommissionRate)
+		if err != nil {
+			k.Logger().Info(
+				"dropping invalid scheduled commission change",
+				"validator", change.ValidatorAddress,
+				"rate", change.CommissionRate.String(),
+				"err", err,
+			)
+			continue
+		}
+
+		// call the before-modification hook since we're about to update the commission
+		if err := k.Hooks().BeforeValidatorModified(ctx, valAddr); err != nil {
+			return err
+		}
+
+		validator.Commission = commission
+		if err := k.SetValidator(ctx, validator); err != nil {
+			return err
+		}
+
+		if err := k.environment.EventService.EventManager(ctx).EmitKV(
+			types.EventTypeCommissionChange,
+			event.NewAttribute(types.AttributeKeyValidator, change.ValidatorAddress),
+			event.NewAttribute(types.AttributeKeyCommissionRate, commission.String()),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	"cosmossdk.io/x/staking/types"
@@ -416,6 +417,54 @@ func (k Keeper) DequeueAllMatureUBDQueue(ctx context.Context, currTime time.Time
 	return matureUnbonds, nil
 }
 
+// GetUBDQueueEntries returns the unbonding delegation entries of the given
+// unbonding queue timeslice, i.e. the entries of its delegator validator pairs
+// completing at the given time. The pairs of the unbonding delegations which
+// have been completed or cancelled since they were queued are skipped.
+func (k Keeper) GetUBDQueueEntries(ctx context.Context, completionTime time.Time, dvPairs []types.DVPair) ([]types.UnbondingQueueEntry, error) {
+	var entries []types.UnbondingQueueEntry
+	seen := make(map[types.DVPair]bool, len(dvPairs))
+	for _, dvPair := range dvPairs {
+		// an unbonding delegation is queued once per entry
+		if seen[dvPair] {
+			continue
+		}
+		seen[dvPair] = true
+
+		delAddr, err := k.authKeeper.AddressCodec().StringToBytes(dvPair.DelegatorAddress)
+		if err != nil {
+			return nil, err
+		}
+		valAddr, err := k.validatorAddressCodec.StringToBytes(dvPair.ValidatorAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		ubd, err := k.GetUnbondingDelegation(ctx, delAddr, valAddr)
+		if err != nil {
+			if errors.Is(err, types.ErrNoUnbondingDelegation) {
+				continue
+			}
+			return nil, err
+		}
+
+		for _, entry := range ubd.Entries {
+			if !entry.CompletionTime.Equal(completionTime) {
+				continue
+			}
+			entries = append(entries, types.UnbondingQueueEntry{
+				DelegatorAddress: ubd.DelegatorAddress,
+				ValidatorAddress: ubd.ValidatorAddress,
+				CreationHeight:   entry.CreationHeight,
+				CompletionTime:   entry.CompletionTime,
+				Balance:          entry.Balance,
+			})
+		}
+	}
+
+	return entries, nil
+}
+
 // GetRedelegations returns a given amount of all the delegator redelegations.
 func (k Keeper) GetRedelegations(ctx context.Context, delegator sdk.AccAddress, maxRetrieve uint16) (redelegations []types.Redelegation, err error) {
 	redelegations = make([]types.Redelegation, maxRetrieve)
@@ -493,6 +542,40 @@ func (k Keeper) HasMaxRedelegationEntries(ctx context.Context, delegatorAddr sdk
 	return len(red.Entries) >= int(maxEntries), nil
 }
 
+// HasMaxValidatorRedelegationEntries checks if the redelegation entries to a
+// destination validator, across all of its delegators and source validators,
+// reached the MaxValidatorRedelegationEntries limit. A zero limit is never
+// reached.
+func (k Keeper) HasMaxValidatorRedelegationEntries(ctx context.Context, validatorDstAddr sdk.ValAddress) (bool, error) {
+	maxEntries, err := k.MaxValidatorRedelegationEntries(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if maxEntries == 0 {
+		return false, nil
+	}
+
+	entries := 0
+	rng := collections.NewPrefixedTripleRange[[]byte, []byte, []byte](validatorDstAddr)
+	err = k.RedelegationsByValDst.Walk(ctx, rng, func(key collections.Triple[[]byte, []byte, []byte], value []byte) (stop bool, err error) {
+		valDstAddr, delAddr, valSrcAddr := key.K1(), key.K2(), key.K3()
+
+		red, err := k.Redelegations.Get(ctx, collections.Join3(delAddr, valSrcAddr, valDstAddr))
+		if err != nil {
+			return true, err
+		}
+		entries += len(red.Entries)
+
+		return entries >= int(maxEntries), nil // stop as soon as the limit is reached
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return entries >= int(maxEntries), nil
+}
+
 // SetRedelegation sets a redelegation and associated index.
 func (k Keeper) SetRedelegation(ctx context.Context, red types.Redelegation) error {
 	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(red.DelegatorAddress)
@@ -829,9 +912,15 @@ func (k Keeper) Unbond(
 	isValidatorOperator := bytes.Equal(delegatorAddress, valbz)
 
 	// If the delegation is the operator of the validator and undelegating will decrease the validator's
-	// self-delegation below their minimum, we jail the validator.
+	// self-delegation below their minimum, we jail the validator. Depending on the params, the remaining
+	// self-delegation is also unbonded.
 	if isValidatorOperator && !validator.Jailed &&
 		validator.TokensFromShares(delegation.Shares).TruncateInt().LT(validator.MinSelfDelegation) {
+		action, err := k.MinSelfDelegationBreachAction(ctx)
+		if err != nil {
+			return amount, err
+		}
+
 		err = k.jailValidator(ctx, validator)
 		if err != nil {
 			return amount, fmt.Errorf("failed to jail validator: %v", err)
@@ -840,6 +929,23 @@ func (k Keeper) Unbond(
 		if err != nil {
 			return amount, fmt.Errorf("validator record not found for address: %X", valbz)
 		}
+
+		if action == types.BreachActionForceUnbond {
+			shares = shares.Add(delegation.Shares)
+			delegation.Shares = math.LegacyZeroDec()
+		}
+
+		if err := k.environment.EventService.EventManager(ctx).EmitKV(
+			types.EventTypeMinSelfDelegationBreach,
+			event.NewAttribute(types.AttributeKeyValidator, validator.GetOperator()),
+			event.NewAttribute(types.AttributeKeyBreachAction, action.String()),
+		); err != nil {
+			return amount, err
+		}
+
+		if err := k.Hooks().AfterSelfDelegationBelowMinimum(ctx, valbz, action); err != nil {
+			return amount, err
+		}
 	}
 
 	if delegation.Shares.IsZero() {
@@ -1073,6 +1179,15 @@ func (k Keeper) BeginRedelegation(
 		return time.Time{}, types.ErrMaxRedelegationEntries
 	}
 
+	hasMaxValRedels, err := k.HasMaxValidatorRedelegationEntries(ctx, valDstAddr)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if hasMaxValRedels {
+		return time.Time{}, types.ErrMaxValidatorRedelegationEntries
+	}
+
 	returnAmount, err := k.Unbond(ctx, delAddr, valSrcAddr, sharesAmount)
 	if err != nil {
 		return time.Time{}, err
@@ -1208,3 +1323,38 @@ func (k Keeper) ValidateUnbondAmount(
 
 	return shares, nil
 }
+
+// ValidateUnbondShares validates that a given amount of shares to unbond or
+// redelegate does not exceed the shares of the delegation. As in
+// ValidateUnbondAmount, if the shares remaining after unbonding are worth less
+// than one token, all the shares of the delegation are returned to avoid
+// leaving dust shares.
+func (k Keeper) ValidateUnbondShares(
+	ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares math.LegacyDec,
+) (math.LegacyDec, error) {
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return shares, err
+	}
+
+	del, err := k.Delegations.Get(ctx, collections.Join(delAddr, valAddr))
+	if err != nil {
+		return shares, err
+	}
+
+	delShares := del.GetShares()
+	if shares.GT(delShares) {
+		return shares, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "invalid shares amount")
+	}
+
+	tolerance, err := validator.SharesFromTokens(math.OneInt())
+	if err != nil {
+		return shares, err
+	}
+
+	if delShares.Sub(shares).LT(tolerance) {
+		shares = delShares
+	}
+
+	return shares, nil
+}
@@ -448,6 +448,71 @@ func (s *KeeperTestSuite) TestUndelegateSelfDelegationBelowMinSelfDelegation() {
 	require.True(validator.Jailed)
 }
 
+// test that the whole self delegation is unbonded when it falls below
+// MinSelfDelegation and the breach action is force unbond
+func (s *KeeperTestSuite) TestUndelegateSelfDelegationBelowMinSelfDelegationForceUnbond() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	params, err := keeper.Params.Get(ctx)
+	require.NoError(err)
+	params.MinSelfDelegationBreachAction = stakingtypes.BreachActionForceUnbond
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	addrDels, valAddrs := createValAddrs(1)
+	delTokens := keeper.TokensFromConsensusPower(ctx, 10)
+
+	// create a validator with a self-delegation
+	validator := testutil.NewValidator(s.T(), valAddrs[0], PKs[0])
+
+	validator.MinSelfDelegation = delTokens
+	validator, issuedShares := validator.AddTokensFromDel(delTokens)
+	require.Equal(delTokens, issuedShares.RoundInt())
+
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, gomock.Any())
+	validator = stakingkeeper.TestingUpdateValidator(keeper, ctx, validator, true)
+	require.NoError(keeper.SetValidatorByConsAddr(ctx, validator))
+	require.True(validator.IsBonded())
+
+	selfDelegation := stakingtypes.NewDelegation(s.addressToString(valAddrs[0]), s.valAddressToString(valAddrs[0]), issuedShares)
+	require.NoError(keeper.SetDelegation(ctx, selfDelegation))
+
+	// create a second delegation to this validator
+	require.NoError(keeper.DeleteValidatorByPowerIndex(ctx, validator))
+	validator, issuedShares = validator.AddTokensFromDel(delTokens)
+	require.True(validator.IsBonded())
+	require.Equal(delTokens, issuedShares.RoundInt())
+
+	validator = stakingkeeper.TestingUpdateValidator(keeper, ctx, validator, true)
+	delegation := stakingtypes.NewDelegation(s.addressToString(addrDels[0]), s.valAddressToString(valAddrs[0]), issuedShares)
+	require.NoError(keeper.SetDelegation(ctx, delegation))
+
+	val0AccAddr := sdk.AccAddress(valAddrs[0].Bytes())
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.BondedPoolName, stakingtypes.NotBondedPoolName, gomock.Any())
+	_, amount, err := keeper.Undelegate(ctx, val0AccAddr, valAddrs[0], math.LegacyNewDecFromInt(keeper.TokensFromConsensusPower(ctx, 6)))
+	require.NoError(err)
+	require.Equal(delTokens, amount)
+
+	// the self delegation is gone
+	_, err = keeper.Delegations.Get(ctx, collections.Join(val0AccAddr, valAddrs[0]))
+	require.ErrorIs(err, collections.ErrNotFound)
+
+	ubd, err := keeper.GetUnbondingDelegation(ctx, val0AccAddr, valAddrs[0])
+	require.NoError(err)
+	require.Len(ubd.Entries, 1)
+	require.Equal(delTokens, ubd.Entries[0].Balance)
+
+	// end block
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.BondedPoolName, stakingtypes.NotBondedPoolName, gomock.Any())
+	s.applyValidatorSetUpdates(ctx, keeper, 1)
+
+	validator, err = keeper.GetValidator(ctx, valAddrs[0])
+	require.NoError(err)
+	require.Equal(delTokens, validator.Tokens)
+	require.Equal(stakingtypes.Unbonding, validator.Status)
+	require.True(validator.Jailed)
+}
+
 func (s *KeeperTestSuite) TestUndelegateFromUnbondingValidator() {
 	ctx, keeper := s.ctx, s.stakingKeeper
 	require := s.Require()
@@ -858,6 +923,62 @@ func (s *KeeperTestSuite) TestRedelegationMaxEntries() {
 	require.NoError(err)
 }
 
+func (s *KeeperTestSuite) TestRedelegationMaxValidatorEntries() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	_, addrVals := createValAddrs(2)
+
+	// create a validator with a self-delegation
+	validator := testutil.NewValidator(s.T(), addrVals[0], PKs[0])
+	valTokens := keeper.TokensFromConsensusPower(ctx, 10)
+	validator, issuedShares := validator.AddTokensFromDel(valTokens)
+	require.Equal(valTokens, issuedShares.RoundInt())
+
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, gomock.Any())
+	_ = stakingkeeper.TestingUpdateValidator(keeper, ctx, validator, true)
+	val0AccAddr := sdk.AccAddress(addrVals[0].Bytes())
+	selfDelegation := stakingtypes.NewDelegation(s.addressToString(val0AccAddr), s.valAddressToString(addrVals[0]), issuedShares)
+	require.NoError(keeper.SetDelegation(ctx, selfDelegation))
+
+	// create a second validator
+	validator2 := testutil.NewValidator(s.T(), addrVals[1], PKs[1])
+	validator2, issuedShares = validator2.AddTokensFromDel(valTokens)
+	require.Equal(valTokens, issuedShares.RoundInt())
+
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, gomock.Any())
+	validator2 = stakingkeeper.TestingUpdateValidator(keeper, ctx, validator2, true)
+	require.Equal(stakingtypes.Bonded, validator2.Status)
+
+	// another delegator already redelegated to the second validator
+	otherDel := sdk.AccAddress(PKs[2].Address())
+	rd := stakingtypes.NewRedelegation(otherDel, addrVals[0], addrVals[1], 0,
+		time.Unix(0, 0).UTC(), math.NewInt(5),
+		math.LegacyNewDec(5), 0, address.NewBech32Codec("cosmosvaloper"), address.NewBech32Codec("cosmos"))
+	require.NoError(keeper.SetRedelegation(ctx, rd))
+
+	params, err := keeper.Params.Get(ctx)
+	require.NoError(err)
+	params.MaxValidatorRedelegationEntries = 3
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	// redelegations should pass until the second validator has 3 entries
+	for i := 0; i < 2; i++ {
+		_, err = keeper.BeginRedelegation(ctx, val0AccAddr, addrVals[0], addrVals[1], math.LegacyNewDec(1))
+		require.NoError(err)
+	}
+
+	// an additional redelegation should fail due to the validator max entries
+	_, err = keeper.BeginRedelegation(ctx, val0AccAddr, addrVals[0], addrVals[1], math.LegacyNewDec(1))
+	require.ErrorIs(err, stakingtypes.ErrMaxValidatorRedelegationEntries)
+
+	// redelegation should work again without the limit
+	params.MaxValidatorRedelegationEntries = 0
+	require.NoError(keeper.Params.Set(ctx, params))
+	_, err = keeper.BeginRedelegation(ctx, val0AccAddr, addrVals[0], addrVals[1], math.LegacyNewDec(1))
+	require.NoError(err)
+}
+
 func (s *KeeperTestSuite) TestRedelegateSelfDelegation() {
 	ctx, keeper := s.ctx, s.stakingKeeper
 	require := s.Require()
@@ -137,6 +137,51 @@ func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) ([]ap
 		}
 	}
 
+	for _, record := range data.TokenizeShareRecords {
+		if err := k.SetTokenizeShareRecord(ctx, record); err != nil {
+			return nil, err
+		}
+
+		// the liquid shares of the validators and delegators are derived from the delegations of the records
+		valAddr, err := k.validatorAddressCodec.StringToBytes(record.Validator)
+		if err != nil {
+			return nil, err
+		}
+
+		delAddr, err := k.authKeeper.AddressCodec().StringToBytes(record.Delegator)
+		if err != nil {
+			return nil, err
+		}
+
+		delegation, err := k.Delegations.Get(ctx, collections.Join(record.GetModuleAddress(), sdk.ValAddress(valAddr)))
+		if err != nil {
+			return nil, fmt.Errorf("delegation of tokenize share record %d not found: %w", record.Id, err)
+		}
+
+		if err := k.addValidatorLiquidShares(ctx, valAddr, delegation.Shares); err != nil {
+			return nil, err
+		}
+
+		if err := k.addDelegatorLiquidShares(ctx, delAddr, valAddr, delegation.Shares); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := k.LastTokenizeShareRecordID.Set(ctx, data.LastTokenizeShareRecordId); err != nil {
+		return nil, err
+	}
+
+	for _, change := range data.ScheduledCommissionChanges {
+		valAddr, err := k.validatorAddressCodec.StringToBytes(change.ValidatorAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := k.SetScheduledCommissionChange(ctx, valAddr, change); err != nil {
+			return nil, err
+		}
+	}
+
 	bondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, bondedTokens))
 	notBondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, notBondedTokens))
 
@@ -275,6 +320,25 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 
+	tokenizeShareRecords, err := k.GetAllTokenizeShareRecords(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	lastTokenizeShareRecordID, err := k.LastTokenizeShareRecordID.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var scheduledCommissionChanges []types.ScheduledCommissionChange
+	err = k.ScheduledCommissionChanges.Walk(ctx, nil, func(_ sdk.ValAddress, change types.ScheduledCommissionChange) (bool, error) {
+		scheduledCommissionChanges = append(scheduledCommissionChanges, change)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &types.GenesisState{
 		Params:               params,
 		LastTotalPower:       totalPower,
@@ -284,5 +348,10 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		UnbondingDelegations: unbondingDelegations,
 		Redelegations:        redelegations,
 		Exported:             true,
+
+		TokenizeShareRecords:      tokenizeShareRecords,
+		LastTokenizeShareRecordId: lastTokenizeShareRecordID,
+
+		ScheduledCommissionChanges: scheduledCommissionChanges,
 	}, nil
 }
@@ -4,11 +4,13 @@ import (
 	"context"
 	"errors"
 	"strings"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
 	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/x/staking/types"
@@ -498,6 +500,320 @@ func (k Querier) Params(ctx context.Context, _ *types.QueryParamsRequest) (*type
 	return &types.QueryParamsResponse{Params: params}, nil
 }
 
+// TokenizeShareRecordById queries a tokenize share record by its id
+func (k Querier) TokenizeShareRecordById(ctx context.Context, req *types.QueryTokenizeShareRecordByIdRequest) (*types.QueryTokenizeShareRecordByIdResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	record, err := k.GetTokenizeShareRecord(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, types.ErrTokenizeShareRecordNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTokenizeShareRecordByIdResponse{Record: record}, nil
+}
+
+// TokenizeShareRecordByDenom queries a tokenize share record by the denom of its share tokens
+func (k Querier) TokenizeShareRecordByDenom(ctx context.Context, req *types.QueryTokenizeShareRecordByDenomRequest) (*types.QueryTokenizeShareRecordByDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "denom cannot be empty")
+	}
+
+	record, err := k.GetTokenizeShareRecordByDenom(ctx, req.Denom)
+	if err != nil {
+		if errors.Is(err, types.ErrTokenizeShareRecordNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTokenizeShareRecordByDenomResponse{Record: record}, nil
+}
+
+// TokenizeShareRecordsOwned queries the tokenize share records owned by an address
+func (k Querier) TokenizeShareRecordsOwned(ctx context.Context, req *types.QueryTokenizeShareRecordsOwnedRequest) (*types.QueryTokenizeShareRecordsOwnedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Owner == "" {
+		return nil, status.Error(codes.InvalidArgument, "owner address cannot be empty")
+	}
+
+	owner, err := k.authKeeper.AddressCodec().StringToBytes(req.Owner)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	records, pageRes, err := query.CollectionPaginate(ctx, k.Keeper.TokenizeShareRecordsByOwner, req.Pagination,
+		func(key collections.Pair[sdk.AccAddress, uint64], _ collections.NoValue) (types.TokenizeShareRecord, error) {
+			return k.Keeper.TokenizeShareRecords.Get(ctx, key.K2())
+		}, query.WithCollectionPaginationPairPrefix[sdk.AccAddress, uint64](owner),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTokenizeShareRecordsOwnedResponse{Records: records, Pagination: pageRes}, nil
+}
+
+// AllTokenizeShareRecords queries all the tokenize share records
+func (k Querier) AllTokenizeShareRecords(ctx context.Context, req *types.QueryAllTokenizeShareRecordsRequest) (*types.QueryAllTokenizeShareRecordsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	records, pageRes, err := query.CollectionPaginate(ctx, k.Keeper.TokenizeShareRecords, req.Pagination,
+		func(_ uint64, record types.TokenizeShareRecord) (types.TokenizeShareRecord, error) {
+			return record, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllTokenizeShareRecordsResponse{Records: records, Pagination: pageRes}, nil
+}
+
+// TotalLiquidStaked queries the amount of tokenized bonded tokens, in total or for a validator
+func (k Querier) TotalLiquidStaked(ctx context.Context, req *types.QueryTotalLiquidStakedRequest) (*types.QueryTotalLiquidStakedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		tokens, err := k.GetTotalLiquidStakedTokens(ctx)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		return &types.QueryTotalLiquidStakedResponse{Tokens: tokens}, nil
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(req.ValidatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	tokens, err := k.GetValidatorLiquidStakedTokens(ctx, valAddr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTotalLiquidStakedResponse{Tokens: tokens}, nil
+}
+
+// ScheduledCommissionChange queries the commission change scheduled by a validator
+func (k Querier) ScheduledCommissionChange(ctx context.Context, req *types.QueryScheduledCommissionChangeRequest) (*types.QueryScheduledCommissionChangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	change, err := k.GetScheduledCommissionChange(ctx, valAddr)
+	if err != nil {
+		if errors.Is(err, types.ErrNoScheduledCommissionChange) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryScheduledCommissionChangeResponse{Change: change}, nil
+}
+
+// RedelegationsGraph queries all the active redelegations as edges from their source to their destination validator
+func (k Querier) RedelegationsGraph(ctx context.Context, req *types.QueryRedelegationsGraphRequest) (*types.QueryRedelegationsGraphResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	edges, pageRes, err := query.CollectionPaginate(ctx, k.Keeper.Redelegations, req.Pagination,
+		func(_ collections.Triple[[]byte, []byte, []byte], red types.Redelegation) (types.RedelegationEdge, error) {
+			edge := types.RedelegationEdge{
+				DelegatorAddress:    red.DelegatorAddress,
+				ValidatorSrcAddress: red.ValidatorSrcAddress,
+				ValidatorDstAddress: red.ValidatorDstAddress,
+				Entries:             uint32(len(red.Entries)),
+				Balance:             math.ZeroInt(),
+			}
+			for _, entry := range red.Entries {
+				edge.Balance = edge.Balance.Add(entry.InitialBalance)
+				if entry.CompletionTime.After(edge.CompletionTime) {
+					edge.CompletionTime = entry.CompletionTime
+				}
+			}
+			return edge, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRedelegationsGraphResponse{Edges: edges, Pagination: pageRes}, nil
+}
+
+// maxTotalUnbondingEntries bounds the number of unbonding queue entries
+// TotalUnbondingAmount iterates over.
+const maxTotalUnbondingEntries = 10_000
+
+// unbondingQueuePageKeyCodec encodes the pagination keys of the unbonding queue
+// entries, as their completion time and their index among the entries completing
+// at that time.
+var unbondingQueuePageKeyCodec = collections.PairKeyCodec(sdk.TimeKey, collections.Uint32Key)
+
+// UnbondingDelegationsByCompletionTime queries the unbonding delegation entries of the unbonding queue
+func (k Querier) UnbondingDelegationsByCompletionTime(ctx context.Context, req *types.QueryUnbondingDelegationsByCompletionTimeRequest) (*types.QueryUnbondingDelegationsByCompletionTimeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.StartTime != nil && req.EndTime != nil && req.StartTime.After(*req.EndTime) {
+		return nil, status.Error(codes.InvalidArgument, "start time cannot be after end time")
+	}
+
+	pageReq := req.Pagination
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if pageReq.Offset > 0 && pageReq.Key != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request, either offset or key is expected, got both")
+	}
+	if pageReq.Reverse {
+		return nil, status.Error(codes.InvalidArgument, "reverse pagination is not supported")
+	}
+
+	limit, countTotal := pageReq.Limit, pageReq.CountTotal
+	if limit == 0 {
+		limit = query.DefaultLimit
+		countTotal = true
+	}
+
+	rng := &collections.Range[time.Time]{}
+	if req.StartTime != nil {
+		rng.StartInclusive(*req.StartTime)
+	}
+	if req.EndTime != nil {
+		rng.EndInclusive(*req.EndTime)
+	}
+
+	var startKey *collections.Pair[time.Time, uint32]
+	if pageReq.Key != nil {
+		_, key, err := unbondingQueuePageKeyCodec.Decode(pageReq.Key)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid pagination key: %s", err)
+		}
+		startKey = &key
+		rng.StartInclusive(key.K1())
+		// the total is only counted when paginating by offset
+		countTotal = false
+	}
+
+	iter, err := k.Keeper.UnbondingQueue.Iterate(ctx, rng)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer iter.Close()
+
+	entries := []types.UnbondingQueueEntry{}
+	pageRes := &query.PageResponse{}
+	var count uint64
+
+iterate:
+	for ; iter.Valid(); iter.Next() {
+		kv, err := iter.KeyValue()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		slice, err := k.GetUBDQueueEntries(ctx, kv.Key, kv.Value.Pairs)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		for i, entry := range slice {
+			if startKey != nil && kv.Key.Equal(startKey.K1()) && uint32(i) < startKey.K2() {
+				continue
+			}
+
+			count++
+			switch {
+			case count <= pageReq.Offset:
+			case count <= pageReq.Offset+limit:
+				entries = append(entries, entry)
+			default:
+				if pageRes.NextKey == nil {
+					pageRes.NextKey, err = collections.EncodeKeyWithPrefix(nil, unbondingQueuePageKeyCodec, collections.Join(kv.Key, uint32(i)))
+					if err != nil {
+						return nil, status.Error(codes.Internal, err.Error())
+					}
+				}
+				if !countTotal {
+					break iterate
+				}
+			}
+		}
+	}
+
+	if countTotal {
+		pageRes.Total = count
+	}
+
+	return &types.QueryUnbondingDelegationsByCompletionTimeResponse{Entries: entries, Pagination: pageRes}, nil
+}
+
+// TotalUnbondingAmount queries the total amount of tokens of the unbonding delegation entries completing until the given time
+func (k Querier) TotalUnbondingAmount(ctx context.Context, req *types.QueryTotalUnbondingAmountRequest) (*types.QueryTotalUnbondingAmountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var rng collections.Ranger[time.Time]
+	if req.EndTime != nil {
+		rng = (&collections.Range[time.Time]{}).EndInclusive(*req.EndTime)
+	}
+
+	res := &types.QueryTotalUnbondingAmountResponse{Amount: math.ZeroInt()}
+	err := k.Keeper.UnbondingQueue.Walk(ctx, rng, func(completionTime time.Time, dvPairs types.DVPairs) (bool, error) {
+		entries, err := k.GetUBDQueueEntries(ctx, completionTime, dvPairs.Pairs)
+		if err != nil {
+			return true, err
+		}
+
+		res.Entries += uint64(len(entries))
+		if res.Entries > maxTotalUnbondingEntries {
+			return true, status.Errorf(codes.ResourceExhausted, "more than %d unbonding entries to sum, use an earlier end time", maxTotalUnbondingEntries)
+		}
+
+		for _, entry := range entries {
+			res.Amount = res.Amount.Add(entry.Balance)
+		}
+		return false, nil
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return res, nil
+}
+
 func queryRedelegation(ctx context.Context, k Querier, req *types.QueryRedelegationsRequest) (redels types.Redelegations, err error) {
 	delAddr, err := k.authKeeper.AddressCodec().StringToBytes(req.DelegatorAddr)
 	if err != nil {
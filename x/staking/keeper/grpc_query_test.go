@@ -3,11 +3,15 @@ package keeper_test
 import (
 	gocontext "context"
 	"fmt"
+	"time"
 
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/staking/testutil"
 	"cosmossdk.io/x/staking/types"
 
+	"github.com/cosmos/cosmos-sdk/codec/address"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 func (s *KeeperTestSuite) TestGRPCQueryValidator() {
@@ -61,3 +65,214 @@ func (s *KeeperTestSuite) TestGRPCQueryValidator() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestGRPCQueryTokenizeShareRecords() {
+	ctx, keeper, queryClient := s.ctx, s.stakingKeeper, s.queryClient
+	require := s.Require()
+
+	valAddr := sdk.ValAddress(PKs[0].Address().Bytes())
+	validator := testutil.NewValidator(s.T(), valAddr, PKs[0])
+	validator, _ = validator.AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, 10))
+	require.NoError(keeper.SetValidator(ctx, validator))
+
+	owner1 := sdk.AccAddress(PKs[1].Address())
+	owner2 := sdk.AccAddress(PKs[2].Address())
+	records := []types.TokenizeShareRecord{
+		{Id: 1, Owner: s.addressToString(owner1), ModuleAccount: types.TokenizeShareModuleAccountName(1), Validator: validator.OperatorAddress},
+		{Id: 2, Owner: s.addressToString(owner2), ModuleAccount: types.TokenizeShareModuleAccountName(2), Validator: validator.OperatorAddress},
+		{Id: 3, Owner: s.addressToString(owner1), ModuleAccount: types.TokenizeShareModuleAccountName(3), Validator: validator.OperatorAddress},
+	}
+	for _, record := range records {
+		require.NoError(keeper.SetTokenizeShareRecord(ctx, record))
+	}
+	require.NoError(keeper.ValidatorLiquidShares.Set(ctx, valAddr, validator.DelegatorShares.QuoInt64(4)))
+
+	// by id
+	_, err := queryClient.TokenizeShareRecordById(gocontext.Background(), &types.QueryTokenizeShareRecordByIdRequest{Id: 4})
+	require.ErrorContains(err, "tokenize share record not found")
+	byID, err := queryClient.TokenizeShareRecordById(gocontext.Background(), &types.QueryTokenizeShareRecordByIdRequest{Id: 2})
+	require.NoError(err)
+	require.Equal(records[1], byID.Record)
+
+	// by denom
+	_, err = queryClient.TokenizeShareRecordByDenom(gocontext.Background(), &types.QueryTokenizeShareRecordByDenomRequest{Denom: sdk.DefaultBondDenom})
+	require.ErrorContains(err, "tokenize share record not found")
+	byDenom, err := queryClient.TokenizeShareRecordByDenom(gocontext.Background(), &types.QueryTokenizeShareRecordByDenomRequest{Denom: records[2].GetShareTokenDenom()})
+	require.NoError(err)
+	require.Equal(records[2], byDenom.Record)
+
+	// by owner
+	_, err = queryClient.TokenizeShareRecordsOwned(gocontext.Background(), &types.QueryTokenizeShareRecordsOwnedRequest{})
+	require.ErrorContains(err, "owner address cannot be empty")
+	owned, err := queryClient.TokenizeShareRecordsOwned(gocontext.Background(), &types.QueryTokenizeShareRecordsOwnedRequest{Owner: s.addressToString(owner1)})
+	require.NoError(err)
+	require.Equal([]types.TokenizeShareRecord{records[0], records[2]}, owned.Records)
+
+	// all
+	all, err := queryClient.AllTokenizeShareRecords(gocontext.Background(), &types.QueryAllTokenizeShareRecordsRequest{})
+	require.NoError(err)
+	require.Equal(records, all.Records)
+
+	// total liquid staked
+	total, err := queryClient.TotalLiquidStaked(gocontext.Background(), &types.QueryTotalLiquidStakedRequest{})
+	require.NoError(err)
+	require.Equal(validator.Tokens.QuoRaw(4), total.Tokens)
+	byValidator, err := queryClient.TotalLiquidStaked(gocontext.Background(), &types.QueryTotalLiquidStakedRequest{ValidatorAddr: validator.OperatorAddress})
+	require.NoError(err)
+	require.Equal(validator.Tokens.QuoRaw(4), byValidator.Tokens)
+
+	// a fully redeemed record is removed from all the indexes
+	require.NoError(keeper.DeleteTokenizeShareRecord(ctx, records[0]))
+	owned, err = queryClient.TokenizeShareRecordsOwned(gocontext.Background(), &types.QueryTokenizeShareRecordsOwnedRequest{Owner: s.addressToString(owner1)})
+	require.NoError(err)
+	require.Equal([]types.TokenizeShareRecord{records[2]}, owned.Records)
+	_, err = queryClient.TokenizeShareRecordByDenom(gocontext.Background(), &types.QueryTokenizeShareRecordByDenomRequest{Denom: records[0].GetShareTokenDenom()})
+	require.ErrorContains(err, "tokenize share record not found")
+}
+
+func (s *KeeperTestSuite) TestGRPCQueryRedelegationsGraph() {
+	ctx, keeper, queryClient := s.ctx, s.stakingKeeper, s.queryClient
+	require := s.Require()
+
+	addrDels, addrVals := createValAddrs(3)
+	valCodec, delCodec := address.NewBech32Codec("cosmosvaloper"), address.NewBech32Codec("cosmos")
+
+	completionTime := time.Unix(100, 0).UTC()
+	rd1 := types.NewRedelegation(addrDels[0], addrVals[0], addrVals[1], 1,
+		completionTime, math.NewInt(5), math.LegacyNewDec(5), 0, valCodec, delCodec)
+	rd1.AddEntry(2, completionTime.Add(time.Hour), math.NewInt(7), math.LegacyNewDec(7), 0)
+	rd2 := types.NewRedelegation(addrDels[1], addrVals[1], addrVals[2], 1,
+		completionTime, math.NewInt(3), math.LegacyNewDec(3), 0, valCodec, delCodec)
+	require.NoError(keeper.SetRedelegation(ctx, rd1))
+	require.NoError(keeper.SetRedelegation(ctx, rd2))
+
+	expEdges := []types.RedelegationEdge{
+		{
+			DelegatorAddress:    rd1.DelegatorAddress,
+			ValidatorSrcAddress: rd1.ValidatorSrcAddress,
+			ValidatorDstAddress: rd1.ValidatorDstAddress,
+			Entries:             2,
+			Balance:             math.NewInt(12),
+			CompletionTime:      completionTime.Add(time.Hour),
+		},
+		{
+			DelegatorAddress:    rd2.DelegatorAddress,
+			ValidatorSrcAddress: rd2.ValidatorSrcAddress,
+			ValidatorDstAddress: rd2.ValidatorDstAddress,
+			Entries:             1,
+			Balance:             math.NewInt(3),
+			CompletionTime:      completionTime,
+		},
+	}
+
+	res, err := queryClient.RedelegationsGraph(gocontext.Background(), &types.QueryRedelegationsGraphRequest{})
+	require.NoError(err)
+	require.ElementsMatch(expEdges, res.Edges)
+
+	// paginated
+	res, err = queryClient.RedelegationsGraph(gocontext.Background(), &types.QueryRedelegationsGraphRequest{Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	require.NoError(err)
+	require.Len(res.Edges, 1)
+	require.Equal(uint64(2), res.Pagination.Total)
+}
+
+func (s *KeeperTestSuite) TestGRPCQueryUnbondingQueue() {
+	ctx, keeper, queryClient := s.ctx, s.stakingKeeper, s.queryClient
+	require := s.Require()
+	s.execExpectCalls()
+
+	addrDels, addrVals := createValAddrs(3)
+	valCodec, delCodec := address.NewBech32Codec("cosmosvaloper"), address.NewBech32Codec("cosmos")
+
+	t1 := time.Unix(100, 0).UTC()
+	t2 := t1.Add(time.Hour)
+	ubd1 := types.NewUnbondingDelegation(addrDels[0], addrVals[0], 1, t1, math.NewInt(5), 0, valCodec, delCodec)
+	ubd1.AddEntry(2, t2, math.NewInt(7), 0)
+	ubd2 := types.NewUnbondingDelegation(addrDels[1], addrVals[1], 1, t1, math.NewInt(3), 0, valCodec, delCodec)
+	require.NoError(keeper.SetUnbondingDelegation(ctx, ubd1))
+	require.NoError(keeper.SetUnbondingDelegation(ctx, ubd2))
+	require.NoError(keeper.InsertUBDQueue(ctx, ubd1, t1))
+	require.NoError(keeper.InsertUBDQueue(ctx, ubd1, t2))
+	require.NoError(keeper.InsertUBDQueue(ctx, ubd2, t1))
+
+	// the pair of a cancelled unbonding delegation is skipped
+	stale := types.NewUnbondingDelegation(addrDels[2], addrVals[2], 1, t1, math.NewInt(11), 0, valCodec, delCodec)
+	require.NoError(keeper.InsertUBDQueue(ctx, stale, t1))
+
+	entry := func(ubd types.UnbondingDelegation, i int) types.UnbondingQueueEntry {
+		return types.UnbondingQueueEntry{
+			DelegatorAddress: ubd.DelegatorAddress,
+			ValidatorAddress: ubd.ValidatorAddress,
+			CreationHeight:   ubd.Entries[i].CreationHeight,
+			CompletionTime:   ubd.Entries[i].CompletionTime,
+			Balance:          ubd.Entries[i].Balance,
+		}
+	}
+
+	res, err := queryClient.UnbondingDelegationsByCompletionTime(gocontext.Background(), &types.QueryUnbondingDelegationsByCompletionTimeRequest{})
+	require.NoError(err)
+	require.Equal([]types.UnbondingQueueEntry{entry(ubd1, 0), entry(ubd2, 0), entry(ubd1, 1)}, res.Entries)
+
+	res, err = queryClient.UnbondingDelegationsByCompletionTime(gocontext.Background(), &types.QueryUnbondingDelegationsByCompletionTimeRequest{StartTime: &t2})
+	require.NoError(err)
+	require.Equal([]types.UnbondingQueueEntry{entry(ubd1, 1)}, res.Entries)
+
+	// the pagination applies to the entries, not to the completion times
+	res, err = queryClient.UnbondingDelegationsByCompletionTime(gocontext.Background(), &types.QueryUnbondingDelegationsByCompletionTimeRequest{Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	require.NoError(err)
+	require.Equal([]types.UnbondingQueueEntry{entry(ubd1, 0)}, res.Entries)
+	require.Equal(uint64(3), res.Pagination.Total)
+	require.NotNil(res.Pagination.NextKey)
+
+	res, err = queryClient.UnbondingDelegationsByCompletionTime(gocontext.Background(), &types.QueryUnbondingDelegationsByCompletionTimeRequest{Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 1}})
+	require.NoError(err)
+	require.Equal([]types.UnbondingQueueEntry{entry(ubd2, 0)}, res.Entries)
+
+	res, err = queryClient.UnbondingDelegationsByCompletionTime(gocontext.Background(), &types.QueryUnbondingDelegationsByCompletionTimeRequest{Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 1}})
+	require.NoError(err)
+	require.Equal([]types.UnbondingQueueEntry{entry(ubd1, 1)}, res.Entries)
+	require.Nil(res.Pagination.NextKey)
+
+	res, err = queryClient.UnbondingDelegationsByCompletionTime(gocontext.Background(), &types.QueryUnbondingDelegationsByCompletionTimeRequest{EndTime: &t1, Pagination: &query.PageRequest{Offset: 1, CountTotal: true}})
+	require.NoError(err)
+	require.Equal([]types.UnbondingQueueEntry{entry(ubd2, 0)}, res.Entries)
+	require.Equal(uint64(2), res.Pagination.Total)
+
+	_, err = queryClient.UnbondingDelegationsByCompletionTime(gocontext.Background(), &types.QueryUnbondingDelegationsByCompletionTimeRequest{StartTime: &t2, EndTime: &t1})
+	require.ErrorContains(err, "start time cannot be after end time")
+
+	total, err := queryClient.TotalUnbondingAmount(gocontext.Background(), &types.QueryTotalUnbondingAmountRequest{})
+	require.NoError(err)
+	require.Equal(math.NewInt(15), total.Amount)
+	require.Equal(uint64(3), total.Entries)
+
+	total, err = queryClient.TotalUnbondingAmount(gocontext.Background(), &types.QueryTotalUnbondingAmountRequest{EndTime: &t1})
+	require.NoError(err)
+	require.Equal(math.NewInt(8), total.Amount)
+	require.Equal(uint64(2), total.Entries)
+}
+
+func (s *KeeperTestSuite) TestGRPCQueryScheduledCommissionChange() {
+	ctx, keeper, queryClient := s.ctx, s.stakingKeeper, s.queryClient
+	require := s.Require()
+
+	valAddr := sdk.ValAddress(PKs[0].Address().Bytes())
+	valStr := s.valAddressToString(valAddr)
+
+	_, err := queryClient.ScheduledCommissionChange(gocontext.Background(), &types.QueryScheduledCommissionChangeRequest{})
+	require.ErrorContains(err, "validator address cannot be empty")
+
+	_, err = queryClient.ScheduledCommissionChange(gocontext.Background(), &types.QueryScheduledCommissionChangeRequest{ValidatorAddr: valStr})
+	require.ErrorContains(err, types.ErrNoScheduledCommissionChange.Error())
+
+	change := types.ScheduledCommissionChange{
+		ValidatorAddress: valStr,
+		CommissionRate:   math.LegacyNewDecWithPrec(2, 1),
+		EffectiveTime:    time.Unix(1000, 0).UTC(),
+	}
+	require.NoError(keeper.ScheduledCommissionChanges.Set(ctx, valAddr, change))
+
+	res, err := queryClient.ScheduledCommissionChange(gocontext.Background(), &types.QueryScheduledCommissionChangeRequest{ValidatorAddr: valStr})
+	require.NoError(err)
+	require.Equal(change, res.Change)
+}
@@ -1,6 +1,17 @@
 package keeper_test
 
 import (
+	"errors"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/collections"
+	coreheader "cosmossdk.io/core/header"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/staking/testutil"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -13,3 +24,66 @@ func (s *KeeperTestSuite) TestHookAfterConsensusPubKeyUpdate() {
 	err := stKeeper.Hooks().AfterConsensusPubKeyUpdate(ctx, PKs[0], PKs[1], rotationFee)
 	require.NoError(err)
 }
+
+func (s *KeeperTestSuite) TestHookVetoesDelegation() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	hooks := testutil.NewMockStakingHooks(gomock.NewController(s.T()))
+	keeper.SetHooks(hooks)
+
+	valAddr := sdk.ValAddress(PKs[0].Address().Bytes())
+	validator := testutil.NewValidator(s.T(), valAddr, PKs[0])
+	require.NoError(keeper.SetValidator(ctx, validator))
+
+	// the delegation is vetoed before any token is moved
+	delAddr := sdk.AccAddress(PKs[1].Address())
+	vetoErr := errors.New("delegation vetoed")
+	hooks.EXPECT().BeforeDelegationCreated(gomock.Any(), delAddr, valAddr).Return(vetoErr)
+	_, err := keeper.Delegate(ctx, delAddr, math.NewInt(10), stakingtypes.Unbonded, validator, true)
+	require.ErrorIs(err, vetoErr)
+
+	_, err = keeper.Delegations.Get(ctx, collections.Join(delAddr, valAddr))
+	require.ErrorIs(err, collections.ErrNotFound)
+	validator, err = keeper.GetValidator(ctx, valAddr)
+	require.NoError(err)
+	require.True(validator.Tokens.IsZero())
+}
+
+func (s *KeeperTestSuite) TestHookVetoesSlash() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+	ctx = ctx.WithHeaderInfo(coreheader.Info{Height: 10, Time: time.Unix(100, 0).UTC()})
+
+	hooks := testutil.NewMockStakingHooks(gomock.NewController(s.T()))
+	keeper.SetHooks(hooks)
+
+	valAddr := sdk.ValAddress(PKs[0].Address().Bytes())
+	consAddr := sdk.ConsAddress(PKs[0].Address())
+	validator := testutil.NewValidator(s.T(), valAddr, PKs[0])
+	validator, _ = validator.AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, 10))
+	validator.Status = stakingtypes.Bonded
+	require.NoError(keeper.SetValidator(ctx, validator))
+	require.NoError(keeper.SetValidatorByConsAddr(ctx, validator))
+
+	// an unbonding delegation which started after the infraction
+	delAddr := sdk.AccAddress(PKs[1].Address())
+	ubd := stakingtypes.NewUnbondingDelegation(delAddr, valAddr, 5, time.Unix(200, 0).UTC(), math.NewInt(10), 0, keeper.ValidatorAddressCodec(), s.accountKeeper.AddressCodec())
+	require.NoError(keeper.SetUnbondingDelegation(ctx, ubd))
+
+	// the slash of the unbonding delegation is discarded with the vetoed slash
+	vetoErr := errors.New("slash vetoed")
+	s.accountKeeper.EXPECT().GetModuleAddress(stakingtypes.NotBondedPoolName).Return(sdk.AccAddress(stakingtypes.NotBondedPoolName)).AnyTimes()
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	hooks.EXPECT().BeforeValidatorModified(gomock.Any(), valAddr).Return(nil)
+	hooks.EXPECT().BeforeValidatorSlashed(gomock.Any(), valAddr, gomock.Any()).Return(vetoErr)
+	_, err := keeper.Slash(ctx, consAddr, 5, 10, math.LegacyNewDecWithPrec(5, 1))
+	require.ErrorIs(err, vetoErr)
+
+	resUbd, err := keeper.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	require.NoError(err)
+	require.Equal(ubd, resUbd)
+	resValidator, err := keeper.GetValidator(ctx, valAddr)
+	require.NoError(err)
+	require.Equal(validator.Tokens, resValidator.Tokens)
+}
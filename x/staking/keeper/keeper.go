@@ -127,6 +127,22 @@ type Keeper struct {
 	// ValidatorConsPubKeyRotationHistory: consPubkey rotation history by validator
 	// A index is being added with key `BlockConsPubKeyRotationHistory`: consPubkey rotation history by height
 	RotationHistory *collections.IndexedMap[collections.Pair[[]byte, uint64], types.ConsPubKeyRotationHistory, rotationHistoryIndexes]
+	// TokenizeShareRecords key: recordID | value: TokenizeShareRecord
+	TokenizeShareRecords collections.Map[uint64, types.TokenizeShareRecord]
+	// TokenizeShareRecordsByOwner key: owner+recordID | value: none used (index key for tokenize share records by owner)
+	TokenizeShareRecordsByOwner collections.KeySet[collections.Pair[sdk.AccAddress, uint64]]
+	// TokenizeShareRecordsByDenom key: denom | value: recordID
+	TokenizeShareRecordsByDenom collections.Map[string, uint64]
+	// LastTokenizeShareRecordID value: id of the last tokenize share record
+	LastTokenizeShareRecordID collections.Sequence
+	// ValidatorLiquidShares key: valAddr | value: delegator shares of the validator held by tokenize share records
+	ValidatorLiquidShares collections.Map[sdk.ValAddress, math.LegacyDec]
+	// DelegatorLiquidShares key: delAddr+valAddr | value: delegator shares of the validator tokenized by the delegator and still held by tokenize share records
+	DelegatorLiquidShares collections.Map[collections.Pair[sdk.AccAddress, sdk.ValAddress], math.LegacyDec]
+	// ScheduledCommissionChanges key: valAddr | value: ScheduledCommissionChange
+	ScheduledCommissionChanges collections.Map[sdk.ValAddress, types.ScheduledCommissionChange]
+	// ScheduledCommissionChangesQueue key: effectiveTime | valAddr
+	ScheduledCommissionChangesQueue collections.KeySet[collections.Pair[time.Time, sdk.ValAddress]]
 }
 
 // NewKeeper creates a new staking Keeper instance
@@ -301,6 +317,55 @@ func NewKeeper(
 			codec.CollValue[types.ConsPubKeyRotationHistory](cdc),
 			NewRotationHistoryIndexes(sb),
 		),
+
+		// key format is: 121 | recordID
+		TokenizeShareRecords: collections.NewMap(
+			sb, types.TokenizeShareRecordKey,
+			"tokenize_share_records",
+			collections.Uint64Key,
+			codec.CollValue[types.TokenizeShareRecord](cdc),
+		),
+		// key format is: 122 | owner | recordID
+		TokenizeShareRecordsByOwner: collections.NewKeySet(
+			sb, types.TokenizeShareRecordOwnerKey,
+			"tokenize_share_records_by_owner",
+			collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key),
+		),
+		// key format is: 123 | denom
+		TokenizeShareRecordsByDenom: collections.NewMap(
+			sb, types.TokenizeShareRecordDenomKey,
+			"tokenize_share_records_by_denom",
+			collections.StringKey,
+			collections.Uint64Value,
+		),
+		LastTokenizeShareRecordID: collections.NewSequence(sb, types.LastTokenizeShareRecordIDKey, "last_tokenize_share_record_id"),
+		// key format is: 125 | valAddr
+		ValidatorLiquidShares: collections.NewMap(
+			sb, types.ValidatorLiquidSharesKey,
+			"validator_liquid_shares",
+			sdk.ValAddressKey,
+			sdk.LegacyDecValue,
+		),
+		// key format is: 128 | delAddr | valAddr
+		DelegatorLiquidShares: collections.NewMap(
+			sb, types.DelegatorLiquidSharesKey,
+			"delegator_liquid_shares",
+			collections.PairKeyCodec(sdk.AccAddressKey, sdk.ValAddressKey),
+			sdk.LegacyDecValue,
+		),
+		// key format is: 126 | valAddr
+		ScheduledCommissionChanges: collections.NewMap(
+			sb, types.ScheduledCommissionChangeKey,
+			"scheduled_commission_changes",
+			sdk.ValAddressKey,
+			codec.CollValue[types.ScheduledCommissionChange](cdc),
+		),
+		// key format is: 127 | effectiveTime | valAddr
+		ScheduledCommissionChangesQueue: collections.NewKeySet(
+			sb, types.ScheduledCommissionChangeQueueKey,
+			"scheduled_commission_changes_queue",
+			collections.PairKeyCodec(sdk.TimeKey, sdk.ValAddressKey),
+		),
 	}
 
 	schema, err := sb.Build()
@@ -267,7 +267,7 @@ func (s *KeeperTestSuite) TestLastTotalPowerMigrationToColls() {
 
 			s.ctx.KVStore(s.key).Set(getLastValidatorPowerKey(valAddrs[i]), bz)
 		},
-		"198aa9b8c1d9bc02308b7b2a48944f3e4b05c6b8312cb0bcc73518d1260f682d",
+		"580c9def74b6827f083359af636386d0d84667d178986deb425ba90b1a84e116",
 	)
 	s.Require().NoError(err)
 
@@ -282,7 +282,7 @@ func (s *KeeperTestSuite) TestLastTotalPowerMigrationToColls() {
 			err = s.stakingKeeper.LastValidatorPower.Set(s.ctx, valAddrs[i], intV)
 			s.Require().NoError(err)
 		},
-		"198aa9b8c1d9bc02308b7b2a48944f3e4b05c6b8312cb0bcc73518d1260f682d",
+		"580c9def74b6827f083359af636386d0d84667d178986deb425ba90b1a84e116",
 	)
 	s.Require().NoError(err)
 }
@@ -300,7 +300,7 @@ func (s *KeeperTestSuite) TestSrcRedelegationsMigrationToColls() {
 			// legacy method to set in the state
 			s.ctx.KVStore(s.key).Set(getREDByValSrcIndexKey(addrs[i], valAddrs[i], valAddrs[i+1]), []byte{})
 		},
-		"cae99e5c0498356a290f9478b7db73d522840b736878a9d4c00b56d1ddd7fd04",
+		"c8bfdf32052c4e5c6276e2ec63c397e947d4cb40de65f2edff9db59722aa80f1",
 	)
 	s.Require().NoError(err)
 
@@ -313,7 +313,7 @@ func (s *KeeperTestSuite) TestSrcRedelegationsMigrationToColls() {
 			err := s.stakingKeeper.RedelegationsByValSrc.Set(s.ctx, collections.Join3(valAddrs[i].Bytes(), addrs[i].Bytes(), valAddrs[i+1].Bytes()), []byte{})
 			s.Require().NoError(err)
 		},
-		"cae99e5c0498356a290f9478b7db73d522840b736878a9d4c00b56d1ddd7fd04",
+		"c8bfdf32052c4e5c6276e2ec63c397e947d4cb40de65f2edff9db59722aa80f1",
 	)
 
 	s.Require().NoError(err)
@@ -332,7 +332,7 @@ func (s *KeeperTestSuite) TestDstRedelegationsMigrationToColls() {
 			// legacy method to set in the state
 			s.ctx.KVStore(s.key).Set(getREDByValDstIndexKey(addrs[i], valAddrs[i], valAddrs[i+1]), []byte{})
 		},
-		"1b7687449a83f8176a60aeced7bcfc69a2b957b9eefad60c69a9fae9acfdaa81", // this hash obtained when ran this test in main branch
+		"58ed237cea650dc25b767ea75fccedc2a197b39bdb87caf3d24ab74c42ccf079", // this hash obtained when ran this test in main branch
 	)
 	s.Require().NoError(err)
 
@@ -345,7 +345,7 @@ func (s *KeeperTestSuite) TestDstRedelegationsMigrationToColls() {
 			err := s.stakingKeeper.RedelegationsByValDst.Set(s.ctx, collections.Join3(valAddrs[i+1].Bytes(), addrs[i].Bytes(), valAddrs[i].Bytes()), []byte{})
 			s.Require().NoError(err)
 		},
-		"1b7687449a83f8176a60aeced7bcfc69a2b957b9eefad60c69a9fae9acfdaa81",
+		"58ed237cea650dc25b767ea75fccedc2a197b39bdb87caf3d24ab74c42ccf079",
 	)
 
 	s.Require().NoError(err)
@@ -376,7 +376,7 @@ func (s *KeeperTestSuite) TestUnbondingDelegationsMigrationToColls() {
 			s.ctx.KVStore(s.key).Set(getUBDKey(delAddrs[i], valAddrs[i]), bz)
 			s.ctx.KVStore(s.key).Set(getUBDByValIndexKey(delAddrs[i], valAddrs[i]), []byte{})
 		},
-		"70454ad98368368aaff32d207a7a115fba49133ecf2a225d8e3eca88c6b2324c",
+		"241052a2ebd0a9c8a66a93a077351ad573280d684618b585fcd40b34811dee00",
 	)
 	s.Require().NoError(err)
 
@@ -400,7 +400,7 @@ func (s *KeeperTestSuite) TestUnbondingDelegationsMigrationToColls() {
 			err := s.stakingKeeper.SetUnbondingDelegation(s.ctx, ubd)
 			s.Require().NoError(err)
 		},
-		"70454ad98368368aaff32d207a7a115fba49133ecf2a225d8e3eca88c6b2324c",
+		"241052a2ebd0a9c8a66a93a077351ad573280d684618b585fcd40b34811dee00",
 	)
 	s.Require().NoError(err)
 }
@@ -417,7 +417,7 @@ func (s *KeeperTestSuite) TestUBDQueueMigrationToColls() {
 			// legacy Set method
 			s.ctx.KVStore(s.key).Set(getUnbondingDelegationTimeKey(date), []byte{})
 		},
-		"2dd1dd08ea1cc2b0a076c420e3888b218647b9409b435f75e5730b0e4f25e890",
+		"343c0d87ecf5c7fd53b600157787bea951bbb2bf5106670e83689ff66036bdac",
 	)
 	s.Require().NoError(err)
 
@@ -430,7 +430,7 @@ func (s *KeeperTestSuite) TestUBDQueueMigrationToColls() {
 			err := s.stakingKeeper.SetUBDQueueTimeSlice(s.ctx, date, nil)
 			s.Require().NoError(err)
 		},
-		"2dd1dd08ea1cc2b0a076c420e3888b218647b9409b435f75e5730b0e4f25e890",
+		"343c0d87ecf5c7fd53b600157787bea951bbb2bf5106670e83689ff66036bdac",
 	)
 	s.Require().NoError(err)
 }
@@ -464,7 +464,7 @@ func (s *KeeperTestSuite) TestValidatorsMigrationToColls() {
 			// legacy Set method
 			s.ctx.KVStore(s.key).Set(getValidatorKey(valAddrs[i]), valBz)
 		},
-		"aa495d55fb45df89fcf1d4326331bfc1244ef879764abe76f6ce2a41ccd4180d",
+		"1df83c288d303ebe998404c625412860288f91b02c7f4f91fb1ec6b31ed45d4a",
 	)
 	s.Require().NoError(err)
 
@@ -490,7 +490,7 @@ func (s *KeeperTestSuite) TestValidatorsMigrationToColls() {
 			err := s.stakingKeeper.SetValidator(s.ctx, val)
 			s.Require().NoError(err)
 		},
-		"aa495d55fb45df89fcf1d4326331bfc1244ef879764abe76f6ce2a41ccd4180d",
+		"1df83c288d303ebe998404c625412860288f91b02c7f4f91fb1ec6b31ed45d4a",
 	)
 	s.Require().NoError(err)
 }
@@ -513,7 +513,7 @@ func (s *KeeperTestSuite) TestValidatorQueueMigrationToColls() {
 			// legacy Set method
 			s.ctx.KVStore(s.key).Set(getValidatorQueueKey(endTime, endHeight), bz)
 		},
-		"b23a5905ced2b76c46ddd0f7d39e2ed7dcc68cd81993c497ee314b2e1a158595",
+		"eb411454615dcc6c136842e1c490e78778f043261ab312e36a9a2a6245367e19",
 	)
 	s.Require().NoError(err)
 
@@ -528,7 +528,7 @@ func (s *KeeperTestSuite) TestValidatorQueueMigrationToColls() {
 			err := s.stakingKeeper.SetUnbondingValidatorsQueue(s.ctx, endTime, endHeight, addrs)
 			s.Require().NoError(err)
 		},
-		"b23a5905ced2b76c46ddd0f7d39e2ed7dcc68cd81993c497ee314b2e1a158595",
+		"eb411454615dcc6c136842e1c490e78778f043261ab312e36a9a2a6245367e19",
 	)
 	s.Require().NoError(err)
 }
@@ -556,7 +556,7 @@ func (s *KeeperTestSuite) TestRedelegationQueueMigrationToColls() {
 			s.Require().NoError(err)
 			s.ctx.KVStore(s.key).Set(getRedelegationTimeKey(date), bz)
 		},
-		"d6a1c46c7c5793ff7094b67252c82883aecb75c8359428a59aacd3657fa16235",
+		"b8c1340b3353bffb372e5242f67f6f98bde5a66f81350ac3cfef4eb8c0a12948",
 	)
 	s.Require().NoError(err)
 
@@ -578,7 +578,7 @@ func (s *KeeperTestSuite) TestRedelegationQueueMigrationToColls() {
 			err := s.stakingKeeper.SetRedelegationQueueTimeSlice(s.ctx, date, dvvTriplets.Triplets)
 			s.Require().NoError(err)
 		},
-		"d6a1c46c7c5793ff7094b67252c82883aecb75c8359428a59aacd3657fa16235",
+		"b8c1340b3353bffb372e5242f67f6f98bde5a66f81350ac3cfef4eb8c0a12948",
 	)
 	s.Require().NoError(err)
 }
@@ -0,0 +1,519 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetTokenizeShareRecord returns the tokenize share record with the given id.
+func (k Keeper) GetTokenizeShareRecord(ctx context.Context, id uint64) (types.TokenizeShareRecord, error) {
+	record, err := k.TokenizeShareRecords.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return record, errorsmod.Wrapf(types.ErrTokenizeShareRecordNotFound, "id %d", id)
+	}
+	return record, err
+}
+
+// GetTokenizeShareRecordByDenom returns the tokenize share record of the given
+// share token denom.
+func (k Keeper) GetTokenizeShareRecordByDenom(ctx context.Context, denom string) (types.TokenizeShareRecord, error) {
+	id, err := k.TokenizeShareRecordsByDenom.Get(ctx, denom)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.TokenizeShareRecord{}, errorsmod.Wrapf(types.ErrTokenizeShareRecordNotFound, "denom %s", denom)
+	} else if err != nil {
+		return types.TokenizeShareRecord{}, err
+	}
+
+	return k.GetTokenizeShareRecord(ctx, id)
+}
+
+// GetAllTokenizeShareRecords returns all the tokenize share records.
+func (k Keeper) GetAllTokenizeShareRecords(ctx context.Context) ([]types.TokenizeShareRecord, error) {
+	iter, err := k.TokenizeShareRecords.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// SetTokenizeShareRecord stores a tokenize share record along with its owner
+// and denom indexes.
+func (k Keeper) SetTokenizeShareRecord(ctx context.Context, record types.TokenizeShareRecord) error {
+	owner, err := k.authKeeper.AddressCodec().StringToBytes(record.Owner)
+	if err != nil {
+		return err
+	}
+
+	if err := k.TokenizeShareRecords.Set(ctx, record.Id, record); err != nil {
+		return err
+	}
+
+	if err := k.TokenizeShareRecordsByOwner.Set(ctx, collections.Join(sdk.AccAddress(owner), record.Id)); err != nil {
+		return err
+	}
+
+	return k.TokenizeShareRecordsByDenom.Set(ctx, record.GetShareTokenDenom(), record.Id)
+}
+
+// DeleteTokenizeShareRecord removes a tokenize share record along with its
+// owner and denom indexes.
+func (k Keeper) DeleteTokenizeShareRecord(ctx context.Context, record types.TokenizeShareRecord) error {
+	owner, err := k.authKeeper.AddressCodec().StringToBytes(record.Owner)
+	if err != nil {
+		return err
+	}
+
+	if err := k.TokenizeShareRecords.Remove(ctx, record.Id); err != nil {
+		return err
+	}
+
+	if err := k.TokenizeShareRecordsByOwner.Remove(ctx, collections.Join(sdk.AccAddress(owner), record.Id)); err != nil {
+		return err
+	}
+
+	return k.TokenizeShareRecordsByDenom.Remove(ctx, record.GetShareTokenDenom())
+}
+
+// TransferTokenizeShareRecord transfers the ownership of a tokenize share
+// record, and of the rewards of its delegation, from owner to newOwner.
+func (k Keeper) TransferTokenizeShareRecord(ctx context.Context, id uint64, owner, newOwner sdk.AccAddress) error {
+	record, err := k.GetTokenizeShareRecord(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	ownerStr, err := k.authKeeper.AddressCodec().BytesToString(owner)
+	if err != nil {
+		return err
+	}
+	if record.Owner != ownerStr {
+		return errorsmod.Wrapf(types.ErrNotTokenizeShareRecordOwner, "%s is not the owner of record %d", ownerStr, id)
+	}
+
+	newOwnerStr, err := k.authKeeper.AddressCodec().BytesToString(newOwner)
+	if err != nil {
+		return err
+	}
+
+	// the owner index is keyed by owner
+	if err := k.DeleteTokenizeShareRecord(ctx, record); err != nil {
+		return err
+	}
+
+	record.Owner = newOwnerStr
+	return k.SetTokenizeShareRecord(ctx, record)
+}
+
+// GetValidatorLiquidShares returns the delegator shares of a validator held by
+// tokenize share records.
+func (k Keeper) GetValidatorLiquidShares(ctx context.Context, valAddr sdk.ValAddress) (math.LegacyDec, error) {
+	shares, err := k.ValidatorLiquidShares.Get(ctx, valAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return math.LegacyZeroDec(), nil
+	}
+	return shares, err
+}
+
+// addValidatorLiquidShares adds (or subtracts, if negative) shares to the
+// liquid shares of a validator.
+func (k Keeper) addValidatorLiquidShares(ctx context.Context, valAddr sdk.ValAddress, shares math.LegacyDec) error {
+	liquidShares, err := k.GetValidatorLiquidShares(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	liquidShares = liquidShares.Add(shares)
+	if !liquidShares.IsPositive() {
+		return k.ValidatorLiquidShares.Remove(ctx, valAddr)
+	}
+	return k.ValidatorLiquidShares.Set(ctx, valAddr, liquidShares)
+}
+
+// GetDelegatorLiquidShares returns the delegator shares of a validator
+// tokenized by a delegator and still held by tokenize share records.
+func (k Keeper) GetDelegatorLiquidShares(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (math.LegacyDec, error) {
+	shares, err := k.DelegatorLiquidShares.Get(ctx, collections.Join(delAddr, valAddr))
+	if errors.Is(err, collections.ErrNotFound) {
+		return math.LegacyZeroDec(), nil
+	}
+	return shares, err
+}
+
+// addDelegatorLiquidShares adds (or subtracts, if negative) shares to the
+// liquid shares of a delegator to a validator.
+func (k Keeper) addDelegatorLiquidShares(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares math.LegacyDec) error {
+	liquidShares, err := k.GetDelegatorLiquidShares(ctx, delAddr, valAddr)
+	if err != nil {
+		return err
+	}
+
+	liquidShares = liquidShares.Add(shares)
+	if !liquidShares.IsPositive() {
+		return k.DelegatorLiquidShares.Remove(ctx, collections.Join(delAddr, valAddr))
+	}
+	return k.DelegatorLiquidShares.Set(ctx, collections.Join(delAddr, valAddr), liquidShares)
+}
+
+// GetValidatorLiquidStakedTokens returns the tokens of a validator held by
+// tokenize share records.
+func (k Keeper) GetValidatorLiquidStakedTokens(ctx context.Context, valAddr sdk.ValAddress) (math.Int, error) {
+	liquidShares, err := k.GetValidatorLiquidShares(ctx, valAddr)
+	if err != nil || liquidShares.IsZero() {
+		return math.ZeroInt(), err
+	}
+
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+
+	return validator.TokensFromShares(liquidShares).TruncateInt(), nil
+}
+
+// GetTotalLiquidStakedTokens returns the tokens of all the validators held by
+// tokenize share records. The tokens are computed from the liquid shares of the
+// validators, so that slashes are accounted for.
+func (k Keeper) GetTotalLiquidStakedTokens(ctx context.Context) (math.Int, error) {
+	total := math.ZeroInt()
+	err := k.ValidatorLiquidShares.Walk(ctx, nil, func(valAddr sdk.ValAddress, liquidShares math.LegacyDec) (bool, error) {
+		validator, err := k.GetValidator(ctx, valAddr)
+		if err != nil {
+			return true, err
+		}
+
+		total = total.Add(validator.TokensFromShares(liquidShares).TruncateInt())
+		return false, nil
+	})
+
+	return total, err
+}
+
+// checkLiquidStakingCaps checks that tokenizing the given tokens and shares of
+// the delegation of delAddr to a validator respects the global and validator
+// liquid staking caps.
+//
+// The validator cap applies to each delegator: the shares it tokenized that are
+// still held by records cannot exceed the cap of its delegation to the
+// validator, tokenized shares included. Summed over the delegators, the liquid
+// shares of the validator are still bounded by the cap of its delegator shares,
+// and a delegator cannot use up the cap of the others.
+func (k Keeper) checkLiquidStakingCaps(
+	ctx context.Context, delAddr sdk.AccAddress, validator types.Validator, tokens math.Int, shares math.LegacyDec,
+) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.GlobalLiquidStakingCap.LT(math.LegacyOneDec()) {
+		totalLiquid, err := k.GetTotalLiquidStakedTokens(ctx)
+		if err != nil {
+			return err
+		}
+
+		totalBonded, err := k.TotalBondedTokens(ctx)
+		if err != nil {
+			return err
+		}
+
+		if math.LegacyNewDecFromInt(totalLiquid.Add(tokens)).GT(params.GlobalLiquidStakingCap.MulInt(totalBonded)) {
+			return types.ErrGlobalLiquidStakingCapExceeded
+		}
+	}
+
+	if params.ValidatorLiquidStakingCap.LT(math.LegacyOneDec()) {
+		valAddr, err := k.validatorAddressCodec.StringToBytes(validator.GetOperator())
+		if err != nil {
+			return err
+		}
+
+		liquidShares, err := k.GetDelegatorLiquidShares(ctx, delAddr, valAddr)
+		if err != nil {
+			return err
+		}
+
+		delegation, err := k.Delegations.Get(ctx, collections.Join(delAddr, sdk.ValAddress(valAddr)))
+		if err != nil {
+			return err
+		}
+
+		if liquidShares.Add(shares).GT(params.ValidatorLiquidStakingCap.Mul(liquidShares.Add(delegation.Shares))) {
+			return types.ErrValidatorLiquidStakingCapExceeded
+		}
+	}
+
+	return nil
+}
+
+// checkVestedDelegation checks that tokenizing the given tokens of a vesting
+// account only undelegates its vested tokens, which, unlike its vesting ones,
+// can be sent to the module account of the record. Undelegations reduce the
+// delegated free tokens first, then the delegated vesting ones, whose part above
+// the tokens still vesting has vested since they were delegated.
+func (k Keeper) checkVestedDelegation(ctx context.Context, delAddr sdk.AccAddress, tokens math.Int) error {
+	acc, ok := k.authKeeper.GetAccount(ctx, delAddr).(types.VestingAccount)
+	if !ok {
+		return nil
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+
+	vesting := acc.GetVestingCoins(k.environment.HeaderService.GetHeaderInfo(ctx).Time).AmountOf(bondDenom)
+	vested := math.MaxInt(acc.GetDelegatedVesting().AmountOf(bondDenom).Sub(vesting), math.ZeroInt())
+	vested = vested.Add(acc.GetDelegatedFree().AmountOf(bondDenom))
+	if tokens.GT(vested) {
+		return errorsmod.Wrapf(types.ErrTokenizeVestingDelegation, "vested delegation %s is smaller than %s", vested, tokens)
+	}
+
+	return nil
+}
+
+// TokenizeShares converts the given amount of the delegation of delAddr to
+// valAddr into share tokens sent to delAddr. The delegation is moved to the
+// module account of a new tokenize share record owned by owner. It returns the
+// minted share tokens.
+func (k Keeper) TokenizeShares(
+	ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount math.Int, owner sdk.AccAddress,
+) (sdk.Coin, error) {
+	if sdk.AccAddress(valAddr).Equals(delAddr) {
+		return sdk.Coin{}, types.ErrTokenizeSelfDelegation
+	}
+
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// a delegation redelegated to the validator could otherwise escape a slash
+	// of the source validator
+	hasRedelegation, err := k.HasReceivingRedelegation(ctx, delAddr, valAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if hasRedelegation {
+		return sdk.Coin{}, types.ErrRedelegationInProgress
+	}
+
+	shares, err := k.ValidateUnbondAmount(ctx, delAddr, valAddr, amount)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.checkLiquidStakingCaps(ctx, delAddr, validator, validator.TokensFromShares(shares).TruncateInt(), shares); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.checkVestedDelegation(ctx, delAddr, validator.TokensFromShares(shares).TruncateInt()); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// the sequence holds the id of the last record, ids start at 1
+	id, err := k.LastTokenizeShareRecordID.Next(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	id++
+
+	ownerStr, err := k.authKeeper.AddressCodec().BytesToString(owner)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	delAddrStr, err := k.authKeeper.AddressCodec().BytesToString(delAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	record := types.TokenizeShareRecord{
+		Id:            id,
+		Owner:         ownerStr,
+		ModuleAccount: types.TokenizeShareModuleAccountName(id),
+		Validator:     validator.GetOperator(),
+		Delegator:     delAddrStr,
+	}
+	if err := k.SetTokenizeShareRecord(ctx, record); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	returnAmount, err := k.Unbond(ctx, delAddr, valAddr, shares)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if validator.IsBonded() {
+		if err := k.bondedTokensToNotBonded(ctx, returnAmount); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	returnCoins := sdk.NewCoins(sdk.NewCoin(bondDenom, returnAmount))
+	if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, delAddr, returnCoins); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, delAddr, record.GetModuleAddress(), returnCoins); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// the validator must be read again after being modified by Unbond
+	validator, err = k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	newShares, err := k.Delegate(ctx, record.GetModuleAddress(), returnAmount, types.Unbonded, validator, true)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.addValidatorLiquidShares(ctx, valAddr, newShares); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.addDelegatorLiquidShares(ctx, delAddr, valAddr, newShares); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	shareTokens := sdk.NewCoin(record.GetShareTokenDenom(), newShares.TruncateInt())
+	if !shareTokens.IsPositive() {
+		return sdk.Coin{}, errorsmod.Wrap(types.ErrInsufficientShares, "no share tokens to mint")
+	}
+
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(shareTokens)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delAddr, sdk.NewCoins(shareTokens)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return shareTokens, nil
+}
+
+// RedeemTokensForShares burns the given share tokens of delAddr and moves the
+// corresponding part of the delegation of their tokenize share record to
+// delAddr. The record is deleted once its whole delegation is redeemed. It
+// returns the amount of bond denom tokens delegated.
+func (k Keeper) RedeemTokensForShares(ctx context.Context, delAddr sdk.AccAddress, shareTokens sdk.Coin) (sdk.Coin, error) {
+	record, err := k.GetTokenizeShareRecordByDenom(ctx, shareTokens.Denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(record.Validator)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	recordAddr := record.GetModuleAddress()
+	delegation, err := k.Delegations.Get(ctx, collections.Join(recordAddr, sdk.ValAddress(valAddr)))
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// share tokens are the truncated delegation shares, so that redeeming all the
+	// share tokens redeems the whole delegation
+	shares := math.LegacyNewDecFromInt(shareTokens.Amount)
+	if shareTokens.Amount.Equal(delegation.Shares.TruncateInt()) {
+		shares = delegation.Shares
+	}
+	if shares.GT(delegation.Shares) {
+		return sdk.Coin{}, errorsmod.Wrap(types.ErrNotEnoughDelegationShares, delegation.Shares.String())
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, delAddr, sdk.NewCoins(shareTokens)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.addValidatorLiquidShares(ctx, valAddr, shares.Neg()); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// the redeemed shares do not count against the cap of the delegator who
+	// tokenized them anymore
+	recordDelAddr, err := k.authKeeper.AddressCodec().StringToBytes(record.Delegator)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.addDelegatorLiquidShares(ctx, recordDelAddr, valAddr, shares.Neg()); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	returnAmount, err := k.Unbond(ctx, recordAddr, valAddr, shares)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if validator.IsBonded() {
+		if err := k.bondedTokensToNotBonded(ctx, returnAmount); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	returnCoin := sdk.NewCoin(bondDenom, returnAmount)
+	if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, recordAddr, sdk.NewCoins(returnCoin)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, recordAddr, delAddr, sdk.NewCoins(returnCoin)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// the rewards withdrawn to the module account of the record when unbonding
+	// are paid out to the owner of the record
+	if rewards := k.bankKeeper.GetAllBalances(ctx, recordAddr); !rewards.IsZero() {
+		owner, err := k.authKeeper.AddressCodec().StringToBytes(record.Owner)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+
+		if err := k.bankKeeper.SendCoins(ctx, recordAddr, owner, rewards); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	// the validator must be read again after being modified by Unbond
+	validator, err = k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if _, err := k.Delegate(ctx, delAddr, returnAmount, types.Unbonded, validator, true); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// the record is not needed anymore once its delegation is fully redeemed
+	if shares.Equal(delegation.Shares) {
+		if err := k.DeleteTokenizeShareRecord(ctx, record); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	return returnCoin, nil
+}
@@ -209,11 +209,26 @@ func (k msgServer) EditValidator(ctx context.Context, msg *types.MsgEditValidato
 	validator.Description = description
 
 	if msg.CommissionRate != nil {
+		noticePeriod, err := k.CommissionChangeNoticePeriod(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		// commission rate increases must be announced with MsgScheduleCommissionChange
+		if noticePeriod > 0 && msg.CommissionRate.GT(validator.Commission.Rate) {
+			return nil, types.ErrCommissionIncreaseNotScheduled
+		}
+
 		commission, err := k.UpdateValidatorCommission(ctx, validator, *msg.CommissionRate)
 		if err != nil {
 			return nil, err
 		}
 
+		// the edit supersedes any commission change scheduled by the validator
+		if err := k.RemoveScheduledCommissionChange(ctx, valAddr); err != nil {
+			return nil, err
+		}
+
 		// call the before-modification hook since we're about to update the commission
 		if err := k.Hooks().BeforeValidatorModified(ctx, valAddr); err != nil {
 			return nil, err
@@ -250,6 +265,54 @@ func (k msgServer) EditValidator(ctx context.Context, msg *types.MsgEditValidato
 	return &types.MsgEditValidatorResponse{}, nil
 }
 
+// DecreaseMinSelfDelegation defines a method for lowering the minimum self delegation of a validator
+func (k msgServer) DecreaseMinSelfDelegation(ctx context.Context, msg *types.MsgDecreaseMinSelfDelegation) (*types.MsgDecreaseMinSelfDelegationResponse, error) {
+	valAddr, err := k.validatorAddressCodec.StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	if msg.MinSelfDelegation.IsNil() || !msg.MinSelfDelegation.IsPositive() {
+		return nil, errorsmod.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"minimum self delegation must be a positive integer",
+		)
+	}
+
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	// a jailed validator could otherwise lower its minimum to get unjailed after
+	// its self-delegation fell below it
+	if validator.Jailed {
+		return nil, types.ErrValidatorJailed
+	}
+
+	if !msg.MinSelfDelegation.LT(validator.MinSelfDelegation) {
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"minimum self delegation must be lower than the current one %s", validator.MinSelfDelegation,
+		)
+	}
+
+	validator.MinSelfDelegation = msg.MinSelfDelegation
+	if err := k.SetValidator(ctx, validator); err != nil {
+		return nil, err
+	}
+
+	if err := k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeDecreaseMinSelfDelegation,
+		event.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+		event.NewAttribute(types.AttributeKeyMinSelfDelegation, validator.MinSelfDelegation.String()),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDecreaseMinSelfDelegationResponse{}, nil
+}
+
 // Delegate defines a method for performing a delegation of coins from a delegator to a validator
 func (k msgServer) Delegate(ctx context.Context, msg *types.MsgDelegate) (*types.MsgDelegateResponse, error) {
 	valAddr, valErr := k.validatorAddressCodec.StringToBytes(msg.ValidatorAddress)
@@ -716,3 +779,250 @@ func (k msgServer) RotateConsPubKey(ctx context.Context, msg *types.MsgRotateCon
 
 	return res, nil
 }
+
+// TokenizeShares defines a method for converting a delegation into share tokens
+func (k msgServer) TokenizeShares(ctx context.Context, msg *types.MsgTokenizeShares) (*types.MsgTokenizeSharesResponse, error) {
+	valAddr, err := k.validatorAddressCodec.StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	owner, err := k.authKeeper.AddressCodec().StringToBytes(msg.TokenizedShareOwner)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid tokenized share owner address: %s", err)
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.Amount.IsPositive() {
+		return nil, errorsmod.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"invalid shares amount",
+		)
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Amount.Denom != bondDenom {
+		return nil, errorsmod.Wrapf(
+			types.ErrOnlyBondDenomAllowedForTokenize, "got %s, expected %s", msg.Amount.Denom, bondDenom,
+		)
+	}
+
+	shareTokens, err := k.Keeper.TokenizeShares(ctx, delegatorAddress, valAddr, msg.Amount.Amount, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeTokenizeShares,
+		event.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+		event.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+		event.NewAttribute(types.AttributeKeyShareOwner, msg.TokenizedShareOwner),
+		event.NewAttribute(sdk.AttributeKeyAmount, shareTokens.String()),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTokenizeSharesResponse{
+		Amount: shareTokens,
+	}, nil
+}
+
+// RedeemTokensForShares defines a method for converting share tokens back into a delegation
+func (k msgServer) RedeemTokensForShares(ctx context.Context, msg *types.MsgRedeemTokensForShares) (*types.MsgRedeemTokensForSharesResponse, error) {
+	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.Amount.IsPositive() {
+		return nil, errorsmod.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"invalid shares amount",
+		)
+	}
+
+	delegated, err := k.Keeper.RedeemTokensForShares(ctx, delegatorAddress, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeRedeemShares,
+		event.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+		event.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRedeemTokensForSharesResponse{
+		Amount: delegated,
+	}, nil
+}
+
+// TransferTokenizeShareRecord defines a method for transferring the ownership of a tokenize share record
+func (k msgServer) TransferTokenizeShareRecord(ctx context.Context, msg *types.MsgTransferTokenizeShareRecord) (*types.MsgTransferTokenizeShareRecordResponse, error) {
+	sender, err := k.authKeeper.AddressCodec().StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	newOwner, err := k.authKeeper.AddressCodec().StringToBytes(msg.NewOwner)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid new owner address: %s", err)
+	}
+
+	if err := k.Keeper.TransferTokenizeShareRecord(ctx, msg.TokenizeShareRecordId, sender, newOwner); err != nil {
+		return nil, err
+	}
+
+	if err := k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeTransferTokenizeShareRecord,
+		event.NewAttribute(types.AttributeKeyShareRecordID, strconv.FormatUint(msg.TokenizeShareRecordId, 10)),
+		event.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		event.NewAttribute(types.AttributeKeyShareOwner, msg.NewOwner),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTransferTokenizeShareRecordResponse{}, nil
+}
+
+// UndelegateShares defines a method for performing an undelegation of an amount of shares from a delegate and a validator
+func (k msgServer) UndelegateShares(ctx context.Context, msg *types.MsgUndelegateShares) (*types.MsgUndelegateSharesResponse, error) {
+	valAddr, err := k.validatorAddressCodec.StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	if msg.Shares.IsNil() || !msg.Shares.IsPositive() {
+		return nil, errorsmod.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"invalid shares amount",
+		)
+	}
+
+	shares, err := k.ValidateUnbondShares(ctx, delegatorAddress, valAddr, msg.Shares)
+	if err != nil {
+		return nil, err
+	}
+
+	completionTime, undelegatedCoin, err := k.undelegateShares(ctx, msg.DelegatorAddress, msg.ValidatorAddress, delegatorAddress, valAddr, shares)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUndelegateSharesResponse{
+		CompletionTime: completionTime,
+		Amount:         undelegatedCoin,
+	}, nil
+}
+
+// UndelegateAll defines a method for performing an undelegation of all the shares of a delegation from a validator
+func (k msgServer) UndelegateAll(ctx context.Context, msg *types.MsgUndelegateAll) (*types.MsgUndelegateAllResponse, error) {
+	valAddr, err := k.validatorAddressCodec.StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	delegation, err := k.Delegations.Get(ctx, collections.Join(sdk.AccAddress(delegatorAddress), sdk.ValAddress(valAddr)))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, types.ErrNoDelegation
+		}
+		return nil, err
+	}
+
+	completionTime, undelegatedCoin, err := k.undelegateShares(ctx, msg.DelegatorAddress, msg.ValidatorAddress, delegatorAddress, valAddr, delegation.Shares)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUndelegateAllResponse{
+		CompletionTime: completionTime,
+		Amount:         undelegatedCoin,
+	}, nil
+}
+
+// ScheduleCommissionChange defines a method for announcing a commission rate
+// change of a validator, which takes effect after the commission change notice
+// period
+func (k msgServer) ScheduleCommissionChange(ctx context.Context, msg *types.MsgScheduleCommissionChange) (*types.MsgScheduleCommissionChangeResponse, error) {
+	valAddr, err := k.validatorAddressCodec.StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	if msg.CommissionRate.IsNil() || msg.CommissionRate.GT(math.LegacyOneDec()) || msg.CommissionRate.IsNegative() {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "commission rate must be between 0 and 1 (inclusive)")
+	}
+
+	// validator must already be registered
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	effectiveTime, err := k.Keeper.ScheduleCommissionChange(ctx, validator, msg.CommissionRate)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeScheduleCommissionChange,
+		event.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+		event.NewAttribute(types.AttributeKeyCommissionRate, msg.CommissionRate.String()),
+		event.NewAttribute(types.AttributeKeyEffectiveTime, effectiveTime.Format(time.RFC3339)),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgScheduleCommissionChangeResponse{EffectiveTime: effectiveTime}, nil
+}
+
+// undelegateShares undelegates the given shares of a delegation and emits the
+// unbond event, returning the completion time and the undelegated coin.
+func (k msgServer) undelegateShares(
+	ctx context.Context, delegatorStr, validatorStr string, delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares math.LegacyDec,
+) (time.Time, sdk.Coin, error) {
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return time.Time{}, sdk.Coin{}, err
+	}
+
+	completionTime, undelegatedAmt, err := k.Keeper.Undelegate(ctx, delAddr, valAddr, shares)
+	if err != nil {
+		return time.Time{}, sdk.Coin{}, err
+	}
+
+	undelegatedCoin := sdk.NewCoin(bondDenom, undelegatedAmt)
+
+	if err := k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeUnbond,
+		event.NewAttribute(types.AttributeKeyValidator, validatorStr),
+		event.NewAttribute(types.AttributeKeyDelegator, delegatorStr),
+		event.NewAttribute(sdk.AttributeKeyAmount, undelegatedCoin.String()),
+		event.NewAttribute(types.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
+	); err != nil {
+		return time.Time{}, sdk.Coin{}, err
+	}
+
+	return completionTime, undelegatedCoin, nil
+}
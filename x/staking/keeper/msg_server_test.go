@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"context"
+	"strings"
 	"testing"
 	"time"
 
@@ -11,6 +13,7 @@ import (
 	"cosmossdk.io/core/header"
 	"cosmossdk.io/math"
 	authtypes "cosmossdk.io/x/auth/types"
+	vestingtypes "cosmossdk.io/x/auth/vesting/types"
 	stakingkeeper "cosmossdk.io/x/staking/keeper"
 	"cosmossdk.io/x/staking/types"
 
@@ -451,6 +454,88 @@ func (s *KeeperTestSuite) TestMsgEditValidator() {
 	}
 }
 
+func (s *KeeperTestSuite) TestMsgDecreaseMinSelfDelegation() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+	s.execExpectCalls()
+
+	pk := ed25519.GenPrivKey().PubKey()
+	require.NotNil(pk)
+
+	comm := types.NewCommissionRates(math.LegacyNewDec(0), math.LegacyNewDec(0), math.LegacyNewDec(0))
+	msg, err := types.NewMsgCreateValidator(s.valAddressToString(ValAddr), pk, sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(10)), types.Description{Moniker: "NewVal"}, comm, math.NewInt(5))
+	require.NoError(err)
+
+	res, err := msgServer.CreateValidator(ctx, msg)
+	require.NoError(err)
+	require.NotNil(res)
+
+	testCases := []struct {
+		name      string
+		input     *types.MsgDecreaseMinSelfDelegation
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name:      "invalid validator",
+			input:     types.NewMsgDecreaseMinSelfDelegation(s.addressToString([]byte("invalid")), math.NewInt(3)),
+			expErr:    true,
+			expErrMsg: "invalid validator address",
+		},
+		{
+			name:      "zero self delegation",
+			input:     types.NewMsgDecreaseMinSelfDelegation(s.valAddressToString(ValAddr), math.ZeroInt()),
+			expErr:    true,
+			expErrMsg: "minimum self delegation must be a positive integer",
+		},
+		{
+			name:      "validator does not exist",
+			input:     types.NewMsgDecreaseMinSelfDelegation(s.valAddressToString([]byte("val")), math.NewInt(3)),
+			expErr:    true,
+			expErrMsg: "validator does not exist",
+		},
+		{
+			name:      "same minimum self delegation",
+			input:     types.NewMsgDecreaseMinSelfDelegation(s.valAddressToString(ValAddr), math.NewInt(5)),
+			expErr:    true,
+			expErrMsg: "minimum self delegation must be lower than the current one",
+		},
+		{
+			name:      "higher minimum self delegation",
+			input:     types.NewMsgDecreaseMinSelfDelegation(s.valAddressToString(ValAddr), math.NewInt(6)),
+			expErr:    true,
+			expErrMsg: "minimum self delegation must be lower than the current one",
+		},
+		{
+			name:   "valid msg",
+			input:  types.NewMsgDecreaseMinSelfDelegation(s.valAddressToString(ValAddr), math.NewInt(3)),
+			expErr: false,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		s.T().Run(tc.name, func(t *testing.T) {
+			_, err := msgServer.DecreaseMinSelfDelegation(ctx, tc.input)
+			if tc.expErr {
+				require.Error(err)
+				require.Contains(err.Error(), tc.expErrMsg)
+			} else {
+				require.NoError(err)
+			}
+		})
+	}
+
+	validator, err := keeper.GetValidator(ctx, ValAddr)
+	require.NoError(err)
+	require.Equal(math.NewInt(3), validator.MinSelfDelegation)
+
+	// a jailed validator cannot lower its minimum self delegation
+	validator.Jailed = true
+	require.NoError(keeper.SetValidator(ctx, validator))
+	_, err = msgServer.DecreaseMinSelfDelegation(ctx, types.NewMsgDecreaseMinSelfDelegation(s.valAddressToString(ValAddr), math.NewInt(1)))
+	require.ErrorIs(err, types.ErrValidatorJailed)
+}
+
 func (s *KeeperTestSuite) TestMsgDelegate() {
 	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
 	require := s.Require()
@@ -861,6 +946,260 @@ func (s *KeeperTestSuite) TestMsgUndelegate() {
 	}
 }
 
+func (s *KeeperTestSuite) TestMsgUndelegateShares() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+	s.execExpectCalls()
+
+	pk := ed25519.GenPrivKey().PubKey()
+	require.NotNil(pk)
+
+	comm := types.NewCommissionRates(math.LegacyNewDec(0), math.LegacyNewDec(0), math.LegacyNewDec(0))
+	amt := sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: keeper.TokensFromConsensusPower(s.ctx, int64(100))}
+
+	msg, err := types.NewMsgCreateValidator(s.valAddressToString(ValAddr), pk, amt, types.Description{Moniker: "NewVal"}, comm, math.OneInt())
+	require.NoError(err)
+	_, err = msgServer.CreateValidator(ctx, msg)
+	require.NoError(err)
+
+	del := types.NewDelegation(s.addressToString(Addr), s.valAddressToString(ValAddr), math.LegacyMustNewDecFromStr("100.5"))
+	require.NoError(keeper.SetDelegation(ctx, del))
+
+	testCases := []struct {
+		name         string
+		input        *types.MsgUndelegateShares
+		expErr       bool
+		expErrMsg    string
+		expAmount    math.Int
+		expRemaining math.LegacyDec
+	}{
+		{
+			name:      "invalid validator",
+			input:     types.NewMsgUndelegateShares(s.addressToString(Addr), s.addressToString([]byte("invalid")), math.LegacyNewDec(10)),
+			expErr:    true,
+			expErrMsg: "invalid validator address",
+		},
+		{
+			name:      "invalid delegator",
+			input:     types.NewMsgUndelegateShares("invalid", s.valAddressToString(ValAddr), math.LegacyNewDec(10)),
+			expErr:    true,
+			expErrMsg: "invalid delegator address: decoding bech32 failed",
+		},
+		{
+			name:      "zero shares",
+			input:     types.NewMsgUndelegateShares(s.addressToString(Addr), s.valAddressToString(ValAddr), math.LegacyZeroDec()),
+			expErr:    true,
+			expErrMsg: "invalid shares amount",
+		},
+		{
+			name:      "nil shares",
+			input:     &types.MsgUndelegateShares{DelegatorAddress: s.addressToString(Addr), ValidatorAddress: s.valAddressToString(ValAddr)},
+			expErr:    true,
+			expErrMsg: "invalid shares amount",
+		},
+		{
+			name:      "shares greater than delegated shares",
+			input:     types.NewMsgUndelegateShares(s.addressToString(Addr), s.valAddressToString(ValAddr), math.LegacyNewDec(101)),
+			expErr:    true,
+			expErrMsg: "invalid shares amount",
+		},
+		{
+			name:         "valid msg",
+			input:        types.NewMsgUndelegateShares(s.addressToString(Addr), s.valAddressToString(ValAddr), math.LegacyNewDec(40)),
+			expAmount:    math.NewInt(40),
+			expRemaining: math.LegacyMustNewDecFromStr("60.5"),
+		},
+		{
+			name:         "remaining dust shares are undelegated",
+			input:        types.NewMsgUndelegateShares(s.addressToString(Addr), s.valAddressToString(ValAddr), math.LegacyNewDec(60)),
+			expAmount:    math.NewInt(60),
+			expRemaining: math.LegacyZeroDec(),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.T().Run(tc.name, func(t *testing.T) {
+			res, err := msgServer.UndelegateShares(ctx, tc.input)
+			if tc.expErr {
+				require.Error(err)
+				require.Contains(err.Error(), tc.expErrMsg)
+				return
+			}
+
+			require.NoError(err)
+			require.Equal(sdk.NewCoin(sdk.DefaultBondDenom, tc.expAmount), res.Amount)
+
+			del, err := keeper.Delegations.Get(ctx, collections.Join(Addr, ValAddr))
+			if tc.expRemaining.IsZero() {
+				require.ErrorIs(err, collections.ErrNotFound)
+			} else {
+				require.NoError(err)
+				require.Equal(tc.expRemaining, del.Shares)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgUndelegateAll() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+	s.execExpectCalls()
+
+	pk := ed25519.GenPrivKey().PubKey()
+	require.NotNil(pk)
+
+	comm := types.NewCommissionRates(math.LegacyNewDec(0), math.LegacyNewDec(0), math.LegacyNewDec(0))
+	amt := sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: keeper.TokensFromConsensusPower(s.ctx, int64(100))}
+
+	msg, err := types.NewMsgCreateValidator(s.valAddressToString(ValAddr), pk, amt, types.Description{Moniker: "NewVal"}, comm, math.OneInt())
+	require.NoError(err)
+	_, err = msgServer.CreateValidator(ctx, msg)
+	require.NoError(err)
+
+	del := types.NewDelegation(s.addressToString(Addr), s.valAddressToString(ValAddr), math.LegacyMustNewDecFromStr("100.000001"))
+	require.NoError(keeper.SetDelegation(ctx, del))
+
+	testCases := []struct {
+		name      string
+		input     *types.MsgUndelegateAll
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name:      "invalid validator",
+			input:     types.NewMsgUndelegateAll(s.addressToString(Addr), s.addressToString([]byte("invalid"))),
+			expErr:    true,
+			expErrMsg: "invalid validator address",
+		},
+		{
+			name:      "invalid delegator",
+			input:     types.NewMsgUndelegateAll("invalid", s.valAddressToString(ValAddr)),
+			expErr:    true,
+			expErrMsg: "invalid delegator address: decoding bech32 failed",
+		},
+		{
+			name:      "no delegation",
+			input:     types.NewMsgUndelegateAll(s.addressToString(PKS[1].Address()), s.valAddressToString(ValAddr)),
+			expErr:    true,
+			expErrMsg: "no delegation for (address, validator) tuple",
+		},
+		{
+			name:  "valid msg",
+			input: types.NewMsgUndelegateAll(s.addressToString(Addr), s.valAddressToString(ValAddr)),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.T().Run(tc.name, func(t *testing.T) {
+			res, err := msgServer.UndelegateAll(ctx, tc.input)
+			if tc.expErr {
+				require.Error(err)
+				require.Contains(err.Error(), tc.expErrMsg)
+				return
+			}
+
+			require.NoError(err)
+			require.Equal(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100)), res.Amount)
+
+			_, err = keeper.Delegations.Get(ctx, collections.Join(Addr, ValAddr))
+			require.ErrorIs(err, collections.ErrNotFound)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgScheduleCommissionChange() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+	s.execExpectCalls()
+
+	noticePeriod := 7 * 24 * time.Hour
+	params, err := keeper.Params.Get(ctx)
+	require.NoError(err)
+	params.CommissionChangeNoticePeriod = noticePeriod
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	pk := ed25519.GenPrivKey().PubKey()
+	comm := types.NewCommissionRates(math.LegacyNewDecWithPrec(1, 1), math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDecWithPrec(1, 1))
+	msg, err := types.NewMsgCreateValidator(s.valAddressToString(ValAddr), pk, sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(10)), types.Description{Moniker: "NewVal"}, comm, math.OneInt())
+	require.NoError(err)
+	_, err = msgServer.CreateValidator(ctx, msg)
+	require.NoError(err)
+
+	newCtx := ctx.WithHeaderInfo(header.Info{Time: ctx.HeaderInfo().Time.AddDate(0, 0, 1)})
+	valStr := s.valAddressToString(ValAddr)
+	newRate := math.LegacyNewDecWithPrec(15, 2)
+
+	// invalid requests
+	_, err = msgServer.ScheduleCommissionChange(newCtx, types.NewMsgScheduleCommissionChange(s.addressToString([]byte("invalid")), newRate))
+	require.ErrorContains(err, "invalid validator address")
+	_, err = msgServer.ScheduleCommissionChange(newCtx, types.NewMsgScheduleCommissionChange(valStr, math.LegacyNewDec(2)))
+	require.ErrorContains(err, "commission rate must be between 0 and 1 (inclusive)")
+	_, err = msgServer.ScheduleCommissionChange(newCtx, types.NewMsgScheduleCommissionChange(valStr, math.LegacyNewDecWithPrec(3, 1)))
+	require.ErrorIs(err, types.ErrCommissionGTMaxChangeRate)
+
+	// rate increases cannot be applied without notice
+	_, err = msgServer.EditValidator(newCtx, &types.MsgEditValidator{
+		Description:      types.Description{Moniker: "NewVal"},
+		ValidatorAddress: valStr,
+		CommissionRate:   &newRate,
+	})
+	require.ErrorIs(err, types.ErrCommissionIncreaseNotScheduled)
+
+	res, err := msgServer.ScheduleCommissionChange(newCtx, types.NewMsgScheduleCommissionChange(valStr, newRate))
+	require.NoError(err)
+	effectiveTime := newCtx.HeaderInfo().Time.Add(noticePeriod)
+	require.Equal(effectiveTime, res.EffectiveTime)
+
+	change, err := keeper.GetScheduledCommissionChange(newCtx, ValAddr)
+	require.NoError(err)
+	require.Equal(types.ScheduledCommissionChange{ValidatorAddress: valStr, CommissionRate: newRate, EffectiveTime: effectiveTime}, change)
+
+	// the change is not applied before its effective time
+	require.NoError(keeper.ApplyMatureCommissionChanges(newCtx, effectiveTime.Add(-time.Second)))
+	validator, err := keeper.GetValidator(newCtx, ValAddr)
+	require.NoError(err)
+	require.Equal(math.LegacyNewDecWithPrec(1, 1), validator.Commission.Rate)
+
+	applyCtx := newCtx.WithHeaderInfo(header.Info{Time: effectiveTime})
+	require.NoError(keeper.ApplyMatureCommissionChanges(applyCtx, effectiveTime))
+	validator, err = keeper.GetValidator(applyCtx, ValAddr)
+	require.NoError(err)
+	require.Equal(newRate, validator.Commission.Rate)
+	require.Equal(effectiveTime, validator.Commission.UpdateTime)
+	_, err = keeper.GetScheduledCommissionChange(applyCtx, ValAddr)
+	require.ErrorIs(err, types.ErrNoScheduledCommissionChange)
+
+	// a commission edit supersedes the scheduled change
+	editCtx := applyCtx.WithHeaderInfo(header.Info{Time: effectiveTime.AddDate(0, 0, 1)})
+	_, err = msgServer.ScheduleCommissionChange(editCtx, types.NewMsgScheduleCommissionChange(valStr, math.LegacyNewDecWithPrec(2, 1)))
+	require.NoError(err)
+	// rescheduling the change replaces its queue entry
+	_, err = msgServer.ScheduleCommissionChange(editCtx.WithHeaderInfo(header.Info{Time: effectiveTime.AddDate(0, 0, 2)}), types.NewMsgScheduleCommissionChange(valStr, math.LegacyNewDecWithPrec(2, 1)))
+	require.NoError(err)
+	queued, err := keeper.ScheduledCommissionChangesQueue.Iterate(editCtx, nil)
+	require.NoError(err)
+	queuedKeys, err := queued.Keys()
+	require.NoError(err)
+	require.Len(queuedKeys, 1)
+	require.Equal(effectiveTime.AddDate(0, 0, 2).Add(noticePeriod), queuedKeys[0].K1())
+	decreasedRate := math.LegacyNewDecWithPrec(1, 1)
+	_, err = msgServer.EditValidator(editCtx, &types.MsgEditValidator{
+		Description:      types.Description{Moniker: "NewVal"},
+		ValidatorAddress: valStr,
+		CommissionRate:   &decreasedRate,
+	})
+	require.NoError(err)
+	_, err = keeper.GetScheduledCommissionChange(editCtx, ValAddr)
+	require.ErrorIs(err, types.ErrNoScheduledCommissionChange)
+	queued, err = keeper.ScheduledCommissionChangesQueue.Iterate(editCtx, nil)
+	require.NoError(err)
+	queuedKeys, err = queued.Keys()
+	require.NoError(err)
+	require.Empty(queuedKeys)
+}
+
 func (s *KeeperTestSuite) TestMsgCancelUnbondingDelegation() {
 	ctx, keeper, msgServer, ak := s.ctx, s.stakingKeeper, s.msgServer, s.accountKeeper
 	require := s.Require()
@@ -1378,3 +1717,448 @@ func (s *KeeperTestSuite) TestConsKeyRotn() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestMsgTokenizeShares() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+	s.execExpectCalls()
+
+	pk := ed25519.GenPrivKey().PubKey()
+	require.NotNil(pk)
+
+	comm := types.NewCommissionRates(math.LegacyNewDec(0), math.LegacyNewDec(0), math.LegacyNewDec(0))
+	amt := sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: keeper.TokensFromConsensusPower(s.ctx, int64(100))}
+
+	msg, err := types.NewMsgCreateValidator(s.valAddressToString(ValAddr), pk, amt, types.Description{Moniker: "NewVal"}, comm, math.OneInt())
+	require.NoError(err)
+	res, err := msgServer.CreateValidator(ctx, msg)
+	require.NoError(err)
+	require.NotNil(res)
+
+	delAddr := sdk.AccAddress(PKS[1].Address())
+	owner := sdk.AccAddress(PKS[2].Address())
+	del := types.NewDelegation(s.addressToString(delAddr), s.valAddressToString(ValAddr), math.LegacyNewDec(100))
+	require.NoError(keeper.SetDelegation(ctx, del))
+
+	recordAddr := types.TokenizeShareRecord{ModuleAccount: types.TokenizeShareModuleAccountName(1)}.GetModuleAddress()
+	shareTokens := sdk.NewCoins(sdk.NewInt64Coin(strings.ToLower(s.valAddressToString(ValAddr))+"/1", 50))
+	s.accountKeeper.EXPECT().GetAccount(gomock.Any(), delAddr).Return(authtypes.NewBaseAccountWithAddress(delAddr)).AnyTimes()
+	s.bankKeeper.EXPECT().UndelegateCoinsFromModuleToAccount(gomock.Any(), types.NotBondedPoolName, delAddr, gomock.Any()).Return(nil)
+	s.bankKeeper.EXPECT().SendCoins(gomock.Any(), delAddr, recordAddr, gomock.Any()).Return(nil)
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), recordAddr, types.NotBondedPoolName, gomock.Any()).Return(nil)
+	s.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, shareTokens).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, delAddr, shareTokens).Return(nil)
+
+	testCases := []struct {
+		name      string
+		input     *types.MsgTokenizeShares
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name:      "invalid validator",
+			input:     types.NewMsgTokenizeShares(s.addressToString(delAddr), s.addressToString([]byte("invalid")), sdk.NewInt64Coin(sdk.DefaultBondDenom, 50), s.addressToString(owner)),
+			expErr:    true,
+			expErrMsg: "invalid validator address",
+		},
+		{
+			name:      "invalid delegator",
+			input:     types.NewMsgTokenizeShares("invalid", s.valAddressToString(ValAddr), sdk.NewInt64Coin(sdk.DefaultBondDenom, 50), s.addressToString(owner)),
+			expErr:    true,
+			expErrMsg: "invalid delegator address",
+		},
+		{
+			name:      "invalid tokenized share owner",
+			input:     types.NewMsgTokenizeShares(s.addressToString(delAddr), s.valAddressToString(ValAddr), sdk.NewInt64Coin(sdk.DefaultBondDenom, 50), "invalid"),
+			expErr:    true,
+			expErrMsg: "invalid tokenized share owner address",
+		},
+		{
+			name:      "zero amount",
+			input:     types.NewMsgTokenizeShares(s.addressToString(delAddr), s.valAddressToString(ValAddr), sdk.NewInt64Coin(sdk.DefaultBondDenom, 0), s.addressToString(owner)),
+			expErr:    true,
+			expErrMsg: "invalid shares amount",
+		},
+		{
+			name:      "invalid coin denom",
+			input:     types.NewMsgTokenizeShares(s.addressToString(delAddr), s.valAddressToString(ValAddr), sdk.NewInt64Coin("test", 50), s.addressToString(owner)),
+			expErr:    true,
+			expErrMsg: "only the bond denom can be tokenized",
+		},
+		{
+			name:      "self delegation",
+			input:     types.NewMsgTokenizeShares(s.addressToString(Addr), s.valAddressToString(ValAddr), sdk.NewInt64Coin(sdk.DefaultBondDenom, 50), s.addressToString(owner)),
+			expErr:    true,
+			expErrMsg: "validator operator cannot tokenize its self delegation",
+		},
+		{
+			name:      "not enough shares",
+			input:     types.NewMsgTokenizeShares(s.addressToString(delAddr), s.valAddressToString(ValAddr), sdk.NewInt64Coin(sdk.DefaultBondDenom, 101), s.addressToString(owner)),
+			expErr:    true,
+			expErrMsg: "invalid shares amount",
+		},
+		{
+			name:   "valid msg",
+			input:  types.NewMsgTokenizeShares(s.addressToString(delAddr), s.valAddressToString(ValAddr), sdk.NewInt64Coin(sdk.DefaultBondDenom, 50), s.addressToString(owner)),
+			expErr: false,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		s.T().Run(tc.name, func(t *testing.T) {
+			res, err := msgServer.TokenizeShares(ctx, tc.input)
+			if tc.expErr {
+				require.Error(err)
+				require.Contains(err.Error(), tc.expErrMsg)
+			} else {
+				require.NoError(err)
+				require.Equal(shareTokens[0], res.Amount)
+			}
+		})
+	}
+
+	record, err := keeper.GetTokenizeShareRecordByDenom(ctx, shareTokens[0].Denom)
+	require.NoError(err)
+	require.Equal(types.TokenizeShareRecord{
+		Id:            1,
+		Owner:         s.addressToString(owner),
+		ModuleAccount: types.TokenizeShareModuleAccountName(1),
+		Validator:     s.valAddressToString(ValAddr),
+		Delegator:     s.addressToString(delAddr),
+	}, record)
+
+	delegation, err := keeper.Delegations.Get(ctx, collections.Join(delAddr, ValAddr))
+	require.NoError(err)
+	require.Equal(math.LegacyNewDec(50), delegation.Shares)
+
+	delegation, err = keeper.Delegations.Get(ctx, collections.Join(recordAddr, ValAddr))
+	require.NoError(err)
+	require.Equal(math.LegacyNewDec(50), delegation.Shares)
+
+	liquidShares, err := keeper.GetValidatorLiquidShares(ctx, ValAddr)
+	require.NoError(err)
+	require.Equal(math.LegacyNewDec(50), liquidShares)
+
+	liquidShares, err = keeper.GetDelegatorLiquidShares(ctx, delAddr, ValAddr)
+	require.NoError(err)
+	require.Equal(math.LegacyNewDec(50), liquidShares)
+}
+
+func (s *KeeperTestSuite) TestMsgTokenizeSharesLiquidStakingCaps() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+	s.execExpectCalls()
+
+	pk := ed25519.GenPrivKey().PubKey()
+	require.NotNil(pk)
+
+	comm := types.NewCommissionRates(math.LegacyNewDec(0), math.LegacyNewDec(0), math.LegacyNewDec(0))
+	amt := sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: keeper.TokensFromConsensusPower(s.ctx, int64(100))}
+
+	msg, err := types.NewMsgCreateValidator(s.valAddressToString(ValAddr), pk, amt, types.Description{Moniker: "NewVal"}, comm, math.OneInt())
+	require.NoError(err)
+	_, err = msgServer.CreateValidator(ctx, msg)
+	require.NoError(err)
+
+	delAddr := sdk.AccAddress(PKS[1].Address())
+	del := types.NewDelegation(s.addressToString(delAddr), s.valAddressToString(ValAddr), math.LegacyNewDec(100))
+	require.NoError(keeper.SetDelegation(ctx, del))
+	otherDelAddr := sdk.AccAddress(PKS[2].Address())
+	del = types.NewDelegation(s.addressToString(otherDelAddr), s.valAddressToString(ValAddr), math.LegacyNewDec(100))
+	require.NoError(keeper.SetDelegation(ctx, del))
+
+	s.accountKeeper.EXPECT().GetAccount(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, addr sdk.AccAddress) sdk.AccountI {
+		return authtypes.NewBaseAccountWithAddress(addr)
+	}).AnyTimes()
+	s.bankKeeper.EXPECT().UndelegateCoinsFromModuleToAccount(gomock.Any(), types.NotBondedPoolName, gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	s.bankKeeper.EXPECT().SendCoins(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), gomock.Any(), types.NotBondedPoolName, gomock.Any()).Return(nil).AnyTimes()
+	s.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, gomock.Any()).Return(nil).AnyTimes()
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	tokenize := types.NewMsgTokenizeShares(s.addressToString(delAddr), s.valAddressToString(ValAddr), sdk.NewInt64Coin(sdk.DefaultBondDenom, 50), s.addressToString(delAddr))
+
+	// 1% of 1000 bonded tokens
+	params, err := keeper.Params.Get(ctx)
+	require.NoError(err)
+	params.GlobalLiquidStakingCap = math.LegacyNewDecWithPrec(1, 2)
+	require.NoError(keeper.Params.Set(ctx, params))
+	s.accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), types.BondedPoolName).Return(bondedAcc)
+	s.bankKeeper.EXPECT().GetBalance(gomock.Any(), bondedAcc.GetAddress(), sdk.DefaultBondDenom).Return(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	_, err = msgServer.TokenizeShares(ctx, tokenize)
+	require.ErrorIs(err, types.ErrGlobalLiquidStakingCapExceeded)
+
+	// 10 of the 100 delegator shares of the delegator
+	params.GlobalLiquidStakingCap = math.LegacyOneDec()
+	params.ValidatorLiquidStakingCap = math.LegacyNewDecWithPrec(1, 1)
+	require.NoError(keeper.Params.Set(ctx, params))
+	_, err = msgServer.TokenizeShares(ctx, tokenize)
+	require.ErrorIs(err, types.ErrValidatorLiquidStakingCapExceeded)
+
+	// nothing is tokenized when a cap is exceeded
+	_, err = keeper.GetTokenizeShareRecord(ctx, 1)
+	require.ErrorIs(err, types.ErrTokenizeShareRecordNotFound)
+
+	// 50 of the 100 delegator shares of each delegator
+	params.ValidatorLiquidStakingCap = math.LegacyNewDecWithPrec(5, 1)
+	require.NoError(keeper.Params.Set(ctx, params))
+	_, err = msgServer.TokenizeShares(ctx, tokenize)
+	require.NoError(err)
+
+	// the shares still held by the record count against the cap of the delegator
+	tokenizeMore := types.NewMsgTokenizeShares(s.addressToString(delAddr), s.valAddressToString(ValAddr), sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), s.addressToString(delAddr))
+	_, err = msgServer.TokenizeShares(ctx, tokenizeMore)
+	require.ErrorIs(err, types.ErrValidatorLiquidStakingCapExceeded)
+
+	// but not against the cap of the other delegators
+	tokenizeOther := types.NewMsgTokenizeShares(s.addressToString(otherDelAddr), s.valAddressToString(ValAddr), sdk.NewInt64Coin(sdk.DefaultBondDenom, 50), s.addressToString(otherDelAddr))
+	_, err = msgServer.TokenizeShares(ctx, tokenizeOther)
+	require.NoError(err)
+
+	liquidShares, err := keeper.GetValidatorLiquidShares(ctx, ValAddr)
+	require.NoError(err)
+	require.Equal(math.LegacyNewDec(100), liquidShares)
+}
+
+func (s *KeeperTestSuite) TestMsgTokenizeSharesVestingDelegator() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+	s.execExpectCalls()
+
+	pk := ed25519.GenPrivKey().PubKey()
+	require.NotNil(pk)
+
+	comm := types.NewCommissionRates(math.LegacyNewDec(0), math.LegacyNewDec(0), math.LegacyNewDec(0))
+	amt := sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: keeper.TokensFromConsensusPower(s.ctx, int64(100))}
+
+	msg, err := types.NewMsgCreateValidator(s.valAddressToString(ValAddr), pk, amt, types.Description{Moniker: "NewVal"}, comm, math.OneInt())
+	require.NoError(err)
+	_, err = msgServer.CreateValidator(ctx, msg)
+	require.NoError(err)
+
+	delAddr := sdk.AccAddress(PKS[1].Address())
+	del := types.NewDelegation(s.addressToString(delAddr), s.valAddressToString(ValAddr), math.LegacyNewDec(100))
+	require.NoError(keeper.SetDelegation(ctx, del))
+
+	// a vesting account delegating 20 free and 80 vesting tokens, half of its
+	// 100 vesting tokens having vested since
+	now := ctx.HeaderInfo().Time
+	vestingAcc, err := vestingtypes.NewContinuousVestingAccount(
+		authtypes.NewBaseAccountWithAddress(delAddr), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)),
+		now.Add(-time.Hour).Unix(), now.Add(time.Hour).Unix(),
+	)
+	require.NoError(err)
+	vestingAcc.DelegatedFree = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 20))
+	vestingAcc.DelegatedVesting = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 80))
+	s.accountKeeper.EXPECT().GetAccount(gomock.Any(), delAddr).Return(vestingAcc).AnyTimes()
+
+	// only the 20 free tokens and the 30 delegated vesting tokens which have
+	// vested can be tokenized
+	tokenize := types.NewMsgTokenizeShares(s.addressToString(delAddr), s.valAddressToString(ValAddr), sdk.NewInt64Coin(sdk.DefaultBondDenom, 51), s.addressToString(delAddr))
+	_, err = msgServer.TokenizeShares(ctx, tokenize)
+	require.ErrorIs(err, types.ErrTokenizeVestingDelegation)
+
+	s.bankKeeper.EXPECT().UndelegateCoinsFromModuleToAccount(gomock.Any(), types.NotBondedPoolName, delAddr, gomock.Any()).Return(nil)
+	s.bankKeeper.EXPECT().SendCoins(gomock.Any(), delAddr, gomock.Any(), gomock.Any()).Return(nil)
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), gomock.Any(), types.NotBondedPoolName, gomock.Any()).Return(nil)
+	s.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, gomock.Any()).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, delAddr, gomock.Any()).Return(nil)
+	tokenize.Amount = sdk.NewInt64Coin(sdk.DefaultBondDenom, 50)
+	_, err = msgServer.TokenizeShares(ctx, tokenize)
+	require.NoError(err)
+}
+
+func (s *KeeperTestSuite) TestMsgRedeemTokensForShares() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+	s.execExpectCalls()
+
+	pk := ed25519.GenPrivKey().PubKey()
+	require.NotNil(pk)
+
+	comm := types.NewCommissionRates(math.LegacyNewDec(0), math.LegacyNewDec(0), math.LegacyNewDec(0))
+	amt := sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: keeper.TokensFromConsensusPower(s.ctx, int64(100))}
+
+	msg, err := types.NewMsgCreateValidator(s.valAddressToString(ValAddr), pk, amt, types.Description{Moniker: "NewVal"}, comm, math.OneInt())
+	require.NoError(err)
+	_, err = msgServer.CreateValidator(ctx, msg)
+	require.NoError(err)
+
+	// a tokenize share record holding a delegation of 50 shares tokenized by
+	// another delegator, and owned by a third one
+	delAddr := sdk.AccAddress(PKS[1].Address())
+	tokenizerAddr := sdk.AccAddress(PKS[2].Address())
+	ownerAddr := sdk.AccAddress(PKS[0].Address())
+	record := types.TokenizeShareRecord{
+		Id:            1,
+		Owner:         s.addressToString(ownerAddr),
+		ModuleAccount: types.TokenizeShareModuleAccountName(1),
+		Validator:     s.valAddressToString(ValAddr),
+		Delegator:     s.addressToString(tokenizerAddr),
+	}
+	require.NoError(keeper.SetTokenizeShareRecord(ctx, record))
+	recordAddr := record.GetModuleAddress()
+	del := types.NewDelegation(s.addressToString(recordAddr), s.valAddressToString(ValAddr), math.LegacyNewDec(50))
+	require.NoError(keeper.SetDelegation(ctx, del))
+	require.NoError(keeper.ValidatorLiquidShares.Set(ctx, ValAddr, math.LegacyNewDec(50)))
+	require.NoError(keeper.DelegatorLiquidShares.Set(ctx, collections.Join(tokenizerAddr, ValAddr), math.LegacyNewDec(50)))
+
+	shareTokens := sdk.NewInt64Coin(record.GetShareTokenDenom(), 50)
+	redeemed := sdk.NewInt64Coin(sdk.DefaultBondDenom, 50)
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), delAddr, sdk.NewCoins(shareTokens)).Return(nil)
+	s.bankKeeper.EXPECT().UndelegateCoinsFromModuleToAccount(gomock.Any(), types.NotBondedPoolName, recordAddr, sdk.NewCoins(redeemed)).Return(nil)
+	s.bankKeeper.EXPECT().SendCoins(gomock.Any(), recordAddr, delAddr, sdk.NewCoins(redeemed)).Return(nil)
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), delAddr, types.NotBondedPoolName, sdk.NewCoins(redeemed)).Return(nil)
+
+	// the rewards withdrawn to the module account of the record when unbonding
+	// go to the owner of the record
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 7))
+	s.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), recordAddr).Return(rewards)
+	s.bankKeeper.EXPECT().SendCoins(gomock.Any(), recordAddr, ownerAddr, rewards).Return(nil)
+
+	testCases := []struct {
+		name      string
+		input     *types.MsgRedeemTokensForShares
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name:      "invalid delegator",
+			input:     types.NewMsgRedeemTokensForShares("invalid", shareTokens),
+			expErr:    true,
+			expErrMsg: "invalid delegator address",
+		},
+		{
+			name:      "zero amount",
+			input:     types.NewMsgRedeemTokensForShares(s.addressToString(delAddr), sdk.NewInt64Coin(shareTokens.Denom, 0)),
+			expErr:    true,
+			expErrMsg: "invalid shares amount",
+		},
+		{
+			name:      "unknown share token denom",
+			input:     types.NewMsgRedeemTokensForShares(s.addressToString(delAddr), sdk.NewInt64Coin(sdk.DefaultBondDenom, 50)),
+			expErr:    true,
+			expErrMsg: "tokenize share record not found",
+		},
+		{
+			name:      "more tokens than the record shares",
+			input:     types.NewMsgRedeemTokensForShares(s.addressToString(delAddr), sdk.NewInt64Coin(shareTokens.Denom, 51)),
+			expErr:    true,
+			expErrMsg: "not enough delegation shares",
+		},
+		{
+			name:   "valid msg",
+			input:  types.NewMsgRedeemTokensForShares(s.addressToString(delAddr), shareTokens),
+			expErr: false,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		s.T().Run(tc.name, func(t *testing.T) {
+			res, err := msgServer.RedeemTokensForShares(ctx, tc.input)
+			if tc.expErr {
+				require.Error(err)
+				require.Contains(err.Error(), tc.expErrMsg)
+			} else {
+				require.NoError(err)
+				require.Equal(redeemed, res.Amount)
+			}
+		})
+	}
+
+	// the fully redeemed record is deleted
+	_, err = keeper.GetTokenizeShareRecord(ctx, 1)
+	require.ErrorIs(err, types.ErrTokenizeShareRecordNotFound)
+	_, err = keeper.GetTokenizeShareRecordByDenom(ctx, shareTokens.Denom)
+	require.ErrorIs(err, types.ErrTokenizeShareRecordNotFound)
+
+	delegation, err := keeper.Delegations.Get(ctx, collections.Join(delAddr, ValAddr))
+	require.NoError(err)
+	require.Equal(math.LegacyNewDec(50), delegation.Shares)
+
+	liquidShares, err := keeper.GetValidatorLiquidShares(ctx, ValAddr)
+	require.NoError(err)
+	require.True(liquidShares.IsZero())
+
+	liquidShares, err = keeper.GetDelegatorLiquidShares(ctx, tokenizerAddr, ValAddr)
+	require.NoError(err)
+	require.True(liquidShares.IsZero())
+}
+
+func (s *KeeperTestSuite) TestMsgTransferTokenizeShareRecord() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+
+	ownerAddr := sdk.AccAddress(PKS[1].Address())
+	newOwnerAddr := sdk.AccAddress(PKS[2].Address())
+	record := types.TokenizeShareRecord{
+		Id:            1,
+		Owner:         s.addressToString(ownerAddr),
+		ModuleAccount: types.TokenizeShareModuleAccountName(1),
+		Validator:     s.valAddressToString(ValAddr),
+		Delegator:     s.addressToString(ownerAddr),
+	}
+	require.NoError(keeper.SetTokenizeShareRecord(ctx, record))
+
+	testCases := []struct {
+		name      string
+		input     *types.MsgTransferTokenizeShareRecord
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name:      "invalid sender",
+			input:     types.NewMsgTransferTokenizeShareRecord(1, "invalid", s.addressToString(newOwnerAddr)),
+			expErr:    true,
+			expErrMsg: "invalid sender address",
+		},
+		{
+			name:      "invalid new owner",
+			input:     types.NewMsgTransferTokenizeShareRecord(1, s.addressToString(ownerAddr), "invalid"),
+			expErr:    true,
+			expErrMsg: "invalid new owner address",
+		},
+		{
+			name:      "unknown record",
+			input:     types.NewMsgTransferTokenizeShareRecord(2, s.addressToString(ownerAddr), s.addressToString(newOwnerAddr)),
+			expErr:    true,
+			expErrMsg: "tokenize share record not found",
+		},
+		{
+			name:      "sender not the owner",
+			input:     types.NewMsgTransferTokenizeShareRecord(1, s.addressToString(newOwnerAddr), s.addressToString(newOwnerAddr)),
+			expErr:    true,
+			expErrMsg: "not the owner of the tokenize share record",
+		},
+		{
+			name:   "valid msg",
+			input:  types.NewMsgTransferTokenizeShareRecord(1, s.addressToString(ownerAddr), s.addressToString(newOwnerAddr)),
+			expErr: false,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		s.T().Run(tc.name, func(t *testing.T) {
+			_, err := msgServer.TransferTokenizeShareRecord(ctx, tc.input)
+			if tc.expErr {
+				require.Error(err)
+				require.Contains(err.Error(), tc.expErrMsg)
+			} else {
+				require.NoError(err)
+			}
+		})
+	}
+
+	record, err := keeper.GetTokenizeShareRecordByDenom(ctx, record.GetShareTokenDenom())
+	require.NoError(err)
+	require.Equal(s.addressToString(newOwnerAddr), record.Owner)
+
+	// the record is indexed by its new owner only
+	has, err := keeper.TokenizeShareRecordsByOwner.Has(ctx, collections.Join(ownerAddr, uint64(1)))
+	require.NoError(err)
+	require.False(has)
+
+	has, err = keeper.TokenizeShareRecordsByOwner.Has(ctx, collections.Join(newOwnerAddr, uint64(1)))
+	require.NoError(err)
+	require.True(has)
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"cosmossdk.io/math"
+	"cosmossdk.io/x/staking/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -54,3 +55,24 @@ func (k Keeper) MinCommissionRate(ctx context.Context) (math.LegacyDec, error) {
 	params, err := k.Params.Get(ctx)
 	return params.MinCommissionRate, err
 }
+
+// MinSelfDelegationBreachAction - Action taken when the self-delegation of a
+// validator falls below its minimum
+func (k Keeper) MinSelfDelegationBreachAction(ctx context.Context) (types.MinSelfDelegationBreachAction, error) {
+	params, err := k.Params.Get(ctx)
+	return params.MinSelfDelegationBreachAction, err
+}
+
+// MaxValidatorRedelegationEntries - Maximum number of redelegation entries to
+// a destination validator
+func (k Keeper) MaxValidatorRedelegationEntries(ctx context.Context) (uint32, error) {
+	params, err := k.Params.Get(ctx)
+	return params.MaxValidatorRedelegationEntries, err
+}
+
+// CommissionChangeNoticePeriod - Time between the scheduling of a commission
+// change and the block at which it takes effect
+func (k Keeper) CommissionChangeNoticePeriod(ctx context.Context) (time.Duration, error) {
+	params, err := k.Params.Get(ctx)
+	return params.CommissionChangeNoticePeriod, err
+}
@@ -34,7 +34,26 @@ import (
 //
 //	Infraction was committed at the current height or at a past height,
 //	but not at a height in the future
+//
+// The slash is applied atomically: if it fails, e.g. because a
+// BeforeValidatorSlashed hook vetoed it, none of the unbonding delegations,
+// redelegations or validator tokens are slashed.
 func (k Keeper) Slash(ctx context.Context, consAddr sdk.ConsAddress, infractionHeight, power int64, slashFactor math.LegacyDec) (math.Int, error) {
+	burned := math.NewInt(0)
+	err := k.environment.BranchService.Execute(ctx, func(ctx context.Context) error {
+		var err error
+		burned, err = k.slash(ctx, consAddr, infractionHeight, power, slashFactor)
+		return err
+	})
+	if err != nil {
+		return math.NewInt(0), err
+	}
+
+	return burned, nil
+}
+
+// slash implements Slash within the branched context.
+func (k Keeper) slash(ctx context.Context, consAddr sdk.ConsAddress, infractionHeight, power int64, slashFactor math.LegacyDec) (math.Int, error) {
 	logger := k.Logger()
 
 	if slashFactor.IsNegative() {
@@ -121,6 +121,12 @@ func (k Keeper) BlockValidatorUpdates(ctx context.Context) ([]appmodule.Validato
 		return nil, err
 	}
 
+	// apply all the commission changes whose notice period has elapsed
+	err = k.ApplyMatureCommissionChanges(ctx, time)
+	if err != nil {
+		return nil, err
+	}
+
 	return validatorUpdates, nil
 }
 
@@ -52,4 +52,18 @@ var (
 	ErrConsensusPubKeyAlreadyUsedForValidator = errors.Register(ModuleName, 46, "consensus pubkey is already used for a validator")
 	ErrExceedingMaxConsPubKeyRotations        = errors.Register(ModuleName, 47, "exceeding maximum consensus pubkey rotations within unbonding period")
 	ErrConsensusPubKeyLenInvalid              = errors.Register(ModuleName, 48, "consensus pubkey len is invalid")
+
+	// liquid staking errors
+	ErrTokenizeShareRecordNotFound       = errors.Register(ModuleName, 49, "tokenize share record not found")
+	ErrGlobalLiquidStakingCapExceeded    = errors.Register(ModuleName, 50, "tokenization exceeds the global liquid staking cap")
+	ErrValidatorLiquidStakingCapExceeded = errors.Register(ModuleName, 51, "tokenization exceeds the validator liquid staking cap")
+	ErrTokenizeSelfDelegation            = errors.Register(ModuleName, 52, "validator operator cannot tokenize its self delegation")
+	ErrRedelegationInProgress            = errors.Register(ModuleName, 53, "delegator has a redelegation in progress to the validator")
+	ErrOnlyBondDenomAllowedForTokenize   = errors.Register(ModuleName, 54, "only the bond denom can be tokenized")
+	ErrTokenizeVestingDelegation         = errors.Register(ModuleName, 58, "tokenization exceeds the vested delegation of the vesting account")
+	ErrNotTokenizeShareRecordOwner       = errors.Register(ModuleName, 59, "not the owner of the tokenize share record")
+
+	ErrMaxValidatorRedelegationEntries = errors.Register(ModuleName, 55, "too many redelegation entries to the destination validator")
+	ErrCommissionIncreaseNotScheduled  = errors.Register(ModuleName, 56, "commission rate increases must be scheduled in advance")
+	ErrNoScheduledCommissionChange     = errors.Register(ModuleName, 57, "no commission change scheduled by the validator")
 )
@@ -2,6 +2,7 @@ package types
 
 import (
 	context "context"
+	"time"
 
 	cmtprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 
@@ -17,7 +18,7 @@ import (
 type AccountKeeper interface {
 	AddressCodec() address.Codec
 
-	GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI // only used for simulation
+	GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
 
 	GetModuleAddress(name string) sdk.AccAddress
 	GetModuleAccount(ctx context.Context, moduleName string) sdk.ModuleAccountI
@@ -26,6 +27,16 @@ type AccountKeeper interface {
 	SetModuleAccount(context.Context, sdk.ModuleAccountI)
 }
 
+// VestingAccount defines the expected interface of the vesting accounts, whose
+// delegations can only be tokenized up to their vested part.
+type VestingAccount interface {
+	sdk.AccountI
+
+	GetVestingCoins(blockTime time.Time) sdk.Coins
+	GetDelegatedFree() sdk.Coins
+	GetDelegatedVesting() sdk.Coins
+}
+
 // BankKeeper defines the expected interface needed to retrieve account balances.
 type BankKeeper interface {
 	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
@@ -35,11 +46,14 @@ type BankKeeper interface {
 
 	GetSupply(ctx context.Context, denom string) sdk.Coin
 
+	SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromModuleToModule(ctx context.Context, senderPool, recipientPool string, amt sdk.Coins) error
 	UndelegateCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	DelegateCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 
+	MintCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
 	BurnCoins(context.Context, []byte, sdk.Coins) error
 }
 
@@ -93,6 +107,11 @@ type DelegationSet interface {
 // staking keeper can call.
 
 // StakingHooks event hooks for staking validator object (noalias)
+//
+// An error returned by a hook aborts the staking operation which called it. In
+// particular, BeforeDelegationCreated and BeforeDelegationSharesModified are
+// called before any token is moved and can veto a delegation, and
+// BeforeValidatorSlashed can veto a slash, in which case nothing is slashed.
 type StakingHooks interface {
 	AfterValidatorCreated(ctx context.Context, valAddr sdk.ValAddress) error                           // Must be called when a validator is created
 	BeforeValidatorModified(ctx context.Context, valAddr sdk.ValAddress) error                         // Must be called when a validator's state changes
@@ -108,6 +127,7 @@ type StakingHooks interface {
 	BeforeValidatorSlashed(ctx context.Context, valAddr sdk.ValAddress, fraction math.LegacyDec) error
 	AfterUnbondingInitiated(ctx context.Context, id uint64) error
 	AfterConsensusPubKeyUpdate(ctx context.Context, oldPubKey, newPubKey cryptotypes.PubKey, rotationFee sdk.Coin) error
+	AfterSelfDelegationBelowMinimum(ctx context.Context, valAddr sdk.ValAddress, action MinSelfDelegationBreachAction) error // Must be called when a validator's self-delegation falls below its minimum
 }
 
 // StakingHooksWrapper is a wrapper for modules to inject StakingHooks using depinject.
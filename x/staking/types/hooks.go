@@ -126,3 +126,12 @@ func (h MultiStakingHooks) AfterConsensusPubKeyUpdate(ctx context.Context, oldPu
 	}
 	return nil
 }
+
+func (h MultiStakingHooks) AfterSelfDelegationBelowMinimum(ctx context.Context, valAddr sdk.ValAddress, action MinSelfDelegationBreachAction) error {
+	for i := range h {
+		if err := h[i].AfterSelfDelegationBelowMinimum(ctx, valAddr, action); err != nil {
+			return err
+		}
+	}
+	return nil
+}
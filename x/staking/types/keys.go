@@ -68,6 +68,17 @@ var (
 	ValidatorConsensusKeyRotationRecordIndexKey = collections.NewPrefix(104) // this key is used to restrict the validator next rotation within waiting (unbonding) period
 	NewToOldConsKeyMap                          = collections.NewPrefix(105) // prefix for rotated cons address to new cons address
 	OldToNewConsKeyMap                          = collections.NewPrefix(106) // prefix for rotated cons address to new cons address
+
+	TokenizeShareRecordKey       = collections.NewPrefix(121) // prefix for the tokenize share records
+	TokenizeShareRecordOwnerKey  = collections.NewPrefix(122) // prefix for the tokenize share records by owner index
+	TokenizeShareRecordDenomKey  = collections.NewPrefix(123) // prefix for the tokenize share records by denom index
+	LastTokenizeShareRecordIDKey = collections.NewPrefix(124) // key for the id of the last tokenize share record
+	ValidatorLiquidSharesKey     = collections.NewPrefix(125) // prefix for the tokenized delegator shares of each validator
+
+	ScheduledCommissionChangeKey      = collections.NewPrefix(126) // prefix for the commission changes scheduled by validators
+	ScheduledCommissionChangeQueueKey = collections.NewPrefix(127) // prefix for the queue of the scheduled commission changes by effective time
+
+	DelegatorLiquidSharesKey = collections.NewPrefix(128) // prefix for the tokenized delegator shares of each delegator and validator
 )
 
 // Reserved kvstore keys
@@ -0,0 +1,28 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// TokenizeShareModuleAccountName returns the name of the module account
+// holding the delegation of the tokenize share record with the given id.
+func TokenizeShareModuleAccountName(id uint64) string {
+	return fmt.Sprintf("tokenizeshare_%d", id)
+}
+
+// GetModuleAddress returns the address of the module account holding the
+// delegation of the record.
+func (r TokenizeShareRecord) GetModuleAddress() sdk.AccAddress {
+	return address.Module(r.ModuleAccount)
+}
+
+// GetShareTokenDenom returns the denom of the share tokens of the record, made
+// of the lowercase validator address and the record id.
+func (r TokenizeShareRecord) GetShareTokenDenom() string {
+	return strings.ToLower(r.Validator) + "/" + strconv.FormatUint(r.Id, 10)
+}
@@ -20,6 +20,12 @@ var (
 	_ sdk.Msg                            = &MsgBeginRedelegate{}
 	_ sdk.Msg                            = &MsgCancelUnbondingDelegation{}
 	_ sdk.Msg                            = &MsgUpdateParams{}
+	_ sdk.Msg                            = &MsgDecreaseMinSelfDelegation{}
+	_ sdk.Msg                            = &MsgTokenizeShares{}
+	_ sdk.Msg                            = &MsgRedeemTokensForShares{}
+	_ sdk.Msg                            = &MsgTransferTokenizeShareRecord{}
+	_ sdk.Msg                            = &MsgUndelegateShares{}
+	_ sdk.Msg                            = &MsgUndelegateAll{}
 )
 
 // NewMsgCreateValidator creates a new MsgCreateValidator instance.
@@ -143,6 +149,66 @@ func NewMsgCancelUnbondingDelegation(delAddr, valAddr string, creationHeight int
 	}
 }
 
+// NewMsgDecreaseMinSelfDelegation creates a new MsgDecreaseMinSelfDelegation instance.
+func NewMsgDecreaseMinSelfDelegation(valAddr string, minSelfDelegation math.Int) *MsgDecreaseMinSelfDelegation {
+	return &MsgDecreaseMinSelfDelegation{
+		ValidatorAddress:  valAddr,
+		MinSelfDelegation: minSelfDelegation,
+	}
+}
+
+// NewMsgTokenizeShares creates a new MsgTokenizeShares instance.
+func NewMsgTokenizeShares(delAddr, valAddr string, amount sdk.Coin, owner string) *MsgTokenizeShares {
+	return &MsgTokenizeShares{
+		DelegatorAddress:    delAddr,
+		ValidatorAddress:    valAddr,
+		Amount:              amount,
+		TokenizedShareOwner: owner,
+	}
+}
+
+// NewMsgRedeemTokensForShares creates a new MsgRedeemTokensForShares instance.
+func NewMsgRedeemTokensForShares(delAddr string, amount sdk.Coin) *MsgRedeemTokensForShares {
+	return &MsgRedeemTokensForShares{
+		DelegatorAddress: delAddr,
+		Amount:           amount,
+	}
+}
+
+// NewMsgTransferTokenizeShareRecord creates a new MsgTransferTokenizeShareRecord instance.
+func NewMsgTransferTokenizeShareRecord(recordID uint64, sender, newOwner string) *MsgTransferTokenizeShareRecord {
+	return &MsgTransferTokenizeShareRecord{
+		TokenizeShareRecordId: recordID,
+		Sender:                sender,
+		NewOwner:              newOwner,
+	}
+}
+
+// NewMsgUndelegateShares creates a new MsgUndelegateShares instance.
+func NewMsgUndelegateShares(delAddr, valAddr string, shares math.LegacyDec) *MsgUndelegateShares {
+	return &MsgUndelegateShares{
+		DelegatorAddress: delAddr,
+		ValidatorAddress: valAddr,
+		Shares:           shares,
+	}
+}
+
+// NewMsgUndelegateAll creates a new MsgUndelegateAll instance.
+func NewMsgUndelegateAll(delAddr, valAddr string) *MsgUndelegateAll {
+	return &MsgUndelegateAll{
+		DelegatorAddress: delAddr,
+		ValidatorAddress: valAddr,
+	}
+}
+
+// NewMsgScheduleCommissionChange creates a new MsgScheduleCommissionChange instance.
+func NewMsgScheduleCommissionChange(valAddr string, newRate math.LegacyDec) *MsgScheduleCommissionChange {
+	return &MsgScheduleCommissionChange{
+		ValidatorAddress: valAddr,
+		CommissionRate:   newRate,
+	}
+}
+
 // NewMsgRotateConsPubKey creates a new MsgRotateConsPubKey instance.
 func NewMsgRotateConsPubKey(valAddr string, pubKey cryptotypes.PubKey) (*MsgRotateConsPubKey, error) {
 	var pkAny *codectypes.Any
@@ -37,6 +37,12 @@ var (
 
 	// DefaultKeyRotationFee is fees used to rotate the ConsPubkey or Operator key
 	DefaultKeyRotationFee = sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000)
+
+	// DefaultGlobalLiquidStakingCap is set to 100%, i.e. no cap
+	DefaultGlobalLiquidStakingCap = math.LegacyOneDec()
+
+	// DefaultValidatorLiquidStakingCap is set to 100%, i.e. no cap
+	DefaultValidatorLiquidStakingCap = math.LegacyOneDec()
 )
 
 // NewParams creates a new Params instance
@@ -53,6 +59,9 @@ func NewParams(unbondingTime time.Duration,
 		BondDenom:         bondDenom,
 		MinCommissionRate: minCommissionRate,
 		KeyRotationFee:    keyRotationFee,
+
+		GlobalLiquidStakingCap:    DefaultGlobalLiquidStakingCap,
+		ValidatorLiquidStakingCap: DefaultValidatorLiquidStakingCap,
 	}
 }
 
@@ -119,6 +128,22 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateMinSelfDelegationBreachAction(p.MinSelfDelegationBreachAction); err != nil {
+		return err
+	}
+
+	if err := validateLiquidStakingCap("global", p.GlobalLiquidStakingCap); err != nil {
+		return err
+	}
+
+	if err := validateLiquidStakingCap("validator", p.ValidatorLiquidStakingCap); err != nil {
+		return err
+	}
+
+	if err := validateCommissionChangeNoticePeriod(p.CommissionChangeNoticePeriod); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -234,3 +259,38 @@ func validateKeyRotationFee(i interface{}) error {
 
 	return nil
 }
+
+func validateMinSelfDelegationBreachAction(i interface{}) error {
+	v, ok := i.(MinSelfDelegationBreachAction)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := MinSelfDelegationBreachAction_name[int32(v)]; !ok {
+		return fmt.Errorf("invalid min self delegation breach action: %d", v)
+	}
+
+	return nil
+}
+
+func validateLiquidStakingCap(name string, v math.LegacyDec) error {
+	if v.IsNil() {
+		return fmt.Errorf("%s liquid staking cap cannot be nil: %s", name, v)
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("%s liquid staking cap cannot be negative: %s", name, v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("%s liquid staking cap cannot be greater than 100%%: %s", name, v)
+	}
+
+	return nil
+}
+
+func validateCommissionChangeNoticePeriod(v time.Duration) error {
+	if v < 0 {
+		return fmt.Errorf("commission change notice period must not be negative: %d", v)
+	}
+
+	return nil
+}
@@ -46,4 +46,25 @@ func TestValidateParams(t *testing.T) {
 	// check keyRotationFee
 	params.KeyRotationFee = coinZero
 	require.Error(t, params.Validate())
+
+	// check minSelfDelegationBreachAction
+	params = types.DefaultParams()
+	params.MinSelfDelegationBreachAction = types.BreachActionForceUnbond
+	require.NoError(t, params.Validate())
+
+	params.MinSelfDelegationBreachAction = types.MinSelfDelegationBreachAction(2)
+	require.Error(t, params.Validate())
+
+	// check liquid staking caps
+	params = types.DefaultParams()
+	params.GlobalLiquidStakingCap = math.LegacyZeroDec()
+	params.ValidatorLiquidStakingCap = math.LegacyNewDecWithPrec(5, 1)
+	require.NoError(t, params.Validate())
+
+	params.GlobalLiquidStakingCap = math.LegacyNewDec(-1)
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.ValidatorLiquidStakingCap = math.LegacyNewDecWithPrec(11, 1)
+	require.Error(t, params.Validate())
 }
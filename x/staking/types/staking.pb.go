@@ -76,6 +76,39 @@ func (BondStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_64c30c6cf92913c9, []int{0}
 }
 
+// MinSelfDelegationBreachAction enumerates the actions taken when the
+// self-delegation of a validator falls below its minimum self-delegation.
+//
+// Since: cosmos-sdk 0.51
+type MinSelfDelegationBreachAction int32
+
+const (
+	// MIN_SELF_DELEGATION_BREACH_ACTION_JAIL jails the validator, which must
+	// restore its self-delegation before unjailing.
+	BreachActionJail MinSelfDelegationBreachAction = 0
+	// MIN_SELF_DELEGATION_BREACH_ACTION_FORCE_UNBOND jails the validator and
+	// unbonds the whole remaining self-delegation of its operator.
+	BreachActionForceUnbond MinSelfDelegationBreachAction = 1
+)
+
+var MinSelfDelegationBreachAction_name = map[int32]string{
+	0: "MIN_SELF_DELEGATION_BREACH_ACTION_JAIL",
+	1: "MIN_SELF_DELEGATION_BREACH_ACTION_FORCE_UNBOND",
+}
+
+var MinSelfDelegationBreachAction_value = map[string]int32{
+	"MIN_SELF_DELEGATION_BREACH_ACTION_JAIL":         0,
+	"MIN_SELF_DELEGATION_BREACH_ACTION_FORCE_UNBOND": 1,
+}
+
+func (x MinSelfDelegationBreachAction) String() string {
+	return proto.EnumName(MinSelfDelegationBreachAction_name, int32(x))
+}
+
+func (MinSelfDelegationBreachAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{1}
+}
+
 // Infraction indicates the infraction a validator committed.
 type Infraction int32
 
@@ -105,7 +138,7 @@ func (x Infraction) String() string {
 }
 
 func (Infraction) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{1}
+	return fileDescriptor_64c30c6cf92913c9, []int{2}
 }
 
 // HistoricalInfo contains header and validator information for a given block.
@@ -1001,6 +1034,34 @@ type Params struct {
 	// key_rotation_fee is fee to be spent when rotating validator's key
 	// (either consensus pubkey or operator key)
 	KeyRotationFee types1.Coin `protobuf:"bytes,7,opt,name=key_rotation_fee,json=keyRotationFee,proto3" json:"key_rotation_fee"`
+	// min_self_delegation_breach_action is the action taken when the
+	// self-delegation of a validator falls below its minimum self-delegation.
+	//
+	// Since: cosmos-sdk 0.51
+	MinSelfDelegationBreachAction MinSelfDelegationBreachAction `protobuf:"varint,8,opt,name=min_self_delegation_breach_action,json=minSelfDelegationBreachAction,proto3,enum=cosmos.staking.v1beta1.MinSelfDelegationBreachAction" json:"min_self_delegation_breach_action,omitempty"`
+	// global_liquid_staking_cap is the maximum fraction of the total bonded
+	// tokens that can be tokenized.
+	//
+	// Since: cosmos-sdk 0.51
+	GlobalLiquidStakingCap cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=global_liquid_staking_cap,json=globalLiquidStakingCap,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"global_liquid_staking_cap"`
+	// validator_liquid_staking_cap is the maximum fraction of the delegation of
+	// each delegator to a validator that can be tokenized.
+	//
+	// Since: cosmos-sdk 0.51
+	ValidatorLiquidStakingCap cosmossdk_io_math.LegacyDec `protobuf:"bytes,10,opt,name=validator_liquid_staking_cap,json=validatorLiquidStakingCap,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"validator_liquid_staking_cap"`
+	// max_validator_redelegation_entries is the max number of redelegation
+	// entries to a destination validator, across all of its delegators and source
+	// validators. Zero means there is no such limit.
+	//
+	// Since: cosmos-sdk 0.51
+	MaxValidatorRedelegationEntries uint32 `protobuf:"varint,11,opt,name=max_validator_redelegation_entries,json=maxValidatorRedelegationEntries,proto3" json:"max_validator_redelegation_entries,omitempty"`
+	// commission_change_notice_period is the time between the scheduling of a
+	// commission change with MsgScheduleCommissionChange and the block at which
+	// it takes effect. When it is not zero, commission rate increases can only be
+	// scheduled, giving advance notice of them to the delegators.
+	//
+	// Since: cosmos-sdk 0.51
+	CommissionChangeNoticePeriod time.Duration `protobuf:"bytes,12,opt,name=commission_change_notice_period,json=commissionChangeNoticePeriod,proto3,stdduration" json:"commission_change_notice_period"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -1078,6 +1139,27 @@ func (m *Params) GetKeyRotationFee() types1.Coin {
 	return types1.Coin{}
 }
 
+func (m *Params) GetMinSelfDelegationBreachAction() MinSelfDelegationBreachAction {
+	if m != nil {
+		return m.MinSelfDelegationBreachAction
+	}
+	return BreachActionJail
+}
+
+func (m *Params) GetMaxValidatorRedelegationEntries() uint32 {
+	if m != nil {
+		return m.MaxValidatorRedelegationEntries
+	}
+	return 0
+}
+
+func (m *Params) GetCommissionChangeNoticePeriod() time.Duration {
+	if m != nil {
+		return m.CommissionChangeNoticePeriod
+	}
+	return 0
+}
+
 // DelegationResponse is equivalent to Delegation except that it contains a
 // balance in addition to shares which is more suitable for client responses.
 type DelegationResponse struct {
@@ -1416,8 +1498,157 @@ func (m *ValAddrsOfRotatedConsKeys) GetAddresses() [][]byte {
 	return nil
 }
 
+// TokenizeShareRecord represents a tokenized delegation. The delegation is
+// held by the module account of the record and is represented by the share
+// tokens of the record denom.
+//
+// Since: cosmos-sdk 0.51
+type TokenizeShareRecord struct {
+	// id is the unique identifier of the record.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner is the address entitled to the rewards of the tokenized delegation.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// module_account is the name of the module account holding the delegation.
+	ModuleAccount string `protobuf:"bytes,3,opt,name=module_account,json=moduleAccount,proto3" json:"module_account,omitempty"`
+	// validator is the operator address of the validator of the delegation.
+	Validator string `protobuf:"bytes,4,opt,name=validator,proto3" json:"validator,omitempty"`
+	// delegator is the address whose delegation was tokenized, which the
+	// tokenized shares still held by the record count against for the validator
+	// liquid staking cap.
+	Delegator string `protobuf:"bytes,5,opt,name=delegator,proto3" json:"delegator,omitempty"`
+}
+
+func (m *TokenizeShareRecord) Reset()         { *m = TokenizeShareRecord{} }
+func (m *TokenizeShareRecord) String() string { return proto.CompactTextString(m) }
+func (*TokenizeShareRecord) ProtoMessage()    {}
+func (*TokenizeShareRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{24}
+}
+func (m *TokenizeShareRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenizeShareRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenizeShareRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenizeShareRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenizeShareRecord.Merge(m, src)
+}
+func (m *TokenizeShareRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenizeShareRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenizeShareRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenizeShareRecord proto.InternalMessageInfo
+
+func (m *TokenizeShareRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *TokenizeShareRecord) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *TokenizeShareRecord) GetModuleAccount() string {
+	if m != nil {
+		return m.ModuleAccount
+	}
+	return ""
+}
+
+func (m *TokenizeShareRecord) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *TokenizeShareRecord) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+// ScheduledCommissionChange defines a commission rate change announced by a
+// validator, which takes effect at the first block after its effective time.
+//
+// Since: cosmos-sdk 0.51
+type ScheduledCommissionChange struct {
+	// validator_address is the address of the validator changing its commission.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// commission_rate is the new commission rate of the validator.
+	CommissionRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=commission_rate,json=commissionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"commission_rate"`
+	// effective_time is the time from which the new commission rate applies.
+	EffectiveTime time.Time `protobuf:"bytes,3,opt,name=effective_time,json=effectiveTime,proto3,stdtime" json:"effective_time"`
+}
+
+func (m *ScheduledCommissionChange) Reset()         { *m = ScheduledCommissionChange{} }
+func (m *ScheduledCommissionChange) String() string { return proto.CompactTextString(m) }
+func (*ScheduledCommissionChange) ProtoMessage()    {}
+func (*ScheduledCommissionChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{25}
+}
+func (m *ScheduledCommissionChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledCommissionChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledCommissionChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledCommissionChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledCommissionChange.Merge(m, src)
+}
+func (m *ScheduledCommissionChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledCommissionChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledCommissionChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledCommissionChange proto.InternalMessageInfo
+
+func (m *ScheduledCommissionChange) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ScheduledCommissionChange) GetEffectiveTime() time.Time {
+	if m != nil {
+		return m.EffectiveTime
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.staking.v1beta1.BondStatus", BondStatus_name, BondStatus_value)
+	proto.RegisterEnum("cosmos.staking.v1beta1.MinSelfDelegationBreachAction", MinSelfDelegationBreachAction_name, MinSelfDelegationBreachAction_value)
 	proto.RegisterEnum("cosmos.staking.v1beta1.Infraction", Infraction_name, Infraction_value)
 	proto.RegisterType((*HistoricalInfo)(nil), "cosmos.staking.v1beta1.HistoricalInfo")
 	proto.RegisterType((*HistoricalRecord)(nil), "cosmos.staking.v1beta1.HistoricalRecord")
@@ -1443,6 +1674,8 @@ func init() {
 	proto.RegisterType((*ValidatorUpdates)(nil), "cosmos.staking.v1beta1.ValidatorUpdates")
 	proto.RegisterType((*ConsPubKeyRotationHistory)(nil), "cosmos.staking.v1beta1.ConsPubKeyRotationHistory")
 	proto.RegisterType((*ValAddrsOfRotatedConsKeys)(nil), "cosmos.staking.v1beta1.ValAddrsOfRotatedConsKeys")
+	proto.RegisterType((*TokenizeShareRecord)(nil), "cosmos.staking.v1beta1.TokenizeShareRecord")
+	proto.RegisterType((*ScheduledCommissionChange)(nil), "cosmos.staking.v1beta1.ScheduledCommissionChange")
 }
 
 func init() {
@@ -1450,138 +1683,161 @@ func init() {
 }
 
 var fileDescriptor_64c30c6cf92913c9 = []byte{
-	// 2084 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x4d, 0x6c, 0x5b, 0x49,
-	0x1d, 0xcf, 0xb3, 0x5d, 0x27, 0xf9, 0xdb, 0x89, 0x9d, 0xe9, 0x97, 0xe3, 0xee, 0xc6, 0xae, 0xb7,
-	0xb0, 0x6d, 0xa1, 0x0e, 0x2d, 0xa8, 0x87, 0x80, 0x40, 0x75, 0xec, 0x6e, 0xbd, 0x1f, 0x49, 0x78,
-	0x4e, 0xc2, 0xe7, 0xf2, 0x34, 0x7e, 0x6f, 0x6c, 0x3f, 0x62, 0xcf, 0x33, 0x6f, 0xc6, 0x6d, 0x7d,
-	0xe7, 0xb0, 0xca, 0x0a, 0x69, 0x4f, 0x80, 0x84, 0x2a, 0x2a, 0x71, 0x59, 0x6e, 0x7b, 0xa8, 0xb8,
-	0x73, 0x5b, 0x90, 0x90, 0xaa, 0x9e, 0x10, 0x12, 0x01, 0xb5, 0x87, 0xae, 0xe0, 0x82, 0x38, 0x71,
-	0x44, 0x33, 0x6f, 0xde, 0x87, 0xe3, 0xb8, 0xf9, 0xe8, 0x0a, 0xad, 0xd8, 0x4b, 0xe4, 0x99, 0xf9,
-	0xff, 0x7f, 0xef, 0xff, 0x3d, 0xf3, 0xff, 0x07, 0x2e, 0x99, 0x0e, 0xeb, 0x39, 0x6c, 0x99, 0x71,
-	0xbc, 0x63, 0xd3, 0xf6, 0xf2, 0xdd, 0xeb, 0x4d, 0xc2, 0xf1, 0x75, 0x7f, 0x5d, 0xee, 0xbb, 0x0e,
-	0x77, 0xd0, 0x39, 0x8f, 0xaa, 0xec, 0xef, 0x2a, 0xaa, 0xfc, 0x99, 0xb6, 0xd3, 0x76, 0x24, 0xc9,
-	0xb2, 0xf8, 0xe5, 0x51, 0xe7, 0x17, 0xdb, 0x8e, 0xd3, 0xee, 0x92, 0x65, 0xb9, 0x6a, 0x0e, 0x5a,
-	0xcb, 0x98, 0x0e, 0xd5, 0xd1, 0xd2, 0xfe, 0x23, 0x6b, 0xe0, 0x62, 0x6e, 0x3b, 0x54, 0x9d, 0x17,
-	0xf6, 0x9f, 0x73, 0xbb, 0x47, 0x18, 0xc7, 0xbd, 0xbe, 0x8f, 0xed, 0x49, 0x62, 0x78, 0x1f, 0x55,
-	0x62, 0x29, 0x6c, 0xa5, 0x4a, 0x13, 0x33, 0x12, 0xe8, 0x61, 0x3a, 0xb6, 0x8f, 0xbd, 0x80, 0x7b,
-	0x36, 0x75, 0x96, 0xe5, 0x5f, 0xb5, 0xf5, 0x0a, 0x27, 0xd4, 0x22, 0x6e, 0xcf, 0xa6, 0x7c, 0x99,
-	0x0f, 0xfb, 0x84, 0x79, 0x7f, 0xd5, 0xe9, 0x85, 0xc8, 0x29, 0x6e, 0x9a, 0x76, 0xf4, 0xb0, 0xf4,
-	0x0b, 0x0d, 0xe6, 0xef, 0xd8, 0x8c, 0x3b, 0xae, 0x6d, 0xe2, 0x6e, 0x9d, 0xb6, 0x1c, 0xf4, 0x75,
-	0x48, 0x76, 0x08, 0xb6, 0x88, 0x9b, 0xd3, 0x8a, 0xda, 0xe5, 0xd4, 0x8d, 0x5c, 0x39, 0x04, 0x28,
-	0x7b, 0xbc, 0x77, 0xe4, 0x79, 0x65, 0xf6, 0xe3, 0xbd, 0xc2, 0xd4, 0x87, 0xcf, 0x3f, 0xba, 0xaa,
-	0xe9, 0x8a, 0x05, 0x55, 0x21, 0x79, 0x17, 0x77, 0x19, 0xe1, 0xb9, 0x58, 0x31, 0x7e, 0x39, 0x75,
-	0xe3, 0x62, 0xf9, 0x60, 0x9b, 0x97, 0xb7, 0x71, 0xd7, 0xb6, 0x30, 0x77, 0x46, 0x51, 0x3c, 0xde,
-	0x95, 0x58, 0x4e, 0x2b, 0xbd, 0xaf, 0x41, 0x36, 0x94, 0x4c, 0x27, 0xa6, 0xe3, 0x5a, 0x28, 0x07,
-	0xd3, 0xb8, 0xdf, 0xef, 0x60, 0xd6, 0x91, 0xc2, 0xa5, 0x75, 0x7f, 0x89, 0xbe, 0x06, 0x09, 0x61,
-	0xe4, 0x5c, 0x4c, 0xca, 0x9c, 0x2f, 0x7b, 0x1e, 0x28, 0xfb, 0x1e, 0x28, 0x6f, 0xfa, 0x1e, 0xa8,
-	0x24, 0x3e, 0xf8, 0x5b, 0x41, 0xd3, 0x25, 0x35, 0x7a, 0x1d, 0x32, 0x77, 0x7d, 0x41, 0x98, 0x21,
-	0x71, 0xe3, 0x12, 0x77, 0x3e, 0xdc, 0xbe, 0x83, 0x59, 0xa7, 0xf4, 0xf3, 0x18, 0x64, 0x56, 0x9d,
-	0x5e, 0xcf, 0x66, 0xcc, 0x76, 0xa8, 0x8e, 0x39, 0x61, 0xe8, 0x4d, 0x48, 0xb8, 0x98, 0x13, 0x29,
-	0xc9, 0x6c, 0xe5, 0xa6, 0x50, 0xe3, 0x2f, 0x7b, 0x85, 0x0b, 0x9e, 0xc2, 0xcc, 0xda, 0x29, 0xdb,
-	0xce, 0x72, 0x0f, 0xf3, 0x4e, 0xf9, 0x6d, 0xd2, 0xc6, 0xe6, 0xb0, 0x4a, 0xcc, 0x27, 0x8f, 0xae,
-	0x81, 0xb2, 0x47, 0x95, 0x98, 0x9e, 0xce, 0x12, 0x03, 0x7d, 0x1b, 0x66, 0x7a, 0xf8, 0xbe, 0x21,
-	0xf1, 0x62, 0x2f, 0x85, 0x37, 0xdd, 0xc3, 0xf7, 0x85, 0x7c, 0xe8, 0x47, 0x90, 0x11, 0x90, 0x66,
-	0x07, 0xd3, 0x36, 0xf1, 0x90, 0xe3, 0x2f, 0x85, 0x3c, 0xd7, 0xc3, 0xf7, 0x57, 0x25, 0x9a, 0xc0,
-	0x5f, 0x49, 0x7c, 0xf2, 0xb0, 0xa0, 0x95, 0x7e, 0xaf, 0x01, 0x84, 0x86, 0x41, 0x18, 0xb2, 0x66,
-	0xb0, 0x92, 0x1f, 0x65, 0x2a, 0x8c, 0x5e, 0x9f, 0x14, 0x09, 0xfb, 0xcc, 0x5a, 0x99, 0x13, 0xe2,
-	0x3d, 0xde, 0x2b, 0x68, 0xde, 0x57, 0x33, 0xe6, 0x98, 0xd9, 0x53, 0x83, 0xbe, 0x85, 0x39, 0x31,
-	0x8e, 0xe8, 0x70, 0x09, 0x28, 0x9c, 0xee, 0x01, 0x82, 0xc7, 0x2d, 0xce, 0x95, 0x0e, 0x1f, 0x6a,
-	0x90, 0xaa, 0x12, 0x66, 0xba, 0x76, 0x5f, 0x24, 0xb1, 0x88, 0xb2, 0x9e, 0x43, 0xed, 0x1d, 0x95,
-	0x02, 0xb3, 0xba, 0xbf, 0x44, 0x79, 0x98, 0xb1, 0x2d, 0x42, 0xb9, 0xcd, 0x87, 0x9e, 0x9b, 0xf4,
-	0x60, 0x2d, 0xb8, 0xee, 0x91, 0x26, 0xb3, 0x7d, 0x3b, 0xeb, 0xfe, 0x12, 0x5d, 0x81, 0x2c, 0x23,
-	0xe6, 0xc0, 0xb5, 0xf9, 0xd0, 0x30, 0x1d, 0xca, 0xb1, 0xc9, 0x73, 0x09, 0x49, 0x92, 0xf1, 0xf7,
-	0x57, 0xbd, 0x6d, 0x01, 0x62, 0x11, 0x8e, 0xed, 0x2e, 0xcb, 0x9d, 0xf2, 0x40, 0xd4, 0x52, 0x89,
-	0xba, 0x3b, 0x0d, 0xb3, 0x41, 0xea, 0xa0, 0x55, 0xc8, 0x3a, 0x7d, 0xe2, 0x8a, 0xdf, 0x06, 0xb6,
-	0x2c, 0x97, 0x30, 0xa6, 0xa2, 0x31, 0xf7, 0xe4, 0xd1, 0xb5, 0x33, 0xca, 0xe0, 0xb7, 0xbc, 0x93,
-	0x06, 0x77, 0x6d, 0xda, 0xd6, 0x33, 0x3e, 0x87, 0xda, 0x46, 0xdf, 0x13, 0x2e, 0xa3, 0x8c, 0x50,
-	0x36, 0x60, 0x46, 0x7f, 0xd0, 0xdc, 0x21, 0x43, 0x65, 0xd4, 0x33, 0x63, 0x46, 0xbd, 0x45, 0x87,
-	0x95, 0xdc, 0x1f, 0x43, 0x68, 0xd3, 0x1d, 0xf6, 0xb9, 0x53, 0xde, 0x18, 0x34, 0xdf, 0x22, 0x43,
-	0xe1, 0x2a, 0x85, 0xb3, 0x21, 0x61, 0xd0, 0x39, 0x48, 0xfe, 0x18, 0xdb, 0x5d, 0x62, 0x49, 0x8b,
-	0xcc, 0xe8, 0x6a, 0x85, 0x56, 0x20, 0xc9, 0x38, 0xe6, 0x03, 0x26, 0xcd, 0x30, 0x7f, 0xa3, 0x34,
-	0x29, 0x36, 0x2a, 0x0e, 0xb5, 0x1a, 0x92, 0x52, 0x57, 0x1c, 0x68, 0x15, 0x92, 0xdc, 0xd9, 0x21,
-	0x54, 0x19, 0xa8, 0xf2, 0x25, 0x15, 0xcd, 0x67, 0xc7, 0xa3, 0xb9, 0x4e, 0x79, 0x24, 0x8e, 0xeb,
-	0x94, 0xeb, 0x8a, 0x15, 0xfd, 0x10, 0xb2, 0x16, 0xe9, 0x92, 0xb6, 0xb4, 0x1c, 0xeb, 0x60, 0x97,
-	0xb0, 0x5c, 0x52, 0xc2, 0x5d, 0x3f, 0x76, 0x72, 0xe8, 0x99, 0x00, 0xaa, 0x21, 0x91, 0xd0, 0x06,
-	0xa4, 0xac, 0x30, 0x9c, 0x72, 0xd3, 0xd2, 0x98, 0xaf, 0x4d, 0xd2, 0x31, 0x12, 0x79, 0xd1, 0x5a,
-	0x18, 0x85, 0x10, 0x11, 0x34, 0xa0, 0x4d, 0x87, 0x5a, 0x36, 0x6d, 0x1b, 0x1d, 0x62, 0xb7, 0x3b,
-	0x3c, 0x37, 0x53, 0xd4, 0x2e, 0xc7, 0xf5, 0x4c, 0xb0, 0x7f, 0x47, 0x6e, 0xa3, 0x0d, 0x98, 0x0f,
-	0x49, 0x65, 0x86, 0xcc, 0x1e, 0x37, 0x43, 0xe6, 0x02, 0x00, 0x41, 0x82, 0xde, 0x01, 0x08, 0x73,
-	0x30, 0x07, 0x12, 0xad, 0x74, 0x78, 0x36, 0x47, 0x95, 0x89, 0x00, 0xa0, 0x1f, 0xc0, 0xe9, 0x9e,
-	0x4d, 0x0d, 0x46, 0xba, 0x2d, 0x43, 0x59, 0x4e, 0xe0, 0xa6, 0x8e, 0xef, 0xcd, 0x85, 0x9e, 0x4d,
-	0x1b, 0xa4, 0xdb, 0xaa, 0x06, 0x28, 0xe8, 0x1b, 0x70, 0x21, 0xd4, 0xde, 0xa1, 0x46, 0xc7, 0xe9,
-	0x5a, 0x86, 0x4b, 0x5a, 0x86, 0xe9, 0x0c, 0x28, 0xcf, 0xa5, 0xa5, 0xcd, 0xce, 0x07, 0x24, 0xeb,
-	0xf4, 0x8e, 0xd3, 0xb5, 0x74, 0xd2, 0x5a, 0x15, 0xc7, 0xe8, 0x35, 0x08, 0x55, 0x37, 0x6c, 0x8b,
-	0xe5, 0xe6, 0x8a, 0xf1, 0xcb, 0x09, 0x3d, 0x1d, 0x6c, 0xd6, 0x2d, 0xb6, 0x32, 0xf3, 0xde, 0xc3,
-	0xc2, 0xd4, 0x27, 0x0f, 0x0b, 0x53, 0xa5, 0xdb, 0x90, 0xde, 0xc6, 0x5d, 0x95, 0x47, 0x84, 0xa1,
-	0x9b, 0x30, 0x8b, 0xfd, 0x45, 0x4e, 0x2b, 0xc6, 0x5f, 0x98, 0x87, 0x21, 0x69, 0xe9, 0xb7, 0x1a,
-	0x24, 0xab, 0xdb, 0x1b, 0xd8, 0x76, 0x51, 0x0d, 0x16, 0xc2, 0xc0, 0x3c, 0x6a, 0x4a, 0x87, 0xb1,
-	0xec, 0xe7, 0xf4, 0x1a, 0x2c, 0x04, 0x17, 0x58, 0x00, 0xe3, 0xdd, 0x2b, 0x17, 0x9f, 0x3c, 0xba,
-	0xf6, 0xaa, 0x82, 0x09, 0x2a, 0xc9, 0x3e, 0xbc, 0xbb, 0xfb, 0xf6, 0x23, 0x3a, 0xbf, 0x09, 0xd3,
-	0x9e, 0xa8, 0x0c, 0x7d, 0x0b, 0x4e, 0xf5, 0xc5, 0x0f, 0xa9, 0x6a, 0xea, 0xc6, 0xd2, 0xc4, 0x00,
-	0x97, 0xf4, 0xd1, 0x70, 0xf0, 0xf8, 0x4a, 0xef, 0xc7, 0x00, 0xaa, 0xdb, 0xdb, 0x9b, 0xae, 0xdd,
-	0xef, 0x12, 0xfe, 0x69, 0xe9, 0xbe, 0x05, 0x67, 0x43, 0xdd, 0x99, 0x6b, 0x1e, 0x5f, 0xff, 0xd3,
-	0x01, 0x7f, 0xc3, 0x35, 0x0f, 0x84, 0xb5, 0x18, 0x0f, 0x60, 0xe3, 0xc7, 0x87, 0xad, 0x32, 0x3e,
-	0x6e, 0xd9, 0xef, 0x42, 0x2a, 0x34, 0x06, 0x43, 0x75, 0x98, 0xe1, 0xea, 0xb7, 0x32, 0x70, 0x69,
-	0xb2, 0x81, 0x7d, 0xb6, 0xa8, 0x91, 0x03, 0xf6, 0xd2, 0x7f, 0x34, 0x80, 0x48, 0x8e, 0x7c, 0x36,
-	0x63, 0x0c, 0xd5, 0x21, 0xa9, 0x2a, 0x71, 0xfc, 0xa4, 0x95, 0x58, 0x01, 0x44, 0x8c, 0xfa, 0xb3,
-	0x18, 0x9c, 0xde, 0xf2, 0xb3, 0xf7, 0xb3, 0x6f, 0x83, 0x2d, 0x98, 0x26, 0x94, 0xbb, 0xb6, 0x34,
-	0x82, 0xf0, 0xf9, 0x57, 0x26, 0xf9, 0xfc, 0x00, 0xa5, 0x6a, 0x94, 0xbb, 0xc3, 0x68, 0x04, 0xf8,
-	0x58, 0x11, 0x7b, 0xfc, 0x2a, 0x0e, 0xb9, 0x49, 0xac, 0xe2, 0x35, 0x6c, 0xba, 0x44, 0x6e, 0xf8,
-	0x97, 0x8c, 0x26, 0x0b, 0xe6, 0xbc, 0xbf, 0xad, 0xee, 0x18, 0x1d, 0xc4, 0xab, 0x4c, 0x04, 0x97,
-	0x20, 0x3d, 0xd9, 0x33, 0x6c, 0x3e, 0x44, 0x90, 0xb7, 0xcc, 0x26, 0x64, 0x6c, 0x6a, 0x73, 0x1b,
-	0x77, 0x8d, 0x26, 0xee, 0x62, 0x6a, 0xfa, 0xcf, 0xd5, 0x63, 0x5d, 0x09, 0xf3, 0x0a, 0xa3, 0xe2,
-	0x41, 0xa0, 0x1a, 0x4c, 0xfb, 0x68, 0x89, 0xe3, 0xa3, 0xf9, 0xbc, 0xe8, 0x22, 0xa4, 0xa3, 0x17,
-	0x83, 0x7c, 0x7a, 0x24, 0xf4, 0x54, 0xe4, 0x5e, 0x38, 0xec, 0xe6, 0x49, 0xbe, 0xf0, 0xe6, 0x51,
-	0xaf, 0xbb, 0x5f, 0xc7, 0x61, 0x41, 0x27, 0xd6, 0xff, 0xbf, 0x5b, 0x36, 0x00, 0xbc, 0x54, 0x15,
-	0x95, 0x54, 0x79, 0xe6, 0x04, 0xf9, 0x3e, 0xeb, 0x81, 0x54, 0x19, 0xff, 0x5f, 0x79, 0xe8, 0xaf,
-	0x31, 0x48, 0x47, 0x3d, 0xf4, 0xb9, 0xbc, 0xb4, 0xd0, 0x5a, 0x58, 0xa6, 0x12, 0xb2, 0x4c, 0x5d,
-	0x99, 0x54, 0xa6, 0xc6, 0xa2, 0xf9, 0x90, 0xfa, 0xf4, 0x3c, 0x0e, 0xc9, 0x0d, 0xec, 0xe2, 0x1e,
-	0x43, 0xeb, 0x63, 0x0f, 0x59, 0xaf, 0x91, 0x5c, 0x1c, 0x0b, 0xe6, 0xaa, 0x9a, 0xbe, 0x78, 0xb1,
-	0xfc, 0xcb, 0x49, 0xef, 0xd8, 0x2f, 0xc0, 0xbc, 0x68, 0x88, 0xc3, 0xce, 0x5e, 0x1a, 0x77, 0x4e,
-	0xf6, 0xb5, 0x81, 0xf6, 0x0c, 0x15, 0x20, 0x25, 0xc8, 0xc2, 0x3a, 0x2c, 0x68, 0xa0, 0x87, 0xef,
-	0xd7, 0xbc, 0x1d, 0x74, 0x0d, 0x50, 0x27, 0x18, 0x4c, 0x18, 0xa1, 0x21, 0x04, 0xdd, 0x42, 0x78,
-	0xe2, 0x93, 0xbf, 0x0a, 0x20, 0xa4, 0x30, 0x2c, 0x42, 0x9d, 0x9e, 0xea, 0xea, 0x66, 0xc5, 0x4e,
-	0x55, 0x6c, 0xa0, 0x9f, 0x6a, 0xde, 0x7b, 0x78, 0x5f, 0xdb, 0xac, 0xda, 0x91, 0xcd, 0x23, 0x24,
-	0xc5, 0xbf, 0xf7, 0x0a, 0xf9, 0x21, 0xee, 0x75, 0x57, 0x4a, 0x07, 0xe0, 0x94, 0x0e, 0xea, 0xe4,
-	0xc5, 0xc3, 0x79, 0xb4, 0xed, 0x46, 0x75, 0xc8, 0xee, 0x90, 0xa1, 0xe1, 0x3a, 0xdc, 0x2b, 0x34,
-	0x2d, 0x42, 0x54, 0xe3, 0xb2, 0xe8, 0xfb, 0xb6, 0x89, 0x19, 0x89, 0xbc, 0xf3, 0x6d, 0x5a, 0x49,
-	0x08, 0xe9, 0xf4, 0xf9, 0x1d, 0x32, 0xd4, 0x15, 0xdf, 0x6d, 0x42, 0x56, 0x2e, 0x89, 0x4c, 0xd9,
-	0x7d, 0xfe, 0xd1, 0x55, 0x25, 0xf4, 0x35, 0x66, 0xed, 0x2c, 0xdf, 0x0f, 0x66, 0x73, 0x9e, 0x7b,
-	0xc5, 0xa3, 0x17, 0x85, 0x17, 0x90, 0x4e, 0x58, 0x5f, 0x34, 0x8f, 0xa2, 0xd9, 0x88, 0x34, 0x05,
-	0xda, 0x8b, 0x9b, 0x8d, 0x90, 0x7f, 0xa4, 0xd9, 0x88, 0xa4, 0xe7, 0x37, 0xc3, 0xfa, 0x1f, 0x3b,
-	0x4c, 0x9b, 0x68, 0x64, 0x2a, 0x26, 0x99, 0xf5, 0x53, 0xa5, 0x3f, 0x69, 0xb0, 0x38, 0x16, 0xc9,
-	0x81, 0xc8, 0x26, 0x20, 0x37, 0x72, 0x28, 0x23, 0x62, 0xa8, 0x44, 0x3f, 0x59, 0x62, 0x2c, 0xb8,
-	0x63, 0x97, 0xc0, 0xa7, 0x73, 0x91, 0xa9, 0x2a, 0xf6, 0x07, 0x0d, 0xce, 0x44, 0x05, 0x08, 0x54,
-	0x69, 0x40, 0x3a, 0xfa, 0x69, 0xa5, 0xc4, 0xa5, 0xa3, 0x28, 0x11, 0x95, 0x7f, 0x04, 0x04, 0x6d,
-	0x87, 0xd5, 0xc2, 0x1b, 0x0a, 0x5e, 0x3f, 0xb2, 0x51, 0x7c, 0xc1, 0x0e, 0xac, 0x1a, 0x9e, 0x6f,
-	0xfe, 0xa9, 0x41, 0x62, 0xc3, 0x71, 0xba, 0xe8, 0x27, 0xb0, 0x40, 0x1d, 0x6e, 0x88, 0xcc, 0x22,
-	0x96, 0xa1, 0x66, 0x04, 0x5e, 0x25, 0xae, 0xbd, 0xd0, 0x56, 0xff, 0xd8, 0x2b, 0x8c, 0x73, 0x8e,
-	0x1a, 0x50, 0x8d, 0xa2, 0xa8, 0xc3, 0x2b, 0x92, 0x68, 0xd3, 0x1b, 0x23, 0xb4, 0x60, 0x6e, 0xf4,
-	0x73, 0x5e, 0xb5, 0xbe, 0x75, 0xd8, 0xe7, 0xe6, 0x0e, 0xfd, 0x54, 0xba, 0x19, 0xf9, 0xce, 0xca,
-	0x8c, 0xf0, 0xda, 0xbf, 0x84, 0xe7, 0xde, 0x85, 0x6c, 0x50, 0xaa, 0xb6, 0xe4, 0x1c, 0x8b, 0x89,
-	0xd0, 0xf0, 0x46, 0x5a, 0x7e, 0xa3, 0x50, 0x8c, 0x4e, 0x6c, 0x71, 0xd3, 0xb4, 0xcb, 0xfb, 0x78,
-	0x46, 0xcc, 0xa9, 0x78, 0xe5, 0xd0, 0xf5, 0x71, 0x0c, 0x16, 0x57, 0x1d, 0xca, 0xd4, 0x40, 0x47,
-	0x25, 0xb5, 0x37, 0x86, 0x1d, 0xa2, 0x2b, 0x13, 0xc6, 0x4d, 0xe9, 0xf1, 0xa1, 0xd2, 0x36, 0x64,
-	0xc4, 0xed, 0x6a, 0x3a, 0xf4, 0x25, 0x67, 0x4a, 0x73, 0x4e, 0xd7, 0x52, 0x12, 0xed, 0x90, 0xa1,
-	0xc0, 0xa5, 0xe4, 0xde, 0x08, 0x6e, 0xfc, 0x64, 0xb8, 0x94, 0xdc, 0x8b, 0xe0, 0x9e, 0x83, 0xa4,
-	0x7a, 0x5a, 0x25, 0xe4, 0xc3, 0x41, 0xad, 0xd0, 0x4d, 0x88, 0x8b, 0x4a, 0x78, 0xea, 0x18, 0xb5,
-	0x43, 0x30, 0x44, 0x6e, 0xb4, 0x06, 0x2c, 0xaa, 0x21, 0x01, 0x5b, 0x6f, 0x49, 0x8b, 0x12, 0xa9,
-	0xd0, 0x5b, 0x64, 0x78, 0xc0, 0xc4, 0x20, 0x7d, 0xa4, 0x89, 0xc1, 0xd5, 0xdf, 0x69, 0x00, 0xe1,
-	0x6c, 0x0c, 0x7d, 0x19, 0xce, 0x57, 0xd6, 0xd7, 0xaa, 0x46, 0x63, 0xf3, 0xd6, 0xe6, 0x56, 0xc3,
-	0xd8, 0x5a, 0x6b, 0x6c, 0xd4, 0x56, 0xeb, 0xb7, 0xeb, 0xb5, 0x6a, 0x76, 0x2a, 0x9f, 0xd9, 0x7d,
-	0x50, 0x4c, 0x6d, 0x51, 0xd6, 0x27, 0xa6, 0xdd, 0xb2, 0x89, 0x85, 0xbe, 0x08, 0x67, 0x46, 0xa9,
-	0xc5, 0xaa, 0x56, 0xcd, 0x6a, 0xf9, 0xf4, 0xee, 0x83, 0xe2, 0x8c, 0xd7, 0x1e, 0x10, 0x0b, 0x5d,
-	0x86, 0xb3, 0xe3, 0x74, 0xf5, 0xb5, 0x37, 0xb2, 0xb1, 0xfc, 0xdc, 0xee, 0x83, 0xe2, 0x6c, 0xd0,
-	0x47, 0xa0, 0x12, 0xa0, 0x28, 0xa5, 0xc2, 0x8b, 0xe7, 0x61, 0xf7, 0x41, 0x31, 0xe9, 0x65, 0x4c,
-	0x3e, 0xf1, 0xde, 0x6f, 0x96, 0xa6, 0xae, 0xbe, 0x0b, 0x50, 0xa7, 0x2d, 0x17, 0x9b, 0xb2, 0x32,
-	0xe4, 0xe1, 0x5c, 0x7d, 0xed, 0xb6, 0x7e, 0x6b, 0x75, 0xb3, 0xbe, 0xbe, 0x36, 0x2a, 0xf6, 0xbe,
-	0xb3, 0xea, 0xfa, 0x56, 0xe5, 0xed, 0x9a, 0xd1, 0xa8, 0xbf, 0xb1, 0x96, 0xd5, 0xd0, 0x79, 0x38,
-	0x3d, 0x72, 0xf6, 0x9d, 0xb5, 0xcd, 0xfa, 0x3b, 0xb5, 0x6c, 0xac, 0x72, 0xf3, 0xe3, 0xa7, 0x4b,
-	0xda, 0xe3, 0xa7, 0x4b, 0xda, 0xdf, 0x9f, 0x2e, 0x69, 0x1f, 0x3c, 0x5b, 0x9a, 0x7a, 0xfc, 0x6c,
-	0x69, 0xea, 0xcf, 0xcf, 0x96, 0xa6, 0xbe, 0xff, 0xca, 0x48, 0x2e, 0x86, 0xb7, 0x91, 0xfc, 0x87,
-	0x46, 0x33, 0x29, 0xa3, 0xe6, 0xab, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x97, 0xca, 0xc1, 0x71,
-	0x48, 0x1a, 0x00, 0x00,
+	// 2453 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x4d, 0x8c, 0x5b, 0x57,
+	0xf5, 0x9f, 0x67, 0x3b, 0x9e, 0x99, 0x63, 0x8f, 0xed, 0xb9, 0x49, 0x13, 0x8f, 0x93, 0x8c, 0x1d,
+	0xb7, 0xff, 0x36, 0xcd, 0x9f, 0x78, 0x48, 0x80, 0x2c, 0x06, 0x44, 0x19, 0x7f, 0x4c, 0xe3, 0x34,
+	0x99, 0x19, 0x9e, 0x67, 0x86, 0xcf, 0xf2, 0x74, 0xfd, 0xde, 0xb5, 0xfd, 0x18, 0xfb, 0x3d, 0xf7,
+	0xdd, 0xe7, 0x24, 0x66, 0xc3, 0x86, 0x45, 0x35, 0x15, 0x52, 0xc5, 0x02, 0x90, 0x50, 0xa0, 0x12,
+	0x9b, 0xb2, 0xeb, 0xa2, 0x42, 0x6c, 0xd9, 0x15, 0x24, 0xa4, 0xa8, 0x2b, 0x84, 0x44, 0x8a, 0x9a,
+	0x45, 0x2b, 0xd8, 0x20, 0x56, 0x48, 0x6c, 0xd0, 0xfd, 0x78, 0x1f, 0xb6, 0xe7, 0x7b, 0x2a, 0x54,
+	0xc1, 0xc6, 0xf2, 0xbd, 0xf7, 0x9c, 0xdf, 0x3d, 0xe7, 0xdc, 0x73, 0xce, 0x3d, 0xf7, 0x3c, 0x78,
+	0x4e, 0xb7, 0x69, 0xcf, 0xa6, 0x4b, 0xd4, 0xc5, 0x3b, 0xa6, 0xd5, 0x5e, 0xba, 0x7f, 0xa3, 0x49,
+	0x5c, 0x7c, 0xc3, 0x1b, 0x97, 0xfa, 0x8e, 0xed, 0xda, 0xe8, 0xbc, 0xa0, 0x2a, 0x79, 0xb3, 0x92,
+	0x2a, 0x77, 0xae, 0x6d, 0xb7, 0x6d, 0x4e, 0xb2, 0xc4, 0xfe, 0x09, 0xea, 0xdc, 0x42, 0xdb, 0xb6,
+	0xdb, 0x5d, 0xb2, 0xc4, 0x47, 0xcd, 0x41, 0x6b, 0x09, 0x5b, 0x43, 0xb9, 0xb4, 0x38, 0xbe, 0x64,
+	0x0c, 0x1c, 0xec, 0x9a, 0xb6, 0x25, 0xd7, 0xf3, 0xe3, 0xeb, 0xae, 0xd9, 0x23, 0xd4, 0xc5, 0xbd,
+	0xbe, 0x87, 0x2d, 0x24, 0xd1, 0xc4, 0xa6, 0x52, 0x2c, 0x89, 0x2d, 0x55, 0x69, 0x62, 0x4a, 0x7c,
+	0x3d, 0x74, 0xdb, 0xf4, 0xb0, 0xe7, 0x71, 0xcf, 0xb4, 0xec, 0x25, 0xfe, 0x2b, 0xa7, 0x2e, 0xb9,
+	0xc4, 0x32, 0x88, 0xd3, 0x33, 0x2d, 0x77, 0xc9, 0x1d, 0xf6, 0x09, 0x15, 0xbf, 0x72, 0xf5, 0x62,
+	0x68, 0x15, 0x37, 0x75, 0x33, 0xbc, 0x58, 0xfc, 0x89, 0x02, 0xa9, 0xdb, 0x26, 0x75, 0x6d, 0xc7,
+	0xd4, 0x71, 0xb7, 0x6e, 0xb5, 0x6c, 0xf4, 0x45, 0x88, 0x77, 0x08, 0x36, 0x88, 0x93, 0x55, 0x0a,
+	0xca, 0xd5, 0xc4, 0xcd, 0x6c, 0x29, 0x00, 0x28, 0x09, 0xde, 0xdb, 0x7c, 0xbd, 0x3c, 0xfb, 0xde,
+	0x93, 0xfc, 0xd4, 0xdb, 0x1f, 0xbd, 0x73, 0x4d, 0x51, 0x25, 0x0b, 0xaa, 0x42, 0xfc, 0x3e, 0xee,
+	0x52, 0xe2, 0x66, 0x23, 0x85, 0xe8, 0xd5, 0xc4, 0xcd, 0x2b, 0xa5, 0xbd, 0x6d, 0x5e, 0xda, 0xc6,
+	0x5d, 0xd3, 0xc0, 0xae, 0x3d, 0x8a, 0x22, 0x78, 0x97, 0x23, 0x59, 0xa5, 0xf8, 0x86, 0x02, 0x99,
+	0x40, 0x32, 0x95, 0xe8, 0xb6, 0x63, 0xa0, 0x2c, 0x4c, 0xe3, 0x7e, 0xbf, 0x83, 0x69, 0x87, 0x0b,
+	0x97, 0x54, 0xbd, 0x21, 0xfa, 0x3c, 0xc4, 0x98, 0x91, 0xb3, 0x11, 0x2e, 0x73, 0xae, 0x24, 0x4e,
+	0xa0, 0xe4, 0x9d, 0x40, 0x69, 0xd3, 0x3b, 0x81, 0x72, 0xec, 0xcd, 0x0f, 0xf2, 0x8a, 0xca, 0xa9,
+	0xd1, 0x0b, 0x90, 0xbe, 0xef, 0x09, 0x42, 0x35, 0x8e, 0x1b, 0xe5, 0xb8, 0xa9, 0x60, 0xfa, 0x36,
+	0xa6, 0x9d, 0xe2, 0x8f, 0x23, 0x90, 0xae, 0xd8, 0xbd, 0x9e, 0x49, 0xa9, 0x69, 0x5b, 0x2a, 0x76,
+	0x09, 0x45, 0x77, 0x20, 0xe6, 0x60, 0x97, 0x70, 0x49, 0x66, 0xcb, 0xb7, 0x98, 0x1a, 0x7f, 0x7a,
+	0x92, 0xbf, 0x28, 0x14, 0xa6, 0xc6, 0x4e, 0xc9, 0xb4, 0x97, 0x7a, 0xd8, 0xed, 0x94, 0xee, 0x92,
+	0x36, 0xd6, 0x87, 0x55, 0xa2, 0xbf, 0xff, 0xee, 0x75, 0x90, 0xf6, 0xa8, 0x12, 0x5d, 0xe8, 0xcc,
+	0x31, 0xd0, 0x57, 0x61, 0xa6, 0x87, 0x1f, 0x6a, 0x1c, 0x2f, 0x72, 0x2a, 0xbc, 0xe9, 0x1e, 0x7e,
+	0xc8, 0xe4, 0x43, 0xdf, 0x81, 0x34, 0x83, 0xd4, 0x3b, 0xd8, 0x6a, 0x13, 0x81, 0x1c, 0x3d, 0x15,
+	0xf2, 0x5c, 0x0f, 0x3f, 0xac, 0x70, 0x34, 0x86, 0xbf, 0x1c, 0xfb, 0xf8, 0xad, 0xbc, 0x52, 0xfc,
+	0xad, 0x02, 0x10, 0x18, 0x06, 0x61, 0xc8, 0xe8, 0xfe, 0x88, 0x6f, 0x4a, 0xa5, 0x1b, 0xbd, 0xb0,
+	0x9f, 0x27, 0x8c, 0x99, 0xb5, 0x3c, 0xc7, 0xc4, 0x7b, 0xfc, 0x24, 0xaf, 0x88, 0x5d, 0xd3, 0xfa,
+	0x84, 0xd9, 0x13, 0x83, 0xbe, 0x81, 0x5d, 0xa2, 0x1d, 0xf1, 0xc0, 0x39, 0xe0, 0x9b, 0x1f, 0x78,
+	0x80, 0x20, 0xb8, 0xd9, 0xba, 0xd4, 0xe1, 0x6d, 0x05, 0x12, 0x55, 0x42, 0x75, 0xc7, 0xec, 0xb3,
+	0x20, 0x66, 0x5e, 0xd6, 0xb3, 0x2d, 0x73, 0x47, 0x86, 0xc0, 0xac, 0xea, 0x0d, 0x51, 0x0e, 0x66,
+	0x4c, 0x83, 0x58, 0xae, 0xe9, 0x0e, 0xc5, 0x31, 0xa9, 0xfe, 0x98, 0x71, 0x3d, 0x20, 0x4d, 0x6a,
+	0x7a, 0x76, 0x56, 0xbd, 0x21, 0x7a, 0x11, 0x32, 0x94, 0xe8, 0x03, 0xc7, 0x74, 0x87, 0x9a, 0x6e,
+	0x5b, 0x2e, 0xd6, 0xdd, 0x6c, 0x8c, 0x93, 0xa4, 0xbd, 0xf9, 0x8a, 0x98, 0x66, 0x20, 0x06, 0x71,
+	0xb1, 0xd9, 0xa5, 0xd9, 0x33, 0x02, 0x44, 0x0e, 0xa5, 0xa8, 0xbb, 0xd3, 0x30, 0xeb, 0x87, 0x0e,
+	0xaa, 0x40, 0xc6, 0xee, 0x13, 0x87, 0xfd, 0xd7, 0xb0, 0x61, 0x38, 0x84, 0x52, 0xe9, 0x8d, 0xd9,
+	0xf7, 0xdf, 0xbd, 0x7e, 0x4e, 0x1a, 0x7c, 0x45, 0xac, 0x34, 0x5c, 0xc7, 0xb4, 0xda, 0x6a, 0xda,
+	0xe3, 0x90, 0xd3, 0xe8, 0x1b, 0xec, 0xc8, 0x2c, 0x4a, 0x2c, 0x3a, 0xa0, 0x5a, 0x7f, 0xd0, 0xdc,
+	0x21, 0x43, 0x69, 0xd4, 0x73, 0x13, 0x46, 0x5d, 0xb1, 0x86, 0xe5, 0xec, 0xef, 0x03, 0x68, 0xdd,
+	0x19, 0xf6, 0x5d, 0xbb, 0xb4, 0x31, 0x68, 0xbe, 0x42, 0x86, 0x6a, 0xda, 0xc7, 0xd9, 0xe0, 0x30,
+	0xe8, 0x3c, 0xc4, 0xbf, 0x8b, 0xcd, 0x2e, 0x31, 0xb8, 0x45, 0x66, 0x54, 0x39, 0x42, 0xcb, 0x10,
+	0xa7, 0x2e, 0x76, 0x07, 0x94, 0x9b, 0x21, 0x75, 0xb3, 0xb8, 0x9f, 0x6f, 0x94, 0x6d, 0xcb, 0x68,
+	0x70, 0x4a, 0x55, 0x72, 0xa0, 0x0a, 0xc4, 0x5d, 0x7b, 0x87, 0x58, 0xd2, 0x40, 0xe5, 0xff, 0x97,
+	0xde, 0xfc, 0xcc, 0xa4, 0x37, 0xd7, 0x2d, 0x37, 0xe4, 0xc7, 0x75, 0xcb, 0x55, 0x25, 0x2b, 0xfa,
+	0x36, 0x64, 0x0c, 0xd2, 0x25, 0x6d, 0x6e, 0x39, 0xda, 0xc1, 0x0e, 0xa1, 0xd9, 0x38, 0x87, 0xbb,
+	0x71, 0xec, 0xe0, 0x50, 0xd3, 0x3e, 0x54, 0x83, 0x23, 0xa1, 0x0d, 0x48, 0x18, 0x81, 0x3b, 0x65,
+	0xa7, 0xb9, 0x31, 0x9f, 0xdd, 0x4f, 0xc7, 0x90, 0xe7, 0x85, 0x73, 0x61, 0x18, 0x82, 0x79, 0xd0,
+	0xc0, 0x6a, 0xda, 0x96, 0x61, 0x5a, 0x6d, 0xad, 0x43, 0xcc, 0x76, 0xc7, 0xcd, 0xce, 0x14, 0x94,
+	0xab, 0x51, 0x35, 0xed, 0xcf, 0xdf, 0xe6, 0xd3, 0x68, 0x03, 0x52, 0x01, 0x29, 0x8f, 0x90, 0xd9,
+	0xe3, 0x46, 0xc8, 0x9c, 0x0f, 0xc0, 0x48, 0xd0, 0x3d, 0x80, 0x20, 0x06, 0xb3, 0xc0, 0xd1, 0x8a,
+	0x87, 0x47, 0x73, 0x58, 0x99, 0x10, 0x00, 0xfa, 0x16, 0x9c, 0xed, 0x99, 0x96, 0x46, 0x49, 0xb7,
+	0xa5, 0x49, 0xcb, 0x31, 0xdc, 0xc4, 0xf1, 0x4f, 0x73, 0xbe, 0x67, 0x5a, 0x0d, 0xd2, 0x6d, 0x55,
+	0x7d, 0x14, 0xf4, 0x25, 0xb8, 0x18, 0x68, 0x6f, 0x5b, 0x5a, 0xc7, 0xee, 0x1a, 0x9a, 0x43, 0x5a,
+	0x9a, 0x6e, 0x0f, 0x2c, 0x37, 0x9b, 0xe4, 0x36, 0xbb, 0xe0, 0x93, 0xac, 0x5b, 0xb7, 0xed, 0xae,
+	0xa1, 0x92, 0x56, 0x85, 0x2d, 0xa3, 0x67, 0x21, 0x50, 0x5d, 0x33, 0x0d, 0x9a, 0x9d, 0x2b, 0x44,
+	0xaf, 0xc6, 0xd4, 0xa4, 0x3f, 0x59, 0x37, 0xe8, 0xf2, 0xcc, 0xeb, 0x6f, 0xe5, 0xa7, 0x3e, 0x7e,
+	0x2b, 0x3f, 0x55, 0x5c, 0x85, 0xe4, 0x36, 0xee, 0xca, 0x38, 0x22, 0x14, 0xdd, 0x82, 0x59, 0xec,
+	0x0d, 0xb2, 0x4a, 0x21, 0x7a, 0x60, 0x1c, 0x06, 0xa4, 0xc5, 0x5f, 0x29, 0x10, 0xaf, 0x6e, 0x6f,
+	0x60, 0xd3, 0x41, 0x35, 0x98, 0x0f, 0x1c, 0xf3, 0xa8, 0x21, 0x1d, 0xf8, 0xb2, 0x17, 0xd3, 0x6b,
+	0x30, 0xef, 0x5f, 0x60, 0x3e, 0x8c, 0xb8, 0x57, 0xae, 0xbc, 0xff, 0xee, 0xf5, 0xcb, 0x12, 0xc6,
+	0xcf, 0x24, 0x63, 0x78, 0xf7, 0xc7, 0xe6, 0x43, 0x3a, 0xdf, 0x81, 0x69, 0x21, 0x2a, 0x45, 0x2f,
+	0xc1, 0x99, 0x3e, 0xfb, 0xc3, 0x55, 0x4d, 0xdc, 0x5c, 0xdc, 0xd7, 0xc1, 0x39, 0x7d, 0xd8, 0x1d,
+	0x04, 0x5f, 0xf1, 0x8d, 0x08, 0x40, 0x75, 0x7b, 0x7b, 0xd3, 0x31, 0xfb, 0x5d, 0xe2, 0x7e, 0x52,
+	0xba, 0x6f, 0xc1, 0x33, 0x81, 0xee, 0xd4, 0xd1, 0x8f, 0xaf, 0xff, 0x59, 0x9f, 0xbf, 0xe1, 0xe8,
+	0x7b, 0xc2, 0x1a, 0xd4, 0xf5, 0x61, 0xa3, 0xc7, 0x87, 0xad, 0x52, 0x77, 0xd2, 0xb2, 0x5f, 0x87,
+	0x44, 0x60, 0x0c, 0x8a, 0xea, 0x30, 0xe3, 0xca, 0xff, 0xd2, 0xc0, 0xc5, 0xfd, 0x0d, 0xec, 0xb1,
+	0x85, 0x8d, 0xec, 0xb3, 0x17, 0xff, 0xa9, 0x00, 0x84, 0x62, 0xe4, 0xd3, 0xe9, 0x63, 0xa8, 0x0e,
+	0x71, 0x99, 0x89, 0xa3, 0x27, 0xcd, 0xc4, 0x12, 0x20, 0x64, 0xd4, 0x1f, 0x46, 0xe0, 0xec, 0x96,
+	0x17, 0xbd, 0x9f, 0x7e, 0x1b, 0x6c, 0xc1, 0x34, 0xb1, 0x5c, 0xc7, 0xe4, 0x46, 0x60, 0x67, 0xfe,
+	0xd9, 0xfd, 0xce, 0x7c, 0x0f, 0xa5, 0x6a, 0x96, 0xeb, 0x0c, 0xc3, 0x1e, 0xe0, 0x61, 0x85, 0xec,
+	0xf1, 0xb3, 0x28, 0x64, 0xf7, 0x63, 0x65, 0xd5, 0xb0, 0xee, 0x10, 0x3e, 0xe1, 0x5d, 0x32, 0x0a,
+	0x4f, 0x98, 0x29, 0x6f, 0x5a, 0xde, 0x31, 0x2a, 0xb0, 0xaa, 0x8c, 0x39, 0x17, 0x23, 0x3d, 0x59,
+	0x19, 0x96, 0x0a, 0x10, 0xf8, 0x2d, 0xb3, 0x09, 0x69, 0xd3, 0x32, 0x5d, 0x13, 0x77, 0xb5, 0x26,
+	0xee, 0x62, 0x4b, 0xf7, 0xca, 0xd5, 0x63, 0x5d, 0x09, 0x29, 0x89, 0x51, 0x16, 0x10, 0xa8, 0x06,
+	0xd3, 0x1e, 0x5a, 0xec, 0xf8, 0x68, 0x1e, 0x2f, 0xba, 0x02, 0xc9, 0xf0, 0xc5, 0xc0, 0x4b, 0x8f,
+	0x98, 0x9a, 0x08, 0xdd, 0x0b, 0x87, 0xdd, 0x3c, 0xf1, 0x03, 0x6f, 0x1e, 0x59, 0xdd, 0xfd, 0x22,
+	0x0a, 0xf3, 0x2a, 0x31, 0xfe, 0xfb, 0x8f, 0x65, 0x03, 0x40, 0x84, 0x2a, 0xcb, 0xa4, 0xd9, 0xd8,
+	0x49, 0xe3, 0x7d, 0x56, 0x80, 0x54, 0xa9, 0xfb, 0x9f, 0x3a, 0xa1, 0x3f, 0x47, 0x20, 0x19, 0x3e,
+	0xa1, 0xff, 0xc9, 0x4b, 0x0b, 0xad, 0x05, 0x69, 0x2a, 0xc6, 0xd3, 0xd4, 0x8b, 0xfb, 0xa5, 0xa9,
+	0x09, 0x6f, 0x3e, 0x24, 0x3f, 0xfd, 0x68, 0x06, 0xe2, 0x1b, 0xd8, 0xc1, 0x3d, 0x8a, 0xd6, 0x27,
+	0x0a, 0x59, 0xf1, 0x90, 0x5c, 0x98, 0x70, 0xe6, 0xaa, 0xec, 0xbe, 0x08, 0x5f, 0xfe, 0xe9, 0x7e,
+	0x75, 0xec, 0xff, 0x41, 0x8a, 0x3d, 0x88, 0x7d, 0x85, 0x84, 0x71, 0xe7, 0xf8, 0xbb, 0xd6, 0xd7,
+	0x9e, 0xa2, 0x3c, 0x24, 0x18, 0x59, 0x90, 0x87, 0x19, 0x0d, 0xf4, 0xf0, 0xc3, 0x9a, 0x98, 0x41,
+	0xd7, 0x01, 0x75, 0xfc, 0xc6, 0x84, 0x16, 0x18, 0x82, 0xd1, 0xcd, 0x07, 0x2b, 0x1e, 0xf9, 0x65,
+	0x00, 0x26, 0x85, 0x66, 0x10, 0xcb, 0xee, 0xc9, 0x57, 0xdd, 0x2c, 0x9b, 0xa9, 0xb2, 0x09, 0xf4,
+	0x03, 0x45, 0xd4, 0xc3, 0x63, 0xcf, 0x66, 0xf9, 0x1c, 0xd9, 0x3c, 0x42, 0x50, 0xfc, 0xe3, 0x49,
+	0x3e, 0x37, 0xc4, 0xbd, 0xee, 0x72, 0x71, 0x0f, 0x9c, 0xe2, 0x5e, 0x2f, 0x79, 0x56, 0x38, 0x8f,
+	0x3e, 0xbb, 0x51, 0x1d, 0x32, 0x3b, 0x64, 0xa8, 0x39, 0xb6, 0x2b, 0x12, 0x4d, 0x8b, 0x10, 0xf9,
+	0x70, 0x59, 0xf0, 0xce, 0xb6, 0x89, 0x29, 0x09, 0xd5, 0xf9, 0xa6, 0x55, 0x8e, 0x31, 0xe9, 0xd4,
+	0xd4, 0x0e, 0x19, 0xaa, 0x92, 0x6f, 0x95, 0x10, 0xf4, 0x7d, 0xb8, 0xb2, 0x47, 0x81, 0xaf, 0x35,
+	0x1d, 0x82, 0xf5, 0x8e, 0x86, 0x75, 0x36, 0xe2, 0xaf, 0x97, 0xd4, 0xcd, 0x2f, 0xec, 0xe7, 0x37,
+	0xf7, 0xc6, 0x2b, 0xfb, 0x32, 0xe7, 0x5e, 0xe1, 0xcc, 0xea, 0xe5, 0xde, 0x41, 0xcb, 0xe8, 0x35,
+	0x58, 0x68, 0x77, 0xed, 0x26, 0xee, 0x6a, 0x5d, 0xf3, 0xb5, 0x81, 0x69, 0x68, 0x12, 0x5d, 0xd3,
+	0x71, 0x3f, 0x3b, 0x7b, 0xaa, 0x1e, 0xc8, 0x79, 0x01, 0x7c, 0x97, 0xe3, 0x36, 0x04, 0x6c, 0x05,
+	0xf7, 0xd1, 0x03, 0xb8, 0x14, 0x04, 0xda, 0x1e, 0xbb, 0xc2, 0xa9, 0x76, 0x5d, 0xf0, 0xb1, 0x27,
+	0x36, 0x7e, 0x05, 0x8a, 0x23, 0x4e, 0xad, 0x39, 0xa1, 0x88, 0xf3, 0x9d, 0x33, 0xc1, 0x9d, 0x33,
+	0x1f, 0x76, 0xf4, 0xf1, 0xc8, 0x64, 0xae, 0x6a, 0x43, 0x3e, 0xe4, 0x3e, 0xb2, 0x73, 0x64, 0xd9,
+	0xae, 0xa9, 0x13, 0xad, 0x4f, 0x1c, 0xd3, 0x36, 0xb2, 0x49, 0xe9, 0x13, 0x47, 0x8d, 0xc1, 0x4b,
+	0x01, 0xa0, 0xe8, 0x1d, 0xad, 0x71, 0xb8, 0x0d, 0x8e, 0xb6, 0xfc, 0x1c, 0x4b, 0xaa, 0xbb, 0x1f,
+	0xbd, 0x73, 0x4d, 0x5a, 0xe4, 0x3a, 0x35, 0x76, 0x96, 0x1e, 0xfa, 0x6d, 0x5c, 0x91, 0x09, 0xd8,
+	0xfb, 0x08, 0x05, 0x47, 0xad, 0x12, 0xda, 0xb7, 0x2d, 0xca, 0xdf, 0xa5, 0xa1, 0xf7, 0xa3, 0x72,
+	0xf0, 0xbb, 0x34, 0xe4, 0x2a, 0xe1, 0x77, 0x69, 0x28, 0x93, 0x7f, 0x39, 0x28, 0x15, 0x22, 0x87,
+	0x39, 0x7e, 0x38, 0x89, 0x49, 0x26, 0x7e, 0x41, 0x4c, 0x15, 0xff, 0xa0, 0xc0, 0xc2, 0x44, 0xd2,
+	0xf3, 0x45, 0xd6, 0x01, 0x4d, 0x9c, 0xcf, 0x50, 0x8a, 0x7e, 0xb2, 0x1c, 0x3a, 0xef, 0x8c, 0xaf,
+	0x7e, 0x42, 0x35, 0x8f, 0xbc, 0xf0, 0x7e, 0xa7, 0xc0, 0xb9, 0xb0, 0x00, 0xbe, 0x2a, 0x0d, 0x48,
+	0x86, 0xb7, 0x96, 0x4a, 0x3c, 0x77, 0x14, 0x25, 0xc2, 0xf2, 0x8f, 0x80, 0xa0, 0xed, 0xe0, 0x62,
+	0x11, 0xfd, 0xe3, 0x1b, 0x47, 0x36, 0x8a, 0x27, 0xd8, 0x9e, 0x17, 0x8c, 0x38, 0x9b, 0xbf, 0x29,
+	0x10, 0xdb, 0xb0, 0xed, 0x2e, 0x7a, 0x0d, 0xe6, 0x2d, 0xdb, 0xd5, 0x58, 0x12, 0x26, 0x86, 0x26,
+	0xdb, 0x49, 0xe2, 0xd2, 0xae, 0x1d, 0x68, 0xab, 0xbf, 0x3e, 0xc9, 0x4f, 0x72, 0x8e, 0x1a, 0x50,
+	0x76, 0x2d, 0x2d, 0xdb, 0x2d, 0x73, 0xa2, 0x4d, 0x4e, 0x83, 0x5a, 0x30, 0x37, 0xba, 0x9d, 0xb8,
+	0xd8, 0x57, 0x0e, 0xdb, 0x6e, 0xee, 0xd0, 0xad, 0x92, 0xcd, 0xd0, 0x3e, 0xcb, 0x33, 0xec, 0xd4,
+	0xfe, 0xce, 0x4e, 0xee, 0x55, 0xc8, 0xf8, 0xc1, 0xbe, 0xc5, 0x5b, 0x9e, 0x94, 0xb9, 0x86, 0xe8,
+	0x7e, 0x7a, 0x6f, 0xca, 0x42, 0xb8, 0xb9, 0xcf, 0xbe, 0x0e, 0x94, 0xc6, 0x78, 0x46, 0xcc, 0x29,
+	0x79, 0x79, 0x7f, 0xfe, 0x71, 0x04, 0x16, 0x2a, 0xb6, 0x45, 0x65, 0xef, 0x4f, 0xe6, 0x7f, 0xd1,
+	0xb1, 0x1f, 0xb2, 0x86, 0xd5, 0x9e, 0x9d, 0xc9, 0xe4, 0x64, 0xff, 0x71, 0x1b, 0xd2, 0xac, 0x10,
+	0xd3, 0x6d, 0xeb, 0x94, 0xed, 0xc7, 0x39, 0xbb, 0x6b, 0x48, 0x89, 0x58, 0xf3, 0x71, 0x1b, 0xd2,
+	0x16, 0x79, 0x30, 0x82, 0x1b, 0x3d, 0x19, 0xae, 0x45, 0x1e, 0x84, 0x70, 0xcf, 0xb3, 0xef, 0x23,
+	0xbc, 0x0a, 0x8f, 0xf1, 0x1a, 0x53, 0x8e, 0xd0, 0x2d, 0x88, 0xb2, 0x4b, 0xf3, 0xcc, 0x31, 0x72,
+	0x07, 0x63, 0x08, 0x15, 0x3f, 0x0d, 0x58, 0x90, 0xfd, 0x24, 0xba, 0xde, 0xe2, 0x16, 0x25, 0x5c,
+	0xa1, 0x57, 0xc8, 0x70, 0x8f, 0xe6, 0x52, 0xf2, 0x68, 0xcd, 0xa5, 0x7f, 0x29, 0x70, 0x96, 0xfb,
+	0x86, 0xf9, 0x3d, 0xc2, 0xfb, 0x93, 0xf2, 0x53, 0x4a, 0x0a, 0x22, 0xa6, 0xc1, 0xcf, 0x24, 0xa6,
+	0x46, 0x4c, 0x03, 0x95, 0xe0, 0x8c, 0xfd, 0xc0, 0x22, 0x8e, 0x74, 0xcc, 0xfd, 0xb1, 0x05, 0x19,
+	0xaf, 0xa6, 0x6c, 0x63, 0xd0, 0x25, 0x1a, 0xd6, 0x45, 0x01, 0x2d, 0xba, 0xde, 0x73, 0x62, 0x76,
+	0x45, 0x4c, 0xa2, 0x97, 0x60, 0xd6, 0xbf, 0x9b, 0xb2, 0xb1, 0xa3, 0x56, 0x9d, 0x01, 0x0f, 0xd3,
+	0xdb, 0xaf, 0x96, 0xb3, 0x67, 0x0e, 0x91, 0x2d, 0x20, 0x95, 0xe9, 0xeb, 0xe7, 0x11, 0x58, 0x68,
+	0xe8, 0x1d, 0xc2, 0x44, 0x32, 0x2a, 0x63, 0x57, 0xd1, 0xde, 0xcf, 0x77, 0xe5, 0xe4, 0xcf, 0x77,
+	0x0d, 0xd2, 0xe3, 0x65, 0xdc, 0xe9, 0x3e, 0xe6, 0xa4, 0x46, 0x3f, 0x7e, 0xb0, 0xe6, 0x2e, 0x69,
+	0xb5, 0x88, 0xee, 0x9a, 0xf7, 0xe5, 0xe7, 0x8f, 0xe8, 0xb1, 0x9b, 0xbb, 0x3e, 0x00, 0x23, 0xb9,
+	0xf6, 0x6b, 0x05, 0x20, 0xe8, 0xb2, 0xa3, 0xcf, 0xc0, 0x85, 0xf2, 0xfa, 0x5a, 0x55, 0x6b, 0x6c,
+	0xae, 0x6c, 0x6e, 0x35, 0xb4, 0xad, 0xb5, 0xc6, 0x46, 0xad, 0x52, 0x5f, 0xad, 0xd7, 0xaa, 0x99,
+	0xa9, 0x5c, 0x7a, 0xf7, 0x51, 0x21, 0xb1, 0x65, 0xd1, 0x3e, 0xd1, 0xcd, 0x96, 0x49, 0x0c, 0xf4,
+	0x3c, 0x9c, 0x1b, 0xa5, 0x66, 0xa3, 0x5a, 0x35, 0xa3, 0xe4, 0x92, 0xbb, 0x8f, 0x0a, 0x33, 0xa2,
+	0xd1, 0x40, 0x0c, 0x74, 0x15, 0x9e, 0x99, 0xa4, 0xab, 0xaf, 0xbd, 0x9c, 0x89, 0xe4, 0xe6, 0x76,
+	0x1f, 0x15, 0x66, 0xfd, 0x8e, 0x04, 0x2a, 0x02, 0x0a, 0x53, 0x4a, 0xbc, 0x68, 0x0e, 0x76, 0x1f,
+	0x15, 0xe2, 0x22, 0xa1, 0xe6, 0x62, 0xaf, 0xff, 0x72, 0x71, 0xea, 0xda, 0x6f, 0x14, 0xb8, 0x7c,
+	0x60, 0x95, 0x88, 0xbe, 0x02, 0xcf, 0xdf, 0xab, 0xaf, 0x69, 0x8d, 0xda, 0xdd, 0x55, 0xad, 0x5a,
+	0xbb, 0x5b, 0x7b, 0x79, 0x65, 0xb3, 0xbe, 0xbe, 0xa6, 0x95, 0xd5, 0xda, 0x4a, 0xe5, 0xb6, 0xb6,
+	0x52, 0xe1, 0xa3, 0x3b, 0x2b, 0xf5, 0xbb, 0x99, 0xa9, 0xdc, 0xb9, 0xdd, 0x47, 0x85, 0x4c, 0x98,
+	0xfb, 0x0e, 0x36, 0xbb, 0x68, 0x1d, 0x4a, 0x87, 0x23, 0xac, 0xae, 0xab, 0x95, 0x9a, 0xd4, 0x29,
+	0xa3, 0xe4, 0x2e, 0xee, 0x3e, 0x2a, 0x5c, 0x08, 0x23, 0xad, 0xda, 0x8e, 0x4e, 0x84, 0x86, 0x52,
+	0xf4, 0x57, 0x01, 0xea, 0x56, 0xcb, 0x11, 0x95, 0x30, 0xca, 0xc1, 0xf9, 0xfa, 0xda, 0xaa, 0x2a,
+	0xd1, 0x46, 0x2c, 0x3e, 0xb6, 0x56, 0x5d, 0xdf, 0x2a, 0xdf, 0xad, 0x69, 0x8d, 0xfa, 0xcb, 0x6b,
+	0x19, 0x05, 0x5d, 0x80, 0xb3, 0x23, 0x6b, 0x5f, 0x5b, 0xdb, 0xac, 0xdf, 0xab, 0x65, 0x22, 0xe5,
+	0x5b, 0xef, 0x7d, 0xb8, 0xa8, 0x3c, 0xfe, 0x70, 0x51, 0xf9, 0xcb, 0x87, 0x8b, 0xca, 0x9b, 0x4f,
+	0x17, 0xa7, 0x1e, 0x3f, 0x5d, 0x9c, 0xfa, 0xe3, 0xd3, 0xc5, 0xa9, 0x6f, 0x5e, 0x1a, 0x71, 0xbf,
+	0xa0, 0xce, 0xe2, 0x5f, 0x75, 0x9b, 0x71, 0xee, 0x3c, 0x9f, 0xfb, 0xf7, 0x00, 0x57, 0x7d, 0xc5,
+	0xdd, 0x4d, 0x1f, 0x00, 0x00,
 }
 
 func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
@@ -1590,716 +1846,805 @@ func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_go
 func StakingDescription() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
 	d := &github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet{}
 	var gzipped = []byte{
-		// 11335 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x69, 0x90, 0x1c, 0xd7,
-		0x79, 0xd8, 0xce, 0x3d, 0xf3, 0xcd, 0xd5, 0xfb, 0x76, 0x01, 0x2c, 0x06, 0x24, 0x76, 0xd9, 0x14,
-		0x49, 0x10, 0x24, 0x17, 0x24, 0x48, 0x80, 0xe4, 0x42, 0x12, 0x33, 0x33, 0x3b, 0x00, 0x06, 0xdc,
-		0x8b, 0x3d, 0xb3, 0x10, 0x49, 0x5b, 0x6a, 0xf7, 0xf6, 0xbc, 0xdd, 0x6d, 0x62, 0xa6, 0xbb, 0xd5,
-		0xdd, 0x03, 0xec, 0xb2, 0x52, 0x29, 0x39, 0xb2, 0x13, 0x99, 0x3e, 0x22, 0xc7, 0x2e, 0x5b, 0xb6,
-		0x04, 0x5b, 0xb2, 0x63, 0x4b, 0x76, 0x2e, 0x1f, 0x8a, 0x8f, 0xb8, 0xe2, 0xd8, 0xa9, 0x1c, 0xb2,
-		0x2b, 0x49, 0x29, 0xfe, 0x91, 0x38, 0xa9, 0x32, 0x63, 0x4b, 0xae, 0x48, 0x91, 0xe5, 0xc4, 0x87,
-		0x9c, 0x38, 0xa5, 0x4a, 0x2a, 0xf5, 0xae, 0x3e, 0xe6, 0xd8, 0x99, 0x85, 0x48, 0xd9, 0xb1, 0xf3,
-		0x07, 0x98, 0xfe, 0xde, 0xf7, 0x7d, 0xef, 0xbd, 0xef, 0x7d, 0xef, 0x7b, 0xdf, 0xf7, 0xbd, 0x63,
-		0xe1, 0x77, 0x6a, 0xb0, 0xb4, 0x67, 0x59, 0x7b, 0x5d, 0x7c, 0xc1, 0x76, 0x2c, 0xcf, 0xda, 0xe9,
-		0xef, 0x5e, 0xe8, 0x60, 0x57, 0x77, 0x0c, 0xdb, 0xb3, 0x9c, 0x65, 0x0a, 0x43, 0x65, 0x86, 0xb1,
-		0x2c, 0x30, 0xe4, 0x75, 0x98, 0xbd, 0x6a, 0x74, 0xf1, 0xaa, 0x8f, 0xd8, 0xc2, 0x1e, 0x7a, 0x0e,
-		0x92, 0xbb, 0x46, 0x17, 0x2f, 0xc4, 0x96, 0x12, 0xe7, 0xf2, 0x17, 0xdf, 0xb1, 0x3c, 0x40, 0xb4,
-		0x1c, 0xa5, 0xd8, 0x22, 0x60, 0x85, 0x52, 0xc8, 0xff, 0x27, 0x09, 0x73, 0x23, 0x4a, 0x11, 0x82,
-		0xa4, 0xa9, 0xf5, 0x08, 0xc7, 0xd8, 0xb9, 0x9c, 0x42, 0x7f, 0xa3, 0x05, 0xc8, 0xd8, 0x9a, 0x7e,
-		0x4b, 0xdb, 0xc3, 0x0b, 0x71, 0x0a, 0x16, 0x9f, 0xe8, 0x2c, 0x40, 0x07, 0xdb, 0xd8, 0xec, 0x60,
-		0x53, 0x3f, 0x5c, 0x48, 0x2c, 0x25, 0xce, 0xe5, 0x94, 0x10, 0x04, 0x3d, 0x06, 0xb3, 0x76, 0x7f,
-		0xa7, 0x6b, 0xe8, 0x6a, 0x08, 0x0d, 0x96, 0x12, 0xe7, 0x52, 0x8a, 0xc4, 0x0a, 0x56, 0x03, 0xe4,
-		0x47, 0xa0, 0x7c, 0x07, 0x6b, 0xb7, 0xc2, 0xa8, 0x79, 0x8a, 0x5a, 0x22, 0xe0, 0x10, 0x62, 0x1d,
-		0x0a, 0x3d, 0xec, 0xba, 0xda, 0x1e, 0x56, 0xbd, 0x43, 0x1b, 0x2f, 0x24, 0x69, 0xef, 0x97, 0x86,
-		0x7a, 0x3f, 0xd8, 0xf3, 0x3c, 0xa7, 0x6a, 0x1f, 0xda, 0x18, 0x55, 0x21, 0x87, 0xcd, 0x7e, 0x8f,
-		0x71, 0x48, 0x8d, 0x91, 0x5f, 0xc3, 0xec, 0xf7, 0x06, 0xb9, 0x64, 0x09, 0x19, 0x67, 0x91, 0x71,
-		0xb1, 0x73, 0xdb, 0xd0, 0xf1, 0x42, 0x9a, 0x32, 0x78, 0x64, 0x88, 0x41, 0x8b, 0x95, 0x0f, 0xf2,
-		0x10, 0x74, 0xa8, 0x0e, 0x39, 0x7c, 0xe0, 0x61, 0xd3, 0x35, 0x2c, 0x73, 0x21, 0x43, 0x99, 0x3c,
-		0x34, 0x62, 0x14, 0x71, 0xb7, 0x33, 0xc8, 0x22, 0xa0, 0x43, 0x97, 0x21, 0x63, 0xd9, 0x9e, 0x61,
-		0x99, 0xee, 0x42, 0x76, 0x29, 0x76, 0x2e, 0x7f, 0xf1, 0xbe, 0x91, 0x8a, 0xb0, 0xc9, 0x70, 0x14,
-		0x81, 0x8c, 0x9a, 0x20, 0xb9, 0x56, 0xdf, 0xd1, 0xb1, 0xaa, 0x5b, 0x1d, 0xac, 0x1a, 0xe6, 0xae,
-		0xb5, 0x90, 0xa3, 0x0c, 0x16, 0x87, 0x3b, 0x42, 0x11, 0xeb, 0x56, 0x07, 0x37, 0xcd, 0x5d, 0x4b,
-		0x29, 0xb9, 0x91, 0x6f, 0x74, 0x12, 0xd2, 0xee, 0xa1, 0xe9, 0x69, 0x07, 0x0b, 0x05, 0xaa, 0x21,
-		0xfc, 0x8b, 0xa8, 0x0e, 0xee, 0x18, 0xa4, 0xba, 0x85, 0x22, 0x53, 0x1d, 0xfe, 0x29, 0xff, 0x62,
-		0x1a, 0xca, 0xd3, 0x28, 0xdf, 0x15, 0x48, 0xed, 0x92, 0xfe, 0x2f, 0xc4, 0x8f, 0x23, 0x1d, 0x46,
-		0x13, 0x15, 0x6f, 0xfa, 0x1e, 0xc5, 0x5b, 0x85, 0xbc, 0x89, 0x5d, 0x0f, 0x77, 0x98, 0xae, 0x24,
-		0xa6, 0xd4, 0x36, 0x60, 0x44, 0xc3, 0xca, 0x96, 0xbc, 0x27, 0x65, 0x7b, 0x19, 0xca, 0x7e, 0x93,
-		0x54, 0x47, 0x33, 0xf7, 0x84, 0xd6, 0x5e, 0x98, 0xd4, 0x92, 0xe5, 0x86, 0xa0, 0x53, 0x08, 0x99,
-		0x52, 0xc2, 0x91, 0x6f, 0xb4, 0x0a, 0x60, 0x99, 0xd8, 0xda, 0x55, 0x3b, 0x58, 0xef, 0x2e, 0x64,
-		0xc7, 0x48, 0x69, 0x93, 0xa0, 0x0c, 0x49, 0xc9, 0x62, 0x50, 0xbd, 0x8b, 0x9e, 0x0f, 0x94, 0x30,
-		0x33, 0x46, 0x87, 0xd6, 0xd9, 0xf4, 0x1b, 0xd2, 0xc3, 0x6d, 0x28, 0x39, 0x98, 0xcc, 0x08, 0xdc,
-		0xe1, 0x3d, 0xcb, 0xd1, 0x46, 0x2c, 0x4f, 0xec, 0x99, 0xc2, 0xc9, 0x58, 0xc7, 0x8a, 0x4e, 0xf8,
-		0x13, 0x3d, 0x08, 0x3e, 0x40, 0xa5, 0x6a, 0x05, 0xd4, 0x3e, 0x15, 0x04, 0x70, 0x43, 0xeb, 0xe1,
-		0xca, 0xeb, 0x50, 0x8a, 0x8a, 0x07, 0xcd, 0x43, 0xca, 0xf5, 0x34, 0xc7, 0xa3, 0x5a, 0x98, 0x52,
-		0xd8, 0x07, 0x92, 0x20, 0x81, 0xcd, 0x0e, 0xb5, 0x7f, 0x29, 0x85, 0xfc, 0x44, 0x7f, 0x25, 0xe8,
-		0x70, 0x82, 0x76, 0xf8, 0xe1, 0xe1, 0x11, 0x8d, 0x70, 0x1e, 0xec, 0x77, 0xe5, 0x59, 0x28, 0x46,
-		0x3a, 0x30, 0x6d, 0xd5, 0xf2, 0x5f, 0x85, 0x13, 0x23, 0x59, 0xa3, 0x97, 0x61, 0xbe, 0x6f, 0x1a,
-		0xa6, 0x87, 0x1d, 0xdb, 0xc1, 0x44, 0x63, 0x59, 0x55, 0x0b, 0x5f, 0xc8, 0x8c, 0xd1, 0xb9, 0xed,
-		0x30, 0x36, 0xe3, 0xa2, 0xcc, 0xf5, 0x87, 0x81, 0xe7, 0x73, 0xd9, 0x2f, 0x66, 0xa4, 0x0f, 0x7c,
-		0xe0, 0x03, 0x1f, 0x88, 0xcb, 0xbf, 0x9a, 0x86, 0xf9, 0x51, 0x73, 0x66, 0xe4, 0xf4, 0x3d, 0x09,
-		0x69, 0xb3, 0xdf, 0xdb, 0xc1, 0x0e, 0x15, 0x52, 0x4a, 0xe1, 0x5f, 0xa8, 0x0a, 0xa9, 0xae, 0xb6,
-		0x83, 0xbb, 0x0b, 0xc9, 0xa5, 0xd8, 0xb9, 0xd2, 0xc5, 0xc7, 0xa6, 0x9a, 0x95, 0xcb, 0x6b, 0x84,
-		0x44, 0x61, 0x94, 0xe8, 0xdd, 0x90, 0xe4, 0xc6, 0x9b, 0x70, 0x38, 0x3f, 0x1d, 0x07, 0x32, 0x97,
-		0x14, 0x4a, 0x87, 0xce, 0x40, 0x8e, 0xfc, 0xcf, 0x74, 0x23, 0x4d, 0xdb, 0x9c, 0x25, 0x00, 0xa2,
-		0x17, 0xa8, 0x02, 0x59, 0x3a, 0x4d, 0x3a, 0x58, 0x2c, 0x7a, 0xfe, 0x37, 0x51, 0xac, 0x0e, 0xde,
-		0xd5, 0xfa, 0x5d, 0x4f, 0xbd, 0xad, 0x75, 0xfb, 0x98, 0x2a, 0x7c, 0x4e, 0x29, 0x70, 0xe0, 0x4d,
-		0x02, 0x43, 0x8b, 0x90, 0x67, 0xb3, 0xca, 0x30, 0x3b, 0xf8, 0x80, 0xda, 0xd5, 0x94, 0xc2, 0x26,
-		0x5a, 0x93, 0x40, 0x48, 0xf5, 0xaf, 0xb9, 0x96, 0x29, 0x54, 0x93, 0x56, 0x41, 0x00, 0xb4, 0xfa,
-		0x67, 0x07, 0x4d, 0xfa, 0xfd, 0xa3, 0xbb, 0x37, 0x34, 0x97, 0x1e, 0x81, 0x32, 0xc5, 0x78, 0x9a,
-		0x0f, 0xbd, 0xd6, 0x5d, 0x98, 0x5d, 0x8a, 0x9d, 0xcb, 0x2a, 0x25, 0x06, 0xde, 0xe4, 0x50, 0xf9,
-		0xe7, 0xe2, 0x90, 0xa4, 0x86, 0xa5, 0x0c, 0xf9, 0xf6, 0x2b, 0x5b, 0x0d, 0x75, 0x75, 0x73, 0xbb,
-		0xb6, 0xd6, 0x90, 0x62, 0xa8, 0x04, 0x40, 0x01, 0x57, 0xd7, 0x36, 0xab, 0x6d, 0x29, 0xee, 0x7f,
-		0x37, 0x37, 0xda, 0x97, 0x9f, 0x91, 0x12, 0x3e, 0xc1, 0x36, 0x03, 0x24, 0xc3, 0x08, 0x4f, 0x5f,
-		0x94, 0x52, 0x48, 0x82, 0x02, 0x63, 0xd0, 0x7c, 0xb9, 0xb1, 0x7a, 0xf9, 0x19, 0x29, 0x1d, 0x85,
-		0x3c, 0x7d, 0x51, 0xca, 0xa0, 0x22, 0xe4, 0x28, 0xa4, 0xb6, 0xb9, 0xb9, 0x26, 0x65, 0x7d, 0x9e,
-		0xad, 0xb6, 0xd2, 0xdc, 0xb8, 0x26, 0xe5, 0x7c, 0x9e, 0xd7, 0x94, 0xcd, 0xed, 0x2d, 0x09, 0x7c,
-		0x0e, 0xeb, 0x8d, 0x56, 0xab, 0x7a, 0xad, 0x21, 0xe5, 0x7d, 0x8c, 0xda, 0x2b, 0xed, 0x46, 0x4b,
-		0x2a, 0x44, 0x9a, 0xf5, 0xf4, 0x45, 0xa9, 0xe8, 0x57, 0xd1, 0xd8, 0xd8, 0x5e, 0x97, 0x4a, 0x68,
-		0x16, 0x8a, 0xac, 0x0a, 0xd1, 0x88, 0xf2, 0x00, 0xe8, 0xf2, 0x33, 0x92, 0x14, 0x34, 0x84, 0x71,
-		0x99, 0x8d, 0x00, 0x2e, 0x3f, 0x23, 0x21, 0xb9, 0x0e, 0x29, 0xaa, 0x86, 0x08, 0x41, 0x69, 0xad,
-		0x5a, 0x6b, 0xac, 0xa9, 0x9b, 0x5b, 0xed, 0xe6, 0xe6, 0x46, 0x75, 0x4d, 0x8a, 0x05, 0x30, 0xa5,
-		0xf1, 0xd2, 0x76, 0x53, 0x69, 0xac, 0x4a, 0xf1, 0x30, 0x6c, 0xab, 0x51, 0x6d, 0x37, 0x56, 0xa5,
-		0x84, 0xac, 0xc3, 0xfc, 0x28, 0x83, 0x3a, 0x72, 0x0a, 0x85, 0x74, 0x21, 0x3e, 0x46, 0x17, 0x28,
-		0xaf, 0x41, 0x5d, 0x90, 0x3f, 0x1f, 0x87, 0xb9, 0x11, 0x8b, 0xca, 0xc8, 0x4a, 0x5e, 0x80, 0x14,
-		0xd3, 0x65, 0xb6, 0xcc, 0x3e, 0x3a, 0x72, 0x75, 0xa2, 0x9a, 0x3d, 0xb4, 0xd4, 0x52, 0xba, 0xb0,
-		0x13, 0x92, 0x18, 0xe3, 0x84, 0x10, 0x16, 0x43, 0x0a, 0xfb, 0xde, 0x21, 0xe3, 0xcf, 0xd6, 0xc7,
-		0xcb, 0xd3, 0xac, 0x8f, 0x14, 0x76, 0xbc, 0x45, 0x20, 0x35, 0x62, 0x11, 0xb8, 0x02, 0xb3, 0x43,
-		0x8c, 0xa6, 0x36, 0xc6, 0x1f, 0x8c, 0xc1, 0xc2, 0x38, 0xe1, 0x4c, 0x30, 0x89, 0xf1, 0x88, 0x49,
-		0xbc, 0x32, 0x28, 0xc1, 0x07, 0xc6, 0x0f, 0xc2, 0xd0, 0x58, 0x7f, 0x32, 0x06, 0x27, 0x47, 0x3b,
-		0x9b, 0x23, 0xdb, 0xf0, 0x6e, 0x48, 0xf7, 0xb0, 0xb7, 0x6f, 0x09, 0xb7, 0xea, 0xe1, 0x11, 0x8b,
-		0x35, 0x29, 0x1e, 0x1c, 0x6c, 0x4e, 0x15, 0x5e, 0xed, 0x13, 0xe3, 0x3c, 0x46, 0xd6, 0x9a, 0xa1,
-		0x96, 0x7e, 0x5b, 0x1c, 0x4e, 0x8c, 0x64, 0x3e, 0xb2, 0xa1, 0xf7, 0x03, 0x18, 0xa6, 0xdd, 0xf7,
-		0x98, 0xeb, 0xc4, 0x2c, 0x71, 0x8e, 0x42, 0xa8, 0xf1, 0x22, 0x56, 0xb6, 0xef, 0xf9, 0xe5, 0x09,
-		0x5a, 0x0e, 0x0c, 0x44, 0x11, 0x9e, 0x0b, 0x1a, 0x9a, 0xa4, 0x0d, 0x3d, 0x3b, 0xa6, 0xa7, 0x43,
-		0x8a, 0xf9, 0x24, 0x48, 0x7a, 0xd7, 0xc0, 0xa6, 0xa7, 0xba, 0x9e, 0x83, 0xb5, 0x9e, 0x61, 0xee,
-		0xd1, 0xa5, 0x26, 0xbb, 0x92, 0xda, 0xd5, 0xba, 0x2e, 0x56, 0xca, 0xac, 0xb8, 0x25, 0x4a, 0x09,
-		0x05, 0x55, 0x20, 0x27, 0x44, 0x91, 0x8e, 0x50, 0xb0, 0x62, 0x9f, 0x42, 0xfe, 0xee, 0x1c, 0xe4,
-		0x43, 0xae, 0x39, 0x7a, 0x00, 0x0a, 0xaf, 0x69, 0xb7, 0x35, 0x55, 0x84, 0x5b, 0x4c, 0x12, 0x79,
-		0x02, 0xdb, 0xe2, 0x21, 0xd7, 0x93, 0x30, 0x4f, 0x51, 0xac, 0xbe, 0x87, 0x1d, 0x55, 0xef, 0x6a,
-		0xae, 0x4b, 0x85, 0x96, 0xa5, 0xa8, 0x88, 0x94, 0x6d, 0x92, 0xa2, 0xba, 0x28, 0x41, 0x97, 0x60,
-		0x8e, 0x52, 0xf4, 0xfa, 0x5d, 0xcf, 0xb0, 0xbb, 0x58, 0x25, 0x01, 0xa0, 0x4b, 0x97, 0x1c, 0xbf,
-		0x65, 0xb3, 0x04, 0x63, 0x9d, 0x23, 0x90, 0x16, 0xb9, 0x68, 0x15, 0xee, 0xa7, 0x64, 0x7b, 0xd8,
-		0xc4, 0x8e, 0xe6, 0x61, 0x15, 0xbf, 0xbf, 0xaf, 0x75, 0x5d, 0x55, 0x33, 0x3b, 0xea, 0xbe, 0xe6,
-		0xee, 0x2f, 0xcc, 0x13, 0x06, 0xb5, 0xf8, 0x42, 0x4c, 0x39, 0x4d, 0x10, 0xaf, 0x71, 0xbc, 0x06,
-		0x45, 0xab, 0x9a, 0x9d, 0xeb, 0x9a, 0xbb, 0x8f, 0x56, 0xe0, 0x24, 0xe5, 0xe2, 0x7a, 0x8e, 0x61,
-		0xee, 0xa9, 0xfa, 0x3e, 0xd6, 0x6f, 0xa9, 0x7d, 0x6f, 0xf7, 0xb9, 0x85, 0x33, 0xe1, 0xfa, 0x69,
-		0x0b, 0x5b, 0x14, 0xa7, 0x4e, 0x50, 0xb6, 0xbd, 0xdd, 0xe7, 0x50, 0x0b, 0x0a, 0x64, 0x30, 0x7a,
-		0xc6, 0xeb, 0x58, 0xdd, 0xb5, 0x1c, 0xba, 0x86, 0x96, 0x46, 0x98, 0xa6, 0x90, 0x04, 0x97, 0x37,
-		0x39, 0xc1, 0xba, 0xd5, 0xc1, 0x2b, 0xa9, 0xd6, 0x56, 0xa3, 0xb1, 0xaa, 0xe4, 0x05, 0x97, 0xab,
-		0x96, 0x43, 0x14, 0x6a, 0xcf, 0xf2, 0x05, 0x9c, 0x67, 0x0a, 0xb5, 0x67, 0x09, 0xf1, 0x5e, 0x82,
-		0x39, 0x5d, 0x67, 0x7d, 0x36, 0x74, 0x95, 0x87, 0x69, 0xee, 0x82, 0x14, 0x11, 0x96, 0xae, 0x5f,
-		0x63, 0x08, 0x5c, 0xc7, 0x5d, 0xf4, 0x3c, 0x9c, 0x08, 0x84, 0x15, 0x26, 0x9c, 0x1d, 0xea, 0xe5,
-		0x20, 0xe9, 0x25, 0x98, 0xb3, 0x0f, 0x87, 0x09, 0x51, 0xa4, 0x46, 0xfb, 0x70, 0x90, 0xec, 0x59,
-		0x98, 0xb7, 0xf7, 0xed, 0x61, 0xba, 0xf3, 0x61, 0x3a, 0x64, 0xef, 0xdb, 0x83, 0x84, 0x0f, 0xd1,
-		0x98, 0xdd, 0xc1, 0xba, 0xe6, 0xe1, 0xce, 0xc2, 0xa9, 0x30, 0x7a, 0xa8, 0x00, 0x2d, 0x83, 0xa4,
-		0xeb, 0x2a, 0x36, 0xb5, 0x9d, 0x2e, 0x56, 0x35, 0x07, 0x9b, 0x9a, 0xbb, 0xb0, 0x48, 0x91, 0x93,
-		0x9e, 0xd3, 0xc7, 0x4a, 0x49, 0xd7, 0x1b, 0xb4, 0xb0, 0x4a, 0xcb, 0xd0, 0x79, 0x98, 0xb5, 0x76,
-		0x5e, 0xd3, 0x99, 0x46, 0xaa, 0xb6, 0x83, 0x77, 0x8d, 0x83, 0x85, 0x77, 0x50, 0xf1, 0x96, 0x49,
-		0x01, 0xd5, 0xc7, 0x2d, 0x0a, 0x46, 0x8f, 0x82, 0xa4, 0xbb, 0xfb, 0x9a, 0x63, 0x53, 0x93, 0xec,
-		0xda, 0x9a, 0x8e, 0x17, 0x1e, 0x62, 0xa8, 0x0c, 0xbe, 0x21, 0xc0, 0x64, 0x46, 0xb8, 0x77, 0x8c,
-		0x5d, 0x4f, 0x70, 0x7c, 0x84, 0xcd, 0x08, 0x0a, 0xe3, 0xdc, 0xce, 0x81, 0x44, 0x24, 0x11, 0xa9,
-		0xf8, 0x1c, 0x45, 0x2b, 0xd9, 0xfb, 0x76, 0xb8, 0xde, 0x07, 0xa1, 0x48, 0x30, 0x83, 0x4a, 0x1f,
-		0x65, 0x8e, 0x9b, 0xbd, 0x1f, 0xaa, 0xf1, 0x19, 0x38, 0x49, 0x90, 0x7a, 0xd8, 0xd3, 0x3a, 0x9a,
-		0xa7, 0x85, 0xb0, 0x1f, 0xa7, 0xd8, 0x44, 0xec, 0xeb, 0xbc, 0x30, 0xd2, 0x4e, 0xa7, 0xbf, 0x73,
-		0xe8, 0x2b, 0xd6, 0x13, 0xac, 0x9d, 0x04, 0x26, 0x54, 0xeb, 0x6d, 0x73, 0xce, 0xe5, 0x15, 0x28,
-		0x84, 0xf5, 0x1e, 0xe5, 0x80, 0x69, 0xbe, 0x14, 0x23, 0x4e, 0x50, 0x7d, 0x73, 0x95, 0xb8, 0x2f,
-		0xaf, 0x36, 0xa4, 0x38, 0x71, 0xa3, 0xd6, 0x9a, 0xed, 0x86, 0xaa, 0x6c, 0x6f, 0xb4, 0x9b, 0xeb,
-		0x0d, 0x29, 0x11, 0x72, 0xec, 0x6f, 0x24, 0xb3, 0x0f, 0x4b, 0x8f, 0xc8, 0xbf, 0x94, 0x80, 0x52,
-		0x34, 0x52, 0x43, 0xef, 0x84, 0x53, 0x22, 0xe1, 0xe2, 0x62, 0x4f, 0xbd, 0x63, 0x38, 0x74, 0x42,
-		0xf6, 0x34, 0xb6, 0x38, 0xfa, 0xfa, 0x33, 0xcf, 0xb1, 0x5a, 0xd8, 0x7b, 0x8f, 0xe1, 0x90, 0xe9,
-		0xd6, 0xd3, 0x3c, 0xb4, 0x06, 0x8b, 0xa6, 0xa5, 0xba, 0x9e, 0x66, 0x76, 0x34, 0xa7, 0xa3, 0x06,
-		0xa9, 0x2e, 0x55, 0xd3, 0x75, 0xec, 0xba, 0x16, 0x5b, 0x08, 0x7d, 0x2e, 0xf7, 0x99, 0x56, 0x8b,
-		0x23, 0x07, 0x2b, 0x44, 0x95, 0xa3, 0x0e, 0xa8, 0x6f, 0x62, 0x9c, 0xfa, 0x9e, 0x81, 0x5c, 0x4f,
-		0xb3, 0x55, 0x6c, 0x7a, 0xce, 0x21, 0xf5, 0xcf, 0xb3, 0x4a, 0xb6, 0xa7, 0xd9, 0x0d, 0xf2, 0x8d,
-		0x6e, 0xc2, 0xc3, 0x01, 0xaa, 0xda, 0xc5, 0x7b, 0x9a, 0x7e, 0xa8, 0x52, 0x67, 0x9c, 0xa6, 0x0d,
-		0x54, 0xdd, 0x32, 0x77, 0xbb, 0x86, 0xee, 0xb9, 0xd4, 0x3e, 0x30, 0x1b, 0x27, 0x07, 0x14, 0x6b,
-		0x94, 0xe0, 0x86, 0x6b, 0x99, 0xd4, 0x07, 0xaf, 0x0b, 0xec, 0xaf, 0x4b, 0xf8, 0x75, 0x23, 0x99,
-		0x4d, 0x4a, 0xa9, 0x1b, 0xc9, 0x6c, 0x4a, 0x4a, 0xdf, 0x48, 0x66, 0xd3, 0x52, 0xe6, 0x46, 0x32,
-		0x9b, 0x95, 0x72, 0x37, 0x92, 0xd9, 0x9c, 0x04, 0xf2, 0xcf, 0x67, 0xa1, 0x10, 0x8e, 0x0c, 0x48,
-		0xa0, 0xa5, 0xd3, 0xb5, 0x31, 0x46, 0xad, 0xe7, 0x83, 0x47, 0xc6, 0x11, 0xcb, 0x75, 0xb2, 0x68,
-		0xae, 0xa4, 0x99, 0x1b, 0xae, 0x30, 0x4a, 0xe2, 0xb0, 0x10, 0xb5, 0xc6, 0xcc, 0xed, 0xc9, 0x2a,
-		0xfc, 0x0b, 0x5d, 0x83, 0xf4, 0x6b, 0x2e, 0xe5, 0x9d, 0xa6, 0xbc, 0xdf, 0x71, 0x34, 0xef, 0x1b,
-		0x2d, 0xca, 0x3c, 0x77, 0xa3, 0xa5, 0x6e, 0x6c, 0x2a, 0xeb, 0xd5, 0x35, 0x85, 0x93, 0xa3, 0xd3,
-		0x90, 0xec, 0x6a, 0xaf, 0x1f, 0x46, 0x97, 0x57, 0x0a, 0x42, 0xcb, 0x50, 0xee, 0x9b, 0xb7, 0xb1,
-		0x63, 0xec, 0x1a, 0x64, 0xa8, 0x08, 0x56, 0x39, 0x8c, 0x55, 0x0a, 0x4a, 0xd7, 0x08, 0xfe, 0x94,
-		0xea, 0x71, 0x1a, 0x92, 0x77, 0xb0, 0x76, 0x2b, 0xba, 0x08, 0x52, 0x10, 0x3a, 0x07, 0x85, 0x0e,
-		0xde, 0xe9, 0xef, 0xa9, 0x0e, 0xee, 0x68, 0xba, 0x17, 0x35, 0xfd, 0x79, 0x5a, 0xa4, 0xd0, 0x12,
-		0xf4, 0x22, 0xe4, 0xc8, 0x18, 0x99, 0x74, 0x8c, 0x67, 0xa9, 0x08, 0x9e, 0x38, 0x5a, 0x04, 0x7c,
-		0x88, 0x05, 0x91, 0x12, 0xd0, 0xa3, 0xab, 0x90, 0xf6, 0x34, 0x67, 0x0f, 0x7b, 0xd4, 0xf2, 0x97,
-		0x46, 0x24, 0x3f, 0x46, 0x70, 0x6a, 0x53, 0x0a, 0x1a, 0xd3, 0x72, 0xea, 0xb7, 0xd1, 0xca, 0x5c,
-		0x80, 0x14, 0x55, 0x0f, 0x04, 0xc0, 0x15, 0x44, 0x9a, 0x41, 0x59, 0x48, 0xd6, 0x37, 0x15, 0x62,
-		0x69, 0x24, 0x28, 0x30, 0xa8, 0xba, 0xd5, 0x6c, 0xd4, 0x1b, 0x52, 0x5c, 0xbe, 0x04, 0x69, 0x36,
-		0xe6, 0xc4, 0x0a, 0xf9, 0xa3, 0x2e, 0xcd, 0xf0, 0x4f, 0xce, 0x23, 0x26, 0x4a, 0xb7, 0xd7, 0x6b,
-		0x0d, 0x45, 0x8a, 0xcb, 0xdb, 0x50, 0x1e, 0x90, 0x13, 0x3a, 0x01, 0xb3, 0x4a, 0xa3, 0xdd, 0xd8,
-		0x20, 0x71, 0x96, 0xba, 0xbd, 0xf1, 0xe2, 0xc6, 0xe6, 0x7b, 0x36, 0xa4, 0x99, 0x28, 0x58, 0x98,
-		0xb4, 0x18, 0x9a, 0x07, 0x29, 0x00, 0xb7, 0x36, 0xb7, 0x15, 0xda, 0x9a, 0xef, 0x88, 0x83, 0x34,
-		0x28, 0x35, 0x74, 0x0a, 0xe6, 0xda, 0x55, 0xe5, 0x5a, 0xa3, 0xad, 0xb2, 0xd8, 0xd1, 0x67, 0x3d,
-		0x0f, 0x52, 0xb8, 0xe0, 0x6a, 0x93, 0x86, 0xc6, 0x8b, 0x70, 0x26, 0x0c, 0x6d, 0xbc, 0xdc, 0x6e,
-		0x6c, 0xb4, 0x68, 0xe5, 0xd5, 0x8d, 0x6b, 0xc4, 0xbe, 0x0e, 0xf0, 0x13, 0xd1, 0x6a, 0x82, 0x34,
-		0x35, 0xca, 0xaf, 0xb1, 0xb6, 0x2a, 0x25, 0x07, 0xc1, 0x9b, 0x1b, 0x8d, 0xcd, 0xab, 0x52, 0x6a,
-		0xb0, 0x76, 0x1a, 0xc1, 0xa6, 0x51, 0x05, 0x4e, 0x0e, 0x42, 0xd5, 0xc6, 0x46, 0x5b, 0x79, 0x45,
-		0xca, 0x0c, 0x56, 0xdc, 0x6a, 0x28, 0x37, 0x9b, 0xf5, 0x86, 0x94, 0x45, 0x27, 0x01, 0x45, 0x5b,
-		0xd4, 0xbe, 0xbe, 0xb9, 0x2a, 0xe5, 0x86, 0x2c, 0x8a, 0xec, 0x42, 0x21, 0x1c, 0x46, 0x7e, 0x7d,
-		0x72, 0x49, 0x1f, 0x89, 0x43, 0x3e, 0x14, 0x16, 0x12, 0x7f, 0x5e, 0xeb, 0x76, 0xad, 0x3b, 0xaa,
-		0xd6, 0x35, 0x34, 0x97, 0xdb, 0x1b, 0xa0, 0xa0, 0x2a, 0x81, 0x4c, 0x3b, 0xbf, 0xa7, 0xb7, 0xf0,
-		0xe9, 0x3f, 0x8f, 0x16, 0x3e, 0x25, 0xa5, 0xe5, 0x1f, 0x8a, 0x81, 0x34, 0x18, 0xef, 0x0d, 0x74,
-		0x3f, 0x36, 0xae, 0xfb, 0x5f, 0x97, 0xb1, 0xfb, 0x58, 0x0c, 0x4a, 0xd1, 0x20, 0x6f, 0xa0, 0x79,
-		0x0f, 0xfc, 0x99, 0x36, 0xef, 0xb7, 0xe3, 0x50, 0x8c, 0x84, 0x76, 0xd3, 0xb6, 0xee, 0xfd, 0x30,
-		0x6b, 0x74, 0x70, 0xcf, 0xb6, 0x3c, 0x6c, 0xea, 0x87, 0x6a, 0x17, 0xdf, 0xc6, 0xdd, 0x05, 0x99,
-		0x1a, 0xe5, 0x0b, 0x47, 0x07, 0x8f, 0xcb, 0xcd, 0x80, 0x6e, 0x8d, 0x90, 0xad, 0xcc, 0x35, 0x57,
-		0x1b, 0xeb, 0x5b, 0x9b, 0xed, 0xc6, 0x46, 0xfd, 0x15, 0x61, 0x5d, 0x14, 0xc9, 0x18, 0x40, 0x7b,
-		0x1b, 0x8d, 0xf6, 0x16, 0x48, 0x83, 0x8d, 0x22, 0xb6, 0x62, 0x44, 0xb3, 0xa4, 0x19, 0x34, 0x07,
-		0xe5, 0x8d, 0x4d, 0xb5, 0xd5, 0x5c, 0x6d, 0xa8, 0x8d, 0xab, 0x57, 0x1b, 0xf5, 0x76, 0x8b, 0xa5,
-		0x03, 0x7d, 0xec, 0xb6, 0x14, 0x0f, 0x8b, 0xf8, 0x07, 0x13, 0x30, 0x37, 0xa2, 0x25, 0xa8, 0xca,
-		0x03, 0x79, 0x96, 0x5b, 0x78, 0x62, 0x9a, 0xd6, 0x2f, 0x13, 0x57, 0x7a, 0x4b, 0x73, 0x3c, 0x1e,
-		0xf7, 0x3f, 0x0a, 0x44, 0x4a, 0xa6, 0x47, 0x56, 0x76, 0x87, 0xa7, 0x59, 0x59, 0x74, 0x5f, 0x0e,
-		0xe0, 0x2c, 0xd3, 0xfa, 0x38, 0x20, 0xdb, 0x72, 0x0d, 0xcf, 0xb8, 0x8d, 0x55, 0xc3, 0x14, 0x39,
-		0x59, 0x12, 0xed, 0x27, 0x15, 0x49, 0x94, 0x34, 0x4d, 0xcf, 0xc7, 0x36, 0xf1, 0x9e, 0x36, 0x80,
-		0x4d, 0x3c, 0x8f, 0x84, 0x22, 0x89, 0x12, 0x1f, 0xfb, 0x01, 0x28, 0x74, 0xac, 0x3e, 0x09, 0x81,
-		0x18, 0x1e, 0xb1, 0x16, 0x31, 0x25, 0xcf, 0x60, 0x3e, 0x0a, 0x0f, 0x6e, 0x83, 0x64, 0x70, 0x41,
-		0xc9, 0x33, 0x18, 0x43, 0x79, 0x04, 0xca, 0xda, 0xde, 0x9e, 0x43, 0x98, 0x0b, 0x46, 0x2c, 0x5c,
-		0x2f, 0xf9, 0x60, 0x8a, 0x58, 0xb9, 0x01, 0x59, 0x21, 0x07, 0xe2, 0xc1, 0x12, 0x49, 0xa8, 0x36,
-		0xcb, 0x41, 0xc5, 0xcf, 0xe5, 0x94, 0xac, 0x29, 0x0a, 0x1f, 0x80, 0x82, 0xe1, 0xaa, 0xc1, 0xde,
-		0x56, 0x7c, 0x29, 0x7e, 0x2e, 0xab, 0xe4, 0x0d, 0xd7, 0xdf, 0x17, 0x90, 0x3f, 0x19, 0x87, 0x52,
-		0x74, 0xd7, 0x0e, 0xad, 0x42, 0xb6, 0x6b, 0xe9, 0x1a, 0x55, 0x2d, 0xb6, 0x65, 0x7c, 0x6e, 0xc2,
-		0x46, 0xdf, 0xf2, 0x1a, 0xc7, 0x57, 0x7c, 0xca, 0xca, 0xbf, 0x8d, 0x41, 0x56, 0x80, 0xd1, 0x49,
-		0x48, 0xda, 0x9a, 0xb7, 0x4f, 0xd9, 0xa5, 0x6a, 0x71, 0x29, 0xa6, 0xd0, 0x6f, 0x02, 0x77, 0x6d,
-		0xcd, 0xa4, 0x2a, 0xc0, 0xe1, 0xe4, 0x9b, 0x8c, 0x6b, 0x17, 0x6b, 0x1d, 0x9a, 0x0b, 0xb0, 0x7a,
-		0x3d, 0x6c, 0x7a, 0xae, 0x18, 0x57, 0x0e, 0xaf, 0x73, 0x30, 0x7a, 0x0c, 0x66, 0x3d, 0x47, 0x33,
-		0xba, 0x11, 0xdc, 0x24, 0xc5, 0x95, 0x44, 0x81, 0x8f, 0xbc, 0x02, 0xa7, 0x05, 0xdf, 0x0e, 0xf6,
-		0x34, 0x7d, 0x1f, 0x77, 0x02, 0xa2, 0x34, 0xcd, 0xf9, 0x9d, 0xe2, 0x08, 0xab, 0xbc, 0x5c, 0xd0,
-		0xca, 0x9f, 0x8d, 0xc3, 0xac, 0xc8, 0x5e, 0x74, 0x7c, 0x61, 0xad, 0x03, 0x68, 0xa6, 0x69, 0x79,
-		0x61, 0x71, 0x0d, 0xab, 0xf2, 0x10, 0xdd, 0x72, 0xd5, 0x27, 0x52, 0x42, 0x0c, 0x2a, 0xbf, 0x17,
-		0x03, 0x08, 0x8a, 0xc6, 0xca, 0x6d, 0x11, 0xf2, 0x7c, 0x4f, 0x96, 0x6e, 0xec, 0xb3, 0x84, 0x17,
-		0x30, 0xd0, 0x55, 0xa3, 0x4b, 0xd3, 0x92, 0x3b, 0x78, 0xcf, 0x30, 0xf9, 0x7e, 0x0a, 0xfb, 0x10,
-		0x69, 0xc9, 0x64, 0xb0, 0x3d, 0xa5, 0x40, 0xd6, 0xc5, 0x3d, 0xcd, 0xf4, 0x0c, 0x9d, 0xef, 0x90,
-		0x5c, 0x3e, 0x56, 0xe3, 0x97, 0x5b, 0x9c, 0x5a, 0xf1, 0xf9, 0xc8, 0xe7, 0x20, 0x2b, 0xa0, 0xc4,
-		0xf1, 0xdb, 0xd8, 0xdc, 0x68, 0x48, 0x33, 0x28, 0x03, 0x89, 0x56, 0xa3, 0x2d, 0xc5, 0x48, 0xd8,
-		0x59, 0x5d, 0x6b, 0x56, 0x5b, 0x52, 0xbc, 0xf6, 0xd7, 0x60, 0x4e, 0xb7, 0x7a, 0x83, 0x15, 0xd6,
-		0xa4, 0x81, 0x94, 0x9f, 0x7b, 0x3d, 0xf6, 0xea, 0x13, 0x1c, 0x69, 0xcf, 0xea, 0x6a, 0xe6, 0xde,
-		0xb2, 0xe5, 0xec, 0x05, 0xc7, 0x22, 0x48, 0x74, 0xe0, 0x86, 0x0e, 0x47, 0xd8, 0x3b, 0x7f, 0x1a,
-		0x8b, 0xfd, 0x48, 0x3c, 0x71, 0x6d, 0xab, 0xf6, 0x93, 0xf1, 0xca, 0x35, 0x46, 0xb8, 0x25, 0xba,
-		0xa3, 0xe0, 0xdd, 0x2e, 0xd6, 0x49, 0xe3, 0xe1, 0xf7, 0x1f, 0x83, 0xf9, 0x3d, 0x6b, 0xcf, 0xa2,
-		0x9c, 0x2e, 0x90, 0x5f, 0xfc, 0x5c, 0x45, 0xce, 0x87, 0x56, 0x26, 0x1e, 0xc2, 0x58, 0xd9, 0x80,
-		0x39, 0x8e, 0xac, 0xd2, 0xed, 0x5b, 0x96, 0x5c, 0x40, 0x47, 0x66, 0xb6, 0x17, 0x7e, 0xfa, 0x77,
-		0xa9, 0x57, 0xa2, 0xcc, 0x72, 0x52, 0x52, 0xc6, 0xf2, 0x0f, 0x2b, 0x0a, 0x9c, 0x88, 0xf0, 0x63,
-		0x36, 0x02, 0x3b, 0x13, 0x38, 0xfe, 0x0b, 0xce, 0x71, 0x2e, 0xc4, 0xb1, 0xc5, 0x49, 0x57, 0xea,
-		0x50, 0x3c, 0x0e, 0xaf, 0x7f, 0xc9, 0x79, 0x15, 0x70, 0x98, 0xc9, 0x35, 0x28, 0x53, 0x26, 0x7a,
-		0xdf, 0xf5, 0xac, 0x1e, 0x35, 0xc0, 0x47, 0xb3, 0xf9, 0x57, 0xbf, 0xcb, 0x26, 0x6d, 0x89, 0x90,
-		0xd5, 0x7d, 0xaa, 0x95, 0x15, 0xa0, 0x3b, 0xd6, 0x1d, 0xac, 0x77, 0x27, 0x70, 0xf8, 0x0c, 0x6f,
-		0x88, 0x8f, 0xbf, 0x72, 0x13, 0xe6, 0xc9, 0x6f, 0x6a, 0x1f, 0xc3, 0x2d, 0x99, 0x9c, 0x06, 0x5f,
-		0xf8, 0x77, 0x1f, 0x64, 0x76, 0x61, 0xce, 0x67, 0x10, 0x6a, 0x53, 0x68, 0x14, 0xf7, 0xb0, 0xe7,
-		0x61, 0xc7, 0x55, 0xb5, 0xee, 0xa8, 0xe6, 0x85, 0xf2, 0x88, 0x0b, 0x3f, 0xf0, 0xe5, 0xe8, 0x28,
-		0x5e, 0x63, 0x94, 0xd5, 0x6e, 0x77, 0x65, 0x1b, 0x4e, 0x8d, 0xd0, 0x8a, 0x29, 0x78, 0xfe, 0x20,
-		0xe7, 0x39, 0x3f, 0xa4, 0x19, 0x84, 0xed, 0x16, 0x08, 0xb8, 0x3f, 0x96, 0x53, 0xf0, 0xfc, 0x28,
-		0xe7, 0x89, 0x38, 0xad, 0x18, 0x52, 0xc2, 0xf1, 0x06, 0xcc, 0xde, 0xc6, 0xce, 0x8e, 0xe5, 0xf2,
-		0xdc, 0xed, 0x14, 0xec, 0x3e, 0xc6, 0xd9, 0x95, 0x39, 0x21, 0x4d, 0xe6, 0x12, 0x5e, 0xcf, 0x43,
-		0x76, 0x57, 0xd3, 0xf1, 0x14, 0x2c, 0xee, 0x72, 0x16, 0x19, 0x82, 0x4f, 0x48, 0xab, 0x50, 0xd8,
-		0xb3, 0xf8, 0x12, 0x39, 0x99, 0xfc, 0x87, 0x38, 0x79, 0x5e, 0xd0, 0x70, 0x16, 0xb6, 0x65, 0xf7,
-		0xbb, 0x64, 0xfd, 0x9c, 0xcc, 0xe2, 0x87, 0x05, 0x0b, 0x41, 0xc3, 0x59, 0x1c, 0x43, 0xac, 0x1f,
-		0x17, 0x2c, 0xdc, 0x90, 0x3c, 0x5f, 0x80, 0xbc, 0x65, 0x76, 0x0f, 0x2d, 0x73, 0x9a, 0x46, 0x7c,
-		0x82, 0x73, 0x00, 0x4e, 0x42, 0x18, 0x5c, 0x81, 0xdc, 0xb4, 0x03, 0xf1, 0x63, 0x5f, 0x16, 0xd3,
-		0x43, 0x8c, 0xc0, 0x35, 0x28, 0x0b, 0x03, 0x65, 0x58, 0xe6, 0x14, 0x2c, 0x7e, 0x9c, 0xb3, 0x28,
-		0x85, 0xc8, 0x78, 0x37, 0x3c, 0xec, 0x7a, 0x7b, 0x78, 0x1a, 0x26, 0x9f, 0x14, 0xdd, 0xe0, 0x24,
-		0x5c, 0x94, 0x3b, 0xd8, 0xd4, 0xf7, 0xa7, 0xe3, 0xf0, 0x29, 0x21, 0x4a, 0x41, 0x43, 0x58, 0xd4,
-		0xa1, 0xd8, 0xd3, 0x1c, 0x77, 0x5f, 0xeb, 0x4e, 0x35, 0x1c, 0x3f, 0xc1, 0x79, 0x14, 0x7c, 0x22,
-		0x2e, 0x91, 0xbe, 0x79, 0x1c, 0x36, 0x3f, 0x29, 0x24, 0x12, 0x22, 0xe3, 0x53, 0xcf, 0xf5, 0x68,
-		0xa2, 0xfb, 0x38, 0xdc, 0xfe, 0xae, 0x98, 0x7a, 0x8c, 0x76, 0x3d, 0xcc, 0xf1, 0x0a, 0xe4, 0x5c,
-		0xe3, 0xf5, 0xa9, 0xd8, 0xfc, 0x3d, 0x31, 0xd2, 0x94, 0x80, 0x10, 0xbf, 0x02, 0xa7, 0x47, 0x2e,
-		0x13, 0x53, 0x30, 0xfb, 0xfb, 0x9c, 0xd9, 0xc9, 0x11, 0x4b, 0x05, 0x37, 0x09, 0xc7, 0x65, 0xf9,
-		0x0f, 0x84, 0x49, 0xc0, 0x03, 0xbc, 0xb6, 0x48, 0xd0, 0xe2, 0x6a, 0xbb, 0xc7, 0x93, 0xda, 0x3f,
-		0x14, 0x52, 0x63, 0xb4, 0x11, 0xa9, 0xb5, 0xe1, 0x24, 0xe7, 0x78, 0xbc, 0x71, 0xfd, 0x29, 0x61,
-		0x58, 0x19, 0xf5, 0x76, 0x74, 0x74, 0xbf, 0x01, 0x2a, 0xbe, 0x38, 0x85, 0x77, 0xec, 0xaa, 0x3d,
-		0xcd, 0x9e, 0x82, 0xf3, 0x4f, 0x73, 0xce, 0xc2, 0xe2, 0xfb, 0xee, 0xb5, 0xbb, 0xae, 0xd9, 0x84,
-		0xf9, 0xcb, 0xb0, 0x20, 0x98, 0xf7, 0x4d, 0x07, 0xeb, 0xd6, 0x9e, 0x69, 0xbc, 0x8e, 0x3b, 0x53,
-		0xb0, 0xfe, 0x99, 0x81, 0xa1, 0xda, 0x0e, 0x91, 0x13, 0xce, 0x4d, 0x90, 0x7c, 0x5f, 0x45, 0x35,
-		0x7a, 0xb6, 0xe5, 0x78, 0x13, 0x38, 0xfe, 0xac, 0x18, 0x29, 0x9f, 0xae, 0x49, 0xc9, 0x56, 0x1a,
-		0xc0, 0x4e, 0x7f, 0x4c, 0xab, 0x92, 0x9f, 0xe6, 0x8c, 0x8a, 0x01, 0x15, 0x37, 0x1c, 0xba, 0xd5,
-		0xb3, 0x35, 0x67, 0x1a, 0xfb, 0xf7, 0x8f, 0x84, 0xe1, 0xe0, 0x24, 0xdc, 0x70, 0x10, 0x8f, 0x8e,
-		0xac, 0xf6, 0x53, 0x70, 0xf8, 0x39, 0x61, 0x38, 0x04, 0x0d, 0x67, 0x21, 0x1c, 0x86, 0x29, 0x58,
-		0xfc, 0xbc, 0x60, 0x21, 0x68, 0x08, 0x8b, 0x97, 0x82, 0x85, 0xd6, 0xc1, 0x7b, 0x86, 0xeb, 0x39,
-		0xcc, 0x25, 0x3f, 0x9a, 0xd5, 0x2f, 0x7c, 0x39, 0xea, 0x84, 0x29, 0x21, 0x52, 0x62, 0x89, 0xf8,
-		0xd6, 0x07, 0x0d, 0xd9, 0x26, 0x37, 0xec, 0x17, 0x85, 0x25, 0x0a, 0x91, 0x91, 0xb6, 0x85, 0x3c,
-		0x44, 0x22, 0x76, 0x9d, 0x04, 0x2a, 0x53, 0xb0, 0xfb, 0xc7, 0x03, 0x8d, 0x6b, 0x09, 0x5a, 0xc2,
-		0x33, 0xe4, 0xff, 0xf4, 0xcd, 0x5b, 0xf8, 0x70, 0x2a, 0xed, 0xfc, 0xa5, 0x01, 0xff, 0x67, 0x9b,
-		0x51, 0x32, 0x1b, 0x52, 0x1e, 0xf0, 0xa7, 0xd0, 0xa4, 0xb3, 0x7e, 0x0b, 0xdf, 0xfc, 0x15, 0xde,
-		0xdf, 0xa8, 0x3b, 0xb5, 0xb2, 0x46, 0x94, 0x3c, 0xea, 0xf4, 0x4c, 0x66, 0xf6, 0xc1, 0xaf, 0xf8,
-		0x7a, 0x1e, 0xf1, 0x79, 0x56, 0xae, 0x42, 0x31, 0xe2, 0xf0, 0x4c, 0x66, 0xf5, 0x2d, 0x9c, 0x55,
-		0x21, 0xec, 0xef, 0xac, 0x5c, 0x82, 0x24, 0x71, 0x5e, 0x26, 0x93, 0x7f, 0x2b, 0x27, 0xa7, 0xe8,
-		0x2b, 0xef, 0x82, 0xac, 0x70, 0x5a, 0x26, 0x93, 0xfe, 0x0d, 0x4e, 0xea, 0x93, 0x10, 0x72, 0xe1,
-		0xb0, 0x4c, 0x26, 0xff, 0x9b, 0x82, 0x5c, 0x90, 0x10, 0xf2, 0xe9, 0x45, 0xf8, 0x2b, 0xdf, 0x9e,
-		0xe4, 0x8b, 0x8e, 0x90, 0xdd, 0x15, 0xc8, 0x70, 0x4f, 0x65, 0x32, 0xf5, 0xb7, 0xf1, 0xca, 0x05,
-		0xc5, 0xca, 0xb3, 0x90, 0x9a, 0x52, 0xe0, 0xdf, 0xc9, 0x49, 0x19, 0xfe, 0x4a, 0x1d, 0xf2, 0x21,
-		0xef, 0x64, 0x32, 0xf9, 0x77, 0x71, 0xf2, 0x30, 0x15, 0x69, 0x3a, 0xf7, 0x4e, 0x26, 0x33, 0xf8,
-		0x5b, 0xa2, 0xe9, 0x9c, 0x82, 0x88, 0x4d, 0x38, 0x26, 0x93, 0xa9, 0x3f, 0x2c, 0xa4, 0x2e, 0x48,
-		0x56, 0x5e, 0x80, 0x9c, 0xbf, 0xd8, 0x4c, 0xa6, 0xff, 0x6e, 0x4e, 0x1f, 0xd0, 0x10, 0x09, 0x84,
-		0x16, 0xbb, 0xc9, 0x2c, 0xfe, 0xb6, 0x90, 0x40, 0x88, 0x8a, 0x4c, 0xa3, 0x41, 0x07, 0x66, 0x32,
-		0xa7, 0xef, 0x11, 0xd3, 0x68, 0xc0, 0x7f, 0x21, 0xa3, 0x49, 0x6d, 0xfe, 0x64, 0x16, 0xdf, 0x2b,
-		0x46, 0x93, 0xe2, 0x93, 0x66, 0x0c, 0x7a, 0x04, 0x93, 0x79, 0x7c, 0xbf, 0x68, 0xc6, 0x80, 0x43,
-		0xb0, 0xb2, 0x05, 0x68, 0xd8, 0x1b, 0x98, 0xcc, 0xef, 0x23, 0x9c, 0xdf, 0xec, 0x90, 0x33, 0xb0,
-		0xf2, 0x1e, 0x38, 0x39, 0xda, 0x13, 0x98, 0xcc, 0xf5, 0x07, 0xbe, 0x32, 0x10, 0xbb, 0x85, 0x1d,
-		0x81, 0x95, 0x76, 0xb0, 0xa4, 0x84, 0xbd, 0x80, 0xc9, 0x6c, 0x7f, 0xf0, 0x2b, 0x51, 0xc3, 0x1d,
-		0x76, 0x02, 0x56, 0xaa, 0x00, 0xc1, 0x02, 0x3c, 0x99, 0xd7, 0xc7, 0x38, 0xaf, 0x10, 0x11, 0x99,
-		0x1a, 0x7c, 0xfd, 0x9d, 0x4c, 0x7f, 0x57, 0x4c, 0x0d, 0x4e, 0x41, 0xa6, 0x86, 0x58, 0x7a, 0x27,
-		0x53, 0xff, 0x90, 0x98, 0x1a, 0x82, 0x84, 0x68, 0x76, 0x68, 0x75, 0x9b, 0xcc, 0xe1, 0x13, 0x42,
-		0xb3, 0x43, 0x54, 0x2b, 0x1b, 0x30, 0x3b, 0xb4, 0x20, 0x4e, 0x66, 0xf5, 0x23, 0x9c, 0x95, 0x34,
-		0xb8, 0x1e, 0x86, 0x17, 0x2f, 0xbe, 0x18, 0x4e, 0xe6, 0xf6, 0xa3, 0x03, 0x8b, 0x17, 0x5f, 0x0b,
-		0x57, 0xae, 0x40, 0xd6, 0xec, 0x77, 0xbb, 0x64, 0xf2, 0xa0, 0xa3, 0xcf, 0xe7, 0x2e, 0xfc, 0xd7,
-		0xaf, 0x72, 0xe9, 0x08, 0x82, 0x95, 0x4b, 0x90, 0xc2, 0xbd, 0x1d, 0xdc, 0x99, 0x44, 0xf9, 0xa5,
-		0xaf, 0x0a, 0x83, 0x49, 0xb0, 0x57, 0x5e, 0x00, 0x60, 0xa9, 0x11, 0xba, 0x71, 0x3e, 0x81, 0xf6,
-		0xf7, 0xbe, 0xca, 0x0f, 0xc4, 0x05, 0x24, 0x01, 0x03, 0x76, 0xbc, 0xee, 0x68, 0x06, 0x5f, 0x8e,
-		0x32, 0xa0, 0x23, 0xf2, 0x3c, 0x64, 0x5e, 0x73, 0x2d, 0xd3, 0xd3, 0xf6, 0x26, 0x51, 0xff, 0x3e,
-		0xa7, 0x16, 0xf8, 0x44, 0x60, 0x3d, 0xcb, 0xc1, 0x9e, 0xb6, 0xe7, 0x4e, 0xa2, 0xfd, 0x6f, 0x9c,
-		0xd6, 0x27, 0x20, 0xc4, 0xba, 0xe6, 0x7a, 0xd3, 0xf4, 0xfb, 0xbf, 0x0b, 0x62, 0x41, 0x40, 0x1a,
-		0x4d, 0x7e, 0xdf, 0xc2, 0x87, 0x93, 0x68, 0xff, 0x40, 0x34, 0x9a, 0xe3, 0xaf, 0xbc, 0x0b, 0x72,
-		0xe4, 0x27, 0x3b, 0xe5, 0x3a, 0x81, 0xf8, 0x0f, 0x39, 0x71, 0x40, 0x41, 0x6a, 0x76, 0xbd, 0x8e,
-		0x67, 0x4c, 0x16, 0xf6, 0x1f, 0xf1, 0x91, 0x16, 0xf8, 0x2b, 0x55, 0xc8, 0xbb, 0x5e, 0xa7, 0xd3,
-		0xe7, 0xfe, 0xe9, 0x04, 0xf2, 0x3f, 0xfe, 0xaa, 0x9f, 0xb2, 0xf0, 0x69, 0xc8, 0x68, 0xdf, 0xb9,
-		0xe5, 0xd9, 0x16, 0xdd, 0x6f, 0x99, 0xc4, 0xe1, 0x2b, 0x9c, 0x43, 0x88, 0x64, 0xa5, 0x0e, 0x05,
-		0xd2, 0x17, 0x07, 0xdb, 0x98, 0x6e, 0x8e, 0x4d, 0x60, 0xf1, 0x27, 0x5c, 0x00, 0x11, 0xa2, 0xda,
-		0x37, 0x7d, 0xe6, 0x73, 0x67, 0x63, 0x9f, 0xfd, 0xdc, 0xd9, 0xd8, 0x6f, 0x7f, 0xee, 0x6c, 0xec,
-		0xc3, 0x9f, 0x3f, 0x3b, 0xf3, 0xd9, 0xcf, 0x9f, 0x9d, 0xf9, 0xcd, 0xcf, 0x9f, 0x9d, 0x19, 0x9d,
-		0x25, 0x86, 0x6b, 0xd6, 0x35, 0x8b, 0xe5, 0x87, 0x5f, 0x7d, 0x68, 0xcf, 0xf0, 0xf6, 0xfb, 0x3b,
-		0xcb, 0xba, 0xd5, 0xbb, 0xa0, 0x5b, 0x6e, 0xcf, 0x72, 0x2f, 0x44, 0xf3, 0xba, 0xf4, 0x17, 0xfc,
-		0xef, 0x18, 0x89, 0x99, 0xa3, 0xe9, 0x5c, 0xcd, 0x3c, 0x1c, 0x77, 0x99, 0xee, 0x32, 0x24, 0xaa,
-		0xe6, 0x21, 0x3a, 0xcd, 0x0c, 0x9c, 0xda, 0x77, 0xba, 0xfc, 0xa8, 0x65, 0x86, 0x7c, 0x6f, 0x3b,
-		0x5d, 0x34, 0x1f, 0x9c, 0x87, 0x8e, 0x9d, 0x2b, 0xf0, 0x43, 0xce, 0xb5, 0xef, 0x8a, 0x1d, 0xaf,
-		0x27, 0xd9, 0xaa, 0x79, 0x48, 0x3b, 0xb2, 0x15, 0x7b, 0xf5, 0xf1, 0x89, 0x79, 0xee, 0x5b, 0xa6,
-		0x75, 0xc7, 0x24, 0xcd, 0xb6, 0x77, 0x44, 0x8e, 0xfb, 0xec, 0x60, 0x8e, 0xfb, 0x3d, 0xb8, 0xdb,
-		0x7d, 0x91, 0xe0, 0xb5, 0x09, 0xc9, 0x4e, 0x9a, 0x9d, 0xea, 0x87, 0xef, 0x89, 0xc3, 0xd9, 0xa1,
-		0x74, 0x36, 0x57, 0x82, 0x71, 0x42, 0x58, 0x81, 0xec, 0xaa, 0xd0, 0xad, 0x05, 0xc8, 0xb8, 0x58,
-		0xb7, 0xcc, 0x8e, 0x4b, 0x05, 0x91, 0x50, 0xc4, 0x27, 0x11, 0x84, 0xa9, 0x99, 0x96, 0xcb, 0x0f,
-		0x2b, 0xb3, 0x8f, 0xda, 0x47, 0x8f, 0x29, 0x88, 0xa2, 0xa8, 0x49, 0x48, 0xe3, 0xa9, 0x29, 0xa5,
-		0x21, 0x3a, 0x11, 0xc9, 0xfc, 0x4f, 0x2b, 0x95, 0xef, 0x8f, 0xc3, 0xe2, 0xa0, 0x54, 0xc8, 0xcc,
-		0x72, 0x3d, 0xad, 0x67, 0x8f, 0x13, 0xcb, 0x15, 0xc8, 0xb5, 0x05, 0xce, 0xb1, 0xe5, 0x72, 0xf7,
-		0x98, 0x72, 0x29, 0xf9, 0x55, 0x09, 0xc1, 0x5c, 0x9c, 0x52, 0x30, 0x7e, 0x3f, 0xee, 0x49, 0x32,
-		0xff, 0x2b, 0x0d, 0xa7, 0xd9, 0x74, 0x52, 0xd9, 0x54, 0x62, 0x1f, 0x5c, 0x26, 0x85, 0x70, 0xd1,
-		0xe4, 0x7d, 0x12, 0xf9, 0x45, 0x98, 0x6b, 0x12, 0x6b, 0x41, 0xa2, 0xa0, 0x60, 0x87, 0x67, 0xe4,
-		0x79, 0xee, 0xa5, 0x88, 0xc3, 0xcf, 0xf7, 0xb7, 0xc2, 0x20, 0xf9, 0x9b, 0x63, 0x20, 0xb5, 0x74,
-		0xad, 0xab, 0x39, 0x5f, 0x2b, 0x2b, 0xf4, 0x2c, 0x00, 0x3b, 0xee, 0xe1, 0x5f, 0xdc, 0x2b, 0x5d,
-		0x5c, 0x58, 0x0e, 0x77, 0x6e, 0x99, 0xd5, 0x44, 0x4f, 0x50, 0xe5, 0x28, 0x2e, 0xf9, 0x79, 0xfe,
-		0x65, 0x80, 0xa0, 0x00, 0x9d, 0x81, 0x53, 0xad, 0x7a, 0x75, 0xad, 0xaa, 0x88, 0x43, 0x42, 0xad,
-		0xad, 0x46, 0xbd, 0x79, 0xb5, 0xd9, 0x58, 0x95, 0x66, 0xd0, 0x49, 0x40, 0xe1, 0x42, 0xff, 0x50,
-		0xd3, 0x09, 0x98, 0x0d, 0xc3, 0xd9, 0x2d, 0x95, 0x38, 0xf1, 0x14, 0x8d, 0x9e, 0xdd, 0xc5, 0x74,
-		0xe7, 0x51, 0x35, 0x84, 0xd4, 0x26, 0x3b, 0x21, 0xbf, 0xf6, 0xef, 0xd9, 0xcd, 0x85, 0xb9, 0x80,
-		0xdc, 0x97, 0xf9, 0xca, 0x1a, 0xcc, 0x6a, 0xba, 0x8e, 0xed, 0x08, 0xcb, 0x09, 0xa6, 0x9a, 0x30,
-		0xa4, 0x7b, 0xa9, 0x9c, 0x32, 0xe0, 0xf6, 0x2c, 0xa4, 0x5d, 0xda, 0xfb, 0x49, 0x2c, 0x7e, 0x9d,
-		0xb3, 0xe0, 0xe8, 0x2b, 0x26, 0xcc, 0x12, 0xcf, 0x4f, 0x73, 0x70, 0xa8, 0x19, 0x47, 0xe7, 0x19,
-		0xfe, 0xc9, 0xcf, 0x3e, 0x49, 0x77, 0x56, 0x1f, 0x88, 0x0e, 0xcb, 0x08, 0x75, 0x52, 0x24, 0xce,
-		0x3b, 0x68, 0x28, 0x86, 0x92, 0xa8, 0x8f, 0x37, 0xf8, 0xe8, 0xca, 0x7e, 0x99, 0x57, 0x76, 0x76,
-		0x94, 0x0e, 0x84, 0x6a, 0x2a, 0x72, 0xae, 0xac, 0xa0, 0xd6, 0x18, 0x37, 0xa7, 0x5f, 0x7d, 0x6c,
-		0x78, 0x75, 0x62, 0xff, 0x3d, 0x41, 0x39, 0x5f, 0x09, 0x57, 0xe3, 0xcf, 0xbd, 0x8f, 0x26, 0x61,
-		0x56, 0xeb, 0x19, 0xa6, 0x75, 0x81, 0xfe, 0xcb, 0xe7, 0x5c, 0x8a, 0x7e, 0x4c, 0xb1, 0x29, 0x79,
-		0x99, 0x4d, 0x85, 0xc9, 0x1a, 0xf3, 0x87, 0xdf, 0xf1, 0xe3, 0xa9, 0x60, 0xba, 0xac, 0xac, 0x83,
-		0x24, 0x0e, 0xf1, 0x62, 0x53, 0xb7, 0x3a, 0x53, 0x65, 0x29, 0xfe, 0x48, 0xf0, 0x10, 0xf9, 0xad,
-		0x06, 0x27, 0x5d, 0x79, 0x27, 0x64, 0x7d, 0x36, 0x93, 0x3c, 0x13, 0xc1, 0xc4, 0xa7, 0x20, 0x7e,
-		0x09, 0x9b, 0x99, 0xd3, 0x78, 0xa1, 0x5f, 0x11, 0xf4, 0x6c, 0x86, 0x6e, 0x90, 0xde, 0x5c, 0x83,
-		0x52, 0xc7, 0x32, 0x3d, 0xd5, 0xea, 0x19, 0x1e, 0xee, 0xd9, 0xde, 0x44, 0xbf, 0xee, 0x4f, 0x18,
-		0x93, 0xac, 0x52, 0x24, 0x74, 0x9b, 0x82, 0x8c, 0xb4, 0x84, 0xdd, 0xd3, 0x9b, 0xa6, 0x25, 0xff,
-		0xc3, 0x6f, 0x09, 0xa5, 0x21, 0x2d, 0xb9, 0x27, 0xed, 0x70, 0x3b, 0xb7, 0xb8, 0x31, 0xf7, 0x0e,
-		0x98, 0x16, 0xf8, 0xda, 0xf1, 0xc9, 0x04, 0x9c, 0xe5, 0xc8, 0x3b, 0x9a, 0x8b, 0x2f, 0xdc, 0x7e,
-		0x6a, 0x07, 0x7b, 0xda, 0x53, 0x17, 0x74, 0xcb, 0x10, 0x2b, 0xf9, 0x1c, 0x37, 0xd6, 0xa4, 0x7c,
-		0x99, 0x97, 0x57, 0x46, 0x6e, 0x77, 0x57, 0xc6, 0x1b, 0xf9, 0xca, 0xb0, 0x0e, 0xca, 0x5d, 0x48,
-		0xd6, 0x2d, 0xc3, 0x24, 0x6b, 0x5b, 0x07, 0x9b, 0x56, 0x8f, 0x9b, 0x5b, 0xf6, 0x81, 0xae, 0x43,
-		0x5a, 0xeb, 0x59, 0x7d, 0xd3, 0x63, 0xa6, 0xb6, 0xf6, 0xe4, 0x67, 0xde, 0x5c, 0x9c, 0xf9, 0x4f,
-		0x6f, 0x2e, 0x9e, 0x60, 0x6c, 0xdd, 0xce, 0xad, 0x65, 0xc3, 0xba, 0xd0, 0xd3, 0xbc, 0x7d, 0x32,
-		0x7d, 0x7f, 0xe3, 0xd3, 0x4f, 0x00, 0xaf, 0xaf, 0x69, 0x7a, 0x9f, 0xfa, 0xc2, 0x4f, 0x9d, 0x8f,
-		0x29, 0x9c, 0x7e, 0x25, 0xf9, 0xc5, 0x8f, 0x2f, 0xc6, 0x64, 0x1b, 0x32, 0xab, 0x58, 0x3f, 0xa2,
-		0xc2, 0xe6, 0x40, 0x85, 0x4f, 0xf1, 0x0a, 0xcf, 0x0c, 0x57, 0xc8, 0x0e, 0xec, 0xad, 0x62, 0x3d,
-		0x54, 0xed, 0x2a, 0xd6, 0xa3, 0x35, 0xd6, 0x56, 0x7f, 0xf3, 0x77, 0xce, 0xce, 0x7c, 0xe0, 0x73,
-		0x67, 0x67, 0xc6, 0x0e, 0x99, 0x3c, 0x79, 0xc8, 0xfc, 0x91, 0xfa, 0xf1, 0x24, 0xdc, 0x4f, 0x6f,
-		0x84, 0x3a, 0x3d, 0xc3, 0xf4, 0x2e, 0xe8, 0xce, 0xa1, 0xed, 0x59, 0x64, 0xe2, 0x5a, 0xbb, 0x7c,
-		0xa0, 0x66, 0x83, 0xe2, 0x65, 0x56, 0x3c, 0x7a, 0x98, 0xe4, 0x5d, 0x48, 0x6d, 0x11, 0x3a, 0x22,
-		0x08, 0xcf, 0xf2, 0xb4, 0x2e, 0xf7, 0x36, 0xd8, 0x07, 0x81, 0xb2, 0x5b, 0xa4, 0x71, 0x06, 0x35,
-		0xc4, 0x05, 0xd2, 0x2e, 0xd6, 0x76, 0xd9, 0x65, 0x9c, 0x04, 0x75, 0x53, 0xb3, 0x04, 0x40, 0xef,
-		0xdd, 0xcc, 0x43, 0x4a, 0xeb, 0xb3, 0x03, 0x33, 0x09, 0xe2, 0xbf, 0xd2, 0x0f, 0xf9, 0x45, 0xc8,
-		0xf0, 0x7d, 0x73, 0x24, 0x41, 0xe2, 0x16, 0x3e, 0xa4, 0xf5, 0x14, 0x14, 0xf2, 0x13, 0x2d, 0x43,
-		0x8a, 0x36, 0x9e, 0xdf, 0x32, 0x5c, 0x58, 0x1e, 0x6a, 0xfd, 0x32, 0x6d, 0xa4, 0xc2, 0xd0, 0xe4,
-		0x1b, 0x90, 0x5d, 0xb5, 0x88, 0xf6, 0x44, 0xb9, 0xe5, 0x18, 0x37, 0xda, 0x66, 0xbb, 0xcf, 0xc7,
-		0x4e, 0x61, 0x1f, 0xe8, 0x24, 0xa4, 0xd9, 0xe5, 0x2c, 0x7e, 0xe8, 0x87, 0x7f, 0xc9, 0x75, 0xc8,
-		0x50, 0xde, 0x9b, 0x36, 0x59, 0xea, 0xfd, 0xf3, 0xea, 0x39, 0x7e, 0x55, 0x97, 0xb3, 0x8f, 0x07,
-		0x8d, 0x45, 0x90, 0xec, 0x68, 0x9e, 0xc6, 0xfb, 0x4d, 0x7f, 0xcb, 0xef, 0x86, 0x2c, 0x67, 0xe2,
-		0xa2, 0x8b, 0x90, 0xb0, 0x6c, 0x97, 0x1f, 0xdb, 0xa9, 0x8c, 0xeb, 0xca, 0xa6, 0x5d, 0x4b, 0x12,
-		0xa5, 0x52, 0x08, 0x72, 0x6d, 0x63, 0xac, 0x5a, 0x3c, 0x13, 0x51, 0x8b, 0x1e, 0xf6, 0x76, 0x76,
-		0xbd, 0xe0, 0x07, 0x1b, 0xce, 0x21, 0x55, 0xf0, 0x15, 0xe5, 0x6e, 0x1c, 0xce, 0x86, 0x4a, 0x6f,
-		0x63, 0xc7, 0x35, 0x2c, 0x93, 0x69, 0x13, 0xd7, 0x14, 0x14, 0x6a, 0x20, 0x2f, 0x1f, 0xa3, 0x2a,
-		0xef, 0x82, 0x44, 0xd5, 0xb6, 0x51, 0x05, 0xb2, 0xf4, 0x5b, 0xb7, 0x98, 0xae, 0x24, 0x15, 0xff,
-		0x9b, 0x94, 0xb9, 0xd6, 0xae, 0x77, 0x47, 0x73, 0xfc, 0xbb, 0xcb, 0xe2, 0x5b, 0x7e, 0x1e, 0x72,
-		0x75, 0xcb, 0x74, 0xb1, 0xe9, 0xf6, 0xa9, 0x0f, 0xbb, 0xd3, 0xb5, 0xf4, 0x5b, 0x9c, 0x03, 0xfb,
-		0x20, 0xc2, 0xd6, 0x6c, 0x9b, 0x52, 0x26, 0x15, 0xf2, 0x93, 0xcf, 0x9e, 0xcd, 0xb1, 0xe2, 0xb9,
-		0x74, 0x3c, 0xf1, 0xf0, 0x0e, 0x06, 0xce, 0x68, 0x0c, 0xee, 0x1b, 0x9e, 0x48, 0xb7, 0xf0, 0xa1,
-		0x7b, 0xdc, 0x79, 0xf4, 0x32, 0xe4, 0xb6, 0xe8, 0xb3, 0x22, 0x2f, 0xe2, 0x43, 0x54, 0x81, 0x0c,
-		0xee, 0x5c, 0xbc, 0x74, 0xe9, 0xa9, 0xe7, 0x99, 0x96, 0x5f, 0x9f, 0x51, 0x04, 0x00, 0x9d, 0x85,
-		0x9c, 0x8b, 0x75, 0xfb, 0xe2, 0xa5, 0xcb, 0xb7, 0x9e, 0x62, 0x6a, 0x75, 0x7d, 0x46, 0x09, 0x40,
-		0x2b, 0x59, 0xd2, 0xe3, 0x2f, 0x7e, 0x62, 0x31, 0x56, 0x4b, 0x41, 0xc2, 0xed, 0xf7, 0xde, 0x36,
-		0xdd, 0xf8, 0x83, 0x34, 0x2c, 0x85, 0x4a, 0xd9, 0xa2, 0x70, 0x5b, 0xeb, 0x1a, 0x1d, 0x2d, 0x78,
-		0x0c, 0x46, 0x0a, 0xf5, 0x9f, 0x62, 0x8c, 0xb1, 0xf6, 0x47, 0x4a, 0x51, 0xfe, 0x99, 0x18, 0x14,
-		0x6e, 0x0a, 0xce, 0x2d, 0xec, 0xa1, 0x2b, 0x00, 0x7e, 0x4d, 0x62, 0xaa, 0x9c, 0x59, 0x1e, 0xac,
-		0x6b, 0xd9, 0xa7, 0x51, 0x42, 0xe8, 0xe8, 0x59, 0xaa, 0x80, 0xb6, 0xe5, 0xf2, 0x7b, 0xac, 0x13,
-		0x48, 0x7d, 0x64, 0xf4, 0x38, 0x20, 0x6a, 0xd5, 0xd4, 0xdb, 0x96, 0x67, 0x98, 0x7b, 0xaa, 0x6d,
-		0xdd, 0xe1, 0xaf, 0x03, 0x24, 0x14, 0x89, 0x96, 0xdc, 0xa4, 0x05, 0x5b, 0x04, 0x4e, 0x1a, 0x9d,
-		0xf3, 0xb9, 0x90, 0x70, 0x4c, 0xeb, 0x74, 0x1c, 0xec, 0xba, 0xdc, 0x70, 0x89, 0x4f, 0x74, 0x05,
-		0x32, 0x76, 0x7f, 0x47, 0x15, 0x56, 0x22, 0x7f, 0xf1, 0xbe, 0x51, 0x73, 0x5e, 0xe8, 0x06, 0x9f,
-		0xf5, 0x69, 0xbb, 0xbf, 0x43, 0x34, 0xe5, 0x01, 0x28, 0x8c, 0x68, 0x4c, 0xfe, 0x76, 0xd0, 0x0e,
-		0xfa, 0x92, 0x0d, 0xef, 0x81, 0x6a, 0x3b, 0x86, 0xe5, 0x18, 0xde, 0x21, 0x3d, 0x6e, 0x97, 0x50,
-		0x24, 0x51, 0xb0, 0xc5, 0xe1, 0xf2, 0x2d, 0x28, 0xb7, 0xa8, 0x9b, 0x1e, 0xb4, 0xfc, 0x52, 0xd0,
-		0xbe, 0xd8, 0xe4, 0xf6, 0x8d, 0x6d, 0x59, 0x7c, 0xa8, 0x65, 0xe7, 0xff, 0x43, 0x0c, 0xf2, 0x35,
-		0x32, 0x71, 0x9b, 0xab, 0x57, 0xbb, 0xda, 0x1e, 0x7a, 0x0a, 0x4e, 0xd4, 0xd6, 0x36, 0xeb, 0x2f,
-		0xaa, 0xcd, 0x55, 0xf5, 0xea, 0x5a, 0xf5, 0x5a, 0x70, 0xbe, 0xb7, 0x72, 0xf2, 0x8d, 0xbb, 0x4b,
-		0x28, 0x84, 0xbb, 0x6d, 0xd2, 0x58, 0x12, 0x5d, 0x80, 0xf9, 0x28, 0x49, 0xb5, 0xd6, 0x6a, 0x6c,
-		0xb4, 0xa5, 0x58, 0xe5, 0xc4, 0x1b, 0x77, 0x97, 0x66, 0x43, 0x14, 0xd5, 0x1d, 0x17, 0x9b, 0xde,
-		0x30, 0x41, 0x7d, 0x73, 0x7d, 0xbd, 0xd9, 0x96, 0xe2, 0x43, 0x04, 0x75, 0xab, 0xd7, 0x33, 0x3c,
-		0xf4, 0x28, 0xcc, 0x46, 0x09, 0x36, 0x9a, 0x6b, 0x52, 0xa2, 0x82, 0xde, 0xb8, 0xbb, 0x54, 0x0a,
-		0x61, 0x6f, 0x18, 0xdd, 0x4a, 0xf6, 0x43, 0x3f, 0x7a, 0x76, 0xe6, 0x53, 0x7f, 0xe7, 0x6c, 0xac,
-		0xb6, 0x3e, 0x76, 0xce, 0x3d, 0x7d, 0xbc, 0x39, 0x17, 0x5d, 0xb7, 0xbf, 0x7a, 0x5f, 0xc4, 0xdc,
-		0x70, 0x3f, 0x2c, 0x64, 0x8c, 0xa7, 0x9d, 0x6e, 0x93, 0x72, 0x0b, 0x95, 0xa3, 0xdd, 0x83, 0xca,
-		0x84, 0x45, 0xa1, 0x32, 0xd1, 0x30, 0xc8, 0xcf, 0x43, 0x71, 0x4b, 0x73, 0xbc, 0x16, 0xf6, 0xae,
-		0x63, 0xad, 0x83, 0x9d, 0xa8, 0xff, 0x50, 0x14, 0xfe, 0x03, 0x82, 0x24, 0x75, 0x12, 0xd8, 0xfa,
-		0x49, 0x7f, 0xcb, 0xfb, 0x90, 0xa4, 0x27, 0x89, 0x7d, 0xdf, 0x82, 0x53, 0x30, 0xdf, 0x82, 0xac,
-		0x0c, 0x87, 0x1e, 0x76, 0x45, 0xfa, 0x8b, 0x7e, 0xa0, 0x67, 0x84, 0x87, 0x90, 0x38, 0xda, 0x43,
-		0xe0, 0xd3, 0x8b, 0xfb, 0x09, 0x5d, 0xc8, 0xf0, 0x21, 0xf6, 0x1b, 0x12, 0x0b, 0x1a, 0x82, 0xd6,
-		0xa1, 0x6c, 0x6b, 0x8e, 0x47, 0x6f, 0x16, 0xee, 0xd3, 0x5e, 0xf0, 0x19, 0xbc, 0x38, 0x6c, 0x4f,
-		0x22, 0x9d, 0xe5, 0xb5, 0x14, 0xed, 0x30, 0x50, 0xfe, 0x2f, 0x49, 0x48, 0x73, 0x61, 0xbc, 0x0b,
-		0x32, 0x5c, 0xac, 0x7c, 0xce, 0xdd, 0xbf, 0x3c, 0xbc, 0xcc, 0x2e, 0xfb, 0xcb, 0x21, 0xe7, 0x27,
-		0x68, 0xd0, 0xc3, 0x90, 0xd5, 0xf7, 0x35, 0xc3, 0x54, 0x8d, 0x0e, 0x77, 0x40, 0xf3, 0x9f, 0x7b,
-		0x73, 0x31, 0x53, 0x27, 0xb0, 0xe6, 0xaa, 0x92, 0xa1, 0x85, 0xcd, 0x0e, 0xf1, 0x69, 0xf6, 0xb1,
-		0xb1, 0xb7, 0xef, 0x71, 0xbb, 0xc1, 0xbf, 0xd0, 0x73, 0x90, 0x24, 0x0a, 0xc1, 0xef, 0x9d, 0x57,
-		0x86, 0x22, 0x0b, 0x3f, 0xf5, 0x53, 0xcb, 0x92, 0x8a, 0x3f, 0xfc, 0x9f, 0x17, 0x63, 0x0a, 0xa5,
-		0x40, 0x75, 0x28, 0x76, 0x35, 0xd7, 0x53, 0xe9, 0x7a, 0x4c, 0xaa, 0x4f, 0x51, 0x16, 0xa7, 0x87,
-		0x05, 0xc2, 0x05, 0xcb, 0x9b, 0x9e, 0x27, 0x54, 0x0c, 0xd4, 0x41, 0xe7, 0x40, 0xa2, 0x4c, 0x74,
-		0x3a, 0x03, 0x99, 0x97, 0x98, 0xa6, 0x72, 0x2f, 0x11, 0x38, 0x9b, 0x98, 0xd4, 0x57, 0x3c, 0x03,
-		0x39, 0x7a, 0xd3, 0x95, 0xa2, 0xb0, 0xe3, 0xeb, 0x59, 0x02, 0xa0, 0x85, 0x8f, 0x40, 0x39, 0xb0,
-		0xfa, 0x0c, 0x25, 0xcb, 0xb8, 0x04, 0x60, 0x8a, 0xf8, 0x24, 0xcc, 0x9b, 0xf8, 0x80, 0x1e, 0xa8,
-		0x8f, 0x60, 0xe7, 0x28, 0x36, 0x22, 0x65, 0x37, 0xa3, 0x14, 0x0f, 0x41, 0x49, 0x17, 0xc2, 0x67,
-		0xb8, 0x40, 0x71, 0x8b, 0x3e, 0x94, 0xa2, 0x9d, 0x86, 0xac, 0x66, 0xdb, 0x0c, 0x21, 0xcf, 0xad,
-		0xbe, 0x6d, 0xd3, 0xa2, 0xf3, 0x30, 0x4b, 0xfb, 0xe8, 0x60, 0xb7, 0xdf, 0xf5, 0x38, 0x93, 0x02,
-		0xc5, 0x29, 0x93, 0x02, 0x85, 0xc1, 0x29, 0xee, 0x83, 0x50, 0xc4, 0xb7, 0x8d, 0x0e, 0x36, 0x75,
-		0xcc, 0xf0, 0x8a, 0x14, 0xaf, 0x20, 0x80, 0x14, 0xe9, 0x51, 0xf0, 0xad, 0xb9, 0x2a, 0x56, 0x9a,
-		0x12, 0xe3, 0x27, 0xe0, 0x55, 0x06, 0x96, 0x17, 0x20, 0xb9, 0xaa, 0x79, 0x1a, 0x71, 0x97, 0xbc,
-		0x03, 0xb6, 0x7c, 0x16, 0x14, 0xf2, 0x53, 0xfe, 0xf9, 0x04, 0x24, 0x6f, 0x5a, 0x1e, 0x46, 0x4f,
-		0x87, 0x5c, 0xd9, 0xd2, 0x28, 0x7d, 0x6e, 0x19, 0x7b, 0x26, 0xee, 0xac, 0xbb, 0x7b, 0xa1, 0x67,
-		0x69, 0x02, 0x75, 0x8a, 0x47, 0xd4, 0x69, 0x1e, 0x52, 0x8e, 0xd5, 0x37, 0x3b, 0xe2, 0xe0, 0x37,
-		0xfd, 0x40, 0x0d, 0xc8, 0xfa, 0x5a, 0x92, 0x9c, 0xa4, 0x25, 0x65, 0xa2, 0x25, 0x44, 0x87, 0x39,
-		0x40, 0xc9, 0xec, 0x70, 0x65, 0xa9, 0x41, 0xce, 0x37, 0x5e, 0x5c, 0xdb, 0xa6, 0x53, 0xd8, 0x80,
-		0x8c, 0x2c, 0x91, 0xfe, 0xd8, 0xfb, 0xc2, 0x63, 0x1a, 0x27, 0xf9, 0x05, 0x5c, 0x7a, 0x11, 0xb5,
-		0xe2, 0x4f, 0xe4, 0x64, 0x68, 0xbf, 0x02, 0xb5, 0x62, 0xcf, 0xe4, 0xdc, 0x07, 0x39, 0xd7, 0xd8,
-		0x33, 0x35, 0xaf, 0xef, 0x60, 0xae, 0x79, 0x01, 0x80, 0x94, 0x06, 0x97, 0x20, 0x98, 0xa6, 0x85,
-		0x5e, 0xee, 0xba, 0x00, 0x73, 0xc1, 0x9b, 0x59, 0x01, 0x17, 0xa6, 0x65, 0xc8, 0x2f, 0x6a, 0x89,
-		0x12, 0xf9, 0x57, 0x62, 0x90, 0xe6, 0x2b, 0x56, 0x30, 0x0c, 0xb1, 0xd1, 0xc3, 0x10, 0x1f, 0x37,
-		0x0c, 0x89, 0x7b, 0x1f, 0x86, 0x2a, 0x80, 0xdf, 0x4c, 0x97, 0x3f, 0x84, 0x32, 0xc2, 0xad, 0x62,
-		0x4d, 0x6c, 0x19, 0x7b, 0x7c, 0xde, 0x87, 0x88, 0xe4, 0xdf, 0x8a, 0x11, 0x0f, 0x9f, 0x97, 0xa3,
-		0x2a, 0x14, 0x45, 0xbb, 0xd4, 0xdd, 0xae, 0xb6, 0xc7, 0x55, 0xf1, 0xfe, 0xb1, 0x8d, 0x23, 0xab,
-		0xb0, 0x92, 0xe7, 0xed, 0xa1, 0xfe, 0xc4, 0xc8, 0x61, 0x8d, 0x8f, 0x19, 0xd6, 0x88, 0x1e, 0x25,
-		0xee, 0x4d, 0x8f, 0x22, 0x23, 0x9e, 0x1c, 0x18, 0x71, 0xf9, 0x77, 0x62, 0xfc, 0xc5, 0xae, 0x0e,
-		0xbb, 0xc1, 0xf1, 0x67, 0x35, 0x54, 0xaf, 0x72, 0xdd, 0xea, 0xe0, 0x8e, 0x3a, 0x34, 0x66, 0x0f,
-		0x0e, 0x73, 0x8c, 0xb6, 0x39, 0x18, 0x3b, 0x24, 0xb8, 0xb4, 0x82, 0x31, 0xfc, 0x74, 0x1c, 0x66,
-		0x87, 0xf0, 0xff, 0xe2, 0x8d, 0x65, 0x74, 0xf6, 0xa6, 0xa6, 0x9c, 0xbd, 0xe9, 0xb1, 0xb3, 0xf7,
-		0x67, 0xe3, 0x34, 0x01, 0x60, 0x5b, 0xae, 0xd6, 0xfd, 0x7a, 0xd8, 0xde, 0x33, 0x90, 0xb3, 0xad,
-		0xae, 0xca, 0x4a, 0xd8, 0xd5, 0x9b, 0xac, 0x6d, 0x75, 0x95, 0x21, 0x35, 0x4b, 0xbd, 0x45, 0x86,
-		0x39, 0xfd, 0x16, 0x0c, 0x42, 0x66, 0x70, 0x42, 0x39, 0x50, 0x60, 0xa2, 0xe0, 0x5e, 0xd3, 0x93,
-		0x44, 0x06, 0xd4, 0x0d, 0x8b, 0x0d, 0x7b, 0x79, 0xac, 0xd9, 0x0c, 0x53, 0xe1, 0x78, 0x84, 0x82,
-		0x39, 0x19, 0xa3, 0x32, 0x47, 0x61, 0x8b, 0xa5, 0x70, 0x3c, 0xf9, 0xfb, 0x62, 0x00, 0x6b, 0x44,
-		0xb2, 0xb4, 0xbf, 0xc4, 0xdf, 0x71, 0x69, 0x13, 0xd4, 0x48, 0xcd, 0x67, 0xc7, 0x0d, 0x1a, 0xaf,
-		0xbf, 0xe0, 0x86, 0xdb, 0x5d, 0x87, 0x62, 0xa0, 0xdb, 0x2e, 0x16, 0x8d, 0x39, 0x7b, 0x44, 0x54,
-		0xda, 0xc2, 0x9e, 0x52, 0xb8, 0x1d, 0xfa, 0x92, 0xff, 0x59, 0x0c, 0x72, 0xb4, 0x4d, 0xeb, 0xd8,
-		0xd3, 0x22, 0x63, 0x18, 0xbb, 0xf7, 0x31, 0xbc, 0x1f, 0x80, 0xb1, 0x71, 0x8d, 0xd7, 0x31, 0xd7,
-		0xac, 0x1c, 0x85, 0xb4, 0x8c, 0xd7, 0x31, 0xba, 0xec, 0x0b, 0x3c, 0x71, 0xb4, 0xc0, 0x45, 0xd4,
-		0xca, 0xc5, 0x7e, 0x0a, 0x32, 0xf4, 0x4d, 0xc9, 0x03, 0x97, 0x07, 0xa2, 0x69, 0xb3, 0xdf, 0x6b,
-		0x1f, 0xb8, 0xf2, 0x6b, 0x90, 0x69, 0x1f, 0xb0, 0x7c, 0xe2, 0x19, 0xc8, 0x39, 0x96, 0xc5, 0xbd,
-		0x3f, 0xe6, 0x75, 0x67, 0x09, 0x80, 0x3a, 0x3b, 0x22, 0x87, 0x16, 0x0f, 0x72, 0x68, 0x41, 0x12,
-		0x30, 0x31, 0x55, 0x12, 0x90, 0x44, 0x9f, 0xc5, 0xc8, 0x4c, 0x42, 0x8f, 0xc3, 0xa9, 0x56, 0xf3,
-		0xda, 0x46, 0x63, 0x55, 0x5d, 0x6f, 0x5d, 0x1b, 0xb8, 0x56, 0x5f, 0x29, 0xbf, 0x71, 0x77, 0x29,
-		0xcf, 0xc3, 0xce, 0x71, 0xd8, 0x5b, 0x4a, 0xe3, 0xe6, 0x66, 0xbb, 0x21, 0xc5, 0x18, 0xf6, 0x96,
-		0x83, 0x6f, 0x5b, 0x1e, 0x7b, 0xb4, 0xf5, 0x49, 0x38, 0x3d, 0x02, 0xdb, 0x0f, 0x3e, 0x67, 0xdf,
-		0xb8, 0xbb, 0x54, 0xdc, 0x72, 0x30, 0xd3, 0x32, 0x4a, 0xb1, 0x0c, 0x0b, 0xc3, 0x14, 0x9b, 0x5b,
-		0x9b, 0xad, 0xea, 0x9a, 0xb4, 0x54, 0x91, 0xde, 0xb8, 0xbb, 0x54, 0x10, 0x26, 0x83, 0xe0, 0xbf,
-		0xfd, 0xd1, 0xe7, 0xb7, 0x65, 0x23, 0x59, 0x63, 0x16, 0xd7, 0xd9, 0x9a, 0xa3, 0xf5, 0x8e, 0x1b,
-		0x7e, 0x4e, 0xd8, 0xf0, 0x97, 0x7f, 0x26, 0x0e, 0x65, 0x3f, 0xb8, 0xd9, 0xa2, 0x35, 0xa0, 0xa7,
-		0xc3, 0x19, 0xbf, 0xfc, 0xd8, 0xb5, 0x83, 0x61, 0x8b, 0x84, 0xe0, 0x3b, 0x21, 0x2b, 0x9c, 0x64,
-		0x3e, 0xa9, 0x96, 0x46, 0xac, 0x6f, 0x1c, 0x83, 0x93, 0xfa, 0x14, 0xe8, 0x05, 0xc8, 0xf9, 0x53,
-		0xcc, 0x7f, 0xd8, 0x6c, 0xfc, 0x9c, 0xe4, 0xf4, 0x01, 0x0d, 0x7a, 0x3e, 0x08, 0xe3, 0x92, 0xe3,
-		0x02, 0xc3, 0x9b, 0x0c, 0x81, 0x13, 0xfb, 0x21, 0xdc, 0x93, 0x90, 0xd4, 0x76, 0x74, 0x83, 0x1b,
-		0xe0, 0xfb, 0x86, 0xe9, 0xaa, 0xb5, 0x7a, 0x93, 0x13, 0x51, 0x4c, 0xb9, 0xc9, 0x93, 0x29, 0x5c,
-		0x5e, 0xf4, 0x19, 0x99, 0x03, 0x95, 0xc5, 0xc2, 0xcc, 0xbb, 0xc8, 0xf6, 0xb4, 0x83, 0x1a, 0x0d,
-		0x87, 0x4f, 0x41, 0x86, 0x14, 0xee, 0xf1, 0x87, 0x0a, 0x12, 0x4a, 0xba, 0xa7, 0x1d, 0x5c, 0xd3,
-		0xdc, 0x1b, 0xc9, 0x6c, 0x42, 0x4a, 0xca, 0x3f, 0x41, 0x3c, 0x95, 0x88, 0x54, 0xd0, 0x63, 0x80,
-		0x08, 0x85, 0xb6, 0x87, 0x55, 0x32, 0x75, 0xa9, 0x78, 0x05, 0xdf, 0x72, 0x4f, 0x3b, 0xa8, 0xee,
-		0xe1, 0x8d, 0x7e, 0x8f, 0x36, 0xc0, 0x45, 0xeb, 0x20, 0x09, 0x64, 0x31, 0xb2, 0x5c, 0xfc, 0xa7,
-		0x87, 0x1f, 0x46, 0xe5, 0x08, 0x6c, 0x01, 0xf8, 0x08, 0x59, 0x00, 0x4a, 0x8c, 0x9f, 0x7f, 0xc4,
-		0x23, 0xd2, 0x95, 0x44, 0xb4, 0x2b, 0xf2, 0x0b, 0x50, 0x1e, 0x18, 0x01, 0x24, 0x43, 0x91, 0x67,
-		0xac, 0xe8, 0xf6, 0x39, 0x8b, 0x70, 0x72, 0x4a, 0x9e, 0x65, 0xa6, 0xe8, 0x71, 0x82, 0x95, 0xec,
-		0x2f, 0x7c, 0x7c, 0x31, 0x46, 0x37, 0x73, 0x1e, 0x83, 0x62, 0x64, 0x0c, 0x44, 0x16, 0x39, 0x16,
-		0x64, 0x91, 0x03, 0xe4, 0x57, 0xa1, 0x40, 0x0c, 0x10, 0xee, 0x70, 0xdc, 0x87, 0xa1, 0xcc, 0x0c,
-		0xe4, 0xa0, 0xac, 0x99, 0xc3, 0xb3, 0x2e, 0x04, 0x2e, 0x0b, 0x0f, 0x28, 0x2a, 0xf6, 0xbc, 0xc0,
-		0xba, 0xa6, 0xb9, 0xf2, 0x26, 0x40, 0x30, 0xa8, 0xa8, 0x0a, 0xf7, 0x13, 0xe3, 0x11, 0x3e, 0xee,
-		0xc9, 0x9f, 0xb4, 0x8a, 0x78, 0x8c, 0x15, 0x82, 0x14, 0x1c, 0xe9, 0x64, 0x0f, 0x5b, 0x5d, 0xa7,
-		0x18, 0xb5, 0x97, 0x3e, 0xf5, 0xb9, 0xb3, 0xb1, 0xb7, 0xc7, 0x16, 0xfc, 0xc7, 0x57, 0xe0, 0x4c,
-		0xa8, 0x90, 0x28, 0x60, 0x24, 0x11, 0x55, 0x0e, 0xe9, 0x2b, 0x29, 0x9c, 0x94, 0x50, 0x3a, 0x32,
-		0xff, 0x5b, 0x39, 0xda, 0xec, 0x4c, 0xce, 0x36, 0x4d, 0xce, 0x77, 0x8d, 0x4e, 0xca, 0xff, 0xd3,
-		0x1c, 0x64, 0x14, 0xfc, 0xfe, 0x3e, 0x76, 0x3d, 0x74, 0x11, 0x92, 0x58, 0xdf, 0xb7, 0x46, 0xe5,
-		0x40, 0x49, 0x07, 0x97, 0x39, 0x5e, 0x43, 0xdf, 0xb7, 0xae, 0xcf, 0x28, 0x14, 0x17, 0x5d, 0x82,
-		0xd4, 0x6e, 0xb7, 0xcf, 0xd3, 0x57, 0x03, 0x36, 0x2b, 0x4c, 0x74, 0x95, 0x20, 0x5d, 0x9f, 0x51,
-		0x18, 0x36, 0xa9, 0x8a, 0xbe, 0x68, 0x9d, 0x38, 0xba, 0xaa, 0xa6, 0xb9, 0x4b, 0xab, 0x22, 0xb8,
-		0xa8, 0x06, 0x60, 0x98, 0x86, 0xa7, 0xd2, 0xd4, 0x0e, 0xb7, 0x1a, 0x0f, 0x8c, 0xa7, 0x34, 0x3c,
-		0x9a, 0x0c, 0xba, 0x3e, 0xa3, 0xe4, 0x0c, 0xf1, 0x41, 0x9a, 0xfb, 0xfe, 0x3e, 0x76, 0x0e, 0xb9,
-		0xb7, 0x36, 0xb6, 0xb9, 0x2f, 0x11, 0x24, 0xd2, 0x5c, 0x8a, 0x4d, 0x8c, 0x2c, 0x7b, 0x1c, 0xcf,
-		0x3b, 0xe0, 0x4f, 0xbe, 0x2e, 0x8e, 0xa3, 0xa4, 0x2f, 0xe4, 0xb5, 0x0f, 0xae, 0xcf, 0x28, 0x19,
-		0x9d, 0xfd, 0x44, 0xcf, 0xf9, 0x2e, 0x58, 0x7e, 0xd8, 0xeb, 0x89, 0xd0, 0xb2, 0xb4, 0xcf, 0x8c,
-		0x70, 0xc5, 0xd0, 0x06, 0x94, 0xba, 0x86, 0xeb, 0xa9, 0xae, 0xa9, 0xd9, 0xee, 0xbe, 0xe5, 0xb9,
-		0x34, 0x7f, 0x92, 0xbf, 0xf8, 0xd0, 0x38, 0x0e, 0x6b, 0x86, 0xeb, 0xb5, 0x04, 0xf2, 0xf5, 0x19,
-		0xa5, 0xd8, 0x0d, 0x03, 0x08, 0x3f, 0x6b, 0x77, 0x17, 0x3b, 0x3e, 0x43, 0x9a, 0x67, 0x39, 0x82,
-		0xdf, 0x26, 0xc1, 0x16, 0xf4, 0x84, 0x9f, 0x15, 0x06, 0xa0, 0x6f, 0x80, 0xb9, 0xae, 0xa5, 0x75,
-		0x7c, 0x76, 0xaa, 0xbe, 0xdf, 0x37, 0x6f, 0xd1, 0xa4, 0x4c, 0xfe, 0xe2, 0xa3, 0x63, 0x1b, 0x69,
-		0x69, 0x1d, 0xc1, 0xa2, 0x4e, 0x08, 0xae, 0xcf, 0x28, 0xb3, 0xdd, 0x41, 0x20, 0x7a, 0x1f, 0xcc,
-		0x6b, 0xb6, 0xdd, 0x3d, 0x1c, 0xe4, 0x5e, 0xa6, 0xdc, 0xcf, 0x8f, 0xe3, 0x5e, 0x25, 0x34, 0x83,
-		0xec, 0x91, 0x36, 0x04, 0x45, 0x6d, 0x90, 0x6c, 0x07, 0xd3, 0x1b, 0x53, 0x36, 0xf7, 0x32, 0xe8,
-		0x7b, 0x52, 0xf9, 0x8b, 0x8f, 0x8c, 0xe3, 0xbd, 0xc5, 0xf0, 0x85, 0x53, 0x72, 0x7d, 0x46, 0x29,
-		0xdb, 0x51, 0x10, 0xe3, 0x6a, 0xe9, 0x98, 0x3e, 0x77, 0xc7, 0xb9, 0xce, 0x4e, 0xe2, 0x4a, 0xf1,
-		0xa3, 0x5c, 0x23, 0x20, 0xd4, 0x80, 0x3c, 0x0b, 0x45, 0x55, 0x62, 0x0c, 0xe9, 0x2b, 0x54, 0xf9,
-		0x8b, 0xf2, 0xd8, 0x19, 0x4a, 0x51, 0x6f, 0x5a, 0x1e, 0xbe, 0x3e, 0xa3, 0x00, 0xf6, 0xbf, 0x90,
-		0x06, 0x27, 0xe8, 0x83, 0x5c, 0x87, 0x6a, 0xd4, 0xf0, 0x2e, 0xcc, 0x51, 0x86, 0x8f, 0x8d, 0x63,
-		0x78, 0x93, 0x12, 0xdd, 0x0c, 0xdb, 0xe1, 0xeb, 0x33, 0xca, 0xdc, 0xed, 0x61, 0x30, 0x51, 0xb1,
-		0x5d, 0xc3, 0xd4, 0xba, 0xc6, 0xeb, 0x98, 0x2d, 0xa1, 0xf4, 0x29, 0xca, 0x23, 0x54, 0xec, 0x2a,
-		0xc7, 0xa6, 0x0b, 0x2b, 0x51, 0xb1, 0xdd, 0x30, 0xa0, 0x96, 0xe1, 0x67, 0x3d, 0xfd, 0xa7, 0xd5,
-		0x32, 0x52, 0x96, 0x3d, 0xa7, 0x76, 0x23, 0x99, 0x05, 0x29, 0x2f, 0x3f, 0x02, 0xf9, 0x90, 0x61,
-		0x42, 0x0b, 0x90, 0xe1, 0xa7, 0x61, 0xc4, 0xa9, 0x51, 0xfe, 0x29, 0x97, 0xa0, 0x10, 0x36, 0x46,
-		0xf2, 0x87, 0x63, 0x3e, 0x25, 0x7d, 0x53, 0x62, 0x21, 0x9a, 0x91, 0xce, 0x05, 0x9e, 0xca, 0x83,
-		0x62, 0x69, 0x13, 0xe5, 0x6c, 0xfb, 0xb5, 0x40, 0x81, 0x7c, 0x65, 0x45, 0x8b, 0x90, 0xb7, 0x2f,
-		0xda, 0x3e, 0x4a, 0x82, 0xa2, 0x80, 0x7d, 0xd1, 0x16, 0x08, 0x0f, 0x40, 0x81, 0xf4, 0x5b, 0x0d,
-		0xfb, 0x4b, 0x39, 0x25, 0x4f, 0x60, 0x1c, 0x45, 0xfe, 0xd7, 0x71, 0x90, 0x06, 0x0d, 0x98, 0x9f,
-		0xaa, 0x8e, 0x1d, 0x3b, 0x55, 0x7d, 0x7a, 0x30, 0x49, 0x1e, 0xe4, 0xc5, 0xd7, 0x40, 0x0a, 0xd2,
-		0xbb, 0x6c, 0xa9, 0x19, 0xef, 0xff, 0x0d, 0x38, 0xaa, 0x4a, 0x59, 0x1f, 0xf0, 0x5c, 0xaf, 0x46,
-		0x36, 0x2b, 0xc5, 0x9f, 0x7c, 0x18, 0x1c, 0x70, 0xdf, 0x89, 0xd9, 0xb6, 0x3b, 0x9a, 0x87, 0x45,
-		0x7e, 0x2c, 0xb4, 0x6f, 0xf9, 0x30, 0x94, 0x35, 0xdb, 0x56, 0x5d, 0x4f, 0xf3, 0x30, 0xf7, 0x35,
-		0x58, 0xe6, 0xa1, 0xa8, 0xd9, 0x76, 0x8b, 0x40, 0x99, 0xaf, 0xf1, 0x10, 0x94, 0x88, 0x4d, 0x37,
-		0xb4, 0xae, 0x70, 0x15, 0xd2, 0xcc, 0x25, 0xe1, 0x50, 0xe6, 0x1d, 0xc8, 0x1d, 0x7f, 0xc4, 0xa9,
-		0x3d, 0xf7, 0x63, 0xaa, 0x58, 0x28, 0xa6, 0x42, 0xfc, 0xad, 0x0f, 0x26, 0x1f, 0xf1, 0x3e, 0xca,
-		0xe8, 0x4d, 0x83, 0x79, 0x1a, 0x7f, 0xdd, 0x66, 0xc9, 0x92, 0xac, 0xc2, 0x3e, 0x64, 0x05, 0x4a,
-		0x51, 0xdb, 0x8f, 0x4a, 0x10, 0xf7, 0x0e, 0x78, 0x2d, 0x71, 0xef, 0x80, 0x78, 0xba, 0xfe, 0x0b,
-		0xb9, 0xa5, 0x11, 0xab, 0x1d, 0xa7, 0x0b, 0x72, 0x1d, 0x72, 0x19, 0x8a, 0x91, 0x35, 0x41, 0x3e,
-		0x09, 0xf3, 0xa3, 0x4c, 0xbc, 0xbc, 0xef, 0xc3, 0x23, 0xa6, 0x1a, 0x5d, 0x82, 0xac, 0x6f, 0xe3,
-		0x47, 0x44, 0xc7, 0xb4, 0x5a, 0x81, 0xac, 0xf8, 0xa8, 0x91, 0x74, 0x7e, 0x3c, 0x92, 0xce, 0x97,
-		0xbf, 0x09, 0x16, 0xc6, 0xd9, 0xef, 0x81, 0x24, 0x5f, 0xd2, 0x17, 0xd8, 0x49, 0x48, 0xf3, 0xa7,
-		0x28, 0xe3, 0x74, 0x03, 0x8b, 0x7f, 0x11, 0x41, 0x32, 0x5b, 0x9e, 0x60, 0xfb, 0x5a, 0xf4, 0x43,
-		0x56, 0xe1, 0xf4, 0x58, 0x1b, 0x3e, 0x7e, 0x2b, 0x8c, 0x31, 0xe2, 0x5b, 0x61, 0xba, 0x68, 0x8e,
-		0x4b, 0xfb, 0x2a, 0x0e, 0xb2, 0xb0, 0x2f, 0xf9, 0x23, 0x09, 0x38, 0x39, 0xda, 0x92, 0xa3, 0x25,
-		0x28, 0x10, 0xbf, 0xd5, 0x8b, 0xba, 0xb8, 0xd0, 0xd3, 0x0e, 0xda, 0xdc, 0xbf, 0xe5, 0x5b, 0x09,
-		0x71, 0x7f, 0x2b, 0x01, 0x6d, 0xc3, 0x6c, 0xd7, 0xd2, 0xb5, 0xae, 0x1a, 0xda, 0xca, 0xe1, 0x93,
-		0xe8, 0xc1, 0x21, 0x61, 0x47, 0x53, 0x86, 0xc4, 0xe0, 0x70, 0xfd, 0x2f, 0x53, 0x1e, 0x6b, 0xfe,
-		0xae, 0x0f, 0x5a, 0x85, 0x7c, 0xcf, 0x70, 0x77, 0xf0, 0xbe, 0x76, 0xdb, 0xb0, 0x1c, 0x3e, 0x9b,
-		0x86, 0x95, 0x66, 0x3d, 0xc0, 0x11, 0x3b, 0x4c, 0x21, 0xb2, 0xd0, 0x90, 0xa4, 0x46, 0x6e, 0x7c,
-		0xa5, 0x8f, 0x6d, 0x4d, 0xc6, 0xed, 0x21, 0x65, 0xc6, 0xee, 0x21, 0x8d, 0xda, 0xb0, 0xc9, 0x8e,
-		0xde, 0xb0, 0xf9, 0x50, 0x78, 0x68, 0xa2, 0x6b, 0xdf, 0xd0, 0x1e, 0x0e, 0x6a, 0xc1, 0x3c, 0xa7,
-		0xef, 0x44, 0x64, 0x3f, 0xe2, 0xa8, 0x03, 0x9b, 0x5f, 0x83, 0x32, 0x47, 0x82, 0x7c, 0xbc, 0xd8,
-		0x13, 0xf7, 0x26, 0x76, 0xb1, 0x89, 0x9a, 0x0c, 0x6d, 0xa2, 0xfe, 0x3f, 0x36, 0x14, 0x1f, 0x4c,
-		0xc0, 0xec, 0x90, 0x23, 0x31, 0x72, 0x77, 0x78, 0x5c, 0x46, 0x56, 0x74, 0x2c, 0x71, 0xec, 0x8e,
-		0xf1, 0xb1, 0x4e, 0x4e, 0x1e, 0xeb, 0xd4, 0x5b, 0x38, 0xd6, 0xe9, 0x7b, 0x1b, 0xeb, 0xb7, 0x75,
-		0x14, 0x3e, 0x16, 0x83, 0xca, 0x78, 0xef, 0x6b, 0xe4, 0x70, 0x1c, 0x6b, 0x03, 0x61, 0xdc, 0x1a,
-		0xf7, 0x10, 0x94, 0x06, 0x7c, 0x43, 0xa6, 0xca, 0xc5, 0x48, 0x14, 0x2e, 0x7f, 0x4b, 0xc2, 0x5f,
-		0x78, 0x22, 0x0e, 0xdc, 0x88, 0xd9, 0xfa, 0x12, 0xcc, 0x75, 0xb0, 0x6e, 0x74, 0xee, 0x75, 0xb2,
-		0xce, 0x72, 0xea, 0xff, 0x3f, 0x57, 0x87, 0xb5, 0xe4, 0x7b, 0x01, 0xb2, 0x0a, 0x76, 0x6d, 0xe2,
-		0x8f, 0xa1, 0x1a, 0xe4, 0xf0, 0x81, 0x8e, 0x6d, 0x2f, 0x38, 0x54, 0x31, 0x2a, 0x44, 0x60, 0xd8,
-		0x0d, 0x81, 0x49, 0x02, 0x64, 0x9f, 0x0c, 0x3d, 0xcd, 0x73, 0x00, 0xe3, 0xc3, 0x79, 0x4e, 0x1e,
-		0x4e, 0x02, 0x5c, 0x16, 0x49, 0x80, 0xc4, 0xd8, 0xf8, 0x96, 0x51, 0x0d, 0x64, 0x01, 0x9e, 0xe6,
-		0x59, 0x80, 0xe4, 0x84, 0xca, 0x22, 0x69, 0x80, 0x7a, 0x24, 0x0d, 0x90, 0x9e, 0xd0, 0xcd, 0x31,
-		0x79, 0x80, 0xcb, 0x22, 0x0f, 0x90, 0x99, 0xd0, 0xe2, 0x81, 0x44, 0xc0, 0xbb, 0x42, 0x89, 0x80,
-		0xdc, 0x70, 0xb6, 0x35, 0x42, 0x3a, 0x22, 0x13, 0xf0, 0xbc, 0x9f, 0x09, 0x28, 0x8c, 0xcd, 0x22,
-		0x70, 0xe2, 0xc1, 0x54, 0xc0, 0xe6, 0x50, 0x2a, 0xa0, 0xc8, 0xff, 0xb0, 0xd1, 0x38, 0x16, 0x13,
-		0x72, 0x01, 0x9b, 0x43, 0xb9, 0x80, 0xd2, 0x04, 0x86, 0x13, 0x92, 0x01, 0xdf, 0x38, 0x3a, 0x19,
-		0x30, 0x3e, 0x5c, 0xe7, 0xcd, 0x9c, 0x2e, 0x1b, 0xa0, 0x8e, 0xc9, 0x06, 0x48, 0x63, 0x23, 0x57,
-		0xc6, 0x7e, 0xea, 0x74, 0xc0, 0xf6, 0x88, 0x74, 0x00, 0x0b, 0xdc, 0xcf, 0x8d, 0x65, 0x3e, 0x45,
-		0x3e, 0x60, 0x7b, 0x44, 0x3e, 0x00, 0x4d, 0x64, 0x3b, 0x31, 0x21, 0x70, 0x35, 0x9a, 0x10, 0x98,
-		0x1b, 0xe3, 0x75, 0x06, 0xb3, 0x7d, 0x4c, 0x46, 0x60, 0x67, 0x5c, 0x46, 0x80, 0x45, 0xed, 0x8f,
-		0x8f, 0xe5, 0x78, 0x8c, 0x94, 0xc0, 0xe6, 0x50, 0x4a, 0xe0, 0xc4, 0x04, 0x4d, 0x9b, 0x3e, 0x27,
-		0xc0, 0x1e, 0x5a, 0x67, 0x4f, 0xac, 0x83, 0x94, 0xbf, 0x91, 0xcc, 0xe6, 0xa5, 0x82, 0xfc, 0x28,
-		0xf1, 0x60, 0x06, 0xec, 0x1c, 0x89, 0x15, 0xb0, 0xe3, 0x58, 0x8e, 0xb8, 0xc7, 0x40, 0x3f, 0xe4,
-		0x73, 0x24, 0x46, 0x0c, 0x6c, 0xda, 0x11, 0xf9, 0x03, 0x1a, 0x93, 0x85, 0xec, 0x98, 0xfc, 0x0b,
-		0xb1, 0x80, 0x96, 0x66, 0x10, 0xc2, 0xf1, 0x65, 0x8e, 0xc7, 0x97, 0xa1, 0xac, 0x42, 0x3c, 0x9a,
-		0x55, 0x58, 0x84, 0x3c, 0x89, 0xb5, 0x06, 0x12, 0x06, 0x9a, 0xed, 0x27, 0x0c, 0xc4, 0x01, 0x2a,
-		0x96, 0x7b, 0xe0, 0xcb, 0x12, 0xdb, 0x4d, 0x2c, 0xfb, 0x87, 0xc9, 0x58, 0xa8, 0x8b, 0x9e, 0x80,
-		0xb9, 0x10, 0xae, 0x1f, 0xc3, 0xb1, 0xe8, 0x59, 0xf2, 0xb1, 0xab, 0x3c, 0x98, 0xfb, 0xe7, 0xb1,
-		0x40, 0x42, 0x41, 0xa6, 0x61, 0x54, 0x52, 0x20, 0xf6, 0x16, 0x25, 0x05, 0xe2, 0xf7, 0x9c, 0x14,
-		0x08, 0xc7, 0xa4, 0x89, 0x68, 0x4c, 0xfa, 0x3f, 0x63, 0xc1, 0x98, 0xf8, 0x21, 0xbe, 0x6e, 0x75,
-		0x30, 0x8f, 0x12, 0xe9, 0x6f, 0xe2, 0x92, 0x74, 0xad, 0x3d, 0x1e, 0x0b, 0x92, 0x9f, 0x04, 0xcb,
-		0x5f, 0x78, 0x72, 0x7c, 0x5d, 0xf1, 0x03, 0xcc, 0x54, 0xf8, 0x1e, 0x07, 0xbf, 0xdc, 0x90, 0x0e,
-		0x2e, 0x37, 0xf8, 0x97, 0x8f, 0x33, 0xa1, 0xcb, 0xc7, 0xe8, 0x39, 0xc8, 0xd1, 0x64, 0xbf, 0x6a,
-		0xd9, 0xe2, 0xaf, 0x82, 0x9d, 0x19, 0x7f, 0xb1, 0xc1, 0xa5, 0x47, 0xae, 0xd9, 0x65, 0x88, 0xc0,
-		0xe3, 0xc8, 0x45, 0x3c, 0x8e, 0xfb, 0x20, 0x47, 0x5a, 0xcf, 0xfe, 0xba, 0x05, 0xf0, 0x9b, 0xeb,
-		0x02, 0x20, 0xff, 0x64, 0x1c, 0xca, 0x03, 0x0b, 0xcd, 0xc8, 0xbe, 0x8f, 0xda, 0x46, 0x9e, 0x4e,
-		0x1e, 0x67, 0x01, 0xf6, 0x34, 0x57, 0xbd, 0xa3, 0x99, 0x1e, 0xee, 0x70, 0xa1, 0x84, 0x20, 0xa8,
-		0x02, 0x59, 0xf2, 0xd5, 0x77, 0x71, 0x87, 0x67, 0x5f, 0xfc, 0x6f, 0x74, 0x1d, 0xd2, 0xf8, 0x36,
-		0x7d, 0xdd, 0x95, 0xbd, 0x91, 0x7c, 0x72, 0x38, 0x1c, 0x26, 0xc5, 0xb5, 0x05, 0x32, 0xd8, 0x5f,
-		0x7a, 0x73, 0x51, 0x62, 0xd8, 0x8f, 0xfb, 0x17, 0xc6, 0x14, 0x4e, 0x1f, 0x95, 0x42, 0x76, 0x40,
-		0x0a, 0x34, 0x0f, 0x58, 0x10, 0xe1, 0x3d, 0x91, 0x29, 0x3b, 0xe3, 0xad, 0x14, 0x7b, 0xb8, 0x67,
-		0x5b, 0x56, 0x57, 0x65, 0x73, 0xbc, 0x0a, 0xa5, 0xe8, 0xba, 0xca, 0xfe, 0xf2, 0x94, 0xa7, 0x19,
-		0xa6, 0x1a, 0x71, 0x82, 0x0b, 0x0c, 0xc8, 0xe6, 0xd4, 0x8d, 0x64, 0x36, 0x26, 0xc5, 0x6f, 0x24,
-		0xb3, 0x71, 0x29, 0x21, 0x6f, 0xc1, 0x89, 0x91, 0xeb, 0x2a, 0x7a, 0x16, 0x72, 0xc1, 0x92, 0xcc,
-		0x8e, 0xe9, 0x1f, 0x91, 0x69, 0x09, 0x70, 0xe5, 0x5f, 0x8e, 0x05, 0x2c, 0xa3, 0xb9, 0x9b, 0x06,
-		0xa4, 0xd9, 0x99, 0x49, 0x7e, 0x3e, 0xe6, 0x89, 0xe9, 0x56, 0xe4, 0x65, 0x76, 0xa0, 0x52, 0xe1,
-		0xc4, 0xf2, 0xfb, 0x20, 0xcd, 0x20, 0x28, 0x0f, 0x99, 0xe0, 0x1d, 0x69, 0x80, 0x74, 0xb5, 0x5e,
-		0x6f, 0x6c, 0x89, 0x47, 0x61, 0x6b, 0x9b, 0x4a, 0x5b, 0x8a, 0x13, 0xb0, 0xd2, 0xb8, 0xd1, 0xa8,
-		0xb7, 0xa5, 0x04, 0x9a, 0x85, 0x22, 0xfb, 0xad, 0x5e, 0xdd, 0x54, 0xd6, 0xab, 0x6d, 0x29, 0x19,
-		0x02, 0xb5, 0x1a, 0x1b, 0xab, 0x0d, 0x45, 0x4a, 0xc9, 0x4f, 0xc1, 0xe9, 0xb1, 0x6b, 0x78, 0x90,
-		0x98, 0x89, 0x85, 0x12, 0x33, 0xf2, 0x47, 0xe2, 0x24, 0xa8, 0x19, 0xb7, 0x30, 0xa3, 0x1b, 0x03,
-		0x1d, 0xbf, 0x78, 0x8c, 0x55, 0x7d, 0xa0, 0xf7, 0x24, 0x8e, 0x71, 0xf0, 0x2e, 0xf6, 0xf4, 0x7d,
-		0xe6, 0x28, 0x30, 0x0b, 0x54, 0x54, 0x8a, 0x1c, 0x4a, 0x89, 0x5c, 0x86, 0xf6, 0x1a, 0xd6, 0x3d,
-		0x95, 0x29, 0x91, 0xcb, 0xff, 0x50, 0x6e, 0x91, 0x41, 0x5b, 0x0c, 0x28, 0x7f, 0xd3, 0xb1, 0x64,
-		0x99, 0x83, 0x94, 0xd2, 0x68, 0x2b, 0xaf, 0x48, 0x09, 0x84, 0xa0, 0x44, 0x7f, 0xaa, 0xad, 0x8d,
-		0xea, 0x56, 0xeb, 0xfa, 0x26, 0x91, 0xe5, 0x1c, 0x94, 0x85, 0x2c, 0x05, 0x30, 0x25, 0x3f, 0x06,
-		0xa7, 0xc6, 0x78, 0x15, 0x23, 0x0e, 0xb1, 0x7e, 0x22, 0x16, 0xc6, 0x8e, 0x7a, 0x06, 0x9b, 0x90,
-		0x76, 0x3d, 0xcd, 0xeb, 0xbb, 0x5c, 0x88, 0xcf, 0x4e, 0xeb, 0x66, 0x2c, 0x8b, 0x1f, 0x2d, 0x4a,
-		0xae, 0x70, 0x36, 0xf2, 0x25, 0x28, 0x45, 0x4b, 0xc6, 0xcb, 0x20, 0x50, 0xa2, 0xb8, 0x7c, 0x05,
-		0xd0, 0xb0, 0xf7, 0x31, 0x22, 0xbc, 0x8c, 0x8d, 0x0a, 0x2f, 0x7f, 0x2c, 0x06, 0x67, 0x8e, 0xf0,
-		0x34, 0xd0, 0x4b, 0x03, 0x9d, 0x7c, 0xfe, 0x38, 0x7e, 0xca, 0x32, 0x83, 0x0d, 0x74, 0xf3, 0x69,
-		0x28, 0x84, 0xe1, 0xd3, 0x75, 0xf2, 0x4b, 0xf1, 0x60, 0x12, 0x47, 0xe3, 0xe0, 0xc0, 0x04, 0xc6,
-		0xbe, 0x46, 0x13, 0xf8, 0x4e, 0x00, 0xef, 0x40, 0x9c, 0xa2, 0xe6, 0xeb, 0xe8, 0xfd, 0x23, 0xf2,
-		0x8b, 0x58, 0x6f, 0x1f, 0xf0, 0x49, 0x90, 0xf3, 0xf8, 0x2f, 0x17, 0xb5, 0xc2, 0x49, 0x81, 0x3e,
-		0x5d, 0x63, 0x5d, 0xff, 0xcf, 0xe4, 0x4e, 0xb7, 0x18, 0x07, 0xc9, 0x03, 0x06, 0x76, 0xd1, 0x2b,
-		0x70, 0x6a, 0xc0, 0x51, 0xf0, 0x59, 0x27, 0xa7, 0xf5, 0x17, 0x4e, 0x44, 0xfd, 0x05, 0xc1, 0x3a,
-		0xbc, 0xda, 0xa7, 0xa2, 0xab, 0xfd, 0x2b, 0x00, 0x41, 0x72, 0x20, 0x38, 0xf6, 0x17, 0x0b, 0x1f,
-		0xfb, 0xbb, 0x04, 0x29, 0xa2, 0x49, 0x42, 0x4e, 0xc3, 0xa6, 0x98, 0x68, 0x42, 0x28, 0xb9, 0xc0,
-		0xb0, 0x65, 0x03, 0xd0, 0x70, 0x82, 0x76, 0x4c, 0x15, 0xef, 0x8a, 0x56, 0xf1, 0xc0, 0xd8, 0x54,
-		0xef, 0xe8, 0xaa, 0x5e, 0x87, 0x14, 0x1d, 0xf9, 0x91, 0x77, 0x29, 0xdf, 0x0b, 0xa0, 0x79, 0x9e,
-		0x63, 0xec, 0xf4, 0x83, 0x0a, 0x16, 0x47, 0x6b, 0x4e, 0x55, 0xe0, 0xd5, 0xee, 0xe3, 0x2a, 0x34,
-		0x1f, 0x90, 0x86, 0xd4, 0x28, 0xc4, 0x50, 0xde, 0x80, 0x52, 0x94, 0x76, 0xf4, 0xdd, 0xd0, 0xe0,
-		0x71, 0x95, 0x9c, 0xf0, 0x6f, 0x7c, 0xef, 0x88, 0x3f, 0x78, 0x44, 0x3f, 0xe4, 0x0f, 0xc4, 0xa1,
-		0x10, 0x56, 0xbc, 0xbf, 0x7c, 0x2e, 0x88, 0xfc, 0xad, 0x31, 0xc8, 0xfa, 0xdd, 0x3f, 0xe2, 0xb0,
-		0x73, 0x70, 0x47, 0xd8, 0xdf, 0xbc, 0x60, 0xdb, 0x44, 0x09, 0x7f, 0x9b, 0xe8, 0x8a, 0xbf, 0xfc,
-		0x8d, 0x4b, 0x88, 0x84, 0x65, 0x2d, 0x0e, 0x1c, 0xf2, 0xd5, 0xfe, 0xca, 0x74, 0x57, 0xf1, 0xe6,
-		0x21, 0x15, 0xbe, 0x46, 0xc7, 0x3e, 0xe4, 0x4e, 0xe8, 0x84, 0x11, 0x9b, 0x88, 0xe1, 0x3b, 0x7b,
-		0xb1, 0x63, 0xdf, 0xd9, 0xf3, 0x6b, 0x89, 0x87, 0x6b, 0xf9, 0x9e, 0x18, 0x64, 0xc5, 0x9c, 0x40,
-		0xef, 0x0e, 0x9f, 0x3c, 0x13, 0x5b, 0x9b, 0x63, 0xed, 0x11, 0xe7, 0x1f, 0x3a, 0x78, 0x36, 0x74,
-		0xe0, 0x3a, 0x71, 0xdc, 0x03, 0xd7, 0xdc, 0xb3, 0xfb, 0xe3, 0x18, 0x48, 0x83, 0x33, 0xf6, 0x6b,
-		0x6e, 0xdd, 0xf0, 0x32, 0x97, 0x18, 0xb1, 0xcc, 0x8d, 0x3b, 0x45, 0x9d, 0x1c, 0x77, 0x8a, 0x7a,
-		0xb8, 0xd7, 0xa9, 0x7b, 0xec, 0xf5, 0x07, 0xe3, 0x90, 0x0f, 0xa5, 0x47, 0xd1, 0x33, 0x91, 0x13,
-		0xd9, 0x4b, 0x47, 0xa5, 0x52, 0x43, 0x47, 0xb2, 0x23, 0x62, 0x8a, 0x1f, 0x5f, 0x4c, 0x6f, 0xfd,
-		0xed, 0xac, 0xd1, 0x17, 0x58, 0x53, 0x63, 0x2e, 0xb0, 0xfe, 0xf5, 0x18, 0x64, 0x7d, 0xb7, 0xfb,
-		0xb8, 0x9b, 0x98, 0x27, 0x21, 0xcd, 0x3d, 0x4b, 0xb6, 0x8b, 0xc9, 0xbf, 0x46, 0xa6, 0x95, 0x2b,
-		0x90, 0x15, 0x7f, 0xb3, 0x90, 0xaf, 0x6a, 0xfe, 0xf7, 0xf9, 0xe7, 0x21, 0x1f, 0xda, 0x00, 0x26,
-		0xa6, 0x71, 0xa3, 0xf1, 0x1e, 0x69, 0xa6, 0x92, 0x79, 0xe3, 0xee, 0x52, 0x62, 0x03, 0xdf, 0x21,
-		0xb3, 0x59, 0x69, 0xd4, 0xaf, 0x37, 0xea, 0x2f, 0x4a, 0xb1, 0x4a, 0xfe, 0x8d, 0xbb, 0x4b, 0x19,
-		0x05, 0xd3, 0x8c, 0xe2, 0xf9, 0x17, 0xa1, 0x3c, 0x30, 0x30, 0x51, 0xb7, 0x05, 0x41, 0x69, 0x75,
-		0x7b, 0x6b, 0xad, 0x59, 0xaf, 0xb6, 0x1b, 0x2a, 0x3b, 0xb7, 0x8b, 0x4e, 0xc1, 0xdc, 0x5a, 0xf3,
-		0xda, 0xf5, 0xb6, 0x5a, 0x5f, 0x6b, 0x36, 0x36, 0xda, 0x6a, 0xb5, 0xdd, 0xae, 0xd6, 0x5f, 0x94,
-		0xe2, 0x17, 0xef, 0xe6, 0x21, 0x59, 0xad, 0xd5, 0x9b, 0xa8, 0x0e, 0x49, 0x9a, 0x0a, 0x39, 0xf2,
-		0x04, 0x58, 0xe5, 0xe8, 0xdc, 0x30, 0xba, 0x0a, 0x29, 0x9a, 0x25, 0x41, 0x47, 0x1f, 0x09, 0xab,
-		0x4c, 0x48, 0x16, 0x93, 0xc6, 0xd0, 0x19, 0x79, 0xe4, 0x19, 0xb1, 0xca, 0xd1, 0xb9, 0x63, 0xb4,
-		0x06, 0x19, 0x11, 0x24, 0x4f, 0x3a, 0xb8, 0x55, 0x99, 0x98, 0xd0, 0x25, 0x5d, 0x63, 0xc9, 0x86,
-		0xa3, 0x8f, 0x8f, 0x55, 0x26, 0x64, 0x95, 0x51, 0xd3, 0xbf, 0xcf, 0x34, 0xe1, 0x44, 0x58, 0x65,
-		0x52, 0x9e, 0x18, 0x29, 0x90, 0x0b, 0xd2, 0x38, 0x93, 0x0f, 0xc5, 0x55, 0xa6, 0x48, 0x98, 0xa3,
-		0xf7, 0x41, 0x31, 0x1a, 0xea, 0x4e, 0x77, 0xea, 0xac, 0x32, 0x65, 0x46, 0x9a, 0xf0, 0x8f, 0xc6,
-		0xbd, 0xd3, 0x9d, 0x42, 0xab, 0x4c, 0x99, 0xa0, 0x46, 0xaf, 0xc1, 0xec, 0x70, 0x5c, 0x3a, 0xfd,
-		0xa1, 0xb4, 0xca, 0x31, 0x52, 0xd6, 0xa8, 0x07, 0x68, 0x44, 0x3c, 0x7b, 0x8c, 0x33, 0x6a, 0x95,
-		0xe3, 0x64, 0xb0, 0x51, 0x07, 0xca, 0x83, 0x41, 0xe2, 0xb4, 0x67, 0xd6, 0x2a, 0x53, 0x67, 0xb3,
-		0x59, 0x2d, 0xd1, 0xe0, 0x72, 0xda, 0x33, 0x6c, 0x95, 0xa9, 0x93, 0xdb, 0x68, 0x1b, 0x20, 0x14,
-		0x1f, 0x4e, 0x71, 0xa6, 0xad, 0x32, 0x4d, 0x9a, 0x1b, 0xd9, 0x30, 0x37, 0x2a, 0x70, 0x3c, 0xce,
-		0x11, 0xb7, 0xca, 0xb1, 0xb2, 0xdf, 0x44, 0x9f, 0xa3, 0x21, 0xe0, 0x74, 0x47, 0xde, 0x2a, 0x53,
-		0xa6, 0xc1, 0x6b, 0xd5, 0xb1, 0xe7, 0x9c, 0x1f, 0x39, 0xf2, 0x9c, 0x73, 0x70, 0x72, 0xd9, 0x3f,
-		0xdb, 0xfc, 0x99, 0x67, 0xe0, 0x1d, 0xfc, 0x05, 0x1d, 0xd7, 0xd3, 0x6e, 0x19, 0xe6, 0x9e, 0xff,
-		0x94, 0x11, 0xff, 0xe6, 0x87, 0x9c, 0x4f, 0xf2, 0xe7, 0x7a, 0x04, 0x74, 0xc2, 0x83, 0x46, 0x63,
-		0x5f, 0x79, 0x9c, 0x74, 0x1f, 0x62, 0xf2, 0xf1, 0xe5, 0x23, 0x1e, 0x4b, 0x9a, 0xf0, 0x24, 0xd3,
-		0x88, 0xc7, 0x94, 0x2a, 0x47, 0xbe, 0x31, 0x50, 0x39, 0xea, 0xdc, 0xb7, 0xfc, 0xfd, 0x31, 0x28,
-		0x5d, 0x37, 0x5c, 0xcf, 0x72, 0x0c, 0x5d, 0xeb, 0xd2, 0xe5, 0xe5, 0xca, 0xb4, 0xb7, 0xb2, 0x6a,
-		0x39, 0xe2, 0xae, 0xf0, 0xf7, 0x96, 0xf8, 0x4d, 0xa1, 0x55, 0x48, 0xdf, 0xd6, 0xba, 0xec, 0x4e,
-		0x54, 0xf8, 0xb1, 0xb5, 0x41, 0x99, 0x87, 0xfc, 0xa8, 0x30, 0x17, 0x46, 0xbb, 0x12, 0x5f, 0x88,
-		0xc9, 0xdf, 0x1e, 0x03, 0x29, 0x68, 0x99, 0x82, 0x75, 0xcb, 0xe9, 0xd0, 0x50, 0xc0, 0xb6, 0x43,
-		0x67, 0x05, 0xc4, 0x27, 0x75, 0xf9, 0x8c, 0x1e, 0xf6, 0xfd, 0xb6, 0xf1, 0x4e, 0x56, 0x32, 0xe4,
-		0x60, 0x8d, 0xb8, 0x72, 0x9e, 0x18, 0x75, 0xe5, 0x5c, 0xfe, 0x3e, 0x7a, 0xc3, 0xa5, 0xd7, 0x33,
-		0x5c, 0x32, 0x3b, 0x14, 0x1a, 0xdc, 0xdf, 0x80, 0xa4, 0xa3, 0x79, 0x3c, 0xe4, 0xad, 0x5d, 0x3e,
-		0xf6, 0x93, 0x51, 0xac, 0xcf, 0x94, 0x07, 0x7a, 0x09, 0xb2, 0x3d, 0xed, 0x40, 0xa5, 0xfc, 0xe2,
-		0x5f, 0x13, 0xbf, 0x4c, 0x4f, 0x3b, 0x20, 0xed, 0x43, 0xef, 0x83, 0x32, 0x61, 0xa9, 0xef, 0x6b,
-		0xe6, 0x1e, 0x66, 0x9c, 0x13, 0x5f, 0x13, 0xe7, 0x62, 0x4f, 0x3b, 0xa8, 0x53, 0x6e, 0x84, 0x3f,
-		0x7f, 0x5a, 0xeb, 0x57, 0x63, 0x3c, 0x8f, 0x41, 0x05, 0x83, 0x34, 0x90, 0x74, 0xff, 0x8b, 0x56,
-		0x2a, 0x36, 0x5d, 0x1e, 0x19, 0xa7, 0x09, 0x03, 0x62, 0xad, 0x15, 0x49, 0xf3, 0x3e, 0xfb, 0xe6,
-		0x62, 0x8c, 0xd5, 0x5a, 0xd6, 0x87, 0xc4, 0x9e, 0x67, 0xe9, 0x19, 0x75, 0xca, 0x01, 0x2f, 0x0a,
-		0xaf, 0x9a, 0x31, 0x04, 0x46, 0x4d, 0xca, 0x79, 0x1f, 0x3e, 0x15, 0x83, 0xfc, 0x6a, 0xe8, 0x31,
-		0xc7, 0x05, 0xc8, 0xf4, 0x2c, 0xd3, 0xb8, 0x85, 0x1d, 0x7f, 0xd7, 0x8c, 0x7d, 0x12, 0xcf, 0x97,
-		0xfd, 0x4d, 0x40, 0xef, 0x50, 0xbc, 0x77, 0x24, 0xbe, 0x09, 0xd5, 0x1d, 0xbc, 0xe3, 0x1a, 0x42,
-		0xce, 0x8a, 0xf8, 0x44, 0x8f, 0x82, 0xe4, 0x62, 0xbd, 0xef, 0x18, 0xde, 0xa1, 0xaa, 0x5b, 0xa6,
-		0xa7, 0xe9, 0x1e, 0xcf, 0x0c, 0x94, 0x05, 0xbc, 0xce, 0xc0, 0x84, 0x49, 0x07, 0x7b, 0x9a, 0xd1,
-		0x65, 0x67, 0x45, 0x73, 0x8a, 0xf8, 0xe4, 0x4d, 0x7d, 0x23, 0x13, 0x8e, 0x8c, 0xeb, 0x20, 0x59,
-		0x36, 0x76, 0x22, 0x47, 0x64, 0x98, 0x36, 0x2e, 0xfc, 0xc6, 0xa7, 0x9f, 0x98, 0xe7, 0x02, 0xe7,
-		0xc7, 0x2b, 0xd8, 0xdf, 0x2e, 0x50, 0xca, 0x82, 0x42, 0x9c, 0x9d, 0x79, 0x25, 0xb2, 0x4f, 0xd6,
-		0xdf, 0x09, 0x1e, 0xb6, 0x99, 0x1f, 0x12, 0x6a, 0xd5, 0x3c, 0xac, 0x2d, 0xfc, 0x7a, 0xc0, 0x3a,
-		0x88, 0x9c, 0x5f, 0xc4, 0x87, 0xe1, 0x4d, 0x33, 0xca, 0x86, 0x04, 0x15, 0xaf, 0x69, 0x46, 0x57,
-		0xfc, 0xf9, 0x54, 0x85, 0x7f, 0xa1, 0x15, 0x3f, 0xe1, 0x99, 0xa4, 0x11, 0x9a, 0x3c, 0x4e, 0x37,
-		0x6a, 0x96, 0xd9, 0x89, 0x66, 0x36, 0x51, 0x1d, 0xd2, 0x9e, 0x75, 0x0b, 0x9b, 0x5c, 0x40, 0xb5,
-		0xc7, 0x8e, 0xf1, 0x36, 0x9c, 0xc2, 0x49, 0xd1, 0x37, 0x82, 0xd4, 0xc1, 0x5d, 0xbc, 0xc7, 0x6e,
-		0x70, 0xee, 0x6b, 0x0e, 0x66, 0xef, 0x07, 0xdc, 0xd3, 0xcb, 0x6f, 0x65, 0x9f, 0x55, 0x8b, 0x72,
-		0x42, 0x5b, 0xd1, 0xe7, 0x42, 0x33, 0x7c, 0x3b, 0x7b, 0x4c, 0x1f, 0x43, 0x9a, 0x17, 0xb6, 0x85,
-		0x91, 0xe7, 0x45, 0x1f, 0x05, 0xa9, 0x6f, 0xee, 0x58, 0x26, 0xfd, 0xab, 0x83, 0x3c, 0xae, 0xcb,
-		0xb2, 0xbd, 0x53, 0x1f, 0xce, 0xf7, 0x4e, 0xb7, 0xa0, 0x14, 0xa0, 0xd2, 0x19, 0x92, 0x3b, 0xee,
-		0x0c, 0x29, 0xfa, 0x0c, 0x08, 0x0a, 0x5a, 0x07, 0x08, 0xe6, 0x20, 0xdd, 0xbc, 0xcb, 0x8f, 0x1f,
-		0xb1, 0x60, 0x36, 0x87, 0x3b, 0x13, 0x62, 0x80, 0xbe, 0x01, 0xe6, 0x7a, 0x86, 0xa9, 0xba, 0xb8,
-		0xbb, 0xab, 0x72, 0xc9, 0x11, 0xbe, 0xf9, 0xe3, 0x8f, 0xe6, 0x6c, 0xcf, 0x30, 0x5b, 0xb8, 0xbb,
-		0xbb, 0xea, 0x73, 0x41, 0xef, 0x84, 0x33, 0x41, 0xef, 0x2d, 0x53, 0xdd, 0xb7, 0xba, 0x1d, 0xd5,
-		0xc1, 0xbb, 0xaa, 0x4e, 0x5f, 0xf7, 0x2b, 0x50, 0x99, 0x9d, 0xf2, 0x51, 0x36, 0xcd, 0xeb, 0x56,
-		0xb7, 0xa3, 0xe0, 0xdd, 0x3a, 0x29, 0x46, 0x0f, 0x42, 0xd0, 0x75, 0xd5, 0xe8, 0xb8, 0x0b, 0xc5,
-		0xa5, 0xc4, 0xb9, 0xa4, 0x52, 0xf0, 0x81, 0xcd, 0x8e, 0xbb, 0x92, 0xfd, 0xd0, 0xc7, 0x17, 0x67,
-		0xbe, 0xf8, 0xf1, 0xc5, 0x19, 0xf9, 0x2a, 0x7d, 0xe5, 0x8a, 0xcf, 0x23, 0xec, 0xa2, 0xcb, 0x90,
-		0xd3, 0xc4, 0x07, 0xbb, 0xc3, 0x76, 0xc4, 0x3c, 0x0c, 0x50, 0xe5, 0x9f, 0x88, 0x41, 0x7a, 0xf5,
-		0xe6, 0x96, 0x66, 0x38, 0xa8, 0x01, 0xb3, 0x81, 0x62, 0x4e, 0x3b, 0xa5, 0x03, 0x5d, 0x16, 0x73,
-		0x7a, 0x63, 0xdc, 0xe1, 0xb9, 0x5c, 0xed, 0x81, 0xdf, 0xf8, 0xf4, 0x13, 0xf7, 0x73, 0x36, 0x37,
-		0x07, 0xce, 0xd1, 0x09, 0x7e, 0x83, 0xe7, 0xeb, 0x42, 0x7d, 0xbe, 0x01, 0x19, 0xd6, 0x54, 0x17,
-		0xbd, 0x00, 0x29, 0x9b, 0xfc, 0xe0, 0x7b, 0x02, 0x67, 0xc7, 0x2a, 0x38, 0xc5, 0x0f, 0xab, 0x03,
-		0xa3, 0x93, 0xbf, 0x3d, 0x0e, 0xb0, 0x7a, 0xf3, 0x66, 0xdb, 0x31, 0xec, 0x2e, 0xf6, 0xde, 0xaa,
-		0xbe, 0x6f, 0xc3, 0x89, 0xd0, 0xed, 0x6c, 0x47, 0x3f, 0x7e, 0xff, 0xe7, 0x82, 0x8b, 0xda, 0x8e,
-		0x3e, 0x92, 0x6d, 0xc7, 0xf5, 0x7c, 0xb6, 0x89, 0xe3, 0xb3, 0x5d, 0x75, 0xbd, 0x61, 0xc9, 0xbe,
-		0x0c, 0xf9, 0x40, 0x18, 0x2e, 0x6a, 0x42, 0xd6, 0xe3, 0xbf, 0xb9, 0x80, 0xe5, 0xf1, 0x02, 0x16,
-		0x64, 0x61, 0x21, 0xfb, 0xe4, 0xf2, 0x9f, 0xc6, 0x00, 0x42, 0x73, 0xe4, 0xcf, 0xa7, 0x8e, 0xa1,
-		0x26, 0xa4, 0xb9, 0x25, 0x4e, 0xdc, 0xf3, 0x1b, 0x9c, 0x8c, 0x41, 0x48, 0xa8, 0xdf, 0x19, 0x87,
-		0xb9, 0x6d, 0x31, 0x7b, 0xff, 0xfc, 0xcb, 0x60, 0x1b, 0x32, 0xd8, 0xf4, 0x1c, 0xc3, 0xdf, 0xd5,
-		0x7a, 0x72, 0xdc, 0x98, 0x8f, 0xe8, 0x54, 0xc3, 0xf4, 0x9c, 0xc3, 0xb0, 0x06, 0x08, 0x5e, 0x21,
-		0x79, 0x7c, 0x34, 0x01, 0x0b, 0xe3, 0x48, 0x89, 0x37, 0xac, 0x3b, 0x98, 0x02, 0xa2, 0x97, 0x56,
-		0x4b, 0x02, 0xcc, 0xd7, 0x18, 0x05, 0x88, 0x57, 0x46, 0x94, 0x8b, 0xa0, 0xde, 0x9b, 0x1b, 0x56,
-		0x0a, 0x38, 0xd0, 0x55, 0xa6, 0x0d, 0x65, 0x71, 0x0b, 0x66, 0x47, 0xeb, 0x6a, 0xa6, 0x2e, 0xdc,
-		0xd5, 0x63, 0x2d, 0x09, 0xe2, 0x26, 0x4d, 0x8d, 0xb1, 0x40, 0x0d, 0xc8, 0x08, 0x6e, 0xc9, 0xe3,
-		0x73, 0x13, 0xb4, 0xe8, 0x01, 0x28, 0x84, 0x17, 0x06, 0xea, 0x7a, 0x24, 0x95, 0x7c, 0x68, 0x5d,
-		0x98, 0xb4, 0xf2, 0xa4, 0x8f, 0x5c, 0x79, 0xb8, 0x77, 0xf7, 0xc3, 0xf4, 0xb0, 0x7a, 0xe7, 0x2f,
-		0xfe, 0xb0, 0x6c, 0x01, 0xb0, 0xa9, 0x4a, 0x2c, 0x29, 0x1f, 0x99, 0x7b, 0x98, 0xef, 0x39, 0xc6,
-		0x64, 0xd5, 0xf5, 0xbe, 0x5e, 0x23, 0xf4, 0x5b, 0x71, 0x28, 0x84, 0x47, 0xe8, 0x2f, 0xe5, 0xa2,
-		0x85, 0x36, 0x02, 0x33, 0xc5, 0x2e, 0xf4, 0x3c, 0x3a, 0xce, 0x4c, 0x0d, 0x69, 0xf3, 0x04, 0xfb,
-		0xf4, 0x85, 0x04, 0xa4, 0xf9, 0x89, 0xbb, 0xcd, 0x21, 0x47, 0x36, 0x36, 0xe9, 0x49, 0x82, 0xa2,
-		0x78, 0x92, 0x60, 0xa4, 0x1f, 0xfb, 0x10, 0x94, 0x48, 0x40, 0x1c, 0x39, 0xc6, 0x17, 0x3b, 0x57,
-		0xa4, 0x71, 0x6d, 0x70, 0x68, 0x1d, 0x2d, 0x42, 0x9e, 0xa0, 0x05, 0x76, 0x98, 0xe0, 0x40, 0x4f,
-		0x3b, 0x68, 0x30, 0x08, 0x7a, 0x02, 0xd0, 0xbe, 0x9f, 0x98, 0x50, 0x03, 0x41, 0x10, 0xbc, 0xd9,
-		0xa0, 0x44, 0xa0, 0xdf, 0x0f, 0x40, 0x5a, 0xa1, 0xb2, 0x67, 0xa7, 0xf9, 0xa3, 0xde, 0x04, 0xb2,
-		0x4a, 0x9f, 0x9e, 0xfe, 0x96, 0x18, 0xf3, 0x87, 0x07, 0xc2, 0x66, 0x1e, 0x8e, 0xb4, 0xa7, 0x98,
-		0x14, 0x7f, 0xf4, 0xe6, 0x62, 0xe5, 0x50, 0xeb, 0x75, 0x57, 0xe4, 0x11, 0x7c, 0xe4, 0x51, 0x91,
-		0x3c, 0x71, 0x9c, 0xa3, 0x61, 0x37, 0x6a, 0x82, 0x74, 0x0b, 0x1f, 0xaa, 0x0e, 0xff, 0x9b, 0xdc,
-		0xea, 0x2e, 0xc6, 0x3c, 0x70, 0x39, 0xbd, 0x3c, 0xe2, 0x11, 0xf0, 0xe5, 0xba, 0x65, 0x98, 0x7c,
-		0x0b, 0xac, 0x74, 0x0b, 0x1f, 0x2a, 0x9c, 0xee, 0x2a, 0xc6, 0x2b, 0xef, 0x20, 0x33, 0xe5, 0x8d,
-		0x2f, 0xfc, 0xd4, 0xf9, 0x33, 0xa1, 0x07, 0xad, 0x0f, 0xfc, 0xdc, 0x1c, 0x1b, 0x5e, 0xe2, 0xf4,
-		0xa2, 0x60, 0x01, 0xf2, 0x8f, 0xfd, 0xaf, 0x03, 0x84, 0x82, 0x82, 0xd8, 0xd1, 0xc1, 0x46, 0x40,
-		0x1f, 0x09, 0x36, 0x42, 0xd3, 0xf3, 0xdd, 0x81, 0xfd, 0x8f, 0x4f, 0xea, 0x4d, 0x58, 0x33, 0x39,
-		0x11, 0x9d, 0xf5, 0x33, 0xf2, 0xbf, 0x89, 0xc1, 0xe9, 0x21, 0x4d, 0xf6, 0x9b, 0xac, 0x03, 0x72,
-		0x42, 0x85, 0x54, 0x23, 0xc4, 0x3e, 0xf3, 0xbd, 0x4d, 0x8c, 0x59, 0x67, 0x68, 0x11, 0x78, 0x6b,
-		0x16, 0x32, 0x6e, 0xc5, 0x7e, 0x2d, 0x06, 0xf3, 0xe1, 0x06, 0xf8, 0x5d, 0x69, 0x41, 0x21, 0x5c,
-		0x35, 0xef, 0xc4, 0x3b, 0xa6, 0xe9, 0x44, 0xb8, 0xfd, 0x11, 0x26, 0xe8, 0x66, 0x60, 0x2d, 0x58,
-		0x52, 0xf0, 0xa9, 0xa9, 0x85, 0x22, 0x1a, 0x36, 0xd2, 0x6a, 0xb0, 0xb1, 0xf9, 0xbd, 0x18, 0x24,
-		0xb7, 0x2c, 0xab, 0x8b, 0xde, 0x0f, 0xb3, 0xa6, 0xe5, 0xa9, 0x64, 0x66, 0xe1, 0x8e, 0xca, 0x73,
-		0x04, 0xcc, 0x12, 0x37, 0x8e, 0x94, 0xd5, 0x97, 0xde, 0x5c, 0x1c, 0xa6, 0x1c, 0xf5, 0xa8, 0x7c,
-		0xd9, 0xb4, 0xbc, 0x1a, 0x45, 0x6a, 0xb3, 0x34, 0xc2, 0x2e, 0x14, 0xa3, 0xd5, 0x31, 0x6b, 0x5d,
-		0x9d, 0x54, 0x5d, 0x71, 0x62, 0x55, 0x85, 0x9d, 0x50, 0x3d, 0xec, 0x8d, 0xe8, 0x3f, 0x20, 0x23,
-		0xf7, 0x5e, 0x90, 0x6e, 0x0e, 0x9e, 0x5f, 0x6a, 0x40, 0x46, 0x9c, 0x57, 0x8a, 0x4d, 0x79, 0x14,
-		0x2a, 0x2c, 0x4e, 0x4e, 0x4b, 0x93, 0xae, 0x9f, 0x8d, 0xc3, 0xe9, 0xba, 0x65, 0xba, 0x3c, 0xa1,
-		0xc3, 0x27, 0x35, 0x4b, 0xc3, 0x1e, 0xa2, 0x47, 0xc7, 0xa4, 0x9b, 0x0a, 0xc3, 0x49, 0xa5, 0x9b,
-		0x50, 0x26, 0xab, 0xab, 0x6e, 0x99, 0x5f, 0x63, 0x4e, 0xa9, 0x68, 0x75, 0x3b, 0xbc, 0x45, 0xb7,
-		0xf0, 0x21, 0xe1, 0x6b, 0xe2, 0x3b, 0x11, 0xbe, 0x89, 0x7b, 0xe3, 0x6b, 0xe2, 0x3b, 0x21, 0xbe,
-		0xc1, 0x76, 0x79, 0x32, 0xb2, 0x5d, 0x7e, 0x19, 0x12, 0xc4, 0x12, 0xa6, 0x8e, 0x61, 0x3b, 0x08,
-		0x41, 0x68, 0x45, 0x6b, 0xc1, 0x69, 0x9e, 0x24, 0x70, 0x37, 0x77, 0xa9, 0x44, 0x31, 0xed, 0xd0,
-		0x8b, 0xf8, 0x70, 0x44, 0xc6, 0xa0, 0x30, 0x55, 0xc6, 0xe0, 0xfc, 0xcf, 0xc5, 0x00, 0x82, 0xdc,
-		0x18, 0x7a, 0x1c, 0x4e, 0xd5, 0x36, 0x37, 0x56, 0xd5, 0x56, 0xbb, 0xda, 0xde, 0x6e, 0x45, 0xff,
-		0x6c, 0x8c, 0x78, 0x08, 0xcb, 0xb5, 0xb1, 0x6e, 0xec, 0x1a, 0xb8, 0x83, 0x1e, 0x86, 0xf9, 0x28,
-		0x36, 0xf9, 0x6a, 0xac, 0x4a, 0xb1, 0x4a, 0xe1, 0x8d, 0xbb, 0x4b, 0x59, 0x16, 0x1e, 0xe0, 0x0e,
-		0x3a, 0x07, 0x27, 0x86, 0xf1, 0x9a, 0x1b, 0xd7, 0xa4, 0x78, 0xa5, 0xf8, 0xc6, 0xdd, 0xa5, 0x9c,
-		0x1f, 0x47, 0x20, 0x19, 0x50, 0x18, 0x93, 0xf3, 0x4b, 0x54, 0xe0, 0x8d, 0xbb, 0x4b, 0x69, 0x36,
-		0x63, 0x2a, 0xc9, 0x0f, 0xfd, 0xe8, 0xd9, 0x99, 0xf3, 0xef, 0x05, 0x68, 0x9a, 0xbb, 0x8e, 0xa6,
-		0x53, 0xcb, 0x50, 0x81, 0x93, 0xcd, 0x8d, 0xab, 0x4a, 0xb5, 0xde, 0x6e, 0x6e, 0x6e, 0x0c, 0xfc,
-		0xb5, 0x9b, 0x68, 0xd9, 0xea, 0xe6, 0x76, 0x6d, 0xad, 0xa1, 0xb6, 0x9a, 0xd7, 0x36, 0xd8, 0xae,
-		0x7f, 0xa4, 0xec, 0x3d, 0x1b, 0xed, 0xe6, 0x7a, 0x43, 0x8a, 0xd7, 0x2e, 0x8f, 0xdd, 0x55, 0xba,
-		0x2f, 0x32, 0x17, 0x83, 0xd5, 0x28, 0xb2, 0x95, 0xf4, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x98,
-		0x91, 0x83, 0x6e, 0x31, 0xa3, 0x00, 0x00,
+		// 12767 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x7d, 0x94, 0x64, 0xc7,
+		0x55, 0x18, 0x3e, 0xaf, 0xbf, 0xa6, 0xfb, 0xf6, 0xd7, 0x9b, 0x9a, 0xd9, 0xdd, 0xde, 0x5e, 0xed,
+		0xee, 0xa8, 0x65, 0x49, 0xab, 0x95, 0x35, 0x2b, 0xad, 0xb4, 0x2b, 0xed, 0xc8, 0xb6, 0xe8, 0xee,
+		0xe9, 0xdd, 0x9d, 0xd5, 0xcc, 0xf4, 0xe8, 0xf5, 0xcc, 0xea, 0x03, 0xec, 0xc7, 0x9b, 0xee, 0x9a,
+		0x99, 0xd6, 0x76, 0xbf, 0xd7, 0xea, 0xf7, 0x7a, 0x77, 0x47, 0x7f, 0xf0, 0x33, 0xd8, 0xfc, 0x62,
+		0xd6, 0x98, 0x98, 0x8f, 0x03, 0x02, 0xb3, 0xc6, 0x86, 0x80, 0x0d, 0x81, 0x04, 0x8c, 0x03, 0x26,
+		0x39, 0x21, 0x90, 0x93, 0x0f, 0xe0, 0x40, 0x8e, 0xe1, 0x9c, 0x24, 0x24, 0xe7, 0xa0, 0x04, 0x9b,
+		0x63, 0x09, 0x63, 0xc2, 0x97, 0x48, 0xc8, 0xf1, 0xc9, 0x71, 0xce, 0xad, 0x8f, 0xf7, 0xd1, 0x1f,
+		0xd3, 0x3d, 0x2b, 0xd9, 0x51, 0x20, 0xff, 0xcc, 0x74, 0x55, 0xdd, 0x7b, 0xab, 0xea, 0xd6, 0xad,
+		0x5b, 0xb7, 0x6e, 0xdd, 0xaa, 0x07, 0x5f, 0xd1, 0x61, 0x7e, 0xc7, 0xb2, 0x76, 0x5a, 0xf4, 0x4c,
+		0xa7, 0x6b, 0x39, 0xd6, 0x56, 0x6f, 0xfb, 0x4c, 0x83, 0xda, 0xf5, 0x6e, 0xb3, 0xe3, 0x58, 0xdd,
+		0x05, 0x96, 0x47, 0xb2, 0x1c, 0x62, 0x41, 0x42, 0x14, 0x56, 0x61, 0xe6, 0x62, 0xb3, 0x45, 0x97,
+		0x5c, 0xc0, 0x1a, 0x75, 0xc8, 0x13, 0x10, 0xd9, 0x6e, 0xb6, 0x68, 0x4e, 0x99, 0x0f, 0x9f, 0x4a,
+		0x9e, 0x7d, 0xc7, 0x42, 0x1f, 0xd2, 0x42, 0x10, 0x63, 0x1d, 0xb3, 0x35, 0x86, 0x51, 0x78, 0x25,
+		0x0a, 0xb3, 0x43, 0x4a, 0x09, 0x81, 0x88, 0x69, 0xb4, 0x91, 0xa2, 0x72, 0x2a, 0xa1, 0xb1, 0xdf,
+		0x24, 0x07, 0xd3, 0x1d, 0xa3, 0x7e, 0xcd, 0xd8, 0xa1, 0xb9, 0x10, 0xcb, 0x96, 0x49, 0x72, 0x02,
+		0xa0, 0x41, 0x3b, 0xd4, 0x6c, 0x50, 0xb3, 0xbe, 0x97, 0x0b, 0xcf, 0x87, 0x4f, 0x25, 0x34, 0x5f,
+		0x0e, 0x79, 0x10, 0x66, 0x3a, 0xbd, 0xad, 0x56, 0xb3, 0xae, 0xfb, 0xc0, 0x60, 0x3e, 0x7c, 0x2a,
+		0xaa, 0xa9, 0xbc, 0x60, 0xc9, 0x03, 0xbe, 0x1f, 0xb2, 0x37, 0xa8, 0x71, 0xcd, 0x0f, 0x9a, 0x64,
+		0xa0, 0x19, 0xcc, 0xf6, 0x01, 0x96, 0x21, 0xd5, 0xa6, 0xb6, 0x6d, 0xec, 0x50, 0xdd, 0xd9, 0xeb,
+		0xd0, 0x5c, 0x84, 0xf5, 0x7e, 0x7e, 0xa0, 0xf7, 0xfd, 0x3d, 0x4f, 0x0a, 0xac, 0x8d, 0xbd, 0x0e,
+		0x25, 0x45, 0x48, 0x50, 0xb3, 0xd7, 0xe6, 0x14, 0xa2, 0x23, 0xf8, 0x57, 0x31, 0x7b, 0xed, 0x7e,
+		0x2a, 0x71, 0x44, 0x13, 0x24, 0xa6, 0x6d, 0xda, 0xbd, 0xde, 0xac, 0xd3, 0x5c, 0x8c, 0x11, 0xb8,
+		0x7f, 0x80, 0x40, 0x8d, 0x97, 0xf7, 0xd3, 0x90, 0x78, 0xa4, 0x0c, 0x09, 0x7a, 0xd3, 0xa1, 0xa6,
+		0xdd, 0xb4, 0xcc, 0xdc, 0x34, 0x23, 0x72, 0xef, 0x90, 0x51, 0xa4, 0xad, 0x46, 0x3f, 0x09, 0x0f,
+		0x8f, 0x9c, 0x87, 0x69, 0xab, 0xe3, 0x34, 0x2d, 0xd3, 0xce, 0xc5, 0xe7, 0x95, 0x53, 0xc9, 0xb3,
+		0x77, 0x0d, 0x15, 0x84, 0x2a, 0x87, 0xd1, 0x24, 0x30, 0x59, 0x06, 0xd5, 0xb6, 0x7a, 0xdd, 0x3a,
+		0xd5, 0xeb, 0x56, 0x83, 0xea, 0x4d, 0x73, 0xdb, 0xca, 0x25, 0x18, 0x81, 0x93, 0x83, 0x1d, 0x61,
+		0x80, 0x65, 0xab, 0x41, 0x97, 0xcd, 0x6d, 0x4b, 0xcb, 0xd8, 0x81, 0x34, 0x39, 0x0c, 0x31, 0x7b,
+		0xcf, 0x74, 0x8c, 0x9b, 0xb9, 0x14, 0x93, 0x10, 0x91, 0x22, 0x67, 0x61, 0x9a, 0x36, 0x9a, 0x58,
+		0x5d, 0x2e, 0x33, 0xaf, 0x9c, 0xca, 0x9c, 0xcd, 0x0d, 0xf2, 0x98, 0x97, 0x6b, 0x12, 0xb0, 0xf0,
+		0x2b, 0x31, 0xc8, 0x4e, 0x22, 0x96, 0x4f, 0x42, 0x74, 0x1b, 0x39, 0x93, 0x0b, 0x1d, 0x84, 0x6f,
+		0x1c, 0x27, 0xc8, 0xf8, 0xd8, 0x1d, 0x32, 0xbe, 0x08, 0x49, 0x93, 0xda, 0x0e, 0x6d, 0x70, 0x29,
+		0x0a, 0x4f, 0x28, 0x87, 0xc0, 0x91, 0x06, 0xc5, 0x30, 0x72, 0x47, 0x62, 0xf8, 0x1c, 0x64, 0xdd,
+		0x26, 0xe9, 0x5d, 0xc3, 0xdc, 0x91, 0xf2, 0x7c, 0x66, 0x5c, 0x4b, 0x16, 0x2a, 0x12, 0x4f, 0x43,
+		0x34, 0x2d, 0x43, 0x03, 0x69, 0xb2, 0x04, 0x60, 0x99, 0xd4, 0xda, 0xd6, 0x1b, 0xb4, 0xde, 0xca,
+		0xc5, 0x47, 0x70, 0xa9, 0x8a, 0x20, 0x03, 0x5c, 0xb2, 0x78, 0x6e, 0xbd, 0x45, 0x2e, 0x78, 0xe2,
+		0x39, 0x3d, 0x42, 0xba, 0x56, 0xf9, 0xc4, 0x1c, 0x90, 0xd0, 0x4d, 0xc8, 0x74, 0x29, 0xce, 0x15,
+		0xda, 0x10, 0x3d, 0x4b, 0xb0, 0x46, 0x2c, 0x8c, 0xed, 0x99, 0x26, 0xd0, 0x78, 0xc7, 0xd2, 0x5d,
+		0x7f, 0x92, 0xdc, 0x03, 0x6e, 0x86, 0xce, 0xc4, 0x0a, 0x98, 0xe6, 0x4a, 0xc9, 0xcc, 0x35, 0xa3,
+		0x4d, 0xf3, 0x2f, 0x43, 0x26, 0xc8, 0x1e, 0x32, 0x07, 0x51, 0xdb, 0x31, 0xba, 0x0e, 0x93, 0xc2,
+		0xa8, 0xc6, 0x13, 0x44, 0x85, 0x30, 0x35, 0x1b, 0x4c, 0x33, 0x46, 0x35, 0xfc, 0x49, 0xbe, 0xc9,
+		0xeb, 0x70, 0x98, 0x75, 0xf8, 0xbe, 0xc1, 0x11, 0x0d, 0x50, 0xee, 0xef, 0x77, 0xfe, 0x71, 0x48,
+		0x07, 0x3a, 0x30, 0x69, 0xd5, 0x85, 0xdf, 0x8e, 0xc0, 0xa1, 0xa1, 0xb4, 0xc9, 0x73, 0x30, 0xd7,
+		0x33, 0x9b, 0xa6, 0x43, 0xbb, 0x9d, 0x2e, 0x45, 0x91, 0xe5, 0x75, 0xe5, 0x5e, 0x9b, 0x1e, 0x21,
+		0x74, 0x9b, 0x7e, 0x68, 0x4e, 0x45, 0x9b, 0xed, 0x0d, 0x66, 0x92, 0xe7, 0x21, 0x89, 0xf2, 0x61,
+		0x74, 0x0d, 0x46, 0x90, 0xcf, 0xc6, 0xb3, 0x93, 0x75, 0x79, 0x61, 0xc9, 0xc3, 0x2c, 0x85, 0x3f,
+		0xa4, 0x84, 0x34, 0x3f, 0x2d, 0xf2, 0x38, 0xc4, 0xb7, 0xa9, 0xe1, 0xf4, 0xba, 0xd4, 0xce, 0x9d,
+		0x65, 0xac, 0x3c, 0x36, 0x38, 0x49, 0x39, 0x40, 0x8d, 0x3a, 0x9a, 0x0b, 0x4c, 0xda, 0x90, 0xba,
+		0x4e, 0xbb, 0xcd, 0xed, 0x66, 0x9d, 0x37, 0x2a, 0xcc, 0x94, 0xcf, 0x13, 0x13, 0x36, 0xea, 0xaa,
+		0x0f, 0xb5, 0xe6, 0x18, 0x0e, 0x5d, 0x84, 0xcd, 0xb5, 0xab, 0x15, 0x6d, 0xf9, 0xe2, 0x72, 0x65,
+		0x89, 0x37, 0x33, 0x40, 0x3e, 0xff, 0x03, 0x0a, 0x24, 0x7d, 0x3d, 0x41, 0x75, 0x68, 0xf6, 0xda,
+		0x5b, 0xb4, 0x2b, 0xc6, 0x4b, 0xa4, 0xc8, 0x31, 0x48, 0x6c, 0xf7, 0x5a, 0x2d, 0x2e, 0x74, 0x7c,
+		0x2d, 0x8d, 0x63, 0x06, 0x0a, 0x1c, 0xea, 0x38, 0xa1, 0x46, 0x98, 0x8e, 0xc3, 0xdf, 0x24, 0x0f,
+		0x71, 0x29, 0x94, 0xb9, 0xe8, 0xbc, 0x72, 0x2a, 0xae, 0xb9, 0x69, 0x5e, 0xd6, 0xa1, 0x86, 0x43,
+		0x1b, 0xb9, 0x98, 0x2c, 0xe3, 0xe9, 0x2b, 0x91, 0x78, 0x44, 0x8d, 0x16, 0x1e, 0x83, 0x99, 0x81,
+		0xae, 0x90, 0x2c, 0x24, 0x97, 0x2a, 0xe5, 0x95, 0xa2, 0x56, 0xdc, 0x58, 0xae, 0xae, 0xa9, 0x53,
+		0x24, 0x03, 0xbe, 0xde, 0xa9, 0xca, 0xe9, 0x44, 0xfc, 0xf5, 0x69, 0xf5, 0xfd, 0xef, 0x7f, 0xff,
+		0xfb, 0x43, 0x85, 0x5f, 0x8f, 0xc1, 0xdc, 0x30, 0x25, 0x38, 0x54, 0x1f, 0x7b, 0x9d, 0x0e, 0x07,
+		0x3a, 0x5d, 0x84, 0x68, 0xcb, 0xd8, 0xa2, 0xad, 0x5c, 0x84, 0x0d, 0xc2, 0x83, 0x13, 0xa9, 0xd9,
+		0x85, 0x15, 0x44, 0xd1, 0x38, 0x26, 0x79, 0x8f, 0x60, 0x4d, 0x94, 0x51, 0x38, 0x3d, 0x19, 0x05,
+		0x54, 0x8e, 0x82, 0x8d, 0xc7, 0x20, 0x81, 0xff, 0x39, 0xdf, 0x63, 0x9c, 0xef, 0x98, 0xc1, 0xf8,
+		0x9e, 0x87, 0x38, 0xd3, 0x7b, 0x0d, 0xea, 0x8e, 0x89, 0x4c, 0xa3, 0xa6, 0x68, 0xd0, 0x6d, 0xa3,
+		0xd7, 0x72, 0xf4, 0xeb, 0x46, 0xab, 0x47, 0x99, 0x06, 0x4b, 0x68, 0x29, 0x91, 0x79, 0x15, 0xf3,
+		0xc8, 0x49, 0x48, 0x72, 0x35, 0xd9, 0x34, 0x1b, 0xf4, 0x26, 0x5b, 0x42, 0xa3, 0x1a, 0xd7, 0x9c,
+		0xcb, 0x98, 0x83, 0xd5, 0xbf, 0x68, 0x5b, 0xa6, 0xd4, 0x35, 0xac, 0x0a, 0xcc, 0x60, 0xd5, 0x3f,
+		0xde, 0xbf, 0x7a, 0x1f, 0x1f, 0xde, 0xbd, 0x01, 0xe5, 0x78, 0x3f, 0x64, 0x19, 0xc4, 0xa3, 0x62,
+		0x2a, 0x1b, 0xad, 0xdc, 0x0c, 0x13, 0x83, 0x0c, 0xcf, 0xae, 0x8a, 0xdc, 0xc2, 0x2f, 0x85, 0x20,
+		0xc2, 0x56, 0x8a, 0x2c, 0x24, 0x37, 0x9e, 0x5f, 0xaf, 0xe8, 0x4b, 0xd5, 0xcd, 0xd2, 0x4a, 0x45,
+		0x55, 0x70, 0xe8, 0x59, 0xc6, 0xc5, 0x95, 0x6a, 0x71, 0x43, 0x0d, 0xb9, 0xe9, 0xe5, 0xb5, 0x8d,
+		0xf3, 0x8f, 0xa9, 0x61, 0x17, 0x61, 0x93, 0x67, 0x44, 0xfc, 0x00, 0x8f, 0x9e, 0x55, 0xa3, 0x44,
+		0x85, 0x14, 0x27, 0xb0, 0xfc, 0x5c, 0x65, 0xe9, 0xfc, 0x63, 0x6a, 0x2c, 0x98, 0xf3, 0xe8, 0x59,
+		0x75, 0x9a, 0xa4, 0x21, 0xc1, 0x72, 0x4a, 0xd5, 0xea, 0x8a, 0x1a, 0x77, 0x69, 0xd6, 0x36, 0xb4,
+		0xe5, 0xb5, 0x4b, 0x6a, 0xc2, 0xa5, 0x79, 0x49, 0xab, 0x6e, 0xae, 0xab, 0xe0, 0x52, 0x58, 0xad,
+		0xd4, 0x6a, 0xc5, 0x4b, 0x15, 0x35, 0xe9, 0x42, 0x94, 0x9e, 0xdf, 0xa8, 0xd4, 0xd4, 0x54, 0xa0,
+		0x59, 0x8f, 0x9e, 0x55, 0xd3, 0x6e, 0x15, 0x95, 0xb5, 0xcd, 0x55, 0x35, 0x43, 0x66, 0x20, 0xcd,
+		0xab, 0x90, 0x8d, 0xc8, 0xf6, 0x65, 0x9d, 0x7f, 0x4c, 0x55, 0xbd, 0x86, 0x70, 0x2a, 0x33, 0x81,
+		0x8c, 0xf3, 0x8f, 0xa9, 0xa4, 0x50, 0x86, 0x28, 0x13, 0x43, 0x42, 0x20, 0xb3, 0x52, 0x2c, 0x55,
+		0x56, 0xf4, 0xea, 0x3a, 0x4e, 0x9a, 0xe2, 0x8a, 0xaa, 0x78, 0x79, 0x5a, 0x65, 0xbd, 0x52, 0xdc,
+		0xa8, 0x2c, 0xa9, 0x61, 0x7f, 0xde, 0x33, 0x9b, 0xcb, 0x5a, 0x65, 0x49, 0x0d, 0x15, 0xea, 0x30,
+		0x37, 0x6c, 0x85, 0x1c, 0x3a, 0x85, 0x7c, 0xb2, 0x10, 0x1a, 0x21, 0x0b, 0x8c, 0x56, 0xbf, 0x2c,
+		0x14, 0xbe, 0x18, 0x82, 0xd9, 0x21, 0x56, 0xc2, 0xd0, 0x4a, 0x9e, 0x82, 0x28, 0x97, 0x65, 0xae,
+		0xa9, 0x1f, 0x18, 0x6a, 0x6e, 0x30, 0xc9, 0x1e, 0xb0, 0x9d, 0x18, 0x9e, 0xdf, 0xde, 0x0c, 0x8f,
+		0xb0, 0x37, 0x91, 0xc4, 0x80, 0xc0, 0xbe, 0x77, 0x60, 0x35, 0xe7, 0x06, 0xcf, 0xf9, 0x49, 0x0c,
+		0x1e, 0x96, 0x77, 0xb0, 0x55, 0x3d, 0x3a, 0x64, 0x55, 0x7f, 0x12, 0x66, 0x06, 0x08, 0x4d, 0xbc,
+		0xba, 0x7e, 0x40, 0x81, 0xdc, 0x28, 0xe6, 0x8c, 0x51, 0x89, 0xa1, 0x80, 0x4a, 0x7c, 0xb2, 0x9f,
+		0x83, 0x77, 0x8f, 0x1e, 0x84, 0x81, 0xb1, 0xfe, 0x94, 0x02, 0x87, 0x87, 0xef, 0x2b, 0x86, 0xb6,
+		0xe1, 0x3d, 0x10, 0x6b, 0x53, 0x67, 0xd7, 0x92, 0x76, 0xf2, 0x7d, 0x43, 0xac, 0x2f, 0x2c, 0xee,
+		0x1f, 0x6c, 0x81, 0x45, 0x2e, 0xf4, 0xb7, 0xf5, 0xe4, 0xa8, 0x5d, 0xce, 0x40, 0x4b, 0xbf, 0x2b,
+		0x04, 0x87, 0x86, 0x12, 0x1f, 0xda, 0xd0, 0xe3, 0x00, 0x4d, 0xb3, 0xd3, 0x73, 0xb8, 0x2d, 0xcc,
+		0x35, 0x71, 0x82, 0xe5, 0x30, 0xe5, 0x85, 0x5a, 0xb6, 0xe7, 0xb8, 0xe5, 0x7c, 0x95, 0x04, 0x9e,
+		0xc5, 0x00, 0x9e, 0xf0, 0x1a, 0x1a, 0x61, 0x0d, 0x3d, 0x31, 0xa2, 0xa7, 0x03, 0x82, 0xf9, 0x30,
+		0xa8, 0xf5, 0x56, 0x93, 0x9a, 0x8e, 0x6e, 0x3b, 0x5d, 0x6a, 0xb4, 0x9b, 0xe6, 0x0e, 0x5f, 0x6d,
+		0x17, 0xa3, 0xdb, 0x46, 0xcb, 0xa6, 0x5a, 0x96, 0x17, 0xd7, 0x64, 0x29, 0x62, 0x30, 0x01, 0xea,
+		0xfa, 0x30, 0x62, 0x01, 0x0c, 0x5e, 0xec, 0x62, 0x14, 0x7e, 0x2e, 0x01, 0x49, 0xdf, 0x2e, 0x8c,
+		0xdc, 0x0d, 0xa9, 0x17, 0x8d, 0xeb, 0x86, 0x2e, 0x77, 0xd6, 0x9c, 0x13, 0x49, 0xcc, 0x5b, 0xe7,
+		0x59, 0xe4, 0x61, 0x98, 0x63, 0x20, 0x56, 0xcf, 0xa1, 0x5d, 0xbd, 0xde, 0x32, 0x6c, 0x9b, 0x31,
+		0x2d, 0xce, 0x40, 0x09, 0x96, 0x55, 0xb1, 0xa8, 0x2c, 0x4b, 0xc8, 0x39, 0x98, 0x65, 0x18, 0xed,
+		0x5e, 0xcb, 0x69, 0x76, 0x5a, 0x54, 0xc7, 0xbd, 0xbe, 0x9d, 0x03, 0x7f, 0xcb, 0x66, 0x10, 0x62,
+		0x55, 0x00, 0x60, 0x8b, 0x6c, 0xb2, 0x04, 0xc7, 0x19, 0xda, 0x0e, 0x35, 0x69, 0xd7, 0x70, 0xa8,
+		0x4e, 0x5f, 0xea, 0x19, 0x2d, 0x5b, 0x37, 0xcc, 0x86, 0xbe, 0x6b, 0xd8, 0xbb, 0xb9, 0x39, 0x24,
+		0x50, 0x0a, 0xe5, 0x14, 0xed, 0x28, 0x02, 0x5e, 0x12, 0x70, 0x15, 0x06, 0x56, 0x34, 0x1b, 0x97,
+		0x0d, 0x7b, 0x97, 0x2c, 0xc2, 0x61, 0x46, 0xc5, 0x76, 0xba, 0x4d, 0x73, 0x47, 0xaf, 0xef, 0xd2,
+		0xfa, 0x35, 0xbd, 0xe7, 0x6c, 0x3f, 0x91, 0x3b, 0xe6, 0xaf, 0x9f, 0xb5, 0xb0, 0xc6, 0x60, 0xca,
+		0x08, 0xb2, 0xe9, 0x6c, 0x3f, 0x41, 0x6a, 0x90, 0xc2, 0xc1, 0x68, 0x37, 0x5f, 0xa6, 0xfa, 0xb6,
+		0xd5, 0x65, 0x6b, 0x68, 0x66, 0x88, 0x6a, 0xf2, 0x71, 0x70, 0xa1, 0x2a, 0x10, 0x56, 0xad, 0x06,
+		0x5d, 0x8c, 0xd6, 0xd6, 0x2b, 0x95, 0x25, 0x2d, 0x29, 0xa9, 0x5c, 0xb4, 0xba, 0x28, 0x50, 0x3b,
+		0x96, 0xcb, 0xe0, 0x24, 0x17, 0xa8, 0x1d, 0x4b, 0xb2, 0xf7, 0x1c, 0xcc, 0xd6, 0xeb, 0xbc, 0xcf,
+		0xcd, 0xba, 0x2e, 0x76, 0xe4, 0x76, 0x4e, 0x0d, 0x30, 0xab, 0x5e, 0xbf, 0xc4, 0x01, 0x84, 0x8c,
+		0xdb, 0xe4, 0x02, 0x1c, 0xf2, 0x98, 0xe5, 0x47, 0x9c, 0x19, 0xe8, 0x65, 0x3f, 0xea, 0x39, 0x98,
+		0xed, 0xec, 0x0d, 0x22, 0x92, 0x40, 0x8d, 0x9d, 0xbd, 0x7e, 0xb4, 0x7b, 0x99, 0x97, 0xa5, 0x4b,
+		0xeb, 0xcc, 0xd4, 0x3b, 0xe2, 0x87, 0xf6, 0x15, 0x90, 0x05, 0x50, 0xeb, 0x75, 0x9d, 0x9a, 0xc6,
+		0x56, 0x8b, 0xea, 0x46, 0x97, 0x9a, 0x86, 0x9d, 0x3b, 0xc9, 0x80, 0x23, 0x4e, 0xb7, 0x47, 0xb5,
+		0x4c, 0xbd, 0x5e, 0x61, 0x85, 0x45, 0x56, 0x46, 0x4e, 0xc3, 0x8c, 0xb5, 0xf5, 0x62, 0x9d, 0x0b,
+		0x96, 0xde, 0xe9, 0xd2, 0xed, 0xe6, 0xcd, 0xdc, 0x3b, 0x18, 0x97, 0xb2, 0x58, 0xc0, 0xc4, 0x6a,
+		0x9d, 0x65, 0x93, 0x07, 0x40, 0xad, 0xdb, 0xbb, 0x46, 0xb7, 0xc3, 0x34, 0xab, 0xdd, 0x31, 0xea,
+		0x34, 0x77, 0x2f, 0x07, 0xe5, 0xf9, 0x6b, 0x32, 0x1b, 0x05, 0xdb, 0xbe, 0xd1, 0xdc, 0x76, 0x24,
+		0xc5, 0xfb, 0xb9, 0x60, 0xb3, 0x3c, 0x41, 0xed, 0x14, 0xa8, 0x9d, 0xdd, 0x4e, 0xb0, 0xe2, 0x53,
+		0x0c, 0x2c, 0xd3, 0xd9, 0xed, 0xf8, 0xeb, 0xbd, 0x07, 0xd2, 0x9d, 0x5d, 0x7f, 0xa5, 0x0f, 0x70,
+		0xfb, 0xab, 0xb3, 0xeb, 0xab, 0xf1, 0x31, 0x38, 0x8c, 0x40, 0x6d, 0xea, 0x18, 0x0d, 0xc3, 0x31,
+		0x7c, 0xd0, 0xef, 0x64, 0xd0, 0x73, 0x9d, 0xdd, 0xce, 0xaa, 0x28, 0x0c, 0xb4, 0xb3, 0xdb, 0xdb,
+		0xda, 0x73, 0xe5, 0xe3, 0x21, 0xde, 0x4e, 0xcc, 0x93, 0x12, 0x72, 0xc7, 0xdb, 0x8f, 0xaf, 0xdb,
+		0x66, 0xab, 0xb0, 0x08, 0x29, 0xbf, 0xdc, 0x93, 0x04, 0x70, 0xc9, 0x57, 0x15, 0x34, 0x82, 0xca,
+		0xd5, 0x25, 0x34, 0x5f, 0x5e, 0xa8, 0xa8, 0x21, 0x34, 0xa3, 0x56, 0x96, 0x37, 0x2a, 0xba, 0xb6,
+		0xb9, 0xb6, 0xb1, 0xbc, 0x5a, 0x51, 0xc3, 0x3e, 0xc3, 0xfe, 0x4a, 0x24, 0x7e, 0x5a, 0x7d, 0xf0,
+		0x4a, 0x24, 0x7e, 0x9f, 0x7a, 0x3f, 0x63, 0xcf, 0x80, 0x50, 0x16, 0xde, 0x08, 0x43, 0x26, 0xb8,
+		0x2d, 0x27, 0xef, 0x82, 0x23, 0xd2, 0xef, 0x66, 0x53, 0x47, 0xbf, 0xd1, 0xec, 0xb2, 0xc9, 0xda,
+		0x36, 0xf8, 0xc2, 0xe9, 0x0a, 0xe5, 0x9c, 0x80, 0xaa, 0x51, 0xe7, 0xd9, 0x66, 0x17, 0xa7, 0x62,
+		0xdb, 0x70, 0xc8, 0x0a, 0x9c, 0x34, 0x2d, 0xdd, 0x76, 0x0c, 0xb3, 0x61, 0x74, 0x1b, 0xba, 0xe7,
+		0xf1, 0xd4, 0x8d, 0x7a, 0x9d, 0xda, 0xb6, 0xc5, 0x17, 0x49, 0x97, 0xca, 0x5d, 0xa6, 0x55, 0x13,
+		0xc0, 0xde, 0xea, 0x51, 0x14, 0xa0, 0x7d, 0x73, 0x22, 0x3c, 0x6a, 0x4e, 0x1c, 0x83, 0x44, 0xdb,
+		0xe8, 0xe8, 0xd4, 0x74, 0xba, 0x7b, 0xcc, 0x76, 0x8f, 0x6b, 0xf1, 0xb6, 0xd1, 0xa9, 0x60, 0x9a,
+		0x5c, 0x85, 0xfb, 0x3c, 0x50, 0xbd, 0x45, 0x77, 0x8c, 0xfa, 0x9e, 0xce, 0x0c, 0x75, 0xe6, 0x23,
+		0xd2, 0xeb, 0x96, 0xb9, 0xdd, 0x6a, 0xd6, 0x1d, 0x3b, 0x97, 0x74, 0xf5, 0x5f, 0xc1, 0xc3, 0x58,
+		0x61, 0x08, 0x57, 0x6c, 0xcb, 0x64, 0xf6, 0x79, 0x59, 0x42, 0x07, 0xc4, 0x26, 0xf5, 0xb6, 0x10,
+		0x9b, 0xe0, 0xd0, 0x47, 0xd4, 0xe8, 0x95, 0x48, 0x3c, 0xaa, 0xc6, 0xae, 0x44, 0xe2, 0x31, 0x75,
+		0xfa, 0x4a, 0x24, 0x1e, 0x57, 0x13, 0x57, 0x22, 0xf1, 0x84, 0x0a, 0x85, 0xdb, 0x69, 0x48, 0xf9,
+		0xb7, 0x1b, 0xb8, 0x7b, 0xab, 0xb3, 0x05, 0x57, 0x61, 0x2a, 0xf9, 0x9e, 0x7d, 0x37, 0x27, 0x0b,
+		0x65, 0x5c, 0x89, 0x17, 0x63, 0xdc, 0xb6, 0xd7, 0x38, 0x26, 0x5a, 0x41, 0x38, 0xc9, 0x28, 0xb7,
+		0xa5, 0xe2, 0x9a, 0x48, 0x91, 0x4b, 0x10, 0x7b, 0xd1, 0x66, 0xb4, 0x63, 0x8c, 0xf6, 0x3b, 0xf6,
+		0xa7, 0x7d, 0xa5, 0xc6, 0x88, 0x27, 0xae, 0xd4, 0xf4, 0xb5, 0xaa, 0xb6, 0x5a, 0x5c, 0xd1, 0x04,
+		0x3a, 0x39, 0x0a, 0x91, 0x96, 0xf1, 0xf2, 0x5e, 0x70, 0xcd, 0x66, 0x59, 0x64, 0x01, 0xb2, 0x3d,
+		0x93, 0xef, 0xd5, 0x71, 0x8c, 0x11, 0x2a, 0xeb, 0x87, 0xca, 0x78, 0xa5, 0x2b, 0x08, 0x3f, 0xa1,
+		0x5c, 0x1d, 0x85, 0x08, 0x3a, 0xa5, 0x83, 0x2b, 0x2b, 0xcb, 0x22, 0xa7, 0x20, 0xd5, 0xa0, 0x5b,
+		0xbd, 0x1d, 0xbd, 0x4b, 0x1b, 0x46, 0xdd, 0x09, 0xae, 0x27, 0x49, 0x56, 0xa4, 0xb1, 0x12, 0xf2,
+		0x34, 0x24, 0x70, 0x8c, 0x4c, 0x36, 0xc6, 0x33, 0x8c, 0x05, 0x0f, 0xed, 0xcf, 0x02, 0x31, 0xc4,
+		0x12, 0x49, 0xf3, 0xf0, 0xc9, 0x65, 0x98, 0x76, 0x8c, 0xee, 0x0e, 0x75, 0xec, 0xdc, 0xec, 0x7c,
+		0xf8, 0x54, 0xe6, 0xec, 0xc2, 0x24, 0xa4, 0x36, 0x18, 0x0a, 0xdb, 0x29, 0x4b, 0x74, 0xf2, 0x2c,
+		0xa8, 0xc2, 0x15, 0xab, 0x8b, 0x6d, 0xae, 0x9d, 0x9b, 0x63, 0x02, 0xf8, 0xce, 0xfd, 0x49, 0x0a,
+		0x4f, 0xee, 0x12, 0x47, 0xd2, 0xb2, 0x34, 0x90, 0x0e, 0xce, 0x8b, 0x43, 0x07, 0x99, 0x17, 0x9b,
+		0x90, 0x15, 0xbf, 0x75, 0xbb, 0xd7, 0xe9, 0x58, 0x5d, 0x27, 0x77, 0x78, 0x5e, 0x19, 0xdf, 0x20,
+		0x49, 0x8c, 0xe3, 0x68, 0x99, 0xed, 0x40, 0xfa, 0xeb, 0x37, 0xdd, 0xf2, 0x2f, 0x40, 0x26, 0xc8,
+		0x0c, 0xbf, 0x23, 0x3c, 0x3c, 0xa1, 0x23, 0x1c, 0xb7, 0x25, 0x72, 0xa3, 0x86, 0x4b, 0x13, 0x4f,
+		0xe4, 0x7f, 0x30, 0x04, 0x99, 0x60, 0xc7, 0xc8, 0x25, 0x20, 0x72, 0xc4, 0x9a, 0xa6, 0xd3, 0xb5,
+		0x1a, 0xbd, 0x3a, 0x6d, 0xe4, 0x94, 0x31, 0xf5, 0xcc, 0x08, 0x9c, 0x65, 0x17, 0xc5, 0x4f, 0xc8,
+		0x37, 0x0b, 0x42, 0x13, 0x12, 0x5a, 0xf2, 0xe6, 0xc7, 0x19, 0x98, 0x95, 0x04, 0x90, 0xd8, 0x0d,
+		0xa3, 0x6b, 0xa2, 0x89, 0xcc, 0x8d, 0x76, 0xe2, 0x2b, 0x7a, 0x96, 0x97, 0x90, 0x22, 0x48, 0x71,
+		0xd1, 0xbb, 0xb4, 0x6d, 0xa1, 0xbf, 0x2b, 0x32, 0xa6, 0xda, 0x8c, 0x40, 0xd0, 0x38, 0x7c, 0xe1,
+		0x0c, 0x44, 0x99, 0xfa, 0x21, 0x00, 0x42, 0x01, 0xa9, 0x53, 0x24, 0x0e, 0x91, 0x72, 0x55, 0xc3,
+		0xe5, 0x51, 0x85, 0x14, 0xcf, 0xd5, 0xd7, 0x97, 0x2b, 0xe5, 0x8a, 0x1a, 0x2a, 0x9c, 0x83, 0x18,
+		0xd7, 0x29, 0xb8, 0x74, 0xba, 0x5a, 0x45, 0x9d, 0x12, 0x49, 0x41, 0x43, 0x91, 0xa5, 0x9b, 0xab,
+		0xa5, 0x8a, 0xa6, 0x86, 0x0a, 0x9b, 0x90, 0xed, 0x9b, 0x87, 0xe4, 0x10, 0xcc, 0x68, 0x95, 0x8d,
+		0xca, 0x1a, 0x3a, 0x07, 0xf4, 0xcd, 0xb5, 0xa7, 0xd7, 0xaa, 0xcf, 0xa2, 0x67, 0x2d, 0x90, 0x2d,
+		0xd7, 0x61, 0x85, 0xcc, 0x81, 0xea, 0x65, 0xd7, 0xaa, 0x9b, 0x1a, 0x6b, 0xcd, 0x77, 0x87, 0x40,
+		0xed, 0x9f, 0x94, 0xe4, 0x08, 0xcc, 0x6e, 0x14, 0xb5, 0x4b, 0x95, 0x0d, 0x9d, 0x3b, 0x3c, 0x5c,
+		0xd2, 0x73, 0xa0, 0xfa, 0x0b, 0x2e, 0x2e, 0x33, 0x7f, 0xce, 0x49, 0x38, 0xe6, 0xcf, 0xad, 0x3c,
+		0xb7, 0x51, 0x59, 0xab, 0xb1, 0xca, 0x8b, 0x6b, 0x97, 0xd0, 0x28, 0xe8, 0xa3, 0x27, 0x5d, 0x2c,
+		0x61, 0x6c, 0x6a, 0x90, 0x5e, 0x65, 0x65, 0x49, 0x8d, 0xf4, 0x67, 0x57, 0xd7, 0x2a, 0xd5, 0x8b,
+		0x6a, 0xb4, 0xbf, 0x76, 0xe6, 0x76, 0x89, 0x91, 0x3c, 0x1c, 0xee, 0xcf, 0xd5, 0x2b, 0x6b, 0x1b,
+		0xda, 0xf3, 0xea, 0x74, 0x7f, 0xc5, 0xb5, 0x8a, 0x76, 0x75, 0xb9, 0x5c, 0x51, 0xe3, 0xe4, 0x30,
+		0x90, 0x60, 0x8b, 0x36, 0x2e, 0x57, 0x97, 0xd4, 0xc4, 0xb0, 0x15, 0x8b, 0xa8, 0xb3, 0x85, 0x9f,
+		0x55, 0x20, 0xe5, 0x77, 0x81, 0x04, 0x94, 0x8a, 0xf2, 0x76, 0x5b, 0x6c, 0x0b, 0xbf, 0x1b, 0x82,
+		0xa4, 0xcf, 0x17, 0x82, 0x9b, 0x58, 0xa3, 0xd5, 0xb2, 0x6e, 0xe8, 0x46, 0xab, 0x69, 0xd8, 0x62,
+		0x3d, 0x04, 0x96, 0x55, 0xc4, 0x9c, 0x49, 0xd7, 0x9f, 0xc9, 0x4d, 0x97, 0xd8, 0x1d, 0x9b, 0x2e,
+		0xd3, 0x6f, 0x43, 0xd3, 0x25, 0xaa, 0xc6, 0x0a, 0xbf, 0x1f, 0x02, 0xb5, 0xdf, 0x3b, 0xd2, 0xc7,
+		0x37, 0x65, 0x14, 0xdf, 0xfc, 0xfd, 0x0b, 0x1d, 0xa4, 0x7f, 0xfd, 0xab, 0x7a, 0x78, 0xe4, 0xaa,
+		0x3e, 0x64, 0xb1, 0x8a, 0xbc, 0x9d, 0x17, 0x2b, 0xbf, 0xb8, 0xfe, 0x7b, 0x05, 0x32, 0x41, 0x67,
+		0x4e, 0x80, 0x63, 0x85, 0x83, 0x70, 0x2c, 0x38, 0x22, 0x77, 0x8f, 0x1a, 0x91, 0x6f, 0x48, 0xbf,
+		0x7e, 0x38, 0x0c, 0xe9, 0x80, 0xef, 0x67, 0xd2, 0xd6, 0xbd, 0x04, 0x33, 0xcd, 0x06, 0x6d, 0x77,
+		0x2c, 0x07, 0x23, 0x0f, 0xf4, 0x16, 0xbd, 0x4e, 0x5b, 0x8c, 0x0d, 0x99, 0x21, 0xa7, 0xab, 0x81,
+		0x1a, 0x16, 0x96, 0x3d, 0xbc, 0x15, 0x44, 0x5b, 0x9c, 0x5d, 0x5e, 0xaa, 0xac, 0xae, 0x57, 0x37,
+		0x2a, 0x6b, 0xe5, 0xe7, 0xa5, 0x26, 0xd7, 0xd4, 0x66, 0x1f, 0x58, 0x80, 0xe1, 0xf7, 0xbc, 0x3d,
+		0x36, 0x9d, 0xeb, 0xa0, 0xf6, 0xf7, 0x06, 0x15, 0xfa, 0x90, 0xfe, 0xa8, 0x53, 0x64, 0x16, 0xb2,
+		0x6b, 0x55, 0xbd, 0xb6, 0xbc, 0x54, 0xd1, 0x2b, 0x17, 0x2f, 0x56, 0xca, 0x1b, 0x35, 0x7e, 0xd0,
+		0xe0, 0x42, 0x6f, 0xa8, 0x21, 0xff, 0xd8, 0xfc, 0x48, 0x18, 0x66, 0x87, 0xb4, 0x84, 0x14, 0x85,
+		0x8b, 0x90, 0x7b, 0x2d, 0x1f, 0x9a, 0xa4, 0xf5, 0x0b, 0xb8, 0xbb, 0x5f, 0x37, 0xba, 0x8e, 0xf0,
+		0x28, 0x3e, 0x00, 0xc8, 0x5e, 0xd3, 0x41, 0xf3, 0xbe, 0x2b, 0x0e, 0x70, 0xb8, 0x09, 0x92, 0xf5,
+		0xf2, 0xf9, 0x19, 0xce, 0x3b, 0x81, 0x74, 0x2c, 0xbb, 0xe9, 0x34, 0xaf, 0x63, 0x20, 0x84, 0x3c,
+		0xed, 0xc1, 0x89, 0x1b, 0xd1, 0x54, 0x59, 0xb2, 0x6c, 0x3a, 0x2e, 0xb4, 0x49, 0x77, 0x8c, 0x3e,
+		0x68, 0xdc, 0x7e, 0x84, 0x35, 0x55, 0x96, 0xb8, 0xd0, 0x77, 0x43, 0xaa, 0x61, 0xf5, 0xd0, 0x2b,
+		0xc3, 0xe1, 0x50, 0x25, 0x2b, 0x5a, 0x92, 0xe7, 0xb9, 0x20, 0xc2, 0x6d, 0xe6, 0x1d, 0x33, 0xa5,
+		0xb4, 0x24, 0xcf, 0xe3, 0x20, 0xf7, 0x43, 0xd6, 0xd8, 0xd9, 0xe9, 0x22, 0x71, 0x49, 0x88, 0x3b,
+		0x02, 0x33, 0x6e, 0x36, 0x03, 0xcc, 0x5f, 0x81, 0xb8, 0xe4, 0x03, 0xee, 0x7f, 0x91, 0x13, 0x7a,
+		0x87, 0x7b, 0xb7, 0x43, 0x78, 0xf2, 0x64, 0xca, 0xc2, 0xbb, 0x21, 0xd5, 0xb4, 0x75, 0x2f, 0x0c,
+		0x22, 0x34, 0x1f, 0x3a, 0x15, 0xd7, 0x92, 0x4d, 0xdb, 0x3d, 0x15, 0x2d, 0x7c, 0x0a, 0x00, 0x3c,
+		0x61, 0x23, 0xdf, 0xab, 0x40, 0x86, 0x2f, 0x30, 0x9d, 0x2e, 0xb5, 0xa9, 0x59, 0x97, 0xdb, 0xc2,
+		0x07, 0xf6, 0x11, 0x51, 0xae, 0xe6, 0xd6, 0x05, 0x42, 0xe9, 0xa9, 0x0f, 0x29, 0xca, 0x2b, 0x4a,
+		0xe4, 0x15, 0x45, 0xf9, 0x71, 0x25, 0x4d, 0xe2, 0x95, 0xe7, 0xd6, 0x57, 0x96, 0xcb, 0xcb, 0x1b,
+		0xb9, 0x2f, 0x4d, 0xb3, 0xf4, 0xf2, 0xaa, 0x48, 0xbf, 0x36, 0x1d, 0x2c, 0x7f, 0x7d, 0xfa, 0x33,
+		0x4a, 0x38, 0xfe, 0xfa, 0xb4, 0x96, 0xde, 0xf6, 0xd3, 0x23, 0x2d, 0x7f, 0x04, 0x45, 0x68, 0xd4,
+		0x46, 0xd2, 0x6b, 0x4d, 0x45, 0xc4, 0x4d, 0x94, 0x1e, 0x60, 0x0d, 0x89, 0xb1, 0x86, 0x24, 0x49,
+		0xac, 0xbc, 0x52, 0xad, 0x55, 0x96, 0x58, 0x33, 0x12, 0x24, 0x52, 0x5d, 0xaf, 0xac, 0xe5, 0x5e,
+		0x93, 0x55, 0x7a, 0xc1, 0x16, 0xaf, 0x28, 0x70, 0x44, 0x9e, 0xb2, 0x8a, 0xb5, 0x96, 0x9a, 0x75,
+		0xab, 0x21, 0xad, 0xdb, 0xcc, 0xd9, 0x47, 0xf6, 0xab, 0x5c, 0x13, 0xa8, 0x8c, 0x25, 0x15, 0x81,
+		0x58, 0x7a, 0x68, 0x80, 0x25, 0xc5, 0xb5, 0x25, 0xd1, 0x96, 0x24, 0x89, 0xad, 0x17, 0xcb, 0x4f,
+		0x57, 0x96, 0xbc, 0xd6, 0x1c, 0xea, 0x0e, 0xa3, 0x42, 0xbe, 0x0d, 0xb2, 0xe8, 0x6d, 0x45, 0xd9,
+		0x68, 0x36, 0xf8, 0xb1, 0x77, 0x64, 0xd4, 0x79, 0xa9, 0xd7, 0x22, 0x74, 0xbf, 0x5e, 0x75, 0x31,
+		0x4a, 0x0f, 0xf8, 0x9a, 0x92, 0x20, 0x91, 0xb5, 0xea, 0x5a, 0x45, 0x36, 0x83, 0x1d, 0x11, 0x3f,
+		0xef, 0x35, 0x23, 0xd3, 0x0b, 0xa0, 0x92, 0x6f, 0x03, 0x55, 0xba, 0x87, 0x5c, 0x96, 0x44, 0x47,
+		0x1d, 0xf9, 0x7a, 0x0d, 0x10, 0x4e, 0x26, 0x97, 0x19, 0xf7, 0xf9, 0x5a, 0x30, 0x47, 0xb2, 0x2b,
+		0x95, 0xb5, 0x4b, 0x1b, 0x97, 0xf5, 0x75, 0xad, 0xc2, 0x4e, 0xee, 0x72, 0x5f, 0x92, 0xd5, 0x67,
+		0xdb, 0x41, 0x44, 0xf2, 0x1d, 0x0a, 0x24, 0xb9, 0x09, 0xc4, 0x7d, 0x52, 0xdc, 0xa9, 0x70, 0xdf,
+		0x7e, 0x75, 0x33, 0x0b, 0x88, 0x41, 0x97, 0x2e, 0xb0, 0x6a, 0xc3, 0x52, 0x20, 0x8e, 0x10, 0xb2,
+		0x52, 0xb9, 0x54, 0x2c, 0x3f, 0xaf, 0x97, 0x2a, 0xb5, 0x0d, 0xd4, 0x64, 0x55, 0x8d, 0xcb, 0x28,
+		0x90, 0x68, 0x71, 0x65, 0xa5, 0xfa, 0xac, 0xc7, 0x08, 0x78, 0xd1, 0x25, 0x53, 0xf8, 0x16, 0x48,
+		0x07, 0xc4, 0x1d, 0x8d, 0x62, 0x66, 0x4c, 0x63, 0x0f, 0x6a, 0x95, 0xb5, 0xb2, 0xdf, 0x88, 0x4f,
+		0x81, 0x2b, 0xde, 0xaa, 0x82, 0x29, 0x29, 0xfc, 0x6a, 0x08, 0xd5, 0xa8, 0x68, 0x80, 0x7b, 0x96,
+		0x18, 0x2e, 0x3c, 0x0e, 0x71, 0x29, 0xbe, 0x68, 0x9a, 0x33, 0x0b, 0xbb, 0x6f, 0x63, 0x10, 0x07,
+		0x26, 0xbb, 0xaa, 0x82, 0xdb, 0x20, 0x2e, 0xd3, 0x6a, 0xa8, 0x70, 0x15, 0x0e, 0x0d, 0x15, 0x3d,
+		0x72, 0x0f, 0x9c, 0x94, 0xe7, 0x97, 0xdc, 0xe8, 0xd7, 0x2b, 0x6b, 0xe5, 0xea, 0x12, 0x6e, 0x93,
+		0x3c, 0x9a, 0x00, 0x42, 0x06, 0x79, 0x2b, 0xa5, 0x7c, 0xaa, 0xa1, 0xc2, 0x32, 0x64, 0x82, 0x02,
+		0x44, 0x8e, 0xc1, 0x91, 0xcd, 0x8d, 0x8b, 0x4f, 0xe8, 0x57, 0x8b, 0x2b, 0xcb, 0x4b, 0xc5, 0xbe,
+		0x0d, 0x11, 0x80, 0x90, 0x22, 0x35, 0x84, 0x0d, 0x45, 0xe9, 0x52, 0xc3, 0x85, 0x48, 0x5c, 0x51,
+		0x95, 0x42, 0x0d, 0xb2, 0x7d, 0xa2, 0x40, 0xee, 0x82, 0x9c, 0xd8, 0xa1, 0x0c, 0x6b, 0xd5, 0x2c,
+		0xf4, 0x0b, 0x07, 0xdf, 0xab, 0x2d, 0x55, 0x56, 0x96, 0x57, 0x97, 0x37, 0x58, 0xfb, 0x2e, 0x03,
+		0x78, 0x63, 0x8c, 0x6b, 0xd6, 0x95, 0x5a, 0x75, 0x4d, 0xbf, 0x88, 0x1b, 0xbd, 0x0d, 0x1f, 0xa9,
+		0x04, 0xf0, 0x31, 0x55, 0x15, 0xdc, 0x8f, 0x0c, 0x0e, 0xbc, 0x1a, 0x3a, 0x1d, 0xc3, 0x15, 0xeb,
+		0xc3, 0x6b, 0xa7, 0x63, 0xf1, 0x0f, 0xaf, 0xa9, 0x1f, 0xc5, 0xff, 0x1f, 0x5d, 0x53, 0xbf, 0x77,
+		0xed, 0x4a, 0x2c, 0xfe, 0xda, 0xb4, 0xfa, 0xfa, 0x74, 0xe1, 0xcf, 0xc2, 0x40, 0x3c, 0xc9, 0x72,
+		0x7d, 0x1e, 0xcf, 0x41, 0xdc, 0x75, 0xa2, 0xf0, 0x28, 0xcd, 0x77, 0xed, 0x23, 0x90, 0x12, 0xcd,
+		0x97, 0xd5, 0xe7, 0x54, 0x71, 0xa9, 0xe1, 0x8e, 0xb9, 0xdd, 0x34, 0x9b, 0xed, 0x5e, 0x5b, 0x97,
+		0x9e, 0x85, 0xb1, 0x3b, 0x66, 0x81, 0x20, 0xd2, 0x8c, 0x84, 0x71, 0x33, 0x40, 0x22, 0x3a, 0x96,
+		0x04, 0x47, 0x10, 0xe9, 0xfc, 0xdf, 0x28, 0x90, 0x1b, 0xd5, 0xd8, 0x3b, 0x72, 0x7a, 0xac, 0xc1,
+		0x9c, 0x75, 0x9d, 0x76, 0xbb, 0xcd, 0x06, 0x3b, 0xc7, 0x70, 0x4d, 0xa1, 0xc8, 0x78, 0x53, 0x68,
+		0xd6, 0x87, 0x28, 0xb2, 0x6d, 0x52, 0xc2, 0x15, 0xeb, 0x26, 0x2a, 0x6b, 0x49, 0x29, 0x3a, 0x9e,
+		0x52, 0x9a, 0xa1, 0x48, 0x1a, 0x57, 0x50, 0x40, 0x71, 0xf7, 0x11, 0x52, 0xc3, 0x9e, 0xbd, 0x55,
+		0xf8, 0x54, 0x08, 0x32, 0xc1, 0xb0, 0x48, 0xb2, 0x04, 0xf1, 0x96, 0x25, 0x42, 0x8e, 0xf8, 0x68,
+		0x9f, 0x1a, 0x13, 0x49, 0xb9, 0xb0, 0x22, 0xe0, 0x35, 0x17, 0x33, 0xff, 0xef, 0x14, 0x88, 0xcb,
+		0x6c, 0x72, 0x18, 0x22, 0x1d, 0xc3, 0xd9, 0x65, 0xe4, 0xa2, 0xa5, 0x90, 0xaa, 0x68, 0x2c, 0x8d,
+		0xf9, 0x76, 0xc7, 0xe0, 0xe1, 0x56, 0x22, 0x1f, 0xd3, 0x68, 0xf3, 0xb4, 0xa8, 0xd1, 0x60, 0x27,
+		0x70, 0x56, 0xbb, 0x4d, 0x4d, 0xc7, 0x96, 0x36, 0x8f, 0xc8, 0x2f, 0x8b, 0x6c, 0x8c, 0xce, 0x75,
+		0xba, 0x46, 0xb3, 0x15, 0x80, 0x8d, 0x30, 0x58, 0x55, 0x16, 0xb8, 0xc0, 0x8b, 0x70, 0x54, 0xd2,
+		0x6d, 0x50, 0xc7, 0xa8, 0xef, 0xd2, 0x86, 0x87, 0x14, 0x63, 0x27, 0xed, 0x47, 0x04, 0xc0, 0x92,
+		0x28, 0x97, 0xb8, 0x85, 0xcf, 0x87, 0x60, 0x46, 0x9e, 0x19, 0x36, 0x5c, 0x66, 0xad, 0x02, 0x18,
+		0xa6, 0x69, 0x39, 0x7e, 0x76, 0x0d, 0x9a, 0x79, 0x03, 0x78, 0x0b, 0x45, 0x17, 0x49, 0xf3, 0x11,
+		0xc8, 0xff, 0x89, 0x02, 0xe0, 0x15, 0x8d, 0xe4, 0xdb, 0x49, 0x48, 0x8a, 0xa0, 0x57, 0x16, 0x39,
+		0xcd, 0x5d, 0x6b, 0xc0, 0xb3, 0xf0, 0x74, 0x11, 0xbd, 0x6e, 0x5b, 0x74, 0xa7, 0x69, 0x8a, 0x28,
+		0x26, 0x9e, 0x90, 0xc1, 0x00, 0x11, 0x2f, 0xca, 0x4f, 0x83, 0xb8, 0x4d, 0xdb, 0x86, 0xe9, 0x34,
+		0xeb, 0x62, 0xd6, 0x9c, 0x3f, 0x50, 0xe3, 0x17, 0x6a, 0x02, 0x5b, 0x73, 0xe9, 0x14, 0x4e, 0x41,
+		0x5c, 0xe6, 0xba, 0xfa, 0x71, 0x8a, 0x4c, 0x43, 0xb8, 0x56, 0xc1, 0x15, 0x82, 0xa9, 0xa9, 0xe5,
+		0x62, 0x4d, 0x0d, 0x9d, 0xfe, 0x54, 0x08, 0xa6, 0xe5, 0x34, 0x9e, 0x85, 0x6c, 0x65, 0x69, 0xb9,
+		0x4f, 0xd5, 0xce, 0x42, 0x46, 0x66, 0x72, 0x7d, 0xa6, 0x7e, 0x70, 0xda, 0x9f, 0xb9, 0xae, 0x55,
+		0x37, 0xaa, 0x67, 0xd5, 0x2f, 0x0d, 0x66, 0x3e, 0xaa, 0xbe, 0x36, 0x4d, 0x66, 0x20, 0x25, 0x33,
+		0xcf, 0x3e, 0x7c, 0xf6, 0x51, 0xf5, 0xf5, 0xfe, 0xac, 0xc7, 0xd4, 0x3f, 0x66, 0x5e, 0x1d, 0x99,
+		0xf5, 0x88, 0xbe, 0x81, 0xfa, 0xb2, 0xba, 0xb6, 0xf2, 0xbc, 0xaa, 0xf8, 0x0b, 0xce, 0xfa, 0x0a,
+		0x42, 0xe4, 0x38, 0x1c, 0x91, 0x05, 0x17, 0x2e, 0x5c, 0xb8, 0xf0, 0xb8, 0xaf, 0xf0, 0xf6, 0x47,
+		0x62, 0xfd, 0xc5, 0x4f, 0xf8, 0x8a, 0x3f, 0x3e, 0x58, 0x7c, 0xc1, 0x57, 0xfc, 0x63, 0x1f, 0x89,
+		0x91, 0x59, 0x48, 0xca, 0xe2, 0xd5, 0xe2, 0x73, 0xea, 0xd7, 0xbe, 0xf6, 0xb5, 0xaf, 0x4d, 0x97,
+		0xbe, 0x0d, 0x66, 0xeb, 0x56, 0xbb, 0x7f, 0x68, 0x4a, 0x6a, 0x5f, 0x48, 0x82, 0x7d, 0x59, 0x79,
+		0xe1, 0x21, 0x01, 0xb4, 0x63, 0xb5, 0x0c, 0x73, 0x67, 0xc1, 0xea, 0xee, 0x78, 0x11, 0xfa, 0x68,
+		0x5e, 0xda, 0xbe, 0x38, 0xfd, 0xce, 0xd6, 0xdf, 0x28, 0xca, 0x8f, 0x87, 0xc2, 0x97, 0xd6, 0x4b,
+		0x3f, 0x13, 0xca, 0x5f, 0xe2, 0x88, 0xeb, 0x72, 0xe0, 0x35, 0xba, 0xdd, 0xa2, 0x75, 0x1c, 0x1d,
+		0xf8, 0xd3, 0x07, 0x61, 0x6e, 0xc7, 0xda, 0xb1, 0x18, 0xa5, 0x33, 0xf8, 0x8b, 0x37, 0x82, 0x24,
+		0xdc, 0xdc, 0xfc, 0xd8, 0xfb, 0x00, 0x8b, 0x6b, 0x30, 0x2b, 0x80, 0x75, 0x66, 0xed, 0xf2, 0x53,
+		0x53, 0xb2, 0x6f, 0xe4, 0x4d, 0xee, 0x17, 0xfe, 0x88, 0xb9, 0x29, 0xb4, 0x19, 0x81, 0x8a, 0x65,
+		0xfc, 0x60, 0x75, 0x51, 0x83, 0x43, 0x01, 0x7a, 0x7c, 0xa7, 0x41, 0xbb, 0x63, 0x28, 0xfe, 0x6b,
+		0x41, 0x71, 0xd6, 0x47, 0xb1, 0x26, 0x50, 0x17, 0xcb, 0x90, 0x3e, 0x08, 0xad, 0x7f, 0x23, 0x68,
+		0xa5, 0xa8, 0x9f, 0xc8, 0x25, 0xc8, 0x32, 0x22, 0xf5, 0x9e, 0xed, 0x58, 0x6d, 0xb6, 0x8d, 0xdb,
+		0x9f, 0xcc, 0xbf, 0xfd, 0x23, 0xae, 0xde, 0x32, 0x88, 0x56, 0x76, 0xb1, 0x16, 0x17, 0x81, 0x59,
+		0xed, 0x18, 0x4e, 0x3a, 0x86, 0xc2, 0x6f, 0x88, 0x86, 0xb8, 0xf0, 0x8b, 0x57, 0x61, 0x0e, 0x7f,
+		0xb3, 0x5d, 0x96, 0xbf, 0x25, 0xe3, 0xc3, 0x74, 0x72, 0xbf, 0xfb, 0x01, 0xae, 0x41, 0x67, 0x5d,
+		0x02, 0xbe, 0x36, 0xf9, 0x46, 0x71, 0x87, 0x3a, 0x0e, 0xed, 0xda, 0xba, 0xd1, 0x1a, 0xd6, 0x3c,
+		0x5f, 0x9c, 0x43, 0xee, 0x87, 0xbf, 0x12, 0x1c, 0xc5, 0x4b, 0x1c, 0xb3, 0xd8, 0x6a, 0x2d, 0x6e,
+		0xc2, 0x91, 0x21, 0x52, 0x31, 0x01, 0xcd, 0x1f, 0x11, 0x34, 0xe7, 0x06, 0x24, 0x03, 0xc9, 0xae,
+		0x83, 0xcc, 0x77, 0xc7, 0x72, 0x02, 0x9a, 0x1f, 0x13, 0x34, 0x89, 0xc0, 0x95, 0x43, 0x8a, 0x14,
+		0xaf, 0xc0, 0xcc, 0x75, 0xda, 0xdd, 0xb2, 0x6c, 0x11, 0x5b, 0x32, 0x01, 0xb9, 0x1f, 0x15, 0xe4,
+		0xb2, 0x02, 0x91, 0x05, 0x9b, 0x20, 0xad, 0x0b, 0x10, 0xdf, 0x36, 0xea, 0x74, 0x02, 0x12, 0xb7,
+		0x05, 0x89, 0x69, 0x84, 0x47, 0xd4, 0x22, 0xa4, 0x76, 0x2c, 0xb1, 0xd1, 0x1e, 0x8f, 0xfe, 0x71,
+		0x81, 0x9e, 0x94, 0x38, 0x82, 0x44, 0xc7, 0xea, 0xf4, 0x5a, 0xb8, 0x0b, 0x1f, 0x4f, 0xe2, 0xc7,
+		0x24, 0x09, 0x89, 0x23, 0x48, 0x1c, 0x80, 0xad, 0x9f, 0x90, 0x24, 0x6c, 0x1f, 0x3f, 0x9f, 0xc2,
+		0x90, 0xd3, 0xd6, 0x9e, 0x65, 0x4e, 0xd2, 0x88, 0x4f, 0x0a, 0x0a, 0x20, 0x50, 0x90, 0xc0, 0x93,
+		0x90, 0x98, 0x74, 0x20, 0x7e, 0xf2, 0x2b, 0x72, 0x7a, 0xc8, 0x11, 0xb8, 0x04, 0x59, 0xa9, 0xa0,
+		0xf0, 0xc0, 0x66, 0x3c, 0x89, 0x9f, 0x12, 0x24, 0x32, 0x3e, 0x34, 0xd1, 0x0d, 0x87, 0xda, 0xce,
+		0x0e, 0x9d, 0x84, 0xc8, 0xa7, 0x64, 0x37, 0x04, 0x8a, 0x60, 0xe5, 0x16, 0x35, 0xeb, 0xbb, 0x93,
+		0x51, 0xf8, 0xb4, 0x64, 0xa5, 0xc4, 0x41, 0x12, 0x65, 0x48, 0xb7, 0x8d, 0xae, 0xbd, 0x6b, 0xb4,
+		0x26, 0x1a, 0x8e, 0x9f, 0x16, 0x34, 0x52, 0x2e, 0x92, 0xe0, 0x48, 0xcf, 0x3c, 0x08, 0x99, 0x9f,
+		0x91, 0x1c, 0xe9, 0x99, 0x01, 0x42, 0xeb, 0x30, 0x67, 0x3b, 0xcc, 0xf2, 0x3d, 0x08, 0xb5, 0x7f,
+		0x28, 0xa7, 0x1e, 0xc7, 0x5d, 0xf5, 0x53, 0x7c, 0x12, 0x12, 0x76, 0xf3, 0xe5, 0x89, 0xc8, 0xfc,
+		0xac, 0x1c, 0x69, 0x86, 0x80, 0xc8, 0xcf, 0xc3, 0xd1, 0xa1, 0xcb, 0xc4, 0x04, 0xc4, 0x7e, 0x4e,
+		0x10, 0x3b, 0x3c, 0x64, 0xa9, 0x10, 0x2a, 0xe1, 0xa0, 0x24, 0xff, 0x91, 0x54, 0x09, 0xb4, 0x8f,
+		0xd6, 0x3a, 0xba, 0x3e, 0x6d, 0x63, 0xfb, 0x60, 0x5c, 0xfb, 0xc7, 0x92, 0x6b, 0x1c, 0x37, 0xc0,
+		0xb5, 0x0d, 0x38, 0x2c, 0x28, 0x1e, 0x6c, 0x5c, 0x7f, 0x5e, 0x2a, 0x56, 0x8e, 0xbd, 0x19, 0x1c,
+		0xdd, 0x6f, 0x86, 0xbc, 0xcb, 0x4e, 0xe9, 0x63, 0xb3, 0x75, 0x8c, 0x50, 0x19, 0x4f, 0xf9, 0x17,
+		0x04, 0x65, 0xa9, 0xf1, 0x5d, 0x27, 0x9d, 0xbd, 0x6a, 0x74, 0x90, 0xf8, 0x73, 0x90, 0x93, 0xc4,
+		0x7b, 0x66, 0x97, 0xd6, 0xad, 0x1d, 0xb3, 0xf9, 0x32, 0x6d, 0x4c, 0x40, 0xfa, 0x33, 0x7d, 0x43,
+		0xb5, 0xe9, 0x43, 0x47, 0xca, 0xcb, 0xa0, 0xba, 0xb6, 0x8a, 0xde, 0x6c, 0xb3, 0xf3, 0x88, 0xfd,
+		0x29, 0xfe, 0xa2, 0x1c, 0x29, 0x17, 0x6f, 0x99, 0xa1, 0x2d, 0x56, 0x80, 0x47, 0xa7, 0x4f, 0x2a,
+		0x92, 0x9f, 0x15, 0x84, 0xd2, 0x1e, 0x96, 0x50, 0x1c, 0x75, 0xab, 0xdd, 0x31, 0xba, 0x93, 0xe8,
+		0xbf, 0x7f, 0x22, 0x15, 0x87, 0x40, 0x11, 0x8a, 0x03, 0x2d, 0x3a, 0x5c, 0xed, 0x27, 0xa0, 0xf0,
+		0x4b, 0x52, 0x71, 0x48, 0x1c, 0x41, 0x42, 0x1a, 0x0c, 0x13, 0x90, 0xf8, 0x65, 0x49, 0x42, 0xe2,
+		0x20, 0x89, 0x67, 0xbc, 0x85, 0xb6, 0x4b, 0x77, 0x9a, 0xb6, 0x23, 0xee, 0x8f, 0xec, 0x4f, 0xea,
+		0x73, 0x5f, 0x09, 0x1a, 0x61, 0x9a, 0x0f, 0x15, 0x35, 0x91, 0x70, 0x8c, 0x31, 0xc7, 0xef, 0xf8,
+		0x86, 0xfd, 0x8a, 0xd4, 0x44, 0x3e, 0x34, 0x6c, 0x9b, 0xcf, 0x42, 0x44, 0xb6, 0xd7, 0x71, 0x4b,
+		0x37, 0x01, 0xb9, 0x7f, 0xda, 0xd7, 0xb8, 0x9a, 0xc4, 0x45, 0x9a, 0x3e, 0xfb, 0xa7, 0x67, 0x5e,
+		0xa3, 0x7b, 0x13, 0x49, 0xe7, 0x3f, 0xeb, 0xb3, 0x7f, 0x36, 0x39, 0x26, 0xd7, 0x21, 0xd9, 0x3e,
+		0x7b, 0x8a, 0x8c, 0xbb, 0x5c, 0x96, 0xfb, 0xf6, 0x37, 0x44, 0x7f, 0x83, 0xe6, 0xd4, 0xe2, 0x0a,
+		0xa8, 0x22, 0xc7, 0x33, 0x60, 0xc7, 0x12, 0xfb, 0xc0, 0x1b, 0xae, 0x9c, 0x07, 0x6c, 0x9e, 0xc5,
+		0x8b, 0x90, 0x0e, 0x18, 0x3c, 0xe3, 0x49, 0x7d, 0x50, 0x90, 0x4a, 0xf9, 0xed, 0x9d, 0xc5, 0x73,
+		0x10, 0x41, 0xe3, 0x65, 0x3c, 0xfa, 0x77, 0x0a, 0x74, 0x06, 0xbe, 0xf8, 0x6e, 0x88, 0x4b, 0xa3,
+		0x65, 0x3c, 0xea, 0xff, 0x2f, 0x50, 0x5d, 0x14, 0x44, 0x97, 0x06, 0xcb, 0x78, 0xf4, 0xbf, 0x27,
+		0xd1, 0x25, 0x0a, 0xa2, 0x4f, 0xce, 0xc2, 0x5f, 0xfb, 0x70, 0x84, 0xa3, 0x4b, 0x94, 0x45, 0x8c,
+		0x8e, 0xe7, 0x96, 0xca, 0x78, 0xec, 0xef, 0x12, 0x95, 0x4b, 0x8c, 0xc5, 0xc7, 0x21, 0x3a, 0x21,
+		0xc3, 0x3f, 0x22, 0x50, 0x39, 0xfc, 0x62, 0x19, 0x92, 0x3e, 0xeb, 0x64, 0x3c, 0xfa, 0xf7, 0x08,
+		0x74, 0x3f, 0x16, 0x36, 0x5d, 0x58, 0x27, 0xe3, 0x09, 0xfc, 0x7d, 0xd9, 0x74, 0x81, 0x81, 0x6c,
+		0x93, 0x86, 0xc9, 0x78, 0xec, 0x8f, 0x4a, 0xae, 0x4b, 0x94, 0xc5, 0xa7, 0x20, 0xe1, 0x2e, 0x36,
+		0xe3, 0xf1, 0xbf, 0x57, 0xe0, 0x7b, 0x38, 0xc8, 0x81, 0x9e, 0x79, 0x00, 0x12, 0xdf, 0x27, 0x39,
+		0xe0, 0xc3, 0xc2, 0x69, 0xd4, 0x6f, 0xc0, 0x8c, 0xa7, 0xf4, 0xfd, 0x72, 0x1a, 0xf5, 0xd9, 0x2f,
+		0x38, 0x9a, 0x4c, 0xe7, 0x8f, 0x27, 0xf1, 0x03, 0x72, 0x34, 0x19, 0x3c, 0x36, 0xa3, 0xdf, 0x22,
+		0x18, 0x4f, 0xe3, 0x87, 0x64, 0x33, 0xfa, 0x0c, 0x82, 0xc5, 0x75, 0x20, 0x83, 0xd6, 0xc0, 0x78,
+		0x7a, 0xaf, 0x08, 0x7a, 0x33, 0x03, 0xc6, 0xc0, 0xe2, 0xb3, 0x70, 0x78, 0xb8, 0x25, 0x30, 0x9e,
+		0xea, 0x0f, 0xbf, 0xd1, 0xb7, 0x77, 0xf3, 0x1b, 0x02, 0x8b, 0x1b, 0x30, 0x37, 0xcc, 0x0a, 0x18,
+		0x4f, 0xf6, 0x47, 0xde, 0x08, 0x2a, 0x6e, 0xbf, 0x11, 0xb0, 0x58, 0x04, 0xf0, 0x16, 0xe0, 0xf1,
+		0xb4, 0x7e, 0x54, 0xd0, 0xf2, 0x21, 0xe1, 0xd4, 0x10, 0xeb, 0xef, 0x78, 0xfc, 0xdb, 0x72, 0x6a,
+		0x08, 0x0c, 0x9c, 0x1a, 0x72, 0xe9, 0x1d, 0x8f, 0xfd, 0x71, 0x39, 0x35, 0x24, 0x0a, 0x4a, 0xb6,
+		0x6f, 0x75, 0x1b, 0x4f, 0xe1, 0x93, 0x52, 0xb2, 0x7d, 0x58, 0x8b, 0x6b, 0x30, 0x33, 0xb0, 0x20,
+		0x8e, 0x27, 0xf5, 0xe3, 0x82, 0x94, 0xda, 0xbf, 0x1e, 0xfa, 0x17, 0x2f, 0xb1, 0x18, 0x8e, 0xa7,
+		0xf6, 0x13, 0x7d, 0x8b, 0x97, 0x58, 0x0b, 0x17, 0x9f, 0x84, 0xb8, 0xd9, 0x6b, 0xb5, 0x70, 0xf2,
+		0x90, 0xfd, 0xef, 0x0f, 0xe6, 0xfe, 0xf8, 0xab, 0x82, 0x3b, 0x12, 0x61, 0xf1, 0x1c, 0x44, 0x69,
+		0x7b, 0x8b, 0x36, 0xc6, 0x61, 0x7e, 0xf9, 0xab, 0x52, 0x61, 0x22, 0xf4, 0xe2, 0x53, 0x00, 0xdc,
+		0x35, 0xc2, 0x62, 0x70, 0xc7, 0xe0, 0xfe, 0xc9, 0x57, 0xc5, 0x85, 0x1d, 0x0f, 0xc5, 0x23, 0xc0,
+		0xaf, 0xff, 0xec, 0x4f, 0xe0, 0x2b, 0x41, 0x02, 0x6c, 0x44, 0x2e, 0xc0, 0x34, 0x1e, 0xbd, 0x39,
+		0xc6, 0xce, 0x38, 0xec, 0x3f, 0x15, 0xd8, 0x12, 0x1e, 0x19, 0xd6, 0xb6, 0xba, 0xd4, 0x31, 0x76,
+		0xec, 0x71, 0xb8, 0xff, 0x4d, 0xe0, 0xba, 0x08, 0x88, 0x5c, 0x37, 0x6c, 0x67, 0x92, 0x7e, 0xff,
+		0x99, 0x44, 0x96, 0x08, 0xd8, 0x68, 0xfc, 0x7d, 0x8d, 0xee, 0x8d, 0xc3, 0xfd, 0x73, 0xd9, 0x68,
+		0x01, 0xbf, 0xf8, 0x6e, 0x48, 0xe0, 0x4f, 0x7e, 0x0b, 0x6f, 0x0c, 0xf2, 0x5f, 0x08, 0x64, 0x0f,
+		0x03, 0x6b, 0xb6, 0x9d, 0x86, 0xd3, 0x1c, 0xcf, 0xec, 0xbf, 0x14, 0x23, 0x2d, 0xe1, 0x17, 0x8b,
+		0x90, 0xb4, 0x9d, 0x46, 0xa3, 0x27, 0xec, 0xd3, 0x31, 0xe8, 0x7f, 0xf5, 0x55, 0xd7, 0x65, 0xe1,
+		0xe2, 0xe0, 0x68, 0xdf, 0xb8, 0xe6, 0x74, 0x2c, 0x16, 0xb5, 0x31, 0x8e, 0xc2, 0x1b, 0x82, 0x82,
+		0x0f, 0x65, 0xb1, 0x0c, 0x29, 0xec, 0x8b, 0x3c, 0xfc, 0x1e, 0x47, 0xe2, 0xaf, 0x05, 0x03, 0x02,
+		0x48, 0xa5, 0x6f, 0xfd, 0x8d, 0x2f, 0x9c, 0x50, 0x3e, 0xff, 0x85, 0x13, 0xca, 0x7f, 0xfd, 0xc2,
+		0x09, 0xe5, 0xa3, 0x5f, 0x3c, 0x31, 0xf5, 0xf9, 0x2f, 0x9e, 0x98, 0xfa, 0xfd, 0x2f, 0x9e, 0x98,
+		0x1a, 0xee, 0x25, 0x86, 0x4b, 0xd6, 0x25, 0x8b, 0xfb, 0x87, 0x5f, 0xb8, 0x77, 0xa7, 0xe9, 0xec,
+		0xf6, 0xb6, 0x16, 0xea, 0x56, 0xfb, 0x4c, 0xdd, 0xb2, 0xdb, 0x96, 0x7d, 0x26, 0xe8, 0xd7, 0x65,
+		0xbf, 0xe0, 0x7f, 0x29, 0x70, 0x94, 0x93, 0xf1, 0xdc, 0xb9, 0x86, 0xb9, 0x37, 0xea, 0x5d, 0x97,
+		0xf3, 0x10, 0x2e, 0x9a, 0x7b, 0xe4, 0x28, 0x57, 0x70, 0x7a, 0xaf, 0xdb, 0x12, 0x57, 0xc1, 0xa6,
+		0x31, 0xbd, 0xd9, 0x6d, 0x05, 0xc3, 0x80, 0x53, 0x22, 0x0c, 0xb8, 0xf4, 0x3d, 0xca, 0xc1, 0x7a,
+		0x12, 0x2f, 0x9a, 0x7b, 0xac, 0x23, 0xeb, 0xca, 0x0b, 0xef, 0x1c, 0xeb, 0xe7, 0xbe, 0x66, 0x5a,
+		0x37, 0x4c, 0x6c, 0x76, 0x67, 0x4b, 0xfa, 0xb8, 0x4f, 0xf4, 0xfb, 0xb8, 0x9f, 0xa5, 0xad, 0xd6,
+		0xd3, 0x08, 0x87, 0xc7, 0xd1, 0xf6, 0x56, 0x8c, 0xdf, 0x3a, 0x86, 0xef, 0x0f, 0xc1, 0x89, 0x01,
+		0x77, 0xb6, 0x10, 0x82, 0x51, 0x4c, 0x58, 0x84, 0xf8, 0x92, 0x94, 0xad, 0x1c, 0xbe, 0xaa, 0x52,
+		0xb7, 0xcc, 0x06, 0x8f, 0xe7, 0x0c, 0x6b, 0x32, 0x89, 0x8c, 0x30, 0x0d, 0xd3, 0xb2, 0xc5, 0x65,
+		0x4a, 0x9e, 0x28, 0x7d, 0xec, 0x80, 0x8c, 0x48, 0xcb, 0x9a, 0x24, 0x37, 0x1e, 0x99, 0x90, 0x1b,
+		0xb2, 0x13, 0x01, 0xcf, 0xff, 0xa4, 0x5c, 0xf9, 0xa1, 0x10, 0x9c, 0xec, 0xe7, 0x0a, 0xce, 0x2c,
+		0xdb, 0x31, 0xda, 0x9d, 0x51, 0x6c, 0x79, 0x12, 0x12, 0x1b, 0x12, 0xe6, 0xc0, 0x7c, 0xb9, 0x7d,
+		0x40, 0xbe, 0x64, 0xdc, 0xaa, 0x24, 0x63, 0xce, 0x4e, 0xc8, 0x18, 0xb7, 0x1f, 0x77, 0xc4, 0x99,
+		0xff, 0x19, 0x83, 0xa3, 0x7c, 0x3a, 0xe9, 0x7c, 0x2a, 0xf1, 0x84, 0xe0, 0x49, 0xca, 0x5f, 0x34,
+		0xfe, 0x9c, 0xa4, 0xf0, 0x34, 0xcc, 0x2e, 0xa3, 0xb6, 0xc0, 0x5d, 0x90, 0x77, 0xc2, 0x33, 0xf4,
+		0xbe, 0xe9, 0x7c, 0xc0, 0xe0, 0x17, 0x27, 0x81, 0xfe, 0xac, 0xc2, 0xb7, 0x2b, 0xa0, 0xd6, 0xea,
+		0x46, 0xcb, 0xe8, 0xbe, 0x59, 0x52, 0xe4, 0x71, 0x00, 0x1e, 0x2d, 0xe4, 0xbe, 0x14, 0x83, 0xa7,
+		0xe1, 0xfe, 0xce, 0x2d, 0xf0, 0x9a, 0xd8, 0x5d, 0x8c, 0x04, 0x83, 0xc5, 0x9f, 0xa7, 0x9f, 0x03,
+		0xf0, 0x0a, 0x30, 0xba, 0xa2, 0x56, 0x2e, 0xae, 0x14, 0x35, 0x19, 0xf6, 0x51, 0x5b, 0xaf, 0x94,
+		0xf9, 0xc3, 0x0d, 0x53, 0x18, 0xba, 0xe0, 0x2f, 0x74, 0xe3, 0xd7, 0x0f, 0xc1, 0x8c, 0x3f, 0x9f,
+		0xdf, 0xa2, 0x0f, 0xa1, 0xa5, 0xd8, 0x6c, 0x77, 0x5a, 0x94, 0x9d, 0xd1, 0xea, 0x4d, 0xc9, 0xb5,
+		0xf1, 0x46, 0xc8, 0x6f, 0xfe, 0x07, 0x7e, 0xb3, 0x7a, 0xd6, 0x43, 0x77, 0x79, 0xbe, 0xb8, 0x02,
+		0x33, 0x78, 0x9f, 0xab, 0x13, 0x20, 0x39, 0x46, 0x55, 0x23, 0x41, 0x76, 0xea, 0x2c, 0x30, 0x3d,
+		0x6a, 0x8f, 0x43, 0xcc, 0x66, 0xbd, 0x1f, 0x47, 0xe2, 0xb7, 0x04, 0x09, 0x01, 0xbe, 0x68, 0xc2,
+		0x0c, 0x7f, 0x48, 0x84, 0xfa, 0x9a, 0xb1, 0xbf, 0x9f, 0xe1, 0x9f, 0xff, 0xe2, 0xc3, 0xec, 0x0c,
+		0xfa, 0xee, 0xe0, 0xb0, 0x0c, 0x11, 0x27, 0x4d, 0x15, 0xb4, 0xbd, 0x86, 0x52, 0xc8, 0xc8, 0xfa,
+		0x44, 0x83, 0xf7, 0xaf, 0xec, 0x57, 0x45, 0x65, 0x27, 0x86, 0xc9, 0x80, 0xaf, 0xa6, 0xb4, 0xa0,
+		0xca, 0x0b, 0x4a, 0x95, 0x51, 0x73, 0xfa, 0x85, 0x07, 0x07, 0x57, 0x27, 0xfe, 0xef, 0x21, 0x46,
+		0xf9, 0x49, 0x7f, 0x35, 0xee, 0xdc, 0xfb, 0x58, 0x04, 0x66, 0xf0, 0x7a, 0xb2, 0x75, 0x86, 0xfd,
+		0x15, 0x73, 0x2e, 0xca, 0x12, 0x13, 0x1c, 0x4a, 0x9e, 0xe7, 0x53, 0x61, 0xbc, 0xc4, 0xfc, 0xc5,
+		0x77, 0xff, 0x54, 0xd4, 0x9b, 0x2e, 0x8b, 0xab, 0x83, 0x91, 0x62, 0xe3, 0x69, 0xfc, 0xa5, 0xa4,
+		0xd1, 0x1f, 0xf8, 0xb5, 0xf8, 0x2e, 0x3c, 0xe9, 0x13, 0x64, 0xc6, 0x59, 0x26, 0x92, 0x88, 0x8b,
+		0x81, 0x76, 0x09, 0x9f, 0x99, 0x93, 0x58, 0xa1, 0x6f, 0x48, 0x7c, 0x3e, 0x43, 0x31, 0xce, 0x72,
+		0xf1, 0x12, 0x64, 0x1a, 0x96, 0xe9, 0xe8, 0x56, 0xbb, 0xe9, 0xd0, 0x76, 0xc7, 0x19, 0x6b, 0xd7,
+		0xfd, 0x35, 0x27, 0x12, 0xd7, 0xd2, 0x88, 0x57, 0x95, 0x68, 0xd8, 0x12, 0xfe, 0x8e, 0xc8, 0x24,
+		0x2d, 0xf9, 0xef, 0x6e, 0x4b, 0x18, 0x0e, 0xb6, 0xe4, 0x8e, 0xa4, 0xc3, 0x6e, 0x5c, 0x13, 0xca,
+		0xdc, 0xb9, 0xc9, 0xa5, 0xc0, 0x95, 0x8e, 0x4f, 0x85, 0xe1, 0x84, 0x00, 0xde, 0x32, 0x6c, 0x7a,
+		0xe6, 0xfa, 0x23, 0x5b, 0xd4, 0x31, 0x1e, 0x39, 0x53, 0xb7, 0x9a, 0x72, 0x25, 0x9f, 0xe5, 0xe5,
+		0x0b, 0x58, 0xbe, 0x20, 0xca, 0xf3, 0x43, 0x8f, 0xbb, 0xf3, 0xa3, 0x95, 0x7c, 0x7e, 0x50, 0x06,
+		0x0b, 0x2d, 0x88, 0x94, 0xad, 0x26, 0xbb, 0x03, 0xd5, 0xa0, 0xa6, 0xd5, 0x16, 0xea, 0x96, 0x27,
+		0xc8, 0x65, 0x88, 0x19, 0x6d, 0xab, 0x67, 0x3a, 0x5c, 0xd5, 0x96, 0x1e, 0xfe, 0x8d, 0x57, 0x4f,
+		0x4e, 0xfd, 0xe7, 0x57, 0x4f, 0x1e, 0xe2, 0x64, 0xed, 0xc6, 0xb5, 0x85, 0xa6, 0x75, 0xa6, 0x6d,
+		0x38, 0xbb, 0x38, 0x7d, 0x7f, 0xef, 0xb3, 0x0f, 0x81, 0xa8, 0x6f, 0xd9, 0x74, 0x3e, 0xfd, 0xda,
+		0xcf, 0x9f, 0x56, 0x34, 0x81, 0xbf, 0x18, 0x79, 0xfd, 0x13, 0x27, 0x95, 0x42, 0x07, 0xa6, 0x97,
+		0x68, 0x7d, 0x9f, 0x0a, 0x97, 0xfb, 0x2a, 0x7c, 0x44, 0x54, 0x78, 0x6c, 0xb0, 0x42, 0x7e, 0xb7,
+		0x62, 0x89, 0xd6, 0x7d, 0xd5, 0x2e, 0xd1, 0x7a, 0xb0, 0xc6, 0xd2, 0xd2, 0xef, 0xff, 0xe1, 0x89,
+		0xa9, 0xf7, 0x7f, 0xe1, 0xc4, 0xd4, 0xc8, 0x21, 0x2b, 0x8c, 0x1f, 0x32, 0x77, 0xa4, 0x7e, 0x2a,
+		0x02, 0xc7, 0xd9, 0x8b, 0x35, 0xdd, 0x76, 0xd3, 0x74, 0xce, 0xd4, 0xbb, 0x7b, 0x1d, 0xc7, 0xc2,
+		0x89, 0x6b, 0x6d, 0x8b, 0x81, 0x9a, 0xf1, 0x8a, 0x17, 0x78, 0xf1, 0xf0, 0x61, 0x2a, 0x6c, 0x43,
+		0x74, 0x1d, 0xf1, 0x90, 0x11, 0x8e, 0xe5, 0x18, 0x2d, 0x61, 0x6d, 0xf0, 0x04, 0xe6, 0xf2, 0x57,
+		0x6e, 0x42, 0x3c, 0xb7, 0x29, 0x1f, 0xb8, 0x69, 0x51, 0x63, 0x9b, 0x3f, 0x16, 0x10, 0x66, 0x66,
+		0x6a, 0x1c, 0x33, 0xd8, 0xbb, 0x00, 0x73, 0x10, 0x35, 0x7a, 0x3c, 0xb4, 0x28, 0x8c, 0xf6, 0x2b,
+		0x4b, 0x14, 0x9e, 0x86, 0x69, 0x71, 0x6e, 0x8e, 0xb1, 0x35, 0xd7, 0xe8, 0x1e, 0xab, 0x27, 0xa5,
+		0xe1, 0x4f, 0xb2, 0x00, 0x51, 0xd6, 0x78, 0x71, 0x47, 0x23, 0xb7, 0x30, 0xd0, 0xfa, 0x05, 0xd6,
+		0x48, 0x8d, 0x83, 0x15, 0xae, 0x40, 0x7c, 0xc9, 0x42, 0xe9, 0x09, 0x52, 0x4b, 0x70, 0x6a, 0xac,
+		0xcd, 0x9d, 0x9e, 0x23, 0xef, 0xd1, 0xb1, 0x04, 0xde, 0x4a, 0xe5, 0x8f, 0x47, 0x88, 0xf0, 0x28,
+		0x91, 0x2a, 0x94, 0x61, 0x9a, 0xd1, 0xae, 0x76, 0xdc, 0x17, 0x99, 0x14, 0xdf, 0x8b, 0x4c, 0x82,
+		0x7c, 0xc8, 0x6b, 0x2c, 0x81, 0x08, 0xde, 0x2c, 0x17, 0xfd, 0x66, 0xbf, 0x0b, 0xef, 0x81, 0xb8,
+		0x20, 0x62, 0x93, 0xb3, 0x10, 0xb6, 0x3a, 0x32, 0xfa, 0x2f, 0x3f, 0xaa, 0x2b, 0xd5, 0x4e, 0x29,
+		0x82, 0x42, 0xa5, 0x21, 0x70, 0x69, 0x6d, 0xa4, 0x58, 0x3c, 0x16, 0x10, 0x8b, 0x36, 0x75, 0xb6,
+		0xb6, 0x1d, 0xef, 0x07, 0x1f, 0xce, 0x01, 0x51, 0x70, 0x05, 0xe5, 0x76, 0x08, 0x4e, 0xf8, 0x4a,
+		0xaf, 0xd3, 0x2e, 0x3a, 0x8e, 0xb8, 0x34, 0x09, 0x49, 0x21, 0xbe, 0x06, 0x8a, 0xf2, 0x11, 0xa2,
+		0xf2, 0x6e, 0x08, 0x17, 0x3b, 0x1d, 0x7c, 0x3f, 0x89, 0xa5, 0xeb, 0x16, 0x97, 0x95, 0x88, 0xe6,
+		0xa6, 0xb1, 0xcc, 0xb6, 0xb6, 0x9d, 0x1b, 0x46, 0xd7, 0x7d, 0x5b, 0x49, 0xa6, 0x0b, 0x17, 0x20,
+		0x51, 0xb6, 0x4c, 0x9b, 0x9a, 0x76, 0x8f, 0xd9, 0xb0, 0x5b, 0x2d, 0xab, 0x7e, 0x4d, 0x50, 0xe0,
+		0x09, 0x64, 0xb6, 0xd1, 0xe9, 0x30, 0xcc, 0x88, 0x86, 0x3f, 0xc5, 0xec, 0xa9, 0x8e, 0x64, 0xcf,
+		0xb9, 0x83, 0xb1, 0x47, 0x74, 0xd0, 0x33, 0x46, 0x15, 0xb8, 0x6b, 0x70, 0x22, 0x5d, 0xa3, 0x7b,
+		0xf6, 0x41, 0xe7, 0xd1, 0x73, 0x90, 0x58, 0x67, 0x2f, 0x5c, 0x3e, 0x4d, 0xf7, 0x48, 0x1e, 0x03,
+		0x21, 0xcf, 0x9e, 0x3b, 0xf7, 0xc8, 0x05, 0x2e, 0xe5, 0x97, 0xa7, 0x34, 0x99, 0x41, 0x4e, 0x40,
+		0xc2, 0xa6, 0xf5, 0xce, 0xd9, 0x73, 0xe7, 0xaf, 0x3d, 0xc2, 0xc5, 0xea, 0xf2, 0x94, 0xe6, 0x65,
+		0x2d, 0xc6, 0xb1, 0xc7, 0xaf, 0x7f, 0xf2, 0xa4, 0x52, 0x8a, 0x42, 0xd8, 0xee, 0xb5, 0xbf, 0x6e,
+		0xb2, 0xf1, 0xe7, 0x31, 0x98, 0xf7, 0x95, 0xf2, 0x45, 0x41, 0x04, 0x93, 0xbb, 0xef, 0x92, 0xaa,
+		0xbe, 0xfe, 0x33, 0x88, 0x11, 0xda, 0x7e, 0x5f, 0x2e, 0x16, 0x3e, 0xa3, 0x40, 0xea, 0xaa, 0xa4,
+		0x8c, 0x17, 0x0b, 0x9e, 0x04, 0x70, 0x6b, 0x92, 0x53, 0xe5, 0xd8, 0x42, 0x7f, 0x5d, 0x0b, 0x2e,
+		0x8e, 0xe6, 0x03, 0xc7, 0x1b, 0x33, 0x9d, 0xae, 0xd5, 0xb1, 0x6c, 0xf1, 0xce, 0xce, 0x18, 0x54,
+		0x17, 0x18, 0xaf, 0x71, 0x30, 0xad, 0xa6, 0x5f, 0xb7, 0x1c, 0x0c, 0x09, 0xe9, 0x58, 0x37, 0xc4,
+		0xeb, 0x65, 0x61, 0x4d, 0x65, 0x25, 0x57, 0x59, 0xc1, 0x3a, 0xe6, 0x63, 0xa3, 0x13, 0x2e, 0x15,
+		0xdc, 0x8e, 0x19, 0x8d, 0x46, 0x97, 0xda, 0xb6, 0x50, 0x5c, 0x32, 0x89, 0x8f, 0xfb, 0x74, 0x7a,
+		0x5b, 0xba, 0xd4, 0x12, 0xf8, 0x3c, 0xd2, 0x90, 0x39, 0x2f, 0x65, 0x43, 0xcc, 0xfa, 0x58, 0xa7,
+		0xb7, 0x85, 0x92, 0x72, 0x37, 0xa4, 0x86, 0x34, 0x26, 0x79, 0xdd, 0x6b, 0x07, 0x7b, 0x54, 0x55,
+		0xf4, 0x40, 0xef, 0x74, 0x9b, 0x56, 0xb7, 0xe9, 0xec, 0xb1, 0xc0, 0xc4, 0xb0, 0xa6, 0xca, 0x82,
+		0x75, 0x91, 0x5f, 0xb8, 0x06, 0xd9, 0x1a, 0x33, 0xd3, 0xbd, 0x96, 0x9f, 0xf3, 0xda, 0xa7, 0x8c,
+		0x6f, 0xdf, 0xc8, 0x96, 0x85, 0x06, 0x5a, 0x76, 0xfa, 0x3f, 0x2a, 0x90, 0x2c, 0xe1, 0xc4, 0x5d,
+		0x5e, 0xba, 0xd8, 0x32, 0x76, 0xc8, 0x23, 0x70, 0xa8, 0xb4, 0x52, 0x2d, 0x3f, 0xad, 0x2f, 0x2f,
+		0xe9, 0x17, 0x57, 0x8a, 0xbe, 0xe0, 0xed, 0xfc, 0xe1, 0x5b, 0xb7, 0xe7, 0x89, 0x0f, 0x76, 0xd3,
+		0x64, 0x7b, 0x49, 0x72, 0x06, 0xe6, 0x82, 0x28, 0xc5, 0x52, 0x0d, 0xaf, 0x0c, 0x29, 0xf9, 0x43,
+		0xb7, 0x6e, 0xcf, 0xcf, 0xf8, 0x30, 0x8a, 0x5b, 0x36, 0x35, 0x9d, 0x41, 0x84, 0x72, 0x75, 0x75,
+		0x15, 0x23, 0xe8, 0x07, 0x10, 0x30, 0x1c, 0xb5, 0xe9, 0x90, 0x07, 0x60, 0x26, 0x88, 0xb0, 0xb6,
+		0xbc, 0xa2, 0x86, 0xf3, 0xe4, 0xd6, 0xed, 0xf9, 0x8c, 0x0f, 0x7a, 0xad, 0xd9, 0xca, 0xc7, 0x3f,
+		0xf4, 0x13, 0x27, 0xa6, 0x3e, 0xfd, 0x0f, 0x4e, 0x28, 0xa5, 0xd5, 0x91, 0x73, 0xee, 0xd1, 0x83,
+		0xcd, 0xb9, 0xe0, 0xba, 0xfd, 0xd5, 0xbb, 0xe0, 0xae, 0xfe, 0xc2, 0x80, 0x32, 0x9e, 0x74, 0xba,
+		0x8d, 0xf3, 0x2d, 0xe4, 0xf7, 0x37, 0x0f, 0xf2, 0x63, 0x16, 0x85, 0xfc, 0x58, 0xc5, 0x50, 0xb8,
+		0x00, 0x69, 0xbc, 0x54, 0x54, 0xa3, 0xce, 0x65, 0x6a, 0x34, 0x68, 0x37, 0x68, 0x3f, 0xa4, 0xa5,
+		0xfd, 0x40, 0x20, 0xc2, 0x8c, 0x04, 0xbe, 0x7e, 0xb2, 0xdf, 0x85, 0x5d, 0x88, 0x20, 0xaa, 0x67,
+		0x5b, 0x08, 0x0c, 0x96, 0xc0, 0xdc, 0xad, 0x3d, 0x47, 0xdc, 0xd7, 0x4c, 0x69, 0x3c, 0x41, 0x1e,
+		0x93, 0x16, 0x42, 0x78, 0x7f, 0x0b, 0x41, 0x4c, 0x2f, 0x61, 0x27, 0xb4, 0x60, 0x5a, 0x0c, 0xb1,
+		0xdb, 0x10, 0xc5, 0x6b, 0x08, 0x59, 0x85, 0x6c, 0xc7, 0xe8, 0x3a, 0xec, 0x75, 0x93, 0x5d, 0xd6,
+		0x0b, 0x31, 0x83, 0x4f, 0x0e, 0xea, 0x93, 0x40, 0x67, 0x45, 0x2d, 0xe9, 0x8e, 0x3f, 0xb3, 0xf0,
+		0xa5, 0x08, 0xc4, 0x04, 0x33, 0xde, 0x0d, 0xd3, 0x82, 0xad, 0x62, 0xce, 0x1d, 0x5f, 0x18, 0x5c,
+		0x66, 0x17, 0xdc, 0xe5, 0x50, 0xd0, 0x93, 0x38, 0xe4, 0x3e, 0x88, 0xd7, 0x77, 0x8d, 0xa6, 0xa9,
+		0x37, 0x1b, 0xc2, 0x00, 0x4d, 0x7e, 0xe1, 0xd5, 0x93, 0xd3, 0x65, 0xcc, 0x5b, 0x5e, 0xd2, 0xa6,
+		0x59, 0xe1, 0x72, 0x03, 0x6d, 0x9a, 0x5d, 0xda, 0xdc, 0xd9, 0x75, 0x84, 0xde, 0x10, 0x29, 0x7c,
+		0x27, 0x1a, 0x05, 0x42, 0x04, 0xd1, 0xe7, 0x07, 0x76, 0x16, 0xae, 0xeb, 0xa7, 0x14, 0xc7, 0x8a,
+		0x3f, 0xfa, 0x5f, 0x4e, 0x2a, 0x1a, 0xc3, 0x20, 0x65, 0x48, 0xb7, 0x0c, 0xdb, 0xd1, 0xd9, 0x7a,
+		0x8c, 0xd5, 0xf3, 0xe8, 0xf9, 0xa3, 0x83, 0x0c, 0x11, 0x8c, 0x15, 0x4d, 0x4f, 0x22, 0x16, 0xcf,
+		0x6a, 0xe0, 0x7b, 0x3f, 0x8c, 0x48, 0x9d, 0xcd, 0x40, 0x6e, 0x25, 0xc6, 0x18, 0xdf, 0x33, 0x98,
+		0xcf, 0x27, 0x26, 0xb3, 0x15, 0x8f, 0x41, 0x82, 0x3d, 0xe1, 0xc3, 0x40, 0xf8, 0x25, 0xb8, 0x38,
+		0x66, 0xb0, 0xc2, 0xfb, 0x21, 0xeb, 0x69, 0x7d, 0x0e, 0x12, 0xe7, 0x54, 0xbc, 0x6c, 0x06, 0xf8,
+		0x30, 0xcc, 0x99, 0xf4, 0xa6, 0xa3, 0xf7, 0x43, 0x27, 0x18, 0x34, 0xc1, 0xb2, 0xab, 0x41, 0x8c,
+		0x7b, 0x21, 0x53, 0x97, 0xcc, 0xe7, 0xb0, 0xc0, 0x60, 0xd3, 0x6e, 0x2e, 0x03, 0x3b, 0x0a, 0x71,
+		0xa3, 0xd3, 0xe1, 0x00, 0x49, 0xa1, 0xf5, 0x3b, 0x1d, 0x56, 0x74, 0x1a, 0x66, 0x58, 0x1f, 0xbb,
+		0xd4, 0xc6, 0xeb, 0x19, 0x1c, 0x26, 0xc5, 0x60, 0xb2, 0x58, 0xa0, 0xf1, 0x7c, 0x06, 0x7b, 0x0f,
+		0xa4, 0xe9, 0x75, 0xbc, 0x81, 0x58, 0xa7, 0x1c, 0x2e, 0xcd, 0xe0, 0x52, 0x32, 0x93, 0x01, 0x3d,
+		0x00, 0xae, 0x36, 0xd7, 0xe5, 0x4a, 0x93, 0xe1, 0xf4, 0x64, 0x7e, 0x91, 0x67, 0x17, 0x72, 0x10,
+		0x59, 0x32, 0x1c, 0x03, 0xcd, 0x25, 0xe7, 0x26, 0x5f, 0x3e, 0x53, 0x1a, 0xfe, 0x2c, 0xfc, 0x72,
+		0x18, 0x22, 0x57, 0x2d, 0x87, 0x92, 0x47, 0x7d, 0xa6, 0x6c, 0x66, 0x98, 0x3c, 0xd7, 0x9a, 0x3b,
+		0x26, 0x6d, 0xac, 0xda, 0x3b, 0xbe, 0x67, 0x33, 0x3d, 0x71, 0x0a, 0x05, 0xc4, 0x69, 0x0e, 0xa2,
+		0x5d, 0xab, 0x67, 0x36, 0x64, 0x88, 0x3c, 0x4b, 0x90, 0x0a, 0xc4, 0x5d, 0x29, 0x89, 0x8c, 0x93,
+		0x92, 0x2c, 0x4a, 0x09, 0xca, 0xb0, 0xc8, 0xd0, 0xa6, 0xb7, 0x84, 0xb0, 0x94, 0x20, 0xe1, 0x2a,
+		0xaf, 0x5c, 0xf4, 0x00, 0x02, 0xeb, 0xa1, 0xe1, 0x12, 0xe9, 0x8e, 0xbd, 0xcb, 0x3c, 0x2e, 0x71,
+		0xaa, 0x5b, 0x20, 0xb8, 0x17, 0x10, 0x2b, 0xf1, 0x84, 0xe7, 0x34, 0xeb, 0x97, 0x27, 0x56, 0xfc,
+		0x19, 0xcf, 0xbb, 0x30, 0x92, 0x6e, 0xc7, 0x64, 0xd7, 0x3f, 0x84, 0xe4, 0x79, 0x19, 0x58, 0xea,
+		0x5d, 0xa5, 0xe4, 0x92, 0xe6, 0x65, 0xe0, 0x83, 0x18, 0x6e, 0x42, 0xf7, 0xa8, 0x70, 0x29, 0x23,
+		0x6e, 0x51, 0x4d, 0x96, 0x14, 0x7e, 0x4d, 0x81, 0x98, 0x58, 0xb1, 0xbc, 0x61, 0x50, 0x86, 0x0f,
+		0x43, 0x68, 0xd4, 0x30, 0x84, 0xef, 0x7c, 0x18, 0x8a, 0x00, 0x6e, 0x33, 0x6d, 0xf1, 0x50, 0xe3,
+		0x10, 0xb3, 0x8a, 0x37, 0xb1, 0xd6, 0xdc, 0x11, 0xf3, 0xde, 0x87, 0x54, 0xf8, 0x03, 0x05, 0x12,
+		0x6e, 0x39, 0x29, 0x42, 0x5a, 0xb6, 0x4b, 0xdf, 0x6e, 0x19, 0x3b, 0x42, 0x14, 0x8f, 0x8f, 0x6c,
+		0x1c, 0xae, 0xc2, 0x5a, 0x52, 0xb4, 0x07, 0x13, 0xc3, 0x87, 0x35, 0x34, 0x62, 0x58, 0x03, 0x72,
+		0x14, 0xbe, 0x33, 0x39, 0x0a, 0x8c, 0x78, 0xa4, 0x6f, 0xc4, 0x0b, 0x7f, 0xa8, 0x88, 0x27, 0xa2,
+		0x1b, 0xb4, 0xf1, 0x7f, 0x72, 0xa8, 0x5e, 0x10, 0xb2, 0xd5, 0xa0, 0x0d, 0x7d, 0x60, 0xcc, 0xee,
+		0x19, 0xa4, 0x18, 0x6c, 0xb3, 0x37, 0x76, 0x44, 0x52, 0xa9, 0x79, 0x63, 0xf8, 0xd9, 0x10, 0xcc,
+		0x0c, 0xc0, 0xff, 0xed, 0x1b, 0xcb, 0xe0, 0xec, 0x8d, 0x4e, 0x38, 0x7b, 0x63, 0x23, 0x67, 0xef,
+		0x2f, 0x86, 0x98, 0x03, 0xa0, 0x63, 0xd9, 0x46, 0xeb, 0x1b, 0xa1, 0x7b, 0x8f, 0x41, 0xa2, 0x63,
+		0xb5, 0x74, 0x5e, 0xc2, 0x2f, 0x29, 0xc5, 0x3b, 0x56, 0x4b, 0x1b, 0x10, 0xb3, 0xe8, 0x5b, 0xa4,
+		0x98, 0x63, 0x6f, 0xc1, 0x20, 0x4c, 0xf7, 0x4f, 0xa8, 0x2e, 0xa4, 0x38, 0x2b, 0x84, 0xd5, 0xf4,
+		0x30, 0xf2, 0x00, 0x7f, 0xe5, 0x94, 0x41, 0x2b, 0x8f, 0x37, 0x9b, 0x43, 0x6a, 0xb1, 0x5d, 0x17,
+		0x83, 0x1b, 0x19, 0xb9, 0xd0, 0x28, 0x0c, 0x2e, 0xc5, 0x9a, 0x80, 0x2b, 0xfc, 0xa0, 0x02, 0xb0,
+		0x82, 0x9c, 0x65, 0xfd, 0x45, 0x7b, 0xc7, 0x66, 0x4d, 0xd0, 0x03, 0x35, 0x9f, 0x18, 0x35, 0x68,
+		0xa2, 0xfe, 0x94, 0xed, 0x6f, 0x77, 0x19, 0xd2, 0x9e, 0x6c, 0xdb, 0x54, 0x36, 0xe6, 0xc4, 0x3e,
+		0xbb, 0x52, 0xbc, 0x75, 0x98, 0xba, 0xee, 0x4b, 0x15, 0xfe, 0xa5, 0x02, 0x09, 0xd6, 0x26, 0x7c,
+		0xba, 0x30, 0x30, 0x86, 0xca, 0x9d, 0x8f, 0xe1, 0x71, 0x00, 0x4e, 0x06, 0x23, 0x58, 0x84, 0x64,
+		0x25, 0x58, 0x0e, 0xc6, 0xa5, 0x90, 0xf3, 0x2e, 0xc3, 0xc3, 0xfb, 0x33, 0x5c, 0xee, 0x5a, 0x05,
+		0xdb, 0x8f, 0xc0, 0x34, 0xbb, 0x82, 0x7f, 0xd3, 0x16, 0x1b, 0x51, 0x7c, 0xe8, 0x76, 0xe3, 0xa6,
+		0x5d, 0x78, 0x11, 0xa6, 0x37, 0x6e, 0x72, 0x7f, 0xe2, 0x31, 0x48, 0x74, 0x2d, 0x4b, 0x58, 0x7f,
+		0xdc, 0xea, 0x8e, 0x63, 0x06, 0x33, 0x76, 0xa4, 0x0f, 0x2d, 0xe4, 0xf9, 0xd0, 0x3c, 0x27, 0x60,
+		0x78, 0x22, 0x27, 0x20, 0xee, 0x3e, 0xd3, 0x81, 0x99, 0x44, 0xde, 0x09, 0x47, 0x6a, 0xcb, 0x97,
+		0xd6, 0x2a, 0x4b, 0xfa, 0x6a, 0xed, 0x52, 0xdf, 0x45, 0xe9, 0x7c, 0xf6, 0xd6, 0xed, 0xf9, 0xa4,
+		0xd8, 0x76, 0x8e, 0x82, 0x5e, 0xd7, 0x2a, 0x57, 0xab, 0x1b, 0x15, 0x55, 0xe1, 0xd0, 0xeb, 0x5d,
+		0x7a, 0xdd, 0x72, 0xf8, 0xf7, 0x43, 0x1e, 0x86, 0xa3, 0x43, 0xa0, 0xdd, 0xcd, 0xe7, 0xcc, 0xad,
+		0xdb, 0xf3, 0xe9, 0xf5, 0x2e, 0xe5, 0x52, 0xc6, 0x30, 0x16, 0x20, 0x37, 0x88, 0x51, 0x5d, 0xaf,
+		0xd6, 0x8a, 0x2b, 0xea, 0x7c, 0x5e, 0xbd, 0x75, 0x7b, 0x3e, 0x25, 0x55, 0x06, 0xc2, 0x7f, 0xfd,
+		0x77, 0x9f, 0xdf, 0x15, 0x87, 0xe3, 0xfd, 0x85, 0x67, 0x3a, 0x46, 0xd7, 0x68, 0x1f, 0x74, 0xfb,
+		0x39, 0xe6, 0xc0, 0xbf, 0xf0, 0x99, 0x10, 0x64, 0xdd, 0xcd, 0xcd, 0x3a, 0xab, 0x81, 0x3c, 0xea,
+		0xf7, 0xf8, 0x25, 0x47, 0xae, 0x1d, 0x1c, 0x5a, 0x3a, 0x04, 0xf1, 0xa8, 0x47, 0x18, 0xc9, 0x62,
+		0x52, 0xcd, 0x0f, 0x59, 0xdf, 0x04, 0x84, 0x40, 0x75, 0x31, 0xc8, 0x53, 0x90, 0x70, 0xa7, 0x98,
+		0xfb, 0xf0, 0xf2, 0xe8, 0x39, 0x29, 0xf0, 0x3d, 0x1c, 0x7c, 0x0b, 0x59, 0x6e, 0xe3, 0x22, 0xa3,
+		0x36, 0x86, 0x57, 0x39, 0x80, 0x40, 0x96, 0xf0, 0xe4, 0x61, 0x88, 0x18, 0x5b, 0xf5, 0x66, 0x2e,
+		0x3a, 0xe8, 0x72, 0xe1, 0x78, 0xc5, 0x52, 0x79, 0x59, 0x20, 0x31, 0xc8, 0xc2, 0xb2, 0x70, 0xa6,
+		0x08, 0x7e, 0xb1, 0xa7, 0x2c, 0x6f, 0xea, 0x7c, 0x2f, 0xcc, 0xad, 0x8b, 0x78, 0xdb, 0xb8, 0x59,
+		0xc2, 0x34, 0x4e, 0x40, 0x2c, 0xdc, 0x11, 0x6f, 0x4a, 0x85, 0xb5, 0x58, 0xdb, 0xb8, 0x79, 0xc9,
+		0xc0, 0xab, 0xcb, 0x61, 0x35, 0x52, 0xf8, 0x69, 0xb4, 0x54, 0x02, 0x5c, 0x21, 0x0f, 0x02, 0x41,
+		0x0c, 0x3c, 0x83, 0xc3, 0xa9, 0xcb, 0xd8, 0x2b, 0xe9, 0xe2, 0xad, 0xf0, 0xe2, 0x0e, 0x5d, 0xeb,
+		0xb5, 0x59, 0x03, 0x6c, 0x82, 0x07, 0x76, 0x02, 0x58, 0x8e, 0xac, 0x60, 0xff, 0xd1, 0xc1, 0x2f,
+		0x71, 0x08, 0x00, 0xbe, 0x00, 0xbc, 0x82, 0x0b, 0x40, 0x86, 0xd3, 0x93, 0x25, 0xc1, 0xae, 0x84,
+		0x83, 0x5d, 0x29, 0x3c, 0x05, 0xd9, 0xbe, 0x11, 0x20, 0x05, 0x48, 0x0b, 0x8f, 0x15, 0x3b, 0x3e,
+		0xe7, 0x3b, 0x9c, 0x84, 0x96, 0xe4, 0x9e, 0x29, 0x16, 0x4e, 0xb0, 0x18, 0xff, 0x1c, 0xba, 0x49,
+		0xf1, 0x30, 0xe7, 0x41, 0x48, 0x07, 0xc6, 0x40, 0x7a, 0x91, 0x15, 0xcf, 0x8b, 0xec, 0x01, 0xbf,
+		0x00, 0x29, 0x54, 0x40, 0xb4, 0x21, 0x60, 0xef, 0x83, 0x2c, 0x57, 0x90, 0xfd, 0xbc, 0xe6, 0x06,
+		0xcf, 0xaa, 0x64, 0x78, 0x01, 0xd2, 0x1e, 0x9c, 0xc7, 0xf6, 0xa4, 0x84, 0xba, 0x64, 0xd8, 0x85,
+		0x2a, 0x80, 0x37, 0xa8, 0xa4, 0x08, 0xc7, 0x51, 0x79, 0xf8, 0xc3, 0x3d, 0xc5, 0x5b, 0xbd, 0x01,
+		0x8b, 0x31, 0x8f, 0x40, 0x5e, 0x48, 0x27, 0x7f, 0xb1, 0xf7, 0x32, 0x83, 0x28, 0x3d, 0xf3, 0xe9,
+		0x2f, 0x9c, 0x50, 0xbe, 0x3e, 0xba, 0xe0, 0x3f, 0x3d, 0x0f, 0xc7, 0x7c, 0x85, 0x28, 0x80, 0x01,
+		0x47, 0x54, 0xd6, 0x2b, 0x5c, 0xc0, 0xc2, 0x71, 0x0e, 0xa5, 0x7d, 0xfd, 0xbf, 0xf9, 0xfd, 0xd5,
+		0xce, 0x78, 0x6f, 0xd3, 0x78, 0x7f, 0xd7, 0x70, 0xa7, 0xfc, 0xbf, 0x48, 0xc0, 0xb4, 0x46, 0x5f,
+		0xea, 0x51, 0x1b, 0x1f, 0x27, 0x88, 0xd0, 0xfa, 0xae, 0x35, 0xcc, 0x07, 0x8a, 0x1d, 0x5c, 0x10,
+		0x70, 0x95, 0xfa, 0xae, 0x75, 0x79, 0x4a, 0x63, 0xb0, 0xe4, 0x1c, 0x44, 0xb7, 0x5b, 0x3d, 0xe1,
+		0xbe, 0xea, 0xd3, 0x59, 0x7e, 0xa4, 0x8b, 0x08, 0x74, 0x79, 0x4a, 0xe3, 0xd0, 0x58, 0x15, 0xfb,
+		0xb8, 0x52, 0x78, 0xff, 0xaa, 0xf0, 0x82, 0x38, 0x56, 0x85, 0xb0, 0xa4, 0x04, 0xd0, 0x34, 0x9b,
+		0x8e, 0xce, 0x5c, 0x3b, 0xb9, 0xe8, 0xa0, 0xb2, 0x0a, 0x62, 0x36, 0x1d, 0xe6, 0x0c, 0xc2, 0xa3,
+		0x83, 0xa6, 0x4c, 0x60, 0x73, 0x5f, 0xea, 0xd1, 0xee, 0x5e, 0x2e, 0xb6, 0x7f, 0x73, 0x9f, 0x41,
+		0x20, 0x6c, 0x2e, 0x83, 0x46, 0x25, 0xcb, 0x1f, 0xef, 0x76, 0x6e, 0xe6, 0xe2, 0x83, 0x6a, 0xce,
+		0x8f, 0xc9, 0x5e, 0xf0, 0xde, 0xb8, 0x89, 0xe7, 0x19, 0x75, 0xfe, 0x93, 0x3c, 0xe1, 0x9a, 0x60,
+		0xc9, 0x41, 0xab, 0x27, 0x80, 0xcb, 0xdd, 0x3e, 0x53, 0xd2, 0x14, 0x23, 0x6b, 0x90, 0x69, 0x35,
+		0x6d, 0x47, 0xb7, 0x4d, 0xa3, 0x63, 0xef, 0x5a, 0x8e, 0x7c, 0x3d, 0xf7, 0xde, 0x51, 0x14, 0x56,
+		0x9a, 0xb6, 0x53, 0x93, 0xc0, 0x97, 0xa7, 0xb4, 0x74, 0xcb, 0x9f, 0x81, 0xf4, 0xac, 0xed, 0x6d,
+		0xda, 0x75, 0x09, 0xe6, 0xd2, 0xfb, 0xd3, 0xab, 0x22, 0xb4, 0xc4, 0x47, 0x7a, 0x96, 0x3f, 0x83,
+		0x7c, 0x33, 0xcc, 0xb6, 0x2c, 0xa3, 0xe1, 0x92, 0xd3, 0xeb, 0xbb, 0x3d, 0xf3, 0x1a, 0x73, 0xca,
+		0xe0, 0x67, 0x14, 0x46, 0x35, 0xd2, 0x32, 0x1a, 0x92, 0x44, 0x19, 0x11, 0x2e, 0x4f, 0x69, 0x33,
+		0xad, 0xfe, 0x4c, 0xf2, 0x3e, 0x98, 0x33, 0x3a, 0x9d, 0xd6, 0x5e, 0x3f, 0xf5, 0x2c, 0xa3, 0x7e,
+		0x7a, 0x14, 0xf5, 0x22, 0xe2, 0xf4, 0x93, 0x27, 0xc6, 0x40, 0x2e, 0xd9, 0x40, 0x77, 0x12, 0x65,
+		0x37, 0xa6, 0x3a, 0xc2, 0xca, 0x60, 0x4f, 0xd3, 0xe2, 0x57, 0xcb, 0x46, 0xd0, 0x5e, 0xe7, 0xf0,
+		0xd2, 0x28, 0xb9, 0x3c, 0x85, 0x9e, 0xa7, 0x40, 0x16, 0xa7, 0x6a, 0xd5, 0x29, 0x7b, 0xc7, 0x5b,
+		0x50, 0x9d, 0x19, 0x47, 0x95, 0xc1, 0x07, 0xa9, 0x06, 0xb2, 0x48, 0x05, 0x92, 0x7c, 0x2b, 0x8a,
+		0x07, 0x33, 0x94, 0xbd, 0x8f, 0x9e, 0x3c, 0x5b, 0x18, 0x39, 0x43, 0x19, 0x28, 0x7a, 0xb9, 0x2e,
+		0x4f, 0x69, 0x40, 0xdd, 0x14, 0x31, 0xe0, 0x10, 0x7b, 0xdb, 0x77, 0x4f, 0x0f, 0x2a, 0xde, 0xdc,
+		0x2c, 0x23, 0xf8, 0xe0, 0x28, 0x82, 0xec, 0x9b, 0x39, 0x7b, 0x57, 0xfd, 0x7a, 0xf8, 0xf2, 0x94,
+		0x36, 0x7b, 0x7d, 0x30, 0x1b, 0x45, 0x6c, 0xbb, 0x69, 0x1a, 0x2d, 0x7c, 0xb7, 0x9e, 0x5b, 0x33,
+		0x73, 0xfb, 0x8b, 0xd8, 0x45, 0x01, 0xcd, 0x16, 0x56, 0x14, 0xb1, 0x6d, 0x7f, 0x46, 0x69, 0x5a,
+		0xc4, 0x7a, 0xba, 0x6f, 0x5e, 0x4e, 0xab, 0x71, 0xfe, 0x32, 0xf3, 0x95, 0x48, 0x1c, 0xd4, 0x64,
+		0xe1, 0x7e, 0x48, 0xfa, 0x14, 0x13, 0x9e, 0x42, 0x89, 0x68, 0x18, 0x19, 0x35, 0x2a, 0x92, 0x85,
+		0x0c, 0xa4, 0xfc, 0xca, 0xa8, 0xf0, 0x51, 0x05, 0x92, 0x3e, 0x3d, 0x83, 0x98, 0x7e, 0x8f, 0x74,
+		0xc2, 0xb3, 0x54, 0xee, 0x91, 0x4b, 0x9b, 0x2c, 0xe7, 0xc7, 0xaf, 0x29, 0x96, 0x29, 0x56, 0x56,
+		0x7c, 0x46, 0xa3, 0x73, 0xb6, 0xe3, 0x82, 0x84, 0x19, 0x08, 0x74, 0xce, 0x76, 0x24, 0xc0, 0xdd,
+		0x90, 0xc2, 0x7e, 0xeb, 0x7e, 0x7b, 0x29, 0xa1, 0x25, 0x31, 0x4f, 0x80, 0x14, 0x7e, 0x3b, 0x04,
+		0x6a, 0xbf, 0x02, 0x73, 0x5d, 0xd5, 0xca, 0x81, 0x5d, 0xd5, 0x47, 0xfb, 0x9d, 0xe4, 0x9e, 0x5f,
+		0x7c, 0x05, 0x54, 0xcf, 0xbd, 0xcb, 0x97, 0x9a, 0xd1, 0xf6, 0x5f, 0x9f, 0xa1, 0xaa, 0x65, 0xeb,
+		0xc1, 0x0c, 0x72, 0x31, 0x70, 0x58, 0x29, 0xbf, 0x3e, 0xd8, 0x3f, 0xe0, 0xae, 0x11, 0xb3, 0xd9,
+		0x69, 0x18, 0x0e, 0x95, 0xfe, 0x31, 0x0f, 0x13, 0x6d, 0x0d, 0xf4, 0x26, 0xdb, 0x8e, 0xe1, 0x50,
+		0x61, 0x6b, 0x70, 0xcf, 0x43, 0xda, 0xe8, 0x74, 0xd8, 0xc7, 0x9a, 0xb8, 0xad, 0x71, 0x2f, 0x64,
+		0x50, 0xa7, 0x37, 0x8d, 0x96, 0x34, 0x15, 0x62, 0xdc, 0x24, 0x11, 0xb9, 0xdc, 0x3a, 0x28, 0x34,
+		0x20, 0xe5, 0xd7, 0xe7, 0xee, 0x9e, 0x4a, 0xf1, 0xed, 0xa9, 0x88, 0x78, 0x15, 0x85, 0xf3, 0x47,
+		0xbe, 0x24, 0x33, 0xfc, 0xd0, 0x60, 0x8e, 0xed, 0xbf, 0xae, 0x73, 0x67, 0x49, 0x5c, 0xe3, 0x89,
+		0x82, 0x06, 0x99, 0xa0, 0xee, 0x27, 0x19, 0x08, 0x39, 0x37, 0x45, 0x2d, 0x21, 0xe7, 0x26, 0x5a,
+		0xba, 0xbe, 0xb7, 0xd8, 0x06, 0x57, 0x3b, 0x81, 0xe7, 0xf9, 0x3a, 0x0a, 0x59, 0x48, 0x4b, 0x9a,
+		0x7c, 0x53, 0x7e, 0x18, 0xe6, 0x86, 0xa9, 0xf8, 0xc2, 0x2e, 0xcc, 0x0d, 0x53, 0xd5, 0xe4, 0x1c,
+		0xc4, 0x5d, 0x1d, 0x3f, 0x64, 0x77, 0xcc, 0xaa, 0x95, 0xc0, 0x9a, 0x0b, 0x1a, 0x70, 0xe7, 0x87,
+		0x02, 0xee, 0xfc, 0xc2, 0xb7, 0x42, 0x6e, 0x94, 0xfe, 0xee, 0x73, 0xf2, 0x45, 0x5c, 0x86, 0x1d,
+		0x86, 0x98, 0x78, 0x7a, 0x2c, 0xc4, 0x0e, 0xb0, 0x44, 0x0a, 0x19, 0xc9, 0x75, 0x79, 0x98, 0x65,
+		0xf3, 0x44, 0x41, 0x87, 0xa3, 0x23, 0x75, 0xf8, 0xe8, 0xa3, 0x30, 0x4e, 0x48, 0x1c, 0x85, 0xd5,
+		0x65, 0x73, 0x6c, 0xd6, 0x57, 0x19, 0xc8, 0xc2, 0x53, 0x85, 0x57, 0xc2, 0x70, 0x78, 0xb8, 0x26,
+		0x27, 0xf3, 0x90, 0x42, 0xbb, 0xd5, 0x09, 0x9a, 0xb8, 0xd0, 0x36, 0x6e, 0x6e, 0x08, 0xfb, 0x56,
+		0x1c, 0x25, 0x84, 0xdc, 0xa3, 0x04, 0xb2, 0x09, 0x33, 0x2d, 0xab, 0x6e, 0xb4, 0x74, 0xdf, 0x51,
+		0x8e, 0x98, 0x44, 0xf7, 0x0c, 0x30, 0x3b, 0xe8, 0x32, 0x44, 0x85, 0x23, 0xe4, 0x3f, 0xcb, 0x68,
+		0xac, 0xb8, 0xa7, 0x3e, 0x64, 0x09, 0x92, 0xed, 0xa6, 0xbd, 0x45, 0x77, 0x8d, 0xeb, 0x4d, 0xab,
+		0x2b, 0x66, 0xd3, 0xa0, 0xd0, 0xac, 0x7a, 0x30, 0xf2, 0x84, 0xc9, 0x87, 0xe6, 0x1b, 0x92, 0xe8,
+		0xd0, 0x83, 0xaf, 0xd8, 0x81, 0xb5, 0xc9, 0xa8, 0x33, 0xa4, 0xe9, 0x91, 0x67, 0x48, 0xc3, 0x0e,
+		0x6c, 0xe2, 0xc3, 0x0f, 0x6c, 0x3e, 0xe4, 0x1f, 0x9a, 0xe0, 0xda, 0x37, 0x70, 0x86, 0x43, 0x6a,
+		0x30, 0x27, 0xf0, 0x1b, 0x01, 0xde, 0x0f, 0x09, 0x75, 0xe0, 0xf3, 0xab, 0x9f, 0xe7, 0x44, 0xa2,
+		0x8f, 0x66, 0x7b, 0xf8, 0xce, 0xd8, 0x2e, 0x0f, 0x51, 0x23, 0xbe, 0x43, 0xd4, 0xff, 0xcb, 0x86,
+		0xe2, 0x03, 0x61, 0x98, 0x19, 0x30, 0x24, 0x86, 0x9e, 0x0e, 0x8f, 0xf2, 0xc8, 0xca, 0x8e, 0x85,
+		0x0f, 0xdc, 0x31, 0x31, 0xd6, 0x91, 0xf1, 0x63, 0x1d, 0x7d, 0x0b, 0xc7, 0x3a, 0x76, 0x67, 0x63,
+		0xfd, 0x75, 0x1d, 0x85, 0x1f, 0x55, 0x20, 0x3f, 0xda, 0xfa, 0x1a, 0x3a, 0x1c, 0x07, 0x3a, 0x40,
+		0x18, 0xb5, 0xc6, 0xdd, 0x0b, 0x99, 0x3e, 0xdb, 0x90, 0x8b, 0x72, 0x3a, 0xb0, 0x0b, 0x2f, 0x7c,
+		0x30, 0x0c, 0x73, 0xc3, 0x0c, 0xb8, 0x21, 0xb3, 0xf5, 0x19, 0x7c, 0xf9, 0xbe, 0xde, 0x6c, 0xdc,
+		0xe9, 0x64, 0x9d, 0x11, 0xd8, 0xff, 0x6f, 0xae, 0x0e, 0x4a, 0xc9, 0x0f, 0x00, 0xc4, 0x35, 0x6a,
+		0x77, 0xd0, 0x1e, 0xc3, 0xe3, 0x08, 0x7a, 0xb3, 0x4e, 0x3b, 0x8e, 0x17, 0x54, 0x31, 0x6c, 0x8b,
+		0xc0, 0xa1, 0x2b, 0x12, 0x12, 0x37, 0xc8, 0x2e, 0x1a, 0x9e, 0xcc, 0x30, 0x1f, 0xc0, 0xe8, 0xed,
+		0xbc, 0x40, 0xf7, 0x3b, 0x01, 0xce, 0x4b, 0x27, 0x40, 0x78, 0xe4, 0xfe, 0x96, 0x63, 0xf5, 0x79,
+		0x01, 0x1e, 0x15, 0x5e, 0x80, 0xc8, 0x98, 0xca, 0x02, 0x6e, 0x80, 0x72, 0xc0, 0x0d, 0x10, 0x1b,
+		0xd3, 0xcd, 0x11, 0x7e, 0x80, 0xf3, 0xd2, 0x0f, 0x30, 0x3d, 0xa6, 0xc5, 0x7d, 0x8e, 0x80, 0x77,
+		0xfb, 0x1c, 0x01, 0x89, 0x41, 0x6f, 0x6b, 0x00, 0x75, 0x88, 0x27, 0xe0, 0x82, 0xeb, 0x09, 0x48,
+		0x8d, 0xf4, 0x22, 0x08, 0xe4, 0x7e, 0x57, 0x40, 0x75, 0xc0, 0x15, 0x90, 0x16, 0x5f, 0xd2, 0x1d,
+		0x45, 0x62, 0x8c, 0x2f, 0xa0, 0x3a, 0xe0, 0x0b, 0xc8, 0x8c, 0x21, 0x38, 0xc6, 0x19, 0xf0, 0x2d,
+		0xc3, 0x9d, 0x01, 0xa3, 0xb7, 0xeb, 0xa2, 0x99, 0x93, 0x79, 0x03, 0xf4, 0x11, 0xde, 0x00, 0x75,
+		0xe4, 0xce, 0x95, 0x93, 0x9f, 0xd8, 0x1d, 0xb0, 0x39, 0xc4, 0x1d, 0xc0, 0x37, 0xee, 0xa7, 0x46,
+		0x12, 0x9f, 0xc0, 0x1f, 0xb0, 0x39, 0xc4, 0x1f, 0x40, 0xc6, 0x92, 0x1d, 0xeb, 0x10, 0xb8, 0x18,
+		0x74, 0x08, 0xcc, 0x8e, 0xb0, 0x3a, 0xbd, 0xd9, 0x3e, 0xc2, 0x23, 0xb0, 0x35, 0xca, 0x23, 0x30,
+		0x27, 0x5e, 0xe8, 0x1f, 0x45, 0xf1, 0x00, 0x2e, 0x81, 0xea, 0x80, 0x4b, 0xe0, 0xd0, 0x18, 0x49,
+		0x9b, 0xdc, 0x27, 0xc0, 0xbf, 0xd9, 0xc4, 0xbf, 0xd6, 0x04, 0x6a, 0xf2, 0x4a, 0x24, 0x9e, 0x54,
+		0x53, 0x85, 0x07, 0x60, 0x46, 0x92, 0x72, 0xf5, 0x1c, 0xee, 0x15, 0x68, 0xb7, 0x6b, 0x75, 0xe5,
+		0x3d, 0x06, 0x96, 0x28, 0x9c, 0x82, 0x94, 0x0b, 0xba, 0xbf, 0xff, 0x80, 0xed, 0xc9, 0x7c, 0x7a,
+		0xac, 0xf0, 0x39, 0x05, 0x52, 0x7e, 0x15, 0x15, 0xd8, 0x5f, 0x26, 0xc4, 0xfe, 0xd2, 0xe7, 0x55,
+		0x08, 0x05, 0xbd, 0x0a, 0xf8, 0xe5, 0x8b, 0xce, 0x80, 0xc3, 0xc0, 0xe8, 0xb8, 0x0e, 0x03, 0x19,
+		0x40, 0xc5, 0x7d, 0x0f, 0x62, 0x59, 0xe2, 0xa7, 0x89, 0x59, 0x37, 0x98, 0x8c, 0x6f, 0x75, 0xc9,
+		0x43, 0x30, 0xeb, 0x83, 0x75, 0xf7, 0x70, 0x7c, 0xf7, 0xac, 0xba, 0xd0, 0x45, 0xb1, 0x99, 0xfb,
+		0x57, 0x0a, 0xcc, 0x0c, 0xa8, 0xc8, 0xa1, 0x4e, 0x01, 0xe5, 0x2d, 0x72, 0x0a, 0x84, 0xee, 0xd8,
+		0x29, 0xe0, 0xdf, 0x93, 0x86, 0x83, 0x7b, 0xd2, 0xff, 0xa1, 0x40, 0x3a, 0xa0, 0xa9, 0x71, 0x08,
+		0xea, 0x56, 0x83, 0x8a, 0x5d, 0x22, 0xfb, 0x8d, 0x26, 0x49, 0xcb, 0x92, 0x9f, 0xda, 0xc1, 0x9f,
+		0x08, 0xe5, 0x2e, 0x3c, 0x09, 0xb1, 0xae, 0xb8, 0x1b, 0xcc, 0xa8, 0xff, 0x1e, 0x87, 0xb8, 0xdc,
+		0x10, 0xf3, 0x2e, 0x37, 0xb8, 0x97, 0x8f, 0xa7, 0x7d, 0x97, 0x8f, 0xc9, 0x13, 0x90, 0x60, 0xce,
+		0x7e, 0xdd, 0xea, 0xf0, 0xc5, 0xba, 0xcf, 0xb4, 0x09, 0x5e, 0x6c, 0xb0, 0x59, 0xc8, 0x35, 0xfb,
+		0xe5, 0xb3, 0x38, 0x12, 0x01, 0x8b, 0xe3, 0x2e, 0x48, 0x60, 0xeb, 0xf9, 0x67, 0xfb, 0x40, 0xdc,
+		0x5c, 0x97, 0x19, 0x85, 0x9f, 0x09, 0x41, 0xb6, 0x6f, 0xa1, 0x19, 0xda, 0xf7, 0x61, 0xc7, 0xc8,
+		0x93, 0xf1, 0xe3, 0x04, 0xc0, 0x8e, 0x61, 0xeb, 0x37, 0x0c, 0xd3, 0x11, 0x9f, 0xda, 0x0e, 0x6b,
+		0xbe, 0x1c, 0xbc, 0xc8, 0x80, 0xa9, 0x9e, 0x2d, 0x3e, 0xb6, 0x1d, 0xd6, 0xdc, 0x34, 0xde, 0x46,
+		0xa2, 0xd7, 0xd9, 0x3b, 0xb8, 0xfc, 0x4b, 0x0b, 0x87, 0x07, 0xb7, 0xc3, 0x58, 0x5c, 0xca, 0xe1,
+		0x60, 0x7f, 0xf9, 0xd5, 0x93, 0x2a, 0x87, 0x7e, 0xa7, 0x7b, 0x61, 0x4c, 0x13, 0xf8, 0x41, 0x2e,
+		0xc4, 0xfb, 0xb8, 0xc0, 0xfc, 0x80, 0x29, 0xb9, 0xbd, 0x47, 0x9e, 0xf2, 0x18, 0x6f, 0x2d, 0xdd,
+		0xa6, 0xed, 0x8e, 0x65, 0xb5, 0x74, 0x3e, 0xc7, 0x8b, 0x90, 0x71, 0x79, 0xc5, 0x57, 0x53, 0xf6,
+		0x65, 0x5c, 0x07, 0x5d, 0x63, 0x01, 0x23, 0x38, 0xc5, 0x33, 0xf9, 0x9c, 0xf2, 0x3f, 0x72, 0x5c,
+		0x58, 0x87, 0x43, 0x92, 0x44, 0x60, 0x5d, 0x25, 0x8f, 0x43, 0xc2, 0x5b, 0x92, 0x79, 0x98, 0xfe,
+		0x3e, 0x9e, 0x16, 0x0f, 0xb6, 0xf0, 0xab, 0x0a, 0x1c, 0x1a, 0xba, 0xb2, 0x92, 0x0a, 0xc4, 0x78,
+		0xcc, 0xa4, 0x88, 0x8f, 0x79, 0x68, 0xb2, 0x15, 0x79, 0x81, 0x07, 0x54, 0x6a, 0x02, 0xb9, 0xf0,
+		0x3e, 0x88, 0xf1, 0x1c, 0x92, 0x84, 0xe9, 0xc0, 0x8b, 0xe3, 0xc5, 0x72, 0xb9, 0xb2, 0x2e, 0x9f,
+		0xcf, 0x2d, 0xb1, 0xd7, 0xbc, 0x31, 0x5b, 0xab, 0x5c, 0xa9, 0x94, 0x37, 0xd4, 0x30, 0x7e, 0x1a,
+		0x9a, 0xff, 0x16, 0xef, 0x82, 0xab, 0x11, 0x5f, 0x56, 0xad, 0xb2, 0xb6, 0x54, 0xd1, 0xd4, 0x68,
+		0xe1, 0x11, 0x38, 0x2a, 0xdb, 0x31, 0xe8, 0x11, 0x72, 0x1d, 0x33, 0x8a, 0xcf, 0x31, 0x53, 0x78,
+		0x25, 0x04, 0x79, 0x89, 0x33, 0xc4, 0xc7, 0x73, 0xa5, 0xaf, 0xe3, 0x67, 0x0f, 0xb0, 0xaa, 0xf7,
+		0xf5, 0x1e, 0xf7, 0x31, 0x5d, 0xba, 0x4d, 0x9d, 0xfa, 0x2e, 0x37, 0x14, 0xb8, 0x06, 0x4a, 0x6b,
+		0x69, 0x91, 0xcb, 0x90, 0x6c, 0x0e, 0xf6, 0x22, 0xad, 0x3b, 0x3a, 0x17, 0x22, 0x9b, 0x6d, 0x26,
+		0x12, 0x5a, 0x9a, 0xe7, 0xd6, 0x78, 0x66, 0xe1, 0x5b, 0x0f, 0xc4, 0xcb, 0x04, 0x44, 0xb5, 0x0a,
+		0x7e, 0xe5, 0x89, 0x7d, 0xff, 0x9a, 0xfd, 0xd4, 0x6b, 0x6b, 0xc5, 0xf5, 0xda, 0xe5, 0x2a, 0xf2,
+		0x72, 0x16, 0xb2, 0x92, 0x97, 0x32, 0x33, 0x5a, 0x78, 0x10, 0x8e, 0x8c, 0xb0, 0x2a, 0x86, 0x04,
+		0xb1, 0x7e, 0x52, 0xf1, 0x43, 0x07, 0x2d, 0x83, 0x2a, 0xc4, 0x6c, 0xc7, 0x70, 0x7a, 0xb6, 0x60,
+		0xe2, 0xe3, 0x93, 0x9a, 0x19, 0x0b, 0xf2, 0x47, 0x8d, 0xa1, 0x6b, 0x82, 0x4c, 0xe1, 0x1c, 0x64,
+		0x82, 0x25, 0xa3, 0x79, 0xe0, 0x09, 0x51, 0xa8, 0xf0, 0x24, 0x90, 0x41, 0xeb, 0x63, 0xc8, 0xf6,
+		0x52, 0x19, 0xb6, 0xbd, 0xfc, 0x49, 0x05, 0x8e, 0xed, 0x63, 0x69, 0x90, 0x67, 0xfa, 0x3a, 0x79,
+		0xe1, 0x20, 0x76, 0xca, 0x02, 0xcf, 0xeb, 0xeb, 0xe6, 0xa3, 0x90, 0xf2, 0xe7, 0x4f, 0xd6, 0xc9,
+		0x2f, 0x87, 0xe0, 0xd0, 0x50, 0xa3, 0xc5, 0xa7, 0x02, 0x95, 0x37, 0xa9, 0x02, 0xdf, 0x05, 0xe0,
+		0xdc, 0x94, 0x51, 0xd4, 0x62, 0x1d, 0x3d, 0x3e, 0xc4, 0xbf, 0x48, 0xeb, 0x1b, 0x37, 0xc5, 0x24,
+		0x48, 0x38, 0xe2, 0x17, 0xfa, 0x4f, 0x7c, 0x4e, 0x81, 0x1e, 0x5b, 0x63, 0xed, 0x5c, 0xf8, 0x40,
+		0x8b, 0xb1, 0x7a, 0x3d, 0x98, 0x6d, 0x93, 0xe7, 0xe1, 0x48, 0x9f, 0xa1, 0xe0, 0x92, 0x8e, 0x4c,
+		0x6a, 0x2f, 0x1c, 0x0a, 0xda, 0x0b, 0x92, 0xb4, 0x7f, 0xb5, 0x8f, 0x06, 0x57, 0xfb, 0xe7, 0x01,
+		0x3c, 0xe7, 0x80, 0x17, 0xf6, 0xa7, 0xf8, 0xc3, 0xfe, 0xce, 0x41, 0x14, 0x25, 0x49, 0xf2, 0x69,
+		0x50, 0x15, 0xa3, 0x24, 0xf8, 0x9c, 0x0b, 0x1c, 0xba, 0xd0, 0x04, 0x32, 0xe8, 0xa0, 0x1d, 0x51,
+		0xc5, 0xbb, 0x83, 0x55, 0xdc, 0x3d, 0xd2, 0xd5, 0x3b, 0xbc, 0xaa, 0x97, 0x21, 0xca, 0x46, 0x7e,
+		0xe8, 0x5d, 0xca, 0xf7, 0x02, 0x18, 0x8e, 0xd3, 0x6d, 0x6e, 0xf5, 0xbc, 0x0a, 0x4e, 0x0e, 0x97,
+		0x9c, 0xa2, 0x84, 0x2b, 0xdd, 0x25, 0x44, 0x68, 0xce, 0x43, 0xf5, 0x89, 0x91, 0x8f, 0x60, 0x61,
+		0x0d, 0x32, 0x41, 0xdc, 0xe1, 0x77, 0x43, 0x07, 0xbf, 0xb1, 0xe8, 0x59, 0x47, 0xe2, 0xc1, 0x23,
+		0x96, 0x28, 0xbc, 0x3f, 0x04, 0x29, 0xbf, 0xe0, 0xfd, 0xdd, 0x33, 0x41, 0x0a, 0xdf, 0xa9, 0x40,
+		0xdc, 0xed, 0xfe, 0x3e, 0xc1, 0xce, 0xde, 0x1d, 0x61, 0xf7, 0xf0, 0x82, 0x1f, 0x13, 0x85, 0xdd,
+		0x63, 0xa2, 0x27, 0xdd, 0xe5, 0x6f, 0x94, 0x43, 0xc4, 0xcf, 0x6b, 0x19, 0x70, 0x28, 0x56, 0xfb,
+		0x27, 0x27, 0xbb, 0x8a, 0x87, 0x47, 0x58, 0xbe, 0x6b, 0x74, 0x3c, 0x51, 0x68, 0xf8, 0x22, 0x8c,
+		0xf8, 0x44, 0xf4, 0xdf, 0xd9, 0x53, 0x0e, 0x7c, 0x67, 0xcf, 0xad, 0x25, 0xe4, 0xaf, 0xe5, 0xfb,
+		0x15, 0x88, 0xcb, 0x39, 0x41, 0xde, 0xe3, 0x8f, 0x3c, 0x93, 0x47, 0x9b, 0x23, 0xf5, 0x91, 0xa0,
+		0xef, 0xa1, 0x0c, 0x06, 0x5c, 0x87, 0x0f, 0x1a, 0x70, 0x2d, 0x2c, 0xbb, 0xbf, 0x52, 0x40, 0xed,
+		0x9f, 0xb1, 0x6f, 0xba, 0x75, 0x83, 0xcb, 0x5c, 0x78, 0xc8, 0x32, 0x37, 0x2a, 0x8a, 0x3a, 0x32,
+		0x2a, 0x8a, 0x7a, 0xb0, 0xd7, 0xd1, 0x3b, 0xec, 0xf5, 0x07, 0x42, 0x90, 0xf4, 0xb9, 0x47, 0xc9,
+		0x63, 0x81, 0x88, 0xec, 0xf9, 0xfd, 0x5c, 0xa9, 0xbe, 0x90, 0xec, 0x00, 0x9b, 0x42, 0x07, 0x67,
+		0xd3, 0x5b, 0x7f, 0x3b, 0x6b, 0xf8, 0x05, 0xd6, 0xe8, 0x88, 0x0b, 0xac, 0xdf, 0xa1, 0x40, 0xdc,
+		0x35, 0xbb, 0x0f, 0x7a, 0x88, 0x79, 0x18, 0x62, 0xc2, 0xb2, 0xe4, 0xa7, 0x98, 0x22, 0x35, 0xd4,
+		0xad, 0x9c, 0x87, 0xb8, 0xfc, 0x18, 0xbb, 0x58, 0xd5, 0xdc, 0xf4, 0xe9, 0x0b, 0x90, 0xf4, 0x1d,
+		0x00, 0xa3, 0x6a, 0x5c, 0xab, 0x3c, 0xab, 0x4e, 0xe5, 0xa7, 0x6f, 0xdd, 0x9e, 0x0f, 0xaf, 0xd1,
+		0x1b, 0x38, 0x9b, 0xb5, 0x4a, 0xf9, 0x72, 0xa5, 0xfc, 0xb4, 0xaa, 0xe4, 0x93, 0xb7, 0x6e, 0xcf,
+		0x4f, 0x6b, 0x94, 0x79, 0x14, 0x4f, 0x3f, 0x0d, 0xd9, 0xbe, 0x81, 0x09, 0x9a, 0x2d, 0x04, 0x32,
+		0x4b, 0x9b, 0xf8, 0x01, 0xa5, 0xe2, 0x46, 0x45, 0xe7, 0x71, 0xbb, 0xf8, 0x21, 0x8a, 0x95, 0xe5,
+		0x4b, 0x97, 0x37, 0xf4, 0xf2, 0xca, 0x72, 0x65, 0x6d, 0x43, 0x2f, 0x6e, 0x6c, 0x14, 0xcb, 0x4f,
+		0xab, 0xa1, 0xb3, 0xb7, 0x93, 0x10, 0xc1, 0xe8, 0x3c, 0x52, 0x86, 0x08, 0x73, 0x85, 0xec, 0x1b,
+		0x01, 0x96, 0xdf, 0xdf, 0x37, 0x4c, 0x2e, 0x42, 0x94, 0x79, 0x49, 0xc8, 0xfe, 0x21, 0x61, 0xf9,
+		0x31, 0xce, 0x62, 0x6c, 0x0c, 0x9b, 0x91, 0xfb, 0xc6, 0x88, 0xe5, 0xf7, 0xf7, 0x1d, 0x93, 0x15,
+		0x98, 0x96, 0x9b, 0xe4, 0x71, 0x81, 0x5b, 0xf9, 0xb1, 0x0e, 0x5d, 0xec, 0x1a, 0x77, 0x36, 0xec,
+		0x1f, 0x3e, 0x96, 0x1f, 0xe3, 0x55, 0xc6, 0x27, 0x34, 0xc4, 0x76, 0x74, 0x4c, 0x44, 0x58, 0x7e,
+		0x9c, 0x9f, 0x98, 0x68, 0x90, 0xf0, 0xdc, 0x38, 0xe3, 0x83, 0xe2, 0xf2, 0x13, 0x38, 0xcc, 0xc9,
+		0xfb, 0x20, 0x1d, 0xdc, 0xea, 0x4e, 0x16, 0x75, 0x96, 0x9f, 0xd0, 0x23, 0x8d, 0xf4, 0x83, 0xfb,
+		0xde, 0xc9, 0xa2, 0xd0, 0xf2, 0x13, 0x3a, 0xa8, 0xc9, 0x8b, 0x30, 0x33, 0xb8, 0x2f, 0x9d, 0x3c,
+		0x28, 0x2d, 0x7f, 0x00, 0x97, 0x35, 0x69, 0x03, 0x19, 0xb2, 0x9f, 0x3d, 0x40, 0x8c, 0x5a, 0xfe,
+		0x20, 0x1e, 0x6c, 0xd2, 0x80, 0x6c, 0xff, 0x26, 0x71, 0xd2, 0x98, 0xb5, 0xfc, 0xc4, 0xde, 0x6c,
+		0x5e, 0x4b, 0x70, 0x73, 0x39, 0x69, 0x0c, 0x5b, 0x7e, 0x62, 0xe7, 0x36, 0xd9, 0x04, 0xf0, 0xed,
+		0x0f, 0x27, 0x88, 0x69, 0xcb, 0x4f, 0xe2, 0xe6, 0x26, 0x1d, 0x98, 0x1d, 0xb6, 0x71, 0x3c, 0x48,
+		0x88, 0x5b, 0xfe, 0x40, 0xde, 0x6f, 0x94, 0xe7, 0xe0, 0x16, 0x70, 0xb2, 0x90, 0xb7, 0xfc, 0x84,
+		0x6e, 0xf0, 0x52, 0x71, 0x64, 0x9c, 0xf3, 0xfd, 0xfb, 0xc6, 0x39, 0x7b, 0x91, 0xcb, 0x6e, 0x6c,
+		0xf3, 0xef, 0xbc, 0x07, 0xde, 0x21, 0x5e, 0xd0, 0xb1, 0x1d, 0xe3, 0x5a, 0xd3, 0xdc, 0x71, 0x9f,
+		0x32, 0x12, 0x69, 0x11, 0xe4, 0x7c, 0x98, 0x43, 0x2d, 0xc8, 0xdc, 0x31, 0x0f, 0x1a, 0x8d, 0x7c,
+		0xe5, 0x71, 0xdc, 0x7d, 0x88, 0xf1, 0xe1, 0xcb, 0xfb, 0x3c, 0x96, 0x34, 0xe6, 0x49, 0xa6, 0x21,
+		0x8f, 0x29, 0xe5, 0xf7, 0x7d, 0x63, 0x20, 0xbf, 0x5f, 0xdc, 0x77, 0xe1, 0x87, 0x14, 0xc8, 0x5c,
+		0x6e, 0xda, 0x8e, 0xd5, 0x6d, 0xd6, 0x8d, 0x16, 0x5b, 0x5e, 0x9e, 0x9c, 0xf4, 0x56, 0x56, 0x29,
+		0x81, 0xe6, 0x8a, 0x78, 0x6f, 0x89, 0xa3, 0x90, 0x25, 0x88, 0x5d, 0x37, 0x5a, 0xfc, 0x4e, 0x94,
+		0xff, 0xb1, 0xb5, 0x7e, 0x9e, 0xfb, 0xec, 0x28, 0x3f, 0x15, 0x8e, 0xbb, 0x18, 0xca, 0x29, 0x85,
+		0x0f, 0x2b, 0xa0, 0x7a, 0x2d, 0xd3, 0x68, 0xdd, 0xea, 0x36, 0xd8, 0x56, 0xa0, 0xd3, 0xf1, 0xc5,
+		0x0a, 0xc8, 0x24, 0x79, 0x4c, 0x18, 0x59, 0xa1, 0xb1, 0x46, 0x56, 0xc4, 0x67, 0x60, 0x0d, 0xb9,
+		0x72, 0x1e, 0x1e, 0x76, 0xe5, 0xbc, 0xf0, 0x83, 0xec, 0x86, 0x4b, 0xbb, 0xdd, 0xb4, 0x71, 0x76,
+		0x68, 0x6c, 0x73, 0x7f, 0x05, 0x22, 0x5d, 0xc3, 0x11, 0x5b, 0xde, 0xd2, 0xf9, 0x03, 0x3f, 0x19,
+		0xc5, 0xfb, 0xcc, 0x68, 0x90, 0x67, 0x00, 0x6f, 0x48, 0xe8, 0x8c, 0x5e, 0xe8, 0x4d, 0xd1, 0xc3,
+		0x8b, 0x22, 0xd8, 0x3e, 0xf2, 0x3e, 0xf6, 0xf5, 0x3f, 0x3c, 0x59, 0x36, 0x77, 0x28, 0xa7, 0x1c,
+		0x7e, 0x53, 0x94, 0xd3, 0x6d, 0xe3, 0x66, 0x99, 0x51, 0x43, 0xfa, 0xe2, 0x69, 0xad, 0x5f, 0x57,
+		0x00, 0x3c, 0xc6, 0x10, 0x03, 0x0f, 0x5d, 0x64, 0x8a, 0x55, 0x2a, 0x0f, 0x5d, 0xee, 0x1f, 0x25,
+		0x09, 0x7d, 0x6c, 0x2d, 0xa5, 0xb1, 0x79, 0x9f, 0x7f, 0xf5, 0xa4, 0xc2, 0x6b, 0xcd, 0xd6, 0x07,
+		0xd8, 0x9e, 0xe4, 0xee, 0x19, 0x7d, 0xc2, 0x01, 0x4f, 0x4b, 0xab, 0x9a, 0x13, 0x04, 0x8e, 0x8d,
+		0xe5, 0xa2, 0x0f, 0x9f, 0x56, 0x20, 0xb9, 0xe4, 0x7b, 0xcc, 0x11, 0x4f, 0xcd, 0x2c, 0xb3, 0x79,
+		0x8d, 0x76, 0xdd, 0x53, 0x33, 0x9e, 0x44, 0xcb, 0x97, 0x7f, 0x59, 0xd8, 0xd9, 0x93, 0xef, 0x1d,
+		0xc9, 0x34, 0x62, 0xdd, 0xa0, 0x5b, 0x76, 0x53, 0xf2, 0x59, 0x93, 0x49, 0x0c, 0x81, 0xb0, 0x69,
+		0xbd, 0x87, 0xce, 0x7c, 0xfc, 0x72, 0xbb, 0x83, 0x1f, 0x18, 0xe7, 0x9e, 0x81, 0xac, 0xcc, 0x2f,
+		0xf3, 0x6c, 0x24, 0xd2, 0xa0, 0x8e, 0xd1, 0x6c, 0xf1, 0x58, 0xd1, 0x84, 0x26, 0x93, 0xa2, 0xa9,
+		0xb7, 0xa6, 0xfd, 0x3b, 0xe3, 0x32, 0xa8, 0x56, 0x87, 0x76, 0x03, 0x21, 0x32, 0x5c, 0x1a, 0x73,
+		0xbf, 0xf7, 0xd9, 0x87, 0xe6, 0x04, 0xc3, 0x45, 0x78, 0x05, 0xff, 0x76, 0x81, 0x96, 0x95, 0x18,
+		0x22, 0x9b, 0x3c, 0x1f, 0x38, 0x27, 0xeb, 0x6d, 0x79, 0x0f, 0xdb, 0xcc, 0x0d, 0x30, 0xb5, 0x68,
+		0xee, 0x95, 0x72, 0xbf, 0xe5, 0x91, 0xf6, 0x76, 0xce, 0xf8, 0x94, 0x8c, 0xef, 0xd0, 0x8c, 0x91,
+		0xc1, 0x4d, 0xc5, 0x8b, 0x46, 0xb3, 0x25, 0xbf, 0x74, 0xaf, 0x89, 0x14, 0x59, 0x74, 0x1d, 0x9e,
+		0xfc, 0x93, 0x96, 0x85, 0x51, 0xb2, 0x51, 0xb2, 0xcc, 0x46, 0xd0, 0xb3, 0x49, 0xca, 0x10, 0x73,
+		0xac, 0x6b, 0xd4, 0x14, 0x0c, 0x2a, 0x3d, 0x78, 0x80, 0xb7, 0xe1, 0x34, 0x81, 0x4a, 0xbe, 0x05,
+		0xd4, 0x06, 0x6d, 0xd1, 0x1d, 0xc6, 0x39, 0x7b, 0xd7, 0xc0, 0xfb, 0xd4, 0xb1, 0x3b, 0x7d, 0xf9,
+		0x2d, 0xeb, 0x92, 0xaa, 0x31, 0x4a, 0x64, 0x3d, 0xf8, 0x5c, 0xe8, 0xb4, 0x38, 0xce, 0x1e, 0xd1,
+		0x47, 0x9f, 0xe4, 0xf9, 0x75, 0xa1, 0x9f, 0x04, 0x4a, 0x50, 0xcf, 0xdc, 0xb2, 0x4c, 0xf6, 0x7d,
+		0x46, 0xb1, 0xaf, 0x8b, 0xf3, 0xb3, 0x53, 0x37, 0x5f, 0x9c, 0x9d, 0xae, 0x43, 0xc6, 0x03, 0x65,
+		0x33, 0x24, 0x71, 0xd0, 0x19, 0x92, 0x76, 0x09, 0x20, 0x08, 0x7e, 0xc8, 0xd1, 0x9b, 0x83, 0x39,
+		0x10, 0x31, 0x2a, 0x63, 0x67, 0xb3, 0xbf, 0x33, 0x3e, 0x02, 0x78, 0xcd, 0xa2, 0xdd, 0x34, 0x75,
+		0x9b, 0xb6, 0xb6, 0x75, 0xc1, 0x39, 0xa4, 0x9b, 0x3c, 0xf8, 0x68, 0xce, 0xb4, 0x9b, 0x66, 0x8d,
+		0xb6, 0xb6, 0x97, 0x5c, 0x2a, 0xe4, 0x5d, 0x70, 0xcc, 0xeb, 0xbd, 0x65, 0xea, 0xbb, 0x56, 0xab,
+		0xa1, 0x77, 0xe9, 0xb6, 0x5e, 0x67, 0xaf, 0xfb, 0xa5, 0x18, 0xcf, 0x8e, 0xb8, 0x20, 0x55, 0xf3,
+		0xb2, 0xd5, 0x6a, 0x68, 0x74, 0xbb, 0x8c, 0xc5, 0x78, 0x90, 0xe6, 0x61, 0x37, 0x1b, 0x18, 0x95,
+		0x12, 0xc6, 0xd0, 0x78, 0x37, 0x73, 0xb9, 0x61, 0x2f, 0xc6, 0x3f, 0xf4, 0x89, 0x93, 0x53, 0xaf,
+		0x7f, 0xe2, 0xe4, 0x54, 0xe1, 0x22, 0x7b, 0xe5, 0x4a, 0xcc, 0x23, 0x6a, 0x93, 0xf3, 0x90, 0x30,
+		0x64, 0x82, 0xdf, 0x61, 0xdb, 0x67, 0x1e, 0x7a, 0xa0, 0x78, 0x7d, 0x2f, 0xb6, 0x74, 0x75, 0xdd,
+		0x68, 0x76, 0x49, 0x05, 0xdf, 0x35, 0x95, 0x82, 0x39, 0xe9, 0x94, 0xf6, 0x64, 0x59, 0xce, 0xe9,
+		0xb5, 0x51, 0xc1, 0x73, 0x89, 0xd2, 0xdd, 0xbf, 0xf7, 0xd9, 0x87, 0x8e, 0x0b, 0x32, 0x57, 0xfb,
+		0xe2, 0xe8, 0x24, 0xbd, 0xfe, 0xf8, 0x3a, 0x5f, 0x9f, 0xaf, 0xc0, 0x34, 0x6f, 0xaa, 0x4d, 0x9e,
+		0x82, 0x68, 0x07, 0x7f, 0xe4, 0x94, 0xc0, 0x53, 0xa7, 0x83, 0x02, 0xce, 0xe0, 0xfd, 0xe2, 0xc0,
+		0xf1, 0x0a, 0x1f, 0x0e, 0x01, 0x2c, 0x5d, 0xbd, 0xba, 0xd1, 0x6d, 0x76, 0x5a, 0xd4, 0x79, 0xab,
+		0xfa, 0xbe, 0x09, 0x87, 0xbc, 0xbe, 0xdb, 0xdd, 0xfa, 0xc1, 0xfb, 0x3f, 0xeb, 0x5d, 0xd4, 0xee,
+		0xd6, 0x87, 0x92, 0x6d, 0xd8, 0x8e, 0x4b, 0x36, 0x7c, 0x70, 0xb2, 0x4b, 0xb6, 0x33, 0xc8, 0xd9,
+		0xe7, 0x20, 0xe9, 0x31, 0xc3, 0x26, 0xcb, 0x10, 0x77, 0xc4, 0x6f, 0xc1, 0xe0, 0xc2, 0x68, 0x06,
+		0x4b, 0x34, 0x3f, 0x93, 0x5d, 0xf4, 0xc2, 0xdf, 0x28, 0x00, 0xbe, 0x39, 0xf2, 0xf6, 0x94, 0x31,
+		0x74, 0x20, 0x08, 0x4d, 0x1c, 0xbe, 0xe3, 0x37, 0x38, 0x39, 0x01, 0x1f, 0x53, 0x3f, 0x12, 0x82,
+		0xd9, 0x4d, 0x39, 0x7b, 0xdf, 0xfe, 0x3c, 0xd8, 0x84, 0x69, 0x6a, 0x3a, 0xdd, 0xa6, 0x7b, 0xaa,
+		0xf5, 0xf0, 0xa8, 0x31, 0x1f, 0xd2, 0xa9, 0x8a, 0xe9, 0x74, 0xf7, 0xfc, 0x12, 0x20, 0x69, 0xf9,
+		0xf8, 0xf1, 0xb1, 0x30, 0xe4, 0x46, 0xa1, 0xa2, 0x35, 0x5c, 0xef, 0x52, 0x96, 0x11, 0xbc, 0xb4,
+		0x9a, 0x91, 0xd9, 0x62, 0x8d, 0xd1, 0x00, 0xad, 0x32, 0x14, 0x2e, 0x04, 0xbd, 0x33, 0x33, 0x2c,
+		0xe3, 0x51, 0x60, 0xab, 0xcc, 0x06, 0x64, 0xe5, 0x2d, 0x98, 0x2d, 0xa3, 0x65, 0xe0, 0x0d, 0xf0,
+		0xf0, 0xc1, 0x97, 0x04, 0x79, 0x93, 0xa6, 0xc4, 0x49, 0x90, 0x0a, 0x4c, 0x4b, 0x6a, 0x91, 0x83,
+		0x53, 0x93, 0xb8, 0x78, 0xdb, 0xc9, 0xbf, 0x30, 0x30, 0xd3, 0x23, 0xa2, 0x25, 0xdd, 0xbc, 0xe5,
+		0xc6, 0xb8, 0x95, 0x27, 0xb6, 0xef, 0xca, 0x23, 0xac, 0xbb, 0x1f, 0x63, 0xc1, 0xea, 0x8d, 0xbf,
+		0xfd, 0xc3, 0xb2, 0x0e, 0xc0, 0xa7, 0x2a, 0x6a, 0xd2, 0x5c, 0xe4, 0x4e, 0xe7, 0x7b, 0x82, 0x13,
+		0x59, 0xb2, 0x9d, 0x6f, 0xd4, 0x08, 0xfd, 0x41, 0x08, 0x52, 0xfe, 0x11, 0xfa, 0x3b, 0xb9, 0x68,
+		0x91, 0x35, 0x4f, 0x4d, 0xf1, 0x0b, 0x3d, 0x0f, 0x8c, 0x52, 0x53, 0x03, 0xd2, 0x3c, 0x46, 0x3f,
+		0x7d, 0x5f, 0x1c, 0x62, 0x22, 0xe2, 0xae, 0x3a, 0x60, 0xc8, 0x2a, 0xe3, 0x9e, 0x24, 0x48, 0xcb,
+		0x27, 0x09, 0x86, 0xda, 0xb1, 0xf7, 0x02, 0x3e, 0x54, 0xa0, 0x07, 0xc2, 0xf8, 0xf0, 0xa8, 0x03,
+		0xf7, 0xb5, 0x6e, 0xef, 0x6d, 0x8c, 0x64, 0x44, 0x30, 0x4f, 0x0f, 0x23, 0x0c, 0xde, 0x9d, 0xaa,
+		0xf0, 0x1c, 0xf2, 0x10, 0x90, 0x5d, 0xd7, 0x31, 0xa1, 0x7b, 0x8c, 0x40, 0xb8, 0x19, 0xaf, 0x44,
+		0x82, 0xe3, 0x9b, 0x2c, 0x96, 0xd9, 0xd0, 0xf9, 0xb3, 0xd3, 0xe2, 0x51, 0x6f, 0xcc, 0x59, 0xc2,
+		0x0c, 0xf2, 0x41, 0x85, 0xdb, 0xc3, 0x7d, 0xdb, 0x66, 0xb1, 0x1d, 0xd9, 0x98, 0x60, 0x52, 0xfc,
+		0xe5, 0xab, 0x27, 0xf3, 0x7b, 0x46, 0xbb, 0xb5, 0x58, 0x18, 0x42, 0xa7, 0x30, 0x6c, 0x27, 0x8f,
+		0x86, 0x73, 0x70, 0xdb, 0x4d, 0x96, 0x41, 0xc5, 0xf7, 0x17, 0xba, 0xe2, 0xeb, 0xe5, 0xfa, 0x36,
+		0xa5, 0x62, 0xe3, 0x72, 0x74, 0x61, 0xc8, 0x23, 0xe0, 0x0b, 0xf8, 0x98, 0xb6, 0x38, 0x02, 0xcb,
+		0x5c, 0xa3, 0x7b, 0x9a, 0xc0, 0xbb, 0x48, 0x29, 0xf9, 0xff, 0xe0, 0xee, 0x21, 0x06, 0xbe, 0xbe,
+		0xd5, 0xa5, 0x46, 0x7d, 0x57, 0x37, 0xd8, 0x67, 0xb3, 0xd9, 0xee, 0x25, 0x73, 0xf6, 0xdc, 0x28,
+		0xb9, 0x59, 0xed, 0xb7, 0xec, 0x4b, 0x0c, 0xbb, 0xc8, 0x90, 0xb5, 0xe3, 0xed, 0xfd, 0x8a, 0xc9,
+		0x4b, 0x70, 0x74, 0xa7, 0x65, 0x6d, 0xe1, 0x5d, 0xb6, 0xe6, 0x4b, 0xbd, 0x66, 0x43, 0x17, 0xd4,
+		0xf5, 0xba, 0xd1, 0xc9, 0x25, 0xde, 0x94, 0x0f, 0xe4, 0x30, 0x27, 0xbc, 0xc2, 0xe8, 0xd6, 0x38,
+		0xd9, 0xb2, 0xd1, 0x21, 0x37, 0xe0, 0x2e, 0x6f, 0xa2, 0x0d, 0xa9, 0x15, 0xde, 0x54, 0xad, 0x47,
+		0x5d, 0xda, 0x03, 0x15, 0x3f, 0x0d, 0x85, 0x80, 0x50, 0xeb, 0x5d, 0xdf, 0x8c, 0x73, 0x85, 0x33,
+		0xc9, 0x84, 0xf3, 0xa4, 0x5f, 0xd0, 0xfb, 0x67, 0x26, 0x8a, 0xaa, 0x05, 0x27, 0x7d, 0xe2, 0x23,
+		0x3c, 0x47, 0xa6, 0xe5, 0x34, 0xeb, 0x54, 0xef, 0xd0, 0x6e, 0xd3, 0x6a, 0xe4, 0x52, 0x07, 0x9c,
+		0x83, 0x77, 0x79, 0x04, 0xb9, 0xef, 0x68, 0x8d, 0x91, 0x5b, 0x67, 0xd4, 0x16, 0xdf, 0x81, 0x4a,
+		0xf5, 0xd6, 0x6b, 0x3f, 0x7f, 0xfa, 0x98, 0xef, 0xed, 0xf3, 0x9b, 0xae, 0x1b, 0x97, 0x6b, 0x02,
+		0xdc, 0x1f, 0x11, 0x6f, 0xa8, 0xdd, 0x1b, 0x22, 0xab, 0x00, 0xbe, 0xfd, 0xa3, 0xb2, 0xff, 0xbe,
+		0xd4, 0x27, 0x2a, 0xfe, 0x7d, 0xa9, 0x4f, 0x93, 0xbf, 0xc7, 0x33, 0x15, 0x42, 0xe3, 0x04, 0xdf,
+		0xaf, 0xc4, 0x04, 0x12, 0x5b, 0x20, 0xa6, 0x0a, 0xbf, 0xa3, 0x60, 0x20, 0x61, 0x9f, 0xd2, 0x73,
+		0x9b, 0x5c, 0x07, 0x32, 0x30, 0x3e, 0x32, 0x24, 0xe1, 0xce, 0x74, 0xe8, 0x4c, 0xb7, 0xbf, 0xf4,
+		0x2d, 0xb2, 0x79, 0xc4, 0x82, 0xf7, 0x9b, 0x0a, 0x5e, 0x8d, 0x6a, 0x0c, 0x72, 0xbf, 0x06, 0x29,
+		0x7f, 0xd5, 0xa2, 0x13, 0xef, 0x98, 0xa4, 0x13, 0xfe, 0xf6, 0x07, 0x88, 0x90, 0xab, 0xde, 0xc2,
+		0xc2, 0xfd, 0xc7, 0x8f, 0x4c, 0xcc, 0x14, 0xd9, 0xb0, 0xa1, 0x0b, 0x0c, 0x1f, 0x9b, 0x3f, 0x51,
+		0x20, 0xb2, 0x6e, 0x59, 0x2d, 0xf2, 0x12, 0xcc, 0x98, 0x96, 0xa3, 0xa3, 0x12, 0xa6, 0x0d, 0x5d,
+		0xb8, 0x93, 0xf8, 0xa2, 0x5d, 0xd9, 0x97, 0x57, 0x5f, 0x7e, 0xf5, 0xe4, 0x20, 0xe6, 0xb0, 0xef,
+		0x0f, 0x64, 0x4d, 0xcb, 0x29, 0x31, 0xa0, 0x0d, 0x06, 0x43, 0xb6, 0x21, 0x1d, 0xac, 0x8e, 0x2f,
+		0xec, 0xc5, 0x71, 0xd5, 0xa5, 0xc7, 0x56, 0x95, 0xda, 0xf2, 0xd5, 0xc3, 0x9f, 0x13, 0xff, 0x73,
+		0x1c, 0xb9, 0xf7, 0x82, 0x7a, 0xb5, 0x3f, 0xd4, 0xad, 0x02, 0xd3, 0x32, 0xb4, 0x4d, 0x99, 0x30,
+		0x6a, 0xce, 0xcf, 0x4e, 0x81, 0xcb, 0xfc, 0xf3, 0x9f, 0x0f, 0xc1, 0x51, 0x8c, 0x82, 0x13, 0xbe,
+		0x3f, 0xa1, 0xff, 0xb9, 0xc7, 0x7e, 0x0f, 0x1d, 0x56, 0x43, 0x3d, 0x93, 0xa9, 0x41, 0xff, 0xe3,
+		0x55, 0xc8, 0xa2, 0x21, 0x86, 0xbe, 0xc3, 0x37, 0xe7, 0x7e, 0x4c, 0x5b, 0xad, 0x86, 0x68, 0x11,
+		0x3a, 0x1f, 0xaf, 0x42, 0xd6, 0xa4, 0x37, 0x02, 0x74, 0xc3, 0x77, 0x46, 0xd7, 0xa4, 0x37, 0x7c,
+		0x74, 0xbd, 0xc8, 0x8a, 0x48, 0x20, 0xb2, 0xe2, 0x3c, 0x84, 0x71, 0xd1, 0x8c, 0x1e, 0x40, 0x77,
+		0x20, 0x82, 0xcf, 0xf8, 0xa9, 0xc1, 0x51, 0xe1, 0x4f, 0xb2, 0xab, 0xdb, 0x8c, 0xa3, 0x94, 0x75,
+		0xe8, 0x69, 0xba, 0x37, 0xc4, 0xb9, 0x94, 0x9a, 0xcc, 0xb9, 0xf4, 0x55, 0x05, 0x66, 0x99, 0x6c,
+		0x34, 0x5f, 0xa6, 0xcc, 0x3f, 0x29, 0x8e, 0x52, 0x32, 0x10, 0x12, 0x6f, 0xcd, 0x45, 0xb4, 0x50,
+		0xb3, 0x81, 0xcf, 0xb1, 0x59, 0x37, 0x4c, 0x11, 0xe5, 0xb4, 0x9f, 0xf1, 0xca, 0xc1, 0x98, 0x35,
+		0x65, 0x35, 0x7a, 0x2d, 0xaa, 0x1b, 0x75, 0x6e, 0x40, 0x73, 0xaf, 0x77, 0x9a, 0xe7, 0x16, 0x79,
+		0x66, 0xf0, 0x4d, 0xae, 0xc8, 0xa4, 0x56, 0xa7, 0x87, 0x83, 0xfd, 0x76, 0xad, 0xe5, 0x5c, 0x74,
+		0x4c, 0xdb, 0x3c, 0x50, 0xa1, 0xbe, 0x3e, 0x1e, 0x82, 0xa3, 0xb5, 0xfa, 0x2e, 0xc5, 0x26, 0x35,
+		0xca, 0x7d, 0x4b, 0xd1, 0xf0, 0xed, 0xbb, 0x72, 0xe7, 0xdb, 0x77, 0x1d, 0xb2, 0xfd, 0x66, 0xdc,
+		0x9b, 0x3b, 0xcc, 0xc9, 0x04, 0x0f, 0x3f, 0xd0, 0xb9, 0x4b, 0xb7, 0xb7, 0x69, 0xdd, 0x69, 0x5e,
+		0x17, 0xc7, 0x1f, 0xe1, 0x03, 0x3b, 0x77, 0x5d, 0x02, 0x08, 0x72, 0xfa, 0x97, 0x14, 0x00, 0xcf,
+		0xcb, 0x8e, 0x8f, 0xe4, 0x95, 0xaa, 0x6b, 0x4b, 0x7a, 0x6d, 0xa3, 0xb8, 0xb1, 0x59, 0x0b, 0x7e,
+		0x80, 0x4a, 0x3e, 0xa9, 0x67, 0x77, 0x68, 0xbd, 0xb9, 0xdd, 0xa4, 0x0d, 0x72, 0x1f, 0xcc, 0x05,
+		0xa1, 0x31, 0x55, 0x59, 0x52, 0x95, 0x7c, 0xea, 0xd6, 0xed, 0xf9, 0x38, 0x77, 0x34, 0x50, 0x7c,
+		0x20, 0xfa, 0xd0, 0x20, 0x1c, 0x7e, 0xbc, 0x2a, 0x94, 0x4f, 0xdf, 0xba, 0x3d, 0x9f, 0x70, 0x3d,
+		0x12, 0xa4, 0x00, 0xc4, 0x0f, 0x29, 0xe8, 0x85, 0xf3, 0x70, 0xeb, 0xf6, 0x7c, 0x8c, 0x2b, 0xd4,
+		0x7c, 0x04, 0x1f, 0xce, 0x3b, 0xfd, 0x39, 0x05, 0x8e, 0xef, 0x6b, 0x25, 0x92, 0x6f, 0x82, 0xfb,
+		0x56, 0x97, 0xd7, 0xf4, 0x5a, 0x65, 0xe5, 0xa2, 0xbe, 0x54, 0x59, 0xa9, 0x5c, 0x2a, 0x6e, 0x2c,
+		0x57, 0xd7, 0xf4, 0x92, 0x56, 0x29, 0x96, 0x2f, 0xeb, 0xc5, 0x32, 0x4b, 0x5d, 0x29, 0x2e, 0xaf,
+		0xa8, 0x53, 0xf9, 0xb9, 0x5b, 0xb7, 0xe7, 0x55, 0x3f, 0xf6, 0x15, 0xa3, 0x89, 0xd1, 0xe7, 0x0b,
+		0xe3, 0x29, 0x5c, 0xac, 0x6a, 0xe5, 0x8a, 0xe8, 0x93, 0xaa, 0xe4, 0x8f, 0xdd, 0xba, 0x3d, 0x7f,
+		0xc4, 0x4f, 0xe9, 0xa2, 0xd5, 0xad, 0x53, 0xde, 0x43, 0xd1, 0xf4, 0xf7, 0x02, 0x2c, 0x9b, 0xdb,
+		0x5d, 0x6e, 0x09, 0x93, 0x3c, 0x1c, 0x5e, 0x5e, 0xbb, 0xa8, 0x09, 0x6a, 0x01, 0x8e, 0xf7, 0x95,
+		0x2d, 0x55, 0x37, 0x4b, 0x2b, 0x15, 0x1d, 0x5f, 0x19, 0xe4, 0xa1, 0x4f, 0x81, 0xb2, 0x67, 0xd7,
+		0x36, 0x96, 0x57, 0x2b, 0x6a, 0xa8, 0x74, 0x7e, 0xe4, 0xd1, 0xfa, 0x5d, 0x01, 0xf1, 0xf3, 0xec,
+		0xac, 0xc0, 0x79, 0xfa, 0xff, 0x1e, 0x00, 0x52, 0x33, 0x7a, 0xe6, 0xc1, 0xb6, 0x00, 0x00,
 	}
 	r := bytes.NewReader(gzipped)
 	gzipr, err := compress_gzip.NewReader(r)
@@ -2526,6 +2871,21 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.KeyRotationFee.Equal(&that1.KeyRotationFee) {
 		return false
 	}
+	if this.MinSelfDelegationBreachAction != that1.MinSelfDelegationBreachAction {
+		return false
+	}
+	if !this.GlobalLiquidStakingCap.Equal(that1.GlobalLiquidStakingCap) {
+		return false
+	}
+	if !this.ValidatorLiquidStakingCap.Equal(that1.ValidatorLiquidStakingCap) {
+		return false
+	}
+	if this.MaxValidatorRedelegationEntries != that1.MaxValidatorRedelegationEntries {
+		return false
+	}
+	if this.CommissionChangeNoticePeriod != that1.CommissionChangeNoticePeriod {
+		return false
+	}
 	return true
 }
 func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
@@ -2582,6 +2942,42 @@ func (this *Pool) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *TokenizeShareRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TokenizeShareRecord)
+	if !ok {
+		that2, ok := that.(TokenizeShareRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Owner != that1.Owner {
+		return false
+	}
+	if this.ModuleAccount != that1.ModuleAccount {
+		return false
+	}
+	if this.Validator != that1.Validator {
+		return false
+	}
+	if this.Delegator != that1.Delegator {
+		return false
+	}
+	return true
+}
 func (m *HistoricalInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3466,6 +3862,44 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n13, err13 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.CommissionChangeNoticePeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CommissionChangeNoticePeriod):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintStaking(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x62
+	if m.MaxValidatorRedelegationEntries != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.MaxValidatorRedelegationEntries))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size := m.ValidatorLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.ValidatorLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.GlobalLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.GlobalLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.MinSelfDelegationBreachAction != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.MinSelfDelegationBreachAction))
+		i--
+		dAtA[i] = 0x40
+	}
 	{
 		size, err := m.KeyRotationFee.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -3508,12 +3942,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x10
 	}
-	n14, err14 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime):])
-	if err14 != nil {
-		return 0, err14
+	n15, err15 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime):])
+	if err15 != nil {
+		return 0, err15
 	}
-	i -= n14
-	i = encodeVarintStaking(dAtA, i, uint64(n14))
+	i -= n15
+	i = encodeVarintStaking(dAtA, i, uint64(n15))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -3833,6 +4267,110 @@ func (m *ValAddrsOfRotatedConsKeys) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *TokenizeShareRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenizeShareRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenizeShareRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ModuleAccount) > 0 {
+		i -= len(m.ModuleAccount)
+		copy(dAtA[i:], m.ModuleAccount)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ModuleAccount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledCommissionChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledCommissionChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledCommissionChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n23, err23 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EffectiveTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EffectiveTime):])
+	if err23 != nil {
+		return 0, err23
+	}
+	i -= n23
+	i = encodeVarintStaking(dAtA, i, uint64(n23))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.CommissionRate.Size()
+		i -= size
+		if _, err := m.CommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
 	offset -= sovStaking(v)
 	base := offset
@@ -4212,6 +4750,18 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovStaking(uint64(l))
 	l = m.KeyRotationFee.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	if m.MinSelfDelegationBreachAction != 0 {
+		n += 1 + sovStaking(uint64(m.MinSelfDelegationBreachAction))
+	}
+	l = m.GlobalLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.ValidatorLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if m.MaxValidatorRedelegationEntries != 0 {
+		n += 1 + sovStaking(uint64(m.MaxValidatorRedelegationEntries))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CommissionChangeNoticePeriod)
+	n += 1 + l + sovStaking(uint64(l))
 	return n
 }
 
@@ -4327,15 +4877,60 @@ func (m *ValAddrsOfRotatedConsKeys) Size() (n int) {
 	return n
 }
 
-func sovStaking(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozStaking(x uint64) (n int) {
-	return sovStaking(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *HistoricalInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
+func (m *TokenizeShareRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovStaking(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ModuleAccount)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *ScheduledCommissionChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.CommissionRate.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EffectiveTime)
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func sovStaking(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStaking(x uint64) (n int) {
+	return sovStaking(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *HistoricalInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
@@ -7174,6 +7769,145 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegationBreachAction", wireType)
+			}
+			m.MinSelfDelegationBreachAction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinSelfDelegationBreachAction |= MinSelfDelegationBreachAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalLiquidStakingCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GlobalLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorLiquidStakingCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidatorRedelegationEntries", wireType)
+			}
+			m.MaxValidatorRedelegationEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxValidatorRedelegationEntries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionChangeNoticePeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.CommissionChangeNoticePeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -8037,6 +8771,352 @@ func (m *ValAddrsOfRotatedConsKeys) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TokenizeShareRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenizeShareRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenizeShareRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledCommissionChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledCommissionChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledCommissionChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EffectiveTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipStaking(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
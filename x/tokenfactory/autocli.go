@@ -0,0 +1,84 @@
+package tokenfactory
+
+import (
+	"fmt"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	tokenfactoryv1 "cosmossdk.io/api/cosmos/tokenfactory/v1"
+
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: tokenfactoryv1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the current tokenfactory parameters",
+				},
+				{
+					RpcMethod:      "DenomAuthorityMetadata",
+					Use:            "denom-authority-metadata [denom]",
+					Short:          "Query the authority metadata, i.e. the admin, of a factory denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod:      "DenomsFromCreator",
+					Use:            "denoms-from-creator [creator]",
+					Short:          "Query the denoms created by an address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "creator"}},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: tokenfactoryv1.Msg_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "CreateDenom",
+					Use:            "create-denom [sender] [subdenom]",
+					Short:          "Create the denom factory/{sender}/{subdenom}, paying the denom creation fee",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "sender"}, {ProtoField: "subdenom"}},
+				},
+				{
+					RpcMethod:      "Mint",
+					Use:            "mint [sender] [amount]",
+					Short:          "Mint coins of a factory denom administered by the sender",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "sender"}, {ProtoField: "amount"}},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"mint_to_address": {Name: "mint-to", Usage: "address receiving the minted coins, defaults to the sender"},
+					},
+				},
+				{
+					RpcMethod:      "Burn",
+					Use:            "burn [sender] [amount]",
+					Short:          "Burn coins of a factory denom administered by the sender from the sender balance",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "sender"}, {ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "ChangeAdmin",
+					Use:            "change-admin [sender] [denom] [new-admin]",
+					Short:          "Transfer the admin rights of a factory denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "sender"}, {ProtoField: "denom"}, {ProtoField: "new_admin"}},
+				},
+				{
+					RpcMethod:      "SetMetadata",
+					Use:            "set-metadata [sender] [metadata]",
+					Short:          "Set the bank metadata of a factory denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "sender"}, {ProtoField: "metadata"}},
+				},
+				{
+					RpcMethod:      "UpdateParams",
+					Use:            "update-params-proposal [params]",
+					Short:          "Submit a proposal to update tokenfactory module params. Note: the entire params must be provided.",
+					Long:           fmt.Sprintf("Submit a proposal to update tokenfactory module params. Note: the entire params must be provided.\n See the fields to fill in by running `%s query tokenfactory params --output json`", version.AppName),
+					Example:        fmt.Sprintf(`%s tx tokenfactory update-params-proposal '{ "denom_creation_fee": [{"denom": "stake", "amount": "1000"}] }'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
+					GovProposal:    true,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	banktypes "cosmossdk.io/x/bank/types"
+	"cosmossdk.io/x/tokenfactory/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CreateDenom creates the denom factory/{creator}/{subdenom} administered by the
+// creator, who pays the denom creation fee. The fee is burned.
+func (k Keeper) CreateDenom(ctx context.Context, creator, subdenom string) (string, error) {
+	creatorAddr, err := k.ak.AddressCodec().StringToBytes(creator)
+	if err != nil {
+		return "", errorsmod.Wrapf(types.ErrInvalidAddress, "invalid creator address: %s", err)
+	}
+
+	denom, err := types.GetTokenDenom(creator, subdenom)
+	if err != nil {
+		return "", err
+	}
+
+	has, err := k.DenomAuthority.Has(ctx, denom)
+	if err != nil {
+		return "", err
+	}
+	if has || k.bk.HasSupply(ctx, denom) {
+		return "", errorsmod.Wrapf(types.ErrDenomExists, "denom %s", denom)
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return "", err
+	}
+	if !params.DenomCreationFee.Empty() {
+		if err := k.bk.BurnCoins(ctx, creatorAddr, params.DenomCreationFee); err != nil {
+			return "", errorsmod.Wrap(err, "unable to pay the denom creation fee")
+		}
+	}
+
+	if err := k.setDenom(ctx, creatorAddr, denom, types.DenomAuthorityMetadata{Admin: creator}); err != nil {
+		return "", err
+	}
+
+	// the base unit metadata is set so that the denom is known to bank, it can
+	// be completed by the admin with MsgSetMetadata
+	if _, found := k.bk.GetDenomMetaData(ctx, denom); !found {
+		k.bk.SetDenomMetaData(ctx, banktypes.Metadata{
+			Base:       denom,
+			Display:    denom,
+			Name:       denom,
+			Symbol:     denom,
+			DenomUnits: []*banktypes.DenomUnit{{Denom: denom, Exponent: 0}},
+		})
+	}
+
+	if err := k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeCreateDenom,
+		event.NewAttribute(types.AttributeKeyCreator, creator),
+		event.NewAttribute(types.AttributeKeyNewTokenDenom, denom),
+	); err != nil {
+		return "", err
+	}
+
+	return denom, nil
+}
+
+// GetAuthorityMetadata returns the authority metadata of a factory denom.
+func (k Keeper) GetAuthorityMetadata(ctx context.Context, denom string) (types.DenomAuthorityMetadata, error) {
+	metadata, err := k.DenomAuthority.Get(ctx, denom)
+	if errorsmod.IsOf(err, collections.ErrNotFound) {
+		return types.DenomAuthorityMetadata{}, errorsmod.Wrapf(types.ErrDenomNotFound, "denom %s", denom)
+	}
+	return metadata, err
+}
+
+// GetDenomsFromCreator returns the denoms created by an address.
+func (k Keeper) GetDenomsFromCreator(ctx context.Context, creator sdk.AccAddress) ([]string, error) {
+	var denoms []string
+	err := k.CreatorDenoms.Walk(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, string](creator), func(key collections.Pair[sdk.AccAddress, string]) (bool, error) {
+		denoms = append(denoms, key.K2())
+		return false, nil
+	})
+	return denoms, err
+}
+
+// setDenom records a factory denom, its authority metadata and its creator.
+func (k Keeper) setDenom(ctx context.Context, creator sdk.AccAddress, denom string, metadata types.DenomAuthorityMetadata) error {
+	if err := k.DenomAuthority.Set(ctx, denom, metadata); err != nil {
+		return err
+	}
+
+	return k.CreatorDenoms.Set(ctx, collections.Join(creator, denom))
+}
@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/x/tokenfactory/types"
+)
+
+// InitGenesis initializes the tokenfactory module's state from a given genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) error {
+	if err := k.Params.Set(ctx, data.Params); err != nil {
+		return err
+	}
+
+	for _, denom := range data.FactoryDenoms {
+		creator, _, err := types.DeconstructDenom(denom.Denom)
+		if err != nil {
+			return err
+		}
+
+		creatorAddr, err := k.ak.AddressCodec().StringToBytes(creator)
+		if err != nil {
+			return err
+		}
+
+		if err := k.setDenom(ctx, creatorAddr, denom.Denom, denom.AuthorityMetadata); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	denoms := []types.GenesisDenom{}
+	err = k.DenomAuthority.Walk(ctx, nil, func(denom string, metadata types.DenomAuthorityMetadata) (bool, error) {
+		denoms = append(denoms, types.GenesisDenom{Denom: denom, AuthorityMetadata: metadata})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewGenesisState(params, denoms), nil
+}
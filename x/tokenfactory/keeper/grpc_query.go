@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/x/tokenfactory/types"
+)
+
+var _ types.QueryServer = queryServer{}
+
+func NewQueryServerImpl(k Keeper) types.QueryServer {
+	return queryServer{k}
+}
+
+type queryServer struct {
+	k Keeper
+}
+
+// Params returns params of the tokenfactory module.
+func (q queryServer) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// DenomAuthorityMetadata returns the authority metadata of a factory denom.
+func (q queryServer) DenomAuthorityMetadata(ctx context.Context, req *types.QueryDenomAuthorityMetadataRequest) (*types.QueryDenomAuthorityMetadataResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	metadata, err := q.k.GetAuthorityMetadata(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDenomAuthorityMetadataResponse{AuthorityMetadata: metadata}, nil
+}
+
+// DenomsFromCreator returns the denoms created by an address.
+func (q queryServer) DenomsFromCreator(ctx context.Context, req *types.QueryDenomsFromCreatorRequest) (*types.QueryDenomsFromCreatorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	creator, err := q.k.ak.AddressCodec().StringToBytes(req.Creator)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid creator address: %s", err)
+	}
+
+	denoms, err := q.k.GetDenomsFromCreator(ctx, creator)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDenomsFromCreatorResponse{Denoms: denoms}, nil
+}
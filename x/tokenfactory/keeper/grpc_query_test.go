@@ -0,0 +1,31 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/x/tokenfactory/types"
+)
+
+func (s *KeeperTestSuite) TestGRPCQueries() {
+	denom := s.createDenom("bitcoin")
+
+	params, err := s.queryServer.Params(s.ctx, &types.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Empty(params.Params.DenomCreationFee)
+
+	metadata, err := s.queryServer.DenomAuthorityMetadata(s.ctx, &types.QueryDenomAuthorityMetadataRequest{Denom: denom})
+	s.Require().NoError(err)
+	s.Require().Equal(s.creator, metadata.AuthorityMetadata.Admin)
+
+	_, err = s.queryServer.DenomAuthorityMetadata(s.ctx, &types.QueryDenomAuthorityMetadataRequest{Denom: "stake"})
+	s.Require().ErrorIs(err, types.ErrDenomNotFound)
+
+	denoms, err := s.queryServer.DenomsFromCreator(s.ctx, &types.QueryDenomsFromCreatorRequest{Creator: s.creator})
+	s.Require().NoError(err)
+	s.Require().Equal([]string{denom}, denoms.Denoms)
+
+	denoms, err = s.queryServer.DenomsFromCreator(s.ctx, &types.QueryDenomsFromCreatorRequest{Creator: s.receiver})
+	s.Require().NoError(err)
+	s.Require().Empty(denoms.Denoms)
+
+	_, err = s.queryServer.DenomsFromCreator(s.ctx, &types.QueryDenomsFromCreatorRequest{Creator: "invalid"})
+	s.Require().Error(err)
+}
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/log"
+	"cosmossdk.io/x/tokenfactory/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Keeper of the tokenfactory store
+type Keeper struct {
+	cdc         codec.BinaryCodec
+	environment appmodule.Environment
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+
+	ak types.AccountKeeper
+	bk types.BankKeeper
+
+	Schema collections.Schema
+	Params collections.Item[types.Params]
+	// DenomAuthority key: denom | value: the authority metadata of the denom
+	DenomAuthority collections.Map[string, types.DenomAuthorityMetadata]
+	// CreatorDenoms key: creator address + denom, indexing the denoms by creator
+	CreatorDenoms collections.KeySet[collections.Pair[sdk.AccAddress, string]]
+}
+
+// NewKeeper creates a new tokenfactory Keeper instance
+func NewKeeper(cdc codec.BinaryCodec, env appmodule.Environment, ak types.AccountKeeper, bk types.BankKeeper, authority string) Keeper {
+	sb := collections.NewSchemaBuilder(env.KVStoreService)
+	k := Keeper{
+		cdc:            cdc,
+		environment:    env,
+		authority:      authority,
+		ak:             ak,
+		bk:             bk,
+		Params:         collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		DenomAuthority: collections.NewMap(sb, types.DenomAuthorityPrefix, "denom_authority", collections.StringKey, codec.CollValue[types.DenomAuthorityMetadata](cdc)),
+		CreatorDenoms:  collections.NewKeySet(sb, types.CreatorDenomsPrefix, "creator_denoms", collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey)),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+	return k
+}
+
+// GetAuthority returns the x/tokenfactory module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx context.Context) log.Logger {
+	return k.environment.Logger.With("module", "x/"+types.ModuleName)
+}
@@ -0,0 +1,139 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	authtypes "cosmossdk.io/x/auth/types"
+	banktypes "cosmossdk.io/x/bank/types"
+	"cosmossdk.io/x/tokenfactory"
+	"cosmossdk.io/x/tokenfactory/keeper"
+	tftestutil "cosmossdk.io/x/tokenfactory/testutil"
+	"cosmossdk.io/x/tokenfactory/types"
+
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx               sdk.Context
+	addressCodec      address.Codec
+	tokenFactory      keeper.Keeper
+	bankKeeper        *tftestutil.MockBankKeeper
+	msgServer         types.MsgServer
+	queryServer       types.QueryServer
+	authority         string
+	creator, receiver string
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, tokenfactory.AppModule{})
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	env := runtime.NewEnvironment(runtime.NewKVStoreService(key), log.NewNopLogger())
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	s.ctx = testCtx.Ctx
+	s.addressCodec = addresscodec.NewBech32Codec("cosmos")
+
+	ctrl := gomock.NewController(s.T())
+	accountKeeper := tftestutil.NewMockAccountKeeper(ctrl)
+	accountKeeper.EXPECT().AddressCodec().Return(s.addressCodec).AnyTimes()
+	s.bankKeeper = tftestutil.NewMockBankKeeper(ctrl)
+
+	var err error
+	s.authority, err = s.addressCodec.BytesToString(authtypes.NewModuleAddress(types.GovModuleName))
+	s.Require().NoError(err)
+	s.creator, err = s.addressCodec.BytesToString(sdk.AccAddress("creator"))
+	s.Require().NoError(err)
+	s.receiver, err = s.addressCodec.BytesToString(sdk.AccAddress("receiver"))
+	s.Require().NoError(err)
+
+	s.tokenFactory = keeper.NewKeeper(encCfg.Codec, env, accountKeeper, s.bankKeeper, s.authority)
+	s.Require().NoError(s.tokenFactory.InitGenesis(s.ctx, types.DefaultGenesisState()))
+	s.msgServer = keeper.NewMsgServerImpl(s.tokenFactory)
+	s.queryServer = keeper.NewQueryServerImpl(s.tokenFactory)
+}
+
+// createDenom creates the denom factory/{creator}/{subdenom} without fee.
+func (s *KeeperTestSuite) createDenom(subdenom string) string {
+	denom := "factory/" + s.creator + "/" + subdenom
+	s.bankKeeper.EXPECT().HasSupply(s.ctx, denom).Return(false)
+	s.bankKeeper.EXPECT().GetDenomMetaData(s.ctx, denom).Return(banktypes.Metadata{}, false)
+	s.bankKeeper.EXPECT().SetDenomMetaData(s.ctx, gomock.Any())
+
+	newDenom, err := s.tokenFactory.CreateDenom(s.ctx, s.creator, subdenom)
+	s.Require().NoError(err)
+	s.Require().Equal(denom, newDenom)
+	return denom
+}
+
+func (s *KeeperTestSuite) TestCreateDenom() {
+	denom := s.createDenom("bitcoin")
+
+	metadata, err := s.tokenFactory.GetAuthorityMetadata(s.ctx, denom)
+	s.Require().NoError(err)
+	s.Require().Equal(s.creator, metadata.Admin)
+
+	denoms, err := s.tokenFactory.GetDenomsFromCreator(s.ctx, sdk.AccAddress("creator"))
+	s.Require().NoError(err)
+	s.Require().Equal([]string{denom}, denoms)
+
+	// a denom cannot be created twice
+	_, err = s.tokenFactory.CreateDenom(s.ctx, s.creator, "bitcoin")
+	s.Require().ErrorIs(err, types.ErrDenomExists)
+
+	// nor can an existing bank denom be shadowed
+	s.bankKeeper.EXPECT().HasSupply(s.ctx, "factory/"+s.creator+"/litecoin").Return(true)
+	_, err = s.tokenFactory.CreateDenom(s.ctx, s.creator, "litecoin")
+	s.Require().ErrorIs(err, types.ErrDenomExists)
+
+	_, err = s.tokenFactory.CreateDenom(s.ctx, s.creator, "")
+	s.Require().ErrorIs(err, types.ErrInvalidDenom)
+
+	_, err = s.tokenFactory.CreateDenom(s.ctx, "invalid", "bitcoin")
+	s.Require().ErrorIs(err, types.ErrInvalidAddress)
+
+	// the creation fee is burned from the creator
+	fee := sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+	s.Require().NoError(s.tokenFactory.Params.Set(s.ctx, types.NewParams(fee)))
+	s.bankKeeper.EXPECT().BurnCoins(s.ctx, sdk.AccAddress("creator"), fee).Return(nil)
+	s.createDenom("ether")
+
+	denoms, err = s.tokenFactory.GetDenomsFromCreator(s.ctx, sdk.AccAddress("creator"))
+	s.Require().NoError(err)
+	s.Require().Equal([]string{denom, "factory/" + s.creator + "/ether"}, denoms)
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	denom := s.createDenom("bitcoin")
+
+	exported, err := s.tokenFactory.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().NoError(types.ValidateGenesis(*exported))
+	s.Require().Equal([]types.GenesisDenom{{Denom: denom, AuthorityMetadata: types.DenomAuthorityMetadata{Admin: s.creator}}}, exported.FactoryDenoms)
+
+	s.SetupTest()
+	s.Require().NoError(s.tokenFactory.InitGenesis(s.ctx, exported))
+
+	imported, err := s.tokenFactory.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(exported, imported)
+
+	denoms, err := s.tokenFactory.GetDenomsFromCreator(s.ctx, sdk.AccAddress("creator"))
+	s.Require().NoError(err)
+	s.Require().Equal([]string{denom}, denoms)
+}
@@ -0,0 +1,186 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/tokenfactory/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ types.MsgServer = msgServer{}
+
+// msgServer is a wrapper of Keeper.
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the x/tokenfactory MsgServer interface.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+// CreateDenom creates a new factory denom administered by the sender.
+func (ms msgServer) CreateDenom(ctx context.Context, msg *types.MsgCreateDenom) (*types.MsgCreateDenomResponse, error) {
+	denom, err := ms.Keeper.CreateDenom(ctx, msg.Sender, msg.Subdenom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateDenomResponse{NewTokenDenom: denom}, nil
+}
+
+// Mint mints coins of a factory denom administered by the sender.
+func (ms msgServer) Mint(ctx context.Context, msg *types.MsgMint) (*types.MsgMintResponse, error) {
+	if err := ms.checkAdmin(ctx, msg.Sender, msg.Amount.Denom); err != nil {
+		return nil, err
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAmount, "amount %s must be positive", msg.Amount)
+	}
+
+	mintTo := msg.MintToAddress
+	if mintTo == "" {
+		mintTo = msg.Sender
+	}
+	mintToAddr, err := ms.ak.AddressCodec().StringToBytes(mintTo)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAddress, "invalid mint to address: %s", err)
+	}
+
+	coins := sdk.NewCoins(msg.Amount)
+	if err := ms.bk.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return nil, err
+	}
+
+	if err := ms.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mintToAddr, coins); err != nil {
+		return nil, err
+	}
+
+	if err := ms.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeMint,
+		event.NewAttribute(types.AttributeKeyMintToAddress, mintTo),
+		event.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgMintResponse{}, nil
+}
+
+// Burn burns coins of a factory denom administered by the sender, from the
+// balance of the sender.
+func (ms msgServer) Burn(ctx context.Context, msg *types.MsgBurn) (*types.MsgBurnResponse, error) {
+	if err := ms.checkAdmin(ctx, msg.Sender, msg.Amount.Denom); err != nil {
+		return nil, err
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAmount, "amount %s must be positive", msg.Amount)
+	}
+
+	senderAddr, err := ms.ak.AddressCodec().StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAddress, "invalid sender address: %s", err)
+	}
+
+	if err := ms.bk.BurnCoins(ctx, senderAddr, sdk.NewCoins(msg.Amount)); err != nil {
+		return nil, err
+	}
+
+	if err := ms.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeBurn,
+		event.NewAttribute(types.AttributeKeyBurnFrom, msg.Sender),
+		event.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBurnResponse{}, nil
+}
+
+// ChangeAdmin transfers the admin rights of a factory denom administered by the
+// sender. An empty new admin renounces them.
+func (ms msgServer) ChangeAdmin(ctx context.Context, msg *types.MsgChangeAdmin) (*types.MsgChangeAdminResponse, error) {
+	if err := ms.checkAdmin(ctx, msg.Sender, msg.Denom); err != nil {
+		return nil, err
+	}
+
+	if msg.NewAdmin != "" {
+		if _, err := ms.ak.AddressCodec().StringToBytes(msg.NewAdmin); err != nil {
+			return nil, errorsmod.Wrapf(types.ErrInvalidAddress, "invalid new admin address: %s", err)
+		}
+	}
+
+	if err := ms.DenomAuthority.Set(ctx, msg.Denom, types.DenomAuthorityMetadata{Admin: msg.NewAdmin}); err != nil {
+		return nil, err
+	}
+
+	if err := ms.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeChangeAdmin,
+		event.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+		event.NewAttribute(types.AttributeKeyNewAdmin, msg.NewAdmin),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgChangeAdminResponse{}, nil
+}
+
+// SetMetadata sets the bank metadata of a factory denom administered by the sender.
+func (ms msgServer) SetMetadata(ctx context.Context, msg *types.MsgSetMetadata) (*types.MsgSetMetadataResponse, error) {
+	if err := ms.checkAdmin(ctx, msg.Sender, msg.Metadata.Base); err != nil {
+		return nil, err
+	}
+
+	if err := msg.Metadata.Validate(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidDenom, err.Error())
+	}
+
+	ms.bk.SetDenomMetaData(ctx, msg.Metadata)
+
+	if err := ms.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeSetMetadata,
+		event.NewAttribute(types.AttributeKeyDenom, msg.Metadata.Base),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetMetadataResponse{}, nil
+}
+
+// UpdateParams updates the params.
+func (ms msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if ms.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := ms.Params.Set(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// checkAdmin returns an error unless sender is the admin of the factory denom.
+func (ms msgServer) checkAdmin(ctx context.Context, sender, denom string) error {
+	metadata, err := ms.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	if metadata.Admin == "" || metadata.Admin != sender {
+		return errorsmod.Wrapf(types.ErrUnauthorized, "denom %s", denom)
+	}
+
+	return nil
+}
@@ -0,0 +1,159 @@
+package keeper_test
+
+import (
+	banktypes "cosmossdk.io/x/bank/types"
+	"cosmossdk.io/x/tokenfactory/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestMsgCreateDenom() {
+	denom := "factory/" + s.creator + "/bitcoin"
+	s.bankKeeper.EXPECT().HasSupply(s.ctx, denom).Return(false)
+	s.bankKeeper.EXPECT().GetDenomMetaData(s.ctx, denom).Return(banktypes.Metadata{}, false)
+	s.bankKeeper.EXPECT().SetDenomMetaData(s.ctx, banktypes.Metadata{
+		Base:       denom,
+		Display:    denom,
+		Name:       denom,
+		Symbol:     denom,
+		DenomUnits: []*banktypes.DenomUnit{{Denom: denom, Exponent: 0}},
+	})
+
+	res, err := s.msgServer.CreateDenom(s.ctx, &types.MsgCreateDenom{Sender: s.creator, Subdenom: "bitcoin"})
+	s.Require().NoError(err)
+	s.Require().Equal(denom, res.NewTokenDenom)
+}
+
+func (s *KeeperTestSuite) TestMsgMint() {
+	denom := s.createDenom("bitcoin")
+	amount := sdk.NewInt64Coin(denom, 100)
+
+	// coins are minted to the sender by default
+	s.bankKeeper.EXPECT().MintCoins(s.ctx, types.ModuleName, sdk.NewCoins(amount)).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(s.ctx, types.ModuleName, sdk.AccAddress("creator"), sdk.NewCoins(amount)).Return(nil)
+	_, err := s.msgServer.Mint(s.ctx, &types.MsgMint{Sender: s.creator, Amount: amount})
+	s.Require().NoError(err)
+
+	s.bankKeeper.EXPECT().MintCoins(s.ctx, types.ModuleName, sdk.NewCoins(amount)).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(s.ctx, types.ModuleName, sdk.AccAddress("receiver"), sdk.NewCoins(amount)).Return(nil)
+	_, err = s.msgServer.Mint(s.ctx, &types.MsgMint{Sender: s.creator, Amount: amount, MintToAddress: s.receiver})
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.Mint(s.ctx, &types.MsgMint{Sender: s.receiver, Amount: amount})
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = s.msgServer.Mint(s.ctx, &types.MsgMint{Sender: s.creator, Amount: sdk.NewInt64Coin("stake", 100)})
+	s.Require().ErrorIs(err, types.ErrDenomNotFound)
+
+	_, err = s.msgServer.Mint(s.ctx, &types.MsgMint{Sender: s.creator, Amount: sdk.NewInt64Coin(denom, 0)})
+	s.Require().ErrorIs(err, types.ErrInvalidAmount)
+}
+
+func (s *KeeperTestSuite) TestMsgBurn() {
+	denom := s.createDenom("bitcoin")
+	amount := sdk.NewInt64Coin(denom, 100)
+
+	s.bankKeeper.EXPECT().BurnCoins(s.ctx, sdk.AccAddress("creator"), sdk.NewCoins(amount)).Return(nil)
+	_, err := s.msgServer.Burn(s.ctx, &types.MsgBurn{Sender: s.creator, Amount: amount})
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.Burn(s.ctx, &types.MsgBurn{Sender: s.receiver, Amount: amount})
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+}
+
+func (s *KeeperTestSuite) TestMsgChangeAdmin() {
+	denom := s.createDenom("bitcoin")
+
+	_, err := s.msgServer.ChangeAdmin(s.ctx, &types.MsgChangeAdmin{Sender: s.receiver, Denom: denom, NewAdmin: s.receiver})
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = s.msgServer.ChangeAdmin(s.ctx, &types.MsgChangeAdmin{Sender: s.creator, Denom: denom, NewAdmin: "invalid"})
+	s.Require().ErrorIs(err, types.ErrInvalidAddress)
+
+	_, err = s.msgServer.ChangeAdmin(s.ctx, &types.MsgChangeAdmin{Sender: s.creator, Denom: denom, NewAdmin: s.receiver})
+	s.Require().NoError(err)
+
+	// the previous admin lost its rights
+	_, err = s.msgServer.Mint(s.ctx, &types.MsgMint{Sender: s.creator, Amount: sdk.NewInt64Coin(denom, 100)})
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// the admin rights are renounced for good
+	_, err = s.msgServer.ChangeAdmin(s.ctx, &types.MsgChangeAdmin{Sender: s.receiver, Denom: denom})
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.ChangeAdmin(s.ctx, &types.MsgChangeAdmin{Sender: "", Denom: denom, NewAdmin: s.receiver})
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+}
+
+func (s *KeeperTestSuite) TestMsgSetMetadata() {
+	denom := s.createDenom("bitcoin")
+	metadata := banktypes.Metadata{
+		Description: "wrapped bitcoin",
+		Base:        denom,
+		Display:     "bitcoin",
+		Name:        "Bitcoin",
+		Symbol:      "BTC",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: denom, Exponent: 0},
+			{Denom: "bitcoin", Exponent: 8},
+		},
+	}
+
+	s.bankKeeper.EXPECT().SetDenomMetaData(s.ctx, metadata)
+	_, err := s.msgServer.SetMetadata(s.ctx, &types.MsgSetMetadata{Sender: s.creator, Metadata: metadata})
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.SetMetadata(s.ctx, &types.MsgSetMetadata{Sender: s.receiver, Metadata: metadata})
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	metadata.DenomUnits = nil
+	_, err = s.msgServer.SetMetadata(s.ctx, &types.MsgSetMetadata{Sender: s.creator, Metadata: metadata})
+	s.Require().ErrorIs(err, types.ErrInvalidDenom)
+
+}
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	testCases := []struct {
+		name      string
+		request   *types.MsgUpdateParams
+		expectErr bool
+	}{
+		{
+			name: "set invalid authority (not defined authority)",
+			request: &types.MsgUpdateParams{
+				Authority: "cosmos139f7kncmglres2nf3h4hc4tade85ekfr8sulz5",
+			},
+			expectErr: true,
+		},
+		{
+			name: "set invalid params",
+			request: &types.MsgUpdateParams{
+				Authority: s.tokenFactory.GetAuthority(),
+				Params:    types.Params{DenomCreationFee: sdk.Coins{sdk.NewInt64Coin("stake", 0)}},
+			},
+			expectErr: true,
+		},
+		{
+			name: "set full valid params",
+			request: &types.MsgUpdateParams{
+				Authority: s.tokenFactory.GetAuthority(),
+				Params:    types.NewParams(sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))),
+			},
+			expectErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.UpdateParams(s.ctx, tc.request)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				params, err := s.tokenFactory.Params.Get(s.ctx)
+				s.Require().NoError(err)
+				s.Require().Equal(tc.request.Params, params)
+			}
+		})
+	}
+}
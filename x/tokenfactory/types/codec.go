@@ -0,0 +1,36 @@
+package types
+
+import (
+	"cosmossdk.io/core/registry"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers concrete types on the LegacyAmino codec
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(Params{}, "cosmos-sdk/x/tokenfactory/Params", nil)
+	legacy.RegisterAminoMsg(cdc, &MsgCreateDenom{}, "cosmos-sdk/tokenfactory/MsgCreateDenom")
+	legacy.RegisterAminoMsg(cdc, &MsgMint{}, "cosmos-sdk/tokenfactory/MsgMint")
+	legacy.RegisterAminoMsg(cdc, &MsgBurn{}, "cosmos-sdk/tokenfactory/MsgBurn")
+	legacy.RegisterAminoMsg(cdc, &MsgChangeAdmin{}, "cosmos-sdk/tokenfactory/MsgChangeAdmin")
+	legacy.RegisterAminoMsg(cdc, &MsgSetMetadata{}, "cosmos-sdk/tokenfactory/MsgSetMetadata")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/tokenfactory/MsgUpdateParams")
+}
+
+// RegisterInterfaces registers the interfaces types with the interface registry.
+func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
+	registrar.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgCreateDenom{},
+		&MsgMint{},
+		&MsgBurn{},
+		&MsgChangeAdmin{},
+		&MsgSetMetadata{},
+		&MsgUpdateParams{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
+}
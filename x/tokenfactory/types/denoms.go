@@ -0,0 +1,56 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleDenomPrefix is the first part of the denoms created with the
+	// tokenfactory module, i.e. factory/{creator}/{subdenom}.
+	ModuleDenomPrefix = "factory"
+
+	// MaxSubdenomLength is the maximum length of a subdenom.
+	MaxSubdenomLength = 44
+	// MaxCreatorLength is the maximum length of the creator address of a denom.
+	MaxCreatorLength = 75
+)
+
+// GetTokenDenom returns the denom factory/{creator}/{subdenom}, validating its
+// parts and the resulting denom.
+func GetTokenDenom(creator, subdenom string) (string, error) {
+	if len(subdenom) == 0 || len(subdenom) > MaxSubdenomLength {
+		return "", errorsmod.Wrapf(ErrInvalidDenom, "subdenom must be between 1 and %d characters", MaxSubdenomLength)
+	}
+	if len(creator) == 0 || len(creator) > MaxCreatorLength {
+		return "", errorsmod.Wrapf(ErrInvalidDenom, "creator must be between 1 and %d characters", MaxCreatorLength)
+	}
+	if strings.Contains(creator, "/") {
+		return "", errorsmod.Wrap(ErrInvalidDenom, "creator cannot contain '/'")
+	}
+
+	denom := strings.Join([]string{ModuleDenomPrefix, creator, subdenom}, "/")
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", errorsmod.Wrap(ErrInvalidDenom, err.Error())
+	}
+
+	return denom, nil
+}
+
+// DeconstructDenom returns the creator and subdenom of a denom created with the
+// tokenfactory module. The creator is not checked to be a valid address.
+func DeconstructDenom(denom string) (creator, subdenom string, err error) {
+	parts := strings.SplitN(denom, "/", 3)
+	if len(parts) != 3 || parts[0] != ModuleDenomPrefix {
+		return "", "", errorsmod.Wrapf(ErrInvalidDenom, "%s is not of the form %s/{creator}/{subdenom}", denom, ModuleDenomPrefix)
+	}
+
+	if _, err := GetTokenDenom(parts[1], parts[2]); err != nil {
+		return "", "", err
+	}
+
+	return parts[1], parts[2], nil
+}
@@ -0,0 +1,45 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/tokenfactory/types"
+)
+
+func TestGetTokenDenom(t *testing.T) {
+	creator := "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du"
+
+	denom, err := types.GetTokenDenom(creator, "bitcoin")
+	require.NoError(t, err)
+	require.Equal(t, "factory/"+creator+"/bitcoin", denom)
+
+	gotCreator, subdenom, err := types.DeconstructDenom(denom)
+	require.NoError(t, err)
+	require.Equal(t, creator, gotCreator)
+	require.Equal(t, "bitcoin", subdenom)
+
+	// subdenoms may contain slashes
+	denom, err = types.GetTokenDenom(creator, "wrapped/bitcoin")
+	require.NoError(t, err)
+	_, subdenom, err = types.DeconstructDenom(denom)
+	require.NoError(t, err)
+	require.Equal(t, "wrapped/bitcoin", subdenom)
+
+	for _, tc := range []struct{ creator, subdenom string }{
+		{creator, ""},
+		{creator, "abcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyz"},
+		{creator, "bit coin"},
+		{"", "bitcoin"},
+		{"cosmos/1", "bitcoin"},
+	} {
+		_, err := types.GetTokenDenom(tc.creator, tc.subdenom)
+		require.ErrorIs(t, err, types.ErrInvalidDenom, tc)
+	}
+
+	for _, denom := range []string{"stake", "factory/" + creator, "ibc/" + creator + "/bitcoin", "factory//bitcoin"} {
+		_, _, err := types.DeconstructDenom(denom)
+		require.ErrorIs(t, err, types.ErrInvalidDenom, denom)
+	}
+}
@@ -0,0 +1,13 @@
+package types
+
+import "cosmossdk.io/errors"
+
+var (
+	ErrInvalidSigner  = errors.Register(ModuleName, 1, "expected authority account as only signer for proposal message")
+	ErrDenomExists    = errors.Register(ModuleName, 2, "denom already exists")
+	ErrInvalidDenom   = errors.Register(ModuleName, 3, "invalid denom")
+	ErrDenomNotFound  = errors.Register(ModuleName, 4, "denom not found")
+	ErrUnauthorized   = errors.Register(ModuleName, 5, "sender is not the admin of the denom")
+	ErrInvalidAmount  = errors.Register(ModuleName, 6, "invalid amount")
+	ErrInvalidAddress = errors.Register(ModuleName, 7, "invalid address")
+)
@@ -0,0 +1,17 @@
+package types
+
+// Token factory module event types
+const (
+	EventTypeCreateDenom = "create_denom"
+	EventTypeMint        = "tf_mint"
+	EventTypeBurn        = "tf_burn"
+	EventTypeChangeAdmin = "change_admin"
+	EventTypeSetMetadata = "set_denom_metadata"
+
+	AttributeKeyCreator       = "creator"
+	AttributeKeyNewTokenDenom = "new_token_denom"
+	AttributeKeyMintToAddress = "mint_to_address"
+	AttributeKeyBurnFrom      = "burn_from_address"
+	AttributeKeyDenom         = "denom"
+	AttributeKeyNewAdmin      = "new_admin"
+)
@@ -0,0 +1,38 @@
+package types
+
+import "fmt"
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, factoryDenoms []GenesisDenom) *GenesisState {
+	return &GenesisState{
+		Params:        params,
+		FactoryDenoms: factoryDenoms,
+	}
+}
+
+// DefaultGenesisState creates a default GenesisState object
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), []GenesisDenom{})
+}
+
+// ValidateGenesis validates the provided genesis state to ensure the
+// expected invariants holds.
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(data.FactoryDenoms))
+	for _, denom := range data.FactoryDenoms {
+		if seen[denom.Denom] {
+			return fmt.Errorf("duplicate factory denom %s", denom.Denom)
+		}
+		seen[denom.Denom] = true
+
+		if _, _, err := DeconstructDenom(denom.Denom); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
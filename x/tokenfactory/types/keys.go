@@ -0,0 +1,25 @@
+package types
+
+import "cosmossdk.io/collections"
+
+var (
+	// ParamsKey is the key used to store the module parameters.
+	ParamsKey = collections.NewPrefix(0)
+	// DenomAuthorityPrefix is the prefix for the authority metadata of the denoms.
+	DenomAuthorityPrefix = collections.NewPrefix(1)
+	// CreatorDenomsPrefix is the prefix for the index of the denoms by creator.
+	CreatorDenomsPrefix = collections.NewPrefix(2)
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "tokenfactory"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// GovModuleName duplicates the gov module's name to avoid a dependency with x/gov.
+	// It should be synced with the gov module's name if it is ever changed.
+	// See: https://github.com/cosmos/cosmos-sdk/blob/b62a28aac041829da5ded4aeacfcd7a42873d1c8/x/gov/types/keys.go#L9
+	GovModuleName = "gov"
+)
@@ -0,0 +1,29 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewParams creates a new Params instance.
+func NewParams(denomCreationFee sdk.Coins) Params {
+	return Params{
+		DenomCreationFee: denomCreationFee,
+	}
+}
+
+// DefaultParams returns the default tokenfactory module parameters, which
+// charge no fee to create a denom.
+func DefaultParams() Params {
+	return NewParams(sdk.NewCoins())
+}
+
+// Validate validates the params.
+func (p Params) Validate() error {
+	if err := p.DenomCreationFee.Validate(); err != nil {
+		return fmt.Errorf("invalid denom creation fee: %w", err)
+	}
+
+	return nil
+}
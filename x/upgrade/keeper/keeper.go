@@ -34,12 +34,14 @@ type Keeper struct {
 	homePath           string         // root directory of app config
 	skipUpgradeHeights map[int64]bool // map of heights to skip for an upgrade
 	environment        appmodule.Environment
-	cdc                codec.BinaryCodec               // App-wide binary codec
-	upgradeHandlers    map[string]types.UpgradeHandler // map of plan name to upgrade handler
-	versionModifier    xp.AppVersionModifier           // implements setting the protocol version field on BaseApp
-	downgradeVerified  bool                            // tells if we've already sanity checked that this binary version isn't being used against an old state.
-	authority          string                          // the address capable of executing and canceling an upgrade. Usually the gov module account
-	initVersionMap     module.VersionMap               // the module version map at init genesis
+	cdc                codec.BinaryCodec                  // App-wide binary codec
+	upgradeHandlers    map[string]types.UpgradeHandler    // map of plan name to upgrade handler
+	preUpgradeHandlers map[string]types.PreUpgradeHandler // map of module name to pre-upgrade handler
+	binaryPath         string                             // path of the binary verified against the plan checksums, defaults to the running executable
+	versionModifier    xp.AppVersionModifier              // implements setting the protocol version field on BaseApp
+	downgradeVerified  bool                               // tells if we've already sanity checked that this binary version isn't being used against an old state.
+	authority          string                             // the address capable of executing and canceling an upgrade. Usually the gov module account
+	initVersionMap     module.VersionMap                  // the module version map at init genesis
 }
 
 // NewKeeper constructs an upgrade Keeper which requires the following arguments:
@@ -55,6 +57,7 @@ func NewKeeper(env appmodule.Environment, skipUpgradeHeights map[int64]bool, cdc
 		environment:        env,
 		cdc:                cdc,
 		upgradeHandlers:    map[string]types.UpgradeHandler{},
+		preUpgradeHandlers: map[string]types.PreUpgradeHandler{},
 		versionModifier:    vs,
 		authority:          authority,
 	}
@@ -85,6 +88,22 @@ func (k Keeper) SetUpgradeHandler(name string, upgradeHandler types.UpgradeHandl
 	k.upgradeHandlers[name] = upgradeHandler
 }
 
+// SetPreUpgradeHandler registers a PreUpgradeHandler for the given module name. All pre-upgrade handlers are
+// called, in module name order, when any upgrade is applied and before its UpgradeHandler runs. It panics if
+// a handler is already registered for the module.
+func (k Keeper) SetPreUpgradeHandler(moduleName string, preUpgradeHandler types.PreUpgradeHandler) {
+	if _, ok := k.preUpgradeHandlers[moduleName]; ok {
+		panic(fmt.Sprintf("pre-upgrade handler for module %s already registered", moduleName))
+	}
+	k.preUpgradeHandlers[moduleName] = preUpgradeHandler
+}
+
+// SetBinaryPath sets the path of the binary verified against the checksums declared in the upgrade plan info.
+// It defaults to the running executable.
+func (k *Keeper) SetBinaryPath(binaryPath string) {
+	k.binaryPath = binaryPath
+}
+
 // SetModuleVersionMap saves a given version map to state
 func (k Keeper) SetModuleVersionMap(ctx context.Context, vm module.VersionMap) error {
 	if len(vm) > 0 {
@@ -418,6 +437,14 @@ func (k Keeper) ApplyUpgrade(ctx context.Context, plan types.Plan) error {
 		return fmt.Errorf("ApplyUpgrade should never be called without first checking HasHandler")
 	}
 
+	if err := k.verifyBinaryChecksum(ctx, plan); err != nil {
+		return err
+	}
+
+	if err := k.runPreUpgradeHandlers(ctx, plan); err != nil {
+		return err
+	}
+
 	vm, err := k.GetModuleVersionMap(ctx)
 	if err != nil {
 		return err
@@ -2,6 +2,10 @@ package keeper_test
 
 import (
 	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"os"
 	"path/filepath"
 	"testing"
 
@@ -325,6 +329,66 @@ func (s *KeeperTestSuite) TestMigrations() {
 	s.Require().NoError(err)
 }
 
+func (s *KeeperTestSuite) TestPreUpgradeHandlers() {
+	var called []string
+	s.upgradeKeeper.SetUpgradeHandler("dummy", func(_ context.Context, _ types.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		called = append(called, "upgrade")
+		return vm, nil
+	})
+	s.upgradeKeeper.SetPreUpgradeHandler("bank", func(_ context.Context, plan types.Plan) error {
+		s.Require().Equal("dummy", plan.Name)
+		called = append(called, "bank")
+		return nil
+	})
+	s.upgradeKeeper.SetPreUpgradeHandler("auth", func(_ context.Context, _ types.Plan) error {
+		called = append(called, "auth")
+		return nil
+	})
+	s.Require().Panics(func() {
+		s.upgradeKeeper.SetPreUpgradeHandler("bank", func(_ context.Context, _ types.Plan) error { return nil })
+	})
+
+	dummyPlan := types.Plan{
+		Name:   "dummy",
+		Info:   "some text here",
+		Height: 123450000,
+	}
+	s.Require().NoError(s.upgradeKeeper.ApplyUpgrade(s.ctx, dummyPlan))
+	s.Require().Equal([]string{"auth", "bank", "upgrade"}, called)
+
+	called = nil
+	s.upgradeKeeper.SetPreUpgradeHandler("staking", func(_ context.Context, _ types.Plan) error {
+		return errors.New("invariant broken")
+	})
+	err := s.upgradeKeeper.ApplyUpgrade(s.ctx, dummyPlan)
+	s.Require().ErrorIs(err, types.ErrPreUpgradeCheckFailed)
+	s.Require().ErrorContains(err, "module staking: invariant broken")
+	s.Require().Equal([]string{"auth", "bank"}, called)
+}
+
+func (s *KeeperTestSuite) TestBinaryChecksumVerification() {
+	binaryPath := filepath.Join(s.T().TempDir(), "simd")
+	s.Require().NoError(os.WriteFile(binaryPath, []byte("binary contents"), 0o600))
+	s.upgradeKeeper.SetBinaryPath(binaryPath)
+	checksum := sha256.Sum256([]byte("binary contents"))
+
+	s.upgradeKeeper.SetUpgradeHandler("dummy", func(_ context.Context, _ types.Plan, vm module.VersionMap) (module.VersionMap, error) { return vm, nil })
+	dummyPlan := types.Plan{
+		Name:   "dummy",
+		Info:   fmt.Sprintf(`{"binaries":{"any":"https://example.com/simd"},"checksums":{"any":"sha256:%x"}}`, sha256.Sum256([]byte("other contents"))),
+		Height: 123450000,
+	}
+	err := s.upgradeKeeper.ApplyUpgrade(s.ctx, dummyPlan)
+	s.Require().ErrorIs(err, types.ErrInvalidBinaryChecksum)
+
+	dummyPlan.Info = fmt.Sprintf(`{"binaries":{"any":"https://example.com/simd"},"checksums":{"any":"sha256:%x"}}`, checksum)
+	s.Require().NoError(s.upgradeKeeper.ApplyUpgrade(s.ctx, dummyPlan))
+
+	// plans without checksums are not verified
+	dummyPlan.Info = `{"binaries":{"any":"https://example.com/simd"}}`
+	s.Require().NoError(s.upgradeKeeper.ApplyUpgrade(s.ctx, dummyPlan))
+}
+
 func (s *KeeperTestSuite) TestLastCompletedUpgrade() {
 	keeper := s.upgradeKeeper
 	require := s.Require()
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"runtime"
+	"sort"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	upgradeplan "cosmossdk.io/x/upgrade/plan"
+	"cosmossdk.io/x/upgrade/types"
+)
+
+// verifyBinaryChecksum checks the running binary against the checksum declared for its os/arch in the plan info.
+// Only inline JSON plan info is considered, so that no download happens while applying the upgrade.
+func (k Keeper) verifyBinaryChecksum(ctx context.Context, plan types.Plan) error {
+	if !strings.HasPrefix(strings.TrimSpace(plan.Info), "{") {
+		return nil
+	}
+
+	info, err := upgradeplan.ParseInfo(plan.Info)
+	if err != nil {
+		// the plan info is free-form, it is not required to follow the upgrade plan info format
+		return nil
+	}
+
+	checksum, ok := info.Checksums.ChecksumFor(fmt.Sprintf("%s/%s", runtime.GOOS, runtime.GOARCH))
+	if !ok {
+		return nil
+	}
+
+	binaryPath := k.binaryPath
+	if binaryPath == "" {
+		if binaryPath, err = os.Executable(); err != nil {
+			return errorsmod.Wrapf(types.ErrInvalidBinaryChecksum, "could not locate running binary: %s", err)
+		}
+	}
+
+	if err := upgradeplan.VerifyBinaryChecksum(binaryPath, checksum); err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidBinaryChecksum, "upgrade %q: %s", plan.Name, err)
+	}
+
+	k.Logger(ctx).Info("verified binary checksum", "upgrade", plan.Name, "checksum", checksum)
+	return nil
+}
+
+// runPreUpgradeHandlers calls all registered pre-upgrade handlers in module name order.
+func (k Keeper) runPreUpgradeHandlers(ctx context.Context, plan types.Plan) error {
+	moduleNames := make([]string, 0, len(k.preUpgradeHandlers))
+	for moduleName := range k.preUpgradeHandlers {
+		moduleNames = append(moduleNames, moduleName)
+	}
+	sort.Strings(moduleNames)
+
+	for _, moduleName := range moduleNames {
+		if err := k.preUpgradeHandlers[moduleName](ctx, plan); err != nil {
+			return errorsmod.Wrapf(types.ErrPreUpgradeCheckFailed, "module %s: %s", moduleName, err)
+		}
+	}
+
+	return nil
+}
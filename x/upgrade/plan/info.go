@@ -1,9 +1,15 @@
 package plan
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"hash"
+	"io"
 	neturl "net/url"
 	"os"
 	"path/filepath"
@@ -18,11 +24,19 @@ type Info struct {
 	parseConfig ParseConfig `json:"-"`
 
 	Binaries BinaryDownloadURLMap `json:"binaries"`
+
+	// Checksums are the expected checksums of the upgraded binaries, verified by
+	// the node against its running binary before applying the upgrade.
+	Checksums BinaryChecksumMap `json:"checksums,omitempty"`
 }
 
 // BinaryDownloadURLMap is a map of os/architecture strings to a URL where the binary can be downloaded.
 type BinaryDownloadURLMap map[string]string
 
+// BinaryChecksumMap is a map of os/architecture strings to the expected checksum of the binary,
+// formatted as "{algorithm}:{hex digest}" with sha256 or sha512 as algorithm.
+type BinaryChecksumMap map[string]string
+
 // ParseConfig is used to configure the parsing of a Plan.Info string.
 type ParseConfig struct {
 	// EnforceChecksum, if true, will cause all downloaded files to be checked against their checksums.
@@ -80,6 +94,7 @@ func ParseInfo(infoStr string, opts ...ParseOption) (*Info, error) {
 // The provided daemonName is the name of the executable file expected in all downloaded directories.
 // It checks that:
 //   - Binaries.ValidateBasic() doesn't return an error
+//   - Checksums.ValidateBasic() doesn't return an error
 //   - Binaries.CheckURLs(daemonName) doesn't return an error.
 //
 // Warning: This is an expensive process. See BinaryDownloadURLMap.CheckURLs for more info.
@@ -87,6 +102,9 @@ func (m Info) ValidateFull(daemonName string) error {
 	if err := m.Binaries.ValidateBasic(m.parseConfig.EnforceChecksum); err != nil {
 		return err
 	}
+	if err := m.Checksums.ValidateBasic(); err != nil {
+		return err
+	}
 	if err := m.Binaries.CheckURLs(daemonName, m.parseConfig.EnforceChecksum); err != nil {
 		return err
 	}
@@ -141,3 +159,87 @@ func (m BinaryDownloadURLMap) CheckURLs(daemonName string, enforceChecksum bool)
 	}
 	return nil
 }
+
+// ValidateBasic does stateless validation of this BinaryChecksumMap.
+// It validates that:
+//   - All entry keys have the format "os/arch" or are "any".
+//   - All entry values have the format "{algorithm}:{hex digest}" with a supported algorithm.
+func (m BinaryChecksumMap) ValidateBasic() error {
+	osArchRx := regexp.MustCompile(`[a-zA-Z0-9]+/[a-zA-Z0-9]+`)
+	for key, val := range m {
+		if key != "any" && !osArchRx.MatchString(key) {
+			return fmt.Errorf("invalid os/arch format in key \"%s\"", key)
+		}
+
+		if _, _, err := parseChecksum(val); err != nil {
+			return fmt.Errorf("invalid checksum \"%s\" in checksums[%s]: %w", val, key, err)
+		}
+	}
+
+	return nil
+}
+
+// ChecksumFor returns the expected checksum of the binary for the given os/arch,
+// falling back on the "any" entry.
+func (m BinaryChecksumMap) ChecksumFor(osArch string) (string, bool) {
+	if checksum, ok := m[osArch]; ok {
+		return checksum, true
+	}
+
+	checksum, ok := m["any"]
+	return checksum, ok
+}
+
+// VerifyBinaryChecksum checks that the file at the given path has the given checksum,
+// formatted as "{algorithm}:{hex digest}".
+func VerifyBinaryChecksum(path, checksum string) error {
+	newHash, expected, err := parseChecksum(checksum)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("could not open binary: %w", err)
+	}
+	defer f.Close()
+
+	h := newHash()
+	if _, err := io.Copy(h, f); err != nil {
+		return fmt.Errorf("could not read binary: %w", err)
+	}
+
+	if actual := h.Sum(nil); !bytes.Equal(actual, expected) {
+		return fmt.Errorf("binary %s has checksum %x, expected %x", path, actual, expected)
+	}
+
+	return nil
+}
+
+// parseChecksum parses a checksum formatted as "{algorithm}:{hex digest}".
+func parseChecksum(checksum string) (func() hash.Hash, []byte, error) {
+	algo, digest, found := strings.Cut(checksum, ":")
+	if !found {
+		return nil, nil, errors.New("checksum must have the format {algorithm}:{hex digest}")
+	}
+
+	var newHash func() hash.Hash
+	switch algo {
+	case "sha256":
+		newHash = sha256.New
+	case "sha512":
+		newHash = sha512.New
+	default:
+		return nil, nil, fmt.Errorf("unsupported checksum algorithm %q", algo)
+	}
+
+	expected, err := hex.DecodeString(digest)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid checksum digest: %w", err)
+	}
+	if len(expected) != newHash().Size() {
+		return nil, nil, fmt.Errorf("invalid %s checksum digest length %d", algo, len(expected))
+	}
+
+	return newHash, expected, nil
+}
@@ -1,6 +1,11 @@
 package plan
 
 import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"fmt"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -344,3 +349,100 @@ func (s *InfoTestSuite) TestBinaryDownloadURLMapCheckURLs() {
 		})
 	}
 }
+
+func (s *InfoTestSuite) TestBinaryChecksumMapValidateBasic() {
+	tests := []struct {
+		name      string
+		checksums BinaryChecksumMap
+		errs      []string
+	}{
+		{
+			name:      "empty map",
+			checksums: BinaryChecksumMap{},
+			errs:      nil,
+		},
+		{
+			name: "valid entries",
+			checksums: BinaryChecksumMap{
+				"any":         "sha256:b5a2c96250612366ea272ffac6d9744aaf4b45aacd96aa7cfcb931ee3b558259",
+				"linux/amd64": "sha512:" + strings.Repeat("ab", 64),
+			},
+			errs: nil,
+		},
+		{
+			name: "invalid key format",
+			checksums: BinaryChecksumMap{
+				"badkey": "sha256:b5a2c96250612366ea272ffac6d9744aaf4b45aacd96aa7cfcb931ee3b558259",
+			},
+			errs: []string{"invalid os/arch", "badkey"},
+		},
+		{
+			name: "missing algorithm",
+			checksums: BinaryChecksumMap{
+				"any": "b5a2c96250612366ea272ffac6d9744aaf4b45aacd96aa7cfcb931ee3b558259",
+			},
+			errs: []string{"invalid checksum", "checksums[any]", "{algorithm}:{hex digest}"},
+		},
+		{
+			name: "unsupported algorithm",
+			checksums: BinaryChecksumMap{
+				"any": "md5:d41d8cd98f00b204e9800998ecf8427e",
+			},
+			errs: []string{"unsupported checksum algorithm", "md5"},
+		},
+		{
+			name: "wrong digest length",
+			checksums: BinaryChecksumMap{
+				"any": "sha256:b5a2c962",
+			},
+			errs: []string{"invalid sha256 checksum digest length 4"},
+		},
+	}
+
+	for _, tc := range tests {
+		s.T().Run(tc.name, func(t *testing.T) {
+			actualErr := tc.checksums.ValidateBasic()
+			if len(tc.errs) > 0 {
+				require.Error(t, actualErr)
+				for _, expectedErr := range tc.errs {
+					assert.Contains(t, actualErr.Error(), expectedErr)
+				}
+			} else {
+				require.NoError(t, actualErr)
+			}
+		})
+	}
+}
+
+func (s *InfoTestSuite) TestBinaryChecksumMapChecksumFor() {
+	checksums := BinaryChecksumMap{
+		"any":         "sha256:any",
+		"linux/amd64": "sha256:linux",
+	}
+
+	checksum, ok := checksums.ChecksumFor("linux/amd64")
+	s.Require().True(ok)
+	s.Require().Equal("sha256:linux", checksum)
+
+	checksum, ok = checksums.ChecksumFor("darwin/arm64")
+	s.Require().True(ok)
+	s.Require().Equal("sha256:any", checksum)
+
+	_, ok = BinaryChecksumMap{"linux/amd64": "sha256:linux"}.ChecksumFor("darwin/arm64")
+	s.Require().False(ok)
+}
+
+func (s *InfoTestSuite) TestVerifyBinaryChecksum() {
+	binaryPath := s.saveTestFile(NewTestFile("simd", "#!/usr/bin\necho 'simd'\n"))
+	sum256 := sha256.Sum256([]byte("#!/usr/bin\necho 'simd'\n"))
+	sum512 := sha512.Sum512([]byte("#!/usr/bin\necho 'simd'\n"))
+
+	s.Require().NoError(VerifyBinaryChecksum(binaryPath, fmt.Sprintf("sha256:%x", sum256)))
+	s.Require().NoError(VerifyBinaryChecksum(binaryPath, fmt.Sprintf("sha512:%x", sum512)))
+
+	err := VerifyBinaryChecksum(binaryPath, "sha256:b5a2c96250612366ea272ffac6d9744aaf4b45aacd96aa7cfcb931ee3b558259")
+	s.Require().ErrorContains(err, "expected b5a2c96250612366ea272ffac6d9744aaf4b45aacd96aa7cfcb931ee3b558259")
+
+	err = VerifyBinaryChecksum(filepath.Join(s.Home, "missing"), fmt.Sprintf("sha256:%x", sum256))
+	s.Require().ErrorContains(err, "could not open binary")
+}
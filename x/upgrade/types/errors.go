@@ -16,4 +16,8 @@ var (
 	ErrNoUpgradedConsensusStateFound = errors.Register(ModuleName, 5, "upgraded consensus state not found")
 	// ErrInvalidSigner error if the authority is not the signer for a proposal message
 	ErrInvalidSigner = errors.Register(ModuleName, 6, "expected authority account as only signer for proposal message")
+	// ErrInvalidBinaryChecksum error if the running binary doesn't match the checksum declared in the upgrade plan
+	ErrInvalidBinaryChecksum = errors.Register(ModuleName, 7, "invalid binary checksum")
+	// ErrPreUpgradeCheckFailed error if a pre-upgrade handler rejects the upgrade
+	ErrPreUpgradeCheckFailed = errors.Register(ModuleName, 8, "pre-upgrade check failed")
 )
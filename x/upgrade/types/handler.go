@@ -25,3 +25,9 @@ import (
 //
 // Please also refer to docs/core/upgrade.md for more information.
 type UpgradeHandler func(ctx context.Context, plan Plan, fromVM module.VersionMap) (module.VersionMap, error)
+
+// PreUpgradeHandler specifies the type of function that is called at the
+// upgrade height, before the UpgradeHandler of the plan is run. Modules can
+// register one to perform sanity checks on their state; returning an error
+// aborts the upgrade before any migration is applied.
+type PreUpgradeHandler func(ctx context.Context, plan Plan) error